@@ -1,6 +1,7 @@
 package clients
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -32,7 +33,12 @@ func (c *BaseClient) SetTimeout(timeout time.Duration) {
 }
 
 func (c *BaseClient) MakeRequest(method, endpoint string, body io.Reader) ([]byte, error) {
-	req, err := http.NewRequest(method, c.baseURL+endpoint, body)
+	return c.MakeRequestWithContext(context.Background(), method, endpoint, body)
+}
+
+// MakeRequestWithContext is MakeRequest cancelled with ctx
+func (c *BaseClient) MakeRequestWithContext(ctx context.Context, method, endpoint string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+endpoint, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -64,6 +70,11 @@ func (c *BaseClient) Get(endpoint string) ([]byte, error) {
 	return c.MakeRequest("GET", endpoint, nil)
 }
 
+// GetWithContext is Get cancelled with ctx
+func (c *BaseClient) GetWithContext(ctx context.Context, endpoint string) ([]byte, error) {
+	return c.MakeRequestWithContext(ctx, "GET", endpoint, nil)
+}
+
 func (c *BaseClient) Post(endpoint string, body io.Reader) ([]byte, error) {
 	return c.MakeRequest("POST", endpoint, body)
 }
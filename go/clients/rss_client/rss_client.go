@@ -0,0 +1,75 @@
+package rss_client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/clients"
+)
+
+// RSSClient fetches a single RSS 2.0 feed
+type RSSClient struct {
+	*clients.BaseClient
+}
+
+func NewRSSClient(feedURL string) *RSSClient {
+	return &RSSClient{
+		BaseClient: clients.NewBaseClient(feedURL),
+	}
+}
+
+// Feed is an RSS 2.0 document
+type Feed struct {
+	Channel Channel `xml:"channel"`
+}
+
+type Channel struct {
+	Title string `xml:"title"`
+	Link  string `xml:"link"`
+	Items []Item `xml:"item"`
+}
+
+type Item struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	Description string `xml:"description"`
+	GUID        string `xml:"guid"`
+	PubDate     string `xml:"pubDate"`
+}
+
+// ID returns a stable identifier for the item, preferring the GUID over the link
+func (i Item) ID() string {
+	if i.GUID != "" {
+		return i.GUID
+	}
+	return i.Link
+}
+
+// PublishedAt parses the item's pubDate, which RSS specifies in RFC 1123 format
+func (i Item) PublishedAt() (time.Time, error) {
+	pubDate := strings.TrimSpace(i.PubDate)
+	for _, layout := range []string{time.RFC1123Z, time.RFC1123} {
+		if t, err := time.Parse(layout, pubDate); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized pubDate %q", i.PubDate)
+}
+
+// GetFeed fetches and parses the feed
+func (c *RSSClient) GetFeed(ctx context.Context) (*Feed, error) {
+	body, err := c.GetWithContext(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch feed: %w", err)
+	}
+
+	var feed Feed
+	if err := xml.Unmarshal(body, &feed); err != nil {
+		return nil, fmt.Errorf("failed to parse feed: %w", err)
+	}
+
+	return &feed, nil
+}
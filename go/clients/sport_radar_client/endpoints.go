@@ -8,6 +8,11 @@ const (
 	accessLevelTrial    = "trial"
 	languageCodeEnglish = "en"
 
+	// Season types
+	SeasonTypePreseason  = "PRE"
+	SeasonTypeRegular    = "REG"
+	SeasonTypePostseason = "PST"
+
 	// Headers - SportRadar uses api_key query parameter, not header
 	APIKeyParam     = "api_key"
 	JsonHeader      = "accept"
@@ -0,0 +1,95 @@
+package sport_radar_client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SRDepthChartPlayer represents a player slotted on a depth chart position
+type SRDepthChartPlayer struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Jersey   string `json:"jersey"`
+	Position string `json:"position"`
+	Depth    int    `json:"depth"`
+	SrID     string `json:"sr_id"`
+}
+
+type SRDepthChartPositionDetail struct {
+	Name    string               `json:"name"`
+	Players []SRDepthChartPlayer `json:"players"`
+}
+
+type SRDepthChartPosition struct {
+	Position SRDepthChartPositionDetail `json:"position"`
+}
+
+// SRTeamDepthChart represents the depth chart for a single team
+type SRTeamDepthChart struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	Market       string                 `json:"market"`
+	Alias        string                 `json:"alias"`
+	SrID         string                 `json:"sr_id"`
+	Offense      []SRDepthChartPosition `json:"offense"`
+	Defense      []SRDepthChartPosition `json:"defense"`
+	SpecialTeams []SRDepthChartPosition `json:"special_teams"`
+}
+
+type SRDepthChartsResponse struct {
+	Teams []SRTeamDepthChart `json:"teams"`
+}
+
+type SRByeWeekTeam struct {
+	Team SRTeam `json:"team"`
+}
+
+type SRScheduleWeek struct {
+	ID       string          `json:"id"`
+	Sequence int             `json:"sequence"`
+	Title    string          `json:"title"`
+	ByeWeek  []SRByeWeekTeam `json:"bye_week"`
+}
+
+type SRScheduleResponse struct {
+	ID    string           `json:"id"`
+	Year  int              `json:"year"`
+	Type  string           `json:"type"`
+	Weeks []SRScheduleWeek `json:"weeks"`
+}
+
+// GetSeasonDepthCharts retrieves the depth charts for every NFL team for a season week
+func (c *SportRadarClient) GetSeasonDepthCharts(year int, seasonType string, week int) ([]SRTeamDepthChart, error) {
+	// Build endpoint: v7/{language_code}/seasons/{year}/{season_type}/{week}/depth_charts.json
+	endpoint := fmt.Sprintf("v7/%s/seasons/%d/%s/%d/depth_charts.json", languageCodeEnglish, year, seasonType, week)
+
+	body, err := c.Get(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get depth charts: %w", err)
+	}
+
+	var response SRDepthChartsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal depth charts response: %w, raw response: %s", err, string(body))
+	}
+
+	return response.Teams, nil
+}
+
+// GetSeasonSchedule retrieves the NFL schedule for a season, including bye weeks
+func (c *SportRadarClient) GetSeasonSchedule(year int, seasonType string) (*SRScheduleResponse, error) {
+	// Build endpoint: v7/{language_code}/games/{year}/{season_type}/schedule.json
+	endpoint := fmt.Sprintf("v7/%s/games/%d/%s/schedule.json", languageCodeEnglish, year, seasonType)
+
+	body, err := c.Get(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season schedule: %w", err)
+	}
+
+	var response SRScheduleResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schedule response: %w, raw response: %s", err, string(body))
+	}
+
+	return &response, nil
+}
@@ -41,6 +41,13 @@ type SRPlayer struct {
 	BirthPlace  string  `json:"birth_place"`
 	Status      string  `json:"status"`
 	SrID        string  `json:"sr_id"`
+	// References are the player's IDs in other systems, e.g. origin "gsis"
+	References []SRReference `json:"references"`
+}
+
+type SRReference struct {
+	ID     string `json:"id"`
+	Origin string `json:"origin"`
 }
 
 type SRRosterResponse struct {
@@ -22,6 +22,15 @@ type Team struct {
 	Established int     `json:"established"`
 	Logo        string  `json:"logo"`
 	Country     Country `json:"country"`
+	// Translations are the team's name and city in other locales, sent by providers that have them
+	Translations []TeamTranslation `json:"translations,omitempty"`
+}
+
+// TeamTranslation is a team's name and city in a locale, given as a BCP 47 tag
+type TeamTranslation struct {
+	Locale string `json:"locale"`
+	Name   string `json:"name"`
+	City   string `json:"city"`
 }
 
 type TeamsResponse struct {
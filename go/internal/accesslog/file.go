@@ -0,0 +1,97 @@
+package accesslog
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	filePrefix     = "access-"
+	fileSuffix     = ".jsonl"
+	fileHourLayout = "2006010215"
+)
+
+// FileSink writes entries to a file per hour in a directory, named for the hour in UTC, such
+// as access-2026101713.jsonl. Files are appended to, so several processes can share the
+// directory as long as each writes lines whole.
+type FileSink struct {
+	dir string
+}
+
+// NewFileSink creates a sink writing to dir, creating it if needed
+func NewFileSink(dir string) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create access log directory: %w", err)
+	}
+	return &FileSink{dir: dir}, nil
+}
+
+// Write appends entries to the files of the hours they were logged in
+func (s *FileSink) Write(ctx context.Context, entries []Entry) error {
+	for start := 0; start < len(entries); {
+		hour := entries[start].Time.UTC().Truncate(time.Hour)
+		end := start + 1
+		for end < len(entries) && entries[end].Time.UTC().Truncate(time.Hour).Equal(hour) {
+			end++
+		}
+		if err := s.append(hour, entries[start:end]); err != nil {
+			return err
+		}
+		start = end
+	}
+	return nil
+}
+
+func (s *FileSink) append(hour time.Time, entries []Entry) error {
+	path := filepath.Join(s.dir, filePrefix+hour.Format(fileHourLayout)+fileSuffix)
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open access log file: %w", err)
+	}
+
+	w := bufio.NewWriter(file)
+	enc := json.NewEncoder(w)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			file.Close()
+			return fmt.Errorf("failed to encode access log entry: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write access log file: %w", err)
+	}
+	return file.Close()
+}
+
+// Prune removes the files of hours that ended before cutoff
+func (s *FileSink) Prune(ctx context.Context, cutoff time.Time) error {
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("failed to list access log files: %w", err)
+	}
+
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, fileSuffix) {
+			continue
+		}
+		hour, err := time.Parse(fileHourLayout, strings.TrimSuffix(strings.TrimPrefix(name, filePrefix), fileSuffix))
+		if err != nil {
+			continue
+		}
+		if hour.Add(time.Hour).After(cutoff) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.dir, name)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove access log file: %w", err)
+		}
+	}
+	return nil
+}
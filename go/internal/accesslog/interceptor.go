@@ -0,0 +1,125 @@
+package accesslog
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+)
+
+type callerKey struct{}
+
+// caller is who made a call, filled in by NewIdentityInterceptor once the call has been
+// authenticated
+type caller struct {
+	userID         string
+	impersonatedBy string
+	operator       string
+	service        string
+	apiKeyID       string
+	leagueID       string
+}
+
+// NewInterceptor creates a Connect interceptor logging every call the server handles to
+// logger. Install it first, so calls turned away by the interceptors after it are logged too,
+// and NewIdentityInterceptor after authentication and tenancy to record who made them. Calls
+// turned away before then are logged with the user named in UserIDHeader, if any.
+func NewInterceptor(logger *Logger) connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+			readOnly := req.Spec().IdempotencyLevel == connect.IdempotencyNoSideEffects
+			if readOnly && logger.config.SkipReads {
+				return next(ctx, req)
+			}
+
+			start := time.Now()
+			who := &caller{}
+			resp, err := next(context.WithValue(ctx, callerKey{}, who), req)
+
+			entry := Entry{
+				Time:           start.UTC(),
+				Procedure:      req.Spec().Procedure,
+				Code:           "ok",
+				DurationMs:     time.Since(start).Milliseconds(),
+				UserID:         who.userID,
+				ImpersonatedBy: who.impersonatedBy,
+				Operator:       who.operator,
+				Service:        who.service,
+				APIKeyID:       who.apiKeyID,
+				LeagueID:       who.leagueID,
+				ClientIP:       interceptors.ClientIP(req),
+				ReadOnly:       readOnly,
+			}
+			if entry.UserID == "" {
+				entry.UserID = req.Header().Get(interceptors.UserIDHeader)
+			}
+			if msg, ok := req.Any().(proto.Message); ok {
+				if id := interceptors.UUIDField(msg, "draft_id"); id != uuid.Nil {
+					entry.DraftID = id.String()
+				}
+				if id := interceptors.UUIDField(msg, "league_id"); id != uuid.Nil && entry.LeagueID == "" {
+					entry.LeagueID = id.String()
+				}
+			}
+			if err != nil {
+				code := connect.CodeOf(err)
+				entry.Code = code.String()
+				var connectErr *connect.Error
+				if errors.As(err, &connectErr) && code != connect.CodeInternal && code != connect.CodeUnknown {
+					entry.Error = connectErr.Message()
+				}
+			}
+			logger.Log(entry)
+
+			return resp, err
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
+
+// NewIdentityInterceptor creates a Connect interceptor recording who made a call for
+// NewInterceptor: the acting user and the operator impersonating them, the platform operator,
+// the internal service or league API key, and the league tenancy checked the call against
+func NewIdentityInterceptor() connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			who, ok := ctx.Value(callerKey{}).(*caller)
+			if !ok {
+				return next(ctx, req)
+			}
+
+			if userID, ok := interceptors.ActingUserFromContext(ctx); ok {
+				who.userID = userID.String()
+			}
+			if principal, ok := interceptors.SessionPrincipalFromContext(ctx); ok {
+				who.impersonatedBy = principal.ImpersonatedBy
+			}
+			if operator, ok := interceptors.AdminOperatorFromContext(ctx); ok {
+				who.operator = operator
+			}
+			if service, ok := interceptors.ServicePrincipalFromContext(ctx); ok {
+				who.service = service
+			}
+			if principal, ok := interceptors.APIKeyPrincipalFromContext(ctx); ok {
+				who.apiKeyID = principal.KeyID.String()
+				who.leagueID = principal.LeagueID.String()
+			}
+			if leagueID, ok := interceptors.ResolvedLeagueFromContext(ctx); ok {
+				who.leagueID = leagueID.String()
+			}
+
+			return next(ctx, req)
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
@@ -0,0 +1,185 @@
+// Package accesslog records who called which RPC and with what result, apart from the
+// application logs, so leagues can hold their commissioners to account. Entries are written
+// as JSON lines to a Sink, such as rotating files on disk or objects in a bucket, and removed
+// once they are older than the configured retention.
+package accesslog
+
+import (
+	"context"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Entry is one call to the API
+type Entry struct {
+	Time       time.Time `json:"time"`
+	Procedure  string    `json:"procedure"`
+	Code       string    `json:"code"`            // "ok", or the Connect code the call failed with
+	Error      string    `json:"error,omitempty"` // why the call failed, unless it failed internally
+	DurationMs int64     `json:"duration_ms"`
+	UserID     string    `json:"user_id,omitempty"`
+	// ImpersonatedBy is the operator who made the call as UserID through a support session
+	ImpersonatedBy string `json:"impersonated_by,omitempty"`
+	Operator       string `json:"operator,omitempty"`   // the platform operator who called the admin service
+	Service        string `json:"service,omitempty"`    // the internal service that made the call
+	APIKeyID       string `json:"api_key_id,omitempty"` // the league API key the call was made with
+	LeagueID       string `json:"league_id,omitempty"`
+	DraftID        string `json:"draft_id,omitempty"`
+	ClientIP       string `json:"client_ip,omitempty"`
+	ReadOnly       bool   `json:"read_only,omitempty"` // the procedure has no side effects
+}
+
+// Sink keeps written entries
+type Sink interface {
+	// Write stores a batch of entries in the order they were logged
+	Write(ctx context.Context, entries []Entry) error
+	// Prune removes entries logged before cutoff. Sinks remove whole files or objects, so
+	// entries can outlive cutoff by as long as a file or object spans.
+	Prune(ctx context.Context, cutoff time.Time) error
+}
+
+// Config configures a Logger
+type Config struct {
+	FlushInterval time.Duration // How often logged entries are written
+	BatchSize     int           // Entries that are written without waiting for the interval
+	BufferSize    int           // Entries waiting to be written before new ones are dropped
+	Retention     time.Duration // How long entries are kept; 0 keeps them forever
+	PruneInterval time.Duration // How often entries past their retention are removed
+	SkipReads     bool          // Leave out calls to procedures without side effects
+}
+
+// DefaultConfig returns default access log configuration
+func DefaultConfig() Config {
+	return Config{
+		FlushInterval: 5 * time.Second,
+		BatchSize:     500,
+		BufferSize:    10000,
+		Retention:     90 * 24 * time.Hour,
+		PruneInterval: time.Hour,
+	}
+}
+
+// stats counts entries logged, dropped because writes fell behind, and written, and the
+// writes that failed
+var stats = expvar.NewMap("access_log")
+
+// Logger writes access log entries to a sink in the background, so logging never holds up
+// the call being logged
+type Logger struct {
+	sink    Sink
+	config  Config
+	entries chan Entry
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewLogger creates a logger writing to sink. Run Start to write what is logged.
+func NewLogger(sink Sink, config Config) *Logger {
+	return &Logger{
+		sink:    sink,
+		config:  config,
+		entries: make(chan Entry, config.BufferSize),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Log queues an entry to be written. When writes have fallen BufferSize entries behind, the
+// entry is dropped and counted instead.
+func (l *Logger) Log(entry Entry) {
+	select {
+	case l.entries <- entry:
+		stats.Add("logged", 1)
+	default:
+		stats.Add("dropped", 1)
+	}
+}
+
+// Start writes logged entries in batches and prunes the sink until Close is called, then
+// writes what is left. ctx is used for the sink's writes.
+func (l *Logger) Start(ctx context.Context) {
+	defer close(l.done)
+
+	log.Info().
+		Dur("flush_interval", l.config.FlushInterval).
+		Dur("retention", l.config.Retention).
+		Msg("starting access log")
+
+	flush := time.NewTicker(l.config.FlushInterval)
+	defer flush.Stop()
+	prune := time.NewTicker(l.config.PruneInterval)
+	defer prune.Stop()
+	l.prune(ctx)
+
+	var batch []Entry
+	failing := false // the last write failed; wait for the interval to try again
+	for {
+		select {
+		case entry := <-l.entries:
+			batch = append(batch, entry)
+			if len(batch) >= l.config.BatchSize && !failing {
+				batch, failing = l.write(ctx, batch)
+			}
+		case <-flush.C:
+			batch, failing = l.write(ctx, batch)
+		case <-prune.C:
+			l.prune(ctx)
+		case <-l.stop:
+			for {
+				select {
+				case entry := <-l.entries:
+					batch = append(batch, entry)
+				default:
+					l.write(ctx, batch)
+					log.Info().Msg("access log stopped")
+					return
+				}
+			}
+		}
+	}
+}
+
+// Close stops the logger once what has been logged is written. Entries logged afterwards
+// are not written.
+func (l *Logger) Close() {
+	l.closeOnce.Do(func() {
+		close(l.stop)
+	})
+	<-l.done
+}
+
+// write writes a batch, returning what is left to write and whether the write failed. A
+// failed batch is kept to try again until it holds BufferSize entries, then dropped.
+func (l *Logger) write(ctx context.Context, batch []Entry) ([]Entry, bool) {
+	if len(batch) == 0 {
+		return batch, false
+	}
+
+	if err := l.sink.Write(ctx, batch); err != nil {
+		stats.Add("write_errors", 1)
+		if len(batch) < l.config.BufferSize {
+			log.Error().Err(err).Int("entries", len(batch)).Msg("failed to write access log, will retry")
+			return batch, true
+		}
+		log.Error().Err(err).Int("entries", len(batch)).Msg("failed to write access log, dropping entries")
+		stats.Add("dropped", int64(len(batch)))
+		return batch[:0], true
+	}
+
+	stats.Add("written", int64(len(batch)))
+	return batch[:0], false
+}
+
+func (l *Logger) prune(ctx context.Context) {
+	if l.config.Retention <= 0 {
+		return
+	}
+	if err := l.sink.Prune(ctx, time.Now().Add(-l.config.Retention)); err != nil {
+		log.Error().Err(err).Msg("failed to prune access log")
+	}
+}
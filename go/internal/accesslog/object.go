@@ -0,0 +1,86 @@
+package accesslog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// ObjectStore keeps objects in a bucket, such as media.S3Store
+type ObjectStore interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// List returns the keys of the objects whose keys start with prefix
+	List(ctx context.Context, prefix string) ([]string, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// ObjectSink uploads every batch as a gzipped JSON lines object, under a folder for the day
+// its first entry was logged in UTC: <prefix>2026/10/17/130405-<source>-1.jsonl.gz. The
+// source tells apart the processes sharing a bucket.
+type ObjectSink struct {
+	store  ObjectStore
+	prefix string
+	source string
+	seq    atomic.Int64
+}
+
+// NewObjectSink creates a sink uploading to store under prefix, such as "access/"
+func NewObjectSink(store ObjectStore, prefix, source string) *ObjectSink {
+	return &ObjectSink{
+		store:  store,
+		prefix: prefix,
+		source: source,
+	}
+}
+
+// Write uploads entries as one object
+func (s *ObjectSink) Write(ctx context.Context, entries []Entry) error {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(zw)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return fmt.Errorf("failed to encode access log entry: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to compress access log: %w", err)
+	}
+
+	first := entries[0].Time.UTC()
+	key := fmt.Sprintf("%s/%s-%s-%d.jsonl.gz", s.dir(first), first.Format("150405"), s.source, s.seq.Add(1))
+	if err := s.store.Put(ctx, key, "application/gzip", buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to upload access log: %w", err)
+	}
+	return nil
+}
+
+// Prune removes the folders of days that ended before cutoff, from the latest back to the
+// first day without any objects
+func (s *ObjectSink) Prune(ctx context.Context, cutoff time.Time) error {
+	day := cutoff.UTC().Truncate(24 * time.Hour).Add(-24 * time.Hour)
+	for {
+		keys, err := s.store.List(ctx, s.dir(day)+"/")
+		if err != nil {
+			return fmt.Errorf("failed to list access logs: %w", err)
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		for _, key := range keys {
+			if err := s.store.Delete(ctx, key); err != nil {
+				return fmt.Errorf("failed to remove access log: %w", err)
+			}
+		}
+		day = day.Add(-24 * time.Hour)
+	}
+}
+
+// dir is the folder of the objects first logged on day
+func (s *ObjectSink) dir(day time.Time) string {
+	return s.prefix + day.Format("2006/01/02")
+}
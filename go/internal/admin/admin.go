@@ -0,0 +1,151 @@
+// Package admin serves the runtime debug endpoints each binary mounts under /admin/debug/: the
+// log level, which can be changed without a restart, CPU and memory profiles and goroutine
+// dumps, and the configuration the process is running with.
+//
+// Every endpoint needs the admin token. Profiles and configuration are not for just anyone,
+// so a binary without one mounts none of them.
+package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// DebugPrefix is the path the debug endpoints are served under
+const DebugPrefix = "/admin/debug/"
+
+// Config configures the debug endpoints of a binary
+type Config struct {
+	Service string // Name of the binary, shown with its configuration
+	Token   string // Bearer token callers must send; the endpoints aren't mounted without one
+	// Settings returns the binary's own settings, shown at /admin/debug/config alongside its
+	// environment. Secrets must be left out. Optional.
+	Settings func() any
+}
+
+// startedAt is when the process started, near enough
+var startedAt = time.Now()
+
+// Mount adds the debug endpoints to mux, reporting whether it did
+func Mount(mux *http.ServeMux, cfg Config) bool {
+	if cfg.Token == "" {
+		log.Warn().Str("service", cfg.Service).Msg("ADMIN_API_TOKEN not set, runtime debug endpoints are off")
+		return false
+	}
+
+	mux.Handle(DebugPrefix+"loglevel", RequireToken(cfg.Token, http.HandlerFunc(serveLogLevel)))
+	mux.Handle(DebugPrefix+"pprof/", RequireToken(cfg.Token, http.HandlerFunc(serveProfile)))
+	mux.Handle(DebugPrefix+"config", RequireToken(cfg.Token, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serveConfig(w, cfg)
+	})))
+	log.Info().Str("service", cfg.Service).Str("prefix", DebugPrefix).Msg("runtime debug endpoints mounted")
+	return true
+}
+
+// RequireToken wraps h so callers must send token as a bearer token
+func RequireToken(token string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		presented, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// serveLogLevel reports the global log level, and sets it from the level query parameter on
+// PUT or POST, e.g. PUT /admin/debug/loglevel?level=debug. The level lasts until the process
+// restarts.
+func serveLogLevel(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut, http.MethodPost:
+		level, err := zerolog.ParseLevel(r.URL.Query().Get("level"))
+		if err != nil || r.URL.Query().Get("level") == "" {
+			http.Error(w, "level must be one of trace, debug, info, warn, error, fatal, panic or disabled", http.StatusBadRequest)
+			return
+		}
+		previous := zerolog.GlobalLevel()
+		zerolog.SetGlobalLevel(level)
+		// Logged without a level so the change shows whatever the new level is
+		log.Log().Str("from", previous.String()).Str("to", level.String()).Msg("log level changed")
+	default:
+		w.Header().Set("Allow", "GET, PUT, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, map[string]string{"level": zerolog.GlobalLevel().String()})
+}
+
+// configReport is the body served at /admin/debug/config
+type configReport struct {
+	Service    string            `json:"service"`
+	GoVersion  string            `json:"goVersion"`
+	PID        int               `json:"pid"`
+	StartedAt  time.Time         `json:"startedAt"`
+	UptimeSec  float64           `json:"uptimeSec"`
+	Goroutines int               `json:"goroutines"`
+	LogLevel   string            `json:"logLevel"`
+	Settings   any               `json:"settings,omitempty"`
+	Env        map[string]string `json:"env"`
+}
+
+func serveConfig(w http.ResponseWriter, cfg Config) {
+	report := configReport{
+		Service:    cfg.Service,
+		GoVersion:  runtime.Version(),
+		PID:        os.Getpid(),
+		StartedAt:  startedAt,
+		UptimeSec:  time.Since(startedAt).Seconds(),
+		Goroutines: runtime.NumGoroutine(),
+		LogLevel:   zerolog.GlobalLevel().String(),
+		Env:        redactedEnv(os.Environ()),
+	}
+	if cfg.Settings != nil {
+		report.Settings = cfg.Settings()
+	}
+	writeJSON(w, report)
+}
+
+// secretKeyParts mark the environment variables whose values are never shown
+var secretKeyParts = []string{"SECRET", "PASSWORD", "TOKEN", "KEY", "CREDENTIAL", "PRIVATE"}
+
+// redactedEnv returns the environment with secrets redacted: variables named like secrets, and
+// passwords in URLs such as database DSNs
+func redactedEnv(environ []string) map[string]string {
+	env := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		key, value, _ := strings.Cut(kv, "=")
+		upper := strings.ToUpper(key)
+		for _, part := range secretKeyParts {
+			if strings.Contains(upper, part) && value != "" {
+				value = "[redacted]"
+				break
+			}
+		}
+		if u, err := url.Parse(value); err == nil && u.User != nil {
+			if _, hasPassword := u.User.Password(); hasPassword {
+				value = u.Redacted()
+			}
+		}
+		env[key] = value
+	}
+	return env
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Error().Err(err).Msg("failed to write admin response")
+	}
+}
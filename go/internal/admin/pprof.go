@@ -0,0 +1,81 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCPUProfile is the longest CPU profile a caller can ask for
+const maxCPUProfile = 2 * time.Minute
+
+// serveProfile serves the runtime's profiles, readable with go tool pprof:
+//
+//	/admin/debug/pprof/                   lists the profiles
+//	/admin/debug/pprof/profile?seconds=N  profiles the CPU for N seconds, 30 by default
+//	/admin/debug/pprof/<name>?debug=N     writes a profile by name, e.g. heap; goroutine?debug=2
+//	                                      dumps every goroutine's stack as text
+//
+// The profiles are written here rather than through net/http/pprof, which would also serve
+// them unauthenticated on http.DefaultServeMux.
+func serveProfile(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, DebugPrefix+"pprof/")
+	switch name {
+	case "":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintln(w, "profile?seconds=N")
+		for _, p := range pprof.Profiles() {
+			fmt.Fprintf(w, "%s (%d)\n", p.Name(), p.Count())
+		}
+	case "profile":
+		serveCPUProfile(w, r)
+	default:
+		profile := pprof.Lookup(name)
+		if profile == nil {
+			http.Error(w, fmt.Sprintf("unknown profile %q", name), http.StatusNotFound)
+			return
+		}
+		debug, _ := strconv.Atoi(r.URL.Query().Get("debug"))
+		if debug > 0 {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		} else {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+		}
+		if err := profile.WriteTo(w, debug); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// serveCPUProfile profiles the CPU for the seconds asked for. The write deadline is pushed past
+// the profile, since servers' write timeouts are shorter than most profiles.
+func serveCPUProfile(w http.ResponseWriter, r *http.Request) {
+	duration := 30 * time.Second
+	if v := r.URL.Query().Get("seconds"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil || seconds <= 0 {
+			http.Error(w, "seconds must be a positive whole number", http.StatusBadRequest)
+			return
+		}
+		duration = min(time.Duration(seconds)*time.Second, maxCPUProfile)
+	}
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(duration + 10*time.Second))
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="profile"`)
+	if err := pprof.StartCPUProfile(w); err != nil {
+		// Only one CPU profile can run at a time
+		w.Header().Del("Content-Disposition")
+		http.Error(w, fmt.Sprintf("could not start CPU profile: %v", err), http.StatusConflict)
+		return
+	}
+	select {
+	case <-time.After(duration):
+	case <-r.Context().Done():
+	}
+	pprof.StopCPUProfile()
+}
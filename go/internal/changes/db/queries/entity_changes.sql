@@ -0,0 +1,27 @@
+-- name: InsertEntityChange :exec
+-- The league of a team's or roster's change is resolved from the fantasy team, so callers only
+-- name the entity. Nothing is recorded for a team that doesn't exist.
+INSERT INTO entity_change_outbox (id, event_type, entity_id, league_id, action)
+SELECT @id::uuid, @event_type::text, @entity_id::uuid, entity.league_id, @action::text
+FROM (SELECT @entity_id::uuid AS league_id
+      WHERE @event_type::text = 'LeagueUpdated'
+      UNION ALL
+      SELECT ft.league_id
+      FROM fantasy_teams ft
+      WHERE ft.id = @entity_id::uuid
+        AND @event_type::text <> 'LeagueUpdated') AS entity;
+
+-- name: FetchUnsentEntityChanges :many
+SELECT id, event_type, entity_id, league_id, action, created_at
+FROM entity_change_outbox
+WHERE sent_at IS NULL
+ORDER BY created_at, id
+LIMIT $1
+    FOR UPDATE SKIP LOCKED;
+
+-- name: MarkEntityChangeSent :exec
+-- created_at narrows the update to the change's partition
+UPDATE entity_change_outbox
+SET sent_at = NOW()
+WHERE id = $1
+  AND created_at = $2;
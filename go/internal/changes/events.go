@@ -0,0 +1,112 @@
+package changes
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Stream is the JetStream stream entity changes are published to
+const Stream = "ENTITY_CHANGES"
+
+// Event types of entity changes, one for each kind of entity
+const (
+	EventTypeLeagueUpdated = "LeagueUpdated"
+	EventTypeTeamUpdated   = "TeamUpdated"
+	EventTypeRosterChanged = "RosterChanged"
+)
+
+// Action is what happened to an entity. Consumers invalidate on any change, so actions are for
+// logs and for consumers that can skip some of them.
+type Action string
+
+// Actions of LeagueUpdated changes
+const (
+	ActionLeagueCreated    Action = "created"
+	ActionLeagueUpdated    Action = "updated"
+	ActionStatusChanged    Action = "status_changed"
+	ActionSettingsChanged  Action = "settings_changed"
+	ActionLeagueDeleted    Action = "deleted"
+	ActionLeagueRestored   Action = "restored"
+	ActionLeagueArchived   Action = "archived"
+	ActionLeagueUnarchived Action = "unarchived"
+	ActionSeasonStarted    Action = "season_started"
+	ActionSeasonCompleted  Action = "season_completed"
+)
+
+// Actions of TeamUpdated changes
+const (
+	ActionTeamCreated Action = "created"
+	ActionTeamUpdated Action = "updated"
+	ActionTeamDeleted Action = "deleted"
+)
+
+// Actions of RosterChanged changes
+const (
+	ActionPlayerAdded     Action = "player_added"
+	ActionPlayerDropped   Action = "player_dropped"
+	ActionLineupChanged   Action = "lineup_changed"
+	ActionKeeperChanged   Action = "keeper_changed"
+	ActionContractChanged Action = "contract_changed"
+	ActionRosterCleared   Action = "cleared"
+)
+
+// Change is a change to an entity, recorded by a TxInserter in the transaction that made it
+type Change struct {
+	EventType string
+	// EntityID is the league of a LeagueUpdated change, and the fantasy team of a TeamUpdated
+	// or RosterChanged one
+	EntityID uuid.UUID
+	Action   Action
+}
+
+// LeagueUpdated is a change to a league, its settings or its seasons
+func LeagueUpdated(leagueID uuid.UUID, action Action) Change {
+	return Change{EventType: EventTypeLeagueUpdated, EntityID: leagueID, Action: action}
+}
+
+// TeamUpdated is a change to a fantasy team
+func TeamUpdated(fantasyTeamID uuid.UUID, action Action) Change {
+	return Change{EventType: EventTypeTeamUpdated, EntityID: fantasyTeamID, Action: action}
+}
+
+// RosterChanged is a change to a fantasy team's roster or lineup
+func RosterChanged(fantasyTeamID uuid.UUID, action Action) Change {
+	return Change{EventType: EventTypeRosterChanged, EntityID: fantasyTeamID, Action: action}
+}
+
+// ChangeEvent is the message published for a change. It says what changed, not how, so
+// consumers reload the entity rather than apply the change.
+type ChangeEvent struct {
+	ID        string    `json:"id"` // also the Nats-Msg-Id, so a change republished after a failure is dropped
+	Type      string    `json:"type"`
+	LeagueID  string    `json:"league_id"`
+	EntityID  string    `json:"entity_id"`
+	Action    Action    `json:"action"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// subjectTokens are the subject tokens of each event type
+var subjectTokens = map[string]string{
+	EventTypeLeagueUpdated: "league",
+	EventTypeTeamUpdated:   "team",
+	EventTypeRosterChanged: "roster",
+}
+
+// Subject is the subject a change is published on, changes.<league>.<league|team|roster>.<entity>,
+// so consumers can follow one league with changes.<league>.> or one kind of entity with
+// changes.*.roster.*
+func Subject(eventType string, leagueID, entityID uuid.UUID) (string, error) {
+	token, ok := subjectTokens[eventType]
+	if !ok {
+		return "", fmt.Errorf("unknown entity change event type %q", eventType)
+	}
+	return strings.Join([]string{"changes", leagueID.String(), token, entityID.String()}, "."), nil
+}
+
+// StreamSubjects are the subjects of the entity change stream
+func StreamSubjects() []string {
+	return []string{"changes.>"}
+}
@@ -0,0 +1,56 @@
+package changes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mcdev12/dynasty/go/internal/changes/db"
+	"github.com/mcdev12/dynasty/go/internal/ids"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// TxInserter records entity changes in the entity change outbox, in the transaction of the
+// writes they describe, so a change is published if and only if those writes commit.
+// Repositories run their writes in its WithTx and Insert their changes before returning.
+type TxInserter struct {
+	queries *db.Queries
+	tx      sqlutil.Transactor
+}
+
+// NewTxInserter creates the inserter of entity changes
+func NewTxInserter(queries *db.Queries, tx sqlutil.Transactor) *TxInserter {
+	return &TxInserter{
+		queries: queries,
+		tx:      tx,
+	}
+}
+
+// Verify that TxInserter implements the Transactor interface
+var _ sqlutil.Transactor = (*TxInserter)(nil)
+
+// WithTx implements sqlutil.Transactor, running fn in the transaction the changes Inserted
+// with its ctx join
+func (i *TxInserter) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return i.tx.WithTx(ctx, fn)
+}
+
+// Insert records changes in the transaction in ctx, or in one of their own outside of one
+func (i *TxInserter) Insert(ctx context.Context, changes ...Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	return i.tx.WithTx(ctx, func(ctx context.Context) error {
+		q := sqlutil.Bind(ctx, i.queries, i.queries.WithTx)
+		for _, change := range changes {
+			if err := q.InsertEntityChange(ctx, db.InsertEntityChangeParams{
+				ID:        ids.New(),
+				EventType: change.EventType,
+				EntityID:  change.EntityID,
+				Action:    string(change.Action),
+			}); err != nil {
+				return fmt.Errorf("failed to record %s change: %w", change.EventType, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,203 @@
+package changes
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/changes/db"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+)
+
+// PublisherConfig holds configuration for the entity change publisher
+type PublisherConfig struct {
+	NATS            natsconfig.Config
+	PollInterval    time.Duration // How often to drain the entity change outbox
+	BatchSize       int32         // Max changes published per poll
+	Replicas        int           // Replicas of the stream
+	MaxAge          time.Duration // How long the stream keeps changes
+	DuplicateWindow time.Duration // Window in which a republished change is dropped
+	MaxReconnects   int
+	ReconnectWait   time.Duration
+}
+
+// DefaultPublisherConfig returns default entity change publisher configuration
+func DefaultPublisherConfig() PublisherConfig {
+	return PublisherConfig{
+		NATS:            natsconfig.Default(),
+		PollInterval:    time.Second,
+		BatchSize:       200,
+		Replicas:        1,
+		MaxAge:          24 * time.Hour, // consumers only need changes since they last caught up
+		DuplicateWindow: 10 * time.Minute,
+		MaxReconnects:   -1, // Infinite
+		ReconnectWait:   2 * time.Second,
+	}
+}
+
+// Publisher drains the entity change outbox to the ENTITY_CHANGES stream. Changes are claimed
+// with FOR UPDATE SKIP LOCKED, so several publishers can safely poll the same database, and
+// published with their id as Nats-Msg-Id, so one published again after its batch failed to
+// commit is dropped by the stream.
+type Publisher struct {
+	queries *db.Queries
+	sqlDB   *sql.DB
+	nc      *nats.Conn
+	js      jetstream.JetStream
+	config  PublisherConfig
+}
+
+// NewPublisher connects to NATS and creates or updates the entity change stream
+func NewPublisher(sqlDB *sql.DB, config PublisherConfig) (*Publisher, error) {
+	opts := []nats.Option{
+		nats.MaxReconnects(config.MaxReconnects),
+		nats.ReconnectWait(config.ReconnectWait),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			log.Error().Err(err).Msg("NATS disconnected")
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Info().Str("url", nc.ConnectedUrl()).Msg("NATS reconnected")
+		}),
+	}
+
+	nc, err := config.NATS.Connect(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("create JetStream context: %w", err)
+	}
+
+	p := &Publisher{
+		queries: db.New(sqlDB),
+		sqlDB:   sqlDB,
+		nc:      nc,
+		js:      js,
+		config:  config,
+	}
+
+	if err := p.ensureStream(context.Background()); err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("ensure stream: %w", err)
+	}
+
+	return p, nil
+}
+
+// ensureStream creates the entity change stream, or updates it to match the config
+func (p *Publisher) ensureStream(ctx context.Context) error {
+	duplicates := min(p.config.DuplicateWindow, p.config.MaxAge)
+	_, err := p.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:        Stream,
+		Description: "Changes to leagues, fantasy teams and rosters",
+		Subjects:    StreamSubjects(),
+		Retention:   jetstream.LimitsPolicy,
+		MaxAge:      p.config.MaxAge,
+		Storage:     jetstream.FileStorage,
+		Replicas:    p.config.Replicas,
+		Duplicates:  duplicates,
+	})
+	return err
+}
+
+// Start polls the entity change outbox until ctx is cancelled
+func (p *Publisher) Start(ctx context.Context) error {
+	log.Info().
+		Dur("poll_interval", p.config.PollInterval).
+		Str("stream", Stream).
+		Msg("starting entity change publisher")
+
+	ticker := time.NewTicker(p.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("entity change publisher shutting down")
+			return nil
+		case <-ticker.C:
+			published, err := p.PublishBatch(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to publish entity changes")
+				continue
+			}
+			if published > 0 {
+				log.Debug().Int("published", published).Msg("published entity changes")
+			}
+		}
+	}
+}
+
+// PublishBatch publishes a batch of unsent changes, oldest first, and marks them sent
+func (p *Publisher) PublishBatch(ctx context.Context) (int, error) {
+	published := 0
+	err := sqlutil.Run(ctx, p.sqlDB, p.queries.WithTx, func(q *db.Queries) error {
+		published = 0
+		rows, err := q.FetchUnsentEntityChanges(ctx, p.config.BatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to fetch unsent entity changes: %w", err)
+		}
+
+		for _, row := range rows {
+			if err := p.publish(ctx, row); err != nil {
+				return err
+			}
+			if err := q.MarkEntityChangeSent(ctx, db.MarkEntityChangeSentParams{
+				ID:        row.ID,
+				CreatedAt: row.CreatedAt,
+			}); err != nil {
+				return fmt.Errorf("failed to mark entity change sent: %w", err)
+			}
+			published++
+		}
+		return nil
+	})
+	return published, err
+}
+
+// publish sends a change to the stream, waiting for its ack
+func (p *Publisher) publish(ctx context.Context, row db.FetchUnsentEntityChangesRow) error {
+	subject, err := Subject(row.EventType, row.LeagueID, row.EntityID)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(ChangeEvent{
+		ID:        row.ID.String(),
+		Type:      row.EventType,
+		LeagueID:  row.LeagueID.String(),
+		EntityID:  row.EntityID.String(),
+		Action:    Action(row.Action),
+		ChangedAt: row.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s change: %w", row.EventType, err)
+	}
+
+	msg := &nats.Msg{
+		Subject: subject,
+		Data:    data,
+		Header: nats.Header{
+			"Event-Type": []string{row.EventType},
+			"League-ID":  []string{row.LeagueID.String()},
+			"Event-ID":   []string{row.ID.String()},
+		},
+	}
+	if _, err := p.js.PublishMsg(ctx, msg, jetstream.WithMsgID(row.ID.String()), jetstream.WithExpectStream(Stream)); err != nil {
+		return fmt.Errorf("failed to publish %s change: %w", row.EventType, err)
+	}
+	return nil
+}
+
+// Close closes the NATS connection
+func (p *Publisher) Close() {
+	p.nc.Close()
+}
@@ -0,0 +1,60 @@
+// Package clock tells the apps the time. They take a Clock instead of calling time.Now, so
+// time-dependent rules such as scheduled draft times, lineup locks and trade deadlines can be
+// checked against a fixed time: in tests with a fake clock, and across a whole process in the
+// frozen-time mode set by FROZEN_TIME.
+package clock
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+// Clock tells the current time. clockwork's real and fake clocks both satisfy it, as does the
+// orchestrator's timer clock.
+type Clock interface {
+	Now() time.Time
+}
+
+// Real returns the wall clock
+func Real() Clock {
+	return clockwork.NewRealClock()
+}
+
+// Frozen returns a clock stopped at t. It only moves when advanced, for deterministic tests.
+func Frozen(t time.Time) *clockwork.FakeClock {
+	return clockwork.NewFakeClockAt(t)
+}
+
+// FromEnv returns the wall clock, or a clock frozen at FROZEN_TIME (RFC 3339) when it's set,
+// for running a process against a fixed time in tests and staging
+func FromEnv() (Clock, error) {
+	v := os.Getenv("FROZEN_TIME")
+	if v == "" {
+		return Real(), nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("parse FROZEN_TIME: %w", err)
+	}
+	return Frozen(t), nil
+}
+
+// IsFrozen reports whether c is a frozen clock rather than the wall clock
+func IsFrozen(c Clock) bool {
+	_, ok := c.(*clockwork.FakeClock)
+	return ok
+}
+
+// DatabaseNow returns the time to pass to queries that take now: c's time when c is frozen, or
+// null on the wall clock so the query falls back to the database clock, which every instance
+// shares
+func DatabaseNow(c Clock) sql.NullTime {
+	if !IsFrozen(c) {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: c.Now(), Valid: true}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/accesslog"
+	"github.com/mcdev12/dynasty/go/internal/media"
+)
+
+// setupAccessLog picks where the access log of API calls is kept from ACCESS_LOG: unset
+// keeps none, "file" writes a file per hour to ACCESS_LOG_DIR, and "s3" and "gcs" upload
+// batches to ACCESS_LOG_BUCKET under ACCESS_LOG_PREFIX. Entries are removed once older than
+// ACCESS_LOG_RETENTION_DAYS (default 90; 0 keeps them), and ACCESS_LOG_READS=false leaves
+// out calls that only read.
+func setupAccessLog() (*accesslog.Logger, error) {
+	config := accesslog.DefaultConfig()
+	config.Retention = time.Duration(getEnvAsInt("ACCESS_LOG_RETENTION_DAYS", 90)) * 24 * time.Hour
+	config.SkipReads = !getEnvAsBool("ACCESS_LOG_READS", true)
+
+	var sink accesslog.Sink
+	switch storage := getEnv("ACCESS_LOG", ""); storage {
+	case "":
+		return nil, nil
+	case "file":
+		dir := getEnv("ACCESS_LOG_DIR", "access-logs")
+		fileSink, err := accesslog.NewFileSink(dir)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Writing the access log to %s", dir)
+		sink = fileSink
+	case "s3", "gcs":
+		cfg := media.S3Config{
+			Bucket:          getEnv("ACCESS_LOG_BUCKET", ""),
+			AccessKeyID:     getEnv("ACCESS_LOG_ACCESS_KEY_ID", ""),
+			SecretAccessKey: getEnv("ACCESS_LOG_SECRET_ACCESS_KEY", ""),
+		}
+		if storage == "s3" {
+			cfg.Region = getEnv("ACCESS_LOG_REGION", "us-east-1")
+			cfg.Endpoint = getEnv("ACCESS_LOG_ENDPOINT", fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region))
+		} else {
+			cfg.Region = getEnv("ACCESS_LOG_REGION", "auto")
+			cfg.Endpoint = getEnv("ACCESS_LOG_ENDPOINT", "https://storage.googleapis.com")
+		}
+
+		store, err := media.NewS3Store(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid access log bucket: %w", err)
+		}
+		// Each upload is an object, so batch a minute of calls into one
+		config.FlushInterval = getEnvAsDuration("ACCESS_LOG_FLUSH_INTERVAL", time.Minute)
+		source, err := os.Hostname()
+		if err != nil {
+			source = "api"
+		}
+		log.Printf("Uploading the access log to %s bucket %s", storage, cfg.Bucket)
+		sink = accesslog.NewObjectSink(store, getEnv("ACCESS_LOG_PREFIX", "access/"), source)
+	default:
+		return nil, fmt.Errorf("unknown ACCESS_LOG %q, want file, s3 or gcs", storage)
+	}
+
+	return accesslog.NewLogger(sink, config), nil
+}
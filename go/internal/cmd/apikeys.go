@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/roster/v1/rosterv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/transaction/v1/transactionv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/leagues"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// leagueAPIKeyAuthenticator adapts the leagues app to the API key interceptor
+type leagueAPIKeyAuthenticator struct {
+	app *leagues.App
+}
+
+func (a leagueAPIKeyAuthenticator) AuthenticateAPIKey(ctx context.Context, key string) (*interceptors.APIKeyPrincipal, error) {
+	apiKey, err := a.app.AuthenticateAPIKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, leagues.ErrInvalidAPIKey) {
+			return nil, interceptors.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+
+	scopes := make([]string, len(apiKey.Scopes))
+	for i, scope := range apiKey.Scopes {
+		scopes[i] = string(scope)
+	}
+	return &interceptors.APIKeyPrincipal{
+		KeyID:    apiKey.ID,
+		LeagueID: apiKey.LeagueID,
+		Scopes:   scopes,
+	}, nil
+}
+
+// setupAPIKeyInterceptor lets external tools holding a league API key call the RPCs their
+// scopes cover
+func setupAPIKeyInterceptor(app *leagues.App, resolvers map[string]interceptors.LeagueResolver) connect.Interceptor {
+	resultsRead := string(models.APIKeyScopeResultsRead)
+	webhooksManage := string(models.APIKeyScopeWebhooksManage)
+	scopes := map[string]string{
+		// Drafts and their results
+		draftv1connect.DraftServiceGetDraftProcedure:                      resultsRead,
+		draftv1connect.DraftServiceGetDraftSummaryProcedure:               resultsRead,
+		draftv1connect.DraftPickServiceGetDraftPickProcedure:              resultsRead,
+		draftv1connect.DraftPickServiceGetDraftPicksByDraftProcedure:      resultsRead,
+		draftv1connect.DraftPickServiceGetDraftPicksByRoundProcedure:      resultsRead,
+		draftv1connect.DraftPickServiceGetNextPickForDraftProcedure:       resultsRead,
+		draftv1connect.DraftPickServiceCountRemainingPicksProcedure:       resultsRead,
+		draftv1connect.DraftPickServiceExportDraftResultsProcedure:        resultsRead,
+		draftv1connect.DraftPickServiceListPickReactionsProcedure:         resultsRead,
+		draftv1connect.DraftSlotSelectionServiceGetSlotSelectionProcedure: resultsRead,
+		draftv1connect.DraftAuctionServiceGetAuctionProcedure:             resultsRead,
+		draftv1connect.DraftAuctionServiceGetAuctionHistoryProcedure:      resultsRead,
+		draftv1connect.DraftRecapServiceGetDraftRecapProcedure:            resultsRead,
+		draftv1connect.DraftRecapServiceGetLeagueDraftSummaryProcedure:    resultsRead,
+
+		// Rosters
+		rosterv1connect.RosterServiceGetRosterProcedure:                                resultsRead,
+		rosterv1connect.RosterServiceGetRosterPlayersByFantasyTeamProcedure:            resultsRead,
+		rosterv1connect.RosterServiceGetRosterPlayersByFantasyTeamAndPositionProcedure: resultsRead,
+		rosterv1connect.RosterServiceGetPlayerOnRosterProcedure:                        resultsRead,
+		rosterv1connect.RosterServiceGetStartingRosterPlayersProcedure:                 resultsRead,
+		rosterv1connect.RosterServiceGetBenchRosterPlayersProcedure:                    resultsRead,
+		rosterv1connect.RosterServiceGetRosterPlayersByAcquisitionTypeProcedure:        resultsRead,
+		rosterv1connect.RosterServiceGetLineupSlotsProcedure:                           resultsRead,
+		rosterv1connect.RosterServiceListTaxiSquadViolationsProcedure:                  resultsRead,
+
+		// League history
+		leaguev1connect.LeagueServiceGetSettingsHistoryProcedure:               resultsRead,
+		leaguev1connect.LeagueServiceListLeagueSeasonsProcedure:                resultsRead,
+		transactionv1connect.TransactionServiceListLeagueTransactionsProcedure: resultsRead,
+
+		// Draft webhooks
+		draftv1connect.DraftServiceCreateDraftWebhookProcedure: webhooksManage,
+		draftv1connect.DraftServiceListDraftWebhooksProcedure:  webhooksManage,
+		draftv1connect.DraftServiceDeleteDraftWebhookProcedure: webhooksManage,
+	}
+
+	return interceptors.NewAPIKeyInterceptor(interceptors.APIKeyConfig{
+		Authenticator: leagueAPIKeyAuthenticator{app: app},
+		Scopes:        scopes,
+		Resolvers:     resolvers,
+	})
+}
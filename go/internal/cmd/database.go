@@ -4,17 +4,23 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
-
-	_ "github.com/lib/pq"
+	"time"
 
 	"github.com/mcdev12/dynasty/go/internal/dbconfig"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/webview/v1/webviewv1connect"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
 )
 
 func setupDatabase() (*sql.DB, error) {
 	cfg := dbconfig.NewConfigFromEnv()
 	dsn := cfg.DSN()
 
-	database, err := sql.Open("postgres", dsn)
+	// Time every query and log slow ones; see /debug/vars for the per-query histograms
+	database, err := sqlutil.OpenObserved(dsn, sqlutil.QueryObserverConfig{
+		SlowQueryThreshold: cfg.SlowQueryThreshold,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -26,3 +32,44 @@ func setupDatabase() (*sql.DB, error) {
 		cfg.User, cfg.Host, cfg.Port, cfg.Database)
 	return database, nil
 }
+
+// replicaReadStaleness maps the heavy read RPCs that may run on the read replica to how far
+// behind the primary the replica may be for them. Writes, deadline RPCs and every other read
+// stay on the primary.
+func replicaReadStaleness() map[string]time.Duration {
+	return map[string]time.Duration{
+		// The board refreshes on every pick event anyway
+		draftv1connect.DraftPickServiceListAvailablePlayersForDraftProcedure: time.Second,
+		draftv1connect.DraftPickServiceGetDraftPicksByDraftProcedure:         time.Second,
+		webviewv1connect.WebViewServiceGetDraftRoomProcedure:                 time.Second,
+		// Public pages are cached for longer than this already
+		leaguev1connect.PublicLeagueServiceGetPublicStandingsProcedure:    30 * time.Second,
+		leaguev1connect.PublicLeagueServiceGetPublicDraftResultsProcedure: 30 * time.Second,
+		leaguev1connect.PublicLeagueServiceGetPublicRostersProcedure:      30 * time.Second,
+	}
+}
+
+// setupReadReplica connects to the read replica when DB_REPLICA_HOST names one, and returns
+// nil otherwise, leaving every query on the primary
+func setupReadReplica() (*sqlutil.ReadReplica, error) {
+	cfg := dbconfig.NewConfigFromEnv()
+	dsn := cfg.ReplicaDSN()
+	if dsn == "" {
+		return nil, nil
+	}
+
+	database, err := sqlutil.OpenObserved(dsn, sqlutil.QueryObserverConfig{
+		SlowQueryThreshold: cfg.SlowQueryThreshold,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open read replica: %w", err)
+	}
+	if err := database.Ping(); err != nil {
+		database.Close()
+		return nil, fmt.Errorf("failed to ping read replica: %w", err)
+	}
+
+	log.Printf("Connected to read replica: %s@%s:%d/%s",
+		cfg.User, cfg.ReplicaHost, cfg.ReplicaPort, cfg.Database)
+	return sqlutil.NewReadReplica(database, getEnvAsDuration("DB_REPLICA_LAG_CHECK_INTERVAL", time.Second)), nil
+}
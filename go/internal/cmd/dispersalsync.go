@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mcdev12/dynasty/go/internal/draft/dispersal"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+)
+
+// setupDispersalSync creates the consumer that completes dispersals as their drafts complete
+func setupDispersalSync(services *Services) (*dispersal.Consumer, error) {
+	config := dispersal.DefaultConfig()
+	config.NATS = natsconfig.NewConfigFromEnv()
+
+	consumer, err := dispersal.NewConsumer(services.DraftDispersal, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dispersal completion consumer: %w", err)
+	}
+
+	return consumer, nil
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mcdev12/dynasty/go/internal/draft/analytics"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+)
+
+// setupDraftAnalytics creates the consumer that analyzes draft boards as picks are made
+func setupDraftAnalytics(services *Services) (*analytics.Consumer, error) {
+	config := analytics.DefaultConfig()
+	config.NATS = natsconfig.NewConfigFromEnv()
+
+	consumer, err := analytics.NewConsumer(services.DraftAnalytics, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create draft analytics consumer: %w", err)
+	}
+
+	return consumer, nil
+}
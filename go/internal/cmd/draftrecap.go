@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mcdev12/dynasty/go/internal/draft/recap"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+)
+
+// setupDraftRecap creates the consumer that generates drafts' recaps as they complete
+func setupDraftRecap(services *Services) (*recap.Consumer, error) {
+	config := recap.DefaultConfig()
+	config.NATS = natsconfig.NewConfigFromEnv()
+
+	consumer, err := recap.NewConsumer(services.DraftRecap, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create draft recap consumer: %w", err)
+	}
+
+	return consumer, nil
+}
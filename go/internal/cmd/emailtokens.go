@@ -0,0 +1,19 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+// emailTokenSecret returns the secret verification and password reset tokens are sealed with
+// in the user outbox. The notification worker must be given the same EMAIL_TOKEN_SECRET.
+func emailTokenSecret() []byte {
+	secret := os.Getenv(events.EmailTokenSecretEnv)
+	if secret == "" {
+		log.Printf("%s not set, verification and password reset emails can't be sent", events.EmailTokenSecretEnv)
+		return nil
+	}
+	return []byte(secret)
+}
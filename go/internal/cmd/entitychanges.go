@@ -0,0 +1,22 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/mcdev12/dynasty/go/internal/changes"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+)
+
+// setupEntityChanges creates the publisher that sends league, team and roster changes to NATS
+func setupEntityChanges(database *sql.DB) (*changes.Publisher, error) {
+	config := changes.DefaultPublisherConfig()
+	config.NATS = natsconfig.NewConfigFromEnv()
+
+	publisher, err := changes.NewPublisher(database, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create entity change publisher: %w", err)
+	}
+
+	return publisher, nil
+}
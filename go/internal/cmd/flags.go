@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/flags"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+)
+
+// flagsLoadTimeout is how long startup waits for the feature flags to load
+const flagsLoadTimeout = 10 * time.Second
+
+// setupFeatureFlags loads the feature flags from the FEATURE_FLAGS_BUCKET KV bucket when
+// FEATURE_FLAGS_ENABLED is set, and watches it for changes. Without them every flag is off.
+func setupFeatureFlags(ctx context.Context) (*flags.Client, error) {
+	if !getEnvAsBool("FEATURE_FLAGS_ENABLED", false) {
+		log.Printf("FEATURE_FLAGS_ENABLED not set, every feature flag is off")
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, flagsLoadTimeout)
+	defer cancel()
+
+	bucket := getEnv("FEATURE_FLAGS_BUCKET", flags.DefaultBucket)
+	client, err := flags.Dial(ctx, natsconfig.NewConfigFromEnv(), bucket)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Watching feature flags in %s", bucket)
+	return client, nil
+}
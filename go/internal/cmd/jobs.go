@@ -0,0 +1,82 @@
+package main
+
+import (
+	"database/sql"
+
+	draftdraft "github.com/mcdev12/dynasty/go/internal/draft/draft"
+	"github.com/mcdev12/dynasty/go/internal/draft/mockdraft"
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+	"github.com/mcdev12/dynasty/go/internal/leagues"
+	"github.com/mcdev12/dynasty/go/internal/partitions"
+	partitionsdb "github.com/mcdev12/dynasty/go/internal/partitions/db"
+	"github.com/mcdev12/dynasty/go/internal/player"
+	"github.com/mcdev12/dynasty/go/internal/roster"
+	"github.com/mcdev12/dynasty/go/internal/schedule"
+	"github.com/mcdev12/dynasty/go/internal/transactions"
+)
+
+// setupJobWorker creates the worker that runs background jobs and schedules the recurring ones
+func setupJobWorker(database *sql.DB, services *Services) *jobs.Worker {
+	worker := jobs.NewWorker(database, services.Jobs, jobs.DefaultWorkerConfig())
+
+	// Flag taxi squads that break their league's rules every night
+	if getEnvAsBool("TAXI_SQUAD_RUNNER_ENABLED", true) {
+		roster.ScheduleTaxiSquadCheck(worker, services.RosterApp, roster.DefaultTaxiSquadRunnerConfig(), services.Clock)
+	}
+
+	// Alert owners to lineup problems an hour before lineups lock
+	if getEnvAsBool("LINEUP_CHECK_ENABLED", true) {
+		roster.ScheduleLineupCheck(worker, services.RosterApp, roster.DefaultLineupCheckRunnerConfig(), services.Clock)
+	}
+
+	// Recompute platform-wide player ownership every night
+	if getEnvAsBool("PLAYER_OWNERSHIP_REFRESH_ENABLED", true) {
+		player.ScheduleOwnershipRefresh(worker, services.PlayerApp, player.DefaultOwnershipRunnerConfig())
+	}
+
+	// Merge duplicate player records every night, queueing the ambiguous ones for review
+	if getEnvAsBool("PLAYER_DEDUPE_ENABLED", true) {
+		player.ScheduleDuplicateDetection(worker, services.PlayerApp, player.DefaultDedupeRunnerConfig())
+	}
+
+	// Count down to drafts' scheduled starts
+	if getEnvAsBool("DRAFT_COUNTDOWN_ENABLED", true) {
+		draftdraft.ScheduleStartCountdown(worker, services.DraftService, services.Clock)
+	}
+
+	// Pause drafts over maintenance windows and resume them after
+	if getEnvAsBool("MAINTENANCE_RUNNER_ENABLED", true) {
+		draftdraft.ScheduleMaintenance(worker, services.DraftService, services.Clock)
+	}
+
+	// Send users daily and weekly digests of the activity in their leagues
+	if getEnvAsBool("DIGESTS_ENABLED", true) {
+		transactions.ScheduleDigests(worker, services.TransactionsApp, transactions.DefaultDigestRunnerConfig())
+	}
+
+	// Recompute league standings from the week's matchup results
+	if getEnvAsBool("STANDINGS_RECOMPUTE_ENABLED", true) {
+		schedule.ScheduleStandingsRecompute(worker, services.ScheduleApp, schedule.DefaultStandingsRunnerConfig())
+	}
+
+	// Warn the commissioners of leagues inactive for a season, then archive the leagues
+	if getEnvAsBool("LEAGUE_ARCHIVAL_ENABLED", true) {
+		leagues.ScheduleLeagueArchival(worker, services.LeagueApp, leagues.DefaultArchivalRunnerConfig())
+	}
+
+	// Seat users queued for mock drafts in lobbies, filling empty seats with bots, and tear down finished lobbies
+	if getEnvAsBool("MOCK_DRAFT_MATCHMAKING_ENABLED", true) {
+		mockdraft.ScheduleMatchmaking(worker, services.MockDraftMatchmaker)
+	}
+
+	// Create the outbox tables' monthly partitions ahead of time and retire the ones past retention
+	if getEnvAsBool("PARTITION_MAINTENANCE_ENABLED", true) {
+		config := partitions.DefaultRunnerConfig()
+		config.Policy.RetentionMonths = getEnvAsInt("OUTBOX_RETENTION_MONTHS", config.Policy.RetentionMonths)
+		config.Policy.DropRetired = getEnvAsBool("OUTBOX_DROP_RETIRED_PARTITIONS", config.Policy.DropRetired)
+		partitionApp := partitions.NewApp(partitions.NewRepository(partitionsdb.New(database)))
+		partitions.ScheduleMaintenance(worker, partitionApp, config)
+	}
+
+	return worker
+}
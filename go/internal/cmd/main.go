@@ -8,7 +8,13 @@ import (
 	"syscall"
 
 	"github.com/joho/godotenv"
+	"github.com/mcdev12/dynasty/go/internal/clock"
+	"github.com/mcdev12/dynasty/go/internal/draft/auction"
+	"github.com/mcdev12/dynasty/go/internal/draft/slotselection"
+	"github.com/mcdev12/dynasty/go/internal/draft/streammonitor"
+	"github.com/mcdev12/dynasty/go/internal/ids"
 	_ "github.com/mcdev12/dynasty/go/internal/sports/nfl"
+	"github.com/mcdev12/dynasty/go/internal/transactions"
 	"github.com/rs/zerolog/log"
 )
 
@@ -28,6 +34,13 @@ func main() {
 			Msg("Could not load .env file; proceeding with existing environment")
 	}
 
+	// Choose the UUID version of new ids; v4 remains available while databases are moved over
+	if err := ids.Configure(getEnv("ID_VERSION", "v7")); err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Invalid ID_VERSION")
+	}
+
 	// Load application config
 	config, err := loadConfig("config.yaml")
 	if err != nil {
@@ -53,14 +66,308 @@ func main() {
 	}
 	defer database.Close()
 
+	// Optionally send heavy reads that can be a little stale to a read replica
+	replica, err := setupReadReplica()
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to setup read replica")
+	}
+	if replica != nil {
+		defer replica.DB().Close()
+		go replica.Run(ctx)
+	}
+
+	// Setup where uploaded logos and avatars are kept
+	mediaStore, err := setupMediaStore()
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to setup media store")
+	}
+
+	// Setup feature flags, rolling new behaviour out per league
+	featureFlags, err := setupFeatureFlags(ctx)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to setup feature flags")
+	}
+	defer featureFlags.Close()
+
+	// Setup rate limiting, shared between replicas through Redis when configured
+	limiter, closeLimiter, err := setupRateLimiter(ctx)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to setup rate limiter")
+	}
+	defer closeLimiter()
+
+	// Setup the clock the apps check times against, frozen when FROZEN_TIME is set
+	appClock, err := clock.FromEnv()
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to setup clock")
+	}
+	if clock.IsFrozen(appClock) {
+		log.Warn().Time("now", appClock.Now()).Msg("Clock frozen, apps check times against FROZEN_TIME")
+	}
+
 	// Setup services
-	services := setupServices(database, plugins)
+	services := setupServices(database, replica, plugins, mediaStore, featureFlags, limiter, appClock)
 
 	// NOTE: Draft orchestrator now runs as a separate binary
 	// See go/internal/draft/orchestrator/cmd/main.go
 
+	// Optionally keep rosters in sync with picks as they are made
+	if getEnvAsBool("ROSTER_SYNC_ENABLED", false) {
+		rosterSync, err := setupRosterSync(services)
+		if err != nil {
+			log.Fatal().
+				Err(err).
+				Msg("Failed to setup roster sync consumer")
+		}
+		defer rosterSync.Close()
+
+		go func() {
+			if err := rosterSync.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Roster sync consumer stopped")
+			}
+		}()
+	}
+
+	// Optionally release folded teams' undrafted players and share out their picks as their
+	// dispersal drafts complete
+	if getEnvAsBool("DISPERSAL_SYNC_ENABLED", false) {
+		dispersalSync, err := setupDispersalSync(services)
+		if err != nil {
+			log.Fatal().
+				Err(err).
+				Msg("Failed to setup dispersal completion consumer")
+		}
+		defer dispersalSync.Close()
+
+		go func() {
+			if err := dispersalSync.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Dispersal completion consumer stopped")
+			}
+		}()
+	}
+
+	// Optionally generate drafts' recaps and send them to league members as drafts complete
+	if getEnvAsBool("DRAFT_RECAP_ENABLED", false) {
+		draftRecap, err := setupDraftRecap(services)
+		if err != nil {
+			log.Fatal().
+				Err(err).
+				Msg("Failed to setup draft recap consumer")
+		}
+		defer draftRecap.Close()
+
+		go func() {
+			if err := draftRecap.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Draft recap consumer stopped")
+			}
+		}()
+	}
+
+	// Optionally push heatmaps, positional scarcity and run alerts to draft rooms as picks are made
+	if getEnvAsBool("DRAFT_ANALYTICS_ENABLED", false) {
+		draftAnalytics, err := setupDraftAnalytics(services)
+		if err != nil {
+			log.Fatal().
+				Err(err).
+				Msg("Failed to setup draft analytics consumer")
+		}
+		defer draftAnalytics.Close()
+
+		go func() {
+			if err := draftAnalytics.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Draft analytics consumer stopped")
+			}
+		}()
+	}
+
+	// Optionally post pick events to the webhooks commissioners register for their drafts
+	if getEnvAsBool("DRAFT_WEBHOOKS_ENABLED", false) {
+		webhookConsumer, webhookDispatcher, err := setupDraftWebhooks(database)
+		if err != nil {
+			log.Fatal().
+				Err(err).
+				Msg("Failed to setup draft webhooks")
+		}
+		defer webhookConsumer.Close()
+
+		go func() {
+			if err := webhookConsumer.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Draft webhook consumer stopped")
+			}
+		}()
+		go func() {
+			if err := webhookDispatcher.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				log.Error().
+					Err(err).
+					Msg("Draft webhook dispatcher stopped")
+			}
+		}()
+	}
+
+	// Auto-assign draft slots when a team's slot selection turn runs out
+	if getEnvAsBool("SLOT_SELECTION_RUNNER_ENABLED", true) {
+		slotSelectionRunner := slotselection.NewRunner(services.DraftSlotSelection, slotselection.DefaultRunnerConfig())
+		go func() {
+			if err := slotSelectionRunner.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Slot selection runner stopped")
+			}
+		}()
+	}
+
+	// Keep auction drafts moving when a nomination turn or a player's bidding runs out
+	if getEnvAsBool("AUCTION_RUNNER_ENABLED", true) {
+		auctionRunner := auction.NewRunner(services.DraftAuction, auction.DefaultRunnerConfig())
+		go func() {
+			if err := auctionRunner.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Auction runner stopped")
+			}
+		}()
+	}
+
+	// Project roster changes into the league transaction log
+	if getEnvAsBool("TRANSACTION_LOG_RUNNER_ENABLED", true) {
+		transactionLogRunner := transactions.NewRunner(services.TransactionsApp, transactions.DefaultRunnerConfig())
+		go func() {
+			if err := transactionLogRunner.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Transaction log runner stopped")
+			}
+		}()
+	}
+
+	// Optionally publish changes to leagues, teams and rosters for caches and search indexes
+	if getEnvAsBool("ENTITY_CHANGES_PUBLISHER_ENABLED", false) {
+		entityChanges, err := setupEntityChanges(database)
+		if err != nil {
+			log.Fatal().
+				Err(err).
+				Msg("Failed to setup entity change publisher")
+		}
+		defer entityChanges.Close()
+
+		go func() {
+			if err := entityChanges.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Entity change publisher stopped")
+			}
+		}()
+	}
+
+	// Run background jobs queued in Postgres, including the recurring ones
+	if getEnvAsBool("JOB_WORKER_ENABLED", true) {
+		jobWorker := setupJobWorker(database, services)
+		go func() {
+			if err := jobWorker.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				log.Error().
+					Err(err).
+					Msg("Job worker stopped")
+			}
+		}()
+	}
+
+	// Optionally record drafted players and traded picks in the league transaction log
+	if getEnvAsBool("TRANSACTION_LOG_CONSUMER_ENABLED", false) {
+		transactionLog, err := setupTransactionLog(services)
+		if err != nil {
+			log.Fatal().
+				Err(err).
+				Msg("Failed to setup transaction log consumer")
+		}
+		defer transactionLog.Close()
+
+		go func() {
+			if err := transactionLog.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Transaction log consumer stopped")
+			}
+		}()
+	}
+
+	// Optionally record the final scores of matchups from the live scoring stream
+	if getEnvAsBool("MATCHUP_RESULTS_CONSUMER_ENABLED", false) {
+		matchupResults, err := setupMatchupResults(services)
+		if err != nil {
+			log.Fatal().
+				Err(err).
+				Msg("Failed to setup matchup result consumer")
+		}
+		defer matchupResults.Close()
+
+		go func() {
+			if err := matchupResults.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Matchup result consumer stopped")
+			}
+		}()
+	}
+
+	// Optionally watch how far the draft event consumers are behind, alerting while drafts are live
+	var streamMonitor *streammonitor.Monitor
+	if getEnvAsBool("STREAM_MONITOR_ENABLED", false) {
+		streamMonitor, err = setupStreamMonitor(database)
+		if err != nil {
+			log.Fatal().
+				Err(err).
+				Msg("Failed to setup stream monitor")
+		}
+		defer streamMonitor.Close()
+
+		go func() {
+			if err := streamMonitor.Start(ctx); err != nil {
+				log.Error().
+					Err(err).
+					Msg("Stream monitor stopped")
+			}
+		}()
+	}
+
+	// Optionally keep an access log of who called what, apart from these logs
+	accessLog, err := setupAccessLog()
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to setup access log")
+	}
+	if accessLog != nil {
+		// Not ctx, so what is logged while shutting down is still written
+		go accessLog.Start(context.Background())
+	}
+
 	// Setup HTTP/gRPC server
-	server := setupServer(services)
+	server, err := setupServer(services, limiter, streamMonitor, accessLog)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to setup server")
+	}
 
 	// Start server in goroutine
 	go func() {
@@ -84,5 +391,8 @@ func main() {
 			Err(err).
 			Msg("Server shutdown failed")
 	}
+	if accessLog != nil {
+		accessLog.Close()
+	}
 	log.Info().Msg("Server shutdown complete")
 }
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/mcdev12/dynasty/go/internal/admin"
+	draftdraft "github.com/mcdev12/dynasty/go/internal/draft/draft"
+)
+
+// mountMaintenance serves the maintenance windows at /admin/maintenance, where operators
+// schedule and cancel them ahead of a deploy. It changes what drafts may do, so unlike the
+// read-only admin endpoints it is only mounted when ADMIN_API_TOKEN is set, and that token
+// must be sent as a bearer token.
+func mountMaintenance(mux *http.ServeMux, service *draftdraft.Service) {
+	token := os.Getenv("ADMIN_API_TOKEN")
+	if token == "" {
+		log.Printf("ADMIN_API_TOKEN not set, maintenance windows can't be scheduled")
+		return
+	}
+
+	mux.Handle("/admin/maintenance", admin.RequireToken(token, service.MaintenanceHandler()))
+}
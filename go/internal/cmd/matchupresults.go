@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/mcdev12/dynasty/go/internal/schedule/scorefeed"
+)
+
+// setupMatchupResults creates the consumer that records the final scores of matchups
+func setupMatchupResults(services *Services) (*scorefeed.Consumer, error) {
+	config := scorefeed.DefaultConfig()
+	config.NATS = natsconfig.NewConfigFromEnv()
+
+	consumer, err := scorefeed.NewConsumer(services.ScheduleApp, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create matchup result consumer: %w", err)
+	}
+
+	return consumer, nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mcdev12/dynasty/go/internal/media"
+)
+
+// setupMediaStore picks where uploaded logos and avatars are kept from MEDIA_STORAGE:
+// "local" (the default) keeps them on disk and serves them from this server, "s3" and "gcs"
+// keep them in a bucket. Buckets are read through MEDIA_PUBLIC_BASE_URL when set, e.g. a CDN.
+func setupMediaStore() (media.Store, error) {
+	switch storage := getEnv("MEDIA_STORAGE", "local"); storage {
+	case "local":
+		dir := getEnv("MEDIA_LOCAL_DIR", "media")
+		baseURL := getEnv("MEDIA_PUBLIC_BASE_URL", fmt.Sprintf("http://localhost:%s/media", getEnv("PORT", "8080")))
+		log.Printf("Keeping uploaded media in %s", dir)
+		return media.NewLocalStore(dir, baseURL), nil
+	case "s3", "gcs":
+		cfg := media.S3Config{
+			Bucket:          getEnv("MEDIA_BUCKET", ""),
+			AccessKeyID:     getEnv("MEDIA_ACCESS_KEY_ID", ""),
+			SecretAccessKey: getEnv("MEDIA_SECRET_ACCESS_KEY", ""),
+			PublicBaseURL:   getEnv("MEDIA_PUBLIC_BASE_URL", ""),
+		}
+		if storage == "s3" {
+			cfg.Region = getEnv("MEDIA_REGION", "us-east-1")
+			cfg.Endpoint = getEnv("MEDIA_ENDPOINT", fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region))
+		} else {
+			// Google Cloud Storage through its S3-compatible XML API, signed with an HMAC key
+			cfg.Region = getEnv("MEDIA_REGION", "auto")
+			cfg.Endpoint = getEnv("MEDIA_ENDPOINT", "https://storage.googleapis.com")
+		}
+
+		store, err := media.NewS3Store(cfg)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Keeping uploaded media in %s bucket %s", storage, cfg.Bucket)
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown MEDIA_STORAGE %q, want local, s3 or gcs", storage)
+	}
+}
+
+// mountMediaFiles serves a local media store's files at the path of its public base URL.
+// Bucket stores are served by the bucket or its CDN.
+func mountMediaFiles(mux *http.ServeMux, store media.Store) {
+	local, ok := store.(*media.LocalStore)
+	if !ok {
+		return
+	}
+
+	base, err := url.Parse(local.URL(""))
+	if err != nil {
+		log.Printf("Not serving uploaded media: %v", err)
+		return
+	}
+	path := strings.Trim(base.Path, "/")
+	if path == "" {
+		log.Printf("Not serving uploaded media: MEDIA_PUBLIC_BASE_URL needs a path, e.g. /media")
+		return
+	}
+	mux.Handle("/"+path+"/", http.StripPrefix("/"+path, local.Handler()))
+}
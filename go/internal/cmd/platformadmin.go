@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/accesslog"
+	"github.com/mcdev12/dynasty/go/internal/genproto/admin/v1/adminv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/platformadmin"
+	"github.com/mcdev12/dynasty/go/internal/users"
+)
+
+// userImpersonator adapts the users app to the platform admin app
+type userImpersonator struct {
+	app *users.App
+}
+
+func (i userImpersonator) Impersonate(ctx context.Context, userID uuid.UUID, operator string) (*platformadmin.Impersonation, error) {
+	issued, err := i.app.Impersonate(ctx, userID, operator)
+	if err != nil {
+		return nil, err
+	}
+	return &platformadmin.Impersonation{
+		SessionID:       issued.Session.ID,
+		AccessToken:     issued.AccessToken,
+		AccessExpiresAt: issued.AccessExpiresAt,
+	}, nil
+}
+
+func (i userImpersonator) EndImpersonation(ctx context.Context, userID, sessionID uuid.UUID) error {
+	return i.app.RevokeSession(ctx, userID, sessionID)
+}
+
+// userRestorer adapts the users app to the platform admin app
+type userRestorer struct {
+	app *users.App
+}
+
+func (r userRestorer) RestoreUser(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.app.RestoreUser(ctx, userID)
+	return err
+}
+
+// adminOperators reads the platform operators from ADMIN_OPERATOR_TOKENS, comma separated
+// name:token pairs, e.g. "alice:s3cret,bob:0th3r"
+func adminOperators() (map[string]string, error) {
+	operators := make(map[string]string)
+	for i, pair := range strings.Split(os.Getenv("ADMIN_OPERATOR_TOKENS"), ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, token, ok := strings.Cut(pair, ":")
+		name, token = strings.TrimSpace(name), strings.TrimSpace(token)
+		if !ok || name == "" || token == "" {
+			return nil, fmt.Errorf("ADMIN_OPERATOR_TOKENS entry %d is not name:token", i+1)
+		}
+		if _, exists := operators[name]; exists {
+			return nil, fmt.Errorf("ADMIN_OPERATOR_TOKENS names operator %q twice", name)
+		}
+		operators[name] = token
+	}
+	return operators, nil
+}
+
+// mountPlatformAdmin serves the admin service to platform operators. It is a separate auth
+// realm: operators sign in with their own token from ADMIN_OPERATOR_TOKENS rather than a user
+// session, and the session, API key and tenancy interceptors don't run. Without operators
+// the service isn't mounted.
+func mountPlatformAdmin(mux *http.ServeMux, service *platformadmin.Service, validationInterceptor connect.Interceptor, accessLog *accesslog.Logger) error {
+	operators, err := adminOperators()
+	if err != nil {
+		return err
+	}
+	if len(operators) == 0 {
+		log.Printf("ADMIN_OPERATOR_TOKENS not set, the admin service is not mounted")
+		return nil
+	}
+
+	adminAuthInterceptor := interceptors.NewAdminAuthInterceptor(interceptors.AdminAuthConfig{
+		Operators: operators,
+	})
+	opts := connect.WithInterceptors(validationInterceptor, adminAuthInterceptor)
+	if accessLog != nil {
+		opts = connect.WithInterceptors(accesslog.NewInterceptor(accessLog), validationInterceptor, adminAuthInterceptor, accesslog.NewIdentityInterceptor())
+	}
+
+	path, handler := adminv1connect.NewAdminServiceHandler(service, opts)
+	mux.Handle(path, handler)
+	log.Printf("Admin service mounted for %d operators", len(operators))
+	return nil
+}
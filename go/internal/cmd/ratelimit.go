@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/user/v1/userv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/ratelimit"
+	"github.com/mcdev12/dynasty/go/internal/redisconfig"
+)
+
+// setupRateLimiter counts requests in Redis when REDIS_URL is set, so every replica
+// enforces the same limits, and in memory otherwise
+func setupRateLimiter(ctx context.Context) (ratelimit.Limiter, func(), error) {
+	cfg := redisconfig.NewConfigFromEnv()
+	if !cfg.Enabled() {
+		log.Printf("REDIS_URL not set, rate limits are counted per replica")
+		return ratelimit.NewMemoryLimiter(), func() {}, nil
+	}
+
+	client, err := cfg.Connect(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Printf("Counting rate limits in Redis")
+	return ratelimit.NewRedisLimiter(client, cfg.KeyPrefix), func() { client.Close() }, nil
+}
+
+// setupRateLimitInterceptor slows down password guessing and account and email spam from a
+// single address. Limits apply per client IP.
+func setupRateLimitInterceptor(limiter ratelimit.Limiter) connect.Interceptor {
+	rules := map[string]ratelimit.Rule{
+		userv1connect.UserServiceLoginProcedure:                 {Limit: 10, Window: time.Minute},
+		userv1connect.UserServiceRefreshSessionProcedure:        {Limit: 30, Window: time.Minute},
+		userv1connect.UserServiceCreateUserProcedure:            {Limit: 5, Window: time.Hour},
+		userv1connect.UserServiceRequestPasswordResetProcedure:  {Limit: 5, Window: time.Hour},
+		userv1connect.UserServiceResetPasswordProcedure:         {Limit: 10, Window: time.Hour},
+		userv1connect.UserServiceSendVerificationEmailProcedure: {Limit: 5, Window: time.Hour},
+		userv1connect.UserServiceVerifyEmailProcedure:           {Limit: 10, Window: time.Hour},
+	}
+
+	return interceptors.NewRateLimitInterceptor(interceptors.RateLimitConfig{
+		Limiter: limiter,
+		Rules:   rules,
+	})
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/mcdev12/dynasty/go/internal/roster/draftsync"
+)
+
+// setupRosterSync creates the consumer that applies PickMade events to rosters
+func setupRosterSync(services *Services) (*draftsync.Consumer, error) {
+	config := draftsync.DefaultConfig()
+	config.NATS = natsconfig.NewConfigFromEnv()
+
+	consumer, err := draftsync.NewConsumer(services.RosterApp, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create roster sync consumer: %w", err)
+	}
+
+	return consumer, nil
+}
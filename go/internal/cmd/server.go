@@ -1,29 +1,88 @@
 package main
 
 import (
+	"expvar"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 
+	"connectrpc.com/connect"
 	"connectrpc.com/grpcreflect"
 
+	"github.com/mcdev12/dynasty/go/internal/accesslog"
+	"github.com/mcdev12/dynasty/go/internal/admin"
+	"github.com/mcdev12/dynasty/go/internal/compression"
+	"github.com/mcdev12/dynasty/go/internal/draft/gateway"
+	"github.com/mcdev12/dynasty/go/internal/draft/streammonitor"
+	"github.com/mcdev12/dynasty/go/internal/etag"
+	"github.com/mcdev12/dynasty/go/internal/genproto/admin/v1/adminv1connect"
 	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
 	"github.com/mcdev12/dynasty/go/internal/genproto/fantasyteam/v1/fantasyteamv1connect"
 	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/leaguechat/v1/leaguechatv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/media/v1/mediav1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/news/v1/newsv1connect"
 	"github.com/mcdev12/dynasty/go/internal/genproto/player/v1/playerv1connect"
 	"github.com/mcdev12/dynasty/go/internal/genproto/roster/v1/rosterv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/schedule/v1/schedulev1connect"
 	"github.com/mcdev12/dynasty/go/internal/genproto/team/v1/teamv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/template/v1/templatev1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/tradeblock/v1/tradeblockv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/transaction/v1/transactionv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/treasury/v1/treasuryv1connect"
 	"github.com/mcdev12/dynasty/go/internal/genproto/user/v1/userv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/webview/v1/webviewv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/media"
+	"github.com/mcdev12/dynasty/go/internal/ratelimit"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
 	"github.com/rs/cors"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
 
-func setupServer(services *Services) *http.Server {
+func setupServer(services *Services, limiter ratelimit.Limiter, streamMonitor *streammonitor.Monitor, accessLog *accesslog.Logger) (*http.Server, error) {
 	mux := http.NewServeMux()
 
+	// Turn away clients hammering login and the other account endpoints before doing any work
+	rateLimitInterceptor := setupRateLimitInterceptor(limiter)
+
+	// Setup request validation for every handler
+	validationInterceptor, err := interceptors.NewValidationInterceptor()
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup validation interceptor: %w", err)
+	}
+	// Identify internal services before tenancy, which lets them through
+	serviceAuthInterceptor := setupServiceAuthInterceptor()
+	// Confine league API keys to their league and scopes, then scope league-owned
+	// resources to members of that league
+	resolvers := leagueResolvers(services.LeagueScoping)
+	apiKeyInterceptor := setupAPIKeyInterceptor(services.LeagueApp, resolvers)
+	// Sign in users by their session access token before tenancy checks their leagues
+	sessionInterceptor := setupSessionInterceptor(services.UserApp)
+	tenancyInterceptor := setupTenancyInterceptor(services.LeagueScoping, resolvers)
+
+	// Retry reads through a database failover and report what's left as unavailable
+	dbRetryInterceptor := interceptors.NewDBRetryInterceptor(sqlutil.DefaultRetryPolicy)
+
+	// Tag queries with the draft or league a request acts on for the slow query log
+	queryFieldsInterceptor := interceptors.NewQueryFieldsInterceptor()
+
+	// Let heavy reads that can be a little stale run on the read replica, if there is one
+	readReplicaInterceptor := interceptors.NewReadReplicaInterceptor(replicaReadStaleness())
+
+	// Answer in the locale the client's Accept-Language asks for where display strings are localized
+	localeInterceptor := interceptors.NewLocaleInterceptor()
+
+	opts := connect.WithInterceptors(rateLimitInterceptor, validationInterceptor, serviceAuthInterceptor, apiKeyInterceptor, sessionInterceptor, tenancyInterceptor, queryFieldsInterceptor, localeInterceptor, readReplicaInterceptor, dbRetryInterceptor)
+	if accessLog != nil {
+		// Log every call, including the ones turned away, and who made it once they're known
+		opts = connect.WithInterceptors(accesslog.NewInterceptor(accessLog), rateLimitInterceptor, validationInterceptor, serviceAuthInterceptor, apiKeyInterceptor, sessionInterceptor, tenancyInterceptor, accesslog.NewIdentityInterceptor(), queryFieldsInterceptor, localeInterceptor, readReplicaInterceptor, dbRetryInterceptor)
+	}
+
 	// Setup CORS middleware
-	c := cors.New(cors.Options{
+	corsOptions := cors.Options{
 		AllowedMethods: []string{
 			http.MethodHead,
 			http.MethodGet,
@@ -32,12 +91,23 @@ func setupServer(services *Services) *http.Server {
 			http.MethodPatch,
 			http.MethodDelete,
 		},
-		AllowedOrigins: []string{"*"},
+		AllowedOrigins: allowedOrigins(),
 		AllowedHeaders: []string{"*"},
-	})
+	}
+	if len(corsOptions.AllowedOrigins) == 0 {
+		// rs/cors treats an empty list as any origin
+		corsOptions.AllowOriginFunc = func(string) bool { return false }
+	}
+	c := cors.New(corsOptions)
 
-	// Register services
-	registerServices(mux, services)
+	// Register services, compressing responses of at least compression.MinBytes for clients
+	// that accept gzip or deflate
+	registerServices(mux, services, opts, connect.WithCompressMinBytes(compression.MinBytes), compression.WithDeflate())
+
+	// Operator tools for support and incidents, behind operator tokens of their own
+	if err := mountPlatformAdmin(mux, services.PlatformAdmin, validationInterceptor, accessLog); err != nil {
+		return nil, fmt.Errorf("failed to setup admin service: %w", err)
+	}
 
 	// Setup reflection for grpcui/grpcurl
 	setupReflection(mux)
@@ -45,6 +115,27 @@ func setupServer(services *Services) *http.Server {
 	// Add health check endpoint
 	setupHealthCheck(mux)
 
+	// Expose runtime, query duration and database retry metrics
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	// Runtime debug endpoints: log level, profiles and configuration
+	admin.Mount(mux, admin.Config{
+		Service: "api",
+		Token:   os.Getenv("ADMIN_API_TOKEN"),
+	})
+
+	// Report how far the draft event consumers are behind, when monitored
+	mountStreamHealth(mux, streamMonitor)
+
+	// Schedule maintenance windows that hold drafts over a deploy
+	mountMaintenance(mux, services.DraftService)
+
+	// Record dues paid through Stripe, when configured
+	mountStripeWebhook(mux, services.TreasuryApp)
+
+	// Take updates pushed by sports data providers, for plugins that accept them
+	mountSportWebhooks(mux, services)
+
 	// Wrap with CORS
 	handler := c.Handler(mux)
 
@@ -52,41 +143,138 @@ func setupServer(services *Services) *http.Server {
 	return &http.Server{
 		Addr:    fmt.Sprintf(":%s", getEnv("PORT", "8080")),
 		Handler: h2c.NewHandler(handler, &http2.Server{}),
+	}, nil
+}
+
+// allowedOrigins reads the browser origins allowed to call the API from CORS_ALLOWED_ORIGINS,
+// comma separated, e.g. "https://app.example.com,https://*.example.com". Development allows
+// any origin unless a list is configured; other environments only allow the configured origins.
+func allowedOrigins() []string {
+	origins := gateway.ParseOrigins(os.Getenv("CORS_ALLOWED_ORIGINS"))
+	if len(origins) > 0 {
+		return origins
 	}
+
+	if appEnv := getEnv("APP_ENV", "development"); appEnv != "development" {
+		log.Printf("CORS_ALLOWED_ORIGINS not set in %s, cross-origin requests will be rejected", appEnv)
+		return nil
+	}
+	return []string{"*"}
 }
 
-func registerServices(mux *http.ServeMux, services *Services) {
+func registerServices(mux *http.ServeMux, services *Services, opts ...connect.HandlerOption) {
+	// Connect GET calls are answered 304 Not Modified when the client holds the response
+	handle := func(path string, handler http.Handler) {
+		mux.Handle(path, etag.Middleware(handler))
+	}
+
 	// Register team service
-	teamServicePath, teamServiceHandler := teamv1connect.NewTeamServiceHandler(services.Teams)
-	mux.Handle(teamServicePath, teamServiceHandler)
+	teamServicePath, teamServiceHandler := teamv1connect.NewTeamServiceHandler(services.Teams, opts...)
+	handle(teamServicePath, teamServiceHandler)
 
 	// Register player service
-	playerServicePath, playerServiceHandler := playerv1connect.NewPlayerServiceHandler(services.Players)
-	mux.Handle(playerServicePath, playerServiceHandler)
+	playerServicePath, playerServiceHandler := playerv1connect.NewPlayerServiceHandler(services.Players, opts...)
+	handle(playerServicePath, playerServiceHandler)
 
 	// Register user service
-	userServicePath, userServiceHandler := userv1connect.NewUserServiceHandler(services.Users)
-	mux.Handle(userServicePath, userServiceHandler)
+	userServicePath, userServiceHandler := userv1connect.NewUserServiceHandler(services.Users, opts...)
+	handle(userServicePath, userServiceHandler)
 
 	// Register league service
-	leagueServicePath, leagueServiceHandler := leaguev1connect.NewLeagueServiceHandler(services.League)
-	mux.Handle(leagueServicePath, leagueServiceHandler)
+	leagueServicePath, leagueServiceHandler := leaguev1connect.NewLeagueServiceHandler(services.League, opts...)
+	handle(leagueServicePath, leagueServiceHandler)
+
+	// Register league initialization service
+	leagueInitServicePath, leagueInitServiceHandler := leaguev1connect.NewLeagueInitServiceHandler(services.LeagueInit, opts...)
+	handle(leagueInitServicePath, leagueInitServiceHandler)
+
+	// Register public league service. It is left out of tenancy so anyone can read a public
+	// league's pages, and answers CDN revalidations with 304s.
+	publicLeagueServicePath, publicLeagueServiceHandler := leaguev1connect.NewPublicLeagueServiceHandler(services.PublicLeagues, opts...)
+	handle(publicLeagueServicePath, publicLeagueServiceHandler)
 
 	// Register fantasy team service
-	fantasyTeamServicePath, fantasyTeamServiceHandler := fantasyteamv1connect.NewFantasyTeamServiceHandler(services.FantasyTeam)
-	mux.Handle(fantasyTeamServicePath, fantasyTeamServiceHandler)
+	fantasyTeamServicePath, fantasyTeamServiceHandler := fantasyteamv1connect.NewFantasyTeamServiceHandler(services.FantasyTeam, opts...)
+	handle(fantasyTeamServicePath, fantasyTeamServiceHandler)
 
 	// Register roster service
-	rosterServicePath, rosterServiceHandler := rosterv1connect.NewRosterServiceHandler(services.Roster)
-	mux.Handle(rosterServicePath, rosterServiceHandler)
+	rosterServicePath, rosterServiceHandler := rosterv1connect.NewRosterServiceHandler(services.Roster, opts...)
+	handle(rosterServicePath, rosterServiceHandler)
 
 	// Draft service
-	draftServicePath, draftServiceHandler := draftv1connect.NewDraftServiceHandler(services.DraftService)
-	mux.Handle(draftServicePath, draftServiceHandler)
+	draftServicePath, draftServiceHandler := draftv1connect.NewDraftServiceHandler(services.DraftService, opts...)
+	handle(draftServicePath, draftServiceHandler)
 
 	// Draft pick service
-	draftPickServicePath, draftPickServiceHandler := draftv1connect.NewDraftPickServiceHandler(services.DraftPickService)
-	mux.Handle(draftPickServicePath, draftPickServiceHandler)
+	draftPickServicePath, draftPickServiceHandler := draftv1connect.NewDraftPickServiceHandler(services.DraftPickService, opts...)
+	handle(draftPickServicePath, draftPickServiceHandler)
+
+	// Draft slot selection service
+	draftSlotSelectionServicePath, draftSlotSelectionServiceHandler := draftv1connect.NewDraftSlotSelectionServiceHandler(services.DraftSlotSelection, opts...)
+	handle(draftSlotSelectionServicePath, draftSlotSelectionServiceHandler)
+
+	// Draft auction service
+	draftAuctionServicePath, draftAuctionServiceHandler := draftv1connect.NewDraftAuctionServiceHandler(services.DraftAuction, opts...)
+	handle(draftAuctionServicePath, draftAuctionServiceHandler)
+
+	// Draft expansion service
+	draftExpansionServicePath, draftExpansionServiceHandler := draftv1connect.NewDraftExpansionServiceHandler(services.DraftExpansion, opts...)
+	handle(draftExpansionServicePath, draftExpansionServiceHandler)
+
+	// Draft dispersal service
+	draftDispersalServicePath, draftDispersalServiceHandler := draftv1connect.NewDraftDispersalServiceHandler(services.DraftDispersal, opts...)
+	handle(draftDispersalServicePath, draftDispersalServiceHandler)
+
+	// Draft history service
+	draftHistoryServicePath, draftHistoryServiceHandler := draftv1connect.NewDraftHistoryServiceHandler(services.DraftHistory, opts...)
+	handle(draftHistoryServicePath, draftHistoryServiceHandler)
+
+	// Draft recap service
+	draftRecapServicePath, draftRecapServiceHandler := draftv1connect.NewDraftRecapServiceHandler(services.DraftRecap, opts...)
+	handle(draftRecapServicePath, draftRecapServiceHandler)
+
+	// Mock draft service. It is left out of tenancy since mock leagues belong to no user.
+	mockDraftServicePath, mockDraftServiceHandler := draftv1connect.NewMockDraftServiceHandler(services.MockDrafts, opts...)
+	handle(mockDraftServicePath, mockDraftServiceHandler)
+
+	// News service
+	newsServicePath, newsServiceHandler := newsv1connect.NewNewsServiceHandler(services.News, opts...)
+	handle(newsServicePath, newsServiceHandler)
+
+	// Transaction log service
+	transactionServicePath, transactionServiceHandler := transactionv1connect.NewTransactionServiceHandler(services.Transactions, opts...)
+	handle(transactionServicePath, transactionServiceHandler)
+
+	// League chat service
+	leagueChatServicePath, leagueChatServiceHandler := leaguechatv1connect.NewChatServiceHandler(services.LeagueChat, opts...)
+	handle(leagueChatServicePath, leagueChatServiceHandler)
+
+	// Trade block service
+	tradeBlockServicePath, tradeBlockServiceHandler := tradeblockv1connect.NewTradeBlockServiceHandler(services.TradeBlock, opts...)
+	handle(tradeBlockServicePath, tradeBlockServiceHandler)
+
+	// Treasury service
+	treasuryServicePath, treasuryServiceHandler := treasuryv1connect.NewTreasuryServiceHandler(services.Treasury, opts...)
+	handle(treasuryServicePath, treasuryServiceHandler)
+
+	// Schedule service
+	scheduleServicePath, scheduleServiceHandler := schedulev1connect.NewScheduleServiceHandler(services.Schedule, opts...)
+	handle(scheduleServicePath, scheduleServiceHandler)
+
+	// Settings template service
+	templateServicePath, templateServiceHandler := templatev1connect.NewSettingsTemplateServiceHandler(services.Templates, opts...)
+	handle(templateServicePath, templateServiceHandler)
+
+	// Register media service. Images are base64 in JSON requests, so requests may run to
+	// a third larger than the largest image.
+	mediaServicePath, mediaServiceHandler := mediav1connect.NewMediaServiceHandler(services.Media,
+		append(opts, connect.WithReadMaxBytes(2*media.MaxUploadBytes))...)
+	handle(mediaServicePath, mediaServiceHandler)
+	mountMediaFiles(mux, services.MediaStore)
+
+	// Web view service
+	webViewServicePath, webViewServiceHandler := webviewv1connect.NewWebViewServiceHandler(services.WebView, opts...)
+	handle(webViewServicePath, webViewServiceHandler)
 }
 
 func setupReflection(mux *http.ServeMux) {
@@ -95,10 +283,29 @@ func setupReflection(mux *http.ServeMux) {
 		playerv1connect.PlayerServiceName,
 		userv1connect.UserServiceName,
 		leaguev1connect.LeagueServiceName,
+		leaguev1connect.LeagueInitServiceName,
+		leaguev1connect.PublicLeagueServiceName,
 		fantasyteamv1connect.FantasyTeamServiceName,
 		rosterv1connect.RosterServiceName,
 		draftv1connect.DraftServiceName,
 		draftv1connect.DraftPickServiceName,
+		draftv1connect.DraftSlotSelectionServiceName,
+		draftv1connect.DraftAuctionServiceName,
+		draftv1connect.DraftExpansionServiceName,
+		draftv1connect.DraftDispersalServiceName,
+		draftv1connect.DraftHistoryServiceName,
+		draftv1connect.DraftRecapServiceName,
+		draftv1connect.MockDraftServiceName,
+		newsv1connect.NewsServiceName,
+		transactionv1connect.TransactionServiceName,
+		leaguechatv1connect.ChatServiceName,
+		tradeblockv1connect.TradeBlockServiceName,
+		treasuryv1connect.TreasuryServiceName,
+		schedulev1connect.ScheduleServiceName,
+		templatev1connect.SettingsTemplateServiceName,
+		mediav1connect.MediaServiceName,
+		webviewv1connect.WebViewServiceName,
+		adminv1connect.AdminServiceName,
 	)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
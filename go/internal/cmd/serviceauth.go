@@ -0,0 +1,62 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"connectrpc.com/connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/news/v1/newsv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/team/v1/teamv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+)
+
+// serviceOrchestrator is the service name the draft orchestrator signs its calls with
+const serviceOrchestrator = "orchestrator"
+
+// serviceNewsSync is the service name the scheduled player news sync signs its calls with
+const serviceNewsSync = "news-sync"
+
+// serviceSeasonSync is the service name the bye week and depth chart sync signs its calls with
+const serviceSeasonSync = "season-sync"
+
+// serviceGateway is the service name the draft gateway signs its calls with
+const serviceGateway = "gateway"
+
+// setupServiceAuthInterceptor authenticates internal services by their signed service token
+// and keeps the scheduler and auto-pick RPCs, which only the orchestrator drives, room presence,
+// which only the gateway sees, and the news and season data syncs, which fetch from outside
+// sites, away from end users.
+// Without SERVICE_AUTH_SECRET the service-only RPCs aren't enforced, but no caller is a service
+// either, so RPCs that take a user or a service, like picks, turn away unsigned calls.
+func setupServiceAuthInterceptor() connect.Interceptor {
+	secret := os.Getenv("SERVICE_AUTH_SECRET")
+	if secret == "" {
+		log.Printf("SERVICE_AUTH_SECRET not set, service-only RPCs are open to any caller and the orchestrator's auto-picks and pause windows will be rejected")
+	}
+
+	orchestratorOnly := []string{serviceOrchestrator}
+	serviceOnly := map[string][]string{
+		// Scheduler operations
+		draftv1connect.DraftServiceFetchNextDeadlineProcedure:                orchestratorOnly,
+		draftv1connect.DraftServiceFetchDraftsDueForPickProcedure:            orchestratorOnly,
+		draftv1connect.DraftServiceUpdateNextDeadlineProcedure:               orchestratorOnly,
+		draftv1connect.DraftServiceClearNextDeadlineProcedure:                orchestratorOnly,
+		draftv1connect.DraftServiceWarnPickClockProcedure:                    orchestratorOnly,
+		draftv1connect.DraftPickServiceClaimNextPickSlotProcedure:            orchestratorOnly,
+		draftv1connect.DraftPickServiceCountRemainingPicksForDraftsProcedure: orchestratorOnly,
+		draftv1connect.DraftPickServiceSkipPickProcedure:                     orchestratorOnly,
+		draftv1connect.DraftPickServiceSkipPickWithoutRosterSpaceProcedure:   orchestratorOnly,
+		draftv1connect.DraftPickServiceExpirePickTradeProcedure:              orchestratorOnly,
+		// Draft room presence
+		draftv1connect.DraftServiceReportRoomPresenceProcedure: {serviceGateway},
+		// Ingestion from outside sites
+		newsv1connect.NewsServiceSyncPlayerNewsProcedure:        {serviceNewsSync},
+		teamv1connect.TeamServiceSyncSeasonDataFromAPIProcedure: {serviceSeasonSync},
+	}
+
+	return interceptors.NewServiceAuthInterceptor(interceptors.ServiceAuthConfig{
+		Secret:      []byte(secret),
+		ServiceOnly: serviceOnly,
+	})
+}
@@ -3,110 +3,337 @@ package main
 import (
 	"database/sql"
 
+	"github.com/mcdev12/dynasty/go/internal/changes"
+	changesdb "github.com/mcdev12/dynasty/go/internal/changes/db"
+	"github.com/mcdev12/dynasty/go/internal/clock"
+	"github.com/mcdev12/dynasty/go/internal/draft/analytics"
+	"github.com/mcdev12/dynasty/go/internal/draft/auction"
+	auctiondb "github.com/mcdev12/dynasty/go/internal/draft/auction/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/dispersal"
+	dispersaldb "github.com/mcdev12/dynasty/go/internal/draft/dispersal/db"
 	draftdraft "github.com/mcdev12/dynasty/go/internal/draft/draft"
 	draftdb "github.com/mcdev12/dynasty/go/internal/draft/draft/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/expansion"
+	expansiondb "github.com/mcdev12/dynasty/go/internal/draft/expansion/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/history"
+	historydb "github.com/mcdev12/dynasty/go/internal/draft/history/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/mockdraft"
+	mockdraftdb "github.com/mcdev12/dynasty/go/internal/draft/mockdraft/db"
 	"github.com/mcdev12/dynasty/go/internal/draft/outbox"
 	outboxdb "github.com/mcdev12/dynasty/go/internal/draft/outbox/db"
 	"github.com/mcdev12/dynasty/go/internal/draft/pick"
 	pickdb "github.com/mcdev12/dynasty/go/internal/draft/pick/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/recap"
+	recapdb "github.com/mcdev12/dynasty/go/internal/draft/recap/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/slotselection"
+	slotselectiondb "github.com/mcdev12/dynasty/go/internal/draft/slotselection/db"
 	"github.com/mcdev12/dynasty/go/internal/fantasyteam"
 	fantasyteamdb "github.com/mcdev12/dynasty/go/internal/fantasyteam/db"
+	"github.com/mcdev12/dynasty/go/internal/flags"
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+	jobsdb "github.com/mcdev12/dynasty/go/internal/jobs/db"
+	"github.com/mcdev12/dynasty/go/internal/leaguechat"
+	leaguechatdb "github.com/mcdev12/dynasty/go/internal/leaguechat/db"
+	"github.com/mcdev12/dynasty/go/internal/leagueinit"
+	leagueinitdb "github.com/mcdev12/dynasty/go/internal/leagueinit/db"
 	"github.com/mcdev12/dynasty/go/internal/leagues"
 	leaguedb "github.com/mcdev12/dynasty/go/internal/leagues/db"
+	"github.com/mcdev12/dynasty/go/internal/media"
+	mediadb "github.com/mcdev12/dynasty/go/internal/media/db"
+	"github.com/mcdev12/dynasty/go/internal/news"
+	newsdb "github.com/mcdev12/dynasty/go/internal/news/db"
+	"github.com/mcdev12/dynasty/go/internal/platformadmin"
+	platformadmindb "github.com/mcdev12/dynasty/go/internal/platformadmin/db"
 	"github.com/mcdev12/dynasty/go/internal/player"
 	playerdb "github.com/mcdev12/dynasty/go/internal/player/db"
+	"github.com/mcdev12/dynasty/go/internal/publicleagues"
+	publicleaguesdb "github.com/mcdev12/dynasty/go/internal/publicleagues/db"
+	"github.com/mcdev12/dynasty/go/internal/ratelimit"
 	"github.com/mcdev12/dynasty/go/internal/roster"
 	rosterdb "github.com/mcdev12/dynasty/go/internal/roster/db"
+	"github.com/mcdev12/dynasty/go/internal/schedule"
+	scheduledb "github.com/mcdev12/dynasty/go/internal/schedule/db"
 	"github.com/mcdev12/dynasty/go/internal/sports/base"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
 	"github.com/mcdev12/dynasty/go/internal/teams"
 	teamsdb "github.com/mcdev12/dynasty/go/internal/teams/db"
+	"github.com/mcdev12/dynasty/go/internal/templates"
+	templatesdb "github.com/mcdev12/dynasty/go/internal/templates/db"
+	"github.com/mcdev12/dynasty/go/internal/tradeblock"
+	tradeblockdb "github.com/mcdev12/dynasty/go/internal/tradeblock/db"
+	"github.com/mcdev12/dynasty/go/internal/transactions"
+	transactionsdb "github.com/mcdev12/dynasty/go/internal/transactions/db"
+	"github.com/mcdev12/dynasty/go/internal/treasury"
+	treasurydb "github.com/mcdev12/dynasty/go/internal/treasury/db"
 	"github.com/mcdev12/dynasty/go/internal/users"
 	usersdb "github.com/mcdev12/dynasty/go/internal/users/db"
+	"github.com/mcdev12/dynasty/go/internal/webview"
 )
 
 type Services struct {
-	Teams             *teams.Service
-	Players           *player.Service
-	Users             *users.Service
-	League            *leagues.Service
-	FantasyTeam       *fantasyteam.Service
-	Roster            *roster.Service
-	DraftService      *draftdraft.Service
-	DraftPickService  *pick.Service
+	Teams               *teams.Service
+	Players             *player.Service
+	PlayerApp           *player.App
+	Users               *users.Service
+	UserApp             *users.App
+	League              *leagues.Service
+	LeagueApp           *leagues.App
+	LeagueInit          *leagueinit.Service
+	PublicLeagues       *publicleagues.Service
+	FantasyTeam         *fantasyteam.Service
+	Roster              *roster.Service
+	RosterApp           *roster.App
+	DraftService        *draftdraft.Service
+	DraftPickService    *pick.Service
+	DraftSlotSelection  *slotselection.Service
+	DraftAuction        *auction.Service
+	DraftExpansion      *expansion.Service
+	DraftDispersal      *dispersal.Service
+	DraftHistory        *history.Service
+	DraftRecap          *recap.Service
+	MockDrafts          *mockdraft.Service
+	MockDraftMatchmaker *mockdraft.Matchmaker
+	DraftAnalytics      *analytics.Analyzer
+	News                *news.Service
+	Transactions        *transactions.Service
+	TransactionsApp     *transactions.App
+	LeagueChat          *leaguechat.Service
+	TradeBlock          *tradeblock.Service
+	Treasury            *treasury.Service
+	TreasuryApp         *treasury.App
+	Schedule            *schedule.Service
+	ScheduleApp         *schedule.App
+	Templates           *templates.Service
+	Media               *media.Service
+	MediaStore          media.Store
+	Plugins             map[string]base.SportPlugin
+	Jobs                *jobs.Queue
+	LeagueScoping       *LeagueScoping
+	WebView             *webview.Service
+	PlatformAdmin       *platformadmin.Service
+	Clock               clock.Clock
 }
 
-func setupServices(database *sql.DB, plugins map[string]base.SportPlugin) *Services {
+func setupServices(database *sql.DB, replica *sqlutil.ReadReplica, plugins map[string]base.SportPlugin, mediaStore media.Store, featureFlags *flags.Client, limiter ratelimit.Limiter, appClock clock.Clock) *Services {
 	// Wire up dependency injection chain
 	// Database layer → Repository layer → App layer → Service layer
 
+	// Transactions that services compose across repositories
+	txManager := sqlutil.NewTxManager(database)
+
+	// Background job queue, drained by the job worker
+	jobQueue := jobs.NewQueue(jobsdb.New(database))
+
+	// Changes to leagues, teams and rosters, recorded with the writes that make them
+	entityChanges := changes.NewTxInserter(changesdb.New(database), txManager)
+
 	// Teams
 	queries := teamsdb.New(database)
-	teamsRepo := teams.NewRepository(queries)
+	teamsRepo := teams.NewRepository(queries, database)
 	teamsApp := teams.NewApp(teamsRepo, plugins)
 	teamsService := teams.NewService(teamsApp)
 
-	// Players
+	// Outbox app, through which draft changes and player news reach live drafts
+	outboxQueries := outboxdb.New(database)
+	outboxRepo := outbox.NewRepository(outboxQueries)
+	outboxApp := outbox.NewApp(outboxRepo)
+
+	// Player news, which alerts live drafts through the outbox
+	newsQueries := newsdb.New(database)
+	newsRepo := news.NewRepository(newsQueries)
+	newsApp := news.NewApp(newsRepo, plugins)
+	newsService := news.NewService(newsApp, outboxApp)
+
+	// Players, whose syncs alert live drafts to new injuries through the news service
 	playerQueries := playerdb.New(database)
 	playerRepo := player.NewRepository(playerQueries, database)
 	playerApp := player.NewApp(playerRepo, plugins)
-	playerService := player.NewService(playerApp, teamsService)
+	playerService := player.NewService(playerApp, teamsService, newsService)
 
 	// Users
 	userQueries := usersdb.New(database)
-	userRepo := users.NewRepository(userQueries)
-	userApp := users.NewApp(userRepo)
+	userRepo := users.NewRepository(userQueries, database)
+	userApp := users.NewApp(userRepo, emailTokenSecret())
 	userService := users.NewService(userApp)
 
+	// Settings templates, applied when creating leagues and drafts
+	templateQueries := templatesdb.New(database)
+	templateRepo := templates.NewRepository(templateQueries)
+	templateApp := templates.NewApp(templateRepo)
+	templateService := templates.NewService(templateApp, userService)
+
+	// Media uploads for team logos and user avatars
+	mediaRepo := media.NewRepository(mediadb.New(database))
+	mediaApp := media.NewApp(mediaRepo, mediaStore)
+	mediaService := media.NewService(mediaApp)
+
 	// League
 	leagueQueries := leaguedb.New(database)
-	leagueRepo := leagues.NewRepository(leagueQueries)
-	leagueApp := leagues.NewApp(leagueRepo)
-	leagueService := leagues.NewService(leagueApp, userService)
+	leagueRepo := leagues.NewRepository(leagueQueries, database, entityChanges)
+	leagueApp := leagues.NewApp(leagueRepo, featureFlags)
+	leagueService := leagues.NewService(leagueApp, userService, templateService)
+
+	// Roster players (the app comes first: my teams checks lineups through it)
+	rosterQueries := rosterdb.New(database)
+	rosterRepo := roster.NewRepository(rosterQueries, database, entityChanges, appClock)
+	rosterApp := roster.NewApp(rosterRepo, appClock)
 
 	// FantasyTeam
 	fantasyTeamQueries := fantasyteamdb.New(database)
-	fantasyTeamRepo := fantasyteam.NewRepository(fantasyTeamQueries)
-	fantasyTeamApp := fantasyteam.NewApp(fantasyTeamRepo)
+	fantasyTeamRepo := fantasyteam.NewRepository(fantasyTeamQueries, entityChanges)
+	fantasyTeamApp := fantasyteam.NewApp(fantasyTeamRepo, rosterApp)
 	fantasyTeamService := fantasyteam.NewService(fantasyTeamApp, userService, leagueService)
 
-	// Roster players
-	rosterQueries := rosterdb.New(database)
-	rosterRepo := roster.NewRepository(rosterQueries)
-	rosterApp := roster.NewApp(rosterRepo)
 	rosterService := roster.NewService(rosterApp, fantasyTeamService, playerService)
 
 	// Draft Services Setup (simplified for monolith - avoiding circular dependencies for now)
 	draftQueries := draftdb.New(database)
 	pickQueries := pickdb.New(database)
-	outboxQueries := outboxdb.New(database)
 
 	// Draft app and service
-	draftRepo := draftdraft.NewRepository(draftQueries)
-	draftApp := draftdraft.NewApp(draftRepo)
+	draftRepo := draftdraft.NewRepository(draftQueries, database, appClock)
+	draftApp := draftdraft.NewApp(draftRepo, appClock)
 
-	// Outbox app
-	outboxRepo := outbox.NewRepository(outboxQueries)
-	outboxApp := outbox.NewApp(outboxRepo)
-
-	// Create draft service with outbox app and league service
-	draftService := draftdraft.NewService(draftApp, outboxApp, leagueService)
+	// Create draft service with outbox app, league service and template service
+	draftService := draftdraft.NewService(draftApp, outboxApp, leagueService, templateService)
 
 	// Draft pick app and service
-	draftPickRepo := pick.NewRepository(pickQueries, database)
-	pickApp := pick.NewApp(draftPickRepo)
-	pickService := pick.NewService(pickApp, draftService, outboxApp)
+	draftPickRepo := pick.NewRepository(pickQueries, database, appClock)
+	pickApp := pick.NewApp(draftPickRepo, appClock)
+	pickService := pick.NewService(pickApp, draftService, outboxApp, txManager, limiter)
+
+	// Pre-draft slot selection app and service
+	slotSelectionRepo := slotselection.NewRepository(slotselectiondb.New(database), database)
+	slotSelectionApp := slotselection.NewApp(slotSelectionRepo)
+	slotSelectionService := slotselection.NewService(slotSelectionApp, draftService, outboxApp)
+
+	// Auction nominations and proxy bidding, which fill picks in auction drafts
+	auctionRepo := auction.NewRepository(auctiondb.New(database), database)
+	auctionApp := auction.NewApp(auctionRepo)
+	auctionService := auction.NewService(auctionApp, draftService, pickApp, outboxApp)
+
+	// Expansion teams: pick slots in upcoming drafts, and protection lists for expansion drafts
+	expansionRepo := expansion.NewRepository(expansiondb.New(database), database)
+	expansionService := expansion.NewService(expansion.NewApp(expansionRepo))
+
+	// Dispersal drafts for folded teams, created and completed through the draft, pick and roster services
+	dispersalRepo := dispersal.NewRepository(dispersaldb.New(database))
+	dispersalService := dispersal.NewService(dispersal.NewApp(dispersalRepo), draftService, pickService, rosterService, txManager)
+
+	// Drafts migrated leagues held before moving to the platform, imported as completed drafts
+	historyRepo := history.NewRepository(historydb.New(database))
+	historyService := history.NewService(history.NewApp(historyRepo), txManager)
+
+	// Recaps of completed drafts, measured against the player rankings and sent to league members
+	recapRepo := recap.NewRepository(recapdb.New(database))
+	recapService := recap.NewService(recap.NewApp(recapRepo), txManager)
+
+	// Live draft room analytics, worked out from the board after each pick
+	draftAnalytics := analytics.NewAnalyzer(pickApp, outboxApp, analytics.DefaultAnalyzerConfig())
+
+	// League transaction log, projected from roster and draft events
+	transactionRepo := transactions.NewRepository(transactionsdb.New(database), database)
+	transactionApp := transactions.NewApp(transactionRepo)
+	transactionService := transactions.NewService(transactionApp)
+
+	// League discussion boards, which notify mentioned members through the user outbox
+	leagueChatRepo := leaguechat.NewRepository(leaguechatdb.New(database), database)
+	leagueChatService := leaguechat.NewService(leaguechat.NewApp(leagueChatRepo))
+
+	// Trade block and wishlists, which notify wishlist owners through the user outbox
+	tradeBlockRepo := tradeblock.NewRepository(tradeblockdb.New(database), database)
+	tradeBlockService := tradeblock.NewService(tradeblock.NewApp(tradeBlockRepo))
+
+	// League dues and payouts, marked by the commissioner or paid through Stripe
+	treasuryRepo := treasury.NewRepository(treasurydb.New(database), database)
+	treasuryApp := treasury.NewApp(treasuryRepo)
+	treasuryService := treasury.NewService(treasuryApp)
+
+	// Regular season schedules, generated from league settings and committed by the commissioner
+	scheduleRepo := schedule.NewRepository(scheduledb.New(database), database)
+	scheduleApp := schedule.NewApp(scheduleRepo)
+	scheduleService := schedule.NewService(scheduleApp)
+
+	// League initialization, a saga over the league, fantasy team and draft services
+	leagueInitRepo := leagueinit.NewRepository(leagueinitdb.New(database), database)
+	leagueInitSaga := leagueinit.NewSaga(leagueInitRepo, leagueService, fantasyTeamService, draftService, pickService)
+	leagueInitService := leagueinit.NewService(leagueInitSaga)
+
+	// Public mock drafts: a matchmaker seats queued users and bots in throwaway leagues hosted through the league, fantasy team and draft services
+	mockDraftApp := mockdraft.NewApp(mockdraft.NewRepository(mockdraftdb.New(database), database))
+	mockDraftService := mockdraft.NewService(mockDraftApp)
+	mockDraftMatchmaker := mockdraft.NewMatchmaker(mockDraftApp, leagueService, fantasyTeamService, draftService, pickService, mockdraft.DefaultMatchmakerConfig())
+
+	// Public league pages
+	publicLeaguesRepo := publicleagues.NewRepository(publicleaguesdb.New(database))
+	publicLeaguesApp := publicleagues.NewApp(publicLeaguesRepo)
+	publicLeaguesService := publicleagues.NewService(publicLeaguesApp)
+
+	// Screens of the web client, composed from the services above
+	webViewService := webview.NewService(leagueService, fantasyTeamService, draftService, pickService, playerService)
+
+	// Operator tools: search, impersonation, stuck drafts, dead letters, dashboards and league validation with repairs
+	platformAdminRepo := platformadmin.NewRepository(platformadmindb.New(database), database)
+	platformAdminApp := platformadmin.NewApp(platformAdminRepo, userImpersonator{app: userApp}, userRestorer{app: userApp}, draftService, rosterApp, playerApp)
+	platformAdminService := platformadmin.NewService(platformAdminApp)
+
+	// Heavy reads allowed to be stale, see replicaReadStaleness, go to the read replica
+	if replica != nil {
+		draftPickRepo.UseReadReplica(replica)
+		publicLeaguesRepo.UseReadReplica(replica)
+	}
 
 	// NOTE: Orchestrator is now a separate binary - see go/internal/draft/orchestrator/cmd/main.go
 	// It runs independently and subscribes to domain events via the message bus
 
 	return &Services{
-		Teams:            teamsService,
-		Players:          playerService,
-		Users:            userService,
-		League:           leagueService,
-		FantasyTeam:      fantasyTeamService,
-		Roster:           rosterService,
-		DraftService:     draftService,
-		DraftPickService: pickService,
+		Teams:               teamsService,
+		Players:             playerService,
+		PlayerApp:           playerApp,
+		Users:               userService,
+		UserApp:             userApp,
+		League:              leagueService,
+		LeagueApp:           leagueApp,
+		LeagueInit:          leagueInitService,
+		PublicLeagues:       publicLeaguesService,
+		FantasyTeam:         fantasyTeamService,
+		Roster:              rosterService,
+		RosterApp:           rosterApp,
+		DraftService:        draftService,
+		DraftPickService:    pickService,
+		DraftSlotSelection:  slotSelectionService,
+		DraftAuction:        auctionService,
+		DraftExpansion:      expansionService,
+		DraftDispersal:      dispersalService,
+		DraftHistory:        historyService,
+		DraftRecap:          recapService,
+		MockDrafts:          mockDraftService,
+		MockDraftMatchmaker: mockDraftMatchmaker,
+		DraftAnalytics:      draftAnalytics,
+		News:                newsService,
+		Transactions:        transactionService,
+		TransactionsApp:     transactionApp,
+		LeagueChat:          leagueChatService,
+		TradeBlock:          tradeBlockService,
+		Treasury:            treasuryService,
+		TreasuryApp:         treasuryApp,
+		Schedule:            scheduleService,
+		ScheduleApp:         scheduleApp,
+		Templates:           templateService,
+		Media:               mediaService,
+		MediaStore:          mediaStore,
+		Plugins:             plugins,
+		Jobs:                jobQueue,
+		LeagueScoping: &LeagueScoping{
+			Leagues:      leagueRepo,
+			Drafts:       draftRepo,
+			Picks:        draftPickRepo,
+			FantasyTeams: fantasyTeamRepo,
+			Roster:       rosterRepo,
+			LeagueChat:   leagueChatRepo,
+		},
+		WebView:       webViewService,
+		PlatformAdmin: platformAdminService,
+		Clock:         appClock,
 	}
 }
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"connectrpc.com/connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/users"
+)
+
+// setupSessionInterceptor signs requests in as the user whose session access token they carry
+func setupSessionInterceptor(app *users.App) connect.Interceptor {
+	return interceptors.NewSessionInterceptor(interceptors.SessionConfig{
+		Authenticator: users.NewSessionAuthenticator(app),
+	})
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/mcdev12/dynasty/go/internal/sports/base"
+)
+
+// maxSportWebhookBytes caps the size of a pushed update, which can carry whole rosters
+const maxSportWebhookBytes = 8 << 20
+
+// sportWebhookResult counts what a pushed update changed
+type sportWebhookResult struct {
+	Teams       int `json:"teams"`
+	Players     int `json:"players"`
+	News        int `json:"news"`
+	DraftAlerts int `json:"draft_alerts"`
+	Errors      int `json:"errors"`
+}
+
+// mountSportWebhooks serves the webhooks of the enabled sport plugins that take pushed updates,
+// each at /webhooks/sports/<plugin key>/<path>. A delivery is verified and mapped by its plugin,
+// then upserted as a sync would: teams, then each team's players, then news, alerting live
+// drafts to new injuries and breaking news.
+func mountSportWebhooks(mux *http.ServeMux, services *Services) {
+	keys := make([]string, 0, len(services.Plugins))
+	for key := range services.Plugins {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		plugin, ok := services.Plugins[key].(base.WebhookPlugin)
+		if !ok {
+			continue
+		}
+		for _, webhook := range plugin.Webhooks() {
+			path := "/webhooks/sports/" + key + "/" + strings.Trim(webhook.Path, "/")
+			mux.Handle(path, sportWebhookHandler(key, webhook, services))
+			log.Printf("Serving %s webhook at %s", key, path)
+		}
+	}
+}
+
+// sportWebhookHandler takes deliveries to one plugin webhook. Items that fail to upsert are
+// logged and counted rather than failing the delivery, since the upserts are idempotent and a
+// provider retrying it would only redo the rest.
+func sportWebhookHandler(sportID string, webhook base.Webhook, services *Services) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSportWebhookBytes))
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+		if err := webhook.Verify(r.Header, body); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		update, err := webhook.Map(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		result := ingestSportUpdate(r.Context(), sportID, update, services)
+		log.Printf("Ingested %s webhook update: %d teams, %d players, %d news, %d draft alerts, %d errors",
+			sportID, result.Teams, result.Players, result.News, result.DraftAlerts, result.Errors)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			log.Printf("Failed to write %s webhook response: %v", sportID, err)
+		}
+	})
+}
+
+// ingestSportUpdate feeds a pushed update through the teams, players and news upserts
+func ingestSportUpdate(ctx context.Context, sportID string, update *base.WebhookUpdate, services *Services) sportWebhookResult {
+	var result sportWebhookResult
+	fail := func(format string, args ...any) {
+		result.Errors++
+		log.Printf("%s webhook: "+format, append([]any{sportID}, args...)...)
+	}
+
+	if len(update.Teams) > 0 {
+		teams, err := services.Teams.IngestTeams(ctx, sportID, update.Teams)
+		if err != nil {
+			fail("failed to ingest teams: %v", err)
+		} else {
+			result.Teams = teams.Created + teams.Updated
+			for _, err := range teams.Errors {
+				fail("%v", err)
+			}
+		}
+	}
+
+	for _, team := range update.Players {
+		players, err := services.Players.IngestPlayers(ctx, sportID, team.TeamCode, team.Players)
+		if err != nil {
+			fail("failed to ingest players of %s: %v", team.TeamCode, err)
+			continue
+		}
+		result.Players += players.Created + players.Updated
+		result.DraftAlerts += players.DraftAlerts
+		for _, err := range players.Errors {
+			fail("%v", err)
+		}
+	}
+
+	if len(update.News) > 0 {
+		news, err := services.News.IngestPlayerNews(ctx, sportID, update.News)
+		if err != nil {
+			fail("failed to ingest news: %v", err)
+		} else {
+			result.News = news.Ingested
+			result.DraftAlerts += news.DraftAlerts
+			for _, err := range news.Errors {
+				fail("%v", err)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/admin"
+	draftdb "github.com/mcdev12/dynasty/go/internal/draft/draft/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/streammonitor"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+)
+
+// setupStreamMonitor creates the monitor that reports how far the draft event consumers are
+// behind. STREAM_MONITOR_CONSUMERS, comma separated, overrides which consumers are expected,
+// e.g. when gateways run under their own consumer names.
+func setupStreamMonitor(database *sql.DB) (*streammonitor.Monitor, error) {
+	config := streammonitor.DefaultConfig()
+	config.NATS = natsconfig.NewConfigFromEnv()
+	config.PollInterval = time.Duration(getEnvAsInt("STREAM_MONITOR_POLL_INTERVAL_SECONDS", int(config.PollInterval/time.Second))) * time.Second
+	config.MaxPending = uint64(getEnvAsInt("STREAM_MONITOR_MAX_PENDING", int(config.MaxPending)))
+	config.MaxAckPending = getEnvAsInt("STREAM_MONITOR_MAX_ACK_PENDING", config.MaxAckPending)
+
+	if consumers := os.Getenv("STREAM_MONITOR_CONSUMERS"); consumers != "" {
+		config.Consumers = nil
+		for _, name := range strings.Split(consumers, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				config.Consumers = append(config.Consumers, name)
+			}
+		}
+	}
+
+	monitor, err := streammonitor.NewMonitor(draftdb.New(database), config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stream monitor: %w", err)
+	}
+
+	return monitor, nil
+}
+
+// mountStreamHealth serves the stream monitor's report at /admin/streams. ADMIN_API_TOKEN,
+// when set, must be sent as a bearer token.
+func mountStreamHealth(mux *http.ServeMux, monitor *streammonitor.Monitor) {
+	if monitor == nil {
+		return
+	}
+
+	token := os.Getenv("ADMIN_API_TOKEN")
+	if token == "" {
+		log.Printf("ADMIN_API_TOKEN not set, /admin/streams is open to any caller")
+		mux.Handle("/admin/streams", monitor.Handler())
+		return
+	}
+
+	mux.Handle("/admin/streams", admin.RequireToken(token, monitor.Handler()))
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	draftdraft "github.com/mcdev12/dynasty/go/internal/draft/draft"
+	"github.com/mcdev12/dynasty/go/internal/draft/pick"
+	"github.com/mcdev12/dynasty/go/internal/fantasyteam"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/fantasyteam/v1/fantasyteamv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/leaguechat/v1/leaguechatv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/media/v1/mediav1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/roster/v1/rosterv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/schedule/v1/schedulev1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/tradeblock/v1/tradeblockv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/transaction/v1/transactionv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/treasury/v1/treasuryv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/webview/v1/webviewv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/leaguechat"
+	"github.com/mcdev12/dynasty/go/internal/leagues"
+	"github.com/mcdev12/dynasty/go/internal/roster"
+)
+
+// LeagueScoping bundles the repositories used to map a resource to the league that owns it
+type LeagueScoping struct {
+	Leagues      *leagues.Repository
+	Drafts       *draftdraft.Repository
+	Picks        *pick.Repository
+	FantasyTeams *fantasyteam.Repository
+	Roster       *roster.Repository
+	LeagueChat   *leaguechat.Repository
+}
+
+type leagueLookup func(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+
+// notFoundAware maps missing rows to interceptors.ErrResourceNotFound
+func notFoundAware(lookup leagueLookup) leagueLookup {
+	return func(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+		leagueID, err := lookup(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, interceptors.ErrResourceNotFound
+		}
+		return leagueID, err
+	}
+}
+
+func leagueIdentity(_ context.Context, id uuid.UUID) (uuid.UUID, error) {
+	return id, nil
+}
+
+// leagueResolvers maps draft, pick, slot selection, expansion, dispersal, roster, league settings history, league API key, transaction log, league chat, trade block, treasury, schedule, team logo and team delegation RPCs
+// to the league owning the resource they act on. Deadline RPCs are left unscoped because only the orchestrator calls them.
+func leagueResolvers(scoping *LeagueScoping) map[string]interceptors.LeagueResolver {
+	byLeague := interceptors.ResolveByField("league_id", leagueIdentity)
+	byDraft := interceptors.ResolveByField("draft_id", notFoundAware(scoping.Drafts.GetDraftLeagueID))
+	byPick := interceptors.ResolveByField("pick_id", notFoundAware(scoping.Picks.GetDraftPickLeagueID))
+	byFantasyTeam := interceptors.ResolveByField("fantasy_team_id", notFoundAware(scoping.FantasyTeams.GetFantasyTeamLeagueID))
+	byRosterEntry := interceptors.ResolveByField("id", notFoundAware(scoping.Roster.GetRosterPlayerLeagueID))
+	byThread := interceptors.ResolveByField("thread_id", notFoundAware(scoping.LeagueChat.GetThreadLeagueID))
+	byMessage := interceptors.ResolveByField("message_id", notFoundAware(scoping.LeagueChat.GetMessageLeagueID))
+	byListedPlayer := interceptors.ResolveByField("roster_player_id", notFoundAware(scoping.Roster.GetRosterPlayerLeagueID))
+	byDelegation := interceptors.ResolveByField("delegation_id", notFoundAware(scoping.FantasyTeams.GetTeamDelegationLeagueID))
+
+	return map[string]interceptors.LeagueResolver{
+		// Draft service
+		draftv1connect.DraftServiceCreateDraftProcedure:         byLeague,
+		draftv1connect.DraftServiceGetDraftProcedure:            byDraft,
+		draftv1connect.DraftServiceGetDraftSummaryProcedure:     byDraft,
+		draftv1connect.DraftServiceListDraftsForLeagueProcedure: byLeague,
+		draftv1connect.DraftServiceUpdateDraftProcedure:         byDraft,
+		draftv1connect.DraftServiceStartDraftProcedure:          byDraft,
+		draftv1connect.DraftServicePauseDraftProcedure:          byDraft,
+		draftv1connect.DraftServiceResumeDraftProcedure:         byDraft,
+		draftv1connect.DraftServiceCompleteDraftProcedure:       byDraft,
+		draftv1connect.DraftServiceDeleteDraftProcedure:         byDraft,
+		// Extending the pick clock is further limited to the commissioner by the draft service
+		draftv1connect.DraftServiceExtendPickClockProcedure: byDraft,
+		// Cloning a draft as a sandbox is further limited to the commissioner by the draft service
+		draftv1connect.DraftServiceCloneDraftAsSandboxProcedure: byDraft,
+		// Rescheduling is further limited to the commissioner by the draft service
+		draftv1connect.DraftServiceUpdateScheduledAtProcedure: byDraft,
+		// Chat reports and frame deliveries are filed by the gateway on behalf of a participant
+		draftv1connect.DraftServiceReportChatMessageProcedure:   byDraft,
+		draftv1connect.DraftServiceRecordFrameDeliveryProcedure: byDraft,
+		// Abandoning teams is further limited to the commissioner by the draft service
+		draftv1connect.DraftServiceAbandonTeamProcedure:        byDraft,
+		draftv1connect.DraftServiceRestoreTeamProcedure:        byDraft,
+		draftv1connect.DraftServiceListAbandonedTeamsProcedure: byDraft,
+		// Marking a team ready is further limited to its owner by the draft service
+		draftv1connect.DraftServiceSetTeamReadyProcedure:  byDraft,
+		draftv1connect.DraftServiceGetDraftLobbyProcedure: byDraft,
+		// Webhooks are further limited to the commissioner by the draft service
+		draftv1connect.DraftServiceCreateDraftWebhookProcedure: byDraft,
+		draftv1connect.DraftServiceListDraftWebhooksProcedure:  byDraft,
+		draftv1connect.DraftServiceDeleteDraftWebhookProcedure: byDraft,
+		// Choosing co-managers is further limited to the team's owner or the commissioner by the draft service
+		draftv1connect.DraftServiceAddDraftCoManagerProcedure:    byDraft,
+		draftv1connect.DraftServiceRemoveDraftCoManagerProcedure: byDraft,
+		draftv1connect.DraftServiceListDraftCoManagersProcedure:  byDraft,
+		// Voting is further limited to the managers of teams in the draft, and closing a vote
+		// to the commissioner, by the draft service
+		draftv1connect.DraftServiceStartPauseVoteProcedure: byDraft,
+		draftv1connect.DraftServiceCastPauseVoteProcedure:  byDraft,
+		draftv1connect.DraftServiceClosePauseVoteProcedure: byDraft,
+
+		// Draft pick service
+		draftv1connect.DraftPickServiceMakePickProcedure:                     byPick,
+		draftv1connect.DraftPickServiceMakeLatePickProcedure:                 byPick,
+		draftv1connect.DraftPickServiceSkipPickProcedure:                     byPick,
+		draftv1connect.DraftPickServiceSkipPickWithoutRosterSpaceProcedure:   byDraft,
+		draftv1connect.DraftPickServiceGetDraftPickProcedure:                 byPick,
+		draftv1connect.DraftPickServiceGetDraftPicksByDraftProcedure:         byDraft,
+		draftv1connect.DraftPickServiceGetDraftPicksByRoundProcedure:         byDraft,
+		draftv1connect.DraftPickServiceGetNextPickForDraftProcedure:          byDraft,
+		draftv1connect.DraftPickServiceCountRemainingPicksProcedure:          byDraft,
+		draftv1connect.DraftPickServiceClaimNextPickSlotProcedure:            byDraft,
+		draftv1connect.DraftPickServicePrepopulateDraftPicksProcedure:        byDraft,
+		draftv1connect.DraftPickServiceListAvailablePlayersForDraftProcedure: byDraft,
+		draftv1connect.DraftPickServiceGetPickSuggestionsProcedure:           byDraft,
+		draftv1connect.DraftPickServiceComparePlayersProcedure:               byDraft,
+		draftv1connect.DraftPickServiceSuggestQueueAdditionsProcedure:        byDraft,
+		draftv1connect.DraftPickServiceExportDraftResultsProcedure:           byDraft,
+		draftv1connect.DraftPickServiceUpdateDraftPickPlayerProcedure:        byPick,
+		draftv1connect.DraftPickServiceDeleteDraftPicksByDraftProcedure:      byDraft,
+		draftv1connect.DraftPickServiceReassignPickSlotProcedure:             byPick,
+		// Trading is further limited to the managers of the teams in the offer by the draft pick service
+		draftv1connect.DraftPickServiceProposePickTradeProcedure:   byDraft,
+		draftv1connect.DraftPickServiceRespondToPickTradeProcedure: byDraft,
+		draftv1connect.DraftPickServiceExpirePickTradeProcedure:    byDraft,
+		draftv1connect.DraftPickServiceReactToPickProcedure:        byDraft,
+		draftv1connect.DraftPickServiceListPickReactionsProcedure:  byDraft,
+
+		// Draft slot selection service
+		draftv1connect.DraftSlotSelectionServiceStartSlotSelectionProcedure: byDraft,
+		draftv1connect.DraftSlotSelectionServiceGetSlotSelectionProcedure:   byDraft,
+		draftv1connect.DraftSlotSelectionServiceClaimDraftSlotProcedure:     byDraft,
+
+		// Draft auction service
+		draftv1connect.DraftAuctionServiceGetAuctionProcedure:         byDraft,
+		draftv1connect.DraftAuctionServiceSetNominationQueueProcedure: byDraft,
+		draftv1connect.DraftAuctionServiceGetNominationQueueProcedure: byDraft,
+		draftv1connect.DraftAuctionServiceNominatePlayerProcedure:     byDraft,
+		draftv1connect.DraftAuctionServicePlaceProxyBidProcedure:      byDraft,
+		draftv1connect.DraftAuctionServiceGetAuctionHistoryProcedure:  byDraft,
+
+		// Draft expansion service. Inserting pick slots is further limited to the commissioner by the expansion service.
+		draftv1connect.DraftExpansionServiceInsertExpansionPickSlotsProcedure: byFantasyTeam,
+		draftv1connect.DraftExpansionServiceSubmitProtectionListProcedure:     byDraft,
+		draftv1connect.DraftExpansionServiceGetProtectionListProcedure:        byDraft,
+		draftv1connect.DraftExpansionServiceListProtectionListsProcedure:      byDraft,
+		draftv1connect.DraftExpansionServiceListExpansionPoolProcedure:        byDraft,
+
+		// Draft dispersal service. Creating and completing dispersals is further limited to the commissioner by the dispersal service.
+		draftv1connect.DraftDispersalServiceCreateDispersalDraftProcedure:   byFantasyTeam,
+		draftv1connect.DraftDispersalServiceGetDispersalDraftProcedure:      byDraft,
+		draftv1connect.DraftDispersalServiceListDispersalPoolProcedure:      byDraft,
+		draftv1connect.DraftDispersalServiceCompleteDispersalDraftProcedure: byDraft,
+
+		// Draft history service. Importing drafts is further limited to the commissioner by the draft history service.
+		draftv1connect.DraftHistoryServiceImportHistoricalDraftProcedure: byLeague,
+		draftv1connect.DraftHistoryServiceListHistoricalDraftsProcedure:  byLeague,
+
+		// Draft recap service
+		draftv1connect.DraftRecapServiceGetDraftRecapProcedure:         byDraft,
+		draftv1connect.DraftRecapServiceGetLeagueDraftSummaryProcedure: byLeague,
+
+		// Roster service
+		rosterv1connect.RosterServiceCreateRosterPlayerProcedure:                       byFantasyTeam,
+		rosterv1connect.RosterServiceGetRosterProcedure:                                byRosterEntry,
+		rosterv1connect.RosterServiceGetRosterPlayersByFantasyTeamProcedure:            byFantasyTeam,
+		rosterv1connect.RosterServiceGetRosterPlayersByFantasyTeamAndPositionProcedure: byFantasyTeam,
+		rosterv1connect.RosterServiceGetPlayerOnRosterProcedure:                        byFantasyTeam,
+		rosterv1connect.RosterServiceGetStartingRosterPlayersProcedure:                 byFantasyTeam,
+		rosterv1connect.RosterServiceGetBenchRosterPlayersProcedure:                    byFantasyTeam,
+		rosterv1connect.RosterServiceGetRosterPlayersByAcquisitionTypeProcedure:        byFantasyTeam,
+		rosterv1connect.RosterServiceUpdateRosterPlayerPositionProcedure:               byRosterEntry,
+		rosterv1connect.RosterServiceBatchUpdateLineupProcedure:                        byFantasyTeam,
+		rosterv1connect.RosterServiceAssignLineupSlotProcedure:                         byRosterEntry,
+		rosterv1connect.RosterServiceGetLineupSlotsProcedure:                           byFantasyTeam,
+		rosterv1connect.RosterServiceCheckLineupProcedure:                              byFantasyTeam,
+		rosterv1connect.RosterServiceGrantTaxiSquadExemptionProcedure:                  byRosterEntry,
+		rosterv1connect.RosterServiceRevokeTaxiSquadExemptionProcedure:                 byRosterEntry,
+		rosterv1connect.RosterServiceListTaxiSquadViolationsProcedure:                  byLeague,
+		rosterv1connect.RosterServiceSetRosterContractProcedure:                        byRosterEntry,
+		rosterv1connect.RosterServiceFranchiseTagPlayerProcedure:                       byRosterEntry,
+		rosterv1connect.RosterServiceGetCapSheetProcedure:                              byFantasyTeam,
+		rosterv1connect.RosterServiceUpdateRosterPlayerKeeperDataProcedure:             byRosterEntry,
+		rosterv1connect.RosterServiceUpdateRosterPositionAndKeeperDataProcedure:        byRosterEntry,
+		rosterv1connect.RosterServiceDeleteRosterEntryProcedure:                        byRosterEntry,
+		rosterv1connect.RosterServiceDeletePlayerFromRosterProcedure:                   byFantasyTeam,
+		rosterv1connect.RosterServiceDeleteTeamRosterProcedure:                         byFantasyTeam,
+
+		// League service
+		leaguev1connect.LeagueServiceGetSettingsHistoryProcedure: byLeague,
+		leaguev1connect.LeagueServiceListLeagueSeasonsProcedure:  byLeague,
+		// API keys and seasons are further limited to the commissioner by the league service
+		leaguev1connect.LeagueServiceCreateLeagueAPIKeyProcedure:   byLeague,
+		leaguev1connect.LeagueServiceListLeagueAPIKeysProcedure:    byLeague,
+		leaguev1connect.LeagueServiceRevokeLeagueAPIKeyProcedure:   byLeague,
+		leaguev1connect.LeagueServiceStartLeagueSeasonProcedure:    byLeague,
+		leaguev1connect.LeagueServiceCompleteLeagueSeasonProcedure: byLeague,
+
+		// Transaction service
+		transactionv1connect.TransactionServiceListLeagueTransactionsProcedure: byLeague,
+
+		// League chat service. Moderation is further limited to the commissioner, and deleting
+		// a message to its author or the commissioner, by the chat service.
+		leaguechatv1connect.ChatServiceCreateThreadProcedure:     byLeague,
+		leaguechatv1connect.ChatServiceGetThreadProcedure:        byThread,
+		leaguechatv1connect.ChatServiceListThreadsProcedure:      byLeague,
+		leaguechatv1connect.ChatServicePostMessageProcedure:      byThread,
+		leaguechatv1connect.ChatServiceListMessagesProcedure:     byThread,
+		leaguechatv1connect.ChatServiceDeleteMessageProcedure:    byMessage,
+		leaguechatv1connect.ChatServiceSetThreadPinnedProcedure:  byThread,
+		leaguechatv1connect.ChatServiceSetThreadLockedProcedure:  byThread,
+		leaguechatv1connect.ChatServiceDeleteThreadProcedure:     byThread,
+		leaguechatv1connect.ChatServiceSetMemberMutedProcedure:   byLeague,
+		leaguechatv1connect.ChatServiceListMutedMembersProcedure: byLeague,
+
+		// Trade block service. Listings and wishlists are further limited to the team's owner,
+		// and wishlists kept private to it, by the trade block service.
+		tradeblockv1connect.TradeBlockServiceAddToTradeBlockProcedure:      byListedPlayer,
+		tradeblockv1connect.TradeBlockServiceRemoveFromTradeBlockProcedure: byListedPlayer,
+		tradeblockv1connect.TradeBlockServiceListTradeBlockProcedure:       byLeague,
+		tradeblockv1connect.TradeBlockServiceAddWishlistPlayerProcedure:    byFantasyTeam,
+		tradeblockv1connect.TradeBlockServiceRemoveWishlistPlayerProcedure: byFantasyTeam,
+		tradeblockv1connect.TradeBlockServiceListWishlistProcedure:         byFantasyTeam,
+
+		// Treasury service. Changes are further limited to the commissioner by the treasury service.
+		treasuryv1connect.TreasuryServiceGetTreasuryProcedure:            byLeague,
+		treasuryv1connect.TreasuryServiceUpdateTreasurySettingsProcedure: byLeague,
+		treasuryv1connect.TreasuryServiceRecordDuesPaymentProcedure:      byFantasyTeam,
+		treasuryv1connect.TreasuryServiceRecordPayoutProcedure:           byFantasyTeam,
+		treasuryv1connect.TreasuryServiceListTreasuryEntriesProcedure:    byLeague,
+		treasuryv1connect.TreasuryServiceListTreasuryAuditProcedure:      byLeague,
+
+		// Schedule service. Previews and commits are further limited to the commissioner by the schedule service.
+		schedulev1connect.ScheduleServicePreviewScheduleProcedure: byLeague,
+		schedulev1connect.ScheduleServiceCommitScheduleProcedure:  byLeague,
+		schedulev1connect.ScheduleServiceGetScheduleProcedure:     byLeague,
+		schedulev1connect.ScheduleServiceGetStandingsProcedure:    byLeague,
+
+		// Media service. Team logos are further limited to the team's owner by the media service.
+		mediav1connect.MediaServiceUploadTeamLogoProcedure: byFantasyTeam,
+
+		// Fantasy team delegations. Delegating and revoking are further limited to the team's owner by the fantasy team service.
+		fantasyteamv1connect.FantasyTeamServiceCreateTeamDelegationProcedure:    byFantasyTeam,
+		fantasyteamv1connect.FantasyTeamServiceRevokeTeamDelegationProcedure:    byDelegation,
+		fantasyteamv1connect.FantasyTeamServiceListTeamDelegationsProcedure:     byFantasyTeam,
+		fantasyteamv1connect.FantasyTeamServiceListTeamDelegateActionsProcedure: byFantasyTeam,
+
+		// Web view service. Views call the services they're composed from directly, past these
+		// checks, so each is scoped to the league everything it reads belongs to.
+		webviewv1connect.WebViewServiceGetDraftRoomProcedure:  byDraft,
+		webviewv1connect.WebViewServiceGetLeagueHomeProcedure: byLeague,
+	}
+}
+
+// readOnlyPrefixes start the names of the league scoped RPCs that only read
+var readOnlyPrefixes = []string{"Get", "List", "Count", "Check", "Export", "Preview"}
+
+// readOnlyProcedures picks the RPCs in resolvers that only read, which members can still call
+// once their league is archived
+func readOnlyProcedures(resolvers map[string]interceptors.LeagueResolver) map[string]bool {
+	readOnly := make(map[string]bool)
+	for procedure := range resolvers {
+		method := procedure[strings.LastIndex(procedure, "/")+1:]
+		for _, prefix := range readOnlyPrefixes {
+			if strings.HasPrefix(method, prefix) {
+				readOnly[procedure] = true
+				break
+			}
+		}
+	}
+	return readOnly
+}
+
+// setupTenancyInterceptor scopes the RPCs in resolvers to members of the owning league, and
+// keeps members from changing archived leagues
+func setupTenancyInterceptor(scoping *LeagueScoping, resolvers map[string]interceptors.LeagueResolver) connect.Interceptor {
+	return interceptors.NewTenancyInterceptor(interceptors.TenancyConfig{
+		Checker:        scoping.Leagues,
+		Resolvers:      resolvers,
+		AllowAnonymous: getEnvAsBool("TENANCY_ALLOW_ANONYMOUS", false),
+		Archives:       scoping.Leagues,
+		ReadOnly:       readOnlyProcedures(resolvers),
+	})
+}
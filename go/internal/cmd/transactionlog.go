@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/mcdev12/dynasty/go/internal/transactions/draftfeed"
+)
+
+// setupTransactionLog creates the consumer that records draft picks and pick trades in the transaction log
+func setupTransactionLog(services *Services) (*draftfeed.Consumer, error) {
+	config := draftfeed.DefaultConfig()
+	config.NATS = natsconfig.NewConfigFromEnv()
+
+	consumer, err := draftfeed.NewConsumer(services.TransactionsApp, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transaction log consumer: %w", err)
+	}
+
+	return consumer, nil
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/mcdev12/dynasty/go/internal/treasury"
+)
+
+// mountStripeWebhook records dues paid through Stripe Checkout at /webhooks/stripe. It is only
+// served when STRIPE_WEBHOOK_SECRET, the endpoint's signing secret, is set.
+func mountStripeWebhook(mux *http.ServeMux, app *treasury.App) {
+	secret := os.Getenv("STRIPE_WEBHOOK_SECRET")
+	if secret == "" {
+		log.Printf("STRIPE_WEBHOOK_SECRET not set, dues paid through Stripe won't be recorded")
+		return
+	}
+	mux.Handle("/webhooks/stripe", treasury.NewStripeWebhookHandler(app, secret))
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+
+	draftdb "github.com/mcdev12/dynasty/go/internal/draft/draft/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/webhooks"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+)
+
+// setupDraftWebhooks creates the consumer that queues pick events for draft webhooks and the
+// dispatcher that posts them
+func setupDraftWebhooks(database *sql.DB) (*webhooks.Consumer, *webhooks.Dispatcher, error) {
+	config := webhooks.DefaultConfig()
+	config.NATS = natsconfig.NewConfigFromEnv()
+
+	consumer, err := webhooks.NewConsumer(draftdb.New(database), config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create draft webhook consumer: %w", err)
+	}
+
+	dispatcherConfig := webhooks.DefaultDispatcherConfig()
+	dispatcherConfig.AllowPrivateAddresses = getEnvAsBool("DRAFT_WEBHOOKS_ALLOW_PRIVATE_ADDRESSES", false)
+
+	return consumer, webhooks.NewDispatcher(database, dispatcherConfig), nil
+}
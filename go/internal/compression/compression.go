@@ -0,0 +1,215 @@
+package compression
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MinBytes is the smallest response body worth compressing. Below it the encoding overhead
+// and the CPU cost outweigh the bytes saved.
+const MinBytes = 1024
+
+// Encodings this package compresses with. Deflate is the zlib format, as HTTP defines it.
+const (
+	Gzip    = "gzip"
+	Deflate = "deflate"
+)
+
+var gzipWriters = sync.Pool{
+	New: func() any { return gzip.NewWriter(io.Discard) },
+}
+
+var zlibWriters = sync.Pool{
+	New: func() any { return zlib.NewWriter(io.Discard) },
+}
+
+// Middleware compresses responses with gzip or deflate, whichever the request's
+// Accept-Encoding prefers (gzip on a tie), once the body reaches MinBytes. Responses a handler
+// encoded itself, responses to HEAD requests and upgraded connections are passed through.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := Negotiate(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Method == http.MethodHead || r.Header.Get("Upgrade") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: w, encoding: encoding, status: http.StatusOK}
+		defer cw.close()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// Negotiate returns the encoding to compress a response with given the request's
+// Accept-Encoding, or "" to send it as it is
+func Negotiate(acceptEncoding string) string {
+	var best string
+	var bestQ float64
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != Gzip && coding != Deflate && coding != "*" {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if coding == "*" {
+			coding = Gzip
+		}
+		if q > bestQ || (q == bestQ && coding == Gzip) {
+			best, bestQ = coding, q
+		}
+	}
+	if bestQ <= 0 {
+		return ""
+	}
+	return best
+}
+
+// compressWriter holds a response's first MinBytes back, then either compresses it or, if
+// the response ends first, writes it as it is
+type compressWriter struct {
+	http.ResponseWriter
+	encoding string
+	status   int
+	buf      []byte
+
+	wroteHeader bool // the handler called WriteHeader
+	started     bool // the header went out to the client
+	encoder     io.WriteCloser
+}
+
+func (w *compressWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+
+	// Informational responses, and responses without a body, go out as they are
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified {
+		w.started = true
+		w.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.started {
+		if w.encoder != nil {
+			return w.encoder.Write(data)
+		}
+		return w.ResponseWriter.Write(data)
+	}
+
+	w.buf = append(w.buf, data...)
+	if len(w.buf) >= MinBytes {
+		if err := w.start(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+// start sends the header, compressing the rest of the response when compress is set and the
+// handler didn't encode it itself, and writes what was held back
+func (w *compressWriter) start(compress bool) error {
+	w.started = true
+
+	header := w.Header()
+	if compress && header.Get("Content-Encoding") == "" {
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			// The compressed bytes differ from the ones the strong tag was computed over
+			header.Set("ETag", "W/"+etag)
+		}
+		w.encoder = w.newEncoder()
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+
+	buf := w.buf
+	w.buf = nil
+	if w.encoder != nil {
+		_, err := w.encoder.Write(buf)
+		return err
+	}
+	_, err := w.ResponseWriter.Write(buf)
+	return err
+}
+
+func (w *compressWriter) newEncoder() io.WriteCloser {
+	if w.encoding == Deflate {
+		zw := zlibWriters.Get().(*zlib.Writer)
+		zw.Reset(w.ResponseWriter)
+		return &pooledEncoder{WriteCloser: zw, release: func() { zlibWriters.Put(zw) }}
+	}
+	gw := gzipWriters.Get().(*gzip.Writer)
+	gw.Reset(w.ResponseWriter)
+	return &pooledEncoder{WriteCloser: gw, release: func() { gzipWriters.Put(gw) }}
+}
+
+// close finishes the response: a body shorter than MinBytes is written as it is, and a
+// compressed one has its encoder flushed
+func (w *compressWriter) close() {
+	if !w.started {
+		_ = w.start(false)
+	}
+	if w.encoder != nil {
+		_ = w.encoder.Close()
+	}
+}
+
+// Flush sends what the handler wrote so far. A response flushed before reaching MinBytes
+// goes out uncompressed.
+func (w *compressWriter) Flush() {
+	if !w.started {
+		_ = w.start(len(w.buf) >= MinBytes)
+	}
+	if flusher, ok := w.encoder.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// pooledEncoder returns its gzip or zlib writer to its pool once closed
+type pooledEncoder struct {
+	io.WriteCloser
+	release func()
+}
+
+func (e *pooledEncoder) Flush() error {
+	if flusher, ok := e.WriteCloser.(interface{ Flush() error }); ok {
+		return flusher.Flush()
+	}
+	return nil
+}
+
+func (e *pooledEncoder) Close() error {
+	err := e.WriteCloser.Close()
+	e.release()
+	return err
+}
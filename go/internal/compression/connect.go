@@ -0,0 +1,49 @@
+package compression
+
+import (
+	"compress/zlib"
+	"io"
+
+	"connectrpc.com/connect"
+)
+
+// WithDeflate registers deflate with a Connect handler, alongside the gzip Connect supports
+// out of the box
+func WithDeflate() connect.HandlerOption {
+	return connect.WithCompression(Deflate,
+		func() connect.Decompressor { return &deflateReader{} },
+		func() connect.Compressor { return zlib.NewWriter(io.Discard) },
+	)
+}
+
+// deflateReader adapts a zlib reader to connect.Decompressor, whose Reset doesn't take a
+// dictionary
+type deflateReader struct {
+	reader io.ReadCloser
+}
+
+func (d *deflateReader) Read(p []byte) (int, error) {
+	if d.reader == nil {
+		return 0, io.EOF
+	}
+	return d.reader.Read(p)
+}
+
+func (d *deflateReader) Reset(src io.Reader) error {
+	if d.reader == nil {
+		reader, err := zlib.NewReader(src)
+		if err != nil {
+			return err
+		}
+		d.reader = reader
+		return nil
+	}
+	return d.reader.(zlib.Resetter).Reset(src, nil)
+}
+
+func (d *deflateReader) Close() error {
+	if d.reader == nil {
+		return nil
+	}
+	return d.reader.Close()
+}
@@ -0,0 +1,160 @@
+// Package connectclient builds Connect clients for service-to-service calls with
+// shared transport, timeout, retry and instrumentation settings.
+package connectclient
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/mcdev12/dynasty/go/internal/faults"
+	"golang.org/x/net/http2"
+)
+
+// Config configures a Factory
+type Config struct {
+	BaseURL string
+	// H2C speaks HTTP/2 over cleartext, matching the h2c server in cmd/server.go.
+	// Without it requests use HTTP/1.1, or HTTP/2 negotiated over TLS.
+	H2C bool
+	// DefaultTimeout bounds unary calls whose context has no deadline. Zero disables it.
+	DefaultTimeout time.Duration
+	// MethodTimeouts overrides DefaultTimeout per procedure, e.g. "/draft.v1.DraftService/GetDraft".
+	// Streaming calls are only bounded when their procedure is listed here.
+	MethodTimeouts map[string]time.Duration
+	// MaxRetries is how many times an idempotent unary call is retried after a transient failure
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry; it doubles on every attempt after that
+	RetryBackoff time.Duration
+	// ServiceName identifies this service to the server. Calls are signed with a service
+	// token when both ServiceName and ServiceSecret are set.
+	ServiceName string
+	// ServiceSecret is the secret shared with the server for signing service tokens
+	ServiceSecret []byte
+	// ServiceTokenTTL is how long each call's service token stays valid
+	ServiceTokenTTL time.Duration
+	// Faults fails calls on purpose for fault injection testing; nil injects nothing
+	Faults *faults.Injector
+}
+
+func DefaultConfig(baseURL string) Config {
+	return Config{
+		BaseURL:         baseURL,
+		H2C:             true,
+		DefaultTimeout:  30 * time.Second,
+		MethodTimeouts:  map[string]time.Duration{},
+		MaxRetries:      3,
+		RetryBackoff:    100 * time.Millisecond,
+		ServiceTokenTTL: time.Minute,
+	}
+}
+
+// ParseMethodTimeouts parses "procedure=duration" pairs separated by commas,
+// e.g. "/draft.v1.DraftPickService/MakePick=10s,/draft.v1.DraftService/GetDraft=2s"
+func ParseMethodTimeouts(s string) (map[string]time.Duration, error) {
+	timeouts := map[string]time.Duration{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		procedure, value, ok := strings.Cut(pair, "=")
+		if !ok || procedure == "" {
+			return nil, fmt.Errorf("invalid method timeout %q, want procedure=duration", pair)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid method timeout %q: %w", pair, err)
+		}
+		timeouts[strings.TrimSpace(procedure)] = d
+	}
+	return timeouts, nil
+}
+
+// Factory creates Connect clients that share one HTTP transport and interceptor chain
+type Factory struct {
+	config     Config
+	httpClient *http.Client
+	options    []connect.ClientOption
+}
+
+func NewFactory(cfg Config) *Factory {
+	chain := []connect.Interceptor{
+		newTimeoutInterceptor(cfg.DefaultTimeout, cfg.MethodTimeouts),
+		newRetryInterceptor(cfg.MaxRetries, cfg.RetryBackoff),
+		newLoggingInterceptor(),
+	}
+	if cfg.Faults != nil {
+		// Inside the retry interceptor, so injected failures are retried like real ones
+		chain = append(chain, cfg.Faults.Interceptor())
+	}
+	if cfg.ServiceName != "" && len(cfg.ServiceSecret) > 0 {
+		// Innermost, so every retry attempt carries a fresh token
+		chain = append(chain, newServiceTokenInterceptor(cfg.ServiceName, cfg.ServiceSecret, cfg.ServiceTokenTTL))
+	}
+
+	return &Factory{
+		config: cfg,
+		// No client-wide Timeout: it would cut off streaming calls. Deadlines are applied per call.
+		httpClient: &http.Client{Transport: newTransport(cfg.H2C)},
+		options: []connect.ClientOption{
+			connect.WithInterceptors(chain...),
+		},
+	}
+}
+
+// HTTPClient returns the shared HTTP client
+func (f *Factory) HTTPClient() connect.HTTPClient {
+	return f.httpClient
+}
+
+// BaseURL returns the URL clients are pointed at
+func (f *Factory) BaseURL() string {
+	return f.config.BaseURL
+}
+
+// Options returns the client options every client from this factory is built with
+func (f *Factory) Options() []connect.ClientOption {
+	return f.options
+}
+
+// Close releases idle connections held by the shared transport
+func (f *Factory) Close() {
+	f.httpClient.CloseIdleConnections()
+}
+
+// New builds a client with a generated constructor, e.g.
+//
+//	draftClient := connectclient.New(factory, draftv1connect.NewDraftServiceClient)
+//
+// extra options are applied after the factory's own.
+func New[T any](f *Factory, newClient func(connect.HTTPClient, string, ...connect.ClientOption) T, extra ...connect.ClientOption) T {
+	opts := make([]connect.ClientOption, 0, len(f.options)+len(extra))
+	opts = append(opts, f.options...)
+	opts = append(opts, extra...)
+	return newClient(f.httpClient, f.config.BaseURL, opts...)
+}
+
+func newTransport(h2c bool) http.RoundTripper {
+	if h2c {
+		return &http2.Transport{
+			// Prior-knowledge HTTP/2 over plain TCP
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, addr)
+			},
+			ReadIdleTimeout: 30 * time.Second,
+			PingTimeout:     10 * time.Second,
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = 32
+	return transport
+}
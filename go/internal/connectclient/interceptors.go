@@ -0,0 +1,204 @@
+package connectclient
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/rs/zerolog/log"
+)
+
+// timeoutInterceptor gives outgoing calls a deadline when the caller didn't set one
+type timeoutInterceptor struct {
+	defaultTimeout time.Duration
+	methodTimeouts map[string]time.Duration
+}
+
+func newTimeoutInterceptor(defaultTimeout time.Duration, methodTimeouts map[string]time.Duration) *timeoutInterceptor {
+	return &timeoutInterceptor{defaultTimeout: defaultTimeout, methodTimeouts: methodTimeouts}
+}
+
+func (i *timeoutInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		if _, ok := ctx.Deadline(); ok {
+			return next(ctx, req)
+		}
+
+		timeout := i.defaultTimeout
+		if t, ok := i.methodTimeouts[req.Spec().Procedure]; ok {
+			timeout = t
+		}
+		if timeout <= 0 {
+			return next(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return next(ctx, req)
+	}
+}
+
+func (i *timeoutInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		// Streams are long-lived, so they only get a deadline when one is configured for them
+		timeout, ok := i.methodTimeouts[spec.Procedure]
+		if !ok || timeout <= 0 {
+			return next(ctx, spec)
+		}
+		if _, ok := ctx.Deadline(); ok {
+			return next(ctx, spec)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		return &cancelOnCloseConn{StreamingClientConn: next(ctx, spec), cancel: cancel}
+	}
+}
+
+func (i *timeoutInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// cancelOnCloseConn releases a stream's deadline once the caller is done reading the response
+type cancelOnCloseConn struct {
+	connect.StreamingClientConn
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnCloseConn) CloseResponse() error {
+	err := c.StreamingClientConn.CloseResponse()
+	c.cancel()
+	return err
+}
+
+// newRetryInterceptor retries unary calls to methods declared idempotent (or free of
+// side effects) in their proto definition when the server is unavailable. Backoff
+// doubles per attempt with jitter and stops early if the call's context ends.
+func newRetryInterceptor(maxRetries int, backoff time.Duration) connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !req.Spec().IsClient || req.Spec().IdempotencyLevel == connect.IdempotencyUnknown {
+				return next(ctx, req)
+			}
+
+			for attempt := 0; ; attempt++ {
+				resp, err := next(ctx, req)
+				if err == nil || attempt >= maxRetries || connect.CodeOf(err) != connect.CodeUnavailable {
+					return resp, err
+				}
+
+				delay := backoff << attempt
+				if delay > 1 {
+					delay += rand.N(delay / 2)
+				}
+				log.Warn().
+					Err(err).
+					Str("procedure", req.Spec().Procedure).
+					Int("attempt", attempt+1).
+					Dur("backoff", delay).
+					Msg("rpc unavailable, retrying")
+
+				select {
+				case <-ctx.Done():
+					return nil, err
+				case <-time.After(delay):
+				}
+			}
+		}
+	}
+	return connect.UnaryInterceptorFunc(interceptor)
+}
+
+// loggingInterceptor records the outcome and latency of every outgoing call attempt
+type loggingInterceptor struct{}
+
+func newLoggingInterceptor() *loggingInterceptor {
+	return &loggingInterceptor{}
+}
+
+func (loggingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := next(ctx, req)
+		elapsed := time.Since(start)
+
+		if err != nil {
+			// Application errors such as NotFound are the caller's to handle; only
+			// infrastructure failures are worth a warning
+			event := log.Debug()
+			switch connect.CodeOf(err) {
+			case connect.CodeUnavailable, connect.CodeDeadlineExceeded, connect.CodeInternal, connect.CodeUnknown:
+				event = log.Warn()
+			}
+			event.
+				Err(err).
+				Str("procedure", req.Spec().Procedure).
+				Str("code", connect.CodeOf(err).String()).
+				Dur("duration", elapsed).
+				Msg("rpc failed")
+			return resp, err
+		}
+
+		log.Debug().
+			Str("procedure", req.Spec().Procedure).
+			Dur("duration", elapsed).
+			Msg("rpc completed")
+		return resp, nil
+	}
+}
+
+func (loggingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		log.Debug().Str("procedure", spec.Procedure).Msg("rpc stream opened")
+		return next(ctx, spec)
+	}
+}
+
+func (loggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// serviceTokenInterceptor signs every outgoing call with a short-lived token naming this
+// service, which the server's service auth interceptor verifies
+type serviceTokenInterceptor struct {
+	service string
+	secret  []byte
+	ttl     time.Duration
+}
+
+func newServiceTokenInterceptor(service string, secret []byte, ttl time.Duration) *serviceTokenInterceptor {
+	return &serviceTokenInterceptor{service: service, secret: secret, ttl: ttl}
+}
+
+func (i *serviceTokenInterceptor) token() string {
+	return interceptors.SignServiceToken(i.secret, i.service, time.Now().Add(i.ttl))
+}
+
+func (i *serviceTokenInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			req.Header().Set(interceptors.ServiceTokenHeader, i.token())
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *serviceTokenInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		conn.RequestHeader().Set(interceptors.ServiceTokenHeader, i.token())
+		return conn
+	}
+}
+
+func (i *serviceTokenInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 // Config holds Postgres connection settings.
@@ -14,6 +15,12 @@ type Config struct {
 	Password string
 	Database string
 	SSLMode  string
+	// SlowQueryThreshold is how long a query runs before it is logged as slow; 0 disables the log
+	SlowQueryThreshold time.Duration
+	// ReplicaHost and ReplicaPort locate a streaming read replica, reached with the primary's
+	// credentials. Without a host every query runs on the primary.
+	ReplicaHost string
+	ReplicaPort int
 }
 
 // NewConfigFromEnv reads DB_* environment variables (with defaults).
@@ -22,6 +29,14 @@ func NewConfigFromEnv() Config {
 	if err != nil {
 		port = 5432
 	}
+	slowQueryMs, err := strconv.Atoi(getEnv("DB_SLOW_QUERY_MS", "250"))
+	if err != nil {
+		slowQueryMs = 250
+	}
+	replicaPort, err := strconv.Atoi(getEnv("DB_REPLICA_PORT", strconv.Itoa(port)))
+	if err != nil {
+		replicaPort = port
+	}
 
 	return Config{
 		Host:     getEnv("DB_HOST", "localhost"),
@@ -30,6 +45,11 @@ func NewConfigFromEnv() Config {
 		Password: getEnv("DB_PASSWORD", "postgres"),
 		Database: getEnv("DB_NAME", "dynasty"),
 		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+
+		SlowQueryThreshold: time.Duration(slowQueryMs) * time.Millisecond,
+
+		ReplicaHost: os.Getenv("DB_REPLICA_HOST"),
+		ReplicaPort: replicaPort,
 	}
 }
 
@@ -41,6 +61,17 @@ func (c Config) DSN() string {
 	)
 }
 
+// ReplicaDSN returns the read replica's connection URL, or "" when no replica is configured.
+func (c Config) ReplicaDSN() string {
+	if c.ReplicaHost == "" {
+		return ""
+	}
+	return fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=%s",
+		c.User, c.Password, c.ReplicaHost, c.ReplicaPort, c.Database, c.SSLMode,
+	)
+}
+
 func getEnv(key, fallback string) string {
 	if v := os.Getenv(key); v != "" {
 		return v
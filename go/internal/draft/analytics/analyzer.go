@@ -0,0 +1,246 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/draft/pick"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// PickSource reads a draft's board and the players still available in it
+type PickSource interface {
+	ListDraftResults(ctx context.Context, draftID uuid.UUID) ([]pick.DraftResult, error)
+	ListRankedAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUID, format *models.ScoringFormat) ([]pick.AvailablePlayer, *pick.RankingProfile, error)
+}
+
+// Publisher queues a draft's analytics for its room
+type Publisher interface {
+	InsertDraftAnalyticsUpdatedEvent(ctx context.Context, draftID uuid.UUID, payload events.DraftAnalyticsUpdatedPayload) error
+}
+
+// AnalyzerConfig sets what counts as a positional run
+type AnalyzerConfig struct {
+	// RunWindow is how many of the latest picks are looked at for runs
+	RunWindow int
+	// RunMinPicks is how many of them must take the same position for it to be a run
+	RunMinPicks int
+}
+
+// DefaultAnalyzerConfig returns the run thresholds used unless configured otherwise
+func DefaultAnalyzerConfig() AnalyzerConfig {
+	return AnalyzerConfig{
+		RunWindow:   6,
+		RunMinPicks: 4,
+	}
+}
+
+// Analyzer works out the live analytics of a draft room after each pick: the board's heatmap
+// of positions by round, how scarce each position has become and any positional runs. They're
+// advisory, so they're worked out from the board as it stands rather than kept up to date
+// pick by pick.
+type Analyzer struct {
+	picks     PickSource
+	publisher Publisher
+	config    AnalyzerConfig
+}
+
+// NewAnalyzer creates a new draft analyzer
+func NewAnalyzer(picks PickSource, publisher Publisher, config AnalyzerConfig) *Analyzer {
+	return &Analyzer{
+		picks:     picks,
+		publisher: publisher,
+		config:    config,
+	}
+}
+
+// AnalyzePick works out a draft's analytics as of one of its picks and queues them for its room
+func (a *Analyzer) AnalyzePick(ctx context.Context, draftID uuid.UUID, made events.PickMadePayload) error {
+	results, err := a.picks.ListDraftResults(ctx, draftID)
+	if errors.Is(err, pick.ErrNoDraftPicks) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	available, _, err := a.picks.ListRankedAvailablePlayersForDraft(ctx, draftID, nil)
+	if err != nil {
+		return err
+	}
+
+	payload := events.DraftAnalyticsUpdatedPayload{
+		DraftID:     draftID.String(),
+		PickID:      made.PickID,
+		OverallPick: made.OverallPick,
+		Heatmap:     heatmap(results),
+		Scarcity:    scarcity(results, available),
+		Runs:        a.runs(results, made.OverallPick),
+		AnalyzedAt:  time.Now(),
+	}
+
+	return a.publisher.InsertDraftAnalyticsUpdatedEvent(ctx, draftID, payload)
+}
+
+// heatmap counts the picks made at each position in each round
+func heatmap(results []pick.DraftResult) []events.PositionRoundCount {
+	type cell struct {
+		round    int
+		position string
+	}
+	counts := make(map[cell]int)
+	for _, result := range results {
+		if result.PlayerID == nil || result.PlayerPosition == "" {
+			continue
+		}
+		counts[cell{result.Round, result.PlayerPosition}]++
+	}
+
+	cells := make([]events.PositionRoundCount, 0, len(counts))
+	for c, count := range counts {
+		cells = append(cells, events.PositionRoundCount{Round: c.round, Position: c.position, Count: count})
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i].Round != cells[j].Round {
+			return cells[i].Round < cells[j].Round
+		}
+		return cells[i].Position < cells[j].Position
+	})
+	return cells
+}
+
+// scarcity counts the players drafted at each position, and how many of the best ranked
+// available players play it, taking as many players as there are picks left. Players the
+// rankings don't cover aren't counted as remaining.
+func scarcity(results []pick.DraftResult, available []pick.AvailablePlayer) []events.PositionScarcity {
+	drafted := make(map[string]int)
+	picksLeft := 0
+	for _, result := range results {
+		if result.PlayerID == nil {
+			picksLeft++
+			continue
+		}
+		if result.PlayerPosition != "" {
+			drafted[result.PlayerPosition]++
+		}
+	}
+
+	remaining := make(map[string]int)
+	taken := 0
+	for _, player := range available {
+		if taken == picksLeft {
+			break
+		}
+		if player.Rank == nil {
+			continue
+		}
+		taken++
+		if player.Position != "" {
+			remaining[player.Position]++
+		}
+	}
+
+	positions := make(map[string]bool, len(drafted)+len(remaining))
+	for position := range drafted {
+		positions[position] = true
+	}
+	for position := range remaining {
+		positions[position] = true
+	}
+
+	scarcity := make([]events.PositionScarcity, 0, len(positions))
+	for position := range positions {
+		scarcity = append(scarcity, events.PositionScarcity{
+			Position:  position,
+			Drafted:   drafted[position],
+			Remaining: remaining[position],
+		})
+	}
+	sort.Slice(scarcity, func(i, j int) bool {
+		return scarcity[i].Position < scarcity[j].Position
+	})
+	return scarcity
+}
+
+// runs finds the positional runs as of the pick with the given overall number, in the order
+// picks were made so late picks count when they happened. Keeper picks aren't choices made
+// during the draft, so they're left out.
+func (a *Analyzer) runs(results []pick.DraftResult, overallPick int) []events.PositionalRun {
+	var made []pick.DraftResult
+	for _, result := range results {
+		if result.PlayerID != nil && !result.KeeperPick {
+			made = append(made, result)
+		}
+	}
+	sort.SliceStable(made, func(i, j int) bool {
+		if made[i].PickedAt != nil && made[j].PickedAt != nil && !made[i].PickedAt.Equal(*made[j].PickedAt) {
+			return made[i].PickedAt.Before(*made[j].PickedAt)
+		}
+		return made[i].OverallPick < made[j].OverallPick
+	})
+
+	// Only look as far as the analyzed pick, in case later ones were made before it was analyzed
+	for i, result := range made {
+		if result.OverallPick == overallPick {
+			made = made[:i+1]
+			break
+		}
+	}
+	if len(made) == 0 {
+		return nil
+	}
+
+	runs := a.runsIn(made)
+	before := make(map[string]bool)
+	for _, run := range a.runsIn(made[:len(made)-1]) {
+		before[run.Position] = true
+	}
+	for i := range runs {
+		runs[i].New = !before[runs[i].Position]
+	}
+	return runs
+}
+
+// runsIn returns the positions taken by at least the run threshold of the latest picks, most
+// picks first
+func (a *Analyzer) runsIn(made []pick.DraftResult) []events.PositionalRun {
+	window := made[max(0, len(made)-a.config.RunWindow):]
+	if len(window) < a.config.RunMinPicks {
+		return nil
+	}
+
+	counts := make(map[string]int)
+	startedAt := make(map[string]int)
+	for _, result := range window {
+		if result.PlayerPosition == "" {
+			continue
+		}
+		if counts[result.PlayerPosition] == 0 {
+			startedAt[result.PlayerPosition] = result.OverallPick
+		}
+		counts[result.PlayerPosition]++
+	}
+
+	var runs []events.PositionalRun
+	for position, count := range counts {
+		if count < a.config.RunMinPicks {
+			continue
+		}
+		runs = append(runs, events.PositionalRun{
+			Position:  position,
+			Picks:     count,
+			Window:    len(window),
+			StartedAt: startedAt[position],
+		})
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		if runs[i].Picks != runs[j].Picks {
+			return runs[i].Picks > runs[j].Picks
+		}
+		return runs[i].Position < runs[j].Position
+	})
+	return runs
+}
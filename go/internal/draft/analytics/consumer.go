@@ -0,0 +1,225 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+)
+
+// Config holds configuration for the draft analytics consumer
+type Config struct {
+	NATS          natsconfig.Config
+	StreamName    string
+	ConsumerName  string
+	SubjectFilter string        // Only PickMade events are needed
+	MaxDeliver    int           // Max delivery attempts
+	AckWait       time.Duration // How long to wait for ack
+	MaxAckPending int           // Max messages pending ack
+	// MaxAge is how old a pick may be and still be analyzed; analytics only help the room live,
+	// so picks from before a backlog are skipped rather than replayed
+	MaxAge        time.Duration
+	MaxReconnects int
+	ReconnectWait time.Duration
+}
+
+// DefaultConfig returns default draft analytics consumer configuration
+func DefaultConfig() Config {
+	return Config{
+		NATS:          natsconfig.Default(),
+		StreamName:    events.StateStream,
+		ConsumerName:  "draft-analytics",
+		SubjectFilter: events.Subject(events.PickMade),
+		MaxDeliver:    3,
+		AckWait:       30 * time.Second,
+		MaxAckPending: 100,
+		MaxAge:        2 * time.Minute,
+		MaxReconnects: -1, // Infinite
+		ReconnectWait: 2 * time.Second,
+	}
+}
+
+// Consumer analyzes each draft's board as its picks are made
+type Consumer struct {
+	analyzer *Analyzer
+	nc       *nats.Conn
+	js       jetstream.JetStream
+	consumer jetstream.Consumer
+	config   Config
+}
+
+// NewConsumer connects to NATS and creates or binds the durable draft analytics consumer
+func NewConsumer(analyzer *Analyzer, config Config) (*Consumer, error) {
+	opts := []nats.Option{
+		nats.MaxReconnects(config.MaxReconnects),
+		nats.ReconnectWait(config.ReconnectWait),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			log.Error().Err(err).Msg("NATS disconnected")
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Info().Str("url", nc.ConnectedUrl()).Msg("NATS reconnected")
+		}),
+	}
+
+	nc, err := config.NATS.Connect(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("create JetStream context: %w", err)
+	}
+
+	c := &Consumer{
+		analyzer: analyzer,
+		nc:       nc,
+		js:       js,
+		config:   config,
+	}
+
+	if err := c.ensureConsumer(context.Background()); err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("ensure consumer: %w", err)
+	}
+
+	return c, nil
+}
+
+// ensureConsumer creates or gets the JetStream consumer
+func (c *Consumer) ensureConsumer(ctx context.Context) error {
+	stream, err := c.js.Stream(ctx, c.config.StreamName)
+	if err != nil {
+		return fmt.Errorf("get stream: %w", err)
+	}
+
+	consumerConfig := jetstream.ConsumerConfig{
+		Name:          c.config.ConsumerName,
+		Durable:       c.config.ConsumerName,
+		Description:   "Draft analytics consumer pushing heatmaps and positional runs to draft rooms",
+		FilterSubject: c.config.SubjectFilter,
+		DeliverPolicy: jetstream.DeliverNewPolicy, // Analytics only matter live
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		MaxDeliver:    c.config.MaxDeliver,
+		AckWait:       c.config.AckWait,
+		MaxAckPending: c.config.MaxAckPending,
+		ReplayPolicy:  jetstream.ReplayInstantPolicy,
+	}
+
+	consumer, err := stream.Consumer(ctx, c.config.ConsumerName)
+	if err != nil {
+		consumer, err = stream.CreateConsumer(ctx, consumerConfig)
+		if err != nil {
+			return fmt.Errorf("create consumer: %w", err)
+		}
+		log.Info().
+			Str("consumer", c.config.ConsumerName).
+			Str("stream", c.config.StreamName).
+			Msg("created JetStream consumer")
+	} else {
+		log.Info().
+			Str("consumer", c.config.ConsumerName).
+			Str("stream", c.config.StreamName).
+			Msg("using existing JetStream consumer")
+	}
+
+	c.consumer = consumer
+	return nil
+}
+
+// Start consumes PickMade events until ctx is cancelled
+func (c *Consumer) Start(ctx context.Context) error {
+	log.Info().
+		Str("consumer", c.config.ConsumerName).
+		Str("stream", c.config.StreamName).
+		Msg("starting draft analytics consumer")
+
+	messageCh := make(chan jetstream.Msg, 100)
+
+	consumeCtx, err := c.consumer.Consume(func(msg jetstream.Msg) {
+		select {
+		case messageCh <- msg:
+		case <-ctx.Done():
+			msg.Nak()
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("start consumer: %w", err)
+	}
+	defer consumeCtx.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("draft analytics consumer shutting down")
+			return nil
+		case msg := <-messageCh:
+			if err := c.processMessage(ctx, msg); err != nil {
+				log.Error().
+					Err(err).
+					Str("subject", msg.Subject()).
+					Msg("failed to analyze pick")
+				if nakErr := msg.Nak(); nakErr != nil {
+					log.Error().Err(nakErr).Msg("failed to NAK message")
+				}
+				continue
+			}
+			if ackErr := msg.Ack(); ackErr != nil {
+				log.Error().Err(ackErr).Msg("failed to ACK message")
+			}
+		}
+	}
+}
+
+// processMessage analyzes a draft as of the pick made, skipping picks too old to matter to the room
+func (c *Consumer) processMessage(ctx context.Context, msg jetstream.Msg) error {
+	var envelope struct {
+		EventID   string          `json:"eventId"`
+		EventType string          `json:"eventType"`
+		DraftID   string          `json:"draftId"`
+		Timestamp time.Time       `json:"timestamp"`
+		Payload   json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(msg.Data(), &envelope); err != nil {
+		return fmt.Errorf("unmarshal event envelope: %w", err)
+	}
+
+	if envelope.EventType != events.PickMade {
+		return nil
+	}
+	if time.Since(envelope.Timestamp) > c.config.MaxAge {
+		log.Debug().
+			Str("draft_id", envelope.DraftID).
+			Time("timestamp", envelope.Timestamp).
+			Msg("skipping stale pick")
+		return nil
+	}
+
+	draftID, err := uuid.Parse(envelope.DraftID)
+	if err != nil {
+		return nil // nothing to analyze, and redelivering won't change that
+	}
+
+	var payload events.PickMadePayload
+	if err := json.Unmarshal(envelope.Payload, &payload); err != nil {
+		return fmt.Errorf("unmarshal PickMade payload: %w", err)
+	}
+
+	return c.analyzer.AnalyzePick(ctx, draftID, payload)
+}
+
+// Close closes the NATS connection
+func (c *Consumer) Close() error {
+	if c.nc != nil {
+		c.nc.Close()
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// AuctionRepository defines what the auction app layer needs from the repository
+type AuctionRepository interface {
+	GetAuction(ctx context.Context, draftID uuid.UUID) (*models.DraftAuction, error)
+	StartAuction(ctx context.Context, draftID uuid.UUID) (*models.DraftAuction, error)
+	SetNominationQueue(ctx context.Context, req SetNominationQueueRequest) error
+	GetNominationQueue(ctx context.Context, draftID, fantasyTeamID uuid.UUID) ([]uuid.UUID, error)
+	NominatePlayer(ctx context.Context, req NominatePlayerRequest) (*models.AuctionLot, error)
+	PlaceProxyBid(ctx context.Context, req PlaceProxyBidRequest) (*BidResult, error)
+	SellExpiredLot(ctx context.Context, draftID uuid.UUID) (*SaleResult, error)
+	ExpireNominationTurn(ctx context.Context, draftID uuid.UUID) (*NominationTurnResult, error)
+	ListUnstartedAuctionDrafts(ctx context.Context, limit int32) ([]uuid.UUID, error)
+	ListExpiredAuctionLots(ctx context.Context, limit int32) ([]uuid.UUID, error)
+	ListExpiredNominationTurns(ctx context.Context, limit int32) ([]uuid.UUID, error)
+	GetAuctionHistory(ctx context.Context, draftID uuid.UUID, playerID *uuid.UUID) ([]LotHistory, error)
+}
+
+// App handles auction draft business logic
+type App struct {
+	repo AuctionRepository
+}
+
+// NewApp creates a new auction App
+func NewApp(repo AuctionRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// GetAuction retrieves the auction state of a draft
+func (a *App) GetAuction(ctx context.Context, draftID uuid.UUID) (*models.DraftAuction, error) {
+	auction, err := a.repo.GetAuction(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auction: %w", err)
+	}
+	return auction, nil
+}
+
+// SetNominationQueue replaces the players a team nominates, in order, when it doesn't
+// nominate before its turn runs out
+func (a *App) SetNominationQueue(ctx context.Context, req SetNominationQueueRequest) error {
+	if err := a.validateSetNominationQueueRequest(req); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	if err := a.repo.SetNominationQueue(ctx, req); err != nil {
+		return fmt.Errorf("failed to set nomination queue: %w", err)
+	}
+
+	log.Printf("Team %s queued %d nominations in draft %s", req.FantasyTeamID, len(req.PlayerIDs), req.DraftID)
+	return nil
+}
+
+// GetNominationQueue retrieves a team's nomination queue in order
+func (a *App) GetNominationQueue(ctx context.Context, draftID, fantasyTeamID uuid.UUID) ([]uuid.UUID, error) {
+	playerIDs, err := a.repo.GetNominationQueue(ctx, draftID, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nomination queue: %w", err)
+	}
+	return playerIDs, nil
+}
+
+// NominatePlayer puts a player up for auction for the team on the clock
+func (a *App) NominatePlayer(ctx context.Context, req NominatePlayerRequest) (*models.AuctionLot, error) {
+	if err := a.validateNominatePlayerRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	lot, err := a.repo.NominatePlayer(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to nominate player: %w", err)
+	}
+
+	log.Printf("Team %s nominated player %s in draft %s at %.2f", req.FantasyTeamID, lot.PlayerID, req.DraftID, lot.CurrentPrice)
+	return lot, nil
+}
+
+// PlaceProxyBid sets the most a team will pay for the player up for auction
+func (a *App) PlaceProxyBid(ctx context.Context, req PlaceProxyBidRequest) (*BidResult, error) {
+	if err := a.validatePlaceProxyBidRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	result, err := a.repo.PlaceProxyBid(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to place proxy bid: %w", err)
+	}
+
+	log.Printf("Team %s bid on lot %s in draft %s; price %.2f, leader %s", req.FantasyTeamID, result.Lot.ID, req.DraftID, result.Lot.CurrentPrice, result.Lot.LeadingTeamID)
+	return result, nil
+}
+
+// GetAuctionHistory lists a draft's lots, or only the given player's, in the order they were
+// opened, each with every bid placed on it
+func (a *App) GetAuctionHistory(ctx context.Context, draftID uuid.UUID, playerID *uuid.UUID) ([]LotHistory, error) {
+	history, err := a.repo.GetAuctionHistory(ctx, draftID, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auction history: %w", err)
+	}
+	return history, nil
+}
+
+// StartPendingAuctions puts the first nominating team on the clock in auction drafts that
+// have started. Auctions another caller started first are skipped.
+func (a *App) StartPendingAuctions(ctx context.Context, limit int32) ([]models.DraftAuction, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than 0")
+	}
+
+	draftIDs, err := a.repo.ListUnstartedAuctionDrafts(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unstarted auction drafts: %w", err)
+	}
+
+	var results []models.DraftAuction
+	for _, draftID := range draftIDs {
+		auction, err := a.repo.StartAuction(ctx, draftID)
+		if err != nil {
+			log.Printf("Failed to start auction for draft %s: %v", draftID, err)
+			continue
+		}
+		if auction == nil {
+			continue
+		}
+
+		log.Printf("Started auction for draft %s", draftID)
+		results = append(results, *auction)
+	}
+
+	return results, nil
+}
+
+// SellExpiredLots sells every lot whose bidding clock has run out to its leading team.
+// Lots that received a bid while being processed are skipped.
+func (a *App) SellExpiredLots(ctx context.Context, limit int32) ([]SaleResult, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than 0")
+	}
+
+	draftIDs, err := a.repo.ListExpiredAuctionLots(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired auction lots: %w", err)
+	}
+
+	var results []SaleResult
+	for _, draftID := range draftIDs {
+		result, err := a.repo.SellExpiredLot(ctx, draftID)
+		if err != nil {
+			if errors.Is(err, ErrLotNotExpired) || errors.Is(err, ErrNoOpenLot) || errors.Is(err, ErrAuctionNotInProgress) {
+				continue
+			}
+			log.Printf("Failed to sell expired lot for draft %s: %v", draftID, err)
+			continue
+		}
+
+		log.Printf("Sold player %s to team %s for %.2f in draft %s", result.Lot.PlayerID, result.Lot.LeadingTeamID, result.Lot.CurrentPrice, draftID)
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
+
+// ExpireNominationTurns nominates from the queue of every team whose nomination turn has
+// run out, or passes the turn on when the team has nothing it can nominate. Turns that
+// moved on while being processed are skipped.
+func (a *App) ExpireNominationTurns(ctx context.Context, limit int32) ([]NominationTurnResult, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than 0")
+	}
+
+	draftIDs, err := a.repo.ListExpiredNominationTurns(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired nomination turns: %w", err)
+	}
+
+	var results []NominationTurnResult
+	for _, draftID := range draftIDs {
+		result, err := a.repo.ExpireNominationTurn(ctx, draftID)
+		if err != nil {
+			if errors.Is(err, ErrNominationNotExpired) || errors.Is(err, ErrLotAlreadyOpen) || errors.Is(err, ErrAuctionNotInProgress) {
+				continue
+			}
+			log.Printf("Failed to expire nomination turn for draft %s: %v", draftID, err)
+			continue
+		}
+
+		if result.Lot != nil {
+			log.Printf("Auto-nominated player %s for team %s in draft %s", result.Lot.PlayerID, result.FantasyTeamID, draftID)
+		} else {
+			log.Printf("Team %s forfeited its nomination turn in draft %s", result.FantasyTeamID, draftID)
+		}
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
+
+// Validation methods
+
+// validateSetNominationQueueRequest validates set nomination queue request
+func (a *App) validateSetNominationQueueRequest(req SetNominationQueueRequest) error {
+	if req.DraftID == uuid.Nil {
+		return fmt.Errorf("draft_id is required")
+	}
+	if req.FantasyTeamID == uuid.Nil {
+		return fmt.Errorf("fantasy_team_id is required")
+	}
+	seen := make(map[uuid.UUID]bool, len(req.PlayerIDs))
+	for _, playerID := range req.PlayerIDs {
+		if playerID == uuid.Nil {
+			return fmt.Errorf("player_ids cannot contain an empty player id")
+		}
+		if seen[playerID] {
+			return fmt.Errorf("player %s appears more than once in player_ids", playerID)
+		}
+		seen[playerID] = true
+	}
+	return nil
+}
+
+// validateNominatePlayerRequest validates nominate player request
+func (a *App) validateNominatePlayerRequest(req NominatePlayerRequest) error {
+	if req.DraftID == uuid.Nil {
+		return fmt.Errorf("draft_id is required")
+	}
+	if req.FantasyTeamID == uuid.Nil {
+		return fmt.Errorf("fantasy_team_id is required")
+	}
+	if req.PlayerID != nil && *req.PlayerID == uuid.Nil {
+		return fmt.Errorf("player_id cannot be empty")
+	}
+	if req.OpeningBid < 0 {
+		return fmt.Errorf("%w: opening_bid cannot be negative", ErrBidTooLow)
+	}
+	if req.MaxBid != 0 && req.MaxBid < req.OpeningBid {
+		return fmt.Errorf("%w: max_bid cannot be less than opening_bid", ErrBidTooLow)
+	}
+	return nil
+}
+
+// validatePlaceProxyBidRequest validates place proxy bid request
+func (a *App) validatePlaceProxyBidRequest(req PlaceProxyBidRequest) error {
+	if req.DraftID == uuid.Nil {
+		return fmt.Errorf("draft_id is required")
+	}
+	if req.FantasyTeamID == uuid.Nil {
+		return fmt.Errorf("fantasy_team_id is required")
+	}
+	if req.MaxAmount <= 0 {
+		return fmt.Errorf("%w: max_amount must be greater than 0", ErrBidTooLow)
+	}
+	return nil
+}
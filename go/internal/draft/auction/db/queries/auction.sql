@@ -0,0 +1,212 @@
+-- name: AssignAuctionPick :exec
+UPDATE draft_picks
+SET player_id      = $2,
+    auction_amount = $3,
+    picked_at      = NOW()
+WHERE id = $1;
+
+-- name: CreateAuctionLot :one
+INSERT INTO auction_lots (draft_id, player_id, nominated_by, current_price, leading_team_id, ends_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: DeleteNominationQueue :exec
+DELETE FROM auction_nomination_queue WHERE draft_id = $1 AND fantasy_team_id = $2;
+
+-- name: EnsureDraftAuction :execrows
+INSERT INTO draft_auctions (draft_id, nomination_deadline)
+VALUES ($1, $2)
+ON CONFLICT (draft_id) DO NOTHING;
+
+-- name: GetAuctionDraft :one
+SELECT draft_type::text AS draft_type, status::text AS status, settings
+FROM draft
+WHERE id = $1;
+
+-- name: GetDraftAuction :one
+SELECT * FROM draft_auctions WHERE draft_id = $1;
+
+-- name: GetDraftAuctionForUpdate :one
+-- Locks the auction so nominations, bids and sales in a draft are applied one at a time.
+SELECT * FROM draft_auctions WHERE draft_id = $1 FOR UPDATE;
+
+-- name: GetNextOpenPickForTeam :one
+-- The team's earliest pick without a player, which a player it wins is drafted with.
+SELECT * FROM draft_picks
+WHERE draft_id = $1
+  AND team_id = $2
+  AND player_id IS NULL
+  AND NOT forfeited
+ORDER BY overall_pick
+LIMIT 1;
+
+-- name: GetNextQueuedNomination :one
+-- The first player in a team's nomination queue that hasn't been nominated or drafted.
+SELECT q.player_id FROM auction_nomination_queue q
+WHERE q.draft_id = $1
+  AND q.fantasy_team_id = $2
+  AND NOT EXISTS (SELECT 1 FROM auction_lots l WHERE l.draft_id = q.draft_id AND l.player_id = q.player_id)
+  AND NOT EXISTS (SELECT 1 FROM draft_picks p WHERE p.draft_id = q.draft_id AND p.player_id = q.player_id)
+ORDER BY q.position
+LIMIT 1;
+
+-- name: GetOpenAuctionLot :one
+SELECT * FROM auction_lots WHERE draft_id = $1 AND status = 'OPEN';
+
+-- name: GetProxyBid :one
+SELECT * FROM auction_proxy_bids WHERE lot_id = $1 AND fantasy_team_id = $2;
+
+-- name: GetTeamAuctionBudget :one
+-- What a team has spent so far and how many roster spots it still has to fill.
+SELECT COALESCE(SUM(auction_amount), 0)::float8 AS spent,
+       COUNT(*) FILTER (WHERE player_id IS NULL AND NOT forfeited) AS open_picks
+FROM draft_picks
+WHERE draft_id = $1
+  AND team_id = $2;
+
+-- name: GetTeamAuctionPayroll :one
+-- The league settings an auction draft runs under and what a team pays its roster: the
+-- salaries of its players, how many of them have no salary, and its winning bids for players who
+-- haven't reached the roster yet.
+SELECT COALESCE(d.league_settings_snapshot, l.league_settings) AS league_settings,
+       (SELECT COALESCE(SUM(rp.salary), 0)
+        FROM roster_players rp
+        WHERE rp.fantasy_team_id = sqlc.arg('team_id'))::bigint AS salaries,
+       (SELECT COUNT(*)
+        FROM roster_players rp
+        WHERE rp.fantasy_team_id = sqlc.arg('team_id')
+          AND rp.salary IS NULL) AS unsigned_players,
+       (SELECT COALESCE(SUM(dp.auction_amount), 0)
+        FROM draft_picks dp
+        WHERE dp.draft_id = d.id
+          AND dp.team_id = sqlc.arg('team_id')
+          AND dp.player_id IS NOT NULL
+          AND NOT EXISTS (
+              SELECT 1
+              FROM roster_players rp
+              WHERE rp.fantasy_team_id = dp.team_id
+                AND rp.player_id = dp.player_id
+          ))::float8 AS pending_bids
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE d.id = sqlc.arg('draft_id');
+
+-- name: InsertAuctionBid :exec
+INSERT INTO auction_bids (lot_id, draft_id, player_id, fantasy_team_id, kind, max_amount, price, leading_team_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: InsertNominationQueueEntry :exec
+INSERT INTO auction_nomination_queue (draft_id, fantasy_team_id, player_id, position)
+VALUES ($1, $2, $3, $4);
+
+-- name: IsPlayerUnavailable :one
+-- Whether a player has already been nominated or drafted in the draft.
+SELECT EXISTS (SELECT 1 FROM auction_lots l WHERE l.draft_id = $1 AND l.player_id = $2)
+    OR EXISTS (SELECT 1 FROM draft_picks p WHERE p.draft_id = $1 AND p.player_id = $2) AS unavailable;
+
+-- name: ListAuctionBids :many
+-- Bids placed in a draft, or only on a player's lot, in the order they were placed.
+SELECT * FROM auction_bids
+WHERE draft_id = sqlc.arg('draft_id')
+  AND (sqlc.narg('player_id')::uuid IS NULL OR player_id = sqlc.narg('player_id'))
+ORDER BY id;
+
+-- name: ListAuctionLots :many
+-- Lots of a draft, or only a player's, in the order they were opened.
+SELECT * FROM auction_lots
+WHERE draft_id = sqlc.arg('draft_id')
+  AND (sqlc.narg('player_id')::uuid IS NULL OR player_id = sqlc.narg('player_id'))
+ORDER BY opened_at, id;
+
+-- name: ListExpiredAuctionLots :many
+-- Drafts whose open lot's clock has run out, oldest first. Paused drafts are left alone.
+SELECT l.draft_id FROM auction_lots l
+JOIN draft d ON d.id = l.draft_id
+WHERE l.status = 'OPEN'
+  AND l.ends_at <= NOW()
+  AND d.status = 'IN_PROGRESS'
+ORDER BY l.ends_at
+LIMIT $1;
+
+-- name: ListExpiredNominationTurns :many
+-- Drafts whose nominating team let its turn run out, oldest first. Paused drafts are left alone.
+SELECT a.draft_id FROM draft_auctions a
+JOIN draft d ON d.id = a.draft_id
+WHERE a.nomination_deadline <= NOW()
+  AND d.status = 'IN_PROGRESS'
+ORDER BY a.nomination_deadline
+LIMIT $1;
+
+-- name: ListNominationQueue :many
+SELECT player_id FROM auction_nomination_queue
+WHERE draft_id = $1
+  AND fantasy_team_id = $2
+ORDER BY position;
+
+-- name: ListProxyBids :many
+SELECT * FROM auction_proxy_bids WHERE lot_id = $1 ORDER BY max_amount DESC, placed_at;
+
+-- name: ListTeamsWithOpenPicks :many
+SELECT DISTINCT team_id FROM draft_picks WHERE draft_id = $1 AND player_id IS NULL AND NOT forfeited;
+
+-- name: ListUnstartedAuctionDrafts :many
+-- Auction drafts that are running but whose nomination turns haven't started.
+SELECT d.id FROM draft d
+LEFT JOIN draft_auctions a ON a.draft_id = d.id
+WHERE d.draft_type = 'AUCTION'
+  AND d.status = 'IN_PROGRESS'
+  AND a.draft_id IS NULL
+ORDER BY d.started_at
+LIMIT $1;
+
+-- name: RefreshPlayerAuctionValue :exec
+-- Recomputes a player's average winning bid in the season of the draft's league from the lots
+-- sold in that season's auction drafts, each scaled to a $200 budget. Sandbox drafts don't count.
+INSERT INTO player_auction_values (season, player_id, average_price, sales, updated_at)
+SELECT l.season,
+       al.player_id,
+       ROUND(AVG(al.current_price * 200 / COALESCE(NULLIF((d.settings -> 'auction' ->> 'budget_per_team')::numeric, 0), 200)), 2),
+       COUNT(*),
+       NOW()
+FROM auction_lots al
+JOIN draft d ON d.id = al.draft_id
+JOIN leagues l ON l.id = d.league_id
+WHERE al.player_id = sqlc.arg('player_id')
+  AND al.status = 'SOLD'
+  AND d.sandbox_of_draft_id IS NULL
+  AND l.season = (SELECT sl.season FROM draft sd JOIN leagues sl ON sl.id = sd.league_id WHERE sd.id = sqlc.arg('draft_id'))
+GROUP BY l.season, al.player_id
+ON CONFLICT (season, player_id) DO UPDATE
+SET average_price = EXCLUDED.average_price,
+    sales         = EXCLUDED.sales,
+    updated_at    = NOW();
+
+-- name: SellAuctionLot :one
+UPDATE auction_lots
+SET status  = 'SOLD',
+    sold_at = NOW(),
+    pick_id = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateAuctionLotPrice :one
+UPDATE auction_lots
+SET current_price   = $2,
+    leading_team_id = $3,
+    ends_at         = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateNominationTurn :one
+UPDATE draft_auctions
+SET nomination_turn     = $2,
+    nomination_deadline = $3
+WHERE draft_id = $1
+RETURNING *;
+
+-- name: UpsertProxyBid :exec
+INSERT INTO auction_proxy_bids (lot_id, fantasy_team_id, max_amount)
+VALUES ($1, $2, $3)
+ON CONFLICT (lot_id, fantasy_team_id) DO UPDATE
+SET max_amount = EXCLUDED.max_amount,
+    updated_at = NOW();
@@ -0,0 +1,888 @@
+package auction
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/auction/db"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+type Repository struct {
+	queries *db.Queries
+	sqlDB   *sql.DB
+}
+
+func NewRepository(queries *db.Queries, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: queries,
+		sqlDB:   sqlDB,
+	}
+}
+
+// auctionSettings are the auction fields of a draft's settings with defaults applied
+type auctionSettings struct {
+	draftOrder        []uuid.UUID
+	budgetPerTeam     float64
+	minBidIncrement   float64
+	timePerNomination time.Duration
+}
+
+// lockedAuction is an auction locked for the rest of a transaction, along with its draft
+type lockedAuction struct {
+	qtx      *db.Queries
+	auction  db.DraftAuction
+	status   models.DraftStatus
+	settings auctionSettings
+}
+
+func (r *Repository) GetAuction(ctx context.Context, draftID uuid.UUID) (*models.DraftAuction, error) {
+	dbAuction, err := r.queries.GetDraftAuction(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := r.queries.GetAuctionDraft(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft: %w", err)
+	}
+	settings, err := r.parseAuctionSettings(draft.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.loadAuction(ctx, r.queries, dbAuction, settings)
+}
+
+func (r *Repository) ListUnstartedAuctionDrafts(ctx context.Context, limit int32) ([]uuid.UUID, error) {
+	return r.queries.ListUnstartedAuctionDrafts(ctx, limit)
+}
+
+func (r *Repository) ListExpiredAuctionLots(ctx context.Context, limit int32) ([]uuid.UUID, error) {
+	return r.queries.ListExpiredAuctionLots(ctx, limit)
+}
+
+func (r *Repository) ListExpiredNominationTurns(ctx context.Context, limit int32) ([]uuid.UUID, error) {
+	return r.queries.ListExpiredNominationTurns(ctx, limit)
+}
+
+// StartAuction puts the first team in the draft order with an open pick on the clock to
+// nominate. It returns nil when another caller already started the auction.
+func (r *Repository) StartAuction(ctx context.Context, draftID uuid.UUID) (*models.DraftAuction, error) {
+	var result *models.DraftAuction
+
+	err := sqlutil.Run(ctx, r.sqlDB, func(tx *sql.Tx) *db.Queries { return r.queries.WithTx(tx) }, func(qtx *db.Queries) error {
+		draft, err := qtx.GetAuctionDraft(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("failed to get draft: %w", err)
+		}
+		if models.DraftType(draft.DraftType) != models.DraftTypeAuction {
+			return ErrNotAuctionDraft
+		}
+		settings, err := r.parseAuctionSettings(draft.Settings)
+		if err != nil {
+			return err
+		}
+
+		deadline := time.Now().Add(settings.timePerNomination)
+		created, err := qtx.EnsureDraftAuction(ctx, db.EnsureDraftAuctionParams{
+			DraftID:            draftID,
+			NominationDeadline: sqlutil.ToSqlTime(&deadline),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create draft auction: %w", err)
+		}
+		if created == 0 {
+			return nil
+		}
+
+		turn, _, err := r.nextNominationTurn(ctx, qtx, draftID, settings, 0)
+		if err != nil {
+			return err
+		}
+		dbAuction, err := qtx.UpdateNominationTurn(ctx, db.UpdateNominationTurnParams{
+			DraftID:            draftID,
+			NominationTurn:     int32(turn),
+			NominationDeadline: sqlutil.ToSqlTime(&deadline),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set nomination turn: %w", err)
+		}
+
+		result, err = r.loadAuction(ctx, qtx, dbAuction, settings)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SetNominationQueue replaces a team's nomination queue
+func (r *Repository) SetNominationQueue(ctx context.Context, req SetNominationQueueRequest) error {
+	return sqlutil.Run(ctx, r.sqlDB, func(tx *sql.Tx) *db.Queries { return r.queries.WithTx(tx) }, func(qtx *db.Queries) error {
+		if err := qtx.DeleteNominationQueue(ctx, db.DeleteNominationQueueParams{
+			DraftID:       req.DraftID,
+			FantasyTeamID: req.FantasyTeamID,
+		}); err != nil {
+			return fmt.Errorf("failed to clear nomination queue: %w", err)
+		}
+
+		for i, playerID := range req.PlayerIDs {
+			if err := qtx.InsertNominationQueueEntry(ctx, db.InsertNominationQueueEntryParams{
+				DraftID:       req.DraftID,
+				FantasyTeamID: req.FantasyTeamID,
+				PlayerID:      playerID,
+				Position:      int32(i + 1),
+			}); err != nil {
+				return fmt.Errorf("failed to queue player %s: %w", playerID, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *Repository) GetNominationQueue(ctx context.Context, draftID, fantasyTeamID uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.ListNominationQueue(ctx, db.ListNominationQueueParams{
+		DraftID:       draftID,
+		FantasyTeamID: fantasyTeamID,
+	})
+}
+
+// NominatePlayer puts a player up for auction for the team on the clock, with the team
+// leading at the opening bid
+func (r *Repository) NominatePlayer(ctx context.Context, req NominatePlayerRequest) (*models.AuctionLot, error) {
+	var result *models.AuctionLot
+
+	err := r.withAuction(ctx, req.DraftID, func(la *lockedAuction) error {
+		if la.status != models.DraftStatusInProgress {
+			return ErrAuctionNotInProgress
+		}
+		if err := r.ensureNoOpenLot(ctx, la); err != nil {
+			return err
+		}
+		teamID, err := r.nominatingTeam(ctx, la)
+		if err != nil {
+			return err
+		}
+		if teamID == nil || *teamID != req.FantasyTeamID {
+			return ErrNotTeamsNomination
+		}
+
+		var playerID uuid.UUID
+		if req.PlayerID != nil {
+			unavailable, err := la.qtx.IsPlayerUnavailable(ctx, db.IsPlayerUnavailableParams{
+				DraftID:  req.DraftID,
+				PlayerID: *req.PlayerID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to check player availability: %w", err)
+			}
+			if unavailable {
+				return ErrPlayerUnavailable
+			}
+			playerID = *req.PlayerID
+		} else {
+			playerID, err = la.qtx.GetNextQueuedNomination(ctx, db.GetNextQueuedNominationParams{
+				DraftID:       req.DraftID,
+				FantasyTeamID: req.FantasyTeamID,
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrNominationQueueEmpty
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get next queued nomination: %w", err)
+			}
+		}
+
+		openingBid := req.OpeningBid
+		if openingBid == 0 {
+			openingBid = la.settings.minBidIncrement
+		}
+		if openingBid < la.settings.minBidIncrement {
+			return fmt.Errorf("%w: opening bid must be at least %.2f", ErrBidTooLow, la.settings.minBidIncrement)
+		}
+		maxAllowed, err := r.maxAllowedBid(ctx, la, req.FantasyTeamID)
+		if err != nil {
+			return err
+		}
+		if openingBid > maxAllowed || req.MaxBid > maxAllowed {
+			return fmt.Errorf("%w: the most this team can bid is %.2f", ErrOverBudget, maxAllowed)
+		}
+
+		result, err = r.openLot(ctx, la, req.FantasyTeamID, playerID, openingBid, req.MaxBid)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// PlaceProxyBid records the most a team will pay for the open lot and re-prices the lot.
+// The leading team pays one increment more than the runner-up's maximum, capped at its
+// own; of two equal maximums the one placed first wins. The lot's clock restarts whenever
+// its price or leader changes.
+func (r *Repository) PlaceProxyBid(ctx context.Context, req PlaceProxyBidRequest) (*BidResult, error) {
+	var result *BidResult
+
+	err := r.withAuction(ctx, req.DraftID, func(la *lockedAuction) error {
+		if la.status != models.DraftStatusInProgress {
+			return ErrAuctionNotInProgress
+		}
+		dbLot, err := la.qtx.GetOpenAuctionLot(ctx, req.DraftID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNoOpenLot
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get open lot: %w", err)
+		}
+		if !dbLot.EndsAt.After(time.Now()) {
+			return ErrLotClosed
+		}
+
+		maxAmount := roundAmount(req.MaxAmount)
+		maxAllowed, err := r.maxAllowedBid(ctx, la, req.FantasyTeamID)
+		if err != nil {
+			return err
+		}
+		if maxAmount > maxAllowed {
+			return fmt.Errorf("%w: the most this team can bid is %.2f", ErrOverBudget, maxAllowed)
+		}
+
+		price, err := parseAmount(dbLot.CurrentPrice)
+		if err != nil {
+			return err
+		}
+		if dbLot.LeadingTeamID != req.FantasyTeamID {
+			if minBid := roundAmount(price + la.settings.minBidIncrement); maxAmount < minBid {
+				return fmt.Errorf("%w: bid must be at least %.2f", ErrBidTooLow, minBid)
+			}
+		}
+		existing, err := la.qtx.GetProxyBid(ctx, db.GetProxyBidParams{
+			LotID:         dbLot.ID,
+			FantasyTeamID: req.FantasyTeamID,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to get proxy bid: %w", err)
+		}
+		if err == nil {
+			previous, err := parseAmount(existing.MaxAmount)
+			if err != nil {
+				return err
+			}
+			if maxAmount <= previous {
+				return fmt.Errorf("%w: a maximum bid can only be raised above %.2f", ErrBidTooLow, previous)
+			}
+		}
+
+		if err := la.qtx.UpsertProxyBid(ctx, db.UpsertProxyBidParams{
+			LotID:         dbLot.ID,
+			FantasyTeamID: req.FantasyTeamID,
+			MaxAmount:     formatAmount(maxAmount),
+		}); err != nil {
+			return fmt.Errorf("failed to place proxy bid: %w", err)
+		}
+
+		dbBids, err := la.qtx.ListProxyBids(ctx, dbLot.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list proxy bids: %w", err)
+		}
+		bids, err := r.dbProxyBidsToModel(dbBids)
+		if err != nil {
+			return err
+		}
+
+		newPrice, leader := resolveProxyBids(bids, price, dbLot.LeadingTeamID, la.settings.minBidIncrement)
+		if err := r.recordBid(ctx, la.qtx, dbLot, req.FantasyTeamID, models.AuctionBidKindProxy, maxAmount, newPrice, leader); err != nil {
+			return err
+		}
+		changed := newPrice != price || leader != dbLot.LeadingTeamID
+		if changed {
+			dbLot, err = la.qtx.UpdateAuctionLotPrice(ctx, db.UpdateAuctionLotPriceParams{
+				ID:            dbLot.ID,
+				CurrentPrice:  formatAmount(newPrice),
+				LeadingTeamID: leader,
+				EndsAt:        time.Now().Add(la.settings.timePerNomination),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update lot price: %w", err)
+			}
+		}
+
+		lot, err := r.dbAuctionLotToModel(dbLot)
+		if err != nil {
+			return err
+		}
+		result = &BidResult{Lot: *lot, Changed: changed}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SellExpiredLot drafts the open lot's player with the leading team's earliest open pick
+// at the current price and puts the next team in the draft order on the clock to nominate
+func (r *Repository) SellExpiredLot(ctx context.Context, draftID uuid.UUID) (*SaleResult, error) {
+	var result *SaleResult
+
+	err := r.withAuction(ctx, draftID, func(la *lockedAuction) error {
+		if la.status != models.DraftStatusInProgress {
+			return ErrAuctionNotInProgress
+		}
+		dbLot, err := la.qtx.GetOpenAuctionLot(ctx, draftID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNoOpenLot
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get open lot: %w", err)
+		}
+		if dbLot.EndsAt.After(time.Now()) {
+			return ErrLotNotExpired
+		}
+
+		dbPick, err := la.qtx.GetNextOpenPickForTeam(ctx, db.GetNextOpenPickForTeamParams{
+			DraftID: draftID,
+			TeamID:  dbLot.LeadingTeamID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRosterFull
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get winner's open pick: %w", err)
+		}
+
+		if err := la.qtx.AssignAuctionPick(ctx, db.AssignAuctionPickParams{
+			ID:            dbPick.ID,
+			PlayerID:      uuid.NullUUID{UUID: dbLot.PlayerID, Valid: true},
+			AuctionAmount: sql.NullString{String: dbLot.CurrentPrice, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to assign pick: %w", err)
+		}
+		dbLot, err = la.qtx.SellAuctionLot(ctx, db.SellAuctionLotParams{
+			ID:     dbLot.ID,
+			PickID: uuid.NullUUID{UUID: dbPick.ID, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to sell lot: %w", err)
+		}
+		if err := la.qtx.RefreshPlayerAuctionValue(ctx, db.RefreshPlayerAuctionValueParams{
+			PlayerID: dbLot.PlayerID,
+			DraftID:  draftID,
+		}); err != nil {
+			return fmt.Errorf("failed to refresh player auction value: %w", err)
+		}
+
+		auction, err := r.advanceNominationTurn(ctx, la)
+		if err != nil {
+			return err
+		}
+		lot, err := r.dbAuctionLotToModel(dbLot)
+		if err != nil {
+			return err
+		}
+
+		price, err := parseAmount(dbLot.CurrentPrice)
+		if err != nil {
+			return err
+		}
+		pickedAt := time.Now()
+		if dbLot.SoldAt.Valid {
+			pickedAt = dbLot.SoldAt.Time
+		}
+		result = &SaleResult{
+			Lot: *lot,
+			Pick: models.DraftPick{
+				ID:            dbPick.ID,
+				DraftID:       dbPick.DraftID,
+				Round:         int(dbPick.Round),
+				Pick:          int(dbPick.Pick),
+				OverallPick:   int(dbPick.OverallPick),
+				TeamID:        dbPick.TeamID,
+				PlayerID:      &dbLot.PlayerID,
+				PickedAt:      &pickedAt,
+				AuctionAmount: &price,
+				KeeperPick:    dbPick.KeeperPick.Bool,
+			},
+			Auction: auction,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ExpireNominationTurn nominates the first available player in the queue of a team that
+// let its turn run out, opening at the minimum bid. A team with nothing queued that it can
+// afford forfeits its turn to the next team.
+func (r *Repository) ExpireNominationTurn(ctx context.Context, draftID uuid.UUID) (*NominationTurnResult, error) {
+	var result *NominationTurnResult
+
+	err := r.withAuction(ctx, draftID, func(la *lockedAuction) error {
+		if la.status != models.DraftStatusInProgress {
+			return ErrAuctionNotInProgress
+		}
+		deadline := sqlutil.FromSqlTime(la.auction.NominationDeadline)
+		if deadline == nil || deadline.After(time.Now()) {
+			return ErrNominationNotExpired
+		}
+		if err := r.ensureNoOpenLot(ctx, la); err != nil {
+			return err
+		}
+		teamID, err := r.nominatingTeam(ctx, la)
+		if err != nil {
+			return err
+		}
+		if teamID == nil {
+			return ErrRosterFull
+		}
+		result = &NominationTurnResult{FantasyTeamID: *teamID}
+
+		playerID, err := la.qtx.GetNextQueuedNomination(ctx, db.GetNextQueuedNominationParams{
+			DraftID:       draftID,
+			FantasyTeamID: *teamID,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to get next queued nomination: %w", err)
+		}
+		if err == nil {
+			maxAllowed, err := r.maxAllowedBid(ctx, la, *teamID)
+			if err != nil {
+				return err
+			}
+			if maxAllowed >= la.settings.minBidIncrement {
+				lot, err := r.openLot(ctx, la, *teamID, playerID, la.settings.minBidIncrement, 0)
+				if err != nil {
+					return err
+				}
+				result.Lot = lot
+				result.Auction, err = r.loadAuction(ctx, la.qtx, la.auction, la.settings)
+				return err
+			}
+		}
+
+		result.Auction, err = r.advanceNominationTurn(ctx, la)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAuctionHistory lists the draft's lots, or only the given player's, in the order they
+// were opened, each with the bids placed on it
+func (r *Repository) GetAuctionHistory(ctx context.Context, draftID uuid.UUID, playerID *uuid.UUID) ([]LotHistory, error) {
+	draft, err := r.queries.GetAuctionDraft(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft: %w", err)
+	}
+	if models.DraftType(draft.DraftType) != models.DraftTypeAuction {
+		return nil, ErrNotAuctionDraft
+	}
+
+	dbLots, err := r.queries.ListAuctionLots(ctx, db.ListAuctionLotsParams{
+		DraftID:  draftID,
+		PlayerID: sqlutil.ToNullUUID(playerID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lots: %w", err)
+	}
+	dbBids, err := r.queries.ListAuctionBids(ctx, db.ListAuctionBidsParams{
+		DraftID:  draftID,
+		PlayerID: sqlutil.ToNullUUID(playerID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bids: %w", err)
+	}
+
+	bidsByLot := make(map[uuid.UUID][]models.AuctionBid)
+	for _, dbBid := range dbBids {
+		bid, err := r.dbAuctionBidToModel(dbBid)
+		if err != nil {
+			return nil, err
+		}
+		bidsByLot[bid.LotID] = append(bidsByLot[bid.LotID], *bid)
+	}
+
+	history := make([]LotHistory, len(dbLots))
+	for i, dbLot := range dbLots {
+		lot, err := r.dbAuctionLotToModel(dbLot)
+		if err != nil {
+			return nil, err
+		}
+		history[i] = LotHistory{Lot: *lot, Bids: bidsByLot[lot.ID]}
+	}
+	return history, nil
+}
+
+// recordBid appends a bid to its lot's history along with the price and leader it left the lot at
+func (r *Repository) recordBid(ctx context.Context, q *db.Queries, lot db.AuctionLot, teamID uuid.UUID, kind models.AuctionBidKind, maxAmount, price float64, leader uuid.UUID) error {
+	if err := q.InsertAuctionBid(ctx, db.InsertAuctionBidParams{
+		LotID:         lot.ID,
+		DraftID:       lot.DraftID,
+		PlayerID:      lot.PlayerID,
+		FantasyTeamID: teamID,
+		Kind:          string(kind),
+		MaxAmount:     formatAmount(maxAmount),
+		Price:         formatAmount(price),
+		LeadingTeamID: leader,
+	}); err != nil {
+		return fmt.Errorf("failed to record bid: %w", err)
+	}
+	return nil
+}
+
+// withAuction locks the draft's auction and runs fn in the same transaction
+func (r *Repository) withAuction(ctx context.Context, draftID uuid.UUID, fn func(la *lockedAuction) error) error {
+	return sqlutil.Run(ctx, r.sqlDB, func(tx *sql.Tx) *db.Queries { return r.queries.WithTx(tx) }, func(qtx *db.Queries) error {
+		dbAuction, err := qtx.GetDraftAuctionForUpdate(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("failed to get draft auction: %w", err)
+		}
+		draft, err := qtx.GetAuctionDraft(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("failed to get draft: %w", err)
+		}
+		if models.DraftType(draft.DraftType) != models.DraftTypeAuction {
+			return ErrNotAuctionDraft
+		}
+		settings, err := r.parseAuctionSettings(draft.Settings)
+		if err != nil {
+			return err
+		}
+
+		return fn(&lockedAuction{
+			qtx:      qtx,
+			auction:  dbAuction,
+			status:   models.DraftStatus(draft.Status),
+			settings: settings,
+		})
+	})
+}
+
+// openLot opens bidding on a player with the nominating team leading at the opening bid.
+// The nominating team's maximum is its opening bid unless it set a higher one.
+func (r *Repository) openLot(ctx context.Context, la *lockedAuction, teamID, playerID uuid.UUID, openingBid, maxBid float64) (*models.AuctionLot, error) {
+	dbLot, err := la.qtx.CreateAuctionLot(ctx, db.CreateAuctionLotParams{
+		DraftID:       la.auction.DraftID,
+		PlayerID:      playerID,
+		NominatedBy:   teamID,
+		CurrentPrice:  formatAmount(openingBid),
+		LeadingTeamID: teamID,
+		EndsAt:        time.Now().Add(la.settings.timePerNomination),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lot: %w", err)
+	}
+
+	maxAmount := math.Max(openingBid, maxBid)
+	if err := la.qtx.UpsertProxyBid(ctx, db.UpsertProxyBidParams{
+		LotID:         dbLot.ID,
+		FantasyTeamID: teamID,
+		MaxAmount:     formatAmount(maxAmount),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to place opening bid: %w", err)
+	}
+	if err := r.recordBid(ctx, la.qtx, dbLot, teamID, models.AuctionBidKindOpening, maxAmount, openingBid, teamID); err != nil {
+		return nil, err
+	}
+
+	// The nomination clock stops while the player is up for auction
+	la.auction, err = la.qtx.UpdateNominationTurn(ctx, db.UpdateNominationTurnParams{
+		DraftID:        la.auction.DraftID,
+		NominationTurn: la.auction.NominationTurn,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to stop nomination clock: %w", err)
+	}
+
+	return r.dbAuctionLotToModel(dbLot)
+}
+
+// advanceNominationTurn puts the next team in the draft order with an open pick on the
+// clock, or stops the nomination clock once every roster is full
+func (r *Repository) advanceNominationTurn(ctx context.Context, la *lockedAuction) (*models.DraftAuction, error) {
+	turn, teamID, err := r.nextNominationTurn(ctx, la.qtx, la.auction.DraftID, la.settings, int(la.auction.NominationTurn)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	var deadline *time.Time
+	if teamID != nil {
+		next := time.Now().Add(la.settings.timePerNomination)
+		deadline = &next
+	}
+	la.auction, err = la.qtx.UpdateNominationTurn(ctx, db.UpdateNominationTurnParams{
+		DraftID:            la.auction.DraftID,
+		NominationTurn:     int32(turn),
+		NominationDeadline: sqlutil.ToSqlTime(deadline),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to advance nomination turn: %w", err)
+	}
+
+	return r.loadAuction(ctx, la.qtx, la.auction, la.settings)
+}
+
+// nextNominationTurn finds the first team with an open pick in the draft order, starting at
+// turn and wrapping around. The team is nil once every roster is full.
+func (r *Repository) nextNominationTurn(ctx context.Context, q *db.Queries, draftID uuid.UUID, settings auctionSettings, turn int) (int, *uuid.UUID, error) {
+	teams, err := q.ListTeamsWithOpenPicks(ctx, draftID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to list teams with open picks: %w", err)
+	}
+	open := make(map[uuid.UUID]bool, len(teams))
+	for _, teamID := range teams {
+		open[teamID] = true
+	}
+
+	order := settings.draftOrder
+	for i := 0; i < len(order); i++ {
+		idx := (turn + i) % len(order)
+		if open[order[idx]] {
+			return idx, &order[idx], nil
+		}
+	}
+	return turn % len(order), nil, nil
+}
+
+// nominatingTeam returns the team whose turn it is to nominate, or nil once every roster is full
+func (r *Repository) nominatingTeam(ctx context.Context, la *lockedAuction) (*uuid.UUID, error) {
+	turn, teamID, err := r.nextNominationTurn(ctx, la.qtx, la.auction.DraftID, la.settings, int(la.auction.NominationTurn))
+	if err != nil {
+		return nil, err
+	}
+	if teamID == nil || turn != int(la.auction.NominationTurn) {
+		return nil, nil
+	}
+	return teamID, nil
+}
+
+// ensureNoOpenLot returns ErrLotAlreadyOpen while a player is up for auction
+func (r *Repository) ensureNoOpenLot(ctx context.Context, la *lockedAuction) error {
+	_, err := la.qtx.GetOpenAuctionLot(ctx, la.auction.DraftID)
+	if err == nil {
+		return ErrLotAlreadyOpen
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to get open lot: %w", err)
+	}
+	return nil
+}
+
+// maxAllowedBid is the most a team can bid while keeping the minimum bid for each of its
+// other open picks, both within its auction budget and, in salary cap leagues, under the cap
+// with the rest of its payroll. A winning bid becomes the player's salary.
+func (r *Repository) maxAllowedBid(ctx context.Context, la *lockedAuction, teamID uuid.UUID) (float64, error) {
+	budget, err := la.qtx.GetTeamAuctionBudget(ctx, db.GetTeamAuctionBudgetParams{
+		DraftID: la.auction.DraftID,
+		TeamID:  teamID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get team budget: %w", err)
+	}
+	if budget.OpenPicks == 0 {
+		return 0, ErrRosterFull
+	}
+
+	reserved := float64(budget.OpenPicks-1) * la.settings.minBidIncrement
+	maxBid := roundAmount(la.settings.budgetPerTeam - budget.Spent - reserved)
+
+	payroll, err := la.qtx.GetTeamAuctionPayroll(ctx, db.GetTeamAuctionPayrollParams{
+		TeamID:  teamID,
+		DraftID: la.auction.DraftID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get team payroll: %w", err)
+	}
+	var settings interface{}
+	if len(payroll.LeagueSettings) > 0 {
+		if err := json.Unmarshal(payroll.LeagueSettings, &settings); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+	rules := models.SettingsSalaryCapRules(settings)
+	if !rules.Enforced() {
+		return maxBid, nil
+	}
+	committed := float64(payroll.Salaries) + float64(payroll.UnsignedPlayers)*float64(rules.MinSalary) + payroll.PendingBids
+	return math.Min(maxBid, roundAmount(float64(*rules.Cap)-committed-reserved)), nil
+}
+
+// loadAuction converts an auction to its model along with the team on the clock and the open lot
+func (r *Repository) loadAuction(ctx context.Context, q *db.Queries, dbAuction db.DraftAuction, settings auctionSettings) (*models.DraftAuction, error) {
+	auction := &models.DraftAuction{
+		DraftID:            dbAuction.DraftID,
+		NominationTurn:     int(dbAuction.NominationTurn),
+		NominationDeadline: sqlutil.FromSqlTime(dbAuction.NominationDeadline),
+		StartedAt:          dbAuction.StartedAt,
+	}
+
+	turn, teamID, err := r.nextNominationTurn(ctx, q, dbAuction.DraftID, settings, int(dbAuction.NominationTurn))
+	if err != nil {
+		return nil, err
+	}
+	if teamID != nil && turn == auction.NominationTurn {
+		auction.NominatingTeamID = teamID
+	}
+
+	dbLot, err := q.GetOpenAuctionLot(ctx, dbAuction.DraftID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get open lot: %w", err)
+	}
+	if err == nil {
+		auction.OpenLot, err = r.dbAuctionLotToModel(dbLot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return auction, nil
+}
+
+func (r *Repository) parseAuctionSettings(raw json.RawMessage) (auctionSettings, error) {
+	var settings models.DraftSettings
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return auctionSettings{}, fmt.Errorf("failed to unmarshal draft settings: %w", err)
+	}
+	if len(settings.DraftOrder) == 0 {
+		return auctionSettings{}, fmt.Errorf("draft has no teams in its draft order")
+	}
+
+	result := auctionSettings{
+		draftOrder:        settings.DraftOrder,
+		budgetPerTeam:     defaultBudgetPerTeam,
+		minBidIncrement:   defaultMinBidIncrement,
+		timePerNomination: defaultTimePerNominationSec * time.Second,
+	}
+	if auction := settings.Auction; auction != nil {
+		if auction.BudgetPerTeam > 0 {
+			result.budgetPerTeam = auction.BudgetPerTeam
+		}
+		if auction.MinBidIncrement > 0 {
+			result.minBidIncrement = auction.MinBidIncrement
+		}
+		if auction.TimePerNominationSec > 0 {
+			result.timePerNomination = time.Duration(auction.TimePerNominationSec) * time.Second
+		}
+	}
+	return result, nil
+}
+
+// resolveProxyBids prices a lot from its proxy bids, highest maximum first and earliest
+// placed first among equal maximums. The leader pays one increment over the runner-up's
+// maximum, capped at its own, and the price never drops below the current price.
+func resolveProxyBids(bids []ProxyBid, currentPrice float64, leader uuid.UUID, increment float64) (float64, uuid.UUID) {
+	if len(bids) == 0 {
+		return currentPrice, leader
+	}
+	sort.SliceStable(bids, func(i, j int) bool {
+		if bids[i].MaxAmount != bids[j].MaxAmount {
+			return bids[i].MaxAmount > bids[j].MaxAmount
+		}
+		return bids[i].PlacedAt.Before(bids[j].PlacedAt)
+	})
+
+	top := bids[0]
+	if len(bids) == 1 {
+		return currentPrice, top.FantasyTeamID
+	}
+	price := math.Min(top.MaxAmount, roundAmount(bids[1].MaxAmount+increment))
+	return math.Max(price, currentPrice), top.FantasyTeamID
+}
+
+func (r *Repository) dbAuctionLotToModel(lot db.AuctionLot) (*models.AuctionLot, error) {
+	price, err := parseAmount(lot.CurrentPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AuctionLot{
+		ID:            lot.ID,
+		DraftID:       lot.DraftID,
+		PlayerID:      lot.PlayerID,
+		NominatedBy:   lot.NominatedBy,
+		Status:        models.AuctionLotStatus(lot.Status),
+		CurrentPrice:  price,
+		LeadingTeamID: lot.LeadingTeamID,
+		EndsAt:        lot.EndsAt,
+		OpenedAt:      lot.OpenedAt,
+		SoldAt:        sqlutil.FromSqlTime(lot.SoldAt),
+		PickID:        sqlutil.FromNullUUID(lot.PickID),
+	}, nil
+}
+
+func (r *Repository) dbAuctionBidToModel(bid db.AuctionBid) (*models.AuctionBid, error) {
+	maxAmount, err := parseAmount(bid.MaxAmount)
+	if err != nil {
+		return nil, err
+	}
+	price, err := parseAmount(bid.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AuctionBid{
+		ID:            bid.ID,
+		LotID:         bid.LotID,
+		PlayerID:      bid.PlayerID,
+		FantasyTeamID: bid.FantasyTeamID,
+		Kind:          models.AuctionBidKind(bid.Kind),
+		MaxAmount:     maxAmount,
+		Price:         price,
+		LeadingTeamID: bid.LeadingTeamID,
+		PlacedAt:      bid.PlacedAt,
+	}, nil
+}
+
+func (r *Repository) dbProxyBidsToModel(dbBids []db.AuctionProxyBid) ([]ProxyBid, error) {
+	bids := make([]ProxyBid, len(dbBids))
+	for i, bid := range dbBids {
+		maxAmount, err := parseAmount(bid.MaxAmount)
+		if err != nil {
+			return nil, err
+		}
+		bids[i] = ProxyBid{
+			FantasyTeamID: bid.FantasyTeamID,
+			MaxAmount:     maxAmount,
+			PlacedAt:      bid.PlacedAt,
+		}
+	}
+	return bids, nil
+}
+
+// roundAmount rounds a dollar amount to the cent, the precision amounts are stored with
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(roundAmount(amount), 'f', 2, 64)
+}
+
+func parseAmount(amount string) (float64, error) {
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse amount %q: %w", amount, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,71 @@
+package auction
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// AuctionClock moves auctions on when a nomination turn or a lot's bidding runs out
+type AuctionClock interface {
+	RunAuctionClock(ctx context.Context, limit int32) (int, error)
+}
+
+// RunnerConfig holds configuration for the auction runner
+type RunnerConfig struct {
+	PollInterval time.Duration // How often to look for expired turns and lots
+	BatchSize    int32         // Max auctions handled per poll, per step
+}
+
+// DefaultRunnerConfig returns default auction runner configuration
+func DefaultRunnerConfig() RunnerConfig {
+	return RunnerConfig{
+		PollInterval: time.Second,
+		BatchSize:    50,
+	}
+}
+
+// Runner keeps auction drafts moving: it starts auctions once their draft starts,
+// nominates from a team's queue when its turn runs out and sells lots whose bidding has
+// closed. Deadlines are re-checked under the auction's row lock, so several runners can
+// safely poll the same database.
+type Runner struct {
+	clock  AuctionClock
+	config RunnerConfig
+}
+
+// NewRunner creates a new auction runner
+func NewRunner(clock AuctionClock, config RunnerConfig) *Runner {
+	return &Runner{
+		clock:  clock,
+		config: config,
+	}
+}
+
+// Start polls for expired nomination turns and lots until ctx is cancelled
+func (r *Runner) Start(ctx context.Context) error {
+	log.Info().
+		Dur("poll_interval", r.config.PollInterval).
+		Msg("starting auction runner")
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("auction runner shutting down")
+			return nil
+		case <-ticker.C:
+			handled, err := r.clock.RunAuctionClock(ctx, r.config.BatchSize)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to run auction clock")
+				continue
+			}
+			if handled > 0 {
+				log.Debug().Int("handled", handled).Msg("moved auctions on")
+			}
+		}
+	}
+}
@@ -0,0 +1,478 @@
+package auction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/draft/pick"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuctionApp defines what the service layer needs from the auction application
+type AuctionApp interface {
+	GetAuction(ctx context.Context, draftID uuid.UUID) (*models.DraftAuction, error)
+	SetNominationQueue(ctx context.Context, req SetNominationQueueRequest) error
+	GetNominationQueue(ctx context.Context, draftID, fantasyTeamID uuid.UUID) ([]uuid.UUID, error)
+	NominatePlayer(ctx context.Context, req NominatePlayerRequest) (*models.AuctionLot, error)
+	PlaceProxyBid(ctx context.Context, req PlaceProxyBidRequest) (*BidResult, error)
+	StartPendingAuctions(ctx context.Context, limit int32) ([]models.DraftAuction, error)
+	SellExpiredLots(ctx context.Context, limit int32) ([]SaleResult, error)
+	ExpireNominationTurns(ctx context.Context, limit int32) ([]NominationTurnResult, error)
+	GetAuctionHistory(ctx context.Context, draftID uuid.UUID, playerID *uuid.UUID) ([]LotHistory, error)
+}
+
+// PickAnnouncer loads the display data announced with a pick
+type PickAnnouncer interface {
+	GetPickAnnouncement(ctx context.Context, pickID uuid.UUID) (*pick.PickAnnouncement, error)
+}
+
+// OutboxApp defines what the service layer needs from the outbox
+type OutboxApp interface {
+	InsertAuctionUpdatedEvent(ctx context.Context, draftID uuid.UUID, payload events.AuctionUpdatedPayload) error
+	InsertPickMadeEvent(ctx context.Context, draftID uuid.UUID, payload events.PickMadePayload) error
+}
+
+// Service implements the DraftAuctionService gRPC interface
+type Service struct {
+	app           AuctionApp
+	draftService  draftv1connect.DraftServiceClient
+	pickAnnouncer PickAnnouncer
+	outboxApp     OutboxApp
+}
+
+// NewService creates a new auction gRPC service
+func NewService(app AuctionApp, draftService draftv1connect.DraftServiceClient, pickAnnouncer PickAnnouncer, outboxApp OutboxApp) *Service {
+	return &Service{
+		app:           app,
+		draftService:  draftService,
+		pickAnnouncer: pickAnnouncer,
+		outboxApp:     outboxApp,
+	}
+}
+
+// Verify that Service implements the DraftAuctionServiceHandler interface
+var _ draftv1connect.DraftAuctionServiceHandler = (*Service)(nil)
+
+// GetAuction retrieves the auction state of a draft
+func (s *Service) GetAuction(ctx context.Context, req *connect.Request[draftv1.GetAuctionRequest]) (*connect.Response[draftv1.GetAuctionResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := s.app.GetAuction(ctx, draftID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no auction for draft %s", draftID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.GetAuctionResponse{
+		Auction: s.auctionToProto(auction),
+	}), nil
+}
+
+// SetNominationQueue replaces a team's nomination queue
+func (s *Service) SetNominationQueue(ctx context.Context, req *connect.Request[draftv1.SetNominationQueueRequest]) (*connect.Response[draftv1.SetNominationQueueResponse], error) {
+	playerIDs, err := uuidutil.ParseAll("player_ids", req.Msg.PlayerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	err = s.app.SetNominationQueue(ctx, SetNominationQueueRequest{
+		DraftID:       draftID,
+		FantasyTeamID: fantasyTeamID,
+		PlayerIDs:     playerIDs,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.SetNominationQueueResponse{
+		PlayerIds: req.Msg.PlayerIds,
+	}), nil
+}
+
+// GetNominationQueue retrieves a team's nomination queue in order
+func (s *Service) GetNominationQueue(ctx context.Context, req *connect.Request[draftv1.GetNominationQueueRequest]) (*connect.Response[draftv1.GetNominationQueueResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	playerIDs, err := s.app.GetNominationQueue(ctx, draftID, fantasyTeamID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoPlayerIDs := make([]string, len(playerIDs))
+	for i, playerID := range playerIDs {
+		protoPlayerIDs[i] = playerID.String()
+	}
+
+	return connect.NewResponse(&draftv1.GetNominationQueueResponse{
+		PlayerIds: protoPlayerIDs,
+	}), nil
+}
+
+// NominatePlayer puts a player up for auction for the team on the clock
+func (s *Service) NominatePlayer(ctx context.Context, req *connect.Request[draftv1.NominatePlayerRequest]) (*connect.Response[draftv1.NominatePlayerResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	nominateReq := NominatePlayerRequest{
+		DraftID:       draftID,
+		FantasyTeamID: fantasyTeamID,
+		OpeningBid:    req.Msg.OpeningBid,
+		MaxBid:        req.Msg.MaxBid,
+	}
+	nominateReq.PlayerID, err = uuidutil.ParseOptional("player_id", req.Msg.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	lot, err := s.app.NominatePlayer(ctx, nominateReq)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	if err := s.emitLotUpdatedEvent(ctx, events.AuctionActionLotOpened, lot, false); err != nil {
+		log.Printf("Failed to emit AuctionUpdated event: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	return connect.NewResponse(&draftv1.NominatePlayerResponse{
+		Lot: s.auctionLotToProto(lot),
+	}), nil
+}
+
+// PlaceProxyBid sets the most a team will pay for the player up for auction
+func (s *Service) PlaceProxyBid(ctx context.Context, req *connect.Request[draftv1.PlaceProxyBidRequest]) (*connect.Response[draftv1.PlaceProxyBidResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.app.PlaceProxyBid(ctx, PlaceProxyBidRequest{
+		DraftID:       draftID,
+		FantasyTeamID: fantasyTeamID,
+		MaxAmount:     req.Msg.MaxAmount,
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	// Raising a maximum that still leads changes nothing anyone else can see
+	if result.Changed {
+		if err := s.emitLotUpdatedEvent(ctx, events.AuctionActionPriceChanged, &result.Lot, false); err != nil {
+			log.Printf("Failed to emit AuctionUpdated event: %v", err)
+			// Don't fail the operation, just log
+		}
+	}
+
+	return connect.NewResponse(&draftv1.PlaceProxyBidResponse{
+		Lot:     s.auctionLotToProto(&result.Lot),
+		Leading: result.Lot.LeadingTeamID == fantasyTeamID,
+	}), nil
+}
+
+// GetAuctionHistory lists a draft's lots with every bid placed on each. Teams' maximums are
+// left out for the open lot, as they are private until it's sold.
+func (s *Service) GetAuctionHistory(ctx context.Context, req *connect.Request[draftv1.GetAuctionHistoryRequest]) (*connect.Response[draftv1.GetAuctionHistoryResponse], error) {
+	playerID, err := uuidutil.ParseOptional("player_id", req.Msg.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.app.GetAuctionHistory(ctx, draftID, playerID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	lots := make([]*draftv1.AuctionLotHistory, len(history))
+	for i := range history {
+		lots[i] = s.lotHistoryToProto(&history[i])
+	}
+
+	return connect.NewResponse(&draftv1.GetAuctionHistoryResponse{
+		Lots: lots,
+	}), nil
+}
+
+// RunAuctionClock starts auctions in drafts that have begun, nominates for teams whose
+// nomination turn has run out and sells lots whose bidding has closed. It returns how
+// many auctions moved on.
+func (s *Service) RunAuctionClock(ctx context.Context, limit int32) (int, error) {
+	started, err := s.app.StartPendingAuctions(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+	for i := range started {
+		if err := s.emitNominationTurnEvent(ctx, &started[i]); err != nil {
+			log.Printf("Failed to emit AuctionUpdated event: %v", err)
+		}
+	}
+
+	turns, err := s.app.ExpireNominationTurns(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+	for i := range turns {
+		turn := turns[i]
+		if turn.Lot != nil {
+			err = s.emitLotUpdatedEvent(ctx, events.AuctionActionLotOpened, turn.Lot, true)
+		} else {
+			err = s.emitNominationTurnEvent(ctx, turn.Auction)
+		}
+		if err != nil {
+			log.Printf("Failed to emit AuctionUpdated event: %v", err)
+		}
+	}
+
+	sales, err := s.app.SellExpiredLots(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+	for i := range sales {
+		s.announceSale(ctx, &sales[i])
+	}
+
+	return len(started) + len(turns) + len(sales), nil
+}
+
+// announceSale emits the sold lot, the pick it filled and the next nomination turn, and
+// completes the draft once every roster is full
+func (s *Service) announceSale(ctx context.Context, sale *SaleResult) {
+	if err := s.emitLotUpdatedEvent(ctx, events.AuctionActionLotSold, &sale.Lot, false); err != nil {
+		log.Printf("Failed to emit AuctionUpdated event: %v", err)
+	}
+	if err := s.emitPickMadeEvent(ctx, &sale.Pick); err != nil {
+		log.Printf("Failed to emit PickMade event: %v", err)
+	}
+
+	if sale.Auction.NominatingTeamID != nil {
+		if err := s.emitNominationTurnEvent(ctx, sale.Auction); err != nil {
+			log.Printf("Failed to emit AuctionUpdated event: %v", err)
+		}
+		return
+	}
+
+	// Cross-domain orchestration: the last sale fills the last roster spot
+	_, err := s.draftService.CompleteDraft(ctx, connect.NewRequest(&draftv1.CompleteDraftRequest{
+		DraftId: sale.Lot.DraftID.String(),
+	}))
+	if err != nil {
+		log.Printf("Failed to complete auction draft %s: %v", sale.Lot.DraftID, err)
+	}
+}
+
+// toConnectError maps nomination and bidding errors to connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrBidTooLow):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrNotAuctionDraft), errors.Is(err, ErrAuctionNotInProgress),
+		errors.Is(err, ErrNotTeamsNomination), errors.Is(err, ErrLotAlreadyOpen),
+		errors.Is(err, ErrNoOpenLot), errors.Is(err, ErrLotClosed),
+		errors.Is(err, ErrPlayerUnavailable), errors.Is(err, ErrNominationQueueEmpty),
+		errors.Is(err, ErrOverBudget), errors.Is(err, ErrRosterFull):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// emitNominationTurnEvent emits an AuctionUpdated event putting the next team on the clock to nominate
+func (s *Service) emitNominationTurnEvent(ctx context.Context, auction *models.DraftAuction) error {
+	payload := events.AuctionUpdatedPayload{
+		DraftID:            auction.DraftID.String(),
+		Action:             events.AuctionActionNominationTurn,
+		NominationDeadline: auction.NominationDeadline,
+	}
+	if auction.NominatingTeamID != nil {
+		payload.NominatingTeamID = auction.NominatingTeamID.String()
+	}
+	return s.emitAuctionUpdatedEvent(ctx, auction.DraftID, payload)
+}
+
+// emitLotUpdatedEvent emits an AuctionUpdated event for a lot being opened, bid on or sold
+func (s *Service) emitLotUpdatedEvent(ctx context.Context, action string, lot *models.AuctionLot, autoNominated bool) error {
+	endsAt := lot.EndsAt
+	payload := events.AuctionUpdatedPayload{
+		DraftID:       lot.DraftID.String(),
+		Action:        action,
+		LotID:         lot.ID.String(),
+		PlayerID:      lot.PlayerID.String(),
+		NominatedBy:   lot.NominatedBy.String(),
+		CurrentPrice:  lot.CurrentPrice,
+		LeadingTeamID: lot.LeadingTeamID.String(),
+		EndsAt:        &endsAt,
+		AutoNominated: autoNominated,
+	}
+	if lot.PickID != nil {
+		payload.PickID = lot.PickID.String()
+	}
+	return s.emitAuctionUpdatedEvent(ctx, lot.DraftID, payload)
+}
+
+func (s *Service) emitAuctionUpdatedEvent(ctx context.Context, draftID uuid.UUID, payload events.AuctionUpdatedPayload) error {
+	return s.outboxApp.InsertAuctionUpdatedEvent(ctx, draftID, payload)
+}
+
+// emitPickMadeEvent emits a PickMade event for the pick a sold lot filled, so draft boards
+// and the orchestrator treat it like any other pick
+func (s *Service) emitPickMadeEvent(ctx context.Context, draftPick *models.DraftPick) error {
+	payload := events.PickMadePayload{
+		PickID:        draftPick.ID.String(),
+		TeamID:        draftPick.TeamID.String(),
+		Round:         draftPick.Round,
+		Pick:          draftPick.Pick,
+		OverallPick:   draftPick.OverallPick,
+		AuctionAmount: draftPick.AuctionAmount,
+	}
+	if draftPick.PlayerID != nil {
+		payload.PlayerID = draftPick.PlayerID.String()
+	}
+	if draftPick.PickedAt != nil {
+		payload.MadeAt = *draftPick.PickedAt
+	}
+
+	// The pick is still announced with IDs alone if the lookup fails
+	announcement, err := s.pickAnnouncer.GetPickAnnouncement(ctx, draftPick.ID)
+	if err != nil {
+		log.Printf("Failed to load display data for pick %s: %v", draftPick.ID, err)
+	} else {
+		payload.TeamName = announcement.TeamName
+		payload.PlayerName = announcement.PlayerName
+		payload.PlayerPosition = announcement.PlayerPosition
+		payload.NFLTeamCode = announcement.NFLTeamCode
+	}
+
+	return s.outboxApp.InsertPickMadeEvent(ctx, draftPick.DraftID, payload)
+}
+
+// Conversion methods between proto and app layer models
+
+func (s *Service) auctionToProto(auction *models.DraftAuction) *draftv1.DraftAuction {
+	protoAuction := &draftv1.DraftAuction{
+		DraftId:   auction.DraftID.String(),
+		StartedAt: timestamppb.New(auction.StartedAt),
+	}
+	if auction.NominatingTeamID != nil {
+		nominatingTeamID := auction.NominatingTeamID.String()
+		protoAuction.NominatingTeamId = &nominatingTeamID
+	}
+	if auction.NominationDeadline != nil {
+		protoAuction.NominationDeadline = timestamppb.New(*auction.NominationDeadline)
+	}
+	if auction.OpenLot != nil {
+		protoAuction.OpenLot = s.auctionLotToProto(auction.OpenLot)
+	}
+
+	return protoAuction
+}
+
+func (s *Service) auctionLotToProto(lot *models.AuctionLot) *draftv1.AuctionLot {
+	protoLot := &draftv1.AuctionLot{
+		Id:            lot.ID.String(),
+		DraftId:       lot.DraftID.String(),
+		PlayerId:      lot.PlayerID.String(),
+		NominatedBy:   lot.NominatedBy.String(),
+		Status:        s.auctionLotStatusToProto(lot.Status),
+		CurrentPrice:  lot.CurrentPrice,
+		LeadingTeamId: lot.LeadingTeamID.String(),
+		EndsAt:        timestamppb.New(lot.EndsAt),
+		OpenedAt:      timestamppb.New(lot.OpenedAt),
+	}
+	if lot.SoldAt != nil {
+		protoLot.SoldAt = timestamppb.New(*lot.SoldAt)
+	}
+	if lot.PickID != nil {
+		pickID := lot.PickID.String()
+		protoLot.PickId = &pickID
+	}
+
+	return protoLot
+}
+
+func (s *Service) lotHistoryToProto(history *LotHistory) *draftv1.AuctionLotHistory {
+	sold := history.Lot.Status == models.AuctionLotStatusSold
+	bids := make([]*draftv1.AuctionBid, len(history.Bids))
+	for i, bid := range history.Bids {
+		protoBid := &draftv1.AuctionBid{
+			FantasyTeamId: bid.FantasyTeamID.String(),
+			Kind:          s.auctionBidKindToProto(bid.Kind),
+			Price:         bid.Price,
+			LeadingTeamId: bid.LeadingTeamID.String(),
+			PlacedAt:      timestamppb.New(bid.PlacedAt),
+		}
+		if sold {
+			maxAmount := bid.MaxAmount
+			protoBid.MaxAmount = &maxAmount
+		}
+		bids[i] = protoBid
+	}
+
+	return &draftv1.AuctionLotHistory{
+		Lot:  s.auctionLotToProto(&history.Lot),
+		Bids: bids,
+	}
+}
+
+func (s *Service) auctionBidKindToProto(kind models.AuctionBidKind) draftv1.AuctionBidKind {
+	switch kind {
+	case models.AuctionBidKindOpening:
+		return draftv1.AuctionBidKind_AUCTION_BID_KIND_OPENING
+	case models.AuctionBidKindProxy:
+		return draftv1.AuctionBidKind_AUCTION_BID_KIND_PROXY
+	default:
+		return draftv1.AuctionBidKind_AUCTION_BID_KIND_UNSPECIFIED
+	}
+}
+
+func (s *Service) auctionLotStatusToProto(status models.AuctionLotStatus) draftv1.AuctionLotStatus {
+	switch status {
+	case models.AuctionLotStatusOpen:
+		return draftv1.AuctionLotStatus_AUCTION_LOT_STATUS_OPEN
+	case models.AuctionLotStatusSold:
+		return draftv1.AuctionLotStatus_AUCTION_LOT_STATUS_SOLD
+	default:
+		return draftv1.AuctionLotStatus_AUCTION_LOT_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,109 @@
+package auction
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrNotAuctionDraft is returned for auction operations on a draft of another type
+	ErrNotAuctionDraft = errors.New("draft is not an auction draft")
+	// ErrAuctionNotInProgress is returned when nominating or bidding while the draft isn't running
+	ErrAuctionNotInProgress = errors.New("auction is not in progress")
+	// ErrNotTeamsNomination is returned when a team nominates out of turn
+	ErrNotTeamsNomination = errors.New("it is not this team's turn to nominate")
+	// ErrLotAlreadyOpen is returned when a player is nominated while another is up for auction
+	ErrLotAlreadyOpen = errors.New("another player is already up for auction")
+	// ErrNoOpenLot is returned when bidding while no player is up for auction
+	ErrNoOpenLot = errors.New("no player is up for auction")
+	// ErrPlayerUnavailable is returned when nominating a player already nominated or drafted
+	ErrPlayerUnavailable = errors.New("player has already been nominated or drafted")
+	// ErrNominationQueueEmpty is returned when nominating from a queue with no available players
+	ErrNominationQueueEmpty = errors.New("nomination queue has no available players")
+	// ErrBidTooLow is returned when a maximum bid doesn't beat the current price by the minimum increment
+	ErrBidTooLow = errors.New("bid is below the minimum")
+	// ErrOverBudget is returned when a maximum bid would leave a team unable to fill its roster,
+	// within its budget or under its league's salary cap
+	ErrOverBudget = errors.New("bid exceeds the team's remaining budget")
+	// ErrRosterFull is returned when a team with no open picks nominates or bids
+	ErrRosterFull = errors.New("team has no open picks left")
+	// ErrLotClosed is returned when a bid arrives after the lot has been sold
+	ErrLotClosed = errors.New("bidding on this player has closed")
+	// ErrLotNotExpired is returned when selling races a bid that reset the lot's clock
+	ErrLotNotExpired = errors.New("lot clock has not expired")
+	// ErrNominationNotExpired is returned when a forced nomination races a team nominating in time
+	ErrNominationNotExpired = errors.New("nomination turn has not expired")
+)
+
+const (
+	// defaultBudgetPerTeam is used when a draft's settings don't set budget_per_team
+	defaultBudgetPerTeam = 200.0
+	// defaultMinBidIncrement is used when a draft's settings don't set min_bid_increment.
+	// It is also the opening and smallest winning bid.
+	defaultMinBidIncrement = 1.0
+	// defaultTimePerNominationSec is used when a draft's settings don't set time_per_nomination_sec.
+	// It bounds both a team's nomination turn and the bidding on a lot after each new price.
+	defaultTimePerNominationSec = 30
+)
+
+// SetNominationQueueRequest replaces a team's nomination queue
+type SetNominationQueueRequest struct {
+	DraftID       uuid.UUID   `json:"draft_id"`
+	FantasyTeamID uuid.UUID   `json:"fantasy_team_id"`
+	PlayerIDs     []uuid.UUID `json:"player_ids"`
+}
+
+// NominatePlayerRequest represents the team on the clock putting a player up for auction.
+// A nil PlayerID nominates the first available player in the team's queue.
+type NominatePlayerRequest struct {
+	DraftID       uuid.UUID  `json:"draft_id"`
+	FantasyTeamID uuid.UUID  `json:"fantasy_team_id"`
+	PlayerID      *uuid.UUID `json:"player_id,omitempty"`
+	OpeningBid    float64    `json:"opening_bid"`
+	MaxBid        float64    `json:"max_bid,omitempty"`
+}
+
+// PlaceProxyBidRequest represents a team setting the most it will pay for the open lot.
+// The system bids on the team's behalf, one increment at a time, up to MaxAmount.
+type PlaceProxyBidRequest struct {
+	DraftID       uuid.UUID `json:"draft_id"`
+	FantasyTeamID uuid.UUID `json:"fantasy_team_id"`
+	MaxAmount     float64   `json:"max_amount"`
+}
+
+// ProxyBid is a team's maximum bid on a lot. Maximums are private to the team.
+type ProxyBid struct {
+	FantasyTeamID uuid.UUID `json:"fantasy_team_id"`
+	MaxAmount     float64   `json:"max_amount"`
+	PlacedAt      time.Time `json:"placed_at"`
+}
+
+// BidResult is the open lot after a bid, and whether the bid moved its price or leader
+type BidResult struct {
+	Lot     models.AuctionLot `json:"lot"`
+	Changed bool              `json:"changed"`
+}
+
+// SaleResult is a lot sold to its leading team along with the auction's next nomination turn
+type SaleResult struct {
+	Lot     models.AuctionLot    `json:"lot"`
+	Pick    models.DraftPick     `json:"pick"`
+	Auction *models.DraftAuction `json:"auction"`
+}
+
+// NominationTurnResult is a nomination turn that expired. Lot is set when a player was
+// nominated from the team's queue; otherwise the team forfeited its turn.
+type NominationTurnResult struct {
+	FantasyTeamID uuid.UUID            `json:"fantasy_team_id"`
+	Lot           *models.AuctionLot   `json:"lot,omitempty"`
+	Auction       *models.DraftAuction `json:"auction"`
+}
+
+// LotHistory is a lot along with every bid placed on it, in the order they were placed
+type LotHistory struct {
+	Lot  models.AuctionLot   `json:"lot"`
+	Bids []models.AuctionBid `json:"bids"`
+}
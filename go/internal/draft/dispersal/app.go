@@ -0,0 +1,166 @@
+package dispersal
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// DispersalRepository defines what the dispersal app layer needs from the repository
+type DispersalRepository interface {
+	GetTeam(ctx context.Context, teamID uuid.UUID) (*Team, error)
+	ListLeagueTeamIDs(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error)
+	CountRosterPlayers(ctx context.Context, teamID uuid.UUID) (int, error)
+	HasOpenDispersalDraft(ctx context.Context, teamID uuid.UUID) (bool, error)
+	CreateDispersalDraft(ctx context.Context, draftID, foldedTeamID uuid.UUID, createdBy *uuid.UUID) (*models.DispersalDraft, error)
+	GetDispersalDraft(ctx context.Context, draftID uuid.UUID) (*Dispersal, error)
+	ListDispersalPool(ctx context.Context, draftID uuid.UUID) ([]models.DispersalPoolPlayer, error)
+	ListFoldedTeamPicks(ctx context.Context, draftID uuid.UUID) ([]FoldedTeamPick, error)
+	MarkDispersalDraftCompleted(ctx context.Context, draftID uuid.UUID) (*models.DispersalDraft, error)
+}
+
+// App handles dispersal draft business logic
+type App struct {
+	repo DispersalRepository
+}
+
+// NewApp creates a new dispersal App
+func NewApp(repo DispersalRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// PlanDispersalDraft works out the draft that disperses a folded team's players: its order,
+// the league's other teams unless the request names them, and enough rounds for each team to
+// take an equal share. Commissioner only.
+func (a *App) PlanDispersalDraft(ctx context.Context, req CreateDispersalDraftRequest) (*DispersalPlan, error) {
+	team, err := a.repo.GetTeam(ctx, req.FoldedTeamID)
+	if err != nil {
+		return nil, err
+	}
+	if req.RequestedBy != nil && *req.RequestedBy != team.CommissionerID {
+		return nil, ErrNotCommissioner
+	}
+
+	open, err := a.repo.HasOpenDispersalDraft(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	if open {
+		return nil, ErrAlreadyFolding
+	}
+	players, err := a.repo.CountRosterPlayers(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	if players == 0 {
+		return nil, ErrNoPlayers
+	}
+
+	leagueTeams, err := a.repo.ListLeagueTeamIDs(ctx, team.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	order, err := dispersalOrder(team.ID, leagueTeams, req.DraftOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DispersalPlan{
+		LeagueID:     team.LeagueID,
+		FoldedTeamID: team.ID,
+		DraftOrder:   order,
+		Rounds:       DispersalRounds(players, len(order)),
+	}, nil
+}
+
+// RecordDispersalDraft records the folded team a newly created draft disperses
+func (a *App) RecordDispersalDraft(ctx context.Context, draftID, foldedTeamID uuid.UUID, createdBy *uuid.UUID) (*models.DispersalDraft, error) {
+	dispersal, err := a.repo.CreateDispersalDraft(ctx, draftID, foldedTeamID, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Created dispersal draft %s for folded team %s", draftID, foldedTeamID)
+	return dispersal, nil
+}
+
+// GetDispersalDraft retrieves a dispersal draft
+func (a *App) GetDispersalDraft(ctx context.Context, draftID uuid.UUID) (*Dispersal, error) {
+	return a.repo.GetDispersalDraft(ctx, draftID)
+}
+
+// ListDispersalPool lists the folded team's players its dispersal draft may still select
+func (a *App) ListDispersalPool(ctx context.Context, draftID uuid.UUID) ([]models.DispersalPoolPlayer, error) {
+	if _, err := a.repo.GetDispersalDraft(ctx, draftID); err != nil {
+		return nil, err
+	}
+	return a.repo.ListDispersalPool(ctx, draftID)
+}
+
+// StartCompletion checks that a dispersal's assets may be reassigned by the user, returning the
+// dispersal. Its draft must have completed. A nil user is a trusted caller.
+func (a *App) StartCompletion(ctx context.Context, draftID uuid.UUID, userID *uuid.UUID) (*Dispersal, error) {
+	dispersal, err := a.repo.GetDispersalDraft(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	if userID != nil && *userID != dispersal.CommissionerID {
+		return nil, ErrNotCommissioner
+	}
+	if dispersal.CompletedAt == nil && dispersal.Status != models.DraftStatusCompleted {
+		return nil, ErrDraftNotCompleted
+	}
+	return dispersal, nil
+}
+
+// ListFoldedTeamPicks lists the folded team's unmade picks in its league's upcoming drafts
+func (a *App) ListFoldedTeamPicks(ctx context.Context, draftID uuid.UUID) ([]FoldedTeamPick, error) {
+	return a.repo.ListFoldedTeamPicks(ctx, draftID)
+}
+
+// FinishCompletion records that a dispersal's assets have been reassigned
+func (a *App) FinishCompletion(ctx context.Context, draftID uuid.UUID, playersReleased, picksReassigned int) (*models.DispersalDraft, error) {
+	dispersal, err := a.repo.MarkDispersalDraftCompleted(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Completed dispersal draft %s: released %d undrafted players of folded team %s and reassigned %d of its picks",
+		draftID, playersReleased, dispersal.FoldedTeamID, picksReassigned)
+	return dispersal, nil
+}
+
+// dispersalOrder checks a requested dispersal draft order against the folded team's league,
+// defaulting to the league's other teams
+func dispersalOrder(foldedTeamID uuid.UUID, leagueTeams, requested []uuid.UUID) ([]uuid.UUID, error) {
+	if len(requested) == 0 {
+		order := make([]uuid.UUID, 0, len(leagueTeams))
+		for _, teamID := range leagueTeams {
+			if teamID != foldedTeamID {
+				order = append(order, teamID)
+			}
+		}
+		if len(order) == 0 {
+			return nil, ErrNoTeamsLeft
+		}
+		return order, nil
+	}
+
+	seen := make(map[uuid.UUID]bool, len(requested))
+	for _, teamID := range requested {
+		switch {
+		case teamID == foldedTeamID:
+			return nil, ErrFoldedTeamInOrder
+		case !slices.Contains(leagueTeams, teamID):
+			return nil, fmt.Errorf("%w: %s", ErrTeamNotInLeague, teamID)
+		case seen[teamID]:
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateTeam, teamID)
+		}
+		seen[teamID] = true
+	}
+	return requested, nil
+}
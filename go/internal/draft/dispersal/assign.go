@@ -0,0 +1,46 @@
+package dispersal
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// DispersalRounds returns how many rounds it takes for every team to take an equal share of
+// a folded team's players, the last round possibly left short
+func DispersalRounds(players, teams int) int {
+	if teams <= 0 {
+		return 0
+	}
+	return (players + teams - 1) / teams
+}
+
+// AssignFoldedTeamPicks shares out a folded team's picks among the teams of its dispersal draft
+// in dispersal order: the first team gets the folded team's first round picks, the second team
+// its second round picks, and so on, wrapping around. Only teams in a pick's draft order can
+// hold it, so each draft cycles through the dispersal teams it has, and picks of drafts with
+// none are left out.
+func AssignFoldedTeamPicks(picks []FoldedTeamPick, dispersalOrder []uuid.UUID, draftOrders map[uuid.UUID][]uuid.UUID) []PickAssignment {
+	eligible := make(map[uuid.UUID][]uuid.UUID)
+	for draftID, order := range draftOrders {
+		for _, teamID := range dispersalOrder {
+			if slices.Contains(order, teamID) {
+				eligible[draftID] = append(eligible[draftID], teamID)
+			}
+		}
+	}
+
+	var assignments []PickAssignment
+	for _, pick := range picks {
+		teams := eligible[pick.DraftID]
+		if len(teams) == 0 || pick.Round < 1 {
+			continue
+		}
+		assignments = append(assignments, PickAssignment{
+			PickID:    pick.PickID,
+			DraftID:   pick.DraftID,
+			NewTeamID: teams[(pick.Round-1)%len(teams)],
+		})
+	}
+	return assignments
+}
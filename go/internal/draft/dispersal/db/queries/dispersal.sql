@@ -0,0 +1,79 @@
+-- name: GetDispersalTeam :one
+-- A fantasy team with the commissioner of its league.
+SELECT ft.id, ft.league_id, l.commissioner_id
+FROM fantasy_teams ft
+JOIN leagues l ON l.id = ft.league_id
+WHERE ft.id = $1;
+
+-- name: ListLeagueTeamIDs :many
+-- The teams of a league in the order they joined.
+SELECT id FROM fantasy_teams
+WHERE league_id = $1
+ORDER BY created_at, id;
+
+-- name: CountRosterPlayers :one
+SELECT COUNT(*) FROM roster_players
+WHERE fantasy_team_id = $1;
+
+-- name: HasOpenDispersalDraft :one
+-- Whether a team's players are already pooled in a dispersal draft that hasn't completed.
+SELECT EXISTS (SELECT 1
+               FROM dispersal_drafts
+               WHERE folded_team_id = $1
+                 AND completed_at IS NULL) AS open;
+
+-- name: CreateDispersalDraft :one
+INSERT INTO dispersal_drafts (draft_id, folded_team_id, created_by)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetDispersalDraft :one
+-- A dispersal draft with its draft's status and the commissioner of its league.
+SELECT dd.draft_id,
+       dd.folded_team_id,
+       dd.created_by,
+       dd.created_at,
+       dd.completed_at,
+       d.status::text AS status,
+       l.commissioner_id
+FROM dispersal_drafts dd
+JOIN draft d ON d.id = dd.draft_id
+JOIN leagues l ON l.id = d.league_id
+WHERE dd.draft_id = $1;
+
+-- name: ListDispersalPool :many
+-- The folded team's players a dispersal draft hasn't picked, by name. Once the draft completes,
+-- these are the players nobody took.
+SELECT rp.player_id, p.full_name, npp.position
+FROM dispersal_drafts dd
+JOIN roster_players rp ON rp.fantasy_team_id = dd.folded_team_id
+JOIN players p ON p.id = rp.player_id
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = rp.player_id
+WHERE dd.draft_id = $1
+  AND NOT EXISTS (
+    SELECT 1
+    FROM draft_picks dp
+    WHERE dp.draft_id = dd.draft_id
+      AND dp.player_id = rp.player_id
+)
+ORDER BY p.full_name, rp.player_id;
+
+-- name: ListFoldedTeamPicks :many
+-- The folded team's unmade picks in the drafts of its league that haven't started, oldest draft
+-- first and in board order.
+SELECT dp.id, dp.draft_id, dp.round
+FROM dispersal_drafts dd
+JOIN fantasy_teams ft ON ft.id = dd.folded_team_id
+JOIN draft d ON d.league_id = ft.league_id
+JOIN draft_picks dp ON dp.draft_id = d.id
+WHERE dd.draft_id = $1
+  AND d.status = 'NOT_STARTED'
+  AND dp.team_id = dd.folded_team_id
+  AND dp.player_id IS NULL
+ORDER BY d.created_at, d.id, dp.overall_pick;
+
+-- name: MarkDispersalDraftCompleted :one
+UPDATE dispersal_drafts
+SET completed_at = COALESCE(completed_at, NOW())
+WHERE draft_id = $1
+RETURNING *;
@@ -0,0 +1,169 @@
+package dispersal
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/dispersal/db"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// Repository implements dispersal data access operations
+type Repository struct {
+	queries *db.Queries
+}
+
+// NewRepository creates a new dispersal repository
+func NewRepository(queries *db.Queries) *Repository {
+	return &Repository{
+		queries: queries,
+	}
+}
+
+// q returns the repository's queries, bound to the transaction in ctx if a service started one
+func (r *Repository) q(ctx context.Context) *db.Queries {
+	return sqlutil.Bind(ctx, r.queries, r.queries.WithTx)
+}
+
+// GetTeam retrieves a fantasy team with its league's commissioner
+func (r *Repository) GetTeam(ctx context.Context, teamID uuid.UUID) (*Team, error) {
+	row, err := r.q(ctx).GetDispersalTeam(ctx, teamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fantasy team: %w", err)
+	}
+	return &Team{
+		ID:             row.ID,
+		LeagueID:       row.LeagueID,
+		CommissionerID: row.CommissionerID,
+	}, nil
+}
+
+// ListLeagueTeamIDs lists the teams of a league in the order they joined
+func (r *Repository) ListLeagueTeamIDs(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error) {
+	teamIDs, err := r.q(ctx).ListLeagueTeamIDs(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list league teams: %w", err)
+	}
+	return teamIDs, nil
+}
+
+// CountRosterPlayers counts the players on a team's roster
+func (r *Repository) CountRosterPlayers(ctx context.Context, teamID uuid.UUID) (int, error) {
+	count, err := r.q(ctx).CountRosterPlayers(ctx, teamID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count roster players: %w", err)
+	}
+	return int(count), nil
+}
+
+// HasOpenDispersalDraft reports whether a team's players are pooled in a dispersal draft that
+// hasn't completed
+func (r *Repository) HasOpenDispersalDraft(ctx context.Context, teamID uuid.UUID) (bool, error) {
+	open, err := r.q(ctx).HasOpenDispersalDraft(ctx, teamID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for open dispersal draft: %w", err)
+	}
+	return open, nil
+}
+
+// CreateDispersalDraft records the folded team a draft pools the players of
+func (r *Repository) CreateDispersalDraft(ctx context.Context, draftID, foldedTeamID uuid.UUID, createdBy *uuid.UUID) (*models.DispersalDraft, error) {
+	row, err := r.q(ctx).CreateDispersalDraft(ctx, db.CreateDispersalDraftParams{
+		DraftID:      draftID,
+		FoldedTeamID: foldedTeamID,
+		CreatedBy:    sqlutil.ToNullUUID(createdBy),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dispersal draft: %w", err)
+	}
+	return dbDispersalDraftToModel(row), nil
+}
+
+// GetDispersalDraft retrieves a dispersal draft with its draft's status and league's commissioner
+func (r *Repository) GetDispersalDraft(ctx context.Context, draftID uuid.UUID) (*Dispersal, error) {
+	row, err := r.q(ctx).GetDispersalDraft(ctx, draftID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDispersalDraftNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dispersal draft: %w", err)
+	}
+	return &Dispersal{
+		DispersalDraft: models.DispersalDraft{
+			DraftID:      row.DraftID,
+			FoldedTeamID: row.FoldedTeamID,
+			CreatedBy:    sqlutil.FromNullUUID(row.CreatedBy),
+			CreatedAt:    row.CreatedAt,
+			CompletedAt:  sqlutil.FromSqlTime(row.CompletedAt),
+		},
+		Status:         models.DraftStatus(row.Status),
+		CommissionerID: row.CommissionerID,
+	}, nil
+}
+
+// ListDispersalPool lists the folded team's players a dispersal draft hasn't picked
+func (r *Repository) ListDispersalPool(ctx context.Context, draftID uuid.UUID) ([]models.DispersalPoolPlayer, error) {
+	rows, err := r.q(ctx).ListDispersalPool(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dispersal pool: %w", err)
+	}
+
+	players := make([]models.DispersalPoolPlayer, len(rows))
+	for i, row := range rows {
+		players[i] = models.DispersalPoolPlayer{
+			PlayerID: row.PlayerID,
+			FullName: row.FullName,
+			Position: sqlutil.FromSqlStringPtr(row.Position),
+		}
+	}
+	return players, nil
+}
+
+// ListFoldedTeamPicks lists the folded team's unmade picks in its league's drafts that haven't
+// started
+func (r *Repository) ListFoldedTeamPicks(ctx context.Context, draftID uuid.UUID) ([]FoldedTeamPick, error) {
+	rows, err := r.q(ctx).ListFoldedTeamPicks(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list folded team picks: %w", err)
+	}
+
+	picks := make([]FoldedTeamPick, len(rows))
+	for i, row := range rows {
+		picks[i] = FoldedTeamPick{
+			PickID:  row.ID,
+			DraftID: row.DraftID,
+			Round:   int(row.Round),
+		}
+	}
+	return picks, nil
+}
+
+// MarkDispersalDraftCompleted records that a dispersal's assets have been reassigned, keeping
+// the time it was first recorded
+func (r *Repository) MarkDispersalDraftCompleted(ctx context.Context, draftID uuid.UUID) (*models.DispersalDraft, error) {
+	row, err := r.q(ctx).MarkDispersalDraftCompleted(ctx, draftID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDispersalDraftNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark dispersal draft completed: %w", err)
+	}
+	return dbDispersalDraftToModel(row), nil
+}
+
+func dbDispersalDraftToModel(row db.DispersalDraft) *models.DispersalDraft {
+	return &models.DispersalDraft{
+		DraftID:      row.DraftID,
+		FoldedTeamID: row.FoldedTeamID,
+		CreatedBy:    sqlutil.FromNullUUID(row.CreatedBy),
+		CreatedAt:    row.CreatedAt,
+		CompletedAt:  sqlutil.FromSqlTime(row.CompletedAt),
+	}
+}
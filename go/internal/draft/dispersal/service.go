@@ -0,0 +1,318 @@
+package dispersal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/pick"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	rosterv1 "github.com/mcdev12/dynasty/go/internal/genproto/roster/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/roster/v1/rosterv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DispersalApp defines what the service layer needs from the dispersal application
+type DispersalApp interface {
+	PlanDispersalDraft(ctx context.Context, req CreateDispersalDraftRequest) (*DispersalPlan, error)
+	RecordDispersalDraft(ctx context.Context, draftID, foldedTeamID uuid.UUID, createdBy *uuid.UUID) (*models.DispersalDraft, error)
+	GetDispersalDraft(ctx context.Context, draftID uuid.UUID) (*Dispersal, error)
+	ListDispersalPool(ctx context.Context, draftID uuid.UUID) ([]models.DispersalPoolPlayer, error)
+	StartCompletion(ctx context.Context, draftID uuid.UUID, userID *uuid.UUID) (*Dispersal, error)
+	ListFoldedTeamPicks(ctx context.Context, draftID uuid.UUID) ([]FoldedTeamPick, error)
+	FinishCompletion(ctx context.Context, draftID uuid.UUID, playersReleased, picksReassigned int) (*models.DispersalDraft, error)
+}
+
+// Service implements the DraftDispersalService gRPC interface. Dispersal drafts are created
+// and their picks generated through the draft and draft pick services, and the folded team's
+// assets are reassigned through the roster and draft pick services, so their events are
+// recorded as usual. Requests without a signed in user are trusted callers.
+type Service struct {
+	app           DispersalApp
+	draftService  draftv1connect.DraftServiceClient
+	pickService   draftv1connect.DraftPickServiceClient
+	rosterService rosterv1connect.RosterServiceClient
+	tx            sqlutil.Transactor
+}
+
+// NewService creates a new dispersal gRPC service
+func NewService(app DispersalApp, draftService draftv1connect.DraftServiceClient, pickService draftv1connect.DraftPickServiceClient, rosterService rosterv1connect.RosterServiceClient, tx sqlutil.Transactor) *Service {
+	return &Service{
+		app:           app,
+		draftService:  draftService,
+		pickService:   pickService,
+		rosterService: rosterService,
+		tx:            tx,
+	}
+}
+
+// Verify that Service implements the DraftDispersalServiceHandler interface
+var _ draftv1connect.DraftDispersalServiceHandler = (*Service)(nil)
+
+// CreateDispersalDraft creates a dispersal draft for a folded team. The draft, its record as a
+// dispersal and its picks are created in one transaction. Commissioner only.
+func (s *Service) CreateDispersalDraft(ctx context.Context, req *connect.Request[draftv1.CreateDispersalDraftRequest]) (*connect.Response[draftv1.CreateDispersalDraftResponse], error) {
+	teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	order := make([]uuid.UUID, len(req.Msg.DraftOrder))
+	for i, id := range req.Msg.DraftOrder {
+		if order[i], err = uuid.Parse(id); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	actingUser := interceptors.ActingUserPtr(ctx)
+	plan, err := s.app.PlanDispersalDraft(ctx, CreateDispersalDraftRequest{
+		FoldedTeamID:   teamID,
+		DraftOrder:     order,
+		TimePerPickSec: int(req.Msg.TimePerPickSec),
+		RequestedBy:    actingUser,
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	settings := &draftv1.DraftSettings{
+		Rounds:         int32(plan.Rounds),
+		TimePerPickSec: req.Msg.TimePerPickSec,
+		DraftOrder:     make([]string, len(plan.DraftOrder)),
+	}
+	for i, id := range plan.DraftOrder {
+		settings.DraftOrder[i] = id.String()
+	}
+
+	var dispersal *models.DispersalDraft
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		draftResp, err := s.draftService.CreateDraft(ctx, connect.NewRequest(&draftv1.CreateDraftRequest{
+			LeagueId:    plan.LeagueID.String(),
+			DraftType:   draftv1.DraftType_DRAFT_TYPE_DISPERSAL,
+			Settings:    settings,
+			ScheduledAt: req.Msg.ScheduledAt,
+		}))
+		if err != nil {
+			return err
+		}
+		draftID := uuid.MustParse(draftResp.Msg.Draft.Id)
+
+		dispersal, err = s.app.RecordDispersalDraft(ctx, draftID, plan.FoldedTeamID, actingUser)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.pickService.PrepopulateDraftPicks(ctx, connect.NewRequest(&draftv1.PrepopulateDraftPicksRequest{
+			DraftId:   draftID.String(),
+			DraftType: draftv1.DraftType_DRAFT_TYPE_DISPERSAL,
+			Settings:  draftResp.Msg.Draft.Settings,
+		}))
+		return err
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&draftv1.CreateDispersalDraftResponse{
+		DispersalDraft: s.dispersalDraftToProto(*dispersal),
+		Rounds:         settings.Rounds,
+		DraftOrder:     settings.DraftOrder,
+	}), nil
+}
+
+// GetDispersalDraft retrieves a dispersal draft
+func (s *Service) GetDispersalDraft(ctx context.Context, req *connect.Request[draftv1.GetDispersalDraftRequest]) (*connect.Response[draftv1.GetDispersalDraftResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	dispersal, err := s.app.GetDispersalDraft(ctx, draftID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&draftv1.GetDispersalDraftResponse{
+		DispersalDraft: s.dispersalDraftToProto(dispersal.DispersalDraft),
+	}), nil
+}
+
+// ListDispersalPool lists the folded team's players the dispersal draft may still select
+func (s *Service) ListDispersalPool(ctx context.Context, req *connect.Request[draftv1.ListDispersalPoolRequest]) (*connect.Response[draftv1.ListDispersalPoolResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	players, err := s.app.ListDispersalPool(ctx, draftID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &draftv1.ListDispersalPoolResponse{
+		Players: make([]*draftv1.DispersalPoolPlayer, len(players)),
+	}
+	for i, player := range players {
+		resp.Players[i] = &draftv1.DispersalPoolPlayer{
+			PlayerId: player.PlayerID.String(),
+			FullName: player.FullName,
+			Position: player.Position,
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// CompleteDispersalDraft releases the folded team's undrafted players and shares out its picks
+// in the league's upcoming drafts. Each player and pick is handled on its own, so a call that
+// fails part way picks up where it left off when retried. Commissioner only.
+func (s *Service) CompleteDispersalDraft(ctx context.Context, req *connect.Request[draftv1.CompleteDispersalDraftRequest]) (*connect.Response[draftv1.CompleteDispersalDraftResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	dispersal, err := s.app.StartCompletion(ctx, draftID, interceptors.ActingUserPtr(ctx))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+	if dispersal.CompletedAt != nil {
+		return connect.NewResponse(&draftv1.CompleteDispersalDraftResponse{
+			DispersalDraft: s.dispersalDraftToProto(dispersal.DispersalDraft),
+		}), nil
+	}
+
+	undrafted, err := s.app.ListDispersalPool(ctx, draftID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+	for _, player := range undrafted {
+		if _, err := s.rosterService.DeletePlayerFromRoster(ctx, connect.NewRequest(&rosterv1.DeletePlayerFromRosterRequest{
+			FantasyTeamId: dispersal.FoldedTeamID.String(),
+			PlayerId:      player.PlayerID.String(),
+		})); err != nil {
+			return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("failed to release player %s: %w", player.PlayerID, err))
+		}
+	}
+
+	assignments, err := s.assignFoldedTeamPicks(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	reason := fmt.Sprintf("dispersal of folded team %s", dispersal.FoldedTeamID)
+	// Handing out a folded team's picks isn't a trade
+	reassignCtx := pick.WithoutTradeRules(ctx)
+	for _, assignment := range assignments {
+		if _, err := s.pickService.ReassignPickSlot(reassignCtx, connect.NewRequest(&draftv1.ReassignPickSlotRequest{
+			PickId:    assignment.PickID.String(),
+			NewTeamId: assignment.NewTeamID.String(),
+			Reason:    &reason,
+		})); err != nil {
+			return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("failed to reassign pick %s: %w", assignment.PickID, err))
+		}
+	}
+
+	completed, err := s.app.FinishCompletion(ctx, draftID, len(undrafted), len(assignments))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&draftv1.CompleteDispersalDraftResponse{
+		DispersalDraft:  s.dispersalDraftToProto(*completed),
+		PlayersReleased: int32(len(undrafted)),
+		PicksReassigned: int32(len(assignments)),
+	}), nil
+}
+
+// assignFoldedTeamPicks works out who gets each of the folded team's unmade picks, from the
+// dispersal draft's order and the orders of the drafts the picks are in. Errors are Connect
+// errors.
+func (s *Service) assignFoldedTeamPicks(ctx context.Context, draftID uuid.UUID) ([]PickAssignment, error) {
+	picks, err := s.app.ListFoldedTeamPicks(ctx, draftID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+	if len(picks) == 0 {
+		return nil, nil
+	}
+
+	dispersalOrder, err := s.draftOrder(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	draftOrders := make(map[uuid.UUID][]uuid.UUID)
+	for _, pick := range picks {
+		if _, ok := draftOrders[pick.DraftID]; ok {
+			continue
+		}
+		if draftOrders[pick.DraftID], err = s.draftOrder(ctx, pick.DraftID); err != nil {
+			return nil, err
+		}
+	}
+	return AssignFoldedTeamPicks(picks, dispersalOrder, draftOrders), nil
+}
+
+// draftOrder retrieves a draft's first round order. Errors are Connect errors.
+func (s *Service) draftOrder(ctx context.Context, draftID uuid.UUID) ([]uuid.UUID, error) {
+	resp, err := s.draftService.GetDraft(ctx, connect.NewRequest(&draftv1.GetDraftRequest{
+		DraftId: draftID.String(),
+	}))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeOf(err), fmt.Errorf("failed to get draft %s: %w", draftID, err))
+	}
+
+	var order []uuid.UUID
+	for _, id := range resp.Msg.Draft.GetSettings().GetDraftOrder() {
+		teamID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid team %q in the order of draft %s: %w", id, draftID, err))
+		}
+		order = append(order, teamID)
+	}
+	return order, nil
+}
+
+// toConnectError maps app errors to Connect codes. Errors from the services a dispersal goes
+// through keep their codes.
+func (s *Service) toConnectError(err error) error {
+	var connectErr *connect.Error
+	switch {
+	case errors.As(err, &connectErr):
+		return connectErr
+	case errors.Is(err, ErrTeamNotFound), errors.Is(err, ErrDispersalDraftNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrTeamNotInLeague), errors.Is(err, ErrFoldedTeamInOrder), errors.Is(err, ErrDuplicateTeam):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrAlreadyFolding), errors.Is(err, ErrNoPlayers), errors.Is(err, ErrNoTeamsLeft),
+		errors.Is(err, ErrDraftNotCompleted):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, ErrNotCommissioner):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// Conversion methods
+
+// dispersalDraftToProto converts a dispersal draft to proto
+func (s *Service) dispersalDraftToProto(dispersal models.DispersalDraft) *draftv1.DispersalDraft {
+	protoDispersal := &draftv1.DispersalDraft{
+		DraftId:      dispersal.DraftID.String(),
+		FoldedTeamId: dispersal.FoldedTeamID.String(),
+		CreatedAt:    timestamppb.New(dispersal.CreatedAt),
+	}
+	if dispersal.CreatedBy != nil {
+		createdBy := dispersal.CreatedBy.String()
+		protoDispersal.CreatedBy = &createdBy
+	}
+	if dispersal.CompletedAt != nil {
+		protoDispersal.CompletedAt = timestamppb.New(*dispersal.CompletedAt)
+	}
+	return protoDispersal
+}
@@ -0,0 +1,79 @@
+package dispersal
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrTeamNotFound is returned when a fantasy team does not exist
+	ErrTeamNotFound = errors.New("fantasy team not found")
+	// ErrDispersalDraftNotFound is returned when a draft doesn't exist or isn't a dispersal draft
+	ErrDispersalDraftNotFound = errors.New("dispersal draft not found")
+	// ErrNotCommissioner is returned when someone other than the commissioner runs a dispersal
+	ErrNotCommissioner = errors.New("only the league commissioner can do this")
+	// ErrAlreadyFolding is returned when a team's players are already pooled in a dispersal draft
+	// that hasn't completed
+	ErrAlreadyFolding = errors.New("team is already in a dispersal draft")
+	// ErrNoPlayers is returned when a folded team has no players to pool
+	ErrNoPlayers = errors.New("team has no players to disperse")
+	// ErrTeamNotInLeague is returned when a dispersal draft order names a team of another league
+	ErrTeamNotInLeague = errors.New("team is not in the folded team's league")
+	// ErrFoldedTeamInOrder is returned when a folded team is put in its own dispersal draft order
+	ErrFoldedTeamInOrder = errors.New("the folded team can't pick in its own dispersal draft")
+	// ErrDuplicateTeam is returned when a dispersal draft order lists a team twice
+	ErrDuplicateTeam = errors.New("team is in the draft order more than once")
+	// ErrNoTeamsLeft is returned when a league has no teams left to take a folded team's players
+	ErrNoTeamsLeft = errors.New("no teams left to disperse to")
+	// ErrDraftNotCompleted is returned when a dispersal's assets are reassigned before its draft
+	// has completed
+	ErrDraftNotCompleted = errors.New("dispersal draft has not completed")
+)
+
+// CreateDispersalDraftRequest pools a folded team's players into a new dispersal draft
+type CreateDispersalDraftRequest struct {
+	FoldedTeamID   uuid.UUID   `json:"folded_team_id"`
+	DraftOrder     []uuid.UUID `json:"draft_order"` // empty uses the league's other teams in the order they joined
+	TimePerPickSec int         `json:"time_per_pick_sec"`
+	ScheduledAt    *time.Time  `json:"scheduled_at,omitempty"`
+	RequestedBy    *uuid.UUID  `json:"requested_by,omitempty"` // nil for trusted callers
+}
+
+// DispersalPlan is the draft a dispersal creates through the draft engine
+type DispersalPlan struct {
+	LeagueID     uuid.UUID
+	FoldedTeamID uuid.UUID
+	DraftOrder   []uuid.UUID
+	Rounds       int
+}
+
+// Dispersal is a dispersal draft with what its app needs to know about the draft
+type Dispersal struct {
+	models.DispersalDraft
+	Status         models.DraftStatus
+	CommissionerID uuid.UUID
+}
+
+// Team is what a dispersal needs to know about a fantasy team
+type Team struct {
+	ID             uuid.UUID
+	LeagueID       uuid.UUID
+	CommissionerID uuid.UUID
+}
+
+// FoldedTeamPick is an unmade pick of a folded team in one of its league's upcoming drafts
+type FoldedTeamPick struct {
+	PickID  uuid.UUID
+	DraftID uuid.UUID
+	Round   int
+}
+
+// PickAssignment is the team a folded team's pick goes to
+type PickAssignment struct {
+	PickID    uuid.UUID
+	DraftID   uuid.UUID
+	NewTeamID uuid.UUID
+}
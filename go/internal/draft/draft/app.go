@@ -2,39 +2,105 @@ package draft
 
 import (
 	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"net/url"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/clock"
 	"github.com/mcdev12/dynasty/go/internal/models"
 )
 
+// catchUpRecentPicks is how many of the latest picks a catch-up summary includes
+const catchUpRecentPicks = 10
+
+// webhookSecretPrefix starts every webhook signing secret so leaked secrets are easy to recognize
+const webhookSecretPrefix = "whsec_"
+
 // DraftRepository defines what the draft app layer needs from the draft repository
 type DraftRepository interface {
 	CreateDraft(ctx context.Context, req CreateDraftRequest) (*models.Draft, error)
 	GetDraft(ctx context.Context, id uuid.UUID) (*models.Draft, error)
-	UpdateDraftStatus(ctx context.Context, id uuid.UUID, req UpdateDraftStatusRequest) (*models.Draft, error)
+	GetDraftEventSequence(ctx context.Context, id uuid.UUID) (int64, error)
+	GetDraftSummary(ctx context.Context, draftID uuid.UUID) (*models.DraftSummary, error)
+	ListDraftTeamSummaries(ctx context.Context, draftID uuid.UUID) ([]models.TeamDraftSummary, error)
+	UpdateDraftStatus(ctx context.Context, id uuid.UUID, req UpdateDraftStatusRequest, check func(current *models.Draft) error) (*models.Draft, error)
 	UpdateDraft(ctx context.Context, id uuid.UUID, req UpdateDraftRequest) (*models.Draft, error)
+	UpdateScheduledAt(ctx context.Context, id uuid.UUID, scheduledAt time.Time, check func(current *models.Draft) error) (*Reschedule, error)
 	DeleteDraft(ctx context.Context, id uuid.UUID) error
-	FetchNextDeadline(ctx context.Context) (*NextDeadline, error)
-	FetchDraftsDueForPick(ctx context.Context, limit int32) ([]uuid.UUID, error)
-	UpdateNextDeadline(ctx context.Context, draftID uuid.UUID, deadline *time.Time) error
+	CloneDraftAsSandbox(ctx context.Context, req CloneDraftAsSandboxRequest) (*models.Draft, error)
+	DeleteSandboxDraft(ctx context.Context, id uuid.UUID) error
+	FetchNextDeadline(ctx context.Context, draftID *uuid.UUID) (*NextDeadline, error)
+	FetchDraftsDueForPick(ctx context.Context, req FetchDraftsDueForPickRequest) ([]uuid.UUID, error)
+	UpdateNextDeadline(ctx context.Context, draftID uuid.UUID, deadline *time.Time) (*NextDeadline, error)
+	SetNextDeadlineFromNow(ctx context.Context, draftID uuid.UUID, timeout time.Duration) (*NextDeadline, error)
 	ClearNextDeadline(ctx context.Context, id uuid.UUID) error
+	HasSlotSelectionInProgress(ctx context.Context, draftID uuid.UUID) (bool, error)
+	GetCurrentPick(ctx context.Context, draftID uuid.UUID) (*models.DraftPick, error)
+	ListRecentPicks(ctx context.Context, draftID uuid.UUID, limit int32) ([]models.DraftPick, error)
+	CountPicksMade(ctx context.Context, draftID uuid.UUID) (int, error)
+	ListDraftsForLeague(ctx context.Context, leagueID uuid.UUID) ([]models.Draft, error)
+	ListDraftsForUser(ctx context.Context, userID uuid.UUID) ([]UserDraft, error)
+	InsertChatReport(ctx context.Context, id uuid.UUID, report ChatReport) (uuid.UUID, error)
+	RecordFrameDelivery(ctx context.Context, delivery FrameDelivery) (bool, error)
+	RecordCommissionerPause(ctx context.Context, draftID uuid.UUID) error
+	ClearCommissionerPause(ctx context.Context, draftID uuid.UUID) (bool, error)
+	CreateMaintenanceWindow(ctx context.Context, req ScheduleMaintenanceRequest) (*models.MaintenanceWindow, error)
+	ListMaintenanceWindows(ctx context.Context, now time.Time) ([]models.MaintenanceWindow, error)
+	CancelMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error)
+	ListDraftIDsInProgress(ctx context.Context) ([]uuid.UUID, error)
+	RecordMaintenanceNotice(ctx context.Context, windowID uuid.UUID, draftID models.DraftID) (bool, error)
+	RecordMaintenancePause(ctx context.Context, draftID models.DraftID, windowID uuid.UUID) error
+	ListMaintenancePausesToLift(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	ClearMaintenancePause(ctx context.Context, draftID uuid.UUID) (bool, error)
+	AbandonTeam(ctx context.Context, req AbandonTeamRequest) (*AbandonTeamResult, error)
+	RestoreTeam(ctx context.Context, draftID models.DraftID, fantasyTeamID models.TeamID) (*RestoreTeamResult, error)
+	ListAbandonedTeams(ctx context.Context, draftID uuid.UUID) ([]models.AbandonedTeam, error)
+	RecordPickClockWarning(ctx context.Context, draftID uuid.UUID, deadline time.Time, percentRemaining int) (*PickClockWarning, error)
+	ExtendPickClock(ctx context.Context, req ExtendPickClockRequest) (*PickClockExtension, error)
+	RaiseWatchlistAlerts(ctx context.Context, draftID uuid.UUID) ([]models.WatchlistAlert, error)
+	ListDraftsStartingSoon(ctx context.Context, now, horizon time.Time) ([]ScheduledDraft, error)
+	RecordStartCountdown(ctx context.Context, draftID uuid.UUID, scheduledAt time.Time, minutesBefore int, announcedAt time.Time) (*StartCountdown, error)
+	SetTeamReady(ctx context.Context, req SetTeamReadyRequest) (*models.DraftLobby, error)
+	GetDraftLobby(ctx context.Context, draftID uuid.UUID) (*models.DraftLobby, error)
+	CreateWebhook(ctx context.Context, req CreateDraftWebhookRequest, secret string) (*models.DraftWebhook, error)
+	ListWebhooks(ctx context.Context, draftID uuid.UUID) ([]models.DraftWebhook, error)
+	DeleteWebhook(ctx context.Context, draftID models.DraftID, webhookID uuid.UUID) error
+	AddCoManager(ctx context.Context, req AddDraftCoManagerRequest) (*models.DraftCoManager, error)
+	RemoveCoManager(ctx context.Context, req RemoveDraftCoManagerRequest) error
+	ListCoManagers(ctx context.Context, draftID uuid.UUID) ([]models.DraftCoManager, error)
+	StartPauseVote(ctx context.Context, req StartPauseVoteRequest) (*models.PauseVote, error)
+	CastPauseVote(ctx context.Context, req CastPauseVoteRequest) (*models.PauseVote, error)
+	ClosePauseVote(ctx context.Context, draftID models.DraftID, voteID uuid.UUID, passed bool) (*ClosePauseVoteResult, error)
 }
 
 // App handles draft business logic
 type App struct {
-	repo DraftRepository
+	repo  DraftRepository
+	clock clock.Clock
 }
 
-// NewApp creates a new draft App
-func NewApp(repo DraftRepository) *App {
+// NewApp creates a new draft App. clock is what deadlines and scheduled times are checked
+// against.
+func NewApp(repo DraftRepository, clock clock.Clock) *App {
 	return &App{
-		repo: repo,
+		repo:  repo,
+		clock: clock,
 	}
 }
 
+// Now returns the time on the app's clock, for the service to timestamp events with
+func (a *App) Now() time.Time {
+	return a.clock.Now()
+}
+
 // CreateDraft creates a new draft with validation
 func (a *App) CreateDraft(ctx context.Context, req CreateDraftRequest) (*models.Draft, error) {
 	if err := a.validateCreateDraftRequest(req); err != nil {
@@ -64,31 +130,164 @@ func (a *App) GetDraft(ctx context.Context, id uuid.UUID) (*models.Draft, error)
 	return draft, nil
 }
 
+// GetDraftSummary returns a draft's progress without reading its picks
+func (a *App) GetDraftSummary(ctx context.Context, draftID uuid.UUID) (*models.DraftSummary, error) {
+	summary, err := a.repo.GetDraftSummary(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft summary: %w", err)
+	}
+	return summary, nil
+}
+
+// ListDraftTeamSummaries returns how each team of a draft fared, in the order of their first pick
+func (a *App) ListDraftTeamSummaries(ctx context.Context, draftID uuid.UUID) ([]models.TeamDraftSummary, error) {
+	summaries, err := a.repo.ListDraftTeamSummaries(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft team summaries: %w", err)
+	}
+	return summaries, nil
+}
+
+// GetDraftWithEventSequence returns a draft along with the sequence of the last event written
+// for it. The sequence is read before the draft, so every event at or below it is already
+// reflected in the returned draft; events above it may or may not be.
+func (a *App) GetDraftWithEventSequence(ctx context.Context, id uuid.UUID) (*models.Draft, int64, error) {
+	seq, err := a.repo.GetDraftEventSequence(ctx, id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get draft event sequence: %w", err)
+	}
+
+	draft, err := a.repo.GetDraft(ctx, id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get draft: %w", err)
+	}
+	return draft, seq, nil
+}
+
 // UpdateDraftStatus updates the status of a draft with validation
 func (a *App) UpdateDraftStatus(ctx context.Context, id uuid.UUID, status models.DraftStatus) (*models.Draft, error) {
-	req := UpdateDraftStatusRequest{Status: status}
+	return a.updateDraftStatus(ctx, id, UpdateDraftStatusRequest{Status: status}, false)
+}
 
-	if err := a.validateDraftStatus(req.Status); err != nil {
-		return nil, fmt.Errorf("validation failed: %w", err)
+// ForceCompleteDraft completes a draft that is in progress or paused however many picks are
+// left, which are left unmade. Platform operators use it for drafts stuck short of the end.
+func (a *App) ForceCompleteDraft(ctx context.Context, id uuid.UUID) (*models.Draft, error) {
+	draft, err := a.repo.UpdateDraftStatus(ctx, id, UpdateDraftStatusRequest{Status: models.DraftStatusCompleted}, func(current *models.Draft) error {
+		if current.Status != models.DraftStatusInProgress && current.Status != models.DraftStatusPaused {
+			return ErrDraftNotRunning
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to force draft to complete: %w", err)
 	}
 
-	// Verify draft exists and get current status
-	currentDraft, err := a.repo.GetDraft(ctx, id)
+	// A draft paused for its commissioner or a maintenance window waits on neither now
+	if _, err := a.repo.ClearCommissionerPause(ctx, id); err != nil {
+		log.Printf("Failed to clear commissioner pause of draft %s: %v", id, err)
+	}
+	if _, err := a.repo.ClearMaintenancePause(ctx, id); err != nil {
+		log.Printf("Failed to clear maintenance pause of draft %s: %v", id, err)
+	}
+	log.Printf("Forced draft %s to complete", id)
+	return draft, nil
+}
+
+// ResumeDraft moves a paused draft back into progress. The pick on the clock keeps the time it
+// had left when the draft paused, and the recomputed deadline is logged against actorID.
+func (a *App) ResumeDraft(ctx context.Context, id uuid.UUID, actorID *uuid.UUID) (*models.Draft, error) {
+	draft, err := a.updateDraftStatus(ctx, id, UpdateDraftStatusRequest{Status: models.DraftStatusInProgress, ActorID: actorID}, false)
 	if err != nil {
-		return nil, fmt.Errorf("draft not found: %w", err)
+		return nil, err
+	}
+
+	// However it was resumed, the draft no longer waits on its commissioner or a maintenance window
+	if _, err := a.repo.ClearCommissionerPause(ctx, id); err != nil {
+		log.Printf("Failed to clear commissioner pause of draft %s: %v", id, err)
+	}
+	if _, err := a.repo.ClearMaintenancePause(ctx, id); err != nil {
+		log.Printf("Failed to clear maintenance pause of draft %s: %v", id, err)
+	}
+	return draft, nil
+}
+
+// PauseDraftForCommissioner pauses an in-progress draft because its commissioner has been
+// disconnected from the draft room for the draft's wait, and records why so the draft is
+// resumed when they reconnect
+func (a *App) PauseDraftForCommissioner(ctx context.Context, id uuid.UUID) (*models.Draft, error) {
+	draft, err := a.repo.UpdateDraftStatus(ctx, id, UpdateDraftStatusRequest{Status: models.DraftStatusPaused}, func(current *models.Draft) error {
+		if current.Status != models.DraftStatusInProgress {
+			return ErrDraftNotInProgress
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause draft: %w", err)
 	}
 
-	// Validate status transition
-	if err := a.validateStatusTransition(currentDraft.Status, req.Status); err != nil {
-		return nil, fmt.Errorf("invalid status transition: %w", err)
+	if err := a.repo.RecordCommissionerPause(ctx, id); err != nil {
+		return nil, fmt.Errorf("failed to record commissioner pause: %w", err)
 	}
+	return draft, nil
+}
 
-	draft, err := a.repo.UpdateDraftStatus(ctx, id, req)
+// ClearCommissionerPause forgets that a draft was paused for its commissioner's disconnect,
+// reporting whether it was
+func (a *App) ClearCommissionerPause(ctx context.Context, id uuid.UUID) (bool, error) {
+	paused, err := a.repo.ClearCommissionerPause(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to clear commissioner pause: %w", err)
+	}
+	return paused, nil
+}
+
+// StartDraft moves a draft that hasn't started yet, or is paused, into progress. A draft that
+// requires every team to be ready only starts before they are when overrideReadiness is set.
+func (a *App) StartDraft(ctx context.Context, id uuid.UUID, overrideReadiness bool) (*models.Draft, error) {
+	return a.updateDraftStatus(ctx, id, UpdateDraftStatusRequest{Status: models.DraftStatusInProgress}, !overrideReadiness)
+}
+
+func (a *App) updateDraftStatus(ctx context.Context, id uuid.UUID, req UpdateDraftStatusRequest, checkReadiness bool) (*models.Draft, error) {
+	if err := a.validateDraftStatus(req.Status); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Nothing starts or resumes into a deploy
+	if req.Status == models.DraftStatusInProgress {
+		if err := a.ensureNoMaintenance(ctx, a.clock.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	// The transition is validated against the current status under the draft lock
+	var previousStatus models.DraftStatus
+	draft, err := a.repo.UpdateDraftStatus(ctx, id, req, func(currentDraft *models.Draft) error {
+		previousStatus = currentDraft.Status
+
+		// Validate status transition
+		if err := a.validateStatusTransition(currentDraft.Status, req.Status); err != nil {
+			return fmt.Errorf("invalid status transition: %w", err)
+		}
+
+		// The draft order is not final until every team has chosen its slot
+		if currentDraft.Status == models.DraftStatusNotStarted && req.Status == models.DraftStatusInProgress {
+			if err := a.ensureSlotSelectionFinished(ctx, id); err != nil {
+				return err
+			}
+			if checkReadiness && currentDraft.Settings.RequireAllTeamsReady {
+				return a.ensureTeamsReady(ctx, id)
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update draft status: %w", err)
 	}
 
-	log.Printf("Updated draft status: %s -> %s", currentDraft.Status, req.Status)
+	log.Printf("Updated draft status: %s -> %s", previousStatus, req.Status)
+	if previousStatus == models.DraftStatusPaused && draft.NextDeadline != nil {
+		log.Printf("Recomputed pick deadline of resumed draft %s: %s", id, draft.NextDeadline.Format(time.RFC3339))
+	}
 	return draft, nil
 }
 
@@ -100,8 +299,17 @@ func (a *App) UpdateDraft(ctx context.Context, id uuid.UUID, req UpdateDraftRequ
 		return nil, fmt.Errorf("draft not found: %w", err)
 	}
 
-	// Only allow updates for NOT_STARTED drafts
-	if currentDraft.Status != models.DraftStatusNotStarted {
+	// Only allow updates for NOT_STARTED drafts, and changes to the pick clock while paused
+	switch currentDraft.Status {
+	case models.DraftStatusNotStarted:
+	case models.DraftStatusPaused:
+		if req.ScheduledAt != nil {
+			return nil, fmt.Errorf("cannot reschedule a draft with status %s", currentDraft.Status)
+		}
+		if req.Settings != nil && !onlyClockSettingsChanged(currentDraft.Settings, *req.Settings) {
+			return nil, fmt.Errorf("only pick clock settings can change while a draft is %s", currentDraft.Status)
+		}
+	default:
 		return nil, fmt.Errorf("can only update drafts with status %s, current status is %s",
 			models.DraftStatusNotStarted, currentDraft.Status)
 	}
@@ -111,11 +319,17 @@ func (a *App) UpdateDraft(ctx context.Context, id uuid.UUID, req UpdateDraftRequ
 		if err := a.validateDraftSettings(currentDraft.DraftType, *req.Settings); err != nil {
 			return nil, fmt.Errorf("invalid draft settings: %w", err)
 		}
+		// Slot selection owns the draft order while it runs
+		if currentDraft.Status == models.DraftStatusNotStarted {
+			if err := a.ensureSlotSelectionFinished(ctx, id); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Validate scheduled_at if provided
-	if req.ScheduledAt != nil && req.ScheduledAt.Before(time.Now()) {
-		return nil, fmt.Errorf("scheduled_at must be in the future")
+	if req.ScheduledAt != nil && req.ScheduledAt.Before(a.clock.Now()) {
+		return nil, ErrScheduledInPast
 	}
 
 	draft, err := a.repo.UpdateDraft(ctx, id, req)
@@ -124,10 +338,55 @@ func (a *App) UpdateDraft(ctx context.Context, id uuid.UUID, req UpdateDraftRequ
 	}
 
 	log.Printf("Updated draft %s: settings=%v, scheduled_at=%v", id, req.Settings != nil, req.ScheduledAt)
+	if currentDraft.Status == models.DraftStatusPaused && req.Settings != nil && draft.NextDeadline != nil {
+		log.Printf("Recomputed pick deadline of paused draft %s: %s", id, draft.NextDeadline.Format(time.RFC3339))
+	}
 	return draft, nil
 }
 
-// DeleteDraft deletes a draft by ID (only allowed for NOT_STARTED drafts)
+// UpdateScheduledAt moves a draft yet to start to a new scheduled start. League members are
+// sent an updated calendar invite.
+func (a *App) UpdateScheduledAt(ctx context.Context, id uuid.UUID, scheduledAt time.Time) (*Reschedule, error) {
+	if scheduledAt.Before(a.clock.Now()) {
+		return nil, ErrScheduledInPast
+	}
+
+	reschedule, err := a.repo.UpdateScheduledAt(ctx, id, scheduledAt, func(current *models.Draft) error {
+		if current.Status != models.DraftStatusNotStarted {
+			return ErrDraftAlreadyStarted
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reschedule draft: %w", err)
+	}
+
+	log.Printf("Rescheduled draft %s to %s", id, scheduledAt.Format(time.RFC3339))
+	return reschedule, nil
+}
+
+// onlyClockSettingsChanged reports whether updated differs from current only in the pick
+// clock: the time per pick, its round overrides, the clock warnings and the pause window
+func onlyClockSettingsChanged(current, updated models.DraftSettings) bool {
+	updated.TimePerPickSec = current.TimePerPickSec
+	updated.RoundTimers = current.RoundTimers
+	updated.ClockWarningPercents = current.ClockWarningPercents
+	updated.PauseWindow = current.PauseWindow
+
+	// Compared as stored, so nil and empty lists are alike
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return false
+	}
+	updatedJSON, err := json.Marshal(updated)
+	if err != nil {
+		return false
+	}
+	return string(currentJSON) == string(updatedJSON)
+}
+
+// DeleteDraft deletes a draft by ID (only allowed for NOT_STARTED drafts). Sandbox drafts are
+// discarded whatever their status, unless they're in progress.
 func (a *App) DeleteDraft(ctx context.Context, id uuid.UUID) error {
 	// Verify draft exists and check status
 	draft, err := a.repo.GetDraft(ctx, id)
@@ -135,6 +394,17 @@ func (a *App) DeleteDraft(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("draft not found: %w", err)
 	}
 
+	if draft.SandboxOf != nil {
+		if draft.Status == models.DraftStatusInProgress {
+			return ErrSandboxRunning
+		}
+		if err := a.repo.DeleteSandboxDraft(ctx, id); err != nil {
+			return fmt.Errorf("failed to discard sandbox draft: %w", err)
+		}
+		log.Printf("Discarded sandbox draft %s of draft %s (status: %s)", id, *draft.SandboxOf, draft.Status)
+		return nil
+	}
+
 	// Only allow deletion of drafts that haven't started
 	if draft.Status != models.DraftStatusNotStarted {
 		return fmt.Errorf("cannot delete draft with status %s, only %s drafts can be deleted",
@@ -149,47 +419,590 @@ func (a *App) DeleteDraft(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// FetchNextDeadline retrieves the next draft deadline across all active drafts
-func (a *App) FetchNextDeadline(ctx context.Context) (*NextDeadline, error) {
-	deadline, err := a.repo.FetchNextDeadline(ctx)
+// CloneDraftAsSandbox copies a draft's settings, order and keeper slots into a sandbox draft
+// against the same player pool, for the commissioner to run end to end with bots standing in
+// for the teams they choose. Nothing done in the sandbox reaches the league's real data.
+func (a *App) CloneDraftAsSandbox(ctx context.Context, req CloneDraftAsSandboxRequest) (*models.Draft, error) {
+	if req.DraftID == uuid.Nil {
+		return nil, fmt.Errorf("validation failed: draft_id is required")
+	}
+
+	sandbox, err := a.repo.CloneDraftAsSandbox(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone draft as sandbox: %w", err)
+	}
+
+	log.Printf("Cloned draft %s as sandbox draft %s (%d bot teams)", req.DraftID, sandbox.ID, len(req.BotTeamIDs))
+	return sandbox, nil
+}
+
+// FetchNextDeadline retrieves the next draft deadline across all active drafts,
+// or the deadline of a single draft when draftID is set
+func (a *App) FetchNextDeadline(ctx context.Context, draftID *uuid.UUID) (*NextDeadline, error) {
+	deadline, err := a.repo.FetchNextDeadline(ctx, draftID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch next deadline: %w", err)
 	}
 	return deadline, nil
 }
 
-// FetchDraftsDueForPick retrieves drafts that have exceeded their pick deadline
-func (a *App) FetchDraftsDueForPick(ctx context.Context, limit int32) ([]uuid.UUID, error) {
-	if limit <= 0 {
+// FetchDraftsDueForPick claims drafts that have exceeded their pick deadline for the caller.
+// Claimed drafts aren't handed out again until the lease runs out or their deadline moves.
+func (a *App) FetchDraftsDueForPick(ctx context.Context, req FetchDraftsDueForPickRequest) ([]uuid.UUID, error) {
+	if req.Limit <= 0 {
 		return nil, fmt.Errorf("limit must be greater than 0")
 	}
+	if req.ClaimedBy == "" {
+		return nil, fmt.Errorf("claimed_by is required")
+	}
+	if req.Lease < 0 {
+		return nil, fmt.Errorf("lease cannot be negative")
+	}
+	if req.Lease < time.Second {
+		req.Lease = DefaultDueDraftClaimLease
+	}
 
-	draftIDs, err := a.repo.FetchDraftsDueForPick(ctx, limit)
+	draftIDs, err := a.repo.FetchDraftsDueForPick(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch drafts due for pick: %w", err)
 	}
 
+	if len(draftIDs) > 0 {
+		log.Printf("Claimed %d drafts due for a pick for %s (lease %s)", len(draftIDs), req.ClaimedBy, req.Lease)
+	}
 	return draftIDs, nil
 }
 
 // UpdateNextDeadline updates the deadline for when the next pick should be made
-func (a *App) UpdateNextDeadline(ctx context.Context, draftID uuid.UUID, deadline *time.Time) error {
-	// Verify draft exists and is in progress
-	draft, err := a.repo.GetDraft(ctx, draftID)
+func (a *App) UpdateNextDeadline(ctx context.Context, draftID uuid.UUID, deadline *time.Time) (*NextDeadline, error) {
+	if err := a.ensureInProgress(ctx, draftID); err != nil {
+		return nil, err
+	}
+
+	next, err := a.repo.UpdateNextDeadline(ctx, draftID, deadline)
 	if err != nil {
-		return fmt.Errorf("draft not found: %w", err)
+		return nil, fmt.Errorf("failed to update next deadline: %w", err)
 	}
 
-	// Only allow deadline updates for drafts in progress
-	if draft.Status != models.DraftStatusInProgress {
-		return fmt.Errorf("can only update deadline for drafts with status %s, current status is %s",
-			models.DraftStatusInProgress, draft.Status)
+	return next, nil
+}
+
+// StartPickClock sets the next deadline to timeout from now, where now is the database
+// clock rather than the caller's, so a skewed host can't shorten or stretch the pick
+func (a *App) StartPickClock(ctx context.Context, draftID uuid.UUID, timeout time.Duration) (*NextDeadline, error) {
+	if timeout <= 0 {
+		return nil, fmt.Errorf("validation failed: pick timeout must be positive")
+	}
+
+	if err := a.ensureInProgress(ctx, draftID); err != nil {
+		return nil, err
+	}
+
+	next, err := a.repo.SetNextDeadlineFromNow(ctx, draftID, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start pick clock: %w", err)
+	}
+
+	return next, nil
+}
+
+// WarnPickClock warns the team on the clock that percentRemaining of its pick clock is left.
+// It returns nil when the clock has moved on from deadline or the warning was already given.
+func (a *App) WarnPickClock(ctx context.Context, draftID uuid.UUID, deadline time.Time, percentRemaining int) (*PickClockWarning, error) {
+	warning, err := a.repo.RecordPickClockWarning(ctx, draftID, deadline, percentRemaining)
+	if err != nil {
+		return nil, fmt.Errorf("failed to warn pick clock: %w", err)
+	}
+
+	if warning != nil {
+		log.Printf("Warned team %s in draft %s: %d%% of the pick clock left", warning.Pick.TeamID, draftID, percentRemaining)
+	}
+	return warning, nil
+}
+
+// ExtendPickClock gives the team on the clock a one-time extension of its pick clock, pushing
+// the deadline back by req.Extension
+func (a *App) ExtendPickClock(ctx context.Context, req ExtendPickClockRequest) (*PickClockExtension, error) {
+	if req.Extension < time.Second {
+		return nil, fmt.Errorf("validation failed: extension must be at least a second")
+	}
+
+	extension, err := a.repo.ExtendPickClock(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extend pick clock: %w", err)
+	}
+
+	log.Printf("Extended the pick clock of team %s in draft %s by %s to %s", extension.Pick.TeamID, req.DraftID, req.Extension, extension.Deadline.Format(time.RFC3339))
+	return extension, nil
+}
+
+// RaiseWatchlistAlerts raises the watchlist alerts due now that a new pick is on the clock,
+// returning the ones not raised before
+func (a *App) RaiseWatchlistAlerts(ctx context.Context, draftID uuid.UUID) ([]models.WatchlistAlert, error) {
+	alerts, err := a.repo.RaiseWatchlistAlerts(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to raise watchlist alerts: %w", err)
+	}
+
+	if len(alerts) > 0 {
+		log.Printf("Raised %d watchlist alerts in draft %s", len(alerts), draftID)
+	}
+	return alerts, nil
+}
+
+// AnnounceDraftsStartingSoon records the countdown checkpoint reached as of now by each draft
+// scheduled to start within the first checkpoint, returning the ones not announced before. A
+// draft past several checkpoints, e.g. after no worker ran for a while, announces only the
+// latest. Countdowns recorded before an error are returned with it.
+func (a *App) AnnounceDraftsStartingSoon(ctx context.Context, now time.Time) ([]StartCountdown, error) {
+	horizon := now.Add(time.Duration(StartCountdownMinutes[0]) * time.Minute)
+	drafts, err := a.repo.ListDraftsStartingSoon(ctx, now, horizon)
+	if err != nil {
+		return nil, err
+	}
+
+	var countdowns []StartCountdown
+	for _, draft := range drafts {
+		minutesBefore := dueStartCountdown(draft.ScheduledAt.Sub(now))
+		countdown, err := a.repo.RecordStartCountdown(ctx, draft.DraftID, draft.ScheduledAt, minutesBefore, now)
+		if err != nil {
+			return countdowns, fmt.Errorf("failed to record start countdown for draft %s: %w", draft.DraftID, err)
+		}
+		if countdown == nil {
+			continue
+		}
+
+		log.Printf("Draft %s starts in %d minutes (owners notified: %t)", draft.DraftID, minutesBefore, countdown.OwnersNotified)
+		countdowns = append(countdowns, *countdown)
+	}
+	return countdowns, nil
+}
+
+// dueStartCountdown returns the latest countdown checkpoint reached with remaining left
+// before a draft's start
+func dueStartCountdown(remaining time.Duration) int {
+	due := StartCountdownMinutes[0]
+	for _, minutes := range StartCountdownMinutes {
+		if remaining <= time.Duration(minutes)*time.Minute {
+			due = minutes
+		}
+	}
+	return due
+}
+
+// ScheduleMaintenance schedules a maintenance window. It must close after now and stay open
+// no longer than MaxMaintenanceWindow.
+func (a *App) ScheduleMaintenance(ctx context.Context, req ScheduleMaintenanceRequest, now time.Time) (*models.MaintenanceWindow, error) {
+	switch {
+	case req.StartsAt.IsZero() || req.EndsAt.IsZero():
+		return nil, fmt.Errorf("%w: starts_at and ends_at are required", ErrInvalidMaintenanceWindow)
+	case !req.EndsAt.After(req.StartsAt):
+		return nil, fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidMaintenanceWindow)
+	case !req.EndsAt.After(now):
+		return nil, fmt.Errorf("%w: ends_at must be in the future", ErrInvalidMaintenanceWindow)
+	case req.EndsAt.Sub(req.StartsAt) > MaxMaintenanceWindow:
+		return nil, fmt.Errorf("%w: a window can't stay open longer than %s", ErrInvalidMaintenanceWindow, MaxMaintenanceWindow)
+	case len(req.Message) > 500:
+		return nil, fmt.Errorf("%w: message must be 500 characters or fewer", ErrInvalidMaintenanceWindow)
+	}
+
+	window, err := a.repo.CreateMaintenanceWindow(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Scheduled maintenance window %s from %s to %s", window.ID, window.StartsAt.Format(time.RFC3339), window.EndsAt.Format(time.RFC3339))
+	return window, nil
+}
+
+// ListMaintenanceWindows returns the maintenance windows that haven't closed or been cancelled
+// as of now, the next to open first
+func (a *App) ListMaintenanceWindows(ctx context.Context, now time.Time) ([]models.MaintenanceWindow, error) {
+	return a.repo.ListMaintenanceWindows(ctx, now)
+}
+
+// CancelMaintenance cancels a maintenance window. Drafts it paused are resumed on the next
+// maintenance run.
+func (a *App) CancelMaintenance(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error) {
+	window, err := a.repo.CancelMaintenanceWindow(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Cancelled maintenance window %s", window.ID)
+	return window, nil
+}
+
+// AnnounceMaintenance records a notice, for every draft in progress, of each maintenance
+// window opening within MaintenanceNoticeLead of now or already open, returning the ones not
+// announced before. Notices recorded before an error are returned with it.
+func (a *App) AnnounceMaintenance(ctx context.Context, now time.Time) ([]MaintenanceNotice, error) {
+	windows, err := a.upcomingMaintenance(ctx, now)
+	if err != nil || len(windows) == 0 {
+		return nil, err
+	}
+
+	draftIDs, err := a.repo.ListDraftIDsInProgress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var notices []MaintenanceNotice
+	for _, window := range windows {
+		for _, draftID := range draftIDs {
+			announced, err := a.repo.RecordMaintenanceNotice(ctx, window.ID, models.DraftID(draftID))
+			if err != nil {
+				return notices, fmt.Errorf("failed to record maintenance notice for draft %s: %w", draftID, err)
+			}
+			if announced {
+				notices = append(notices, MaintenanceNotice{DraftID: draftID, Window: window, AnnouncedAt: now})
+			}
+		}
+	}
+	return notices, nil
+}
+
+// PauseDraftsForMaintenance pauses every draft in progress while a maintenance window is open
+// at now, and records why so each is resumed when the window closes. Pauses made before an
+// error are returned with it.
+func (a *App) PauseDraftsForMaintenance(ctx context.Context, now time.Time) ([]MaintenancePause, error) {
+	windows, err := a.repo.ListMaintenanceWindows(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	var open *models.MaintenanceWindow
+	for i := range windows {
+		if windows[i].Open(now) {
+			open = &windows[i]
+			break
+		}
+	}
+	if open == nil {
+		return nil, nil
+	}
+
+	draftIDs, err := a.repo.ListDraftIDsInProgress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pauses []MaintenancePause
+	for _, draftID := range draftIDs {
+		_, err := a.repo.UpdateDraftStatus(ctx, draftID, UpdateDraftStatusRequest{Status: models.DraftStatusPaused}, func(current *models.Draft) error {
+			if current.Status != models.DraftStatusInProgress {
+				return ErrDraftNotInProgress
+			}
+			return nil
+		})
+		if errors.Is(err, ErrDraftNotInProgress) {
+			// Paused or finished since it was listed
+			continue
+		}
+		if err != nil {
+			return pauses, fmt.Errorf("failed to pause draft %s for maintenance: %w", draftID, err)
+		}
+
+		if err := a.repo.RecordMaintenancePause(ctx, models.DraftID(draftID), open.ID); err != nil {
+			return pauses, fmt.Errorf("failed to record maintenance pause of draft %s: %w", draftID, err)
+		}
+		pauses = append(pauses, MaintenancePause{DraftID: draftID, Window: *open, PausedAt: now})
+	}
+	return pauses, nil
+}
+
+// ListMaintenancePausesToLift returns the drafts still paused for a maintenance window that
+// closed or was cancelled as of now
+func (a *App) ListMaintenancePausesToLift(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	return a.repo.ListMaintenancePausesToLift(ctx, now)
+}
+
+// ensureNoMaintenance returns ErrMaintenance while a maintenance window is open or opens
+// within MaintenanceNoticeLead of now
+func (a *App) ensureNoMaintenance(ctx context.Context, now time.Time) error {
+	windows, err := a.upcomingMaintenance(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to check for maintenance: %w", err)
+	}
+	if len(windows) > 0 {
+		return fmt.Errorf("%w until %s", ErrMaintenance, windows[0].EndsAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// upcomingMaintenance returns the maintenance windows open at now or opening within
+// MaintenanceNoticeLead of it
+func (a *App) upcomingMaintenance(ctx context.Context, now time.Time) ([]models.MaintenanceWindow, error) {
+	windows, err := a.repo.ListMaintenanceWindows(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var upcoming []models.MaintenanceWindow
+	for _, window := range windows {
+		if !window.StartsAt.After(now.Add(MaintenanceNoticeLead)) {
+			upcoming = append(upcoming, window)
+		}
+	}
+	return upcoming, nil
+}
+
+// ListDraftsForLeague returns a league's drafts, newest first
+func (a *App) ListDraftsForLeague(ctx context.Context, leagueID uuid.UUID) ([]models.Draft, error) {
+	drafts, err := a.repo.ListDraftsForLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drafts for league: %w", err)
+	}
+	return drafts, nil
+}
+
+// ListDraftsForUser returns the unfinished drafts in the user's leagues
+func (a *App) ListDraftsForUser(ctx context.Context, userID uuid.UUID) ([]UserDraft, error) {
+	drafts, err := a.repo.ListDraftsForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drafts for user: %w", err)
+	}
+	return drafts, nil
+}
+
+// ReportChatMessage records a flagged chat message. duplicate is true when the reporter
+// had already flagged the message, in which case the original report's id is returned.
+func (a *App) ReportChatMessage(ctx context.Context, report ChatReport) (reportID uuid.UUID, duplicate bool, err error) {
+	if report.ReporterUserID == report.SenderUserID {
+		return uuid.Nil, false, ErrCannotReportOwnMessage
+	}
+
+	id := uuid.New()
+	reportID, err = a.repo.InsertChatReport(ctx, id, report)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("failed to report chat message: %w", err)
+	}
+
+	log.Printf("Chat message %s in draft %s reported by %s", report.MessageID, report.DraftID, report.ReporterUserID)
+	return reportID, reportID != id, nil
+}
+
+// RecordFrameDelivery records whether a user acknowledged a frame the gateway required them
+// to, reporting whether an unacknowledged frame fell back to a push notification
+func (a *App) RecordFrameDelivery(ctx context.Context, delivery FrameDelivery) (bool, error) {
+	pushQueued, err := a.repo.RecordFrameDelivery(ctx, delivery)
+	if err != nil {
+		return false, fmt.Errorf("failed to record frame delivery: %w", err)
+	}
+
+	if pushQueued {
+		log.Printf("%s frame %s in draft %s unacknowledged by %s, push notification queued", delivery.EventType, delivery.EventID, delivery.DraftID, delivery.UserID)
+	}
+	return pushQueued, nil
+}
+
+// AbandonTeam takes a team whose owner stopped taking part out of a running draft. Its
+// remaining picks are auto-picked as they come up or, when skipped, forfeited at once.
+func (a *App) AbandonTeam(ctx context.Context, req AbandonTeamRequest) (*AbandonTeamResult, error) {
+	if err := a.validateAbandonTeamRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	result, err := a.repo.AbandonTeam(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abandon team: %w", err)
+	}
+
+	log.Printf("Team %s abandoned in draft %s (%s, %d picks forfeited)", req.FantasyTeamID, req.DraftID, req.PickHandling, len(result.ForfeitedPickIDs))
+	return result, nil
+}
+
+// RestoreTeam hands an abandoned team back to its owner, along with the forfeited picks
+// the draft hasn't moved past
+func (a *App) RestoreTeam(ctx context.Context, draftID models.DraftID, fantasyTeamID models.TeamID) (*RestoreTeamResult, error) {
+	result, err := a.repo.RestoreTeam(ctx, draftID, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore team: %w", err)
+	}
+
+	log.Printf("Team %s restored in draft %s (%d picks restored)", fantasyTeamID, draftID, len(result.RestoredPickIDs))
+	return result, nil
+}
+
+// ListAbandonedTeams returns the teams abandoned in a draft, oldest first
+func (a *App) ListAbandonedTeams(ctx context.Context, draftID uuid.UUID) ([]models.AbandonedTeam, error) {
+	teams, err := a.repo.ListAbandonedTeams(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list abandoned teams: %w", err)
+	}
+	return teams, nil
+}
+
+// SetTeamReady marks a team ready, or no longer ready, in the lobby of a draft that hasn't
+// started, and returns the lobby as it stands after the change
+func (a *App) SetTeamReady(ctx context.Context, req SetTeamReadyRequest) (*models.DraftLobby, error) {
+	lobby, err := a.repo.SetTeamReady(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set team readiness: %w", err)
+	}
+
+	log.Printf("Team %s marked %s in draft %s lobby (%d of %d ready)", req.FantasyTeamID, readinessLabel(req.Ready), req.DraftID, lobby.ReadyCount(), len(lobby.Teams))
+	return lobby, nil
+}
+
+// GetDraftLobby returns the readiness of every team in a draft's order
+func (a *App) GetDraftLobby(ctx context.Context, draftID uuid.UUID) (*models.DraftLobby, error) {
+	lobby, err := a.repo.GetDraftLobby(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft lobby: %w", err)
+	}
+	return lobby, nil
+}
+
+// CreateWebhook registers an endpoint to post a draft's pick events to. The secret its
+// deliveries are signed with is returned once and can't be retrieved again.
+func (a *App) CreateWebhook(ctx context.Context, req CreateDraftWebhookRequest) (*IssuedDraftWebhook, error) {
+	if err := a.validateCreateWebhookRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	secret := webhookSecretPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	webhook, err := a.repo.CreateWebhook(ctx, req, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	log.Printf("Created webhook %s for draft %s posting to %s", webhook.ID, req.DraftID, webhook.URL)
+	return &IssuedDraftWebhook{Webhook: webhook, Secret: secret}, nil
+}
+
+// ListWebhooks returns a draft's webhooks, oldest first
+func (a *App) ListWebhooks(ctx context.Context, draftID uuid.UUID) ([]models.DraftWebhook, error) {
+	webhooks, err := a.repo.ListWebhooks(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook removes a draft's webhook, dropping the deliveries it hasn't made yet
+func (a *App) DeleteWebhook(ctx context.Context, draftID models.DraftID, webhookID uuid.UUID) error {
+	if err := a.repo.DeleteWebhook(ctx, draftID, webhookID); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	log.Printf("Deleted webhook %s of draft %s", webhookID, draftID)
+	return nil
+}
+
+// AddCoManager lets a user besides a team's owner make the team's picks in a draft
+func (a *App) AddCoManager(ctx context.Context, req AddDraftCoManagerRequest) (*models.DraftCoManager, error) {
+	coManager, err := a.repo.AddCoManager(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add co-manager: %w", err)
+	}
+
+	log.Printf("User %s now co-manages team %s in draft %s", req.UserID, req.FantasyTeamID, req.DraftID)
+	return coManager, nil
+}
+
+// RemoveCoManager stops a co-manager making a team's picks in a draft
+func (a *App) RemoveCoManager(ctx context.Context, req RemoveDraftCoManagerRequest) error {
+	if err := a.repo.RemoveCoManager(ctx, req); err != nil {
+		return fmt.Errorf("failed to remove co-manager: %w", err)
+	}
+
+	log.Printf("User %s no longer co-manages team %s in draft %s", req.UserID, req.FantasyTeamID, req.DraftID)
+	return nil
+}
+
+// ListCoManagers returns the co-managers of every team in a draft
+func (a *App) ListCoManagers(ctx context.Context, draftID uuid.UUID) ([]models.DraftCoManager, error) {
+	coManagers, err := a.repo.ListCoManagers(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list co-managers: %w", err)
+	}
+	return coManagers, nil
+}
+
+// StartPauseVote opens a vote to pause a running draft, with the initiating team voting for it
+func (a *App) StartPauseVote(ctx context.Context, req StartPauseVoteRequest) (*models.PauseVote, error) {
+	vote, err := a.repo.StartPauseVote(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start pause vote: %w", err)
 	}
 
-	if err := a.repo.UpdateNextDeadline(ctx, draftID, deadline); err != nil {
-		return fmt.Errorf("failed to update next deadline: %w", err)
+	log.Printf("Team %s started pause vote %s in draft %s (%d of %d teams needed, closes %s)", req.FantasyTeamID, vote.ID, req.DraftID, vote.VotesNeeded, vote.EligibleTeams, vote.ClosesAt.Format(time.RFC3339))
+	return vote, nil
+}
+
+// CastPauseVote casts or changes a team's ballot in an open pause vote
+func (a *App) CastPauseVote(ctx context.Context, req CastPauseVoteRequest) (*models.PauseVote, error) {
+	vote, err := a.repo.CastPauseVote(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cast pause vote: %w", err)
+	}
+
+	log.Printf("Team %s voted %t in pause vote %s of draft %s (%d for, %d against, %d needed)", req.FantasyTeamID, req.InFavor, req.VoteID, req.DraftID, vote.VotesFor, vote.VotesAgainst, vote.VotesNeeded)
+	return vote, nil
+}
+
+// ClosePauseVote closes a pause vote as passed or failed, pausing the draft when it passed and
+// the draft is still running. Closing a closed vote changes nothing.
+func (a *App) ClosePauseVote(ctx context.Context, draftID models.DraftID, voteID uuid.UUID, passed bool) (*ClosePauseVoteResult, error) {
+	result, err := a.repo.ClosePauseVote(ctx, draftID, voteID, passed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close pause vote: %w", err)
+	}
+
+	if result.Closed {
+		log.Printf("Pause vote %s of draft %s %s (%d for, %d against, %d needed)", voteID, draftID, result.Vote.Status, result.Vote.VotesFor, result.Vote.VotesAgainst, result.Vote.VotesNeeded)
+	}
+	if result.Paused {
+		log.Printf("Draft %s paused by vote %s", draftID, voteID)
+	}
+	return result, nil
+}
+
+// GetCurrentPick returns the pick on the clock, or sql.ErrNoRows once every pick is made
+func (a *App) GetCurrentPick(ctx context.Context, draftID uuid.UUID) (*models.DraftPick, error) {
+	return a.repo.GetCurrentPick(ctx, draftID)
+}
+
+// GetCatchUp summarizes where a draft stands: how many picks have been made, the most
+// recent of them, and who is on the clock
+func (a *App) GetCatchUp(ctx context.Context, draftID uuid.UUID) (*CatchUp, error) {
+	picksMade, err := a.repo.CountPicksMade(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	recent, err := a.repo.ListRecentPicks(ctx, draftID, catchUpRecentPicks)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := a.repo.GetCurrentPick(ctx, draftID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	return &CatchUp{
+		PicksMade:   picksMade,
+		RecentPicks: recent,
+		CurrentPick: current,
+	}, nil
+}
+
+// ensureInProgress verifies the draft exists and is in progress, the only state with a pick clock
+func (a *App) ensureInProgress(ctx context.Context, draftID uuid.UUID) error {
+	draft, err := a.repo.GetDraft(ctx, draftID)
+	if err != nil {
+		return fmt.Errorf("draft not found: %w", err)
 	}
 
+	if draft.Status != models.DraftStatusInProgress {
+		return fmt.Errorf("%w: current status is %s", ErrDraftNotInProgress, draft.Status)
+	}
 	return nil
 }
 
@@ -216,6 +1029,36 @@ func (a *App) ClearNextDeadline(ctx context.Context, draftID uuid.UUID) error {
 	return nil
 }
 
+// ensureSlotSelectionFinished returns ErrSlotSelectionInProgress while teams are still choosing draft slots
+func (a *App) ensureSlotSelectionFinished(ctx context.Context, draftID uuid.UUID) error {
+	inProgress, err := a.repo.HasSlotSelectionInProgress(ctx, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to check slot selection: %w", err)
+	}
+	if inProgress {
+		return ErrSlotSelectionInProgress
+	}
+	return nil
+}
+
+// ensureTeamsReady checks that every team in a draft's order has marked itself ready
+func (a *App) ensureTeamsReady(ctx context.Context, draftID uuid.UUID) error {
+	lobby, err := a.repo.GetDraftLobby(ctx, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to check team readiness: %w", err)
+	}
+	if !lobby.AllReady() {
+		return fmt.Errorf("%w: %d of %d teams ready", ErrTeamsNotReady, lobby.ReadyCount(), len(lobby.Teams))
+	}
+	return nil
+}
+
+func readinessLabel(ready bool) string {
+	if ready {
+		return "ready"
+	}
+	return "not ready"
+}
 
 // Validation methods
 
@@ -242,10 +1085,38 @@ func (a *App) validateCreateDraftRequest(req CreateDraftRequest) error {
 	return nil
 }
 
+// validateAbandonTeamRequest validates abandon team request
+func (a *App) validateAbandonTeamRequest(req AbandonTeamRequest) error {
+	if req.DraftID == uuid.Nil {
+		return fmt.Errorf("draft_id is required")
+	}
+	if req.FantasyTeamID == uuid.Nil {
+		return fmt.Errorf("fantasy_team_id is required")
+	}
+	switch req.PickHandling {
+	case models.AbandonedPickHandlingAutoPick, models.AbandonedPickHandlingSkip:
+		return nil
+	default:
+		return fmt.Errorf("invalid pick handling: %s", req.PickHandling)
+	}
+}
+
+// validateCreateWebhookRequest validates create webhook request
+func (a *App) validateCreateWebhookRequest(req CreateDraftWebhookRequest) error {
+	if req.DraftID == uuid.Nil {
+		return fmt.Errorf("draft_id is required")
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+	return nil
+}
+
 // validateDraftType validates draft type
 func (a *App) validateDraftType(draftType models.DraftType) error {
 	switch draftType {
-	case models.DraftTypeSnake, models.DraftTypeAuction, models.DraftTypeRookie:
+	case models.DraftTypeSnake, models.DraftTypeAuction, models.DraftTypeRookie, models.DraftTypeExpansion, models.DraftTypeDispersal:
 		return nil
 	default:
 		return fmt.Errorf("invalid draft type: %s", draftType)
@@ -301,18 +1172,47 @@ func (a *App) validateDraftSettings(draftType models.DraftType, settings models.
 	if settings.TimePerPickSec < 0 {
 		return fmt.Errorf("time_per_pick_sec cannot be negative")
 	}
+	if err := a.validateRoundTimers(settings); err != nil {
+		return err
+	}
+	if err := a.validateClockWarningPercents(settings); err != nil {
+		return err
+	}
+	if settings.PauseWindow != nil {
+		if err := settings.PauseWindow.Validate(); err != nil {
+			return fmt.Errorf("pause_window: %w", err)
+		}
+	}
+	if settings.PauseVote != nil {
+		if err := settings.PauseVote.Validate(); err != nil {
+			return fmt.Errorf("pause_vote: %w", err)
+		}
+	}
+	if settings.CommissionerDisconnectPause != nil {
+		if err := settings.CommissionerDisconnectPause.Validate(); err != nil {
+			return fmt.Errorf("commissioner_disconnect_pause: %w", err)
+		}
+	}
+	if settings.LivePickTrades != nil {
+		if err := settings.LivePickTrades.Validate(); err != nil {
+			return fmt.Errorf("live_pick_trades: %w", err)
+		}
+	}
+	if err := settings.ValidateTypeSettings(draftType); err != nil {
+		return err
+	}
 
 	// Type-specific validations
 	switch draftType {
 	case models.DraftTypeAuction:
-		if settings.BudgetPerTeam == nil || *settings.BudgetPerTeam <= 0 {
-			return fmt.Errorf("budget_per_team is required and must be greater than 0 for auction drafts")
+		if settings.DeferPicksOnTimeout {
+			return fmt.Errorf("defer_picks_on_timeout is not supported for auction drafts")
 		}
-		if settings.MinBidIncrement == nil || *settings.MinBidIncrement <= 0 {
-			return fmt.Errorf("min_bid_increment is required and must be greater than 0 for auction drafts")
+		if settings.SkipPicksWithoutRosterSpace {
+			return fmt.Errorf("skip_picks_without_roster_space is not supported for auction drafts")
 		}
-		if settings.TimePerNominationSec == nil || *settings.TimePerNominationSec < 0 {
-			return fmt.Errorf("time_per_nomination_sec is required and cannot be negative for auction drafts")
+		if len(settings.ClockWarningPercents) > 0 {
+			return fmt.Errorf("clock_warning_percents is not supported for auction drafts")
 		}
 
 	case models.DraftTypeSnake:
@@ -329,7 +1229,66 @@ func (a *App) validateDraftSettings(draftType models.DraftType, settings models.
 		if settings.Rounds > 5 {
 			return fmt.Errorf("rookie drafts typically have 5 or fewer rounds")
 		}
+
+	case models.DraftTypeExpansion:
+		// Expansion drafts order only the new teams; everyone else is a player source
+		if len(settings.DraftOrder) == 0 {
+			return fmt.Errorf("draft_order is required for expansion drafts")
+		}
+		if settings.ProtectedPlayersPerTeam < 0 {
+			return fmt.Errorf("protected_players_per_team cannot be negative")
+		}
+		if settings.MaxPlayersLostPerTeam < 0 {
+			return fmt.Errorf("max_players_lost_per_team cannot be negative")
+		}
+
+	case models.DraftTypeDispersal:
+		// Dispersal drafts order the teams taking in the folded team's players
+		if len(settings.DraftOrder) == 0 {
+			return fmt.Errorf("draft_order is required for dispersal drafts")
+		}
 	}
 
 	return nil
 }
+
+// validateClockWarningPercents checks that each pick clock warning falls within the clock and
+// is listed once
+func (a *App) validateClockWarningPercents(settings models.DraftSettings) error {
+	for i, percent := range settings.ClockWarningPercents {
+		if percent < 1 || percent > 99 {
+			return fmt.Errorf("clock_warning_percents[%d]: must be between 1 and 99", i)
+		}
+		if slices.Contains(settings.ClockWarningPercents[:i], percent) {
+			return fmt.Errorf("clock_warning_percents[%d]: %d%% is listed twice", i, percent)
+		}
+	}
+	return nil
+}
+
+// validateRoundTimers checks that per-round pick clock overrides fall within the draft's
+// rounds and don't overlap, so every round resolves to exactly one timer
+func (a *App) validateRoundTimers(settings models.DraftSettings) error {
+	for i, t := range settings.RoundTimers {
+		if t.FromRound < 1 || t.FromRound > settings.Rounds {
+			return fmt.Errorf("round_timers[%d]: from_round must be between 1 and %d", i, settings.Rounds)
+		}
+		if t.ToRound != 0 && (t.ToRound < t.FromRound || t.ToRound > settings.Rounds) {
+			return fmt.Errorf("round_timers[%d]: to_round must be between from_round and %d, or 0 for the last round", i, settings.Rounds)
+		}
+		if t.TimePerPickSec < 0 {
+			return fmt.Errorf("round_timers[%d]: time_per_pick_sec cannot be negative", i)
+		}
+
+		last := t.ToRound
+		if last == 0 {
+			last = settings.Rounds
+		}
+		for j, other := range settings.RoundTimers[:i] {
+			if other.Covers(t.FromRound) || other.Covers(last) || t.Covers(other.FromRound) {
+				return fmt.Errorf("round_timers[%d] overlaps round_timers[%d]", i, j)
+			}
+		}
+	}
+	return nil
+}
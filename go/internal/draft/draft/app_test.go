@@ -0,0 +1,57 @@
+package draft
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/clock"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// rescheduleRepo is a DraftRepository holding a single draft yet to start. Only
+// UpdateScheduledAt is implemented.
+type rescheduleRepo struct {
+	DraftRepository
+	scheduledAt *time.Time
+}
+
+func (r *rescheduleRepo) UpdateScheduledAt(_ context.Context, id uuid.UUID, scheduledAt time.Time, check func(current *models.Draft) error) (*Reschedule, error) {
+	current := &models.Draft{ID: id, Status: models.DraftStatusNotStarted}
+	if err := check(current); err != nil {
+		return nil, err
+	}
+	r.scheduledAt = &scheduledAt
+	current.ScheduledAt = &scheduledAt
+	return &Reschedule{Draft: current}, nil
+}
+
+func TestUpdateScheduledAtChecksFrozenClock(t *testing.T) {
+	// Long past on the wall clock, so only the frozen clock can accept the start
+	now := time.Date(2020, time.September, 6, 19, 0, 0, 0, time.UTC)
+	frozen := clock.Frozen(now)
+	repo := &rescheduleRepo{}
+	app := NewApp(repo, frozen)
+	ctx := context.Background()
+	draftID := uuid.New()
+
+	start := now.Add(time.Hour)
+	if _, err := app.UpdateScheduledAt(ctx, draftID, start); err != nil {
+		t.Fatalf("UpdateScheduledAt(%s) at %s: %v", start, now, err)
+	}
+	if repo.scheduledAt == nil || !repo.scheduledAt.Equal(start) {
+		t.Fatalf("scheduled at %v, want %s", repo.scheduledAt, start)
+	}
+
+	if _, err := app.UpdateScheduledAt(ctx, draftID, now.Add(-time.Minute)); !errors.Is(err, ErrScheduledInPast) {
+		t.Fatalf("UpdateScheduledAt a minute before %s: got %v, want ErrScheduledInPast", now, err)
+	}
+
+	// Once the clock passes the start, the same start is in the past
+	frozen.Advance(2 * time.Hour)
+	if _, err := app.UpdateScheduledAt(ctx, draftID, start); !errors.Is(err, ErrScheduledInPast) {
+		t.Fatalf("UpdateScheduledAt(%s) at %s: got %v, want ErrScheduledInPast", start, frozen.Now(), err)
+	}
+}
@@ -0,0 +1,37 @@
+package draft
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/clock"
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+)
+
+// StartCountdownJob is the job kind of the check for drafts reaching a countdown checkpoint
+const StartCountdownJob = "draft.announce_starting_soon"
+
+// StartCountdownInterval is how often drafts are checked for countdown checkpoints. Events
+// carry the scheduled start, so clients count down to the second between them.
+const StartCountdownInterval = 15 * time.Second
+
+// StartCountdownAnnouncer announces drafts that reached a countdown checkpoint
+type StartCountdownAnnouncer interface {
+	AnnounceDraftsStartingSoon(ctx context.Context, now time.Time) (int, error)
+}
+
+// ScheduleStartCountdown schedules the countdown to every scheduled draft's start on the job
+// worker. Drafts are started by their commissioner rather than automatically, so the countdown
+// runs on its own and ends when the start time passes or the draft starts early. Each
+// checkpoint is recorded once per scheduled start, so retried or overlapping runs announce it once.
+// Checkpoints are reached as of the time on clock.
+func ScheduleStartCountdown(worker *jobs.Worker, announcer StartCountdownAnnouncer, clock clock.Clock) {
+	worker.Schedule(StartCountdownJob, jobs.Every(StartCountdownInterval), func(ctx context.Context, _ jobs.Job) error {
+		announced, err := announcer.AnnounceDraftsStartingSoon(ctx, clock.Now())
+		if announced > 0 {
+			log.Printf("Announced countdown checkpoints for %d drafts", announced)
+		}
+		return err
+	})
+}
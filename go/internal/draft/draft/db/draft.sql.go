@@ -9,22 +9,84 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 const clearNextDeadline = `-- name: ClearNextDeadline :exec
 UPDATE draft
-SET next_deadline = NULL
+SET next_deadline         = NULL,
+    pick_clock_started_at = NULL,
+    claimed_by            = NULL,
+    claimed_until         = NULL
 WHERE id = $1
 `
 
-// Clear the deadline (e.g. when pausing or completing a draft).
+// Clear the deadline (e.g. when pausing or completing a draft) and any claim on it.
 func (q *Queries) ClearNextDeadline(ctx context.Context, id uuid.UUID) error {
 	_, err := q.db.ExecContext(ctx, clearNextDeadline, id)
 	return err
 }
 
+const cloneDraftPicks = `-- name: CloneDraftPicks :execrows
+INSERT INTO draft_picks (id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick)
+SELECT gen_random_uuid(),
+       $1::uuid,
+       dp.round,
+       dp.pick,
+       dp.overall_pick,
+       dp.team_id,
+       CASE WHEN dp.keeper_pick THEN dp.player_id END,
+       CASE WHEN dp.keeper_pick THEN dp.picked_at END,
+       CASE WHEN dp.keeper_pick THEN dp.auction_amount END,
+       dp.keeper_pick
+FROM draft_picks dp
+WHERE dp.draft_id = $2::uuid
+`
+
+type CloneDraftPicksParams struct {
+	SandboxID uuid.UUID `json:"sandbox_id"`
+	DraftID   uuid.UUID `json:"draft_id"`
+}
+
+// Copy a draft's pick slots into a sandbox draft. Keeper picks keep their player; every other
+// slot starts open.
+func (q *Queries) CloneDraftPicks(ctx context.Context, arg CloneDraftPicksParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, cloneDraftPicks, arg.SandboxID, arg.DraftID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countDraftPicksMade = `-- name: CountDraftPicksMade :one
+SELECT COUNT(*)
+FROM draft_picks
+WHERE draft_id = $1
+  AND player_id IS NOT NULL
+`
+
+func (q *Queries) CountDraftPicksMade(ctx context.Context, draftID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countDraftPicksMade, draftID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countDraftsInProgress = `-- name: CountDraftsInProgress :one
+SELECT COUNT(*)
+FROM draft
+WHERE status = 'IN_PROGRESS'
+`
+
+func (q *Queries) CountDraftsInProgress(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countDraftsInProgress)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createDraft = `-- name: CreateDraft :one
 INSERT INTO draft (
     id,
@@ -33,6 +95,7 @@ INSERT INTO draft (
     status,
     settings,
     scheduled_at,
+    season_id,
     created_at,
     updated_at
 ) VALUES (
@@ -42,10 +105,11 @@ INSERT INTO draft (
              $4, -- status
              $5, -- settings
              $6, -- scheduled_at
+             (SELECT s.id FROM league_seasons s WHERE s.league_id = $2 AND s.status = 'ACTIVE'),
              NOW(),
              NOW()
          )
-RETURNING id, league_id, draft_type, status, settings, scheduled_at, started_at, completed_at, created_at, updated_at, next_deadline
+RETURNING id, league_id, draft_type, status, settings, scheduled_at, started_at, completed_at, created_at, updated_at, next_deadline, claimed_by, claimed_until, pick_clock_started_at, paused_at, league_settings_snapshot, sandbox_of_draft_id, season_id
 `
 
 type CreateDraftParams struct {
@@ -57,6 +121,7 @@ type CreateDraftParams struct {
 	ScheduledAt sql.NullTime    `json:"scheduled_at"`
 }
 
+// A new draft is held in its league's active season.
 func (q *Queries) CreateDraft(ctx context.Context, arg CreateDraftParams) (Draft, error) {
 	row := q.db.QueryRowContext(ctx, createDraft,
 		arg.ID,
@@ -79,6 +144,71 @@ func (q *Queries) CreateDraft(ctx context.Context, arg CreateDraftParams) (Draft
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.NextDeadline,
+		&i.ClaimedBy,
+		&i.ClaimedUntil,
+		&i.PickClockStartedAt,
+		&i.PausedAt,
+		&i.LeagueSettingsSnapshot,
+		&i.SandboxOfDraftID,
+		&i.SeasonID,
+	)
+	return i, err
+}
+
+const createSandboxDraft = `-- name: CreateSandboxDraft :one
+INSERT INTO draft (
+    id,
+    league_id,
+    draft_type,
+    status,
+    settings,
+    sandbox_of_draft_id,
+    season_id,
+    created_at,
+    updated_at
+)
+SELECT $1::uuid,
+       d.league_id,
+       d.draft_type,
+       'NOT_STARTED',
+       d.settings,
+       d.id,
+       d.season_id,
+       NOW(),
+       NOW()
+FROM draft d
+WHERE d.id = $2::uuid
+RETURNING id, league_id, draft_type, status, settings, scheduled_at, started_at, completed_at, created_at, updated_at, next_deadline, claimed_by, claimed_until, pick_clock_started_at, paused_at, league_settings_snapshot, sandbox_of_draft_id, season_id
+`
+
+type CreateSandboxDraftParams struct {
+	ID      uuid.UUID `json:"id"`
+	DraftID uuid.UUID `json:"draft_id"`
+}
+
+// Create a sandbox draft rehearsing another draft, with its league, type and settings.
+func (q *Queries) CreateSandboxDraft(ctx context.Context, arg CreateSandboxDraftParams) (Draft, error) {
+	row := q.db.QueryRowContext(ctx, createSandboxDraft, arg.ID, arg.DraftID)
+	var i Draft
+	err := row.Scan(
+		&i.ID,
+		&i.LeagueID,
+		&i.DraftType,
+		&i.Status,
+		&i.Settings,
+		&i.ScheduledAt,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.NextDeadline,
+		&i.ClaimedBy,
+		&i.ClaimedUntil,
+		&i.PickClockStartedAt,
+		&i.PausedAt,
+		&i.LeagueSettingsSnapshot,
+		&i.SandboxOfDraftID,
+		&i.SeasonID,
 	)
 	return i, err
 }
@@ -94,20 +224,88 @@ func (q *Queries) DeleteDraft(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteDraftAuctionLots = `-- name: DeleteDraftAuctionLots :exec
+DELETE FROM auction_lots
+WHERE draft_id = $1
+`
+
+func (q *Queries) DeleteDraftAuctionLots(ctx context.Context, draftID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteDraftAuctionLots, draftID)
+	return err
+}
+
+const deleteDraftOutbox = `-- name: DeleteDraftOutbox :exec
+DELETE FROM draft_outbox
+WHERE draft_id = $1
+`
+
+func (q *Queries) DeleteDraftOutbox(ctx context.Context, draftID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteDraftOutbox, draftID)
+	return err
+}
+
+const deleteDraftPicks = `-- name: DeleteDraftPicks :exec
+DELETE FROM draft_picks
+WHERE draft_id = $1
+`
+
+func (q *Queries) DeleteDraftPicks(ctx context.Context, draftID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteDraftPicks, draftID)
+	return err
+}
+
+const deleteSandboxDraft = `-- name: DeleteSandboxDraft :execrows
+DELETE FROM draft
+WHERE id = $1
+  AND sandbox_of_draft_id IS NOT NULL
+  AND status <> 'IN_PROGRESS'
+`
+
+// Delete a sandbox draft that isn't running; real drafts are never matched.
+func (q *Queries) DeleteSandboxDraft(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteSandboxDraft, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const fetchDraftsDueForPick = `-- name: FetchDraftsDueForPick :many
-SELECT
-    id AS draft_id
-FROM draft
-WHERE status = 'IN_PROGRESS'
-  AND next_deadline <= NOW()
-ORDER BY next_deadline
-LIMIT $1
-    FOR UPDATE SKIP LOCKED
+WITH due AS (
+    SELECT id
+    FROM draft
+    WHERE status = 'IN_PROGRESS'
+      AND next_deadline <= COALESCE($1::timestamptz, NOW())
+      AND (claimed_until IS NULL OR claimed_until <= COALESCE($1::timestamptz, NOW()))
+    ORDER BY next_deadline
+    LIMIT $2
+        FOR UPDATE SKIP LOCKED
+)
+UPDATE draft
+SET claimed_by    = $3,
+    claimed_until = COALESCE($1::timestamptz, NOW()) + make_interval(secs => $4::int)
+FROM due
+WHERE draft.id = due.id
+RETURNING draft.id AS draft_id
 `
 
-// Claim up to $1 drafts whose deadline has passed, locking them to avoid races.
-func (q *Queries) FetchDraftsDueForPick(ctx context.Context, limit int32) ([]uuid.UUID, error) {
-	rows, err := q.db.QueryContext(ctx, fetchDraftsDueForPick, limit)
+type FetchDraftsDueForPickParams struct {
+	Now       sql.NullTime   `json:"now"`
+	MaxDrafts int32          `json:"max_drafts"`
+	ClaimedBy sql.NullString `json:"claimed_by"`
+	LeaseSec  int32          `json:"lease_sec"`
+}
+
+// Claim up to max_drafts drafts whose deadline has passed for claimed_by, leasing them for
+// lease_sec seconds. Drafts under an unexpired claim, or being claimed by a concurrent
+// caller, are skipped, so no draft is handed to two callers at once.
+func (q *Queries) FetchDraftsDueForPick(ctx context.Context, arg FetchDraftsDueForPickParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, fetchDraftsDueForPick,
+		arg.Now,
+		arg.MaxDrafts,
+		arg.ClaimedBy,
+		arg.LeaseSec,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -132,28 +330,69 @@ func (q *Queries) FetchDraftsDueForPick(ctx context.Context, limit int32) ([]uui
 const fetchNextDeadline = `-- name: FetchNextDeadline :one
 SELECT
     id      AS draft_id,
-    next_deadline
+    next_deadline,
+    COALESCE($1::timestamptz, clock_timestamp()) AS server_time
 FROM draft
 WHERE status = 'IN_PROGRESS'
+  AND ($2::uuid IS NULL OR id = $2)
 ORDER BY next_deadline
 LIMIT 1
 `
 
+type FetchNextDeadlineParams struct {
+	Now     sql.NullTime  `json:"now"`
+	DraftID uuid.NullUUID `json:"draft_id"`
+}
+
 type FetchNextDeadlineRow struct {
 	DraftID      uuid.UUID    `json:"draft_id"`
 	NextDeadline sql.NullTime `json:"next_deadline"`
+	ServerTime   time.Time    `json:"server_time"`
 }
 
-// Fetch the single soonest deadline across all in-progress drafts.
-func (q *Queries) FetchNextDeadline(ctx context.Context) (FetchNextDeadlineRow, error) {
-	row := q.db.QueryRowContext(ctx, fetchNextDeadline)
+// Fetch the soonest deadline across all in-progress drafts, or one draft's deadline when
+// draft_id is set. server_time is now, or the database clock when now is null: the authority deadlines are set against.
+func (q *Queries) FetchNextDeadline(ctx context.Context, arg FetchNextDeadlineParams) (FetchNextDeadlineRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchNextDeadline, arg.Now, arg.DraftID)
 	var i FetchNextDeadlineRow
-	err := row.Scan(&i.DraftID, &i.NextDeadline)
+	err := row.Scan(&i.DraftID, &i.NextDeadline, &i.ServerTime)
+	return i, err
+}
+
+const getCurrentDraftPick = `-- name: GetCurrentDraftPick :one
+SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at
+FROM draft_picks
+WHERE draft_id = $1
+  AND player_id IS NULL
+  AND NOT forfeited
+ORDER BY skipped_at IS NOT NULL, overall_pick
+LIMIT 1
+`
+
+// The first open slot on a draft's board: the pick on the clock. Skipped picks come
+// back on the clock in board order once every other pick is made.
+func (q *Queries) GetCurrentDraftPick(ctx context.Context, draftID uuid.UUID) (DraftPick, error) {
+	row := q.db.QueryRowContext(ctx, getCurrentDraftPick, draftID)
+	var i DraftPick
+	err := row.Scan(
+		&i.ID,
+		&i.DraftID,
+		&i.Round,
+		&i.Pick,
+		&i.OverallPick,
+		&i.TeamID,
+		&i.PlayerID,
+		&i.PickedAt,
+		&i.AuctionAmount,
+		&i.KeeperPick,
+		&i.Forfeited,
+		&i.SkippedAt,
+	)
 	return i, err
 }
 
 const getDraft = `-- name: GetDraft :one
-SELECT id, league_id, draft_type, status, settings, scheduled_at, started_at, completed_at, created_at, updated_at, next_deadline
+SELECT id, league_id, draft_type, status, settings, scheduled_at, started_at, completed_at, created_at, updated_at, next_deadline, claimed_by, claimed_until, pick_clock_started_at, paused_at, league_settings_snapshot, sandbox_of_draft_id, season_id
 FROM draft
 WHERE id = $1
 `
@@ -173,7 +412,394 @@ func (q *Queries) GetDraft(ctx context.Context, id uuid.UUID) (Draft, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.NextDeadline,
+		&i.ClaimedBy,
+		&i.ClaimedUntil,
+		&i.PickClockStartedAt,
+		&i.PausedAt,
+		&i.LeagueSettingsSnapshot,
+		&i.SandboxOfDraftID,
+		&i.SeasonID,
+	)
+	return i, err
+}
+
+const getDraftEventSequence = `-- name: GetDraftEventSequence :one
+SELECT COALESCE((SELECT last_seq
+                 FROM draft_event_sequences
+                 WHERE draft_id = $1), 0)::bigint AS last_seq
+`
+
+// The sequence of the last outbox event written for a draft, 0 before the first one.
+func (q *Queries) GetDraftEventSequence(ctx context.Context, draftID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getDraftEventSequence, draftID)
+	var last_seq int64
+	err := row.Scan(&last_seq)
+	return last_seq, err
+}
+
+const getDraftLeagueID = `-- name: GetDraftLeagueID :one
+SELECT league_id
+FROM draft
+WHERE id = $1
+`
+
+// Resolve the league that owns a draft (used for tenancy checks).
+func (q *Queries) GetDraftLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, getDraftLeagueID, id)
+	var league_id uuid.UUID
+	err := row.Scan(&league_id)
+	return league_id, err
+}
+
+const hasSlotSelectionInProgress = `-- name: HasSlotSelectionInProgress :one
+SELECT EXISTS (SELECT 1
+               FROM draft_slot_selections
+               WHERE draft_id = $1
+                 AND status = 'IN_PROGRESS')
+`
+
+// Whether teams are still choosing their draft slots.
+func (q *Queries) HasSlotSelectionInProgress(ctx context.Context, draftID uuid.UUID) (bool, error) {
+	row := q.db.QueryRowContext(ctx, hasSlotSelectionInProgress, draftID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const insertDraftDeadlineChange = `-- name: InsertDraftDeadlineChange :exec
+INSERT INTO draft_deadline_changes (draft_id, reason, old_deadline, new_deadline, pick_time_sec, actor_user_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertDraftDeadlineChangeParams struct {
+	DraftID     uuid.UUID     `json:"draft_id"`
+	Reason      string        `json:"reason"`
+	OldDeadline sql.NullTime  `json:"old_deadline"`
+	NewDeadline sql.NullTime  `json:"new_deadline"`
+	PickTimeSec sql.NullInt32 `json:"pick_time_sec"`
+	ActorUserID uuid.NullUUID `json:"actor_user_id"`
+}
+
+// Record a recomputed pick deadline in the audit log.
+func (q *Queries) InsertDraftDeadlineChange(ctx context.Context, arg InsertDraftDeadlineChangeParams) error {
+	_, err := q.db.ExecContext(ctx, insertDraftDeadlineChange,
+		arg.DraftID,
+		arg.Reason,
+		arg.OldDeadline,
+		arg.NewDeadline,
+		arg.PickTimeSec,
+		arg.ActorUserID,
 	)
+	return err
+}
+
+const listDraftsForLeague = `-- name: ListDraftsForLeague :many
+SELECT id, league_id, draft_type, status, settings, scheduled_at, started_at, completed_at, created_at, updated_at, next_deadline, claimed_by, claimed_until, pick_clock_started_at, paused_at, league_settings_snapshot, sandbox_of_draft_id, season_id
+FROM draft
+WHERE league_id = $1
+  AND sandbox_of_draft_id IS NULL
+ORDER BY created_at DESC, id DESC
+`
+
+// A league's drafts, newest first. Sandbox rehearsals are left out.
+func (q *Queries) ListDraftsForLeague(ctx context.Context, leagueID uuid.UUID) ([]Draft, error) {
+	rows, err := q.db.QueryContext(ctx, listDraftsForLeague, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Draft
+	for rows.Next() {
+		var i Draft
+		if err := rows.Scan(
+			&i.ID,
+			&i.LeagueID,
+			&i.DraftType,
+			&i.Status,
+			&i.Settings,
+			&i.ScheduledAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.NextDeadline,
+			&i.ClaimedBy,
+			&i.ClaimedUntil,
+			&i.PickClockStartedAt,
+			&i.PausedAt,
+			&i.LeagueSettingsSnapshot,
+			&i.SandboxOfDraftID,
+			&i.SeasonID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDraftsForUser = `-- name: ListDraftsForUser :many
+SELECT
+    d.id, d.league_id, d.draft_type, d.status, d.settings, d.scheduled_at, d.started_at, d.completed_at, d.created_at, d.updated_at, d.next_deadline, d.claimed_by, d.claimed_until, d.pick_clock_started_at, d.paused_at, d.league_settings_snapshot, d.sandbox_of_draft_id, d.season_id,
+    l.name                                        AS league_name,
+    l.commissioner_id = $1::uuid AS is_commissioner,
+    ft.id                                         AS team_id,
+    ds.current_pick_id                            AS current_pick_id,
+    ds.on_the_clock_team_id                       AS current_pick_team_id,
+    ds.current_round                              AS current_pick_round,
+    ds.current_pick                               AS current_pick_pick,
+    ds.current_overall_pick                       AS current_pick_overall,
+    ds.picks_made                                 AS picks_made,
+    ds.total_picks                                AS total_picks,
+    COALESCE($2::timestamptz, clock_timestamp())                  AS server_time
+FROM draft d
+         JOIN leagues l ON l.id = d.league_id
+         JOIN draft_summary ds ON ds.draft_id = d.id
+         LEFT JOIN fantasy_teams ft ON ft.league_id = d.league_id AND ft.owner_id = $1::uuid
+WHERE d.status IN ('NOT_STARTED', 'IN_PROGRESS', 'PAUSED')
+  AND (ft.id IS NOT NULL OR l.commissioner_id = $1::uuid)
+  AND (d.sandbox_of_draft_id IS NULL OR l.commissioner_id = $1::uuid)
+  AND l.deleted_at IS NULL
+ORDER BY d.created_at
+`
+
+type ListDraftsForUserParams struct {
+	UserID uuid.UUID    `json:"user_id"`
+	Now    sql.NullTime `json:"now"`
+}
+
+type ListDraftsForUserRow struct {
+	Draft              Draft         `json:"draft"`
+	LeagueName         string        `json:"league_name"`
+	IsCommissioner     bool          `json:"is_commissioner"`
+	TeamID             uuid.NullUUID `json:"team_id"`
+	CurrentPickID      uuid.NullUUID `json:"current_pick_id"`
+	CurrentPickTeamID  uuid.NullUUID `json:"current_pick_team_id"`
+	CurrentPickRound   sql.NullInt32 `json:"current_pick_round"`
+	CurrentPickPick    sql.NullInt32 `json:"current_pick_pick"`
+	CurrentPickOverall sql.NullInt32 `json:"current_pick_overall"`
+	PicksMade          int32         `json:"picks_made"`
+	TotalPicks         int32         `json:"total_picks"`
+	ServerTime         time.Time     `json:"server_time"`
+}
+
+// Unfinished drafts in leagues the user has a team in or commissions, with the user's team,
+// the pick on the clock, the draft's progress and now, or the database clock when now is null, to measure its deadline against.
+// Sandbox drafts are listed only for the commissioner rehearsing them.
+func (q *Queries) ListDraftsForUser(ctx context.Context, arg ListDraftsForUserParams) ([]ListDraftsForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDraftsForUser, arg.UserID, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDraftsForUserRow
+	for rows.Next() {
+		var i ListDraftsForUserRow
+		if err := rows.Scan(
+			&i.Draft.ID,
+			&i.Draft.LeagueID,
+			&i.Draft.DraftType,
+			&i.Draft.Status,
+			&i.Draft.Settings,
+			&i.Draft.ScheduledAt,
+			&i.Draft.StartedAt,
+			&i.Draft.CompletedAt,
+			&i.Draft.CreatedAt,
+			&i.Draft.UpdatedAt,
+			&i.Draft.NextDeadline,
+			&i.Draft.ClaimedBy,
+			&i.Draft.ClaimedUntil,
+			&i.Draft.PickClockStartedAt,
+			&i.Draft.PausedAt,
+			&i.Draft.LeagueSettingsSnapshot,
+			&i.Draft.SandboxOfDraftID,
+			&i.Draft.SeasonID,
+			&i.LeagueName,
+			&i.IsCommissioner,
+			&i.TeamID,
+			&i.CurrentPickID,
+			&i.CurrentPickTeamID,
+			&i.CurrentPickRound,
+			&i.CurrentPickPick,
+			&i.CurrentPickOverall,
+			&i.PicksMade,
+			&i.TotalPicks,
+			&i.ServerTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentDraftPicks = `-- name: ListRecentDraftPicks :many
+SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at
+FROM draft_picks
+WHERE draft_id = $1
+  AND player_id IS NOT NULL
+ORDER BY overall_pick DESC
+LIMIT $2
+`
+
+type ListRecentDraftPicksParams struct {
+	DraftID uuid.UUID `json:"draft_id"`
+	Limit   int32     `json:"limit"`
+}
+
+// The most recently made picks of a draft, newest first.
+func (q *Queries) ListRecentDraftPicks(ctx context.Context, arg ListRecentDraftPicksParams) ([]DraftPick, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentDraftPicks, arg.DraftID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DraftPick
+	for rows.Next() {
+		var i DraftPick
+		if err := rows.Scan(
+			&i.ID,
+			&i.DraftID,
+			&i.Round,
+			&i.Pick,
+			&i.OverallPick,
+			&i.TeamID,
+			&i.PlayerID,
+			&i.PickedAt,
+			&i.AuctionAmount,
+			&i.KeeperPick,
+			&i.Forfeited,
+			&i.SkippedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recomputeNextDeadline = `-- name: RecomputeNextDeadline :one
+WITH now AS (
+    SELECT COALESCE($1::timestamptz, clock_timestamp()) AS server_time
+),
+     pause AS (
+         SELECT d.id,
+                CASE
+                    WHEN $2::bool AND d.paused_at IS NOT NULL THEN now.server_time - d.paused_at
+                    ELSE INTERVAL '0'
+                    END AS length
+         FROM draft d,
+              now
+         WHERE d.id = $3
+     )
+UPDATE draft
+SET pick_clock_started_at = CASE
+                                WHEN draft.pick_clock_started_at IS NOT NULL THEN draft.pick_clock_started_at + pause.length
+                                WHEN draft.next_deadline IS NULL AND $4::int > 0
+                                    THEN COALESCE(draft.paused_at, now.server_time) + pause.length
+                                END,
+    next_deadline         = CASE
+                                WHEN draft.next_deadline IS NULL AND $4::int IS NULL THEN NULL
+                                WHEN $4::int <= 0 THEN NULL
+                                WHEN $4::int IS NULL OR draft.pick_clock_started_at IS NULL THEN GREATEST(
+                                        COALESCE(draft.next_deadline + pause.length,
+                                                 COALESCE(draft.paused_at, now.server_time) + pause.length +
+                                                 make_interval(secs => $4::int)),
+                                        now.server_time + make_interval(secs => $5::int))
+                                ELSE GREATEST(
+                                        draft.pick_clock_started_at + pause.length + make_interval(secs => $4::int),
+                                        now.server_time + make_interval(secs => $5::int))
+                                END,
+    paused_at             = CASE WHEN $2::bool THEN NULL ELSE draft.paused_at END,
+    claimed_by            = NULL,
+    claimed_until         = NULL
+FROM pause,
+     now
+WHERE draft.id = pause.id
+RETURNING draft.next_deadline, now.server_time
+`
+
+type RecomputeNextDeadlineParams struct {
+	Now             sql.NullTime  `json:"now"`
+	Resume          bool          `json:"resume"`
+	ID              uuid.UUID     `json:"id"`
+	PickTimeSec     sql.NullInt32 `json:"pick_time_sec"`
+	MinRemainingSec int32         `json:"min_remaining_sec"`
+}
+
+type RecomputeNextDeadlineRow struct {
+	NextDeadline sql.NullTime `json:"next_deadline"`
+	ServerTime   time.Time    `json:"server_time"`
+}
+
+// Recompute a draft's pick deadline from when the clock of the pick on it started, leaving at
+// least min_remaining_sec on it. On resume the clock's start first moves on by the length of
+// the pause. A null pick_time_sec keeps the clock's length; otherwise the clock becomes
+// pick_time_sec long, 0 leaving the pick untimed. An untimed pick given a clock starts it
+// when the draft resumes, and a deadline whose clock start isn't known only moves on by the
+// pause.
+func (q *Queries) RecomputeNextDeadline(ctx context.Context, arg RecomputeNextDeadlineParams) (RecomputeNextDeadlineRow, error) {
+	row := q.db.QueryRowContext(ctx, recomputeNextDeadline,
+		arg.Now,
+		arg.Resume,
+		arg.ID,
+		arg.PickTimeSec,
+		arg.MinRemainingSec,
+	)
+	var i RecomputeNextDeadlineRow
+	err := row.Scan(&i.NextDeadline, &i.ServerTime)
+	return i, err
+}
+
+const setNextDeadlineFromNow = `-- name: SetNextDeadlineFromNow :one
+WITH now AS (
+    SELECT COALESCE($1::timestamptz, clock_timestamp()) AS server_time
+)
+UPDATE draft
+SET next_deadline         = now.server_time + make_interval(secs => $2::int),
+    pick_clock_started_at = now.server_time,
+    claimed_by            = NULL,
+    claimed_until         = NULL
+FROM now
+WHERE draft.id = $3
+RETURNING draft.next_deadline, now.server_time
+`
+
+type SetNextDeadlineFromNowParams struct {
+	Now        sql.NullTime `json:"now"`
+	TimeoutSec int32        `json:"timeout_sec"`
+	ID         uuid.UUID    `json:"id"`
+}
+
+type SetNextDeadlineFromNowRow struct {
+	NextDeadline sql.NullTime `json:"next_deadline"`
+	ServerTime   time.Time    `json:"server_time"`
+}
+
+// Start the pick clock at now, or on the database clock when now is null: the deadline is now plus timeout_sec seconds. Any claim on the
+// previous deadline is released.
+func (q *Queries) SetNextDeadlineFromNow(ctx context.Context, arg SetNextDeadlineFromNowParams) (SetNextDeadlineFromNowRow, error) {
+	row := q.db.QueryRowContext(ctx, setNextDeadlineFromNow, arg.Now, arg.TimeoutSec, arg.ID)
+	var i SetNextDeadlineFromNowRow
+	err := row.Scan(&i.NextDeadline, &i.ServerTime)
 	return i, err
 }
 
@@ -184,7 +810,7 @@ SET
     scheduled_at = COALESCE($3, scheduled_at),
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, league_id, draft_type, status, settings, scheduled_at, started_at, completed_at, created_at, updated_at, next_deadline
+RETURNING id, league_id, draft_type, status, settings, scheduled_at, started_at, completed_at, created_at, updated_at, next_deadline, claimed_by, claimed_until, pick_clock_started_at, paused_at, league_settings_snapshot, sandbox_of_draft_id, season_id
 `
 
 type UpdateDraftParams struct {
@@ -209,6 +835,13 @@ func (q *Queries) UpdateDraft(ctx context.Context, arg UpdateDraftParams) (Draft
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.NextDeadline,
+		&i.ClaimedBy,
+		&i.ClaimedUntil,
+		&i.PickClockStartedAt,
+		&i.PausedAt,
+		&i.LeagueSettingsSnapshot,
+		&i.SandboxOfDraftID,
+		&i.SeasonID,
 	)
 	return i, err
 }
@@ -216,21 +849,29 @@ func (q *Queries) UpdateDraft(ctx context.Context, arg UpdateDraftParams) (Draft
 const updateDraftStatus = `-- name: UpdateDraftStatus :one
 UPDATE draft
 SET
-    status = $2,
-    started_at = CASE WHEN $2 = 'IN_PROGRESS'::draft_status THEN NOW() ELSE started_at END,
-    completed_at = CASE WHEN $2 = 'COMPLETED'::draft_status THEN NOW() ELSE completed_at END,
+    status = $1,
+    started_at = CASE WHEN $1 = 'IN_PROGRESS'::draft_status THEN COALESCE($2::timestamptz, NOW()) ELSE started_at END,
+    completed_at = CASE WHEN $1 = 'COMPLETED'::draft_status THEN COALESCE($2::timestamptz, NOW()) ELSE completed_at END,
+    paused_at = CASE WHEN $1 = 'PAUSED'::draft_status THEN COALESCE($2::timestamptz, NOW()) END,
+    league_settings_snapshot = CASE
+        WHEN $1 = 'IN_PROGRESS'::draft_status AND league_settings_snapshot IS NULL
+            THEN (SELECT l.league_settings FROM leagues l WHERE l.id = draft.league_id)
+        ELSE league_settings_snapshot END,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, league_id, draft_type, status, settings, scheduled_at, started_at, completed_at, created_at, updated_at, next_deadline
+WHERE id = $3
+RETURNING id, league_id, draft_type, status, settings, scheduled_at, started_at, completed_at, created_at, updated_at, next_deadline, claimed_by, claimed_until, pick_clock_started_at, paused_at, league_settings_snapshot, sandbox_of_draft_id, season_id
 `
 
 type UpdateDraftStatusParams struct {
-	ID     uuid.UUID   `json:"id"`
-	Status DraftStatus `json:"status"`
+	Status DraftStatus  `json:"status"`
+	Now    sql.NullTime `json:"now"`
+	ID     uuid.UUID    `json:"id"`
 }
 
+// A draft starting for the first time snapshots its league's settings, so edits to the league
+// mid-draft don't change the rules it runs under.
 func (q *Queries) UpdateDraftStatus(ctx context.Context, arg UpdateDraftStatusParams) (Draft, error) {
-	row := q.db.QueryRowContext(ctx, updateDraftStatus, arg.ID, arg.Status)
+	row := q.db.QueryRowContext(ctx, updateDraftStatus, arg.Status, arg.Now, arg.ID)
 	var i Draft
 	err := row.Scan(
 		&i.ID,
@@ -244,23 +885,43 @@ func (q *Queries) UpdateDraftStatus(ctx context.Context, arg UpdateDraftStatusPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.NextDeadline,
+		&i.ClaimedBy,
+		&i.ClaimedUntil,
+		&i.PickClockStartedAt,
+		&i.PausedAt,
+		&i.LeagueSettingsSnapshot,
+		&i.SandboxOfDraftID,
+		&i.SeasonID,
 	)
 	return i, err
 }
 
-const updateNextDeadline = `-- name: UpdateNextDeadline :exec
+const updateNextDeadline = `-- name: UpdateNextDeadline :one
 UPDATE draft
-SET next_deadline = $2
-WHERE id = $1
+SET next_deadline         = $1,
+    pick_clock_started_at = NULL,
+    claimed_by            = NULL,
+    claimed_until         = NULL
+WHERE id = $2
+RETURNING next_deadline, COALESCE($3::timestamptz, clock_timestamp()) AS server_time
 `
 
 type UpdateNextDeadlineParams struct {
+	NextDeadline sql.NullTime `json:"next_deadline"`
 	ID           uuid.UUID    `json:"id"`
+	Now          sql.NullTime `json:"now"`
+}
+
+type UpdateNextDeadlineRow struct {
 	NextDeadline sql.NullTime `json:"next_deadline"`
+	ServerTime   time.Time    `json:"server_time"`
 }
 
-// Set the next pick deadline for a draft (e.g. after a pick or resume).
-func (q *Queries) UpdateNextDeadline(ctx context.Context, arg UpdateNextDeadlineParams) error {
-	_, err := q.db.ExecContext(ctx, updateNextDeadline, arg.ID, arg.NextDeadline)
-	return err
+// Set the next pick deadline for a draft (e.g. after a pick or resume), releasing any claim
+// on the previous one. The start of the clock behind an explicit deadline isn't known.
+func (q *Queries) UpdateNextDeadline(ctx context.Context, arg UpdateNextDeadlineParams) (UpdateNextDeadlineRow, error) {
+	row := q.db.QueryRowContext(ctx, updateNextDeadline, arg.NextDeadline, arg.ID, arg.Now)
+	var i UpdateNextDeadlineRow
+	err := row.Scan(&i.NextDeadline, &i.ServerTime)
+	return i, err
 }
@@ -108,9 +108,11 @@ func (ns NullDraftStatus) Value() (driver.Value, error) {
 type DraftType string
 
 const (
-	DraftTypeSNAKE   DraftType = "SNAKE"
-	DraftTypeAUCTION DraftType = "AUCTION"
-	DraftTypeROOKIE  DraftType = "ROOKIE"
+	DraftTypeSNAKE     DraftType = "SNAKE"
+	DraftTypeAUCTION   DraftType = "AUCTION"
+	DraftTypeROOKIE    DraftType = "ROOKIE"
+	DraftTypeEXPANSION DraftType = "EXPANSION"
+	DraftTypeDISPERSAL DraftType = "DISPERSAL"
 )
 
 func (e *DraftType) Scan(src interface{}) error {
@@ -280,17 +282,78 @@ func (ns NullRosterPositionEnum) Value() (driver.Value, error) {
 }
 
 type Draft struct {
-	ID           uuid.UUID       `json:"id"`
-	LeagueID     uuid.UUID       `json:"league_id"`
-	DraftType    DraftType       `json:"draft_type"`
-	Status       DraftStatus     `json:"status"`
-	Settings     json.RawMessage `json:"settings"`
-	ScheduledAt  sql.NullTime    `json:"scheduled_at"`
-	StartedAt    sql.NullTime    `json:"started_at"`
-	CompletedAt  sql.NullTime    `json:"completed_at"`
-	CreatedAt    time.Time       `json:"created_at"`
-	UpdatedAt    time.Time       `json:"updated_at"`
-	NextDeadline sql.NullTime    `json:"next_deadline"`
+	ID                     uuid.UUID             `json:"id"`
+	LeagueID               uuid.UUID             `json:"league_id"`
+	DraftType              DraftType             `json:"draft_type"`
+	Status                 DraftStatus           `json:"status"`
+	Settings               json.RawMessage       `json:"settings"`
+	ScheduledAt            sql.NullTime          `json:"scheduled_at"`
+	StartedAt              sql.NullTime          `json:"started_at"`
+	CompletedAt            sql.NullTime          `json:"completed_at"`
+	CreatedAt              time.Time             `json:"created_at"`
+	UpdatedAt              time.Time             `json:"updated_at"`
+	NextDeadline           sql.NullTime          `json:"next_deadline"`
+	ClaimedBy              sql.NullString        `json:"claimed_by"`
+	ClaimedUntil           sql.NullTime          `json:"claimed_until"`
+	PickClockStartedAt     sql.NullTime          `json:"pick_clock_started_at"`
+	PausedAt               sql.NullTime          `json:"paused_at"`
+	LeagueSettingsSnapshot pqtype.NullRawMessage `json:"league_settings_snapshot"`
+	SandboxOfDraftID       uuid.NullUUID         `json:"sandbox_of_draft_id"`
+	SeasonID               uuid.NullUUID         `json:"season_id"`
+}
+
+type DraftAbandonedTeam struct {
+	DraftID       uuid.UUID      `json:"draft_id"`
+	FantasyTeamID uuid.UUID      `json:"fantasy_team_id"`
+	PickHandling  string         `json:"pick_handling"`
+	Reason        sql.NullString `json:"reason"`
+	AbandonedBy   uuid.NullUUID  `json:"abandoned_by"`
+	AbandonedAt   time.Time      `json:"abandoned_at"`
+}
+
+type DraftChatReport struct {
+	ID             uuid.UUID `json:"id"`
+	DraftID        uuid.UUID `json:"draft_id"`
+	MessageID      uuid.UUID `json:"message_id"`
+	ReporterUserID uuid.UUID `json:"reporter_user_id"`
+	SenderUserID   uuid.UUID `json:"sender_user_id"`
+	MessageText    string    `json:"message_text"`
+	Reason         string    `json:"reason"`
+	SentAt         time.Time `json:"sent_at"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type DraftCoManager struct {
+	DraftID       uuid.UUID     `json:"draft_id"`
+	FantasyTeamID uuid.UUID     `json:"fantasy_team_id"`
+	UserID        uuid.UUID     `json:"user_id"`
+	AddedBy       uuid.NullUUID `json:"added_by"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+type DraftDeadlineChange struct {
+	ID          uuid.UUID     `json:"id"`
+	DraftID     uuid.UUID     `json:"draft_id"`
+	Reason      string        `json:"reason"`
+	OldDeadline sql.NullTime  `json:"old_deadline"`
+	NewDeadline sql.NullTime  `json:"new_deadline"`
+	PickTimeSec sql.NullInt32 `json:"pick_time_sec"`
+	ActorUserID uuid.NullUUID `json:"actor_user_id"`
+	ChangedAt   time.Time     `json:"changed_at"`
+	PickID      uuid.NullUUID `json:"pick_id"`
+}
+
+type DraftEventSequence struct {
+	DraftID uuid.UUID `json:"draft_id"`
+	LastSeq int64     `json:"last_seq"`
+}
+
+type DraftLobbyReadiness struct {
+	DraftID       uuid.UUID     `json:"draft_id"`
+	FantasyTeamID uuid.UUID     `json:"fantasy_team_id"`
+	Ready         bool          `json:"ready"`
+	UpdatedBy     uuid.NullUUID `json:"updated_by"`
+	UpdatedAt     time.Time     `json:"updated_at"`
 }
 
 type DraftOutbox struct {
@@ -300,6 +363,29 @@ type DraftOutbox struct {
 	Payload   json.RawMessage `json:"payload"`
 	CreatedAt time.Time       `json:"created_at"`
 	SentAt    sql.NullTime    `json:"sent_at"`
+	Seq       sql.NullInt64   `json:"seq"`
+}
+
+type DraftPauseVote struct {
+	ID               uuid.UUID      `json:"id"`
+	DraftID          uuid.UUID      `json:"draft_id"`
+	InitiatingTeamID uuid.UUID      `json:"initiating_team_id"`
+	InitiatedBy      uuid.NullUUID  `json:"initiated_by"`
+	Reason           sql.NullString `json:"reason"`
+	EligibleTeams    int32          `json:"eligible_teams"`
+	VotesNeeded      int32          `json:"votes_needed"`
+	Status           string         `json:"status"`
+	OpenedAt         time.Time      `json:"opened_at"`
+	ClosesAt         time.Time      `json:"closes_at"`
+	ClosedAt         sql.NullTime   `json:"closed_at"`
+}
+
+type DraftPauseVoteBallot struct {
+	VoteID        uuid.UUID     `json:"vote_id"`
+	FantasyTeamID uuid.UUID     `json:"fantasy_team_id"`
+	InFavor       bool          `json:"in_favor"`
+	CastBy        uuid.NullUUID `json:"cast_by"`
+	CastAt        time.Time     `json:"cast_at"`
 }
 
 type DraftPick struct {
@@ -313,6 +399,49 @@ type DraftPick struct {
 	PickedAt      sql.NullTime   `json:"picked_at"`
 	AuctionAmount sql.NullString `json:"auction_amount"`
 	KeeperPick    sql.NullBool   `json:"keeper_pick"`
+	Forfeited     bool           `json:"forfeited"`
+	SkippedAt     sql.NullTime   `json:"skipped_at"`
+}
+
+type DraftSummary struct {
+	DraftID            uuid.UUID     `json:"draft_id"`
+	TotalPicks         int32         `json:"total_picks"`
+	PicksMade          int32         `json:"picks_made"`
+	RemainingPicks     int32         `json:"remaining_picks"`
+	CurrentPickID      uuid.NullUUID `json:"current_pick_id"`
+	CurrentRound       sql.NullInt32 `json:"current_round"`
+	CurrentPick        sql.NullInt32 `json:"current_pick"`
+	CurrentOverallPick sql.NullInt32 `json:"current_overall_pick"`
+	OnTheClockTeamID   uuid.NullUUID `json:"on_the_clock_team_id"`
+	LastPickAt         sql.NullTime  `json:"last_pick_at"`
+	UpdatedAt          time.Time     `json:"updated_at"`
+}
+
+type DraftWebhook struct {
+	ID              uuid.UUID      `json:"id"`
+	DraftID         uuid.UUID      `json:"draft_id"`
+	Url             string         `json:"url"`
+	Secret          string         `json:"secret"`
+	Description     sql.NullString `json:"description"`
+	CreatedBy       uuid.NullUUID  `json:"created_by"`
+	CreatedAt       time.Time      `json:"created_at"`
+	LastDeliveredAt sql.NullTime   `json:"last_delivered_at"`
+	LastFailedAt    sql.NullTime   `json:"last_failed_at"`
+	LastError       sql.NullString `json:"last_error"`
+}
+
+type DraftWebhookDelivery struct {
+	ID            uuid.UUID       `json:"id"`
+	WebhookID     uuid.UUID       `json:"webhook_id"`
+	EventID       uuid.UUID       `json:"event_id"`
+	EventType     string          `json:"event_type"`
+	Body          json.RawMessage `json:"body"`
+	Attempts      int32           `json:"attempts"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	LastError     sql.NullString  `json:"last_error"`
+	DeliveredAt   sql.NullTime    `json:"delivered_at"`
+	FailedAt      sql.NullTime    `json:"failed_at"`
+	CreatedAt     time.Time       `json:"created_at"`
 }
 
 type FantasyTeam struct {
@@ -324,6 +453,18 @@ type FantasyTeam struct {
 	CreatedAt time.Time      `json:"created_at"`
 }
 
+type FrameDelivery struct {
+	EventID    uuid.UUID    `json:"event_id"`
+	UserID     uuid.UUID    `json:"user_id"`
+	DraftID    uuid.UUID    `json:"draft_id"`
+	EventType  string       `json:"event_type"`
+	Status     string       `json:"status"`
+	Attempts   int32        `json:"attempts"`
+	SentAt     time.Time    `json:"sent_at"`
+	AckedAt    sql.NullTime `json:"acked_at"`
+	RecordedAt time.Time    `json:"recorded_at"`
+}
+
 type League struct {
 	ID             uuid.UUID       `json:"id"`
 	Name           string          `json:"name"`
@@ -337,6 +478,15 @@ type League struct {
 	UpdatedAt      time.Time       `json:"updated_at"`
 }
 
+type MaintenanceWindow struct {
+	ID          uuid.UUID    `json:"id"`
+	StartsAt    time.Time    `json:"starts_at"`
+	EndsAt      time.Time    `json:"ends_at"`
+	Message     string       `json:"message"`
+	CreatedAt   time.Time    `json:"created_at"`
+	CancelledAt sql.NullTime `json:"cancelled_at"`
+}
+
 type NflPlayerProfile struct {
 	PlayerID     uuid.UUID      `json:"player_id"`
 	Position     sql.NullString `json:"position"`
@@ -368,6 +518,10 @@ type RosterPlayer struct {
 	AcquiredAt      time.Time             `json:"acquired_at"`
 	AcquisitionType AcquisitionTypeEnum   `json:"acquisition_type"`
 	KeeperData      pqtype.NullRawMessage `json:"keeper_data"`
+	LineupSlot      sql.NullString        `json:"lineup_slot"`
+	Salary          sql.NullInt32         `json:"salary"`
+	ContractYears   sql.NullInt32         `json:"contract_years"`
+	FranchiseTagged bool                  `json:"franchise_tagged"`
 }
 
 type Sport struct {
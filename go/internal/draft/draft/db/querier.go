@@ -6,25 +6,192 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
-	// Clear the deadline (e.g. when pausing or completing a draft).
+	// Whether a user may choose a team's co-managers in a draft: the team must be in the draft's
+	// league, and the user its owner or the league's commissioner.
+	CanManageDraftTeam(ctx context.Context, arg CanManageDraftTeamParams) (bool, error)
+	// Whether a user may vote for a team in a draft: its owner or one of its co-managers.
+	CanVoteForDraftTeam(ctx context.Context, arg CanVoteForDraftTeamParams) (bool, error)
+	CancelMaintenanceWindow(ctx context.Context, id uuid.UUID) (MaintenanceWindow, error)
+	// Clear the deadline (e.g. when pausing or completing a draft) and any claim on it.
 	ClearNextDeadline(ctx context.Context, id uuid.UUID) error
+	// Close an open pause vote. Returns no row when it's already closed.
+	// Copy a draft's pick slots into a sandbox draft. Keeper picks keep their player; every other
+	// slot starts open.
+	CloneDraftPicks(ctx context.Context, arg CloneDraftPicksParams) (int64, error)
+	ClosePauseVote(ctx context.Context, arg ClosePauseVoteParams) (DraftPauseVote, error)
+	CountDraftPicksMade(ctx context.Context, draftID uuid.UUID) (int64, error)
+	CountDraftStartCountdowns(ctx context.Context, arg CountDraftStartCountdownsParams) (int64, error)
+	CountDraftTeamCoManagers(ctx context.Context, arg CountDraftTeamCoManagersParams) (int64, error)
+	CountDraftWebhooks(ctx context.Context, draftID uuid.UUID) (int64, error)
+	CountDraftsInProgress(ctx context.Context) (int64, error)
+	CountPauseVoteBallots(ctx context.Context, voteID uuid.UUID) (CountPauseVoteBallotsRow, error)
+	// A new draft is held in its league's active season.
 	CreateDraft(ctx context.Context, arg CreateDraftParams) (Draft, error)
+	CreateDraftWebhook(ctx context.Context, arg CreateDraftWebhookParams) (DraftWebhook, error)
+	// Create a sandbox draft rehearsing another draft, with its league, type and settings.
+	CreateSandboxDraft(ctx context.Context, arg CreateSandboxDraftParams) (Draft, error)
+	DeleteAbandonedTeam(ctx context.Context, arg DeleteAbandonedTeamParams) (DraftAbandonedTeam, error)
+	// Forget a draft's commissioner pause, reporting whether it had one.
+	DeleteCommissionerPause(ctx context.Context, draftID uuid.UUID) (int64, error)
 	DeleteDraft(ctx context.Context, id uuid.UUID) error
-	// Claim up to $1 drafts whose deadline has passed, locking them to avoid races.
-	FetchDraftsDueForPick(ctx context.Context, limit int32) ([]uuid.UUID, error)
-	// Fetch the single soonest deadline across all in-progress drafts.
-	FetchNextDeadline(ctx context.Context) (FetchNextDeadlineRow, error)
+	DeleteDraftAuctionLots(ctx context.Context, draftID uuid.UUID) error
+	DeleteDraftCoManager(ctx context.Context, arg DeleteDraftCoManagerParams) (int64, error)
+	// Forget a draft's maintenance pause, reporting whether it had one.
+	DeleteDraftMaintenancePause(ctx context.Context, draftID uuid.UUID) (int64, error)
+	DeleteDraftOutbox(ctx context.Context, draftID uuid.UUID) error
+	DeleteDraftPicks(ctx context.Context, draftID uuid.UUID) error
+	DeleteDraftWebhook(ctx context.Context, arg DeleteDraftWebhookParams) (int64, error)
+	// Delete a sandbox draft that isn't running; real drafts are never matched.
+	DeleteSandboxDraft(ctx context.Context, id uuid.UUID) (int64, error)
+	// Queue an event for every webhook of its draft; an event already queued for a webhook is skipped.
+	EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error)
+	// Fail a draft's open pause vote once it has run out of time, in case it was never closed.
+	ExpireOpenPauseVotes(ctx context.Context, arg ExpireOpenPauseVotesParams) (int64, error)
+	// Push a running pick clock's deadline back by extension_sec seconds, moving the clock's start
+	// with it, and drop any claim on the old deadline. No row is returned once the deadline has
+	// passed at now, or on the database clock when now is null.
+	ExtendNextDeadline(ctx context.Context, arg ExtendNextDeadlineParams) (ExtendNextDeadlineRow, error)
+	// Claim up to max_drafts drafts whose deadline has passed for claimed_by, leasing them for
+	// lease_sec seconds. Drafts under an unexpired claim, or being claimed by a concurrent
+	// caller, are skipped, so no draft is handed to two callers at once.
+	FetchDraftsDueForPick(ctx context.Context, arg FetchDraftsDueForPickParams) ([]uuid.UUID, error)
+	// Claim deliveries that are due, oldest first, with the endpoint to send them to.
+	FetchDueWebhookDeliveries(ctx context.Context, limit int32) ([]FetchDueWebhookDeliveriesRow, error)
+	// Forfeit every pick the team has yet to make.
+	ForfeitTeamPicks(ctx context.Context, arg ForfeitTeamPicksParams) ([]uuid.UUID, error)
+	// Fetch the soonest deadline across all in-progress drafts, or one draft's deadline when
+	// draft_id is set. server_time is now, or the database clock when now is null: the authority deadlines are set against.
+	FetchNextDeadline(ctx context.Context, arg FetchNextDeadlineParams) (FetchNextDeadlineRow, error)
+	// The first open slot on a draft's board: the pick on the clock. Skipped picks come
+	// back on the clock in board order once every other pick is made.
+	GetCurrentDraftPick(ctx context.Context, draftID uuid.UUID) (DraftPick, error)
 	GetDraft(ctx context.Context, id uuid.UUID) (Draft, error)
+	// The sequence of the last outbox event written for a draft, 0 before the first one.
+	GetDraftEventSequence(ctx context.Context, draftID uuid.UUID) (int64, error)
+	// Resolve the league that owns a draft (used for tenancy checks).
+	GetDraftLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	// A draft's progress, kept up to date by trigger whenever its picks change.
+	GetDraftSummary(ctx context.Context, draftID uuid.UUID) (DraftSummary, error)
+	// The user who owns a fantasy team.
+	GetFantasyTeamOwnerID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	GetPauseVote(ctx context.Context, arg GetPauseVoteParams) (DraftPauseVote, error)
+	// The owner of a fantasy team, for notifications sent to them, with the settings of the
+	// team's league for the time zone and locale to show times in.
+	GetTeamOwnerContact(ctx context.Context, id uuid.UUID) (GetTeamOwnerContactRow, error)
+	// A user managing a fantasy team, for notifications sent to them about the team, with the
+	// settings of the team's league for the time zone and locale to show times in.
+	GetUserTeamContact(ctx context.Context, arg GetUserTeamContactParams) (GetUserTeamContactRow, error)
+	// A watchlist alert raised for a user, with the player, pick and league it is about.
+	GetWatchlistAlert(ctx context.Context, arg GetWatchlistAlertParams) (GetWatchlistAlertRow, error)
+	// Record a failed final attempt on the delivery and its webhook; the delivery isn't retried.
+	GiveUpWebhookDelivery(ctx context.Context, arg GiveUpWebhookDeliveryParams) error
+	// Whether a live pick trade offer has a draft's pick clock stopped.
+	HasOpenPickTradeOffer(ctx context.Context, draftID uuid.UUID) (bool, error)
+	// Whether teams are still choosing their draft slots.
+	HasSlotSelectionInProgress(ctx context.Context, draftID uuid.UUID) (bool, error)
+	// Mark a team as abandoned. Returns no row when the team already is.
+	InsertAbandonedTeam(ctx context.Context, arg InsertAbandonedTeamParams) (DraftAbandonedTeam, error)
+	// Flag a chat message. Reporting the same message twice keeps the first report and returns its id.
+	InsertChatReport(ctx context.Context, arg InsertChatReportParams) (uuid.UUID, error)
+	// Record that a draft was paused because its commissioner disconnected.
+	InsertCommissionerPause(ctx context.Context, draftID uuid.UUID) error
+	// Designate a co-manager for a team. Returns no row when the user already is one.
+	InsertDraftCoManager(ctx context.Context, arg InsertDraftCoManagerParams) (DraftCoManager, error)
+	// Record a recomputed pick deadline in the audit log.
+	InsertDraftDeadlineChange(ctx context.Context, arg InsertDraftDeadlineChangeParams) error
+	// Record a window announced to a draft. Nothing is written if it was announced before.
+	InsertDraftMaintenanceNotice(ctx context.Context, arg InsertDraftMaintenanceNoticeParams) (int64, error)
+	// Record that a draft was paused for a maintenance window.
+	InsertDraftMaintenancePause(ctx context.Context, arg InsertDraftMaintenancePauseParams) error
+	// Record a countdown checkpoint. Nothing is written if it was already announced for this start.
+	InsertDraftStartCountdown(ctx context.Context, arg InsertDraftStartCountdownParams) (int64, error)
+	InsertMaintenanceWindow(ctx context.Context, arg InsertMaintenanceWindowParams) (MaintenanceWindow, error)
+	// Open a pause vote. Returns no row when the draft already has one open.
+	InsertPauseVote(ctx context.Context, arg InsertPauseVoteParams) (DraftPauseVote, error)
+	// Record a pick clock extension in the deadline log. Nothing is written if the pick was
+	// already extended.
+	InsertPickClockExtension(ctx context.Context, arg InsertPickClockExtensionParams) (int64, error)
+	// Record a pick clock warning. Nothing is written if it was already given for this clock.
+	InsertPickClockWarning(ctx context.Context, arg InsertPickClockWarningParams) (int64, error)
+	// Record that no client acknowledged a frame. Nothing is written if the frame was already
+	// recorded for the user, acknowledged or not.
+	InsertUnackedFrameDelivery(ctx context.Context, arg InsertUnackedFrameDeliveryParams) (int64, error)
+	// Queue a notification for the notification worker to deliver.
+	InsertUserOutbox(ctx context.Context, arg InsertUserOutboxParams) error
+	// Raise a watchlist alert. Returns no row when it was already raised.
+	InsertWatchlistAlert(ctx context.Context, arg InsertWatchlistAlertParams) (uuid.UUID, error)
+	ListAbandonedTeams(ctx context.Context, draftID uuid.UUID) ([]DraftAbandonedTeam, error)
+	ListDraftCoManagers(ctx context.Context, draftID uuid.UUID) ([]DraftCoManager, error)
+	ListDraftIDsInProgress(ctx context.Context) ([]uuid.UUID, error)
+	// The owners of every team in a draft's league, for notifications sent to all of them, with
+	// the league's name and settings for the time zone and locale to show times in.
+	ListDraftTeamOwnerContacts(ctx context.Context, id uuid.UUID) ([]ListDraftTeamOwnerContactsRow, error)
+	// How each team of a draft fared: its pick slots, the ones it made a pick with, keepers
+	// included, and the ones it forfeited. Teams come in the order of their first pick.
+	ListDraftTeamSummaries(ctx context.Context, draftID uuid.UUID) ([]ListDraftTeamSummariesRow, error)
+	// The watchlisted players of the owners and co-managers of a draft's teams, limited to players
+	// of the league's sport, with the pick that took each player if one did. Players drafted before
+	// they were watchlisted are left out.
+	ListDraftWatchlistEntries(ctx context.Context, draftID uuid.UUID) ([]ListDraftWatchlistEntriesRow, error)
+	ListDraftWebhooks(ctx context.Context, draftID uuid.UUID) ([]DraftWebhook, error)
+	// A league's drafts, newest first. Sandbox rehearsals are left out.
+	ListDraftsForLeague(ctx context.Context, leagueID uuid.UUID) ([]Draft, error)
+	// Unfinished drafts in leagues the user has a team in or commissions, with the user's team,
+	// the pick on the clock, the draft's progress and now, or the database clock when now is null, to measure its deadline against.
+	// Sandbox drafts are listed only for the commissioner rehearsing them.
+	ListDraftsForUser(ctx context.Context, arg ListDraftsForUserParams) ([]ListDraftsForUserRow, error)
+	// Drafts yet to start that are scheduled to start after now and no later than horizon.
+	ListDraftsStartingSoon(ctx context.Context, arg ListDraftsStartingSoonParams) ([]ListDraftsStartingSoonRow, error)
+	// Drafts still paused for a window that closed or was cancelled as of now.
+	ListMaintenancePausesToLift(ctx context.Context, now time.Time) ([]ListMaintenancePausesToLiftRow, error)
+	// Windows that haven't closed or been cancelled as of now, the next to open first.
+	ListMaintenanceWindows(ctx context.Context, now time.Time) ([]MaintenanceWindow, error)
+	// The picks of a draft still to be made, in the order they come on the clock.
+	ListOpenDraftPicks(ctx context.Context, draftID uuid.UUID) ([]ListOpenDraftPicksRow, error)
+	// The most recently made picks of a draft, newest first.
+	ListRecentDraftPicks(ctx context.Context, arg ListRecentDraftPicksParams) ([]DraftPick, error)
+	ListTeamReadiness(ctx context.Context, draftID uuid.UUID) ([]DraftLobbyReadiness, error)
+	// Record a successful delivery on the delivery and its webhook.
+	MarkWebhookDeliveryDelivered(ctx context.Context, id uuid.UUID) error
+	// Record a calendar invite sent for a draft's scheduled start and return its sequence: 0 for
+	// the draft's first invite, one more for each invite after it.
+	NextDraftCalendarInviteSequence(ctx context.Context, arg NextDraftCalendarInviteSequenceParams) (int32, error)
+	// Recompute a draft's pick deadline from when the clock of the pick on it started, leaving at
+	// least min_remaining_sec on it. On resume the clock's start first moves on by the length of
+	// the pause. A null pick_time_sec keeps the clock's length; otherwise the clock becomes
+	// pick_time_sec long, 0 leaving the pick untimed. An untimed pick given a clock starts it
+	// when the draft resumes, and a deadline whose clock start isn't known only moves on by the
+	// pause.
+	RecomputeNextDeadline(ctx context.Context, arg RecomputeNextDeadlineParams) (RecomputeNextDeadlineRow, error)
+	// Give a team back the forfeited picks the draft hasn't moved past. Picks before the last
+	// pick made stay forfeited unless restore_all is set (auction picks aren't made in board order).
+	RestoreForfeitedTeamPicks(ctx context.Context, arg RestoreForfeitedTeamPicksParams) ([]uuid.UUID, error)
+	// Record a failed attempt on the delivery and its webhook, retrying the delivery at next_attempt_at.
+	ScheduleWebhookDeliveryRetry(ctx context.Context, arg ScheduleWebhookDeliveryRetryParams) error
+	// Start the pick clock at now, or on the database clock when now is null: the deadline is now plus timeout_sec seconds. Any claim on the
+	// previous deadline is released.
+	SetNextDeadlineFromNow(ctx context.Context, arg SetNextDeadlineFromNowParams) (SetNextDeadlineFromNowRow, error)
 	// Update draft settings and/or scheduled_at
 	UpdateDraft(ctx context.Context, arg UpdateDraftParams) (Draft, error)
+	// A draft starting for the first time snapshots its league's settings, so edits to the league
+	// mid-draft don't change the rules it runs under.
 	UpdateDraftStatus(ctx context.Context, arg UpdateDraftStatusParams) (Draft, error)
-	// Set the next pick deadline for a draft (e.g. after a pick or resume).
-	UpdateNextDeadline(ctx context.Context, arg UpdateNextDeadlineParams) error
+	// Set the next pick deadline for a draft (e.g. after a pick or resume), releasing any claim
+	// on the previous one. The start of the clock behind an explicit deadline isn't known.
+	UpdateNextDeadline(ctx context.Context, arg UpdateNextDeadlineParams) (UpdateNextDeadlineRow, error)
+	// Record that a client acknowledged a frame, including one already recorded unacknowledged.
+	UpsertAckedFrameDelivery(ctx context.Context, arg UpsertAckedFrameDeliveryParams) error
+	// Cast a team's ballot, replacing any it cast before.
+	UpsertPauseVoteBallot(ctx context.Context, arg UpsertPauseVoteBallotParams) error
+	// Mark a team ready, or not ready, in a draft's lobby.
+	UpsertTeamReadiness(ctx context.Context, arg UpsertTeamReadinessParams) error
+	UserExists(ctx context.Context, id uuid.UUID) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
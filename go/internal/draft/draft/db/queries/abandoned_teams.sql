@@ -0,0 +1,43 @@
+-- name: InsertAbandonedTeam :one
+-- Mark a team as abandoned. Returns no row when the team already is.
+INSERT INTO draft_abandoned_teams (draft_id, fantasy_team_id, pick_handling, reason, abandoned_by)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (draft_id, fantasy_team_id) DO NOTHING
+RETURNING *;
+
+-- name: DeleteAbandonedTeam :one
+DELETE FROM draft_abandoned_teams
+WHERE draft_id = $1
+  AND fantasy_team_id = $2
+RETURNING *;
+
+-- name: ListAbandonedTeams :many
+SELECT *
+FROM draft_abandoned_teams
+WHERE draft_id = $1
+ORDER BY abandoned_at;
+
+-- name: ForfeitTeamPicks :many
+-- Forfeit every pick the team has yet to make.
+UPDATE draft_picks
+SET forfeited = TRUE
+WHERE draft_id = $1
+  AND team_id = $2
+  AND player_id IS NULL
+  AND NOT forfeited
+RETURNING id;
+
+-- name: RestoreForfeitedTeamPicks :many
+-- Give a team back the forfeited picks the draft hasn't moved past. Picks before the last
+-- pick made stay forfeited unless restore_all is set (auction picks aren't made in board order).
+UPDATE draft_picks
+SET forfeited = FALSE
+WHERE draft_id = sqlc.arg('draft_id')
+  AND team_id = sqlc.arg('team_id')
+  AND forfeited
+  AND (sqlc.arg('restore_all')::boolean
+    OR overall_pick > (SELECT COALESCE(MAX(made.overall_pick), 0)
+                       FROM draft_picks made
+                       WHERE made.draft_id = sqlc.arg('draft_id')
+                         AND made.player_id IS NOT NULL))
+RETURNING id;
@@ -0,0 +1,6 @@
+-- name: InsertChatReport :one
+-- Flag a chat message. Reporting the same message twice keeps the first report and returns its id.
+INSERT INTO draft_chat_reports (id, draft_id, message_id, reporter_user_id, sender_user_id, message_text, reason, sent_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (message_id, reporter_user_id) DO UPDATE SET message_id = EXCLUDED.message_id
+RETURNING id;
\ No newline at end of file
@@ -0,0 +1,41 @@
+-- name: InsertDraftCoManager :one
+-- Designate a co-manager for a team. Returns no row when the user already is one.
+INSERT INTO draft_co_managers (draft_id, fantasy_team_id, user_id, added_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING
+RETURNING *;
+
+-- name: DeleteDraftCoManager :execrows
+DELETE
+FROM draft_co_managers
+WHERE draft_id = $1
+  AND fantasy_team_id = $2
+  AND user_id = $3;
+
+-- name: ListDraftCoManagers :many
+SELECT *
+FROM draft_co_managers
+WHERE draft_id = $1
+ORDER BY fantasy_team_id, created_at;
+
+-- name: CountDraftTeamCoManagers :one
+SELECT COUNT(*)
+FROM draft_co_managers
+WHERE draft_id = $1
+  AND fantasy_team_id = $2;
+
+-- name: CanManageDraftTeam :one
+-- Whether a user may choose a team's co-managers in a draft: the team must be in the draft's
+-- league, and the user its owner or the league's commissioner.
+SELECT EXISTS (
+    SELECT 1
+    FROM draft d
+             JOIN leagues l ON l.id = d.league_id
+             JOIN fantasy_teams ft ON ft.league_id = d.league_id
+    WHERE d.id = sqlc.arg('draft_id')
+      AND ft.id = sqlc.arg('fantasy_team_id')
+      AND (ft.owner_id = sqlc.arg('user_id') OR l.commissioner_id = sqlc.arg('user_id'))
+) AS can_manage;
+
+-- name: UserExists :one
+SELECT EXISTS (SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL) AS user_exists;
@@ -0,0 +1,11 @@
+-- name: InsertCommissionerPause :exec
+-- Record that a draft was paused because its commissioner disconnected.
+INSERT INTO draft_commissioner_pauses (draft_id)
+VALUES ($1)
+ON CONFLICT (draft_id) DO UPDATE SET paused_at = NOW();
+
+-- name: DeleteCommissionerPause :execrows
+-- Forget a draft's commissioner pause, reporting whether it had one.
+DELETE
+FROM draft_commissioner_pauses
+WHERE draft_id = $1;
@@ -1,4 +1,5 @@
 -- name: CreateDraft :one
+-- A new draft is held in its league's active season.
 INSERT INTO draft (
     id,
     league_id,
@@ -6,6 +7,7 @@ INSERT INTO draft (
     status,
     settings,
     scheduled_at,
+    season_id,
     created_at,
     updated_at
 ) VALUES (
@@ -15,6 +17,7 @@ INSERT INTO draft (
              $4, -- status
              $5, -- settings
              $6, -- scheduled_at
+             (SELECT s.id FROM league_seasons s WHERE s.league_id = $2 AND s.status = 'ACTIVE'),
              NOW(),
              NOW()
          )
@@ -26,13 +29,20 @@ FROM draft
 WHERE id = $1;
 
 -- name: UpdateDraftStatus :one
+-- A draft starting for the first time snapshots its league's settings, so edits to the league
+-- mid-draft don't change the rules it runs under.
 UPDATE draft
 SET
-    status = $2,
-    started_at = CASE WHEN $2 = 'IN_PROGRESS'::draft_status THEN NOW() ELSE started_at END,
-    completed_at = CASE WHEN $2 = 'COMPLETED'::draft_status THEN NOW() ELSE completed_at END,
+    status = sqlc.arg('status'),
+    started_at = CASE WHEN sqlc.arg('status') = 'IN_PROGRESS'::draft_status THEN COALESCE(sqlc.narg('now')::timestamptz, NOW()) ELSE started_at END,
+    completed_at = CASE WHEN sqlc.arg('status') = 'COMPLETED'::draft_status THEN COALESCE(sqlc.narg('now')::timestamptz, NOW()) ELSE completed_at END,
+    paused_at = CASE WHEN sqlc.arg('status') = 'PAUSED'::draft_status THEN COALESCE(sqlc.narg('now')::timestamptz, NOW()) END,
+    league_settings_snapshot = CASE
+        WHEN sqlc.arg('status') = 'IN_PROGRESS'::draft_status AND league_settings_snapshot IS NULL
+            THEN (SELECT l.league_settings FROM leagues l WHERE l.id = draft.league_id)
+        ELSE league_settings_snapshot END,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = sqlc.arg('id')
 RETURNING *;
 
 -- name: DeleteDraft :exec
@@ -40,37 +50,186 @@ DELETE FROM draft
 WHERE id = $1
   AND status = 'NOT_STARTED';
 
+-- name: CreateSandboxDraft :one
+-- Create a sandbox draft rehearsing another draft, with its league, type and settings.
+INSERT INTO draft (
+    id,
+    league_id,
+    draft_type,
+    status,
+    settings,
+    sandbox_of_draft_id,
+    season_id,
+    created_at,
+    updated_at
+)
+SELECT sqlc.arg('id')::uuid,
+       d.league_id,
+       d.draft_type,
+       'NOT_STARTED',
+       d.settings,
+       d.id,
+       d.season_id,
+       NOW(),
+       NOW()
+FROM draft d
+WHERE d.id = sqlc.arg('draft_id')::uuid
+RETURNING *;
+
+-- name: CloneDraftPicks :execrows
+-- Copy a draft's pick slots into a sandbox draft. Keeper picks keep their player; every other
+-- slot starts open.
+INSERT INTO draft_picks (id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick)
+SELECT gen_random_uuid(),
+       sqlc.arg('sandbox_id')::uuid,
+       dp.round,
+       dp.pick,
+       dp.overall_pick,
+       dp.team_id,
+       CASE WHEN dp.keeper_pick THEN dp.player_id END,
+       CASE WHEN dp.keeper_pick THEN dp.picked_at END,
+       CASE WHEN dp.keeper_pick THEN dp.auction_amount END,
+       dp.keeper_pick
+FROM draft_picks dp
+WHERE dp.draft_id = sqlc.arg('draft_id')::uuid;
+
+-- name: DeleteDraftAuctionLots :exec
+DELETE FROM auction_lots
+WHERE draft_id = $1;
+
+-- name: DeleteDraftOutbox :exec
+DELETE FROM draft_outbox
+WHERE draft_id = $1;
+
+-- name: DeleteDraftPicks :exec
+DELETE FROM draft_picks
+WHERE draft_id = $1;
+
+-- name: DeleteSandboxDraft :execrows
+-- Delete a sandbox draft that isn't running; real drafts are never matched.
+DELETE FROM draft
+WHERE id = $1
+  AND sandbox_of_draft_id IS NOT NULL
+  AND status <> 'IN_PROGRESS';
+
 -- name: FetchNextDeadline :one
--- Fetch the single soonest deadline across all in-progress drafts.
+-- Fetch the soonest deadline across all in-progress drafts, or one draft's deadline when
+-- draft_id is set. server_time is now, or the database clock when now is null: the authority deadlines are set against.
 SELECT
     id      AS draft_id,
-    next_deadline
+    next_deadline,
+    COALESCE(sqlc.narg('now')::timestamptz, clock_timestamp()) AS server_time
 FROM draft
 WHERE status = 'IN_PROGRESS'
+  AND (sqlc.narg('draft_id')::uuid IS NULL OR id = sqlc.narg('draft_id'))
 ORDER BY next_deadline
 LIMIT 1;
 
 -- name: FetchDraftsDueForPick :many
--- Claim up to $1 drafts whose deadline has passed, locking them to avoid races.
-SELECT
-    id AS draft_id
-FROM draft
-WHERE status = 'IN_PROGRESS'
-  AND next_deadline <= NOW()
-ORDER BY next_deadline
-LIMIT $1
-    FOR UPDATE SKIP LOCKED;
+-- Claim up to max_drafts drafts whose deadline has passed for claimed_by, leasing them for
+-- lease_sec seconds. Drafts under an unexpired claim, or being claimed by a concurrent
+-- caller, are skipped, so no draft is handed to two callers at once.
+WITH due AS (
+    SELECT id
+    FROM draft
+    WHERE status = 'IN_PROGRESS'
+      AND next_deadline <= COALESCE(sqlc.narg('now')::timestamptz, NOW())
+      AND (claimed_until IS NULL OR claimed_until <= COALESCE(sqlc.narg('now')::timestamptz, NOW()))
+    ORDER BY next_deadline
+    LIMIT @max_drafts
+        FOR UPDATE SKIP LOCKED
+)
+UPDATE draft
+SET claimed_by    = @claimed_by,
+    claimed_until = COALESCE(sqlc.narg('now')::timestamptz, NOW()) + make_interval(secs => @lease_sec::int)
+FROM due
+WHERE draft.id = due.id
+RETURNING draft.id AS draft_id;
 
--- name: UpdateNextDeadline :exec
--- Set the next pick deadline for a draft (e.g. after a pick or resume).
+-- name: UpdateNextDeadline :one
+-- Set the next pick deadline for a draft (e.g. after a pick or resume), releasing any claim
+-- on the previous one. The start of the clock behind an explicit deadline isn't known.
 UPDATE draft
-SET next_deadline = $2
-WHERE id = $1;
+SET next_deadline         = sqlc.arg('next_deadline'),
+    pick_clock_started_at = NULL,
+    claimed_by            = NULL,
+    claimed_until         = NULL
+WHERE id = sqlc.arg('id')
+RETURNING next_deadline, COALESCE(sqlc.narg('now')::timestamptz, clock_timestamp()) AS server_time;
+
+-- name: SetNextDeadlineFromNow :one
+-- Start the pick clock at now, or on the database clock when now is null: the deadline is now plus timeout_sec seconds. Any claim on the
+-- previous deadline is released.
+WITH now AS (
+    SELECT COALESCE(sqlc.narg('now')::timestamptz, clock_timestamp()) AS server_time
+)
+UPDATE draft
+SET next_deadline         = now.server_time + make_interval(secs => sqlc.arg('timeout_sec')::int),
+    pick_clock_started_at = now.server_time,
+    claimed_by            = NULL,
+    claimed_until         = NULL
+FROM now
+WHERE draft.id = sqlc.arg('id')
+RETURNING draft.next_deadline, now.server_time;
+
+-- name: RecomputeNextDeadline :one
+-- Recompute a draft's pick deadline from when the clock of the pick on it started, leaving at
+-- least min_remaining_sec on it. On resume the clock's start first moves on by the length of
+-- the pause. A null pick_time_sec keeps the clock's length; otherwise the clock becomes
+-- pick_time_sec long, 0 leaving the pick untimed. An untimed pick given a clock starts it
+-- when the draft resumes, and a deadline whose clock start isn't known only moves on by the
+-- pause.
+WITH now AS (
+    SELECT COALESCE(sqlc.narg('now')::timestamptz, clock_timestamp()) AS server_time
+),
+     pause AS (
+         SELECT d.id,
+                CASE
+                    WHEN sqlc.arg('resume')::bool AND d.paused_at IS NOT NULL THEN now.server_time - d.paused_at
+                    ELSE INTERVAL '0'
+                    END AS length
+         FROM draft d,
+              now
+         WHERE d.id = sqlc.arg('id')
+     )
+UPDATE draft
+SET pick_clock_started_at = CASE
+                                WHEN draft.pick_clock_started_at IS NOT NULL THEN draft.pick_clock_started_at + pause.length
+                                WHEN draft.next_deadline IS NULL AND sqlc.narg('pick_time_sec')::int > 0
+                                    THEN COALESCE(draft.paused_at, now.server_time) + pause.length
+                                END,
+    next_deadline         = CASE
+                                WHEN draft.next_deadline IS NULL AND sqlc.narg('pick_time_sec')::int IS NULL THEN NULL
+                                WHEN sqlc.narg('pick_time_sec')::int <= 0 THEN NULL
+                                WHEN sqlc.narg('pick_time_sec')::int IS NULL OR draft.pick_clock_started_at IS NULL THEN GREATEST(
+                                        COALESCE(draft.next_deadline + pause.length,
+                                                 COALESCE(draft.paused_at, now.server_time) + pause.length +
+                                                 make_interval(secs => sqlc.narg('pick_time_sec')::int)),
+                                        now.server_time + make_interval(secs => sqlc.arg('min_remaining_sec')::int))
+                                ELSE GREATEST(
+                                        draft.pick_clock_started_at + pause.length + make_interval(secs => sqlc.narg('pick_time_sec')::int),
+                                        now.server_time + make_interval(secs => sqlc.arg('min_remaining_sec')::int))
+                                END,
+    paused_at             = CASE WHEN sqlc.arg('resume')::bool THEN NULL ELSE draft.paused_at END,
+    claimed_by            = NULL,
+    claimed_until         = NULL
+FROM pause,
+     now
+WHERE draft.id = pause.id
+RETURNING draft.next_deadline, now.server_time;
+
+-- name: InsertDraftDeadlineChange :exec
+-- Record a recomputed pick deadline in the audit log.
+INSERT INTO draft_deadline_changes (draft_id, reason, old_deadline, new_deadline, pick_time_sec, actor_user_id)
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: ClearNextDeadline :exec
--- Clear the deadline (e.g. when pausing or completing a draft).
+-- Clear the deadline (e.g. when pausing or completing a draft) and any claim on it.
 UPDATE draft
-SET next_deadline = NULL
+SET next_deadline         = NULL,
+    pick_clock_started_at = NULL,
+    claimed_by            = NULL,
+    claimed_until         = NULL
 WHERE id = $1;
 
 -- name: UpdateDraft :one
@@ -81,4 +240,89 @@ SET
     scheduled_at = COALESCE($3, scheduled_at),
     updated_at = NOW()
 WHERE id = $1
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: GetDraftEventSequence :one
+-- The sequence of the last outbox event written for a draft, 0 before the first one.
+SELECT COALESCE((SELECT last_seq
+                 FROM draft_event_sequences
+                 WHERE draft_id = $1), 0)::bigint AS last_seq;
+
+-- name: GetDraftLeagueID :one
+-- Resolve the league that owns a draft (used for tenancy checks).
+SELECT league_id
+FROM draft
+WHERE id = $1;
+
+-- name: HasSlotSelectionInProgress :one
+-- Whether teams are still choosing their draft slots.
+SELECT EXISTS (SELECT 1
+               FROM draft_slot_selections
+               WHERE draft_id = $1
+                 AND status = 'IN_PROGRESS');
+
+-- name: GetCurrentDraftPick :one
+-- The first open slot on a draft's board: the pick on the clock. Skipped picks come
+-- back on the clock in board order once every other pick is made.
+SELECT *
+FROM draft_picks
+WHERE draft_id = $1
+  AND player_id IS NULL
+  AND NOT forfeited
+ORDER BY skipped_at IS NOT NULL, overall_pick
+LIMIT 1;
+
+-- name: ListRecentDraftPicks :many
+-- The most recently made picks of a draft, newest first.
+SELECT *
+FROM draft_picks
+WHERE draft_id = $1
+  AND player_id IS NOT NULL
+ORDER BY overall_pick DESC
+LIMIT $2;
+
+-- name: CountDraftPicksMade :one
+SELECT COUNT(*)
+FROM draft_picks
+WHERE draft_id = $1
+  AND player_id IS NOT NULL;
+
+-- name: CountDraftsInProgress :one
+SELECT COUNT(*)
+FROM draft
+WHERE status = 'IN_PROGRESS';
+
+-- name: ListDraftsForLeague :many
+-- A league's drafts, newest first. Sandbox rehearsals are left out.
+SELECT *
+FROM draft
+WHERE league_id = $1
+  AND sandbox_of_draft_id IS NULL
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListDraftsForUser :many
+-- Unfinished drafts in leagues the user has a team in or commissions, with the user's team,
+-- the pick on the clock, the draft's progress and now, or the database clock when now is null, to measure its deadline against.
+-- Sandbox drafts are listed only for the commissioner rehearsing them.
+SELECT
+    sqlc.embed(d),
+    l.name                                        AS league_name,
+    l.commissioner_id = sqlc.arg('user_id')::uuid AS is_commissioner,
+    ft.id                                         AS team_id,
+    ds.current_pick_id                            AS current_pick_id,
+    ds.on_the_clock_team_id                       AS current_pick_team_id,
+    ds.current_round                              AS current_pick_round,
+    ds.current_pick                               AS current_pick_pick,
+    ds.current_overall_pick                       AS current_pick_overall,
+    ds.picks_made                                 AS picks_made,
+    ds.total_picks                                AS total_picks,
+    COALESCE(sqlc.narg('now')::timestamptz, clock_timestamp())                  AS server_time
+FROM draft d
+         JOIN leagues l ON l.id = d.league_id
+         JOIN draft_summary ds ON ds.draft_id = d.id
+         LEFT JOIN fantasy_teams ft ON ft.league_id = d.league_id AND ft.owner_id = sqlc.arg('user_id')::uuid
+WHERE d.status IN ('NOT_STARTED', 'IN_PROGRESS', 'PAUSED')
+  AND (ft.id IS NOT NULL OR l.commissioner_id = sqlc.arg('user_id')::uuid)
+  AND (d.sandbox_of_draft_id IS NULL OR l.commissioner_id = sqlc.arg('user_id')::uuid)
+  AND l.deleted_at IS NULL
+ORDER BY d.created_at;
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- name: GetDraftSummary :one
+-- A draft's progress, kept up to date by trigger whenever its picks change.
+SELECT *
+FROM draft_summary
+WHERE draft_id = $1;
+
+-- name: ListDraftTeamSummaries :many
+-- How each team of a draft fared: its pick slots, the ones it made a pick with, keepers
+-- included, and the ones it forfeited. Teams come in the order of their first pick.
+SELECT
+    team_id,
+    COUNT(*)::int                                AS total_picks,
+    COUNT(player_id)::int                        AS players_drafted,
+    COUNT(*) FILTER (WHERE keeper_pick)::int     AS keeper_picks,
+    COUNT(*) FILTER (WHERE forfeited)::int       AS forfeited_picks
+FROM draft_picks
+WHERE draft_id = $1
+GROUP BY team_id
+ORDER BY MIN(overall_pick);
@@ -0,0 +1,24 @@
+-- name: InsertUnackedFrameDelivery :execrows
+-- Record that no client acknowledged a frame. Nothing is written if the frame was already
+-- recorded for the user, acknowledged or not.
+INSERT INTO frame_deliveries (event_id, user_id, draft_id, event_type, status, attempts, sent_at)
+VALUES ($1, $2, $3, $4, 'UNACKED', $5, $6)
+ON CONFLICT DO NOTHING;
+
+-- name: UpsertAckedFrameDelivery :exec
+-- Record that a client acknowledged a frame, including one already recorded unacknowledged.
+INSERT INTO frame_deliveries (event_id, user_id, draft_id, event_type, status, attempts, sent_at, acked_at)
+VALUES ($1, $2, $3, $4, 'ACKED', $5, $6, NOW())
+ON CONFLICT (event_id, user_id) DO UPDATE
+    SET status   = 'ACKED',
+        attempts = GREATEST(frame_deliveries.attempts, EXCLUDED.attempts),
+        acked_at = COALESCE(frame_deliveries.acked_at, EXCLUDED.acked_at);
+
+-- name: GetUserTeamContact :one
+-- A user managing a fantasy team, for notifications sent to them about the team, with the
+-- settings of the team's league for the time zone and locale to show times in.
+SELECT u.id, u.username, u.email, ft.name AS team_name, l.league_settings
+FROM users u
+         JOIN fantasy_teams ft ON ft.id = sqlc.arg('fantasy_team_id')
+         JOIN leagues l ON l.id = ft.league_id
+WHERE u.id = sqlc.arg('user_id');
@@ -0,0 +1,19 @@
+-- name: UpsertTeamReadiness :exec
+-- Mark a team ready, or not ready, in a draft's lobby.
+INSERT INTO draft_lobby_readiness (draft_id, fantasy_team_id, ready, updated_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (draft_id, fantasy_team_id) DO UPDATE
+    SET ready      = EXCLUDED.ready,
+        updated_by = EXCLUDED.updated_by,
+        updated_at = NOW();
+
+-- name: ListTeamReadiness :many
+SELECT *
+FROM draft_lobby_readiness
+WHERE draft_id = $1;
+
+-- name: GetFantasyTeamOwnerID :one
+-- The user who owns a fantasy team.
+SELECT owner_id
+FROM fantasy_teams
+WHERE id = $1;
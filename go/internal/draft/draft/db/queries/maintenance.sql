@@ -0,0 +1,54 @@
+-- name: InsertMaintenanceWindow :one
+INSERT INTO maintenance_windows (starts_at, ends_at, message)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: ListMaintenanceWindows :many
+-- Windows that haven't closed or been cancelled as of now, the next to open first.
+SELECT *
+FROM maintenance_windows
+WHERE cancelled_at IS NULL
+  AND ends_at > sqlc.arg('now')::timestamptz
+ORDER BY starts_at;
+
+-- name: CancelMaintenanceWindow :one
+UPDATE maintenance_windows
+SET cancelled_at = NOW()
+WHERE id = $1
+  AND cancelled_at IS NULL
+RETURNING *;
+
+-- name: ListDraftIDsInProgress :many
+SELECT id
+FROM draft
+WHERE status = 'IN_PROGRESS'
+ORDER BY id;
+
+-- name: InsertDraftMaintenanceNotice :execrows
+-- Record a window announced to a draft. Nothing is written if it was announced before.
+INSERT INTO draft_maintenance_notices (window_id, draft_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: InsertDraftMaintenancePause :exec
+-- Record that a draft was paused for a maintenance window.
+INSERT INTO draft_maintenance_pauses (draft_id, window_id)
+VALUES ($1, $2)
+ON CONFLICT (draft_id) DO UPDATE SET window_id = EXCLUDED.window_id,
+                                     paused_at = NOW();
+
+-- name: ListMaintenancePausesToLift :many
+-- Drafts still paused for a window that closed or was cancelled as of now.
+SELECT p.draft_id, p.window_id
+FROM draft_maintenance_pauses p
+         JOIN maintenance_windows w ON w.id = p.window_id
+         JOIN draft d ON d.id = p.draft_id
+WHERE d.status = 'PAUSED'
+  AND (w.cancelled_at IS NOT NULL OR w.ends_at <= sqlc.arg('now')::timestamptz)
+ORDER BY p.paused_at;
+
+-- name: DeleteDraftMaintenancePause :execrows
+-- Forget a draft's maintenance pause, reporting whether it had one.
+DELETE
+FROM draft_maintenance_pauses
+WHERE draft_id = $1;
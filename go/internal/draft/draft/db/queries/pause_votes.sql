@@ -0,0 +1,58 @@
+-- name: InsertPauseVote :one
+-- Open a pause vote. Returns no row when the draft already has one open.
+INSERT INTO draft_pause_votes (draft_id, initiating_team_id, initiated_by, reason, eligible_teams, votes_needed, closes_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (draft_id) WHERE status = 'OPEN' DO NOTHING
+RETURNING *;
+
+-- name: ExpireOpenPauseVotes :execrows
+-- Fail a draft's open pause vote once it has run out of time, in case it was never closed.
+UPDATE draft_pause_votes
+SET status    = 'FAILED',
+    closed_at = closes_at
+WHERE draft_id = $1
+  AND status = 'OPEN'
+  AND closes_at <= $2;
+
+-- name: GetPauseVote :one
+SELECT *
+FROM draft_pause_votes
+WHERE id = $1
+  AND draft_id = $2;
+
+-- name: ClosePauseVote :one
+-- Close an open pause vote. Returns no row when it's already closed.
+UPDATE draft_pause_votes
+SET status    = $3,
+    closed_at = NOW()
+WHERE id = $1
+  AND draft_id = $2
+  AND status = 'OPEN'
+RETURNING *;
+
+-- name: UpsertPauseVoteBallot :exec
+-- Cast a team's ballot, replacing any it cast before.
+INSERT INTO draft_pause_vote_ballots (vote_id, fantasy_team_id, in_favor, cast_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (vote_id, fantasy_team_id) DO UPDATE
+    SET in_favor = EXCLUDED.in_favor,
+        cast_by  = EXCLUDED.cast_by,
+        cast_at  = NOW();
+
+-- name: CountPauseVoteBallots :one
+SELECT COUNT(*) FILTER (WHERE in_favor)     AS votes_for,
+       COUNT(*) FILTER (WHERE NOT in_favor) AS votes_against
+FROM draft_pause_vote_ballots
+WHERE vote_id = $1;
+
+-- name: CanVoteForDraftTeam :one
+-- Whether a user may vote for a team in a draft: its owner or one of its co-managers.
+SELECT EXISTS (SELECT 1
+               FROM fantasy_teams ft
+               WHERE ft.id = sqlc.arg('fantasy_team_id')
+                 AND ft.owner_id = sqlc.arg('user_id'))
+    OR EXISTS (SELECT 1
+               FROM draft_co_managers cm
+               WHERE cm.draft_id = sqlc.arg('draft_id')
+                 AND cm.fantasy_team_id = sqlc.arg('fantasy_team_id')
+                 AND cm.user_id = sqlc.arg('user_id')) AS can_vote;
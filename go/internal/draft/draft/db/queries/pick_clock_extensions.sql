@@ -0,0 +1,30 @@
+-- name: ExtendNextDeadline :one
+-- Push a running pick clock's deadline back by extension_sec seconds, moving the clock's start
+-- with it, and drop any claim on the old deadline. No row is returned once the deadline has
+-- passed at now, or on the database clock when now is null.
+WITH now AS (
+    SELECT COALESCE(sqlc.narg('now')::timestamptz, clock_timestamp()) AS server_time
+)
+UPDATE draft
+SET next_deadline         = draft.next_deadline + make_interval(secs => sqlc.arg('extension_sec')::int),
+    pick_clock_started_at = draft.pick_clock_started_at + make_interval(secs => sqlc.arg('extension_sec')::int),
+    claimed_by            = NULL,
+    claimed_until         = NULL
+FROM now
+WHERE draft.id = sqlc.arg('id')
+  AND draft.next_deadline > now.server_time
+RETURNING draft.next_deadline, now.server_time;
+
+-- name: InsertPickClockExtension :execrows
+-- Record a pick clock extension in the deadline log. Nothing is written if the pick was
+-- already extended.
+INSERT INTO draft_deadline_changes (draft_id, reason, old_deadline, new_deadline, actor_user_id, pick_id)
+VALUES ($1, 'EXTENSION', $2, $3, $4, $5)
+ON CONFLICT DO NOTHING;
+
+-- name: HasOpenPickTradeOffer :one
+-- Whether a live pick trade offer has a draft's pick clock stopped.
+SELECT EXISTS (SELECT 1
+               FROM pick_trade_offers
+               WHERE draft_id = $1
+                 AND status = 'OPEN');
@@ -0,0 +1,19 @@
+-- name: InsertPickClockWarning :execrows
+-- Record a pick clock warning. Nothing is written if it was already given for this clock.
+INSERT INTO draft_pick_clock_warnings (pick_id, deadline, percent_remaining)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: GetTeamOwnerContact :one
+-- The owner of a fantasy team, for notifications sent to them, with the settings of the
+-- team's league for the time zone and locale to show times in.
+SELECT u.id, u.username, u.email, ft.name AS team_name, l.league_settings
+FROM fantasy_teams ft
+         JOIN users u ON u.id = ft.owner_id
+         JOIN leagues l ON l.id = ft.league_id
+WHERE ft.id = $1;
+
+-- name: InsertUserOutbox :exec
+-- Queue a notification for the notification worker to deliver.
+INSERT INTO user_outbox (id, user_id, event_type, payload)
+VALUES ($1, $2, $3, $4);
@@ -0,0 +1,43 @@
+-- name: ListDraftsStartingSoon :many
+-- Drafts yet to start that are scheduled to start after now and no later than horizon.
+SELECT id, scheduled_at::timestamptz AS scheduled_at
+FROM draft
+WHERE status = 'NOT_STARTED'
+  AND scheduled_at > sqlc.arg('now')::timestamptz
+  AND scheduled_at <= sqlc.arg('horizon')::timestamptz
+  AND EXISTS (SELECT 1 FROM leagues l WHERE l.id = draft.league_id AND l.deleted_at IS NULL)
+ORDER BY scheduled_at;
+
+-- name: InsertDraftStartCountdown :execrows
+-- Record a countdown checkpoint. Nothing is written if it was already announced for this start.
+INSERT INTO draft_start_countdowns (draft_id, scheduled_at, minutes_before)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: CountDraftStartCountdowns :one
+SELECT COUNT(*)
+FROM draft_start_countdowns
+WHERE draft_id = $1
+  AND scheduled_at = $2;
+
+-- name: ListDraftTeamOwnerContacts :many
+-- The owners of every team in a draft's league, for notifications sent to all of them, with
+-- the league's name and settings for the time zone and locale to show times in.
+SELECT u.id, u.username, u.email, ft.name AS team_name, l.name AS league_name, l.league_settings
+FROM draft d
+         JOIN fantasy_teams ft ON ft.league_id = d.league_id
+         JOIN users u ON u.id = ft.owner_id
+         JOIN leagues l ON l.id = d.league_id
+WHERE d.id = $1
+ORDER BY ft.name;
+
+-- name: NextDraftCalendarInviteSequence :one
+-- Record a calendar invite sent for a draft's scheduled start and return its sequence: 0 for
+-- the draft's first invite, one more for each invite after it.
+INSERT INTO draft_calendar_invites (draft_id, scheduled_at)
+VALUES ($1, $2)
+ON CONFLICT (draft_id) DO UPDATE
+    SET sequence     = draft_calendar_invites.sequence + 1,
+        scheduled_at = EXCLUDED.scheduled_at,
+        sent_at      = NOW()
+RETURNING sequence;
@@ -0,0 +1,52 @@
+-- name: ListOpenDraftPicks :many
+-- The picks of a draft still to be made, in the order they come on the clock.
+SELECT id, team_id
+FROM draft_picks
+WHERE draft_id = $1
+  AND player_id IS NULL
+  AND NOT forfeited
+ORDER BY skipped_at IS NOT NULL, overall_pick;
+
+-- name: ListDraftWatchlistEntries :many
+-- The watchlisted players of the owners and co-managers of a draft's teams, limited to players
+-- of the league's sport, with the pick that took each player if one did. Players drafted before
+-- they were watchlisted are left out.
+SELECT m.user_id, m.fantasy_team_id, w.player_id, w.alert_within_picks,
+       dp.id AS drafted_pick_id, dp.team_id AS drafted_by_team_id
+FROM (SELECT ft.owner_id AS user_id, ft.id AS fantasy_team_id
+      FROM fantasy_teams ft
+      WHERE ft.id IN (SELECT team_id FROM draft_picks WHERE draft_picks.draft_id = sqlc.arg('draft_id'))
+      UNION
+      SELECT cm.user_id, cm.fantasy_team_id
+      FROM draft_co_managers cm
+      WHERE cm.draft_id = sqlc.arg('draft_id')) m
+         JOIN users u ON u.id = m.user_id AND u.deleted_at IS NULL
+         JOIN player_watchlists w ON w.user_id = m.user_id
+         JOIN players p ON p.id = w.player_id
+         JOIN draft d ON d.id = sqlc.arg('draft_id')
+         JOIN leagues l ON l.id = d.league_id
+         LEFT JOIN draft_picks dp ON dp.draft_id = sqlc.arg('draft_id') AND dp.player_id = w.player_id
+WHERE p.sport_id = l.sport_id
+  AND (dp.id IS NULL OR dp.picked_at >= w.created_at)
+ORDER BY m.user_id, w.created_at, w.player_id;
+
+-- name: InsertWatchlistAlert :one
+-- Raise a watchlist alert. Returns no row when it was already raised.
+INSERT INTO watchlist_alerts (draft_id, user_id, fantasy_team_id, player_id, kind, pick_id, picks_away)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT DO NOTHING
+RETURNING id;
+
+-- name: GetWatchlistAlert :one
+-- A watchlist alert raised for a user, with the player, pick and league it is about.
+SELECT a.id, a.draft_id, a.user_id, a.fantasy_team_id, a.player_id, a.kind, a.pick_id, a.picks_away, a.created_at,
+       p.full_name AS player_name, dp.round, dp.pick, dp.overall_pick, dp.team_id AS pick_team_id,
+       ft.name AS pick_team_name, l.name AS league_name
+FROM watchlist_alerts a
+         JOIN players p ON p.id = a.player_id
+         JOIN draft_picks dp ON dp.id = a.pick_id
+         JOIN fantasy_teams ft ON ft.id = dp.team_id
+         JOIN draft d ON d.id = a.draft_id
+         JOIN leagues l ON l.id = d.league_id
+WHERE a.id = $1
+  AND a.user_id = $2;
@@ -0,0 +1,81 @@
+-- name: CreateDraftWebhook :one
+INSERT INTO draft_webhooks (draft_id, url, secret, description, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListDraftWebhooks :many
+SELECT *
+FROM draft_webhooks
+WHERE draft_id = $1
+ORDER BY created_at;
+
+-- name: CountDraftWebhooks :one
+SELECT COUNT(*)
+FROM draft_webhooks
+WHERE draft_id = $1;
+
+-- name: DeleteDraftWebhook :execrows
+DELETE
+FROM draft_webhooks
+WHERE id = $1
+  AND draft_id = $2;
+
+-- name: EnqueueWebhookDeliveries :execrows
+-- Queue an event for every webhook of its draft; an event already queued for a webhook is skipped.
+INSERT INTO draft_webhook_deliveries (webhook_id, event_id, event_type, body)
+SELECT w.id, sqlc.arg('event_id')::uuid, sqlc.arg('event_type')::text, sqlc.arg('body')::jsonb
+FROM draft_webhooks w
+WHERE w.draft_id = sqlc.arg('draft_id')
+ON CONFLICT (webhook_id, event_id) DO NOTHING;
+
+-- name: FetchDueWebhookDeliveries :many
+-- Claim deliveries that are due, oldest first, with the endpoint to send them to.
+SELECT d.id, d.webhook_id, d.event_type, d.body, d.attempts, w.url, w.secret
+FROM draft_webhook_deliveries d
+         JOIN draft_webhooks w ON w.id = d.webhook_id
+WHERE d.delivered_at IS NULL
+  AND d.failed_at IS NULL
+  AND d.next_attempt_at <= NOW()
+ORDER BY d.next_attempt_at, d.created_at
+LIMIT $1 FOR UPDATE OF d SKIP LOCKED;
+
+-- name: MarkWebhookDeliveryDelivered :exec
+-- Record a successful delivery on the delivery and its webhook.
+WITH delivery AS (
+    UPDATE draft_webhook_deliveries
+        SET delivered_at = NOW(),
+            attempts = attempts + 1,
+            last_error = NULL
+        WHERE draft_webhook_deliveries.id = $1
+        RETURNING webhook_id)
+UPDATE draft_webhooks
+SET last_delivered_at = NOW()
+WHERE draft_webhooks.id = (SELECT webhook_id FROM delivery);
+
+-- name: ScheduleWebhookDeliveryRetry :exec
+-- Record a failed attempt on the delivery and its webhook, retrying the delivery at next_attempt_at.
+WITH delivery AS (
+    UPDATE draft_webhook_deliveries
+        SET attempts = attempts + 1,
+            last_error = sqlc.arg('last_error')::text,
+            next_attempt_at = sqlc.arg('next_attempt_at')
+        WHERE draft_webhook_deliveries.id = sqlc.arg('id')
+        RETURNING webhook_id)
+UPDATE draft_webhooks
+SET last_failed_at = NOW(),
+    last_error     = sqlc.arg('last_error')::text
+WHERE draft_webhooks.id = (SELECT webhook_id FROM delivery);
+
+-- name: GiveUpWebhookDelivery :exec
+-- Record a failed final attempt on the delivery and its webhook; the delivery isn't retried.
+WITH delivery AS (
+    UPDATE draft_webhook_deliveries
+        SET attempts = attempts + 1,
+            last_error = sqlc.arg('last_error')::text,
+            failed_at = NOW()
+        WHERE draft_webhook_deliveries.id = sqlc.arg('id')
+        RETURNING webhook_id)
+UPDATE draft_webhooks
+SET last_failed_at = NOW(),
+    last_error     = sqlc.arg('last_error')::text
+WHERE draft_webhooks.id = (SELECT webhook_id FROM delivery);
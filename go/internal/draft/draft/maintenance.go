@@ -0,0 +1,106 @@
+package draft
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/clock"
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+)
+
+// MaintenanceJob is the job kind of the run that holds drafts around maintenance windows
+const MaintenanceJob = "draft.maintenance"
+
+// MaintenanceInterval is how often drafts are checked against maintenance windows. A draft is
+// paused at most this long after its window opens, and resumed at most this long after it closes.
+const MaintenanceInterval = 15 * time.Second
+
+// MaintenanceRunner holds drafts around maintenance windows
+type MaintenanceRunner interface {
+	RunMaintenance(ctx context.Context, now time.Time) (MaintenanceRun, error)
+}
+
+// ScheduleMaintenance schedules the maintenance run on the job worker. Notices and pauses are
+// recorded per draft and window, so retried or overlapping runs announce and pause a draft once.
+// Windows open and close by the time on clock.
+func ScheduleMaintenance(worker *jobs.Worker, runner MaintenanceRunner, clock clock.Clock) {
+	worker.Schedule(MaintenanceJob, jobs.Every(MaintenanceInterval), func(ctx context.Context, _ jobs.Job) error {
+		run, err := runner.RunMaintenance(ctx, clock.Now())
+		if run.Announced > 0 || run.Paused > 0 || run.Resumed > 0 {
+			log.Printf("Maintenance run announced to %d drafts, paused %d and resumed %d", run.Announced, run.Paused, run.Resumed)
+		}
+		return err
+	})
+}
+
+// MaintenanceHandler serves the maintenance windows for operators: GET lists the windows yet to
+// close, POST schedules one from a JSON body of starts_at, ends_at and message, and DELETE
+// cancels the one given by the id query parameter. It does no authentication of its own.
+func (s *Service) MaintenanceHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			windows, err := s.draftApp.ListMaintenanceWindows(r.Context(), s.draftApp.Now())
+			if err != nil {
+				log.Printf("Failed to list maintenance windows: %v", err)
+				http.Error(w, "failed to list maintenance windows", http.StatusInternalServerError)
+				return
+			}
+			writeMaintenanceJSON(w, http.StatusOK, map[string]any{"windows": windows})
+
+		case http.MethodPost:
+			var req ScheduleMaintenanceRequest
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<16)).Decode(&req); err != nil {
+				http.Error(w, "body must be JSON with starts_at, ends_at and message", http.StatusBadRequest)
+				return
+			}
+			window, err := s.draftApp.ScheduleMaintenance(r.Context(), req, s.draftApp.Now())
+			if err != nil {
+				if errors.Is(err, ErrInvalidMaintenanceWindow) {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				log.Printf("Failed to schedule maintenance window: %v", err)
+				http.Error(w, "failed to schedule maintenance window", http.StatusInternalServerError)
+				return
+			}
+			writeMaintenanceJSON(w, http.StatusCreated, window)
+
+		case http.MethodDelete:
+			id, err := uuid.Parse(r.URL.Query().Get("id"))
+			if err != nil {
+				http.Error(w, "id must be a maintenance window ID", http.StatusBadRequest)
+				return
+			}
+			window, err := s.draftApp.CancelMaintenance(r.Context(), id)
+			if err != nil {
+				if errors.Is(err, ErrMaintenanceWindowNotFound) {
+					http.Error(w, err.Error(), http.StatusNotFound)
+					return
+				}
+				log.Printf("Failed to cancel maintenance window %s: %v", id, err)
+				http.Error(w, "failed to cancel maintenance window", http.StatusInternalServerError)
+				return
+			}
+			writeMaintenanceJSON(w, http.StatusOK, window)
+
+		default:
+			w.Header().Set("Allow", "GET, POST, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func writeMaintenanceJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("Failed to write maintenance response: %v", err)
+	}
+}
@@ -4,24 +4,43 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/clock"
 	"github.com/mcdev12/dynasty/go/internal/draft/draft/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/ids"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	userevents "github.com/mcdev12/dynasty/go/internal/users/events"
 )
 
 type Repository struct {
 	queries *db.Queries
+	sqlDB   *sql.DB
+	clock   clock.Clock
 }
 
-func NewRepository(queries *db.Queries) *Repository {
+// NewRepository creates a draft Repository. Deadlines, pause votes and status changes are set
+// and checked against clock.
+func NewRepository(queries *db.Queries, sqlDB *sql.DB, clock clock.Clock) *Repository {
 	return &Repository{
 		queries: queries,
+		sqlDB:   sqlDB,
+		clock:   clock,
 	}
 }
 
+// q returns the repository's queries, bound to the transaction in ctx if a service started one
+func (r *Repository) q(ctx context.Context) *db.Queries {
+	return sqlutil.Bind(ctx, r.queries, r.queries.WithTx)
+}
+
 
 func (r *Repository) CreateDraft(ctx context.Context, req CreateDraftRequest) (*models.Draft, error) {
 	settingsBytes, err := json.Marshal(req.Settings)
@@ -34,23 +53,121 @@ func (r *Repository) CreateDraft(ctx context.Context, req CreateDraftRequest) (*
 		scheduledAt = sql.NullTime{Time: *req.ScheduledAt, Valid: true}
 	}
 
-	draft, err := r.queries.CreateDraft(ctx, db.CreateDraftParams{
-		ID:          req.ID,
-		LeagueID:    req.LeagueID,
-		DraftType:   db.DraftType(req.DraftType),
-		Status:      db.DraftStatus(req.Status),
-		Settings:    settingsBytes,
-		ScheduledAt: scheduledAt,
+	// A draft created with a start time sends its calendar invites in the same transaction
+	var created *models.Draft
+	err = sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.ID, r.queries.WithTx, func(q *db.Queries) error {
+		draft, err := q.CreateDraft(ctx, db.CreateDraftParams{
+			ID:          req.ID,
+			LeagueID:    req.LeagueID,
+			DraftType:   db.DraftType(req.DraftType),
+			Status:      db.DraftStatus(req.Status),
+			Settings:    settingsBytes,
+			ScheduledAt: scheduledAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create draft: %w", err)
+		}
+		created = r.dbDraftToModel(draft)
+		return r.notifyTeamOwnersScheduled(ctx, q, draft, nil)
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create draft: %w", err)
+		return nil, err
 	}
 
-	return r.dbDraftToModel(draft), nil
+	return created, nil
+}
+
+// CloneDraftAsSandbox copies a draft's settings, order and pick slots, keepers included, into a
+// new sandbox draft and hands its bot teams' picks to auto-pick. The draft is locked so its
+// keepers can't change while they're copied.
+func (r *Repository) CloneDraftAsSandbox(ctx context.Context, req CloneDraftAsSandboxRequest) (*models.Draft, error) {
+	var sandbox *models.Draft
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID, r.queries.WithTx, func(q *db.Queries) error {
+		dbDraft, err := q.GetDraft(ctx, req.DraftID)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		draft := r.dbDraftToModel(dbDraft)
+		if draft.SandboxOf != nil {
+			return ErrSandboxOfSandbox
+		}
+		switch draft.DraftType {
+		case models.DraftTypeSnake, models.DraftTypeRookie, models.DraftTypeAuction:
+		default:
+			return fmt.Errorf("%w: draft type is %s", ErrSandboxDraftType, draft.DraftType)
+		}
+		if len(req.BotTeamIDs) > 0 && draft.DraftType == models.DraftTypeAuction {
+			return ErrAutoPickInAuction
+		}
+		for _, teamID := range req.BotTeamIDs {
+			if !slices.Contains(draft.Settings.DraftOrder, teamID) {
+				return ErrTeamNotInDraft
+			}
+		}
+
+		dbSandbox, err := q.CreateSandboxDraft(ctx, db.CreateSandboxDraftParams{
+			ID:      ids.New(),
+			DraftID: req.DraftID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create sandbox draft: %w", err)
+		}
+		if _, err := q.CloneDraftPicks(ctx, db.CloneDraftPicksParams{
+			SandboxID: dbSandbox.ID,
+			DraftID:   req.DraftID,
+		}); err != nil {
+			return fmt.Errorf("failed to copy draft picks: %w", err)
+		}
+
+		// Bots are abandoned teams whose picks are auto-picked from the start
+		for _, teamID := range req.BotTeamIDs {
+			if _, err := q.InsertAbandonedTeam(ctx, db.InsertAbandonedTeamParams{
+				DraftID:       dbSandbox.ID,
+				FantasyTeamID: teamID,
+				PickHandling:  string(models.AbandonedPickHandlingAutoPick),
+				Reason:        sql.NullString{String: sandboxBotReason, Valid: true},
+				AbandonedBy:   sqlutil.ToNullUUID(req.CreatedBy),
+			}); err != nil {
+				return fmt.Errorf("failed to add sandbox bot: %w", err)
+			}
+		}
+
+		sandbox = r.dbDraftToModel(dbSandbox)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sandbox, nil
+}
+
+// DeleteSandboxDraft deletes a sandbox draft that isn't in progress along with its picks,
+// auction lots and events
+func (r *Repository) DeleteSandboxDraft(ctx context.Context, id uuid.UUID) error {
+	return sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, id, r.queries.WithTx, func(q *db.Queries) error {
+		if err := q.DeleteDraftAuctionLots(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete auction lots: %w", err)
+		}
+		if err := q.DeleteDraftOutbox(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete draft events: %w", err)
+		}
+		if err := q.DeleteDraftPicks(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete draft picks: %w", err)
+		}
+
+		deleted, err := q.DeleteSandboxDraft(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to delete sandbox draft: %w", err)
+		}
+		if deleted == 0 {
+			return ErrSandboxRunning
+		}
+		return nil
+	})
 }
 
 func (r *Repository) GetDraft(ctx context.Context, id uuid.UUID) (*models.Draft, error) {
-	draft, err := r.queries.GetDraft(ctx, id)
+	draft, err := r.q(ctx).GetDraft(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get draft: %w", err)
 	}
@@ -58,20 +175,146 @@ func (r *Repository) GetDraft(ctx context.Context, id uuid.UUID) (*models.Draft,
 	return r.dbDraftToModel(draft), nil
 }
 
-func (r *Repository) UpdateDraftStatus(ctx context.Context, id uuid.UUID, req UpdateDraftStatusRequest) (*models.Draft, error) {
-	draft, err := r.queries.UpdateDraftStatus(ctx, db.UpdateDraftStatusParams{
-		ID:     id,
-		Status: db.DraftStatus(req.Status),
+func (r *Repository) GetDraftSummary(ctx context.Context, draftID uuid.UUID) (*models.DraftSummary, error) {
+	row, err := r.q(ctx).GetDraftSummary(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft summary: %w", err)
+	}
+
+	summary := &models.DraftSummary{
+		DraftID:        row.DraftID,
+		TotalPicks:     int(row.TotalPicks),
+		PicksMade:      int(row.PicksMade),
+		RemainingPicks: int(row.RemainingPicks),
+		LastPickAt:     sqlutil.FromSqlTime(row.LastPickAt),
+		UpdatedAt:      row.UpdatedAt,
+	}
+	if row.CurrentPickID.Valid {
+		summary.CurrentPick = &models.DraftPick{
+			ID:          row.CurrentPickID.UUID,
+			DraftID:     row.DraftID,
+			Round:       int(row.CurrentRound.Int32),
+			Pick:        int(row.CurrentPick.Int32),
+			OverallPick: int(row.CurrentOverallPick.Int32),
+			TeamID:      row.OnTheClockTeamID.UUID,
+		}
+	}
+
+	return summary, nil
+}
+
+// ListDraftTeamSummaries returns how each team of a draft fared, in the order of their first pick
+func (r *Repository) ListDraftTeamSummaries(ctx context.Context, draftID uuid.UUID) ([]models.TeamDraftSummary, error) {
+	rows, err := r.q(ctx).ListDraftTeamSummaries(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft team summaries: %w", err)
+	}
+
+	summaries := make([]models.TeamDraftSummary, len(rows))
+	for i, row := range rows {
+		summaries[i] = models.TeamDraftSummary{
+			TeamID:         row.TeamID,
+			TotalPicks:     int(row.TotalPicks),
+			PlayersDrafted: int(row.PlayersDrafted),
+			KeeperPicks:    int(row.KeeperPicks),
+			ForfeitedPicks: int(row.ForfeitedPicks),
+		}
+	}
+	return summaries, nil
+}
+
+func (r *Repository) GetDraftEventSequence(ctx context.Context, id uuid.UUID) (int64, error) {
+	seq, err := r.q(ctx).GetDraftEventSequence(ctx, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get draft event sequence: %w", err)
+	}
+
+	return seq, nil
+}
+
+func (r *Repository) GetDraftLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	leagueID, err := r.q(ctx).GetDraftLeagueID(ctx, id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get draft league: %w", err)
+	}
+
+	return leagueID, nil
+}
+
+func (r *Repository) HasSlotSelectionInProgress(ctx context.Context, draftID uuid.UUID) (bool, error) {
+	inProgress, err := r.q(ctx).HasSlotSelectionInProgress(ctx, draftID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check slot selection: %w", err)
+	}
+
+	return inProgress, nil
+}
+
+// InsertChatReport stores a chat report and returns its id, or the id of the reporter's
+// earlier report for the same message
+func (r *Repository) InsertChatReport(ctx context.Context, id uuid.UUID, report ChatReport) (uuid.UUID, error) {
+	reportID, err := r.q(ctx).InsertChatReport(ctx, db.InsertChatReportParams{
+		ID:             id,
+		DraftID:        report.DraftID,
+		MessageID:      report.MessageID,
+		ReporterUserID: report.ReporterUserID,
+		SenderUserID:   report.SenderUserID,
+		MessageText:    report.MessageText,
+		Reason:         report.Reason,
+		SentAt:         report.SentAt,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update draft status: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to insert chat report: %w", err)
 	}
 
-	return r.dbDraftToModel(draft), nil
+	return reportID, nil
+}
+
+func (r *Repository) UpdateDraftStatus(ctx context.Context, id uuid.UUID, req UpdateDraftStatusRequest, check func(current *models.Draft) error) (*models.Draft, error) {
+	// check sees the current draft under the draft's advisory lock, so concurrent
+	// transitions, picks and deadline updates for the same draft can't interleave
+	var updated *models.Draft
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, id, r.queries.WithTx, func(q *db.Queries) error {
+		current, err := q.GetDraft(ctx, id)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		if err := check(r.dbDraftToModel(current)); err != nil {
+			return err
+		}
+
+		// The pick clock stood still while the draft was paused
+		var next *NextDeadline
+		if current.Status == db.DraftStatusPAUSED && req.Status == models.DraftStatusInProgress {
+			next, err = r.recomputeDeadline(ctx, q, current, DeadlineChangeResume, req.ActorID)
+			if err != nil {
+				return err
+			}
+		}
+
+		draft, err := q.UpdateDraftStatus(ctx, db.UpdateDraftStatusParams{
+			Status: db.DraftStatus(req.Status),
+			Now:    clock.DatabaseNow(r.clock),
+			ID:     id,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update draft status: %w", err)
+		}
+		updated = r.dbDraftToModel(draft)
+		if next != nil {
+			updated.NextDeadline = next.Deadline
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
 }
 
 func (r *Repository) DeleteDraft(ctx context.Context, id uuid.UUID) error {
-	if err := r.queries.DeleteDraft(ctx, id); err != nil {
+	if err := r.q(ctx).DeleteDraft(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete draft: %w", err)
 	}
 	return nil
@@ -92,92 +335,1819 @@ func (r *Repository) UpdateDraft(ctx context.Context, id uuid.UUID, req UpdateDr
 		scheduledAt = sql.NullTime{Time: *req.ScheduledAt, Valid: true}
 	}
 
-	draft, err := r.queries.UpdateDraft(ctx, db.UpdateDraftParams{
-		ID:          id,
-		Settings:    settingsBytes,
-		ScheduledAt: scheduledAt,
+	// Locked so a paused draft's deadline is recomputed against the settings it resumes with
+	var updated *models.Draft
+	err = sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, id, r.queries.WithTx, func(q *db.Queries) error {
+		current, err := q.GetDraft(ctx, id)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+
+		draft, err := q.UpdateDraft(ctx, db.UpdateDraftParams{
+			ID:          id,
+			Settings:    settingsBytes,
+			ScheduledAt: scheduledAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update draft: %w", err)
+		}
+		updated = r.dbDraftToModel(draft)
+
+		if rescheduled(current.ScheduledAt, draft.ScheduledAt) {
+			if err := r.notifyTeamOwnersScheduled(ctx, q, draft, sqlutil.FromSqlTime(current.ScheduledAt)); err != nil {
+				return err
+			}
+		}
+		if req.Settings == nil || draft.Status != db.DraftStatusPAUSED {
+			return nil
+		}
+		next, err := r.recomputeDeadline(ctx, q, draft, DeadlineChangeSettingsChange, req.ActorID)
+		if err != nil {
+			return err
+		}
+		if next != nil {
+			updated.NextDeadline = next.Deadline
+		}
+		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update draft: %w", err)
+		return nil, err
 	}
 
-	return r.dbDraftToModel(draft), nil
+	return updated, nil
+}
+
+// UpdateScheduledAt moves a draft to a new scheduled start and sends its league members an
+// updated calendar invite. check sees the current draft under the draft's advisory lock, so
+// the draft can't start while it moves.
+func (r *Repository) UpdateScheduledAt(ctx context.Context, id uuid.UUID, scheduledAt time.Time, check func(current *models.Draft) error) (*Reschedule, error) {
+	var reschedule *Reschedule
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, id, r.queries.WithTx, func(q *db.Queries) error {
+		current, err := q.GetDraft(ctx, id)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		if err := check(r.dbDraftToModel(current)); err != nil {
+			return err
+		}
+
+		draft, err := q.UpdateDraft(ctx, db.UpdateDraftParams{
+			ID:          id,
+			ScheduledAt: sql.NullTime{Time: scheduledAt, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update draft: %w", err)
+		}
+
+		reschedule = &Reschedule{
+			Draft:               r.dbDraftToModel(draft),
+			PreviousScheduledAt: sqlutil.FromSqlTime(current.ScheduledAt),
+		}
+		if !rescheduled(current.ScheduledAt, draft.ScheduledAt) {
+			return nil
+		}
+		return r.notifyTeamOwnersScheduled(ctx, q, draft, reschedule.PreviousScheduledAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reschedule, nil
+}
+
+// rescheduled reports whether a draft's scheduled start moved to a new time
+func rescheduled(previous, current sql.NullTime) bool {
+	return current.Valid && (!previous.Valid || !previous.Time.Equal(current.Time))
 }
 
-func (r *Repository) FetchNextDeadline(ctx context.Context) (*NextDeadline, error) {
-	row, err := r.queries.FetchNextDeadline(ctx)
+// recomputeDeadline recomputes the deadline of the pick on the draft's clock in q's
+// transaction and records the change in the draft's deadline log. On resume the clock keeps
+// its length and moves on by the length of the pause; on a settings change it takes the
+// length the new settings give the pick. Auction drafts, whose picks the auction runner keeps
+// on the clock, and picks of abandoned teams set to auto-pick, which are on a short clock of
+// their own, are left alone by settings changes. It returns nil when no deadline changed.
+func (r *Repository) recomputeDeadline(ctx context.Context, q *db.Queries, draft db.Draft, reason DeadlineChangeReason, actorID *uuid.UUID) (*NextDeadline, error) {
+	var pickTime sql.NullInt32
+	if reason == DeadlineChangeSettingsChange {
+		if draft.DraftType == db.DraftTypeAUCTION {
+			return nil, nil
+		}
+		pick, err := q.GetCurrentDraftPick(ctx, draft.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current pick: %w", err)
+		}
+		abandoned, err := q.ListAbandonedTeams(ctx, draft.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list abandoned teams: %w", err)
+		}
+		for _, team := range abandoned {
+			if team.FantasyTeamID == pick.TeamID && models.AbandonedPickHandling(team.PickHandling) == models.AbandonedPickHandlingAutoPick {
+				return nil, nil
+			}
+		}
+		settings := r.dbDraftToModel(draft).Settings
+		pickTime = sql.NullInt32{Int32: int32(settings.TimePerPickForRound(int(pick.Round))), Valid: true}
+	}
+
+	row, err := q.RecomputeNextDeadline(ctx, db.RecomputeNextDeadlineParams{
+		Now:             clock.DatabaseNow(r.clock),
+		Resume:          reason == DeadlineChangeResume,
+		ID:              draft.ID,
+		PickTimeSec:     pickTime,
+		MinRemainingSec: int32(MinRecomputedPickClock / time.Second),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch next deadline: %w", err)
+		return nil, fmt.Errorf("failed to recompute next deadline: %w", err)
+	}
+	if !draft.NextDeadline.Valid && !row.NextDeadline.Valid {
+		return nil, nil
+	}
+
+	err = q.InsertDraftDeadlineChange(ctx, db.InsertDraftDeadlineChangeParams{
+		DraftID:     draft.ID,
+		Reason:      string(reason),
+		OldDeadline: draft.NextDeadline,
+		NewDeadline: row.NextDeadline,
+		PickTimeSec: pickTime,
+		ActorUserID: sqlutil.ToNullUUID(actorID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record deadline change: %w", err)
+	}
+
+	return &NextDeadline{
+		DraftID:    draft.ID,
+		Deadline:   sqlutil.FromSqlTime(row.NextDeadline),
+		ServerTime: row.ServerTime,
+	}, nil
+}
+
+// ExtendPickClock pushes the deadline of the pick on the draft's clock back by the extension and
+// records the grant in the draft's deadline log, under the draft's lock so the pick can't be
+// made, skipped or timed out while its clock moves. Each pick can be extended once.
+func (r *Repository) ExtendPickClock(ctx context.Context, req ExtendPickClockRequest) (*PickClockExtension, error) {
+	var extension *PickClockExtension
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID, r.queries.WithTx, func(q *db.Queries) error {
+		draft, err := q.GetDraft(ctx, req.DraftID)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		if draft.Status != db.DraftStatusINPROGRESS {
+			return fmt.Errorf("%w: current status is %s", ErrDraftNotInProgress, draft.Status)
+		}
+		if !draft.NextDeadline.Valid {
+			return ErrPickClockNotRunning
+		}
+
+		current, err := q.GetCurrentDraftPick(ctx, req.DraftID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPickClockNotRunning
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get current pick: %w", err)
+		}
+		if current.ID != req.PickID {
+			return ErrPickClockNotRunning
+		}
+
+		// Closing the offer early sets the deadline to what was left when it opened
+		stopped, err := q.HasOpenPickTradeOffer(ctx, req.DraftID)
+		if err != nil {
+			return fmt.Errorf("failed to check for an open pick trade offer: %w", err)
+		}
+		if stopped {
+			return ErrPickClockStopped
+		}
+
+		row, err := q.ExtendNextDeadline(ctx, db.ExtendNextDeadlineParams{
+			Now:          clock.DatabaseNow(r.clock),
+			ExtensionSec: int32(req.Extension / time.Second),
+			ID:           req.DraftID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPickClockNotRunning
+		}
+		if err != nil {
+			return fmt.Errorf("failed to extend next deadline: %w", err)
+		}
+
+		inserted, err := q.InsertPickClockExtension(ctx, db.InsertPickClockExtensionParams{
+			DraftID:     req.DraftID,
+			OldDeadline: draft.NextDeadline,
+			NewDeadline: row.NextDeadline,
+			ActorUserID: sqlutil.ToNullUUID(req.GrantedBy),
+			PickID:      uuid.NullUUID{UUID: current.ID, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record pick clock extension: %w", err)
+		}
+		if inserted == 0 {
+			return ErrPickClockAlreadyExtended
+		}
+
+		extension = &PickClockExtension{
+			Pick:             r.dbDraftPickToModel(current),
+			Extension:        req.Extension,
+			PreviousDeadline: draft.NextDeadline.Time,
+			Deadline:         row.NextDeadline.Time,
+			ServerTime:       row.ServerTime,
+			GrantedBy:        req.GrantedBy,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return extension, nil
+}
 
-	var deadline *time.Time
-	if row.NextDeadline.Valid {
-		deadline = &row.NextDeadline.Time
+func (r *Repository) FetchNextDeadline(ctx context.Context, draftID *uuid.UUID) (*NextDeadline, error) {
+	row, err := r.q(ctx).FetchNextDeadline(ctx, db.FetchNextDeadlineParams{
+		Now:     clock.DatabaseNow(r.clock),
+		DraftID: sqlutil.ToNullUUID(draftID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch next deadline: %w", err)
 	}
 
 	return &NextDeadline{
-		DraftID:  row.DraftID,
-		Deadline: deadline,
+		DraftID:    row.DraftID,
+		Deadline:   sqlutil.FromSqlTime(row.NextDeadline),
+		ServerTime: row.ServerTime,
 	}, nil
 }
 
-func (r *Repository) FetchDraftsDueForPick(ctx context.Context, limit int32) ([]uuid.UUID, error) {
-	rows, err := r.queries.FetchDraftsDueForPick(ctx, limit)
+// FetchDraftsDueForPick claims drafts whose pick deadline has passed. The claim is taken in
+// the same statement that finds the drafts, so concurrent callers never get the same draft.
+func (r *Repository) FetchDraftsDueForPick(ctx context.Context, req FetchDraftsDueForPickRequest) ([]uuid.UUID, error) {
+	rows, err := r.q(ctx).FetchDraftsDueForPick(ctx, db.FetchDraftsDueForPickParams{
+		Now:       clock.DatabaseNow(r.clock),
+		MaxDrafts: req.Limit,
+		ClaimedBy: sql.NullString{String: req.ClaimedBy, Valid: true},
+		LeaseSec:  int32(req.Lease / time.Second),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch drafts due for pick: %w", err)
 	}
 	return rows, nil
 }
 
-func (r *Repository) UpdateNextDeadline(ctx context.Context, draftID uuid.UUID, deadline *time.Time) error {
-	var deadlineValue sql.NullTime
-	if deadline != nil {
-		deadlineValue = sql.NullTime{Time: *deadline, Valid: true}
+func (r *Repository) UpdateNextDeadline(ctx context.Context, draftID uuid.UUID, deadline *time.Time) (*NextDeadline, error) {
+	var next *NextDeadline
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, draftID, r.queries.WithTx, func(q *db.Queries) error {
+		row, err := q.UpdateNextDeadline(ctx, db.UpdateNextDeadlineParams{
+			NextDeadline: sqlutil.ToSqlTime(deadline),
+			ID:           draftID,
+			Now:          clock.DatabaseNow(r.clock),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update next deadline: %w", err)
+		}
+		next = &NextDeadline{
+			DraftID:    draftID,
+			Deadline:   sqlutil.FromSqlTime(row.NextDeadline),
+			ServerTime: row.ServerTime,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return next, nil
+}
 
-	if err := r.queries.UpdateNextDeadline(ctx, db.UpdateNextDeadlineParams{
-		ID:            draftID,
-		NextDeadline: deadlineValue,
-	}); err != nil {
-		return fmt.Errorf("failed to update next deadline: %w", err)
+func (r *Repository) SetNextDeadlineFromNow(ctx context.Context, draftID uuid.UUID, timeout time.Duration) (*NextDeadline, error) {
+	var next *NextDeadline
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, draftID, r.queries.WithTx, func(q *db.Queries) error {
+		row, err := q.SetNextDeadlineFromNow(ctx, db.SetNextDeadlineFromNowParams{
+			Now:        clock.DatabaseNow(r.clock),
+			TimeoutSec: int32(timeout / time.Second),
+			ID:         draftID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set next deadline: %w", err)
+		}
+		next = &NextDeadline{
+			DraftID:    draftID,
+			Deadline:   sqlutil.FromSqlTime(row.NextDeadline),
+			ServerTime: row.ServerTime,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return next, nil
 }
 
 func (r *Repository) ClearNextDeadline(ctx context.Context, id uuid.UUID) error {
-	if err := r.queries.ClearNextDeadline(ctx, id); err != nil {
-		return fmt.Errorf("failed to clear next deadline: %w", err)
+	return sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, id, r.queries.WithTx, func(q *db.Queries) error {
+		if err := q.ClearNextDeadline(ctx, id); err != nil {
+			return fmt.Errorf("failed to clear next deadline: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *Repository) GetCurrentPick(ctx context.Context, draftID uuid.UUID) (*models.DraftPick, error) {
+	pick, err := r.q(ctx).GetCurrentDraftPick(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current pick: %w", err)
 	}
-	return nil
+	return r.dbDraftPickToModel(pick), nil
 }
 
-// Helper function to convert DB draft to model
-func (r *Repository) dbDraftToModel(dbDraft db.Draft) *models.Draft {
-	var settings models.DraftSettings
-	if err := json.Unmarshal(dbDraft.Settings, &settings); err != nil {
-		// Handle error appropriately, for now we'll use empty settings
-		settings = models.DraftSettings{}
+func (r *Repository) ListRecentPicks(ctx context.Context, draftID uuid.UUID, limit int32) ([]models.DraftPick, error) {
+	rows, err := r.q(ctx).ListRecentDraftPicks(ctx, db.ListRecentDraftPicksParams{
+		DraftID: draftID,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent picks: %w", err)
 	}
 
-	draft := &models.Draft{
-		ID:        dbDraft.ID,
-		LeagueID:  dbDraft.LeagueID,
-		DraftType: models.DraftType(dbDraft.DraftType),
-		Status:    models.DraftStatus(dbDraft.Status),
-		Settings:  settings,
-		CreatedAt: dbDraft.CreatedAt,
-		UpdatedAt: dbDraft.UpdatedAt,
+	picks := make([]models.DraftPick, len(rows))
+	for i, row := range rows {
+		picks[i] = *r.dbDraftPickToModel(row)
 	}
+	return picks, nil
+}
 
-	if dbDraft.ScheduledAt.Valid {
-		draft.ScheduledAt = &dbDraft.ScheduledAt.Time
+func (r *Repository) CountPicksMade(ctx context.Context, draftID uuid.UUID) (int, error) {
+	count, err := r.q(ctx).CountDraftPicksMade(ctx, draftID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count picks made: %w", err)
 	}
-	if dbDraft.StartedAt.Valid {
-		draft.StartedAt = &dbDraft.StartedAt.Time
+	return int(count), nil
+}
+
+func (r *Repository) AbandonTeam(ctx context.Context, req AbandonTeamRequest) (*AbandonTeamResult, error) {
+	// Runs under the draft's advisory lock so a pick can't be made for the team while its
+	// picks are being forfeited
+	var result *AbandonTeamResult
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID, r.queries.WithTx, func(q *db.Queries) error {
+		dbDraft, err := q.GetDraft(ctx, req.DraftID)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		draft := r.dbDraftToModel(dbDraft)
+		if err := checkDraftRunning(draft); err != nil {
+			return err
+		}
+		if !slices.Contains(draft.Settings.DraftOrder, req.FantasyTeamID) {
+			return ErrTeamNotInDraft
+		}
+		if draft.DraftType == models.DraftTypeAuction && req.PickHandling == models.AbandonedPickHandlingAutoPick {
+			return ErrAutoPickInAuction
+		}
+
+		row, err := q.InsertAbandonedTeam(ctx, db.InsertAbandonedTeamParams{
+			DraftID:       req.DraftID,
+			FantasyTeamID: req.FantasyTeamID,
+			PickHandling:  string(req.PickHandling),
+			Reason:        sqlutil.ToSqlString(req.Reason),
+			AbandonedBy:   sqlutil.ToNullUUID(req.AbandonedBy),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTeamAlreadyAbandoned
+		}
+		if err != nil {
+			return fmt.Errorf("failed to abandon team: %w", err)
+		}
+
+		onTheClock, err := holdsCurrentPick(ctx, q, req.DraftID, req.FantasyTeamID)
+		if err != nil {
+			return err
+		}
+
+		var forfeited []uuid.UUID
+		if req.PickHandling == models.AbandonedPickHandlingSkip {
+			forfeited, err = q.ForfeitTeamPicks(ctx, db.ForfeitTeamPicksParams{
+				DraftID: req.DraftID,
+				TeamID:  req.FantasyTeamID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to forfeit team picks: %w", err)
+			}
+		}
+
+		result = &AbandonTeamResult{
+			Team:             r.dbAbandonedTeamToModel(row),
+			ForfeitedPickIDs: forfeited,
+			OnTheClock:       onTheClock,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	if dbDraft.CompletedAt.Valid {
-		draft.CompletedAt = &dbDraft.CompletedAt.Time
+	return result, nil
+}
+
+func (r *Repository) RestoreTeam(ctx context.Context, draftID models.DraftID, fantasyTeamID models.TeamID) (*RestoreTeamResult, error) {
+	var result *RestoreTeamResult
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, draftID.UUID(), r.queries.WithTx, func(q *db.Queries) error {
+		dbDraft, err := q.GetDraft(ctx, draftID.UUID())
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		draft := r.dbDraftToModel(dbDraft)
+		if err := checkDraftRunning(draft); err != nil {
+			return err
+		}
+
+		_, err = q.DeleteAbandonedTeam(ctx, db.DeleteAbandonedTeamParams{
+			DraftID:       draftID.UUID(),
+			FantasyTeamID: fantasyTeamID.UUID(),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTeamNotAbandoned
+		}
+		if err != nil {
+			return fmt.Errorf("failed to restore team: %w", err)
+		}
+
+		restored, err := q.RestoreForfeitedTeamPicks(ctx, db.RestoreForfeitedTeamPicksParams{
+			DraftID:    draftID.UUID(),
+			TeamID:     fantasyTeamID.UUID(),
+			RestoreAll: draft.DraftType == models.DraftTypeAuction,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to restore forfeited picks: %w", err)
+		}
+
+		onTheClock, err := holdsCurrentPick(ctx, q, draftID.UUID(), fantasyTeamID.UUID())
+		if err != nil {
+			return err
+		}
+
+		result = &RestoreTeamResult{
+			RestoredPickIDs: restored,
+			OnTheClock:      onTheClock,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return result, nil
+}
 
-	return draft
-}
\ No newline at end of file
+func (r *Repository) ListAbandonedTeams(ctx context.Context, draftID uuid.UUID) ([]models.AbandonedTeam, error) {
+	rows, err := r.q(ctx).ListAbandonedTeams(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list abandoned teams: %w", err)
+	}
+
+	teams := make([]models.AbandonedTeam, len(rows))
+	for i, row := range rows {
+		teams[i] = *r.dbAbandonedTeamToModel(row)
+	}
+	return teams, nil
+}
+
+func (r *Repository) SetTeamReady(ctx context.Context, req SetTeamReadyRequest) (*models.DraftLobby, error) {
+	// Runs under the draft's advisory lock so the draft can't start between checking that it
+	// hasn't and marking the team
+	var lobby *models.DraftLobby
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID, r.queries.WithTx, func(q *db.Queries) error {
+		dbDraft, err := q.GetDraft(ctx, req.DraftID)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		draft := r.dbDraftToModel(dbDraft)
+		if draft.Status != models.DraftStatusNotStarted {
+			return fmt.Errorf("%w: current status is %s", ErrDraftAlreadyStarted, draft.Status)
+		}
+		if !slices.Contains(draft.Settings.DraftOrder, req.FantasyTeamID) {
+			return ErrTeamNotInDraft
+		}
+		if req.SetBy != nil {
+			ownerID, err := q.GetFantasyTeamOwnerID(ctx, req.FantasyTeamID)
+			if err != nil {
+				return fmt.Errorf("failed to get team owner: %w", err)
+			}
+			if ownerID != *req.SetBy {
+				return ErrNotTeamOwner
+			}
+		}
+
+		err = q.UpsertTeamReadiness(ctx, db.UpsertTeamReadinessParams{
+			DraftID:       req.DraftID,
+			FantasyTeamID: req.FantasyTeamID,
+			Ready:         req.Ready,
+			UpdatedBy:     sqlutil.ToNullUUID(req.SetBy),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set team readiness: %w", err)
+		}
+
+		lobby, err = r.draftLobby(ctx, q, draft)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lobby, nil
+}
+
+func (r *Repository) GetDraftLobby(ctx context.Context, draftID uuid.UUID) (*models.DraftLobby, error) {
+	draft, err := r.GetDraft(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	return r.draftLobby(ctx, r.queries, draft)
+}
+
+// draftLobby reads the readiness of a draft's teams
+func (r *Repository) draftLobby(ctx context.Context, q *db.Queries, draft *models.Draft) (*models.DraftLobby, error) {
+	rows, err := q.ListTeamReadiness(ctx, draft.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team readiness: %w", err)
+	}
+
+	marks := make([]models.TeamReadiness, len(rows))
+	for i, row := range rows {
+		marks[i] = r.dbTeamReadinessToModel(row)
+	}
+	return models.NewDraftLobby(draft, marks), nil
+}
+
+// RecordPickClockWarning records a warning for the pick on the clock unless the clock has moved
+// on from deadline or the warning was already given, in which case it returns nil. The final
+// warning also queues a notification for the owner of the team on the clock.
+func (r *Repository) RecordPickClockWarning(ctx context.Context, draftID uuid.UUID, deadline time.Time, percentRemaining int) (*PickClockWarning, error) {
+	var warning *PickClockWarning
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, draftID, r.queries.WithTx, func(q *db.Queries) error {
+		dbDraft, err := q.GetDraft(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		draft := r.dbDraftToModel(dbDraft)
+		if draft.Status != models.DraftStatusInProgress || draft.NextDeadline == nil || !draft.NextDeadline.Equal(deadline) {
+			return nil
+		}
+
+		current, err := q.GetCurrentDraftPick(ctx, draftID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get current pick: %w", err)
+		}
+
+		inserted, err := q.InsertPickClockWarning(ctx, db.InsertPickClockWarningParams{
+			PickID:           current.ID,
+			Deadline:         deadline,
+			PercentRemaining: int32(percentRemaining),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record pick clock warning: %w", err)
+		}
+		if inserted == 0 {
+			return nil
+		}
+
+		percents := draft.Settings.EffectiveClockWarningPercents()
+		warning = &PickClockWarning{
+			Pick:             r.dbDraftPickToModel(current),
+			PercentRemaining: percentRemaining,
+			Deadline:         deadline,
+			Final:            percentRemaining <= percents[len(percents)-1],
+		}
+		// Owners of the teams in a sandbox draft aren't taking part in it
+		if warning.Final && draft.SandboxOf == nil {
+			return r.notifyTeamOwner(ctx, q, draftID, warning)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return warning, nil
+}
+
+// notifyTeamOwner queues the final pick clock warning for the notification worker to email
+// and push to the owner of the team on the clock
+func (r *Repository) notifyTeamOwner(ctx context.Context, q *db.Queries, draftID uuid.UUID, warning *PickClockWarning) error {
+	owner, err := q.GetTeamOwnerContact(ctx, warning.Pick.TeamID)
+	if err != nil {
+		return fmt.Errorf("failed to get team owner: %w", err)
+	}
+
+	var leagueSettings interface{}
+	if err := json.Unmarshal(owner.LeagueSettings, &leagueSettings); err != nil {
+		return fmt.Errorf("failed to unmarshal league settings: %w", err)
+	}
+	clock := models.SettingsLeagueClock(leagueSettings)
+
+	payload, err := json.Marshal(userevents.PickClockWarningPayload{
+		UserID:           owner.ID.String(),
+		Username:         owner.Username,
+		Email:            owner.Email,
+		DraftID:          draftID.String(),
+		PickID:           warning.Pick.ID.String(),
+		TeamName:         owner.TeamName,
+		Round:            warning.Pick.Round,
+		Pick:             warning.Pick.Pick,
+		OverallPick:      warning.Pick.OverallPick,
+		PercentRemaining: warning.PercentRemaining,
+		Deadline:         warning.Deadline,
+		Timezone:         clock.Location.String(),
+		Locale:           clock.Locale.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal PickClockWarning notification: %w", err)
+	}
+
+	if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+		ID:        ids.New(),
+		UserID:    owner.ID,
+		EventType: userevents.PickClockWarning,
+		Payload:   payload,
+	}); err != nil {
+		return fmt.Errorf("failed to queue PickClockWarning notification: %w", err)
+	}
+	return nil
+}
+
+// RecordCommissionerPause records that a draft was paused for its commissioner's disconnect
+func (r *Repository) RecordCommissionerPause(ctx context.Context, draftID uuid.UUID) error {
+	return r.q(ctx).InsertCommissionerPause(ctx, draftID)
+}
+
+// ClearCommissionerPause forgets a draft's commissioner pause and reports whether it had one
+func (r *Repository) ClearCommissionerPause(ctx context.Context, draftID uuid.UUID) (bool, error) {
+	deleted, err := r.q(ctx).DeleteCommissionerPause(ctx, draftID)
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+// CreateMaintenanceWindow schedules a maintenance window
+func (r *Repository) CreateMaintenanceWindow(ctx context.Context, req ScheduleMaintenanceRequest) (*models.MaintenanceWindow, error) {
+	dbWindow, err := r.q(ctx).InsertMaintenanceWindow(ctx, db.InsertMaintenanceWindowParams{
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+		Message:  req.Message,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create maintenance window: %w", err)
+	}
+	return r.dbMaintenanceWindowToModel(dbWindow), nil
+}
+
+// ListMaintenanceWindows returns the maintenance windows that haven't closed or been cancelled
+// as of now, the next to open first
+func (r *Repository) ListMaintenanceWindows(ctx context.Context, now time.Time) ([]models.MaintenanceWindow, error) {
+	dbWindows, err := r.q(ctx).ListMaintenanceWindows(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance windows: %w", err)
+	}
+
+	windows := make([]models.MaintenanceWindow, len(dbWindows))
+	for i, dbWindow := range dbWindows {
+		windows[i] = *r.dbMaintenanceWindowToModel(dbWindow)
+	}
+	return windows, nil
+}
+
+// CancelMaintenanceWindow cancels a maintenance window that hasn't been cancelled yet
+func (r *Repository) CancelMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error) {
+	dbWindow, err := r.q(ctx).CancelMaintenanceWindow(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMaintenanceWindowNotFound
+		}
+		return nil, fmt.Errorf("failed to cancel maintenance window: %w", err)
+	}
+	return r.dbMaintenanceWindowToModel(dbWindow), nil
+}
+
+// ListDraftIDsInProgress returns the IDs of every draft in progress
+func (r *Repository) ListDraftIDsInProgress(ctx context.Context) ([]uuid.UUID, error) {
+	ids, err := r.q(ctx).ListDraftIDsInProgress(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drafts in progress: %w", err)
+	}
+	return ids, nil
+}
+
+// RecordMaintenanceNotice records that a maintenance window was announced to a draft and
+// reports whether it wasn't before
+func (r *Repository) RecordMaintenanceNotice(ctx context.Context, windowID uuid.UUID, draftID models.DraftID) (bool, error) {
+	inserted, err := r.q(ctx).InsertDraftMaintenanceNotice(ctx, db.InsertDraftMaintenanceNoticeParams{
+		WindowID: windowID,
+		DraftID:  draftID.UUID(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return inserted > 0, nil
+}
+
+// RecordMaintenancePause records that a draft was paused for a maintenance window
+func (r *Repository) RecordMaintenancePause(ctx context.Context, draftID models.DraftID, windowID uuid.UUID) error {
+	return r.q(ctx).InsertDraftMaintenancePause(ctx, db.InsertDraftMaintenancePauseParams{
+		DraftID:  draftID.UUID(),
+		WindowID: windowID,
+	})
+}
+
+// ListMaintenancePausesToLift returns the drafts still paused for a maintenance window that
+// closed or was cancelled as of now
+func (r *Repository) ListMaintenancePausesToLift(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	rows, err := r.q(ctx).ListMaintenancePausesToLift(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance pauses to lift: %w", err)
+	}
+
+	draftIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		draftIDs[i] = row.DraftID
+	}
+	return draftIDs, nil
+}
+
+// ClearMaintenancePause forgets a draft's maintenance pause and reports whether it had one
+func (r *Repository) ClearMaintenancePause(ctx context.Context, draftID uuid.UUID) (bool, error) {
+	deleted, err := r.q(ctx).DeleteDraftMaintenancePause(ctx, draftID)
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+// RecordFrameDelivery records whether a user acknowledged a frame and reports whether the
+// frame fell back to a push notification. That happens the first time a PickStarted frame is
+// recorded unacknowledged, if its pick is still on the clock and the user manages its team.
+func (r *Repository) RecordFrameDelivery(ctx context.Context, delivery FrameDelivery) (bool, error) {
+	if delivery.Status == models.FrameDeliveryStatusAcked {
+		err := r.q(ctx).UpsertAckedFrameDelivery(ctx, db.UpsertAckedFrameDeliveryParams{
+			EventID:   delivery.EventID,
+			UserID:    delivery.UserID,
+			DraftID:   delivery.DraftID,
+			EventType: delivery.EventType,
+			Attempts:  int32(delivery.Attempts),
+			SentAt:    delivery.SentAt,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to record acknowledged frame: %w", err)
+		}
+		return false, nil
+	}
+
+	var pushQueued bool
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, delivery.DraftID, r.queries.WithTx, func(q *db.Queries) error {
+		inserted, err := q.InsertUnackedFrameDelivery(ctx, db.InsertUnackedFrameDeliveryParams{
+			EventID:   delivery.EventID,
+			UserID:    delivery.UserID,
+			DraftID:   delivery.DraftID,
+			EventType: delivery.EventType,
+			Attempts:  int32(delivery.Attempts),
+			SentAt:    delivery.SentAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record unacknowledged frame: %w", err)
+		}
+		if inserted == 0 {
+			return nil
+		}
+		if delivery.EventType == events.WatchlistAlert && delivery.WatchlistAlertID != nil {
+			if err := r.notifyWatchlistAlert(ctx, q, delivery); err != nil {
+				return err
+			}
+			pushQueued = true
+			return nil
+		}
+		if delivery.EventType != events.PickStarted || delivery.PickID == nil {
+			return nil
+		}
+
+		current, err := q.GetCurrentDraftPick(ctx, delivery.DraftID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get current pick: %w", err)
+		}
+		if current.ID != *delivery.PickID {
+			return nil
+		}
+
+		manages, err := q.CanVoteForDraftTeam(ctx, db.CanVoteForDraftTeamParams{
+			FantasyTeamID: current.TeamID,
+			UserID:        delivery.UserID,
+			DraftID:       delivery.DraftID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check team manager: %w", err)
+		}
+		if !manages {
+			return nil
+		}
+
+		dbDraft, err := q.GetDraft(ctx, delivery.DraftID)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		draft := r.dbDraftToModel(dbDraft)
+		if draft.SandboxOf != nil {
+			return nil
+		}
+		if err := r.notifyOnTheClock(ctx, q, delivery, r.dbDraftPickToModel(current), draft.NextDeadline); err != nil {
+			return err
+		}
+		pushQueued = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return pushQueued, nil
+}
+
+// notifyOnTheClock queues the push notification telling a user their team is on the clock,
+// for the frame that should have told them
+func (r *Repository) notifyOnTheClock(ctx context.Context, q *db.Queries, delivery FrameDelivery, pick *models.DraftPick, deadline *time.Time) error {
+	contact, err := q.GetUserTeamContact(ctx, db.GetUserTeamContactParams{
+		FantasyTeamID: pick.TeamID,
+		UserID:        delivery.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get user contact: %w", err)
+	}
+
+	var leagueSettings interface{}
+	if err := json.Unmarshal(contact.LeagueSettings, &leagueSettings); err != nil {
+		return fmt.Errorf("failed to unmarshal league settings: %w", err)
+	}
+	clock := models.SettingsLeagueClock(leagueSettings)
+
+	payload, err := json.Marshal(userevents.OnTheClockPayload{
+		UserID:      contact.ID.String(),
+		Username:    contact.Username,
+		Email:       contact.Email,
+		DraftID:     delivery.DraftID.String(),
+		EventID:     delivery.EventID.String(),
+		PickID:      pick.ID.String(),
+		TeamName:    contact.TeamName,
+		Round:       pick.Round,
+		Pick:        pick.Pick,
+		OverallPick: pick.OverallPick,
+		Deadline:    deadline,
+		Timezone:    clock.Location.String(),
+		Locale:      clock.Locale.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal OnTheClock notification: %w", err)
+	}
+
+	if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+		ID:        ids.New(),
+		UserID:    contact.ID,
+		EventType: userevents.OnTheClock,
+		Payload:   payload,
+	}); err != nil {
+		return fmt.Errorf("failed to queue OnTheClock notification: %w", err)
+	}
+	return nil
+}
+
+// notifyWatchlistAlert queues the push notification for a watchlist alert, for the frame that
+// should have told the user
+func (r *Repository) notifyWatchlistAlert(ctx context.Context, q *db.Queries, delivery FrameDelivery) error {
+	alert, err := r.getWatchlistAlert(ctx, q, *delivery.WatchlistAlertID, delivery.UserID)
+	if err != nil {
+		return err
+	}
+	contact, err := q.GetUserTeamContact(ctx, db.GetUserTeamContactParams{
+		FantasyTeamID: alert.FantasyTeamID,
+		UserID:        delivery.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get user contact: %w", err)
+	}
+
+	payload, err := json.Marshal(userevents.WatchlistAlertPayload{
+		UserID:       contact.ID.String(),
+		Username:     contact.Username,
+		Email:        contact.Email,
+		DraftID:      delivery.DraftID.String(),
+		EventID:      delivery.EventID.String(),
+		LeagueName:   alert.LeagueName,
+		PlayerID:     alert.PlayerID.String(),
+		PlayerName:   alert.PlayerName,
+		Kind:         string(alert.Kind),
+		OverallPick:  alert.OverallPick,
+		PickTeamName: alert.PickTeamName,
+		PicksAway:    alert.PicksAway,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal WatchlistAlert notification: %w", err)
+	}
+
+	if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+		ID:        ids.New(),
+		UserID:    contact.ID,
+		EventType: userevents.WatchlistAlert,
+		Payload:   payload,
+	}); err != nil {
+		return fmt.Errorf("failed to queue WatchlistAlert notification: %w", err)
+	}
+	return nil
+}
+
+// RaiseWatchlistAlerts raises the watchlist alerts due in a draft now that a new pick is on
+// the clock: one for each watchlisted player still on the board whose watcher's team picks
+// within the watcher's alert window, and one for each taken by another team. Alerts already
+// raised aren't raised again, so only new ones are returned. Sandbox drafts raise none.
+func (r *Repository) RaiseWatchlistAlerts(ctx context.Context, draftID uuid.UUID) ([]models.WatchlistAlert, error) {
+	var alerts []models.WatchlistAlert
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, draftID, r.queries.WithTx, func(q *db.Queries) error {
+		alerts = nil
+
+		dbDraft, err := q.GetDraft(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		if r.dbDraftToModel(dbDraft).SandboxOf != nil {
+			return nil
+		}
+
+		entries, err := q.ListDraftWatchlistEntries(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("failed to list watchlist entries: %w", err)
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		open, err := q.ListOpenDraftPicks(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("failed to list open picks: %w", err)
+		}
+		// How many picks come before each team's next one
+		picksAway := make(map[uuid.UUID]int)
+		for i, pick := range open {
+			if _, ok := picksAway[pick.TeamID]; !ok {
+				picksAway[pick.TeamID] = i
+			}
+		}
+
+		for _, entry := range entries {
+			params := db.InsertWatchlistAlertParams{
+				DraftID:       draftID,
+				UserID:        entry.UserID,
+				FantasyTeamID: entry.FantasyTeamID,
+				PlayerID:      entry.PlayerID,
+			}
+			if entry.DraftedPickID.Valid {
+				if entry.DraftedByTeamID.UUID == entry.FantasyTeamID {
+					continue
+				}
+				params.Kind = string(models.WatchlistAlertDrafted)
+				params.PickID = entry.DraftedPickID.UUID
+			} else {
+				away, ok := picksAway[entry.FantasyTeamID]
+				if !ok || away > int(entry.AlertWithinPicks) {
+					continue
+				}
+				params.Kind = string(models.WatchlistAlertApproaching)
+				params.PickID = open[away].ID
+				params.PicksAway = sql.NullInt32{Int32: int32(away), Valid: true}
+			}
+
+			alertID, err := q.InsertWatchlistAlert(ctx, params)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to raise watchlist alert: %w", err)
+			}
+			alert, err := r.getWatchlistAlert(ctx, q, alertID, entry.UserID)
+			if err != nil {
+				return err
+			}
+			alerts = append(alerts, *alert)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// getWatchlistAlert loads a watchlist alert raised for a user
+func (r *Repository) getWatchlistAlert(ctx context.Context, q *db.Queries, alertID, userID uuid.UUID) (*models.WatchlistAlert, error) {
+	row, err := q.GetWatchlistAlert(ctx, db.GetWatchlistAlertParams{
+		ID:     alertID,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watchlist alert: %w", err)
+	}
+
+	alert := &models.WatchlistAlert{
+		ID:            row.ID,
+		DraftID:       row.DraftID,
+		LeagueName:    row.LeagueName,
+		UserID:        row.UserID,
+		FantasyTeamID: row.FantasyTeamID,
+		PlayerID:      row.PlayerID,
+		PlayerName:    row.PlayerName,
+		Kind:          models.WatchlistAlertKind(row.Kind),
+		PickID:        row.PickID,
+		Round:         int(row.Round),
+		Pick:          int(row.Pick),
+		OverallPick:   int(row.OverallPick),
+		PickTeamID:    row.PickTeamID,
+		PickTeamName:  row.PickTeamName,
+		CreatedAt:     row.CreatedAt,
+	}
+	if row.PicksAway.Valid {
+		away := int(row.PicksAway.Int32)
+		alert.PicksAway = &away
+	}
+	return alert, nil
+}
+
+// ListDraftsStartingSoon lists the drafts yet to start that are scheduled to start after now
+// and no later than horizon
+func (r *Repository) ListDraftsStartingSoon(ctx context.Context, now, horizon time.Time) ([]ScheduledDraft, error) {
+	rows, err := r.q(ctx).ListDraftsStartingSoon(ctx, db.ListDraftsStartingSoonParams{
+		Now:     now,
+		Horizon: horizon,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drafts starting soon: %w", err)
+	}
+
+	drafts := make([]ScheduledDraft, len(rows))
+	for i, row := range rows {
+		drafts[i] = ScheduledDraft{DraftID: row.ID, ScheduledAt: row.ScheduledAt}
+	}
+	return drafts, nil
+}
+
+// RecordStartCountdown records the countdown checkpoint minutesBefore the draft's start at
+// scheduledAt unless the draft has started or been moved to another time, or the checkpoint
+// was already announced, in which case it returns nil. The first checkpoint announced for a
+// start also queues a notification for the owner of every team in the draft's league.
+func (r *Repository) RecordStartCountdown(ctx context.Context, draftID uuid.UUID, scheduledAt time.Time, minutesBefore int, announcedAt time.Time) (*StartCountdown, error) {
+	var countdown *StartCountdown
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, draftID, r.queries.WithTx, func(q *db.Queries) error {
+		dbDraft, err := q.GetDraft(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		draft := r.dbDraftToModel(dbDraft)
+		if draft.Status != models.DraftStatusNotStarted || draft.ScheduledAt == nil || !draft.ScheduledAt.Equal(scheduledAt) {
+			return nil
+		}
+
+		inserted, err := q.InsertDraftStartCountdown(ctx, db.InsertDraftStartCountdownParams{
+			DraftID:       draftID,
+			ScheduledAt:   scheduledAt,
+			MinutesBefore: int32(minutesBefore),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record start countdown: %w", err)
+		}
+		if inserted == 0 {
+			return nil
+		}
+
+		announced, err := q.CountDraftStartCountdowns(ctx, db.CountDraftStartCountdownsParams{
+			DraftID:     draftID,
+			ScheduledAt: scheduledAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to count start countdowns: %w", err)
+		}
+
+		countdown = &StartCountdown{
+			DraftID:        draftID,
+			ScheduledAt:    scheduledAt,
+			MinutesBefore:  minutesBefore,
+			AnnouncedAt:    announcedAt,
+			OwnersNotified: announced == 1,
+		}
+		if countdown.OwnersNotified {
+			return r.notifyTeamOwnersStartingSoon(ctx, q, countdown)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return countdown, nil
+}
+
+// notifyTeamOwnersStartingSoon queues a notification for the notification worker to email and
+// push to the owner of every team in the draft's league that the draft is about to start
+func (r *Repository) notifyTeamOwnersStartingSoon(ctx context.Context, q *db.Queries, countdown *StartCountdown) error {
+	owners, err := q.ListDraftTeamOwnerContacts(ctx, countdown.DraftID)
+	if err != nil {
+		return fmt.Errorf("failed to list team owners: %w", err)
+	}
+
+	for _, owner := range owners {
+		var leagueSettings interface{}
+		if err := json.Unmarshal(owner.LeagueSettings, &leagueSettings); err != nil {
+			return fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+		clock := models.SettingsLeagueClock(leagueSettings)
+
+		payload, err := json.Marshal(userevents.DraftStartingSoonPayload{
+			UserID:      owner.ID.String(),
+			Username:    owner.Username,
+			Email:       owner.Email,
+			DraftID:     countdown.DraftID.String(),
+			LeagueName:  owner.LeagueName,
+			TeamName:    owner.TeamName,
+			ScheduledAt: countdown.ScheduledAt,
+			Timezone:    clock.Location.String(),
+			Locale:      clock.Locale.String(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal DraftStartingSoon notification: %w", err)
+		}
+
+		if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+			ID:        ids.New(),
+			UserID:    owner.ID,
+			EventType: userevents.DraftStartingSoon,
+			Payload:   payload,
+		}); err != nil {
+			return fmt.Errorf("failed to queue DraftStartingSoon notification: %w", err)
+		}
+	}
+	return nil
+}
+
+// notifyTeamOwnersScheduled queues a notification for the notification worker to email the
+// owner of every team in the draft's league a calendar invite for the draft's scheduled start.
+// Sandbox drafts, whose teams' owners are never notified, and drafts without a start are skipped.
+func (r *Repository) notifyTeamOwnersScheduled(ctx context.Context, q *db.Queries, draft db.Draft, previous *time.Time) error {
+	if !draft.ScheduledAt.Valid || draft.SandboxOfDraftID.Valid {
+		return nil
+	}
+
+	sequence, err := q.NextDraftCalendarInviteSequence(ctx, db.NextDraftCalendarInviteSequenceParams{
+		DraftID:     draft.ID,
+		ScheduledAt: draft.ScheduledAt.Time,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record calendar invite: %w", err)
+	}
+
+	owners, err := q.ListDraftTeamOwnerContacts(ctx, draft.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list team owners: %w", err)
+	}
+
+	for _, owner := range owners {
+		var leagueSettings interface{}
+		if err := json.Unmarshal(owner.LeagueSettings, &leagueSettings); err != nil {
+			return fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+		clock := models.SettingsLeagueClock(leagueSettings)
+
+		payload, err := json.Marshal(userevents.DraftScheduledPayload{
+			UserID:              owner.ID.String(),
+			Username:            owner.Username,
+			Email:               owner.Email,
+			DraftID:             draft.ID.String(),
+			LeagueName:          owner.LeagueName,
+			TeamName:            owner.TeamName,
+			ScheduledAt:         draft.ScheduledAt.Time,
+			PreviousScheduledAt: previous,
+			Sequence:            int(sequence),
+			Timezone:            clock.Location.String(),
+			Locale:              clock.Locale.String(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal DraftScheduled notification: %w", err)
+		}
+
+		if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+			ID:        ids.New(),
+			UserID:    owner.ID,
+			EventType: userevents.DraftScheduled,
+			Payload:   payload,
+		}); err != nil {
+			return fmt.Errorf("failed to queue DraftScheduled notification: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *Repository) CreateWebhook(ctx context.Context, req CreateDraftWebhookRequest, secret string) (*models.DraftWebhook, error) {
+	// Runs under the draft's advisory lock so webhooks created at once can't exceed the limit
+	var webhook *models.DraftWebhook
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID, r.queries.WithTx, func(q *db.Queries) error {
+		count, err := q.CountDraftWebhooks(ctx, req.DraftID)
+		if err != nil {
+			return fmt.Errorf("failed to count webhooks: %w", err)
+		}
+		if count >= MaxWebhooksPerDraft {
+			return ErrTooManyWebhooks
+		}
+
+		row, err := q.CreateDraftWebhook(ctx, db.CreateDraftWebhookParams{
+			DraftID:     req.DraftID,
+			Url:         req.URL,
+			Secret:      secret,
+			Description: sqlutil.ToSqlString(req.Description),
+			CreatedBy:   sqlutil.ToNullUUID(req.CreatedBy),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create webhook: %w", err)
+		}
+		webhook = r.dbWebhookToModel(row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (r *Repository) ListWebhooks(ctx context.Context, draftID uuid.UUID) ([]models.DraftWebhook, error) {
+	rows, err := r.q(ctx).ListDraftWebhooks(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	webhooks := make([]models.DraftWebhook, len(rows))
+	for i, row := range rows {
+		webhooks[i] = *r.dbWebhookToModel(row)
+	}
+	return webhooks, nil
+}
+
+func (r *Repository) DeleteWebhook(ctx context.Context, draftID models.DraftID, webhookID uuid.UUID) error {
+	deleted, err := r.q(ctx).DeleteDraftWebhook(ctx, db.DeleteDraftWebhookParams{
+		ID:      webhookID,
+		DraftID: draftID.UUID(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	if deleted == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *Repository) AddCoManager(ctx context.Context, req AddDraftCoManagerRequest) (*models.DraftCoManager, error) {
+	// Runs under the draft's advisory lock so co-managers added at once can't exceed the limit
+	var coManager *models.DraftCoManager
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID, r.queries.WithTx, func(q *db.Queries) error {
+		if req.AddedBy != nil {
+			if err := checkCanManageTeam(ctx, q, req.DraftID, req.FantasyTeamID, *req.AddedBy); err != nil {
+				return err
+			}
+		}
+
+		exists, err := q.UserExists(ctx, req.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to check user: %w", err)
+		}
+		if !exists {
+			return ErrUserNotFound
+		}
+
+		count, err := q.CountDraftTeamCoManagers(ctx, db.CountDraftTeamCoManagersParams{
+			DraftID:       req.DraftID,
+			FantasyTeamID: req.FantasyTeamID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to count co-managers: %w", err)
+		}
+		if count >= MaxCoManagersPerTeam {
+			return ErrTooManyCoManagers
+		}
+
+		row, err := q.InsertDraftCoManager(ctx, db.InsertDraftCoManagerParams{
+			DraftID:       req.DraftID,
+			FantasyTeamID: req.FantasyTeamID,
+			UserID:        req.UserID,
+			AddedBy:       sqlutil.ToNullUUID(req.AddedBy),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrAlreadyCoManager
+		}
+		if err != nil {
+			return fmt.Errorf("failed to insert co-manager: %w", err)
+		}
+		coManager = r.dbCoManagerToModel(row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return coManager, nil
+}
+
+func (r *Repository) RemoveCoManager(ctx context.Context, req RemoveDraftCoManagerRequest) error {
+	q := r.q(ctx)
+	// Co-managers may step down themselves
+	if req.RemovedBy != nil && *req.RemovedBy != req.UserID {
+		if err := checkCanManageTeam(ctx, q, req.DraftID, req.FantasyTeamID, *req.RemovedBy); err != nil {
+			return err
+		}
+	}
+
+	deleted, err := q.DeleteDraftCoManager(ctx, db.DeleteDraftCoManagerParams{
+		DraftID:       req.DraftID,
+		FantasyTeamID: req.FantasyTeamID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete co-manager: %w", err)
+	}
+	if deleted == 0 {
+		return ErrCoManagerNotFound
+	}
+	return nil
+}
+
+func (r *Repository) ListCoManagers(ctx context.Context, draftID uuid.UUID) ([]models.DraftCoManager, error) {
+	rows, err := r.q(ctx).ListDraftCoManagers(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list co-managers: %w", err)
+	}
+
+	coManagers := make([]models.DraftCoManager, len(rows))
+	for i, row := range rows {
+		coManagers[i] = *r.dbCoManagerToModel(row)
+	}
+	return coManagers, nil
+}
+
+func (r *Repository) StartPauseVote(ctx context.Context, req StartPauseVoteRequest) (*models.PauseVote, error) {
+	// Runs under the draft's advisory lock so the draft can't pause or finish while the vote opens
+	var vote *models.PauseVote
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID, r.queries.WithTx, func(q *db.Queries) error {
+		dbDraft, err := q.GetDraft(ctx, req.DraftID)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		draft := r.dbDraftToModel(dbDraft)
+		if draft.Status != models.DraftStatusInProgress {
+			return fmt.Errorf("%w: current status is %s", ErrDraftNotInProgress, draft.Status)
+		}
+		settings := draft.Settings.PauseVote
+		if settings == nil {
+			return ErrPauseVotesDisabled
+		}
+
+		eligible, err := pauseVoteTeams(ctx, q, draft)
+		if err != nil {
+			return err
+		}
+		if err := checkCanVote(ctx, q, draft, eligible, req.FantasyTeamID, req.StartedBy); err != nil {
+			return err
+		}
+
+		// A vote left open past its window no longer blocks a new one
+		now := r.clock.Now()
+		if _, err := q.ExpireOpenPauseVotes(ctx, db.ExpireOpenPauseVotesParams{
+			DraftID:  req.DraftID,
+			ClosesAt: now,
+		}); err != nil {
+			return fmt.Errorf("failed to expire pause votes: %w", err)
+		}
+
+		row, err := q.InsertPauseVote(ctx, db.InsertPauseVoteParams{
+			DraftID:          req.DraftID,
+			InitiatingTeamID: req.FantasyTeamID,
+			InitiatedBy:      sqlutil.ToNullUUID(req.StartedBy),
+			Reason:           sqlutil.ToSqlString(req.Reason),
+			EligibleTeams:    int32(len(eligible)),
+			VotesNeeded:      int32(settings.VotesNeeded(len(eligible))),
+			ClosesAt:         now.Add(settings.Window()),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPauseVoteOpen
+		}
+		if err != nil {
+			return fmt.Errorf("failed to open pause vote: %w", err)
+		}
+
+		err = q.UpsertPauseVoteBallot(ctx, db.UpsertPauseVoteBallotParams{
+			VoteID:        row.ID,
+			FantasyTeamID: req.FantasyTeamID,
+			InFavor:       true,
+			CastBy:        sqlutil.ToNullUUID(req.StartedBy),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to cast pause vote ballot: %w", err)
+		}
+
+		vote, err = r.pauseVote(ctx, q, row)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vote, nil
+}
+
+func (r *Repository) CastPauseVote(ctx context.Context, req CastPauseVoteRequest) (*models.PauseVote, error) {
+	// Runs under the draft's advisory lock so a ballot can't land after the vote closes
+	var vote *models.PauseVote
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID, r.queries.WithTx, func(q *db.Queries) error {
+		row, err := q.GetPauseVote(ctx, db.GetPauseVoteParams{ID: req.VoteID, DraftID: req.DraftID})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPauseVoteNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get pause vote: %w", err)
+		}
+		if row.Status != string(models.PauseVoteStatusOpen) || !r.clock.Now().Before(row.ClosesAt) {
+			return ErrPauseVoteClosed
+		}
+
+		dbDraft, err := q.GetDraft(ctx, req.DraftID)
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		draft := r.dbDraftToModel(dbDraft)
+		if draft.Status != models.DraftStatusInProgress {
+			return fmt.Errorf("%w: current status is %s", ErrDraftNotInProgress, draft.Status)
+		}
+		eligible, err := pauseVoteTeams(ctx, q, draft)
+		if err != nil {
+			return err
+		}
+		if err := checkCanVote(ctx, q, draft, eligible, req.FantasyTeamID, req.CastBy); err != nil {
+			return err
+		}
+
+		err = q.UpsertPauseVoteBallot(ctx, db.UpsertPauseVoteBallotParams{
+			VoteID:        req.VoteID,
+			FantasyTeamID: req.FantasyTeamID,
+			InFavor:       req.InFavor,
+			CastBy:        sqlutil.ToNullUUID(req.CastBy),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to cast pause vote ballot: %w", err)
+		}
+
+		vote, err = r.pauseVote(ctx, q, row)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vote, nil
+}
+
+func (r *Repository) ClosePauseVote(ctx context.Context, draftID models.DraftID, voteID uuid.UUID, passed bool) (*ClosePauseVoteResult, error) {
+	// Runs under the draft's advisory lock so a passed vote pauses the draft it was counted against
+	var result *ClosePauseVoteResult
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, draftID.UUID(), r.queries.WithTx, func(q *db.Queries) error {
+		row, err := q.GetPauseVote(ctx, db.GetPauseVoteParams{ID: voteID, DraftID: draftID.UUID()})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPauseVoteNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get pause vote: %w", err)
+		}
+		vote, err := r.pauseVote(ctx, q, row)
+		if err != nil {
+			return err
+		}
+		if vote.Status != models.PauseVoteStatusOpen {
+			result = &ClosePauseVoteResult{Vote: vote}
+			return nil
+		}
+		if passed && !vote.Reached() {
+			return ErrPauseVoteNotReached
+		}
+
+		status := models.PauseVoteStatusFailed
+		if passed {
+			status = models.PauseVoteStatusPassed
+		}
+		row, err = q.ClosePauseVote(ctx, db.ClosePauseVoteParams{
+			ID:      voteID,
+			DraftID: draftID.UUID(),
+			Status:  string(status),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to close pause vote: %w", err)
+		}
+		result = &ClosePauseVoteResult{Closed: true}
+		if result.Vote, err = r.pauseVote(ctx, q, row); err != nil {
+			return err
+		}
+		if !passed {
+			return nil
+		}
+
+		// The draft may have been paused by other means while the vote was open
+		dbDraft, err := q.GetDraft(ctx, draftID.UUID())
+		if err != nil {
+			return fmt.Errorf("draft not found: %w", err)
+		}
+		if dbDraft.Status != db.DraftStatusINPROGRESS {
+			return nil
+		}
+		_, err = q.UpdateDraftStatus(ctx, db.UpdateDraftStatusParams{
+			Status: db.DraftStatusPAUSED,
+			Now:    clock.DatabaseNow(r.clock),
+			ID:     draftID.UUID(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update draft status: %w", err)
+		}
+		result.Paused = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// pauseVote tallies the ballots cast in a pause vote
+func (r *Repository) pauseVote(ctx context.Context, q *db.Queries, row db.DraftPauseVote) (*models.PauseVote, error) {
+	counts, err := q.CountPauseVoteBallots(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pause vote ballots: %w", err)
+	}
+	return r.dbPauseVoteToModel(row, counts), nil
+}
+
+// pauseVoteTeams returns the teams that may vote in a draft's pause votes: those in its order
+// that haven't been abandoned
+func pauseVoteTeams(ctx context.Context, q *db.Queries, draft *models.Draft) (map[uuid.UUID]bool, error) {
+	abandoned, err := q.ListAbandonedTeams(ctx, draft.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list abandoned teams: %w", err)
+	}
+
+	teams := make(map[uuid.UUID]bool, len(draft.Settings.DraftOrder))
+	for _, teamID := range draft.Settings.DraftOrder {
+		teams[teamID] = true
+	}
+	for _, team := range abandoned {
+		delete(teams, team.FantasyTeamID)
+	}
+	return teams, nil
+}
+
+// checkCanVote allows a team to vote only while it's drafting, and only through its owner or co-managers
+func checkCanVote(ctx context.Context, q *db.Queries, draft *models.Draft, eligible map[uuid.UUID]bool, fantasyTeamID uuid.UUID, userID *uuid.UUID) error {
+	if !slices.Contains(draft.Settings.DraftOrder, fantasyTeamID) {
+		return ErrTeamNotInDraft
+	}
+	if !eligible[fantasyTeamID] {
+		return ErrTeamAbandoned
+	}
+	if userID == nil {
+		return nil
+	}
+
+	canVote, err := q.CanVoteForDraftTeam(ctx, db.CanVoteForDraftTeamParams{
+		FantasyTeamID: fantasyTeamID,
+		UserID:        *userID,
+		DraftID:       draft.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check team voter: %w", err)
+	}
+	if !canVote {
+		return ErrNotTeamVoter
+	}
+	return nil
+}
+
+// checkCanManageTeam allows only a team's owner or the league's commissioner to choose its co-managers
+func checkCanManageTeam(ctx context.Context, q *db.Queries, draftID, fantasyTeamID, userID uuid.UUID) error {
+	canManage, err := q.CanManageDraftTeam(ctx, db.CanManageDraftTeamParams{
+		DraftID:       draftID,
+		FantasyTeamID: fantasyTeamID,
+		UserID:        userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check team manager: %w", err)
+	}
+	if !canManage {
+		return ErrNotTeamManager
+	}
+	return nil
+}
+
+// holdsCurrentPick reports whether a team holds the pick on the clock
+func holdsCurrentPick(ctx context.Context, q *db.Queries, draftID, fantasyTeamID uuid.UUID) (bool, error) {
+	current, err := q.GetCurrentDraftPick(ctx, draftID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get current pick: %w", err)
+	}
+	return current.TeamID == fantasyTeamID, nil
+}
+
+// checkDraftRunning allows abandoning and restoring teams only while a draft is underway
+func checkDraftRunning(draft *models.Draft) error {
+	if draft.Status != models.DraftStatusInProgress && draft.Status != models.DraftStatusPaused {
+		return fmt.Errorf("%w: current status is %s", ErrDraftNotInProgress, draft.Status)
+	}
+	return nil
+}
+
+// Helper function to convert DB draft to model
+func (r *Repository) dbDraftToModel(dbDraft db.Draft) *models.Draft {
+	var settings models.DraftSettings
+	if err := json.Unmarshal(dbDraft.Settings, &settings); err != nil {
+		// Handle error appropriately, for now we'll use empty settings
+		settings = models.DraftSettings{}
+	}
+
+	draft := &models.Draft{
+		ID:        dbDraft.ID,
+		LeagueID:  dbDraft.LeagueID,
+		DraftType: models.DraftType(dbDraft.DraftType),
+		Status:    models.DraftStatus(dbDraft.Status),
+		Settings:  settings,
+		CreatedAt: dbDraft.CreatedAt,
+		UpdatedAt: dbDraft.UpdatedAt,
+	}
+
+	if dbDraft.ScheduledAt.Valid {
+		draft.ScheduledAt = &dbDraft.ScheduledAt.Time
+	}
+	if dbDraft.StartedAt.Valid {
+		draft.StartedAt = &dbDraft.StartedAt.Time
+	}
+	if dbDraft.CompletedAt.Valid {
+		draft.CompletedAt = &dbDraft.CompletedAt.Time
+	}
+	draft.SandboxOf = sqlutil.FromNullUUID(dbDraft.SandboxOfDraftID)
+	draft.SeasonID = sqlutil.FromNullUUID(dbDraft.SeasonID)
+
+	return draft
+}
+
+func (r *Repository) ListDraftsForLeague(ctx context.Context, leagueID uuid.UUID) ([]models.Draft, error) {
+	rows, err := r.q(ctx).ListDraftsForLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drafts for league: %w", err)
+	}
+
+	drafts := make([]models.Draft, len(rows))
+	for i, row := range rows {
+		drafts[i] = *r.dbDraftToModel(row)
+	}
+	return drafts, nil
+}
+
+func (r *Repository) ListDraftsForUser(ctx context.Context, userID uuid.UUID) ([]UserDraft, error) {
+	rows, err := r.q(ctx).ListDraftsForUser(ctx, db.ListDraftsForUserParams{
+		UserID: userID,
+		Now:    clock.DatabaseNow(r.clock),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drafts for user: %w", err)
+	}
+
+	drafts := make([]UserDraft, len(rows))
+	for i, row := range rows {
+		drafts[i] = UserDraft{
+			Draft:          r.dbDraftToModel(row.Draft),
+			LeagueName:     row.LeagueName,
+			IsCommissioner: row.IsCommissioner,
+			TeamID:         sqlutil.FromNullUUID(row.TeamID),
+			PicksMade:      int(row.PicksMade),
+			TotalPicks:     int(row.TotalPicks),
+			NextDeadline:   sqlutil.FromSqlTime(row.Draft.NextDeadline),
+			ServerTime:     row.ServerTime,
+		}
+		if row.CurrentPickID.Valid {
+			drafts[i].CurrentPick = &models.DraftPick{
+				ID:          row.CurrentPickID.UUID,
+				DraftID:     row.Draft.ID,
+				Round:       int(row.CurrentPickRound.Int32),
+				Pick:        int(row.CurrentPickPick.Int32),
+				OverallPick: int(row.CurrentPickOverall.Int32),
+				TeamID:      row.CurrentPickTeamID.UUID,
+			}
+		}
+	}
+
+	return drafts, nil
+}
+
+// Helper function to convert DB draft pick to model
+func (r *Repository) dbDraftPickToModel(dbPick db.DraftPick) *models.DraftPick {
+	pick := &models.DraftPick{
+		ID:          dbPick.ID,
+		DraftID:     dbPick.DraftID,
+		Round:       int(dbPick.Round),
+		Pick:        int(dbPick.Pick),
+		OverallPick: int(dbPick.OverallPick),
+		TeamID:      dbPick.TeamID,
+		PlayerID:    sqlutil.FromNullUUID(dbPick.PlayerID),
+		PickedAt:    sqlutil.FromSqlTime(dbPick.PickedAt),
+		KeeperPick:  dbPick.KeeperPick.Bool,
+		Forfeited:   dbPick.Forfeited,
+		SkippedAt:   sqlutil.FromSqlTime(dbPick.SkippedAt),
+	}
+	if dbPick.AuctionAmount.Valid {
+		amount, err := strconv.ParseFloat(dbPick.AuctionAmount.String, 64)
+		if err == nil {
+			pick.AuctionAmount = &amount
+		}
+	}
+	return pick
+}
+
+// Helper function to convert DB abandoned team to model
+func (r *Repository) dbAbandonedTeamToModel(dbTeam db.DraftAbandonedTeam) *models.AbandonedTeam {
+	return &models.AbandonedTeam{
+		DraftID:       dbTeam.DraftID,
+		FantasyTeamID: dbTeam.FantasyTeamID,
+		PickHandling:  models.AbandonedPickHandling(dbTeam.PickHandling),
+		Reason:        sqlutil.FromSqlStringPtr(dbTeam.Reason),
+		AbandonedBy:   sqlutil.FromNullUUID(dbTeam.AbandonedBy),
+		AbandonedAt:   dbTeam.AbandonedAt,
+	}
+}
+
+// Helper function to convert DB team readiness to model
+func (r *Repository) dbTeamReadinessToModel(dbMark db.DraftLobbyReadiness) models.TeamReadiness {
+	return models.TeamReadiness{
+		FantasyTeamID: dbMark.FantasyTeamID,
+		Ready:         dbMark.Ready,
+		UpdatedBy:     sqlutil.FromNullUUID(dbMark.UpdatedBy),
+		UpdatedAt:     &dbMark.UpdatedAt,
+	}
+}
+
+// Helper function to convert DB webhook to model
+func (r *Repository) dbWebhookToModel(dbWebhook db.DraftWebhook) *models.DraftWebhook {
+	return &models.DraftWebhook{
+		ID:              dbWebhook.ID,
+		DraftID:         dbWebhook.DraftID,
+		URL:             dbWebhook.Url,
+		Description:     sqlutil.FromSqlStringPtr(dbWebhook.Description),
+		CreatedBy:       sqlutil.FromNullUUID(dbWebhook.CreatedBy),
+		CreatedAt:       dbWebhook.CreatedAt,
+		LastDeliveredAt: sqlutil.FromSqlTime(dbWebhook.LastDeliveredAt),
+		LastFailedAt:    sqlutil.FromSqlTime(dbWebhook.LastFailedAt),
+		LastError:       sqlutil.FromSqlStringPtr(dbWebhook.LastError),
+	}
+}
+
+// Helper function to convert DB co-manager to model
+func (r *Repository) dbCoManagerToModel(dbCoManager db.DraftCoManager) *models.DraftCoManager {
+	return &models.DraftCoManager{
+		DraftID:       dbCoManager.DraftID,
+		FantasyTeamID: dbCoManager.FantasyTeamID,
+		UserID:        dbCoManager.UserID,
+		AddedBy:       sqlutil.FromNullUUID(dbCoManager.AddedBy),
+		CreatedAt:     dbCoManager.CreatedAt,
+	}
+}
+
+// Helper function to convert DB pause vote and its tally to model
+func (r *Repository) dbPauseVoteToModel(dbVote db.DraftPauseVote, counts db.CountPauseVoteBallotsRow) *models.PauseVote {
+	return &models.PauseVote{
+		ID:               dbVote.ID,
+		DraftID:          dbVote.DraftID,
+		InitiatingTeamID: dbVote.InitiatingTeamID,
+		InitiatedBy:      sqlutil.FromNullUUID(dbVote.InitiatedBy),
+		Reason:           sqlutil.FromSqlStringPtr(dbVote.Reason),
+		Status:           models.PauseVoteStatus(dbVote.Status),
+		VotesFor:         int(counts.VotesFor),
+		VotesAgainst:     int(counts.VotesAgainst),
+		EligibleTeams:    int(dbVote.EligibleTeams),
+		VotesNeeded:      int(dbVote.VotesNeeded),
+		OpenedAt:         dbVote.OpenedAt,
+		ClosesAt:         dbVote.ClosesAt,
+		ClosedAt:         sqlutil.FromSqlTime(dbVote.ClosedAt),
+	}
+}
+
+// Helper function to convert DB maintenance window to model
+func (r *Repository) dbMaintenanceWindowToModel(dbWindow db.MaintenanceWindow) *models.MaintenanceWindow {
+	return &models.MaintenanceWindow{
+		ID:          dbWindow.ID,
+		StartsAt:    dbWindow.StartsAt,
+		EndsAt:      dbWindow.EndsAt,
+		Message:     dbWindow.Message,
+		CreatedAt:   dbWindow.CreatedAt,
+		CancelledAt: sqlutil.FromSqlTime(dbWindow.CancelledAt),
+	}
+}
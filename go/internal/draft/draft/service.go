@@ -3,7 +3,6 @@ package draft
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -12,11 +11,17 @@ import (
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/draft/outbox"
 	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
 	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	templatev1 "github.com/mcdev12/dynasty/go/internal/genproto/template/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/template/v1/templatev1connect"
+	"github.com/mcdev12/dynasty/go/internal/ids"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -24,36 +29,94 @@ import (
 type DraftApp interface {
 	CreateDraft(ctx context.Context, req CreateDraftRequest) (*models.Draft, error)
 	GetDraft(ctx context.Context, id uuid.UUID) (*models.Draft, error)
+	GetDraftWithEventSequence(ctx context.Context, id uuid.UUID) (*models.Draft, int64, error)
+	GetDraftSummary(ctx context.Context, draftID uuid.UUID) (*models.DraftSummary, error)
+	ListDraftTeamSummaries(ctx context.Context, draftID uuid.UUID) ([]models.TeamDraftSummary, error)
 	UpdateDraftStatus(ctx context.Context, id uuid.UUID, status models.DraftStatus) (*models.Draft, error)
+	ForceCompleteDraft(ctx context.Context, id uuid.UUID) (*models.Draft, error)
+	ResumeDraft(ctx context.Context, id uuid.UUID, actorID *uuid.UUID) (*models.Draft, error)
+	PauseDraftForCommissioner(ctx context.Context, id uuid.UUID) (*models.Draft, error)
+	ClearCommissionerPause(ctx context.Context, id uuid.UUID) (bool, error)
+	StartDraft(ctx context.Context, id uuid.UUID, overrideReadiness bool) (*models.Draft, error)
 	UpdateDraft(ctx context.Context, id uuid.UUID, req UpdateDraftRequest) (*models.Draft, error)
+	UpdateScheduledAt(ctx context.Context, id uuid.UUID, scheduledAt time.Time) (*Reschedule, error)
 	DeleteDraft(ctx context.Context, id uuid.UUID) error
-	FetchNextDeadline(ctx context.Context) (*NextDeadline, error)
-	FetchDraftsDueForPick(ctx context.Context, limit int32) ([]uuid.UUID, error)
-	UpdateNextDeadline(ctx context.Context, draftID uuid.UUID, deadline *time.Time) error
+	CloneDraftAsSandbox(ctx context.Context, req CloneDraftAsSandboxRequest) (*models.Draft, error)
+	FetchNextDeadline(ctx context.Context, draftID *uuid.UUID) (*NextDeadline, error)
+	FetchDraftsDueForPick(ctx context.Context, req FetchDraftsDueForPickRequest) ([]uuid.UUID, error)
+	UpdateNextDeadline(ctx context.Context, draftID uuid.UUID, deadline *time.Time) (*NextDeadline, error)
+	StartPickClock(ctx context.Context, draftID uuid.UUID, timeout time.Duration) (*NextDeadline, error)
+	GetCurrentPick(ctx context.Context, draftID uuid.UUID) (*models.DraftPick, error)
+	GetCatchUp(ctx context.Context, draftID uuid.UUID) (*CatchUp, error)
+	ListDraftsForLeague(ctx context.Context, leagueID uuid.UUID) ([]models.Draft, error)
+	ListDraftsForUser(ctx context.Context, userID uuid.UUID) ([]UserDraft, error)
+	ReportChatMessage(ctx context.Context, report ChatReport) (uuid.UUID, bool, error)
+	RecordFrameDelivery(ctx context.Context, delivery FrameDelivery) (bool, error)
 	ClearNextDeadline(ctx context.Context, id uuid.UUID) error
+	AbandonTeam(ctx context.Context, req AbandonTeamRequest) (*AbandonTeamResult, error)
+	RestoreTeam(ctx context.Context, draftID models.DraftID, fantasyTeamID models.TeamID) (*RestoreTeamResult, error)
+	ListAbandonedTeams(ctx context.Context, draftID uuid.UUID) ([]models.AbandonedTeam, error)
+	WarnPickClock(ctx context.Context, draftID uuid.UUID, deadline time.Time, percentRemaining int) (*PickClockWarning, error)
+	RaiseWatchlistAlerts(ctx context.Context, draftID uuid.UUID) ([]models.WatchlistAlert, error)
+	AnnounceDraftsStartingSoon(ctx context.Context, now time.Time) ([]StartCountdown, error)
+	ScheduleMaintenance(ctx context.Context, req ScheduleMaintenanceRequest, now time.Time) (*models.MaintenanceWindow, error)
+	ListMaintenanceWindows(ctx context.Context, now time.Time) ([]models.MaintenanceWindow, error)
+	CancelMaintenance(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error)
+	AnnounceMaintenance(ctx context.Context, now time.Time) ([]MaintenanceNotice, error)
+	PauseDraftsForMaintenance(ctx context.Context, now time.Time) ([]MaintenancePause, error)
+	ListMaintenancePausesToLift(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	SetTeamReady(ctx context.Context, req SetTeamReadyRequest) (*models.DraftLobby, error)
+	GetDraftLobby(ctx context.Context, draftID uuid.UUID) (*models.DraftLobby, error)
+	CreateWebhook(ctx context.Context, req CreateDraftWebhookRequest) (*IssuedDraftWebhook, error)
+	ListWebhooks(ctx context.Context, draftID uuid.UUID) ([]models.DraftWebhook, error)
+	DeleteWebhook(ctx context.Context, draftID models.DraftID, webhookID uuid.UUID) error
+	AddCoManager(ctx context.Context, req AddDraftCoManagerRequest) (*models.DraftCoManager, error)
+	RemoveCoManager(ctx context.Context, req RemoveDraftCoManagerRequest) error
+	ListCoManagers(ctx context.Context, draftID uuid.UUID) ([]models.DraftCoManager, error)
+	StartPauseVote(ctx context.Context, req StartPauseVoteRequest) (*models.PauseVote, error)
+	CastPauseVote(ctx context.Context, req CastPauseVoteRequest) (*models.PauseVote, error)
+	ClosePauseVote(ctx context.Context, draftID models.DraftID, voteID uuid.UUID, passed bool) (*ClosePauseVoteResult, error)
+	ExtendPickClock(ctx context.Context, req ExtendPickClockRequest) (*PickClockExtension, error)
+	Now() time.Time
 }
 
 // OutboxApp defines what the service layer needs from the outbox
 type OutboxApp interface {
-	InsertOutboxDraftStarted(ctx context.Context, draftID uuid.UUID, payload []byte) error
-	InsertOutboxDraftCompleted(ctx context.Context, draftID uuid.UUID, payload []byte) error
-	InsertOutboxDraftPaused(ctx context.Context, draftID uuid.UUID, payload []byte) error
-	InsertOutboxDraftResumed(ctx context.Context, draftID uuid.UUID, payload []byte) error
+	InsertDraftStartingSoonEvent(ctx context.Context, draftID uuid.UUID, payload events.DraftStartingSoonPayload) error
+	InsertDraftRescheduledEvent(ctx context.Context, draftID uuid.UUID, payload events.DraftRescheduledPayload) error
+	InsertMaintenanceScheduledEvent(ctx context.Context, draftID uuid.UUID, payload events.MaintenanceScheduledPayload) error
+	InsertDraftStartedEvent(ctx context.Context, draftID uuid.UUID, payload events.DraftStartedPayload) error
+	InsertDraftCompletedEvent(ctx context.Context, draftID uuid.UUID, payload events.DraftCompletedPayload) error
+	InsertDraftPausedEvent(ctx context.Context, draftID uuid.UUID, payload events.DraftPausedPayload) error
+	InsertDraftResumedEvent(ctx context.Context, draftID uuid.UUID, payload events.DraftResumedPayload) error
+	InsertDraftCatchUpEvent(ctx context.Context, draftID uuid.UUID, payload events.DraftCatchUpPayload) error
+	InsertPickStartedEvent(ctx context.Context, draftID uuid.UUID, payload events.PickStartedPayload) error
+	InsertPickClockWarningEvent(ctx context.Context, draftID uuid.UUID, payload events.PickClockWarningPayload) error
+	InsertPickClockExtendedEvent(ctx context.Context, draftID uuid.UUID, payload events.PickClockExtendedPayload) error
+	InsertWatchlistAlertEvent(ctx context.Context, draftID uuid.UUID, payload events.WatchlistAlertPayload) error
+	InsertTeamAbandonedEvent(ctx context.Context, draftID uuid.UUID, payload events.TeamAbandonedPayload) error
+	InsertTeamRestoredEvent(ctx context.Context, draftID uuid.UUID, payload events.TeamRestoredPayload) error
+	InsertLobbyUpdatedEvent(ctx context.Context, draftID uuid.UUID, payload events.LobbyUpdatedPayload) error
+	InsertPauseVoteUpdatedEvent(ctx context.Context, draftID uuid.UUID, payload events.PauseVoteUpdatedPayload) error
+	InsertCommissionerPresenceChangedEvent(ctx context.Context, draftID uuid.UUID, payload events.CommissionerPresenceChangedPayload) error
+	InsertEvents(ctx context.Context, draftID uuid.UUID, batch []outbox.Event) error
 }
 
 // Service implements the DraftService gRPC interface
 type Service struct {
-	draftApp      DraftApp
-	outboxApp     OutboxApp
-	leagueService leaguev1connect.LeagueServiceClient
+	draftApp        DraftApp
+	outboxApp       OutboxApp
+	leagueService   leaguev1connect.LeagueServiceClient
+	templateService templatev1connect.SettingsTemplateServiceClient
 }
 
 // NewService creates a new draft gRPC service
-func NewService(draftApp DraftApp, outboxApp OutboxApp, leagueService leaguev1connect.LeagueServiceClient) *Service {
+func NewService(draftApp DraftApp, outboxApp OutboxApp, leagueService leaguev1connect.LeagueServiceClient, templateService templatev1connect.SettingsTemplateServiceClient) *Service {
 	return &Service{
-		draftApp:      draftApp,
-		outboxApp:     outboxApp,
-		leagueService: leagueService,
+		draftApp:        draftApp,
+		outboxApp:       outboxApp,
+		leagueService:   leagueService,
+		templateService: templateService,
 	}
 }
 
@@ -64,10 +127,6 @@ var _ draftv1connect.DraftServiceHandler = (*Service)(nil)
 func (s *Service) CreateDraft(ctx context.Context, req *connect.Request[draftv1.CreateDraftRequest]) (*connect.Response[draftv1.CreateDraftResponse], error) {
 
 	// TODO NEED TXN HANDLING HERE
-	appReq, err := s.protoToCreateDraftRequest(req.Msg)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
-	}
 
 	// Validate that the league exists via league service
 	leagueReq := &leaguev1.GetLeagueRequest{
@@ -78,6 +137,19 @@ func (s *Service) CreateDraft(ctx context.Context, req *connect.Request[draftv1.
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("league not found: %w", err))
 	}
 
+	if req.Msg.TemplateId != nil {
+		settings, err := s.templateDraftSettings(ctx, *req.Msg.TemplateId, req.Msg, leagueResp.Msg.League.SportId)
+		if err != nil {
+			return nil, err
+		}
+		req.Msg.Settings = settings
+	}
+
+	appReq, err := s.protoToCreateDraftRequest(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
 	draft, err := s.draftApp.CreateDraft(ctx, appReq)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
@@ -100,12 +172,12 @@ func (s *Service) CreateDraft(ctx context.Context, req *connect.Request[draftv1.
 
 // GetDraft retrieves a draft by ID
 func (s *Service) GetDraft(ctx context.Context, req *connect.Request[draftv1.GetDraftRequest]) (*connect.Response[draftv1.GetDraftResponse], error) {
-	id, err := uuid.Parse(req.Msg.DraftId)
+	id, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
-	draft, err := s.draftApp.GetDraft(ctx, id)
+	draft, seq, err := s.draftApp.GetDraftWithEventSequence(ctx, id)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
@@ -116,14 +188,56 @@ func (s *Service) GetDraft(ctx context.Context, req *connect.Request[draftv1.Get
 	}
 
 	return connect.NewResponse(&draftv1.GetDraftResponse{
-		Draft: protoDraft,
+		Draft:         protoDraft,
+		EventSequence: seq,
+	}), nil
+}
+
+// GetDraftSummary returns a draft's progress from its summary row
+func (s *Service) GetDraftSummary(ctx context.Context, req *connect.Request[draftv1.GetDraftSummaryRequest]) (*connect.Response[draftv1.GetDraftSummaryResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.draftApp.GetDraftSummary(ctx, draftID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoSummary := &draftv1.DraftSummary{
+		DraftId:        summary.DraftID.String(),
+		TotalPicks:     int32(summary.TotalPicks),
+		PicksMade:      int32(summary.PicksMade),
+		RemainingPicks: int32(summary.RemainingPicks),
+		UpdatedAt:      timestamppb.New(summary.UpdatedAt),
+	}
+	if summary.CurrentPick != nil {
+		protoSummary.CurrentPick = &draftv1.DraftPick{
+			Id:          summary.CurrentPick.ID.String(),
+			DraftId:     summary.CurrentPick.DraftID.String(),
+			Round:       int32(summary.CurrentPick.Round),
+			Pick:        int32(summary.CurrentPick.Pick),
+			OverallPick: int32(summary.CurrentPick.OverallPick),
+			TeamId:      summary.CurrentPick.TeamID.String(),
+		}
+	}
+	if summary.LastPickAt != nil {
+		protoSummary.LastPickAt = timestamppb.New(*summary.LastPickAt)
+	}
+
+	return connect.NewResponse(&draftv1.GetDraftSummaryResponse{
+		Summary: protoSummary,
 	}), nil
 }
 
 func (s *Service) UpdateDraft(ctx context.Context, req *connect.Request[draftv1.UpdateDraftRequest]) (*connect.Response[draftv1.UpdateDraftResponse], error) {
-	id, err := uuid.Parse(req.Msg.DraftId)
+	id, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Build update request
@@ -131,22 +245,49 @@ func (s *Service) UpdateDraft(ctx context.Context, req *connect.Request[draftv1.
 
 	// Handle optional settings update
 	if req.Msg.Settings != nil {
-		settings := s.protoToDraftSettings(req.Msg.Settings)
+		settings, err := s.protoToDraftSettings(req.Msg.Settings)
+		if err != nil {
+			return nil, err
+		}
 		updateReq.Settings = &settings
 	}
 
 	// Handle optional scheduled_at update
+	var previousScheduledAt *time.Time
 	if req.Msg.ScheduledAt != nil {
 		scheduledAt := req.Msg.ScheduledAt.AsTime()
 		updateReq.ScheduledAt = &scheduledAt
+
+		// Read first for the DraftRescheduled event
+		current, err := s.draftApp.GetDraft(ctx, id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		previousScheduledAt = current.ScheduledAt
+	}
+
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok {
+		updateReq.ActorID = &actingUser
 	}
 
 	// Perform the update
 	draft, err := s.draftApp.UpdateDraft(ctx, id, updateReq)
 	if err != nil {
+		switch {
+		case errors.Is(err, ErrSlotSelectionInProgress):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, ErrScheduledInPast):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	if updateReq.ScheduledAt != nil && (previousScheduledAt == nil || !previousScheduledAt.Equal(*updateReq.ScheduledAt)) {
+		if emitErr := s.emitDraftRescheduledEvent(ctx, &Reschedule{Draft: draft, PreviousScheduledAt: previousScheduledAt}, updateReq.ActorID); emitErr != nil {
+			log.Printf("Failed to emit DraftRescheduled event: %v", emitErr)
+		}
+	}
+
 	// Convert response to proto
 	protoDraft, err := s.draftToProto(draft)
 	if err != nil {
@@ -158,10 +299,62 @@ func (s *Service) UpdateDraft(ctx context.Context, req *connect.Request[draftv1.
 	}), nil
 }
 
+// UpdateScheduledAt moves a draft that hasn't started to a new scheduled start, sending
+// league members an updated calendar invite. Commissioner only.
+func (s *Service) UpdateScheduledAt(ctx context.Context, req *connect.Request[draftv1.UpdateScheduledAtRequest]) (*connect.Response[draftv1.UpdateScheduledAtResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	actorID, err := s.ensureCommissioner(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reschedule, err := s.draftApp.UpdateScheduledAt(ctx, id, req.Msg.ScheduledAt.AsTime())
+	if err != nil {
+		return nil, connect.NewError(rescheduleErrorCode(err), err)
+	}
+
+	if reschedule.PreviousScheduledAt == nil || !reschedule.PreviousScheduledAt.Equal(*reschedule.Draft.ScheduledAt) {
+		if emitErr := s.emitDraftRescheduledEvent(ctx, reschedule, actorID); emitErr != nil {
+			log.Printf("Failed to emit DraftRescheduled event: %v", emitErr)
+		}
+	}
+
+	protoDraft, err := s.draftToProto(reschedule.Draft)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &draftv1.UpdateScheduledAtResponse{
+		Draft: protoDraft,
+	}
+	if reschedule.PreviousScheduledAt != nil {
+		resp.PreviousScheduledAt = timestamppb.New(*reschedule.PreviousScheduledAt)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *Service) PauseDraft(ctx context.Context, req *connect.Request[draftv1.PauseDraftRequest]) (*connect.Response[draftv1.PauseDraftResponse], error) {
-	id, err := uuid.Parse(req.Msg.DraftId)
+	id, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
+	}
+
+	// Pause windows and commissioner disconnects are seen by the orchestrator, which tracks the
+	// draft room's connections; every other pause is the commissioner's
+	if req.Msg.Scheduled || req.Msg.CommissionerDisconnected {
+		if err := ensureOrchestrator(ctx); err != nil {
+			return nil, err
+		}
+	} else if _, err := s.ensureCommissioner(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.CommissionerDisconnected {
+		return s.pauseForCommissioner(ctx, id)
 	}
 
 	// Update draft status to paused
@@ -170,8 +363,20 @@ func (s *Service) PauseDraft(ctx context.Context, req *connect.Request[draftv1.P
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	// A scheduled pause comes from the draft's pause window and lifts when the window closes
+	pausedAt := s.draftApp.Now()
+	reason := "Manual pause"
+	var resumesAt *time.Time
+	if req.Msg.Scheduled {
+		reason = "Pause window"
+		if window := draft.Settings.PauseWindow; window != nil {
+			end := window.NextEnd(pausedAt)
+			resumesAt = &end
+		}
+	}
+
 	// Emit DraftPaused domain event
-	if err := s.emitDraftPausedEvent(ctx, id, time.Now(), "Manual pause"); err != nil {
+	if err := s.emitDraftPausedEvent(ctx, id, pausedAt, reason, req.Msg.Scheduled, resumesAt); err != nil {
 		log.Printf("Failed to emit DraftPaused event: %v", err)
 		// Don't fail the operation, just log
 	}
@@ -180,20 +385,57 @@ func (s *Service) PauseDraft(ctx context.Context, req *connect.Request[draftv1.P
 	return connect.NewResponse(&draftv1.PauseDraftResponse{}), nil
 }
 
+// pauseForCommissioner pauses an in-progress draft whose commissioner has been away from the
+// draft room for the draft's wait. It resumes when they reconnect.
+func (s *Service) pauseForCommissioner(ctx context.Context, id uuid.UUID) (*connect.Response[draftv1.PauseDraftResponse], error) {
+	draft, err := s.draftApp.PauseDraftForCommissioner(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrDraftNotInProgress) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Emit DraftPaused domain event
+	payload := events.DraftPausedPayload{
+		DraftID:                  id.String(),
+		PausedAt:                 s.draftApp.Now(),
+		Reason:                   "Commissioner disconnected",
+		CommissionerDisconnected: true,
+	}
+	if err := s.outboxApp.InsertDraftPausedEvent(ctx, id, payload); err != nil {
+		log.Printf("Failed to emit DraftPaused event: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	log.Printf("Draft %s paused while its commissioner is disconnected", draft.ID)
+	return connect.NewResponse(&draftv1.PauseDraftResponse{}), nil
+}
+
 func (s *Service) StartDraft(ctx context.Context, req *connect.Request[draftv1.StartDraftRequest]) (*connect.Response[draftv1.StartDraftResponse], error) {
-	id, err := uuid.Parse(req.Msg.DraftId)
+	id, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
+	}
+
+	// Only the commissioner can start a draft before every team is ready
+	if req.Msg.OverrideReadiness {
+		if _, err := s.ensureCommissioner(ctx, id); err != nil {
+			return nil, err
+		}
 	}
 
 	// Update draft status to in progress
-	draft, err := s.draftApp.UpdateDraftStatus(ctx, id, models.DraftStatusInProgress)
+	draft, err := s.draftApp.StartDraft(ctx, id, req.Msg.OverrideReadiness)
 	if err != nil {
+		if errors.Is(err, ErrSlotSelectionInProgress) || errors.Is(err, ErrTeamsNotReady) || errors.Is(err, ErrMaintenance) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	// Emit DraftStarted domain event
-	if err := s.emitDraftStartedEvent(ctx, id, time.Now()); err != nil {
+	if err := s.emitDraftStartedEvent(ctx, id, s.draftApp.Now()); err != nil {
 		log.Printf("Failed to emit DraftStarted event: %v", err)
 		// Don't fail the operation, just log
 	}
@@ -203,147 +445,1483 @@ func (s *Service) StartDraft(ctx context.Context, req *connect.Request[draftv1.S
 }
 
 func (s *Service) ResumeDraft(ctx context.Context, req *connect.Request[draftv1.ResumeDraftRequest]) (*connect.Response[draftv1.ResumeDraftResponse], error) {
-	id, err := uuid.Parse(req.Msg.DraftId)
+	id, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
-	// Update draft status to in progress
-	draft, err := s.draftApp.UpdateDraftStatus(ctx, id, models.DraftStatusInProgress)
+	// Pause windows and commissioner disconnects are lifted by the orchestrator; every other
+	// pause by the commissioner
+	var actorID *uuid.UUID
+	if req.Msg.Scheduled || req.Msg.CommissionerReconnected {
+		if err := ensureOrchestrator(ctx); err != nil {
+			return nil, err
+		}
+	} else if actorID, err = s.ensureCommissioner(ctx, id); err != nil {
+		return nil, err
+	}
+
+	// A commissioner reconnecting only lifts the pause their disconnect caused
+	if req.Msg.CommissionerReconnected {
+		paused, err := s.draftApp.ClearCommissionerPause(ctx, id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if !paused {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrNotPausedForCommissioner)
+		}
+	}
+
+	// Update draft status to in progress, carrying the pick clock on from where it stood
+	draft, err := s.draftApp.ResumeDraft(ctx, id, actorID)
 	if err != nil {
+		if errors.Is(err, ErrMaintenance) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	resumedAt := s.draftApp.Now()
+
+	// After a pause window the pick clock restarts here, before DraftResumed is emitted,
+	// so the orchestrator arms its timer from this deadline rather than setting its own.
+	// After a manual pause the pick on the clock keeps the time it had left.
+	var catchUp *events.DraftCatchUpPayload
+	var onTheClock *events.PickStartedPayload
+	if req.Msg.Scheduled {
+		catchUp, err = s.buildCatchUp(ctx, draft, resumedAt)
+		if err != nil {
+			log.Printf("Failed to build catch-up summary for draft %s: %v", id, err)
+		}
+		if catchUp != nil {
+			onTheClock = catchUp.OnTheClock
+		}
+	} else {
+		onTheClock, err = s.resumedPickClock(ctx, draft, resumedAt)
+		if err != nil {
+			log.Printf("Failed to describe resumed pick clock for draft %s: %v", id, err)
+		}
+	}
+
 	// Emit DraftResumed domain event
-	if err := s.emitDraftResumedEvent(ctx, id, time.Now()); err != nil {
+	if err := s.emitDraftResumedEvent(ctx, id, resumedAt, req.Msg.Scheduled); err != nil {
 		log.Printf("Failed to emit DraftResumed event: %v", err)
 		// Don't fail the operation, just log
 	}
 
-	log.Printf("Draft %s resumed", draft.ID)
-	return connect.NewResponse(&draftv1.ResumeDraftResponse{}), nil
+	if onTheClock != nil {
+		if err := s.emitPickStartedEvent(ctx, id, *onTheClock); err != nil {
+			log.Printf("Failed to emit PickStarted event: %v", err)
+		}
+	}
+	if catchUp != nil {
+		if err := s.emitDraftCatchUpEvent(ctx, id, *catchUp); err != nil {
+			log.Printf("Failed to emit DraftCatchUp event: %v", err)
+		}
+	}
+
+	log.Printf("Draft %s resumed", draft.ID)
+	return connect.NewResponse(&draftv1.ResumeDraftResponse{}), nil
+}
+
+// DeleteDraft deletes a draft by ID
+func (s *Service) DeleteDraft(ctx context.Context, req *connect.Request[draftv1.DeleteDraftRequest]) (*connect.Response[draftv1.DeleteDraftResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.draftApp.DeleteDraft(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(sandboxErrorCode(err), err)
+	}
+
+	return connect.NewResponse(&draftv1.DeleteDraftResponse{}), nil
+}
+
+// CloneDraftAsSandbox copies a draft into a sandbox draft for its commissioner to rehearse
+func (s *Service) CloneDraftAsSandbox(ctx context.Context, req *connect.Request[draftv1.CloneDraftAsSandboxRequest]) (*connect.Response[draftv1.CloneDraftAsSandboxResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	createdBy, err := s.ensureCommissioner(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	botTeamIDs, err := uuidutil.ParseAll("bot_fantasy_team_ids", req.Msg.BotFantasyTeamIds)
+	if err != nil {
+		return nil, err
+	}
+
+	sandbox, err := s.draftApp.CloneDraftAsSandbox(ctx, CloneDraftAsSandboxRequest{
+		DraftID:    draftID,
+		BotTeamIDs: botTeamIDs,
+		CreatedBy:  createdBy,
+	})
+	if err != nil {
+		return nil, connect.NewError(sandboxErrorCode(err), err)
+	}
+
+	protoDraft, err := s.draftToProto(sandbox)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.CloneDraftAsSandboxResponse{
+		Draft: protoDraft,
+	}), nil
+}
+
+// ListDraftsForLeague lists a league's drafts, newest first
+func (s *Service) ListDraftsForLeague(ctx context.Context, req *connect.Request[draftv1.ListDraftsForLeagueRequest]) (*connect.Response[draftv1.ListDraftsForLeagueResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	drafts, err := s.draftApp.ListDraftsForLeague(ctx, leagueID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &draftv1.ListDraftsForLeagueResponse{
+		Drafts: make([]*draftv1.Draft, len(drafts)),
+	}
+	for i := range drafts {
+		resp.Drafts[i], err = s.draftToProto(&drafts[i])
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ListDraftsForUser lists the unfinished drafts in the leagues a user has a team in or commissions
+func (s *Service) ListDraftsForUser(ctx context.Context, req *connect.Request[draftv1.ListDraftsForUserRequest]) (*connect.Response[draftv1.ListDraftsForUserResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok && actingUser != userID {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("cannot list another user's drafts"))
+	}
+
+	drafts, err := s.draftApp.ListDraftsForUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &draftv1.ListDraftsForUserResponse{
+		Drafts: make([]*draftv1.UserDraft, 0, len(drafts)),
+	}
+	for _, d := range drafts {
+		protoDraft, err := s.draftToProto(d.Draft)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		userDraft := &draftv1.UserDraft{
+			Draft:          protoDraft,
+			LeagueName:     d.LeagueName,
+			IsCommissioner: d.IsCommissioner,
+			PicksMade:      int32(d.PicksMade),
+			TotalPicks:     int32(d.TotalPicks),
+		}
+		if d.TeamID != nil {
+			teamID := d.TeamID.String()
+			userDraft.TeamId = &teamID
+		}
+		if d.CurrentPick != nil {
+			userDraft.CurrentPick = &draftv1.DraftPick{
+				Id:          d.CurrentPick.ID.String(),
+				DraftId:     d.CurrentPick.DraftID.String(),
+				Round:       int32(d.CurrentPick.Round),
+				Pick:        int32(d.CurrentPick.Pick),
+				OverallPick: int32(d.CurrentPick.OverallPick),
+				TeamId:      d.CurrentPick.TeamID.String(),
+			}
+		}
+		if d.NextDeadline != nil {
+			userDraft.NextDeadline = timestamppb.New(*d.NextDeadline)
+		}
+		resp.Drafts = append(resp.Drafts, userDraft)
+		resp.ServerTime = timestamppb.New(d.ServerTime)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ReportChatMessage flags a draft chat message for commissioner or admin review
+func (s *Service) ReportChatMessage(ctx context.Context, req *connect.Request[draftv1.ReportChatMessageRequest]) (*connect.Response[draftv1.ReportChatMessageResponse], error) {
+	reporterID, err := uuidutil.MustParseOrInvalidArg("reporter_user_id", req.Msg.ReporterUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok && actingUser != reporterID {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("cannot report a message on another user's behalf"))
+	}
+
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	messageID, err := uuidutil.MustParseOrInvalidArg("message_id", req.Msg.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	senderUserID, err := uuidutil.MustParseOrInvalidArg("sender_user_id", req.Msg.SenderUserId)
+	if err != nil {
+		return nil, err
+	}
+	reportID, duplicate, err := s.draftApp.ReportChatMessage(ctx, ChatReport{
+		DraftID:        draftID,
+		MessageID:      messageID,
+		ReporterUserID: reporterID,
+		SenderUserID:   senderUserID,
+		MessageText:    req.Msg.MessageText,
+		Reason:         req.Msg.Reason,
+		SentAt:         req.Msg.SentAt.AsTime(),
+	})
+	if err != nil {
+		if errors.Is(err, ErrCannotReportOwnMessage) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.ReportChatMessageResponse{
+		ReportId:  reportID.String(),
+		Duplicate: duplicate,
+	}), nil
+}
+
+// RecordFrameDelivery records whether a user acknowledged a frame the gateway required acks for
+func (s *Service) RecordFrameDelivery(ctx context.Context, req *connect.Request[draftv1.RecordFrameDeliveryRequest]) (*connect.Response[draftv1.RecordFrameDeliveryResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok && actingUser != userID {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("cannot record another user's frame delivery"))
+	}
+
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	eventID, err := uuidutil.MustParseOrInvalidArg("event_id", req.Msg.EventId)
+	if err != nil {
+		return nil, err
+	}
+	delivery := FrameDelivery{
+		DraftID:   draftID,
+		EventID:   eventID,
+		EventType: req.Msg.EventType,
+		UserID:    userID,
+		Status:    models.FrameDeliveryStatusAcked,
+		Attempts:  int(req.Msg.Attempts),
+		SentAt:    req.Msg.SentAt.AsTime(),
+	}
+	if req.Msg.Status == draftv1.FrameDeliveryStatus_FRAME_DELIVERY_STATUS_UNACKED {
+		delivery.Status = models.FrameDeliveryStatusUnacked
+	}
+	delivery.PickID, err = uuidutil.ParseOptional("pick_id", req.Msg.PickId)
+	if err != nil {
+		return nil, err
+	}
+	delivery.WatchlistAlertID, err = uuidutil.ParseOptional("watchlist_alert_id", req.Msg.WatchlistAlertId)
+	if err != nil {
+		return nil, err
+	}
+
+	pushQueued, err := s.draftApp.RecordFrameDelivery(ctx, delivery)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.RecordFrameDeliveryResponse{
+		PushQueued: pushQueued,
+	}), nil
+}
+
+// ReportRoomPresence passes on the commissioner joining or leaving the room of a draft that
+// pauses while they're away, for the orchestrator to pause or resume it. Other users' presence
+// isn't recorded. Only the gateway, which sees the room, or the user themselves may report it.
+func (s *Service) ReportRoomPresence(ctx context.Context, req *connect.Request[draftv1.ReportRoomPresenceRequest]) (*connect.Response[draftv1.ReportRoomPresenceResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	actingUser, err := interceptors.ActingUserOrService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if actingUser != nil && *actingUser != userID {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("cannot report another user's presence"))
+	}
+
+	draft, err := s.draftApp.GetDraft(ctx, draftID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	settings := draft.Settings.CommissionerDisconnectPause
+	if settings == nil || (draft.Status != models.DraftStatusInProgress && draft.Status != models.DraftStatusPaused) {
+		return connect.NewResponse(&draftv1.ReportRoomPresenceResponse{}), nil
+	}
+
+	leagueResp, err := s.leagueService.GetLeague(ctx, connect.NewRequest(&leaguev1.GetLeagueRequest{
+		Id: draft.LeagueID.String(),
+	}))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get league: %w", err))
+	}
+	if leagueResp.Msg.League.CommissionerId != userID.String() {
+		return connect.NewResponse(&draftv1.ReportRoomPresenceResponse{}), nil
+	}
+
+	// An in-progress draft pauses once the commissioner has been away for its wait
+	changedAt := s.draftApp.Now()
+	payload := events.CommissionerPresenceChangedPayload{
+		DraftID:   draftID.String(),
+		UserID:    userID.String(),
+		Online:    req.Msg.Online,
+		ChangedAt: changedAt,
+	}
+	if !req.Msg.Online && draft.Status == models.DraftStatusInProgress {
+		pausesAt := changedAt.Add(settings.Wait())
+		payload.PausesAt = &pausesAt
+	}
+	if err := s.outboxApp.InsertCommissionerPresenceChangedEvent(ctx, draftID, payload); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to emit CommissionerPresenceChanged event: %w", err))
+	}
+
+	return connect.NewResponse(&draftv1.ReportRoomPresenceResponse{
+		Commissioner: true,
+	}), nil
+}
+
+// AbandonTeam takes a team whose owner stopped taking part out of a draft
+func (s *Service) AbandonTeam(ctx context.Context, req *connect.Request[draftv1.AbandonTeamRequest]) (*connect.Response[draftv1.AbandonTeamResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	abandonedBy, err := s.ensureCommissioner(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	abandonReq := AbandonTeamRequest{
+		DraftID:       draftID,
+		FantasyTeamID: fantasyTeamID,
+		PickHandling:  s.protoToAbandonedPickHandling(req.Msg.PickHandling),
+		AbandonedBy:   abandonedBy,
+	}
+	if req.Msg.Reason != "" {
+		abandonReq.Reason = &req.Msg.Reason
+	}
+
+	result, err := s.draftApp.AbandonTeam(ctx, abandonReq)
+	if err != nil {
+		return nil, connect.NewError(abandonTeamErrorCode(err), err)
+	}
+
+	// Emit TeamAbandoned domain event; the orchestrator moves the pick clock on from it when
+	// the team was on the clock
+	if err := s.emitTeamAbandonedEvent(ctx, result); err != nil {
+		log.Printf("Failed to emit TeamAbandoned event: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	return connect.NewResponse(&draftv1.AbandonTeamResponse{
+		AbandonedTeam:    s.abandonedTeamToProto(result.Team),
+		ForfeitedPickIds: uuidsToStrings(result.ForfeitedPickIDs),
+	}), nil
+}
+
+// RestoreTeam hands an abandoned team back to its owner
+func (s *Service) RestoreTeam(ctx context.Context, req *connect.Request[draftv1.RestoreTeamRequest]) (*connect.Response[draftv1.RestoreTeamResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	teamID, err := uuidutil.ParseID[models.TeamID]("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ensureCommissioner(ctx, draftID.UUID()); err != nil {
+		return nil, err
+	}
+
+	result, err := s.draftApp.RestoreTeam(ctx, draftID, teamID)
+	if err != nil {
+		return nil, connect.NewError(abandonTeamErrorCode(err), err)
+	}
+
+	// Emit TeamRestored domain event; the orchestrator restarts the pick clock from it when
+	// the team is back on the clock
+	if err := s.emitTeamRestoredEvent(ctx, draftID.UUID(), teamID.UUID(), result, s.draftApp.Now()); err != nil {
+		log.Printf("Failed to emit TeamRestored event: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	return connect.NewResponse(&draftv1.RestoreTeamResponse{
+		RestoredPickIds: uuidsToStrings(result.RestoredPickIDs),
+	}), nil
+}
+
+// ListAbandonedTeams lists the teams abandoned in a draft
+func (s *Service) ListAbandonedTeams(ctx context.Context, req *connect.Request[draftv1.ListAbandonedTeamsRequest]) (*connect.Response[draftv1.ListAbandonedTeamsResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.draftApp.ListAbandonedTeams(ctx, draftID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoTeams := make([]*draftv1.AbandonedTeam, len(teams))
+	for i := range teams {
+		protoTeams[i] = s.abandonedTeamToProto(&teams[i])
+	}
+
+	return connect.NewResponse(&draftv1.ListAbandonedTeamsResponse{
+		AbandonedTeams: protoTeams,
+	}), nil
+}
+
+// SetTeamReady marks a team ready, or no longer ready, in the lobby before a draft starts
+func (s *Service) SetTeamReady(ctx context.Context, req *connect.Request[draftv1.SetTeamReadyRequest]) (*connect.Response[draftv1.SetTeamReadyResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	var setBy *uuid.UUID
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok {
+		setBy = &actingUser
+	}
+
+	lobby, err := s.draftApp.SetTeamReady(ctx, SetTeamReadyRequest{
+		DraftID:       draftID,
+		FantasyTeamID: teamID,
+		Ready:         req.Msg.Ready,
+		SetBy:         setBy,
+	})
+	if err != nil {
+		return nil, connect.NewError(lobbyErrorCode(err), err)
+	}
+
+	// Emit LobbyUpdated domain event so the lobby shows the team's readiness live
+	if err := s.emitLobbyUpdatedEvent(ctx, lobby, teamID, req.Msg.Ready, s.draftApp.Now()); err != nil {
+		log.Printf("Failed to emit LobbyUpdated event: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	return connect.NewResponse(&draftv1.SetTeamReadyResponse{
+		Lobby: s.draftLobbyToProto(lobby),
+	}), nil
+}
+
+// GetDraftLobby returns which of a draft's teams are ready to start
+func (s *Service) GetDraftLobby(ctx context.Context, req *connect.Request[draftv1.GetDraftLobbyRequest]) (*connect.Response[draftv1.GetDraftLobbyResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	lobby, err := s.draftApp.GetDraftLobby(ctx, draftID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.GetDraftLobbyResponse{
+		Lobby: s.draftLobbyToProto(lobby),
+	}), nil
+}
+
+// CreateDraftWebhook registers an endpoint a draft's pick events are posted to
+func (s *Service) CreateDraftWebhook(ctx context.Context, req *connect.Request[draftv1.CreateDraftWebhookRequest]) (*connect.Response[draftv1.CreateDraftWebhookResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	createdBy, err := s.ensureWebhookManager(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	createReq := CreateDraftWebhookRequest{
+		DraftID:   draftID,
+		URL:       req.Msg.Url,
+		CreatedBy: createdBy,
+	}
+	if req.Msg.Description != "" {
+		createReq.Description = &req.Msg.Description
+	}
+
+	issued, err := s.draftApp.CreateWebhook(ctx, createReq)
+	if err != nil {
+		return nil, connect.NewError(webhookErrorCode(err), err)
+	}
+
+	return connect.NewResponse(&draftv1.CreateDraftWebhookResponse{
+		Webhook: s.webhookToProto(issued.Webhook),
+		Secret:  issued.Secret,
+	}), nil
+}
+
+// ListDraftWebhooks lists the webhooks of a draft
+func (s *Service) ListDraftWebhooks(ctx context.Context, req *connect.Request[draftv1.ListDraftWebhooksRequest]) (*connect.Response[draftv1.ListDraftWebhooksResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ensureWebhookManager(ctx, draftID); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.draftApp.ListWebhooks(ctx, draftID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoWebhooks := make([]*draftv1.DraftWebhook, len(webhooks))
+	for i := range webhooks {
+		protoWebhooks[i] = s.webhookToProto(&webhooks[i])
+	}
+
+	return connect.NewResponse(&draftv1.ListDraftWebhooksResponse{
+		Webhooks: protoWebhooks,
+	}), nil
+}
+
+// DeleteDraftWebhook removes a draft's webhook
+func (s *Service) DeleteDraftWebhook(ctx context.Context, req *connect.Request[draftv1.DeleteDraftWebhookRequest]) (*connect.Response[draftv1.DeleteDraftWebhookResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ensureWebhookManager(ctx, draftID.UUID()); err != nil {
+		return nil, err
+	}
+
+	webhookID, err := uuidutil.MustParseOrInvalidArg("webhook_id", req.Msg.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.draftApp.DeleteWebhook(ctx, draftID, webhookID); err != nil {
+		return nil, connect.NewError(webhookErrorCode(err), err)
+	}
+
+	return connect.NewResponse(&draftv1.DeleteDraftWebhookResponse{}), nil
+}
+
+// AddDraftCoManager lets a user besides a team's owner make the team's picks in a draft.
+// Only the team's owner or the league's commissioner may add one.
+func (s *Service) AddDraftCoManager(ctx context.Context, req *connect.Request[draftv1.AddDraftCoManagerRequest]) (*connect.Response[draftv1.AddDraftCoManagerResponse], error) {
+	var addedBy *uuid.UUID
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok {
+		addedBy = &actingUser
+	}
+
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	coManager, err := s.draftApp.AddCoManager(ctx, AddDraftCoManagerRequest{
+		DraftID:       draftID,
+		FantasyTeamID: fantasyTeamID,
+		UserID:        userID,
+		AddedBy:       addedBy,
+	})
+	if err != nil {
+		return nil, connect.NewError(coManagerErrorCode(err), err)
+	}
+
+	return connect.NewResponse(&draftv1.AddDraftCoManagerResponse{
+		CoManager: s.coManagerToProto(coManager),
+	}), nil
+}
+
+// RemoveDraftCoManager stops a co-manager making a team's picks in a draft. The team's owner,
+// the league's commissioner or the co-manager themself may remove them.
+func (s *Service) RemoveDraftCoManager(ctx context.Context, req *connect.Request[draftv1.RemoveDraftCoManagerRequest]) (*connect.Response[draftv1.RemoveDraftCoManagerResponse], error) {
+	var removedBy *uuid.UUID
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok {
+		removedBy = &actingUser
+	}
+
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	err = s.draftApp.RemoveCoManager(ctx, RemoveDraftCoManagerRequest{
+		DraftID:       draftID,
+		FantasyTeamID: fantasyTeamID,
+		UserID:        userID,
+		RemovedBy:     removedBy,
+	})
+	if err != nil {
+		return nil, connect.NewError(coManagerErrorCode(err), err)
+	}
+
+	return connect.NewResponse(&draftv1.RemoveDraftCoManagerResponse{}), nil
+}
+
+// ListDraftCoManagers lists the co-managers of every team in a draft
+func (s *Service) ListDraftCoManagers(ctx context.Context, req *connect.Request[draftv1.ListDraftCoManagersRequest]) (*connect.Response[draftv1.ListDraftCoManagersResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	coManagers, err := s.draftApp.ListCoManagers(ctx, draftID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoCoManagers := make([]*draftv1.DraftCoManager, len(coManagers))
+	for i := range coManagers {
+		protoCoManagers[i] = s.coManagerToProto(&coManagers[i])
+	}
+
+	return connect.NewResponse(&draftv1.ListDraftCoManagersResponse{
+		CoManagers: protoCoManagers,
+	}), nil
+}
+
+// StartPauseVote opens a vote to pause a running draft. The initiating team's owner or one of
+// its co-managers may start it, and the team's ballot is cast in favor.
+func (s *Service) StartPauseVote(ctx context.Context, req *connect.Request[draftv1.StartPauseVoteRequest]) (*connect.Response[draftv1.StartPauseVoteResponse], error) {
+	var startedBy *uuid.UUID
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok {
+		startedBy = &actingUser
+	}
+
+	var reason *string
+	if req.Msg.Reason != "" {
+		reason = &req.Msg.Reason
+	}
+
+	teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	vote, err := s.draftApp.StartPauseVote(ctx, StartPauseVoteRequest{
+		DraftID:       draftID,
+		FantasyTeamID: teamID,
+		Reason:        reason,
+		StartedBy:     startedBy,
+	})
+	if err != nil {
+		return nil, connect.NewError(pauseVoteErrorCode(err), err)
+	}
+
+	// Emit PauseVoteUpdated domain event so the room sees the vote and the orchestrator times it
+	if err := s.emitPauseVoteUpdatedEvent(ctx, vote, &teamID, vote.OpenedAt); err != nil {
+		log.Printf("Failed to emit PauseVoteUpdated event: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	return connect.NewResponse(&draftv1.StartPauseVoteResponse{
+		Vote: s.pauseVoteToProto(vote),
+	}), nil
+}
+
+// CastPauseVote casts or changes a team's ballot in an open pause vote
+func (s *Service) CastPauseVote(ctx context.Context, req *connect.Request[draftv1.CastPauseVoteRequest]) (*connect.Response[draftv1.CastPauseVoteResponse], error) {
+	var castBy *uuid.UUID
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok {
+		castBy = &actingUser
+	}
+
+	teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	voteID, err := uuidutil.MustParseOrInvalidArg("vote_id", req.Msg.VoteId)
+	if err != nil {
+		return nil, err
+	}
+	vote, err := s.draftApp.CastPauseVote(ctx, CastPauseVoteRequest{
+		DraftID:       draftID,
+		VoteID:        voteID,
+		FantasyTeamID: teamID,
+		InFavor:       req.Msg.InFavor,
+		CastBy:        castBy,
+	})
+	if err != nil {
+		return nil, connect.NewError(pauseVoteErrorCode(err), err)
+	}
+
+	// Emit PauseVoteUpdated domain event so the room sees the tally and the orchestrator can
+	// close the vote once it's decided
+	if err := s.emitPauseVoteUpdatedEvent(ctx, vote, &teamID, s.draftApp.Now()); err != nil {
+		log.Printf("Failed to emit PauseVoteUpdated event: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	return connect.NewResponse(&draftv1.CastPauseVoteResponse{
+		Vote: s.pauseVoteToProto(vote),
+	}), nil
+}
+
+// ClosePauseVote closes a pause vote, pausing the draft when it passed. The orchestrator closes
+// votes as they're decided or run out of time; otherwise only the commissioner may close one.
+func (s *Service) ClosePauseVote(ctx context.Context, req *connect.Request[draftv1.ClosePauseVoteRequest]) (*connect.Response[draftv1.ClosePauseVoteResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.ensureCommissioner(ctx, draftID.UUID()); err != nil {
+		return nil, err
+	}
+
+	voteID, err := uuidutil.MustParseOrInvalidArg("vote_id", req.Msg.VoteId)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.draftApp.ClosePauseVote(ctx, draftID, voteID, req.Msg.Passed)
+	if err != nil {
+		return nil, connect.NewError(pauseVoteErrorCode(err), err)
+	}
+
+	if result.Closed {
+		closedAt := s.draftApp.Now()
+		if result.Vote.ClosedAt != nil {
+			closedAt = *result.Vote.ClosedAt
+		}
+		if err := s.emitPauseVoteUpdatedEvent(ctx, result.Vote, nil, closedAt); err != nil {
+			log.Printf("Failed to emit PauseVoteUpdated event: %v", err)
+			// Don't fail the operation, just log
+		}
+	}
+	if result.Paused {
+		if err := s.emitDraftPausedEvent(ctx, draftID.UUID(), s.draftApp.Now(), "Pause vote", false, nil); err != nil {
+			log.Printf("Failed to emit DraftPaused event: %v", err)
+			// Don't fail the operation, just log
+		}
+	}
+
+	return connect.NewResponse(&draftv1.ClosePauseVoteResponse{
+		Vote: s.pauseVoteToProto(result.Vote),
+	}), nil
+}
+
+// ExtendPickClock gives the team on the clock a one-time extension of its pick clock. The
+// PickClockExtended event it emits has the orchestrator re-arm its timer for the new deadline.
+func (s *Service) ExtendPickClock(ctx context.Context, req *connect.Request[draftv1.ExtendPickClockRequest]) (*connect.Response[draftv1.ExtendPickClockResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	pickID, err := uuidutil.MustParseOrInvalidArg("pick_id", req.Msg.PickId)
+	if err != nil {
+		return nil, err
+	}
+
+	grantedBy, err := s.ensureCommissioner(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	extension, err := s.draftApp.ExtendPickClock(ctx, ExtendPickClockRequest{
+		DraftID:   draftID,
+		PickID:    pickID,
+		Extension: time.Duration(req.Msg.ExtensionSec) * time.Second,
+		GrantedBy: grantedBy,
+	})
+	if err != nil {
+		return nil, connect.NewError(extendPickClockErrorCode(err), err)
+	}
+
+	// Emit PickClockExtended domain event; without it the orchestrator's timer still finds the
+	// deadline moved when it fires and waits on, but no clock warnings are given for the new one
+	if err := s.emitPickClockExtendedEvent(ctx, draftID, extension); err != nil {
+		log.Printf("Failed to emit PickClockExtended event: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	return connect.NewResponse(&draftv1.ExtendPickClockResponse{
+		PreviousDeadline: timestamppb.New(extension.PreviousDeadline),
+		Deadline:         timestamppb.New(extension.Deadline),
+		ServerTime:       timestamppb.New(extension.ServerTime),
+	}), nil
+}
+
+// ensureCommissioner rejects acting users other than the commissioner of the draft's league
+// and returns the acting user. Calls from internal services carry no acting user and pass;
+// any other call without one is unauthenticated.
+func (s *Service) ensureCommissioner(ctx context.Context, draftID uuid.UUID) (*uuid.UUID, error) {
+	actingUser, err := interceptors.ActingUserOrService(ctx)
+	if err != nil || actingUser == nil {
+		return nil, err
+	}
+
+	draft, err := s.draftApp.GetDraft(ctx, draftID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	leagueResp, err := s.leagueService.GetLeague(ctx, connect.NewRequest(&leaguev1.GetLeagueRequest{
+		Id: draft.LeagueID.String(),
+	}))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get league: %w", err))
+	}
+	if leagueResp.Msg.League.CommissionerId != actingUser.String() {
+		return nil, connect.NewError(connect.CodePermissionDenied, ErrNotCommissioner)
+	}
+
+	return actingUser, nil
+}
+
+// ensureWebhookManager checks the request may manage a draft's webhooks: a league API key
+// holding the webhooks:manage scope, which the API key interceptor has already matched to
+// the draft's league, or else the commissioner as for ensureCommissioner
+func (s *Service) ensureWebhookManager(ctx context.Context, draftID uuid.UUID) (*uuid.UUID, error) {
+	if key, ok := interceptors.APIKeyPrincipalFromContext(ctx); ok {
+		if !key.HasScope(string(models.APIKeyScopeWebhooksManage)) {
+			return nil, connect.NewError(connect.CodePermissionDenied, ErrNotCommissioner)
+		}
+		return nil, nil
+	}
+	return s.ensureCommissioner(ctx, draftID)
+}
+
+// ensureOrchestrator returns PermissionDenied unless the request comes from the draft
+// orchestrator, authenticated by its service token
+func ensureOrchestrator(ctx context.Context) error {
+	if service, ok := interceptors.ServicePrincipalFromContext(ctx); !ok || service != orchestratorService {
+		return connect.NewError(connect.CodePermissionDenied, ErrNotOrchestrator)
+	}
+	return nil
+}
+
+// abandonTeamErrorCode maps abandon and restore failures to Connect codes
+func abandonTeamErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, ErrTeamAlreadyAbandoned):
+		return connect.CodeAlreadyExists
+	case errors.Is(err, ErrTeamNotAbandoned), errors.Is(err, ErrTeamNotInDraft), errors.Is(err, sql.ErrNoRows):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrDraftNotInProgress):
+		return connect.CodeFailedPrecondition
+	case errors.Is(err, ErrAutoPickInAuction):
+		return connect.CodeInvalidArgument
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// extendPickClockErrorCode maps pick clock extension failures to Connect codes
+func extendPickClockErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrPickClockAlreadyExtended):
+		return connect.CodeAlreadyExists
+	case errors.Is(err, ErrDraftNotInProgress), errors.Is(err, ErrPickClockNotRunning), errors.Is(err, ErrPickClockStopped):
+		return connect.CodeFailedPrecondition
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// rescheduleErrorCode maps failures to move a draft's scheduled start to Connect codes
+func rescheduleErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrScheduledInPast):
+		return connect.CodeInvalidArgument
+	case errors.Is(err, ErrDraftAlreadyStarted):
+		return connect.CodeFailedPrecondition
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// sandboxErrorCode maps failures to clone or discard a sandbox draft to Connect codes
+func sandboxErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrSandboxOfSandbox), errors.Is(err, ErrSandboxDraftType),
+		errors.Is(err, ErrAutoPickInAuction), errors.Is(err, ErrTeamNotInDraft):
+		return connect.CodeInvalidArgument
+	case errors.Is(err, ErrSandboxRunning):
+		return connect.CodeFailedPrecondition
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// webhookErrorCode maps webhook management failures to Connect codes
+func webhookErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, ErrWebhookNotFound):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrInvalidWebhookURL):
+		return connect.CodeInvalidArgument
+	case errors.Is(err, ErrTooManyWebhooks):
+		return connect.CodeResourceExhausted
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// coManagerErrorCode maps co-manager management failures to Connect codes
+func coManagerErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, ErrNotTeamManager):
+		return connect.CodePermissionDenied
+	case errors.Is(err, ErrUserNotFound), errors.Is(err, ErrCoManagerNotFound):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrAlreadyCoManager):
+		return connect.CodeAlreadyExists
+	case errors.Is(err, ErrTooManyCoManagers):
+		return connect.CodeResourceExhausted
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// pauseVoteErrorCode maps pause vote failures to Connect codes
+func pauseVoteErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, ErrNotTeamVoter):
+		return connect.CodePermissionDenied
+	case errors.Is(err, ErrPauseVoteNotFound), errors.Is(err, ErrTeamNotInDraft), errors.Is(err, sql.ErrNoRows):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrPauseVoteOpen):
+		return connect.CodeAlreadyExists
+	case errors.Is(err, ErrDraftNotInProgress), errors.Is(err, ErrPauseVotesDisabled), errors.Is(err, ErrPauseVoteClosed),
+		errors.Is(err, ErrPauseVoteNotReached), errors.Is(err, ErrTeamAbandoned):
+		return connect.CodeFailedPrecondition
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// lobbyErrorCode maps lobby readiness failures to Connect codes
+func lobbyErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, ErrNotTeamOwner):
+		return connect.CodePermissionDenied
+	case errors.Is(err, ErrTeamNotInDraft), errors.Is(err, sql.ErrNoRows):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrDraftAlreadyStarted):
+		return connect.CodeFailedPrecondition
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// ForceComplete completes a stuck draft for a platform operator, emitting the events
+// CompleteDraft does. It isn't an RPC of DraftService; the admin service calls it.
+func (s *Service) ForceComplete(ctx context.Context, id uuid.UUID) (*models.Draft, error) {
+	draft, err := s.draftApp.ForceCompleteDraft(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the per-team DraftSummary and DraftCompleted domain events
+	if err := s.emitDraftCompletedEvent(ctx, id, s.draftApp.Now()); err != nil {
+		log.Printf("Failed to emit DraftCompleted events: %v", err)
+	}
+	return draft, nil
+}
+
+// RunScheduler is no longer part of DraftService - it belongs to Orchestrator
+// This method is removed as part of the clean separation of concerns
+
+// CompleteDraft completes a draft
+func (s *Service) CompleteDraft(ctx context.Context, req *connect.Request[draftv1.CompleteDraftRequest]) (*connect.Response[draftv1.CompleteDraftResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := s.draftApp.UpdateDraftStatus(ctx, id, models.DraftStatusCompleted)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Emit the per-team DraftSummary and DraftCompleted domain events
+	if err := s.emitDraftCompletedEvent(ctx, id, s.draftApp.Now()); err != nil {
+		log.Printf("Failed to emit DraftCompleted events: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	protoDraft, err := s.draftToProto(draft)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Printf("Draft %s completed", draft.ID)
+	return connect.NewResponse(&draftv1.CompleteDraftResponse{
+		Draft: protoDraft,
+	}), nil
+}
+
+// FetchNextDeadline fetches the next deadline across all active drafts, or for one draft
+func (s *Service) FetchNextDeadline(ctx context.Context, req *connect.Request[draftv1.FetchNextDeadlineRequest]) (*connect.Response[draftv1.FetchNextDeadlineResponse], error) {
+	draftID, err := uuidutil.ParseOptional("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline, err := s.draftApp.FetchNextDeadline(ctx, draftID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// No deadline found - return empty response
+			return connect.NewResponse(&draftv1.FetchNextDeadlineResponse{}), nil
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoDeadline := &draftv1.NextDeadline{
+		DraftId: deadline.DraftID.String(),
+	}
+	if deadline.Deadline != nil {
+		protoDeadline.Deadline = timestamppb.New(*deadline.Deadline)
+	}
+
+	return connect.NewResponse(&draftv1.FetchNextDeadlineResponse{
+		NextDeadline: protoDeadline,
+		ServerTime:   timestamppb.New(deadline.ServerTime),
+	}), nil
+}
+
+// FetchDraftsDueForPick claims drafts that are due for a pick for the caller
+func (s *Service) FetchDraftsDueForPick(ctx context.Context, req *connect.Request[draftv1.FetchDraftsDueForPickRequest]) (*connect.Response[draftv1.FetchDraftsDueForPickResponse], error) {
+	draftIDs, err := s.draftApp.FetchDraftsDueForPick(ctx, FetchDraftsDueForPickRequest{
+		Limit:     req.Msg.Limit,
+		ClaimedBy: req.Msg.ClaimedBy,
+		Lease:     time.Duration(req.Msg.LeaseSec) * time.Second,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Convert UUIDs to strings
+	draftIDStrings := make([]string, len(draftIDs))
+	for i, id := range draftIDs {
+		draftIDStrings[i] = id.String()
+	}
+
+	return connect.NewResponse(&draftv1.FetchDraftsDueForPickResponse{
+		DraftIds: draftIDStrings,
+	}), nil
+}
+
+// UpdateNextDeadline updates the next deadline for a draft, either to an explicit
+// time or to a timeout from now on the database clock
+func (s *Service) UpdateNextDeadline(ctx context.Context, req *connect.Request[draftv1.UpdateNextDeadlineRequest]) (*connect.Response[draftv1.UpdateNextDeadlineResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	var next *NextDeadline
+	if req.Msg.TimeoutSec != nil {
+		next, err = s.draftApp.StartPickClock(ctx, draftID, time.Duration(*req.Msg.TimeoutSec)*time.Second)
+		if err == nil {
+			s.announcePickStarted(ctx, draftID, next, int(*req.Msg.TimeoutSec))
+		}
+	} else {
+		var deadline *time.Time
+		if req.Msg.Deadline != nil {
+			t := req.Msg.Deadline.AsTime()
+			deadline = &t
+		}
+		next, err = s.draftApp.UpdateNextDeadline(ctx, draftID, deadline)
+	}
+	if err != nil {
+		if errors.Is(err, ErrDraftNotInProgress) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &draftv1.UpdateNextDeadlineResponse{
+		ServerTime: timestamppb.New(next.ServerTime),
+	}
+	if next.Deadline != nil {
+		resp.Deadline = timestamppb.New(*next.Deadline)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ClearNextDeadline clears the deadline for a draft
+func (s *Service) ClearNextDeadline(ctx context.Context, req *connect.Request[draftv1.ClearNextDeadlineRequest]) (*connect.Response[draftv1.ClearNextDeadlineResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.draftApp.ClearNextDeadline(ctx, draftID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.ClearNextDeadlineResponse{}), nil
 }
 
-// DeleteDraft deletes a draft by ID
-func (s *Service) DeleteDraft(ctx context.Context, req *connect.Request[draftv1.DeleteDraftRequest]) (*connect.Response[draftv1.DeleteDraftResponse], error) {
-	id, err := uuid.Parse(req.Msg.DraftId)
+// WarnPickClock warns the team on the clock that its pick clock is running out
+func (s *Service) WarnPickClock(ctx context.Context, req *connect.Request[draftv1.WarnPickClockRequest]) (*connect.Response[draftv1.WarnPickClockResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
-	err = s.draftApp.DeleteDraft(ctx, id)
+	warning, err := s.draftApp.WarnPickClock(ctx, draftID, req.Msg.Deadline.AsTime(), int(req.Msg.PercentRemaining))
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	if warning == nil {
+		return connect.NewResponse(&draftv1.WarnPickClockResponse{}), nil
+	}
 
-	return connect.NewResponse(&draftv1.DeleteDraftResponse{}), nil
+	if err := s.emitPickClockWarningEvent(ctx, draftID, warning); err != nil {
+		log.Printf("Failed to emit PickClockWarning event: %v", err)
+	}
+
+	return connect.NewResponse(&draftv1.WarnPickClockResponse{
+		Warned: true,
+	}), nil
 }
 
-// RunScheduler is no longer part of DraftService - it belongs to Orchestrator
-// This method is removed as part of the clean separation of concerns
+// AnnounceDraftsStartingSoon emits DraftStartingSoon for every draft that reached a countdown
+// checkpoint as of now and returns how many did. The job worker runs it on StartCountdownJob.
+func (s *Service) AnnounceDraftsStartingSoon(ctx context.Context, now time.Time) (int, error) {
+	countdowns, err := s.draftApp.AnnounceDraftsStartingSoon(ctx, now)
+	for i := range countdowns {
+		if emitErr := s.emitDraftStartingSoonEvent(ctx, &countdowns[i]); emitErr != nil {
+			log.Printf("Failed to emit DraftStartingSoon event: %v", emitErr)
+		}
+	}
+	return len(countdowns), err
+}
 
-// CompleteDraft completes a draft
-func (s *Service) CompleteDraft(ctx context.Context, req *connect.Request[draftv1.CompleteDraftRequest]) (*connect.Response[draftv1.CompleteDraftResponse], error) {
-	id, err := uuid.Parse(req.Msg.DraftId)
+// RunMaintenance holds drafts around the maintenance windows as of now: it announces windows
+// about to open to the drafts in progress, pauses those drafts once a window opens, and resumes
+// them once it closes or is cancelled, with the pick on the clock keeping the time it had
+// left. It returns how many drafts were announced to, paused and resumed. The job worker runs
+// it on MaintenanceJob.
+func (s *Service) RunMaintenance(ctx context.Context, now time.Time) (MaintenanceRun, error) {
+	var run MaintenanceRun
+
+	notices, err := s.draftApp.AnnounceMaintenance(ctx, now)
+	for i := range notices {
+		if emitErr := s.emitMaintenanceScheduledEvent(ctx, &notices[i]); emitErr != nil {
+			log.Printf("Failed to emit MaintenanceScheduled event: %v", emitErr)
+		}
+	}
+	run.Announced = len(notices)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return run, err
 	}
 
-	draft, err := s.draftApp.UpdateDraftStatus(ctx, id, models.DraftStatusCompleted)
+	pauses, err := s.draftApp.PauseDraftsForMaintenance(ctx, now)
+	for _, pause := range pauses {
+		if emitErr := s.emitDraftPausedForMaintenanceEvent(ctx, pause); emitErr != nil {
+			log.Printf("Failed to emit DraftPaused event: %v", emitErr)
+		}
+		log.Printf("Draft %s paused for maintenance window %s", pause.DraftID, pause.Window.ID)
+	}
+	run.Paused = len(pauses)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return run, err
 	}
 
-	// Emit DraftCompleted domain event
-	if err := s.emitDraftCompletedEvent(ctx, id, time.Now()); err != nil {
-		log.Printf("Failed to emit DraftCompleted event: %v", err)
-		// Don't fail the operation, just log
+	draftIDs, err := s.draftApp.ListMaintenancePausesToLift(ctx, now)
+	if err != nil {
+		return run, err
+	}
+	for _, draftID := range draftIDs {
+		// Resumed the way a commissioner would, so the pick clock carries on from where it stood
+		_, err := s.ResumeDraft(interceptors.WithServicePrincipal(ctx, MaintenanceJob), connect.NewRequest(&draftv1.ResumeDraftRequest{DraftId: draftID.String()}))
+		if err != nil {
+			// Another window is open or about to; the draft is resumed after that one
+			if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+				continue
+			}
+			return run, fmt.Errorf("failed to resume draft %s after maintenance: %w", draftID, err)
+		}
+		run.Resumed++
 	}
+	return run, nil
+}
 
-	protoDraft, err := s.draftToProto(draft)
+// announcePickStarted emits PickStarted for the pick whose clock was just started
+func (s *Service) announcePickStarted(ctx context.Context, draftID uuid.UUID, next *NextDeadline, timePerPickSec int) {
+	pick, err := s.draftApp.GetCurrentPick(ctx, draftID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		log.Printf("Failed to load pick on the clock for draft %s: %v", draftID, err)
+		return
 	}
 
-	log.Printf("Draft %s completed", draft.ID)
-	return connect.NewResponse(&draftv1.CompleteDraftResponse{
-		Draft: protoDraft,
-	}), nil
+	payload := pickStartedPayload(pick, timePerPickSec, next)
+	if err := s.emitPickStartedEvent(ctx, draftID, payload); err != nil {
+		log.Printf("Failed to emit PickStarted event: %v", err)
+	}
+
+	s.alertWatchlists(ctx, draftID)
 }
 
-// FetchNextDeadline fetches the next deadline across all active drafts
-func (s *Service) FetchNextDeadline(ctx context.Context, req *connect.Request[draftv1.FetchNextDeadlineRequest]) (*connect.Response[draftv1.FetchNextDeadlineResponse], error) {
-	deadline, err := s.draftApp.FetchNextDeadline(ctx)
+// alertWatchlists emits a WatchlistAlert for each watchlist alert the new pick on the clock
+// made due. Like the PickStarted announcement, failures are logged and don't fail the caller.
+func (s *Service) alertWatchlists(ctx context.Context, draftID uuid.UUID) {
+	alerts, err := s.draftApp.RaiseWatchlistAlerts(ctx, draftID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			// No deadline found - return empty response
-			return connect.NewResponse(&draftv1.FetchNextDeadlineResponse{}), nil
+		log.Printf("Failed to raise watchlist alerts for draft %s: %v", draftID, err)
+		return
+	}
+	for i := range alerts {
+		if err := s.emitWatchlistAlertEvent(ctx, &alerts[i]); err != nil {
+			log.Printf("Failed to emit WatchlistAlert event: %v", err)
 		}
-		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+}
 
-	protoDeadline := &draftv1.NextDeadline{
-		DraftId: deadline.DraftID.String(),
+// buildCatchUp restarts the clock for the pick on the clock and summarizes the draft
+// for clients rejoining after its pause window
+func (s *Service) buildCatchUp(ctx context.Context, draft *models.Draft, resumedAt time.Time) (*events.DraftCatchUpPayload, error) {
+	catchUp, err := s.draftApp.GetCatchUp(ctx, draft.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load catch-up summary: %w", err)
+	}
+
+	payload := &events.DraftCatchUpPayload{
+		DraftID:     draft.ID.String(),
+		ResumedAt:   resumedAt,
+		PicksMade:   catchUp.PicksMade,
+		TotalPicks:  draft.Settings.Rounds * len(draft.Settings.DraftOrder),
+		RecentPicks: make([]events.CatchUpPick, 0, len(catchUp.RecentPicks)),
+	}
+	for _, pick := range catchUp.RecentPicks {
+		recent := events.CatchUpPick{
+			PickID:      pick.ID.String(),
+			TeamID:      pick.TeamID.String(),
+			Round:       pick.Round,
+			Pick:        pick.Pick,
+			OverallPick: pick.OverallPick,
+		}
+		if pick.PlayerID != nil {
+			recent.PlayerID = pick.PlayerID.String()
+		}
+		if pick.PickedAt != nil {
+			recent.PickedAt = *pick.PickedAt
+		}
+		payload.RecentPicks = append(payload.RecentPicks, recent)
 	}
-	if deadline.Deadline != nil {
-		protoDeadline.Deadline = timestamppb.New(*deadline.Deadline)
+
+	if catchUp.CurrentPick == nil {
+		return payload, nil
 	}
 
-	return connect.NewResponse(&draftv1.FetchNextDeadlineResponse{
-		NextDeadline: protoDeadline,
-	}), nil
+	// Restart the pick clock on the database clock; untimed rounds get no deadline
+	timePerPick := draft.Settings.TimePerPickForRound(catchUp.CurrentPick.Round)
+	next := &NextDeadline{DraftID: draft.ID, ServerTime: resumedAt}
+	if timePerPick > 0 {
+		next, err = s.draftApp.StartPickClock(ctx, draft.ID, time.Duration(timePerPick)*time.Second)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restart pick clock: %w", err)
+		}
+	}
+
+	onTheClock := pickStartedPayload(catchUp.CurrentPick, timePerPick, next)
+	onTheClock.Resume = &events.ResumeContext{
+		ResumedAt: resumedAt,
+		PicksMade: catchUp.PicksMade,
+	}
+	payload.OnTheClock = &onTheClock
+	return payload, nil
 }
 
-// FetchDraftsDueForPick fetches drafts that are due for a pick
-func (s *Service) FetchDraftsDueForPick(ctx context.Context, req *connect.Request[draftv1.FetchDraftsDueForPickRequest]) (*connect.Response[draftv1.FetchDraftsDueForPickResponse], error) {
-	draftIDs, err := s.draftApp.FetchDraftsDueForPick(ctx, req.Msg.Limit)
+// resumedPickClock describes the pick on the clock of a draft resumed by hand, whose
+// deadline the resume moved on by the length of the pause
+func (s *Service) resumedPickClock(ctx context.Context, draft *models.Draft, resumedAt time.Time) (*events.PickStartedPayload, error) {
+	if draft.NextDeadline == nil {
+		return nil, nil
+	}
+	catchUp, err := s.draftApp.GetCatchUp(ctx, draft.ID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, fmt.Errorf("failed to load catch-up summary: %w", err)
+	}
+	if catchUp.CurrentPick == nil {
+		return nil, nil
+	}
+	next, err := s.draftApp.FetchNextDeadline(ctx, &draft.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert UUIDs to strings
-	draftIDStrings := make([]string, len(draftIDs))
-	for i, id := range draftIDs {
-		draftIDStrings[i] = id.String()
+	payload := pickStartedPayload(catchUp.CurrentPick, draft.Settings.TimePerPickForRound(catchUp.CurrentPick.Round), next)
+	payload.Resume = &events.ResumeContext{
+		ResumedAt: resumedAt,
+		PicksMade: catchUp.PicksMade,
 	}
+	return &payload, nil
+}
 
-	return connect.NewResponse(&draftv1.FetchDraftsDueForPickResponse{
-		DraftIds: draftIDStrings,
-	}), nil
+// pickStartedPayload describes a pick whose clock started at next.ServerTime
+func pickStartedPayload(pick *models.DraftPick, timePerPickSec int, next *NextDeadline) events.PickStartedPayload {
+	payload := events.PickStartedPayload{
+		PickID:         pick.ID.String(),
+		TeamID:         pick.TeamID.String(),
+		Round:          pick.Round,
+		Pick:           pick.Pick,
+		OverallPick:    pick.OverallPick,
+		StartedAt:      next.ServerTime,
+		TimePerPickSec: timePerPickSec,
+	}
+	if next.Deadline != nil {
+		payload.TimeoutAt = *next.Deadline
+	}
+	return payload
 }
 
-// UpdateNextDeadline updates the next deadline for a draft
-func (s *Service) UpdateNextDeadline(ctx context.Context, req *connect.Request[draftv1.UpdateNextDeadlineRequest]) (*connect.Response[draftv1.UpdateNextDeadlineResponse], error) {
-	draftID, err := uuid.Parse(req.Msg.DraftId)
+// templateDraftSettings resolves a create request's settings against a settings
+// template. The template supplies the base settings, non-zero fields on the request
+// override them, and the draft order always comes from the request.
+func (s *Service) templateDraftSettings(ctx context.Context, templateID string, req *draftv1.CreateDraftRequest, sportID string) (*draftv1.DraftSettings, error) {
+	templateResp, err := s.templateService.GetSettingsTemplate(ctx, connect.NewRequest(&templatev1.GetSettingsTemplateRequest{
+		Id: templateID,
+	}))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("settings template not found: %w", err))
 	}
+	template := templateResp.Msg.Template
 
-	var deadline *time.Time
-	if req.Msg.Deadline != nil {
-		t := req.Msg.Deadline.AsTime()
-		deadline = &t
+	if template.DraftSettings == nil || template.DraftType == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("settings template %s has no draft settings", template.Name))
 	}
-
-	if err := s.draftApp.UpdateNextDeadline(ctx, draftID, deadline); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	if *template.DraftType != req.DraftType {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("settings template is for %s drafts", s.protoToDraftType(*template.DraftType)))
+	}
+	if template.SportId != sportID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("settings template is for sport %s, not %s", template.SportId, sportID))
 	}
 
-	return connect.NewResponse(&draftv1.UpdateNextDeadlineResponse{}), nil
-}
-
-// ClearNextDeadline clears the deadline for a draft
-func (s *Service) ClearNextDeadline(ctx context.Context, req *connect.Request[draftv1.ClearNextDeadlineRequest]) (*connect.Response[draftv1.ClearNextDeadlineResponse], error) {
-	draftID, err := uuid.Parse(req.Msg.DraftId)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	settings := &draftv1.DraftSettings{
+		Rounds:                      template.DraftSettings.Rounds,
+		TimePerPickSec:              template.DraftSettings.TimePerPickSec,
+		TypeSettings:                template.DraftSettings.TypeSettings,
+		RoundTimers:                 template.DraftSettings.RoundTimers,
+		PauseWindow:                 template.DraftSettings.PauseWindow,
+		PauseVote:                   template.DraftSettings.PauseVote,
+		CommissionerDisconnectPause: template.DraftSettings.CommissionerDisconnectPause,
+		LivePickTrades:              template.DraftSettings.LivePickTrades,
+		ClockWarningPercents:        template.DraftSettings.ClockWarningPercents,
+		DeferPicksOnTimeout:         template.DraftSettings.DeferPicksOnTimeout,
+		SkipPicksWithoutRosterSpace: template.DraftSettings.SkipPicksWithoutRosterSpace,
+		RequireAllTeamsReady:        template.DraftSettings.RequireAllTeamsReady,
+		ProtectedPlayersPerTeam:     template.DraftSettings.ProtectedPlayersPerTeam,
+		MaxPlayersLostPerTeam:       template.DraftSettings.MaxPlayersLostPerTeam,
 	}
 
-	if err := s.draftApp.ClearNextDeadline(ctx, draftID); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	overrides := req.Settings
+	if overrides == nil {
+		return settings, nil
+	}
+	settings.DraftOrder = overrides.DraftOrder
+	if overrides.Rounds != 0 {
+		settings.Rounds = overrides.Rounds
+	}
+	if overrides.TimePerPickSec != 0 {
+		settings.TimePerPickSec = overrides.TimePerPickSec
+	}
+	if overrides.TypeSettings != nil {
+		settings.TypeSettings = overrides.TypeSettings
+	}
+	if len(overrides.RoundTimers) > 0 {
+		settings.RoundTimers = overrides.RoundTimers
+	}
+	if len(overrides.ClockWarningPercents) > 0 {
+		settings.ClockWarningPercents = overrides.ClockWarningPercents
+	}
+	if overrides.PauseWindow != nil {
+		settings.PauseWindow = overrides.PauseWindow
+	}
+	if overrides.PauseVote != nil {
+		settings.PauseVote = overrides.PauseVote
+	}
+	if overrides.CommissionerDisconnectPause != nil {
+		settings.CommissionerDisconnectPause = overrides.CommissionerDisconnectPause
+	}
+	if overrides.LivePickTrades != nil {
+		settings.LivePickTrades = overrides.LivePickTrades
+	}
+	if overrides.DeferPicksOnTimeout {
+		settings.DeferPicksOnTimeout = true
+	}
+	if overrides.SkipPicksWithoutRosterSpace {
+		settings.SkipPicksWithoutRosterSpace = true
+	}
+	if overrides.RequireAllTeamsReady {
+		settings.RequireAllTeamsReady = true
+	}
+	if overrides.ProtectedPlayersPerTeam != 0 {
+		settings.ProtectedPlayersPerTeam = overrides.ProtectedPlayersPerTeam
+	}
+	if overrides.MaxPlayersLostPerTeam != 0 {
+		settings.MaxPlayersLostPerTeam = overrides.MaxPlayersLostPerTeam
 	}
 
-	return connect.NewResponse(&draftv1.ClearNextDeadlineResponse{}), nil
+	return settings, nil
 }
 
 // Conversion methods between proto and app layer models
@@ -368,22 +1946,33 @@ func (s *Service) draftToProto(draft *models.Draft) (*draftv1.Draft, error) {
 	if draft.CompletedAt != nil {
 		protoDraft.CompletedAt = timestamppb.New(*draft.CompletedAt)
 	}
+	if draft.SandboxOf != nil {
+		protoDraft.SandboxOfDraftId = draft.SandboxOf.String()
+	}
+	if draft.SeasonID != nil {
+		protoDraft.SeasonId = draft.SeasonID.String()
+	}
 
 	return protoDraft, nil
 }
 
 func (s *Service) protoToCreateDraftRequest(proto *draftv1.CreateDraftRequest) (CreateDraftRequest, error) {
-	leagueID, err := uuid.Parse(proto.LeagueId)
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", proto.LeagueId)
+	if err != nil {
+		return CreateDraftRequest{}, err
+	}
+
+	settings, err := s.protoToDraftSettings(proto.Settings)
 	if err != nil {
 		return CreateDraftRequest{}, err
 	}
 
 	req := CreateDraftRequest{
-		ID:        uuid.New(), // Generate new UUID for draft
+		ID:        ids.New(), // Generate new UUID for draft
 		LeagueID:  leagueID,
 		DraftType: s.protoToDraftType(proto.DraftType),
 		Status:    models.DraftStatusNotStarted, // Always start as NOT_STARTED
-		Settings:  s.protoToDraftSettings(proto.Settings),
+		Settings:  settings,
 	}
 
 	if proto.ScheduledAt != nil {
@@ -396,9 +1985,13 @@ func (s *Service) protoToCreateDraftRequest(proto *draftv1.CreateDraftRequest) (
 
 func (s *Service) draftSettingsToProto(settings models.DraftSettings) *draftv1.DraftSettings {
 	protoSettings := &draftv1.DraftSettings{
-		Rounds:             int32(settings.Rounds),
-		TimePerPickSec:     int32(settings.TimePerPickSec),
-		ThirdRoundReversal: settings.ThirdRoundReversal,
+		Rounds:                      int32(settings.Rounds),
+		TimePerPickSec:              int32(settings.TimePerPickSec),
+		DeferPicksOnTimeout:         settings.DeferPicksOnTimeout,
+		SkipPicksWithoutRosterSpace: settings.SkipPicksWithoutRosterSpace,
+		RequireAllTeamsReady:        settings.RequireAllTeamsReady,
+		ProtectedPlayersPerTeam:     int32(settings.ProtectedPlayersPerTeam),
+		MaxPlayersLostPerTeam:       int32(settings.MaxPlayersLostPerTeam),
 	}
 
 	// Convert draft order UUIDs to strings
@@ -409,47 +2002,116 @@ func (s *Service) draftSettingsToProto(settings models.DraftSettings) *draftv1.D
 		}
 	}
 
-	// Set optional auction fields
-	if settings.BudgetPerTeam != nil {
-		protoSettings.BudgetPerTeam = settings.BudgetPerTeam
+	s.typeSettingsToProto(settings, protoSettings)
+
+	// Convert per-round timer overrides
+	if len(settings.RoundTimers) > 0 {
+		protoSettings.RoundTimers = make([]*draftv1.RoundTimer, len(settings.RoundTimers))
+		for i, t := range settings.RoundTimers {
+			protoSettings.RoundTimers[i] = &draftv1.RoundTimer{
+				FromRound:      int32(t.FromRound),
+				ToRound:        int32(t.ToRound),
+				TimePerPickSec: int32(t.TimePerPickSec),
+			}
+		}
 	}
-	if settings.MinBidIncrement != nil {
-		protoSettings.MinBidIncrement = settings.MinBidIncrement
+	if len(settings.ClockWarningPercents) > 0 {
+		protoSettings.ClockWarningPercents = make([]int32, len(settings.ClockWarningPercents))
+		for i, percent := range settings.ClockWarningPercents {
+			protoSettings.ClockWarningPercents[i] = int32(percent)
+		}
+	}
+	if settings.PauseWindow != nil {
+		protoSettings.PauseWindow = &draftv1.PauseWindow{
+			Start:    settings.PauseWindow.Start,
+			End:      settings.PauseWindow.End,
+			Timezone: settings.PauseWindow.Timezone,
+		}
+	}
+	if settings.PauseVote != nil {
+		protoSettings.PauseVote = &draftv1.PauseVoteSettings{
+			WindowSec:        int32(settings.PauseVote.WindowSec),
+			ThresholdPercent: int32(settings.PauseVote.ThresholdPercent),
+		}
+	}
+	if settings.CommissionerDisconnectPause != nil {
+		protoSettings.CommissionerDisconnectPause = &draftv1.CommissionerDisconnectPauseSettings{
+			AfterMinutes: int32(settings.CommissionerDisconnectPause.AfterMinutes),
+		}
 	}
-	if settings.TimePerNominationSec != nil {
-		timePerNom := int32(*settings.TimePerNominationSec)
-		protoSettings.TimePerNominationSec = &timePerNom
+	if settings.LivePickTrades != nil {
+		protoSettings.LivePickTrades = &draftv1.LivePickTradeSettings{
+			NegotiationWindowSec: int32(settings.LivePickTrades.NegotiationWindowSec),
+		}
 	}
 
 	return protoSettings
 }
 
-func (s *Service) protoToDraftSettings(proto *draftv1.DraftSettings) models.DraftSettings {
+func (s *Service) protoToDraftSettings(proto *draftv1.DraftSettings) (models.DraftSettings, error) {
 	settings := models.DraftSettings{
-		Rounds:             int(proto.Rounds),
-		TimePerPickSec:     int(proto.TimePerPickSec),
-		ThirdRoundReversal: proto.ThirdRoundReversal,
-		BudgetPerTeam:      proto.BudgetPerTeam,
-		MinBidIncrement:    proto.MinBidIncrement,
+		Rounds:                      int(proto.Rounds),
+		TimePerPickSec:              int(proto.TimePerPickSec),
+		DeferPicksOnTimeout:         proto.DeferPicksOnTimeout,
+		SkipPicksWithoutRosterSpace: proto.SkipPicksWithoutRosterSpace,
+		RequireAllTeamsReady:        proto.RequireAllTeamsReady,
+		ProtectedPlayersPerTeam:     int(proto.ProtectedPlayersPerTeam),
+		MaxPlayersLostPerTeam:       int(proto.MaxPlayersLostPerTeam),
 	}
 
-	// Convert optional int32 to int pointer
-	if proto.TimePerNominationSec != nil {
-		timePerNom := int(*proto.TimePerNominationSec)
-		settings.TimePerNominationSec = &timePerNom
-	}
+	s.protoToTypeSettings(proto, &settings)
 
 	// Convert draft order strings to UUIDs
 	if len(proto.DraftOrder) > 0 {
-		settings.DraftOrder = make([]uuid.UUID, len(proto.DraftOrder))
-		for i, teamIDStr := range proto.DraftOrder {
-			if teamID, err := uuid.Parse(teamIDStr); err == nil {
-				settings.DraftOrder[i] = teamID
+		draftOrder, err := uuidutil.ParseAll("draft_order", proto.DraftOrder)
+		if err != nil {
+			return models.DraftSettings{}, err
+		}
+		settings.DraftOrder = draftOrder
+	}
+
+	// Convert per-round timer overrides
+	if len(proto.RoundTimers) > 0 {
+		settings.RoundTimers = make([]models.RoundTimer, len(proto.RoundTimers))
+		for i, t := range proto.RoundTimers {
+			settings.RoundTimers[i] = models.RoundTimer{
+				FromRound:      int(t.FromRound),
+				ToRound:        int(t.ToRound),
+				TimePerPickSec: int(t.TimePerPickSec),
 			}
 		}
 	}
+	if len(proto.ClockWarningPercents) > 0 {
+		settings.ClockWarningPercents = make([]int, len(proto.ClockWarningPercents))
+		for i, percent := range proto.ClockWarningPercents {
+			settings.ClockWarningPercents[i] = int(percent)
+		}
+	}
+	if proto.PauseWindow != nil {
+		settings.PauseWindow = &models.PauseWindow{
+			Start:    proto.PauseWindow.Start,
+			End:      proto.PauseWindow.End,
+			Timezone: proto.PauseWindow.Timezone,
+		}
+	}
+	if proto.PauseVote != nil {
+		settings.PauseVote = &models.PauseVoteSettings{
+			WindowSec:        int(proto.PauseVote.WindowSec),
+			ThresholdPercent: int(proto.PauseVote.ThresholdPercent),
+		}
+	}
+	if proto.CommissionerDisconnectPause != nil {
+		settings.CommissionerDisconnectPause = &models.CommissionerDisconnectPauseSettings{
+			AfterMinutes: int(proto.CommissionerDisconnectPause.AfterMinutes),
+		}
+	}
+	if proto.LivePickTrades != nil {
+		settings.LivePickTrades = &models.LivePickTradeSettings{
+			NegotiationWindowSec: int(proto.LivePickTrades.NegotiationWindowSec),
+		}
+	}
 
-	return settings
+	return settings, nil
 }
 
 // Enum conversion methods
@@ -462,6 +2124,10 @@ func (s *Service) draftTypeToProto(draftType models.DraftType) draftv1.DraftType
 		return draftv1.DraftType_DRAFT_TYPE_AUCTION
 	case models.DraftTypeRookie:
 		return draftv1.DraftType_DRAFT_TYPE_ROOKIE
+	case models.DraftTypeExpansion:
+		return draftv1.DraftType_DRAFT_TYPE_EXPANSION
+	case models.DraftTypeDispersal:
+		return draftv1.DraftType_DRAFT_TYPE_DISPERSAL
 	default:
 		return draftv1.DraftType_DRAFT_TYPE_UNSPECIFIED
 	}
@@ -475,11 +2141,82 @@ func (s *Service) protoToDraftType(protoType draftv1.DraftType) models.DraftType
 		return models.DraftTypeAuction
 	case draftv1.DraftType_DRAFT_TYPE_ROOKIE:
 		return models.DraftTypeRookie
+	case draftv1.DraftType_DRAFT_TYPE_EXPANSION:
+		return models.DraftTypeExpansion
+	case draftv1.DraftType_DRAFT_TYPE_DISPERSAL:
+		return models.DraftTypeDispersal
 	default:
 		return models.DraftTypeSnake // default fallback
 	}
 }
 
+// typeSettingsToProto sets the oneof of protoSettings to the settings of the draft's type.
+// Snake and rookie drafts report the order mode their picks are laid out in.
+func (s *Service) typeSettingsToProto(settings models.DraftSettings, protoSettings *draftv1.DraftSettings) {
+	switch {
+	case settings.Snake != nil:
+		protoSettings.TypeSettings = &draftv1.DraftSettings_Snake{Snake: &draftv1.SnakeSettings{
+			OrderMode: s.draftOrderModeToProto(settings.EffectiveOrderMode()),
+		}}
+	case settings.Auction != nil:
+		protoSettings.TypeSettings = &draftv1.DraftSettings_Auction{Auction: &draftv1.AuctionSettings{
+			BudgetPerTeam:        settings.Auction.BudgetPerTeam,
+			MinBidIncrement:      settings.Auction.MinBidIncrement,
+			TimePerNominationSec: int32(settings.Auction.TimePerNominationSec),
+		}}
+	case settings.Rookie != nil:
+		protoSettings.TypeSettings = &draftv1.DraftSettings_Rookie{Rookie: &draftv1.RookieSettings{
+			OrderMode: s.draftOrderModeToProto(settings.EffectiveOrderMode()),
+		}}
+	}
+}
+
+// protoToTypeSettings sets the settings of the draft's type from the oneof in proto
+func (s *Service) protoToTypeSettings(proto *draftv1.DraftSettings, settings *models.DraftSettings) {
+	switch typeSettings := proto.TypeSettings.(type) {
+	case *draftv1.DraftSettings_Snake:
+		settings.Snake = &models.SnakeSettings{
+			OrderMode: s.protoToDraftOrderMode(typeSettings.Snake.GetOrderMode()),
+		}
+	case *draftv1.DraftSettings_Auction:
+		settings.Auction = &models.AuctionSettings{
+			BudgetPerTeam:        typeSettings.Auction.GetBudgetPerTeam(),
+			MinBidIncrement:      typeSettings.Auction.GetMinBidIncrement(),
+			TimePerNominationSec: int(typeSettings.Auction.GetTimePerNominationSec()),
+		}
+	case *draftv1.DraftSettings_Rookie:
+		settings.Rookie = &models.RookieSettings{
+			OrderMode: s.protoToDraftOrderMode(typeSettings.Rookie.GetOrderMode()),
+		}
+	}
+}
+
+func (s *Service) draftOrderModeToProto(mode models.DraftOrderMode) draftv1.DraftOrderMode {
+	switch mode {
+	case models.DraftOrderModeSnake:
+		return draftv1.DraftOrderMode_DRAFT_ORDER_MODE_SNAKE
+	case models.DraftOrderModeThirdRoundReversal:
+		return draftv1.DraftOrderMode_DRAFT_ORDER_MODE_THIRD_ROUND_REVERSAL
+	case models.DraftOrderModeLinear:
+		return draftv1.DraftOrderMode_DRAFT_ORDER_MODE_LINEAR
+	default:
+		return draftv1.DraftOrderMode_DRAFT_ORDER_MODE_UNSPECIFIED
+	}
+}
+
+func (s *Service) protoToDraftOrderMode(protoMode draftv1.DraftOrderMode) models.DraftOrderMode {
+	switch protoMode {
+	case draftv1.DraftOrderMode_DRAFT_ORDER_MODE_SNAKE:
+		return models.DraftOrderModeSnake
+	case draftv1.DraftOrderMode_DRAFT_ORDER_MODE_THIRD_ROUND_REVERSAL:
+		return models.DraftOrderModeThirdRoundReversal
+	case draftv1.DraftOrderMode_DRAFT_ORDER_MODE_LINEAR:
+		return models.DraftOrderModeLinear
+	default:
+		return "" // unset; snakes
+	}
+}
+
 func (s *Service) draftStatusToProto(status models.DraftStatus) draftv1.DraftStatus {
 	switch status {
 	case models.DraftStatusNotStarted:
@@ -514,8 +2251,145 @@ func (s *Service) protoToDraftStatus(protoStatus draftv1.DraftStatus) models.Dra
 	}
 }
 
+func (s *Service) abandonedTeamToProto(team *models.AbandonedTeam) *draftv1.AbandonedTeam {
+	protoTeam := &draftv1.AbandonedTeam{
+		DraftId:       team.DraftID.String(),
+		FantasyTeamId: team.FantasyTeamID.String(),
+		PickHandling:  s.abandonedPickHandlingToProto(team.PickHandling),
+		Reason:        team.Reason,
+		AbandonedAt:   timestamppb.New(team.AbandonedAt),
+	}
+	if team.AbandonedBy != nil {
+		abandonedBy := team.AbandonedBy.String()
+		protoTeam.AbandonedBy = &abandonedBy
+	}
+	return protoTeam
+}
+
+func (s *Service) webhookToProto(webhook *models.DraftWebhook) *draftv1.DraftWebhook {
+	protoWebhook := &draftv1.DraftWebhook{
+		Id:          webhook.ID.String(),
+		DraftId:     webhook.DraftID.String(),
+		Url:         webhook.URL,
+		Description: webhook.Description,
+		CreatedAt:   timestamppb.New(webhook.CreatedAt),
+		LastError:   webhook.LastError,
+	}
+	if webhook.CreatedBy != nil {
+		createdBy := webhook.CreatedBy.String()
+		protoWebhook.CreatedBy = &createdBy
+	}
+	if webhook.LastDeliveredAt != nil {
+		protoWebhook.LastDeliveredAt = timestamppb.New(*webhook.LastDeliveredAt)
+	}
+	if webhook.LastFailedAt != nil {
+		protoWebhook.LastFailedAt = timestamppb.New(*webhook.LastFailedAt)
+	}
+	return protoWebhook
+}
+
+func (s *Service) draftLobbyToProto(lobby *models.DraftLobby) *draftv1.DraftLobby {
+	teams := make([]*draftv1.TeamReadiness, len(lobby.Teams))
+	for i, team := range lobby.Teams {
+		teams[i] = &draftv1.TeamReadiness{
+			FantasyTeamId: team.FantasyTeamID.String(),
+			Ready:         team.Ready,
+		}
+		if team.UpdatedBy != nil {
+			updatedBy := team.UpdatedBy.String()
+			teams[i].UpdatedBy = &updatedBy
+		}
+		if team.UpdatedAt != nil {
+			teams[i].UpdatedAt = timestamppb.New(*team.UpdatedAt)
+		}
+	}
+
+	return &draftv1.DraftLobby{
+		DraftId:         lobby.DraftID.String(),
+		Teams:           teams,
+		ReadyCount:      int32(lobby.ReadyCount()),
+		RequireAllReady: lobby.RequireAllReady,
+	}
+}
+
+func (s *Service) abandonedPickHandlingToProto(handling models.AbandonedPickHandling) draftv1.AbandonedPickHandling {
+	switch handling {
+	case models.AbandonedPickHandlingAutoPick:
+		return draftv1.AbandonedPickHandling_ABANDONED_PICK_HANDLING_AUTO_PICK
+	case models.AbandonedPickHandlingSkip:
+		return draftv1.AbandonedPickHandling_ABANDONED_PICK_HANDLING_SKIP
+	default:
+		return draftv1.AbandonedPickHandling_ABANDONED_PICK_HANDLING_UNSPECIFIED
+	}
+}
+
+func (s *Service) protoToAbandonedPickHandling(protoHandling draftv1.AbandonedPickHandling) models.AbandonedPickHandling {
+	switch protoHandling {
+	case draftv1.AbandonedPickHandling_ABANDONED_PICK_HANDLING_AUTO_PICK:
+		return models.AbandonedPickHandlingAutoPick
+	case draftv1.AbandonedPickHandling_ABANDONED_PICK_HANDLING_SKIP:
+		return models.AbandonedPickHandlingSkip
+	default:
+		return "" // rejected by validation
+	}
+}
+
+// uuidsToStrings converts ids for protos and event payloads
+func uuidsToStrings(ids []uuid.UUID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	return strs
+}
+
 // Event emission helper methods
 
+// emitDraftStartingSoonEvent emits a DraftStartingSoon event to the outbox
+func (s *Service) emitDraftStartingSoonEvent(ctx context.Context, countdown *StartCountdown) error {
+	payload := events.DraftStartingSoonPayload{
+		DraftID:          countdown.DraftID.String(),
+		ScheduledAt:      countdown.ScheduledAt,
+		MinutesBefore:    countdown.MinutesBefore,
+		SecondsRemaining: int(countdown.ScheduledAt.Sub(countdown.AnnouncedAt).Seconds()),
+		AnnouncedAt:      countdown.AnnouncedAt,
+	}
+
+	return s.outboxApp.InsertDraftStartingSoonEvent(ctx, countdown.DraftID, payload)
+}
+
+// emitMaintenanceScheduledEvent emits a MaintenanceScheduled event to the outbox
+func (s *Service) emitMaintenanceScheduledEvent(ctx context.Context, notice *MaintenanceNotice) error {
+	payload := events.MaintenanceScheduledPayload{
+		DraftID:     notice.DraftID.String(),
+		WindowID:    notice.Window.ID.String(),
+		StartsAt:    notice.Window.StartsAt,
+		EndsAt:      notice.Window.EndsAt,
+		Message:     notice.Window.Message,
+		AnnouncedAt: notice.AnnouncedAt,
+	}
+
+	return s.outboxApp.InsertMaintenanceScheduledEvent(ctx, notice.DraftID, payload)
+}
+
+// emitDraftRescheduledEvent emits a DraftRescheduled event to the outbox
+func (s *Service) emitDraftRescheduledEvent(ctx context.Context, reschedule *Reschedule, actorID *uuid.UUID) error {
+	payload := events.DraftRescheduledPayload{
+		DraftID:             reschedule.Draft.ID.String(),
+		ScheduledAt:         *reschedule.Draft.ScheduledAt,
+		PreviousScheduledAt: reschedule.PreviousScheduledAt,
+		RescheduledAt:       s.draftApp.Now(),
+	}
+	if actorID != nil {
+		payload.RescheduledBy = actorID.String()
+	}
+
+	return s.outboxApp.InsertDraftRescheduledEvent(ctx, reschedule.Draft.ID, payload)
+}
+
 // emitDraftStartedEvent emits a DraftStarted event to the outbox
 func (s *Service) emitDraftStartedEvent(ctx context.Context, draftID uuid.UUID, startedAt time.Time) error {
 	// Get draft information to include in the event
@@ -536,54 +2410,208 @@ func (s *Service) emitDraftStartedEvent(ctx context.Context, draftID uuid.UUID,
 		TotalPicks:  totalPicks,
 	}
 
-	// Marshal payload to JSON
-	payloadBytes, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal DraftStarted payload: %w", err)
-	}
-
 	// Insert into outbox
-	return s.outboxApp.InsertOutboxDraftStarted(ctx, draftID, payloadBytes)
+	return s.outboxApp.InsertDraftStartedEvent(ctx, draftID, payload)
 }
 
 // emitDraftPausedEvent emits a DraftPaused event to the outbox
-func (s *Service) emitDraftPausedEvent(ctx context.Context, draftID uuid.UUID, pausedAt time.Time, reason string) error {
+func (s *Service) emitDraftPausedEvent(ctx context.Context, draftID uuid.UUID, pausedAt time.Time, reason string, scheduled bool, resumesAt *time.Time) error {
 	// Create DraftPaused payload
 	payload := events.DraftPausedPayload{
-		DraftID:  draftID.String(),
-		PausedAt: pausedAt,
-		Reason:   reason,
+		DraftID:   draftID.String(),
+		PausedAt:  pausedAt,
+		Reason:    reason,
+		Scheduled: scheduled,
+		ResumesAt: resumesAt,
 	}
 
-	// Marshal payload to JSON
-	payloadBytes, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal DraftPaused payload: %w", err)
+	// Insert into outbox
+	return s.outboxApp.InsertDraftPausedEvent(ctx, draftID, payload)
+}
+
+// emitDraftPausedForMaintenanceEvent emits a DraftPaused event to the outbox for a draft
+// paused for a maintenance window
+func (s *Service) emitDraftPausedForMaintenanceEvent(ctx context.Context, pause MaintenancePause) error {
+	reason := "Scheduled maintenance"
+	if pause.Window.Message != "" {
+		reason = pause.Window.Message
 	}
+	resumesAt := pause.Window.EndsAt
 
-	// Insert into outbox
-	return s.outboxApp.InsertOutboxDraftPaused(ctx, draftID, payloadBytes)
+	payload := events.DraftPausedPayload{
+		DraftID:     pause.DraftID.String(),
+		PausedAt:    pause.PausedAt,
+		Reason:      reason,
+		ResumesAt:   &resumesAt,
+		Maintenance: true,
+	}
+
+	return s.outboxApp.InsertDraftPausedEvent(ctx, pause.DraftID, payload)
 }
 
 // emitDraftResumedEvent emits a DraftResumed event to the outbox
-func (s *Service) emitDraftResumedEvent(ctx context.Context, draftID uuid.UUID, resumedAt time.Time) error {
+func (s *Service) emitDraftResumedEvent(ctx context.Context, draftID uuid.UUID, resumedAt time.Time, scheduled bool) error {
 	// Create DraftResumed payload
 	payload := events.DraftResumedPayload{
 		DraftID:   draftID.String(),
 		ResumedAt: resumedAt,
+		Scheduled: scheduled,
 	}
 
-	// Marshal payload to JSON
-	payloadBytes, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal DraftResumed payload: %w", err)
+	// Insert into outbox
+	return s.outboxApp.InsertDraftResumedEvent(ctx, draftID, payload)
+}
+
+// emitDraftCatchUpEvent emits a DraftCatchUp event to the outbox
+func (s *Service) emitDraftCatchUpEvent(ctx context.Context, draftID uuid.UUID, payload events.DraftCatchUpPayload) error {
+	return s.outboxApp.InsertDraftCatchUpEvent(ctx, draftID, payload)
+}
+
+// emitPickStartedEvent emits a PickStarted event to the outbox
+func (s *Service) emitPickStartedEvent(ctx context.Context, draftID uuid.UUID, payload events.PickStartedPayload) error {
+	return s.outboxApp.InsertPickStartedEvent(ctx, draftID, payload)
+}
+
+// emitPickClockWarningEvent emits a PickClockWarning event to the outbox
+func (s *Service) emitPickClockWarningEvent(ctx context.Context, draftID uuid.UUID, warning *PickClockWarning) error {
+	payload := events.PickClockWarningPayload{
+		PickID:           warning.Pick.ID.String(),
+		TeamID:           warning.Pick.TeamID.String(),
+		Round:            warning.Pick.Round,
+		Pick:             warning.Pick.Pick,
+		OverallPick:      warning.Pick.OverallPick,
+		PercentRemaining: warning.PercentRemaining,
+		TimeoutAt:        warning.Deadline,
+		Final:            warning.Final,
 	}
 
-	// Insert into outbox
-	return s.outboxApp.InsertOutboxDraftResumed(ctx, draftID, payloadBytes)
+	return s.outboxApp.InsertPickClockWarningEvent(ctx, draftID, payload)
+}
+
+// emitPickClockExtendedEvent emits a PickClockExtended event to the outbox
+func (s *Service) emitPickClockExtendedEvent(ctx context.Context, draftID uuid.UUID, extension *PickClockExtension) error {
+	payload := events.PickClockExtendedPayload{
+		PickID:            extension.Pick.ID.String(),
+		TeamID:            extension.Pick.TeamID.String(),
+		Round:             extension.Pick.Round,
+		Pick:              extension.Pick.Pick,
+		OverallPick:       extension.Pick.OverallPick,
+		ExtensionSec:      int(extension.Extension / time.Second),
+		PreviousTimeoutAt: extension.PreviousDeadline,
+		TimeoutAt:         extension.Deadline,
+		ExtendedAt:        extension.ServerTime,
+	}
+	if extension.GrantedBy != nil {
+		payload.GrantedBy = extension.GrantedBy.String()
+	}
+
+	return s.outboxApp.InsertPickClockExtendedEvent(ctx, draftID, payload)
+}
+
+// emitWatchlistAlertEvent emits a WatchlistAlert event to the outbox
+func (s *Service) emitWatchlistAlertEvent(ctx context.Context, alert *models.WatchlistAlert) error {
+	payload := events.WatchlistAlertPayload{
+		AlertID:       alert.ID.String(),
+		UserID:        alert.UserID.String(),
+		FantasyTeamID: alert.FantasyTeamID.String(),
+		PlayerID:      alert.PlayerID.String(),
+		PlayerName:    alert.PlayerName,
+		Kind:          string(alert.Kind),
+		PickID:        alert.PickID.String(),
+		Round:         alert.Round,
+		Pick:          alert.Pick,
+		OverallPick:   alert.OverallPick,
+		PickTeamID:    alert.PickTeamID.String(),
+		PicksAway:     alert.PicksAway,
+		RaisedAt:      alert.CreatedAt,
+	}
+
+	return s.outboxApp.InsertWatchlistAlertEvent(ctx, alert.DraftID, payload)
+}
+
+// emitTeamAbandonedEvent emits a TeamAbandoned event to the outbox
+func (s *Service) emitTeamAbandonedEvent(ctx context.Context, result *AbandonTeamResult) error {
+	payload := events.TeamAbandonedPayload{
+		DraftID:          result.Team.DraftID.String(),
+		FantasyTeamID:    result.Team.FantasyTeamID.String(),
+		PickHandling:     string(result.Team.PickHandling),
+		ForfeitedPickIDs: uuidsToStrings(result.ForfeitedPickIDs),
+		OnTheClock:       result.OnTheClock,
+		AbandonedAt:      result.Team.AbandonedAt,
+	}
+	if result.Team.Reason != nil {
+		payload.Reason = *result.Team.Reason
+	}
+
+	return s.outboxApp.InsertTeamAbandonedEvent(ctx, result.Team.DraftID, payload)
+}
+
+// emitLobbyUpdatedEvent emits a LobbyUpdated event to the outbox
+func (s *Service) emitLobbyUpdatedEvent(ctx context.Context, lobby *models.DraftLobby, teamID uuid.UUID, ready bool, updatedAt time.Time) error {
+	payload := events.LobbyUpdatedPayload{
+		DraftID:         lobby.DraftID.String(),
+		FantasyTeamID:   teamID.String(),
+		Ready:           ready,
+		Teams:           make([]events.LobbyTeam, len(lobby.Teams)),
+		ReadyCount:      lobby.ReadyCount(),
+		RequireAllReady: lobby.RequireAllReady,
+		UpdatedAt:       updatedAt,
+	}
+	for i, team := range lobby.Teams {
+		payload.Teams[i] = events.LobbyTeam{
+			TeamID: team.FantasyTeamID.String(),
+			Ready:  team.Ready,
+		}
+	}
+
+	return s.outboxApp.InsertLobbyUpdatedEvent(ctx, lobby.DraftID, payload)
 }
 
-// emitDraftCompletedEvent emits a DraftCompleted event to the outbox
+// emitPauseVoteUpdatedEvent emits a PauseVoteUpdated event to the outbox. teamID is the team
+// whose ballot was just cast, nil when the vote closed.
+func (s *Service) emitPauseVoteUpdatedEvent(ctx context.Context, vote *models.PauseVote, teamID *uuid.UUID, updatedAt time.Time) error {
+	payload := events.PauseVoteUpdatedPayload{
+		DraftID:          vote.DraftID.String(),
+		VoteID:           vote.ID.String(),
+		Status:           string(vote.Status),
+		InitiatingTeamID: vote.InitiatingTeamID.String(),
+		VotesFor:         vote.VotesFor,
+		VotesAgainst:     vote.VotesAgainst,
+		EligibleTeams:    vote.EligibleTeams,
+		VotesNeeded:      vote.VotesNeeded,
+		ClosesAt:         vote.ClosesAt,
+		ClosedAt:         vote.ClosedAt,
+		UpdatedAt:        updatedAt,
+	}
+	if vote.InitiatedBy != nil {
+		payload.InitiatedBy = vote.InitiatedBy.String()
+	}
+	if vote.Reason != nil {
+		payload.Reason = *vote.Reason
+	}
+	if teamID != nil {
+		payload.FantasyTeamID = teamID.String()
+	}
+
+	return s.outboxApp.InsertPauseVoteUpdatedEvent(ctx, vote.DraftID, payload)
+}
+
+// emitTeamRestoredEvent emits a TeamRestored event to the outbox
+func (s *Service) emitTeamRestoredEvent(ctx context.Context, draftID, teamID uuid.UUID, result *RestoreTeamResult, restoredAt time.Time) error {
+	payload := events.TeamRestoredPayload{
+		DraftID:         draftID.String(),
+		FantasyTeamID:   teamID.String(),
+		RestoredPickIDs: uuidsToStrings(result.RestoredPickIDs),
+		OnTheClock:      result.OnTheClock,
+		RestoredAt:      restoredAt,
+	}
+
+	return s.outboxApp.InsertTeamRestoredEvent(ctx, draftID, payload)
+}
+
+// emitDraftCompletedEvent emits a DraftSummary event for each team followed by the DraftCompleted
+// event, inserted into the outbox together. The summaries come first since the gateway tears the
+// draft room down on DraftCompleted.
 func (s *Service) emitDraftCompletedEvent(ctx context.Context, draftID uuid.UUID, completedAt time.Time) error {
 	// Get draft information to calculate duration
 	draft, err := s.draftApp.GetDraft(ctx, draftID)
@@ -600,20 +2628,88 @@ func (s *Service) emitDraftCompletedEvent(ctx context.Context, draftID uuid.UUID
 	// Count total picks for the draft
 	totalPicks := draft.Settings.Rounds * len(draft.Settings.DraftOrder)
 
-	// Create DraftCompleted payload
-	payload := events.DraftCompletedPayload{
-		DraftID:     draftID.String(),
-		CompletedAt: completedAt,
-		Duration:    duration,
-		TotalPicks:  totalPicks,
+	summaries, err := s.draftApp.ListDraftTeamSummaries(ctx, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to get team summaries for DraftSummary events: %w", err)
 	}
 
-	// Marshal payload to JSON
-	payloadBytes, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal DraftCompleted payload: %w", err)
+	batch := make([]outbox.Event, 0, len(summaries)+1)
+	for _, summary := range summaries {
+		batch = append(batch, outbox.Event{
+			Type: events.DraftSummary,
+			Payload: events.DraftSummaryPayload{
+				DraftID:        draftID.String(),
+				TeamID:         summary.TeamID.String(),
+				TotalPicks:     summary.TotalPicks,
+				PlayersDrafted: summary.PlayersDrafted,
+				KeeperPicks:    summary.KeeperPicks,
+				ForfeitedPicks: summary.ForfeitedPicks,
+			},
+		})
 	}
 
-	// Insert into outbox
-	return s.outboxApp.InsertOutboxDraftCompleted(ctx, draftID, payloadBytes)
+	// Create DraftCompleted payload
+	batch = append(batch, outbox.Event{
+		Type: events.DraftCompleted,
+		Payload: events.DraftCompletedPayload{
+			DraftID:     draftID.String(),
+			CompletedAt: completedAt,
+			Duration:    duration,
+			TotalPicks:  totalPicks,
+		},
+	})
+
+	// Insert into outbox, all or none
+	return s.outboxApp.InsertEvents(ctx, draftID, batch)
+}
+
+func (s *Service) coManagerToProto(coManager *models.DraftCoManager) *draftv1.DraftCoManager {
+	protoCoManager := &draftv1.DraftCoManager{
+		DraftId:       coManager.DraftID.String(),
+		FantasyTeamId: coManager.FantasyTeamID.String(),
+		UserId:        coManager.UserID.String(),
+		CreatedAt:     timestamppb.New(coManager.CreatedAt),
+	}
+	if coManager.AddedBy != nil {
+		addedBy := coManager.AddedBy.String()
+		protoCoManager.AddedBy = &addedBy
+	}
+	return protoCoManager
+}
+
+func (s *Service) pauseVoteToProto(vote *models.PauseVote) *draftv1.PauseVote {
+	protoVote := &draftv1.PauseVote{
+		Id:               vote.ID.String(),
+		DraftId:          vote.DraftID.String(),
+		InitiatingTeamId: vote.InitiatingTeamID.String(),
+		Reason:           vote.Reason,
+		Status:           s.pauseVoteStatusToProto(vote.Status),
+		VotesFor:         int32(vote.VotesFor),
+		VotesAgainst:     int32(vote.VotesAgainst),
+		EligibleTeams:    int32(vote.EligibleTeams),
+		VotesNeeded:      int32(vote.VotesNeeded),
+		OpenedAt:         timestamppb.New(vote.OpenedAt),
+		ClosesAt:         timestamppb.New(vote.ClosesAt),
+	}
+	if vote.InitiatedBy != nil {
+		initiatedBy := vote.InitiatedBy.String()
+		protoVote.InitiatedBy = &initiatedBy
+	}
+	if vote.ClosedAt != nil {
+		protoVote.ClosedAt = timestamppb.New(*vote.ClosedAt)
+	}
+	return protoVote
+}
+
+func (s *Service) pauseVoteStatusToProto(status models.PauseVoteStatus) draftv1.PauseVoteStatus {
+	switch status {
+	case models.PauseVoteStatusOpen:
+		return draftv1.PauseVoteStatus_PAUSE_VOTE_STATUS_OPEN
+	case models.PauseVoteStatusPassed:
+		return draftv1.PauseVoteStatus_PAUSE_VOTE_STATUS_PASSED
+	case models.PauseVoteStatusFailed:
+		return draftv1.PauseVoteStatus_PAUSE_VOTE_STATUS_FAILED
+	default:
+		return draftv1.PauseVoteStatus_PAUSE_VOTE_STATUS_UNSPECIFIED
+	}
 }
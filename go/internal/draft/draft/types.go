@@ -1,12 +1,154 @@
 package draft
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/mcdev12/dynasty/go/internal/models"
 )
 
+// ErrSlotSelectionInProgress is returned when a draft is started or its order changed while teams are still choosing slots
+var ErrSlotSelectionInProgress = errors.New("draft slot selection is still in progress")
+
+// ErrDraftNotInProgress is returned when a pick deadline is set on a draft that isn't in progress
+var ErrDraftNotInProgress = errors.New("draft is not in progress")
+
+// ErrCannotReportOwnMessage is returned when a user reports a chat message they sent
+var ErrCannotReportOwnMessage = errors.New("cannot report your own chat message")
+
+// ErrTeamNotInDraft is returned when a team that isn't in a draft's order is abandoned
+var ErrTeamNotInDraft = errors.New("team is not in the draft")
+
+// ErrTeamAlreadyAbandoned is returned when a team is abandoned twice
+var ErrTeamAlreadyAbandoned = errors.New("team is already abandoned")
+
+// ErrTeamNotAbandoned is returned when a team that isn't abandoned is restored
+var ErrTeamNotAbandoned = errors.New("team is not abandoned")
+
+// ErrAutoPickInAuction is returned when an abandoned team's picks are set to be auto-picked
+// in an auction draft, where picks are won by bidding rather than made in turn
+var ErrAutoPickInAuction = errors.New("auction drafts cannot auto-pick for an abandoned team")
+
+// ErrNotCommissioner is returned when someone other than the league's commissioner manages its draft's teams
+var ErrNotCommissioner = errors.New("only the league commissioner can do this")
+
+// ErrNotOrchestrator is returned when something other than the draft orchestrator acts as the
+// draft's scheduler
+var ErrNotOrchestrator = errors.New("only the draft orchestrator can do this")
+
+// orchestratorService is the service name the draft orchestrator signs its calls with
+const orchestratorService = "orchestrator"
+
+// ErrNotTeamOwner is returned when someone marks a team they don't own ready in a draft's lobby
+var ErrNotTeamOwner = errors.New("only the team's owner can do this")
+
+// ErrDraftAlreadyStarted is returned when a team marks itself ready in the lobby of a draft
+// that has already started
+var ErrDraftAlreadyStarted = errors.New("draft has already started")
+
+// ErrTeamsNotReady is returned when a draft that requires every team to be ready is started before they are
+var ErrTeamsNotReady = errors.New("not every team is ready")
+
+// ErrWebhookNotFound is returned when a webhook that doesn't belong to a draft is deleted
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+// ErrTooManyWebhooks is returned when a draft already has MaxWebhooksPerDraft webhooks
+var ErrTooManyWebhooks = errors.New("draft has too many webhooks")
+
+// ErrInvalidWebhookURL is returned when a webhook URL isn't an absolute https URL
+var ErrInvalidWebhookURL = errors.New("webhook URL must be an absolute https URL")
+
+// MaxWebhooksPerDraft is how many webhooks a draft may have
+const MaxWebhooksPerDraft = 5
+
+// ErrNotTeamManager is returned when someone other than a team's owner or the league's
+// commissioner chooses the team's co-managers
+var ErrNotTeamManager = errors.New("only the team's owner or the league commissioner can do this")
+
+// ErrUserNotFound is returned when a user who doesn't exist is made a co-manager
+var ErrUserNotFound = errors.New("user not found")
+
+// ErrAlreadyCoManager is returned when a user is made a co-manager of a team they already co-manage
+var ErrAlreadyCoManager = errors.New("user already co-manages the team")
+
+// ErrCoManagerNotFound is returned when a user who doesn't co-manage a team is removed as its co-manager
+var ErrCoManagerNotFound = errors.New("user does not co-manage the team")
+
+// ErrTooManyCoManagers is returned when a team already has MaxCoManagersPerTeam co-managers
+var ErrTooManyCoManagers = errors.New("team has too many co-managers")
+
+// MaxCoManagersPerTeam is how many co-managers a team may have in a draft
+const MaxCoManagersPerTeam = 3
+
+// ErrPauseVotesDisabled is returned when a pause vote is started in a draft whose settings don't allow them
+var ErrPauseVotesDisabled = errors.New("draft does not allow pause votes")
+
+// ErrPauseVoteOpen is returned when a pause vote is started while another is open
+var ErrPauseVoteOpen = errors.New("a pause vote is already open")
+
+// ErrPauseVoteNotFound is returned when a pause vote that doesn't belong to a draft is cast or closed
+var ErrPauseVoteNotFound = errors.New("pause vote not found")
+
+// ErrPauseVoteClosed is returned when a ballot is cast in a pause vote that has closed or run out of time
+var ErrPauseVoteClosed = errors.New("pause vote is closed")
+
+// ErrPauseVoteNotReached is returned when a pause vote is passed before enough teams voted for it
+var ErrPauseVoteNotReached = errors.New("pause vote has not reached its threshold")
+
+// ErrNotTeamVoter is returned when someone other than a team's owner or co-managers votes for it
+var ErrNotTeamVoter = errors.New("only the team's owner or co-managers can vote for it")
+
+// ErrTeamAbandoned is returned when an abandoned team votes in a draft
+var ErrTeamAbandoned = errors.New("team is abandoned")
+
+// ErrNotPausedForCommissioner is returned when a draft is resumed for its commissioner
+// reconnecting but wasn't paused for their disconnect
+var ErrNotPausedForCommissioner = errors.New("draft was not paused for its commissioner's disconnect")
+
+// ErrSandboxOfSandbox is returned when a sandbox draft is cloned into another sandbox
+var ErrSandboxOfSandbox = errors.New("a sandbox draft cannot be cloned")
+
+// ErrSandboxDraftType is returned when an expansion or dispersal draft, whose players come from
+// a pool of its own, is cloned into a sandbox
+var ErrSandboxDraftType = errors.New("only snake, rookie and auction drafts can be rehearsed in a sandbox")
+
+// ErrSandboxRunning is returned when a sandbox draft is discarded while it's in progress
+var ErrSandboxRunning = errors.New("sandbox draft is in progress, pause it before discarding it")
+
+// ErrDraftNotRunning is returned when forcing a draft to complete that is neither in progress
+// nor paused
+var ErrDraftNotRunning = errors.New("draft is neither in progress nor paused")
+
+// ErrScheduledInPast is returned when a draft is scheduled to start at a time that has passed
+var ErrScheduledInPast = errors.New("scheduled_at must be in the future")
+
+// ErrMaintenance is returned when a draft is started or resumed while a maintenance window is
+// open or about to open
+var ErrMaintenance = errors.New("drafts can't start or resume during scheduled maintenance")
+
+// ErrMaintenanceWindowNotFound is returned when cancelling a maintenance window that doesn't
+// exist or was already cancelled
+var ErrMaintenanceWindowNotFound = errors.New("maintenance window not found")
+
+// ErrInvalidMaintenanceWindow is returned when a maintenance window is scheduled with times
+// that don't make sense
+var ErrInvalidMaintenanceWindow = errors.New("invalid maintenance window")
+
+// ErrPickClockNotRunning is returned when extending the pick clock of a draft whose pick isn't
+// on a running clock: it's untimed, its deadline has passed, or the draft moved past the pick
+var ErrPickClockNotRunning = errors.New("the pick is not on a running clock")
+
+// ErrPickClockAlreadyExtended is returned when extending the clock of a pick that was extended before
+var ErrPickClockAlreadyExtended = errors.New("the pick clock was already extended")
+
+// ErrPickClockStopped is returned when extending the pick clock while a live pick trade offer
+// has it stopped
+var ErrPickClockStopped = errors.New("the pick clock is stopped for a pick trade offer")
+
+// sandboxBotReason is recorded against the teams auto-picked in a sandbox draft
+const sandboxBotReason = "Sandbox bot"
+
 // CreateDraftRequest represents a request to create a new draft
 type CreateDraftRequest struct {
 	ID          uuid.UUID            `json:"id"`
@@ -17,19 +159,281 @@ type CreateDraftRequest struct {
 	ScheduledAt *time.Time           `json:"scheduled_at"`
 }
 
+// CloneDraftAsSandboxRequest copies a draft into a sandbox draft its commissioner rehearses it in
+type CloneDraftAsSandboxRequest struct {
+	DraftID    uuid.UUID
+	BotTeamIDs []uuid.UUID // teams whose picks are auto-picked as they come up
+	CreatedBy  *uuid.UUID  // nil when cloned by a service rather than a user
+}
+
 // UpdateDraftStatusRequest represents a request to update draft status
 type UpdateDraftStatusRequest struct {
-	Status models.DraftStatus `json:"status"`
+	Status  models.DraftStatus `json:"status"`
+	ActorID *uuid.UUID         `json:"actor_id"` // recorded against a deadline recomputed on resume
 }
 
 // UpdateDraftRequest represents a request to update draft settings/schedule
 type UpdateDraftRequest struct {
 	Settings    *models.DraftSettings `json:"settings"`
 	ScheduledAt *time.Time            `json:"scheduled_at"`
+	ActorID     *uuid.UUID            `json:"actor_id"` // recorded against a deadline the new settings recompute
 }
 
-// NextDeadline represents the next deadline for a draft
+// Reschedule is a draft moved to a new scheduled start
+type Reschedule struct {
+	Draft               *models.Draft
+	PreviousScheduledAt *time.Time // nil for a draft that wasn't scheduled
+}
+
+// DeadlineChangeReason is why a draft's pick deadline was recomputed
+type DeadlineChangeReason string
+
+const (
+	DeadlineChangeResume         DeadlineChangeReason = "RESUME"          // the draft resumed after a pause
+	DeadlineChangeSettingsChange DeadlineChangeReason = "SETTINGS_CHANGE" // the pick clock settings changed while paused
+	DeadlineChangeExtension      DeadlineChangeReason = "EXTENSION"       // the commissioner extended the pick clock
+)
+
+// MinRecomputedPickClock is the least time left on a pick whose deadline is recomputed, so
+// a clock that ran down before a pause, or that new settings shorten, doesn't expire the
+// moment the draft resumes
+const MinRecomputedPickClock = 15 * time.Second
+
+// NextDeadline represents the next deadline for a draft. ServerTime is the database
+// clock when the deadline was read or set; comparing Deadline against it rather than a
+// local clock keeps callers immune to clock skew between hosts.
 type NextDeadline struct {
-	DraftID  uuid.UUID  `json:"draft_id"`
-	Deadline *time.Time `json:"deadline"`
-}
\ No newline at end of file
+	DraftID    uuid.UUID  `json:"draft_id"`
+	Deadline   *time.Time `json:"deadline"`
+	ServerTime time.Time  `json:"server_time"`
+}
+
+// Remaining returns how long until the deadline, measured on the database clock
+func (d *NextDeadline) Remaining() time.Duration {
+	if d.Deadline == nil {
+		return 0
+	}
+	return d.Deadline.Sub(d.ServerTime)
+}
+
+// DefaultDueDraftClaimLease is how long drafts claimed by FetchDraftsDueForPick stay claimed
+// when the caller doesn't ask for a lease
+const DefaultDueDraftClaimLease = 30 * time.Second
+
+// FetchDraftsDueForPickRequest represents a request to claim drafts whose pick deadline has passed
+type FetchDraftsDueForPickRequest struct {
+	Limit     int32         `json:"limit"`
+	ClaimedBy string        `json:"claimed_by"` // identifies the caller, e.g. an orchestrator instance
+	Lease     time.Duration `json:"lease"`      // how long the drafts stay claimed unless their deadline moves
+}
+
+// UserDraft is an unfinished draft seen from the seat of one user in its league
+type UserDraft struct {
+	Draft          *models.Draft
+	LeagueName     string
+	IsCommissioner bool
+	TeamID         *uuid.UUID        // nil for a commissioner without a team
+	CurrentPick    *models.DraftPick // the pick on the clock, nil before picks are generated
+	PicksMade      int
+	TotalPicks     int
+	NextDeadline   *time.Time
+	ServerTime     time.Time // database clock NextDeadline is measured against
+}
+
+// CatchUp summarizes a draft's progress for clients rejoining after a pause
+type CatchUp struct {
+	PicksMade   int
+	RecentPicks []models.DraftPick // newest first
+	CurrentPick *models.DraftPick  // nil once every pick is made
+}
+
+// ChatReport is a draft chat message flagged by another participant
+type ChatReport struct {
+	DraftID        uuid.UUID
+	MessageID      uuid.UUID
+	ReporterUserID uuid.UUID
+	SenderUserID   uuid.UUID
+	MessageText    string
+	Reason         string
+	SentAt         time.Time
+}
+
+// FrameDelivery is whether a user's clients acknowledged a draft room frame the gateway
+// required them to
+type FrameDelivery struct {
+	DraftID          uuid.UUID
+	EventID          uuid.UUID
+	EventType        string
+	UserID           uuid.UUID
+	Status           models.FrameDeliveryStatus
+	Attempts         int
+	SentAt           time.Time
+	PickID           *uuid.UUID // the pick a PickStarted frame announced
+	WatchlistAlertID *uuid.UUID // the alert a WatchlistAlert frame carried
+}
+
+// SetTeamReadyRequest marks a team ready, or no longer ready, in a draft's lobby
+type SetTeamReadyRequest struct {
+	DraftID       uuid.UUID
+	FantasyTeamID uuid.UUID
+	Ready         bool
+	SetBy         *uuid.UUID // nil when set by a service rather than a user
+}
+
+// AbandonTeamRequest takes a team whose owner stopped taking part out of a draft
+type AbandonTeamRequest struct {
+	DraftID       uuid.UUID
+	FantasyTeamID uuid.UUID
+	PickHandling  models.AbandonedPickHandling
+	Reason        *string
+	AbandonedBy   *uuid.UUID // nil when abandoned by a service rather than a user
+}
+
+// AbandonTeamResult is an abandoned team and the picks it forfeited
+type AbandonTeamResult struct {
+	Team             *models.AbandonedTeam
+	ForfeitedPickIDs []uuid.UUID // empty unless the team's picks are skipped
+	OnTheClock       bool        // the team held the pick on the clock when abandoned
+}
+
+// RestoreTeamResult is the outcome of handing an abandoned team back to its owner
+type RestoreTeamResult struct {
+	RestoredPickIDs []uuid.UUID // forfeited picks given back to the team
+	OnTheClock      bool        // the team holds the pick on the clock once restored
+}
+
+// PickClockWarning is a warning to the team on the clock that its pick clock is running out
+type PickClockWarning struct {
+	Pick             *models.DraftPick
+	PercentRemaining int
+	Deadline         time.Time
+	Final            bool // the last warning before the clock runs out, also sent to the team's owner
+}
+
+// ExtendPickClockRequest gives the team on the clock a one-time extension of its pick clock
+type ExtendPickClockRequest struct {
+	DraftID   uuid.UUID
+	PickID    uuid.UUID // the pick on the clock
+	Extension time.Duration
+	GrantedBy *uuid.UUID // nil when granted by a service rather than a user
+}
+
+// PickClockExtension is an extension of the pick clock granted to the team on it
+type PickClockExtension struct {
+	Pick             *models.DraftPick
+	Extension        time.Duration
+	PreviousDeadline time.Time
+	Deadline         time.Time
+	ServerTime       time.Time // database clock when the deadline was moved
+	GrantedBy        *uuid.UUID
+}
+
+// StartCountdownMinutes are the checkpoints, in minutes before a draft's scheduled start, at
+// which its countdown is announced
+var StartCountdownMinutes = []int{10, 5, 2, 1}
+
+// ScheduledDraft is a draft yet to start and when it is scheduled to
+type ScheduledDraft struct {
+	DraftID     uuid.UUID
+	ScheduledAt time.Time
+}
+
+// StartCountdown is a countdown checkpoint announced for a draft about to start
+type StartCountdown struct {
+	DraftID        uuid.UUID
+	ScheduledAt    time.Time
+	MinutesBefore  int
+	AnnouncedAt    time.Time
+	OwnersNotified bool // the first checkpoint announced for this start, also sent to every team's owner
+}
+
+// MaintenanceNoticeLead is how long before a maintenance window opens that drafts in progress
+// are told it's coming, and no draft may start or resume from then on
+const MaintenanceNoticeLead = 15 * time.Minute
+
+// MaxMaintenanceWindow is the longest a maintenance window may stay open
+const MaxMaintenanceWindow = 12 * time.Hour
+
+// ScheduleMaintenanceRequest schedules a maintenance window
+type ScheduleMaintenanceRequest struct {
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+	Message  string    `json:"message"`
+}
+
+// MaintenanceNotice is a maintenance window announced to a draft in progress
+type MaintenanceNotice struct {
+	DraftID     uuid.UUID
+	Window      models.MaintenanceWindow
+	AnnouncedAt time.Time
+}
+
+// MaintenanceRun counts what a maintenance run did
+type MaintenanceRun struct {
+	Announced int // drafts told of a window about to open
+	Paused    int // drafts paused as a window opened
+	Resumed   int // drafts resumed after their window closed or was cancelled
+}
+
+// MaintenancePause is a draft paused for a maintenance window
+type MaintenancePause struct {
+	DraftID  uuid.UUID
+	Window   models.MaintenanceWindow
+	PausedAt time.Time
+}
+
+// CreateDraftWebhookRequest registers an endpoint to post a draft's pick events to
+type CreateDraftWebhookRequest struct {
+	DraftID     uuid.UUID
+	URL         string
+	Description *string
+	CreatedBy   *uuid.UUID // nil when created by a service rather than a user
+}
+
+// IssuedDraftWebhook is a newly created webhook and the secret its deliveries are signed
+// with, which can't be retrieved again
+type IssuedDraftWebhook struct {
+	Webhook *models.DraftWebhook
+	Secret  string
+}
+
+// AddDraftCoManagerRequest lets a user besides a team's owner make the team's picks in a draft
+type AddDraftCoManagerRequest struct {
+	DraftID       uuid.UUID
+	FantasyTeamID uuid.UUID
+	UserID        uuid.UUID
+	AddedBy       *uuid.UUID // nil when added by a service rather than a user
+}
+
+// RemoveDraftCoManagerRequest stops a co-manager making a team's picks in a draft
+type RemoveDraftCoManagerRequest struct {
+	DraftID       uuid.UUID
+	FantasyTeamID uuid.UUID
+	UserID        uuid.UUID
+	RemovedBy     *uuid.UUID // nil when removed by a service rather than a user
+}
+
+// StartPauseVoteRequest opens a vote to pause a running draft, with the initiating team voting for it
+type StartPauseVoteRequest struct {
+	DraftID       uuid.UUID
+	FantasyTeamID uuid.UUID
+	Reason        *string
+	StartedBy     *uuid.UUID // nil when started by a service rather than a user
+}
+
+// CastPauseVoteRequest casts or changes a team's ballot in an open pause vote
+type CastPauseVoteRequest struct {
+	DraftID       uuid.UUID
+	VoteID        uuid.UUID
+	FantasyTeamID uuid.UUID
+	InFavor       bool
+	CastBy        *uuid.UUID // nil when cast by a service rather than a user
+}
+
+// ClosePauseVoteResult is a pause vote as it stands after closing it. Closed is false when it
+// was already closed, and Paused is set when the vote passed and paused the running draft.
+type ClosePauseVoteResult struct {
+	Vote   *models.PauseVote
+	Closed bool
+	Paused bool
+}
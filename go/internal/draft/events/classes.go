@@ -0,0 +1,98 @@
+package events
+
+import "strings"
+
+// EventClass groups draft event types by how they're delivered. Each class is published under
+// its own subject hierarchy, draft.<class>.<EventType>, so consumers only bind to the classes
+// they handle.
+type EventClass string
+
+const (
+	// ClassLifecycle is the draft starting, pausing, resuming and finishing, votes to pause
+	// it, and teams leaving or rejoining it
+	ClassLifecycle EventClass = "lifecycle"
+	// ClassPicks is the pick clock and the picks made on it
+	ClassPicks EventClass = "picks"
+	// ClassActivity is the high-volume chatter around a draft room: the lobby, auction bids,
+	// slot selection and player news. Nothing is derived from it, so it is kept only briefly.
+	ClassActivity EventClass = "activity"
+)
+
+// Draft events are spread across two JetStream streams. Lifecycle and pick events share the
+// state stream so consumers see them in the draft's sequence order, which the gateway's
+// projection and snapshot fences depend on. Activity events get a stream of their own, so a
+// burst of them can't hold up picks.
+const (
+	// StateStream is the JetStream stream carrying lifecycle and pick events
+	StateStream = "DRAFT_STATE"
+	// ActivityStream is the JetStream stream carrying activity events
+	ActivityStream = "DRAFT_ACTIVITY"
+
+	// LegacyStream is the stream every draft event was published to before events were split
+	// by class; the stream migration command copies it into the class streams
+	LegacyStream = "DRAFT_EVENTS"
+	// LegacySubjectPrefix prefixed every subject on the legacy stream
+	LegacySubjectPrefix = "draft.events"
+)
+
+// Classes returns every event class in the order their streams are set up
+func Classes() []EventClass {
+	return []EventClass{ClassLifecycle, ClassPicks, ClassActivity}
+}
+
+// Class returns the class of an event type, or an empty class if it isn't registered
+func Class(eventType string) EventClass {
+	return registry[eventType].class
+}
+
+// Subject returns the subject an event type is published on, or an empty string if it isn't
+// registered
+func Subject(eventType string) string {
+	class := Class(eventType)
+	if class == "" {
+		return ""
+	}
+	return class.SubjectPrefix() + "." + eventType
+}
+
+// SubjectPrefix prefixes the subjects of the class's event types
+func (c EventClass) SubjectPrefix() string {
+	return "draft." + string(c)
+}
+
+// SubjectFilter matches every subject of the class
+func (c EventClass) SubjectFilter() string {
+	return c.SubjectPrefix() + ".>"
+}
+
+// Stream returns the JetStream stream the class is published to
+func (c EventClass) Stream() string {
+	if c == ClassActivity {
+		return ActivityStream
+	}
+	return StateStream
+}
+
+// Sequenced reports whether the class's events reach consumers in the order of their draft
+// sequence. Activity events travel on their own stream and can overtake the events around
+// them, so their sequence only says when they were written.
+func (c EventClass) Sequenced() bool {
+	return c.Stream() == StateStream
+}
+
+// StreamSubjects returns the subject filters of every class published to a stream
+func StreamSubjects(stream string) []string {
+	var subjects []string
+	for _, class := range Classes() {
+		if class.Stream() == stream {
+			subjects = append(subjects, class.SubjectFilter())
+		}
+	}
+	return subjects
+}
+
+// LegacyEventType returns the event type a legacy stream subject was published for
+func LegacyEventType(subject string) (string, bool) {
+	eventType, ok := strings.CutPrefix(subject, LegacySubjectPrefix+".")
+	return eventType, ok && eventType != "" && !strings.Contains(eventType, ".")
+}
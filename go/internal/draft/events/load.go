@@ -0,0 +1,30 @@
+package events
+
+import "time"
+
+// OrchestratorLoadSubject is the core NATS subject each orchestrator instance publishes its
+// load on. Reports aren't draft events: they're published straight to NATS rather than through
+// the outbox, aren't kept on a stream, and are only useful until the next one.
+const OrchestratorLoadSubject = "draft.orchestrator.load"
+
+// OrchestratorLoadPayload reports whether an orchestrator instance is behind schedule, handling
+// expired pick clocks later than they ran out. It is published every load check while the
+// instance is behind, and once more when it has caught up.
+type OrchestratorLoadPayload struct {
+	InstanceID string `json:"instance_id"`
+	Behind     bool   `json:"behind"`
+	// Queued is how many expired pick clocks are waiting for a worker
+	Queued int `json:"queued"`
+	// OldestWaitMs is how long the most overdue of them has been waiting
+	OldestWaitMs int64 `json:"oldest_wait_ms"`
+	// DelayedDrafts are the drafts waiting, most overdue first; capped, so it may be fewer
+	// than Queued
+	DelayedDrafts []DelayedDraft `json:"delayed_drafts,omitempty"`
+	ReportedAt    time.Time      `json:"reported_at"`
+}
+
+// DelayedDraft is a draft whose expired pick clock is waiting for a worker
+type DelayedDraft struct {
+	DraftID string `json:"draft_id"`
+	WaitMs  int64  `json:"wait_ms"`
+}
@@ -16,19 +16,361 @@ type PickStartedPayload struct {
 	StartedAt      time.Time `json:"started_at"`
 	TimeoutAt      time.Time `json:"timeout_at"`
 	TimePerPickSec int       `json:"time_per_pick_sec"`
+	// Resume is set when the pick clock starts, or carries on, because the draft resumed
+	Resume *ResumeContext `json:"resume,omitempty"`
+}
+
+// ResumeContext describes the resume that started a pick's clock
+type ResumeContext struct {
+	ResumedAt time.Time `json:"resumed_at"`
+	PicksMade int       `json:"picks_made"` // picks made before the pause
 }
 
 // PickMadePayload is the payload for a PickMade event
 type PickMadePayload struct {
+	PickID         string    `json:"pick_id"`
+	TeamID         string    `json:"team_id"`
+	TeamName       string    `json:"team_name"`
+	PlayerID       string    `json:"player_id"`
+	PlayerName     string    `json:"player_name"`
+	PlayerPosition string    `json:"player_position,omitempty"`
+	NFLTeamCode    string    `json:"nfl_team_code,omitempty"` // empty for free agents
+	Round          int       `json:"round"`
+	Pick           int       `json:"pick"`
+	OverallPick    int       `json:"overall_pick"`
+	MadeAt         time.Time `json:"made_at"`
+	AuctionAmount  *float64  `json:"auction_amount,omitempty"` // winning bid in auction drafts
+	// Late is set when a skipped pick is made out of board order; the pick on the clock is unaffected
+	Late bool `json:"late,omitempty"`
+	// PickedBy is the user who made the pick: the team's owner, one of its co-managers, or the
+	// commissioner proxy drafting for an absent owner. Empty for auto-picks and auction sales.
+	PickedBy string `json:"picked_by,omitempty"`
+}
+
+// PickSkippedPayload is the payload for a PickSkipped event, emitted when a pick's clock
+// runs out in a draft that defers picks on timeout, in which case the team can still make the
+// pick late, or when the pick is forfeited because its team has no roster space left.
+type PickSkippedPayload struct {
 	PickID      string    `json:"pick_id"`
 	TeamID      string    `json:"team_id"`
-	TeamName    string    `json:"team_name"`
-	PlayerID    string    `json:"player_id"`
-	PlayerName  string    `json:"player_name"`
 	Round       int       `json:"round"`
 	Pick        int       `json:"pick"`
 	OverallPick int       `json:"overall_pick"`
-	MadeAt      time.Time `json:"made_at"`
+	SkippedAt   time.Time `json:"skipped_at"`
+	Forfeited   bool      `json:"forfeited,omitempty"` // the pick is never made
+	Reason      string    `json:"reason,omitempty"`    // SkipReasonNoRosterSpace for forfeited picks
+}
+
+// SkipReasonNoRosterSpace is the PickSkipped reason for a pick forfeited by a team whose roster is full
+const SkipReasonNoRosterSpace = "NO_ROSTER_SPACE"
+
+// PickClockWarningPayload is the payload for a PickClockWarning event, emitted when a pick
+// clock passes one of the draft's clock warning thresholds
+type PickClockWarningPayload struct {
+	PickID           string    `json:"pick_id"`
+	TeamID           string    `json:"team_id"`
+	Round            int       `json:"round"`
+	Pick             int       `json:"pick"`
+	OverallPick      int       `json:"overall_pick"`
+	PercentRemaining int       `json:"percent_remaining"`
+	TimeoutAt        time.Time `json:"timeout_at"`
+	// Final is set for the last warning before the clock runs out, which is also emailed and
+	// pushed to the team's owner
+	Final bool `json:"final,omitempty"`
+}
+
+// PickClockExtendedPayload is the payload for a PickClockExtended event, emitted when the
+// commissioner gives the team on the clock a one-time extension of its pick clock
+type PickClockExtendedPayload struct {
+	PickID            string    `json:"pick_id"`
+	TeamID            string    `json:"team_id"`
+	Round             int       `json:"round"`
+	Pick              int       `json:"pick"`
+	OverallPick       int       `json:"overall_pick"`
+	ExtensionSec      int       `json:"extension_sec"`
+	PreviousTimeoutAt time.Time `json:"previous_timeout_at"`
+	TimeoutAt         time.Time `json:"timeout_at"`
+	GrantedBy         string    `json:"granted_by,omitempty"` // unset when granted by a service
+	ExtendedAt        time.Time `json:"extended_at"`
+}
+
+// PickSlotReassignedPayload is the payload for a PickSlotReassigned event
+type PickSlotReassignedPayload struct {
+	PickID       string    `json:"pick_id"`
+	Round        int       `json:"round"`
+	Pick         int       `json:"pick"`
+	OverallPick  int       `json:"overall_pick"`
+	FromTeamID   string    `json:"from_team_id"`
+	ToTeamID     string    `json:"to_team_id"`
+	Reason       string    `json:"reason,omitempty"`
+	ReassignedAt time.Time `json:"reassigned_at"`
+}
+
+// PickTradeUpdatedPayload is the payload for a PickTradeUpdated event, emitted when the team on
+// the clock offers its pick to another team during a draft and when the offer closes
+type PickTradeUpdatedPayload struct {
+	DraftID         string `json:"draft_id"`
+	OfferID         string `json:"offer_id"`
+	PickID          string `json:"pick_id"`
+	FromTeamID      string `json:"from_team_id"`
+	ToTeamID        string `json:"to_team_id"`
+	RequestedPickID string `json:"requested_pick_id,omitempty"` // the receiving team's pick asked for in return
+	Message         string `json:"message,omitempty"`
+	ProposedBy      string `json:"proposed_by,omitempty"`
+	Status          string `json:"status"` // OPEN, ACCEPTED, DECLINED, WITHDRAWN or EXPIRED
+	RespondedBy     string `json:"responded_by,omitempty"`
+	// ExpiresAt is when the negotiation window closes
+	ExpiresAt time.Time `json:"expires_at"`
+	// TimeoutAt is the pick deadline once the clock stopped for the offer or carried on after it;
+	// unset when the clock wasn't touched
+	TimeoutAt *time.Time `json:"timeout_at,omitempty"`
+	ClosedAt  *time.Time `json:"closed_at,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// TeamAbandonedPayload is the payload for a TeamAbandoned event, emitted when the commissioner
+// takes a team whose owner stopped taking part out of a draft
+type TeamAbandonedPayload struct {
+	DraftID          string    `json:"draft_id"`
+	FantasyTeamID    string    `json:"fantasy_team_id"`
+	PickHandling     string    `json:"pick_handling"` // AUTO_PICK or SKIP
+	Reason           string    `json:"reason,omitempty"`
+	ForfeitedPickIDs []string  `json:"forfeited_pick_ids,omitempty"` // set when picks are skipped
+	OnTheClock       bool      `json:"on_the_clock,omitempty"`       // the team held the pick on the clock
+	AbandonedAt      time.Time `json:"abandoned_at"`
+}
+
+// TeamRestoredPayload is the payload for a TeamRestored event, emitted when an abandoned
+// team is handed back to its owner
+type TeamRestoredPayload struct {
+	DraftID         string    `json:"draft_id"`
+	FantasyTeamID   string    `json:"fantasy_team_id"`
+	RestoredPickIDs []string  `json:"restored_pick_ids,omitempty"` // forfeited picks given back to the team
+	OnTheClock      bool      `json:"on_the_clock,omitempty"`      // the team is back on the clock
+	RestoredAt      time.Time `json:"restored_at"`
+}
+
+// LobbyUpdatedPayload is the payload for a LobbyUpdated event, emitted when a team marks
+// itself ready, or no longer ready, in the lobby before a draft starts
+type LobbyUpdatedPayload struct {
+	DraftID         string      `json:"draft_id"`
+	FantasyTeamID   string      `json:"fantasy_team_id"` // the team whose readiness changed
+	Ready           bool        `json:"ready"`
+	Teams           []LobbyTeam `json:"teams"` // every team in draft order
+	ReadyCount      int         `json:"ready_count"`
+	RequireAllReady bool        `json:"require_all_ready,omitempty"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+// LobbyTeam is a team's readiness in a draft's lobby
+type LobbyTeam struct {
+	TeamID string `json:"team_id"`
+	Ready  bool   `json:"ready"`
+}
+
+// PauseVoteUpdatedPayload is the payload for a PauseVoteUpdated event, emitted when a vote to
+// pause a draft opens, a team casts a ballot in it and it closes
+type PauseVoteUpdatedPayload struct {
+	DraftID          string     `json:"draft_id"`
+	VoteID           string     `json:"vote_id"`
+	Status           string     `json:"status"` // OPEN, PASSED or FAILED
+	InitiatingTeamID string     `json:"initiating_team_id"`
+	InitiatedBy      string     `json:"initiated_by,omitempty"`
+	Reason           string     `json:"reason,omitempty"`
+	FantasyTeamID    string     `json:"fantasy_team_id,omitempty"` // the team whose ballot was cast
+	VotesFor         int        `json:"votes_for"`
+	VotesAgainst     int        `json:"votes_against"`
+	EligibleTeams    int        `json:"eligible_teams"`
+	VotesNeeded      int        `json:"votes_needed"`
+	ClosesAt         time.Time  `json:"closes_at"`
+	ClosedAt         *time.Time `json:"closed_at,omitempty"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// CommissionerPresenceChangedPayload is the payload for a CommissionerPresenceChanged event,
+// emitted when the commissioner of a draft that pauses while they're away joins or leaves its room
+type CommissionerPresenceChangedPayload struct {
+	DraftID   string    `json:"draft_id"`
+	UserID    string    `json:"user_id"`
+	Online    bool      `json:"online"`
+	ChangedAt time.Time `json:"changed_at"`
+	// PausesAt is when an in-progress draft pauses unless the commissioner reconnects first
+	PausesAt *time.Time `json:"pauses_at,omitempty"`
+}
+
+// PlayerNewsPayload is the payload for a PlayerNews event, emitted to live drafts
+// when news breaks about a player that has been drafted or rostered in them
+type PlayerNewsPayload struct {
+	NewsID        string    `json:"news_id"`
+	PlayerID      string    `json:"player_id"`
+	FantasyTeamID string    `json:"fantasy_team_id"`
+	Headline      string    `json:"headline"`
+	URL           string    `json:"url,omitempty"`
+	Source        string    `json:"source"`
+	PublishedAt   time.Time `json:"published_at"`
+}
+
+// PlayerInjuryDesignatedPayload is the payload for a PlayerInjuryDesignated event, emitted to
+// the live drafts of a player's sport when a sync puts the player on an injury designation,
+// so draft rooms can flag them in the available list
+type PlayerInjuryDesignatedPayload struct {
+	PlayerID       string    `json:"player_id"`
+	PlayerName     string    `json:"player_name"`
+	Position       string    `json:"position,omitempty"`
+	Status         string    `json:"status"`
+	PreviousStatus string    `json:"previous_status,omitempty"`
+	DesignatedAt   time.Time `json:"designated_at"`
+}
+
+// Reasons a team is alerted to a player's injury designation during a draft
+const (
+	InjuryAlertDrafted = "drafted" // the team picked the player in the draft
+	InjuryAlertQueued  = "queued"  // the team queued the player for nomination
+)
+
+// PlayerInjuryAlertPayload is the payload for a PlayerInjuryAlert event, sent only to the
+// owner and co-managers of a team that drafted or queued a player put on an injury
+// designation during the draft
+type PlayerInjuryAlertPayload struct {
+	PlayerID      string    `json:"player_id"`
+	PlayerName    string    `json:"player_name"`
+	Status        string    `json:"status"`
+	FantasyTeamID string    `json:"fantasy_team_id"`
+	Reason        string    `json:"reason"`
+	UserIDs       []string  `json:"user_ids"`
+	DesignatedAt  time.Time `json:"designated_at"`
+}
+
+// WatchlistAlertPayload is the payload for a WatchlistAlert event, sent only to the user whose
+// watchlist has the player: when the pick on the clock comes within the user's alert window of
+// their team's next pick with the player still on the board, or when another team drafts them
+type WatchlistAlertPayload struct {
+	AlertID       string    `json:"alert_id"`
+	UserID        string    `json:"user_id"`
+	FantasyTeamID string    `json:"fantasy_team_id"` // the user's team
+	PlayerID      string    `json:"player_id"`
+	PlayerName    string    `json:"player_name"`
+	Kind          string    `json:"kind"`    // APPROACHING or DRAFTED
+	PickID        string    `json:"pick_id"` // the user's next pick when approaching, the pick that took the player when drafted
+	Round         int       `json:"round"`
+	Pick          int       `json:"pick"`
+	OverallPick   int       `json:"overall_pick"`
+	PickTeamID    string    `json:"pick_team_id"`
+	PicksAway     *int      `json:"picks_away,omitempty"` // picks left before the user's, approaching only
+	RaisedAt      time.Time `json:"raised_at"`
+}
+
+// SlotSelectionUpdatedPayload is the payload for a SlotSelectionUpdated event, emitted when
+// pre-draft slot selection starts and after every slot is claimed
+type SlotSelectionUpdatedPayload struct {
+	DraftID       string     `json:"draft_id"`
+	Status        string     `json:"status"`
+	CurrentTeamID string     `json:"current_team_id,omitempty"`
+	TurnDeadline  *time.Time `json:"turn_deadline,omitempty"`
+	ClaimedTeamID string     `json:"claimed_team_id,omitempty"`
+	ClaimedSlot   int        `json:"claimed_slot,omitempty"`
+	AutoAssigned  bool       `json:"auto_assigned,omitempty"`
+	DraftOrder    []string   `json:"draft_order,omitempty"` // final order, set once every team has chosen
+}
+
+// Auction actions reported by AuctionUpdatedPayload
+const (
+	AuctionActionNominationTurn = "NOMINATION_TURN" // a team is on the clock to nominate
+	AuctionActionLotOpened      = "LOT_OPENED"      // a player was put up for auction
+	AuctionActionPriceChanged   = "PRICE_CHANGED"   // a bid moved the price or leader
+	AuctionActionLotSold        = "LOT_SOLD"        // bidding closed and the player was drafted
+)
+
+// AuctionUpdatedPayload is the payload for an AuctionUpdated event, emitted as an auction
+// draft moves between nominations and bids. Teams' maximum bids are never included.
+type AuctionUpdatedPayload struct {
+	DraftID            string     `json:"draft_id"`
+	Action             string     `json:"action"`
+	NominatingTeamID   string     `json:"nominating_team_id,omitempty"`
+	NominationDeadline *time.Time `json:"nomination_deadline,omitempty"`
+	LotID              string     `json:"lot_id,omitempty"`
+	PlayerID           string     `json:"player_id,omitempty"`
+	NominatedBy        string     `json:"nominated_by,omitempty"`
+	CurrentPrice       float64    `json:"current_price,omitempty"`
+	LeadingTeamID      string     `json:"leading_team_id,omitempty"`
+	EndsAt             *time.Time `json:"ends_at,omitempty"`
+	AutoNominated      bool       `json:"auto_nominated,omitempty"` // nominated from the team's queue after its turn ran out
+	PickID             string     `json:"pick_id,omitempty"`        // set once the lot is sold
+}
+
+// DraftAnalyticsUpdatedPayload is the payload for a DraftAnalyticsUpdated event, emitted after
+// each pick with advisory analytics for the draft room: where each position has gone by round,
+// how many draftable players are left at each position, and any positional runs under way. Each
+// event replaces the last, so clients can render the latest without keeping history.
+type DraftAnalyticsUpdatedPayload struct {
+	DraftID     string `json:"draft_id"`
+	PickID      string `json:"pick_id"` // the pick the analytics were taken after
+	OverallPick int    `json:"overall_pick"`
+	// Heatmap counts the picks made at each position in each round, by round then position
+	Heatmap  []PositionRoundCount `json:"heatmap"`
+	Scarcity []PositionScarcity   `json:"scarcity"`
+	// Runs are the positions being drafted unusually often over the latest picks, most picks first
+	Runs       []PositionalRun `json:"runs,omitempty"`
+	AnalyzedAt time.Time       `json:"analyzed_at"`
+}
+
+// PositionRoundCount is how many picks in a round took players at a position
+type PositionRoundCount struct {
+	Round    int    `json:"round"`
+	Position string `json:"position"`
+	Count    int    `json:"count"`
+}
+
+// PositionScarcity is how far a position has been drafted down
+type PositionScarcity struct {
+	Position string `json:"position"`
+	Drafted  int    `json:"drafted"`
+	// Remaining is how many of the best ranked available players, taking as many as there are
+	// picks left, play the position
+	Remaining int `json:"remaining"`
+}
+
+// PositionalRun is a position taken with at least a run's share of the latest picks
+type PositionalRun struct {
+	Position string `json:"position"`
+	Picks    int    `json:"picks"`  // picks at the position among the latest Window picks
+	Window   int    `json:"window"` // how many of the latest picks were looked at
+	// StartedAt is the overall pick of the run's first pick still within the window
+	StartedAt int `json:"started_at"`
+	// New is set when the pick the analytics were taken after started the run
+	New bool `json:"new,omitempty"`
+}
+
+// DraftStartingSoonPayload is the payload for a DraftStartingSoon event, emitted at each
+// countdown checkpoint in the minutes before a draft's scheduled start
+type DraftStartingSoonPayload struct {
+	DraftID          string    `json:"draft_id"`
+	ScheduledAt      time.Time `json:"scheduled_at"`
+	MinutesBefore    int       `json:"minutes_before"`    // the checkpoint reached
+	SecondsRemaining int       `json:"seconds_remaining"` // until the scheduled start when announced
+	AnnouncedAt      time.Time `json:"announced_at"`
+}
+
+// DraftRescheduledPayload is the payload for a DraftRescheduled event, emitted when a draft yet
+// to start moves to a new scheduled start. League members are sent an updated calendar invite.
+type DraftRescheduledPayload struct {
+	DraftID             string     `json:"draft_id"`
+	ScheduledAt         time.Time  `json:"scheduled_at"`
+	PreviousScheduledAt *time.Time `json:"previous_scheduled_at,omitempty"` // none for a draft that wasn't scheduled
+	RescheduledBy       string     `json:"rescheduled_by,omitempty"`
+	RescheduledAt       time.Time  `json:"rescheduled_at"`
+}
+
+// MaintenanceScheduledPayload is the payload for a MaintenanceScheduled event, emitted to a
+// draft in progress shortly before a maintenance window opens. The draft pauses when it opens
+// and resumes when it closes.
+type MaintenanceScheduledPayload struct {
+	DraftID     string    `json:"draft_id"`
+	WindowID    string    `json:"window_id"`
+	StartsAt    time.Time `json:"starts_at"`
+	EndsAt      time.Time `json:"ends_at"`
+	Message     string    `json:"message,omitempty"`
+	AnnouncedAt time.Time `json:"announced_at"`
 }
 
 // DraftStartedPayload is the payload for a DraftStarted event
@@ -48,15 +390,55 @@ type DraftCompletedPayload struct {
 	TotalPicks  int       `json:"total_picks"`
 }
 
+// DraftSummaryPayload is the payload for a DraftSummary event, emitted for each team of a
+// completed draft along with, and just ahead of, its DraftCompleted event
+type DraftSummaryPayload struct {
+	DraftID        string `json:"draft_id"`
+	TeamID         string `json:"team_id"`
+	TotalPicks     int    `json:"total_picks"`     // the team's pick slots
+	PlayersDrafted int    `json:"players_drafted"` // slots the team made a pick with, keepers included
+	KeeperPicks    int    `json:"keeper_picks"`
+	ForfeitedPicks int    `json:"forfeited_picks"`
+}
+
 // DraftPausedPayload is the payload for a DraftPaused event
 type DraftPausedPayload struct {
-	DraftID  string    `json:"draft_id"`
-	PausedAt time.Time `json:"paused_at"`
-	Reason   string    `json:"reason"`
+	DraftID   string     `json:"draft_id"`
+	PausedAt  time.Time  `json:"paused_at"`
+	Reason    string     `json:"reason"`
+	Scheduled bool       `json:"scheduled,omitempty"`  // paused by the draft's pause window
+	ResumesAt *time.Time `json:"resumes_at,omitempty"` // when the pause window closes
+	// Paused because the commissioner was away from the draft room; resumes when they reconnect
+	CommissionerDisconnected bool `json:"commissioner_disconnected,omitempty"`
+	// Paused for a maintenance window; resumes at ResumesAt, when the window closes
+	Maintenance bool `json:"maintenance,omitempty"`
 }
 
 // DraftResumedPayload is the payload for a DraftResumed event
 type DraftResumedPayload struct {
 	DraftID   string    `json:"draft_id"`
 	ResumedAt time.Time `json:"resumed_at"`
-}
\ No newline at end of file
+	Scheduled bool      `json:"scheduled,omitempty"` // resumed because the pause window closed
+}
+
+// DraftCatchUpPayload is the payload for a DraftCatchUp event, emitted when a draft resumes
+// after its pause window so reconnecting clients can catch up without diffing the board
+type DraftCatchUpPayload struct {
+	DraftID     string              `json:"draft_id"`
+	ResumedAt   time.Time           `json:"resumed_at"`
+	PicksMade   int                 `json:"picks_made"`
+	TotalPicks  int                 `json:"total_picks"`
+	RecentPicks []CatchUpPick       `json:"recent_picks"` // the last picks made before the pause, newest first
+	OnTheClock  *PickStartedPayload `json:"on_the_clock,omitempty"`
+}
+
+// CatchUpPick is a pick made before a pause, as summarized in DraftCatchUpPayload
+type CatchUpPick struct {
+	PickID      string    `json:"pick_id"`
+	TeamID      string    `json:"team_id"`
+	PlayerID    string    `json:"player_id"`
+	Round       int       `json:"round"`
+	Pick        int       `json:"pick"`
+	OverallPick int       `json:"overall_pick"`
+	PickedAt    time.Time `json:"picked_at"`
+}
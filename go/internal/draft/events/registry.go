@@ -0,0 +1,331 @@
+package events
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Draft outbox event types
+const (
+	PickMade                    = "PickMade"
+	PickStarted                 = "PickStarted"
+	PickSlotReassigned          = "PickSlotReassigned"
+	PickSkipped                 = "PickSkipped"
+	PickClockWarning            = "PickClockWarning"
+	PickClockExtended           = "PickClockExtended"
+	PickTradeUpdated            = "PickTradeUpdated"
+	TeamAbandoned               = "TeamAbandoned"
+	TeamRestored                = "TeamRestored"
+	PlayerNews                  = "PlayerNews"
+	PlayerInjuryDesignated      = "PlayerInjuryDesignated"
+	PlayerInjuryAlert           = "PlayerInjuryAlert"
+	WatchlistAlert              = "WatchlistAlert"
+	SlotSelectionUpdated        = "SlotSelectionUpdated"
+	LobbyUpdated                = "LobbyUpdated"
+	PauseVoteUpdated            = "PauseVoteUpdated"
+	CommissionerPresenceChanged = "CommissionerPresenceChanged"
+	AuctionUpdated              = "AuctionUpdated"
+	DraftAnalyticsUpdated       = "DraftAnalyticsUpdated"
+	DraftStartingSoon           = "DraftStartingSoon"
+	DraftRescheduled            = "DraftRescheduled"
+	MaintenanceScheduled        = "MaintenanceScheduled"
+	DraftStarted                = "DraftStarted"
+	DraftPaused                 = "DraftPaused"
+	DraftResumed                = "DraftResumed"
+	DraftCatchUp                = "DraftCatchUp"
+	DraftSummary                = "DraftSummary"
+	DraftCompleted              = "DraftCompleted"
+)
+
+var (
+	// ErrUnknownEventType is returned when validating a payload for an event type that isn't registered
+	ErrUnknownEventType = errors.New("unknown event type")
+	// ErrInvalidPayload is returned for a payload that doesn't match its event type's schema
+	ErrInvalidPayload = errors.New("payload does not match schema")
+)
+
+// registration pairs an event type's payload struct with the version of its schema. Bump the
+// version for any change that breaks readers of the other version: removing or renaming a
+// field, changing its type, adding a field that is always present, or adding or dropping
+// omitempty on a field. Adding an omitempty field is compatible and keeps the version.
+type registration struct {
+	version int
+	class   EventClass
+	payload any
+}
+
+var registry = map[string]registration{
+	PickMade:                    {version: 1, class: ClassPicks, payload: PickMadePayload{}},
+	PickStarted:                 {version: 1, class: ClassPicks, payload: PickStartedPayload{}},
+	PickSlotReassigned:          {version: 1, class: ClassPicks, payload: PickSlotReassignedPayload{}},
+	PickSkipped:                 {version: 1, class: ClassPicks, payload: PickSkippedPayload{}},
+	PickClockWarning:            {version: 1, class: ClassPicks, payload: PickClockWarningPayload{}},
+	PickClockExtended:           {version: 1, class: ClassPicks, payload: PickClockExtendedPayload{}},
+	PickTradeUpdated:            {version: 1, class: ClassPicks, payload: PickTradeUpdatedPayload{}},
+	TeamAbandoned:               {version: 1, class: ClassLifecycle, payload: TeamAbandonedPayload{}},
+	TeamRestored:                {version: 1, class: ClassLifecycle, payload: TeamRestoredPayload{}},
+	PlayerNews:                  {version: 1, class: ClassActivity, payload: PlayerNewsPayload{}},
+	PlayerInjuryDesignated:      {version: 1, class: ClassActivity, payload: PlayerInjuryDesignatedPayload{}},
+	PlayerInjuryAlert:           {version: 1, class: ClassActivity, payload: PlayerInjuryAlertPayload{}},
+	WatchlistAlert:              {version: 1, class: ClassActivity, payload: WatchlistAlertPayload{}},
+	SlotSelectionUpdated:        {version: 1, class: ClassActivity, payload: SlotSelectionUpdatedPayload{}},
+	LobbyUpdated:                {version: 1, class: ClassActivity, payload: LobbyUpdatedPayload{}},
+	PauseVoteUpdated:            {version: 1, class: ClassLifecycle, payload: PauseVoteUpdatedPayload{}},
+	CommissionerPresenceChanged: {version: 1, class: ClassLifecycle, payload: CommissionerPresenceChangedPayload{}},
+	AuctionUpdated:              {version: 1, class: ClassActivity, payload: AuctionUpdatedPayload{}},
+	DraftAnalyticsUpdated:       {version: 1, class: ClassActivity, payload: DraftAnalyticsUpdatedPayload{}},
+	DraftStartingSoon:           {version: 1, class: ClassLifecycle, payload: DraftStartingSoonPayload{}},
+	DraftRescheduled:            {version: 1, class: ClassLifecycle, payload: DraftRescheduledPayload{}},
+	MaintenanceScheduled:        {version: 1, class: ClassLifecycle, payload: MaintenanceScheduledPayload{}},
+	DraftStarted:                {version: 1, class: ClassLifecycle, payload: DraftStartedPayload{}},
+	DraftPaused:                 {version: 1, class: ClassLifecycle, payload: DraftPausedPayload{}},
+	DraftResumed:                {version: 1, class: ClassLifecycle, payload: DraftResumedPayload{}},
+	DraftCatchUp:                {version: 1, class: ClassLifecycle, payload: DraftCatchUpPayload{}},
+	DraftSummary:                {version: 1, class: ClassLifecycle, payload: DraftSummaryPayload{}},
+	DraftCompleted:              {version: 1, class: ClassLifecycle, payload: DraftCompletedPayload{}},
+}
+
+// Types returns every registered event type, sorted
+func Types() []string {
+	types := make([]string, 0, len(registry))
+	for eventType := range registry {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// PayloadType returns the payload struct type registered for eventType, or nil if it isn't registered
+func PayloadType(eventType string) reflect.Type {
+	reg, ok := registry[eventType]
+	if !ok {
+		return nil
+	}
+	return reflect.TypeOf(reg.payload)
+}
+
+// SchemaVersion returns the payload schema version of an event type, or 0 if it isn't registered
+func SchemaVersion(eventType string) int {
+	return registry[eventType].version
+}
+
+// Validate checks that payload is a JSON object of the shape registered for eventType: no
+// fields the payload struct doesn't declare, every field decodes into its declared type, and
+// every field without omitempty is present.
+func Validate(eventType string, payload []byte) error {
+	reg, ok := registry[eventType]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownEventType, eventType)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(reflect.New(reflect.TypeOf(reg.payload)).Interface()); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidPayload, eventType, err)
+	}
+
+	var present map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &present); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidPayload, eventType, err)
+	}
+	for _, field := range shapeOf(reflect.TypeOf(reg.payload)) {
+		if _, ok := present[field.Name]; !ok && !field.Optional {
+			return fmt.Errorf("%w: %s: missing field %q", ErrInvalidPayload, eventType, field.Name)
+		}
+	}
+	return nil
+}
+
+// Schema is the JSON shape of one version of an event type's payload
+type Schema struct {
+	Version int     `json:"version"`
+	Fields  []Field `json:"fields"`
+}
+
+// Field is one field of a payload's JSON shape. Objects, and arrays of objects, list their fields.
+type Field struct {
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Optional bool    `json:"optional,omitempty"` // omitempty, so consumers can't rely on it being present
+	Fields   []Field `json:"fields,omitempty"`
+}
+
+// Schemas returns the current schema of every registered event type, keyed by event type
+func Schemas() map[string]Schema {
+	schemas := make(map[string]Schema, len(registry))
+	for eventType, reg := range registry {
+		schemas[eventType] = Schema{
+			Version: reg.version,
+			Fields:  shapeOf(reflect.TypeOf(reg.payload)),
+		}
+	}
+	return schemas
+}
+
+//go:embed schemas.json
+var snapshotJSON []byte
+
+// CheckSnapshot compares the current schemas to the snapshot in schemas.json, which records
+// the schemas consumers were last built against. It reports payload changes that break
+// consumers without a version bump, and a snapshot that is out of date with the payload
+// structs. go test checks it; regenerate it with make update-event-schemas once a change is
+// intended.
+func CheckSnapshot() ([]string, error) {
+	var snapshot map[string]Schema
+	if err := json.Unmarshal(snapshotJSON, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to read schema snapshot: %w", err)
+	}
+	return compareSchemas(snapshot, Schemas()), nil
+}
+
+// SnapshotJSON renders the current schemas in the format of schemas.json
+func SnapshotJSON() ([]byte, error) {
+	out, err := json.MarshalIndent(Schemas(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+// compareSchemas lists the differences between the snapshot and the current schemas that
+// need attention, sorted by event type
+func compareSchemas(snapshot, current map[string]Schema) []string {
+	var problems []string
+	for eventType, old := range snapshot {
+		if _, ok := current[eventType]; !ok {
+			problems = append(problems, fmt.Sprintf("%s: event type removed; consumers may still expect it", eventType))
+			continue
+		}
+		cur := current[eventType]
+		breaking := compareFields("", old.Fields, cur.Fields)
+
+		switch {
+		case cur.Version < old.Version:
+			problems = append(problems, fmt.Sprintf("%s: version went back from %d to %d", eventType, old.Version, cur.Version))
+		case cur.Version == old.Version && len(breaking) > 0:
+			for _, change := range breaking {
+				problems = append(problems, fmt.Sprintf("%s v%d: %s without a version bump", eventType, cur.Version, change))
+			}
+		case !reflect.DeepEqual(old, cur):
+			problems = append(problems, fmt.Sprintf("%s: schema snapshot is out of date", eventType))
+		}
+	}
+	for eventType := range current {
+		if _, ok := snapshot[eventType]; !ok {
+			problems = append(problems, fmt.Sprintf("%s: event type missing from the schema snapshot", eventType))
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+// compareFields lists the changes from old to cur that break either consumers built
+// against old or consumers of cur reading events written under old
+func compareFields(prefix string, old, cur []Field) []string {
+	byName := make(map[string]Field, len(cur))
+	for _, f := range cur {
+		byName[f.Name] = f
+	}
+
+	var changes []string
+	for _, o := range old {
+		name := prefix + o.Name
+		c, ok := byName[o.Name]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("field %q removed", name))
+		case c.Type != o.Type:
+			changes = append(changes, fmt.Sprintf("field %q changed from %s to %s", name, o.Type, c.Type))
+		case c.Optional && !o.Optional:
+			changes = append(changes, fmt.Sprintf("field %q became optional", name))
+		case !c.Optional && o.Optional:
+			changes = append(changes, fmt.Sprintf("field %q became required", name))
+		default:
+			changes = append(changes, compareFields(name+".", o.Fields, c.Fields)...)
+		}
+	}
+
+	known := make(map[string]bool, len(old))
+	for _, o := range old {
+		known[o.Name] = true
+	}
+	for _, c := range cur {
+		if !known[c.Name] && !c.Optional {
+			changes = append(changes, fmt.Sprintf("required field %q added", prefix+c.Name))
+		}
+	}
+	return changes
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// shapeOf describes the JSON fields of a struct type as encoding/json marshals them
+func shapeOf(t reflect.Type) []Field {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var fields []Field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+
+		typeName, nested := typeOf(sf.Type)
+		fields = append(fields, Field{
+			Name:     name,
+			Type:     typeName,
+			Optional: strings.Contains(","+opts+",", ",omitempty,"),
+			Fields:   nested,
+		})
+	}
+	return fields
+}
+
+// typeOf names a Go type's JSON type, along with the fields of objects and arrays of objects
+func typeOf(t reflect.Type) (string, []Field) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return "timestamp", nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return "string", nil
+	case reflect.Bool:
+		return "boolean", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer", nil
+	case reflect.Float32, reflect.Float64:
+		return "number", nil
+	case reflect.Slice, reflect.Array:
+		elem, nested := typeOf(t.Elem())
+		return "array<" + elem + ">", nested
+	case reflect.Map:
+		elem, nested := typeOf(t.Elem())
+		return "map<" + elem + ">", nested
+	case reflect.Struct:
+		return "object", shapeOf(t)
+	default:
+		return t.Kind().String(), nil
+	}
+}
@@ -0,0 +1,33 @@
+package events
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "write the current schemas to the snapshot instead of checking them")
+
+// TestSchemaSnapshot checks the outbox event payloads against the schema snapshot consumers
+// were built against. Bump an event's schema version for breaking changes, then run with
+// -update to accept them.
+func TestSchemaSnapshot(t *testing.T) {
+	if *update {
+		out, err := SnapshotJSON()
+		if err != nil {
+			t.Fatalf("failed to render schemas: %v", err)
+		}
+		if err := os.WriteFile("schemas.json", out, 0o644); err != nil {
+			t.Fatalf("failed to write schema snapshot: %v", err)
+		}
+		return
+	}
+
+	problems, err := CheckSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, problem := range problems {
+		t.Error(problem)
+	}
+}
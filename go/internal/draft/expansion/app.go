@@ -0,0 +1,171 @@
+package expansion
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// ExpansionRepository defines what the expansion app layer needs from the repository
+type ExpansionRepository interface {
+	GetDraft(ctx context.Context, draftID uuid.UUID) (*Draft, error)
+	GetTeam(ctx context.Context, teamID uuid.UUID) (*Team, error)
+	ListUnstartedDrafts(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error)
+	InsertPickSlots(ctx context.Context, draftID, teamID uuid.UUID, slot int) (*PickSlot, error)
+	ReplaceProtectionList(ctx context.Context, req SubmitProtectionListRequest) (*models.ExpansionProtectionList, error)
+	GetProtectionList(ctx context.Context, draftID, teamID uuid.UUID) (*models.ExpansionProtectionList, error)
+	ListProtectionLists(ctx context.Context, draftID uuid.UUID) ([]models.ExpansionProtectionList, map[uuid.UUID]uuid.UUID, error)
+	ListExpansionPool(ctx context.Context, draftID uuid.UUID) ([]models.ExpansionPoolPlayer, error)
+}
+
+// App handles expansion team business logic
+type App struct {
+	repo ExpansionRepository
+}
+
+// NewApp creates a new expansion App
+func NewApp(repo ExpansionRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// InsertExpansionPickSlots adds an expansion team to the draft order of every draft of its
+// league that hasn't started, with a pick in each round of drafts whose picks have been
+// generated. Drafts the team is already in are skipped, so a call that fails part way can be
+// retried. Commissioner only.
+func (a *App) InsertExpansionPickSlots(ctx context.Context, req InsertPickSlotsRequest) ([]PickSlot, error) {
+	if req.Slot < 0 {
+		return nil, fmt.Errorf("%w: slot cannot be negative", ErrInvalidSlot)
+	}
+	team, err := a.repo.GetTeam(ctx, req.FantasyTeamID)
+	if err != nil {
+		return nil, err
+	}
+	if req.RequestedBy != nil && *req.RequestedBy != team.CommissionerID {
+		return nil, ErrNotCommissioner
+	}
+
+	draftIDs, err := a.repo.ListUnstartedDrafts(ctx, team.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	var slots []PickSlot
+	for _, draftID := range draftIDs {
+		slot, err := a.repo.InsertPickSlots(ctx, draftID, team.ID, req.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert pick slots into draft %s: %w", draftID, err)
+		}
+		if slot == nil {
+			continue
+		}
+		log.Printf("Inserted expansion team %s into draft %s at slot %d with %d picks", team.ID, draftID, slot.Slot, slot.PicksInserted)
+		slots = append(slots, *slot)
+	}
+	return slots, nil
+}
+
+// SubmitProtectionList replaces an existing team's protection list for an expansion draft that
+// hasn't started. The team's owner or the commissioner only.
+func (a *App) SubmitProtectionList(ctx context.Context, req SubmitProtectionListRequest) (*models.ExpansionProtectionList, error) {
+	draft, team, err := a.getDraftTeam(ctx, req.DraftID, req.FantasyTeamID)
+	if err != nil {
+		return nil, err
+	}
+	if req.SubmittedBy != nil && *req.SubmittedBy != team.OwnerID && *req.SubmittedBy != team.CommissionerID {
+		return nil, ErrNotTeamManager
+	}
+	if draft.Status != models.DraftStatusNotStarted {
+		return nil, ErrDraftStarted
+	}
+	if draft.InDraftOrder(team.ID) {
+		return nil, ErrExpansionTeam
+	}
+	if len(req.PlayerIDs) > draft.Settings.ProtectedPlayersPerTeam {
+		return nil, fmt.Errorf("%w: the draft allows %d", ErrTooManyProtectedPlayers, draft.Settings.ProtectedPlayersPerTeam)
+	}
+
+	list, err := a.repo.ReplaceProtectionList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Team %s protected %d players from expansion draft %s", team.ID, len(list.PlayerIDs), draft.ID)
+	return list, nil
+}
+
+// GetProtectionList retrieves a team's protection list. Until the draft starts, only the team's
+// owner and the commissioner may see it. A nil user is a trusted caller.
+func (a *App) GetProtectionList(ctx context.Context, draftID, teamID uuid.UUID, userID *uuid.UUID) (*models.ExpansionProtectionList, error) {
+	draft, team, err := a.getDraftTeam(ctx, draftID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if draft.Status == models.DraftStatusNotStarted && userID != nil && *userID != team.OwnerID && *userID != team.CommissionerID {
+		return nil, ErrNotTeamManager
+	}
+	return a.repo.GetProtectionList(ctx, draftID, teamID)
+}
+
+// ListProtectionLists lists the protection lists submitted for an expansion draft. Until the
+// draft starts, the players on lists of teams the user doesn't own are withheld unless the user
+// is the commissioner. A nil user is a trusted caller.
+func (a *App) ListProtectionLists(ctx context.Context, draftID uuid.UUID, userID *uuid.UUID) ([]models.ExpansionProtectionList, error) {
+	draft, err := a.getExpansionDraft(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, owners, err := a.repo.ListProtectionLists(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	if draft.Status != models.DraftStatusNotStarted || userID == nil || *userID == draft.CommissionerID {
+		return lists, nil
+	}
+	for i := range lists {
+		if owners[lists[i].FantasyTeamID] != *userID {
+			lists[i].PlayerIDs = []uuid.UUID{}
+			lists[i].PlayersWithheld = true
+		}
+	}
+	return lists, nil
+}
+
+// ListExpansionPool lists the players the expansion teams of a draft may still select
+func (a *App) ListExpansionPool(ctx context.Context, draftID uuid.UUID) ([]models.ExpansionPoolPlayer, error) {
+	if _, err := a.getExpansionDraft(ctx, draftID); err != nil {
+		return nil, err
+	}
+	return a.repo.ListExpansionPool(ctx, draftID)
+}
+
+// getExpansionDraft retrieves a draft, returning ErrNotExpansionDraft for other draft types
+func (a *App) getExpansionDraft(ctx context.Context, draftID uuid.UUID) (*Draft, error) {
+	draft, err := a.repo.GetDraft(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	if draft.Type != models.DraftTypeExpansion {
+		return nil, ErrNotExpansionDraft
+	}
+	return draft, nil
+}
+
+// getDraftTeam retrieves an expansion draft and a team of its league
+func (a *App) getDraftTeam(ctx context.Context, draftID, teamID uuid.UUID) (*Draft, *Team, error) {
+	draft, err := a.getExpansionDraft(ctx, draftID)
+	if err != nil {
+		return nil, nil, err
+	}
+	team, err := a.repo.GetTeam(ctx, teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if team.LeagueID != draft.LeagueID {
+		return nil, nil, ErrTeamNotInLeague
+	}
+	return draft, team, nil
+}
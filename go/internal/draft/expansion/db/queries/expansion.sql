@@ -0,0 +1,140 @@
+-- name: GetExpansionDraft :one
+-- A draft with the commissioner of its league.
+SELECT d.id,
+       d.league_id,
+       d.draft_type::text AS draft_type,
+       d.status::text     AS status,
+       d.settings,
+       l.commissioner_id
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE d.id = $1;
+
+-- name: GetExpansionTeam :one
+-- A fantasy team with the commissioner of its league.
+SELECT ft.id, ft.league_id, ft.owner_id, l.commissioner_id
+FROM fantasy_teams ft
+JOIN leagues l ON l.id = ft.league_id
+WHERE ft.id = $1;
+
+-- name: ListUnstartedLeagueDrafts :many
+-- The drafts of a league that haven't started, oldest first.
+SELECT id FROM draft
+WHERE league_id = $1
+  AND status = 'NOT_STARTED'
+ORDER BY created_at, id;
+
+-- name: IsSlotSelectionInProgress :one
+-- Whether a draft's teams are still choosing their draft slots.
+SELECT EXISTS (SELECT 1
+               FROM draft_slot_selections
+               WHERE draft_id = $1
+                 AND status = 'IN_PROGRESS') AS in_progress;
+
+-- name: ListDraftPickSlots :many
+SELECT id, round, pick, overall_pick FROM draft_picks
+WHERE draft_id = $1
+ORDER BY overall_pick;
+
+-- name: ParkDraftPickNumbers :exec
+-- Move a draft's overall pick numbers out of the way of the ones RenumberDraftPicks gives out,
+-- which the (draft_id, overall_pick) unique constraint would otherwise trip over mid-update.
+UPDATE draft_picks SET overall_pick = -overall_pick WHERE draft_id = $1;
+
+-- name: RenumberDraftPicks :exec
+UPDATE draft_picks dp
+SET pick         = r.pick,
+    overall_pick = r.overall_pick
+FROM unnest(@ids::uuid[], @picks::integer[], @overall_picks::integer[]) AS r(id, pick, overall_pick)
+WHERE dp.id = r.id;
+
+-- name: InsertDraftPicks :exec
+INSERT INTO draft_picks (id, draft_id, round, pick, overall_pick, team_id)
+SELECT unnest(@ids::uuid[]),
+       @draft_id::uuid,
+       unnest(@rounds::integer[]),
+       unnest(@picks::integer[]),
+       unnest(@overall_picks::integer[]),
+       @team_id::uuid;
+
+-- name: UpdateDraftOrder :exec
+UPDATE draft
+SET settings   = jsonb_set(settings, '{draft_order}', @draft_order::jsonb),
+    updated_at = NOW()
+WHERE id = @id;
+
+-- name: UpsertProtectionList :one
+INSERT INTO expansion_protection_lists (draft_id, fantasy_team_id, submitted_by)
+VALUES ($1, $2, $3)
+ON CONFLICT (draft_id, fantasy_team_id) DO UPDATE
+    SET submitted_by = EXCLUDED.submitted_by,
+        submitted_at = NOW()
+RETURNING *;
+
+-- name: DeleteProtectedPlayers :exec
+DELETE FROM expansion_protected_players
+WHERE draft_id = $1
+  AND fantasy_team_id = $2;
+
+-- name: InsertProtectedPlayers :exec
+INSERT INTO expansion_protected_players (draft_id, fantasy_team_id, player_id)
+SELECT @draft_id::uuid, @fantasy_team_id::uuid, unnest(@player_ids::uuid[]);
+
+-- name: GetProtectionList :one
+SELECT * FROM expansion_protection_lists
+WHERE draft_id = $1
+  AND fantasy_team_id = $2;
+
+-- name: ListProtectionLists :many
+-- The protection lists submitted for a draft, with the owner of each team.
+SELECT epl.draft_id, epl.fantasy_team_id, epl.submitted_by, epl.submitted_at, ft.owner_id
+FROM expansion_protection_lists epl
+JOIN fantasy_teams ft ON ft.id = epl.fantasy_team_id
+WHERE epl.draft_id = $1
+ORDER BY epl.submitted_at, epl.fantasy_team_id;
+
+-- name: ListProtectedPlayers :many
+SELECT fantasy_team_id, player_id FROM expansion_protected_players
+WHERE draft_id = $1
+ORDER BY fantasy_team_id, player_id;
+
+-- name: CountPlayersNotOnRoster :one
+-- How many of the players aren't on the team's roster.
+SELECT COUNT(*)
+FROM unnest(@player_ids::uuid[]) AS p(player_id)
+WHERE NOT EXISTS (SELECT 1
+                  FROM roster_players rp
+                  WHERE rp.fantasy_team_id = @fantasy_team_id
+                    AND rp.player_id = p.player_id);
+
+-- name: ListExpansionPool :many
+-- The players an expansion draft can still take, by name: rostered by a team in the draft's
+-- league outside the draft order, left off that team's protection list, not yet picked, and
+-- held by a team that hasn't lost max_players_lost_per_team players to the draft.
+SELECT rp.player_id, p.full_name, npp.position, rp.fantasy_team_id
+FROM draft d
+JOIN fantasy_teams ft ON ft.league_id = d.league_id
+JOIN roster_players rp ON rp.fantasy_team_id = ft.id
+JOIN players p ON p.id = rp.player_id
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = rp.player_id
+WHERE d.id = $1
+  AND NOT COALESCE(d.settings -> 'draft_order', '[]'::jsonb) @> to_jsonb(ft.id::text)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM expansion_protected_players epp
+    WHERE epp.draft_id = d.id
+      AND epp.fantasy_team_id = ft.id
+      AND epp.player_id = rp.player_id
+)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM draft_picks dp
+    WHERE dp.draft_id = d.id
+      AND dp.player_id = rp.player_id
+)
+  AND (COALESCE((d.settings ->> 'max_players_lost_per_team')::int, 0) = 0
+    OR (SELECT COUNT(*)
+        FROM expansion_selections es
+        WHERE es.draft_id = d.id
+          AND es.from_team_id = ft.id) < (d.settings ->> 'max_players_lost_per_team')::int)
+ORDER BY p.full_name, rp.player_id;
@@ -0,0 +1,341 @@
+package expansion
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/expansion/db"
+	"github.com/mcdev12/dynasty/go/internal/ids"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// Querier defines what the repository needs from the database layer outside a transaction
+type Querier interface {
+	GetExpansionDraft(ctx context.Context, id uuid.UUID) (db.GetExpansionDraftRow, error)
+	GetExpansionTeam(ctx context.Context, id uuid.UUID) (db.GetExpansionTeamRow, error)
+	GetProtectionList(ctx context.Context, arg db.GetProtectionListParams) (db.ExpansionProtectionList, error)
+	ListExpansionPool(ctx context.Context, id uuid.UUID) ([]db.ListExpansionPoolRow, error)
+	ListProtectedPlayers(ctx context.Context, draftID uuid.UUID) ([]db.ListProtectedPlayersRow, error)
+	ListProtectionLists(ctx context.Context, draftID uuid.UUID) ([]db.ListProtectionListsRow, error)
+	ListUnstartedLeagueDrafts(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// Repository implements expansion data access operations
+type Repository struct {
+	queries Querier
+	sqlDB   *sql.DB
+}
+
+// NewRepository creates a new expansion repository. sqlDB changes a draft's order and picks, or
+// a protection list, in a transaction holding the draft's lock.
+func NewRepository(querier Querier, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: querier,
+		sqlDB:   sqlDB,
+	}
+}
+
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
+// GetDraft retrieves a draft with its settings and its league's commissioner
+func (r *Repository) GetDraft(ctx context.Context, draftID uuid.UUID) (*Draft, error) {
+	return getDraft(ctx, r.queries, draftID)
+}
+
+func getDraft(ctx context.Context, q Querier, draftID uuid.UUID) (*Draft, error) {
+	row, err := q.GetExpansionDraft(ctx, draftID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDraftNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft: %w", err)
+	}
+
+	var settings models.DraftSettings
+	if err := json.Unmarshal(row.Settings, &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal draft settings: %w", err)
+	}
+	return &Draft{
+		ID:             row.ID,
+		LeagueID:       row.LeagueID,
+		Type:           models.DraftType(row.DraftType),
+		Status:         models.DraftStatus(row.Status),
+		Settings:       settings,
+		CommissionerID: row.CommissionerID,
+	}, nil
+}
+
+// GetTeam retrieves a fantasy team with its league's commissioner
+func (r *Repository) GetTeam(ctx context.Context, teamID uuid.UUID) (*Team, error) {
+	row, err := r.queries.GetExpansionTeam(ctx, teamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fantasy team: %w", err)
+	}
+	return &Team{
+		ID:             row.ID,
+		LeagueID:       row.LeagueID,
+		OwnerID:        row.OwnerID,
+		CommissionerID: row.CommissionerID,
+	}, nil
+}
+
+// ListUnstartedDrafts lists the IDs of a league's drafts that haven't started, oldest first
+func (r *Repository) ListUnstartedDrafts(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error) {
+	draftIDs, err := r.queries.ListUnstartedLeagueDrafts(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unstarted drafts: %w", err)
+	}
+	return draftIDs, nil
+}
+
+// InsertPickSlots adds a team to a draft's order at a 1-based slot, 0 for after the existing
+// teams, and gives it a pick in every round when the draft's picks have been generated. It
+// returns nil without changing anything for drafts that have started, expansion drafts and
+// drafts the team is already in.
+func (r *Repository) InsertPickSlots(ctx context.Context, draftID, teamID uuid.UUID, slot int) (*PickSlot, error) {
+	var inserted *PickSlot
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, draftID, txQueries, func(q *db.Queries) error {
+		inserted = nil
+		draft, err := getDraft(ctx, q, draftID)
+		if err != nil {
+			return err
+		}
+		if draft.Status != models.DraftStatusNotStarted || draft.Type == models.DraftTypeExpansion || draft.InDraftOrder(teamID) {
+			return nil
+		}
+
+		selecting, err := q.IsSlotSelectionInProgress(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("failed to check slot selection: %w", err)
+		}
+		if selecting {
+			return fmt.Errorf("%w: draft %s", ErrSlotSelectionInProgress, draftID)
+		}
+
+		order := draft.Settings.DraftOrder
+		teams := len(order)
+		position := slot
+		if position == 0 {
+			position = teams + 1
+		}
+		if position > teams+1 {
+			return fmt.Errorf("%w: draft %s has room for slots 1 to %d", ErrInvalidSlot, draftID, teams+1)
+		}
+
+		order = slices.Insert(slices.Clone(order), position-1, teamID)
+		orderJSON, err := json.Marshal(order)
+		if err != nil {
+			return fmt.Errorf("failed to marshal draft order: %w", err)
+		}
+		if err := q.UpdateDraftOrder(ctx, db.UpdateDraftOrderParams{DraftOrder: orderJSON, ID: draftID}); err != nil {
+			return fmt.Errorf("failed to update draft order: %w", err)
+		}
+
+		inserted = &PickSlot{DraftID: draftID, Slot: position}
+		rows, err := q.ListDraftPickSlots(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("failed to list draft picks: %w", err)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		picks := make([]PickNumber, len(rows))
+		for i, row := range rows {
+			picks[i] = PickNumber{ID: row.ID, Round: int(row.Round), Pick: int(row.Pick), OverallPick: int(row.OverallPick)}
+		}
+		// Auction nominations go round the table in draft order every round
+		orderMode := draft.Settings.EffectiveOrderMode()
+		reverses := func(round int) bool {
+			return draft.Type != models.DraftTypeAuction && orderMode.ReversesRound(round)
+		}
+		renumbered, added := LayoutPickSlots(picks, teams, draft.Settings.Rounds, position, reverses)
+
+		if err := q.ParkDraftPickNumbers(ctx, draftID); err != nil {
+			return fmt.Errorf("failed to park draft pick numbers: %w", err)
+		}
+		renumberParams := db.RenumberDraftPicksParams{}
+		for _, p := range renumbered {
+			renumberParams.Ids = append(renumberParams.Ids, p.ID)
+			renumberParams.Picks = append(renumberParams.Picks, int32(p.Pick))
+			renumberParams.OverallPicks = append(renumberParams.OverallPicks, int32(p.OverallPick))
+		}
+		if err := q.RenumberDraftPicks(ctx, renumberParams); err != nil {
+			return fmt.Errorf("failed to renumber draft picks: %w", err)
+		}
+
+		insertParams := db.InsertDraftPicksParams{DraftID: draftID, TeamID: teamID}
+		for _, p := range added {
+			insertParams.Ids = append(insertParams.Ids, ids.New())
+			insertParams.Rounds = append(insertParams.Rounds, int32(p.Round))
+			insertParams.Picks = append(insertParams.Picks, int32(p.Pick))
+			insertParams.OverallPicks = append(insertParams.OverallPicks, int32(p.OverallPick))
+		}
+		if err := q.InsertDraftPicks(ctx, insertParams); err != nil {
+			return fmt.Errorf("failed to insert draft picks: %w", err)
+		}
+		inserted.PicksInserted = len(added)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return inserted, nil
+}
+
+// ReplaceProtectionList replaces a team's protection list while its draft hasn't started,
+// returning ErrPlayerNotOnRoster when the team doesn't roster every player on it
+func (r *Repository) ReplaceProtectionList(ctx context.Context, req SubmitProtectionListRequest) (*models.ExpansionProtectionList, error) {
+	var list *models.ExpansionProtectionList
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID, txQueries, func(q *db.Queries) error {
+		draft, err := getDraft(ctx, q, req.DraftID)
+		if err != nil {
+			return err
+		}
+		if draft.Status != models.DraftStatusNotStarted {
+			return ErrDraftStarted
+		}
+
+		missing, err := q.CountPlayersNotOnRoster(ctx, db.CountPlayersNotOnRosterParams{
+			PlayerIds:     req.PlayerIDs,
+			FantasyTeamID: req.FantasyTeamID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check team roster: %w", err)
+		}
+		if missing > 0 {
+			return fmt.Errorf("%w: %d of the players aren't", ErrPlayerNotOnRoster, missing)
+		}
+
+		row, err := q.UpsertProtectionList(ctx, db.UpsertProtectionListParams{
+			DraftID:       req.DraftID,
+			FantasyTeamID: req.FantasyTeamID,
+			SubmittedBy:   sqlutil.ToNullUUID(req.SubmittedBy),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save protection list: %w", err)
+		}
+		if err := q.DeleteProtectedPlayers(ctx, db.DeleteProtectedPlayersParams{
+			DraftID:       req.DraftID,
+			FantasyTeamID: req.FantasyTeamID,
+		}); err != nil {
+			return fmt.Errorf("failed to clear protected players: %w", err)
+		}
+		if len(req.PlayerIDs) > 0 {
+			if err := q.InsertProtectedPlayers(ctx, db.InsertProtectedPlayersParams{
+				DraftID:       req.DraftID,
+				FantasyTeamID: req.FantasyTeamID,
+				PlayerIds:     req.PlayerIDs,
+			}); err != nil {
+				return fmt.Errorf("failed to save protected players: %w", err)
+			}
+		}
+
+		list = dbProtectionListToModel(row)
+		list.PlayerIDs = slices.Clone(req.PlayerIDs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// GetProtectionList retrieves a team's protection list with its players
+func (r *Repository) GetProtectionList(ctx context.Context, draftID, teamID uuid.UUID) (*models.ExpansionProtectionList, error) {
+	row, err := r.queries.GetProtectionList(ctx, db.GetProtectionListParams{DraftID: draftID, FantasyTeamID: teamID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrProtectionListNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get protection list: %w", err)
+	}
+
+	players, err := r.listProtectedPlayers(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	list := dbProtectionListToModel(row)
+	list.PlayerIDs = players[teamID]
+	return list, nil
+}
+
+// ListProtectionLists retrieves the protection lists submitted for a draft with their players,
+// and the owner of each list's team
+func (r *Repository) ListProtectionLists(ctx context.Context, draftID uuid.UUID) ([]models.ExpansionProtectionList, map[uuid.UUID]uuid.UUID, error) {
+	rows, err := r.queries.ListProtectionLists(ctx, draftID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list protection lists: %w", err)
+	}
+	players, err := r.listProtectedPlayers(ctx, draftID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lists := make([]models.ExpansionProtectionList, len(rows))
+	owners := make(map[uuid.UUID]uuid.UUID, len(rows))
+	for i, row := range rows {
+		lists[i] = *dbProtectionListToModel(db.ExpansionProtectionList{
+			DraftID:       row.DraftID,
+			FantasyTeamID: row.FantasyTeamID,
+			SubmittedBy:   row.SubmittedBy,
+			SubmittedAt:   row.SubmittedAt,
+		})
+		lists[i].PlayerIDs = players[row.FantasyTeamID]
+		owners[row.FantasyTeamID] = row.OwnerID
+	}
+	return lists, owners, nil
+}
+
+// listProtectedPlayers retrieves the players protected for a draft by team
+func (r *Repository) listProtectedPlayers(ctx context.Context, draftID uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	rows, err := r.queries.ListProtectedPlayers(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list protected players: %w", err)
+	}
+	players := make(map[uuid.UUID][]uuid.UUID)
+	for _, row := range rows {
+		players[row.FantasyTeamID] = append(players[row.FantasyTeamID], row.PlayerID)
+	}
+	return players, nil
+}
+
+// ListExpansionPool retrieves the players an expansion draft can still take, by name
+func (r *Repository) ListExpansionPool(ctx context.Context, draftID uuid.UUID) ([]models.ExpansionPoolPlayer, error) {
+	rows, err := r.queries.ListExpansionPool(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expansion pool: %w", err)
+	}
+
+	players := make([]models.ExpansionPoolPlayer, len(rows))
+	for i, row := range rows {
+		players[i] = models.ExpansionPoolPlayer{
+			PlayerID:      row.PlayerID,
+			FullName:      row.FullName,
+			Position:      sqlutil.FromSqlStringPtr(row.Position),
+			FantasyTeamID: row.FantasyTeamID,
+		}
+	}
+	return players, nil
+}
+
+func dbProtectionListToModel(row db.ExpansionProtectionList) *models.ExpansionProtectionList {
+	return &models.ExpansionProtectionList{
+		DraftID:       row.DraftID,
+		FantasyTeamID: row.FantasyTeamID,
+		PlayerIDs:     []uuid.UUID{},
+		SubmittedBy:   sqlutil.FromNullUUID(row.SubmittedBy),
+		SubmittedAt:   row.SubmittedAt,
+	}
+}
@@ -0,0 +1,209 @@
+package expansion
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExpansionApp defines what the service layer needs from the expansion application
+type ExpansionApp interface {
+	InsertExpansionPickSlots(ctx context.Context, req InsertPickSlotsRequest) ([]PickSlot, error)
+	SubmitProtectionList(ctx context.Context, req SubmitProtectionListRequest) (*models.ExpansionProtectionList, error)
+	GetProtectionList(ctx context.Context, draftID, teamID uuid.UUID, userID *uuid.UUID) (*models.ExpansionProtectionList, error)
+	ListProtectionLists(ctx context.Context, draftID uuid.UUID, userID *uuid.UUID) ([]models.ExpansionProtectionList, error)
+	ListExpansionPool(ctx context.Context, draftID uuid.UUID) ([]models.ExpansionPoolPlayer, error)
+}
+
+// Service implements the DraftExpansionService gRPC interface. Requests without a signed in
+// user are trusted callers.
+type Service struct {
+	app ExpansionApp
+}
+
+// NewService creates a new expansion gRPC service
+func NewService(app ExpansionApp) *Service {
+	return &Service{
+		app: app,
+	}
+}
+
+// Verify that Service implements the DraftExpansionServiceHandler interface
+var _ draftv1connect.DraftExpansionServiceHandler = (*Service)(nil)
+
+// InsertExpansionPickSlots adds an expansion team to its league's upcoming drafts. Commissioner only.
+func (s *Service) InsertExpansionPickSlots(ctx context.Context, req *connect.Request[draftv1.InsertExpansionPickSlotsRequest]) (*connect.Response[draftv1.InsertExpansionPickSlotsResponse], error) {
+	teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := s.app.InsertExpansionPickSlots(ctx, InsertPickSlotsRequest{
+		FantasyTeamID: teamID,
+		Slot:          int(req.Msg.Slot),
+		RequestedBy:   interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &draftv1.InsertExpansionPickSlotsResponse{
+		Slots: make([]*draftv1.ExpansionPickSlot, len(slots)),
+	}
+	for i, slot := range slots {
+		resp.Slots[i] = &draftv1.ExpansionPickSlot{
+			DraftId:       slot.DraftID.String(),
+			Slot:          int32(slot.Slot),
+			PicksInserted: int32(slot.PicksInserted),
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// SubmitProtectionList replaces an existing team's protection list. The team's owner or the
+// commissioner only.
+func (s *Service) SubmitProtectionList(ctx context.Context, req *connect.Request[draftv1.SubmitProtectionListRequest]) (*connect.Response[draftv1.SubmitProtectionListResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	playerIDs := make([]uuid.UUID, len(req.Msg.PlayerIds))
+	for i, id := range req.Msg.PlayerIds {
+		if playerIDs[i], err = uuid.Parse(id); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	list, err := s.app.SubmitProtectionList(ctx, SubmitProtectionListRequest{
+		DraftID:       draftID,
+		FantasyTeamID: teamID,
+		PlayerIDs:     playerIDs,
+		SubmittedBy:   interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&draftv1.SubmitProtectionListResponse{
+		ProtectionList: s.protectionListToProto(*list),
+	}), nil
+}
+
+// GetProtectionList retrieves a team's protection list
+func (s *Service) GetProtectionList(ctx context.Context, req *connect.Request[draftv1.GetProtectionListRequest]) (*connect.Response[draftv1.GetProtectionListResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.app.GetProtectionList(ctx, draftID, teamID, interceptors.ActingUserPtr(ctx))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&draftv1.GetProtectionListResponse{
+		ProtectionList: s.protectionListToProto(*list),
+	}), nil
+}
+
+// ListProtectionLists lists the protection lists submitted for an expansion draft
+func (s *Service) ListProtectionLists(ctx context.Context, req *connect.Request[draftv1.ListProtectionListsRequest]) (*connect.Response[draftv1.ListProtectionListsResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := s.app.ListProtectionLists(ctx, draftID, interceptors.ActingUserPtr(ctx))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &draftv1.ListProtectionListsResponse{
+		ProtectionLists: make([]*draftv1.ProtectionList, len(lists)),
+	}
+	for i, list := range lists {
+		resp.ProtectionLists[i] = s.protectionListToProto(list)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ListExpansionPool lists the players the expansion teams may still select
+func (s *Service) ListExpansionPool(ctx context.Context, req *connect.Request[draftv1.ListExpansionPoolRequest]) (*connect.Response[draftv1.ListExpansionPoolResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	players, err := s.app.ListExpansionPool(ctx, draftID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &draftv1.ListExpansionPoolResponse{
+		Players: make([]*draftv1.ExpansionPoolPlayer, len(players)),
+	}
+	for i, player := range players {
+		resp.Players[i] = &draftv1.ExpansionPoolPlayer{
+			PlayerId:      player.PlayerID.String(),
+			FullName:      player.FullName,
+			Position:      player.Position,
+			FantasyTeamId: player.FantasyTeamID.String(),
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// toConnectError maps app errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrDraftNotFound), errors.Is(err, ErrTeamNotFound), errors.Is(err, ErrProtectionListNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrInvalidSlot), errors.Is(err, ErrTooManyProtectedPlayers), errors.Is(err, ErrPlayerNotOnRoster),
+		errors.Is(err, ErrTeamNotInLeague):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrNotExpansionDraft), errors.Is(err, ErrDraftStarted), errors.Is(err, ErrExpansionTeam),
+		errors.Is(err, ErrSlotSelectionInProgress):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, ErrNotCommissioner), errors.Is(err, ErrNotTeamManager):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// Conversion methods
+
+// protectionListToProto converts a protection list to proto
+func (s *Service) protectionListToProto(list models.ExpansionProtectionList) *draftv1.ProtectionList {
+	protoList := &draftv1.ProtectionList{
+		DraftId:         list.DraftID.String(),
+		FantasyTeamId:   list.FantasyTeamID.String(),
+		PlayerIds:       make([]string, len(list.PlayerIDs)),
+		PlayersWithheld: list.PlayersWithheld,
+		SubmittedAt:     timestamppb.New(list.SubmittedAt),
+	}
+	for i, id := range list.PlayerIDs {
+		protoList.PlayerIds[i] = id.String()
+	}
+	if list.SubmittedBy != nil {
+		submittedBy := list.SubmittedBy.String()
+		protoList.SubmittedBy = &submittedBy
+	}
+	return protoList
+}
@@ -0,0 +1,51 @@
+package expansion
+
+import "github.com/google/uuid"
+
+// PickNumber places a pick on a draft board
+type PickNumber struct {
+	ID          uuid.UUID
+	Round       int
+	Pick        int
+	OverallPick int
+}
+
+// LayoutPickSlots renumbers the picks of a draft with teams teams to make room for a new team
+// at a 1-based slot of its draft order, and numbers the new team's pick in each of rounds
+// rounds. reverses reports the rounds that run in reverse draft order. Each existing pick keeps
+// its place relative to the others, so traded picks stay with the teams holding them.
+func LayoutPickSlots(picks []PickNumber, teams, rounds, slot int, reverses func(round int) bool) (renumbered, inserted []PickNumber) {
+	// The pick a round gives the team at a 1-based order position, in a draft of size teams
+	pickAt := func(round, position, size int) int {
+		if reverses(round) {
+			return size + 1 - position
+		}
+		return position
+	}
+
+	renumbered = make([]PickNumber, len(picks))
+	for i, p := range picks {
+		position := pickAt(p.Round, p.Pick, teams)
+		if position >= slot {
+			position++
+		}
+		pick := pickAt(p.Round, position, teams+1)
+		renumbered[i] = PickNumber{
+			ID:          p.ID,
+			Round:       p.Round,
+			Pick:        pick,
+			OverallPick: (p.Round-1)*(teams+1) + pick,
+		}
+	}
+
+	inserted = make([]PickNumber, rounds)
+	for round := 1; round <= rounds; round++ {
+		pick := pickAt(round, slot, teams+1)
+		inserted[round-1] = PickNumber{
+			Round:       round,
+			Pick:        pick,
+			OverallPick: (round-1)*(teams+1) + pick,
+		}
+	}
+	return renumbered, inserted
+}
@@ -0,0 +1,86 @@
+package expansion
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrDraftNotFound is returned when a draft does not exist
+	ErrDraftNotFound = errors.New("draft not found")
+	// ErrTeamNotFound is returned when a fantasy team does not exist
+	ErrTeamNotFound = errors.New("fantasy team not found")
+	// ErrNotExpansionDraft is returned when protection lists are used with a draft of another type
+	ErrNotExpansionDraft = errors.New("draft is not an expansion draft")
+	// ErrDraftStarted is returned when a protection list changes after its draft has started
+	ErrDraftStarted = errors.New("draft has already started")
+	// ErrExpansionTeam is returned when an expansion team, which has nothing to protect, submits a protection list
+	ErrExpansionTeam = errors.New("expansion teams don't submit protection lists")
+	// ErrTeamNotInLeague is returned when a team is used with a draft of another league
+	ErrTeamNotInLeague = errors.New("team is not in the draft's league")
+	// ErrTooManyProtectedPlayers is returned when a protection list is over the draft's limit
+	ErrTooManyProtectedPlayers = errors.New("too many protected players")
+	// ErrPlayerNotOnRoster is returned when a team protects a player it doesn't roster
+	ErrPlayerNotOnRoster = errors.New("protected players must be on the team's roster")
+	// ErrProtectionListNotFound is returned when a team hasn't submitted a protection list
+	ErrProtectionListNotFound = errors.New("protection list not found")
+	// ErrNotCommissioner is returned when someone other than the commissioner inserts pick slots
+	ErrNotCommissioner = errors.New("only the league commissioner can do this")
+	// ErrNotTeamManager is returned when someone other than a team's owner or the commissioner
+	// submits or reads its protection list
+	ErrNotTeamManager = errors.New("only the team's owner or the commissioner can do this")
+	// ErrInvalidSlot is returned when a pick slot is past the end of a draft order
+	ErrInvalidSlot = errors.New("slot is outside the draft order")
+	// ErrSlotSelectionInProgress is returned when pick slots are inserted into a draft whose teams
+	// are still choosing their slots
+	ErrSlotSelectionInProgress = errors.New("draft slot selection is in progress")
+)
+
+// InsertPickSlotsRequest adds an expansion team to the draft order of its league's upcoming drafts
+type InsertPickSlotsRequest struct {
+	FantasyTeamID uuid.UUID  `json:"fantasy_team_id"`
+	Slot          int        `json:"slot"`                   // 1-based; 0 places the team after the existing teams
+	RequestedBy   *uuid.UUID `json:"requested_by,omitempty"` // nil for trusted callers
+}
+
+// PickSlot is an expansion team's place in one of its league's drafts
+type PickSlot struct {
+	DraftID       uuid.UUID `json:"draft_id"`
+	Slot          int       `json:"slot"`
+	PicksInserted int       `json:"picks_inserted"` // 0 when the draft's picks haven't been generated
+}
+
+// SubmitProtectionListRequest replaces an existing team's protection list
+type SubmitProtectionListRequest struct {
+	DraftID       uuid.UUID   `json:"draft_id"`
+	FantasyTeamID uuid.UUID   `json:"fantasy_team_id"`
+	PlayerIDs     []uuid.UUID `json:"player_ids"`
+	SubmittedBy   *uuid.UUID  `json:"submitted_by,omitempty"` // nil for trusted callers
+}
+
+// Draft is what expansion needs to know about a draft
+type Draft struct {
+	ID             uuid.UUID
+	LeagueID       uuid.UUID
+	Type           models.DraftType
+	Status         models.DraftStatus
+	Settings       models.DraftSettings
+	CommissionerID uuid.UUID
+}
+
+// Team is what expansion needs to know about a fantasy team
+type Team struct {
+	ID             uuid.UUID
+	LeagueID       uuid.UUID
+	OwnerID        uuid.UUID
+	CommissionerID uuid.UUID
+}
+
+// InDraftOrder reports whether a team picks in the draft, which makes it one of the expansion
+// teams of an expansion draft
+func (d *Draft) InDraftOrder(teamID uuid.UUID) bool {
+	return slices.Contains(d.Settings.DraftOrder, teamID)
+}
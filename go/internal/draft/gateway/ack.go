@@ -0,0 +1,268 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ackCheckInterval is how often frames waiting on an ack are checked for retries
+	ackCheckInterval = time.Second
+	// ackRetention is how long a frame that ran out of retries is remembered, so a late ack
+	// still marks it delivered
+	ackRetention = 10 * time.Minute
+	// ackRecordTimeout bounds recording a frame's delivery with the draft service
+	ackRecordTimeout = 5 * time.Second
+)
+
+// ackRequiredEvents are the frames connections that negotiated the acks capability must
+// acknowledge. PickStarted is what tells a team's managers they're on the clock, and
+// WatchlistAlert a user that a player they're watching is about to go or just went.
+var ackRequiredEvents = map[EventType]bool{
+	EventTypePickStarted:    true,
+	EventTypeWatchlistAlert: true,
+}
+
+// FrameDeliveryStore records whether users acknowledged the frames that required it. A frame
+// recorded unacknowledged may fall back to a push notification.
+type FrameDeliveryStore interface {
+	RecordFrameDelivery(ctx context.Context, delivery FrameDelivery) error
+}
+
+// FrameDelivery is the outcome of sending a frame that required an ack to a user
+type FrameDelivery struct {
+	DraftID          uuid.UUID
+	EventID          uuid.UUID
+	EventType        EventType
+	UserID           uuid.UUID
+	Acked            bool
+	Attempts         int
+	SentAt           time.Time
+	PickID           *uuid.UUID // the pick a PickStarted frame announced
+	WatchlistAlertID *uuid.UUID // the alert a WatchlistAlert frame carried
+}
+
+// ackKey identifies a frame sent to a user; an ack from any of their connections counts
+type ackKey struct {
+	eventID string
+	userID  uuid.UUID
+}
+
+// pendingAck is a frame waiting on a user's ack
+type pendingAck struct {
+	draftID   uuid.UUID
+	eventType EventType
+	pickID    *uuid.UUID
+	alertID   *uuid.UUID
+	data      []byte
+	attempts  int
+	sentAt    time.Time
+	deadline  time.Time
+	// unacked is set once the frame ran out of retries and was recorded unacknowledged
+	unacked bool
+}
+
+// ackTracker holds the frames waiting on acks, across every draft room
+type ackTracker struct {
+	mu      sync.Mutex
+	pending map[ackKey]*pendingAck
+}
+
+func newAckTracker() *ackTracker {
+	return &ackTracker{pending: make(map[ackKey]*pendingAck)}
+}
+
+// ackFrame marshals the frame sent in place of event to connections that negotiated acks,
+// or returns nil when the event doesn't require an ack
+func ackFrame(event *DraftEvent) []byte {
+	if !ackRequiredEvents[event.Type] {
+		return nil
+	}
+	frame := *event
+	frame.AckRequired = true
+	data, err := json.Marshal(&frame)
+	if err != nil {
+		log.Error().Err(err).Str("event_type", string(event.Type)).Msg("failed to marshal ack frame")
+		return nil
+	}
+	return data
+}
+
+// expectAck starts waiting on the user's ack for a frame sent to one of their connections.
+// A frame already sent to another of the user's connections is only tracked once.
+func (cm *ConnectionManager) expectAck(conn *Connection, event *DraftEvent, data []byte) {
+	userID, ok := conn.signedInUser()
+	if !ok {
+		return
+	}
+
+	cm.acks.mu.Lock()
+	defer cm.acks.mu.Unlock()
+
+	key := ackKey{eventID: event.ID, userID: userID}
+	if _, ok := cm.acks.pending[key]; ok {
+		return
+	}
+	now := time.Now()
+	cm.acks.pending[key] = &pendingAck{
+		draftID:   conn.DraftID,
+		eventType: event.Type,
+		pickID:    ackPickID(event),
+		alertID:   ackWatchlistAlertID(event),
+		data:      data,
+		attempts:  1,
+		sentAt:    now,
+		deadline:  now.Add(cm.config.AckTimeout),
+	}
+}
+
+// acknowledge records a connection's ack of a frame, including one that already ran out of
+// retries. Acks of frames that aren't waited on are ignored.
+func (cm *ConnectionManager) acknowledge(conn *Connection, eventID string) {
+	userID, ok := conn.signedInUser()
+	if !ok {
+		return
+	}
+
+	key := ackKey{eventID: eventID, userID: userID}
+	cm.acks.mu.Lock()
+	pending, ok := cm.acks.pending[key]
+	delete(cm.acks.pending, key)
+	cm.acks.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	go cm.recordDelivery(key, *pending, true)
+}
+
+// retryUnacked resends the frames whose ack is overdue to the user's connections, and records
+// the ones out of retries as unacknowledged
+func (cm *ConnectionManager) retryUnacked(now time.Time) {
+	type resend struct {
+		key     ackKey
+		draftID uuid.UUID
+		data    []byte
+	}
+	var resends []resend
+
+	cm.acks.mu.Lock()
+	for key, pending := range cm.acks.pending {
+		if now.Before(pending.deadline) {
+			continue
+		}
+		switch {
+		case pending.unacked:
+			delete(cm.acks.pending, key)
+		case pending.attempts >= cm.config.AckAttempts:
+			pending.unacked = true
+			pending.deadline = now.Add(ackRetention)
+			go cm.recordDelivery(key, *pending, false)
+		default:
+			pending.attempts++
+			pending.deadline = now.Add(cm.config.AckTimeout)
+			resends = append(resends, resend{key: key, draftID: pending.draftID, data: pending.data})
+		}
+	}
+	cm.acks.mu.Unlock()
+
+	for _, r := range resends {
+		cm.mu.RLock()
+		var targets []*Connection
+		for conn := range cm.draftConnections[r.draftID] {
+			if conn.UserID == r.key.userID.String() && conn.Protocol.Has(CapabilityAcks) {
+				targets = append(targets, conn)
+			}
+		}
+		cm.mu.RUnlock()
+
+		for _, conn := range targets {
+			cm.deliver(conn, 0, r.data)
+		}
+	}
+}
+
+// forgetAcks stops waiting on acks for a draft whose room has closed
+func (cm *ConnectionManager) forgetAcks(draftID uuid.UUID) {
+	cm.acks.mu.Lock()
+	defer cm.acks.mu.Unlock()
+
+	for key, pending := range cm.acks.pending {
+		if pending.draftID == draftID {
+			delete(cm.acks.pending, key)
+		}
+	}
+}
+
+// recordDelivery records a frame's delivery with the draft service
+func (cm *ConnectionManager) recordDelivery(key ackKey, pending pendingAck, acked bool) {
+	if cm.deliveries == nil {
+		return
+	}
+	eventID, err := uuid.Parse(key.eventID)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ackRecordTimeout)
+	defer cancel()
+
+	err = cm.deliveries.RecordFrameDelivery(ctx, FrameDelivery{
+		DraftID:          pending.draftID,
+		EventID:          eventID,
+		EventType:        pending.eventType,
+		UserID:           key.userID,
+		Acked:            acked,
+		Attempts:         pending.attempts,
+		SentAt:           pending.sentAt,
+		PickID:           pending.pickID,
+		WatchlistAlertID: pending.alertID,
+	})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("draft_id", pending.draftID.String()).
+			Str("event_id", key.eventID).
+			Str("user_id", key.userID.String()).
+			Bool("acked", acked).
+			Msg("failed to record frame delivery")
+	}
+}
+
+// ackPickID returns the pick a PickStarted frame announced
+func ackPickID(event *DraftEvent) *uuid.UUID {
+	if event.Type != EventTypePickStarted {
+		return nil
+	}
+	var payload events.PickStartedPayload
+	if err := json.Unmarshal(event.Data, &payload); err != nil {
+		return nil
+	}
+	pickID, err := uuid.Parse(payload.PickID)
+	if err != nil {
+		return nil
+	}
+	return &pickID
+}
+
+// ackWatchlistAlertID returns the alert a WatchlistAlert frame carried
+func ackWatchlistAlertID(event *DraftEvent) *uuid.UUID {
+	if event.Type != EventTypeWatchlistAlert {
+		return nil
+	}
+	var payload events.WatchlistAlertPayload
+	if err := json.Unmarshal(event.Data, &payload); err != nil {
+		return nil
+	}
+	alertID, err := uuid.Parse(payload.AlertID)
+	if err != nil {
+		return nil
+	}
+	return &alertID
+}
@@ -0,0 +1,230 @@
+package gateway
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Admission error codes, telling a turned away client whether waiting will get it in
+const (
+	// AdmissionGatewayFull means the gateway instance is at its connection cap; the client
+	// holds a place in the gateway's queue
+	AdmissionGatewayFull = "gateway_full"
+	// AdmissionDraftFull means the draft room is at its connection cap; the client holds a
+	// place in the room's queue
+	AdmissionDraftFull = "draft_full"
+	// AdmissionTooManyConnections means the user already has as many connections open as
+	// allowed, and has to close one, such as another tab, to connect
+	AdmissionTooManyConnections = "too_many_connections"
+)
+
+// anonymousUserID is the user of draft connections that didn't name one. They're all
+// counted as one user, so the per-user cap doesn't apply to them.
+const anonymousUserID = "anonymous"
+
+// gatewayQueue is the queue key of clients waiting on the gateway's connection cap
+const gatewayQueue = "gateway"
+
+// AdmissionConfig caps the connections a gateway instance holds, so a huge public mock draft
+// room can't exhaust its memory. A zero cap is no cap.
+type AdmissionConfig struct {
+	// MaxConnections caps the draft and live scoring connections held by the gateway instance
+	MaxConnections int
+	// MaxConnectionsPerDraft caps the connections to a single draft room on this instance
+	MaxConnectionsPerDraft int
+	// MaxConnectionsPerUser caps the connections a user has open, such as one per browser tab
+	MaxConnectionsPerUser int
+	// QueueRetryAfter is how long a queued client is told to wait before trying again, and
+	// QueueTicketTTL how long its place is kept without a retry. The TTL should leave room
+	// for a few retries so a slow client doesn't lose its place.
+	QueueRetryAfter time.Duration
+	QueueTicketTTL  time.Duration
+}
+
+// DefaultAdmissionConfig returns default admission configuration, with every cap off
+func DefaultAdmissionConfig() AdmissionConfig {
+	return AdmissionConfig{
+		QueueRetryAfter: 5 * time.Second,
+		QueueTicketTTL:  30 * time.Second,
+	}
+}
+
+// AdmissionError is returned when a connection is turned away by a connection cap. It's
+// written to the client as the JSON body of the rejected upgrade.
+type AdmissionError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Limit is the cap the connection ran into
+	Limit int `json:"limit"`
+	// QueuePosition is the client's 1-based place in the queue, and QueueTicket the ticket to
+	// pass as the queue_ticket query parameter on its next try to keep that place. Both are
+	// empty for the per-user cap, which waiting doesn't lift.
+	QueuePosition int    `json:"queue_position,omitempty"`
+	QueueTicket   string `json:"queue_ticket,omitempty"`
+	RetryAfterSec int    `json:"retry_after_sec,omitempty"`
+}
+
+func (e *AdmissionError) Error() string {
+	return e.Message
+}
+
+// queuedTicket is a client's place in an admission queue
+type queuedTicket struct {
+	ticket   string
+	lastSeen time.Time
+}
+
+// admissionController counts the connections held by the gateway and queues the clients
+// turned away by a full gateway or room, so they're let in first come first served as
+// connections close
+type admissionController struct {
+	config AdmissionConfig
+
+	mu       sync.Mutex
+	total    int
+	perDraft map[uuid.UUID]int
+	perUser  map[string]int
+	// queues of waiting clients, by gatewayQueue or draft ID
+	queues map[string][]queuedTicket
+}
+
+func newAdmissionController(config AdmissionConfig) *admissionController {
+	return &admissionController{
+		config:   config,
+		perDraft: make(map[uuid.UUID]int),
+		perUser:  make(map[string]int),
+		queues:   make(map[string][]queuedTicket),
+	}
+}
+
+// admit reserves a connection slot for a user in a draft room, or in no room when draftID
+// is uuid.Nil, returning the func that frees it again. A client replacing a connection it
+// still holds, such as a resumed session whose old socket hasn't been noticed dropping, is
+// let in regardless of the caps since it frees a slot once connected.
+func (a *admissionController) admit(draftID uuid.UUID, userID, ticket string, replacing bool) (func(), error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.pruneQueues(time.Now())
+
+	if !replacing {
+		if limit := a.config.MaxConnectionsPerUser; limit > 0 && userID != anonymousUserID && a.perUser[userID] >= limit {
+			return nil, &AdmissionError{
+				Code:    AdmissionTooManyConnections,
+				Message: fmt.Sprintf("user already has %d connections open", limit),
+				Limit:   limit,
+			}
+		}
+		if err := a.claim(gatewayQueue, ticket, a.config.MaxConnections, a.total, AdmissionGatewayFull, "gateway is at capacity"); err != nil {
+			return nil, err
+		}
+		if draftID != uuid.Nil {
+			if err := a.claim(draftID.String(), ticket, a.config.MaxConnectionsPerDraft, a.perDraft[draftID], AdmissionDraftFull, "draft room is at capacity"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	a.total++
+	a.perUser[userID]++
+	if draftID != uuid.Nil {
+		a.perDraft[draftID]++
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { a.release(draftID, userID) })
+	}, nil
+}
+
+// claim takes the client's turn in a queue against a cap with count slots in use. A client
+// gets in when a slot is free for it: with no one queued ahead of it, or with its ticket
+// among the first of the queue. Otherwise it's queued, keeping its place when it holds a
+// ticket.
+func (a *admissionController) claim(key, ticket string, limit, count int, code, message string) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	queue := a.queues[key]
+	position := -1
+	for i, queued := range queue {
+		if ticket != "" && queued.ticket == ticket {
+			position = i
+			break
+		}
+	}
+
+	free := limit - count
+	if free > 0 {
+		if position < 0 && len(queue) < free {
+			return nil
+		}
+		if position >= 0 && position < free {
+			a.queues[key] = append(queue[:position], queue[position+1:]...)
+			if len(a.queues[key]) == 0 {
+				delete(a.queues, key)
+			}
+			return nil
+		}
+	}
+
+	now := time.Now()
+	if position >= 0 {
+		queue[position].lastSeen = now
+	} else {
+		if ticket == "" {
+			ticket = uuid.New().String()
+		}
+		queue = append(queue, queuedTicket{ticket: ticket, lastSeen: now})
+		a.queues[key] = queue
+		position = len(queue) - 1
+	}
+
+	return &AdmissionError{
+		Code:          code,
+		Message:       message,
+		Limit:         limit,
+		QueuePosition: position + 1,
+		QueueTicket:   ticket,
+		RetryAfterSec: max(int(a.config.QueueRetryAfter.Seconds()), 1),
+	}
+}
+
+// release frees a connection slot
+func (a *admissionController) release(draftID uuid.UUID, userID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.total--
+	if a.perUser[userID]--; a.perUser[userID] <= 0 {
+		delete(a.perUser, userID)
+	}
+	if draftID != uuid.Nil {
+		if a.perDraft[draftID]--; a.perDraft[draftID] <= 0 {
+			delete(a.perDraft, draftID)
+		}
+	}
+}
+
+// pruneQueues drops the tickets of clients that stopped retrying, so they don't hold up
+// the clients behind them
+func (a *admissionController) pruneQueues(now time.Time) {
+	cutoff := now.Add(-a.config.QueueTicketTTL)
+	for key, queue := range a.queues {
+		kept := queue[:0]
+		for _, queued := range queue {
+			if queued.lastSeen.After(cutoff) {
+				kept = append(kept, queued)
+			}
+		}
+		if len(kept) == 0 {
+			delete(a.queues, key)
+		} else {
+			a.queues[key] = kept
+		}
+	}
+}
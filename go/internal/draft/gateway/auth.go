@@ -0,0 +1,73 @@
+package gateway
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/rs/zerolog/log"
+)
+
+// accessTokenParam carries a session access token on WebSocket handshakes, since browsers
+// can't set headers on them
+const accessTokenParam = "access_token"
+
+// errInvalidAccessToken is returned for a malformed Authorization header, or an access token
+// that isn't a live session
+var errInvalidAccessToken = errors.New("invalid or expired access token")
+
+// authenticateUser returns the user whose session access token r carries, as
+// "Bearer <token>" in the Authorization header or, on WebSocket handshakes, in the
+// access_token query parameter. ok is false for a request without a token, or when the
+// gateway has no sessions to check tokens against.
+func authenticateUser(r *http.Request, sessions interceptors.SessionAuthenticator, allowQuery bool) (userID uuid.UUID, ok bool, err error) {
+	token := ""
+	if header := r.Header.Get(interceptors.AuthorizationHeader); header != "" {
+		bearer, found := strings.CutPrefix(header, "Bearer ")
+		if !found || bearer == "" {
+			return uuid.Nil, false, errInvalidAccessToken
+		}
+		token = bearer
+	} else if allowQuery {
+		token = r.URL.Query().Get(accessTokenParam)
+	}
+	if token == "" || sessions == nil {
+		return uuid.Nil, false, nil
+	}
+
+	principal, err := sessions.AuthenticateSession(r.Context(), token, clientIP(r))
+	if err != nil {
+		if errors.Is(err, interceptors.ErrInvalidSession) {
+			return uuid.Nil, false, errInvalidAccessToken
+		}
+		return uuid.Nil, false, err
+	}
+	return principal.UserID, true, nil
+}
+
+// requireUser is authenticateUser for requests only a signed-in user may make, answering 401
+// for one without an access token. ok is false once the response has been written.
+func requireUser(w http.ResponseWriter, r *http.Request, sessions interceptors.SessionAuthenticator, allowQuery bool) (uuid.UUID, bool) {
+	userID, signedIn, err := authenticateUser(r, sessions, allowQuery)
+	if err != nil {
+		writeAuthError(w, err)
+		return uuid.Nil, false
+	}
+	if !signedIn {
+		http.Error(w, "Authentication required", http.StatusUnauthorized)
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// writeAuthError answers a request whose access token couldn't be checked
+func writeAuthError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errInvalidAccessToken) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	log.Error().Err(err).Msg("failed to authenticate session")
+	http.Error(w, "failed to check access token", http.StatusInternalServerError)
+}
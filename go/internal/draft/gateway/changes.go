@@ -0,0 +1,136 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// defaultChangesLimit is how many events a poll for changes returns when it doesn't say
+	defaultChangesLimit = 100
+	// maxChangesLimit caps the events a poll for changes returns
+	maxChangesLimit = 500
+)
+
+// DraftChangeLog reads a draft's event log for clients that poll for changes rather than
+// hold a WebSocket open, e.g. TVs and kiosks
+type DraftChangeLog interface {
+	// EventsSince returns up to limit of the draft's events after sinceSeq in sequence order,
+	// with the types they were written with
+	EventsSince(ctx context.Context, draftID uuid.UUID, sinceSeq int64, limit int) ([]DraftEvent, error)
+}
+
+// DraftChangesResponse is a draft's events since the sequence a client polled with
+type DraftChangesResponse struct {
+	DraftID string       `json:"draft_id"`
+	Events  []DraftEvent `json:"events"`
+	// NextSinceSeq is the since_seq to poll with next
+	NextSinceSeq int64 `json:"next_since_seq"`
+	// HasMore is set when the limit cut the events short, so the client should poll again
+	// straight away
+	HasMore bool `json:"has_more"`
+	// ResyncRequired is set when events after since_seq are missing from the log; the client
+	// should reload the draft's state and poll from its event_sequence
+	ResyncRequired bool `json:"resync_required,omitempty"`
+}
+
+// HandleGetDraftChanges handles GET /api/drafts/{id}/changes?since_seq=N&limit=M, serving the
+// events after sequence N in the shape WebSocket clients receive them. Clients load state
+// first and poll from its event_sequence. Alerts meant for one user aren't included.
+func (h *StateHandler) HandleGetDraftChanges(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if h.changes == nil {
+		http.Error(w, "Draft changes are not available", http.StatusNotImplemented)
+		return
+	}
+
+	draftID, ok := parseDraftIDFromPath(w, r.URL.Path, "/changes")
+	if !ok {
+		return
+	}
+
+	sinceSeq, limit, err := parseChangesQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// One extra event tells whether there are more
+	events, err := h.changes.EventsSince(r.Context(), draftID, sinceSeq, limit+1)
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to get draft changes")
+		http.Error(w, "Failed to get draft changes", http.StatusInternalServerError)
+		return
+	}
+
+	resp := draftChanges(draftID, sinceSeq, limit, events)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Error().Err(err).Msg("failed to encode draft changes response")
+	}
+}
+
+// draftChanges builds the response to a poll from up to limit+1 of the events after sinceSeq
+func draftChanges(draftID uuid.UUID, sinceSeq int64, limit int, events []DraftEvent) *DraftChangesResponse {
+	resp := &DraftChangesResponse{
+		DraftID:      draftID.String(),
+		Events:       []DraftEvent{},
+		NextSinceSeq: sinceSeq,
+	}
+	if len(events) > limit {
+		events = events[:limit]
+		resp.HasMore = true
+	}
+	// Each draft's sequence is handed out one at a time as events commit, so a hole after
+	// since_seq means events were removed from the log
+	if sinceSeq > 0 && len(events) > 0 && events[0].Sequence != sinceSeq+1 {
+		resp.ResyncRequired = true
+	}
+
+	for _, event := range events {
+		resp.NextSinceSeq = event.Sequence
+
+		eventType, ok := clientEventType(string(event.Type))
+		if !ok || eventType == EventTypePlayerInjuryAlert || eventType == EventTypeWatchlistAlert {
+			continue
+		}
+		event.Type = eventType
+		event.DraftID = draftID.String()
+		resp.Events = append(resp.Events, event)
+	}
+	return resp
+}
+
+// parseChangesQuery reads the since_seq and limit query parameters of a poll for changes
+func parseChangesQuery(r *http.Request) (int64, int, error) {
+	query := r.URL.Query()
+
+	var sinceSeq int64
+	if v := query.Get("since_seq"); v != "" {
+		seq, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || seq < 0 {
+			return 0, 0, errors.New("since_seq must be a non-negative integer")
+		}
+		sinceSeq = seq
+	}
+
+	limit := defaultChangesLimit
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxChangesLimit {
+			return 0, 0, errors.New("limit must be between 1 and 500")
+		}
+		limit = n
+	}
+	return sinceSeq, limit, nil
+}
@@ -0,0 +1,436 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxChatMessageLen is the longest chat message, in characters, the gateway relays
+	maxChatMessageLen = 500
+	// maxChatReportReasonLen is the longest reason a report may give
+	maxChatReportReasonLen = 500
+	// recentChatMessages is how many messages per draft are kept so they can be reported
+	recentChatMessages = 200
+	// chatRoleTimeout bounds the lookup of whether a user commissions a draft
+	chatRoleTimeout = 5 * time.Second
+)
+
+// ChatReportStore persists chat messages flagged by draft participants
+type ChatReportStore interface {
+	ReportChatMessage(ctx context.Context, draftID, reporterID uuid.UUID, message ChatMessagePayload, reason string) (*ChatReportResult, error)
+}
+
+// ChatReportResult is the stored report for a flagged message
+type ChatReportResult struct {
+	ReportID string `json:"report_id"`
+	// Duplicate is true when the reporter had already flagged the message
+	Duplicate bool `json:"duplicate"`
+}
+
+// ChatMessagePayload is a chat message relayed to a draft room
+type ChatMessagePayload struct {
+	MessageID string    `json:"message_id"`
+	UserID    string    `json:"user_id"`
+	Text      string    `json:"text"`
+	SentAt    time.Time `json:"sent_at"`
+}
+
+// ChatRejectedPayload tells a sender why their chat message or command was not accepted
+type ChatRejectedPayload struct {
+	Reason string `json:"reason"`
+}
+
+// ChatRoomMuteChangedPayload announces a commissioner muting or unmuting a user for the whole room
+type ChatRoomMuteChangedPayload struct {
+	UserID    string `json:"user_id"`
+	Muted     bool   `json:"muted"`
+	ChangedBy string `json:"changed_by"`
+}
+
+// ChatListsPayload is a user's current mute and block lists for a draft room, sent to all
+// of the user's connections whenever the lists change
+type ChatListsPayload struct {
+	Muted   []string `json:"muted"`
+	Blocked []string `json:"blocked"`
+}
+
+// ChatModerator holds the moderation state of draft room chats: users the commissioner muted
+// for the whole room, each user's own mute and block lists, and recent messages that can still
+// be reported. State lives in memory for as long as the draft room is open.
+type ChatModerator struct {
+	mu    sync.RWMutex
+	rooms map[uuid.UUID]*chatRoom
+	roles UserDraftsProvider
+}
+
+type chatRoom struct {
+	roomMuted map[string]bool
+	// muted[a][b] means a no longer sees b's messages
+	muted map[string]map[string]bool
+	// blocked[a][b] means neither a nor b sees the other's messages
+	blocked map[string]map[string]bool
+	recent  []ChatMessagePayload
+}
+
+// NewChatModerator creates a chat moderator that looks up commissioners through roles
+func NewChatModerator(roles UserDraftsProvider) *ChatModerator {
+	return &ChatModerator{
+		rooms: make(map[uuid.UUID]*chatRoom),
+		roles: roles,
+	}
+}
+
+// room returns the draft's chat room, creating it if needed. Callers hold mu for writing.
+func (m *ChatModerator) room(draftID uuid.UUID) *chatRoom {
+	room, ok := m.rooms[draftID]
+	if !ok {
+		room = &chatRoom{
+			roomMuted: make(map[string]bool),
+			muted:     make(map[string]map[string]bool),
+			blocked:   make(map[string]map[string]bool),
+		}
+		m.rooms[draftID] = room
+	}
+	return room
+}
+
+// IsRoomMuted reports whether the commissioner muted the user for the whole room
+func (m *ChatModerator) IsRoomMuted(draftID uuid.UUID, userID string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	room, ok := m.rooms[draftID]
+	return ok && room.roomMuted[userID]
+}
+
+// Hides reports whether a message from sender must not reach recipient: the recipient muted
+// or blocked the sender, or the sender blocked the recipient
+func (m *ChatModerator) Hides(draftID uuid.UUID, recipientID, senderID string) bool {
+	if recipientID == senderID {
+		return false
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	room, ok := m.rooms[draftID]
+	if !ok {
+		return false
+	}
+	return room.muted[recipientID][senderID] ||
+		room.blocked[recipientID][senderID] ||
+		room.blocked[senderID][recipientID]
+}
+
+// SetRoomMute mutes or unmutes a user for the whole room
+func (m *ChatModerator) SetRoomMute(draftID uuid.UUID, userID string, muted bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	room := m.room(draftID)
+	if muted {
+		room.roomMuted[userID] = true
+	} else {
+		delete(room.roomMuted, userID)
+	}
+}
+
+// SetMute adds target to, or removes it from, the user's mute list and returns the user's lists
+func (m *ChatModerator) SetMute(draftID uuid.UUID, userID, target string, muted bool) ChatListsPayload {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	room := m.room(draftID)
+	setListEntry(room.muted, userID, target, muted)
+	return room.lists(userID)
+}
+
+// SetBlock adds target to, or removes it from, the user's block list and returns the user's lists
+func (m *ChatModerator) SetBlock(draftID uuid.UUID, userID, target string, blocked bool) ChatListsPayload {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	room := m.room(draftID)
+	setListEntry(room.blocked, userID, target, blocked)
+	return room.lists(userID)
+}
+
+func setListEntry(lists map[string]map[string]bool, userID, target string, on bool) {
+	if on {
+		if lists[userID] == nil {
+			lists[userID] = make(map[string]bool)
+		}
+		lists[userID][target] = true
+		return
+	}
+	delete(lists[userID], target)
+	if len(lists[userID]) == 0 {
+		delete(lists, userID)
+	}
+}
+
+// lists returns a user's mute and block lists in a stable order
+func (r *chatRoom) lists(userID string) ChatListsPayload {
+	sorted := func(set map[string]bool) []string {
+		list := make([]string, 0, len(set))
+		for id := range set {
+			list = append(list, id)
+		}
+		sort.Strings(list)
+		return list
+	}
+	return ChatListsPayload{
+		Muted:   sorted(r.muted[userID]),
+		Blocked: sorted(r.blocked[userID]),
+	}
+}
+
+// Record remembers a relayed message so it can be reported while it is recent
+func (m *ChatModerator) Record(draftID uuid.UUID, message ChatMessagePayload) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	room := m.room(draftID)
+	room.recent = append(room.recent, message)
+	if len(room.recent) > recentChatMessages {
+		room.recent = room.recent[len(room.recent)-recentChatMessages:]
+	}
+}
+
+// Message looks up a recent message by ID
+func (m *ChatModerator) Message(draftID uuid.UUID, messageID string) (ChatMessagePayload, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	room, ok := m.rooms[draftID]
+	if !ok {
+		return ChatMessagePayload{}, false
+	}
+	for _, message := range room.recent {
+		if message.MessageID == messageID {
+			return message, true
+		}
+	}
+	return ChatMessagePayload{}, false
+}
+
+// Forget drops a draft's chat state once its room has closed
+func (m *ChatModerator) Forget(draftID uuid.UUID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.rooms, draftID)
+}
+
+// IsCommissioner reports whether the user commissions the draft's league
+func (m *ChatModerator) IsCommissioner(ctx context.Context, draftID, userID uuid.UUID) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, chatRoleTimeout)
+	defer cancel()
+
+	drafts, err := m.roles.GetUserDrafts(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, d := range drafts {
+		if d.DraftID == draftID.String() {
+			return d.Role == RoleCommissioner, nil
+		}
+	}
+	return false, nil
+}
+
+// handleChatMessage handles the chat messages and commands a client sends. Chat needs a
+// signed-in user, since mute, block and report lists are keyed by user ID.
+func (c *Connection) handleChatMessage(msg clientMessage) {
+	userID, ok := c.signedInUser()
+	if !ok {
+		c.rejectChat("chat requires a signed-in user")
+		return
+	}
+
+	if msg.Type == EventTypeChatMessage {
+		c.sendChatMessage(msg.Text)
+		return
+	}
+
+	target, err := uuid.Parse(msg.TargetUserID)
+	if err != nil {
+		c.rejectChat("target_user_id must be a user ID")
+		return
+	}
+	if target == userID {
+		c.rejectChat("cannot mute or block yourself")
+		return
+	}
+
+	chat := c.Manager.chat
+	switch msg.Type {
+	case EventTypeChatMute, EventTypeChatUnmute:
+		lists := chat.SetMute(c.DraftID, c.UserID, target.String(), msg.Type == EventTypeChatMute)
+		c.sendChatLists(lists)
+	case EventTypeChatBlock, EventTypeChatUnblock:
+		lists := chat.SetBlock(c.DraftID, c.UserID, target.String(), msg.Type == EventTypeChatBlock)
+		c.sendChatLists(lists)
+	case EventTypeChatRoomMute:
+		c.setRoomMute(userID, target, msg.Muted)
+	}
+}
+
+// sendChatMessage relays a message to the draft room unless the sender is muted for the room
+func (c *Connection) sendChatMessage(text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		c.rejectChat("message is empty")
+		return
+	}
+	if utf8.RuneCountInString(text) > maxChatMessageLen {
+		c.rejectChat("message is too long")
+		return
+	}
+	if c.Manager.chat.IsRoomMuted(c.DraftID, c.UserID) {
+		c.rejectChat("you have been muted by the commissioner")
+		return
+	}
+
+	c.Manager.BroadcastChat(c.DraftID, ChatMessagePayload{
+		MessageID: uuid.New().String(),
+		UserID:    c.UserID,
+		Text:      text,
+		SentAt:    time.Now(),
+	})
+}
+
+// setRoomMute mutes or unmutes target for the whole room; only the commissioner may
+func (c *Connection) setRoomMute(userID, target uuid.UUID, muted bool) {
+	isCommissioner, err := c.Manager.chat.IsCommissioner(context.Background(), c.DraftID, userID)
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", c.ID).Msg("failed to look up draft commissioner")
+		c.rejectChat("could not verify commissioner")
+		return
+	}
+	if !isCommissioner {
+		c.rejectChat("only the commissioner can mute users for the room")
+		return
+	}
+
+	c.Manager.chat.SetRoomMute(c.DraftID, target.String(), muted)
+
+	data, err := json.Marshal(ChatRoomMuteChangedPayload{
+		UserID:    target.String(),
+		Muted:     muted,
+		ChangedBy: c.UserID,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", c.ID).Msg("failed to marshal room mute change")
+		return
+	}
+	c.Manager.BroadcastChatNotice(c.DraftID, newChatEvent(c.DraftID, EventTypeChatRoomMuteChanged, data))
+
+	log.Info().
+		Str("draft_id", c.DraftID.String()).
+		Str("user_id", target.String()).
+		Str("commissioner_id", c.UserID).
+		Bool("muted", muted).
+		Msg("chat room mute changed")
+}
+
+// sendChatLists sends the user's updated mute and block lists to all of their connections
+func (c *Connection) sendChatLists(lists ChatListsPayload) {
+	data, err := json.Marshal(lists)
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", c.ID).Msg("failed to marshal chat lists")
+		return
+	}
+	c.Manager.BroadcastToUser(c.DraftID, c.UserID, newChatEvent(c.DraftID, EventTypeChatListsUpdated, data))
+}
+
+// rejectChat tells the connection why its chat message or command was refused
+func (c *Connection) rejectChat(reason string) {
+	data, err := json.Marshal(ChatRejectedPayload{Reason: reason})
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", c.ID).Msg("failed to marshal chat rejection")
+		return
+	}
+	c.Manager.SendToConnection(c.DraftID, c.ID, newChatEvent(c.DraftID, EventTypeChatRejected, data))
+}
+
+func newChatEvent(draftID uuid.UUID, eventType EventType, data []byte) *DraftEvent {
+	return &DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   draftID.String(),
+		Type:      eventType,
+		Timestamp: time.Now(),
+		Data:      data,
+	}
+}
+
+// reportChatMessageRequest is the body of a chat report
+type reportChatMessageRequest struct {
+	MessageID string `json:"message_id"`
+	Reason    string `json:"reason"`
+}
+
+// HandleReportChatMessage handles POST /api/drafts/{id}/chat/reports, flagging a recent chat
+// message on behalf of the user whose session access token the request carries
+func (h *StateHandler) HandleReportChatMessage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	reporterID, ok := requireUser(w, r, h.sessions, false)
+	if !ok {
+		return
+	}
+
+	draftID, ok := parseDraftIDFromPath(w, r.URL.Path, "/chat/reports")
+	if !ok {
+		return
+	}
+
+	var body reportChatMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if utf8.RuneCountInString(body.Reason) > maxChatReportReasonLen {
+		http.Error(w, "reason is too long", http.StatusBadRequest)
+		return
+	}
+
+	// Only messages the gateway relayed can be reported, so the stored text is what was sent
+	message, found := h.chat.Message(draftID, body.MessageID)
+	if !found {
+		http.Error(w, "Chat message not found or too old to report", http.StatusNotFound)
+		return
+	}
+	if message.UserID == reporterID.String() {
+		http.Error(w, "Cannot report your own message", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.chatReports.ReportChatMessage(r.Context(), draftID, reporterID, message, body.Reason)
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", draftID.String()).Str("message_id", body.MessageID).Msg("failed to report chat message")
+		http.Error(w, "Failed to report chat message", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !result.Duplicate {
+		w.WriteHeader(http.StatusCreated)
+	}
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		log.Error().Err(err).Msg("failed to encode chat report response")
+	}
+}
@@ -3,26 +3,49 @@ package main
 import (
 	"context"
 	"database/sql"
+	"expvar"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
+	"github.com/mcdev12/dynasty/go/internal/admin"
+	"github.com/mcdev12/dynasty/go/internal/changes"
+	changesdb "github.com/mcdev12/dynasty/go/internal/changes/db"
+	"github.com/mcdev12/dynasty/go/internal/clock"
 	"github.com/mcdev12/dynasty/go/internal/dbconfig"
 	draftdraft "github.com/mcdev12/dynasty/go/internal/draft/draft"
 	draftdb "github.com/mcdev12/dynasty/go/internal/draft/draft/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
 	"github.com/mcdev12/dynasty/go/internal/draft/gateway"
 	"github.com/mcdev12/dynasty/go/internal/draft/outbox"
 	outboxdb "github.com/mcdev12/dynasty/go/internal/draft/outbox/db"
 	"github.com/mcdev12/dynasty/go/internal/draft/pick"
 	pickdb "github.com/mcdev12/dynasty/go/internal/draft/pick/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/replay"
+	"github.com/mcdev12/dynasty/go/internal/flags"
 	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/schedule/v1/schedulev1connect"
+	"github.com/mcdev12/dynasty/go/internal/genproto/team/v1/teamv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
 	"github.com/mcdev12/dynasty/go/internal/leagues"
 	leaguedb "github.com/mcdev12/dynasty/go/internal/leagues/db"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/mcdev12/dynasty/go/internal/ratelimit"
+	"github.com/mcdev12/dynasty/go/internal/redisconfig"
+	"github.com/mcdev12/dynasty/go/internal/schedule"
+	scheduledb "github.com/mcdev12/dynasty/go/internal/schedule/db"
+	"github.com/mcdev12/dynasty/go/internal/scoring"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/mcdev12/dynasty/go/internal/teams"
+	teamsdb "github.com/mcdev12/dynasty/go/internal/teams/db"
+	"github.com/mcdev12/dynasty/go/internal/templates"
+	templatesdb "github.com/mcdev12/dynasty/go/internal/templates/db"
 	"github.com/mcdev12/dynasty/go/internal/users"
 	usersdb "github.com/mcdev12/dynasty/go/internal/users/db"
 	"github.com/rs/zerolog"
@@ -41,7 +64,7 @@ func main() {
 
 	// Get configuration
 	port := getEnv("GATEWAY_PORT", "8081")
-	natsURL := getEnv("NATS_URL", "nats://localhost:4222")
+	natsCfg := natsconfig.NewConfigFromEnv()
 
 	// Database configuration
 	dbCfg := dbconfig.NewConfigFromEnv()
@@ -60,34 +83,139 @@ func main() {
 
 	log.Info().
 		Str("database", dbCfg.Database).
-		Str("nats_url", natsURL).
+		Str("nats_url", natsCfg.URL).
 		Str("port", port).
 		Msg("starting draft gateway")
 
 	// Setup service clients for state provider
-	draftService, draftPickService := setupServiceClients(db)
+	draftService, draftPickService, scheduleService, teamService, sessions := setupServiceClients(db)
+
+	// Browser origins allowed to call the gateway. Development allows any origin unless
+	// a list is configured; other environments only allow the configured origins.
+	appEnv := getEnv("APP_ENV", "development")
+	allowedOrigins := gateway.ParseOrigins(os.Getenv("CORS_ALLOWED_ORIGINS"))
+	if len(allowedOrigins) == 0 {
+		if appEnv == "development" {
+			allowedOrigins = []string{"*"}
+		} else {
+			log.Warn().Str("app_env", appEnv).Msg("CORS_ALLOWED_ORIGINS not set, cross-origin requests and WebSocket connections will be rejected")
+		}
+	}
+	originPolicy := gateway.NewOriginPolicy(allowedOrigins)
 
 	// Create gateway configuration
+	connectionConfig := gateway.DefaultConnectionConfig()
+	connectionConfig.CheckOrigin = originPolicy.CheckOrigin
+	// Connection caps protecting the instance's memory in huge rooms; unset leaves them off
+	connectionConfig.Admission.MaxConnections = getEnvAsInt("GATEWAY_MAX_CONNECTIONS", 0)
+	connectionConfig.Admission.MaxConnectionsPerDraft = getEnvAsInt("GATEWAY_MAX_CONNECTIONS_PER_DRAFT", 0)
+	connectionConfig.Admission.MaxConnectionsPerUser = getEnvAsInt("GATEWAY_MAX_CONNECTIONS_PER_USER", 0)
+	// Draft events are relayed by several workers, each draft's in order, so one busy draft
+	// doesn't hold up the rest on a pick storm; more pull subscriptions help when fetching
+	// can't keep up
+	pullConsumers := getEnvAsInt("GATEWAY_PULL_CONSUMERS", 1)
+	consumerWorkers := getEnvAsInt("GATEWAY_CONSUMER_WORKERS", 4)
+	reorderWait := time.Duration(getEnvAsInt("GATEWAY_CONSUMER_REORDER_WAIT_MS", 0)) * time.Millisecond
 	gatewayConfig := gateway.Config{
-		ConnectionConfig: gateway.DefaultConnectionConfig(),
+		ConnectionConfig: connectionConfig,
 		JetStreamConfig: gateway.JetStreamConsumerConfig{
-			URL:           natsURL,
-			StreamName:    "DRAFT_EVENTS",
-			ConsumerName:  "draft-gateway",
-			SubjectFilter: "draft.events.>",
-			MaxDeliver:    5,
-			AckWait:       30 * time.Second,
-			MaxAckPending: 100,
+			NATS:       natsCfg,
+			StreamName: events.StateStream,
+			// Every replica needs its own consumer to see every event
+			ConsumerName:   getEnv("GATEWAY_CONSUMER_NAME", "draft-gateway"),
+			SubjectFilters: events.StreamSubjects(events.StateStream),
+			MaxDeliver:     5,
+			AckWait:        30 * time.Second,
+			MaxAckPending:  100,
+			MaxReconnects:  -1,
+			ReconnectWait:  2 * time.Second,
+			PullConsumers:  pullConsumers,
+			Workers:        consumerWorkers,
+			ReorderWait:    reorderWait,
+		},
+		ActivityJetStreamConfig: gateway.JetStreamConsumerConfig{
+			NATS:       natsCfg,
+			StreamName: events.ActivityStream,
+			// Every replica needs its own consumer to see every event
+			ConsumerName:   getEnv("GATEWAY_ACTIVITY_CONSUMER_NAME", "draft-gateway-activity"),
+			SubjectFilters: events.StreamSubjects(events.ActivityStream),
+			MaxDeliver:     5,
+			AckWait:        30 * time.Second,
+			MaxAckPending:  100,
+			MaxReconnects:  -1,
+			ReconnectWait:  2 * time.Second,
+			PullConsumers:  pullConsumers,
+			Workers:        consumerWorkers,
+			ReorderWait:    reorderWait,
+		},
+		MatchupJetStreamConfig: gateway.JetStreamConsumerConfig{
+			NATS:       natsCfg,
+			StreamName: scoring.LiveScoringStream,
+			// Every replica needs its own consumer to see every score
+			ConsumerName:   getEnv("GATEWAY_MATCHUP_CONSUMER_NAME", "matchup-gateway"),
+			SubjectFilters: []string{scoring.LiveScoringSubjectPrefix + ".>"},
+			MaxDeliver:     5,
+			AckWait:        30 * time.Second,
+			MaxAckPending:  100,
+			MaxReconnects:  -1,
+			ReconnectWait:  2 * time.Second,
+		},
+		DelayNoticeConfig: gateway.DelayNoticeConfig{
+			NATS:          natsCfg,
+			StaleAfter:    10 * time.Second,
 			MaxReconnects: -1,
 			ReconnectWait: 2 * time.Second,
 		},
 	}
 
+	// Share sessions, presence and rate limits with the other replicas through Redis when
+	// configured; a single gateway keeps them in memory
+	redisCfg := redisconfig.NewConfigFromEnv()
+	if redisCfg.Enabled() {
+		redisClient, err := redisCfg.Connect(context.Background())
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to connect to redis")
+		}
+		defer redisClient.Close()
+
+		gatewayConfig.SessionState = gateway.NewRedisSessionState(redisClient, redisCfg.KeyPrefix)
+		gatewayConfig.Limiter = ratelimit.NewRedisLimiter(redisClient, redisCfg.KeyPrefix)
+		log.Info().Msg("sharing gateway sessions and rate limits through redis")
+	} else {
+		log.Info().Msg("REDIS_URL not set, keeping gateway sessions and rate limits in memory")
+	}
+
+	// Feature flags roll protocol capabilities out per league
+	if getEnv("FEATURE_FLAGS_ENABLED", "false") == "true" {
+		flagsCtx, flagsCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		featureFlags, err := flags.Dial(flagsCtx, natsCfg, getEnv("FEATURE_FLAGS_BUCKET", flags.DefaultBucket))
+		flagsCancel()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load feature flags")
+		}
+		defer featureFlags.Close()
+		gatewayConfig.Flags = featureFlags
+	}
+
+	// Users act in draft rooms, and call the REST routes that act for them, with the session
+	// access tokens the API server issued
+	gatewayConfig.Sessions = sessions
+
+	// Name the NFL teams of picks in the locale REST clients ask for
+	gatewayConfig.TeamNames = gateway.NewTeamNameCache(teamService, "nfl", gateway.DefaultTeamNamesTTL)
+
 	// Create state provider
 	stateProvider := gateway.NewDraftStateProvider(draftService, draftPickService)
+	matchupProvider := gateway.NewMatchupProvider(scheduleService)
+
+	// Past draft state is rebuilt from the outbox, which keeps every event, and polling
+	// clients read what changed since their last poll from it
+	outboxSource := replay.NewOutboxSource(outboxdb.New(db))
+	gatewayConfig.History = replay.NewHistory(outboxSource, stateProvider)
+	gatewayConfig.ChangeLog = outboxSource
 
 	// Create gateway service
-	gatewayService, err := gateway.NewService(gatewayConfig, stateProvider)
+	gatewayService, err := gateway.NewService(gatewayConfig, stateProvider, stateProvider, stateProvider, stateProvider, stateProvider, stateProvider, stateProvider, stateProvider, stateProvider, matchupProvider)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create gateway service")
 	}
@@ -114,8 +242,17 @@ func main() {
 	mux.HandleFunc("/info", func(w http.ResponseWriter, r *http.Request) {
 		stats := gatewayService.GetStats()
 		w.Header().Set("Content-Type", "application/json")
-		fmt.Fprintf(w, `{"service":"draft-gateway","version":"1.0.0","connections":%d}`,
-			stats["total_connections"])
+		fmt.Fprintf(w, `{"service":"draft-gateway","version":"1.0.0","connections":%d,"min_protocol_version":%d,"protocol_version":%d}`,
+			stats["total_connections"], gateway.MinProtocolVersion, gateway.CurrentProtocolVersion)
+	})
+
+	// Expose runtime and consumer lag metrics
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	// Runtime debug endpoints: log level, profiles and configuration
+	admin.Mount(mux, admin.Config{
+		Service: "gateway",
+		Token:   os.Getenv("ADMIN_API_TOKEN"),
 	})
 
 	// Debug endpoint to list all routes
@@ -125,15 +262,26 @@ func main() {
 		fmt.Fprintf(w, "/health\n")
 		fmt.Fprintf(w, "/info\n")
 		fmt.Fprintf(w, "/ws/draft\n")
+		fmt.Fprintf(w, "/ws/matchups\n")
 		fmt.Fprintf(w, "/ws/stats\n")
 		fmt.Fprintf(w, "/api/drafts/active\n")
+		fmt.Fprintf(w, "/api/users/me/drafts\n")
 		fmt.Fprintf(w, "/api/drafts/{id}/state\n")
+		fmt.Fprintf(w, "/api/drafts/{id}/state/at\n")
+		fmt.Fprintf(w, "/api/drafts/{id}/board\n")
+		fmt.Fprintf(w, "/api/drafts/{id}/changes\n")
+		fmt.Fprintf(w, "/api/drafts/{id}/clock\n")
+		fmt.Fprintf(w, "/api/drafts/{id}/export\n")
+		fmt.Fprintf(w, "/api/drafts/{id}/chat/reports\n")
+		fmt.Fprintf(w, "/api/locales\n")
+		fmt.Fprintf(w, "/api/format\n")
 		fmt.Fprintf(w, "/debug/routes\n")
+		fmt.Fprintf(w, "/debug/vars\n")
 	})
 
 	server := &http.Server{
 		Addr:         fmt.Sprintf(":%s", port),
-		Handler:      gateway.CORSMiddleware(mux),
+		Handler:      gateway.CORSMiddleware(originPolicy, mux),
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 10 * time.Second,
 		IdleTimeout:  120 * time.Second,
@@ -183,37 +331,51 @@ func main() {
 	log.Info().Msg("draft gateway shutdown complete")
 }
 
-func setupServiceClients(db *sql.DB) (draftv1connect.DraftServiceClient, draftv1connect.DraftPickServiceClient) {
+func setupServiceClients(db *sql.DB) (draftv1connect.DraftServiceClient, draftv1connect.DraftPickServiceClient, schedulev1connect.ScheduleServiceClient, teamv1connect.TeamServiceClient, interceptors.SessionAuthenticator) {
 	// Setup queries
 	draftQueries := draftdb.New(db)
 	pickQueries := pickdb.New(db)
 	outboxQueries := outboxdb.New(db)
 	leagueQueries := leaguedb.New(db)
 	userQueries := usersdb.New(db)
+	templateQueries := templatesdb.New(db)
+	scheduleQueries := scheduledb.New(db)
+	teamQueries := teamsdb.New(db)
 
 	// Setup repositories
-	draftRepo := draftdraft.NewRepository(draftQueries)
-	draftPickRepo := pick.NewRepository(pickQueries, db)
+	appClock := clock.Real()
+	draftRepo := draftdraft.NewRepository(draftQueries, db, appClock)
+	draftPickRepo := pick.NewRepository(pickQueries, db, appClock)
 	outboxRepo := outbox.NewRepository(outboxQueries)
-	leagueRepo := leagues.NewRepository(leagueQueries)
-	userRepo := users.NewRepository(userQueries)
+	leagueRepo := leagues.NewRepository(leagueQueries, db, changes.NewTxInserter(changesdb.New(db), sqlutil.NewTxManager(db)))
+	userRepo := users.NewRepository(userQueries, db)
+	templateRepo := templates.NewRepository(templateQueries)
+	scheduleRepo := schedule.NewRepository(scheduleQueries, db)
+	teamRepo := teams.NewRepository(teamQueries, db)
 
 	// Setup apps
-	draftApp := draftdraft.NewApp(draftRepo)
-	pickApp := pick.NewApp(draftPickRepo)
+	draftApp := draftdraft.NewApp(draftRepo, appClock)
+	pickApp := pick.NewApp(draftPickRepo, appClock)
 	outboxApp := outbox.NewApp(outboxRepo)
-	leagueApp := leagues.NewApp(leagueRepo)
-	userApp := users.NewApp(userRepo)
+	leagueApp := leagues.NewApp(leagueRepo, nil)
+	userApp := users.NewApp(userRepo, nil)
+	templateApp := templates.NewApp(templateRepo)
+	scheduleApp := schedule.NewApp(scheduleRepo)
+	teamApp := teams.NewApp(teamRepo, nil) // reads only, so no sport plugins
 
 	// Create services (these will act as local clients for the gateway)
 	userService := users.NewService(userApp)
-	leagueService := leagues.NewService(leagueApp, userService)
+	templateService := templates.NewService(templateApp, userService)
+	leagueService := leagues.NewService(leagueApp, userService, templateService)
+
+	// Create draft service with outbox app, league service and template service
+	draftService := draftdraft.NewService(draftApp, outboxApp, leagueService, templateService)
+	pickService := pick.NewService(pickApp, draftService, outboxApp, sqlutil.NewTxManager(db), nil)
 
-	// Create draft service with outbox app and league service
-	draftService := draftdraft.NewService(draftApp, outboxApp, leagueService)
-	pickService := pick.NewService(pickApp, draftService, outboxApp)
+	scheduleService := schedule.NewService(scheduleApp)
+	teamService := teams.NewService(teamApp)
 
-	return draftService, pickService
+	return draftService, pickService, scheduleService, teamService, users.NewSessionAuthenticator(userApp)
 }
 
 func getEnv(key, defaultValue string) string {
@@ -222,3 +384,12 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvAsInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if intValue, err := strconv.Atoi(value); err == nil {
+			return intValue
+		}
+	}
+	return defaultValue
+}
@@ -3,22 +3,32 @@ package gateway
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/ratelimit"
 	"github.com/rs/zerolog/log"
 )
 
+// ErrDraftClosed is returned when a client tries to join the room of a completed draft
+var ErrDraftClosed = errors.New("draft has completed")
+
 // ConnectionManager manages WebSocket connections for draft events
 type ConnectionManager struct {
 	// Connection pools organized by draft ID
 	draftConnections map[uuid.UUID]map[*Connection]bool
 	mu               sync.RWMutex
 
+	// Completed drafts and when they completed; no new connections are accepted for these
+	closedDrafts map[uuid.UUID]time.Time
+
 	// Upgrader for WebSocket connections
 	upgrader websocket.Upgrader
 
@@ -27,12 +37,89 @@ type ConnectionManager struct {
 
 	// Event broadcasting
 	broadcastCh chan BroadcastMessage
+
+	// Completed drafts whose grace period has passed, torn down on the broadcast goroutine
+	// so a room is never closed while an event is being sent to it
+	closeCh chan uuid.UUID
+
+	// Snapshot sequences reported by clients, applied on the broadcast goroutine so held
+	// events are released in order with live ones
+	fenceCh chan fenceUpdate
+
+	// Event category subscriptions sent by clients, applied on the broadcast goroutine
+	subscribeCh chan subscriptionUpdate
+
+	// Resumable sessions and room presence, backed by the session state shared with other gateways
+	sessions *sessionStore
+	// Recent sequenced events per draft, replayed to resumed sessions. Only touched on the
+	// broadcast goroutine, which also replays them in response to resumeCh.
+	replay   map[uuid.UUID]*replayBuffer
+	resumeCh chan resumeRequest
+
+	// Chat moderation state, consulted when chat messages are broadcast
+	chat *ChatModerator
+	// Where pause vote commands from clients are recorded
+	pauseVotes PauseVoteStore
+	// Where pick trade commands from clients are recorded
+	pickTrades PickTradeStore
+	// Where reactions to picks from clients are recorded
+	pickReactions PickReactionStore
+	// Frames waiting on acks, and where their delivery is recorded; nil records nothing
+	acks       *ackTracker
+	deliveries FrameDeliveryStore
+	// Where users joining and leaving rooms are reported; nil reports nothing
+	presence RoomPresenceStore
+
+	// Connection pools organized by matchup ID, for clients watching live scores
+	matchupConnections map[uuid.UUID]map[*Connection]bool
+	// Latest score frame relayed per matchup, guarded by mu
+	matchupScores map[uuid.UUID]latestScore
+	matchupCh     chan matchupBroadcast
+
+	// User stream connections organized by the draft IDs they relay events of
+	userConnections map[uuid.UUID]map[*Connection]bool
+
+	// Connection caps and the clients queued on them
+	admission *admissionController
+
+	// What every connection has been sent, reported on /ws/stats
+	traffic trafficCounters
+}
+
+// maxHeldEvents bounds how many events are queued for a connection waiting on its snapshot.
+// A client that doesn't report its snapshot in time gets the queue flushed unfenced.
+const maxHeldEvents = 256
+
+// EventFence is where a connection starts receiving sequenced draft events relative to
+// the state snapshot the client loaded over REST
+type EventFence struct {
+	// SnapshotSequence is the event_sequence of the client's snapshot; events at or below it
+	// are already reflected there and are dropped
+	SnapshotSequence int64
+	// Hold queues events until the client sends SnapshotLoaded with its snapshot sequence,
+	// for clients that connect before fetching state
+	Hold bool
+}
+
+type fenceUpdate struct {
+	conn     *Connection
+	sequence int64
+}
+
+type heldEvent struct {
+	sequence  int64
+	eventType EventType
+	data      []byte
 }
 
 // Connection represents a WebSocket connection to a client
 type Connection struct {
-	ID      string
-	UserID  string
+	ID string
+	// UserID is the signed-in user's ID, or anonymousUserID for a spectator
+	UserID string
+	// User is the user whose session access token the connection was opened with, nil for a
+	// spectator who connected without one
+	User    *uuid.UUID
 	DraftID uuid.UUID
 	Conn    *websocket.Conn
 	Send    chan []byte
@@ -41,6 +128,32 @@ type Connection struct {
 	// Connection metadata
 	ConnectedAt time.Time
 	LastPing    time.Time
+	Protocol    Protocol
+
+	// Close frame sent when the manager closes the connection, set before Send is closed
+	closeMessage []byte
+
+	// Admission state, only touched on the broadcast goroutine
+	fence   EventFence
+	held    []heldEvent
+	holding bool
+	// subscription is the event categories the connection receives, nil for every event
+	subscription map[EventCategory]bool
+
+	// Matchups are the matchups a live scoring connection watches; empty on draft connections
+	Matchups []uuid.UUID
+	// Drafts are the drafts a user stream relays events of, with the user's seat in each;
+	// empty on other connections
+	Drafts map[uuid.UUID]UserDraftSummary
+
+	// session is the token of the resumable session the connection is attached to
+	session string
+	// lastSequence is the last sequenced event queued on the connection
+	lastSequence atomic.Int64
+	// stats is what the connection has been sent and its latest ping round trip
+	stats connectionStats
+	// release frees the connection's admission slot; safe to call more than once
+	release func()
 }
 
 // ConnectionConfig holds configuration for WebSocket connections
@@ -52,6 +165,27 @@ type ConnectionConfig struct {
 	ReadBufferSize  int
 	WriteBufferSize int
 	CheckOrigin     func(r *http.Request) bool
+
+	// CompletedDraftGracePeriod is how long a completed draft's room stays open after
+	// the final summary frame so clients can render it
+	CompletedDraftGracePeriod time.Duration
+	// CompletedDraftRetention is how long a completed draft is remembered to reject new connections
+	CompletedDraftRetention time.Duration
+	// SessionResumeTTL is how long a dropped connection's session can be resumed with its
+	// token before it ends and the user is announced as having left the room
+	SessionResumeTTL time.Duration
+	// ConnectRateLimit limits the connection attempts from each client IP; a zero limit
+	// disables it
+	ConnectRateLimit ratelimit.Rule
+	// LiveScoreRetention is how long a matchup's latest score is kept for new connections
+	// after its last update
+	LiveScoreRetention time.Duration
+	// AckTimeout is how long a connection that negotiated acks has to acknowledge a frame
+	// requiring one before it's sent again, up to AckAttempts sends in all
+	AckTimeout  time.Duration
+	AckAttempts int
+	// Admission caps the connections held per gateway instance, draft room and user
+	Admission AdmissionConfig
 }
 
 // BroadcastMessage represents a message to broadcast to connections
@@ -59,6 +193,16 @@ type BroadcastMessage struct {
 	DraftID uuid.UUID
 	Event   *DraftEvent
 	UserID  string // Optional: if set, only send to this user
+	// Optional: if set, only send to this connection
+	ConnectionID string
+	// Chat marks chat frames, sent only to connections that negotiated the chat capability.
+	// SenderID is the author of a chat message; recipients who muted or blocked the sender,
+	// or whom the sender blocked, don't receive it.
+	Chat     bool
+	SenderID string
+	// Reactions marks pick reaction counts, sent only to connections that negotiated the
+	// reactions capability
+	Reactions bool
 }
 
 // DefaultConnectionConfig returns default WebSocket configuration
@@ -67,27 +211,62 @@ func DefaultConnectionConfig() ConnectionConfig {
 		WriteTimeout:    10 * time.Second,
 		ReadTimeout:     60 * time.Second,
 		PingInterval:    30 * time.Second,
-		MaxMessageSize:  1024, // 1KB max message size
+		MaxMessageSize:  4096, // 4KB, room for a full chat message
 		ReadBufferSize:  1024,
 		WriteBufferSize: 1024,
-		CheckOrigin: func(r *http.Request) bool {
-			// Allow all origins in development - restrict in production
-			return true
-		},
+		// Reject cross-origin connections by default, deployments replace this with their OriginPolicy
+		CheckOrigin:               NewOriginPolicy(nil).CheckOrigin,
+		CompletedDraftGracePeriod: 30 * time.Second,
+		CompletedDraftRetention:   24 * time.Hour,
+		SessionResumeTTL:          30 * time.Second,
+		ConnectRateLimit:          ratelimit.Rule{Limit: 30, Window: time.Minute},
+		LiveScoreRetention:        7 * 24 * time.Hour,
+		AckTimeout:                10 * time.Second,
+		AckAttempts:               3,
+		Admission:                 DefaultAdmissionConfig(),
 	}
 }
 
-// NewConnectionManager creates a new WebSocket connection manager
-func NewConnectionManager(config ConnectionConfig) *ConnectionManager {
+// NewConnectionManager creates a new WebSocket connection manager. Sessions are kept in
+// memory unless a shared session state is given.
+func NewConnectionManager(config ConnectionConfig, chat *ChatModerator, pauseVotes PauseVoteStore, pickTrades PickTradeStore, pickReactions PickReactionStore, deliveries FrameDeliveryStore, presence RoomPresenceStore, state SessionState) *ConnectionManager {
+	if state == nil {
+		state = NewMemorySessionState()
+	}
+
 	cm := &ConnectionManager{
 		draftConnections: make(map[uuid.UUID]map[*Connection]bool),
+		closedDrafts:     make(map[uuid.UUID]time.Time),
 		upgrader: websocket.Upgrader{
 			ReadBufferSize:  config.ReadBufferSize,
 			WriteBufferSize: config.WriteBufferSize,
 			CheckOrigin:     config.CheckOrigin,
+			// Only connections that negotiate the compression capability write compressed frames
+			EnableCompression: true,
 		},
-		config:      config,
-		broadcastCh: make(chan BroadcastMessage, 1000), // Buffer for high throughput
+		config:        config,
+		broadcastCh:   make(chan BroadcastMessage, 1000), // Buffer for high throughput
+		closeCh:       make(chan uuid.UUID, 100),
+		fenceCh:       make(chan fenceUpdate, 100),
+		subscribeCh:   make(chan subscriptionUpdate, 100),
+		sessions:      newSessionStore(state, config.SessionResumeTTL),
+		replay:        make(map[uuid.UUID]*replayBuffer),
+		resumeCh:      make(chan resumeRequest, 100),
+		chat:          chat,
+		pauseVotes:    pauseVotes,
+		pickTrades:    pickTrades,
+		pickReactions: pickReactions,
+		acks:          newAckTracker(),
+		deliveries:    deliveries,
+		presence:      presence,
+
+		matchupConnections: make(map[uuid.UUID]map[*Connection]bool),
+		matchupScores:      make(map[uuid.UUID]latestScore),
+		matchupCh:          make(chan matchupBroadcast, 1000),
+
+		userConnections: make(map[uuid.UUID]map[*Connection]bool),
+
+		admission: newAdmissionController(config.Admission),
 	}
 
 	return cm
@@ -97,6 +276,18 @@ func NewConnectionManager(config ConnectionConfig) *ConnectionManager {
 func (cm *ConnectionManager) Start(ctx context.Context) {
 	log.Info().Msg("connection manager started")
 
+	pruneTicker := time.NewTicker(time.Hour)
+	defer pruneTicker.Stop()
+	ackTicker := time.NewTicker(ackCheckInterval)
+	defer ackTicker.Stop()
+
+	// Tell this gateway's clients about users joining and leaving through other gateways
+	go func() {
+		if err := cm.sessions.state.SubscribePresence(ctx, cm.announcePresence); err != nil {
+			log.Error().Err(err).Msg("presence subscription stopped")
+		}
+	}()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -104,14 +295,50 @@ func (cm *ConnectionManager) Start(ctx context.Context) {
 			return
 		case message := <-cm.broadcastCh:
 			cm.handleBroadcast(message)
+		case message := <-cm.matchupCh:
+			cm.handleMatchupBroadcast(message)
+		case draftID := <-cm.closeCh:
+			cm.closeDraftConnections(draftID)
+		case update := <-cm.fenceCh:
+			cm.releaseHeldEvents(update.conn, update.sequence)
+		case update := <-cm.subscribeCh:
+			cm.applySubscription(update.conn, update.categories)
+		case request := <-cm.resumeCh:
+			cm.replayMissedEvents(request.conn, request.sequence, request.remote)
+		case now := <-ackTicker.C:
+			cm.retryUnacked(now)
+		case <-pruneTicker.C:
+			cm.pruneClosedDrafts()
+			cm.pruneReplayBuffers()
+			cm.pruneMatchupScores()
 		}
 	}
 }
 
-// UpgradeConnection upgrades an HTTP connection to WebSocket
-func (cm *ConnectionManager) UpgradeConnection(w http.ResponseWriter, r *http.Request, userID string, draftID uuid.UUID) error {
+// UpgradeConnection upgrades an HTTP connection to WebSocket speaking the negotiated protocol,
+// acting as user, the signed-in user the handshake was authenticated as, or nil for a spectator.
+// Draft events are admitted to the connection from the given fence onwards, unless
+// sessionToken resumes an earlier session: then the fence is ignored and the events the
+// session missed are replayed. An unknown or expired token starts a new session.
+// A connection over one of the admission caps is turned away with an *AdmissionError
+// before upgrading; queueTicket keeps the place a client was given in a queue earlier.
+func (cm *ConnectionManager) UpgradeConnection(w http.ResponseWriter, r *http.Request, user *uuid.UUID, draftID uuid.UUID, protocol Protocol, fence EventFence, sessionToken, queueTicket string) error {
+	if cm.IsDraftClosed(draftID) {
+		return ErrDraftClosed
+	}
+	userID := anonymousUserID
+	if user != nil {
+		userID = user.String()
+	}
+
+	release, err := cm.admission.admit(draftID, userID, queueTicket, cm.holdsSession(draftID, sessionToken))
+	if err != nil {
+		return err
+	}
+
 	conn, err := cm.upgrader.Upgrade(w, r, nil)
 	if err != nil {
+		release()
 		log.Error().Err(err).Msg("failed to upgrade WebSocket connection")
 		return fmt.Errorf("failed to upgrade connection: %w", err)
 	}
@@ -119,14 +346,76 @@ func (cm *ConnectionManager) UpgradeConnection(w http.ResponseWriter, r *http.Re
 	connection := &Connection{
 		ID:          uuid.New().String(),
 		UserID:      userID,
+		User:        user,
 		DraftID:     draftID,
 		Conn:        conn,
 		Send:        make(chan []byte, 256),
 		Manager:     cm,
 		ConnectedAt: time.Now(),
 		LastPing:    time.Now(),
+		Protocol:    protocol,
+		fence:       fence,
+		holding:     fence.Hold,
+		release:     release,
+	}
+	conn.EnableWriteCompression(protocol.Has(CapabilityCompression))
+
+	// A resumed connection holds live events until the missed ones have been replayed
+	resumed, remote := false, false
+	var resumeSequence int64
+	if sessionToken != "" {
+		sequence, previous, parkedElsewhere, ok := cm.sessions.resume(sessionToken, connection)
+		if ok {
+			resumed, remote, resumeSequence = true, parkedElsewhere, sequence
+			// The connection isn't shared yet, so the session's subscription can be set directly
+			connection.subscription = cm.sessions.subscription(sessionToken)
+			connection.fence = EventFence{SnapshotSequence: sequence}
+			connection.holding = true
+			if previous != nil {
+				// The old socket dropped without the server noticing yet
+				cm.unregisterConnection(previous)
+				previous.Conn.Close()
+			}
+		} else {
+			log.Info().
+				Str("user_id", userID).
+				Str("draft_id", draftID.String()).
+				Msg("session token unknown or expired, starting a new session")
+		}
+	}
+	if !resumed {
+		connection.session = newSessionToken()
+	}
+
+	// Queue the handshake frame before the connection is registered so it is always the
+	// first frame the client reads
+	if protocol.Negotiated() {
+		if hello, err := helloEvent(connection, resumed); err != nil {
+			log.Error().Err(err).Str("connection_id", connection.ID).Msg("failed to build hello frame")
+		} else {
+			connection.Send <- hello
+		}
+	}
+
+	if err := cm.registerConnection(connection); err != nil {
+		// The draft completed while the connection was being upgraded
+		cm.sessions.detach(connection, cm.expireSession)
+		release()
+		conn.WriteControl(websocket.CloseMessage, draftClosedMessage(), time.Now().Add(cm.config.WriteTimeout))
+		conn.Close()
+		return nil
+	}
+
+	if resumed {
+		select {
+		case cm.resumeCh <- resumeRequest{conn: connection, sequence: resumeSequence, remote: remote}:
+		default:
+			// Held events are still flushed once maxHeldEvents is reached
+			log.Warn().Str("connection_id", connection.ID).Msg("resume channel full, not replaying missed events")
+		}
+	} else if joined := cm.sessions.open(connection); joined {
+		cm.broadcastPresence(draftID, userID, true)
 	}
-	cm.registerConnection(connection)
 
 	// Start connection handlers
 	go connection.writePump()
@@ -136,16 +425,66 @@ func (cm *ConnectionManager) UpgradeConnection(w http.ResponseWriter, r *http.Re
 		Str("connection_id", connection.ID).
 		Str("user_id", userID).
 		Str("draft_id", draftID.String()).
+		Int("protocol_version", protocol.Version).
+		Int64("snapshot_seq", fence.SnapshotSequence).
+		Bool("hold_events", fence.Hold).
+		Bool("resumed", resumed).
 		Msg("WebSocket connection established")
 
 	return nil
 }
 
+// helloEvent renders the handshake frame telling a client what was negotiated
+func helloEvent(c *Connection, resumed bool) ([]byte, error) {
+	data, err := json.Marshal(HelloPayload{
+		ConnectionID:     c.ID,
+		ProtocolVersion:  c.Protocol.Version,
+		RequestedVersion: c.Protocol.RequestedVersion,
+		Capabilities:     c.Protocol.CapabilityList(),
+		ServerTime:       time.Now(),
+		SessionToken:     c.session,
+		SessionResumeTTL: int(c.Manager.config.SessionResumeTTL.Seconds()),
+		Resumed:          resumed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   c.DraftID.String(),
+		Type:      EventTypeHello,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
+
+// holdsSession reports whether a connection to the draft on this gateway is attached to the
+// session, so a client resuming it replaces that connection rather than adding one
+func (cm *ConnectionManager) holdsSession(draftID uuid.UUID, sessionToken string) bool {
+	if sessionToken == "" {
+		return false
+	}
+
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+
+	for conn := range cm.draftConnections[draftID] {
+		if conn.session == sessionToken {
+			return true
+		}
+	}
+	return false
+}
+
 // registerConnection adds a connection to the manager
-func (cm *ConnectionManager) registerConnection(conn *Connection) {
+func (cm *ConnectionManager) registerConnection(conn *Connection) error {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 
+	if _, closed := cm.closedDrafts[conn.DraftID]; closed {
+		return ErrDraftClosed
+	}
+
 	if cm.draftConnections[conn.DraftID] == nil {
 		cm.draftConnections[conn.DraftID] = make(map[*Connection]bool)
 	}
@@ -156,32 +495,156 @@ func (cm *ConnectionManager) registerConnection(conn *Connection) {
 		Str("draft_id", conn.DraftID.String()).
 		Int("total_connections", len(cm.draftConnections[conn.DraftID])).
 		Msg("connection registered")
+
+	return nil
 }
 
 // unregisterConnection removes a connection from the manager
 func (cm *ConnectionManager) unregisterConnection(conn *Connection) {
+	if len(conn.Matchups) > 0 {
+		cm.removeMatchupConnection(conn)
+		return
+	}
+	if len(conn.Drafts) > 0 {
+		cm.removeUserConnection(conn)
+		return
+	}
+	if cm.removeConnection(conn) {
+		// Parked outside the manager's lock, since the session state may be remote
+		cm.sessions.detach(conn, cm.expireSession)
+	}
+}
+
+// removeConnection drops a connection from its draft's pool and reports whether it was there
+func (cm *ConnectionManager) removeConnection(conn *Connection) bool {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 
-	if connections, exists := cm.draftConnections[conn.DraftID]; exists {
-		if _, exists := connections[conn]; exists {
-			delete(connections, conn)
-			close(conn.Send)
+	connections := cm.draftConnections[conn.DraftID]
+	if _, exists := connections[conn]; !exists {
+		return false
+	}
+	delete(connections, conn)
+	close(conn.Send)
+	conn.release()
 
-			// Clean up empty draft connection pools
-			if len(connections) == 0 {
-				delete(cm.draftConnections, conn.DraftID)
-			}
+	// Clean up empty draft connection pools
+	if len(connections) == 0 {
+		delete(cm.draftConnections, conn.DraftID)
+	}
 
-			log.Info().
-				Str("connection_id", conn.ID).
-				Str("user_id", conn.UserID).
-				Str("draft_id", conn.DraftID.String()).
-				Msg("connection unregistered")
+	log.Info().
+		Str("connection_id", conn.ID).
+		Str("user_id", conn.UserID).
+		Str("draft_id", conn.DraftID.String()).
+		Msg("connection unregistered")
+	return true
+}
+
+// IsDraftClosed reports whether the draft has completed and its room no longer accepts connections
+func (cm *ConnectionManager) IsDraftClosed(draftID uuid.UUID) bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+
+	_, closed := cm.closedDrafts[draftID]
+	return closed
+}
+
+// CloseDraft tears down a completed draft's room. It broadcasts the DraftCompleted event and a
+// final DraftRoomClosing summary frame, stops accepting new connections for the draft, and
+// closes the remaining connections once the grace period has passed.
+func (cm *ConnectionManager) CloseDraft(draftID uuid.UUID, completed *DraftEvent) {
+	cm.mu.Lock()
+	if _, closed := cm.closedDrafts[draftID]; closed {
+		// Redelivered DraftCompleted event; teardown is already scheduled
+		cm.mu.Unlock()
+		return
+	}
+	cm.closedDrafts[draftID] = time.Now()
+	cm.mu.Unlock()
+
+	cm.BroadcastToDraft(draftID, completed)
+
+	closesAt := time.Now().Add(cm.config.CompletedDraftGracePeriod)
+	summary := DraftRoomClosingPayload{
+		DraftID:  draftID.String(),
+		ClosesAt: closesAt,
+	}
+	var completedPayload events.DraftCompletedPayload
+	if err := json.Unmarshal(completed.Data, &completedPayload); err != nil {
+		log.Warn().Err(err).Str("draft_id", draftID.String()).Msg("failed to parse DraftCompleted payload for summary")
+	} else {
+		summary.CompletedAt = completedPayload.CompletedAt
+		summary.Duration = completedPayload.Duration
+		summary.TotalPicks = completedPayload.TotalPicks
+	}
+
+	summaryData, err := json.Marshal(summary)
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to marshal draft room summary")
+	} else {
+		cm.BroadcastToDraft(draftID, &DraftEvent{
+			ID:        uuid.New().String(),
+			DraftID:   draftID.String(),
+			Type:      EventTypeDraftRoomClosing,
+			Timestamp: time.Now(),
+			Data:      summaryData,
+		})
+	}
+
+	time.AfterFunc(cm.config.CompletedDraftGracePeriod, func() {
+		cm.closeCh <- draftID
+	})
+
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Time("closes_at", closesAt).
+		Msg("draft completed, closing draft room")
+}
+
+// closeDraftConnections closes every connection to a draft and frees its connection pool
+func (cm *ConnectionManager) closeDraftConnections(draftID uuid.UUID) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	connections := cm.draftConnections[draftID]
+	delete(cm.draftConnections, draftID)
+	delete(cm.replay, draftID)
+	cm.sessions.forgetDraft(draftID)
+	cm.chat.Forget(draftID)
+	cm.forgetAcks(draftID)
+
+	// Closing Send makes the write pump send the close frame and shut the connection down
+	for conn := range connections {
+		conn.closeMessage = draftClosedMessage()
+		close(conn.Send)
+		conn.release()
+	}
+
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Int("connections", len(connections)).
+		Msg("draft room closed")
+}
+
+// pruneClosedDrafts forgets completed drafts older than the retention period
+func (cm *ConnectionManager) pruneClosedDrafts() {
+	cutoff := time.Now().Add(-cm.config.CompletedDraftRetention)
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	for draftID, closedAt := range cm.closedDrafts {
+		if closedAt.Before(cutoff) {
+			delete(cm.closedDrafts, draftID)
 		}
 	}
 }
 
+func draftClosedMessage() []byte {
+	return websocket.FormatCloseMessage(websocket.CloseNormalClosure, "draft completed")
+}
+
 // BroadcastToDraft sends an event to all connections for a specific draft
 func (cm *ConnectionManager) BroadcastToDraft(draftID uuid.UUID, event *DraftEvent) {
 	select {
@@ -203,8 +666,70 @@ func (cm *ConnectionManager) BroadcastToUser(draftID uuid.UUID, userID string, e
 	}
 }
 
+// SendToConnection sends an event to a single connection in a draft
+func (cm *ConnectionManager) SendToConnection(draftID uuid.UUID, connectionID string, event *DraftEvent) {
+	select {
+	case cm.broadcastCh <- BroadcastMessage{DraftID: draftID, Event: event, ConnectionID: connectionID}:
+	default:
+		log.Warn().
+			Str("draft_id", draftID.String()).
+			Str("connection_id", connectionID).
+			Msg("broadcast channel full, dropping connection message")
+	}
+}
+
+// BroadcastChat relays a chat message to a draft room. Mutes and blocks are applied per
+// recipient when the message is broadcast.
+func (cm *ConnectionManager) BroadcastChat(draftID uuid.UUID, message ChatMessagePayload) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to marshal chat message")
+		return
+	}
+	event := newChatEvent(draftID, EventTypeChatMessage, data)
+
+	select {
+	case cm.broadcastCh <- BroadcastMessage{DraftID: draftID, Event: event, Chat: true, SenderID: message.UserID}:
+		cm.chat.Record(draftID, message)
+	default:
+		log.Warn().Str("draft_id", draftID.String()).Msg("broadcast channel full, dropping chat message")
+	}
+}
+
+// BroadcastChatNotice sends a chat frame that isn't a message to every chat connection in a draft
+func (cm *ConnectionManager) BroadcastChatNotice(draftID uuid.UUID, event *DraftEvent) {
+	select {
+	case cm.broadcastCh <- BroadcastMessage{DraftID: draftID, Event: event, Chat: true}:
+	default:
+		log.Warn().Str("draft_id", draftID.String()).Msg("broadcast channel full, dropping chat notice")
+	}
+}
+
 // handleBroadcast processes a broadcast message
 func (cm *ConnectionManager) handleBroadcast(message BroadcastMessage) {
+	// The commissioner may have muted the sender after the message was accepted
+	if message.SenderID != "" && cm.chat.IsRoomMuted(message.DraftID, message.SenderID) {
+		return
+	}
+
+	// Marshal the event once
+	eventData, err := json.Marshal(message.Event)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to marshal event for broadcast")
+		return
+	}
+
+	// Buffered even while every session is detached, so it can be replayed on resume
+	cm.recordReplay(message, eventData)
+
+	// User streams get the draft's headline events whether or not anyone is in its room
+	if message.ConnectionID == "" && !message.Chat {
+		cm.relayToUserStreams(message)
+	}
+
+	// Connections that negotiated acks are sent the frame marked as requiring one
+	ackData := ackFrame(message.Event)
+
 	cm.mu.RLock()
 	connections, exists := cm.draftConnections[message.DraftID]
 	if !exists {
@@ -219,29 +744,40 @@ func (cm *ConnectionManager) handleBroadcast(message BroadcastMessage) {
 		if message.UserID != "" && conn.UserID != message.UserID {
 			continue
 		}
+		if message.ConnectionID != "" && conn.ID != message.ConnectionID {
+			continue
+		}
+		if message.Chat && !conn.Protocol.Has(CapabilityChat) {
+			continue
+		}
+		if message.Reactions && !conn.Protocol.Has(CapabilityReactions) {
+			continue
+		}
+		if message.SenderID != "" && cm.chat.Hides(message.DraftID, conn.UserID, message.SenderID) {
+			continue
+		}
+		// Replies to a single connection are sent whatever it subscribed to
+		if message.ConnectionID == "" && !conn.wants(message.Event.Type) {
+			continue
+		}
 		targetConnections = append(targetConnections, conn)
 	}
 	cm.mu.RUnlock()
 
-	// Marshal the event once
-	eventData, err := json.Marshal(message.Event)
-	if err != nil {
-		log.Error().Err(err).Msg("failed to marshal event for broadcast")
-		return
-	}
-
 	// Send to all target connections
 	for _, conn := range targetConnections {
-		select {
-		case conn.Send <- eventData:
-		default:
-			// Connection is slow/dead, close it
-			log.Warn().
-				Str("connection_id", conn.ID).
-				Str("user_id", conn.UserID).
-				Msg("connection send buffer full, closing connection")
-			cm.unregisterConnection(conn)
-			conn.Conn.Close()
+		// Replies to a single connection aren't part of the draft's event stream
+		if message.ConnectionID != "" {
+			cm.deliver(conn, 0, eventData)
+			continue
+		}
+		data := eventData
+		if ackData != nil && conn.Protocol.Has(CapabilityAcks) {
+			data = ackData
+			cm.expectAck(conn, message.Event, ackData)
+		}
+		if cm.admit(conn, message.Event.Sequence, message.Event.Type, data) {
+			cm.deliver(conn, message.Event.Sequence, data)
 		}
 	}
 
@@ -252,6 +788,96 @@ func (cm *ConnectionManager) handleBroadcast(message BroadcastMessage) {
 		Msg("event broadcasted")
 }
 
+// deliver queues a frame on a connection, closing the connection if it can't keep up.
+// The sequence of a draft event is recorded so a resumed session knows where to replay from.
+func (cm *ConnectionManager) deliver(conn *Connection, sequence int64, data []byte) bool {
+	select {
+	case conn.Send <- data:
+		if sequence > 0 {
+			conn.lastSequence.Store(sequence)
+		}
+		return true
+	default:
+		// Connection is slow/dead, close it
+		conn.recordDropped()
+		log.Warn().
+			Str("connection_id", conn.ID).
+			Str("user_id", conn.UserID).
+			Msg("connection send buffer full, closing connection")
+		cm.unregisterConnection(conn)
+		conn.Conn.Close()
+		return false
+	}
+}
+
+// admit reports whether an event should be sent to a connection now. Sequenced events the
+// client's snapshot already reflects are dropped, and events arriving while the connection
+// waits for its snapshot are held back.
+func (cm *ConnectionManager) admit(conn *Connection, sequence int64, eventType EventType, data []byte) bool {
+	if sequence > 0 && sequence <= conn.fence.SnapshotSequence {
+		return false
+	}
+	if !conn.holding {
+		return true
+	}
+
+	if len(conn.held) >= maxHeldEvents {
+		log.Warn().
+			Str("connection_id", conn.ID).
+			Int("held", len(conn.held)).
+			Msg("client did not report its snapshot in time, releasing held events")
+		cm.releaseHeldEvents(conn, 0)
+		return true
+	}
+	conn.held = append(conn.held, heldEvent{sequence: sequence, eventType: eventType, data: data})
+	return false
+}
+
+// FenceConnection records the sequence of the snapshot a client loaded, dropping events it
+// already reflects and releasing any events held for the connection
+func (cm *ConnectionManager) FenceConnection(conn *Connection, sequence int64) {
+	select {
+	case cm.fenceCh <- fenceUpdate{conn: conn, sequence: sequence}:
+	default:
+		// Held events are still flushed once maxHeldEvents is reached
+		log.Warn().
+			Str("connection_id", conn.ID).
+			Int64("snapshot_seq", sequence).
+			Msg("fence channel full, dropping snapshot fence")
+	}
+}
+
+// releaseHeldEvents raises a connection's fence to sequence and sends the events held for
+// it that the snapshot doesn't already reflect, in the order they arrived
+func (cm *ConnectionManager) releaseHeldEvents(conn *Connection, sequence int64) {
+	cm.mu.RLock()
+	_, registered := cm.draftConnections[conn.DraftID][conn]
+	cm.mu.RUnlock()
+	if !registered {
+		return
+	}
+
+	conn.fence.SnapshotSequence = max(conn.fence.SnapshotSequence, sequence)
+	held := conn.held
+	conn.held = nil
+	conn.holding = false
+
+	for _, event := range held {
+		if event.sequence > 0 && event.sequence <= conn.fence.SnapshotSequence {
+			continue
+		}
+		if !cm.deliver(conn, event.sequence, event.data) {
+			return
+		}
+	}
+
+	log.Debug().
+		Str("connection_id", conn.ID).
+		Int64("snapshot_seq", conn.fence.SnapshotSequence).
+		Int("held", len(held)).
+		Msg("connection fenced at snapshot")
+}
+
 // GetConnectionStats returns statistics about active connections
 func (cm *ConnectionManager) GetConnectionStats() map[string]interface{} {
 	cm.mu.RLock()
@@ -259,17 +885,52 @@ func (cm *ConnectionManager) GetConnectionStats() map[string]interface{} {
 
 	totalConnections := 0
 	draftCounts := make(map[string]int)
+	var open []*Connection
 
 	for draftID, connections := range cm.draftConnections {
 		count := len(connections)
 		totalConnections += count
 		draftCounts[draftID.String()] = count
+		for conn := range connections {
+			open = append(open, conn)
+		}
+	}
+
+	// A matchup connection sits in the room of every matchup it watches
+	matchupConnections := make(map[*Connection]bool)
+	for _, connections := range cm.matchupConnections {
+		for conn := range connections {
+			matchupConnections[conn] = true
+		}
+	}
+	totalConnections += len(matchupConnections)
+
+	// And a user stream in the user stream room of every draft it relays
+	userConnections := make(map[*Connection]bool)
+	for _, connections := range cm.userConnections {
+		for conn := range connections {
+			userConnections[conn] = true
+		}
+	}
+	totalConnections += len(userConnections)
+
+	for conn := range matchupConnections {
+		open = append(open, conn)
+	}
+	for conn := range userConnections {
+		open = append(open, conn)
 	}
 
 	return map[string]interface{}{
-		"total_connections": totalConnections,
-		"active_drafts":     len(cm.draftConnections),
-		"draft_connections": draftCounts,
+		"total_connections":   totalConnections,
+		"active_drafts":       len(cm.draftConnections),
+		"closed_drafts":       len(cm.closedDrafts),
+		"draft_connections":   draftCounts,
+		"sessions":            cm.sessions.count(),
+		"matchup_rooms":       len(cm.matchupConnections),
+		"matchup_connections": len(matchupConnections),
+		"user_streams":        len(userConnections),
+		"traffic":             cm.trafficStats(open),
 	}
 }
 
@@ -280,6 +941,7 @@ func (c *Connection) writePump() {
 		ticker.Stop()
 		c.Conn.Close()
 		c.Manager.unregisterConnection(c)
+		c.logTraffic()
 	}()
 
 	for {
@@ -288,21 +950,31 @@ func (c *Connection) writePump() {
 			c.Conn.SetWriteDeadline(time.Now().Add(c.Manager.config.WriteTimeout))
 			if !ok {
 				// Channel was closed
-				c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
+				closeMessage := c.closeMessage
+				if closeMessage == nil {
+					closeMessage = []byte{}
+				}
+				c.Conn.WriteMessage(websocket.CloseMessage, closeMessage)
 				return
 			}
 
-			if err := c.Conn.WriteMessage(websocket.TextMessage, message); err != nil {
+			messageType := websocket.TextMessage
+			if c.Protocol.Has(CapabilityBinaryFrames) {
+				messageType = websocket.BinaryMessage
+			}
+			if err := c.Conn.WriteMessage(messageType, message); err != nil {
+				c.recordDropped()
 				log.Error().
 					Err(err).
 					Str("connection_id", c.ID).
 					Msg("failed to write message to WebSocket")
 				return
 			}
+			c.recordSent(message)
 
 		case <-ticker.C:
 			c.Conn.SetWriteDeadline(time.Now().Add(c.Manager.config.WriteTimeout))
-			if err := c.Conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			if err := c.writePing(); err != nil {
 				log.Error().
 					Err(err).
 					Str("connection_id", c.ID).
@@ -323,9 +995,10 @@ func (c *Connection) readPump() {
 
 	c.Conn.SetReadLimit(c.Manager.config.MaxMessageSize)
 	c.Conn.SetReadDeadline(time.Now().Add(c.Manager.config.ReadTimeout))
-	c.Conn.SetPongHandler(func(string) error {
+	c.Conn.SetPongHandler(func(appData string) error {
 		c.Conn.SetReadDeadline(time.Now().Add(c.Manager.config.ReadTimeout))
 		c.LastPing = time.Now()
+		c.handlePong(appData)
 		return nil
 	})
 
@@ -347,13 +1020,91 @@ func (c *Connection) readPump() {
 	}
 }
 
+// signedInUser returns the user the connection's access token was issued to, or false for a
+// spectator, who can't act as any user
+func (c *Connection) signedInUser() (uuid.UUID, bool) {
+	if c.User == nil {
+		return uuid.Nil, false
+	}
+	return *c.User, true
+}
+
 // handleClientMessage processes messages received from the client
 func (c *Connection) handleClientMessage(message []byte) {
-	// For now, just log client messages
-	// In the future, this could handle client commands like "subscribe to draft", "unsubscribe", etc.
-	log.Debug().
-		Str("connection_id", c.ID).
-		Str("user_id", c.UserID).
-		RawJSON("message", message).
-		Msg("received client message")
+	var msg clientMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		log.Debug().
+			Err(err).
+			Str("connection_id", c.ID).
+			Msg("ignoring malformed client message")
+		return
+	}
+
+	// Live scoring connections and user streams take no commands from the client
+	if len(c.Matchups) > 0 || len(c.Drafts) > 0 {
+		return
+	}
+
+	switch msg.Type {
+	case EventTypeClockSync:
+		if !c.Protocol.Has(CapabilityClockSync) {
+			return
+		}
+		c.replyClockSync(msg.ClientTime)
+	case EventTypeSnapshotLoaded:
+		c.Manager.FenceConnection(c, msg.Sequence)
+	case EventTypeSubscribe:
+		c.Manager.Subscribe(c, parseSubscription(msg.Categories))
+	case EventTypeChatMessage, EventTypeChatMute, EventTypeChatUnmute,
+		EventTypeChatBlock, EventTypeChatUnblock, EventTypeChatRoomMute:
+		if !c.Protocol.Has(CapabilityChat) {
+			return
+		}
+		c.handleChatMessage(msg)
+	case EventTypeAck:
+		if !c.Protocol.Has(CapabilityAcks) {
+			return
+		}
+		c.Manager.acknowledge(c, msg.EventID)
+	case EventTypePauseVoteStart, EventTypePauseVoteCast:
+		if !c.Protocol.Has(CapabilityPauseVotes) {
+			return
+		}
+		c.handlePauseVoteMessage(msg)
+	case EventTypePickTradePropose, EventTypePickTradeRespond:
+		if !c.Protocol.Has(CapabilityPickTrades) {
+			return
+		}
+		c.handlePickTradeMessage(msg)
+	case EventTypePickReact:
+		if !c.Protocol.Has(CapabilityReactions) {
+			return
+		}
+		c.handlePickReactMessage(msg)
+	default:
+		log.Debug().
+			Str("connection_id", c.ID).
+			Str("user_id", c.UserID).
+			RawJSON("message", message).
+			Msg("received client message")
+	}
+}
+
+// replyClockSync answers a ClockSync request with the server's current time
+func (c *Connection) replyClockSync(clientTime time.Time) {
+	data, err := json.Marshal(ClockSyncPayload{
+		ClientTime: clientTime,
+		ServerTime: time.Now(),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", c.ID).Msg("failed to marshal clock sync reply")
+		return
+	}
+	c.Manager.SendToConnection(c.DraftID, c.ID, &DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   c.DraftID.String(),
+		Type:      EventTypeClockSync,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
 }
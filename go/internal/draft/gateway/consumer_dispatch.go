@@ -0,0 +1,247 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+)
+
+// consumerVars publishes, per JetStream consumer, how far the gateway is behind relaying its
+// events: "lag_ms" from the last event reaching the stream to its broadcast, "pending" events
+// on the stream not yet delivered to the gateway, "queued" events delivered and waiting for a
+// worker, "held" events waiting for an earlier event of their draft, and "processed". The
+// gateway serves them at /debug/vars.
+var consumerVars = expvar.NewMap("gateway_consumers")
+
+// defaultReorderWait is how long an event that overtook an earlier one of its draft is held
+// for the earlier one to arrive, when the consumer pulls in parallel
+const defaultReorderWait = 250 * time.Millisecond
+
+// eventEnvelope is the outbox event as published on the draft streams
+type eventEnvelope struct {
+	EventID   string          `json:"eventId"`
+	EventType string          `json:"eventType"`
+	DraftID   string          `json:"draftId"`
+	Timestamp time.Time       `json:"timestamp"`
+	Payload   json.RawMessage `json:"payload"`
+	Sequence  int64           `json:"sequence"`
+}
+
+// inboundEvent is a message delivered by one of the consumer's pull subscriptions
+type inboundEvent struct {
+	msg      jetstream.Msg
+	envelope eventEnvelope
+	draftID  uuid.UUID
+	// sequence orders the event among its draft's events; 0 for events relayed unsequenced
+	sequence int64
+}
+
+// consumerStats counts what a consumer's workers have done
+type consumerStats struct {
+	processed atomic.Int64
+	queued    atomic.Int64
+	held      atomic.Int64
+	lagMs     atomic.Int64
+	pending   atomic.Uint64
+}
+
+// publish registers the stats under the consumer's name
+func (s *consumerStats) publish(name string, workers, pullConsumers int) {
+	consumerVars.Set(name, expvar.Func(func() any {
+		return map[string]any{
+			"lag_ms":         s.lagMs.Load(),
+			"pending":        s.pending.Load(),
+			"queued":         s.queued.Load(),
+			"held":           s.held.Load(),
+			"processed":      s.processed.Load(),
+			"workers":        workers,
+			"pull_consumers": pullConsumers,
+		}
+	}))
+}
+
+// observe records a message's lag once it has been relayed
+func (s *consumerStats) observe(msg jetstream.Msg) {
+	s.processed.Add(1)
+	meta, err := msg.Metadata()
+	if err != nil {
+		return
+	}
+	s.lagMs.Store(max(time.Since(meta.Timestamp), 0).Milliseconds())
+	s.pending.Store(meta.NumPending)
+}
+
+// decodeInbound reads the envelope of a delivered message
+func decodeInbound(msg jetstream.Msg) (inboundEvent, error) {
+	var envelope eventEnvelope
+	if err := json.Unmarshal(msg.Data(), &envelope); err != nil {
+		return inboundEvent{}, fmt.Errorf("unmarshal event envelope: %w", err)
+	}
+	draftID, err := uuid.Parse(envelope.DraftID)
+	if err != nil {
+		return inboundEvent{}, fmt.Errorf("parse draft ID: %w", err)
+	}
+
+	event := inboundEvent{msg: msg, envelope: envelope, draftID: draftID}
+	// Activity events can overtake the lifecycle and pick events written before them, so
+	// they're relayed unsequenced rather than moving the projection or a session's resume point
+	if events.Class(envelope.EventType).Sequenced() {
+		event.sequence = envelope.Sequence
+	}
+	return event, nil
+}
+
+// shardFor returns the worker a draft's events go to
+func shardFor(draftID uuid.UUID, workers int) int {
+	h := fnv.New32a()
+	h.Write(draftID[:])
+	return int(h.Sum32() % uint32(workers))
+}
+
+// reorderedEvent is an event waiting for an earlier event of its draft
+type reorderedEvent struct {
+	event    inboundEvent
+	deadline time.Time
+}
+
+// consumerWorker relays the events of the drafts sharded to it, one at a time. With a single
+// pull subscription a draft's events arrive in order. With several, an event can overtake an
+// earlier one of its draft pulled by another subscription, so an event arriving ahead of the
+// next sequence the worker expects is held for up to reorderWait for the gap to fill, then
+// relayed regardless.
+type consumerWorker struct {
+	ec          *EventConsumer
+	in          chan inboundEvent
+	reorderWait time.Duration // 0 relays every event as it arrives
+
+	lastSequence map[uuid.UUID]int64 // the last sequence relayed per draft
+	held         map[uuid.UUID][]reorderedEvent
+}
+
+func newConsumerWorker(ec *EventConsumer, reorderWait time.Duration) *consumerWorker {
+	return &consumerWorker{
+		ec:           ec,
+		in:           make(chan inboundEvent, 100),
+		reorderWait:  reorderWait,
+		lastSequence: make(map[uuid.UUID]int64),
+		held:         make(map[uuid.UUID][]reorderedEvent),
+	}
+}
+
+// run relays events until ctx is cancelled. Held events are left unacknowledged, so
+// JetStream redelivers them.
+func (w *consumerWorker) run(ctx context.Context) {
+	var tick <-chan time.Time
+	if w.reorderWait > 0 {
+		ticker := time.NewTicker(w.reorderWait / 2)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-w.in:
+			w.ec.stats.queued.Add(-1)
+			w.receive(ctx, event)
+		case now := <-tick:
+			w.releaseExpired(ctx, now)
+		}
+	}
+}
+
+// receive relays an event, or holds it while an earlier event of its draft may still arrive
+func (w *consumerWorker) receive(ctx context.Context, event inboundEvent) {
+	last, known := w.lastSequence[event.draftID]
+	if w.reorderWait == 0 || event.sequence == 0 || !known || event.sequence <= last+1 {
+		w.relay(ctx, event)
+		w.releaseInOrder(ctx, event.draftID)
+		return
+	}
+
+	held := append(w.held[event.draftID], reorderedEvent{event: event, deadline: time.Now().Add(w.reorderWait)})
+	sort.Slice(held, func(i, j int) bool { return held[i].event.sequence < held[j].event.sequence })
+	w.held[event.draftID] = held
+	w.ec.stats.held.Add(1)
+}
+
+// releaseInOrder relays a draft's held events that no longer wait on a gap
+func (w *consumerWorker) releaseInOrder(ctx context.Context, draftID uuid.UUID) {
+	for len(w.held[draftID]) > 0 {
+		next := w.held[draftID][0]
+		if next.event.sequence > w.lastSequence[draftID]+1 {
+			return
+		}
+		w.popHeld(draftID)
+		w.relay(ctx, next.event)
+	}
+}
+
+// releaseExpired gives up on the gaps that held events waited on past their deadline, relaying
+// them in order
+func (w *consumerWorker) releaseExpired(ctx context.Context, now time.Time) {
+	for draftID, held := range w.held {
+		if now.Before(held[0].deadline) {
+			continue
+		}
+		log.Warn().
+			Str("draft_id", draftID.String()).
+			Int64("expected_sequence", w.lastSequence[draftID]+1).
+			Int64("sequence", held[0].event.sequence).
+			Msg("relaying draft events past a sequence gap")
+		for len(w.held[draftID]) > 0 {
+			next := w.held[draftID][0]
+			w.popHeld(draftID)
+			w.relay(ctx, next.event)
+		}
+	}
+}
+
+func (w *consumerWorker) popHeld(draftID uuid.UUID) {
+	w.held[draftID] = w.held[draftID][1:]
+	if len(w.held[draftID]) == 0 {
+		delete(w.held, draftID)
+	}
+	w.ec.stats.held.Add(-1)
+}
+
+// relay processes an event and acknowledges it, or asks for it again if processing failed
+func (w *consumerWorker) relay(ctx context.Context, event inboundEvent) {
+	if event.sequence > w.lastSequence[event.draftID] {
+		w.lastSequence[event.draftID] = event.sequence
+	}
+
+	if err := w.ec.processEvent(ctx, event); err != nil {
+		log.Error().
+			Err(err).
+			Str("subject", event.msg.Subject()).
+			Msg("failed to process message")
+		// Negative acknowledge to retry
+		if nakErr := event.msg.Nak(); nakErr != nil {
+			log.Error().Err(nakErr).Msg("failed to NAK message")
+		}
+		return
+	}
+
+	// Acknowledge successful processing
+	if ackErr := event.msg.Ack(); ackErr != nil {
+		log.Error().Err(ackErr).Msg("failed to ACK message")
+	}
+	w.ec.stats.observe(event.msg)
+
+	// A completed draft has no more events to order
+	if event.envelope.EventType == events.DraftCompleted {
+		delete(w.lastSequence, event.draftID)
+	}
+}
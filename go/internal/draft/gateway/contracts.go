@@ -0,0 +1,383 @@
+package gateway
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+)
+
+// Contracts are golden JSON files recording the shape of every frame the gateway sends on its
+// WebSockets and every response its REST routes serve. Mobile and web clients are built
+// against these shapes, so a change to a payload struct that renames, retypes, drops or adds
+// a field shows up as a golden file that no longer matches. Each golden file marshals a sample
+// with every field set, so omitempty fields are recorded too. go test checks them, and
+// make update-gateway-contracts accepts an intended change.
+
+// contractsDir is where the golden files live, relative to this package
+const contractsDir = "contracts"
+
+//go:embed contracts
+var goldenContracts embed.FS
+
+// contractSampleTime is the time every sample time field is set to
+var contractSampleTime = time.Date(2025, time.September, 4, 20, 15, 0, 0, time.UTC)
+
+// contractMaxDepth stops filling samples of self-referencing types
+const contractMaxDepth = 8
+
+// Contract is one frame or response whose JSON shape clients depend on
+type Contract struct {
+	// Name is the golden file's path under contracts/, e.g. frames/draft/PickMade.json
+	Name   string
+	Sample any
+}
+
+// gatewayFramePayloads are the payloads of the frames the gateway makes itself, rather than
+// relaying from the draft outbox
+var gatewayFramePayloads = map[EventType]any{
+	EventTypeTimerTick:            TimerTickPayload{},
+	EventTypeDraftRoomClosing:     DraftRoomClosingPayload{},
+	EventTypeHello:                HelloPayload{},
+	EventTypeClockSync:            ClockSyncPayload{},
+	EventTypeConnectionQuality:    ConnectionQualityPayload{},
+	EventTypeSessionResumed:       SessionResumedPayload{},
+	EventTypeSubscribed:           SubscribedPayload{},
+	EventTypePresenceChanged:      PresenceChangedPayload{},
+	EventTypeDraftDelayed:         DraftDelayedPayload{},
+	EventTypeChatMessage:          ChatMessagePayload{},
+	EventTypeChatRejected:         ChatRejectedPayload{},
+	EventTypeChatRoomMuteChanged:  ChatRoomMuteChangedPayload{},
+	EventTypeChatListsUpdated:     ChatListsPayload{},
+	EventTypePauseVoteRejected:    PauseVoteRejectedPayload{},
+	EventTypePickTradeRejected:    PickTradeRejectedPayload{},
+	EventTypePickReactionsUpdated: PickReactionsPayload{},
+	EventTypePickReactionRejected: PickReactionRejectedPayload{},
+}
+
+// restResponses are the JSON bodies of the gateway's REST routes
+var restResponses = map[string]any{
+	"get_draft_state":      DraftStateResponse{}, // also /state/at
+	"get_draft_board":      DraftBoardResponse{},
+	"get_draft_clock":      DraftClockResponse{},
+	"get_draft_changes":    DraftChangesResponse{},
+	"get_active_drafts":    []DraftSummary{},
+	"get_my_drafts":        []UserDraftSummary{},
+	"report_chat_message":  ChatReportResult{},
+	"connection_rejected":  AdmissionError{},
+	"get_connection_stats": ConnectionStatsResponse{},
+}
+
+// Contracts returns every frame and response contract, sorted by name. Draft room frames
+// cover every event type registered with the draft outbox, so a new event type needs its
+// golden file before the check passes.
+func Contracts() ([]Contract, error) {
+	var contracts []Contract
+	draftFrame := func(eventType EventType, payload reflect.Type) error {
+		data, err := json.Marshal(contractSample(payload))
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s sample: %w", eventType, err)
+		}
+		frame := contractSample(reflect.TypeOf(DraftEvent{})).(DraftEvent)
+		frame.Type = eventType
+		frame.Data = data
+		contracts = append(contracts, Contract{Name: path.Join("frames", "draft", string(eventType)+".json"), Sample: frame})
+		return nil
+	}
+
+	for _, eventType := range events.Types() {
+		if err := draftFrame(EventType(eventType), events.PayloadType(eventType)); err != nil {
+			return nil, err
+		}
+	}
+	for eventType, payload := range gatewayFramePayloads {
+		if err := draftFrame(eventType, reflect.TypeOf(payload)); err != nil {
+			return nil, err
+		}
+	}
+
+	matchupsWatched, err := json.Marshal(contractSample(reflect.TypeOf(MatchupsWatchedPayload{})))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s sample: %w", EventTypeMatchupsWatched, err)
+	}
+	matchupFrame := contractSample(reflect.TypeOf(MatchupEvent{})).(MatchupEvent)
+	matchupFrame.Type = EventTypeMatchupsWatched
+	matchupFrame.Data = matchupsWatched
+	contracts = append(contracts, Contract{Name: "frames/matchups/MatchupsWatched.json", Sample: matchupFrame})
+
+	draftsWatched, err := json.Marshal(contractSample(reflect.TypeOf(DraftsWatchedPayload{})))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s sample: %w", EventTypeDraftsWatched, err)
+	}
+	userFrame := contractSample(reflect.TypeOf(UserStreamEvent{})).(UserStreamEvent)
+	userFrame.Type = EventTypeDraftsWatched
+	userFrame.Data = draftsWatched
+	contracts = append(contracts, Contract{Name: "frames/me/DraftsWatched.json", Sample: userFrame})
+
+	contracts = append(contracts, Contract{Name: "frames/client/message.json", Sample: contractSample(reflect.TypeOf(clientMessage{}))})
+	for name, response := range restResponses {
+		contracts = append(contracts, Contract{Name: path.Join("rest", name+".json"), Sample: contractSample(reflect.TypeOf(response))})
+	}
+
+	sort.Slice(contracts, func(i, j int) bool { return contracts[i].Name < contracts[j].Name })
+	return contracts, nil
+}
+
+// CheckContracts compares every contract to its golden file, reporting frames and responses
+// whose shape changed, contracts without a golden file and golden files whose contract is gone
+func CheckContracts() ([]string, error) {
+	contracts, err := Contracts()
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	seen := make(map[string]bool, len(contracts))
+	for _, contract := range contracts {
+		seen[contract.Name] = true
+		golden, err := goldenContracts.ReadFile(path.Join(contractsDir, contract.Name))
+		if errors.Is(err, fs.ErrNotExist) {
+			problems = append(problems, fmt.Sprintf("%s: no golden file", contract.Name))
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read golden %s: %w", contract.Name, err)
+		}
+
+		current, err := renderContract(contract)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(golden, current) {
+			continue
+		}
+		changes, err := diffContract(golden, current)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare %s: %w", contract.Name, err)
+		}
+		if len(changes) == 0 {
+			changes = []string{"formatting changed"}
+		}
+		for _, change := range changes {
+			problems = append(problems, fmt.Sprintf("%s: %s", contract.Name, change))
+		}
+	}
+
+	err = fs.WalkDir(goldenContracts, contractsDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(p) != ".json" {
+			return err
+		}
+		name := p[len(contractsDir)+1:]
+		if !seen[name] {
+			problems = append(problems, fmt.Sprintf("%s: frame or response removed; clients may still expect it", name))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list golden files: %w", err)
+	}
+	return problems, nil
+}
+
+// WriteContracts replaces the golden files under dir, the package's contracts directory, with
+// the current contracts
+func WriteContracts(dir string) error {
+	contracts, err := Contracts()
+	if err != nil {
+		return err
+	}
+	// Clear out the golden files of frames and responses that are gone
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(p) != ".json" {
+			return err
+		}
+		return os.Remove(p)
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to clear %s: %w", dir, err)
+	}
+	for _, contract := range contracts {
+		out, err := renderContract(contract)
+		if err != nil {
+			return err
+		}
+		file := filepath.Join(dir, filepath.FromSlash(contract.Name))
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", filepath.Dir(file), err)
+		}
+		if err := os.WriteFile(file, out, 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file, err)
+		}
+	}
+	return nil
+}
+
+// renderContract marshals a contract's sample the way golden files store it, with embedded
+// frame data indented along with the rest
+func renderContract(contract Contract) ([]byte, error) {
+	compact, err := json.Marshal(contract.Sample)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", contract.Name, err)
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, compact, "", "  "); err != nil {
+		return nil, fmt.Errorf("failed to indent %s: %w", contract.Name, err)
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
+
+// diffContract lists the fields added, removed or retyped between a golden file and the
+// current rendering of its contract
+func diffContract(golden, current []byte) ([]string, error) {
+	var old, cur any
+	if err := json.Unmarshal(golden, &old); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(current, &cur); err != nil {
+		return nil, err
+	}
+	var changes []string
+	diffJSON("", old, cur, &changes)
+	return changes, nil
+}
+
+func diffJSON(at string, old, cur any, changes *[]string) {
+	if jsonKind(old) != jsonKind(cur) {
+		*changes = append(*changes, fmt.Sprintf("%s changed from %s to %s", fieldPath(at), jsonKind(old), jsonKind(cur)))
+		return
+	}
+	switch old := old.(type) {
+	case map[string]any:
+		cur := cur.(map[string]any)
+		keys := make([]string, 0, len(old)+len(cur))
+		for key := range old {
+			keys = append(keys, key)
+		}
+		for key := range cur {
+			if _, ok := old[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			oldValue, inOld := old[key]
+			curValue, inCur := cur[key]
+			switch {
+			case !inCur:
+				*changes = append(*changes, fmt.Sprintf("%s removed", fieldPath(at+"."+key)))
+			case !inOld:
+				*changes = append(*changes, fmt.Sprintf("%s added", fieldPath(at+"."+key)))
+			default:
+				diffJSON(at+"."+key, oldValue, curValue, changes)
+			}
+		}
+	case []any:
+		cur := cur.([]any)
+		if len(old) > 0 && len(cur) > 0 {
+			diffJSON(at+"[]", old[0], cur[0], changes)
+		}
+	}
+}
+
+// jsonKind names the JSON type of a decoded value
+func jsonKind(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return "null"
+	}
+}
+
+func fieldPath(at string) string {
+	if at == "" {
+		return "the body"
+	}
+	return at[1:]
+}
+
+// contractSample returns a value of type t with every field set: one element in each slice
+// and map, pointers set, and fixed values so golden files don't change from run to run
+func contractSample(t reflect.Type) any {
+	if t == nil {
+		return nil
+	}
+	value := reflect.New(t).Elem()
+	fillSample(value, 0)
+	return value.Interface()
+}
+
+func fillSample(v reflect.Value, depth int) {
+	if depth > contractMaxDepth {
+		return
+	}
+	switch v.Type() {
+	case reflect.TypeOf(time.Time{}):
+		v.Set(reflect.ValueOf(contractSampleTime))
+		return
+	case reflect.TypeOf(time.Duration(0)):
+		v.SetInt(int64(time.Minute))
+		return
+	case reflect.TypeOf(json.RawMessage{}):
+		v.SetBytes([]byte("{}"))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("string")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fillSample(v.Index(i), depth+1)
+		}
+	case reflect.Slice:
+		slice := reflect.MakeSlice(v.Type(), 1, 1)
+		fillSample(slice.Index(0), depth+1)
+		v.Set(slice)
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		key := reflect.New(v.Type().Key()).Elem()
+		fillSample(key, depth+1)
+		elem := reflect.New(v.Type().Elem()).Elem()
+		fillSample(elem, depth+1)
+		m.SetMapIndex(key, elem)
+		v.Set(m)
+	case reflect.Pointer:
+		ptr := reflect.New(v.Type().Elem())
+		fillSample(ptr.Elem(), depth+1)
+		v.Set(ptr)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				fillSample(v.Field(i), depth+1)
+			}
+		}
+	}
+}
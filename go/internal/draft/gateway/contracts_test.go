@@ -0,0 +1,28 @@
+package gateway
+
+import (
+	"flag"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "write the current contracts to the golden files instead of checking them")
+
+// TestContracts checks the frames the gateway sends and the responses it serves against the
+// golden files clients were built against. Run with -update to accept an intended change, and
+// bump the protocol version for changes older clients can't read.
+func TestContracts(t *testing.T) {
+	if *update {
+		if err := WriteContracts(contractsDir); err != nil {
+			t.Fatalf("failed to write golden files: %v", err)
+		}
+		return
+	}
+
+	problems, err := CheckContracts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, problem := range problems {
+		t.Error(problem)
+	}
+}
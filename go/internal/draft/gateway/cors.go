@@ -1,41 +1,135 @@
 package gateway
 
-import "net/http"
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
 
-// CORS middleware to handle cross-origin requests
-func CORSMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Set CORS headers
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
-		w.Header().Set("Access-Control-Max-Age", "86400") // 24 hours
+// OriginPolicy decides which browser origins may use the gateway, enforced both by the
+// CORS middleware and by the WebSocket upgrader
+type OriginPolicy struct {
+	allowAll  bool
+	origins   map[string]bool
+	wildcards []wildcardOrigin
+}
 
-		// Handle preflight requests
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
+// wildcardOrigin matches every subdomain of domain over scheme, e.g. https://*.example.com
+type wildcardOrigin struct {
+	scheme string
+	domain string
+}
+
+// NewOriginPolicy builds a policy from origins such as "https://app.example.com".
+// "*" allows every origin, and "https://*.example.com" allows any subdomain of
+// example.com (but not example.com itself). An empty list allows no cross-origin use.
+func NewOriginPolicy(origins []string) OriginPolicy {
+	policy := OriginPolicy{origins: make(map[string]bool)}
+	for _, origin := range origins {
+		origin = strings.ToLower(strings.TrimSpace(origin))
+		switch {
+		case origin == "":
+		case origin == "*":
+			policy.allowAll = true
+		case strings.Contains(origin, "://*."):
+			scheme, domain, _ := strings.Cut(origin, "://*.")
+			policy.wildcards = append(policy.wildcards, wildcardOrigin{scheme: scheme, domain: strings.TrimSuffix(domain, "/")})
+		default:
+			policy.origins[strings.TrimSuffix(origin, "/")] = true
 		}
+	}
+	return policy
+}
 
-		// Pass to next handler
-		next.ServeHTTP(w, r)
-	})
+// ParseOrigins splits a comma separated origin list, as read from the environment
+func ParseOrigins(s string) []string {
+	var origins []string
+	for _, origin := range strings.Split(s, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// Allowed reports whether a request from origin is allowed
+func (p OriginPolicy) Allowed(origin string) bool {
+	if p.allowAll {
+		return true
+	}
+
+	u, err := url.Parse(strings.ToLower(origin))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return false
+	}
+	if p.origins[u.Scheme+"://"+u.Host] {
+		return true
+	}
+	for _, w := range p.wildcards {
+		if u.Scheme == w.scheme && strings.HasSuffix(u.Host, "."+w.domain) {
+			return true
+		}
+	}
+	return false
 }
 
-// CORSHandler wraps a HandlerFunc with CORS headers
-func CORSHandler(handler http.HandlerFunc) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		// Set CORS headers
+// CheckOrigin is the WebSocket upgrader's origin check. Requests without an Origin header
+// don't come from a browser and are allowed.
+func (p OriginPolicy) CheckOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	return origin == "" || p.Allowed(origin)
+}
+
+// setHeaders adds CORS headers for an allowed origin and reports whether it was allowed
+func (p OriginPolicy) setHeaders(w http.ResponseWriter, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if !p.Allowed(origin) {
+		return false
+	}
+
+	if p.allowAll {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
+	} else {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+	}
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
+	return true
+}
+
+// isPreflight reports whether r is a CORS preflight request
+func isPreflight(r *http.Request) bool {
+	return r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+}
+
+// CORSMiddleware adds CORS headers for origins the policy allows. Preflight requests are
+// answered here, and rejected with 403 for other origins; other requests from a disallowed
+// origin are served without CORS headers, so the browser withholds the response.
+func CORSMiddleware(policy OriginPolicy, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		allowed := policy.setHeaders(w, r)
 
 		// Handle preflight requests
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
+		if isPreflight(r) {
+			if !allowed {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Access-Control-Max-Age", "86400") // 24 hours
+			w.WriteHeader(http.StatusNoContent)
 			return
 		}
 
-		handler(w, r)
-	}
-}
\ No newline at end of file
+		// Pass to next handler
+		next.ServeHTTP(w, r)
+	})
+}
+
+// CORSHandler wraps a HandlerFunc with CORSMiddleware
+func CORSHandler(policy OriginPolicy, handler http.HandlerFunc) http.HandlerFunc {
+	return CORSMiddleware(policy, handler).ServeHTTP
+}
@@ -0,0 +1,91 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOriginPolicyWildcardSubdomains(t *testing.T) {
+	policy := NewOriginPolicy(ParseOrigins("https://app.example.com, https://*.example.org/"))
+
+	tests := []struct {
+		origin  string
+		allowed bool
+	}{
+		{"https://app.example.com", true},
+		{"HTTPS://App.Example.com", true},
+		{"https://other.example.com", false},
+		{"https://a.example.org", true},
+		{"https://a.b.example.org", true},
+		{"https://a.example.org:8443", false},
+		// The wildcard covers subdomains only, over its own scheme
+		{"https://example.org", false},
+		{"http://a.example.org", false},
+		{"https://evilexample.org", false},
+		{"https://example.org.evil.com", false},
+		{"null", false},
+	}
+	for _, tt := range tests {
+		if got := policy.Allowed(tt.origin); got != tt.allowed {
+			t.Errorf("Allowed(%q) = %v, want %v", tt.origin, got, tt.allowed)
+		}
+	}
+}
+
+func TestOriginPolicyDeniesByDefault(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/ws/draft", nil)
+	if !DefaultConnectionConfig().CheckOrigin(r) {
+		t.Error("default CheckOrigin rejected a request without an Origin header")
+	}
+	r.Header.Set("Origin", "https://app.example.com")
+	if DefaultConnectionConfig().CheckOrigin(r) {
+		t.Error("default CheckOrigin allowed a cross-origin connection")
+	}
+}
+
+func TestCORSMiddlewarePreflight(t *testing.T) {
+	var served int
+	handler := CORSMiddleware(NewOriginPolicy([]string{"https://*.example.com"}), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+	}))
+
+	preflight := func(origin string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodOptions, "/api/drafts/mine", nil)
+		r.Header.Set("Origin", origin)
+		r.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := preflight("https://app.example.com")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("preflight from an allowed origin answered %d, want %d", w.Code, http.StatusNoContent)
+	}
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want the request's origin", got)
+	}
+	if w.Header().Get("Access-Control-Max-Age") == "" || w.Header().Get("Vary") != "Origin" {
+		t.Errorf("preflight headers %v lack Access-Control-Max-Age or Vary: Origin", w.Header())
+	}
+
+	w = preflight("https://app.example.net")
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("preflight from a disallowed origin answered %d, want %d", w.Code, http.StatusForbidden)
+	}
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("disallowed preflight got Access-Control-Allow-Origin %q", got)
+	}
+	if served != 0 {
+		t.Errorf("preflights reached the handler %d times", served)
+	}
+
+	// A plain OPTIONS request isn't a preflight and is passed on
+	r := httptest.NewRequest(http.MethodOptions, "/api/drafts/mine", nil)
+	r.Header.Set("Origin", "https://app.example.com")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	if served != 1 {
+		t.Errorf("OPTIONS without Access-Control-Request-Method reached the handler %d times, want 1", served)
+	}
+}
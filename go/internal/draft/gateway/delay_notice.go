@@ -0,0 +1,209 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+)
+
+// DraftDelayedPayload tells a draft room whether its expired pick clock is waiting on an
+// orchestrator that's behind schedule, so clients can show a brief delay notice instead of a
+// clock stuck at zero. A notice with Delayed false follows once the draft has caught up.
+type DraftDelayedPayload struct {
+	Delayed bool `json:"delayed"`
+	// DelaySec is how long the pick has been waiting past its clock; 0 once caught up
+	DelaySec  int       `json:"delay_sec"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// DelayNoticeConfig configures the delay notices sent to draft rooms
+type DelayNoticeConfig struct {
+	// NATS is the server orchestrators report their load on; an empty URL turns delay notices off
+	NATS natsconfig.Config
+	// StaleAfter is how long an orchestrator's last report stands without a fresh one, so
+	// rooms aren't left showing a notice when an instance stops reporting
+	StaleAfter    time.Duration
+	MaxReconnects int
+	ReconnectWait time.Duration
+}
+
+// DefaultDelayNoticeConfig returns default configuration for delay notices
+func DefaultDelayNoticeConfig() DelayNoticeConfig {
+	return DelayNoticeConfig{
+		NATS:          natsconfig.Default(),
+		StaleAfter:    10 * time.Second,
+		MaxReconnects: -1, // Infinite
+		ReconnectWait: 2 * time.Second,
+	}
+}
+
+// loadReport is the last load report of an orchestrator instance
+type loadReport struct {
+	delayed    map[uuid.UUID]int // wait in seconds by draft
+	receivedAt time.Time
+}
+
+// DelayMonitor follows the load reports of the orchestrator instances and tells the rooms of
+// the drafts they're holding up, and later that they've caught up
+type DelayMonitor struct {
+	connectionManager *ConnectionManager
+	nc                *nats.Conn
+	sub               *nats.Subscription
+	config            DelayNoticeConfig
+
+	mu      sync.Mutex
+	reports map[string]loadReport
+	delayed map[uuid.UUID]bool
+}
+
+// NewDelayMonitor connects to NATS to follow orchestrator load reports
+func NewDelayMonitor(cm *ConnectionManager, config DelayNoticeConfig) (*DelayMonitor, error) {
+	opts := []nats.Option{
+		nats.MaxReconnects(config.MaxReconnects),
+		nats.ReconnectWait(config.ReconnectWait),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			log.Error().Err(err).Msg("NATS disconnected")
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Info().Str("url", nc.ConnectedUrl()).Msg("NATS reconnected")
+		}),
+	}
+
+	nc, err := config.NATS.Connect(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+
+	return &DelayMonitor{
+		connectionManager: cm,
+		nc:                nc,
+		config:            config,
+		reports:           make(map[string]loadReport),
+		delayed:           make(map[uuid.UUID]bool),
+	}, nil
+}
+
+// Start follows load reports, expiring stale ones, until ctx is cancelled
+func (dm *DelayMonitor) Start(ctx context.Context) error {
+	sub, err := dm.nc.Subscribe(events.OrchestratorLoadSubject, dm.handleReport)
+	if err != nil {
+		return fmt.Errorf("subscribe to load reports: %w", err)
+	}
+	dm.sub = sub
+
+	log.Info().Str("subject", events.OrchestratorLoadSubject).Msg("delay monitor started")
+
+	ticker := time.NewTicker(dm.config.StaleAfter / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			dm.update(time.Now())
+		}
+	}
+}
+
+// Stop unsubscribes from load reports and closes the NATS connection
+func (dm *DelayMonitor) Stop() error {
+	if dm.sub != nil {
+		if err := dm.sub.Unsubscribe(); err != nil {
+			log.Warn().Err(err).Msg("failed to unsubscribe from load reports")
+		}
+	}
+	if dm.nc != nil {
+		dm.nc.Close()
+	}
+	return nil
+}
+
+// handleReport records an orchestrator's load report
+func (dm *DelayMonitor) handleReport(msg *nats.Msg) {
+	var payload events.OrchestratorLoadPayload
+	if err := json.Unmarshal(msg.Data, &payload); err != nil {
+		log.Error().Err(err).Msg("failed to unmarshal load report")
+		return
+	}
+
+	report := loadReport{
+		delayed:    make(map[uuid.UUID]int, len(payload.DelayedDrafts)),
+		receivedAt: time.Now(),
+	}
+	for _, draft := range payload.DelayedDrafts {
+		draftID, err := uuid.Parse(draft.DraftID)
+		if err != nil {
+			continue
+		}
+		report.delayed[draftID] = int(draft.WaitMs / 1000)
+	}
+
+	dm.mu.Lock()
+	dm.reports[payload.InstanceID] = report
+	dm.mu.Unlock()
+
+	dm.update(report.receivedAt)
+}
+
+// update drops stale reports and tells the rooms of drafts that have started or stopped
+// waiting on an orchestrator
+func (dm *DelayMonitor) update(now time.Time) {
+	dm.mu.Lock()
+	waits := make(map[uuid.UUID]int)
+	for instanceID, report := range dm.reports {
+		if now.Sub(report.receivedAt) > dm.config.StaleAfter {
+			delete(dm.reports, instanceID)
+			continue
+		}
+		for draftID, wait := range report.delayed {
+			waits[draftID] = max(waits[draftID], wait)
+		}
+	}
+
+	var started, cleared []uuid.UUID
+	for draftID := range waits {
+		if !dm.delayed[draftID] {
+			dm.delayed[draftID] = true
+			started = append(started, draftID)
+		}
+	}
+	for draftID := range dm.delayed {
+		if _, ok := waits[draftID]; !ok {
+			delete(dm.delayed, draftID)
+			cleared = append(cleared, draftID)
+		}
+	}
+	dm.mu.Unlock()
+
+	for _, draftID := range started {
+		dm.announce(draftID, DraftDelayedPayload{Delayed: true, DelaySec: waits[draftID], ChangedAt: now})
+	}
+	for _, draftID := range cleared {
+		dm.announce(draftID, DraftDelayedPayload{Delayed: false, ChangedAt: now})
+	}
+}
+
+// announce sends a delay notice to the clients of this gateway in the draft's room
+func (dm *DelayMonitor) announce(draftID uuid.UUID, payload DraftDelayedPayload) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to marshal delay notice")
+		return
+	}
+	dm.connectionManager.BroadcastToDraft(draftID, &DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   draftID.String(),
+		Type:      EventTypeDraftDelayed,
+		Timestamp: payload.ChangedAt,
+		Data:      data,
+	})
+}
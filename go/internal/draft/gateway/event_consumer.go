@@ -6,7 +6,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/rs/zerolog/log"
@@ -14,43 +15,87 @@ import (
 
 // JetStreamConsumerConfig holds configuration for the JetStream consumer
 type JetStreamConsumerConfig struct {
-	URL               string
+	NATS              natsconfig.Config
 	StreamName        string
 	ConsumerName      string
-	SubjectFilter     string        // e.g., "draft.events.>"
+	SubjectFilters    []string      // e.g., "draft.picks.>"; every subject on the stream when empty
 	MaxDeliver        int           // Max delivery attempts
 	AckWait           time.Duration // How long to wait for ack
 	MaxAckPending     int           // Max messages pending ack
 	MaxReconnects     int
 	ReconnectWait     time.Duration
+	// PullConsumers is how many pull subscriptions fetch from the consumer in parallel; 0 for one
+	PullConsumers int
+	// Workers is how many events are relayed at once. A draft's events always go to the same
+	// worker, so they reach its room in order. 0 for one.
+	Workers int
+	// ReorderWait is how long an event that overtook an earlier one of its draft, pulled by
+	// another subscription, is held for the earlier one; 0 for defaultReorderWait. Only used
+	// with more than one pull subscription.
+	ReorderWait time.Duration
 }
 
-// DefaultJetStreamConsumerConfig returns default JetStream consumer configuration
+func (c JetStreamConsumerConfig) pullConsumers() int {
+	return max(c.PullConsumers, 1)
+}
+
+func (c JetStreamConsumerConfig) workers() int {
+	return max(c.Workers, 1)
+}
+
+// reorderWait returns how long events are held for a gap in their draft's sequence
+func (c JetStreamConsumerConfig) reorderWait() time.Duration {
+	if c.pullConsumers() == 1 {
+		return 0
+	}
+	if c.ReorderWait <= 0 {
+		return defaultReorderWait
+	}
+	return c.ReorderWait
+}
+
+// DefaultJetStreamConsumerConfig returns default configuration for the consumer of draft
+// lifecycle and pick events
 func DefaultJetStreamConsumerConfig() JetStreamConsumerConfig {
 	return JetStreamConsumerConfig{
-		URL:           nats.DefaultURL,
-		StreamName:    "DRAFT_EVENTS",
-		ConsumerName:  "draft-gateway",
-		SubjectFilter: "draft.events.>",
-		MaxDeliver:    5,
-		AckWait:       30 * time.Second,
-		MaxAckPending: 100,
-		MaxReconnects: -1, // Infinite
-		ReconnectWait: 2 * time.Second,
+		NATS:           natsconfig.Default(),
+		StreamName:     events.StateStream,
+		ConsumerName:   "draft-gateway",
+		SubjectFilters: events.StreamSubjects(events.StateStream),
+		MaxDeliver:     5,
+		AckWait:        30 * time.Second,
+		MaxAckPending:  100,
+		MaxReconnects:  -1, // Infinite
+		ReconnectWait:  2 * time.Second,
+		PullConsumers:  1,
+		Workers:        4,
 	}
 }
 
+// DefaultActivityJetStreamConsumerConfig returns default configuration for the consumer of
+// draft activity events, which has a stream of its own so a burst of activity can't hold up
+// picks
+func DefaultActivityJetStreamConsumerConfig() JetStreamConsumerConfig {
+	config := DefaultJetStreamConsumerConfig()
+	config.StreamName = events.ActivityStream
+	config.ConsumerName = "draft-gateway-activity"
+	config.SubjectFilters = events.StreamSubjects(events.ActivityStream)
+	return config
+}
+
 // EventConsumer consumes events from JetStream and broadcasts to WebSocket clients
 type EventConsumer struct {
 	connectionManager *ConnectionManager
+	projection        *DraftProjection
 	nc                *nats.Conn
 	js                jetstream.JetStream
 	consumer          jetstream.Consumer
 	config            JetStreamConsumerConfig
+	stats             consumerStats
 }
 
 // NewEventConsumer creates a new JetStream event consumer
-func NewEventConsumer(cm *ConnectionManager, config JetStreamConsumerConfig) (*EventConsumer, error) {
+func NewEventConsumer(cm *ConnectionManager, projection *DraftProjection, config JetStreamConsumerConfig) (*EventConsumer, error) {
 	opts := []nats.Option{
 		nats.MaxReconnects(config.MaxReconnects),
 		nats.ReconnectWait(config.ReconnectWait),
@@ -65,7 +110,7 @@ func NewEventConsumer(cm *ConnectionManager, config JetStreamConsumerConfig) (*E
 		}),
 	}
 
-	nc, err := nats.Connect(config.URL, opts...)
+	nc, err := config.NATS.Connect(opts...)
 	if err != nil {
 		return nil, fmt.Errorf("connect to NATS: %w", err)
 	}
@@ -78,10 +123,12 @@ func NewEventConsumer(cm *ConnectionManager, config JetStreamConsumerConfig) (*E
 
 	ec := &EventConsumer{
 		connectionManager: cm,
+		projection:        projection,
 		nc:                nc,
 		js:                js,
 		config:            config,
 	}
+	ec.stats.publish(config.ConsumerName, config.workers(), config.pullConsumers())
 
 	// Create or get consumer
 	if err := ec.ensureConsumer(context.Background()); err != nil {
@@ -104,7 +151,7 @@ func (ec *EventConsumer) ensureConsumer(ctx context.Context) error {
 		Name:           ec.config.ConsumerName,
 		Durable:        ec.config.ConsumerName, // Make it durable
 		Description:    "Draft gateway WebSocket consumer",
-		FilterSubject:  ec.config.SubjectFilter,
+		FilterSubjects: ec.config.SubjectFilters,
 		DeliverPolicy:  jetstream.DeliverLastPerSubjectPolicy, // Start with latest per subject
 		AckPolicy:      jetstream.AckExplicitPolicy,
 		MaxDeliver:     ec.config.MaxDeliver,
@@ -136,91 +183,103 @@ func (ec *EventConsumer) ensureConsumer(ctx context.Context) error {
 	return nil
 }
 
-// Start begins consuming events from JetStream
+// Start begins consuming events from JetStream. Each pull subscription hands the events it
+// receives to the worker of their draft, and the workers relay them to the draft rooms.
 func (ec *EventConsumer) Start(ctx context.Context) error {
 	log.Info().
 		Str("consumer", ec.config.ConsumerName).
 		Str("stream", ec.config.StreamName).
+		Int("pull_consumers", ec.config.pullConsumers()).
+		Int("workers", ec.config.workers()).
 		Msg("starting JetStream event consumer")
 
-	// Create message handler
-	messageCh := make(chan jetstream.Msg, 100)
-	
-	// Start consumer
-	consumeCtx, err := ec.consumer.Consume(func(msg jetstream.Msg) {
+	workers := make([]*consumerWorker, ec.config.workers())
+	for i := range workers {
+		workers[i] = newConsumerWorker(ec, ec.config.reorderWait())
+		go workers[i].run(ctx)
+	}
+
+	dispatch := func(msg jetstream.Msg) {
+		event, err := decodeInbound(msg)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("subject", msg.Subject()).
+				Msg("failed to process message")
+			if nakErr := msg.Nak(); nakErr != nil {
+				log.Error().Err(nakErr).Msg("failed to NAK message")
+			}
+			return
+		}
+
+		worker := workers[shardFor(event.draftID, len(workers))]
+		ec.stats.queued.Add(1)
 		select {
-		case messageCh <- msg:
+		case worker.in <- event:
 		case <-ctx.Done():
+			ec.stats.queued.Add(-1)
 			msg.Nak()
 		}
-	})
-	if err != nil {
-		return fmt.Errorf("start consumer: %w", err)
 	}
-	defer consumeCtx.Stop()
 
-	// Process messages
-	for {
-		select {
-		case <-ctx.Done():
-			log.Info().Msg("event consumer shutting down")
-			return nil
-		case msg := <-messageCh:
-			if err := ec.processMessage(ctx, msg); err != nil {
-				log.Error().
-					Err(err).
-					Str("subject", msg.Subject()).
-					Msg("failed to process message")
-				// Negative acknowledge to retry
-				if nakErr := msg.Nak(); nakErr != nil {
-					log.Error().Err(nakErr).Msg("failed to NAK message")
-				}
-			} else {
-				// Acknowledge successful processing
-				if ackErr := msg.Ack(); ackErr != nil {
-					log.Error().Err(ackErr).Msg("failed to ACK message")
-				}
-			}
+	for i := 0; i < ec.config.pullConsumers(); i++ {
+		consumeCtx, err := ec.consumer.Consume(dispatch)
+		if err != nil {
+			return fmt.Errorf("start consumer: %w", err)
 		}
+		defer consumeCtx.Stop()
 	}
-}
 
-// processMessage processes a single JetStream message
-func (ec *EventConsumer) processMessage(ctx context.Context, msg jetstream.Msg) error {
-	// Parse the event envelope
-	var envelope struct {
-		EventID   string          `json:"eventId"`
-		EventType string          `json:"eventType"`
-		DraftID   string          `json:"draftId"`
-		Timestamp time.Time       `json:"timestamp"`
-		Payload   json.RawMessage `json:"payload"`
-	}
+	<-ctx.Done()
+	log.Info().Msg("event consumer shutting down")
+	return nil
+}
 
-	if err := json.Unmarshal(msg.Data(), &envelope); err != nil {
-		return fmt.Errorf("unmarshal event envelope: %w", err)
-	}
+// processEvent relays a single JetStream event to the draft's room
+func (ec *EventConsumer) processEvent(ctx context.Context, event inboundEvent) error {
+	envelope := event.envelope
+	draftID := event.draftID
 
 	log.Debug().
 		Str("event_id", envelope.EventID).
 		Str("draft_id", envelope.DraftID).
 		Str("event_type", envelope.EventType).
-		Str("subject", msg.Subject()).
+		Str("subject", event.msg.Subject()).
 		Msg("processing JetStream event")
 
-	// Parse draft ID
-	draftID, err := uuid.Parse(envelope.DraftID)
-	if err != nil {
-		return fmt.Errorf("parse draft ID: %w", err)
-	}
-
 	// Convert to WebSocket event
 	wsEvent, err := ec.convertToWebSocketEvent(envelope.EventID, envelope.EventType, envelope.DraftID, envelope.Payload)
 	if err != nil {
 		return fmt.Errorf("convert to WebSocket event: %w", err)
 	}
+	wsEvent.Sequence = event.sequence
 
-	// Broadcast to connected clients
-	ec.connectionManager.BroadcastToDraft(draftID, wsEvent)
+	// Update the in-memory projection before clients see the event
+	ec.projection.Apply(wsEvent)
+
+	// Broadcast to connected clients, tearing the room down once the draft completes. Injury
+	// alerts only go to the managers of the team alerted, who may have queued the player
+	// without the rest of the room knowing, and watchlist alerts only to the watcher.
+	switch wsEvent.Type {
+	case EventTypeDraftCompleted:
+		ec.connectionManager.CloseDraft(draftID, wsEvent)
+	case EventTypePlayerInjuryAlert:
+		var payload events.PlayerInjuryAlertPayload
+		if err := json.Unmarshal(envelope.Payload, &payload); err != nil {
+			return fmt.Errorf("unmarshal injury alert: %w", err)
+		}
+		for _, userID := range payload.UserIDs {
+			ec.connectionManager.BroadcastToUser(draftID, userID, wsEvent)
+		}
+	case EventTypeWatchlistAlert:
+		var payload events.WatchlistAlertPayload
+		if err := json.Unmarshal(envelope.Payload, &payload); err != nil {
+			return fmt.Errorf("unmarshal watchlist alert: %w", err)
+		}
+		ec.connectionManager.BroadcastToUser(draftID, payload.UserID, wsEvent)
+	default:
+		ec.connectionManager.BroadcastToDraft(draftID, wsEvent)
+	}
 
 	log.Info().
 		Str("event_id", envelope.EventID).
@@ -233,22 +292,8 @@ func (ec *EventConsumer) processMessage(ctx context.Context, msg jetstream.Msg)
 
 // convertToWebSocketEvent converts a JetStream event to WebSocket event format
 func (ec *EventConsumer) convertToWebSocketEvent(eventID, eventType, draftID string, payload json.RawMessage) (*DraftEvent, error) {
-	// Map event types
-	var wsEventType EventType
-	switch eventType {
-	case "PickMade":
-		wsEventType = EventTypePickMade
-	case "PickStarted":
-		wsEventType = EventTypePickStarted
-	case "DraftStarted":
-		wsEventType = EventTypeDraftStarted
-	case "DraftCompleted":
-		wsEventType = EventTypeDraftCompleted
-	case "DraftPaused":
-		wsEventType = EventTypeDraftPaused
-	case "DraftResumed":
-		wsEventType = EventTypeDraftResumed
-	default:
+	wsEventType, ok := clientEventType(eventType)
+	if !ok {
 		return nil, fmt.Errorf("unknown event type: %s", eventType)
 	}
 
@@ -263,6 +308,71 @@ func (ec *EventConsumer) convertToWebSocketEvent(eventID, eventType, draftID str
 	return wsEvent, nil
 }
 
+// clientEventType maps the type of a draft event from the event stream to the type clients
+// receive it as, reporting false for events clients aren't sent
+func clientEventType(eventType string) (EventType, bool) {
+	switch eventType {
+	case "PickMade":
+		return EventTypePickMade, true
+	case "PickStarted":
+		return EventTypePickStarted, true
+	case "PickSlotReassigned":
+		return EventTypePickSlotReassigned, true
+	case "PickSkipped":
+		return EventTypePickSkipped, true
+	case "PickClockWarning":
+		return EventTypePickClockWarning, true
+	case "PickClockExtended":
+		return EventTypePickClockExtended, true
+	case "TeamAbandoned":
+		return EventTypeTeamAbandoned, true
+	case "TeamRestored":
+		return EventTypeTeamRestored, true
+	case "PlayerNews":
+		return EventTypePlayerNews, true
+	case "PlayerInjuryDesignated":
+		return EventTypePlayerInjuryDesignated, true
+	case "PlayerInjuryAlert":
+		return EventTypePlayerInjuryAlert, true
+	case "WatchlistAlert":
+		return EventTypeWatchlistAlert, true
+	case "SlotSelectionUpdated":
+		return EventTypeSlotSelectionUpdated, true
+	case "LobbyUpdated":
+		return EventTypeLobbyUpdated, true
+	case "AuctionUpdated":
+		return EventTypeAuctionUpdated, true
+	case "DraftAnalyticsUpdated":
+		return EventTypeDraftAnalyticsUpdated, true
+	case "DraftStartingSoon":
+		return EventTypeDraftStartingSoon, true
+	case "DraftRescheduled":
+		return EventTypeDraftRescheduled, true
+	case "MaintenanceScheduled":
+		return EventTypeMaintenanceScheduled, true
+	case "DraftStarted":
+		return EventTypeDraftStarted, true
+	case "DraftCompleted":
+		return EventTypeDraftCompleted, true
+	case "DraftSummary":
+		return EventTypeDraftSummary, true
+	case "DraftPaused":
+		return EventTypeDraftPaused, true
+	case "DraftResumed":
+		return EventTypeDraftResumed, true
+	case "PauseVoteUpdated":
+		return EventTypePauseVoteUpdated, true
+	case "PickTradeUpdated":
+		return EventTypePickTradeUpdated, true
+	case "CommissionerPresenceChanged":
+		return EventTypeCommissionerPresenceChanged, true
+	case "DraftCatchUp":
+		return EventTypeDraftCatchUp, true
+	default:
+		return "", false
+	}
+}
+
 // Stop gracefully shuts down the event consumer
 func (ec *EventConsumer) Stop() error {
 	log.Info().Msg("stopping event consumer")
@@ -14,19 +14,111 @@ type DraftEvent struct {
 	Type      EventType       `json:"type"`      // Event type
 	Timestamp time.Time       `json:"timestamp"` // Event creation time
 	Data      json.RawMessage `json:"data"`      // Event-specific payload
+	// Sequence orders the event within its draft and matches the event_sequence of state
+	// snapshots; zero for events the gateway generates itself
+	Sequence int64 `json:"sequence,omitempty"`
+	// AckRequired asks the client to answer with an Ack naming the event's ID; only set on
+	// frames sent to connections that negotiated the acks capability
+	AckRequired bool `json:"ack_required,omitempty"`
 }
 
 // EventType represents the type of draft event
 type EventType string
 
 const (
-	EventTypePickMade       EventType = "PickMade"
-	EventTypePickStarted    EventType = "PickStarted"
-	EventTypeDraftStarted   EventType = "DraftStarted"
-	EventTypeDraftPaused    EventType = "DraftPaused"
-	EventTypeDraftResumed   EventType = "DraftResumed"
-	EventTypeDraftCompleted EventType = "DraftCompleted"
-	EventTypeTimerTick      EventType = "TimerTick"
+	EventTypePickMade               EventType = "PickMade"
+	EventTypePickStarted            EventType = "PickStarted"
+	EventTypePickSlotReassigned     EventType = "PickSlotReassigned"
+	EventTypePickSkipped            EventType = "PickSkipped"
+	EventTypePickClockWarning       EventType = "PickClockWarning"
+	EventTypePickClockExtended      EventType = "PickClockExtended"
+	EventTypeTeamAbandoned          EventType = "TeamAbandoned"
+	EventTypeTeamRestored           EventType = "TeamRestored"
+	EventTypePlayerNews             EventType = "PlayerNews"
+	EventTypePlayerInjuryDesignated EventType = "PlayerInjuryDesignated"
+	EventTypePlayerInjuryAlert      EventType = "PlayerInjuryAlert"
+	EventTypeWatchlistAlert         EventType = "WatchlistAlert"
+	EventTypeSlotSelectionUpdated   EventType = "SlotSelectionUpdated"
+	EventTypeLobbyUpdated           EventType = "LobbyUpdated"
+	EventTypeAuctionUpdated         EventType = "AuctionUpdated"
+	EventTypeDraftAnalyticsUpdated  EventType = "DraftAnalyticsUpdated"
+	EventTypeDraftStartingSoon      EventType = "DraftStartingSoon"
+	EventTypeDraftRescheduled       EventType = "DraftRescheduled"
+	EventTypeMaintenanceScheduled   EventType = "MaintenanceScheduled"
+	EventTypeDraftStarted           EventType = "DraftStarted"
+	EventTypeDraftPaused            EventType = "DraftPaused"
+	EventTypeDraftResumed           EventType = "DraftResumed"
+	EventTypeDraftCatchUp           EventType = "DraftCatchUp"
+	EventTypeDraftCompleted         EventType = "DraftCompleted"
+	EventTypeDraftSummary           EventType = "DraftSummary"
+	EventTypeTimerTick              EventType = "TimerTick"
+	EventTypeDraftRoomClosing       EventType = "DraftRoomClosing"
+	EventTypeHello                  EventType = "Hello"
+	EventTypeClockSync              EventType = "ClockSync"
+	// EventTypeSnapshotLoaded is sent by clients once they have loaded state, never broadcast
+	EventTypeSnapshotLoaded EventType = "SnapshotLoaded"
+	// EventTypeSessionResumed follows the Hello frame of a resumed session
+	EventTypeSessionResumed EventType = "SessionResumed"
+	// EventTypeSubscribe is sent by clients to choose the event categories they receive,
+	// and answered with Subscribed
+	EventTypeSubscribe  EventType = "Subscribe"
+	EventTypeSubscribed EventType = "Subscribed"
+	// EventTypeAck is sent by clients to acknowledge a frame marked ack_required, never broadcast
+	EventTypeAck EventType = "Ack"
+	// EventTypePresenceChanged announces a user joining or leaving the draft room
+	EventTypePresenceChanged EventType = "PresenceChanged"
+	// EventTypeDraftDelayed tells the room its pick is waiting on an orchestrator that's
+	// behind schedule, and again once it has caught up
+	EventTypeDraftDelayed EventType = "DraftDelayed"
+	// EventTypePauseVoteUpdated reports the progress of a vote to pause the draft
+	EventTypePauseVoteUpdated EventType = "PauseVoteUpdated"
+	// EventTypePickTradeUpdated reports a live pick trade offer opening and closing
+	EventTypePickTradeUpdated EventType = "PickTradeUpdated"
+	// EventTypePickReactionsUpdated reports a pick's reaction counts after a user in the room
+	// reacted to it, only to connections that negotiated the reactions capability
+	EventTypePickReactionsUpdated EventType = "PickReactionsUpdated"
+	// EventTypeConnectionQuality tells a connection that negotiated the connection_quality
+	// capability how well it keeps up, after its first ping and whenever that changes
+	EventTypeConnectionQuality EventType = "ConnectionQuality"
+	// EventTypeCommissionerPresenceChanged tells the room of a draft that pauses while its
+	// commissioner is away that they left, and when the draft pauses, or came back
+	EventTypeCommissionerPresenceChanged EventType = "CommissionerPresenceChanged"
+
+	// Live scoring frames, sent on matchup connections
+	EventTypeMatchupsWatched     EventType = "MatchupsWatched"
+	EventTypeMatchupScoreUpdated EventType = "MatchupScoreUpdated"
+
+	// EventTypeDraftsWatched is the first frame on a user stream, listing the drafts it relays
+	EventTypeDraftsWatched EventType = "DraftsWatched"
+
+	// Chat frames, only exchanged with connections that negotiated the chat capability
+	EventTypeChatMessage         EventType = "ChatMessage"
+	EventTypeChatRejected        EventType = "ChatRejected"
+	EventTypeChatRoomMuteChanged EventType = "ChatRoomMuteChanged"
+	EventTypeChatListsUpdated    EventType = "ChatListsUpdated"
+	// Chat commands sent by clients, never broadcast
+	EventTypeChatMute     EventType = "ChatMute"
+	EventTypeChatUnmute   EventType = "ChatUnmute"
+	EventTypeChatBlock    EventType = "ChatBlock"
+	EventTypeChatUnblock  EventType = "ChatUnblock"
+	EventTypeChatRoomMute EventType = "ChatRoomMute"
+
+	// Pause vote commands sent by clients that negotiated the pause_votes capability, and
+	// the frame answering a command that failed; never broadcast
+	EventTypePauseVoteStart    EventType = "PauseVoteStart"
+	EventTypePauseVoteCast     EventType = "PauseVoteCast"
+	EventTypePauseVoteRejected EventType = "PauseVoteRejected"
+
+	// Pick trade commands sent by clients that negotiated the pick_trades capability, and the
+	// frame answering a command that failed; never broadcast
+	EventTypePickTradePropose  EventType = "PickTradePropose"
+	EventTypePickTradeRespond  EventType = "PickTradeRespond"
+	EventTypePickTradeRejected EventType = "PickTradeRejected"
+
+	// Reaction commands sent by clients that negotiated the reactions capability, and the frame
+	// answering a command that failed; never broadcast
+	EventTypePickReact            EventType = "PickReact"
+	EventTypePickReactionRejected EventType = "PickReactionRejected"
 )
 
 // Event Payloads are now in the events package to avoid cyclic imports
@@ -39,6 +131,16 @@ type TimerTickPayload struct {
 	TickedAt         time.Time `json:"ticked_at"`
 }
 
+// DraftRoomClosingPayload is the final frame sent to a completed draft's room before
+// the gateway closes its connections
+type DraftRoomClosingPayload struct {
+	DraftID     string    `json:"draft_id"`
+	CompletedAt time.Time `json:"completed_at"`
+	Duration    string    `json:"duration"`
+	TotalPicks  int       `json:"total_picks"`
+	ClosesAt    time.Time `json:"closes_at"`
+}
+
 // ParseEventPayload parses event data into the appropriate payload struct
 func ParseEventPayload(event *DraftEvent) (interface{}, error) {
 	switch event.Type {
@@ -56,6 +158,125 @@ func ParseEventPayload(event *DraftEvent) (interface{}, error) {
 		}
 		return payload, nil
 
+	case EventTypePickSlotReassigned:
+		var payload events.PickSlotReassignedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypePickSkipped:
+		var payload events.PickSkippedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypePickClockWarning:
+		var payload events.PickClockWarningPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypePickClockExtended:
+		var payload events.PickClockExtendedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeTeamAbandoned:
+		var payload events.TeamAbandonedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeTeamRestored:
+		var payload events.TeamRestoredPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypePlayerNews:
+		var payload events.PlayerNewsPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypePlayerInjuryDesignated:
+		var payload events.PlayerInjuryDesignatedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypePlayerInjuryAlert:
+		var payload events.PlayerInjuryAlertPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeWatchlistAlert:
+		var payload events.WatchlistAlertPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeSlotSelectionUpdated:
+		var payload events.SlotSelectionUpdatedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeLobbyUpdated:
+		var payload events.LobbyUpdatedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeAuctionUpdated:
+		var payload events.AuctionUpdatedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeDraftAnalyticsUpdated:
+		var payload events.DraftAnalyticsUpdatedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeDraftStartingSoon:
+		var payload events.DraftStartingSoonPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeDraftRescheduled:
+		var payload events.DraftRescheduledPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeMaintenanceScheduled:
+		var payload events.MaintenanceScheduledPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
 	case EventTypeDraftStarted:
 		var payload events.DraftStartedPayload
 		if err := json.Unmarshal(event.Data, &payload); err != nil {
@@ -77,6 +298,34 @@ func ParseEventPayload(event *DraftEvent) (interface{}, error) {
 		}
 		return payload, nil
 
+	case EventTypePauseVoteUpdated:
+		var payload events.PauseVoteUpdatedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypePickTradeUpdated:
+		var payload events.PickTradeUpdatedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeCommissionerPresenceChanged:
+		var payload events.CommissionerPresenceChangedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeDraftCatchUp:
+		var payload events.DraftCatchUpPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
 	case EventTypeDraftCompleted:
 		var payload events.DraftCompletedPayload
 		if err := json.Unmarshal(event.Data, &payload); err != nil {
@@ -84,6 +333,13 @@ func ParseEventPayload(event *DraftEvent) (interface{}, error) {
 		}
 		return payload, nil
 
+	case EventTypeDraftSummary:
+		var payload events.DraftSummaryPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
 	case EventTypeTimerTick:
 		var payload TimerTickPayload
 		if err := json.Unmarshal(event.Data, &payload); err != nil {
@@ -91,6 +347,104 @@ func ParseEventPayload(event *DraftEvent) (interface{}, error) {
 		}
 		return payload, nil
 
+	case EventTypeDraftRoomClosing:
+		var payload DraftRoomClosingPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeHello:
+		var payload HelloPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeSessionResumed:
+		var payload SessionResumedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeSubscribed:
+		var payload SubscribedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypePresenceChanged:
+		var payload PresenceChangedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeDraftDelayed:
+		var payload DraftDelayedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeClockSync:
+		var payload ClockSyncPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeConnectionQuality:
+		var payload ConnectionQualityPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeChatMessage:
+		var payload ChatMessagePayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeChatRejected:
+		var payload ChatRejectedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeChatRoomMuteChanged:
+		var payload ChatRoomMuteChangedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypeChatListsUpdated:
+		var payload ChatListsPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypePickReactionsUpdated:
+		var payload PickReactionsPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
+	case EventTypePickReactionRejected:
+		var payload PickReactionRejectedPayload
+		if err := json.Unmarshal(event.Data, &payload); err != nil {
+			return nil, err
+		}
+		return payload, nil
+
 	default:
 		return nil, nil // Unknown event type
 	}
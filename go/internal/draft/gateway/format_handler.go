@@ -0,0 +1,113 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/i18n"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/text/language/display"
+)
+
+// maxFormatValues caps how many values of each kind one format request may ask for
+const maxFormatValues = 500
+
+// maxFormatBodyBytes caps the size of a format request body
+const maxFormatBodyBytes = 1 << 20
+
+// defaultFormatFractionDigits is how many decimals numbers are formatted with by default
+const defaultFormatFractionDigits = 2
+
+// formatRequest is the body of POST /api/format: values to format for display in the locale
+type formatRequest struct {
+	Numbers           []float64   `json:"numbers"`
+	MaxFractionDigits *int        `json:"max_fraction_digits,omitempty"` // default 2
+	Times             []time.Time `json:"times"`
+	Timezone          string      `json:"timezone,omitempty"` // IANA name the times are shown in; UTC when unset
+	PlayerNames       []string    `json:"player_names"`
+}
+
+// FormatResponse holds the values of a format request formatted for display in a locale
+type FormatResponse struct {
+	Locale  string   `json:"locale"`
+	Numbers []string `json:"numbers"`
+	Times   []string `json:"times"`
+	// PlayerNames are the names sorted by family name in the locale's alphabetical order
+	PlayerNames []string `json:"player_names"`
+}
+
+// LocaleInfo describes a locale responses can be localized for
+type LocaleInfo struct {
+	Tag  string `json:"tag"`
+	Name string `json:"name"` // in the locale itself, e.g. "Deutsch"
+}
+
+// HandleGetLocales handles GET /api/locales, listing the locales responses can be localized for,
+// the default first
+func (h *StateHandler) HandleGetLocales(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	locales := make([]LocaleInfo, len(i18n.Supported))
+	for i, tag := range i18n.Supported {
+		locales[i] = LocaleInfo{
+			Tag:  tag.String(),
+			Name: display.Self.Name(tag),
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(locales); err != nil {
+		log.Error().Err(err).Msg("failed to encode locales response")
+	}
+}
+
+// HandleFormat handles POST /api/format, formatting numbers, times and player names for display
+// in the request's locale, so clients show them the same way the server's own strings do
+func (h *StateHandler) HandleFormat(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var body formatRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxFormatBodyBytes)).Decode(&body); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if len(body.Numbers) > maxFormatValues || len(body.Times) > maxFormatValues || len(body.PlayerNames) > maxFormatValues {
+		http.Error(w, "at most 500 numbers, times and player names may be formatted at once", http.StatusBadRequest)
+		return
+	}
+	fractionDigits := defaultFormatFractionDigits
+	if body.MaxFractionDigits != nil {
+		if *body.MaxFractionDigits < 0 || *body.MaxFractionDigits > 6 {
+			http.Error(w, "max_fraction_digits must be between 0 and 6", http.StatusBadRequest)
+			return
+		}
+		fractionDigits = *body.MaxFractionDigits
+	}
+
+	formatter := i18n.NewFormatter(i18n.FromContext(r.Context()))
+	resp := FormatResponse{
+		Locale:      formatter.Locale().String(),
+		Numbers:     make([]string, len(body.Numbers)),
+		Times:       make([]string, len(body.Times)),
+		PlayerNames: append([]string{}, body.PlayerNames...),
+	}
+	for i, n := range body.Numbers {
+		resp.Numbers[i] = formatter.Number(n, fractionDigits)
+	}
+	for i, t := range body.Times {
+		resp.Times[i] = formatter.DateTime(t, body.Timezone)
+	}
+	formatter.SortPlayerNames(resp.PlayerNames)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Error().Err(err).Msg("failed to encode format response")
+	}
+}
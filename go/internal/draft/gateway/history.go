@@ -0,0 +1,95 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrHistoryPointNotReached is returned for a point in a draft's history its event log
+// doesn't reach yet
+var ErrHistoryPointNotReached = errors.New("draft event log does not reach that point")
+
+// DraftHistory reconstructs the state of a draft as it stood at a point in its event log
+type DraftHistory interface {
+	GetDraftStateAt(ctx context.Context, draftID uuid.UUID, point HistoryPoint) (*DraftStateResponse, error)
+}
+
+// HistoryPoint is a point in a draft's event log: just after the event with Sequence, or
+// else just after the last event written at or before At
+type HistoryPoint struct {
+	Sequence int64
+	At       time.Time
+}
+
+// HandleGetDraftStateAt handles GET /api/drafts/{id}/state/at?sequence=N or ?at=<RFC 3339 time>,
+// serving the draft's state as it stood at that point, rebuilt from the draft's event log, in
+// the shape of the live state. It's for settling disputes over what was on the board when.
+func (h *StateHandler) HandleGetDraftStateAt(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if h.history == nil {
+		http.Error(w, "Draft history is not available", http.StatusNotImplemented)
+		return
+	}
+
+	draftID, ok := parseDraftIDFromPath(w, r.URL.Path, "/state/at")
+	if !ok {
+		return
+	}
+
+	point, err := parseHistoryPoint(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	state, err := h.history.GetDraftStateAt(r.Context(), draftID, point)
+	if err != nil {
+		if errors.Is(err, ErrHistoryPointNotReached) {
+			http.Error(w, "Draft has not reached that point", http.StatusNotFound)
+			return
+		}
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to rebuild draft state")
+		http.Error(w, "Failed to rebuild draft state", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		log.Error().Err(err).Msg("failed to encode draft state response")
+	}
+}
+
+// parseHistoryPoint reads the point in a draft's history from exactly one of the sequence
+// and at query parameters
+func parseHistoryPoint(r *http.Request) (HistoryPoint, error) {
+	query := r.URL.Query()
+	sequence, at := query.Get("sequence"), query.Get("at")
+	switch {
+	case sequence != "" && at != "":
+		return HistoryPoint{}, errors.New("pass one of sequence and at, not both")
+	case sequence != "":
+		seq, err := strconv.ParseInt(sequence, 10, 64)
+		if err != nil || seq <= 0 {
+			return HistoryPoint{}, errors.New("sequence must be a positive integer")
+		}
+		return HistoryPoint{Sequence: seq}, nil
+	case at != "":
+		t, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return HistoryPoint{}, errors.New("at must be an RFC 3339 time")
+		}
+		return HistoryPoint{At: t}, nil
+	default:
+		return HistoryPoint{}, errors.New("sequence or at is required")
+	}
+}
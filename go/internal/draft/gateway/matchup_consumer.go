@@ -0,0 +1,231 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/mcdev12/dynasty/go/internal/scoring"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+)
+
+// liveScoringMaxAge is how long the live scoring stream keeps a matchup's latest score
+const liveScoringMaxAge = 7 * 24 * time.Hour
+
+// DefaultMatchupJetStreamConsumerConfig returns default configuration for the live scoring consumer
+func DefaultMatchupJetStreamConsumerConfig() JetStreamConsumerConfig {
+	return JetStreamConsumerConfig{
+		NATS:           natsconfig.Default(),
+		StreamName:     scoring.LiveScoringStream,
+		ConsumerName:   "matchup-gateway",
+		SubjectFilters: []string{scoring.LiveScoringSubjectPrefix + ".>"},
+		MaxDeliver:     5,
+		AckWait:        30 * time.Second,
+		MaxAckPending:  100,
+		MaxReconnects:  -1, // Infinite
+		ReconnectWait:  2 * time.Second,
+	}
+}
+
+// MatchupEventConsumer consumes live scoring updates from JetStream and relays them to the
+// rooms of the matchups they score
+type MatchupEventConsumer struct {
+	connectionManager *ConnectionManager
+	nc                *nats.Conn
+	js                jetstream.JetStream
+	consumer          jetstream.Consumer
+	config            JetStreamConsumerConfig
+}
+
+// NewMatchupEventConsumer creates a new live scoring consumer
+func NewMatchupEventConsumer(cm *ConnectionManager, config JetStreamConsumerConfig) (*MatchupEventConsumer, error) {
+	opts := []nats.Option{
+		nats.MaxReconnects(config.MaxReconnects),
+		nats.ReconnectWait(config.ReconnectWait),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			log.Error().Err(err).Msg("NATS disconnected")
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Info().Str("url", nc.ConnectedUrl()).Msg("NATS reconnected")
+		}),
+		nats.ErrorHandler(func(nc *nats.Conn, sub *nats.Subscription, err error) {
+			log.Error().Err(err).Msg("NATS error")
+		}),
+	}
+
+	nc, err := config.NATS.Connect(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("create JetStream context: %w", err)
+	}
+
+	mc := &MatchupEventConsumer{
+		connectionManager: cm,
+		nc:                nc,
+		js:                js,
+		config:            config,
+	}
+
+	if err := mc.ensureConsumer(context.Background()); err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("ensure consumer: %w", err)
+	}
+
+	return mc, nil
+}
+
+// ensureConsumer creates or gets the JetStream consumer. The stream is created when missing
+// so the gateway can start before any scores have been published; it keeps only the latest
+// update per matchup, since each carries the full score.
+func (mc *MatchupEventConsumer) ensureConsumer(ctx context.Context) error {
+	stream, err := mc.js.Stream(ctx, mc.config.StreamName)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		stream, err = mc.js.CreateStream(ctx, jetstream.StreamConfig{
+			Name:              mc.config.StreamName,
+			Description:       "Live matchup scoring updates",
+			Subjects:          []string{scoring.LiveScoringSubjectPrefix + ".>"},
+			Retention:         jetstream.LimitsPolicy,
+			MaxAge:            liveScoringMaxAge,
+			MaxMsgsPerSubject: 1,
+			Storage:           jetstream.FileStorage,
+			Replicas:          1,
+		})
+		if err == nil {
+			log.Info().Str("stream", mc.config.StreamName).Msg("created JetStream stream")
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("get stream: %w", err)
+	}
+
+	consumer, err := stream.Consumer(ctx, mc.config.ConsumerName)
+	if err != nil {
+		consumer, err = stream.CreateConsumer(ctx, jetstream.ConsumerConfig{
+			Name:           mc.config.ConsumerName,
+			Durable:        mc.config.ConsumerName,
+			Description:    "Matchup gateway live scoring consumer",
+			FilterSubjects: mc.config.SubjectFilters,
+			DeliverPolicy:  jetstream.DeliverLastPerSubjectPolicy, // Start with the latest score per matchup
+			AckPolicy:      jetstream.AckExplicitPolicy,
+			MaxDeliver:     mc.config.MaxDeliver,
+			AckWait:        mc.config.AckWait,
+			MaxAckPending:  mc.config.MaxAckPending,
+			ReplayPolicy:   jetstream.ReplayInstantPolicy,
+		})
+		if err != nil {
+			return fmt.Errorf("create consumer: %w", err)
+		}
+		log.Info().
+			Str("consumer", mc.config.ConsumerName).
+			Str("stream", mc.config.StreamName).
+			Msg("created JetStream consumer")
+	} else {
+		log.Info().
+			Str("consumer", mc.config.ConsumerName).
+			Str("stream", mc.config.StreamName).
+			Msg("using existing JetStream consumer")
+	}
+
+	mc.consumer = consumer
+	return nil
+}
+
+// Start begins consuming live scoring updates from JetStream
+func (mc *MatchupEventConsumer) Start(ctx context.Context) error {
+	log.Info().
+		Str("consumer", mc.config.ConsumerName).
+		Str("stream", mc.config.StreamName).
+		Msg("starting live scoring consumer")
+
+	messageCh := make(chan jetstream.Msg, 100)
+
+	consumeCtx, err := mc.consumer.Consume(func(msg jetstream.Msg) {
+		select {
+		case messageCh <- msg:
+		case <-ctx.Done():
+			msg.Nak()
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("start consumer: %w", err)
+	}
+	defer consumeCtx.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("live scoring consumer shutting down")
+			return nil
+		case msg := <-messageCh:
+			if err := mc.processMessage(msg); err != nil {
+				log.Error().
+					Err(err).
+					Str("subject", msg.Subject()).
+					Msg("failed to process scoring update")
+				if nakErr := msg.Nak(); nakErr != nil {
+					log.Error().Err(nakErr).Msg("failed to NAK message")
+				}
+			} else if ackErr := msg.Ack(); ackErr != nil {
+				log.Error().Err(ackErr).Msg("failed to ACK message")
+			}
+		}
+	}
+}
+
+// processMessage relays a scoring update to the room of the matchup it scores
+func (mc *MatchupEventConsumer) processMessage(msg jetstream.Msg) error {
+	var update scoring.MatchupScoreUpdate
+	if err := json.Unmarshal(msg.Data(), &update); err != nil {
+		return fmt.Errorf("unmarshal scoring update: %w", err)
+	}
+	if update.MatchupID == uuid.Nil {
+		return errors.New("scoring update has no matchup ID")
+	}
+
+	eventID := update.EventID
+	if eventID == "" {
+		eventID = uuid.New().String()
+	}
+	timestamp := update.UpdatedAt
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	mc.connectionManager.BroadcastToMatchup(update.MatchupID, &MatchupEvent{
+		ID:        eventID,
+		MatchupID: update.MatchupID.String(),
+		Type:      EventTypeMatchupScoreUpdated,
+		Timestamp: timestamp,
+		Data:      msg.Data(),
+	})
+
+	log.Debug().
+		Str("event_id", eventID).
+		Str("matchup_id", update.MatchupID.String()).
+		Bool("final", update.Final).
+		Msg("scoring update relayed to WebSocket clients")
+
+	return nil
+}
+
+// Stop gracefully shuts down the live scoring consumer
+func (mc *MatchupEventConsumer) Stop() error {
+	log.Info().Msg("stopping live scoring consumer")
+
+	if mc.nc != nil {
+		mc.nc.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,298 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	schedulev1 "github.com/mcdev12/dynasty/go/internal/genproto/schedule/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/schedule/v1/schedulev1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/rs/zerolog/log"
+)
+
+// UserMatchupsProvider lists the matchups a user's teams play this season
+type UserMatchupsProvider interface {
+	GetUserMatchups(ctx context.Context, userID uuid.UUID, week *int) ([]UserMatchupSummary, error)
+}
+
+// UserMatchupSummary is a matchup one of the user's teams plays in
+type UserMatchupSummary struct {
+	MatchupID  string `json:"matchup_id"`
+	LeagueID   string `json:"league_id"`
+	LeagueName string `json:"league_name"`
+	Season     string `json:"season"`
+	Week       int    `json:"week"`
+	TeamID     string `json:"team_id"` // the user's team
+	HomeTeamID string `json:"home_team_id"`
+	AwayTeamID string `json:"away_team_id"`
+}
+
+// MatchupEvent is a frame sent on a matchup connection
+type MatchupEvent struct {
+	ID        string          `json:"id"`
+	MatchupID string          `json:"matchup_id,omitempty"` // unset on frames about the connection itself
+	Type      EventType       `json:"type"`
+	Timestamp time.Time       `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// MatchupsWatchedPayload is the first frame on a matchup connection, listing the matchups it
+// receives scores for
+type MatchupsWatchedPayload struct {
+	ConnectionID string               `json:"connection_id"`
+	Matchups     []UserMatchupSummary `json:"matchups"`
+	ServerTime   time.Time            `json:"server_time"`
+}
+
+type matchupBroadcast struct {
+	MatchupID uuid.UUID
+	Event     *MatchupEvent
+}
+
+// latestScore is the last score frame relayed for a matchup, sent to connections that start
+// watching it so they don't wait for the next play
+type latestScore struct {
+	data      []byte
+	updatedAt time.Time
+}
+
+// UpgradeMatchupConnection upgrades an HTTP connection to a WebSocket receiving live scores
+// for the given matchups. The connection joins each matchup's room and is sent the latest
+// score already relayed for it. A connection over the gateway or user cap is turned away
+// with an *AdmissionError before upgrading.
+func (cm *ConnectionManager) UpgradeMatchupConnection(w http.ResponseWriter, r *http.Request, userID uuid.UUID, matchups []UserMatchupSummary, queueTicket string) error {
+	matchupIDs := make([]uuid.UUID, len(matchups))
+	for i, matchup := range matchups {
+		matchupID, err := uuid.Parse(matchup.MatchupID)
+		if err != nil {
+			return fmt.Errorf("invalid matchup ID %q: %w", matchup.MatchupID, err)
+		}
+		matchupIDs[i] = matchupID
+	}
+
+	// Live scoring connections count against the gateway and user caps but aren't in a draft room
+	release, err := cm.admission.admit(uuid.Nil, userID.String(), queueTicket, false)
+	if err != nil {
+		return err
+	}
+
+	conn, err := cm.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		release()
+		log.Error().Err(err).Msg("failed to upgrade WebSocket connection")
+		return fmt.Errorf("failed to upgrade connection: %w", err)
+	}
+
+	connection := &Connection{
+		ID:     uuid.New().String(),
+		UserID: userID.String(),
+		User:   &userID,
+		Conn:   conn,
+		// Room for the watched frame and a latest score per matchup on top of live frames
+		Send:        make(chan []byte, 256+len(matchupIDs)),
+		Manager:     cm,
+		ConnectedAt: time.Now(),
+		LastPing:    time.Now(),
+		Matchups:    matchupIDs,
+		release:     release,
+	}
+
+	// Queue the watched frame before the connection is registered so it is always the
+	// first frame the client reads
+	if watched, err := matchupsWatchedEvent(connection, matchups); err != nil {
+		log.Error().Err(err).Str("connection_id", connection.ID).Msg("failed to build matchups watched frame")
+	} else {
+		connection.Send <- watched
+	}
+
+	cm.registerMatchupConnection(connection)
+
+	// Start connection handlers
+	go connection.writePump()
+	go connection.readPump()
+
+	log.Info().
+		Str("connection_id", connection.ID).
+		Stringer("user_id", userID).
+		Int("matchups", len(matchupIDs)).
+		Msg("matchup WebSocket connection established")
+
+	return nil
+}
+
+// matchupsWatchedEvent renders the frame telling a client which matchups it is watching
+func matchupsWatchedEvent(c *Connection, matchups []UserMatchupSummary) ([]byte, error) {
+	data, err := json.Marshal(MatchupsWatchedPayload{
+		ConnectionID: c.ID,
+		Matchups:     matchups,
+		ServerTime:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&MatchupEvent{
+		ID:        uuid.New().String(),
+		Type:      EventTypeMatchupsWatched,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
+
+// registerMatchupConnection adds a connection to the room of each matchup it watches and
+// queues the latest score of each. Done under the same lock the broadcast goroutine records
+// scores under, so a connection gets every score after the one it was sent.
+func (cm *ConnectionManager) registerMatchupConnection(conn *Connection) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	for _, matchupID := range conn.Matchups {
+		if cm.matchupConnections[matchupID] == nil {
+			cm.matchupConnections[matchupID] = make(map[*Connection]bool)
+		}
+		cm.matchupConnections[matchupID][conn] = true
+
+		if score, ok := cm.matchupScores[matchupID]; ok {
+			conn.Send <- score.data
+		}
+	}
+
+	log.Debug().
+		Str("connection_id", conn.ID).
+		Int("matchups", len(conn.Matchups)).
+		Msg("matchup connection registered")
+}
+
+// removeMatchupConnection drops a connection from its matchups' rooms and reports whether it was there
+func (cm *ConnectionManager) removeMatchupConnection(conn *Connection) bool {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	registered := false
+	for _, matchupID := range conn.Matchups {
+		connections := cm.matchupConnections[matchupID]
+		if _, exists := connections[conn]; !exists {
+			continue
+		}
+		registered = true
+		delete(connections, conn)
+		if len(connections) == 0 {
+			delete(cm.matchupConnections, matchupID)
+		}
+	}
+	if !registered {
+		return false
+	}
+	close(conn.Send)
+	conn.release()
+
+	log.Info().
+		Str("connection_id", conn.ID).
+		Str("user_id", conn.UserID).
+		Msg("matchup connection unregistered")
+	return true
+}
+
+// BroadcastToMatchup sends a scoring frame to every connection watching a matchup
+func (cm *ConnectionManager) BroadcastToMatchup(matchupID uuid.UUID, event *MatchupEvent) {
+	select {
+	case cm.matchupCh <- matchupBroadcast{MatchupID: matchupID, Event: event}:
+	default:
+		log.Warn().Str("matchup_id", matchupID.String()).Msg("matchup broadcast channel full, dropping score")
+	}
+}
+
+// handleMatchupBroadcast records a matchup's latest score and sends it to the matchup's room.
+// A score older than the one already relayed, such as a redelivered message, is dropped.
+func (cm *ConnectionManager) handleMatchupBroadcast(message matchupBroadcast) {
+	eventData, err := json.Marshal(message.Event)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to marshal matchup event for broadcast")
+		return
+	}
+
+	cm.mu.Lock()
+	if latest, ok := cm.matchupScores[message.MatchupID]; ok && latest.updatedAt.After(message.Event.Timestamp) {
+		cm.mu.Unlock()
+		return
+	}
+	cm.matchupScores[message.MatchupID] = latestScore{data: eventData, updatedAt: message.Event.Timestamp}
+
+	targetConnections := make([]*Connection, 0, len(cm.matchupConnections[message.MatchupID]))
+	for conn := range cm.matchupConnections[message.MatchupID] {
+		targetConnections = append(targetConnections, conn)
+	}
+	cm.mu.Unlock()
+
+	for _, conn := range targetConnections {
+		cm.deliver(conn, 0, eventData)
+	}
+
+	log.Debug().
+		Str("matchup_id", message.MatchupID.String()).
+		Int("connections", len(targetConnections)).
+		Msg("matchup score broadcasted")
+}
+
+// pruneMatchupScores forgets the latest scores of matchups that haven't scored within the retention period
+func (cm *ConnectionManager) pruneMatchupScores() {
+	cutoff := time.Now().Add(-cm.config.LiveScoreRetention)
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	for matchupID, score := range cm.matchupScores {
+		if score.updatedAt.Before(cutoff) {
+			delete(cm.matchupScores, matchupID)
+		}
+	}
+}
+
+// MatchupProvider implements UserMatchupsProvider using the schedule service client
+type MatchupProvider struct {
+	scheduleService schedulev1connect.ScheduleServiceClient
+}
+
+// NewMatchupProvider creates a new matchup provider
+func NewMatchupProvider(scheduleService schedulev1connect.ScheduleServiceClient) *MatchupProvider {
+	return &MatchupProvider{
+		scheduleService: scheduleService,
+	}
+}
+
+// GetUserMatchups lists the matchups the user's teams play this season, or only in the given week
+func (p *MatchupProvider) GetUserMatchups(ctx context.Context, userID uuid.UUID, week *int) ([]UserMatchupSummary, error) {
+	msg := &schedulev1.ListUserMatchupsRequest{
+		UserId: userID.String(),
+	}
+	if week != nil {
+		w := int32(*week)
+		msg.Week = &w
+	}
+	req := connect.NewRequest(msg)
+	req.Header().Set(interceptors.UserIDHeader, userID.String())
+
+	resp, err := p.scheduleService.ListUserMatchups(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list matchups for user: %w", err)
+	}
+
+	matchups := make([]UserMatchupSummary, len(resp.Msg.Matchups))
+	for i, um := range resp.Msg.Matchups {
+		matchups[i] = UserMatchupSummary{
+			MatchupID:  um.Matchup.GetId(),
+			LeagueID:   um.LeagueId,
+			LeagueName: um.LeagueName,
+			Season:     um.Season,
+			Week:       int(um.Matchup.GetWeek()),
+			TeamID:     um.TeamId,
+			HomeTeamID: um.Matchup.GetHomeTeamId(),
+			AwayTeamID: um.Matchup.GetAwayTeamId(),
+		}
+	}
+	return matchups, nil
+}
@@ -0,0 +1,86 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// pauseVoteTimeout bounds the draft service call behind a pause vote command
+const pauseVoteTimeout = 5 * time.Second
+
+// PauseVoteStore starts and casts votes to pause a draft, acting as the voting user
+type PauseVoteStore interface {
+	StartPauseVote(ctx context.Context, draftID, fantasyTeamID, userID uuid.UUID, reason string) error
+	CastPauseVote(ctx context.Context, draftID, voteID, fantasyTeamID, userID uuid.UUID, inFavor bool) error
+}
+
+// PauseVoteRejectedPayload tells a voter why their pause vote command was not accepted
+type PauseVoteRejectedPayload struct {
+	Reason string `json:"reason"`
+}
+
+// handlePauseVoteMessage starts or casts a vote to pause the draft for one of the user's
+// teams. Progress reaches the room as PauseVoteUpdated events once the draft service has
+// recorded the vote; only failures are answered directly.
+func (c *Connection) handlePauseVoteMessage(msg clientMessage) {
+	userID, ok := c.signedInUser()
+	if !ok {
+		c.rejectPauseVote("pause votes require a signed-in user")
+		return
+	}
+	teamID, err := uuid.Parse(msg.FantasyTeamID)
+	if err != nil {
+		c.rejectPauseVote("fantasy_team_id must be a team ID")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pauseVoteTimeout)
+	defer cancel()
+
+	store := c.Manager.pauseVotes
+	if msg.Type == EventTypePauseVoteStart {
+		err = store.StartPauseVote(ctx, c.DraftID, teamID, userID, msg.Text)
+	} else {
+		voteID, parseErr := uuid.Parse(msg.VoteID)
+		if parseErr != nil {
+			c.rejectPauseVote("vote_id must be a vote ID")
+			return
+		}
+		err = store.CastPauseVote(ctx, c.DraftID, voteID, teamID, userID, msg.InFavor)
+	}
+	if err != nil {
+		log.Debug().Err(err).Str("connection_id", c.ID).Str("user_id", c.UserID).Msg("pause vote command rejected")
+		c.rejectPauseVote(pauseVoteRejection(err))
+	}
+}
+
+// pauseVoteRejection is the reason given to a voter for a failed command; internal
+// failures are not spelled out
+func pauseVoteRejection(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && connectErr.Code() != connect.CodeInternal && connectErr.Code() != connect.CodeUnknown {
+		return connectErr.Message()
+	}
+	return "could not record the pause vote"
+}
+
+func (c *Connection) rejectPauseVote(reason string) {
+	data, err := json.Marshal(PauseVoteRejectedPayload{Reason: reason})
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", c.ID).Msg("failed to marshal pause vote rejection")
+		return
+	}
+	c.Manager.SendToConnection(c.DraftID, c.ID, &DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   c.DraftID.String(),
+		Type:      EventTypePauseVoteRejected,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
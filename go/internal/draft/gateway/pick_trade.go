@@ -0,0 +1,107 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// pickTradeTimeout bounds the draft pick service call behind a pick trade command
+const pickTradeTimeout = 5 * time.Second
+
+// PickTradeStore offers the pick on the clock to another team and answers such offers, acting
+// as the user sending the command
+type PickTradeStore interface {
+	ProposePickTrade(ctx context.Context, draftID, pickID, fromTeamID, toTeamID uuid.UUID, requestedPickID *uuid.UUID, message string, userID uuid.UUID) error
+	RespondToPickTrade(ctx context.Context, draftID, offerID, fantasyTeamID, userID uuid.UUID, accept bool) error
+}
+
+// PickTradeRejectedPayload tells a user why their pick trade command was not accepted
+type PickTradeRejectedPayload struct {
+	Reason string `json:"reason"`
+}
+
+// handlePickTradeMessage offers the pick on the clock to another team for one of the user's
+// teams, or accepts, declines or withdraws an open offer. The offer reaches the room as
+// PickTradeUpdated events once the draft pick service has recorded it; only failures are
+// answered directly.
+func (c *Connection) handlePickTradeMessage(msg clientMessage) {
+	userID, ok := c.signedInUser()
+	if !ok {
+		c.rejectPickTrade("pick trades require a signed-in user")
+		return
+	}
+	teamID, err := uuid.Parse(msg.FantasyTeamID)
+	if err != nil {
+		c.rejectPickTrade("fantasy_team_id must be a team ID")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pickTradeTimeout)
+	defer cancel()
+
+	store := c.Manager.pickTrades
+	if msg.Type == EventTypePickTradePropose {
+		pickID, parseErr := uuid.Parse(msg.PickID)
+		if parseErr != nil {
+			c.rejectPickTrade("pick_id must be a pick ID")
+			return
+		}
+		toTeamID, parseErr := uuid.Parse(msg.ToTeamID)
+		if parseErr != nil {
+			c.rejectPickTrade("to_team_id must be a team ID")
+			return
+		}
+		var requestedPickID *uuid.UUID
+		if msg.RequestedPickID != "" {
+			id, parseErr := uuid.Parse(msg.RequestedPickID)
+			if parseErr != nil {
+				c.rejectPickTrade("requested_pick_id must be a pick ID")
+				return
+			}
+			requestedPickID = &id
+		}
+		err = store.ProposePickTrade(ctx, c.DraftID, pickID, teamID, toTeamID, requestedPickID, msg.Text, userID)
+	} else {
+		offerID, parseErr := uuid.Parse(msg.OfferID)
+		if parseErr != nil {
+			c.rejectPickTrade("offer_id must be an offer ID")
+			return
+		}
+		err = store.RespondToPickTrade(ctx, c.DraftID, offerID, teamID, userID, msg.Accept)
+	}
+	if err != nil {
+		log.Debug().Err(err).Str("connection_id", c.ID).Str("user_id", c.UserID).Msg("pick trade command rejected")
+		c.rejectPickTrade(pickTradeRejection(err))
+	}
+}
+
+// pickTradeRejection is the reason given to a user for a failed command; internal failures
+// are not spelled out
+func pickTradeRejection(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && connectErr.Code() != connect.CodeInternal && connectErr.Code() != connect.CodeUnknown {
+		return connectErr.Message()
+	}
+	return "could not record the pick trade"
+}
+
+func (c *Connection) rejectPickTrade(reason string) {
+	data, err := json.Marshal(PickTradeRejectedPayload{Reason: reason})
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", c.ID).Msg("failed to marshal pick trade rejection")
+		return
+	}
+	c.Manager.SendToConnection(c.DraftID, c.ID, &DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   c.DraftID.String(),
+		Type:      EventTypePickTradeRejected,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
@@ -0,0 +1,807 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/rs/zerolog/log"
+)
+
+// SnapshotProvider loads the authoritative state of a draft, used to hydrate and
+// reconcile the in-memory projection
+type SnapshotProvider interface {
+	// GetDraftSnapshot may return a recently loaded snapshot, but never one older than minSequence
+	GetDraftSnapshot(ctx context.Context, draftID uuid.UUID, minSequence int64) (*DraftSnapshot, error)
+}
+
+// DraftSnapshot is a point-in-time copy of a draft and its full pick board
+type DraftSnapshot struct {
+	DraftID       string
+	LeagueID      string
+	DraftType     string
+	Status        string
+	StartedAt     *time.Time
+	CompletedAt   *time.Time
+	TotalRounds   int
+	TimePerPick   int
+	DraftOrder    []string
+	BudgetPerTeam *float64
+	CurrentPick   *CurrentPickInfo
+	Board         []BoardPick // ordered by overall pick
+	// EventSequence is the sequence of the last draft event reflected in the snapshot
+	EventSequence int64
+}
+
+// BoardPick is a single slot on the draft board
+type BoardPick struct {
+	PickID         string     `json:"pick_id"`
+	TeamID         string     `json:"team_id"`
+	Round          int        `json:"round"`
+	Pick           int        `json:"pick"`
+	OverallPick    int        `json:"overall_pick"`
+	PlayerID       string     `json:"player_id,omitempty"`
+	PlayerName     string     `json:"player_name,omitempty"`
+	PlayerPosition string     `json:"player_position,omitempty"`
+	NFLTeamCode    string     `json:"nfl_team_code,omitempty"`
+	NFLTeamName    string     `json:"nfl_team_name,omitempty"` // in the request's locale, set by the state handler
+	PickedAt       *time.Time `json:"picked_at,omitempty"`
+	AuctionAmount  *float64   `json:"auction_amount,omitempty"`
+	KeeperPick     bool       `json:"keeper_pick,omitempty"`
+	Forfeited      bool       `json:"forfeited,omitempty"` // given up by a team abandoned mid-draft
+	Skipped        bool       `json:"skipped,omitempty"`   // clock ran out; the team can still make it late
+}
+
+// TeamBoardSummary summarizes a team's progress on the draft board
+type TeamBoardSummary struct {
+	TeamID          string   `json:"team_id"`
+	TeamName        string   `json:"team_name"`
+	PicksMade       int      `json:"picks_made"`
+	PicksRemaining  int      `json:"picks_remaining"`
+	BudgetSpent     *float64 `json:"budget_spent,omitempty"`
+	BudgetRemaining *float64 `json:"budget_remaining,omitempty"`
+}
+
+// DraftBoardResponse is the full draft board served from the projection
+type DraftBoardResponse struct {
+	DraftID       string             `json:"draft_id"`
+	Status        string             `json:"status"`
+	CurrentPick   *CurrentPickInfo   `json:"current_pick,omitempty"`
+	TimeRemaining *int               `json:"time_remaining_sec,omitempty"`
+	Picks         []BoardPick        `json:"picks"`
+	Teams         []TeamBoardSummary `json:"teams"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	EventSequence int64              `json:"event_sequence"`
+}
+
+// DraftClockResponse answers who is on the clock and who is on deck
+type DraftClockResponse struct {
+	DraftID       string           `json:"draft_id"`
+	Status        string           `json:"status"`
+	CurrentPick   *CurrentPickInfo `json:"current_pick,omitempty"`
+	TimeRemaining *int             `json:"time_remaining_sec,omitempty"`
+	OnDeck        *BoardPick       `json:"on_deck,omitempty"`
+	EventSequence int64            `json:"event_sequence"`
+}
+
+// ProjectionConfig holds configuration for the in-memory draft projection
+type ProjectionConfig struct {
+	ReconcileInterval  time.Duration // how often tracked drafts are re-hydrated from the draft service
+	CompletedRetention time.Duration // how long finished drafts stay in memory
+	RecentPicksLimit   int
+}
+
+// DefaultProjectionConfig returns default configuration for the draft projection
+func DefaultProjectionConfig() ProjectionConfig {
+	return ProjectionConfig{
+		ReconcileInterval:  30 * time.Second,
+		CompletedRetention: 10 * time.Minute,
+		RecentPicksLimit:   10,
+	}
+}
+
+var (
+	statusNotStarted = draftv1.DraftStatus_DRAFT_STATUS_NOT_STARTED.String()
+	statusInProgress = draftv1.DraftStatus_DRAFT_STATUS_IN_PROGRESS.String()
+	statusPaused     = draftv1.DraftStatus_DRAFT_STATUS_PAUSED.String()
+	statusCompleted  = draftv1.DraftStatus_DRAFT_STATUS_COMPLETED.String()
+	statusCancelled  = draftv1.DraftStatus_DRAFT_STATUS_CANCELLED.String()
+)
+
+// projectedDraft is the in-memory state of a single draft
+type projectedDraft struct {
+	snapshot   DraftSnapshot
+	byPickID   map[string]int    // index into snapshot.Board
+	teamNames  map[string]string // learned from PickMade events
+	stale      bool              // re-hydrate on next read
+	hydratedAt time.Time
+	updatedAt  time.Time
+}
+
+// DraftProjection maintains a per-draft in-memory replica of draft state built from
+// events, hydrated from snapshots on first read and reconciled periodically.
+// It serves the gateway's REST state endpoints without a round trip per request.
+type DraftProjection struct {
+	snapshots SnapshotProvider
+	config    ProjectionConfig
+
+	mu     sync.RWMutex
+	drafts map[uuid.UUID]*projectedDraft
+}
+
+// NewDraftProjection creates a new draft projection
+func NewDraftProjection(snapshots SnapshotProvider, config ProjectionConfig) *DraftProjection {
+	return &DraftProjection{
+		snapshots: snapshots,
+		config:    config,
+		drafts:    make(map[uuid.UUID]*projectedDraft),
+	}
+}
+
+// Start runs the reconciliation loop until the context is cancelled
+func (p *DraftProjection) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.config.ReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Reconcile(ctx)
+		}
+	}
+}
+
+// Reconcile re-hydrates every tracked draft from the draft service and drops drafts
+// that finished longer than the retention period ago
+func (p *DraftProjection) Reconcile(ctx context.Context) {
+	now := time.Now()
+
+	p.mu.Lock()
+	draftIDs := make([]uuid.UUID, 0, len(p.drafts))
+	for draftID, d := range p.drafts {
+		if isTerminalStatus(d.snapshot.Status) && now.Sub(d.updatedAt) > p.config.CompletedRetention {
+			delete(p.drafts, draftID)
+			continue
+		}
+		draftIDs = append(draftIDs, draftID)
+	}
+	p.mu.Unlock()
+
+	for _, draftID := range draftIDs {
+		if _, err := p.hydrate(ctx, draftID); err != nil {
+			log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to reconcile draft projection")
+		}
+	}
+}
+
+// Apply folds a draft event into the projection. Events for drafts that aren't
+// tracked yet are ignored, they are picked up by the snapshot on first read, and so
+// are events at or below the sequence the projection already reflects.
+func (p *DraftProjection) Apply(event *DraftEvent) {
+	draftID, err := uuid.Parse(event.DraftID)
+	if err != nil {
+		return
+	}
+
+	payload, err := ParseEventPayload(event)
+	if err != nil {
+		log.Error().Err(err).Str("event_type", string(event.Type)).Msg("failed to parse event for draft projection")
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	d, ok := p.drafts[draftID]
+	if !ok {
+		return
+	}
+
+	s := &d.snapshot
+	if event.Sequence > 0 {
+		if event.Sequence <= s.EventSequence {
+			return
+		}
+		s.EventSequence = event.Sequence
+	}
+
+	switch pl := payload.(type) {
+	case events.DraftStartedPayload:
+		startedAt := pl.StartedAt
+		s.Status = statusInProgress
+		s.StartedAt = &startedAt
+
+	case events.PickStartedPayload:
+		s.Status = statusInProgress
+		s.CurrentPick = &CurrentPickInfo{
+			PickID:      pl.PickID,
+			TeamID:      pl.TeamID,
+			TeamName:    d.teamName(pl.TeamID),
+			Round:       pl.Round,
+			Pick:        pl.Pick,
+			OverallPick: pl.OverallPick,
+			StartedAt:   pl.StartedAt,
+			TimeoutAt:   pl.TimeoutAt,
+			TimePerPick: pl.TimePerPickSec,
+		}
+
+	case events.PickMadePayload:
+		if pl.TeamName != "" {
+			d.teamNames[pl.TeamID] = pl.TeamName
+		}
+		if idx, ok := d.byPickID[pl.PickID]; ok {
+			madeAt := pl.MadeAt
+			bp := &s.Board[idx]
+			bp.TeamID = pl.TeamID
+			bp.PlayerID = pl.PlayerID
+			bp.PlayerName = pl.PlayerName
+			bp.PlayerPosition = pl.PlayerPosition
+			bp.NFLTeamCode = pl.NFLTeamCode
+			bp.AuctionAmount = pl.AuctionAmount
+			bp.PickedAt = &madeAt
+		} else {
+			// Pick isn't on the board we hydrated, the next read should re-hydrate
+			d.stale = true
+		}
+		if s.CurrentPick != nil && s.CurrentPick.PickID == pl.PickID {
+			s.CurrentPick = nil
+		}
+
+	case events.PickSlotReassignedPayload:
+		if idx, ok := d.byPickID[pl.PickID]; ok {
+			s.Board[idx].TeamID = pl.ToTeamID
+		}
+		if s.CurrentPick != nil && s.CurrentPick.PickID == pl.PickID {
+			s.CurrentPick.TeamID = pl.ToTeamID
+			s.CurrentPick.TeamName = d.teamName(pl.ToTeamID)
+		}
+
+	case events.PickTradeUpdatedPayload:
+		// The pick clock stops while an offer is open and carries on once it closes
+		if pl.TimeoutAt != nil && s.CurrentPick != nil && s.CurrentPick.PickID == pl.PickID {
+			s.CurrentPick.TimeoutAt = *pl.TimeoutAt
+		}
+
+	case events.PickClockExtendedPayload:
+		if s.CurrentPick != nil && s.CurrentPick.PickID == pl.PickID {
+			s.CurrentPick.TimeoutAt = pl.TimeoutAt
+		}
+
+	case events.PickSkippedPayload:
+		if idx, ok := d.byPickID[pl.PickID]; ok {
+			s.Board[idx].Skipped = true
+			s.Board[idx].Forfeited = pl.Forfeited
+		}
+		// The orchestrator follows up with PickStarted for the next pick
+		if s.CurrentPick != nil && s.CurrentPick.PickID == pl.PickID {
+			s.CurrentPick = nil
+		}
+
+	case events.TeamAbandonedPayload:
+		for _, pickID := range pl.ForfeitedPickIDs {
+			if idx, ok := d.byPickID[pickID]; ok {
+				s.Board[idx].Forfeited = true
+			}
+			// The orchestrator follows up with PickStarted for the next pick
+			if s.CurrentPick != nil && s.CurrentPick.PickID == pickID {
+				s.CurrentPick = nil
+			}
+		}
+
+	case events.TeamRestoredPayload:
+		for _, pickID := range pl.RestoredPickIDs {
+			if idx, ok := d.byPickID[pickID]; ok {
+				s.Board[idx].Forfeited = false
+			}
+		}
+
+	case events.DraftPausedPayload:
+		s.Status = statusPaused
+		if s.CurrentPick != nil {
+			// The clock is stopped, the deadline is recomputed on resume
+			s.CurrentPick.TimeoutAt = time.Time{}
+		}
+
+	case events.DraftResumedPayload:
+		s.Status = statusInProgress
+		// An automatic resume is followed by PickStarted and DraftCatchUp, which carry the new clock
+		if !pl.Scheduled {
+			d.stale = true
+		}
+
+	case events.DraftCatchUpPayload:
+		s.Status = statusInProgress
+		if on := pl.OnTheClock; on != nil {
+			s.CurrentPick = &CurrentPickInfo{
+				PickID:      on.PickID,
+				TeamID:      on.TeamID,
+				TeamName:    d.teamName(on.TeamID),
+				Round:       on.Round,
+				Pick:        on.Pick,
+				OverallPick: on.OverallPick,
+				StartedAt:   on.StartedAt,
+				TimeoutAt:   on.TimeoutAt,
+				TimePerPick: on.TimePerPickSec,
+			}
+		}
+
+	case events.DraftCompletedPayload:
+		completedAt := pl.CompletedAt
+		s.Status = statusCompleted
+		s.CompletedAt = &completedAt
+		s.CurrentPick = nil
+
+	default:
+		return
+	}
+
+	d.updatedAt = time.Now()
+}
+
+// GetDraftState returns the state of a draft from memory, hydrating it on first read
+func (p *DraftProjection) GetDraftState(ctx context.Context, draftID uuid.UUID) (*DraftStateResponse, error) {
+	var response *DraftStateResponse
+	err := p.read(ctx, draftID, func(d *projectedDraft) {
+		response = d.state(p.config.RecentPicksLimit)
+		response.Metadata["hydrated_at"] = d.hydratedAt
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DraftLeague returns the league a draft belongs to, hydrating the draft on first read
+func (p *DraftProjection) DraftLeague(ctx context.Context, draftID uuid.UUID) (uuid.UUID, error) {
+	var leagueID string
+	err := p.read(ctx, draftID, func(d *projectedDraft) {
+		leagueID = d.snapshot.LeagueID
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.Parse(leagueID)
+}
+
+// GetActiveDrafts returns the in-progress and paused drafts currently tracked in memory
+func (p *DraftProjection) GetActiveDrafts(ctx context.Context) ([]DraftSummary, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	drafts := []DraftSummary{}
+	for _, d := range p.drafts {
+		s := &d.snapshot
+		if s.Status != statusInProgress && s.Status != statusPaused {
+			continue
+		}
+
+		summary := DraftSummary{
+			DraftID:     s.DraftID,
+			LeagueID:    s.LeagueID,
+			Status:      s.Status,
+			StartedAt:   s.StartedAt,
+			TotalTeams:  len(s.DraftOrder),
+			TotalRounds: s.TotalRounds,
+		}
+		if s.CurrentPick != nil {
+			summary.CurrentRound = s.CurrentPick.Round
+			summary.CurrentPick = s.CurrentPick.Pick
+		}
+		drafts = append(drafts, summary)
+	}
+
+	sort.Slice(drafts, func(i, j int) bool {
+		return drafts[i].DraftID < drafts[j].DraftID
+	})
+	return drafts, nil
+}
+
+// GetDraftBoard returns the full pick board of a draft with per-team totals
+func (p *DraftProjection) GetDraftBoard(ctx context.Context, draftID uuid.UUID) (*DraftBoardResponse, error) {
+	var response *DraftBoardResponse
+	err := p.read(ctx, draftID, func(d *projectedDraft) {
+		response = d.board()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ProjectedBoard returns the board of a tracked draft as projected so far, without hydrating
+// it from a snapshot first
+func (p *DraftProjection) ProjectedBoard(draftID uuid.UUID) (*DraftBoardResponse, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	d, ok := p.drafts[draftID]
+	if !ok {
+		return nil, false
+	}
+	return d.board(), true
+}
+
+// ProjectedState returns the state of a tracked draft as projected so far, without hydrating
+// it from a snapshot first. The time remaining on the clock is measured at asOf.
+func (p *DraftProjection) ProjectedState(draftID uuid.UUID, asOf time.Time) (*DraftStateResponse, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	d, ok := p.drafts[draftID]
+	if !ok {
+		return nil, false
+	}
+	state := d.state(p.config.RecentPicksLimit)
+	if current := state.CurrentPick; current != nil && !current.TimeoutAt.IsZero() && current.TimeoutAt.After(asOf) {
+		remaining := int(current.TimeoutAt.Sub(asOf).Seconds())
+		state.TimeRemaining = &remaining
+	}
+	return state, true
+}
+
+// Rewind tracks a draft as it stood before its first event: its current snapshot with every
+// pick unmade, so applying the draft's events in order rebuilds its board. Keeper picks stay
+// made since no event makes them, and slots keep their current teams, which reassignments
+// replayed later set again.
+func (p *DraftProjection) Rewind(ctx context.Context, draftID uuid.UUID) error {
+	snapshot, err := p.snapshots.GetDraftSnapshot(ctx, draftID, 0)
+	if err != nil {
+		return fmt.Errorf("failed to load draft snapshot: %w", err)
+	}
+
+	snapshot.Status = statusNotStarted
+	snapshot.StartedAt = nil
+	snapshot.CompletedAt = nil
+	snapshot.CurrentPick = nil
+	snapshot.EventSequence = 0
+	board := make([]BoardPick, len(snapshot.Board))
+	for i, bp := range snapshot.Board {
+		if !bp.KeeperPick {
+			bp = BoardPick{
+				PickID:      bp.PickID,
+				TeamID:      bp.TeamID,
+				Round:       bp.Round,
+				Pick:        bp.Pick,
+				OverallPick: bp.OverallPick,
+			}
+		}
+		board[i] = bp
+	}
+	snapshot.Board = board
+
+	now := time.Now()
+	d := &projectedDraft{
+		snapshot:   *snapshot,
+		byPickID:   make(map[string]int, len(board)),
+		teamNames:  make(map[string]string),
+		hydratedAt: now,
+		updatedAt:  now,
+	}
+	for i, bp := range board {
+		d.byPickID[bp.PickID] = i
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.drafts[draftID] = d
+	return nil
+}
+
+// GetDraftClock returns the pick on the clock and the pick on deck
+func (p *DraftProjection) GetDraftClock(ctx context.Context, draftID uuid.UUID) (*DraftClockResponse, error) {
+	var response *DraftClockResponse
+	err := p.read(ctx, draftID, func(d *projectedDraft) {
+		s := &d.snapshot
+		response = &DraftClockResponse{
+			DraftID:       s.DraftID,
+			Status:        s.Status,
+			CurrentPick:   d.currentPick(),
+			OnDeck:        d.onDeck(),
+			EventSequence: s.EventSequence,
+		}
+		response.TimeRemaining = timeRemaining(response.CurrentPick)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// TrackedDrafts returns the number of drafts held in memory
+func (p *DraftProjection) TrackedDrafts() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.drafts)
+}
+
+// read runs fn against the projected draft under a read lock, hydrating it first
+// if it isn't tracked yet or has been marked stale
+func (p *DraftProjection) read(ctx context.Context, draftID uuid.UUID, fn func(d *projectedDraft)) error {
+	p.mu.RLock()
+	d, ok := p.drafts[draftID]
+	if ok && !d.stale {
+		fn(d)
+		p.mu.RUnlock()
+		return nil
+	}
+	p.mu.RUnlock()
+
+	if _, err := p.hydrate(ctx, draftID); err != nil {
+		return err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	d, ok = p.drafts[draftID]
+	if !ok {
+		return fmt.Errorf("draft %s evicted during hydration", draftID)
+	}
+	fn(d)
+	return nil
+}
+
+// hydrate replaces the projection of a draft with a fresh snapshot, carrying over
+// player and team names that only arrive on events
+func (p *DraftProjection) hydrate(ctx context.Context, draftID uuid.UUID) (*projectedDraft, error) {
+	// The snapshot must not predate events already applied, or they'd be lost
+	var minSequence int64
+	p.mu.RLock()
+	if prev, ok := p.drafts[draftID]; ok {
+		minSequence = prev.snapshot.EventSequence
+	}
+	p.mu.RUnlock()
+
+	snapshot, err := p.snapshots.GetDraftSnapshot(ctx, draftID, minSequence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load draft snapshot: %w", err)
+	}
+
+	now := time.Now()
+	d := &projectedDraft{
+		snapshot:   *snapshot,
+		byPickID:   make(map[string]int, len(snapshot.Board)),
+		teamNames:  make(map[string]string),
+		hydratedAt: now,
+		updatedAt:  now,
+	}
+	for i, bp := range d.snapshot.Board {
+		d.byPickID[bp.PickID] = i
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if prev, ok := p.drafts[draftID]; ok {
+		for teamID, name := range prev.teamNames {
+			d.teamNames[teamID] = name
+		}
+		for i := range d.snapshot.Board {
+			bp := &d.snapshot.Board[i]
+			if j, ok := prev.byPickID[bp.PickID]; ok && bp.PlayerName == "" && prev.snapshot.Board[j].PlayerID == bp.PlayerID {
+				bp.PlayerName = prev.snapshot.Board[j].PlayerName
+				bp.PlayerPosition = prev.snapshot.Board[j].PlayerPosition
+				bp.NFLTeamCode = prev.snapshot.Board[j].NFLTeamCode
+			}
+		}
+		if prev.snapshot.EventSequence > d.snapshot.EventSequence {
+			// Events newer than the snapshot were already applied and won't be seen again;
+			// the snapshot may predate them, so take another one on the next read
+			d.stale = true
+		}
+		if isTerminalStatus(d.snapshot.Status) && isTerminalStatus(prev.snapshot.Status) {
+			// Keep the original finish time so retention isn't extended by reconciliation
+			d.updatedAt = prev.updatedAt
+		}
+	}
+	if d.snapshot.CurrentPick != nil {
+		d.snapshot.CurrentPick.TeamName = d.teamName(d.snapshot.CurrentPick.TeamID)
+	}
+
+	p.drafts[draftID] = d
+	return d, nil
+}
+
+// state is the draft's state in the shape served by the state endpoint, without the time
+// remaining on the clock
+func (d *projectedDraft) state(recentPicksLimit int) *DraftStateResponse {
+	s := &d.snapshot
+	return &DraftStateResponse{
+		DraftID:        s.DraftID,
+		Status:         s.Status,
+		CurrentPick:    d.currentPick(),
+		RecentPicks:    d.recentPicks(recentPicksLimit),
+		TotalPicks:     d.totalPicks(),
+		CompletedPicks: d.completedPicks(),
+		EventSequence:  s.EventSequence,
+		Metadata: map[string]interface{}{
+			"league_id":    s.LeagueID,
+			"draft_type":   s.DraftType,
+			"total_rounds": s.TotalRounds,
+			"total_teams":  len(s.DraftOrder),
+		},
+	}
+}
+
+// board copies the draft's board with per-team totals
+func (d *projectedDraft) board() *DraftBoardResponse {
+	s := &d.snapshot
+	response := &DraftBoardResponse{
+		DraftID:       s.DraftID,
+		Status:        s.Status,
+		CurrentPick:   d.currentPick(),
+		Picks:         make([]BoardPick, len(s.Board)),
+		Teams:         d.teamSummaries(),
+		UpdatedAt:     d.updatedAt,
+		EventSequence: s.EventSequence,
+	}
+	copy(response.Picks, s.Board)
+	response.TimeRemaining = timeRemaining(response.CurrentPick)
+	return response
+}
+
+// teamName returns the known name of a team, falling back to a short placeholder
+func (d *projectedDraft) teamName(teamID string) string {
+	if name, ok := d.teamNames[teamID]; ok {
+		return name
+	}
+	if len(teamID) >= 8 {
+		return fmt.Sprintf("Team %s", teamID[:8])
+	}
+	return teamID
+}
+
+// currentPick returns a copy of the pick on the clock while the draft is running
+func (d *projectedDraft) currentPick() *CurrentPickInfo {
+	s := &d.snapshot
+	if s.CurrentPick == nil || (s.Status != statusInProgress && s.Status != statusPaused) {
+		return nil
+	}
+	current := *s.CurrentPick
+	return &current
+}
+
+// onDeck returns the open pick that follows the one on the clock. Skipped picks come back
+// on the clock in board order once every other pick is made.
+func (d *projectedDraft) onDeck() *BoardPick {
+	s := &d.snapshot
+	if s.CurrentPick == nil {
+		return nil
+	}
+	currentSkipped := false
+	if idx, ok := d.byPickID[s.CurrentPick.PickID]; ok {
+		currentSkipped = s.Board[idx].Skipped
+	}
+
+	var skipped *BoardPick
+	for _, bp := range s.Board {
+		if bp.PlayerID != "" || bp.Forfeited || bp.PickID == s.CurrentPick.PickID {
+			continue
+		}
+		if bp.Skipped {
+			if skipped == nil && (!currentSkipped || bp.OverallPick > s.CurrentPick.OverallPick) {
+				next := bp
+				skipped = &next
+			}
+			continue
+		}
+		if bp.OverallPick > s.CurrentPick.OverallPick {
+			next := bp
+			return &next
+		}
+	}
+	return skipped
+}
+
+// totalPicks returns the size of the board, falling back to rounds x teams
+func (d *projectedDraft) totalPicks() int {
+	if len(d.snapshot.Board) > 0 {
+		return len(d.snapshot.Board)
+	}
+	return d.snapshot.TotalRounds * len(d.snapshot.DraftOrder)
+}
+
+// completedPicks counts board slots that have a player
+func (d *projectedDraft) completedPicks() int {
+	count := 0
+	for _, bp := range d.snapshot.Board {
+		if bp.PlayerID != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// recentPicks returns the most recently made picks, newest first
+func (d *projectedDraft) recentPicks(limit int) []RecentPickInfo {
+	recent := []RecentPickInfo{}
+	for i := len(d.snapshot.Board) - 1; i >= 0 && len(recent) < limit; i-- {
+		bp := d.snapshot.Board[i]
+		if bp.PlayerID == "" {
+			continue
+		}
+
+		pick := RecentPickInfo{
+			PickID:         bp.PickID,
+			TeamID:         bp.TeamID,
+			TeamName:       d.teamName(bp.TeamID),
+			PlayerID:       bp.PlayerID,
+			PlayerName:     bp.PlayerName,
+			PlayerPosition: bp.PlayerPosition,
+			NFLTeamCode:    bp.NFLTeamCode,
+			Round:          bp.Round,
+			Pick:           bp.Pick,
+			OverallPick:    bp.OverallPick,
+		}
+		if bp.PickedAt != nil {
+			pick.MadeAt = *bp.PickedAt
+		}
+		recent = append(recent, pick)
+	}
+	return recent
+}
+
+// teamSummaries totals picks and auction spend per team, in draft order
+func (d *projectedDraft) teamSummaries() []TeamBoardSummary {
+	s := &d.snapshot
+	index := make(map[string]int, len(s.DraftOrder))
+	teams := make([]TeamBoardSummary, 0, len(s.DraftOrder))
+	for _, teamID := range s.DraftOrder {
+		index[teamID] = len(teams)
+		teams = append(teams, TeamBoardSummary{TeamID: teamID, TeamName: d.teamName(teamID)})
+	}
+
+	spent := make(map[string]float64)
+	for _, bp := range s.Board {
+		i, ok := index[bp.TeamID]
+		if !ok {
+			// Team holds a pick but isn't in the draft order, e.g. after a trade
+			index[bp.TeamID] = len(teams)
+			i = len(teams)
+			teams = append(teams, TeamBoardSummary{TeamID: bp.TeamID, TeamName: d.teamName(bp.TeamID)})
+		}
+		if bp.PlayerID == "" {
+			if !bp.Forfeited {
+				teams[i].PicksRemaining++
+			}
+			continue
+		}
+		teams[i].PicksMade++
+		if bp.AuctionAmount != nil {
+			spent[bp.TeamID] += *bp.AuctionAmount
+		}
+	}
+
+	if s.BudgetPerTeam != nil {
+		for i := range teams {
+			teamSpent := spent[teams[i].TeamID]
+			remaining := *s.BudgetPerTeam - teamSpent
+			teams[i].BudgetSpent = &teamSpent
+			teams[i].BudgetRemaining = &remaining
+		}
+	}
+	return teams
+}
+
+// timeRemaining returns the seconds left on the clock, or nil when the clock is stopped
+func timeRemaining(current *CurrentPickInfo) *int {
+	if current == nil || current.TimeoutAt.IsZero() {
+		return nil
+	}
+	remaining := int(time.Until(current.TimeoutAt).Seconds())
+	if remaining <= 0 {
+		return nil
+	}
+	return &remaining
+}
+
+// isTerminalStatus reports whether a draft status is final
+func isTerminalStatus(status string) bool {
+	return status == statusCompleted || status == statusCancelled
+}
@@ -0,0 +1,200 @@
+package gateway
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Protocol versions spoken on the draft WebSocket. Bump CurrentProtocolVersion when frames
+// change in a way older clients can't read, and raise MinProtocolVersion only once the
+// oldest version is no longer served.
+const (
+	MinProtocolVersion     = 1
+	CurrentProtocolVersion = 1
+)
+
+// Capability is an optional protocol feature a client can ask for when connecting
+type Capability string
+
+const (
+	// CapabilityBinaryFrames sends event frames as binary instead of text messages
+	CapabilityBinaryFrames Capability = "binary_frames"
+	// CapabilityCompression compresses frames with permessage-deflate when the client offered it
+	CapabilityCompression Capability = "compression"
+	// CapabilityChat carries draft room chat messages
+	CapabilityChat Capability = "chat"
+	// CapabilityClockSync answers ClockSync requests so clients can correct pick timers for clock skew
+	CapabilityClockSync Capability = "clock_sync"
+	// CapabilityPauseVotes accepts PauseVoteStart and PauseVoteCast commands from the client
+	CapabilityPauseVotes Capability = "pause_votes"
+	// CapabilityPickTrades accepts PickTradePropose and PickTradeRespond commands from the client
+	CapabilityPickTrades Capability = "pick_trades"
+	// CapabilityReactions accepts PickReact commands from the client and sends the room's
+	// PickReactionsUpdated counts
+	CapabilityReactions Capability = "reactions"
+	// CapabilityAcks marks critical frames, such as PickStarted, as requiring an Ack from the
+	// client; unacknowledged frames are sent again and then fall back to a push notification
+	CapabilityAcks Capability = "acks"
+	// CapabilityConnectionQuality sends ConnectionQuality frames rating the connection by its
+	// ping round trip and the frames waiting to be written to it
+	CapabilityConnectionQuality Capability = "connection_quality"
+)
+
+// supportedCapabilities are the capabilities this gateway can turn on for a connection.
+// Requested capabilities outside this set are dropped during negotiation.
+var supportedCapabilities = map[Capability]bool{
+	CapabilityBinaryFrames:      true,
+	CapabilityCompression:       true,
+	CapabilityChat:              true,
+	CapabilityClockSync:         true,
+	CapabilityPauseVotes:        true,
+	CapabilityPickTrades:        true,
+	CapabilityReactions:         true,
+	CapabilityAcks:              true,
+	CapabilityConnectionQuality: true,
+}
+
+// capabilityFlagPrefix starts the feature flags rolling capabilities out per league, such as
+// gateway.capability.acks. A capability without a flag stays on for every league.
+const capabilityFlagPrefix = "gateway.capability."
+
+// CapabilityFlag returns the key of the feature flag controlling a capability
+func CapabilityFlag(c Capability) string {
+	return capabilityFlagPrefix + string(c)
+}
+
+var (
+	// ErrInvalidProtocolVersion is returned when the requested protocol version isn't a number
+	ErrInvalidProtocolVersion = errors.New("invalid protocol version")
+	// ErrUnsupportedProtocolVersion is returned when the requested protocol version is too old to serve
+	ErrUnsupportedProtocolVersion = errors.New("unsupported protocol version")
+)
+
+// Protocol is what a connection agreed on during the WebSocket handshake
+type Protocol struct {
+	Version int
+	// RequestedVersion is the version the client asked for; zero for legacy clients
+	RequestedVersion int
+	Capabilities     map[Capability]bool
+}
+
+// Negotiated reports whether the client took part in version negotiation. Legacy clients
+// that connect without a protocol_version get version 1 frames and no handshake frame.
+func (p Protocol) Negotiated() bool {
+	return p.RequestedVersion != 0
+}
+
+// Has reports whether a capability was agreed for the connection
+func (p Protocol) Has(c Capability) bool {
+	return p.Capabilities[c]
+}
+
+// CapabilityList returns the agreed capabilities in a stable order
+func (p Protocol) CapabilityList() []Capability {
+	list := make([]Capability, 0, len(p.Capabilities))
+	for c := range p.Capabilities {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// NegotiateProtocol settles the protocol for a new connection from the protocol_version and
+// comma separated capabilities query parameters. Versions newer than the gateway speaks are
+// downgraded to CurrentProtocolVersion; versions older than MinProtocolVersion are rejected.
+func NegotiateProtocol(version, capabilities string) (Protocol, error) {
+	if version == "" {
+		return Protocol{Version: MinProtocolVersion, Capabilities: map[Capability]bool{}}, nil
+	}
+
+	requested, err := strconv.Atoi(version)
+	if err != nil || requested < 1 {
+		return Protocol{}, fmt.Errorf("%w: %q", ErrInvalidProtocolVersion, version)
+	}
+	if requested < MinProtocolVersion {
+		return Protocol{}, fmt.Errorf("%w: %d, oldest supported is %d", ErrUnsupportedProtocolVersion, requested, MinProtocolVersion)
+	}
+
+	protocol := Protocol{
+		Version:          min(requested, CurrentProtocolVersion),
+		RequestedVersion: requested,
+		Capabilities:     map[Capability]bool{},
+	}
+	for _, name := range strings.Split(capabilities, ",") {
+		c := Capability(strings.ToLower(strings.TrimSpace(name)))
+		if supportedCapabilities[c] {
+			protocol.Capabilities[c] = true
+		}
+	}
+
+	return protocol, nil
+}
+
+// HelloPayload is the first frame on a connection that negotiated a protocol version
+type HelloPayload struct {
+	ConnectionID     string       `json:"connection_id"`
+	ProtocolVersion  int          `json:"protocol_version"`
+	RequestedVersion int          `json:"requested_version"`
+	Capabilities     []Capability `json:"capabilities"`
+	ServerTime       time.Time    `json:"server_time"`
+	// SessionToken resumes this session when passed as session_token on a reconnect within
+	// SessionResumeTTL seconds of the socket dropping
+	SessionToken     string `json:"session_token"`
+	SessionResumeTTL int    `json:"session_resume_ttl_sec"`
+	// Resumed is true when the connection resumed an earlier session; a SessionResumed
+	// frame follows
+	Resumed bool `json:"resumed"`
+}
+
+// ClockSyncPayload answers a client's ClockSync message. Clients estimate their offset
+// from the server as server_time - (client_time + round trip / 2).
+type ClockSyncPayload struct {
+	ClientTime time.Time `json:"client_time"`
+	ServerTime time.Time `json:"server_time"`
+}
+
+// clientMessage is the envelope of messages clients send on the WebSocket
+type clientMessage struct {
+	Type       EventType `json:"type"`
+	ClientTime time.Time `json:"client_time"`
+	// Sequence is the event_sequence of the state snapshot a SnapshotLoaded message reports
+	Sequence int64 `json:"sequence"`
+	// Text is the body of a ChatMessage
+	Text string `json:"text"`
+	// TargetUserID is the user a chat mute, block or room mute command applies to
+	TargetUserID string `json:"target_user_id"`
+	// Muted is whether a ChatRoomMute command mutes or unmutes its target
+	Muted bool `json:"muted"`
+	// Categories are the event categories a Subscribe message asks for; none for every event
+	Categories []string `json:"categories"`
+	// FantasyTeamID is the team a PauseVoteStart or PauseVoteCast command votes for, and the
+	// team a PickTradePropose or PickTradeRespond command acts for; the reason for starting a
+	// vote and the message of an offer are carried in Text
+	FantasyTeamID string `json:"fantasy_team_id"`
+	// VoteID is the open vote a PauseVoteCast command votes in
+	VoteID string `json:"vote_id"`
+	// InFavor is whether a PauseVoteCast command votes to pause
+	InFavor bool `json:"in_favor"`
+	// PickID is the pick on the clock a PickTradePropose command offers, and the made pick a
+	// PickReact command reacts to
+	PickID string `json:"pick_id"`
+	// ToTeamID is the team a PickTradePropose command offers the pick to
+	ToTeamID string `json:"to_team_id"`
+	// RequestedPickID is the pick a PickTradePropose command asks for in return; empty for none
+	RequestedPickID string `json:"requested_pick_id"`
+	// OfferID is the open offer a PickTradeRespond command answers
+	OfferID string `json:"offer_id"`
+	// Accept is whether a PickTradeRespond command accepts the offer rather than declining or
+	// withdrawing it
+	Accept bool `json:"accept"`
+	// Reaction is the emoji a PickReact command leaves on the pick
+	Reaction string `json:"reaction"`
+	// Remove is whether a PickReact command takes the user's reaction back
+	Remove bool `json:"remove"`
+	// EventID is the frame an Ack message acknowledges
+	EventID string `json:"event_id"`
+}
@@ -0,0 +1,211 @@
+package gateway
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+)
+
+// ConnectionQuality is how well a connection keeps up with the frames sent to it
+type ConnectionQuality string
+
+const (
+	QualityGood ConnectionQuality = "good"
+	QualityFair ConnectionQuality = "fair"
+	QualityPoor ConnectionQuality = "poor"
+)
+
+// Round trips at or above these, measured by pings, lower a connection's quality
+const (
+	fairRTT = 300 * time.Millisecond
+	poorRTT = time.Second
+)
+
+// Frames waiting to be written at or above these shares of the send buffer lower a
+// connection's quality, however fast its pings come back
+const (
+	fairBacklog = 0.25
+	poorBacklog = 0.5
+)
+
+// ConnectionQualityPayload tells a client that negotiated the connection_quality capability
+// how its connection is doing, so it can show that a lagging board is down to the network.
+// It's sent after the first ping is answered and whenever the quality changes.
+type ConnectionQualityPayload struct {
+	Quality ConnectionQuality `json:"quality"`
+	// RTTMs is the round trip of the latest ping
+	RTTMs int64 `json:"rtt_ms"`
+	// QueuedFrames are frames the gateway has yet to write to the socket
+	QueuedFrames int `json:"queued_frames"`
+	// BytesSent and FramesSent count the connection's frames so far, before compression
+	BytesSent  int64     `json:"bytes_sent"`
+	FramesSent int64     `json:"frames_sent"`
+	MeasuredAt time.Time `json:"measured_at"`
+}
+
+// TrafficStats sums up what a gateway instance sends its connections and how well they keep up
+type TrafficStats struct {
+	// BytesSent, FramesSent and FramesDropped count every connection since the gateway
+	// started; bytes are counted before compression. A frame is dropped when the connection
+	// can't keep up or the write fails, and the connection is closed.
+	BytesSent     int64 `json:"bytes_sent"`
+	FramesSent    int64 `json:"frames_sent"`
+	FramesDropped int64 `json:"frames_dropped"`
+	// QueuedFrames are frames waiting to be written across open connections
+	QueuedFrames int `json:"queued_frames"`
+	// RTT percentiles over the open connections that have answered a ping
+	RTTP50Ms int64 `json:"rtt_p50_ms"`
+	RTTP95Ms int64 `json:"rtt_p95_ms"`
+	RTTMaxMs int64 `json:"rtt_max_ms"`
+	// Open connections at each quality
+	Good int `json:"good"`
+	Fair int `json:"fair"`
+	Poor int `json:"poor"`
+}
+
+// trafficCounters count what has been sent on a connection, or on every connection
+type trafficCounters struct {
+	bytesSent     atomic.Int64
+	framesSent    atomic.Int64
+	framesDropped atomic.Int64
+}
+
+// connectionStats is what a connection has sent and how fast it answers pings
+type connectionStats struct {
+	trafficCounters
+	// rtt is the round trip of the latest ping, 0 until one is answered
+	rtt atomic.Int64
+	// reported is the quality last sent to the client, only touched by the read pump
+	reported ConnectionQuality
+}
+
+// recordSent counts a frame written to the socket
+func (c *Connection) recordSent(message []byte) {
+	for _, counters := range []*trafficCounters{&c.stats.trafficCounters, &c.Manager.traffic} {
+		counters.bytesSent.Add(int64(len(message)))
+		counters.framesSent.Add(1)
+	}
+}
+
+// recordDropped counts a frame the connection didn't get
+func (c *Connection) recordDropped() {
+	c.stats.framesDropped.Add(1)
+	c.Manager.traffic.framesDropped.Add(1)
+}
+
+// writePing sends a ping carrying when it was sent, which the client echoes in its pong
+func (c *Connection) writePing() error {
+	return c.Conn.WriteMessage(websocket.PingMessage, []byte(strconv.FormatInt(time.Now().UnixNano(), 10)))
+}
+
+// handlePong records the round trip of the ping a pong answers, and tells the client when
+// its connection quality changed
+func (c *Connection) handlePong(appData string) {
+	sentAt, err := strconv.ParseInt(appData, 10, 64)
+	if err != nil {
+		return
+	}
+	rtt := max(time.Since(time.Unix(0, sentAt)), 0)
+	c.stats.rtt.Store(int64(rtt))
+
+	quality := c.quality()
+	if quality == c.stats.reported || !c.Protocol.Has(CapabilityConnectionQuality) {
+		return
+	}
+	c.stats.reported = quality
+	c.sendQuality(quality, rtt)
+}
+
+// quality rates the connection by its latest round trip and how full its send buffer is
+func (c *Connection) quality() ConnectionQuality {
+	rtt := time.Duration(c.stats.rtt.Load())
+	backlog := 0.0
+	if cap(c.Send) > 0 {
+		backlog = float64(len(c.Send)) / float64(cap(c.Send))
+	}
+	switch {
+	case rtt >= poorRTT || backlog >= poorBacklog:
+		return QualityPoor
+	case rtt >= fairRTT || backlog >= fairBacklog:
+		return QualityFair
+	default:
+		return QualityGood
+	}
+}
+
+// sendQuality sends the client a ConnectionQuality frame
+func (c *Connection) sendQuality(quality ConnectionQuality, rtt time.Duration) {
+	data, err := json.Marshal(ConnectionQualityPayload{
+		Quality:      quality,
+		RTTMs:        rtt.Milliseconds(),
+		QueuedFrames: len(c.Send),
+		BytesSent:    c.stats.bytesSent.Load(),
+		FramesSent:   c.stats.framesSent.Load(),
+		MeasuredAt:   time.Now(),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", c.ID).Msg("failed to marshal connection quality")
+		return
+	}
+	c.Manager.SendToConnection(c.DraftID, c.ID, &DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   c.DraftID.String(),
+		Type:      EventTypeConnectionQuality,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
+
+// logTraffic records what a closing connection was sent, for looking into reports of a
+// lagging board
+func (c *Connection) logTraffic() {
+	log.Info().
+		Str("connection_id", c.ID).
+		Str("user_id", c.UserID).
+		Str("draft_id", c.DraftID.String()).
+		Dur("connected_for", time.Since(c.ConnectedAt)).
+		Int64("bytes_sent", c.stats.bytesSent.Load()).
+		Int64("frames_sent", c.stats.framesSent.Load()).
+		Int64("frames_dropped", c.stats.framesDropped.Load()).
+		Int64("rtt_ms", time.Duration(c.stats.rtt.Load()).Milliseconds()).
+		Msg("connection traffic")
+}
+
+// trafficStats sums up the gateway's traffic and the quality of the given open connections
+func (cm *ConnectionManager) trafficStats(connections []*Connection) TrafficStats {
+	stats := TrafficStats{
+		BytesSent:     cm.traffic.bytesSent.Load(),
+		FramesSent:    cm.traffic.framesSent.Load(),
+		FramesDropped: cm.traffic.framesDropped.Load(),
+	}
+
+	var rtts []int64
+	for _, conn := range connections {
+		stats.QueuedFrames += len(conn.Send)
+		if rtt := conn.stats.rtt.Load(); rtt > 0 {
+			rtts = append(rtts, time.Duration(rtt).Milliseconds())
+		}
+		switch conn.quality() {
+		case QualityGood:
+			stats.Good++
+		case QualityFair:
+			stats.Fair++
+		case QualityPoor:
+			stats.Poor++
+		}
+	}
+
+	if len(rtts) > 0 {
+		slices.Sort(rtts)
+		stats.RTTP50Ms = rtts[(len(rtts)-1)*50/100]
+		stats.RTTP95Ms = rtts[(len(rtts)-1)*95/100]
+		stats.RTTMaxMs = rtts[len(rtts)-1]
+	}
+	return stats
+}
@@ -0,0 +1,115 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// pickReactionTimeout bounds the draft pick service call behind a reaction command
+const pickReactionTimeout = 5 * time.Second
+
+// PickReactionStore records emoji reactions on made picks, acting as the reacting user, and
+// returns the pick's counts afterwards
+type PickReactionStore interface {
+	ReactToPick(ctx context.Context, draftID, pickID, userID uuid.UUID, reaction string, remove bool) (*PickReactionsPayload, error)
+}
+
+// PickReactionsPayload carries a pick's reaction counts, one for every reaction offered
+type PickReactionsPayload struct {
+	PickID string              `json:"pick_id"`
+	Counts []PickReactionCount `json:"counts"`
+}
+
+// PickReactionCount is how many users in the draft left a reaction on a pick
+type PickReactionCount struct {
+	Reaction string `json:"reaction"`
+	Count    int    `json:"count"`
+}
+
+// PickReactionRejectedPayload tells a user why their reaction was not recorded
+type PickReactionRejectedPayload struct {
+	PickID string `json:"pick_id"`
+	Reason string `json:"reason"`
+}
+
+// handlePickReactMessage leaves the user's reaction on a made pick, or takes it back, and
+// broadcasts the pick's new counts to the room. Like chat, the counts are sent to the room from
+// the gateway the reaction arrived on; clients that join later load them with the pick's
+// reactions from the draft pick service.
+func (c *Connection) handlePickReactMessage(msg clientMessage) {
+	userID, ok := c.signedInUser()
+	if !ok {
+		c.rejectPickReaction(msg.PickID, "reactions require a signed-in user")
+		return
+	}
+	pickID, err := uuid.Parse(msg.PickID)
+	if err != nil {
+		c.rejectPickReaction(msg.PickID, "pick_id must be a pick ID")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pickReactionTimeout)
+	defer cancel()
+
+	counts, err := c.Manager.pickReactions.ReactToPick(ctx, c.DraftID, pickID, userID, msg.Reaction, msg.Remove)
+	if err != nil {
+		log.Debug().Err(err).Str("connection_id", c.ID).Str("user_id", c.UserID).Msg("pick reaction rejected")
+		c.rejectPickReaction(msg.PickID, pickReactionRejection(err))
+		return
+	}
+	c.Manager.BroadcastPickReactions(c.DraftID, *counts)
+}
+
+// pickReactionRejection is the reason given to a user for a failed reaction; internal failures
+// are not spelled out
+func pickReactionRejection(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && connectErr.Code() != connect.CodeInternal && connectErr.Code() != connect.CodeUnknown {
+		return connectErr.Message()
+	}
+	return "could not record the reaction"
+}
+
+func (c *Connection) rejectPickReaction(pickID, reason string) {
+	data, err := json.Marshal(PickReactionRejectedPayload{PickID: pickID, Reason: reason})
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", c.ID).Msg("failed to marshal pick reaction rejection")
+		return
+	}
+	c.Manager.SendToConnection(c.DraftID, c.ID, &DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   c.DraftID.String(),
+		Type:      EventTypePickReactionRejected,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
+
+// BroadcastPickReactions sends a pick's reaction counts to every connection in a draft that
+// negotiated the reactions capability
+func (cm *ConnectionManager) BroadcastPickReactions(draftID uuid.UUID, counts PickReactionsPayload) {
+	data, err := json.Marshal(counts)
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to marshal pick reactions")
+		return
+	}
+	event := &DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   draftID.String(),
+		Type:      EventTypePickReactionsUpdated,
+		Timestamp: time.Now(),
+		Data:      data,
+	}
+
+	select {
+	case cm.broadcastCh <- BroadcastMessage{DraftID: draftID, Event: event, Reactions: true}:
+	default:
+		log.Warn().Str("draft_id", draftID.String()).Msg("broadcast channel full, dropping pick reactions")
+	}
+}
@@ -0,0 +1,174 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// parkGrace keeps a parked session in Redis past its resume TTL, so the replica that
+	// parked it always gets to expire it and announce the departure
+	parkGrace = time.Minute
+	// presenceTTL bounds how long a room's presence counts outlive their last join, which
+	// cleans up after replicas that died holding sessions
+	presenceTTL = 24 * time.Hour
+)
+
+// joinScript counts a session of a user in a room and returns the user's session count
+var joinScript = redis.NewScript(`
+local count = redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+redis.call("EXPIRE", KEYS[1], ARGV[2])
+return count
+`)
+
+// leaveScript uncounts a session of a user in a room and returns the sessions left
+var leaveScript = redis.NewScript(`
+local count = redis.call("HINCRBY", KEYS[1], ARGV[1], -1)
+if count <= 0 then
+	redis.call("HDEL", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// RedisSessionState is a SessionState shared by every gateway replica using the same Redis.
+// Parked sessions are claimed atomically, so a session resumes on one replica only, and
+// presence changes are relayed to the other replicas over pub/sub.
+type RedisSessionState struct {
+	client redis.UniversalClient
+	prefix string
+	// replica tells this gateway's presence announcements apart from other replicas'
+	replica string
+}
+
+type presenceMessage struct {
+	Replica string         `json:"replica"`
+	Change  PresenceChange `json:"change"`
+}
+
+// NewRedisSessionState creates a session state kept under keyPrefix
+func NewRedisSessionState(client redis.UniversalClient, keyPrefix string) *RedisSessionState {
+	return &RedisSessionState{
+		client:  client,
+		prefix:  keyPrefix + "gateway:",
+		replica: uuid.New().String(),
+	}
+}
+
+func (s *RedisSessionState) sessionKey(token string) string {
+	return s.prefix + "session:" + token
+}
+
+func (s *RedisSessionState) presenceKey(draftID uuid.UUID) string {
+	return s.prefix + "presence:" + draftID.String()
+}
+
+func (s *RedisSessionState) presenceChannel() string {
+	return s.prefix + "presence"
+}
+
+// Join implements SessionState
+func (s *RedisSessionState) Join(ctx context.Context, draftID uuid.UUID, userID string) (bool, error) {
+	count, err := joinScript.Run(ctx, s.client, []string{s.presenceKey(draftID)}, userID, int(presenceTTL.Seconds())).Int64()
+	if err != nil {
+		return false, fmt.Errorf("failed to record presence: %w", err)
+	}
+	return count == 1, nil
+}
+
+// Park implements SessionState
+func (s *RedisSessionState) Park(ctx context.Context, record SessionRecord, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session: %w", err)
+	}
+	if err := s.client.Set(ctx, s.sessionKey(record.Token), data, ttl+parkGrace).Err(); err != nil {
+		return fmt.Errorf("failed to park session: %w", err)
+	}
+	return nil
+}
+
+// Claim implements SessionState
+func (s *RedisSessionState) Claim(ctx context.Context, token string) (SessionRecord, bool, error) {
+	data, err := s.client.GetDel(ctx, s.sessionKey(token)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return SessionRecord{}, false, nil
+		}
+		return SessionRecord{}, false, fmt.Errorf("failed to claim session: %w", err)
+	}
+
+	var record SessionRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return SessionRecord{}, false, fmt.Errorf("failed to unmarshal session: %w", err)
+	}
+	return record, true, nil
+}
+
+// Expire implements SessionState
+func (s *RedisSessionState) Expire(ctx context.Context, token string) (*SessionRecord, bool, error) {
+	// Claiming first means only one of an expiry and a resume racing for the session wins
+	record, ok, err := s.Claim(ctx, token)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	count, err := leaveScript.Run(ctx, s.client, []string{s.presenceKey(record.DraftID)}, record.UserID).Int64()
+	if err != nil {
+		return &record, false, fmt.Errorf("failed to record presence: %w", err)
+	}
+	return &record, count <= 0, nil
+}
+
+// ForgetDraft implements SessionState. Sessions parked in the room expire on their own.
+func (s *RedisSessionState) ForgetDraft(ctx context.Context, draftID uuid.UUID) error {
+	if err := s.client.Del(ctx, s.presenceKey(draftID)).Err(); err != nil {
+		return fmt.Errorf("failed to forget presence: %w", err)
+	}
+	return nil
+}
+
+// PublishPresence implements SessionState
+func (s *RedisSessionState) PublishPresence(ctx context.Context, change PresenceChange) error {
+	data, err := json.Marshal(presenceMessage{Replica: s.replica, Change: change})
+	if err != nil {
+		return fmt.Errorf("failed to marshal presence change: %w", err)
+	}
+	if err := s.client.Publish(ctx, s.presenceChannel(), data).Err(); err != nil {
+		return fmt.Errorf("failed to publish presence change: %w", err)
+	}
+	return nil
+}
+
+// SubscribePresence implements SessionState
+func (s *RedisSessionState) SubscribePresence(ctx context.Context, relay func(PresenceChange)) error {
+	pubsub := s.client.Subscribe(ctx, s.presenceChannel())
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return errors.New("presence subscription closed")
+			}
+			var presence presenceMessage
+			if err := json.Unmarshal([]byte(msg.Payload), &presence); err != nil {
+				log.Error().Err(err).Msg("failed to unmarshal presence change")
+				continue
+			}
+			if presence.Replica == s.replica {
+				continue
+			}
+			relay(presence.Change)
+		}
+	}
+}
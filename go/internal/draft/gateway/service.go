@@ -6,55 +6,152 @@ import (
 	"github.com/google/uuid"
 	"net/http"
 
+	"github.com/mcdev12/dynasty/go/internal/flags"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/ratelimit"
 	"github.com/rs/zerolog/log"
 )
 
-// Service is the main draft gateway service that handles WebSocket connections and event broadcasting
+// Service is the main draft gateway service that handles WebSocket connections and event
+// broadcasting, for draft rooms and for live scoring of matchups
 type Service struct {
 	connectionManager *ConnectionManager
 	wsHandler         *WebSocketHandler
 	eventConsumer     *EventConsumer
+	activityConsumer  *EventConsumer
+	matchupConsumer   *MatchupEventConsumer
+	delayMonitor      *DelayMonitor
 	stateHandler      *StateHandler
-	stateProvider     StateProvider
+	projection        *DraftProjection
 }
 
 // Config holds configuration for the draft gateway service
 type Config struct {
 	ConnectionConfig ConnectionConfig
-	JetStreamConfig  JetStreamConsumerConfig
+	// JetStreamConfig is where draft lifecycle and pick events are consumed from
+	JetStreamConfig JetStreamConsumerConfig
+	// ActivityJetStreamConfig is where draft activity events are consumed from, apart from
+	// lifecycle and pick events; an empty StreamName turns activity off
+	ActivityJetStreamConfig JetStreamConsumerConfig
+	ProjectionConfig        ProjectionConfig
+	// MatchupJetStreamConfig is where live scoring updates are consumed from; an empty
+	// StreamName turns live scoring off
+	MatchupJetStreamConfig JetStreamConsumerConfig
+	// DelayNoticeConfig is where orchestrator load reports are followed, to tell draft rooms
+	// when their pick is delayed; an empty URL turns delay notices off
+	DelayNoticeConfig DelayNoticeConfig
+
+	// SessionState keeps resumable sessions and room presence; replicas behind a load
+	// balancer share a RedisSessionState. Nil keeps them in memory.
+	SessionState SessionState
+	// Limiter counts connection attempts against ConnectionConfig.ConnectRateLimit; replicas
+	// share a ratelimit.RedisLimiter. Nil counts in memory.
+	Limiter ratelimit.Limiter
+	// History rebuilds a draft's past state from its event log for the historical state
+	// endpoint. Nil turns the endpoint off.
+	History DraftHistory
+	// ChangeLog serves a draft's events since a sequence to clients that poll. Nil turns the
+	// changes endpoint off.
+	ChangeLog DraftChangeLog
+	// Flags turn protocol capabilities off for leagues their CapabilityFlag is off for. Nil
+	// offers every capability to every league.
+	Flags *flags.Client
+	// TeamNames names the NFL teams of picks in the locale of REST requests. Nil leaves the
+	// names unset.
+	TeamNames TeamNames
+	// Sessions checks the session access tokens users connect and call with. Nil admits
+	// everyone to draft rooms as a spectator, unable to act as any user, and turns away
+	// everything that needs a signed-in user.
+	Sessions interceptors.SessionAuthenticator
 }
 
 // DefaultConfig returns default configuration for the draft gateway
 func DefaultConfig() Config {
 	return Config{
-		ConnectionConfig: DefaultConnectionConfig(),
-		JetStreamConfig:  DefaultJetStreamConsumerConfig(),
+		ConnectionConfig:        DefaultConnectionConfig(),
+		JetStreamConfig:         DefaultJetStreamConsumerConfig(),
+		ActivityJetStreamConfig: DefaultActivityJetStreamConsumerConfig(),
+		ProjectionConfig:        DefaultProjectionConfig(),
+		MatchupJetStreamConfig:  DefaultMatchupJetStreamConsumerConfig(),
+		DelayNoticeConfig:       DefaultDelayNoticeConfig(),
 	}
 }
 
 // NewService creates a new draft gateway service
-func NewService(config Config, stateProvider StateProvider) (*Service, error) {
+func NewService(config Config, snapshots SnapshotProvider, userDrafts UserDraftsProvider, exports ExportProvider, chatReports ChatReportStore, pauseVotes PauseVoteStore, pickTrades PickTradeStore, pickReactions PickReactionStore, deliveries FrameDeliveryStore, presence RoomPresenceStore, matchups UserMatchupsProvider) (*Service, error) {
+	// Create chat moderation, enforced by the connection manager as messages are broadcast
+	chat := NewChatModerator(userDrafts)
+
 	// Create connection manager
-	connectionManager := NewConnectionManager(config.ConnectionConfig)
+	connectionManager := NewConnectionManager(config.ConnectionConfig, chat, pauseVotes, pickTrades, pickReactions, deliveries, presence, config.SessionState)
+
+	// Create in-memory draft projection, hydrated from snapshots and fed by events
+	projection := NewDraftProjection(snapshots, config.ProjectionConfig)
 
 	// Create WebSocket handler
-	wsHandler := NewWebSocketHandler(connectionManager)
+	limiter := config.Limiter
+	if limiter == nil {
+		limiter = ratelimit.NewMemoryLimiter()
+	}
+	wsHandler := NewWebSocketHandler(connectionManager, limiter, matchups, userDrafts, projection, config.Flags, config.Sessions)
 
 	// Create JetStream event consumer
-	eventConsumer, err := NewEventConsumer(connectionManager, config.JetStreamConfig)
+	eventConsumer, err := NewEventConsumer(connectionManager, projection, config.JetStreamConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create event consumer: %w", err)
 	}
 
+	// Create the draft activity consumer, reading its own stream alongside the event consumer
+	var activityConsumer *EventConsumer
+	if config.ActivityJetStreamConfig.StreamName != "" {
+		activityConsumer, err = NewEventConsumer(connectionManager, projection, config.ActivityJetStreamConfig)
+		if err != nil {
+			eventConsumer.Stop()
+			return nil, fmt.Errorf("failed to create activity event consumer: %w", err)
+		}
+	}
+
+	// Create the live scoring consumer, the second event source feeding matchup rooms
+	var matchupConsumer *MatchupEventConsumer
+	if config.MatchupJetStreamConfig.StreamName != "" {
+		matchupConsumer, err = NewMatchupEventConsumer(connectionManager, config.MatchupJetStreamConfig)
+		if err != nil {
+			eventConsumer.Stop()
+			if activityConsumer != nil {
+				activityConsumer.Stop()
+			}
+			return nil, fmt.Errorf("failed to create matchup event consumer: %w", err)
+		}
+	}
+
+	// Create the delay monitor, telling draft rooms when an orchestrator is behind schedule
+	var delayMonitor *DelayMonitor
+	if config.DelayNoticeConfig.NATS.URL != "" {
+		delayMonitor, err = NewDelayMonitor(connectionManager, config.DelayNoticeConfig)
+		if err != nil {
+			eventConsumer.Stop()
+			if activityConsumer != nil {
+				activityConsumer.Stop()
+			}
+			if matchupConsumer != nil {
+				matchupConsumer.Stop()
+			}
+			return nil, fmt.Errorf("failed to create delay monitor: %w", err)
+		}
+	}
+
 	// Create state handler
-	stateHandler := NewStateHandler(stateProvider)
+	stateHandler := NewStateHandler(projection, projection, userDrafts, exports, chat, chatReports, config.History, config.ChangeLog, config.TeamNames, config.Sessions)
 
 	return &Service{
 		connectionManager: connectionManager,
 		wsHandler:         wsHandler,
 		eventConsumer:     eventConsumer,
+		activityConsumer:  activityConsumer,
+		matchupConsumer:   matchupConsumer,
+		delayMonitor:      delayMonitor,
 		stateHandler:      stateHandler,
-		stateProvider:     stateProvider,
+		projection:        projection,
 	}, nil
 }
 
@@ -65,6 +162,9 @@ func (s *Service) Start(ctx context.Context) error {
 	// Start connection manager
 	go s.connectionManager.Start(ctx)
 
+	// Start projection reconciliation
+	go s.projection.Start(ctx)
+
 	// Start JetStream event consumer
 	go func() {
 		if err := s.eventConsumer.Start(ctx); err != nil {
@@ -72,6 +172,33 @@ func (s *Service) Start(ctx context.Context) error {
 		}
 	}()
 
+	// Start draft activity consumer
+	if s.activityConsumer != nil {
+		go func() {
+			if err := s.activityConsumer.Start(ctx); err != nil {
+				log.Error().Err(err).Msg("activity event consumer failed")
+			}
+		}()
+	}
+
+	// Start live scoring consumer
+	if s.matchupConsumer != nil {
+		go func() {
+			if err := s.matchupConsumer.Start(ctx); err != nil {
+				log.Error().Err(err).Msg("live scoring consumer failed")
+			}
+		}()
+	}
+
+	// Start delay monitor
+	if s.delayMonitor != nil {
+		go func() {
+			if err := s.delayMonitor.Start(ctx); err != nil {
+				log.Error().Err(err).Msg("delay monitor failed")
+			}
+		}()
+	}
+
 	// Wait for context cancellation
 	<-ctx.Done()
 
@@ -85,6 +212,21 @@ func (s *Service) Stop() error {
 	if err := s.eventConsumer.Stop(); err != nil {
 		log.Error().Err(err).Msg("failed to stop event consumer")
 	}
+	if s.activityConsumer != nil {
+		if err := s.activityConsumer.Stop(); err != nil {
+			log.Error().Err(err).Msg("failed to stop activity event consumer")
+		}
+	}
+	if s.matchupConsumer != nil {
+		if err := s.matchupConsumer.Stop(); err != nil {
+			log.Error().Err(err).Msg("failed to stop live scoring consumer")
+		}
+	}
+	if s.delayMonitor != nil {
+		if err := s.delayMonitor.Stop(); err != nil {
+			log.Error().Err(err).Msg("failed to stop delay monitor")
+		}
+	}
 
 	// Connection manager will stop when context is cancelled
 	log.Info().Msg("draft gateway service stopped")
@@ -105,6 +247,7 @@ func (s *Service) RegisterRoutes(mux *http.ServeMux) {
 // GetStats returns statistics about the gateway service
 func (s *Service) GetStats() map[string]interface{} {
 	stats := s.connectionManager.GetConnectionStats()
+	stats["projected_drafts"] = s.projection.TrackedDrafts()
 	stats["service"] = "draft_gateway"
 	stats["status"] = "running"
 	return stats
@@ -115,6 +258,11 @@ func (s *Service) HandleDraftConnection(w http.ResponseWriter, r *http.Request)
 	s.wsHandler.HandleDraftConnection(w, r)
 }
 
+// HandleMatchupConnection is a convenience method that delegates to the WebSocket handler
+func (s *Service) HandleMatchupConnection(w http.ResponseWriter, r *http.Request) {
+	s.wsHandler.HandleMatchupConnection(w, r)
+}
+
 // BroadcastEvent allows manual event broadcasting (useful for testing)
 func (s *Service) BroadcastEvent(draftID uuid.UUID, event *DraftEvent) {
 	s.connectionManager.BroadcastToDraft(draftID, event)
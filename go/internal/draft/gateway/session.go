@@ -0,0 +1,515 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// replayBufferSize is how many recent sequenced events per draft are kept to replay to
+// resumed sessions. A session that missed more than this must reload state.
+const replayBufferSize = 512
+
+// presenceReportTimeout bounds the draft service call reporting a presence change
+const presenceReportTimeout = 5 * time.Second
+
+// RoomPresenceStore is told when users join and leave draft rooms, once per change however many
+// gateways their sessions are on, so a draft can pause while its commissioner is away
+type RoomPresenceStore interface {
+	ReportRoomPresence(ctx context.Context, draftID, userID uuid.UUID, online bool) error
+}
+
+// PresenceChangedPayload announces a user joining or leaving a draft room. A user stays
+// present while any of their sessions is connected or can still be resumed, so a dropped
+// socket that reconnects in time doesn't produce a leave and a join.
+type PresenceChangedPayload struct {
+	UserID    string    `json:"user_id"`
+	Online    bool      `json:"online"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// SessionResumedPayload follows the Hello frame of a resumed session, before any replayed
+// events. Complete is false when events the client missed are no longer buffered; the client
+// must then reload state and send SnapshotLoaded, and events are held until it does.
+type SessionResumedPayload struct {
+	LastSequence int64 `json:"last_sequence"`
+	Replayed     int   `json:"replayed"`
+	Complete     bool  `json:"complete"`
+}
+
+// session is a client's stay in a draft room, which can outlive its connection. When the
+// socket drops the session is detached and parked in the session state, where it can be
+// resumed with its token, on this gateway or another, until the resume TTL passes.
+type session struct {
+	token   string
+	userID  string
+	draftID uuid.UUID
+	// conn is the connection the session is attached to, nil while detached
+	conn *Connection
+	// lastSequence is the last sequenced event delivered, recorded when the session detaches
+	lastSequence int64
+	// subscription carries the connection's event categories over to a resuming connection
+	subscription map[EventCategory]bool
+	expiry       *time.Timer
+}
+
+// sessionStore tracks the sessions on this gateway. Parked sessions and the room presence
+// sessions imply live in the session state, which may be shared with other gateways.
+type sessionStore struct {
+	state     SessionState
+	resumeTTL time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*session
+	// drafts counts the sessions on this gateway per draft
+	drafts map[uuid.UUID]int
+}
+
+func newSessionStore(state SessionState, resumeTTL time.Duration) *sessionStore {
+	return &sessionStore{
+		state:     state,
+		resumeTTL: resumeTTL,
+		sessions:  make(map[string]*session),
+		drafts:    make(map[uuid.UUID]int),
+	}
+}
+
+// newSessionToken returns an unguessable session token
+func newSessionToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand doesn't fail on supported platforms
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// stateContext bounds a call to the session state
+func stateContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), sessionStateTimeout)
+}
+
+// open starts a session for a new connection and reports whether the user just joined the room
+func (s *sessionStore) open(conn *Connection) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions[conn.session] = &session{
+		token:   conn.session,
+		userID:  conn.UserID,
+		draftID: conn.DraftID,
+		conn:    conn,
+	}
+	s.drafts[conn.DraftID]++
+
+	ctx, cancel := stateContext()
+	defer cancel()
+	joined, err := s.state.Join(ctx, conn.DraftID, conn.UserID)
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", conn.DraftID.String()).Msg("failed to record presence, not announcing join")
+		return false
+	}
+	return joined
+}
+
+// resume attaches a connection to the session with the given token. It returns the last
+// sequenced event the session received and the connection it was still attached to, if
+// the old socket hasn't been noticed dropping yet. remote reports a session parked by
+// another gateway, whose missed events this gateway may not have buffered.
+func (s *sessionStore) resume(token string, conn *Connection) (sequence int64, previous *Connection, remote bool, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, local := s.sessions[token]
+	if local && sess.conn != nil {
+		// Still attached, so never parked
+		if sess.userID != conn.UserID || sess.draftID != conn.DraftID {
+			return 0, nil, false, false
+		}
+		previous = sess.conn
+		sess.lastSequence = previous.lastSequence.Load()
+		sess.conn = conn
+		conn.session = token
+		return sess.lastSequence, previous, false, true
+	}
+
+	ctx, cancel := stateContext()
+	defer cancel()
+	record, parked, err := s.state.Claim(ctx, token)
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", conn.ID).Msg("failed to claim parked session")
+		return 0, nil, false, false
+	}
+	if !parked {
+		// Unknown, expired, or resumed on another gateway
+		return 0, nil, false, false
+	}
+	if record.UserID != conn.UserID || record.DraftID != conn.DraftID {
+		// Not this client's to resume; put it back for its owner
+		if err := s.state.Park(ctx, record, s.resumeTTL); err != nil {
+			log.Error().Err(err).Str("connection_id", conn.ID).Msg("failed to re-park session")
+		}
+		return 0, nil, false, false
+	}
+
+	if local {
+		if sess.expiry != nil {
+			sess.expiry.Stop()
+			sess.expiry = nil
+		}
+	} else {
+		sess = &session{
+			token:        token,
+			userID:       record.UserID,
+			draftID:      record.DraftID,
+			lastSequence: record.LastSequence,
+			subscription: categorySet(record.Subscription),
+		}
+		s.sessions[token] = sess
+		s.drafts[sess.draftID]++
+	}
+	sess.conn = conn
+	conn.session = token
+	return sess.lastSequence, nil, !local, true
+}
+
+// subscription returns the event categories a session subscribed to
+func (s *sessionStore) subscription(token string) map[EventCategory]bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sess, ok := s.sessions[token]; ok {
+		return sess.subscription
+	}
+	return nil
+}
+
+// subscribe records a connection's event categories on its session
+func (s *sessionStore) subscribe(conn *Connection, categories map[EventCategory]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sess, ok := s.sessions[conn.session]; ok && sess.conn == conn {
+		sess.subscription = categories
+	}
+}
+
+// detach parks a dropped connection's session so it can be resumed for the resume TTL,
+// after which expire is called
+func (s *sessionStore) detach(conn *Connection, expire func(token string)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.sessions[conn.session]
+	if !ok || sess.conn != conn {
+		// Never opened, or already taken over by a resuming connection
+		return
+	}
+	sess.conn = nil
+	sess.lastSequence = conn.lastSequence.Load()
+
+	ctx, cancel := stateContext()
+	defer cancel()
+	err := s.state.Park(ctx, SessionRecord{
+		Token:        sess.token,
+		UserID:       sess.userID,
+		DraftID:      sess.draftID,
+		LastSequence: sess.lastSequence,
+		Subscription: categoryList(sess.subscription),
+	}, s.resumeTTL)
+	if err != nil {
+		// The session can't be resumed, but still expires so the session count stays right
+		log.Error().Err(err).Str("connection_id", conn.ID).Msg("failed to park session")
+	}
+	sess.expiry = time.AfterFunc(s.resumeTTL, func() { expire(sess.token) })
+}
+
+// expire ends a session that wasn't resumed in time and reports whether its user left the room
+func (s *sessionStore) expire(token string) (*session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.sessions[token]
+	if !ok || sess.conn != nil {
+		// Resumed while the timer fired
+		return nil, false
+	}
+	s.drop(sess)
+
+	ctx, cancel := stateContext()
+	defer cancel()
+	_, left, err := s.state.Expire(ctx, token)
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", sess.draftID.String()).Msg("failed to expire session")
+		return sess, false
+	}
+	return sess, left
+}
+
+// drop forgets a session on this gateway
+func (s *sessionStore) drop(sess *session) {
+	delete(s.sessions, sess.token)
+	s.drafts[sess.draftID]--
+	if s.drafts[sess.draftID] <= 0 {
+		delete(s.drafts, sess.draftID)
+	}
+}
+
+// hasDraft reports whether any session on this gateway, connected or resumable, is in a
+// draft's room
+func (s *sessionStore) hasDraft(draftID uuid.UUID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.drafts[draftID] > 0
+}
+
+// forgetDraft drops every session in a closed draft's room without announcing departures
+func (s *sessionStore) forgetDraft(draftID uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sess := range s.sessions {
+		if sess.draftID != draftID {
+			continue
+		}
+		if sess.expiry != nil {
+			sess.expiry.Stop()
+		}
+		s.drop(sess)
+	}
+
+	ctx, cancel := stateContext()
+	defer cancel()
+	if err := s.state.ForgetDraft(ctx, draftID); err != nil {
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to forget draft presence")
+	}
+}
+
+// count returns the number of sessions on this gateway, connected or resumable
+func (s *sessionStore) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.sessions)
+}
+
+// categoryList and categorySet convert a subscription to and from its shared form
+func categoryList(categories map[EventCategory]bool) []EventCategory {
+	if categories == nil {
+		return nil
+	}
+	list := make([]EventCategory, 0, len(categories))
+	for category := range categories {
+		list = append(list, category)
+	}
+	return list
+}
+
+func categorySet(list []EventCategory) map[EventCategory]bool {
+	if list == nil {
+		return nil
+	}
+	categories := make(map[EventCategory]bool, len(list))
+	for _, category := range list {
+		categories[category] = true
+	}
+	return categories
+}
+
+// replayBuffer holds a draft's most recent sequenced events
+type replayBuffer struct {
+	events []heldEvent
+	// evicted is the highest sequence dropped from the buffer; sessions that last saw an
+	// earlier event can't be replayed to
+	evicted int64
+	// floor is the sequence before the first event buffered. Sessions on this gateway saw
+	// everything before it, but sessions parked by another gateway may not have.
+	floor int64
+}
+
+func (b *replayBuffer) add(sequence int64, eventType EventType, data []byte) {
+	b.events = append(b.events, heldEvent{sequence: sequence, eventType: eventType, data: data})
+	if len(b.events) > replayBufferSize {
+		b.evicted = b.events[0].sequence
+		b.events = b.events[1:]
+	}
+}
+
+type resumeRequest struct {
+	conn     *Connection
+	sequence int64
+	// remote marks a session parked by another gateway
+	remote bool
+}
+
+// recordReplay buffers a draft-wide sequenced event for sessions that may resume. Only drafts
+// with sessions are buffered.
+func (cm *ConnectionManager) recordReplay(message BroadcastMessage, data []byte) {
+	if message.Event.Sequence <= 0 || message.UserID != "" || message.ConnectionID != "" || message.Chat {
+		return
+	}
+	if !cm.sessions.hasDraft(message.DraftID) {
+		return
+	}
+
+	buffer, ok := cm.replay[message.DraftID]
+	if !ok {
+		buffer = &replayBuffer{floor: message.Event.Sequence - 1}
+		cm.replay[message.DraftID] = buffer
+	}
+	buffer.add(message.Event.Sequence, message.Event.Type, data)
+}
+
+// replayMissedEvents sends a resumed connection the buffered events after the last one its
+// session received, then releases the live events held while it resumed. If the missed
+// events are no longer buffered, or may never have been for a session resumed from another
+// gateway, events stay held until the client reloads state.
+func (cm *ConnectionManager) replayMissedEvents(conn *Connection, sequence int64, remote bool) {
+	cm.mu.RLock()
+	_, registered := cm.draftConnections[conn.DraftID][conn]
+	cm.mu.RUnlock()
+	if !registered {
+		return
+	}
+
+	var missed []heldEvent
+	complete := !remote
+	if buffer, ok := cm.replay[conn.DraftID]; ok {
+		complete = buffer.evicted <= sequence && (!remote || buffer.floor <= sequence)
+		for _, event := range buffer.events {
+			if event.sequence > sequence && conn.wants(event.eventType) {
+				missed = append(missed, event)
+			}
+		}
+	}
+	if !complete {
+		missed = nil
+	}
+
+	resumed, err := sessionResumedEvent(conn, SessionResumedPayload{
+		LastSequence: sequence,
+		Replayed:     len(missed),
+		Complete:     complete,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", conn.ID).Msg("failed to build session resumed frame")
+		return
+	}
+	if !cm.deliver(conn, 0, resumed) {
+		return
+	}
+	if !complete {
+		log.Info().
+			Str("connection_id", conn.ID).
+			Int64("last_seq", sequence).
+			Msg("missed events no longer buffered, resumed session must reload state")
+		return
+	}
+
+	for _, event := range missed {
+		if !cm.deliver(conn, event.sequence, event.data) {
+			return
+		}
+		sequence = event.sequence
+	}
+	cm.releaseHeldEvents(conn, sequence)
+}
+
+// pruneReplayBuffers drops the replay buffers of drafts no session can resume in
+func (cm *ConnectionManager) pruneReplayBuffers() {
+	for draftID := range cm.replay {
+		if !cm.sessions.hasDraft(draftID) {
+			delete(cm.replay, draftID)
+		}
+	}
+}
+
+// expireSession ends a session that wasn't resumed within the TTL, announcing the user's
+// departure if it was their last session in the room
+func (cm *ConnectionManager) expireSession(token string) {
+	sess, left := cm.sessions.expire(token)
+	if !left {
+		return
+	}
+	cm.broadcastPresence(sess.draftID, sess.userID, false)
+}
+
+// broadcastPresence announces a user joining or leaving a draft room, to the clients of
+// this gateway and through the session state to those of the others
+func (cm *ConnectionManager) broadcastPresence(draftID uuid.UUID, userID string, online bool) {
+	change := PresenceChange{DraftID: draftID, UserID: userID, Online: online}
+	cm.announcePresence(change)
+
+	ctx, cancel := stateContext()
+	defer cancel()
+	if err := cm.sessions.state.PublishPresence(ctx, change); err != nil {
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to publish presence change")
+	}
+
+	go cm.reportPresence(change)
+}
+
+// reportPresence reports a presence change of a signed-in user to the draft service
+func (cm *ConnectionManager) reportPresence(change PresenceChange) {
+	if cm.presence == nil {
+		return
+	}
+	userID, err := uuid.Parse(change.UserID)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), presenceReportTimeout)
+	defer cancel()
+
+	if err := cm.presence.ReportRoomPresence(ctx, change.DraftID, userID, change.Online); err != nil {
+		log.Error().
+			Err(err).
+			Str("draft_id", change.DraftID.String()).
+			Str("user_id", change.UserID).
+			Bool("online", change.Online).
+			Msg("failed to report presence change")
+	}
+}
+
+// announcePresence sends a presence change to the clients of this gateway in the room
+func (cm *ConnectionManager) announcePresence(change PresenceChange) {
+	data, err := json.Marshal(PresenceChangedPayload{
+		UserID:    change.UserID,
+		Online:    change.Online,
+		ChangedAt: time.Now(),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", change.DraftID.String()).Msg("failed to marshal presence change")
+		return
+	}
+	cm.BroadcastToDraft(change.DraftID, &DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   change.DraftID.String(),
+		Type:      EventTypePresenceChanged,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
+
+// sessionResumedEvent renders the frame telling a resumed client what is being replayed
+func sessionResumedEvent(c *Connection, payload SessionResumedPayload) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   c.DraftID.String(),
+		Type:      EventTypeSessionResumed,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
@@ -0,0 +1,148 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// sessionStateTimeout bounds a call to the session state, which may be remote
+const sessionStateTimeout = 2 * time.Second
+
+// SessionRecord is what a gateway needs to resume a detached session
+type SessionRecord struct {
+	Token        string          `json:"token"`
+	UserID       string          `json:"user_id"`
+	DraftID      uuid.UUID       `json:"draft_id"`
+	LastSequence int64           `json:"last_sequence"`
+	Subscription []EventCategory `json:"subscription,omitempty"` // nil for every event
+}
+
+// PresenceChange is a user joining or leaving a draft room
+type PresenceChange struct {
+	DraftID uuid.UUID `json:"draft_id"`
+	UserID  string    `json:"user_id"`
+	Online  bool      `json:"online"`
+}
+
+// SessionState is the session state gateway replicas share: detached sessions, so a client
+// can resume on whichever replica it reconnects to, and room presence, so a user is announced
+// once however many replicas their sessions are on. MemorySessionState serves a single
+// gateway; RedisSessionState shares the state between replicas.
+type SessionState interface {
+	// Join counts a session of a user in a draft's room and reports whether the user just joined
+	Join(ctx context.Context, draftID uuid.UUID, userID string) (bool, error)
+	// Park keeps a detached session resumable for at least ttl
+	Park(ctx context.Context, record SessionRecord, ttl time.Duration) error
+	// Claim takes a parked session for a resuming connection. It reports false for a session
+	// that is unknown, expired or already claimed.
+	Claim(ctx context.Context, token string) (SessionRecord, bool, error)
+	// Expire ends a parked session that wasn't resumed in time and reports whether its user
+	// left the room. A session claimed in the meantime is left alone.
+	Expire(ctx context.Context, token string) (*SessionRecord, bool, error)
+	// ForgetDraft drops the presence of a closed draft's room
+	ForgetDraft(ctx context.Context, draftID uuid.UUID) error
+	// PublishPresence relays a presence change to the clients of the other replicas
+	PublishPresence(ctx context.Context, change PresenceChange) error
+	// SubscribePresence calls relay with the presence changes other replicas publish until
+	// ctx is done
+	SubscribePresence(ctx context.Context, relay func(PresenceChange)) error
+}
+
+// MemorySessionState is the SessionState of a single gateway
+type MemorySessionState struct {
+	mu     sync.Mutex
+	parked map[string]SessionRecord
+	// present[draftID][userID] counts a user's sessions in a draft
+	present map[uuid.UUID]map[string]int
+}
+
+// NewMemorySessionState creates an in-memory session state
+func NewMemorySessionState() *MemorySessionState {
+	return &MemorySessionState{
+		parked:  make(map[string]SessionRecord),
+		present: make(map[uuid.UUID]map[string]int),
+	}
+}
+
+// Join implements SessionState
+func (s *MemorySessionState) Join(_ context.Context, draftID uuid.UUID, userID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.present[draftID] == nil {
+		s.present[draftID] = make(map[string]int)
+	}
+	s.present[draftID][userID]++
+	return s.present[draftID][userID] == 1, nil
+}
+
+// Park implements SessionState. Parked sessions are only dropped by Claim and Expire; the
+// gateway expires every session it parks.
+func (s *MemorySessionState) Park(_ context.Context, record SessionRecord, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.parked[record.Token] = record
+	return nil
+}
+
+// Claim implements SessionState
+func (s *MemorySessionState) Claim(_ context.Context, token string) (SessionRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.parked[token]
+	delete(s.parked, token)
+	return record, ok, nil
+}
+
+// Expire implements SessionState
+func (s *MemorySessionState) Expire(_ context.Context, token string) (*SessionRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.parked[token]
+	if !ok {
+		return nil, false, nil
+	}
+	delete(s.parked, token)
+
+	users := s.present[record.DraftID]
+	users[record.UserID]--
+	if users[record.UserID] > 0 {
+		return &record, false, nil
+	}
+	delete(users, record.UserID)
+	if len(users) == 0 {
+		delete(s.present, record.DraftID)
+	}
+	return &record, true, nil
+}
+
+// ForgetDraft implements SessionState
+func (s *MemorySessionState) ForgetDraft(_ context.Context, draftID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for token, record := range s.parked {
+		if record.DraftID == draftID {
+			delete(s.parked, token)
+		}
+	}
+	delete(s.present, draftID)
+	return nil
+}
+
+// PublishPresence implements SessionState; there are no other replicas to tell
+func (s *MemorySessionState) PublishPresence(context.Context, PresenceChange) error {
+	return nil
+}
+
+// SubscribePresence implements SessionState; no other replica publishes
+func (s *MemorySessionState) SubscribePresence(ctx context.Context, _ func(PresenceChange)) error {
+	<-ctx.Done()
+	return nil
+}
@@ -0,0 +1,129 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// defaultSnapshotCacheTTL is how long a loaded snapshot is reused for hydrations that
+	// don't need anything newer
+	defaultSnapshotCacheTTL = 2 * time.Second
+	// snapshotLoadTimeout bounds a shared snapshot load, which runs detached from the
+	// requests waiting on it
+	snapshotLoadTimeout = 10 * time.Second
+)
+
+// snapshotCache keeps recently loaded draft snapshots and shares in-flight loads, so a burst
+// of hydrations, such as every client of a draft reconnecting at once, costs the draft
+// service one load per draft rather than one per client
+type snapshotCache struct {
+	ttl    time.Duration
+	flight singleflight.Group
+
+	mu      sync.Mutex
+	entries map[uuid.UUID]cachedSnapshot
+}
+
+type cachedSnapshot struct {
+	snapshot *DraftSnapshot
+	loadedAt time.Time
+}
+
+func newSnapshotCache(ttl time.Duration) *snapshotCache {
+	return &snapshotCache{
+		ttl:     ttl,
+		entries: make(map[uuid.UUID]cachedSnapshot),
+	}
+}
+
+// get returns the snapshot of a draft, from the cache when one loaded within the TTL reflects
+// at least minSequence and otherwise from load. Callers get their own copy to modify.
+func (c *snapshotCache) get(ctx context.Context, draftID uuid.UUID, minSequence int64, load func(ctx context.Context) (*DraftSnapshot, error)) (*DraftSnapshot, error) {
+	if snapshot, ok := c.cached(draftID, minSequence); ok {
+		return snapshot, nil
+	}
+
+	snapshot, err := c.share(ctx, draftID, load)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.EventSequence < minSequence {
+		// Joined a load that started before the draft moved on; take another
+		snapshot, err = c.share(ctx, draftID, load)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return snapshot.clone(), nil
+}
+
+// share runs load for a draft, or waits on the load already running for it
+func (c *snapshotCache) share(ctx context.Context, draftID uuid.UUID, load func(ctx context.Context) (*DraftSnapshot, error)) (*DraftSnapshot, error) {
+	ch := c.flight.DoChan(draftID.String(), func() (interface{}, error) {
+		// Detached from the caller so one client going away doesn't fail the load for the rest
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), snapshotLoadTimeout)
+		defer cancel()
+
+		snapshot, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+		c.put(draftID, snapshot)
+		return snapshot, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*DraftSnapshot), nil
+	}
+}
+
+// cached returns a copy of a draft's cached snapshot if it is still fresh and reflects at
+// least minSequence
+func (c *snapshotCache) cached(draftID uuid.UUID, minSequence int64) (*DraftSnapshot, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[draftID]
+	if !ok || time.Since(entry.loadedAt) > c.ttl || entry.snapshot.EventSequence < minSequence {
+		return nil, false
+	}
+	return entry.snapshot.clone(), true
+}
+
+// put caches a draft's snapshot, dropping entries that have expired
+func (c *snapshotCache) put(draftID uuid.UUID, snapshot *DraftSnapshot) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for id, entry := range c.entries {
+		if now.Sub(entry.loadedAt) > c.ttl {
+			delete(c.entries, id)
+		}
+	}
+	c.entries[draftID] = cachedSnapshot{snapshot: snapshot, loadedAt: now}
+}
+
+// clone copies a snapshot deeply enough that the projection can update the copy's board and
+// pick on the clock without touching the original
+func (s *DraftSnapshot) clone() *DraftSnapshot {
+	c := *s
+	c.DraftOrder = append([]string(nil), s.DraftOrder...)
+	c.Board = append([]BoardPick(nil), s.Board...)
+	if s.CurrentPick != nil {
+		currentPick := *s.CurrentPick
+		c.CurrentPick = &currentPick
+	}
+	return &c
+}
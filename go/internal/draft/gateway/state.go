@@ -146,8 +146,18 @@ func (dsm *DraftStateManager) ProcessEvent(event *DraftEvent) error {
 		state.CurrentPick.UpdateTimeRemaining()
 
 	case EventTypePickMade:
+		payload, err := ParseEventPayload(event)
+		if err != nil {
+			return err
+		}
 		state.CompletedPicks++
-		state.CurrentPick = nil // Clear current pick
+		// A late pick is made out of board order and leaves the pick on the clock running
+		if !payload.(events.PickMadePayload).Late {
+			state.CurrentPick = nil // Clear current pick
+		}
+
+	case EventTypePickSkipped:
+		state.CurrentPick = nil // The next pick starts with its own PickStarted event
 
 	case EventTypeDraftPaused:
 		payload, err := ParseEventPayload(event)
@@ -3,10 +3,17 @@ package gateway
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/compression"
+	"github.com/mcdev12/dynasty/go/internal/etag"
+	"github.com/mcdev12/dynasty/go/internal/i18n"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
 	"github.com/rs/zerolog/log"
 )
 
@@ -16,6 +23,24 @@ type StateProvider interface {
 	GetActiveDrafts(ctx context.Context) ([]DraftSummary, error)
 }
 
+// BoardProvider interface defines methods for retrieving the draft board and clock
+type BoardProvider interface {
+	GetDraftBoard(ctx context.Context, draftID uuid.UUID) (*DraftBoardResponse, error)
+	GetDraftClock(ctx context.Context, draftID uuid.UUID) (*DraftClockResponse, error)
+}
+
+// ExportProvider renders a draft's full results in a download format
+type ExportProvider interface {
+	ExportDraftResults(ctx context.Context, draftID uuid.UUID, format string) (*DraftExport, error)
+}
+
+// DraftExport is a rendered draft results file
+type DraftExport struct {
+	ContentType string
+	Filename    string
+	Data        []byte
+}
+
 // DraftStateResponse represents the complete state of a draft
 type DraftStateResponse struct {
 	DraftID        string                 `json:"draft_id"`
@@ -26,6 +51,9 @@ type DraftStateResponse struct {
 	TotalPicks     int                    `json:"total_picks"`
 	CompletedPicks int                    `json:"completed_picks"`
 	Metadata       map[string]interface{} `json:"metadata"`
+	// EventSequence is the sequence of the last draft event reflected in this state.
+	// Clients pass it as snapshot_seq when connecting to skip events they already have.
+	EventSequence int64 `json:"event_sequence"`
 }
 
 // CurrentPickInfo represents the current pick on the clock
@@ -43,15 +71,18 @@ type CurrentPickInfo struct {
 
 // RecentPickInfo represents a recently made pick
 type RecentPickInfo struct {
-	PickID      string    `json:"pick_id"`
-	TeamID      string    `json:"team_id"`
-	TeamName    string    `json:"team_name"`
-	PlayerID    string    `json:"player_id"`
-	PlayerName  string    `json:"player_name"`
-	Round       int       `json:"round"`
-	Pick        int       `json:"pick"`
-	OverallPick int       `json:"overall_pick"`
-	MadeAt      time.Time `json:"made_at"`
+	PickID         string    `json:"pick_id"`
+	TeamID         string    `json:"team_id"`
+	TeamName       string    `json:"team_name"`
+	PlayerID       string    `json:"player_id"`
+	PlayerName     string    `json:"player_name"`
+	PlayerPosition string    `json:"player_position,omitempty"`
+	NFLTeamCode    string    `json:"nfl_team_code,omitempty"`
+	NFLTeamName    string    `json:"nfl_team_name,omitempty"` // in the request's locale
+	Round          int       `json:"round"`
+	Pick           int       `json:"pick"`
+	OverallPick    int       `json:"overall_pick"`
+	MadeAt         time.Time `json:"made_at"`
 }
 
 // DraftSummary represents a summary of an active draft
@@ -69,12 +100,32 @@ type DraftSummary struct {
 // StateHandler handles HTTP requests for draft state
 type StateHandler struct {
 	stateProvider StateProvider
+	boardProvider BoardProvider
+	userDrafts    UserDraftsProvider
+	exports       ExportProvider
+	chat          *ChatModerator
+	chatReports   ChatReportStore
+	history       DraftHistory
+	changes       DraftChangeLog
+	teamNames     TeamNames
+	sessions      interceptors.SessionAuthenticator
 }
 
-// NewStateHandler creates a new state handler
-func NewStateHandler(provider StateProvider) *StateHandler {
+// NewStateHandler creates a new state handler. A nil history turns off the historical state
+// endpoint, a nil change log the changes endpoint, and nil team names leave picks' NFL team
+// names unset.
+func NewStateHandler(provider StateProvider, boards BoardProvider, userDrafts UserDraftsProvider, exports ExportProvider, chat *ChatModerator, chatReports ChatReportStore, history DraftHistory, changes DraftChangeLog, teamNames TeamNames, sessions interceptors.SessionAuthenticator) *StateHandler {
 	return &StateHandler{
 		stateProvider: provider,
+		boardProvider: boards,
+		userDrafts:    userDrafts,
+		exports:       exports,
+		chat:          chat,
+		chatReports:   chatReports,
+		history:       history,
+		changes:       changes,
+		teamNames:     teamNames,
+		sessions:      sessions,
 	}
 }
 
@@ -87,16 +138,8 @@ func (h *StateHandler) HandleGetDraftState(w http.ResponseWriter, r *http.Reques
 
 	// Extract draft ID from path
 	// Expecting path like /api/drafts/{id}/state
-	path := r.URL.Path
-	draftIDStr := extractDraftIDFromPath(path)
-	if draftIDStr == "" {
-		http.Error(w, "Draft ID is required", http.StatusBadRequest)
-		return
-	}
-
-	draftID, err := uuid.Parse(draftIDStr)
-	if err != nil {
-		http.Error(w, "Invalid draft ID format", http.StatusBadRequest)
+	draftID, ok := parseDraftIDFromPath(w, r.URL.Path, "/state")
+	if !ok {
 		return
 	}
 
@@ -109,11 +152,10 @@ func (h *StateHandler) HandleGetDraftState(w http.ResponseWriter, r *http.Reques
 	}
 
 	// Calculate time remaining if draft is in progress
-	if state.CurrentPick != nil {
-		remaining := int(time.Until(state.CurrentPick.TimeoutAt).Seconds())
-		if remaining > 0 {
-			state.TimeRemaining = &remaining
-		}
+	state.TimeRemaining = timeRemaining(state.CurrentPick)
+
+	for i := range state.RecentPicks {
+		state.RecentPicks[i].NFLTeamName = h.nflTeamName(r.Context(), state.RecentPicks[i].NFLTeamCode)
 	}
 
 	// Send response
@@ -123,6 +165,102 @@ func (h *StateHandler) HandleGetDraftState(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// HandleGetDraftBoard handles GET /api/drafts/{id}/board
+func (h *StateHandler) HandleGetDraftBoard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	draftID, ok := parseDraftIDFromPath(w, r.URL.Path, "/board")
+	if !ok {
+		return
+	}
+
+	board, err := h.boardProvider.GetDraftBoard(r.Context(), draftID)
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to get draft board")
+		http.Error(w, "Failed to get draft board", http.StatusInternalServerError)
+		return
+	}
+	for i := range board.Picks {
+		board.Picks[i].NFLTeamName = h.nflTeamName(r.Context(), board.Picks[i].NFLTeamCode)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(board); err != nil {
+		log.Error().Err(err).Msg("failed to encode draft board response")
+	}
+}
+
+// HandleGetDraftClock handles GET /api/drafts/{id}/clock
+func (h *StateHandler) HandleGetDraftClock(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	draftID, ok := parseDraftIDFromPath(w, r.URL.Path, "/clock")
+	if !ok {
+		return
+	}
+
+	clock, err := h.boardProvider.GetDraftClock(r.Context(), draftID)
+	if err != nil {
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to get draft clock")
+		http.Error(w, "Failed to get draft clock", http.StatusInternalServerError)
+		return
+	}
+	if clock.OnDeck != nil {
+		clock.OnDeck.NFLTeamName = h.nflTeamName(r.Context(), clock.OnDeck.NFLTeamCode)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(clock); err != nil {
+		log.Error().Err(err).Msg("failed to encode draft clock response")
+	}
+}
+
+// HandleExportDraft handles GET /api/drafts/{id}/export?format=csv|json, serving the
+// draft's results as a file download. The format defaults to CSV.
+func (h *StateHandler) HandleExportDraft(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	draftID, ok := parseDraftIDFromPath(w, r.URL.Path, "/export")
+	if !ok {
+		return
+	}
+
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "json" {
+		http.Error(w, "format must be csv or json", http.StatusBadRequest)
+		return
+	}
+
+	export, err := h.exports.ExportDraftResults(r.Context(), draftID, format)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			http.Error(w, "Draft results not found", http.StatusNotFound)
+			return
+		}
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to export draft results")
+		http.Error(w, "Failed to export draft results", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.Filename))
+	if _, err := w.Write(export.Data); err != nil {
+		log.Error().Err(err).Msg("failed to write draft export response")
+	}
+}
+
 // HandleGetActiveDrafts handles GET /api/drafts/active
 func (h *StateHandler) HandleGetActiveDrafts(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -143,29 +281,78 @@ func (h *StateHandler) HandleGetActiveDrafts(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// RegisterStateRoutes registers state-related HTTP routes
+// nflTeamName names the NFL team with a code in the request's locale, or is empty when there
+// are no team names or no team with the code
+func (h *StateHandler) nflTeamName(ctx context.Context, code string) string {
+	if h.teamNames == nil {
+		return ""
+	}
+	name, _ := h.teamNames.TeamName(ctx, code, i18n.FromContext(ctx))
+	return name
+}
+
+// RegisterStateRoutes registers state-related HTTP routes. Responses are compressed for
+// clients that accept gzip or deflate, and GETs answered 304 Not Modified when the client's
+// If-None-Match names the response's ETag, so clients polling a board between picks don't
+// download it again. Display strings are localized for the locale the request's locale query
+// parameter or Accept-Language asks for, named back in Content-Language.
 func (h *StateHandler) RegisterStateRoutes(mux *http.ServeMux) {
+	handle := func(pattern string, handler http.HandlerFunc) {
+		mux.Handle(pattern, compression.Middleware(etag.Middleware(i18n.Middleware(handler))))
+	}
+
 	// Register specific routes
-	mux.HandleFunc("/api/drafts/active", h.HandleGetActiveDrafts)
+	handle("/api/drafts/active", h.HandleGetActiveDrafts)
+	handle("/api/users/me/drafts", h.HandleGetMyDrafts)
+	handle("/api/locales", h.HandleGetLocales)
+	handle("/api/format", h.HandleFormat)
 
-	// Register pattern for draft state - note the trailing slash
-	mux.HandleFunc("/api/drafts/", func(w http.ResponseWriter, r *http.Request) {
+	// Register pattern for per-draft routes - note the trailing slash
+	handle("/api/drafts/", func(w http.ResponseWriter, r *http.Request) {
 		log.Debug().Str("path", r.URL.Path).Msg("state handler received request")
 
-		// Check if path ends with /state
-		if len(r.URL.Path) > len("/api/drafts/") && r.URL.Path[len(r.URL.Path)-6:] == "/state" {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/state/at"):
+			h.HandleGetDraftStateAt(w, r)
+		case strings.HasSuffix(r.URL.Path, "/state"):
 			h.HandleGetDraftState(w, r)
-		} else {
+		case strings.HasSuffix(r.URL.Path, "/board"):
+			h.HandleGetDraftBoard(w, r)
+		case strings.HasSuffix(r.URL.Path, "/changes"):
+			h.HandleGetDraftChanges(w, r)
+		case strings.HasSuffix(r.URL.Path, "/clock"):
+			h.HandleGetDraftClock(w, r)
+		case strings.HasSuffix(r.URL.Path, "/export"):
+			h.HandleExportDraft(w, r)
+		case strings.HasSuffix(r.URL.Path, "/chat/reports"):
+			h.HandleReportChatMessage(w, r)
+		default:
 			http.NotFound(w, r)
 		}
 	})
 }
 
+// parseDraftIDFromPath extracts and parses the draft ID from a per-draft route,
+// writing a 400 response when it is missing or malformed
+func parseDraftIDFromPath(w http.ResponseWriter, path, suffix string) (uuid.UUID, bool) {
+	draftIDStr := extractDraftIDFromPath(path, suffix)
+	if draftIDStr == "" {
+		http.Error(w, "Draft ID is required", http.StatusBadRequest)
+		return uuid.Nil, false
+	}
+
+	draftID, err := uuid.Parse(draftIDStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID format", http.StatusBadRequest)
+		return uuid.Nil, false
+	}
+	return draftID, true
+}
+
 // extractDraftIDFromPath extracts draft ID from path like /api/drafts/{id}/state
-func extractDraftIDFromPath(path string) string {
+func extractDraftIDFromPath(path, suffix string) string {
 	// Remove prefix and suffix
 	const prefix = "/api/drafts/"
-	const suffix = "/state"
 
 	if len(path) <= len(prefix)+len(suffix) {
 		return ""
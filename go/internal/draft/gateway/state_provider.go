@@ -3,18 +3,23 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// DraftStateProvider implements StateProvider using the draft service client
+// DraftStateProvider implements StateProvider and SnapshotProvider using the draft service client
 type DraftStateProvider struct {
 	draftService     draftv1connect.DraftServiceClient
 	draftPickService draftv1connect.DraftPickServiceClient
+	snapshots        *snapshotCache
 }
 
 // NewDraftStateProvider creates a new draft state provider
@@ -22,6 +27,7 @@ func NewDraftStateProvider(draftService draftv1connect.DraftServiceClient, draft
 	return &DraftStateProvider{
 		draftService:     draftService,
 		draftPickService: draftPickService,
+		snapshots:        newSnapshotCache(defaultSnapshotCacheTTL),
 	}
 }
 
@@ -39,8 +45,9 @@ func (p *DraftStateProvider) GetDraftState(ctx context.Context, draftID uuid.UUI
 
 	// Build response
 	response := &DraftStateResponse{
-		DraftID: draftID.String(),
-		Status:  draft.Status.String(),
+		DraftID:       draftID.String(),
+		Status:        draft.Status.String(),
+		EventSequence: draftResp.Msg.EventSequence,
 		Metadata: map[string]interface{}{
 			"league_id":    draft.LeagueId,
 			"draft_type":   draft.DraftType.String(),
@@ -54,31 +61,32 @@ func (p *DraftStateProvider) GetDraftState(ctx context.Context, draftID uuid.UUI
 
 	// Get pick information if draft is in progress
 	if draft.Status == draftv1.DraftStatus_DRAFT_STATUS_IN_PROGRESS {
-		// Get current pick on the clock via draft pick service
-		getNextPickReq := &draftv1.GetNextPickForDraftRequest{
+		// The summary row holds the pick on the clock and the pick counts, so this is a
+		// single lookup rather than a scan of the pick board
+		summaryResp, err := p.draftService.GetDraftSummary(ctx, connect.NewRequest(&draftv1.GetDraftSummaryRequest{
 			DraftId: draftID.String(),
-		}
-		nextPickResp, err := p.draftPickService.GetNextPickForDraft(ctx, connect.NewRequest(getNextPickReq))
-		if err == nil && nextPickResp.Msg.Pick != nil && nextPickResp.Msg.Pick.PlayerId == "" {
-			// This pick hasn't been made yet, so it's the current pick
-			currentPick := nextPickResp.Msg.Pick
-			response.CurrentPick = &CurrentPickInfo{
-				PickID:      currentPick.Id,
-				TeamID:      currentPick.TeamId,
-				TeamName:    fmt.Sprintf("Team %s", currentPick.TeamId[:8]), // TODO: Get actual team name
-				Round:       int(currentPick.Round),
-				Pick:        int(currentPick.Pick),
-				OverallPick: int(currentPick.OverallPick),
-				TimePerPick: int(draft.Settings.TimePerPickSec),
-			}
+		}))
+		if err == nil {
+			summary := summaryResp.Msg.Summary
+			response.TotalPicks = int(summary.TotalPicks)
+			response.CompletedPicks = int(summary.PicksMade)
+
+			if currentPick := summary.CurrentPick; currentPick != nil {
+				response.CurrentPick = &CurrentPickInfo{
+					PickID:      currentPick.Id,
+					TeamID:      currentPick.TeamId,
+					TeamName:    fmt.Sprintf("Team %s", currentPick.TeamId[:8]), // TODO: Get actual team name
+					Round:       int(currentPick.Round),
+					Pick:        int(currentPick.Pick),
+					OverallPick: int(currentPick.OverallPick),
+					TimePerPick: int(timePerPickForRound(draft.Settings, currentPick.Round)),
+				}
 
-			// Get next deadline separately for timer information
-			deadlineResp, err := p.draftService.FetchNextDeadline(ctx, connect.NewRequest(&draftv1.FetchNextDeadlineRequest{}))
-			if err == nil && deadlineResp.Msg.NextDeadline != nil && deadlineResp.Msg.NextDeadline.DraftId == draftID.String() {
-				if deadlineResp.Msg.NextDeadline.Deadline != nil {
-					response.CurrentPick.TimeoutAt = deadlineResp.Msg.NextDeadline.Deadline.AsTime()
+				// Get next deadline separately for timer information
+				if timeoutAt, ok := p.fetchTimeoutAt(ctx, draftID); ok {
+					response.CurrentPick.TimeoutAt = timeoutAt
 					// StartedAt would be TimeoutAt minus TimePerPick
-					response.CurrentPick.StartedAt = deadlineResp.Msg.NextDeadline.Deadline.AsTime().Add(-timeDurationFromSeconds(int(draft.Settings.TimePerPickSec)))
+					response.CurrentPick.StartedAt = timeoutAt.Add(-timeDurationFromSeconds(response.CurrentPick.TimePerPick))
 				}
 			}
 		}
@@ -86,18 +94,146 @@ func (p *DraftStateProvider) GetDraftState(ctx context.Context, draftID uuid.UUI
 		// TODO: Get recent picks (last 5-10 picks)
 		// This would require a new method in DraftPickService to fetch recent picks
 		response.RecentPicks = []RecentPickInfo{}
+	}
 
-		// Count completed picks via draft pick service
-		countReq := &draftv1.CountRemainingPicksRequest{
+	return response, nil
+}
+
+// GetDraftSnapshot loads a draft and its full pick board for hydrating the projection. A
+// snapshot loaded moments ago is reused if it reflects at least minSequence, and concurrent
+// loads of the same draft share one round trip to the draft service.
+func (p *DraftStateProvider) GetDraftSnapshot(ctx context.Context, draftID uuid.UUID, minSequence int64) (*DraftSnapshot, error) {
+	return p.snapshots.get(ctx, draftID, minSequence, func(ctx context.Context) (*DraftSnapshot, error) {
+		return p.loadDraftSnapshot(ctx, draftID)
+	})
+}
+
+// loadDraftSnapshot fetches a draft and its pick board from the draft services
+func (p *DraftStateProvider) loadDraftSnapshot(ctx context.Context, draftID uuid.UUID) (*DraftSnapshot, error) {
+	// The draft and its picks are independent reads, so fetch them together
+	var (
+		draftResp *connect.Response[draftv1.GetDraftResponse]
+		picksResp *connect.Response[draftv1.GetDraftPicksByDraftResponse]
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		draftResp, err = p.draftService.GetDraft(gctx, connect.NewRequest(&draftv1.GetDraftRequest{
 			DraftId: draftID.String(),
+		}))
+		if err != nil {
+			return fmt.Errorf("failed to get draft: %w", err)
 		}
-		countResp, err := p.draftPickService.CountRemainingPicks(ctx, connect.NewRequest(countReq))
-		if err == nil {
-			response.CompletedPicks = response.TotalPicks - int(countResp.Msg.RemainingPicks)
+		return nil
+	})
+	g.Go(func() error {
+		// Limit 0 returns every pick on the board
+		var err error
+		picksResp, err = p.draftPickService.GetDraftPicksByDraft(gctx, connect.NewRequest(&draftv1.GetDraftPicksByDraftRequest{
+			DraftId: draftID.String(),
+		}))
+		if err != nil {
+			return fmt.Errorf("failed to get draft picks: %w", err)
 		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
 	}
+	draft := draftResp.Msg.Draft
 
-	return response, nil
+	snapshot := &DraftSnapshot{
+		DraftID:     draftID.String(),
+		LeagueID:    draft.LeagueId,
+		DraftType:   draft.DraftType.String(),
+		Status:      draft.Status.String(),
+		TotalRounds: int(draft.Settings.Rounds),
+		TimePerPick: int(draft.Settings.TimePerPickSec),
+		DraftOrder:  draft.Settings.DraftOrder,
+		// Each read carries the sequence it was taken at; the older of the two is one every
+		// event up to which is reflected in both the draft and its board
+		EventSequence: min(draftResp.Msg.EventSequence, picksResp.Msg.EventSequence),
+	}
+	if auction := draft.Settings.GetAuction(); auction != nil {
+		snapshot.BudgetPerTeam = &auction.BudgetPerTeam
+	}
+	if draft.StartedAt != nil {
+		startedAt := draft.StartedAt.AsTime()
+		snapshot.StartedAt = &startedAt
+	}
+	if draft.CompletedAt != nil {
+		completedAt := draft.CompletedAt.AsTime()
+		snapshot.CompletedAt = &completedAt
+	}
+
+	snapshot.Board = make([]BoardPick, 0, len(picksResp.Msg.Picks))
+	for _, pick := range picksResp.Msg.Picks {
+		bp := BoardPick{
+			PickID:        pick.Id,
+			TeamID:        pick.TeamId,
+			Round:         int(pick.Round),
+			Pick:          int(pick.Pick),
+			OverallPick:   int(pick.OverallPick),
+			PlayerID:      pick.PlayerId,
+			AuctionAmount: pick.AuctionAmount,
+			KeeperPick:    pick.KeeperPick,
+			Forfeited:     pick.Forfeited,
+			Skipped:       pick.SkippedAt != nil,
+		}
+		if pick.PickedAt != nil {
+			pickedAt := pick.PickedAt.AsTime()
+			bp.PickedAt = &pickedAt
+		}
+		snapshot.Board = append(snapshot.Board, bp)
+	}
+	sort.Slice(snapshot.Board, func(i, j int) bool {
+		return snapshot.Board[i].OverallPick < snapshot.Board[j].OverallPick
+	})
+
+	if draft.Status != draftv1.DraftStatus_DRAFT_STATUS_IN_PROGRESS && draft.Status != draftv1.DraftStatus_DRAFT_STATUS_PAUSED {
+		return snapshot, nil
+	}
+
+	// The pick on the clock is the first open slot on the board
+	for _, bp := range snapshot.Board {
+		if bp.PlayerID != "" {
+			continue
+		}
+		snapshot.CurrentPick = &CurrentPickInfo{
+			PickID:      bp.PickID,
+			TeamID:      bp.TeamID,
+			Round:       bp.Round,
+			Pick:        bp.Pick,
+			OverallPick: bp.OverallPick,
+			TimePerPick: int(timePerPickForRound(draft.Settings, int32(bp.Round))),
+		}
+		break
+	}
+
+	// The clock only runs while the draft is in progress
+	if snapshot.CurrentPick != nil && draft.Status == draftv1.DraftStatus_DRAFT_STATUS_IN_PROGRESS {
+		if timeoutAt, ok := p.fetchTimeoutAt(ctx, draftID); ok {
+			snapshot.CurrentPick.TimeoutAt = timeoutAt
+			snapshot.CurrentPick.StartedAt = timeoutAt.Add(-timeDurationFromSeconds(snapshot.CurrentPick.TimePerPick))
+		}
+	}
+
+	return snapshot, nil
+}
+
+// fetchTimeoutAt returns when the draft's current pick times out, expressed on this host's clock.
+// The deadline is set on the database clock, so the time remaining is measured against the
+// database's own time and re-applied locally, cancelling out any skew between the two.
+func (p *DraftStateProvider) fetchTimeoutAt(ctx context.Context, draftID uuid.UUID) (time.Time, bool) {
+	id := draftID.String()
+	resp, err := p.draftService.FetchNextDeadline(ctx, connect.NewRequest(&draftv1.FetchNextDeadlineRequest{
+		DraftId: &id,
+	}))
+	if err != nil || resp.Msg.NextDeadline == nil || resp.Msg.NextDeadline.Deadline == nil || resp.Msg.ServerTime == nil {
+		return time.Time{}, false
+	}
+	remaining := resp.Msg.NextDeadline.Deadline.AsTime().Sub(resp.Msg.ServerTime.AsTime())
+	return time.Now().Add(remaining), true
 }
 
 // GetActiveDrafts retrieves all active drafts
@@ -107,7 +243,290 @@ func (p *DraftStateProvider) GetActiveDrafts(ctx context.Context) ([]DraftSummar
 	return []DraftSummary{}, nil
 }
 
+// GetUserDrafts lists the unfinished drafts in the user's leagues with the user's role, team
+// and whether they are on the clock
+func (p *DraftStateProvider) GetUserDrafts(ctx context.Context, userID uuid.UUID) ([]UserDraftSummary, error) {
+	req := connect.NewRequest(&draftv1.ListDraftsForUserRequest{
+		UserId: userID.String(),
+	})
+	ctx = asUser(ctx, req, userID)
+
+	resp, err := p.draftService.ListDraftsForUser(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drafts for user: %w", err)
+	}
+
+	drafts := make([]UserDraftSummary, 0, len(resp.Msg.Drafts))
+	for _, ud := range resp.Msg.Drafts {
+		draft := ud.Draft
+		summary := UserDraftSummary{
+			DraftID:    draft.Id,
+			LeagueID:   draft.LeagueId,
+			LeagueName: ud.LeagueName,
+			DraftType:  draft.DraftType.String(),
+			Status:     draft.Status.String(),
+			Role:       RoleManager,
+			TeamID:     ud.GetTeamId(),
+			PicksMade:  int(ud.PicksMade),
+			TotalPicks: int(ud.TotalPicks),
+		}
+		if ud.IsCommissioner {
+			summary.Role = RoleCommissioner
+		}
+		if draft.ScheduledAt != nil {
+			scheduledAt := draft.ScheduledAt.AsTime()
+			summary.ScheduledAt = &scheduledAt
+		}
+		if draft.StartedAt != nil {
+			startedAt := draft.StartedAt.AsTime()
+			summary.StartedAt = &startedAt
+		}
+
+		if pick := ud.CurrentPick; pick != nil && draft.Status != draftv1.DraftStatus_DRAFT_STATUS_NOT_STARTED {
+			summary.CurrentPick = &PickSlot{
+				PickID:      pick.Id,
+				TeamID:      pick.TeamId,
+				Round:       int(pick.Round),
+				Pick:        int(pick.Pick),
+				OverallPick: int(pick.OverallPick),
+			}
+			summary.OnTheClock = summary.TeamID != "" && pick.TeamId == summary.TeamID
+		}
+
+		// The clock only runs while the draft is in progress; measure it on the database clock
+		if draft.Status == draftv1.DraftStatus_DRAFT_STATUS_IN_PROGRESS && ud.NextDeadline != nil && resp.Msg.ServerTime != nil {
+			remaining := int(ud.NextDeadline.AsTime().Sub(resp.Msg.ServerTime.AsTime()).Seconds())
+			if remaining < 0 {
+				remaining = 0
+			}
+			summary.TimeRemaining = &remaining
+		}
+
+		drafts = append(drafts, summary)
+	}
+
+	return drafts, nil
+}
+
+// ReportChatMessage stores a report of a chat message through the draft service, acting as the reporter
+func (p *DraftStateProvider) ReportChatMessage(ctx context.Context, draftID, reporterID uuid.UUID, message ChatMessagePayload, reason string) (*ChatReportResult, error) {
+	req := connect.NewRequest(&draftv1.ReportChatMessageRequest{
+		DraftId:        draftID.String(),
+		MessageId:      message.MessageID,
+		ReporterUserId: reporterID.String(),
+		SenderUserId:   message.UserID,
+		MessageText:    message.Text,
+		SentAt:         timestamppb.New(message.SentAt),
+		Reason:         reason,
+	})
+	ctx = asUser(ctx, req, reporterID)
+
+	resp, err := p.draftService.ReportChatMessage(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to report chat message: %w", err)
+	}
+
+	return &ChatReportResult{
+		ReportID:  resp.Msg.ReportId,
+		Duplicate: resp.Msg.Duplicate,
+	}, nil
+}
+
+// StartPauseVote opens a vote to pause the draft through the draft service, acting as the
+// user initiating it
+func (p *DraftStateProvider) StartPauseVote(ctx context.Context, draftID, fantasyTeamID, userID uuid.UUID, reason string) error {
+	req := connect.NewRequest(&draftv1.StartPauseVoteRequest{
+		DraftId:       draftID.String(),
+		FantasyTeamId: fantasyTeamID.String(),
+		Reason:        reason,
+	})
+	ctx = asUser(ctx, req, userID)
+
+	if _, err := p.draftService.StartPauseVote(ctx, req); err != nil {
+		return fmt.Errorf("failed to start pause vote: %w", err)
+	}
+	return nil
+}
+
+// CastPauseVote records a team's ballot in an open pause vote through the draft service,
+// acting as the voting user
+func (p *DraftStateProvider) CastPauseVote(ctx context.Context, draftID, voteID, fantasyTeamID, userID uuid.UUID, inFavor bool) error {
+	req := connect.NewRequest(&draftv1.CastPauseVoteRequest{
+		DraftId:       draftID.String(),
+		VoteId:        voteID.String(),
+		FantasyTeamId: fantasyTeamID.String(),
+		InFavor:       inFavor,
+	})
+	ctx = asUser(ctx, req, userID)
+
+	if _, err := p.draftService.CastPauseVote(ctx, req); err != nil {
+		return fmt.Errorf("failed to cast pause vote: %w", err)
+	}
+	return nil
+}
+
+// ProposePickTrade offers the pick on the clock to another team through the draft pick service,
+// acting as the user making the offer
+func (p *DraftStateProvider) ProposePickTrade(ctx context.Context, draftID, pickID, fromTeamID, toTeamID uuid.UUID, requestedPickID *uuid.UUID, message string, userID uuid.UUID) error {
+	msg := &draftv1.ProposePickTradeRequest{
+		DraftId:    draftID.String(),
+		PickId:     pickID.String(),
+		FromTeamId: fromTeamID.String(),
+		ToTeamId:   toTeamID.String(),
+		Message:    message,
+	}
+	if requestedPickID != nil {
+		id := requestedPickID.String()
+		msg.RequestedPickId = &id
+	}
+	req := connect.NewRequest(msg)
+	ctx = asUser(ctx, req, userID)
+
+	if _, err := p.draftPickService.ProposePickTrade(ctx, req); err != nil {
+		return fmt.Errorf("failed to propose pick trade: %w", err)
+	}
+	return nil
+}
+
+// RespondToPickTrade accepts, declines or withdraws a pick trade offer through the draft pick
+// service, acting as the responding user
+func (p *DraftStateProvider) RespondToPickTrade(ctx context.Context, draftID, offerID, fantasyTeamID, userID uuid.UUID, accept bool) error {
+	req := connect.NewRequest(&draftv1.RespondToPickTradeRequest{
+		DraftId:       draftID.String(),
+		OfferId:       offerID.String(),
+		FantasyTeamId: fantasyTeamID.String(),
+		Accept:        accept,
+	})
+	ctx = asUser(ctx, req, userID)
+
+	if _, err := p.draftPickService.RespondToPickTrade(ctx, req); err != nil {
+		return fmt.Errorf("failed to respond to pick trade: %w", err)
+	}
+	return nil
+}
+
+// ReactToPick leaves a reaction on a made pick, or takes it back, through the draft pick service,
+// acting as the reacting user
+func (p *DraftStateProvider) ReactToPick(ctx context.Context, draftID, pickID, userID uuid.UUID, reaction string, remove bool) (*PickReactionsPayload, error) {
+	req := connect.NewRequest(&draftv1.ReactToPickRequest{
+		DraftId:  draftID.String(),
+		PickId:   pickID.String(),
+		Reaction: reaction,
+		Remove:   remove,
+	})
+	ctx = asUser(ctx, req, userID)
+
+	resp, err := p.draftPickService.ReactToPick(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to react to pick: %w", err)
+	}
+
+	reactions := resp.Msg.GetReactions()
+	payload := &PickReactionsPayload{
+		PickID: reactions.GetPickId(),
+		Counts: make([]PickReactionCount, len(reactions.GetCounts())),
+	}
+	for i, count := range reactions.GetCounts() {
+		payload.Counts[i] = PickReactionCount{
+			Reaction: count.Reaction,
+			Count:    int(count.Count),
+		}
+	}
+	return payload, nil
+}
+
+// gatewayService is the service name the gateway reports room presence under
+const gatewayService = "gateway"
+
+// ReportRoomPresence reports a user joining or leaving a draft room to the draft service,
+// as the gateway rather than the user, who may have left the room from another replica
+func (p *DraftStateProvider) ReportRoomPresence(ctx context.Context, draftID, userID uuid.UUID, online bool) error {
+	ctx = interceptors.WithServicePrincipal(ctx, gatewayService)
+	if _, err := p.draftService.ReportRoomPresence(ctx, connect.NewRequest(&draftv1.ReportRoomPresenceRequest{
+		DraftId: draftID.String(),
+		UserId:  userID.String(),
+		Online:  online,
+	})); err != nil {
+		return fmt.Errorf("failed to report room presence: %w", err)
+	}
+	return nil
+}
+
+// RecordFrameDelivery records whether a user acknowledged a frame through the draft service,
+// acting as the user
+func (p *DraftStateProvider) RecordFrameDelivery(ctx context.Context, delivery FrameDelivery) error {
+	status := draftv1.FrameDeliveryStatus_FRAME_DELIVERY_STATUS_UNACKED
+	if delivery.Acked {
+		status = draftv1.FrameDeliveryStatus_FRAME_DELIVERY_STATUS_ACKED
+	}
+	msg := &draftv1.RecordFrameDeliveryRequest{
+		DraftId:   delivery.DraftID.String(),
+		EventId:   delivery.EventID.String(),
+		EventType: string(delivery.EventType),
+		UserId:    delivery.UserID.String(),
+		Status:    status,
+		Attempts:  int32(delivery.Attempts),
+		SentAt:    timestamppb.New(delivery.SentAt),
+	}
+	if delivery.PickID != nil {
+		pickID := delivery.PickID.String()
+		msg.PickId = &pickID
+	}
+	if delivery.WatchlistAlertID != nil {
+		alertID := delivery.WatchlistAlertID.String()
+		msg.WatchlistAlertId = &alertID
+	}
+	req := connect.NewRequest(msg)
+	ctx = asUser(ctx, req, delivery.UserID)
+
+	if _, err := p.draftService.RecordFrameDelivery(ctx, req); err != nil {
+		return fmt.Errorf("failed to record frame delivery: %w", err)
+	}
+	return nil
+}
+
+// ExportDraftResults renders the draft's results as "csv" or "json" through the pick service
+func (p *DraftStateProvider) ExportDraftResults(ctx context.Context, draftID uuid.UUID, format string) (*DraftExport, error) {
+	exportFormat := draftv1.ExportFormat_EXPORT_FORMAT_CSV
+	if format == "json" {
+		exportFormat = draftv1.ExportFormat_EXPORT_FORMAT_JSON
+	}
+
+	resp, err := p.draftPickService.ExportDraftResults(ctx, connect.NewRequest(&draftv1.ExportDraftResultsRequest{
+		DraftId: draftID.String(),
+		Format:  exportFormat,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to export draft results: %w", err)
+	}
+
+	return &DraftExport{
+		ContentType: resp.Msg.ContentType,
+		Filename:    resp.Msg.Filename,
+		Data:        resp.Msg.Data,
+	}, nil
+}
+
+// timePerPickForRound returns the pick clock in seconds for a round, applying the
+// draft's per-round timer override when one covers it
+func timePerPickForRound(settings *draftv1.DraftSettings, round int32) int32 {
+	for _, t := range settings.RoundTimers {
+		if round >= t.FromRound && (t.ToRound == 0 || round <= t.ToRound) {
+			return t.TimePerPickSec
+		}
+	}
+	return settings.TimePerPickSec
+}
+
 // timeDurationFromSeconds converts seconds to time.Duration
 func timeDurationFromSeconds(seconds int) time.Duration {
 	return time.Duration(seconds) * time.Second
 }
+
+// asUser makes a call to the draft services act as userID, which must be the user a
+// connection or request was signed in as. They run in process, past the interceptors that
+// read UserIDHeader, so the acting user goes on ctx as well.
+func asUser[T any](ctx context.Context, req *connect.Request[T], userID uuid.UUID) context.Context {
+	req.Header().Set(interceptors.UserIDHeader, userID.String())
+	return interceptors.WithActingUser(ctx, userID)
+}
@@ -0,0 +1,166 @@
+package gateway
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// EventCategory groups event types a client can subscribe to
+type EventCategory string
+
+const (
+	// EventCategoryPicks covers picks being made, started and reassigned
+	EventCategoryPicks EventCategory = "picks"
+	// EventCategoryClock covers pick timer updates, warnings that the pick clock is running out
+	// and notices that the pick is delayed
+	EventCategoryClock EventCategory = "clock"
+	// EventCategoryChat covers chat frames
+	EventCategoryChat EventCategory = "chat"
+	// EventCategoryDraft covers the pre-draft lobby and countdown, the draft starting,
+	// pausing, resuming and completing, and votes to pause it
+	EventCategoryDraft EventCategory = "draft"
+	// EventCategoryNews covers player news and injury designations
+	EventCategoryNews EventCategory = "news"
+	// EventCategoryPresence covers users joining and leaving the room
+	EventCategoryPresence EventCategory = "presence"
+	// EventCategoryAnalytics covers the draft board heatmap, positional scarcity and run alerts
+	EventCategoryAnalytics EventCategory = "analytics"
+	// EventCategoryReactions covers reaction counts on picks
+	EventCategoryReactions EventCategory = "reactions"
+)
+
+// eventCategories maps event types to their category. Types not listed, such as Hello and
+// DraftRoomClosing, are control frames every connection receives.
+var eventCategories = map[EventType]EventCategory{
+	EventTypePickMade:                    EventCategoryPicks,
+	EventTypePickStarted:                 EventCategoryPicks,
+	EventTypePickSlotReassigned:          EventCategoryPicks,
+	EventTypePickSkipped:                 EventCategoryPicks,
+	EventTypePickTradeUpdated:            EventCategoryPicks,
+	EventTypeTeamAbandoned:               EventCategoryPicks,
+	EventTypeTeamRestored:                EventCategoryPicks,
+	EventTypeSlotSelectionUpdated:        EventCategoryPicks,
+	EventTypeAuctionUpdated:              EventCategoryPicks,
+	EventTypeTimerTick:                   EventCategoryClock,
+	EventTypePickClockWarning:            EventCategoryClock,
+	EventTypePickClockExtended:           EventCategoryClock,
+	EventTypeDraftDelayed:                EventCategoryClock,
+	EventTypeChatMessage:                 EventCategoryChat,
+	EventTypeChatRoomMuteChanged:         EventCategoryChat,
+	EventTypeChatListsUpdated:            EventCategoryChat,
+	EventTypeLobbyUpdated:                EventCategoryDraft,
+	EventTypeDraftStartingSoon:           EventCategoryDraft,
+	EventTypeDraftRescheduled:            EventCategoryDraft,
+	EventTypeMaintenanceScheduled:        EventCategoryDraft,
+	EventTypeDraftStarted:                EventCategoryDraft,
+	EventTypeDraftPaused:                 EventCategoryDraft,
+	EventTypeDraftResumed:                EventCategoryDraft,
+	EventTypePauseVoteUpdated:            EventCategoryDraft,
+	EventTypeCommissionerPresenceChanged: EventCategoryDraft,
+	EventTypeDraftCatchUp:                EventCategoryDraft,
+	EventTypeDraftCompleted:              EventCategoryDraft,
+	EventTypeDraftSummary:                EventCategoryDraft,
+	EventTypePlayerNews:                  EventCategoryNews,
+	EventTypePlayerInjuryDesignated:      EventCategoryNews,
+	EventTypePlayerInjuryAlert:           EventCategoryNews,
+	EventTypeWatchlistAlert:              EventCategoryPicks,
+	EventTypePresenceChanged:             EventCategoryPresence,
+	EventTypeDraftAnalyticsUpdated:       EventCategoryAnalytics,
+	EventTypePickReactionsUpdated:        EventCategoryReactions,
+}
+
+// SubscribedPayload confirms the categories a connection now receives. An empty list means
+// every event.
+type SubscribedPayload struct {
+	Categories []EventCategory `json:"categories"`
+}
+
+type subscriptionUpdate struct {
+	conn       *Connection
+	categories map[EventCategory]bool
+}
+
+// parseSubscription returns the known categories among those a client asked for, or nil
+// to receive every event when none were given
+func parseSubscription(names []string) map[EventCategory]bool {
+	known := make(map[EventCategory]bool)
+	for _, category := range eventCategories {
+		known[category] = true
+	}
+
+	var categories map[EventCategory]bool
+	for _, name := range names {
+		category := EventCategory(strings.ToLower(strings.TrimSpace(name)))
+		if !known[category] {
+			continue
+		}
+		if categories == nil {
+			categories = make(map[EventCategory]bool)
+		}
+		categories[category] = true
+	}
+	return categories
+}
+
+// wants reports whether a connection's subscription includes an event type
+func (c *Connection) wants(eventType EventType) bool {
+	if c.subscription == nil {
+		return true
+	}
+	category, ok := eventCategories[eventType]
+	return !ok || c.subscription[category]
+}
+
+// Subscribe limits the events broadcast to a connection to the given categories; nil
+// restores every event. The subscription is applied on the broadcast goroutine so it
+// takes effect between events.
+func (cm *ConnectionManager) Subscribe(conn *Connection, categories map[EventCategory]bool) {
+	select {
+	case cm.subscribeCh <- subscriptionUpdate{conn: conn, categories: categories}:
+	default:
+		log.Warn().
+			Str("connection_id", conn.ID).
+			Msg("subscribe channel full, dropping subscription")
+	}
+}
+
+// applySubscription sets a connection's subscription and confirms it to the client
+func (cm *ConnectionManager) applySubscription(conn *Connection, categories map[EventCategory]bool) {
+	cm.mu.RLock()
+	_, registered := cm.draftConnections[conn.DraftID][conn]
+	cm.mu.RUnlock()
+	if !registered {
+		return
+	}
+	conn.subscription = categories
+	cm.sessions.subscribe(conn, categories)
+
+	list := make([]EventCategory, 0, len(categories))
+	for category := range categories {
+		list = append(list, category)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+
+	data, err := json.Marshal(SubscribedPayload{Categories: list})
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", conn.ID).Msg("failed to marshal subscription")
+		return
+	}
+	event, err := json.Marshal(&DraftEvent{
+		ID:        uuid.New().String(),
+		DraftID:   conn.DraftID.String(),
+		Type:      EventTypeSubscribed,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("connection_id", conn.ID).Msg("failed to marshal subscription")
+		return
+	}
+	cm.deliver(conn, 0, event)
+}
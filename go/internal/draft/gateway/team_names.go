@@ -0,0 +1,94 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	teamv1 "github.com/mcdev12/dynasty/go/internal/genproto/team/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/team/v1/teamv1connect"
+	"github.com/mcdev12/dynasty/go/internal/i18n"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/text/language"
+)
+
+// TeamNames names a sport's teams by their code for display in a locale
+type TeamNames interface {
+	// TeamName returns the name of the team with a code in a locale, falling back to its name
+	// without a translation for the locale; false when there's no team with the code
+	TeamName(ctx context.Context, code string, locale language.Tag) (string, bool)
+}
+
+// DefaultTeamNamesTTL is how long a TeamNameCache holds a sport's teams before listing them again
+const DefaultTeamNamesTTL = 15 * time.Minute
+
+// TeamNameCache names a sport's teams from the team service, listing them again once the list it
+// holds is older than its TTL. Teams and their translations change a few times a season at most.
+type TeamNameCache struct {
+	teams   teamv1connect.TeamServiceClient
+	sportID string
+	ttl     time.Duration
+
+	mu       sync.Mutex
+	byCode   map[string]*teamv1.Team
+	loadedAt time.Time
+}
+
+// Verify that TeamNameCache implements TeamNames
+var _ TeamNames = (*TeamNameCache)(nil)
+
+// NewTeamNameCache creates a cache of a sport's team names, listed from the team service
+func NewTeamNameCache(teams teamv1connect.TeamServiceClient, sportID string, ttl time.Duration) *TeamNameCache {
+	return &TeamNameCache{
+		teams:   teams,
+		sportID: sportID,
+		ttl:     ttl,
+	}
+}
+
+// TeamName returns the name of the team with a code in a locale. When the teams can't be listed
+// again the names already held keep being used.
+func (c *TeamNameCache) TeamName(ctx context.Context, code string, locale language.Tag) (string, bool) {
+	if code == "" {
+		return "", false
+	}
+	team, ok := c.team(ctx, code)
+	if !ok {
+		return "", false
+	}
+
+	locales := make([]string, len(team.LocalizedNames))
+	for i, name := range team.LocalizedNames {
+		locales[i] = name.Locale
+	}
+	if best := i18n.Best(locale, locales); best >= 0 {
+		return team.LocalizedNames[best].Name, true
+	}
+	return team.Name, true
+}
+
+// team returns the team with a code, listing the sport's teams first when the list is stale
+func (c *TeamNameCache) team(ctx context.Context, code string) (*teamv1.Team, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.byCode == nil || time.Since(c.loadedAt) > c.ttl {
+		resp, err := c.teams.ListTeamsBySport(ctx, connect.NewRequest(&teamv1.ListTeamsBySportRequest{
+			SportId: c.sportID,
+		}))
+		if err != nil {
+			log.Warn().Err(err).Str("sport_id", c.sportID).Msg("failed to list teams for their names")
+		} else {
+			c.byCode = make(map[string]*teamv1.Team, len(resp.Msg.Teams))
+			for _, team := range resp.Msg.Teams {
+				c.byCode[team.Code] = team
+			}
+		}
+		// Don't list again on every lookup while the team service is failing
+		c.loadedAt = time.Now()
+	}
+
+	team, ok := c.byCode[code]
+	return team, ok
+}
@@ -0,0 +1,113 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// UserDraftsProvider lists the unfinished drafts in a user's leagues
+type UserDraftsProvider interface {
+	GetUserDrafts(ctx context.Context, userID uuid.UUID) ([]UserDraftSummary, error)
+}
+
+// Roles a user can hold in a draft's league
+const (
+	RoleCommissioner = "commissioner"
+	RoleManager      = "manager"
+)
+
+// UserDraftSummary is a draft in one of the caller's leagues, seen from the caller's seat
+type UserDraftSummary struct {
+	DraftID       string     `json:"draft_id"`
+	LeagueID      string     `json:"league_id"`
+	LeagueName    string     `json:"league_name"`
+	DraftType     string     `json:"draft_type"`
+	Status        string     `json:"status"`
+	Role          string     `json:"role"`
+	TeamID        string     `json:"team_id,omitempty"`
+	OnTheClock    bool       `json:"on_the_clock"`
+	CurrentPick   *PickSlot  `json:"current_pick,omitempty"`
+	PicksMade     int        `json:"picks_made"`
+	TotalPicks    int        `json:"total_picks"`
+	TimeRemaining *int       `json:"time_remaining_sec,omitempty"`
+	ScheduledAt   *time.Time `json:"scheduled_at,omitempty"`
+	StartedAt     *time.Time `json:"started_at,omitempty"`
+}
+
+// PickSlot identifies the pick on the clock
+type PickSlot struct {
+	PickID      string `json:"pick_id"`
+	TeamID      string `json:"team_id"`
+	Round       int    `json:"round"`
+	Pick        int    `json:"pick"`
+	OverallPick int    `json:"overall_pick"`
+}
+
+// HandleGetMyDrafts handles GET /api/users/me/drafts for the user whose session access token the
+// request carries
+func (h *StateHandler) HandleGetMyDrafts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID, ok := requireUser(w, r, h.sessions, false)
+	if !ok {
+		return
+	}
+
+	drafts, err := h.userDrafts.GetUserDrafts(r.Context(), userID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", userID.String()).Msg("failed to get user drafts")
+		http.Error(w, "Failed to get drafts", http.StatusInternalServerError)
+		return
+	}
+	sortByUrgency(drafts)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(drafts); err != nil {
+		log.Error().Err(err).Msg("failed to encode user drafts response")
+	}
+}
+
+// sortByUrgency orders drafts by how soon they need the user: drafts where the user is on
+// the clock first, then other live drafts, paused drafts, and finally drafts yet to start.
+// Within a group the least time remaining, or the earliest start, comes first.
+func sortByUrgency(drafts []UserDraftSummary) {
+	group := func(d UserDraftSummary) int {
+		switch {
+		case d.Status == statusInProgress && d.OnTheClock:
+			return 0
+		case d.Status == statusInProgress:
+			return 1
+		case d.Status == statusPaused:
+			return 2
+		default:
+			return 3
+		}
+	}
+
+	sort.SliceStable(drafts, func(i, j int) bool {
+		a, b := drafts[i], drafts[j]
+		if ga, gb := group(a), group(b); ga != gb {
+			return ga < gb
+		}
+		switch {
+		case a.TimeRemaining != nil && b.TimeRemaining != nil:
+			return *a.TimeRemaining < *b.TimeRemaining
+		case a.TimeRemaining != nil || b.TimeRemaining != nil:
+			// A running clock is more urgent than an untimed pick
+			return a.TimeRemaining != nil
+		case a.ScheduledAt != nil && b.ScheduledAt != nil:
+			return a.ScheduledAt.Before(*b.ScheduledAt)
+		default:
+			return a.ScheduledAt != nil
+		}
+	})
+}
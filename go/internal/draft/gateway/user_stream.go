@@ -0,0 +1,251 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// userStreamEventTypes are the draft events relayed on user streams. A dashboard across
+// leagues needs to know when drafts start, stop and finish and whose pick is on the clock,
+// not the play-by-play of each room, so timer ticks, chat, news and analytics stay in the rooms.
+// Watchlist alerts are relayed since they're about players the user follows across drafts.
+var userStreamEventTypes = map[EventType]bool{
+	EventTypePickStarted:        true,
+	EventTypePickMade:           true,
+	EventTypePickSkipped:        true,
+	EventTypePickSlotReassigned: true,
+	EventTypePickClockWarning:   true,
+	EventTypePickClockExtended:  true,
+	EventTypeDraftDelayed:       true,
+	EventTypeDraftStartingSoon:  true,
+	EventTypeDraftRescheduled:   true,
+	EventTypeDraftStarted:       true,
+	EventTypeDraftPaused:        true,
+	EventTypeDraftResumed:       true,
+	EventTypeDraftCompleted:     true,
+	EventTypeWatchlistAlert:     true,
+}
+
+// UserStreamEvent is a frame sent on a user stream: a draft event tagged with the draft and
+// league it comes from and the user's team there
+type UserStreamEvent struct {
+	ID         string    `json:"id"`
+	DraftID    string    `json:"draft_id,omitempty"` // unset on frames about the connection itself
+	LeagueID   string    `json:"league_id,omitempty"`
+	LeagueName string    `json:"league_name,omitempty"`
+	TeamID     string    `json:"team_id,omitempty"` // the user's team, unset for a commissioner without one
+	Type       EventType `json:"type"`
+	Timestamp  time.Time `json:"timestamp"`
+	Sequence   int64     `json:"sequence,omitempty"`
+	// OnTheClock marks PickStarted, PickClockWarning and PickClockExtended frames for the user's team
+	OnTheClock bool            `json:"on_the_clock,omitempty"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// DraftsWatchedPayload is the first frame on a user stream, listing the drafts it relays
+// events of, most urgent first
+type DraftsWatchedPayload struct {
+	ConnectionID string             `json:"connection_id"`
+	Drafts       []UserDraftSummary `json:"drafts"`
+	ServerTime   time.Time          `json:"server_time"`
+}
+
+// UpgradeUserConnection upgrades an HTTP connection to a WebSocket relaying the headline
+// events of every given draft, so a user in many leagues can follow them all on one socket.
+// The connection joins each draft's user stream room rather than the draft room, so it
+// doesn't count as presence there. A connection over the gateway or user cap is turned away
+// with an *AdmissionError before upgrading.
+func (cm *ConnectionManager) UpgradeUserConnection(w http.ResponseWriter, r *http.Request, userID uuid.UUID, drafts []UserDraftSummary, queueTicket string) error {
+	watched := make(map[uuid.UUID]UserDraftSummary, len(drafts))
+	for _, draft := range drafts {
+		draftID, err := uuid.Parse(draft.DraftID)
+		if err != nil {
+			return fmt.Errorf("invalid draft ID %q: %w", draft.DraftID, err)
+		}
+		watched[draftID] = draft
+	}
+
+	// User streams count against the gateway and user caps but aren't in a draft room
+	release, err := cm.admission.admit(uuid.Nil, userID.String(), queueTicket, false)
+	if err != nil {
+		return err
+	}
+
+	conn, err := cm.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		release()
+		log.Error().Err(err).Msg("failed to upgrade WebSocket connection")
+		return fmt.Errorf("failed to upgrade connection: %w", err)
+	}
+
+	connection := &Connection{
+		ID:          uuid.New().String(),
+		UserID:      userID.String(),
+		User:        &userID,
+		Conn:        conn,
+		Send:        make(chan []byte, 256),
+		Manager:     cm,
+		ConnectedAt: time.Now(),
+		LastPing:    time.Now(),
+		Drafts:      watched,
+		release:     release,
+	}
+
+	// Queue the watched frame before the connection is registered so it is always the
+	// first frame the client reads
+	if frame, err := draftsWatchedEvent(connection, drafts); err != nil {
+		log.Error().Err(err).Str("connection_id", connection.ID).Msg("failed to build drafts watched frame")
+	} else {
+		connection.Send <- frame
+	}
+
+	cm.registerUserConnection(connection)
+
+	// Start connection handlers
+	go connection.writePump()
+	go connection.readPump()
+
+	log.Info().
+		Str("connection_id", connection.ID).
+		Stringer("user_id", userID).
+		Int("drafts", len(watched)).
+		Msg("user stream WebSocket connection established")
+
+	return nil
+}
+
+// draftsWatchedEvent renders the frame telling a client which drafts its user stream relays
+func draftsWatchedEvent(c *Connection, drafts []UserDraftSummary) ([]byte, error) {
+	data, err := json.Marshal(DraftsWatchedPayload{
+		ConnectionID: c.ID,
+		Drafts:       drafts,
+		ServerTime:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&UserStreamEvent{
+		ID:        uuid.New().String(),
+		Type:      EventTypeDraftsWatched,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
+
+// registerUserConnection adds a user stream to the user stream room of each draft it relays
+func (cm *ConnectionManager) registerUserConnection(conn *Connection) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	for draftID := range conn.Drafts {
+		if cm.userConnections[draftID] == nil {
+			cm.userConnections[draftID] = make(map[*Connection]bool)
+		}
+		cm.userConnections[draftID][conn] = true
+	}
+
+	log.Debug().
+		Str("connection_id", conn.ID).
+		Int("drafts", len(conn.Drafts)).
+		Msg("user stream registered")
+}
+
+// removeUserConnection drops a user stream from its drafts' rooms and reports whether it was there
+func (cm *ConnectionManager) removeUserConnection(conn *Connection) bool {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	registered := false
+	for draftID := range conn.Drafts {
+		connections := cm.userConnections[draftID]
+		if _, exists := connections[conn]; !exists {
+			continue
+		}
+		registered = true
+		delete(connections, conn)
+		if len(connections) == 0 {
+			delete(cm.userConnections, draftID)
+		}
+	}
+	if !registered {
+		return false
+	}
+	close(conn.Send)
+	conn.release()
+
+	log.Info().
+		Str("connection_id", conn.ID).
+		Str("user_id", conn.UserID).
+		Msg("user stream unregistered")
+	return true
+}
+
+// relayToUserStreams sends a draft event on the user streams relaying the draft, tagged for
+// each stream's user. Events for a single user only reach that user's streams.
+func (cm *ConnectionManager) relayToUserStreams(message BroadcastMessage) {
+	if !userStreamEventTypes[message.Event.Type] {
+		return
+	}
+
+	cm.mu.RLock()
+	targetConnections := make([]*Connection, 0, len(cm.userConnections[message.DraftID]))
+	for conn := range cm.userConnections[message.DraftID] {
+		if message.UserID != "" && conn.UserID != message.UserID {
+			continue
+		}
+		targetConnections = append(targetConnections, conn)
+	}
+	cm.mu.RUnlock()
+	if len(targetConnections) == 0 {
+		return
+	}
+
+	clockTeamID := clockTeam(message.Event)
+	for _, conn := range targetConnections {
+		// Drafts is set before the connection is registered and never changes
+		draft := conn.Drafts[message.DraftID]
+		data, err := json.Marshal(&UserStreamEvent{
+			ID:         message.Event.ID,
+			DraftID:    message.Event.DraftID,
+			LeagueID:   draft.LeagueID,
+			LeagueName: draft.LeagueName,
+			TeamID:     draft.TeamID,
+			Type:       message.Event.Type,
+			Timestamp:  message.Event.Timestamp,
+			Sequence:   message.Event.Sequence,
+			OnTheClock: clockTeamID != "" && clockTeamID == draft.TeamID,
+			Data:       message.Event.Data,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("failed to marshal user stream event")
+			return
+		}
+		cm.deliver(conn, 0, data)
+	}
+
+	log.Debug().
+		Str("event_type", string(message.Event.Type)).
+		Str("draft_id", message.DraftID.String()).
+		Int("connections", len(targetConnections)).
+		Msg("event relayed to user streams")
+}
+
+// clockTeam returns the team on the clock in a PickStarted, PickClockWarning or PickClockExtended
+// event, or "" for any other event
+func clockTeam(event *DraftEvent) string {
+	if event.Type != EventTypePickStarted && event.Type != EventTypePickClockWarning && event.Type != EventTypePickClockExtended {
+		return ""
+	}
+	var payload struct {
+		TeamID string `json:"team_id"`
+	}
+	if err := json.Unmarshal(event.Data, &payload); err != nil {
+		return ""
+	}
+	return payload.TeamID
+}
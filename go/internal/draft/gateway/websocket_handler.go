@@ -1,27 +1,62 @@
 package gateway
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/flags"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/ratelimit"
 	"github.com/rs/zerolog/log"
 )
 
-// WebSocketHandler handles WebSocket upgrade requests for draft connections
+// WebSocketHandler handles WebSocket upgrade requests for draft, live scoring and user stream connections
 type WebSocketHandler struct {
 	connectionManager *ConnectionManager
+	limiter           ratelimit.Limiter
+	matchups          UserMatchupsProvider
+	userDrafts        UserDraftsProvider
+	// leagues and flags gate capabilities by the draft's league; nil flags gate nothing
+	leagues DraftLeagueProvider
+	flags   *flags.Client
+	// sessions checks the access tokens users connect with; nil makes every draft room
+	// connection a spectator's
+	sessions interceptors.SessionAuthenticator
+}
+
+// DraftLeagueProvider looks up the league a draft belongs to
+type DraftLeagueProvider interface {
+	DraftLeague(ctx context.Context, draftID uuid.UUID) (uuid.UUID, error)
 }
 
 // NewWebSocketHandler creates a new WebSocket handler
-func NewWebSocketHandler(cm *ConnectionManager) *WebSocketHandler {
+func NewWebSocketHandler(cm *ConnectionManager, limiter ratelimit.Limiter, matchups UserMatchupsProvider, userDrafts UserDraftsProvider, leagues DraftLeagueProvider, featureFlags *flags.Client, sessions interceptors.SessionAuthenticator) *WebSocketHandler {
 	return &WebSocketHandler{
 		connectionManager: cm,
+		limiter:           limiter,
+		matchups:          matchups,
+		userDrafts:        userDrafts,
+		leagues:           leagues,
+		flags:             featureFlags,
+		sessions:          sessions,
 	}
 }
 
 // HandleDraftConnection handles WebSocket connections for a specific draft
 func (h *WebSocketHandler) HandleDraftConnection(w http.ResponseWriter, r *http.Request) {
+	// Turn away clients stuck in a reconnect loop before doing any work for them
+	if !h.allowConnect(w, r) {
+		return
+	}
+
 	// Extract draft ID from URL path or query parameter
 	draftIDStr := r.URL.Query().Get("draft_id")
 	if draftIDStr == "" {
@@ -35,20 +70,56 @@ func (h *WebSocketHandler) HandleDraftConnection(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// Extract user ID from query parameter or header
-	// In production, this would come from JWT token or session
-	userID := r.URL.Query().Get("user_id")
-	if userID == "" {
-		// For development, allow anonymous connections
-		userID = "anonymous"
+	// Connections without an access token watch the room as spectators
+	var user *uuid.UUID
+	userID, signedIn, err := authenticateUser(r, h.sessions, true)
+	if err != nil {
+		writeAuthError(w, err)
+		return
+	}
+	if signedIn {
+		user = &userID
+	}
+
+	// Settle the protocol version and capabilities before upgrading so a client asking
+	// for a version that can't be served gets a plain HTTP error it can act on
+	protocol, err := NegotiateProtocol(r.URL.Query().Get("protocol_version"), r.URL.Query().Get("capabilities"))
+	if err != nil {
+		if errors.Is(err, ErrUnsupportedProtocolVersion) {
+			w.Header().Set("X-Min-Protocol-Version", strconv.Itoa(MinProtocolVersion))
+			w.Header().Set("X-Protocol-Version", strconv.Itoa(CurrentProtocolVersion))
+			http.Error(w, err.Error(), http.StatusUpgradeRequired)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.flagCapabilities(r.Context(), draftID, protocol)
+
+	fence, err := parseEventFence(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	// Upgrade the connection
-	if err := h.connectionManager.UpgradeConnection(w, r, userID, draftID); err != nil {
+	// Upgrade the connection, resuming the session a reconnecting client passes the token of
+	sessionToken := r.URL.Query().Get("session_token")
+	queueTicket := r.URL.Query().Get("queue_ticket")
+	if err := h.connectionManager.UpgradeConnection(w, r, user, draftID, protocol, fence, sessionToken, queueTicket); err != nil {
+		if errors.Is(err, ErrDraftClosed) {
+			http.Error(w, "draft has completed", http.StatusGone)
+			return
+		}
+		var admissionErr *AdmissionError
+		if errors.As(err, &admissionErr) {
+			writeAdmissionError(w, admissionErr)
+			return
+		}
 		log.Error().
 			Err(err).
 			Str("draft_id", draftID.String()).
-			Str("user_id", userID).
+			Bool("signed_in", signedIn).
+			Stringer("user_id", userID).
 			Msg("failed to upgrade WebSocket connection")
 		http.Error(w, "failed to upgrade connection", http.StatusInternalServerError)
 		return
@@ -57,22 +128,246 @@ func (h *WebSocketHandler) HandleDraftConnection(w http.ResponseWriter, r *http.
 	// Connection is now handled by the connection manager
 }
 
-// HandleConnectionStats returns statistics about active connections
+// flagCapabilities drops the agreed capabilities whose feature flag is off for the draft's
+// league. When the league can't be looked up the capabilities are left as agreed.
+func (h *WebSocketHandler) flagCapabilities(ctx context.Context, draftID uuid.UUID, protocol Protocol) {
+	if h.flags == nil || len(protocol.Capabilities) == 0 {
+		return
+	}
+
+	leagueID, err := h.leagues.DraftLeague(ctx, draftID)
+	if err != nil {
+		log.Warn().Err(err).Str("draft_id", draftID.String()).Msg("failed to look up draft league for capability flags")
+		return
+	}
+	for c := range protocol.Capabilities {
+		if !h.flags.EnabledOr(CapabilityFlag(c), leagueID, true) {
+			delete(protocol.Capabilities, c)
+		}
+	}
+}
+
+// HandleMatchupConnection handles WebSocket connections streaming live scores for the
+// matchups of the user whose session access token the handshake carries. The optional week and league_id query parameters narrow the matchups watched.
+func (h *WebSocketHandler) HandleMatchupConnection(w http.ResponseWriter, r *http.Request) {
+	if !h.allowConnect(w, r) {
+		return
+	}
+
+	userID, ok := requireUser(w, r, h.sessions, true)
+	if !ok {
+		return
+	}
+
+	var week *int
+	if v := r.URL.Query().Get("week"); v != "" {
+		weekNumber, err := strconv.Atoi(v)
+		if err != nil || weekNumber < 1 {
+			http.Error(w, fmt.Sprintf("invalid week %q", v), http.StatusBadRequest)
+			return
+		}
+		week = &weekNumber
+	}
+	leagueID := r.URL.Query().Get("league_id")
+	if leagueID != "" {
+		if _, err := uuid.Parse(leagueID); err != nil {
+			http.Error(w, "invalid league_id format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	matchups, err := h.matchups.GetUserMatchups(r.Context(), userID, week)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", userID.String()).Msg("failed to get user matchups")
+		http.Error(w, "Failed to get matchups", http.StatusInternalServerError)
+		return
+	}
+	if leagueID != "" {
+		inLeague := matchups[:0]
+		for _, matchup := range matchups {
+			if matchup.LeagueID == leagueID {
+				inLeague = append(inLeague, matchup)
+			}
+		}
+		matchups = inLeague
+	}
+	if len(matchups) == 0 {
+		http.Error(w, "no matchups to watch", http.StatusNotFound)
+		return
+	}
+
+	if err := h.connectionManager.UpgradeMatchupConnection(w, r, userID, matchups, r.URL.Query().Get("queue_ticket")); err != nil {
+		var admissionErr *AdmissionError
+		if errors.As(err, &admissionErr) {
+			writeAdmissionError(w, admissionErr)
+			return
+		}
+		log.Error().
+			Err(err).
+			Str("user_id", userID.String()).
+			Msg("failed to upgrade matchup WebSocket connection")
+		http.Error(w, "failed to upgrade connection", http.StatusInternalServerError)
+		return
+	}
+}
+
+// HandleUserConnection handles WebSocket connections relaying the headline events of every
+// unfinished draft in the leagues of the user whose session access token the handshake
+// carries, each frame tagged with its draft. The drafts are settled when the user connects,
+// so a draft scheduled later is picked up on the next connection.
+func (h *WebSocketHandler) HandleUserConnection(w http.ResponseWriter, r *http.Request) {
+	if !h.allowConnect(w, r) {
+		return
+	}
+
+	userID, ok := requireUser(w, r, h.sessions, true)
+	if !ok {
+		return
+	}
+
+	drafts, err := h.userDrafts.GetUserDrafts(r.Context(), userID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", userID.String()).Msg("failed to get user drafts")
+		http.Error(w, "Failed to get drafts", http.StatusInternalServerError)
+		return
+	}
+	// Drafts that completed since the list was last refreshed won't send anything more
+	open := drafts[:0]
+	for _, draft := range drafts {
+		draftID, err := uuid.Parse(draft.DraftID)
+		if err == nil && h.connectionManager.IsDraftClosed(draftID) {
+			continue
+		}
+		open = append(open, draft)
+	}
+	drafts = open
+	if len(drafts) == 0 {
+		http.Error(w, "no drafts to watch", http.StatusNotFound)
+		return
+	}
+	sortByUrgency(drafts)
+
+	if err := h.connectionManager.UpgradeUserConnection(w, r, userID, drafts, r.URL.Query().Get("queue_ticket")); err != nil {
+		var admissionErr *AdmissionError
+		if errors.As(err, &admissionErr) {
+			writeAdmissionError(w, admissionErr)
+			return
+		}
+		log.Error().
+			Err(err).
+			Str("user_id", userID.String()).
+			Msg("failed to upgrade user stream WebSocket connection")
+		http.Error(w, "failed to upgrade connection", http.StatusInternalServerError)
+		return
+	}
+}
+
+// allowConnect counts a connection attempt against the client IP's rate limit, answering
+// 429 with Retry-After when it is over. Should the limiter fail, the attempt is let through.
+func (h *WebSocketHandler) allowConnect(w http.ResponseWriter, r *http.Request) bool {
+	rule := h.connectionManager.config.ConnectRateLimit
+	if rule.Limit <= 0 {
+		return true
+	}
+
+	ip := clientIP(r)
+	allowed, retryAfter, err := h.limiter.Allow(r.Context(), "ws-connect|"+ip, rule)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check connection rate limit")
+		return true
+	}
+	if !allowed {
+		log.Warn().Str("client_ip", ip).Msg("connection rate limit exceeded")
+		seconds := int(math.Ceil(retryAfter.Seconds()))
+		w.Header().Set("Retry-After", strconv.Itoa(max(seconds, 1)))
+		http.Error(w, "too many connection attempts", http.StatusTooManyRequests)
+		return false
+	}
+	return true
+}
+
+// writeAdmissionError answers a connection turned away by a connection cap: 503 with
+// Retry-After while it's queued on a full gateway or room, 429 when the user has too many
+// connections open. The body is the AdmissionError, carrying the client's queue position
+// and the ticket to retry with.
+func writeAdmissionError(w http.ResponseWriter, admissionErr *AdmissionError) {
+	status := http.StatusServiceUnavailable
+	if admissionErr.Code == AdmissionTooManyConnections {
+		status = http.StatusTooManyRequests
+	}
+	if admissionErr.RetryAfterSec > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(admissionErr.RetryAfterSec))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(admissionErr); err != nil {
+		log.Error().Err(err).Msg("failed to encode admission error")
+	}
+}
+
+// clientIP returns the address a request came from: the first X-Forwarded-For entry when
+// behind a proxy, else the peer address
+func clientIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		first, _, _ := strings.Cut(forwarded, ",")
+		return strings.TrimSpace(first)
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// parseEventFence reads where event delivery starts from the snapshot_seq and hold_events
+// query parameters. Clients that loaded state first pass its event_sequence as snapshot_seq;
+// clients that connect first pass hold_events=true and send SnapshotLoaded once state is loaded.
+func parseEventFence(r *http.Request) (EventFence, error) {
+	var fence EventFence
+	if v := r.URL.Query().Get("snapshot_seq"); v != "" {
+		seq, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || seq < 0 {
+			return EventFence{}, fmt.Errorf("invalid snapshot_seq %q", v)
+		}
+		fence.SnapshotSequence = seq
+	}
+	if v := r.URL.Query().Get("hold_events"); v != "" {
+		hold, err := strconv.ParseBool(v)
+		if err != nil {
+			return EventFence{}, fmt.Errorf("invalid hold_events %q", v)
+		}
+		fence.Hold = hold
+	}
+	return fence, nil
+}
+
+// ConnectionStatsResponse is the body of /ws/stats
+type ConnectionStatsResponse struct {
+	TotalConnections int          `json:"total_connections"`
+	ActiveDrafts     int          `json:"active_drafts"`
+	Traffic          TrafficStats `json:"traffic"`
+}
+
+// HandleConnectionStats returns statistics about active connections, and the bandwidth and
+// quality of their traffic
 func (h *WebSocketHandler) HandleConnectionStats(w http.ResponseWriter, r *http.Request) {
 	stats := h.connectionManager.GetConnectionStats()
-	
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	
-	// Simple JSON response
-	w.Write([]byte("{"))
-	w.Write([]byte("\"total_connections\":" + strconv.Itoa(stats["total_connections"].(int)) + ","))
-	w.Write([]byte("\"active_drafts\":" + strconv.Itoa(stats["active_drafts"].(int))))
-	w.Write([]byte("}"))
+	if err := json.NewEncoder(w).Encode(ConnectionStatsResponse{
+		TotalConnections: stats["total_connections"].(int),
+		ActiveDrafts:     stats["active_drafts"].(int),
+		Traffic:          stats["traffic"].(TrafficStats),
+	}); err != nil {
+		log.Error().Err(err).Msg("failed to encode connection stats response")
+	}
 }
 
 // RegisterRoutes registers WebSocket routes with an HTTP mux
 func (h *WebSocketHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/ws/draft", h.HandleDraftConnection)
+	mux.HandleFunc("/ws/matchups", h.HandleMatchupConnection)
+	mux.HandleFunc("/ws/me", h.HandleUserConnection)
 	mux.HandleFunc("/ws/stats", h.HandleConnectionStats)
 }
\ No newline at end of file
@@ -0,0 +1,254 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// HistoryRepository defines what the historical draft app layer needs from the repository
+type HistoryRepository interface {
+	GetLeague(ctx context.Context, leagueID uuid.UUID) (*League, error)
+	ListLeagueTeamIDs(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error)
+	ListKnownPlayerIDs(ctx context.Context, playerIDs []uuid.UUID) ([]uuid.UUID, error)
+	ResolveExternalPlayerIDs(ctx context.Context, provider string, externalIDs []string) (map[string]uuid.UUID, error)
+	HasHistoricalDraft(ctx context.Context, leagueID uuid.UUID, season string, draftType models.DraftType) (bool, error)
+	CreateHistoricalDraft(ctx context.Context, draft ImportedDraft) (*models.HistoricalDraft, error)
+	ListHistoricalDrafts(ctx context.Context, leagueID uuid.UUID) ([]models.HistoricalDraft, error)
+}
+
+// App handles historical draft business logic
+type App struct {
+	repo HistoryRepository
+}
+
+// NewApp creates a new historical draft App
+func NewApp(repo HistoryRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// ImportHistoricalDraft imports a draft a league held before it moved to the platform as a
+// completed draft with its picks. Nothing is announced and rosters are left alone: the draft
+// happened seasons ago, and the league's rosters were brought over as they stand now.
+// Commissioner only; run it in a transaction.
+func (a *App) ImportHistoricalDraft(ctx context.Context, req ImportHistoricalDraftRequest) (*models.HistoricalDraft, error) {
+	league, err := a.repo.GetLeague(ctx, req.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	if req.ImportedBy != nil && *req.ImportedBy != league.CommissionerID {
+		return nil, ErrNotCommissioner
+	}
+
+	switch req.DraftType {
+	case models.DraftTypeSnake, models.DraftTypeAuction, models.DraftTypeRookie:
+	default:
+		return nil, ErrUnsupportedDraftType
+	}
+	if err := checkSeason(req.Season, league.Season); err != nil {
+		return nil, err
+	}
+	if req.CompletedAt.After(time.Now()) || (req.StartedAt != nil && req.StartedAt.After(req.CompletedAt)) {
+		return nil, ErrInvalidDraftTimes
+	}
+
+	imported, err := a.repo.HasHistoricalDraft(ctx, league.ID, req.Season, req.DraftType)
+	if err != nil {
+		return nil, err
+	}
+	if imported {
+		return nil, ErrAlreadyImported
+	}
+
+	leagueTeams, err := a.repo.ListLeagueTeamIDs(ctx, league.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBoard(req.Picks, leagueTeams); err != nil {
+		return nil, err
+	}
+	players, err := a.resolvePlayers(ctx, req.Picks)
+	if err != nil {
+		return nil, err
+	}
+
+	picks := make([]ImportedPick, len(req.Picks))
+	drafted := make(map[uuid.UUID]bool, len(req.Picks))
+	for i, pick := range req.Picks {
+		if drafted[players[i]] {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatePlayer, players[i])
+		}
+		drafted[players[i]] = true
+		picks[i] = ImportedPick{
+			Round:         pick.Round,
+			Pick:          pick.Pick,
+			OverallPick:   pick.OverallPick,
+			TeamID:        pick.TeamID,
+			PlayerID:      players[i],
+			PickedAt:      pick.PickedAt,
+			AuctionAmount: pick.AuctionAmount,
+			KeeperPick:    pick.KeeperPick,
+		}
+	}
+
+	historical, err := a.repo.CreateHistoricalDraft(ctx, ImportedDraft{
+		LeagueID:    league.ID,
+		Season:      req.Season,
+		DraftType:   req.DraftType,
+		Source:      req.Source,
+		Settings:    importedSettings(req.Picks),
+		StartedAt:   req.StartedAt,
+		CompletedAt: req.CompletedAt,
+		Picks:       picks,
+		ImportedBy:  req.ImportedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Imported %s %s draft %s for league %s with %d picks", req.Season, req.DraftType, historical.DraftID, league.ID, len(picks))
+	return historical, nil
+}
+
+// ListHistoricalDrafts lists a league's imported drafts, latest season first
+func (a *App) ListHistoricalDrafts(ctx context.Context, leagueID uuid.UUID) ([]models.HistoricalDraft, error) {
+	if _, err := a.repo.GetLeague(ctx, leagueID); err != nil {
+		return nil, err
+	}
+	return a.repo.ListHistoricalDrafts(ctx, leagueID)
+}
+
+// resolvePlayers returns the player each pick took, looking players given by a provider's ID up
+// one provider at a time
+func (a *App) resolvePlayers(ctx context.Context, picks []HistoricalPick) ([]uuid.UUID, error) {
+	var playerIDs []uuid.UUID
+	externalIDs := make(map[string][]string)
+	for _, pick := range picks {
+		switch {
+		case pick.PlayerID != nil:
+			playerIDs = append(playerIDs, *pick.PlayerID)
+		case pick.Provider != "" && pick.ExternalID != "":
+			externalIDs[pick.Provider] = append(externalIDs[pick.Provider], pick.ExternalID)
+		default:
+			return nil, fmt.Errorf("%w: pick %d", ErrNoPlayer, pick.OverallPick)
+		}
+	}
+
+	known := make(map[uuid.UUID]bool, len(playerIDs))
+	if len(playerIDs) > 0 {
+		found, err := a.repo.ListKnownPlayerIDs(ctx, playerIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range found {
+			known[id] = true
+		}
+	}
+	external := make(map[string]map[string]uuid.UUID, len(externalIDs))
+	for provider, ids := range externalIDs {
+		resolved, err := a.repo.ResolveExternalPlayerIDs(ctx, provider, ids)
+		if err != nil {
+			return nil, err
+		}
+		external[provider] = resolved
+	}
+
+	players := make([]uuid.UUID, len(picks))
+	for i, pick := range picks {
+		if pick.PlayerID != nil {
+			if !known[*pick.PlayerID] {
+				return nil, fmt.Errorf("%w: %s in pick %d", ErrPlayerNotFound, *pick.PlayerID, pick.OverallPick)
+			}
+			players[i] = *pick.PlayerID
+			continue
+		}
+		playerID, ok := external[pick.Provider][pick.ExternalID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s ID %s in pick %d", ErrPlayerNotFound, pick.Provider, pick.ExternalID, pick.OverallPick)
+		}
+		players[i] = playerID
+	}
+	return players, nil
+}
+
+// checkSeason checks that a draft's season starts with its year and comes before the league's
+// current season
+func checkSeason(season, leagueSeason string) error {
+	year, ok := seasonYear(season)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrInvalidSeason, season)
+	}
+	if leagueYear, ok := seasonYear(leagueSeason); ok && year >= leagueYear {
+		return fmt.Errorf("%w: %s is not before %s", ErrSeasonNotPast, season, leagueSeason)
+	}
+	return nil
+}
+
+// seasonYear returns the year a season starts with, such as 2023 for "2023" or "2023-24"
+func seasonYear(season string) (string, bool) {
+	if len(season) < 4 {
+		return "", false
+	}
+	for _, r := range season[:4] {
+		if !unicode.IsDigit(r) {
+			return "", false
+		}
+	}
+	return season[:4], true
+}
+
+// checkBoard checks that every pick goes to a team of the league and has a place on the board
+// of its own
+func checkBoard(picks []HistoricalPick, leagueTeams []uuid.UUID) error {
+	inLeague := make(map[uuid.UUID]bool, len(leagueTeams))
+	for _, teamID := range leagueTeams {
+		inLeague[teamID] = true
+	}
+
+	type slot struct{ round, pick int }
+	overall := make(map[int]bool, len(picks))
+	slots := make(map[slot]bool, len(picks))
+	for _, pick := range picks {
+		if !inLeague[pick.TeamID] {
+			return fmt.Errorf("%w: %s", ErrTeamNotInLeague, pick.TeamID)
+		}
+		s := slot{pick.Round, pick.Pick}
+		if overall[pick.OverallPick] || slots[s] {
+			return fmt.Errorf("%w: round %d pick %d (overall %d)", ErrDuplicatePick, pick.Round, pick.Pick, pick.OverallPick)
+		}
+		overall[pick.OverallPick] = true
+		slots[s] = true
+	}
+	return nil
+}
+
+// importedSettings works out the settings of an imported draft from its board: as many rounds
+// as it had, and its first round order. Nothing else about how it ran is known.
+func importedSettings(picks []HistoricalPick) models.DraftSettings {
+	var settings models.DraftSettings
+	var firstRound []HistoricalPick
+	for _, pick := range picks {
+		settings.Rounds = max(settings.Rounds, pick.Round)
+		if pick.Round == 1 {
+			firstRound = append(firstRound, pick)
+		}
+	}
+	sort.Slice(firstRound, func(i, j int) bool {
+		return firstRound[i].Pick < firstRound[j].Pick
+	})
+	for _, pick := range firstRound {
+		// A team holding another's traded first is in the order once
+		if !slices.Contains(settings.DraftOrder, pick.TeamID) {
+			settings.DraftOrder = append(settings.DraftOrder, pick.TeamID)
+		}
+	}
+	return settings
+}
@@ -0,0 +1,69 @@
+-- name: GetHistoryLeague :one
+-- A league with its current season and commissioner.
+SELECT id, season, commissioner_id
+FROM leagues
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: ListLeagueTeamIDs :many
+SELECT id FROM fantasy_teams
+WHERE league_id = $1;
+
+-- name: ListKnownPlayerIDs :many
+-- The given player IDs that belong to a player.
+SELECT id FROM players
+WHERE id = ANY(@player_ids::uuid[]);
+
+-- name: ResolveExternalPlayerIDs :many
+-- The players a provider's IDs refer to, for those that are known.
+SELECT external_id, player_id
+FROM external_player_ids
+WHERE provider = @provider
+  AND external_id = ANY(@external_ids::text[]);
+
+-- name: HasHistoricalDraft :one
+-- Whether a league's draft of a type has already been imported for a season.
+SELECT EXISTS (SELECT 1
+               FROM historical_drafts
+               WHERE league_id = $1
+                 AND season = $2
+                 AND draft_type = $3) AS imported;
+
+-- name: EnsurePastLeagueSeason :one
+-- The season of a league an imported draft was held in, recorded as completed when the league
+-- has no season that year yet.
+INSERT INTO league_seasons (league_id, year, status, started_at)
+VALUES ($1, $2, 'COMPLETED', $3)
+ON CONFLICT (league_id, year) DO UPDATE SET year = EXCLUDED.year
+RETURNING id;
+
+-- name: InsertImportedDraft :one
+-- Imported drafts are stored completed, as they were held.
+INSERT INTO draft (league_id, draft_type, status, settings, started_at, completed_at, season_id)
+VALUES ($1, $2, 'COMPLETED', $3, $4, $5, $6)
+RETURNING id;
+
+-- name: InsertImportedDraftPick :exec
+INSERT INTO draft_picks (draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: CreateHistoricalDraft :one
+INSERT INTO historical_drafts (draft_id, league_id, season, draft_type, source, imported_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListHistoricalDrafts :many
+-- A league's imported drafts with how many picks each holds, latest season first.
+SELECT hd.draft_id,
+       hd.league_id,
+       hd.season,
+       hd.draft_type,
+       hd.source,
+       hd.imported_by,
+       hd.imported_at,
+       COUNT(dp.id) AS picks
+FROM historical_drafts hd
+LEFT JOIN draft_picks dp ON dp.draft_id = hd.draft_id
+WHERE hd.league_id = $1
+GROUP BY hd.draft_id
+ORDER BY hd.season DESC, hd.draft_type;
@@ -0,0 +1,194 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/history/db"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// Repository implements historical draft data access operations
+type Repository struct {
+	queries *db.Queries
+}
+
+// NewRepository creates a new historical draft repository
+func NewRepository(queries *db.Queries) *Repository {
+	return &Repository{
+		queries: queries,
+	}
+}
+
+// q returns the repository's queries, bound to the transaction in ctx if a service started one
+func (r *Repository) q(ctx context.Context) *db.Queries {
+	return sqlutil.Bind(ctx, r.queries, r.queries.WithTx)
+}
+
+// GetLeague retrieves a league with its current season and commissioner
+func (r *Repository) GetLeague(ctx context.Context, leagueID uuid.UUID) (*League, error) {
+	row, err := r.q(ctx).GetHistoryLeague(ctx, leagueID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrLeagueNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league: %w", err)
+	}
+	return &League{
+		ID:             row.ID,
+		Season:         row.Season,
+		CommissionerID: row.CommissionerID,
+	}, nil
+}
+
+// ListLeagueTeamIDs lists the teams of a league
+func (r *Repository) ListLeagueTeamIDs(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error) {
+	teamIDs, err := r.q(ctx).ListLeagueTeamIDs(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list league teams: %w", err)
+	}
+	return teamIDs, nil
+}
+
+// ListKnownPlayerIDs returns which of the given player IDs belong to a player
+func (r *Repository) ListKnownPlayerIDs(ctx context.Context, playerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	known, err := r.q(ctx).ListKnownPlayerIDs(ctx, playerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list known players: %w", err)
+	}
+	return known, nil
+}
+
+// ResolveExternalPlayerIDs maps a provider's IDs to the players they refer to, leaving out the
+// ones that aren't known
+func (r *Repository) ResolveExternalPlayerIDs(ctx context.Context, provider string, externalIDs []string) (map[string]uuid.UUID, error) {
+	rows, err := r.q(ctx).ResolveExternalPlayerIDs(ctx, db.ResolveExternalPlayerIDsParams{
+		Provider:    provider,
+		ExternalIds: externalIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s player IDs: %w", provider, err)
+	}
+
+	players := make(map[string]uuid.UUID, len(rows))
+	for _, row := range rows {
+		players[row.ExternalID] = row.PlayerID
+	}
+	return players, nil
+}
+
+// HasHistoricalDraft reports whether a league's draft of a type has already been imported for a
+// season
+func (r *Repository) HasHistoricalDraft(ctx context.Context, leagueID uuid.UUID, season string, draftType models.DraftType) (bool, error) {
+	imported, err := r.q(ctx).HasHistoricalDraft(ctx, db.HasHistoricalDraftParams{
+		LeagueID:  leagueID,
+		Season:    season,
+		DraftType: db.DraftType(draftType),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check for imported draft: %w", err)
+	}
+	return imported, nil
+}
+
+// CreateHistoricalDraft stores an imported draft as a completed draft with its picks, and
+// records where it came from. Run it in a transaction so a draft is never left half imported.
+func (r *Repository) CreateHistoricalDraft(ctx context.Context, draft ImportedDraft) (*models.HistoricalDraft, error) {
+	settings, err := json.Marshal(draft.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal draft settings: %w", err)
+	}
+
+	q := r.q(ctx)
+	seasonID, err := q.EnsurePastLeagueSeason(ctx, db.EnsurePastLeagueSeasonParams{
+		LeagueID:  draft.LeagueID,
+		Year:      draft.Season,
+		StartedAt: draft.CompletedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record draft season: %w", err)
+	}
+
+	draftID, err := q.InsertImportedDraft(ctx, db.InsertImportedDraftParams{
+		LeagueID:    draft.LeagueID,
+		DraftType:   db.DraftType(draft.DraftType),
+		Settings:    settings,
+		StartedAt:   sqlutil.ToSqlTime(draft.StartedAt),
+		CompletedAt: sqlutil.ToSqlTime(&draft.CompletedAt),
+		SeasonID:    uuid.NullUUID{UUID: seasonID, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create draft: %w", err)
+	}
+
+	for _, pick := range draft.Picks {
+		var auctionAmount sql.NullString
+		if pick.AuctionAmount != nil {
+			auctionAmount = sql.NullString{String: fmt.Sprintf("%.2f", *pick.AuctionAmount), Valid: true}
+		}
+		if err := q.InsertImportedDraftPick(ctx, db.InsertImportedDraftPickParams{
+			DraftID:       draftID,
+			Round:         int32(pick.Round),
+			Pick:          int32(pick.Pick),
+			OverallPick:   int32(pick.OverallPick),
+			TeamID:        pick.TeamID,
+			PlayerID:      uuid.NullUUID{UUID: pick.PlayerID, Valid: true},
+			PickedAt:      sqlutil.ToSqlTime(pick.PickedAt),
+			AuctionAmount: auctionAmount,
+			KeeperPick:    sql.NullBool{Bool: pick.KeeperPick, Valid: true},
+		}); err != nil {
+			return nil, fmt.Errorf("failed to create pick %d: %w", pick.OverallPick, err)
+		}
+	}
+
+	row, err := q.CreateHistoricalDraft(ctx, db.CreateHistoricalDraftParams{
+		DraftID:    draftID,
+		LeagueID:   draft.LeagueID,
+		Season:     draft.Season,
+		DraftType:  db.DraftType(draft.DraftType),
+		Source:     sqlutil.ToSqlString(draft.Source),
+		ImportedBy: sqlutil.ToNullUUID(draft.ImportedBy),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record historical draft: %w", err)
+	}
+
+	return &models.HistoricalDraft{
+		DraftID:    row.DraftID,
+		LeagueID:   row.LeagueID,
+		Season:     row.Season,
+		DraftType:  models.DraftType(row.DraftType),
+		Source:     sqlutil.FromSqlStringPtr(row.Source),
+		ImportedBy: sqlutil.FromNullUUID(row.ImportedBy),
+		ImportedAt: row.ImportedAt,
+		Picks:      len(draft.Picks),
+	}, nil
+}
+
+// ListHistoricalDrafts lists a league's imported drafts, latest season first
+func (r *Repository) ListHistoricalDrafts(ctx context.Context, leagueID uuid.UUID) ([]models.HistoricalDraft, error) {
+	rows, err := r.q(ctx).ListHistoricalDrafts(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list historical drafts: %w", err)
+	}
+
+	drafts := make([]models.HistoricalDraft, len(rows))
+	for i, row := range rows {
+		drafts[i] = models.HistoricalDraft{
+			DraftID:    row.DraftID,
+			LeagueID:   row.LeagueID,
+			Season:     row.Season,
+			DraftType:  models.DraftType(row.DraftType),
+			Source:     sqlutil.FromSqlStringPtr(row.Source),
+			ImportedBy: sqlutil.FromNullUUID(row.ImportedBy),
+			ImportedAt: row.ImportedAt,
+			Picks:      int(row.Picks),
+		}
+	}
+	return drafts, nil
+}
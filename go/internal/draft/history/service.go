@@ -0,0 +1,194 @@
+package history
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// HistoryApp defines what the service layer needs from the historical draft application
+type HistoryApp interface {
+	ImportHistoricalDraft(ctx context.Context, req ImportHistoricalDraftRequest) (*models.HistoricalDraft, error)
+	ListHistoricalDrafts(ctx context.Context, leagueID uuid.UUID) ([]models.HistoricalDraft, error)
+}
+
+// Service implements the DraftHistoryService gRPC interface. Imported drafts are written
+// straight to the draft tables rather than run through the draft services, since none of the
+// events those record apply to a draft held seasons ago. Requests without a signed in user are
+// trusted callers.
+type Service struct {
+	app HistoryApp
+	tx  sqlutil.Transactor
+}
+
+// NewService creates a new historical draft gRPC service
+func NewService(app HistoryApp, tx sqlutil.Transactor) *Service {
+	return &Service{
+		app: app,
+		tx:  tx,
+	}
+}
+
+// Verify that Service implements the DraftHistoryServiceHandler interface
+var _ draftv1connect.DraftHistoryServiceHandler = (*Service)(nil)
+
+// ImportHistoricalDraft imports a completed draft from before the league moved to the
+// platform. The draft, its picks and its record as imported are created in one transaction.
+// Commissioner only.
+func (s *Service) ImportHistoricalDraft(ctx context.Context, req *connect.Request[draftv1.ImportHistoricalDraftRequest]) (*connect.Response[draftv1.ImportHistoricalDraftResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	importReq := ImportHistoricalDraftRequest{
+		LeagueID:    leagueID,
+		Season:      req.Msg.Season,
+		DraftType:   protoToDraftType(req.Msg.DraftType),
+		Source:      req.Msg.Source,
+		CompletedAt: req.Msg.CompletedAt.AsTime(),
+		Picks:       make([]HistoricalPick, len(req.Msg.Picks)),
+		ImportedBy:  interceptors.ActingUserPtr(ctx),
+	}
+	if req.Msg.StartedAt != nil {
+		startedAt := req.Msg.StartedAt.AsTime()
+		importReq.StartedAt = &startedAt
+	}
+	for i, pick := range req.Msg.Picks {
+		teamID, err := uuid.Parse(pick.TeamId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		importReq.Picks[i] = HistoricalPick{
+			Round:         int(pick.Round),
+			Pick:          int(pick.Pick),
+			OverallPick:   int(pick.OverallPick),
+			TeamID:        teamID,
+			Provider:      pick.GetProvider(),
+			ExternalID:    pick.GetExternalId(),
+			AuctionAmount: pick.AuctionAmount,
+			KeeperPick:    pick.KeeperPick,
+		}
+		if pick.PlayerId != nil {
+			playerID, err := uuid.Parse(*pick.PlayerId)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			importReq.Picks[i].PlayerID = &playerID
+		}
+		if pick.PickedAt != nil {
+			pickedAt := pick.PickedAt.AsTime()
+			importReq.Picks[i].PickedAt = &pickedAt
+		}
+	}
+
+	var historical *models.HistoricalDraft
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		historical, err = s.app.ImportHistoricalDraft(ctx, importReq)
+		return err
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&draftv1.ImportHistoricalDraftResponse{
+		HistoricalDraft: s.historicalDraftToProto(*historical),
+	}), nil
+}
+
+// ListHistoricalDrafts lists a league's imported drafts, latest season first
+func (s *Service) ListHistoricalDrafts(ctx context.Context, req *connect.Request[draftv1.ListHistoricalDraftsRequest]) (*connect.Response[draftv1.ListHistoricalDraftsResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	drafts, err := s.app.ListHistoricalDrafts(ctx, leagueID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &draftv1.ListHistoricalDraftsResponse{
+		HistoricalDrafts: make([]*draftv1.HistoricalDraft, len(drafts)),
+	}
+	for i, draft := range drafts {
+		resp.HistoricalDrafts[i] = s.historicalDraftToProto(draft)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// toConnectError maps app errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrLeagueNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrInvalidSeason), errors.Is(err, ErrUnsupportedDraftType), errors.Is(err, ErrInvalidDraftTimes),
+		errors.Is(err, ErrTeamNotInLeague), errors.Is(err, ErrDuplicatePick), errors.Is(err, ErrDuplicatePlayer),
+		errors.Is(err, ErrNoPlayer), errors.Is(err, ErrPlayerNotFound):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrSeasonNotPast):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, ErrAlreadyImported):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, ErrNotCommissioner):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// Conversion methods
+
+// historicalDraftToProto converts a historical draft to proto
+func (s *Service) historicalDraftToProto(draft models.HistoricalDraft) *draftv1.HistoricalDraft {
+	protoDraft := &draftv1.HistoricalDraft{
+		DraftId:    draft.DraftID.String(),
+		LeagueId:   draft.LeagueID.String(),
+		Season:     draft.Season,
+		DraftType:  draftTypeToProto(draft.DraftType),
+		Source:     draft.Source,
+		ImportedAt: timestamppb.New(draft.ImportedAt),
+		Picks:      int32(draft.Picks),
+	}
+	if draft.ImportedBy != nil {
+		importedBy := draft.ImportedBy.String()
+		protoDraft.ImportedBy = &importedBy
+	}
+	return protoDraft
+}
+
+// protoToDraftType converts the draft types a historical draft may have; any other is unset
+func protoToDraftType(protoType draftv1.DraftType) models.DraftType {
+	switch protoType {
+	case draftv1.DraftType_DRAFT_TYPE_SNAKE:
+		return models.DraftTypeSnake
+	case draftv1.DraftType_DRAFT_TYPE_AUCTION:
+		return models.DraftTypeAuction
+	case draftv1.DraftType_DRAFT_TYPE_ROOKIE:
+		return models.DraftTypeRookie
+	default:
+		return ""
+	}
+}
+
+func draftTypeToProto(draftType models.DraftType) draftv1.DraftType {
+	switch draftType {
+	case models.DraftTypeSnake:
+		return draftv1.DraftType_DRAFT_TYPE_SNAKE
+	case models.DraftTypeAuction:
+		return draftv1.DraftType_DRAFT_TYPE_AUCTION
+	case models.DraftTypeRookie:
+		return draftv1.DraftType_DRAFT_TYPE_ROOKIE
+	default:
+		return draftv1.DraftType_DRAFT_TYPE_UNSPECIFIED
+	}
+}
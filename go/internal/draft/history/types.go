@@ -0,0 +1,98 @@
+package history
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrLeagueNotFound is returned when a league does not exist
+	ErrLeagueNotFound = errors.New("league not found")
+	// ErrNotCommissioner is returned when someone other than the commissioner imports a draft
+	ErrNotCommissioner = errors.New("only the league commissioner can do this")
+	// ErrInvalidSeason is returned when a season doesn't start with its year
+	ErrInvalidSeason = errors.New("season must start with its year")
+	// ErrSeasonNotPast is returned when a draft is imported for the league's current season or
+	// later, which are drafted on the platform
+	ErrSeasonNotPast = errors.New("only drafts from seasons before the league's current season can be imported")
+	// ErrUnsupportedDraftType is returned when a draft of a type held only on the platform is
+	// imported
+	ErrUnsupportedDraftType = errors.New("only snake, auction and rookie drafts can be imported")
+	// ErrAlreadyImported is returned when a league's draft of a type has already been imported for
+	// the season
+	ErrAlreadyImported = errors.New("draft has already been imported for this season")
+	// ErrInvalidDraftTimes is returned when a draft completes before it starts or in the future
+	ErrInvalidDraftTimes = errors.New("draft must complete after it started and before now")
+	// ErrTeamNotInLeague is returned when a pick is given to a team of another league
+	ErrTeamNotInLeague = errors.New("team is not in the league")
+	// ErrDuplicatePick is returned when two picks share a place on the board
+	ErrDuplicatePick = errors.New("draft has more than one pick at the same place on the board")
+	// ErrDuplicatePlayer is returned when a player is drafted more than once
+	ErrDuplicatePlayer = errors.New("player is drafted more than once")
+	// ErrNoPlayer is returned when a pick gives neither a player nor a provider's ID for one
+	ErrNoPlayer = errors.New("pick must give a player ID or a provider and external ID")
+	// ErrPlayerNotFound is returned when a pick's player can't be found
+	ErrPlayerNotFound = errors.New("player not found")
+)
+
+// ImportHistoricalDraftRequest imports a draft a league held before it moved to the platform
+type ImportHistoricalDraftRequest struct {
+	LeagueID    uuid.UUID        `json:"league_id"`
+	Season      string           `json:"season"`
+	DraftType   models.DraftType `json:"draft_type"`
+	Source      *string          `json:"source,omitempty"`
+	StartedAt   *time.Time       `json:"started_at,omitempty"`
+	CompletedAt time.Time        `json:"completed_at"`
+	Picks       []HistoricalPick `json:"picks"`
+	ImportedBy  *uuid.UUID       `json:"imported_by,omitempty"` // nil for trusted callers
+}
+
+// HistoricalPick is a pick of an imported draft. The player is given by ID, or by a provider
+// and its ID for them when PlayerID is nil.
+type HistoricalPick struct {
+	Round         int        `json:"round"`
+	Pick          int        `json:"pick"`
+	OverallPick   int        `json:"overall_pick"`
+	TeamID        uuid.UUID  `json:"team_id"`
+	PlayerID      *uuid.UUID `json:"player_id,omitempty"`
+	Provider      string     `json:"provider,omitempty"`
+	ExternalID    string     `json:"external_id,omitempty"`
+	PickedAt      *time.Time `json:"picked_at,omitempty"`
+	AuctionAmount *float64   `json:"auction_amount,omitempty"`
+	KeeperPick    bool       `json:"keeper_pick"`
+}
+
+// League is what an import needs to know about a league
+type League struct {
+	ID             uuid.UUID
+	Season         string
+	CommissionerID uuid.UUID
+}
+
+// ImportedDraft is a historical draft ready to be stored, its picks' players resolved
+type ImportedDraft struct {
+	LeagueID    uuid.UUID
+	Season      string
+	DraftType   models.DraftType
+	Source      *string
+	Settings    models.DraftSettings
+	StartedAt   *time.Time
+	CompletedAt time.Time
+	Picks       []ImportedPick
+	ImportedBy  *uuid.UUID
+}
+
+// ImportedPick is a pick of an imported draft with its player resolved
+type ImportedPick struct {
+	Round         int
+	Pick          int
+	OverallPick   int
+	TeamID        uuid.UUID
+	PlayerID      uuid.UUID
+	PickedAt      *time.Time
+	AuctionAmount *float64
+	KeeperPick    bool
+}
@@ -0,0 +1,141 @@
+package mockdraft
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockDraftRepository defines what the mock draft app layer needs from the repository
+type MockDraftRepository interface {
+	Enqueue(ctx context.Context, userID uuid.UUID, format, teamName string) (*QueueEntry, error)
+	GetQueueEntry(ctx context.Context, userID uuid.UUID) (*QueueEntry, error)
+	LeaveQueue(ctx context.Context, userID uuid.UUID) (bool, error)
+	CountWaiting(ctx context.Context) (map[string]int, error)
+	CountOpenLobbies(ctx context.Context) (int, error)
+	FormLobby(ctx context.Context, format Format, fillBefore time.Time) (*Lobby, []QueueEntry, error)
+	SetSeatTeam(ctx context.Context, userID, fantasyTeamID uuid.UUID) error
+	SaveLobby(ctx context.Context, lobby *Lobby) error
+	GetLobby(ctx context.Context, id uuid.UUID) (*Lobby, error)
+	ListLobbiesToTearDown(ctx context.Context, completedBefore, createdBefore, formingBefore time.Time, limit int) ([]Lobby, error)
+	TearDownLobby(ctx context.Context, lobby *Lobby, status LobbyStatus) error
+}
+
+// App handles mock draft queue and lobby business logic
+type App struct {
+	repo MockDraftRepository
+}
+
+// NewApp creates a new mock draft App
+func NewApp(repo MockDraftRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// JoinQueue queues a user for a mock draft of a format, under a team name that defaults to
+// DefaultTeamName
+func (a *App) JoinQueue(ctx context.Context, userID uuid.UUID, formatID, teamName string) (*QueueEntry, error) {
+	if _, ok := FormatByID(formatID); !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, formatID)
+	}
+	if teamName = strings.TrimSpace(teamName); teamName == "" {
+		teamName = DefaultTeamName
+	}
+
+	entry, err := a.repo.Enqueue(ctx, userID, formatID, teamName)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("User %s queued for a %s mock draft", userID, formatID)
+	return entry, nil
+}
+
+// LeaveQueue takes a user out of the queue, reporting whether they were waiting. Users seated
+// in a lobby stay in it.
+func (a *App) LeaveQueue(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return a.repo.LeaveQueue(ctx, userID)
+}
+
+// GetQueueEntry retrieves where a user stands in the mock draft queue
+func (a *App) GetQueueEntry(ctx context.Context, userID uuid.UUID) (*QueueEntry, error) {
+	return a.repo.GetQueueEntry(ctx, userID)
+}
+
+// GetLobby retrieves a mock draft lobby
+func (a *App) GetLobby(ctx context.Context, id uuid.UUID) (*Lobby, error) {
+	return a.repo.GetLobby(ctx, id)
+}
+
+// CountWaiting counts the users waiting for a lobby by format
+func (a *App) CountWaiting(ctx context.Context) (map[string]int, error) {
+	return a.repo.CountWaiting(ctx)
+}
+
+// CountOpenLobbies counts the lobbies forming or drafting
+func (a *App) CountOpenLobbies(ctx context.Context) (int, error) {
+	return a.repo.CountOpenLobbies(ctx)
+}
+
+// FormLobby seats the users waiting longest for a format in a new lobby. A lobby short of
+// users is only formed once the longest waiting of them queued before fillBefore, its empty
+// seats going to bots; until then no lobby is returned.
+func (a *App) FormLobby(ctx context.Context, format Format, fillBefore time.Time) (*Lobby, []QueueEntry, error) {
+	if format.Teams-1 > MaxBots {
+		return nil, nil, fmt.Errorf("format %s has more seats than there are bots to fill them", format.ID)
+	}
+
+	lobby, seats, err := a.repo.FormLobby(ctx, format, fillBefore)
+	if err != nil || lobby == nil {
+		return nil, nil, err
+	}
+	log.Printf("Formed %s mock draft lobby %s with %d users and %d bots", format.ID, lobby.ID, lobby.HumanSeats, lobby.BotSeats)
+	return lobby, seats, nil
+}
+
+// SetSeatTeam records a seated user's team in their lobby's league
+func (a *App) SetSeatTeam(ctx context.Context, userID, fantasyTeamID uuid.UUID) error {
+	return a.repo.SetSeatTeam(ctx, userID, fantasyTeamID)
+}
+
+// SaveLobby records a lobby's status, league, draft and error
+func (a *App) SaveLobby(ctx context.Context, lobby *Lobby) error {
+	return a.repo.SaveLobby(ctx, lobby)
+}
+
+// ListLobbiesToTearDown lists the lobbies due for teardown as of now: those whose draft
+// completed more than linger ago, those still drafting after maxLifetime, and those left forming
+// for longer than formingTimeout by an interrupted matchmaker
+func (a *App) ListLobbiesToTearDown(ctx context.Context, now time.Time, config MatchmakerConfig) ([]Lobby, error) {
+	return a.repo.ListLobbiesToTearDown(ctx,
+		now.Add(-config.Linger),
+		now.Add(-config.MaxLifetime),
+		now.Add(-config.FormingTimeout),
+		config.TeardownBatch,
+	)
+}
+
+// TearDownLobby deletes a finished lobby's league and draft and lets its users queue again
+func (a *App) TearDownLobby(ctx context.Context, lobby *Lobby) error {
+	if err := a.repo.TearDownLobby(ctx, lobby, LobbyStatusTornDown); err != nil {
+		return err
+	}
+	log.Printf("Tore down %s mock draft lobby %s", lobby.Format, lobby.ID)
+	return nil
+}
+
+// FailLobby deletes whatever was created for a lobby that failed to form, records why it
+// failed and puts its users back in the queue in their place
+func (a *App) FailLobby(ctx context.Context, lobby *Lobby, cause error) error {
+	reason := cause.Error()
+	lobby.Error = &reason
+	if err := a.repo.TearDownLobby(ctx, lobby, LobbyStatusFailed); err != nil {
+		return err
+	}
+	log.Printf("Mock draft lobby %s failed, its %d users went back to the queue: %v", lobby.ID, lobby.HumanSeats, cause)
+	return nil
+}
@@ -0,0 +1,144 @@
+-- name: EnqueueMockDraftUser :one
+-- Returns no row when the user is already queued or seated.
+INSERT INTO mock_draft_queue (user_id, format, team_name)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO NOTHING
+RETURNING *;
+
+-- name: GetMockDraftQueueEntry :one
+SELECT *
+FROM mock_draft_queue
+WHERE user_id = $1;
+
+-- name: DeleteWaitingMockDraftQueueEntry :execrows
+-- Users already seated in a lobby are never matched.
+DELETE FROM mock_draft_queue
+WHERE user_id = $1
+  AND lobby_id IS NULL;
+
+-- name: CountWaitingMockDraftUsers :many
+-- The users waiting for a lobby, by format.
+SELECT format, COUNT(*) AS waiting
+FROM mock_draft_queue
+WHERE lobby_id IS NULL
+GROUP BY format;
+
+-- name: ClaimWaitingMockDraftUsers :many
+-- Lock up to max_users users waiting for a format, longest waiting first. Users being claimed by
+-- a concurrent matchmaker are skipped.
+SELECT *
+FROM mock_draft_queue
+WHERE format = $1
+  AND lobby_id IS NULL
+ORDER BY queued_at, user_id
+LIMIT sqlc.arg('max_users')
+FOR UPDATE SKIP LOCKED;
+
+-- name: CountOpenMockDraftLobbies :one
+SELECT COUNT(*)
+FROM mock_draft_lobbies
+WHERE status IN ('FORMING', 'DRAFTING');
+
+-- name: CreateMockDraftLobby :one
+INSERT INTO mock_draft_lobbies (format, human_seats, bot_seats)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: SeatMockDraftUsers :exec
+UPDATE mock_draft_queue
+SET lobby_id = $1
+WHERE user_id = ANY(@user_ids::uuid[]);
+
+-- name: SetMockDraftSeatTeam :exec
+UPDATE mock_draft_queue
+SET fantasy_team_id = $2
+WHERE user_id = $1;
+
+-- name: ListMockDraftLobbySeats :many
+-- The users seated in a lobby, in the order they queued.
+SELECT *
+FROM mock_draft_queue
+WHERE lobby_id = $1
+ORDER BY queued_at, user_id;
+
+-- name: ReleaseMockDraftLobbySeats :exec
+-- Put a lobby's users back in the queue, keeping their place.
+UPDATE mock_draft_queue
+SET lobby_id        = NULL,
+    fantasy_team_id = NULL
+WHERE lobby_id = $1;
+
+-- name: DeleteMockDraftLobbySeats :exec
+DELETE FROM mock_draft_queue
+WHERE lobby_id = $1;
+
+-- name: GetMockDraftLobby :one
+SELECT *
+FROM mock_draft_lobbies
+WHERE id = $1;
+
+-- name: UpdateMockDraftLobby :one
+UPDATE mock_draft_lobbies
+SET status       = $2,
+    league_id    = $3,
+    draft_id     = $4,
+    error        = $5,
+    torn_down_at = CASE WHEN $2 = 'TORN_DOWN' THEN NOW() ELSE torn_down_at END,
+    updated_at   = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListMockDraftLobbiesToTearDown :many
+-- Lobbies whose draft completed before completed_before, lobbies still drafting that were created
+-- before created_before, and lobbies left forming since before forming_before by an interrupted
+-- matchmaker, oldest first.
+SELECT l.*
+FROM mock_draft_lobbies l
+LEFT JOIN draft d ON d.id = l.draft_id
+WHERE (l.status = 'DRAFTING'
+    AND ((d.status = 'COMPLETED' AND d.completed_at < sqlc.arg('completed_before'))
+        OR l.created_at < sqlc.arg('created_before')
+        OR d.id IS NULL))
+   OR (l.status = 'FORMING' AND l.updated_at < sqlc.arg('forming_before'))
+ORDER BY l.created_at
+LIMIT sqlc.arg('max_lobbies');
+
+-- name: LockMockLeague :one
+-- Lock a mock league for teardown. Leagues hosted by anyone else are never matched.
+SELECT id
+FROM leagues
+WHERE id = $1
+  AND commissioner_id = $2
+FOR UPDATE;
+
+-- name: DeleteMockLeagueDraftEvents :exec
+DELETE FROM draft_outbox
+WHERE draft_id IN (SELECT id FROM draft WHERE league_id = $1);
+
+-- name: DeleteMockLeagueDraftPicks :exec
+DELETE FROM draft_picks
+WHERE draft_id IN (SELECT id FROM draft WHERE league_id = $1);
+
+-- name: DeleteMockLeagueDrafts :exec
+DELETE FROM draft
+WHERE league_id = $1;
+
+-- name: DeleteMockLeagueRosters :exec
+DELETE FROM roster_players
+WHERE fantasy_team_id IN (SELECT id FROM fantasy_teams WHERE league_id = $1);
+
+-- name: DeleteMockLeagueTransactions :exec
+DELETE FROM league_transactions
+WHERE league_id = $1;
+
+-- name: DeleteMockLeagueSettingsChanges :exec
+DELETE FROM league_settings_changes
+WHERE league_id = $1;
+
+-- name: DeleteMockLeagueTeams :exec
+DELETE FROM fantasy_teams
+WHERE league_id = $1;
+
+-- name: DeleteMockLeague :exec
+DELETE FROM leagues
+WHERE id = $1;
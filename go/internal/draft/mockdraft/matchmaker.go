@@ -0,0 +1,264 @@
+package mockdraft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	fantasyteamv1 "github.com/mcdev12/dynasty/go/internal/genproto/fantasyteam/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/fantasyteam/v1/fantasyteamv1connect"
+	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// MatchmakingJob is the job kind of the mock draft matchmaker
+const MatchmakingJob = "mockdraft.matchmake"
+
+// mockSportID is the sport mock drafts are run in
+const mockSportID = "nfl"
+
+// Matchmaker seats queued users in mock draft lobbies and runs each lobby's draft in a throwaway
+// league hosted by HostUserID, created through the league, fantasy team and draft services so
+// the draft runs like any other. Bot seats are abandoned teams that auto-pick. Lobbies are torn
+// down a while after their draft completes.
+type Matchmaker struct {
+	app           *App
+	leagueService leaguev1connect.LeagueServiceClient
+	teamService   fantasyteamv1connect.FantasyTeamServiceClient
+	draftService  draftv1connect.DraftServiceClient
+	pickService   draftv1connect.DraftPickServiceClient
+	config        MatchmakerConfig
+}
+
+// NewMatchmaker creates a mock draft matchmaker
+func NewMatchmaker(app *App, leagueService leaguev1connect.LeagueServiceClient, teamService fantasyteamv1connect.FantasyTeamServiceClient, draftService draftv1connect.DraftServiceClient, pickService draftv1connect.DraftPickServiceClient, config MatchmakerConfig) *Matchmaker {
+	return &Matchmaker{
+		app:           app,
+		leagueService: leagueService,
+		teamService:   teamService,
+		draftService:  draftService,
+		pickService:   pickService,
+		config:        config,
+	}
+}
+
+// ScheduleMatchmaking runs the matchmaker on the job worker every config.Interval
+func ScheduleMatchmaking(worker *jobs.Worker, matchmaker *Matchmaker) {
+	log.Printf("Scheduling mock draft matchmaking every %s (bots fill after %s, %d open lobbies at most)",
+		matchmaker.config.Interval, matchmaker.config.FillAfter, matchmaker.config.MaxOpenLobbies)
+
+	worker.Schedule(MatchmakingJob, jobs.Every(matchmaker.config.Interval), func(ctx context.Context, _ jobs.Job) error {
+		return matchmaker.Run(ctx, time.Now())
+	})
+}
+
+// Run tears down the lobbies that are done, then forms lobbies from the queues. Lobbies that
+// fail to form are undone and their users put back in the queue without failing the run.
+func (m *Matchmaker) Run(ctx context.Context, now time.Time) error {
+	tornDown, err := m.tearDown(ctx, now)
+	if err != nil {
+		return err
+	}
+	formed, err := m.matchQueues(ctx, now)
+	if err != nil {
+		return err
+	}
+	if tornDown > 0 || formed > 0 {
+		log.Printf("Mock draft matchmaking formed %d lobbies and tore down %d", formed, tornDown)
+	}
+	return nil
+}
+
+// tearDown tears down the lobbies due for it. Lobbies left forming by an interrupted matchmaker
+// are failed, so their users go back to the queue.
+func (m *Matchmaker) tearDown(ctx context.Context, now time.Time) (int, error) {
+	lobbies, err := m.app.ListLobbiesToTearDown(ctx, now, m.config)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range lobbies {
+		lobby := &lobbies[i]
+		if lobby.Status == LobbyStatusForming {
+			err = m.app.FailLobby(ctx, lobby, errors.New("matchmaker was interrupted while forming the lobby"))
+		} else {
+			err = m.app.TearDownLobby(ctx, lobby)
+		}
+		if err != nil {
+			return i, fmt.Errorf("failed to tear down lobby %s: %w", lobby.ID, err)
+		}
+	}
+	return len(lobbies), nil
+}
+
+// matchQueues forms lobbies for every format with users waiting, up to MaxOpenLobbies
+func (m *Matchmaker) matchQueues(ctx context.Context, now time.Time) (int, error) {
+	open, err := m.app.CountOpenLobbies(ctx)
+	if err != nil {
+		return 0, err
+	}
+	waiting, err := m.app.CountWaiting(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	fillBefore := now.Add(-m.config.FillAfter)
+	formed := 0
+	for _, format := range Formats {
+		for waiting[format.ID] > 0 && open < m.config.MaxOpenLobbies {
+			lobby, seats, err := m.app.FormLobby(ctx, format, fillBefore)
+			if err != nil {
+				return formed, err
+			}
+			if lobby == nil {
+				break
+			}
+			waiting[format.ID] -= len(seats)
+			open++
+
+			if err := m.startLobby(ctx, format, lobby, seats); err != nil {
+				if failErr := m.app.FailLobby(ctx, lobby, err); failErr != nil {
+					return formed, errors.Join(err, failErr)
+				}
+				continue
+			}
+			formed++
+		}
+	}
+	return formed, nil
+}
+
+// startLobby creates a lobby's league, its users' and bots' teams and its draft in random
+// order, then starts the draft and hands the bots' teams to auto-pick. Everything created is
+// recorded on the lobby as it's made, so a lobby that fails can be undone.
+func (m *Matchmaker) startLobby(ctx context.Context, format Format, lobby *Lobby, seats []QueueEntry) error {
+	leagueID, err := m.createLeague(ctx, format)
+	if err != nil {
+		return fmt.Errorf("failed to create league: %w", err)
+	}
+	lobby.LeagueID = &leagueID
+	if err := m.app.SaveLobby(ctx, lobby); err != nil {
+		return err
+	}
+
+	order := make([]string, 0, format.Teams)
+	for _, seat := range seats {
+		teamID, err := m.createTeam(ctx, leagueID, seat.UserID, seat.TeamName)
+		if err != nil {
+			return fmt.Errorf("failed to create team for user %s: %w", seat.UserID, err)
+		}
+		if err := m.app.SetSeatTeam(ctx, seat.UserID, teamID); err != nil {
+			return err
+		}
+		order = append(order, teamID.String())
+	}
+	var botTeamIDs []string
+	for n := 1; n <= lobby.BotSeats; n++ {
+		teamID, err := m.createTeam(ctx, leagueID, BotUserID(n), fmt.Sprintf("Bot %d", n))
+		if err != nil {
+			return fmt.Errorf("failed to create bot team: %w", err)
+		}
+		botTeamIDs = append(botTeamIDs, teamID.String())
+		order = append(order, teamID.String())
+	}
+	rand.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+
+	draftResp, err := m.draftService.CreateDraft(ctx, connect.NewRequest(&draftv1.CreateDraftRequest{
+		LeagueId:  leagueID.String(),
+		DraftType: draftv1.DraftType_DRAFT_TYPE_SNAKE,
+		Settings: &draftv1.DraftSettings{
+			Rounds:         int32(format.Rounds),
+			TimePerPickSec: int32(format.TimePerPickSec),
+			DraftOrder:     order,
+		},
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to create draft: %w", err)
+	}
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft.id", draftResp.Msg.Draft.Id)
+	if err != nil {
+		return err
+	}
+	lobby.DraftID = &draftID
+	if err := m.app.SaveLobby(ctx, lobby); err != nil {
+		return err
+	}
+
+	_, err = m.pickService.PrepopulateDraftPicks(ctx, connect.NewRequest(&draftv1.PrepopulateDraftPicksRequest{
+		DraftId:   draftID.String(),
+		DraftType: draftv1.DraftType_DRAFT_TYPE_SNAKE,
+		Settings:  draftResp.Msg.Draft.Settings,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to prepopulate picks: %w", err)
+	}
+
+	// Bots can only be handed to auto-pick once the draft is running
+	_, err = m.draftService.StartDraft(ctx, connect.NewRequest(&draftv1.StartDraftRequest{
+		DraftId:           draftID.String(),
+		OverrideReadiness: true,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to start draft: %w", err)
+	}
+	for _, teamID := range botTeamIDs {
+		_, err := m.draftService.AbandonTeam(ctx, connect.NewRequest(&draftv1.AbandonTeamRequest{
+			DraftId:       draftID.String(),
+			FantasyTeamId: teamID,
+			PickHandling:  draftv1.AbandonedPickHandling_ABANDONED_PICK_HANDLING_AUTO_PICK,
+			Reason:        "Mock draft bot",
+		}))
+		if err != nil {
+			return fmt.Errorf("failed to hand bot team %s to auto-pick: %w", teamID, err)
+		}
+	}
+
+	lobby.Status = LobbyStatusDrafting
+	return m.app.SaveLobby(ctx, lobby)
+}
+
+// createLeague creates a lobby's league, hosted by HostUserID
+func (m *Matchmaker) createLeague(ctx context.Context, format Format) (uuid.UUID, error) {
+	settings, err := structpb.NewStruct(format.LeagueSettings())
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to convert league settings: %w", err)
+	}
+
+	resp, err := m.leagueService.CreateLeague(ctx, connect.NewRequest(&leaguev1.CreateLeagueRequest{
+		Name:           format.Name + " Mock Draft",
+		SportId:        mockSportID,
+		LeagueType:     leaguev1.LeagueType_LEAGUE_TYPE_REDRAFT,
+		CommissionerId: HostUserID.String(),
+		LeagueSettings: settings,
+		LeagueStatus:   leaguev1.LeagueStatus_LEAGUE_STATUS_PENDING,
+		Season:         strconv.Itoa(time.Now().Year()),
+	}))
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuidutil.MustParseOrInvalidArg("league.id", resp.Msg.League.Id)
+}
+
+// createTeam creates a team owned by a user in a lobby's league
+func (m *Matchmaker) createTeam(ctx context.Context, leagueID, ownerID uuid.UUID, name string) (uuid.UUID, error) {
+	resp, err := m.teamService.CreateFantasyTeam(ctx, connect.NewRequest(&fantasyteamv1.CreateFantasyTeamRequest{
+		LeagueId: leagueID.String(),
+		OwnerId:  ownerID.String(),
+		Name:     name,
+	}))
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuidutil.MustParseOrInvalidArg("fantasy_team.id", resp.Msg.FantasyTeam.Id)
+}
@@ -0,0 +1,310 @@
+package mockdraft
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/mockdraft/db"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// Repository implements mock draft data access operations
+type Repository struct {
+	queries *db.Queries
+	sqlDB   *sql.DB
+}
+
+// NewRepository creates a new mock draft repository
+func NewRepository(queries *db.Queries, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: queries,
+		sqlDB:   sqlDB,
+	}
+}
+
+// Enqueue queues a user for a mock draft of a format
+func (r *Repository) Enqueue(ctx context.Context, userID uuid.UUID, format, teamName string) (*QueueEntry, error) {
+	row, err := r.queries.EnqueueMockDraftUser(ctx, db.EnqueueMockDraftUserParams{
+		UserID:   userID,
+		Format:   format,
+		TeamName: teamName,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAlreadyQueued
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue user: %w", err)
+	}
+	return dbQueueEntryToModel(row), nil
+}
+
+// GetQueueEntry retrieves where a user stands in the mock draft queue
+func (r *Repository) GetQueueEntry(ctx context.Context, userID uuid.UUID) (*QueueEntry, error) {
+	row, err := r.queries.GetMockDraftQueueEntry(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotQueued
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue entry: %w", err)
+	}
+	return dbQueueEntryToModel(row), nil
+}
+
+// LeaveQueue takes a waiting user out of the queue, reporting whether they were waiting
+func (r *Repository) LeaveQueue(ctx context.Context, userID uuid.UUID) (bool, error) {
+	deleted, err := r.queries.DeleteWaitingMockDraftQueueEntry(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to leave queue: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+// CountWaiting counts the users waiting for a lobby by format
+func (r *Repository) CountWaiting(ctx context.Context) (map[string]int, error) {
+	rows, err := r.queries.CountWaitingMockDraftUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count waiting users: %w", err)
+	}
+	waiting := make(map[string]int, len(rows))
+	for _, row := range rows {
+		waiting[row.Format] = int(row.Waiting)
+	}
+	return waiting, nil
+}
+
+// CountOpenLobbies counts the lobbies forming or drafting
+func (r *Repository) CountOpenLobbies(ctx context.Context) (int, error) {
+	count, err := r.queries.CountOpenMockDraftLobbies(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count open lobbies: %w", err)
+	}
+	return int(count), nil
+}
+
+// FormLobby seats the users waiting longest for a format in a new lobby, up to the format's
+// team count. When fewer are waiting, the lobby is only formed if the longest waiting user
+// queued before fillBefore; otherwise it returns no lobby.
+func (r *Repository) FormLobby(ctx context.Context, format Format, fillBefore time.Time) (*Lobby, []QueueEntry, error) {
+	var lobby *Lobby
+	var seats []QueueEntry
+	err := sqlutil.Run(ctx, r.sqlDB, r.queries.WithTx, func(q *db.Queries) error {
+		lobby, seats = nil, nil
+
+		rows, err := q.ClaimWaitingMockDraftUsers(ctx, db.ClaimWaitingMockDraftUsersParams{
+			Format:   format.ID,
+			MaxUsers: int32(format.Teams),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to claim waiting users: %w", err)
+		}
+		if len(rows) == 0 || (len(rows) < format.Teams && !rows[0].QueuedAt.Before(fillBefore)) {
+			return nil
+		}
+
+		dbLobby, err := q.CreateMockDraftLobby(ctx, db.CreateMockDraftLobbyParams{
+			Format:     format.ID,
+			HumanSeats: int32(len(rows)),
+			BotSeats:   int32(format.Teams - len(rows)),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create lobby: %w", err)
+		}
+
+		userIDs := make([]uuid.UUID, len(rows))
+		for i, row := range rows {
+			userIDs[i] = row.UserID
+		}
+		err = q.SeatMockDraftUsers(ctx, db.SeatMockDraftUsersParams{
+			LobbyID: uuid.NullUUID{UUID: dbLobby.ID, Valid: true},
+			UserIds: userIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to seat users: %w", err)
+		}
+
+		lobby = dbLobbyToModel(dbLobby)
+		for _, row := range rows {
+			seat := dbQueueEntryToModel(row)
+			seat.LobbyID = &lobby.ID
+			seats = append(seats, *seat)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return lobby, seats, nil
+}
+
+// SetSeatTeam records a seated user's team in their lobby's league
+func (r *Repository) SetSeatTeam(ctx context.Context, userID, fantasyTeamID uuid.UUID) error {
+	err := r.queries.SetMockDraftSeatTeam(ctx, db.SetMockDraftSeatTeamParams{
+		UserID:        userID,
+		FantasyTeamID: uuid.NullUUID{UUID: fantasyTeamID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set seat team: %w", err)
+	}
+	return nil
+}
+
+// SaveLobby records a lobby's status, league, draft and error
+func (r *Repository) SaveLobby(ctx context.Context, lobby *Lobby) error {
+	_, err := r.updateLobby(ctx, r.queries, lobby)
+	return err
+}
+
+// GetLobby retrieves a mock draft lobby
+func (r *Repository) GetLobby(ctx context.Context, id uuid.UUID) (*Lobby, error) {
+	row, err := r.queries.GetMockDraftLobby(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrLobbyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lobby: %w", err)
+	}
+	return dbLobbyToModel(row), nil
+}
+
+// ListLobbiesToTearDown lists, oldest first, the lobbies whose draft completed before
+// completedBefore, the lobbies still drafting that were created before createdBefore, and the
+// lobbies left forming since before formingBefore
+func (r *Repository) ListLobbiesToTearDown(ctx context.Context, completedBefore, createdBefore, formingBefore time.Time, limit int) ([]Lobby, error) {
+	rows, err := r.queries.ListMockDraftLobbiesToTearDown(ctx, db.ListMockDraftLobbiesToTearDownParams{
+		CompletedBefore: sql.NullTime{Time: completedBefore, Valid: true},
+		CreatedBefore:   createdBefore,
+		FormingBefore:   formingBefore,
+		MaxLobbies:      int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lobbies to tear down: %w", err)
+	}
+	lobbies := make([]Lobby, len(rows))
+	for i, row := range rows {
+		lobbies[i] = *dbLobbyToModel(row)
+	}
+	return lobbies, nil
+}
+
+// TearDownLobby deletes a lobby's league with its teams, rosters, drafts and their picks and
+// events, and records the lobby's new status. A failed lobby's users go back to the queue in
+// their place; otherwise they leave it. The league is only deleted if the mock draft host
+// commissions it. It runs under the draft's advisory lock so no pick is made meanwhile.
+func (r *Repository) TearDownLobby(ctx context.Context, lobby *Lobby, status LobbyStatus) error {
+	lockID := lobby.ID
+	if lobby.DraftID != nil {
+		lockID = *lobby.DraftID
+	}
+
+	return sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, lockID, r.queries.WithTx, func(q *db.Queries) error {
+		if lobby.LeagueID != nil {
+			if err := deleteMockLeague(ctx, q, *lobby.LeagueID); err != nil {
+				return err
+			}
+		}
+
+		lobbyID := uuid.NullUUID{UUID: lobby.ID, Valid: true}
+		if status == LobbyStatusFailed {
+			if err := q.ReleaseMockDraftLobbySeats(ctx, lobbyID); err != nil {
+				return fmt.Errorf("failed to release seats: %w", err)
+			}
+		} else {
+			if err := q.DeleteMockDraftLobbySeats(ctx, lobbyID); err != nil {
+				return fmt.Errorf("failed to delete seats: %w", err)
+			}
+		}
+
+		torn := *lobby
+		torn.Status = status
+		saved, err := r.updateLobby(ctx, q, &torn)
+		if err != nil {
+			return err
+		}
+		*lobby = *saved
+		return nil
+	})
+}
+
+// deleteMockLeague deletes a mock league and everything in it. A league the mock draft host
+// doesn't commission, or that is already gone, is left alone.
+func deleteMockLeague(ctx context.Context, q *db.Queries, leagueID uuid.UUID) error {
+	_, err := q.LockMockLeague(ctx, db.LockMockLeagueParams{
+		ID:             leagueID,
+		CommissionerID: HostUserID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock mock league: %w", err)
+	}
+
+	steps := []struct {
+		name   string
+		delete func(context.Context, uuid.UUID) error
+	}{
+		{"draft events", q.DeleteMockLeagueDraftEvents},
+		{"draft picks", q.DeleteMockLeagueDraftPicks},
+		{"drafts", q.DeleteMockLeagueDrafts},
+		{"rosters", q.DeleteMockLeagueRosters},
+		{"transactions", q.DeleteMockLeagueTransactions},
+		{"settings changes", q.DeleteMockLeagueSettingsChanges},
+		{"teams", q.DeleteMockLeagueTeams},
+		{"league", q.DeleteMockLeague},
+	}
+	for _, step := range steps {
+		if err := step.delete(ctx, leagueID); err != nil {
+			return fmt.Errorf("failed to delete mock league %s: %w", step.name, err)
+		}
+	}
+	return nil
+}
+
+func (r *Repository) updateLobby(ctx context.Context, q *db.Queries, lobby *Lobby) (*Lobby, error) {
+	row, err := q.UpdateMockDraftLobby(ctx, db.UpdateMockDraftLobbyParams{
+		ID:       lobby.ID,
+		Status:   string(lobby.Status),
+		LeagueID: sqlutil.ToNullUUID(lobby.LeagueID),
+		DraftID:  sqlutil.ToNullUUID(lobby.DraftID),
+		Error:    sqlutil.ToSqlString(lobby.Error),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrLobbyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save lobby: %w", err)
+	}
+	return dbLobbyToModel(row), nil
+}
+
+// Helper function to convert DB lobby to model
+func dbLobbyToModel(row db.MockDraftLobby) *Lobby {
+	return &Lobby{
+		ID:         row.ID,
+		Format:     row.Format,
+		Status:     LobbyStatus(row.Status),
+		LeagueID:   sqlutil.FromNullUUID(row.LeagueID),
+		DraftID:    sqlutil.FromNullUUID(row.DraftID),
+		HumanSeats: int(row.HumanSeats),
+		BotSeats:   int(row.BotSeats),
+		Error:      sqlutil.FromSqlStringPtr(row.Error),
+		CreatedAt:  row.CreatedAt,
+		TornDownAt: sqlutil.FromSqlTime(row.TornDownAt),
+	}
+}
+
+// Helper function to convert DB queue entry to model
+func dbQueueEntryToModel(row db.MockDraftQueue) *QueueEntry {
+	return &QueueEntry{
+		UserID:        row.UserID,
+		Format:        row.Format,
+		TeamName:      row.TeamName,
+		LobbyID:       sqlutil.FromNullUUID(row.LobbyID),
+		FantasyTeamID: sqlutil.FromNullUUID(row.FantasyTeamID),
+		QueuedAt:      row.QueuedAt,
+	}
+}
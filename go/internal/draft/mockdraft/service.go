@@ -0,0 +1,193 @@
+package mockdraft
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MockDraftApp defines what the service layer needs from the mock draft application
+type MockDraftApp interface {
+	JoinQueue(ctx context.Context, userID uuid.UUID, formatID, teamName string) (*QueueEntry, error)
+	LeaveQueue(ctx context.Context, userID uuid.UUID) (bool, error)
+	GetQueueEntry(ctx context.Context, userID uuid.UUID) (*QueueEntry, error)
+	GetLobby(ctx context.Context, id uuid.UUID) (*Lobby, error)
+	CountWaiting(ctx context.Context) (map[string]int, error)
+}
+
+// Service implements the MockDraftService gRPC interface. Users may only queue, leave and look
+// themselves up; requests without a signed in user are trusted callers.
+type Service struct {
+	app MockDraftApp
+}
+
+// NewService creates a new mock draft gRPC service
+func NewService(app MockDraftApp) *Service {
+	return &Service{
+		app: app,
+	}
+}
+
+// Verify that Service implements the MockDraftServiceHandler interface
+var _ draftv1connect.MockDraftServiceHandler = (*Service)(nil)
+
+// ListMockDraftFormats lists the mock draft formats with how many users are waiting for each
+func (s *Service) ListMockDraftFormats(ctx context.Context, req *connect.Request[draftv1.ListMockDraftFormatsRequest]) (*connect.Response[draftv1.ListMockDraftFormatsResponse], error) {
+	waiting, err := s.app.CountWaiting(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &draftv1.ListMockDraftFormatsResponse{
+		Formats: make([]*draftv1.MockDraftFormat, len(Formats)),
+	}
+	for i, format := range Formats {
+		resp.Formats[i] = &draftv1.MockDraftFormat{
+			Id:              format.ID,
+			Name:            format.Name,
+			Teams:           int32(format.Teams),
+			Rounds:          int32(format.Rounds),
+			TimePerPickSec:  int32(format.TimePerPickSec),
+			ReceptionPoints: format.ReceptionPoints,
+			Superflex:       format.Superflex,
+			Waiting:         int32(waiting[format.ID]),
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// JoinMockDraftQueue queues the user for a mock draft of a format
+func (s *Service) JoinMockDraftQueue(ctx context.Context, req *connect.Request[draftv1.JoinMockDraftQueueRequest]) (*connect.Response[draftv1.JoinMockDraftQueueResponse], error) {
+	userID, err := s.requestUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.app.JoinQueue(ctx, userID, req.Msg.Format, req.Msg.TeamName)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+	waiting, err := s.app.CountWaiting(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.JoinMockDraftQueueResponse{
+		QueuedAt: timestamppb.New(entry.QueuedAt),
+		Waiting:  int32(waiting[entry.Format]),
+	}), nil
+}
+
+// LeaveMockDraftQueue takes the user out of the queue
+func (s *Service) LeaveMockDraftQueue(ctx context.Context, req *connect.Request[draftv1.LeaveMockDraftQueueRequest]) (*connect.Response[draftv1.LeaveMockDraftQueueResponse], error) {
+	userID, err := s.requestUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	left, err := s.app.LeaveQueue(ctx, userID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+	return connect.NewResponse(&draftv1.LeaveMockDraftQueueResponse{Left: left}), nil
+}
+
+// GetMockDraftStatus gets whether the user is waiting in the queue or seated in a lobby
+func (s *Service) GetMockDraftStatus(ctx context.Context, req *connect.Request[draftv1.GetMockDraftStatusRequest]) (*connect.Response[draftv1.GetMockDraftStatusResponse], error) {
+	userID, err := s.requestUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.app.GetQueueEntry(ctx, userID)
+	if errors.Is(err, ErrNotQueued) {
+		return connect.NewResponse(&draftv1.GetMockDraftStatusResponse{}), nil
+	}
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &draftv1.GetMockDraftStatusResponse{
+		Format:   &entry.Format,
+		QueuedAt: timestamppb.New(entry.QueuedAt),
+	}
+	if entry.LobbyID != nil {
+		lobby, err := s.app.GetLobby(ctx, *entry.LobbyID)
+		if err != nil {
+			return nil, s.toConnectError(err)
+		}
+		resp.Lobby = lobbyToProto(lobby)
+	}
+	if entry.FantasyTeamID != nil {
+		teamID := entry.FantasyTeamID.String()
+		resp.FantasyTeamId = &teamID
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// requestUser parses the user a request is for, who must be the signed in user if there is one
+func (s *Service) requestUser(ctx context.Context, rawUserID string) (uuid.UUID, error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", rawUserID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok && actingUser != userID {
+		return uuid.Nil, connect.NewError(connect.CodePermissionDenied, errors.New("cannot act for another user's mock drafts"))
+	}
+	return userID, nil
+}
+
+// toConnectError maps a mock draft error to a connect error
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrUnknownFormat):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrAlreadyQueued):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, ErrNotQueued), errors.Is(err, ErrLobbyNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func lobbyToProto(lobby *Lobby) *draftv1.MockDraftLobby {
+	proto := &draftv1.MockDraftLobby{
+		Id:         lobby.ID.String(),
+		Format:     lobby.Format,
+		Status:     lobbyStatusToProto(lobby.Status),
+		HumanSeats: int32(lobby.HumanSeats),
+		BotSeats:   int32(lobby.BotSeats),
+		CreatedAt:  timestamppb.New(lobby.CreatedAt),
+	}
+	if lobby.LeagueID != nil {
+		leagueID := lobby.LeagueID.String()
+		proto.LeagueId = &leagueID
+	}
+	if lobby.DraftID != nil {
+		draftID := lobby.DraftID.String()
+		proto.DraftId = &draftID
+	}
+	return proto
+}
+
+func lobbyStatusToProto(status LobbyStatus) draftv1.MockDraftLobbyStatus {
+	switch status {
+	case LobbyStatusForming:
+		return draftv1.MockDraftLobbyStatus_MOCK_DRAFT_LOBBY_STATUS_FORMING
+	case LobbyStatusDrafting:
+		return draftv1.MockDraftLobbyStatus_MOCK_DRAFT_LOBBY_STATUS_DRAFTING
+	case LobbyStatusTornDown:
+		return draftv1.MockDraftLobbyStatus_MOCK_DRAFT_LOBBY_STATUS_TORN_DOWN
+	case LobbyStatusFailed:
+		return draftv1.MockDraftLobbyStatus_MOCK_DRAFT_LOBBY_STATUS_FAILED
+	default:
+		return draftv1.MockDraftLobbyStatus_MOCK_DRAFT_LOBBY_STATUS_UNSPECIFIED
+	}
+}
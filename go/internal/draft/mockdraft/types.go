@@ -0,0 +1,149 @@
+package mockdraft
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrUnknownFormat is returned when queueing for a mock draft format that doesn't exist
+	ErrUnknownFormat = errors.New("unknown mock draft format")
+	// ErrAlreadyQueued is returned when a user already waiting for, or seated in, a mock draft
+	// queues again
+	ErrAlreadyQueued = errors.New("user is already in a mock draft")
+	// ErrNotQueued is returned when a user isn't waiting for or seated in a mock draft
+	ErrNotQueued = errors.New("user is not in a mock draft")
+	// ErrLobbyNotFound is returned when a mock draft lobby doesn't exist
+	ErrLobbyNotFound = errors.New("mock draft lobby not found")
+)
+
+// DefaultTeamName is the name of a user's team when they don't pick one
+const DefaultTeamName = "Mock Team"
+
+// HostUserID is the user that commissions every mock league. Mock leagues are recognized by it,
+// and only leagues it hosts are ever torn down.
+var HostUserID = uuid.MustParse("00000000-0000-4000-8000-00000000b000")
+
+// MaxBots is how many bot users there are to fill a lobby's empty seats
+const MaxBots = 15
+
+// BotUserID returns the user owning the nth bot seat of a lobby, counting from 1
+func BotUserID(n int) uuid.UUID {
+	return uuid.MustParse(fmt.Sprintf("00000000-0000-4000-8000-00000000b%03d", n))
+}
+
+// Format is a kind of mock draft users queue for
+type Format struct {
+	ID              string
+	Name            string
+	Teams           int
+	Rounds          int
+	TimePerPickSec  int
+	ReceptionPoints float64
+	Superflex       bool
+}
+
+// LeagueSettings returns the league_settings of a mock league of the format: its reception
+// scoring and, for superflex, a starting lineup with a superflex slot
+func (f Format) LeagueSettings() map[string]interface{} {
+	lineup := []interface{}{
+		map[string]interface{}{"name": "QB"},
+		map[string]interface{}{"name": "RB"},
+		map[string]interface{}{"name": "RB"},
+		map[string]interface{}{"name": "WR"},
+		map[string]interface{}{"name": "WR"},
+		map[string]interface{}{"name": "TE"},
+		map[string]interface{}{"name": "FLEX", "eligible": []interface{}{"RB", "WR", "TE"}},
+	}
+	if f.Superflex {
+		lineup = append(lineup, map[string]interface{}{"name": "SUPERFLEX", "eligible": []interface{}{"QB", "RB", "WR", "TE"}})
+	} else {
+		lineup = append(lineup, map[string]interface{}{"name": "K"}, map[string]interface{}{"name": "DEF"})
+	}
+
+	return map[string]interface{}{
+		models.LeagueSettingScoring:     map[string]interface{}{"reception": f.ReceptionPoints, "pass_td": 4.0},
+		models.LeagueSettingLineupSlots: lineup,
+		models.LeagueSettingBenchSlots:  float64(f.Rounds - len(lineup)),
+	}
+}
+
+// Formats are the mock draft formats users can queue for, in the order they're listed
+var Formats = []Format{
+	{ID: "12-team-ppr", Name: "12-Team PPR", Teams: 12, Rounds: 15, TimePerPickSec: 60, ReceptionPoints: 1},
+	{ID: "12-team-half-ppr", Name: "12-Team Half PPR", Teams: 12, Rounds: 15, TimePerPickSec: 60, ReceptionPoints: 0.5},
+	{ID: "12-team-superflex", Name: "12-Team Superflex PPR", Teams: 12, Rounds: 16, TimePerPickSec: 60, ReceptionPoints: 1, Superflex: true},
+	{ID: "10-team-ppr", Name: "10-Team PPR", Teams: 10, Rounds: 15, TimePerPickSec: 60, ReceptionPoints: 1},
+}
+
+// FormatByID returns the mock draft format with the given ID
+func FormatByID(id string) (Format, bool) {
+	for _, format := range Formats {
+		if format.ID == id {
+			return format, true
+		}
+	}
+	return Format{}, false
+}
+
+// LobbyStatus is where a mock draft lobby stands
+type LobbyStatus string
+
+const (
+	LobbyStatusForming  LobbyStatus = "FORMING"   // its league and draft are being created
+	LobbyStatusDrafting LobbyStatus = "DRAFTING"  // its draft is running or completed
+	LobbyStatusTornDown LobbyStatus = "TORN_DOWN" // its league and draft were deleted
+	LobbyStatusFailed   LobbyStatus = "FAILED"    // its league or draft couldn't be created
+)
+
+// Lobby is a mock draft's seats and the throwaway league and draft created for them
+type Lobby struct {
+	ID         uuid.UUID
+	Format     string
+	Status     LobbyStatus
+	LeagueID   *uuid.UUID
+	DraftID    *uuid.UUID
+	HumanSeats int
+	BotSeats   int
+	Error      *string
+	CreatedAt  time.Time
+	TornDownAt *time.Time
+}
+
+// QueueEntry is a user waiting for a mock draft, or seated in a lobby once LobbyID is set
+type QueueEntry struct {
+	UserID        uuid.UUID
+	Format        string
+	TeamName      string
+	LobbyID       *uuid.UUID
+	FantasyTeamID *uuid.UUID
+	QueuedAt      time.Time
+}
+
+// MatchmakerConfig holds configuration for the mock draft matchmaker
+type MatchmakerConfig struct {
+	Interval       time.Duration // how often the queues are matched and finished lobbies torn down
+	FillAfter      time.Duration // how long the longest waiting user waits before bots fill the empty seats
+	MaxOpenLobbies int           // lobbies forming or drafting at once; users wait for one to finish past it
+	Linger         time.Duration // how long a completed mock draft stays up for its users to look over
+	MaxLifetime    time.Duration // lobbies still drafting after this long are torn down regardless
+	FormingTimeout time.Duration // lobbies still forming after this long were left by an interrupted matchmaker
+	TeardownBatch  int           // lobbies torn down per run
+}
+
+// DefaultMatchmakerConfig returns default matchmaker configuration
+func DefaultMatchmakerConfig() MatchmakerConfig {
+	return MatchmakerConfig{
+		Interval:       10 * time.Second,
+		FillAfter:      2 * time.Minute,
+		MaxOpenLobbies: 200,
+		Linger:         time.Hour,
+		MaxLifetime:    12 * time.Hour,
+		FormingTimeout: 10 * time.Minute,
+		TeardownBatch:  50,
+	}
+}
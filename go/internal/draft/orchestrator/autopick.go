@@ -13,6 +13,7 @@ import (
 	"github.com/mcdev12/dynasty/go/internal/draft/pick"
 	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/models"
 	"github.com/rs/zerolog/log"
 )
 
@@ -20,6 +21,25 @@ type AutoPickStrategy interface {
 	// SelectClaim atomically claims the next available slot
 	// and returns a MakePickRequest ready for use by the orchestrator.
 	SelectClaim(ctx context.Context, draftID uuid.UUID) (pick.MakePickRequest, error)
+	// ChoosePlayer returns the ID of the player the strategy would pick next without
+	// claiming the slot, for comparing strategies in shadow mode
+	ChoosePlayer(ctx context.Context, draftID uuid.UUID) (string, error)
+}
+
+// StrategyFactory builds an AutoPickStrategy on top of a draft pick service client
+type StrategyFactory func(draftPickService draftv1connect.DraftPickServiceClient) AutoPickStrategy
+
+// Strategies are the auto-pick strategies that can be selected by name
+var Strategies = map[string]StrategyFactory{
+	"random": func(c draftv1connect.DraftPickServiceClient) AutoPickStrategy {
+		return NewRandomStrategy(c)
+	},
+	"best_available": func(c draftv1connect.DraftPickServiceClient) AutoPickStrategy {
+		return NewBestAvailableStrategy(c)
+	},
+	"fill_lineup": func(c draftv1connect.DraftPickServiceClient) AutoPickStrategy {
+		return NewFillLineupStrategy(c)
+	},
 }
 
 // RandomStrategy uses random choice for the player.
@@ -40,25 +60,116 @@ func NewRandomStrategy(draftPickService draftv1connect.DraftPickServiceClient) *
 
 // SelectClaim implements AutoPickStrategy.SelectClaim
 func (s *RandomStrategy) SelectClaim(ctx context.Context, draftID uuid.UUID) (pick.MakePickRequest, error) {
+	playerID, err := s.ChoosePlayer(ctx, draftID)
+	if err != nil {
+		return pick.MakePickRequest{}, err
+	}
+	return claimSlot(ctx, s.draftPickService, draftID, playerID)
+}
+
+// ChoosePlayer implements AutoPickStrategy.ChoosePlayer
+func (s *RandomStrategy) ChoosePlayer(ctx context.Context, draftID uuid.UUID) (string, error) {
 	// 2a) List available players via draft pick service
 	playersReq := &draftv1.ListAvailablePlayersForDraftRequest{
-		DraftId: draftID.String(),
+		DraftId:           draftID.String(),
+		OpenPositionsOnly: true,
 	}
 	playersResp, err := s.draftPickService.ListAvailablePlayersForDraft(ctx, connect.NewRequest(playersReq))
 	if err != nil {
-		return pick.MakePickRequest{}, fmt.Errorf("list players: %w", err)
+		return "", fmt.Errorf("list players: %w", err)
 	}
 	if len(playersResp.Msg.Players) == 0 {
-		return pick.MakePickRequest{}, fmt.Errorf("no available players")
+		return "", fmt.Errorf("no available players")
 	}
 
 	// 2b) Choose one at random
-	choice := playersResp.Msg.Players[s.rng.Intn(len(playersResp.Msg.Players))]
-	// 2c) Atomically claim the next pick slot via draft pick service
+	return playersResp.Msg.Players[s.rng.Intn(len(playersResp.Msg.Players))].Id, nil
+}
+
+// BestAvailableStrategy takes the highest ranked player left in the league's rankings
+type BestAvailableStrategy struct {
+	draftPickService draftv1connect.DraftPickServiceClient
+}
+
+// NewBestAvailableStrategy constructs a BestAvailableStrategy
+func NewBestAvailableStrategy(draftPickService draftv1connect.DraftPickServiceClient) *BestAvailableStrategy {
+	return &BestAvailableStrategy{draftPickService: draftPickService}
+}
+
+// SelectClaim implements AutoPickStrategy.SelectClaim
+func (s *BestAvailableStrategy) SelectClaim(ctx context.Context, draftID uuid.UUID) (pick.MakePickRequest, error) {
+	playerID, err := s.ChoosePlayer(ctx, draftID)
+	if err != nil {
+		return pick.MakePickRequest{}, err
+	}
+	return claimSlot(ctx, s.draftPickService, draftID, playerID)
+}
+
+// ChoosePlayer implements AutoPickStrategy.ChoosePlayer
+func (s *BestAvailableStrategy) ChoosePlayer(ctx context.Context, draftID uuid.UUID) (string, error) {
+	playersResp, err := s.draftPickService.ListAvailablePlayersForDraft(ctx, connect.NewRequest(&draftv1.ListAvailablePlayersForDraftRequest{
+		DraftId:           draftID.String(),
+		Ranked:            true,
+		OpenPositionsOnly: true,
+	}))
+	if err != nil {
+		return "", fmt.Errorf("list players: %w", err)
+	}
+	if len(playersResp.Msg.Players) == 0 {
+		return "", fmt.Errorf("no available players")
+	}
+
+	// Ranked players come first, best rank first
+	return playersResp.Msg.Players[0].Id, nil
+}
+
+// FillLineupStrategy takes the highest ranked player who could fill a starting lineup slot the
+// team hasn't filled yet, going by the league's lineup and its sport's position eligibility, and
+// the best available player once the lineup is full
+type FillLineupStrategy struct {
+	draftPickService draftv1connect.DraftPickServiceClient
+}
+
+// NewFillLineupStrategy constructs a FillLineupStrategy
+func NewFillLineupStrategy(draftPickService draftv1connect.DraftPickServiceClient) *FillLineupStrategy {
+	return &FillLineupStrategy{draftPickService: draftPickService}
+}
+
+// SelectClaim implements AutoPickStrategy.SelectClaim
+func (s *FillLineupStrategy) SelectClaim(ctx context.Context, draftID uuid.UUID) (pick.MakePickRequest, error) {
+	playerID, err := s.ChoosePlayer(ctx, draftID)
+	if err != nil {
+		return pick.MakePickRequest{}, err
+	}
+	return claimSlot(ctx, s.draftPickService, draftID, playerID)
+}
+
+// ChoosePlayer implements AutoPickStrategy.ChoosePlayer
+func (s *FillLineupStrategy) ChoosePlayer(ctx context.Context, draftID uuid.UUID) (string, error) {
+	playersResp, err := s.draftPickService.ListAvailablePlayersForDraft(ctx, connect.NewRequest(&draftv1.ListAvailablePlayersForDraftRequest{
+		DraftId:             draftID.String(),
+		Ranked:              true,
+		OpenPositionsOnly:   true,
+		OpenLineupSlotsOnly: true,
+	}))
+	if err != nil {
+		return "", fmt.Errorf("list players: %w", err)
+	}
+	if len(playersResp.Msg.Players) == 0 {
+		// Nobody left for the open slots, so fall back to the best player there's room for
+		return NewBestAvailableStrategy(s.draftPickService).ChoosePlayer(ctx, draftID)
+	}
+
+	return playersResp.Msg.Players[0].Id, nil
+}
+
+// claimSlot atomically claims the next pick slot and builds the MakePickRequest for the chosen player
+func claimSlot(ctx context.Context, draftPickService draftv1connect.DraftPickServiceClient, draftID uuid.UUID, chosenPlayerID string) (pick.MakePickRequest, error) {
+	// Atomically claim the next pick slot via draft pick service
 	claimReq := &draftv1.ClaimNextPickSlotRequest{
 		DraftId: draftID.String(),
 	}
-	claimResp, err := s.draftPickService.ClaimNextPickSlot(ctx, connect.NewRequest(claimReq))
+	claimResp, err := draftPickService.ClaimNextPickSlot(ctx, connect.NewRequest(claimReq))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return pick.MakePickRequest{}, fmt.Errorf("no available slots to claim")
@@ -68,26 +179,26 @@ func (s *RandomStrategy) SelectClaim(ctx context.Context, draftID uuid.UUID) (pi
 
 	log.Info().
 		Str("draft_id", draftID.String()).
-		Str("player_id", choice.Id).
+		Str("player_id", chosenPlayerID).
 		Msg("auto-pick picked player slot")
 
-	// 2d) Build the MakePickRequest for the orchestrator
+	// Build the MakePickRequest for the orchestrator
 	pickID, err := uuid.Parse(claimResp.Msg.Slot.PickId)
 	if err != nil {
 		return pick.MakePickRequest{}, fmt.Errorf("invalid pick ID: %w", err)
 	}
-	teamID, err := uuid.Parse(claimResp.Msg.Slot.TeamId)
+	teamID, err := models.ParseTeamID(claimResp.Msg.Slot.TeamId)
 	if err != nil {
-		return pick.MakePickRequest{}, fmt.Errorf("invalid team ID: %w", err)
+		return pick.MakePickRequest{}, err
 	}
-	playerID, err := uuid.Parse(choice.Id)
+	playerID, err := models.ParsePlayerID(chosenPlayerID)
 	if err != nil {
-		return pick.MakePickRequest{}, fmt.Errorf("invalid player ID: %w", err)
+		return pick.MakePickRequest{}, err
 	}
 
 	return pick.MakePickRequest{
 		PickID:      pickID,
-		DraftID:     draftID,
+		DraftID:     models.DraftID(draftID),
 		TeamID:      teamID,
 		PlayerID:    playerID,
 		OverallPick: int(claimResp.Msg.Slot.OverallPick),
@@ -0,0 +1,94 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// scheduleClockWarnings arms a timer for each of the draft's clock warning thresholds the pick
+// clock hasn't passed yet, replacing the warnings of the previous pick. pickTime is the full
+// clock and remaining what is left of it on the database clock.
+func (o *Orchestrator) scheduleClockWarnings(ctx context.Context, draftID uuid.UUID, deadline time.Time, remaining, pickTime time.Duration) {
+	// Abandoned teams are auto-picked on a clock too short to be worth warning about
+	if pickTime <= abandonedTeamPickTime {
+		o.cancelClockWarnings(draftID)
+		return
+	}
+
+	draft, err := o.getDraft(ctx, draftID)
+	if err != nil {
+		o.cancelClockWarnings(draftID)
+		log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to get draft for clock warnings")
+		return
+	}
+	settings := models.DraftSettings{ClockWarningPercents: make([]int, len(draft.Settings.ClockWarningPercents))}
+	for i, percent := range draft.Settings.ClockWarningPercents {
+		settings.ClockWarningPercents[i] = int(percent)
+	}
+
+	var timers []clockwork.Timer
+	for _, percent := range settings.EffectiveClockWarningPercents() {
+		// Thresholds already passed, e.g. on a clock resumed after a pause, are left out
+		d := remaining - pickTime*time.Duration(percent)/100
+		if d <= 0 {
+			continue
+		}
+		timers = append(timers, o.armClockWarning(ctx, draftID, deadline, percent, d))
+	}
+
+	o.clockWarningsMu.Lock()
+	for _, existing := range o.clockWarnings[draftID] {
+		stopAndDrainTimer(existing)
+	}
+	o.clockWarnings[draftID] = timers
+	o.clockWarningsMu.Unlock()
+
+	log.Debug().
+		Str("draft_id", draftID.String()).
+		Int("warnings", len(timers)).
+		Msg("scheduled pick clock warnings")
+}
+
+// armClockWarning starts a timer that warns the team on the clock after d that percent of the
+// clock running out at deadline is left
+func (o *Orchestrator) armClockWarning(ctx context.Context, draftID uuid.UUID, deadline time.Time, percent int, d time.Duration) clockwork.Timer {
+	timer := o.clock.NewTimer(d)
+
+	go func(id uuid.UUID, t clockwork.Timer) {
+		select {
+		case <-t.Chan():
+			// The draft service drops the warning if the clock has moved on from deadline
+			_, err := o.draftService.WarnPickClock(ctx, connect.NewRequest(&draftv1.WarnPickClockRequest{
+				DraftId:          id.String(),
+				Deadline:         timestamppb.New(deadline),
+				PercentRemaining: int32(percent),
+			}))
+			if err != nil {
+				log.Error().Err(err).Str("draft_id", id.String()).Int("percent_remaining", percent).Msg("failed to warn pick clock")
+			}
+		case <-ctx.Done():
+			stopAndDrainTimer(t)
+		}
+	}(draftID, timer)
+
+	return timer
+}
+
+// cancelClockWarnings cancels the pending pick clock warnings for a draft
+func (o *Orchestrator) cancelClockWarnings(draftID uuid.UUID) {
+	o.clockWarningsMu.Lock()
+	defer o.clockWarningsMu.Unlock()
+
+	for _, timer := range o.clockWarnings[draftID] {
+		stopAndDrainTimer(timer)
+	}
+	delete(o.clockWarnings, draftID)
+}
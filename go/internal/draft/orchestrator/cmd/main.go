@@ -6,21 +6,31 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
+	"github.com/mcdev12/dynasty/go/internal/admin"
+	"github.com/mcdev12/dynasty/go/internal/connectclient"
 	"github.com/mcdev12/dynasty/go/internal/dbconfig"
 	"github.com/mcdev12/dynasty/go/internal/draft/orchestrator"
+	"github.com/mcdev12/dynasty/go/internal/faults"
+	"github.com/mcdev12/dynasty/go/internal/flags"
 	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
-	"github.com/nats-io/nats.go"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
 func main() {
+	// Strategy authors compare auto-pick strategies offline: orchestrator simulate -h
+	if len(os.Args) > 1 && os.Args[1] == "simulate" {
+		os.Exit(runSimulate(os.Args[2:]))
+	}
+
 	// Load .env file if it exists
 	if err := godotenv.Load(); err != nil {
 		log.Warn().Err(err).Msg("could not load .env file")
@@ -32,7 +42,23 @@ func main() {
 
 	// Get configuration
 	draftServiceURL := getEnv("DRAFT_SERVICE_URL", "http://localhost:8080")
-	natsURL := getEnv("NATS_URL", nats.DefaultURL)
+	natsCfg := natsconfig.NewConfigFromEnv()
+	timeoutGrace := orchestrator.DefaultTimeoutGrace
+	if v := os.Getenv("PICK_TIMEOUT_GRACE"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			log.Fatal().Str("value", v).Msg("invalid PICK_TIMEOUT_GRACE")
+		}
+		timeoutGrace = d
+	}
+	loadCfg := orchestrator.DefaultLoadConfig()
+	if v := os.Getenv("BEHIND_SCHEDULE_AFTER"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			log.Fatal().Str("value", v).Msg("invalid BEHIND_SCHEDULE_AFTER")
+		}
+		loadCfg.BehindAfter = d
+	}
 
 	// Database configuration
 	dbCfg := dbconfig.NewConfigFromEnv()
@@ -52,27 +78,82 @@ func main() {
 	log.Info().
 		Str("database", dbCfg.Database).
 		Str("draft_service_url", draftServiceURL).
-		Str("nats_url", natsURL).
+		Str("nats_url", natsCfg.URL).
+		Dur("pick_timeout_grace", timeoutGrace).
+		Dur("behind_schedule_after", loadCfg.BehindAfter).
 		Msg("starting draft orchestrator")
 
-	// Setup HTTP client for gRPC Connect
-	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
+	// Shared Connect client settings for calls to the draft service
+	clientCfg := connectclient.DefaultConfig(draftServiceURL)
+	clientCfg.H2C = getEnv("RPC_H2C", "true") == "true"
+	if v := os.Getenv("RPC_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid RPC_TIMEOUT")
+		}
+		clientCfg.DefaultTimeout = d
+	}
+	if v := os.Getenv("RPC_METHOD_TIMEOUTS"); v != "" {
+		timeouts, err := connectclient.ParseMethodTimeouts(v)
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid RPC_METHOD_TIMEOUTS")
+		}
+		clientCfg.MethodTimeouts = timeouts
+	}
+	if v := os.Getenv("RPC_MAX_RETRIES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			log.Fatal().Str("value", v).Msg("invalid RPC_MAX_RETRIES")
+		}
+		clientCfg.MaxRetries = n
+	}
+	// Sign calls so the draft service accepts orchestrator-only RPCs
+	if secret := os.Getenv("SERVICE_AUTH_SECRET"); secret != "" {
+		clientCfg.ServiceName = getEnv("SERVICE_NAME", "orchestrator")
+		clientCfg.ServiceSecret = []byte(secret)
+	} else {
+		log.Warn().Msg("SERVICE_AUTH_SECRET not set, calls to the draft service are unauthenticated")
+	}
+	// Fault injection for testing the pipeline under failure; off unless FAULTS_ENABLED is set
+	faultCfg, err := faults.ConfigFromEnv()
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid fault injection config")
+	}
+	if clientCfg.Faults = faults.NewInjector(faultCfg); clientCfg.Faults != nil {
+		log.Warn().Float64("rpc_error_rate", faultCfg.RPCErrorRate).Msg("fault injection enabled, calls to the draft service will fail on purpose")
 	}
+	clientFactory := connectclient.NewFactory(clientCfg)
+	defer clientFactory.Close()
 
 	// Create gRPC service clients
-	draftServiceClient := draftv1connect.NewDraftServiceClient(httpClient, draftServiceURL)
-	draftPickServiceClient := draftv1connect.NewDraftPickServiceClient(httpClient, draftServiceURL)
+	draftServiceClient := connectclient.New(clientFactory, draftv1connect.NewDraftServiceClient)
+	draftPickServiceClient := connectclient.New(clientFactory, draftv1connect.NewDraftPickServiceClient)
 
 	// Create autopick strategy
 	randStrat := orchestrator.NewRandomStrategy(draftPickServiceClient)
 
+	// Feature flags pick other strategies, and shadow ones, per league
+	var featureFlags *flags.Client
+	if getEnv("FEATURE_FLAGS_ENABLED", "false") == "true" {
+		flagsCtx, flagsCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		featureFlags, err = flags.Dial(flagsCtx, natsCfg, getEnv("FEATURE_FLAGS_BUCKET", flags.DefaultBucket))
+		flagsCancel()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load feature flags")
+		}
+		defer featureFlags.Close()
+	}
+
 	// Create orchestrator
 	orch, err := orchestrator.NewOrchestrator(
 		draftServiceClient,
 		draftPickServiceClient,
 		randStrat,
-		natsURL,
+		natsCfg,
+		db,
+		timeoutGrace,
+		loadCfg,
+		featureFlags,
 	)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create orchestrator")
@@ -91,12 +172,30 @@ func main() {
 	}()
 	defer orch.Close()
 
-	// Add health check endpoint
+	// Add health check endpoint; load metrics are served at /debug/vars
 	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
 
+	// Runtime debug endpoints for looking into a misbehaving orchestrator mid-draft
+	admin.Mount(http.DefaultServeMux, admin.Config{
+		Service: "orchestrator",
+		Token:   os.Getenv("ADMIN_API_TOKEN"),
+		Settings: func() any {
+			return map[string]any{
+				"draft_service_url":     draftServiceURL,
+				"nats_url":              natsCfg.URL,
+				"pick_timeout_grace":    timeoutGrace.String(),
+				"behind_schedule_after": loadCfg.BehindAfter.String(),
+				"rpc_timeout":           clientCfg.DefaultTimeout.String(),
+				"rpc_max_retries":       clientCfg.MaxRetries,
+				"feature_flags":         featureFlags != nil,
+				"fault_injection":       faultCfg.Enabled(),
+			}
+		},
+	})
+
 	// Start HTTP server for health checks
 	server := &http.Server{
 		Addr:         ":8082", // Different port from main service
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/mcdev12/dynasty/go/internal/draft/orchestrator"
+	"github.com/mcdev12/dynasty/go/internal/draft/simulation"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/rs/zerolog"
+)
+
+// runSimulate implements the simulate subcommand, which runs in-memory drafts between
+// auto-pick strategies and prints a comparison. It returns the process exit code.
+func runSimulate(args []string) int {
+	fs := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: orchestrator simulate -pool players.json [flags]\n\n")
+		fmt.Fprintf(fs.Output(), "The pool is a JSON array of {\"id\", \"name\", \"position\", \"rank\", \"projected_points\"}\n")
+		fmt.Fprintf(fs.Output(), "ranked for the simulated scoring format. Available strategies: %s\n\n", strings.Join(strategyNames(), ", "))
+		fs.PrintDefaults()
+	}
+	poolPath := fs.String("pool", "", "path to the player pool JSON file")
+	drafts := fs.Int("drafts", 100, "number of drafts to simulate")
+	teams := fs.Int("teams", 12, "teams per draft")
+	rounds := fs.Int("rounds", 15, "rounds per draft")
+	strategies := fs.String("strategies", strings.Join(strategyNames(), ","), "comma separated strategies to compare")
+	scoring := fs.String("scoring", string(models.ScoringFormatPPR), "scoring format the pool is ranked for: STANDARD, HALF_PPR or PPR")
+	superflex := fs.Bool("superflex", false, "the pool is ranked for superflex leagues")
+	asJSON := fs.Bool("json", false, "print the report as JSON")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if *poolPath == "" {
+		fmt.Fprintln(os.Stderr, "simulate: -pool is required")
+		fs.Usage()
+		return 2
+	}
+
+	format := models.ScoringFormat(strings.ToUpper(*scoring))
+	switch format {
+	case models.ScoringFormatStandard, models.ScoringFormatHalfPPR, models.ScoringFormatPPR:
+	default:
+		fmt.Fprintf(os.Stderr, "simulate: unknown scoring format %q\n", *scoring)
+		return 2
+	}
+
+	cfg := simulation.Config{
+		Drafts: *drafts,
+		League: simulation.LeagueSettings{
+			Teams:         *teams,
+			Rounds:        *rounds,
+			ScoringFormat: format,
+			Superflex:     *superflex,
+		},
+	}
+	for _, name := range strings.Split(*strategies, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		factory, ok := orchestrator.Strategies[name]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "simulate: unknown strategy %q, available: %s\n", name, strings.Join(strategyNames(), ", "))
+			return 2
+		}
+		cfg.Strategies = append(cfg.Strategies, simulation.Strategy{Name: name, Factory: factory})
+	}
+
+	data, err := os.ReadFile(*poolPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "simulate: read pool: %v\n", err)
+		return 1
+	}
+	if err := json.Unmarshal(data, &cfg.Pool); err != nil {
+		fmt.Fprintf(os.Stderr, "simulate: parse pool: %v\n", err)
+		return 1
+	}
+
+	// Strategies log every pick they make; only problems are worth showing here
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	report, err := simulation.Run(ctx, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "simulate: %v\n", err)
+		return 1
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	} else {
+		err = report.WriteText(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "simulate: write report: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func strategyNames() []string {
+	names := make([]string, 0, len(orchestrator.Strategies))
+	for name := range orchestrator.Strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,115 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/rs/zerolog/log"
+)
+
+// handleCommissionerPresenceChangedEvent pauses a draft whose commissioner left its room once
+// they've been away for the draft's wait, and resumes a draft paused that way when they're back
+func (o *Orchestrator) handleCommissionerPresenceChangedEvent(ctx context.Context, draftID uuid.UUID, payload events.CommissionerPresenceChangedPayload) error {
+	if !payload.Online {
+		if payload.PausesAt == nil {
+			// The draft wasn't in progress when the commissioner left
+			return nil
+		}
+		o.armCommissionerTimer(ctx, draftID, payload.PausesAt.Sub(o.clock.Now()))
+		log.Info().
+			Str("draft_id", draftID.String()).
+			Str("user_id", payload.UserID).
+			Time("pauses_at", *payload.PausesAt).
+			Msg("commissioner disconnected, scheduled pause")
+		return nil
+	}
+
+	o.cancelCommissionerTimer(draftID)
+
+	_, err := o.draftService.ResumeDraft(ctx, connect.NewRequest(&draftv1.ResumeDraftRequest{
+		DraftId:                 draftID.String(),
+		CommissionerReconnected: true,
+	}))
+	if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+		// The draft wasn't paused for the commissioner's disconnect
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to resume draft: %w", err)
+	}
+
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Str("user_id", payload.UserID).
+		Msg("commissioner reconnected, resumed draft")
+	return nil
+}
+
+// pauseForCommissioner pauses the draft for its commissioner's disconnect if it is still in
+// progress
+func (o *Orchestrator) pauseForCommissioner(ctx context.Context, draftID uuid.UUID) error {
+	_, err := o.draftService.PauseDraft(ctx, connect.NewRequest(&draftv1.PauseDraftRequest{
+		DraftId:                  draftID.String(),
+		CommissionerDisconnected: true,
+	}))
+	if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to pause draft: %w", err)
+	}
+
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Msg("paused draft while its commissioner is disconnected")
+	return nil
+}
+
+// armCommissionerTimer pauses the draft after d, replacing any pending commissioner timer
+func (o *Orchestrator) armCommissionerTimer(ctx context.Context, draftID uuid.UUID, d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	timer := o.clock.NewTimer(d)
+
+	o.commissionerTimersMu.Lock()
+	if existing, ok := o.commissionerTimers[draftID]; ok {
+		stopAndDrainTimer(existing)
+	}
+	o.commissionerTimers[draftID] = timer
+	o.commissionerTimersMu.Unlock()
+
+	go func(id uuid.UUID, t clockwork.Timer) {
+		select {
+		case <-t.Chan():
+			o.commissionerTimersMu.Lock()
+			if o.commissionerTimers[id] == t {
+				delete(o.commissionerTimers, id)
+			}
+			o.commissionerTimersMu.Unlock()
+
+			if err := o.pauseForCommissioner(ctx, id); err != nil {
+				log.Error().Err(err).Str("draft_id", id.String()).Msg("failed to pause draft for disconnected commissioner")
+			}
+		case <-ctx.Done():
+			stopAndDrainTimer(t)
+		}
+	}(draftID, timer)
+}
+
+// cancelCommissionerTimer cancels any pending commissioner timer for a draft
+func (o *Orchestrator) cancelCommissionerTimer(draftID uuid.UUID) {
+	o.commissionerTimersMu.Lock()
+	defer o.commissionerTimersMu.Unlock()
+
+	if timer, ok := o.commissionerTimers[draftID]; ok {
+		stopAndDrainTimer(timer)
+		delete(o.commissionerTimers, draftID)
+	}
+}
@@ -6,13 +6,15 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/rs/zerolog/log"
 )
 
 // setupNATSConnection creates a NATS connection with JetStream
-func setupNATSConnection(natsURL string) (*nats.Conn, jetstream.JetStream, error) {
+func setupNATSConnection(natsCfg natsconfig.Config) (*nats.Conn, jetstream.JetStream, error) {
 	opts := []nats.Option{
 		nats.MaxReconnects(natsMaxReconnects),
 		nats.ReconnectWait(natsReconnectWait),
@@ -27,7 +29,7 @@ func setupNATSConnection(natsURL string) (*nats.Conn, jetstream.JetStream, error
 		}),
 	}
 
-	nc, err := nats.Connect(natsURL, opts...)
+	nc, err := natsCfg.Connect(opts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("connect to NATS: %w", err)
 	}
@@ -41,9 +43,10 @@ func setupNATSConnection(natsURL string) (*nats.Conn, jetstream.JetStream, error
 	return nc, js, nil
 }
 
-// ensureConsumer creates or gets the JetStream consumer
+// ensureConsumer creates or gets the JetStream consumer. The orchestrator only acts on
+// lifecycle and pick events, so it reads every subject on their stream.
 func (o *Orchestrator) ensureConsumer(ctx context.Context) error {
-	stream, err := o.js.Stream(ctx, "DRAFT_EVENTS")
+	stream, err := o.js.Stream(ctx, events.StateStream)
 	if err != nil {
 		return fmt.Errorf("get stream: %w", err)
 	}
@@ -52,7 +55,6 @@ func (o *Orchestrator) ensureConsumer(ctx context.Context) error {
 		Name:          consumerName,
 		Durable:       consumerName,
 		Description:   "Draft orchestrator event consumer with startup replay",
-		FilterSubject: "draft.events.>",
 		DeliverPolicy: jetstream.DeliverAllPolicy, // Replay all events for recovery
 		AckPolicy:     jetstream.AckExplicitPolicy,
 		MaxDeliver:    consumerMaxDeliver,
@@ -0,0 +1,61 @@
+package orchestrator
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// FlagAutoPickStrategy auto-picks with the strategy named by its value, one of Strategies,
+	// for the leagues it is on for
+	FlagAutoPickStrategy = "orchestrator.autopick_strategy"
+	// FlagAutoPickShadow has the strategy named by its value choose alongside every auto-pick
+	// for the leagues it is on for, logging whether it would have picked the same player. The
+	// shadow strategy never claims a slot.
+	FlagAutoPickShadow = "orchestrator.autopick_shadow"
+)
+
+// autoPickStrategies returns the strategy to auto-pick a draft's pick with, and the shadow
+// strategy and its name when the draft's league has one. Drafts whose league can't be
+// looked up auto-pick with the default strategy.
+func (o *Orchestrator) autoPickStrategies(ctx context.Context, draftID uuid.UUID) (AutoPickStrategy, AutoPickStrategy, string) {
+	if o.flags == nil {
+		return o.strat, nil, ""
+	}
+
+	draftResp, err := o.draftService.GetDraft(ctx, connect.NewRequest(&draftv1.GetDraftRequest{
+		DraftId: draftID.String(),
+	}))
+	if err != nil {
+		log.Warn().Err(err).Str("draft_id", draftID.String()).Msg("failed to get draft for auto-pick flags, using the default strategy")
+		return o.strat, nil, ""
+	}
+	leagueID, err := uuid.Parse(draftResp.Msg.Draft.GetLeagueId())
+	if err != nil {
+		return o.strat, nil, ""
+	}
+
+	strat := o.strat
+	if name, ok := o.flags.Value(FlagAutoPickStrategy, leagueID); ok {
+		if flagged, ok := o.strategies[name]; ok {
+			strat = flagged
+		} else {
+			log.Warn().Str("strategy", name).Str("flag", FlagAutoPickStrategy).Msg("unknown auto-pick strategy in flag, using the default strategy")
+		}
+	}
+
+	name, ok := o.flags.Value(FlagAutoPickShadow, leagueID)
+	if !ok {
+		return strat, nil, ""
+	}
+	shadow, ok := o.strategies[name]
+	if !ok {
+		log.Warn().Str("strategy", name).Str("flag", FlagAutoPickShadow).Msg("unknown auto-pick strategy in flag, skipping shadow mode")
+		return strat, nil, ""
+	}
+	return strat, shadow, name
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
 	"github.com/rs/zerolog/log"
 )
 
@@ -60,6 +61,55 @@ func (o *Orchestrator) HandleDomainEvent(ctx context.Context, eventType string,
 		}
 		return o.handlePickMadeEvent(ctx, draftID, pickMadePayload)
 
+	case "PickSkipped":
+		var pickSkippedPayload events.PickSkippedPayload
+		if err := json.Unmarshal(payload, &pickSkippedPayload); err != nil {
+			return fmt.Errorf("failed to unmarshal PickSkipped payload: %w", err)
+		}
+		return o.handlePickSkippedEvent(ctx, draftID, pickSkippedPayload)
+
+	case "PickClockExtended":
+		var pickClockExtendedPayload events.PickClockExtendedPayload
+		if err := json.Unmarshal(payload, &pickClockExtendedPayload); err != nil {
+			return fmt.Errorf("failed to unmarshal PickClockExtended payload: %w", err)
+		}
+		return o.handlePickClockExtendedEvent(ctx, draftID, pickClockExtendedPayload)
+
+	case "TeamAbandoned":
+		var teamAbandonedPayload events.TeamAbandonedPayload
+		if err := json.Unmarshal(payload, &teamAbandonedPayload); err != nil {
+			return fmt.Errorf("failed to unmarshal TeamAbandoned payload: %w", err)
+		}
+		return o.handleTeamAbandonedEvent(ctx, draftID, teamAbandonedPayload)
+
+	case "TeamRestored":
+		var teamRestoredPayload events.TeamRestoredPayload
+		if err := json.Unmarshal(payload, &teamRestoredPayload); err != nil {
+			return fmt.Errorf("failed to unmarshal TeamRestored payload: %w", err)
+		}
+		return o.handleTeamRestoredEvent(ctx, draftID, teamRestoredPayload)
+
+	case "PauseVoteUpdated":
+		var pauseVotePayload events.PauseVoteUpdatedPayload
+		if err := json.Unmarshal(payload, &pauseVotePayload); err != nil {
+			return fmt.Errorf("failed to unmarshal PauseVoteUpdated payload: %w", err)
+		}
+		return o.handlePauseVoteUpdatedEvent(ctx, draftID, pauseVotePayload)
+
+	case "PickTradeUpdated":
+		var pickTradePayload events.PickTradeUpdatedPayload
+		if err := json.Unmarshal(payload, &pickTradePayload); err != nil {
+			return fmt.Errorf("failed to unmarshal PickTradeUpdated payload: %w", err)
+		}
+		return o.handlePickTradeUpdatedEvent(ctx, draftID, pickTradePayload)
+
+	case "CommissionerPresenceChanged":
+		var presencePayload events.CommissionerPresenceChangedPayload
+		if err := json.Unmarshal(payload, &presencePayload); err != nil {
+			return fmt.Errorf("failed to unmarshal CommissionerPresenceChanged payload: %w", err)
+		}
+		return o.handleCommissionerPresenceChangedEvent(ctx, draftID, presencePayload)
+
 	case "DraftCompleted":
 		// For DraftCompleted, clean up tracking maps and log completion
 		log.Info().
@@ -73,6 +123,10 @@ func (o *Orchestrator) HandleDomainEvent(ctx context.Context, eventType string,
 
 		// Cancel any active timer for this draft
 		o.cancelTimer(draftID)
+		o.cancelWindowTimer(draftID)
+		o.cancelVoteTimer(draftID)
+		o.cancelTradeTimer(draftID)
+		o.cancelCommissionerTimer(draftID)
 
 		return nil
 
@@ -91,12 +145,88 @@ func (o *Orchestrator) handlePickMadeEvent(ctx context.Context, draftID uuid.UUI
 		Str("draft_id", draftID.String()).
 		Str("pick_id", pickPayload.PickID).
 		Int("overall_pick", pickPayload.OverallPick).
+		Bool("late", pickPayload.Late).
 		Msg("handling PickMade event")
 
+	// A late pick fills a skipped slot while another pick is on the clock, which keeps its timer
+	if pickPayload.Late {
+		return nil
+	}
+
 	// Schedule next pick timeout using current time as base
 	return o.scheduleNextPick(ctx, draftID, o.clock.Now())
 }
 
+// handlePickSkippedEvent puts the next pick on the clock once the draft has moved past a
+// pick whose clock ran out
+func (o *Orchestrator) handlePickSkippedEvent(ctx context.Context, draftID uuid.UUID, payload events.PickSkippedPayload) error {
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Str("pick_id", payload.PickID).
+		Str("team_id", payload.TeamID).
+		Int("overall_pick", payload.OverallPick).
+		Bool("forfeited", payload.Forfeited).
+		Msg("handling PickSkipped event")
+
+	if err := o.scheduleNextPick(ctx, draftID, o.clock.Now()); err != nil {
+		return err
+	}
+	// Forfeiting the pick may have left none to make
+	if payload.Forfeited {
+		return o.finalizeIfComplete(ctx, draftID)
+	}
+	return nil
+}
+
+// handlePickClockExtendedEvent re-arms the pick timer and clock warnings for the deadline the
+// commissioner's extension pushed back
+func (o *Orchestrator) handlePickClockExtendedEvent(ctx context.Context, draftID uuid.UUID, payload events.PickClockExtendedPayload) error {
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Str("pick_id", payload.PickID).
+		Str("team_id", payload.TeamID).
+		Int("extension_sec", payload.ExtensionSec).
+		Time("timeout_at", payload.TimeoutAt).
+		Msg("handling PickClockExtended event")
+
+	return o.resumePickClock(ctx, draftID, payload.ExtendedAt)
+}
+
+// handleTeamAbandonedEvent moves the pick clock on when the abandoned team was on it: to
+// the next team when its picks are skipped, or to an immediate auto-pick otherwise
+func (o *Orchestrator) handleTeamAbandonedEvent(ctx context.Context, draftID uuid.UUID, payload events.TeamAbandonedPayload) error {
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Str("team_id", payload.FantasyTeamID).
+		Str("pick_handling", payload.PickHandling).
+		Int("forfeited_picks", len(payload.ForfeitedPickIDs)).
+		Msg("handling TeamAbandoned event")
+
+	if !payload.OnTheClock {
+		return nil
+	}
+	if err := o.scheduleNextPick(ctx, draftID, payload.AbandonedAt); err != nil {
+		return err
+	}
+	// Skipping the team's picks may have left none to make
+	return o.finalizeIfComplete(ctx, draftID)
+}
+
+// handleTeamRestoredEvent restarts the pick clock when the restored team is back on it, so
+// its owner gets the full pick time rather than an abandoned team's
+func (o *Orchestrator) handleTeamRestoredEvent(ctx context.Context, draftID uuid.UUID, payload events.TeamRestoredPayload) error {
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Str("team_id", payload.FantasyTeamID).
+		Int("restored_picks", len(payload.RestoredPickIDs)).
+		Msg("handling TeamRestored event")
+
+	if !payload.OnTheClock {
+		return nil
+	}
+	return o.scheduleNextPick(ctx, draftID, payload.RestoredAt)
+}
+
 // handleDraftStartedEvent handles a DraftStarted domain event by setting up the first pick timer
 func (o *Orchestrator) handleDraftStartedEvent(ctx context.Context, draftID uuid.UUID, payload events.DraftStartedPayload) error {
 	log.Info().
@@ -106,7 +236,10 @@ func (o *Orchestrator) handleDraftStartedEvent(ctx context.Context, draftID uuid
 		Msg("handling DraftStarted event")
 
 	// Schedule first pick timeout using draft start time as base
-	return o.scheduleNextPick(ctx, draftID, payload.StartedAt)
+	if err := o.scheduleNextPick(ctx, draftID, payload.StartedAt); err != nil {
+		return err
+	}
+	return o.schedulePauseWindow(ctx, draftID)
 }
 
 // handleDraftPausedEvent handles a DraftPaused domain event by cancelling timers
@@ -118,6 +251,13 @@ func (o *Orchestrator) handleDraftPausedEvent(ctx context.Context, draftID uuid.
 
 	// Cancel the active timer to stop timeout processing
 	o.cancelTimer(draftID)
+
+	// A pause window pause lifts itself when the window closes; a manual one waits for a manual resume
+	if payload.Scheduled && payload.ResumesAt != nil {
+		o.scheduleWindowResume(ctx, draftID, *payload.ResumesAt)
+	} else {
+		o.cancelWindowTimer(draftID)
+	}
 	return nil
 }
 
@@ -127,16 +267,85 @@ func (o *Orchestrator) handleDraftResumedEvent(ctx context.Context, draftID uuid
 		Str("draft_id", draftID.String()).
 		Msg("handling DraftResumed event")
 
-	// Restart the pick timer using resume time as base
-	// TODO: should resume from remaining time instead of full timeout
-	return o.scheduleNextPick(ctx, draftID, payload.ResumedAt)
+	// The draft service has already set the pick deadline: restarting the clock after a
+	// pause window, or carrying it on from where it stood after a manual pause
+	if err := o.resumePickClock(ctx, draftID, payload.ResumedAt); err != nil {
+		return err
+	}
+	return o.schedulePauseWindow(ctx, draftID)
 }
 
 func (o *Orchestrator) handleTimeout(ctx context.Context, draftID uuid.UUID) error {
 	log.Info().Str("draft_id", draftID.String()).Msg("auto-pick timeout firing")
 
-	// 1) Attempt to claim the next slot
-	req, err := o.strat.SelectClaim(ctx, draftID)
+	// Only one orchestrator instance handles a draft's timeout at a time. The pick and
+	// completion calls below take the draft's state lock in the draft service, so the
+	// auto-pick can't interleave with a pause or a manual pick.
+	release, ok, err := sqlutil.TryLockSession(ctx, o.db, sqlutil.LockClassDraftTimeout, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to lock draft timeout: %w", err)
+	}
+	if !ok {
+		log.Info().
+			Str("draft_id", draftID.String()).
+			Str("instance_id", o.instanceID).
+			Msg("draft timeout already being handled by another instance")
+		return nil
+	}
+	defer release()
+
+	// The timer ran on this host's clock; confirm the deadline has passed on the database
+	// clock it was set against before auto-picking
+	draftIDStr := draftID.String()
+	deadlineResp, err := o.draftService.FetchNextDeadline(ctx, connect.NewRequest(&draftv1.FetchNextDeadlineRequest{
+		DraftId: &draftIDStr,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to fetch pick deadline: %w", err)
+	}
+	next := deadlineResp.Msg.NextDeadline
+	if next == nil || next.Deadline == nil {
+		log.Info().Str("draft_id", draftIDStr).Msg("skipping auto-pick, draft has no pick deadline")
+		return nil
+	}
+	if remaining := next.Deadline.AsTime().Sub(deadlineResp.Msg.ServerTime.AsTime()); remaining > 0 {
+		log.Info().
+			Str("draft_id", draftIDStr).
+			Dur("remaining", remaining).
+			Msg("timer fired before the deadline, rescheduling")
+		o.armTimer(ctx, draftID, remaining+o.timeoutGrace)
+		return nil
+	}
+
+	// A team with a full roster can't make its pick, so it is forfeited rather than auto-picked
+	forfeited, err := o.skipPickWithoutRosterSpace(ctx, draftID, true)
+	if err != nil {
+		return err
+	}
+	if forfeited {
+		return nil
+	}
+
+	// Drafts that defer picks on timeout move past the pick instead of auto-picking it
+	skipped, err := o.skipExpiredPick(ctx, draftID)
+	if err != nil {
+		return err
+	}
+	if skipped {
+		return nil
+	}
+
+	// 1) Attempt to claim the next slot, with the strategy the draft's league is flagged for
+	strat, shadow, shadowName := o.autoPickStrategies(ctx, draftID)
+	var shadowPlayerID string
+	if shadow != nil {
+		// The shadow strategy chooses from the same board, before the pick changes it
+		shadowPlayerID, err = shadow.ChoosePlayer(ctx, draftID)
+		if err != nil {
+			log.Warn().Err(err).Str("draft_id", draftID.String()).Str("shadow_strategy", shadowName).Msg("shadow auto-pick strategy failed")
+		}
+	}
+	req, err := strat.SelectClaim(ctx, draftID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || err.Error() == "no available slots to claim" {
 			// ← no slots left ⇒ finalize the draft now
@@ -145,6 +354,15 @@ func (o *Orchestrator) handleTimeout(ctx context.Context, draftID uuid.UUID) err
 		log.Warn().Err(err).Msg("auto-pick strategy failed")
 		return nil
 	}
+	if shadowPlayerID != "" {
+		log.Info().
+			Str("draft_id", draftID.String()).
+			Str("shadow_strategy", shadowName).
+			Str("player_id", req.PlayerID.String()).
+			Str("shadow_player_id", shadowPlayerID).
+			Bool("agree", shadowPlayerID == req.PlayerID.String()).
+			Msg("shadow auto-pick")
+	}
 
 	// 2) We got a slot—record the pick via gRPC (this will emit PickMade event)
 	protoReq := &draftv1.MakePickRequest{
@@ -155,6 +373,11 @@ func (o *Orchestrator) handleTimeout(ctx context.Context, draftID uuid.UUID) err
 		OverallPick: int32(req.OverallPick),
 	}
 	_, err = o.draftPickService.MakePick(ctx, connect.NewRequest(protoReq))
+	if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+		// The draft was paused or completed while the timer was firing
+		log.Info().Err(err).Str("draft_id", draftID.String()).Msg("skipping auto-pick, draft no longer in progress")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("auto-pick MakePick failed: %w", err)
 	}
@@ -163,7 +386,100 @@ func (o *Orchestrator) handleTimeout(ctx context.Context, draftID uuid.UUID) err
 	return o.finalizeIfComplete(ctx, draftID)
 }
 
+// skipExpiredPick skips the pick on the clock when the draft defers picks on timeout,
+// reporting whether it did. The PickSkipped event it emits puts the next pick on the clock.
+// Picks already skipped once, back on the clock at the end of the draft, and picks of
+// abandoned teams set to auto-pick are auto-picked as usual.
+func (o *Orchestrator) skipExpiredPick(ctx context.Context, draftID uuid.UUID) (bool, error) {
+	draftResp, err := o.draftService.GetDraft(ctx, connect.NewRequest(&draftv1.GetDraftRequest{
+		DraftId: draftID.String(),
+	}))
+	if err != nil {
+		return false, fmt.Errorf("failed to get draft: %w", err)
+	}
+	if !draftResp.Msg.Draft.GetSettings().GetDeferPicksOnTimeout() {
+		return false, nil
+	}
+
+	nextResp, err := o.draftPickService.GetNextPickForDraft(ctx, connect.NewRequest(&draftv1.GetNextPickForDraftRequest{
+		DraftId: draftID.String(),
+	}))
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get next pick: %w", err)
+	}
+	next := nextResp.Msg.Pick
+	if next == nil || next.SkippedAt != nil {
+		return false, nil
+	}
+
+	autoPickTeams, err := o.autoPickTeams(ctx, draftID)
+	if err != nil {
+		return false, err
+	}
+	if autoPickTeams[next.TeamId] {
+		return false, nil
+	}
+
+	_, err = o.draftPickService.SkipPick(ctx, connect.NewRequest(&draftv1.SkipPickRequest{
+		PickId:  next.Id,
+		DraftId: draftID.String(),
+	}))
+	if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+		// The draft was paused or the pick was made while the timer was firing
+		log.Info().Err(err).Str("draft_id", draftID.String()).Msg("pick no longer on an expired clock, not skipping it")
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to skip pick: %w", err)
+	}
+
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Str("pick_id", next.Id).
+		Str("team_id", next.TeamId).
+		Msg("skipped expired pick")
+	return true, nil
+}
+
+// skipPickWithoutRosterSpace forfeits the pick on the clock when its team's roster is full,
+// reporting whether it did: as it comes on the clock in drafts set to skip such picks, or once
+// clockExpired. The PickSkipped event it emits puts the next pick on the clock.
+func (o *Orchestrator) skipPickWithoutRosterSpace(ctx context.Context, draftID uuid.UUID, clockExpired bool) (bool, error) {
+	resp, err := o.draftPickService.SkipPickWithoutRosterSpace(ctx, connect.NewRequest(&draftv1.SkipPickWithoutRosterSpaceRequest{
+		DraftId:      draftID.String(),
+		ClockExpired: clockExpired,
+	}))
+	if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+		// The draft was paused or completed while the pick came on the clock
+		log.Info().Err(err).Str("draft_id", draftID.String()).Msg("not checking roster space, draft no longer in progress")
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to skip pick without roster space: %w", err)
+	}
+	if resp.Msg.Pick == nil {
+		return false, nil
+	}
+
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Str("pick_id", resp.Msg.Pick.Id).
+		Str("team_id", resp.Msg.Pick.TeamId).
+		Msg("forfeited pick of team with a full roster")
+	return true, nil
+}
+
+// finalizeIfComplete completes the draft once it has no picks left to make. While the
+// orchestrator is behind schedule the check is deferred to the next batched one.
 func (o *Orchestrator) finalizeIfComplete(ctx context.Context, draftID uuid.UUID) error {
+	if o.behind.Load() {
+		o.deferCompletionCheck(draftID)
+		return nil
+	}
+
 	remResp, err := o.draftPickService.CountRemainingPicks(ctx, connect.NewRequest(&draftv1.CountRemainingPicksRequest{
 		DraftId: draftID.String(),
 	}))
@@ -174,12 +490,16 @@ func (o *Orchestrator) finalizeIfComplete(ctx context.Context, draftID uuid.UUID
 	if rem > 0 {
 		return nil
 	}
+	return o.completeDraft(ctx, draftID)
+}
 
+// completeDraft marks a draft that has made its last pick completed and clears its pick clock
+func (o *Orchestrator) completeDraft(ctx context.Context, draftID uuid.UUID) error {
 	// Mark draft completed via draft service (this will emit DraftCompleted event)
 	completeReq := &draftv1.CompleteDraftRequest{
 		DraftId: draftID.String(),
 	}
-	_, err = o.draftService.CompleteDraft(ctx, connect.NewRequest(completeReq))
+	_, err := o.draftService.CompleteDraft(ctx, connect.NewRequest(completeReq))
 	if err != nil {
 		return err
 	}
@@ -193,6 +513,10 @@ func (o *Orchestrator) finalizeIfComplete(ctx context.Context, draftID uuid.UUID
 	return nil
 }
 
+// getPickTime returns the pick clock for the draft's upcoming pick, applying the
+// draft's per-round timer override for that pick's round when there is one. Picks of
+// abandoned teams set to auto-pick get abandonedTeamPickTime. Auction picks are untimed
+// here; the auction runner keeps nominations and bidding on the clock.
 func (o *Orchestrator) getPickTime(ctx context.Context, draftID uuid.UUID) (time.Duration, error) {
 	getReq := &draftv1.GetDraftRequest{
 		DraftId: draftID.String(),
@@ -202,7 +526,57 @@ func (o *Orchestrator) getPickTime(ctx context.Context, draftID uuid.UUID) (time
 		return 0, err
 	}
 	draft := draftResp.Msg.Draft
+	if draft.DraftType == draftv1.DraftType_DRAFT_TYPE_AUCTION {
+		return 0, nil
+	}
+
+	autoPickTeams, err := o.autoPickTeams(ctx, draftID)
+	if err != nil {
+		return 0, err
+	}
 
 	secs := draft.Settings.TimePerPickSec
+	if len(draft.Settings.RoundTimers) > 0 || len(autoPickTeams) > 0 {
+		nextResp, err := o.draftPickService.GetNextPickForDraft(ctx, connect.NewRequest(&draftv1.GetNextPickForDraftRequest{
+			DraftId: draftID.String(),
+		}))
+		if err != nil && connect.CodeOf(err) != connect.CodeNotFound {
+			return 0, err
+		}
+		if err == nil && nextResp.Msg.Pick != nil {
+			if autoPickTeams[nextResp.Msg.Pick.TeamId] {
+				return abandonedTeamPickTime, nil
+			}
+			secs = timePerPickForRound(draft.Settings, nextResp.Msg.Pick.Round)
+		}
+	}
 	return time.Duration(secs) * time.Second, nil
 }
+
+// autoPickTeams returns the abandoned teams in a draft whose picks are auto-picked
+func (o *Orchestrator) autoPickTeams(ctx context.Context, draftID uuid.UUID) (map[string]bool, error) {
+	resp, err := o.draftService.ListAbandonedTeams(ctx, connect.NewRequest(&draftv1.ListAbandonedTeamsRequest{
+		DraftId: draftID.String(),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list abandoned teams: %w", err)
+	}
+
+	teams := make(map[string]bool)
+	for _, team := range resp.Msg.AbandonedTeams {
+		if team.PickHandling == draftv1.AbandonedPickHandling_ABANDONED_PICK_HANDLING_AUTO_PICK {
+			teams[team.FantasyTeamId] = true
+		}
+	}
+	return teams, nil
+}
+
+// timePerPickForRound mirrors models.DraftSettings.TimePerPickForRound for proto settings
+func timePerPickForRound(settings *draftv1.DraftSettings, round int32) int32 {
+	for _, t := range settings.RoundTimers {
+		if round >= t.FromRound && (t.ToRound == 0 || round <= t.ToRound) {
+			return t.TimePerPickSec
+		}
+	}
+	return settings.TimePerPickSec
+}
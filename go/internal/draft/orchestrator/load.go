@@ -0,0 +1,202 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/rs/zerolog/log"
+)
+
+// loadVars publishes how far behind the orchestrator is handling expired pick clocks. Where the
+// orchestrator serves /debug/vars, importing expvar mounts it.
+var loadVars = expvar.NewMap("orchestrator_load")
+
+// maxReportedDrafts caps how many delayed drafts one load report lists
+const maxReportedDrafts = 500
+
+// LoadConfig tunes how the orchestrator copes when more pick clocks run out at once than its
+// workers keep up with, as on the busiest draft nights. While it's behind schedule, expired
+// clocks are still handled most overdue first, but checks for drafts that have made their last
+// pick are batched into one call per load check, and the gateway is told which drafts are
+// waiting so players see a delay notice rather than a frozen clock.
+type LoadConfig struct {
+	// CheckInterval is how often the load is measured and reported, and batched completion
+	// checks are run
+	CheckInterval time.Duration
+	// BehindAfter is how long the most overdue expired pick clock may wait for a worker before
+	// the orchestrator is behind schedule. It has caught up once that wait is under half of it.
+	BehindAfter time.Duration
+	// MaxCompletionBatch caps how many drafts one batched completion check covers
+	MaxCompletionBatch int
+}
+
+// DefaultLoadConfig returns the load settings used unless configured otherwise
+func DefaultLoadConfig() LoadConfig {
+	return LoadConfig{
+		CheckInterval:      time.Second,
+		BehindAfter:        5 * time.Second,
+		MaxCompletionBatch: 200,
+	}
+}
+
+// publishLoadVars registers the orchestrator's load metrics
+func (o *Orchestrator) publishLoadVars() {
+	loadVars.Set("queued", expvar.Func(func() any {
+		queued, _ := o.timeouts.overdue(0)
+		return queued
+	}))
+	loadVars.Set("oldest_wait_ms", expvar.Func(func() any {
+		_, oldest := o.timeouts.overdue(1)
+		if len(oldest) == 0 {
+			return 0
+		}
+		return o.clock.Now().Sub(oldest[0].dueAt).Milliseconds()
+	}))
+	loadVars.Set("behind", expvar.Func(func() any {
+		return o.behind.Load()
+	}))
+	loadVars.Set("pending_completion_checks", expvar.Func(func() any {
+		o.pendingCompletionsMu.Lock()
+		defer o.pendingCompletionsMu.Unlock()
+		return len(o.pendingCompletions)
+	}))
+}
+
+// runLoadMonitor measures the load every check interval until ctx is cancelled
+func (o *Orchestrator) runLoadMonitor(ctx context.Context) {
+	ticker := time.NewTicker(o.load.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			o.checkLoad(ctx)
+		}
+	}
+}
+
+// checkLoad works out whether the orchestrator is behind schedule, reports it while it is and
+// once it catches up, and runs any completion checks deferred while it was behind
+func (o *Orchestrator) checkLoad(ctx context.Context) {
+	now := o.clock.Now()
+	queued, delayed := o.timeouts.overdue(maxReportedDrafts)
+
+	var oldestWait time.Duration
+	if len(delayed) > 0 {
+		oldestWait = now.Sub(delayed[0].dueAt)
+	}
+
+	wasBehind := o.behind.Load()
+	behind := oldestWait >= o.load.BehindAfter || (wasBehind && oldestWait >= o.load.BehindAfter/2)
+	if behind != wasBehind {
+		o.behind.Store(behind)
+		loadVars.Add("behind_transitions", 1)
+		log.Warn().
+			Str("instance", o.instanceID).
+			Bool("behind", behind).
+			Int("queued", queued).
+			Dur("oldest_wait", oldestWait).
+			Msg("orchestrator schedule status changed")
+	}
+
+	if behind || wasBehind {
+		o.reportLoad(behind, queued, oldestWait, delayed, now)
+	}
+	o.flushCompletionChecks(ctx)
+}
+
+// reportLoad publishes the orchestrator's load for the gateway. Reports are best effort; the
+// next check sends a fresh one.
+func (o *Orchestrator) reportLoad(behind bool, queued int, oldestWait time.Duration, delayed []queuedTimeout, now time.Time) {
+	payload := events.OrchestratorLoadPayload{
+		InstanceID:   o.instanceID,
+		Behind:       behind,
+		Queued:       queued,
+		OldestWaitMs: oldestWait.Milliseconds(),
+		ReportedAt:   now,
+	}
+	if behind {
+		payload.DelayedDrafts = make([]events.DelayedDraft, len(delayed))
+		for i, item := range delayed {
+			payload.DelayedDrafts[i] = events.DelayedDraft{
+				DraftID: item.draftID.String(),
+				WaitMs:  now.Sub(item.dueAt).Milliseconds(),
+			}
+		}
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to marshal load report")
+		return
+	}
+	if err := o.nc.Publish(events.OrchestratorLoadSubject, data); err != nil {
+		log.Warn().Err(err).Msg("failed to publish load report")
+	}
+}
+
+// deferCompletionCheck leaves checking whether a draft has made its last pick to the next
+// batched check
+func (o *Orchestrator) deferCompletionCheck(draftID uuid.UUID) {
+	o.pendingCompletionsMu.Lock()
+	defer o.pendingCompletionsMu.Unlock()
+	o.pendingCompletions[draftID] = struct{}{}
+}
+
+// flushCompletionChecks counts the remaining picks of every draft whose completion check was
+// deferred in one call per batch, and completes those with none left. Drafts whose check fails
+// are kept for the next flush.
+func (o *Orchestrator) flushCompletionChecks(ctx context.Context) {
+	o.pendingCompletionsMu.Lock()
+	if len(o.pendingCompletions) == 0 {
+		o.pendingCompletionsMu.Unlock()
+		return
+	}
+	draftIDs := make([]string, 0, len(o.pendingCompletions))
+	for draftID := range o.pendingCompletions {
+		if len(draftIDs) == o.load.MaxCompletionBatch {
+			break
+		}
+		draftIDs = append(draftIDs, draftID.String())
+		delete(o.pendingCompletions, draftID)
+	}
+	o.pendingCompletionsMu.Unlock()
+
+	resp, err := o.draftPickService.CountRemainingPicksForDrafts(ctx, connect.NewRequest(&draftv1.CountRemainingPicksForDraftsRequest{
+		DraftIds: draftIDs,
+	}))
+	if err != nil {
+		log.Error().Err(err).Int("drafts", len(draftIDs)).Msg("batched completion check failed")
+		for _, id := range draftIDs {
+			o.deferCompletionCheck(uuid.MustParse(id))
+		}
+		return
+	}
+	loadVars.Add("batched_completion_checks", 1)
+	loadVars.Add("batched_completion_drafts", int64(len(draftIDs)))
+
+	for _, id := range draftIDs {
+		if resp.Msg.RemainingPicks[id] > 0 {
+			continue
+		}
+		draftID := uuid.MustParse(id)
+		err := o.completeDraft(ctx, draftID)
+		if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+			// Completed, or paused, since its last pick
+			log.Info().Err(err).Str("draft_id", id).Msg("not completing draft, no longer in progress")
+			continue
+		}
+		if err != nil {
+			log.Error().Err(err).Str("draft_id", id).Msg("failed to complete draft")
+			o.deferCompletionCheck(draftID)
+		}
+	}
+}
@@ -2,13 +2,17 @@ package orchestrator
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jonboulle/clockwork"
+	"github.com/mcdev12/dynasty/go/internal/flags"
 	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 )
@@ -18,17 +22,17 @@ EVENT FLOW:
 1. StartDraft gRPC → DraftService updates status → emits DraftStarted → outbox
 2. Outbox Relay → publishes DraftStarted to message bus
 3. Orchestrator → subscribes to DraftStarted → creates one-shot timer
-4. Timer expires → self-enqueues to workCh → Worker makes auto-pick via gRPC → PickMade event → repeat
+4. Timer expires → queues the draft for the workers, most overdue first → Worker makes auto-pick via gRPC → PickMade event → repeat
 
 TIMER FLOW:
-- scheduleNextPick() → timer.NewTimer(duration) → goroutine waits → timer fires → workCh <- draftID
-- No polling, no database queries for scheduling, only events
+- scheduleNextPick() → timer.NewTimer(duration) → goroutine waits → timer fires → timeouts.push(draftID)
+- No polling; the deadline is set on the database clock and the timer runs for the remaining duration plus a grace period
+- On firing, the deadline is re-checked against the database clock and the timer re-armed if it fired early
 */
 
 const (
 	// Worker pool configuration
-	defaultNumWorkers       = 10
-	workerChannelBufferSize = 20 // 2x numWorkers
+	defaultNumWorkers = 10
 	
 	// JetStream consumer configuration
 	consumerName          = "draft-orchestrator"
@@ -38,6 +42,14 @@ const (
 	
 	// Event processing
 	eventChannelBufferSize = 100
+
+	// DefaultTimeoutGrace is how long past a pick deadline the auto-pick waits, so a manual
+	// pick made right at the buzzer isn't beaten by the timer
+	DefaultTimeoutGrace = 500 * time.Millisecond
+
+	// abandonedTeamPickTime is the pick clock for abandoned teams whose picks are auto-picked;
+	// the deadline is set in whole seconds, so this is the shortest clock there is
+	abandonedTeamPickTime = time.Second
 	
 	// NATS connection configuration
 	natsMaxReconnects  = -1 // Infinite
@@ -58,9 +70,27 @@ type Orchestrator struct {
 	strat            AutoPickStrategy
 	instanceID       string // unique ID for this scheduler instance
 
+	// Feature flags choosing auto-pick strategies per league; nil turns them off and every
+	// draft auto-picks with strat
+	flags      *flags.Client
+	strategies map[string]AutoPickStrategy
+
+	// Database used for per-draft advisory locks shared with other instances
+	db *sql.DB
+
+	// Grace period added after a pick deadline before the auto-pick fires
+	timeoutGrace time.Duration
+
 	// Worker pool configuration
 	numWorkers int
-	workCh     chan uuid.UUID
+	timeouts   *timeoutQueue
+
+	// Load shedding: whether expired pick clocks are waiting too long for a worker, and the
+	// drafts whose completion check is deferred to the next batched one while they are
+	load                 LoadConfig
+	behind               atomic.Bool
+	pendingCompletions   map[uuid.UUID]struct{}
+	pendingCompletionsMu sync.Mutex
 
 	// Track last scheduled baseTime to prevent duplicate timers with same baseTime
 	lastScheduled   map[uuid.UUID]time.Time
@@ -70,18 +100,42 @@ type Orchestrator struct {
 	activeTimers   map[uuid.UUID]clockwork.Timer
 	activeTimersMu sync.Mutex
 
+	// Pause window timers: each draft waits on at most one window opening or closing
+	windowTimers   map[uuid.UUID]clockwork.Timer
+	windowTimersMu sync.Mutex
+
+	// Pause vote timers: each draft has at most one vote open, failed when its window closes
+	voteTimers   map[uuid.UUID]clockwork.Timer
+	voteTimersMu sync.Mutex
+
+	// Pick trade timers: each draft has at most one live pick trade offer open, expired when its
+	// negotiation window closes
+	tradeTimers   map[uuid.UUID]clockwork.Timer
+	tradeTimersMu sync.Mutex
+
+	// Commissioner timers: each draft whose commissioner left its room waits on at most one,
+	// pausing it when the commissioner has been away for the draft's wait
+	commissionerTimers   map[uuid.UUID]clockwork.Timer
+	commissionerTimersMu sync.Mutex
+
+	// Pick clock warning timers for the pick on the clock, one per warning threshold
+	clockWarnings   map[uuid.UUID][]clockwork.Timer
+	clockWarningsMu sync.Mutex
+
 	// JetStream connection and consumer
 	nc       *nats.Conn
 	js       jetstream.JetStream
 	consumer jetstream.Consumer
 }
 
-// NewOrchestrator creates a new draft orchestrator with JetStream consumer
-func NewOrchestrator(draftService draftv1connect.DraftServiceClient, draftPickService draftv1connect.DraftPickServiceClient, strat AutoPickStrategy, natsURL string) (*Orchestrator, error) {
+// NewOrchestrator creates a new draft orchestrator with JetStream consumer.
+// timeoutGrace is added after each pick deadline before the auto-pick fires.
+// featureFlags may be nil, in which case every draft auto-picks with strat.
+func NewOrchestrator(draftService draftv1connect.DraftServiceClient, draftPickService draftv1connect.DraftPickServiceClient, strat AutoPickStrategy, natsCfg natsconfig.Config, db *sql.DB, timeoutGrace time.Duration, load LoadConfig, featureFlags *flags.Client) (*Orchestrator, error) {
 	numWorkers := defaultNumWorkers
 
 	// Connect to NATS with JetStream
-	nc, js, err := setupNATSConnection(natsURL)
+	nc, js, err := setupNATSConnection(natsCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -92,21 +146,37 @@ func NewOrchestrator(draftService draftv1connect.DraftServiceClient, draftPickSe
 		strat:            strat,
 		clock:            clockwork.NewRealClock(),
 		instanceID:       uuid.New().String()[:8], // short ID for logging
-
-		numWorkers:    numWorkers,
-		workCh:        make(chan uuid.UUID, workerChannelBufferSize),
-		lastScheduled: make(map[uuid.UUID]time.Time),
-		activeTimers:  make(map[uuid.UUID]clockwork.Timer),
+		db:               db,
+		timeoutGrace:     timeoutGrace,
+		flags:            featureFlags,
+		strategies:       make(map[string]AutoPickStrategy, len(Strategies)),
+
+		numWorkers:         numWorkers,
+		timeouts:           newTimeoutQueue(),
+		load:               load,
+		pendingCompletions: make(map[uuid.UUID]struct{}),
+		lastScheduled:      make(map[uuid.UUID]time.Time),
+		activeTimers:       make(map[uuid.UUID]clockwork.Timer),
+		windowTimers:       make(map[uuid.UUID]clockwork.Timer),
+		voteTimers:         make(map[uuid.UUID]clockwork.Timer),
+		tradeTimers:        make(map[uuid.UUID]clockwork.Timer),
+		commissionerTimers: make(map[uuid.UUID]clockwork.Timer),
+		clockWarnings:      make(map[uuid.UUID][]clockwork.Timer),
 
 		nc: nc,
 		js: js,
 	}
 
+	for name, factory := range Strategies {
+		orch.strategies[name] = factory(draftPickService)
+	}
+
 	// Set up JetStream consumer
 	if err := orch.ensureConsumer(context.Background()); err != nil {
 		nc.Close()
 		return nil, fmt.Errorf("ensure JetStream consumer: %w", err)
 	}
+	orch.publishLoadVars()
 
 	return orch, nil
 }
\ No newline at end of file
@@ -0,0 +1,121 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/rs/zerolog/log"
+)
+
+// handlePauseVoteUpdatedEvent decides an open pause vote as soon as its outcome is known: it
+// passes, pausing the draft, once enough teams vote for it, and fails once the teams yet to vote
+// can no longer carry it. Until then it's timed to fail when its window closes.
+func (o *Orchestrator) handlePauseVoteUpdatedEvent(ctx context.Context, draftID uuid.UUID, payload events.PauseVoteUpdatedPayload) error {
+	voteID, err := uuid.Parse(payload.VoteID)
+	if err != nil {
+		return fmt.Errorf("invalid vote ID in PauseVoteUpdated payload: %w", err)
+	}
+
+	if models.PauseVoteStatus(payload.Status) != models.PauseVoteStatusOpen {
+		o.cancelVoteTimer(draftID)
+		return nil
+	}
+
+	vote := models.PauseVote{
+		VotesFor:      payload.VotesFor,
+		VotesAgainst:  payload.VotesAgainst,
+		EligibleTeams: payload.EligibleTeams,
+		VotesNeeded:   payload.VotesNeeded,
+	}
+	switch {
+	case vote.Reached():
+		return o.closePauseVote(ctx, draftID, voteID, true)
+	case vote.Unreachable():
+		return o.closePauseVote(ctx, draftID, voteID, false)
+	}
+
+	o.armVoteTimer(ctx, draftID, voteID, payload.ClosesAt.Sub(o.clock.Now()))
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Str("vote_id", payload.VoteID).
+		Int("votes_for", payload.VotesFor).
+		Int("votes_needed", payload.VotesNeeded).
+		Time("closes_at", payload.ClosesAt).
+		Msg("pause vote open")
+	return nil
+}
+
+// closePauseVote closes a pause vote through the draft service, which pauses the draft when
+// it passed. A vote closed already is left as it is.
+func (o *Orchestrator) closePauseVote(ctx context.Context, draftID, voteID uuid.UUID, passed bool) error {
+	o.cancelVoteTimer(draftID)
+
+	_, err := o.draftService.ClosePauseVote(ctx, connect.NewRequest(&draftv1.ClosePauseVoteRequest{
+		DraftId: draftID.String(),
+		VoteId:  voteID.String(),
+		Passed:  passed,
+	}))
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to close pause vote: %w", err)
+	}
+
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Str("vote_id", voteID.String()).
+		Bool("passed", passed).
+		Msg("closed pause vote")
+	return nil
+}
+
+// armVoteTimer fails the draft's open pause vote after d, replacing any pending vote timer
+func (o *Orchestrator) armVoteTimer(ctx context.Context, draftID, voteID uuid.UUID, d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	timer := o.clock.NewTimer(d)
+
+	o.voteTimersMu.Lock()
+	if existing, ok := o.voteTimers[draftID]; ok {
+		stopAndDrainTimer(existing)
+	}
+	o.voteTimers[draftID] = timer
+	o.voteTimersMu.Unlock()
+
+	go func(id uuid.UUID, t clockwork.Timer) {
+		select {
+		case <-t.Chan():
+			o.voteTimersMu.Lock()
+			if o.voteTimers[id] == t {
+				delete(o.voteTimers, id)
+			}
+			o.voteTimersMu.Unlock()
+
+			if err := o.closePauseVote(ctx, id, voteID, false); err != nil {
+				log.Error().Err(err).Str("draft_id", id.String()).Msg("failed to close expired pause vote")
+			}
+		case <-ctx.Done():
+			stopAndDrainTimer(t)
+		}
+	}(draftID, timer)
+}
+
+// cancelVoteTimer cancels any pending pause vote timer for a draft
+func (o *Orchestrator) cancelVoteTimer(draftID uuid.UUID) {
+	o.voteTimersMu.Lock()
+	defer o.voteTimersMu.Unlock()
+
+	if timer, ok := o.voteTimers[draftID]; ok {
+		stopAndDrainTimer(timer)
+		delete(o.voteTimers, draftID)
+	}
+}
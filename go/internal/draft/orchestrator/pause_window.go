@@ -0,0 +1,195 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/rs/zerolog/log"
+)
+
+// schedulePauseWindow arms a timer that pauses the draft when its pause window next opens.
+// A draft started or resumed inside the window keeps running until the following one,
+// so a commissioner can override tonight's pause by resuming manually.
+func (o *Orchestrator) schedulePauseWindow(ctx context.Context, draftID uuid.UUID) error {
+	draft, err := o.getDraft(ctx, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to get draft: %w", err)
+	}
+	window := pauseWindowFromProto(draft.Settings.PauseWindow)
+	if window == nil {
+		o.cancelWindowTimer(draftID)
+		return nil
+	}
+
+	now := o.clock.Now()
+	opensAt := window.NextStart(now)
+	o.armWindowTimer(ctx, draftID, opensAt.Sub(now), o.pauseForWindow)
+
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Time("opens_at", opensAt).
+		Msg("scheduled pause window")
+	return nil
+}
+
+// scheduleWindowResume arms a timer that resumes a draft paused by its pause window
+func (o *Orchestrator) scheduleWindowResume(ctx context.Context, draftID uuid.UUID, resumesAt time.Time) {
+	o.armWindowTimer(ctx, draftID, resumesAt.Sub(o.clock.Now()), o.resumeAfterWindow)
+
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Time("resumes_at", resumesAt).
+		Msg("scheduled resume after pause window")
+}
+
+// pauseForWindow pauses the draft if it is still in progress and inside its pause window
+func (o *Orchestrator) pauseForWindow(ctx context.Context, draftID uuid.UUID) error {
+	draft, err := o.getDraft(ctx, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to get draft: %w", err)
+	}
+	if draft.Status != draftv1.DraftStatus_DRAFT_STATUS_IN_PROGRESS {
+		return nil
+	}
+	window := pauseWindowFromProto(draft.Settings.PauseWindow)
+	if window == nil {
+		return nil
+	}
+	if !window.Contains(o.clock.Now()) {
+		// The window was changed after the timer was armed
+		return o.schedulePauseWindow(ctx, draftID)
+	}
+
+	_, err = o.draftService.PauseDraft(ctx, connect.NewRequest(&draftv1.PauseDraftRequest{
+		DraftId:   draftID.String(),
+		Scheduled: true,
+	}))
+	if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+		return nil
+	}
+	return err
+}
+
+// resumeAfterWindow resumes a draft paused by its pause window, unless the window is still open
+func (o *Orchestrator) resumeAfterWindow(ctx context.Context, draftID uuid.UUID) error {
+	draft, err := o.getDraft(ctx, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to get draft: %w", err)
+	}
+	if draft.Status != draftv1.DraftStatus_DRAFT_STATUS_PAUSED {
+		return nil
+	}
+	now := o.clock.Now()
+	if window := pauseWindowFromProto(draft.Settings.PauseWindow); window != nil && window.Contains(now) {
+		o.scheduleWindowResume(ctx, draftID, window.NextEnd(now))
+		return nil
+	}
+
+	_, err = o.draftService.ResumeDraft(ctx, connect.NewRequest(&draftv1.ResumeDraftRequest{
+		DraftId:   draftID.String(),
+		Scheduled: true,
+	}))
+	if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+		return nil
+	}
+	return err
+}
+
+// resumePickClock arms the pick timer from the deadline the draft service set when the
+// draft resumed, falling back to starting a fresh clock
+func (o *Orchestrator) resumePickClock(ctx context.Context, draftID uuid.UUID, resumedAt time.Time) error {
+	id := draftID.String()
+	resp, err := o.draftService.FetchNextDeadline(ctx, connect.NewRequest(&draftv1.FetchNextDeadlineRequest{
+		DraftId: &id,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to fetch pick deadline: %w", err)
+	}
+
+	if next := resp.Msg.NextDeadline; next != nil && next.Deadline != nil {
+		if remaining := next.Deadline.AsTime().Sub(resp.Msg.ServerTime.AsTime()); remaining > 0 {
+			o.lastScheduledMu.Lock()
+			o.lastScheduled[draftID] = resumedAt
+			o.lastScheduledMu.Unlock()
+
+			o.armTimer(ctx, draftID, remaining+o.timeoutGrace)
+			if pickTime, err := o.getPickTime(ctx, draftID); err != nil {
+				log.Error().Err(err).Str("draft_id", draftID.String()).Msg("failed to get pick time for clock warnings")
+			} else {
+				o.scheduleClockWarnings(ctx, draftID, next.Deadline.AsTime(), remaining, pickTime)
+			}
+			return nil
+		}
+	}
+	return o.scheduleNextPick(ctx, draftID, resumedAt)
+}
+
+// armWindowTimer runs fn for the draft after d, replacing any pending window timer
+func (o *Orchestrator) armWindowTimer(ctx context.Context, draftID uuid.UUID, d time.Duration, fn func(context.Context, uuid.UUID) error) {
+	if d < 0 {
+		d = 0
+	}
+	timer := o.clock.NewTimer(d)
+
+	o.windowTimersMu.Lock()
+	if existing, ok := o.windowTimers[draftID]; ok {
+		stopAndDrainTimer(existing)
+	}
+	o.windowTimers[draftID] = timer
+	o.windowTimersMu.Unlock()
+
+	go func(id uuid.UUID, t clockwork.Timer) {
+		select {
+		case <-t.Chan():
+			o.windowTimersMu.Lock()
+			if o.windowTimers[id] == t {
+				delete(o.windowTimers, id)
+			}
+			o.windowTimersMu.Unlock()
+
+			if err := fn(ctx, id); err != nil {
+				log.Error().Err(err).Str("draft_id", id.String()).Msg("pause window transition failed")
+			}
+		case <-ctx.Done():
+			stopAndDrainTimer(t)
+		}
+	}(draftID, timer)
+}
+
+// cancelWindowTimer cancels any pending pause window timer for a draft
+func (o *Orchestrator) cancelWindowTimer(draftID uuid.UUID) {
+	o.windowTimersMu.Lock()
+	defer o.windowTimersMu.Unlock()
+
+	if timer, ok := o.windowTimers[draftID]; ok {
+		stopAndDrainTimer(timer)
+		delete(o.windowTimers, draftID)
+	}
+}
+
+func (o *Orchestrator) getDraft(ctx context.Context, draftID uuid.UUID) (*draftv1.Draft, error) {
+	resp, err := o.draftService.GetDraft(ctx, connect.NewRequest(&draftv1.GetDraftRequest{
+		DraftId: draftID.String(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.Draft, nil
+}
+
+func pauseWindowFromProto(window *draftv1.PauseWindow) *models.PauseWindow {
+	if window == nil {
+		return nil
+	}
+	return &models.PauseWindow{
+		Start:    window.Start,
+		End:      window.End,
+		Timezone: window.Timezone,
+	}
+}
@@ -0,0 +1,107 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/rs/zerolog/log"
+)
+
+// handlePickTradeUpdatedEvent times an open live pick trade offer to expire when its negotiation
+// window closes, and re-arms the pick timer whenever the offer moved the pick deadline: back by
+// the window when it opened, and to what was left on the clock when it closed early.
+func (o *Orchestrator) handlePickTradeUpdatedEvent(ctx context.Context, draftID uuid.UUID, payload events.PickTradeUpdatedPayload) error {
+	offerID, err := uuid.Parse(payload.OfferID)
+	if err != nil {
+		return fmt.Errorf("invalid offer ID in PickTradeUpdated payload: %w", err)
+	}
+
+	if models.PickTradeOfferStatus(payload.Status) == models.PickTradeOfferStatusOpen {
+		o.armTradeTimer(ctx, draftID, offerID, payload.ExpiresAt.Sub(o.clock.Now()))
+		log.Info().
+			Str("draft_id", draftID.String()).
+			Str("offer_id", payload.OfferID).
+			Str("from_team_id", payload.FromTeamID).
+			Str("to_team_id", payload.ToTeamID).
+			Time("expires_at", payload.ExpiresAt).
+			Msg("pick trade offer open")
+	} else {
+		o.cancelTradeTimer(draftID)
+	}
+
+	if payload.TimeoutAt == nil {
+		return nil
+	}
+	return o.resumePickClock(ctx, draftID, payload.UpdatedAt)
+}
+
+// expirePickTrade expires a live pick trade offer through the draft pick service. An offer
+// closed already is left as it is.
+func (o *Orchestrator) expirePickTrade(ctx context.Context, draftID, offerID uuid.UUID) error {
+	_, err := o.draftPickService.ExpirePickTrade(ctx, connect.NewRequest(&draftv1.ExpirePickTradeRequest{
+		DraftId: draftID.String(),
+		OfferId: offerID.String(),
+	}))
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to expire pick trade offer: %w", err)
+	}
+
+	log.Info().
+		Str("draft_id", draftID.String()).
+		Str("offer_id", offerID.String()).
+		Msg("expired pick trade offer")
+	return nil
+}
+
+// armTradeTimer expires the draft's open pick trade offer after d, replacing any pending trade timer
+func (o *Orchestrator) armTradeTimer(ctx context.Context, draftID, offerID uuid.UUID, d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	timer := o.clock.NewTimer(d)
+
+	o.tradeTimersMu.Lock()
+	if existing, ok := o.tradeTimers[draftID]; ok {
+		stopAndDrainTimer(existing)
+	}
+	o.tradeTimers[draftID] = timer
+	o.tradeTimersMu.Unlock()
+
+	go func(id uuid.UUID, t clockwork.Timer) {
+		select {
+		case <-t.Chan():
+			o.tradeTimersMu.Lock()
+			if o.tradeTimers[id] == t {
+				delete(o.tradeTimers, id)
+			}
+			o.tradeTimersMu.Unlock()
+
+			if err := o.expirePickTrade(ctx, id, offerID); err != nil {
+				log.Error().Err(err).Str("draft_id", id.String()).Msg("failed to expire pick trade offer")
+			}
+		case <-ctx.Done():
+			stopAndDrainTimer(t)
+		}
+	}(draftID, timer)
+}
+
+// cancelTradeTimer cancels any pending pick trade timer for a draft
+func (o *Orchestrator) cancelTradeTimer(draftID uuid.UUID) {
+	o.tradeTimersMu.Lock()
+	defer o.tradeTimersMu.Unlock()
+
+	if timer, ok := o.tradeTimers[draftID]; ok {
+		stopAndDrainTimer(timer)
+		delete(o.tradeTimers, draftID)
+	}
+}
@@ -5,14 +5,21 @@ import (
 	"fmt"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/jonboulle/clockwork"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
 	"github.com/rs/zerolog/log"
 )
 
 // scheduleNextPick is a helper method that handles the common pattern of scheduling a pick timeout.
-// It fetches the timeout duration, calculates the next deadline, and sets up a timer.
+// It fetches the timeout duration, starts the pick clock in the draft service and sets up a timer.
 // Includes single-layer idempotency guard to prevent duplicate scheduling operations.
+//
+// The deadline is set on the database clock, which is the single authority every instance and
+// the gateway read it against. The local timer only runs for the remaining duration the database
+// reports, measured with the monotonic clock, so wall-clock skew on this host can't make the
+// auto-pick fire early or late. baseTime only identifies the event for idempotency.
 func (o *Orchestrator) scheduleNextPick(ctx context.Context, draftID uuid.UUID, baseTime time.Time) error {
 	// Base-time idempotency guard - prevent duplicate timers with same baseTime
 	o.lastScheduledMu.Lock()
@@ -27,65 +34,105 @@ func (o *Orchestrator) scheduleNextPick(ctx context.Context, draftID uuid.UUID,
 	o.lastScheduled[draftID] = baseTime
 	o.lastScheduledMu.Unlock()
 
+	// Drafts set to skip picks of teams with a full roster move past them as they come on the clock
+	forfeited, err := o.skipPickWithoutRosterSpace(ctx, draftID, false)
+	if err != nil {
+		return err
+	}
+	if forfeited {
+		return nil
+	}
+
 	// Get pick timeout duration from draft settings
 	timeOut, err := o.getPickTime(ctx, draftID)
 	if err != nil {
 		return fmt.Errorf("failed to get pick time: %w", err)
 	}
+	if timeOut <= 0 {
+		// Untimed pick: drop any deadline and timer left over from the previous pick
+		o.cancelTimer(draftID)
+		_, err := o.draftService.UpdateNextDeadline(ctx, connect.NewRequest(&draftv1.UpdateNextDeadlineRequest{
+			DraftId: draftID.String(),
+		}))
+		if err != nil && connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			return fmt.Errorf("failed to clear next deadline: %w", err)
+		}
+		return nil
+	}
 
-	// Calculate next deadline
-	next := baseTime.Add(timeOut)
+	// Start the pick clock on the database clock
+	timeoutSec := int32(timeOut / time.Second)
+	resp, err := o.draftService.UpdateNextDeadline(ctx, connect.NewRequest(&draftv1.UpdateNextDeadlineRequest{
+		DraftId:    draftID.String(),
+		TimeoutSec: &timeoutSec,
+	}))
+	if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+		// Stale event for a draft that has since been paused or completed
+		log.Info().Err(err).Str("draft_id", draftID.String()).Msg("skipping schedule, draft no longer in progress")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set next deadline: %w", err)
+	}
 
-	// Create one-shot timer that will enqueue the draft when it fires
-	duration := next.Sub(o.clock.Now())
-	if duration > 0 {
-		timer := o.clock.NewTimer(duration)
-		
-		// Atomically replace any existing timer for this draft
-		o.replaceTimer(draftID, timer)
-		
-		// Start goroutine to wait for timer and enqueue work
-		go func(id uuid.UUID, t clockwork.Timer) {
-			select {
-			case <-t.Chan():
-				// Timer fired normally - remove from active timers and enqueue
-				o.removeTimer(id)
-				
-				// Clean up lastScheduled entry after timer fires to prevent unbounded growth
-				o.lastScheduledMu.Lock()
-				delete(o.lastScheduled, id)
-				o.lastScheduledMu.Unlock()
-				
-				select {
-				case o.workCh <- id:
-					log.Debug().Str("draft_id", id.String()).Msg("timer fired - enqueued for processing")
-				default:
-					log.Warn().Str("draft_id", id.String()).Msg("timer fired but work channel full")
-				}
-			case <-ctx.Done():
-				// Context cancelled - stop timer and clean up
-				stopAndDrainTimer(t)
-				o.removeTimer(id)
-				
-				// Clean up lastScheduled entry when cancelled
-				o.lastScheduledMu.Lock()
-				delete(o.lastScheduled, id)
-				o.lastScheduledMu.Unlock()
-				
-				log.Debug().Str("draft_id", id.String()).Msg("timer cancelled due to context cancellation")
-			}
-		}(draftID, timer)
+	deadline := resp.Msg.Deadline.AsTime()
+	serverTime := resp.Msg.ServerTime.AsTime()
+	o.armTimer(ctx, draftID, deadline.Sub(serverTime)+o.timeoutGrace)
+	o.scheduleClockWarnings(ctx, draftID, deadline, deadline.Sub(serverTime), timeOut)
 
-		log.Debug().
-			Str("draft_id", draftID.String()).
-			Time("deadline", next).
-			Dur("duration", duration).
-			Msg("scheduled one-shot timer")
-	}
+	log.Debug().
+		Str("draft_id", draftID.String()).
+		Time("deadline", deadline).
+		Dur("clock_skew", serverTime.Sub(o.clock.Now())).
+		Msg("started pick clock")
 
 	return nil
 }
 
+// armTimer sets up a one-shot timer that enqueues the draft for timeout handling after d,
+// replacing any timer already running for the draft.
+func (o *Orchestrator) armTimer(ctx context.Context, draftID uuid.UUID, d time.Duration) {
+	dueAt := o.clock.Now().Add(d)
+	timer := o.clock.NewTimer(d)
+
+	// Atomically replace any existing timer for this draft
+	o.replaceTimer(draftID, timer)
+
+	// Start goroutine to wait for timer and enqueue work
+	go func(id uuid.UUID, t clockwork.Timer) {
+		select {
+		case <-t.Chan():
+			// Timer fired normally - remove from active timers and enqueue
+			o.removeTimer(id)
+
+			// Clean up lastScheduled entry after timer fires to prevent unbounded growth
+			o.lastScheduledMu.Lock()
+			delete(o.lastScheduled, id)
+			o.lastScheduledMu.Unlock()
+
+			if o.timeouts.push(id, dueAt) {
+				log.Debug().Str("draft_id", id.String()).Msg("timer fired - enqueued for processing")
+			}
+		case <-ctx.Done():
+			// Context cancelled - stop timer and clean up
+			stopAndDrainTimer(t)
+			o.removeTimer(id)
+
+			// Clean up lastScheduled entry when cancelled
+			o.lastScheduledMu.Lock()
+			delete(o.lastScheduled, id)
+			o.lastScheduledMu.Unlock()
+
+			log.Debug().Str("draft_id", id.String()).Msg("timer cancelled due to context cancellation")
+		}
+	}(draftID, timer)
+
+	log.Debug().
+		Str("draft_id", draftID.String()).
+		Dur("duration", d).
+		Msg("scheduled one-shot timer")
+}
+
 // replaceTimer atomically replaces a timer for a draft, properly cancelling any existing timer.
 // This prevents race conditions where a new timer could slip in between Stop() and delete().
 func (o *Orchestrator) replaceTimer(draftID uuid.UUID, newTimer clockwork.Timer) {
@@ -114,8 +161,10 @@ func stopAndDrainTimer(timer clockwork.Timer) {
 	}
 }
 
-// cancelTimer cancels and removes an active timer for a draft
+// cancelTimer cancels and removes an active timer for a draft, along with its clock warnings
 func (o *Orchestrator) cancelTimer(draftID uuid.UUID) {
+	o.cancelClockWarnings(draftID)
+
 	o.activeTimersMu.Lock()
 	defer o.activeTimersMu.Unlock()
 
@@ -0,0 +1,131 @@
+package orchestrator
+
+import (
+	"container/heap"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// queuedTimeout is a draft whose pick clock ran out at dueAt, waiting for a worker
+type queuedTimeout struct {
+	draftID uuid.UUID
+	dueAt   time.Time
+	index   int
+}
+
+// timeoutHeap orders queued timeouts by when they were due, earliest first
+type timeoutHeap []*queuedTimeout
+
+func (h timeoutHeap) Len() int           { return len(h) }
+func (h timeoutHeap) Less(i, j int) bool { return h[i].dueAt.Before(h[j].dueAt) }
+func (h timeoutHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *timeoutHeap) Push(x any) {
+	item := x.(*queuedTimeout)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *timeoutHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
+
+// timeoutQueue holds drafts whose pick clocks have run out until a worker takes them, most
+// overdue first. A draft is queued at most once, at its earliest deadline. Unlike a channel it
+// never fills up, so when more clocks run out at once than the workers can keep up with they
+// wait their turn rather than being dropped.
+type timeoutQueue struct {
+	mu     sync.Mutex
+	ready  *sync.Cond
+	items  timeoutHeap
+	queued map[uuid.UUID]*queuedTimeout
+	closed bool
+}
+
+func newTimeoutQueue() *timeoutQueue {
+	q := &timeoutQueue{queued: make(map[uuid.UUID]*queuedTimeout)}
+	q.ready = sync.NewCond(&q.mu)
+	return q
+}
+
+// push queues a draft whose pick clock ran out at dueAt, reporting false once the queue is closed
+func (q *timeoutQueue) push(draftID uuid.UUID, dueAt time.Time) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return false
+	}
+	if item, exists := q.queued[draftID]; exists {
+		if dueAt.Before(item.dueAt) {
+			item.dueAt = dueAt
+			heap.Fix(&q.items, item.index)
+		}
+		return true
+	}
+
+	item := &queuedTimeout{draftID: draftID, dueAt: dueAt}
+	heap.Push(&q.items, item)
+	q.queued[draftID] = item
+	q.ready.Signal()
+	return true
+}
+
+// pop waits for the most overdue draft and takes it off the queue. It reports false once the
+// queue is closed.
+func (q *timeoutQueue) pop() (uuid.UUID, time.Time, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.items) == 0 && !q.closed {
+		q.ready.Wait()
+	}
+	if q.closed {
+		return uuid.Nil, time.Time{}, false
+	}
+
+	item := heap.Pop(&q.items).(*queuedTimeout)
+	delete(q.queued, item.draftID)
+	return item.draftID, item.dueAt, true
+}
+
+// close wakes every waiting worker and drops whatever is still queued
+func (q *timeoutQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	q.items = nil
+	q.queued = make(map[uuid.UUID]*queuedTimeout)
+	q.ready.Broadcast()
+}
+
+// overdue returns how many drafts are queued and up to limit of them, most overdue first
+func (q *timeoutQueue) overdue(limit int) (int, []queuedTimeout) {
+	q.mu.Lock()
+	items := make([]queuedTimeout, len(q.items))
+	for i, item := range q.items {
+		items[i] = *item
+	}
+	q.mu.Unlock()
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].dueAt.Before(items[j].dueAt)
+	})
+	if len(items) > limit {
+		return len(items), items[:limit]
+	}
+	return len(items), items
+}
@@ -44,12 +44,13 @@ func (o *Orchestrator) RunScheduler(ctx context.Context) error {
 		wg.Add(1)
 		go o.worker(workerCtx, &wg, i)
 	}
+	go o.runLoadMonitor(workerCtx)
 
 	// Ensure workers are cleaned up
 	defer func() {
 		log.Info().Str("instance", o.instanceID).Msg("shutting down workers")
 		cancelWorkers()
-		close(o.workCh)
+		o.timeouts.close()
 		wg.Wait()
 		log.Info().Str("instance", o.instanceID).Msg("all workers shut down")
 	}()
@@ -84,7 +85,7 @@ shutdown:
 	return nil
 }
 
-// worker processes draft timeouts from the work channel
+// worker processes draft timeouts from the timeout queue, most overdue first
 func (o *Orchestrator) worker(ctx context.Context, wg *sync.WaitGroup, workerID int) {
 	defer wg.Done()
 
@@ -94,36 +95,29 @@ func (o *Orchestrator) worker(ctx context.Context, wg *sync.WaitGroup, workerID
 		Msg("worker started")
 
 	for {
-		select {
-		case <-ctx.Done():
+		draftID, dueAt, ok := o.timeouts.pop()
+		if !ok || ctx.Err() != nil {
 			log.Info().
 				Str("instance", o.instanceID).
 				Int("worker_id", workerID).
 				Msg("worker shutting down")
 			return
-		case draftID, ok := <-o.workCh:
-			if !ok {
-				log.Info().
-					Str("instance", o.instanceID).
-					Int("worker_id", workerID).
-					Msg("work channel closed, worker shutting down")
-				return
-			}
+		}
 
-			log.Info().
+		log.Info().
+			Str("draft_id", draftID.String()).
+			Str("instance", o.instanceID).
+			Int("worker_id", workerID).
+			Dur("waited", o.clock.Now().Sub(dueAt)).
+			Msg("worker handling timeout")
+
+		if err := o.handleTimeout(ctx, draftID); err != nil {
+			log.Error().
+				Err(err).
 				Str("draft_id", draftID.String()).
 				Str("instance", o.instanceID).
 				Int("worker_id", workerID).
-				Msg("worker handling timeout")
-
-			if err := o.handleTimeout(ctx, draftID); err != nil {
-				log.Error().
-					Err(err).
-					Str("draft_id", draftID.String()).
-					Str("instance", o.instanceID).
-					Int("worker_id", workerID).
-					Msg("worker timeout handling failed")
-			}
+				Msg("worker timeout handling failed")
 		}
 	}
 }
\ No newline at end of file
@@ -1,25 +1,27 @@
 package outbox
 
+//go:generate go run ./gen
+
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
 	"github.com/mcdev12/dynasty/go/internal/draft/outbox/worker"
 	"github.com/rs/zerolog/log"
 )
 
 // OutboxRepository defines what the app layer needs from the repository
 type OutboxRepository interface {
-	InsertOutboxPickMade(ctx context.Context, draftID uuid.UUID, payload []byte) error
-	InsertOutboxPickStarted(ctx context.Context, draftID uuid.UUID, payload []byte) error
-	InsertOutboxDraftStarted(ctx context.Context, draftID uuid.UUID, payload []byte) error
-	InsertOutboxDraftPaused(ctx context.Context, draftID uuid.UUID, payload []byte) error
-	InsertOutboxDraftResumed(ctx context.Context, draftID uuid.UUID, payload []byte) error
-	InsertOutboxDraftCompleted(ctx context.Context, draftID uuid.UUID, payload []byte) error
+	InsertOutboxEvent(ctx context.Context, draftID uuid.UUID, eventType string, payload []byte) error
+	InsertOutboxEvents(ctx context.Context, draftID uuid.UUID, eventTypes []string, payloads [][]byte) error
 	FetchUnsentOutbox(ctx context.Context, limit int32) ([]worker.OutboxEvent, error)
-	MarkOutboxSent(ctx context.Context, id uuid.UUID) error
-	FetchOutboxByID(ctx context.Context, id uuid.UUID) (*worker.OutboxEvent, error)
+	MarkOutboxSent(ctx context.Context, id uuid.UUID, createdAt time.Time) error
+	FetchOutboxByID(ctx context.Context, id uuid.UUID, createdAt time.Time) (*worker.OutboxEvent, error)
+	GetOutboxBacklog(ctx context.Context) (*worker.Backlog, error)
 }
 
 // App handles outbox business logic
@@ -34,135 +36,65 @@ func NewApp(repo OutboxRepository) *App {
 	}
 }
 
-// InsertPickMadeEvent inserts a PickMade event into the outbox
-func (a *App) InsertPickMadeEvent(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	if err := a.validateEventPayload(payload); err != nil {
-		return fmt.Errorf("invalid PickMade payload: %w", err)
-	}
-
-	if err := a.repo.InsertOutboxPickMade(ctx, draftID, payload); err != nil {
-		return fmt.Errorf("failed to insert PickMade event: %w", err)
+// InsertEvent marshals payload to JSON and inserts it into the outbox as an eventType event,
+// once it matches the schema registered for eventType. Callers use the typed helpers that are
+// generated from the event registry into insert_events_gen.go.
+func (a *App) InsertEvent(ctx context.Context, draftID uuid.UUID, eventType string, payload any) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s payload: %w", eventType, err)
 	}
 
-	log.Info().
-		Str("draft_id", draftID.String()).
-		Str("event_type", "PickMade").
-		Msg("outbox event inserted")
-
-	return nil
-}
-
-// InsertPickStartedEvent inserts a PickStarted event into the outbox
-func (a *App) InsertPickStartedEvent(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	if err := a.validateEventPayload(payload); err != nil {
-		return fmt.Errorf("invalid PickStarted payload: %w", err)
+	if err := a.validateEventPayload(eventType, payloadBytes); err != nil {
+		return fmt.Errorf("invalid %s payload: %w", eventType, err)
 	}
 
-	if err := a.repo.InsertOutboxPickStarted(ctx, draftID, payload); err != nil {
-		return fmt.Errorf("failed to insert PickStarted event: %w", err)
+	if err := a.repo.InsertOutboxEvent(ctx, draftID, eventType, payloadBytes); err != nil {
+		return fmt.Errorf("failed to insert %s event: %w", eventType, err)
 	}
 
 	log.Info().
 		Str("draft_id", draftID.String()).
-		Str("event_type", "PickStarted").
+		Str("event_type", eventType).
 		Msg("outbox event inserted")
 
 	return nil
 }
 
-// InsertDraftStartedEvent inserts a DraftStarted event into the outbox
-func (a *App) InsertDraftStartedEvent(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	if err := a.validateEventPayload(payload); err != nil {
-		return fmt.Errorf("invalid DraftStarted payload: %w", err)
-	}
-
-	if err := a.repo.InsertOutboxDraftStarted(ctx, draftID, payload); err != nil {
-		return fmt.Errorf("failed to insert DraftStarted event: %w", err)
+// InsertEvents inserts the events of a composite action together: all of them or, if any
+// payload doesn't match its schema or the insert fails, none. They take consecutive sequence
+// numbers in the order given, so relays see them in that order.
+func (a *App) InsertEvents(ctx context.Context, draftID uuid.UUID, batch []Event) error {
+	if len(batch) == 0 {
+		return nil
 	}
 
-	log.Info().
-		Str("draft_id", draftID.String()).
-		Str("event_type", "DraftStarted").
-		Msg("outbox event inserted")
-
-	return nil
-}
-
-// InsertDraftPausedEvent inserts a DraftPaused event into the outbox
-func (a *App) InsertDraftPausedEvent(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	if err := a.validateEventPayload(payload); err != nil {
-		return fmt.Errorf("invalid DraftPaused payload: %w", err)
-	}
-
-	if err := a.repo.InsertOutboxDraftPaused(ctx, draftID, payload); err != nil {
-		return fmt.Errorf("failed to insert DraftPaused event: %w", err)
-	}
-
-	log.Info().
-		Str("draft_id", draftID.String()).
-		Str("event_type", "DraftPaused").
-		Msg("outbox event inserted")
-
-	return nil
-}
-
-// InsertDraftResumedEvent inserts a DraftResumed event into the outbox
-func (a *App) InsertDraftResumedEvent(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	if err := a.validateEventPayload(payload); err != nil {
-		return fmt.Errorf("invalid DraftResumed payload: %w", err)
-	}
-
-	if err := a.repo.InsertOutboxDraftResumed(ctx, draftID, payload); err != nil {
-		return fmt.Errorf("failed to insert DraftResumed event: %w", err)
-	}
-
-	log.Info().
-		Str("draft_id", draftID.String()).
-		Str("event_type", "DraftResumed").
-		Msg("outbox event inserted")
-
-	return nil
-}
-
-// InsertDraftCompletedEvent inserts a DraftCompleted event into the outbox
-func (a *App) InsertDraftCompletedEvent(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	if err := a.validateEventPayload(payload); err != nil {
-		return fmt.Errorf("invalid DraftCompleted payload: %w", err)
+	eventTypes := make([]string, len(batch))
+	payloads := make([][]byte, len(batch))
+	for i, event := range batch {
+		payloadBytes, err := json.Marshal(event.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s payload: %w", event.Type, err)
+		}
+		if err := a.validateEventPayload(event.Type, payloadBytes); err != nil {
+			return fmt.Errorf("invalid %s payload: %w", event.Type, err)
+		}
+		eventTypes[i] = event.Type
+		payloads[i] = payloadBytes
 	}
 
-	if err := a.repo.InsertOutboxDraftCompleted(ctx, draftID, payload); err != nil {
-		return fmt.Errorf("failed to insert DraftCompleted event: %w", err)
+	if err := a.repo.InsertOutboxEvents(ctx, draftID, eventTypes, payloads); err != nil {
+		return err
 	}
 
 	log.Info().
 		Str("draft_id", draftID.String()).
-		Str("event_type", "DraftCompleted").
-		Msg("outbox event inserted")
+		Strs("event_types", eventTypes).
+		Msg("outbox events inserted")
 
 	return nil
 }
 
-// Alias methods to match orchestrator interface expectations
-func (a *App) InsertOutboxDraftStarted(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	return a.InsertDraftStartedEvent(ctx, draftID, payload)
-}
-
-func (a *App) InsertOutboxDraftCompleted(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	return a.InsertDraftCompletedEvent(ctx, draftID, payload)
-}
-
-func (a *App) InsertOutboxDraftPaused(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	return a.InsertDraftPausedEvent(ctx, draftID, payload)
-}
-
-func (a *App) InsertOutboxDraftResumed(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	return a.InsertDraftResumedEvent(ctx, draftID, payload)
-}
-
-func (a *App) InsertOutboxPickStarted(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	return a.InsertPickStartedEvent(ctx, draftID, payload)
-}
-
 // FetchUnsentEvents fetches unsent outbox events
 func (a *App) FetchUnsentEvents(ctx context.Context, limit int32) ([]worker.OutboxEvent, error) {
 	if limit <= 0 {
@@ -183,9 +115,10 @@ func (a *App) FetchUnsentEvents(ctx context.Context, limit int32) ([]worker.Outb
 	return events, nil
 }
 
-// MarkEventSent marks an outbox event as sent
-func (a *App) MarkEventSent(ctx context.Context, eventID uuid.UUID) error {
-	if err := a.repo.MarkOutboxSent(ctx, eventID); err != nil {
+// MarkEventSent marks an outbox event as sent. createdAt is the event's, which locates its
+// partition.
+func (a *App) MarkEventSent(ctx context.Context, eventID uuid.UUID, createdAt time.Time) error {
+	if err := a.repo.MarkOutboxSent(ctx, eventID, createdAt); err != nil {
 		return fmt.Errorf("failed to mark event as sent: %w", err)
 	}
 
@@ -196,9 +129,19 @@ func (a *App) MarkEventSent(ctx context.Context, eventID uuid.UUID) error {
 	return nil
 }
 
-// GetEventByID fetches a specific outbox event by ID
-func (a *App) GetEventByID(ctx context.Context, eventID uuid.UUID) (*worker.OutboxEvent, error) {
-	event, err := a.repo.FetchOutboxByID(ctx, eventID)
+// GetBacklog reports how many events are waiting to be published and the age of the oldest
+func (a *App) GetBacklog(ctx context.Context) (*worker.Backlog, error) {
+	backlog, err := a.repo.GetOutboxBacklog(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backlog: %w", err)
+	}
+
+	return backlog, nil
+}
+
+// GetEventByID fetches a specific outbox event by ID and creation time
+func (a *App) GetEventByID(ctx context.Context, eventID uuid.UUID, createdAt time.Time) (*worker.OutboxEvent, error) {
+	event, err := a.repo.FetchOutboxByID(ctx, eventID, createdAt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch event by ID: %w", err)
 	}
@@ -227,7 +170,7 @@ func (a *App) ProcessUnsentEvents(ctx context.Context, batchSize int32, processo
 			continue
 		}
 
-		if err := a.MarkEventSent(ctx, event.ID); err != nil {
+		if err := a.MarkEventSent(ctx, event.ID, event.CreatedAt); err != nil {
 			log.Error().
 				Err(err).
 				Str("event_id", event.ID.String()).
@@ -250,10 +193,11 @@ func (a *App) ProcessUnsentEvents(ctx context.Context, batchSize int32, processo
 	return nil
 }
 
-// validateEventPayload validates that the event payload is not empty
-func (a *App) validateEventPayload(payload []byte) error {
+// validateEventPayload checks the payload against the schema registered for its event type,
+// so a malformed event is rejected here rather than discovered by consumers
+func (a *App) validateEventPayload(eventType string, payload []byte) error {
 	if len(payload) == 0 {
 		return fmt.Errorf("event payload cannot be empty")
 	}
-	return nil
+	return events.Validate(eventType, payload)
 }
\ No newline at end of file
@@ -108,9 +108,11 @@ func (ns NullDraftStatus) Value() (driver.Value, error) {
 type DraftType string
 
 const (
-	DraftTypeSNAKE   DraftType = "SNAKE"
-	DraftTypeAUCTION DraftType = "AUCTION"
-	DraftTypeROOKIE  DraftType = "ROOKIE"
+	DraftTypeSNAKE     DraftType = "SNAKE"
+	DraftTypeAUCTION   DraftType = "AUCTION"
+	DraftTypeROOKIE    DraftType = "ROOKIE"
+	DraftTypeEXPANSION DraftType = "EXPANSION"
+	DraftTypeDISPERSAL DraftType = "DISPERSAL"
 )
 
 func (e *DraftType) Scan(src interface{}) error {
@@ -293,6 +295,11 @@ type Draft struct {
 	NextDeadline sql.NullTime    `json:"next_deadline"`
 }
 
+type DraftEventSequence struct {
+	DraftID uuid.UUID `json:"draft_id"`
+	LastSeq int64     `json:"last_seq"`
+}
+
 type DraftOutbox struct {
 	ID        uuid.UUID       `json:"id"`
 	DraftID   uuid.UUID       `json:"draft_id"`
@@ -300,6 +307,7 @@ type DraftOutbox struct {
 	Payload   json.RawMessage `json:"payload"`
 	CreatedAt time.Time       `json:"created_at"`
 	SentAt    sql.NullTime    `json:"sent_at"`
+	Seq       sql.NullInt64   `json:"seq"`
 }
 
 type DraftPick struct {
@@ -368,6 +376,10 @@ type RosterPlayer struct {
 	AcquiredAt      time.Time             `json:"acquired_at"`
 	AcquisitionType AcquisitionTypeEnum   `json:"acquisition_type"`
 	KeeperData      pqtype.NullRawMessage `json:"keeper_data"`
+	LineupSlot      sql.NullString        `json:"lineup_slot"`
+	Salary          sql.NullInt32         `json:"salary"`
+	ContractYears   sql.NullInt32         `json:"contract_years"`
+	FranchiseTagged bool                  `json:"franchise_tagged"`
 }
 
 type Sport struct {
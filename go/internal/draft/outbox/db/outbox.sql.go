@@ -7,9 +7,12 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const fetchOutboxByID = `-- name: FetchOutboxByID :one
@@ -17,37 +20,50 @@ SELECT
     id,
     draft_id,
     event_type,
-    payload
+    payload,
+    seq,
+    created_at
 FROM draft_outbox
 WHERE id = $1
+  AND created_at = $2
   AND sent_at IS NULL
     FOR UPDATE SKIP LOCKED
 `
 
+type FetchOutboxByIDParams struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type FetchOutboxByIDRow struct {
 	ID        uuid.UUID       `json:"id"`
 	DraftID   uuid.UUID       `json:"draft_id"`
 	EventType string          `json:"event_type"`
 	Payload   json.RawMessage `json:"payload"`
+	Seq       sql.NullInt64   `json:"seq"`
+	CreatedAt time.Time       `json:"created_at"`
 }
 
-func (q *Queries) FetchOutboxByID(ctx context.Context, id uuid.UUID) (FetchOutboxByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, fetchOutboxByID, id)
+// created_at, carried on the insert notification, narrows the lookup to the event's partition
+func (q *Queries) FetchOutboxByID(ctx context.Context, arg FetchOutboxByIDParams) (FetchOutboxByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchOutboxByID, arg.ID, arg.CreatedAt)
 	var i FetchOutboxByIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.DraftID,
 		&i.EventType,
 		&i.Payload,
+		&i.Seq,
+		&i.CreatedAt,
 	)
 	return i, err
 }
 
 const fetchUnsentOutbox = `-- name: FetchUnsentOutbox :many
-SELECT id, draft_id, event_type, payload
+SELECT id, draft_id, event_type, payload, seq, created_at
 FROM draft_outbox
 WHERE sent_at IS NULL
-ORDER BY created_at
+ORDER BY created_at, seq, id
 LIMIT $1
     FOR UPDATE SKIP LOCKED
 `
@@ -57,6 +73,8 @@ type FetchUnsentOutboxRow struct {
 	DraftID   uuid.UUID       `json:"draft_id"`
 	EventType string          `json:"event_type"`
 	Payload   json.RawMessage `json:"payload"`
+	Seq       sql.NullInt64   `json:"seq"`
+	CreatedAt time.Time       `json:"created_at"`
 }
 
 func (q *Queries) FetchUnsentOutbox(ctx context.Context, limit int32) ([]FetchUnsentOutboxRow, error) {
@@ -73,6 +91,8 @@ func (q *Queries) FetchUnsentOutbox(ctx context.Context, limit int32) ([]FetchUn
 			&i.DraftID,
 			&i.EventType,
 			&i.Payload,
+			&i.Seq,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -87,109 +107,218 @@ func (q *Queries) FetchUnsentOutbox(ctx context.Context, limit int32) ([]FetchUn
 	return items, nil
 }
 
-const insertOutboxDraftCompleted = `-- name: InsertOutboxDraftCompleted :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'DraftCompleted', $3)
+const getOutboxBacklog = `-- name: GetOutboxBacklog :one
+SELECT
+    COUNT(*) AS unsent,
+    COALESCE(EXTRACT(EPOCH FROM clock_timestamp() - MIN(created_at)), 0)::float8 AS oldest_age_sec
+FROM draft_outbox
+WHERE sent_at IS NULL
 `
 
-type InsertOutboxDraftCompletedParams struct {
-	ID      uuid.UUID       `json:"id"`
-	DraftID uuid.UUID       `json:"draft_id"`
-	Payload json.RawMessage `json:"payload"`
+type GetOutboxBacklogRow struct {
+	Unsent       int64   `json:"unsent"`
+	OldestAgeSec float64 `json:"oldest_age_sec"`
 }
 
-func (q *Queries) InsertOutboxDraftCompleted(ctx context.Context, arg InsertOutboxDraftCompletedParams) error {
-	_, err := q.db.ExecContext(ctx, insertOutboxDraftCompleted, arg.ID, arg.DraftID, arg.Payload)
-	return err
+// Counts unsent events and how long the oldest of them has been waiting
+func (q *Queries) GetOutboxBacklog(ctx context.Context) (GetOutboxBacklogRow, error) {
+	row := q.db.QueryRowContext(ctx, getOutboxBacklog)
+	var i GetOutboxBacklogRow
+	err := row.Scan(&i.Unsent, &i.OldestAgeSec)
+	return i, err
 }
 
-const insertOutboxDraftPaused = `-- name: InsertOutboxDraftPaused :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'DraftPaused', $3)
+const insertOutboxEvent = `-- name: InsertOutboxEvent :exec
+WITH next AS (
+    INSERT INTO draft_event_sequences (draft_id, last_seq)
+    VALUES ($2, 1)
+    ON CONFLICT (draft_id) DO UPDATE SET last_seq = draft_event_sequences.last_seq + 1
+    RETURNING last_seq
+)
+INSERT INTO draft_outbox (id, draft_id, event_type, payload, seq)
+SELECT $1, $2, $3, $4, next.last_seq
+FROM next
 `
 
-type InsertOutboxDraftPausedParams struct {
-	ID      uuid.UUID       `json:"id"`
-	DraftID uuid.UUID       `json:"draft_id"`
-	Payload json.RawMessage `json:"payload"`
+type InsertOutboxEventParams struct {
+	ID        uuid.UUID       `json:"id"`
+	DraftID   uuid.UUID       `json:"draft_id"`
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
 }
 
-func (q *Queries) InsertOutboxDraftPaused(ctx context.Context, arg InsertOutboxDraftPausedParams) error {
-	_, err := q.db.ExecContext(ctx, insertOutboxDraftPaused, arg.ID, arg.DraftID, arg.Payload)
+// Event types are validated against the event registry by the outbox app before insert
+func (q *Queries) InsertOutboxEvent(ctx context.Context, arg InsertOutboxEventParams) error {
+	_, err := q.db.ExecContext(ctx, insertOutboxEvent,
+		arg.ID,
+		arg.DraftID,
+		arg.EventType,
+		arg.Payload,
+	)
 	return err
 }
 
-const insertOutboxDraftResumed = `-- name: InsertOutboxDraftResumed :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'DraftResumed', $3)
+const insertOutboxEvents = `-- name: InsertOutboxEvents :exec
+WITH next AS (
+    INSERT INTO draft_event_sequences (draft_id, last_seq)
+    VALUES ($1, cardinality($2::uuid[]))
+    ON CONFLICT (draft_id) DO UPDATE SET last_seq = draft_event_sequences.last_seq + cardinality($2::uuid[])
+    RETURNING last_seq
+)
+INSERT INTO draft_outbox (id, draft_id, event_type, payload, seq)
+SELECT e.id, $1, e.event_type, e.payload::jsonb, next.last_seq - cardinality($2::uuid[]) + e.ord
+FROM next, unnest($2::uuid[], $3::text[], $4::text[]) WITH ORDINALITY AS e (id, event_type, payload, ord)
 `
 
-type InsertOutboxDraftResumedParams struct {
-	ID      uuid.UUID       `json:"id"`
-	DraftID uuid.UUID       `json:"draft_id"`
-	Payload json.RawMessage `json:"payload"`
+type InsertOutboxEventsParams struct {
+	DraftID    uuid.UUID   `json:"draft_id"`
+	Ids        []uuid.UUID `json:"ids"`
+	EventTypes []string    `json:"event_types"`
+	Payloads   []string    `json:"payloads"`
 }
 
-func (q *Queries) InsertOutboxDraftResumed(ctx context.Context, arg InsertOutboxDraftResumedParams) error {
-	_, err := q.db.ExecContext(ctx, insertOutboxDraftResumed, arg.ID, arg.DraftID, arg.Payload)
+// Inserts several events of a draft in one statement, so they commit or fail together, taking
+// consecutive sequence numbers in the order given. Payloads are passed as JSON text.
+func (q *Queries) InsertOutboxEvents(ctx context.Context, arg InsertOutboxEventsParams) error {
+	_, err := q.db.ExecContext(ctx, insertOutboxEvents,
+		arg.DraftID,
+		pq.Array(arg.Ids),
+		pq.Array(arg.EventTypes),
+		pq.Array(arg.Payloads),
+	)
 	return err
 }
 
-const insertOutboxDraftStarted = `-- name: InsertOutboxDraftStarted :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'DraftStarted', $3)
+const listDraftOutboxEvents = `-- name: ListDraftOutboxEvents :many
+SELECT id, draft_id, event_type, payload, seq, created_at
+FROM draft_outbox
+WHERE draft_id = $1
+  AND created_at >= (SELECT d.created_at FROM draft d WHERE d.id = $1)
+  AND ($2::bigint IS NULL OR seq IS NULL OR seq <= $2)
+ORDER BY seq NULLS FIRST, created_at, id
 `
 
-type InsertOutboxDraftStartedParams struct {
-	ID      uuid.UUID       `json:"id"`
-	DraftID uuid.UUID       `json:"draft_id"`
-	Payload json.RawMessage `json:"payload"`
+type ListDraftOutboxEventsParams struct {
+	DraftID uuid.UUID     `json:"draft_id"`
+	ToSeq   sql.NullInt64 `json:"to_seq"`
 }
 
-func (q *Queries) InsertOutboxDraftStarted(ctx context.Context, arg InsertOutboxDraftStartedParams) error {
-	_, err := q.db.ExecContext(ctx, insertOutboxDraftStarted, arg.ID, arg.DraftID, arg.Payload)
-	return err
-}
-
-const insertOutboxPickMade = `-- name: InsertOutboxPickMade :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'PickMade', $3)
-`
-
-type InsertOutboxPickMadeParams struct {
-	ID      uuid.UUID       `json:"id"`
-	DraftID uuid.UUID       `json:"draft_id"`
-	Payload json.RawMessage `json:"payload"`
+type ListDraftOutboxEventsRow struct {
+	ID        uuid.UUID       `json:"id"`
+	DraftID   uuid.UUID       `json:"draft_id"`
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
+	Seq       sql.NullInt64   `json:"seq"`
+	CreatedAt time.Time       `json:"created_at"`
 }
 
-func (q *Queries) InsertOutboxPickMade(ctx context.Context, arg InsertOutboxPickMadeParams) error {
-	_, err := q.db.ExecContext(ctx, insertOutboxPickMade, arg.ID, arg.DraftID, arg.Payload)
-	return err
+// A draft's events in the order they were written, up to to_seq when it is set, for replaying
+// them. Events written before sequencing have no seq and are always included. Partitions from
+// before the draft was created are skipped.
+func (q *Queries) ListDraftOutboxEvents(ctx context.Context, arg ListDraftOutboxEventsParams) ([]ListDraftOutboxEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDraftOutboxEvents, arg.DraftID, arg.ToSeq)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDraftOutboxEventsRow
+	for rows.Next() {
+		var i ListDraftOutboxEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DraftID,
+			&i.EventType,
+			&i.Payload,
+			&i.Seq,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const insertOutboxPickStarted = `-- name: InsertOutboxPickStarted :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'PickStarted', $3)
+const listDraftOutboxEventsSince = `-- name: ListDraftOutboxEventsSince :many
+SELECT id, event_type, payload, seq, created_at
+FROM draft_outbox
+WHERE draft_id = $1
+  AND created_at >= (SELECT d.created_at FROM draft d WHERE d.id = $1)
+  AND seq > $2::bigint
+  AND EXISTS (SELECT 1
+              FROM draft_event_sequences s
+              WHERE s.draft_id = $1
+                AND s.last_seq > $2::bigint)
+ORDER BY seq
+LIMIT $3
 `
 
-type InsertOutboxPickStartedParams struct {
-	ID      uuid.UUID       `json:"id"`
-	DraftID uuid.UUID       `json:"draft_id"`
-	Payload json.RawMessage `json:"payload"`
+type ListDraftOutboxEventsSinceParams struct {
+	DraftID  uuid.UUID `json:"draft_id"`
+	SinceSeq int64     `json:"since_seq"`
+	RowLimit int32     `json:"row_limit"`
 }
 
-func (q *Queries) InsertOutboxPickStarted(ctx context.Context, arg InsertOutboxPickStartedParams) error {
-	_, err := q.db.ExecContext(ctx, insertOutboxPickStarted, arg.ID, arg.DraftID, arg.Payload)
-	return err
+type ListDraftOutboxEventsSinceRow struct {
+	ID        uuid.UUID       `json:"id"`
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
+	Seq       sql.NullInt64   `json:"seq"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// A draft's events after since_seq in sequence order, for clients polling for changes. Each
+// partition is read through its (draft_id, seq) index, and a poll with nothing new stops at
+// the draft's event sequence without reading the outbox at all.
+func (q *Queries) ListDraftOutboxEventsSince(ctx context.Context, arg ListDraftOutboxEventsSinceParams) ([]ListDraftOutboxEventsSinceRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDraftOutboxEventsSince, arg.DraftID, arg.SinceSeq, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDraftOutboxEventsSinceRow
+	for rows.Next() {
+		var i ListDraftOutboxEventsSinceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.Payload,
+			&i.Seq,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const markOutboxSent = `-- name: MarkOutboxSent :exec
 UPDATE draft_outbox
 SET sent_at = NOW()
 WHERE id = $1
+  AND created_at = $2
 `
 
-func (q *Queries) MarkOutboxSent(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, markOutboxSent, id)
+type MarkOutboxSentParams struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// created_at narrows the update to the event's partition
+func (q *Queries) MarkOutboxSent(ctx context.Context, arg MarkOutboxSentParams) error {
+	_, err := q.db.ExecContext(ctx, markOutboxSent, arg.ID, arg.CreatedAt)
 	return err
 }
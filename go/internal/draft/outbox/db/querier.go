@@ -6,20 +6,29 @@ package db
 
 import (
 	"context"
-
-	"github.com/google/uuid"
 )
 
 type Querier interface {
-	FetchOutboxByID(ctx context.Context, id uuid.UUID) (FetchOutboxByIDRow, error)
+	// created_at, carried on the insert notification, narrows the lookup to the event's partition
+	FetchOutboxByID(ctx context.Context, arg FetchOutboxByIDParams) (FetchOutboxByIDRow, error)
 	FetchUnsentOutbox(ctx context.Context, limit int32) ([]FetchUnsentOutboxRow, error)
-	InsertOutboxDraftCompleted(ctx context.Context, arg InsertOutboxDraftCompletedParams) error
-	InsertOutboxDraftPaused(ctx context.Context, arg InsertOutboxDraftPausedParams) error
-	InsertOutboxDraftResumed(ctx context.Context, arg InsertOutboxDraftResumedParams) error
-	InsertOutboxDraftStarted(ctx context.Context, arg InsertOutboxDraftStartedParams) error
-	InsertOutboxPickMade(ctx context.Context, arg InsertOutboxPickMadeParams) error
-	InsertOutboxPickStarted(ctx context.Context, arg InsertOutboxPickStartedParams) error
-	MarkOutboxSent(ctx context.Context, id uuid.UUID) error
+	// Counts unsent events and how long the oldest of them has been waiting
+	GetOutboxBacklog(ctx context.Context) (GetOutboxBacklogRow, error)
+	// Event types are validated against the event registry by the outbox app before insert
+	InsertOutboxEvent(ctx context.Context, arg InsertOutboxEventParams) error
+	// Inserts several events of a draft in one statement, so they commit or fail together, taking
+	// consecutive sequence numbers in the order given. Payloads are passed as JSON text.
+	InsertOutboxEvents(ctx context.Context, arg InsertOutboxEventsParams) error
+	// A draft's events in the order they were written, up to to_seq when it is set, for replaying
+	// them. Events written before sequencing have no seq and are always included. Partitions from
+	// before the draft was created are skipped.
+	ListDraftOutboxEvents(ctx context.Context, arg ListDraftOutboxEventsParams) ([]ListDraftOutboxEventsRow, error)
+	// A draft's events after since_seq in sequence order, for clients polling for changes. Each
+	// partition is read through its (draft_id, seq) index, and a poll with nothing new stops at
+	// the draft's event sequence without reading the outbox at all.
+	ListDraftOutboxEventsSince(ctx context.Context, arg ListDraftOutboxEventsSinceParams) ([]ListDraftOutboxEventsSinceRow, error)
+	// created_at narrows the update to the event's partition
+	MarkOutboxSent(ctx context.Context, arg MarkOutboxSentParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -1,48 +1,90 @@
--- name: InsertOutboxPickMade :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'PickMade', $3);
+-- name: InsertOutboxEvent :exec
+-- Event types are validated against the event registry by the outbox app before insert
+WITH next AS (
+    INSERT INTO draft_event_sequences (draft_id, last_seq)
+    VALUES ($2, 1)
+    ON CONFLICT (draft_id) DO UPDATE SET last_seq = draft_event_sequences.last_seq + 1
+    RETURNING last_seq
+)
+INSERT INTO draft_outbox (id, draft_id, event_type, payload, seq)
+SELECT $1, $2, $3, $4, next.last_seq
+FROM next;
 
--- name: InsertOutboxPickStarted :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'PickStarted', $3);
-
--- name: InsertOutboxDraftStarted :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'DraftStarted', $3);
-
--- name: InsertOutboxDraftPaused :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'DraftPaused', $3);
-
--- name: InsertOutboxDraftResumed :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'DraftResumed', $3);
-
--- name: InsertOutboxDraftCompleted :exec
-INSERT INTO draft_outbox (id, draft_id, event_type, payload)
-VALUES ($1, $2, 'DraftCompleted', $3);
+-- name: InsertOutboxEvents :exec
+-- Inserts several events of a draft in one statement, so they commit or fail together, taking
+-- consecutive sequence numbers in the order given. Payloads are passed as JSON text.
+WITH next AS (
+    INSERT INTO draft_event_sequences (draft_id, last_seq)
+    VALUES (@draft_id, cardinality(@ids::uuid[]))
+    ON CONFLICT (draft_id) DO UPDATE SET last_seq = draft_event_sequences.last_seq + cardinality(@ids::uuid[])
+    RETURNING last_seq
+)
+INSERT INTO draft_outbox (id, draft_id, event_type, payload, seq)
+SELECT e.id, @draft_id, e.event_type, e.payload::jsonb, next.last_seq - cardinality(@ids::uuid[]) + e.ord
+FROM next, unnest(@ids::uuid[], @event_types::text[], @payloads::text[]) WITH ORDINALITY AS e (id, event_type, payload, ord);
 
 -- name: FetchUnsentOutbox :many
-SELECT id, draft_id, event_type, payload
+SELECT id, draft_id, event_type, payload, seq, created_at
 FROM draft_outbox
 WHERE sent_at IS NULL
-ORDER BY created_at
+ORDER BY created_at, seq, id
 LIMIT $1
     FOR UPDATE SKIP LOCKED;
 
 -- name: MarkOutboxSent :exec
+-- created_at narrows the update to the event's partition
 UPDATE draft_outbox
 SET sent_at = NOW()
-WHERE id = $1;
+WHERE id = $1
+  AND created_at = $2;
 
 
 -- name: FetchOutboxByID :one
+-- created_at, carried on the insert notification, narrows the lookup to the event's partition
 SELECT
     id,
     draft_id,
     event_type,
-    payload
+    payload,
+    seq,
+    created_at
 FROM draft_outbox
 WHERE id = $1
+  AND created_at = $2
   AND sent_at IS NULL
-    FOR UPDATE SKIP LOCKED;
\ No newline at end of file
+    FOR UPDATE SKIP LOCKED;
+
+-- name: GetOutboxBacklog :one
+-- Counts unsent events and how long the oldest of them has been waiting
+SELECT
+    COUNT(*) AS unsent,
+    COALESCE(EXTRACT(EPOCH FROM clock_timestamp() - MIN(created_at)), 0)::float8 AS oldest_age_sec
+FROM draft_outbox
+WHERE sent_at IS NULL;
+
+-- name: ListDraftOutboxEvents :many
+-- A draft's events in the order they were written, up to to_seq when it is set, for replaying
+-- them. Events written before sequencing have no seq and are always included. Partitions from
+-- before the draft was created are skipped.
+SELECT id, draft_id, event_type, payload, seq, created_at
+FROM draft_outbox
+WHERE draft_id = @draft_id
+  AND created_at >= (SELECT d.created_at FROM draft d WHERE d.id = @draft_id)
+  AND (sqlc.narg('to_seq')::bigint IS NULL OR seq IS NULL OR seq <= sqlc.narg('to_seq'))
+ORDER BY seq NULLS FIRST, created_at, id;
+
+-- name: ListDraftOutboxEventsSince :many
+-- A draft's events after since_seq in sequence order, for clients polling for changes. Each
+-- partition is read through its (draft_id, seq) index, and a poll with nothing new stops at
+-- the draft's event sequence without reading the outbox at all.
+SELECT id, event_type, payload, seq, created_at
+FROM draft_outbox
+WHERE draft_id = @draft_id
+  AND created_at >= (SELECT d.created_at FROM draft d WHERE d.id = @draft_id)
+  AND seq > @since_seq::bigint
+  AND EXISTS (SELECT 1
+              FROM draft_event_sequences s
+              WHERE s.draft_id = @draft_id
+                AND s.last_seq > @since_seq::bigint)
+ORDER BY seq
+LIMIT @row_limit;
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+)
+
+const eventsPkgPath = "github.com/mcdev12/dynasty/go/internal/draft/events"
+
+// Generates the outbox App's typed Insert<EventType>Event helpers from the event registry, so
+// a new event type only needs its payload registered. Run with go generate from the outbox
+// package, or with make generate-outbox-inserts.
+func main() {
+	out := "insert_events_gen.go"
+	if len(os.Args) > 1 {
+		out = os.Args[1]
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by go run ./gen; DO NOT EDIT.
+
+package outbox
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+)
+`)
+
+	for _, eventType := range events.Types() {
+		payloadType := events.PayloadType(eventType)
+		if payloadType.PkgPath() != eventsPkgPath {
+			fmt.Fprintf(os.Stderr, "%s: payload %s is not declared in the events package\n", eventType, payloadType)
+			os.Exit(1)
+		}
+		article := "a"
+		if strings.ContainsRune("AEIOU", rune(eventType[0])) {
+			article = "an"
+		}
+		fmt.Fprintf(&buf, `
+// Insert%[1]sEvent inserts %[3]s %[1]s event into the outbox
+func (a *App) Insert%[1]sEvent(ctx context.Context, draftID uuid.UUID, payload events.%[2]s) error {
+	return a.InsertEvent(ctx, draftID, events.%[1]s, payload)
+}
+`, eventType, payloadType.Name(), article)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to format generated helpers: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(out, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write %s: %v\n", out, err)
+		os.Exit(1)
+	}
+}
@@ -5,10 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mcdev12/dynasty/go/internal/draft/outbox/db"
 	"github.com/mcdev12/dynasty/go/internal/draft/outbox/worker"
+	"github.com/mcdev12/dynasty/go/internal/ids"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
 )
 
 type Repository struct {
@@ -21,80 +24,46 @@ func NewRepository(queries *db.Queries) *Repository {
 	}
 }
 
-func (r *Repository) InsertOutboxPickMade(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	err := r.queries.InsertOutboxPickMade(ctx, db.InsertOutboxPickMadeParams{
-		ID:      uuid.New(),
-		DraftID: draftID,
-		Payload: payload,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to insert PickMade outbox event: %w", err)
-	}
-	return nil
+// q returns the repository's queries, bound to the transaction in ctx if a service started one
+func (r *Repository) q(ctx context.Context) *db.Queries {
+	return sqlutil.Bind(ctx, r.queries, r.queries.WithTx)
 }
 
-func (r *Repository) InsertOutboxPickStarted(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	err := r.queries.InsertOutboxPickStarted(ctx, db.InsertOutboxPickStartedParams{
-		ID:      uuid.New(),
-		DraftID: draftID,
-		Payload: payload,
+// InsertOutboxEvent inserts an event into the outbox, next in its draft's sequence
+func (r *Repository) InsertOutboxEvent(ctx context.Context, draftID uuid.UUID, eventType string, payload []byte) error {
+	err := r.q(ctx).InsertOutboxEvent(ctx, db.InsertOutboxEventParams{
+		ID:        ids.New(),
+		DraftID:   draftID,
+		EventType: eventType,
+		Payload:   payload,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to insert PickStarted outbox event: %w", err)
+		return fmt.Errorf("failed to insert %s outbox event: %w", eventType, err)
 	}
 	return nil
 }
 
-func (r *Repository) InsertOutboxDraftStarted(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	err := r.queries.InsertOutboxDraftStarted(ctx, db.InsertOutboxDraftStartedParams{
-		ID:      uuid.New(),
-		DraftID: draftID,
-		Payload: payload,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to insert DraftStarted outbox event: %w", err)
+// InsertOutboxEvents inserts several events of a draft into the outbox together, next in its
+// sequence in the order given
+func (r *Repository) InsertOutboxEvents(ctx context.Context, draftID uuid.UUID, eventTypes []string, payloads [][]byte) error {
+	params := db.InsertOutboxEventsParams{
+		DraftID:    draftID,
+		Ids:        make([]uuid.UUID, len(eventTypes)),
+		EventTypes: eventTypes,
+		Payloads:   make([]string, len(payloads)),
 	}
-	return nil
-}
-
-func (r *Repository) InsertOutboxDraftPaused(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	err := r.queries.InsertOutboxDraftPaused(ctx, db.InsertOutboxDraftPausedParams{
-		ID:      uuid.New(),
-		DraftID: draftID,
-		Payload: payload,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to insert DraftPaused outbox event: %w", err)
+	for i := range eventTypes {
+		params.Ids[i] = ids.New()
+		params.Payloads[i] = string(payloads[i])
 	}
-	return nil
-}
-
-func (r *Repository) InsertOutboxDraftResumed(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	err := r.queries.InsertOutboxDraftResumed(ctx, db.InsertOutboxDraftResumedParams{
-		ID:      uuid.New(),
-		DraftID: draftID,
-		Payload: payload,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to insert DraftResumed outbox event: %w", err)
-	}
-	return nil
-}
-
-func (r *Repository) InsertOutboxDraftCompleted(ctx context.Context, draftID uuid.UUID, payload []byte) error {
-	err := r.queries.InsertOutboxDraftCompleted(ctx, db.InsertOutboxDraftCompletedParams{
-		ID:      uuid.New(),
-		DraftID: draftID,
-		Payload: payload,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to insert DraftCompleted outbox event: %w", err)
+	if err := r.q(ctx).InsertOutboxEvents(ctx, params); err != nil {
+		return fmt.Errorf("failed to insert %d outbox events: %w", len(eventTypes), err)
 	}
 	return nil
 }
 
 func (r *Repository) FetchUnsentOutbox(ctx context.Context, limit int32) ([]worker.OutboxEvent, error) {
-	rows, err := r.queries.FetchUnsentOutbox(ctx, limit)
+	rows, err := r.q(ctx).FetchUnsentOutbox(ctx, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch unsent outbox events: %w", err)
 	}
@@ -106,22 +75,41 @@ func (r *Repository) FetchUnsentOutbox(ctx context.Context, limit int32) ([]work
 			DraftID:   row.DraftID,
 			EventType: row.EventType,
 			Payload:   []byte(row.Payload),
+			CreatedAt: row.CreatedAt,
+			Sequence:  row.Seq.Int64,
 		}
 	}
 
 	return events, nil
 }
 
-func (r *Repository) MarkOutboxSent(ctx context.Context, id uuid.UUID) error {
-	err := r.queries.MarkOutboxSent(ctx, id)
+func (r *Repository) MarkOutboxSent(ctx context.Context, id uuid.UUID, createdAt time.Time) error {
+	err := r.q(ctx).MarkOutboxSent(ctx, db.MarkOutboxSentParams{
+		ID:        id,
+		CreatedAt: createdAt,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to mark outbox event as sent: %w", err)
 	}
 	return nil
 }
 
-func (r *Repository) FetchOutboxByID(ctx context.Context, id uuid.UUID) (*worker.OutboxEvent, error) {
-	row, err := r.queries.FetchOutboxByID(ctx, id)
+func (r *Repository) GetOutboxBacklog(ctx context.Context) (*worker.Backlog, error) {
+	row, err := r.q(ctx).GetOutboxBacklog(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbox backlog: %w", err)
+	}
+	return &worker.Backlog{
+		Unsent:    row.Unsent,
+		OldestAge: time.Duration(row.OldestAgeSec * float64(time.Second)),
+	}, nil
+}
+
+func (r *Repository) FetchOutboxByID(ctx context.Context, id uuid.UUID, createdAt time.Time) (*worker.OutboxEvent, error) {
+	row, err := r.q(ctx).FetchOutboxByID(ctx, db.FetchOutboxByIDParams{
+		ID:        id,
+		CreatedAt: createdAt,
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("outbox event not found or already sent")
@@ -134,5 +122,7 @@ func (r *Repository) FetchOutboxByID(ctx context.Context, id uuid.UUID) (*worker
 		DraftID:   row.DraftID,
 		EventType: row.EventType,
 		Payload:   []byte(row.Payload),
+		CreatedAt: row.CreatedAt,
+		Sequence:  row.Seq.Int64,
 	}, nil
 }
@@ -15,4 +15,11 @@ type OutboxEvent struct {
 	Payload   json.RawMessage `json:"payload"`
 	CreatedAt time.Time       `json:"created_at"`
 	SentAt    *time.Time      `json:"sent_at,omitempty"`
-}
\ No newline at end of file
+}
+
+// Event is one of the events of a composite action inserted together with InsertEvents. Type
+// is one of the event types in the events package and Payload its registered payload struct.
+type Event struct {
+	Type    string
+	Payload any
+}
@@ -3,20 +3,27 @@ package main
 import (
 	"context"
 	"database/sql"
+	"expvar"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 
+	"github.com/mcdev12/dynasty/go/internal/admin"
 	"github.com/mcdev12/dynasty/go/internal/dbconfig"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
 	"github.com/mcdev12/dynasty/go/internal/draft/outbox"
 	outboxdb "github.com/mcdev12/dynasty/go/internal/draft/outbox/db"
 	"github.com/mcdev12/dynasty/go/internal/draft/outbox/worker"
+	"github.com/mcdev12/dynasty/go/internal/faults"
 )
 
 func main() {
@@ -46,14 +53,54 @@ func main() {
 		Str("database", cfg.Database).
 		Msg("connected to database")
 
-	// JetStream publisher
+	// Publisher: NATS JetStream by default, Kafka with OUTBOX_PUBLISHER=kafka
 	jsCfg := worker.DefaultJetStreamConfig()
-	if url := os.Getenv("NATS_URL"); url != "" {
-		jsCfg.URL = url
+	jsCfg.NATS = natsconfig.NewConfigFromEnv()
+	if v := os.Getenv("DRAFT_ACTIVITY_MAX_AGE"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			retention := jsCfg.Retention[events.ActivityStream]
+			retention.MaxAge = d
+			jsCfg.Retention[events.ActivityStream] = retention
+		}
+	}
+	// Publishes awaiting their ack at once; NATS_ASYNC_WINDOW=0 waits for each ack in turn
+	if v := os.Getenv("NATS_ASYNC_WINDOW"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			jsCfg.AsyncWindow = n
+		}
+	}
+	if v := os.Getenv("NATS_ACK_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			jsCfg.AckTimeout = d
+		}
+	}
+	// Fault injection for testing the pipeline under failure; off unless FAULTS_ENABLED is set
+	faultCfg, err := faults.ConfigFromEnv()
+	if err != nil {
+		log.Fatal().Err(err).Msg("parse fault injection config")
+	}
+	if jsCfg.Faults = faults.NewInjector(faultCfg); jsCfg.Faults != nil {
+		log.Warn().Msg("fault injection enabled, outbox publishes will be delayed, dropped and duplicated")
+	}
+	kafkaCfg := worker.DefaultKafkaConfig()
+	if url := os.Getenv("KAFKA_REST_PROXY_URL"); url != "" {
+		kafkaCfg.RESTProxyURL = url
 	}
-	publisher, err := worker.NewJetStreamPublisher(jsCfg)
+	if prefix := os.Getenv("KAFKA_TOPIC_PREFIX"); prefix != "" {
+		kafkaCfg.TopicPrefix = prefix
+	}
+	if topics := os.Getenv("KAFKA_TOPIC_MAP"); topics != "" {
+		mapping, err := worker.ParseTopicMapping(topics)
+		if err != nil {
+			log.Fatal().Err(err).Msg("parse KAFKA_TOPIC_MAP")
+		}
+		kafkaCfg.TopicMapping = mapping
+	}
+
+	backend := worker.PublisherBackend(os.Getenv("OUTBOX_PUBLISHER"))
+	publisher, err := worker.NewEventPublisher(backend, jsCfg, kafkaCfg)
 	if err != nil {
-		log.Fatal().Err(err).Msg("create JetStream publisher")
+		log.Fatal().Err(err).Str("backend", string(backend)).Msg("create event publisher")
 	}
 	defer func() {
 		if err := publisher.Close(); err != nil {
@@ -69,6 +116,23 @@ func main() {
 			ltCfg.FallbackInterval = d
 		}
 	}
+	sloCfg := worker.DefaultSLOConfig()
+	if v := os.Getenv("OUTBOX_SLO_TARGET"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			sloCfg.Target = d
+		}
+	}
+	if v := os.Getenv("OUTBOX_SLO_OBJECTIVE"); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil && f > 0 && f <= 1 {
+			sloCfg.Objective = f
+		}
+	}
+	if v := os.Getenv("OUTBOX_SLO_WINDOW"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			sloCfg.Window = d
+		}
+	}
+	ltCfg.Latency = worker.NewLatencyRecorder(sloCfg)
 
 	// Create outbox repository and app
 	queries := outboxdb.New(db)
@@ -80,6 +144,62 @@ func main() {
 		log.Fatal().Err(err).Msg("create outbox listener")
 	}
 
+	// Health endpoint reporting broker connectivity and outbox backlog, alongside the publish
+	// latency SLO report and histograms
+	healthCfg := worker.DefaultHealthConfig()
+	if v := os.Getenv("OUTBOX_MAX_BACKLOG"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			healthCfg.MaxBacklog = n
+		}
+	}
+	if v := os.Getenv("OUTBOX_MAX_BACKLOG_AGE"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			healthCfg.MaxBacklogAge = d
+		}
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/health", worker.NewHealthChecker(publisher, app, healthCfg))
+	mux.Handle("/slo", ltCfg.Latency)
+	mux.Handle("/debug/vars", expvar.Handler())
+	admin.Mount(mux, admin.Config{
+		Service: "outbox-worker",
+		Token:   os.Getenv("ADMIN_API_TOKEN"),
+		Settings: func() any {
+			return map[string]any{
+				"publisher":         getEnv("OUTBOX_PUBLISHER", string(worker.PublisherBackendJetStream)),
+				"notify_channel":    ltCfg.NotifyChannel,
+				"fallback_interval": ltCfg.FallbackInterval.String(),
+				"batch_size":        ltCfg.BatchSize,
+				"lane_workers":      ltCfg.LaneWorkers,
+				"async_window":      jsCfg.AsyncWindow,
+				"ack_timeout":       jsCfg.AckTimeout.String(),
+				"max_backlog":       healthCfg.MaxBacklog,
+				"max_backlog_age":   healthCfg.MaxBacklogAge.String(),
+				"slo":               sloCfg,
+				"fault_injection":   faultCfg.Enabled(),
+			}
+		},
+	})
+	healthServer := &http.Server{
+		Addr:         getEnv("HEALTH_ADDR", ":8083"),
+		Handler:      mux,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+	go func() {
+		log.Info().Str("addr", healthServer.Addr).Msg("health check server starting")
+		if err := healthServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msg("health check server failed")
+		}
+	}()
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := healthServer.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("health check server shutdown failed")
+		}
+	}()
+
 	//GRACEFUL SHUTDOWN
 
 	// signal‐aware context
@@ -108,3 +228,10 @@ func main() {
 		log.Error().Err(err).Msg("listener exited unexpectedly")
 	}
 }
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,155 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// publishTracker remembers the outcome of the latest publishes for health reporting
+type publishTracker struct {
+	mu    sync.Mutex
+	stats PublishStats
+}
+
+func (t *publishTracker) record(err error) {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err != nil {
+		t.stats.LastFailureAt = &now
+		t.stats.LastError = err.Error()
+		return
+	}
+	t.stats.LastSuccessAt = &now
+}
+
+// Stats returns the outcome of the most recent publishes
+func (t *publishTracker) Stats() PublishStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stats
+}
+
+// BacklogSource reports the outbox events still waiting to be published
+type BacklogSource interface {
+	GetBacklog(ctx context.Context) (*Backlog, error)
+}
+
+type HealthConfig struct {
+	PingTimeout   time.Duration // how long a broker or database check may take
+	MaxBacklog    int64         // unsent events above which the worker reports degraded, 0 disables
+	MaxBacklogAge time.Duration // age of the oldest unsent event above which the worker reports degraded, 0 disables
+}
+
+func DefaultHealthConfig() HealthConfig {
+	return HealthConfig{
+		PingTimeout:   2 * time.Second,
+		MaxBacklog:    1000,
+		MaxBacklogAge: time.Minute,
+	}
+}
+
+// Health statuses, from best to worst
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusDegraded = "degraded"
+	HealthStatusDown     = "down"
+)
+
+// HealthReport is the body served by the worker's health endpoint
+type HealthReport struct {
+	Status  string        `json:"status"`
+	Broker  BrokerHealth  `json:"broker"`
+	Backlog BacklogHealth `json:"backlog"`
+}
+
+type BrokerHealth struct {
+	Connected     bool       `json:"connected"`
+	Error         string     `json:"error,omitempty"`
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"`
+	LastFailureAt *time.Time `json:"lastFailureAt,omitempty"`
+	LastError     string     `json:"lastError,omitempty"`
+}
+
+type BacklogHealth struct {
+	Unsent       int64   `json:"unsent"`
+	OldestAgeSec float64 `json:"oldestAgeSec"`
+	Error        string  `json:"error,omitempty"`
+}
+
+// HealthChecker reports broker connectivity, recent publish results and outbox backlog depth.
+// The worker is down when it can't reach the broker or the database, and degraded when it
+// can but the backlog is building up.
+type HealthChecker struct {
+	publisher EventPublisher
+	backlog   BacklogSource
+	cfg       HealthConfig
+}
+
+func NewHealthChecker(publisher EventPublisher, backlog BacklogSource, cfg HealthConfig) *HealthChecker {
+	return &HealthChecker{
+		publisher: publisher,
+		backlog:   backlog,
+		cfg:       cfg,
+	}
+}
+
+// Check runs the broker and backlog checks
+func (h *HealthChecker) Check(ctx context.Context) HealthReport {
+	ctx, cancel := context.WithTimeout(ctx, h.cfg.PingTimeout)
+	defer cancel()
+
+	report := HealthReport{Status: HealthStatusOK}
+
+	if reporter, ok := h.publisher.(HealthReporter); ok {
+		if err := reporter.Ping(ctx); err != nil {
+			report.Status = HealthStatusDown
+			report.Broker.Error = err.Error()
+		} else {
+			report.Broker.Connected = true
+		}
+		stats := reporter.Stats()
+		report.Broker.LastSuccessAt = stats.LastSuccessAt
+		report.Broker.LastFailureAt = stats.LastFailureAt
+		report.Broker.LastError = stats.LastError
+	} else {
+		// Nothing to check, assume the publisher manages its own connection
+		report.Broker.Connected = true
+	}
+
+	backlog, err := h.backlog.GetBacklog(ctx)
+	if err != nil {
+		report.Status = HealthStatusDown
+		report.Backlog.Error = err.Error()
+		return report
+	}
+	report.Backlog.Unsent = backlog.Unsent
+	report.Backlog.OldestAgeSec = backlog.OldestAge.Seconds()
+
+	if report.Status == HealthStatusOK {
+		if (h.cfg.MaxBacklog > 0 && backlog.Unsent > h.cfg.MaxBacklog) ||
+			(h.cfg.MaxBacklogAge > 0 && backlog.OldestAge > h.cfg.MaxBacklogAge) {
+			report.Status = HealthStatusDegraded
+		}
+	}
+	return report
+}
+
+// ServeHTTP serves the health report as JSON, with 503 when the worker is down
+func (h *HealthChecker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report := h.Check(r.Context())
+
+	w.Header().Set("Content-Type", "application/json")
+	if report.Status == HealthStatusDown {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Error().Err(err).Msg("failed to write health report")
+	}
+}
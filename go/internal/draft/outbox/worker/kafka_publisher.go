@@ -0,0 +1,241 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// KafkaConfig configures KafkaPublisher. Events are produced through a Kafka
+// REST Proxy (v2 API), which keeps the worker free of a native Kafka client.
+type KafkaConfig struct {
+	RESTProxyURL   string            // e.g. http://localhost:8082
+	TopicPrefix    string            // topic for unmapped event types is <prefix>.<EventType>
+	TopicMapping   map[string]string // event type -> topic overrides
+	MaxRetries     int               // retries for retriable produce failures
+	RetryBackoff   time.Duration     // multiplied by the attempt number
+	RequestTimeout time.Duration
+}
+
+func DefaultKafkaConfig() KafkaConfig {
+	return KafkaConfig{
+		RESTProxyURL:   "http://localhost:8082",
+		TopicPrefix:    "draft.events",
+		TopicMapping:   map[string]string{},
+		MaxRetries:     3,
+		RetryBackoff:   250 * time.Millisecond,
+		RequestTimeout: 10 * time.Second,
+	}
+}
+
+// ParseTopicMapping parses "EventType=topic" pairs separated by commas,
+// e.g. "PickMade=draft.picks,DraftStarted=draft.lifecycle"
+func ParseTopicMapping(s string) (map[string]string, error) {
+	mapping := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		eventType, topic, ok := strings.Cut(pair, "=")
+		if !ok || eventType == "" || topic == "" {
+			return nil, fmt.Errorf("invalid topic mapping %q, want EventType=topic", pair)
+		}
+		mapping[strings.TrimSpace(eventType)] = strings.TrimSpace(topic)
+	}
+	return mapping, nil
+}
+
+// KafkaPublisher publishes outbox events to Kafka. Records are keyed by draft ID
+// so every event of a draft lands on the same partition, in order.
+type KafkaPublisher struct {
+	publishTracker
+	client *http.Client
+	config KafkaConfig
+}
+
+func NewKafkaPublisher(cfg KafkaConfig) (*KafkaPublisher, error) {
+	if _, err := url.ParseRequestURI(cfg.RESTProxyURL); err != nil {
+		return nil, fmt.Errorf("invalid Kafka REST proxy URL: %w", err)
+	}
+	return &KafkaPublisher{
+		client: &http.Client{Timeout: cfg.RequestTimeout},
+		config: cfg,
+	}, nil
+}
+
+// kafkaProduceRequest is the v2 REST proxy produce body for JSON records
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// kafkaProduceResponse carries one delivery report per produced record
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		Partition int32   `json:"partition"`
+		Offset    int64   `json:"offset"`
+		ErrorCode *int    `json:"error_code"`
+		Error     *string `json:"error"`
+	} `json:"offsets"`
+}
+
+// errorCodeRetriable is the REST proxy's per-record error code for retriable failures
+const errorCodeRetriable = 2
+
+// errNotRetriable marks produce failures that another attempt won't fix
+var errNotRetriable = errors.New("not retriable")
+
+// Topic returns the topic an event type is published to
+func (p *KafkaPublisher) Topic(eventType string) string {
+	if topic, ok := p.config.TopicMapping[eventType]; ok {
+		return topic
+	}
+	return fmt.Sprintf("%s.%s", p.config.TopicPrefix, eventType)
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, event OutboxEvent) error {
+	topic := p.Topic(event.EventType)
+
+	body, err := json.Marshal(kafkaProduceRequest{
+		Records: []kafkaRecord{{
+			Key:   event.DraftID.String(),
+			Value: newEnvelope(event),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= p.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(p.config.RetryBackoff * time.Duration(attempt)):
+			}
+		}
+
+		partition, offset, err := p.produce(ctx, topic, body)
+		if err == nil {
+			log.Info().
+				Str("topic", topic).
+				Str("event_id", event.ID.String()).
+				Int32("partition", partition).
+				Int64("offset", offset).
+				Msg("published to Kafka")
+			p.record(nil)
+			return nil
+		}
+
+		lastErr = err
+		if errors.Is(err, errNotRetriable) {
+			break
+		}
+		log.Warn().
+			Err(err).
+			Int("attempt", attempt+1).
+			Str("topic", topic).
+			Str("event_id", event.ID.String()).
+			Msg("Kafka produce failed, retrying")
+	}
+
+	err = fmt.Errorf("publish to Kafka topic %s: %w", topic, lastErr)
+	p.record(err)
+	return err
+}
+
+// produce sends one record and returns its delivery report
+func (p *KafkaPublisher) produce(ctx context.Context, topic string, body []byte) (int32, int64, error) {
+	endpoint := strings.TrimRight(p.config.RESTProxyURL, "/") + "/topics/" + url.PathEscape(topic)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, 0, fmt.Errorf("build produce request: %w: %w", errNotRetriable, err)
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("produce request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, 0, fmt.Errorf("read produce response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("produce returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+		// Server errors and throttling are worth another attempt; other client errors are not
+		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+			return 0, 0, err
+		}
+		return 0, 0, fmt.Errorf("%w: %w", errNotRetriable, err)
+	}
+
+	var report kafkaProduceResponse
+	if err := json.Unmarshal(respBody, &report); err != nil {
+		return 0, 0, fmt.Errorf("decode produce response: %w", err)
+	}
+	if len(report.Offsets) != 1 {
+		return 0, 0, fmt.Errorf("expected 1 delivery report, got %d", len(report.Offsets))
+	}
+
+	offset := report.Offsets[0]
+	if offset.ErrorCode != nil {
+		msg := ""
+		if offset.Error != nil {
+			msg = *offset.Error
+		}
+		err := fmt.Errorf("record rejected (error code %d): %s", *offset.ErrorCode, msg)
+		if *offset.ErrorCode != errorCodeRetriable {
+			return 0, 0, fmt.Errorf("%w: %w", errNotRetriable, err)
+		}
+		return 0, 0, err
+	}
+
+	return offset.Partition, offset.Offset, nil
+}
+
+// Ping checks that the REST proxy is up and can reach the cluster by listing topics
+func (p *KafkaPublisher) Ping(ctx context.Context) error {
+	endpoint := strings.TrimRight(p.config.RESTProxyURL, "/") + "/topics"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("build topics request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("topics request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("topics returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (p *KafkaPublisher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
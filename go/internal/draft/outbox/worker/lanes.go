@@ -0,0 +1,229 @@
+package worker
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/rs/zerolog/log"
+)
+
+// orderingKey is what outbox events are ordered by. A draft's events bound for one stream are
+// published in the order they were written; events of other drafts, or bound for the draft's
+// other stream, go out independently of them.
+type orderingKey struct {
+	draftID uuid.UUID
+	stream  string
+}
+
+func orderingKeyOf(event OutboxEvent) orderingKey {
+	return orderingKey{draftID: event.DraftID, stream: events.Class(event.EventType).Stream()}
+}
+
+// lanes publishes outbox events through a pool of workers per stream, so a burst of activity
+// never queues ahead of picks. Each ordering key always lands on the same worker, which keeps
+// its events in order. Once an event fails to publish, or can't be queued, its key is blocked
+// and its later events are left unsent; the fallback poll unblocks the key and queues its
+// events again from the oldest unsent one.
+//
+// With an async publisher the workers don't wait for acks: a worker sends its events in order
+// while earlier ones are still awaiting theirs, and marks each published once its ack
+// arrives. An event whose async publish fails is published again synchronously before its
+// key is blocked.
+type lanes struct {
+	workers map[string][]chan OutboxEvent
+	publish func(ctx context.Context, event OutboxEvent) error
+
+	// publishAsync, when set, sends events without waiting for their acks
+	publishAsync func(ctx context.Context, event OutboxEvent, done func(error)) error
+	// acked marks an event published once its async publish is acked
+	acked  func(ctx context.Context, event OutboxEvent) error
+	window int
+
+	mu      sync.Mutex
+	blocked map[orderingKey]bool
+}
+
+func newLanes(workers, queueSize int, publish func(ctx context.Context, event OutboxEvent) error) *lanes {
+	l := &lanes{
+		workers: make(map[string][]chan OutboxEvent),
+		publish: publish,
+		blocked: make(map[orderingKey]bool),
+	}
+	for _, stream := range []string{events.StateStream, events.ActivityStream} {
+		for range max(workers, 1) {
+			l.workers[stream] = append(l.workers[stream], make(chan OutboxEvent, queueSize))
+		}
+	}
+	return l
+}
+
+// pipeline makes the workers publish asynchronously, with up to window events of all
+// workers awaiting their acks at once
+func (l *lanes) pipeline(window int, publishAsync func(ctx context.Context, event OutboxEvent, done func(error)) error, acked func(ctx context.Context, event OutboxEvent) error) {
+	l.window = window
+	l.publishAsync = publishAsync
+	l.acked = acked
+}
+
+// start runs the workers until ctx is cancelled
+func (l *lanes) start(ctx context.Context) {
+	for stream, queues := range l.workers {
+		for i, queue := range queues {
+			if l.publishAsync != nil {
+				go l.runAsync(ctx, stream, i, queue)
+			} else {
+				go l.run(ctx, stream, i, queue)
+			}
+		}
+	}
+}
+
+// dispatch queues an event on its ordering key's worker, reporting whether it was queued
+func (l *lanes) dispatch(event OutboxEvent) bool {
+	key := orderingKeyOf(event)
+	if l.isBlocked(key) {
+		log.Debug().
+			Str("event_id", event.ID.String()).
+			Str("draft_id", event.DraftID.String()).
+			Msg("earlier event of the draft unsent, leaving event for the fallback poll")
+		return false
+	}
+
+	select {
+	case l.queueFor(key) <- event:
+		return true
+	default:
+		l.block(key)
+		log.Warn().
+			Str("event_id", event.ID.String()).
+			Str("stream", key.stream).
+			Msg("publish lane full, leaving event for the fallback poll")
+		return false
+	}
+}
+
+// run publishes the events queued on one worker
+func (l *lanes) run(ctx context.Context, stream string, worker int, queue chan OutboxEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-queue:
+			key := orderingKeyOf(event)
+			if l.isBlocked(key) {
+				continue
+			}
+			if err := l.publish(ctx, event); err != nil {
+				l.fail(key, event, stream, worker, err)
+				continue
+			}
+			log.Info().Str("event_id", event.ID.String()).Msg("published and marked event as sent")
+		}
+	}
+}
+
+// asyncResult is the outcome of an async publish
+type asyncResult struct {
+	event OutboxEvent
+	err   error
+}
+
+// runAsync sends the events queued on one worker without waiting for their acks, which are
+// settled as they arrive
+func (l *lanes) runAsync(ctx context.Context, stream string, worker int, queue chan OutboxEvent) {
+	// The window bounds the acks outstanding, so they never fill the buffer while the
+	// settling keeps up
+	results := make(chan asyncResult, max(l.window, 1))
+	report := func(event OutboxEvent, err error) {
+		select {
+		case results <- asyncResult{event: event, err: err}:
+		case <-ctx.Done():
+		}
+	}
+	go l.settle(ctx, stream, worker, results)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-queue:
+			if l.isBlocked(orderingKeyOf(event)) {
+				continue
+			}
+			if err := l.publishAsync(ctx, event, func(err error) { report(event, err) }); err != nil {
+				report(event, err)
+			}
+		}
+	}
+}
+
+// settle marks acked events published, and publishes events whose async publish failed
+// again synchronously
+func (l *lanes) settle(ctx context.Context, stream string, worker int, results chan asyncResult) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case result := <-results:
+			event, key := result.event, orderingKeyOf(result.event)
+			if result.err == nil {
+				if err := l.acked(ctx, event); err != nil {
+					l.fail(key, event, stream, worker, err)
+					continue
+				}
+				log.Info().Str("event_id", event.ID.String()).Msg("published and marked event as sent")
+				continue
+			}
+
+			log.Warn().
+				Err(result.err).
+				Str("event_id", event.ID.String()).
+				Msg("async publish failed, publishing event again")
+			if err := l.publish(ctx, event); err != nil {
+				l.fail(key, event, stream, worker, err)
+				continue
+			}
+			log.Info().Str("event_id", event.ID.String()).Msg("published and marked event as sent")
+		}
+	}
+}
+
+// fail blocks the key of an event that couldn't be published
+func (l *lanes) fail(key orderingKey, event OutboxEvent, stream string, worker int, err error) {
+	l.block(key)
+	log.Error().
+		Err(err).
+		Str("event_id", event.ID.String()).
+		Str("stream", stream).
+		Int("worker", worker).
+		Msg("failed to publish event, holding back the draft's later events")
+}
+
+// queueFor returns the queue of the worker a key's events always go to
+func (l *lanes) queueFor(key orderingKey) chan OutboxEvent {
+	queues := l.workers[key.stream]
+	h := fnv.New32a()
+	h.Write(key.draftID[:])
+	return queues[h.Sum32()%uint32(len(queues))]
+}
+
+func (l *lanes) isBlocked(key orderingKey) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.blocked[key]
+}
+
+func (l *lanes) block(key orderingKey) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.blocked[key] = true
+}
+
+func (l *lanes) unblock(key orderingKey) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.blocked, key)
+}
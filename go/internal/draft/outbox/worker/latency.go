@@ -0,0 +1,244 @@
+package worker
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// SLOConfig is the objective for how quickly outbox events reach the broker after they are
+// written. Events published over the LISTEN/NOTIFY path take milliseconds; events only the
+// fallback poll picks up take up to its interval, so a notify path that silently stopped
+// working shows up as the objective being missed.
+type SLOConfig struct {
+	Target    time.Duration // how soon after being written an event should be published
+	Objective float64       // share of events that must be published within Target, e.g. 0.99
+	Window    time.Duration // how far back the report looks, rounded up to whole minutes
+}
+
+func DefaultSLOConfig() SLOConfig {
+	return SLOConfig{
+		Target:    500 * time.Millisecond,
+		Objective: 0.99,
+		Window:    time.Hour,
+	}
+}
+
+// publishLatencyBucketsMs are the upper bounds of the publish latency histogram buckets. They
+// reach past the fallback poll interval, where events the notify path missed land.
+var publishLatencyBucketsMs = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000}
+
+var publishLatencies = expvar.NewMap("outbox_publish_latency")
+
+// sloSlot counts the publishes of one minute, per event type
+type sloSlot struct {
+	minute int64 // unix minute the counts are for
+	counts map[string]*sloCounts
+}
+
+type sloCounts struct {
+	published    int64
+	withinTarget int64
+}
+
+// LatencyRecorder records how long each outbox event waited between being written and being
+// published. Latencies are published as histograms per event type under
+// "outbox_publish_latency" at /debug/vars, and the recorder serves a report of the last
+// window's publishes against the SLO. A nil LatencyRecorder records nothing.
+type LatencyRecorder struct {
+	cfg SLOConfig
+
+	mu    sync.Mutex
+	slots []sloSlot // ring of one slot per minute of the window
+}
+
+func NewLatencyRecorder(cfg SLOConfig) *LatencyRecorder {
+	minutes := int((cfg.Window + time.Minute - 1) / time.Minute)
+	return &LatencyRecorder{
+		cfg:   cfg,
+		slots: make([]sloSlot, max(minutes, 1)),
+	}
+}
+
+// Record records the publish of event at publishedAt
+func (r *LatencyRecorder) Record(event OutboxEvent, publishedAt time.Time) {
+	if r == nil || event.CreatedAt.IsZero() {
+		return
+	}
+	latency := max(publishedAt.Sub(event.CreatedAt), 0)
+	latencyHistogram(event.EventType).observe(latency)
+
+	minute := publishedAt.Unix() / 60
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	slot := &r.slots[minute%int64(len(r.slots))]
+	if slot.minute != minute || slot.counts == nil {
+		*slot = sloSlot{minute: minute, counts: make(map[string]*sloCounts)}
+	}
+	counts, ok := slot.counts[event.EventType]
+	if !ok {
+		counts = &sloCounts{}
+		slot.counts[event.EventType] = counts
+	}
+	counts.published++
+	if latency <= r.cfg.Target {
+		counts.withinTarget++
+	}
+}
+
+// SLOReport is the body served by the worker's SLO endpoint
+type SLOReport struct {
+	Met          bool           `json:"met"`
+	TargetMs     int64          `json:"targetMs"`
+	Objective    float64        `json:"objective"`
+	WindowSec    float64        `json:"windowSec"`
+	Published    int64          `json:"published"`
+	WithinTarget int64          `json:"withinTarget"`
+	Ratio        float64        `json:"ratio"`
+	EventTypes   []EventTypeSLO `json:"eventTypes"`
+}
+
+// EventTypeSLO is how the publishes of one event type fared against the SLO
+type EventTypeSLO struct {
+	EventType    string  `json:"eventType"`
+	Met          bool    `json:"met"`
+	Published    int64   `json:"published"`
+	WithinTarget int64   `json:"withinTarget"`
+	Ratio        float64 `json:"ratio"`
+}
+
+// Report sums up the publishes of the window ending at now. With nothing published the SLO
+// counts as met.
+func (r *LatencyRecorder) Report(now time.Time) SLOReport {
+	report := SLOReport{
+		TargetMs:   r.cfg.Target.Milliseconds(),
+		Objective:  r.cfg.Objective,
+		EventTypes: []EventTypeSLO{},
+	}
+
+	r.mu.Lock()
+	report.WindowSec = float64(len(r.slots) * 60)
+	oldest := now.Unix()/60 - int64(len(r.slots)) + 1
+	totals := make(map[string]sloCounts)
+	for _, slot := range r.slots {
+		if slot.minute < oldest {
+			continue
+		}
+		for eventType, counts := range slot.counts {
+			total := totals[eventType]
+			total.published += counts.published
+			total.withinTarget += counts.withinTarget
+			totals[eventType] = total
+		}
+	}
+	r.mu.Unlock()
+
+	for eventType, counts := range totals {
+		ratio := sloRatio(counts)
+		report.EventTypes = append(report.EventTypes, EventTypeSLO{
+			EventType:    eventType,
+			Met:          ratio >= r.cfg.Objective,
+			Published:    counts.published,
+			WithinTarget: counts.withinTarget,
+			Ratio:        ratio,
+		})
+		report.Published += counts.published
+		report.WithinTarget += counts.withinTarget
+	}
+	sort.Slice(report.EventTypes, func(i, j int) bool {
+		return report.EventTypes[i].EventType < report.EventTypes[j].EventType
+	})
+	report.Ratio = sloRatio(sloCounts{published: report.Published, withinTarget: report.WithinTarget})
+	report.Met = report.Ratio >= r.cfg.Objective
+	return report
+}
+
+func sloRatio(counts sloCounts) float64 {
+	if counts.published == 0 {
+		return 1
+	}
+	return float64(counts.withinTarget) / float64(counts.published)
+}
+
+// ServeHTTP serves the SLO report as JSON
+func (r *LatencyRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	report := r.Report(time.Now())
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Error().Err(err).Msg("failed to write SLO report")
+	}
+}
+
+var latencyHistogramsMu sync.Mutex
+
+// latencyHistogram returns the publish latency histogram of an event type
+func latencyHistogram(eventType string) *durationHistogram {
+	if v, ok := publishLatencies.Get(eventType).(*durationHistogram); ok {
+		return v
+	}
+	latencyHistogramsMu.Lock()
+	defer latencyHistogramsMu.Unlock()
+	if v, ok := publishLatencies.Get(eventType).(*durationHistogram); ok {
+		return v
+	}
+	v := &durationHistogram{counts: make([]int64, len(publishLatencyBucketsMs)+1)}
+	publishLatencies.Set(eventType, v)
+	return v
+}
+
+// durationHistogram is a cumulative histogram of durations that renders as JSON for expvar
+type durationHistogram struct {
+	mu     sync.Mutex
+	counts []int64 // per bucket, the last one past the largest bound
+	count  int64
+	sumMs  float64
+}
+
+func (h *durationHistogram) observe(d time.Duration) {
+	ms := float64(d) / float64(time.Millisecond)
+	bucket := len(publishLatencyBucketsMs)
+	for i, bound := range publishLatencyBucketsMs {
+		if ms <= bound {
+			bucket = i
+			break
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.counts[bucket]++
+	h.count++
+	h.sumMs += ms
+}
+
+// String renders the histogram as {"count":n,"sum_ms":n,"buckets":{"le_5":n,...,"le_inf":n}}
+// with cumulative bucket counts
+func (h *durationHistogram) String() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	buckets := make(map[string]int64, len(h.counts))
+	var cumulative int64
+	for i, n := range h.counts {
+		cumulative += n
+		key := "le_inf"
+		if i < len(publishLatencyBucketsMs) {
+			key = "le_" + strconv.FormatFloat(publishLatencyBucketsMs[i], 'f', -1, 64)
+		}
+		buckets[key] = cumulative
+	}
+
+	out, _ := json.Marshal(struct {
+		Count   int64            `json:"count"`
+		SumMs   float64          `json:"sum_ms"`
+		Buckets map[string]int64 `json:"buckets"`
+	}{h.count, h.sumMs, buckets})
+	return string(out)
+}
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -18,6 +19,10 @@ type ListenerConfig struct {
 	RetryDelay       time.Duration
 	PingInterval     time.Duration
 	BatchSize        int32 // Max events to fetch per batch
+	LaneWorkers      int   // Publishing workers per stream
+	LaneQueueSize    int   // Events queued per worker before more are left for the fallback poll
+	// Latency records how long events waited to be published; nil records nothing
+	Latency *LatencyRecorder
 }
 
 func DefaultListenerConfig() ListenerConfig {
@@ -29,29 +34,27 @@ func DefaultListenerConfig() ListenerConfig {
 		RetryDelay:       200 * time.Millisecond,
 		PingInterval:     90 * time.Second,
 		BatchSize:        100,
+		LaneWorkers:      4,
+		LaneQueueSize:    256,
 	}
 }
 
-// Publisher is an interface that defines our publisher.
-type Publisher interface {
-	Publish(ctx context.Context, event OutboxEvent) error
-}
-
 // OutboxApp defines the app interface for outbox operations
 type OutboxApp interface {
-	GetEventByID(ctx context.Context, eventID uuid.UUID) (*OutboxEvent, error)
-	MarkEventSent(ctx context.Context, eventID uuid.UUID) error
+	GetEventByID(ctx context.Context, eventID uuid.UUID, createdAt time.Time) (*OutboxEvent, error)
+	MarkEventSent(ctx context.Context, eventID uuid.UUID, createdAt time.Time) error
 	FetchUnsentEvents(ctx context.Context, limit int32) ([]OutboxEvent, error)
 }
 
 type Listener struct {
 	app       OutboxApp
 	listener  *pq.Listener
-	publisher Publisher
+	publisher EventPublisher
+	lanes     *lanes
 	cfg       ListenerConfig
 }
 
-func NewListener(app OutboxApp, publisher Publisher, cfg ListenerConfig) (*Listener, error) {
+func NewListener(app OutboxApp, publisher EventPublisher, cfg ListenerConfig) (*Listener, error) {
 	l := pq.NewListener(
 		cfg.DatabaseURL,
 		10*time.Second,
@@ -70,12 +73,18 @@ func NewListener(app OutboxApp, publisher Publisher, cfg ListenerConfig) (*Liste
 		Str("channel", cfg.NotifyChannel).
 		Msg("listening for notifications")
 
-	return &Listener{
+	listener := &Listener{
 		app:       app,
 		listener:  l,
 		publisher: publisher,
 		cfg:       cfg,
-	}, nil
+	}
+	listener.lanes = newLanes(cfg.LaneWorkers, cfg.LaneQueueSize, listener.publishWithRetry)
+	if async, ok := publisher.(AsyncEventPublisher); ok && async.InFlightWindow() > 0 {
+		listener.lanes.pipeline(async.InFlightWindow(), async.PublishAsync, listener.markPublished)
+		log.Info().Int("window", async.InFlightWindow()).Msg("publishing asynchronously")
+	}
+	return listener, nil
 }
 
 func (l *Listener) Start(ctx context.Context) error {
@@ -85,6 +94,8 @@ func (l *Listener) Start(ctx context.Context) error {
 		Dur("fallback_interval", l.cfg.FallbackInterval).
 		Msg("listener started")
 
+	l.lanes.start(ctx)
+
 	pingTicker := time.NewTicker(l.cfg.PingInterval)
 	fallbackTicker := time.NewTicker(l.cfg.FallbackInterval)
 	defer pingTicker.Stop()
@@ -121,37 +132,36 @@ func (l *Listener) Stop() error {
 	return l.listener.Close()
 }
 
+// outboxNotification is the payload of an outbox insert notification: the event's id and
+// creation time, which together locate its row in the partitioned outbox
+type outboxNotification struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 // handleNotification handles a pg listen notification. Extra is the payload on the note.
-// It fetches the outbox event from the db, constructs an event and then publishes it.
+// It fetches the outbox event from the db and queues it on its publishing lane.
 func (l *Listener) handleNotification(ctx context.Context, extra string) error {
-	id, err := uuid.Parse(extra)
-	if err != nil {
-		log.Error().Err(err).Msg("invalid event ID in notification")
-		return fmt.Errorf("invalid event ID in notification: %w", err)
+	var note outboxNotification
+	if err := json.Unmarshal([]byte(extra), &note); err != nil {
+		log.Error().Err(err).Msg("invalid outbox notification")
+		return fmt.Errorf("invalid outbox notification: %w", err)
 	}
 
-	event, err := l.app.GetEventByID(ctx, id)
+	event, err := l.app.GetEventByID(ctx, note.ID, note.CreatedAt)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to fetch outbox event")
 		return fmt.Errorf("failed to fetch outbox event: %w", err)
 	}
 
-	err = l.publishWithRetry(ctx, *event)
-	if err != nil {
-		return fmt.Errorf("failed to publish event: %w", err)
-	}
-
-	if err := l.app.MarkEventSent(ctx, id); err != nil {
-		log.Error().Err(err).Str("event_id", id.String()).Msg("failed to mark outbox event as sent")
-		return err
-	}
-
-	log.Info().Str("event_id", id.String()).Msg("published and marked event as sent")
+	l.lanes.dispatch(*event)
 	return nil
 }
 
 // TODO Fix int32 type on batch size
-// processUnsent processes unsent message in our draft outbox.
+// processUnsent processes unsent message in our draft outbox. The batch holds each draft's
+// unsent events oldest first, so the draft's ordering keys are unblocked and its events
+// queued again from the one that last failed.
 func (l *Listener) processUnsent(ctx context.Context) error {
 	unsent, err := l.app.FetchUnsentEvents(ctx, l.cfg.BatchSize)
 	if err != nil {
@@ -159,17 +169,13 @@ func (l *Listener) processUnsent(ctx context.Context) error {
 		return fmt.Errorf("failed to fetch unsent outbox events: %w", err)
 	}
 
+	retried := make(map[orderingKey]bool)
 	for _, event := range unsent {
-		err := l.publishWithRetry(ctx, event)
-		if err != nil {
-			log.Error().Err(err).Str("event_id", event.ID.String()).Msg("failed to publish event")
-			continue
-		}
-
-		if err := l.app.MarkEventSent(ctx, event.ID); err != nil {
-			log.Error().Err(err).Str("event_id", event.ID.String()).Msg("failed to mark outbox event as sent")
-			continue
+		if key := orderingKeyOf(event); !retried[key] {
+			retried[key] = true
+			l.lanes.unblock(key)
 		}
+		l.lanes.dispatch(event)
 	}
 	return nil
 
@@ -199,9 +205,7 @@ func (l *Listener) publishWithRetry(ctx context.Context, event OutboxEvent) erro
 				Msg("failed to publish, retrying")
 			continue
 		}
-
-		if err := l.app.MarkEventSent(ctx, event.ID); err != nil {
-			log.Error().Err(err).Str("event_id", event.ID.String()).Msg("failed to mark outbox event as sent")
+		if err := l.markPublished(ctx, event); err != nil {
 			return err
 		}
 
@@ -217,3 +221,14 @@ func (l *Listener) publishWithRetry(ctx context.Context, event OutboxEvent) erro
 	// All attempts exhausted
 	return fmt.Errorf("publish failed after %d attempts: %w", l.cfg.MaxRetries+1, lastErr)
 }
+
+// markPublished records an event the broker has acked as sent
+func (l *Listener) markPublished(ctx context.Context, event OutboxEvent) error {
+	l.cfg.Latency.Record(event, time.Now())
+
+	if err := l.app.MarkEventSent(ctx, event.ID, event.CreatedAt); err != nil {
+		log.Error().Err(err).Str("event_id", event.ID.String()).Msg("failed to mark outbox event as sent")
+		return err
+	}
+	return nil
+}
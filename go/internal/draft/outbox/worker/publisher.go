@@ -1,46 +1,106 @@
 package worker
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/faults"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/rs/zerolog/log"
 )
 
 type JetStreamConfig struct {
-	URL             string
-	StreamName      string
-	SubjectPrefix   string
+	NATS            natsconfig.Config
 	MaxReconnects   int
 	ReconnectWait   time.Duration
-	MaxAge          time.Duration // How long to keep messages
-	MaxMsgs         int64         // Max number of messages to keep
-	Replicas        int           // Number of replicas for the stream
+	Replicas        int           // Number of replicas for each stream
 	DuplicateWindow time.Duration // Window for duplicate detection
+	// Retention of each draft event stream, keyed by stream name
+	Retention map[string]StreamRetention
+	// Faults delays, drops and duplicates publishes for fault injection testing; nil
+	// injects nothing
+	Faults *faults.Injector
+	// AsyncWindow is how many publishes may await their ack at once; 0 publishes one event
+	// at a time, each waiting for its ack
+	AsyncWindow int
+	AckTimeout  time.Duration // How long an async publish waits for its ack before failing
+}
+
+// StreamRetention is how long a draft event stream keeps its messages
+type StreamRetention struct {
+	MaxAge  time.Duration // How long to keep messages
+	MaxMsgs int64         // Max number of messages to keep
 }
 
 func DefaultJetStreamConfig() JetStreamConfig {
 	return JetStreamConfig{
-		URL:             nats.DefaultURL,
-		StreamName:      "DRAFT_EVENTS",
-		SubjectPrefix:   "draft.events",
+		NATS:            natsconfig.Default(),
 		MaxReconnects:   -1, // Infinite
 		ReconnectWait:   2 * time.Second,
-		MaxAge:          7 * 24 * time.Hour, // 7 days
-		MaxMsgs:         -1,                 // No limit
 		Replicas:        1,
 		DuplicateWindow: 2 * time.Hour,
+		AsyncWindow:     128,
+		AckTimeout:      5 * time.Second,
+		Retention: map[string]StreamRetention{
+			events.StateStream: {
+				MaxAge:  7 * 24 * time.Hour, // 7 days
+				MaxMsgs: -1,                 // No limit
+			},
+			// Activity only matters while it's live; the gateway replays at most the last
+			// message of each subject to a new consumer
+			events.ActivityStream: {
+				MaxAge:  time.Hour,
+				MaxMsgs: 1_000_000,
+			},
+		},
+	}
+}
+
+// NewEventPublisher creates the publisher for the selected backend
+func NewEventPublisher(backend PublisherBackend, jsCfg JetStreamConfig, kafkaCfg KafkaConfig) (EventPublisher, error) {
+	switch backend {
+	case PublisherBackendJetStream, "":
+		return NewJetStreamPublisher(jsCfg)
+	case PublisherBackendKafka:
+		return NewKafkaPublisher(kafkaCfg)
+	default:
+		return nil, fmt.Errorf("unknown publisher backend %q", backend)
 	}
 }
 
 type JetStreamPublisher struct {
+	publishTracker
 	nc     *nats.Conn
 	js     jetstream.JetStream
 	config JetStreamConfig
+
+	// window holds a slot for each async publish awaiting its ack
+	window chan struct{}
+
+	mu       sync.Mutex
+	inFlight map[uuid.UUID]*pendingPublish
+	sent     uint64 // Orders in-flight publishes for resending
+}
+
+// pendingPublish is an async publish awaiting its ack
+type pendingPublish struct {
+	event OutboxEvent
+	msg   *nats.Msg
+	opts  []jetstream.PublishOpt
+	done  func(error)
+	order uint64
+	// attempt counts sends of the publish; acks of earlier sends are ignored once it is resent
+	attempt int
 }
 
 func NewJetStreamPublisher(cfg JetStreamConfig) (*JetStreamPublisher, error) {
@@ -48,6 +108,7 @@ func NewJetStreamPublisher(cfg JetStreamConfig) (*JetStreamPublisher, error) {
 	// log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stdout})
 	// zerolog.SetGlobalLevel(zerolog.DebugLevel)
 
+	var p *JetStreamPublisher
 	opts := []nats.Option{
 		nats.MaxReconnects(cfg.MaxReconnects),
 		nats.ReconnectWait(cfg.ReconnectWait),
@@ -56,54 +117,94 @@ func NewJetStreamPublisher(cfg JetStreamConfig) (*JetStreamPublisher, error) {
 		}),
 		nats.ReconnectHandler(func(nc *nats.Conn) {
 			log.Info().Str("url", nc.ConnectedUrl()).Msg("NATS reconnected")
+			if p != nil {
+				p.resendInFlight()
+			}
 		}),
 		nats.ErrorHandler(func(nc *nats.Conn, sub *nats.Subscription, err error) {
 			log.Error().Err(err).Msg("NATS error")
 		}),
 	}
 
-	nc, err := nats.Connect(cfg.URL, opts...)
+	nc, err := cfg.NATS.Connect(opts...)
 	if err != nil {
 		return nil, fmt.Errorf("connect to NATS: %w", err)
 	}
 
-	js, err := jetstream.New(nc)
+	var jsOpts []jetstream.JetStreamOpt
+	if cfg.AsyncWindow > 0 {
+		// Room for a full window to be resent after a reconnect while the acks of the first
+		// sends are still outstanding
+		jsOpts = append(jsOpts,
+			jetstream.WithPublishAsyncMaxPending(2*cfg.AsyncWindow),
+			jetstream.WithPublishAsyncTimeout(cfg.AckTimeout),
+		)
+	}
+	js, err := jetstream.New(nc, jsOpts...)
 	if err != nil {
 		nc.Close()
 		return nil, fmt.Errorf("create JetStream context: %w", err)
 	}
 
-	p := &JetStreamPublisher{nc: nc, js: js, config: cfg}
+	p = &JetStreamPublisher{
+		nc:       nc,
+		js:       js,
+		config:   cfg,
+		window:   make(chan struct{}, max(cfg.AsyncWindow, 1)),
+		inFlight: make(map[uuid.UUID]*pendingPublish),
+	}
 
-	if err := p.ensureStream(context.Background()); err != nil {
+	if err := EnsureStreams(context.Background(), js, cfg); err != nil {
 		nc.Close()
-		return nil, fmt.Errorf("ensure stream: %w", err)
+		return nil, fmt.Errorf("ensure streams: %w", err)
 	}
 
 	return p, nil
 }
 
-func (p *JetStreamPublisher) ensureStream(ctx context.Context) error {
-	sc := jetstream.StreamConfig{
-		Name:        p.config.StreamName,
+// EnsureStreams creates the draft event streams, or updates them to match cfg
+func EnsureStreams(ctx context.Context, js jetstream.JetStream, cfg JetStreamConfig) error {
+	for _, name := range []string{events.StateStream, events.ActivityStream} {
+		if err := ensureStream(ctx, js, streamConfig(name, cfg)); err != nil {
+			return fmt.Errorf("stream %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// streamConfig is the configuration of a draft event stream
+func streamConfig(name string, cfg JetStreamConfig) jetstream.StreamConfig {
+	retention, ok := cfg.Retention[name]
+	if !ok {
+		retention = StreamRetention{MaxMsgs: -1}
+	}
+	duplicates := cfg.DuplicateWindow
+	if retention.MaxAge > 0 && duplicates > retention.MaxAge {
+		// JetStream rejects a duplicate window longer than the stream keeps messages
+		duplicates = retention.MaxAge
+	}
+	return jetstream.StreamConfig{
+		Name:        name,
 		Description: "Draft event stream for outbox pattern",
-		Subjects:    []string{fmt.Sprintf("%s.>", p.config.SubjectPrefix)},
+		Subjects:    events.StreamSubjects(name),
 		Retention:   jetstream.LimitsPolicy,
-		MaxAge:      p.config.MaxAge,
-		MaxMsgs:     p.config.MaxMsgs,
+		MaxAge:      retention.MaxAge,
+		MaxMsgs:     retention.MaxMsgs,
 		Storage:     jetstream.FileStorage,
-		Replicas:    p.config.Replicas,
-		Duplicates:  p.config.DuplicateWindow,
+		Replicas:    cfg.Replicas,
+		Duplicates:  duplicates,
 	}
+}
 
-	stream, err := p.js.Stream(ctx, p.config.StreamName)
+func ensureStream(ctx context.Context, js jetstream.JetStream, sc jetstream.StreamConfig) error {
+	stream, err := js.Stream(ctx, sc.Name)
 	if err != nil {
 		// Create new stream
-		if _, err = p.js.CreateStream(ctx, sc); err != nil {
+		if _, err = js.CreateStream(ctx, sc); err != nil {
 			return fmt.Errorf("create stream: %w", err)
 		}
 		log.Info().
-			Str("stream", p.config.StreamName).
+			Str("stream", sc.Name).
 			Msg("created JetStream stream")
 	} else {
 		// Update existing if needed
@@ -112,11 +213,11 @@ func (p *JetStreamPublisher) ensureStream(ctx context.Context) error {
 			return fmt.Errorf("get stream info: %w", err)
 		}
 		if !isStreamConfigEqual(info.Config, sc) {
-			if _, err = p.js.UpdateStream(ctx, sc); err != nil {
+			if _, err = js.UpdateStream(ctx, sc); err != nil {
 				return fmt.Errorf("update stream: %w", err)
 			}
 			log.Info().
-				Str("stream", p.config.StreamName).
+				Str("stream", sc.Name).
 				Msg("updated JetStream stream")
 		}
 	}
@@ -124,38 +225,32 @@ func (p *JetStreamPublisher) ensureStream(ctx context.Context) error {
 }
 
 func (p *JetStreamPublisher) Publish(ctx context.Context, event OutboxEvent) error {
-	subject := fmt.Sprintf("%s.%s", p.config.SubjectPrefix, event.EventType)
+	msg, opts, err := p.message(event)
+	if err != nil {
+		p.record(err)
+		return err
+	}
 
-	env := map[string]interface{}{
-		"eventId":   event.ID.String(),
-		"eventType": event.EventType,
-		"draftId":   event.DraftID.String(),
-		"timestamp": time.Now().UTC(),
-		"payload":   json.RawMessage(event.Payload),
+	if err := p.injectFaults(ctx, event); err != nil {
+		p.record(err)
+		return err
 	}
-	data, err := json.Marshal(env)
+
+	ack, err := p.js.PublishMsg(ctx, msg, opts...)
 	if err != nil {
-		return fmt.Errorf("marshal event: %w", err)
+		err = fmt.Errorf("publish to JetStream: %w", err)
+		p.record(err)
+		return err
 	}
 
-	ack, err := p.js.PublishMsg(ctx, &nats.Msg{
-		Subject: subject,
-		Data:    data,
-		Header: nats.Header{
-			"Event-Type": []string{event.EventType},
-			"Draft-ID":   []string{event.DraftID.String()},
-			"Event-ID":   []string{event.ID.String()},
-		},
-	},
-		jetstream.WithMsgID(event.ID.String()),
-		jetstream.WithExpectStream(p.config.StreamName),
-	)
-	if err != nil {
-		return fmt.Errorf("publish to JetStream: %w", err)
+	if err := p.injectAckFaults(ctx, event, msg); err != nil {
+		p.record(err)
+		return err
 	}
+	p.record(nil)
 
 	log.Info().
-		Str("subject", subject).
+		Str("subject", msg.Subject).
 		Str("event_id", event.ID.String()).
 		Uint64("sequence", ack.Sequence).
 		Str("stream", ack.Stream).
@@ -164,6 +259,194 @@ func (p *JetStreamPublisher) Publish(ctx context.Context, event OutboxEvent) err
 	return nil
 }
 
+// PublishAsync sends an event without waiting for its ack, once a slot in the window is free.
+// done is called with the outcome when the ack arrives or the publish fails. Unacked
+// publishes are resent in order when the connection comes back; the message ID lets
+// JetStream drop the copies it already stored.
+func (p *JetStreamPublisher) PublishAsync(ctx context.Context, event OutboxEvent, done func(error)) error {
+	msg, opts, err := p.message(event)
+	if err != nil {
+		p.record(err)
+		return err
+	}
+	if err := p.injectFaults(ctx, event); err != nil {
+		p.record(err)
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case p.window <- struct{}{}:
+	}
+
+	p.mu.Lock()
+	p.sent++
+	pending := &pendingPublish{event: event, msg: msg, opts: opts, done: done, order: p.sent}
+	p.inFlight[event.ID] = pending
+	p.mu.Unlock()
+
+	p.send(pending, 1)
+	return nil
+}
+
+// InFlightWindow returns how many publishes may await their ack at once
+func (p *JetStreamPublisher) InFlightWindow() int {
+	return p.config.AsyncWindow
+}
+
+// send hands an in-flight publish to the connection and waits for its ack in the background
+func (p *JetStreamPublisher) send(pending *pendingPublish, attempt int) {
+	future, err := p.js.PublishMsgAsync(pending.msg, pending.opts...)
+	if err != nil {
+		p.finish(pending, attempt, nil, err)
+		return
+	}
+	go func() {
+		select {
+		case ack := <-future.Ok():
+			p.finish(pending, attempt, ack, nil)
+		case err := <-future.Err():
+			p.finish(pending, attempt, nil, err)
+		}
+	}()
+}
+
+// finish settles an in-flight publish with the outcome of one of its sends, freeing its slot
+// in the window. The outcome of a send the publish has since been resent past is ignored.
+func (p *JetStreamPublisher) finish(pending *pendingPublish, attempt int, ack *jetstream.PubAck, err error) {
+	p.mu.Lock()
+	if pending.attempt != attempt || p.inFlight[pending.event.ID] != pending {
+		p.mu.Unlock()
+		return
+	}
+	delete(p.inFlight, pending.event.ID)
+	p.mu.Unlock()
+	<-p.window
+
+	if err != nil {
+		err = fmt.Errorf("publish to JetStream: %w", err)
+	} else {
+		err = p.injectAckFaults(context.Background(), pending.event, pending.msg)
+	}
+	p.record(err)
+	if err == nil {
+		log.Info().
+			Str("subject", pending.msg.Subject).
+			Str("event_id", pending.event.ID.String()).
+			Uint64("sequence", ack.Sequence).
+			Str("stream", ack.Stream).
+			Msg("published to JetStream")
+	}
+	pending.done(err)
+}
+
+// resendInFlight sends every unacked publish again, oldest first, after a reconnect. Their
+// first sends may have been lost with the old connection and would otherwise only fail once
+// the ack timeout runs out.
+func (p *JetStreamPublisher) resendInFlight() {
+	p.mu.Lock()
+	pending := make([]*pendingPublish, 0, len(p.inFlight))
+	for _, publish := range p.inFlight {
+		publish.attempt++
+		pending = append(pending, publish)
+	}
+	p.mu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	slices.SortFunc(pending, func(a, b *pendingPublish) int {
+		return cmp.Compare(a.order, b.order)
+	})
+	log.Warn().Int("in_flight", len(pending)).Msg("resending unacked publishes after reconnect")
+	for _, publish := range pending {
+		p.mu.Lock()
+		attempt := publish.attempt
+		p.mu.Unlock()
+		p.send(publish, attempt)
+	}
+}
+
+// message builds the NATS message for an outbox event and the options that publish it once
+// to its stream
+func (p *JetStreamPublisher) message(event OutboxEvent) (*nats.Msg, []jetstream.PublishOpt, error) {
+	class := events.Class(event.EventType)
+	if class == "" {
+		return nil, nil, fmt.Errorf("no event class for event type %q", event.EventType)
+	}
+
+	data, err := json.Marshal(newEnvelope(event))
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal event: %w", err)
+	}
+	msg := &nats.Msg{
+		Subject: events.Subject(event.EventType),
+		Data:    data,
+		Header: nats.Header{
+			"Event-Type":     []string{event.EventType},
+			"Draft-ID":       []string{event.DraftID.String()},
+			"Event-ID":       []string{event.ID.String()},
+			"Schema-Version": []string{strconv.Itoa(events.SchemaVersion(event.EventType))},
+		},
+	}
+	opts := []jetstream.PublishOpt{
+		jetstream.WithMsgID(event.ID.String()),
+		jetstream.WithExpectStream(class.Stream()),
+	}
+	return msg, opts, nil
+}
+
+// injectAckFaults publishes a stored event a second time, or fails it as though its ack was
+// lost, when fault injection picks it to
+func (p *JetStreamPublisher) injectAckFaults(ctx context.Context, event OutboxEvent, msg *nats.Msg) error {
+	if p.config.Faults.DuplicateDelivery() {
+		// Another message ID gets the copy past duplicate detection, so consumers see the
+		// event twice with the same Event-ID
+		log.Warn().Str("event_id", event.ID.String()).Msg("injecting duplicate delivery")
+		if _, err := p.js.PublishMsg(ctx, msg,
+			jetstream.WithMsgID(event.ID.String()+"-duplicate"),
+			jetstream.WithExpectStream(events.Class(event.EventType).Stream()),
+		); err != nil {
+			log.Error().Err(err).Str("event_id", event.ID.String()).Msg("failed to publish injected duplicate")
+		}
+	}
+	if p.config.Faults.LoseAck() {
+		log.Warn().Str("event_id", event.ID.String()).Msg("injecting lost publish ack")
+		return fmt.Errorf("publish to JetStream: %w: ack lost", faults.ErrInjected)
+	}
+	return nil
+}
+
+// injectFaults holds a publish back or fails it before it reaches the broker, when fault
+// injection picks it to
+func (p *JetStreamPublisher) injectFaults(ctx context.Context, event OutboxEvent) error {
+	if delay := p.config.Faults.PublishDelay(); delay > 0 {
+		log.Warn().Str("event_id", event.ID.String()).Dur("delay", delay).Msg("injecting publish delay")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	if p.config.Faults.DropPublish() {
+		log.Warn().Str("event_id", event.ID.String()).Msg("injecting dropped publish")
+		return fmt.Errorf("publish to JetStream: %w: dropped", faults.ErrInjected)
+	}
+	return nil
+}
+
+// Ping checks that the NATS connection is up and JetStream answers on it
+func (p *JetStreamPublisher) Ping(ctx context.Context) error {
+	if !p.nc.IsConnected() {
+		return fmt.Errorf("NATS connection is %s", p.nc.Status())
+	}
+	if _, err := p.js.AccountInfo(ctx); err != nil {
+		return fmt.Errorf("JetStream unavailable: %w", err)
+	}
+	return nil
+}
+
 func (p *JetStreamPublisher) Close() error {
 	if p.nc != nil {
 		p.nc.Close()
@@ -171,8 +454,23 @@ func (p *JetStreamPublisher) Close() error {
 	return nil
 }
 
+// newEnvelope wraps an outbox event in the message body shared by every publisher backend
+func newEnvelope(event OutboxEvent) map[string]interface{} {
+	return map[string]interface{}{
+		"eventId":   event.ID.String(),
+		"eventType": event.EventType,
+		"draftId":   event.DraftID.String(),
+		"timestamp": time.Now().UTC(),
+		"payload":   json.RawMessage(event.Payload),
+		"sequence":  event.Sequence,
+		// Lets consumers tell payload shapes apart once an event type's schema changes
+		"schemaVersion": events.SchemaVersion(event.EventType),
+	}
+}
+
 func isStreamConfigEqual(a, b jetstream.StreamConfig) bool {
 	return a.Name == b.Name &&
+		slices.Equal(a.Subjects, b.Subjects) &&
 		a.MaxAge == b.MaxAge &&
 		a.MaxMsgs == b.MaxMsgs &&
 		a.Replicas == b.Replicas &&
@@ -14,8 +14,55 @@ type OutboxEvent struct {
 	Payload   []byte
 	CreatedAt time.Time
 	SentAt    *time.Time
+	// Sequence orders the event within its draft; zero for events written before sequencing
+	Sequence int64
 }
 
+// Backlog summarizes the outbox events still waiting to be published
+type Backlog struct {
+	Unsent    int64
+	OldestAge time.Duration
+}
+
+// EventPublisher forwards outbox events to a message broker. Publish returns
+// only once the broker has acknowledged the event.
 type EventPublisher interface {
 	Publish(ctx context.Context, event OutboxEvent) error
+	Close() error
+}
+
+// AsyncEventPublisher is implemented by publishers that can have several events awaiting
+// their acks at once
+type AsyncEventPublisher interface {
+	EventPublisher
+	// PublishAsync sends an event once the window has room and calls done with the outcome
+	// when the broker acks it or the publish fails. An error returned means the event
+	// wasn't sent and done won't be called.
+	PublishAsync(ctx context.Context, event OutboxEvent, done func(error)) error
+	// InFlightWindow is how many events may await their acks at once; 0 when publishes are
+	// synchronous
+	InFlightWindow() int
+}
+
+// PublisherBackend selects the broker outbox events are published to
+type PublisherBackend string
+
+const (
+	PublisherBackendJetStream PublisherBackend = "nats"
+	PublisherBackendKafka     PublisherBackend = "kafka"
+)
+
+// HealthReporter is implemented by publishers that can report on their broker connection
+type HealthReporter interface {
+	// Ping checks that the broker is reachable right now
+	Ping(ctx context.Context) error
+	// Stats returns the outcome of the most recent publishes
+	Stats() PublishStats
+}
+
+// PublishStats records when publishing last succeeded and last failed
+type PublishStats struct {
+	LastSuccessAt *time.Time
+	LastFailureAt *time.Time
+	LastError     string
 }
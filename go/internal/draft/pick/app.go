@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/clock"
+	"github.com/mcdev12/dynasty/go/internal/ids"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
 )
 
 // PickRepository defines what the pick app layer needs from the pick repository
@@ -14,28 +19,57 @@ type PickRepository interface {
 	CreateDraftPicksBatch(ctx context.Context, picks []models.DraftPick) error
 	GetDraftPick(ctx context.Context, id uuid.UUID) (*models.DraftPick, error)
 	GetDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) ([]models.DraftPick, error)
+	ListDraftPicksByDraft(ctx context.Context, draftID uuid.UUID, filter DraftPickFilter, pagination PaginationParams) ([]models.DraftPick, error)
+	CountDraftPicksByDraft(ctx context.Context, draftID uuid.UUID, filter DraftPickFilter) (int, error)
+	GetDraftEventSequence(ctx context.Context, draftID uuid.UUID) (int64, error)
 	GetDraftPicksByRound(ctx context.Context, draftID uuid.UUID, round int) ([]models.DraftPick, error)
 	GetNextPickForDraft(ctx context.Context, draftID uuid.UUID) (*models.DraftPick, error)
 	UpdateDraftPickPlayer(ctx context.Context, id uuid.UUID, req UpdateDraftPickPlayerRequest) (*models.DraftPick, error)
 	DeleteDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) (int, error)
+	ReassignPickSlot(ctx context.Context, req ReassignPickSlotRequest) (*PickSlotReassignment, error)
+	ProposePickTrade(ctx context.Context, req ProposePickTradeRequest) (*PickTrade, error)
+	RespondToPickTrade(ctx context.Context, req RespondToPickTradeRequest) (*PickTrade, error)
+	ExpirePickTrade(ctx context.Context, draftID models.DraftID, offerID uuid.UUID) (*PickTrade, error)
+	ReactToPick(ctx context.Context, req ReactToPickRequest) (*models.PickReactionCounts, error)
+	ListPickReactions(ctx context.Context, draftID uuid.UUID) ([]models.PickReactionCounts, error)
 	MakePick(ctx context.Context, pickRequest MakePickRequest) error
+	MakeLatePick(ctx context.Context, req MakeLatePickRequest) (*LatePick, error)
+	SkipPick(ctx context.Context, req SkipPickRequest) (*models.DraftPick, error)
+	SkipPickWithoutRosterSpace(ctx context.Context, req SkipPickWithoutRosterSpaceRequest) (*models.DraftPick, error)
+	GetRosterSpace(ctx context.Context, draftID, teamID uuid.UUID) (*RosterSpace, error)
+	ListTeamByeWeeks(ctx context.Context, draftID, teamID uuid.UUID) ([]HeldByeWeek, error)
 	CountRemainingPicks(ctx context.Context, draftID uuid.UUID) (int, error)
+	CountRemainingPicksForDrafts(ctx context.Context, draftIDs []uuid.UUID) (map[uuid.UUID]int, error)
 	ClaimNextPickSlot(ctx context.Context, draftID uuid.UUID) (*Slot, error)
 	ListAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUID) ([]AvailablePlayer, error)
+	GetDraftRankingProfile(ctx context.Context, draftID uuid.UUID) (*RankingProfile, error)
+	GetEligiblePositions(ctx context.Context, draftID uuid.UUID, name string) ([]string, error)
+	ListRankedAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUID, profile RankingProfile) ([]AvailablePlayer, error)
+	ListExpansionPoolPlayerIDs(ctx context.Context, draftID uuid.UUID) ([]uuid.UUID, error)
+	ListDispersalPoolPlayerIDs(ctx context.Context, draftID uuid.UUID) ([]uuid.UUID, error)
+	ListDraftResults(ctx context.Context, draftID uuid.UUID) ([]DraftResult, error)
+	GetPickAnnouncement(ctx context.Context, pickID uuid.UUID) (*PickAnnouncement, error)
 }
 
 // App handles pick business logic
 type App struct {
-	repo PickRepository
+	repo  PickRepository
+	clock clock.Clock
 }
 
-// NewApp creates a new pick App
-func NewApp(repo PickRepository) *App {
+// NewApp creates a new pick App. clock is what picks and their events are timestamped with.
+func NewApp(repo PickRepository, clock clock.Clock) *App {
 	return &App{
-		repo: repo,
+		repo:  repo,
+		clock: clock,
 	}
 }
 
+// Now returns the time on the app's clock, for the service to timestamp events with
+func (a *App) Now() time.Time {
+	return a.clock.Now()
+}
+
 // PrepopulateDraftPicks creates all draft pick slots for a draft based on rounds and team count
 func (a *App) PrepopulateDraftPicks(ctx context.Context, draftID uuid.UUID, draftType models.DraftType, settings models.DraftSettings) error {
 	// Check if picks already exist
@@ -56,8 +90,8 @@ func (a *App) PrepopulateDraftPicks(ctx context.Context, draftID uuid.UUID, draf
 	// Generate all draft picks based on draft type
 	var picks []models.DraftPick
 	switch draftType {
-	case models.DraftTypeSnake, models.DraftTypeRookie:
-		picks = a.generateSnakeDraftPicks(draftID, settings.Rounds, settings.DraftOrder, settings.ThirdRoundReversal)
+	case models.DraftTypeSnake, models.DraftTypeRookie, models.DraftTypeExpansion, models.DraftTypeDispersal:
+		picks = a.generateSnakeDraftPicks(draftID, settings.Rounds, settings.DraftOrder, settings.EffectiveOrderMode())
 	case models.DraftTypeAuction:
 		picks = a.generateAuctionDraftPicks(draftID, settings.Rounds, settings.DraftOrder)
 	default:
@@ -86,6 +120,58 @@ func (a *App) MakePick(ctx context.Context, req MakePickRequest) error {
 	return nil
 }
 
+// MakeLatePick makes a pick the draft skipped when its clock ran out, out of board order
+func (a *App) MakeLatePick(ctx context.Context, req MakeLatePickRequest) (*LatePick, error) {
+	if err := a.validateMakeLatePickRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	latePick, err := a.repo.MakeLatePick(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make late pick: %w", err)
+	}
+
+	log.Printf("Late pick made: %s for team %s with pick %d in draft %s", req.PlayerID, latePick.Pick.TeamID, latePick.Pick.OverallPick, req.DraftID)
+	return latePick, nil
+}
+
+// SkipPick moves the draft past the pick on the clock once its deadline has passed, leaving
+// the team to make the pick late
+func (a *App) SkipPick(ctx context.Context, req SkipPickRequest) (*models.DraftPick, error) {
+	if req.PickID == uuid.Nil {
+		return nil, fmt.Errorf("validation failed: pick_id is required")
+	}
+	if req.DraftID.IsZero() {
+		return nil, fmt.Errorf("validation failed: draft_id is required")
+	}
+
+	skipped, err := a.repo.SkipPick(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to skip pick: %w", err)
+	}
+
+	log.Printf("Skipped pick %d for team %s in draft %s", skipped.OverallPick, skipped.TeamID, req.DraftID)
+	return skipped, nil
+}
+
+// SkipPickWithoutRosterSpace forfeits the pick on the clock when its team has no roster space
+// left, returning nil when the pick stays on the clock
+func (a *App) SkipPickWithoutRosterSpace(ctx context.Context, req SkipPickWithoutRosterSpaceRequest) (*models.DraftPick, error) {
+	if req.DraftID.IsZero() {
+		return nil, fmt.Errorf("validation failed: draft_id is required")
+	}
+
+	forfeited, err := a.repo.SkipPickWithoutRosterSpace(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to skip pick without roster space: %w", err)
+	}
+
+	if forfeited != nil {
+		log.Printf("Forfeited pick %d for team %s in draft %s, roster is full", forfeited.OverallPick, forfeited.TeamID, req.DraftID)
+	}
+	return forfeited, nil
+}
+
 // GetDraftPick retrieves a draft pick by ID
 func (a *App) GetDraftPick(ctx context.Context, id uuid.UUID) (*models.DraftPick, error) {
 	pick, err := a.repo.GetDraftPick(ctx, id)
@@ -104,6 +190,96 @@ func (a *App) GetDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) ([]mo
 	return picks, nil
 }
 
+// ListDraftPicksByDraft retrieves a filtered page of draft picks ordered by overall pick, along
+// with the sequence of the last event written for the draft. The sequence is read before the
+// picks, so every event at or below it is already reflected in them.
+func (a *App) ListDraftPicksByDraft(ctx context.Context, draftID uuid.UUID, filter DraftPickFilter, params PaginationParams) (*DraftPickListResponse, error) {
+	if err := a.validateDraftPickFilter(filter); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if params.Page.Paged() {
+		return a.listDraftPicksPage(ctx, draftID, filter, params.Page)
+	}
+	if params.Limit < 0 || params.Offset < 0 {
+		return nil, fmt.Errorf("validation failed: limit and offset cannot be negative")
+	}
+
+	seq, err := a.repo.GetDraftEventSequence(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft event sequence: %w", err)
+	}
+
+	picks, err := a.repo.ListDraftPicksByDraft(ctx, draftID, filter, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft picks by draft: %w", err)
+	}
+
+	total, err := a.repo.CountDraftPicksByDraft(ctx, draftID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count draft picks by draft: %w", err)
+	}
+
+	return &DraftPickListResponse{
+		Picks:         picks,
+		Total:         &total,
+		Limit:         params.Limit,
+		Offset:        params.Offset,
+		HasMore:       params.Offset+len(picks) < total,
+		EventSequence: seq,
+	}, nil
+}
+
+// listDraftPicksPage retrieves a page of a draft's picks by page token, counting every
+// matching pick only when asked to
+func (a *App) listDraftPicksPage(ctx context.Context, draftID uuid.UUID, filter DraftPickFilter, req pagination.Request) (*DraftPickListResponse, error) {
+	pageSize, err := pickPageSizes.Resolve(req.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	params := PaginationParams{Limit: pageSize + 1}
+	var cursor pickCursor
+	ok, err := pagination.DecodeToken(req.PageToken, &cursor)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if cursor.OverallPick <= 0 {
+			return nil, pagination.ErrInvalidPageToken
+		}
+		params.afterOverallPick = &cursor.OverallPick
+	}
+
+	seq, err := a.repo.GetDraftEventSequence(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft event sequence: %w", err)
+	}
+
+	// Fetch one extra pick to tell whether another page follows
+	picks, err := a.repo.ListDraftPicksByDraft(ctx, draftID, filter, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft picks by draft: %w", err)
+	}
+
+	result := &DraftPickListResponse{EventSequence: seq}
+	result.Picks, result.HasMore = pagination.Trim(picks, pageSize)
+	if result.HasMore {
+		last := result.Picks[pageSize-1]
+		result.NextPageToken, err = pagination.EncodeToken(pickCursor{OverallPick: last.OverallPick})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.IncludeTotal {
+		total, err := a.repo.CountDraftPicksByDraft(ctx, draftID, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count draft picks by draft: %w", err)
+		}
+		result.Total = &total
+	}
+	return result, nil
+}
+
 // GetDraftPicksByRound retrieves draft picks for a specific round
 func (a *App) GetDraftPicksByRound(ctx context.Context, draftID uuid.UUID, round int) ([]models.DraftPick, error) {
 	if round <= 0 {
@@ -135,6 +311,21 @@ func (a *App) CountRemainingPicks(ctx context.Context, draftID uuid.UUID) (int,
 	return count, nil
 }
 
+// CountRemainingPicksForDrafts returns the number of unpicked slots in each of several drafts.
+// Every draft asked about is in the result; drafts without picks have none remaining.
+func (a *App) CountRemainingPicksForDrafts(ctx context.Context, draftIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	counts, err := a.repo.CountRemainingPicksForDrafts(ctx, draftIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count remaining picks: %w", err)
+	}
+	for _, draftID := range draftIDs {
+		if _, ok := counts[draftID]; !ok {
+			counts[draftID] = 0
+		}
+	}
+	return counts, nil
+}
+
 // ClaimNextPickSlot atomically claims the next available pick slot for auto-pick
 func (a *App) ClaimNextPickSlot(ctx context.Context, draftID uuid.UUID) (*Slot, error) {
 	slot, err := a.repo.ClaimNextPickSlot(ctx, draftID)
@@ -165,6 +356,90 @@ func (a *App) UpdateDraftPickPlayer(ctx context.Context, id uuid.UUID, req Updat
 	return pick, nil
 }
 
+// ReassignPickSlot transfers ownership of an unmade pick slot to another team before its draft
+// starts.
+func (a *App) ReassignPickSlot(ctx context.Context, req ReassignPickSlotRequest) (*PickSlotReassignment, error) {
+	if err := a.validateReassignPickSlotRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	reassignment, err := a.repo.ReassignPickSlot(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign pick slot: %w", err)
+	}
+
+	log.Printf("Reassigned draft pick %s (overall %d) from team %s to team %s",
+		req.PickID, reassignment.Pick.OverallPick, reassignment.FromTeamID, req.NewTeamID)
+	return reassignment, nil
+}
+
+// ProposePickTrade offers the pick on the clock to another team, stopping the pick clock while
+// the offer is open
+func (a *App) ProposePickTrade(ctx context.Context, req ProposePickTradeRequest) (*PickTrade, error) {
+	if err := a.validateProposePickTradeRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	trade, err := a.repo.ProposePickTrade(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to propose pick trade: %w", err)
+	}
+
+	log.Printf("Team %s offered pick %s to team %s in draft %s (offer %s)",
+		req.FromTeamID, req.PickID, req.ToTeamID, req.DraftID, trade.Offer.ID)
+	return trade, nil
+}
+
+// RespondToPickTrade accepts, declines or withdraws a live pick trade offer
+func (a *App) RespondToPickTrade(ctx context.Context, req RespondToPickTradeRequest) (*PickTrade, error) {
+	trade, err := a.repo.RespondToPickTrade(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to respond to pick trade: %w", err)
+	}
+
+	if trade.Changed {
+		log.Printf("Pick trade offer %s in draft %s closed as %s by team %s",
+			req.OfferID, req.DraftID, trade.Offer.Status, req.TeamID)
+	}
+	return trade, nil
+}
+
+// ExpirePickTrade expires a live pick trade offer whose window has passed
+func (a *App) ExpirePickTrade(ctx context.Context, draftID models.DraftID, offerID uuid.UUID) (*PickTrade, error) {
+	trade, err := a.repo.ExpirePickTrade(ctx, draftID, offerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire pick trade: %w", err)
+	}
+
+	if trade.Changed {
+		log.Printf("Pick trade offer %s in draft %s expired", offerID, draftID)
+	}
+	return trade, nil
+}
+
+// ReactToPick leaves a user's reaction on a made pick, or takes it back, returning the pick's
+// reaction counts
+func (a *App) ReactToPick(ctx context.Context, req ReactToPickRequest) (*models.PickReactionCounts, error) {
+	if !req.Reaction.IsValid() {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownReaction, req.Reaction)
+	}
+
+	counts, err := a.repo.ReactToPick(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to react to pick: %w", err)
+	}
+	return counts, nil
+}
+
+// ListPickReactions lists the reaction counts of a draft's picks that have any, in draft order
+func (a *App) ListPickReactions(ctx context.Context, draftID uuid.UUID) ([]models.PickReactionCounts, error) {
+	picks, err := a.repo.ListPickReactions(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pick reactions: %w", err)
+	}
+	return picks, nil
+}
+
 // DeleteDraftPicksByDraft deletes all draft picks for a draft
 func (a *App) DeleteDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) (int, error) {
 	count, err := a.repo.DeleteDraftPicksByDraft(ctx, draftID)
@@ -186,9 +461,392 @@ func (a *App) ListAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUI
 	return players, nil
 }
 
+// ListRankedAvailablePlayersForDraft returns the players not yet picked in a draft, sorted by
+// the rankings for its league's season and scoring format. format overrides the league's
+// own reception scoring when set. The profile used is returned alongside the players.
+func (a *App) ListRankedAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUID, format *models.ScoringFormat) ([]AvailablePlayer, *RankingProfile, error) {
+	profile, err := a.repo.GetDraftRankingProfile(ctx, draftID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get draft ranking profile: %w", err)
+	}
+	if format != nil {
+		profile.ScoringFormat = *format
+	}
+
+	players, err := a.repo.ListRankedAvailablePlayersForDraft(ctx, draftID, *profile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list ranked available players for draft: %w", err)
+	}
+
+	return players, profile, nil
+}
+
+// RestrictToExpansionPool narrows players to those an expansion draft can still take
+func (a *App) RestrictToExpansionPool(ctx context.Context, draftID uuid.UUID, players []AvailablePlayer) ([]AvailablePlayer, error) {
+	pool, err := a.repo.ListExpansionPoolPlayerIDs(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expansion pool: %w", err)
+	}
+	return restrictToPool(players, pool), nil
+}
+
+// RestrictToDispersalPool narrows players to those a dispersal draft can still take
+func (a *App) RestrictToDispersalPool(ctx context.Context, draftID uuid.UUID, players []AvailablePlayer) ([]AvailablePlayer, error) {
+	pool, err := a.repo.ListDispersalPoolPlayerIDs(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dispersal pool: %w", err)
+	}
+	return restrictToPool(players, pool), nil
+}
+
+// restrictToPool keeps the players in pool, in their original order
+func restrictToPool(players []AvailablePlayer, pool []uuid.UUID) []AvailablePlayer {
+	inPool := make(map[uuid.UUID]bool, len(pool))
+	for _, id := range pool {
+		inPool[id] = true
+	}
+
+	restricted := make([]AvailablePlayer, 0, len(players))
+	for _, player := range players {
+		if inPool[player.ID] {
+			restricted = append(restricted, player)
+		}
+	}
+	return restricted
+}
+
+// RestrictToOpenPositions narrows players to those the team on the clock has roster space for
+func (a *App) RestrictToOpenPositions(ctx context.Context, draftID uuid.UUID, players []AvailablePlayer) ([]AvailablePlayer, error) {
+	next, err := a.repo.GetNextPickForDraft(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next pick for draft: %w", err)
+	}
+
+	space, err := a.repo.GetRosterSpace(ctx, draftID, next.TeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster space: %w", err)
+	}
+	if !space.Limits.Enforced() {
+		return players, nil
+	}
+
+	open := make([]AvailablePlayer, 0, len(players))
+	for _, player := range players {
+		if space.HasRoom(player.Position) {
+			open = append(open, player)
+		}
+	}
+	return open, nil
+}
+
+// RestrictToOpenLineupSlots narrows players to those who could fill a starting lineup slot the
+// team on the clock hasn't filled yet. Teams with a full lineup, or in leagues that don't
+// configure one, keep every player.
+func (a *App) RestrictToOpenLineupSlots(ctx context.Context, draftID uuid.UUID, players []AvailablePlayer) ([]AvailablePlayer, error) {
+	next, err := a.repo.GetNextPickForDraft(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next pick for draft: %w", err)
+	}
+
+	space, err := a.repo.GetRosterSpace(ctx, draftID, next.TeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster space: %w", err)
+	}
+	slots := space.OpenLineupSlots()
+	if len(slots) == 0 {
+		return players, nil
+	}
+
+	open := make([]AvailablePlayer, 0, len(players))
+	for _, player := range players {
+		for _, slot := range slots {
+			if slot.Accepts(player.Position) {
+				open = append(open, player)
+				break
+			}
+		}
+	}
+	return open, nil
+}
+
+// RestrictToPosition narrows players to those eligible for position, which may also name a
+// lineup slot of the draft's league or a standard slot of its sport, e.g. FLEX
+func (a *App) RestrictToPosition(ctx context.Context, draftID uuid.UUID, players []AvailablePlayer, position string) ([]AvailablePlayer, error) {
+	eligible, err := a.repo.GetEligiblePositions(ctx, draftID, position)
+	if err != nil {
+		return nil, err
+	}
+
+	slot := models.LineupSlot{Name: position, Eligible: eligible}
+	restricted := make([]AvailablePlayer, 0, len(players))
+	for _, player := range players {
+		if slot.Accepts(player.Position) {
+			restricted = append(restricted, player)
+		}
+	}
+	return restricted, nil
+}
+
+// ComparePlayers lines up the players with the given IDs, in that order, for the draft room's
+// comparison panel. players are the draft's ranked available players, which each of them must
+// be among.
+func (a *App) ComparePlayers(players []AvailablePlayer, playerIDs []uuid.UUID) ([]PlayerComparison, error) {
+	comparisons := make(map[uuid.UUID]PlayerComparison, len(players))
+	positionRanks := make(map[string]int)
+	previous := make(map[string]uuid.UUID) // the last ranked player seen at each position
+	for _, player := range players {
+		if player.Rank == nil {
+			comparisons[player.ID] = PlayerComparison{Player: player}
+			continue
+		}
+
+		positionRanks[player.Position]++
+		rank := positionRanks[player.Position]
+		comparisons[player.ID] = PlayerComparison{Player: player, PositionRank: &rank}
+
+		// Players come best ranked first, so this is the next one at the previous player's position
+		if prevID, ok := previous[player.Position]; ok {
+			prev := comparisons[prevID]
+			if prev.Player.ProjectedPoints != nil && player.ProjectedPoints != nil {
+				over := *prev.Player.ProjectedPoints - *player.ProjectedPoints
+				prev.PointsOverNext = &over
+				comparisons[prevID] = prev
+			}
+		}
+		previous[player.Position] = player.ID
+	}
+
+	compared := make([]PlayerComparison, len(playerIDs))
+	for i, id := range playerIDs {
+		comparison, ok := comparisons[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrPlayerNotAvailable, id)
+		}
+		compared[i] = comparison
+	}
+	return compared, nil
+}
+
+// Suggestions order players by their overall rank, scaled down for each reason to move them up
+// and up for each reason to move them down, so a player ranked 50th who fills a lineup need
+// sits alongside the best available player ranked 40th
+const (
+	lineupNeedWeight     = 0.8
+	scarcePositionWeight = 0.9
+	valueWeight          = 0.9
+	byeConflictWeight    = 1.15
+)
+
+// suggestionBoard is what a team's suggestions are weighed against
+type suggestionBoard struct {
+	teams     int
+	space     *RosterSpace
+	openSlots []models.LineupSlot
+	// remaining counts the ranked players left at each position among those the remaining
+	// picks would take
+	remaining map[string]int
+	// nextPick is the team's next unmade overall pick, nil when it has none left
+	nextPick *int
+}
+
+// suggestionBoard reads the draft and team state suggestions for a team are weighed against
+func (a *App) suggestionBoard(ctx context.Context, draftID models.DraftID, teamID models.TeamID, players []AvailablePlayer) (*suggestionBoard, error) {
+	results, err := a.repo.ListDraftResults(ctx, draftID.UUID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft results: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrNoDraftPicks
+	}
+
+	board := &suggestionBoard{remaining: make(map[string]int)}
+	teams := make(map[uuid.UUID]bool)
+	picksLeft := 0
+	for _, result := range results {
+		teams[result.TeamID] = true
+		if result.PlayerID == nil {
+			picksLeft++
+			// Results come in board order, so the first unmade pick is the team's next
+			if result.TeamID == teamID.UUID() && board.nextPick == nil {
+				overall := result.OverallPick
+				board.nextPick = &overall
+			}
+		}
+	}
+	if !teams[teamID.UUID()] {
+		return nil, ErrTeamNotInDraft
+	}
+	board.teams = len(teams)
 
-// generateSnakeDraftPicks generates picks for snake and rookie drafts with optional reversal
-func (a *App) generateSnakeDraftPicks(draftID uuid.UUID, rounds int, draftOrder []uuid.UUID, thirdRoundReversal bool) []models.DraftPick {
+	board.space, err = a.repo.GetRosterSpace(ctx, draftID.UUID(), teamID.UUID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster space: %w", err)
+	}
+	board.openSlots = board.space.OpenLineupSlots()
+
+	// Count the ranked players left at each position among those the remaining picks would
+	// take, as the draft room's scarcity analytics do
+	taken := 0
+	for _, player := range players {
+		if taken == picksLeft {
+			break
+		}
+		if player.Rank == nil {
+			continue
+		}
+		taken++
+		board.remaining[player.Position]++
+	}
+	return board, nil
+}
+
+// suggestionCandidate is a player weighed for a suggestion; lower weights come first
+type suggestionCandidate struct {
+	player  AvailablePlayer
+	reasons []SuggestionReason
+	weight  float64
+}
+
+// candidates weighs the ranked players the team has roster space for, the players auto-pick
+// chooses among, by lineup need and positional scarcity. Those in exclude are left out.
+func (b *suggestionBoard) candidates(players []AvailablePlayer, exclude []uuid.UUID) []suggestionCandidate {
+	excluded := make(map[uuid.UUID]bool, len(exclude))
+	for _, id := range exclude {
+		excluded[id] = true
+	}
+
+	var candidates []suggestionCandidate
+	for _, player := range players {
+		if player.Rank == nil || excluded[player.ID] || !b.space.HasRoom(player.Position) {
+			continue
+		}
+
+		c := suggestionCandidate{player: player, weight: float64(*player.Rank)}
+		for _, slot := range b.openSlots {
+			if slot.Accepts(player.Position) {
+				c.reasons = append(c.reasons, SuggestionReasonLineupNeed)
+				c.weight *= lineupNeedWeight
+				break
+			}
+		}
+		if b.remaining[player.Position] < b.teams {
+			c.reasons = append(c.reasons, SuggestionReasonScarcePosition)
+			c.weight *= scarcePositionWeight
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates
+}
+
+// bestCandidates sorts candidates by weight, keeping ties in rank order, and keeps up to limit
+func bestCandidates(candidates []suggestionCandidate, limit int) []suggestionCandidate {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].weight < candidates[j].weight
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates
+}
+
+// SuggestQueueAdditions suggests up to limit of players, the draft's ranked available players,
+// for a team's pick queue. Players the team has no roster space for, or already queued in
+// exclude, are left out. Those filling a starting lineup slot the team hasn't filled, or at a
+// position with fewer ranked players left than there are teams, are moved up.
+func (a *App) SuggestQueueAdditions(ctx context.Context, draftID models.DraftID, teamID models.TeamID, players []AvailablePlayer, exclude []uuid.UUID, limit int) ([]QueueSuggestion, error) {
+	board, err := a.suggestionBoard(ctx, draftID, teamID, players)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := bestCandidates(board.candidates(players, exclude), limit)
+	suggestions := make([]QueueSuggestion, len(candidates))
+	for i, c := range candidates {
+		suggestions[i] = QueueSuggestion{
+			Player:            c.player,
+			Reasons:           c.reasons,
+			PositionRemaining: board.remaining[c.player.Position],
+		}
+	}
+	return suggestions, nil
+}
+
+// GetPickSuggestions suggests up to limit of players, the draft's ranked available players, for
+// a team's next pick. They're weighed as queue suggestions are, from the same players auto-pick
+// chooses among, and further moved up when ranked at least a round ahead of the team's next
+// pick, or down when they share a bye week with a player the team holds at their position.
+func (a *App) GetPickSuggestions(ctx context.Context, draftID models.DraftID, teamID models.TeamID, players []AvailablePlayer, limit int) (*PickSuggestions, error) {
+	board, err := a.suggestionBoard(ctx, draftID, teamID, players)
+	if err != nil {
+		return nil, err
+	}
+
+	held, err := a.repo.ListTeamByeWeeks(ctx, draftID.UUID(), teamID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	heldByes := make(map[HeldByeWeek]bool, len(held))
+	for _, h := range held {
+		heldByes[h] = true
+	}
+
+	candidates := board.candidates(players, nil)
+	for i := range candidates {
+		c := &candidates[i]
+		if board.nextPick != nil && *c.player.Rank+board.teams <= *board.nextPick {
+			c.reasons = append(c.reasons, SuggestionReasonValue)
+			c.weight *= valueWeight
+		}
+		if c.player.ByeWeek != nil && heldByes[HeldByeWeek{Position: c.player.Position, ByeWeek: *c.player.ByeWeek}] {
+			c.reasons = append(c.reasons, SuggestionReasonByeConflict)
+			c.weight *= byeConflictWeight
+		}
+	}
+
+	candidates = bestCandidates(candidates, limit)
+	suggestions := &PickSuggestions{
+		NextOverallPick: board.nextPick,
+		Suggestions:     make([]PickSuggestion, len(candidates)),
+	}
+	for i, c := range candidates {
+		suggestions.Suggestions[i] = PickSuggestion{
+			Player:            c.player,
+			Reasons:           c.reasons,
+			PositionRemaining: board.remaining[c.player.Position],
+		}
+		if board.nextPick != nil {
+			past := *board.nextPick - *c.player.Rank
+			suggestions.Suggestions[i].PicksPastRank = &past
+		}
+	}
+	return suggestions, nil
+}
+
+// GetPickAnnouncement returns the team and player display data announced with a pick
+func (a *App) GetPickAnnouncement(ctx context.Context, pickID uuid.UUID) (*PickAnnouncement, error) {
+	announcement, err := a.repo.GetPickAnnouncement(ctx, pickID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pick announcement: %w", err)
+	}
+	return announcement, nil
+}
+
+// ListDraftResults returns every pick of a draft in board order, for exports
+func (a *App) ListDraftResults(ctx context.Context, draftID uuid.UUID) ([]DraftResult, error) {
+	results, err := a.repo.ListDraftResults(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft results: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrNoDraftPicks
+	}
+
+	return results, nil
+}
+
+
+// generateSnakeDraftPicks generates picks for snake and rookie drafts, reversing rounds as orderMode dictates
+func (a *App) generateSnakeDraftPicks(draftID uuid.UUID, rounds int, draftOrder []uuid.UUID, orderMode models.DraftOrderMode) []models.DraftPick {
 	numTeams := len(draftOrder)
 	totalPicks := rounds * numTeams
 	picks := make([]models.DraftPick, 0, totalPicks)
@@ -196,15 +854,8 @@ func (a *App) generateSnakeDraftPicks(draftID uuid.UUID, rounds int, draftOrder
 	overallPick := 1
 
 	for round := 1; round <= rounds; round++ {
-		// Determine if this round should be reversed
-		isReversed := (round%2 == 0) // Even rounds are always reversed in snake
-		if thirdRoundReversal && round >= 3 {
-			// Apply third round reversal logic - reverse every round from round 3 onwards
-			isReversed = true
-		}
-
 		var roundOrder []uuid.UUID
-		if isReversed {
+		if orderMode.ReversesRound(round) {
 			// Reverse the draft order for this round
 			roundOrder = make([]uuid.UUID, numTeams)
 			for i, teamID := range draftOrder {
@@ -217,7 +868,7 @@ func (a *App) generateSnakeDraftPicks(draftID uuid.UUID, rounds int, draftOrder
 		// Create picks for this round
 		for pick, teamID := range roundOrder {
 			picks = append(picks, models.DraftPick{
-				ID:          uuid.New(),
+				ID:          ids.New(),
 				DraftID:     draftID,
 				Round:       round,
 				Pick:        pick + 1, // 1-indexed pick number within round
@@ -246,7 +897,7 @@ func (a *App) generateAuctionDraftPicks(draftID uuid.UUID, rounds int, draftOrde
 		// Auction drafts maintain the same order every round (no snake reversal)
 		for pick, teamID := range draftOrder {
 			picks = append(picks, models.DraftPick{
-				ID:            uuid.New(),
+				ID:            ids.New(),
 				DraftID:       draftID,
 				Round:         round,
 				Pick:          pick + 1, // 1-indexed pick number within round
@@ -270,13 +921,13 @@ func (a *App) validateMakePickRequest(req MakePickRequest) error {
 	if req.PickID == uuid.Nil {
 		return fmt.Errorf("pick_id is required")
 	}
-	if req.PlayerID == uuid.Nil {
+	if req.PlayerID.IsZero() {
 		return fmt.Errorf("player_id is required")
 	}
-	if req.DraftID == uuid.Nil {
+	if req.DraftID.IsZero() {
 		return fmt.Errorf("draft_id is required")
 	}
-	if req.TeamID == uuid.Nil {
+	if req.TeamID.IsZero() {
 		return fmt.Errorf("team_id is required")
 	}
 	if req.OverallPick <= 0 {
@@ -285,9 +936,61 @@ func (a *App) validateMakePickRequest(req MakePickRequest) error {
 	return nil
 }
 
+func (a *App) validateMakeLatePickRequest(req MakeLatePickRequest) error {
+	if req.PickID == uuid.Nil {
+		return fmt.Errorf("pick_id is required")
+	}
+	if req.PlayerID.IsZero() {
+		return fmt.Errorf("player_id is required")
+	}
+	if req.DraftID.IsZero() {
+		return fmt.Errorf("draft_id is required")
+	}
+	return nil
+}
+
 func (a *App) validateUpdateDraftPickPlayerRequest(req UpdateDraftPickPlayerRequest) error {
 	if req.PlayerID == uuid.Nil {
 		return fmt.Errorf("player_id is required")
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+func (a *App) validateDraftPickFilter(filter DraftPickFilter) error {
+	if filter.OnlyCompleted && filter.OnlyRemaining {
+		return fmt.Errorf("only_completed and only_remaining are mutually exclusive")
+	}
+	if filter.MinRound != nil && filter.MaxRound != nil && *filter.MinRound > *filter.MaxRound {
+		return fmt.Errorf("min_round must be less than or equal to max_round")
+	}
+	return nil
+}
+
+func (a *App) validateReassignPickSlotRequest(req ReassignPickSlotRequest) error {
+	if req.DraftID.IsZero() {
+		return fmt.Errorf("draft_id is required")
+	}
+	if req.PickID == uuid.Nil {
+		return fmt.Errorf("pick_id is required")
+	}
+	if req.NewTeamID == uuid.Nil {
+		return fmt.Errorf("new_team_id is required")
+	}
+	if req.Reason != nil && len(*req.Reason) > 500 {
+		return fmt.Errorf("reason must be at most 500 characters")
+	}
+	return nil
+}
+
+func (a *App) validateProposePickTradeRequest(req ProposePickTradeRequest) error {
+	if req.FromTeamID == req.ToTeamID {
+		return fmt.Errorf("a pick can't be offered to the team holding it")
+	}
+	if req.Window <= 0 {
+		return fmt.Errorf("window must be positive")
+	}
+	if req.Message != nil && len(*req.Message) > 200 {
+		return fmt.Errorf("message must be at most 200 characters")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package pick
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+func TestGenerateSnakeDraftPicksOrderModes(t *testing.T) {
+	forward, reverse := []int{0, 1, 2}, []int{2, 1, 0}
+	tests := []struct {
+		mode models.DraftOrderMode
+		// rounds holds, for rounds 1 to 4, the draft order positions picking in turn
+		rounds [][]int
+	}{
+		{models.DraftOrderModeLinear, [][]int{forward, forward, forward, forward}},
+		{models.DraftOrderModeSnake, [][]int{forward, reverse, forward, reverse}},
+		// Round 3 repeats round 2, then snaking continues from there
+		{models.DraftOrderModeThirdRoundReversal, [][]int{forward, reverse, reverse, forward}},
+	}
+
+	draftID := uuid.New()
+	draftOrder := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			picks := (&App{}).generateSnakeDraftPicks(draftID, len(tt.rounds), draftOrder, tt.mode)
+			if want := len(tt.rounds) * len(draftOrder); len(picks) != want {
+				t.Fatalf("generated %d picks, want %d", len(picks), want)
+			}
+
+			overall := 1
+			for r, positions := range tt.rounds {
+				for i, position := range positions {
+					p := picks[overall-1]
+					if p.Round != r+1 || p.Pick != i+1 || p.OverallPick != overall {
+						t.Fatalf("pick %d is round %d pick %d overall %d, want round %d pick %d", overall, p.Round, p.Pick, p.OverallPick, r+1, i+1)
+					}
+					if p.TeamID != draftOrder[position] {
+						t.Errorf("round %d pick %d goes to draft order position %d, want %d", r+1, i+1, indexOf(draftOrder, p.TeamID), position)
+					}
+					if p.DraftID != draftID {
+						t.Errorf("pick %d belongs to draft %s, want %s", overall, p.DraftID, draftID)
+					}
+					overall++
+				}
+			}
+		})
+	}
+}
+
+func indexOf(ids []uuid.UUID, id uuid.UUID) int {
+	for i := range ids {
+		if ids[i] == id {
+			return i
+		}
+	}
+	return -1
+}
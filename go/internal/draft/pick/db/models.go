@@ -108,9 +108,11 @@ func (ns NullDraftStatus) Value() (driver.Value, error) {
 type DraftType string
 
 const (
-	DraftTypeSNAKE   DraftType = "SNAKE"
-	DraftTypeAUCTION DraftType = "AUCTION"
-	DraftTypeROOKIE  DraftType = "ROOKIE"
+	DraftTypeSNAKE     DraftType = "SNAKE"
+	DraftTypeAUCTION   DraftType = "AUCTION"
+	DraftTypeROOKIE    DraftType = "ROOKIE"
+	DraftTypeEXPANSION DraftType = "EXPANSION"
+	DraftTypeDISPERSAL DraftType = "DISPERSAL"
 )
 
 func (e *DraftType) Scan(src interface{}) error {
@@ -313,6 +315,18 @@ type DraftPick struct {
 	PickedAt      sql.NullTime   `json:"picked_at"`
 	AuctionAmount sql.NullString `json:"auction_amount"`
 	KeeperPick    sql.NullBool   `json:"keeper_pick"`
+	Forfeited     bool           `json:"forfeited"`
+	SkippedAt     sql.NullTime   `json:"skipped_at"`
+}
+
+type DraftPickSlotChange struct {
+	ID         uuid.UUID      `json:"id"`
+	PickID     uuid.UUID      `json:"pick_id"`
+	DraftID    uuid.UUID      `json:"draft_id"`
+	FromTeamID uuid.UUID      `json:"from_team_id"`
+	ToTeamID   uuid.UUID      `json:"to_team_id"`
+	Reason     sql.NullString `json:"reason"`
+	ChangedAt  time.Time      `json:"changed_at"`
 }
 
 type FantasyTeam struct {
@@ -351,6 +365,23 @@ type NflPlayerProfile struct {
 	WeightDesc   sql.NullString `json:"weight_desc"`
 }
 
+type PickTradeOffer struct {
+	ID               uuid.UUID      `json:"id"`
+	DraftID          uuid.UUID      `json:"draft_id"`
+	PickID           uuid.UUID      `json:"pick_id"`
+	FromTeamID       uuid.UUID      `json:"from_team_id"`
+	ToTeamID         uuid.UUID      `json:"to_team_id"`
+	RequestedPickID  uuid.NullUUID  `json:"requested_pick_id"`
+	Message          sql.NullString `json:"message"`
+	ProposedBy       uuid.NullUUID  `json:"proposed_by"`
+	Status           string         `json:"status"`
+	ClockRemainingMs sql.NullInt64  `json:"clock_remaining_ms"`
+	OpenedAt         time.Time      `json:"opened_at"`
+	ExpiresAt        time.Time      `json:"expires_at"`
+	ClosedAt         sql.NullTime   `json:"closed_at"`
+	RespondedBy      uuid.NullUUID  `json:"responded_by"`
+}
+
 type Player struct {
 	ID         uuid.UUID     `json:"id"`
 	SportID    string        `json:"sport_id"`
@@ -368,6 +399,10 @@ type RosterPlayer struct {
 	AcquiredAt      time.Time             `json:"acquired_at"`
 	AcquisitionType AcquisitionTypeEnum   `json:"acquisition_type"`
 	KeeperData      pqtype.NullRawMessage `json:"keeper_data"`
+	LineupSlot      sql.NullString        `json:"lineup_slot"`
+	Salary          sql.NullInt32         `json:"salary"`
+	ContractYears   sql.NullInt32         `json:"contract_years"`
+	FranchiseTagged bool                  `json:"franchise_tagged"`
 }
 
 type Sport struct {
@@ -391,6 +426,21 @@ type Team struct {
 	CreatedAt       time.Time      `json:"created_at"`
 }
 
+type TeamByeWeek struct {
+	TeamID    uuid.UUID `json:"team_id"`
+	Season    int32     `json:"season"`
+	ByeWeek   int32     `json:"bye_week"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TeamDepthChart struct {
+	TeamID    uuid.UUID `json:"team_id"`
+	PlayerID  uuid.UUID `json:"player_id"`
+	Position  string    `json:"position"`
+	Depth     int32     `json:"depth"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 type User struct {
 	ID        uuid.UUID `json:"id"`
 	Username  string    `json:"username"`
@@ -8,17 +8,60 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
+const canUserMakePick = `-- name: CanUserMakePick :one
+SELECT EXISTS (
+    SELECT 1
+    FROM draft_picks dp
+             JOIN fantasy_teams ft ON ft.id = dp.team_id
+    WHERE dp.id = $1 AND ft.owner_id = $2
+    UNION ALL
+    SELECT 1
+    FROM draft_picks dp
+             JOIN draft_co_managers cm ON cm.draft_id = dp.draft_id AND cm.fantasy_team_id = dp.team_id
+    WHERE dp.id = $1 AND cm.user_id = $2
+    UNION ALL
+    SELECT 1
+    FROM draft_picks dp
+             JOIN team_delegations td ON td.fantasy_team_id = dp.team_id
+    WHERE dp.id = $1 AND td.delegate_id = $2
+      AND td.revoked_at IS NULL AND td.starts_at <= NOW() AND td.ends_at > NOW()
+    UNION ALL
+    SELECT 1
+    FROM draft_picks dp
+             JOIN draft d ON d.id = dp.draft_id
+             JOIN leagues l ON l.id = d.league_id
+    WHERE dp.id = $1 AND l.commissioner_id = $2
+) AS can_pick
+`
+
+type CanUserMakePickParams struct {
+	PickID uuid.UUID `json:"pick_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Whether a user may make a pick: the owner of the team holding it, a co-manager of that team
+// in the pick's draft, the team's delegate while its owner is away, or the commissioner of the
+// draft's league, proxy drafting for the owner.
+func (q *Queries) CanUserMakePick(ctx context.Context, arg CanUserMakePickParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, canUserMakePick, arg.PickID, arg.UserID)
+	var can_pick bool
+	err := row.Scan(&can_pick)
+	return can_pick, err
+}
+
 const claimNextPickSlot = `-- name: ClaimNextPickSlot :one
 SELECT dp.id, dp.team_id, dp.overall_pick
 FROM draft_picks dp
 WHERE dp.draft_id = $1
   AND dp.player_id IS NULL
-ORDER BY dp.overall_pick
+  AND NOT dp.forfeited
+ORDER BY dp.skipped_at IS NOT NULL, dp.overall_pick
 FOR UPDATE SKIP LOCKED
 LIMIT 1
 `
@@ -36,9 +79,39 @@ func (q *Queries) ClaimNextPickSlot(ctx context.Context, draftID uuid.UUID) (Cla
 	return i, err
 }
 
+const countDraftPicksByDraft = `-- name: CountDraftPicksByDraft :one
+SELECT COUNT(*) FROM draft_picks
+WHERE draft_id = $1
+  AND ($2::integer IS NULL OR round >= $2::integer)
+  AND ($3::integer IS NULL OR round <= $3::integer)
+  AND (NOT $4::boolean OR player_id IS NOT NULL)
+  AND (NOT $5::boolean OR (player_id IS NULL AND NOT forfeited))
+`
+
+type CountDraftPicksByDraftParams struct {
+	DraftID       uuid.UUID     `json:"draft_id"`
+	MinRound      sql.NullInt32 `json:"min_round"`
+	MaxRound      sql.NullInt32 `json:"max_round"`
+	OnlyCompleted bool          `json:"only_completed"`
+	OnlyRemaining bool          `json:"only_remaining"`
+}
+
+func (q *Queries) CountDraftPicksByDraft(ctx context.Context, arg CountDraftPicksByDraftParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countDraftPicksByDraft,
+		arg.DraftID,
+		arg.MinRound,
+		arg.MaxRound,
+		arg.OnlyCompleted,
+		arg.OnlyRemaining,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countRemainingPicks = `-- name: CountRemainingPicks :one
 SELECT COUNT(*) FROM draft_picks
-WHERE draft_id = $1 AND player_id IS NULL
+WHERE draft_id = $1 AND player_id IS NULL AND NOT forfeited
 `
 
 func (q *Queries) CountRemainingPicks(ctx context.Context, draftID uuid.UUID) (int64, error) {
@@ -48,6 +121,42 @@ func (q *Queries) CountRemainingPicks(ctx context.Context, draftID uuid.UUID) (i
 	return count, err
 }
 
+const countRemainingPicksForDrafts = `-- name: CountRemainingPicksForDrafts :many
+SELECT draft_id, COUNT(*) FILTER (WHERE player_id IS NULL AND NOT forfeited) AS remaining_picks
+FROM draft_picks
+WHERE draft_id = ANY($1::uuid[])
+GROUP BY draft_id
+`
+
+type CountRemainingPicksForDraftsRow struct {
+	DraftID        uuid.UUID `json:"draft_id"`
+	RemainingPicks int64     `json:"remaining_picks"`
+}
+
+// Unpicked slots of several drafts at once; drafts without draft picks are left out.
+func (q *Queries) CountRemainingPicksForDrafts(ctx context.Context, draftIds []uuid.UUID) ([]CountRemainingPicksForDraftsRow, error) {
+	rows, err := q.db.QueryContext(ctx, countRemainingPicksForDrafts, pq.Array(draftIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountRemainingPicksForDraftsRow
+	for rows.Next() {
+		var i CountRemainingPicksForDraftsRow
+		if err := rows.Scan(&i.DraftID, &i.RemainingPicks); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createDraftPick = `-- name: CreateDraftPick :one
 INSERT INTO draft_picks (
     id,
@@ -71,7 +180,7 @@ INSERT INTO draft_picks (
     $8, -- picked_at
     $9, -- auction_amount
     $10 -- keeper_pick
-) RETURNING id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick
+) RETURNING id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at
 `
 
 type CreateDraftPickParams struct {
@@ -112,6 +221,8 @@ func (q *Queries) CreateDraftPick(ctx context.Context, arg CreateDraftPickParams
 		&i.PickedAt,
 		&i.AuctionAmount,
 		&i.KeeperPick,
+		&i.Forfeited,
+		&i.SkippedAt,
 	)
 	return i, err
 }
@@ -164,8 +275,39 @@ func (q *Queries) DeleteDraftPicksByDraft(ctx context.Context, draftID uuid.UUID
 	return err
 }
 
+const forfeitPick = `-- name: ForfeitPick :execrows
+UPDATE draft_picks
+SET forfeited = TRUE, skipped_at = COALESCE(skipped_at, NOW())
+WHERE id = $1
+  AND player_id IS NULL
+  AND NOT forfeited
+`
+
+// Move the draft past an unmade pick for good, for a team with no room left on its roster.
+func (q *Queries) ForfeitPick(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, forfeitPick, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getDraftEventSequence = `-- name: GetDraftEventSequence :one
+SELECT COALESCE((SELECT last_seq
+                 FROM draft_event_sequences
+                 WHERE draft_id = $1), 0)::bigint AS last_seq
+`
+
+// The sequence of the last outbox event written for a draft, 0 before the first one.
+func (q *Queries) GetDraftEventSequence(ctx context.Context, draftID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getDraftEventSequence, draftID)
+	var last_seq int64
+	err := row.Scan(&last_seq)
+	return last_seq, err
+}
+
 const getDraftPick = `-- name: GetDraftPick :one
-SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick FROM draft_picks WHERE id = $1
+SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at FROM draft_picks WHERE id = $1
 `
 
 func (q *Queries) GetDraftPick(ctx context.Context, id uuid.UUID) (DraftPick, error) {
@@ -182,12 +324,53 @@ func (q *Queries) GetDraftPick(ctx context.Context, id uuid.UUID) (DraftPick, er
 		&i.PickedAt,
 		&i.AuctionAmount,
 		&i.KeeperPick,
+		&i.Forfeited,
+		&i.SkippedAt,
+	)
+	return i, err
+}
+
+const getDraftPickForUpdate = `-- name: GetDraftPickForUpdate :one
+SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at FROM draft_picks WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetDraftPickForUpdate(ctx context.Context, id uuid.UUID) (DraftPick, error) {
+	row := q.db.QueryRowContext(ctx, getDraftPickForUpdate, id)
+	var i DraftPick
+	err := row.Scan(
+		&i.ID,
+		&i.DraftID,
+		&i.Round,
+		&i.Pick,
+		&i.OverallPick,
+		&i.TeamID,
+		&i.PlayerID,
+		&i.PickedAt,
+		&i.AuctionAmount,
+		&i.KeeperPick,
+		&i.Forfeited,
+		&i.SkippedAt,
 	)
 	return i, err
 }
 
+const getDraftPickLeagueID = `-- name: GetDraftPickLeagueID :one
+SELECT d.league_id
+FROM draft_picks dp
+JOIN draft d ON d.id = dp.draft_id
+WHERE dp.id = $1
+`
+
+// Resolve the league that owns a pick via its draft (used for tenancy checks).
+func (q *Queries) GetDraftPickLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, getDraftPickLeagueID, id)
+	var league_id uuid.UUID
+	err := row.Scan(&league_id)
+	return league_id, err
+}
+
 const getDraftPicksByDraft = `-- name: GetDraftPicksByDraft :many
-SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick FROM draft_picks 
+SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at FROM draft_picks 
 WHERE draft_id = $1 
 ORDER BY overall_pick
 `
@@ -212,6 +395,8 @@ func (q *Queries) GetDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) (
 			&i.PickedAt,
 			&i.AuctionAmount,
 			&i.KeeperPick,
+			&i.Forfeited,
+			&i.SkippedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -227,7 +412,7 @@ func (q *Queries) GetDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) (
 }
 
 const getDraftPicksByRound = `-- name: GetDraftPicksByRound :many
-SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick FROM draft_picks 
+SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at FROM draft_picks 
 WHERE draft_id = $1 AND round = $2 
 ORDER BY pick
 `
@@ -257,6 +442,8 @@ func (q *Queries) GetDraftPicksByRound(ctx context.Context, arg GetDraftPicksByR
 			&i.PickedAt,
 			&i.AuctionAmount,
 			&i.KeeperPick,
+			&i.Forfeited,
+			&i.SkippedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -271,13 +458,123 @@ func (q *Queries) GetDraftPicksByRound(ctx context.Context, arg GetDraftPicksByR
 	return items, nil
 }
 
+const getDraftRankingSettings = `-- name: GetDraftRankingSettings :one
+SELECT l.season, l.sport_id, COALESCE(d.league_settings_snapshot, l.league_settings) AS league_settings
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE d.id = $1
+`
+
+type GetDraftRankingSettingsRow struct {
+	Season         string          `json:"season"`
+	SportID        string          `json:"sport_id"`
+	LeagueSettings json.RawMessage `json:"league_settings"`
+}
+
+// The season, sport and settings of the league running a draft, which pick the rankings its board is
+// sorted by and the positions it drafts. A started draft reads the settings it snapshotted.
+func (q *Queries) GetDraftRankingSettings(ctx context.Context, id uuid.UUID) (GetDraftRankingSettingsRow, error) {
+	row := q.db.QueryRowContext(ctx, getDraftRankingSettings, id)
+	var i GetDraftRankingSettingsRow
+	err := row.Scan(&i.Season, &i.SportID, &i.LeagueSettings)
+	return i, err
+}
+
+const getDraftSettings = `-- name: GetDraftSettings :one
+SELECT settings FROM draft WHERE id = $1
+`
+
+func (q *Queries) GetDraftSettings(ctx context.Context, id uuid.UUID) (json.RawMessage, error) {
+	row := q.db.QueryRowContext(ctx, getDraftSettings, id)
+	var settings json.RawMessage
+	err := row.Scan(&settings)
+	return settings, err
+}
+
+const getDraftStatus = `-- name: GetDraftStatus :one
+SELECT status::text AS status FROM draft WHERE id = $1
+`
+
+// Read the status of the draft a pick belongs to, checked under the draft lock before a pick is made.
+func (q *Queries) GetDraftStatus(ctx context.Context, id uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, getDraftStatus, id)
+	var status string
+	err := row.Scan(&status)
+	return status, err
+}
+
+const getDraftType = `-- name: GetDraftType :one
+SELECT draft_type::text AS draft_type FROM draft WHERE id = $1
+`
+
+// Read the type of a draft, checked under the draft lock to route expansion and dispersal picks through their pools.
+func (q *Queries) GetDraftType(ctx context.Context, id uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, getDraftType, id)
+	var draft_type string
+	err := row.Scan(&draft_type)
+	return draft_type, err
+}
+
+const getExpansionPlayerSource = `-- name: GetExpansionPlayerSource :one
+SELECT
+    rp.fantasy_team_id,
+    EXISTS (
+        SELECT 1
+        FROM expansion_protected_players epp
+        WHERE epp.draft_id = d.id
+          AND epp.fantasy_team_id = rp.fantasy_team_id
+          AND epp.player_id = rp.player_id
+    )::boolean AS protected,
+    (SELECT COUNT(*)
+     FROM expansion_selections es
+     WHERE es.draft_id = d.id
+       AND es.from_team_id = rp.fantasy_team_id) AS players_lost,
+    COALESCE((d.settings ->> 'max_players_lost_per_team')::int, 0)::int AS max_players_lost
+FROM draft d
+JOIN fantasy_teams ft ON ft.league_id = d.league_id
+JOIN roster_players rp ON rp.fantasy_team_id = ft.id
+WHERE d.id = sqlc.arg('draft_id')
+  AND rp.player_id = sqlc.arg('player_id')
+  AND NOT COALESCE(d.settings -> 'draft_order', '[]'::jsonb) @> to_jsonb(ft.id::text)
+LIMIT 1
+`
+
+type GetExpansionPlayerSourceParams struct {
+	DraftID  uuid.UUID `json:"draft_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+type GetExpansionPlayerSourceRow struct {
+	FantasyTeamID  uuid.UUID `json:"fantasy_team_id"`
+	Protected      bool      `json:"protected"`
+	PlayersLost    int64     `json:"players_lost"`
+	MaxPlayersLost int32     `json:"max_players_lost"`
+}
+
+// The existing team an expansion draft takes a player from: the team in the draft's league
+// rostering the player outside the draft order, whether it protected the player, how many
+// players it has lost to the draft so far and the most it may lose, zero for no limit.
+func (q *Queries) GetExpansionPlayerSource(ctx context.Context, arg GetExpansionPlayerSourceParams) (GetExpansionPlayerSourceRow, error) {
+	row := q.db.QueryRowContext(ctx, getExpansionPlayerSource, arg.DraftID, arg.PlayerID)
+	var i GetExpansionPlayerSourceRow
+	err := row.Scan(
+		&i.FantasyTeamID,
+		&i.Protected,
+		&i.PlayersLost,
+		&i.MaxPlayersLost,
+	)
+	return i, err
+}
+
 const getNextPickForDraft = `-- name: GetNextPickForDraft :one
-SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick FROM draft_picks 
-WHERE draft_id = $1 AND player_id IS NULL 
-ORDER BY overall_pick 
+SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at FROM draft_picks 
+WHERE draft_id = $1 AND player_id IS NULL AND NOT forfeited
+ORDER BY skipped_at IS NOT NULL, overall_pick 
 LIMIT 1
 `
 
+// The pick on the clock. Skipped picks come back on the clock in board order once every
+// other pick is made.
 func (q *Queries) GetNextPickForDraft(ctx context.Context, draftID uuid.UUID) (DraftPick, error) {
 	row := q.db.QueryRowContext(ctx, getNextPickForDraft, draftID)
 	var i DraftPick
@@ -292,16 +589,310 @@ func (q *Queries) GetNextPickForDraft(ctx context.Context, draftID uuid.UUID) (D
 		&i.PickedAt,
 		&i.AuctionAmount,
 		&i.KeeperPick,
+		&i.Forfeited,
+		&i.SkippedAt,
 	)
 	return i, err
 }
 
+const getPickAnnouncement = `-- name: GetPickAnnouncement :one
+SELECT
+    ft.name      AS team_name,
+    p.full_name  AS player_name,
+    npp.position AS player_position,
+    t.code       AS nfl_team_code
+FROM draft_picks dp
+LEFT JOIN fantasy_teams ft ON ft.id = dp.team_id
+LEFT JOIN players p ON p.id = dp.player_id
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = dp.player_id
+LEFT JOIN teams t ON t.id = p.team_id
+WHERE dp.id = $1
+`
+
+type GetPickAnnouncementRow struct {
+	TeamName       sql.NullString `json:"team_name"`
+	PlayerName     sql.NullString `json:"player_name"`
+	PlayerPosition sql.NullString `json:"player_position"`
+	NflTeamCode    sql.NullString `json:"nfl_team_code"`
+}
+
+// Display data for announcing a pick: the fantasy team's name and the player's name, position and NFL team code.
+func (q *Queries) GetPickAnnouncement(ctx context.Context, id uuid.UUID) (GetPickAnnouncementRow, error) {
+	row := q.db.QueryRowContext(ctx, getPickAnnouncement, id)
+	var i GetPickAnnouncementRow
+	err := row.Scan(
+		&i.TeamName,
+		&i.PlayerName,
+		&i.PlayerPosition,
+		&i.NflTeamCode,
+	)
+	return i, err
+}
+
+const getPickTradeSettings = `-- name: GetPickTradeSettings :one
+SELECT d.league_id, d.scheduled_at, d.sandbox_of_draft_id IS NOT NULL AS sandbox, l.season, l.league_settings
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE d.id = $1
+`
+
+type GetPickTradeSettingsRow struct {
+	LeagueID       uuid.UUID       `json:"league_id"`
+	ScheduledAt    sql.NullTime    `json:"scheduled_at"`
+	Sandbox        bool            `json:"sandbox"`
+	Season         string          `json:"season"`
+	LeagueSettings json.RawMessage `json:"league_settings"`
+}
+
+// The league and schedule of the draft a traded pick is in, which decide the season the pick is
+// for and the rules it is traded under. Moving a pick of a sandbox draft isn't a trade.
+func (q *Queries) GetPickTradeSettings(ctx context.Context, id uuid.UUID) (GetPickTradeSettingsRow, error) {
+	row := q.db.QueryRowContext(ctx, getPickTradeSettings, id)
+	var i GetPickTradeSettingsRow
+	err := row.Scan(
+		&i.LeagueID,
+		&i.ScheduledAt,
+		&i.Sandbox,
+		&i.Season,
+		&i.LeagueSettings,
+	)
+	return i, err
+}
+
+const getPlayerPosition = `-- name: GetPlayerPosition :one
+SELECT COALESCE(position, '')::text AS position FROM nfl_player_profiles WHERE player_id = $1
+`
+
+// The position a player is listed at, empty when the profile has none.
+func (q *Queries) GetPlayerPosition(ctx context.Context, playerID uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, getPlayerPosition, playerID)
+	var position string
+	err := row.Scan(&position)
+	return position, err
+}
+
+const getTeamDraftPayroll = `-- name: GetTeamDraftPayroll :one
+SELECT (
+    (SELECT COALESCE(SUM(COALESCE(rp.salary, $1::int)), 0)
+     FROM roster_players rp
+     WHERE rp.fantasy_team_id = $2)
+    + $1::int * (SELECT COUNT(*)
+     FROM draft_picks dp
+     WHERE dp.draft_id = $3
+       AND dp.team_id = $2
+       AND dp.player_id IS NOT NULL
+       AND NOT EXISTS (
+           SELECT 1
+           FROM roster_players rp
+           WHERE rp.fantasy_team_id = dp.team_id
+             AND rp.player_id = dp.player_id
+       ))
+)::bigint AS payroll
+`
+
+type GetTeamDraftPayrollParams struct {
+	MinSalary int32     `json:"min_salary"`
+	TeamID    uuid.UUID `json:"team_id"`
+	DraftID   uuid.UUID `json:"draft_id"`
+}
+
+// What a team pays its roster during a draft: its players' salaries, counting players without
+// one at the league's minimum salary, plus the minimum salary for each of its picks in the draft
+// that hasn't reached the roster yet.
+func (q *Queries) GetTeamDraftPayroll(ctx context.Context, arg GetTeamDraftPayrollParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getTeamDraftPayroll, arg.MinSalary, arg.TeamID, arg.DraftID)
+	var payroll int64
+	err := row.Scan(&payroll)
+	return payroll, err
+}
+
+const insertDelegateAction = `-- name: InsertDelegateAction :exec
+INSERT INTO team_delegate_actions (id, delegation_id, fantasy_team_id, delegate_id, action, details)
+SELECT $1::uuid, td.id, td.fantasy_team_id, td.delegate_id, $2::text, $3::jsonb
+FROM team_delegations td
+WHERE td.fantasy_team_id = $4
+  AND td.delegate_id = $5
+  AND td.revoked_at IS NULL
+  AND td.starts_at <= NOW()
+  AND td.ends_at > NOW()
+LIMIT 1
+`
+
+type InsertDelegateActionParams struct {
+	ID            uuid.UUID       `json:"id"`
+	Action        string          `json:"action"`
+	Details       json.RawMessage `json:"details"`
+	FantasyTeamID uuid.UUID       `json:"fantasy_team_id"`
+	UserID        uuid.UUID       `json:"user_id"`
+}
+
+// Attributes an action on a team to the user who took it when they took it as the team's active
+// delegate. Inserts nothing for anyone else.
+func (q *Queries) InsertDelegateAction(ctx context.Context, arg InsertDelegateActionParams) error {
+	_, err := q.db.ExecContext(ctx, insertDelegateAction,
+		arg.ID,
+		arg.Action,
+		arg.Details,
+		arg.FantasyTeamID,
+		arg.UserID,
+	)
+	return err
+}
+
+const insertDraftPickSlotChange = `-- name: InsertDraftPickSlotChange :exec
+INSERT INTO draft_pick_slot_changes (id, pick_id, draft_id, from_team_id, to_team_id, reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertDraftPickSlotChangeParams struct {
+	ID         uuid.UUID      `json:"id"`
+	PickID     uuid.UUID      `json:"pick_id"`
+	DraftID    uuid.UUID      `json:"draft_id"`
+	FromTeamID uuid.UUID      `json:"from_team_id"`
+	ToTeamID   uuid.UUID      `json:"to_team_id"`
+	Reason     sql.NullString `json:"reason"`
+}
+
+func (q *Queries) InsertDraftPickSlotChange(ctx context.Context, arg InsertDraftPickSlotChangeParams) error {
+	_, err := q.db.ExecContext(ctx, insertDraftPickSlotChange,
+		arg.ID,
+		arg.PickID,
+		arg.DraftID,
+		arg.FromTeamID,
+		arg.ToTeamID,
+		arg.Reason,
+	)
+	return err
+}
+
+const insertExpansionSelection = `-- name: InsertExpansionSelection :exec
+INSERT INTO expansion_selections (pick_id, draft_id, from_team_id, player_id)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertExpansionSelectionParams struct {
+	PickID     uuid.UUID `json:"pick_id"`
+	DraftID    uuid.UUID `json:"draft_id"`
+	FromTeamID uuid.UUID `json:"from_team_id"`
+	PlayerID   uuid.UUID `json:"player_id"`
+}
+
+func (q *Queries) InsertExpansionSelection(ctx context.Context, arg InsertExpansionSelectionParams) error {
+	_, err := q.db.ExecContext(ctx, insertExpansionSelection,
+		arg.PickID,
+		arg.DraftID,
+		arg.FromTeamID,
+		arg.PlayerID,
+	)
+	return err
+}
+
+const isDraftCommissioner = `-- name: IsDraftCommissioner :one
+SELECT EXISTS (SELECT 1
+               FROM draft d
+                        JOIN leagues l ON l.id = d.league_id
+               WHERE d.id = $1 AND l.commissioner_id = $2) AS commissioner
+`
+
+type IsDraftCommissionerParams struct {
+	DraftID uuid.UUID `json:"draft_id"`
+	UserID  uuid.UUID `json:"user_id"`
+}
+
+// Whether a user is the commissioner of the league a draft belongs to.
+func (q *Queries) IsDraftCommissioner(ctx context.Context, arg IsDraftCommissionerParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isDraftCommissioner, arg.DraftID, arg.UserID)
+	var commissioner bool
+	err := row.Scan(&commissioner)
+	return commissioner, err
+}
+
+const isDraftDeadlinePassed = `-- name: IsDraftDeadlinePassed :one
+SELECT COALESCE(next_deadline <= COALESCE($1::timestamptz, NOW()), FALSE)::boolean AS passed FROM draft WHERE id = $2
+`
+
+type IsDraftDeadlinePassedParams struct {
+	Now sql.NullTime `json:"now"`
+	ID  uuid.UUID    `json:"id"`
+}
+
+// Whether the pick clock of a draft has run out at now, or on the database clock when now is null.
+func (q *Queries) IsDraftDeadlinePassed(ctx context.Context, arg IsDraftDeadlinePassedParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isDraftDeadlinePassed, arg.Now, arg.ID)
+	var passed bool
+	err := row.Scan(&passed)
+	return passed, err
+}
+
+const isInDispersalPool = `-- name: IsInDispersalPool :one
+SELECT EXISTS (SELECT 1
+               FROM dispersal_drafts dd
+               JOIN roster_players rp ON rp.fantasy_team_id = dd.folded_team_id
+               WHERE dd.draft_id = $1
+                 AND rp.player_id = $2
+                 AND NOT EXISTS (SELECT 1
+                                 FROM draft_picks dp
+                                 WHERE dp.draft_id = dd.draft_id
+                                   AND dp.player_id = rp.player_id)) AS in_pool
+`
+
+type IsInDispersalPoolParams struct {
+	DraftID  uuid.UUID `json:"draft_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+// Whether a dispersal draft can take a player: still rostered by the team that folded and not yet picked.
+func (q *Queries) IsInDispersalPool(ctx context.Context, arg IsInDispersalPoolParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isInDispersalPool, arg.DraftID, arg.PlayerID)
+	var in_pool bool
+	err := row.Scan(&in_pool)
+	return in_pool, err
+}
+
+const isPickTeamAbandoned = `-- name: IsPickTeamAbandoned :one
+SELECT EXISTS (SELECT 1
+               FROM draft_picks dp
+                        JOIN draft_abandoned_teams dat ON dat.draft_id = dp.draft_id AND dat.fantasy_team_id = dp.team_id
+               WHERE dp.id = $1) AS abandoned
+`
+
+// Whether the team holding a pick has been abandoned by the commissioner; its owner can't make the pick.
+func (q *Queries) IsPickTeamAbandoned(ctx context.Context, id uuid.UUID) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isPickTeamAbandoned, id)
+	var abandoned bool
+	err := row.Scan(&abandoned)
+	return abandoned, err
+}
+
 const listAvailablePlayersForDraft = `-- name: ListAvailablePlayersForDraft :many
 SELECT
     p.id,
     p.full_name,
-    p.team_id
+    p.team_id,
+    bw.bye_week,
+    dc.position AS depth_chart_position,
+    dc.depth AS depth_chart_depth,
+    npp.position,
+    npp.status,
+    po.owned_pct,
+    po.started_pct
 FROM players p
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+LEFT JOIN player_ownership po ON po.player_id = p.id
+LEFT JOIN LATERAL (
+    SELECT tbw.bye_week
+    FROM team_bye_weeks tbw
+    WHERE tbw.team_id = p.team_id
+    ORDER BY tbw.season DESC
+    LIMIT 1
+) bw ON TRUE
+LEFT JOIN LATERAL (
+    SELECT tdc.position, tdc.depth
+    FROM team_depth_charts tdc
+    WHERE tdc.player_id = p.id
+    ORDER BY tdc.depth
+    LIMIT 1
+) dc ON TRUE
 WHERE NOT EXISTS (
     SELECT 1
     FROM draft_picks dp
@@ -312,12 +903,20 @@ ORDER BY p.full_name
 `
 
 type ListAvailablePlayersForDraftRow struct {
-	ID       uuid.UUID     `json:"id"`
-	FullName string        `json:"full_name"`
-	TeamID   uuid.NullUUID `json:"team_id"`
+	ID                 uuid.UUID       `json:"id"`
+	FullName           string          `json:"full_name"`
+	TeamID             uuid.NullUUID   `json:"team_id"`
+	ByeWeek            sql.NullInt32   `json:"bye_week"`
+	DepthChartPosition sql.NullString  `json:"depth_chart_position"`
+	DepthChartDepth    sql.NullInt32   `json:"depth_chart_depth"`
+	Position           sql.NullString  `json:"position"`
+	Status             sql.NullString  `json:"status"`
+	OwnedPct           sql.NullFloat64 `json:"owned_pct"`
+	StartedPct         sql.NullFloat64 `json:"started_pct"`
 }
 
-// List all players not yet picked in draft $1, ordered by name.
+// List all players not yet picked in draft $1, ordered by name, with their position, their
+// team's latest bye week and their highest depth chart slot.
 func (q *Queries) ListAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUID) ([]ListAvailablePlayersForDraftRow, error) {
 	rows, err := q.db.QueryContext(ctx, listAvailablePlayersForDraft, draftID)
 	if err != nil {
@@ -327,7 +926,530 @@ func (q *Queries) ListAvailablePlayersForDraft(ctx context.Context, draftID uuid
 	var items []ListAvailablePlayersForDraftRow
 	for rows.Next() {
 		var i ListAvailablePlayersForDraftRow
-		if err := rows.Scan(&i.ID, &i.FullName, &i.TeamID); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.TeamID,
+			&i.ByeWeek,
+			&i.DepthChartPosition,
+			&i.DepthChartDepth,
+			&i.Position,
+			&i.Status,
+			&i.OwnedPct,
+			&i.StartedPct,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDispersalPoolPlayerIDs = `-- name: ListDispersalPoolPlayerIDs :many
+SELECT rp.player_id
+FROM dispersal_drafts dd
+JOIN roster_players rp ON rp.fantasy_team_id = dd.folded_team_id
+WHERE dd.draft_id = $1
+  AND NOT EXISTS (
+    SELECT 1
+    FROM draft_picks dp
+    WHERE dp.draft_id = dd.draft_id
+      AND dp.player_id = rp.player_id
+)
+`
+
+// The players a dispersal draft can still take: rostered by the team that folded and not yet picked.
+func (q *Queries) ListDispersalPoolPlayerIDs(ctx context.Context, draftID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listDispersalPoolPlayerIDs, draftID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var player_id uuid.UUID
+		if err := rows.Scan(&player_id); err != nil {
+			return nil, err
+		}
+		items = append(items, player_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDraftPicksByDraft = `-- name: ListDraftPicksByDraft :many
+SELECT id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at FROM draft_picks
+WHERE draft_id = $1
+  AND ($2::integer IS NULL OR round >= $2::integer)
+  AND ($3::integer IS NULL OR round <= $3::integer)
+  AND (NOT $4::boolean OR player_id IS NOT NULL)
+  AND (NOT $5::boolean OR (player_id IS NULL AND NOT forfeited))
+  AND ($6::integer IS NULL OR overall_pick > $6::integer)
+ORDER BY overall_pick
+LIMIT $7 OFFSET $8
+`
+
+type ListDraftPicksByDraftParams struct {
+	DraftID          uuid.UUID     `json:"draft_id"`
+	MinRound         sql.NullInt32 `json:"min_round"`
+	MaxRound         sql.NullInt32 `json:"max_round"`
+	OnlyCompleted    bool          `json:"only_completed"`
+	OnlyRemaining    bool          `json:"only_remaining"`
+	AfterOverallPick sql.NullInt32 `json:"after_overall_pick"`
+	PageLimit        sql.NullInt32 `json:"page_limit"`
+	PageOffset       int32         `json:"page_offset"`
+}
+
+func (q *Queries) ListDraftPicksByDraft(ctx context.Context, arg ListDraftPicksByDraftParams) ([]DraftPick, error) {
+	rows, err := q.db.QueryContext(ctx, listDraftPicksByDraft,
+		arg.DraftID,
+		arg.MinRound,
+		arg.MaxRound,
+		arg.OnlyCompleted,
+		arg.OnlyRemaining,
+		arg.AfterOverallPick,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DraftPick
+	for rows.Next() {
+		var i DraftPick
+		if err := rows.Scan(
+			&i.ID,
+			&i.DraftID,
+			&i.Round,
+			&i.Pick,
+			&i.OverallPick,
+			&i.TeamID,
+			&i.PlayerID,
+			&i.PickedAt,
+			&i.AuctionAmount,
+			&i.KeeperPick,
+			&i.Forfeited,
+			&i.SkippedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDraftResults = `-- name: ListDraftResults :many
+SELECT
+    dp.round,
+    dp.pick,
+    dp.overall_pick,
+    dp.team_id,
+    ft.name      AS team_name,
+    dp.player_id,
+    p.full_name  AS player_name,
+    npp.position AS player_position,
+    dp.keeper_pick,
+    dp.auction_amount,
+    dp.picked_at
+FROM draft_picks dp
+LEFT JOIN fantasy_teams ft ON ft.id = dp.team_id
+LEFT JOIN players p ON p.id = dp.player_id
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = dp.player_id
+WHERE dp.draft_id = $1
+ORDER BY dp.overall_pick
+`
+
+type ListDraftResultsRow struct {
+	Round          int32          `json:"round"`
+	Pick           int32          `json:"pick"`
+	OverallPick    int32          `json:"overall_pick"`
+	TeamID         uuid.UUID      `json:"team_id"`
+	TeamName       sql.NullString `json:"team_name"`
+	PlayerID       uuid.NullUUID  `json:"player_id"`
+	PlayerName     sql.NullString `json:"player_name"`
+	PlayerPosition sql.NullString `json:"player_position"`
+	KeeperPick     sql.NullBool   `json:"keeper_pick"`
+	AuctionAmount  sql.NullString `json:"auction_amount"`
+	PickedAt       sql.NullTime   `json:"picked_at"`
+}
+
+// Every pick of a draft in board order with its team's name and, once made, the player's name and position.
+func (q *Queries) ListDraftResults(ctx context.Context, draftID uuid.UUID) ([]ListDraftResultsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDraftResults, draftID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDraftResultsRow
+	for rows.Next() {
+		var i ListDraftResultsRow
+		if err := rows.Scan(
+			&i.Round,
+			&i.Pick,
+			&i.OverallPick,
+			&i.TeamID,
+			&i.TeamName,
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.PlayerPosition,
+			&i.KeeperPick,
+			&i.AuctionAmount,
+			&i.PickedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpansionPoolPlayerIDs = `-- name: ListExpansionPoolPlayerIDs :many
+SELECT rp.player_id
+FROM draft d
+JOIN fantasy_teams ft ON ft.league_id = d.league_id
+JOIN roster_players rp ON rp.fantasy_team_id = ft.id
+WHERE d.id = $1
+  AND NOT COALESCE(d.settings -> 'draft_order', '[]'::jsonb) @> to_jsonb(ft.id::text)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM expansion_protected_players epp
+    WHERE epp.draft_id = d.id
+      AND epp.fantasy_team_id = ft.id
+      AND epp.player_id = rp.player_id
+)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM draft_picks dp
+    WHERE dp.draft_id = d.id
+      AND dp.player_id = rp.player_id
+)
+  AND (COALESCE((d.settings ->> 'max_players_lost_per_team')::int, 0) = 0
+    OR (SELECT COUNT(*)
+        FROM expansion_selections es
+        WHERE es.draft_id = d.id
+          AND es.from_team_id = ft.id) < (d.settings ->> 'max_players_lost_per_team')::int)
+`
+
+// The players an expansion draft can still take: rostered by a team in the draft's league outside
+// the draft order, left off that team's protection list, not yet picked, and held by a team that
+// hasn't lost max_players_lost_per_team players to the draft.
+func (q *Queries) ListExpansionPoolPlayerIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listExpansionPoolPlayerIDs, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var player_id uuid.UUID
+		if err := rows.Scan(&player_id); err != nil {
+			return nil, err
+		}
+		items = append(items, player_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRankedAvailablePlayersForDraft = `-- name: ListRankedAvailablePlayersForDraft :many
+SELECT
+    p.id,
+    p.full_name,
+    p.team_id,
+    bw.bye_week,
+    dc.position AS depth_chart_position,
+    dc.depth AS depth_chart_depth,
+    npp.position,
+    npp.status,
+    pr.overall_rank,
+    pr.projected_points,
+    po.owned_pct,
+    po.started_pct,
+    pav.average_price AS average_auction_price,
+    pav.sales AS auction_sales
+FROM players p
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+LEFT JOIN player_ownership po ON po.player_id = p.id
+LEFT JOIN LATERAL (
+    SELECT tbw.bye_week
+    FROM team_bye_weeks tbw
+    WHERE tbw.team_id = p.team_id
+    ORDER BY tbw.season DESC
+    LIMIT 1
+) bw ON TRUE
+LEFT JOIN LATERAL (
+    SELECT tdc.position, tdc.depth
+    FROM team_depth_charts tdc
+    WHERE tdc.player_id = p.id
+    ORDER BY tdc.depth
+    LIMIT 1
+) dc ON TRUE
+LEFT JOIN player_rankings pr
+    ON pr.player_id = p.id
+   AND pr.season = $2
+   AND pr.scoring_format = $3
+   AND pr.superflex = $4
+LEFT JOIN player_auction_values pav
+    ON pav.player_id = p.id
+   AND pav.season = $2
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM draft_picks dp
+    WHERE dp.draft_id  = $1
+      AND dp.player_id = p.id
+)
+ORDER BY pr.overall_rank NULLS LAST, p.full_name
+`
+
+type ListRankedAvailablePlayersForDraftParams struct {
+	DraftID       uuid.UUID `json:"draft_id"`
+	Season        string    `json:"season"`
+	ScoringFormat string    `json:"scoring_format"`
+	Superflex     bool      `json:"superflex"`
+}
+
+type ListRankedAvailablePlayersForDraftRow struct {
+	ID                  uuid.UUID       `json:"id"`
+	FullName            string          `json:"full_name"`
+	TeamID              uuid.NullUUID   `json:"team_id"`
+	ByeWeek             sql.NullInt32   `json:"bye_week"`
+	DepthChartPosition  sql.NullString  `json:"depth_chart_position"`
+	DepthChartDepth     sql.NullInt32   `json:"depth_chart_depth"`
+	Position            sql.NullString  `json:"position"`
+	Status              sql.NullString  `json:"status"`
+	OverallRank         sql.NullInt32   `json:"overall_rank"`
+	ProjectedPoints     sql.NullFloat64 `json:"projected_points"`
+	OwnedPct            sql.NullFloat64 `json:"owned_pct"`
+	StartedPct          sql.NullFloat64 `json:"started_pct"`
+	AverageAuctionPrice sql.NullString  `json:"average_auction_price"`
+	AuctionSales        sql.NullInt32   `json:"auction_sales"`
+}
+
+// Same as ListAvailablePlayersForDraft plus each player's rank and projection for a
+// season and scoring format, and average auction price that season. Ranked players come
+// first by rank, the rest by name.
+func (q *Queries) ListRankedAvailablePlayersForDraft(ctx context.Context, arg ListRankedAvailablePlayersForDraftParams) ([]ListRankedAvailablePlayersForDraftRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRankedAvailablePlayersForDraft,
+		arg.DraftID,
+		arg.Season,
+		arg.ScoringFormat,
+		arg.Superflex,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRankedAvailablePlayersForDraftRow
+	for rows.Next() {
+		var i ListRankedAvailablePlayersForDraftRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.TeamID,
+			&i.ByeWeek,
+			&i.DepthChartPosition,
+			&i.DepthChartDepth,
+			&i.Position,
+			&i.Status,
+			&i.OverallRank,
+			&i.ProjectedPoints,
+			&i.OwnedPct,
+			&i.StartedPct,
+			&i.AverageAuctionPrice,
+			&i.AuctionSales,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamByeWeeks = `-- name: ListTeamByeWeeks :many
+SELECT npp.position::text AS position, bw.bye_week
+FROM (
+    SELECT rp.player_id
+    FROM roster_players rp
+    WHERE rp.fantasy_team_id = $1
+      AND rp.position <> 'TAXI'
+    UNION
+    SELECT dp.player_id
+    FROM draft_picks dp
+    WHERE dp.draft_id = $2
+      AND dp.team_id = $1
+      AND dp.player_id IS NOT NULL
+) held
+JOIN players p ON p.id = held.player_id
+JOIN nfl_player_profiles npp ON npp.player_id = held.player_id
+JOIN LATERAL (
+    SELECT tbw.bye_week
+    FROM team_bye_weeks tbw
+    WHERE tbw.team_id = p.team_id
+    ORDER BY tbw.season DESC
+    LIMIT 1
+) bw ON TRUE
+WHERE npp.position IS NOT NULL
+`
+
+type ListTeamByeWeeksParams struct {
+	TeamID  uuid.UUID `json:"team_id"`
+	DraftID uuid.UUID `json:"draft_id"`
+}
+
+type ListTeamByeWeeksRow struct {
+	Position string `json:"position"`
+	ByeWeek  int32  `json:"bye_week"`
+}
+
+// The position and bye week of each player a team holds, counted as in ListTeamRosterPositions, from
+// the latest season with bye weeks recorded for the player's team. Players without a position or a
+// recorded bye week are left out.
+func (q *Queries) ListTeamByeWeeks(ctx context.Context, arg ListTeamByeWeeksParams) ([]ListTeamByeWeeksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamByeWeeks, arg.TeamID, arg.DraftID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTeamByeWeeksRow
+	for rows.Next() {
+		var i ListTeamByeWeeksRow
+		if err := rows.Scan(&i.Position, &i.ByeWeek); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamFirstRoundPicksByDraft = `-- name: ListTeamFirstRoundPicksByDraft :many
+SELECT d.id, d.scheduled_at, COUNT(dp.id) AS first_round_picks
+FROM draft d
+LEFT JOIN draft_picks dp ON dp.draft_id = d.id AND dp.round = 1 AND dp.team_id = $1
+WHERE d.league_id = $2
+  AND d.sandbox_of_draft_id IS NULL
+  AND d.status <> 'CANCELLED'
+  AND d.settings -> 'draft_order' ? $1::text
+GROUP BY d.id, d.scheduled_at
+`
+
+type ListTeamFirstRoundPicksByDraftParams struct {
+	TeamID   uuid.UUID `json:"team_id"`
+	LeagueID uuid.UUID `json:"league_id"`
+}
+
+type ListTeamFirstRoundPicksByDraftRow struct {
+	ID              uuid.UUID    `json:"id"`
+	ScheduledAt     sql.NullTime `json:"scheduled_at"`
+	FirstRoundPicks int64        `json:"first_round_picks"`
+}
+
+// The league's drafts a team is in the draft order of, leaving out sandboxes and cancelled drafts,
+// with when each is scheduled and how many first round picks the team holds in it.
+func (q *Queries) ListTeamFirstRoundPicksByDraft(ctx context.Context, arg ListTeamFirstRoundPicksByDraftParams) ([]ListTeamFirstRoundPicksByDraftRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamFirstRoundPicksByDraft, arg.TeamID, arg.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTeamFirstRoundPicksByDraftRow
+	for rows.Next() {
+		var i ListTeamFirstRoundPicksByDraftRow
+		if err := rows.Scan(&i.ID, &i.ScheduledAt, &i.FirstRoundPicks); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamRosterPositions = `-- name: ListTeamRosterPositions :many
+SELECT COALESCE(npp.position, '')::text AS position, COUNT(*) AS players
+FROM (
+    SELECT rp.player_id
+    FROM roster_players rp
+    WHERE rp.fantasy_team_id = $1
+      AND rp.position <> 'TAXI'
+    UNION
+    SELECT dp.player_id
+    FROM draft_picks dp
+    WHERE dp.draft_id = $2
+      AND dp.team_id = $1
+      AND dp.player_id IS NOT NULL
+) held
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = held.player_id
+GROUP BY 1
+`
+
+type ListTeamRosterPositionsParams struct {
+	TeamID  uuid.UUID `json:"team_id"`
+	DraftID uuid.UUID `json:"draft_id"`
+}
+
+type ListTeamRosterPositionsRow struct {
+	Position string `json:"position"`
+	Players  int64  `json:"players"`
+}
+
+// How many players a team holds at each position, counting its roster outside the taxi squad and
+// its picks in the draft, which may not have reached the roster yet. Players without a profile
+// are counted under an empty position.
+func (q *Queries) ListTeamRosterPositions(ctx context.Context, arg ListTeamRosterPositionsParams) ([]ListTeamRosterPositionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamRosterPositions, arg.TeamID, arg.DraftID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTeamRosterPositionsRow
+	for rows.Next() {
+		var i ListTeamRosterPositionsRow
+		if err := rows.Scan(&i.Position, &i.Players); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -343,18 +1465,71 @@ func (q *Queries) ListAvailablePlayersForDraft(ctx context.Context, draftID uuid
 
 const makePick = `-- name: MakePick :execrows
 UPDATE draft_picks
-SET player_id = $2, picked_at = NOW()
+SET player_id = $2, picked_at = $3
 WHERE id = $1
   AND player_id IS NULL
+  AND NOT forfeited
 `
 
 type MakePickParams struct {
 	ID       uuid.UUID     `json:"id"`
 	PlayerID uuid.NullUUID `json:"player_id"`
+	PickedAt sql.NullTime  `json:"picked_at"`
 }
 
 func (q *Queries) MakePick(ctx context.Context, arg MakePickParams) (int64, error) {
-	result, err := q.db.ExecContext(ctx, makePick, arg.ID, arg.PlayerID)
+	result, err := q.db.ExecContext(ctx, makePick, arg.ID, arg.PlayerID, arg.PickedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const reassignDraftPickTeam = `-- name: ReassignDraftPickTeam :one
+UPDATE draft_picks
+SET team_id = $2
+WHERE id = $1
+  AND player_id IS NULL
+RETURNING id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at
+`
+
+type ReassignDraftPickTeamParams struct {
+	ID     uuid.UUID `json:"id"`
+	TeamID uuid.UUID `json:"team_id"`
+}
+
+func (q *Queries) ReassignDraftPickTeam(ctx context.Context, arg ReassignDraftPickTeamParams) (DraftPick, error) {
+	row := q.db.QueryRowContext(ctx, reassignDraftPickTeam, arg.ID, arg.TeamID)
+	var i DraftPick
+	err := row.Scan(
+		&i.ID,
+		&i.DraftID,
+		&i.Round,
+		&i.Pick,
+		&i.OverallPick,
+		&i.TeamID,
+		&i.PlayerID,
+		&i.PickedAt,
+		&i.AuctionAmount,
+		&i.KeeperPick,
+		&i.Forfeited,
+		&i.SkippedAt,
+	)
+	return i, err
+}
+
+const skipPick = `-- name: SkipPick :execrows
+UPDATE draft_picks
+SET skipped_at = NOW()
+WHERE id = $1
+  AND player_id IS NULL
+  AND NOT forfeited
+  AND skipped_at IS NULL
+`
+
+// Move the draft past an unmade pick whose clock ran out; the team can still make it late.
+func (q *Queries) SkipPick(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, skipPick, id)
 	if err != nil {
 		return 0, err
 	}
@@ -368,7 +1543,7 @@ UPDATE draft_picks SET
     auction_amount = $3,
     keeper_pick = $4
 WHERE id = $1
-RETURNING id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick
+RETURNING id, draft_id, round, pick, overall_pick, team_id, player_id, picked_at, auction_amount, keeper_pick, forfeited, skipped_at
 `
 
 type UpdateDraftPickPlayerParams struct {
@@ -397,6 +1572,8 @@ func (q *Queries) UpdateDraftPickPlayer(ctx context.Context, arg UpdateDraftPick
 		&i.PickedAt,
 		&i.AuctionAmount,
 		&i.KeeperPick,
+		&i.Forfeited,
+		&i.SkippedAt,
 	)
 	return i, err
 }
@@ -6,23 +6,130 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	// Leave a reaction on a pick; reacting the same way twice keeps the first.
+	AddPickReaction(ctx context.Context, arg AddPickReactionParams) error
+	// Whether a user may make a pick: the owner of the team holding it, a co-manager of that team
+	// in the pick's draft, the team's delegate while its owner is away, or the commissioner of the
+	// draft's league, proxy drafting for the owner.
+	CanUserMakePick(ctx context.Context, arg CanUserMakePickParams) (bool, error)
+	// Whether a user may act for a team in a draft: its owner, one of its co-managers, or its
+	// delegate while its owner is away.
+	CanUserManageDraftTeam(ctx context.Context, arg CanUserManageDraftTeamParams) (bool, error)
 	ClaimNextPickSlot(ctx context.Context, draftID uuid.UUID) (ClaimNextPickSlotRow, error)
+	// Close an open offer. Returns no row when it's already closed.
+	ClosePickTradeOffer(ctx context.Context, arg ClosePickTradeOfferParams) (PickTradeOffer, error)
+	CountDraftPicksByDraft(ctx context.Context, arg CountDraftPicksByDraftParams) (int64, error)
+	CountPickReactions(ctx context.Context, pickID uuid.UUID) ([]CountPickReactionsRow, error)
 	CountRemainingPicks(ctx context.Context, draftID uuid.UUID) (int64, error)
+	// Unpicked slots of several drafts at once; drafts without draft picks are left out.
+	CountRemainingPicksForDrafts(ctx context.Context, draftIds []uuid.UUID) ([]CountRemainingPicksForDraftsRow, error)
 	CreateDraftPick(ctx context.Context, arg CreateDraftPickParams) (DraftPick, error)
 	CreateDraftPickBatch(ctx context.Context, arg CreateDraftPickBatchParams) error
 	DeleteDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) error
+	// Expire a draft's open offer once its negotiation window has passed, or once its pick was made
+	// while it was open, in case it was never closed.
+	ExpireOpenPickTradeOffers(ctx context.Context, arg ExpireOpenPickTradeOffersParams) (int64, error)
+	// Move the draft past an unmade pick for good, for a team with no room left on its roster.
+	ForfeitPick(ctx context.Context, id uuid.UUID) (int64, error)
+	// The pick deadline of a draft and now, or the database clock when now is null, that it runs against.
+	GetDraftClock(ctx context.Context, arg GetDraftClockParams) (GetDraftClockRow, error)
+	// The sequence of the last outbox event written for a draft, 0 before the first one.
+	GetDraftEventSequence(ctx context.Context, draftID uuid.UUID) (int64, error)
 	GetDraftPick(ctx context.Context, id uuid.UUID) (DraftPick, error)
+	GetDraftPickForUpdate(ctx context.Context, id uuid.UUID) (DraftPick, error)
+	// Resolve the league that owns a pick via its draft (used for tenancy checks).
+	GetDraftPickLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
 	GetDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) ([]DraftPick, error)
 	GetDraftPicksByRound(ctx context.Context, arg GetDraftPicksByRoundParams) ([]DraftPick, error)
+	// The season, sport and settings of the league running a draft, which pick the rankings its board is
+	// sorted by and the positions it drafts. A started draft reads the settings it snapshotted.
+	GetDraftRankingSettings(ctx context.Context, id uuid.UUID) (GetDraftRankingSettingsRow, error)
+	GetDraftSettings(ctx context.Context, id uuid.UUID) (json.RawMessage, error)
+	// Read the status of the draft a pick belongs to, checked under the draft lock before a pick is made.
+	GetDraftStatus(ctx context.Context, id uuid.UUID) (string, error)
+	// Read the type of a draft, checked under the draft lock to route expansion and dispersal picks through their pools.
+	GetDraftType(ctx context.Context, id uuid.UUID) (string, error)
+	// The existing team an expansion draft takes a player from: the team in the draft's league
+	// rostering the player outside the draft order, whether it protected the player, how many
+	// players it has lost to the draft so far and the most it may lose, zero for no limit.
+	GetExpansionPlayerSource(ctx context.Context, arg GetExpansionPlayerSourceParams) (GetExpansionPlayerSourceRow, error)
+	// The pick on the clock. Skipped picks come back on the clock in board order once every
+	// other pick is made.
 	GetNextPickForDraft(ctx context.Context, draftID uuid.UUID) (DraftPick, error)
-	// List all players not yet picked in draft $1, ordered by name.
+	// Display data for announcing a pick: the fantasy team's name and the player's name, position and NFL team code.
+	GetPickAnnouncement(ctx context.Context, id uuid.UUID) (GetPickAnnouncementRow, error)
+	// The league and schedule of the draft a traded pick is in, which decide the season the pick is
+	// for and the rules it is traded under. Moving a pick of a sandbox draft isn't a trade.
+	GetPickTradeSettings(ctx context.Context, id uuid.UUID) (GetPickTradeSettingsRow, error)
+	GetPickTradeOffer(ctx context.Context, arg GetPickTradeOfferParams) (PickTradeOffer, error)
+	// The position a player is listed at, empty when the profile has none.
+	GetPlayerPosition(ctx context.Context, playerID uuid.UUID) (string, error)
+	// Whether a pick of a draft has been made, since only made picks are reacted to. Returns no row
+	// when the pick isn't in the draft.
+	GetReactionPick(ctx context.Context, arg GetReactionPickParams) (bool, error)
+	// What a team pays its roster during a draft: its players' salaries, counting players without
+	// one at the league's minimum salary, plus the minimum salary for each of its picks in the draft
+	// that hasn't reached the roster yet.
+	GetTeamDraftPayroll(ctx context.Context, arg GetTeamDraftPayrollParams) (int64, error)
+	// Attributes an action on a team to the user who took it when they took it as the team's active
+	// delegate. Inserts nothing for anyone else.
+	InsertDelegateAction(ctx context.Context, arg InsertDelegateActionParams) error
+	InsertDraftPickSlotChange(ctx context.Context, arg InsertDraftPickSlotChangeParams) error
+	InsertExpansionSelection(ctx context.Context, arg InsertExpansionSelectionParams) error
+	// Open a pick trade offer. Returns no row when the draft already has one open.
+	InsertPickTradeOffer(ctx context.Context, arg InsertPickTradeOfferParams) (PickTradeOffer, error)
+	// Whether a user is the commissioner of the league a draft belongs to.
+	IsDraftCommissioner(ctx context.Context, arg IsDraftCommissionerParams) (bool, error)
+	// Whether the pick clock of a draft has run out at now, or on the database clock when now is null.
+	IsDraftDeadlinePassed(ctx context.Context, arg IsDraftDeadlinePassedParams) (bool, error)
+	IsDraftTeamAbandoned(ctx context.Context, arg IsDraftTeamAbandonedParams) (bool, error)
+	// Whether a dispersal draft can take a player: still rostered by the team that folded and not yet picked.
+	IsInDispersalPool(ctx context.Context, arg IsInDispersalPoolParams) (bool, error)
+	// Whether the team holding a pick has been abandoned by the commissioner; its owner can't make the pick.
+	IsPickTeamAbandoned(ctx context.Context, id uuid.UUID) (bool, error)
+	// List all players not yet picked in draft $1, ordered by name, with their position, their
+	// team's latest bye week and their highest depth chart slot.
 	ListAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUID) ([]ListAvailablePlayersForDraftRow, error)
+	// The players a dispersal draft can still take: rostered by the team that folded and not yet picked.
+	ListDispersalPoolPlayerIDs(ctx context.Context, draftID uuid.UUID) ([]uuid.UUID, error)
+	// The reaction counts of every pick of a draft that has any, in draft order.
+	ListDraftPickReactionCounts(ctx context.Context, draftID uuid.UUID) ([]ListDraftPickReactionCountsRow, error)
+	ListDraftPicksByDraft(ctx context.Context, arg ListDraftPicksByDraftParams) ([]DraftPick, error)
+	// Every pick of a draft in board order with its team's name and, once made, the player's name and position.
+	ListDraftResults(ctx context.Context, draftID uuid.UUID) ([]ListDraftResultsRow, error)
+	// The players an expansion draft can still take: rostered by a team in the draft's league outside
+	// the draft order, left off that team's protection list, not yet picked, and held by a team that
+	// hasn't lost max_players_lost_per_team players to the draft.
+	ListExpansionPoolPlayerIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
+	// Same as ListAvailablePlayersForDraft plus each player's rank and projection for a
+	// season and scoring format. Ranked players come first by rank, the rest by name.
+	ListRankedAvailablePlayersForDraft(ctx context.Context, arg ListRankedAvailablePlayersForDraftParams) ([]ListRankedAvailablePlayersForDraftRow, error)
+	// The position and bye week of each player a team holds, counted as in ListTeamRosterPositions, from
+	// the latest season with bye weeks recorded for the player's team. Players without a position or a
+	// recorded bye week are left out.
+	ListTeamByeWeeks(ctx context.Context, arg ListTeamByeWeeksParams) ([]ListTeamByeWeeksRow, error)
+	// The league's drafts a team is in the draft order of, leaving out sandboxes and cancelled drafts,
+	// with when each is scheduled and how many first round picks the team holds in it.
+	ListTeamFirstRoundPicksByDraft(ctx context.Context, arg ListTeamFirstRoundPicksByDraftParams) ([]ListTeamFirstRoundPicksByDraftRow, error)
+	// How many players a team holds at each position, counting its roster outside the taxi squad and
+	// its picks in the draft, which may not have reached the roster yet. Players without a profile
+	// are counted under an empty position.
+	ListTeamRosterPositions(ctx context.Context, arg ListTeamRosterPositionsParams) ([]ListTeamRosterPositionsRow, error)
 	MakePick(ctx context.Context, arg MakePickParams) (int64, error)
+	// Move the pick deadline of a draft, keeping the clock's start the same distance before it, and
+	// drop any claim on the old deadline so the move isn't mistaken for a timeout being handled.
+	MoveDraftDeadline(ctx context.Context, arg MoveDraftDeadlineParams) (sql.NullTime, error)
+	ReassignDraftPickTeam(ctx context.Context, arg ReassignDraftPickTeamParams) (DraftPick, error)
+	RemovePickReaction(ctx context.Context, arg RemovePickReactionParams) error
+	// Move the draft past an unmade pick whose clock ran out; the team can still make it late.
+	SkipPick(ctx context.Context, id uuid.UUID) (int64, error)
 	UpdateDraftPickPlayer(ctx context.Context, arg UpdateDraftPickPlayerParams) (DraftPick, error)
 }
 
@@ -48,15 +48,42 @@ SELECT * FROM draft_picks
 WHERE draft_id = $1 
 ORDER BY overall_pick;
 
+-- name: ListDraftPicksByDraft :many
+SELECT * FROM draft_picks
+WHERE draft_id = @draft_id
+  AND (sqlc.narg('min_round')::integer IS NULL OR round >= sqlc.narg('min_round')::integer)
+  AND (sqlc.narg('max_round')::integer IS NULL OR round <= sqlc.narg('max_round')::integer)
+  AND (NOT @only_completed::boolean OR player_id IS NOT NULL)
+  AND (NOT @only_remaining::boolean OR (player_id IS NULL AND NOT forfeited))
+  AND (sqlc.narg('after_overall_pick')::integer IS NULL OR overall_pick > sqlc.narg('after_overall_pick')::integer)
+ORDER BY overall_pick
+LIMIT sqlc.narg('page_limit') OFFSET @page_offset;
+
+-- name: CountDraftPicksByDraft :one
+SELECT COUNT(*) FROM draft_picks
+WHERE draft_id = @draft_id
+  AND (sqlc.narg('min_round')::integer IS NULL OR round >= sqlc.narg('min_round')::integer)
+  AND (sqlc.narg('max_round')::integer IS NULL OR round <= sqlc.narg('max_round')::integer)
+  AND (NOT @only_completed::boolean OR player_id IS NOT NULL)
+  AND (NOT @only_remaining::boolean OR (player_id IS NULL AND NOT forfeited));
+
+-- name: GetDraftEventSequence :one
+-- The sequence of the last outbox event written for a draft, 0 before the first one.
+SELECT COALESCE((SELECT last_seq
+                 FROM draft_event_sequences
+                 WHERE draft_id = $1), 0)::bigint AS last_seq;
+
 -- name: GetDraftPicksByRound :many
 SELECT * FROM draft_picks 
 WHERE draft_id = $1 AND round = $2 
 ORDER BY pick;
 
 -- name: GetNextPickForDraft :one
+-- The pick on the clock. Skipped picks come back on the clock in board order once every
+-- other pick is made.
 SELECT * FROM draft_picks 
-WHERE draft_id = $1 AND player_id IS NULL 
-ORDER BY overall_pick 
+WHERE draft_id = $1 AND player_id IS NULL AND NOT forfeited
+ORDER BY skipped_at IS NOT NULL, overall_pick 
 LIMIT 1;
 
 -- name: UpdateDraftPickPlayer :one
@@ -68,39 +95,452 @@ UPDATE draft_picks SET
 WHERE id = $1
 RETURNING *;
 
+-- name: GetDraftPickForUpdate :one
+SELECT * FROM draft_picks WHERE id = $1 FOR UPDATE;
+
+-- name: ReassignDraftPickTeam :one
+UPDATE draft_picks
+SET team_id = $2
+WHERE id = $1
+  AND player_id IS NULL
+RETURNING *;
+
+-- name: InsertDraftPickSlotChange :exec
+INSERT INTO draft_pick_slot_changes (id, pick_id, draft_id, from_team_id, to_team_id, reason)
+VALUES ($1, $2, $3, $4, $5, $6);
+
 -- name: DeleteDraftPicksByDraft :exec
 DELETE FROM draft_picks WHERE draft_id = $1;
 
 -- name: MakePick :execrows
 UPDATE draft_picks
-SET player_id = $2, picked_at = NOW()
+SET player_id = $2, picked_at = $3
 WHERE id = $1
-  AND player_id IS NULL;
+  AND player_id IS NULL
+  AND NOT forfeited;
+
+-- name: SkipPick :execrows
+-- Move the draft past an unmade pick whose clock ran out; the team can still make it late.
+UPDATE draft_picks
+SET skipped_at = NOW()
+WHERE id = $1
+  AND player_id IS NULL
+  AND NOT forfeited
+  AND skipped_at IS NULL;
+
+-- name: ForfeitPick :execrows
+-- Move the draft past an unmade pick for good, for a team with no room left on its roster.
+UPDATE draft_picks
+SET forfeited = TRUE, skipped_at = COALESCE(skipped_at, NOW())
+WHERE id = $1
+  AND player_id IS NULL
+  AND NOT forfeited;
+
+-- name: IsDraftDeadlinePassed :one
+-- Whether the pick clock of a draft has run out at now, or on the database clock when now is null.
+SELECT COALESCE(next_deadline <= COALESCE(sqlc.narg('now')::timestamptz, NOW()), FALSE)::boolean AS passed FROM draft WHERE id = sqlc.arg('id');
+
+-- name: IsDraftCommissioner :one
+-- Whether a user is the commissioner of the league a draft belongs to.
+SELECT EXISTS (SELECT 1
+               FROM draft d
+                        JOIN leagues l ON l.id = d.league_id
+               WHERE d.id = sqlc.arg('draft_id') AND l.commissioner_id = sqlc.arg('user_id')) AS commissioner;
 
 -- name: CountRemainingPicks :one
 SELECT COUNT(*) FROM draft_picks
-WHERE draft_id = $1 AND player_id IS NULL;
+WHERE draft_id = $1 AND player_id IS NULL AND NOT forfeited;
+
+-- name: CountRemainingPicksForDrafts :many
+-- Unpicked slots of several drafts at once; drafts without draft picks are left out.
+SELECT draft_id, COUNT(*) FILTER (WHERE player_id IS NULL AND NOT forfeited) AS remaining_picks
+FROM draft_picks
+WHERE draft_id = ANY(@draft_ids::uuid[])
+GROUP BY draft_id;
 
 -- name: ClaimNextPickSlot :one
 SELECT dp.id, dp.team_id, dp.overall_pick
 FROM draft_picks dp
 WHERE dp.draft_id = $1
   AND dp.player_id IS NULL
-ORDER BY dp.overall_pick
+  AND NOT dp.forfeited
+ORDER BY dp.skipped_at IS NOT NULL, dp.overall_pick
 FOR UPDATE SKIP LOCKED
 LIMIT 1;
 
 -- name: ListAvailablePlayersForDraft :many
--- List all players not yet picked in draft $1, ordered by name.
+-- List all players not yet picked in draft $1, ordered by name, with their position, their
+-- team's latest bye week and their highest depth chart slot.
 SELECT
     p.id,
     p.full_name,
-    p.team_id
+    p.team_id,
+    bw.bye_week,
+    dc.position AS depth_chart_position,
+    dc.depth AS depth_chart_depth,
+    npp.position,
+    npp.status,
+    po.owned_pct,
+    po.started_pct
 FROM players p
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+LEFT JOIN player_ownership po ON po.player_id = p.id
+LEFT JOIN LATERAL (
+    SELECT tbw.bye_week
+    FROM team_bye_weeks tbw
+    WHERE tbw.team_id = p.team_id
+    ORDER BY tbw.season DESC
+    LIMIT 1
+) bw ON TRUE
+LEFT JOIN LATERAL (
+    SELECT tdc.position, tdc.depth
+    FROM team_depth_charts tdc
+    WHERE tdc.player_id = p.id
+    ORDER BY tdc.depth
+    LIMIT 1
+) dc ON TRUE
 WHERE NOT EXISTS (
     SELECT 1
     FROM draft_picks dp
     WHERE dp.draft_id  = $1
       AND dp.player_id = p.id
 )
-ORDER BY p.full_name;
\ No newline at end of file
+ORDER BY p.full_name;
+
+-- name: GetDraftPickLeagueID :one
+-- Resolve the league that owns a pick via its draft (used for tenancy checks).
+SELECT d.league_id
+FROM draft_picks dp
+JOIN draft d ON d.id = dp.draft_id
+WHERE dp.id = $1;
+
+-- name: GetDraftSettings :one
+SELECT settings FROM draft WHERE id = $1;
+
+-- name: ListTeamRosterPositions :many
+-- How many players a team holds at each position, counting its roster outside the taxi squad and
+-- its picks in the draft, which may not have reached the roster yet. Players without a profile
+-- are counted under an empty position.
+SELECT COALESCE(npp.position, '')::text AS position, COUNT(*) AS players
+FROM (
+    SELECT rp.player_id
+    FROM roster_players rp
+    WHERE rp.fantasy_team_id = sqlc.arg('team_id')
+      AND rp.position <> 'TAXI'
+    UNION
+    SELECT dp.player_id
+    FROM draft_picks dp
+    WHERE dp.draft_id = sqlc.arg('draft_id')
+      AND dp.team_id = sqlc.arg('team_id')
+      AND dp.player_id IS NOT NULL
+) held
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = held.player_id
+GROUP BY 1;
+
+-- name: ListTeamByeWeeks :many
+-- The position and bye week of each player a team holds, counted as in ListTeamRosterPositions, from
+-- the latest season with bye weeks recorded for the player's team. Players without a position or a
+-- recorded bye week are left out.
+SELECT npp.position::text AS position, bw.bye_week
+FROM (
+    SELECT rp.player_id
+    FROM roster_players rp
+    WHERE rp.fantasy_team_id = sqlc.arg('team_id')
+      AND rp.position <> 'TAXI'
+    UNION
+    SELECT dp.player_id
+    FROM draft_picks dp
+    WHERE dp.draft_id = sqlc.arg('draft_id')
+      AND dp.team_id = sqlc.arg('team_id')
+      AND dp.player_id IS NOT NULL
+) held
+JOIN players p ON p.id = held.player_id
+JOIN nfl_player_profiles npp ON npp.player_id = held.player_id
+JOIN LATERAL (
+    SELECT tbw.bye_week
+    FROM team_bye_weeks tbw
+    WHERE tbw.team_id = p.team_id
+    ORDER BY tbw.season DESC
+    LIMIT 1
+) bw ON TRUE
+WHERE npp.position IS NOT NULL;
+
+-- name: GetDraftStatus :one
+-- Read the status of the draft a pick belongs to, checked under the draft lock before a pick is made.
+SELECT status::text AS status FROM draft WHERE id = $1;
+
+-- name: CanUserMakePick :one
+-- Whether a user may make a pick: the owner of the team holding it, a co-manager of that team
+-- in the pick's draft, the team's delegate while its owner is away, or the commissioner of the
+-- draft's league, proxy drafting for the owner.
+SELECT EXISTS (
+    SELECT 1
+    FROM draft_picks dp
+             JOIN fantasy_teams ft ON ft.id = dp.team_id
+    WHERE dp.id = sqlc.arg('pick_id') AND ft.owner_id = sqlc.arg('user_id')
+    UNION ALL
+    SELECT 1
+    FROM draft_picks dp
+             JOIN draft_co_managers cm ON cm.draft_id = dp.draft_id AND cm.fantasy_team_id = dp.team_id
+    WHERE dp.id = sqlc.arg('pick_id') AND cm.user_id = sqlc.arg('user_id')
+    UNION ALL
+    SELECT 1
+    FROM draft_picks dp
+             JOIN team_delegations td ON td.fantasy_team_id = dp.team_id
+    WHERE dp.id = sqlc.arg('pick_id') AND td.delegate_id = sqlc.arg('user_id')
+      AND td.revoked_at IS NULL AND td.starts_at <= NOW() AND td.ends_at > NOW()
+    UNION ALL
+    SELECT 1
+    FROM draft_picks dp
+             JOIN draft d ON d.id = dp.draft_id
+             JOIN leagues l ON l.id = d.league_id
+    WHERE dp.id = sqlc.arg('pick_id') AND l.commissioner_id = sqlc.arg('user_id')
+) AS can_pick;
+
+-- name: InsertDelegateAction :exec
+-- Attributes an action on a team to the user who took it when they took it as the team's active
+-- delegate. Inserts nothing for anyone else.
+INSERT INTO team_delegate_actions (id, delegation_id, fantasy_team_id, delegate_id, action, details)
+SELECT sqlc.arg('id')::uuid, td.id, td.fantasy_team_id, td.delegate_id, sqlc.arg('action')::text, sqlc.arg('details')::jsonb
+FROM team_delegations td
+WHERE td.fantasy_team_id = sqlc.arg('fantasy_team_id')
+  AND td.delegate_id = sqlc.arg('user_id')
+  AND td.revoked_at IS NULL
+  AND td.starts_at <= NOW()
+  AND td.ends_at > NOW()
+LIMIT 1;
+
+-- name: IsPickTeamAbandoned :one
+-- Whether the team holding a pick has been abandoned by the commissioner; its owner can't make the pick.
+SELECT EXISTS (SELECT 1
+               FROM draft_picks dp
+                        JOIN draft_abandoned_teams dat ON dat.draft_id = dp.draft_id AND dat.fantasy_team_id = dp.team_id
+               WHERE dp.id = $1) AS abandoned;
+
+-- name: ListDraftResults :many
+-- Every pick of a draft in board order with its team's name and, once made, the player's name and position.
+SELECT
+    dp.round,
+    dp.pick,
+    dp.overall_pick,
+    dp.team_id,
+    ft.name      AS team_name,
+    dp.player_id,
+    p.full_name  AS player_name,
+    npp.position AS player_position,
+    dp.keeper_pick,
+    dp.auction_amount,
+    dp.picked_at
+FROM draft_picks dp
+LEFT JOIN fantasy_teams ft ON ft.id = dp.team_id
+LEFT JOIN players p ON p.id = dp.player_id
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = dp.player_id
+WHERE dp.draft_id = $1
+ORDER BY dp.overall_pick;
+
+-- name: GetPickAnnouncement :one
+-- Display data for announcing a pick: the fantasy team's name and the player's name, position and NFL team code.
+SELECT
+    ft.name      AS team_name,
+    p.full_name  AS player_name,
+    npp.position AS player_position,
+    t.code       AS nfl_team_code
+FROM draft_picks dp
+LEFT JOIN fantasy_teams ft ON ft.id = dp.team_id
+LEFT JOIN players p ON p.id = dp.player_id
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = dp.player_id
+LEFT JOIN teams t ON t.id = p.team_id
+WHERE dp.id = $1;
+
+-- name: GetPickTradeSettings :one
+-- The league and schedule of the draft a traded pick is in, which decide the season the pick is
+-- for and the rules it is traded under. Moving a pick of a sandbox draft isn't a trade.
+SELECT d.league_id, d.scheduled_at, d.sandbox_of_draft_id IS NOT NULL AS sandbox, l.season, l.league_settings
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE d.id = $1;
+
+-- name: GetPlayerPosition :one
+-- The position a player is listed at, empty when the profile has none.
+SELECT COALESCE(position, '')::text AS position FROM nfl_player_profiles WHERE player_id = $1;
+
+-- name: GetTeamDraftPayroll :one
+-- What a team pays its roster during a draft: its players' salaries, counting players without
+-- one at the league's minimum salary, plus the minimum salary for each of its picks in the draft
+-- that hasn't reached the roster yet.
+SELECT (
+    (SELECT COALESCE(SUM(COALESCE(rp.salary, sqlc.arg('min_salary')::int)), 0)
+     FROM roster_players rp
+     WHERE rp.fantasy_team_id = sqlc.arg('team_id'))
+    + sqlc.arg('min_salary')::int * (SELECT COUNT(*)
+     FROM draft_picks dp
+     WHERE dp.draft_id = sqlc.arg('draft_id')
+       AND dp.team_id = sqlc.arg('team_id')
+       AND dp.player_id IS NOT NULL
+       AND NOT EXISTS (
+           SELECT 1
+           FROM roster_players rp
+           WHERE rp.fantasy_team_id = dp.team_id
+             AND rp.player_id = dp.player_id
+       ))
+)::bigint AS payroll;
+
+-- name: GetDraftRankingSettings :one
+-- The season, sport and settings of the league running a draft, which pick the rankings its board is
+-- sorted by and the positions it drafts. A started draft reads the settings it snapshotted.
+SELECT l.season, l.sport_id, COALESCE(d.league_settings_snapshot, l.league_settings) AS league_settings
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE d.id = $1;
+
+-- name: ListTeamFirstRoundPicksByDraft :many
+-- The league's drafts a team is in the draft order of, leaving out sandboxes and cancelled drafts,
+-- with when each is scheduled and how many first round picks the team holds in it.
+SELECT d.id, d.scheduled_at, COUNT(dp.id) AS first_round_picks
+FROM draft d
+LEFT JOIN draft_picks dp ON dp.draft_id = d.id AND dp.round = 1 AND dp.team_id = sqlc.arg('team_id')
+WHERE d.league_id = sqlc.arg('league_id')
+  AND d.sandbox_of_draft_id IS NULL
+  AND d.status <> 'CANCELLED'
+  AND d.settings -> 'draft_order' ? sqlc.arg('team_id')::text
+GROUP BY d.id, d.scheduled_at;
+
+-- name: ListRankedAvailablePlayersForDraft :many
+-- Same as ListAvailablePlayersForDraft plus each player's rank and projection for a
+-- season and scoring format, and average auction price that season. Ranked players come
+-- first by rank, the rest by name.
+SELECT
+    p.id,
+    p.full_name,
+    p.team_id,
+    bw.bye_week,
+    dc.position AS depth_chart_position,
+    dc.depth AS depth_chart_depth,
+    npp.position,
+    npp.status,
+    pr.overall_rank,
+    pr.projected_points,
+    po.owned_pct,
+    po.started_pct,
+    pav.average_price AS average_auction_price,
+    pav.sales AS auction_sales
+FROM players p
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+LEFT JOIN player_ownership po ON po.player_id = p.id
+LEFT JOIN LATERAL (
+    SELECT tbw.bye_week
+    FROM team_bye_weeks tbw
+    WHERE tbw.team_id = p.team_id
+    ORDER BY tbw.season DESC
+    LIMIT 1
+) bw ON TRUE
+LEFT JOIN LATERAL (
+    SELECT tdc.position, tdc.depth
+    FROM team_depth_charts tdc
+    WHERE tdc.player_id = p.id
+    ORDER BY tdc.depth
+    LIMIT 1
+) dc ON TRUE
+LEFT JOIN player_rankings pr
+    ON pr.player_id = p.id
+   AND pr.season = $2
+   AND pr.scoring_format = $3
+   AND pr.superflex = $4
+LEFT JOIN player_auction_values pav
+    ON pav.player_id = p.id
+   AND pav.season = $2
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM draft_picks dp
+    WHERE dp.draft_id  = $1
+      AND dp.player_id = p.id
+)
+ORDER BY pr.overall_rank NULLS LAST, p.full_name;
+
+-- name: GetDraftType :one
+-- Read the type of a draft, checked under the draft lock to route expansion and dispersal picks through their pools.
+SELECT draft_type::text AS draft_type FROM draft WHERE id = $1;
+
+-- name: GetExpansionPlayerSource :one
+-- The existing team an expansion draft takes a player from: the team in the draft's league
+-- rostering the player outside the draft order, whether it protected the player, how many
+-- players it has lost to the draft so far and the most it may lose, zero for no limit.
+SELECT
+    rp.fantasy_team_id,
+    EXISTS (
+        SELECT 1
+        FROM expansion_protected_players epp
+        WHERE epp.draft_id = d.id
+          AND epp.fantasy_team_id = rp.fantasy_team_id
+          AND epp.player_id = rp.player_id
+    )::boolean AS protected,
+    (SELECT COUNT(*)
+     FROM expansion_selections es
+     WHERE es.draft_id = d.id
+       AND es.from_team_id = rp.fantasy_team_id) AS players_lost,
+    COALESCE((d.settings ->> 'max_players_lost_per_team')::int, 0)::int AS max_players_lost
+FROM draft d
+JOIN fantasy_teams ft ON ft.league_id = d.league_id
+JOIN roster_players rp ON rp.fantasy_team_id = ft.id
+WHERE d.id = sqlc.arg('draft_id')
+  AND rp.player_id = sqlc.arg('player_id')
+  AND NOT COALESCE(d.settings -> 'draft_order', '[]'::jsonb) @> to_jsonb(ft.id::text)
+LIMIT 1;
+
+-- name: InsertExpansionSelection :exec
+INSERT INTO expansion_selections (pick_id, draft_id, from_team_id, player_id)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListExpansionPoolPlayerIDs :many
+-- The players an expansion draft can still take: rostered by a team in the draft's league outside
+-- the draft order, left off that team's protection list, not yet picked, and held by a team that
+-- hasn't lost max_players_lost_per_team players to the draft.
+SELECT rp.player_id
+FROM draft d
+JOIN fantasy_teams ft ON ft.league_id = d.league_id
+JOIN roster_players rp ON rp.fantasy_team_id = ft.id
+WHERE d.id = $1
+  AND NOT COALESCE(d.settings -> 'draft_order', '[]'::jsonb) @> to_jsonb(ft.id::text)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM expansion_protected_players epp
+    WHERE epp.draft_id = d.id
+      AND epp.fantasy_team_id = ft.id
+      AND epp.player_id = rp.player_id
+)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM draft_picks dp
+    WHERE dp.draft_id = d.id
+      AND dp.player_id = rp.player_id
+)
+  AND (COALESCE((d.settings ->> 'max_players_lost_per_team')::int, 0) = 0
+    OR (SELECT COUNT(*)
+        FROM expansion_selections es
+        WHERE es.draft_id = d.id
+          AND es.from_team_id = ft.id) < (d.settings ->> 'max_players_lost_per_team')::int);
+
+-- name: IsInDispersalPool :one
+-- Whether a dispersal draft can take a player: still rostered by the team that folded and not yet picked.
+SELECT EXISTS (SELECT 1
+               FROM dispersal_drafts dd
+               JOIN roster_players rp ON rp.fantasy_team_id = dd.folded_team_id
+               WHERE dd.draft_id = sqlc.arg('draft_id')
+                 AND rp.player_id = sqlc.arg('player_id')
+                 AND NOT EXISTS (SELECT 1
+                                 FROM draft_picks dp
+                                 WHERE dp.draft_id = dd.draft_id
+                                   AND dp.player_id = rp.player_id)) AS in_pool;
+
+-- name: ListDispersalPoolPlayerIDs :many
+-- The players a dispersal draft can still take: rostered by the team that folded and not yet picked.
+SELECT rp.player_id
+FROM dispersal_drafts dd
+JOIN roster_players rp ON rp.fantasy_team_id = dd.folded_team_id
+WHERE dd.draft_id = $1
+  AND NOT EXISTS (
+    SELECT 1
+    FROM draft_picks dp
+    WHERE dp.draft_id = dd.draft_id
+      AND dp.player_id = rp.player_id
+);
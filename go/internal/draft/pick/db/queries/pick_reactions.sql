@@ -0,0 +1,35 @@
+-- name: GetReactionPick :one
+-- Whether a pick of a draft has been made, since only made picks are reacted to. Returns no row
+-- when the pick isn't in the draft.
+SELECT (player_id IS NOT NULL)::boolean AS made
+FROM draft_picks
+WHERE id = $1
+  AND draft_id = $2;
+
+-- name: AddPickReaction :exec
+-- Leave a reaction on a pick; reacting the same way twice keeps the first.
+INSERT INTO draft_pick_reactions (pick_id, user_id, reaction)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: RemovePickReaction :exec
+DELETE
+FROM draft_pick_reactions
+WHERE pick_id = $1
+  AND user_id = $2
+  AND reaction = $3;
+
+-- name: CountPickReactions :many
+SELECT reaction, COUNT(*) AS reactions
+FROM draft_pick_reactions
+WHERE pick_id = $1
+GROUP BY reaction;
+
+-- name: ListDraftPickReactionCounts :many
+-- The reaction counts of every pick of a draft that has any, in draft order.
+SELECT r.pick_id, r.reaction, COUNT(*) AS reactions
+FROM draft_pick_reactions r
+         JOIN draft_picks dp ON dp.id = r.pick_id
+WHERE dp.draft_id = $1
+GROUP BY r.pick_id, dp.overall_pick, r.reaction
+ORDER BY dp.overall_pick;
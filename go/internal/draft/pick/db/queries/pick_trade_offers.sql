@@ -0,0 +1,77 @@
+-- name: InsertPickTradeOffer :one
+-- Open a pick trade offer. Returns no row when the draft already has one open.
+INSERT INTO pick_trade_offers (draft_id, pick_id, from_team_id, to_team_id, requested_pick_id, message, proposed_by, clock_remaining_ms, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (draft_id) WHERE status = 'OPEN' DO NOTHING
+RETURNING *;
+
+-- name: GetPickTradeOffer :one
+SELECT *
+FROM pick_trade_offers
+WHERE id = $1
+  AND draft_id = $2;
+
+-- name: ClosePickTradeOffer :one
+-- Close an open offer. Returns no row when it's already closed.
+UPDATE pick_trade_offers
+SET status       = $3,
+    responded_by = $4,
+    closed_at    = NOW()
+WHERE id = $1
+  AND draft_id = $2
+  AND status = 'OPEN'
+RETURNING *;
+
+-- name: ExpireOpenPickTradeOffers :execrows
+-- Expire a draft's open offer once its negotiation window has passed, or once its pick was made
+-- while it was open, in case it was never closed.
+UPDATE pick_trade_offers o
+SET status    = 'EXPIRED',
+    closed_at = LEAST(o.expires_at, COALESCE(sqlc.narg('now')::timestamptz, NOW()))
+WHERE o.draft_id = sqlc.arg('draft_id')
+  AND o.status = 'OPEN'
+  AND (o.expires_at <= COALESCE(sqlc.narg('now')::timestamptz, NOW())
+    OR EXISTS (SELECT 1 FROM draft_picks dp WHERE dp.id = o.pick_id AND (dp.player_id IS NOT NULL OR dp.forfeited)));
+
+-- name: GetDraftClock :one
+-- The pick deadline of a draft and now, or the database clock when now is null, that it runs against.
+SELECT next_deadline, COALESCE(sqlc.narg('now')::timestamptz, NOW()) AS server_time
+FROM draft
+WHERE id = sqlc.arg('id');
+
+-- name: MoveDraftDeadline :one
+-- Move the pick deadline of a draft, keeping the clock's start the same distance before it, and
+-- drop any claim on the old deadline so the move isn't mistaken for a timeout being handled.
+UPDATE draft
+SET pick_clock_started_at = pick_clock_started_at + (sqlc.arg('next_deadline')::timestamptz - next_deadline),
+    next_deadline         = sqlc.arg('next_deadline')::timestamptz,
+    claimed_by            = NULL,
+    claimed_until         = NULL
+WHERE id = sqlc.arg('id')
+RETURNING next_deadline;
+
+-- name: CanUserManageDraftTeam :one
+-- Whether a user may act for a team in a draft: its owner, one of its co-managers, or its
+-- delegate while its owner is away.
+SELECT EXISTS (SELECT 1
+               FROM fantasy_teams ft
+               WHERE ft.id = sqlc.arg('fantasy_team_id')
+                 AND ft.owner_id = sqlc.arg('user_id'))
+    OR EXISTS (SELECT 1
+               FROM draft_co_managers cm
+               WHERE cm.draft_id = sqlc.arg('draft_id')
+                 AND cm.fantasy_team_id = sqlc.arg('fantasy_team_id')
+                 AND cm.user_id = sqlc.arg('user_id'))
+    OR EXISTS (SELECT 1
+               FROM team_delegations td
+               WHERE td.fantasy_team_id = sqlc.arg('fantasy_team_id')
+                 AND td.delegate_id = sqlc.arg('user_id')
+                 AND td.revoked_at IS NULL
+                 AND td.starts_at <= NOW()
+                 AND td.ends_at > NOW()) AS can_manage;
+
+-- name: IsDraftTeamAbandoned :one
+SELECT EXISTS (SELECT 1
+               FROM draft_abandoned_teams
+               WHERE draft_id = $1
+                 AND fantasy_team_id = $2) AS abandoned;
@@ -0,0 +1,85 @@
+package pick
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// resultsCSVHeader names the columns of a CSV results export
+var resultsCSVHeader = []string{
+	"round",
+	"pick",
+	"overall_pick",
+	"team_id",
+	"team_name",
+	"player_id",
+	"player_name",
+	"position",
+	"keeper",
+	"auction_amount",
+	"picked_at",
+}
+
+// encodeResultsCSV renders draft results as CSV with a header row. Open picks have empty player columns.
+func encodeResultsCSV(results []DraftResult) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(resultsCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		record := []string{
+			strconv.Itoa(r.Round),
+			strconv.Itoa(r.Pick),
+			strconv.Itoa(r.OverallPick),
+			r.TeamID.String(),
+			r.TeamName,
+			"",
+			r.PlayerName,
+			r.PlayerPosition,
+			strconv.FormatBool(r.KeeperPick),
+			"",
+			"",
+		}
+		if r.PlayerID != nil {
+			record[5] = r.PlayerID.String()
+		}
+		if r.AuctionAmount != nil {
+			record[9] = strconv.FormatFloat(*r.AuctionAmount, 'f', 2, 64)
+		}
+		if r.PickedAt != nil {
+			record[10] = r.PickedAt.UTC().Format(time.RFC3339)
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resultsExport is the document written by a JSON results export
+type resultsExport struct {
+	DraftID    uuid.UUID     `json:"draft_id"`
+	ExportedAt time.Time     `json:"exported_at"`
+	Picks      []DraftResult `json:"picks"`
+}
+
+// encodeResultsJSON renders draft results as an indented JSON document
+func encodeResultsJSON(draftID uuid.UUID, results []DraftResult, exportedAt time.Time) ([]byte, error) {
+	return json.MarshalIndent(resultsExport{
+		DraftID:    draftID,
+		ExportedAt: exportedAt.UTC(),
+		Picks:      results,
+	}, "", "  ")
+}
@@ -3,27 +3,55 @@ package pick
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/clock"
 	"github.com/mcdev12/dynasty/go/internal/draft/pick/db"
+	"github.com/mcdev12/dynasty/go/internal/ids"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
 )
 
 type Repository struct {
 	queries *db.Queries
 	sqlDB   *sql.DB
+	clock   clock.Clock
+
+	replica        *sqlutil.ReadReplica
+	replicaQueries *db.Queries
 }
 
-func NewRepository(queries *db.Queries, sqlDB *sql.DB) *Repository {
+// NewRepository creates a pick Repository. Picks are timestamped, and pick clocks and trade
+// offers checked, against clock.
+func NewRepository(queries *db.Queries, sqlDB *sql.DB, clock clock.Clock) *Repository {
 	return &Repository{
 		queries: queries,
 		sqlDB:   sqlDB,
+		clock:   clock,
 	}
 }
 
+// q returns the repository's queries, bound to the transaction in ctx if a service started one
+func (r *Repository) q(ctx context.Context) *db.Queries {
+	return sqlutil.Bind(ctx, r.queries, r.queries.WithTx)
+}
+
+// UseReadReplica sends the board reads of requests allowed stale reads to replica
+func (r *Repository) UseReadReplica(replica *sqlutil.ReadReplica) {
+	r.replica = replica
+	r.replicaQueries = db.New(replica.DB())
+}
+
+// read returns the queries for a read that may run on the read replica, see sqlutil.BindRead
+func (r *Repository) read(ctx context.Context) *db.Queries {
+	return sqlutil.BindRead(ctx, r.queries, r.replicaQueries, r.replica, r.queries.WithTx)
+}
+
 
 func (r *Repository) CreateDraftPick(ctx context.Context, req CreateDraftPickRequest) (*models.DraftPick, error) {
 	var playerID uuid.NullUUID
@@ -38,10 +66,10 @@ func (r *Repository) CreateDraftPick(ctx context.Context, req CreateDraftPickReq
 
 	var pickedAt sql.NullTime
 	if req.PlayerID != nil {
-		pickedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		pickedAt = sql.NullTime{Time: r.clock.Now(), Valid: true}
 	}
 
-	pick, err := r.queries.CreateDraftPick(ctx, db.CreateDraftPickParams{
+	pick, err := r.q(ctx).CreateDraftPick(ctx, db.CreateDraftPickParams{
 		ID:            req.ID,
 		DraftID:       req.DraftID,
 		Round:         int32(req.Round),
@@ -83,7 +111,7 @@ func (r *Repository) CreateDraftPicksBatch(ctx context.Context, picks []models.D
 	}
 
 	// Execute batch insert
-	err := r.queries.CreateDraftPickBatch(ctx, db.CreateDraftPickBatchParams{
+	err := r.q(ctx).CreateDraftPickBatch(ctx, db.CreateDraftPickBatchParams{
 		Column1: ids,
 		Column2: draftIDs,
 		Column3: rounds,
@@ -99,7 +127,7 @@ func (r *Repository) CreateDraftPicksBatch(ctx context.Context, picks []models.D
 }
 
 func (r *Repository) GetDraftPick(ctx context.Context, id uuid.UUID) (*models.DraftPick, error) {
-	pick, err := r.queries.GetDraftPick(ctx, id)
+	pick, err := r.q(ctx).GetDraftPick(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get draft pick: %w", err)
 	}
@@ -107,8 +135,17 @@ func (r *Repository) GetDraftPick(ctx context.Context, id uuid.UUID) (*models.Dr
 	return r.dbDraftPickToModel(pick), nil
 }
 
+func (r *Repository) GetDraftPickLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	leagueID, err := r.q(ctx).GetDraftPickLeagueID(ctx, id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get draft pick league: %w", err)
+	}
+
+	return leagueID, nil
+}
+
 func (r *Repository) GetDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) ([]models.DraftPick, error) {
-	picks, err := r.queries.GetDraftPicksByDraft(ctx, draftID)
+	picks, err := r.read(ctx).GetDraftPicksByDraft(ctx, draftID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get draft picks by draft: %w", err)
 	}
@@ -121,8 +158,54 @@ func (r *Repository) GetDraftPicksByDraft(ctx context.Context, draftID uuid.UUID
 	return result, nil
 }
 
+func (r *Repository) ListDraftPicksByDraft(ctx context.Context, draftID uuid.UUID, filter DraftPickFilter, pagination PaginationParams) ([]models.DraftPick, error) {
+	picks, err := r.q(ctx).ListDraftPicksByDraft(ctx, db.ListDraftPicksByDraftParams{
+		DraftID:          draftID,
+		MinRound:         sqlutil.ToSqlInt32(filter.MinRound),
+		MaxRound:         sqlutil.ToSqlInt32(filter.MaxRound),
+		OnlyCompleted:    filter.OnlyCompleted,
+		OnlyRemaining:    filter.OnlyRemaining,
+		AfterOverallPick: sqlutil.ToSqlInt32(pagination.afterOverallPick),
+		PageLimit:        sql.NullInt32{Int32: int32(pagination.Limit), Valid: pagination.Limit > 0},
+		PageOffset:       int32(pagination.Offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft picks by draft: %w", err)
+	}
+
+	result := make([]models.DraftPick, len(picks))
+	for i, pick := range picks {
+		result[i] = *r.dbDraftPickToModel(pick)
+	}
+
+	return result, nil
+}
+
+func (r *Repository) CountDraftPicksByDraft(ctx context.Context, draftID uuid.UUID, filter DraftPickFilter) (int, error) {
+	count, err := r.q(ctx).CountDraftPicksByDraft(ctx, db.CountDraftPicksByDraftParams{
+		DraftID:       draftID,
+		MinRound:      sqlutil.ToSqlInt32(filter.MinRound),
+		MaxRound:      sqlutil.ToSqlInt32(filter.MaxRound),
+		OnlyCompleted: filter.OnlyCompleted,
+		OnlyRemaining: filter.OnlyRemaining,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count draft picks by draft: %w", err)
+	}
+
+	return int(count), nil
+}
+
+func (r *Repository) GetDraftEventSequence(ctx context.Context, draftID uuid.UUID) (int64, error) {
+	seq, err := r.q(ctx).GetDraftEventSequence(ctx, draftID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get draft event sequence: %w", err)
+	}
+	return seq, nil
+}
+
 func (r *Repository) GetDraftPicksByRound(ctx context.Context, draftID uuid.UUID, round int) ([]models.DraftPick, error) {
-	picks, err := r.queries.GetDraftPicksByRound(ctx, db.GetDraftPicksByRoundParams{
+	picks, err := r.q(ctx).GetDraftPicksByRound(ctx, db.GetDraftPicksByRoundParams{
 		DraftID: draftID,
 		Round:   int32(round),
 	})
@@ -139,7 +222,7 @@ func (r *Repository) GetDraftPicksByRound(ctx context.Context, draftID uuid.UUID
 }
 
 func (r *Repository) GetNextPickForDraft(ctx context.Context, draftID uuid.UUID) (*models.DraftPick, error) {
-	pick, err := r.queries.GetNextPickForDraft(ctx, draftID)
+	pick, err := r.q(ctx).GetNextPickForDraft(ctx, draftID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get next pick for draft: %w", err)
 	}
@@ -153,7 +236,7 @@ func (r *Repository) UpdateDraftPickPlayer(ctx context.Context, id uuid.UUID, re
 		auctionAmount = sql.NullString{String: fmt.Sprintf("%.2f", *req.AuctionAmount), Valid: true}
 	}
 
-	pick, err := r.queries.UpdateDraftPickPlayer(ctx, db.UpdateDraftPickPlayerParams{
+	pick, err := r.q(ctx).UpdateDraftPickPlayer(ctx, db.UpdateDraftPickPlayerParams{
 		ID:            id,
 		PlayerID:      uuid.NullUUID{UUID: req.PlayerID, Valid: true},
 		AuctionAmount: auctionAmount,
@@ -166,9 +249,555 @@ func (r *Repository) UpdateDraftPickPlayer(ctx context.Context, id uuid.UUID, re
 	return r.dbDraftPickToModel(pick), nil
 }
 
+// ReassignPickSlot moves an unmade pick to another team and records the change in the slot audit
+// log. The draft must not have started; its status is checked under the draft lock, so the move
+// can't interleave with the draft starting.
+func (r *Repository) ReassignPickSlot(ctx context.Context, req ReassignPickSlotRequest) (*PickSlotReassignment, error) {
+	var reassignment *PickSlotReassignment
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID.UUID(), r.queries.WithTx, func(q *db.Queries) error {
+		status, err := q.GetDraftStatus(ctx, req.DraftID.UUID())
+		if err != nil {
+			return fmt.Errorf("failed to get draft status: %w", err)
+		}
+		if status != string(models.DraftStatusNotStarted) {
+			return ErrPickSlotsLocked
+		}
+
+		reassignment, err = r.reassignPickSlot(ctx, q, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reassignment, nil
+}
+
+// reassignPickSlot moves an unmade pick to another team within qtx
+func (r *Repository) reassignPickSlot(ctx context.Context, qtx *db.Queries, req ReassignPickSlotRequest) (*PickSlotReassignment, error) {
+	current, err := qtx.GetDraftPickForUpdate(ctx, req.PickID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft pick: %w", err)
+	}
+	if !req.DraftID.IsZero() && current.DraftID != req.DraftID.UUID() {
+		return nil, fmt.Errorf("pick %s does not belong to draft %s", req.PickID, req.DraftID)
+	}
+	if current.PlayerID.Valid {
+		return nil, ErrPickAlreadyMade
+	}
+	if req.ReassignedBy != nil {
+		if err := checkPickHolder(ctx, qtx, current, *req.ReassignedBy); err != nil {
+			return nil, err
+		}
+	}
+	if current.TeamID == req.NewTeamID {
+		return nil, fmt.Errorf("pick is already owned by team %s", req.NewTeamID)
+	}
+	if !req.SkipTradeRules {
+		if err := checkPickTradeRules(ctx, qtx, current); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := qtx.ReassignDraftPickTeam(ctx, db.ReassignDraftPickTeamParams{
+		ID:     req.PickID,
+		TeamID: req.NewTeamID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign draft pick: %w", err)
+	}
+
+	err = qtx.InsertDraftPickSlotChange(ctx, db.InsertDraftPickSlotChangeParams{
+		ID:         ids.New(),
+		PickID:     req.PickID,
+		DraftID:    current.DraftID,
+		FromTeamID: current.TeamID,
+		ToTeamID:   req.NewTeamID,
+		Reason:     sqlutil.ToSqlString(req.Reason),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record pick slot change: %w", err)
+	}
+
+	return &PickSlotReassignment{
+		Pick:       r.dbDraftPickToModel(updated),
+		FromTeamID: current.TeamID,
+	}, nil
+}
+
+// checkPickHolder returns ErrNotPickHolder unless userID manages the team holding pick or is the
+// commissioner of its draft's league
+func checkPickHolder(ctx context.Context, qtx *db.Queries, pick db.DraftPick, userID uuid.UUID) error {
+	canManage, err := qtx.CanUserManageDraftTeam(ctx, db.CanUserManageDraftTeamParams{
+		FantasyTeamID: pick.TeamID,
+		UserID:        userID,
+		DraftID:       pick.DraftID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check team permission: %w", err)
+	}
+	if canManage {
+		return nil
+	}
+	commissioner, err := qtx.IsDraftCommissioner(ctx, db.IsDraftCommissionerParams{
+		DraftID: pick.DraftID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check commissioner: %w", err)
+	}
+	if !commissioner {
+		return ErrNotPickHolder
+	}
+	return nil
+}
+
+// checkPickTradeRules checks that the league of pick's draft lets its team trade the pick away.
+// Picks of sandbox drafts, and of leagues whose seasons aren't named by their year, are never
+// restricted.
+func checkPickTradeRules(ctx context.Context, qtx *db.Queries, pick db.DraftPick) error {
+	row, err := qtx.GetPickTradeSettings(ctx, pick.DraftID)
+	if err != nil {
+		return fmt.Errorf("failed to get pick trade settings: %w", err)
+	}
+	if row.Sandbox {
+		return nil
+	}
+	var settings map[string]interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+	rules := models.SettingsPickTradeRules(settings)
+	if !rules.Enforced() {
+		return nil
+	}
+	currentSeason, err := strconv.Atoi(row.Season)
+	if err != nil {
+		return nil
+	}
+	loc := models.SettingsLeagueClock(settings).Location
+	season, _ := models.DraftSeason(sqlutil.FromSqlTime(row.ScheduledAt), row.Season, loc)
+
+	if rules.MaxSeasonsOut != nil && season-currentSeason > *rules.MaxSeasonsOut {
+		return fmt.Errorf("%w: the league only allows trading picks up to %d seasons out, and this pick is for %d",
+			ErrPickTradeRestricted, *rules.MaxSeasonsOut, season)
+	}
+
+	if rules.NoConsecutiveFirsts && pick.Round == 1 {
+		drafts, err := qtx.ListTeamFirstRoundPicksByDraft(ctx, db.ListTeamFirstRoundPicksByDraftParams{
+			TeamID:   pick.TeamID,
+			LeagueID: row.LeagueID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list first round picks: %w", err)
+		}
+		// First round picks the team holds per season it drafts in, once this one is gone
+		firsts := make(map[int]int64)
+		for _, d := range drafts {
+			draftSeason, ok := models.DraftSeason(sqlutil.FromSqlTime(d.ScheduledAt), row.Season, loc)
+			if !ok {
+				continue
+			}
+			firsts[draftSeason] += d.FirstRoundPicks
+		}
+		firsts[season]--
+		if firsts[season] == 0 {
+			for _, adjacent := range []int{season - 1, season + 1} {
+				if held, ok := firsts[adjacent]; ok && held == 0 {
+					return fmt.Errorf("%w: the team has already traded away its first round pick for %d, and the league doesn't allow trading away first round picks for consecutive seasons",
+						ErrPickTradeRestricted, adjacent)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// pickTradeMinClock is the least time the team an accepted live pick trade hands the pick to
+// gets on the clock
+const pickTradeMinClock = 15 * time.Second
+
+// pickTradeReason is recorded against the picks an accepted live pick trade moves
+const pickTradeReason = "Live pick trade"
+
+// ProposePickTrade offers the pick on the clock to another team. The pick clock stops while the
+// offer is open: its deadline moves back by the offer's window, and what was left on it is kept
+// for when the offer closes.
+func (r *Repository) ProposePickTrade(ctx context.Context, req ProposePickTradeRequest) (*PickTrade, error) {
+	var trade *PickTrade
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID.UUID(), r.queries.WithTx, func(q *db.Queries) error {
+		status, err := q.GetDraftStatus(ctx, req.DraftID.UUID())
+		if err != nil {
+			return fmt.Errorf("failed to get draft status: %w", err)
+		}
+		if status != string(models.DraftStatusInProgress) {
+			return ErrDraftNotInProgress
+		}
+
+		next, err := q.GetNextPickForDraft(ctx, req.DraftID.UUID())
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPickNotOnTheClock
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get next pick: %w", err)
+		}
+		if next.ID != req.PickID {
+			return ErrPickNotOnTheClock
+		}
+		if next.TeamID != req.FromTeamID.UUID() {
+			return fmt.Errorf("%w for team %s", ErrPickNotOnTheClock, req.FromTeamID)
+		}
+		if req.ProposedBy != nil {
+			if err := checkUserMayPick(ctx, q, next.ID, *req.ProposedBy); err != nil {
+				return err
+			}
+		}
+
+		abandoned, err := q.IsDraftTeamAbandoned(ctx, db.IsDraftTeamAbandonedParams{
+			DraftID:       req.DraftID.UUID(),
+			FantasyTeamID: req.ToTeamID.UUID(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check for abandoned team: %w", err)
+		}
+		if abandoned {
+			return ErrTeamAbandoned
+		}
+
+		if err := checkPickTradeRules(ctx, q, next); err != nil {
+			return err
+		}
+		if req.RequestedPickID != nil {
+			requested, err := q.GetDraftPickForUpdate(ctx, *req.RequestedPickID)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("failed to get requested pick: %w", err)
+			}
+			if err != nil || requested.DraftID != req.DraftID.UUID() || requested.TeamID != req.ToTeamID.UUID() ||
+				requested.PlayerID.Valid || requested.Forfeited {
+				return fmt.Errorf("%w: the pick asked for in return must be an unmade pick the receiving team holds in the draft",
+					ErrPickTradeRestricted)
+			}
+			if err := checkPickTradeRules(ctx, q, requested); err != nil {
+				return err
+			}
+		}
+
+		draftClock, err := q.GetDraftClock(ctx, db.GetDraftClockParams{
+			Now: clock.DatabaseNow(r.clock),
+			ID:  req.DraftID.UUID(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get pick clock: %w", err)
+		}
+		var remaining sql.NullInt64
+		if draftClock.NextDeadline.Valid {
+			left := draftClock.NextDeadline.Time.Sub(draftClock.ServerTime)
+			if left <= 0 {
+				return fmt.Errorf("%w: its clock has run out", ErrPickNotOnTheClock)
+			}
+			remaining = sql.NullInt64{Int64: left.Milliseconds(), Valid: true}
+		}
+
+		// An offer left open past its window, or whose pick was made, doesn't block this one
+		if _, err := q.ExpireOpenPickTradeOffers(ctx, db.ExpireOpenPickTradeOffersParams{
+			Now:     clock.DatabaseNow(r.clock),
+			DraftID: req.DraftID.UUID(),
+		}); err != nil {
+			return fmt.Errorf("failed to expire stale pick trade offers: %w", err)
+		}
+		offer, err := q.InsertPickTradeOffer(ctx, db.InsertPickTradeOfferParams{
+			DraftID:          req.DraftID.UUID(),
+			PickID:           req.PickID,
+			FromTeamID:       req.FromTeamID.UUID(),
+			ToTeamID:         req.ToTeamID.UUID(),
+			RequestedPickID:  sqlutil.ToNullUUID(req.RequestedPickID),
+			Message:          sqlutil.ToSqlString(req.Message),
+			ProposedBy:       sqlutil.ToNullUUID(req.ProposedBy),
+			ClockRemainingMs: remaining,
+			ExpiresAt:        draftClock.ServerTime.Add(req.Window),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPickTradeOfferOpen
+		}
+		if err != nil {
+			return fmt.Errorf("failed to insert pick trade offer: %w", err)
+		}
+
+		trade = &PickTrade{Offer: pickTradeOfferToModel(offer), Changed: true}
+		if draftClock.NextDeadline.Valid {
+			deadline, err := q.MoveDraftDeadline(ctx, db.MoveDraftDeadlineParams{
+				NextDeadline: draftClock.NextDeadline.Time.Add(req.Window),
+				ID:           req.DraftID.UUID(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to stop pick clock: %w", err)
+			}
+			trade.Deadline = sqlutil.FromSqlTime(deadline)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// RespondToPickTrade accepts, declines or withdraws an open live pick trade offer for a team. An
+// offer past its window, or whose pick is no longer on the clock, expires instead.
+func (r *Repository) RespondToPickTrade(ctx context.Context, req RespondToPickTradeRequest) (*PickTrade, error) {
+	var trade *PickTrade
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID.UUID(), r.queries.WithTx, func(q *db.Queries) error {
+		offer, err := q.GetPickTradeOffer(ctx, db.GetPickTradeOfferParams{
+			ID:      req.OfferID,
+			DraftID: req.DraftID.UUID(),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPickTradeOfferNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get pick trade offer: %w", err)
+		}
+
+		var status models.PickTradeOfferStatus
+		switch {
+		case req.TeamID.UUID() == offer.ToTeamID && req.Accept:
+			status = models.PickTradeOfferStatusAccepted
+		case req.TeamID.UUID() == offer.ToTeamID:
+			status = models.PickTradeOfferStatusDeclined
+		case req.TeamID.UUID() == offer.FromTeamID && !req.Accept:
+			status = models.PickTradeOfferStatusWithdrawn
+		default:
+			return ErrNotPickTradeParty
+		}
+
+		if req.RespondedBy != nil {
+			canManage, err := q.CanUserManageDraftTeam(ctx, db.CanUserManageDraftTeamParams{
+				FantasyTeamID: req.TeamID.UUID(),
+				UserID:        *req.RespondedBy,
+				DraftID:       req.DraftID.UUID(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to check team permission: %w", err)
+			}
+			if !canManage {
+				return ErrNotTeamManager
+			}
+		}
+
+		live, err := r.isPickTradeLive(ctx, q, offer)
+		if err != nil {
+			return err
+		}
+		if !live {
+			status = models.PickTradeOfferStatusExpired
+		}
+
+		trade, err = r.closePickTrade(ctx, q, offer, status, req.RespondedBy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// ExpirePickTrade expires a live pick trade offer once its window has passed
+func (r *Repository) ExpirePickTrade(ctx context.Context, draftID models.DraftID, offerID uuid.UUID) (*PickTrade, error) {
+	var trade *PickTrade
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, draftID.UUID(), r.queries.WithTx, func(q *db.Queries) error {
+		offer, err := q.GetPickTradeOffer(ctx, db.GetPickTradeOfferParams{
+			ID:      offerID,
+			DraftID: draftID.UUID(),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPickTradeOfferNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get pick trade offer: %w", err)
+		}
+
+		trade, err = r.closePickTrade(ctx, q, offer, models.PickTradeOfferStatusExpired, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// isPickTradeLive reports whether an offer can still be taken up: its window is open, and its pick
+// is on the clock of the running draft
+func (r *Repository) isPickTradeLive(ctx context.Context, q *db.Queries, offer db.PickTradeOffer) (bool, error) {
+	status, err := q.GetDraftStatus(ctx, offer.DraftID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get draft status: %w", err)
+	}
+	if status != string(models.DraftStatusInProgress) {
+		return false, nil
+	}
+
+	clock, err := q.GetDraftClock(ctx, db.GetDraftClockParams{
+		Now: clock.DatabaseNow(r.clock),
+		ID:  offer.DraftID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get pick clock: %w", err)
+	}
+	if !clock.ServerTime.Before(offer.ExpiresAt) {
+		return false, nil
+	}
+
+	pick, err := q.GetDraftPickForUpdate(ctx, offer.PickID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get draft pick: %w", err)
+	}
+	return r.isPickOnTheClock(ctx, q, pick)
+}
+
+// closePickTrade closes an open offer with status, swapping the picks of an accepted one. While
+// the offer's pick is still on the clock the clock carries on from what was left on it when the
+// offer was made, giving the team an accepted offer hands the pick to at least pickTradeMinClock.
+// An offer that expired with its window already has its deadline there. An offer closed already
+// is returned as it is.
+func (r *Repository) closePickTrade(ctx context.Context, q *db.Queries, offer db.PickTradeOffer, status models.PickTradeOfferStatus, respondedBy *uuid.UUID) (*PickTrade, error) {
+	closed, err := q.ClosePickTradeOffer(ctx, db.ClosePickTradeOfferParams{
+		ID:          offer.ID,
+		DraftID:     offer.DraftID,
+		Status:      string(status),
+		RespondedBy: sqlutil.ToNullUUID(respondedBy),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return &PickTrade{Offer: pickTradeOfferToModel(offer)}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to close pick trade offer: %w", err)
+	}
+	trade := &PickTrade{Offer: pickTradeOfferToModel(closed), Changed: true}
+
+	if status == models.PickTradeOfferStatusAccepted {
+		reason := pickTradeReason
+		moved, err := r.reassignPickSlot(ctx, q, ReassignPickSlotRequest{
+			PickID:    closed.PickID,
+			NewTeamID: closed.ToTeamID,
+			Reason:    &reason,
+		})
+		if err != nil {
+			return nil, err
+		}
+		trade.Reassignments = append(trade.Reassignments, *moved)
+
+		if closed.RequestedPickID.Valid {
+			moved, err := r.reassignPickSlot(ctx, q, ReassignPickSlotRequest{
+				PickID:    closed.RequestedPickID.UUID,
+				NewTeamID: closed.FromTeamID,
+				Reason:    &reason,
+			})
+			if err != nil {
+				return nil, err
+			}
+			trade.Reassignments = append(trade.Reassignments, *moved)
+		}
+	}
+
+	if !closed.ClockRemainingMs.Valid || status == models.PickTradeOfferStatusExpired {
+		return trade, nil
+	}
+	clock, err := q.GetDraftClock(ctx, db.GetDraftClockParams{
+		Now: clock.DatabaseNow(r.clock),
+		ID:  closed.DraftID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pick clock: %w", err)
+	}
+	if !clock.NextDeadline.Valid {
+		return trade, nil
+	}
+	remaining := time.Duration(closed.ClockRemainingMs.Int64) * time.Millisecond
+	if status == models.PickTradeOfferStatusAccepted && remaining < pickTradeMinClock {
+		remaining = pickTradeMinClock
+	}
+	deadline, err := q.MoveDraftDeadline(ctx, db.MoveDraftDeadlineParams{
+		NextDeadline: clock.ServerTime.Add(remaining),
+		ID:           closed.DraftID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to restart pick clock: %w", err)
+	}
+	trade.Deadline = sqlutil.FromSqlTime(deadline)
+	return trade, nil
+}
+
+// ReactToPick leaves a user's reaction on a made pick of a draft, or takes it back, and returns
+// the pick's counts afterwards
+func (r *Repository) ReactToPick(ctx context.Context, req ReactToPickRequest) (*models.PickReactionCounts, error) {
+	q := r.q(ctx)
+	made, err := q.GetReactionPick(ctx, db.GetReactionPickParams{
+		ID:      req.PickID,
+		DraftID: req.DraftID.UUID(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPickNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pick: %w", err)
+	}
+	if !made {
+		return nil, ErrPickNotMade
+	}
+
+	if req.Remove {
+		err = q.RemovePickReaction(ctx, db.RemovePickReactionParams{
+			PickID:   req.PickID,
+			UserID:   req.UserID,
+			Reaction: string(req.Reaction),
+		})
+	} else {
+		err = q.AddPickReaction(ctx, db.AddPickReactionParams{
+			PickID:   req.PickID,
+			UserID:   req.UserID,
+			Reaction: string(req.Reaction),
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update pick reaction: %w", err)
+	}
+
+	rows, err := q.CountPickReactions(ctx, req.PickID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pick reactions: %w", err)
+	}
+	counts := models.NewPickReactionCounts(req.PickID)
+	for _, row := range rows {
+		counts.Set(models.PickReaction(row.Reaction), int(row.Reactions))
+	}
+	return &counts, nil
+}
+
+// ListPickReactions lists the reaction counts of every pick of a draft that has any, in draft order
+func (r *Repository) ListPickReactions(ctx context.Context, draftID uuid.UUID) ([]models.PickReactionCounts, error) {
+	rows, err := r.read(ctx).ListDraftPickReactionCounts(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pick reactions: %w", err)
+	}
+
+	var picks []models.PickReactionCounts
+	for _, row := range rows {
+		if len(picks) == 0 || picks[len(picks)-1].PickID != row.PickID {
+			picks = append(picks, models.NewPickReactionCounts(row.PickID))
+		}
+		picks[len(picks)-1].Set(models.PickReaction(row.Reaction), int(row.Reactions))
+	}
+	return picks, nil
+}
+
 func (r *Repository) DeleteDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) (int, error) {
 	// Use direct SQL execution to get the count of deleted rows
-	result, err := r.sqlDB.ExecContext(ctx, "DELETE FROM draft_picks WHERE draft_id = $1", draftID)
+	var exec db.DBTX = r.sqlDB
+	if tx, ok := sqlutil.TxFromContext(ctx); ok {
+		exec = tx
+	}
+	result, err := exec.ExecContext(ctx, "DELETE FROM draft_picks WHERE draft_id = $1", draftID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to delete draft picks by draft: %w", err)
 	}
@@ -182,29 +811,582 @@ func (r *Repository) DeleteDraftPicksByDraft(ctx context.Context, draftID uuid.U
 }
 
 func (r *Repository) MakePick(ctx context.Context, req MakePickRequest) error {
-	rowsAffected, err := r.queries.MakePick(ctx, db.MakePickParams{
-		ID:       req.PickID,
-		PlayerID: uuid.NullUUID{UUID: req.PlayerID, Valid: true},
+	// Serialize with status transitions and deadline updates for the same draft
+	return sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID.UUID(), r.queries.WithTx, func(q *db.Queries) error {
+		status, err := q.GetDraftStatus(ctx, req.DraftID.UUID())
+		if err != nil {
+			return fmt.Errorf("failed to get draft status: %w", err)
+		}
+		if status != string(models.DraftStatusInProgress) {
+			return ErrDraftNotInProgress
+		}
+
+		// The lock is the request's draft's, so a pick of another draft isn't made under it
+		current, err := q.GetDraftPickForUpdate(ctx, req.PickID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrPickNotFound
+			}
+			return fmt.Errorf("failed to get draft pick: %w", err)
+		}
+		if current.DraftID != req.DraftID.UUID() {
+			return ErrPickNotFound
+		}
+
+		if req.PickedBy != nil {
+			if err := checkUserMayPick(ctx, q, req.PickID, *req.PickedBy); err != nil {
+				return err
+			}
+		}
+
+		if current.PlayerID.Valid {
+			return ErrPickAlreadyMade
+		}
+		// A skipped pick is made through MakeLatePick until it's back on the clock
+		onTheClock, err := r.isPickOnTheClock(ctx, q, current)
+		if err != nil {
+			return err
+		}
+		if current.SkippedAt.Valid && !onTheClock {
+			return ErrPickSkipped
+		}
+		if err := checkTurn(ctx, q, req, current, onTheClock); err != nil {
+			return err
+		}
+		if err := r.checkRosterSpace(ctx, q, req.DraftID.UUID(), current.TeamID, req.PlayerID.UUID()); err != nil {
+			return err
+		}
+		if err := r.checkCapSpace(ctx, q, req.DraftID.UUID(), current.TeamID); err != nil {
+			return err
+		}
+		source, err := expansionSource(ctx, q, req.DraftID.UUID(), req.PlayerID.UUID())
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := q.MakePick(ctx, db.MakePickParams{
+			ID:       req.PickID,
+			PlayerID: uuid.NullUUID{UUID: req.PlayerID.UUID(), Valid: true},
+			PickedAt: sql.NullTime{Time: r.clock.Now(), Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to make pick: %w", err)
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("pick already made or pick not found")
+		}
+		if err := recordDelegatePick(ctx, q, current.TeamID, req.PickID, req.DraftID.UUID(), req.PlayerID.UUID(), req.PickedBy); err != nil {
+			return err
+		}
+		return recordExpansionSelection(ctx, q, req.PickID, req.DraftID.UUID(), req.PlayerID.UUID(), source)
+	})
+}
+
+// expansionSource returns the existing team an expansion draft takes a player from, or nil for
+// any other draft type. It returns ErrNotInExpansionPool when the player isn't rostered by an
+// existing team, was protected, or would take the team past the most players it may lose, and
+// ErrNotInDispersalPool when a dispersal draft's player isn't on the folded team's roster.
+func expansionSource(ctx context.Context, q *db.Queries, draftID, playerID uuid.UUID) (*uuid.UUID, error) {
+	draftType, err := q.GetDraftType(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft type: %w", err)
+	}
+	if draftType == string(models.DraftTypeDispersal) {
+		inPool, err := q.IsInDispersalPool(ctx, db.IsInDispersalPoolParams{
+			DraftID:  draftID,
+			PlayerID: playerID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to check dispersal pool: %w", err)
+		}
+		if !inPool {
+			return nil, ErrNotInDispersalPool
+		}
+		return nil, nil
+	}
+	if draftType != string(models.DraftTypeExpansion) {
+		return nil, nil
+	}
+
+	source, err := q.GetExpansionPlayerSource(ctx, db.GetExpansionPlayerSourceParams{
+		DraftID:  draftID,
+		PlayerID: playerID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: not on an existing team's roster", ErrNotInExpansionPool)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expansion player source: %w", err)
+	}
+	if source.Protected {
+		return nil, fmt.Errorf("%w: protected by their team", ErrNotInExpansionPool)
+	}
+	if source.MaxPlayersLost > 0 && source.PlayersLost >= int64(source.MaxPlayersLost) {
+		return nil, fmt.Errorf("%w: their team has lost %d players already", ErrNotInExpansionPool, source.PlayersLost)
+	}
+	return &source.FantasyTeamID, nil
+}
+
+// recordExpansionSelection counts an expansion pick against the team it took its player from.
+// It does nothing when source is nil.
+func recordExpansionSelection(ctx context.Context, q *db.Queries, pickID, draftID, playerID uuid.UUID, source *uuid.UUID) error {
+	if source == nil {
+		return nil
+	}
+	if err := q.InsertExpansionSelection(ctx, db.InsertExpansionSelectionParams{
+		PickID:     pickID,
+		DraftID:    draftID,
+		FromTeamID: *source,
+		PlayerID:   playerID,
+	}); err != nil {
+		return fmt.Errorf("failed to record expansion selection: %w", err)
+	}
+	return nil
+}
+
+// recordDelegatePick logs a pick in the team's delegate action log when the user who made it made
+// it as the team's delegate. Picks made by anyone else, or by the auto-pick, aren't logged.
+func recordDelegatePick(ctx context.Context, q *db.Queries, teamID, pickID, draftID, playerID uuid.UUID, pickedBy *uuid.UUID) error {
+	if pickedBy == nil {
+		return nil
+	}
+	details, err := json.Marshal(map[string]any{
+		"pick_id":   pickID,
+		"draft_id":  draftID,
+		"player_id": playerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal delegate pick: %w", err)
+	}
+	if err := q.InsertDelegateAction(ctx, db.InsertDelegateActionParams{
+		ID:            ids.New(),
+		Action:        models.DelegateActionPickMade,
+		Details:       details,
+		FantasyTeamID: teamID,
+		UserID:        *pickedBy,
+	}); err != nil {
+		return fmt.Errorf("failed to record delegate pick: %w", err)
+	}
+	return nil
+}
+
+// checkUserMayPick bars users from picks of abandoned teams, and from picks of teams they
+// neither own, co-manage nor stand in for as delegate unless they are the commissioner
+func checkUserMayPick(ctx context.Context, q *db.Queries, pickID, userID uuid.UUID) error {
+	abandoned, err := q.IsPickTeamAbandoned(ctx, pickID)
+	if err != nil {
+		return fmt.Errorf("failed to check for abandoned team: %w", err)
+	}
+	if abandoned {
+		return ErrTeamAbandoned
+	}
+
+	canPick, err := q.CanUserMakePick(ctx, db.CanUserMakePickParams{
+		PickID: pickID,
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check pick permission: %w", err)
+	}
+	if !canPick {
+		return ErrNotTeamManager
+	}
+	return nil
+}
+
+// checkTurn returns ErrOutOfTurn unless the pick is on the clock under the overall pick the client
+// submitted, so a client whose board is behind can't make the wrong pick. The commissioner and
+// trusted callers may override it.
+func checkTurn(ctx context.Context, q *db.Queries, req MakePickRequest, pick db.DraftPick, onTheClock bool) error {
+	if req.OverrideTurn {
+		if req.PickedBy == nil {
+			return nil
+		}
+		commissioner, err := q.IsDraftCommissioner(ctx, db.IsDraftCommissionerParams{
+			DraftID: req.DraftID.UUID(),
+			UserID:  *req.PickedBy,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check commissioner: %w", err)
+		}
+		if !commissioner {
+			return ErrNotCommissioner
+		}
+		return nil
+	}
+	if !onTheClock || int(pick.OverallPick) != req.OverallPick {
+		return ErrOutOfTurn
+	}
+	return nil
+}
+
+// MakeLatePick makes a skipped pick out of board order while the draft carries on
+func (r *Repository) MakeLatePick(ctx context.Context, req MakeLatePickRequest) (*LatePick, error) {
+	var result *LatePick
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID.UUID(), r.queries.WithTx, func(q *db.Queries) error {
+		status, err := q.GetDraftStatus(ctx, req.DraftID.UUID())
+		if err != nil {
+			return fmt.Errorf("failed to get draft status: %w", err)
+		}
+		if status != string(models.DraftStatusInProgress) {
+			return ErrDraftNotInProgress
+		}
+
+		current, err := q.GetDraftPickForUpdate(ctx, req.PickID)
+		if err != nil {
+			return fmt.Errorf("failed to get draft pick: %w", err)
+		}
+		if current.DraftID != req.DraftID.UUID() {
+			return fmt.Errorf("pick %s does not belong to draft %s", req.PickID, req.DraftID)
+		}
+		if current.PlayerID.Valid {
+			return ErrPickAlreadyMade
+		}
+		if !current.SkippedAt.Valid || current.Forfeited {
+			return ErrPickNotSkipped
+		}
+
+		if req.PickedBy != nil {
+			if err := checkUserMayPick(ctx, q, req.PickID, *req.PickedBy); err != nil {
+				return err
+			}
+		}
+
+		if err := r.checkRosterSpace(ctx, q, req.DraftID.UUID(), current.TeamID, req.PlayerID.UUID()); err != nil {
+			return err
+		}
+		if err := r.checkCapSpace(ctx, q, req.DraftID.UUID(), current.TeamID); err != nil {
+			return err
+		}
+		source, err := expansionSource(ctx, q, req.DraftID.UUID(), req.PlayerID.UUID())
+		if err != nil {
+			return err
+		}
+
+		onTheClock, err := r.isPickOnTheClock(ctx, q, current)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := q.MakePick(ctx, db.MakePickParams{
+			ID:       req.PickID,
+			PlayerID: uuid.NullUUID{UUID: req.PlayerID.UUID(), Valid: true},
+			PickedAt: sql.NullTime{Time: r.clock.Now(), Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to make late pick: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrPickAlreadyMade
+		}
+		if err := recordDelegatePick(ctx, q, current.TeamID, req.PickID, req.DraftID.UUID(), req.PlayerID.UUID(), req.PickedBy); err != nil {
+			return err
+		}
+		if err := recordExpansionSelection(ctx, q, req.PickID, req.DraftID.UUID(), req.PlayerID.UUID(), source); err != nil {
+			return err
+		}
+
+		made, err := q.GetDraftPick(ctx, req.PickID)
+		if err != nil {
+			return fmt.Errorf("failed to get draft pick: %w", err)
+		}
+		result = &LatePick{
+			Pick:       r.dbDraftPickToModel(made),
+			OnTheClock: onTheClock,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SkipPick moves the draft past the pick on the clock once its deadline has passed. The
+// team keeps the pick and can make it late.
+func (r *Repository) SkipPick(ctx context.Context, req SkipPickRequest) (*models.DraftPick, error) {
+	var skipped *models.DraftPick
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID.UUID(), r.queries.WithTx, func(q *db.Queries) error {
+		status, err := q.GetDraftStatus(ctx, req.DraftID.UUID())
+		if err != nil {
+			return fmt.Errorf("failed to get draft status: %w", err)
+		}
+		if status != string(models.DraftStatusInProgress) {
+			return ErrDraftNotInProgress
+		}
+
+		next, err := q.GetNextPickForDraft(ctx, req.DraftID.UUID())
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPickNotOnTheClock
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get next pick: %w", err)
+		}
+		// A skipped pick back on the clock at the end of the draft can't be skipped again
+		if next.ID != req.PickID || next.SkippedAt.Valid {
+			return ErrPickNotOnTheClock
+		}
+
+		passed, err := q.IsDraftDeadlinePassed(ctx, db.IsDraftDeadlinePassedParams{
+			Now: clock.DatabaseNow(r.clock),
+			ID:  req.DraftID.UUID(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check pick deadline: %w", err)
+		}
+		if !passed {
+			return ErrPickClockRunning
+		}
+
+		rowsAffected, err := q.SkipPick(ctx, req.PickID)
+		if err != nil {
+			return fmt.Errorf("failed to skip pick: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrPickNotOnTheClock
+		}
+
+		pick, err := q.GetDraftPick(ctx, req.PickID)
+		if err != nil {
+			return fmt.Errorf("failed to get draft pick: %w", err)
+		}
+		skipped = r.dbDraftPickToModel(pick)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return skipped, nil
+}
+
+// SkipPickWithoutRosterSpace forfeits the pick on the clock when its team's roster is full, or
+// its salary cap has no room for another player at the league's minimum salary: as soon as the
+// pick comes on the clock in drafts set to skip such picks, and otherwise once its clock has run
+// out. It returns nil when the pick stays on the clock.
+func (r *Repository) SkipPickWithoutRosterSpace(ctx context.Context, req SkipPickWithoutRosterSpaceRequest) (*models.DraftPick, error) {
+	var forfeited *models.DraftPick
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, req.DraftID.UUID(), r.queries.WithTx, func(q *db.Queries) error {
+		status, err := q.GetDraftStatus(ctx, req.DraftID.UUID())
+		if err != nil {
+			return fmt.Errorf("failed to get draft status: %w", err)
+		}
+		if status != string(models.DraftStatusInProgress) {
+			return ErrDraftNotInProgress
+		}
+
+		next, err := q.GetNextPickForDraft(ctx, req.DraftID.UUID())
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get next pick: %w", err)
+		}
+
+		space, err := r.rosterSpace(ctx, q, req.DraftID.UUID(), next.TeamID)
+		if err != nil {
+			return err
+		}
+		if !space.Limits.Full(space.Counts) {
+			// A team that can't pay anyone the minimum salary can't pick anyone either
+			if err := r.checkCapSpace(ctx, q, req.DraftID.UUID(), next.TeamID); !errors.Is(err, ErrNoCapSpace) {
+				return err
+			}
+		}
+
+		if req.ClockExpired {
+			passed, err := q.IsDraftDeadlinePassed(ctx, db.IsDraftDeadlinePassedParams{
+				Now: clock.DatabaseNow(r.clock),
+				ID:  req.DraftID.UUID(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to check pick deadline: %w", err)
+			}
+			if !passed {
+				return ErrPickClockRunning
+			}
+		} else {
+			rawSettings, err := q.GetDraftSettings(ctx, req.DraftID.UUID())
+			if err != nil {
+				return fmt.Errorf("failed to get draft settings: %w", err)
+			}
+			var settings models.DraftSettings
+			if err := json.Unmarshal(rawSettings, &settings); err != nil {
+				return fmt.Errorf("failed to unmarshal draft settings: %w", err)
+			}
+			if !settings.SkipPicksWithoutRosterSpace {
+				return nil
+			}
+		}
+
+		rowsAffected, err := q.ForfeitPick(ctx, next.ID)
+		if err != nil {
+			return fmt.Errorf("failed to forfeit pick: %w", err)
+		}
+		if rowsAffected == 0 {
+			return nil
+		}
+
+		pick, err := q.GetDraftPick(ctx, next.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get draft pick: %w", err)
+		}
+		forfeited = r.dbDraftPickToModel(pick)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return forfeited, nil
+}
+
+// GetRosterSpace returns what a team holds against its league's roster limits during a draft
+func (r *Repository) GetRosterSpace(ctx context.Context, draftID, teamID uuid.UUID) (*RosterSpace, error) {
+	return r.rosterSpace(ctx, r.queries, draftID, teamID)
+}
+
+// rosterSpace reads a team's roster limits and starting lineup from the league running the draft
+// and, when the league sets either, the players the team holds by position
+func (r *Repository) rosterSpace(ctx context.Context, q *db.Queries, draftID, teamID uuid.UUID) (*RosterSpace, error) {
+	row, err := q.GetDraftRankingSettings(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league settings for draft: %w", err)
+	}
+	var settings interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	space := &RosterSpace{
+		Limits: models.SettingsRosterLimits(row.SportID, settings),
+		Slots:  models.SettingsLineupSlots(row.SportID, settings),
+	}
+	if !space.Limits.Enforced() && len(space.Slots) == 0 {
+		return space, nil
+	}
+
+	rows, err := q.ListTeamRosterPositions(ctx, db.ListTeamRosterPositionsParams{
+		TeamID:  teamID,
+		DraftID: draftID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count team roster positions: %w", err)
+	}
+	space.Counts = make(map[string]int, len(rows))
+	for _, row := range rows {
+		space.Counts[row.Position] = int(row.Players)
+	}
+	return space, nil
+}
+
+// ListTeamByeWeeks returns the position and bye week of each player a team holds during a draft
+func (r *Repository) ListTeamByeWeeks(ctx context.Context, draftID, teamID uuid.UUID) ([]HeldByeWeek, error) {
+	rows, err := r.q(ctx).ListTeamByeWeeks(ctx, db.ListTeamByeWeeksParams{
+		TeamID:  teamID,
+		DraftID: draftID,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to make pick: %w", err)
+		return nil, fmt.Errorf("failed to list team bye weeks: %w", err)
+	}
+
+	held := make([]HeldByeWeek, len(rows))
+	for i, row := range rows {
+		held[i] = HeldByeWeek{Position: row.Position, ByeWeek: int(row.ByeWeek)}
 	}
-	if rowsAffected == 0 {
-		return fmt.Errorf("pick already made or pick not found")
+	return held, nil
+}
+
+// checkRosterSpace returns ErrNoRosterSpace when a team can't roster the player it is picking
+func (r *Repository) checkRosterSpace(ctx context.Context, q *db.Queries, draftID, teamID, playerID uuid.UUID) error {
+	space, err := r.rosterSpace(ctx, q, draftID, teamID)
+	if err != nil {
+		return err
+	}
+	if !space.Limits.Enforced() {
+		return nil
+	}
+
+	position, err := q.GetPlayerPosition(ctx, playerID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to get player position: %w", err)
+	}
+	if space.HasRoom(position) {
+		return nil
+	}
+	if space.Limits.Full(space.Counts) {
+		return fmt.Errorf("%w: roster is full", ErrNoRosterSpace)
+	}
+	return fmt.Errorf("%w: no open %s spot", ErrNoRosterSpace, position)
+}
+
+// checkCapSpace returns ErrNoCapSpace when a team in a salary cap league can't pay the league's
+// minimum salary to another player
+func (r *Repository) checkCapSpace(ctx context.Context, q *db.Queries, draftID, teamID uuid.UUID) error {
+	row, err := q.GetDraftRankingSettings(ctx, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to get league settings for draft: %w", err)
+	}
+	var settings interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	rules := models.SettingsSalaryCapRules(settings)
+	if !rules.Enforced() {
+		return nil
+	}
+	payroll, err := q.GetTeamDraftPayroll(ctx, db.GetTeamDraftPayrollParams{
+		MinSalary: int32(rules.MinSalary),
+		TeamID:    teamID,
+		DraftID:   draftID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get team payroll: %w", err)
+	}
+	if int(payroll)+rules.MinSalary > *rules.Cap {
+		return fmt.Errorf("%w: a payroll of %d against a cap of %d", ErrNoCapSpace, int(payroll)+rules.MinSalary, *rules.Cap)
 	}
 	return nil
 }
 
+// isPickOnTheClock reports whether pick is the next one its draft is waiting on
+func (r *Repository) isPickOnTheClock(ctx context.Context, q *db.Queries, pick db.DraftPick) (bool, error) {
+	next, err := q.GetNextPickForDraft(ctx, pick.DraftID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get next pick: %w", err)
+	}
+	return next.ID == pick.ID, nil
+}
+
 func (r *Repository) CountRemainingPicks(ctx context.Context, draftID uuid.UUID) (int, error) {
-	count, err := r.queries.CountRemainingPicks(ctx, draftID)
+	count, err := r.q(ctx).CountRemainingPicks(ctx, draftID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count remaining picks: %w", err)
 	}
 	return int(count), nil
 }
 
+func (r *Repository) CountRemainingPicksForDrafts(ctx context.Context, draftIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	rows, err := r.q(ctx).CountRemainingPicksForDrafts(ctx, draftIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count remaining picks: %w", err)
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.DraftID] = int(row.RemainingPicks)
+	}
+	return counts, nil
+}
+
 func (r *Repository) ClaimNextPickSlot(ctx context.Context, draftID uuid.UUID) (*Slot, error) {
-	row, err := r.queries.ClaimNextPickSlot(ctx, draftID)
+	row, err := r.q(ctx).ClaimNextPickSlot(ctx, draftID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to claim next pick slot: %w", err)
 	}
@@ -217,7 +1399,7 @@ func (r *Repository) ClaimNextPickSlot(ctx context.Context, draftID uuid.UUID) (
 }
 
 func (r *Repository) ListAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUID) ([]AvailablePlayer, error) {
-	rows, err := r.queries.ListAvailablePlayersForDraft(ctx, draftID)
+	rows, err := r.read(ctx).ListAvailablePlayersForDraft(ctx, draftID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list available players for draft: %w", err)
 	}
@@ -225,15 +1407,163 @@ func (r *Repository) ListAvailablePlayersForDraft(ctx context.Context, draftID u
 	players := make([]AvailablePlayer, len(rows))
 	for i, row := range rows {
 		players[i] = AvailablePlayer{
-			ID:       row.ID,
-			FullName: row.FullName,
-			TeamID:   row.TeamID.UUID, // Convert NullUUID to UUID
+			ID:                 row.ID,
+			FullName:           row.FullName,
+			TeamID:             row.TeamID.UUID, // Convert NullUUID to UUID
+			ByeWeek:            sqlutil.FromSqlInt32(row.ByeWeek),
+			DepthChartPosition: sqlutil.FromSqlStringPtr(row.DepthChartPosition),
+			DepthChartDepth:    sqlutil.FromSqlInt32(row.DepthChartDepth),
+			Position:           row.Position.String,
+			OwnedPct:           sqlutil.FromSqlFloat64(row.OwnedPct),
+			StartedPct:         sqlutil.FromSqlFloat64(row.StartedPct),
+			InjuryStatus:       injuryStatus(row.Status),
+		}
+	}
+
+	return players, nil
+}
+
+// ListExpansionPoolPlayerIDs returns the players an expansion draft can still take
+func (r *Repository) ListExpansionPoolPlayerIDs(ctx context.Context, draftID uuid.UUID) ([]uuid.UUID, error) {
+	playerIDs, err := r.q(ctx).ListExpansionPoolPlayerIDs(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expansion pool: %w", err)
+	}
+	return playerIDs, nil
+}
+
+// ListDispersalPoolPlayerIDs returns the players a dispersal draft can still take
+func (r *Repository) ListDispersalPoolPlayerIDs(ctx context.Context, draftID uuid.UUID) ([]uuid.UUID, error) {
+	playerIDs, err := r.q(ctx).ListDispersalPoolPlayerIDs(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dispersal pool: %w", err)
+	}
+	return playerIDs, nil
+}
+
+// GetEligiblePositions resolves a position filter against the lineup and sport of the league
+// running a draft: a lineup slot's eligible positions, or the position itself
+func (r *Repository) GetEligiblePositions(ctx context.Context, draftID uuid.UUID, name string) ([]string, error) {
+	row, err := r.q(ctx).GetDraftRankingSettings(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league settings for draft: %w", err)
+	}
+
+	var settings map[string]interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	return models.EligiblePositions(row.SportID, settings, name), nil
+}
+
+// GetDraftRankingProfile picks the rankings for a draft from its league's season and settings
+func (r *Repository) GetDraftRankingProfile(ctx context.Context, draftID uuid.UUID) (*RankingProfile, error) {
+	row, err := r.q(ctx).GetDraftRankingSettings(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league settings for draft: %w", err)
+	}
+
+	var settings map[string]interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	return &RankingProfile{
+		Season:        row.Season,
+		ScoringFormat: models.SettingsScoringFormat(settings),
+		Superflex:     models.SettingsSuperflex(row.SportID, settings),
+	}, nil
+}
+
+func (r *Repository) ListRankedAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUID, profile RankingProfile) ([]AvailablePlayer, error) {
+	rows, err := r.q(ctx).ListRankedAvailablePlayersForDraft(ctx, db.ListRankedAvailablePlayersForDraftParams{
+		DraftID:       draftID,
+		Season:        profile.Season,
+		ScoringFormat: string(profile.ScoringFormat),
+		Superflex:     profile.Superflex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ranked available players for draft: %w", err)
+	}
+
+	players := make([]AvailablePlayer, len(rows))
+	for i, row := range rows {
+		players[i] = AvailablePlayer{
+			ID:                 row.ID,
+			FullName:           row.FullName,
+			TeamID:             row.TeamID.UUID, // Convert NullUUID to UUID
+			ByeWeek:            sqlutil.FromSqlInt32(row.ByeWeek),
+			DepthChartPosition: sqlutil.FromSqlStringPtr(row.DepthChartPosition),
+			DepthChartDepth:    sqlutil.FromSqlInt32(row.DepthChartDepth),
+			Position:           row.Position.String,
+			Rank:               sqlutil.FromSqlInt32(row.OverallRank),
+			ProjectedPoints:    sqlutil.FromSqlFloat64(row.ProjectedPoints),
+			OwnedPct:           sqlutil.FromSqlFloat64(row.OwnedPct),
+			StartedPct:         sqlutil.FromSqlFloat64(row.StartedPct),
+			InjuryStatus:       injuryStatus(row.Status),
+			AuctionSales:       sqlutil.FromSqlInt32(row.AuctionSales),
+		}
+		if row.AverageAuctionPrice.Valid {
+			price, err := strconv.ParseFloat(row.AverageAuctionPrice.String, 64)
+			if err == nil {
+				players[i].AverageAuctionPrice = &price
+			}
 		}
 	}
 
 	return players, nil
 }
 
+func (r *Repository) GetPickAnnouncement(ctx context.Context, pickID uuid.UUID) (*PickAnnouncement, error) {
+	row, err := r.q(ctx).GetPickAnnouncement(ctx, pickID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pick announcement: %w", err)
+	}
+
+	return &PickAnnouncement{
+		TeamName:       row.TeamName.String,
+		PlayerName:     row.PlayerName.String,
+		PlayerPosition: row.PlayerPosition.String,
+		NFLTeamCode:    row.NflTeamCode.String,
+	}, nil
+}
+
+func (r *Repository) ListDraftResults(ctx context.Context, draftID uuid.UUID) ([]DraftResult, error) {
+	rows, err := r.q(ctx).ListDraftResults(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft results: %w", err)
+	}
+
+	results := make([]DraftResult, len(rows))
+	for i, row := range rows {
+		results[i] = DraftResult{
+			Round:          int(row.Round),
+			Pick:           int(row.Pick),
+			OverallPick:    int(row.OverallPick),
+			TeamID:         row.TeamID,
+			TeamName:       row.TeamName.String,
+			PlayerID:       sqlutil.FromNullUUID(row.PlayerID),
+			PlayerName:     row.PlayerName.String,
+			PlayerPosition: row.PlayerPosition.String,
+			KeeperPick:     row.KeeperPick.Bool,
+			PickedAt:       sqlutil.FromSqlTime(row.PickedAt),
+		}
+		if row.AuctionAmount.Valid {
+			amount, err := strconv.ParseFloat(row.AuctionAmount.String, 64)
+			if err == nil {
+				results[i].AuctionAmount = &amount
+			}
+		}
+	}
+
+	return results, nil
+}
+
 // Helper function to convert DB draft pick to model
 func (r *Repository) dbDraftPickToModel(dbPick db.DraftPick) *models.DraftPick {
 	pick := &models.DraftPick{
@@ -244,6 +1574,7 @@ func (r *Repository) dbDraftPickToModel(dbPick db.DraftPick) *models.DraftPick {
 		OverallPick: int(dbPick.OverallPick),
 		TeamID:      dbPick.TeamID,
 		KeeperPick:  dbPick.KeeperPick.Bool,
+		Forfeited:   dbPick.Forfeited,
 	}
 
 	if dbPick.PlayerID.Valid {
@@ -252,6 +1583,9 @@ func (r *Repository) dbDraftPickToModel(dbPick db.DraftPick) *models.DraftPick {
 	if dbPick.PickedAt.Valid {
 		pick.PickedAt = &dbPick.PickedAt.Time
 	}
+	if dbPick.SkippedAt.Valid {
+		pick.SkippedAt = &dbPick.SkippedAt.Time
+	}
 	if dbPick.AuctionAmount.Valid {
 		amount, err := strconv.ParseFloat(dbPick.AuctionAmount.String, 64)
 		if err == nil {
@@ -260,4 +1594,35 @@ func (r *Repository) dbDraftPickToModel(dbPick db.DraftPick) *models.DraftPick {
 	}
 
 	return pick
-}
\ No newline at end of file
+}
+
+// injuryStatus returns a player's status when it's an injury designation
+func pickTradeOfferToModel(offer db.PickTradeOffer) *models.PickTradeOffer {
+	result := &models.PickTradeOffer{
+		ID:              offer.ID,
+		DraftID:         offer.DraftID,
+		PickID:          offer.PickID,
+		FromTeamID:      offer.FromTeamID,
+		ToTeamID:        offer.ToTeamID,
+		RequestedPickID: sqlutil.FromNullUUID(offer.RequestedPickID),
+		Message:         sqlutil.FromSqlStringPtr(offer.Message),
+		ProposedBy:      sqlutil.FromNullUUID(offer.ProposedBy),
+		Status:          models.PickTradeOfferStatus(offer.Status),
+		OpenedAt:        offer.OpenedAt,
+		ExpiresAt:       offer.ExpiresAt,
+		ClosedAt:        sqlutil.FromSqlTime(offer.ClosedAt),
+		RespondedBy:     sqlutil.FromNullUUID(offer.RespondedBy),
+	}
+	if offer.ClockRemainingMs.Valid {
+		remaining := time.Duration(offer.ClockRemainingMs.Int64) * time.Millisecond
+		result.ClockRemaining = &remaining
+	}
+	return result
+}
+
+func injuryStatus(status sql.NullString) *string {
+	if !status.Valid || !models.IsNFLInjuryStatus(status.String) {
+		return nil
+	}
+	return &status.String
+}
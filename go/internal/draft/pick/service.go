@@ -2,9 +2,12 @@ package pick
 
 import (
 	"context"
-	"encoding/json"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"math"
+	"strconv"
 	"time"
 
 	"connectrpc.com/connect"
@@ -12,7 +15,12 @@ import (
 	"github.com/mcdev12/dynasty/go/internal/draft/events"
 	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
+	"github.com/mcdev12/dynasty/go/internal/ratelimit"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -20,35 +28,71 @@ import (
 type PickApp interface {
 	PrepopulateDraftPicks(ctx context.Context, draftID uuid.UUID, draftType models.DraftType, settings models.DraftSettings) error
 	MakePick(ctx context.Context, req MakePickRequest) error
+	MakeLatePick(ctx context.Context, req MakeLatePickRequest) (*LatePick, error)
+	SkipPick(ctx context.Context, req SkipPickRequest) (*models.DraftPick, error)
+	SkipPickWithoutRosterSpace(ctx context.Context, req SkipPickWithoutRosterSpaceRequest) (*models.DraftPick, error)
 	GetDraftPick(ctx context.Context, pickID uuid.UUID) (*models.DraftPick, error)
-	GetDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) ([]models.DraftPick, error)
+	ListDraftPicksByDraft(ctx context.Context, draftID uuid.UUID, filter DraftPickFilter, pagination PaginationParams) (*DraftPickListResponse, error)
 	GetDraftPicksByRound(ctx context.Context, draftID uuid.UUID, round int) ([]models.DraftPick, error)
 	GetNextPickForDraft(ctx context.Context, draftID uuid.UUID) (*models.DraftPick, error)
 	CountRemainingPicks(ctx context.Context, draftID uuid.UUID) (int, error)
+	CountRemainingPicksForDrafts(ctx context.Context, draftIDs []uuid.UUID) (map[uuid.UUID]int, error)
 	ClaimNextPickSlot(ctx context.Context, draftID uuid.UUID) (*Slot, error)
 	ListAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUID) ([]AvailablePlayer, error)
+	ListRankedAvailablePlayersForDraft(ctx context.Context, draftID uuid.UUID, format *models.ScoringFormat) ([]AvailablePlayer, *RankingProfile, error)
+	RestrictToOpenPositions(ctx context.Context, draftID uuid.UUID, players []AvailablePlayer) ([]AvailablePlayer, error)
+	RestrictToOpenLineupSlots(ctx context.Context, draftID uuid.UUID, players []AvailablePlayer) ([]AvailablePlayer, error)
+	RestrictToPosition(ctx context.Context, draftID uuid.UUID, players []AvailablePlayer, position string) ([]AvailablePlayer, error)
+	ComparePlayers(players []AvailablePlayer, playerIDs []uuid.UUID) ([]PlayerComparison, error)
+	SuggestQueueAdditions(ctx context.Context, draftID models.DraftID, teamID models.TeamID, players []AvailablePlayer, exclude []uuid.UUID, limit int) ([]QueueSuggestion, error)
+	GetPickSuggestions(ctx context.Context, draftID models.DraftID, teamID models.TeamID, players []AvailablePlayer, limit int) (*PickSuggestions, error)
+	RestrictToExpansionPool(ctx context.Context, draftID uuid.UUID, players []AvailablePlayer) ([]AvailablePlayer, error)
+	RestrictToDispersalPool(ctx context.Context, draftID uuid.UUID, players []AvailablePlayer) ([]AvailablePlayer, error)
+	ListDraftResults(ctx context.Context, draftID uuid.UUID) ([]DraftResult, error)
+	GetPickAnnouncement(ctx context.Context, pickID uuid.UUID) (*PickAnnouncement, error)
 	UpdateDraftPickPlayer(ctx context.Context, pickID uuid.UUID, req UpdateDraftPickPlayerRequest) (*models.DraftPick, error)
 	DeleteDraftPicksByDraft(ctx context.Context, draftID uuid.UUID) (int, error)
+	ReassignPickSlot(ctx context.Context, req ReassignPickSlotRequest) (*PickSlotReassignment, error)
+	ProposePickTrade(ctx context.Context, req ProposePickTradeRequest) (*PickTrade, error)
+	RespondToPickTrade(ctx context.Context, req RespondToPickTradeRequest) (*PickTrade, error)
+	ExpirePickTrade(ctx context.Context, draftID models.DraftID, offerID uuid.UUID) (*PickTrade, error)
+	ReactToPick(ctx context.Context, req ReactToPickRequest) (*models.PickReactionCounts, error)
+	ListPickReactions(ctx context.Context, draftID uuid.UUID) ([]models.PickReactionCounts, error)
+	Now() time.Time
 }
 
 // OutboxApp defines what the service layer needs from the outbox
 type OutboxApp interface {
-	InsertPickMadeEvent(ctx context.Context, draftID uuid.UUID, payload []byte) error
+	InsertPickMadeEvent(ctx context.Context, draftID uuid.UUID, payload events.PickMadePayload) error
+	InsertPickSlotReassignedEvent(ctx context.Context, draftID uuid.UUID, payload events.PickSlotReassignedPayload) error
+	InsertPickSkippedEvent(ctx context.Context, draftID uuid.UUID, payload events.PickSkippedPayload) error
+	InsertPickTradeUpdatedEvent(ctx context.Context, draftID uuid.UUID, payload events.PickTradeUpdatedPayload) error
 }
 
-// Service implements the DraftPickService gRPC interface
+// pickSubmitRule limits the picks each user submits in a draft, so a double-clicked pick button
+// reaches the draft once
+var pickSubmitRule = ratelimit.Rule{Limit: 1, Window: time.Second}
+
+// Service implements the DraftPickService gRPC interface. Pick changes are written in the
+// same transaction as the outbox events announcing them, so the draft never moves on
+// without its consumers hearing about it.
 type Service struct {
 	app          PickApp
 	draftService draftv1connect.DraftServiceClient
 	outboxApp    OutboxApp
+	tx           sqlutil.Transactor
+	limiter      ratelimit.Limiter
 }
 
-// NewService creates a new draft pick gRPC service
-func NewService(app PickApp, draftService draftv1connect.DraftServiceClient, outboxApp OutboxApp) *Service {
+// NewService creates a new draft pick gRPC service. The limiter throttles the picks users
+// submit; services that don't take picks from users pass nil.
+func NewService(app PickApp, draftService draftv1connect.DraftServiceClient, outboxApp OutboxApp, tx sqlutil.Transactor, limiter ratelimit.Limiter) *Service {
 	return &Service{
 		app:          app,
 		draftService: draftService,
 		outboxApp:    outboxApp,
+		tx:           tx,
+		limiter:      limiter,
 	}
 }
 
@@ -59,43 +103,283 @@ var _ draftv1connect.DraftPickServiceHandler = (*Service)(nil)
 func (s *Service) MakePick(ctx context.Context, req *connect.Request[draftv1.MakePickRequest]) (*connect.Response[draftv1.MakePickResponse], error) {
 	appReq, err := s.protoToMakePickRequest(req.Msg)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
+	}
+	// Picks without an acting user come from the orchestrator's auto-pick
+	appReq.PickedBy, err = interceptors.ActingUserOrService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if appReq.PickedBy != nil {
+		if err := s.throttlePickSubmission(ctx, appReq); err != nil {
+			return nil, err
+		}
 	}
 
-	err = s.app.MakePick(ctx, appReq)
+	var protoPick *draftv1.DraftPick
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.app.MakePick(ctx, appReq); err != nil {
+			return err
+		}
+
+		// Get the updated pick to return
+		pick, err := s.app.GetDraftPick(ctx, appReq.PickID)
+		if err != nil {
+			return err
+		}
+
+		protoPick, err = s.draftPickToProto(pick)
+		if err != nil {
+			return err
+		}
+
+		// Emit PickMade domain event
+		return s.emitPickMadeEvent(ctx, appReq.DraftID.UUID(), protoPick, false, appReq.PickedBy)
+	})
 	if err != nil {
+		if errors.Is(err, ErrDraftNotInProgress) || errors.Is(err, ErrTeamAbandoned) || errors.Is(err, ErrPickSkipped) ||
+			errors.Is(err, ErrNoRosterSpace) || errors.Is(err, ErrNoCapSpace) || errors.Is(err, ErrNotInExpansionPool) || errors.Is(err, ErrNotInDispersalPool) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if errors.Is(err, ErrPickAlreadyMade) {
+			pick, getErr := s.app.GetDraftPick(ctx, appReq.PickID)
+			if getErr != nil {
+				return nil, connect.NewError(connect.CodeAlreadyExists, err)
+			}
+			return nil, s.alreadyPickedError(pick)
+		}
+		if errors.Is(err, ErrOutOfTurn) {
+			onTheClock, getErr := s.app.GetNextPickForDraft(ctx, appReq.DraftID.UUID())
+			if getErr != nil && !errors.Is(getErr, sql.ErrNoRows) {
+				return nil, connect.NewError(connect.CodeAborted, err)
+			}
+			return nil, s.outOfTurnError(onTheClock)
+		}
+		if errors.Is(err, ErrNotTeamManager) || errors.Is(err, ErrNotCommissioner) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		if errors.Is(err, ErrPickNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Get the updated pick to return
-	pick, err := s.app.GetDraftPick(ctx, appReq.PickID)
+	log.Printf("Pick made: %s for team %s in draft %s", appReq.PlayerID, appReq.TeamID, appReq.DraftID)
+
+	return connect.NewResponse(&draftv1.MakePickResponse{
+		Pick: protoPick,
+	}), nil
+}
+
+// throttlePickSubmission holds a user to pickSubmitRule in each draft. A submission over it is
+// answered as AlreadyPicked when the pick it races has been made, and with CodeResourceExhausted
+// and a Retry-After header otherwise. Should the limiter fail, the submission goes through.
+func (s *Service) throttlePickSubmission(ctx context.Context, req MakePickRequest) error {
+	if s.limiter == nil {
+		return nil
+	}
+
+	key := "make-pick|" + req.DraftID.String() + "|" + req.PickedBy.String()
+	allowed, retryAfter, err := s.limiter.Allow(ctx, key, pickSubmitRule)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		log.Printf("Failed to check pick submission rate limit in draft %s: %v", req.DraftID, err)
+		return nil
 	}
+	if allowed {
+		return nil
+	}
+
+	if pick, err := s.app.GetDraftPick(ctx, req.PickID); err == nil && pick.PlayerID != nil {
+		return s.alreadyPickedError(pick)
+	}
+	connectErr := connect.NewError(connect.CodeResourceExhausted, ErrPickSubmissionThrottled)
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	connectErr.Meta().Set("Retry-After", strconv.Itoa(max(seconds, 1)))
+	return connectErr
+}
 
+// alreadyPickedError answers a submission for a pick that has already been made with
+// CodeAlreadyExists and the pick that won attached as an AlreadyPicked detail
+func (s *Service) alreadyPickedError(pick *models.DraftPick) error {
+	connectErr := connect.NewError(connect.CodeAlreadyExists, ErrPickAlreadyMade)
 	protoPick, err := s.draftPickToProto(pick)
 	if err != nil {
+		return connectErr
+	}
+	detail, err := connect.NewErrorDetail(&draftv1.AlreadyPicked{Pick: protoPick})
+	if err != nil {
+		return connectErr
+	}
+	connectErr.AddDetail(detail)
+	return connectErr
+}
+
+// outOfTurnError is the error of a MakePick for a pick that isn't on the clock, detailing the pick
+// that is, if any is left, so the client can refresh its board
+func (s *Service) outOfTurnError(onTheClock *models.DraftPick) error {
+	connectErr := connect.NewError(connect.CodeAborted, ErrOutOfTurn)
+	outOfTurn := &draftv1.OutOfTurn{}
+	if onTheClock != nil {
+		protoPick, err := s.draftPickToProto(onTheClock)
+		if err != nil {
+			return connectErr
+		}
+		outOfTurn.OnTheClock = protoPick
+	}
+	detail, err := connect.NewErrorDetail(outOfTurn)
+	if err != nil {
+		return connectErr
+	}
+	connectErr.AddDetail(detail)
+	return connectErr
+}
+
+// MakeLatePick makes a pick the draft skipped when its clock ran out, out of board order
+func (s *Service) MakeLatePick(ctx context.Context, req *connect.Request[draftv1.MakeLatePickRequest]) (*connect.Response[draftv1.MakeLatePickResponse], error) {
+	pickID, err := uuidutil.MustParseOrInvalidArg("pick_id", req.Msg.PickId)
+	if err != nil {
+		return nil, err
+	}
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	playerID, err := uuidutil.ParseID[models.PlayerID]("player_id", req.Msg.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+	appReq := MakeLatePickRequest{
+		PickID:   pickID,
+		DraftID:  draftID,
+		PlayerID: playerID,
+	}
+	appReq.PickedBy, err = interceptors.ActingUserOrService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var protoPick *draftv1.DraftPick
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		latePick, err := s.app.MakeLatePick(ctx, appReq)
+		if err != nil {
+			return err
+		}
+
+		protoPick, err = s.draftPickToProto(latePick.Pick)
+		if err != nil {
+			return err
+		}
+
+		// A skipped pick back on the clock is announced like any other pick, so the draft moves
+		// on; otherwise the pick on the clock keeps running
+		return s.emitPickMadeEvent(ctx, appReq.DraftID.UUID(), protoPick, !latePick.OnTheClock, appReq.PickedBy)
+	})
+	if err != nil {
+		if errors.Is(err, ErrDraftNotInProgress) || errors.Is(err, ErrTeamAbandoned) ||
+			errors.Is(err, ErrPickNotSkipped) || errors.Is(err, ErrPickAlreadyMade) || errors.Is(err, ErrNoRosterSpace) || errors.Is(err, ErrNoCapSpace) ||
+			errors.Is(err, ErrNotInExpansionPool) || errors.Is(err, ErrNotInDispersalPool) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if errors.Is(err, ErrNotTeamManager) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Emit PickMade domain event
-	if err := s.emitPickMadeEvent(ctx, appReq.DraftID, protoPick); err != nil {
-		log.Printf("Failed to emit PickMade event: %v", err)
-		// Don't fail the operation, just log
+	return connect.NewResponse(&draftv1.MakeLatePickResponse{
+		Pick: protoPick,
+	}), nil
+}
+
+// SkipPick moves the draft past the pick on the clock once its deadline has passed
+func (s *Service) SkipPick(ctx context.Context, req *connect.Request[draftv1.SkipPickRequest]) (*connect.Response[draftv1.SkipPickResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
 	}
 
-	log.Printf("Pick made: %s for team %s in draft %s", appReq.PlayerID, appReq.TeamID, appReq.DraftID)
+	var protoPick *draftv1.DraftPick
+	pickID, err := uuidutil.MustParseOrInvalidArg("pick_id", req.Msg.PickId)
+	if err != nil {
+		return nil, err
+	}
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		skipped, err := s.app.SkipPick(ctx, SkipPickRequest{
+			PickID:  pickID,
+			DraftID: draftID,
+		})
+		if err != nil {
+			return err
+		}
 
-	return connect.NewResponse(&draftv1.MakePickResponse{
+		protoPick, err = s.draftPickToProto(skipped)
+		if err != nil {
+			return err
+		}
+
+		// Emit PickSkipped domain event so the orchestrator puts the next pick on the clock
+		return s.emitPickSkippedEvent(ctx, skipped)
+	})
+	if err != nil {
+		if errors.Is(err, ErrDraftNotInProgress) || errors.Is(err, ErrPickNotOnTheClock) || errors.Is(err, ErrPickClockRunning) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.SkipPickResponse{
+		Pick: protoPick,
+	}), nil
+}
+
+// SkipPickWithoutRosterSpace forfeits the pick on the clock when its team has no roster space left
+func (s *Service) SkipPickWithoutRosterSpace(ctx context.Context, req *connect.Request[draftv1.SkipPickWithoutRosterSpaceRequest]) (*connect.Response[draftv1.SkipPickWithoutRosterSpaceResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	var protoPick *draftv1.DraftPick
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		forfeited, err := s.app.SkipPickWithoutRosterSpace(ctx, SkipPickWithoutRosterSpaceRequest{
+			DraftID:      draftID,
+			ClockExpired: req.Msg.ClockExpired,
+		})
+		if err != nil || forfeited == nil {
+			return err
+		}
+
+		protoPick, err = s.draftPickToProto(forfeited)
+		if err != nil {
+			return err
+		}
+
+		// Emit PickSkipped domain event so the orchestrator puts the next pick on the clock
+		return s.emitPickSkippedEvent(ctx, forfeited)
+	})
+	if err != nil {
+		if errors.Is(err, ErrDraftNotInProgress) || errors.Is(err, ErrPickClockRunning) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if protoPick == nil {
+		return connect.NewResponse(&draftv1.SkipPickWithoutRosterSpaceResponse{}), nil
+	}
+
+	return connect.NewResponse(&draftv1.SkipPickWithoutRosterSpaceResponse{
 		Pick: protoPick,
 	}), nil
 }
 
 // GetDraftPick retrieves a draft pick by ID
 func (s *Service) GetDraftPick(ctx context.Context, req *connect.Request[draftv1.GetDraftPickRequest]) (*connect.Response[draftv1.GetDraftPickResponse], error) {
-	pickID, err := uuid.Parse(req.Msg.PickId)
+	pickID, err := uuidutil.MustParseOrInvalidArg("pick_id", req.Msg.PickId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	pick, err := s.app.GetDraftPick(ctx, pickID)
@@ -113,20 +397,45 @@ func (s *Service) GetDraftPick(ctx context.Context, req *connect.Request[draftv1
 	}), nil
 }
 
-// GetDraftPicksByDraft retrieves all picks for a draft
+// GetDraftPicksByDraft retrieves a filtered, paginated list of picks for a draft
 func (s *Service) GetDraftPicksByDraft(ctx context.Context, req *connect.Request[draftv1.GetDraftPicksByDraftRequest]) (*connect.Response[draftv1.GetDraftPicksByDraftResponse], error) {
-	draftID, err := uuid.Parse(req.Msg.DraftId)
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
-	picks, err := s.app.GetDraftPicksByDraft(ctx, draftID)
+	filter := DraftPickFilter{
+		OnlyCompleted: req.Msg.OnlyCompleted,
+		OnlyRemaining: req.Msg.OnlyRemaining,
+	}
+	if req.Msg.MinRound != nil {
+		minRound := int(*req.Msg.MinRound)
+		filter.MinRound = &minRound
+	}
+	if req.Msg.MaxRound != nil {
+		maxRound := int(*req.Msg.MaxRound)
+		filter.MaxRound = &maxRound
+	}
+	params := PaginationParams{
+		Limit:  int(req.Msg.Limit),
+		Offset: int(req.Msg.Offset),
+		Page: pagination.Request{
+			PageSize:     int(req.Msg.PageSize),
+			PageToken:    req.Msg.PageToken,
+			IncludeTotal: req.Msg.IncludeTotal,
+		},
+	}
+
+	result, err := s.app.ListDraftPicksByDraft(ctx, draftID, filter, params)
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) || errors.Is(err, pagination.ErrInvalidPageSize) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	protoPicks := make([]*draftv1.DraftPick, len(picks))
-	for i, pick := range picks {
+	protoPicks := make([]*draftv1.DraftPick, len(result.Picks))
+	for i, pick := range result.Picks {
 		protoPick, err := s.draftPickToProto(&pick)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, err)
@@ -134,16 +443,23 @@ func (s *Service) GetDraftPicksByDraft(ctx context.Context, req *connect.Request
 		protoPicks[i] = protoPick
 	}
 
-	return connect.NewResponse(&draftv1.GetDraftPicksByDraftResponse{
-		Picks: protoPicks,
-	}), nil
+	resp := &draftv1.GetDraftPicksByDraftResponse{
+		Picks:         protoPicks,
+		HasMore:       result.HasMore,
+		EventSequence: result.EventSequence,
+		NextPageToken: result.NextPageToken,
+	}
+	if result.Total != nil {
+		resp.Total = int32(*result.Total)
+	}
+	return connect.NewResponse(resp), nil
 }
 
 // GetDraftPicksByRound retrieves picks for a specific round
 func (s *Service) GetDraftPicksByRound(ctx context.Context, req *connect.Request[draftv1.GetDraftPicksByRoundRequest]) (*connect.Response[draftv1.GetDraftPicksByRoundResponse], error) {
-	draftID, err := uuid.Parse(req.Msg.DraftId)
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	picks, err := s.app.GetDraftPicksByRound(ctx, draftID, int(req.Msg.Round))
@@ -167,9 +483,9 @@ func (s *Service) GetDraftPicksByRound(ctx context.Context, req *connect.Request
 
 // GetNextPickForDraft retrieves the next pick for a draft
 func (s *Service) GetNextPickForDraft(ctx context.Context, req *connect.Request[draftv1.GetNextPickForDraftRequest]) (*connect.Response[draftv1.GetNextPickForDraftResponse], error) {
-	draftID, err := uuid.Parse(req.Msg.DraftId)
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	pick, err := s.app.GetNextPickForDraft(ctx, draftID)
@@ -189,9 +505,9 @@ func (s *Service) GetNextPickForDraft(ctx context.Context, req *connect.Request[
 
 // CountRemainingPicks counts remaining picks for a draft
 func (s *Service) CountRemainingPicks(ctx context.Context, req *connect.Request[draftv1.CountRemainingPicksRequest]) (*connect.Response[draftv1.CountRemainingPicksResponse], error) {
-	draftID, err := uuid.Parse(req.Msg.DraftId)
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	count, err := s.app.CountRemainingPicks(ctx, draftID)
@@ -204,11 +520,32 @@ func (s *Service) CountRemainingPicks(ctx context.Context, req *connect.Request[
 	}), nil
 }
 
+// CountRemainingPicksForDrafts counts remaining picks for several drafts in one call
+func (s *Service) CountRemainingPicksForDrafts(ctx context.Context, req *connect.Request[draftv1.CountRemainingPicksForDraftsRequest]) (*connect.Response[draftv1.CountRemainingPicksForDraftsResponse], error) {
+	draftIDs, err := uuidutil.ParseAll("draft_ids", req.Msg.DraftIds)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.app.CountRemainingPicksForDrafts(ctx, draftIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	remaining := make(map[string]int32, len(counts))
+	for draftID, count := range counts {
+		remaining[draftID.String()] = int32(count)
+	}
+	return connect.NewResponse(&draftv1.CountRemainingPicksForDraftsResponse{
+		RemainingPicks: remaining,
+	}), nil
+}
+
 // ClaimNextPickSlot claims the next pick slot for auto-pick
 func (s *Service) ClaimNextPickSlot(ctx context.Context, req *connect.Request[draftv1.ClaimNextPickSlotRequest]) (*connect.Response[draftv1.ClaimNextPickSlotResponse], error) {
-	draftID, err := uuid.Parse(req.Msg.DraftId)
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Validate draft exists and is in progress via draft service
@@ -244,13 +581,16 @@ func (s *Service) ClaimNextPickSlot(ctx context.Context, req *connect.Request[dr
 
 // PrepopulateDraftPicks prepopulates draft picks
 func (s *Service) PrepopulateDraftPicks(ctx context.Context, req *connect.Request[draftv1.PrepopulateDraftPicksRequest]) (*connect.Response[draftv1.PrepopulateDraftPicksResponse], error) {
-	draftID, err := uuid.Parse(req.Msg.DraftId)
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	draftType := s.protoToDraftType(req.Msg.DraftType)
-	settings := s.protoToDraftSettings(req.Msg.Settings)
+	settings, err := s.protoToDraftSettings(req.Msg.Settings)
+	if err != nil {
+		return nil, err
+	}
 
 	err = s.app.PrepopulateDraftPicks(ctx, draftID, draftType, settings)
 	if err != nil {
@@ -267,49 +607,382 @@ func (s *Service) PrepopulateDraftPicks(ctx context.Context, req *connect.Reques
 
 // ListAvailablePlayersForDraft lists available players for a draft
 func (s *Service) ListAvailablePlayersForDraft(ctx context.Context, req *connect.Request[draftv1.ListAvailablePlayersForDraftRequest]) (*connect.Response[draftv1.ListAvailablePlayersForDraftResponse], error) {
-	draftID, err := uuid.Parse(req.Msg.DraftId)
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Validate draft exists via draft service
 	getDraftReq := &draftv1.GetDraftRequest{
 		DraftId: draftID.String(),
 	}
-	_, err = s.draftService.GetDraft(ctx, connect.NewRequest(getDraftReq))
+	draftResp, err := s.draftService.GetDraft(ctx, connect.NewRequest(getDraftReq))
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("draft not found: %w", err))
 	}
 
-	players, err := s.app.ListAvailablePlayersForDraft(ctx, draftID)
+	var (
+		players []AvailablePlayer
+		profile *RankingProfile
+	)
+	if req.Msg.Ranked {
+		var format *models.ScoringFormat
+		if req.Msg.ScoringFormat != nil {
+			f := protoToScoringFormat(*req.Msg.ScoringFormat)
+			format = &f
+		}
+		players, profile, err = s.app.ListRankedAvailablePlayersForDraft(ctx, draftID, format)
+	} else {
+		players, err = s.app.ListAvailablePlayersForDraft(ctx, draftID)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// Expansion drafts only take the players existing teams left unprotected, and dispersal
+	// drafts only the folded team's players
+	switch draftResp.Msg.Draft.GetDraftType() {
+	case draftv1.DraftType_DRAFT_TYPE_EXPANSION:
+		players, err = s.app.RestrictToExpansionPool(ctx, draftID, players)
+	case draftv1.DraftType_DRAFT_TYPE_DISPERSAL:
+		players, err = s.app.RestrictToDispersalPool(ctx, draftID, players)
+	}
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	if req.Msg.OpenPositionsOnly {
+		players, err = s.app.RestrictToOpenPositions(ctx, draftID, players)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	if req.Msg.OpenLineupSlotsOnly {
+		players, err = s.app.RestrictToOpenLineupSlots(ctx, draftID, players)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	if req.Msg.Position != nil {
+		players, err = s.app.RestrictToPosition(ctx, draftID, players, req.Msg.GetPosition())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
 
 	protoPlayers := make([]*draftv1.AvailablePlayer, len(players))
 	for i, player := range players {
-		protoPlayers[i] = &draftv1.AvailablePlayer{
-			Id:       player.ID.String(),
-			FullName: player.FullName,
-			TeamId:   player.TeamID.String(),
-		}
+		protoPlayers[i] = availablePlayerToProto(player)
 	}
 
-	return connect.NewResponse(&draftv1.ListAvailablePlayersForDraftResponse{
+	resp := &draftv1.ListAvailablePlayersForDraftResponse{
 		Players: protoPlayers,
+	}
+	if profile != nil {
+		resp.ScoringFormat = scoringFormatToProto(profile.ScoringFormat)
+		resp.Superflex = profile.Superflex
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ComparePlayers lines up available players side by side for the draft room
+func (s *Service) ComparePlayers(ctx context.Context, req *connect.Request[draftv1.ComparePlayersRequest]) (*connect.Response[draftv1.ComparePlayersResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	playerIDs, err := uuidutil.ParseAll("player_ids", req.Msg.PlayerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	players, profile, err := s.rankedDraftPool(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	compared, err := s.app.ComparePlayers(players, playerIDs)
+	if err != nil {
+		if errors.Is(err, ErrPlayerNotAvailable) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoPlayers := make([]*draftv1.ComparedPlayer, len(compared))
+	for i, comparison := range compared {
+		protoPlayers[i] = &draftv1.ComparedPlayer{
+			Player:         availablePlayerToProto(comparison.Player),
+			PointsOverNext: comparison.PointsOverNext,
+		}
+		if comparison.PositionRank != nil {
+			rank := int32(*comparison.PositionRank)
+			protoPlayers[i].PositionRank = &rank
+		}
+	}
+
+	return connect.NewResponse(&draftv1.ComparePlayersResponse{
+		Players:       protoPlayers,
+		ScoringFormat: scoringFormatToProto(profile.ScoringFormat),
+		Superflex:     profile.Superflex,
+	}), nil
+}
+
+// SuggestQueueAdditions suggests available players for a team's pick queue
+func (s *Service) SuggestQueueAdditions(ctx context.Context, req *connect.Request[draftv1.SuggestQueueAdditionsRequest]) (*connect.Response[draftv1.SuggestQueueAdditionsResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	teamID, err := uuidutil.ParseID[models.TeamID]("team_id", req.Msg.TeamId)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := uuidutil.ParseAll("exclude_player_ids", req.Msg.ExcludePlayerIds)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = 10
+	}
+
+	players, profile, err := s.rankedDraftPool(ctx, draftID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	suggestions, err := s.app.SuggestQueueAdditions(ctx, draftID, teamID, players, exclude, limit)
+	if err != nil {
+		if errors.Is(err, ErrNoDraftPicks) || errors.Is(err, ErrTeamNotInDraft) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoSuggestions := make([]*draftv1.QueueSuggestion, len(suggestions))
+	for i, suggestion := range suggestions {
+		reasons := make([]draftv1.SuggestionReason, len(suggestion.Reasons))
+		for j, reason := range suggestion.Reasons {
+			reasons[j] = suggestionReasonToProto(reason)
+		}
+		protoSuggestions[i] = &draftv1.QueueSuggestion{
+			Player:            availablePlayerToProto(suggestion.Player),
+			Reasons:           reasons,
+			PositionRemaining: int32(suggestion.PositionRemaining),
+		}
+	}
+
+	return connect.NewResponse(&draftv1.SuggestQueueAdditionsResponse{
+		Suggestions:   protoSuggestions,
+		ScoringFormat: scoringFormatToProto(profile.ScoringFormat),
+		Superflex:     profile.Superflex,
+	}), nil
+}
+
+// GetPickSuggestions suggests the best available players for a team's next pick
+func (s *Service) GetPickSuggestions(ctx context.Context, req *connect.Request[draftv1.GetPickSuggestionsRequest]) (*connect.Response[draftv1.GetPickSuggestionsResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	teamID, err := uuidutil.ParseID[models.TeamID]("team_id", req.Msg.TeamId)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = 5
+	}
+
+	players, profile, err := s.rankedDraftPool(ctx, draftID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	suggestions, err := s.app.GetPickSuggestions(ctx, draftID, teamID, players, limit)
+	if err != nil {
+		if errors.Is(err, ErrNoDraftPicks) || errors.Is(err, ErrTeamNotInDraft) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoSuggestions := make([]*draftv1.PickSuggestion, len(suggestions.Suggestions))
+	for i, suggestion := range suggestions.Suggestions {
+		reasons := make([]draftv1.SuggestionReason, len(suggestion.Reasons))
+		for j, reason := range suggestion.Reasons {
+			reasons[j] = suggestionReasonToProto(reason)
+		}
+		protoSuggestions[i] = &draftv1.PickSuggestion{
+			Player:            availablePlayerToProto(suggestion.Player),
+			Reasons:           reasons,
+			PositionRemaining: int32(suggestion.PositionRemaining),
+		}
+		if suggestion.PicksPastRank != nil {
+			past := int32(*suggestion.PicksPastRank)
+			protoSuggestions[i].PicksPastRank = &past
+		}
+	}
+
+	resp := &draftv1.GetPickSuggestionsResponse{
+		Suggestions:   protoSuggestions,
+		ScoringFormat: scoringFormatToProto(profile.ScoringFormat),
+		Superflex:     profile.Superflex,
+	}
+	if suggestions.NextOverallPick != nil {
+		next := int32(*suggestions.NextOverallPick)
+		resp.NextOverallPick = &next
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// rankedDraftPool returns the players a draft can still take, sorted by the rankings for its
+// league's season and scoring format, with the profile of those rankings
+func (s *Service) rankedDraftPool(ctx context.Context, draftID uuid.UUID) ([]AvailablePlayer, *RankingProfile, error) {
+	draftResp, err := s.draftService.GetDraft(ctx, connect.NewRequest(&draftv1.GetDraftRequest{
+		DraftId: draftID.String(),
+	}))
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("draft not found: %w", err))
+	}
+
+	players, profile, err := s.app.ListRankedAvailablePlayersForDraft(ctx, draftID, nil)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch draftResp.Msg.Draft.GetDraftType() {
+	case draftv1.DraftType_DRAFT_TYPE_EXPANSION:
+		players, err = s.app.RestrictToExpansionPool(ctx, draftID, players)
+	case draftv1.DraftType_DRAFT_TYPE_DISPERSAL:
+		players, err = s.app.RestrictToDispersalPool(ctx, draftID, players)
+	}
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return players, profile, nil
+}
+
+func availablePlayerToProto(player AvailablePlayer) *draftv1.AvailablePlayer {
+	protoPlayer := &draftv1.AvailablePlayer{
+		Id:                  player.ID.String(),
+		FullName:            player.FullName,
+		TeamId:              player.TeamID.String(),
+		DepthChartPosition:  player.DepthChartPosition,
+		ProjectedPoints:     player.ProjectedPoints,
+		OwnedPct:            player.OwnedPct,
+		StartedPct:          player.StartedPct,
+		InjuryStatus:        player.InjuryStatus,
+		AverageAuctionPrice: player.AverageAuctionPrice,
+	}
+	if player.AuctionSales != nil {
+		sales := int32(*player.AuctionSales)
+		protoPlayer.AuctionSales = &sales
+	}
+	if player.ByeWeek != nil {
+		byeWeek := int32(*player.ByeWeek)
+		protoPlayer.ByeWeek = &byeWeek
+	}
+	if player.DepthChartDepth != nil {
+		depth := int32(*player.DepthChartDepth)
+		protoPlayer.DepthChartDepth = &depth
+	}
+	if player.Rank != nil {
+		rank := int32(*player.Rank)
+		protoPlayer.Rank = &rank
+	}
+	if player.Position != "" {
+		position := player.Position
+		protoPlayer.Position = &position
+	}
+	return protoPlayer
+}
+
+func suggestionReasonToProto(reason SuggestionReason) draftv1.SuggestionReason {
+	switch reason {
+	case SuggestionReasonLineupNeed:
+		return draftv1.SuggestionReason_SUGGESTION_REASON_LINEUP_NEED
+	case SuggestionReasonScarcePosition:
+		return draftv1.SuggestionReason_SUGGESTION_REASON_SCARCE_POSITION
+	case SuggestionReasonValue:
+		return draftv1.SuggestionReason_SUGGESTION_REASON_VALUE
+	case SuggestionReasonByeConflict:
+		return draftv1.SuggestionReason_SUGGESTION_REASON_BYE_CONFLICT
+	default:
+		return draftv1.SuggestionReason_SUGGESTION_REASON_UNSPECIFIED
+	}
+}
+
+func protoToScoringFormat(format draftv1.ScoringFormat) models.ScoringFormat {
+	switch format {
+	case draftv1.ScoringFormat_SCORING_FORMAT_HALF_PPR:
+		return models.ScoringFormatHalfPPR
+	case draftv1.ScoringFormat_SCORING_FORMAT_PPR:
+		return models.ScoringFormatPPR
+	default:
+		return models.ScoringFormatStandard
+	}
+}
+
+func scoringFormatToProto(format models.ScoringFormat) draftv1.ScoringFormat {
+	switch format {
+	case models.ScoringFormatStandard:
+		return draftv1.ScoringFormat_SCORING_FORMAT_STANDARD
+	case models.ScoringFormatHalfPPR:
+		return draftv1.ScoringFormat_SCORING_FORMAT_HALF_PPR
+	case models.ScoringFormatPPR:
+		return draftv1.ScoringFormat_SCORING_FORMAT_PPR
+	default:
+		return draftv1.ScoringFormat_SCORING_FORMAT_UNSPECIFIED
+	}
+}
+
+// ExportDraftResults renders a draft's full results as CSV or JSON
+func (s *Service) ExportDraftResults(ctx context.Context, req *connect.Request[draftv1.ExportDraftResultsRequest]) (*connect.Response[draftv1.ExportDraftResultsResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.app.ListDraftResults(ctx, draftID)
+	if err != nil {
+		if errors.Is(err, ErrNoDraftPicks) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var (
+		data        []byte
+		contentType string
+		ext         string
+	)
+	switch req.Msg.Format {
+	case draftv1.ExportFormat_EXPORT_FORMAT_CSV:
+		data, err = encodeResultsCSV(results)
+		contentType, ext = "text/csv; charset=utf-8", "csv"
+	case draftv1.ExportFormat_EXPORT_FORMAT_JSON:
+		data, err = encodeResultsJSON(draftID, results, s.app.Now())
+		contentType, ext = "application/json", "json"
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported export format %s", req.Msg.Format))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to encode draft results: %w", err))
+	}
+
+	return connect.NewResponse(&draftv1.ExportDraftResultsResponse{
+		Data:        data,
+		ContentType: contentType,
+		Filename:    fmt.Sprintf("draft-%s-results.%s", draftID, ext),
 	}), nil
 }
 
 // UpdateDraftPickPlayer updates a draft pick's player
 func (s *Service) UpdateDraftPickPlayer(ctx context.Context, req *connect.Request[draftv1.UpdateDraftPickPlayerRequest]) (*connect.Response[draftv1.UpdateDraftPickPlayerResponse], error) {
-	pickID, err := uuid.Parse(req.Msg.PickId)
+	pickID, err := uuidutil.MustParseOrInvalidArg("pick_id", req.Msg.PickId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
-
-	playerID, err := uuid.Parse(req.Msg.PlayerId)
+	playerID, err := uuidutil.MustParseOrInvalidArg("player_id", req.Msg.PlayerId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	updateReq := UpdateDraftPickPlayerRequest{
@@ -338,9 +1011,9 @@ func (s *Service) UpdateDraftPickPlayer(ctx context.Context, req *connect.Reques
 
 // DeleteDraftPicksByDraft deletes all picks for a draft
 func (s *Service) DeleteDraftPicksByDraft(ctx context.Context, req *connect.Request[draftv1.DeleteDraftPicksByDraftRequest]) (*connect.Response[draftv1.DeleteDraftPicksByDraftResponse], error) {
-	draftID, err := uuid.Parse(req.Msg.DraftId)
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	count, err := s.app.DeleteDraftPicksByDraft(ctx, draftID)
@@ -353,32 +1026,382 @@ func (s *Service) DeleteDraftPicksByDraft(ctx context.Context, req *connect.Requ
 	}), nil
 }
 
+type tradeRulesWaivedKey struct{}
+
+// WithoutTradeRules returns a copy of ctx under which ReassignPickSlot moves picks without
+// checking the league's pick trade rules, for reassignments that aren't trades, like a
+// dispersal draft handing out a folded team's picks. Only callers in process can set it.
+func WithoutTradeRules(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tradeRulesWaivedKey{}, true)
+}
+
+// ReassignPickSlot moves an unmade pick slot to another team before the draft starts
+func (s *Service) ReassignPickSlot(ctx context.Context, req *connect.Request[draftv1.ReassignPickSlotRequest]) (*connect.Response[draftv1.ReassignPickSlotResponse], error) {
+	pickID, err := uuidutil.MustParseOrInvalidArg("pick_id", req.Msg.PickId)
+	if err != nil {
+		return nil, err
+	}
+	newTeamID, err := uuidutil.MustParseOrInvalidArg("new_team_id", req.Msg.NewTeamId)
+	if err != nil {
+		return nil, err
+	}
+	reassignedBy, err := interceptors.ActingUserOrService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pick, err := s.app.GetDraftPick(ctx, pickID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	// Cross-domain orchestration: the draft's status is checked again under its lock when the
+	// slot moves, so a draft starting meanwhile can't race the reassignment
+	draftResp, err := s.draftService.GetDraft(ctx, connect.NewRequest(&draftv1.GetDraftRequest{
+		DraftId: pick.DraftID.String(),
+	}))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("draft not found: %w", err))
+	}
+	draft := draftResp.Msg.Draft
+	if draft.Status != draftv1.DraftStatus_DRAFT_STATUS_NOT_STARTED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("pick slots can only be reassigned before the draft starts (status: %s)", draft.Status))
+	}
+
+	// The receiving team must be part of this draft
+	inDraft := false
+	for _, teamID := range draft.GetSettings().GetDraftOrder() {
+		if teamID == newTeamID.String() {
+			inDraft = true
+			break
+		}
+	}
+	if !inDraft {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("team %s is not in the draft order", newTeamID))
+	}
+
+	var reassignment *PickSlotReassignment
+	var protoPick *draftv1.DraftPick
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		reassignment, err = s.app.ReassignPickSlot(ctx, ReassignPickSlotRequest{
+			DraftID:        models.DraftID(pick.DraftID),
+			PickID:         pickID,
+			NewTeamID:      newTeamID,
+			Reason:         req.Msg.Reason,
+			ReassignedBy:   reassignedBy,
+			SkipTradeRules: ctx.Value(tradeRulesWaivedKey{}) == true,
+		})
+		if err != nil {
+			return err
+		}
+
+		protoPick, err = s.draftPickToProto(reassignment.Pick)
+		if err != nil {
+			return err
+		}
+
+		// Emit PickSlotReassigned domain event so the board reflects the traded pick
+		return s.emitPickSlotReassignedEvent(ctx, reassignment, req.Msg.GetReason())
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotPickHolder) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		if errors.Is(err, ErrPickAlreadyMade) || errors.Is(err, ErrPickTradeRestricted) || errors.Is(err, ErrPickSlotsLocked) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.ReassignPickSlotResponse{
+		Pick:           protoPick,
+		PreviousTeamId: reassignment.FromTeamID.String(),
+	}), nil
+}
+
+// ProposePickTrade offers the pick on the clock to another team, in drafts that allow live pick
+// trades. The pick clock stops for the draft's negotiation window while the offer is open.
+func (s *Service) ProposePickTrade(ctx context.Context, req *connect.Request[draftv1.ProposePickTradeRequest]) (*connect.Response[draftv1.ProposePickTradeResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	pickID, err := uuidutil.MustParseOrInvalidArg("pick_id", req.Msg.PickId)
+	if err != nil {
+		return nil, err
+	}
+	fromTeamID, err := uuidutil.ParseID[models.TeamID]("from_team_id", req.Msg.FromTeamId)
+	if err != nil {
+		return nil, err
+	}
+	toTeamID, err := uuidutil.ParseID[models.TeamID]("to_team_id", req.Msg.ToTeamId)
+	if err != nil {
+		return nil, err
+	}
+	appReq := ProposePickTradeRequest{
+		DraftID:    draftID,
+		PickID:     pickID,
+		FromTeamID: fromTeamID,
+		ToTeamID:   toTeamID,
+	}
+	appReq.RequestedPickID, err = uuidutil.ParseOptional("requested_pick_id", req.Msg.RequestedPickId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Message != "" {
+		appReq.Message = &req.Msg.Message
+	}
+	appReq.ProposedBy, err = interceptors.ActingUserOrService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if appReq.FromTeamID == appReq.ToTeamID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a pick can't be offered to the team holding it"))
+	}
+
+	// Cross-domain orchestration: the draft decides whether its picks can be traded live, for how
+	// long the clock stops, and which teams can take part
+	draftResp, err := s.draftService.GetDraft(ctx, connect.NewRequest(&draftv1.GetDraftRequest{
+		DraftId: draftID.String(),
+	}))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("draft not found: %w", err))
+	}
+	draft := draftResp.Msg.Draft
+	liveTrades := draft.GetSettings().GetLivePickTrades()
+	if liveTrades == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("draft doesn't allow live pick trades"))
+	}
+	if draft.DraftType == draftv1.DraftType_DRAFT_TYPE_AUCTION {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("auction drafts have no picks on the clock to trade"))
+	}
+	inDraft := false
+	for _, teamID := range draft.GetSettings().GetDraftOrder() {
+		if teamID == appReq.ToTeamID.String() {
+			inDraft = true
+			break
+		}
+	}
+	if !inDraft {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("team %s is not in the draft order", appReq.ToTeamID))
+	}
+	appReq.Window = models.LivePickTradeSettings{NegotiationWindowSec: int(liveTrades.NegotiationWindowSec)}.Window()
+
+	var trade *PickTrade
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		trade, err = s.app.ProposePickTrade(ctx, appReq)
+		if err != nil {
+			return err
+		}
+
+		// Emit PickTradeUpdated domain event so the room sees the offer and the orchestrator
+		// times it and moves the pick timer back
+		return s.emitPickTradeUpdatedEvent(ctx, trade, trade.Offer.OpenedAt)
+	})
+	if err != nil {
+		return nil, connect.NewError(pickTradeErrorCode(err), err)
+	}
+
+	resp := &draftv1.ProposePickTradeResponse{
+		Offer: s.pickTradeOfferToProto(trade.Offer),
+	}
+	if trade.Deadline != nil {
+		resp.Deadline = timestamppb.New(*trade.Deadline)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RespondToPickTrade accepts or declines a live pick trade offer as the team it was made to, or
+// withdraws it as the team that made it. Accepting moves the picks and hands the receiving team
+// the pick on the clock.
+func (s *Service) RespondToPickTrade(ctx context.Context, req *connect.Request[draftv1.RespondToPickTradeRequest]) (*connect.Response[draftv1.RespondToPickTradeResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	offerID, err := uuidutil.MustParseOrInvalidArg("offer_id", req.Msg.OfferId)
+	if err != nil {
+		return nil, err
+	}
+	fantasyTeamID, err := uuidutil.ParseID[models.TeamID]("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	appReq := RespondToPickTradeRequest{
+		DraftID: draftID,
+		OfferID: offerID,
+		TeamID:  fantasyTeamID,
+		Accept:  req.Msg.Accept,
+	}
+	appReq.RespondedBy, err = interceptors.ActingUserOrService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var trade *PickTrade
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		trade, err = s.app.RespondToPickTrade(ctx, appReq)
+		if err != nil {
+			return err
+		}
+		return s.emitPickTradeClosedEvents(ctx, trade)
+	})
+	if err != nil {
+		return nil, connect.NewError(pickTradeErrorCode(err), err)
+	}
+
+	resp := &draftv1.RespondToPickTradeResponse{
+		Offer: s.pickTradeOfferToProto(trade.Offer),
+	}
+	if trade.Deadline != nil {
+		resp.Deadline = timestamppb.New(*trade.Deadline)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ExpirePickTrade expires a live pick trade offer once its negotiation window has passed. The
+// orchestrator calls it when the window closes; an offer closed already is left as it is.
+func (s *Service) ExpirePickTrade(ctx context.Context, req *connect.Request[draftv1.ExpirePickTradeRequest]) (*connect.Response[draftv1.ExpirePickTradeResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	offerID, err := uuidutil.MustParseOrInvalidArg("offer_id", req.Msg.OfferId)
+	if err != nil {
+		return nil, err
+	}
+
+	var trade *PickTrade
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		trade, err = s.app.ExpirePickTrade(ctx, draftID, offerID)
+		if err != nil {
+			return err
+		}
+		return s.emitPickTradeClosedEvents(ctx, trade)
+	})
+	if err != nil {
+		return nil, connect.NewError(pickTradeErrorCode(err), err)
+	}
+
+	return connect.NewResponse(&draftv1.ExpirePickTradeResponse{
+		Offer: s.pickTradeOfferToProto(trade.Offer),
+	}), nil
+}
+
+// pickTradeErrorCode maps live pick trade errors to connect codes
+func pickTradeErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, ErrPickTradeOfferNotFound):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrNotTeamManager), errors.Is(err, ErrNotPickTradeParty):
+		return connect.CodePermissionDenied
+	case errors.Is(err, ErrPickTradeOfferOpen):
+		return connect.CodeAlreadyExists
+	case errors.Is(err, ErrDraftNotInProgress), errors.Is(err, ErrPickNotOnTheClock), errors.Is(err, ErrTeamAbandoned),
+		errors.Is(err, ErrPickTradeRestricted), errors.Is(err, ErrPickAlreadyMade):
+		return connect.CodeFailedPrecondition
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// ReactToPick leaves the acting user's reaction on a made pick, or takes it back. The gateway
+// relays the counts it returns to the draft room.
+func (s *Service) ReactToPick(ctx context.Context, req *connect.Request[draftv1.ReactToPickRequest]) (*connect.Response[draftv1.ReactToPickResponse], error) {
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	pickID, err := uuidutil.MustParseOrInvalidArg("pick_id", req.Msg.PickId)
+	if err != nil {
+		return nil, err
+	}
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("sign in to react to picks"))
+	}
+
+	counts, err := s.app.ReactToPick(ctx, ReactToPickRequest{
+		DraftID:  draftID,
+		PickID:   pickID,
+		UserID:   actingUser,
+		Reaction: models.PickReaction(req.Msg.Reaction),
+		Remove:   req.Msg.Remove,
+	})
+	if err != nil {
+		return nil, connect.NewError(pickReactionErrorCode(err), err)
+	}
+
+	return connect.NewResponse(&draftv1.ReactToPickResponse{
+		Reactions: s.pickReactionCountsToProto(*counts),
+	}), nil
+}
+
+// ListPickReactions lists the reaction counts of a draft's picks that have any, in draft order
+func (s *Service) ListPickReactions(ctx context.Context, req *connect.Request[draftv1.ListPickReactionsRequest]) (*connect.Response[draftv1.ListPickReactionsResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	picks, err := s.app.ListPickReactions(ctx, draftID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &draftv1.ListPickReactionsResponse{
+		Picks: make([]*draftv1.PickReactions, len(picks)),
+	}
+	for i, pick := range picks {
+		resp.Picks[i] = s.pickReactionCountsToProto(pick)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// pickReactionErrorCode maps pick reaction errors to connect codes
+func pickReactionErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, ErrUnknownReaction):
+		return connect.CodeInvalidArgument
+	case errors.Is(err, ErrPickNotFound):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrPickNotMade):
+		return connect.CodeFailedPrecondition
+	default:
+		return connect.CodeInternal
+	}
+}
+
 // Conversion methods between proto and app layer models
 
 func (s *Service) protoToMakePickRequest(proto *draftv1.MakePickRequest) (MakePickRequest, error) {
-	pickID, err := uuid.Parse(proto.PickId)
+	pickID, err := uuidutil.MustParseOrInvalidArg("pick_id", proto.PickId)
 	if err != nil {
 		return MakePickRequest{}, err
 	}
-	draftID, err := uuid.Parse(proto.DraftId)
+	draftID, err := uuidutil.ParseID[models.DraftID]("draft_id", proto.DraftId)
 	if err != nil {
 		return MakePickRequest{}, err
 	}
-	teamID, err := uuid.Parse(proto.TeamId)
+	teamID, err := uuidutil.ParseID[models.TeamID]("team_id", proto.TeamId)
 	if err != nil {
 		return MakePickRequest{}, err
 	}
-	playerID, err := uuid.Parse(proto.PlayerId)
+	playerID, err := uuidutil.ParseID[models.PlayerID]("player_id", proto.PlayerId)
 	if err != nil {
 		return MakePickRequest{}, err
 	}
-
 	return MakePickRequest{
-		PickID:      pickID,
-		DraftID:     draftID,
-		TeamID:      teamID,
-		PlayerID:    playerID,
-		OverallPick: int(proto.OverallPick),
+		PickID:       pickID,
+		DraftID:      draftID,
+		TeamID:       teamID,
+		PlayerID:     playerID,
+		OverallPick:  int(proto.OverallPick),
+		OverrideTurn: proto.OverrideTurn,
 	}, nil
 }
 
@@ -391,6 +1414,7 @@ func (s *Service) draftPickToProto(pick *models.DraftPick) (*draftv1.DraftPick,
 		OverallPick: int32(pick.OverallPick),
 		TeamId:      pick.TeamID.String(),
 		KeeperPick:  pick.KeeperPick,
+		Forfeited:   pick.Forfeited,
 	}
 
 	if pick.PlayerID != nil {
@@ -401,6 +1425,10 @@ func (s *Service) draftPickToProto(pick *models.DraftPick) (*draftv1.DraftPick,
 		protoPick.PickedAt = timestamppb.New(*pick.PickedAt)
 	}
 
+	if pick.SkippedAt != nil {
+		protoPick.SkippedAt = timestamppb.New(*pick.SkippedAt)
+	}
+
 	if pick.AuctionAmount != nil {
 		protoPick.AuctionAmount = pick.AuctionAmount
 	}
@@ -408,6 +1436,67 @@ func (s *Service) draftPickToProto(pick *models.DraftPick) (*draftv1.DraftPick,
 	return protoPick, nil
 }
 
+func (s *Service) pickTradeOfferToProto(offer *models.PickTradeOffer) *draftv1.PickTradeOffer {
+	protoOffer := &draftv1.PickTradeOffer{
+		Id:         offer.ID.String(),
+		DraftId:    offer.DraftID.String(),
+		PickId:     offer.PickID.String(),
+		FromTeamId: offer.FromTeamID.String(),
+		ToTeamId:   offer.ToTeamID.String(),
+		Message:    offer.Message,
+		Status:     s.pickTradeOfferStatusToProto(offer.Status),
+		OpenedAt:   timestamppb.New(offer.OpenedAt),
+		ExpiresAt:  timestamppb.New(offer.ExpiresAt),
+	}
+	if offer.ClosedAt != nil {
+		protoOffer.ClosedAt = timestamppb.New(*offer.ClosedAt)
+	}
+	if offer.RequestedPickID != nil {
+		requestedPickID := offer.RequestedPickID.String()
+		protoOffer.RequestedPickId = &requestedPickID
+	}
+	if offer.ProposedBy != nil {
+		proposedBy := offer.ProposedBy.String()
+		protoOffer.ProposedBy = &proposedBy
+	}
+	if offer.RespondedBy != nil {
+		respondedBy := offer.RespondedBy.String()
+		protoOffer.RespondedBy = &respondedBy
+	}
+	return protoOffer
+}
+
+func (s *Service) pickReactionCountsToProto(counts models.PickReactionCounts) *draftv1.PickReactions {
+	protoCounts := &draftv1.PickReactions{
+		PickId: counts.PickID.String(),
+		Counts: make([]*draftv1.PickReactionCount, len(counts.Counts)),
+	}
+	for i, count := range counts.Counts {
+		protoCounts.Counts[i] = &draftv1.PickReactionCount{
+			Reaction: string(count.Reaction),
+			Count:    int32(count.Count),
+		}
+	}
+	return protoCounts
+}
+
+func (s *Service) pickTradeOfferStatusToProto(status models.PickTradeOfferStatus) draftv1.PickTradeOfferStatus {
+	switch status {
+	case models.PickTradeOfferStatusOpen:
+		return draftv1.PickTradeOfferStatus_PICK_TRADE_OFFER_STATUS_OPEN
+	case models.PickTradeOfferStatusAccepted:
+		return draftv1.PickTradeOfferStatus_PICK_TRADE_OFFER_STATUS_ACCEPTED
+	case models.PickTradeOfferStatusDeclined:
+		return draftv1.PickTradeOfferStatus_PICK_TRADE_OFFER_STATUS_DECLINED
+	case models.PickTradeOfferStatusWithdrawn:
+		return draftv1.PickTradeOfferStatus_PICK_TRADE_OFFER_STATUS_WITHDRAWN
+	case models.PickTradeOfferStatusExpired:
+		return draftv1.PickTradeOfferStatus_PICK_TRADE_OFFER_STATUS_EXPIRED
+	default:
+		return draftv1.PickTradeOfferStatus_PICK_TRADE_OFFER_STATUS_UNSPECIFIED
+	}
+}
+
 func (s *Service) protoToDraftType(protoType draftv1.DraftType) models.DraftType {
 	switch protoType {
 	case draftv1.DraftType_DRAFT_TYPE_SNAKE:
@@ -416,44 +1505,120 @@ func (s *Service) protoToDraftType(protoType draftv1.DraftType) models.DraftType
 		return models.DraftTypeAuction
 	case draftv1.DraftType_DRAFT_TYPE_ROOKIE:
 		return models.DraftTypeRookie
+	case draftv1.DraftType_DRAFT_TYPE_EXPANSION:
+		return models.DraftTypeExpansion
+	case draftv1.DraftType_DRAFT_TYPE_DISPERSAL:
+		return models.DraftTypeDispersal
 	default:
 		return models.DraftTypeSnake // default fallback
 	}
 }
 
-func (s *Service) protoToDraftSettings(proto *draftv1.DraftSettings) models.DraftSettings {
-	settings := models.DraftSettings{
-		Rounds:             int(proto.Rounds),
-		TimePerPickSec:     int(proto.TimePerPickSec),
-		ThirdRoundReversal: proto.ThirdRoundReversal,
-		BudgetPerTeam:      proto.BudgetPerTeam,
-		MinBidIncrement:    proto.MinBidIncrement,
+// protoToTypeSettings sets the settings of the draft's type from the oneof in proto
+func (s *Service) protoToTypeSettings(proto *draftv1.DraftSettings, settings *models.DraftSettings) {
+	switch typeSettings := proto.TypeSettings.(type) {
+	case *draftv1.DraftSettings_Snake:
+		settings.Snake = &models.SnakeSettings{
+			OrderMode: s.protoToDraftOrderMode(typeSettings.Snake.GetOrderMode()),
+		}
+	case *draftv1.DraftSettings_Auction:
+		settings.Auction = &models.AuctionSettings{
+			BudgetPerTeam:        typeSettings.Auction.GetBudgetPerTeam(),
+			MinBidIncrement:      typeSettings.Auction.GetMinBidIncrement(),
+			TimePerNominationSec: int(typeSettings.Auction.GetTimePerNominationSec()),
+		}
+	case *draftv1.DraftSettings_Rookie:
+		settings.Rookie = &models.RookieSettings{
+			OrderMode: s.protoToDraftOrderMode(typeSettings.Rookie.GetOrderMode()),
+		}
 	}
+}
 
-	// Convert optional int32 to int pointer
-	if proto.TimePerNominationSec != nil {
-		timePerNom := int(*proto.TimePerNominationSec)
-		settings.TimePerNominationSec = &timePerNom
+func (s *Service) protoToDraftOrderMode(protoMode draftv1.DraftOrderMode) models.DraftOrderMode {
+	switch protoMode {
+	case draftv1.DraftOrderMode_DRAFT_ORDER_MODE_SNAKE:
+		return models.DraftOrderModeSnake
+	case draftv1.DraftOrderMode_DRAFT_ORDER_MODE_THIRD_ROUND_REVERSAL:
+		return models.DraftOrderModeThirdRoundReversal
+	case draftv1.DraftOrderMode_DRAFT_ORDER_MODE_LINEAR:
+		return models.DraftOrderModeLinear
+	default:
+		return "" // unset; snakes
 	}
+}
+
+func (s *Service) protoToDraftSettings(proto *draftv1.DraftSettings) (models.DraftSettings, error) {
+	settings := models.DraftSettings{
+		Rounds:                      int(proto.Rounds),
+		TimePerPickSec:              int(proto.TimePerPickSec),
+		DeferPicksOnTimeout:         proto.DeferPicksOnTimeout,
+		SkipPicksWithoutRosterSpace: proto.SkipPicksWithoutRosterSpace,
+		RequireAllTeamsReady:        proto.RequireAllTeamsReady,
+		ProtectedPlayersPerTeam:     int(proto.ProtectedPlayersPerTeam),
+		MaxPlayersLostPerTeam:       int(proto.MaxPlayersLostPerTeam),
+	}
+
+	s.protoToTypeSettings(proto, &settings)
 
 	// Convert draft order strings to UUIDs
 	if len(proto.DraftOrder) > 0 {
-		settings.DraftOrder = make([]uuid.UUID, len(proto.DraftOrder))
-		for i, teamIDStr := range proto.DraftOrder {
-			if teamID, err := uuid.Parse(teamIDStr); err == nil {
-				settings.DraftOrder[i] = teamID
+		draftOrder, err := uuidutil.ParseAll("draft_order", proto.DraftOrder)
+		if err != nil {
+			return models.DraftSettings{}, err
+		}
+		settings.DraftOrder = draftOrder
+	}
+
+	// Convert per-round timer overrides
+	if len(proto.RoundTimers) > 0 {
+		settings.RoundTimers = make([]models.RoundTimer, len(proto.RoundTimers))
+		for i, t := range proto.RoundTimers {
+			settings.RoundTimers[i] = models.RoundTimer{
+				FromRound:      int(t.FromRound),
+				ToRound:        int(t.ToRound),
+				TimePerPickSec: int(t.TimePerPickSec),
 			}
 		}
 	}
+	if len(proto.ClockWarningPercents) > 0 {
+		settings.ClockWarningPercents = make([]int, len(proto.ClockWarningPercents))
+		for i, percent := range proto.ClockWarningPercents {
+			settings.ClockWarningPercents[i] = int(percent)
+		}
+	}
+	if proto.PauseWindow != nil {
+		settings.PauseWindow = &models.PauseWindow{
+			Start:    proto.PauseWindow.Start,
+			End:      proto.PauseWindow.End,
+			Timezone: proto.PauseWindow.Timezone,
+		}
+	}
+	if proto.PauseVote != nil {
+		settings.PauseVote = &models.PauseVoteSettings{
+			WindowSec:        int(proto.PauseVote.WindowSec),
+			ThresholdPercent: int(proto.PauseVote.ThresholdPercent),
+		}
+	}
+	if proto.CommissionerDisconnectPause != nil {
+		settings.CommissionerDisconnectPause = &models.CommissionerDisconnectPauseSettings{
+			AfterMinutes: int(proto.CommissionerDisconnectPause.AfterMinutes),
+		}
+	}
+	if proto.LivePickTrades != nil {
+		settings.LivePickTrades = &models.LivePickTradeSettings{
+			NegotiationWindowSec: int(proto.LivePickTrades.NegotiationWindowSec),
+		}
+	}
 
-	return settings
+	return settings, nil
 }
 
 // Event emission helper method
 
-// emitPickMadeEvent emits a PickMade event to the outbox
-func (s *Service) emitPickMadeEvent(ctx context.Context, draftID uuid.UUID, pick *draftv1.DraftPick) error {
-	madeAt := time.Now()
+// emitPickMadeEvent emits a PickMade event to the outbox. late marks a skipped pick made
+// while another pick is on the clock; pickedBy is the user who made the pick, nil for auto-picks.
+func (s *Service) emitPickMadeEvent(ctx context.Context, draftID uuid.UUID, pick *draftv1.DraftPick, late bool, pickedBy *uuid.UUID) error {
+	madeAt := s.app.Now()
 
 	// Create PickMade payload
 	payload := events.PickMadePayload{
@@ -464,14 +1629,118 @@ func (s *Service) emitPickMadeEvent(ctx context.Context, draftID uuid.UUID, pick
 		Pick:        int(pick.Pick),
 		OverallPick: int(pick.OverallPick),
 		MadeAt:      madeAt,
+		Late:        late,
+	}
+	if pickedBy != nil {
+		payload.PickedBy = pickedBy.String()
 	}
 
-	// Marshal payload to JSON
-	payloadBytes, err := json.Marshal(payload)
+	// Carry display data so draft boards can render the pick without resolving IDs.
+	// The pick is still announced with IDs alone if the lookup fails.
+	pickID, err := uuid.Parse(pick.Id)
+	if err != nil {
+		return fmt.Errorf("invalid pick ID %q: %w", pick.Id, err)
+	}
+	announcement, err := s.app.GetPickAnnouncement(ctx, pickID)
 	if err != nil {
-		return fmt.Errorf("failed to marshal PickMade payload: %w", err)
+		log.Printf("Failed to load display data for pick %s: %v", pick.Id, err)
+	} else {
+		payload.TeamName = announcement.TeamName
+		payload.PlayerName = announcement.PlayerName
+		payload.PlayerPosition = announcement.PlayerPosition
+		payload.NFLTeamCode = announcement.NFLTeamCode
 	}
 
 	// Insert into outbox
-	return s.outboxApp.InsertPickMadeEvent(ctx, draftID, payloadBytes)
+	return s.outboxApp.InsertPickMadeEvent(ctx, draftID, payload)
+}
+
+// emitPickSlotReassignedEvent emits a PickSlotReassigned event to the outbox
+func (s *Service) emitPickSlotReassignedEvent(ctx context.Context, reassignment *PickSlotReassignment, reason string) error {
+	pick := reassignment.Pick
+
+	payload := events.PickSlotReassignedPayload{
+		PickID:       pick.ID.String(),
+		Round:        pick.Round,
+		Pick:         pick.Pick,
+		OverallPick:  pick.OverallPick,
+		FromTeamID:   reassignment.FromTeamID.String(),
+		ToTeamID:     pick.TeamID.String(),
+		Reason:       reason,
+		ReassignedAt: s.app.Now(),
+	}
+
+	return s.outboxApp.InsertPickSlotReassignedEvent(ctx, pick.DraftID, payload)
+}
+
+// emitPickSkippedEvent emits a PickSkipped event to the outbox
+func (s *Service) emitPickSkippedEvent(ctx context.Context, pick *models.DraftPick) error {
+	payload := events.PickSkippedPayload{
+		PickID:      pick.ID.String(),
+		TeamID:      pick.TeamID.String(),
+		Round:       pick.Round,
+		Pick:        pick.Pick,
+		OverallPick: pick.OverallPick,
+		SkippedAt:   s.app.Now(),
+	}
+	if pick.SkippedAt != nil {
+		payload.SkippedAt = *pick.SkippedAt
+	}
+	// Skipped picks are only forfeited when their team's roster is full
+	if pick.Forfeited {
+		payload.Forfeited = true
+		payload.Reason = events.SkipReasonNoRosterSpace
+	}
+
+	return s.outboxApp.InsertPickSkippedEvent(ctx, pick.DraftID, payload)
+}
+
+// emitPickTradeClosedEvents emits a PickTradeUpdated event for an offer that just closed, after
+// a PickSlotReassigned event for each pick an accepted offer moved. Nothing is emitted for an
+// offer that had closed already.
+func (s *Service) emitPickTradeClosedEvents(ctx context.Context, trade *PickTrade) error {
+	if !trade.Changed {
+		return nil
+	}
+	for i := range trade.Reassignments {
+		if err := s.emitPickSlotReassignedEvent(ctx, &trade.Reassignments[i], pickTradeReason); err != nil {
+			return err
+		}
+	}
+	updatedAt := s.app.Now()
+	if trade.Offer.ClosedAt != nil {
+		updatedAt = *trade.Offer.ClosedAt
+	}
+	return s.emitPickTradeUpdatedEvent(ctx, trade, updatedAt)
+}
+
+// emitPickTradeUpdatedEvent emits a PickTradeUpdated event to the outbox
+func (s *Service) emitPickTradeUpdatedEvent(ctx context.Context, trade *PickTrade, updatedAt time.Time) error {
+	offer := trade.Offer
+	payload := events.PickTradeUpdatedPayload{
+		DraftID:    offer.DraftID.String(),
+		OfferID:    offer.ID.String(),
+		PickID:     offer.PickID.String(),
+		FromTeamID: offer.FromTeamID.String(),
+		ToTeamID:   offer.ToTeamID.String(),
+		Status:     string(offer.Status),
+		ExpiresAt:  offer.ExpiresAt,
+		TimeoutAt:  trade.Deadline,
+		ClosedAt:   offer.ClosedAt,
+		UpdatedAt:  updatedAt,
+	}
+	if offer.RequestedPickID != nil {
+		payload.RequestedPickID = offer.RequestedPickID.String()
+	}
+	if offer.Message != nil {
+		payload.Message = *offer.Message
+	}
+	if offer.ProposedBy != nil {
+		payload.ProposedBy = offer.ProposedBy.String()
+	}
+	if offer.RespondedBy != nil {
+		payload.RespondedBy = offer.RespondedBy.String()
+	}
+
+	return s.outboxApp.InsertPickTradeUpdatedEvent(ctx, offer.DraftID, payload)
 }
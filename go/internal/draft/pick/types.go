@@ -1,9 +1,106 @@
 package pick
 
 import (
+	"errors"
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
 )
 
+// ErrPickAlreadyMade is returned when a pick or slot change targets a pick that already has a player
+var ErrPickAlreadyMade = errors.New("pick has already been made")
+
+// ErrPickSubmissionThrottled is returned when a user submits picks in a draft faster than
+// pickSubmitRule allows
+var ErrPickSubmissionThrottled = errors.New("picks are being submitted too fast, try again in a moment")
+
+// ErrDraftNotInProgress is returned when a pick is made while the draft is not running
+var ErrDraftNotInProgress = errors.New("draft is not in progress")
+
+// ErrNoDraftPicks is returned when a draft's picks have not been generated
+var ErrNoDraftPicks = errors.New("draft has no picks")
+
+// ErrTeamAbandoned is returned when a user makes a pick for a team the commissioner abandoned
+var ErrTeamAbandoned = errors.New("team has been abandoned and can no longer pick")
+
+// ErrOutOfTurn is returned when MakePick targets a pick other than the one on the clock, most often
+// because the client's board is behind
+var ErrOutOfTurn = errors.New("pick is not on the clock; refresh the board")
+
+// ErrNotCommissioner is returned when a user other than the commissioner makes a pick out of turn
+var ErrNotCommissioner = errors.New("only the commissioner can make a pick out of turn")
+
+// ErrPickSkipped is returned when a skipped pick is made through MakePick before it is back on the clock
+var ErrPickSkipped = errors.New("pick was skipped and must be made as a late pick")
+
+// ErrPickNotSkipped is returned when a late pick targets a pick the draft hasn't skipped
+var ErrPickNotSkipped = errors.New("pick has not been skipped")
+
+// ErrPickNotOnTheClock is returned when a skip targets a pick other than the one on the clock
+var ErrPickNotOnTheClock = errors.New("pick is not on the clock")
+
+// ErrPickClockRunning is returned when a pick is skipped before its deadline has passed
+var ErrPickClockRunning = errors.New("pick clock has not run out")
+
+// ErrNoRosterSpace is returned when a pick is made for a player its team has no roster space for
+var ErrNoRosterSpace = errors.New("team has no roster space for the player")
+
+// ErrNoCapSpace is returned when a pick is made for a team that can't fit the player's salary
+// under its league's salary cap
+var ErrNoCapSpace = errors.New("team has no salary cap space for the player")
+
+// ErrNotTeamManager is returned when a user makes a pick for a team they don't own, co-manage or
+// stand in for as its delegate and aren't the commissioner of
+var ErrNotTeamManager = errors.New("only the team's owner, a co-manager, its delegate or the commissioner can make this pick")
+
+// ErrPickSlotsLocked is returned when a pick slot is reassigned after its draft has started
+var ErrPickSlotsLocked = errors.New("pick slots can only be reassigned before the draft starts")
+
+// ErrNotPickHolder is returned when a user reassigns a pick slot held by a team they don't
+// manage and aren't the commissioner of
+var ErrNotPickHolder = errors.New("only a manager of the team holding the pick or the commissioner can reassign it")
+
+// ErrNotInExpansionPool is returned when an expansion draft picks a player no existing team has
+// left exposed to it
+var ErrNotInExpansionPool = errors.New("player is not in the expansion pool")
+
+// ErrPlayerNotAvailable is returned when players compared in a draft room include one that was
+// already picked or that the draft can't take
+var ErrPlayerNotAvailable = errors.New("player is not available in the draft")
+
+// ErrTeamNotInDraft is returned when queue suggestions are asked for a team without picks in the draft
+var ErrTeamNotInDraft = errors.New("team has no picks in the draft")
+
+// ErrNotInDispersalPool is returned when a dispersal draft picks a player the folded team didn't
+// roster
+var ErrNotInDispersalPool = errors.New("player is not in the dispersal pool")
+
+// ErrPickTradeRestricted is returned when a pick slot is reassigned against its league's pick
+// trade rules; the wrapping error names the rule
+var ErrPickTradeRestricted = errors.New("pick can't be traded")
+
+// ErrPickTradeOfferOpen is returned when a live pick trade is offered while the draft has one open
+var ErrPickTradeOfferOpen = errors.New("draft already has a pick trade offer open")
+
+// ErrPickTradeOfferNotFound is returned when a live pick trade offer isn't in the draft
+var ErrPickTradeOfferNotFound = errors.New("pick trade offer not found")
+
+// ErrNotPickTradeParty is returned when a team answers a live pick trade offer it isn't part of,
+// or the team making the offer tries to accept it
+var ErrNotPickTradeParty = errors.New("only the team offered the pick can accept it, and only the teams in the offer can turn it down")
+
+// ErrPickNotFound is returned when a pick is made, or a reaction left, on a pick that isn't in
+// the draft
+var ErrPickNotFound = errors.New("pick not found")
+
+// ErrPickNotMade is returned when a reaction is left on a pick that hasn't been made yet
+var ErrPickNotMade = errors.New("only picks that have been made can be reacted to")
+
+// ErrUnknownReaction is returned when a pick is reacted to with an emoji that isn't offered
+var ErrUnknownReaction = errors.New("unknown reaction")
+
 // CreateDraftPickRequest represents a request to create a new draft pick
 type CreateDraftPickRequest struct {
 	ID            uuid.UUID  `json:"id"`
@@ -27,10 +124,104 @@ type UpdateDraftPickPlayerRequest struct {
 // MakePickRequest represents a request to make a draft pick
 type MakePickRequest struct {
 	PickID      uuid.UUID `json:"pick_id"`
-	PlayerID    uuid.UUID `json:"player_id"`
-	DraftID     uuid.UUID `json:"draft_id"`
-	TeamID      uuid.UUID `json:"team_id"`
-	OverallPick int       `json:"overall_pick"`
+	PlayerID    models.PlayerID `json:"player_id"`
+	DraftID     models.DraftID `json:"draft_id"`
+	TeamID      models.TeamID `json:"team_id"`
+	OverallPick int        `json:"overall_pick"`
+	PickedBy    *uuid.UUID `json:"picked_by,omitempty"` // nil for the auto-pick; users are barred from abandoned teams
+	// OverrideTurn makes the pick though it isn't on the clock; users must be the commissioner
+	OverrideTurn bool `json:"override_turn,omitempty"`
+}
+
+// MakeLatePickRequest represents a request to make a skipped pick out of board order
+type MakeLatePickRequest struct {
+	PickID   uuid.UUID `json:"pick_id"`
+	PlayerID models.PlayerID `json:"player_id"`
+	DraftID  models.DraftID  `json:"draft_id"`
+	PickedBy *uuid.UUID `json:"picked_by,omitempty"` // nil for the auto-pick; users are barred from abandoned teams
+}
+
+// LatePick is a skipped pick made out of board order
+type LatePick struct {
+	Pick       *models.DraftPick `json:"pick"`
+	OnTheClock bool              `json:"on_the_clock"` // the pick was back on the clock, so the draft moves on
+}
+
+// SkipPickRequest represents a request to move the draft past the pick on the clock
+type SkipPickRequest struct {
+	PickID  uuid.UUID `json:"pick_id"`
+	DraftID models.DraftID `json:"draft_id"`
+}
+
+// SkipPickWithoutRosterSpaceRequest represents a request to forfeit the pick on the clock when
+// its team's roster is full
+type SkipPickWithoutRosterSpaceRequest struct {
+	DraftID      models.DraftID `json:"draft_id"`
+	ClockExpired bool      `json:"clock_expired"` // forfeit even in drafts that don't skip such picks up front
+}
+
+// ReassignPickSlotRequest represents a request to move a pick slot to another team
+type ReassignPickSlotRequest struct {
+	DraftID   models.DraftID `json:"draft_id"`
+	PickID    uuid.UUID      `json:"pick_id"`
+	NewTeamID uuid.UUID      `json:"new_team_id"`
+	Reason    *string        `json:"reason,omitempty"`
+	// ReassignedBy is the user moving the pick, who must manage the team holding it or be the
+	// commissioner. Nil for internal services.
+	ReassignedBy *uuid.UUID `json:"reassigned_by,omitempty"`
+	// SkipTradeRules moves the pick without checking the league's pick trade rules, for
+	// reassignments that aren't trades
+	SkipTradeRules bool `json:"skip_trade_rules,omitempty"`
+}
+
+// PickSlotReassignment represents the outcome of a pick slot ownership change
+type PickSlotReassignment struct {
+	Pick       *models.DraftPick `json:"pick"`
+	FromTeamID uuid.UUID         `json:"from_team_id"`
+}
+
+// ProposePickTradeRequest represents a request to offer the pick on the clock to another team
+type ProposePickTradeRequest struct {
+	DraftID         models.DraftID  `json:"draft_id"`
+	PickID          uuid.UUID  `json:"pick_id"`
+	FromTeamID      models.TeamID  `json:"from_team_id"`
+	ToTeamID        models.TeamID  `json:"to_team_id"`
+	RequestedPickID *uuid.UUID `json:"requested_pick_id,omitempty"` // the receiving team's pick asked for in return
+	Message         *string    `json:"message,omitempty"`
+	ProposedBy      *uuid.UUID `json:"proposed_by,omitempty"`
+	// Window is how long the offer stays open, and how long the pick clock stops for it
+	Window time.Duration `json:"window"`
+}
+
+// RespondToPickTradeRequest represents a team's answer to a live pick trade offer. The
+// receiving team accepts or declines it; the offering team can only withdraw it.
+type RespondToPickTradeRequest struct {
+	DraftID     models.DraftID  `json:"draft_id"`
+	OfferID     uuid.UUID  `json:"offer_id"`
+	TeamID      models.TeamID  `json:"team_id"`
+	Accept      bool       `json:"accept"`
+	RespondedBy *uuid.UUID `json:"responded_by,omitempty"`
+}
+
+// ReactToPickRequest represents a user leaving a reaction on a made pick, or taking it back
+type ReactToPickRequest struct {
+	DraftID  models.DraftID           `json:"draft_id"`
+	PickID   uuid.UUID           `json:"pick_id"`
+	UserID   uuid.UUID           `json:"user_id"`
+	Reaction models.PickReaction `json:"reaction"`
+	Remove   bool                `json:"remove"`
+}
+
+// PickTrade is a live pick trade offer after a change, with the pick clock it left behind
+type PickTrade struct {
+	Offer *models.PickTradeOffer `json:"offer"`
+	// Changed is false when the offer had closed already and was left as it was
+	Changed bool `json:"changed"`
+	// Deadline is the pick deadline once the change was made, nil for an untimed pick or when
+	// the clock wasn't touched
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// Reassignments are the picks an accepted offer moved, the offered pick first
+	Reassignments []PickSlotReassignment `json:"reassignments,omitempty"`
 }
 
 // Slot represents a claimed pick slot for auto-pick
@@ -42,7 +233,164 @@ type Slot struct {
 
 // AvailablePlayer represents a player available for draft
 type AvailablePlayer struct {
-	ID       uuid.UUID `json:"id"`
-	FullName string    `json:"full_name"`
-	TeamID   uuid.UUID `json:"team_id"`
-}
\ No newline at end of file
+	ID                 uuid.UUID `json:"id"`
+	FullName           string    `json:"full_name"`
+	TeamID             uuid.UUID `json:"team_id"`
+	ByeWeek            *int      `json:"bye_week,omitempty"`
+	DepthChartPosition *string   `json:"depth_chart_position,omitempty"`
+	DepthChartDepth    *int      `json:"depth_chart_depth,omitempty"`
+	Position           string    `json:"position,omitempty"`
+	// Rank and ProjectedPoints are only set on ranked listings, for players the rankings cover
+	Rank            *int     `json:"rank,omitempty"`
+	ProjectedPoints *float64 `json:"projected_points,omitempty"`
+	// OwnedPct and StartedPct are the platform-wide shares of leagues rostering and starting
+	// the player, as of the nightly refresh. Unset for players on no roster.
+	OwnedPct   *float64 `json:"owned_pct,omitempty"`
+	StartedPct *float64 `json:"started_pct,omitempty"`
+	// InjuryStatus is the player's injury designation, e.g. IR; unset for healthy players
+	InjuryStatus *string `json:"injury_status,omitempty"`
+	// AverageAuctionPrice is the player's average winning bid in auction drafts this season,
+	// scaled to a $200 budget, over AuctionSales sales. Only set on ranked listings, for
+	// players sold in at least one auction.
+	AverageAuctionPrice *float64 `json:"average_auction_price,omitempty"`
+	AuctionSales        *int     `json:"auction_sales,omitempty"`
+}
+
+// RosterSpace is what a team holds against its league's roster limits and starting lineup
+// during a draft
+type RosterSpace struct {
+	Limits models.RosterLimits
+	Slots  []models.LineupSlot // the league's starting lineup; empty when it doesn't configure one
+	Counts map[string]int      // players held by position
+}
+
+// HasRoom reports whether the team can add a player at position
+func (s RosterSpace) HasRoom(position string) bool {
+	return s.Limits.HasRoom(s.Counts, position)
+}
+
+// OpenLineupSlots returns the starting lineup slots the team's players can't fill yet
+func (s RosterSpace) OpenLineupSlots() []models.LineupSlot {
+	return models.UnfilledLineupSlots(s.Slots, s.Counts)
+}
+
+// RankingProfile selects the rankings a draft's available players are sorted by
+type RankingProfile struct {
+	Season        string               `json:"season"`
+	ScoringFormat models.ScoringFormat `json:"scoring_format"`
+	Superflex     bool                 `json:"superflex"`
+}
+
+// PlayerComparison is an available player as compared with others in the draft room
+type PlayerComparison struct {
+	Player AvailablePlayer `json:"player"`
+	// PositionRank is the player's rank among the available players at their position, and
+	// PointsOverNext how many more points they're projected than the next of them. Unset for
+	// players the rankings don't cover.
+	PositionRank   *int     `json:"position_rank,omitempty"`
+	PointsOverNext *float64 `json:"points_over_next,omitempty"`
+}
+
+// SuggestionReason is why a player was moved up, or down, a team's queue or pick suggestions
+type SuggestionReason string
+
+const (
+	SuggestionReasonLineupNeed     SuggestionReason = "LINEUP_NEED"     // fills a starting lineup slot the team hasn't filled
+	SuggestionReasonScarcePosition SuggestionReason = "SCARCE_POSITION" // fewer ranked players left at the position than teams
+	SuggestionReasonValue          SuggestionReason = "VALUE"           // ranked at least a round ahead of the team's next pick
+	SuggestionReasonByeConflict    SuggestionReason = "BYE_CONFLICT"    // shares a bye week with a player the team holds at the position
+)
+
+// QueueSuggestion is an available player suggested for a team's pick queue
+type QueueSuggestion struct {
+	Player  AvailablePlayer    `json:"player"`
+	Reasons []SuggestionReason `json:"reasons,omitempty"` // empty for the best available players
+	// PositionRemaining is how many ranked players are left at the player's position among as
+	// many of the best ranked available players as there are picks left
+	PositionRemaining int `json:"position_remaining"`
+}
+
+// PickSuggestion is an available player suggested for a team's next pick
+type PickSuggestion struct {
+	Player            AvailablePlayer    `json:"player"`
+	Reasons           []SuggestionReason `json:"reasons,omitempty"`
+	PositionRemaining int                `json:"position_remaining"`
+	// PicksPastRank is how many picks after the player's overall rank the team's next pick
+	// comes, negative when it comes before. Unset when the team has no picks left.
+	PicksPastRank *int `json:"picks_past_rank,omitempty"`
+}
+
+// PickSuggestions are the suggestions for a team's next pick
+type PickSuggestions struct {
+	NextOverallPick *int             `json:"next_overall_pick,omitempty"` // unset once the team has no picks left
+	Suggestions     []PickSuggestion `json:"suggestions"`
+}
+
+// HeldByeWeek is the position and bye week of a player a team holds
+type HeldByeWeek struct {
+	Position string
+	ByeWeek  int
+}
+
+// DraftPickFilter narrows the picks returned for a draft
+type DraftPickFilter struct {
+	MinRound      *int `json:"min_round,omitempty"`
+	MaxRound      *int `json:"max_round,omitempty"`
+	OnlyCompleted bool `json:"only_completed"`
+	OnlyRemaining bool `json:"only_remaining"`
+}
+
+// PaginationParams pages a draft's picks, by token when Page is paged and otherwise by the
+// deprecated Limit and Offset, where a zero Limit returns all results
+type PaginationParams struct {
+	Limit  int                `json:"limit"`
+	Offset int                `json:"offset"`
+	Page   pagination.Request `json:"page"`
+
+	// afterOverallPick continues after a pick when paging by token
+	afterOverallPick *int
+}
+
+// pickPageSizes are the page sizes of a draft picks request paged by token
+var pickPageSizes = pagination.Sizes{Default: 100, Max: 500}
+
+// pickCursor is the position of the last pick on a page
+type pickCursor struct {
+	OverallPick int `json:"overall_pick"`
+}
+
+// DraftPickListResponse represents a paginated list of draft picks ordered by overall pick
+type DraftPickListResponse struct {
+	Picks []models.DraftPick `json:"picks"`
+	// Total is nil when paging by token without the total requested
+	Total         *int   `json:"total,omitempty"`
+	Limit         int    `json:"limit"`
+	Offset        int    `json:"offset"`
+	HasMore       bool   `json:"has_more"`
+	NextPageToken string `json:"next_page_token,omitempty"` // set when paging by token and more picks follow
+	EventSequence int64  `json:"event_sequence"`            // last draft event reflected in the picks
+}
+
+// DraftResult is one row of a draft's results: a pick slot with its team and, once made, its player
+type DraftResult struct {
+	Round          int        `json:"round"`
+	Pick           int        `json:"pick"`
+	OverallPick    int        `json:"overall_pick"`
+	TeamID         uuid.UUID  `json:"team_id"`
+	TeamName       string     `json:"team_name"`
+	PlayerID       *uuid.UUID `json:"player_id,omitempty"`
+	PlayerName     string     `json:"player_name,omitempty"`
+	PlayerPosition string     `json:"player_position,omitempty"`
+	KeeperPick     bool       `json:"keeper_pick"`
+	AuctionAmount  *float64   `json:"auction_amount,omitempty"`
+	PickedAt       *time.Time `json:"picked_at,omitempty"`
+}
+
+// PickAnnouncement is the display data announced with a made pick, so clients can render
+// it without resolving IDs. Fields are empty when the underlying record is missing.
+type PickAnnouncement struct {
+	TeamName       string
+	PlayerName     string
+	PlayerPosition string
+	NFLTeamCode    string
+}
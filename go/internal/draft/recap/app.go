@@ -0,0 +1,161 @@
+package recap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	userevents "github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+// RecapRepository defines what the draft recap app layer needs from the repository
+type RecapRepository interface {
+	GetDraft(ctx context.Context, draftID uuid.UUID) (*RecapDraft, error)
+	ListDraftedPlayers(ctx context.Context, draftID uuid.UUID, profile RankingProfile) ([]DraftedPlayer, error)
+	ListMembers(ctx context.Context, leagueID uuid.UUID) ([]Member, error)
+	CreateDraftRecap(ctx context.Context, recap models.DraftRecap) (bool, error)
+	GetDraftRecap(ctx context.Context, draftID uuid.UUID) (*models.DraftRecap, error)
+	QueueRecapNotification(ctx context.Context, userID uuid.UUID, payload userevents.DraftRecapPayload) error
+	RefreshDraftStats(ctx context.Context, draftID uuid.UUID) error
+	GetLeagueDraftSummary(ctx context.Context, leagueID uuid.UUID, draftID *uuid.UUID) (*models.LeagueDraftSummary, error)
+}
+
+// App handles draft recap business logic
+type App struct {
+	repo RecapRepository
+}
+
+// NewApp creates a new draft recap App
+func NewApp(repo RecapRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// GenerateDraftRecap works out a completed draft's recap, stores it and queues a notification
+// with it for the owner of every team in the league. A draft's recap is generated once: when it
+// already has one, that recap is returned and nothing is sent again. Run it in a transaction so
+// the recap and its notifications are stored together.
+func (a *App) GenerateDraftRecap(ctx context.Context, draftID uuid.UUID) (*models.DraftRecap, error) {
+	draft, err := a.repo.GetDraft(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	if draft.Sandbox {
+		return nil, ErrNoRecap
+	}
+	switch draft.DraftType {
+	case models.DraftTypeSnake, models.DraftTypeAuction, models.DraftTypeRookie:
+	default:
+		return nil, ErrNoRecap
+	}
+	if draft.Status != models.DraftStatusCompleted {
+		return nil, ErrDraftNotCompleted
+	}
+
+	existing, err := a.repo.GetDraftRecap(ctx, draftID)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, ErrRecapNotFound) {
+		return nil, err
+	}
+
+	picks, err := a.repo.ListDraftedPlayers(ctx, draftID, draft.Profile)
+	if err != nil {
+		return nil, err
+	}
+	recap := buildRecap(*draft, picks)
+
+	created, err := a.repo.CreateDraftRecap(ctx, *recap)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		// Generated by a concurrent delivery of the same completion
+		return a.repo.GetDraftRecap(ctx, draftID)
+	}
+
+	members, err := a.repo.ListMembers(ctx, draft.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if err := a.repo.QueueRecapNotification(ctx, member.UserID, recapPayload(*draft, *recap, member)); err != nil {
+			return nil, fmt.Errorf("failed to notify %s: %w", member.UserID, err)
+		}
+	}
+
+	log.Printf("Generated recap for draft %s with %d team grades, notifying %d members", draftID, len(recap.TeamGrades), len(members))
+	return a.repo.GetDraftRecap(ctx, draftID)
+}
+
+// GetDraftRecap retrieves a draft's recap
+func (a *App) GetDraftRecap(ctx context.Context, draftID uuid.UUID) (*models.DraftRecap, error) {
+	return a.repo.GetDraftRecap(ctx, draftID)
+}
+
+// RefreshDraftStats rolls up a completed draft's statistics for its league's home page,
+// replacing any it had. Sandboxes get none.
+func (a *App) RefreshDraftStats(ctx context.Context, draftID uuid.UUID) error {
+	draft, err := a.repo.GetDraft(ctx, draftID)
+	if err != nil {
+		return err
+	}
+	if draft.Sandbox {
+		return nil
+	}
+	if draft.Status != models.DraftStatusCompleted {
+		return ErrDraftNotCompleted
+	}
+	return a.repo.RefreshDraftStats(ctx, draftID)
+}
+
+// GetLeagueDraftSummary retrieves the statistics of a league's completed draft, its most
+// recently completed one when draftID is nil
+func (a *App) GetLeagueDraftSummary(ctx context.Context, leagueID uuid.UUID, draftID *uuid.UUID) (*models.LeagueDraftSummary, error) {
+	return a.repo.GetLeagueDraftSummary(ctx, leagueID, draftID)
+}
+
+// recapPayload builds a member's recap notification: their team's grade and the top of the
+// recap's best values and biggest reaches
+func recapPayload(draft RecapDraft, recap models.DraftRecap, member Member) userevents.DraftRecapPayload {
+	payload := userevents.DraftRecapPayload{
+		UserID:         member.UserID.String(),
+		Username:       member.Username,
+		Email:          member.Email,
+		DraftID:        draft.ID.String(),
+		LeagueName:     draft.LeagueName,
+		TeamName:       member.TeamName,
+		Teams:          len(recap.TeamGrades),
+		BestValues:     digestPicks(recap.BestValues),
+		BiggestReaches: digestPicks(recap.BiggestReaches),
+	}
+	for _, grade := range recap.TeamGrades {
+		if grade.TeamID == member.TeamID {
+			payload.Grade = grade.Grade
+			payload.Rank = grade.Rank
+			payload.ProjectedPoints = grade.ProjectedPoints
+			break
+		}
+	}
+	return payload
+}
+
+// digestPicks repeats the first of a recap's picks in a notification
+func digestPicks(picks []models.RecapPick) []userevents.DraftRecapPick {
+	digest := make([]userevents.DraftRecapPick, 0, min(len(picks), DigestPicksListed))
+	for _, pick := range picks[:min(len(picks), DigestPicksListed)] {
+		digest = append(digest, userevents.DraftRecapPick{
+			OverallPick:  pick.OverallPick,
+			TeamName:     pick.TeamName,
+			PlayerName:   pick.PlayerName,
+			ExpectedPick: pick.ExpectedPick,
+			PicksVsRank:  pick.PicksVsRank,
+		})
+	}
+	return digest
+}
@@ -0,0 +1,240 @@
+package recap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+)
+
+// Generator generates a completed draft's recap and rolls up its statistics. Both must be
+// idempotent since JetStream may redeliver a message.
+type Generator interface {
+	GenerateDraftRecap(ctx context.Context, draftID uuid.UUID) (*models.DraftRecap, error)
+	RefreshDraftStats(ctx context.Context, draftID uuid.UUID) error
+}
+
+// Config holds configuration for the draft recap consumer
+type Config struct {
+	NATS          natsconfig.Config
+	StreamName    string
+	ConsumerName  string
+	SubjectFilter string        // Only DraftCompleted events are needed
+	MaxDeliver    int           // Max delivery attempts
+	AckWait       time.Duration // How long to wait for ack
+	MaxAckPending int           // Max messages pending ack
+	MaxReconnects int
+	ReconnectWait time.Duration
+}
+
+// DefaultConfig returns default draft recap consumer configuration
+func DefaultConfig() Config {
+	return Config{
+		NATS:          natsconfig.Default(),
+		StreamName:    events.StateStream,
+		ConsumerName:  "draft-recap",
+		SubjectFilter: events.Subject(events.DraftCompleted),
+		MaxDeliver:    10,
+		AckWait:       30 * time.Second,
+		MaxAckPending: 100,
+		MaxReconnects: -1, // Infinite
+		ReconnectWait: 2 * time.Second,
+	}
+}
+
+// Consumer generates a recap for every draft as it completes, so league members hear how their
+// draft went as soon as it's over, and rolls up the draft's statistics for the league's home page
+type Consumer struct {
+	generator Generator
+	nc        *nats.Conn
+	js        jetstream.JetStream
+	consumer  jetstream.Consumer
+	config    Config
+}
+
+// NewConsumer connects to NATS and creates or binds the durable draft recap consumer
+func NewConsumer(generator Generator, config Config) (*Consumer, error) {
+	opts := []nats.Option{
+		nats.MaxReconnects(config.MaxReconnects),
+		nats.ReconnectWait(config.ReconnectWait),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			log.Error().Err(err).Msg("NATS disconnected")
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Info().Str("url", nc.ConnectedUrl()).Msg("NATS reconnected")
+		}),
+	}
+
+	nc, err := config.NATS.Connect(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("create JetStream context: %w", err)
+	}
+
+	c := &Consumer{
+		generator: generator,
+		nc:        nc,
+		js:        js,
+		config:    config,
+	}
+
+	if err := c.ensureConsumer(context.Background()); err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("ensure consumer: %w", err)
+	}
+
+	return c, nil
+}
+
+// ensureConsumer creates or gets the JetStream consumer
+func (c *Consumer) ensureConsumer(ctx context.Context) error {
+	stream, err := c.js.Stream(ctx, c.config.StreamName)
+	if err != nil {
+		return fmt.Errorf("get stream: %w", err)
+	}
+
+	consumerConfig := jetstream.ConsumerConfig{
+		Name:          c.config.ConsumerName,
+		Durable:       c.config.ConsumerName,
+		Description:   "Draft recap consumer generating completed drafts' recaps",
+		FilterSubject: c.config.SubjectFilter,
+		DeliverPolicy: jetstream.DeliverAllPolicy, // Catch up on any drafts completed while offline
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		MaxDeliver:    c.config.MaxDeliver,
+		AckWait:       c.config.AckWait,
+		MaxAckPending: c.config.MaxAckPending,
+		ReplayPolicy:  jetstream.ReplayInstantPolicy,
+	}
+
+	consumer, err := stream.Consumer(ctx, c.config.ConsumerName)
+	if err != nil {
+		consumer, err = stream.CreateConsumer(ctx, consumerConfig)
+		if err != nil {
+			return fmt.Errorf("create consumer: %w", err)
+		}
+		log.Info().
+			Str("consumer", c.config.ConsumerName).
+			Str("stream", c.config.StreamName).
+			Msg("created JetStream consumer")
+	} else {
+		log.Info().
+			Str("consumer", c.config.ConsumerName).
+			Str("stream", c.config.StreamName).
+			Msg("using existing JetStream consumer")
+	}
+
+	c.consumer = consumer
+	return nil
+}
+
+// Start consumes DraftCompleted events until ctx is cancelled
+func (c *Consumer) Start(ctx context.Context) error {
+	log.Info().
+		Str("consumer", c.config.ConsumerName).
+		Str("stream", c.config.StreamName).
+		Msg("starting draft recap consumer")
+
+	messageCh := make(chan jetstream.Msg, 100)
+
+	consumeCtx, err := c.consumer.Consume(func(msg jetstream.Msg) {
+		select {
+		case messageCh <- msg:
+		case <-ctx.Done():
+			msg.Nak()
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("start consumer: %w", err)
+	}
+	defer consumeCtx.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("draft recap consumer shutting down")
+			return nil
+		case msg := <-messageCh:
+			if err := c.processMessage(ctx, msg); err != nil {
+				log.Error().
+					Err(err).
+					Str("subject", msg.Subject()).
+					Msg("failed to generate draft recap")
+				if nakErr := msg.Nak(); nakErr != nil {
+					log.Error().Err(nakErr).Msg("failed to NAK message")
+				}
+				continue
+			}
+			if ackErr := msg.Ack(); ackErr != nil {
+				log.Error().Err(ackErr).Msg("failed to ACK message")
+			}
+		}
+	}
+}
+
+// processMessage rolls up a completed draft's statistics and generates its recap, skipping
+// drafts that get none
+func (c *Consumer) processMessage(ctx context.Context, msg jetstream.Msg) error {
+	var envelope struct {
+		EventID   string          `json:"eventId"`
+		EventType string          `json:"eventType"`
+		DraftID   string          `json:"draftId"`
+		Timestamp time.Time       `json:"timestamp"`
+		Payload   json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(msg.Data(), &envelope); err != nil {
+		return fmt.Errorf("unmarshal event envelope: %w", err)
+	}
+
+	if envelope.EventType != events.DraftCompleted {
+		return nil
+	}
+	draftID, err := uuid.Parse(envelope.DraftID)
+	if err != nil {
+		return fmt.Errorf("parse draft ID: %w", err)
+	}
+
+	if err := c.generator.RefreshDraftStats(ctx, draftID); err != nil {
+		if errors.Is(err, ErrDraftNotFound) {
+			return nil
+		}
+		return fmt.Errorf("refresh draft stats: %w", err)
+	}
+
+	recap, err := c.generator.GenerateDraftRecap(ctx, draftID)
+	if errors.Is(err, ErrNoRecap) || errors.Is(err, ErrDraftNotFound) {
+		return nil // a sandbox, expansion or dispersal draft, or one deleted since
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("draft_id", envelope.DraftID).
+		Int("team_grades", len(recap.TeamGrades)).
+		Int("best_values", len(recap.BestValues)).
+		Msg("generated draft recap")
+
+	return nil
+}
+
+// Close closes the NATS connection
+func (c *Consumer) Close() error {
+	if c.nc != nil {
+		c.nc.Close()
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+-- name: RefreshDraftStats :exec
+-- Rebuild a completed draft's statistics rollups from its picks.
+SELECT refresh_draft_stats(@draft_id::uuid);
+
+-- name: GetStatsDraft :one
+-- The league's draft a summary is shown for: the one asked for, or else the league's most
+-- recently completed draft with statistics. Sandboxes are never summarized.
+SELECT d.id,
+       d.draft_type,
+       d.completed_at,
+       l.season
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE d.league_id = @league_id
+  AND d.sandbox_of_draft_id IS NULL
+  AND (sqlc.narg('draft_id')::uuid IS NULL OR d.id = sqlc.narg('draft_id')::uuid)
+  AND EXISTS (SELECT 1 FROM draft_team_stats s WHERE s.draft_id = d.id)
+ORDER BY d.completed_at DESC NULLS LAST
+LIMIT 1;
+
+-- name: ListDraftTeamStats :many
+SELECT s.team_id,
+       ft.name AS team_name,
+       s.picks_made,
+       s.autopicks,
+       s.keeper_picks,
+       s.avg_overall_pick::float8 AS avg_overall_pick,
+       s.refreshed_at
+FROM draft_team_stats s
+JOIN fantasy_teams ft ON ft.id = s.team_id
+WHERE s.draft_id = $1
+ORDER BY ft.name;
+
+-- name: ListDraftTeamPositionStats :many
+SELECT team_id,
+       position,
+       picks,
+       avg_overall_pick::float8 AS avg_overall_pick
+FROM draft_team_position_stats
+WHERE draft_id = $1
+ORDER BY team_id, picks DESC, position;
+
+-- name: ListDraftPositionStats :many
+-- Positions in the order they came off the board, by realized average draft position.
+SELECT position,
+       picks,
+       avg_overall_pick::float8 AS avg_overall_pick,
+       first_overall_pick,
+       last_overall_pick
+FROM draft_position_stats
+WHERE draft_id = $1
+ORDER BY avg_overall_pick, position;
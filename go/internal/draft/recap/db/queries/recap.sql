@@ -0,0 +1,68 @@
+-- name: GetRecapDraft :one
+-- A draft with its league's name, season, sport and the settings that pick the rankings a recap
+-- measures it against. A completed draft reads the settings it snapshotted.
+SELECT d.id,
+       d.league_id,
+       d.draft_type,
+       d.status,
+       d.sandbox_of_draft_id,
+       l.name AS league_name,
+       l.season,
+       l.sport_id,
+       COALESCE(d.league_settings_snapshot, l.league_settings) AS league_settings
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE d.id = $1;
+
+-- name: ListRecapPicks :many
+-- A draft's made picks in order with each player's rank and projection, for players the rankings
+-- have.
+SELECT dp.overall_pick,
+       dp.round,
+       dp.pick,
+       dp.team_id,
+       ft.name AS team_name,
+       p.id AS player_id,
+       p.full_name AS player_name,
+       npp.position AS player_position,
+       COALESCE(dp.keeper_pick, FALSE)::boolean AS keeper_pick,
+       pr.overall_rank,
+       pr.projected_points
+FROM draft_picks dp
+JOIN fantasy_teams ft ON ft.id = dp.team_id
+JOIN players p ON p.id = dp.player_id
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+LEFT JOIN player_rankings pr ON pr.player_id = p.id
+    AND pr.season = @season
+    AND pr.scoring_format = @scoring_format
+    AND pr.superflex = @superflex
+WHERE dp.draft_id = @draft_id
+ORDER BY dp.overall_pick;
+
+-- name: ListRecapTeams :many
+-- The teams of a league with their owners, who are sent the recap.
+SELECT ft.id AS fantasy_team_id,
+       ft.name AS team_name,
+       u.id AS user_id,
+       u.username,
+       u.email
+FROM fantasy_teams ft
+JOIN users u ON u.id = ft.owner_id
+WHERE ft.league_id = $1
+  AND u.deleted_at IS NULL
+ORDER BY ft.name;
+
+-- name: InsertDraftRecap :execrows
+-- A recap is generated once; a redelivered completion leaves the first in place.
+INSERT INTO draft_recaps (draft_id, league_id, season, scoring_format, superflex, best_values, biggest_reaches, team_grades)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (draft_id) DO NOTHING;
+
+-- name: GetDraftRecap :one
+SELECT * FROM draft_recaps
+WHERE draft_id = $1;
+
+-- name: InsertUserOutbox :exec
+-- Queue a notification for the notification worker to deliver.
+INSERT INTO user_outbox (id, user_id, event_type, payload)
+VALUES ($1, $2, $3, $4);
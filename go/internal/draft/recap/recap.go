@@ -0,0 +1,174 @@
+package recap
+
+import (
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// gradeCutoffs maps how many standard deviations a team's projected points are above the
+// league's average to its grade, best first. Teams below the last cutoff get a D.
+var gradeCutoffs = []struct {
+	minZ  float64
+	grade string
+}{
+	{1.25, "A+"},
+	{0.75, "A"},
+	{0.25, "B+"},
+	{-0.25, "B"},
+	{-0.75, "C+"},
+	{-1.25, "C"},
+}
+
+// buildRecap works out the recap of a completed draft from its picks, in draft order
+func buildRecap(draft RecapDraft, picks []DraftedPlayer) *models.DraftRecap {
+	measured := measurePicks(draft.DraftType, picks)
+
+	recap := &models.DraftRecap{
+		DraftID:        draft.ID,
+		LeagueID:       draft.LeagueID,
+		Season:         draft.Profile.Season,
+		ScoringFormat:  draft.Profile.ScoringFormat,
+		Superflex:      draft.Profile.Superflex,
+		BestValues:     []models.RecapPick{},
+		BiggestReaches: []models.RecapPick{},
+	}
+	for _, pick := range measured {
+		switch {
+		case pick.PicksVsRank > 0:
+			recap.BestValues = append(recap.BestValues, pick)
+		case pick.PicksVsRank < 0:
+			recap.BiggestReaches = append(recap.BiggestReaches, pick)
+		}
+	}
+	sort.SliceStable(recap.BestValues, func(i, j int) bool {
+		return recap.BestValues[i].PicksVsRank > recap.BestValues[j].PicksVsRank
+	})
+	sort.SliceStable(recap.BiggestReaches, func(i, j int) bool {
+		return recap.BiggestReaches[i].PicksVsRank < recap.BiggestReaches[j].PicksVsRank
+	})
+	recap.BestValues = recap.BestValues[:min(len(recap.BestValues), RecapPicksListed)]
+	recap.BiggestReaches = recap.BiggestReaches[:min(len(recap.BiggestReaches), RecapPicksListed)]
+
+	recap.TeamGrades = gradeTeams(picks, measured)
+	return recap
+}
+
+// measurePicks measures every ranked pick made in the draft against where the player's rank put
+// them: ordering the draft's ranked picks by rank gives the pick each player was expected at.
+// Keepers weren't picked on the board and auctions have no pick order, so neither is measured.
+func measurePicks(draftType models.DraftType, picks []DraftedPlayer) []models.RecapPick {
+	if draftType == models.DraftTypeAuction {
+		return nil
+	}
+
+	var ranked []DraftedPlayer
+	for _, pick := range picks {
+		if !pick.KeeperPick && pick.OverallRank != nil {
+			ranked = append(ranked, pick)
+		}
+	}
+	byRank := make([]DraftedPlayer, len(ranked))
+	copy(byRank, ranked)
+	sort.SliceStable(byRank, func(i, j int) bool {
+		return *byRank[i].OverallRank < *byRank[j].OverallRank
+	})
+	expected := make(map[uuid.UUID]int, len(byRank))
+	for i, pick := range byRank {
+		expected[pick.PlayerID] = ranked[i].OverallPick
+	}
+
+	measured := make([]models.RecapPick, len(ranked))
+	for i, pick := range ranked {
+		measured[i] = models.RecapPick{
+			OverallPick:    pick.OverallPick,
+			Round:          pick.Round,
+			Pick:           pick.Pick,
+			TeamID:         pick.TeamID,
+			TeamName:       pick.TeamName,
+			PlayerID:       pick.PlayerID,
+			PlayerName:     pick.PlayerName,
+			PlayerPosition: pick.PlayerPosition,
+			ExpectedPick:   expected[pick.PlayerID],
+			PicksVsRank:    pick.OverallPick - expected[pick.PlayerID],
+		}
+	}
+	return measured
+}
+
+// gradeTeams grades every team that made a pick on its players' projected points against the
+// rest of the league, keepers included, best graded first
+func gradeTeams(picks []DraftedPlayer, measured []models.RecapPick) []models.TeamDraftGrade {
+	var grades []models.TeamDraftGrade
+	byTeam := make(map[uuid.UUID]int) // index into grades
+	for _, pick := range picks {
+		i, ok := byTeam[pick.TeamID]
+		if !ok {
+			i = len(grades)
+			byTeam[pick.TeamID] = i
+			grades = append(grades, models.TeamDraftGrade{TeamID: pick.TeamID, TeamName: pick.TeamName})
+		}
+		grade := &grades[i]
+		grade.PlayersDrafted++
+		if pick.ProjectedPoints == nil {
+			grade.UnrankedPlayers++
+			continue
+		}
+		grade.ProjectedPoints += *pick.ProjectedPoints
+	}
+	if len(grades) == 0 {
+		return []models.TeamDraftGrade{}
+	}
+
+	for _, pick := range measured {
+		if pick.PicksVsRank <= 0 {
+			continue
+		}
+		grade := &grades[byTeam[pick.TeamID]]
+		if grade.BestValue == nil || pick.PicksVsRank > grade.BestValue.PicksVsRank {
+			best := pick
+			grade.BestValue = &best
+		}
+	}
+
+	var mean, variance float64
+	for _, grade := range grades {
+		mean += grade.ProjectedPoints
+	}
+	mean /= float64(len(grades))
+	for _, grade := range grades {
+		variance += (grade.ProjectedPoints - mean) * (grade.ProjectedPoints - mean)
+	}
+	stddev := math.Sqrt(variance / float64(len(grades)))
+
+	for i := range grades {
+		z := 0.0
+		if stddev > 0 {
+			z = (grades[i].ProjectedPoints - mean) / stddev
+		}
+		grades[i].Grade = letterGrade(z)
+		grades[i].ProjectedPoints = math.Round(grades[i].ProjectedPoints*10) / 10
+	}
+	sort.SliceStable(grades, func(i, j int) bool {
+		if grades[i].ProjectedPoints != grades[j].ProjectedPoints {
+			return grades[i].ProjectedPoints > grades[j].ProjectedPoints
+		}
+		return grades[i].TeamName < grades[j].TeamName
+	})
+	for i := range grades {
+		grades[i].Rank = i + 1
+	}
+	return grades
+}
+
+// letterGrade grades a team z standard deviations above the league's average
+func letterGrade(z float64) string {
+	for _, cutoff := range gradeCutoffs {
+		if z >= cutoff.minZ {
+			return cutoff.grade
+		}
+	}
+	return "D"
+}
@@ -0,0 +1,281 @@
+package recap
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/recap/db"
+	"github.com/mcdev12/dynasty/go/internal/ids"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	userevents "github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+// Repository implements draft recap data access operations
+type Repository struct {
+	queries *db.Queries
+}
+
+// NewRepository creates a new draft recap repository
+func NewRepository(queries *db.Queries) *Repository {
+	return &Repository{
+		queries: queries,
+	}
+}
+
+// q returns the repository's queries, bound to the transaction in ctx if a service started one
+func (r *Repository) q(ctx context.Context) *db.Queries {
+	return sqlutil.Bind(ctx, r.queries, r.queries.WithTx)
+}
+
+// GetDraft retrieves a draft with its league and the rankings its recap is measured against
+func (r *Repository) GetDraft(ctx context.Context, draftID uuid.UUID) (*RecapDraft, error) {
+	row, err := r.q(ctx).GetRecapDraft(ctx, draftID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDraftNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft: %w", err)
+	}
+
+	var settings map[string]interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	return &RecapDraft{
+		ID:         row.ID,
+		LeagueID:   row.LeagueID,
+		LeagueName: row.LeagueName,
+		DraftType:  models.DraftType(row.DraftType),
+		Status:     models.DraftStatus(row.Status),
+		Sandbox:    row.SandboxOfDraftID.Valid,
+		Profile: RankingProfile{
+			Season:        row.Season,
+			ScoringFormat: models.SettingsScoringFormat(settings),
+			Superflex:     models.SettingsSuperflex(row.SportID, settings),
+		},
+	}, nil
+}
+
+// ListDraftedPlayers lists a draft's made picks in order with their players' ranks and
+// projections in profile
+func (r *Repository) ListDraftedPlayers(ctx context.Context, draftID uuid.UUID, profile RankingProfile) ([]DraftedPlayer, error) {
+	rows, err := r.q(ctx).ListRecapPicks(ctx, db.ListRecapPicksParams{
+		Season:        profile.Season,
+		ScoringFormat: string(profile.ScoringFormat),
+		Superflex:     profile.Superflex,
+		DraftID:       draftID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft picks: %w", err)
+	}
+
+	picks := make([]DraftedPlayer, len(rows))
+	for i, row := range rows {
+		picks[i] = DraftedPlayer{
+			OverallPick:    int(row.OverallPick),
+			Round:          int(row.Round),
+			Pick:           int(row.Pick),
+			TeamID:         row.TeamID,
+			TeamName:       row.TeamName,
+			PlayerID:       row.PlayerID,
+			PlayerName:     row.PlayerName,
+			PlayerPosition: row.PlayerPosition.String,
+			KeeperPick:     row.KeeperPick,
+		}
+		if row.OverallRank.Valid {
+			rank := int(row.OverallRank.Int32)
+			picks[i].OverallRank = &rank
+		}
+		if row.ProjectedPoints.Valid {
+			points := row.ProjectedPoints.Float64
+			picks[i].ProjectedPoints = &points
+		}
+	}
+	return picks, nil
+}
+
+// ListMembers lists the owners of a league's teams
+func (r *Repository) ListMembers(ctx context.Context, leagueID uuid.UUID) ([]Member, error) {
+	rows, err := r.q(ctx).ListRecapTeams(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list league teams: %w", err)
+	}
+
+	members := make([]Member, len(rows))
+	for i, row := range rows {
+		members[i] = Member{
+			TeamID:   row.FantasyTeamID,
+			TeamName: row.TeamName,
+			UserID:   row.UserID,
+			Username: row.Username,
+			Email:    row.Email,
+		}
+	}
+	return members, nil
+}
+
+// CreateDraftRecap stores a draft's recap, reporting false when the draft already has one
+func (r *Repository) CreateDraftRecap(ctx context.Context, recap models.DraftRecap) (bool, error) {
+	bestValues, err := json.Marshal(recap.BestValues)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal best values: %w", err)
+	}
+	biggestReaches, err := json.Marshal(recap.BiggestReaches)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal biggest reaches: %w", err)
+	}
+	teamGrades, err := json.Marshal(recap.TeamGrades)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal team grades: %w", err)
+	}
+
+	inserted, err := r.q(ctx).InsertDraftRecap(ctx, db.InsertDraftRecapParams{
+		DraftID:        recap.DraftID,
+		LeagueID:       recap.LeagueID,
+		Season:         recap.Season,
+		ScoringFormat:  string(recap.ScoringFormat),
+		Superflex:      recap.Superflex,
+		BestValues:     bestValues,
+		BiggestReaches: biggestReaches,
+		TeamGrades:     teamGrades,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create draft recap: %w", err)
+	}
+	return inserted > 0, nil
+}
+
+// GetDraftRecap retrieves a draft's recap
+func (r *Repository) GetDraftRecap(ctx context.Context, draftID uuid.UUID) (*models.DraftRecap, error) {
+	row, err := r.q(ctx).GetDraftRecap(ctx, draftID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRecapNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft recap: %w", err)
+	}
+
+	recap := &models.DraftRecap{
+		DraftID:       row.DraftID,
+		LeagueID:      row.LeagueID,
+		Season:        row.Season,
+		ScoringFormat: models.ScoringFormat(row.ScoringFormat),
+		Superflex:     row.Superflex,
+		GeneratedAt:   row.GeneratedAt,
+	}
+	if err := json.Unmarshal(row.BestValues, &recap.BestValues); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal best values: %w", err)
+	}
+	if err := json.Unmarshal(row.BiggestReaches, &recap.BiggestReaches); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal biggest reaches: %w", err)
+	}
+	if err := json.Unmarshal(row.TeamGrades, &recap.TeamGrades); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal team grades: %w", err)
+	}
+	return recap, nil
+}
+
+// RefreshDraftStats rebuilds a completed draft's statistics rollups from its picks
+func (r *Repository) RefreshDraftStats(ctx context.Context, draftID uuid.UUID) error {
+	if err := r.q(ctx).RefreshDraftStats(ctx, draftID); err != nil {
+		return fmt.Errorf("failed to refresh draft stats: %w", err)
+	}
+	return nil
+}
+
+// GetLeagueDraftSummary retrieves the statistics of a league's completed draft, its most
+// recently completed one when draftID is nil
+func (r *Repository) GetLeagueDraftSummary(ctx context.Context, leagueID uuid.UUID, draftID *uuid.UUID) (*models.LeagueDraftSummary, error) {
+	draft, err := r.q(ctx).GetStatsDraft(ctx, db.GetStatsDraftParams{
+		LeagueID: leagueID,
+		DraftID:  sqlutil.ToNullUUID(draftID),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDraftSummaryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft: %w", err)
+	}
+
+	teamRows, err := r.q(ctx).ListDraftTeamStats(ctx, draft.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft team stats: %w", err)
+	}
+	teamPositionRows, err := r.q(ctx).ListDraftTeamPositionStats(ctx, draft.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft team position stats: %w", err)
+	}
+	positionRows, err := r.q(ctx).ListDraftPositionStats(ctx, draft.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft position stats: %w", err)
+	}
+
+	summary := &models.LeagueDraftSummary{
+		DraftID:     draft.ID,
+		LeagueID:    leagueID,
+		DraftType:   models.DraftType(draft.DraftType),
+		Season:      draft.Season,
+		CompletedAt: sqlutil.FromSqlTime(draft.CompletedAt),
+		Teams:       make([]models.TeamDraftStats, len(teamRows)),
+		Positions:   make([]models.PositionDraftStats, len(positionRows)),
+	}
+
+	teamPositions := make(map[uuid.UUID][]models.TeamPositionStats)
+	for _, row := range teamPositionRows {
+		teamPositions[row.TeamID] = append(teamPositions[row.TeamID], models.TeamPositionStats{
+			Position:       row.Position,
+			Picks:          int(row.Picks),
+			AvgOverallPick: row.AvgOverallPick,
+		})
+	}
+	for i, row := range teamRows {
+		summary.Teams[i] = models.TeamDraftStats{
+			TeamID:         row.TeamID,
+			TeamName:       row.TeamName,
+			PicksMade:      int(row.PicksMade),
+			Autopicks:      int(row.Autopicks),
+			KeeperPicks:    int(row.KeeperPicks),
+			AvgOverallPick: sqlutil.FromSqlFloat64(row.AvgOverallPick),
+			Positions:      teamPositions[row.TeamID],
+		}
+		if row.RefreshedAt.After(summary.RefreshedAt) {
+			summary.RefreshedAt = row.RefreshedAt
+		}
+	}
+	for i, row := range positionRows {
+		summary.Positions[i] = models.PositionDraftStats{
+			Position:         row.Position,
+			Picks:            int(row.Picks),
+			AvgOverallPick:   row.AvgOverallPick,
+			FirstOverallPick: int(row.FirstOverallPick),
+			LastOverallPick:  int(row.LastOverallPick),
+		}
+	}
+	return summary, nil
+}
+
+// QueueRecapNotification queues a member's recap notification for the notification worker
+func (r *Repository) QueueRecapNotification(ctx context.Context, userID uuid.UUID, payload userevents.DraftRecapPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal DraftRecap notification: %w", err)
+	}
+
+	if err := r.q(ctx).InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+		ID:        ids.New(),
+		UserID:    userID,
+		EventType: userevents.DraftRecap,
+		Payload:   data,
+	}); err != nil {
+		return fmt.Errorf("failed to queue DraftRecap notification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package recap
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RecapApp defines what the service layer needs from the draft recap application
+type RecapApp interface {
+	GenerateDraftRecap(ctx context.Context, draftID uuid.UUID) (*models.DraftRecap, error)
+	GetDraftRecap(ctx context.Context, draftID uuid.UUID) (*models.DraftRecap, error)
+	RefreshDraftStats(ctx context.Context, draftID uuid.UUID) error
+	GetLeagueDraftSummary(ctx context.Context, leagueID uuid.UUID, draftID *uuid.UUID) (*models.LeagueDraftSummary, error)
+}
+
+// Service implements the DraftRecapService gRPC interface, and generates recaps for the
+// consumer as drafts complete
+type Service struct {
+	app RecapApp
+	tx  sqlutil.Transactor
+}
+
+// NewService creates a new draft recap gRPC service
+func NewService(app RecapApp, tx sqlutil.Transactor) *Service {
+	return &Service{
+		app: app,
+		tx:  tx,
+	}
+}
+
+// Verify that Service implements the DraftRecapServiceHandler interface
+var _ draftv1connect.DraftRecapServiceHandler = (*Service)(nil)
+
+// GenerateDraftRecap generates a completed draft's recap and queues its notifications in one
+// transaction, returning the recap the draft already has when it was generated before
+func (s *Service) GenerateDraftRecap(ctx context.Context, draftID uuid.UUID) (*models.DraftRecap, error) {
+	var recap *models.DraftRecap
+	err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		recap, err = s.app.GenerateDraftRecap(ctx, draftID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recap, nil
+}
+
+// RefreshDraftStats rolls up a completed draft's statistics for the consumer
+func (s *Service) RefreshDraftStats(ctx context.Context, draftID uuid.UUID) error {
+	return s.tx.WithTx(ctx, func(ctx context.Context) error {
+		return s.app.RefreshDraftStats(ctx, draftID)
+	})
+}
+
+// GetDraftRecap gets the recap of a completed draft
+func (s *Service) GetDraftRecap(ctx context.Context, req *connect.Request[draftv1.GetDraftRecapRequest]) (*connect.Response[draftv1.GetDraftRecapResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	recap, err := s.app.GetDraftRecap(ctx, draftID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&draftv1.GetDraftRecapResponse{
+		Recap: s.recapToProto(*recap),
+	}), nil
+}
+
+// GetLeagueDraftSummary gets the statistics of a league's completed draft
+func (s *Service) GetLeagueDraftSummary(ctx context.Context, req *connect.Request[draftv1.GetLeagueDraftSummaryRequest]) (*connect.Response[draftv1.GetLeagueDraftSummaryResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+	var draftID *uuid.UUID
+	if req.Msg.DraftId != nil {
+		id, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.GetDraftId())
+		if err != nil {
+			return nil, err
+		}
+		draftID = &id
+	}
+
+	summary, err := s.app.GetLeagueDraftSummary(ctx, leagueID, draftID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&draftv1.GetLeagueDraftSummaryResponse{
+		Summary: s.summaryToProto(*summary),
+	}), nil
+}
+
+// toConnectError maps app errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrDraftNotFound), errors.Is(err, ErrRecapNotFound), errors.Is(err, ErrDraftSummaryNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrDraftNotCompleted), errors.Is(err, ErrNoRecap):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// Conversion methods
+
+// recapToProto converts a draft recap to proto
+func (s *Service) recapToProto(recap models.DraftRecap) *draftv1.DraftRecap {
+	protoRecap := &draftv1.DraftRecap{
+		DraftId:        recap.DraftID.String(),
+		LeagueId:       recap.LeagueID.String(),
+		Season:         recap.Season,
+		ScoringFormat:  scoringFormatToProto(recap.ScoringFormat),
+		Superflex:      recap.Superflex,
+		BestValues:     make([]*draftv1.RecapPick, len(recap.BestValues)),
+		BiggestReaches: make([]*draftv1.RecapPick, len(recap.BiggestReaches)),
+		TeamGrades:     make([]*draftv1.TeamDraftGrade, len(recap.TeamGrades)),
+		GeneratedAt:    timestamppb.New(recap.GeneratedAt),
+	}
+	for i, pick := range recap.BestValues {
+		protoRecap.BestValues[i] = recapPickToProto(pick)
+	}
+	for i, pick := range recap.BiggestReaches {
+		protoRecap.BiggestReaches[i] = recapPickToProto(pick)
+	}
+	for i, grade := range recap.TeamGrades {
+		protoRecap.TeamGrades[i] = &draftv1.TeamDraftGrade{
+			TeamId:          grade.TeamID.String(),
+			TeamName:        grade.TeamName,
+			Grade:           grade.Grade,
+			Rank:            int32(grade.Rank),
+			ProjectedPoints: grade.ProjectedPoints,
+			PlayersDrafted:  int32(grade.PlayersDrafted),
+			UnrankedPlayers: int32(grade.UnrankedPlayers),
+		}
+		if grade.BestValue != nil {
+			protoRecap.TeamGrades[i].BestValue = recapPickToProto(*grade.BestValue)
+		}
+	}
+	return protoRecap
+}
+
+// summaryToProto converts a league draft summary to proto
+func (s *Service) summaryToProto(summary models.LeagueDraftSummary) *draftv1.LeagueDraftSummary {
+	protoSummary := &draftv1.LeagueDraftSummary{
+		DraftId:     summary.DraftID.String(),
+		LeagueId:    summary.LeagueID.String(),
+		DraftType:   draftTypeToProto(summary.DraftType),
+		Season:      summary.Season,
+		Teams:       make([]*draftv1.TeamDraftStats, len(summary.Teams)),
+		Positions:   make([]*draftv1.PositionDraftStats, len(summary.Positions)),
+		RefreshedAt: timestamppb.New(summary.RefreshedAt),
+	}
+	if summary.CompletedAt != nil {
+		protoSummary.CompletedAt = timestamppb.New(*summary.CompletedAt)
+	}
+	for i, team := range summary.Teams {
+		protoSummary.Teams[i] = &draftv1.TeamDraftStats{
+			TeamId:         team.TeamID.String(),
+			TeamName:       team.TeamName,
+			PicksMade:      int32(team.PicksMade),
+			Autopicks:      int32(team.Autopicks),
+			KeeperPicks:    int32(team.KeeperPicks),
+			AvgOverallPick: team.AvgOverallPick,
+			Positions:      make([]*draftv1.TeamPositionStats, len(team.Positions)),
+		}
+		for j, position := range team.Positions {
+			protoSummary.Teams[i].Positions[j] = &draftv1.TeamPositionStats{
+				Position:       position.Position,
+				Picks:          int32(position.Picks),
+				AvgOverallPick: position.AvgOverallPick,
+			}
+		}
+	}
+	for i, position := range summary.Positions {
+		protoSummary.Positions[i] = &draftv1.PositionDraftStats{
+			Position:         position.Position,
+			Picks:            int32(position.Picks),
+			AvgOverallPick:   position.AvgOverallPick,
+			FirstOverallPick: int32(position.FirstOverallPick),
+			LastOverallPick:  int32(position.LastOverallPick),
+		}
+	}
+	return protoSummary
+}
+
+func recapPickToProto(pick models.RecapPick) *draftv1.RecapPick {
+	return &draftv1.RecapPick{
+		OverallPick:    int32(pick.OverallPick),
+		Round:          int32(pick.Round),
+		Pick:           int32(pick.Pick),
+		TeamId:         pick.TeamID.String(),
+		TeamName:       pick.TeamName,
+		PlayerId:       pick.PlayerID.String(),
+		PlayerName:     pick.PlayerName,
+		PlayerPosition: pick.PlayerPosition,
+		ExpectedPick:   int32(pick.ExpectedPick),
+		PicksVsRank:    int32(pick.PicksVsRank),
+	}
+}
+
+func scoringFormatToProto(format models.ScoringFormat) draftv1.ScoringFormat {
+	switch format {
+	case models.ScoringFormatStandard:
+		return draftv1.ScoringFormat_SCORING_FORMAT_STANDARD
+	case models.ScoringFormatHalfPPR:
+		return draftv1.ScoringFormat_SCORING_FORMAT_HALF_PPR
+	case models.ScoringFormatPPR:
+		return draftv1.ScoringFormat_SCORING_FORMAT_PPR
+	default:
+		return draftv1.ScoringFormat_SCORING_FORMAT_UNSPECIFIED
+	}
+}
+
+func draftTypeToProto(draftType models.DraftType) draftv1.DraftType {
+	switch draftType {
+	case models.DraftTypeSnake:
+		return draftv1.DraftType_DRAFT_TYPE_SNAKE
+	case models.DraftTypeAuction:
+		return draftv1.DraftType_DRAFT_TYPE_AUCTION
+	case models.DraftTypeRookie:
+		return draftv1.DraftType_DRAFT_TYPE_ROOKIE
+	case models.DraftTypeExpansion:
+		return draftv1.DraftType_DRAFT_TYPE_EXPANSION
+	case models.DraftTypeDispersal:
+		return draftv1.DraftType_DRAFT_TYPE_DISPERSAL
+	default:
+		return draftv1.DraftType_DRAFT_TYPE_UNSPECIFIED
+	}
+}
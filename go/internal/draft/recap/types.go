@@ -0,0 +1,74 @@
+package recap
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrDraftNotFound is returned when a draft does not exist
+	ErrDraftNotFound = errors.New("draft not found")
+	// ErrDraftNotCompleted is returned when a recap is generated for a draft still running
+	ErrDraftNotCompleted = errors.New("draft is not completed")
+	// ErrNoRecap is returned when a draft gets no recap: sandboxes, and expansion and dispersal
+	// drafts, which pick from other teams' rosters rather than the rankings
+	ErrNoRecap = errors.New("draft does not get a recap")
+	// ErrRecapNotFound is returned when a draft has no recap
+	ErrRecapNotFound = errors.New("draft recap not found")
+	// ErrDraftSummaryNotFound is returned when a league has no completed draft with statistics,
+	// or the draft asked for isn't one of them
+	ErrDraftSummaryNotFound = errors.New("league draft summary not found")
+)
+
+const (
+	// RecapPicksListed is how many best values and biggest reaches a recap lists
+	RecapPicksListed = 5
+	// DigestPicksListed is how many of those a member's notification repeats
+	DigestPicksListed = 3
+)
+
+// RecapDraft is what a recap needs to know about a draft
+type RecapDraft struct {
+	ID         uuid.UUID
+	LeagueID   uuid.UUID
+	LeagueName string
+	DraftType  models.DraftType
+	Status     models.DraftStatus
+	Sandbox    bool
+	Profile    RankingProfile
+}
+
+// RankingProfile picks the rankings a draft is measured against: its league's season, scoring
+// format and whether it starts a superflex
+type RankingProfile struct {
+	Season        string
+	ScoringFormat models.ScoringFormat
+	Superflex     bool
+}
+
+// DraftedPlayer is a made pick with its player's rank and projection, nil when the rankings
+// don't have the player
+type DraftedPlayer struct {
+	OverallPick     int
+	Round           int
+	Pick            int
+	TeamID          uuid.UUID
+	TeamName        string
+	PlayerID        uuid.UUID
+	PlayerName      string
+	PlayerPosition  string
+	KeeperPick      bool
+	OverallRank     *int
+	ProjectedPoints *float64
+}
+
+// Member is the owner of a team in the league, sent the recap
+type Member struct {
+	TeamID   uuid.UUID
+	TeamName string
+	UserID   uuid.UUID
+	Username string
+	Email    string
+}
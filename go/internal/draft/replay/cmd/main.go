@@ -0,0 +1,209 @@
+// Command replay rebuilds state derived from a draft's events after a bug: the gateway's
+// projection of the draft's board and the league transaction log. Events are read from the
+// outbox, which keeps every event, or from the JetStream stream, and are applied in sequence
+// order up to an optional cutoff. Rerunning a replay is safe.
+//
+//	replay -draft <id> [-source outbox|stream] [-to-seq n] [-targets projection,transactions]
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	_ "github.com/lib/pq"
+	"github.com/mcdev12/dynasty/go/internal/changes"
+	changesdb "github.com/mcdev12/dynasty/go/internal/changes/db"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/clock"
+	"github.com/mcdev12/dynasty/go/internal/dbconfig"
+	draftdraft "github.com/mcdev12/dynasty/go/internal/draft/draft"
+	draftdb "github.com/mcdev12/dynasty/go/internal/draft/draft/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/gateway"
+	"github.com/mcdev12/dynasty/go/internal/draft/outbox"
+	outboxdb "github.com/mcdev12/dynasty/go/internal/draft/outbox/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/pick"
+	pickdb "github.com/mcdev12/dynasty/go/internal/draft/pick/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/replay"
+	"github.com/mcdev12/dynasty/go/internal/leagues"
+	leaguedb "github.com/mcdev12/dynasty/go/internal/leagues/db"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/mcdev12/dynasty/go/internal/templates"
+	templatesdb "github.com/mcdev12/dynasty/go/internal/templates/db"
+	"github.com/mcdev12/dynasty/go/internal/transactions"
+	transactionsdb "github.com/mcdev12/dynasty/go/internal/transactions/db"
+	"github.com/mcdev12/dynasty/go/internal/users"
+	usersdb "github.com/mcdev12/dynasty/go/internal/users/db"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run replays a draft and returns the process exit code
+func run(args []string) int {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: replay -draft <id> [flags]\n\n")
+		fmt.Fprintf(fs.Output(), "The projection target prints the rebuilt board as JSON; the transactions\n")
+		fmt.Fprintf(fs.Output(), "target records transactions missing from the league transaction log.\n\n")
+		fs.PrintDefaults()
+	}
+	draftFlag := fs.String("draft", "", "ID of the draft to replay")
+	source := fs.String("source", "outbox", "where events are read from: outbox or stream")
+	toSeq := fs.Int64("to-seq", 0, "last event sequence to apply, 0 for every event")
+	targetsFlag := fs.String("targets", "projection", "comma separated targets to rebuild: projection, transactions")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	draftID, err := uuid.Parse(*draftFlag)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "replay: -draft must be a draft ID")
+		fs.Usage()
+		return 2
+	}
+	if *toSeq < 0 {
+		fmt.Fprintln(os.Stderr, "replay: -to-seq can't be negative")
+		return 2
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Debug().Err(err).Msg("could not load .env file")
+	}
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	db, err := sql.Open("postgres", dbconfig.NewConfigFromEnv().DSN())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "replay: open database: %v\n", err)
+		return 1
+	}
+	defer db.Close()
+	if err := db.PingContext(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "replay: ping database: %v\n", err)
+		return 1
+	}
+
+	var events replay.Source
+	switch *source {
+	case "outbox":
+		events = replay.NewOutboxSource(outboxdb.New(db))
+	case "stream":
+		nc, err := natsconfig.NewConfigFromEnv().Connect()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "replay: connect to NATS: %v\n", err)
+			return 1
+		}
+		defer nc.Close()
+		js, err := jetstream.New(nc)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "replay: create JetStream context: %v\n", err)
+			return 1
+		}
+		events = replay.NewStreamSource(js, replay.DefaultStreamConfig())
+	default:
+		fmt.Fprintf(os.Stderr, "replay: unknown source %q, want outbox or stream\n", *source)
+		return 2
+	}
+
+	var (
+		targets    []replay.Target
+		projection *replay.ProjectionTarget
+	)
+	for _, name := range strings.Split(*targetsFlag, ",") {
+		switch name = strings.TrimSpace(name); name {
+		case "":
+		case "projection":
+			projection, err = replay.NewProjectionTarget(ctx, newSnapshotProvider(db), draftID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "replay: %v\n", err)
+				return 1
+			}
+			targets = append(targets, projection)
+		case "transactions":
+			recorder := transactions.NewApp(transactions.NewRepository(transactionsdb.New(db), db))
+			targets = append(targets, replay.NewTransactionLogTarget(recorder))
+		default:
+			fmt.Fprintf(os.Stderr, "replay: unknown target %q, want projection or transactions\n", name)
+			return 2
+		}
+	}
+	if len(targets) == 0 {
+		fmt.Fprintln(os.Stderr, "replay: -targets names nothing to rebuild")
+		return 2
+	}
+
+	result, err := replay.NewReplayer(events, targets...).Replay(ctx, draftID, *toSeq)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "replay: %v\n", err)
+		return 1
+	}
+
+	logEvent := log.Info().
+		Str("draft_id", draftID.String()).
+		Str("source", *source).
+		Int("events", result.Events).
+		Int("duplicates", result.Duplicates).
+		Int64("last_sequence", result.LastSequence).
+		Int("missing", len(result.Missing))
+	for name, changed := range result.Changed {
+		logEvent = logEvent.Int("changed_"+name, changed)
+	}
+	logEvent.Msg("replayed draft events")
+
+	if projection != nil {
+		board, err := projection.Board()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "replay: %v\n", err)
+			return 1
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(board); err != nil {
+			fmt.Fprintf(os.Stderr, "replay: write board: %v\n", err)
+			return 1
+		}
+	}
+	return 0
+}
+
+// newSnapshotProvider loads draft snapshots through in-process draft services, as the
+// gateway does
+func newSnapshotProvider(db *sql.DB) *gateway.DraftStateProvider {
+	userService := users.NewService(users.NewApp(users.NewRepository(usersdb.New(db), db), nil))
+	templateService := templates.NewService(templates.NewApp(templates.NewRepository(templatesdb.New(db))), userService)
+	leagueService := leagues.NewService(leagues.NewApp(leagues.NewRepository(leaguedb.New(db), db, changes.NewTxInserter(changesdb.New(db), sqlutil.NewTxManager(db))), nil), userService, templateService)
+	outboxApp := outbox.NewApp(outbox.NewRepository(outboxdb.New(db)))
+
+	appClock := clock.Real()
+	draftService := draftdraft.NewService(draftdraft.NewApp(draftdraft.NewRepository(draftdb.New(db), db, appClock), appClock), outboxApp, leagueService, templateService)
+	pickService := pick.NewService(pick.NewApp(pick.NewRepository(pickdb.New(db), db, appClock), appClock), draftService, outboxApp, sqlutil.NewTxManager(db), nil)
+
+	return gateway.NewDraftStateProvider(draftService, pickService)
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
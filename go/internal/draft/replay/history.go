@@ -0,0 +1,61 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/gateway"
+)
+
+// History rebuilds a draft's past state for the gateway by replaying its event log onto a
+// projection wound back to before the draft's first event. Like any projection replay, slots
+// keep their current teams until a replayed reassignment sets them.
+type History struct {
+	source    Source
+	snapshots gateway.SnapshotProvider
+}
+
+// NewHistory creates a history reading a draft's events from source, with snapshots supplying
+// the board's slots
+func NewHistory(source Source, snapshots gateway.SnapshotProvider) *History {
+	return &History{
+		source:    source,
+		snapshots: snapshots,
+	}
+}
+
+// GetDraftStateAt rebuilds a draft's state just after the event at point. The state's metadata
+// records the moment it reflects and how many events the log was missing along the way.
+func (h *History) GetDraftStateAt(ctx context.Context, draftID uuid.UUID, point gateway.HistoryPoint) (*gateway.DraftStateResponse, error) {
+	target, err := NewProjectionTarget(ctx, h.snapshots, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	replayer := NewReplayer(h.source, target)
+	var result *Result
+	if point.Sequence > 0 {
+		result, err = replayer.Replay(ctx, draftID, point.Sequence)
+	} else {
+		result, err = replayer.ReplayUntil(ctx, draftID, point.At)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("replay draft events: %w", err)
+	}
+	if point.Sequence > 0 && result.LastSequence < point.Sequence {
+		return nil, fmt.Errorf("%w: last event is sequence %d", gateway.ErrHistoryPointNotReached, result.LastSequence)
+	}
+
+	asOf := point.At
+	if point.Sequence > 0 {
+		asOf = result.LastEventAt
+	}
+	state, err := target.State(asOf)
+	if err != nil {
+		return nil, err
+	}
+	state.Metadata["as_of"] = asOf
+	state.Metadata["missing_events"] = len(result.Missing)
+	return state, nil
+}
@@ -0,0 +1,81 @@
+package replay
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/gateway"
+	outboxdb "github.com/mcdev12/dynasty/go/internal/draft/outbox/db"
+)
+
+// OutboxQuerier reads the events kept in the draft outbox
+type OutboxQuerier interface {
+	ListDraftOutboxEvents(ctx context.Context, arg outboxdb.ListDraftOutboxEventsParams) ([]outboxdb.ListDraftOutboxEventsRow, error)
+	ListDraftOutboxEventsSince(ctx context.Context, arg outboxdb.ListDraftOutboxEventsSinceParams) ([]outboxdb.ListDraftOutboxEventsSinceRow, error)
+}
+
+// OutboxSource reads a draft's events from the outbox, which keeps every event after it is
+// published, including those aged out of the stream. It is also the gateway's change log for
+// polling clients.
+type OutboxSource struct {
+	queries OutboxQuerier
+}
+
+// NewOutboxSource creates a source reading from the draft outbox
+func NewOutboxSource(queries OutboxQuerier) *OutboxSource {
+	return &OutboxSource{
+		queries: queries,
+	}
+}
+
+// Events returns a draft's events from the outbox up to toSeq, or all of them when toSeq is zero
+func (s *OutboxSource) Events(ctx context.Context, draftID uuid.UUID, toSeq int64) ([]Event, error) {
+	rows, err := s.queries.ListDraftOutboxEvents(ctx, outboxdb.ListDraftOutboxEventsParams{
+		DraftID: draftID,
+		ToSeq:   sql.NullInt64{Int64: toSeq, Valid: toSeq > 0},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list outbox events: %w", err)
+	}
+
+	events := make([]Event, len(rows))
+	for i, row := range rows {
+		events[i] = Event{
+			ID:        row.ID,
+			DraftID:   row.DraftID,
+			Type:      row.EventType,
+			Sequence:  row.Seq.Int64,
+			Timestamp: row.CreatedAt,
+			Payload:   row.Payload,
+		}
+	}
+	return events, nil
+}
+
+// EventsSince returns up to limit of a draft's events after sinceSeq in sequence order, for
+// the gateway to serve to clients polling for changes
+func (s *OutboxSource) EventsSince(ctx context.Context, draftID uuid.UUID, sinceSeq int64, limit int) ([]gateway.DraftEvent, error) {
+	rows, err := s.queries.ListDraftOutboxEventsSince(ctx, outboxdb.ListDraftOutboxEventsSinceParams{
+		DraftID:  draftID,
+		SinceSeq: sinceSeq,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list outbox events since %d: %w", sinceSeq, err)
+	}
+
+	events := make([]gateway.DraftEvent, len(rows))
+	for i, row := range rows {
+		events[i] = gateway.DraftEvent{
+			ID:        row.ID.String(),
+			DraftID:   draftID.String(),
+			Type:      gateway.EventType(row.EventType),
+			Timestamp: row.CreatedAt,
+			Data:      row.Payload,
+			Sequence:  row.Seq.Int64,
+		}
+	}
+	return events, nil
+}
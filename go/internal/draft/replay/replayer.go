@@ -0,0 +1,104 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Replayer feeds a draft's events to targets in sequence order to rebuild their state after
+// a bug
+type Replayer struct {
+	source  Source
+	targets []Target
+}
+
+// NewReplayer creates a replayer reading from source and applying to targets
+func NewReplayer(source Source, targets ...Target) *Replayer {
+	return &Replayer{
+		source:  source,
+		targets: targets,
+	}
+}
+
+// Replay applies a draft's events up to toSeq, or all of them when toSeq is zero, to every
+// target. Each event is applied once however often the source returns it. Replay stops at the
+// first event a target fails to apply; since targets are idempotent it can simply be rerun.
+func (r *Replayer) Replay(ctx context.Context, draftID uuid.UUID, toSeq int64) (*Result, error) {
+	return r.replay(ctx, draftID, toSeq, time.Time{})
+}
+
+// ReplayUntil applies a draft's events written at or before until to every target, as Replay
+// does
+func (r *Replayer) ReplayUntil(ctx context.Context, draftID uuid.UUID, until time.Time) (*Result, error) {
+	return r.replay(ctx, draftID, 0, until)
+}
+
+// replay applies a draft's events up to toSeq when it is set, and written at or before until
+// when it is set
+func (r *Replayer) replay(ctx context.Context, draftID uuid.UUID, toSeq int64, until time.Time) (*Result, error) {
+	events, err := r.source.Events(ctx, draftID, toSeq)
+	if err != nil {
+		return nil, fmt.Errorf("read events: %w", err)
+	}
+
+	// Events written before sequencing keep the order the source returned them in, ahead of
+	// the sequenced ones
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Sequence < events[j].Sequence
+	})
+
+	result := &Result{Changed: make(map[string]int, len(r.targets))}
+	seenIDs := make(map[uuid.UUID]bool, len(events))
+	seenSeqs := make(map[int64]bool, len(events))
+	var expected int64 = 1
+
+	for _, event := range events {
+		if toSeq > 0 && event.Sequence > toSeq {
+			continue
+		}
+		if !until.IsZero() && event.Timestamp.After(until) {
+			continue
+		}
+		if seenIDs[event.ID] || (event.Sequence > 0 && seenSeqs[event.Sequence]) {
+			result.Duplicates++
+			continue
+		}
+		seenIDs[event.ID] = true
+
+		if event.Sequence > 0 {
+			seenSeqs[event.Sequence] = true
+			for ; expected < event.Sequence; expected++ {
+				result.Missing = append(result.Missing, expected)
+			}
+			expected = event.Sequence + 1
+			result.LastSequence = event.Sequence
+		}
+		result.LastEventAt = event.Timestamp
+
+		for _, target := range r.targets {
+			changed, err := target.Apply(ctx, event)
+			if err != nil {
+				return result, fmt.Errorf("%s: apply %s event %s (sequence %d): %w",
+					target.Name(), event.Type, event.ID, event.Sequence, err)
+			}
+			if changed {
+				result.Changed[target.Name()]++
+			}
+		}
+		result.Events++
+	}
+
+	if len(result.Missing) > 0 {
+		log.Warn().
+			Str("draft_id", draftID.String()).
+			Int("missing", len(result.Missing)).
+			Int64("first_missing", result.Missing[0]).
+			Msg("source is missing events, the rebuilt state may be incomplete")
+	}
+	return result, nil
+}
@@ -0,0 +1,145 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// StreamConfig holds configuration for reading draft events back from JetStream
+type StreamConfig struct {
+	StreamName     string
+	SubjectFilters []string      // Every subject on the stream when empty
+	FetchBatch     int           // Messages fetched per request
+	FetchMaxWait   time.Duration // How long a fetch waits for messages
+}
+
+// DefaultStreamConfig returns default configuration for reading the draft lifecycle and pick
+// events, which are all a replay applies
+func DefaultStreamConfig() StreamConfig {
+	return StreamConfig{
+		StreamName:   events.StateStream,
+		FetchBatch:   500,
+		FetchMaxWait: 2 * time.Second,
+	}
+}
+
+// StreamSource reads a draft's events back from JetStream. The stream only keeps events for
+// its retention period, so older drafts are better replayed from the outbox.
+type StreamSource struct {
+	js     jetstream.JetStream
+	config StreamConfig
+}
+
+// NewStreamSource creates a source reading from the draft event stream
+func NewStreamSource(js jetstream.JetStream, config StreamConfig) *StreamSource {
+	return &StreamSource{
+		js:     js,
+		config: config,
+	}
+}
+
+// Events scans the stream from its first message to its last as of the call with a
+// throwaway ordered consumer, keeping a draft's events up to toSeq, or all of them when toSeq
+// is zero. Events are spread across the stream by draft, so every message is read.
+func (s *StreamSource) Events(ctx context.Context, draftID uuid.UUID, toSeq int64) ([]Event, error) {
+	stream, err := s.js.Stream(ctx, s.config.StreamName)
+	if err != nil {
+		return nil, fmt.Errorf("get stream: %w", err)
+	}
+	lastSeq := stream.CachedInfo().State.LastSeq
+	if lastSeq == 0 {
+		return nil, nil
+	}
+
+	consumer, err := s.js.OrderedConsumer(ctx, s.config.StreamName, jetstream.OrderedConsumerConfig{
+		FilterSubjects: s.config.SubjectFilters,
+		DeliverPolicy:  jetstream.DeliverAllPolicy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create ordered consumer: %w", err)
+	}
+
+	draft := draftID.String()
+	var events []Event
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		batch, err := consumer.Fetch(s.config.FetchBatch, jetstream.FetchMaxWait(s.config.FetchMaxWait))
+		if err != nil {
+			return nil, fmt.Errorf("fetch messages: %w", err)
+		}
+
+		received := 0
+		done := false
+		for msg := range batch.Messages() {
+			received++
+			meta, err := msg.Metadata()
+			if err != nil {
+				return nil, fmt.Errorf("read message metadata: %w", err)
+			}
+			if meta.Sequence.Stream >= lastSeq {
+				done = true
+			}
+			if msg.Headers().Get("Draft-ID") != draft {
+				continue
+			}
+
+			event, err := decodeEnvelope(msg.Data())
+			if err != nil {
+				return nil, fmt.Errorf("decode message %d: %w", meta.Sequence.Stream, err)
+			}
+			if toSeq > 0 && event.Sequence > toSeq {
+				continue
+			}
+			events = append(events, *event)
+		}
+		if err := batch.Error(); err != nil {
+			return nil, fmt.Errorf("fetch messages: %w", err)
+		}
+		// An empty fetch means messages past the filter ran out before lastSeq
+		if done || received == 0 {
+			return events, nil
+		}
+	}
+}
+
+// decodeEnvelope reads a draft event from the message body the outbox publisher writes
+func decodeEnvelope(data []byte) (*Event, error) {
+	var envelope struct {
+		EventID   string          `json:"eventId"`
+		EventType string          `json:"eventType"`
+		DraftID   string          `json:"draftId"`
+		Timestamp time.Time       `json:"timestamp"`
+		Payload   json.RawMessage `json:"payload"`
+		Sequence  int64           `json:"sequence"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("unmarshal event envelope: %w", err)
+	}
+
+	eventID, err := uuid.Parse(envelope.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("parse event ID: %w", err)
+	}
+	draftID, err := uuid.Parse(envelope.DraftID)
+	if err != nil {
+		return nil, fmt.Errorf("parse draft ID: %w", err)
+	}
+
+	return &Event{
+		ID:        eventID,
+		DraftID:   draftID,
+		Type:      envelope.EventType,
+		Sequence:  envelope.Sequence,
+		Timestamp: envelope.Timestamp,
+		Payload:   envelope.Payload,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/gateway"
+	"github.com/mcdev12/dynasty/go/internal/transactions"
+)
+
+// ProjectionTarget rebuilds a draft's board the way the gateway projects it, starting from
+// the board with every pick unmade
+type ProjectionTarget struct {
+	projection *gateway.DraftProjection
+	draftID    uuid.UUID
+}
+
+// NewProjectionTarget creates a gateway projection of a draft wound back to before its first
+// event. The snapshot supplies the board's slots, which events don't describe.
+func NewProjectionTarget(ctx context.Context, snapshots gateway.SnapshotProvider, draftID uuid.UUID) (*ProjectionTarget, error) {
+	projection := gateway.NewDraftProjection(snapshots, gateway.DefaultProjectionConfig())
+	if err := projection.Rewind(ctx, draftID); err != nil {
+		return nil, err
+	}
+
+	return &ProjectionTarget{
+		projection: projection,
+		draftID:    draftID,
+	}, nil
+}
+
+// Name identifies the target in replay results
+func (t *ProjectionTarget) Name() string {
+	return "projection"
+}
+
+// Apply folds an event into the projection. Events it already reflects are ignored.
+func (t *ProjectionTarget) Apply(ctx context.Context, event Event) (bool, error) {
+	t.projection.Apply(&gateway.DraftEvent{
+		ID:        event.ID.String(),
+		DraftID:   event.DraftID.String(),
+		Type:      gateway.EventType(event.Type),
+		Timestamp: event.Timestamp,
+		Data:      event.Payload,
+		Sequence:  event.Sequence,
+	})
+	return true, nil
+}
+
+// Board returns the board rebuilt so far
+func (t *ProjectionTarget) Board() (*gateway.DraftBoardResponse, error) {
+	board, ok := t.projection.ProjectedBoard(t.draftID)
+	if !ok {
+		return nil, fmt.Errorf("draft %s is not projected", t.draftID)
+	}
+	return board, nil
+}
+
+// State returns the draft's state rebuilt so far, with the time remaining on the clock
+// measured at asOf
+func (t *ProjectionTarget) State(asOf time.Time) (*gateway.DraftStateResponse, error) {
+	state, ok := t.projection.ProjectedState(t.draftID, asOf)
+	if !ok {
+		return nil, fmt.Errorf("draft %s is not projected", t.draftID)
+	}
+	return state, nil
+}
+
+// TransactionRecorder records the league transaction described by a draft event
+type TransactionRecorder interface {
+	RecordDraftEvent(ctx context.Context, event transactions.DraftEvent) (bool, error)
+}
+
+// TransactionLogTarget records the drafted players and traded picks missing from the league
+// transaction log. Transactions are keyed by event, so ones already recorded are left alone.
+type TransactionLogTarget struct {
+	recorder TransactionRecorder
+}
+
+// NewTransactionLogTarget creates a target that backfills the league transaction log
+func NewTransactionLogTarget(recorder TransactionRecorder) *TransactionLogTarget {
+	return &TransactionLogTarget{
+		recorder: recorder,
+	}
+}
+
+// Name identifies the target in replay results
+func (t *TransactionLogTarget) Name() string {
+	return "transactions"
+}
+
+// Apply records the transaction an event describes, if it describes one not yet recorded
+func (t *TransactionLogTarget) Apply(ctx context.Context, event Event) (bool, error) {
+	return t.recorder.RecordDraftEvent(ctx, transactions.DraftEvent{
+		EventID:   event.ID,
+		EventType: event.Type,
+		DraftID:   event.DraftID,
+		Timestamp: event.Timestamp,
+		Payload:   event.Payload,
+	})
+}
@@ -0,0 +1,44 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event is a draft event as it was published
+type Event struct {
+	ID        uuid.UUID
+	DraftID   uuid.UUID
+	Type      string
+	Sequence  int64 // zero for events written before sequencing
+	Timestamp time.Time
+	Payload   json.RawMessage
+}
+
+// Source reads a draft's events
+type Source interface {
+	// Events returns a draft's events up to toSeq, or all of them when toSeq is zero. They
+	// need not be in order or free of duplicates.
+	Events(ctx context.Context, draftID uuid.UUID, toSeq int64) ([]Event, error)
+}
+
+// Target rebuilds derived state from draft events. Implementations must be idempotent so a
+// replay that failed part way, or one over state that is only partly wrong, can be rerun.
+type Target interface {
+	Name() string
+	// Apply folds an event into the target's state, reporting whether it changed anything
+	Apply(ctx context.Context, event Event) (bool, error)
+}
+
+// Result summarizes a replay
+type Result struct {
+	Events       int            // distinct events replayed
+	Duplicates   int            // events the source returned more than once
+	LastSequence int64          // sequence of the last event replayed
+	LastEventAt  time.Time      // when the last event replayed was written
+	Missing      []int64        // sequences absent from the source, e.g. aged out of the stream
+	Changed      map[string]int // per target, how many events changed its state
+}
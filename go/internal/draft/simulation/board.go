@@ -0,0 +1,179 @@
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/pick"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// board is an in-memory snake draft that stands in for the draft pick service, so
+// strategies run against it exactly as they run against the real service. Only the
+// calls strategies make are implemented; everything else returns CodeUnimplemented.
+type board struct {
+	draftv1connect.UnimplementedDraftPickServiceHandler
+
+	draftID  uuid.UUID
+	league   LeagueSettings
+	slots    []boardSlot
+	cursor   int
+	pool     []Player // ranked order
+	byName   []Player // name order
+	drafted  map[uuid.UUID]bool
+	byPlayer map[uuid.UUID]Player
+}
+
+type boardSlot struct {
+	pickID      uuid.UUID
+	teamID      uuid.UUID
+	teamIndex   int
+	round       int
+	overallPick int
+}
+
+var _ draftv1connect.DraftPickServiceClient = (*board)(nil)
+
+// newBoard lays out the pick slots of a snake draft over a pool sorted by rank
+func newBoard(league LeagueSettings, pool []Player) *board {
+	b := &board{
+		draftID:  uuid.New(),
+		league:   league,
+		slots:    make([]boardSlot, 0, league.Teams*league.Rounds),
+		pool:     pool,
+		byName:   make([]Player, len(pool)),
+		drafted:  make(map[uuid.UUID]bool, league.Teams*league.Rounds),
+		byPlayer: make(map[uuid.UUID]Player, len(pool)),
+	}
+	copy(b.byName, pool)
+	sort.SliceStable(b.byName, func(i, j int) bool { return b.byName[i].Name < b.byName[j].Name })
+	for _, p := range pool {
+		b.byPlayer[p.ID] = p
+	}
+
+	teams := make([]uuid.UUID, league.Teams)
+	for i := range teams {
+		teams[i] = uuid.New()
+	}
+	for round := 1; round <= league.Rounds; round++ {
+		for i := 0; i < league.Teams; i++ {
+			teamIndex := i
+			if round%2 == 0 {
+				teamIndex = league.Teams - 1 - i
+			}
+			b.slots = append(b.slots, boardSlot{
+				pickID:      uuid.New(),
+				teamID:      teams[teamIndex],
+				teamIndex:   teamIndex,
+				round:       round,
+				overallPick: len(b.slots) + 1,
+			})
+		}
+	}
+	return b
+}
+
+func (b *board) done() bool {
+	return b.cursor >= len(b.slots)
+}
+
+func (b *board) current() boardSlot {
+	return b.slots[b.cursor]
+}
+
+// makePick applies a strategy's pick to the slot on the clock
+func (b *board) makePick(req pick.MakePickRequest) (Player, error) {
+	if b.done() {
+		return Player{}, errors.New("draft is complete")
+	}
+	slot := b.current()
+	if req.DraftID.UUID() != b.draftID || req.PickID != slot.pickID || req.TeamID.UUID() != slot.teamID {
+		return Player{}, fmt.Errorf("pick %s is not the slot on the clock", req.PickID)
+	}
+	player, ok := b.byPlayer[req.PlayerID.UUID()]
+	if !ok {
+		return Player{}, fmt.Errorf("player %s is not in the pool", req.PlayerID)
+	}
+	if b.drafted[req.PlayerID.UUID()] {
+		return Player{}, fmt.Errorf("player %s was already drafted", req.PlayerID)
+	}
+
+	b.drafted[req.PlayerID.UUID()] = true
+	b.cursor++
+	return player, nil
+}
+
+// ListAvailablePlayersForDraft lists undrafted players, by rank when ranked and by name otherwise
+func (b *board) ListAvailablePlayersForDraft(_ context.Context, req *connect.Request[draftv1.ListAvailablePlayersForDraftRequest]) (*connect.Response[draftv1.ListAvailablePlayersForDraftResponse], error) {
+	if req.Msg.DraftId != b.draftID.String() {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("draft %s not found", req.Msg.DraftId))
+	}
+
+	players := b.byName
+	resp := &draftv1.ListAvailablePlayersForDraftResponse{}
+	if req.Msg.Ranked {
+		players = b.pool
+		resp.ScoringFormat = scoringFormatToProto(b.league.ScoringFormat)
+		resp.Superflex = b.league.Superflex
+	}
+
+	resp.Players = make([]*draftv1.AvailablePlayer, 0, len(players)-len(b.drafted))
+	for _, p := range players {
+		if b.drafted[p.ID] {
+			continue
+		}
+		position := p.Position
+		available := &draftv1.AvailablePlayer{
+			Id:                 p.ID.String(),
+			FullName:           p.Name,
+			DepthChartPosition: &position,
+		}
+		if req.Msg.Ranked && p.Rank > 0 {
+			rank := int32(p.Rank)
+			points := p.ProjectedPoints
+			available.Rank = &rank
+			available.ProjectedPoints = &points
+		}
+		resp.Players = append(resp.Players, available)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ClaimNextPickSlot returns the slot on the clock
+func (b *board) ClaimNextPickSlot(_ context.Context, req *connect.Request[draftv1.ClaimNextPickSlotRequest]) (*connect.Response[draftv1.ClaimNextPickSlotResponse], error) {
+	if req.Msg.DraftId != b.draftID.String() {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("draft %s not found", req.Msg.DraftId))
+	}
+	if b.done() {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("no available slots to claim"))
+	}
+
+	slot := b.current()
+	return connect.NewResponse(&draftv1.ClaimNextPickSlotResponse{
+		Slot: &draftv1.PickSlot{
+			PickId:      slot.pickID.String(),
+			TeamId:      slot.teamID.String(),
+			OverallPick: int32(slot.overallPick),
+		},
+	}), nil
+}
+
+func scoringFormatToProto(f models.ScoringFormat) draftv1.ScoringFormat {
+	switch f {
+	case models.ScoringFormatStandard:
+		return draftv1.ScoringFormat_SCORING_FORMAT_STANDARD
+	case models.ScoringFormatHalfPPR:
+		return draftv1.ScoringFormat_SCORING_FORMAT_HALF_PPR
+	case models.ScoringFormatPPR:
+		return draftv1.ScoringFormat_SCORING_FORMAT_PPR
+	default:
+		return draftv1.ScoringFormat_SCORING_FORMAT_UNSPECIFIED
+	}
+}
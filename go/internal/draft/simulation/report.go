@@ -0,0 +1,152 @@
+package simulation
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// Report compares how each strategy drafted across a simulation run
+type Report struct {
+	Drafts        int                  `json:"drafts"`
+	Teams         int                  `json:"teams"`
+	Rounds        int                  `json:"rounds"`
+	ScoringFormat models.ScoringFormat `json:"scoring_format"`
+	Superflex     bool                 `json:"superflex"`
+	Strategies    []StrategyReport     `json:"strategies"`
+}
+
+// StrategyReport summarizes the rosters a strategy drafted
+type StrategyReport struct {
+	Strategy string `json:"strategy"`
+	// Rosters is how many teams the strategy drafted for over the run
+	Rosters int `json:"rosters"`
+	// AvgProjectedPoints is the projected season points of a drafted roster
+	AvgProjectedPoints float64 `json:"avg_projected_points"`
+	// AvgValue is how many picks after their rank players were taken on average; negative
+	// values mean the strategy reached for players ahead of the rankings
+	AvgValue float64 `json:"avg_value"`
+	// Positions is the average number of players per roster at each position
+	Positions map[string]float64 `json:"positions"`
+}
+
+// reportBuilder accumulates picks per strategy while drafts run
+type reportBuilder struct {
+	report  *Report
+	index   map[string]int
+	points  []float64
+	value   []float64
+	ranked  []int
+	players []map[string]int
+}
+
+func newReport(cfg Config) *reportBuilder {
+	b := &reportBuilder{
+		report: &Report{
+			Drafts:        cfg.Drafts,
+			Teams:         cfg.League.Teams,
+			Rounds:        cfg.League.Rounds,
+			ScoringFormat: cfg.League.ScoringFormat,
+			Superflex:     cfg.League.Superflex,
+		},
+		index: make(map[string]int, len(cfg.Strategies)),
+	}
+	for _, s := range cfg.Strategies {
+		if _, ok := b.index[s.Name]; ok {
+			continue
+		}
+		b.index[s.Name] = len(b.report.Strategies)
+		b.report.Strategies = append(b.report.Strategies, StrategyReport{Strategy: s.Name})
+		b.points = append(b.points, 0)
+		b.value = append(b.value, 0)
+		b.ranked = append(b.ranked, 0)
+		b.players = append(b.players, map[string]int{})
+	}
+	return b
+}
+
+// add folds the picks of one completed draft into the report
+func (b *reportBuilder) add(picks []SimulatedPick) {
+	rosters := map[int]string{}
+	for _, p := range picks {
+		i := b.index[p.Strategy]
+		rosters[p.TeamIndex] = p.Strategy
+
+		b.points[i] += p.Player.ProjectedPoints
+		if p.Player.Rank > 0 {
+			b.value[i] += float64(p.OverallPick - p.Player.Rank)
+			b.ranked[i]++
+		}
+		position := p.Player.Position
+		if position == "" {
+			position = "UNKNOWN"
+		}
+		b.players[i][position]++
+	}
+	for _, strategy := range rosters {
+		b.report.Strategies[b.index[strategy]].Rosters++
+	}
+}
+
+// finish turns the accumulated totals into per-roster averages
+func (b *reportBuilder) finish() *Report {
+	for i := range b.report.Strategies {
+		s := &b.report.Strategies[i]
+		s.Positions = make(map[string]float64, len(b.players[i]))
+		if s.Rosters == 0 {
+			continue
+		}
+		s.AvgProjectedPoints = b.points[i] / float64(s.Rosters)
+		if b.ranked[i] > 0 {
+			s.AvgValue = b.value[i] / float64(b.ranked[i])
+		}
+		for position, count := range b.players[i] {
+			s.Positions[position] = float64(count) / float64(s.Rosters)
+		}
+	}
+	return b.report
+}
+
+// WriteText renders the report as an aligned table, one row per strategy
+func (r *Report) WriteText(w io.Writer) error {
+	positions := map[string]bool{}
+	for _, s := range r.Strategies {
+		for position := range s.Positions {
+			positions[position] = true
+		}
+	}
+	columns := make([]string, 0, len(positions))
+	for position := range positions {
+		columns = append(columns, position)
+	}
+	sort.Strings(columns)
+
+	format := string(r.ScoringFormat)
+	if r.Superflex {
+		format += " superflex"
+	}
+	if _, err := fmt.Fprintf(w, "%d drafts, %d teams x %d rounds, %s\n\n", r.Drafts, r.Teams, r.Rounds, format); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	header := append([]string{"strategy", "rosters", "avg points", "avg value"}, columns...)
+	fmt.Fprintln(tw, strings.Join(header, "\t")+"\t")
+	for _, s := range r.Strategies {
+		row := []string{
+			s.Strategy,
+			fmt.Sprintf("%d", s.Rosters),
+			fmt.Sprintf("%.1f", s.AvgProjectedPoints),
+			fmt.Sprintf("%+.1f", s.AvgValue),
+		}
+		for _, position := range columns {
+			row = append(row, fmt.Sprintf("%.2f", s.Positions[position]))
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t")+"\t")
+	}
+	return tw.Flush()
+}
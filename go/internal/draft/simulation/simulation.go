@@ -0,0 +1,157 @@
+// Package simulation runs complete drafts in memory so auto-pick strategies can be
+// compared against each other without a database, message bus or running services.
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/orchestrator"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrInvalidConfig is returned when a simulation can't be run with the given settings
+	ErrInvalidConfig = errors.New("invalid simulation config")
+	// ErrPoolTooSmall is returned when there aren't enough players to fill every pick
+	ErrPoolTooSmall = errors.New("player pool too small for the draft")
+)
+
+// Player is a draftable player in the simulated pool
+type Player struct {
+	ID              uuid.UUID `json:"id"`
+	Name            string    `json:"name"`
+	Position        string    `json:"position"`
+	Rank            int       `json:"rank"` // overall rank, 0 when unranked
+	ProjectedPoints float64   `json:"projected_points"`
+}
+
+// LeagueSettings are the league and draft settings every simulated draft uses
+type LeagueSettings struct {
+	Teams         int
+	Rounds        int
+	ScoringFormat models.ScoringFormat
+	Superflex     bool
+}
+
+// Strategy is a named auto-pick strategy taking part in the simulation
+type Strategy struct {
+	Name    string
+	Factory orchestrator.StrategyFactory
+}
+
+// Config configures a simulation run
+type Config struct {
+	Drafts     int
+	League     LeagueSettings
+	Strategies []Strategy
+	// Pool is the players available in every draft; ranks should match League's scoring format
+	Pool []Player
+}
+
+// Validate checks that the config describes drafts that can be completed
+func (c Config) Validate() error {
+	if c.Drafts < 1 {
+		return fmt.Errorf("%w: drafts must be at least 1", ErrInvalidConfig)
+	}
+	if c.League.Teams < 2 {
+		return fmt.Errorf("%w: a draft needs at least 2 teams", ErrInvalidConfig)
+	}
+	if c.League.Rounds < 1 {
+		return fmt.Errorf("%w: rounds must be at least 1", ErrInvalidConfig)
+	}
+	if len(c.Strategies) == 0 {
+		return fmt.Errorf("%w: at least one strategy is required", ErrInvalidConfig)
+	}
+	if len(c.Pool) < c.League.Teams*c.League.Rounds {
+		return fmt.Errorf("%w: %d players for %d picks", ErrPoolTooSmall, len(c.Pool), c.League.Teams*c.League.Rounds)
+	}
+	return nil
+}
+
+// SimulatedPick is one pick made in a simulated draft
+type SimulatedPick struct {
+	OverallPick int
+	Round       int
+	TeamIndex   int
+	Strategy    string
+	Player      Player
+}
+
+// Run simulates cfg.Drafts complete snake drafts. Strategies are assigned to draft slots in
+// rotation, shifted by one slot every draft, so no strategy keeps the same draft position.
+func Run(ctx context.Context, cfg Config) (*Report, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	pool := make([]Player, len(cfg.Pool))
+	copy(pool, cfg.Pool)
+	sort.SliceStable(pool, func(i, j int) bool { return rankLess(pool[i], pool[j]) })
+
+	report := newReport(cfg)
+	for n := 0; n < cfg.Drafts; n++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		picks, err := runDraft(ctx, cfg, pool, n)
+		if err != nil {
+			return nil, fmt.Errorf("failed to simulate draft %d: %w", n+1, err)
+		}
+		report.add(picks)
+	}
+
+	return report.finish(), nil
+}
+
+// runDraft simulates a single draft, the n-th of the run
+func runDraft(ctx context.Context, cfg Config, pool []Player, n int) ([]SimulatedPick, error) {
+	board := newBoard(cfg.League, pool)
+
+	// Each team gets its own strategy instance, built on the in-memory board
+	assigned := make([]Strategy, cfg.League.Teams)
+	strategies := make([]orchestrator.AutoPickStrategy, cfg.League.Teams)
+	for i := range assigned {
+		assigned[i] = cfg.Strategies[(i+n)%len(cfg.Strategies)]
+		strategies[i] = assigned[i].Factory(board)
+	}
+
+	picks := make([]SimulatedPick, 0, len(board.slots))
+	for !board.done() {
+		slot := board.current()
+		req, err := strategies[slot.teamIndex].SelectClaim(ctx, board.draftID)
+		if err != nil {
+			return nil, fmt.Errorf("strategy %s failed at pick %d: %w", assigned[slot.teamIndex].Name, slot.overallPick, err)
+		}
+
+		player, err := board.makePick(req)
+		if err != nil {
+			return nil, fmt.Errorf("strategy %s made an invalid pick at %d: %w", assigned[slot.teamIndex].Name, slot.overallPick, err)
+		}
+		picks = append(picks, SimulatedPick{
+			OverallPick: slot.overallPick,
+			Round:       slot.round,
+			TeamIndex:   slot.teamIndex,
+			Strategy:    assigned[slot.teamIndex].Name,
+			Player:      player,
+		})
+	}
+
+	return picks, nil
+}
+
+// rankLess orders ranked players by rank ahead of unranked players by name
+func rankLess(a, b Player) bool {
+	switch {
+	case a.Rank > 0 && b.Rank > 0:
+		return a.Rank < b.Rank
+	case a.Rank > 0 || b.Rank > 0:
+		return a.Rank > 0
+	default:
+		return a.Name < b.Name
+	}
+}
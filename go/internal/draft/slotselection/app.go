@@ -0,0 +1,182 @@
+package slotselection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// SlotSelectionRepository defines what the slot selection app layer needs from the repository
+type SlotSelectionRepository interface {
+	CreateSlotSelection(ctx context.Context, req StartSlotSelectionRequest, selectionOrder []uuid.UUID) (*models.DraftSlotSelection, error)
+	GetSlotSelection(ctx context.Context, draftID uuid.UUID) (*models.DraftSlotSelection, error)
+	CountDraftPicks(ctx context.Context, draftID uuid.UUID) (int, error)
+	ListExpiredSlotSelections(ctx context.Context, limit int32) ([]uuid.UUID, error)
+	ClaimDraftSlot(ctx context.Context, req ClaimDraftSlotRequest) (*SlotClaimResult, error)
+	AutoAssignDraftSlot(ctx context.Context, draftID uuid.UUID) (*SlotClaimResult, error)
+	IsDraftCommissioner(ctx context.Context, draftID, userID uuid.UUID) (bool, error)
+	CanUserClaimSlot(ctx context.Context, draftID, fantasyTeamID, userID uuid.UUID) (bool, error)
+}
+
+// App handles slot selection business logic
+type App struct {
+	repo SlotSelectionRepository
+}
+
+// NewApp creates a new slot selection App
+func NewApp(repo SlotSelectionRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// StartSlotSelection draws the order the draft's teams choose their slot in by lottery and opens
+// slot selection with the first of them on the clock. Commissioner only. Callers are responsible
+// for ensuring the draft has not started.
+func (a *App) StartSlotSelection(ctx context.Context, req StartSlotSelectionRequest) (*models.DraftSlotSelection, error) {
+	if err := a.validateStartSlotSelectionRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if req.StartedBy != nil {
+		commissioner, err := a.repo.IsDraftCommissioner(ctx, req.DraftID, *req.StartedBy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check commissioner: %w", err)
+		}
+		if !commissioner {
+			return nil, ErrNotCommissioner
+		}
+	}
+
+	// Picks generated from the current order would not reflect the chosen slots
+	pickCount, err := a.repo.CountDraftPicks(ctx, req.DraftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count draft picks: %w", err)
+	}
+	if pickCount > 0 {
+		return nil, ErrDraftPicksExist
+	}
+
+	selection, err := a.repo.CreateSlotSelection(ctx, req, drawSelectionOrder(req.Teams))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start slot selection: %w", err)
+	}
+
+	log.Printf("Started slot selection for draft %s with %d teams", req.DraftID, len(req.Teams))
+	return selection, nil
+}
+
+// drawSelectionOrder is the slot selection lottery: a uniformly random order of the teams
+func drawSelectionOrder(teams []uuid.UUID) []uuid.UUID {
+	order := slices.Clone(teams)
+	rand.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+	return order
+}
+
+// GetSlotSelection retrieves the slot selection for a draft
+func (a *App) GetSlotSelection(ctx context.Context, draftID uuid.UUID) (*models.DraftSlotSelection, error) {
+	selection, err := a.repo.GetSlotSelection(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slot selection: %w", err)
+	}
+	return selection, nil
+}
+
+// ClaimDraftSlot claims a draft slot for the team on the clock
+func (a *App) ClaimDraftSlot(ctx context.Context, req ClaimDraftSlotRequest) (*SlotClaimResult, error) {
+	if err := a.validateClaimDraftSlotRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if req.ClaimedBy != nil {
+		canClaim, err := a.repo.CanUserClaimSlot(ctx, req.DraftID, req.FantasyTeamID, *req.ClaimedBy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check team manager: %w", err)
+		}
+		if !canClaim {
+			return nil, ErrNotTeamManager
+		}
+	}
+
+	result, err := a.repo.ClaimDraftSlot(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim draft slot: %w", err)
+	}
+
+	log.Printf("Team %s claimed slot %d in draft %s", req.FantasyTeamID, req.Slot, req.DraftID)
+	return result, nil
+}
+
+// AutoAssignExpiredSlots gives every team whose turn has run out the earliest open slot.
+// Selections that moved on while being processed are skipped.
+func (a *App) AutoAssignExpiredSlots(ctx context.Context, limit int32) ([]SlotClaimResult, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be greater than 0")
+	}
+
+	draftIDs, err := a.repo.ListExpiredSlotSelections(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired slot selections: %w", err)
+	}
+
+	var results []SlotClaimResult
+	for _, draftID := range draftIDs {
+		result, err := a.repo.AutoAssignDraftSlot(ctx, draftID)
+		if err != nil {
+			if errors.Is(err, ErrTurnNotExpired) || errors.Is(err, ErrSlotSelectionNotInProgress) {
+				continue
+			}
+			log.Printf("Failed to auto-assign draft slot for draft %s: %v", draftID, err)
+			continue
+		}
+
+		log.Printf("Auto-assigned slot %d to team %s in draft %s", result.Claim.Slot, result.Claim.FantasyTeamID, draftID)
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
+
+// Validation methods
+
+// validateStartSlotSelectionRequest validates start slot selection request
+func (a *App) validateStartSlotSelectionRequest(req StartSlotSelectionRequest) error {
+	if req.DraftID == uuid.Nil {
+		return fmt.Errorf("draft_id is required")
+	}
+	if len(req.Teams) < 2 {
+		return fmt.Errorf("draft must have at least 2 teams")
+	}
+	seen := make(map[uuid.UUID]bool, len(req.Teams))
+	for _, teamID := range req.Teams {
+		if teamID == uuid.Nil {
+			return fmt.Errorf("draft order cannot contain an empty team id")
+		}
+		if seen[teamID] {
+			return fmt.Errorf("team %s appears more than once in the draft order", teamID)
+		}
+		seen[teamID] = true
+	}
+	if req.TimePerSelectionSec <= 0 {
+		return fmt.Errorf("time_per_selection_sec must be greater than 0")
+	}
+	return nil
+}
+
+// validateClaimDraftSlotRequest validates claim draft slot request
+func (a *App) validateClaimDraftSlotRequest(req ClaimDraftSlotRequest) error {
+	if req.DraftID == uuid.Nil {
+		return fmt.Errorf("draft_id is required")
+	}
+	if req.FantasyTeamID == uuid.Nil {
+		return fmt.Errorf("fantasy_team_id is required")
+	}
+	if req.Slot < 1 {
+		return fmt.Errorf("%w: slot must be at least 1", ErrInvalidSlot)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+-- name: AdvanceSlotSelectionTurn :one
+UPDATE draft_slot_selections
+SET current_turn  = current_turn + 1,
+    turn_deadline = $2
+WHERE draft_id = $1
+RETURNING *;
+
+-- name: CanUserClaimSlot :one
+-- Whether a user may claim a draft slot for a team: its owner or a co-manager of the team in
+-- the draft.
+SELECT EXISTS (
+    SELECT 1
+    FROM fantasy_teams ft
+    WHERE ft.id = sqlc.arg('fantasy_team_id') AND ft.owner_id = sqlc.arg('user_id')
+    UNION ALL
+    SELECT 1
+    FROM draft_co_managers cm
+    WHERE cm.draft_id = sqlc.arg('draft_id') AND cm.fantasy_team_id = sqlc.arg('fantasy_team_id')
+      AND cm.user_id = sqlc.arg('user_id')
+) AS can_claim;
+
+-- name: CompleteSlotSelection :one
+UPDATE draft_slot_selections
+SET current_turn  = current_turn + 1,
+    turn_deadline = NULL,
+    status        = 'COMPLETED',
+    completed_at  = NOW()
+WHERE draft_id = $1
+RETURNING *;
+
+-- name: CountDraftPicks :one
+SELECT COUNT(*) FROM draft_picks WHERE draft_id = $1;
+
+-- name: CreateSlotSelection :one
+INSERT INTO draft_slot_selections (draft_id, selection_order, time_per_selection_sec, turn_deadline)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetSlotSelection :one
+SELECT * FROM draft_slot_selections WHERE draft_id = $1;
+
+-- name: GetSlotSelectionForUpdate :one
+SELECT * FROM draft_slot_selections WHERE draft_id = $1 FOR UPDATE;
+
+-- name: InsertSlotClaim :one
+INSERT INTO draft_slot_claims (draft_id, fantasy_team_id, slot, auto_assigned)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: IsDraftCommissioner :one
+-- Whether a user is the commissioner of the league a draft belongs to.
+SELECT EXISTS (SELECT 1
+               FROM draft d
+                        JOIN leagues l ON l.id = d.league_id
+               WHERE d.id = sqlc.arg('draft_id') AND l.commissioner_id = sqlc.arg('user_id')) AS commissioner;
+
+-- name: ListExpiredSlotSelections :many
+-- Slot selections whose current turn has run out, oldest deadline first.
+SELECT draft_id FROM draft_slot_selections
+WHERE status = 'IN_PROGRESS'
+  AND turn_deadline <= NOW()
+ORDER BY turn_deadline
+LIMIT $1;
+
+-- name: ListSlotClaims :many
+SELECT * FROM draft_slot_claims WHERE draft_id = $1 ORDER BY slot;
+
+-- name: UpdateDraftOrder :exec
+-- Persist the final slot order into the draft's settings.
+UPDATE draft
+SET settings   = jsonb_set(settings, '{draft_order}', @draft_order::jsonb),
+    updated_at = NOW()
+WHERE id = @id;
\ No newline at end of file
@@ -0,0 +1,249 @@
+package slotselection
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/slotselection/db"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+type Repository struct {
+	queries *db.Queries
+	sqlDB   *sql.DB
+}
+
+func NewRepository(queries *db.Queries, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: queries,
+		sqlDB:   sqlDB,
+	}
+}
+
+func (r *Repository) CreateSlotSelection(ctx context.Context, req StartSlotSelectionRequest, order []uuid.UUID) (*models.DraftSlotSelection, error) {
+	selectionOrder, err := json.Marshal(order)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal selection order: %w", err)
+	}
+
+	deadline := time.Now().Add(time.Duration(req.TimePerSelectionSec) * time.Second)
+	selection, err := r.queries.CreateSlotSelection(ctx, db.CreateSlotSelectionParams{
+		DraftID:             req.DraftID,
+		SelectionOrder:      selectionOrder,
+		TimePerSelectionSec: int32(req.TimePerSelectionSec),
+		TurnDeadline:        sqlutil.ToSqlTime(&deadline),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create slot selection: %w", err)
+	}
+
+	return r.dbSlotSelectionToModel(selection, nil)
+}
+
+func (r *Repository) GetSlotSelection(ctx context.Context, draftID uuid.UUID) (*models.DraftSlotSelection, error) {
+	selection, err := r.queries.GetSlotSelection(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := r.queries.ListSlotClaims(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list slot claims: %w", err)
+	}
+
+	return r.dbSlotSelectionToModel(selection, claims)
+}
+
+func (r *Repository) CountDraftPicks(ctx context.Context, draftID uuid.UUID) (int, error) {
+	count, err := r.queries.CountDraftPicks(ctx, draftID)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *Repository) IsDraftCommissioner(ctx context.Context, draftID, userID uuid.UUID) (bool, error) {
+	return r.queries.IsDraftCommissioner(ctx, db.IsDraftCommissionerParams{
+		DraftID: draftID,
+		UserID:  userID,
+	})
+}
+
+func (r *Repository) CanUserClaimSlot(ctx context.Context, draftID, fantasyTeamID, userID uuid.UUID) (bool, error) {
+	return r.queries.CanUserClaimSlot(ctx, db.CanUserClaimSlotParams{
+		FantasyTeamID: fantasyTeamID,
+		UserID:        userID,
+		DraftID:       draftID,
+	})
+}
+
+func (r *Repository) ListExpiredSlotSelections(ctx context.Context, limit int32) ([]uuid.UUID, error) {
+	return r.queries.ListExpiredSlotSelections(ctx, limit)
+}
+
+// ClaimDraftSlot claims the requested slot for the team on the clock and advances the turn
+func (r *Repository) ClaimDraftSlot(ctx context.Context, req ClaimDraftSlotRequest) (*SlotClaimResult, error) {
+	return r.claimSlot(ctx, req.DraftID, func(selection *models.DraftSlotSelection, taken map[int]bool) (int, bool, error) {
+		current := selection.CurrentTeamID()
+		if current == nil || *current != req.FantasyTeamID {
+			return 0, false, ErrNotTeamsTurn
+		}
+		if req.Slot < 1 || req.Slot > len(selection.SelectionOrder) {
+			return 0, false, fmt.Errorf("%w: slot must be between 1 and %d", ErrInvalidSlot, len(selection.SelectionOrder))
+		}
+		if taken[req.Slot] {
+			return 0, false, ErrSlotTaken
+		}
+		return req.Slot, false, nil
+	})
+}
+
+// AutoAssignDraftSlot gives the team whose turn has expired the earliest open slot
+func (r *Repository) AutoAssignDraftSlot(ctx context.Context, draftID uuid.UUID) (*SlotClaimResult, error) {
+	return r.claimSlot(ctx, draftID, func(selection *models.DraftSlotSelection, taken map[int]bool) (int, bool, error) {
+		if selection.TurnDeadline == nil || selection.TurnDeadline.After(time.Now()) {
+			return 0, false, ErrTurnNotExpired
+		}
+		for slot := 1; slot <= len(selection.SelectionOrder); slot++ {
+			if !taken[slot] {
+				return slot, true, nil
+			}
+		}
+		return 0, false, fmt.Errorf("no open slots remain")
+	})
+}
+
+// claimSlot locks the selection, lets choose pick the slot for the team on the clock, records the
+// claim and advances to the next team. When the last team has chosen, the final order is written
+// to the draft's settings in the same transaction.
+func (r *Repository) claimSlot(
+	ctx context.Context,
+	draftID uuid.UUID,
+	choose func(selection *models.DraftSlotSelection, taken map[int]bool) (slot int, autoAssigned bool, err error),
+) (*SlotClaimResult, error) {
+	var result *SlotClaimResult
+
+	err := sqlutil.Run(ctx, r.sqlDB, func(tx *sql.Tx) *db.Queries { return r.queries.WithTx(tx) }, func(qtx *db.Queries) error {
+		dbSelection, err := qtx.GetSlotSelectionForUpdate(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("failed to get slot selection: %w", err)
+		}
+		dbClaims, err := qtx.ListSlotClaims(ctx, draftID)
+		if err != nil {
+			return fmt.Errorf("failed to list slot claims: %w", err)
+		}
+
+		selection, err := r.dbSlotSelectionToModel(dbSelection, dbClaims)
+		if err != nil {
+			return err
+		}
+		teamID := selection.CurrentTeamID()
+		if teamID == nil {
+			return ErrSlotSelectionNotInProgress
+		}
+
+		taken := make(map[int]bool, len(selection.Claims))
+		for _, claim := range selection.Claims {
+			taken[claim.Slot] = true
+		}
+
+		slot, autoAssigned, err := choose(selection, taken)
+		if err != nil {
+			return err
+		}
+
+		dbClaim, err := qtx.InsertSlotClaim(ctx, db.InsertSlotClaimParams{
+			DraftID:       draftID,
+			FantasyTeamID: *teamID,
+			Slot:          int32(slot),
+			AutoAssigned:  autoAssigned,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert slot claim: %w", err)
+		}
+		dbClaims = append(dbClaims, dbClaim)
+
+		if selection.CurrentTurn+1 < len(selection.SelectionOrder) {
+			deadline := time.Now().Add(time.Duration(selection.TimePerSelectionSec) * time.Second)
+			dbSelection, err = qtx.AdvanceSlotSelectionTurn(ctx, db.AdvanceSlotSelectionTurnParams{
+				DraftID:      draftID,
+				TurnDeadline: sqlutil.ToSqlTime(&deadline),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to advance slot selection: %w", err)
+			}
+		} else {
+			dbSelection, err = qtx.CompleteSlotSelection(ctx, draftID)
+			if err != nil {
+				return fmt.Errorf("failed to complete slot selection: %w", err)
+			}
+		}
+
+		updated, err := r.dbSlotSelectionToModel(dbSelection, dbClaims)
+		if err != nil {
+			return err
+		}
+
+		if updated.Status == models.SlotSelectionStatusCompleted {
+			draftOrder, err := json.Marshal(updated.DraftOrder())
+			if err != nil {
+				return fmt.Errorf("failed to marshal draft order: %w", err)
+			}
+			if err := qtx.UpdateDraftOrder(ctx, db.UpdateDraftOrderParams{
+				DraftOrder: draftOrder,
+				ID:         draftID,
+			}); err != nil {
+				return fmt.Errorf("failed to update draft order: %w", err)
+			}
+		}
+
+		result = &SlotClaimResult{
+			Claim:     r.dbSlotClaimToModel(dbClaim),
+			Selection: updated,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *Repository) dbSlotSelectionToModel(selection db.DraftSlotSelection, claims []db.DraftSlotClaim) (*models.DraftSlotSelection, error) {
+	var selectionOrder []uuid.UUID
+	if err := json.Unmarshal(selection.SelectionOrder, &selectionOrder); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal selection order: %w", err)
+	}
+
+	modelClaims := make([]models.DraftSlotClaim, len(claims))
+	for i, claim := range claims {
+		modelClaims[i] = r.dbSlotClaimToModel(claim)
+	}
+
+	return &models.DraftSlotSelection{
+		DraftID:             selection.DraftID,
+		SelectionOrder:      selectionOrder,
+		TimePerSelectionSec: int(selection.TimePerSelectionSec),
+		CurrentTurn:         int(selection.CurrentTurn),
+		TurnDeadline:        sqlutil.FromSqlTime(selection.TurnDeadline),
+		Status:              models.SlotSelectionStatus(selection.Status),
+		Claims:              modelClaims,
+		StartedAt:           selection.StartedAt,
+		CompletedAt:         sqlutil.FromSqlTime(selection.CompletedAt),
+	}, nil
+}
+
+func (r *Repository) dbSlotClaimToModel(claim db.DraftSlotClaim) models.DraftSlotClaim {
+	return models.DraftSlotClaim{
+		FantasyTeamID: claim.FantasyTeamID,
+		Slot:          int(claim.Slot),
+		AutoAssigned:  claim.AutoAssigned,
+		ClaimedAt:     claim.ClaimedAt,
+	}
+}
@@ -0,0 +1,70 @@
+package slotselection
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// TurnExpirer auto-assigns slots to teams whose selection turn has run out
+type TurnExpirer interface {
+	AssignExpiredTurns(ctx context.Context, limit int32) (int, error)
+}
+
+// RunnerConfig holds configuration for the slot selection runner
+type RunnerConfig struct {
+	PollInterval time.Duration // How often to look for expired turns
+	BatchSize    int32         // Max selections handled per poll
+}
+
+// DefaultRunnerConfig returns default slot selection runner configuration
+func DefaultRunnerConfig() RunnerConfig {
+	return RunnerConfig{
+		PollInterval: time.Second,
+		BatchSize:    50,
+	}
+}
+
+// Runner keeps slot selection moving by auto-assigning the earliest open slot when a
+// team's turn expires. Turn deadlines are re-checked under a row lock, so several
+// runners can safely poll the same database.
+type Runner struct {
+	expirer TurnExpirer
+	config  RunnerConfig
+}
+
+// NewRunner creates a new slot selection runner
+func NewRunner(expirer TurnExpirer, config RunnerConfig) *Runner {
+	return &Runner{
+		expirer: expirer,
+		config:  config,
+	}
+}
+
+// Start polls for expired turns until ctx is cancelled
+func (r *Runner) Start(ctx context.Context) error {
+	log.Info().
+		Dur("poll_interval", r.config.PollInterval).
+		Msg("starting slot selection runner")
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("slot selection runner shutting down")
+			return nil
+		case <-ticker.C:
+			assigned, err := r.expirer.AssignExpiredTurns(ctx, r.config.BatchSize)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to assign expired slot selection turns")
+				continue
+			}
+			if assigned > 0 {
+				log.Debug().Int("assigned", assigned).Msg("auto-assigned expired slot selection turns")
+			}
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package slotselection
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SlotSelectionApp defines what the service layer needs from the slot selection application
+type SlotSelectionApp interface {
+	StartSlotSelection(ctx context.Context, req StartSlotSelectionRequest) (*models.DraftSlotSelection, error)
+	GetSlotSelection(ctx context.Context, draftID uuid.UUID) (*models.DraftSlotSelection, error)
+	ClaimDraftSlot(ctx context.Context, req ClaimDraftSlotRequest) (*SlotClaimResult, error)
+	AutoAssignExpiredSlots(ctx context.Context, limit int32) ([]SlotClaimResult, error)
+}
+
+// OutboxApp defines what the service layer needs from the outbox
+type OutboxApp interface {
+	InsertSlotSelectionUpdatedEvent(ctx context.Context, draftID uuid.UUID, payload events.SlotSelectionUpdatedPayload) error
+}
+
+// Service implements the DraftSlotSelectionService gRPC interface
+type Service struct {
+	app          SlotSelectionApp
+	draftService draftv1connect.DraftServiceClient
+	outboxApp    OutboxApp
+}
+
+// NewService creates a new slot selection gRPC service
+func NewService(app SlotSelectionApp, draftService draftv1connect.DraftServiceClient, outboxApp OutboxApp) *Service {
+	return &Service{
+		app:          app,
+		draftService: draftService,
+		outboxApp:    outboxApp,
+	}
+}
+
+// Verify that Service implements the DraftSlotSelectionServiceHandler interface
+var _ draftv1connect.DraftSlotSelectionServiceHandler = (*Service)(nil)
+
+// StartSlotSelection starts the pre-draft phase in which teams choose their draft slot, in an
+// order drawn by lottery
+func (s *Service) StartSlotSelection(ctx context.Context, req *connect.Request[draftv1.StartSlotSelectionRequest]) (*connect.Response[draftv1.StartSlotSelectionResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	startedBy, err := interceptors.ActingUserOrService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cross-domain orchestration: slots can only be chosen before the draft starts
+	draftResp, err := s.draftService.GetDraft(ctx, connect.NewRequest(&draftv1.GetDraftRequest{
+		DraftId: draftID.String(),
+	}))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("draft not found: %w", err))
+	}
+	draft := draftResp.Msg.Draft
+	if draft.Status != draftv1.DraftStatus_DRAFT_STATUS_NOT_STARTED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("slot selection can only start before the draft starts (status: %s)", draft.Status))
+	}
+
+	// Every team in the draft chooses exactly once
+	teams, err := draftTeams(draft.GetSettings().GetDraftOrder())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	selection, err := s.app.StartSlotSelection(ctx, StartSlotSelectionRequest{
+		DraftID:             draftID,
+		Teams:               teams,
+		TimePerSelectionSec: int(req.Msg.TimePerSelectionSec),
+		StartedBy:           startedBy,
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotCommissioner) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		if errors.Is(err, ErrDraftPicksExist) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Emit SlotSelectionUpdated domain event so the first team knows it is on the clock
+	if err := s.emitSlotSelectionUpdatedEvent(ctx, selection, nil); err != nil {
+		log.Printf("Failed to emit SlotSelectionUpdated event: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	return connect.NewResponse(&draftv1.StartSlotSelectionResponse{
+		Selection: s.slotSelectionToProto(selection),
+	}), nil
+}
+
+// GetSlotSelection retrieves the slot selection for a draft
+func (s *Service) GetSlotSelection(ctx context.Context, req *connect.Request[draftv1.GetSlotSelectionRequest]) (*connect.Response[draftv1.GetSlotSelectionResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	selection, err := s.app.GetSlotSelection(ctx, draftID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no slot selection for draft %s", draftID))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&draftv1.GetSlotSelectionResponse{
+		Selection: s.slotSelectionToProto(selection),
+	}), nil
+}
+
+// ClaimDraftSlot claims a draft slot for the team on the clock
+func (s *Service) ClaimDraftSlot(ctx context.Context, req *connect.Request[draftv1.ClaimDraftSlotRequest]) (*connect.Response[draftv1.ClaimDraftSlotResponse], error) {
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	claimedBy, err := interceptors.ActingUserOrService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.app.ClaimDraftSlot(ctx, ClaimDraftSlotRequest{
+		DraftID:       draftID,
+		FantasyTeamID: fantasyTeamID,
+		Slot:          int(req.Msg.Slot),
+		ClaimedBy:     claimedBy,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrNotTeamManager):
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, ErrInvalidSlot):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, ErrSlotSelectionNotInProgress), errors.Is(err, ErrNotTeamsTurn), errors.Is(err, ErrSlotTaken):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.emitSlotSelectionUpdatedEvent(ctx, result.Selection, &result.Claim); err != nil {
+		log.Printf("Failed to emit SlotSelectionUpdated event: %v", err)
+		// Don't fail the operation, just log
+	}
+
+	return connect.NewResponse(&draftv1.ClaimDraftSlotResponse{
+		Selection: s.slotSelectionToProto(result.Selection),
+	}), nil
+}
+
+// AssignExpiredTurns auto-assigns slots to teams whose turn has run out and returns how many were assigned
+func (s *Service) AssignExpiredTurns(ctx context.Context, limit int32) (int, error) {
+	results, err := s.app.AutoAssignExpiredSlots(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range results {
+		if err := s.emitSlotSelectionUpdatedEvent(ctx, results[i].Selection, &results[i].Claim); err != nil {
+			log.Printf("Failed to emit SlotSelectionUpdated event: %v", err)
+		}
+	}
+
+	return len(results), nil
+}
+
+// draftTeams parses the teams in a draft's order, the teams that choose a slot
+func draftTeams(draftOrder []string) ([]uuid.UUID, error) {
+	if len(draftOrder) == 0 {
+		return nil, fmt.Errorf("draft has no teams in its draft order")
+	}
+	teams := make([]uuid.UUID, len(draftOrder))
+	for i, id := range draftOrder {
+		teamID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid team %q in the draft order: %w", id, err)
+		}
+		teams[i] = teamID
+	}
+	return teams, nil
+}
+
+// emitSlotSelectionUpdatedEvent emits a SlotSelectionUpdated event to the outbox
+func (s *Service) emitSlotSelectionUpdatedEvent(ctx context.Context, selection *models.DraftSlotSelection, claim *models.DraftSlotClaim) error {
+	payload := events.SlotSelectionUpdatedPayload{
+		DraftID:      selection.DraftID.String(),
+		Status:       string(selection.Status),
+		TurnDeadline: selection.TurnDeadline,
+	}
+	if current := selection.CurrentTeamID(); current != nil {
+		payload.CurrentTeamID = current.String()
+	}
+	if claim != nil {
+		payload.ClaimedTeamID = claim.FantasyTeamID.String()
+		payload.ClaimedSlot = claim.Slot
+		payload.AutoAssigned = claim.AutoAssigned
+	}
+	if selection.Status == models.SlotSelectionStatusCompleted {
+		for _, teamID := range selection.DraftOrder() {
+			payload.DraftOrder = append(payload.DraftOrder, teamID.String())
+		}
+	}
+
+	return s.outboxApp.InsertSlotSelectionUpdatedEvent(ctx, selection.DraftID, payload)
+}
+
+// Conversion methods between proto and app layer models
+
+func (s *Service) slotSelectionToProto(selection *models.DraftSlotSelection) *draftv1.SlotSelection {
+	selectionOrder := make([]string, len(selection.SelectionOrder))
+	for i, teamID := range selection.SelectionOrder {
+		selectionOrder[i] = teamID.String()
+	}
+
+	claims := make([]*draftv1.DraftSlotClaim, len(selection.Claims))
+	for i, claim := range selection.Claims {
+		claims[i] = &draftv1.DraftSlotClaim{
+			FantasyTeamId: claim.FantasyTeamID.String(),
+			Slot:          int32(claim.Slot),
+			AutoAssigned:  claim.AutoAssigned,
+			ClaimedAt:     timestamppb.New(claim.ClaimedAt),
+		}
+	}
+
+	protoSelection := &draftv1.SlotSelection{
+		DraftId:             selection.DraftID.String(),
+		SelectionOrder:      selectionOrder,
+		TimePerSelectionSec: int32(selection.TimePerSelectionSec),
+		Status:              s.slotSelectionStatusToProto(selection.Status),
+		Claims:              claims,
+		StartedAt:           timestamppb.New(selection.StartedAt),
+	}
+	if current := selection.CurrentTeamID(); current != nil {
+		currentTeamID := current.String()
+		protoSelection.CurrentTeamId = &currentTeamID
+	}
+	if selection.TurnDeadline != nil {
+		protoSelection.TurnDeadline = timestamppb.New(*selection.TurnDeadline)
+	}
+	if selection.CompletedAt != nil {
+		protoSelection.CompletedAt = timestamppb.New(*selection.CompletedAt)
+	}
+
+	return protoSelection
+}
+
+func (s *Service) slotSelectionStatusToProto(status models.SlotSelectionStatus) draftv1.SlotSelectionStatus {
+	switch status {
+	case models.SlotSelectionStatusInProgress:
+		return draftv1.SlotSelectionStatus_SLOT_SELECTION_STATUS_IN_PROGRESS
+	case models.SlotSelectionStatusCompleted:
+		return draftv1.SlotSelectionStatus_SLOT_SELECTION_STATUS_COMPLETED
+	default:
+		return draftv1.SlotSelectionStatus_SLOT_SELECTION_STATUS_UNSPECIFIED
+	}
+}
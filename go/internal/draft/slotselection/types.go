@@ -0,0 +1,50 @@
+package slotselection
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrSlotSelectionNotInProgress is returned when a claim is made after every team has chosen
+	ErrSlotSelectionNotInProgress = errors.New("slot selection is not in progress")
+	// ErrNotTeamsTurn is returned when a team claims a slot out of turn
+	ErrNotTeamsTurn = errors.New("it is not this team's turn to choose a slot")
+	// ErrSlotTaken is returned when the requested slot has already been claimed
+	ErrSlotTaken = errors.New("draft slot has already been claimed")
+	// ErrInvalidSlot is returned when the requested slot is outside the draft order
+	ErrInvalidSlot = errors.New("invalid draft slot")
+	// ErrTurnNotExpired is returned when auto-assignment races a claim that reset the turn
+	ErrTurnNotExpired = errors.New("current turn has not expired")
+	// ErrDraftPicksExist is returned when slot selection starts after picks were generated from the old order
+	ErrDraftPicksExist = errors.New("draft picks have already been generated for this draft")
+	// ErrNotCommissioner is returned when someone other than the commissioner starts slot selection
+	ErrNotCommissioner = errors.New("only the league commissioner can do this")
+	// ErrNotTeamManager is returned when a user claims a slot for a team they don't manage
+	ErrNotTeamManager = errors.New("only the team's owner or a co-manager can claim its slot")
+)
+
+// StartSlotSelectionRequest represents a request to start slot selection for a draft
+type StartSlotSelectionRequest struct {
+	DraftID uuid.UUID `json:"draft_id"`
+	// Teams are the draft's teams; the order they choose a slot in is drawn by lottery
+	Teams               []uuid.UUID `json:"teams"`
+	TimePerSelectionSec int         `json:"time_per_selection_sec"`
+	StartedBy           *uuid.UUID  `json:"started_by,omitempty"` // nil for trusted callers
+}
+
+// ClaimDraftSlotRequest represents a team claiming a draft slot on its turn
+type ClaimDraftSlotRequest struct {
+	DraftID       uuid.UUID  `json:"draft_id"`
+	FantasyTeamID uuid.UUID  `json:"fantasy_team_id"`
+	Slot          int        `json:"slot"`
+	ClaimedBy     *uuid.UUID `json:"claimed_by,omitempty"` // nil for trusted callers
+}
+
+// SlotClaimResult represents the outcome of a claim along with the updated selection
+type SlotClaimResult struct {
+	Claim     models.DraftSlotClaim      `json:"claim"`
+	Selection *models.DraftSlotSelection `json:"selection"`
+}
@@ -0,0 +1,104 @@
+// Command streammigrate moves the draft events retained on the legacy DRAFT_EVENTS stream to
+// the per-class DRAFT_STATE and DRAFT_ACTIVITY streams. To cut over:
+//
+//  1. Stop the outbox worker; events written meanwhile wait in the outbox.
+//  2. Run streammigrate, with -dry-run first to see what will be copied.
+//  3. Start the outbox worker and consumers that use the class streams. Consumers get new
+//     durable consumers there and catch up as they would after being offline.
+//  4. Once nothing reads the legacy stream, run streammigrate -delete-legacy to remove it.
+//
+// Rerunning the copy within the streams' duplicate window is safe.
+//
+//	streammigrate [-dry-run | -delete-legacy]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/joho/godotenv"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/draft/streammigrate"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run migrates the legacy stream and returns the process exit code
+func run(args []string) int {
+	fs := flag.NewFlagSet("streammigrate", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "count the messages that would be copied without changing any stream")
+	deleteLegacy := fs.Bool("delete-legacy", false, "delete the legacy stream instead of copying it")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Debug().Err(err).Msg("could not load .env file")
+	}
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	nc, err := natsconfig.NewConfigFromEnv().Connect()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "streammigrate: connect to NATS: %v\n", err)
+		return 1
+	}
+	defer nc.Close()
+	js, err := jetstream.New(nc)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "streammigrate: create JetStream context: %v\n", err)
+		return 1
+	}
+
+	if *deleteLegacy {
+		if err := streammigrate.DeleteLegacyStream(ctx, js); err != nil {
+			fmt.Fprintf(os.Stderr, "streammigrate: %v\n", err)
+			return 1
+		}
+		log.Info().Msg("deleted legacy draft event stream")
+		return 0
+	}
+
+	config := streammigrate.DefaultConfig()
+	config.DryRun = *dryRun
+	result, err := streammigrate.Migrate(ctx, js, config)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "streammigrate: %v\n", err)
+		return 1
+	}
+
+	logEvent := log.Info().
+		Bool("dry_run", *dryRun).
+		Int("read", result.Read).
+		Int("duplicates", result.Duplicates).
+		Int("expired", result.Expired).
+		Int("unknown", result.Unknown)
+	for stream, copied := range result.Copied {
+		logEvent = logEvent.Int("copied_"+stream, copied)
+	}
+	logEvent.Msg("migrated legacy draft event stream")
+	return 0
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
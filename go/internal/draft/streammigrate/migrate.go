@@ -0,0 +1,178 @@
+package streammigrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/draft/outbox/worker"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+)
+
+// Config holds configuration for copying the legacy draft event stream into the class streams
+type Config struct {
+	// Streams is how the class streams are set up; it should match the outbox worker's
+	Streams      worker.JetStreamConfig
+	FetchBatch   int           // Messages fetched per request
+	FetchMaxWait time.Duration // How long a fetch waits for messages
+	DryRun       bool          // Count what would be copied without creating streams or publishing
+}
+
+// DefaultConfig returns default migration configuration
+func DefaultConfig() Config {
+	return Config{
+		Streams:      worker.DefaultJetStreamConfig(),
+		FetchBatch:   500,
+		FetchMaxWait: 2 * time.Second,
+	}
+}
+
+// Result summarizes a migration
+type Result struct {
+	Read       int            // messages read from the legacy stream
+	Copied     map[string]int // messages published, by class stream
+	Duplicates int            // messages the class streams already had
+	Expired    int            // messages older than their class stream keeps
+	Unknown    int            // messages of event types without a class
+}
+
+// Migrate copies every message on the legacy stream to the class stream of its event type, in
+// stream order, under the event type's new subject. Messages keep their body and headers and
+// are published with their event ID as the message ID, so a rerun within the streams'
+// duplicate window, or an event the outbox worker publishes again, isn't stored twice.
+// Messages the class stream would already have aged out are left behind.
+//
+// Run it while the outbox worker is stopped, before the worker publishing to the class streams
+// starts: events written meanwhile wait in the outbox and follow the copied history in order.
+func Migrate(ctx context.Context, js jetstream.JetStream, config Config) (*Result, error) {
+	result := &Result{Copied: make(map[string]int)}
+
+	legacy, err := js.Stream(ctx, events.LegacyStream)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get legacy stream: %w", err)
+	}
+	lastSeq := legacy.CachedInfo().State.LastSeq
+	if lastSeq == 0 {
+		return result, nil
+	}
+
+	if !config.DryRun {
+		if err := worker.EnsureStreams(ctx, js, config.Streams); err != nil {
+			return nil, fmt.Errorf("ensure class streams: %w", err)
+		}
+	}
+
+	consumer, err := js.OrderedConsumer(ctx, events.LegacyStream, jetstream.OrderedConsumerConfig{
+		DeliverPolicy: jetstream.DeliverAllPolicy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create ordered consumer: %w", err)
+	}
+
+	now := time.Now()
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		batch, err := consumer.Fetch(config.FetchBatch, jetstream.FetchMaxWait(config.FetchMaxWait))
+		if err != nil {
+			return nil, fmt.Errorf("fetch messages: %w", err)
+		}
+
+		received := 0
+		done := false
+		for msg := range batch.Messages() {
+			received++
+			meta, err := msg.Metadata()
+			if err != nil {
+				return nil, fmt.Errorf("read message metadata: %w", err)
+			}
+			if meta.Sequence.Stream >= lastSeq {
+				done = true
+			}
+			result.Read++
+
+			if err := copyMessage(ctx, js, config, msg, now.Sub(meta.Timestamp), result); err != nil {
+				return nil, fmt.Errorf("copy message %d: %w", meta.Sequence.Stream, err)
+			}
+		}
+		if err := batch.Error(); err != nil {
+			return nil, fmt.Errorf("fetch messages: %w", err)
+		}
+		// An empty fetch means the stream ran out before lastSeq, its tail having aged out
+		if done || received == 0 {
+			return result, nil
+		}
+	}
+}
+
+// copyMessage publishes a legacy message to its class stream, counting the outcome
+func copyMessage(ctx context.Context, js jetstream.JetStream, config Config, msg jetstream.Msg, age time.Duration, result *Result) error {
+	eventType := msg.Headers().Get("Event-Type")
+	if eventType == "" {
+		eventType, _ = events.LegacyEventType(msg.Subject())
+	}
+	class := events.Class(eventType)
+	if class == "" {
+		result.Unknown++
+		log.Warn().
+			Str("subject", msg.Subject()).
+			Str("event_type", eventType).
+			Msg("legacy message has no event class, leaving it behind")
+		return nil
+	}
+
+	stream := class.Stream()
+	if maxAge := config.Streams.Retention[stream].MaxAge; maxAge > 0 && age > maxAge {
+		result.Expired++
+		return nil
+	}
+	if config.DryRun {
+		result.Copied[stream]++
+		return nil
+	}
+
+	opts := []jetstream.PublishOpt{jetstream.WithExpectStream(stream)}
+	if eventID := msg.Headers().Get("Event-ID"); eventID != "" {
+		opts = append(opts, jetstream.WithMsgID(eventID))
+	}
+	// The event's own headers carry over; JetStream's are set again by the publish options
+	header := nats.Header{}
+	for key, values := range msg.Headers() {
+		if !strings.HasPrefix(key, "Nats-") {
+			header[key] = values
+		}
+	}
+	ack, err := js.PublishMsg(ctx, &nats.Msg{
+		Subject: events.Subject(eventType),
+		Data:    msg.Data(),
+		Header:  header,
+	}, opts...)
+	if err != nil {
+		return fmt.Errorf("publish to %s: %w", stream, err)
+	}
+	if ack.Duplicate {
+		result.Duplicates++
+	} else {
+		result.Copied[stream]++
+	}
+	return nil
+}
+
+// DeleteLegacyStream removes the legacy stream and the consumers left on it, once its messages
+// have been copied and nothing reads it
+func DeleteLegacyStream(ctx context.Context, js jetstream.JetStream) error {
+	if err := js.DeleteStream(ctx, events.LegacyStream); err != nil && !errors.Is(err, jetstream.ErrStreamNotFound) {
+		return fmt.Errorf("delete legacy stream: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+package streammonitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+)
+
+// consumerVars publishes the health of each watched consumer, and alertVars how many times
+// each has started falling behind during live drafts
+var (
+	consumerVars = expvar.NewMap("jetstream_consumers")
+	alertVars    = expvar.NewMap("jetstream_consumer_alerts")
+)
+
+// progress is the last time a consumer was seen delivering messages
+type progress struct {
+	streamSeq uint64
+	at        time.Time
+}
+
+// Monitor periodically checks how far the consumers of a stream are behind it. Lag is always
+// reported; it is only alerted on while drafts are in progress, when a consumer falling behind
+// holds up picks, clocks and what players see.
+type Monitor struct {
+	activity DraftActivity
+	nc       *nats.Conn
+	js       jetstream.JetStream
+	config   Config
+
+	mu       sync.RWMutex
+	report   *Report
+	progress map[string]progress
+	alerting map[string]bool
+}
+
+// NewMonitor connects to NATS and publishes the health of the configured consumers as expvar
+// metrics
+func NewMonitor(activity DraftActivity, config Config) (*Monitor, error) {
+	opts := []nats.Option{
+		nats.MaxReconnects(config.MaxReconnects),
+		nats.ReconnectWait(config.ReconnectWait),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			log.Error().Err(err).Msg("NATS disconnected")
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Info().Str("url", nc.ConnectedUrl()).Msg("NATS reconnected")
+		}),
+	}
+
+	nc, err := config.NATS.Connect(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("create JetStream context: %w", err)
+	}
+
+	m := &Monitor{
+		activity: activity,
+		nc:       nc,
+		js:       js,
+		config:   config,
+		progress: make(map[string]progress),
+		alerting: make(map[string]bool),
+	}
+
+	for _, name := range config.Consumers {
+		consumerVars.Set(name, expvar.Func(func() any {
+			return m.consumerHealth(name)
+		}))
+	}
+
+	return m, nil
+}
+
+// Start checks the consumers every poll interval until ctx is cancelled
+func (m *Monitor) Start(ctx context.Context) error {
+	log.Info().
+		Str("stream", m.config.StreamName).
+		Strs("consumers", m.config.Consumers).
+		Dur("poll_interval", m.config.PollInterval).
+		Msg("starting stream monitor")
+
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		m.check(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("stream monitor shutting down")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Report returns the health of the consumers as of the last check, or nil before the first
+func (m *Monitor) Report() *Report {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.report
+}
+
+// Handler serves the last report as JSON, with 503 Service Unavailable while a consumer is
+// alerting or the stream couldn't be checked
+func (m *Monitor) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := m.Report()
+		if report == nil {
+			http.Error(w, "stream not checked yet", http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		if !report.Healthy() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			log.Error().Err(err).Msg("failed to write stream health report")
+		}
+	})
+}
+
+// Close closes the NATS connection
+func (m *Monitor) Close() error {
+	if m.nc != nil {
+		m.nc.Close()
+	}
+	return nil
+}
+
+// check builds a new report and alerts on consumers that started or stopped falling behind
+func (m *Monitor) check(ctx context.Context, now time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, m.config.PollInterval)
+	defer cancel()
+
+	report := &Report{
+		Stream:    m.config.StreamName,
+		CheckedAt: now,
+	}
+
+	liveDrafts, err := m.activity.CountDraftsInProgress(ctx)
+	if err != nil {
+		// Err on the side of alerting when it can't be told whether drafts are live
+		log.Error().Err(err).Msg("failed to count drafts in progress")
+		report.PrimeTime = true
+	} else {
+		report.LiveDrafts = liveDrafts
+		report.PrimeTime = liveDrafts > 0
+	}
+
+	stream, err := m.js.Stream(ctx, m.config.StreamName)
+	if err != nil {
+		report.Error = fmt.Sprintf("get stream: %v", err)
+		log.Error().Err(err).Str("stream", m.config.StreamName).Msg("failed to check stream")
+		m.setReport(report)
+		return
+	}
+	report.LastSeq = stream.CachedInfo().State.LastSeq
+
+	for _, name := range m.config.Consumers {
+		report.Consumers = append(report.Consumers, m.checkConsumer(ctx, stream, name, report.PrimeTime, now))
+	}
+	m.setReport(report)
+}
+
+// checkConsumer measures one consumer's lag and logs when it starts or stops alerting
+func (m *Monitor) checkConsumer(ctx context.Context, stream jetstream.Stream, name string, primeTime bool, now time.Time) ConsumerHealth {
+	health := ConsumerHealth{Consumer: name}
+
+	consumer, err := stream.Consumer(ctx, name)
+	if err == nil {
+		var info *jetstream.ConsumerInfo
+		if info, err = consumer.Info(ctx); err == nil {
+			health.Found = true
+			health.Pending = info.NumPending
+			health.AckPending = info.NumAckPending
+			health.Redelivered = info.NumRedelivered
+			health.DeliveredStreamSeq = info.Delivered.Stream
+			health.LastActive = info.Delivered.Last
+		}
+	}
+
+	switch {
+	case errors.Is(err, jetstream.ErrConsumerNotFound):
+		health.Behind, health.Reason = true, "consumer not found"
+	case err != nil:
+		health.Behind, health.Reason = true, fmt.Sprintf("get consumer: %v", err)
+	case health.Pending > m.config.MaxPending:
+		health.Behind = true
+		health.Reason = fmt.Sprintf("%d messages pending, more than %d", health.Pending, m.config.MaxPending)
+	case health.AckPending > m.config.MaxAckPending:
+		health.Behind = true
+		health.Reason = fmt.Sprintf("%d messages awaiting ack, more than %d", health.AckPending, m.config.MaxAckPending)
+	case m.stalled(name, health, now):
+		health.Behind = true
+		health.Reason = fmt.Sprintf("no messages delivered for %s with %d pending", m.config.StallAfter, health.Pending)
+	}
+	health.Alerting = health.Behind && primeTime
+
+	m.mu.Lock()
+	wasAlerting := m.alerting[name]
+	m.alerting[name] = health.Alerting
+	m.mu.Unlock()
+
+	switch {
+	case health.Alerting && !wasAlerting:
+		alertVars.Add(name, 1)
+		log.Error().
+			Str("consumer", name).
+			Str("stream", m.config.StreamName).
+			Uint64("pending", health.Pending).
+			Int("ack_pending", health.AckPending).
+			Str("reason", health.Reason).
+			Msg("JetStream consumer falling behind during live drafts")
+	case !health.Alerting && wasAlerting:
+		log.Info().
+			Str("consumer", name).
+			Str("stream", m.config.StreamName).
+			Msg("JetStream consumer caught up")
+	case health.Behind && !primeTime:
+		log.Debug().
+			Str("consumer", name).
+			Str("reason", health.Reason).
+			Msg("JetStream consumer behind with no drafts in progress")
+	}
+	return health
+}
+
+// stalled reports whether a consumer with messages pending hasn't delivered any for
+// StallAfter, tracking when each consumer last made progress
+func (m *Monitor) stalled(name string, health ConsumerHealth, now time.Time) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	last, seen := m.progress[name]
+	if !seen || health.DeliveredStreamSeq != last.streamSeq || health.Pending == 0 {
+		m.progress[name] = progress{streamSeq: health.DeliveredStreamSeq, at: now}
+		return false
+	}
+	return now.Sub(last.at) >= m.config.StallAfter
+}
+
+func (m *Monitor) setReport(report *Report) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.report = report
+}
+
+// consumerHealth returns a consumer's health from the last report for expvar
+func (m *Monitor) consumerHealth(name string) any {
+	report := m.Report()
+	if report == nil {
+		return nil
+	}
+	for _, c := range report.Consumers {
+		if c.Consumer == name {
+			return c
+		}
+	}
+	return nil
+}
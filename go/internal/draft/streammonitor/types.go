@@ -0,0 +1,86 @@
+package streammonitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+)
+
+// DraftActivity tells the monitor whether drafts are being run, which is when consumer lag is
+// felt by players and worth alerting on
+type DraftActivity interface {
+	CountDraftsInProgress(ctx context.Context) (int64, error)
+}
+
+// Config holds configuration for the stream monitor
+type Config struct {
+	NATS          natsconfig.Config
+	StreamName    string
+	Consumers     []string      // Durable consumers expected on the stream
+	PollInterval  time.Duration // How often consumer lag is checked
+	MaxPending    uint64        // Messages not yet delivered before a consumer is behind
+	MaxAckPending int           // Messages delivered but not acked before a consumer is behind
+	StallAfter    time.Duration // How long a consumer with pending messages may deliver none
+	MaxReconnects int
+	ReconnectWait time.Duration
+}
+
+// DefaultConfig returns default stream monitor configuration, watching the consumers of
+// draft lifecycle and pick events. Activity consumers aren't watched: they can't hold up a
+// draft.
+func DefaultConfig() Config {
+	return Config{
+		NATS:       natsconfig.Default(),
+		StreamName: events.StateStream,
+		Consumers: []string{
+			"draft-orchestrator",
+			"draft-gateway",
+			"draft-webhooks",
+			"roster-sync",
+			"transaction-log",
+		},
+		PollInterval:  15 * time.Second,
+		MaxPending:    100,
+		MaxAckPending: 50,
+		StallAfter:    time.Minute,
+		MaxReconnects: -1, // Infinite
+		ReconnectWait: 2 * time.Second,
+	}
+}
+
+// ConsumerHealth is how far a consumer is behind its stream
+type ConsumerHealth struct {
+	Consumer           string     `json:"consumer"`
+	Found              bool       `json:"found"`
+	Pending            uint64     `json:"pending"`     // not yet delivered
+	AckPending         int        `json:"ack_pending"` // delivered but not acked
+	Redelivered        int        `json:"redelivered"`
+	DeliveredStreamSeq uint64     `json:"delivered_stream_seq"`
+	LastActive         *time.Time `json:"last_active,omitempty"`
+	Behind             bool       `json:"behind"`
+	Reason             string     `json:"reason,omitempty"` // why the consumer is behind
+	Alerting           bool       `json:"alerting"`         // behind while drafts are in progress
+}
+
+// Report is the health of a stream's consumers as of the last check
+type Report struct {
+	Stream     string           `json:"stream"`
+	LastSeq    uint64           `json:"last_seq"`
+	LiveDrafts int64            `json:"live_drafts"`
+	PrimeTime  bool             `json:"prime_time"` // drafts are in progress, so lag is alerted on
+	Consumers  []ConsumerHealth `json:"consumers"`
+	CheckedAt  time.Time        `json:"checked_at"`
+	Error      string           `json:"error,omitempty"` // set when the stream couldn't be checked
+}
+
+// Healthy reports whether no consumer is alerting
+func (r *Report) Healthy() bool {
+	for _, c := range r.Consumers {
+		if c.Alerting {
+			return false
+		}
+	}
+	return r.Error == ""
+}
@@ -0,0 +1,268 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/draft/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+)
+
+// Enqueuer queues an event for every webhook of its draft. Implementations must be
+// idempotent since JetStream may redeliver a message.
+type Enqueuer interface {
+	EnqueueWebhookDeliveries(ctx context.Context, arg db.EnqueueWebhookDeliveriesParams) (int64, error)
+}
+
+// Config holds configuration for the webhook consumer
+type Config struct {
+	NATS           natsconfig.Config
+	StreamName     string
+	ConsumerName   string
+	SubjectFilters []string      // Only pick events are posted to webhooks
+	MaxDeliver     int           // Max delivery attempts
+	AckWait        time.Duration // How long to wait for ack
+	MaxAckPending  int           // Max messages pending ack
+	MaxReconnects  int
+	ReconnectWait  time.Duration
+}
+
+// DefaultConfig returns default webhook consumer configuration
+func DefaultConfig() Config {
+	return Config{
+		NATS:         natsconfig.Default(),
+		StreamName:   events.StateStream,
+		ConsumerName: "draft-webhooks",
+		SubjectFilters: []string{
+			events.Subject(events.PickStarted),
+			events.Subject(events.PickMade),
+			events.Subject(events.PickSkipped),
+			events.Subject(events.PickSlotReassigned),
+			events.Subject(events.PickClockWarning),
+			events.Subject(events.PickClockExtended),
+		},
+		MaxDeliver:    10,
+		AckWait:       30 * time.Second,
+		MaxAckPending: 100,
+		MaxReconnects: -1, // Infinite
+		ReconnectWait: 2 * time.Second,
+	}
+}
+
+// Delivery is the body posted to a webhook: the draft event with its envelope
+type Delivery struct {
+	EventID       string          `json:"event_id"`
+	EventType     string          `json:"event_type"`
+	DraftID       string          `json:"draft_id"`
+	Sequence      int64           `json:"sequence"`
+	SchemaVersion int             `json:"schema_version"`
+	Timestamp     time.Time       `json:"timestamp"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// Consumer queues pick events for delivery to their draft's webhooks. The Dispatcher sends
+// them, so a slow or failing endpoint never holds up the stream.
+type Consumer struct {
+	enqueuer Enqueuer
+	nc       *nats.Conn
+	js       jetstream.JetStream
+	consumer jetstream.Consumer
+	config   Config
+}
+
+// NewConsumer connects to NATS and creates or binds the durable webhook consumer
+func NewConsumer(enqueuer Enqueuer, config Config) (*Consumer, error) {
+	opts := []nats.Option{
+		nats.MaxReconnects(config.MaxReconnects),
+		nats.ReconnectWait(config.ReconnectWait),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			log.Error().Err(err).Msg("NATS disconnected")
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Info().Str("url", nc.ConnectedUrl()).Msg("NATS reconnected")
+		}),
+	}
+
+	nc, err := config.NATS.Connect(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("create JetStream context: %w", err)
+	}
+
+	c := &Consumer{
+		enqueuer: enqueuer,
+		nc:       nc,
+		js:       js,
+		config:   config,
+	}
+
+	if err := c.ensureConsumer(context.Background()); err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("ensure consumer: %w", err)
+	}
+
+	return c, nil
+}
+
+// ensureConsumer creates or gets the JetStream consumer
+func (c *Consumer) ensureConsumer(ctx context.Context) error {
+	stream, err := c.js.Stream(ctx, c.config.StreamName)
+	if err != nil {
+		return fmt.Errorf("get stream: %w", err)
+	}
+
+	consumerConfig := jetstream.ConsumerConfig{
+		Name:           c.config.ConsumerName,
+		Durable:        c.config.ConsumerName,
+		Description:    "Webhook consumer queueing pick events for draft webhooks",
+		FilterSubjects: c.config.SubjectFilters,
+		// Start from events published once the consumer exists rather than replaying the
+		// stream's history to webhooks; events missed while offline are still caught up on
+		DeliverPolicy: jetstream.DeliverNewPolicy,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		MaxDeliver:    c.config.MaxDeliver,
+		AckWait:       c.config.AckWait,
+		MaxAckPending: c.config.MaxAckPending,
+		ReplayPolicy:  jetstream.ReplayInstantPolicy,
+	}
+
+	consumer, err := stream.Consumer(ctx, c.config.ConsumerName)
+	if err != nil {
+		consumer, err = stream.CreateConsumer(ctx, consumerConfig)
+		if err != nil {
+			return fmt.Errorf("create consumer: %w", err)
+		}
+		log.Info().
+			Str("consumer", c.config.ConsumerName).
+			Str("stream", c.config.StreamName).
+			Msg("created JetStream consumer")
+	} else {
+		log.Info().
+			Str("consumer", c.config.ConsumerName).
+			Str("stream", c.config.StreamName).
+			Msg("using existing JetStream consumer")
+	}
+
+	c.consumer = consumer
+	return nil
+}
+
+// Start consumes pick events until ctx is cancelled
+func (c *Consumer) Start(ctx context.Context) error {
+	log.Info().
+		Str("consumer", c.config.ConsumerName).
+		Str("stream", c.config.StreamName).
+		Msg("starting webhook consumer")
+
+	messageCh := make(chan jetstream.Msg, 100)
+
+	consumeCtx, err := c.consumer.Consume(func(msg jetstream.Msg) {
+		select {
+		case messageCh <- msg:
+		case <-ctx.Done():
+			msg.Nak()
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("start consumer: %w", err)
+	}
+	defer consumeCtx.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("webhook consumer shutting down")
+			return nil
+		case msg := <-messageCh:
+			if err := c.processMessage(ctx, msg); err != nil {
+				log.Error().
+					Err(err).
+					Str("subject", msg.Subject()).
+					Msg("failed to queue webhook deliveries")
+				if nakErr := msg.Nak(); nakErr != nil {
+					log.Error().Err(nakErr).Msg("failed to NAK message")
+				}
+				continue
+			}
+			if ackErr := msg.Ack(); ackErr != nil {
+				log.Error().Err(ackErr).Msg("failed to ACK message")
+			}
+		}
+	}
+}
+
+// processMessage queues a single pick event for the webhooks of its draft
+func (c *Consumer) processMessage(ctx context.Context, msg jetstream.Msg) error {
+	var envelope struct {
+		EventID       string          `json:"eventId"`
+		EventType     string          `json:"eventType"`
+		DraftID       string          `json:"draftId"`
+		Timestamp     time.Time       `json:"timestamp"`
+		Payload       json.RawMessage `json:"payload"`
+		Sequence      int64           `json:"sequence"`
+		SchemaVersion int             `json:"schemaVersion"`
+	}
+	if err := json.Unmarshal(msg.Data(), &envelope); err != nil {
+		return fmt.Errorf("unmarshal event envelope: %w", err)
+	}
+
+	eventID, err := uuid.Parse(envelope.EventID)
+	if err != nil {
+		return fmt.Errorf("parse event ID: %w", err)
+	}
+	draftID, err := uuid.Parse(envelope.DraftID)
+	if err != nil {
+		return fmt.Errorf("parse draft ID: %w", err)
+	}
+
+	body, err := json.Marshal(Delivery{
+		EventID:       envelope.EventID,
+		EventType:     envelope.EventType,
+		DraftID:       envelope.DraftID,
+		Sequence:      envelope.Sequence,
+		SchemaVersion: envelope.SchemaVersion,
+		Timestamp:     envelope.Timestamp,
+		Payload:       envelope.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal delivery: %w", err)
+	}
+
+	queued, err := c.enqueuer.EnqueueWebhookDeliveries(ctx, db.EnqueueWebhookDeliveriesParams{
+		EventID:   eventID,
+		EventType: envelope.EventType,
+		Body:      body,
+		DraftID:   draftID,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("draft_id", envelope.DraftID).
+		Str("event_type", envelope.EventType).
+		Int64("queued", queued).
+		Msg("queued webhook deliveries")
+
+	return nil
+}
+
+// Close closes the NATS connection
+func (c *Consumer) Close() error {
+	if c.nc != nil {
+		c.nc.Close()
+	}
+	return nil
+}
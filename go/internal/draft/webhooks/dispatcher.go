@@ -0,0 +1,215 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/draft/draft/db"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// errPrivateAddress is returned when a webhook resolves to an address it may not reach
+var errPrivateAddress = errors.New("webhook address is not public")
+
+// DispatcherConfig configures the webhook dispatcher
+type DispatcherConfig struct {
+	PollInterval   time.Duration
+	BatchSize      int32
+	RequestTimeout time.Duration // how long an endpoint has to respond
+	MaxAttempts    int32         // attempts before a delivery is given up on
+	InitialBackoff time.Duration // wait before the first retry, doubled for each one after
+	MaxBackoff     time.Duration
+	UserAgent      string
+	// AllowPrivateAddresses lets webhooks reach loopback and private network addresses, for
+	// local development. Otherwise a commissioner could point a webhook at internal services.
+	AllowPrivateAddresses bool
+}
+
+// DefaultDispatcherConfig returns the default dispatcher configuration. A delivery is retried
+// for about an hour and a half before it is given up on.
+func DefaultDispatcherConfig() DispatcherConfig {
+	return DispatcherConfig{
+		PollInterval:   2 * time.Second,
+		BatchSize:      20,
+		RequestTimeout: 10 * time.Second,
+		MaxAttempts:    10,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     time.Hour,
+		UserAgent:      "Dynasty-Webhooks/1.0",
+	}
+}
+
+// Dispatcher posts queued pick events to draft webhooks, retrying failed deliveries with
+// exponential backoff. Deliveries are claimed with FOR UPDATE SKIP LOCKED, so several
+// dispatchers can run at once. A delivery may be sent more than once; receivers can tell
+// repeats apart by the X-Dynasty-Delivery header.
+type Dispatcher struct {
+	db     *sql.DB
+	client *http.Client
+	config DispatcherConfig
+}
+
+// NewDispatcher creates a webhook dispatcher
+func NewDispatcher(db *sql.DB, cfg DispatcherConfig) *Dispatcher {
+	return &Dispatcher{
+		db:     db,
+		client: newHTTPClient(cfg),
+		config: cfg,
+	}
+}
+
+// newHTTPClient creates the client deliveries are posted with. Unless private addresses are
+// allowed, it refuses to connect to them after DNS resolution, so a hostname can't be used to
+// reach them either.
+func newHTTPClient(cfg DispatcherConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: cfg.RequestTimeout}
+	if !cfg.AllowPrivateAddresses {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if ip = ip.Unmap(); !ip.IsGlobalUnicast() || ip.IsPrivate() {
+				return fmt.Errorf("%w: %s", errPrivateAddress, ip)
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   cfg.RequestTimeout,
+		Transport: transport,
+		// A redirect is a failed delivery rather than a way around the address check
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Start polls for due deliveries until ctx is cancelled
+func (d *Dispatcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.processBatch(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Error().Err(err).Msg("process webhook delivery batch")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// processBatch attempts one batch of due deliveries and records how each went
+func (d *Dispatcher) processBatch(ctx context.Context) error {
+	newQueries := func(tx *sql.Tx) *db.Queries { return db.New(tx) }
+	return sqlutil.Run(ctx, d.db, newQueries, func(q *db.Queries) error {
+		rows, err := q.FetchDueWebhookDeliveries(ctx, d.config.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			logger := log.With().
+				Str("delivery_id", row.ID.String()).
+				Str("webhook_id", row.WebhookID.String()).
+				Str("event_type", row.EventType).
+				Logger()
+
+			sendErr := d.send(ctx, row)
+			if sendErr == nil {
+				if err := q.MarkWebhookDeliveryDelivered(ctx, row.ID); err != nil {
+					return err
+				}
+				logger.Debug().Msg("webhook delivered")
+				continue
+			}
+
+			attempts := row.Attempts + 1
+			if attempts >= d.config.MaxAttempts {
+				if err := q.GiveUpWebhookDelivery(ctx, db.GiveUpWebhookDeliveryParams{
+					LastError: sendErr.Error(),
+					ID:        row.ID,
+				}); err != nil {
+					return err
+				}
+				logger.Warn().Err(sendErr).Int32("attempts", attempts).Msg("giving up on webhook delivery")
+				continue
+			}
+
+			retryAt := time.Now().Add(d.backoff(attempts))
+			if err := q.ScheduleWebhookDeliveryRetry(ctx, db.ScheduleWebhookDeliveryRetryParams{
+				LastError:     sendErr.Error(),
+				NextAttemptAt: retryAt,
+				ID:            row.ID,
+			}); err != nil {
+				return err
+			}
+			logger.Info().Err(sendErr).Int32("attempts", attempts).Time("retry_at", retryAt).Msg("webhook delivery failed")
+		}
+		return nil
+	})
+}
+
+// send posts a delivery to its webhook, signed with the webhook's secret. Any response
+// other than a 2xx is a failure.
+func (d *Dispatcher) send(ctx context.Context, row db.FetchDueWebhookDeliveriesRow) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, row.Url, bytes.NewReader(row.Body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", d.config.UserAgent)
+	req.Header.Set("X-Dynasty-Event", row.EventType)
+	req.Header.Set("X-Dynasty-Delivery", row.ID.String())
+	req.Header.Set("X-Dynasty-Attempt", strconv.Itoa(int(row.Attempts)+1))
+	req.Header.Set(SignatureHeader, Sign(row.Secret, time.Now().Unix(), row.Body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("endpoint responded %s", resp.Status)
+	}
+	return nil
+}
+
+// backoff is how long to wait before retrying a delivery that has failed attempts times
+func (d *Dispatcher) backoff(attempts int32) time.Duration {
+	wait := d.config.InitialBackoff
+	for i := int32(1); i < attempts; i++ {
+		wait *= 2
+		if wait >= d.config.MaxBackoff {
+			return d.config.MaxBackoff
+		}
+	}
+	return wait
+}
@@ -0,0 +1,24 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+)
+
+// SignatureHeader carries a delivery's signature, in the form "t=<unix seconds>,v1=<hex HMAC>"
+const SignatureHeader = "X-Dynasty-Signature"
+
+// Sign returns the signature header value for a body sent at timestamp. The HMAC-SHA256 is
+// keyed with the webhook's secret and covers "<timestamp>.<body>", so receivers can reject
+// replayed deliveries by their age.
+func Sign(secret string, timestamp int64, body []byte) string {
+	t := strconv.FormatInt(timestamp, 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", t, hex.EncodeToString(mac.Sum(nil)))
+}
@@ -0,0 +1,101 @@
+package etag
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// Middleware tags the 200 responses to GET requests with a weak ETag of their body, unless the
+// handler set one, and answers a GET whose If-None-Match names the tag with 304 Not Modified
+// and no body. Responses to GET requests are held until the handler returns, so it's only for
+// read endpoints answering with a single body, such as Connect GET calls, not for streams.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ew := &etagWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(ew, r)
+		ew.finish(r.Header.Get("If-None-Match"))
+	})
+}
+
+// Of returns the weak ETag of a body. The tag is weak because the same content may be sent
+// in another encoding.
+func Of(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// Matches reports whether an If-None-Match header names etag, comparing weakly as RFC 9110
+// requires for If-None-Match
+func Matches(ifNoneMatch, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// etagWriter holds a response back until the handler returns, to tag it with its ETag
+type etagWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        []byte
+}
+
+func (w *etagWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+}
+
+func (w *etagWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	w.body = append(w.body, data...)
+	return len(data), nil
+}
+
+// finish sends the response held back, or 304 Not Modified when the client already holds it
+func (w *etagWriter) finish(ifNoneMatch string) {
+	header := w.Header()
+	if w.status == http.StatusOK {
+		tag := header.Get("ETag")
+		if tag == "" {
+			tag = Of(w.body)
+			header.Set("ETag", tag)
+		}
+		if ifNoneMatch != "" && Matches(ifNoneMatch, tag) {
+			for _, key := range []string{"Content-Type", "Content-Length", "Content-Encoding"} {
+				header.Del(key)
+			}
+			w.ResponseWriter.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+	if len(w.body) > 0 {
+		_, _ = w.ResponseWriter.Write(w.body)
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (w *etagWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -2,8 +2,10 @@ package fantasyteam
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mcdev12/dynasty/go/internal/models"
@@ -18,17 +20,45 @@ type FantasyTeamRepository interface {
 	GetFantasyTeamByLeagueAndOwner(ctx context.Context, ownerID, leagueID uuid.UUID) (*models.FantasyTeam, error)
 	UpdateFantasyTeam(ctx context.Context, id uuid.UUID, req UpdateFantasyTeamRequest) (*models.FantasyTeam, error)
 	DeleteFantasyTeam(ctx context.Context, id uuid.UUID) error
+	CreateTeamDelegation(ctx context.Context, req CreateTeamDelegationRequest) (*models.TeamDelegation, error)
+	GetTeamDelegation(ctx context.Context, id uuid.UUID) (*models.TeamDelegation, error)
+	HasOverlappingTeamDelegation(ctx context.Context, fantasyTeamID uuid.UUID, startsAt, endsAt time.Time) (bool, error)
+	IsLeagueMember(ctx context.Context, leagueID, userID uuid.UUID) (bool, error)
+	ListTeamDelegations(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.TeamDelegation, error)
+	ListTeamDelegateActions(ctx context.Context, fantasyTeamID uuid.UUID, limit int32) ([]models.TeamDelegateAction, error)
+	RevokeTeamDelegation(ctx context.Context, id uuid.UUID) (*models.TeamDelegation, bool, error)
+	ListMyTeams(ctx context.Context, userID uuid.UUID) ([]MyTeam, error)
 }
 
+var (
+	// ErrTeamNotFound is returned when a fantasy team does not exist
+	ErrTeamNotFound = errors.New("fantasy team not found")
+	// ErrDelegationNotFound is returned when a team delegation does not exist
+	ErrDelegationNotFound = errors.New("team delegation not found")
+	// ErrNotTeamOwner is returned when someone other than a team's owner delegates it or revokes its delegations
+	ErrNotTeamOwner = errors.New("only the team's owner can do this")
+	// ErrInvalidDelegation is returned for a delegation to the team's owner or with an invalid period
+	ErrInvalidDelegation = errors.New("invalid team delegation")
+	// ErrDelegateNotLeagueMember is returned when a team is delegated to someone outside its league
+	ErrDelegateNotLeagueMember = errors.New("delegate is not a member of the team's league")
+	// ErrDelegationOverlaps is returned when a delegation's period overlaps another of the team's delegations
+	ErrDelegationOverlaps = errors.New("team is already delegated for part of this period")
+	// ErrInvalidLimit is returned for a delegate action page size out of range
+	ErrInvalidLimit = errors.New("invalid limit")
+)
+
 // App handles fantasy teams business logic
 type App struct {
-	repo FantasyTeamRepository
+	repo    FantasyTeamRepository
+	lineups LineupChecker
 }
 
-// NewApp creates a new fantasy teams App
-func NewApp(repo FantasyTeamRepository) *App {
+// NewApp creates a new fantasy teams App. lineups finds the lineup problems shown as to-dos
+// on a user's teams.
+func NewApp(repo FantasyTeamRepository, lineups LineupChecker) *App {
 	return &App{
-		repo: repo,
+		repo:    repo,
+		lineups: lineups,
 	}
 }
 
@@ -106,6 +106,18 @@ func (q *Queries) GetFantasyTeamByLeagueAndOwner(ctx context.Context, arg GetFan
 	return i, err
 }
 
+const getFantasyTeamLeagueID = `-- name: GetFantasyTeamLeagueID :one
+SELECT league_id FROM fantasy_teams WHERE id = $1
+`
+
+// Resolve the league a fantasy team belongs to (used for tenancy checks).
+func (q *Queries) GetFantasyTeamLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, getFantasyTeamLeagueID, id)
+	var league_id uuid.UUID
+	err := row.Scan(&league_id)
+	return league_id, err
+}
+
 const getFantasyTeamsByLeague = `-- name: GetFantasyTeamsByLeague :many
 SELECT id, league_id, owner_id, name, logo_url, created_at FROM fantasy_teams WHERE league_id = $1
 `
@@ -267,6 +267,27 @@ type Team struct {
 	CreatedAt       time.Time      `json:"created_at"`
 }
 
+type TeamDelegateAction struct {
+	ID            uuid.UUID       `json:"id"`
+	DelegationID  uuid.UUID       `json:"delegation_id"`
+	FantasyTeamID uuid.UUID       `json:"fantasy_team_id"`
+	DelegateID    uuid.UUID       `json:"delegate_id"`
+	Action        string          `json:"action"`
+	Details       json.RawMessage `json:"details"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+type TeamDelegation struct {
+	ID            uuid.UUID     `json:"id"`
+	FantasyTeamID uuid.UUID     `json:"fantasy_team_id"`
+	DelegateID    uuid.UUID     `json:"delegate_id"`
+	StartsAt      time.Time     `json:"starts_at"`
+	EndsAt        time.Time     `json:"ends_at"`
+	CreatedBy     uuid.NullUUID `json:"created_by"`
+	CreatedAt     time.Time     `json:"created_at"`
+	RevokedAt     sql.NullTime  `json:"revoked_at"`
+}
+
 type User struct {
 	ID        uuid.UUID `json:"id"`
 	Username  string    `json:"username"`
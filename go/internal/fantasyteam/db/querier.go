@@ -12,11 +12,33 @@ import (
 
 type Querier interface {
 	CreateFantasyTeam(ctx context.Context, arg CreateFantasyTeamParams) (FantasyTeam, error)
+	CreateTeamDelegation(ctx context.Context, arg CreateTeamDelegationParams) (TeamDelegation, error)
 	DeleteFantasyTeam(ctx context.Context, id uuid.UUID) error
 	GetFantasyTeam(ctx context.Context, id uuid.UUID) (FantasyTeam, error)
 	GetFantasyTeamByLeagueAndOwner(ctx context.Context, arg GetFantasyTeamByLeagueAndOwnerParams) (FantasyTeam, error)
+	// Resolve the league a fantasy team belongs to (used for tenancy checks).
+	GetFantasyTeamLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
 	GetFantasyTeamsByLeague(ctx context.Context, leagueID uuid.UUID) ([]FantasyTeam, error)
 	GetFantasyTeamsByOwner(ctx context.Context, ownerID uuid.UUID) ([]FantasyTeam, error)
+	GetTeamDelegation(ctx context.Context, id uuid.UUID) (TeamDelegation, error)
+	// Resolve the league a delegation's team belongs to (used for tenancy checks).
+	GetTeamDelegationLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	// Whether a team has a delegation that hasn't been revoked covering any part of a period.
+	HasOverlappingTeamDelegation(ctx context.Context, arg HasOverlappingTeamDelegationParams) (bool, error)
+	// A user is a member of a league if they are its commissioner, own one of its fantasy teams
+	// or co-manage one of them in one of its drafts. Deleted leagues have no members.
+	IsLeagueMember(ctx context.Context, arg IsLeagueMemberParams) (bool, error)
+	// Every team a user owns or co-manages in one of its league's drafts, in leagues that aren't
+	// deleted, with its league, its record and next matchup in the league's current season, and
+	// the draft in progress it's on the clock in. Co-managers only see the clock of drafts they
+	// co-manage the team in.
+	ListMyTeams(ctx context.Context, userID uuid.UUID) ([]ListMyTeamsRow, error)
+	// Newest actions first.
+	ListTeamDelegateActions(ctx context.Context, arg ListTeamDelegateActionsParams) ([]TeamDelegateAction, error)
+	// Latest starting first.
+	ListTeamDelegations(ctx context.Context, fantasyTeamID uuid.UUID) ([]TeamDelegation, error)
+	// Ends a delegation early. Returns no rows when it was revoked already.
+	RevokeTeamDelegation(ctx context.Context, id uuid.UUID) (TeamDelegation, error)
 	UpdateFantasyTeam(ctx context.Context, arg UpdateFantasyTeamParams) (FantasyTeam, error)
 }
 
@@ -0,0 +1,61 @@
+-- name: CreateTeamDelegation :one
+INSERT INTO team_delegations (id, fantasy_team_id, delegate_id, starts_at, ends_at, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetTeamDelegation :one
+SELECT * FROM team_delegations WHERE id = $1;
+
+-- name: GetTeamDelegationLeagueID :one
+-- Resolve the league a delegation's team belongs to (used for tenancy checks).
+SELECT ft.league_id
+FROM team_delegations td
+         JOIN fantasy_teams ft ON ft.id = td.fantasy_team_id
+WHERE td.id = $1;
+
+-- name: HasOverlappingTeamDelegation :one
+-- Whether a team has a delegation that hasn't been revoked covering any part of a period.
+SELECT EXISTS (
+    SELECT 1
+    FROM team_delegations
+    WHERE fantasy_team_id = @fantasy_team_id
+      AND revoked_at IS NULL
+      AND starts_at < @ends_at
+      AND ends_at > @starts_at
+) AS overlaps;
+
+-- name: IsLeagueMember :one
+-- A user is a member of a league if they are its commissioner, own one of its fantasy teams
+-- or co-manage one of them in one of its drafts. Deleted leagues have no members.
+SELECT EXISTS (
+    SELECT 1 FROM leagues l WHERE l.id = @league_id AND l.deleted_at IS NULL
+) AND EXISTS (
+    SELECT 1 FROM leagues l WHERE l.id = @league_id AND l.commissioner_id = @user_id
+    UNION ALL
+    SELECT 1 FROM fantasy_teams ft WHERE ft.league_id = @league_id AND ft.owner_id = @user_id
+    UNION ALL
+    SELECT 1 FROM draft_co_managers cm JOIN draft d ON d.id = cm.draft_id WHERE d.league_id = @league_id AND cm.user_id = @user_id
+) AS is_member;
+
+-- name: ListTeamDelegateActions :many
+-- Newest actions first.
+SELECT *
+FROM team_delegate_actions
+WHERE fantasy_team_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
+
+-- name: ListTeamDelegations :many
+-- Latest starting first.
+SELECT *
+FROM team_delegations
+WHERE fantasy_team_id = $1
+ORDER BY starts_at DESC, id DESC;
+
+-- name: RevokeTeamDelegation :one
+-- Ends a delegation early. Returns no rows when it was revoked already.
+UPDATE team_delegations
+SET revoked_at = NOW()
+WHERE id = $1
+  AND revoked_at IS NULL
+RETURNING *;
@@ -35,4 +35,8 @@ WHERE id = $1
 RETURNING *;
 
 -- name: DeleteFantasyTeam :exec
-DELETE FROM fantasy_teams WHERE id = $1;
\ No newline at end of file
+DELETE FROM fantasy_teams WHERE id = $1;
+
+-- name: GetFantasyTeamLeagueID :one
+-- Resolve the league a fantasy team belongs to (used for tenancy checks).
+SELECT league_id FROM fantasy_teams WHERE id = $1;
\ No newline at end of file
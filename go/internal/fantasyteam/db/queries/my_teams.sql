@@ -0,0 +1,81 @@
+-- name: ListMyTeams :many
+-- Every team a user owns or co-manages in one of its league's drafts, in leagues that aren't
+-- deleted, with its league, its record and next matchup in the league's current season, and
+-- the draft in progress it's on the clock in. Co-managers only see the clock of drafts they
+-- co-manage the team in.
+SELECT ft.id,
+       ft.league_id,
+       ft.owner_id,
+       ft.name,
+       ft.logo_url,
+       ft.created_at,
+       ft.owner_id <> @user_id::uuid AS co_manager,
+       l.name AS league_name,
+       l.sport_id,
+       l.season,
+       l.league_type::TEXT AS league_type,
+       l.status::TEXT AS league_status,
+       rec.wins,
+       rec.losses,
+       rec.ties,
+       rec.points_for,
+       rec.points_against,
+       nm.id AS next_matchup_id,
+       nm.week AS next_matchup_week,
+       nm.opponent_id AS next_opponent_id,
+       nm.opponent_name AS next_opponent_name,
+       nm.home AS next_matchup_home,
+       otc.draft_id AS on_the_clock_draft_id,
+       otc.next_deadline AS on_the_clock_deadline
+FROM fantasy_teams ft
+         JOIN leagues l ON l.id = ft.league_id AND l.deleted_at IS NULL
+         CROSS JOIN LATERAL (
+    SELECT COUNT(*) FILTER (WHERE g.points_for > g.points_against) AS wins,
+           COUNT(*) FILTER (WHERE g.points_for < g.points_against) AS losses,
+           COUNT(*) FILTER (WHERE g.points_for = g.points_against) AS ties,
+           COALESCE(SUM(g.points_for), 0)::FLOAT8 AS points_for,
+           COALESCE(SUM(g.points_against), 0)::FLOAT8 AS points_against
+    FROM (SELECT CASE WHEN m.home_team_id = ft.id THEN r.home_points ELSE r.away_points END AS points_for,
+                 CASE WHEN m.home_team_id = ft.id THEN r.away_points ELSE r.home_points END AS points_against
+          FROM league_matchups m
+                   JOIN matchup_results r ON r.matchup_id = m.id
+          WHERE m.league_id = ft.league_id
+            AND m.season = l.season
+            AND ft.id IN (m.home_team_id, m.away_team_id)) g
+    ) rec
+         LEFT JOIN LATERAL (
+    SELECT m.id,
+           m.week,
+           opp.id AS opponent_id,
+           opp.name AS opponent_name,
+           m.home_team_id = ft.id AS home
+    FROM league_matchups m
+             JOIN fantasy_teams opp
+                  ON opp.id = CASE WHEN m.home_team_id = ft.id THEN m.away_team_id ELSE m.home_team_id END
+    WHERE m.league_id = ft.league_id
+      AND m.season = l.season
+      AND ft.id IN (m.home_team_id, m.away_team_id)
+      AND NOT EXISTS (SELECT 1 FROM matchup_results r WHERE r.matchup_id = m.id)
+    ORDER BY m.week
+    LIMIT 1
+    ) nm ON TRUE
+         LEFT JOIN LATERAL (
+    SELECT d.id AS draft_id, d.next_deadline
+    FROM draft d
+             JOIN draft_summary ds ON ds.draft_id = d.id
+    WHERE d.league_id = ft.league_id
+      AND d.status = 'IN_PROGRESS'
+      AND d.sandbox_of_draft_id IS NULL
+      AND ds.on_the_clock_team_id = ft.id
+      AND (ft.owner_id = @user_id::uuid
+        OR EXISTS (SELECT 1
+                   FROM draft_co_managers cm
+                   WHERE cm.draft_id = d.id
+                     AND cm.fantasy_team_id = ft.id
+                     AND cm.user_id = @user_id::uuid))
+    ORDER BY d.next_deadline NULLS LAST
+    LIMIT 1
+    ) otc ON TRUE
+WHERE ft.owner_id = @user_id::uuid
+   OR EXISTS (SELECT 1 FROM draft_co_managers cm WHERE cm.fantasy_team_id = ft.id AND cm.user_id = @user_id::uuid)
+ORDER BY l.name, ft.name, ft.id;
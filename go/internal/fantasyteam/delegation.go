@@ -0,0 +1,151 @@
+package fantasyteam
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+const (
+	// MaxDelegationLength is the longest a team may be handed to a delegate in one go; handing
+	// it over for longer is a change of owner
+	MaxDelegationLength = 90 * 24 * time.Hour
+	// defaultDelegateActionLimit is used when a delegate action list request doesn't set a limit
+	defaultDelegateActionLimit = 50
+	// maxDelegateActionLimit caps how many delegate actions one request returns
+	maxDelegateActionLimit = 200
+)
+
+// CreateTeamDelegation hands control of a team to another member of its league while the owner
+// is away. Until it ends or the owner revokes it, the delegate may set the team's lineups and
+// make its draft picks, and everything they do is recorded in the team's delegate action log.
+// A team can't have two delegates at once.
+func (a *App) CreateTeamDelegation(ctx context.Context, req CreateTeamDelegationRequest) (*models.TeamDelegation, error) {
+	if err := validateCreateTeamDelegationRequest(req, time.Now()); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	team, err := a.teamOwnedBy(ctx, req.FantasyTeamID, req.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	if req.DelegateID == team.OwnerID {
+		return nil, fmt.Errorf("%w: the team's owner can't be its delegate", ErrInvalidDelegation)
+	}
+
+	member, err := a.repo.IsLeagueMember(ctx, team.LeagueID, req.DelegateID)
+	if err != nil {
+		return nil, err
+	}
+	if !member {
+		return nil, ErrDelegateNotLeagueMember
+	}
+
+	overlaps, err := a.repo.HasOverlappingTeamDelegation(ctx, req.FantasyTeamID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	if overlaps {
+		return nil, ErrDelegationOverlaps
+	}
+
+	delegation, err := a.repo.CreateTeamDelegation(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create team delegation: %w", err)
+	}
+
+	log.Printf("Delegated team %s to user %s from %s until %s", delegation.FantasyTeamID, delegation.DelegateID, delegation.StartsAt.Format(time.RFC3339), delegation.EndsAt.Format(time.RFC3339))
+	return delegation, nil
+}
+
+// RevokeTeamDelegation hands a team back to its owner, ending its delegation early. Revoking a
+// delegation that has already been revoked changes nothing. A nil user is a trusted caller.
+func (a *App) RevokeTeamDelegation(ctx context.Context, id uuid.UUID, revokedBy *uuid.UUID) (*models.TeamDelegation, error) {
+	existing, err := a.repo.GetTeamDelegation(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDelegationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.teamOwnedBy(ctx, existing.FantasyTeamID, revokedBy); err != nil {
+		return nil, err
+	}
+
+	delegation, revoked, err := a.repo.RevokeTeamDelegation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		return existing, nil
+	}
+
+	log.Printf("Revoked delegation of team %s to user %s", delegation.FantasyTeamID, delegation.DelegateID)
+	return delegation, nil
+}
+
+// ListTeamDelegations retrieves a team's delegations, latest starting first
+func (a *App) ListTeamDelegations(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.TeamDelegation, error) {
+	delegations, err := a.repo.ListTeamDelegations(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team delegations: %w", err)
+	}
+	return delegations, nil
+}
+
+// ListTeamDelegateActions retrieves the latest actions delegates took on a team, newest first
+func (a *App) ListTeamDelegateActions(ctx context.Context, fantasyTeamID uuid.UUID, limit int) ([]models.TeamDelegateAction, error) {
+	if limit < 0 || limit > maxDelegateActionLimit {
+		return nil, fmt.Errorf("validation failed: %w: must be between 0 and %d", ErrInvalidLimit, maxDelegateActionLimit)
+	}
+	if limit == 0 {
+		limit = defaultDelegateActionLimit
+	}
+
+	actions, err := a.repo.ListTeamDelegateActions(ctx, fantasyTeamID, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team delegate actions: %w", err)
+	}
+	return actions, nil
+}
+
+// teamOwnedBy returns a team, or ErrNotTeamOwner unless the user owns it. A nil user is a
+// trusted caller.
+func (a *App) teamOwnedBy(ctx context.Context, fantasyTeamID uuid.UUID, userID *uuid.UUID) (*models.FantasyTeam, error) {
+	team, err := a.repo.GetFantasyTeam(ctx, fantasyTeamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if userID != nil && *userID != team.OwnerID {
+		return nil, ErrNotTeamOwner
+	}
+	return team, nil
+}
+
+func validateCreateTeamDelegationRequest(req CreateTeamDelegationRequest, now time.Time) error {
+	if req.FantasyTeamID == uuid.Nil {
+		return fmt.Errorf("fantasy_team_id is required")
+	}
+	if req.DelegateID == uuid.Nil {
+		return fmt.Errorf("delegate_id is required")
+	}
+	if !req.EndsAt.After(req.StartsAt) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidDelegation)
+	}
+	if !req.EndsAt.After(now) {
+		return fmt.Errorf("%w: ends_at must be in the future", ErrInvalidDelegation)
+	}
+	if req.EndsAt.Sub(req.StartsAt) > MaxDelegationLength {
+		return fmt.Errorf("%w: may last at most %d days", ErrInvalidDelegation, int(MaxDelegationLength/(24*time.Hour)))
+	}
+	return nil
+}
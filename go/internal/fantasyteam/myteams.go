@@ -0,0 +1,129 @@
+package fantasyteam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/roster"
+)
+
+// TeamToDoKind is a kind of thing that needs a user's attention on one of their teams
+type TeamToDoKind string
+
+const (
+	// TeamToDoLineupNotSet is a lineup with problems to fix before the next lineup lock
+	TeamToDoLineupNotSet TeamToDoKind = "LINEUP_NOT_SET"
+	// TeamToDoOnTheClock is a team on the clock in a draft in progress
+	TeamToDoOnTheClock TeamToDoKind = "ON_THE_CLOCK"
+)
+
+// LineupChecker checks a team's lineup ahead of the next lineup lock
+type LineupChecker interface {
+	CheckLineup(ctx context.Context, fantasyTeamID uuid.UUID) (*roster.LineupCheck, error)
+}
+
+// MyTeam is a team a user owns or co-manages, with what the app home screen shows for it
+type MyTeam struct {
+	Team        models.FantasyTeam `json:"team"`
+	CoManager   bool               `json:"co_manager"` // co-manages the team in a draft rather than owning it
+	League      MyTeamLeague       `json:"league"`
+	Record      TeamRecord         `json:"record"`
+	NextMatchup *NextMatchup       `json:"next_matchup,omitempty"` // nil without a game left on the schedule
+	ToDos       []TeamToDo         `json:"to_dos"`
+}
+
+// MyTeamLeague is the league a user's team plays in
+type MyTeamLeague struct {
+	ID         uuid.UUID           `json:"id"`
+	Name       string              `json:"name"`
+	SportID    string              `json:"sport_id"`
+	Season     string              `json:"season"`
+	LeagueType models.LeagueType   `json:"league_type"`
+	Status     models.LeagueStatus `json:"status"`
+}
+
+// TeamRecord is a team's record in the matchups of its league's current season with a final score
+type TeamRecord struct {
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	Ties          int     `json:"ties"`
+	PointsFor     float64 `json:"points_for"`
+	PointsAgainst float64 `json:"points_against"`
+}
+
+// NextMatchup is the next game on a team's schedule
+type NextMatchup struct {
+	MatchupID        uuid.UUID `json:"matchup_id"`
+	Week             int       `json:"week"`
+	OpponentTeamID   uuid.UUID `json:"opponent_team_id"`
+	OpponentTeamName string    `json:"opponent_team_name"`
+	Home             bool      `json:"home"`
+}
+
+// TeamToDo is something that needs a user's attention on one of their teams
+type TeamToDo struct {
+	Kind    TeamToDoKind `json:"kind"`
+	Detail  string       `json:"detail"`
+	DraftID *uuid.UUID   `json:"draft_id,omitempty"` // the draft the team is on the clock in
+	DueAt   *time.Time   `json:"due_at,omitempty"`   // nil for drafts without a pick clock
+}
+
+// GetMyTeams retrieves every team a user owns or co-manages across their leagues, with its
+// league, record, next matchup and to-dos. The lineups of teams the user owns in active
+// leagues are checked ahead of the next lineup lock; a team whose lineup can't be checked is
+// logged and shown without a lineup to-do rather than failing the whole list.
+func (a *App) GetMyTeams(ctx context.Context, userID uuid.UUID) ([]MyTeam, error) {
+	teams, err := a.repo.ListMyTeams(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	for i := range teams {
+		team := &teams[i]
+		if team.CoManager || team.League.Status != models.LeagueStatusActive {
+			continue
+		}
+		check, err := a.lineups.CheckLineup(ctx, team.Team.ID)
+		if errors.Is(err, roster.ErrLineupManagedAutomatically) {
+			continue
+		}
+		if err != nil {
+			log.Printf("Failed to check lineup of fantasy team %s: %v", team.Team.ID, err)
+			continue
+		}
+		if len(check.Issues) > 0 {
+			team.ToDos = append(team.ToDos, lineupToDo(check))
+		}
+	}
+
+	for i := range teams {
+		sortToDos(teams[i].ToDos)
+	}
+	return teams, nil
+}
+
+// lineupToDo asks the owner to fix the problems a lineup check found
+func lineupToDo(check *roster.LineupCheck) TeamToDo {
+	detail := "1 lineup problem to fix"
+	if len(check.Issues) > 1 {
+		detail = fmt.Sprintf("%d lineup problems to fix", len(check.Issues))
+	}
+	locksAt := check.LocksAt
+	return TeamToDo{Kind: TeamToDoLineupNotSet, Detail: detail, DueAt: &locksAt}
+}
+
+// sortToDos orders to-dos soonest due first, with those that aren't due at any time last
+func sortToDos(toDos []TeamToDo) {
+	sort.SliceStable(toDos, func(i, j int) bool {
+		if toDos[i].DueAt == nil || toDos[j].DueAt == nil {
+			return toDos[i].DueAt != nil
+		}
+		return toDos[i].DueAt.Before(*toDos[j].DueAt)
+	})
+}
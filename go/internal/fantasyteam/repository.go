@@ -3,33 +3,57 @@ package fantasyteam
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/changes"
 	"github.com/mcdev12/dynasty/go/internal/fantasyteam/db"
+	"github.com/mcdev12/dynasty/go/internal/ids"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
 )
 
 type Querier interface {
 	CreateFantasyTeam(ctx context.Context, arg db.CreateFantasyTeamParams) (db.FantasyTeam, error)
+	CreateTeamDelegation(ctx context.Context, arg db.CreateTeamDelegationParams) (db.TeamDelegation, error)
 	DeleteFantasyTeam(ctx context.Context, id uuid.UUID) error
 	GetFantasyTeam(ctx context.Context, id uuid.UUID) (db.FantasyTeam, error)
 	GetFantasyTeamByLeagueAndOwner(ctx context.Context, arg db.GetFantasyTeamByLeagueAndOwnerParams) (db.FantasyTeam, error)
+	GetFantasyTeamLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
 	GetFantasyTeamsByLeague(ctx context.Context, leagueID uuid.UUID) ([]db.FantasyTeam, error)
 	GetFantasyTeamsByOwner(ctx context.Context, ownerID uuid.UUID) ([]db.FantasyTeam, error)
+	GetTeamDelegation(ctx context.Context, id uuid.UUID) (db.TeamDelegation, error)
+	GetTeamDelegationLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	HasOverlappingTeamDelegation(ctx context.Context, arg db.HasOverlappingTeamDelegationParams) (bool, error)
+	IsLeagueMember(ctx context.Context, arg db.IsLeagueMemberParams) (bool, error)
+	ListMyTeams(ctx context.Context, userID uuid.UUID) ([]db.ListMyTeamsRow, error)
+	ListTeamDelegateActions(ctx context.Context, arg db.ListTeamDelegateActionsParams) ([]db.TeamDelegateAction, error)
+	ListTeamDelegations(ctx context.Context, fantasyTeamID uuid.UUID) ([]db.TeamDelegation, error)
+	RevokeTeamDelegation(ctx context.Context, id uuid.UUID) (db.TeamDelegation, error)
 	UpdateFantasyTeam(ctx context.Context, arg db.UpdateFantasyTeamParams) (db.FantasyTeam, error)
 }
 
 type Repository struct {
 	queries Querier
+	changes *changes.TxInserter
 }
 
-func NewRepository(querier Querier) *Repository {
+// NewRepository creates a new fantasy team repository. Changes to teams are recorded with
+// changes, in the transaction that made them.
+func NewRepository(querier Querier, changes *changes.TxInserter) *Repository {
 	return &Repository{
 		queries: querier,
+		changes: changes,
 	}
 }
 
+// q returns the repository's queries, bound to the transaction in ctx if there is one
+func (r *Repository) q(ctx context.Context) Querier {
+	return sqlutil.Bind(ctx, r.queries, func(tx *sql.Tx) Querier { return db.New(tx) })
+}
+
 type CreateFantasyTeamRequest struct {
 	LeagueID uuid.UUID `json:"league_id"`
 	OwnerID  uuid.UUID `json:"owner_id"`
@@ -42,12 +66,29 @@ type UpdateFantasyTeamRequest struct {
 	LogoURL string `json:"logo_url"`
 }
 
+// CreateTeamDelegationRequest hands control of a team to a delegate between StartsAt and EndsAt
+type CreateTeamDelegationRequest struct {
+	FantasyTeamID uuid.UUID  `json:"fantasy_team_id"`
+	DelegateID    uuid.UUID  `json:"delegate_id"`
+	StartsAt      time.Time  `json:"starts_at"`
+	EndsAt        time.Time  `json:"ends_at"`
+	CreatedBy     *uuid.UUID `json:"created_by,omitempty"` // nil for trusted callers, who may delegate any team
+}
+
 func (r *Repository) CreateFantasyTeam(ctx context.Context, req CreateFantasyTeamRequest) (*models.FantasyTeam, error) {
-	team, err := r.queries.CreateFantasyTeam(ctx, db.CreateFantasyTeamParams{
-		LeagueID: req.LeagueID,
-		OwnerID:  req.OwnerID,
-		Name:     req.Name,
-		LogoUrl:  sql.NullString{String: req.LogoURL, Valid: req.LogoURL != ""},
+	var team db.FantasyTeam
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		team, err = r.q(ctx).CreateFantasyTeam(ctx, db.CreateFantasyTeamParams{
+			LeagueID: req.LeagueID,
+			OwnerID:  req.OwnerID,
+			Name:     req.Name,
+			LogoUrl:  sql.NullString{String: req.LogoURL, Valid: req.LogoURL != ""},
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.TeamUpdated(team.ID, changes.ActionTeamCreated))
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create fantasy team: %w", err)
@@ -65,6 +106,15 @@ func (r *Repository) GetFantasyTeam(ctx context.Context, id uuid.UUID) (*models.
 	return r.dbFantasyTeamToModel(team), nil
 }
 
+func (r *Repository) GetFantasyTeamLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	leagueID, err := r.queries.GetFantasyTeamLeagueID(ctx, id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get fantasy team league: %w", err)
+	}
+
+	return leagueID, nil
+}
+
 func (r *Repository) GetFantasyTeamsByLeague(ctx context.Context, leagueID uuid.UUID) ([]models.FantasyTeam, error) {
 	teams, err := r.queries.GetFantasyTeamsByLeague(ctx, leagueID)
 	if err != nil {
@@ -104,10 +154,18 @@ func (r *Repository) GetFantasyTeamByLeagueAndOwner(ctx context.Context, ownerID
 }
 
 func (r *Repository) UpdateFantasyTeam(ctx context.Context, id uuid.UUID, req UpdateFantasyTeamRequest) (*models.FantasyTeam, error) {
-	team, err := r.queries.UpdateFantasyTeam(ctx, db.UpdateFantasyTeamParams{
-		ID:      id,
-		Name:    req.Name,
-		LogoUrl: sql.NullString{String: req.LogoURL, Valid: req.LogoURL != ""},
+	var team db.FantasyTeam
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		team, err = r.q(ctx).UpdateFantasyTeam(ctx, db.UpdateFantasyTeamParams{
+			ID:      id,
+			Name:    req.Name,
+			LogoUrl: sql.NullString{String: req.LogoURL, Valid: req.LogoURL != ""},
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.TeamUpdated(id, changes.ActionTeamUpdated))
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update fantasy team: %w", err)
@@ -116,13 +174,207 @@ func (r *Repository) UpdateFantasyTeam(ctx context.Context, id uuid.UUID, req Up
 	return r.dbFantasyTeamToModel(team), nil
 }
 
+// DeleteFantasyTeam deletes a team. Its change is recorded first, while the team's league can
+// still be looked up.
 func (r *Repository) DeleteFantasyTeam(ctx context.Context, id uuid.UUID) error {
-	if err := r.queries.DeleteFantasyTeam(ctx, id); err != nil {
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		if err := r.changes.Insert(ctx, changes.TeamUpdated(id, changes.ActionTeamDeleted)); err != nil {
+			return err
+		}
+		return r.q(ctx).DeleteFantasyTeam(ctx, id)
+	})
+	if err != nil {
 		return fmt.Errorf("failed to delete fantasy team: %w", err)
 	}
 	return nil
 }
 
+func (r *Repository) CreateTeamDelegation(ctx context.Context, req CreateTeamDelegationRequest) (*models.TeamDelegation, error) {
+	delegation, err := r.queries.CreateTeamDelegation(ctx, db.CreateTeamDelegationParams{
+		ID:            ids.New(),
+		FantasyTeamID: req.FantasyTeamID,
+		DelegateID:    req.DelegateID,
+		StartsAt:      req.StartsAt,
+		EndsAt:        req.EndsAt,
+		CreatedBy:     sqlutil.ToNullUUID(req.CreatedBy),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create team delegation: %w", err)
+	}
+
+	return r.dbTeamDelegationToModel(delegation), nil
+}
+
+func (r *Repository) GetTeamDelegation(ctx context.Context, id uuid.UUID) (*models.TeamDelegation, error) {
+	delegation, err := r.queries.GetTeamDelegation(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team delegation: %w", err)
+	}
+
+	return r.dbTeamDelegationToModel(delegation), nil
+}
+
+func (r *Repository) GetTeamDelegationLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	leagueID, err := r.queries.GetTeamDelegationLeagueID(ctx, id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get team delegation league: %w", err)
+	}
+
+	return leagueID, nil
+}
+
+// HasOverlappingTeamDelegation reports whether a team has a delegation that hasn't been revoked
+// covering any part of the period from startsAt to endsAt
+func (r *Repository) HasOverlappingTeamDelegation(ctx context.Context, fantasyTeamID uuid.UUID, startsAt, endsAt time.Time) (bool, error) {
+	overlaps, err := r.queries.HasOverlappingTeamDelegation(ctx, db.HasOverlappingTeamDelegationParams{
+		FantasyTeamID: fantasyTeamID,
+		EndsAt:        endsAt,
+		StartsAt:      startsAt,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check for overlapping team delegations: %w", err)
+	}
+	return overlaps, nil
+}
+
+func (r *Repository) IsLeagueMember(ctx context.Context, leagueID, userID uuid.UUID) (bool, error) {
+	member, err := r.queries.IsLeagueMember(ctx, db.IsLeagueMemberParams{
+		LeagueID: leagueID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check league membership: %w", err)
+	}
+	return member, nil
+}
+
+// ListTeamDelegations retrieves a team's delegations, latest starting first
+func (r *Repository) ListTeamDelegations(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.TeamDelegation, error) {
+	delegations, err := r.queries.ListTeamDelegations(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team delegations: %w", err)
+	}
+
+	result := make([]models.TeamDelegation, len(delegations))
+	for i, delegation := range delegations {
+		result[i] = *r.dbTeamDelegationToModel(delegation)
+	}
+	return result, nil
+}
+
+// ListTeamDelegateActions retrieves the latest actions delegates took on a team, newest first
+func (r *Repository) ListTeamDelegateActions(ctx context.Context, fantasyTeamID uuid.UUID, limit int32) ([]models.TeamDelegateAction, error) {
+	rows, err := r.queries.ListTeamDelegateActions(ctx, db.ListTeamDelegateActionsParams{
+		FantasyTeamID: fantasyTeamID,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team delegate actions: %w", err)
+	}
+
+	result := make([]models.TeamDelegateAction, len(rows))
+	for i, row := range rows {
+		result[i] = models.TeamDelegateAction{
+			ID:            row.ID,
+			DelegationID:  row.DelegationID,
+			FantasyTeamID: row.FantasyTeamID,
+			DelegateID:    row.DelegateID,
+			Action:        row.Action,
+			Details:       row.Details,
+			CreatedAt:     row.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// RevokeTeamDelegation ends a delegation early. It returns false when the delegation had
+// already been revoked.
+func (r *Repository) RevokeTeamDelegation(ctx context.Context, id uuid.UUID) (*models.TeamDelegation, bool, error) {
+	delegation, err := r.queries.RevokeTeamDelegation(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to revoke team delegation: %w", err)
+	}
+
+	return r.dbTeamDelegationToModel(delegation), true, nil
+}
+
+func (r *Repository) dbTeamDelegationToModel(dbDelegation db.TeamDelegation) *models.TeamDelegation {
+	return &models.TeamDelegation{
+		ID:            dbDelegation.ID,
+		FantasyTeamID: dbDelegation.FantasyTeamID,
+		DelegateID:    dbDelegation.DelegateID,
+		StartsAt:      dbDelegation.StartsAt,
+		EndsAt:        dbDelegation.EndsAt,
+		CreatedBy:     sqlutil.FromNullUUID(dbDelegation.CreatedBy),
+		CreatedAt:     dbDelegation.CreatedAt,
+		RevokedAt:     sqlutil.FromSqlTime(dbDelegation.RevokedAt),
+	}
+}
+
+// ListMyTeams retrieves every team a user owns or co-manages, ordered by league and team name,
+// with its league, record and next matchup. A team on the clock in a draft in progress comes
+// with that to-do.
+func (r *Repository) ListMyTeams(ctx context.Context, userID uuid.UUID) ([]MyTeam, error) {
+	rows, err := r.queries.ListMyTeams(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list my teams: %w", err)
+	}
+
+	teams := make([]MyTeam, len(rows))
+	for i, row := range rows {
+		team := MyTeam{
+			Team: *r.dbFantasyTeamToModel(db.FantasyTeam{
+				ID:        row.ID,
+				LeagueID:  row.LeagueID,
+				OwnerID:   row.OwnerID,
+				Name:      row.Name,
+				LogoUrl:   row.LogoUrl,
+				CreatedAt: row.CreatedAt,
+			}),
+			CoManager: row.CoManager,
+			League: MyTeamLeague{
+				ID:         row.LeagueID,
+				Name:       row.LeagueName,
+				SportID:    row.SportID,
+				Season:     row.Season,
+				LeagueType: models.LeagueType(row.LeagueType),
+				Status:     models.LeagueStatus(row.LeagueStatus),
+			},
+			Record: TeamRecord{
+				Wins:          int(row.Wins),
+				Losses:        int(row.Losses),
+				Ties:          int(row.Ties),
+				PointsFor:     row.PointsFor,
+				PointsAgainst: row.PointsAgainst,
+			},
+			ToDos: []TeamToDo{},
+		}
+		if row.NextMatchupID.Valid {
+			team.NextMatchup = &NextMatchup{
+				MatchupID:        row.NextMatchupID.UUID,
+				Week:             int(row.NextMatchupWeek.Int32),
+				OpponentTeamID:   row.NextOpponentID.UUID,
+				OpponentTeamName: row.NextOpponentName.String,
+				Home:             row.NextMatchupHome.Bool,
+			}
+		}
+		if row.OnTheClockDraftID.Valid {
+			draftID := row.OnTheClockDraftID.UUID
+			team.ToDos = append(team.ToDos, TeamToDo{
+				Kind:    TeamToDoOnTheClock,
+				Detail:  "On the clock",
+				DraftID: &draftID,
+				DueAt:   sqlutil.FromSqlTime(row.OnTheClockDeadline),
+			})
+		}
+		teams[i] = team
+	}
+	return teams, nil
+}
+
 func (r *Repository) dbFantasyTeamToModel(dbTeam db.FantasyTeam) *models.FantasyTeam {
 	return &models.FantasyTeam{
 		ID:        dbTeam.ID,
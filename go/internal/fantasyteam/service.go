@@ -2,6 +2,8 @@ package fantasyteam
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -11,7 +13,9 @@ import (
 	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
 	userv1 "github.com/mcdev12/dynasty/go/internal/genproto/user/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/user/v1/userv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -24,6 +28,11 @@ type FantasyTeamApp interface {
 	GetFantasyTeamByLeagueAndOwner(ctx context.Context, ownerID, leagueID uuid.UUID) (*models.FantasyTeam, error)
 	UpdateFantasyTeam(ctx context.Context, id uuid.UUID, req UpdateFantasyTeamRequest) (*models.FantasyTeam, error)
 	DeleteFantasyTeam(ctx context.Context, id uuid.UUID) error
+	CreateTeamDelegation(ctx context.Context, req CreateTeamDelegationRequest) (*models.TeamDelegation, error)
+	RevokeTeamDelegation(ctx context.Context, id uuid.UUID, revokedBy *uuid.UUID) (*models.TeamDelegation, error)
+	ListTeamDelegations(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.TeamDelegation, error)
+	ListTeamDelegateActions(ctx context.Context, fantasyTeamID uuid.UUID, limit int) ([]models.TeamDelegateAction, error)
+	GetMyTeams(ctx context.Context, userID uuid.UUID) ([]MyTeam, error)
 }
 
 // Service implements the FantasyTeamService gRPC interface
@@ -49,7 +58,7 @@ var _ fantasyteamv1connect.FantasyTeamServiceHandler = (*Service)(nil)
 func (s *Service) CreateFantasyTeam(ctx context.Context, req *connect.Request[fantasyteamv1.CreateFantasyTeamRequest]) (*connect.Response[fantasyteamv1.CreateFantasyTeamResponse], error) {
 	appReq, err := s.protoToCreateFantasyTeamRequest(req.Msg)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Cross-domain orchestration: validate owner exists first
@@ -82,9 +91,9 @@ func (s *Service) CreateFantasyTeam(ctx context.Context, req *connect.Request[fa
 
 // GetFantasyTeam retrieves a fantasy team by ID
 func (s *Service) GetFantasyTeam(ctx context.Context, req *connect.Request[fantasyteamv1.GetFantasyTeamRequest]) (*connect.Response[fantasyteamv1.GetFantasyTeamResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	team, err := s.app.GetFantasyTeam(ctx, id)
@@ -101,9 +110,9 @@ func (s *Service) GetFantasyTeam(ctx context.Context, req *connect.Request[fanta
 
 // GetFantasyTeamsByLeague retrieves fantasy teams by league ID
 func (s *Service) GetFantasyTeamsByLeague(ctx context.Context, req *connect.Request[fantasyteamv1.GetFantasyTeamsByLeagueRequest]) (*connect.Response[fantasyteamv1.GetFantasyTeamsByLeagueResponse], error) {
-	leagueID, err := uuid.Parse(req.Msg.LeagueId)
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Cross-domain orchestration: validate league exists first
@@ -128,9 +137,9 @@ func (s *Service) GetFantasyTeamsByLeague(ctx context.Context, req *connect.Requ
 
 // GetFantasyTeamsByOwner retrieves fantasy teams by owner ID
 func (s *Service) GetFantasyTeamsByOwner(ctx context.Context, req *connect.Request[fantasyteamv1.GetFantasyTeamsByOwnerRequest]) (*connect.Response[fantasyteamv1.GetFantasyTeamsByOwnerResponse], error) {
-	ownerID, err := uuid.Parse(req.Msg.OwnerId)
+	ownerID, err := uuidutil.MustParseOrInvalidArg("owner_id", req.Msg.OwnerId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Cross-domain orchestration: validate owner exists first
@@ -155,14 +164,13 @@ func (s *Service) GetFantasyTeamsByOwner(ctx context.Context, req *connect.Reque
 
 // GetFantasyTeamByLeagueAndOwner retrieves a fantasy team by league and owner
 func (s *Service) GetFantasyTeamByLeagueAndOwner(ctx context.Context, req *connect.Request[fantasyteamv1.GetFantasyTeamByLeagueAndOwnerRequest]) (*connect.Response[fantasyteamv1.GetFantasyTeamByLeagueAndOwnerResponse], error) {
-	ownerID, err := uuid.Parse(req.Msg.OwnerId)
+	ownerID, err := uuidutil.MustParseOrInvalidArg("owner_id", req.Msg.OwnerId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
-
-	leagueID, err := uuid.Parse(req.Msg.LeagueId)
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Cross-domain orchestration: validate owner exists first
@@ -193,11 +201,37 @@ func (s *Service) GetFantasyTeamByLeagueAndOwner(ctx context.Context, req *conne
 	}), nil
 }
 
+// GetMyTeams retrieves every team a user owns or co-manages across their leagues. Users can
+// only list their own teams.
+func (s *Service) GetMyTeams(ctx context.Context, req *connect.Request[fantasyteamv1.GetMyTeamsRequest]) (*connect.Response[fantasyteamv1.GetMyTeamsResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok && actingUser != userID {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("users can only list their own teams"))
+	}
+
+	teams, err := s.app.GetMyTeams(ctx, userID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	protoTeams := make([]*fantasyteamv1.MyTeam, len(teams))
+	for i := range teams {
+		protoTeams[i] = s.myTeamToProto(&teams[i])
+	}
+
+	return connect.NewResponse(&fantasyteamv1.GetMyTeamsResponse{
+		Teams: protoTeams,
+	}), nil
+}
+
 // UpdateFantasyTeam updates an existing fantasy team
 func (s *Service) UpdateFantasyTeam(ctx context.Context, req *connect.Request[fantasyteamv1.UpdateFantasyTeamRequest]) (*connect.Response[fantasyteamv1.UpdateFantasyTeamResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	appReq := s.protoToUpdateFantasyTeamRequest(req.Msg)
@@ -216,9 +250,9 @@ func (s *Service) UpdateFantasyTeam(ctx context.Context, req *connect.Request[fa
 
 // DeleteFantasyTeam deletes a fantasy team by ID
 func (s *Service) DeleteFantasyTeam(ctx context.Context, req *connect.Request[fantasyteamv1.DeleteFantasyTeamRequest]) (*connect.Response[fantasyteamv1.DeleteFantasyTeamResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	err = s.app.DeleteFantasyTeam(ctx, id)
@@ -231,8 +265,136 @@ func (s *Service) DeleteFantasyTeam(ctx context.Context, req *connect.Request[fa
 	}), nil
 }
 
+// CreateTeamDelegation hands a team to another league member while its owner is away
+func (s *Service) CreateTeamDelegation(ctx context.Context, req *connect.Request[fantasyteamv1.CreateTeamDelegationRequest]) (*connect.Response[fantasyteamv1.CreateTeamDelegationResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	delegateID, err := uuidutil.MustParseOrInvalidArg("delegate_id", req.Msg.DelegateId)
+	if err != nil {
+		return nil, err
+	}
+	delegation, err := s.app.CreateTeamDelegation(ctx, CreateTeamDelegationRequest{
+		FantasyTeamID: fantasyTeamID,
+		DelegateID:    delegateID,
+		StartsAt:      req.Msg.StartsAt.AsTime(),
+		EndsAt:        req.Msg.EndsAt.AsTime(),
+		CreatedBy:     interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&fantasyteamv1.CreateTeamDelegationResponse{
+		Delegation: s.teamDelegationToProto(delegation),
+	}), nil
+}
+
+// RevokeTeamDelegation hands a team back to its owner early
+func (s *Service) RevokeTeamDelegation(ctx context.Context, req *connect.Request[fantasyteamv1.RevokeTeamDelegationRequest]) (*connect.Response[fantasyteamv1.RevokeTeamDelegationResponse], error) {
+	delegationID, err := uuidutil.MustParseOrInvalidArg("delegation_id", req.Msg.DelegationId)
+	if err != nil {
+		return nil, err
+	}
+	delegation, err := s.app.RevokeTeamDelegation(ctx, delegationID, interceptors.ActingUserPtr(ctx))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&fantasyteamv1.RevokeTeamDelegationResponse{
+		Delegation: s.teamDelegationToProto(delegation),
+	}), nil
+}
+
+// ListTeamDelegations lists a team's delegations, latest starting first
+func (s *Service) ListTeamDelegations(ctx context.Context, req *connect.Request[fantasyteamv1.ListTeamDelegationsRequest]) (*connect.Response[fantasyteamv1.ListTeamDelegationsResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	delegations, err := s.app.ListTeamDelegations(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	protoDelegations := make([]*fantasyteamv1.TeamDelegation, len(delegations))
+	for i := range delegations {
+		protoDelegations[i] = s.teamDelegationToProto(&delegations[i])
+	}
+
+	return connect.NewResponse(&fantasyteamv1.ListTeamDelegationsResponse{
+		Delegations: protoDelegations,
+	}), nil
+}
+
+// ListTeamDelegateActions lists what delegates did on a team, newest first
+func (s *Service) ListTeamDelegateActions(ctx context.Context, req *connect.Request[fantasyteamv1.ListTeamDelegateActionsRequest]) (*connect.Response[fantasyteamv1.ListTeamDelegateActionsResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := s.app.ListTeamDelegateActions(ctx, fantasyTeamID, int(req.Msg.Limit))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	protoActions := make([]*fantasyteamv1.TeamDelegateAction, len(actions))
+	for i, action := range actions {
+		protoActions[i] = &fantasyteamv1.TeamDelegateAction{
+			Id:            action.ID.String(),
+			DelegationId:  action.DelegationID.String(),
+			FantasyTeamId: action.FantasyTeamID.String(),
+			DelegateId:    action.DelegateID.String(),
+			Action:        action.Action,
+			DetailsJson:   string(action.Details),
+			CreatedAt:     timestamppb.New(action.CreatedAt),
+		}
+	}
+
+	return connect.NewResponse(&fantasyteamv1.ListTeamDelegateActionsResponse{
+		Actions: protoActions,
+	}), nil
+}
+
+// toConnectError maps delegation errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidDelegation), errors.Is(err, ErrDelegateNotLeagueMember), errors.Is(err, ErrInvalidLimit):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrTeamNotFound), errors.Is(err, ErrDelegationNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrDelegationOverlaps):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, ErrNotTeamOwner):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
 // Conversion methods between proto and app layer models
 
+func (s *Service) teamDelegationToProto(delegation *models.TeamDelegation) *fantasyteamv1.TeamDelegation {
+	protoDelegation := &fantasyteamv1.TeamDelegation{
+		Id:            delegation.ID.String(),
+		FantasyTeamId: delegation.FantasyTeamID.String(),
+		DelegateId:    delegation.DelegateID.String(),
+		StartsAt:      timestamppb.New(delegation.StartsAt),
+		EndsAt:        timestamppb.New(delegation.EndsAt),
+		CreatedAt:     timestamppb.New(delegation.CreatedAt),
+		Active:        delegation.ActiveAt(time.Now()),
+	}
+	if delegation.CreatedBy != nil {
+		createdBy := delegation.CreatedBy.String()
+		protoDelegation.CreatedBy = &createdBy
+	}
+	if delegation.RevokedAt != nil {
+		protoDelegation.RevokedAt = timestamppb.New(*delegation.RevokedAt)
+	}
+	return protoDelegation
+}
+
 func (s *Service) fantasyTeamToProto(team *models.FantasyTeam) *fantasyteamv1.FantasyTeam {
 	return &fantasyteamv1.FantasyTeam{
 		Id:        team.ID.String(),
@@ -244,6 +406,92 @@ func (s *Service) fantasyTeamToProto(team *models.FantasyTeam) *fantasyteamv1.Fa
 	}
 }
 
+func (s *Service) myTeamToProto(team *MyTeam) *fantasyteamv1.MyTeam {
+	protoTeam := &fantasyteamv1.MyTeam{
+		FantasyTeam: s.fantasyTeamToProto(&team.Team),
+		CoManager:   team.CoManager,
+		League: &fantasyteamv1.MyTeamLeague{
+			Id:         team.League.ID.String(),
+			Name:       team.League.Name,
+			SportId:    team.League.SportID,
+			Season:     team.League.Season,
+			LeagueType: s.leagueTypeToProto(team.League.LeagueType),
+			Status:     s.leagueStatusToProto(team.League.Status),
+		},
+		Record: &fantasyteamv1.TeamRecord{
+			Wins:          int32(team.Record.Wins),
+			Losses:        int32(team.Record.Losses),
+			Ties:          int32(team.Record.Ties),
+			PointsFor:     team.Record.PointsFor,
+			PointsAgainst: team.Record.PointsAgainst,
+		},
+		ToDos: make([]*fantasyteamv1.TeamToDo, len(team.ToDos)),
+	}
+	if team.NextMatchup != nil {
+		protoTeam.NextMatchup = &fantasyteamv1.NextMatchup{
+			MatchupId:        team.NextMatchup.MatchupID.String(),
+			Week:             int32(team.NextMatchup.Week),
+			OpponentTeamId:   team.NextMatchup.OpponentTeamID.String(),
+			OpponentTeamName: team.NextMatchup.OpponentTeamName,
+			Home:             team.NextMatchup.Home,
+		}
+	}
+	for i, toDo := range team.ToDos {
+		protoToDo := &fantasyteamv1.TeamToDo{
+			Kind:   s.teamToDoKindToProto(toDo.Kind),
+			Detail: toDo.Detail,
+		}
+		if toDo.DraftID != nil {
+			draftID := toDo.DraftID.String()
+			protoToDo.DraftId = &draftID
+		}
+		if toDo.DueAt != nil {
+			protoToDo.DueAt = timestamppb.New(*toDo.DueAt)
+		}
+		protoTeam.ToDos[i] = protoToDo
+	}
+	return protoTeam
+}
+
+func (s *Service) teamToDoKindToProto(kind TeamToDoKind) fantasyteamv1.TeamToDoKind {
+	switch kind {
+	case TeamToDoLineupNotSet:
+		return fantasyteamv1.TeamToDoKind_TEAM_TO_DO_KIND_LINEUP_NOT_SET
+	case TeamToDoOnTheClock:
+		return fantasyteamv1.TeamToDoKind_TEAM_TO_DO_KIND_ON_THE_CLOCK
+	default:
+		return fantasyteamv1.TeamToDoKind_TEAM_TO_DO_KIND_UNSPECIFIED
+	}
+}
+
+func (s *Service) leagueTypeToProto(leagueType models.LeagueType) leaguev1.LeagueType {
+	switch leagueType {
+	case models.LeagueTypeRedraft:
+		return leaguev1.LeagueType_LEAGUE_TYPE_REDRAFT
+	case models.LeagueTypeKeeper:
+		return leaguev1.LeagueType_LEAGUE_TYPE_KEEPER
+	case models.LeagueTypeDynasty:
+		return leaguev1.LeagueType_LEAGUE_TYPE_DYNASTY
+	default:
+		return leaguev1.LeagueType_LEAGUE_TYPE_UNSPECIFIED
+	}
+}
+
+func (s *Service) leagueStatusToProto(leagueStatus models.LeagueStatus) leaguev1.LeagueStatus {
+	switch leagueStatus {
+	case models.LeagueStatusActive:
+		return leaguev1.LeagueStatus_LEAGUE_STATUS_ACTIVE
+	case models.LeagueStatusCancelled:
+		return leaguev1.LeagueStatus_LEAGUE_STATUS_CANCELLED
+	case models.LeagueStatusCompleted:
+		return leaguev1.LeagueStatus_LEAGUE_STATUS_COMPLETED
+	case models.LeagueStatusPending:
+		return leaguev1.LeagueStatus_LEAGUE_STATUS_PENDING
+	default:
+		return leaguev1.LeagueStatus_LEAGUE_STATUS_UNSPECIFIED
+	}
+}
+
 func (s *Service) fantasyTeamsToProto(teams []models.FantasyTeam) []*fantasyteamv1.FantasyTeam {
 	protoTeams := make([]*fantasyteamv1.FantasyTeam, len(teams))
 	for i, team := range teams {
@@ -253,16 +501,14 @@ func (s *Service) fantasyTeamsToProto(teams []models.FantasyTeam) []*fantasyteam
 }
 
 func (s *Service) protoToCreateFantasyTeamRequest(proto *fantasyteamv1.CreateFantasyTeamRequest) (CreateFantasyTeamRequest, error) {
-	leagueID, err := uuid.Parse(proto.LeagueId)
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", proto.LeagueId)
 	if err != nil {
 		return CreateFantasyTeamRequest{}, err
 	}
-
-	ownerID, err := uuid.Parse(proto.OwnerId)
+	ownerID, err := uuidutil.MustParseOrInvalidArg("owner_id", proto.OwnerId)
 	if err != nil {
 		return CreateFantasyTeamRequest{}, err
 	}
-
 	return CreateFantasyTeamRequest{
 		LeagueID: leagueID,
 		OwnerID:  ownerID,
@@ -0,0 +1,188 @@
+// Package faults injects failures into the draft pipeline, to check that its idempotency and
+// ordering hold up before they are needed: outbox publishes that are delayed, dropped or whose
+// acknowledgement is lost, events delivered twice to every consumer, and failing RPCs.
+//
+// Nothing is injected unless FAULTS_ENABLED is set. It is meant for load and staging
+// environments and must never be set in production.
+package faults
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// ErrInjected is the error of operations failed on purpose
+var ErrInjected = errors.New("injected fault")
+
+// Config sets how often each fault is injected. Rates are probabilities between 0 and 1.
+type Config struct {
+	// PublishDelayRate of outbox publishes wait up to PublishDelay before reaching the broker
+	PublishDelayRate float64
+	PublishDelay     time.Duration
+	// PublishDropRate of outbox publishes fail without reaching the broker; the outbox
+	// publishes them again
+	PublishDropRate float64
+	// PublishLostAckRate of outbox publishes reach the broker but report failing, so the
+	// outbox publishes them again and the broker's duplicate detection has to catch them
+	PublishLostAckRate float64
+	// DuplicateDeliveryRate of published events are published again under another message
+	// ID, getting past duplicate detection so every consumer is delivered them twice
+	DuplicateDeliveryRate float64
+
+	// RPCErrorRate of outgoing RPCs to RPCMethods fail with RPCErrorCode instead of being sent
+	RPCErrorRate float64
+	RPCErrorCode connect.Code
+	// RPCMethods are the procedures errors are injected into, e.g.
+	// "/draft.v1.DraftPickService/MakePick"; empty for every procedure
+	RPCMethods []string
+
+	// Seed makes the faults injected repeatable; zero picks a random seed
+	Seed uint64
+}
+
+// Enabled reports whether the config injects any fault
+func (c Config) Enabled() bool {
+	return c.PublishDelayRate > 0 || c.PublishDropRate > 0 || c.PublishLostAckRate > 0 ||
+		c.DuplicateDeliveryRate > 0 || c.RPCErrorRate > 0
+}
+
+// ConfigFromEnv reads the FAULTS_* environment variables. Without FAULTS_ENABLED the config
+// injects nothing, whatever else is set.
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		PublishDelay: time.Second,
+		RPCErrorCode: connect.CodeUnavailable,
+	}
+	if os.Getenv("FAULTS_ENABLED") != "true" {
+		return Config{}, nil
+	}
+
+	rates := map[string]*float64{
+		"FAULTS_PUBLISH_DELAY_RATE":      &cfg.PublishDelayRate,
+		"FAULTS_PUBLISH_DROP_RATE":       &cfg.PublishDropRate,
+		"FAULTS_PUBLISH_LOST_ACK_RATE":   &cfg.PublishLostAckRate,
+		"FAULTS_DUPLICATE_DELIVERY_RATE": &cfg.DuplicateDeliveryRate,
+		"FAULTS_RPC_ERROR_RATE":          &cfg.RPCErrorRate,
+	}
+	for key, rate := range rates {
+		v := os.Getenv(key)
+		if v == "" {
+			continue
+		}
+		r, err := strconv.ParseFloat(v, 64)
+		if err != nil || r < 0 || r > 1 {
+			return Config{}, fmt.Errorf("invalid %s %q, want a rate between 0 and 1", key, v)
+		}
+		*rate = r
+	}
+
+	if v := os.Getenv("FAULTS_PUBLISH_DELAY"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return Config{}, fmt.Errorf("invalid FAULTS_PUBLISH_DELAY %q", v)
+		}
+		cfg.PublishDelay = d
+	}
+	if v := os.Getenv("FAULTS_RPC_ERROR_CODE"); v != "" {
+		if err := cfg.RPCErrorCode.UnmarshalText([]byte(v)); err != nil {
+			return Config{}, fmt.Errorf("invalid FAULTS_RPC_ERROR_CODE %q: %w", v, err)
+		}
+	}
+	if v := os.Getenv("FAULTS_RPC_METHODS"); v != "" {
+		for _, method := range strings.Split(v, ",") {
+			if method = strings.TrimSpace(method); method != "" {
+				cfg.RPCMethods = append(cfg.RPCMethods, method)
+			}
+		}
+	}
+	if v := os.Getenv("FAULTS_SEED"); v != "" {
+		seed, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid FAULTS_SEED %q", v)
+		}
+		cfg.Seed = seed
+	}
+	return cfg, nil
+}
+
+// Injector decides which operations fail. A nil Injector injects nothing, so callers hold
+// one unconditionally.
+type Injector struct {
+	config  Config
+	methods map[string]bool
+
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// NewInjector creates an injector for a config, or returns nil when it injects nothing
+func NewInjector(cfg Config) *Injector {
+	if !cfg.Enabled() {
+		return nil
+	}
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+
+	methods := make(map[string]bool, len(cfg.RPCMethods))
+	for _, method := range cfg.RPCMethods {
+		methods[method] = true
+	}
+	return &Injector{
+		config:  cfg,
+		methods: methods,
+		rng:     rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// roll reports whether an operation injected at rate fails this time
+func (i *Injector) roll(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.rng.Float64() < rate
+}
+
+// PublishDelay returns how long to hold an outbox publish back; zero for most publishes
+func (i *Injector) PublishDelay() time.Duration {
+	if i == nil || !i.roll(i.config.PublishDelayRate) {
+		return 0
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return time.Duration(i.rng.Int64N(int64(i.config.PublishDelay))) + 1
+}
+
+// DropPublish reports whether an outbox publish fails before reaching the broker
+func (i *Injector) DropPublish() bool {
+	return i != nil && i.roll(i.config.PublishDropRate)
+}
+
+// LoseAck reports whether a publish the broker acknowledged reports failing
+func (i *Injector) LoseAck() bool {
+	return i != nil && i.roll(i.config.PublishLostAckRate)
+}
+
+// DuplicateDelivery reports whether a published event is published a second time
+func (i *Injector) DuplicateDelivery() bool {
+	return i != nil && i.roll(i.config.DuplicateDeliveryRate)
+}
+
+// failRPC reports whether a call to procedure fails
+func (i *Injector) failRPC(procedure string) bool {
+	if i == nil || (len(i.methods) > 0 && !i.methods[procedure]) {
+		return false
+	}
+	return i.roll(i.config.RPCErrorRate)
+}
@@ -0,0 +1,27 @@
+package faults
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog/log"
+)
+
+// Interceptor fails outgoing unary calls at the configured rate instead of sending them.
+// Placed inside a client's retry interceptor, injected Unavailable errors are retried like
+// real ones.
+func (i *Injector) Interceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !req.Spec().IsClient || !i.failRPC(req.Spec().Procedure) {
+				return next(ctx, req)
+			}
+			log.Warn().
+				Str("procedure", req.Spec().Procedure).
+				Str("code", i.config.RPCErrorCode.String()).
+				Msg("injecting rpc error")
+			return nil, connect.NewError(i.config.RPCErrorCode, fmt.Errorf("%w: %s", ErrInjected, req.Spec().Procedure))
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package flags
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Client answers flag checks from memory, watching the bucket so changes reach every process
+// within moments of being stored. A nil Client has every flag off, for processes running
+// without flags.
+type Client struct {
+	mu    sync.RWMutex
+	flags map[string]Flag
+
+	watcher jetstream.KeyWatcher
+	done    chan struct{}
+	// Connection opened by Dial, closed with the client
+	nc *nats.Conn
+}
+
+// NewClient loads the flags in a bucket, creating it when it doesn't exist yet, and keeps
+// them current until the client is closed
+func NewClient(ctx context.Context, js jetstream.JetStream, bucket string) (*Client, error) {
+	store, err := OpenStore(ctx, js, bucket)
+	if err != nil {
+		return nil, err
+	}
+	watcher, err := store.kv.WatchAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch flag bucket %s: %w", bucket, err)
+	}
+
+	c := &Client{
+		flags:   make(map[string]Flag),
+		watcher: watcher,
+		done:    make(chan struct{}),
+	}
+
+	// The watcher sends the flags already stored, then a nil entry once it has caught up
+	for initialized := false; !initialized; {
+		select {
+		case entry, ok := <-watcher.Updates():
+			if !ok {
+				return nil, fmt.Errorf("flag watcher for %s stopped while loading", bucket)
+			}
+			if entry == nil {
+				initialized = true
+				continue
+			}
+			c.apply(entry)
+		case <-ctx.Done():
+			watcher.Stop()
+			return nil, ctx.Err()
+		}
+	}
+
+	go c.watch()
+	return c, nil
+}
+
+// Dial connects to NATS for a client of its own, for processes with no JetStream connection
+// to share
+func Dial(ctx context.Context, natsCfg natsconfig.Config, bucket string) (*Client, error) {
+	nc, err := natsCfg.Connect(
+		nats.Name("feature-flags"),
+		nats.MaxReconnects(-1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	c, err := NewClient(ctx, js, bucket)
+	if err != nil {
+		nc.Close()
+		return nil, err
+	}
+	c.nc = nc
+	return c, nil
+}
+
+// watch applies changes to the flags until the watcher is stopped
+func (c *Client) watch() {
+	defer close(c.done)
+	for entry := range c.watcher.Updates() {
+		if entry != nil {
+			c.apply(entry)
+		}
+	}
+}
+
+// apply updates the in-memory flags with a KV entry
+func (c *Client) apply(entry jetstream.KeyValueEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch entry.Operation() {
+	case jetstream.KeyValueDelete, jetstream.KeyValuePurge:
+		delete(c.flags, entry.Key())
+	default:
+		flag, err := decodeFlag(entry)
+		if err != nil {
+			// Keep the flag as it was rather than turning it off over a bad write
+			log.Printf("Ignoring flag update: %v", err)
+			return
+		}
+		c.flags[flag.Key] = *flag
+	}
+}
+
+// Lookup returns the flag with a key, reporting false when it doesn't exist
+func (c *Client) Lookup(key string) (Flag, bool) {
+	if c == nil {
+		return Flag{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	flag, ok := c.flags[key]
+	return flag, ok
+}
+
+// Enabled reports whether a flag is on for a league
+func (c *Client) Enabled(key string, leagueID uuid.UUID) bool {
+	return c.EnabledOr(key, leagueID, false)
+}
+
+// EnabledOr reports whether a flag is on for a league, or fallback when the flag doesn't
+// exist, so features already shipped stay on until a flag turns them off
+func (c *Client) EnabledOr(key string, leagueID uuid.UUID, fallback bool) bool {
+	flag, ok := c.Lookup(key)
+	if !ok {
+		return fallback
+	}
+	return flag.EnabledFor(leagueID)
+}
+
+// Value returns a flag's value for a league, reporting false when the flag is off for it
+func (c *Client) Value(key string, leagueID uuid.UUID) (string, bool) {
+	flag, ok := c.Lookup(key)
+	if !ok || !flag.EnabledFor(leagueID) {
+		return "", false
+	}
+	return flag.Value, true
+}
+
+// Close stops watching for changes, closing the connection opened by Dial
+func (c *Client) Close() error {
+	if c == nil {
+		return nil
+	}
+	err := c.watcher.Stop()
+	<-c.done
+	if c.nc != nil {
+		c.nc.Close()
+	}
+	return err
+}
@@ -0,0 +1,174 @@
+// Command flags lists and changes the feature flags in the NATS KV bucket. Changes reach the
+// services watching the bucket within moments, without a redeploy.
+//
+//	flags list
+//	flags get <key>
+//	flags set [-enabled] [-percentage n] [-leagues id,id] [-value v] [-description d] <key>
+//	flags delete <key>
+//
+// set replaces the whole flag, so pass every setting the flag should keep.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/mcdev12/dynasty/go/internal/flags"
+)
+
+const usage = `usage:
+  flags list
+  flags get <key>
+  flags set [-enabled] [-percentage n] [-leagues id,id] [-value v] [-description d] <key>
+  flags delete <key>`
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run carries out a flag command and returns the process exit code
+func run(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, usage)
+		return 2
+	}
+
+	if err := godotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		fmt.Fprintf(os.Stderr, "flags: load .env: %v\n", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	nc, err := natsconfig.NewConfigFromEnv().Connect()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flags: connect to NATS: %v\n", err)
+		return 1
+	}
+	defer nc.Close()
+	js, err := jetstream.New(nc)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flags: create JetStream context: %v\n", err)
+		return 1
+	}
+	store, err := flags.OpenStore(ctx, js, getEnv("FEATURE_FLAGS_BUCKET", flags.DefaultBucket))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flags: %v\n", err)
+		return 1
+	}
+
+	switch args[0] {
+	case "list":
+		err = list(ctx, store)
+	case "get":
+		err = get(ctx, store, args[1:])
+	case "set":
+		err = set(ctx, store, args[1:])
+	case "delete":
+		err = remove(ctx, store, args[1:])
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flags: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func list(ctx context.Context, store *flags.Store) error {
+	all, err := store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tENABLED\tPERCENTAGE\tLEAGUES\tVALUE\tUPDATED")
+	for _, f := range all {
+		fmt.Fprintf(w, "%s\t%t\t%d\t%d\t%s\t%s\n",
+			f.Key, f.Enabled, f.Percentage, len(f.LeagueIDs), f.Value, f.UpdatedAt.Format("2006-01-02 15:04:05"))
+	}
+	return w.Flush()
+}
+
+func get(ctx context.Context, store *flags.Store, args []string) error {
+	if len(args) != 1 {
+		return errors.New("get takes a flag key")
+	}
+	f, err := store.Get(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return printFlag(f)
+}
+
+func set(ctx context.Context, store *flags.Store, args []string) error {
+	fs := flag.NewFlagSet("set", flag.ContinueOnError)
+	enabled := fs.Bool("enabled", false, "turn the flag on; without it the flag is off everywhere")
+	percentage := fs.Int("percentage", 0, "percentage of leagues the flag is on for, 0 to 100")
+	leagues := fs.String("leagues", "", "comma separated IDs of leagues the flag is always on for")
+	value := fs.String("value", "", "value handed to the leagues the flag is on for")
+	description := fs.String("description", "", "what the flag controls")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("set takes a flag key")
+	}
+
+	f := flags.Flag{
+		Key:         fs.Arg(0),
+		Enabled:     *enabled,
+		Percentage:  *percentage,
+		Value:       *value,
+		Description: *description,
+	}
+	if *leagues != "" {
+		for _, s := range strings.Split(*leagues, ",") {
+			id, err := uuid.Parse(strings.TrimSpace(s))
+			if err != nil {
+				return fmt.Errorf("invalid league ID %q: %w", s, err)
+			}
+			f.LeagueIDs = append(f.LeagueIDs, id)
+		}
+	}
+
+	stored, err := store.Put(ctx, f)
+	if err != nil {
+		return err
+	}
+	return printFlag(stored)
+}
+
+func remove(ctx context.Context, store *flags.Store, args []string) error {
+	if len(args) != 1 {
+		return errors.New("delete takes a flag key")
+	}
+	return store.Delete(ctx, args[0])
+}
+
+func printFlag(f *flags.Flag) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(f)
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,86 @@
+// Package flags holds feature flags kept in a NATS KV bucket, so features can be turned on
+// for some leagues, or a share of them, and back off again without a redeploy.
+//
+// A flag is off unless it is enabled, and an enabled flag is on for the leagues listed on it
+// and for its percentage of the rest. Leagues are bucketed by a hash of the flag key and the
+// league ID, so raising the percentage only ever adds leagues. A flag that doesn't exist is
+// off, and callers that keep an existing behaviour on by default check EnabledOr.
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrFlagNotFound is returned when no flag has the requested key
+	ErrFlagNotFound = errors.New("flag not found")
+	// ErrInvalidFlag is returned when a flag can't be stored as given
+	ErrInvalidFlag = errors.New("invalid flag")
+)
+
+// keyPattern matches the keys the KV bucket accepts: dotted lower case names such as
+// orchestrator.autopick_strategy
+var keyPattern = regexp.MustCompile(`^[a-z0-9_-]+(\.[a-z0-9_-]+)*$`)
+
+// Flag is one feature flag
+type Flag struct {
+	Key string `json:"key"`
+	// Enabled is the kill switch: a disabled flag is off everywhere, whatever else it says
+	Enabled bool `json:"enabled"`
+	// Percentage of leagues the flag is on for, 0 to 100
+	Percentage int `json:"percentage"`
+	// LeagueIDs the flag is on for whatever the percentage
+	LeagueIDs []uuid.UUID `json:"league_ids,omitempty"`
+	// Value is handed to the leagues the flag is on for, for flags choosing between more than
+	// two behaviours, such as the name of an auto-pick strategy
+	Value       string    `json:"value,omitempty"`
+	Description string    `json:"description,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Validate checks the flag can be stored
+func (f Flag) Validate() error {
+	if !keyPattern.MatchString(f.Key) {
+		return fmt.Errorf("%w: key %q must be dotted lower case words", ErrInvalidFlag, f.Key)
+	}
+	if f.Percentage < 0 || f.Percentage > 100 {
+		return fmt.Errorf("%w: percentage must be between 0 and 100", ErrInvalidFlag)
+	}
+	return nil
+}
+
+// EnabledFor reports whether the flag is on for a league. uuid.Nil stands for callers with no
+// league, which only get the flag once it is rolled out to every league.
+func (f Flag) EnabledFor(leagueID uuid.UUID) bool {
+	if !f.Enabled {
+		return false
+	}
+	if f.Percentage >= 100 {
+		return true
+	}
+	if leagueID == uuid.Nil {
+		return false
+	}
+	for _, id := range f.LeagueIDs {
+		if id == leagueID {
+			return true
+		}
+	}
+	return bucket(f.Key, leagueID) < f.Percentage
+}
+
+// bucket places a league between 0 and 99 for a flag. Hashing the key in too keeps the same
+// leagues from being first in line for every rollout.
+func bucket(key string, leagueID uuid.UUID) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	h.Write([]byte{'/'})
+	h.Write(leagueID[:])
+	return int(h.Sum32() % 100)
+}
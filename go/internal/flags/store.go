@@ -0,0 +1,115 @@
+package flags
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// DefaultBucket is the KV bucket flags are kept in
+	DefaultBucket = "feature_flags"
+
+	// bucketHistory is how many past values of each flag the bucket keeps, for seeing what a
+	// flag was before a change with nats kv history
+	bucketHistory = 10
+)
+
+// Store reads and writes flags in the KV bucket
+type Store struct {
+	kv jetstream.KeyValue
+}
+
+// OpenStore opens the flag bucket, creating it when it doesn't exist yet
+func OpenStore(ctx context.Context, js jetstream.JetStream, bucket string) (*Store, error) {
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:      bucket,
+		Description: "Feature flags",
+		History:     bucketHistory,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open flag bucket %s: %w", bucket, err)
+	}
+	return &Store{kv: kv}, nil
+}
+
+// Get returns the flag with a key
+func (s *Store) Get(ctx context.Context, key string) (*Flag, error) {
+	entry, err := s.kv.Get(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil, ErrFlagNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flag %s: %w", key, err)
+	}
+	return decodeFlag(entry)
+}
+
+// Put stores a flag, replacing any flag with the same key
+func (s *Store) Put(ctx context.Context, flag Flag) (*Flag, error) {
+	if err := flag.Validate(); err != nil {
+		return nil, err
+	}
+	flag.UpdatedAt = time.Now().UTC()
+
+	raw, err := json.Marshal(flag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal flag: %w", err)
+	}
+	if _, err := s.kv.Put(ctx, flag.Key, raw); err != nil {
+		return nil, fmt.Errorf("failed to put flag %s: %w", flag.Key, err)
+	}
+	return &flag, nil
+}
+
+// Delete removes a flag, which turns it off everywhere
+func (s *Store) Delete(ctx context.Context, key string) error {
+	if _, err := s.Get(ctx, key); err != nil {
+		return err
+	}
+	if err := s.kv.Delete(ctx, key); err != nil {
+		return fmt.Errorf("failed to delete flag %s: %w", key, err)
+	}
+	return nil
+}
+
+// List returns every flag, ordered by key
+func (s *Store) List(ctx context.Context) ([]Flag, error) {
+	lister, err := s.kv.ListKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flags: %w", err)
+	}
+	defer lister.Stop()
+
+	var flags []Flag
+	for key := range lister.Keys() {
+		flag, err := s.Get(ctx, key)
+		if errors.Is(err, ErrFlagNotFound) {
+			// Deleted since the keys were listed
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		flags = append(flags, *flag)
+	}
+
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Key < flags[j].Key })
+	return flags, nil
+}
+
+// decodeFlag reads the flag held by a KV entry
+func decodeFlag(entry jetstream.KeyValueEntry) (*Flag, error) {
+	var flag Flag
+	if err := json.Unmarshal(entry.Value(), &flag); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal flag %s: %w", entry.Key(), err)
+	}
+	// The entry key is the one lookups go by
+	flag.Key = entry.Key()
+	return &flag, nil
+}
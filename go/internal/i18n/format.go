@@ -0,0 +1,89 @@
+package i18n
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// Formatter formats numbers, times and player names for display in a locale
+type Formatter struct {
+	tag     language.Tag
+	printer *message.Printer
+}
+
+// NewFormatter creates a formatter for a locale
+func NewFormatter(tag language.Tag) *Formatter {
+	return &Formatter{
+		tag:     tag,
+		printer: message.NewPrinter(tag),
+	}
+}
+
+// Locale returns the locale the formatter formats for
+func (f *Formatter) Locale() language.Tag {
+	return f.tag
+}
+
+// Number formats a number with the locale's digit grouping and decimal separator, rounded to at
+// most maxFractionDigits decimals, e.g. 1234.5 as "1,234.5" in en-US and "1.234,5" in de-DE
+func (f *Formatter) Number(v float64, maxFractionDigits int) string {
+	return f.printer.Sprint(number.Decimal(v, number.MaxFractionDigits(maxFractionDigits)))
+}
+
+// DateTime formats t for display in a time zone, given by its IANA name, and the locale. An empty
+// or unknown time zone is UTC.
+func (f *Formatter) DateTime(t time.Time, timezone string) string {
+	return models.NewLeagueClock(timezone, f.tag.String()).FormatDateTime(t)
+}
+
+// SortPlayerNames sorts full player names in place by family name, then given name, in the
+// locale's alphabetical order, so accented names sort where its readers expect them
+func (f *Formatter) SortPlayerNames(names []string) {
+	collator := collate.New(f.tag, collate.IgnoreCase)
+	sort.SliceStable(names, func(i, j int) bool {
+		iGiven, iFamily := SplitPlayerName(names[i])
+		jGiven, jFamily := SplitPlayerName(names[j])
+		if c := collator.CompareString(iFamily, jFamily); c != 0 {
+			return c < 0
+		}
+		return collator.CompareString(iGiven, jGiven) < 0
+	})
+}
+
+// nameSuffixes are generational suffixes, kept with the family name they follow
+var nameSuffixes = map[string]bool{
+	"jr": true, "jr.": true, "sr": true, "sr.": true,
+	"ii": true, "iii": true, "iv": true, "v": true,
+}
+
+// nameParticles are the words starting a family name of several, e.g. "St. Brown"
+var nameParticles = map[string]bool{
+	"st.": true, "st": true, "van": true, "von": true, "de": true, "del": true,
+	"della": true, "da": true, "di": true, "du": true, "la": true, "le": true,
+}
+
+// SplitPlayerName splits a full name as providers send it, given name first, into its given and
+// family names: the family name is the last word, along with a generational suffix after it and
+// particles before it, e.g. "Odell Beckham Jr." is "Odell" and "Beckham Jr.", and "Amon-Ra St.
+// Brown" is "Amon-Ra" and "St. Brown". A single word is all family name.
+func SplitPlayerName(fullName string) (given, family string) {
+	words := strings.Fields(fullName)
+	if len(words) == 0 {
+		return "", ""
+	}
+	last := len(words) - 1
+	if last > 1 && nameSuffixes[strings.ToLower(words[last])] {
+		last--
+	}
+	for last > 1 && nameParticles[strings.ToLower(words[last-1])] {
+		last--
+	}
+	return strings.Join(words[:last], " "), strings.Join(words[last:], " ")
+}
@@ -0,0 +1,103 @@
+// Package i18n negotiates the locale a request is answered in and formats display strings for it.
+package i18n
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Default is the locale of requests that don't ask for one, or only for locales we don't support
+var Default = language.AmericanEnglish
+
+// Supported are the locales responses can be localized for, the default first
+var Supported = []language.Tag{
+	language.AmericanEnglish,
+	language.BritishEnglish,
+	language.MustParse("en-CA"),
+	language.MustParse("en-AU"),
+	language.EuropeanSpanish,
+	language.LatinAmericanSpanish,
+	language.MustParse("es-MX"),
+	language.BrazilianPortuguese,
+	language.MustParse("fr-FR"),
+	language.MustParse("de-DE"),
+	language.MustParse("it-IT"),
+	language.MustParse("ja-JP"),
+	language.MustParse("ko-KR"),
+	language.MustParse("zh-CN"),
+}
+
+var matcher = language.NewMatcher(Supported)
+
+// Negotiate picks the supported locale best matching an Accept-Language value, or a single
+// BCP 47 tag, falling back to Default when it's empty, malformed or matches none of them
+func Negotiate(acceptLanguage string) language.Tag {
+	if strings.TrimSpace(acceptLanguage) == "" {
+		return Default
+	}
+	preferred, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(preferred) == 0 {
+		return Default
+	}
+	_, index, confidence := matcher.Match(preferred...)
+	if confidence == language.No {
+		return Default
+	}
+	return Supported[index]
+}
+
+// Best returns the index of the locale, given as BCP 47 tags, best matching tag, or -1 when none
+// is a match. Tags that don't parse are never matched.
+func Best(tag language.Tag, locales []string) int {
+	tags := make([]language.Tag, 0, len(locales))
+	indexes := make([]int, 0, len(locales))
+	for i, locale := range locales {
+		if parsed, err := language.Parse(locale); err == nil {
+			tags = append(tags, parsed)
+			indexes = append(indexes, i)
+		}
+	}
+	if len(tags) == 0 {
+		return -1
+	}
+	_, index, confidence := language.NewMatcher(tags).Match(tag)
+	if confidence == language.No {
+		return -1
+	}
+	return indexes[index]
+}
+
+type localeKey struct{}
+
+// WithLocale returns a context carrying the locale a request is answered in
+func WithLocale(ctx context.Context, tag language.Tag) context.Context {
+	return context.WithValue(ctx, localeKey{}, tag)
+}
+
+// FromContext returns the locale a request is answered in, or Default when it has none
+func FromContext(ctx context.Context) language.Tag {
+	if tag, ok := ctx.Value(localeKey{}).(language.Tag); ok {
+		return tag
+	}
+	return Default
+}
+
+// Middleware answers each request in the locale negotiated from its locale query parameter, if
+// set, or else its Accept-Language header. The locale is put on the request context and named
+// in Content-Language, and the response varies by Accept-Language so caches keep one per locale.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested := r.URL.Query().Get("locale")
+		if requested == "" {
+			requested = r.Header.Get("Accept-Language")
+		}
+		tag := Negotiate(requested)
+
+		w.Header().Set("Content-Language", tag.String())
+		w.Header().Add("Vary", "Accept-Language")
+		next.ServeHTTP(w, r.WithContext(WithLocale(r.Context(), tag)))
+	})
+}
@@ -0,0 +1,123 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// APIKeyHeader carries a league API key issued to an external tool.
+const APIKeyHeader = "X-API-Key"
+
+// ErrInvalidAPIKey is returned by an APIKeyAuthenticator for a key that is unknown or revoked.
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// APIKeyPrincipal is the league API key a request was authenticated with.
+type APIKeyPrincipal struct {
+	KeyID    uuid.UUID
+	LeagueID uuid.UUID
+	Scopes   []string
+}
+
+// HasScope reports whether the key was granted scope.
+func (p *APIKeyPrincipal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type apiKeyPrincipalKey struct{}
+
+// WithAPIKeyPrincipal returns a copy of ctx carrying the API key the request was made with.
+func WithAPIKeyPrincipal(ctx context.Context, principal *APIKeyPrincipal) context.Context {
+	return context.WithValue(ctx, apiKeyPrincipalKey{}, principal)
+}
+
+// APIKeyPrincipalFromContext returns the API key the request was made with, if any.
+func APIKeyPrincipalFromContext(ctx context.Context) (*APIKeyPrincipal, bool) {
+	principal, ok := ctx.Value(apiKeyPrincipalKey{}).(*APIKeyPrincipal)
+	return principal, ok
+}
+
+// APIKeyAuthenticator looks up the active API key matching a key sent by a client.
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*APIKeyPrincipal, error)
+}
+
+// APIKeyConfig configures NewAPIKeyInterceptor.
+type APIKeyConfig struct {
+	// Authenticator validates the key in APIKeyHeader.
+	Authenticator APIKeyAuthenticator
+	// Scopes maps a fully qualified procedure name to the scope a key needs to call it.
+	// API keys can't call procedures without a scope.
+	Scopes map[string]string
+	// Resolvers maps a procedure to the league owning the resource it acts on, as in
+	// TenancyConfig. Keys only reach resources of the league they were issued for.
+	Resolvers map[string]LeagueResolver
+}
+
+// NewAPIKeyInterceptor creates a Connect interceptor that authenticates league API keys
+// sent in APIKeyHeader. A key may only call procedures its scopes cover, on resources of
+// its own league. The key is made available to handlers and later interceptors through
+// APIKeyPrincipalFromContext, which lets the request through tenancy checks.
+func NewAPIKeyInterceptor(cfg APIKeyConfig) connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			key := req.Header().Get(APIKeyHeader)
+			if key == "" {
+				return next(ctx, req)
+			}
+
+			principal, err := cfg.Authenticator.AuthenticateAPIKey(ctx, key)
+			if err != nil {
+				if errors.Is(err, ErrInvalidAPIKey) {
+					return nil, connect.NewError(connect.CodeUnauthenticated, err)
+				}
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to authenticate api key: %w", err))
+			}
+
+			procedure := req.Spec().Procedure
+			scope, allowed := cfg.Scopes[procedure]
+			if !allowed {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api keys may not call %s", procedure))
+			}
+			if !principal.HasScope(scope) {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api key lacks the %s scope needed for %s", scope, procedure))
+			}
+
+			resolve, scoped := cfg.Resolvers[procedure]
+			if !scoped {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s is not league scoped", procedure))
+			}
+			msg, ok := req.Any().(proto.Message)
+			if !ok {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected request type %T", req.Any()))
+			}
+			leagueID, err := resolve(ctx, msg)
+			if err != nil {
+				if errors.Is(err, ErrResourceNotFound) {
+					return nil, connect.NewError(connect.CodeNotFound, err)
+				}
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve league: %w", err))
+			}
+			if leagueID != principal.LeagueID {
+				return nil, connect.NewError(connect.CodePermissionDenied, errors.New("api key was issued for a different league"))
+			}
+
+			return next(WithAPIKeyPrincipal(ctx, principal), req)
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
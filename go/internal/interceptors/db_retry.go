@@ -0,0 +1,50 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// NewDBRetryInterceptor creates a Connect interceptor that rides out database failovers.
+// RPCs declared NO_SIDE_EFFECTS are retried when they fail with a transient database error;
+// writes aren't, since their transactions are already retried in sqlutil. A transient error
+// that still reaches the client is reported as CodeUnavailable instead of CodeInternal so
+// clients know trying again later is safe.
+func NewDBRetryInterceptor(policy sqlutil.RetryPolicy) connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			var resp connect.AnyResponse
+			var err error
+			if req.Spec().IdempotencyLevel == connect.IdempotencyNoSideEffects {
+				err = sqlutil.Retry(ctx, policy, req.Spec().Procedure, func() error {
+					var callErr error
+					resp, callErr = next(ctx, req)
+					return callErr
+				})
+			} else {
+				resp, err = next(ctx, req)
+			}
+
+			if err != nil && sqlutil.IsTransient(err) {
+				var connectErr *connect.Error
+				if !errors.As(err, &connectErr) {
+					return nil, connect.NewError(connect.CodeUnavailable, err)
+				}
+				if connectErr.Code() == connect.CodeInternal {
+					return nil, connect.NewError(connect.CodeUnavailable, connectErr.Unwrap())
+				}
+			}
+			return resp, err
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
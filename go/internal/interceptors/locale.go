@@ -0,0 +1,29 @@
+package interceptors
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/mcdev12/dynasty/go/internal/i18n"
+)
+
+// NewLocaleInterceptor creates a Connect interceptor that answers each request in the supported
+// locale best matching its Accept-Language header, putting it on the context for handlers that
+// localize display strings. Requests without the header get i18n.Default.
+func NewLocaleInterceptor() connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			if acceptLanguage := req.Header().Get("Accept-Language"); acceptLanguage != "" {
+				ctx = i18n.WithLocale(ctx, i18n.Negotiate(acceptLanguage))
+			}
+			return next(ctx, req)
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
@@ -0,0 +1,57 @@
+package interceptors
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// NewQueryFieldsInterceptor creates a Connect interceptor that tags the queries a request
+// runs with the draft_id and league_id fields of its request message, so slow queries can
+// be traced back to the draft or league that ran them.
+func NewQueryFieldsInterceptor() connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			msg, ok := req.Any().(proto.Message)
+			if !ok {
+				return next(ctx, req)
+			}
+
+			fields := sqlutil.QueryFields{
+				DraftID:  UUIDField(msg, "draft_id"),
+				LeagueID: UUIDField(msg, "league_id"),
+			}
+			if fields != (sqlutil.QueryFields{}) {
+				ctx = sqlutil.WithQueryFields(ctx, fields)
+			}
+
+			return next(ctx, req)
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
+
+// UUIDField returns the UUID in a top-level string field of msg, or uuid.Nil when the
+// message has no such field or it doesn't hold a UUID
+func UUIDField(msg proto.Message, field protoreflect.Name) uuid.UUID {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(field)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return uuid.Nil
+	}
+	id, err := uuid.Parse(m.Get(fd).String())
+	if err != nil {
+		return uuid.Nil
+	}
+	return id
+}
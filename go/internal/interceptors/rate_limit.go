@@ -0,0 +1,56 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+
+	"connectrpc.com/connect"
+
+	"github.com/mcdev12/dynasty/go/internal/ratelimit"
+)
+
+// ErrRateLimited is returned for a request over its procedure's rate limit.
+var ErrRateLimited = errors.New("too many requests, try again later")
+
+// RateLimitConfig configures NewRateLimitInterceptor.
+type RateLimitConfig struct {
+	// Limiter counts requests. Replicas behind a load balancer need a shared limiter for
+	// the limits to hold across them.
+	Limiter ratelimit.Limiter
+	// Rules limits the requests each client IP makes to a procedure. Procedures without a
+	// rule aren't limited.
+	Rules map[string]ratelimit.Rule
+}
+
+// NewRateLimitInterceptor creates a Connect interceptor that rejects requests over their
+// procedure's rate limit with CodeResourceExhausted and a Retry-After header, guarding
+// procedures such as login against guessing. Should the limiter itself fail, requests are
+// let through rather than locking everyone out.
+func NewRateLimitInterceptor(cfg RateLimitConfig) connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			procedure := req.Spec().Procedure
+			rule, ok := cfg.Rules[procedure]
+			if !ok {
+				return next(ctx, req)
+			}
+
+			allowed, retryAfter, err := cfg.Limiter.Allow(ctx, procedure+"|"+ClientIP(req), rule)
+			if err == nil && !allowed {
+				connectErr := connect.NewError(connect.CodeResourceExhausted, ErrRateLimited)
+				seconds := int(math.Ceil(retryAfter.Seconds()))
+				connectErr.Meta().Set("Retry-After", strconv.Itoa(max(seconds, 1)))
+				return nil, connectErr
+			}
+			return next(ctx, req)
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
@@ -0,0 +1,37 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// NewReadReplicaInterceptor creates a Connect interceptor that lets the reads of the procedures
+// in staleness run on the read replica while it is no further behind the primary than the
+// procedure allows. Requests from internal services stay on the primary, since the
+// orchestrator and gateway act on what they read in the middle of a draft; so does every
+// procedure not listed.
+func NewReadReplicaInterceptor(staleness map[string]time.Duration) connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			maxStaleness, ok := staleness[req.Spec().Procedure]
+			if !ok {
+				return next(ctx, req)
+			}
+			if _, isService := ServicePrincipalFromContext(ctx); isService {
+				return next(ctx, req)
+			}
+
+			return next(sqlutil.WithReadStaleness(ctx, maxStaleness), req)
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
@@ -0,0 +1,134 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// ServiceTokenHeader carries a signed token identifying the internal service making a request.
+const ServiceTokenHeader = "X-Service-Token"
+
+var (
+	// ErrInvalidServiceToken is returned for a service token that is malformed or has a bad signature.
+	ErrInvalidServiceToken = errors.New("invalid service token")
+	// ErrServiceTokenExpired is returned for a correctly signed service token past its expiry.
+	ErrServiceTokenExpired = errors.New("service token expired")
+)
+
+type servicePrincipalKey struct{}
+
+// WithServicePrincipal returns a copy of ctx carrying the name of the calling service.
+func WithServicePrincipal(ctx context.Context, service string) context.Context {
+	return context.WithValue(ctx, servicePrincipalKey{}, service)
+}
+
+// ServicePrincipalFromContext returns the name of the calling service, if the request
+// carried a valid service token.
+func ServicePrincipalFromContext(ctx context.Context) (string, bool) {
+	service, ok := ctx.Value(servicePrincipalKey{}).(string)
+	return service, ok
+}
+
+// SignServiceToken issues a token for service that is valid until expiresAt. The token is
+// "<service>.<expiry unix seconds>.<signature>" with an HMAC-SHA256 signature over the first two parts.
+func SignServiceToken(secret []byte, service string, expiresAt time.Time) string {
+	payload := service + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+	return payload + "." + serviceTokenSignature(secret, payload)
+}
+
+// VerifyServiceToken checks a token's signature and expiry and returns the service it was issued to.
+func VerifyServiceToken(secret []byte, token string, now time.Time) (string, error) {
+	payload, signature, ok := cutLast(token, ".")
+	if !ok {
+		return "", ErrInvalidServiceToken
+	}
+	service, expiry, ok := cutLast(payload, ".")
+	if !ok || service == "" {
+		return "", ErrInvalidServiceToken
+	}
+	if !hmac.Equal([]byte(signature), []byte(serviceTokenSignature(secret, payload))) {
+		return "", ErrInvalidServiceToken
+	}
+
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return "", ErrInvalidServiceToken
+	}
+	if now.Unix() > expiresAt {
+		return "", ErrServiceTokenExpired
+	}
+	return service, nil
+}
+
+func serviceTokenSignature(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func cutLast(s, sep string) (before, after string, ok bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
+
+// ServiceAuthConfig configures NewServiceAuthInterceptor.
+type ServiceAuthConfig struct {
+	// Secret verifies service tokens. When empty no request is treated as coming from a
+	// service and ServiceOnly isn't enforced, which keeps local setups without a secret working.
+	Secret []byte
+	// ServiceOnly maps a fully qualified procedure name to the services allowed to call it.
+	// End users can't call these procedures.
+	ServiceOnly map[string][]string
+}
+
+// NewServiceAuthInterceptor creates a Connect interceptor that authenticates internal
+// services by the token in ServiceTokenHeader and restricts service-only procedures to
+// the services allowed to call them. The calling service is made available to handlers
+// and later interceptors through ServicePrincipalFromContext.
+func NewServiceAuthInterceptor(cfg ServiceAuthConfig) connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient || len(cfg.Secret) == 0 {
+				return next(ctx, req)
+			}
+
+			service, hasService := "", false
+			if token := req.Header().Get(ServiceTokenHeader); token != "" {
+				verified, err := VerifyServiceToken(cfg.Secret, token, time.Now())
+				if err != nil {
+					return nil, connect.NewError(connect.CodeUnauthenticated, err)
+				}
+				service, hasService = verified, true
+				ctx = WithServicePrincipal(ctx, service)
+			}
+
+			allowed, serviceOnly := cfg.ServiceOnly[req.Spec().Procedure]
+			if !serviceOnly {
+				return next(ctx, req)
+			}
+			if !hasService {
+				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("%s can only be called by an internal service", req.Spec().Procedure))
+			}
+			for _, name := range allowed {
+				if name == service {
+					return next(ctx, req)
+				}
+			}
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("service %q may not call %s", service, req.Spec().Procedure))
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
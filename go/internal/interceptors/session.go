@@ -0,0 +1,101 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+)
+
+// AuthorizationHeader carries a session access token as "Bearer <token>".
+const AuthorizationHeader = "Authorization"
+
+// ErrInvalidSession is returned by a SessionAuthenticator for an access token that is
+// unknown, expired or revoked.
+var ErrInvalidSession = errors.New("invalid or expired session")
+
+// SessionPrincipal is the user session a request was authenticated with.
+type SessionPrincipal struct {
+	SessionID uuid.UUID
+	UserID    uuid.UUID
+	// ImpersonatedBy is the operator acting as the user through a support session, if any.
+	ImpersonatedBy string
+}
+
+type sessionPrincipalKey struct{}
+
+// WithSessionPrincipal returns a copy of ctx carrying the session the request was made with.
+func WithSessionPrincipal(ctx context.Context, principal *SessionPrincipal) context.Context {
+	return context.WithValue(ctx, sessionPrincipalKey{}, principal)
+}
+
+// SessionPrincipalFromContext returns the session the request was made with, if any.
+func SessionPrincipalFromContext(ctx context.Context) (*SessionPrincipal, bool) {
+	principal, ok := ctx.Value(sessionPrincipalKey{}).(*SessionPrincipal)
+	return principal, ok
+}
+
+// SessionAuthenticator looks up the active session an access token belongs to.
+type SessionAuthenticator interface {
+	AuthenticateSession(ctx context.Context, accessToken, ipAddress string) (*SessionPrincipal, error)
+}
+
+// SessionConfig configures NewSessionInterceptor.
+type SessionConfig struct {
+	// Authenticator validates the access token in AuthorizationHeader.
+	Authenticator SessionAuthenticator
+}
+
+// NewSessionInterceptor creates a Connect interceptor that authenticates the user session
+// access token sent in AuthorizationHeader. The session's user becomes the acting user
+// (see ActingUserFromContext), and the session itself is available to handlers through
+// SessionPrincipalFromContext. Requests without a token pass through.
+func NewSessionInterceptor(cfg SessionConfig) connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			header := req.Header().Get(AuthorizationHeader)
+			if header == "" {
+				return next(ctx, req)
+			}
+			token, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok || token == "" {
+				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid %s header", AuthorizationHeader))
+			}
+
+			principal, err := cfg.Authenticator.AuthenticateSession(ctx, token, ClientIP(req))
+			if err != nil {
+				if errors.Is(err, ErrInvalidSession) {
+					return nil, connect.NewError(connect.CodeUnauthenticated, err)
+				}
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to authenticate session: %w", err))
+			}
+
+			ctx = WithSessionPrincipal(ctx, principal)
+			return next(WithActingUser(ctx, principal.UserID), req)
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
+
+// ClientIP returns the address a request came from: the first X-Forwarded-For entry when
+// behind a proxy, else the peer address.
+func ClientIP(req connect.AnyRequest) string {
+	if forwarded := req.Header().Get("X-Forwarded-For"); forwarded != "" {
+		first, _, _ := strings.Cut(forwarded, ",")
+		return strings.TrimSpace(first)
+	}
+	addr := req.Peer().Addr
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
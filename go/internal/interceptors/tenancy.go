@@ -0,0 +1,213 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UserIDHeader carries the ID of the user acting on a request.
+const UserIDHeader = "X-User-ID"
+
+// ErrResourceNotFound is returned by a LeagueResolver when the resource named
+// in the request does not exist.
+var ErrResourceNotFound = errors.New("resource not found")
+
+type actingUserKey struct{}
+
+// WithActingUser returns a copy of ctx carrying the acting user's ID.
+func WithActingUser(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, actingUserKey{}, userID)
+}
+
+// ActingUserFromContext returns the acting user's ID, if the request carried one.
+func ActingUserFromContext(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(actingUserKey{}).(uuid.UUID)
+	return userID, ok
+}
+
+// ActingUserPtr returns the acting user, or nil for trusted callers
+func ActingUserPtr(ctx context.Context) *uuid.UUID {
+	if userID, ok := ActingUserFromContext(ctx); ok {
+		return &userID
+	}
+	return nil
+}
+
+// ActingUserOrService returns the acting user, or nil for a request from an internal service
+// authenticated by its service token, which acts on no user's behalf. Requests with neither
+// are rejected as unauthenticated.
+func ActingUserOrService(ctx context.Context) (*uuid.UUID, error) {
+	if userID, ok := ActingUserFromContext(ctx); ok {
+		return &userID, nil
+	}
+	if _, ok := ServicePrincipalFromContext(ctx); ok {
+		return nil, nil
+	}
+	return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("request carries no user session or service token"))
+}
+
+type resolvedLeagueKey struct{}
+
+// WithResolvedLeague returns a copy of ctx carrying the league that owns the resource a
+// request addresses.
+func WithResolvedLeague(ctx context.Context, leagueID uuid.UUID) context.Context {
+	return context.WithValue(ctx, resolvedLeagueKey{}, leagueID)
+}
+
+// ResolvedLeagueFromContext returns the league NewTenancyInterceptor resolved for a request,
+// if it checked the request's league.
+func ResolvedLeagueFromContext(ctx context.Context) (uuid.UUID, bool) {
+	leagueID, ok := ctx.Value(resolvedLeagueKey{}).(uuid.UUID)
+	return leagueID, ok
+}
+
+// LeagueResolver returns the league that owns the resource addressed by a request.
+type LeagueResolver func(ctx context.Context, msg proto.Message) (uuid.UUID, error)
+
+// ResolveByField builds a LeagueResolver that reads a UUID string field from
+// the request message and maps it to its owning league with lookup.
+func ResolveByField(field protoreflect.Name, lookup func(ctx context.Context, id uuid.UUID) (uuid.UUID, error)) LeagueResolver {
+	return func(ctx context.Context, msg proto.Message) (uuid.UUID, error) {
+		m := msg.ProtoReflect()
+		fd := m.Descriptor().Fields().ByName(field)
+		if fd == nil || fd.Kind() != protoreflect.StringKind {
+			return uuid.Nil, fmt.Errorf("request %s has no string field %q", m.Descriptor().FullName(), field)
+		}
+
+		id, err := uuid.Parse(m.Get(fd).String())
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("invalid %s: %w", field, err)
+		}
+
+		return lookup(ctx, id)
+	}
+}
+
+// MembershipChecker reports whether a user belongs to a league.
+type MembershipChecker interface {
+	IsLeagueMember(ctx context.Context, leagueID, userID uuid.UUID) (bool, error)
+}
+
+// ErrLeagueArchived is returned for requests that would change an archived league.
+var ErrLeagueArchived = errors.New("league is archived and read only")
+
+// ArchiveChecker reports whether a league has been archived.
+type ArchiveChecker interface {
+	IsLeagueArchived(ctx context.Context, leagueID uuid.UUID) (bool, error)
+}
+
+// TenancyConfig configures NewTenancyInterceptor.
+type TenancyConfig struct {
+	// Checker decides whether the acting user belongs to the resolved league.
+	Checker MembershipChecker
+	// Resolvers maps a fully qualified procedure name to the resolver for the
+	// league owning the resource it acts on. Procedures without a resolver are
+	// not league scoped.
+	Resolvers map[string]LeagueResolver
+	// AllowAnonymous lets league scoped requests without any identity through
+	// unchecked, for local setups without sessions. Otherwise they are rejected
+	// unless they come from an authenticated internal service (see
+	// NewServiceAuthInterceptor) or are made with a league API key, which
+	// NewAPIKeyInterceptor has already confined to its league.
+	AllowAnonymous bool
+	// Archives, when set, makes archived leagues read only for every caller:
+	// league scoped requests are rejected unless their procedure has no side
+	// effects or is listed in ReadOnly.
+	Archives ArchiveChecker
+	// ReadOnly lists the league scoped procedures that only read, for those
+	// not declared free of side effects in their proto.
+	ReadOnly map[string]bool
+}
+
+// NewTenancyInterceptor creates a Connect interceptor that verifies the acting
+// user is a member of the league owning the resource a request addresses.
+// The acting user is the user of the session authenticated by
+// NewSessionInterceptor, or else is read from UserIDHeader on requests from an
+// internal service authenticated by NewServiceAuthInterceptor, and is made
+// available to handlers through ActingUserFromContext, and the league it
+// checked through ResolvedLeagueFromContext.
+func NewTenancyInterceptor(cfg TenancyConfig) connect.Interceptor {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			userID, hasUser := ActingUserFromContext(ctx)
+			if header := req.Header().Get(UserIDHeader); header != "" {
+				parsed, err := uuid.Parse(header)
+				if err != nil {
+					return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid %s header", UserIDHeader))
+				}
+				if hasUser && parsed != userID {
+					return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("%s header does not match the session's user", UserIDHeader))
+				}
+				// Only an internal service may name the user it acts for; anyone else signs in
+				// with a session
+				if _, isService := ServicePrincipalFromContext(ctx); !hasUser && !isService {
+					return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("%s header is only accepted from internal services", UserIDHeader))
+				}
+				userID, hasUser = parsed, true
+				ctx = WithActingUser(ctx, userID)
+			}
+
+			resolve, scoped := cfg.Resolvers[req.Spec().Procedure]
+			if !scoped {
+				return next(ctx, req)
+			}
+
+			if !hasUser {
+				_, isService := ServicePrincipalFromContext(ctx)
+				_, isAPIKey := APIKeyPrincipalFromContext(ctx)
+				if !cfg.AllowAnonymous && !isService && !isAPIKey {
+					return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("sign in to use league resources"))
+				}
+			}
+
+			msg, ok := req.Any().(proto.Message)
+			if !ok {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected request type %T", req.Any()))
+			}
+
+			leagueID, err := resolve(ctx, msg)
+			if err != nil {
+				if errors.Is(err, ErrResourceNotFound) {
+					return nil, connect.NewError(connect.CodeNotFound, err)
+				}
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve league: %w", err))
+			}
+
+			// Requests without a user are confined to their league elsewhere, but an archived
+			// league is read only for them too
+			if hasUser {
+				isMember, err := cfg.Checker.IsLeagueMember(ctx, leagueID, userID)
+				if err != nil {
+					return nil, connect.NewError(connect.CodeInternal, err)
+				}
+				if !isMember {
+					return nil, connect.NewError(connect.CodePermissionDenied, errors.New("user is not a member of the league that owns this resource"))
+				}
+			}
+
+			if cfg.Archives != nil && req.Spec().IdempotencyLevel != connect.IdempotencyNoSideEffects && !cfg.ReadOnly[req.Spec().Procedure] {
+				archived, err := cfg.Archives.IsLeagueArchived(ctx, leagueID)
+				if err != nil {
+					return nil, connect.NewError(connect.CodeInternal, err)
+				}
+				if archived {
+					return nil, connect.NewError(connect.CodeFailedPrecondition, ErrLeagueArchived)
+				}
+			}
+
+			return next(WithResolvedLeague(ctx, leagueID), req)
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
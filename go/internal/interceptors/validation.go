@@ -0,0 +1,43 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewValidationInterceptor creates a Connect interceptor that enforces the
+// buf.validate annotations declared on every request message. Invalid requests
+// are rejected with CodeInvalidArgument before they reach a handler, so
+// handlers can rely on fields such as UUIDs already being well formed.
+func NewValidationInterceptor() (connect.Interceptor, error) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create validator: %w", err)
+	}
+
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			// Only validate inbound requests; outgoing client calls are validated by the server
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			msg, ok := req.Any().(proto.Message)
+			if !ok {
+				return next(ctx, req)
+			}
+
+			if err := validator.Validate(msg); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+
+			return next(ctx, req)
+		}
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor), nil
+}
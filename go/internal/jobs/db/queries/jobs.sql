@@ -0,0 +1,57 @@
+-- name: EnqueueJob :execrows
+-- Queue a job. Nothing is written if an unfinished job already holds its unique key.
+INSERT INTO jobs (kind, payload, run_at, max_attempts, unique_key)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (unique_key) WHERE status IN ('PENDING', 'RUNNING') DO NOTHING;
+
+-- name: ClaimJobs :many
+-- Lease due jobs of the given kinds, oldest first, and running jobs whose lease ran out.
+UPDATE jobs
+SET status       = 'RUNNING',
+    attempts     = attempts + 1,
+    locked_until = NOW() + sqlc.arg('lease_seconds')::int * INTERVAL '1 second'
+WHERE id IN (SELECT id
+             FROM jobs
+             WHERE kind = ANY (sqlc.arg('kinds')::text[])
+               AND ((status = 'PENDING' AND run_at <= NOW())
+                 OR (status = 'RUNNING' AND locked_until < NOW()))
+             ORDER BY run_at
+             LIMIT sqlc.arg('batch_size') FOR UPDATE SKIP LOCKED)
+RETURNING *;
+
+-- name: CompleteJob :execrows
+-- Record that a job succeeded. attempts fences off a worker whose lease ran out and whose job
+-- was claimed again.
+UPDATE jobs
+SET status       = 'SUCCEEDED',
+    locked_until = NULL,
+    last_error   = NULL,
+    finished_at  = NOW()
+WHERE id = $1
+  AND attempts = $2;
+
+-- name: RetryJob :execrows
+-- Record a failed attempt and run the job again at run_at.
+UPDATE jobs
+SET status       = 'PENDING',
+    run_at       = sqlc.arg('run_at'),
+    locked_until = NULL,
+    last_error   = sqlc.arg('last_error')::text
+WHERE id = sqlc.arg('id')
+  AND attempts = sqlc.arg('attempts');
+
+-- name: FailJob :execrows
+-- Record a failed attempt and give up on the job.
+UPDATE jobs
+SET status       = 'FAILED',
+    locked_until = NULL,
+    last_error   = sqlc.arg('last_error')::text,
+    finished_at  = NOW()
+WHERE id = sqlc.arg('id')
+  AND attempts = sqlc.arg('attempts');
+
+-- name: DeleteFinishedJobs :execrows
+-- Delete jobs that finished before the cutoff.
+DELETE
+FROM jobs
+WHERE finished_at < $1;
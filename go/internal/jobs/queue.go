@@ -0,0 +1,109 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/jobs/db"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// DefaultMaxAttempts is how many times a job is tried when its request doesn't say
+const DefaultMaxAttempts = 10
+
+// Job is a claimed job handed to its kind's handler
+type Job struct {
+	ID      uuid.UUID
+	Kind    string
+	Payload json.RawMessage
+	RunAt   time.Time // when the job was due
+	Attempt int       // 1 on the first try
+}
+
+// Handler runs a job. A returned error retries the job with backoff, unless it is wrapped
+// with Permanent or the job is out of attempts. Handlers may run a job more than once, so
+// they must be idempotent.
+type Handler func(ctx context.Context, job Job) error
+
+// permanentError marks a job failure that retrying won't fix
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so the job that failed with it is given up on rather than retried
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// isPermanent reports whether err was wrapped with Permanent
+func isPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// EnqueueRequest describes a job to queue
+type EnqueueRequest struct {
+	Kind        string
+	Payload     interface{} // marshalled to JSON; nil for none
+	RunAt       time.Time   // zero to run as soon as possible
+	MaxAttempts int         // 0 for DefaultMaxAttempts
+	// UniqueKey, when set, keeps the job from being queued while an unfinished job has the same key
+	UniqueKey string
+}
+
+// Queue queues jobs for the workers
+type Queue struct {
+	queries *db.Queries
+}
+
+// NewQueue creates a job queue
+func NewQueue(queries *db.Queries) *Queue {
+	return &Queue{
+		queries: queries,
+	}
+}
+
+// Enqueue queues a job, reporting false when an unfinished job already holds its unique key.
+// Called in a sqlutil.TxManager transaction, the job is only queued if the transaction commits.
+func (q *Queue) Enqueue(ctx context.Context, req EnqueueRequest) (bool, error) {
+	if req.Kind == "" {
+		return false, fmt.Errorf("job kind is required")
+	}
+
+	payload := json.RawMessage("{}")
+	if req.Payload != nil {
+		var err error
+		payload, err = json.Marshal(req.Payload)
+		if err != nil {
+			return false, fmt.Errorf("failed to marshal %s job payload: %w", req.Kind, err)
+		}
+	}
+	runAt := req.RunAt
+	if runAt.IsZero() {
+		runAt = time.Now()
+	}
+	maxAttempts := req.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	queued, err := sqlutil.Bind(ctx, q.queries, q.queries.WithTx).EnqueueJob(ctx, db.EnqueueJobParams{
+		Kind:        req.Kind,
+		Payload:     payload,
+		RunAt:       runAt,
+		MaxAttempts: int32(maxAttempts),
+		UniqueKey:   sql.NullString{String: req.UniqueKey, Valid: req.UniqueKey != ""},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to enqueue %s job: %w", req.Kind, err)
+	}
+	return queued > 0, nil
+}
@@ -0,0 +1,39 @@
+package jobs
+
+import "time"
+
+// Schedule decides when a recurring job runs next
+type Schedule interface {
+	// Next returns the first run after after
+	Next(after time.Time) time.Time
+}
+
+// Every runs a job at a fixed interval
+func Every(interval time.Duration) Schedule {
+	return everySchedule(interval)
+}
+
+type everySchedule time.Duration
+
+func (s everySchedule) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(s))
+}
+
+// DailyAt runs a job every day at hour:minute in loc
+func DailyAt(hour, minute int, loc *time.Location) Schedule {
+	return dailySchedule{hour: hour, minute: minute, loc: loc}
+}
+
+type dailySchedule struct {
+	hour, minute int
+	loc          *time.Location
+}
+
+func (s dailySchedule) Next(after time.Time) time.Time {
+	local := after.In(s.loc)
+	next := time.Date(local.Year(), local.Month(), local.Day(), s.hour, s.minute, 0, 0, s.loc)
+	if !next.After(after) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+1, s.hour, s.minute, 0, 0, s.loc)
+	}
+	return next
+}
@@ -0,0 +1,305 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/jobs/db"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// WorkerConfig configures a job worker
+type WorkerConfig struct {
+	PollInterval   time.Duration
+	BatchSize      int32         // jobs claimed, and run concurrently, at a time
+	Lease          time.Duration // how long a job may run before another worker may claim it again
+	InitialBackoff time.Duration // wait before the first retry, doubled for each one after
+	MaxBackoff     time.Duration
+	Retention      time.Duration // how long finished jobs are kept
+}
+
+// DefaultWorkerConfig returns the default worker configuration
+func DefaultWorkerConfig() WorkerConfig {
+	return WorkerConfig{
+		PollInterval:   time.Second,
+		BatchSize:      10,
+		Lease:          5 * time.Minute,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     time.Hour,
+		Retention:      7 * 24 * time.Hour,
+	}
+}
+
+// cleanupInterval is how often a worker deletes jobs past their retention
+const cleanupInterval = time.Hour
+
+type recurring struct {
+	kind     string
+	schedule Schedule
+}
+
+// Worker runs queued jobs with the handlers registered for their kinds. Jobs are leased with
+// FOR UPDATE SKIP LOCKED, so any number of workers can share the queue, and run outside the
+// claiming transaction, so a long job holds no locks. A job that outlives its lease, because
+// its worker died, is run again by whichever worker claims it next.
+type Worker struct {
+	db        *sql.DB
+	queue     *Queue
+	config    WorkerConfig
+	handlers  map[string]Handler
+	recurring map[string]recurring
+}
+
+// NewWorker creates a job worker
+func NewWorker(database *sql.DB, queue *Queue, config WorkerConfig) *Worker {
+	return &Worker{
+		db:        database,
+		queue:     queue,
+		config:    config,
+		handlers:  make(map[string]Handler),
+		recurring: make(map[string]recurring),
+	}
+}
+
+// Register sets the handler for jobs of kind. Handlers must be registered before Start.
+func (w *Worker) Register(kind string, handler Handler) {
+	w.handlers[kind] = handler
+}
+
+// Schedule registers handler for a recurring job of kind run on schedule. Every worker
+// scheduling a kind shares one queued run, so each run happens once however many workers
+// there are. The next run is queued when a run finishes, whether or not it succeeded.
+func (w *Worker) Schedule(kind string, schedule Schedule, handler Handler) {
+	w.Register(kind, handler)
+	w.recurring[kind] = recurring{kind: kind, schedule: schedule}
+}
+
+// recurringKey is the unique key of a recurring job's queued run
+func recurringKey(kind string) string {
+	return "recurring:" + kind
+}
+
+// Start runs jobs until ctx is cancelled
+func (w *Worker) Start(ctx context.Context) error {
+	kinds := make([]string, 0, len(w.handlers))
+	for kind := range w.handlers {
+		kinds = append(kinds, kind)
+	}
+	log.Info().
+		Strs("kinds", kinds).
+		Msg("starting job worker")
+
+	for _, r := range w.recurring {
+		if err := w.scheduleNext(ctx, w.queue, r, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(w.config.PollInterval)
+	defer ticker.Stop()
+	var lastCleanup time.Time
+
+	for {
+		claimed, err := w.processBatch(ctx, kinds)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			log.Error().Err(err).Msg("process job batch")
+		}
+
+		if time.Since(lastCleanup) >= cleanupInterval {
+			w.cleanup(ctx)
+			lastCleanup = time.Now()
+		}
+
+		// A full batch suggests more jobs are due, so claim again right away
+		if claimed == int(w.config.BatchSize) && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("job worker shutting down")
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// processBatch claims a batch of due jobs and runs them concurrently, returning how many
+// were claimed
+func (w *Worker) processBatch(ctx context.Context, kinds []string) (int, error) {
+	var claimed []db.Job
+	err := sqlutil.Retry(ctx, sqlutil.DefaultRetryPolicy, "claim_jobs", func() error {
+		var err error
+		claimed, err = w.queue.queries.ClaimJobs(ctx, db.ClaimJobsParams{
+			LeaseSeconds: int32(w.config.Lease / time.Second),
+			Kinds:        kinds,
+			BatchSize:    w.config.BatchSize,
+		})
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim jobs: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, row := range claimed {
+		wg.Add(1)
+		go func(row db.Job) {
+			defer wg.Done()
+			w.run(ctx, row)
+		}(row)
+	}
+	wg.Wait()
+	return len(claimed), nil
+}
+
+// run runs a claimed job and records how it went
+func (w *Worker) run(ctx context.Context, row db.Job) {
+	logger := log.With().
+		Str("job_id", row.ID.String()).
+		Str("kind", row.Kind).
+		Int32("attempt", row.Attempts).
+		Logger()
+
+	job := Job{
+		ID:      row.ID,
+		Kind:    row.Kind,
+		Payload: row.Payload,
+		RunAt:   row.RunAt,
+		Attempt: int(row.Attempts),
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, w.config.Lease)
+	runErr := w.handle(runCtx, job)
+	cancel()
+
+	if ctx.Err() != nil {
+		// Shutting down; the job is run again once its lease runs out
+		logger.Info().Msg("job interrupted by shutdown")
+		return
+	}
+
+	// Recorded even if the worker starts shutting down meanwhile
+	recordCtx := context.WithoutCancel(ctx)
+	if err := w.record(recordCtx, row, runErr, logger); err != nil {
+		logger.Error().Err(err).Msg("failed to record job outcome")
+	}
+}
+
+// handle runs a job with its kind's handler, turning a panic into a permanent failure
+func (w *Worker) handle(ctx context.Context, job Job) (err error) {
+	handler, ok := w.handlers[job.Kind]
+	if !ok {
+		return Permanent(fmt.Errorf("no handler for job kind %q", job.Kind))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = Permanent(fmt.Errorf("job panicked: %v", r))
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// record marks a job succeeded, retried or failed, queueing the next run of a recurring job
+// that finished
+func (w *Worker) record(ctx context.Context, row db.Job, runErr error, logger zerolog.Logger) error {
+	newQueries := func(tx *sql.Tx) *db.Queries { return db.New(tx) }
+	return sqlutil.Run(ctx, w.db, newQueries, func(q *db.Queries) error {
+		var updated int64
+		var err error
+		finished := true
+
+		switch {
+		case runErr == nil:
+			updated, err = q.CompleteJob(ctx, db.CompleteJobParams{
+				ID:       row.ID,
+				Attempts: row.Attempts,
+			})
+			if err == nil && updated > 0 {
+				logger.Debug().Msg("job succeeded")
+			}
+
+		case isPermanent(runErr) || row.Attempts >= row.MaxAttempts:
+			updated, err = q.FailJob(ctx, db.FailJobParams{
+				LastError: runErr.Error(),
+				ID:        row.ID,
+				Attempts:  row.Attempts,
+			})
+			if err == nil && updated > 0 {
+				logger.Warn().Err(runErr).Msg("giving up on job")
+			}
+
+		default:
+			finished = false
+			retryAt := time.Now().Add(w.backoff(row.Attempts))
+			updated, err = q.RetryJob(ctx, db.RetryJobParams{
+				RunAt:     retryAt,
+				LastError: runErr.Error(),
+				ID:        row.ID,
+				Attempts:  row.Attempts,
+			})
+			if err == nil && updated > 0 {
+				logger.Info().Err(runErr).Time("retry_at", retryAt).Msg("job failed")
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			logger.Warn().Msg("job lease ran out before it finished; its outcome is left to the worker that claimed it again")
+			return nil
+		}
+
+		if r, ok := w.recurring[row.Kind]; ok && finished {
+			return w.scheduleNext(ctx, &Queue{queries: q}, r, row.RunAt)
+		}
+		return nil
+	})
+}
+
+// scheduleNext queues the run of a recurring job following after, unless one is queued
+func (w *Worker) scheduleNext(ctx context.Context, queue *Queue, r recurring, after time.Time) error {
+	// A run missed while no worker was up is made once rather than once per missed slot
+	next := r.schedule.Next(after)
+	if now := time.Now(); next.Before(now) {
+		next = r.schedule.Next(now)
+	}
+
+	_, err := queue.Enqueue(ctx, EnqueueRequest{
+		Kind:      r.kind,
+		RunAt:     next,
+		UniqueKey: recurringKey(r.kind),
+	})
+	return err
+}
+
+// cleanup deletes jobs that finished longer ago than the retention
+func (w *Worker) cleanup(ctx context.Context) {
+	deleted, err := w.queue.queries.DeleteFinishedJobs(ctx, sql.NullTime{Time: time.Now().Add(-w.config.Retention), Valid: true})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to delete finished jobs")
+		return
+	}
+	if deleted > 0 {
+		log.Info().Int64("deleted", deleted).Msg("deleted finished jobs")
+	}
+}
+
+// backoff is how long to wait before retrying a job that has failed attempts times
+func (w *Worker) backoff(attempts int32) time.Duration {
+	wait := w.config.InitialBackoff
+	for i := int32(1); i < attempts; i++ {
+		wait *= 2
+		if wait >= w.config.MaxBackoff {
+			return w.config.MaxBackoff
+		}
+	}
+	return wait
+}
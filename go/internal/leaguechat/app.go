@@ -0,0 +1,322 @@
+package leaguechat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// ChatRepository defines what the league chat app layer needs from the repository
+type ChatRepository interface {
+	GetLeagueCommissionerID(ctx context.Context, leagueID uuid.UUID) (uuid.UUID, error)
+	CreateThread(ctx context.Context, req CreateThreadRequest, message NewMessage) (*models.ChatThread, *models.ChatMessage, error)
+	PostMessage(ctx context.Context, threadID uuid.UUID, message NewMessage) (*models.ChatMessage, error)
+	GetThread(ctx context.Context, threadID uuid.UUID) (*models.ChatThread, error)
+	ListThreads(ctx context.Context, query ListThreadsQuery) ([]models.ChatThread, error)
+	ListMessages(ctx context.Context, query ListMessagesQuery) ([]models.ChatMessage, error)
+	GetMessage(ctx context.Context, messageID uuid.UUID) (*models.ChatMessage, uuid.UUID, error)
+	DeleteMessage(ctx context.Context, messageID uuid.UUID, deletedBy *uuid.UUID) (*models.ChatMessage, error)
+	SetThreadPinned(ctx context.Context, threadID uuid.UUID, pinned bool) (*models.ChatThread, error)
+	SetThreadLocked(ctx context.Context, threadID uuid.UUID, locked bool) (*models.ChatThread, error)
+	DeleteThread(ctx context.Context, threadID uuid.UUID) error
+	SetMemberMuted(ctx context.Context, req SetMemberMutedRequest) ([]uuid.UUID, error)
+	ListMutedMembers(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// App handles league chat business logic
+type App struct {
+	repo ChatRepository
+}
+
+// NewApp creates a new league chat App
+func NewApp(repo ChatRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// CreateThread starts a thread on one of a league's boards with its first message
+func (a *App) CreateThread(ctx context.Context, req CreateThreadRequest) (*models.ChatThread, *models.ChatMessage, error) {
+	req.Title = strings.TrimSpace(req.Title)
+	req.Body = strings.TrimSpace(req.Body)
+	if err := a.validateCreateThreadRequest(req); err != nil {
+		return nil, nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	thread, message, err := a.repo.CreateThread(ctx, req, NewMessage{
+		AuthorID:  req.CreatedBy,
+		Body:      req.Body,
+		Mentioned: parseMentions(req.Body),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create thread: %w", err)
+	}
+	return thread, message, nil
+}
+
+// PostMessage adds a message to a thread, notifying the members it mentions
+func (a *App) PostMessage(ctx context.Context, req PostMessageRequest) (*models.ChatMessage, error) {
+	req.Body = strings.TrimSpace(req.Body)
+	if err := a.validateMessageBody(req.Body); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	message, err := a.repo.PostMessage(ctx, req.ThreadID, NewMessage{
+		AuthorID:  req.AuthorID,
+		Body:      req.Body,
+		Mentioned: parseMentions(req.Body),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to post message: %w", err)
+	}
+	return message, nil
+}
+
+// GetThread retrieves a thread
+func (a *App) GetThread(ctx context.Context, threadID uuid.UUID) (*models.ChatThread, error) {
+	thread, err := a.repo.GetThread(ctx, threadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thread: %w", err)
+	}
+	return thread, nil
+}
+
+// ListThreads retrieves a page of a league's threads, pinned ones first
+func (a *App) ListThreads(ctx context.Context, req ListThreadsRequest) (*ThreadPage, error) {
+	if req.Kind != nil && !validThreadKind(*req.Kind) {
+		return nil, fmt.Errorf("validation failed: %w", ErrInvalidThreadKind)
+	}
+	if req.PageSize < 0 || req.PageSize > maxThreadPageSize {
+		return nil, fmt.Errorf("validation failed: %w: must be between 0 and %d", ErrInvalidPageSize, maxThreadPageSize)
+	}
+
+	after, err := decodeThreadPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultThreadPageSize
+	}
+
+	// Fetch one extra thread to tell whether another page follows
+	threads, err := a.repo.ListThreads(ctx, ListThreadsQuery{
+		LeagueID: req.LeagueID,
+		Kind:     req.Kind,
+		After:    after,
+		Limit:    int32(pageSize + 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list threads: %w", err)
+	}
+
+	page := &ThreadPage{Threads: threads}
+	if len(threads) > pageSize {
+		page.Threads = threads[:pageSize]
+		last := page.Threads[pageSize-1]
+		page.NextPageToken, err = encodePageToken(ThreadCursor{Pinned: last.Pinned, LastMessageAt: last.LastMessageAt, ID: last.ID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// ListMessages retrieves a page of a thread's messages, newest first
+func (a *App) ListMessages(ctx context.Context, req ListMessagesRequest) (*MessagePage, error) {
+	if req.PageSize < 0 || req.PageSize > maxMessagePageSize {
+		return nil, fmt.Errorf("validation failed: %w: must be between 0 and %d", ErrInvalidPageSize, maxMessagePageSize)
+	}
+
+	after, err := decodeMessagePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultMessagePageSize
+	}
+
+	// Fetch one extra message to tell whether another page follows
+	messages, err := a.repo.ListMessages(ctx, ListMessagesQuery{
+		ThreadID: req.ThreadID,
+		After:    after,
+		Limit:    int32(pageSize + 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	page := &MessagePage{Messages: messages}
+	if len(messages) > pageSize {
+		page.Messages = messages[:pageSize]
+		last := page.Messages[pageSize-1]
+		page.NextPageToken, err = encodePageToken(MessageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// DeleteMessage removes a message's text. Only its author or the league's commissioner may.
+func (a *App) DeleteMessage(ctx context.Context, req DeleteMessageRequest) (*models.ChatMessage, error) {
+	if req.DeletedBy != nil {
+		message, leagueID, err := a.repo.GetMessage(ctx, req.MessageID)
+		if err != nil {
+			return nil, err
+		}
+		if message.Deleted() {
+			return nil, ErrMessageNotFound
+		}
+		isAuthor := message.AuthorID != nil && *message.AuthorID == *req.DeletedBy
+		if !isAuthor {
+			if err := a.checkCommissioner(ctx, leagueID, req.DeletedBy); err != nil {
+				if errors.Is(err, ErrNotCommissioner) {
+					return nil, ErrNotAuthor
+				}
+				return nil, err
+			}
+		}
+	}
+
+	message, err := a.repo.DeleteMessage(ctx, req.MessageID, req.DeletedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete message: %w", err)
+	}
+	return message, nil
+}
+
+// SetThreadPinned pins or unpins a thread. Commissioner only.
+func (a *App) SetThreadPinned(ctx context.Context, req ModerateThreadRequest) (*models.ChatThread, error) {
+	if err := a.checkThreadCommissioner(ctx, req.ThreadID, req.ModeratedBy); err != nil {
+		return nil, err
+	}
+
+	thread, err := a.repo.SetThreadPinned(ctx, req.ThreadID, req.On)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pin thread: %w", err)
+	}
+	return thread, nil
+}
+
+// SetThreadLocked locks or unlocks a thread. Commissioner only.
+func (a *App) SetThreadLocked(ctx context.Context, req ModerateThreadRequest) (*models.ChatThread, error) {
+	if err := a.checkThreadCommissioner(ctx, req.ThreadID, req.ModeratedBy); err != nil {
+		return nil, err
+	}
+
+	thread, err := a.repo.SetThreadLocked(ctx, req.ThreadID, req.On)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock thread: %w", err)
+	}
+	return thread, nil
+}
+
+// DeleteThread deletes a thread and its messages. Commissioner only.
+func (a *App) DeleteThread(ctx context.Context, threadID uuid.UUID, deletedBy *uuid.UUID) error {
+	if err := a.checkThreadCommissioner(ctx, threadID, deletedBy); err != nil {
+		return err
+	}
+
+	if err := a.repo.DeleteThread(ctx, threadID); err != nil {
+		return fmt.Errorf("failed to delete thread: %w", err)
+	}
+	return nil
+}
+
+// SetMemberMuted mutes or unmutes a member in a league's threads. Commissioner only.
+func (a *App) SetMemberMuted(ctx context.Context, req SetMemberMutedRequest) ([]uuid.UUID, error) {
+	commissionerID, err := a.repo.GetLeagueCommissionerID(ctx, req.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+	if req.MutedBy != nil && *req.MutedBy != commissionerID {
+		return nil, ErrNotCommissioner
+	}
+	if req.Muted && req.UserID == commissionerID {
+		return nil, ErrCannotMuteCommissioner
+	}
+
+	muted, err := a.repo.SetMemberMuted(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set member muted: %w", err)
+	}
+	return muted, nil
+}
+
+// ListMutedMembers retrieves the members muted in a league
+func (a *App) ListMutedMembers(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error) {
+	muted, err := a.repo.ListMutedMembers(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list muted members: %w", err)
+	}
+	return muted, nil
+}
+
+// checkThreadCommissioner returns ErrNotCommissioner unless the user commissions the league
+// owning a thread. A nil user is a trusted caller.
+func (a *App) checkThreadCommissioner(ctx context.Context, threadID uuid.UUID, userID *uuid.UUID) error {
+	if userID == nil {
+		return nil
+	}
+
+	thread, err := a.repo.GetThread(ctx, threadID)
+	if err != nil {
+		return err
+	}
+	return a.checkCommissioner(ctx, thread.LeagueID, userID)
+}
+
+// checkCommissioner returns ErrNotCommissioner unless the user commissions the league. A nil
+// user is a trusted caller.
+func (a *App) checkCommissioner(ctx context.Context, leagueID uuid.UUID, userID *uuid.UUID) error {
+	if userID == nil {
+		return nil
+	}
+
+	commissionerID, err := a.repo.GetLeagueCommissionerID(ctx, leagueID)
+	if err != nil {
+		return err
+	}
+	if *userID != commissionerID {
+		return ErrNotCommissioner
+	}
+	return nil
+}
+
+// Validation methods
+
+func (a *App) validateCreateThreadRequest(req CreateThreadRequest) error {
+	if !validThreadKind(req.Kind) {
+		return ErrInvalidThreadKind
+	}
+	if req.Title == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalidTitle)
+	}
+	if utf8.RuneCountInString(req.Title) > MaxTitleLen {
+		return fmt.Errorf("%w: must be at most %d characters", ErrInvalidTitle, MaxTitleLen)
+	}
+	return a.validateMessageBody(req.Body)
+}
+
+func (a *App) validateMessageBody(body string) error {
+	if body == "" {
+		return fmt.Errorf("%w: message is empty", ErrInvalidMessage)
+	}
+	if utf8.RuneCountInString(body) > MaxMessageLen {
+		return fmt.Errorf("%w: must be at most %d characters", ErrInvalidMessage, MaxMessageLen)
+	}
+	return nil
+}
+
+func validThreadKind(kind models.ChatThreadKind) bool {
+	return kind == models.ChatThreadKindTrashTalk || kind == models.ChatThreadKindTradeBlock
+}
@@ -0,0 +1,54 @@
+package leaguechat
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// encodePageToken turns a thread or message cursor into the opaque token handed to clients
+func encodePageToken(cursor any) (string, error) {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal page cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decodeThreadPageToken reverses encodePageToken for a thread list. An empty token starts
+// from the top of the board.
+func decodeThreadPageToken(token string) (*ThreadCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	var cursor ThreadCursor
+	if err := decodePageToken(token, &cursor); err != nil || cursor.ID == uuid.Nil || cursor.LastMessageAt.IsZero() {
+		return nil, ErrInvalidPageToken
+	}
+	return &cursor, nil
+}
+
+// decodeMessagePageToken reverses encodePageToken for a message list. An empty token starts
+// from the newest message.
+func decodeMessagePageToken(token string) (*MessageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	var cursor MessageCursor
+	if err := decodePageToken(token, &cursor); err != nil || cursor.ID == uuid.Nil || cursor.CreatedAt.IsZero() {
+		return nil, ErrInvalidPageToken
+	}
+	return &cursor, nil
+}
+
+func decodePageToken(token string, cursor any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, cursor)
+}
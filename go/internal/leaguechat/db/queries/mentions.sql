@@ -0,0 +1,21 @@
+-- name: ListMentionedMembers :many
+-- The league's members, as counted for tenancy, whose usernames were mentioned. Usernames
+-- are matched without regard to case.
+SELECT u.id, u.username, u.email
+FROM users u
+WHERE lower(u.username) = ANY(@usernames::text[])
+  AND u.deleted_at IS NULL
+  AND (
+    EXISTS (SELECT 1 FROM leagues l WHERE l.id = @league_id AND l.commissioner_id = u.id)
+    OR EXISTS (SELECT 1 FROM fantasy_teams ft WHERE ft.league_id = @league_id AND ft.owner_id = u.id)
+    OR EXISTS (SELECT 1 FROM draft_co_managers cm JOIN draft d ON d.id = cm.draft_id WHERE d.league_id = @league_id AND cm.user_id = u.id)
+  )
+ORDER BY u.username;
+
+-- name: GetChatAuthorUsername :one
+SELECT username FROM users WHERE id = $1;
+
+-- name: InsertUserOutbox :exec
+-- Queue a notification for the notification worker to deliver.
+INSERT INTO user_outbox (id, user_id, event_type, payload)
+VALUES ($1, $2, $3, $4);
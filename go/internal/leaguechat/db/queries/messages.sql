@@ -0,0 +1,37 @@
+-- name: InsertChatMessage :one
+INSERT INTO league_chat_messages (thread_id, author_id, body)
+VALUES ($1, $2, $3)
+RETURNING id, thread_id, author_id, body, created_at, deleted_at, deleted_by;
+
+-- name: GetChatMessage :one
+-- The message with the league its thread belongs to.
+SELECT m.id, m.thread_id, m.author_id, m.body, m.created_at, m.deleted_at, m.deleted_by, t.league_id
+FROM league_chat_messages m
+         JOIN league_chat_threads t ON t.id = m.thread_id
+WHERE m.id = $1;
+
+-- name: ListChatMessages :many
+-- Newest first, continuing after the (created_at, id) cursor when one is given.
+SELECT id, thread_id, author_id, body, created_at, deleted_at, deleted_by
+FROM league_chat_messages
+WHERE thread_id = @thread_id
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT @page_size;
+
+-- name: DeleteChatMessage :one
+-- Clears the text of a message, keeping its place in the thread.
+UPDATE league_chat_messages
+SET body       = '',
+    deleted_at = NOW(),
+    deleted_by = $2
+WHERE id = $1
+  AND deleted_at IS NULL
+RETURNING id, thread_id, author_id, body, created_at, deleted_at, deleted_by;
+
+-- name: GetChatMessageLeagueID :one
+SELECT t.league_id
+FROM league_chat_messages m
+         JOIN league_chat_threads t ON t.id = m.thread_id
+WHERE m.id = $1;
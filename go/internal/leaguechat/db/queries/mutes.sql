@@ -0,0 +1,15 @@
+-- name: MuteChatMember :exec
+INSERT INTO league_chat_mutes (league_id, user_id, muted_by)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: UnmuteChatMember :exec
+DELETE FROM league_chat_mutes WHERE league_id = $1 AND user_id = $2;
+
+-- name: IsChatMemberMuted :one
+SELECT EXISTS (
+    SELECT 1 FROM league_chat_mutes WHERE league_id = $1 AND user_id = $2
+) AS is_muted;
+
+-- name: ListChatMutes :many
+SELECT user_id FROM league_chat_mutes WHERE league_id = $1 ORDER BY created_at, user_id;
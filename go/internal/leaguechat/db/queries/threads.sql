@@ -0,0 +1,58 @@
+-- name: CreateChatThread :one
+INSERT INTO league_chat_threads (league_id, kind, title, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING id, league_id, kind, title, created_by, pinned, locked, message_count, last_message_at, created_at, updated_at;
+
+-- name: GetChatThread :one
+SELECT id, league_id, kind, title, created_by, pinned, locked, message_count, last_message_at, created_at, updated_at
+FROM league_chat_threads
+WHERE id = $1;
+
+-- name: GetChatThreadForUpdate :one
+-- Locks the thread so a message can't be posted while it is being locked.
+SELECT id, league_id, kind, title, created_by, pinned, locked, message_count, last_message_at, created_at, updated_at
+FROM league_chat_threads
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListChatThreads :many
+-- Pinned threads first, then by latest activity, continuing after the (pinned, last_message_at, id)
+-- cursor when one is given.
+SELECT id, league_id, kind, title, created_by, pinned, locked, message_count, last_message_at, created_at, updated_at
+FROM league_chat_threads
+WHERE league_id = @league_id
+  AND (sqlc.narg('kind')::text IS NULL OR kind = sqlc.narg('kind')::text)
+  AND (sqlc.narg('cursor_last_message_at')::timestamptz IS NULL
+       OR (pinned, last_message_at, id) < (sqlc.narg('cursor_pinned')::boolean, sqlc.narg('cursor_last_message_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY pinned DESC, last_message_at DESC, id DESC
+LIMIT @page_size;
+
+-- name: RecordChatThreadMessage :exec
+UPDATE league_chat_threads
+SET message_count   = message_count + 1,
+    last_message_at = @posted_at
+WHERE id = @id;
+
+-- name: SetChatThreadPinned :one
+UPDATE league_chat_threads
+SET pinned     = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, league_id, kind, title, created_by, pinned, locked, message_count, last_message_at, created_at, updated_at;
+
+-- name: SetChatThreadLocked :one
+UPDATE league_chat_threads
+SET locked     = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, league_id, kind, title, created_by, pinned, locked, message_count, last_message_at, created_at, updated_at;
+
+-- name: DeleteChatThread :execrows
+DELETE FROM league_chat_threads WHERE id = $1;
+
+-- name: GetChatThreadLeagueID :one
+SELECT league_id FROM league_chat_threads WHERE id = $1;
+
+-- name: GetChatLeague :one
+-- The league's name for notifications and its commissioner for moderation.
+SELECT id, name, commissioner_id FROM leagues WHERE id = $1;
@@ -0,0 +1,42 @@
+package leaguechat
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// mentionPattern matches an @username that starts a message or follows whitespace or
+// punctuation, so email addresses aren't taken for mentions
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@(\w[\w.-]*)`)
+
+// parseMentions returns the lowercased usernames a message mentions, in the order they first
+// appear, up to maxMentionsPerMessage. Whether they belong to league members is left to the
+// repository.
+func parseMentions(body string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		// A mention at the end of a sentence shouldn't take the full stop with it
+		username := strings.ToLower(strings.TrimRight(match[1], ".-"))
+		if username == "" || seen[username] {
+			continue
+		}
+		seen[username] = true
+		usernames = append(usernames, username)
+		if len(usernames) == maxMentionsPerMessage {
+			break
+		}
+	}
+	return usernames
+}
+
+// excerpt shortens a message to quote it in a notification
+func excerpt(body string) string {
+	body = strings.Join(strings.Fields(body), " ")
+	if utf8.RuneCountInString(body) <= mentionExcerptLen {
+		return body
+	}
+	runes := []rune(body)
+	return strings.TrimSpace(string(runes[:mentionExcerptLen-1])) + "…"
+}
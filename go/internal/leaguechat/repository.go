@@ -0,0 +1,447 @@
+package leaguechat
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/ids"
+	"github.com/mcdev12/dynasty/go/internal/leaguechat/db"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	userevents "github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	DeleteChatMessage(ctx context.Context, arg db.DeleteChatMessageParams) (db.LeagueChatMessage, error)
+	DeleteChatThread(ctx context.Context, id uuid.UUID) (int64, error)
+	GetChatLeague(ctx context.Context, id uuid.UUID) (db.GetChatLeagueRow, error)
+	GetChatMessage(ctx context.Context, id uuid.UUID) (db.GetChatMessageRow, error)
+	GetChatMessageLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	GetChatThread(ctx context.Context, id uuid.UUID) (db.LeagueChatThread, error)
+	GetChatThreadLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	ListChatMessages(ctx context.Context, arg db.ListChatMessagesParams) ([]db.LeagueChatMessage, error)
+	ListChatMutes(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error)
+	ListChatThreads(ctx context.Context, arg db.ListChatThreadsParams) ([]db.LeagueChatThread, error)
+	MuteChatMember(ctx context.Context, arg db.MuteChatMemberParams) error
+	SetChatThreadLocked(ctx context.Context, arg db.SetChatThreadLockedParams) (db.LeagueChatThread, error)
+	SetChatThreadPinned(ctx context.Context, arg db.SetChatThreadPinnedParams) (db.LeagueChatThread, error)
+	UnmuteChatMember(ctx context.Context, arg db.UnmuteChatMemberParams) error
+}
+
+// Repository implements league chat data access operations
+type Repository struct {
+	queries Querier
+	sqlDB   *sql.DB
+}
+
+// NewRepository creates a new league chat repository. sqlDB posts messages in a transaction
+// with the notifications for their mentions.
+func NewRepository(querier Querier, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: querier,
+		sqlDB:   sqlDB,
+	}
+}
+
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
+// GetLeagueCommissionerID retrieves the commissioner of a league
+func (r *Repository) GetLeagueCommissionerID(ctx context.Context, leagueID uuid.UUID) (uuid.UUID, error) {
+	league, err := r.queries.GetChatLeague(ctx, leagueID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get league: %w", err)
+	}
+	return league.CommissionerID, nil
+}
+
+// GetThreadLeagueID resolves the league that owns a thread
+func (r *Repository) GetThreadLeagueID(ctx context.Context, threadID uuid.UUID) (uuid.UUID, error) {
+	leagueID, err := r.queries.GetChatThreadLeagueID(ctx, threadID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get thread league: %w", err)
+	}
+	return leagueID, nil
+}
+
+// GetMessageLeagueID resolves the league that owns a message's thread
+func (r *Repository) GetMessageLeagueID(ctx context.Context, messageID uuid.UUID) (uuid.UUID, error) {
+	leagueID, err := r.queries.GetChatMessageLeagueID(ctx, messageID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get message league: %w", err)
+	}
+	return leagueID, nil
+}
+
+// CreateThread starts a thread with its first message and queues notifications for the
+// members it mentions, in one transaction
+func (r *Repository) CreateThread(ctx context.Context, req CreateThreadRequest, message NewMessage) (*models.ChatThread, *models.ChatMessage, error) {
+	var (
+		thread *models.ChatThread
+		posted *models.ChatMessage
+	)
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		league, err := q.GetChatLeague(ctx, req.LeagueID)
+		if err != nil {
+			return fmt.Errorf("failed to get league: %w", err)
+		}
+		if err := r.checkNotMuted(ctx, q, req.LeagueID, message.AuthorID); err != nil {
+			return err
+		}
+
+		row, err := q.CreateChatThread(ctx, db.CreateChatThreadParams{
+			LeagueID:  req.LeagueID,
+			Kind:      string(req.Kind),
+			Title:     req.Title,
+			CreatedBy: uuid.NullUUID{UUID: req.CreatedBy, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create thread: %w", err)
+		}
+
+		posted, err = r.postMessage(ctx, q, league, row, message)
+		if err != nil {
+			return err
+		}
+		row.MessageCount++
+		row.LastMessageAt = posted.CreatedAt
+		thread = r.dbThreadToModel(row)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return thread, posted, nil
+}
+
+// PostMessage adds a message to a thread and queues notifications for the members it
+// mentions, in one transaction. Locked threads only take messages from the commissioner.
+func (r *Repository) PostMessage(ctx context.Context, threadID uuid.UUID, message NewMessage) (*models.ChatMessage, error) {
+	var posted *models.ChatMessage
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		// Locking the thread row keeps the thread from being locked while the message is posted
+		thread, err := q.GetChatThreadForUpdate(ctx, threadID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrThreadNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get thread: %w", err)
+		}
+
+		league, err := q.GetChatLeague(ctx, thread.LeagueID)
+		if err != nil {
+			return fmt.Errorf("failed to get league: %w", err)
+		}
+		if thread.Locked && message.AuthorID != league.CommissionerID {
+			return ErrThreadLocked
+		}
+		if err := r.checkNotMuted(ctx, q, thread.LeagueID, message.AuthorID); err != nil {
+			return err
+		}
+
+		posted, err = r.postMessage(ctx, q, league, thread, message)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return posted, nil
+}
+
+// checkNotMuted returns ErrMuted when the commissioner muted the user in the league
+func (r *Repository) checkNotMuted(ctx context.Context, q *db.Queries, leagueID, userID uuid.UUID) error {
+	muted, err := q.IsChatMemberMuted(ctx, db.IsChatMemberMutedParams{
+		LeagueID: leagueID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check mute: %w", err)
+	}
+	if muted {
+		return ErrMuted
+	}
+	return nil
+}
+
+// postMessage stores a message in a thread, bumps the thread's activity and queues a
+// LeagueChatMention notification for every league member the message mentions other than
+// its author
+func (r *Repository) postMessage(ctx context.Context, q *db.Queries, league db.GetChatLeagueRow, thread db.LeagueChatThread, message NewMessage) (*models.ChatMessage, error) {
+	row, err := q.InsertChatMessage(ctx, db.InsertChatMessageParams{
+		ThreadID: thread.ID,
+		AuthorID: uuid.NullUUID{UUID: message.AuthorID, Valid: true},
+		Body:     message.Body,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert message: %w", err)
+	}
+
+	if err := q.RecordChatThreadMessage(ctx, db.RecordChatThreadMessageParams{
+		PostedAt: row.CreatedAt,
+		ID:       thread.ID,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update thread: %w", err)
+	}
+
+	posted := r.dbMessageToModel(row)
+	if len(message.Mentioned) == 0 {
+		return posted, nil
+	}
+
+	members, err := q.ListMentionedMembers(ctx, db.ListMentionedMembersParams{
+		Usernames: message.Mentioned,
+		LeagueID:  thread.LeagueID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mentioned members: %w", err)
+	}
+	if len(members) == 0 {
+		return posted, nil
+	}
+
+	authorUsername, err := q.GetChatAuthorUsername(ctx, message.AuthorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get author: %w", err)
+	}
+
+	for _, member := range members {
+		if member.ID == message.AuthorID {
+			continue
+		}
+
+		payload, err := json.Marshal(userevents.LeagueChatMentionPayload{
+			UserID:         member.ID.String(),
+			Username:       member.Username,
+			Email:          member.Email,
+			LeagueID:       league.ID.String(),
+			LeagueName:     league.Name,
+			ThreadID:       thread.ID.String(),
+			ThreadTitle:    thread.Title,
+			MessageID:      row.ID.String(),
+			AuthorUsername: authorUsername,
+			Excerpt:        excerpt(message.Body),
+			PostedAt:       row.CreatedAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal LeagueChatMention notification: %w", err)
+		}
+
+		if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+			ID:        ids.New(),
+			UserID:    member.ID,
+			EventType: userevents.LeagueChatMention,
+			Payload:   payload,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to queue LeagueChatMention notification: %w", err)
+		}
+		posted.Mentions = append(posted.Mentions, member.ID)
+	}
+	return posted, nil
+}
+
+// GetThread retrieves a thread
+func (r *Repository) GetThread(ctx context.Context, threadID uuid.UUID) (*models.ChatThread, error) {
+	row, err := r.queries.GetChatThread(ctx, threadID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrThreadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thread: %w", err)
+	}
+	return r.dbThreadToModel(row), nil
+}
+
+// ListThreads retrieves a league's threads, pinned ones first and then by latest activity
+func (r *Repository) ListThreads(ctx context.Context, query ListThreadsQuery) ([]models.ChatThread, error) {
+	params := db.ListChatThreadsParams{
+		LeagueID: query.LeagueID,
+		PageSize: query.Limit,
+	}
+	if query.Kind != nil {
+		params.Kind = sql.NullString{String: string(*query.Kind), Valid: true}
+	}
+	if query.After != nil {
+		params.CursorPinned = sql.NullBool{Bool: query.After.Pinned, Valid: true}
+		params.CursorLastMessageAt = sql.NullTime{Time: query.After.LastMessageAt, Valid: true}
+		params.CursorID = uuid.NullUUID{UUID: query.After.ID, Valid: true}
+	}
+
+	rows, err := r.queries.ListChatThreads(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list threads: %w", err)
+	}
+
+	result := make([]models.ChatThread, len(rows))
+	for i, row := range rows {
+		result[i] = *r.dbThreadToModel(row)
+	}
+	return result, nil
+}
+
+// ListMessages retrieves a thread's messages, newest first
+func (r *Repository) ListMessages(ctx context.Context, query ListMessagesQuery) ([]models.ChatMessage, error) {
+	params := db.ListChatMessagesParams{
+		ThreadID: query.ThreadID,
+		PageSize: query.Limit,
+	}
+	if query.After != nil {
+		params.CursorCreatedAt = sql.NullTime{Time: query.After.CreatedAt, Valid: true}
+		params.CursorID = uuid.NullUUID{UUID: query.After.ID, Valid: true}
+	}
+
+	rows, err := r.queries.ListChatMessages(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	result := make([]models.ChatMessage, len(rows))
+	for i, row := range rows {
+		result[i] = *r.dbMessageToModel(row)
+	}
+	return result, nil
+}
+
+// GetMessage retrieves a message with the league its thread belongs to
+func (r *Repository) GetMessage(ctx context.Context, messageID uuid.UUID) (*models.ChatMessage, uuid.UUID, error) {
+	row, err := r.queries.GetChatMessage(ctx, messageID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, uuid.Nil, ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	return r.dbMessageToModel(db.LeagueChatMessage{
+		ID:        row.ID,
+		ThreadID:  row.ThreadID,
+		AuthorID:  row.AuthorID,
+		Body:      row.Body,
+		CreatedAt: row.CreatedAt,
+		DeletedAt: row.DeletedAt,
+		DeletedBy: row.DeletedBy,
+	}), row.LeagueID, nil
+}
+
+// DeleteMessage clears a message's text. Messages already deleted return ErrMessageNotFound.
+func (r *Repository) DeleteMessage(ctx context.Context, messageID uuid.UUID, deletedBy *uuid.UUID) (*models.ChatMessage, error) {
+	row, err := r.queries.DeleteChatMessage(ctx, db.DeleteChatMessageParams{
+		ID:        messageID,
+		DeletedBy: sqlutil.ToNullUUID(deletedBy),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete message: %w", err)
+	}
+	return r.dbMessageToModel(row), nil
+}
+
+// SetThreadPinned pins or unpins a thread
+func (r *Repository) SetThreadPinned(ctx context.Context, threadID uuid.UUID, pinned bool) (*models.ChatThread, error) {
+	row, err := r.queries.SetChatThreadPinned(ctx, db.SetChatThreadPinnedParams{
+		ID:     threadID,
+		Pinned: pinned,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrThreadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to pin thread: %w", err)
+	}
+	return r.dbThreadToModel(row), nil
+}
+
+// SetThreadLocked locks or unlocks a thread
+func (r *Repository) SetThreadLocked(ctx context.Context, threadID uuid.UUID, locked bool) (*models.ChatThread, error) {
+	row, err := r.queries.SetChatThreadLocked(ctx, db.SetChatThreadLockedParams{
+		ID:     threadID,
+		Locked: locked,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrThreadNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock thread: %w", err)
+	}
+	return r.dbThreadToModel(row), nil
+}
+
+// DeleteThread deletes a thread and its messages
+func (r *Repository) DeleteThread(ctx context.Context, threadID uuid.UUID) error {
+	deleted, err := r.queries.DeleteChatThread(ctx, threadID)
+	if err != nil {
+		return fmt.Errorf("failed to delete thread: %w", err)
+	}
+	if deleted == 0 {
+		return ErrThreadNotFound
+	}
+	return nil
+}
+
+// SetMemberMuted mutes or unmutes a member and returns every member muted in the league
+func (r *Repository) SetMemberMuted(ctx context.Context, req SetMemberMutedRequest) ([]uuid.UUID, error) {
+	if req.Muted {
+		if err := r.queries.MuteChatMember(ctx, db.MuteChatMemberParams{
+			LeagueID: req.LeagueID,
+			UserID:   req.UserID,
+			MutedBy:  sqlutil.ToNullUUID(req.MutedBy),
+		}); err != nil {
+			return nil, fmt.Errorf("failed to mute member: %w", err)
+		}
+	} else {
+		if err := r.queries.UnmuteChatMember(ctx, db.UnmuteChatMemberParams{
+			LeagueID: req.LeagueID,
+			UserID:   req.UserID,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to unmute member: %w", err)
+		}
+	}
+	return r.ListMutedMembers(ctx, req.LeagueID)
+}
+
+// ListMutedMembers retrieves the members muted in a league, longest muted first
+func (r *Repository) ListMutedMembers(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error) {
+	userIDs, err := r.queries.ListChatMutes(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list muted members: %w", err)
+	}
+	return userIDs, nil
+}
+
+// Conversion methods
+
+// dbThreadToModel converts a database thread to a domain model
+func (r *Repository) dbThreadToModel(row db.LeagueChatThread) *models.ChatThread {
+	return &models.ChatThread{
+		ID:            row.ID,
+		LeagueID:      row.LeagueID,
+		Kind:          models.ChatThreadKind(row.Kind),
+		Title:         row.Title,
+		CreatedBy:     sqlutil.FromNullUUID(row.CreatedBy),
+		Pinned:        row.Pinned,
+		Locked:        row.Locked,
+		MessageCount:  int(row.MessageCount),
+		LastMessageAt: row.LastMessageAt,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
+
+// dbMessageToModel converts a database message to a domain model
+func (r *Repository) dbMessageToModel(row db.LeagueChatMessage) *models.ChatMessage {
+	return &models.ChatMessage{
+		ID:        row.ID,
+		ThreadID:  row.ThreadID,
+		AuthorID:  sqlutil.FromNullUUID(row.AuthorID),
+		Body:      row.Body,
+		CreatedAt: row.CreatedAt,
+		DeletedAt: sqlutil.FromSqlTime(row.DeletedAt),
+		DeletedBy: sqlutil.FromNullUUID(row.DeletedBy),
+	}
+}
@@ -0,0 +1,388 @@
+package leaguechat
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	leaguechatv1 "github.com/mcdev12/dynasty/go/internal/genproto/leaguechat/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/leaguechat/v1/leaguechatv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ChatApp defines what the service layer needs from the league chat application
+type ChatApp interface {
+	CreateThread(ctx context.Context, req CreateThreadRequest) (*models.ChatThread, *models.ChatMessage, error)
+	PostMessage(ctx context.Context, req PostMessageRequest) (*models.ChatMessage, error)
+	GetThread(ctx context.Context, threadID uuid.UUID) (*models.ChatThread, error)
+	ListThreads(ctx context.Context, req ListThreadsRequest) (*ThreadPage, error)
+	ListMessages(ctx context.Context, req ListMessagesRequest) (*MessagePage, error)
+	DeleteMessage(ctx context.Context, req DeleteMessageRequest) (*models.ChatMessage, error)
+	SetThreadPinned(ctx context.Context, req ModerateThreadRequest) (*models.ChatThread, error)
+	SetThreadLocked(ctx context.Context, req ModerateThreadRequest) (*models.ChatThread, error)
+	DeleteThread(ctx context.Context, threadID uuid.UUID, deletedBy *uuid.UUID) error
+	SetMemberMuted(ctx context.Context, req SetMemberMutedRequest) ([]uuid.UUID, error)
+	ListMutedMembers(ctx context.Context, leagueID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// Service implements the ChatService gRPC interface. Posting needs a signed in user; requests
+// without one are trusted callers when moderating.
+type Service struct {
+	app ChatApp
+}
+
+// NewService creates a new league chat gRPC service
+func NewService(app ChatApp) *Service {
+	return &Service{
+		app: app,
+	}
+}
+
+// Verify that Service implements the ChatServiceHandler interface
+var _ leaguechatv1connect.ChatServiceHandler = (*Service)(nil)
+
+// CreateThread starts a thread with its first message
+func (s *Service) CreateThread(ctx context.Context, req *connect.Request[leaguechatv1.CreateThreadRequest]) (*connect.Response[leaguechatv1.CreateThreadResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("sign in to start a thread"))
+	}
+
+	thread, message, err := s.app.CreateThread(ctx, CreateThreadRequest{
+		LeagueID:  leagueID,
+		Kind:      s.protoToThreadKind(req.Msg.Kind),
+		Title:     req.Msg.Title,
+		Body:      req.Msg.Body,
+		CreatedBy: actingUser,
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&leaguechatv1.CreateThreadResponse{
+		Thread:  s.threadToProto(thread),
+		Message: s.messageToProto(message),
+	}), nil
+}
+
+// GetThread retrieves a thread
+func (s *Service) GetThread(ctx context.Context, req *connect.Request[leaguechatv1.GetThreadRequest]) (*connect.Response[leaguechatv1.GetThreadResponse], error) {
+	threadID, err := uuidutil.MustParseOrInvalidArg("thread_id", req.Msg.ThreadId)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := s.app.GetThread(ctx, threadID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&leaguechatv1.GetThreadResponse{
+		Thread: s.threadToProto(thread),
+	}), nil
+}
+
+// ListThreads pages through a league's threads, pinned ones first and then by latest activity
+func (s *Service) ListThreads(ctx context.Context, req *connect.Request[leaguechatv1.ListThreadsRequest]) (*connect.Response[leaguechatv1.ListThreadsResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := ListThreadsRequest{
+		LeagueID:  leagueID,
+		PageSize:  int(req.Msg.PageSize),
+		PageToken: req.Msg.PageToken,
+	}
+	if req.Msg.Kind != nil {
+		kind := s.protoToThreadKind(*req.Msg.Kind)
+		appReq.Kind = &kind
+	}
+
+	page, err := s.app.ListThreads(ctx, appReq)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	protoThreads := make([]*leaguechatv1.Thread, len(page.Threads))
+	for i := range page.Threads {
+		protoThreads[i] = s.threadToProto(&page.Threads[i])
+	}
+
+	return connect.NewResponse(&leaguechatv1.ListThreadsResponse{
+		Threads:       protoThreads,
+		NextPageToken: page.NextPageToken,
+	}), nil
+}
+
+// PostMessage adds a message to a thread
+func (s *Service) PostMessage(ctx context.Context, req *connect.Request[leaguechatv1.PostMessageRequest]) (*connect.Response[leaguechatv1.PostMessageResponse], error) {
+	threadID, err := uuidutil.MustParseOrInvalidArg("thread_id", req.Msg.ThreadId)
+	if err != nil {
+		return nil, err
+	}
+
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("sign in to post a message"))
+	}
+
+	message, err := s.app.PostMessage(ctx, PostMessageRequest{
+		ThreadID: threadID,
+		Body:     req.Msg.Body,
+		AuthorID: actingUser,
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&leaguechatv1.PostMessageResponse{
+		Message: s.messageToProto(message),
+	}), nil
+}
+
+// ListMessages pages through a thread's messages, newest first
+func (s *Service) ListMessages(ctx context.Context, req *connect.Request[leaguechatv1.ListMessagesRequest]) (*connect.Response[leaguechatv1.ListMessagesResponse], error) {
+	threadID, err := uuidutil.MustParseOrInvalidArg("thread_id", req.Msg.ThreadId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.app.ListMessages(ctx, ListMessagesRequest{
+		ThreadID:  threadID,
+		PageSize:  int(req.Msg.PageSize),
+		PageToken: req.Msg.PageToken,
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	protoMessages := make([]*leaguechatv1.Message, len(page.Messages))
+	for i := range page.Messages {
+		protoMessages[i] = s.messageToProto(&page.Messages[i])
+	}
+
+	return connect.NewResponse(&leaguechatv1.ListMessagesResponse{
+		Messages:      protoMessages,
+		NextPageToken: page.NextPageToken,
+	}), nil
+}
+
+// DeleteMessage removes a message's text. Its author or the commissioner only.
+func (s *Service) DeleteMessage(ctx context.Context, req *connect.Request[leaguechatv1.DeleteMessageRequest]) (*connect.Response[leaguechatv1.DeleteMessageResponse], error) {
+	messageID, err := uuidutil.MustParseOrInvalidArg("message_id", req.Msg.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := s.app.DeleteMessage(ctx, DeleteMessageRequest{
+		MessageID: messageID,
+		DeletedBy: interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&leaguechatv1.DeleteMessageResponse{
+		Message: s.messageToProto(message),
+	}), nil
+}
+
+// SetThreadPinned pins or unpins a thread. Commissioner only.
+func (s *Service) SetThreadPinned(ctx context.Context, req *connect.Request[leaguechatv1.SetThreadPinnedRequest]) (*connect.Response[leaguechatv1.SetThreadPinnedResponse], error) {
+	threadID, err := uuidutil.MustParseOrInvalidArg("thread_id", req.Msg.ThreadId)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := s.app.SetThreadPinned(ctx, ModerateThreadRequest{
+		ThreadID:    threadID,
+		On:          req.Msg.Pinned,
+		ModeratedBy: interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&leaguechatv1.SetThreadPinnedResponse{
+		Thread: s.threadToProto(thread),
+	}), nil
+}
+
+// SetThreadLocked locks or unlocks a thread. Commissioner only.
+func (s *Service) SetThreadLocked(ctx context.Context, req *connect.Request[leaguechatv1.SetThreadLockedRequest]) (*connect.Response[leaguechatv1.SetThreadLockedResponse], error) {
+	threadID, err := uuidutil.MustParseOrInvalidArg("thread_id", req.Msg.ThreadId)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := s.app.SetThreadLocked(ctx, ModerateThreadRequest{
+		ThreadID:    threadID,
+		On:          req.Msg.Locked,
+		ModeratedBy: interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&leaguechatv1.SetThreadLockedResponse{
+		Thread: s.threadToProto(thread),
+	}), nil
+}
+
+// DeleteThread deletes a thread and its messages. Commissioner only.
+func (s *Service) DeleteThread(ctx context.Context, req *connect.Request[leaguechatv1.DeleteThreadRequest]) (*connect.Response[leaguechatv1.DeleteThreadResponse], error) {
+	threadID, err := uuidutil.MustParseOrInvalidArg("thread_id", req.Msg.ThreadId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.app.DeleteThread(ctx, threadID, interceptors.ActingUserPtr(ctx)); err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&leaguechatv1.DeleteThreadResponse{
+		Success: true,
+	}), nil
+}
+
+// SetMemberMuted stops a member from posting to the league's threads, or lets them again.
+// Commissioner only.
+func (s *Service) SetMemberMuted(ctx context.Context, req *connect.Request[leaguechatv1.SetMemberMutedRequest]) (*connect.Response[leaguechatv1.SetMemberMutedResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	muted, err := s.app.SetMemberMuted(ctx, SetMemberMutedRequest{
+		LeagueID: leagueID,
+		UserID:   userID,
+		Muted:    req.Msg.Muted,
+		MutedBy:  interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&leaguechatv1.SetMemberMutedResponse{
+		MutedUserIds: uuidsToStrings(muted),
+	}), nil
+}
+
+// ListMutedMembers lists the members muted in a league
+func (s *Service) ListMutedMembers(ctx context.Context, req *connect.Request[leaguechatv1.ListMutedMembersRequest]) (*connect.Response[leaguechatv1.ListMutedMembersResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	muted, err := s.app.ListMutedMembers(ctx, leagueID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&leaguechatv1.ListMutedMembersResponse{
+		MutedUserIds: uuidsToStrings(muted),
+	}), nil
+}
+
+// toConnectError maps app errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidPageToken), errors.Is(err, ErrInvalidThreadKind), errors.Is(err, ErrInvalidTitle),
+		errors.Is(err, ErrInvalidMessage), errors.Is(err, ErrInvalidPageSize), errors.Is(err, ErrCannotMuteCommissioner):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrThreadNotFound), errors.Is(err, ErrMessageNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrThreadLocked):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, ErrMuted), errors.Is(err, ErrNotCommissioner), errors.Is(err, ErrNotAuthor):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// Conversion methods
+
+// threadToProto converts a domain thread to proto
+func (s *Service) threadToProto(thread *models.ChatThread) *leaguechatv1.Thread {
+	protoThread := &leaguechatv1.Thread{
+		Id:            thread.ID.String(),
+		LeagueId:      thread.LeagueID.String(),
+		Kind:          s.threadKindToProto(thread.Kind),
+		Title:         thread.Title,
+		Pinned:        thread.Pinned,
+		Locked:        thread.Locked,
+		MessageCount:  int32(thread.MessageCount),
+		LastMessageAt: timestamppb.New(thread.LastMessageAt),
+		CreatedAt:     timestamppb.New(thread.CreatedAt),
+	}
+	if thread.CreatedBy != nil {
+		createdBy := thread.CreatedBy.String()
+		protoThread.CreatedBy = &createdBy
+	}
+	return protoThread
+}
+
+// messageToProto converts a domain message to proto
+func (s *Service) messageToProto(message *models.ChatMessage) *leaguechatv1.Message {
+	protoMessage := &leaguechatv1.Message{
+		Id:               message.ID.String(),
+		ThreadId:         message.ThreadID.String(),
+		Body:             message.Body,
+		CreatedAt:        timestamppb.New(message.CreatedAt),
+		Deleted:          message.Deleted(),
+		MentionedUserIds: uuidsToStrings(message.Mentions),
+	}
+	if message.AuthorID != nil {
+		authorID := message.AuthorID.String()
+		protoMessage.AuthorId = &authorID
+	}
+	return protoMessage
+}
+
+// threadKindToProto converts a domain thread kind to proto
+func (s *Service) threadKindToProto(kind models.ChatThreadKind) leaguechatv1.ThreadKind {
+	switch kind {
+	case models.ChatThreadKindTrashTalk:
+		return leaguechatv1.ThreadKind_THREAD_KIND_TRASH_TALK
+	case models.ChatThreadKindTradeBlock:
+		return leaguechatv1.ThreadKind_THREAD_KIND_TRADE_BLOCK
+	default:
+		return leaguechatv1.ThreadKind_THREAD_KIND_UNSPECIFIED
+	}
+}
+
+// protoToThreadKind converts a proto thread kind to domain
+func (s *Service) protoToThreadKind(kind leaguechatv1.ThreadKind) models.ChatThreadKind {
+	switch kind {
+	case leaguechatv1.ThreadKind_THREAD_KIND_TRASH_TALK:
+		return models.ChatThreadKindTrashTalk
+	case leaguechatv1.ThreadKind_THREAD_KIND_TRADE_BLOCK:
+		return models.ChatThreadKindTradeBlock
+	default:
+		return ""
+	}
+}
+
+func uuidsToStrings(ids []uuid.UUID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
@@ -0,0 +1,156 @@
+package leaguechat
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrInvalidPageToken is returned when a page token wasn't issued by the list it is passed to
+	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrInvalidThreadKind is returned for a thread on an unknown board
+	ErrInvalidThreadKind = errors.New("invalid thread kind")
+	// ErrInvalidTitle is returned for a thread title that is empty or too long
+	ErrInvalidTitle = errors.New("invalid thread title")
+	// ErrInvalidMessage is returned for a message that is empty or too long
+	ErrInvalidMessage = errors.New("invalid message")
+	// ErrInvalidPageSize is returned for a page size out of range
+	ErrInvalidPageSize = errors.New("invalid page size")
+	// ErrThreadNotFound is returned when a thread does not exist
+	ErrThreadNotFound = errors.New("thread not found")
+	// ErrMessageNotFound is returned when a message does not exist or was already deleted
+	ErrMessageNotFound = errors.New("message not found")
+	// ErrThreadLocked is returned when someone other than the commissioner posts to a locked thread
+	ErrThreadLocked = errors.New("thread is locked")
+	// ErrMuted is returned when a member the commissioner muted posts to the league's threads
+	ErrMuted = errors.New("you have been muted in this league")
+	// ErrNotCommissioner is returned when someone other than the league's commissioner moderates its threads
+	ErrNotCommissioner = errors.New("only the league commissioner can do this")
+	// ErrNotAuthor is returned when someone deletes a message that is neither theirs nor in a league they commission
+	ErrNotAuthor = errors.New("only the message's author or the league commissioner can delete it")
+	// ErrCannotMuteCommissioner is returned when the commissioner is the member being muted
+	ErrCannotMuteCommissioner = errors.New("the league commissioner can't be muted")
+)
+
+const (
+	// MaxTitleLen is the longest thread title, in characters
+	MaxTitleLen = 120
+	// MaxMessageLen is the longest message, in characters
+	MaxMessageLen = 4000
+
+	// maxMentionsPerMessage caps how many members one message can notify
+	maxMentionsPerMessage = 10
+	// mentionExcerptLen is how much of a message, in characters, a mention notification quotes
+	mentionExcerptLen = 140
+
+	// defaultThreadPageSize is used when a thread list request doesn't set a page size
+	defaultThreadPageSize = 25
+	// maxThreadPageSize caps the page size of a thread list request
+	maxThreadPageSize = 100
+	// defaultMessagePageSize is used when a message list request doesn't set a page size
+	defaultMessagePageSize = 50
+	// maxMessagePageSize caps the page size of a message list request
+	maxMessagePageSize = 200
+)
+
+// CreateThreadRequest starts a thread on one of a league's boards with its first message
+type CreateThreadRequest struct {
+	LeagueID  uuid.UUID             `json:"league_id"`
+	Kind      models.ChatThreadKind `json:"kind"`
+	Title     string                `json:"title"`
+	Body      string                `json:"body"`
+	CreatedBy uuid.UUID             `json:"created_by"`
+}
+
+// PostMessageRequest adds a message to a thread
+type PostMessageRequest struct {
+	ThreadID uuid.UUID `json:"thread_id"`
+	Body     string    `json:"body"`
+	AuthorID uuid.UUID `json:"author_id"`
+}
+
+// NewMessage is a message the repository stores, with the usernames it mentions
+type NewMessage struct {
+	AuthorID  uuid.UUID
+	Body      string
+	Mentioned []string // lowercased usernames
+}
+
+// DeleteMessageRequest removes a message's text
+type DeleteMessageRequest struct {
+	MessageID uuid.UUID  `json:"message_id"`
+	DeletedBy *uuid.UUID `json:"deleted_by,omitempty"` // nil for trusted callers, who may delete any message
+}
+
+// ModerateThreadRequest pins or locks a thread
+type ModerateThreadRequest struct {
+	ThreadID    uuid.UUID  `json:"thread_id"`
+	On          bool       `json:"on"`
+	ModeratedBy *uuid.UUID `json:"moderated_by,omitempty"` // nil for trusted callers
+}
+
+// SetMemberMutedRequest mutes or unmutes a member in a league's threads
+type SetMemberMutedRequest struct {
+	LeagueID uuid.UUID  `json:"league_id"`
+	UserID   uuid.UUID  `json:"user_id"`
+	Muted    bool       `json:"muted"`
+	MutedBy  *uuid.UUID `json:"muted_by,omitempty"` // nil for trusted callers
+}
+
+// ListThreadsRequest pages through a league's threads, pinned ones first
+type ListThreadsRequest struct {
+	LeagueID  uuid.UUID              `json:"league_id"`
+	Kind      *models.ChatThreadKind `json:"kind,omitempty"` // nil returns every board
+	PageSize  int                    `json:"page_size"`
+	PageToken string                 `json:"page_token,omitempty"`
+}
+
+// ThreadPage is one page of a league's threads
+type ThreadPage struct {
+	Threads       []models.ChatThread `json:"threads"`
+	NextPageToken string              `json:"next_page_token,omitempty"` // empty on the last page
+}
+
+// ListThreadsQuery selects the threads the repository returns
+type ListThreadsQuery struct {
+	LeagueID uuid.UUID
+	Kind     *models.ChatThreadKind
+	After    *ThreadCursor // continue after this thread
+	Limit    int32
+}
+
+// ThreadCursor is the position of the last thread on a page in board order
+type ThreadCursor struct {
+	Pinned        bool      `json:"pinned"`
+	LastMessageAt time.Time `json:"last_message_at"`
+	ID            uuid.UUID `json:"id"`
+}
+
+// ListMessagesRequest pages through a thread's messages, newest first
+type ListMessagesRequest struct {
+	ThreadID  uuid.UUID `json:"thread_id"`
+	PageSize  int       `json:"page_size"`
+	PageToken string    `json:"page_token,omitempty"`
+}
+
+// MessagePage is one page of a thread's messages
+type MessagePage struct {
+	Messages      []models.ChatMessage `json:"messages"`
+	NextPageToken string               `json:"next_page_token,omitempty"` // empty on the last page
+}
+
+// ListMessagesQuery selects the messages the repository returns
+type ListMessagesQuery struct {
+	ThreadID uuid.UUID
+	After    *MessageCursor // continue after this message
+	Limit    int32
+}
+
+// MessageCursor is the position of the last message on a page in thread order
+type MessageCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
@@ -0,0 +1,19 @@
+-- name: CreateLeagueInitialization :one
+-- Returns no row when an initialization with the id already exists.
+INSERT INTO league_initializations (id, commissioner_id, request)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO NOTHING
+RETURNING *;
+
+-- name: GetLeagueInitialization :one
+SELECT *
+FROM league_initializations
+WHERE id = $1;
+
+-- name: UpdateLeagueInitialization :exec
+UPDATE league_initializations
+SET status     = $2,
+    progress   = $3,
+    error      = $4,
+    updated_at = NOW()
+WHERE id = $1;
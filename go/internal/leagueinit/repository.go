@@ -0,0 +1,97 @@
+package leagueinit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
+	"github.com/mcdev12/dynasty/go/internal/leagueinit/db"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type Repository struct {
+	queries *db.Queries
+	sqlDB   *sql.DB
+}
+
+func NewRepository(queries *db.Queries, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: queries,
+		sqlDB:   sqlDB,
+	}
+}
+
+// StartInitialization records a new initialization, or returns the existing one with the
+// same ID
+func (r *Repository) StartInitialization(ctx context.Context, id, commissionerID uuid.UUID, req *leaguev1.InitializeLeagueRequest) (*Initialization, error) {
+	request, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	row, err := r.queries.CreateLeagueInitialization(ctx, db.CreateLeagueInitializationParams{
+		ID:             id,
+		CommissionerID: commissionerID,
+		Request:        request,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		row, err = r.queries.GetLeagueInitialization(ctx, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to start league initialization: %w", err)
+	}
+	return r.dbInitializationToModel(row)
+}
+
+// SaveInitialization records an initialization's status, progress and error
+func (r *Repository) SaveInitialization(ctx context.Context, init *Initialization) error {
+	progress, err := json.Marshal(init.Progress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal progress: %w", err)
+	}
+
+	err = r.queries.UpdateLeagueInitialization(ctx, db.UpdateLeagueInitializationParams{
+		ID:       init.ID,
+		Status:   string(init.Status),
+		Progress: progress,
+		Error:    sqlutil.ToSqlString(init.Error),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save league initialization: %w", err)
+	}
+	return nil
+}
+
+// LockInitialization takes a session lock on an initialization without waiting. ok is false
+// when another request holds it; otherwise release must be called.
+func (r *Repository) LockInitialization(ctx context.Context, id uuid.UUID) (release func(), ok bool, err error) {
+	return sqlutil.TryLockSession(ctx, r.sqlDB, sqlutil.LockClassLeagueInitialization, id)
+}
+
+// Helper function to convert DB initialization to model
+func (r *Repository) dbInitializationToModel(dbInit db.LeagueInitialization) (*Initialization, error) {
+	req := &leaguev1.InitializeLeagueRequest{}
+	if err := protojson.Unmarshal(dbInit.Request, req); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request: %w", err)
+	}
+	var progress Progress
+	if err := json.Unmarshal(dbInit.Progress, &progress); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal progress: %w", err)
+	}
+
+	return &Initialization{
+		ID:             dbInit.ID,
+		CommissionerID: dbInit.CommissionerID,
+		Request:        req,
+		Status:         Status(dbInit.Status),
+		Progress:       progress,
+		Error:          sqlutil.FromSqlStringPtr(dbInit.Error),
+		CreatedAt:      dbInit.CreatedAt,
+		UpdatedAt:      dbInit.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,489 @@
+package leagueinit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	fantasyteamv1 "github.com/mcdev12/dynasty/go/internal/genproto/fantasyteam/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/fantasyteam/v1/fantasyteamv1connect"
+	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+)
+
+// InitializationRepository defines what the saga needs from the initialization repository
+type InitializationRepository interface {
+	StartInitialization(ctx context.Context, id, commissionerID uuid.UUID, req *leaguev1.InitializeLeagueRequest) (*Initialization, error)
+	SaveInitialization(ctx context.Context, init *Initialization) error
+	LockInitialization(ctx context.Context, id uuid.UUID) (release func(), ok bool, err error)
+}
+
+// step is one step of the saga. Both functions record what they change in the
+// initialization's progress and must be safe to repeat, since a saga interrupted between
+// making a change and saving its progress repeats the step when resumed.
+type step struct {
+	name       string
+	run        func(ctx context.Context, init *Initialization) error
+	compensate func(ctx context.Context, init *Initialization) error
+}
+
+// Saga initializes a league across the league, fantasy team, draft and draft pick services,
+// undoing the completed steps when one fails. Its progress is saved after every change, so a
+// saga interrupted by a crash or a cancelled request is resumed by retrying the request.
+type Saga struct {
+	repo          InitializationRepository
+	leagueService leaguev1connect.LeagueServiceClient
+	teamService   fantasyteamv1connect.FantasyTeamServiceClient
+	draftService  draftv1connect.DraftServiceClient
+	pickService   draftv1connect.DraftPickServiceClient
+	steps         []step
+}
+
+// NewSaga creates a league initialization saga
+func NewSaga(repo InitializationRepository, leagueService leaguev1connect.LeagueServiceClient, teamService fantasyteamv1connect.FantasyTeamServiceClient, draftService draftv1connect.DraftServiceClient, pickService draftv1connect.DraftPickServiceClient) *Saga {
+	s := &Saga{
+		repo:          repo,
+		leagueService: leagueService,
+		teamService:   teamService,
+		draftService:  draftService,
+		pickService:   pickService,
+	}
+	s.steps = []step{
+		{name: "create league", run: s.createLeague, compensate: s.deleteLeague},
+		{name: "create teams", run: s.createTeams, compensate: s.deleteTeams},
+		{name: "create draft", run: s.createDraft, compensate: s.deleteDraft},
+		{name: "prepopulate picks", run: s.prepopulatePicks, compensate: s.deletePicks},
+	}
+	return s
+}
+
+// Initialize runs the initialization with the given ID, starting it from req if it is new.
+// A completed initialization returns its result again; one that failed returns
+// ErrInitializationFailed.
+func (s *Saga) Initialize(ctx context.Context, id, commissionerID uuid.UUID, req *leaguev1.InitializeLeagueRequest) (*Result, error) {
+	init, err := s.repo.StartInitialization(ctx, id, commissionerID, req)
+	if err != nil {
+		return nil, err
+	}
+	if init.CommissionerID != commissionerID {
+		return nil, ErrNotCommissioner
+	}
+
+	release, ok, err := s.repo.LockInitialization(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock league initialization: %w", err)
+	}
+	if !ok {
+		return nil, ErrInitializationInProgress
+	}
+	defer release()
+
+	switch init.Status {
+	case StatusCompleted:
+		return s.result(ctx, init)
+	case StatusFailed:
+		return nil, fmt.Errorf("%w: %s", ErrInitializationFailed, *init.Error)
+	case StatusCompensating:
+		return nil, s.compensate(ctx, init)
+	}
+
+	for _, step := range s.steps {
+		if err := step.run(ctx, init); err != nil {
+			if ctx.Err() != nil {
+				// Left running for a retry of the request to resume
+				return nil, err
+			}
+			return nil, s.fail(ctx, init, fmt.Errorf("failed to %s: %w", step.name, err))
+		}
+	}
+
+	init.Status = StatusCompleted
+	if err := s.repo.SaveInitialization(ctx, init); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Initialized league %s with %d teams and draft %s (%d picks)", *init.Progress.LeagueID, len(init.Progress.TeamIDs), *init.Progress.DraftID, init.Progress.PicksCreated)
+	return s.result(ctx, init)
+}
+
+// fail records why the saga failed and undoes the steps it completed
+func (s *Saga) fail(ctx context.Context, init *Initialization, cause error) error {
+	log.Printf("League initialization %s failed, compensating: %v", init.ID, cause)
+
+	reason := cause.Error()
+	init.Status = StatusCompensating
+	init.Error = &reason
+	if err := s.repo.SaveInitialization(ctx, init); err != nil {
+		return errors.Join(cause, err)
+	}
+	if err := s.compensate(ctx, init); err != nil && !errors.Is(err, ErrInitializationFailed) {
+		return errors.Join(cause, err)
+	}
+	return fmt.Errorf("%w: %w", ErrInitializationFailed, cause)
+}
+
+// compensate undoes the saga's steps in reverse order. A compensation that fails leaves the
+// saga compensating, to be finished by a retry of the request.
+func (s *Saga) compensate(ctx context.Context, init *Initialization) error {
+	for i := len(s.steps) - 1; i >= 0; i-- {
+		if err := s.steps[i].compensate(ctx, init); err != nil {
+			return fmt.Errorf("failed to undo %s: %w", s.steps[i].name, err)
+		}
+	}
+
+	init.Status = StatusFailed
+	if err := s.repo.SaveInitialization(ctx, init); err != nil {
+		return err
+	}
+
+	log.Printf("League initialization %s undone", init.ID)
+	return fmt.Errorf("%w: %s", ErrInitializationFailed, *init.Error)
+}
+
+// result reads back what a completed initialization created
+func (s *Saga) result(ctx context.Context, init *Initialization) (*Result, error) {
+	leagueResp, err := s.leagueService.GetLeague(ctx, connect.NewRequest(&leaguev1.GetLeagueRequest{
+		Id: init.Progress.LeagueID.String(),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league: %w", err)
+	}
+	teamIDs, err := memberTeamIDs(init)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		League:       leagueResp.Msg.League,
+		TeamIDs:      teamIDs,
+		DraftID:      *init.Progress.DraftID,
+		PicksCreated: init.Progress.PicksCreated,
+	}, nil
+}
+
+// createLeague creates the league. A league the saga created before being interrupted is
+// found among the commissioner's leagues rather than created again.
+func (s *Saga) createLeague(ctx context.Context, init *Initialization) error {
+	if init.Progress.LeagueID != nil {
+		return nil
+	}
+	req := init.Request
+
+	leagueID, err := s.findCreatedLeague(ctx, init)
+	if err != nil {
+		return err
+	}
+	if leagueID == nil {
+		resp, err := s.leagueService.CreateLeague(ctx, connect.NewRequest(&leaguev1.CreateLeagueRequest{
+			Name:           req.Name,
+			SportId:        req.SportId,
+			LeagueType:     req.LeagueType,
+			CommissionerId: init.CommissionerID.String(),
+			LeagueSettings: req.LeagueSettings,
+			LeagueStatus:   leaguev1.LeagueStatus_LEAGUE_STATUS_PENDING,
+			Season:         req.Season,
+			TemplateId:     req.TemplateId,
+		}))
+		if err != nil {
+			return err
+		}
+		id, err := uuidutil.MustParseOrInvalidArg("league.id", resp.Msg.League.Id)
+		if err != nil {
+			return err
+		}
+		leagueID = &id
+	}
+
+	init.Progress.LeagueID = leagueID
+	return s.repo.SaveInitialization(ctx, init)
+}
+
+// findCreatedLeague looks for a league matching the request that the commissioner created
+// since the initialization started
+func (s *Saga) findCreatedLeague(ctx context.Context, init *Initialization) (*uuid.UUID, error) {
+	resp, err := s.leagueService.GetLeaguesByCommissioner(ctx, connect.NewRequest(&leaguev1.GetLeaguesByCommissionerRequest{
+		CommissionerId: init.CommissionerID.String(),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commissioner's leagues: %w", err)
+	}
+
+	for _, league := range resp.Msg.Leagues {
+		if league.Name == init.Request.Name && league.Season == init.Request.Season && !league.CreatedAt.AsTime().Before(init.CreatedAt) {
+			id, err := uuidutil.MustParseOrInvalidArg("league.id", league.Id)
+			if err != nil {
+				return nil, err
+			}
+			return &id, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *Saga) deleteLeague(ctx context.Context, init *Initialization) error {
+	if init.Progress.LeagueID == nil {
+		return nil
+	}
+
+	_, err := s.leagueService.GetLeague(ctx, connect.NewRequest(&leaguev1.GetLeagueRequest{
+		Id: init.Progress.LeagueID.String(),
+	}))
+	if err == nil {
+		_, err = s.leagueService.DeleteLeague(ctx, connect.NewRequest(&leaguev1.DeleteLeagueRequest{
+			Id: init.Progress.LeagueID.String(),
+		}))
+	}
+	if err != nil && connect.CodeOf(err) != connect.CodeNotFound {
+		return err
+	}
+
+	init.Progress.LeagueID = nil
+	return s.repo.SaveInitialization(ctx, init)
+}
+
+// createTeams creates a team for every member that doesn't have one in the league yet
+func (s *Saga) createTeams(ctx context.Context, init *Initialization) error {
+	leagueID := init.Progress.LeagueID.String()
+	if init.Progress.TeamIDs == nil {
+		init.Progress.TeamIDs = make(map[uuid.UUID]uuid.UUID)
+	}
+
+	for _, member := range init.Request.Members {
+		ownerID, err := uuidutil.MustParseOrInvalidArg("owner_id", member.OwnerId)
+		if err != nil {
+			return err
+		}
+		if _, ok := init.Progress.TeamIDs[ownerID]; ok {
+			continue
+		}
+
+		var team *fantasyteamv1.FantasyTeam
+		existing, err := s.teamService.GetFantasyTeamByLeagueAndOwner(ctx, connect.NewRequest(&fantasyteamv1.GetFantasyTeamByLeagueAndOwnerRequest{
+			OwnerId:  member.OwnerId,
+			LeagueId: leagueID,
+		}))
+		switch {
+		case err == nil:
+			team = existing.Msg.FantasyTeam
+		case connect.CodeOf(err) == connect.CodeNotFound:
+			created, err := s.teamService.CreateFantasyTeam(ctx, connect.NewRequest(&fantasyteamv1.CreateFantasyTeamRequest{
+				LeagueId: leagueID,
+				OwnerId:  member.OwnerId,
+				Name:     member.TeamName,
+				LogoUrl:  member.LogoUrl,
+			}))
+			if err != nil {
+				return fmt.Errorf("team %q: %w", member.TeamName, err)
+			}
+			team = created.Msg.FantasyTeam
+		default:
+			return fmt.Errorf("team %q: %w", member.TeamName, err)
+		}
+
+		teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team.id", team.Id)
+		if err != nil {
+			return err
+		}
+		init.Progress.TeamIDs[ownerID] = teamID
+		if err := s.repo.SaveInitialization(ctx, init); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Saga) deleteTeams(ctx context.Context, init *Initialization) error {
+	for ownerID, teamID := range init.Progress.TeamIDs {
+		_, err := s.teamService.GetFantasyTeam(ctx, connect.NewRequest(&fantasyteamv1.GetFantasyTeamRequest{
+			Id: teamID.String(),
+		}))
+		if err == nil {
+			_, err = s.teamService.DeleteFantasyTeam(ctx, connect.NewRequest(&fantasyteamv1.DeleteFantasyTeamRequest{
+				Id: teamID.String(),
+			}))
+		}
+		if err != nil && connect.CodeOf(err) != connect.CodeNotFound {
+			return err
+		}
+
+		delete(init.Progress.TeamIDs, ownerID)
+		if err := s.repo.SaveInitialization(ctx, init); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createDraft creates a snake draft in member order. The league is new, so a draft already
+// in it was created by the saga before being interrupted.
+func (s *Saga) createDraft(ctx context.Context, init *Initialization) error {
+	if init.Progress.DraftID != nil {
+		return nil
+	}
+
+	draftID, err := s.findCreatedDraft(ctx, init)
+	if err != nil {
+		return err
+	}
+	if draftID == nil {
+		settings, err := draftSettings(init)
+		if err != nil {
+			return err
+		}
+		resp, err := s.draftService.CreateDraft(ctx, connect.NewRequest(&draftv1.CreateDraftRequest{
+			LeagueId:    init.Progress.LeagueID.String(),
+			DraftType:   draftv1.DraftType_DRAFT_TYPE_SNAKE,
+			Settings:    settings,
+			ScheduledAt: init.Request.GetDraft().GetScheduledAt(),
+		}))
+		if err != nil {
+			return err
+		}
+		id, err := uuidutil.MustParseOrInvalidArg("draft.id", resp.Msg.Draft.Id)
+		if err != nil {
+			return err
+		}
+		draftID = &id
+	}
+
+	init.Progress.DraftID = draftID
+	return s.repo.SaveInitialization(ctx, init)
+}
+
+// findCreatedDraft looks for a draft in the initialization's league
+func (s *Saga) findCreatedDraft(ctx context.Context, init *Initialization) (*uuid.UUID, error) {
+	resp, err := s.draftService.ListDraftsForUser(ctx, connect.NewRequest(&draftv1.ListDraftsForUserRequest{
+		UserId: init.CommissionerID.String(),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commissioner's drafts: %w", err)
+	}
+
+	for _, draft := range resp.Msg.Drafts {
+		if draft.Draft.LeagueId == init.Progress.LeagueID.String() {
+			id, err := uuidutil.MustParseOrInvalidArg("draft.id", draft.Draft.Id)
+			if err != nil {
+				return nil, err
+			}
+			return &id, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *Saga) deleteDraft(ctx context.Context, init *Initialization) error {
+	if init.Progress.DraftID == nil {
+		return nil
+	}
+
+	_, err := s.draftService.GetDraft(ctx, connect.NewRequest(&draftv1.GetDraftRequest{
+		DraftId: init.Progress.DraftID.String(),
+	}))
+	if err == nil {
+		_, err = s.draftService.DeleteDraft(ctx, connect.NewRequest(&draftv1.DeleteDraftRequest{
+			DraftId: init.Progress.DraftID.String(),
+		}))
+	}
+	if err != nil && connect.CodeOf(err) != connect.CodeNotFound {
+		return err
+	}
+
+	init.Progress.DraftID = nil
+	return s.repo.SaveInitialization(ctx, init)
+}
+
+// prepopulatePicks generates the draft's picks unless the saga already did
+func (s *Saga) prepopulatePicks(ctx context.Context, init *Initialization) error {
+	if init.Progress.PicksCreated > 0 {
+		return nil
+	}
+	draftID := init.Progress.DraftID.String()
+
+	existing, err := s.pickService.GetDraftPicksByDraft(ctx, connect.NewRequest(&draftv1.GetDraftPicksByDraftRequest{
+		DraftId: draftID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get draft picks: %w", err)
+	}
+
+	picksCreated := int(existing.Msg.Total)
+	if picksCreated == 0 {
+		settings, err := draftSettings(init)
+		if err != nil {
+			return err
+		}
+		resp, err := s.pickService.PrepopulateDraftPicks(ctx, connect.NewRequest(&draftv1.PrepopulateDraftPicksRequest{
+			DraftId:   draftID,
+			DraftType: draftv1.DraftType_DRAFT_TYPE_SNAKE,
+			Settings:  settings,
+		}))
+		if err != nil {
+			return err
+		}
+		picksCreated = int(resp.Msg.PicksCreated)
+	}
+
+	init.Progress.PicksCreated = picksCreated
+	return s.repo.SaveInitialization(ctx, init)
+}
+
+func (s *Saga) deletePicks(ctx context.Context, init *Initialization) error {
+	if init.Progress.DraftID == nil {
+		return nil
+	}
+
+	_, err := s.pickService.DeleteDraftPicksByDraft(ctx, connect.NewRequest(&draftv1.DeleteDraftPicksByDraftRequest{
+		DraftId: init.Progress.DraftID.String(),
+	}))
+	if err != nil {
+		return err
+	}
+
+	init.Progress.PicksCreated = 0
+	return s.repo.SaveInitialization(ctx, init)
+}
+
+// draftSettings are the settings of the league's first draft: the defaults, overridden by the
+// request, with the members' teams in draft order
+func draftSettings(init *Initialization) (*draftv1.DraftSettings, error) {
+	teamIDs, err := memberTeamIDs(init)
+	if err != nil {
+		return nil, err
+	}
+	settings := &draftv1.DraftSettings{
+		Rounds:         DefaultDraftRounds,
+		TimePerPickSec: DefaultDraftTimePerPickSec,
+		DraftOrder:     make([]string, 0, len(init.Progress.TeamIDs)),
+	}
+	if rounds := init.Request.GetDraft().GetRounds(); rounds > 0 {
+		settings.Rounds = rounds
+	}
+	if timePerPick := init.Request.GetDraft().GetTimePerPickSec(); timePerPick > 0 {
+		settings.TimePerPickSec = timePerPick
+	}
+	for _, teamID := range teamIDs {
+		settings.DraftOrder = append(settings.DraftOrder, teamID.String())
+	}
+	return settings, nil
+}
+
+// memberTeamIDs returns the members' teams in the order the members were given
+func memberTeamIDs(init *Initialization) ([]uuid.UUID, error) {
+	teamIDs := make([]uuid.UUID, 0, len(init.Request.Members))
+	for _, member := range init.Request.Members {
+		ownerID, err := uuidutil.MustParseOrInvalidArg("owner_id", member.OwnerId)
+		if err != nil {
+			return nil, err
+		}
+		if teamID, ok := init.Progress.TeamIDs[ownerID]; ok {
+			teamIDs = append(teamIDs, teamID)
+		}
+	}
+	return teamIDs, nil
+}
@@ -0,0 +1,88 @@
+package leagueinit
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+)
+
+// LeagueInitializer defines what the service layer needs from the initialization saga
+type LeagueInitializer interface {
+	Initialize(ctx context.Context, id, commissionerID uuid.UUID, req *leaguev1.InitializeLeagueRequest) (*Result, error)
+}
+
+// Service implements the LeagueInitService gRPC interface
+type Service struct {
+	saga LeagueInitializer
+}
+
+// NewService creates a new league initialization gRPC service
+func NewService(saga LeagueInitializer) *Service {
+	return &Service{
+		saga: saga,
+	}
+}
+
+// Verify that Service implements the LeagueInitServiceHandler interface
+var _ leaguev1connect.LeagueInitServiceHandler = (*Service)(nil)
+
+// InitializeLeague creates a league with its members' teams and its first draft, undoing
+// everything if a step fails. Retrying with the same initialization ID resumes an
+// interrupted initialization or returns the result of a completed one.
+func (s *Service) InitializeLeague(ctx context.Context, req *connect.Request[leaguev1.InitializeLeagueRequest]) (*connect.Response[leaguev1.InitializeLeagueResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("initialization_id", req.Msg.InitializationId)
+	if err != nil {
+		return nil, err
+	}
+	commissionerID, err := uuidutil.MustParseOrInvalidArg("commissioner_id", req.Msg.CommissionerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok && actingUser != commissionerID {
+		return nil, connect.NewError(connect.CodePermissionDenied, ErrNotCommissioner)
+	}
+
+	result, err := s.saga.Initialize(ctx, id, commissionerID, req.Msg)
+	if err != nil {
+		return nil, initializationErrorCode(err)
+	}
+
+	teamIDs := make([]string, len(result.TeamIDs))
+	for i, teamID := range result.TeamIDs {
+		teamIDs[i] = teamID.String()
+	}
+
+	return connect.NewResponse(&leaguev1.InitializeLeagueResponse{
+		League:         result.League,
+		FantasyTeamIds: teamIDs,
+		DraftId:        result.DraftID.String(),
+		PicksCreated:   int32(result.PicksCreated),
+	}), nil
+}
+
+// initializationErrorCode maps a saga error to a connect error. A step that failed in another
+// service keeps that service's code.
+func initializationErrorCode(err error) error {
+	switch {
+	case errors.Is(err, ErrNotCommissioner):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, ErrInitializationInProgress):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, ErrInitializationFailed):
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) && connectErr.Code() != connect.CodeInternal && connectErr.Code() != connect.CodeUnknown {
+			return connect.NewError(connectErr.Code(), err)
+		}
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeOf(err), err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
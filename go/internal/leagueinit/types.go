@@ -0,0 +1,64 @@
+package leagueinit
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
+)
+
+// ErrInitializationInProgress is returned when an initialization is resumed while another
+// request is still running it
+var ErrInitializationInProgress = errors.New("league initialization is already in progress")
+
+// ErrInitializationFailed is returned when resuming an initialization that failed and was undone
+var ErrInitializationFailed = errors.New("league initialization failed")
+
+// ErrNotCommissioner is returned when someone other than an initialization's commissioner runs it
+var ErrNotCommissioner = errors.New("only the league commissioner can do this")
+
+// Defaults of the league's first draft
+const (
+	DefaultDraftRounds         = 15
+	DefaultDraftTimePerPickSec = 90
+)
+
+// Status is where a league initialization saga stands
+type Status string
+
+const (
+	StatusRunning      Status = "RUNNING"
+	StatusCompleted    Status = "COMPLETED"
+	StatusCompensating Status = "COMPENSATING" // a step failed and the steps before it are being undone
+	StatusFailed       Status = "FAILED"       // a step failed and everything created before it was undone
+)
+
+// Progress records what the saga's completed steps created, so a resumed saga skips them and a
+// failed one knows what to undo
+type Progress struct {
+	LeagueID     *uuid.UUID              `json:"league_id,omitempty"`
+	TeamIDs      map[uuid.UUID]uuid.UUID `json:"team_ids,omitempty"` // members' teams by owner ID
+	DraftID      *uuid.UUID              `json:"draft_id,omitempty"`
+	PicksCreated int                     `json:"picks_created,omitempty"`
+}
+
+// Initialization is a league initialization saga
+type Initialization struct {
+	ID             uuid.UUID
+	CommissionerID uuid.UUID
+	Request        *leaguev1.InitializeLeagueRequest // as first made; retries resume it as is
+	Status         Status
+	Progress       Progress
+	Error          *string // why the saga failed
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Result is what a completed initialization created
+type Result struct {
+	League       *leaguev1.League
+	TeamIDs      []uuid.UUID // in draft order
+	DraftID      uuid.UUID
+	PicksCreated int
+}
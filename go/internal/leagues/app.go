@@ -2,10 +2,18 @@ package leagues
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/flags"
 	"github.com/mcdev12/dynasty/go/internal/models"
 )
 
@@ -14,21 +22,42 @@ type LeaguesRepository interface {
 	CreateLeague(ctx context.Context, req CreateLeagueRequest) (*models.League, error)
 	GetLeague(ctx context.Context, id uuid.UUID) (*models.League, error)
 	GetLeaguesByCommissioner(ctx context.Context, commissionerID uuid.UUID) ([]models.League, error)
-	UpdateLeague(ctx context.Context, id uuid.UUID, req UpdateLeagueRequest) (*models.League, error)
+	ListLeagues(ctx context.Context, query ListLeaguesQuery) ([]models.League, error)
+	UpdateLeague(ctx context.Context, id uuid.UUID, req UpdateLeagueRequest, check SettingsChangeCheck) (*models.League, error)
 	UpdateLeagueStatus(ctx context.Context, id uuid.UUID, status models.LeagueStatus) (*models.League, error)
-	UpdateLeagueSettings(ctx context.Context, id uuid.UUID, settings interface{}) (*models.League, error)
+	UpdateLeagueSettings(ctx context.Context, id uuid.UUID, req UpdateLeagueSettingsRequest, check SettingsChangeCheck) (*models.League, error)
+	GetSettingsHistory(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueSettingsChange, error)
+	GetSettingsEffectiveAt(ctx context.Context, leagueID uuid.UUID, at time.Time) (*models.LeagueSettingsChange, error)
 	DeleteLeague(ctx context.Context, id uuid.UUID) error
+	RestoreLeague(ctx context.Context, id uuid.UUID) (*models.League, error)
+	RestoreArchivedLeague(ctx context.Context, id uuid.UUID) (*models.League, error)
+	ArchiveInactiveLeagues(ctx context.Context, now time.Time, inactiveFor, grace time.Duration, batchSize int32) (*ArchivalResult, error)
+	ListSeasons(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueSeason, error)
+	StartSeason(ctx context.Context, leagueID uuid.UUID, year string) (*models.LeagueSeason, error)
+	CompleteSeason(ctx context.Context, leagueID uuid.UUID) (*models.LeagueSeason, error)
+	CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest, keyPrefix string, keyHash []byte) (*models.LeagueAPIKey, error)
+	ListAPIKeys(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueAPIKey, error)
+	RevokeAPIKey(ctx context.Context, leagueID, keyID uuid.UUID) (*models.LeagueAPIKey, error)
+	GetActiveAPIKeyByHash(ctx context.Context, keyHash []byte) (*models.LeagueAPIKey, error)
+	TouchAPIKey(ctx context.Context, keyID uuid.UUID) error
 }
 
+// FlagValidateLineupFitsRosterLimits turns on checking that a league's position limits leave
+// room for its starting lineup, for the leagues it is on for
+const FlagValidateLineupFitsRosterLimits = "leagues.validate_lineup_fits_roster_limits"
+
 // App handles leagues business logic
 type App struct {
 	repo LeaguesRepository
+	// flags roll new settings validations out per league; nil leaves them off
+	flags *flags.Client
 }
 
-// NewApp creates a new leagues App
-func NewApp(repo LeaguesRepository) *App {
+// NewApp creates a new leagues App. featureFlags may be nil.
+func NewApp(repo LeaguesRepository, featureFlags *flags.Client) *App {
 	return &App{
-		repo: repo,
+		repo:  repo,
+		flags: featureFlags,
 	}
 }
 
@@ -37,6 +66,10 @@ func (a *App) CreateLeague(ctx context.Context, req CreateLeagueRequest) (*model
 	if err := a.validateCreateLeagueRequest(req); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
+	// The league has no ID yet, so flagged validations apply once rolled out to every league
+	if err := a.validateFlaggedSettings(uuid.Nil, req.SportID, req.LeagueSettings); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
 
 	league, err := a.repo.CreateLeague(ctx, req)
 	if err != nil {
@@ -65,19 +98,68 @@ func (a *App) GetLeaguesByCommissioner(ctx context.Context, commissionerID uuid.
 	return leagues, nil
 }
 
+// ListLeagues retrieves a page of the leagues matching a request, newest first
+func (a *App) ListLeagues(ctx context.Context, req ListLeaguesRequest) (*LeaguePage, error) {
+	if err := a.validateListLeaguesRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	after, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	// Fetch one extra league to tell whether another page follows
+	leagues, err := a.repo.ListLeagues(ctx, ListLeaguesQuery{
+		MemberUserID:    req.MemberUserID,
+		SportID:         req.SportID,
+		Status:          req.Status,
+		Season:          req.Season,
+		Search:          strings.TrimSpace(req.Search),
+		IncludeArchived: req.IncludeArchived,
+		After:           after,
+		Limit:           int32(pageSize + 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leagues: %w", err)
+	}
+
+	page := &LeaguePage{Leagues: leagues}
+	if len(leagues) > pageSize {
+		page.Leagues = leagues[:pageSize]
+		last := page.Leagues[pageSize-1]
+		page.NextPageToken, err = encodePageToken(PageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
 // UpdateLeague updates an existing league with validation
 func (a *App) UpdateLeague(ctx context.Context, id uuid.UUID, req UpdateLeagueRequest) (*models.League, error) {
 	if err := a.validateUpdateLeagueRequest(req); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
+	if err := a.validateFlaggedSettings(id, req.SportID, req.LeagueSettings); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
 
 	// Verify league exists
-	_, err := a.repo.GetLeague(ctx, id)
+	existing, err := a.repo.GetLeague(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("league not found: %w", err)
 	}
+	if existing.ArchivedAt != nil {
+		return nil, ErrLeagueArchived
+	}
 
-	league, err := a.repo.UpdateLeague(ctx, id, req)
+	league, err := a.repo.UpdateLeague(ctx, id, req, a.checkSettingsChangeOrder)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update league: %w", err)
 	}
@@ -93,10 +175,13 @@ func (a *App) UpdateLeagueStatus(ctx context.Context, id uuid.UUID, status model
 	}
 
 	// Verify league exists
-	_, err := a.repo.GetLeague(ctx, id)
+	existing, err := a.repo.GetLeague(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("league not found: %w", err)
 	}
+	if existing.ArchivedAt != nil {
+		return nil, ErrLeagueArchived
+	}
 
 	league, err := a.repo.UpdateLeagueStatus(ctx, id, status)
 	if err != nil {
@@ -107,28 +192,74 @@ func (a *App) UpdateLeagueStatus(ctx context.Context, id uuid.UUID, status model
 	return league, nil
 }
 
-// UpdateLeagueSettings updates only the settings of a league
-func (a *App) UpdateLeagueSettings(ctx context.Context, id uuid.UUID, settings interface{}) (*models.League, error) {
-	if settings == nil {
+// UpdateLeagueSettings updates only the settings of a league, recording the change with
+// the date it takes effect
+func (a *App) UpdateLeagueSettings(ctx context.Context, id uuid.UUID, req UpdateLeagueSettingsRequest) (*models.League, error) {
+	if req.LeagueSettings == nil {
 		return nil, fmt.Errorf("league settings cannot be nil")
 	}
+	if req.EffectiveAt != nil && req.EffectiveAt.Before(time.Now()) {
+		return nil, fmt.Errorf("validation failed: effective_at cannot be in the past")
+	}
 
 	// Verify league exists
-	_, err := a.repo.GetLeague(ctx, id)
+	existing, err := a.repo.GetLeague(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("league not found: %w", err)
 	}
+	if existing.ArchivedAt != nil {
+		return nil, ErrLeagueArchived
+	}
+	if err := a.validateLeagueSettings(existing.SportID, req.LeagueSettings); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := a.validateFlaggedSettings(id, existing.SportID, req.LeagueSettings); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
 
-	league, err := a.repo.UpdateLeagueSettings(ctx, id, settings)
+	league, err := a.repo.UpdateLeagueSettings(ctx, id, req, a.checkSettingsChangeOrder)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update league settings: %w", err)
 	}
 
-	log.Printf("Updated league settings: %s", league.Name)
+	if req.EffectiveAt != nil {
+		log.Printf("Scheduled league settings change: %s, effective %s", league.Name, req.EffectiveAt.Format(time.RFC3339))
+	} else {
+		log.Printf("Updated league settings: %s", league.Name)
+	}
 	return league, nil
 }
 
-// DeleteLeague deletes a league by ID
+// GetSettingsHistory retrieves every change to a league's settings, newest first
+func (a *App) GetSettingsHistory(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueSettingsChange, error) {
+	history, err := a.repo.GetSettingsHistory(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings history: %w", err)
+	}
+	return history, nil
+}
+
+// GetSettingsEffectiveAt returns a league's settings as they were in force at a point in
+// time, e.g. the start of a scored week. A time before the league's first recorded version
+// falls back to its current settings.
+func (a *App) GetSettingsEffectiveAt(ctx context.Context, leagueID uuid.UUID, at time.Time) (interface{}, error) {
+	change, err := a.repo.GetSettingsEffectiveAt(ctx, leagueID, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get effective settings: %w", err)
+	}
+	if change != nil {
+		return change.Settings, nil
+	}
+
+	league, err := a.repo.GetLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("league not found: %w", err)
+	}
+	return league.LeagueSettings, nil
+}
+
+// DeleteLeague soft deletes a league. It drops out of lookups and its members lose access, but
+// its drafts and transactions keep pointing at it.
 func (a *App) DeleteLeague(ctx context.Context, id uuid.UUID) error {
 	// Verify league exists
 	league, err := a.repo.GetLeague(ctx, id)
@@ -144,6 +275,227 @@ func (a *App) DeleteLeague(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// RestoreLeague undoes a league's deletion. Restoring a league that isn't deleted changes nothing.
+func (a *App) RestoreLeague(ctx context.Context, id uuid.UUID) (*models.League, error) {
+	league, err := a.repo.RestoreLeague(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrCommissionerDeleted) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("league not found: %w", err)
+	}
+
+	log.Printf("Restored league: %s (%s)", league.Name, league.LeagueType)
+	return league, nil
+}
+
+// RestoreArchivedLeague lifts the archival of a league archived for inactivity, making it
+// writable and listed again
+func (a *App) RestoreArchivedLeague(ctx context.Context, id uuid.UUID) (*models.League, error) {
+	league, err := a.repo.RestoreArchivedLeague(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrLeagueNotArchived) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to restore archived league: %w", err)
+	}
+
+	log.Printf("Restored archived league: %s (%s)", league.Name, league.LeagueType)
+	return league, nil
+}
+
+// ArchiveInactiveLeagues warns the commissioners of leagues inactive for inactiveFor and
+// archives the leagues still inactive once grace has passed since the warning
+func (a *App) ArchiveInactiveLeagues(ctx context.Context, now time.Time, inactiveFor, grace time.Duration, batchSize int32) (*ArchivalResult, error) {
+	result, err := a.repo.ArchiveInactiveLeagues(ctx, now, inactiveFor, grace, batchSize)
+	if err != nil {
+		return result, fmt.Errorf("failed to archive inactive leagues: %w", err)
+	}
+
+	for _, id := range result.Archived {
+		log.Printf("Archived inactive league %s", id)
+	}
+	return result, nil
+}
+
+// maxSeasonYearLength is the longest year leagues.season holds
+const maxSeasonYearLength = 10
+
+// ListSeasons retrieves a league's seasons, latest first
+func (a *App) ListSeasons(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueSeason, error) {
+	seasons, err := a.repo.ListSeasons(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seasons: %w", err)
+	}
+	return seasons, nil
+}
+
+// StartSeason starts a league's season for year, which becomes the league's season. The
+// previous season has to be completed first.
+func (a *App) StartSeason(ctx context.Context, leagueID uuid.UUID, year string) (*models.LeagueSeason, error) {
+	year = strings.TrimSpace(year)
+	if year == "" {
+		return nil, fmt.Errorf("validation failed: year is required")
+	}
+	if len(year) > maxSeasonYearLength {
+		return nil, fmt.Errorf("validation failed: year cannot be longer than %d characters", maxSeasonYearLength)
+	}
+
+	existing, err := a.repo.GetLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("league not found: %w", err)
+	}
+	if existing.ArchivedAt != nil {
+		return nil, ErrLeagueArchived
+	}
+
+	season, err := a.repo.StartSeason(ctx, leagueID, year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start season: %w", err)
+	}
+
+	log.Printf("Started season %s of league %s", season.Year, existing.Name)
+	return season, nil
+}
+
+// CompleteSeason completes a league's active season, once none of its drafts are under way
+func (a *App) CompleteSeason(ctx context.Context, leagueID uuid.UUID) (*models.LeagueSeason, error) {
+	existing, err := a.repo.GetLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("league not found: %w", err)
+	}
+	if existing.ArchivedAt != nil {
+		return nil, ErrLeagueArchived
+	}
+
+	season, err := a.repo.CompleteSeason(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete season: %w", err)
+	}
+
+	log.Printf("Completed season %s of league %s", season.Year, existing.Name)
+	return season, nil
+}
+
+// apiKeyPrefix starts every league API key so leaked keys are easy to recognize
+const apiKeyPrefix = "dyn_"
+
+// apiKeyDisplayLength is how much of a key is kept to tell keys apart
+const apiKeyDisplayLength = len(apiKeyPrefix) + 8
+
+// CreateAPIKey issues an API key for a league. The key is returned once; only its hash is stored.
+func (a *App) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*IssuedAPIKey, error) {
+	if err := a.validateCreateAPIKeyRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate api key: %w", err)
+	}
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	hash := sha256.Sum256([]byte(key))
+
+	apiKey, err := a.repo.CreateAPIKey(ctx, req, key[:apiKeyDisplayLength], hash[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	log.Printf("Issued api key %s (%s) for league %s with scopes %v", apiKey.ID, apiKey.Name, req.LeagueID, req.Scopes)
+	return &IssuedAPIKey{APIKey: *apiKey, Key: key}, nil
+}
+
+// ListAPIKeys retrieves a league's API keys, revoked ones included, newest first
+func (a *App) ListAPIKeys(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueAPIKey, error) {
+	keys, err := a.repo.ListAPIKeys(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey revokes a league API key; requests made with it are rejected from then on
+func (a *App) RevokeAPIKey(ctx context.Context, leagueID, keyID uuid.UUID) (*models.LeagueAPIKey, error) {
+	apiKey, err := a.repo.RevokeAPIKey(ctx, leagueID, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	log.Printf("Revoked api key %s (%s) for league %s", apiKey.ID, apiKey.Name, leagueID)
+	return apiKey, nil
+}
+
+// AuthenticateAPIKey returns the active API key matching key, or ErrInvalidAPIKey
+func (a *App) AuthenticateAPIKey(ctx context.Context, key string) (*models.LeagueAPIKey, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	hash := sha256.Sum256([]byte(key))
+	apiKey, err := a.repo.GetActiveAPIKeyByHash(ctx, hash[:])
+	if err != nil {
+		if errors.Is(err, ErrInvalidAPIKey) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to authenticate api key: %w", err)
+	}
+
+	// Last use is informational, so a failed update doesn't fail the request
+	if err := a.repo.TouchAPIKey(ctx, apiKey.ID); err != nil {
+		log.Printf("Failed to record use of api key %s: %v", apiKey.ID, err)
+	}
+	return apiKey, nil
+}
+
+// validateCreateAPIKeyRequest validates create api key request
+func (a *App) validateCreateAPIKeyRequest(req CreateAPIKeyRequest) error {
+	if req.LeagueID == uuid.Nil {
+		return fmt.Errorf("league_id is required")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(req.Scopes) == 0 {
+		return fmt.Errorf("at least one scope is required")
+	}
+	seen := make(map[models.APIKeyScope]bool, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		if !scope.IsValid() {
+			return fmt.Errorf("invalid scope: %s", scope)
+		}
+		if seen[scope] {
+			return fmt.Errorf("scope %s appears more than once", scope)
+		}
+		seen[scope] = true
+	}
+	return nil
+}
+
+// validateListLeaguesRequest validates list leagues request
+func (a *App) validateListLeaguesRequest(req ListLeaguesRequest) error {
+	if req.MemberUserID != nil && *req.MemberUserID == uuid.Nil {
+		return fmt.Errorf("member_user_id cannot be empty")
+	}
+	if req.SportID != nil && strings.TrimSpace(*req.SportID) == "" {
+		return fmt.Errorf("sport_id cannot be empty")
+	}
+	if req.Season != nil && strings.TrimSpace(*req.Season) == "" {
+		return fmt.Errorf("season cannot be empty")
+	}
+	if req.Status != nil {
+		if err := a.validateLeagueStatus(*req.Status); err != nil {
+			return err
+		}
+	}
+	if utf8.RuneCountInString(req.Search) > maxSearchLength {
+		return fmt.Errorf("search cannot be longer than %d characters", maxSearchLength)
+	}
+	if req.PageSize < 0 || req.PageSize > maxPageSize {
+		return fmt.Errorf("page_size must be between 0 and %d", maxPageSize)
+	}
+	return nil
+}
+
 // validateCreateLeagueRequest validates create league request
 func (a *App) validateCreateLeagueRequest(req CreateLeagueRequest) error {
 	if req.Name == "" {
@@ -164,6 +516,9 @@ func (a *App) validateCreateLeagueRequest(req CreateLeagueRequest) error {
 	if req.LeagueSettings == nil {
 		return fmt.Errorf("league_settings is required")
 	}
+	if err := a.validateLeagueSettings(req.SportID, req.LeagueSettings); err != nil {
+		return err
+	}
 	if req.Status == "" {
 		return fmt.Errorf("status is required")
 	}
@@ -196,6 +551,9 @@ func (a *App) validateUpdateLeagueRequest(req UpdateLeagueRequest) error {
 	if req.LeagueSettings == nil {
 		return fmt.Errorf("league_settings cannot be nil")
 	}
+	if err := a.validateLeagueSettings(req.SportID, req.LeagueSettings); err != nil {
+		return err
+	}
 	if req.Status == "" {
 		return fmt.Errorf("status cannot be empty")
 	}
@@ -208,6 +566,71 @@ func (a *App) validateUpdateLeagueRequest(req UpdateLeagueRequest) error {
 	return nil
 }
 
+// validateLeagueSettings validates the well-known keys of the settings of a league in sportID
+func (a *App) validateLeagueSettings(sportID string, settings interface{}) error {
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if value, exists := m[models.LeagueSettingBestBall]; exists {
+		if _, isBool := value.(bool); !isBool {
+			return fmt.Errorf("%s must be a boolean", models.LeagueSettingBestBall)
+		}
+	}
+	if value, exists := m[models.LeagueSettingPublic]; exists {
+		if _, isBool := value.(bool); !isBool {
+			return fmt.Errorf("%s must be a boolean", models.LeagueSettingPublic)
+		}
+	}
+	if value, exists := m[models.LeagueSettingLineupSlots]; exists {
+		if err := models.ValidateLineupSlotsSetting(sportID, value); err != nil {
+			return err
+		}
+	}
+	if err := models.ValidateLineupLockSettings(m); err != nil {
+		return err
+	}
+	if err := models.ValidateTaxiSquadSettings(m); err != nil {
+		return err
+	}
+	if err := models.ValidateRosterLimitsSettings(sportID, m); err != nil {
+		return err
+	}
+	if err := models.ValidateSalaryCapSettings(m); err != nil {
+		return err
+	}
+	if err := models.ValidatePickTradeSettings(m); err != nil {
+		return err
+	}
+	if err := models.ValidateLeagueClockSettings(m); err != nil {
+		return err
+	}
+	if err := models.ValidateScheduleSettings(m); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateFlaggedSettings runs the settings validations still being rolled out, for the leagues
+// their feature flags are on for
+func (a *App) validateFlaggedSettings(leagueID uuid.UUID, sportID string, settings interface{}) error {
+	if a.flags.Enabled(FlagValidateLineupFitsRosterLimits, leagueID) {
+		if err := models.ValidateLineupFitsRosterLimits(sportID, settings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSettingsChangeOrder keeps the settings change log in order: versions are recorded
+// and take effect in the same order, so a change can't take effect before one already scheduled
+func (a *App) checkSettingsChangeOrder(latest *models.LeagueSettingsChange, effectiveAt time.Time) error {
+	if latest != nil && effectiveAt.Before(latest.EffectiveAt) {
+		return fmt.Errorf("%w: the change effective %s is pending", ErrSettingsChangeOutOfOrder, latest.EffectiveAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
 // validateLeagueType validates league type
 func (a *App) validateLeagueType(leagueType models.LeagueType) error {
 	switch leagueType {
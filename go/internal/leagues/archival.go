@@ -0,0 +1,54 @@
+package leagues
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+)
+
+// ArchivalJob is the job kind of the nightly run of the league archival policy
+const ArchivalJob = "leagues.archive_inactive"
+
+// InactiveLeagueArchiver warns the commissioners of inactive leagues and archives the leagues
+// that stay inactive
+type InactiveLeagueArchiver interface {
+	ArchiveInactiveLeagues(ctx context.Context, now time.Time, inactiveFor, grace time.Duration, batchSize int32) (*ArchivalResult, error)
+}
+
+// ArchivalRunnerConfig holds configuration for the nightly league archival run
+type ArchivalRunnerConfig struct {
+	HourUTC     int           // Hour of the day, in UTC, the run starts at
+	InactiveFor time.Duration // How long a league goes without activity before its commissioner is warned
+	GracePeriod time.Duration // How long after the warning an inactive league is archived
+	BatchSize   int32         // Max commissioners warned per run
+}
+
+// DefaultArchivalRunnerConfig returns default league archival runner configuration
+func DefaultArchivalRunnerConfig() ArchivalRunnerConfig {
+	return ArchivalRunnerConfig{
+		HourUTC:     9,                    // after the nightly taxi squad check
+		InactiveFor: 365 * 24 * time.Hour, // a whole season, offseason included
+		GracePeriod: 30 * 24 * time.Hour,
+		BatchSize:   500,
+	}
+}
+
+// ScheduleLeagueArchival schedules the league archival policy once a night on the job worker.
+// Leagues inactive for a season get their commissioner warned, and are archived if they are
+// still inactive when the grace period ends. Notices are recorded once per league, so a
+// retried run warns nobody twice, and leagues left over by a full batch are warned the next night.
+func ScheduleLeagueArchival(worker *jobs.Worker, archiver InactiveLeagueArchiver, config ArchivalRunnerConfig) {
+	log.Printf("Scheduling league archival at %02d:00 UTC for leagues inactive for %s", config.HourUTC, config.InactiveFor)
+
+	worker.Schedule(ArchivalJob, jobs.DailyAt(config.HourUTC, 0, time.UTC), func(ctx context.Context, _ jobs.Job) error {
+		result, err := archiver.ArchiveInactiveLeagues(ctx, time.Now(), config.InactiveFor, config.GracePeriod, config.BatchSize)
+		if err != nil {
+			return err
+		}
+		log.Printf("League archival: warned %d commissioners, archived %d leagues, %d leagues active again",
+			result.Warned, len(result.Archived), result.Resumed)
+		return nil
+	})
+}
@@ -7,11 +7,23 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 
 	"github.com/google/uuid"
 )
 
+const countLeagueDraftsInProgress = `-- name: CountLeagueDraftsInProgress :one
+SELECT COUNT(*) FROM draft WHERE league_id = $1 AND status IN ('IN_PROGRESS', 'PAUSED')
+`
+
+func (q *Queries) CountLeagueDraftsInProgress(ctx context.Context, leagueID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countLeagueDraftsInProgress, leagueID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createLeague = `-- name: CreateLeague :one
 INSERT INTO leagues (
     name,
@@ -29,7 +41,7 @@ INSERT INTO leagues (
     $5,
     $6,
     $7
-) RETURNING id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at
+) RETURNING id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at, deleted_at, archived_at
 `
 
 type CreateLeagueParams struct {
@@ -64,21 +76,30 @@ func (q *Queries) CreateLeague(ctx context.Context, arg CreateLeagueParams) (Lea
 		&i.Season,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
 
-const deleteLeague = `-- name: DeleteLeague :exec
-DELETE FROM leagues WHERE id = $1
+const deleteLeague = `-- name: DeleteLeague :execrows
+UPDATE leagues SET
+    deleted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
 `
 
-func (q *Queries) DeleteLeague(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteLeague, id)
-	return err
+// Marks the league deleted; its teams, drafts and transactions stay.
+func (q *Queries) DeleteLeague(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteLeague, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const getLeague = `-- name: GetLeague :one
-SELECT id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at FROM leagues WHERE id = $1
+SELECT id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at, deleted_at, archived_at FROM leagues WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetLeague(ctx context.Context, id uuid.UUID) (League, error) {
@@ -95,12 +116,14 @@ func (q *Queries) GetLeague(ctx context.Context, id uuid.UUID) (League, error) {
 		&i.Season,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
 
 const getLeaguesByCommissioner = `-- name: GetLeaguesByCommissioner :many
-SELECT id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at FROM leagues WHERE commissioner_id = $1 ORDER BY created_at DESC
+SELECT id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at, deleted_at, archived_at FROM leagues WHERE commissioner_id = $1 AND deleted_at IS NULL AND archived_at IS NULL ORDER BY created_at DESC
 `
 
 func (q *Queries) GetLeaguesByCommissioner(ctx context.Context, commissionerID uuid.UUID) ([]League, error) {
@@ -123,6 +146,8 @@ func (q *Queries) GetLeaguesByCommissioner(ctx context.Context, commissionerID u
 			&i.Season,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ArchivedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -137,6 +162,155 @@ func (q *Queries) GetLeaguesByCommissioner(ctx context.Context, commissionerID u
 	return items, nil
 }
 
+const isLeagueCommissionerDeleted = `-- name: IsLeagueCommissionerDeleted :one
+SELECT EXISTS (
+    SELECT 1 FROM leagues l JOIN users u ON u.id = l.commissioner_id
+    WHERE l.id = $1 AND u.deleted_at IS NOT NULL
+) AS commissioner_deleted
+`
+
+func (q *Queries) IsLeagueCommissionerDeleted(ctx context.Context, id uuid.UUID) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isLeagueCommissionerDeleted, id)
+	var commissioner_deleted bool
+	err := row.Scan(&commissioner_deleted)
+	return commissioner_deleted, err
+}
+
+const isLeagueMember = `-- name: IsLeagueMember :one
+SELECT EXISTS (
+    SELECT 1 FROM leagues l WHERE l.id = $1 AND l.deleted_at IS NULL
+) AND EXISTS (
+    SELECT 1 FROM leagues l WHERE l.id = $1 AND l.commissioner_id = $2
+    UNION ALL
+    SELECT 1 FROM fantasy_teams ft WHERE ft.league_id = $1 AND ft.owner_id = $2
+    UNION ALL
+    SELECT 1 FROM draft_co_managers cm JOIN draft d ON d.id = cm.draft_id WHERE d.league_id = $1 AND cm.user_id = $2
+) AS is_member
+`
+
+type IsLeagueMemberParams struct {
+	LeagueID uuid.UUID `json:"league_id"`
+	UserID   uuid.UUID `json:"user_id"`
+}
+
+// A user is a member of a league if they are its commissioner, own one of its fantasy teams
+// or co-manage one of them in one of its drafts. Deleted leagues have no members.
+func (q *Queries) IsLeagueMember(ctx context.Context, arg IsLeagueMemberParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isLeagueMember, arg.LeagueID, arg.UserID)
+	var is_member bool
+	err := row.Scan(&is_member)
+	return is_member, err
+}
+
+const listLeagues = `-- name: ListLeagues :many
+SELECT id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at, deleted_at, archived_at FROM leagues
+WHERE deleted_at IS NULL
+  AND ($1::uuid IS NULL
+       OR commissioner_id = $1::uuid
+       OR EXISTS (SELECT 1 FROM fantasy_teams ft WHERE ft.league_id = leagues.id AND ft.owner_id = $1::uuid)
+       OR EXISTS (SELECT 1 FROM draft_co_managers cm JOIN draft d ON d.id = cm.draft_id
+                  WHERE d.league_id = leagues.id AND cm.user_id = $1::uuid))
+  AND ($2::text IS NULL OR sport_id = $2::text)
+  AND ($3::league_status IS NULL OR status = $3::league_status)
+  AND ($4::text IS NULL OR season = $4::text)
+  AND ($5::text = '' OR strpos(lower(name), lower($5::text)) > 0)
+  AND ($6::bool OR archived_at IS NULL)
+  AND ($7::timestamptz IS NULL
+       OR (created_at, id) < ($7::timestamptz, $8::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $9
+`
+
+type ListLeaguesParams struct {
+	MemberUserID    uuid.NullUUID    `json:"member_user_id"`
+	SportID         sql.NullString   `json:"sport_id"`
+	Status          NullLeagueStatus `json:"status"`
+	Season          sql.NullString   `json:"season"`
+	Search          string           `json:"search"`
+	IncludeArchived bool             `json:"include_archived"`
+	CursorCreatedAt sql.NullTime     `json:"cursor_created_at"`
+	CursorID        uuid.NullUUID    `json:"cursor_id"`
+	PageSize        int32            `json:"page_size"`
+}
+
+// Newest first, continuing after the (created_at, id) cursor when one is given. A member filter
+// matches the same users as IsLeagueMember and search is a case-insensitive substring of the name.
+// Archived leagues are left out unless include_archived is set.
+func (q *Queries) ListLeagues(ctx context.Context, arg ListLeaguesParams) ([]League, error) {
+	rows, err := q.db.QueryContext(ctx, listLeagues,
+		arg.MemberUserID,
+		arg.SportID,
+		arg.Status,
+		arg.Season,
+		arg.Search,
+		arg.IncludeArchived,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []League
+	for rows.Next() {
+		var i League
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.SportID,
+			&i.LeagueType,
+			&i.CommissionerID,
+			&i.LeagueSettings,
+			&i.Status,
+			&i.Season,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ArchivedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restoreLeague = `-- name: RestoreLeague :one
+UPDATE leagues SET
+    deleted_at = NULL,
+    updated_at = CASE WHEN deleted_at IS NULL THEN updated_at ELSE NOW() END
+WHERE id = $1
+RETURNING id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at, deleted_at, archived_at
+`
+
+// Clears a league's deletion; restoring a league that isn't deleted changes nothing.
+func (q *Queries) RestoreLeague(ctx context.Context, id uuid.UUID) (League, error) {
+	row := q.db.QueryRowContext(ctx, restoreLeague, id)
+	var i League
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.SportID,
+		&i.LeagueType,
+		&i.CommissionerID,
+		&i.LeagueSettings,
+		&i.Status,
+		&i.Season,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
 const updateLeague = `-- name: UpdateLeague :one
 UPDATE leagues SET
     name = $2,
@@ -147,8 +321,8 @@ UPDATE leagues SET
     status = $7,
     season = $8,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at, deleted_at, archived_at
 `
 
 type UpdateLeagueParams struct {
@@ -185,6 +359,8 @@ func (q *Queries) UpdateLeague(ctx context.Context, arg UpdateLeagueParams) (Lea
 		&i.Season,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -193,8 +369,8 @@ const updateLeagueSettings = `-- name: UpdateLeagueSettings :one
 UPDATE leagues SET
     league_settings = $2,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at, deleted_at, archived_at
 `
 
 type UpdateLeagueSettingsParams struct {
@@ -216,6 +392,8 @@ func (q *Queries) UpdateLeagueSettings(ctx context.Context, arg UpdateLeagueSett
 		&i.Season,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -224,8 +402,8 @@ const updateLeagueStatus = `-- name: UpdateLeagueStatus :one
 UPDATE leagues SET
     status = $2,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, name, sport_id, league_type, commissioner_id, league_settings, status, season, created_at, updated_at, deleted_at, archived_at
 `
 
 type UpdateLeagueStatusParams struct {
@@ -247,6 +425,8 @@ func (q *Queries) UpdateLeagueStatus(ctx context.Context, arg UpdateLeagueStatus
 		&i.Season,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
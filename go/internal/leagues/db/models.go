@@ -112,6 +112,47 @@ type League struct {
 	Season         string          `json:"season"`
 	CreatedAt      time.Time       `json:"created_at"`
 	UpdatedAt      time.Time       `json:"updated_at"`
+	DeletedAt      sql.NullTime    `json:"deleted_at"`
+	ArchivedAt     sql.NullTime    `json:"archived_at"`
+}
+
+type LeagueApiKey struct {
+	ID         uuid.UUID     `json:"id"`
+	LeagueID   uuid.UUID     `json:"league_id"`
+	Name       string        `json:"name"`
+	KeyPrefix  string        `json:"key_prefix"`
+	KeyHash    []byte        `json:"key_hash"`
+	Scopes     []string      `json:"scopes"`
+	CreatedBy  uuid.NullUUID `json:"created_by"`
+	CreatedAt  time.Time     `json:"created_at"`
+	LastUsedAt sql.NullTime  `json:"last_used_at"`
+	RevokedAt  sql.NullTime  `json:"revoked_at"`
+}
+
+type LeagueArchiveNotice struct {
+	LeagueID       uuid.UUID `json:"league_id"`
+	LastActivityAt time.Time `json:"last_activity_at"`
+	NotifiedAt     time.Time `json:"notified_at"`
+	ArchiveAfter   time.Time `json:"archive_after"`
+}
+
+type LeagueSeason struct {
+	ID          uuid.UUID    `json:"id"`
+	LeagueID    uuid.UUID    `json:"league_id"`
+	Year        string       `json:"year"`
+	Status      string       `json:"status"`
+	StartedAt   time.Time    `json:"started_at"`
+	CompletedAt sql.NullTime `json:"completed_at"`
+}
+
+type LeagueSettingsChange struct {
+	ID          uuid.UUID       `json:"id"`
+	LeagueID    uuid.UUID       `json:"league_id"`
+	ActorUserID uuid.NullUUID   `json:"actor_user_id"`
+	Settings    json.RawMessage `json:"settings"`
+	Diff        json.RawMessage `json:"diff"`
+	EffectiveAt time.Time       `json:"effective_at"`
+	CreatedAt   time.Time       `json:"created_at"`
 }
 
 type NflPlayerProfile struct {
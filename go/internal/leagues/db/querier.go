@@ -6,15 +6,67 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	// Archives the warned leagues whose grace period is over. updated_at is left alone, so being
+	// archived doesn't count as activity.
+	ArchiveDueLeagues(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	// Drops the notices of leagues that have been active since their commissioner was warned.
+	ClearResumedLeagueArchiveNotices(ctx context.Context) (int64, error)
+	CountLeagueDraftsInProgress(ctx context.Context, leagueID uuid.UUID) (int64, error)
+	CompleteLeagueSeason(ctx context.Context, leagueID uuid.UUID) (LeagueSeason, error)
 	CreateLeague(ctx context.Context, arg CreateLeagueParams) (League, error)
-	DeleteLeague(ctx context.Context, id uuid.UUID) error
+	// Starts a season of a league. Returns no row when the league already has a season that year.
+	CreateLeagueSeason(ctx context.Context, arg CreateLeagueSeasonParams) (LeagueSeason, error)
+	// Marks the league deleted; its teams, drafts and transactions stay.
+	DeleteLeague(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteLeagueArchiveNotice(ctx context.Context, leagueID uuid.UUID) error
+	GetActiveLeagueAPIKeyByHash(ctx context.Context, keyHash []byte) (LeagueApiKey, error)
+	GetActiveLeagueSeason(ctx context.Context, leagueID uuid.UUID) (LeagueSeason, error)
+	GetLatestLeagueSettingsChange(ctx context.Context, leagueID uuid.UUID) (LeagueSettingsChange, error)
 	GetLeague(ctx context.Context, id uuid.UUID) (League, error)
+	GetLeagueSettingsChanges(ctx context.Context, leagueID uuid.UUID) ([]LeagueSettingsChange, error)
+	// The settings in force at a point in time: the latest version that had taken effect by then,
+	// with later-recorded versions winning ties.
+	GetLeagueSettingsEffectiveAt(ctx context.Context, arg GetLeagueSettingsEffectiveAtParams) (LeagueSettingsChange, error)
 	GetLeaguesByCommissioner(ctx context.Context, commissionerID uuid.UUID) ([]League, error)
+	HasLeagueSeason(ctx context.Context, arg HasLeagueSeasonParams) (bool, error)
+	InsertLeagueAPIKey(ctx context.Context, arg InsertLeagueAPIKeyParams) (LeagueApiKey, error)
+	InsertLeagueArchiveNotice(ctx context.Context, arg InsertLeagueArchiveNoticeParams) (int64, error)
+	InsertLeagueSettingsChange(ctx context.Context, arg InsertLeagueSettingsChangeParams) (LeagueSettingsChange, error)
+	// Queue a notification for the notification worker to deliver.
+	InsertUserOutbox(ctx context.Context, arg InsertUserOutboxParams) error
+	IsLeagueArchived(ctx context.Context, id uuid.UUID) (bool, error)
+	IsLeagueCommissionerDeleted(ctx context.Context, id uuid.UUID) (bool, error)
+	// A user is a member of a league if they are its commissioner, own one of its fantasy teams
+	// or co-manage one of them in one of its drafts. Deleted leagues have no members.
+	IsLeagueMember(ctx context.Context, arg IsLeagueMemberParams) (bool, error)
+	// Leagues quiet since before inactive_since whose commissioner hasn't been warned yet, quietest
+	// first. A league with a draft under way is never quiet.
+	ListInactiveLeagues(ctx context.Context, arg ListInactiveLeaguesParams) ([]ListInactiveLeaguesRow, error)
+	ListLeagueAPIKeys(ctx context.Context, leagueID uuid.UUID) ([]LeagueApiKey, error)
+	// Latest first.
+	ListLeagueSeasons(ctx context.Context, leagueID uuid.UUID) ([]LeagueSeason, error)
+	// Newest first, continuing after the (created_at, id) cursor when one is given. A member filter
+	// matches the same users as IsLeagueMember and search is a case-insensitive substring of the name.
+	// Archived leagues are left out unless include_archived is set.
+	ListLeagues(ctx context.Context, arg ListLeaguesParams) ([]League, error)
+	// Clears a league's deletion; restoring a league that isn't deleted changes nothing.
+	RestoreLeague(ctx context.Context, id uuid.UUID) (League, error)
+	RevokeAllLeagueAPIKeys(ctx context.Context, leagueID uuid.UUID) error
+	RevokeLeagueAPIKey(ctx context.Context, arg RevokeLeagueAPIKeyParams) (LeagueApiKey, error)
+	// Keeps a league's active season in step when leagues.season is corrected.
+	SetActiveLeagueSeasonYear(ctx context.Context, arg SetActiveLeagueSeasonYearParams) error
+	SetLeagueSeason(ctx context.Context, arg SetLeagueSeasonParams) (League, error)
+	// Records that a key was used, at most once a minute so busy keys don't write on every request.
+	TouchLeagueAPIKey(ctx context.Context, id uuid.UUID) error
+	// Clears a league's archival. Restoring counts as activity, so the league has a full season
+	// before it can be archived again.
+	UnarchiveLeague(ctx context.Context, id uuid.UUID) (League, error)
 	UpdateLeague(ctx context.Context, arg UpdateLeagueParams) (League, error)
 	UpdateLeagueSettings(ctx context.Context, arg UpdateLeagueSettingsParams) (League, error)
 	UpdateLeagueStatus(ctx context.Context, arg UpdateLeagueStatusParams) (League, error)
@@ -0,0 +1,61 @@
+-- name: ListInactiveLeagues :many
+-- Leagues quiet since before inactive_since whose commissioner hasn't been warned yet, quietest
+-- first. A league with a draft under way is never quiet.
+SELECT l.id, l.name, l.commissioner_id, u.username, u.email, a.last_activity_at
+FROM leagues l
+         JOIN league_activity a ON a.league_id = l.id
+         JOIN users u ON u.id = l.commissioner_id
+WHERE l.deleted_at IS NULL
+  AND l.archived_at IS NULL
+  AND a.last_activity_at < @inactive_since
+  AND NOT EXISTS (SELECT 1 FROM league_archive_notices n WHERE n.league_id = l.id)
+  AND NOT EXISTS (SELECT 1 FROM draft d WHERE d.league_id = l.id AND d.status IN ('IN_PROGRESS', 'PAUSED'))
+ORDER BY a.last_activity_at
+LIMIT @batch_size;
+
+-- name: InsertLeagueArchiveNotice :execrows
+INSERT INTO league_archive_notices (league_id, last_activity_at, archive_after)
+VALUES ($1, $2, $3)
+ON CONFLICT (league_id) DO NOTHING;
+
+-- name: ClearResumedLeagueArchiveNotices :execrows
+-- Drops the notices of leagues that have been active since their commissioner was warned.
+DELETE FROM league_archive_notices n
+USING league_activity a
+WHERE a.league_id = n.league_id
+  AND a.last_activity_at > n.last_activity_at;
+
+-- name: ArchiveDueLeagues :many
+-- Archives the warned leagues whose grace period is over. updated_at is left alone, so being
+-- archived doesn't count as activity.
+UPDATE leagues l SET
+    archived_at = @now
+FROM league_archive_notices n
+WHERE n.league_id = l.id
+  AND n.archive_after <= @now
+  AND l.archived_at IS NULL
+  AND l.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM draft d WHERE d.league_id = l.id AND d.status IN ('IN_PROGRESS', 'PAUSED'))
+RETURNING l.id;
+
+-- name: UnarchiveLeague :one
+-- Clears a league's archival. Restoring counts as activity, so the league has a full season
+-- before it can be archived again.
+UPDATE leagues SET
+    archived_at = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL AND archived_at IS NOT NULL
+RETURNING *;
+
+-- name: DeleteLeagueArchiveNotice :exec
+DELETE FROM league_archive_notices WHERE league_id = $1;
+
+-- name: IsLeagueArchived :one
+SELECT EXISTS (
+    SELECT 1 FROM leagues WHERE id = $1 AND archived_at IS NOT NULL
+) AS archived;
+
+-- name: InsertUserOutbox :exec
+-- Queue a notification for the notification worker to deliver.
+INSERT INTO user_outbox (id, user_id, event_type, payload)
+VALUES ($1, $2, $3, $4);
@@ -0,0 +1,30 @@
+-- name: InsertLeagueAPIKey :one
+INSERT INTO league_api_keys (league_id, name, key_prefix, key_hash, scopes, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListLeagueAPIKeys :many
+SELECT * FROM league_api_keys
+WHERE league_id = $1
+ORDER BY created_at DESC;
+
+-- name: RevokeLeagueAPIKey :one
+UPDATE league_api_keys
+SET revoked_at = NOW()
+WHERE id = $1 AND league_id = $2 AND revoked_at IS NULL
+RETURNING *;
+
+-- name: RevokeAllLeagueAPIKeys :exec
+UPDATE league_api_keys
+SET revoked_at = NOW()
+WHERE league_id = $1 AND revoked_at IS NULL;
+
+-- name: GetActiveLeagueAPIKeyByHash :one
+SELECT * FROM league_api_keys
+WHERE key_hash = $1 AND revoked_at IS NULL;
+
+-- name: TouchLeagueAPIKey :exec
+-- Records that a key was used, at most once a minute so busy keys don't write on every request.
+UPDATE league_api_keys
+SET last_used_at = NOW()
+WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute');
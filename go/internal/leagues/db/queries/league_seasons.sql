@@ -0,0 +1,36 @@
+-- name: CreateLeagueSeason :one
+-- Starts a season of a league. Returns no row when the league already has a season that year.
+INSERT INTO league_seasons (league_id, year)
+VALUES ($1, $2)
+ON CONFLICT (league_id, year) DO NOTHING
+RETURNING *;
+
+-- name: GetActiveLeagueSeason :one
+SELECT * FROM league_seasons WHERE league_id = $1 AND status = 'ACTIVE';
+
+-- name: HasLeagueSeason :one
+SELECT EXISTS (SELECT 1 FROM league_seasons WHERE league_id = $1 AND year = $2) AS has_season;
+
+-- name: ListLeagueSeasons :many
+-- Latest first.
+SELECT * FROM league_seasons
+WHERE league_id = $1
+ORDER BY started_at DESC, id DESC;
+
+-- name: CompleteLeagueSeason :one
+UPDATE league_seasons SET
+    status = 'COMPLETED',
+    completed_at = NOW()
+WHERE league_id = $1 AND status = 'ACTIVE'
+RETURNING *;
+
+-- name: SetActiveLeagueSeasonYear :exec
+-- Keeps a league's active season in step when leagues.season is corrected.
+UPDATE league_seasons SET year = @year WHERE league_id = @league_id AND status = 'ACTIVE';
+
+-- name: SetLeagueSeason :one
+UPDATE leagues SET
+    season = $2,
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
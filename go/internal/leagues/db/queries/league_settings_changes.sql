@@ -0,0 +1,23 @@
+-- name: InsertLeagueSettingsChange :one
+INSERT INTO league_settings_changes (league_id, actor_user_id, settings, diff, effective_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetLeagueSettingsChanges :many
+SELECT * FROM league_settings_changes
+WHERE league_id = $1
+ORDER BY created_at DESC;
+
+-- name: GetLatestLeagueSettingsChange :one
+SELECT * FROM league_settings_changes
+WHERE league_id = $1
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: GetLeagueSettingsEffectiveAt :one
+-- The settings in force at a point in time: the latest version that had taken effect by then,
+-- with later-recorded versions winning ties.
+SELECT * FROM league_settings_changes
+WHERE league_id = $1 AND effective_at <= $2
+ORDER BY effective_at DESC, created_at DESC
+LIMIT 1;
\ No newline at end of file
@@ -18,10 +18,31 @@ INSERT INTO leagues (
 ) RETURNING *;
 
 -- name: GetLeague :one
-SELECT * FROM leagues WHERE id = $1;
+SELECT * FROM leagues WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: GetLeaguesByCommissioner :many
-SELECT * FROM leagues WHERE commissioner_id = $1 ORDER BY created_at DESC;
+SELECT * FROM leagues WHERE commissioner_id = $1 AND deleted_at IS NULL AND archived_at IS NULL ORDER BY created_at DESC;
+
+-- name: ListLeagues :many
+-- Newest first, continuing after the (created_at, id) cursor when one is given. A member filter
+-- matches the same users as IsLeagueMember and search is a case-insensitive substring of the name.
+-- Archived leagues are left out unless include_archived is set.
+SELECT * FROM leagues
+WHERE deleted_at IS NULL
+  AND (sqlc.narg('member_user_id')::uuid IS NULL
+       OR commissioner_id = sqlc.narg('member_user_id')::uuid
+       OR EXISTS (SELECT 1 FROM fantasy_teams ft WHERE ft.league_id = leagues.id AND ft.owner_id = sqlc.narg('member_user_id')::uuid)
+       OR EXISTS (SELECT 1 FROM draft_co_managers cm JOIN draft d ON d.id = cm.draft_id
+                  WHERE d.league_id = leagues.id AND cm.user_id = sqlc.narg('member_user_id')::uuid))
+  AND (sqlc.narg('sport_id')::text IS NULL OR sport_id = sqlc.narg('sport_id')::text)
+  AND (sqlc.narg('status')::league_status IS NULL OR status = sqlc.narg('status')::league_status)
+  AND (sqlc.narg('season')::text IS NULL OR season = sqlc.narg('season')::text)
+  AND (@search::text = '' OR strpos(lower(name), lower(@search::text)) > 0)
+  AND (@include_archived::bool OR archived_at IS NULL)
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT @page_size;
 
 -- name: UpdateLeague :one
 UPDATE leagues SET
@@ -33,22 +54,56 @@ UPDATE leagues SET
     status = $7,
     season = $8,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
 -- name: UpdateLeagueStatus :one
 UPDATE leagues SET
     status = $2,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
 -- name: UpdateLeagueSettings :one
 UPDATE leagues SET
     league_settings = $2,
     updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteLeague :execrows
+-- Marks the league deleted; its teams, drafts and transactions stay.
+UPDATE leagues SET
+    deleted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: RestoreLeague :one
+-- Clears a league's deletion; restoring a league that isn't deleted changes nothing.
+UPDATE leagues SET
+    deleted_at = NULL,
+    updated_at = CASE WHEN deleted_at IS NULL THEN updated_at ELSE NOW() END
 WHERE id = $1
 RETURNING *;
 
--- name: DeleteLeague :exec
-DELETE FROM leagues WHERE id = $1;
\ No newline at end of file
+-- name: IsLeagueCommissionerDeleted :one
+SELECT EXISTS (
+    SELECT 1 FROM leagues l JOIN users u ON u.id = l.commissioner_id
+    WHERE l.id = $1 AND u.deleted_at IS NOT NULL
+) AS commissioner_deleted;
+
+-- name: CountLeagueDraftsInProgress :one
+SELECT COUNT(*) FROM draft WHERE league_id = $1 AND status IN ('IN_PROGRESS', 'PAUSED');
+
+-- name: IsLeagueMember :one
+-- A user is a member of a league if they are its commissioner, own one of its fantasy teams
+-- or co-manage one of them in one of its drafts. Deleted leagues have no members.
+SELECT EXISTS (
+    SELECT 1 FROM leagues l WHERE l.id = @league_id AND l.deleted_at IS NULL
+) AND EXISTS (
+    SELECT 1 FROM leagues l WHERE l.id = @league_id AND l.commissioner_id = @user_id
+    UNION ALL
+    SELECT 1 FROM fantasy_teams ft WHERE ft.league_id = @league_id AND ft.owner_id = @user_id
+    UNION ALL
+    SELECT 1 FROM draft_co_managers cm JOIN draft d ON d.id = cm.draft_id WHERE d.league_id = @league_id AND cm.user_id = @user_id
+) AS is_member;
\ No newline at end of file
@@ -2,20 +2,41 @@ package leagues
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/changes"
+	"github.com/mcdev12/dynasty/go/internal/ids"
 	"github.com/mcdev12/dynasty/go/internal/leagues/db"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	userevents "github.com/mcdev12/dynasty/go/internal/users/events"
 )
 
 // Querier defines what the repository needs from the database layer
 type Querier interface {
+	ArchiveDueLeagues(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	ClearResumedLeagueArchiveNotices(ctx context.Context) (int64, error)
 	CreateLeague(ctx context.Context, arg db.CreateLeagueParams) (db.League, error)
-	DeleteLeague(ctx context.Context, id uuid.UUID) error
+	GetActiveLeagueAPIKeyByHash(ctx context.Context, keyHash []byte) (db.LeagueApiKey, error)
 	GetLeague(ctx context.Context, id uuid.UUID) (db.League, error)
+	GetLeagueSettingsChanges(ctx context.Context, leagueID uuid.UUID) ([]db.LeagueSettingsChange, error)
+	GetLeagueSettingsEffectiveAt(ctx context.Context, arg db.GetLeagueSettingsEffectiveAtParams) (db.LeagueSettingsChange, error)
 	GetLeaguesByCommissioner(ctx context.Context, commissionerID uuid.UUID) ([]db.League, error)
+	InsertLeagueAPIKey(ctx context.Context, arg db.InsertLeagueAPIKeyParams) (db.LeagueApiKey, error)
+	IsLeagueArchived(ctx context.Context, id uuid.UUID) (bool, error)
+	IsLeagueMember(ctx context.Context, arg db.IsLeagueMemberParams) (bool, error)
+	ListInactiveLeagues(ctx context.Context, arg db.ListInactiveLeaguesParams) ([]db.ListInactiveLeaguesRow, error)
+	ListLeagueAPIKeys(ctx context.Context, leagueID uuid.UUID) ([]db.LeagueApiKey, error)
+	ListLeagueSeasons(ctx context.Context, leagueID uuid.UUID) ([]db.LeagueSeason, error)
+	ListLeagues(ctx context.Context, arg db.ListLeaguesParams) ([]db.League, error)
+	RevokeLeagueAPIKey(ctx context.Context, arg db.RevokeLeagueAPIKeyParams) (db.LeagueApiKey, error)
+	TouchLeagueAPIKey(ctx context.Context, id uuid.UUID) error
+	RestoreLeague(ctx context.Context, id uuid.UUID) (db.League, error)
 	UpdateLeague(ctx context.Context, arg db.UpdateLeagueParams) (db.League, error)
 	UpdateLeagueSettings(ctx context.Context, arg db.UpdateLeagueSettingsParams) (db.League, error)
 	UpdateLeagueStatus(ctx context.Context, arg db.UpdateLeagueStatusParams) (db.League, error)
@@ -24,15 +45,35 @@ type Querier interface {
 // Repository implements league data access operations
 type Repository struct {
 	queries Querier
+	sqlDB   *sql.DB
+	changes *changes.TxInserter
 }
 
-// NewRepository creates a new leagues repository
-func NewRepository(querier Querier) *Repository {
+// NewRepository creates a new leagues repository. sqlDB runs settings changes, which
+// are recorded in the settings change log, in a transaction. Changes to leagues are recorded
+// with changes, in the transaction that made them.
+func NewRepository(querier Querier, sqlDB *sql.DB, changes *changes.TxInserter) *Repository {
 	return &Repository{
 		queries: querier,
+		sqlDB:   sqlDB,
+		changes: changes,
 	}
 }
 
+// q returns the repository's queries, bound to the transaction in ctx if there is one
+func (r *Repository) q(ctx context.Context) Querier {
+	return sqlutil.Bind(ctx, r.queries, func(tx *sql.Tx) Querier { return txQueries(tx) })
+}
+
+// txQueries binds the sqlc queries to a transaction
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
+// SettingsChangeCheck validates a settings change against the latest version in the
+// change log, which is nil for a league without history
+type SettingsChangeCheck func(latest *models.LeagueSettingsChange, effectiveAt time.Time) error
+
 // CreateLeague creates a new league
 func (r *Repository) CreateLeague(ctx context.Context, req CreateLeagueRequest) (*models.League, error) {
 	// Marshal league settings to JSON
@@ -41,14 +82,45 @@ func (r *Repository) CreateLeague(ctx context.Context, req CreateLeagueRequest)
 		return nil, fmt.Errorf("failed to marshal league settings: %w", err)
 	}
 
-	league, err := r.queries.CreateLeague(ctx, db.CreateLeagueParams{
-		Name:           req.Name,
-		SportID:        req.SportID,
-		LeagueType:     db.LeagueType(req.LeagueType),
-		CommissionerID: req.CommissionerID,
-		LeagueSettings: settingsJSON,
-		Status:         db.LeagueStatus(req.Status),
-		Season:         req.Season,
+	var league db.League
+	err = r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			var err error
+			league, err = q.CreateLeague(ctx, db.CreateLeagueParams{
+				Name:           req.Name,
+				SportID:        req.SportID,
+				LeagueType:     db.LeagueType(req.LeagueType),
+				CommissionerID: req.CommissionerID,
+				LeagueSettings: settingsJSON,
+				Status:         db.LeagueStatus(req.Status),
+				Season:         req.Season,
+			})
+			if err != nil {
+				return err
+			}
+
+			// The league starts in its first season
+			if _, err := q.CreateLeagueSeason(ctx, db.CreateLeagueSeasonParams{
+				LeagueID: league.ID,
+				Year:     req.Season,
+			}); err != nil {
+				return fmt.Errorf("failed to create league season: %w", err)
+			}
+
+			// The initial settings start the change log
+			_, err = q.InsertLeagueSettingsChange(ctx, db.InsertLeagueSettingsChangeParams{
+				LeagueID:    league.ID,
+				ActorUserID: uuid.NullUUID{UUID: req.CommissionerID, Valid: true},
+				Settings:    league.LeagueSettings,
+				Diff:        json.RawMessage("{}"),
+				EffectiveAt: time.Now(),
+			})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.LeagueUpdated(league.ID, changes.ActionLeagueCreated))
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create league: %w", err)
@@ -77,23 +149,77 @@ func (r *Repository) GetLeaguesByCommissioner(ctx context.Context, commissionerI
 	return r.dbLeaguesToModels(leagues), nil
 }
 
-// UpdateLeague updates an existing league
-func (r *Repository) UpdateLeague(ctx context.Context, id uuid.UUID, req UpdateLeagueRequest) (*models.League, error) {
+// ListLeagues retrieves the leagues matching a query, newest first
+func (r *Repository) ListLeagues(ctx context.Context, query ListLeaguesQuery) ([]models.League, error) {
+	params := db.ListLeaguesParams{
+		MemberUserID:    sqlutil.ToNullUUID(query.MemberUserID),
+		SportID:         sqlutil.ToSqlString(query.SportID),
+		Season:          sqlutil.ToSqlString(query.Season),
+		Search:          query.Search,
+		IncludeArchived: query.IncludeArchived,
+		PageSize:        query.Limit,
+	}
+	if query.Status != nil {
+		params.Status = db.NullLeagueStatus{LeagueStatus: db.LeagueStatus(*query.Status), Valid: true}
+	}
+	if query.After != nil {
+		params.CursorCreatedAt = sql.NullTime{Time: query.After.CreatedAt, Valid: true}
+		params.CursorID = uuid.NullUUID{UUID: query.After.ID, Valid: true}
+	}
+
+	leagues, err := r.queries.ListLeagues(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leagues: %w", err)
+	}
+
+	return r.dbLeaguesToModels(leagues), nil
+}
+
+// UpdateLeague updates an existing league, recording a settings change that takes effect
+// immediately if its settings differ
+func (r *Repository) UpdateLeague(ctx context.Context, id uuid.UUID, req UpdateLeagueRequest, check SettingsChangeCheck) (*models.League, error) {
 	// Marshal league settings to JSON
 	settingsJSON, err := json.Marshal(req.LeagueSettings)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal league settings: %w", err)
 	}
 
-	league, err := r.queries.UpdateLeague(ctx, db.UpdateLeagueParams{
-		ID:             id,
-		Name:           req.Name,
-		SportID:        req.SportID,
-		LeagueType:     db.LeagueType(req.LeagueType),
-		CommissionerID: req.CommissionerID,
-		LeagueSettings: settingsJSON,
-		Status:         db.LeagueStatus(req.Status),
-		Season:         req.Season,
+	var league db.League
+	err = r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassLeagueSettings, id, txQueries, func(q *db.Queries) error {
+			change, err := r.newSettingsChange(ctx, q, id, req.LeagueSettings, req.ActorID, nil, check)
+			if err != nil {
+				return err
+			}
+			if err := r.renameActiveSeason(ctx, q, id, req.Season); err != nil {
+				return err
+			}
+
+			league, err = q.UpdateLeague(ctx, db.UpdateLeagueParams{
+				ID:             id,
+				Name:           req.Name,
+				SportID:        req.SportID,
+				LeagueType:     db.LeagueType(req.LeagueType),
+				CommissionerID: req.CommissionerID,
+				LeagueSettings: settingsJSON,
+				Status:         db.LeagueStatus(req.Status),
+				Season:         req.Season,
+			})
+			if err != nil {
+				return err
+			}
+
+			if change == nil {
+				return nil
+			}
+			change.Settings = league.LeagueSettings
+			_, err = q.InsertLeagueSettingsChange(ctx, *change)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.LeagueUpdated(id, changes.ActionLeagueUpdated))
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update league: %w", err)
@@ -102,11 +228,43 @@ func (r *Repository) UpdateLeague(ctx context.Context, id uuid.UUID, req UpdateL
 	return r.dbLeagueToModel(league), nil
 }
 
+// renameActiveSeason corrects the year of a league's active season to a changed
+// leagues.season, returning ErrSeasonExists when the league already has a season that year
+func (r *Repository) renameActiveSeason(ctx context.Context, q *db.Queries, leagueID uuid.UUID, year string) error {
+	active, err := q.GetActiveLeagueSeason(ctx, leagueID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get active league season: %w", err)
+	}
+	if active.Year == year {
+		return nil
+	}
+
+	taken, err := q.HasLeagueSeason(ctx, db.HasLeagueSeasonParams{LeagueID: leagueID, Year: year})
+	if err != nil {
+		return fmt.Errorf("failed to check league seasons: %w", err)
+	}
+	if taken {
+		return ErrSeasonExists
+	}
+	return q.SetActiveLeagueSeasonYear(ctx, db.SetActiveLeagueSeasonYearParams{Year: year, LeagueID: leagueID})
+}
+
 // UpdateLeagueStatus updates only the status of a league
 func (r *Repository) UpdateLeagueStatus(ctx context.Context, id uuid.UUID, status models.LeagueStatus) (*models.League, error) {
-	league, err := r.queries.UpdateLeagueStatus(ctx, db.UpdateLeagueStatusParams{
-		ID:     id,
-		Status: db.LeagueStatus(status),
+	var league db.League
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		league, err = r.q(ctx).UpdateLeagueStatus(ctx, db.UpdateLeagueStatusParams{
+			ID:     id,
+			Status: db.LeagueStatus(status),
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.LeagueUpdated(id, changes.ActionStatusChanged))
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update league status: %w", err)
@@ -115,17 +273,43 @@ func (r *Repository) UpdateLeagueStatus(ctx context.Context, id uuid.UUID, statu
 	return r.dbLeagueToModel(league), nil
 }
 
-// UpdateLeagueSettings updates only the settings of a league
-func (r *Repository) UpdateLeagueSettings(ctx context.Context, id uuid.UUID, settings interface{}) (*models.League, error) {
+// UpdateLeagueSettings updates only the settings of a league and records the change in
+// its settings change log. The league keeps the latest settings even when the change is
+// scheduled, readers that depend on the week use GetSettingsEffectiveAt.
+func (r *Repository) UpdateLeagueSettings(ctx context.Context, id uuid.UUID, req UpdateLeagueSettingsRequest, check SettingsChangeCheck) (*models.League, error) {
 	// Marshal league settings to JSON
-	settingsJSON, err := json.Marshal(settings)
+	settingsJSON, err := json.Marshal(req.LeagueSettings)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal league settings: %w", err)
 	}
 
-	league, err := r.queries.UpdateLeagueSettings(ctx, db.UpdateLeagueSettingsParams{
-		ID:             id,
-		LeagueSettings: settingsJSON,
+	var league db.League
+	err = r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassLeagueSettings, id, txQueries, func(q *db.Queries) error {
+			change, err := r.newSettingsChange(ctx, q, id, req.LeagueSettings, req.ActorID, req.EffectiveAt, check)
+			if err != nil {
+				return err
+			}
+
+			league, err = q.UpdateLeagueSettings(ctx, db.UpdateLeagueSettingsParams{
+				ID:             id,
+				LeagueSettings: settingsJSON,
+			})
+			if err != nil {
+				return err
+			}
+
+			if change == nil {
+				return nil
+			}
+			change.Settings = league.LeagueSettings
+			_, err = q.InsertLeagueSettingsChange(ctx, *change)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.LeagueUpdated(id, changes.ActionSettingsChanged))
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update league settings: %w", err)
@@ -134,14 +318,460 @@ func (r *Repository) UpdateLeagueSettings(ctx context.Context, id uuid.UUID, set
 	return r.dbLeagueToModel(league), nil
 }
 
-// DeleteLeague deletes a league by ID
-func (r *Repository) DeleteLeague(ctx context.Context, id uuid.UUID) error {
-	if err := r.queries.DeleteLeague(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete league: %w", err)
+// newSettingsChange diffs new settings against the league's current ones and, when anything
+// changed, runs check and returns the change log entry to insert once the league is updated
+func (r *Repository) newSettingsChange(
+	ctx context.Context,
+	q *db.Queries,
+	leagueID uuid.UUID,
+	settings interface{},
+	actorID *uuid.UUID,
+	effectiveAt *time.Time,
+	check SettingsChangeCheck,
+) (*db.InsertLeagueSettingsChangeParams, error) {
+	current, err := q.GetLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := models.DiffSettings(r.dbLeagueToModel(current).LeagueSettings, settings)
+	if len(diff) == 0 {
+		return nil, nil
+	}
+
+	var latest *models.LeagueSettingsChange
+	dbLatest, err := q.GetLatestLeagueSettingsChange(ctx, leagueID)
+	switch {
+	case err == nil:
+		latest = r.dbSettingsChangeToModel(dbLatest)
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, err
+	}
+
+	effective := time.Now()
+	if effectiveAt != nil {
+		effective = *effectiveAt
+	}
+	if err := check(latest, effective); err != nil {
+		return nil, err
+	}
+
+	diffJSON, err := json.Marshal(diff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal settings diff: %w", err)
+	}
+
+	return &db.InsertLeagueSettingsChangeParams{
+		LeagueID:    leagueID,
+		ActorUserID: sqlutil.ToNullUUID(actorID),
+		Diff:        diffJSON,
+		EffectiveAt: effective,
+	}, nil
+}
+
+// GetSettingsHistory retrieves a league's settings change log, newest first
+func (r *Repository) GetSettingsHistory(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueSettingsChange, error) {
+	changes, err := r.queries.GetLeagueSettingsChanges(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league settings changes: %w", err)
+	}
+
+	history := make([]models.LeagueSettingsChange, len(changes))
+	for i, change := range changes {
+		history[i] = *r.dbSettingsChangeToModel(change)
+	}
+	return history, nil
+}
+
+// GetSettingsEffectiveAt retrieves the version of a league's settings in force at a point
+// in time, or nil if the league has no version that had taken effect by then
+func (r *Repository) GetSettingsEffectiveAt(ctx context.Context, leagueID uuid.UUID, at time.Time) (*models.LeagueSettingsChange, error) {
+	change, err := r.queries.GetLeagueSettingsEffectiveAt(ctx, db.GetLeagueSettingsEffectiveAtParams{
+		LeagueID:    leagueID,
+		EffectiveAt: at,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get effective league settings: %w", err)
+	}
+
+	return r.dbSettingsChangeToModel(change), nil
+}
+
+// IsLeagueMember reports whether a user is the commissioner of a league or owns one of its teams
+func (r *Repository) IsLeagueMember(ctx context.Context, leagueID, userID uuid.UUID) (bool, error) {
+	isMember, err := r.queries.IsLeagueMember(ctx, db.IsLeagueMemberParams{
+		LeagueID: leagueID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check league membership: %w", err)
+	}
+
+	return isMember, nil
+}
+
+// CreateAPIKey stores a league API key by the hash of the key
+func (r *Repository) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest, keyPrefix string, keyHash []byte) (*models.LeagueAPIKey, error) {
+	scopes := make([]string, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	key, err := r.queries.InsertLeagueAPIKey(ctx, db.InsertLeagueAPIKeyParams{
+		LeagueID:  req.LeagueID,
+		Name:      req.Name,
+		KeyPrefix: keyPrefix,
+		KeyHash:   keyHash,
+		Scopes:    scopes,
+		CreatedBy: sqlutil.ToNullUUID(req.CreatedBy),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert league api key: %w", err)
+	}
+
+	return r.dbAPIKeyToModel(key), nil
+}
+
+// ListAPIKeys retrieves a league's API keys, revoked ones included, newest first
+func (r *Repository) ListAPIKeys(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueAPIKey, error) {
+	keys, err := r.queries.ListLeagueAPIKeys(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list league api keys: %w", err)
+	}
+
+	result := make([]models.LeagueAPIKey, len(keys))
+	for i, key := range keys {
+		result[i] = *r.dbAPIKeyToModel(key)
+	}
+	return result, nil
+}
+
+// RevokeAPIKey revokes an active API key of a league
+func (r *Repository) RevokeAPIKey(ctx context.Context, leagueID, keyID uuid.UUID) (*models.LeagueAPIKey, error) {
+	key, err := r.queries.RevokeLeagueAPIKey(ctx, db.RevokeLeagueAPIKeyParams{
+		ID:       keyID,
+		LeagueID: leagueID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to revoke league api key: %w", err)
+	}
+
+	return r.dbAPIKeyToModel(key), nil
+}
+
+// GetActiveAPIKeyByHash retrieves the unrevoked API key with the given key hash
+func (r *Repository) GetActiveAPIKeyByHash(ctx context.Context, keyHash []byte) (*models.LeagueAPIKey, error) {
+	key, err := r.queries.GetActiveLeagueAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidAPIKey
+		}
+		return nil, fmt.Errorf("failed to get league api key: %w", err)
+	}
+
+	return r.dbAPIKeyToModel(key), nil
+}
+
+// TouchAPIKey records that an API key was just used
+func (r *Repository) TouchAPIKey(ctx context.Context, keyID uuid.UUID) error {
+	if err := r.queries.TouchLeagueAPIKey(ctx, keyID); err != nil {
+		return fmt.Errorf("failed to touch league api key: %w", err)
 	}
 	return nil
 }
 
+// DeleteLeague soft deletes a league and revokes its API keys. Its teams, drafts and
+// transactions are kept. A league can't be deleted while one of its drafts is under way.
+func (r *Repository) DeleteLeague(ctx context.Context, id uuid.UUID) error {
+	return r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			drafts, err := q.CountLeagueDraftsInProgress(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to count drafts in progress: %w", err)
+			}
+			if drafts > 0 {
+				return ErrLeagueHasDraftInProgress
+			}
+
+			deleted, err := q.DeleteLeague(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to delete league: %w", err)
+			}
+			if deleted == 0 {
+				return fmt.Errorf("failed to delete league: %w", sql.ErrNoRows)
+			}
+
+			if err := q.RevokeAllLeagueAPIKeys(ctx, id); err != nil {
+				return fmt.Errorf("failed to revoke league api keys: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.LeagueUpdated(id, changes.ActionLeagueDeleted))
+	})
+}
+
+// RestoreLeague undoes a league's deletion. API keys revoked when it was deleted stay revoked.
+// A league whose commissioner has since been deleted can't be restored.
+func (r *Repository) RestoreLeague(ctx context.Context, id uuid.UUID) (*models.League, error) {
+	var league *models.League
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			commissionerDeleted, err := q.IsLeagueCommissionerDeleted(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to check league commissioner: %w", err)
+			}
+			if commissionerDeleted {
+				return ErrCommissionerDeleted
+			}
+
+			dbLeague, err := q.RestoreLeague(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to restore league: %w", err)
+			}
+			league = r.dbLeagueToModel(dbLeague)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.LeagueUpdated(id, changes.ActionLeagueRestored))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return league, nil
+}
+
+// RestoreArchivedLeague lifts a league's archival and drops its archive notice, so it has to be
+// inactive for another season before it is archived again. ErrLeagueNotArchived is returned
+// for a league that isn't archived.
+func (r *Repository) RestoreArchivedLeague(ctx context.Context, id uuid.UUID) (*models.League, error) {
+	var league *models.League
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			dbLeague, err := q.UnarchiveLeague(ctx, id)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return ErrLeagueNotArchived
+				}
+				return fmt.Errorf("failed to unarchive league: %w", err)
+			}
+			if err := q.DeleteLeagueArchiveNotice(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete league archive notice: %w", err)
+			}
+			league = r.dbLeagueToModel(dbLeague)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.LeagueUpdated(id, changes.ActionLeagueUnarchived))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return league, nil
+}
+
+// ListSeasons retrieves a league's seasons, latest first
+func (r *Repository) ListSeasons(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueSeason, error) {
+	dbSeasons, err := r.queries.ListLeagueSeasons(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list league seasons: %w", err)
+	}
+
+	seasons := make([]models.LeagueSeason, len(dbSeasons))
+	for i, dbSeason := range dbSeasons {
+		seasons[i] = *r.dbSeasonToModel(dbSeason)
+	}
+	return seasons, nil
+}
+
+// StartSeason starts a league's season for year and makes it the league's current season.
+// ErrActiveSeasonExists is returned while the previous season is still active, and
+// ErrSeasonExists when the league already had a season that year.
+func (r *Repository) StartSeason(ctx context.Context, leagueID uuid.UUID, year string) (*models.LeagueSeason, error) {
+	var season *models.LeagueSeason
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassLeagueSeason, leagueID, txQueries, func(q *db.Queries) error {
+			_, err := q.GetActiveLeagueSeason(ctx, leagueID)
+			switch {
+			case err == nil:
+				return ErrActiveSeasonExists
+			case !errors.Is(err, sql.ErrNoRows):
+				return fmt.Errorf("failed to get active league season: %w", err)
+			}
+
+			dbSeason, err := q.CreateLeagueSeason(ctx, db.CreateLeagueSeasonParams{LeagueID: leagueID, Year: year})
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return ErrSeasonExists
+				}
+				return fmt.Errorf("failed to create league season: %w", err)
+			}
+			if _, err := q.SetLeagueSeason(ctx, db.SetLeagueSeasonParams{ID: leagueID, Season: year}); err != nil {
+				return fmt.Errorf("failed to set league season: %w", err)
+			}
+			season = r.dbSeasonToModel(dbSeason)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.LeagueUpdated(leagueID, changes.ActionSeasonStarted))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return season, nil
+}
+
+// CompleteSeason completes a league's active season. ErrNoActiveSeason is returned when it
+// has none, and ErrLeagueHasDraftInProgress while one of its drafts is under way.
+func (r *Repository) CompleteSeason(ctx context.Context, leagueID uuid.UUID) (*models.LeagueSeason, error) {
+	var season *models.LeagueSeason
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassLeagueSeason, leagueID, txQueries, func(q *db.Queries) error {
+			inProgress, err := q.CountLeagueDraftsInProgress(ctx, leagueID)
+			if err != nil {
+				return fmt.Errorf("failed to count drafts in progress: %w", err)
+			}
+			if inProgress > 0 {
+				return ErrLeagueHasDraftInProgress
+			}
+
+			dbSeason, err := q.CompleteLeagueSeason(ctx, leagueID)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return ErrNoActiveSeason
+				}
+				return fmt.Errorf("failed to complete league season: %w", err)
+			}
+			season = r.dbSeasonToModel(dbSeason)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.LeagueUpdated(leagueID, changes.ActionSeasonCompleted))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return season, nil
+}
+
+// IsLeagueArchived reports whether a league has been archived for inactivity
+func (r *Repository) IsLeagueArchived(ctx context.Context, leagueID uuid.UUID) (bool, error) {
+	archived, err := r.queries.IsLeagueArchived(ctx, leagueID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check league archival: %w", err)
+	}
+	return archived, nil
+}
+
+// ArchiveInactiveLeagues runs the archival policy at now. Notices of leagues that were used
+// again are dropped first, then leagues whose notice's grace period is over are archived, and
+// last the commissioners of up to batchSize leagues inactive for inactiveFor are warned that
+// their league is archived once grace has passed.
+func (r *Repository) ArchiveInactiveLeagues(ctx context.Context, now time.Time, inactiveFor, grace time.Duration, batchSize int32) (*ArchivalResult, error) {
+	resumed, err := r.queries.ClearResumedLeagueArchiveNotices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clear resumed league archive notices: %w", err)
+	}
+
+	var archived []uuid.UUID
+	err = r.changes.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		archived, err = r.q(ctx).ArchiveDueLeagues(ctx, now)
+		if err != nil {
+			return err
+		}
+		archivedChanges := make([]changes.Change, len(archived))
+		for i, leagueID := range archived {
+			archivedChanges[i] = changes.LeagueUpdated(leagueID, changes.ActionLeagueArchived)
+		}
+		return r.changes.Insert(ctx, archivedChanges...)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive due leagues: %w", err)
+	}
+
+	inactive, err := r.queries.ListInactiveLeagues(ctx, db.ListInactiveLeaguesParams{
+		InactiveSince: now.Add(-inactiveFor),
+		BatchSize:     batchSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inactive leagues: %w", err)
+	}
+
+	result := &ArchivalResult{Resumed: int(resumed), Archived: archived}
+	for _, league := range inactive {
+		warned, err := r.warnInactiveLeague(ctx, league, now.Add(grace))
+		if err != nil {
+			return result, err
+		}
+		if warned {
+			result.Warned++
+		}
+	}
+	return result, nil
+}
+
+// warnInactiveLeague records an inactive league's archive notice and queues the warning to its
+// commissioner in one transaction, so the commissioner is warned once per notice
+func (r *Repository) warnInactiveLeague(ctx context.Context, league db.ListInactiveLeaguesRow, archiveAfter time.Time) (bool, error) {
+	var warned bool
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		inserted, err := q.InsertLeagueArchiveNotice(ctx, db.InsertLeagueArchiveNoticeParams{
+			LeagueID:       league.ID,
+			LastActivityAt: league.LastActivityAt,
+			ArchiveAfter:   archiveAfter,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert league archive notice: %w", err)
+		}
+		if inserted == 0 {
+			// Another run warned the commissioner already
+			return nil
+		}
+
+		payload, err := json.Marshal(userevents.LeagueArchiveWarningPayload{
+			UserID:         league.CommissionerID.String(),
+			Username:       league.Username,
+			Email:          league.Email,
+			LeagueID:       league.ID.String(),
+			LeagueName:     league.Name,
+			LastActivityAt: league.LastActivityAt,
+			ArchiveAfter:   archiveAfter,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal LeagueArchiveWarning notification: %w", err)
+		}
+
+		if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+			ID:        ids.New(),
+			UserID:    league.CommissionerID,
+			EventType: userevents.LeagueArchiveWarning,
+			Payload:   payload,
+		}); err != nil {
+			return fmt.Errorf("failed to queue LeagueArchiveWarning notification: %w", err)
+		}
+		warned = true
+		return nil
+	})
+	return warned, err
+}
+
 // dbLeagueToModel converts a database league to domain model
 func (r *Repository) dbLeagueToModel(dbLeague db.League) *models.League {
 	// Unmarshal league settings from JSON
@@ -164,6 +794,59 @@ func (r *Repository) dbLeagueToModel(dbLeague db.League) *models.League {
 		Season:         dbLeague.Season,
 		CreatedAt:      dbLeague.CreatedAt,
 		UpdatedAt:      dbLeague.UpdatedAt,
+		ArchivedAt:     sqlutil.FromSqlTime(dbLeague.ArchivedAt),
+	}
+}
+
+// dbSettingsChangeToModel converts a database settings change to domain model
+func (r *Repository) dbSettingsChangeToModel(dbChange db.LeagueSettingsChange) *models.LeagueSettingsChange {
+	var settings interface{}
+	if err := json.Unmarshal(dbChange.Settings, &settings); err != nil {
+		settings = string(dbChange.Settings)
+	}
+	diff := make(map[string]models.SettingDiff)
+	_ = json.Unmarshal(dbChange.Diff, &diff)
+
+	return &models.LeagueSettingsChange{
+		ID:          dbChange.ID,
+		LeagueID:    dbChange.LeagueID,
+		ActorUserID: sqlutil.FromNullUUID(dbChange.ActorUserID),
+		Settings:    settings,
+		Diff:        diff,
+		EffectiveAt: dbChange.EffectiveAt,
+		CreatedAt:   dbChange.CreatedAt,
+	}
+}
+
+// dbAPIKeyToModel converts a database league API key to domain model
+func (r *Repository) dbAPIKeyToModel(dbKey db.LeagueApiKey) *models.LeagueAPIKey {
+	scopes := make([]models.APIKeyScope, len(dbKey.Scopes))
+	for i, scope := range dbKey.Scopes {
+		scopes[i] = models.APIKeyScope(scope)
+	}
+
+	return &models.LeagueAPIKey{
+		ID:         dbKey.ID,
+		LeagueID:   dbKey.LeagueID,
+		Name:       dbKey.Name,
+		KeyPrefix:  dbKey.KeyPrefix,
+		Scopes:     scopes,
+		CreatedBy:  sqlutil.FromNullUUID(dbKey.CreatedBy),
+		CreatedAt:  dbKey.CreatedAt,
+		LastUsedAt: sqlutil.FromSqlTime(dbKey.LastUsedAt),
+		RevokedAt:  sqlutil.FromSqlTime(dbKey.RevokedAt),
+	}
+}
+
+// dbSeasonToModel converts a database league season to domain model
+func (r *Repository) dbSeasonToModel(dbSeason db.LeagueSeason) *models.LeagueSeason {
+	return &models.LeagueSeason{
+		ID:          dbSeason.ID,
+		LeagueID:    dbSeason.LeagueID,
+		Year:        dbSeason.Year,
+		Status:      models.LeagueSeasonStatus(dbSeason.Status),
+		StartedAt:   dbSeason.StartedAt,
+		CompletedAt: sqlutil.FromSqlTime(dbSeason.CompletedAt),
 	}
 }
 
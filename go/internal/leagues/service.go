@@ -2,14 +2,22 @@ package leagues
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	templatev1 "github.com/mcdev12/dynasty/go/internal/genproto/template/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/template/v1/templatev1connect"
 	userv1 "github.com/mcdev12/dynasty/go/internal/genproto/user/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/user/v1/userv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -19,23 +27,35 @@ type LeaguesApp interface {
 	CreateLeague(ctx context.Context, req CreateLeagueRequest) (*models.League, error)
 	GetLeague(ctx context.Context, id uuid.UUID) (*models.League, error)
 	GetLeaguesByCommissioner(ctx context.Context, commissionerID uuid.UUID) ([]models.League, error)
+	ListLeagues(ctx context.Context, req ListLeaguesRequest) (*LeaguePage, error)
 	UpdateLeague(ctx context.Context, id uuid.UUID, req UpdateLeagueRequest) (*models.League, error)
 	UpdateLeagueStatus(ctx context.Context, id uuid.UUID, status models.LeagueStatus) (*models.League, error)
-	UpdateLeagueSettings(ctx context.Context, id uuid.UUID, settings interface{}) (*models.League, error)
+	UpdateLeagueSettings(ctx context.Context, id uuid.UUID, req UpdateLeagueSettingsRequest) (*models.League, error)
+	GetSettingsHistory(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueSettingsChange, error)
 	DeleteLeague(ctx context.Context, id uuid.UUID) error
+	RestoreLeague(ctx context.Context, id uuid.UUID) (*models.League, error)
+	RestoreArchivedLeague(ctx context.Context, id uuid.UUID) (*models.League, error)
+	CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*IssuedAPIKey, error)
+	ListAPIKeys(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueAPIKey, error)
+	RevokeAPIKey(ctx context.Context, leagueID, keyID uuid.UUID) (*models.LeagueAPIKey, error)
+	ListSeasons(ctx context.Context, leagueID uuid.UUID) ([]models.LeagueSeason, error)
+	StartSeason(ctx context.Context, leagueID uuid.UUID, year string) (*models.LeagueSeason, error)
+	CompleteSeason(ctx context.Context, leagueID uuid.UUID) (*models.LeagueSeason, error)
 }
 
 // Service implements the LeagueService gRPC interface
 type Service struct {
-	app         LeaguesApp
-	userService userv1connect.UserServiceClient
+	app             LeaguesApp
+	userService     userv1connect.UserServiceClient
+	templateService templatev1connect.SettingsTemplateServiceClient
 }
 
 // NewService creates a new leagues gRPC service
-func NewService(app LeaguesApp, userService userv1connect.UserServiceClient) *Service {
+func NewService(app LeaguesApp, userService userv1connect.UserServiceClient, templateService templatev1connect.SettingsTemplateServiceClient) *Service {
 	return &Service{
-		app:         app,
-		userService: userService,
+		app:             app,
+		userService:     userService,
+		templateService: templateService,
 	}
 }
 
@@ -46,7 +66,7 @@ var _ leaguev1connect.LeagueServiceHandler = (*Service)(nil)
 func (s *Service) CreateLeague(ctx context.Context, req *connect.Request[leaguev1.CreateLeagueRequest]) (*connect.Response[leaguev1.CreateLeagueResponse], error) {
 	appReq, err := s.protoToCreateLeagueRequest(req.Msg)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Cross-domain orchestration: validate commissioner exists first
@@ -57,6 +77,12 @@ func (s *Service) CreateLeague(ctx context.Context, req *connect.Request[leaguev
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
+	if req.Msg.TemplateId != nil {
+		if err := s.applyTemplate(ctx, *req.Msg.TemplateId, &appReq); err != nil {
+			return nil, err
+		}
+	}
+
 	league, err := s.app.CreateLeague(ctx, appReq)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
@@ -74,9 +100,9 @@ func (s *Service) CreateLeague(ctx context.Context, req *connect.Request[leaguev
 
 // GetLeague retrieves a league by ID
 func (s *Service) GetLeague(ctx context.Context, req *connect.Request[leaguev1.GetLeagueRequest]) (*connect.Response[leaguev1.GetLeagueResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	league, err := s.app.GetLeague(ctx, id)
@@ -96,9 +122,9 @@ func (s *Service) GetLeague(ctx context.Context, req *connect.Request[leaguev1.G
 
 // GetLeaguesByCommissioner retrieves leagues by commissioner ID
 func (s *Service) GetLeaguesByCommissioner(ctx context.Context, req *connect.Request[leaguev1.GetLeaguesByCommissionerRequest]) (*connect.Response[leaguev1.GetLeaguesByCommissionerResponse], error) {
-	commissionerID, err := uuid.Parse(req.Msg.CommissionerId)
+	commissionerID, err := uuidutil.MustParseOrInvalidArg("commissioner_id", req.Msg.CommissionerId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	leagues, err := s.app.GetLeaguesByCommissioner(ctx, commissionerID)
@@ -116,16 +142,59 @@ func (s *Service) GetLeaguesByCommissioner(ctx context.Context, req *connect.Req
 	}), nil
 }
 
+// ListLeagues pages through leagues, newest first
+func (s *Service) ListLeagues(ctx context.Context, req *connect.Request[leaguev1.ListLeaguesRequest]) (*connect.Response[leaguev1.ListLeaguesResponse], error) {
+	memberUserID, err := uuidutil.ParseOptional("member_user_id", req.Msg.MemberUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := ListLeaguesRequest{
+		SportID:         req.Msg.SportId,
+		Season:          req.Msg.Season,
+		Search:          req.Msg.Search,
+		IncludeArchived: req.Msg.IncludeArchived,
+		PageSize:        int(req.Msg.PageSize),
+		PageToken:       req.Msg.PageToken,
+		MemberUserID:    memberUserID,
+	}
+	if req.Msg.Status != leaguev1.LeagueStatus_LEAGUE_STATUS_UNSPECIFIED {
+		status := s.protoToLeagueStatus(req.Msg.Status)
+		appReq.Status = &status
+	}
+
+	page, err := s.app.ListLeagues(ctx, appReq)
+	if err != nil {
+		if errors.Is(err, ErrInvalidPageToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoLeagues, err := s.leaguesToProto(page.Leagues)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&leaguev1.ListLeaguesResponse{
+		Leagues:       protoLeagues,
+		NextPageToken: page.NextPageToken,
+	}), nil
+}
+
 // UpdateLeague updates an existing league
 func (s *Service) UpdateLeague(ctx context.Context, req *connect.Request[leaguev1.UpdateLeagueRequest]) (*connect.Response[leaguev1.UpdateLeagueResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	appReq, err := s.protoToUpdateLeagueRequest(req.Msg)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
+	}
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok {
+		appReq.ActorID = &actingUser
 	}
 
 	// Cross-domain orchestration: validate commissioner exists first
@@ -138,7 +207,14 @@ func (s *Service) UpdateLeague(ctx context.Context, req *connect.Request[leaguev
 
 	league, err := s.app.UpdateLeague(ctx, id, appReq)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		switch {
+		case errors.Is(err, ErrSettingsChangeOutOfOrder) || errors.Is(err, ErrLeagueArchived):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, ErrSeasonExists):
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
 	}
 
 	protoLeague, err := s.leagueToProto(league)
@@ -153,13 +229,16 @@ func (s *Service) UpdateLeague(ctx context.Context, req *connect.Request[leaguev
 
 // UpdateLeagueStatus updates only the status of a league
 func (s *Service) UpdateLeagueStatus(ctx context.Context, req *connect.Request[leaguev1.UpdateLeagueStatusRequest]) (*connect.Response[leaguev1.UpdateLeagueStatusResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	league, err := s.app.UpdateLeagueStatus(ctx, id, s.protoToLeagueStatus(req.Msg.Status))
 	if err != nil {
+		if errors.Is(err, ErrLeagueArchived) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -175,16 +254,28 @@ func (s *Service) UpdateLeagueStatus(ctx context.Context, req *connect.Request[l
 
 // UpdateLeagueSettings updates only the settings of a league
 func (s *Service) UpdateLeagueSettings(ctx context.Context, req *connect.Request[leaguev1.UpdateLeagueSettingsRequest]) (*connect.Response[leaguev1.UpdateLeagueSettingsResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Convert protobuf Struct to interface{}
-	settings := req.Msg.LeagueSettings.AsMap()
+	appReq := UpdateLeagueSettingsRequest{
+		LeagueSettings: req.Msg.LeagueSettings.AsMap(),
+	}
+	if req.Msg.EffectiveAt != nil {
+		effectiveAt := req.Msg.EffectiveAt.AsTime()
+		appReq.EffectiveAt = &effectiveAt
+	}
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok {
+		appReq.ActorID = &actingUser
+	}
 
-	league, err := s.app.UpdateLeagueSettings(ctx, id, settings)
+	league, err := s.app.UpdateLeagueSettings(ctx, id, appReq)
 	if err != nil {
+		if errors.Is(err, ErrSettingsChangeOutOfOrder) || errors.Is(err, ErrLeagueArchived) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -198,16 +289,49 @@ func (s *Service) UpdateLeagueSettings(ctx context.Context, req *connect.Request
 	}), nil
 }
 
-// DeleteLeague deletes a league by ID
+// GetSettingsHistory retrieves every change to a league's settings, newest first
+func (s *Service) GetSettingsHistory(ctx context.Context, req *connect.Request[leaguev1.GetSettingsHistoryRequest]) (*connect.Response[leaguev1.GetSettingsHistoryResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.app.GetSettingsHistory(ctx, leagueID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	changes := make([]*leaguev1.LeagueSettingsChange, len(history))
+	for i := range history {
+		change, err := s.settingsChangeToProto(&history[i])
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		changes[i] = change
+	}
+
+	return connect.NewResponse(&leaguev1.GetSettingsHistoryResponse{
+		Changes: changes,
+	}), nil
+}
+
+// DeleteLeague soft deletes a league by ID
 func (s *Service) DeleteLeague(ctx context.Context, req *connect.Request[leaguev1.DeleteLeagueRequest]) (*connect.Response[leaguev1.DeleteLeagueResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	err = s.app.DeleteLeague(ctx, id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, ErrLeagueHasDraftInProgress):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
 	}
 
 	return connect.NewResponse(&leaguev1.DeleteLeagueResponse{
@@ -215,6 +339,272 @@ func (s *Service) DeleteLeague(ctx context.Context, req *connect.Request[leaguev
 	}), nil
 }
 
+// RestoreLeague undoes a league's deletion
+func (s *Service) RestoreLeague(ctx context.Context, req *connect.Request[leaguev1.RestoreLeagueRequest]) (*connect.Response[leaguev1.RestoreLeagueResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	league, err := s.app.RestoreLeague(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, ErrCommissionerDeleted):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	protoLeague, err := s.leagueToProto(league)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&leaguev1.RestoreLeagueResponse{
+		League: protoLeague,
+	}), nil
+}
+
+// RestoreArchivedLeague lifts the archival of a league archived for inactivity. Commissioner only.
+func (s *Service) RestoreArchivedLeague(ctx context.Context, req *connect.Request[leaguev1.RestoreArchivedLeagueRequest]) (*connect.Response[leaguev1.RestoreArchivedLeagueResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ensureCommissioner(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	league, err := s.app.RestoreArchivedLeague(ctx, leagueID)
+	if err != nil {
+		if errors.Is(err, ErrLeagueNotArchived) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoLeague, err := s.leagueToProto(league)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&leaguev1.RestoreArchivedLeagueResponse{
+		League: protoLeague,
+	}), nil
+}
+
+// CreateLeagueAPIKey issues an API key for external tools. Commissioner only.
+func (s *Service) CreateLeagueAPIKey(ctx context.Context, req *connect.Request[leaguev1.CreateLeagueAPIKeyRequest]) (*connect.Response[leaguev1.CreateLeagueAPIKeyResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	commissioner, err := s.ensureCommissioner(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]models.APIKeyScope, len(req.Msg.Scopes))
+	for i, scope := range req.Msg.Scopes {
+		scopes[i] = s.protoToAPIKeyScope(scope)
+	}
+
+	issued, err := s.app.CreateAPIKey(ctx, CreateAPIKeyRequest{
+		LeagueID:  leagueID,
+		Name:      req.Msg.Name,
+		Scopes:    scopes,
+		CreatedBy: &commissioner,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&leaguev1.CreateLeagueAPIKeyResponse{
+		ApiKey: s.apiKeyToProto(&issued.APIKey),
+		Key:    issued.Key,
+	}), nil
+}
+
+// ListLeagueAPIKeys retrieves a league's API keys, revoked ones included. Commissioner only.
+func (s *Service) ListLeagueAPIKeys(ctx context.Context, req *connect.Request[leaguev1.ListLeagueAPIKeysRequest]) (*connect.Response[leaguev1.ListLeagueAPIKeysResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ensureCommissioner(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.app.ListAPIKeys(ctx, leagueID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoKeys := make([]*leaguev1.LeagueAPIKey, len(keys))
+	for i := range keys {
+		protoKeys[i] = s.apiKeyToProto(&keys[i])
+	}
+
+	return connect.NewResponse(&leaguev1.ListLeagueAPIKeysResponse{
+		ApiKeys: protoKeys,
+	}), nil
+}
+
+// RevokeLeagueAPIKey revokes an API key. Commissioner only.
+func (s *Service) RevokeLeagueAPIKey(ctx context.Context, req *connect.Request[leaguev1.RevokeLeagueAPIKeyRequest]) (*connect.Response[leaguev1.RevokeLeagueAPIKeyResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+	keyID, err := uuidutil.MustParseOrInvalidArg("api_key_id", req.Msg.ApiKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ensureCommissioner(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	key, err := s.app.RevokeAPIKey(ctx, leagueID, keyID)
+	if err != nil {
+		if errors.Is(err, ErrAPIKeyNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&leaguev1.RevokeLeagueAPIKeyResponse{
+		ApiKey: s.apiKeyToProto(key),
+	}), nil
+}
+
+// ListLeagueSeasons retrieves a league's seasons, latest first
+func (s *Service) ListLeagueSeasons(ctx context.Context, req *connect.Request[leaguev1.ListLeagueSeasonsRequest]) (*connect.Response[leaguev1.ListLeagueSeasonsResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons, err := s.app.ListSeasons(ctx, leagueID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoSeasons := make([]*leaguev1.LeagueSeason, len(seasons))
+	for i := range seasons {
+		protoSeasons[i] = s.seasonToProto(&seasons[i])
+	}
+
+	return connect.NewResponse(&leaguev1.ListLeagueSeasonsResponse{
+		Seasons: protoSeasons,
+	}), nil
+}
+
+// StartLeagueSeason starts a league's season for a year. Commissioner only.
+func (s *Service) StartLeagueSeason(ctx context.Context, req *connect.Request[leaguev1.StartLeagueSeasonRequest]) (*connect.Response[leaguev1.StartLeagueSeasonResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ensureCommissioner(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	season, err := s.app.StartSeason(ctx, leagueID, req.Msg.Year)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrActiveSeasonExists) || errors.Is(err, ErrLeagueArchived):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, ErrSeasonExists):
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	return connect.NewResponse(&leaguev1.StartLeagueSeasonResponse{
+		Season: s.seasonToProto(season),
+	}), nil
+}
+
+// CompleteLeagueSeason completes a league's active season. Commissioner only.
+func (s *Service) CompleteLeagueSeason(ctx context.Context, req *connect.Request[leaguev1.CompleteLeagueSeasonRequest]) (*connect.Response[leaguev1.CompleteLeagueSeasonResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ensureCommissioner(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	season, err := s.app.CompleteSeason(ctx, leagueID)
+	if err != nil {
+		if errors.Is(err, ErrNoActiveSeason) || errors.Is(err, ErrLeagueHasDraftInProgress) || errors.Is(err, ErrLeagueArchived) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&leaguev1.CompleteLeagueSeasonResponse{
+		Season: s.seasonToProto(season),
+	}), nil
+}
+
+// ensureCommissioner returns the acting user when they are the league's commissioner.
+// Unlike other commissioner checks, a user is always required: API keys are credentials.
+func (s *Service) ensureCommissioner(ctx context.Context, leagueID uuid.UUID) (uuid.UUID, error) {
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	if !ok {
+		return uuid.Nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("missing %s header", interceptors.UserIDHeader))
+	}
+
+	league, err := s.app.GetLeague(ctx, leagueID)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if league.CommissionerID != actingUser {
+		return uuid.Nil, connect.NewError(connect.CodePermissionDenied, ErrNotCommissioner)
+	}
+
+	return actingUser, nil
+}
+
+// applyTemplate uses a settings template's league settings as the base for a new
+// league, with any keys set on the request taking precedence
+func (s *Service) applyTemplate(ctx context.Context, templateID string, appReq *CreateLeagueRequest) error {
+	templateResp, err := s.templateService.GetSettingsTemplate(ctx, connect.NewRequest(&templatev1.GetSettingsTemplateRequest{
+		Id: templateID,
+	}))
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("settings template not found: %w", err))
+	}
+	template := templateResp.Msg.Template
+
+	if template.SportId != appReq.SportID {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("settings template is for sport %s, not %s", template.SportId, appReq.SportID))
+	}
+	if template.LeagueType != nil && s.protoToLeagueType(*template.LeagueType) != appReq.LeagueType {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("settings template is for %s leagues", s.protoToLeagueType(*template.LeagueType)))
+	}
+
+	settings := template.LeagueSettings.AsMap()
+	if overrides, ok := appReq.LeagueSettings.(map[string]interface{}); ok {
+		for key, value := range overrides {
+			settings[key] = value
+		}
+	}
+	appReq.LeagueSettings = settings
+	return nil
+}
+
 // Conversion methods between proto and app layer models
 
 func (s *Service) leagueToProto(league *models.League) (*leaguev1.League, error) {
@@ -224,7 +614,7 @@ func (s *Service) leagueToProto(league *models.League) (*leaguev1.League, error)
 		return nil, err
 	}
 
-	return &leaguev1.League{
+	protoLeague := &leaguev1.League{
 		Id:             league.ID.String(),
 		Name:           league.Name,
 		SportId:        league.SportID,
@@ -235,7 +625,52 @@ func (s *Service) leagueToProto(league *models.League) (*leaguev1.League, error)
 		Season:         league.Season,
 		CreatedAt:      timestamppb.New(league.CreatedAt),
 		UpdatedAt:      timestamppb.New(league.UpdatedAt),
-	}, nil
+	}
+	if league.ArchivedAt != nil {
+		protoLeague.ArchivedAt = timestamppb.New(*league.ArchivedAt)
+	}
+	return protoLeague, nil
+}
+
+func (s *Service) settingsChangeToProto(change *models.LeagueSettingsChange) (*leaguev1.LeagueSettingsChange, error) {
+	settings, _ := change.Settings.(map[string]interface{})
+	settingsStruct, err := structpb.NewStruct(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(change.Diff))
+	for key := range change.Diff {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	diff := make([]*leaguev1.SettingDiff, len(keys))
+	for i, key := range keys {
+		oldValue, err := structpb.NewValue(change.Diff[key].Old)
+		if err != nil {
+			return nil, err
+		}
+		newValue, err := structpb.NewValue(change.Diff[key].New)
+		if err != nil {
+			return nil, err
+		}
+		diff[i] = &leaguev1.SettingDiff{Key: key, OldValue: oldValue, NewValue: newValue}
+	}
+
+	protoChange := &leaguev1.LeagueSettingsChange{
+		Id:          change.ID.String(),
+		LeagueId:    change.LeagueID.String(),
+		Settings:    settingsStruct,
+		Diff:        diff,
+		EffectiveAt: timestamppb.New(change.EffectiveAt),
+		CreatedAt:   timestamppb.New(change.CreatedAt),
+	}
+	if change.ActorUserID != nil {
+		actorUserID := change.ActorUserID.String()
+		protoChange.ActorUserId = &actorUserID
+	}
+	return protoChange, nil
 }
 
 func (s *Service) leaguesToProto(leagues []models.League) ([]*leaguev1.League, error) {
@@ -251,11 +686,10 @@ func (s *Service) leaguesToProto(leagues []models.League) ([]*leaguev1.League, e
 }
 
 func (s *Service) protoToCreateLeagueRequest(proto *leaguev1.CreateLeagueRequest) (CreateLeagueRequest, error) {
-	commissionerID, err := uuid.Parse(proto.CommissionerId)
+	commissionerID, err := uuidutil.MustParseOrInvalidArg("commissioner_id", proto.CommissionerId)
 	if err != nil {
 		return CreateLeagueRequest{}, err
 	}
-
 	return CreateLeagueRequest{
 		Name:           proto.Name,
 		SportID:        proto.SportId,
@@ -268,11 +702,10 @@ func (s *Service) protoToCreateLeagueRequest(proto *leaguev1.CreateLeagueRequest
 }
 
 func (s *Service) protoToUpdateLeagueRequest(proto *leaguev1.UpdateLeagueRequest) (UpdateLeagueRequest, error) {
-	commissionerID, err := uuid.Parse(proto.CommissionerId)
+	commissionerID, err := uuidutil.MustParseOrInvalidArg("commissioner_id", proto.CommissionerId)
 	if err != nil {
 		return UpdateLeagueRequest{}, err
 	}
-
 	return UpdateLeagueRequest{
 		Name:           proto.Name,
 		SportID:        proto.SportId,
@@ -339,3 +772,79 @@ func (s *Service) protoToLeagueStatus(protoStatus leaguev1.LeagueStatus) models.
 		return models.LeagueStatusActive
 	}
 }
+
+func (s *Service) apiKeyToProto(key *models.LeagueAPIKey) *leaguev1.LeagueAPIKey {
+	scopes := make([]leaguev1.APIKeyScope, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = s.apiKeyScopeToProto(scope)
+	}
+
+	protoKey := &leaguev1.LeagueAPIKey{
+		Id:        key.ID.String(),
+		LeagueId:  key.LeagueID.String(),
+		Name:      key.Name,
+		KeyPrefix: key.KeyPrefix,
+		Scopes:    scopes,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+	if key.CreatedBy != nil {
+		createdBy := key.CreatedBy.String()
+		protoKey.CreatedBy = &createdBy
+	}
+	if key.LastUsedAt != nil {
+		protoKey.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+	if key.RevokedAt != nil {
+		protoKey.RevokedAt = timestamppb.New(*key.RevokedAt)
+	}
+	return protoKey
+}
+
+func (s *Service) apiKeyScopeToProto(scope models.APIKeyScope) leaguev1.APIKeyScope {
+	switch scope {
+	case models.APIKeyScopeResultsRead:
+		return leaguev1.APIKeyScope_API_KEY_SCOPE_RESULTS_READ
+	case models.APIKeyScopeWebhooksManage:
+		return leaguev1.APIKeyScope_API_KEY_SCOPE_WEBHOOKS_MANAGE
+	default:
+		return leaguev1.APIKeyScope_API_KEY_SCOPE_UNSPECIFIED
+	}
+}
+
+func (s *Service) protoToAPIKeyScope(protoScope leaguev1.APIKeyScope) models.APIKeyScope {
+	switch protoScope {
+	case leaguev1.APIKeyScope_API_KEY_SCOPE_RESULTS_READ:
+		return models.APIKeyScopeResultsRead
+	case leaguev1.APIKeyScope_API_KEY_SCOPE_WEBHOOKS_MANAGE:
+		return models.APIKeyScopeWebhooksManage
+	default:
+		return "" // rejected by validation
+	}
+}
+
+// seasonToProto converts a league season to protobuf
+func (s *Service) seasonToProto(season *models.LeagueSeason) *leaguev1.LeagueSeason {
+	protoSeason := &leaguev1.LeagueSeason{
+		Id:        season.ID.String(),
+		LeagueId:  season.LeagueID.String(),
+		Year:      season.Year,
+		Status:    s.seasonStatusToProto(season.Status),
+		StartedAt: timestamppb.New(season.StartedAt),
+	}
+	if season.CompletedAt != nil {
+		protoSeason.CompletedAt = timestamppb.New(*season.CompletedAt)
+	}
+	return protoSeason
+}
+
+// seasonStatusToProto converts a league season status to protobuf
+func (s *Service) seasonStatusToProto(status models.LeagueSeasonStatus) leaguev1.LeagueSeasonStatus {
+	switch status {
+	case models.LeagueSeasonStatusActive:
+		return leaguev1.LeagueSeasonStatus_LEAGUE_SEASON_STATUS_ACTIVE
+	case models.LeagueSeasonStatusCompleted:
+		return leaguev1.LeagueSeasonStatus_LEAGUE_SEASON_STATUS_COMPLETED
+	default:
+		return leaguev1.LeagueSeasonStatus_LEAGUE_SEASON_STATUS_UNSPECIFIED
+	}
+}
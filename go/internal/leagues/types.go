@@ -1,10 +1,59 @@
 package leagues
 
 import (
+	"errors"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/mcdev12/dynasty/go/internal/models"
 )
 
+// ErrSettingsChangeOutOfOrder is returned when a settings change would take effect before
+// a change that is already scheduled
+var ErrSettingsChangeOutOfOrder = errors.New("settings change takes effect before an already scheduled change")
+
+// ErrNotCommissioner is returned when someone other than the league's commissioner manages its API keys
+var ErrNotCommissioner = errors.New("only the league commissioner can do this")
+
+// ErrAPIKeyNotFound is returned for an API key that doesn't exist in the league or is already revoked
+var ErrAPIKeyNotFound = errors.New("api key not found")
+
+// ErrInvalidAPIKey is returned when authenticating with an unknown or revoked API key
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// ErrLeagueHasDraftInProgress is returned when deleting a league while one of its drafts is under way
+var ErrLeagueHasDraftInProgress = errors.New("league has a draft in progress")
+
+// ErrCommissionerDeleted is returned when restoring a league whose commissioner has been deleted
+var ErrCommissionerDeleted = errors.New("league commissioner has been deleted")
+
+// ErrLeagueArchived is returned when changing a league that has been archived for inactivity
+var ErrLeagueArchived = errors.New("league is archived and read only")
+
+// ErrLeagueNotArchived is returned when restoring a league that isn't archived
+var ErrLeagueNotArchived = errors.New("league is not archived")
+
+// ErrActiveSeasonExists is returned when starting a season while the league's current one is still active
+var ErrActiveSeasonExists = errors.New("league already has an active season")
+
+// ErrNoActiveSeason is returned when completing the season of a league without an active one
+var ErrNoActiveSeason = errors.New("league has no active season")
+
+// ErrSeasonExists is returned when a league already has a season for the year
+var ErrSeasonExists = errors.New("league already has a season for that year")
+
+// ErrInvalidPageToken is returned when a page token wasn't issued by ListLeagues
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+const (
+	// defaultPageSize is used when a list request doesn't set a page size
+	defaultPageSize = 50
+	// maxPageSize caps the page size of a list request
+	maxPageSize = 200
+	// maxSearchLength caps the length of a league name search
+	maxSearchLength = 100
+)
+
 // CreateLeagueRequest represents the data needed to create a new league
 type CreateLeagueRequest struct {
 	Name           string              `json:"name" validate:"required"`
@@ -16,6 +65,49 @@ type CreateLeagueRequest struct {
 	Season         string              `json:"season" validate:"required"`
 }
 
+// ListLeaguesRequest pages through leagues, newest first. Unset filters match every league.
+type ListLeaguesRequest struct {
+	MemberUserID    *uuid.UUID           `json:"member_user_id,omitempty"` // commissioner, team owner or co-manager
+	SportID         *string              `json:"sport_id,omitempty"`
+	Status          *models.LeagueStatus `json:"status,omitempty"`
+	Season          *string              `json:"season,omitempty"`
+	Search          string               `json:"search,omitempty"`           // case-insensitive substring of the name
+	IncludeArchived bool                 `json:"include_archived,omitempty"` // archived leagues are left out otherwise
+	PageSize        int                  `json:"page_size"`
+	PageToken       string               `json:"page_token,omitempty"`
+}
+
+// LeaguePage is one page of leagues
+type LeaguePage struct {
+	Leagues       []models.League `json:"leagues"`
+	NextPageToken string          `json:"next_page_token,omitempty"` // empty on the last page
+}
+
+// ListLeaguesQuery selects the leagues the repository returns
+type ListLeaguesQuery struct {
+	MemberUserID    *uuid.UUID
+	SportID         *string
+	Status          *models.LeagueStatus
+	Season          *string
+	Search          string
+	IncludeArchived bool
+	After           *PageCursor // continue after this league
+	Limit           int32
+}
+
+// ArchivalResult counts what a run of the archival policy did
+type ArchivalResult struct {
+	Resumed  int         // notices dropped because their league was used again
+	Archived []uuid.UUID // leagues archived once their grace period was over
+	Warned   int         // commissioners warned that their league is about to be archived
+}
+
+// PageCursor is the position of the last league on a page in list order
+type PageCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
 // UpdateLeagueRequest represents the data that can be updated for a league
 type UpdateLeagueRequest struct {
 	Name           string              `json:"name" validate:"required"`
@@ -25,4 +117,27 @@ type UpdateLeagueRequest struct {
 	LeagueSettings interface{}         `json:"league_settings" validate:"required"`
 	Status         models.LeagueStatus `json:"status" validate:"required"`
 	Season         string              `json:"season" validate:"required"`
+	ActorID        *uuid.UUID          `json:"-"` // recorded in the settings change log
+}
+
+// UpdateLeagueSettingsRequest represents a change to a league's settings
+type UpdateLeagueSettingsRequest struct {
+	LeagueSettings interface{} `json:"league_settings" validate:"required"`
+	ActorID        *uuid.UUID  `json:"-"`                      // recorded in the settings change log
+	EffectiveAt    *time.Time  `json:"effective_at,omitempty"` // nil to take effect immediately
+}
+
+// CreateAPIKeyRequest represents a request to issue an API key for a league
+type CreateAPIKeyRequest struct {
+	LeagueID  uuid.UUID            `json:"league_id" validate:"required"`
+	Name      string               `json:"name" validate:"required"`
+	Scopes    []models.APIKeyScope `json:"scopes" validate:"required"`
+	CreatedBy *uuid.UUID           `json:"-"`
+}
+
+// IssuedAPIKey is a newly issued API key together with the key itself, which can't be
+// retrieved again
+type IssuedAPIKey struct {
+	APIKey models.LeagueAPIKey `json:"api_key"`
+	Key    string              `json:"key"`
 }
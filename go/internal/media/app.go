@@ -0,0 +1,113 @@
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// MediaRepository defines what the media app layer needs from the repository
+type MediaRepository interface {
+	GetFantasyTeamOwnerID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	SetFantasyTeamLogo(ctx context.Context, id uuid.UUID, logoURL string) (*string, error)
+	SetUserAvatar(ctx context.Context, id uuid.UUID, avatarURL string) (*string, error)
+}
+
+// App handles media uploads business logic
+type App struct {
+	repo  MediaRepository
+	store Store
+}
+
+// NewApp creates a new media App that keeps uploads in store
+func NewApp(repo MediaRepository, store Store) *App {
+	return &App{
+		repo:  repo,
+		store: store,
+	}
+}
+
+// UploadTeamLogo stores a fantasy team's new logo and points the team at it, returning its URL
+func (a *App) UploadTeamLogo(ctx context.Context, req UploadTeamLogoRequest) (string, error) {
+	ownerID, err := a.repo.GetFantasyTeamOwnerID(ctx, req.FantasyTeamID)
+	if err != nil {
+		return "", err
+	}
+	if req.UploadedBy != nil && *req.UploadedBy != ownerID {
+		return "", ErrNotTeamOwner
+	}
+
+	logo, err := squareImage(req.Image, TeamLogoSize)
+	if err != nil {
+		return "", err
+	}
+
+	logoURL, err := a.replace(ctx, "team-logos", req.FantasyTeamID, logo, a.repo.SetFantasyTeamLogo)
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("Uploaded logo for fantasy team %s", req.FantasyTeamID)
+	return logoURL, nil
+}
+
+// UploadUserAvatar stores a user's new avatar and points the user at it, returning its URL
+func (a *App) UploadUserAvatar(ctx context.Context, req UploadUserAvatarRequest) (string, error) {
+	if req.UploadedBy != nil && *req.UploadedBy != req.UserID {
+		return "", ErrNotSelf
+	}
+
+	avatar, err := squareImage(req.Image, AvatarSize)
+	if err != nil {
+		return "", err
+	}
+
+	avatarURL, err := a.replace(ctx, "avatars", req.UserID, avatar, a.repo.SetUserAvatar)
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("Uploaded avatar for user %s", req.UserID)
+	return avatarURL, nil
+}
+
+// replace stores a processed image for the team or user id and records its URL with set.
+// The image it replaces is deleted once nothing points at it; a failure to delete it only
+// leaves an orphaned object behind, so it is logged rather than returned.
+func (a *App) replace(ctx context.Context, prefix string, id uuid.UUID, image []byte,
+	set func(ctx context.Context, id uuid.UUID, url string) (*string, error)) (string, error) {
+	// The key names the content, so caches never serve a replaced image
+	sum := sha256.Sum256(image)
+	key := fmt.Sprintf("%s/%s/%s.png", prefix, id, hex.EncodeToString(sum[:8]))
+
+	if err := a.store.Put(ctx, key, "image/png", image); err != nil {
+		return "", fmt.Errorf("failed to store image: %w", err)
+	}
+
+	url := a.store.URL(key)
+	previous, err := set(ctx, id, url)
+	if err != nil {
+		// Another upload of the same image may already point at the key, unless there is
+		// no team or user to point at it
+		if errors.Is(err, ErrNotFound) {
+			if deleteErr := a.store.Delete(ctx, key); deleteErr != nil {
+				log.Printf("Failed to delete unused image %s: %v", key, deleteErr)
+			}
+		}
+		return "", err
+	}
+
+	if previous != nil {
+		if previousKey, ok := storedKey(a.store, *previous); ok && previousKey != key {
+			if err := a.store.Delete(ctx, previousKey); err != nil {
+				log.Printf("Failed to delete replaced image %s: %v", previousKey, err)
+			}
+		}
+	}
+	return url, nil
+}
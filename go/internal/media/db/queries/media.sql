@@ -0,0 +1,19 @@
+-- name: GetFantasyTeamOwnerID :one
+-- The user who may change a team's logo.
+SELECT owner_id FROM fantasy_teams WHERE id = $1;
+
+-- name: SwapFantasyTeamLogo :one
+-- Sets a team's logo and returns the one it replaced, so its image can be deleted.
+UPDATE fantasy_teams t SET
+    logo_url = $2
+FROM (SELECT id, logo_url FROM fantasy_teams WHERE id = $1 FOR UPDATE) previous
+WHERE t.id = previous.id
+RETURNING previous.logo_url;
+
+-- name: SwapUserAvatar :one
+-- Sets a user's avatar and returns the one it replaced, so its image can be deleted.
+UPDATE users u SET
+    avatar_url = $2
+FROM (SELECT id, avatar_url FROM users WHERE id = $1 FOR UPDATE) previous
+WHERE u.id = previous.id
+RETURNING previous.avatar_url;
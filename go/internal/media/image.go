@@ -0,0 +1,91 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+)
+
+// squareImage checks that data is an image this service takes, crops it to a centered square
+// and scales it down to at most size pixels a side. The result is PNG, which keeps logos'
+// transparency. Only the first frame of an animated GIF is kept.
+func squareImage(data []byte, size int) ([]byte, error) {
+	if len(data) > MaxUploadBytes {
+		return nil, fmt.Errorf("%w: larger than %d bytes", ErrInvalidImage, MaxUploadBytes)
+	}
+
+	// Check the dimensions before decoding, so a small file can't claim a huge image
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: not a JPEG, PNG or GIF", ErrInvalidImage)
+	}
+	if cfg.Width > MaxImageDimension || cfg.Height > MaxImageDimension {
+		return nil, fmt.Errorf("%w: %dx%d is larger than %dx%d", ErrInvalidImage, cfg.Width, cfg.Height, MaxImageDimension, MaxImageDimension)
+	}
+	if cfg.Width < MinImageDimension || cfg.Height < MinImageDimension {
+		return nil, fmt.Errorf("%w: %dx%d is smaller than %dx%d", ErrInvalidImage, cfg.Width, cfg.Height, MinImageDimension, MinImageDimension)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode %s: %v", ErrInvalidImage, format, err)
+	}
+
+	// Crop to the centered square
+	bounds := src.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	crop := image.Rect(0, 0, side, side)
+	square := image.NewRGBA(crop)
+	offset := image.Pt(bounds.Min.X+(bounds.Dx()-side)/2, bounds.Min.Y+(bounds.Dy()-side)/2)
+	draw.Draw(square, crop, src, offset, draw.Src)
+
+	out := square
+	if side > size {
+		out = scaleDown(square, size)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, out); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// scaleDown shrinks a square image to size pixels a side, averaging the block of source
+// pixels behind each destination pixel
+func scaleDown(src *image.RGBA, size int) *image.RGBA {
+	side := src.Bounds().Dx()
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+
+	for dy := 0; dy < size; dy++ {
+		sy0, sy1 := dy*side/size, (dy+1)*side/size
+		for dx := 0; dx < size; dx++ {
+			sx0, sx1 := dx*side/size, (dx+1)*side/size
+
+			var r, g, b, a, n int
+			for sy := sy0; sy < sy1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := sx0; sx < sx1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += int(p[0])
+					g += int(p[1])
+					b += int(p[2])
+					a += int(p[3])
+					n++
+				}
+			}
+
+			// Pixels are premultiplied by alpha, so averaging each channel keeps colors true
+			p := dst.Pix[dy*dst.Stride+dx*4:]
+			p[0] = uint8(r / n)
+			p[1] = uint8(g / n)
+			p[2] = uint8(b / n)
+			p[3] = uint8(a / n)
+		}
+	}
+	return dst
+}
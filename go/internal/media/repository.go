@@ -0,0 +1,73 @@
+package media
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/media/db"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	GetFantasyTeamOwnerID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	SwapFantasyTeamLogo(ctx context.Context, arg db.SwapFantasyTeamLogoParams) (sql.NullString, error)
+	SwapUserAvatar(ctx context.Context, arg db.SwapUserAvatarParams) (sql.NullString, error)
+}
+
+// Repository records where uploaded media is stored
+type Repository struct {
+	queries Querier
+}
+
+// NewRepository creates a new media repository
+func NewRepository(querier Querier) *Repository {
+	return &Repository{
+		queries: querier,
+	}
+}
+
+// GetFantasyTeamOwnerID retrieves the user who owns a fantasy team
+func (r *Repository) GetFantasyTeamOwnerID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	ownerID, err := r.queries.GetFantasyTeamOwnerID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, ErrNotFound
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get fantasy team owner: %w", err)
+	}
+	return ownerID, nil
+}
+
+// SetFantasyTeamLogo sets a fantasy team's logo URL, returning the one it replaced
+func (r *Repository) SetFantasyTeamLogo(ctx context.Context, id uuid.UUID, logoURL string) (*string, error) {
+	previous, err := r.queries.SwapFantasyTeamLogo(ctx, db.SwapFantasyTeamLogoParams{
+		ID:      id,
+		LogoUrl: sql.NullString{String: logoURL, Valid: true},
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set fantasy team logo: %w", err)
+	}
+	return sqlutil.FromSqlStringPtr(previous), nil
+}
+
+// SetUserAvatar sets a user's avatar URL, returning the one it replaced
+func (r *Repository) SetUserAvatar(ctx context.Context, id uuid.UUID, avatarURL string) (*string, error) {
+	previous, err := r.queries.SwapUserAvatar(ctx, db.SwapUserAvatarParams{
+		ID:        id,
+		AvatarUrl: sql.NullString{String: avatarURL, Valid: true},
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set user avatar: %w", err)
+	}
+	return sqlutil.FromSqlStringPtr(previous), nil
+}
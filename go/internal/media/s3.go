@@ -0,0 +1,250 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Config configures an S3Store
+type S3Config struct {
+	// Endpoint is the storage API's base URL, e.g. "https://s3.us-east-1.amazonaws.com", or
+	// "https://storage.googleapis.com" for Google Cloud Storage's S3-compatible XML API
+	Endpoint string
+	// Region signs requests. Google Cloud Storage accepts "auto".
+	Region string
+	Bucket string
+	// AccessKeyID and SecretAccessKey are an AWS access key, or a Google Cloud Storage HMAC key
+	AccessKeyID     string
+	SecretAccessKey string
+	// PublicBaseURL is where objects are loaded from, such as a CDN in front of the bucket.
+	// Defaults to the bucket's path on Endpoint, which needs the bucket to allow public reads.
+	PublicBaseURL string
+	// HTTPClient makes the storage API requests. Defaults to a client with a 30 second timeout.
+	HTTPClient *http.Client
+}
+
+// S3Store keeps media in a bucket of an S3-compatible object store, such as Amazon S3,
+// Google Cloud Storage through its XML API, or MinIO. Requests are signed with AWS
+// Signature Version 4 and address the bucket by path.
+type S3Store struct {
+	cfg        S3Config
+	bucketURL  string
+	publicURL  string
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewS3Store creates a store for the bucket in cfg
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	if cfg.Endpoint == "" || cfg.Region == "" || cfg.Bucket == "" {
+		return nil, fmt.Errorf("media store needs an endpoint, region and bucket")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("media store needs an access key")
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid media store endpoint %q", cfg.Endpoint)
+	}
+
+	bucketURL := strings.TrimSuffix(cfg.Endpoint, "/") + "/" + escapePath(cfg.Bucket)
+	publicURL := bucketURL
+	if cfg.PublicBaseURL != "" {
+		publicURL = strings.TrimSuffix(cfg.PublicBaseURL, "/")
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return &S3Store{
+		cfg:        cfg,
+		bucketURL:  bucketURL,
+		publicURL:  publicURL,
+		httpClient: httpClient,
+		now:        time.Now,
+	}, nil
+}
+
+// Put uploads data under key. Keys name their content, so the object is marked cacheable
+// for good.
+func (s *S3Store) Put(ctx context.Context, key, contentType string, data []byte) error {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	header.Set("Cache-Control", immutableCacheControl)
+	return s.do(ctx, http.MethodPut, key, header, data)
+}
+
+// Delete removes the object under key. S3 answers deletes of missing objects with success.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	return s.do(ctx, http.MethodDelete, key, http.Header{}, nil)
+}
+
+// List returns the keys of the objects whose keys start with prefix, following
+// ListObjectsV2's pages
+func (s *S3Store) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+	for {
+		body, err := s.send(ctx, http.MethodGet, s.bucketURL+"?"+encodeQuery(query), "list "+prefix, http.Header{}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			Contents []struct {
+				Key string
+			}
+			IsTruncated           bool
+			NextContinuationToken string
+		}
+		if err := xml.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("failed to read media store listing: %w", err)
+		}
+		for _, object := range page.Contents {
+			keys = append(keys, object.Key)
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return keys, nil
+		}
+		query.Set("continuation-token", page.NextContinuationToken)
+	}
+}
+
+// URL is where the object under key is loaded from
+func (s *S3Store) URL(key string) string {
+	return s.publicURL + "/" + escapePath(key)
+}
+
+// do sends a signed request for the object under key
+func (s *S3Store) do(ctx context.Context, method, key string, header http.Header, body []byte) error {
+	_, err := s.send(ctx, method, s.bucketURL+"/"+escapePath(key), key, header, body)
+	return err
+}
+
+// send sends a signed request to target, returning the response body. What names the
+// request in errors.
+func (s *S3Store) send(ctx context.Context, method, target, what string, header http.Header, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build media store request: %w", err)
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	s.sign(req, body)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("media store request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("media store %s %s returned %d: %s", method, what, resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media store response: %w", err)
+	}
+	return respBody, nil
+}
+
+// sign adds AWS Signature Version 4 headers to req, signing every header it carries
+func (s *S3Store) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	// Canonical headers: lowercase names in order, with host, which Go sends from the URL
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.cfg.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// encodeQuery encodes a query string as Signature Version 4 expects it signed: sorted by
+// name, with spaces as %20
+func encodeQuery(query url.Values) string {
+	return strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+// escapePath percent-encodes everything in an object path but unreserved characters and
+// slashes, as Signature Version 4 expects of S3 paths
+func escapePath(path string) string {
+	var escaped strings.Builder
+	for _, b := range []byte(path) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9',
+			b == '-', b == '_', b == '.', b == '~', b == '/':
+			escaped.WriteByte(b)
+		default:
+			fmt.Fprintf(&escaped, "%%%02X", b)
+		}
+	}
+	return escaped.String()
+}
@@ -0,0 +1,99 @@
+package media
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	mediav1 "github.com/mcdev12/dynasty/go/internal/genproto/media/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/media/v1/mediav1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+)
+
+// MediaApp defines what the service layer needs from the media application
+type MediaApp interface {
+	UploadTeamLogo(ctx context.Context, req UploadTeamLogoRequest) (string, error)
+	UploadUserAvatar(ctx context.Context, req UploadUserAvatarRequest) (string, error)
+}
+
+// Service implements the MediaService gRPC interface. Uploads need a signed in user.
+type Service struct {
+	app MediaApp
+}
+
+// NewService creates a new media gRPC service
+func NewService(app MediaApp) *Service {
+	return &Service{
+		app: app,
+	}
+}
+
+// Verify that Service implements the MediaServiceHandler interface
+var _ mediav1connect.MediaServiceHandler = (*Service)(nil)
+
+// UploadTeamLogo sets a fantasy team's logo
+func (s *Service) UploadTeamLogo(ctx context.Context, req *connect.Request[mediav1.UploadTeamLogoRequest]) (*connect.Response[mediav1.UploadTeamLogoResponse], error) {
+	teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("sign in to change your team's logo"))
+	}
+
+	logoURL, err := s.app.UploadTeamLogo(ctx, UploadTeamLogoRequest{
+		FantasyTeamID: teamID,
+		Image:         req.Msg.Image,
+		UploadedBy:    &actingUser,
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&mediav1.UploadTeamLogoResponse{
+		LogoUrl: logoURL,
+	}), nil
+}
+
+// UploadUserAvatar sets a user's avatar
+func (s *Service) UploadUserAvatar(ctx context.Context, req *connect.Request[mediav1.UploadUserAvatarRequest]) (*connect.Response[mediav1.UploadUserAvatarResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("sign in to change your avatar"))
+	}
+
+	avatarURL, err := s.app.UploadUserAvatar(ctx, UploadUserAvatarRequest{
+		UserID:     userID,
+		Image:      req.Msg.Image,
+		UploadedBy: &actingUser,
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&mediav1.UploadUserAvatarResponse{
+		AvatarUrl: avatarURL,
+	}), nil
+}
+
+// toConnectError maps app errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidImage):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrNotTeamOwner), errors.Is(err, ErrNotSelf):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -0,0 +1,23 @@
+package media
+
+import (
+	"context"
+	"strings"
+)
+
+// Store keeps uploaded media where browsers can load it from
+type Store interface {
+	// Put stores data under key, replacing anything already there
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// Delete removes the object under key. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+	// URL is where browsers load the object under key from
+	URL(key string) string
+}
+
+// storedKey returns the key of an object in store from its URL, or false if the URL points
+// somewhere else, such as a logo linked before uploads existed
+func storedKey(store Store, url string) (string, bool) {
+	key, ok := strings.CutPrefix(url, store.URL(""))
+	return key, ok && key != ""
+}
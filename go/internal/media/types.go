@@ -0,0 +1,51 @@
+package media
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxUploadBytes is the largest image file accepted
+	MaxUploadBytes = 5 << 20
+	// MaxImageDimension is the widest or tallest image accepted, in pixels
+	MaxImageDimension = 4096
+	// MinImageDimension is the narrowest or shortest image accepted, in pixels
+	MinImageDimension = 32
+
+	// TeamLogoSize is the side of the square team logos are scaled down to, in pixels
+	TeamLogoSize = 256
+	// AvatarSize is the side of the square user avatars are scaled down to, in pixels
+	AvatarSize = 256
+
+	// immutableCacheControl marks stored media cacheable for good. Keys name their content,
+	// so a new image always gets a new URL.
+	immutableCacheControl = "public, max-age=31536000, immutable"
+)
+
+// ErrInvalidImage is returned for an upload that isn't an image this service takes
+var ErrInvalidImage = errors.New("invalid image")
+
+// ErrNotFound is returned when the team or user an upload is for does not exist
+var ErrNotFound = errors.New("not found")
+
+// ErrNotTeamOwner is returned when someone uploads a logo for a team they don't own
+var ErrNotTeamOwner = errors.New("only the team's owner can do this")
+
+// ErrNotSelf is returned when someone uploads an avatar for another user
+var ErrNotSelf = errors.New("cannot change another user's avatar")
+
+// UploadTeamLogoRequest represents a request to set a fantasy team's logo
+type UploadTeamLogoRequest struct {
+	FantasyTeamID uuid.UUID  `json:"fantasy_team_id"`
+	Image         []byte     `json:"-"`
+	UploadedBy    *uuid.UUID `json:"uploaded_by,omitempty"` // nil for trusted callers, who may set any team's logo
+}
+
+// UploadUserAvatarRequest represents a request to set a user's avatar
+type UploadUserAvatarRequest struct {
+	UserID     uuid.UUID  `json:"user_id"`
+	Image      []byte     `json:"-"`
+	UploadedBy *uuid.UUID `json:"uploaded_by,omitempty"` // nil for trusted callers, who may set any user's avatar
+}
@@ -1,7 +1,9 @@
 package models
 
 import (
+	"fmt"
 	"github.com/google/uuid"
+	"slices"
 	"time"
 )
 
@@ -12,8 +14,53 @@ const (
 	DraftTypeSnake   DraftType = "SNAKE"
 	DraftTypeAuction DraftType = "AUCTION"
 	DraftTypeRookie  DraftType = "ROOKIE"
+	// DraftTypeExpansion stocks the teams in its draft order, teams added to a running league,
+	// with players the league's other teams left unprotected
+	DraftTypeExpansion DraftType = "EXPANSION"
+	// DraftTypeDispersal shares out a folded team's players among the teams in its draft order
+	DraftTypeDispersal DraftType = "DISPERSAL"
 )
 
+// DraftOrderMode defines how the draft order is applied round by round in snake and rookie drafts.
+type DraftOrderMode string
+
+const (
+	DraftOrderModeSnake              DraftOrderMode = "SNAKE"                // every even round runs in reverse
+	DraftOrderModeThirdRoundReversal DraftOrderMode = "THIRD_ROUND_REVERSAL" // round 3 repeats round 2's order, then snaking continues
+	DraftOrderModeLinear             DraftOrderMode = "LINEAR"               // every round runs in draft order
+)
+
+// roundReversals decide, for each order mode, whether a round runs in reverse draft order
+var roundReversals = map[DraftOrderMode]func(round int) bool{
+	DraftOrderModeSnake: func(round int) bool {
+		return round%2 == 0
+	},
+	DraftOrderModeThirdRoundReversal: func(round int) bool {
+		if round < 3 {
+			return round%2 == 0
+		}
+		return round%2 == 1
+	},
+	DraftOrderModeLinear: func(int) bool {
+		return false
+	},
+}
+
+// Valid reports whether m is a known order mode
+func (m DraftOrderMode) Valid() bool {
+	_, ok := roundReversals[m]
+	return ok
+}
+
+// ReversesRound reports whether round runs in reverse draft order. Unknown modes snake.
+func (m DraftOrderMode) ReversesRound(round int) bool {
+	reversal, ok := roundReversals[m]
+	if !ok {
+		reversal = roundReversals[DraftOrderModeSnake]
+	}
+	return reversal(round)
+}
+
 // DraftStatus defines the status of a draft.
 type DraftStatus string
 
@@ -25,16 +72,172 @@ const (
 	DraftStatusCancelled  DraftStatus = "CANCELLED"
 )
 
-// DraftSettings holds JSONB configuration for drafts.
+// DraftSettings holds JSONB configuration for drafts. Settings of a single draft type live in
+// the one of Snake, Auction and Rookie matching the draft's type; the others are nil.
 type DraftSettings struct {
-	Rounds               int         `json:"rounds"`
-	TimePerPickSec       int         `json:"time_per_pick_sec"`
-	DraftOrder           []uuid.UUID `json:"draft_order,omitempty"`
-	ThirdRoundReversal   bool        `json:"third_round_reversal,omitempty"`
-	BudgetPerTeam        *float64    `json:"budget_per_team,omitempty"`         // auction
-	MinBidIncrement      *float64    `json:"min_bid_increment,omitempty"`       // auction
-	TimePerNominationSec *int        `json:"time_per_nomination_sec,omitempty"` // auction
-	// Extend with more settings as needed
+	Rounds              int          `json:"rounds"`
+	TimePerPickSec      int          `json:"time_per_pick_sec"`
+	DraftOrder          []uuid.UUID  `json:"draft_order,omitempty"`
+	RoundTimers         []RoundTimer `json:"round_timers,omitempty"`
+	PauseWindow         *PauseWindow `json:"pause_window,omitempty"`
+	DeferPicksOnTimeout bool         `json:"defer_picks_on_timeout,omitempty"` // skip expired picks so the team can make them late
+	// Forfeit picks of teams with a full roster as they come on the clock rather than once it runs out
+	SkipPicksWithoutRosterSpace bool `json:"skip_picks_without_roster_space,omitempty"`
+	// Percentages of the pick clock left at which the team on the clock is warned; empty uses the defaults
+	ClockWarningPercents []int `json:"clock_warning_percents,omitempty"`
+	// Only start the draft once every team has marked itself ready in the lobby, unless the
+	// commissioner overrides it
+	RequireAllTeamsReady bool `json:"require_all_teams_ready,omitempty"`
+	// Expansion drafts: how many players each existing team may protect, and the most players
+	// the expansion teams may take from any one of them (0 for no limit)
+	ProtectedPlayersPerTeam int `json:"protected_players_per_team,omitempty"`
+	MaxPlayersLostPerTeam   int `json:"max_players_lost_per_team,omitempty"`
+	// Lets the draft's managers vote to pause it; nil when the league doesn't allow pause votes
+	PauseVote *PauseVoteSettings `json:"pause_vote,omitempty"`
+	// Pauses the draft while its commissioner is away from the draft room; nil keeps it running
+	CommissionerDisconnectPause *CommissionerDisconnectPauseSettings `json:"commissioner_disconnect_pause,omitempty"`
+	// Lets teams trade the pick on the clock while the draft runs; nil when the league doesn't allow it
+	LivePickTrades *LivePickTradeSettings `json:"live_pick_trades,omitempty"`
+
+	Snake   *SnakeSettings   `json:"snake,omitempty"`
+	Auction *AuctionSettings `json:"auction,omitempty"`
+	Rookie  *RookieSettings  `json:"rookie,omitempty"`
+}
+
+// SnakeSettings are the settings only snake drafts have
+type SnakeSettings struct {
+	OrderMode DraftOrderMode `json:"order_mode,omitempty"` // DraftOrderModeSnake when empty
+}
+
+// Validate checks that the order mode is known
+func (s SnakeSettings) Validate() error {
+	return validateOrderMode(s.OrderMode)
+}
+
+// RookieSettings are the settings only rookie drafts have
+type RookieSettings struct {
+	OrderMode DraftOrderMode `json:"order_mode,omitempty"` // DraftOrderModeSnake when empty
+}
+
+// Validate checks that the order mode is known
+func (s RookieSettings) Validate() error {
+	return validateOrderMode(s.OrderMode)
+}
+
+func validateOrderMode(mode DraftOrderMode) error {
+	if mode != "" && !mode.Valid() {
+		return fmt.Errorf("invalid order_mode: %s", mode)
+	}
+	return nil
+}
+
+// AuctionSettings are the settings only auction drafts have
+type AuctionSettings struct {
+	BudgetPerTeam        float64 `json:"budget_per_team"`
+	MinBidIncrement      float64 `json:"min_bid_increment"`
+	TimePerNominationSec int     `json:"time_per_nomination_sec,omitempty"` // 0 for the auction default
+}
+
+// Validate checks that the budget and bid increment are set and the nomination clock isn't negative
+func (s AuctionSettings) Validate() error {
+	if s.BudgetPerTeam <= 0 {
+		return fmt.Errorf("budget_per_team must be greater than 0")
+	}
+	if s.MinBidIncrement <= 0 {
+		return fmt.Errorf("min_bid_increment must be greater than 0")
+	}
+	if s.TimePerNominationSec < 0 {
+		return fmt.Errorf("time_per_nomination_sec cannot be negative")
+	}
+	return nil
+}
+
+// ValidateTypeSettings checks the settings of a draft of draftType's type: auction drafts need
+// their auction settings, snake and rookie drafts may leave theirs out for the defaults, and
+// settings of any other type are rejected.
+func (s DraftSettings) ValidateTypeSettings(draftType DraftType) error {
+	if s.Snake != nil && draftType != DraftTypeSnake {
+		return fmt.Errorf("snake settings are only for snake drafts")
+	}
+	if s.Auction != nil && draftType != DraftTypeAuction {
+		return fmt.Errorf("auction settings are only for auction drafts")
+	}
+	if s.Rookie != nil && draftType != DraftTypeRookie {
+		return fmt.Errorf("rookie settings are only for rookie drafts")
+	}
+
+	switch {
+	case s.Snake != nil:
+		if err := s.Snake.Validate(); err != nil {
+			return fmt.Errorf("snake: %w", err)
+		}
+	case s.Rookie != nil:
+		if err := s.Rookie.Validate(); err != nil {
+			return fmt.Errorf("rookie: %w", err)
+		}
+	case s.Auction != nil:
+		if err := s.Auction.Validate(); err != nil {
+			return fmt.Errorf("auction: %w", err)
+		}
+	case draftType == DraftTypeAuction:
+		return fmt.Errorf("auction settings are required for auction drafts")
+	}
+	return nil
+}
+
+// EffectiveOrderMode returns the order mode picks are laid out in: the one in the snake or
+// rookie settings, or DraftOrderModeSnake when they don't set one
+func (s DraftSettings) EffectiveOrderMode() DraftOrderMode {
+	var mode DraftOrderMode
+	switch {
+	case s.Snake != nil:
+		mode = s.Snake.OrderMode
+	case s.Rookie != nil:
+		mode = s.Rookie.OrderMode
+	}
+	if mode == "" {
+		return DraftOrderModeSnake
+	}
+	return mode
+}
+
+// DefaultClockWarningPercents are the pick clock warnings of drafts that don't set their own
+var DefaultClockWarningPercents = []int{50, 20, 5}
+
+// EffectiveClockWarningPercents returns the percentages of the pick clock left at which the
+// team on the clock is warned, largest first. The last one is the final warning.
+func (s DraftSettings) EffectiveClockWarningPercents() []int {
+	percents := DefaultClockWarningPercents
+	if len(s.ClockWarningPercents) > 0 {
+		percents = s.ClockWarningPercents
+	}
+	sorted := slices.Clone(percents)
+	slices.SortFunc(sorted, func(a, b int) int { return b - a })
+	return sorted
+}
+
+// RoundTimer overrides TimePerPickSec for rounds FromRound through ToRound.
+// A ToRound of 0 runs through the last round.
+type RoundTimer struct {
+	FromRound      int `json:"from_round"`
+	ToRound        int `json:"to_round,omitempty"`
+	TimePerPickSec int `json:"time_per_pick_sec"`
+}
+
+// Covers reports whether the override applies to round
+func (t RoundTimer) Covers(round int) bool {
+	return round >= t.FromRound && (t.ToRound == 0 || round <= t.ToRound)
+}
+
+// TimePerPickForRound returns the pick clock in seconds for a round,
+// falling back to TimePerPickSec when no override covers it
+func (s DraftSettings) TimePerPickForRound(round int) int {
+	for _, t := range s.RoundTimers {
+		if t.Covers(round) {
+			return t.TimePerPickSec
+		}
+	}
+	return s.TimePerPickSec
 }
 
 // Draft represents a draft instance.
@@ -50,4 +253,129 @@ type Draft struct {
 	CreatedAt    time.Time     `json:"created_at"`
 	UpdatedAt    time.Time     `json:"updated_at"`
 	NextDeadline *time.Time    `json:"next_deadline,omitempty"`
+	// SandboxOf is the draft a sandbox draft rehearses; nil for real drafts
+	SandboxOf *uuid.UUID `json:"sandbox_of,omitempty"`
+	// SeasonID is the league season the draft is held in
+	SeasonID *uuid.UUID `json:"season_id,omitempty"`
+}
+
+// PauseWindow is a daily window, e.g. overnight, during which an in-progress draft is
+// paused automatically. Start and End are "HH:MM" wall-clock times in Timezone (an IANA
+// name, UTC when empty); a window whose End is before its Start runs past midnight.
+type PauseWindow struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Validate checks that the window's times and timezone parse and that it isn't empty
+func (w PauseWindow) Validate() error {
+	start, end, _, err := w.parse()
+	if err != nil {
+		return err
+	}
+	if start == end {
+		return fmt.Errorf("start and end must differ")
+	}
+	return nil
+}
+
+// Contains reports whether t falls inside the window
+func (w PauseWindow) Contains(t time.Time) bool {
+	start, end, loc, err := w.parse()
+	if err != nil {
+		return false
+	}
+	local := t.In(loc)
+	now := clockTime{local.Hour(), local.Minute()}
+	if start.before(end) {
+		return !now.before(start) && now.before(end)
+	}
+	return !now.before(start) || now.before(end)
+}
+
+// NextStart returns the first time after t that the window opens
+func (w PauseWindow) NextStart(t time.Time) time.Time {
+	start, _, loc, err := w.parse()
+	if err != nil {
+		return time.Time{}
+	}
+	return start.next(t, loc)
+}
+
+// NextEnd returns the first time after t that the window closes
+func (w PauseWindow) NextEnd(t time.Time) time.Time {
+	_, end, loc, err := w.parse()
+	if err != nil {
+		return time.Time{}
+	}
+	return end.next(t, loc)
+}
+
+func (w PauseWindow) parse() (start, end clockTime, loc *time.Location, err error) {
+	if start, err = parseClockTime(w.Start); err != nil {
+		return start, end, nil, fmt.Errorf("invalid start: %w", err)
+	}
+	if end, err = parseClockTime(w.End); err != nil {
+		return start, end, nil, fmt.Errorf("invalid end: %w", err)
+	}
+	if loc, err = time.LoadLocation(w.Timezone); err != nil {
+		return start, end, nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+	return start, end, loc, nil
+}
+
+// DefaultCommissionerDisconnectWait is how long an in-progress draft runs without its
+// commissioner connected before it's paused, unless the draft sets its own wait
+const DefaultCommissionerDisconnectWait = 5 * time.Minute
+
+// CommissionerDisconnectPauseSettings pauses an in-progress draft once its commissioner has
+// had no gateway connection to the draft room for AfterMinutes, and resumes it when they
+// reconnect
+type CommissionerDisconnectPauseSettings struct {
+	AfterMinutes int `json:"after_minutes,omitempty"` // 0 for DefaultCommissionerDisconnectWait
+}
+
+// Validate checks that the wait is in range
+func (s CommissionerDisconnectPauseSettings) Validate() error {
+	if s.AfterMinutes < 0 || s.AfterMinutes > 60 {
+		return fmt.Errorf("after_minutes must be between 0 and 60")
+	}
+	return nil
+}
+
+// Wait returns how long the commissioner may be disconnected before the draft is paused
+func (s CommissionerDisconnectPauseSettings) Wait() time.Duration {
+	if s.AfterMinutes == 0 {
+		return DefaultCommissionerDisconnectWait
+	}
+	return time.Duration(s.AfterMinutes) * time.Minute
+}
+
+// clockTime is a wall-clock time of day
+type clockTime struct {
+	hour, minute int
+}
+
+func parseClockTime(s string) (clockTime, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return clockTime{}, err
+	}
+	return clockTime{t.Hour(), t.Minute()}, nil
+}
+
+func (c clockTime) before(other clockTime) bool {
+	return c.hour < other.hour || (c.hour == other.hour && c.minute < other.minute)
+}
+
+// next returns the first occurrence of c in loc strictly after t
+func (c clockTime) next(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	for day := 0; ; day++ {
+		candidate := time.Date(local.Year(), local.Month(), local.Day()+day, c.hour, c.minute, 0, 0, loc)
+		if candidate.After(t) {
+			return candidate
+		}
+	}
 }
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// AbandonedPickHandling defines what happens to the remaining picks of an abandoned team.
+type AbandonedPickHandling string
+
+const (
+	AbandonedPickHandlingAutoPick AbandonedPickHandling = "AUTO_PICK" // picks are made for the team as soon as they come up
+	AbandonedPickHandlingSkip     AbandonedPickHandling = "SKIP"      // picks are forfeited and the draft moves past them
+)
+
+// AbandonedTeam is a team the commissioner took out of a draft after its owner stopped
+// taking part. Its owner can no longer make picks until the team is restored.
+type AbandonedTeam struct {
+	DraftID       uuid.UUID             `json:"draft_id"`
+	FantasyTeamID uuid.UUID             `json:"fantasy_team_id"`
+	PickHandling  AbandonedPickHandling `json:"pick_handling"`
+	Reason        *string               `json:"reason,omitempty"`
+	AbandonedBy   *uuid.UUID            `json:"abandoned_by,omitempty"`
+	AbandonedAt   time.Time             `json:"abandoned_at"`
+}
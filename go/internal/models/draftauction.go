@@ -0,0 +1,63 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// AuctionLotStatus defines the status of a player up for auction.
+type AuctionLotStatus string
+
+const (
+	AuctionLotStatusOpen AuctionLotStatus = "OPEN"
+	AuctionLotStatusSold AuctionLotStatus = "SOLD"
+)
+
+// AuctionBidKind defines how a bid was placed on a lot.
+type AuctionBidKind string
+
+const (
+	AuctionBidKindOpening AuctionBidKind = "OPENING" // the nominating team's opening bid
+	AuctionBidKindProxy   AuctionBidKind = "PROXY"
+)
+
+// DraftAuction is the progress of an auction draft: teams take turns, in draft order,
+// nominating a player, and every team may then bid on the nominated player.
+type DraftAuction struct {
+	DraftID            uuid.UUID   `json:"draft_id"`
+	NominationTurn     int         `json:"nomination_turn"`               // index into the draft order
+	NominatingTeamID   *uuid.UUID  `json:"nominating_team_id,omitempty"`  // nil once every roster is full
+	NominationDeadline *time.Time  `json:"nomination_deadline,omitempty"` // nil while a lot is open
+	OpenLot            *AuctionLot `json:"open_lot,omitempty"`
+	StartedAt          time.Time   `json:"started_at"`
+}
+
+// AuctionLot is a nominated player up for auction. Bids are proxy bids, so the current
+// price is what the leading team pays, not the most it offered.
+type AuctionLot struct {
+	ID            uuid.UUID        `json:"id"`
+	DraftID       uuid.UUID        `json:"draft_id"`
+	PlayerID      uuid.UUID        `json:"player_id"`
+	NominatedBy   uuid.UUID        `json:"nominated_by"`
+	Status        AuctionLotStatus `json:"status"`
+	CurrentPrice  float64          `json:"current_price"`
+	LeadingTeamID uuid.UUID        `json:"leading_team_id"`
+	EndsAt        time.Time        `json:"ends_at"`
+	OpenedAt      time.Time        `json:"opened_at"`
+	SoldAt        *time.Time       `json:"sold_at,omitempty"`
+	PickID        *uuid.UUID       `json:"pick_id,omitempty"` // the winner's pick, once sold
+}
+
+// AuctionBid is a bid in a lot's history: the most the team offered as of the bid, and the
+// price and leader the lot was left at once it was applied.
+type AuctionBid struct {
+	ID            int64          `json:"id"`
+	LotID         uuid.UUID      `json:"lot_id"`
+	PlayerID      uuid.UUID      `json:"player_id"`
+	FantasyTeamID uuid.UUID      `json:"fantasy_team_id"`
+	Kind          AuctionBidKind `json:"kind"`
+	MaxAmount     float64        `json:"max_amount"`
+	Price         float64        `json:"price"`
+	LeadingTeamID uuid.UUID      `json:"leading_team_id"`
+	PlacedAt      time.Time      `json:"placed_at"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DraftCoManager is a user besides a team's owner who may make the team's picks in a draft
+type DraftCoManager struct {
+	DraftID       uuid.UUID  `json:"draft_id"`
+	FantasyTeamID uuid.UUID  `json:"fantasy_team_id"`
+	UserID        uuid.UUID  `json:"user_id"`
+	AddedBy       *uuid.UUID `json:"added_by,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
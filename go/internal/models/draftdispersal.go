@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DispersalDraft pools the players of a team that folded into a draft among its league's
+// remaining teams
+type DispersalDraft struct {
+	DraftID      uuid.UUID  `json:"draft_id"`
+	FoldedTeamID uuid.UUID  `json:"folded_team_id"`
+	CreatedBy    *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	// CompletedAt is set once the folded team's undrafted players have been released and its
+	// future picks shared out
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// DispersalPoolPlayer is a folded team's player its dispersal draft may still select
+type DispersalPoolPlayer struct {
+	PlayerID uuid.UUID `json:"player_id"`
+	FullName string    `json:"full_name"`
+	Position *string   `json:"position,omitempty"`
+}
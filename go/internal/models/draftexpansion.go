@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExpansionProtectionList is the players an existing team keeps out of an expansion draft
+type ExpansionProtectionList struct {
+	DraftID       uuid.UUID   `json:"draft_id"`
+	FantasyTeamID uuid.UUID   `json:"fantasy_team_id"`
+	PlayerIDs     []uuid.UUID `json:"player_ids"`
+	SubmittedBy   *uuid.UUID  `json:"submitted_by,omitempty"`
+	SubmittedAt   time.Time   `json:"submitted_at"`
+	// PlayersWithheld is set when PlayerIDs is left empty because the caller may not see the
+	// list until the draft starts
+	PlayersWithheld bool `json:"players_withheld,omitempty"`
+}
+
+// ExpansionPoolPlayer is a player the expansion teams may still select: rostered by an existing
+// team that neither protected them nor has lost as many players as it may
+type ExpansionPoolPlayer struct {
+	PlayerID      uuid.UUID `json:"player_id"`
+	FullName      string    `json:"full_name"`
+	Position      *string   `json:"position,omitempty"`
+	FantasyTeamID uuid.UUID `json:"fantasy_team_id"` // the team the player would be taken from
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HistoricalDraft is a draft a league held before it moved to the platform, imported with its
+// picks as a completed draft
+type HistoricalDraft struct {
+	DraftID    uuid.UUID  `json:"draft_id"`
+	LeagueID   uuid.UUID  `json:"league_id"`
+	Season     string     `json:"season"`
+	DraftType  DraftType  `json:"draft_type"`
+	Source     *string    `json:"source,omitempty"` // where the results came from, e.g. "sleeper"
+	ImportedBy *uuid.UUID `json:"imported_by,omitempty"`
+	ImportedAt time.Time  `json:"imported_at"`
+	Picks      int        `json:"picks"`
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TeamReadiness is whether a team has marked itself ready in a draft's lobby
+type TeamReadiness struct {
+	FantasyTeamID uuid.UUID  `json:"fantasy_team_id"`
+	Ready         bool       `json:"ready"`
+	UpdatedBy     *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"` // nil until the team first marks itself
+}
+
+// DraftLobby is where a draft's teams gather before it starts, marking themselves ready
+type DraftLobby struct {
+	DraftID         uuid.UUID       `json:"draft_id"`
+	Teams           []TeamReadiness `json:"teams"` // in draft order
+	RequireAllReady bool            `json:"require_all_ready"`
+}
+
+// NewDraftLobby lays out the readiness of every team in a draft's order. Teams that haven't
+// marked themselves yet aren't ready, and marks left by teams no longer in the order are dropped.
+func NewDraftLobby(draft *Draft, marks []TeamReadiness) *DraftLobby {
+	byTeam := make(map[uuid.UUID]TeamReadiness, len(marks))
+	for _, mark := range marks {
+		byTeam[mark.FantasyTeamID] = mark
+	}
+
+	teams := make([]TeamReadiness, len(draft.Settings.DraftOrder))
+	for i, teamID := range draft.Settings.DraftOrder {
+		mark, ok := byTeam[teamID]
+		if !ok {
+			mark = TeamReadiness{FantasyTeamID: teamID}
+		}
+		teams[i] = mark
+	}
+
+	return &DraftLobby{
+		DraftID:         draft.ID,
+		Teams:           teams,
+		RequireAllReady: draft.Settings.RequireAllTeamsReady,
+	}
+}
+
+// ReadyCount returns how many of the lobby's teams are ready
+func (l *DraftLobby) ReadyCount() int {
+	count := 0
+	for _, team := range l.Teams {
+		if team.Ready {
+			count++
+		}
+	}
+	return count
+}
+
+// AllReady reports whether every team in the lobby is ready
+func (l *DraftLobby) AllReady() bool {
+	return l.ReadyCount() == len(l.Teams)
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPauseVoteWindow is how long a pause vote stays open unless the draft sets its own window
+	DefaultPauseVoteWindow = 2 * time.Minute
+	// DefaultPauseVoteThresholdPercent makes a pause vote a simple majority
+	DefaultPauseVoteThresholdPercent = 50
+)
+
+// PauseVoteSettings sets how a vote by a draft's managers to pause it is held. A vote passes
+// once more than ThresholdPercent of the teams still drafting vote for it within the window.
+type PauseVoteSettings struct {
+	WindowSec        int `json:"window_sec,omitempty"`        // 0 for DefaultPauseVoteWindow
+	ThresholdPercent int `json:"threshold_percent,omitempty"` // 0 for DefaultPauseVoteThresholdPercent
+}
+
+// Validate checks that the window and threshold are in range
+func (s PauseVoteSettings) Validate() error {
+	if s.WindowSec < 0 || s.WindowSec > 3600 {
+		return fmt.Errorf("window_sec must be between 0 and 3600")
+	}
+	if s.ThresholdPercent < 0 || s.ThresholdPercent > 99 {
+		return fmt.Errorf("threshold_percent must be between 0 and 99")
+	}
+	return nil
+}
+
+// Window returns how long a vote stays open
+func (s PauseVoteSettings) Window() time.Duration {
+	if s.WindowSec == 0 {
+		return DefaultPauseVoteWindow
+	}
+	return time.Duration(s.WindowSec) * time.Second
+}
+
+// VotesNeeded returns how many of the eligible teams must vote for a pause for it to pass
+func (s PauseVoteSettings) VotesNeeded(eligibleTeams int) int {
+	threshold := s.ThresholdPercent
+	if threshold == 0 {
+		threshold = DefaultPauseVoteThresholdPercent
+	}
+	return eligibleTeams*threshold/100 + 1
+}
+
+// PauseVoteStatus defines where a pause vote stands.
+type PauseVoteStatus string
+
+const (
+	PauseVoteStatusOpen   PauseVoteStatus = "OPEN"
+	PauseVoteStatusPassed PauseVoteStatus = "PASSED"
+	PauseVoteStatusFailed PauseVoteStatus = "FAILED"
+)
+
+// PauseVote is a vote by a draft's managers to pause it, one ballot per team
+type PauseVote struct {
+	ID               uuid.UUID       `json:"id"`
+	DraftID          uuid.UUID       `json:"draft_id"`
+	InitiatingTeamID uuid.UUID       `json:"initiating_team_id"`
+	InitiatedBy      *uuid.UUID      `json:"initiated_by,omitempty"`
+	Reason           *string         `json:"reason,omitempty"`
+	Status           PauseVoteStatus `json:"status"`
+	VotesFor         int             `json:"votes_for"`
+	VotesAgainst     int             `json:"votes_against"`
+	EligibleTeams    int             `json:"eligible_teams"` // teams still drafting when the vote opened
+	VotesNeeded      int             `json:"votes_needed"`
+	OpenedAt         time.Time       `json:"opened_at"`
+	ClosesAt         time.Time       `json:"closes_at"`
+	ClosedAt         *time.Time      `json:"closed_at,omitempty"`
+}
+
+// Reached reports whether enough teams voted for a pause for the vote to pass
+func (v *PauseVote) Reached() bool {
+	return v.VotesFor >= v.VotesNeeded
+}
+
+// Unreachable reports whether the teams yet to vote can no longer carry the vote
+func (v *PauseVote) Unreachable() bool {
+	return v.EligibleTeams-v.VotesAgainst < v.VotesNeeded
+}
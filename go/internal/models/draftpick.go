@@ -17,4 +17,6 @@ type DraftPick struct {
 	PickedAt      *time.Time `json:"picked_at,omitempty"`
 	AuctionAmount *float64   `json:"auction_amount,omitempty"` // auction support
 	KeeperPick    bool       `json:"keeper_pick"`              // indicates if used on keeper
+	Forfeited     bool       `json:"forfeited,omitempty"`      // given up by a team abandoned mid-draft
+	SkippedAt     *time.Time `json:"skipped_at,omitempty"`     // clock ran out; the team can still make it late
 }
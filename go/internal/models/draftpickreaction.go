@@ -0,0 +1,59 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// PickReaction is an emoji left on a made pick from the draft room
+type PickReaction string
+
+const (
+	PickReactionFire     PickReaction = "🔥"
+	PickReactionThinking PickReaction = "🤔"
+)
+
+// PickReactions lists the reactions picks can get, in the order their counts are shown
+var PickReactions = []PickReaction{PickReactionFire, PickReactionThinking}
+
+// IsValid reports whether r is a known reaction
+func (r PickReaction) IsValid() bool {
+	switch r {
+	case PickReactionFire, PickReactionThinking:
+		return true
+	}
+	return false
+}
+
+// PickReactionCount is how many users left a reaction on a pick
+type PickReactionCount struct {
+	Reaction PickReaction `json:"reaction"`
+	Count    int          `json:"count"`
+}
+
+// PickReactionCounts are a pick's reaction counts, one for every reaction in PickReactions
+type PickReactionCounts struct {
+	PickID uuid.UUID           `json:"pick_id"`
+	Counts []PickReactionCount `json:"counts"`
+}
+
+// NewPickReactionCounts returns a pick's counts with every reaction at zero
+func NewPickReactionCounts(pickID uuid.UUID) PickReactionCounts {
+	counts := PickReactionCounts{
+		PickID: pickID,
+		Counts: make([]PickReactionCount, len(PickReactions)),
+	}
+	for i, reaction := range PickReactions {
+		counts.Counts[i] = PickReactionCount{Reaction: reaction}
+	}
+	return counts
+}
+
+// Set records how many users left a reaction, ignoring reactions no longer offered
+func (c PickReactionCounts) Set(reaction PickReaction, count int) {
+	for i := range c.Counts {
+		if c.Counts[i].Reaction == reaction {
+			c.Counts[i].Count = count
+			return
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultPickTradeNegotiationWindow is how long the pick clock stops for a live pick trade
+// offer unless the draft sets its own window
+const DefaultPickTradeNegotiationWindow = 2 * time.Minute
+
+// LivePickTradeSettings lets the team on the clock offer its pick to another team mid-draft.
+// The pick clock stops while the offer is open, for at most NegotiationWindowSec.
+type LivePickTradeSettings struct {
+	NegotiationWindowSec int `json:"negotiation_window_sec,omitempty"` // 0 for DefaultPickTradeNegotiationWindow
+}
+
+// Validate checks that the negotiation window is in range
+func (s LivePickTradeSettings) Validate() error {
+	if s.NegotiationWindowSec < 0 || s.NegotiationWindowSec > 600 {
+		return fmt.Errorf("negotiation_window_sec must be between 0 and 600")
+	}
+	return nil
+}
+
+// Window returns how long an offer stays open
+func (s LivePickTradeSettings) Window() time.Duration {
+	if s.NegotiationWindowSec == 0 {
+		return DefaultPickTradeNegotiationWindow
+	}
+	return time.Duration(s.NegotiationWindowSec) * time.Second
+}
+
+// PickTradeOfferStatus defines where a live pick trade offer stands.
+type PickTradeOfferStatus string
+
+const (
+	PickTradeOfferStatusOpen      PickTradeOfferStatus = "OPEN"
+	PickTradeOfferStatusAccepted  PickTradeOfferStatus = "ACCEPTED"
+	PickTradeOfferStatusDeclined  PickTradeOfferStatus = "DECLINED"
+	PickTradeOfferStatusWithdrawn PickTradeOfferStatus = "WITHDRAWN"
+	PickTradeOfferStatusExpired   PickTradeOfferStatus = "EXPIRED"
+)
+
+// PickTradeOffer is an offer of the pick on the clock from the team holding it to another
+// team in the draft, optionally for one of that team's later picks
+type PickTradeOffer struct {
+	ID              uuid.UUID            `json:"id"`
+	DraftID         uuid.UUID            `json:"draft_id"`
+	PickID          uuid.UUID            `json:"pick_id"`
+	FromTeamID      uuid.UUID            `json:"from_team_id"`
+	ToTeamID        uuid.UUID            `json:"to_team_id"`
+	RequestedPickID *uuid.UUID           `json:"requested_pick_id,omitempty"`
+	Message         *string              `json:"message,omitempty"`
+	ProposedBy      *uuid.UUID           `json:"proposed_by,omitempty"`
+	Status          PickTradeOfferStatus `json:"status"`
+	// ClockRemaining is what was left on the pick clock when the offer was made, nil for an
+	// untimed pick. The clock carries on from it once the offer closes.
+	ClockRemaining *time.Duration `json:"clock_remaining,omitempty"`
+	OpenedAt       time.Time      `json:"opened_at"`
+	ExpiresAt      time.Time      `json:"expires_at"`
+	ClosedAt       *time.Time     `json:"closed_at,omitempty"`
+	RespondedBy    *uuid.UUID     `json:"responded_by,omitempty"`
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DraftRecap sums up a completed draft: the picks that were the best values and biggest
+// reaches against the players' ranks, and how each team graded out on its players'
+// projections. It's generated once, when the draft completes.
+type DraftRecap struct {
+	DraftID        uuid.UUID        `json:"draft_id"`
+	LeagueID       uuid.UUID        `json:"league_id"`
+	Season         string           `json:"season"` // the rankings the picks were measured against
+	ScoringFormat  ScoringFormat    `json:"scoring_format"`
+	Superflex      bool             `json:"superflex"`
+	BestValues     []RecapPick      `json:"best_values"`     // biggest value first
+	BiggestReaches []RecapPick      `json:"biggest_reaches"` // biggest reach first
+	TeamGrades     []TeamDraftGrade `json:"team_grades"`     // best graded first
+	GeneratedAt    time.Time        `json:"generated_at"`
+}
+
+// RecapPick is a pick a draft recap singles out
+type RecapPick struct {
+	OverallPick    int       `json:"overall_pick"`
+	Round          int       `json:"round"`
+	Pick           int       `json:"pick"`
+	TeamID         uuid.UUID `json:"team_id"`
+	TeamName       string    `json:"team_name"`
+	PlayerID       uuid.UUID `json:"player_id"`
+	PlayerName     string    `json:"player_name"`
+	PlayerPosition string    `json:"player_position,omitempty"`
+	// ExpectedPick is where the player's rank put them among the draft's ranked picks, and
+	// PicksVsRank how many picks after it they went: positive for a value, negative for a reach
+	ExpectedPick int `json:"expected_pick"`
+	PicksVsRank  int `json:"picks_vs_rank"`
+}
+
+// TeamDraftGrade is how a team's draft graded out against the rest of the league
+type TeamDraftGrade struct {
+	TeamID          uuid.UUID  `json:"team_id"`
+	TeamName        string     `json:"team_name"`
+	Grade           string     `json:"grade"` // A+ down to D
+	Rank            int        `json:"rank"`  // by projected points, 1 for the most
+	ProjectedPoints float64    `json:"projected_points"`
+	PlayersDrafted  int        `json:"players_drafted"`  // keepers included
+	UnrankedPlayers int        `json:"unranked_players"` // players without a projection, counted as none
+	BestValue       *RecapPick `json:"best_value,omitempty"`
+}
+
+// LeagueDraftSummary is the statistics of a league's completed draft for its home page, rolled
+// up from the draft's picks when it completes
+type LeagueDraftSummary struct {
+	DraftID     uuid.UUID            `json:"draft_id"`
+	LeagueID    uuid.UUID            `json:"league_id"`
+	DraftType   DraftType            `json:"draft_type"`
+	Season      string               `json:"season"`
+	CompletedAt *time.Time           `json:"completed_at,omitempty"`
+	Teams       []TeamDraftStats     `json:"teams"`     // by team name
+	Positions   []PositionDraftStats `json:"positions"` // earliest drafted first
+	RefreshedAt time.Time            `json:"refreshed_at"`
+}
+
+// TeamDraftStats is a team's picks in a completed draft
+type TeamDraftStats struct {
+	TeamID         uuid.UUID           `json:"team_id"`
+	TeamName       string              `json:"team_name"`
+	PicksMade      int                 `json:"picks_made"` // keepers included
+	Autopicks      int                 `json:"autopicks"`
+	KeeperPicks    int                 `json:"keeper_picks"`
+	AvgOverallPick *float64            `json:"avg_overall_pick,omitempty"` // nil when the team made no picks
+	Positions      []TeamPositionStats `json:"positions"`                  // most drafted first
+}
+
+// TeamPositionStats is how many players of a position a team drafted
+type TeamPositionStats struct {
+	Position       string  `json:"position"` // empty for players without a position
+	Picks          int     `json:"picks"`
+	AvgOverallPick float64 `json:"avg_overall_pick"`
+}
+
+// PositionDraftStats is where a position went in a completed draft, its realized average draft
+// position among them
+type PositionDraftStats struct {
+	Position         string  `json:"position"`
+	Picks            int     `json:"picks"`
+	AvgOverallPick   float64 `json:"avg_overall_pick"`
+	FirstOverallPick int     `json:"first_overall_pick"`
+	LastOverallPick  int     `json:"last_overall_pick"`
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// SlotSelectionStatus defines the status of a pre-draft slot selection.
+type SlotSelectionStatus string
+
+const (
+	SlotSelectionStatusInProgress SlotSelectionStatus = "IN_PROGRESS"
+	SlotSelectionStatusCompleted  SlotSelectionStatus = "COMPLETED"
+)
+
+// DraftSlotSelection is a pre-draft phase in which teams take turns, in lottery
+// order, claiming the slot they will pick from in the draft.
+type DraftSlotSelection struct {
+	DraftID             uuid.UUID           `json:"draft_id"`
+	SelectionOrder      []uuid.UUID         `json:"selection_order"` // order teams choose in
+	TimePerSelectionSec int                 `json:"time_per_selection_sec"`
+	CurrentTurn         int                 `json:"current_turn"` // index into SelectionOrder
+	TurnDeadline        *time.Time          `json:"turn_deadline,omitempty"`
+	Status              SlotSelectionStatus `json:"status"`
+	Claims              []DraftSlotClaim    `json:"claims"`
+	StartedAt           time.Time           `json:"started_at"`
+	CompletedAt         *time.Time          `json:"completed_at,omitempty"`
+}
+
+// DraftSlotClaim is a draft slot claimed by a team during slot selection.
+type DraftSlotClaim struct {
+	FantasyTeamID uuid.UUID `json:"fantasy_team_id"`
+	Slot          int       `json:"slot"`          // 1-based position in the draft order
+	AutoAssigned  bool      `json:"auto_assigned"` // assigned because the team's turn expired
+	ClaimedAt     time.Time `json:"claimed_at"`
+}
+
+// CurrentTeamID returns the team on the clock, or nil once every team has chosen.
+func (s *DraftSlotSelection) CurrentTeamID() *uuid.UUID {
+	if s.Status != SlotSelectionStatusInProgress || s.CurrentTurn >= len(s.SelectionOrder) {
+		return nil
+	}
+	return &s.SelectionOrder[s.CurrentTurn]
+}
+
+// DraftOrder returns the teams ordered by the slot they claimed.
+func (s *DraftSlotSelection) DraftOrder() []uuid.UUID {
+	order := make([]uuid.UUID, len(s.Claims))
+	for _, claim := range s.Claims {
+		if claim.Slot >= 1 && claim.Slot <= len(order) {
+			order[claim.Slot-1] = claim.FantasyTeamID
+		}
+	}
+	return order
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// DraftSummary is a draft's progress, kept in step with its picks by the database so it
+// can be read without scanning the pick board.
+type DraftSummary struct {
+	DraftID        uuid.UUID  `json:"draft_id"`
+	TotalPicks     int        `json:"total_picks"`
+	PicksMade      int        `json:"picks_made"`
+	RemainingPicks int        `json:"remaining_picks"`
+	CurrentPick    *DraftPick `json:"current_pick,omitempty"` // the pick on the clock, nil once every pick is made
+	LastPickAt     *time.Time `json:"last_pick_at,omitempty"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// TeamDraftSummary is how one team fared in a draft
+type TeamDraftSummary struct {
+	TeamID         uuid.UUID `json:"team_id"`
+	TotalPicks     int       `json:"total_picks"`     // the team's pick slots
+	PlayersDrafted int       `json:"players_drafted"` // slots made a pick with, keepers included
+	KeeperPicks    int       `json:"keeper_picks"`
+	ForfeitedPicks int       `json:"forfeited_picks"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DraftWebhook is an endpoint a draft's pick events are posted to, signed with a secret that
+// is only known when the webhook is created
+type DraftWebhook struct {
+	ID              uuid.UUID  `json:"id"`
+	DraftID         uuid.UUID  `json:"draft_id"`
+	URL             string     `json:"url"`
+	Description     *string    `json:"description,omitempty"`
+	CreatedBy       *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	LastDeliveredAt *time.Time `json:"last_delivered_at,omitempty"`
+	LastFailedAt    *time.Time `json:"last_failed_at,omitempty"`
+	LastError       *string    `json:"last_error,omitempty"` // why the last failed delivery attempt failed
+}
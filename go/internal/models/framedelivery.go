@@ -0,0 +1,13 @@
+package models
+
+// FrameDeliveryStatus defines whether a draft room frame the gateway required clients to
+// acknowledge reached the user it was sent to.
+type FrameDeliveryStatus string
+
+const (
+	// FrameDeliveryStatusAcked is a frame one of the user's clients acknowledged
+	FrameDeliveryStatusAcked FrameDeliveryStatus = "ACKED"
+	// FrameDeliveryStatusUnacked is a frame none of the user's clients acknowledged within the
+	// gateway's retries
+	FrameDeliveryStatusUnacked FrameDeliveryStatus = "UNACKED"
+)
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+)
+
+// idTypes are the typed ids generated, with the entity each identifies
+var idTypes = []struct {
+	Name   string
+	Entity string
+}{
+	{"DraftID", "draft"},
+	{"TeamID", "fantasy team"},
+	{"PlayerID", "player"},
+}
+
+// Generates the models package's typed ids from idTypes, so adding an id type only needs a
+// line here. Run with go generate from the models package, or with make generate-model-ids.
+func main() {
+	out := "ids_gen.go"
+	if len(os.Args) > 1 {
+		out = os.Args[1]
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by go run ./gen; DO NOT EDIT.
+
+package models
+
+import (
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/ids"
+)
+`)
+
+	for _, t := range idTypes {
+		fmt.Fprintf(&buf, `
+// %[1]s identifies a %[2]s
+type %[1]s uuid.UUID
+
+// New%[1]s returns a new %[2]s id
+func New%[1]s() %[1]s {
+	return %[1]s(ids.New())
+}
+
+// Parse%[1]s reads a %[2]s id from its string form, as proto messages and URLs carry it
+func Parse%[1]s(s string) (%[1]s, error) {
+	id, err := parseID(%[2]q, s)
+	return %[1]s(id), err
+}
+
+// UUID returns the id as a uuid.UUID, for queries and for code that doesn't use typed ids yet
+func (id %[1]s) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// String returns the id's string form, as proto messages carry it
+func (id %[1]s) String() string {
+	return uuid.UUID(id).String()
+}
+
+// IsZero reports whether the id is unset
+func (id %[1]s) IsZero() bool {
+	return uuid.UUID(id) == uuid.Nil
+}
+
+// MarshalText implements encoding.TextMarshaler, so the id is a string in JSON
+func (id %[1]s) MarshalText() ([]byte, error) {
+	return uuid.UUID(id).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (id *%[1]s) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
+`, t.Name, t.Entity)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to format generated ids: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(out, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write %s: %v\n", out, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,23 @@
+package models
+
+//go:generate go run ./gen
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// The typed ids in ids_gen.go keep the ids of different entities apart, so a team id can't be
+// passed where a draft id is expected. Code converts at its edges: services parse request
+// fields with uuidutil.ParseID, other code reads ids with Parse<Type>, and UUID and String
+// hand them back to queries and proto messages.
+
+// parseID reads the string form of an id of entity
+func parseID(entity, s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid %s id %q: %w", entity, s, err)
+	}
+	return id, nil
+}
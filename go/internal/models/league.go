@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/google/uuid"
+	"reflect"
 	"time"
 )
 
@@ -35,4 +36,123 @@ type League struct {
 	Season         string       `json:"season"`
 	CreatedAt      time.Time    `json:"created_at"`
 	UpdatedAt      time.Time    `json:"updated_at"`
+	ArchivedAt     *time.Time   `json:"archived_at,omitempty"` // set while the league is archived and read only
+}
+
+// LeagueSettingsChange is one version of a league's settings in its change log. Versions
+// are recorded in order and take effect in order; the first has no diff.
+type LeagueSettingsChange struct {
+	ID          uuid.UUID              `json:"id"`
+	LeagueID    uuid.UUID              `json:"league_id"`
+	ActorUserID *uuid.UUID             `json:"actor_user_id,omitempty"` // nil for system changes
+	Settings    interface{}            `json:"settings"`                // the full settings after the change
+	Diff        map[string]SettingDiff `json:"diff"`
+	EffectiveAt time.Time              `json:"effective_at"`
+	CreatedAt   time.Time              `json:"created_at"`
+}
+
+// SettingDiff is the old and new value of a changed league_settings key. Old is nil
+// for an added key and New is nil for a removed one.
+type SettingDiff struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// DiffSettings compares two raw league_settings values key by key
+func DiffSettings(old, new interface{}) map[string]SettingDiff {
+	oldMap, _ := old.(map[string]interface{})
+	newMap, _ := new.(map[string]interface{})
+
+	diff := make(map[string]SettingDiff)
+	for key, oldValue := range oldMap {
+		newValue, ok := newMap[key]
+		if !ok || !reflect.DeepEqual(oldValue, newValue) {
+			diff[key] = SettingDiff{Old: oldValue, New: newValue}
+		}
+	}
+	for key, newValue := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			diff[key] = SettingDiff{New: newValue}
+		}
+	}
+	return diff
+}
+
+// LeagueSettingBestBall is the league_settings key that enables best-ball mode.
+// Best-ball leagues have no lineup management: the optimal lineup is selected
+// automatically from the full roster each week.
+const LeagueSettingBestBall = "best_ball"
+
+// IsBestBall reports whether the league is configured for best-ball scoring
+func (l *League) IsBestBall() bool {
+	return SettingsBestBall(l.LeagueSettings)
+}
+
+// SettingsBestBall reports whether a raw league_settings value enables best-ball mode
+func SettingsBestBall(settings interface{}) bool {
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	bestBall, _ := m[LeagueSettingBestBall].(bool)
+	return bestBall
+}
+
+// LeagueSettingPublic is the league_settings key that publishes a league's standings, draft
+// results and rosters to anyone, signed in or not
+const LeagueSettingPublic = "public"
+
+// SettingsPublic reports whether a raw league_settings value publishes the league
+func SettingsPublic(settings interface{}) bool {
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	public, _ := m[LeagueSettingPublic].(bool)
+	return public
+}
+
+// ScoringFormat is how a league scores receptions, which decides the rankings its draft board uses
+type ScoringFormat string
+
+const (
+	ScoringFormatStandard ScoringFormat = "STANDARD"
+	ScoringFormatHalfPPR  ScoringFormat = "HALF_PPR"
+	ScoringFormatPPR      ScoringFormat = "PPR"
+)
+
+// LeagueSettingScoring is the league_settings key holding points per stat, e.g. {"reception": 1}
+const LeagueSettingScoring = "scoring"
+
+// LeagueSettingLineupSlots is the league_settings key holding the starting lineup slots
+const LeagueSettingLineupSlots = "lineup_slots"
+
+// SettingsScoringFormat derives the scoring format from the points a raw league_settings
+// value awards per reception. Leagues without reception scoring are standard.
+func SettingsScoringFormat(settings interface{}) ScoringFormat {
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return ScoringFormatStandard
+	}
+	scoring, _ := m[LeagueSettingScoring].(map[string]interface{})
+	reception, _ := scoring["reception"].(float64)
+	switch {
+	case reception >= 1:
+		return ScoringFormatPPR
+	case reception >= 0.5:
+		return ScoringFormatHalfPPR
+	default:
+		return ScoringFormatStandard
+	}
+}
+
+// SettingsSuperflex reports whether a raw league_settings value of a league in sportID has a
+// lineup slot that can start a quarterback alongside other positions
+func SettingsSuperflex(sportID string, settings interface{}) bool {
+	for _, slot := range SettingsLineupSlots(sportID, settings) {
+		if len(slot.Eligible) >= 2 && slot.Accepts("QB") {
+			return true
+		}
+	}
+	return false
 }
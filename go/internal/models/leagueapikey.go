@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyScope is a permission granted to a league API key
+type APIKeyScope string
+
+const (
+	// APIKeyScopeResultsRead reads the league's drafts, rosters and transactions
+	APIKeyScopeResultsRead APIKeyScope = "results:read"
+	// APIKeyScopeWebhooksManage manages the league's webhooks
+	APIKeyScopeWebhooksManage APIKeyScope = "webhooks:manage"
+)
+
+// IsValid reports whether s is a known scope
+func (s APIKeyScope) IsValid() bool {
+	switch s {
+	case APIKeyScopeResultsRead, APIKeyScopeWebhooksManage:
+		return true
+	}
+	return false
+}
+
+// LeagueAPIKey lets an external tool call the API on behalf of one league, limited to its
+// scopes. The key itself is only known when it is issued.
+type LeagueAPIKey struct {
+	ID         uuid.UUID     `json:"id"`
+	LeagueID   uuid.UUID     `json:"league_id"`
+	Name       string        `json:"name"`
+	KeyPrefix  string        `json:"key_prefix"` // start of the key, to tell keys apart
+	Scopes     []APIKeyScope `json:"scopes"`
+	CreatedBy  *uuid.UUID    `json:"created_by,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+	LastUsedAt *time.Time    `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time    `json:"revoked_at,omitempty"`
+}
+
+// HasScope reports whether the key was granted scope
+func (k *LeagueAPIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
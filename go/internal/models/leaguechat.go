@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChatThreadKind is the board a league discussion thread is posted to
+type ChatThreadKind string
+
+const (
+	ChatThreadKindTrashTalk  ChatThreadKind = "TRASH_TALK"
+	ChatThreadKindTradeBlock ChatThreadKind = "TRADE_BLOCK"
+)
+
+// ChatThread is a discussion thread on one of a league's boards
+type ChatThread struct {
+	ID            uuid.UUID      `json:"id"`
+	LeagueID      uuid.UUID      `json:"league_id"`
+	Kind          ChatThreadKind `json:"kind"`
+	Title         string         `json:"title"`
+	CreatedBy     *uuid.UUID     `json:"created_by,omitempty"` // unset once the user is deleted
+	Pinned        bool           `json:"pinned"`
+	Locked        bool           `json:"locked"` // locked threads take no new messages
+	MessageCount  int            `json:"message_count"`
+	LastMessageAt time.Time      `json:"last_message_at"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+// ChatMessage is a message posted to a league discussion thread. Deleted messages keep their
+// place in the thread without their text.
+type ChatMessage struct {
+	ID        uuid.UUID   `json:"id"`
+	ThreadID  uuid.UUID   `json:"thread_id"`
+	AuthorID  *uuid.UUID  `json:"author_id,omitempty"` // unset once the user is deleted
+	Body      string      `json:"body"`
+	Mentions  []uuid.UUID `json:"mentions,omitempty"` // members notified of the message; only set when it is posted
+	CreatedAt time.Time   `json:"created_at"`
+	DeletedAt *time.Time  `json:"deleted_at,omitempty"`
+	DeletedBy *uuid.UUID  `json:"deleted_by,omitempty"`
+}
+
+// Deleted reports whether the message was removed by its author or the commissioner
+func (m *ChatMessage) Deleted() bool {
+	return m.DeletedAt != nil
+}
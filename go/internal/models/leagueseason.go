@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LeagueSeasonStatus is where a league season is in its year
+type LeagueSeasonStatus string
+
+const (
+	LeagueSeasonStatusActive    LeagueSeasonStatus = "ACTIVE"
+	LeagueSeasonStatusCompleted LeagueSeasonStatus = "COMPLETED"
+)
+
+// LeagueSeason is one year of a league. Its drafts, matchups and transactions belong to a
+// season, and a league has at most one active season at a time.
+type LeagueSeason struct {
+	ID          uuid.UUID          `json:"id"`
+	LeagueID    uuid.UUID          `json:"league_id"`
+	Year        string             `json:"year"` // e.g. "2025", as in League.Season
+	Status      LeagueSeasonStatus `json:"status"`
+	StartedAt   time.Time          `json:"started_at"`
+	CompletedAt *time.Time         `json:"completed_at,omitempty"`
+}
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// League settings keys deciding how a league tells time. Leagues without them run on UTC and
+// display times the US English way.
+const (
+	// LeagueSettingTimezone is the IANA name of the time zone the league's dates and daily
+	// deadlines fall in, e.g. "America/New_York"
+	LeagueSettingTimezone = "timezone"
+	// LeagueSettingLocale is the BCP 47 tag of the locale the league's times are shown in, e.g. "en-GB"
+	LeagueSettingLocale = "locale"
+)
+
+const (
+	DefaultLeagueTimezone = "UTC"
+	DefaultLeagueLocale   = "en-US"
+)
+
+// LeagueClock converts times to and from a league's time zone and formats them for its locale
+type LeagueClock struct {
+	Location *time.Location
+	Locale   language.Tag
+}
+
+// SettingsLeagueClock reads a league's time zone and locale from a raw league_settings value,
+// falling back to the defaults for keys that are missing or invalid
+func SettingsLeagueClock(settings interface{}) LeagueClock {
+	clock := LeagueClock{Location: time.UTC, Locale: language.AmericanEnglish}
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return clock
+	}
+	if name, ok := m[LeagueSettingTimezone].(string); ok {
+		if loc, err := loadLeagueLocation(name); err == nil {
+			clock.Location = loc
+		}
+	}
+	if locale, ok := m[LeagueSettingLocale].(string); ok {
+		if tag, err := language.Parse(locale); err == nil {
+			clock.Locale = tag
+		}
+	}
+	return clock
+}
+
+// NewLeagueClock builds a clock from a time zone name and locale tag as stored in
+// league_settings; empty or invalid values fall back to the defaults
+func NewLeagueClock(timezone, locale string) LeagueClock {
+	settings := map[string]interface{}{}
+	if timezone != "" {
+		settings[LeagueSettingTimezone] = timezone
+	}
+	if locale != "" {
+		settings[LeagueSettingLocale] = locale
+	}
+	return SettingsLeagueClock(settings)
+}
+
+// ValidateLeagueClockSettings checks the time zone and locale keys of a league_settings map
+func ValidateLeagueClockSettings(settings map[string]interface{}) error {
+	if value, exists := settings[LeagueSettingTimezone]; exists {
+		name, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be an IANA time zone name", LeagueSettingTimezone)
+		}
+		if _, err := loadLeagueLocation(name); err != nil {
+			return fmt.Errorf("%s must be an IANA time zone name: %w", LeagueSettingTimezone, err)
+		}
+	}
+	if value, exists := settings[LeagueSettingLocale]; exists {
+		locale, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be a BCP 47 language tag", LeagueSettingLocale)
+		}
+		if _, err := language.Parse(locale); err != nil {
+			return fmt.Errorf("%s must be a BCP 47 language tag: %w", LeagueSettingLocale, err)
+		}
+	}
+	return nil
+}
+
+// loadLeagueLocation loads an IANA time zone. "Local" is refused, since it names whatever zone
+// the server happens to run in, and so is the empty name time.LoadLocation takes for UTC.
+func loadLeagueLocation(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	return time.LoadLocation(name)
+}
+
+// In returns t as a wall clock time in the league's time zone
+func (c LeagueClock) In(t time.Time) time.Time {
+	return t.In(c.Location)
+}
+
+// Date returns midnight at the start of a day in the league's time zone
+func (c LeagueClock) Date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, c.Location)
+}
+
+// NextTimeOfDay returns the first time after after at which the league's wall clock reads
+// timeOfDay, given as HH:MM, e.g. when daily waiver processing next runs
+func (c LeagueClock) NextTimeOfDay(after time.Time, timeOfDay string) (time.Time, error) {
+	at, err := time.Parse("15:04", timeOfDay)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("time of day must be HH:MM: %w", err)
+	}
+
+	local := c.In(after)
+	next := time.Date(local.Year(), local.Month(), local.Day(), at.Hour(), at.Minute(), 0, 0, c.Location)
+	if !next.After(after) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+1, at.Hour(), at.Minute(), 0, 0, c.Location)
+	}
+	return next, nil
+}
+
+// FormatDateTime formats t for display in the league's time zone and locale
+func (c LeagueClock) FormatDateTime(t time.Time) string {
+	if c.monthFirst() {
+		return c.In(t).Format("Mon, Jan 2, 2006 at 3:04 PM MST")
+	}
+	return c.In(t).Format("Mon 2 Jan 2006 at 15:04 MST")
+}
+
+// FormatDate formats the day t falls on in the league's time zone for display in its locale
+func (c LeagueClock) FormatDate(t time.Time) string {
+	if c.monthFirst() {
+		return c.In(t).Format("Jan 2, 2006")
+	}
+	return c.In(t).Format("2 Jan 2006")
+}
+
+// monthFirst reports whether the locale writes the month before the day and uses a 12-hour clock
+func (c LeagueClock) monthFirst() bool {
+	region, _ := c.Locale.Region()
+	switch region.String() {
+	case "US", "PH", "CA":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransactionType categorizes an entry in a league's transaction log
+type TransactionType string
+
+const (
+	TransactionTypeDrafted          TransactionType = "DRAFTED"
+	TransactionTypeAdded            TransactionType = "ADDED"
+	TransactionTypeDropped          TransactionType = "DROPPED"
+	TransactionTypeTraded           TransactionType = "TRADED"
+	TransactionTypeWaiverClaim      TransactionType = "WAIVER_CLAIM"
+	TransactionTypeKeeperDesignated TransactionType = "KEEPER_DESIGNATED"
+)
+
+// LeagueTransaction is one entry in a league's activity feed. Entries are projected from
+// roster and draft events and never change once written.
+type LeagueTransaction struct {
+	ID                 uuid.UUID       `json:"id"`
+	LeagueID           uuid.UUID       `json:"league_id"`
+	Type               TransactionType `json:"type"`
+	FantasyTeamID      uuid.UUID       `json:"fantasy_team_id"`
+	CounterpartyTeamID *uuid.UUID      `json:"counterparty_team_id,omitempty"` // the other side of a trade
+	PlayerID           *uuid.UUID      `json:"player_id,omitempty"`            // unset for draft pick trades
+	DraftID            *uuid.UUID      `json:"draft_id,omitempty"`
+	PickID             *uuid.UUID      `json:"pick_id,omitempty"`
+	Details            json.RawMessage `json:"details,omitempty"`
+	OccurredAt         time.Time       `json:"occurred_at"`
+	SeasonID           *uuid.UUID      `json:"season_id,omitempty"` // the league season it was made in
+}
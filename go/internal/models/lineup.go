@@ -0,0 +1,223 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LineupSlot is a starting lineup slot and the player positions eligible to fill it. A league
+// defines its starting lineup in league_settings as a list of slots, each with a name and the
+// positions eligible to fill it, e.g. {"name": "FLEX", "eligible": ["RB", "WR", "TE"]}. Standard
+// slots of the league's sport may leave out the eligible list to use their standard eligibility.
+type LineupSlot struct {
+	Name     string   `json:"name"`
+	Eligible []string `json:"eligible"`
+}
+
+// Accepts reports whether a player listed at position may fill the slot
+func (s LineupSlot) Accepts(position string) bool {
+	for _, eligible := range s.Eligible {
+		if eligible == position {
+			return true
+		}
+	}
+	return false
+}
+
+// SettingsLineupSlots reads the starting lineup slots from a raw league_settings value of a
+// league in sportID. Standard slots without an eligible list get their standard eligibility.
+func SettingsLineupSlots(sportID string, settings interface{}) []LineupSlot {
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	raw, _ := m[LeagueSettingLineupSlots].([]interface{})
+
+	positions := SportPositions(sportID)
+	slots := make([]LineupSlot, 0, len(raw))
+	for _, s := range raw {
+		slot, _ := s.(map[string]interface{})
+		name, _ := slot["name"].(string)
+		eligible, _ := slot["eligible"].([]interface{})
+
+		lineupSlot := LineupSlot{Name: name}
+		for _, position := range eligible {
+			if p, ok := position.(string); ok {
+				lineupSlot.Eligible = append(lineupSlot.Eligible, p)
+			}
+		}
+		if len(lineupSlot.Eligible) == 0 {
+			lineupSlot.Eligible, _ = positions.StandardSlot(name)
+		}
+		slots = append(slots, lineupSlot)
+	}
+	return slots
+}
+
+// ValidateLineupSlotsSetting checks the value of the lineup_slots league setting of a league in
+// sportID: a list of named slots, each either a standard slot of the sport or one listing the
+// sport's positions eligible to fill it
+func ValidateLineupSlotsSetting(sportID string, value interface{}) error {
+	raw, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("%s must be a list of slots", LeagueSettingLineupSlots)
+	}
+	positions := SportPositions(sportID)
+	for i, s := range raw {
+		slot, ok := s.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s[%d] must be an object", LeagueSettingLineupSlots, i)
+		}
+		name, _ := slot["name"].(string)
+		if name == "" {
+			return fmt.Errorf("%s[%d] needs a name", LeagueSettingLineupSlots, i)
+		}
+
+		eligible, exists := slot["eligible"]
+		if !exists {
+			if _, standard := positions.StandardSlot(name); !standard {
+				return fmt.Errorf("%s[%d]: %s is not a standard slot and needs an eligible list", LeagueSettingLineupSlots, i, name)
+			}
+			continue
+		}
+		eligiblePositions, ok := eligible.([]interface{})
+		if !ok || len(eligiblePositions) == 0 {
+			return fmt.Errorf("%s[%d].eligible must be a non-empty list of positions", LeagueSettingLineupSlots, i)
+		}
+		for _, position := range eligiblePositions {
+			p, ok := position.(string)
+			if !ok || p == "" {
+				return fmt.Errorf("%s[%d].eligible must be a non-empty list of positions", LeagueSettingLineupSlots, i)
+			}
+			if len(positions.Positions) > 0 && !positions.HasPosition(p) {
+				return fmt.Errorf("%s[%d].eligible: %s is not a %s position", LeagueSettingLineupSlots, i, p, sportID)
+			}
+		}
+	}
+	return nil
+}
+
+// UnfilledLineupSlots returns the starting lineup slots a team holding counts players by position
+// can't fill. The most restrictive slots are filled first, which finds a full lineup whenever
+// one exists for nested slots like the standard ones (e.g. FLEX within SUPERFLEX).
+func UnfilledLineupSlots(slots []LineupSlot, counts map[string]int) []LineupSlot {
+	order := make([]int, len(slots))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(slots[order[i]].Eligible) < len(slots[order[j]].Eligible)
+	})
+
+	left := make(map[string]int, len(counts))
+	for position, n := range counts {
+		left[position] = n
+	}
+	filled := make([]bool, len(slots))
+	for _, i := range order {
+		for _, position := range slots[i].Eligible {
+			if left[position] > 0 {
+				left[position]--
+				filled[i] = true
+				break
+			}
+		}
+	}
+
+	var unfilled []LineupSlot
+	for i, slot := range slots {
+		if !filled[i] {
+			unfilled = append(unfilled, slot)
+		}
+	}
+	return unfilled
+}
+
+// League settings keys deciding when lineups lock each week. Leagues without them lock at
+// 13:00 on Sundays in the league's time zone.
+const (
+	// LeagueSettingLineupLockDay is the English name of the weekday lineups lock on, e.g. "sunday"
+	LeagueSettingLineupLockDay = "lineup_lock_day"
+	// LeagueSettingLineupLockTime is the time of day, as HH:MM, lineups lock at
+	LeagueSettingLineupLockTime = "lineup_lock_time"
+)
+
+const (
+	DefaultLineupLockDay  = time.Sunday
+	DefaultLineupLockTime = "13:00"
+)
+
+// LineupLock is when a league's lineups lock each week, on the league's wall clock
+type LineupLock struct {
+	Weekday   time.Weekday
+	TimeOfDay string // HH:MM
+	Clock     LeagueClock
+}
+
+// Next returns the first lineup lock after after
+func (l LineupLock) Next(after time.Time) (time.Time, error) {
+	next, err := l.Clock.NextTimeOfDay(after, l.TimeOfDay)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for l.Clock.In(next).Weekday() != l.Weekday {
+		if next, err = l.Clock.NextTimeOfDay(next, l.TimeOfDay); err != nil {
+			return time.Time{}, err
+		}
+	}
+	return next, nil
+}
+
+// SettingsLineupLock reads when lineups lock from a raw league_settings value, falling back to
+// the defaults for keys that are missing or invalid
+func SettingsLineupLock(settings interface{}) LineupLock {
+	lock := LineupLock{
+		Weekday:   DefaultLineupLockDay,
+		TimeOfDay: DefaultLineupLockTime,
+		Clock:     SettingsLeagueClock(settings),
+	}
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return lock
+	}
+	if day, ok := m[LeagueSettingLineupLockDay].(string); ok {
+		if weekday, ok := parseWeekday(day); ok {
+			lock.Weekday = weekday
+		}
+	}
+	if timeOfDay, ok := m[LeagueSettingLineupLockTime].(string); ok {
+		if _, err := time.Parse("15:04", timeOfDay); err == nil {
+			lock.TimeOfDay = timeOfDay
+		}
+	}
+	return lock
+}
+
+// ValidateLineupLockSettings checks the lineup lock keys of a league_settings map
+func ValidateLineupLockSettings(settings map[string]interface{}) error {
+	if value, exists := settings[LeagueSettingLineupLockDay]; exists {
+		day, _ := value.(string)
+		if _, ok := parseWeekday(day); !ok {
+			return fmt.Errorf("%s must be the name of a weekday", LeagueSettingLineupLockDay)
+		}
+	}
+	if value, exists := settings[LeagueSettingLineupLockTime]; exists {
+		timeOfDay, _ := value.(string)
+		if _, err := time.Parse("15:04", timeOfDay); err != nil {
+			return fmt.Errorf("%s must be a time of day as HH:MM", LeagueSettingLineupLockTime)
+		}
+	}
+	return nil
+}
+
+// parseWeekday parses the English name of a weekday in any case
+func parseWeekday(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(name, day.String()) {
+			return day, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaintenanceWindow is a deploy window during which drafts are held: none starts or resumes
+// from shortly before it opens until it closes, and drafts in progress are paused while it's open
+type MaintenanceWindow struct {
+	ID          uuid.UUID  `json:"id"`
+	StartsAt    time.Time  `json:"starts_at"`
+	EndsAt      time.Time  `json:"ends_at"`
+	Message     string     `json:"message,omitempty"` // shown to draft rooms with the notice
+	CreatedAt   time.Time  `json:"created_at"`
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+}
+
+// Open reports whether the window is open at t
+func (w MaintenanceWindow) Open(t time.Time) bool {
+	return w.CancelledAt == nil && !t.Before(w.StartsAt) && t.Before(w.EndsAt)
+}
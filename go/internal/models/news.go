@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PlayerNews represents a news item associated with a player
+type PlayerNews struct {
+	ID          uuid.UUID `json:"id"`
+	PlayerID    uuid.UUID `json:"player_id"`
+	Source      string    `json:"source"`
+	ExternalID  string    `json:"external_id"`
+	Headline    string    `json:"headline"`
+	Body        *string   `json:"body,omitempty"`
+	URL         *string   `json:"url,omitempty"`
+	PublishedAt time.Time `json:"published_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ExternalPlayerNews represents a news item mapped from an external source.
+// The player is identified by external ID when the source provides one,
+// otherwise by name, and is resolved on ingest.
+type ExternalPlayerNews struct {
+	Source           string    `json:"source"`
+	ExternalID       string    `json:"external_id"`
+	PlayerExternalID string    `json:"player_external_id,omitempty"`
+	PlayerName       string    `json:"player_name,omitempty"`
+	Headline         string    `json:"headline"`
+	Body             string    `json:"body,omitempty"`
+	URL              string    `json:"url,omitempty"`
+	PublishedAt      time.Time `json:"published_at"`
+}
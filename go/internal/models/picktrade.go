@@ -0,0 +1,73 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// League settings keys holding the rules on trading draft picks. Leagues without them let any
+// unmade pick be traded.
+const (
+	// LeagueSettingPickTradeMaxSeasonsOut is how many seasons past the league's current one a
+	// traded pick may be for; 0 allows only picks for the current season
+	LeagueSettingPickTradeMaxSeasonsOut = "pick_trade_max_seasons_out"
+	// LeagueSettingPickTradeNoConsecutiveFirsts stops a team from trading away its first round
+	// picks for two seasons in a row
+	LeagueSettingPickTradeNoConsecutiveFirsts = "pick_trade_no_consecutive_firsts"
+)
+
+// PickTradeRules are the limits a league puts on trading draft picks. A nil MaxSeasonsOut isn't
+// enforced.
+type PickTradeRules struct {
+	MaxSeasonsOut       *int
+	NoConsecutiveFirsts bool
+}
+
+// Enforced reports whether the league sets any pick trade rule
+func (r PickTradeRules) Enforced() bool {
+	return r.MaxSeasonsOut != nil || r.NoConsecutiveFirsts
+}
+
+// SettingsPickTradeRules reads the pick trade rules from a raw league_settings value
+func SettingsPickTradeRules(settings interface{}) PickTradeRules {
+	var rules PickTradeRules
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return rules
+	}
+	if seasons, ok := m[LeagueSettingPickTradeMaxSeasonsOut].(float64); ok {
+		n := int(seasons)
+		rules.MaxSeasonsOut = &n
+	}
+	rules.NoConsecutiveFirsts, _ = m[LeagueSettingPickTradeNoConsecutiveFirsts].(bool)
+	return rules
+}
+
+// ValidatePickTradeSettings checks the pick trade keys of a league_settings map
+func ValidatePickTradeSettings(settings map[string]interface{}) error {
+	if value, exists := settings[LeagueSettingPickTradeMaxSeasonsOut]; exists {
+		n, ok := value.(float64)
+		if !ok || n < 0 || n != math.Trunc(n) {
+			return fmt.Errorf("%s must be a non-negative whole number", LeagueSettingPickTradeMaxSeasonsOut)
+		}
+	}
+	if value, exists := settings[LeagueSettingPickTradeNoConsecutiveFirsts]; exists {
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean", LeagueSettingPickTradeNoConsecutiveFirsts)
+		}
+	}
+	return nil
+}
+
+// DraftSeason returns the season a draft's picks are for: the year the draft is scheduled in,
+// in the league's time zone, or the league's current season when it isn't scheduled. ok is false
+// when the league's season isn't named by its year.
+func DraftSeason(scheduledAt *time.Time, leagueSeason string, loc *time.Location) (season int, ok bool) {
+	if scheduledAt != nil {
+		return scheduledAt.In(loc).Year(), true
+	}
+	season, err := strconv.Atoi(leagueSeason)
+	return season, err == nil
+}
@@ -11,6 +11,15 @@ type Profile interface {
 	SportID() string
 }
 
+// Providers whose player IDs are kept in the external ID crosswalk
+const (
+	PlayerIDProviderSportradar = "sportradar"
+	PlayerIDProviderGSIS       = "gsis"
+	PlayerIDProviderSleeper    = "sleeper"
+	PlayerIDProviderESPN       = "espn"
+	PlayerIDProviderYahoo      = "yahoo"
+)
+
 // Player represents a sports player in the system
 type Player struct {
 	ID         uuid.UUID  `json:"id"`
@@ -20,7 +29,60 @@ type Player struct {
 	TeamID     *uuid.UUID `json:"team_id,omitempty"`
 	CreatedAt  time.Time  `json:"created_at"`
 
+	// ExternalIDs maps a provider (see PlayerIDProvider*) to that provider's ID for the player
+	ExternalIDs map[string]string `json:"external_ids,omitempty"`
+
 	NFLPlayerProfile *NFLPlayerProfile `json:"nfl_player_profile,omitempty"`
+
+	// Ownership is nil until the player has been on a roster at a nightly refresh
+	Ownership *PlayerOwnership `json:"ownership,omitempty"`
+}
+
+// PlayerOwnership is how widely a player is rostered and started across the platform, out
+// of the leagues of their sport that are still in play
+type PlayerOwnership struct {
+	PlayerID        uuid.UUID `json:"player_id"`
+	FullName        string    `json:"full_name,omitempty"` // only set when listing ownership
+	RosteredLeagues int       `json:"rostered_leagues"`
+	StartedLeagues  int       `json:"started_leagues"`
+	TotalLeagues    int       `json:"total_leagues"`
+	OwnedPct        float64   `json:"owned_pct"` // 0 to 100
+	StartedPct      float64   `json:"started_pct"`
+	ComputedAt      time.Time `json:"computed_at"`
+}
+
+// NFLPlayerStatusActive is the status of an NFL player on a team's active roster. Players with
+// any other status, such as IR or SUS, can't play.
+const NFLPlayerStatusActive = "ACT"
+
+// nflInjuryStatuses are the NFL player statuses that put a player out injured
+var nflInjuryStatuses = map[string]bool{
+	"IR":     true, // Injured Reserve
+	"IRD":    true, // Injured Reserve - Designated for Return
+	"PUP":    true, // Physically unable to perform
+	"NON":    true, // Non-football related injured reserve
+	"PRA_IR": true, // Practice Squad Injured Reserve
+}
+
+// IsNFLInjuryStatus reports whether an NFL player status is an injury designation
+func IsNFLInjuryStatus(status string) bool {
+	return nflInjuryStatuses[status]
+}
+
+// PlayerStatusChange is a player's status changed by a sync with the sport's data provider
+type PlayerStatusChange struct {
+	PlayerID       uuid.UUID `json:"player_id"`
+	SportID        string    `json:"sport_id"`
+	FullName       string    `json:"full_name"`
+	Position       string    `json:"position"`
+	PreviousStatus string    `json:"previous_status"`
+	Status         string    `json:"status"`
+	ChangedAt      time.Time `json:"changed_at"`
+}
+
+// NewInjury reports whether the change puts a player who wasn't out injured on an injury designation
+func (c PlayerStatusChange) NewInjury() bool {
+	return IsNFLInjuryStatus(c.Status) && !IsNFLInjuryStatus(c.PreviousStatus)
 }
 
 // NFLPlayerProfile represents NFL-specific player attributes
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PlayerDuplicate is a pair of player records the dedupe job found likely to be the same player,
+// waiting in the review queue or dismissed from it
+type PlayerDuplicate struct {
+	ID            uuid.UUID             `json:"id"`
+	SportID       string                `json:"sport_id"`
+	PlayerID      uuid.UUID             `json:"player_id"`      // the older record, kept by a merge
+	PlayerName    string                `json:"player_name"`    // only set when listing the queue
+	DuplicateID   uuid.UUID             `json:"duplicate_id"`   // the newer record, merged away
+	DuplicateName string                `json:"duplicate_name"` // only set when listing the queue
+	SameBirthDate bool                  `json:"same_birth_date"`
+	SameTeam      bool                  `json:"same_team"`
+	Status        PlayerDuplicateStatus `json:"status"`
+	DetectedAt    time.Time             `json:"detected_at"`
+	DismissedAt   *time.Time            `json:"dismissed_at,omitempty"`
+}
+
+// PlayerDuplicateStatus is where a duplicate pair stands in review. Merged pairs leave the
+// queue with the duplicate record.
+type PlayerDuplicateStatus string
+
+const (
+	PlayerDuplicateStatusPending   PlayerDuplicateStatus = "PENDING"
+	PlayerDuplicateStatusDismissed PlayerDuplicateStatus = "DISMISSED"
+)
+
+// Certain reports whether the pair matches on name, birth date and team, which the dedupe job
+// merges without review
+func (d PlayerDuplicate) Certain() bool {
+	return d.SameBirthDate && d.SameTeam
+}
+
+// PlayerMerge is a duplicate player record merged into the canonical one
+type PlayerMerge struct {
+	CanonicalID uuid.UUID `json:"canonical_id"`
+	DuplicateID uuid.UUID `json:"duplicate_id"`
+	SportID     string    `json:"sport_id"`
+	ExternalID  string    `json:"external_id"` // the duplicate's, which syncs now resolve to the canonical player
+	FullName    string    `json:"full_name"`   // the duplicate's
+	MergedAt    time.Time `json:"merged_at"`
+}
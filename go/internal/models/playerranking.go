@@ -0,0 +1,14 @@
+package models
+
+import "github.com/google/uuid"
+
+// PlayerRanking is a player's place on the preseason board of a season and scoring format
+type PlayerRanking struct {
+	Season          string        `json:"season"`
+	ScoringFormat   ScoringFormat `json:"scoring_format"`
+	Superflex       bool          `json:"superflex"`
+	PlayerID        uuid.UUID     `json:"player_id"`
+	OverallRank     int           `json:"overall_rank"`
+	ProjectedPoints *float64      `json:"projected_points,omitempty"`
+	Source          string        `json:"source"` // e.g. "consensus"
+}
@@ -0,0 +1,65 @@
+package models
+
+import "sync"
+
+// PositionTaxonomy is the player positions of a sport and its standard lineup slots. Each sport
+// plugin registers one, so lineup validation, roster limits and the draft board read position
+// eligibility from the sport rather than hard-coding any sport's positions.
+type PositionTaxonomy struct {
+	// Positions are the positions the sport's players are listed at
+	Positions []string
+	// Slots are the standard lineup slots and the positions eligible to fill each, e.g. the
+	// NFL's FLEX taking RB, WR and TE. A league's lineup may name them without an eligible list.
+	Slots map[string][]string
+}
+
+// HasPosition reports whether position is one of the sport's positions
+func (t PositionTaxonomy) HasPosition(position string) bool {
+	for _, p := range t.Positions {
+		if p == position {
+			return true
+		}
+	}
+	return false
+}
+
+// StandardSlot returns the positions eligible for a standard lineup slot, and whether name is one
+func (t PositionTaxonomy) StandardSlot(name string) ([]string, bool) {
+	eligible, ok := t.Slots[name]
+	return eligible, ok
+}
+
+var (
+	positionTaxonomies   = make(map[string]PositionTaxonomy)
+	positionTaxonomiesMu sync.RWMutex
+)
+
+// RegisterPositionTaxonomy sets the position taxonomy of a sport. Sport plugins register theirs
+// as they're registered, replacing any taxonomy registered for the sport before.
+func RegisterPositionTaxonomy(sportID string, taxonomy PositionTaxonomy) {
+	positionTaxonomiesMu.Lock()
+	defer positionTaxonomiesMu.Unlock()
+	positionTaxonomies[sportID] = taxonomy
+}
+
+// SportPositions returns the position taxonomy of a sport, empty when no plugin registered one
+func SportPositions(sportID string) PositionTaxonomy {
+	positionTaxonomiesMu.RLock()
+	defer positionTaxonomiesMu.RUnlock()
+	return positionTaxonomies[sportID]
+}
+
+// EligiblePositions resolves a position filter for a league: a slot of the league's lineup or a
+// standard slot of its sport gives the positions eligible to fill it, and anything else is taken
+// as a single position
+func EligiblePositions(sportID string, settings interface{}, name string) []string {
+	for _, slot := range SettingsLineupSlots(sportID, settings) {
+		if slot.Name == name {
+			return slot.Eligible
+		}
+	}
+	if eligible, ok := SportPositions(sportID).StandardSlot(name); ok {
+		return eligible
+	}
+	return []string{name}
+}
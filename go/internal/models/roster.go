@@ -12,9 +12,14 @@ type Roster struct {
 	FantasyTeamID   uuid.UUID       `json:"fantasy_team_id"`
 	PlayerID        uuid.UUID       `json:"player_id"`
 	Position        RosterPosition  `json:"position"`
+	LineupSlot      string          `json:"lineup_slot,omitempty"` // the league lineup slot a starter fills
 	AcquiredAt      time.Time       `json:"acquired_at"`
 	AcquisitionType AcquisitionType `json:"acquisition_type"`
 	KeeperData      json.RawMessage `json:"keeper_data"`
+	// Contract terms, tracked in leagues with a salary cap
+	Salary          *int `json:"salary,omitempty"`
+	ContractYears   *int `json:"contract_years,omitempty"`
+	FranchiseTagged bool `json:"franchise_tagged,omitempty"`
 }
 
 // RosterPosition represents the position a player has on a roster
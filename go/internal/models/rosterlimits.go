@@ -0,0 +1,131 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// League settings keys limiting how many players a team may roster. Taxi squad players don't
+// count against them; the taxi squad has its own limit.
+const (
+	// LeagueSettingBenchSlots is how many players a team may keep beyond its starting lineup slots
+	LeagueSettingBenchSlots = "bench_slots"
+	// LeagueSettingPositionLimits caps the players a team may roster at a position, e.g. {"K": 1}
+	LeagueSettingPositionLimits = "position_limits"
+)
+
+// RosterLimits are the limits a league puts on the size of its teams' rosters
+type RosterLimits struct {
+	Size           *int           // starting lineup slots plus bench slots; nil when bench_slots isn't set
+	PositionLimits map[string]int // positions without an entry are uncapped
+}
+
+// Enforced reports whether the league limits its rosters at all
+func (l RosterLimits) Enforced() bool {
+	return l.Size != nil || len(l.PositionLimits) > 0
+}
+
+// Full reports whether a team rostering counts players by position has no room for another player
+func (l RosterLimits) Full(counts map[string]int) bool {
+	if l.Size == nil {
+		return false
+	}
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	return total >= *l.Size
+}
+
+// HasRoom reports whether a team rostering counts players by position can add a player at position
+func (l RosterLimits) HasRoom(counts map[string]int, position string) bool {
+	if l.Full(counts) {
+		return false
+	}
+	limit, capped := l.PositionLimits[position]
+	return !capped || counts[position] < limit
+}
+
+// SettingsRosterLimits reads the roster limits from a raw league_settings value of a league in sportID
+func SettingsRosterLimits(sportID string, settings interface{}) RosterLimits {
+	var limits RosterLimits
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return limits
+	}
+	if bench, ok := m[LeagueSettingBenchSlots].(float64); ok {
+		size := len(SettingsLineupSlots(sportID, settings)) + int(bench)
+		limits.Size = &size
+	}
+	if caps, ok := m[LeagueSettingPositionLimits].(map[string]interface{}); ok {
+		limits.PositionLimits = make(map[string]int, len(caps))
+		for position, limit := range caps {
+			if n, ok := limit.(float64); ok {
+				limits.PositionLimits[position] = int(n)
+			}
+		}
+	}
+	return limits
+}
+
+// ValidateRosterLimitsSettings checks the roster limit keys of a league_settings map of a league
+// in sportID, whose position limits must be for the sport's positions
+func ValidateRosterLimitsSettings(sportID string, settings map[string]interface{}) error {
+	if value, exists := settings[LeagueSettingBenchSlots]; exists {
+		if !isWholeNumber(value) {
+			return fmt.Errorf("%s must be a non-negative whole number", LeagueSettingBenchSlots)
+		}
+	}
+	if value, exists := settings[LeagueSettingPositionLimits]; exists {
+		caps, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s must map positions to player limits", LeagueSettingPositionLimits)
+		}
+		positions := SportPositions(sportID)
+		for position, limit := range caps {
+			if !isWholeNumber(limit) {
+				return fmt.Errorf("%s.%s must be a non-negative whole number", LeagueSettingPositionLimits, position)
+			}
+			if len(positions.Positions) > 0 && !positions.HasPosition(position) {
+				return fmt.Errorf("%s.%s: %s is not a %s position", LeagueSettingPositionLimits, position, position, sportID)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateLineupFitsRosterLimits checks the position limits of a raw league_settings value of a
+// league in sportID leave room to fill its starting lineup: a position must be allowed at least
+// as many players as there are lineup slots only that position can fill
+func ValidateLineupFitsRosterLimits(sportID string, settings interface{}) error {
+	limits := SettingsRosterLimits(sportID, settings)
+	if len(limits.PositionLimits) == 0 {
+		return nil
+	}
+
+	needed := make(map[string]int)
+	for _, slot := range SettingsLineupSlots(sportID, settings) {
+		if len(slot.Eligible) == 1 {
+			needed[slot.Eligible[0]]++
+		}
+	}
+
+	positions := make([]string, 0, len(limits.PositionLimits))
+	for position := range limits.PositionLimits {
+		positions = append(positions, position)
+	}
+	sort.Strings(positions)
+	for _, position := range positions {
+		if limit := limits.PositionLimits[position]; needed[position] > limit {
+			return fmt.Errorf("%s.%s allows %d players but the starting lineup has %d %s slots",
+				LeagueSettingPositionLimits, position, limit, needed[position], position)
+		}
+	}
+	return nil
+}
+
+func isWholeNumber(value interface{}) bool {
+	n, ok := value.(float64)
+	return ok && n >= 0 && n == math.Trunc(n)
+}
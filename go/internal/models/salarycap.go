@@ -0,0 +1,135 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// League settings keys holding the salary cap and contract rules. Leagues without a salary cap
+// don't track contracts.
+const (
+	// LeagueSettingSalaryCap is the most a team's roster may be paid in total
+	LeagueSettingSalaryCap = "salary_cap"
+	// LeagueSettingMinSalary is the lowest salary a contract may pay. Players acquired without a
+	// contract are paid it.
+	LeagueSettingMinSalary = "min_salary"
+	// LeagueSettingMaxContractYears is the longest contract, in seasons, a player may be signed to
+	LeagueSettingMaxContractYears = "max_contract_years"
+	// LeagueSettingFranchiseTags is how many players a team may have franchise tagged at once
+	LeagueSettingFranchiseTags = "franchise_tags"
+	// LeagueSettingFranchiseTagSalary is the least a franchise tagged player is paid
+	LeagueSettingFranchiseTagSalary = "franchise_tag_salary"
+)
+
+// SalaryCapRules are the salary cap and contract rules of a league. A nil MaxContractYears isn't
+// enforced; unset amounts are zero, so a league without franchise_tags allows no franchise tags.
+type SalaryCapRules struct {
+	Cap                *int
+	MinSalary          int
+	MaxContractYears   *int
+	FranchiseTags      int
+	FranchiseTagSalary int
+}
+
+// Enforced reports whether the league has a salary cap
+func (r SalaryCapRules) Enforced() bool {
+	return r.Cap != nil
+}
+
+// CapHit is what a contract paying salary counts against the cap; players without a contract
+// count at the league's minimum salary
+func (r SalaryCapRules) CapHit(salary *int) int {
+	if salary == nil {
+		return r.MinSalary
+	}
+	return *salary
+}
+
+// TagSalary is what a player on a contract paying salary is paid once franchise tagged: the
+// league's franchise tag salary, or their current salary if that's more
+func (r SalaryCapRules) TagSalary(salary *int) int {
+	return max(r.FranchiseTagSalary, r.CapHit(salary))
+}
+
+// ValidateContract checks a contract's terms against the league's rules
+func (r SalaryCapRules) ValidateContract(salary, years *int) error {
+	if salary != nil && *salary < r.MinSalary {
+		return fmt.Errorf("salary must be at least the league minimum of %d", r.MinSalary)
+	}
+	if years != nil {
+		if *years < 1 {
+			return fmt.Errorf("contract_years must be at least 1")
+		}
+		if r.MaxContractYears != nil && *years > *r.MaxContractYears {
+			return fmt.Errorf("contract_years must be at most %d", *r.MaxContractYears)
+		}
+	}
+	return nil
+}
+
+// SettingsSalaryCapRules reads the salary cap rules from a raw league_settings value
+func SettingsSalaryCapRules(settings interface{}) SalaryCapRules {
+	var rules SalaryCapRules
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return rules
+	}
+	if salaryCap, ok := m[LeagueSettingSalaryCap].(float64); ok {
+		n := int(salaryCap)
+		rules.Cap = &n
+	}
+	if minSalary, ok := m[LeagueSettingMinSalary].(float64); ok {
+		rules.MinSalary = int(minSalary)
+	}
+	if years, ok := m[LeagueSettingMaxContractYears].(float64); ok {
+		n := int(years)
+		rules.MaxContractYears = &n
+	}
+	if tags, ok := m[LeagueSettingFranchiseTags].(float64); ok {
+		rules.FranchiseTags = int(tags)
+	}
+	if tagSalary, ok := m[LeagueSettingFranchiseTagSalary].(float64); ok {
+		rules.FranchiseTagSalary = int(tagSalary)
+	}
+	return rules
+}
+
+// ValidateSalaryCapSettings checks the salary cap keys of a league_settings map
+func ValidateSalaryCapSettings(settings map[string]interface{}) error {
+	for _, key := range []string{LeagueSettingSalaryCap, LeagueSettingMinSalary, LeagueSettingFranchiseTags, LeagueSettingFranchiseTagSalary} {
+		if value, exists := settings[key]; exists && !isWholeNumber(value) {
+			return fmt.Errorf("%s must be a non-negative whole number", key)
+		}
+	}
+	if value, exists := settings[LeagueSettingMaxContractYears]; exists {
+		if n, ok := value.(float64); !ok || !isWholeNumber(value) || n < 1 {
+			return fmt.Errorf("%s must be a positive whole number", LeagueSettingMaxContractYears)
+		}
+	}
+	if _, exists := settings[LeagueSettingSalaryCap]; !exists {
+		for _, key := range []string{LeagueSettingMinSalary, LeagueSettingMaxContractYears, LeagueSettingFranchiseTags, LeagueSettingFranchiseTagSalary} {
+			if _, set := settings[key]; set {
+				return fmt.Errorf("%s requires %s", key, LeagueSettingSalaryCap)
+			}
+		}
+	}
+	return nil
+}
+
+// CapSheet is a team's payroll against its league's salary cap
+type CapSheet struct {
+	FantasyTeamID        uuid.UUID       `json:"fantasy_team_id"`
+	SalaryCap            int             `json:"salary_cap"`
+	Payroll              int             `json:"payroll"`
+	CapSpace             int             `json:"cap_space"` // negative when the team is over the cap
+	FranchiseTagsUsed    int             `json:"franchise_tags_used"`
+	FranchiseTagsAllowed int             `json:"franchise_tags_allowed"`
+	Entries              []CapSheetEntry `json:"entries"`
+}
+
+// CapSheetEntry is a roster entry on a cap sheet with what it counts against the cap
+type CapSheetEntry struct {
+	Roster Roster `json:"roster"`
+	CapHit int    `json:"cap_hit"`
+}
@@ -0,0 +1,294 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// League settings keys shaping the regular season schedule
+const (
+	// LeagueSettingRegularSeasonWeeks is how many weeks of head-to-head matchups the regular season has
+	LeagueSettingRegularSeasonWeeks = "regular_season_weeks"
+	// LeagueSettingDivisions groups the league's teams, e.g. [{"name": "East", "team_ids": [...]}]
+	LeagueSettingDivisions = "divisions"
+	// LeagueSettingDivisionGameWeight is how many times as often a team meets each division rival
+	// as each team outside its division, e.g. 2 for home and away division series
+	LeagueSettingDivisionGameWeight = "division_game_weight"
+	// LeagueSettingRivalries pins pairs of teams to meet in a given week, e.g.
+	// [{"team_ids": [a, b], "week": 8}]
+	LeagueSettingRivalries = "rivalries"
+	// LeagueSettingTiebreakers orders the tiebreakers that rank teams with the same record, e.g.
+	// ["head_to_head", "points_for"]. A coin flip settles whatever they leave tied.
+	LeagueSettingTiebreakers = "tiebreakers"
+)
+
+// DefaultRegularSeasonWeeks is the length of the regular season of leagues that don't set one
+const DefaultRegularSeasonWeeks = 14
+
+// Tiebreaker ranks teams the standings have level on record
+type Tiebreaker string
+
+const (
+	// TiebreakerHeadToHead ranks by record in the games between the tied teams, once each has played all the others
+	TiebreakerHeadToHead Tiebreaker = "head_to_head"
+	// TiebreakerPointsFor ranks by total points scored
+	TiebreakerPointsFor Tiebreaker = "points_for"
+	// TiebreakerDivisionRecord ranks by record in division games, when the tied teams share a division
+	TiebreakerDivisionRecord Tiebreaker = "division_record"
+	// TiebreakerCoinFlip ranks by a coin flip seeded by the league and season, so it lands the same way every week
+	TiebreakerCoinFlip Tiebreaker = "coin_flip"
+)
+
+// DefaultTiebreakers are the tiebreakers of leagues that don't set their own
+var DefaultTiebreakers = []Tiebreaker{TiebreakerHeadToHead, TiebreakerPointsFor, TiebreakerDivisionRecord, TiebreakerCoinFlip}
+
+// IsValid reports whether t is a known tiebreaker
+func (t Tiebreaker) IsValid() bool {
+	switch t {
+	case TiebreakerHeadToHead, TiebreakerPointsFor, TiebreakerDivisionRecord, TiebreakerCoinFlip:
+		return true
+	}
+	return false
+}
+
+// Division is a named group of teams that meet each other more often
+type Division struct {
+	Name    string      `json:"name"`
+	TeamIDs []uuid.UUID `json:"team_ids"`
+}
+
+// Rivalry is a pair of teams pinned to meet in a week of the regular season
+type Rivalry struct {
+	TeamIDs [2]uuid.UUID `json:"team_ids"`
+	Week    int          `json:"week"`
+}
+
+// ScheduleSettings are the league settings the schedule generator works from
+type ScheduleSettings struct {
+	Weeks              int
+	Divisions          []Division
+	DivisionGameWeight int
+	Rivalries          []Rivalry
+	Tiebreakers        []Tiebreaker // in the order they apply, always ending with a coin flip
+}
+
+// DivisionOf returns the name of the division a team plays in, or "" for a team in none
+func (s ScheduleSettings) DivisionOf(teamID uuid.UUID) string {
+	for _, division := range s.Divisions {
+		for _, id := range division.TeamIDs {
+			if id == teamID {
+				return division.Name
+			}
+		}
+	}
+	return ""
+}
+
+// SettingsSchedule reads the schedule settings from a raw league_settings value. Malformed
+// entries are skipped; ValidateScheduleSettings rejects them when settings are saved.
+func SettingsSchedule(settings interface{}) ScheduleSettings {
+	schedule := ScheduleSettings{Weeks: DefaultRegularSeasonWeeks, DivisionGameWeight: 1, Tiebreakers: DefaultTiebreakers}
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return schedule
+	}
+	if weeks, ok := m[LeagueSettingRegularSeasonWeeks].(float64); ok && weeks >= 1 {
+		schedule.Weeks = int(weeks)
+	}
+	if weight, ok := m[LeagueSettingDivisionGameWeight].(float64); ok && weight >= 1 {
+		schedule.DivisionGameWeight = int(weight)
+	}
+	divisions, _ := m[LeagueSettingDivisions].([]interface{})
+	for _, value := range divisions {
+		entry, _ := value.(map[string]interface{})
+		name, _ := entry["name"].(string)
+		teamIDs, ok := parseTeamIDs(entry["team_ids"])
+		if name == "" || !ok {
+			continue
+		}
+		schedule.Divisions = append(schedule.Divisions, Division{Name: name, TeamIDs: teamIDs})
+	}
+	rivalries, _ := m[LeagueSettingRivalries].([]interface{})
+	for _, value := range rivalries {
+		entry, _ := value.(map[string]interface{})
+		teamIDs, ok := parseTeamIDs(entry["team_ids"])
+		week, _ := entry["week"].(float64)
+		if !ok || len(teamIDs) != 2 || week < 1 {
+			continue
+		}
+		schedule.Rivalries = append(schedule.Rivalries, Rivalry{TeamIDs: [2]uuid.UUID{teamIDs[0], teamIDs[1]}, Week: int(week)})
+	}
+	schedule.Tiebreakers = settingsTiebreakers(m[LeagueSettingTiebreakers])
+	return schedule
+}
+
+// settingsTiebreakers reads the tiebreakers setting, falling back to DefaultTiebreakers when it
+// is missing or names none. A coin flip is added last when the setting leaves it out.
+func settingsTiebreakers(value interface{}) []Tiebreaker {
+	list, ok := value.([]interface{})
+	if !ok {
+		return DefaultTiebreakers
+	}
+	var tiebreakers []Tiebreaker
+	seen := make(map[Tiebreaker]bool, len(list))
+	for _, item := range list {
+		name, _ := item.(string)
+		tiebreaker := Tiebreaker(name)
+		if !tiebreaker.IsValid() || seen[tiebreaker] {
+			continue
+		}
+		seen[tiebreaker] = true
+		tiebreakers = append(tiebreakers, tiebreaker)
+		if tiebreaker == TiebreakerCoinFlip {
+			break
+		}
+	}
+	if len(tiebreakers) == 0 {
+		return DefaultTiebreakers
+	}
+	if !seen[TiebreakerCoinFlip] {
+		tiebreakers = append(tiebreakers, TiebreakerCoinFlip)
+	}
+	return tiebreakers
+}
+
+// ValidateScheduleSettings checks the schedule keys of a league_settings map. Whether the
+// teams named belong to the league is checked when a schedule is generated.
+func ValidateScheduleSettings(settings map[string]interface{}) error {
+	weeks := DefaultRegularSeasonWeeks
+	if value, exists := settings[LeagueSettingRegularSeasonWeeks]; exists {
+		if !isWholeNumber(value) || value.(float64) < 1 {
+			return fmt.Errorf("%s must be a positive whole number", LeagueSettingRegularSeasonWeeks)
+		}
+		weeks = int(value.(float64))
+	}
+	if value, exists := settings[LeagueSettingDivisionGameWeight]; exists {
+		if !isWholeNumber(value) || value.(float64) < 1 {
+			return fmt.Errorf("%s must be a positive whole number", LeagueSettingDivisionGameWeight)
+		}
+	}
+
+	if value, exists := settings[LeagueSettingDivisions]; exists {
+		divisions, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s must be a list of divisions", LeagueSettingDivisions)
+		}
+		names := make(map[string]bool, len(divisions))
+		placed := make(map[uuid.UUID]bool)
+		for i, value := range divisions {
+			entry, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s[%d] must be an object with a name and team_ids", LeagueSettingDivisions, i)
+			}
+			name, _ := entry["name"].(string)
+			if name == "" {
+				return fmt.Errorf("%s[%d].name is required", LeagueSettingDivisions, i)
+			}
+			if names[name] {
+				return fmt.Errorf("%s: division %q is listed twice", LeagueSettingDivisions, name)
+			}
+			names[name] = true
+			teamIDs, ok := parseTeamIDs(entry["team_ids"])
+			if !ok {
+				return fmt.Errorf("%s[%d].team_ids must be a list of team IDs", LeagueSettingDivisions, i)
+			}
+			for _, teamID := range teamIDs {
+				if placed[teamID] {
+					return fmt.Errorf("%s: team %s is in more than one division", LeagueSettingDivisions, teamID)
+				}
+				placed[teamID] = true
+			}
+		}
+	}
+
+	if value, exists := settings[LeagueSettingRivalries]; exists {
+		rivalries, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s must be a list of rivalries", LeagueSettingRivalries)
+		}
+		pinned := make(map[int]map[uuid.UUID]bool)
+		for i, value := range rivalries {
+			entry, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s[%d] must be an object with team_ids and a week", LeagueSettingRivalries, i)
+			}
+			teamIDs, ok := parseTeamIDs(entry["team_ids"])
+			if !ok || len(teamIDs) != 2 || teamIDs[0] == teamIDs[1] {
+				return fmt.Errorf("%s[%d].team_ids must be two different team IDs", LeagueSettingRivalries, i)
+			}
+			if !isWholeNumber(entry["week"]) || entry["week"].(float64) < 1 || int(entry["week"].(float64)) > weeks {
+				return fmt.Errorf("%s[%d].week must be a week of the regular season, 1 to %d", LeagueSettingRivalries, i, weeks)
+			}
+			week := int(entry["week"].(float64))
+			if pinned[week] == nil {
+				pinned[week] = make(map[uuid.UUID]bool)
+			}
+			for _, teamID := range teamIDs {
+				if pinned[week][teamID] {
+					return fmt.Errorf("%s: team %s has two rivalry games in week %d", LeagueSettingRivalries, teamID, week)
+				}
+				pinned[week][teamID] = true
+			}
+		}
+	}
+
+	if value, exists := settings[LeagueSettingTiebreakers]; exists {
+		list, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s must be a list of tiebreakers", LeagueSettingTiebreakers)
+		}
+		seen := make(map[Tiebreaker]bool, len(list))
+		for i, item := range list {
+			name, _ := item.(string)
+			tiebreaker := Tiebreaker(name)
+			if !tiebreaker.IsValid() {
+				return fmt.Errorf("%s[%d] must be one of %s, %s, %s or %s", LeagueSettingTiebreakers, i,
+					TiebreakerHeadToHead, TiebreakerPointsFor, TiebreakerDivisionRecord, TiebreakerCoinFlip)
+			}
+			if seen[tiebreaker] {
+				return fmt.Errorf("%s: %s is listed twice", LeagueSettingTiebreakers, tiebreaker)
+			}
+			if seen[TiebreakerCoinFlip] {
+				return fmt.Errorf("%s: %s must be the last tiebreaker, it leaves no ties", LeagueSettingTiebreakers, TiebreakerCoinFlip)
+			}
+			seen[tiebreaker] = true
+		}
+	}
+	return nil
+}
+
+// parseTeamIDs reads a JSON list of team ID strings
+func parseTeamIDs(value interface{}) ([]uuid.UUID, bool) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, false
+	}
+	teamIDs := make([]uuid.UUID, len(list))
+	for i, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return nil, false
+		}
+		teamID, err := uuid.Parse(s)
+		if err != nil {
+			return nil, false
+		}
+		teamIDs[i] = teamID
+	}
+	return teamIDs, true
+}
+
+// Matchup is a head-to-head game of a league's regular season schedule
+type Matchup struct {
+	ID         uuid.UUID `json:"id"`
+	LeagueID   uuid.UUID `json:"league_id"`
+	Season     string    `json:"season"`
+	Week       int       `json:"week"`
+	HomeTeamID uuid.UUID `json:"home_team_id"`
+	AwayTeamID uuid.UUID `json:"away_team_id"`
+	Division   bool      `json:"division"` // both teams play in the same division
+	Rivalry    bool      `json:"rivalry"`  // pinned by a rivalry setting
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SettingsTemplate is a named bundle of league and draft settings that can be applied
+// when creating a league or draft, so commissioners don't re-key settings each season
+type SettingsTemplate struct {
+	ID             uuid.UUID      `json:"id"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description"`
+	SportID        string         `json:"sport_id"`
+	OwnerID        *uuid.UUID     `json:"owner_id,omitempty"` // nil for built-in presets
+	IsPublic       bool           `json:"is_public"`
+	LeagueType     *LeagueType    `json:"league_type,omitempty"`
+	LeagueSettings interface{}    `json:"league_settings"` // JSONB stored as interface{}, like League.LeagueSettings
+	DraftType      *DraftType     `json:"draft_type,omitempty"`
+	DraftSettings  *DraftSettings `json:"draft_settings,omitempty"` // never carries a draft order
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// IsPreset reports whether the template is a built-in preset
+func (t *SettingsTemplate) IsPreset() bool {
+	return t.OwnerID == nil
+}
+
+// VisibleTo reports whether a user may see and apply the template
+func (t *SettingsTemplate) VisibleTo(userID uuid.UUID) bool {
+	return t.IsPublic || (t.OwnerID != nil && *t.OwnerID == userID)
+}
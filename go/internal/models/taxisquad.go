@@ -0,0 +1,124 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// League settings keys holding the taxi squad rules. Leagues without them have no taxi squad limits.
+const (
+	// LeagueSettingTaxiSlots is how many players a team may keep on its taxi squad
+	LeagueSettingTaxiSlots = "taxi_slots"
+	// LeagueSettingTaxiMaxExperience is the most years of pro experience a taxi squad player may have
+	LeagueSettingTaxiMaxExperience = "taxi_max_experience"
+	// LeagueSettingTaxiPromotionDeadline is the day of the season, as MM-DD, after which players can't
+	// move onto the taxi squad and players who no longer qualify for it must have been promoted
+	LeagueSettingTaxiPromotionDeadline = "taxi_promotion_deadline"
+)
+
+// TaxiSquadRules are the limits a league puts on its teams' taxi squads. Nil fields aren't enforced.
+type TaxiSquadRules struct {
+	Slots             *int
+	MaxExperience     *int
+	PromotionDeadline *time.Time
+}
+
+// Enforced reports whether the league sets any taxi squad rule
+func (r TaxiSquadRules) Enforced() bool {
+	return r.Slots != nil || r.MaxExperience != nil || r.PromotionDeadline != nil
+}
+
+// PastDeadline reports whether the promotion deadline has passed at now
+func (r TaxiSquadRules) PastDeadline(now time.Time) bool {
+	return r.PromotionDeadline != nil && !now.Before(*r.PromotionDeadline)
+}
+
+// ExperienceEligible reports whether a player with the given years of experience may be on the
+// taxi squad. Players without known experience are given the benefit of the doubt.
+func (r TaxiSquadRules) ExperienceEligible(experience *int) bool {
+	return r.MaxExperience == nil || experience == nil || *experience <= *r.MaxExperience
+}
+
+// SettingsTaxiSquadRules reads the taxi squad rules from a raw league_settings value. The promotion
+// deadline falls in the league's season, so it is only set for seasons named by their year, and
+// starts at midnight in the league's time zone.
+func SettingsTaxiSquadRules(settings interface{}, season string) TaxiSquadRules {
+	var rules TaxiSquadRules
+	m, ok := settings.(map[string]interface{})
+	if !ok {
+		return rules
+	}
+	if slots, ok := m[LeagueSettingTaxiSlots].(float64); ok {
+		n := int(slots)
+		rules.Slots = &n
+	}
+	if experience, ok := m[LeagueSettingTaxiMaxExperience].(float64); ok {
+		n := int(experience)
+		rules.MaxExperience = &n
+	}
+	if deadline, ok := m[LeagueSettingTaxiPromotionDeadline].(string); ok {
+		if at, err := time.ParseInLocation("2006-01-02", season+"-"+deadline, SettingsLeagueClock(settings).Location); err == nil {
+			rules.PromotionDeadline = &at
+		}
+	}
+	return rules
+}
+
+// ValidateTaxiSquadSettings checks the taxi squad keys of a league_settings map
+func ValidateTaxiSquadSettings(settings map[string]interface{}) error {
+	for _, key := range []string{LeagueSettingTaxiSlots, LeagueSettingTaxiMaxExperience} {
+		value, exists := settings[key]
+		if !exists {
+			continue
+		}
+		n, ok := value.(float64)
+		if !ok || n < 0 || n != math.Trunc(n) {
+			return fmt.Errorf("%s must be a non-negative whole number", key)
+		}
+	}
+	if value, exists := settings[LeagueSettingTaxiPromotionDeadline]; exists {
+		deadline, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be a MM-DD date", LeagueSettingTaxiPromotionDeadline)
+		}
+		// Checked against a leap year so a Feb 29 deadline is accepted
+		if _, err := time.Parse("2006-01-02", "2024-"+deadline); err != nil {
+			return fmt.Errorf("%s must be a MM-DD date", LeagueSettingTaxiPromotionDeadline)
+		}
+	}
+	return nil
+}
+
+// TaxiSquadViolationReason is the taxi squad rule a violation breaks
+type TaxiSquadViolationReason string
+
+const (
+	// TaxiSquadViolationOverLimit is a taxi squad holding more players than the league allows
+	TaxiSquadViolationOverLimit TaxiSquadViolationReason = "OVER_LIMIT"
+	// TaxiSquadViolationIneligibleExperience is a player left on the taxi squad past the promotion
+	// deadline with more experience than the league allows
+	TaxiSquadViolationIneligibleExperience TaxiSquadViolationReason = "INELIGIBLE_EXPERIENCE"
+)
+
+// TaxiSquadViolation is a taxi squad rule violation found by the nightly check
+type TaxiSquadViolation struct {
+	ID            uuid.UUID                `json:"id"`
+	LeagueID      uuid.UUID                `json:"league_id"`
+	FantasyTeamID uuid.UUID                `json:"fantasy_team_id"`
+	RosterID      *uuid.UUID               `json:"roster_id,omitempty"` // nil for squad-wide violations
+	Reason        TaxiSquadViolationReason `json:"reason"`
+	Detail        string                   `json:"detail"`
+	DetectedAt    time.Time                `json:"detected_at"`
+	ResolvedAt    *time.Time               `json:"resolved_at,omitempty"`
+}
+
+// TaxiSquadExemption is a commissioner's override of the taxi squad rules for one roster entry
+type TaxiSquadExemption struct {
+	RosterID  uuid.UUID  `json:"roster_id"`
+	GrantedBy *uuid.UUID `json:"granted_by,omitempty"`
+	Reason    string     `json:"reason"`
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -19,4 +19,40 @@ type Team struct {
 	Stadium         *string    `json:"stadium,omitempty"`
 	EstablishedYear *int       `json:"established_year,omitempty"`
 	CreatedAt       time.Time  `json:"created_at"`
-}
\ No newline at end of file
+	// LocalizedNames are the team's name and city in other locales, where providers supply them
+	LocalizedNames []TeamLocalizedName `json:"localized_names,omitempty"`
+}
+
+// TeamLocalizedName is a team's name and city in a locale, given as a BCP 47 tag, e.g. "es-MX"
+type TeamLocalizedName struct {
+	Locale string `json:"locale"`
+	Name   string `json:"name"`
+	City   string `json:"city"`
+}
+
+// DepthChartSlot represents a depth chart position mapped from an external source.
+// The team and player are identified by their external keys and resolved on ingest.
+type DepthChartSlot struct {
+	TeamCode         string `json:"team_code"`
+	PlayerExternalID string `json:"player_external_id"`
+	Position         string `json:"position"`
+	Depth            int    `json:"depth"`
+}
+
+// TeamDepthChartEntry represents a player's slot on a team's depth chart
+type TeamDepthChartEntry struct {
+	TeamID     uuid.UUID `json:"team_id"`
+	PlayerID   uuid.UUID `json:"player_id"`
+	PlayerName string    `json:"player_name"`
+	Position   string    `json:"position"`
+	Depth      int       `json:"depth"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// TeamDepthChart represents a team's depth chart along with its bye week
+type TeamDepthChart struct {
+	TeamID  uuid.UUID             `json:"team_id"`
+	Season  *int                  `json:"season,omitempty"`
+	ByeWeek *int                  `json:"bye_week,omitempty"`
+	Entries []TeamDepthChartEntry `json:"entries"`
+}
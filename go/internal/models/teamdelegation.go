@@ -0,0 +1,44 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Actions a delegate takes on a team, as recorded in its delegate action log
+const (
+	DelegateActionLineupUpdated = "LINEUP_UPDATED"
+	DelegateActionPickMade      = "PICK_MADE"
+)
+
+// TeamDelegation hands control of a team to another league member, its delegate, while the
+// team's owner is away. Between StartsAt and EndsAt the delegate may set the team's lineups and
+// make its draft picks, until the owner revokes the delegation.
+type TeamDelegation struct {
+	ID            uuid.UUID  `json:"id"`
+	FantasyTeamID uuid.UUID  `json:"fantasy_team_id"`
+	DelegateID    uuid.UUID  `json:"delegate_id"`
+	StartsAt      time.Time  `json:"starts_at"`
+	EndsAt        time.Time  `json:"ends_at"`
+	CreatedBy     *uuid.UUID `json:"created_by,omitempty"` // unset when created by another service
+	CreatedAt     time.Time  `json:"created_at"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty"`
+}
+
+// ActiveAt reports whether the delegate may manage the team at t
+func (d TeamDelegation) ActiveAt(t time.Time) bool {
+	return d.RevokedAt == nil && !t.Before(d.StartsAt) && t.Before(d.EndsAt)
+}
+
+// TeamDelegateAction records something a delegate did on a team while standing in for its owner
+type TeamDelegateAction struct {
+	ID            uuid.UUID       `json:"id"`
+	DelegationID  uuid.UUID       `json:"delegation_id"`
+	FantasyTeamID uuid.UUID       `json:"fantasy_team_id"`
+	DelegateID    uuid.UUID       `json:"delegate_id"`
+	Action        string          `json:"action"`
+	Details       json.RawMessage `json:"details"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
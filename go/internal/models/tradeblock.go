@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TradeBlockListing is a roster player their team is shopping to the rest of the league
+type TradeBlockListing struct {
+	RosterPlayerID  uuid.UUID  `json:"roster_player_id"`
+	LeagueID        uuid.UUID  `json:"league_id"`
+	FantasyTeamID   uuid.UUID  `json:"fantasy_team_id"`
+	FantasyTeamName string     `json:"fantasy_team_name"`
+	PlayerID        uuid.UUID  `json:"player_id"`
+	PlayerName      string     `json:"player_name"`
+	Note            string     `json:"note,omitempty"` // e.g. what the team wants back
+	ListedBy        *uuid.UUID `json:"listed_by,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// WishlistPlayer is a player a team would like to trade for
+type WishlistPlayer struct {
+	FantasyTeamID uuid.UUID `json:"fantasy_team_id"`
+	PlayerID      uuid.UUID `json:"player_id"`
+	PlayerName    string    `json:"player_name"`
+	Note          string    `json:"note,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	// The roster entry and team of the player's listing when another team has them on the block
+	OnBlockRosterPlayerID *uuid.UUID `json:"on_block_roster_player_id,omitempty"`
+	OnBlockFantasyTeamID  *uuid.UUID `json:"on_block_fantasy_team_id,omitempty"`
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TreasuryEntryKind is which way money in a treasury entry moved
+type TreasuryEntryKind string
+
+const (
+	TreasuryEntryKindDues   TreasuryEntryKind = "DUES"   // a team paid into the pot, negative for refunds
+	TreasuryEntryKindPayout TreasuryEntryKind = "PAYOUT" // the pot paid a team
+)
+
+// TreasuryPaymentMethod is how a treasury entry was recorded
+type TreasuryPaymentMethod string
+
+const (
+	TreasuryPaymentMethodManual TreasuryPaymentMethod = "MANUAL" // marked by the commissioner
+	TreasuryPaymentMethodStripe TreasuryPaymentMethod = "STRIPE" // a Stripe checkout completed
+)
+
+// PayoutPlace is the share of a league's pot paid to a finishing place
+type PayoutPlace struct {
+	Place    int    `json:"place"`
+	Label    string `json:"label,omitempty"` // e.g. "Champion"
+	ShareBps int    `json:"share_bps"`       // hundredths of a percent of the pot
+}
+
+// LeagueTreasury is a league's dues configuration
+type LeagueTreasury struct {
+	LeagueID      uuid.UUID     `json:"league_id"`
+	EntryFeeCents int64         `json:"entry_fee_cents"`
+	Currency      string        `json:"currency"` // ISO 4217, e.g. USD
+	Payouts       []PayoutPlace `json:"payouts"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// TreasuryEntry is money paid into or out of a league's pot
+type TreasuryEntry struct {
+	ID            uuid.UUID             `json:"id"`
+	LeagueID      uuid.UUID             `json:"league_id"`
+	FantasyTeamID uuid.UUID             `json:"fantasy_team_id"`
+	Kind          TreasuryEntryKind     `json:"kind"`
+	AmountCents   int64                 `json:"amount_cents"`
+	Method        TreasuryPaymentMethod `json:"method"`
+	Note          string                `json:"note,omitempty"`
+	RecordedBy    *uuid.UUID            `json:"recorded_by,omitempty"` // unset for Stripe payments
+	CreatedAt     time.Time             `json:"created_at"`
+}
+
+// TeamDuesBalance is what a team has paid into and received from a league's pot
+type TeamDuesBalance struct {
+	FantasyTeamID        uuid.UUID `json:"fantasy_team_id"`
+	FantasyTeamName      string    `json:"fantasy_team_name"`
+	DuesPaidCents        int64     `json:"dues_paid_cents"`
+	BalanceCents         int64     `json:"balance_cents"` // still owed; negative when overpaid
+	PayoutsReceivedCents int64     `json:"payouts_received_cents"`
+}
+
+// PaidInFull reports whether the team owes nothing more
+func (b TeamDuesBalance) PaidInFull() bool {
+	return b.BalanceCents <= 0
+}
+
+// TreasuryAuditEntry records a change to a league's treasury
+type TreasuryAuditEntry struct {
+	ID          uuid.UUID       `json:"id"`
+	LeagueID    uuid.UUID       `json:"league_id"`
+	ActorUserID *uuid.UUID      `json:"actor_user_id,omitempty"` // unset for Stripe and trusted callers
+	Action      string          `json:"action"`
+	Details     json.RawMessage `json:"details"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
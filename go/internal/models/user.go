@@ -6,10 +6,65 @@ import (
 	"github.com/google/uuid"
 )
 
+// UserTokenPurpose is what a single-use emailed token authorizes
+type UserTokenPurpose string
+
+const (
+	UserTokenPurposeEmailVerification UserTokenPurpose = "EMAIL_VERIFICATION"
+	UserTokenPurposePasswordReset     UserTokenPurpose = "PASSWORD_RESET"
+)
+
 // User represents a user in the system
 type User struct {
-	ID        uuid.UUID `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
+	ID              uuid.UUID  `json:"id"`
+	Username        string     `json:"username"`
+	Email           string     `json:"email"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	AvatarURL       *string    `json:"avatar_url,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// IsEmailVerified reports whether the user has verified their email address
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// NotificationChannel is a way notifications reach a user
+type NotificationChannel string
+
+const (
+	NotificationChannelEmail NotificationChannel = "EMAIL"
+	NotificationChannelPush  NotificationChannel = "PUSH"
+)
+
+// NotificationOptOut records that a user turned a notification off on one channel
+type NotificationOptOut struct {
+	UserID           uuid.UUID           `json:"user_id"`
+	NotificationType string              `json:"notification_type"` // a user outbox event type
+	Channel          NotificationChannel `json:"channel"`
+	CreatedAt        time.Time           `json:"created_at"`
+}
+
+// DigestFrequency is how often a user is sent a digest of the activity in their leagues
+type DigestFrequency string
+
+const (
+	DigestFrequencyOff    DigestFrequency = "OFF"
+	DigestFrequencyDaily  DigestFrequency = "DAILY"
+	DigestFrequencyWeekly DigestFrequency = "WEEKLY"
+
+	// DefaultDigestFrequency is the digest frequency of users who haven't chosen one
+	DefaultDigestFrequency = DigestFrequencyWeekly
+)
+
+// Period is how much activity one digest at this frequency covers, or 0 when digests are off
+func (f DigestFrequency) Period() time.Duration {
+	switch f {
+	case DigestFrequencyDaily:
+		return 24 * time.Hour
+	case DigestFrequencyWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
 }
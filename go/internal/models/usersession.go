@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserSession is a device a user is signed in on. Its tokens are only known when they are issued.
+type UserSession struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	DeviceName string     `json:"device_name"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"` // where the session was last seen from
+	CreatedAt  time.Time  `json:"created_at"`
+	LastSeenAt time.Time  `json:"last_seen_at"`
+	ExpiresAt  time.Time  `json:"expires_at"` // the session ends unless refreshed by then
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	// ImpersonatedBy is the operator who opened the session as the user to support them
+	ImpersonatedBy *string `json:"impersonated_by,omitempty"`
+}
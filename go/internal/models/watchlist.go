@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WatchlistPlayer is a player a user follows across every draft they take part in
+type WatchlistPlayer struct {
+	UserID     uuid.UUID `json:"user_id"`
+	PlayerID   uuid.UUID `json:"player_id"`
+	PlayerName string    `json:"player_name"`
+	// AlertWithinPicks is how many picks before the user's turn the player raises an alert
+	AlertWithinPicks int       `json:"alert_within_picks"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// WatchlistAlertKind defines why a watchlisted player raised an alert in a draft
+type WatchlistAlertKind string
+
+const (
+	// WatchlistAlertApproaching is a player still on the board with the user's pick coming up
+	WatchlistAlertApproaching WatchlistAlertKind = "APPROACHING"
+	// WatchlistAlertDrafted is a player another team drafted
+	WatchlistAlertDrafted WatchlistAlertKind = "DRAFTED"
+)
+
+// WatchlistAlert is an alert raised in a draft for a player on a user's watchlist
+type WatchlistAlert struct {
+	ID            uuid.UUID          `json:"id"`
+	DraftID       uuid.UUID          `json:"draft_id"`
+	LeagueName    string             `json:"league_name"`
+	UserID        uuid.UUID          `json:"user_id"`
+	FantasyTeamID uuid.UUID          `json:"fantasy_team_id"` // the user's team in the draft
+	PlayerID      uuid.UUID          `json:"player_id"`
+	PlayerName    string             `json:"player_name"`
+	Kind          WatchlistAlertKind `json:"kind"`
+	// The user's next pick when approaching, the pick that took the player when drafted
+	PickID       uuid.UUID `json:"pick_id"`
+	Round        int       `json:"round"`
+	Pick         int       `json:"pick"`
+	OverallPick  int       `json:"overall_pick"`
+	PickTeamID   uuid.UUID `json:"pick_team_id"`
+	PickTeamName string    `json:"pick_team_name"`
+	PicksAway    *int      `json:"picks_away,omitempty"` // picks left before the user's, approaching only
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,112 @@
+package natsconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Config holds the settings every service connects to NATS with. Credentials are optional;
+// at most one of CredsFile, NKeySeedFile and User may be set.
+type Config struct {
+	URL string
+	// CredsFile is a decorated JWT and NKey seed, as written by nsc
+	CredsFile string
+	// NKeySeedFile is an NKey seed, for servers that authorize bare NKeys
+	NKeySeedFile string
+	User         string
+	Password     string
+	// TLSCAFile verifies the server against a private CA; empty for the system roots
+	TLSCAFile string
+	// TLSCertFile and TLSKeyFile present a client certificate, for servers that verify clients
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
+// NewConfigFromEnv reads NATS_URL, NATS_CREDS_FILE, NATS_NKEY_SEED_FILE, NATS_USER,
+// NATS_PASSWORD, NATS_TLS_CA_FILE, NATS_TLS_CERT_FILE and NATS_TLS_KEY_FILE (with defaults).
+func NewConfigFromEnv() Config {
+	return Config{
+		URL:          getEnv("NATS_URL", nats.DefaultURL),
+		CredsFile:    os.Getenv("NATS_CREDS_FILE"),
+		NKeySeedFile: os.Getenv("NATS_NKEY_SEED_FILE"),
+		User:         os.Getenv("NATS_USER"),
+		Password:     os.Getenv("NATS_PASSWORD"),
+		TLSCAFile:    os.Getenv("NATS_TLS_CA_FILE"),
+		TLSCertFile:  os.Getenv("NATS_TLS_CERT_FILE"),
+		TLSKeyFile:   os.Getenv("NATS_TLS_KEY_FILE"),
+	}
+}
+
+// Default returns the settings of an unauthenticated NATS on localhost.
+func Default() Config {
+	return Config{URL: nats.DefaultURL}
+}
+
+// Validate reports settings that can't be combined.
+func (c Config) Validate() error {
+	methods := 0
+	for _, set := range []bool{c.CredsFile != "", c.NKeySeedFile != "", c.User != ""} {
+		if set {
+			methods++
+		}
+	}
+	if methods > 1 {
+		return errors.New("only one of NATS_CREDS_FILE, NATS_NKEY_SEED_FILE and NATS_USER may be set")
+	}
+	if c.Password != "" && c.User == "" {
+		return errors.New("NATS_PASSWORD requires NATS_USER")
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return errors.New("NATS_TLS_CERT_FILE and NATS_TLS_KEY_FILE must be set together")
+	}
+	return nil
+}
+
+// Options returns the credential and TLS options for the configured server.
+func (c Config) Options() ([]nats.Option, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	var opts []nats.Option
+	switch {
+	case c.CredsFile != "":
+		opts = append(opts, nats.UserCredentials(c.CredsFile))
+	case c.NKeySeedFile != "":
+		opt, err := nats.NkeyOptionFromSeed(c.NKeySeedFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NATS_NKEY_SEED_FILE: %w", err)
+		}
+		opts = append(opts, opt)
+	case c.User != "":
+		opts = append(opts, nats.UserInfo(c.User, c.Password))
+	}
+
+	if c.TLSCAFile != "" {
+		opts = append(opts, nats.RootCAs(c.TLSCAFile))
+	}
+	if c.TLSCertFile != "" {
+		opts = append(opts, nats.ClientCert(c.TLSCertFile, c.TLSKeyFile))
+	}
+	return opts, nil
+}
+
+// Connect opens a connection to the configured server. opts, such as reconnect settings and
+// handlers, are applied after the credential and TLS options.
+func (c Config) Connect(opts ...nats.Option) (*nats.Conn, error) {
+	authOpts, err := c.Options()
+	if err != nil {
+		return nil, err
+	}
+	return nats.Connect(c.URL, append(authOpts, opts...)...)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,186 @@
+package news
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sports/base"
+)
+
+const (
+	defaultNewsLimit    = 20
+	maxNewsLimit        = 100
+	defaultSyncLookback = 24 * time.Hour
+)
+
+// NewsRepository defines what the app layer needs from the repository
+type NewsRepository interface {
+	InsertPlayerNews(ctx context.Context, playerID uuid.UUID, item models.ExternalPlayerNews) (*models.PlayerNews, error)
+	GetPlayerNews(ctx context.Context, playerID uuid.UUID, limit int32) ([]models.PlayerNews, error)
+	GetPlayerIDByExternalID(ctx context.Context, sportID, externalID string) (uuid.UUID, error)
+	FindPlayerIDsByName(ctx context.Context, sportID, fullName string) ([]uuid.UUID, error)
+	ListActiveDraftTeamsForPlayer(ctx context.Context, playerID uuid.UUID) ([]ActiveDraftTeam, error)
+	ListLiveDraftIDsForSport(ctx context.Context, sportID string) ([]uuid.UUID, error)
+	ListInjuryAlertTeams(ctx context.Context, playerID uuid.UUID) ([]InjuryAlertTeam, error)
+}
+
+// App handles player news business logic
+type App struct {
+	repo    NewsRepository
+	plugins map[string]base.SportPlugin
+}
+
+// NewApp creates a new news App
+func NewApp(repo NewsRepository, plugins map[string]base.SportPlugin) *App {
+	return &App{
+		repo:    repo,
+		plugins: plugins,
+	}
+}
+
+// GetPlayerNews retrieves the latest news for a player
+func (a *App) GetPlayerNews(ctx context.Context, playerID uuid.UUID, limit int) ([]models.PlayerNews, error) {
+	if playerID == uuid.Nil {
+		return nil, fmt.Errorf("validation failed: player_id is required")
+	}
+	if limit <= 0 {
+		limit = defaultNewsLimit
+	}
+	if limit > maxNewsLimit {
+		limit = maxNewsLimit
+	}
+
+	news, err := a.repo.GetPlayerNews(ctx, playerID, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player news: %w", err)
+	}
+	return news, nil
+}
+
+// SyncPlayerNews pulls news from the sport plugin, associates each item with a player and stores new items
+func (a *App) SyncPlayerNews(ctx context.Context, req SyncPlayerNewsRequest) (*NewsSyncResult, error) {
+	if err := a.validateSyncPlayerNewsRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	plugin, ok := a.plugins[req.SportID]
+	if !ok {
+		return nil, fmt.Errorf("no plugin registered for sport %q", req.SportID)
+	}
+
+	since := req.Since
+	if since.IsZero() {
+		since = time.Now().Add(-defaultSyncLookback)
+	}
+
+	items, err := plugin.FetchPlayerNews(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch player news from plugin: %w", err)
+	}
+
+	return a.IngestPlayerNews(ctx, req.SportID, items)
+}
+
+// IngestPlayerNews stores news items, whether fetched by a sync or pushed to a plugin webhook
+func (a *App) IngestPlayerNews(ctx context.Context, sportID string, items []models.ExternalPlayerNews) (*NewsSyncResult, error) {
+	if _, ok := a.plugins[sportID]; !ok {
+		return nil, fmt.Errorf("no plugin registered for sport %q", sportID)
+	}
+
+	result := &NewsSyncResult{TotalFetched: len(items)}
+	for _, item := range items {
+		playerID, found, err := a.resolvePlayer(ctx, sportID, item)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("failed to resolve player for news %s: %w", item.ExternalID, err))
+			continue
+		}
+		if !found {
+			result.Unmatched++
+			continue
+		}
+
+		news, err := a.repo.InsertPlayerNews(ctx, playerID, item)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("failed to store news %s: %w", item.ExternalID, err))
+			continue
+		}
+		if news == nil {
+			result.Duplicates++
+			continue
+		}
+
+		result.Ingested++
+		result.News = append(result.News, *news)
+	}
+
+	log.Printf("Player news sync completed for %s: %d fetched, %d ingested, %d duplicates, %d unmatched, %d errors",
+		sportID, result.TotalFetched, result.Ingested, result.Duplicates, result.Unmatched, len(result.Errors))
+
+	return result, nil
+}
+
+// ListActiveDraftTeamsForPlayer returns the live drafts in which a player has been drafted or is rostered
+func (a *App) ListActiveDraftTeamsForPlayer(ctx context.Context, playerID uuid.UUID) ([]ActiveDraftTeam, error) {
+	teams, err := a.repo.ListActiveDraftTeamsForPlayer(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active drafts for player: %w", err)
+	}
+	return teams, nil
+}
+
+// ListLiveDraftIDsForSport returns the drafts in progress or paused in the leagues of a sport
+func (a *App) ListLiveDraftIDsForSport(ctx context.Context, sportID string) ([]uuid.UUID, error) {
+	ids, err := a.repo.ListLiveDraftIDsForSport(ctx, sportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list live drafts: %w", err)
+	}
+	return ids, nil
+}
+
+// ListInjuryAlertTeams returns the teams in live drafts to alert to a player's injury
+// designation: those that picked the player or queued them for nomination
+func (a *App) ListInjuryAlertTeams(ctx context.Context, playerID uuid.UUID) ([]InjuryAlertTeam, error) {
+	teams, err := a.repo.ListInjuryAlertTeams(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list injury alert teams: %w", err)
+	}
+	return teams, nil
+}
+
+// resolvePlayer maps a news item to a player, preferring the external ID and falling
+// back to an exact name match. Ambiguous names are treated as unmatched.
+func (a *App) resolvePlayer(ctx context.Context, sportID string, item models.ExternalPlayerNews) (uuid.UUID, bool, error) {
+	if item.PlayerExternalID != "" {
+		playerID, err := a.repo.GetPlayerIDByExternalID(ctx, sportID, item.PlayerExternalID)
+		if err == nil {
+			return playerID, true, nil
+		}
+	}
+
+	if item.PlayerName == "" {
+		return uuid.Nil, false, nil
+	}
+
+	ids, err := a.repo.FindPlayerIDsByName(ctx, sportID, item.PlayerName)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	if len(ids) != 1 {
+		return uuid.Nil, false, nil
+	}
+	return ids[0], true, nil
+}
+
+func (a *App) validateSyncPlayerNewsRequest(req SyncPlayerNewsRequest) error {
+	if req.SportID == "" {
+		return fmt.Errorf("sport_id is required")
+	}
+	if req.Since.After(time.Now()) {
+		return fmt.Errorf("since cannot be in the future")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+-- name: InsertPlayerNews :one
+-- Insert a news item; returns no rows when the item was already ingested.
+INSERT INTO player_news (
+    player_id,
+    source,
+    external_id,
+    headline,
+    body,
+    url,
+    published_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (source, external_id) DO NOTHING
+RETURNING *;
+
+-- name: GetPlayerNews :many
+SELECT * FROM player_news
+WHERE player_id = $1
+ORDER BY published_at DESC
+LIMIT $2;
+
+-- name: GetPlayerIDByExternalID :one
+SELECT id FROM players WHERE sport_id = $1 AND external_id = $2;
+
+-- name: FindPlayerIDsByName :many
+SELECT id FROM players WHERE sport_id = @sport_id AND lower(full_name) = lower(@full_name::text);
+
+-- name: ListActiveDraftTeamsForPlayer :many
+-- Live drafts in which the player has been picked or is already rostered, with the fantasy team holding them.
+SELECT d.id AS draft_id, dp.team_id AS fantasy_team_id
+FROM draft_picks dp
+JOIN draft d ON d.id = dp.draft_id
+WHERE dp.player_id = $1
+  AND d.status IN ('IN_PROGRESS', 'PAUSED')
+UNION
+SELECT d.id AS draft_id, rp.fantasy_team_id
+FROM roster_players rp
+JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+JOIN draft d ON d.league_id = ft.league_id
+WHERE rp.player_id = $1
+  AND d.status IN ('IN_PROGRESS', 'PAUSED');
+
+-- name: ListInjuryAlertRecipients :many
+-- Owners and co-managers of the teams in live drafts that picked the player or queued them for nomination.
+-- Sandbox drafts aren't real drafts for their teams' owners and are left out.
+WITH alerted AS (
+    SELECT dp.draft_id, dp.team_id AS fantasy_team_id, FALSE AS queued
+    FROM draft_picks dp
+    JOIN draft d ON d.id = dp.draft_id
+    WHERE dp.player_id = @player_id::uuid
+      AND d.status IN ('IN_PROGRESS', 'PAUSED')
+      AND d.sandbox_of_draft_id IS NULL
+    UNION
+    SELECT q.draft_id, q.fantasy_team_id, TRUE AS queued
+    FROM auction_nomination_queue q
+    JOIN draft d ON d.id = q.draft_id
+    WHERE q.player_id = @player_id::uuid
+      AND d.status IN ('IN_PROGRESS', 'PAUSED')
+      AND d.sandbox_of_draft_id IS NULL
+)
+SELECT a.draft_id, a.fantasy_team_id, a.queued, ft.owner_id AS user_id
+FROM alerted a
+JOIN fantasy_teams ft ON ft.id = a.fantasy_team_id
+UNION
+SELECT a.draft_id, a.fantasy_team_id, a.queued, cm.user_id
+FROM alerted a
+JOIN draft_co_managers cm ON cm.draft_id = a.draft_id AND cm.fantasy_team_id = a.fantasy_team_id
+ORDER BY draft_id, fantasy_team_id, user_id;
+
+-- name: ListLiveDraftIDsForSport :many
+-- Drafts in progress or paused in the leagues of a sport.
+SELECT d.id
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE l.sport_id = $1
+  AND l.deleted_at IS NULL
+  AND d.status IN ('IN_PROGRESS', 'PAUSED')
+ORDER BY d.id;
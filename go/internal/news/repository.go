@@ -0,0 +1,173 @@
+package news
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/news/db"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	FindPlayerIDsByName(ctx context.Context, arg db.FindPlayerIDsByNameParams) ([]uuid.UUID, error)
+	GetPlayerIDByExternalID(ctx context.Context, arg db.GetPlayerIDByExternalIDParams) (uuid.UUID, error)
+	GetPlayerNews(ctx context.Context, arg db.GetPlayerNewsParams) ([]db.PlayerNews, error)
+	InsertPlayerNews(ctx context.Context, arg db.InsertPlayerNewsParams) (db.PlayerNews, error)
+	ListActiveDraftTeamsForPlayer(ctx context.Context, playerID uuid.NullUUID) ([]db.ListActiveDraftTeamsForPlayerRow, error)
+	ListInjuryAlertRecipients(ctx context.Context, playerID uuid.UUID) ([]db.ListInjuryAlertRecipientsRow, error)
+	ListLiveDraftIDsForSport(ctx context.Context, sportID string) ([]uuid.UUID, error)
+}
+
+// Repository implements player news data access operations
+type Repository struct {
+	queries Querier
+}
+
+// NewRepository creates a new news repository
+func NewRepository(querier Querier) *Repository {
+	return &Repository{
+		queries: querier,
+	}
+}
+
+// InsertPlayerNews stores a news item for a player. It returns nil without error
+// when the item has already been ingested from the same source.
+func (r *Repository) InsertPlayerNews(ctx context.Context, playerID uuid.UUID, item models.ExternalPlayerNews) (*models.PlayerNews, error) {
+	news, err := r.queries.InsertPlayerNews(ctx, db.InsertPlayerNewsParams{
+		PlayerID:    playerID,
+		Source:      item.Source,
+		ExternalID:  item.ExternalID,
+		Headline:    item.Headline,
+		Body:        sql.NullString{String: item.Body, Valid: item.Body != ""},
+		Url:         sql.NullString{String: item.URL, Valid: item.URL != ""},
+		PublishedAt: item.PublishedAt,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to insert player news: %w", err)
+	}
+
+	return r.dbPlayerNewsToModel(news), nil
+}
+
+// GetPlayerNews retrieves the latest news for a player, newest first
+func (r *Repository) GetPlayerNews(ctx context.Context, playerID uuid.UUID, limit int32) ([]models.PlayerNews, error) {
+	rows, err := r.queries.GetPlayerNews(ctx, db.GetPlayerNewsParams{
+		PlayerID: playerID,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player news: %w", err)
+	}
+
+	result := make([]models.PlayerNews, len(rows))
+	for i, row := range rows {
+		result[i] = *r.dbPlayerNewsToModel(row)
+	}
+	return result, nil
+}
+
+// GetPlayerIDByExternalID resolves a player by the sport's external ID
+func (r *Repository) GetPlayerIDByExternalID(ctx context.Context, sportID, externalID string) (uuid.UUID, error) {
+	id, err := r.queries.GetPlayerIDByExternalID(ctx, db.GetPlayerIDByExternalIDParams{
+		SportID:    sportID,
+		ExternalID: externalID,
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get player by external ID: %w", err)
+	}
+	return id, nil
+}
+
+// FindPlayerIDsByName returns all players of a sport whose full name matches case-insensitively
+func (r *Repository) FindPlayerIDsByName(ctx context.Context, sportID, fullName string) ([]uuid.UUID, error) {
+	ids, err := r.queries.FindPlayerIDsByName(ctx, db.FindPlayerIDsByNameParams{
+		SportID:  sportID,
+		FullName: fullName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find players by name: %w", err)
+	}
+	return ids, nil
+}
+
+// ListActiveDraftTeamsForPlayer returns the live drafts in which a player has been drafted or is rostered
+func (r *Repository) ListActiveDraftTeamsForPlayer(ctx context.Context, playerID uuid.UUID) ([]ActiveDraftTeam, error) {
+	rows, err := r.queries.ListActiveDraftTeamsForPlayer(ctx, uuid.NullUUID{UUID: playerID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active drafts for player: %w", err)
+	}
+
+	result := make([]ActiveDraftTeam, len(rows))
+	for i, row := range rows {
+		result[i] = ActiveDraftTeam{
+			DraftID:       row.DraftID,
+			FantasyTeamID: row.FantasyTeamID,
+		}
+	}
+	return result, nil
+}
+
+// ListLiveDraftIDsForSport returns the drafts in progress or paused in the leagues of a sport
+func (r *Repository) ListLiveDraftIDsForSport(ctx context.Context, sportID string) ([]uuid.UUID, error) {
+	ids, err := r.queries.ListLiveDraftIDsForSport(ctx, sportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list live drafts for sport: %w", err)
+	}
+	return ids, nil
+}
+
+// ListInjuryAlertTeams returns the teams in live drafts that picked a player or queued them for
+// nomination, with the users managing each. A team that queued a player it went on to pick
+// is alerted as having drafted them.
+func (r *Repository) ListInjuryAlertTeams(ctx context.Context, playerID uuid.UUID) ([]InjuryAlertTeam, error) {
+	rows, err := r.queries.ListInjuryAlertRecipients(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list injury alert recipients: %w", err)
+	}
+
+	var result []InjuryAlertTeam
+	index := make(map[ActiveDraftTeam]int)
+	for _, row := range rows {
+		key := ActiveDraftTeam{DraftID: row.DraftID, FantasyTeamID: row.FantasyTeamID}
+		i, ok := index[key]
+		if !ok {
+			i = len(result)
+			index[key] = i
+			result = append(result, InjuryAlertTeam{DraftID: row.DraftID, FantasyTeamID: row.FantasyTeamID, Queued: true})
+		}
+		team := &result[i]
+		team.Queued = team.Queued && row.Queued
+		if !slices.Contains(team.UserIDs, row.UserID) {
+			team.UserIDs = append(team.UserIDs, row.UserID)
+		}
+	}
+	return result, nil
+}
+
+// dbPlayerNewsToModel converts a database news item to domain model
+func (r *Repository) dbPlayerNewsToModel(news db.PlayerNews) *models.PlayerNews {
+	result := &models.PlayerNews{
+		ID:          news.ID,
+		PlayerID:    news.PlayerID,
+		Source:      news.Source,
+		ExternalID:  news.ExternalID,
+		Headline:    news.Headline,
+		PublishedAt: news.PublishedAt,
+		CreatedAt:   news.CreatedAt,
+	}
+	if news.Body.Valid {
+		result.Body = &news.Body.String
+	}
+	if news.Url.Valid {
+		result.URL = &news.Url.String
+	}
+	return result
+}
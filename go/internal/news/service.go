@@ -0,0 +1,233 @@
+package news
+
+import (
+	"context"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	newsv1 "github.com/mcdev12/dynasty/go/internal/genproto/news/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/news/v1/newsv1connect"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewsApp defines what the service layer needs from the news application
+type NewsApp interface {
+	GetPlayerNews(ctx context.Context, playerID uuid.UUID, limit int) ([]models.PlayerNews, error)
+	SyncPlayerNews(ctx context.Context, req SyncPlayerNewsRequest) (*NewsSyncResult, error)
+	IngestPlayerNews(ctx context.Context, sportID string, items []models.ExternalPlayerNews) (*NewsSyncResult, error)
+	ListActiveDraftTeamsForPlayer(ctx context.Context, playerID uuid.UUID) ([]ActiveDraftTeam, error)
+	ListLiveDraftIDsForSport(ctx context.Context, sportID string) ([]uuid.UUID, error)
+	ListInjuryAlertTeams(ctx context.Context, playerID uuid.UUID) ([]InjuryAlertTeam, error)
+}
+
+// OutboxApp defines what the news service needs from the outbox
+type OutboxApp interface {
+	InsertPlayerNewsEvent(ctx context.Context, draftID uuid.UUID, payload events.PlayerNewsPayload) error
+	InsertPlayerInjuryDesignatedEvent(ctx context.Context, draftID uuid.UUID, payload events.PlayerInjuryDesignatedPayload) error
+	InsertPlayerInjuryAlertEvent(ctx context.Context, draftID uuid.UUID, payload events.PlayerInjuryAlertPayload) error
+}
+
+// Service implements the NewsService gRPC interface
+type Service struct {
+	app       NewsApp
+	outboxApp OutboxApp
+}
+
+// NewService creates a new news gRPC service
+func NewService(app NewsApp, outboxApp OutboxApp) *Service {
+	return &Service{
+		app:       app,
+		outboxApp: outboxApp,
+	}
+}
+
+// Verify that Service implements the NewsServiceHandler interface
+var _ newsv1connect.NewsServiceHandler = (*Service)(nil)
+
+// GetPlayerNews retrieves the latest news for a player
+func (s *Service) GetPlayerNews(ctx context.Context, req *connect.Request[newsv1.GetPlayerNewsRequest]) (*connect.Response[newsv1.GetPlayerNewsResponse], error) {
+	playerID, err := uuidutil.MustParseOrInvalidArg("player_id", req.Msg.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	news, err := s.app.GetPlayerNews(ctx, playerID, int(req.Msg.Limit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoNews := make([]*newsv1.PlayerNews, len(news))
+	for i := range news {
+		protoNews[i] = s.playerNewsToProto(&news[i])
+	}
+
+	return connect.NewResponse(&newsv1.GetPlayerNewsResponse{
+		News: protoNews,
+	}), nil
+}
+
+// SyncPlayerNews ingests player news and alerts live drafts holding the affected players
+func (s *Service) SyncPlayerNews(ctx context.Context, req *connect.Request[newsv1.SyncPlayerNewsRequest]) (*connect.Response[newsv1.SyncPlayerNewsResponse], error) {
+	appReq := SyncPlayerNewsRequest{
+		SportID: req.Msg.SportId,
+	}
+	if req.Msg.Since != nil {
+		appReq.Since = req.Msg.Since.AsTime()
+	}
+
+	result, err := s.app.SyncPlayerNews(ctx, appReq)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.alertNews(ctx, result)
+
+	return connect.NewResponse(&newsv1.SyncPlayerNewsResponse{
+		Result: s.newsSyncResultToProto(result),
+	}), nil
+}
+
+// IngestPlayerNews stores news pushed to a sport plugin's webhook, alerting live drafts as a
+// sync does
+func (s *Service) IngestPlayerNews(ctx context.Context, sportID string, items []models.ExternalPlayerNews) (*NewsSyncResult, error) {
+	result, err := s.app.IngestPlayerNews(ctx, sportID, items)
+	if err != nil {
+		return nil, err
+	}
+	s.alertNews(ctx, result)
+	return result, nil
+}
+
+// alertNews emits breaking news to any live draft where the player is already on a team
+func (s *Service) alertNews(ctx context.Context, result *NewsSyncResult) {
+	for i := range result.News {
+		alerts, err := s.emitPlayerNewsEvents(ctx, &result.News[i])
+		if err != nil {
+			log.Printf("Failed to emit PlayerNews events for news %s: %v", result.News[i].ID, err)
+		}
+		result.DraftAlerts += alerts
+	}
+}
+
+// emitPlayerNewsEvents emits a PlayerNews event to the outbox of every live draft holding the player
+func (s *Service) emitPlayerNewsEvents(ctx context.Context, news *models.PlayerNews) (int, error) {
+	teams, err := s.app.ListActiveDraftTeamsForPlayer(ctx, news.PlayerID)
+	if err != nil {
+		return 0, err
+	}
+
+	emitted := 0
+	for _, team := range teams {
+		payload := events.PlayerNewsPayload{
+			NewsID:        news.ID.String(),
+			PlayerID:      news.PlayerID.String(),
+			FantasyTeamID: team.FantasyTeamID.String(),
+			Headline:      news.Headline,
+			Source:        news.Source,
+			PublishedAt:   news.PublishedAt,
+		}
+		if news.URL != nil {
+			payload.URL = *news.URL
+		}
+
+		if err := s.outboxApp.InsertPlayerNewsEvent(ctx, team.DraftID, payload); err != nil {
+			return emitted, err
+		}
+		emitted++
+	}
+
+	return emitted, nil
+}
+
+// AlertPlayerInjury tells live drafts that a player synced from the sport's data provider was
+// put on an injury designation. Every live draft of the player's sport is sent a
+// PlayerInjuryDesignated event to flag the player in its available list, and the managers of
+// teams that drafted or queued the player a PlayerInjuryAlert. It returns the events emitted.
+func (s *Service) AlertPlayerInjury(ctx context.Context, change models.PlayerStatusChange) (int, error) {
+	if !change.NewInjury() {
+		return 0, nil
+	}
+
+	draftIDs, err := s.app.ListLiveDraftIDsForSport(ctx, change.SportID)
+	if err != nil {
+		return 0, err
+	}
+	teams, err := s.app.ListInjuryAlertTeams(ctx, change.PlayerID)
+	if err != nil {
+		return 0, err
+	}
+
+	emitted := 0
+	designated := events.PlayerInjuryDesignatedPayload{
+		PlayerID:       change.PlayerID.String(),
+		PlayerName:     change.FullName,
+		Position:       change.Position,
+		Status:         change.Status,
+		PreviousStatus: change.PreviousStatus,
+		DesignatedAt:   change.ChangedAt,
+	}
+	for _, draftID := range draftIDs {
+		if err := s.outboxApp.InsertPlayerInjuryDesignatedEvent(ctx, draftID, designated); err != nil {
+			return emitted, err
+		}
+		emitted++
+	}
+
+	for _, team := range teams {
+		payload := events.PlayerInjuryAlertPayload{
+			PlayerID:      change.PlayerID.String(),
+			PlayerName:    change.FullName,
+			Status:        change.Status,
+			FantasyTeamID: team.FantasyTeamID.String(),
+			Reason:        events.InjuryAlertDrafted,
+			UserIDs:       make([]string, len(team.UserIDs)),
+			DesignatedAt:  change.ChangedAt,
+		}
+		if team.Queued {
+			payload.Reason = events.InjuryAlertQueued
+		}
+		for i, userID := range team.UserIDs {
+			payload.UserIDs[i] = userID.String()
+		}
+
+		if err := s.outboxApp.InsertPlayerInjuryAlertEvent(ctx, team.DraftID, payload); err != nil {
+			return emitted, err
+		}
+		emitted++
+	}
+
+	return emitted, nil
+}
+
+// Conversion methods between proto and app layer models
+
+func (s *Service) playerNewsToProto(news *models.PlayerNews) *newsv1.PlayerNews {
+	return &newsv1.PlayerNews{
+		Id:          news.ID.String(),
+		PlayerId:    news.PlayerID.String(),
+		Source:      news.Source,
+		Headline:    news.Headline,
+		Body:        news.Body,
+		Url:         news.URL,
+		PublishedAt: timestamppb.New(news.PublishedAt),
+	}
+}
+
+func (s *Service) newsSyncResultToProto(result *NewsSyncResult) *newsv1.NewsSyncResult {
+	errors := make([]string, len(result.Errors))
+	for i, err := range result.Errors {
+		errors[i] = err.Error()
+	}
+
+	return &newsv1.NewsSyncResult{
+		TotalFetched: int32(result.TotalFetched),
+		Ingested:     int32(result.Ingested),
+		Duplicates:   int32(result.Duplicates),
+		Unmatched:    int32(result.Unmatched),
+		DraftAlerts:  int32(result.DraftAlerts),
+		Errors:       errors,
+	}
+}
@@ -0,0 +1,42 @@
+package news
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// SyncPlayerNewsRequest represents a request to ingest player news from a sport plugin
+type SyncPlayerNewsRequest struct {
+	SportID string    `json:"sport_id"`
+	Since   time.Time `json:"since"`
+}
+
+// NewsSyncResult represents the result of ingesting player news
+type NewsSyncResult struct {
+	TotalFetched int     `json:"total_fetched"`
+	Ingested     int     `json:"ingested"`
+	Duplicates   int     `json:"duplicates"`
+	Unmatched    int     `json:"unmatched"`    // items whose player could not be resolved
+	DraftAlerts  int     `json:"draft_alerts"` // PlayerNews events emitted to live drafts
+	Errors       []error `json:"errors,omitempty"`
+
+	// News holds the newly ingested items so callers can alert live drafts
+	News []models.PlayerNews `json:"-"`
+}
+
+// InjuryAlertTeam is a team in a live draft alerted to a player's injury designation, with
+// the owner and co-managers its alert is sent to
+type InjuryAlertTeam struct {
+	DraftID       uuid.UUID   `json:"draft_id"`
+	FantasyTeamID uuid.UUID   `json:"fantasy_team_id"`
+	Queued        bool        `json:"queued"` // the team queued the player for nomination rather than drafting them
+	UserIDs       []uuid.UUID `json:"user_ids"`
+}
+
+// ActiveDraftTeam identifies a fantasy team holding a player in a live draft
+type ActiveDraftTeam struct {
+	DraftID       uuid.UUID `json:"draft_id"`
+	FantasyTeamID uuid.UUID `json:"fantasy_team_id"`
+}
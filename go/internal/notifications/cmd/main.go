@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+	_ "github.com/lib/pq"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/admin"
+	"github.com/mcdev12/dynasty/go/internal/dbconfig"
+	"github.com/mcdev12/dynasty/go/internal/notifications"
+	"github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+func main() {
+	// load .env
+	if err := godotenv.Load(); err != nil {
+		log.Warn().Err(err).Msg("could not load .env file")
+	}
+
+	// configure zerolog console output and level
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stdout})
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+
+	// DB config
+	cfg := dbconfig.NewConfigFromEnv()
+	db, err := sql.Open("postgres", cfg.DSN())
+	if err != nil {
+		log.Fatal().Err(err).Msg("open database")
+	}
+	defer db.Close()
+	if err := db.Ping(); err != nil {
+		log.Fatal().Err(err).Msg("ping database")
+	}
+	log.Info().
+		Str("host", cfg.Host).
+		Int("port", cfg.Port).
+		Str("database", cfg.Database).
+		Msg("connected to database")
+
+	// Mailer: SMTP when a host is configured, otherwise emails are only logged
+	var mailer notifications.Mailer = notifications.LogMailer{}
+	if host := os.Getenv("SMTP_HOST"); host != "" {
+		port := 587
+		if p, err := strconv.Atoi(os.Getenv("SMTP_PORT")); err == nil {
+			port = p
+		}
+		mailer = notifications.NewSMTPMailer(notifications.SMTPConfig{
+			Host:     host,
+			Port:     port,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     getEnv("SMTP_FROM", "no-reply@dynasty.local"),
+		})
+		log.Info().Str("host", host).Int("port", port).Msg("sending email over SMTP")
+	}
+
+	// Worker config
+	wCfg := notifications.DefaultWorkerConfig()
+	wCfg.AppBaseURL = getEnv("APP_BASE_URL", wCfg.AppBaseURL)
+	// Verification and password reset tokens arrive sealed by the users service
+	wCfg.TokenSecret = []byte(os.Getenv(events.EmailTokenSecretEnv))
+	if len(wCfg.TokenSecret) == 0 {
+		log.Warn().Msgf("%s not set, verification and password reset emails can't be sent", events.EmailTokenSecretEnv)
+	}
+	if iv := os.Getenv("POLL_INTERVAL"); iv != "" {
+		if d, err := time.ParseDuration(iv); err == nil {
+			wCfg.PollInterval = d
+		}
+	}
+
+	// No push provider is wired up yet, so push notifications are only logged
+	worker := notifications.NewWorker(db, mailer, notifications.LogPusher{}, wCfg)
+
+	// signal‐aware context
+	ctx, stop := signal.NotifyContext(context.Background(),
+		syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// The worker has no HTTP server of its own, so the runtime debug endpoints get one
+	adminMux := http.NewServeMux()
+	if admin.Mount(adminMux, admin.Config{
+		Service: "notifications",
+		Token:   os.Getenv("ADMIN_API_TOKEN"),
+		Settings: func() any {
+			return map[string]any{
+				"poll_interval": wCfg.PollInterval.String(),
+				"app_base_url":  wCfg.AppBaseURL,
+			}
+		},
+	}) {
+		adminServer := &http.Server{
+			Addr:              getEnv("ADMIN_ADDR", ":8084"),
+			Handler:           adminMux,
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+		go func() {
+			log.Info().Str("addr", adminServer.Addr).Msg("starting admin server")
+			if err := adminServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Error().Err(err).Msg("admin server failed")
+			}
+		}()
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = adminServer.Shutdown(shutdownCtx)
+		}()
+	}
+
+	log.Info().Dur("poll_interval", wCfg.PollInterval).Msg("starting notification worker")
+	if err := worker.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		log.Error().Err(err).Msg("notification worker exited unexpectedly")
+		return
+	}
+	log.Info().Msg("graceful shutdown complete")
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
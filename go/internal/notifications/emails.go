@@ -0,0 +1,506 @@
+package notifications
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+// errUnknownEvent marks outbox events the worker has no email or push notification for
+var errUnknownEvent = errors.New("no notification for event type")
+
+// renderEmail builds the email for a user outbox event. Links point at baseURL, and emailed
+// tokens are opened with tokenSecret.
+func renderEmail(eventType string, payload []byte, baseURL string, tokenSecret []byte) (Message, error) {
+	switch eventType {
+	case events.EmailVerificationRequested:
+		var p events.EmailTokenPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		token, err := events.OpenToken(tokenSecret, p.SealedToken)
+		if err != nil {
+			return Message{}, fmt.Errorf("open %s token: %w", eventType, err)
+		}
+		return Message{
+			To:      p.Email,
+			Subject: "Verify your email address",
+			Body: fmt.Sprintf("Hi %s,\n\nConfirm your email address by opening this link:\n\n%s\n\nThe link expires %s.\n",
+				p.Username, tokenLink(baseURL, "/verify-email", token), formatExpiry(p.ExpiresAt)),
+		}, nil
+
+	case events.PasswordResetRequested:
+		var p events.EmailTokenPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		token, err := events.OpenToken(tokenSecret, p.SealedToken)
+		if err != nil {
+			return Message{}, fmt.Errorf("open %s token: %w", eventType, err)
+		}
+		return Message{
+			To:      p.Email,
+			Subject: "Reset your password",
+			Body: fmt.Sprintf("Hi %s,\n\nSomeone asked to reset the password for your account. If it was you, open this link:\n\n%s\n\nThe link expires %s and works once. If you didn't ask for this, you can ignore this email.\n",
+				p.Username, tokenLink(baseURL, "/reset-password", token), formatExpiry(p.ExpiresAt)),
+		}, nil
+
+	case events.PasswordChanged:
+		var p events.PasswordChangedPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		return Message{
+			To:      p.Email,
+			Subject: "Your password was changed",
+			Body: fmt.Sprintf("Hi %s,\n\nThe password for your account was changed on %s and you were signed out on every device. If this wasn't you, reset your password right away.\n",
+				p.Username, p.ChangedAt.UTC().Format(time.RFC1123)),
+		}, nil
+
+	case events.PickClockWarning:
+		var p events.PickClockWarningPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		return Message{
+			To:      p.Email,
+			Subject: fmt.Sprintf("%s is almost out of time to pick", p.TeamName),
+			Body: fmt.Sprintf("Hi %s,\n\n%s is on the clock with pick %d.%02d (%d overall) and the clock runs out %s. Make your pick here:\n\n%s\n",
+				p.Username, p.TeamName, p.Round, p.Pick, p.OverallPick, formatLeagueTime(p.Deadline, p.Timezone, p.Locale), draftLink(baseURL, p.DraftID)),
+		}, nil
+
+	case events.DraftStartingSoon:
+		var p events.DraftStartingSoonPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		return Message{
+			To:      p.Email,
+			Subject: fmt.Sprintf("The %s draft is about to start", p.LeagueName),
+			Body: fmt.Sprintf("Hi %s,\n\nThe %s draft starts %s. Join the draft room with %s here:\n\n%s\n",
+				p.Username, p.LeagueName, formatLeagueTime(p.ScheduledAt, p.Timezone, p.Locale), p.TeamName, draftLink(baseURL, p.DraftID)),
+		}, nil
+
+	case events.DraftScheduled:
+		var p events.DraftScheduledPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		subject := fmt.Sprintf("The %s draft is scheduled", p.LeagueName)
+		when := fmt.Sprintf("The %s draft is scheduled to start %s.", p.LeagueName, formatLeagueTime(p.ScheduledAt, p.Timezone, p.Locale))
+		if p.PreviousScheduledAt != nil {
+			subject = fmt.Sprintf("The %s draft has moved", p.LeagueName)
+			when = fmt.Sprintf("The %s draft has moved and now starts %s, not %s.", p.LeagueName,
+				formatLeagueTime(p.ScheduledAt, p.Timezone, p.Locale), formatLeagueTime(*p.PreviousScheduledAt, p.Timezone, p.Locale))
+		}
+		event := draftCalendarEvent(baseURL, p.DraftID, p.LeagueName, p.TeamName, p.ScheduledAt, p.Sequence)
+		return Message{
+			To:      p.Email,
+			Subject: subject,
+			Body: fmt.Sprintf("Hi %s,\n\n%s The attached invite puts it in your calendar. Join the draft room with %s here:\n\n%s\n",
+				p.Username, when, p.TeamName, draftLink(baseURL, p.DraftID)),
+			Attachments: []Attachment{icsAttachment(event, time.Now())},
+		}, nil
+
+	case events.LeagueChatMention:
+		var p events.LeagueChatMentionPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		return Message{
+			To:      p.Email,
+			Subject: fmt.Sprintf("%s mentioned you in %s", p.AuthorUsername, p.LeagueName),
+			Body: fmt.Sprintf("Hi %s,\n\n%s mentioned you in \"%s\":\n\n%s\n\nReply here:\n\n%s\n",
+				p.Username, p.AuthorUsername, p.ThreadTitle, p.Excerpt, threadLink(baseURL, p.LeagueID, p.ThreadID)),
+		}, nil
+
+	case events.WishlistPlayerOnBlock:
+		var p events.WishlistPlayerOnBlockPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		note := ""
+		if p.Note != "" {
+			note = fmt.Sprintf("\n\nThey added: %s", p.Note)
+		}
+		return Message{
+			To:      p.Email,
+			Subject: fmt.Sprintf("%s is on the trade block", p.PlayerName),
+			Body: fmt.Sprintf("Hi %s,\n\n%s put %s, who is on the %s wishlist, on the trade block in %s.%s\n\nSee the trade block here:\n\n%s\n",
+				p.Username, p.ListingTeamName, p.PlayerName, p.TeamName, p.LeagueName, note, tradeBlockLink(baseURL, p.LeagueID)),
+		}, nil
+
+	case events.LineupIssues:
+		var p events.LineupIssuesPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		return Message{
+			To:      p.Email,
+			Subject: fmt.Sprintf("Fix the %s lineup before it locks", p.TeamName),
+			Body: fmt.Sprintf("Hi %s,\n\nLineups in %s lock %s, and the %s lineup has problems:\n\n- %s\n\nSet your lineup here:\n\n%s\n",
+				p.Username, p.LeagueName, formatLeagueTime(p.LocksAt, p.Timezone, p.Locale), p.TeamName, strings.Join(p.Issues, "\n- "), lineupLink(baseURL, p.LeagueID, p.FantasyTeamID)),
+		}, nil
+
+	case events.LeagueDigest:
+		var p events.LeagueDigestPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		var body strings.Builder
+		fmt.Fprintf(&body, "Hi %s,\n\nHere's what happened in your leagues %s.\n", p.Username, digestPeriod(p.Frequency))
+		for _, league := range p.Leagues {
+			fmt.Fprintf(&body, "\n%s\n\n", league.LeagueName)
+			if league.MoreTransactions > 0 {
+				fmt.Fprintf(&body, "- %d earlier transactions\n", league.MoreTransactions)
+			}
+			for _, txn := range league.Transactions {
+				fmt.Fprintf(&body, "- %s\n", describeDigestTransaction(txn))
+			}
+			fmt.Fprintf(&body, "\nThe full transaction log: %s\n", transactionsLink(baseURL, league.LeagueID))
+		}
+		return Message{
+			To:      p.Email,
+			Subject: fmt.Sprintf("Your %s league digest", strings.ToLower(p.Frequency)),
+			Body:    body.String(),
+		}, nil
+
+	case events.LeagueArchiveWarning:
+		var p events.LeagueArchiveWarningPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		return Message{
+			To:      p.Email,
+			Subject: fmt.Sprintf("%s will be archived for inactivity", p.LeagueName),
+			Body: fmt.Sprintf("Hi %s,\n\nNothing has happened in %s since %s. Unless the league is used again, it will be archived %s: everything in it is kept, but it becomes read only and drops out of league lists.\n\nTo keep it active, make any change to the league here:\n\n%s\n\nAn archived league can be restored by its commissioner at any time.\n",
+				p.Username, p.LeagueName, p.LastActivityAt.UTC().Format("January 2, 2006"), formatLeagueTime(p.ArchiveAfter, "", ""), leagueLink(baseURL, p.LeagueID)),
+		}, nil
+
+	case events.DraftRecap:
+		var p events.DraftRecapPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return Message{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		var body strings.Builder
+		fmt.Fprintf(&body, "Hi %s,\n\nThe %s draft is complete.", p.Username, p.LeagueName)
+		if p.Grade != "" {
+			fmt.Fprintf(&body, " %s graded out %s, %s of %d teams with %.1f projected points.", p.TeamName, p.Grade, ordinal(p.Rank), p.Teams, p.ProjectedPoints)
+		}
+		body.WriteString("\n")
+		if len(p.BestValues) > 0 {
+			body.WriteString("\nBest values\n\n")
+			for _, pick := range p.BestValues {
+				fmt.Fprintf(&body, "- %s\n", describeRecapPick(pick))
+			}
+		}
+		if len(p.BiggestReaches) > 0 {
+			body.WriteString("\nBiggest reaches\n\n")
+			for _, pick := range p.BiggestReaches {
+				fmt.Fprintf(&body, "- %s\n", describeRecapPick(pick))
+			}
+		}
+		fmt.Fprintf(&body, "\nSee the full recap and every team's grade here:\n\n%s\n", recapLink(baseURL, p.DraftID))
+		return Message{
+			To:      p.Email,
+			Subject: fmt.Sprintf("Your %s draft recap", p.LeagueName),
+			Body:    body.String(),
+		}, nil
+
+	default:
+		return Message{}, fmt.Errorf("%w %q", errUnknownEvent, eventType)
+	}
+}
+
+// renderPush builds the push notification for a user outbox event. Only time-sensitive events,
+// mentions, trade block alerts, digests, draft recaps and on-the-clock frames the draft room
+// didn't deliver are pushed; the rest return errUnknownEvent.
+func renderPush(eventType string, payload []byte, baseURL string) (PushMessage, error) {
+	switch eventType {
+	case events.PickClockWarning:
+		var p events.PickClockWarningPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return PushMessage{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		return PushMessage{
+			UserID: p.UserID,
+			Title:  "You're almost out of time",
+			Body:   fmt.Sprintf("%s has %d%% of the clock left for pick %d.%02d", p.TeamName, p.PercentRemaining, p.Round, p.Pick),
+			URL:    draftLink(baseURL, p.DraftID),
+		}, nil
+
+	case events.DraftStartingSoon:
+		var p events.DraftStartingSoonPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return PushMessage{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		return PushMessage{
+			UserID: p.UserID,
+			Title:  "Your draft is about to start",
+			Body:   fmt.Sprintf("The %s draft starts in %s", p.LeagueName, formatCountdown(p.ScheduledAt)),
+			URL:    draftLink(baseURL, p.DraftID),
+		}, nil
+
+	case events.LeagueChatMention:
+		var p events.LeagueChatMentionPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return PushMessage{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		return PushMessage{
+			UserID: p.UserID,
+			Title:  fmt.Sprintf("%s mentioned you", p.AuthorUsername),
+			Body:   p.Excerpt,
+			URL:    threadLink(baseURL, p.LeagueID, p.ThreadID),
+		}, nil
+
+	case events.WishlistPlayerOnBlock:
+		var p events.WishlistPlayerOnBlockPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return PushMessage{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		return PushMessage{
+			UserID: p.UserID,
+			Title:  fmt.Sprintf("%s is on the trade block", p.PlayerName),
+			Body:   fmt.Sprintf("%s is shopping a player on your wishlist", p.ListingTeamName),
+			URL:    tradeBlockLink(baseURL, p.LeagueID),
+		}, nil
+
+	case events.LineupIssues:
+		var p events.LineupIssuesPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return PushMessage{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		body := fmt.Sprintf("%s has a lineup problem to fix before lineups lock in %s", p.TeamName, formatCountdown(p.LocksAt))
+		if len(p.Issues) > 1 {
+			body = fmt.Sprintf("%s has %d lineup problems to fix before lineups lock in %s", p.TeamName, len(p.Issues), formatCountdown(p.LocksAt))
+		}
+		return PushMessage{
+			UserID: p.UserID,
+			Title:  "Check your lineup",
+			Body:   body,
+			URL:    lineupLink(baseURL, p.LeagueID, p.FantasyTeamID),
+		}, nil
+
+	case events.OnTheClock:
+		var p events.OnTheClockPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return PushMessage{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		body := fmt.Sprintf("%s is on the clock with pick %d.%02d", p.TeamName, p.Round, p.Pick)
+		if p.Deadline != nil {
+			body = fmt.Sprintf("%s is on the clock with pick %d.%02d and has %s to pick", p.TeamName, p.Round, p.Pick, formatCountdown(*p.Deadline))
+		}
+		return PushMessage{
+			UserID: p.UserID,
+			Title:  "You're on the clock",
+			Body:   body,
+			URL:    draftLink(baseURL, p.DraftID),
+		}, nil
+
+	case events.WatchlistAlert:
+		var p events.WatchlistAlertPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return PushMessage{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		msg := PushMessage{
+			UserID: p.UserID,
+			Title:  fmt.Sprintf("%s was drafted", p.PlayerName),
+			Body:   fmt.Sprintf("%s took %s from your watchlist with pick %d in %s", p.PickTeamName, p.PlayerName, p.OverallPick, p.LeagueName),
+			URL:    draftLink(baseURL, p.DraftID),
+		}
+		if p.Kind == "APPROACHING" {
+			msg.Title = fmt.Sprintf("%s is still available", p.PlayerName)
+			msg.Body = fmt.Sprintf("%s from your watchlist is still on the board in %s", p.PlayerName, p.LeagueName)
+			if p.PicksAway != nil {
+				msg.Body += " " + picksAway(*p.PicksAway)
+			}
+		}
+		return msg, nil
+
+	case events.LeagueDigest:
+		var p events.LeagueDigestPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return PushMessage{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		transactions := 0
+		for _, league := range p.Leagues {
+			transactions += len(league.Transactions) + league.MoreTransactions
+		}
+		msg := PushMessage{
+			UserID: p.UserID,
+			Title:  fmt.Sprintf("Your %s league digest", strings.ToLower(p.Frequency)),
+			Body:   fmt.Sprintf("%d transactions across %d of your leagues %s", transactions, len(p.Leagues), digestPeriod(p.Frequency)),
+			URL:    strings.TrimRight(baseURL, "/") + "/leagues",
+		}
+		if len(p.Leagues) == 1 {
+			msg.Body = fmt.Sprintf("%d transactions in %s %s", transactions, p.Leagues[0].LeagueName, digestPeriod(p.Frequency))
+			msg.URL = transactionsLink(baseURL, p.Leagues[0].LeagueID)
+		}
+		return msg, nil
+
+	case events.DraftRecap:
+		var p events.DraftRecapPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return PushMessage{}, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		msg := PushMessage{
+			UserID: p.UserID,
+			Title:  "Your draft recap is ready",
+			Body:   fmt.Sprintf("See the best values and biggest reaches of the %s draft", p.LeagueName),
+			URL:    recapLink(baseURL, p.DraftID),
+		}
+		if p.Grade != "" {
+			msg.Body = fmt.Sprintf("%s graded out %s in the %s draft, %s of %d teams", p.TeamName, p.Grade, p.LeagueName, ordinal(p.Rank), p.Teams)
+		}
+		return msg, nil
+
+	default:
+		return PushMessage{}, fmt.Errorf("%w %q", errUnknownEvent, eventType)
+	}
+}
+
+// picksAway describes how soon the user's team picks
+func picksAway(n int) string {
+	switch n {
+	case 0:
+		return "and you're on the clock"
+	case 1:
+		return "with 1 pick before yours"
+	default:
+		return fmt.Sprintf("with %d picks before yours", n)
+	}
+}
+
+// draftLink links to a draft room
+func draftLink(baseURL, draftID string) string {
+	return strings.TrimRight(baseURL, "/") + "/drafts/" + url.PathEscape(draftID)
+}
+
+// recapLink links to a completed draft's recap
+func recapLink(baseURL, draftID string) string {
+	return draftLink(baseURL, draftID) + "/recap"
+}
+
+// leagueLink links to a league's home page
+func leagueLink(baseURL, leagueID string) string {
+	return strings.TrimRight(baseURL, "/") + "/leagues/" + url.PathEscape(leagueID)
+}
+
+// threadLink links to a league discussion thread
+func threadLink(baseURL, leagueID, threadID string) string {
+	return strings.TrimRight(baseURL, "/") + "/leagues/" + url.PathEscape(leagueID) + "/threads/" + url.PathEscape(threadID)
+}
+
+// tradeBlockLink links to a league's trade block
+func tradeBlockLink(baseURL, leagueID string) string {
+	return strings.TrimRight(baseURL, "/") + "/leagues/" + url.PathEscape(leagueID) + "/trade-block"
+}
+
+// lineupLink links to a team's lineup
+func lineupLink(baseURL, leagueID, fantasyTeamID string) string {
+	return strings.TrimRight(baseURL, "/") + "/leagues/" + url.PathEscape(leagueID) + "/teams/" + url.PathEscape(fantasyTeamID) + "/lineup"
+}
+
+// transactionsLink links to a league's transaction log
+func transactionsLink(baseURL, leagueID string) string {
+	return strings.TrimRight(baseURL, "/") + "/leagues/" + url.PathEscape(leagueID) + "/transactions"
+}
+
+// tokenLink builds a link carrying a single-use token
+func tokenLink(baseURL, path, token string) string {
+	return strings.TrimRight(baseURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+// formatExpiry formats a token expiry for an email body
+func formatExpiry(t time.Time) string {
+	return "at " + t.UTC().Format(time.RFC1123)
+}
+
+// formatLeagueTime formats a time in a league's time zone and locale for an email body.
+// Events queued before leagues had them fall back to the league defaults.
+func formatLeagueTime(t time.Time, timezone, locale string) string {
+	return "on " + models.NewLeagueClock(timezone, locale).FormatDateTime(t)
+}
+
+// formatCountdown formats the time left until t, in whole minutes, for a push notification
+func formatCountdown(t time.Time) string {
+	minutes := int(math.Ceil(time.Until(t).Minutes()))
+	if minutes <= 1 {
+		return "a minute"
+	}
+	return fmt.Sprintf("%d minutes", minutes)
+}
+
+// digestPeriod describes the period a digest at frequency covers
+func digestPeriod(frequency string) string {
+	if models.DigestFrequency(frequency) == models.DigestFrequencyDaily {
+		return "since yesterday"
+	}
+	return "this week"
+}
+
+// describeDigestTransaction describes a transaction log entry in a line of a digest
+func describeDigestTransaction(txn events.LeagueDigestTransaction) string {
+	player := txn.PlayerName
+	if player == "" {
+		player = "a player"
+	}
+	switch models.TransactionType(txn.Type) {
+	case models.TransactionTypeDrafted:
+		return fmt.Sprintf("%s drafted %s", txn.TeamName, player)
+	case models.TransactionTypeAdded:
+		return fmt.Sprintf("%s added %s", txn.TeamName, player)
+	case models.TransactionTypeDropped:
+		return fmt.Sprintf("%s dropped %s", txn.TeamName, player)
+	case models.TransactionTypeWaiverClaim:
+		return fmt.Sprintf("%s claimed %s off waivers", txn.TeamName, player)
+	case models.TransactionTypeKeeperDesignated:
+		return fmt.Sprintf("%s kept %s", txn.TeamName, player)
+	case models.TransactionTypeTraded:
+		if txn.PlayerName == "" {
+			player = "a draft pick"
+		}
+		if txn.CounterpartyTeamName != "" {
+			return fmt.Sprintf("%s traded for %s with %s", txn.TeamName, player, txn.CounterpartyTeamName)
+		}
+		return fmt.Sprintf("%s traded for %s", txn.TeamName, player)
+	default:
+		return fmt.Sprintf("%s: %s %s", txn.TeamName, txn.Type, player)
+	}
+}
+
+// describeRecapPick describes a pick a draft recap singles out in a line of its notification
+func describeRecapPick(pick events.DraftRecapPick) string {
+	picks := pick.PicksVsRank
+	when := "after"
+	if picks < 0 {
+		picks, when = -picks, "before"
+	}
+	unit := "picks"
+	if picks == 1 {
+		unit = "pick"
+	}
+	return fmt.Sprintf("%s took %s at pick %d, %d %s %s their rank", pick.TeamName, pick.PlayerName, pick.OverallPick, picks, unit, when)
+}
+
+// ordinal writes a rank as 1st, 2nd, 3rd and so on
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
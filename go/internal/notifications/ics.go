@@ -0,0 +1,102 @@
+package notifications
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// draftInviteLength is how long the calendar event of a draft lasts. Drafts have no set end,
+// so the event blocks out the time most drafts take.
+const draftInviteLength = 2 * time.Hour
+
+// icsTimeFormat is the UTC form of an iCalendar DATE-TIME
+const icsTimeFormat = "20060102T150405Z"
+
+// calendarEvent is a single event of an iCalendar file
+type calendarEvent struct {
+	UID         string
+	Sequence    int // revision of the event; calendars keep the highest they have seen
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	URL         string
+}
+
+// draftCalendarEvent builds the calendar event inviting a league member to a draft. Every
+// invite for the draft shares its UID, so a rescheduled draft moves the event already in the
+// member's calendar.
+func draftCalendarEvent(baseURL, draftID, leagueName, teamName string, scheduledAt time.Time, sequence int) calendarEvent {
+	host := "dynasty"
+	if u, err := url.Parse(baseURL); err == nil && u.Hostname() != "" {
+		host = u.Hostname()
+	}
+	link := draftLink(baseURL, draftID)
+	return calendarEvent{
+		UID:         fmt.Sprintf("draft-%s@%s", draftID, host),
+		Sequence:    sequence,
+		Start:       scheduledAt,
+		End:         scheduledAt.Add(draftInviteLength),
+		Summary:     fmt.Sprintf("%s draft", leagueName),
+		Description: fmt.Sprintf("Join the draft room with %s here: %s", teamName, link),
+		URL:         link,
+	}
+}
+
+// icsAttachment renders event as an iCalendar (RFC 5545) file to attach to an email. It is
+// published rather than sent as a meeting request, so calendars add it without an organizer
+// to reply to.
+func icsAttachment(event calendarEvent, now time.Time) Attachment {
+	var b strings.Builder
+	line := func(name, value string) {
+		b.WriteString(foldICSLine(name + ":" + value))
+	}
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//Dynasty//Draft Invites//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("BEGIN", "VEVENT")
+	line("UID", event.UID)
+	line("SEQUENCE", fmt.Sprint(event.Sequence))
+	line("DTSTAMP", now.UTC().Format(icsTimeFormat))
+	line("DTSTART", event.Start.UTC().Format(icsTimeFormat))
+	line("DTEND", event.End.UTC().Format(icsTimeFormat))
+	line("SUMMARY", escapeICSText(event.Summary))
+	line("DESCRIPTION", escapeICSText(event.Description))
+	line("URL", event.URL)
+	line("STATUS", "CONFIRMED")
+	line("END", "VEVENT")
+	line("END", "VCALENDAR")
+
+	return Attachment{
+		Filename:    "draft.ics",
+		ContentType: "text/calendar; charset=UTF-8; method=PUBLISH",
+		Data:        []byte(b.String()),
+	}
+}
+
+// escapeICSText escapes an iCalendar TEXT value
+func escapeICSText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// foldICSLine ends a content line with CRLF, folding it so no line is longer than the 75
+// octets RFC 5545 allows. Folds fall between runes, never inside a UTF-8 sequence.
+func foldICSLine(line string) string {
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > 75 {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+	return b.String()
+}
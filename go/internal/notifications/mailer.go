@@ -0,0 +1,133 @@
+package notifications
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Message is a plain-text email, with any files attached to it
+type Message struct {
+	To          string
+	Subject     string
+	Body        string
+	Attachments []Attachment
+}
+
+// Attachment is a file attached to an email
+type Attachment struct {
+	Filename    string
+	ContentType string // e.g. "text/calendar; charset=UTF-8; method=PUBLISH"
+	Data        []byte
+}
+
+// Mailer delivers emails
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMTPConfig configures SMTPMailer
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // empty disables authentication
+	Password string
+	From     string
+}
+
+// SMTPMailer sends email through an SMTP relay
+type SMTPMailer struct {
+	config SMTPConfig
+}
+
+// NewSMTPMailer creates a mailer for the given relay
+func NewSMTPMailer(cfg SMTPConfig) *SMTPMailer {
+	return &SMTPMailer{config: cfg}
+}
+
+// Send delivers msg. net/smtp has no context support, so ctx is only checked up front.
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.config.Username != "" {
+		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+	}
+
+	addr := fmt.Sprintf("%s:%d", m.config.Host, m.config.Port)
+	if err := smtp.SendMail(addr, auth, m.config.From, []string{msg.To}, m.format(msg)); err != nil {
+		return fmt.Errorf("send mail to %s: %w", msg.To, err)
+	}
+	return nil
+}
+
+// format renders msg as an RFC 5322 message. A message with attachments is sent as
+// multipart/mixed, its body the first part.
+func (m *SMTPMailer) format(msg Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", m.config.From)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	body := strings.ReplaceAll(msg.Body, "\n", "\r\n")
+	if len(msg.Attachments) == 0 {
+		b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+		b.WriteString("\r\n")
+		b.WriteString(body)
+		return []byte(b.String())
+	}
+
+	parts := multipart.NewWriter(&b)
+	fmt.Fprintf(&b, "Content-Type: multipart/mixed; boundary=%q\r\n", parts.Boundary())
+	b.WriteString("\r\n")
+
+	// strings.Builder never fails to write, so neither do the part writers
+	part, _ := parts.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"text/plain; charset=UTF-8"},
+	})
+	io.WriteString(part, body)
+	for _, attachment := range msg.Attachments {
+		part, _ := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {attachment.ContentType},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		writeBase64Lines(part, attachment.Data)
+	}
+	parts.Close()
+	return []byte(b.String())
+}
+
+// writeBase64Lines writes data base64 encoded in lines of 76 characters, the most RFC 2045 allows
+func writeBase64Lines(w io.Writer, data []byte) {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 76 {
+		io.WriteString(w, encoded[:76]+"\r\n")
+		encoded = encoded[76:]
+	}
+	io.WriteString(w, encoded+"\r\n")
+}
+
+// LogMailer logs emails instead of sending them, for local development
+type LogMailer struct{}
+
+// Send logs msg
+func (LogMailer) Send(_ context.Context, msg Message) error {
+	log.Info().
+		Str("to", msg.To).
+		Str("subject", msg.Subject).
+		Str("body", msg.Body).
+		Int("attachments", len(msg.Attachments)).
+		Msg("email (not sent, no SMTP host configured)")
+	return nil
+}
@@ -0,0 +1,34 @@
+package notifications
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+// PushMessage is a push notification to every device a user has registered
+type PushMessage struct {
+	UserID string
+	Title  string
+	Body   string
+	URL    string // opened when the notification is tapped
+}
+
+// Pusher delivers push notifications
+type Pusher interface {
+	Push(ctx context.Context, msg PushMessage) error
+}
+
+// LogPusher logs push notifications instead of sending them, for local development
+type LogPusher struct{}
+
+// Push logs msg
+func (LogPusher) Push(_ context.Context, msg PushMessage) error {
+	log.Info().
+		Str("user_id", msg.UserID).
+		Str("title", msg.Title).
+		Str("body", msg.Body).
+		Str("url", msg.URL).
+		Msg("push notification (not sent, no push provider configured)")
+	return nil
+}
@@ -0,0 +1,217 @@
+package notifications
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	usersdb "github.com/mcdev12/dynasty/go/internal/users/db"
+	"github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+// WorkerConfig configures the notification worker
+type WorkerConfig struct {
+	PollInterval time.Duration
+	BatchSize    int32
+	AppBaseURL   string // links in emails and push notifications point here
+	TokenSecret  []byte // opens the tokens in verification and password reset emails
+}
+
+// DefaultWorkerConfig returns the default worker configuration
+func DefaultWorkerConfig() WorkerConfig {
+	return WorkerConfig{
+		PollInterval: 5 * time.Second,
+		BatchSize:    50,
+		AppBaseURL:   "http://localhost:3000",
+	}
+}
+
+// Worker drains the user outbox and delivers the emails and push notifications it describes,
+// skipping the channels a user opted out of. Rows are claimed with FOR UPDATE SKIP LOCKED,
+// so several workers can run at once.
+type Worker struct {
+	db     *sql.DB
+	mailer Mailer
+	pusher Pusher
+	config WorkerConfig
+}
+
+// NewWorker creates a notification worker
+func NewWorker(db *sql.DB, mailer Mailer, pusher Pusher, cfg WorkerConfig) *Worker {
+	return &Worker{
+		db:     db,
+		mailer: mailer,
+		pusher: pusher,
+		config: cfg,
+	}
+}
+
+// Start polls the outbox until ctx is cancelled
+func (w *Worker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(w.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.processBatch(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Error().Err(err).Msg("process user outbox batch")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// processBatch delivers one batch of unsent events. Failed emails stay unsent and are
+// retried on the next poll; push notifications are best effort and aren't retried, so a
+// failed email doesn't push twice. Events with nothing to deliver are marked sent and skipped.
+func (w *Worker) processBatch(ctx context.Context) error {
+	newQueries := func(tx *sql.Tx) *usersdb.Queries { return usersdb.New(tx) }
+	return sqlutil.Run(ctx, w.db, newQueries, func(q *usersdb.Queries) error {
+		rows, err := q.FetchUnsentUserOutbox(ctx, w.config.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			logger := log.With().
+				Str("event_id", row.ID.String()).
+				Str("event_type", row.EventType).
+				Str("user_id", row.UserID.String()).
+				Logger()
+
+			if row.RecipientDeleted {
+				logger.Info().Msg("skipping user outbox event for deleted user")
+				if err := w.markSent(ctx, q, row); err != nil {
+					return err
+				}
+				continue
+			}
+
+			optedOut, err := w.optedOutChannels(ctx, q, row)
+			if err != nil {
+				return err
+			}
+
+			if !optedOut[models.NotificationChannelEmail] && !events.PushOnly(row.EventType) && !w.sendEmail(ctx, logger, row) {
+				continue
+			}
+			if !optedOut[models.NotificationChannelPush] {
+				acked, err := w.frameAcked(ctx, q, row)
+				if err != nil {
+					return err
+				}
+				if acked {
+					logger.Info().Msg("skipping push notification for a frame acknowledged since")
+				} else {
+					w.sendPush(ctx, logger, row)
+				}
+			}
+
+			if err := w.markSent(ctx, q, row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// optedOutChannels returns the channels the user turned the event's notifications off on
+func (w *Worker) optedOutChannels(ctx context.Context, q *usersdb.Queries, row usersdb.FetchUnsentUserOutboxRow) (map[models.NotificationChannel]bool, error) {
+	if !events.CanOptOut(row.EventType) {
+		return nil, nil
+	}
+	channels, err := q.ListOptedOutChannels(ctx, usersdb.ListOptedOutChannelsParams{
+		UserID:           row.UserID,
+		NotificationType: row.EventType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	optedOut := make(map[models.NotificationChannel]bool, len(channels))
+	for _, channel := range channels {
+		optedOut[models.NotificationChannel(channel)] = true
+	}
+	return optedOut, nil
+}
+
+// markSent marks an event delivered, or dropped, so it isn't picked up again
+func (w *Worker) markSent(ctx context.Context, q *usersdb.Queries, row usersdb.FetchUnsentUserOutboxRow) error {
+	return q.MarkUserOutboxSent(ctx, usersdb.MarkUserOutboxSentParams{
+		ID:        row.ID,
+		CreatedAt: row.CreatedAt,
+	})
+}
+
+// frameAcked reports whether the event stands in for a draft room frame that one of the user's
+// clients has acknowledged since it was queued, making the push notification redundant
+func (w *Worker) frameAcked(ctx context.Context, q *usersdb.Queries, row usersdb.FetchUnsentUserOutboxRow) (bool, error) {
+	if row.EventType != events.OnTheClock && row.EventType != events.WatchlistAlert {
+		return false, nil
+	}
+	// Both payloads carry the frame's event
+	var p struct {
+		EventID string `json:"event_id"`
+	}
+	if err := json.Unmarshal(row.Payload, &p); err != nil {
+		return false, err
+	}
+	eventID, err := uuid.Parse(p.EventID)
+	if err != nil {
+		return false, err
+	}
+	return q.IsFrameAcked(ctx, usersdb.IsFrameAckedParams{
+		EventID: eventID,
+		UserID:  row.UserID,
+	})
+}
+
+// sendEmail emails the event, reporting whether the row is done with. A row whose email
+// failed to render or send is left for the next poll.
+func (w *Worker) sendEmail(ctx context.Context, logger zerolog.Logger, row usersdb.FetchUnsentUserOutboxRow) bool {
+	msg, err := renderEmail(row.EventType, row.Payload, w.config.AppBaseURL, w.config.TokenSecret)
+	switch {
+	case errors.Is(err, errUnknownEvent):
+		logger.Warn().Msg("skipping user outbox event with no email")
+		return true
+	case err != nil:
+		logger.Error().Err(err).Msg("render email")
+		return false
+	}
+
+	if err := w.mailer.Send(ctx, msg); err != nil {
+		logger.Error().Err(err).Msg("send email")
+		return false
+	}
+	logger.Info().Msg("email sent")
+	return true
+}
+
+// sendPush pushes the event to the user's devices, if it has a push notification
+func (w *Worker) sendPush(ctx context.Context, logger zerolog.Logger, row usersdb.FetchUnsentUserOutboxRow) {
+	msg, err := renderPush(row.EventType, row.Payload, w.config.AppBaseURL)
+	switch {
+	case errors.Is(err, errUnknownEvent):
+		return
+	case err != nil:
+		logger.Error().Err(err).Msg("render push notification")
+		return
+	}
+
+	if err := w.pusher.Push(ctx, msg); err != nil {
+		logger.Error().Err(err).Msg("send push notification")
+		return
+	}
+	logger.Info().Msg("push notification sent")
+}
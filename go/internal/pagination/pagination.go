@@ -0,0 +1,120 @@
+// Package pagination holds the paging conventions shared by list endpoints: opaque page
+// tokens, page sizes with a default and a cap, and a total count callers opt in to.
+//
+// A list fetches one item more than the page size to tell whether another page follows,
+// and hands back a token for the position of the last item on the page. Lists backed by a
+// stable key page by that key; lists sorted in memory page by offset.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidPageToken is returned when a page token wasn't issued by the list it is passed to
+	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrInvalidPageSize is returned for a page size out of range
+	ErrInvalidPageSize = errors.New("invalid page size")
+)
+
+// Request is the paging part of a list request
+type Request struct {
+	PageSize  int    `json:"page_size"` // 0 uses the list's default
+	PageToken string `json:"page_token,omitempty"`
+	// IncludeTotal asks for the number of items across every page, which costs lists backed
+	// by the database a count query
+	IncludeTotal bool `json:"include_total,omitempty"`
+}
+
+// Paged reports whether the request pages by token, for lists that still take a deprecated
+// limit and offset
+func (r Request) Paged() bool {
+	return r.PageSize > 0 || r.PageToken != ""
+}
+
+// Sizes are the page size a list uses when a request doesn't set one, and the largest it allows
+type Sizes struct {
+	Default int
+	Max     int
+}
+
+// Resolve returns the page size to use for a requested one
+func (s Sizes) Resolve(pageSize int) (int, error) {
+	if pageSize < 0 || pageSize > s.Max {
+		return 0, fmt.Errorf("%w: must be between 0 and %d", ErrInvalidPageSize, s.Max)
+	}
+	if pageSize == 0 {
+		return s.Default, nil
+	}
+	return pageSize, nil
+}
+
+// EncodeToken turns a cursor into the opaque token handed to clients
+func EncodeToken(cursor any) (string, error) {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal page cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// DecodeToken reverses EncodeToken into cursor, reporting false for an empty token, which
+// starts from the first page. Lists check the decoded cursor is one they issued.
+func DecodeToken(token string, cursor any) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return false, ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(raw, cursor); err != nil {
+		return false, ErrInvalidPageToken
+	}
+	return true, nil
+}
+
+// Trim cuts items fetched with one more than the page size down to the page, reporting
+// whether another page follows
+func Trim[T any](items []T, pageSize int) ([]T, bool) {
+	if len(items) > pageSize {
+		return items[:pageSize], true
+	}
+	return items, false
+}
+
+// offsetCursor is the position of the next page of a list paged by offset
+type offsetCursor struct {
+	Offset int `json:"offset"`
+}
+
+// EncodeOffset returns the token of the page starting at offset
+func EncodeOffset(offset int) (string, error) {
+	return EncodeToken(offsetCursor{Offset: offset})
+}
+
+// DecodeOffset returns the offset a token issued by EncodeOffset starts at, 0 for an empty token
+func DecodeOffset(token string) (int, error) {
+	var cursor offsetCursor
+	ok, err := DecodeToken(token, &cursor)
+	if err != nil || !ok {
+		return 0, err
+	}
+	if cursor.Offset <= 0 {
+		return 0, ErrInvalidPageToken
+	}
+	return cursor.Offset, nil
+}
+
+// Window returns the page of items starting at offset, reporting whether another page
+// follows, for lists sorted in memory
+func Window[T any](items []T, offset, pageSize int) ([]T, bool) {
+	if offset >= len(items) {
+		return items[:0], false
+	}
+	return Trim(items[offset:], pageSize)
+}
@@ -0,0 +1,90 @@
+package partitions
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// PartitionsRepository defines what the app layer needs from the repository
+type PartitionsRepository interface {
+	CreateMonthlyPartition(ctx context.Context, table string, month time.Time) (string, error)
+	ListPartitionMonths(ctx context.Context, table string) ([]time.Time, error)
+	RetireMonthlyPartition(ctx context.Context, table string, month time.Time, dropTable bool) (bool, error)
+}
+
+// App handles partition maintenance
+type App struct {
+	repo PartitionsRepository
+}
+
+// NewApp creates a new partitions App
+func NewApp(repo PartitionsRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// MaintainPartitions creates the partitions of each table for the months from now's through
+// policy.MonthsAhead later, and retires the ones for months older than the retention period.
+// Creating a month that exists is a no-op and a retired month is no longer listed, so a retried
+// run picks up where the last one failed. Results for the tables done before an error are
+// returned with it.
+func (a *App) MaintainPartitions(ctx context.Context, now time.Time, tables []string, policy MaintenancePolicy) ([]MaintenanceResult, error) {
+	current := monthOf(now)
+	cutoff := current.AddDate(0, -policy.RetentionMonths, 0)
+
+	results := make([]MaintenanceResult, 0, len(tables))
+	for _, table := range tables {
+		existing, err := a.repo.ListPartitionMonths(ctx, table)
+		if err != nil {
+			return results, err
+		}
+		attached := make(map[time.Time]bool, len(existing))
+		for _, month := range existing {
+			attached[month] = true
+		}
+
+		result := MaintenanceResult{Table: table}
+		for i := 0; i <= policy.MonthsAhead; i++ {
+			month := current.AddDate(0, i, 0)
+			if attached[month] {
+				continue
+			}
+			name, err := a.repo.CreateMonthlyPartition(ctx, table, month)
+			if err != nil {
+				return results, err
+			}
+			log.Info().Str("partition", name).Msg("created partition")
+			result.Created++
+		}
+
+		for _, month := range existing {
+			if !month.Before(cutoff) {
+				break
+			}
+			retired, err := a.repo.RetireMonthlyPartition(ctx, table, month, policy.DropRetired)
+			if err != nil {
+				return results, err
+			}
+			if !retired {
+				log.Warn().
+					Str("table", table).
+					Str("month", month.Format(monthLayout)).
+					Msg("partition past retention still holds unsent events, keeping it")
+				result.Held = append(result.Held, month)
+				continue
+			}
+			log.Info().
+				Str("table", table).
+				Str("month", month.Format(monthLayout)).
+				Bool("dropped", policy.DropRetired).
+				Msg("retired partition")
+			result.Retired = append(result.Retired, month)
+		}
+
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,17 @@
+-- name: CreateMonthlyPartition :one
+-- Create the partition of a table holding a UTC month, unless it exists. Returns its name.
+SELECT create_monthly_partition(@parent_table::text, @month::date)::text AS name;
+
+-- name: ListMonthlyPartitions :many
+-- The monthly partitions attached to a table, oldest first. The default partition isn't listed.
+SELECT c.relname::text AS name
+FROM pg_inherits i
+JOIN pg_class c ON c.oid = i.inhrelid
+WHERE i.inhparent = to_regclass(@parent_table::text)
+  AND c.relname ~ '_p[0-9]{6}$'
+ORDER BY c.relname;
+
+-- name: RetireMonthlyPartition :one
+-- Detach the partition of a table holding a UTC month, and drop it when drop_table is set. A
+-- month still holding unsent events is left attached. Returns whether it was retired.
+SELECT retire_monthly_partition(@parent_table::text, @month::date, @drop_table::boolean)::boolean AS retired;
@@ -0,0 +1,74 @@
+package partitions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/partitions/db"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	CreateMonthlyPartition(ctx context.Context, arg db.CreateMonthlyPartitionParams) (string, error)
+	ListMonthlyPartitions(ctx context.Context, parentTable string) ([]string, error)
+	RetireMonthlyPartition(ctx context.Context, arg db.RetireMonthlyPartitionParams) (bool, error)
+}
+
+// Repository implements partition maintenance operations
+type Repository struct {
+	queries Querier
+}
+
+// NewRepository creates a new partitions repository
+func NewRepository(querier Querier) *Repository {
+	return &Repository{
+		queries: querier,
+	}
+}
+
+// CreateMonthlyPartition creates the partition of table holding month, unless it exists, and
+// returns its name
+func (r *Repository) CreateMonthlyPartition(ctx context.Context, table string, month time.Time) (string, error) {
+	name, err := r.queries.CreateMonthlyPartition(ctx, db.CreateMonthlyPartitionParams{
+		ParentTable: table,
+		Month:       month,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create partition of %s for %s: %w", table, month.Format(monthLayout), err)
+	}
+	return name, nil
+}
+
+// ListPartitionMonths returns the months table has a partition attached for, oldest first
+func (r *Repository) ListPartitionMonths(ctx context.Context, table string) ([]time.Time, error) {
+	names, err := r.queries.ListMonthlyPartitions(ctx, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions of %s: %w", table, err)
+	}
+
+	months := make([]time.Time, 0, len(names))
+	for _, name := range names {
+		month, err := time.Parse(monthLayout, name[strings.LastIndex(name, "_p")+2:])
+		if err != nil {
+			return nil, fmt.Errorf("unexpected partition name %s: %w", name, err)
+		}
+		months = append(months, month)
+	}
+	return months, nil
+}
+
+// RetireMonthlyPartition detaches the partition of table holding month, dropping it when
+// dropTable is set. It reports false for a month still holding unsent events, which is kept.
+func (r *Repository) RetireMonthlyPartition(ctx context.Context, table string, month time.Time, dropTable bool) (bool, error) {
+	retired, err := r.queries.RetireMonthlyPartition(ctx, db.RetireMonthlyPartitionParams{
+		ParentTable: table,
+		Month:       month,
+		DropTable:   dropTable,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to retire partition of %s for %s: %w", table, month.Format(monthLayout), err)
+	}
+	return retired, nil
+}
@@ -0,0 +1,67 @@
+package partitions
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+)
+
+// MaintenanceJob is the job kind of the nightly partition maintenance
+const MaintenanceJob = "partitions.maintain"
+
+// Maintainer creates upcoming partitions and retires old ones
+type Maintainer interface {
+	MaintainPartitions(ctx context.Context, now time.Time, tables []string, policy MaintenancePolicy) ([]MaintenanceResult, error)
+}
+
+// RunnerConfig holds configuration for the nightly partition maintenance
+type RunnerConfig struct {
+	HourUTC int // Hour of the day, in UTC, the maintenance runs at
+	Policy  MaintenancePolicy
+}
+
+// DefaultRunnerConfig returns default partition maintenance configuration
+func DefaultRunnerConfig() RunnerConfig {
+	return RunnerConfig{
+		HourUTC: 10, // after the night's other jobs, before US mornings
+		Policy: MaintenancePolicy{
+			MonthsAhead:     3,
+			RetentionMonths: 12, // a season of events, offseason included
+			DropRetired:     true,
+		},
+	}
+}
+
+// ScheduleMaintenance schedules partition maintenance of the outbox tables once a night on the
+// job worker. Partitions are created months ahead, so a few failed nights don't leave inserts
+// to the default partition. Creating and detaching partitions briefly locks the outbox tables
+// against writes, which is why it runs at night.
+func ScheduleMaintenance(worker *jobs.Worker, maintainer Maintainer, config RunnerConfig) {
+	if err := config.Policy.validate(); err != nil {
+		log.Error().Err(err).Msg("invalid partition maintenance policy, not scheduling it")
+		return
+	}
+
+	log.Info().
+		Int("hour_utc", config.HourUTC).
+		Int("months_ahead", config.Policy.MonthsAhead).
+		Int("retention_months", config.Policy.RetentionMonths).
+		Bool("drop_retired", config.Policy.DropRetired).
+		Msg("scheduling partition maintenance")
+
+	worker.Schedule(MaintenanceJob, jobs.DailyAt(config.HourUTC, 0, time.UTC), func(ctx context.Context, _ jobs.Job) error {
+		results, err := maintainer.MaintainPartitions(ctx, time.Now(), OutboxTables, config.Policy)
+		for _, result := range results {
+			log.Info().
+				Str("table", result.Table).
+				Int("created", result.Created).
+				Int("retired", len(result.Retired)).
+				Int("held", len(result.Held)).
+				Msg("maintained partitions")
+		}
+		return err
+	})
+}
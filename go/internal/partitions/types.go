@@ -0,0 +1,45 @@
+package partitions
+
+import (
+	"fmt"
+	"time"
+)
+
+// OutboxTables are the tables partitioned by month of created_at. Each has a sent_at column,
+// so a month is only retired once every event in it went out.
+var OutboxTables = []string{"draft_outbox", "user_outbox", "roster_outbox", "entity_change_outbox"}
+
+// monthLayout is the suffix of a monthly partition's name, e.g. draft_outbox_p202610
+const monthLayout = "200601"
+
+// MaintenancePolicy is how far ahead partitions are created and how long they're kept
+type MaintenancePolicy struct {
+	MonthsAhead     int  // months after the current one to have partitions for
+	RetentionMonths int  // months before the current one to keep, the current one aside
+	DropRetired     bool // drop retired partitions rather than leave them detached for archiving
+}
+
+// validate checks a maintenance policy
+func (p MaintenancePolicy) validate() error {
+	if p.MonthsAhead < 1 {
+		return fmt.Errorf("months ahead must be at least 1, got %d", p.MonthsAhead)
+	}
+	if p.RetentionMonths < 1 {
+		return fmt.Errorf("retention must be at least 1 month, got %d", p.RetentionMonths)
+	}
+	return nil
+}
+
+// MaintenanceResult is what a maintenance run did to the partitions of a table
+type MaintenanceResult struct {
+	Table   string
+	Created int         // partitions created, not counting ones that existed
+	Retired []time.Time // months detached, or dropped
+	Held    []time.Time // months past retention kept for their unsent events
+}
+
+// monthOf returns the first instant of t's UTC month
+func monthOf(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,503 @@
+package platformadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+const (
+	// defaultLimit is how many results a list returns when its request doesn't say
+	defaultLimit = 50
+	// DefaultStaleAfter is how long a draft in progress can go without moving before it is stuck
+	DefaultStaleAfter = 15 * time.Minute
+)
+
+// AdminRepository defines what the app layer needs from the repository
+type AdminRepository interface {
+	SearchUsers(ctx context.Context, query string, limit int) ([]User, error)
+	GetUser(ctx context.Context, id uuid.UUID) (*User, error)
+	SearchLeagues(ctx context.Context, query string, limit int) ([]League, error)
+	ListStuckDrafts(ctx context.Context, staleAfter time.Duration, limit int) ([]StuckDraft, error)
+	CountUnmadePicks(ctx context.Context, draftID uuid.UUID) (int, error)
+	ListDeadLetters(ctx context.Context, kind *DeadLetterKind, limit int) ([]DeadLetter, error)
+	Redrive(ctx context.Context, operator string, kind DeadLetterKind, ids []uuid.UUID, reason string) ([]uuid.UUID, error)
+	GetDashboard(ctx context.Context, staleAfter time.Duration) (*SystemDashboard, error)
+	RecordAudit(ctx context.Context, req AuditRequest) (*AuditEntry, error)
+	ListAudit(ctx context.Context, filter AuditFilter) ([]AuditEntry, error)
+	ListOutboxEvents(ctx context.Context, filter OutboxFilter) ([]OutboxEvent, error)
+	GetOutboxEvent(ctx context.Context, id uuid.UUID) (*OutboxEvent, error)
+	RepublishOutboxEvent(ctx context.Context, operator string, id uuid.UUID, reason string) (*RepublishedEvent, error)
+	GetLeagueSnapshot(ctx context.Context, leagueID uuid.UUID) (*LeagueSnapshot, error)
+	ListTeamRosterPlayerIDs(ctx context.Context, teamID uuid.UUID) ([]uuid.UUID, error)
+	ForfeitOrphanedPick(ctx context.Context, operator string, pickID uuid.UUID, reason string) (*RepairedPick, error)
+	ReassignTeamOwner(ctx context.Context, operator string, teamID, ownerID uuid.UUID, reason string) (*OwnerChange, error)
+}
+
+// Impersonator opens and ends sessions as users for operators
+type Impersonator interface {
+	Impersonate(ctx context.Context, userID uuid.UUID, operator string) (*Impersonation, error)
+	EndImpersonation(ctx context.Context, userID, sessionID uuid.UUID) error
+}
+
+// UserRestorer undoes users' deletion
+type UserRestorer interface {
+	RestoreUser(ctx context.Context, userID uuid.UUID) error
+}
+
+// DraftCompleter completes stuck drafts, emitting the events a completed draft does
+type DraftCompleter interface {
+	ForceComplete(ctx context.Context, id uuid.UUID) (*models.Draft, error)
+}
+
+// RosterReleaser drops players from rosters, emitting the events a drop does
+type RosterReleaser interface {
+	DeletePlayerFromRoster(ctx context.Context, fantasyTeamID, playerID uuid.UUID) error
+}
+
+// PlayerMerger merges duplicate player records, or dismisses pairs that aren't duplicates,
+// from the dedupe review queue
+type PlayerMerger interface {
+	MergePlayers(ctx context.Context, canonicalID, duplicateID uuid.UUID) (*models.PlayerMerge, error)
+	DismissPlayerDuplicate(ctx context.Context, id uuid.UUID) (*models.PlayerDuplicate, error)
+}
+
+// App carries out what platform operators do through the admin service, auditing every change
+type App struct {
+	repo         AdminRepository
+	impersonator Impersonator
+	restorer     UserRestorer
+	drafts       DraftCompleter
+	rosters      RosterReleaser
+	players      PlayerMerger
+}
+
+// NewApp creates a new admin App
+func NewApp(repo AdminRepository, impersonator Impersonator, restorer UserRestorer, drafts DraftCompleter, rosters RosterReleaser, players PlayerMerger) *App {
+	return &App{
+		repo:         repo,
+		impersonator: impersonator,
+		restorer:     restorer,
+		drafts:       drafts,
+		rosters:      rosters,
+		players:      players,
+	}
+}
+
+// SearchUsers finds users by part of their username or email, or their id
+func (a *App) SearchUsers(ctx context.Context, query string, limit int) ([]User, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ErrEmptyQuery
+	}
+	return a.repo.SearchUsers(ctx, query, resolveLimit(limit))
+}
+
+// SearchLeagues finds leagues by part of their name, or their id
+func (a *App) SearchLeagues(ctx context.Context, query string, limit int) ([]League, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ErrEmptyQuery
+	}
+	return a.repo.SearchLeagues(ctx, query, resolveLimit(limit))
+}
+
+// ImpersonateUser opens a session as a user for operator. The session is only handed out
+// once it is audited; a session that can't be audited is ended again.
+func (a *App) ImpersonateUser(ctx context.Context, operator string, userID uuid.UUID, reason string) (*User, *Impersonation, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, nil, ErrReasonRequired
+	}
+
+	user, err := a.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user.DeletedAt != nil {
+		return nil, nil, ErrUserDeleted
+	}
+
+	impersonation, err := a.impersonator.Impersonate(ctx, userID, operator)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to impersonate user: %w", err)
+	}
+
+	if _, err := a.repo.RecordAudit(ctx, AuditRequest{
+		Operator:   operator,
+		Action:     ActionImpersonateUser,
+		TargetType: TargetUser,
+		TargetID:   userID,
+		Reason:     reason,
+		Details: map[string]interface{}{
+			"session_id": impersonation.SessionID,
+			"expires_at": impersonation.AccessExpiresAt,
+		},
+	}); err != nil {
+		if endErr := a.impersonator.EndImpersonation(ctx, userID, impersonation.SessionID); endErr != nil {
+			log.Printf("Failed to end unaudited impersonation session %s of user %s: %v", impersonation.SessionID, userID, endErr)
+		}
+		return nil, nil, err
+	}
+
+	log.Printf("Operator %s impersonated user %s: %s", operator, userID, reason)
+	return user, impersonation, nil
+}
+
+// RestoreUser undoes a deleted user's deletion for operator
+func (a *App) RestoreUser(ctx context.Context, operator string, userID uuid.UUID, reason string) (*User, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	user, err := a.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.DeletedAt == nil {
+		return nil, ErrUserNotDeleted
+	}
+	deletedAt := *user.DeletedAt
+
+	if err := a.restorer.RestoreUser(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to restore user: %w", err)
+	}
+
+	// The user is restored either way, so a failed audit is reported rather than undone
+	if _, err := a.repo.RecordAudit(ctx, AuditRequest{
+		Operator:   operator,
+		Action:     ActionRestoreUser,
+		TargetType: TargetUser,
+		TargetID:   userID,
+		Reason:     reason,
+		Details: map[string]interface{}{
+			"deleted_at": deletedAt,
+		},
+	}); err != nil {
+		log.Printf("Operator %s restored user %s but it could not be audited: %v", operator, userID, err)
+		return nil, err
+	}
+
+	log.Printf("Operator %s restored user %s: %s", operator, userID, reason)
+	return a.repo.GetUser(ctx, userID)
+}
+
+// ListStuckDrafts lists drafts in progress that haven't moved in staleAfter, or
+// DefaultStaleAfter when it is zero, with why each counts as stuck
+func (a *App) ListStuckDrafts(ctx context.Context, staleAfter time.Duration, limit int) ([]StuckDraft, error) {
+	if staleAfter <= 0 {
+		staleAfter = DefaultStaleAfter
+	}
+
+	drafts, err := a.repo.ListStuckDrafts(ctx, staleAfter, resolveLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range drafts {
+		drafts[i].Reason = stuckReason(drafts[i], now)
+	}
+	return drafts, nil
+}
+
+// stuckReason says why a draft counts as stuck
+func stuckReason(draft StuckDraft, now time.Time) string {
+	switch {
+	case draft.RemainingPicks == 0:
+		return "every pick is made but the draft never completed"
+	case draft.NextDeadline != nil:
+		return fmt.Sprintf("pick deadline passed %s ago", now.Sub(*draft.NextDeadline).Round(time.Minute))
+	default:
+		return fmt.Sprintf("no pick on the clock for %s", now.Sub(draft.UpdatedAt).Round(time.Minute))
+	}
+}
+
+// ForceCompleteDraft completes a draft in progress or paused for operator, leaving the picks
+// still to make unmade
+func (a *App) ForceCompleteDraft(ctx context.Context, operator string, draftID uuid.UUID, reason string) (*ForcedCompletion, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	unmade, err := a.repo.CountUnmadePicks(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	draft, err := a.drafts.ForceComplete(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	completion := &ForcedCompletion{
+		DraftID:     draft.ID,
+		CompletedAt: time.Now(),
+		UnmadePicks: unmade,
+	}
+	if draft.CompletedAt != nil {
+		completion.CompletedAt = *draft.CompletedAt
+	}
+
+	// The draft is complete either way, so a failed audit is reported rather than undone
+	if _, err := a.repo.RecordAudit(ctx, AuditRequest{
+		Operator:   operator,
+		Action:     ActionForceCompleteDraft,
+		TargetType: TargetDraft,
+		TargetID:   draftID,
+		Reason:     reason,
+		Details: map[string]interface{}{
+			"league_id":    draft.LeagueID,
+			"unmade_picks": unmade,
+		},
+	}); err != nil {
+		log.Printf("Operator %s forced draft %s to complete but it could not be audited: %v", operator, draftID, err)
+		return nil, err
+	}
+
+	log.Printf("Operator %s forced draft %s to complete with %d picks unmade: %s", operator, draftID, unmade, reason)
+	return completion, nil
+}
+
+// ListDeadLetters lists failed jobs and webhook deliveries, or only those of kind, most
+// recently failed first
+func (a *App) ListDeadLetters(ctx context.Context, kind *DeadLetterKind, limit int) ([]DeadLetter, error) {
+	return a.repo.ListDeadLetters(ctx, kind, resolveLimit(limit))
+}
+
+// RedriveDeadLetters queues dead letters of kind to be tried again for operator and returns
+// the ids queued
+func (a *App) RedriveDeadLetters(ctx context.Context, operator string, kind DeadLetterKind, ids []uuid.UUID, reason string) ([]uuid.UUID, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	redriven, err := a.repo.Redrive(ctx, operator, kind, ids, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Operator %s redrove %d of %d %s dead letters: %s", operator, len(redriven), len(ids), kind, reason)
+	return redriven, nil
+}
+
+// GetSystemDashboard reports the platform's health
+func (a *App) GetSystemDashboard(ctx context.Context) (*SystemDashboard, error) {
+	return a.repo.GetDashboard(ctx, DefaultStaleAfter)
+}
+
+// ListAuditLog lists what operators did, newest first
+func (a *App) ListAuditLog(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	filter.Limit = resolveLimit(filter.Limit)
+	return a.repo.ListAudit(ctx, filter)
+}
+
+// ListOutboxEvents lists the draft outbox's events matching filter, newest first
+func (a *App) ListOutboxEvents(ctx context.Context, filter OutboxFilter) ([]OutboxEvent, error) {
+	filter.Limit = resolveLimit(filter.Limit)
+	return a.repo.ListOutboxEvents(ctx, filter)
+}
+
+// GetOutboxEvent gets an event in the draft outbox with its payload
+func (a *App) GetOutboxEvent(ctx context.Context, id uuid.UUID) (*OutboxEvent, error) {
+	return a.repo.GetOutboxEvent(ctx, id)
+}
+
+// RepublishOutboxEvent queues a published outbox event to be published again for operator
+func (a *App) RepublishOutboxEvent(ctx context.Context, operator string, id uuid.UUID, reason string) (*RepublishedEvent, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	republished, err := a.repo.RepublishOutboxEvent(ctx, operator, id, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Operator %s republished %s event %s of draft %s: %s", operator, republished.Event.EventType, id, republished.Event.DraftID, reason)
+	return republished, nil
+}
+
+// ValidateLeague checks a league's data for inconsistencies, suggesting a repair for each
+func (a *App) ValidateLeague(ctx context.Context, leagueID uuid.UUID) (*LeagueValidationReport, error) {
+	snapshot, err := a.repo.GetLeagueSnapshot(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &LeagueValidationReport{
+		LeagueID:    leagueID,
+		GeneratedAt: time.Now(),
+	}
+	report.Findings = append(report.Findings, rosterFindings(snapshot)...)
+	report.Findings = append(report.Findings, orphanedPickFindings(snapshot)...)
+	report.Findings = append(report.Findings, ownerlessTeamFindings(snapshot)...)
+	report.Findings = append(report.Findings, finishedDraftFindings(snapshot)...)
+	return report, nil
+}
+
+// RepairRosterOverLimit releases players from a team's roster for operator. Every player must
+// be on the roster; they are released one at a time, so a failure partway leaves the players
+// before it released, which the audit entry records.
+func (a *App) RepairRosterOverLimit(ctx context.Context, operator string, teamID uuid.UUID, playerIDs []uuid.UUID, reason string) ([]uuid.UUID, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	rostered, err := a.repo.ListTeamRosterPlayerIDs(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	onRoster := make(map[uuid.UUID]bool, len(rostered))
+	for _, id := range rostered {
+		onRoster[id] = true
+	}
+	for _, id := range playerIDs {
+		if !onRoster[id] {
+			return nil, fmt.Errorf("%w: %s", ErrPlayerNotOnRoster, id)
+		}
+	}
+
+	var released []uuid.UUID
+	var releaseErr error
+	for _, id := range playerIDs {
+		if releaseErr = a.rosters.DeletePlayerFromRoster(ctx, teamID, id); releaseErr != nil {
+			break
+		}
+		released = append(released, id)
+	}
+
+	if len(released) > 0 {
+		if _, err := a.repo.RecordAudit(ctx, AuditRequest{
+			Operator:   operator,
+			Action:     ActionReleaseRosterPlayers,
+			TargetType: TargetFantasyTeam,
+			TargetID:   teamID,
+			Reason:     reason,
+			Details: map[string]interface{}{
+				"player_ids": released,
+			},
+		}); err != nil {
+			log.Printf("Operator %s released %d players from team %s but it could not be audited: %v", operator, len(released), teamID, err)
+			return nil, err
+		}
+	}
+	if releaseErr != nil {
+		return nil, fmt.Errorf("failed to release player after releasing %d: %w", len(released), releaseErr)
+	}
+
+	log.Printf("Operator %s released %d players from team %s: %s", operator, len(released), teamID, reason)
+	return released, nil
+}
+
+// RepairOrphanedPick forfeits an unmade pick held by a team of another league for operator
+func (a *App) RepairOrphanedPick(ctx context.Context, operator string, pickID uuid.UUID, reason string) (*RepairedPick, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	repaired, err := a.repo.ForfeitOrphanedPick(ctx, operator, pickID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Operator %s forfeited orphaned pick %d of draft %s: %s", operator, repaired.OverallPick, repaired.DraftID, reason)
+	return repaired, nil
+}
+
+// RepairTeamOwner hands a team to another user for operator
+func (a *App) RepairTeamOwner(ctx context.Context, operator string, teamID, ownerID uuid.UUID, reason string) (*OwnerChange, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	change, err := a.repo.ReassignTeamOwner(ctx, operator, teamID, ownerID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Operator %s handed team %s from user %s to user %s: %s", operator, teamID, change.PreviousOwnerID, ownerID, reason)
+	return change, nil
+}
+
+// MergePlayers merges a duplicate player record into the canonical one for operator
+func (a *App) MergePlayers(ctx context.Context, operator string, canonicalID, duplicateID uuid.UUID, reason string) (*models.PlayerMerge, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	merge, err := a.players.MergePlayers(ctx, canonicalID, duplicateID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The duplicate is gone either way, so a failed audit is reported rather than undone
+	if _, err := a.repo.RecordAudit(ctx, AuditRequest{
+		Operator:   operator,
+		Action:     ActionMergePlayers,
+		TargetType: TargetPlayer,
+		TargetID:   canonicalID,
+		Reason:     reason,
+		Details: map[string]interface{}{
+			"duplicate_id": duplicateID,
+			"external_id":  merge.ExternalID,
+			"full_name":    merge.FullName,
+		},
+	}); err != nil {
+		log.Printf("Operator %s merged player %s into %s but it could not be audited: %v", operator, duplicateID, canonicalID, err)
+		return nil, err
+	}
+
+	log.Printf("Operator %s merged player %s into %s: %s", operator, duplicateID, canonicalID, reason)
+	return merge, nil
+}
+
+// DismissPlayerDuplicate takes a pair that isn't a duplicate out of the review queue for operator
+func (a *App) DismissPlayerDuplicate(ctx context.Context, operator string, id uuid.UUID, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrReasonRequired
+	}
+
+	duplicate, err := a.players.DismissPlayerDuplicate(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.repo.RecordAudit(ctx, AuditRequest{
+		Operator:   operator,
+		Action:     ActionDismissPlayerDuplicate,
+		TargetType: TargetDuplicate,
+		TargetID:   id,
+		Reason:     reason,
+		Details: map[string]interface{}{
+			"player_id":    duplicate.PlayerID,
+			"duplicate_id": duplicate.DuplicateID,
+		},
+	}); err != nil {
+		log.Printf("Operator %s dismissed player duplicate %s but it could not be audited: %v", operator, id, err)
+		return err
+	}
+
+	log.Printf("Operator %s dismissed player duplicate %s: %s", operator, id, reason)
+	return nil
+}
+
+func resolveLimit(limit int) int {
+	if limit <= 0 {
+		return defaultLimit
+	}
+	return limit
+}
@@ -0,0 +1,24 @@
+-- name: InsertAdminAuditEntry :one
+INSERT INTO admin_audit_log (
+    operator,
+    action,
+    target_type,
+    target_id,
+    reason,
+    details
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+) RETURNING *;
+
+-- name: ListAdminAuditEntries :many
+-- Newest first, optionally only one operator's or one target's
+SELECT * FROM admin_audit_log
+WHERE (sqlc.narg('operator')::text IS NULL OR operator = sqlc.narg('operator')::text)
+  AND (sqlc.narg('target_id')::uuid IS NULL OR target_id = sqlc.narg('target_id')::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('row_limit');
@@ -0,0 +1,38 @@
+-- name: GetSystemCounts :one
+SELECT (SELECT COUNT(*) FROM users WHERE deleted_at IS NULL) AS users,
+       (SELECT COUNT(*)
+        FROM users
+        WHERE deleted_at IS NULL
+          AND created_at > NOW() - INTERVAL '1 day') AS users_joined_last_day,
+       (SELECT COUNT(*)
+        FROM user_sessions
+        WHERE expires_at > NOW()
+          AND revoked_at IS NULL) AS active_sessions,
+       (SELECT COUNT(*) FROM leagues WHERE deleted_at IS NULL) AS leagues,
+       (SELECT COUNT(*)
+        FROM leagues
+        WHERE deleted_at IS NULL
+          AND archived_at IS NOT NULL) AS archived_leagues,
+       (SELECT COUNT(*) FROM draft WHERE status = 'IN_PROGRESS') AS drafts_in_progress,
+       (SELECT COUNT(*) FROM draft WHERE status = 'PAUSED') AS drafts_paused,
+       (SELECT COUNT(*)
+        FROM draft
+        WHERE status = 'NOT_STARTED'
+          AND scheduled_at BETWEEN NOW() AND NOW() + INTERVAL '1 day') AS drafts_starting_next_day,
+       (SELECT COUNT(*) FROM jobs WHERE status IN ('PENDING', 'RUNNING')) AS pending_jobs,
+       (SELECT COUNT(*) FROM jobs WHERE status = 'FAILED') AS failed_jobs,
+       (SELECT COUNT(*)
+        FROM draft_webhook_deliveries
+        WHERE failed_at IS NOT NULL
+          AND delivered_at IS NULL) AS failed_webhook_deliveries;
+
+-- name: GetUnpublishedEvents :one
+-- Events in the draft, roster and user outboxes not yet published, and how long the oldest
+-- has waited
+SELECT COUNT(*) AS unpublished,
+       COALESCE(EXTRACT(EPOCH FROM NOW() - MIN(created_at)), 0)::float8 AS oldest_age_seconds
+FROM (SELECT created_at FROM draft_outbox WHERE sent_at IS NULL
+      UNION ALL
+      SELECT created_at FROM roster_outbox WHERE sent_at IS NULL
+      UNION ALL
+      SELECT created_at FROM user_outbox WHERE sent_at IS NULL) unpublished;
@@ -0,0 +1,47 @@
+-- name: ListFailedJobs :many
+-- Jobs that ran out of attempts or failed permanently, most recently failed first
+SELECT id, kind, attempts, last_error, created_at, finished_at FROM jobs
+WHERE status = 'FAILED'
+ORDER BY finished_at DESC, id DESC
+LIMIT $1;
+
+-- name: ListFailedWebhookDeliveries :many
+-- Webhook deliveries that ran out of retries, most recently failed first
+SELECT id, event_type, attempts, last_error, created_at, failed_at FROM draft_webhook_deliveries
+WHERE failed_at IS NOT NULL
+  AND delivered_at IS NULL
+ORDER BY failed_at DESC, id DESC
+LIMIT $1;
+
+-- name: RedriveFailedJobs :many
+-- Gives failed jobs fresh attempts, due now. A job's unique key allows one unfinished job, so
+-- only the latest of several failed jobs sharing a key is queued again, and none is while an
+-- unfinished job holds the key.
+UPDATE jobs SET
+    status = 'PENDING',
+    attempts = 0,
+    run_at = NOW(),
+    locked_until = NULL,
+    finished_at = NULL
+WHERE jobs.id IN (SELECT DISTINCT ON (COALESCE(f.unique_key, f.id::text)) f.id
+                  FROM jobs f
+                  WHERE f.id = ANY (sqlc.arg('ids')::uuid[])
+                    AND f.status = 'FAILED'
+                  ORDER BY COALESCE(f.unique_key, f.id::text), f.finished_at DESC)
+  AND (jobs.unique_key IS NULL
+    OR NOT EXISTS (SELECT 1
+                   FROM jobs other
+                   WHERE other.unique_key = jobs.unique_key
+                     AND other.status IN ('PENDING', 'RUNNING')))
+RETURNING jobs.id;
+
+-- name: RedriveFailedWebhookDeliveries :many
+-- Gives failed webhook deliveries fresh retries, due now
+UPDATE draft_webhook_deliveries SET
+    attempts = 0,
+    next_attempt_at = NOW(),
+    failed_at = NULL
+WHERE id = ANY (sqlc.arg('ids')::uuid[])
+  AND failed_at IS NOT NULL
+  AND delivered_at IS NULL
+RETURNING id;
@@ -0,0 +1,44 @@
+-- name: ListStuckDrafts :many
+-- Drafts in progress that aren't getting anywhere: the pick deadline passed longer than
+-- stale_minutes ago without the orchestrator acting on it, no pick has been on the clock
+-- for stale_minutes, or every pick is made but the draft never completed. Longest stuck first.
+SELECT d.id,
+       d.league_id,
+       l.name AS league_name,
+       d.status::text AS status,
+       d.next_deadline,
+       d.updated_at,
+       (SELECT COUNT(*)
+        FROM draft_picks dp
+        WHERE dp.draft_id = d.id
+          AND dp.player_id IS NULL
+          AND NOT dp.forfeited) AS remaining_picks
+FROM draft d
+         JOIN leagues l ON l.id = d.league_id
+WHERE d.status = 'IN_PROGRESS'
+  AND (d.next_deadline < NOW() - make_interval(mins => sqlc.arg('stale_minutes')::int)
+    OR (d.next_deadline IS NULL AND d.updated_at < NOW() - make_interval(mins => sqlc.arg('stale_minutes')::int))
+    OR NOT EXISTS (SELECT 1
+                   FROM draft_picks dp
+                   WHERE dp.draft_id = d.id
+                     AND dp.player_id IS NULL
+                     AND NOT dp.forfeited))
+ORDER BY COALESCE(d.next_deadline, d.updated_at)
+LIMIT sqlc.arg('row_limit');
+
+-- name: CountStuckDrafts :one
+-- How many drafts ListStuckDrafts would list without a limit
+SELECT COUNT(*)
+FROM draft d
+WHERE d.status = 'IN_PROGRESS'
+  AND (d.next_deadline < NOW() - make_interval(mins => sqlc.arg('stale_minutes')::int)
+    OR (d.next_deadline IS NULL AND d.updated_at < NOW() - make_interval(mins => sqlc.arg('stale_minutes')::int))
+    OR NOT EXISTS (SELECT 1
+                   FROM draft_picks dp
+                   WHERE dp.draft_id = d.id
+                     AND dp.player_id IS NULL
+                     AND NOT dp.forfeited));
+
+-- name: CountUnmadeDraftPicks :one
+SELECT COUNT(*) FROM draft_picks
+WHERE draft_id = $1 AND player_id IS NULL AND NOT forfeited;
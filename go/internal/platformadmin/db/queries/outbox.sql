@@ -0,0 +1,36 @@
+-- name: ListOutboxEvents :many
+-- The draft outbox's events newest first, narrowed by whichever filters are set. created_after
+-- is inclusive and created_before exclusive.
+SELECT id, draft_id, event_type, seq, created_at, sent_at
+FROM draft_outbox
+WHERE (sqlc.narg('draft_id')::uuid IS NULL OR draft_id = sqlc.narg('draft_id')::uuid)
+  AND (sqlc.narg('event_type')::text IS NULL OR event_type = sqlc.narg('event_type')::text)
+  AND (sqlc.narg('published')::boolean IS NULL OR (sent_at IS NOT NULL) = sqlc.narg('published')::boolean)
+  AND (sqlc.narg('created_after')::timestamptz IS NULL OR created_at >= sqlc.narg('created_after')::timestamptz)
+  AND (sqlc.narg('created_before')::timestamptz IS NULL OR created_at < sqlc.narg('created_before')::timestamptz)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('row_limit');
+
+-- name: GetOutboxEvent :one
+SELECT id, draft_id, event_type, seq, payload, created_at, sent_at
+FROM draft_outbox
+WHERE id = $1;
+
+-- name: GetOutboxEventForUpdate :one
+SELECT id, draft_id, event_type, created_at, sent_at
+FROM draft_outbox
+WHERE id = $1
+    FOR UPDATE;
+
+-- name: RepublishOutboxEvent :exec
+-- Clears when the event was published, so the outbox worker publishes it again. created_at
+-- narrows the update to the event's partition.
+UPDATE draft_outbox
+SET sent_at = NULL
+WHERE id = $1
+  AND created_at = $2;
+
+-- name: NotifyOutboxEvent :exec
+-- Tells the outbox worker about an event to publish as the insert trigger does, once the
+-- transaction commits
+SELECT pg_notify('draft_outbox_events', json_build_object('id', @id::uuid, 'created_at', @created_at::timestamptz)::text);
@@ -0,0 +1,66 @@
+-- name: SearchUsers :many
+-- Users whose username or email contains query, or whose id is query, deleted or not. An id
+-- match comes first.
+SELECT u.id,
+       u.username,
+       u.email,
+       u.created_at,
+       u.email_verified_at,
+       u.deleted_at,
+       (SELECT COUNT(*)
+        FROM leagues l
+        WHERE l.commissioner_id = u.id
+           OR EXISTS (SELECT 1 FROM fantasy_teams ft WHERE ft.league_id = l.id AND ft.owner_id = u.id)) AS league_count,
+       (SELECT COUNT(*)
+        FROM user_sessions s
+        WHERE s.user_id = u.id
+          AND s.expires_at > NOW()
+          AND s.revoked_at IS NULL) AS active_sessions
+FROM users u
+WHERE u.id::text = sqlc.arg('query')::text
+   OR u.username ILIKE '%' || sqlc.arg('query')::text || '%'
+   OR u.email ILIKE '%' || sqlc.arg('query')::text || '%'
+ORDER BY u.id::text = sqlc.arg('query')::text DESC, u.username
+LIMIT sqlc.arg('row_limit');
+
+-- name: GetAdminUser :one
+-- A user with what SearchUsers shows of them, deleted or not
+SELECT u.id,
+       u.username,
+       u.email,
+       u.created_at,
+       u.email_verified_at,
+       u.deleted_at,
+       (SELECT COUNT(*)
+        FROM leagues l
+        WHERE l.commissioner_id = u.id
+           OR EXISTS (SELECT 1 FROM fantasy_teams ft WHERE ft.league_id = l.id AND ft.owner_id = u.id)) AS league_count,
+       (SELECT COUNT(*)
+        FROM user_sessions s
+        WHERE s.user_id = u.id
+          AND s.expires_at > NOW()
+          AND s.revoked_at IS NULL) AS active_sessions
+FROM users u
+WHERE u.id = $1;
+
+-- name: SearchLeagues :many
+-- Leagues whose name contains query, or whose id is query, archived, deleted or not. An id
+-- match comes first.
+SELECT l.id,
+       l.name,
+       l.sport_id,
+       l.league_type::text AS league_type,
+       l.status::text      AS status,
+       l.season,
+       l.commissioner_id,
+       u.username          AS commissioner_username,
+       (SELECT COUNT(*) FROM fantasy_teams ft WHERE ft.league_id = l.id) AS team_count,
+       l.created_at,
+       l.archived_at,
+       l.deleted_at
+FROM leagues l
+         JOIN users u ON u.id = l.commissioner_id
+WHERE l.id::text = sqlc.arg('query')::text
+   OR l.name ILIKE '%' || sqlc.arg('query')::text || '%'
+ORDER BY l.id::text = sqlc.arg('query')::text DESC, l.name
+LIMIT sqlc.arg('row_limit');
@@ -0,0 +1,105 @@
+-- name: GetValidationLeague :one
+SELECT id, sport_id, league_settings FROM leagues
+WHERE id = $1;
+
+-- name: ListLeagueRosterPlayers :many
+-- The players on the league's rosters outside the taxi squads, which don't count against roster
+-- limits, each team's most recently acquired first. Players without a profile have an empty
+-- position.
+SELECT rp.fantasy_team_id,
+       ft.name                            AS team_name,
+       rp.player_id,
+       p.full_name                        AS player_name,
+       COALESCE(npp.position, '')::text   AS player_position,
+       rp.position::text                  AS roster_position,
+       rp.acquisition_type::text          AS acquisition_type,
+       rp.franchise_tagged,
+       rp.acquired_at
+FROM roster_players rp
+         JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+         JOIN players p ON p.id = rp.player_id
+         LEFT JOIN nfl_player_profiles npp ON npp.player_id = rp.player_id
+WHERE ft.league_id = $1
+  AND rp.position <> 'TAXI'
+ORDER BY ft.name, rp.fantasy_team_id, rp.acquired_at DESC, rp.player_id;
+
+-- name: ListLeagueOrphanedPicks :many
+-- Unmade picks in the league's drafts held by a team of another league, in draft order
+SELECT dp.id,
+       dp.draft_id,
+       dp.overall_pick,
+       dp.team_id,
+       ft.name      AS team_name,
+       ft.league_id AS team_league_id
+FROM draft_picks dp
+         JOIN draft d ON d.id = dp.draft_id
+         JOIN fantasy_teams ft ON ft.id = dp.team_id
+WHERE d.league_id = $1
+  AND ft.league_id <> d.league_id
+  AND dp.player_id IS NULL
+  AND NOT dp.forfeited
+ORDER BY dp.draft_id, dp.overall_pick;
+
+-- name: ListLeagueOwnerlessTeams :many
+-- The league's teams whose owner's account was deleted
+SELECT ft.id,
+       ft.name,
+       ft.owner_id,
+       u.username AS owner_username,
+       u.deleted_at
+FROM fantasy_teams ft
+         JOIN users u ON u.id = ft.owner_id
+WHERE ft.league_id = $1
+  AND u.deleted_at IS NOT NULL
+ORDER BY ft.name, ft.id;
+
+-- name: ListLeagueFinishedDraftsInProgress :many
+-- The league's drafts still in progress with every pick made or forfeited
+SELECT d.id,
+       d.updated_at,
+       (SELECT COUNT(*) FROM draft_picks dp WHERE dp.draft_id = d.id) AS picks
+FROM draft d
+WHERE d.league_id = $1
+  AND d.status = 'IN_PROGRESS'
+  AND NOT EXISTS (SELECT 1
+                  FROM draft_picks dp
+                  WHERE dp.draft_id = d.id
+                    AND dp.player_id IS NULL
+                    AND NOT dp.forfeited)
+ORDER BY d.updated_at, d.id;
+
+-- name: ListTeamRosterPlayerIDs :many
+SELECT player_id FROM roster_players
+WHERE fantasy_team_id = $1;
+
+-- name: GetDraftPickDraftID :one
+SELECT draft_id FROM draft_picks
+WHERE id = $1;
+
+-- name: ForfeitOrphanedPick :one
+-- Forfeits an unmade pick held by a team of another league than its draft's. No row comes back
+-- when the pick isn't orphaned, or no longer is.
+UPDATE draft_picks dp
+SET forfeited = TRUE
+FROM draft d, fantasy_teams ft
+WHERE dp.id = $1
+  AND d.id = dp.draft_id
+  AND ft.id = dp.team_id
+  AND ft.league_id <> d.league_id
+  AND dp.player_id IS NULL
+  AND NOT dp.forfeited
+RETURNING dp.id, dp.draft_id, dp.overall_pick, dp.team_id, d.league_id;
+
+-- name: GetFantasyTeamForUpdate :one
+SELECT id, league_id, owner_id, name FROM fantasy_teams
+WHERE id = $1
+FOR UPDATE;
+
+-- name: CountLeagueTeamsOwnedBy :one
+SELECT COUNT(*) FROM fantasy_teams
+WHERE league_id = $1 AND owner_id = $2;
+
+-- name: SetFantasyTeamOwner :exec
+UPDATE fantasy_teams
+SET owner_id = $2
+WHERE id = $1;
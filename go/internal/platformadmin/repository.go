@@ -0,0 +1,691 @@
+package platformadmin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/platformadmin/db"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	CountStuckDrafts(ctx context.Context, staleMinutes int32) (int64, error)
+	CountUnmadeDraftPicks(ctx context.Context, draftID uuid.UUID) (int64, error)
+	GetAdminUser(ctx context.Context, id uuid.UUID) (db.GetAdminUserRow, error)
+	GetDraftPickDraftID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	GetOutboxEvent(ctx context.Context, id uuid.UUID) (db.GetOutboxEventRow, error)
+	GetSystemCounts(ctx context.Context) (db.GetSystemCountsRow, error)
+	GetUnpublishedEvents(ctx context.Context) (db.GetUnpublishedEventsRow, error)
+	GetValidationLeague(ctx context.Context, id uuid.UUID) (db.GetValidationLeagueRow, error)
+	InsertAdminAuditEntry(ctx context.Context, arg db.InsertAdminAuditEntryParams) (db.AdminAuditLog, error)
+	ListAdminAuditEntries(ctx context.Context, arg db.ListAdminAuditEntriesParams) ([]db.AdminAuditLog, error)
+	ListFailedJobs(ctx context.Context, limit int32) ([]db.ListFailedJobsRow, error)
+	ListFailedWebhookDeliveries(ctx context.Context, limit int32) ([]db.ListFailedWebhookDeliveriesRow, error)
+	ListLeagueFinishedDraftsInProgress(ctx context.Context, leagueID uuid.UUID) ([]db.ListLeagueFinishedDraftsInProgressRow, error)
+	ListLeagueOrphanedPicks(ctx context.Context, leagueID uuid.UUID) ([]db.ListLeagueOrphanedPicksRow, error)
+	ListLeagueOwnerlessTeams(ctx context.Context, leagueID uuid.UUID) ([]db.ListLeagueOwnerlessTeamsRow, error)
+	ListLeagueRosterPlayers(ctx context.Context, leagueID uuid.UUID) ([]db.ListLeagueRosterPlayersRow, error)
+	ListOutboxEvents(ctx context.Context, arg db.ListOutboxEventsParams) ([]db.ListOutboxEventsRow, error)
+	ListStuckDrafts(ctx context.Context, arg db.ListStuckDraftsParams) ([]db.ListStuckDraftsRow, error)
+	ListTeamRosterPlayerIDs(ctx context.Context, fantasyTeamID uuid.UUID) ([]uuid.UUID, error)
+	SearchLeagues(ctx context.Context, arg db.SearchLeaguesParams) ([]db.SearchLeaguesRow, error)
+	SearchUsers(ctx context.Context, arg db.SearchUsersParams) ([]db.SearchUsersRow, error)
+}
+
+// Repository reads and audits what the admin service works on across every domain's tables
+type Repository struct {
+	queries Querier
+	sqlDB   *sql.DB
+}
+
+// NewRepository creates a new admin repository. sqlDB re-drives dead letters in the same
+// transaction as their audit entries.
+func NewRepository(querier Querier, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: querier,
+		sqlDB:   sqlDB,
+	}
+}
+
+// txQueries binds the sqlc queries to a transaction
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
+// SearchUsers finds users by part of their username or email, or their id
+func (r *Repository) SearchUsers(ctx context.Context, query string, limit int) ([]User, error) {
+	rows, err := r.queries.SearchUsers(ctx, db.SearchUsersParams{
+		Query:    query,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	users := make([]User, len(rows))
+	for i, row := range rows {
+		users[i] = dbUserToModel(db.GetAdminUserRow(row))
+	}
+	return users, nil
+}
+
+// GetUser retrieves a user, deleted or not
+func (r *Repository) GetUser(ctx context.Context, id uuid.UUID) (*User, error) {
+	row, err := r.queries.GetAdminUser(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	user := dbUserToModel(row)
+	return &user, nil
+}
+
+// SearchLeagues finds leagues by part of their name, or their id
+func (r *Repository) SearchLeagues(ctx context.Context, query string, limit int) ([]League, error) {
+	rows, err := r.queries.SearchLeagues(ctx, db.SearchLeaguesParams{
+		Query:    query,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search leagues: %w", err)
+	}
+
+	leagues := make([]League, len(rows))
+	for i, row := range rows {
+		leagues[i] = League{
+			ID:                   row.ID,
+			Name:                 row.Name,
+			SportID:              row.SportID,
+			LeagueType:           row.LeagueType,
+			Status:               row.Status,
+			Season:               row.Season,
+			CommissionerID:       row.CommissionerID,
+			CommissionerUsername: row.CommissionerUsername,
+			TeamCount:            int(row.TeamCount),
+			CreatedAt:            row.CreatedAt,
+			ArchivedAt:           sqlutil.FromSqlTime(row.ArchivedAt),
+			DeletedAt:            sqlutil.FromSqlTime(row.DeletedAt),
+		}
+	}
+	return leagues, nil
+}
+
+// ListStuckDrafts lists drafts in progress that haven't moved in staleAfter, longest stuck first
+func (r *Repository) ListStuckDrafts(ctx context.Context, staleAfter time.Duration, limit int) ([]StuckDraft, error) {
+	rows, err := r.queries.ListStuckDrafts(ctx, db.ListStuckDraftsParams{
+		StaleMinutes: int32(staleAfter / time.Minute),
+		RowLimit:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stuck drafts: %w", err)
+	}
+
+	drafts := make([]StuckDraft, len(rows))
+	for i, row := range rows {
+		drafts[i] = StuckDraft{
+			ID:             row.ID,
+			LeagueID:       row.LeagueID,
+			LeagueName:     row.LeagueName,
+			Status:         models.DraftStatus(row.Status),
+			NextDeadline:   sqlutil.FromSqlTime(row.NextDeadline),
+			UpdatedAt:      row.UpdatedAt,
+			RemainingPicks: int(row.RemainingPicks),
+		}
+	}
+	return drafts, nil
+}
+
+// CountUnmadePicks counts the picks of a draft still to be made
+func (r *Repository) CountUnmadePicks(ctx context.Context, draftID uuid.UUID) (int, error) {
+	count, err := r.queries.CountUnmadeDraftPicks(ctx, draftID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unmade draft picks: %w", err)
+	}
+	return int(count), nil
+}
+
+// ListDeadLetters lists failed jobs and webhook deliveries, or only those of kind, most
+// recently failed first
+func (r *Repository) ListDeadLetters(ctx context.Context, kind *DeadLetterKind, limit int) ([]DeadLetter, error) {
+	var letters []DeadLetter
+	if kind == nil || *kind == DeadLetterKindJob {
+		jobs, err := r.queries.ListFailedJobs(ctx, int32(limit))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list failed jobs: %w", err)
+		}
+		for _, job := range jobs {
+			letters = append(letters, DeadLetter{
+				ID:        job.ID,
+				Kind:      DeadLetterKindJob,
+				Name:      job.Kind,
+				Attempts:  int(job.Attempts),
+				LastError: sqlutil.FromSqlString(job.LastError, ""),
+				CreatedAt: job.CreatedAt,
+				FailedAt:  job.FinishedAt.Time,
+			})
+		}
+	}
+	if kind == nil || *kind == DeadLetterKindWebhookDelivery {
+		deliveries, err := r.queries.ListFailedWebhookDeliveries(ctx, int32(limit))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list failed webhook deliveries: %w", err)
+		}
+		for _, delivery := range deliveries {
+			letters = append(letters, DeadLetter{
+				ID:        delivery.ID,
+				Kind:      DeadLetterKindWebhookDelivery,
+				Name:      delivery.EventType,
+				Attempts:  int(delivery.Attempts),
+				LastError: sqlutil.FromSqlString(delivery.LastError, ""),
+				CreatedAt: delivery.CreatedAt,
+				FailedAt:  delivery.FailedAt.Time,
+			})
+		}
+	}
+
+	sort.SliceStable(letters, func(i, j int) bool {
+		return letters[i].FailedAt.After(letters[j].FailedAt)
+	})
+	if len(letters) > limit {
+		letters = letters[:limit]
+	}
+	return letters, nil
+}
+
+// Redrive queues the dead letters of kind among ids to be tried again, auditing each against
+// operator in the same transaction, and returns the ids queued. Ids that aren't dead letters
+// of kind are skipped.
+func (r *Repository) Redrive(ctx context.Context, operator string, kind DeadLetterKind, ids []uuid.UUID, reason string) ([]uuid.UUID, error) {
+	var redriven []uuid.UUID
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		var err error
+		var action string
+		switch kind {
+		case DeadLetterKindJob:
+			action = ActionRedriveJob
+			redriven, err = q.RedriveFailedJobs(ctx, ids)
+		case DeadLetterKindWebhookDelivery:
+			action = ActionRedriveWebhookDelivery
+			redriven, err = q.RedriveFailedWebhookDeliveries(ctx, ids)
+		default:
+			return fmt.Errorf("unknown dead letter kind %q", kind)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to redrive %s dead letters: %w", kind, err)
+		}
+
+		for _, id := range redriven {
+			if _, err := r.recordAudit(ctx, q, AuditRequest{
+				Operator:   operator,
+				Action:     action,
+				TargetType: string(kind),
+				TargetID:   id,
+				Reason:     reason,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return redriven, nil
+}
+
+// GetDashboard gathers the platform's health, counting drafts that haven't moved in
+// staleAfter as stuck
+func (r *Repository) GetDashboard(ctx context.Context, staleAfter time.Duration) (*SystemDashboard, error) {
+	counts, err := r.queries.GetSystemCounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system counts: %w", err)
+	}
+	unpublished, err := r.queries.GetUnpublishedEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unpublished events: %w", err)
+	}
+	stuck, err := r.queries.CountStuckDrafts(ctx, int32(staleAfter/time.Minute))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count stuck drafts: %w", err)
+	}
+
+	return &SystemDashboard{
+		GeneratedAt:               time.Now(),
+		Users:                     counts.Users,
+		UsersJoinedLastDay:        counts.UsersJoinedLastDay,
+		ActiveSessions:            counts.ActiveSessions,
+		Leagues:                   counts.Leagues,
+		ArchivedLeagues:           counts.ArchivedLeagues,
+		DraftsInProgress:          counts.DraftsInProgress,
+		DraftsPaused:              counts.DraftsPaused,
+		DraftsStartingNextDay:     counts.DraftsStartingNextDay,
+		StuckDrafts:               stuck,
+		UnpublishedEvents:         unpublished.Unpublished,
+		OldestUnpublishedEventAge: time.Duration(unpublished.OldestAgeSeconds * float64(time.Second)),
+		PendingJobs:               counts.PendingJobs,
+		DeadLetters:               counts.FailedJobs + counts.FailedWebhookDeliveries,
+	}, nil
+}
+
+// GetLeagueSnapshot reads what league validation checks of a league: its settings, its rosters
+// outside the taxi squads, and the orphaned picks, ownerless teams and finished drafts still in
+// progress it has
+func (r *Repository) GetLeagueSnapshot(ctx context.Context, leagueID uuid.UUID) (*LeagueSnapshot, error) {
+	league, err := r.queries.GetValidationLeague(ctx, leagueID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrLeagueNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league: %w", err)
+	}
+
+	snapshot := &LeagueSnapshot{
+		LeagueID: league.ID,
+		SportID:  league.SportID,
+	}
+	if len(league.LeagueSettings) > 0 {
+		if err := json.Unmarshal(league.LeagueSettings, &snapshot.Settings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	rosterRows, err := r.queries.ListLeagueRosterPlayers(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roster players: %w", err)
+	}
+	snapshot.RosterPlayers = make([]RosterPlayer, len(rosterRows))
+	for i, row := range rosterRows {
+		snapshot.RosterPlayers[i] = RosterPlayer{
+			TeamID:          row.FantasyTeamID,
+			TeamName:        row.TeamName,
+			PlayerID:        row.PlayerID,
+			PlayerName:      row.PlayerName,
+			PlayerPosition:  row.PlayerPosition,
+			RosterPosition:  models.RosterPosition(row.RosterPosition),
+			AcquisitionType: models.AcquisitionType(row.AcquisitionType),
+			FranchiseTagged: row.FranchiseTagged,
+			AcquiredAt:      row.AcquiredAt,
+		}
+	}
+
+	pickRows, err := r.queries.ListLeagueOrphanedPicks(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orphaned picks: %w", err)
+	}
+	snapshot.OrphanedPicks = make([]OrphanedPick, len(pickRows))
+	for i, row := range pickRows {
+		snapshot.OrphanedPicks[i] = OrphanedPick{
+			ID:           row.ID,
+			DraftID:      row.DraftID,
+			OverallPick:  int(row.OverallPick),
+			TeamID:       row.TeamID,
+			TeamName:     row.TeamName,
+			TeamLeagueID: row.TeamLeagueID,
+		}
+	}
+
+	teamRows, err := r.queries.ListLeagueOwnerlessTeams(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ownerless teams: %w", err)
+	}
+	snapshot.OwnerlessTeams = make([]OwnerlessTeam, len(teamRows))
+	for i, row := range teamRows {
+		snapshot.OwnerlessTeams[i] = OwnerlessTeam{
+			ID:            row.ID,
+			Name:          row.Name,
+			OwnerID:       row.OwnerID,
+			OwnerUsername: row.OwnerUsername,
+			DeletedAt:     sqlutil.FromSqlTime(row.DeletedAt),
+		}
+	}
+
+	draftRows, err := r.queries.ListLeagueFinishedDraftsInProgress(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list finished drafts in progress: %w", err)
+	}
+	snapshot.FinishedDrafts = make([]FinishedDraft, len(draftRows))
+	for i, row := range draftRows {
+		snapshot.FinishedDrafts[i] = FinishedDraft{
+			ID:        row.ID,
+			UpdatedAt: row.UpdatedAt,
+			Picks:     int(row.Picks),
+		}
+	}
+	return snapshot, nil
+}
+
+// ListTeamRosterPlayerIDs lists the players on a team's roster, taxi squad included
+func (r *Repository) ListTeamRosterPlayerIDs(ctx context.Context, teamID uuid.UUID) ([]uuid.UUID, error) {
+	ids, err := r.queries.ListTeamRosterPlayerIDs(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team roster players: %w", err)
+	}
+	return ids, nil
+}
+
+// ForfeitOrphanedPick forfeits an orphaned pick for operator under its draft's lock, auditing
+// it in the same transaction
+func (r *Repository) ForfeitOrphanedPick(ctx context.Context, operator string, pickID uuid.UUID, reason string) (*RepairedPick, error) {
+	draftID, err := r.queries.GetDraftPickDraftID(ctx, pickID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPickNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft pick: %w", err)
+	}
+
+	var repaired *RepairedPick
+	err = sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassDraft, draftID, txQueries, func(q *db.Queries) error {
+		row, err := q.ForfeitOrphanedPick(ctx, pickID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPickNotOrphaned
+		}
+		if err != nil {
+			return fmt.Errorf("failed to forfeit orphaned pick: %w", err)
+		}
+
+		if _, err := r.recordAudit(ctx, q, AuditRequest{
+			Operator:   operator,
+			Action:     ActionForfeitOrphanedPick,
+			TargetType: TargetDraftPick,
+			TargetID:   pickID,
+			Reason:     reason,
+			Details: map[string]interface{}{
+				"draft_id":     row.DraftID,
+				"league_id":    row.LeagueID,
+				"team_id":      row.TeamID,
+				"overall_pick": row.OverallPick,
+			},
+		}); err != nil {
+			return err
+		}
+
+		repaired = &RepairedPick{
+			ID:          row.ID,
+			DraftID:     row.DraftID,
+			OverallPick: int(row.OverallPick),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repaired, nil
+}
+
+// ReassignTeamOwner hands a team to ownerID for operator, auditing it in the same transaction.
+// The new owner must be an active user without a team in the league.
+func (r *Repository) ReassignTeamOwner(ctx context.Context, operator string, teamID, ownerID uuid.UUID, reason string) (*OwnerChange, error) {
+	var change *OwnerChange
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		team, err := q.GetFantasyTeamForUpdate(ctx, teamID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTeamNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get fantasy team: %w", err)
+		}
+
+		owner, err := q.GetAdminUser(ctx, ownerID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if owner.DeletedAt.Valid {
+			return ErrUserDeleted
+		}
+
+		owned, err := q.CountLeagueTeamsOwnedBy(ctx, db.CountLeagueTeamsOwnedByParams{
+			LeagueID: team.LeagueID,
+			OwnerID:  ownerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to count the user's teams in the league: %w", err)
+		}
+		if owned > 0 {
+			return ErrOwnerHasTeam
+		}
+
+		if err := q.SetFantasyTeamOwner(ctx, db.SetFantasyTeamOwnerParams{
+			ID:      teamID,
+			OwnerID: ownerID,
+		}); err != nil {
+			return fmt.Errorf("failed to set fantasy team owner: %w", err)
+		}
+
+		if _, err := r.recordAudit(ctx, q, AuditRequest{
+			Operator:   operator,
+			Action:     ActionReassignTeamOwner,
+			TargetType: TargetFantasyTeam,
+			TargetID:   teamID,
+			Reason:     reason,
+			Details: map[string]interface{}{
+				"league_id":         team.LeagueID,
+				"previous_owner_id": team.OwnerID,
+				"owner_id":          ownerID,
+			},
+		}); err != nil {
+			return err
+		}
+
+		change = &OwnerChange{
+			TeamID:          teamID,
+			PreviousOwnerID: team.OwnerID,
+			OwnerID:         ownerID,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// RecordAudit writes an entry to the admin audit log
+func (r *Repository) RecordAudit(ctx context.Context, req AuditRequest) (*AuditEntry, error) {
+	return r.recordAudit(ctx, r.queries, req)
+}
+
+func (r *Repository) recordAudit(ctx context.Context, q Querier, req AuditRequest) (*AuditEntry, error) {
+	details := []byte("{}")
+	if req.Details != nil {
+		var err error
+		if details, err = json.Marshal(req.Details); err != nil {
+			return nil, fmt.Errorf("failed to marshal audit details: %w", err)
+		}
+	}
+
+	entry, err := q.InsertAdminAuditEntry(ctx, db.InsertAdminAuditEntryParams{
+		Operator:   req.Operator,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Reason:     req.Reason,
+		Details:    details,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert admin audit entry: %w", err)
+	}
+
+	audit := dbAuditToModel(entry)
+	return &audit, nil
+}
+
+// ListOutboxEvents lists the draft outbox's events matching filter, newest first, without
+// their payloads
+func (r *Repository) ListOutboxEvents(ctx context.Context, filter OutboxFilter) ([]OutboxEvent, error) {
+	params := db.ListOutboxEventsParams{
+		DraftID:       sqlutil.ToNullUUID(filter.DraftID),
+		EventType:     sqlutil.ToSqlString(filter.EventType),
+		CreatedAfter:  sqlutil.ToSqlTime(filter.CreatedAfter),
+		CreatedBefore: sqlutil.ToSqlTime(filter.CreatedBefore),
+		RowLimit:      int32(filter.Limit),
+	}
+	if filter.Published != nil {
+		params.Published = sql.NullBool{Bool: *filter.Published, Valid: true}
+	}
+
+	rows, err := r.queries.ListOutboxEvents(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outbox events: %w", err)
+	}
+
+	events := make([]OutboxEvent, len(rows))
+	for i, row := range rows {
+		events[i] = OutboxEvent{
+			ID:        row.ID,
+			DraftID:   row.DraftID,
+			EventType: row.EventType,
+			Seq:       fromSqlInt64(row.Seq),
+			CreatedAt: row.CreatedAt,
+			SentAt:    sqlutil.FromSqlTime(row.SentAt),
+		}
+	}
+	return events, nil
+}
+
+// GetOutboxEvent gets an event in the draft outbox with its payload
+func (r *Repository) GetOutboxEvent(ctx context.Context, id uuid.UUID) (*OutboxEvent, error) {
+	row, err := r.queries.GetOutboxEvent(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOutboxEventNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbox event: %w", err)
+	}
+
+	return &OutboxEvent{
+		ID:        row.ID,
+		DraftID:   row.DraftID,
+		EventType: row.EventType,
+		Seq:       fromSqlInt64(row.Seq),
+		Payload:   row.Payload,
+		CreatedAt: row.CreatedAt,
+		SentAt:    sqlutil.FromSqlTime(row.SentAt),
+	}, nil
+}
+
+// RepublishOutboxEvent marks a published outbox event unsent for operator and wakes the outbox
+// worker to publish it again, auditing it in the same transaction
+func (r *Repository) RepublishOutboxEvent(ctx context.Context, operator string, id uuid.UUID, reason string) (*RepublishedEvent, error) {
+	var republished *RepublishedEvent
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		row, err := q.GetOutboxEventForUpdate(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOutboxEventNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get outbox event: %w", err)
+		}
+		if !row.SentAt.Valid {
+			return ErrOutboxEventNotPublished
+		}
+
+		if err := q.RepublishOutboxEvent(ctx, db.RepublishOutboxEventParams{
+			ID:        row.ID,
+			CreatedAt: row.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to republish outbox event: %w", err)
+		}
+		if err := q.NotifyOutboxEvent(ctx, db.NotifyOutboxEventParams{
+			ID:        row.ID,
+			CreatedAt: row.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to notify outbox worker: %w", err)
+		}
+
+		if _, err := r.recordAudit(ctx, q, AuditRequest{
+			Operator:   operator,
+			Action:     ActionRepublishOutboxEvent,
+			TargetType: TargetOutboxEvent,
+			TargetID:   id,
+			Reason:     reason,
+			Details: map[string]interface{}{
+				"draft_id":           row.DraftID,
+				"event_type":         row.EventType,
+				"previously_sent_at": row.SentAt.Time,
+			},
+		}); err != nil {
+			return err
+		}
+
+		republished = &RepublishedEvent{
+			Event: OutboxEvent{
+				ID:        row.ID,
+				DraftID:   row.DraftID,
+				EventType: row.EventType,
+				CreatedAt: row.CreatedAt,
+			},
+			PreviouslySentAt: row.SentAt.Time,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return republished, nil
+}
+
+// ListAudit lists audit entries matching filter, newest first
+func (r *Repository) ListAudit(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	rows, err := r.queries.ListAdminAuditEntries(ctx, db.ListAdminAuditEntriesParams{
+		Operator: sqlutil.ToSqlString(filter.Operator),
+		TargetID: sqlutil.ToNullUUID(filter.TargetID),
+		RowLimit: int32(filter.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list admin audit entries: %w", err)
+	}
+
+	entries := make([]AuditEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = dbAuditToModel(row)
+	}
+	return entries, nil
+}
+
+func dbUserToModel(row db.GetAdminUserRow) User {
+	return User{
+		ID:             row.ID,
+		Username:       row.Username,
+		Email:          row.Email,
+		EmailVerified:  row.EmailVerifiedAt.Valid,
+		CreatedAt:      row.CreatedAt,
+		DeletedAt:      sqlutil.FromSqlTime(row.DeletedAt),
+		LeagueCount:    int(row.LeagueCount),
+		ActiveSessions: int(row.ActiveSessions),
+	}
+}
+
+func dbAuditToModel(row db.AdminAuditLog) AuditEntry {
+	return AuditEntry{
+		ID:         row.ID,
+		Operator:   row.Operator,
+		Action:     row.Action,
+		TargetType: row.TargetType,
+		TargetID:   row.TargetID,
+		Reason:     row.Reason,
+		Details:    row.Details,
+		CreatedAt:  row.CreatedAt,
+	}
+}
+
+func fromSqlInt64(val sql.NullInt64) *int64 {
+	if !val.Valid {
+		return nil
+	}
+	return &val.Int64
+}
@@ -0,0 +1,703 @@
+package platformadmin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/draft"
+	adminv1 "github.com/mcdev12/dynasty/go/internal/genproto/admin/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/admin/v1/adminv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/player"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PlatformAdminApp defines what the service layer needs from the admin application
+type PlatformAdminApp interface {
+	SearchUsers(ctx context.Context, query string, limit int) ([]User, error)
+	SearchLeagues(ctx context.Context, query string, limit int) ([]League, error)
+	ImpersonateUser(ctx context.Context, operator string, userID uuid.UUID, reason string) (*User, *Impersonation, error)
+	RestoreUser(ctx context.Context, operator string, userID uuid.UUID, reason string) (*User, error)
+	ListStuckDrafts(ctx context.Context, staleAfter time.Duration, limit int) ([]StuckDraft, error)
+	ForceCompleteDraft(ctx context.Context, operator string, draftID uuid.UUID, reason string) (*ForcedCompletion, error)
+	ListDeadLetters(ctx context.Context, kind *DeadLetterKind, limit int) ([]DeadLetter, error)
+	RedriveDeadLetters(ctx context.Context, operator string, kind DeadLetterKind, ids []uuid.UUID, reason string) ([]uuid.UUID, error)
+	GetSystemDashboard(ctx context.Context) (*SystemDashboard, error)
+	ListAuditLog(ctx context.Context, filter AuditFilter) ([]AuditEntry, error)
+	ValidateLeague(ctx context.Context, leagueID uuid.UUID) (*LeagueValidationReport, error)
+	RepairRosterOverLimit(ctx context.Context, operator string, teamID uuid.UUID, playerIDs []uuid.UUID, reason string) ([]uuid.UUID, error)
+	RepairOrphanedPick(ctx context.Context, operator string, pickID uuid.UUID, reason string) (*RepairedPick, error)
+	RepairTeamOwner(ctx context.Context, operator string, teamID, ownerID uuid.UUID, reason string) (*OwnerChange, error)
+	ListOutboxEvents(ctx context.Context, filter OutboxFilter) ([]OutboxEvent, error)
+	GetOutboxEvent(ctx context.Context, id uuid.UUID) (*OutboxEvent, error)
+	RepublishOutboxEvent(ctx context.Context, operator string, id uuid.UUID, reason string) (*RepublishedEvent, error)
+	MergePlayers(ctx context.Context, operator string, canonicalID, duplicateID uuid.UUID, reason string) (*models.PlayerMerge, error)
+	DismissPlayerDuplicate(ctx context.Context, operator string, id uuid.UUID, reason string) error
+}
+
+// Service implements the AdminService gRPC interface. It is for platform operators, who
+// authenticate with operator tokens rather than user sessions.
+type Service struct {
+	app PlatformAdminApp
+}
+
+// NewService creates a new admin gRPC service
+func NewService(app PlatformAdminApp) *Service {
+	return &Service{
+		app: app,
+	}
+}
+
+// Verify that Service implements the AdminServiceHandler interface
+var _ adminv1connect.AdminServiceHandler = (*Service)(nil)
+
+// SearchUsers finds users by part of their username or email, or their id
+func (s *Service) SearchUsers(ctx context.Context, req *connect.Request[adminv1.SearchUsersRequest]) (*connect.Response[adminv1.SearchUsersResponse], error) {
+	users, err := s.app.SearchUsers(ctx, req.Msg.Query, int(req.Msg.Limit))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &adminv1.SearchUsersResponse{
+		Users: make([]*adminv1.AdminUser, len(users)),
+	}
+	for i := range users {
+		resp.Users[i] = s.userToProto(&users[i])
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// SearchLeagues finds leagues by part of their name, or their id
+func (s *Service) SearchLeagues(ctx context.Context, req *connect.Request[adminv1.SearchLeaguesRequest]) (*connect.Response[adminv1.SearchLeaguesResponse], error) {
+	leagues, err := s.app.SearchLeagues(ctx, req.Msg.Query, int(req.Msg.Limit))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &adminv1.SearchLeaguesResponse{
+		Leagues: make([]*adminv1.AdminLeague, len(leagues)),
+	}
+	for i := range leagues {
+		resp.Leagues[i] = s.leagueToProto(&leagues[i])
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ImpersonateUser opens a short-lived session as a user for support, auditing who opened it and why
+func (s *Service) ImpersonateUser(ctx context.Context, req *connect.Request[adminv1.ImpersonateUserRequest]) (*connect.Response[adminv1.ImpersonateUserResponse], error) {
+	operator, err := s.operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	user, impersonation, err := s.app.ImpersonateUser(ctx, operator, userID, req.Msg.Reason)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.ImpersonateUserResponse{
+		User:            s.userToProto(user),
+		SessionId:       impersonation.SessionID.String(),
+		AccessToken:     impersonation.AccessToken,
+		AccessExpiresAt: timestamppb.New(impersonation.AccessExpiresAt),
+	}), nil
+}
+
+// RestoreUser undoes a user's deletion
+func (s *Service) RestoreUser(ctx context.Context, req *connect.Request[adminv1.RestoreUserRequest]) (*connect.Response[adminv1.RestoreUserResponse], error) {
+	operator, err := s.operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.app.RestoreUser(ctx, operator, userID, req.Msg.Reason)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.RestoreUserResponse{
+		User: s.userToProto(user),
+	}), nil
+}
+
+// ListStuckDrafts lists drafts in progress that aren't getting anywhere
+func (s *Service) ListStuckDrafts(ctx context.Context, req *connect.Request[adminv1.ListStuckDraftsRequest]) (*connect.Response[adminv1.ListStuckDraftsResponse], error) {
+	staleAfter := time.Duration(req.Msg.StaleMinutes) * time.Minute
+	drafts, err := s.app.ListStuckDrafts(ctx, staleAfter, int(req.Msg.Limit))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &adminv1.ListStuckDraftsResponse{
+		Drafts: make([]*adminv1.StuckDraft, len(drafts)),
+	}
+	for i := range drafts {
+		resp.Drafts[i] = s.stuckDraftToProto(&drafts[i])
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ForceCompleteDraft completes a stuck draft, leaving the picks still to make unmade
+func (s *Service) ForceCompleteDraft(ctx context.Context, req *connect.Request[adminv1.ForceCompleteDraftRequest]) (*connect.Response[adminv1.ForceCompleteDraftResponse], error) {
+	operator, err := s.operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	draftID, err := uuidutil.MustParseOrInvalidArg("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	completion, err := s.app.ForceCompleteDraft(ctx, operator, draftID, req.Msg.Reason)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.ForceCompleteDraftResponse{
+		DraftId:     completion.DraftID.String(),
+		CompletedAt: timestamppb.New(completion.CompletedAt),
+		UnmadePicks: int32(completion.UnmadePicks),
+	}), nil
+}
+
+// ListDeadLetters lists failed jobs and webhook deliveries, most recently failed first
+func (s *Service) ListDeadLetters(ctx context.Context, req *connect.Request[adminv1.ListDeadLettersRequest]) (*connect.Response[adminv1.ListDeadLettersResponse], error) {
+	var kind *DeadLetterKind
+	if req.Msg.Kind != nil {
+		k := s.deadLetterKindFromProto(*req.Msg.Kind)
+		kind = &k
+	}
+
+	deadLetters, err := s.app.ListDeadLetters(ctx, kind, int(req.Msg.Limit))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &adminv1.ListDeadLettersResponse{
+		DeadLetters: make([]*adminv1.DeadLetter, len(deadLetters)),
+	}
+	for i := range deadLetters {
+		resp.DeadLetters[i] = s.deadLetterToProto(&deadLetters[i])
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RedriveDeadLetters queues dead letters to be tried again. Ids that aren't dead letters of
+// the kind are skipped, so only the ids queued are returned.
+func (s *Service) RedriveDeadLetters(ctx context.Context, req *connect.Request[adminv1.RedriveDeadLettersRequest]) (*connect.Response[adminv1.RedriveDeadLettersResponse], error) {
+	operator, err := s.operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, len(req.Msg.Ids))
+	for i, id := range req.Msg.Ids {
+		if ids[i], err = uuidutil.MustParseOrInvalidArg("ids", id); err != nil {
+			return nil, err
+		}
+	}
+
+	redriven, err := s.app.RedriveDeadLetters(ctx, operator, s.deadLetterKindFromProto(req.Msg.Kind), ids, req.Msg.Reason)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &adminv1.RedriveDeadLettersResponse{
+		RedrivenIds: make([]string, len(redriven)),
+	}
+	for i, id := range redriven {
+		resp.RedrivenIds[i] = id.String()
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetSystemDashboard reports the platform's health
+func (s *Service) GetSystemDashboard(ctx context.Context, req *connect.Request[adminv1.GetSystemDashboardRequest]) (*connect.Response[adminv1.GetSystemDashboardResponse], error) {
+	dashboard, err := s.app.GetSystemDashboard(ctx)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.GetSystemDashboardResponse{
+		Dashboard: s.dashboardToProto(dashboard),
+	}), nil
+}
+
+// ListAuditLog lists what operators did through the admin service, newest first
+func (s *Service) ListAuditLog(ctx context.Context, req *connect.Request[adminv1.ListAuditLogRequest]) (*connect.Response[adminv1.ListAuditLogResponse], error) {
+	targetID, err := uuidutil.ParseOptional("target_id", req.Msg.TargetId)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.app.ListAuditLog(ctx, AuditFilter{
+		Operator: req.Msg.Operator,
+		TargetID: targetID,
+		Limit:    int(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &adminv1.ListAuditLogResponse{
+		Entries: make([]*adminv1.AuditEntry, len(entries)),
+	}
+	for i := range entries {
+		resp.Entries[i] = s.auditEntryToProto(&entries[i])
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ValidateLeague reports the inconsistencies in a league's data, each with the RPC that repairs it
+func (s *Service) ValidateLeague(ctx context.Context, req *connect.Request[adminv1.ValidateLeagueRequest]) (*connect.Response[adminv1.ValidateLeagueResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.app.ValidateLeague(ctx, leagueID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.ValidateLeagueResponse{
+		Report: s.reportToProto(report),
+	}), nil
+}
+
+// RepairRosterOverLimit releases players from a team's roster
+func (s *Service) RepairRosterOverLimit(ctx context.Context, req *connect.Request[adminv1.RepairRosterOverLimitRequest]) (*connect.Response[adminv1.RepairRosterOverLimitResponse], error) {
+	operator, err := s.operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	playerIDs := make([]uuid.UUID, len(req.Msg.PlayerIds))
+	for i, id := range req.Msg.PlayerIds {
+		if playerIDs[i], err = uuidutil.MustParseOrInvalidArg("player_ids", id); err != nil {
+			return nil, err
+		}
+	}
+
+	released, err := s.app.RepairRosterOverLimit(ctx, operator, teamID, playerIDs, req.Msg.Reason)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &adminv1.RepairRosterOverLimitResponse{
+		ReleasedPlayerIds: make([]string, len(released)),
+	}
+	for i, id := range released {
+		resp.ReleasedPlayerIds[i] = id.String()
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RepairOrphanedPick forfeits an unmade pick held by a team of another league
+func (s *Service) RepairOrphanedPick(ctx context.Context, req *connect.Request[adminv1.RepairOrphanedPickRequest]) (*connect.Response[adminv1.RepairOrphanedPickResponse], error) {
+	operator, err := s.operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pickID, err := uuidutil.MustParseOrInvalidArg("pick_id", req.Msg.PickId)
+	if err != nil {
+		return nil, err
+	}
+
+	repaired, err := s.app.RepairOrphanedPick(ctx, operator, pickID, req.Msg.Reason)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.RepairOrphanedPickResponse{
+		PickId:      repaired.ID.String(),
+		DraftId:     repaired.DraftID.String(),
+		OverallPick: int32(repaired.OverallPick),
+	}), nil
+}
+
+// RepairTeamOwner hands a team to another user
+func (s *Service) RepairTeamOwner(ctx context.Context, req *connect.Request[adminv1.RepairTeamOwnerRequest]) (*connect.Response[adminv1.RepairTeamOwnerResponse], error) {
+	operator, err := s.operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	teamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := uuidutil.MustParseOrInvalidArg("new_owner_id", req.Msg.NewOwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := s.app.RepairTeamOwner(ctx, operator, teamID, ownerID, req.Msg.Reason)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.RepairTeamOwnerResponse{
+		FantasyTeamId:   change.TeamID.String(),
+		PreviousOwnerId: change.PreviousOwnerID.String(),
+		OwnerId:         change.OwnerID.String(),
+	}), nil
+}
+
+// ListOutboxEvents lists the draft outbox's events, newest first, without their payloads
+func (s *Service) ListOutboxEvents(ctx context.Context, req *connect.Request[adminv1.ListOutboxEventsRequest]) (*connect.Response[adminv1.ListOutboxEventsResponse], error) {
+	draftID, err := uuidutil.ParseOptional("draft_id", req.Msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+	filter := OutboxFilter{
+		DraftID:   draftID,
+		EventType: req.Msg.EventType,
+		Published: req.Msg.Published,
+		Limit:     int(req.Msg.Limit),
+	}
+	if req.Msg.CreatedAfter != nil {
+		createdAfter := req.Msg.CreatedAfter.AsTime()
+		filter.CreatedAfter = &createdAfter
+	}
+	if req.Msg.CreatedBefore != nil {
+		createdBefore := req.Msg.CreatedBefore.AsTime()
+		filter.CreatedBefore = &createdBefore
+	}
+
+	events, err := s.app.ListOutboxEvents(ctx, filter)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &adminv1.ListOutboxEventsResponse{
+		Events: make([]*adminv1.OutboxEvent, len(events)),
+	}
+	for i := range events {
+		resp.Events[i] = s.outboxEventToProto(&events[i])
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetOutboxEvent gets an event in the draft outbox with its payload
+func (s *Service) GetOutboxEvent(ctx context.Context, req *connect.Request[adminv1.GetOutboxEventRequest]) (*connect.Response[adminv1.GetOutboxEventResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.app.GetOutboxEvent(ctx, id)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.GetOutboxEventResponse{
+		Event: s.outboxEventToProto(event),
+	}), nil
+}
+
+// RepublishOutboxEvent queues a published outbox event for the outbox worker to publish again
+func (s *Service) RepublishOutboxEvent(ctx context.Context, req *connect.Request[adminv1.RepublishOutboxEventRequest]) (*connect.Response[adminv1.RepublishOutboxEventResponse], error) {
+	operator, err := s.operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	republished, err := s.app.RepublishOutboxEvent(ctx, operator, id, req.Msg.Reason)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.RepublishOutboxEventResponse{
+		Event:            s.outboxEventToProto(&republished.Event),
+		PreviouslySentAt: timestamppb.New(republished.PreviouslySentAt),
+	}), nil
+}
+
+// MergePlayers merges a duplicate player record into the canonical one
+func (s *Service) MergePlayers(ctx context.Context, req *connect.Request[adminv1.MergePlayersRequest]) (*connect.Response[adminv1.MergePlayersResponse], error) {
+	operator, err := s.operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	canonicalID, err := uuidutil.MustParseOrInvalidArg("canonical_id", req.Msg.CanonicalId)
+	if err != nil {
+		return nil, err
+	}
+	duplicateID, err := uuidutil.MustParseOrInvalidArg("duplicate_id", req.Msg.DuplicateId)
+	if err != nil {
+		return nil, err
+	}
+
+	merge, err := s.app.MergePlayers(ctx, operator, canonicalID, duplicateID, req.Msg.Reason)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.MergePlayersResponse{
+		Merge: &adminv1.PlayerMerge{
+			CanonicalId: merge.CanonicalID.String(),
+			DuplicateId: merge.DuplicateID.String(),
+			SportId:     merge.SportID,
+			ExternalId:  merge.ExternalID,
+			FullName:    merge.FullName,
+			MergedAt:    timestamppb.New(merge.MergedAt),
+		},
+	}), nil
+}
+
+// DismissPlayerDuplicate takes a pair that isn't a duplicate out of the review queue
+func (s *Service) DismissPlayerDuplicate(ctx context.Context, req *connect.Request[adminv1.DismissPlayerDuplicateRequest]) (*connect.Response[adminv1.DismissPlayerDuplicateResponse], error) {
+	operator, err := s.operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.app.DismissPlayerDuplicate(ctx, operator, id, req.Msg.Reason); err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&adminv1.DismissPlayerDuplicateResponse{}), nil
+}
+
+// operator returns the operator the admin auth interceptor authenticated
+func (s *Service) operator(ctx context.Context) (string, error) {
+	operator, ok := interceptors.AdminOperatorFromContext(ctx)
+	if !ok {
+		return "", connect.NewError(connect.CodeUnauthenticated, errors.New("no operator"))
+	}
+	return operator, nil
+}
+
+// toConnectError maps app errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrUserNotFound), errors.Is(err, ErrLeagueNotFound), errors.Is(err, ErrTeamNotFound),
+		errors.Is(err, ErrPickNotFound), errors.Is(err, ErrOutboxEventNotFound), errors.Is(err, player.ErrPlayerNotFound),
+		errors.Is(err, player.ErrPlayerDuplicateNotFound), errors.Is(err, sql.ErrNoRows):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrUserDeleted), errors.Is(err, ErrUserNotDeleted), errors.Is(err, draft.ErrDraftNotRunning), errors.Is(err, ErrPlayerNotOnRoster),
+		errors.Is(err, ErrPickNotOrphaned), errors.Is(err, ErrOwnerHasTeam), errors.Is(err, ErrOutboxEventNotPublished),
+		errors.Is(err, player.ErrPlayerDuplicateDismissed):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, ErrEmptyQuery), errors.Is(err, ErrReasonRequired), errors.Is(err, player.ErrInvalidMerge):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// Conversion methods
+
+// userToProto converts a user to proto
+func (s *Service) userToProto(user *User) *adminv1.AdminUser {
+	return &adminv1.AdminUser{
+		Id:             user.ID.String(),
+		Username:       user.Username,
+		Email:          user.Email,
+		EmailVerified:  user.EmailVerified,
+		CreatedAt:      timestamppb.New(user.CreatedAt),
+		DeletedAt:      timestampOrNil(user.DeletedAt),
+		LeagueCount:    int32(user.LeagueCount),
+		ActiveSessions: int32(user.ActiveSessions),
+	}
+}
+
+// leagueToProto converts a league to proto
+func (s *Service) leagueToProto(league *League) *adminv1.AdminLeague {
+	return &adminv1.AdminLeague{
+		Id:                   league.ID.String(),
+		Name:                 league.Name,
+		SportId:              league.SportID,
+		LeagueType:           league.LeagueType,
+		Status:               league.Status,
+		Season:               league.Season,
+		CommissionerId:       league.CommissionerID.String(),
+		CommissionerUsername: league.CommissionerUsername,
+		TeamCount:            int32(league.TeamCount),
+		CreatedAt:            timestamppb.New(league.CreatedAt),
+		ArchivedAt:           timestampOrNil(league.ArchivedAt),
+		DeletedAt:            timestampOrNil(league.DeletedAt),
+	}
+}
+
+// stuckDraftToProto converts a stuck draft to proto
+func (s *Service) stuckDraftToProto(d *StuckDraft) *adminv1.StuckDraft {
+	return &adminv1.StuckDraft{
+		Id:             d.ID.String(),
+		LeagueId:       d.LeagueID.String(),
+		LeagueName:     d.LeagueName,
+		Status:         string(d.Status),
+		NextDeadline:   timestampOrNil(d.NextDeadline),
+		RemainingPicks: int32(d.RemainingPicks),
+		UpdatedAt:      timestamppb.New(d.UpdatedAt),
+		Reason:         d.Reason,
+	}
+}
+
+// deadLetterToProto converts a dead letter to proto
+func (s *Service) deadLetterToProto(d *DeadLetter) *adminv1.DeadLetter {
+	return &adminv1.DeadLetter{
+		Id:        d.ID.String(),
+		Kind:      s.deadLetterKindToProto(d.Kind),
+		Name:      d.Name,
+		Attempts:  int32(d.Attempts),
+		LastError: d.LastError,
+		CreatedAt: timestamppb.New(d.CreatedAt),
+		FailedAt:  timestamppb.New(d.FailedAt),
+	}
+}
+
+// outboxEventToProto converts an outbox event to proto
+func (s *Service) outboxEventToProto(event *OutboxEvent) *adminv1.OutboxEvent {
+	return &adminv1.OutboxEvent{
+		Id:          event.ID.String(),
+		DraftId:     event.DraftID.String(),
+		EventType:   event.EventType,
+		Seq:         event.Seq,
+		CreatedAt:   timestamppb.New(event.CreatedAt),
+		SentAt:      timestampOrNil(event.SentAt),
+		PayloadJson: string(event.Payload),
+	}
+}
+
+// deadLetterKindToProto converts a dead letter kind to proto
+func (s *Service) deadLetterKindToProto(kind DeadLetterKind) adminv1.DeadLetterKind {
+	switch kind {
+	case DeadLetterKindJob:
+		return adminv1.DeadLetterKind_DEAD_LETTER_KIND_JOB
+	case DeadLetterKindWebhookDelivery:
+		return adminv1.DeadLetterKind_DEAD_LETTER_KIND_WEBHOOK_DELIVERY
+	default:
+		return adminv1.DeadLetterKind_DEAD_LETTER_KIND_UNSPECIFIED
+	}
+}
+
+// deadLetterKindFromProto converts a proto dead letter kind, which validation keeps specified
+func (s *Service) deadLetterKindFromProto(kind adminv1.DeadLetterKind) DeadLetterKind {
+	if kind == adminv1.DeadLetterKind_DEAD_LETTER_KIND_WEBHOOK_DELIVERY {
+		return DeadLetterKindWebhookDelivery
+	}
+	return DeadLetterKindJob
+}
+
+// dashboardToProto converts a system dashboard to proto
+func (s *Service) dashboardToProto(d *SystemDashboard) *adminv1.SystemDashboard {
+	return &adminv1.SystemDashboard{
+		GeneratedAt:                      timestamppb.New(d.GeneratedAt),
+		Users:                            d.Users,
+		UsersJoinedLastDay:               d.UsersJoinedLastDay,
+		ActiveSessions:                   d.ActiveSessions,
+		Leagues:                          d.Leagues,
+		ArchivedLeagues:                  d.ArchivedLeagues,
+		DraftsInProgress:                 d.DraftsInProgress,
+		DraftsPaused:                     d.DraftsPaused,
+		DraftsStartingNextDay:            d.DraftsStartingNextDay,
+		StuckDrafts:                      d.StuckDrafts,
+		UnpublishedEvents:                d.UnpublishedEvents,
+		OldestUnpublishedEventAgeSeconds: d.OldestUnpublishedEventAge.Seconds(),
+		PendingJobs:                      d.PendingJobs,
+		DeadLetters:                      d.DeadLetters,
+	}
+}
+
+// auditEntryToProto converts an audit entry to proto
+func (s *Service) auditEntryToProto(entry *AuditEntry) *adminv1.AuditEntry {
+	return &adminv1.AuditEntry{
+		Id:          entry.ID.String(),
+		Operator:    entry.Operator,
+		Action:      entry.Action,
+		TargetType:  entry.TargetType,
+		TargetId:    entry.TargetID.String(),
+		Reason:      entry.Reason,
+		DetailsJson: string(entry.Details),
+		CreatedAt:   timestamppb.New(entry.CreatedAt),
+	}
+}
+
+// reportToProto converts a league validation report to proto
+func (s *Service) reportToProto(report *LeagueValidationReport) *adminv1.LeagueValidationReport {
+	proto := &adminv1.LeagueValidationReport{
+		LeagueId:    report.LeagueID.String(),
+		GeneratedAt: timestamppb.New(report.GeneratedAt),
+		Findings:    make([]*adminv1.LeagueFinding, len(report.Findings)),
+	}
+	for i, finding := range report.Findings {
+		proto.Findings[i] = &adminv1.LeagueFinding{
+			Kind:               s.findingKindToProto(finding.Kind),
+			TargetType:         finding.TargetType,
+			TargetId:           finding.TargetID.String(),
+			Message:            finding.Message,
+			Repair:             s.repairActionToProto(finding.Repair),
+			Suggestion:         finding.Suggestion,
+			SuggestedPlayerIds: make([]string, len(finding.SuggestedPlayerIDs)),
+		}
+		for j, id := range finding.SuggestedPlayerIDs {
+			proto.Findings[i].SuggestedPlayerIds[j] = id.String()
+		}
+	}
+	return proto
+}
+
+// findingKindToProto converts a finding kind to proto
+func (s *Service) findingKindToProto(kind FindingKind) adminv1.LeagueFindingKind {
+	switch kind {
+	case FindingRosterOverLimit:
+		return adminv1.LeagueFindingKind_LEAGUE_FINDING_KIND_ROSTER_OVER_LIMIT
+	case FindingOrphanedPick:
+		return adminv1.LeagueFindingKind_LEAGUE_FINDING_KIND_ORPHANED_PICK
+	case FindingTeamWithoutOwner:
+		return adminv1.LeagueFindingKind_LEAGUE_FINDING_KIND_TEAM_WITHOUT_OWNER
+	case FindingFinishedDraftInProgress:
+		return adminv1.LeagueFindingKind_LEAGUE_FINDING_KIND_FINISHED_DRAFT_IN_PROGRESS
+	default:
+		return adminv1.LeagueFindingKind_LEAGUE_FINDING_KIND_UNSPECIFIED
+	}
+}
+
+// repairActionToProto converts a repair action to proto
+func (s *Service) repairActionToProto(action RepairAction) adminv1.RepairAction {
+	switch action {
+	case RepairReleasePlayers:
+		return adminv1.RepairAction_REPAIR_ACTION_RELEASE_PLAYERS
+	case RepairForfeitPick:
+		return adminv1.RepairAction_REPAIR_ACTION_FORFEIT_PICK
+	case RepairReassignOwner:
+		return adminv1.RepairAction_REPAIR_ACTION_REASSIGN_OWNER
+	case RepairForceCompleteDraft:
+		return adminv1.RepairAction_REPAIR_ACTION_FORCE_COMPLETE_DRAFT
+	default:
+		return adminv1.RepairAction_REPAIR_ACTION_UNSPECIFIED
+	}
+}
+
+// timestampOrNil converts an optional time to proto, leaving it unset when nil
+func timestampOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,327 @@
+package platformadmin
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// ErrUserNotFound is returned for a user id that no user, deleted or not, has
+var ErrUserNotFound = errors.New("user not found")
+
+// ErrUserDeleted is returned when impersonating a deleted user
+var ErrUserDeleted = errors.New("deleted users can't be impersonated")
+
+// ErrUserNotDeleted is returned when restoring a user who isn't deleted
+var ErrUserNotDeleted = errors.New("user is not deleted")
+
+// ErrEmptyQuery is returned for a search without anything to search for
+var ErrEmptyQuery = errors.New("query must not be blank")
+
+// ErrReasonRequired is returned for an audited operation without a reason
+var ErrReasonRequired = errors.New("a reason is required")
+
+// ErrLeagueNotFound is returned for a league id that no league, deleted or not, has
+var ErrLeagueNotFound = errors.New("league not found")
+
+// ErrTeamNotFound is returned for a fantasy team id that no team has
+var ErrTeamNotFound = errors.New("fantasy team not found")
+
+// ErrPickNotFound is returned for a draft pick id that no pick has
+var ErrPickNotFound = errors.New("draft pick not found")
+
+// ErrPlayerNotOnRoster is returned when releasing a player a team doesn't roster
+var ErrPlayerNotOnRoster = errors.New("player is not on the team's roster")
+
+// ErrPickNotOrphaned is returned when repairing a pick that is made, forfeited or held by a team
+// of its draft's league
+var ErrPickNotOrphaned = errors.New("pick is not orphaned")
+
+// ErrOwnerHasTeam is returned when handing a team to a user who already has one in its league
+var ErrOwnerHasTeam = errors.New("user already has a team in the league")
+
+// ErrOutboxEventNotFound is returned for an outbox event id that no event has
+var ErrOutboxEventNotFound = errors.New("outbox event not found")
+
+// ErrOutboxEventNotPublished is returned when republishing an event the outbox worker hasn't
+// published yet
+var ErrOutboxEventNotPublished = errors.New("outbox event has not been published yet")
+
+// Audited actions
+const (
+	ActionImpersonateUser        = "user.impersonate"
+	ActionRestoreUser            = "user.restore"
+	ActionForceCompleteDraft     = "draft.force_complete"
+	ActionRedriveJob             = "job.redrive"
+	ActionRedriveWebhookDelivery = "webhook_delivery.redrive"
+	ActionReleaseRosterPlayers   = "fantasy_team.release_players"
+	ActionForfeitOrphanedPick    = "draft_pick.forfeit_orphaned"
+	ActionReassignTeamOwner      = "fantasy_team.reassign_owner"
+	ActionRepublishOutboxEvent   = "outbox_event.republish"
+	ActionMergePlayers           = "player.merge"
+	ActionDismissPlayerDuplicate = "player_duplicate.dismiss"
+)
+
+// Kinds of audit targets
+const (
+	TargetUser        = "user"
+	TargetDraft       = "draft"
+	TargetFantasyTeam = "fantasy_team"
+	TargetDraftPick   = "draft_pick"
+	TargetOutboxEvent = "outbox_event"
+	TargetPlayer      = "player"
+	TargetDuplicate   = "player_duplicate"
+)
+
+// DeadLetterKind is what kind of work a dead letter is. It doubles as the dead letter's audit
+// target type.
+type DeadLetterKind string
+
+const (
+	DeadLetterKindJob             DeadLetterKind = "job"
+	DeadLetterKindWebhookDelivery DeadLetterKind = "webhook_delivery"
+)
+
+// User is a user as support sees them, deleted or not
+type User struct {
+	ID             uuid.UUID  `json:"id"`
+	Username       string     `json:"username"`
+	Email          string     `json:"email"`
+	EmailVerified  bool       `json:"email_verified"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+	LeagueCount    int        `json:"league_count"` // leagues the user has a team in or runs
+	ActiveSessions int        `json:"active_sessions"`
+}
+
+// League is a league as support sees it, deleted or archived or not
+type League struct {
+	ID                   uuid.UUID  `json:"id"`
+	Name                 string     `json:"name"`
+	SportID              string     `json:"sport_id"`
+	LeagueType           string     `json:"league_type"`
+	Status               string     `json:"status"`
+	Season               string     `json:"season"`
+	CommissionerID       uuid.UUID  `json:"commissioner_id"`
+	CommissionerUsername string     `json:"commissioner_username"`
+	TeamCount            int        `json:"team_count"`
+	CreatedAt            time.Time  `json:"created_at"`
+	ArchivedAt           *time.Time `json:"archived_at,omitempty"`
+	DeletedAt            *time.Time `json:"deleted_at,omitempty"`
+}
+
+// StuckDraft is a draft in progress that isn't getting anywhere
+type StuckDraft struct {
+	ID             uuid.UUID          `json:"id"`
+	LeagueID       uuid.UUID          `json:"league_id"`
+	LeagueName     string             `json:"league_name"`
+	Status         models.DraftStatus `json:"status"`
+	NextDeadline   *time.Time         `json:"next_deadline,omitempty"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+	RemainingPicks int                `json:"remaining_picks"`
+	Reason         string             `json:"reason"` // why it counts as stuck
+}
+
+// DeadLetter is work given up on after failing every attempt
+type DeadLetter struct {
+	ID        uuid.UUID      `json:"id"`
+	Kind      DeadLetterKind `json:"kind"`
+	Name      string         `json:"name"` // the job's kind or the delivered event's type
+	Attempts  int            `json:"attempts"`
+	LastError string         `json:"last_error"`
+	CreatedAt time.Time      `json:"created_at"`
+	FailedAt  time.Time      `json:"failed_at"`
+}
+
+// SystemDashboard is a snapshot of the platform's health
+type SystemDashboard struct {
+	GeneratedAt               time.Time     `json:"generated_at"`
+	Users                     int64         `json:"users"`
+	UsersJoinedLastDay        int64         `json:"users_joined_last_day"`
+	ActiveSessions            int64         `json:"active_sessions"`
+	Leagues                   int64         `json:"leagues"`
+	ArchivedLeagues           int64         `json:"archived_leagues"`
+	DraftsInProgress          int64         `json:"drafts_in_progress"`
+	DraftsPaused              int64         `json:"drafts_paused"`
+	DraftsStartingNextDay     int64         `json:"drafts_starting_next_day"`
+	StuckDrafts               int64         `json:"stuck_drafts"`
+	UnpublishedEvents         int64         `json:"unpublished_events"`
+	OldestUnpublishedEventAge time.Duration `json:"oldest_unpublished_event_age"`
+	PendingJobs               int64         `json:"pending_jobs"`
+	DeadLetters               int64         `json:"dead_letters"`
+}
+
+// AuditEntry is one thing an operator did through the admin service
+type AuditEntry struct {
+	ID         uuid.UUID       `json:"id"`
+	Operator   string          `json:"operator"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   uuid.UUID       `json:"target_id"`
+	Reason     string          `json:"reason"`
+	Details    json.RawMessage `json:"details"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// AuditRequest records something an operator did
+type AuditRequest struct {
+	Operator   string
+	Action     string
+	TargetType string
+	TargetID   uuid.UUID
+	Reason     string
+	Details    interface{} // marshalled to JSON; nil for none
+}
+
+// AuditFilter narrows the audit log
+type AuditFilter struct {
+	Operator *string
+	TargetID *uuid.UUID
+	Limit    int
+}
+
+// Impersonation is a session opened as a user for an operator
+type Impersonation struct {
+	SessionID       uuid.UUID
+	AccessToken     string
+	AccessExpiresAt time.Time
+}
+
+// ForcedCompletion is a draft an operator forced to complete
+type ForcedCompletion struct {
+	DraftID     uuid.UUID
+	CompletedAt time.Time
+	UnmadePicks int // picks left unmade
+}
+
+// FindingKind is what kind of inconsistency league validation found
+type FindingKind string
+
+const (
+	FindingRosterOverLimit         FindingKind = "ROSTER_OVER_LIMIT"
+	FindingOrphanedPick            FindingKind = "ORPHANED_PICK"
+	FindingTeamWithoutOwner        FindingKind = "TEAM_WITHOUT_OWNER"
+	FindingFinishedDraftInProgress FindingKind = "FINISHED_DRAFT_IN_PROGRESS"
+)
+
+// RepairAction is what repairs a finding
+type RepairAction string
+
+const (
+	RepairReleasePlayers     RepairAction = "RELEASE_PLAYERS"
+	RepairForfeitPick        RepairAction = "FORFEIT_PICK"
+	RepairReassignOwner      RepairAction = "REASSIGN_OWNER"
+	RepairForceCompleteDraft RepairAction = "FORCE_COMPLETE_DRAFT"
+)
+
+// LeagueFinding is one inconsistency in a league's data, with how to repair it
+type LeagueFinding struct {
+	Kind               FindingKind
+	TargetType         string
+	TargetID           uuid.UUID
+	Message            string
+	Repair             RepairAction
+	Suggestion         string
+	SuggestedPlayerIDs []uuid.UUID // players to release, for roster findings
+}
+
+// LeagueValidationReport is what league validation found in a league's data
+type LeagueValidationReport struct {
+	LeagueID    uuid.UUID
+	GeneratedAt time.Time
+	Findings    []LeagueFinding
+}
+
+// LeagueSnapshot is the data of a league validation checks
+type LeagueSnapshot struct {
+	LeagueID       uuid.UUID
+	SportID        string
+	Settings       interface{} // the raw league_settings
+	RosterPlayers  []RosterPlayer
+	OrphanedPicks  []OrphanedPick
+	OwnerlessTeams []OwnerlessTeam
+	FinishedDrafts []FinishedDraft
+}
+
+// RosterPlayer is a player on a team's roster outside the taxi squad
+type RosterPlayer struct {
+	TeamID          uuid.UUID
+	TeamName        string
+	PlayerID        uuid.UUID
+	PlayerName      string
+	PlayerPosition  string // empty for players without a profile
+	RosterPosition  models.RosterPosition
+	AcquisitionType models.AcquisitionType
+	FranchiseTagged bool
+	AcquiredAt      time.Time
+}
+
+// OrphanedPick is an unmade pick held by a team of another league than its draft's
+type OrphanedPick struct {
+	ID           uuid.UUID
+	DraftID      uuid.UUID
+	OverallPick  int
+	TeamID       uuid.UUID
+	TeamName     string
+	TeamLeagueID uuid.UUID
+}
+
+// OwnerlessTeam is a team whose owner deleted their account
+type OwnerlessTeam struct {
+	ID            uuid.UUID
+	Name          string
+	OwnerID       uuid.UUID
+	OwnerUsername string
+	DeletedAt     *time.Time
+}
+
+// FinishedDraft is a draft in progress with every pick made or forfeited
+type FinishedDraft struct {
+	ID        uuid.UUID
+	UpdatedAt time.Time
+	Picks     int
+}
+
+// RepairedPick is an orphaned pick an operator forfeited
+type RepairedPick struct {
+	ID          uuid.UUID
+	DraftID     uuid.UUID
+	OverallPick int
+}
+
+// OwnerChange is a team an operator handed to another user
+type OwnerChange struct {
+	TeamID          uuid.UUID
+	PreviousOwnerID uuid.UUID
+	OwnerID         uuid.UUID
+}
+
+// OutboxEvent is an event in the draft outbox
+type OutboxEvent struct {
+	ID        uuid.UUID       `json:"id"`
+	DraftID   uuid.UUID       `json:"draft_id"`
+	EventType string          `json:"event_type"`
+	Seq       *int64          `json:"seq,omitempty"`     // nil for events written before sequencing
+	Payload   json.RawMessage `json:"payload,omitempty"` // only loaded for a single event
+	CreatedAt time.Time       `json:"created_at"`
+	SentAt    *time.Time      `json:"sent_at,omitempty"` // nil until the outbox worker publishes it
+}
+
+// OutboxFilter narrows the draft outbox
+type OutboxFilter struct {
+	DraftID       *uuid.UUID
+	EventType     *string
+	Published     *bool
+	CreatedAfter  *time.Time // inclusive
+	CreatedBefore *time.Time // exclusive
+	Limit         int
+}
+
+// RepublishedEvent is an outbox event queued to be published again
+type RepublishedEvent struct {
+	Event            OutboxEvent
+	PreviouslySentAt time.Time
+}
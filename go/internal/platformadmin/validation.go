@@ -0,0 +1,156 @@
+package platformadmin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// rosterFindings finds the teams rostering more players than the league's roster size or a
+// position limit allows, suggesting the most recently acquired bench players to release
+func rosterFindings(snapshot *LeagueSnapshot) []LeagueFinding {
+	limits := models.SettingsRosterLimits(snapshot.SportID, snapshot.Settings)
+	if !limits.Enforced() {
+		return nil
+	}
+
+	// Roster players come grouped by team, most recently acquired first
+	var findings []LeagueFinding
+	for start := 0; start < len(snapshot.RosterPlayers); {
+		end := start
+		for end < len(snapshot.RosterPlayers) && snapshot.RosterPlayers[end].TeamID == snapshot.RosterPlayers[start].TeamID {
+			end++
+		}
+		if finding, ok := rosterFinding(limits, snapshot.RosterPlayers[start:end]); ok {
+			findings = append(findings, finding)
+		}
+		start = end
+	}
+	return findings
+}
+
+// rosterFinding checks one team's roster players against the league's limits
+func rosterFinding(limits models.RosterLimits, players []RosterPlayer) (LeagueFinding, bool) {
+	counts := make(map[string]int)
+	for _, player := range players {
+		counts[player.PlayerPosition]++
+	}
+
+	var problems []string
+	var chosen []uuid.UUID
+	released := make(map[uuid.UUID]bool)
+	release := func(n int, position *string) {
+		for _, player := range players {
+			if n <= 0 {
+				return
+			}
+			if released[player.PlayerID] || !releasable(player) || (position != nil && player.PlayerPosition != *position) {
+				continue
+			}
+			released[player.PlayerID] = true
+			chosen = append(chosen, player.PlayerID)
+			n--
+		}
+	}
+
+	positions := make([]string, 0, len(limits.PositionLimits))
+	for position := range limits.PositionLimits {
+		positions = append(positions, position)
+	}
+	sort.Strings(positions)
+	for _, position := range positions {
+		limit := limits.PositionLimits[position]
+		if over := counts[position] - limit; over > 0 {
+			problems = append(problems, fmt.Sprintf("%d %s players, over its limit of %d", counts[position], position, limit))
+			release(over, &position)
+		}
+	}
+	if limits.Size != nil {
+		if over := len(players) - *limits.Size; over > 0 {
+			problems = append([]string{fmt.Sprintf("%d players, over its limit of %d", len(players), *limits.Size)}, problems...)
+			release(over-len(chosen), nil)
+		}
+	}
+	if len(problems) == 0 {
+		return LeagueFinding{}, false
+	}
+
+	suggestion := fmt.Sprintf("release the %d most recently acquired bench players that aren't keepers or franchise tagged", len(chosen))
+	if len(chosen) == 0 {
+		suggestion = "release players; every bench player is a keeper or franchise tagged, so pick them with the owner"
+	}
+	return LeagueFinding{
+		Kind:               FindingRosterOverLimit,
+		TargetType:         TargetFantasyTeam,
+		TargetID:           players[0].TeamID,
+		Message:            fmt.Sprintf("%s rosters %s", players[0].TeamName, strings.Join(problems, "; ")),
+		Repair:             RepairReleasePlayers,
+		Suggestion:         suggestion,
+		SuggestedPlayerIDs: chosen,
+	}, true
+}
+
+// releasable reports whether a player may be suggested for release: a bench player who isn't a
+// keeper or franchise tagged
+func releasable(player RosterPlayer) bool {
+	return player.RosterPosition == models.RosterPositionBench &&
+		player.AcquisitionType != models.AcquisitionTypeKeeper &&
+		!player.FranchiseTagged
+}
+
+// orphanedPickFindings reports the unmade picks held by teams of other leagues
+func orphanedPickFindings(snapshot *LeagueSnapshot) []LeagueFinding {
+	findings := make([]LeagueFinding, len(snapshot.OrphanedPicks))
+	for i, pick := range snapshot.OrphanedPicks {
+		findings[i] = LeagueFinding{
+			Kind:       FindingOrphanedPick,
+			TargetType: TargetDraftPick,
+			TargetID:   pick.ID,
+			Message: fmt.Sprintf("pick %d of draft %s is held by %s (%s) of league %s",
+				pick.OverallPick, pick.DraftID, pick.TeamName, pick.TeamID, pick.TeamLeagueID),
+			Repair:     RepairForfeitPick,
+			Suggestion: "forfeit the pick so the draft skips it",
+		}
+	}
+	return findings
+}
+
+// ownerlessTeamFindings reports the teams whose owner deleted their account
+func ownerlessTeamFindings(snapshot *LeagueSnapshot) []LeagueFinding {
+	findings := make([]LeagueFinding, len(snapshot.OwnerlessTeams))
+	for i, team := range snapshot.OwnerlessTeams {
+		message := fmt.Sprintf("%s is owned by %s, whose account was deleted", team.Name, team.OwnerUsername)
+		if team.DeletedAt != nil {
+			message += " on " + team.DeletedAt.Format("2006-01-02")
+		}
+		findings[i] = LeagueFinding{
+			Kind:       FindingTeamWithoutOwner,
+			TargetType: TargetFantasyTeam,
+			TargetID:   team.ID,
+			Message:    message,
+			Repair:     RepairReassignOwner,
+			Suggestion: "hand the team to the member the commissioner names, who mustn't have a team in the league",
+		}
+	}
+	return findings
+}
+
+// finishedDraftFindings reports the drafts in progress with every pick made or forfeited
+func finishedDraftFindings(snapshot *LeagueSnapshot) []LeagueFinding {
+	findings := make([]LeagueFinding, len(snapshot.FinishedDrafts))
+	for i, draft := range snapshot.FinishedDrafts {
+		findings[i] = LeagueFinding{
+			Kind:       FindingFinishedDraftInProgress,
+			TargetType: TargetDraft,
+			TargetID:   draft.ID,
+			Message: fmt.Sprintf("draft %s is in progress with all %d picks made or forfeited since %s",
+				draft.ID, draft.Picks, draft.UpdatedAt.Format("2006-01-02 15:04 MST")),
+			Repair:     RepairForceCompleteDraft,
+			Suggestion: "complete the draft",
+		}
+	}
+	return findings
+}
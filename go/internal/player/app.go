@@ -3,10 +3,12 @@ package player
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	sportradarclient "github.com/mcdev12/dynasty/go/clients/sport_radar_client"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
 	"github.com/mcdev12/dynasty/go/internal/sports/base"
 )
 
@@ -15,11 +17,26 @@ import (
 type PlayerRepository interface {
 	CreatePlayer(ctx context.Context, req CreatePlayerRequest) (*models.Player, error)
 	GetPlayer(ctx context.Context, id uuid.UUID) (*models.Player, error)
+	GetPlayersByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Player, error)
 	GetPlayerByExternalID(ctx context.Context, sportID, externalID string) (*models.Player, error)
 	UpdatePlayer(ctx context.Context, playerID uuid.UUID, fullName string, teamID *uuid.UUID) (*models.Player, error)
 	UpdatePlayerProfile(ctx context.Context, playerID uuid.UUID, profile models.Profile) error
 	UpdatePlayerAndProfile(ctx context.Context, playerID uuid.UUID, fullName string, teamID *uuid.UUID, profile models.Profile) (*models.Player, error)
 	DeletePlayer(ctx context.Context, id uuid.UUID) error
+	UpsertExternalPlayerIDs(ctx context.Context, playerID uuid.UUID, ids map[string]string) error
+	ResolveExternalPlayerIDs(ctx context.Context, provider string, externalIDs []string) (map[string]uuid.UUID, error)
+	ListExternalPlayerIDs(ctx context.Context, playerIDs []uuid.UUID) (map[uuid.UUID]map[string]string, error)
+	RefreshPlayerOwnership(ctx context.Context, computedAt time.Time) (int64, error)
+	ListPlayerOwnership(ctx context.Context, sportID string, limit, offset int32) ([]models.PlayerOwnership, error)
+	SearchPlayers(ctx context.Context, query PlayerSearchQuery) ([]PlayerSearchResult, error)
+	CountPlayers(ctx context.Context, filter PlayerFilter) (int, error)
+	UpsertPlayerRankings(ctx context.Context, rankings []models.PlayerRanking) error
+	FindPlayerDuplicates(ctx context.Context) ([]models.PlayerDuplicate, error)
+	CreatePlayerDuplicate(ctx context.Context, duplicate models.PlayerDuplicate) (*models.PlayerDuplicate, error)
+	GetPlayerDuplicate(ctx context.Context, id uuid.UUID) (*models.PlayerDuplicate, error)
+	ListPendingPlayerDuplicates(ctx context.Context, sportID *string, limit, offset int32) ([]models.PlayerDuplicate, error)
+	DismissPlayerDuplicate(ctx context.Context, id uuid.UUID) (*models.PlayerDuplicate, error)
+	MergePlayers(ctx context.Context, canonicalID, duplicateID uuid.UUID) (*models.PlayerMerge, error)
 }
 
 // SyncResult represents the result of syncing players from external API
@@ -28,6 +45,11 @@ type SyncResult struct {
 	Created        int     `json:"created"`
 	Updated        int     `json:"updated"`
 	Errors         []error `json:"errors,omitempty"`
+	DraftAlerts    int     `json:"draft_alerts"` // injury designation events emitted to live drafts
+
+	// StatusChanges holds the players whose status the sync changed, so callers can alert
+	// live drafts of new injuries
+	StatusChanges []models.PlayerStatusChange `json:"-"`
 }
 
 // AddError adds an error to the sync result
@@ -40,6 +62,102 @@ func (r *SyncResult) HasErrors() bool {
 	return len(r.Errors) > 0
 }
 
+// ProviderInternal names our own player UUIDs in a ResolvePlayerIDs request
+const ProviderInternal = "internal"
+
+// defaultOwnershipPageSize is used when a ListPlayerOwnership request doesn't set a limit
+const defaultOwnershipPageSize = 50
+
+// maxResolvePlayerIDs bounds how many IDs one ResolvePlayerIDs request may carry
+const maxResolvePlayerIDs = 1000
+
+// crosswalkProviders are the providers whose IDs are kept in the external ID crosswalk
+var crosswalkProviders = map[string]bool{
+	models.PlayerIDProviderSportradar: true,
+	models.PlayerIDProviderGSIS:       true,
+	models.PlayerIDProviderSleeper:    true,
+	models.PlayerIDProviderESPN:       true,
+	models.PlayerIDProviderYahoo:      true,
+}
+
+// ResolvePlayerIDsRequest asks for the IDs of the same players at other providers
+type ResolvePlayerIDsRequest struct {
+	FromProvider string   // provider the IDs belong to, or ProviderInternal for player UUIDs
+	IDs          []string // IDs to resolve
+	ToProviders  []string // providers to return IDs for; empty returns every known provider
+}
+
+// PlayerIDMapping is one resolved player
+type PlayerIDMapping struct {
+	ID          string            // the ID as given in the request
+	PlayerID    uuid.UUID         // internal player ID
+	ExternalIDs map[string]string // provider -> ID, limited to the requested providers
+}
+
+// ResolvePlayerIDsResult lists resolved players in request order, plus the IDs that had no match
+type ResolvePlayerIDsResult struct {
+	Mappings   []PlayerIDMapping
+	Unresolved []string
+}
+
+// playerPageSizes are the page sizes of a player search
+var playerPageSizes = pagination.Sizes{Default: 50, Max: 100}
+
+// PlayerSortBy orders a player search. Players are ordered by name within equal sort keys.
+type PlayerSortBy string
+
+const (
+	PlayerSortByName      PlayerSortBy = "name"
+	PlayerSortByPosition  PlayerSortBy = "position"
+	PlayerSortByTeam      PlayerSortBy = "team" // by team code, with free agents first
+	PlayerSortByCreatedAt PlayerSortBy = "created_at"
+)
+
+// PlayerFilter narrows a player search; nil fields match every player
+type PlayerFilter struct {
+	SportID  *string    `json:"sport_id,omitempty"`
+	TeamID   *uuid.UUID `json:"team_id,omitempty"`
+	Position *string    `json:"position,omitempty"`
+}
+
+// PlayerSearchRequest pages through the players matching a filter
+type PlayerSearchRequest struct {
+	Filter PlayerFilter       `json:"filter"`
+	SortBy PlayerSortBy       `json:"sort_by"` // defaults to PlayerSortByName
+	Page   pagination.Request `json:"page"`
+}
+
+// PlayerPage is one page of a player search
+type PlayerPage struct {
+	Players       []models.Player `json:"players"`
+	PageSize      int             `json:"page_size"`
+	NextPageToken string          `json:"next_page_token,omitempty"` // empty on the last page
+	Total         *int            `json:"total,omitempty"`           // set when the request included the total
+}
+
+// PlayerSearchQuery selects the players the repository returns
+type PlayerSearchQuery struct {
+	Filter PlayerFilter
+	SortBy PlayerSortBy
+	After  *PlayerCursor // continue after this player
+	Limit  int32
+}
+
+// PlayerSearchResult is a player found by a search, with the key it was sorted by
+type PlayerSearchResult struct {
+	Player  models.Player
+	SortKey string
+}
+
+// PlayerCursor is the position of the last player on a page in search order. It carries the
+// sort it was issued for, so a token isn't reused under a different one.
+type PlayerCursor struct {
+	SortBy   PlayerSortBy `json:"sort_by"`
+	SortKey  string       `json:"sort_key"`
+	FullName string       `json:"full_name"`
+	ID       uuid.UUID    `json:"id"`
+}
+
 // App handles player business logic
 type App struct {
 	repo    PlayerRepository
@@ -92,6 +210,28 @@ func (a *App) GetPlayer(ctx context.Context, id uuid.UUID) (*models.Player, erro
 	return player, nil
 }
 
+// BatchGetPlayers retrieves players by ID, by name, along with the requested IDs without a
+// player in request order
+func (a *App) BatchGetPlayers(ctx context.Context, ids []uuid.UUID) ([]models.Player, []uuid.UUID, error) {
+	players, err := a.repo.GetPlayersByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get players: %w", err)
+	}
+
+	found := make(map[uuid.UUID]bool, len(players))
+	for _, player := range players {
+		found[player.ID] = true
+	}
+	var missing []uuid.UUID
+	for _, id := range ids {
+		if !found[id] {
+			found[id] = true
+			missing = append(missing, id)
+		}
+	}
+	return players, missing, nil
+}
+
 // GetPlayerByExternalID retrieves a player by sport ID and external ID
 func (a *App) GetPlayerByExternalID(ctx context.Context, sportID, externalID string) (*models.Player, error) {
 	player, err := a.repo.GetPlayerByExternalID(ctx, sportID, externalID)
@@ -116,6 +256,200 @@ func (a *App) DeletePlayer(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// ResolvePlayerIDs maps player IDs between providers and internal player UUIDs
+func (a *App) ResolvePlayerIDs(ctx context.Context, req ResolvePlayerIDsRequest) (*ResolvePlayerIDsResult, error) {
+	if req.FromProvider != ProviderInternal && !crosswalkProviders[req.FromProvider] {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, req.FromProvider)
+	}
+	for _, provider := range req.ToProviders {
+		if provider != ProviderInternal && !crosswalkProviders[provider] {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+		}
+	}
+	if len(req.IDs) > maxResolvePlayerIDs {
+		return nil, fmt.Errorf("%w: %d, max %d", ErrTooManyPlayerIDs, len(req.IDs), maxResolvePlayerIDs)
+	}
+
+	result := &ResolvePlayerIDsResult{}
+	if len(req.IDs) == 0 {
+		return result, nil
+	}
+
+	// Map each requested ID to an internal player ID first
+	playerIDs := make(map[string]uuid.UUID, len(req.IDs))
+	if req.FromProvider == ProviderInternal {
+		for _, id := range req.IDs {
+			if playerID, err := uuid.Parse(id); err == nil {
+				playerIDs[id] = playerID
+			}
+		}
+	} else {
+		resolved, err := a.repo.ResolveExternalPlayerIDs(ctx, req.FromProvider, req.IDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve player IDs: %w", err)
+		}
+		playerIDs = resolved
+	}
+
+	lookup := make([]uuid.UUID, 0, len(playerIDs))
+	for _, playerID := range playerIDs {
+		lookup = append(lookup, playerID)
+	}
+	externalIDs, err := a.repo.ListExternalPlayerIDs(ctx, lookup)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list external player IDs: %w", err)
+	}
+
+	wanted := make(map[string]bool, len(req.ToProviders))
+	for _, provider := range req.ToProviders {
+		wanted[provider] = true
+	}
+
+	for _, id := range req.IDs {
+		playerID, ok := playerIDs[id]
+		// Internal IDs only count as resolved when the crosswalk knows the player
+		if _, known := externalIDs[playerID]; !ok || (req.FromProvider == ProviderInternal && !known) {
+			result.Unresolved = append(result.Unresolved, id)
+			continue
+		}
+
+		mapping := PlayerIDMapping{ID: id, PlayerID: playerID, ExternalIDs: map[string]string{}}
+		for provider, externalID := range externalIDs[playerID] {
+			if len(wanted) == 0 || wanted[provider] {
+				mapping.ExternalIDs[provider] = externalID
+			}
+		}
+		result.Mappings = append(result.Mappings, mapping)
+	}
+
+	return result, nil
+}
+
+// RefreshPlayerOwnership recomputes platform-wide ownership and start percentages for every
+// rostered player. Run nightly by the ownership job.
+func (a *App) RefreshPlayerOwnership(ctx context.Context) (int64, error) {
+	rows, err := a.repo.RefreshPlayerOwnership(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh player ownership: %w", err)
+	}
+	return rows, nil
+}
+
+// ListPlayerOwnership returns a page of a sport's most-owned players as of the last refresh
+func (a *App) ListPlayerOwnership(ctx context.Context, sportID string, limit, offset int) ([]models.PlayerOwnership, error) {
+	if limit == 0 {
+		limit = defaultOwnershipPageSize
+	}
+
+	ownership, err := a.repo.ListPlayerOwnership(ctx, sportID, int32(limit), int32(offset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list player ownership: %w", err)
+	}
+	return ownership, nil
+}
+
+// UpsertPlayerRankings places players on the preseason boards the draft room sorts by, e.g.
+// from a rankings import. Rankings without a source are taken as consensus rankings.
+func (a *App) UpsertPlayerRankings(ctx context.Context, rankings []models.PlayerRanking) error {
+	for i := range rankings {
+		ranking := &rankings[i]
+		if ranking.Season == "" {
+			return fmt.Errorf("validation failed: season is required")
+		}
+		if ranking.PlayerID == uuid.Nil {
+			return fmt.Errorf("validation failed: player_id is required")
+		}
+		if ranking.OverallRank < 1 {
+			return fmt.Errorf("validation failed: overall_rank must be at least 1")
+		}
+		switch ranking.ScoringFormat {
+		case models.ScoringFormatStandard, models.ScoringFormatHalfPPR, models.ScoringFormatPPR:
+		default:
+			return fmt.Errorf("validation failed: unknown scoring format %q", ranking.ScoringFormat)
+		}
+		if ranking.Source == "" {
+			ranking.Source = "consensus"
+		}
+	}
+
+	if err := a.repo.UpsertPlayerRankings(ctx, rankings); err != nil {
+		return fmt.Errorf("failed to upsert player rankings: %w", err)
+	}
+	return nil
+}
+
+// SearchPlayers retrieves a page of the players matching a filter
+func (a *App) SearchPlayers(ctx context.Context, req PlayerSearchRequest) (*PlayerPage, error) {
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = PlayerSortByName
+	}
+	switch sortBy {
+	case PlayerSortByName, PlayerSortByPosition, PlayerSortByTeam, PlayerSortByCreatedAt:
+	default:
+		return nil, fmt.Errorf("validation failed: unknown sort %q", sortBy)
+	}
+
+	pageSize, err := playerPageSizes.Resolve(req.Page.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var after *PlayerCursor
+	var cursor PlayerCursor
+	ok, err := pagination.DecodeToken(req.Page.PageToken, &cursor)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if cursor.ID == uuid.Nil || cursor.SortBy != sortBy {
+			return nil, pagination.ErrInvalidPageToken
+		}
+		after = &cursor
+	}
+
+	// Fetch one extra player to tell whether another page follows
+	results, err := a.repo.SearchPlayers(ctx, PlayerSearchQuery{
+		Filter: req.Filter,
+		SortBy: sortBy,
+		After:  after,
+		Limit:  int32(pageSize + 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search players: %w", err)
+	}
+
+	results, more := pagination.Trim(results, pageSize)
+	page := &PlayerPage{
+		Players:  make([]models.Player, len(results)),
+		PageSize: pageSize,
+	}
+	for i := range results {
+		page.Players[i] = results[i].Player
+	}
+	if more {
+		last := results[len(results)-1]
+		page.NextPageToken, err = pagination.EncodeToken(PlayerCursor{
+			SortBy:   sortBy,
+			SortKey:  last.SortKey,
+			FullName: last.Player.FullName,
+			ID:       last.Player.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Page.IncludeTotal {
+		total, err := a.repo.CountPlayers(ctx, req.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count players: %w", err)
+		}
+		page.Total = &total
+	}
+	return page, nil
+}
+
 // validatePlayer validates a player model
 func (a *App) validatePlayer(player *models.Player) error {
 	if player.SportID == "" {
@@ -142,8 +476,6 @@ func (a *App) SyncPlayersFromAPI(ctx context.Context, teamID uuid.UUID, teamCode
 		return nil, fmt.Errorf("sport_id is required")
 	}
 
-	result := &SyncResult{}
-
 	// Get the plugin for the sport
 	plugin, exists := a.plugins[sportID]
 	if !exists {
@@ -156,14 +488,28 @@ func (a *App) SyncPlayersFromAPI(ctx context.Context, teamID uuid.UUID, teamCode
 		return nil, fmt.Errorf("failed to fetch players from plugin: %w", err)
 	}
 
-	result.TotalProcessed = len(players)
+	return a.IngestPlayers(ctx, teamID, sportID, players)
+}
+
+// IngestPlayers upserts a team's players in the provider's form, whether fetched by a sync or
+// pushed to a plugin webhook
+func (a *App) IngestPlayers(ctx context.Context, teamID uuid.UUID, sportID string, players []sportradarclient.SRPlayer) (*SyncResult, error) {
+	plugin, exists := a.plugins[sportID]
+	if !exists {
+		return nil, fmt.Errorf("no plugin found for sport: %s", sportID)
+	}
+
+	result := &SyncResult{TotalProcessed: len(players)}
 
 	for _, player := range players {
-		isNew, err := a.upsertPlayerFromPlugin(ctx, plugin, player, teamID)
+		isNew, change, err := a.upsertPlayerFromPlugin(ctx, plugin, player, teamID)
 		if err != nil {
 			result.AddError(fmt.Errorf("failed to upsert player %s: %w", player.Name, err))
 			continue
 		}
+		if change != nil {
+			result.StatusChanges = append(result.StatusChanges, *change)
+		}
 
 		if isNew {
 			result.Created++
@@ -182,12 +528,13 @@ func (a *App) SyncAllNFLPlayersFromAPI(ctx context.Context) (*SyncResult, error)
 }
 
 // upsertPlayerFromPlugin performs an upsert operation for a player from plugin data
-// Returns true if player was created (new), false if updated
-func (a *App) upsertPlayerFromPlugin(ctx context.Context, plugin base.SportPlugin, srPlayer sportradarclient.SRPlayer, teamId uuid.UUID) (bool, error) {
+// Returns true if player was created (new), false if updated, along with the change to an
+// existing player's status, if any
+func (a *App) upsertPlayerFromPlugin(ctx context.Context, plugin base.SportPlugin, srPlayer sportradarclient.SRPlayer, teamId uuid.UUID) (bool, *models.PlayerStatusChange, error) {
 	// Map player data using the plugin (includes attached profile)
 	player, err := plugin.MapExternalPlayer(srPlayer)
 	if err != nil {
-		return false, fmt.Errorf("failed to map external player: %w", err)
+		return false, nil, fmt.Errorf("failed to map external player: %w", err)
 	}
 
 	player.TeamID = &teamId
@@ -203,18 +550,44 @@ func (a *App) upsertPlayerFromPlugin(ctx context.Context, plugin base.SportPlugi
 			TeamID:     player.TeamID,
 			Profile:    player.NFLPlayerProfile,
 		}
-		_, err := a.repo.CreatePlayer(ctx, req)
+		createdPlayer, err := a.repo.CreatePlayer(ctx, req)
 		if err != nil {
-			return false, fmt.Errorf("failed to create player: %w", err)
+			return false, nil, fmt.Errorf("failed to create player: %w", err)
 		}
-		return true, nil // Created new player
+		if err := a.repo.UpsertExternalPlayerIDs(ctx, createdPlayer.ID, player.ExternalIDs); err != nil {
+			return false, nil, fmt.Errorf("failed to record external player IDs: %w", err)
+		}
+		return true, nil, nil // Created new player
 	}
 
 	// Player exists, update it
 	_, err = a.repo.UpdatePlayerAndProfile(ctx, existingPlayer.ID, player.FullName, player.TeamID, player.NFLPlayerProfile)
 	if err != nil {
-		return false, fmt.Errorf("failed to update player: %w", err)
+		return false, nil, fmt.Errorf("failed to update player: %w", err)
+	}
+	if err := a.repo.UpsertExternalPlayerIDs(ctx, existingPlayer.ID, player.ExternalIDs); err != nil {
+		return false, nil, fmt.Errorf("failed to record external player IDs: %w", err)
 	}
 
-	return false, nil // Updated existing player
+	return false, statusChange(existingPlayer, player), nil // Updated existing player
+}
+
+// statusChange returns the change a sync makes to an existing player's status, or nil when
+// the status is unchanged
+func statusChange(existing, synced *models.Player) *models.PlayerStatusChange {
+	if existing.NFLPlayerProfile == nil || synced.NFLPlayerProfile == nil {
+		return nil
+	}
+	if existing.NFLPlayerProfile.Status == synced.NFLPlayerProfile.Status {
+		return nil
+	}
+	return &models.PlayerStatusChange{
+		PlayerID:       existing.ID,
+		SportID:        existing.SportID,
+		FullName:       synced.FullName,
+		Position:       synced.NFLPlayerProfile.Position,
+		PreviousStatus: existing.NFLPlayerProfile.Status,
+		Status:         synced.NFLPlayerProfile.Status,
+		ChangedAt:      time.Now(),
+	}
 }
@@ -11,6 +11,13 @@ import (
 	"github.com/google/uuid"
 )
 
+type ExternalPlayerID struct {
+	Provider   string    `json:"provider"`
+	ExternalID string    `json:"external_id"`
+	PlayerID   uuid.UUID `json:"player_id"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
 type NflPlayerProfile struct {
 	PlayerID     uuid.UUID      `json:"player_id"`
 	Position     sql.NullString `json:"position"`
@@ -34,6 +41,37 @@ type Player struct {
 	CreatedAt  time.Time     `json:"created_at"`
 }
 
+type PlayerDuplicate struct {
+	ID            uuid.UUID    `json:"id"`
+	SportID       string       `json:"sport_id"`
+	PlayerID      uuid.UUID    `json:"player_id"`
+	DuplicateID   uuid.UUID    `json:"duplicate_id"`
+	SameBirthDate bool         `json:"same_birth_date"`
+	SameTeam      bool         `json:"same_team"`
+	Status        string       `json:"status"`
+	DetectedAt    time.Time    `json:"detected_at"`
+	DismissedAt   sql.NullTime `json:"dismissed_at"`
+}
+
+type PlayerMerge struct {
+	DuplicateID uuid.UUID `json:"duplicate_id"`
+	CanonicalID uuid.UUID `json:"canonical_id"`
+	SportID     string    `json:"sport_id"`
+	ExternalID  string    `json:"external_id"`
+	FullName    string    `json:"full_name"`
+	MergedAt    time.Time `json:"merged_at"`
+}
+
+type PlayerOwnership struct {
+	PlayerID        uuid.UUID `json:"player_id"`
+	RosteredLeagues int32     `json:"rostered_leagues"`
+	StartedLeagues  int32     `json:"started_leagues"`
+	TotalLeagues    int32     `json:"total_leagues"`
+	OwnedPct        float64   `json:"owned_pct"`
+	StartedPct      float64   `json:"started_pct"`
+	ComputedAt      time.Time `json:"computed_at"`
+}
+
 type Sport struct {
 	ID        string    `json:"id"`
 	Name      string    `json:"name"`
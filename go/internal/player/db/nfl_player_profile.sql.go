@@ -10,6 +10,7 @@ import (
 	"database/sql"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const createNFLPlayerProfile = `-- name: CreateNFLPlayerProfile :one
@@ -147,6 +148,46 @@ func (q *Queries) GetNFLPlayerProfileByExternalID(ctx context.Context, arg GetNF
 	return i, err
 }
 
+const listNFLPlayerProfilesByPlayers = `-- name: ListNFLPlayerProfilesByPlayers :many
+SELECT player_id, position, status, college, jersey_number, experience, birth_date, height_cm, weight_kg, height_desc, weight_desc FROM nfl_player_profiles
+WHERE player_id = ANY($1::uuid[])
+`
+
+func (q *Queries) ListNFLPlayerProfilesByPlayers(ctx context.Context, playerIds []uuid.UUID) ([]NflPlayerProfile, error) {
+	rows, err := q.db.QueryContext(ctx, listNFLPlayerProfilesByPlayers, pq.Array(playerIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NflPlayerProfile
+	for rows.Next() {
+		var i NflPlayerProfile
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Position,
+			&i.Status,
+			&i.College,
+			&i.JerseyNumber,
+			&i.Experience,
+			&i.BirthDate,
+			&i.HeightCm,
+			&i.WeightKg,
+			&i.HeightDesc,
+			&i.WeightDesc,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateNFLPlayerProfile = `-- name: UpdateNFLPlayerProfile :one
 UPDATE nfl_player_profiles SET
     position = $2,
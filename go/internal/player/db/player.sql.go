@@ -7,10 +7,35 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+const countSearchPlayers = `-- name: CountSearchPlayers :one
+SELECT COUNT(*)
+FROM players p
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+WHERE ($1::text IS NULL OR p.sport_id = $1::text)
+  AND ($2::uuid IS NULL OR p.team_id = $2::uuid)
+  AND ($3::text IS NULL OR npp.position = $3::text)
+`
+
+type CountSearchPlayersParams struct {
+	SportID  sql.NullString `json:"sport_id"`
+	TeamID   uuid.NullUUID  `json:"team_id"`
+	Position sql.NullString `json:"position"`
+}
+
+func (q *Queries) CountSearchPlayers(ctx context.Context, arg CountSearchPlayersParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchPlayers, arg.SportID, arg.TeamID, arg.Position)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createPlayer = `-- name: CreatePlayer :one
 INSERT INTO players (
     id,
@@ -103,6 +128,127 @@ func (q *Queries) GetPlayerByExternalID(ctx context.Context, arg GetPlayerByExte
 	return i, err
 }
 
+const getPlayersByIDs = `-- name: GetPlayersByIDs :many
+SELECT id, sport_id, external_id, full_name, team_id, created_at FROM players
+WHERE id = ANY($1::uuid[])
+ORDER BY full_name, id
+`
+
+func (q *Queries) GetPlayersByIDs(ctx context.Context, ids []uuid.UUID) ([]Player, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayersByIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Player
+	for rows.Next() {
+		var i Player
+		if err := rows.Scan(
+			&i.ID,
+			&i.SportID,
+			&i.ExternalID,
+			&i.FullName,
+			&i.TeamID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchPlayers = `-- name: SearchPlayers :many
+SELECT p.id, p.sport_id, p.external_id, p.full_name, p.team_id, p.created_at, k.sort_key::text AS sort_key
+FROM players p
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+LEFT JOIN teams t ON t.id = p.team_id
+CROSS JOIN LATERAL (
+    SELECT CASE $1::text
+               WHEN 'position' THEN COALESCE(npp.position, '')
+               WHEN 'team' THEN COALESCE(t.code, '')
+               WHEN 'created_at' THEN to_char(p.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US')
+               ELSE ''
+           END AS sort_key
+) k
+WHERE ($2::text IS NULL OR p.sport_id = $2::text)
+  AND ($3::uuid IS NULL OR p.team_id = $3::uuid)
+  AND ($4::text IS NULL OR npp.position = $4::text)
+  AND ($5::text IS NULL
+       OR (k.sort_key, p.full_name, p.id) > ($5::text, $6::text, $7::uuid))
+ORDER BY k.sort_key, p.full_name, p.id
+LIMIT $8
+`
+
+type SearchPlayersParams struct {
+	SortBy        string         `json:"sort_by"`
+	SportID       sql.NullString `json:"sport_id"`
+	TeamID        uuid.NullUUID  `json:"team_id"`
+	Position      sql.NullString `json:"position"`
+	AfterSortKey  sql.NullString `json:"after_sort_key"`
+	AfterFullName string         `json:"after_full_name"`
+	AfterID       uuid.UUID      `json:"after_id"`
+	PageLimit     int32          `json:"page_limit"`
+}
+
+type SearchPlayersRow struct {
+	ID         uuid.UUID     `json:"id"`
+	SportID    string        `json:"sport_id"`
+	ExternalID string        `json:"external_id"`
+	FullName   string        `json:"full_name"`
+	TeamID     uuid.NullUUID `json:"team_id"`
+	CreatedAt  time.Time     `json:"created_at"`
+	SortKey    string        `json:"sort_key"`
+}
+
+// Players matching the filters in sort key order, then by name. The sort key, name and ID of
+// the last player on a previous page continue after it.
+func (q *Queries) SearchPlayers(ctx context.Context, arg SearchPlayersParams) ([]SearchPlayersRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchPlayers,
+		arg.SortBy,
+		arg.SportID,
+		arg.TeamID,
+		arg.Position,
+		arg.AfterSortKey,
+		arg.AfterFullName,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchPlayersRow
+	for rows.Next() {
+		var i SearchPlayersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SportID,
+			&i.ExternalID,
+			&i.FullName,
+			&i.TeamID,
+			&i.CreatedAt,
+			&i.SortKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updatePlayer = `-- name: UpdatePlayer :one
 UPDATE players SET
     full_name = $2,
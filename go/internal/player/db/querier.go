@@ -6,21 +6,97 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	CountSearchPlayers(ctx context.Context, arg CountSearchPlayersParams) (int64, error)
 	CreateNFLPlayerProfile(ctx context.Context, arg CreateNFLPlayerProfileParams) (NflPlayerProfile, error)
 	CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Player, error)
+	CreatePlayerDuplicate(ctx context.Context, arg CreatePlayerDuplicateParams) (PlayerDuplicate, error)
+	CreatePlayerMerge(ctx context.Context, arg CreatePlayerMergeParams) error
+	// Nominations of the duplicate by teams that also queued the canonical player
+	DeleteDuplicateAuctionNominations(ctx context.Context, arg DeleteDuplicateAuctionNominationsParams) error
+	// Protections of the duplicate by teams that also protected the canonical player
+	DeleteDuplicateExpansionProtectedPlayers(ctx context.Context, arg DeleteDuplicateExpansionProtectedPlayersParams) error
+	// The duplicate's IDs at providers that already have an ID for the canonical player
+	DeleteDuplicateExternalPlayerIDs(ctx context.Context, arg DeleteDuplicateExternalPlayerIDsParams) error
+	// The duplicate's auction values for seasons the canonical player has one for
+	DeleteDuplicatePlayerAuctionValues(ctx context.Context, arg DeleteDuplicatePlayerAuctionValuesParams) error
+	// The duplicate's rankings on boards the canonical player is ranked on
+	DeleteDuplicatePlayerRankings(ctx context.Context, arg DeleteDuplicatePlayerRankingsParams) error
+	// The duplicate on watchlists that also have the canonical player
+	DeleteDuplicatePlayerWatchlists(ctx context.Context, arg DeleteDuplicatePlayerWatchlistsParams) error
+	// The duplicate's roster spots on teams that also roster the canonical player
+	DeleteDuplicateRosterPlayers(ctx context.Context, arg DeleteDuplicateRosterPlayersParams) error
+	// The duplicate on wishlists that also have the canonical player
+	DeleteDuplicateTradeWishlistPlayers(ctx context.Context, arg DeleteDuplicateTradeWishlistPlayersParams) error
+	// Watchlist alerts for the duplicate already sent for the canonical player
+	DeleteDuplicateWatchlistAlerts(ctx context.Context, arg DeleteDuplicateWatchlistAlertsParams) error
 	DeleteNFLPlayerProfile(ctx context.Context, playerID uuid.UUID) error
 	DeletePlayer(ctx context.Context, id uuid.UUID) error
+	DeletePlayerOwnership(ctx context.Context) error
+	// Drops a player's ID for a provider once the provider has assigned them a different one
+	DeleteStaleExternalPlayerID(ctx context.Context, arg DeleteStaleExternalPlayerIDParams) error
+	// Keeps a pair that isn't a duplicate out of the queue for good
+	DismissPlayerDuplicate(ctx context.Context, id uuid.UUID) (PlayerDuplicate, error)
+	// Pairs of players of a sport with the same name, ignoring case, spaces and punctuation, and
+	// either the same birth date or the same team. Players whose birth dates differ are never
+	// paired. The older player of a pair comes first, and pairs already queued, whether pending or
+	// dismissed, are left out.
+	FindPlayerDuplicates(ctx context.Context) ([]FindPlayerDuplicatesRow, error)
 	GetNFLPlayerProfile(ctx context.Context, playerID uuid.UUID) (NflPlayerProfile, error)
 	GetNFLPlayerProfileByExternalID(ctx context.Context, arg GetNFLPlayerProfileByExternalIDParams) (NflPlayerProfile, error)
 	GetPlayer(ctx context.Context, id uuid.UUID) (Player, error)
 	GetPlayerByExternalID(ctx context.Context, arg GetPlayerByExternalIDParams) (Player, error)
+	// The player a merged player's external ID now belongs to
+	GetPlayerByMergedExternalID(ctx context.Context, arg GetPlayerByMergedExternalIDParams) (Player, error)
+	GetPlayerDuplicate(ctx context.Context, id uuid.UUID) (PlayerDuplicate, error)
+	GetPlayerOwnership(ctx context.Context, playerID uuid.UUID) (PlayerOwnership, error)
+	GetPlayersByIDs(ctx context.Context, ids []uuid.UUID) ([]Player, error)
+	// Recomputes every rostered player's ownership from the rosters of leagues still in play.
+	// A league counts toward its sport's total once any of its teams has rostered a player.
+	InsertPlayerOwnership(ctx context.Context, computedAt time.Time) (int64, error)
+	ListExternalPlayerIDsByPlayers(ctx context.Context, playerIds []uuid.UUID) ([]ExternalPlayerID, error)
+	ListNFLPlayerProfilesByPlayers(ctx context.Context, playerIds []uuid.UUID) ([]NflPlayerProfile, error)
+	// The review queue, oldest first
+	ListPendingPlayerDuplicates(ctx context.Context, arg ListPendingPlayerDuplicatesParams) ([]ListPendingPlayerDuplicatesRow, error)
+	// The most-owned players of a sport, most started first among equally owned ones
+	ListPlayerOwnership(ctx context.Context, arg ListPlayerOwnershipParams) ([]ListPlayerOwnershipRow, error)
+	ListPlayerOwnershipByPlayers(ctx context.Context, playerIds []uuid.UUID) ([]PlayerOwnership, error)
+	ReassignAuctionBids(ctx context.Context, arg ReassignAuctionBidsParams) error
+	ReassignAuctionLots(ctx context.Context, arg ReassignAuctionLotsParams) error
+	ReassignAuctionNominations(ctx context.Context, arg ReassignAuctionNominationsParams) error
+	ReassignDepthCharts(ctx context.Context, arg ReassignDepthChartsParams) error
+	ReassignDraftPicks(ctx context.Context, arg ReassignDraftPicksParams) error
+	ReassignExpansionProtectedPlayers(ctx context.Context, arg ReassignExpansionProtectedPlayersParams) error
+	ReassignExpansionSelections(ctx context.Context, arg ReassignExpansionSelectionsParams) error
+	ReassignExternalPlayerIDs(ctx context.Context, arg ReassignExternalPlayerIDsParams) error
+	ReassignLeagueTransactions(ctx context.Context, arg ReassignLeagueTransactionsParams) error
+	// Moves the duplicate's profile to the canonical player when they have none of their own
+	ReassignNFLPlayerProfile(ctx context.Context, arg ReassignNFLPlayerProfileParams) error
+	ReassignPlayerAuctionValues(ctx context.Context, arg ReassignPlayerAuctionValuesParams) error
+	// Players merged into the duplicate now resolve to the canonical player
+	ReassignPlayerMerges(ctx context.Context, arg ReassignPlayerMergesParams) error
+	ReassignPlayerNews(ctx context.Context, arg ReassignPlayerNewsParams) error
+	ReassignPlayerRankings(ctx context.Context, arg ReassignPlayerRankingsParams) error
+	ReassignPlayerWatchlists(ctx context.Context, arg ReassignPlayerWatchlistsParams) error
+	ReassignRosterPlayers(ctx context.Context, arg ReassignRosterPlayersParams) error
+	ReassignTradeBlockListings(ctx context.Context, arg ReassignTradeBlockListingsParams) error
+	ReassignTradeWishlistPlayers(ctx context.Context, arg ReassignTradeWishlistPlayersParams) error
+	ReassignWatchlistAlerts(ctx context.Context, arg ReassignWatchlistAlertsParams) error
+	ResolveExternalPlayerIDs(ctx context.Context, arg ResolveExternalPlayerIDsParams) ([]ExternalPlayerID, error)
+	// Players matching the filters in sort key order, then by name. The sort key, name and ID of
+	// the last player on a previous page continue after it.
+	SearchPlayers(ctx context.Context, arg SearchPlayersParams) ([]SearchPlayersRow, error)
 	UpdateNFLPlayerProfile(ctx context.Context, arg UpdateNFLPlayerProfileParams) (NflPlayerProfile, error)
 	UpdatePlayer(ctx context.Context, arg UpdatePlayerParams) (Player, error)
+	// An ID that moves to another player (e.g. after a duplicate merge) is reassigned
+	UpsertExternalPlayerID(ctx context.Context, arg UpsertExternalPlayerIDParams) error
+	// Places a player on a season's board for a scoring format, replacing their previous rank
+	UpsertPlayerRanking(ctx context.Context, arg UpsertPlayerRankingParams) error
 }
 
 var _ Querier = (*Queries)(nil)
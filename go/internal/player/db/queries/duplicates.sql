@@ -0,0 +1,80 @@
+-- name: FindPlayerDuplicates :many
+-- Pairs of players of a sport with the same name, ignoring case, spaces and punctuation, and
+-- either the same birth date or the same team. Players whose birth dates differ are never
+-- paired. The older player of a pair comes first, and pairs already queued, whether pending or
+-- dismissed, are left out.
+WITH candidates AS (
+    SELECT p.id,
+           p.sport_id,
+           p.team_id,
+           p.created_at,
+           npp.birth_date,
+           lower(regexp_replace(p.full_name, '[^[:alnum:]]', '', 'g')) AS name_key
+    FROM players p
+    LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+)
+SELECT p.sport_id,
+       p.id                                                                       AS player_id,
+       d.id                                                                       AS duplicate_id,
+       (p.birth_date IS NOT NULL AND p.birth_date = d.birth_date)::boolean         AS same_birth_date,
+       (p.team_id IS NOT NULL AND p.team_id = d.team_id)::boolean                 AS same_team
+FROM candidates p
+JOIN candidates d
+  ON d.sport_id = p.sport_id
+ AND d.name_key = p.name_key
+ AND (d.created_at, d.id) > (p.created_at, p.id)
+WHERE (p.birth_date = d.birth_date OR (p.team_id = d.team_id AND (p.birth_date IS NULL OR d.birth_date IS NULL)))
+  AND NOT EXISTS (
+      SELECT 1 FROM player_duplicates pd
+      WHERE pd.player_id = p.id
+        AND pd.duplicate_id = d.id
+  )
+ORDER BY p.sport_id, p.created_at, p.id, d.created_at, d.id;
+
+-- name: CreatePlayerDuplicate :one
+INSERT INTO player_duplicates (
+    sport_id,
+    player_id,
+    duplicate_id,
+    same_birth_date,
+    same_team
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+) RETURNING *;
+
+-- name: GetPlayerDuplicate :one
+SELECT * FROM player_duplicates WHERE id = $1;
+
+-- name: ListPendingPlayerDuplicates :many
+-- The review queue, oldest first
+SELECT pd.id,
+       pd.sport_id,
+       pd.player_id,
+       pd.duplicate_id,
+       pd.same_birth_date,
+       pd.same_team,
+       pd.status,
+       pd.detected_at,
+       pd.dismissed_at,
+       p.full_name AS player_name,
+       d.full_name AS duplicate_name
+FROM player_duplicates pd
+JOIN players p ON p.id = pd.player_id
+JOIN players d ON d.id = pd.duplicate_id
+WHERE pd.status = 'PENDING'
+  AND (sqlc.narg('sport_id')::text IS NULL OR pd.sport_id = sqlc.narg('sport_id')::text)
+ORDER BY pd.detected_at, pd.id
+LIMIT @page_limit OFFSET @page_offset;
+
+-- name: DismissPlayerDuplicate :one
+-- Keeps a pair that isn't a duplicate out of the queue for good
+UPDATE player_duplicates
+SET status = 'DISMISSED',
+    dismissed_at = NOW()
+WHERE id = $1
+  AND status = 'PENDING'
+RETURNING *;
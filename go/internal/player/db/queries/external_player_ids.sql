@@ -0,0 +1,31 @@
+-- name: DeleteStaleExternalPlayerID :exec
+-- Drops a player's ID for a provider once the provider has assigned them a different one
+DELETE FROM external_player_ids
+WHERE player_id = $1
+  AND provider = $2
+  AND external_id <> $3;
+
+-- name: ListExternalPlayerIDsByPlayers :many
+SELECT * FROM external_player_ids
+WHERE player_id = ANY(@player_ids::uuid[])
+ORDER BY player_id, provider;
+
+-- name: ResolveExternalPlayerIDs :many
+SELECT * FROM external_player_ids
+WHERE provider = @provider
+  AND external_id = ANY(@external_ids::text[]);
+
+-- name: UpsertExternalPlayerID :exec
+-- An ID that moves to another player (e.g. after a duplicate merge) is reassigned
+INSERT INTO external_player_ids (
+    provider,
+    external_id,
+    player_id
+) VALUES (
+    $1,
+    $2,
+    $3
+)
+ON CONFLICT (provider, external_id) DO UPDATE SET
+    player_id = EXCLUDED.player_id,
+    updated_at = NOW();
\ No newline at end of file
@@ -0,0 +1,216 @@
+-- name: CreatePlayerMerge :exec
+INSERT INTO player_merges (
+    duplicate_id,
+    canonical_id,
+    sport_id,
+    external_id,
+    full_name
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+);
+
+-- name: DeleteDuplicateAuctionNominations :exec
+-- Nominations of the duplicate by teams that also queued the canonical player
+DELETE FROM auction_nomination_queue d
+WHERE d.player_id = @duplicate_id
+  AND EXISTS (
+      SELECT 1 FROM auction_nomination_queue c
+      WHERE c.player_id = @canonical_id
+        AND c.draft_id = d.draft_id
+        AND c.fantasy_team_id = d.fantasy_team_id
+  );
+
+-- name: DeleteDuplicateExpansionProtectedPlayers :exec
+-- Protections of the duplicate by teams that also protected the canonical player
+DELETE FROM expansion_protected_players d
+WHERE d.player_id = @duplicate_id
+  AND EXISTS (
+      SELECT 1 FROM expansion_protected_players c
+      WHERE c.player_id = @canonical_id
+        AND c.draft_id = d.draft_id
+        AND c.fantasy_team_id = d.fantasy_team_id
+  );
+
+-- name: DeleteDuplicateExternalPlayerIDs :exec
+-- The duplicate's IDs at providers that already have an ID for the canonical player
+DELETE FROM external_player_ids d
+WHERE d.player_id = @duplicate_id
+  AND EXISTS (
+      SELECT 1 FROM external_player_ids c
+      WHERE c.player_id = @canonical_id
+        AND c.provider = d.provider
+  );
+
+-- name: DeleteDuplicatePlayerAuctionValues :exec
+-- The duplicate's auction values for seasons the canonical player has one for
+DELETE FROM player_auction_values d
+WHERE d.player_id = @duplicate_id
+  AND EXISTS (
+      SELECT 1 FROM player_auction_values c
+      WHERE c.player_id = @canonical_id
+        AND c.season = d.season
+  );
+
+-- name: DeleteDuplicatePlayerRankings :exec
+-- The duplicate's rankings on boards the canonical player is ranked on
+DELETE FROM player_rankings d
+WHERE d.player_id = @duplicate_id
+  AND EXISTS (
+      SELECT 1 FROM player_rankings c
+      WHERE c.player_id = @canonical_id
+        AND c.season = d.season
+        AND c.scoring_format = d.scoring_format
+        AND c.superflex = d.superflex
+  );
+
+-- name: DeleteDuplicatePlayerWatchlists :exec
+-- The duplicate on watchlists that also have the canonical player
+DELETE FROM player_watchlists d
+WHERE d.player_id = @duplicate_id
+  AND EXISTS (
+      SELECT 1 FROM player_watchlists c
+      WHERE c.player_id = @canonical_id
+        AND c.user_id = d.user_id
+  );
+
+-- name: DeleteDuplicateRosterPlayers :exec
+-- The duplicate's roster spots on teams that also roster the canonical player
+DELETE FROM roster_players d
+WHERE d.player_id = @duplicate_id
+  AND EXISTS (
+      SELECT 1 FROM roster_players c
+      WHERE c.player_id = @canonical_id
+        AND c.fantasy_team_id = d.fantasy_team_id
+  );
+
+-- name: DeleteDuplicateTradeWishlistPlayers :exec
+-- The duplicate on wishlists that also have the canonical player
+DELETE FROM trade_wishlist_players d
+WHERE d.player_id = @duplicate_id
+  AND EXISTS (
+      SELECT 1 FROM trade_wishlist_players c
+      WHERE c.player_id = @canonical_id
+        AND c.fantasy_team_id = d.fantasy_team_id
+  );
+
+-- name: DeleteDuplicateWatchlistAlerts :exec
+-- Watchlist alerts for the duplicate already sent for the canonical player
+DELETE FROM watchlist_alerts d
+WHERE d.player_id = @duplicate_id
+  AND EXISTS (
+      SELECT 1 FROM watchlist_alerts c
+      WHERE c.player_id = @canonical_id
+        AND c.user_id = d.user_id
+        AND c.pick_id = d.pick_id
+        AND c.kind = d.kind
+  );
+
+-- name: GetPlayerByMergedExternalID :one
+-- The player a merged player's external ID now belongs to
+SELECT p.*
+FROM player_merges m
+JOIN players p ON p.id = m.canonical_id
+WHERE m.sport_id = $1
+  AND m.external_id = $2;
+
+-- name: ReassignAuctionBids :exec
+UPDATE auction_bids
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignAuctionLots :exec
+UPDATE auction_lots
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignAuctionNominations :exec
+UPDATE auction_nomination_queue
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignDepthCharts :exec
+UPDATE team_depth_charts
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignDraftPicks :exec
+UPDATE draft_picks
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignExpansionProtectedPlayers :exec
+UPDATE expansion_protected_players
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignExpansionSelections :exec
+UPDATE expansion_selections
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignExternalPlayerIDs :exec
+UPDATE external_player_ids
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignLeagueTransactions :exec
+UPDATE league_transactions
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignNFLPlayerProfile :exec
+-- Moves the duplicate's profile to the canonical player when they have none of their own
+UPDATE nfl_player_profiles
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id
+  AND NOT EXISTS (SELECT 1 FROM nfl_player_profiles c WHERE c.player_id = @canonical_id);
+
+-- name: ReassignPlayerAuctionValues :exec
+UPDATE player_auction_values
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignPlayerMerges :exec
+-- Players merged into the duplicate now resolve to the canonical player
+UPDATE player_merges
+SET canonical_id = @canonical_id
+WHERE canonical_id = @duplicate_id;
+
+-- name: ReassignPlayerNews :exec
+UPDATE player_news
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignPlayerRankings :exec
+UPDATE player_rankings
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignPlayerWatchlists :exec
+UPDATE player_watchlists
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignRosterPlayers :exec
+UPDATE roster_players
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignTradeBlockListings :exec
+UPDATE trade_block_listings
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignTradeWishlistPlayers :exec
+UPDATE trade_wishlist_players
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
+
+-- name: ReassignWatchlistAlerts :exec
+UPDATE watchlist_alerts
+SET player_id = @canonical_id
+WHERE player_id = @duplicate_id;
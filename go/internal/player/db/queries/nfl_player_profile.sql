@@ -33,6 +33,10 @@ SELECT npp.* FROM nfl_player_profiles npp
 JOIN players p ON npp.player_id = p.id
 WHERE p.sport_id = $1 AND p.external_id = $2;
 
+-- name: ListNFLPlayerProfilesByPlayers :many
+SELECT * FROM nfl_player_profiles
+WHERE player_id = ANY(@player_ids::uuid[]);
+
 -- name: UpdateNFLPlayerProfile :one
 UPDATE nfl_player_profiles SET
     position = $2,
@@ -0,0 +1,63 @@
+-- name: DeletePlayerOwnership :exec
+DELETE FROM player_ownership;
+
+-- name: InsertPlayerOwnership :execrows
+-- Recomputes every rostered player's ownership from the rosters of leagues still in play.
+-- A league counts toward its sport's total once any of its teams has rostered a player.
+WITH active_leagues AS (
+    SELECT DISTINCT l.id, l.sport_id
+    FROM leagues l
+    JOIN fantasy_teams ft ON ft.league_id = l.id
+    JOIN roster_players rp ON rp.fantasy_team_id = ft.id
+    WHERE l.status IN ('PENDING', 'ACTIVE')
+      AND l.deleted_at IS NULL
+),
+sport_totals AS (
+    SELECT sport_id, COUNT(*)::INTEGER AS total_leagues
+    FROM active_leagues
+    GROUP BY sport_id
+),
+player_usage AS (
+    SELECT rp.player_id,
+           al.sport_id,
+           COUNT(DISTINCT al.id)::INTEGER                                       AS rostered_leagues,
+           COUNT(DISTINCT al.id) FILTER (WHERE rp.position = 'STARTING')::INTEGER AS started_leagues
+    FROM roster_players rp
+    JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+    JOIN active_leagues al ON al.id = ft.league_id
+    GROUP BY rp.player_id, al.sport_id
+)
+INSERT INTO player_ownership (
+    player_id,
+    rostered_leagues,
+    started_leagues,
+    total_leagues,
+    owned_pct,
+    started_pct,
+    computed_at
+)
+SELECT pu.player_id,
+       pu.rostered_leagues,
+       pu.started_leagues,
+       st.total_leagues,
+       round(100.0 * pu.rostered_leagues / st.total_leagues, 1)::DOUBLE PRECISION,
+       round(100.0 * pu.started_leagues / st.total_leagues, 1)::DOUBLE PRECISION,
+       @computed_at::TIMESTAMPTZ
+FROM player_usage pu
+JOIN sport_totals st ON st.sport_id = pu.sport_id;
+
+-- name: GetPlayerOwnership :one
+SELECT * FROM player_ownership WHERE player_id = $1;
+
+-- name: ListPlayerOwnershipByPlayers :many
+SELECT * FROM player_ownership
+WHERE player_id = ANY(@player_ids::uuid[]);
+
+-- name: ListPlayerOwnership :many
+-- The most-owned players of a sport, most started first among equally owned ones
+SELECT po.player_id, po.rostered_leagues, po.started_leagues, po.total_leagues, po.owned_pct, po.started_pct, po.computed_at, p.full_name
+FROM player_ownership po
+JOIN players p ON p.id = po.player_id
+WHERE p.sport_id = @sport_id
+ORDER BY po.owned_pct DESC, po.started_pct DESC, p.full_name
+LIMIT @row_limit OFFSET @row_offset;
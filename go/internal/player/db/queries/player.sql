@@ -16,6 +16,11 @@ INSERT INTO players (
 -- name: GetPlayer :one
 SELECT * FROM players WHERE id = $1;
 
+-- name: GetPlayersByIDs :many
+SELECT * FROM players
+WHERE id = ANY(@ids::uuid[])
+ORDER BY full_name, id;
+
 -- name: GetPlayerByExternalID :one
 SELECT * FROM players WHERE sport_id = $1 AND external_id = $2;
 
@@ -27,4 +32,35 @@ WHERE id = $1
 RETURNING *;
 
 -- name: DeletePlayer :exec
-DELETE FROM players WHERE id = $1;
\ No newline at end of file
+DELETE FROM players WHERE id = $1;
+
+-- name: SearchPlayers :many
+-- Players matching the filters in sort key order, then by name. The sort key, name and ID of
+-- the last player on a previous page continue after it.
+SELECT p.id, p.sport_id, p.external_id, p.full_name, p.team_id, p.created_at, k.sort_key::text AS sort_key
+FROM players p
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+LEFT JOIN teams t ON t.id = p.team_id
+CROSS JOIN LATERAL (
+    SELECT CASE @sort_by::text
+               WHEN 'position' THEN COALESCE(npp.position, '')
+               WHEN 'team' THEN COALESCE(t.code, '')
+               WHEN 'created_at' THEN to_char(p.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US')
+               ELSE ''
+           END AS sort_key
+) k
+WHERE (sqlc.narg('sport_id')::text IS NULL OR p.sport_id = sqlc.narg('sport_id')::text)
+  AND (sqlc.narg('team_id')::uuid IS NULL OR p.team_id = sqlc.narg('team_id')::uuid)
+  AND (sqlc.narg('position')::text IS NULL OR npp.position = sqlc.narg('position')::text)
+  AND (sqlc.narg('after_sort_key')::text IS NULL
+       OR (k.sort_key, p.full_name, p.id) > (sqlc.narg('after_sort_key')::text, @after_full_name::text, @after_id::uuid))
+ORDER BY k.sort_key, p.full_name, p.id
+LIMIT @page_limit;
+
+-- name: CountSearchPlayers :one
+SELECT COUNT(*)
+FROM players p
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+WHERE (sqlc.narg('sport_id')::text IS NULL OR p.sport_id = sqlc.narg('sport_id')::text)
+  AND (sqlc.narg('team_id')::uuid IS NULL OR p.team_id = sqlc.narg('team_id')::uuid)
+  AND (sqlc.narg('position')::text IS NULL OR npp.position = sqlc.narg('position')::text);
@@ -0,0 +1,9 @@
+-- name: UpsertPlayerRanking :exec
+-- Places a player on a season's board for a scoring format, replacing their previous rank
+INSERT INTO player_rankings (season, scoring_format, superflex, player_id, overall_rank, projected_points, source, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+ON CONFLICT (season, scoring_format, superflex, player_id) DO UPDATE
+SET overall_rank     = EXCLUDED.overall_rank,
+    projected_points = EXCLUDED.projected_points,
+    source           = EXCLUDED.source,
+    updated_at       = NOW();
@@ -0,0 +1,112 @@
+package player
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// defaultDuplicatePageSize is used when a ListPlayerDuplicates request doesn't set a limit
+const defaultDuplicatePageSize = 50
+
+// DedupeResult is the outcome of a dedupe run
+type DedupeResult struct {
+	Found  int // pairs not seen by an earlier run
+	Merged int // certain pairs merged right away
+	Queued int // pairs put in the review queue
+}
+
+// DetectDuplicatePlayers looks for player records likely to be the same player, usually created
+// by syncs from two providers. Pairs matching on name, birth date and team are merged into the
+// older record; the rest, and certain pairs whose merge fails, go to the review queue. Each
+// pair is only considered once, so dismissed pairs stay dismissed.
+func (a *App) DetectDuplicatePlayers(ctx context.Context) (*DedupeResult, error) {
+	duplicates, err := a.repo.FindPlayerDuplicates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DedupeResult{Found: len(duplicates)}
+	merged := make(map[uuid.UUID]bool)
+	for _, duplicate := range duplicates {
+		// A player merged away earlier in the run takes their other pairs with them
+		if merged[duplicate.PlayerID] || merged[duplicate.DuplicateID] {
+			continue
+		}
+
+		if duplicate.Certain() {
+			_, err := a.repo.MergePlayers(ctx, duplicate.PlayerID, duplicate.DuplicateID)
+			if err == nil {
+				merged[duplicate.DuplicateID] = true
+				result.Merged++
+				continue
+			}
+			log.Warn().
+				Err(err).
+				Str("player_id", duplicate.PlayerID.String()).
+				Str("duplicate_id", duplicate.DuplicateID.String()).
+				Msg("failed to merge duplicate player, queueing it for review")
+		}
+
+		if _, err := a.repo.CreatePlayerDuplicate(ctx, duplicate); err != nil {
+			return result, err
+		}
+		result.Queued++
+	}
+
+	return result, nil
+}
+
+// ListPlayerDuplicates returns a page of the review queue, oldest first. An empty sport lists
+// every sport.
+func (a *App) ListPlayerDuplicates(ctx context.Context, sportID string, limit, offset int) ([]models.PlayerDuplicate, error) {
+	if limit == 0 {
+		limit = defaultDuplicatePageSize
+	}
+
+	var sport *string
+	if sportID != "" {
+		sport = &sportID
+	}
+	return a.repo.ListPendingPlayerDuplicates(ctx, sport, int32(limit), int32(offset))
+}
+
+// MergePlayers merges the duplicate player record into the canonical one: every roster spot,
+// draft pick, auction, ranking and other reference moves to the canonical player, and the
+// duplicate is deleted. Syncs that still send the duplicate's external ID update the canonical
+// player.
+func (a *App) MergePlayers(ctx context.Context, canonicalID, duplicateID uuid.UUID) (*models.PlayerMerge, error) {
+	if canonicalID == duplicateID {
+		return nil, fmt.Errorf("%w: a player can't be merged into themselves", ErrInvalidMerge)
+	}
+
+	merge, err := a.repo.MergePlayers(ctx, canonicalID, duplicateID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("canonical_id", canonicalID.String()).
+		Str("duplicate_id", duplicateID.String()).
+		Str("external_id", merge.ExternalID).
+		Msg("merged duplicate player")
+	return merge, nil
+}
+
+// DismissPlayerDuplicate takes a pair that isn't a duplicate out of the review queue. The
+// dedupe job won't queue it again.
+func (a *App) DismissPlayerDuplicate(ctx context.Context, id uuid.UUID) (*models.PlayerDuplicate, error) {
+	duplicate, err := a.repo.GetPlayerDuplicate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate.Status == models.PlayerDuplicateStatusDismissed {
+		return nil, ErrPlayerDuplicateDismissed
+	}
+
+	return a.repo.DismissPlayerDuplicate(ctx, id)
+}
@@ -3,4 +3,23 @@ package player
 import "errors"
 
 // ErrNoProfile is returned when a player has no sport-specific profile
-var ErrNoProfile = errors.New("no profile")
\ No newline at end of file
+var ErrNoProfile = errors.New("no profile")
+
+// ErrUnknownProvider is returned when a player ID provider isn't in the crosswalk
+var ErrUnknownProvider = errors.New("unknown player ID provider")
+
+// ErrTooManyPlayerIDs is returned when a single resolve request carries more IDs than allowed
+var ErrTooManyPlayerIDs = errors.New("too many player IDs")
+
+// ErrPlayerNotFound is returned when a player to merge doesn't exist
+var ErrPlayerNotFound = errors.New("player not found")
+
+// ErrInvalidMerge is returned when two players can't be merged, e.g. a player into themselves
+// or players of different sports
+var ErrInvalidMerge = errors.New("invalid player merge")
+
+// ErrPlayerDuplicateNotFound is returned when a duplicate pair isn't in the review queue
+var ErrPlayerDuplicateNotFound = errors.New("player duplicate not found")
+
+// ErrPlayerDuplicateDismissed is returned when dismissing a pair that was already dismissed
+var ErrPlayerDuplicateDismissed = errors.New("player duplicate already dismissed")
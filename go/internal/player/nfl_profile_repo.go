@@ -60,22 +60,21 @@ func (r *NFLProfileRepository) LoadProfile(ctx context.Context, q db.Querier, pl
 		return nil, fmt.Errorf("failed to get NFL player profile: %w", err)
 	}
 	
-	// Convert database model to domain model
-	profile := &models.NFLPlayerProfile{
-		PlayerID:     playerID,
-		Position:     sqlutil.FromSqlString(dbProfile.Position, ""),
-		Status:       sqlutil.FromSqlString(dbProfile.Status, ""),
-		College:      sqlutil.FromSqlString(dbProfile.College, ""),
-		JerseyNumber: sqlutil.FromSqlInt16(dbProfile.JerseyNumber),
-		Experience:   sqlutil.FromSqlInt16(dbProfile.Experience),
-		BirthDate:    sqlutil.FromSqlTime(dbProfile.BirthDate),
-		HeightCm:     int(dbProfile.HeightCm.Int32),
-		WeightKg:     int(dbProfile.WeightKg.Int32),
-		HeightDesc:   sqlutil.FromSqlString(dbProfile.HeightDesc, ""),
-		WeightDesc:   sqlutil.FromSqlString(dbProfile.WeightDesc, ""),
+	return dbNFLProfileToDomain(dbProfile), nil
+}
+
+// LoadProfiles loads the NFL player profiles of many players, by player ID
+func (r *NFLProfileRepository) LoadProfiles(ctx context.Context, q db.Querier, playerIDs []uuid.UUID) (map[uuid.UUID]models.Profile, error) {
+	dbProfiles, err := q.ListNFLPlayerProfilesByPlayers(ctx, playerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NFL player profiles: %w", err)
 	}
-	
-	return profile, nil
+
+	profiles := make(map[uuid.UUID]models.Profile, len(dbProfiles))
+	for _, dbProfile := range dbProfiles {
+		profiles[dbProfile.PlayerID] = dbNFLProfileToDomain(dbProfile)
+	}
+	return profiles, nil
 }
 
 // UpdateProfile updates an NFL player profile
@@ -124,4 +123,21 @@ func init() {
 	if err := RegisterProfileRepo("nfl", NewNFLProfileRepository()); err != nil {
 		panic(fmt.Sprintf("Failed to register NFL profile repository: %v", err))
 	}
-}
\ No newline at end of file
+}
+
+// dbNFLProfileToDomain converts a database NFL player profile to the domain model
+func dbNFLProfileToDomain(dbProfile db.NflPlayerProfile) *models.NFLPlayerProfile {
+	return &models.NFLPlayerProfile{
+		PlayerID:     dbProfile.PlayerID,
+		Position:     sqlutil.FromSqlString(dbProfile.Position, ""),
+		Status:       sqlutil.FromSqlString(dbProfile.Status, ""),
+		College:      sqlutil.FromSqlString(dbProfile.College, ""),
+		JerseyNumber: sqlutil.FromSqlInt16(dbProfile.JerseyNumber),
+		Experience:   sqlutil.FromSqlInt16(dbProfile.Experience),
+		BirthDate:    sqlutil.FromSqlTime(dbProfile.BirthDate),
+		HeightCm:     int(dbProfile.HeightCm.Int32),
+		WeightKg:     int(dbProfile.WeightKg.Int32),
+		HeightDesc:   sqlutil.FromSqlString(dbProfile.HeightDesc, ""),
+		WeightDesc:   sqlutil.FromSqlString(dbProfile.WeightDesc, ""),
+	}
+}
@@ -18,6 +18,10 @@ type ProfileRepository interface {
 	
 	// LoadProfile loads a sport-specific profile for a player
 	LoadProfile(ctx context.Context, q db.Querier, playerID uuid.UUID) (models.Profile, error)
+
+	// LoadProfiles loads the sport-specific profiles of many players in one query, by player ID.
+	// Players without a profile are left out.
+	LoadProfiles(ctx context.Context, q db.Querier, playerIDs []uuid.UUID) (map[uuid.UUID]models.Profile, error)
 	
 	// UpdateProfile updates a sport-specific profile for a player
 	UpdateProfile(ctx context.Context, qtx db.Querier, playerID uuid.UUID, profile models.Profile) error
@@ -90,7 +94,39 @@ func LoadProfileIntoPlayer(ctx context.Context, q db.Querier, player *models.Pla
 		return fmt.Errorf("failed to load profile for sport %s: %w", player.SportID, err)
 	}
 	
-	// Type switch to assign the profile to the appropriate field
+	setPlayerProfile(player, profile)
+	return nil
+}
+
+// LoadProfilesIntoPlayers loads the players' sport-specific profiles with one query per sport
+func LoadProfilesIntoPlayers(ctx context.Context, q db.Querier, players []models.Player) error {
+	idsBySport := make(map[string][]uuid.UUID)
+	for _, player := range players {
+		idsBySport[player.SportID] = append(idsBySport[player.SportID], player.ID)
+	}
+
+	for sportID, ids := range idsBySport {
+		repo, err := GetProfileRepo(sportID)
+		if err != nil {
+			// No profile repo for this sport is not an error - some sports may not have profiles
+			continue
+		}
+
+		profiles, err := repo.LoadProfiles(ctx, q, ids)
+		if err != nil {
+			return fmt.Errorf("failed to load profiles for sport %s: %w", sportID, err)
+		}
+		for i := range players {
+			if profile, ok := profiles[players[i].ID]; ok && players[i].SportID == sportID {
+				setPlayerProfile(&players[i], profile)
+			}
+		}
+	}
+	return nil
+}
+
+// setPlayerProfile assigns a profile to the player's field for its sport
+func setPlayerProfile(player *models.Player, profile models.Profile) {
 	switch p := profile.(type) {
 	case *models.NFLPlayerProfile:
 		player.NFLPlayerProfile = p
@@ -98,6 +134,4 @@ func LoadProfileIntoPlayer(ctx context.Context, q db.Querier, player *models.Pla
 	// case *models.NBAPlayerProfile:
 	//     player.NBAPlayerProfile = p
 	}
-	
-	return nil
 }
\ No newline at end of file
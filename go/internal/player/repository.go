@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mcdev12/dynasty/go/internal/models"
@@ -112,10 +113,53 @@ func (r *Repository) GetPlayer(ctx context.Context, id uuid.UUID) (*models.Playe
 		return nil, err
 	}
 
+	if err := r.loadOwnership(ctx, player); err != nil {
+		return nil, err
+	}
+
 	return player, nil
 }
 
-// GetPlayerByExternalID retrieves a player by sport ID and external ID with their profile
+// GetPlayersByIDs retrieves the players with the given IDs, by name, with their profiles and
+// ownership. IDs without a player are skipped. The players, profiles and ownership each take one
+// query however many IDs there are.
+func (r *Repository) GetPlayersByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Player, error) {
+	rows, err := r.queries.GetPlayersByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	players := make([]models.Player, len(rows))
+	playerIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		players[i] = *dbPlayerToDomain(row)
+		playerIDs[i] = row.ID
+	}
+	if err := LoadProfilesIntoPlayers(ctx, r.queries, players); err != nil {
+		return nil, err
+	}
+
+	ownership, err := r.queries.ListPlayerOwnershipByPlayers(ctx, playerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list player ownership: %w", err)
+	}
+	ownershipByPlayer := make(map[uuid.UUID]db.PlayerOwnership, len(ownership))
+	for _, o := range ownership {
+		ownershipByPlayer[o.PlayerID] = o
+	}
+	for i := range players {
+		if o, ok := ownershipByPlayer[players[i].ID]; ok {
+			players[i].Ownership = dbOwnershipToDomain(o)
+		}
+	}
+	return players, nil
+}
+
+// GetPlayerByExternalID retrieves a player by sport ID and external ID with their profile. The
+// external ID of a player merged into another retrieves the player it was merged into.
 func (r *Repository) GetPlayerByExternalID(ctx context.Context, sportID, externalID string) (*models.Player, error) {
 	params := db.GetPlayerByExternalIDParams{
 		SportID:    sportID,
@@ -123,6 +167,13 @@ func (r *Repository) GetPlayerByExternalID(ctx context.Context, sportID, externa
 	}
 
 	dbPlayer, err := r.queries.GetPlayerByExternalID(ctx, params)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The ID may belong to a player merged into another
+		dbPlayer, err = r.queries.GetPlayerByMergedExternalID(ctx, db.GetPlayerByMergedExternalIDParams{
+			SportID:    sportID,
+			ExternalID: externalID,
+		})
+	}
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("player not found")
@@ -137,6 +188,10 @@ func (r *Repository) GetPlayerByExternalID(ctx context.Context, sportID, externa
 		return nil, err
 	}
 
+	if err := r.loadOwnership(ctx, player); err != nil {
+		return nil, err
+	}
+
 	return player, nil
 }
 
@@ -288,6 +343,515 @@ func (r *Repository) UpdatePlayerAndProfile(ctx context.Context, playerID uuid.U
 	return player, nil
 }
 
+// UpsertExternalPlayerIDs records a player's IDs at other providers, replacing any
+// ID a provider previously had for them
+func (r *Repository) UpsertExternalPlayerIDs(ctx context.Context, playerID uuid.UUID, ids map[string]string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback() // Ignore error since Commit might have succeeded
+	}()
+
+	qtx := r.queries.WithTx(tx)
+
+	for provider, externalID := range ids {
+		if err := qtx.DeleteStaleExternalPlayerID(ctx, db.DeleteStaleExternalPlayerIDParams{
+			PlayerID:   playerID,
+			Provider:   provider,
+			ExternalID: externalID,
+		}); err != nil {
+			return fmt.Errorf("failed to clear stale %s ID: %w", provider, err)
+		}
+		if err := qtx.UpsertExternalPlayerID(ctx, db.UpsertExternalPlayerIDParams{
+			Provider:   provider,
+			ExternalID: externalID,
+			PlayerID:   playerID,
+		}); err != nil {
+			return fmt.Errorf("failed to upsert %s ID: %w", provider, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ResolveExternalPlayerIDs maps a provider's player IDs to internal player IDs.
+// IDs without a crosswalk entry are left out of the result.
+func (r *Repository) ResolveExternalPlayerIDs(ctx context.Context, provider string, externalIDs []string) (map[string]uuid.UUID, error) {
+	rows, err := r.queries.ResolveExternalPlayerIDs(ctx, db.ResolveExternalPlayerIDsParams{
+		Provider:    provider,
+		ExternalIds: externalIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve external player IDs: %w", err)
+	}
+
+	resolved := make(map[string]uuid.UUID, len(rows))
+	for _, row := range rows {
+		resolved[row.ExternalID] = row.PlayerID
+	}
+	return resolved, nil
+}
+
+// ListExternalPlayerIDs returns every known provider ID for each of the players, keyed by player and then provider
+func (r *Repository) ListExternalPlayerIDs(ctx context.Context, playerIDs []uuid.UUID) (map[uuid.UUID]map[string]string, error) {
+	rows, err := r.queries.ListExternalPlayerIDsByPlayers(ctx, playerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list external player IDs: %w", err)
+	}
+
+	ids := make(map[uuid.UUID]map[string]string)
+	for _, row := range rows {
+		if ids[row.PlayerID] == nil {
+			ids[row.PlayerID] = map[string]string{}
+		}
+		ids[row.PlayerID][row.Provider] = row.ExternalID
+	}
+	return ids, nil
+}
+
+// RefreshPlayerOwnership recomputes every player's ownership from the current rosters,
+// returning how many players are rostered anywhere
+func (r *Repository) RefreshPlayerOwnership(ctx context.Context, computedAt time.Time) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback() // Ignore error since Commit might have succeeded
+	}()
+
+	qtx := r.queries.WithTx(tx)
+
+	// Players dropped from every roster since the last refresh lose their row
+	if err := qtx.DeletePlayerOwnership(ctx); err != nil {
+		return 0, fmt.Errorf("failed to clear player ownership: %w", err)
+	}
+	rows, err := qtx.InsertPlayerOwnership(ctx, computedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute player ownership: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return rows, nil
+}
+
+// UpsertPlayerRankings places players on the preseason boards the rankings are for, all of
+// them or none
+func (r *Repository) UpsertPlayerRankings(ctx context.Context, rankings []models.PlayerRanking) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback() // Ignore error since Commit might have succeeded
+	}()
+
+	qtx := r.queries.WithTx(tx)
+
+	for _, ranking := range rankings {
+		params := db.UpsertPlayerRankingParams{
+			Season:        ranking.Season,
+			ScoringFormat: string(ranking.ScoringFormat),
+			Superflex:     ranking.Superflex,
+			PlayerID:      ranking.PlayerID,
+			OverallRank:   int32(ranking.OverallRank),
+			Source:        ranking.Source,
+		}
+		if ranking.ProjectedPoints != nil {
+			params.ProjectedPoints = sql.NullFloat64{Float64: *ranking.ProjectedPoints, Valid: true}
+		}
+		if err := qtx.UpsertPlayerRanking(ctx, params); err != nil {
+			return fmt.Errorf("failed to upsert ranking of player %s: %w", ranking.PlayerID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListPlayerOwnership returns a page of a sport's players by ownership, most owned first
+func (r *Repository) ListPlayerOwnership(ctx context.Context, sportID string, limit, offset int32) ([]models.PlayerOwnership, error) {
+	rows, err := r.queries.ListPlayerOwnership(ctx, db.ListPlayerOwnershipParams{
+		SportID:   sportID,
+		RowLimit:  limit,
+		RowOffset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list player ownership: %w", err)
+	}
+
+	ownership := make([]models.PlayerOwnership, len(rows))
+	for i, row := range rows {
+		ownership[i] = *dbOwnershipToDomain(db.PlayerOwnership{
+			PlayerID:        row.PlayerID,
+			RosteredLeagues: row.RosteredLeagues,
+			StartedLeagues:  row.StartedLeagues,
+			TotalLeagues:    row.TotalLeagues,
+			OwnedPct:        row.OwnedPct,
+			StartedPct:      row.StartedPct,
+			ComputedAt:      row.ComputedAt,
+		})
+		ownership[i].FullName = row.FullName
+	}
+	return ownership, nil
+}
+
+// SearchPlayers returns the players matching a search with their profiles and ownership, in
+// search order
+func (r *Repository) SearchPlayers(ctx context.Context, query PlayerSearchQuery) ([]PlayerSearchResult, error) {
+	params := db.SearchPlayersParams{
+		SortBy:    string(query.SortBy),
+		SportID:   sqlutil.ToSqlString(query.Filter.SportID),
+		TeamID:    sqlutil.ToNullUUID(query.Filter.TeamID),
+		Position:  sqlutil.ToSqlString(query.Filter.Position),
+		PageLimit: query.Limit,
+	}
+	if query.After != nil {
+		params.AfterSortKey = sql.NullString{String: query.After.SortKey, Valid: true}
+		params.AfterFullName = query.After.FullName
+		params.AfterID = query.After.ID
+	}
+
+	rows, err := r.queries.SearchPlayers(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search players: %w", err)
+	}
+
+	results := make([]PlayerSearchResult, len(rows))
+	for i, row := range rows {
+		player := dbPlayerToDomain(db.Player{
+			ID:         row.ID,
+			SportID:    row.SportID,
+			ExternalID: row.ExternalID,
+			FullName:   row.FullName,
+			TeamID:     row.TeamID,
+			CreatedAt:  row.CreatedAt,
+		})
+		if err := LoadProfileIntoPlayer(ctx, r.queries, player); err != nil {
+			return nil, err
+		}
+		if err := r.loadOwnership(ctx, player); err != nil {
+			return nil, err
+		}
+		results[i] = PlayerSearchResult{Player: *player, SortKey: row.SortKey}
+	}
+	return results, nil
+}
+
+// CountPlayers counts the players matching a search filter
+func (r *Repository) CountPlayers(ctx context.Context, filter PlayerFilter) (int, error) {
+	count, err := r.queries.CountSearchPlayers(ctx, db.CountSearchPlayersParams{
+		SportID:  sqlutil.ToSqlString(filter.SportID),
+		TeamID:   sqlutil.ToNullUUID(filter.TeamID),
+		Position: sqlutil.ToSqlString(filter.Position),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count players: %w", err)
+	}
+	return int(count), nil
+}
+
+// FindPlayerDuplicates returns the likely duplicate pairs of players not yet in the review queue
+func (r *Repository) FindPlayerDuplicates(ctx context.Context) ([]models.PlayerDuplicate, error) {
+	rows, err := r.queries.FindPlayerDuplicates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find player duplicates: %w", err)
+	}
+
+	duplicates := make([]models.PlayerDuplicate, len(rows))
+	for i, row := range rows {
+		duplicates[i] = models.PlayerDuplicate{
+			SportID:       row.SportID,
+			PlayerID:      row.PlayerID,
+			DuplicateID:   row.DuplicateID,
+			SameBirthDate: row.SameBirthDate,
+			SameTeam:      row.SameTeam,
+		}
+	}
+	return duplicates, nil
+}
+
+// CreatePlayerDuplicate puts a likely duplicate pair in the review queue
+func (r *Repository) CreatePlayerDuplicate(ctx context.Context, duplicate models.PlayerDuplicate) (*models.PlayerDuplicate, error) {
+	dbDuplicate, err := r.queries.CreatePlayerDuplicate(ctx, db.CreatePlayerDuplicateParams{
+		SportID:       duplicate.SportID,
+		PlayerID:      duplicate.PlayerID,
+		DuplicateID:   duplicate.DuplicateID,
+		SameBirthDate: duplicate.SameBirthDate,
+		SameTeam:      duplicate.SameTeam,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create player duplicate: %w", err)
+	}
+	return dbPlayerDuplicateToDomain(dbDuplicate), nil
+}
+
+// GetPlayerDuplicate retrieves a duplicate pair, returning ErrPlayerDuplicateNotFound when it
+// isn't queued
+func (r *Repository) GetPlayerDuplicate(ctx context.Context, id uuid.UUID) (*models.PlayerDuplicate, error) {
+	dbDuplicate, err := r.queries.GetPlayerDuplicate(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPlayerDuplicateNotFound
+		}
+		return nil, fmt.Errorf("failed to get player duplicate: %w", err)
+	}
+	return dbPlayerDuplicateToDomain(dbDuplicate), nil
+}
+
+// ListPendingPlayerDuplicates returns a page of the review queue, oldest first. A nil sport
+// lists every sport.
+func (r *Repository) ListPendingPlayerDuplicates(ctx context.Context, sportID *string, limit, offset int32) ([]models.PlayerDuplicate, error) {
+	rows, err := r.queries.ListPendingPlayerDuplicates(ctx, db.ListPendingPlayerDuplicatesParams{
+		SportID:    sqlutil.ToSqlString(sportID),
+		PageLimit:  limit,
+		PageOffset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list player duplicates: %w", err)
+	}
+
+	duplicates := make([]models.PlayerDuplicate, len(rows))
+	for i, row := range rows {
+		duplicates[i] = *dbPlayerDuplicateToDomain(db.PlayerDuplicate{
+			ID:            row.ID,
+			SportID:       row.SportID,
+			PlayerID:      row.PlayerID,
+			DuplicateID:   row.DuplicateID,
+			SameBirthDate: row.SameBirthDate,
+			SameTeam:      row.SameTeam,
+			Status:        row.Status,
+			DetectedAt:    row.DetectedAt,
+			DismissedAt:   row.DismissedAt,
+		})
+		duplicates[i].PlayerName = row.PlayerName
+		duplicates[i].DuplicateName = row.DuplicateName
+	}
+	return duplicates, nil
+}
+
+// DismissPlayerDuplicate takes a pending pair out of the review queue, returning
+// ErrPlayerDuplicateNotFound when it isn't pending
+func (r *Repository) DismissPlayerDuplicate(ctx context.Context, id uuid.UUID) (*models.PlayerDuplicate, error) {
+	dbDuplicate, err := r.queries.DismissPlayerDuplicate(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPlayerDuplicateNotFound
+		}
+		return nil, fmt.Errorf("failed to dismiss player duplicate: %w", err)
+	}
+	return dbPlayerDuplicateToDomain(dbDuplicate), nil
+}
+
+// mergeStep moves one kind of reference from a duplicate player to the canonical one. Tables
+// keyed by player drop the duplicate's rows the canonical player already has a row for first.
+type mergeStep struct {
+	references string
+	run        func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error
+}
+
+// mergeSteps are every reference to a player outside of its own record and profile
+var mergeSteps = []mergeStep{
+	{"roster spots", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		if err := q.DeleteDuplicateRosterPlayers(ctx, db.DeleteDuplicateRosterPlayersParams{DuplicateID: duplicateID, CanonicalID: canonicalID}); err != nil {
+			return err
+		}
+		return q.ReassignRosterPlayers(ctx, db.ReassignRosterPlayersParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"draft picks", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		return q.ReassignDraftPicks(ctx, db.ReassignDraftPicksParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"auction nominations", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		if err := q.DeleteDuplicateAuctionNominations(ctx, db.DeleteDuplicateAuctionNominationsParams{DuplicateID: duplicateID, CanonicalID: canonicalID}); err != nil {
+			return err
+		}
+		return q.ReassignAuctionNominations(ctx, db.ReassignAuctionNominationsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"auction lots", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		return q.ReassignAuctionLots(ctx, db.ReassignAuctionLotsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"auction bids", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		return q.ReassignAuctionBids(ctx, db.ReassignAuctionBidsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"auction values", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		if err := q.DeleteDuplicatePlayerAuctionValues(ctx, db.DeleteDuplicatePlayerAuctionValuesParams{DuplicateID: duplicateID, CanonicalID: canonicalID}); err != nil {
+			return err
+		}
+		return q.ReassignPlayerAuctionValues(ctx, db.ReassignPlayerAuctionValuesParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"expansion protections", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		if err := q.DeleteDuplicateExpansionProtectedPlayers(ctx, db.DeleteDuplicateExpansionProtectedPlayersParams{DuplicateID: duplicateID, CanonicalID: canonicalID}); err != nil {
+			return err
+		}
+		return q.ReassignExpansionProtectedPlayers(ctx, db.ReassignExpansionProtectedPlayersParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"expansion selections", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		return q.ReassignExpansionSelections(ctx, db.ReassignExpansionSelectionsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"league transactions", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		return q.ReassignLeagueTransactions(ctx, db.ReassignLeagueTransactionsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"trade block listings", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		return q.ReassignTradeBlockListings(ctx, db.ReassignTradeBlockListingsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"trade wishlists", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		if err := q.DeleteDuplicateTradeWishlistPlayers(ctx, db.DeleteDuplicateTradeWishlistPlayersParams{DuplicateID: duplicateID, CanonicalID: canonicalID}); err != nil {
+			return err
+		}
+		return q.ReassignTradeWishlistPlayers(ctx, db.ReassignTradeWishlistPlayersParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"watchlists", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		if err := q.DeleteDuplicatePlayerWatchlists(ctx, db.DeleteDuplicatePlayerWatchlistsParams{DuplicateID: duplicateID, CanonicalID: canonicalID}); err != nil {
+			return err
+		}
+		return q.ReassignPlayerWatchlists(ctx, db.ReassignPlayerWatchlistsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"watchlist alerts", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		if err := q.DeleteDuplicateWatchlistAlerts(ctx, db.DeleteDuplicateWatchlistAlertsParams{DuplicateID: duplicateID, CanonicalID: canonicalID}); err != nil {
+			return err
+		}
+		return q.ReassignWatchlistAlerts(ctx, db.ReassignWatchlistAlertsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"rankings", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		if err := q.DeleteDuplicatePlayerRankings(ctx, db.DeleteDuplicatePlayerRankingsParams{DuplicateID: duplicateID, CanonicalID: canonicalID}); err != nil {
+			return err
+		}
+		return q.ReassignPlayerRankings(ctx, db.ReassignPlayerRankingsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"depth charts", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		return q.ReassignDepthCharts(ctx, db.ReassignDepthChartsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"news", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		return q.ReassignPlayerNews(ctx, db.ReassignPlayerNewsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"external IDs", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		if err := q.DeleteDuplicateExternalPlayerIDs(ctx, db.DeleteDuplicateExternalPlayerIDsParams{DuplicateID: duplicateID, CanonicalID: canonicalID}); err != nil {
+			return err
+		}
+		return q.ReassignExternalPlayerIDs(ctx, db.ReassignExternalPlayerIDsParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"profile", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		return q.ReassignNFLPlayerProfile(ctx, db.ReassignNFLPlayerProfileParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+	{"earlier merges", func(ctx context.Context, q *db.Queries, canonicalID, duplicateID uuid.UUID) error {
+		return q.ReassignPlayerMerges(ctx, db.ReassignPlayerMergesParams{CanonicalID: canonicalID, DuplicateID: duplicateID})
+	}},
+}
+
+// MergePlayers moves every reference to the duplicate player to the canonical one and deletes
+// the duplicate, all in one transaction. Ownership is left to the next nightly refresh, and
+// review queue entries of the duplicate go with it.
+func (r *Repository) MergePlayers(ctx context.Context, canonicalID, duplicateID uuid.UUID) (*models.PlayerMerge, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback() // Ignore error since Commit might have succeeded
+	}()
+
+	qtx := r.queries.WithTx(tx)
+
+	canonical, err := qtx.GetPlayer(ctx, canonicalID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", ErrPlayerNotFound, canonicalID)
+		}
+		return nil, fmt.Errorf("failed to get player: %w", err)
+	}
+	duplicate, err := qtx.GetPlayer(ctx, duplicateID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", ErrPlayerNotFound, duplicateID)
+		}
+		return nil, fmt.Errorf("failed to get player: %w", err)
+	}
+	if canonical.SportID != duplicate.SportID {
+		return nil, fmt.Errorf("%w: players of %s and %s", ErrInvalidMerge, canonical.SportID, duplicate.SportID)
+	}
+
+	for _, step := range mergeSteps {
+		if err := step.run(ctx, qtx, canonicalID, duplicateID); err != nil {
+			return nil, fmt.Errorf("failed to merge %s: %w", step.references, err)
+		}
+	}
+
+	// A profile the canonical player already had wins over the duplicate's
+	if profileRepo, err := GetProfileRepo(duplicate.SportID); err == nil {
+		if err := profileRepo.DeleteProfile(ctx, qtx, duplicateID); err != nil {
+			return nil, fmt.Errorf("failed to delete duplicate player profile: %w", err)
+		}
+	}
+
+	if err := qtx.CreatePlayerMerge(ctx, db.CreatePlayerMergeParams{
+		DuplicateID: duplicateID,
+		CanonicalID: canonicalID,
+		SportID:     duplicate.SportID,
+		ExternalID:  duplicate.ExternalID,
+		FullName:    duplicate.FullName,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to record player merge: %w", err)
+	}
+	if err := qtx.DeletePlayer(ctx, duplicateID); err != nil {
+		return nil, fmt.Errorf("failed to delete duplicate player: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &models.PlayerMerge{
+		CanonicalID: canonicalID,
+		DuplicateID: duplicateID,
+		SportID:     duplicate.SportID,
+		ExternalID:  duplicate.ExternalID,
+		FullName:    duplicate.FullName,
+		MergedAt:    time.Now(),
+	}, nil
+}
+
+// loadOwnership attaches a player's ownership, leaving it nil for players on no roster
+func (r *Repository) loadOwnership(ctx context.Context, player *models.Player) error {
+	dbOwnership, err := r.queries.GetPlayerOwnership(ctx, player.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get player ownership: %w", err)
+	}
+
+	player.Ownership = dbOwnershipToDomain(dbOwnership)
+	return nil
+}
+
+func dbOwnershipToDomain(dbOwnership db.PlayerOwnership) *models.PlayerOwnership {
+	return &models.PlayerOwnership{
+		PlayerID:        dbOwnership.PlayerID,
+		RosteredLeagues: int(dbOwnership.RosteredLeagues),
+		StartedLeagues:  int(dbOwnership.StartedLeagues),
+		TotalLeagues:    int(dbOwnership.TotalLeagues),
+		OwnedPct:        dbOwnership.OwnedPct,
+		StartedPct:      dbOwnership.StartedPct,
+		ComputedAt:      dbOwnership.ComputedAt,
+	}
+}
+
 // Helper function to convert database player to domain model
 func dbPlayerToDomain(dbPlayer db.Player) *models.Player {
 	player := &models.Player{
@@ -301,3 +865,17 @@ func dbPlayerToDomain(dbPlayer db.Player) *models.Player {
 
 	return player
 }
+
+func dbPlayerDuplicateToDomain(dbDuplicate db.PlayerDuplicate) *models.PlayerDuplicate {
+	return &models.PlayerDuplicate{
+		ID:            dbDuplicate.ID,
+		SportID:       dbDuplicate.SportID,
+		PlayerID:      dbDuplicate.PlayerID,
+		DuplicateID:   dbDuplicate.DuplicateID,
+		SameBirthDate: dbDuplicate.SameBirthDate,
+		SameTeam:      dbDuplicate.SameTeam,
+		Status:        models.PlayerDuplicateStatus(dbDuplicate.Status),
+		DetectedAt:    dbDuplicate.DetectedAt,
+		DismissedAt:   sqlutil.FromSqlTime(dbDuplicate.DismissedAt),
+	}
+}
@@ -0,0 +1,92 @@
+package player
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+)
+
+// OwnershipRefreshJob is the job kind of the nightly player ownership refresh
+const OwnershipRefreshJob = "player.refresh_ownership"
+
+// OwnershipRefresher recomputes platform-wide player ownership
+type OwnershipRefresher interface {
+	RefreshPlayerOwnership(ctx context.Context) (int64, error)
+}
+
+// OwnershipRunnerConfig holds configuration for the nightly ownership refresh
+type OwnershipRunnerConfig struct {
+	HourUTC int // Hour of the day, in UTC, the refresh runs at
+}
+
+// DefaultOwnershipRunnerConfig returns default ownership runner configuration
+func DefaultOwnershipRunnerConfig() OwnershipRunnerConfig {
+	return OwnershipRunnerConfig{
+		HourUTC: 9, // after the night's waivers and taxi squad check have settled rosters
+	}
+}
+
+// ScheduleOwnershipRefresh schedules a recompute of every player's ownership and start
+// percentages once a night on the job worker. The refresh replaces the whole table in one
+// transaction, so a retried or overlapping run leaves the same result.
+func ScheduleOwnershipRefresh(worker *jobs.Worker, refresher OwnershipRefresher, config OwnershipRunnerConfig) {
+	log.Info().
+		Int("hour_utc", config.HourUTC).
+		Msg("scheduling player ownership refresh")
+
+	worker.Schedule(OwnershipRefreshJob, jobs.DailyAt(config.HourUTC, 0, time.UTC), func(ctx context.Context, _ jobs.Job) error {
+		players, err := refresher.RefreshPlayerOwnership(ctx)
+		if err != nil {
+			return err
+		}
+		log.Info().
+			Int64("players", players).
+			Msg("refreshed player ownership")
+		return nil
+	})
+}
+
+// DedupeJob is the job kind of the nightly duplicate player detection
+const DedupeJob = "player.detect_duplicates"
+
+// DuplicateDetector finds and merges duplicate player records
+type DuplicateDetector interface {
+	DetectDuplicatePlayers(ctx context.Context) (*DedupeResult, error)
+}
+
+// DedupeRunnerConfig holds configuration for the nightly duplicate player detection
+type DedupeRunnerConfig struct {
+	HourUTC int // Hour of the day, in UTC, the detection runs at
+}
+
+// DefaultDedupeRunnerConfig returns default dedupe runner configuration
+func DefaultDedupeRunnerConfig() DedupeRunnerConfig {
+	return DedupeRunnerConfig{
+		HourUTC: 8, // before the ownership refresh, so merged players are counted once
+	}
+}
+
+// ScheduleDuplicateDetection schedules a search for duplicate player records once a night on the
+// job worker. Pairs are only considered once, so a retried run picks up where the failed one
+// left off.
+func ScheduleDuplicateDetection(worker *jobs.Worker, detector DuplicateDetector, config DedupeRunnerConfig) {
+	log.Info().
+		Int("hour_utc", config.HourUTC).
+		Msg("scheduling duplicate player detection")
+
+	worker.Schedule(DedupeJob, jobs.DailyAt(config.HourUTC, 0, time.UTC), func(ctx context.Context, _ jobs.Job) error {
+		result, err := detector.DetectDuplicatePlayers(ctx)
+		if err != nil {
+			return err
+		}
+		log.Info().
+			Int("found", result.Found).
+			Int("merged", result.Merged).
+			Int("queued", result.Queued).
+			Msg("detected duplicate players")
+		return nil
+	})
+}
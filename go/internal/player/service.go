@@ -2,15 +2,20 @@ package player
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	sportradarclient "github.com/mcdev12/dynasty/go/clients/sport_radar_client"
 	playerv1 "github.com/mcdev12/dynasty/go/internal/genproto/player/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/player/v1/playerv1connect"
 	teamv1 "github.com/mcdev12/dynasty/go/internal/genproto/team/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/team/v1/teamv1connect"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -18,23 +23,38 @@ import (
 type PlayerApp interface {
 	CreatePlayer(ctx context.Context, player *models.Player) (*models.Player, error)
 	GetPlayer(ctx context.Context, id uuid.UUID) (*models.Player, error)
+	BatchGetPlayers(ctx context.Context, ids []uuid.UUID) ([]models.Player, []uuid.UUID, error)
 	GetPlayerByExternalID(ctx context.Context, sportID, externalID string) (*models.Player, error)
 	DeletePlayer(ctx context.Context, id uuid.UUID) error
 	SyncPlayersFromAPI(ctx context.Context, teamID uuid.UUID, teamCode string, sportID string) (*SyncResult, error)
+	IngestPlayers(ctx context.Context, teamID uuid.UUID, sportID string, players []sportradarclient.SRPlayer) (*SyncResult, error)
 	SyncAllNFLPlayersFromAPI(ctx context.Context) (*SyncResult, error)
+	ResolvePlayerIDs(ctx context.Context, req ResolvePlayerIDsRequest) (*ResolvePlayerIDsResult, error)
+	ListPlayerOwnership(ctx context.Context, sportID string, limit, offset int) ([]models.PlayerOwnership, error)
+	SearchPlayers(ctx context.Context, req PlayerSearchRequest) (*PlayerPage, error)
+	ListPlayerDuplicates(ctx context.Context, sportID string, limit, offset int) ([]models.PlayerDuplicate, error)
+}
+
+// InjuryAlerter alerts live drafts to players a sync put on an injury designation, returning
+// the events emitted
+type InjuryAlerter interface {
+	AlertPlayerInjury(ctx context.Context, change models.PlayerStatusChange) (int, error)
 }
 
 // Service implements the PlayerService gRPC interface
 type Service struct {
 	app         PlayerApp
 	teamService teamv1connect.TeamServiceClient
+	// Where status changes found by syncs are sent; nil alerts no one
+	injuries InjuryAlerter
 }
 
 // NewService creates a new player gRPC service
-func NewService(app PlayerApp, teamService teamv1connect.TeamServiceClient) *Service {
+func NewService(app PlayerApp, teamService teamv1connect.TeamServiceClient, injuries InjuryAlerter) *Service {
 	return &Service{
 		app:         app,
 		teamService: teamService,
+		injuries:    injuries,
 	}
 }
 
@@ -52,15 +72,21 @@ func (s *Service) CreatePlayer(ctx context.Context, req *connect.Request[playerv
 
 	// Parse optional team ID
 	if req.Msg.TeamId != "" {
-		if teamID, err := uuid.Parse(req.Msg.TeamId); err == nil {
-			player.TeamID = &teamID
+		teamID, err := uuidutil.MustParseOrInvalidArg("team_id", req.Msg.TeamId)
+		if err != nil {
+			return nil, err
 		}
+		player.TeamID = &teamID
 	}
 
 	// Handle profile if provided
 	if req.Msg.GetPlayerProfile() != nil {
 		if nflProfile := req.Msg.GetPlayerProfile().GetNflProfile(); nflProfile != nil {
-			player.NFLPlayerProfile = s.protoToNFLProfile(nflProfile)
+			profile, err := s.protoToNFLProfile(nflProfile)
+			if err != nil {
+				return nil, err
+			}
+			player.NFLPlayerProfile = profile
 		}
 	}
 
@@ -78,9 +104,9 @@ func (s *Service) CreatePlayer(ctx context.Context, req *connect.Request[playerv
 
 // GetPlayer retrieves a player by ID
 func (s *Service) GetPlayer(ctx context.Context, req *connect.Request[playerv1.GetPlayerRequest]) (*connect.Response[playerv1.GetPlayerResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	player, err := s.app.GetPlayer(ctx, id)
@@ -95,6 +121,31 @@ func (s *Service) GetPlayer(ctx context.Context, req *connect.Request[playerv1.G
 	}), nil
 }
 
+// BatchGetPlayers retrieves players by ID in one round trip, reporting the IDs without a player
+func (s *Service) BatchGetPlayers(ctx context.Context, req *connect.Request[playerv1.BatchGetPlayersRequest]) (*connect.Response[playerv1.BatchGetPlayersResponse], error) {
+	ids, err := uuidutil.ParseAll("ids", req.Msg.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	players, missing, err := s.app.BatchGetPlayers(ctx, ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &playerv1.BatchGetPlayersResponse{
+		Players:    make([]*playerv1.Player, len(players)),
+		MissingIds: make([]string, len(missing)),
+	}
+	for i := range players {
+		resp.Players[i] = s.playerToProto(&players[i])
+	}
+	for i, id := range missing {
+		resp.MissingIds[i] = id.String()
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // GetPlayerByExternalID retrieves a player by sport ID and external ID
 func (s *Service) GetPlayerByExternalID(ctx context.Context, req *connect.Request[playerv1.GetPlayerByExternalIDRequest]) (*connect.Response[playerv1.GetPlayerByExternalIDResponse], error) {
 	player, err := s.app.GetPlayerByExternalID(ctx, req.Msg.SportId, req.Msg.ExternalId)
@@ -117,9 +168,9 @@ func (s *Service) UpdatePlayer(ctx context.Context, req *connect.Request[playerv
 
 // DeletePlayer deletes a player by ID
 func (s *Service) DeletePlayer(ctx context.Context, req *connect.Request[playerv1.DeletePlayerRequest]) (*connect.Response[playerv1.DeletePlayerResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	err = s.app.DeletePlayer(ctx, id)
@@ -143,7 +194,10 @@ func (s *Service) SyncPlayersFromAPI(ctx context.Context, req *connect.Request[p
 	}
 
 	// Extract only the fields we need from the team proto
-	teamID, _ := uuid.Parse(teamResp.Msg.Team.Id)
+	teamID, err := uuid.Parse(teamResp.Msg.Team.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("team service returned invalid team id %q: %w", teamResp.Msg.Team.Id, err))
+	}
 	teamCode := teamResp.Msg.Team.Code
 
 	// Call app layer with only the data we need
@@ -151,6 +205,7 @@ func (s *Service) SyncPlayersFromAPI(ctx context.Context, req *connect.Request[p
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	s.alertInjuries(ctx, result)
 
 	// Convert result to proto
 	protoResult := s.syncResultToProto(result)
@@ -160,6 +215,29 @@ func (s *Service) SyncPlayersFromAPI(ctx context.Context, req *connect.Request[p
 	}), nil
 }
 
+// IngestPlayers upserts the players of the team with teamCode pushed to a sport plugin's
+// webhook, alerting live drafts to new injuries as a sync does
+func (s *Service) IngestPlayers(ctx context.Context, sportID, teamCode string, players []sportradarclient.SRPlayer) (*SyncResult, error) {
+	teamResp, err := s.teamService.GetTeamBySportIDAndCode(ctx, connect.NewRequest(&teamv1.GetTeamBySportIDAndCodeRequest{
+		SportId:  sportID,
+		TeamCode: teamCode,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team %s: %w", teamCode, err)
+	}
+	teamID, err := uuid.Parse(teamResp.Msg.Team.Id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid team ID for %s: %w", teamCode, err)
+	}
+
+	result, err := s.app.IngestPlayers(ctx, teamID, sportID, players)
+	if err != nil {
+		return nil, err
+	}
+	s.alertInjuries(ctx, result)
+	return result, nil
+}
+
 // SyncAllNFLPlayersFromAPI synchronizes all NFL players from external sports API
 func (s *Service) SyncAllNFLPlayersFromAPI(ctx context.Context, req *connect.Request[playerv1.SyncAllNFLPlayersFromAPIRequest]) (*connect.Response[playerv1.SyncAllNFLPlayersFromAPIResponse], error) {
 	result, err := s.app.SyncAllNFLPlayersFromAPI(ctx)
@@ -176,12 +254,120 @@ func (s *Service) SyncAllNFLPlayersFromAPI(ctx context.Context, req *connect.Req
 
 // GetPlayersWithFilter retrieves players with filtering and pagination
 func (s *Service) GetPlayersWithFilter(ctx context.Context, req *connect.Request[playerv1.GetPlayersWithFilterRequest]) (*connect.Response[playerv1.GetPlayersWithFilterResponse], error) {
-	// TODO: Implement when filtering is added to app layer
-	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+	search := PlayerSearchRequest{
+		SortBy: s.protoToPlayerSortBy(req.Msg.GetSortBy()),
+		Page: pagination.Request{
+			PageSize:     int(req.Msg.PageSize),
+			PageToken:    req.Msg.PageToken,
+			IncludeTotal: req.Msg.IncludeTotal,
+		},
+	}
+	if filter := req.Msg.Filter; filter != nil {
+		if filter.SportId != "" {
+			search.Filter.SportID = &filter.SportId
+		}
+		if filter.TeamId != "" {
+			teamID, err := uuidutil.MustParseOrInvalidArg("filter.team_id", filter.TeamId)
+			if err != nil {
+				return nil, err
+			}
+			search.Filter.TeamID = &teamID
+		}
+		if filter.Position != "" {
+			search.Filter.Position = &filter.Position
+		}
+	}
+
+	page, err := s.app.SearchPlayers(ctx, search)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) || errors.Is(err, pagination.ErrInvalidPageSize) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	players := make([]*playerv1.Player, len(page.Players))
+	for i := range page.Players {
+		players[i] = s.playerToProto(&page.Players[i])
+	}
+
+	list := &playerv1.PlayerListResponse{
+		Players: players,
+		Limit:   int32(page.PageSize),
+		HasMore: page.NextPageToken != "",
+	}
+	if page.Total != nil {
+		list.Total = int32(*page.Total)
+	}
+
+	return connect.NewResponse(&playerv1.GetPlayersWithFilterResponse{
+		Response:      list,
+		NextPageToken: page.NextPageToken,
+	}), nil
+}
+
+// ResolvePlayerIDs maps player IDs between external providers and internal player IDs
+func (s *Service) ResolvePlayerIDs(ctx context.Context, req *connect.Request[playerv1.ResolvePlayerIDsRequest]) (*connect.Response[playerv1.ResolvePlayerIDsResponse], error) {
+	result, err := s.app.ResolvePlayerIDs(ctx, ResolvePlayerIDsRequest{
+		FromProvider: req.Msg.FromProvider,
+		IDs:          req.Msg.Ids,
+		ToProviders:  req.Msg.ToProviders,
+	})
+	if err != nil {
+		if errors.Is(err, ErrUnknownProvider) || errors.Is(err, ErrTooManyPlayerIDs) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	mappings := make([]*playerv1.PlayerIDMapping, len(result.Mappings))
+	for i, mapping := range result.Mappings {
+		mappings[i] = &playerv1.PlayerIDMapping{
+			Id:          mapping.ID,
+			PlayerId:    mapping.PlayerID.String(),
+			ExternalIds: mapping.ExternalIDs,
+		}
+	}
+
+	return connect.NewResponse(&playerv1.ResolvePlayerIDsResponse{
+		Mappings:   mappings,
+		Unresolved: result.Unresolved,
+	}), nil
+}
+
+// ListPlayerOwnership lists a sport's most-owned players as of the last nightly refresh
+func (s *Service) ListPlayerOwnership(ctx context.Context, req *connect.Request[playerv1.ListPlayerOwnershipRequest]) (*connect.Response[playerv1.ListPlayerOwnershipResponse], error) {
+	pagination := req.Msg.GetPagination()
+	ownership, err := s.app.ListPlayerOwnership(ctx, req.Msg.SportId, int(pagination.GetLimit()), int(pagination.GetOffset()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	players := make([]*playerv1.PlayerOwnership, len(ownership))
+	for i := range ownership {
+		players[i] = s.ownershipToProto(&ownership[i])
+	}
+
+	return connect.NewResponse(&playerv1.ListPlayerOwnershipResponse{
+		Players: players,
+	}), nil
 }
 
 // Conversion methods between proto and app layer models
 
+func (s *Service) protoToPlayerSortBy(sortBy playerv1.PlayerSortBy) PlayerSortBy {
+	switch sortBy {
+	case playerv1.PlayerSortBy_PLAYER_SORT_BY_POSITION:
+		return PlayerSortByPosition
+	case playerv1.PlayerSortBy_PLAYER_SORT_BY_TEAM:
+		return PlayerSortByTeam
+	case playerv1.PlayerSortBy_PLAYER_SORT_BY_CREATED_AT:
+		return PlayerSortByCreatedAt
+	default:
+		return PlayerSortByName
+	}
+}
+
 func (s *Service) playerToProto(player *models.Player) *playerv1.Player {
 	proto := &playerv1.Player{
 		Id:         player.ID.String(),
@@ -206,9 +392,58 @@ func (s *Service) playerToProto(player *models.Player) *playerv1.Player {
 		}
 	}
 
+	if player.Ownership != nil {
+		proto.Ownership = s.ownershipToProto(player.Ownership)
+	}
+
 	return proto
 }
 
+// ListPlayerDuplicates lists the review queue of likely duplicate players, oldest first
+func (s *Service) ListPlayerDuplicates(ctx context.Context, req *connect.Request[playerv1.ListPlayerDuplicatesRequest]) (*connect.Response[playerv1.ListPlayerDuplicatesResponse], error) {
+	pagination := req.Msg.GetPagination()
+	duplicates, err := s.app.ListPlayerDuplicates(ctx, req.Msg.SportId, int(pagination.GetLimit()), int(pagination.GetOffset()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoDuplicates := make([]*playerv1.PlayerDuplicate, len(duplicates))
+	for i := range duplicates {
+		protoDuplicates[i] = s.duplicateToProto(&duplicates[i])
+	}
+
+	return connect.NewResponse(&playerv1.ListPlayerDuplicatesResponse{
+		Duplicates: protoDuplicates,
+	}), nil
+}
+
+func (s *Service) ownershipToProto(ownership *models.PlayerOwnership) *playerv1.PlayerOwnership {
+	return &playerv1.PlayerOwnership{
+		PlayerId:        ownership.PlayerID.String(),
+		RosteredLeagues: int32(ownership.RosteredLeagues),
+		StartedLeagues:  int32(ownership.StartedLeagues),
+		TotalLeagues:    int32(ownership.TotalLeagues),
+		OwnedPct:        ownership.OwnedPct,
+		StartedPct:      ownership.StartedPct,
+		ComputedAt:      timestamppb.New(ownership.ComputedAt),
+		FullName:        ownership.FullName,
+	}
+}
+
+func (s *Service) duplicateToProto(duplicate *models.PlayerDuplicate) *playerv1.PlayerDuplicate {
+	return &playerv1.PlayerDuplicate{
+		Id:            duplicate.ID.String(),
+		SportId:       duplicate.SportID,
+		PlayerId:      duplicate.PlayerID.String(),
+		PlayerName:    duplicate.PlayerName,
+		DuplicateId:   duplicate.DuplicateID.String(),
+		DuplicateName: duplicate.DuplicateName,
+		SameBirthDate: duplicate.SameBirthDate,
+		SameTeam:      duplicate.SameTeam,
+		DetectedAt:    timestamppb.New(duplicate.DetectedAt),
+	}
+}
+
 func (s *Service) nflProfileToProto(profile *models.NFLPlayerProfile) *playerv1.NFLPlayerProfile {
 	proto := &playerv1.NFLPlayerProfile{
 		PlayerId:     profile.PlayerID.String(),
@@ -230,8 +465,17 @@ func (s *Service) nflProfileToProto(profile *models.NFLPlayerProfile) *playerv1.
 	return proto
 }
 
-func (s *Service) protoToNFLProfile(proto *playerv1.NFLPlayerProfile) *models.NFLPlayerProfile {
-	playerID, _ := uuid.Parse(proto.PlayerId)
+// protoToNFLProfile converts a profile sent in a request. Its player_id may be left empty when
+// creating the player the profile belongs to.
+func (s *Service) protoToNFLProfile(proto *playerv1.NFLPlayerProfile) (*models.NFLPlayerProfile, error) {
+	var playerID uuid.UUID
+	if proto.PlayerId != "" {
+		var err error
+		playerID, err = uuidutil.MustParseOrInvalidArg("player_profile.nfl_profile.player_id", proto.PlayerId)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	profile := &models.NFLPlayerProfile{
 		PlayerID:     playerID,
@@ -252,7 +496,22 @@ func (s *Service) protoToNFLProfile(proto *playerv1.NFLPlayerProfile) *models.NF
 		}
 	}
 
-	return profile
+	return profile, nil
+}
+
+// alertInjuries alerts live drafts to the players a sync put on an injury designation,
+// recording failures among the sync's errors
+func (s *Service) alertInjuries(ctx context.Context, result *SyncResult) {
+	if s.injuries == nil {
+		return
+	}
+	for _, change := range result.StatusChanges {
+		alerts, err := s.injuries.AlertPlayerInjury(ctx, change)
+		result.DraftAlerts += alerts
+		if err != nil {
+			result.AddError(fmt.Errorf("failed to alert drafts to status of player %s: %w", change.PlayerID, err))
+		}
+	}
 }
 
 func (s *Service) syncResultToProto(result *SyncResult) *playerv1.SyncResult {
@@ -266,5 +525,6 @@ func (s *Service) syncResultToProto(result *SyncResult) *playerv1.SyncResult {
 		Created:        int32(result.Created),
 		Updated:        int32(result.Updated),
 		Errors:         errors,
+		DraftAlerts:    int32(result.DraftAlerts),
 	}
 }
@@ -0,0 +1,103 @@
+package publicleagues
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// PublicLeagueRepository defines what the public league app layer needs from the repository
+type PublicLeagueRepository interface {
+	GetLeague(ctx context.Context, id uuid.UUID) (*PublicLeague, error)
+	ListTeams(ctx context.Context, leagueID uuid.UUID) ([]PublicTeam, error)
+	ListDrafts(ctx context.Context, leagueID uuid.UUID) ([]PublicDraft, error)
+	ListRosterPlayers(ctx context.Context, leagueID uuid.UUID) (map[uuid.UUID][]PublicRosterPlayer, error)
+}
+
+// App serves the pages of leagues that opt in to being public
+type App struct {
+	repo PublicLeagueRepository
+}
+
+// NewApp creates a new public league App
+func NewApp(repo PublicLeagueRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// GetStandings retrieves a public league's teams in standings order. No matchup results are
+// kept yet, so every team is level and the standings run by team name.
+func (a *App) GetStandings(ctx context.Context, leagueID uuid.UUID) (*PublicLeague, []Standing, error) {
+	league, err := a.getPublicLeague(ctx, leagueID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teams, err := a.repo.ListTeams(ctx, leagueID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	standings := make([]Standing, len(teams))
+	for i, team := range teams {
+		standings[i] = Standing{Rank: i + 1, Team: team}
+	}
+	return league, standings, nil
+}
+
+// GetDraftResults retrieves the picks made in a public league's drafts, oldest draft first
+func (a *App) GetDraftResults(ctx context.Context, leagueID uuid.UUID) (*PublicLeague, []PublicDraft, error) {
+	league, err := a.getPublicLeague(ctx, leagueID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	drafts, err := a.repo.ListDrafts(ctx, leagueID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list drafts: %w", err)
+	}
+	return league, drafts, nil
+}
+
+// GetRosters retrieves the rosters of a public league's teams, by team name
+func (a *App) GetRosters(ctx context.Context, leagueID uuid.UUID) (*PublicLeague, []PublicRoster, error) {
+	league, err := a.getPublicLeague(ctx, leagueID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teams, err := a.repo.ListTeams(ctx, leagueID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+	players, err := a.repo.ListRosterPlayers(ctx, leagueID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list roster players: %w", err)
+	}
+
+	rosters := make([]PublicRoster, len(teams))
+	for i, team := range teams {
+		rosters[i] = PublicRoster{Team: team, Players: players[team.ID]}
+	}
+	return league, rosters, nil
+}
+
+// getPublicLeague retrieves a league, unless it hasn't opted in to public pages
+func (a *App) getPublicLeague(ctx context.Context, leagueID uuid.UUID) (*PublicLeague, error) {
+	league, err := a.repo.GetLeague(ctx, leagueID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrLeagueNotPublic
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !models.SettingsPublic(league.Settings) {
+		return nil, ErrLeagueNotPublic
+	}
+	return league, nil
+}
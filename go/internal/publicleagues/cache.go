@@ -0,0 +1,32 @@
+package publicleagues
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mcdev12/dynasty/go/internal/etag"
+)
+
+const (
+	// MaxAge is how long a CDN or browser may serve a public page without revalidating it
+	MaxAge = time.Minute
+	// StaleWhileRevalidate is how much longer a cache may serve a stale page while it revalidates
+	StaleWhileRevalidate = 5 * time.Minute
+)
+
+// setCacheHeaders marks a public page response cacheable by shared caches and tags it with a
+// weak ETag of its content. The tag is weak because the same content is served as JSON or
+// binary protobuf depending on the request.
+func setCacheHeaders(header http.Header, msg proto.Message) error {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response for its ETag: %w", err)
+	}
+	header.Set("ETag", etag.Of(data))
+	header.Set("Cache-Control", fmt.Sprintf("public, max-age=%d, stale-while-revalidate=%d",
+		int(MaxAge/time.Second), int(StaleWhileRevalidate/time.Second)))
+	return nil
+}
@@ -0,0 +1,70 @@
+-- name: GetPublicLeague :one
+-- What a public page shows about a league, with the settings that decide whether it is public.
+SELECT id,
+       name,
+       sport_id,
+       league_type::text AS league_type,
+       season,
+       league_settings,
+       updated_at
+FROM leagues
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListPublicLeagueTeams :many
+-- A league's teams with their owners' usernames, by name.
+SELECT ft.id,
+       ft.name,
+       ft.logo_url,
+       u.username AS owner_name
+FROM fantasy_teams ft
+         JOIN users u ON u.id = ft.owner_id
+WHERE ft.league_id = $1
+ORDER BY ft.name, ft.id;
+
+-- name: ListPublicLeagueDraftPicks :many
+-- The picks made in a league's drafts, sandboxes aside, with the names of their teams and
+-- players, oldest draft first and each draft's picks in board order.
+SELECT d.id                AS draft_id,
+       d.draft_type::text  AS draft_type,
+       d.status::text      AS draft_status,
+       d.completed_at,
+       dp.round,
+       dp.pick,
+       dp.overall_pick,
+       dp.team_id,
+       ft.name             AS team_name,
+       p.id                AS player_id,
+       p.full_name         AS player_name,
+       npp.position        AS player_position,
+       t.code              AS player_team_code,
+       dp.keeper_pick,
+       dp.auction_amount,
+       dp.picked_at
+FROM draft d
+         JOIN draft_picks dp ON dp.draft_id = d.id
+         JOIN players p ON p.id = dp.player_id
+         JOIN fantasy_teams ft ON ft.id = dp.team_id
+         LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+         LEFT JOIN teams t ON t.id = p.team_id
+WHERE d.league_id = $1
+  AND d.sandbox_of_draft_id IS NULL
+ORDER BY d.created_at, d.id, dp.overall_pick;
+
+-- name: ListPublicLeagueRosterPlayers :many
+-- The players on a league's rosters with their names, by team and then starters first.
+SELECT rp.fantasy_team_id,
+       p.id                      AS player_id,
+       p.full_name               AS player_name,
+       npp.position              AS player_position,
+       t.code                    AS player_team_code,
+       rp.position::text         AS roster_position,
+       rp.acquisition_type::text AS acquisition_type,
+       rp.acquired_at,
+       rp.lineup_slot
+FROM roster_players rp
+         JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+         JOIN players p ON p.id = rp.player_id
+         LEFT JOIN nfl_player_profiles npp ON npp.player_id = p.id
+         LEFT JOIN teams t ON t.id = p.team_id
+WHERE ft.league_id = $1
+ORDER BY rp.fantasy_team_id, rp.position, p.full_name;
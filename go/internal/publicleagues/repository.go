@@ -0,0 +1,165 @@
+package publicleagues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/publicleagues/db"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	GetPublicLeague(ctx context.Context, id uuid.UUID) (db.GetPublicLeagueRow, error)
+	ListPublicLeagueTeams(ctx context.Context, leagueID uuid.UUID) ([]db.ListPublicLeagueTeamsRow, error)
+	ListPublicLeagueDraftPicks(ctx context.Context, leagueID uuid.UUID) ([]db.ListPublicLeagueDraftPicksRow, error)
+	ListPublicLeagueRosterPlayers(ctx context.Context, leagueID uuid.UUID) ([]db.ListPublicLeagueRosterPlayersRow, error)
+}
+
+// Repository reads what public league pages show
+type Repository struct {
+	queries Querier
+
+	replica        *sqlutil.ReadReplica
+	replicaQueries Querier
+}
+
+// NewRepository creates a new public league repository
+func NewRepository(querier Querier) *Repository {
+	return &Repository{
+		queries: querier,
+	}
+}
+
+// UseReadReplica sends the reads of requests allowed stale reads to replica
+func (r *Repository) UseReadReplica(replica *sqlutil.ReadReplica) {
+	r.replica = replica
+	r.replicaQueries = db.New(replica.DB())
+}
+
+// read returns the replica's queries when the replica serves ctx, and the primary's otherwise
+func (r *Repository) read(ctx context.Context) Querier {
+	if r.replica.Serves(ctx) {
+		return r.replicaQueries
+	}
+	return r.queries
+}
+
+// GetLeague retrieves a league with the settings that decide whether it is public
+func (r *Repository) GetLeague(ctx context.Context, id uuid.UUID) (*PublicLeague, error) {
+	row, err := r.read(ctx).GetPublicLeague(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league: %w", err)
+	}
+
+	var settings interface{}
+	if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal league settings: %w", err)
+	}
+
+	return &PublicLeague{
+		ID:         row.ID,
+		Name:       row.Name,
+		SportID:    row.SportID,
+		LeagueType: models.LeagueType(row.LeagueType),
+		Season:     row.Season,
+		Settings:   settings,
+		UpdatedAt:  row.UpdatedAt,
+	}, nil
+}
+
+// ListTeams retrieves a league's teams by name
+func (r *Repository) ListTeams(ctx context.Context, leagueID uuid.UUID) ([]PublicTeam, error) {
+	rows, err := r.read(ctx).ListPublicLeagueTeams(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	teams := make([]PublicTeam, len(rows))
+	for i, row := range rows {
+		teams[i] = PublicTeam{
+			ID:        row.ID,
+			Name:      row.Name,
+			LogoURL:   sqlutil.FromSqlStringPtr(row.LogoUrl),
+			OwnerName: row.OwnerName,
+		}
+	}
+	return teams, nil
+}
+
+// ListDrafts retrieves a league's drafts that have picks made, oldest first, with their picks
+// in board order
+func (r *Repository) ListDrafts(ctx context.Context, leagueID uuid.UUID) ([]PublicDraft, error) {
+	rows, err := r.read(ctx).ListPublicLeagueDraftPicks(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft picks: %w", err)
+	}
+
+	// Rows come grouped by draft
+	var drafts []PublicDraft
+	for _, row := range rows {
+		if len(drafts) == 0 || drafts[len(drafts)-1].ID != row.DraftID {
+			drafts = append(drafts, PublicDraft{
+				ID:          row.DraftID,
+				DraftType:   models.DraftType(row.DraftType),
+				Status:      models.DraftStatus(row.DraftStatus),
+				CompletedAt: sqlutil.FromSqlTime(row.CompletedAt),
+			})
+		}
+
+		pick := PublicDraftPick{
+			Round:       int(row.Round),
+			Pick:        int(row.Pick),
+			OverallPick: int(row.OverallPick),
+			TeamID:      row.TeamID,
+			TeamName:    row.TeamName,
+			Player: PublicPlayer{
+				ID:       row.PlayerID,
+				FullName: row.PlayerName,
+				Position: sqlutil.FromSqlStringPtr(row.PlayerPosition),
+				TeamCode: sqlutil.FromSqlStringPtr(row.PlayerTeamCode),
+			},
+			KeeperPick: row.KeeperPick.Valid && row.KeeperPick.Bool,
+			PickedAt:   sqlutil.FromSqlTime(row.PickedAt),
+		}
+		if row.AuctionAmount.Valid {
+			amount, err := strconv.ParseFloat(row.AuctionAmount.String, 64)
+			if err == nil {
+				pick.AuctionAmount = &amount
+			}
+		}
+
+		draft := &drafts[len(drafts)-1]
+		draft.Picks = append(draft.Picks, pick)
+	}
+	return drafts, nil
+}
+
+// ListRosterPlayers retrieves the players on a league's rosters by team, starters first
+func (r *Repository) ListRosterPlayers(ctx context.Context, leagueID uuid.UUID) (map[uuid.UUID][]PublicRosterPlayer, error) {
+	rows, err := r.read(ctx).ListPublicLeagueRosterPlayers(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roster players: %w", err)
+	}
+
+	players := make(map[uuid.UUID][]PublicRosterPlayer)
+	for _, row := range rows {
+		players[row.FantasyTeamID] = append(players[row.FantasyTeamID], PublicRosterPlayer{
+			Player: PublicPlayer{
+				ID:       row.PlayerID,
+				FullName: row.PlayerName,
+				Position: sqlutil.FromSqlStringPtr(row.PlayerPosition),
+				TeamCode: sqlutil.FromSqlStringPtr(row.PlayerTeamCode),
+			},
+			RosterPosition:  models.RosterPosition(row.RosterPosition),
+			AcquisitionType: models.AcquisitionType(row.AcquisitionType),
+			AcquiredAt:      row.AcquiredAt,
+			LineupSlot:      sqlutil.FromSqlStringPtr(row.LineupSlot),
+		})
+	}
+	return players, nil
+}
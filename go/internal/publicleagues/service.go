@@ -0,0 +1,219 @@
+package publicleagues
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PublicLeagueApp defines what the service layer needs from the public league application
+type PublicLeagueApp interface {
+	GetStandings(ctx context.Context, leagueID uuid.UUID) (*PublicLeague, []Standing, error)
+	GetDraftResults(ctx context.Context, leagueID uuid.UUID) (*PublicLeague, []PublicDraft, error)
+	GetRosters(ctx context.Context, leagueID uuid.UUID) (*PublicLeague, []PublicRoster, error)
+}
+
+// Service implements the PublicLeagueService gRPC interface. Its methods need no sign in, and
+// their responses carry caching headers, which the API server's ETag middleware answers
+// revalidations with.
+type Service struct {
+	app PublicLeagueApp
+}
+
+// NewService creates a new public league gRPC service
+func NewService(app PublicLeagueApp) *Service {
+	return &Service{
+		app: app,
+	}
+}
+
+// Verify that Service implements the PublicLeagueServiceHandler interface
+var _ leaguev1connect.PublicLeagueServiceHandler = (*Service)(nil)
+
+// GetPublicStandings retrieves a public league's teams in standings order
+func (s *Service) GetPublicStandings(ctx context.Context, req *connect.Request[leaguev1.GetPublicStandingsRequest]) (*connect.Response[leaguev1.GetPublicStandingsResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	league, standings, err := s.app.GetStandings(ctx, leagueID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	msg := &leaguev1.GetPublicStandingsResponse{
+		League:    s.leagueToProto(league),
+		Standings: make([]*leaguev1.PublicStanding, len(standings)),
+	}
+	for i, standing := range standings {
+		msg.Standings[i] = &leaguev1.PublicStanding{
+			Rank: int32(standing.Rank),
+			Team: s.teamToProto(standing.Team),
+		}
+	}
+	return cacheableResponse(msg)
+}
+
+// GetPublicDraftResults retrieves the picks made in a public league's drafts
+func (s *Service) GetPublicDraftResults(ctx context.Context, req *connect.Request[leaguev1.GetPublicDraftResultsRequest]) (*connect.Response[leaguev1.GetPublicDraftResultsResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	league, drafts, err := s.app.GetDraftResults(ctx, leagueID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	msg := &leaguev1.GetPublicDraftResultsResponse{
+		League: s.leagueToProto(league),
+		Drafts: make([]*leaguev1.PublicDraft, len(drafts)),
+	}
+	for i := range drafts {
+		msg.Drafts[i] = s.draftToProto(&drafts[i])
+	}
+	return cacheableResponse(msg)
+}
+
+// GetPublicRosters retrieves the rosters of a public league's teams
+func (s *Service) GetPublicRosters(ctx context.Context, req *connect.Request[leaguev1.GetPublicRostersRequest]) (*connect.Response[leaguev1.GetPublicRostersResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	league, rosters, err := s.app.GetRosters(ctx, leagueID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	msg := &leaguev1.GetPublicRostersResponse{
+		League:  s.leagueToProto(league),
+		Rosters: make([]*leaguev1.PublicRoster, len(rosters)),
+	}
+	for i, roster := range rosters {
+		protoRoster := &leaguev1.PublicRoster{
+			Team:    s.teamToProto(roster.Team),
+			Players: make([]*leaguev1.PublicRosterPlayer, len(roster.Players)),
+		}
+		for j, player := range roster.Players {
+			protoRoster.Players[j] = &leaguev1.PublicRosterPlayer{
+				Player:          s.playerToProto(player.Player),
+				RosterPosition:  string(player.RosterPosition),
+				AcquisitionType: string(player.AcquisitionType),
+				AcquiredAt:      timestamppb.New(player.AcquiredAt),
+				LineupSlot:      player.LineupSlot,
+			}
+		}
+		msg.Rosters[i] = protoRoster
+	}
+	return cacheableResponse(msg)
+}
+
+// cacheableResponse wraps msg in a response with the headers that let CDNs cache it
+func cacheableResponse[T any, PT interface {
+	*T
+	proto.Message
+}](msg PT) (*connect.Response[T], error) {
+	resp := connect.NewResponse((*T)(msg))
+	if err := setCacheHeaders(resp.Header(), msg); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return resp, nil
+}
+
+// toConnectError maps app errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	if errors.Is(err, ErrLeagueNotPublic) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// Conversion methods
+
+// leagueToProto converts a public league to proto
+func (s *Service) leagueToProto(league *PublicLeague) *leaguev1.PublicLeague {
+	return &leaguev1.PublicLeague{
+		Id:         league.ID.String(),
+		Name:       league.Name,
+		SportId:    league.SportID,
+		LeagueType: s.leagueTypeToProto(league.LeagueType),
+		Season:     league.Season,
+		UpdatedAt:  timestamppb.New(league.UpdatedAt),
+	}
+}
+
+// leagueTypeToProto converts a domain league type to proto
+func (s *Service) leagueTypeToProto(leagueType models.LeagueType) leaguev1.LeagueType {
+	switch leagueType {
+	case models.LeagueTypeRedraft:
+		return leaguev1.LeagueType_LEAGUE_TYPE_REDRAFT
+	case models.LeagueTypeKeeper:
+		return leaguev1.LeagueType_LEAGUE_TYPE_KEEPER
+	case models.LeagueTypeDynasty:
+		return leaguev1.LeagueType_LEAGUE_TYPE_DYNASTY
+	default:
+		return leaguev1.LeagueType_LEAGUE_TYPE_UNSPECIFIED
+	}
+}
+
+// teamToProto converts a public team to proto
+func (s *Service) teamToProto(team PublicTeam) *leaguev1.PublicTeam {
+	return &leaguev1.PublicTeam{
+		Id:        team.ID.String(),
+		Name:      team.Name,
+		LogoUrl:   team.LogoURL,
+		OwnerName: team.OwnerName,
+	}
+}
+
+// playerToProto converts a public player to proto
+func (s *Service) playerToProto(player PublicPlayer) *leaguev1.PublicPlayer {
+	return &leaguev1.PublicPlayer{
+		Id:       player.ID.String(),
+		FullName: player.FullName,
+		Position: player.Position,
+		TeamCode: player.TeamCode,
+	}
+}
+
+// draftToProto converts a public draft to proto
+func (s *Service) draftToProto(draft *PublicDraft) *leaguev1.PublicDraft {
+	protoDraft := &leaguev1.PublicDraft{
+		Id:        draft.ID.String(),
+		DraftType: string(draft.DraftType),
+		Status:    string(draft.Status),
+		Picks:     make([]*leaguev1.PublicDraftPick, len(draft.Picks)),
+	}
+	if draft.CompletedAt != nil {
+		protoDraft.CompletedAt = timestamppb.New(*draft.CompletedAt)
+	}
+	for i, pick := range draft.Picks {
+		protoPick := &leaguev1.PublicDraftPick{
+			Round:         int32(pick.Round),
+			Pick:          int32(pick.Pick),
+			OverallPick:   int32(pick.OverallPick),
+			TeamId:        pick.TeamID.String(),
+			TeamName:      pick.TeamName,
+			Player:        s.playerToProto(pick.Player),
+			KeeperPick:    pick.KeeperPick,
+			AuctionAmount: pick.AuctionAmount,
+		}
+		if pick.PickedAt != nil {
+			protoPick.PickedAt = timestamppb.New(*pick.PickedAt)
+		}
+		protoDraft.Picks[i] = protoPick
+	}
+	return protoDraft
+}
@@ -0,0 +1,83 @@
+package publicleagues
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// ErrLeagueNotPublic is returned for a league that doesn't exist or hasn't opted in to public
+// pages. The two aren't told apart, so private leagues can't be discovered.
+var ErrLeagueNotPublic = errors.New("league not found")
+
+// PublicLeague is what a public page shows about a league itself
+type PublicLeague struct {
+	ID         uuid.UUID         `json:"id"`
+	Name       string            `json:"name"`
+	SportID    string            `json:"sport_id"`
+	LeagueType models.LeagueType `json:"league_type"`
+	Season     string            `json:"season"`
+	Settings   interface{}       `json:"-"` // decides whether the league is public; never shown
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// PublicTeam is a fantasy team as a public page shows it
+type PublicTeam struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	LogoURL   *string   `json:"logo_url,omitempty"`
+	OwnerName string    `json:"owner_name"` // the owner's username
+}
+
+// PublicPlayer is a player with the names a public page shows
+type PublicPlayer struct {
+	ID       uuid.UUID `json:"id"`
+	FullName string    `json:"full_name"`
+	Position *string   `json:"position,omitempty"`
+	TeamCode *string   `json:"team_code,omitempty"` // nil for free agents
+}
+
+// Standing is a team's place in a league's standings
+type Standing struct {
+	Rank int        `json:"rank"`
+	Team PublicTeam `json:"team"`
+}
+
+// PublicDraftPick is a pick made in a league's draft
+type PublicDraftPick struct {
+	Round         int          `json:"round"`
+	Pick          int          `json:"pick"`
+	OverallPick   int          `json:"overall_pick"`
+	TeamID        uuid.UUID    `json:"team_id"`
+	TeamName      string       `json:"team_name"`
+	Player        PublicPlayer `json:"player"`
+	KeeperPick    bool         `json:"keeper_pick"`
+	AuctionAmount *float64     `json:"auction_amount,omitempty"`
+	PickedAt      *time.Time   `json:"picked_at,omitempty"`
+}
+
+// PublicDraft is a league's draft with the picks made in it
+type PublicDraft struct {
+	ID          uuid.UUID          `json:"id"`
+	DraftType   models.DraftType   `json:"draft_type"`
+	Status      models.DraftStatus `json:"status"`
+	CompletedAt *time.Time         `json:"completed_at,omitempty"`
+	Picks       []PublicDraftPick  `json:"picks"`
+}
+
+// PublicRosterPlayer is a player on a team's roster
+type PublicRosterPlayer struct {
+	Player          PublicPlayer           `json:"player"`
+	RosterPosition  models.RosterPosition  `json:"roster_position"`
+	AcquisitionType models.AcquisitionType `json:"acquisition_type"`
+	AcquiredAt      time.Time              `json:"acquired_at"`
+	LineupSlot      *string                `json:"lineup_slot,omitempty"`
+}
+
+// PublicRoster is a team's roster
+type PublicRoster struct {
+	Team    PublicTeam           `json:"team"`
+	Players []PublicRosterPlayer `json:"players"`
+}
@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Rule allows up to Limit requests per key in each Window
+type Rule struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Limiter counts requests against a rule in fixed windows. The in-memory limiter counts
+// for one process; RedisLimiter shares the counts between replicas.
+type Limiter interface {
+	// Allow counts a request for key and reports whether it is within the rule. When it
+	// isn't, the duration is how long until the window resets.
+	Allow(ctx context.Context, key string, rule Rule) (bool, time.Duration, error)
+}
+
+// sweepInterval is how often the in-memory limiter drops windows that have ended
+const sweepInterval = time.Minute
+
+type window struct {
+	count   int
+	resetAt time.Time
+}
+
+// MemoryLimiter is a Limiter for single-node deployments
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	windows   map[string]*window
+	lastSweep time.Time
+}
+
+// NewMemoryLimiter creates an in-memory limiter
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		windows:   make(map[string]*window),
+		lastSweep: time.Now(),
+	}
+}
+
+// Allow implements Limiter
+func (l *MemoryLimiter) Allow(_ context.Context, key string, rule Rule) (bool, time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) > sweepInterval {
+		for k, w := range l.windows {
+			if !now.Before(w.resetAt) {
+				delete(l.windows, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	w, ok := l.windows[key]
+	if !ok || !now.Before(w.resetAt) {
+		w = &window{resetAt: now.Add(rule.Window)}
+		l.windows[key] = w
+	}
+	w.count++
+	if w.count > rule.Limit {
+		return false, w.resetAt.Sub(now), nil
+	}
+	return true, 0, nil
+}
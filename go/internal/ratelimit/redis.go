@@ -0,0 +1,46 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// countScript increments a window's counter, starting the window's expiry on its first
+// request, and returns the count and the milliseconds left in the window
+var countScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return {count, redis.call("PTTL", KEYS[1])}
+`)
+
+// RedisLimiter is a Limiter whose counts are shared by every replica using the same Redis
+type RedisLimiter struct {
+	client redis.Scripter
+	prefix string
+}
+
+// NewRedisLimiter creates a limiter keeping its counters under keyPrefix
+func NewRedisLimiter(client redis.Scripter, keyPrefix string) *RedisLimiter {
+	return &RedisLimiter{
+		client: client,
+		prefix: keyPrefix + "ratelimit:",
+	}
+}
+
+// Allow implements Limiter
+func (l *RedisLimiter) Allow(ctx context.Context, key string, rule Rule) (bool, time.Duration, error) {
+	res, err := countScript.Run(ctx, l.client, []string{l.prefix + key}, rule.Window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to count request: %w", err)
+	}
+	count, ttl := res[0], time.Duration(res[1])*time.Millisecond
+	if count > int64(rule.Limit) {
+		return false, ttl, nil
+	}
+	return true, 0, nil
+}
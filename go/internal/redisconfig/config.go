@@ -0,0 +1,56 @@
+package redisconfig
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Config holds the settings of the optional Redis that service replicas share rate limit
+// counters, gateway sessions and presence through.
+type Config struct {
+	// URL is a redis:// or rediss:// URL; empty when Redis isn't used
+	URL string
+	// KeyPrefix namespaces every key, so environments can share a Redis
+	KeyPrefix string
+}
+
+// NewConfigFromEnv reads REDIS_URL and REDIS_KEY_PREFIX (with defaults).
+func NewConfigFromEnv() Config {
+	return Config{
+		URL:       os.Getenv("REDIS_URL"),
+		KeyPrefix: getEnv("REDIS_KEY_PREFIX", "dynasty:"),
+	}
+}
+
+// Enabled reports whether a Redis is configured.
+func (c Config) Enabled() bool {
+	return c.URL != ""
+}
+
+// Connect opens a client for the configured Redis and checks it is reachable.
+func (c Config) Connect(ctx context.Context) (*redis.Client, error) {
+	opts, err := redis.ParseURL(c.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid REDIS_URL: %w", err)
+	}
+	client := redis.NewClient(opts)
+
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to ping redis: %w", err)
+	}
+	return client, nil
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -2,16 +2,21 @@ package roster
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/clock"
 	"github.com/mcdev12/dynasty/go/internal/models"
 )
 
 // RosterRepository defines what the app layer needs from the repository
 type RosterRepository interface {
 	CreateRosterPlayer(ctx context.Context, req CreateRosterPlayerRequest) (*models.Roster, error)
+	AddDraftedPlayerToRoster(ctx context.Context, fantasyTeamID, playerID uuid.UUID, acquiredAt time.Time, salary *int) (bool, error)
 	GetRoster(ctx context.Context, id uuid.UUID) (*models.Roster, error)
 	GetRosterPlayersByFantasyTeam(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.Roster, error)
 	GetRosterPlayersByFantasyTeamAndPosition(ctx context.Context, fantasyTeamID uuid.UUID, position models.RosterPosition) ([]models.Roster, error)
@@ -25,17 +30,82 @@ type RosterRepository interface {
 	DeleteRosterEntry(ctx context.Context, id uuid.UUID) error
 	DeletePlayerFromRoster(ctx context.Context, fantasyTeamID, playerID uuid.UUID) error
 	DeleteTeamRoster(ctx context.Context, fantasyTeamID uuid.UUID) error
+	IsBestBallTeam(ctx context.Context, fantasyTeamID uuid.UUID) (bool, error)
+	GetLineupSlots(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.LineupSlot, error)
+	GetRosterPlayerPositions(ctx context.Context, fantasyTeamID uuid.UUID) (map[uuid.UUID]string, error)
+	GetLineupLock(ctx context.Context, fantasyTeamID uuid.UUID) (models.LineupLock, error)
+	ListLineupPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]LineupPlayer, error)
+	ListLineupCheckTeams(ctx context.Context) ([]LineupCheckTeam, error)
+	QueueLineupAlert(ctx context.Context, team LineupCheckTeam, check *LineupCheck) (bool, error)
+	GetRosterPlayerCommissionerID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	GetSalaryCapRules(ctx context.Context, fantasyTeamID uuid.UUID) (models.SalaryCapRules, error)
+	GetDraftSalaryCapRules(ctx context.Context, draftID uuid.UUID) (models.SalaryCapRules, error)
+	IsSandboxDraft(ctx context.Context, draftID uuid.UUID) (bool, error)
+	GetRosterPlayerManagers(ctx context.Context, id uuid.UUID) (uuid.UUID, uuid.UUID, error)
+	UpdateRosterPlayerContract(ctx context.Context, id uuid.UUID, contract RosterContract) (*models.Roster, error)
+	GetTaxiSquadRules(ctx context.Context, fantasyTeamID uuid.UUID) (models.TaxiSquadRules, error)
+	GetPlayersExperience(ctx context.Context, playerIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	GetTaxiSquadExemptions(ctx context.Context, fantasyTeamID uuid.UUID) (map[uuid.UUID]models.TaxiSquadExemption, error)
+	GrantTaxiSquadExemption(ctx context.Context, id, grantedBy uuid.UUID, reason string) (*models.TaxiSquadExemption, error)
+	RevokeTaxiSquadExemption(ctx context.Context, id uuid.UUID) (bool, error)
+	ListTaxiSquadEntries(ctx context.Context) ([]TaxiSquadEntry, error)
+	RecordTaxiSquadViolations(ctx context.Context, violations []models.TaxiSquadViolation) (int64, error)
+	ListTaxiSquadViolations(ctx context.Context, leagueID uuid.UUID, includeResolved bool) ([]models.TaxiSquadViolation, error)
+	BatchUpdateLineup(ctx context.Context, fantasyTeamID uuid.UUID, assignments []LineupAssignment, updatedBy *uuid.UUID, check func(current, proposed []models.Roster) error) ([]models.Roster, error)
+	CanUserSetLineup(ctx context.Context, fantasyTeamID, userID uuid.UUID) (bool, error)
 }
 
+// ErrLineupManagedAutomatically is returned when a manual lineup change is attempted in a best-ball league
+var ErrLineupManagedAutomatically = errors.New("lineups are set automatically in best-ball leagues")
+
+// ErrRosterEntryNotOnTeam is returned when a batch lineup change names a roster entry of another team
+var ErrRosterEntryNotOnTeam = errors.New("roster entry is not on this team")
+
+// ErrInvalidLineup is returned when a batch lineup change would leave the team with an invalid lineup
+var ErrInvalidLineup = errors.New("invalid lineup")
+
+// ErrTaxiSquadRule is returned when moving a player onto the taxi squad would break the league's taxi squad rules
+var ErrTaxiSquadRule = errors.New("taxi squad rule violated")
+
+// ErrNotCommissioner is returned when someone other than the league's commissioner overrides its taxi squad rules
+var ErrNotCommissioner = errors.New("only the league commissioner can do this")
+
+// ErrTaxiSquadExemptionNotFound is returned when revoking a taxi squad exemption a roster entry doesn't have
+var ErrTaxiSquadExemptionNotFound = errors.New("roster entry has no taxi squad exemption")
+
+// ErrNoSalaryCap is returned when contract terms are given for a team whose league has no salary cap
+var ErrNoSalaryCap = errors.New("league has no salary cap")
+
+// ErrOverSalaryCap is returned when an acquisition or contract would put a team over its league's salary cap
+var ErrOverSalaryCap = errors.New("team would be over the salary cap")
+
+// ErrInvalidContract is returned when contract terms break the league's contract rules
+var ErrInvalidContract = errors.New("invalid contract")
+
+// ErrNoFranchiseTags is returned when franchise tagging a player on a team with no franchise tags left
+var ErrNoFranchiseTags = errors.New("team has no franchise tags left")
+
+// ErrNotTeamManager is returned when someone other than a team's owner or its league's commissioner manages its contracts
+var ErrNotTeamManager = errors.New("only the team's owner or the league commissioner can do this")
+
+// ErrNotLineupManager is returned when someone other than a team's owner, its delegate or its league's commissioner sets its lineup
+var ErrNotLineupManager = errors.New("only the team's owner, its delegate or the league commissioner can set its lineup")
+
+// maxExemptionReasonLength bounds the reason a commissioner gives for a taxi squad exemption
+const maxExemptionReasonLength = 500
+
 // App handles roster business logic
 type App struct {
-	repo RosterRepository
+	repo  RosterRepository
+	clock clock.Clock
 }
 
-// NewApp creates a new roster App
-func NewApp(repo RosterRepository) *App {
+// NewApp creates a new roster App. clock is what deadlines and scheduled times are checked
+// against.
+func NewApp(repo RosterRepository, clock clock.Clock) *App {
 	return &App{
-		repo: repo,
+		repo:  repo,
+		clock: clock,
 	}
 }
 
@@ -51,6 +121,20 @@ func (a *App) CreateRosterPlayer(ctx context.Context, req CreateRosterPlayerRequ
 		return nil, fmt.Errorf("player is already on this team's roster")
 	}
 
+	added := models.Roster{FantasyTeamID: req.FantasyTeamID, PlayerID: req.PlayerID, Position: req.Position}
+	// A new entry has no position yet, so adding it as a starter is checked as a move into the lineup
+	if err := a.validateLineupChange(ctx, &models.Roster{FantasyTeamID: req.FantasyTeamID, PlayerID: req.PlayerID}, req.Position); err != nil {
+		return nil, err
+	}
+	if req.Position == models.RosterPositionTaxi {
+		if err := a.validateTaxiMove(ctx, added); err != nil {
+			return nil, err
+		}
+	}
+	if err := a.signAcquisition(ctx, &req); err != nil {
+		return nil, err
+	}
+
 	roster, err := a.repo.CreateRosterPlayer(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create roster entry: %w", err)
@@ -60,6 +144,54 @@ func (a *App) CreateRosterPlayer(ctx context.Context, req CreateRosterPlayerRequ
 	return roster, nil
 }
 
+// ApplyDraftPick adds a drafted player to the picking team's bench, taking them off any other
+// team in the league that still rosters them. In salary cap leagues the player is paid the
+// winning bid of an auction pick, or the minimum salary of the settings the draft runs under;
+// the pick was checked against the cap when it was made. It is idempotent: replaying the same
+// pick reports false without changing the roster. Picks made in sandbox drafts are ignored.
+func (a *App) ApplyDraftPick(ctx context.Context, draftID, fantasyTeamID, playerID uuid.UUID, pickedAt time.Time, auctionAmount *float64) (bool, error) {
+	if fantasyTeamID == uuid.Nil {
+		return false, fmt.Errorf("validation failed: fantasy_team_id is required")
+	}
+	if playerID == uuid.Nil {
+		return false, fmt.Errorf("validation failed: player_id is required")
+	}
+	if pickedAt.IsZero() {
+		pickedAt = a.clock.Now()
+	}
+
+	sandbox, err := a.repo.IsSandboxDraft(ctx, draftID)
+	if err != nil {
+		return false, err
+	}
+	if sandbox {
+		return false, nil
+	}
+
+	rules, err := a.repo.GetDraftSalaryCapRules(ctx, draftID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get salary cap rules: %w", err)
+	}
+	var salary *int
+	if rules.Enforced() {
+		draftSalary := rules.MinSalary
+		if auctionAmount != nil {
+			draftSalary = int(math.Round(*auctionAmount))
+		}
+		salary = &draftSalary
+	}
+
+	added, err := a.repo.AddDraftedPlayerToRoster(ctx, fantasyTeamID, playerID, pickedAt, salary)
+	if err != nil {
+		return false, fmt.Errorf("failed to apply draft pick to roster: %w", err)
+	}
+
+	if added {
+		log.Printf("Added drafted player %s to team %s roster", playerID, fantasyTeamID)
+	}
+	return added, nil
+}
+
 // GetRoster retrieves a roster entry by ID
 func (a *App) GetRoster(ctx context.Context, id uuid.UUID) (*models.Roster, error) {
 	roster, err := a.repo.GetRoster(ctx, id)
@@ -138,11 +270,23 @@ func (a *App) UpdateRosterPlayerPosition(ctx context.Context, id uuid.UUID, req
 	}
 
 	// Verify roster entry exists
-	_, err := a.repo.GetRoster(ctx, id)
+	existing, err := a.repo.GetRoster(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("roster entry not found: %w", err)
 	}
 
+	if err := a.checkLineupManager(ctx, existing.FantasyTeamID, req.UpdatedBy); err != nil {
+		return nil, err
+	}
+	if err := a.validateLineupChange(ctx, existing, req.Position); err != nil {
+		return nil, err
+	}
+	if req.Position == models.RosterPositionTaxi && existing.Position != models.RosterPositionTaxi {
+		if err := a.validateTaxiMove(ctx, *existing); err != nil {
+			return nil, err
+		}
+	}
+
 	roster, err := a.repo.UpdateRosterPlayerPosition(ctx, id, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update roster player position: %w", err)
@@ -152,6 +296,142 @@ func (a *App) UpdateRosterPlayerPosition(ctx context.Context, id uuid.UUID, req
 	return roster, nil
 }
 
+// BatchUpdateLineup applies a set of position changes to one team's roster atomically. The
+// resulting lineup is validated as a whole, so a swap that would be invalid halfway through
+// (e.g. starting a bench player before benching a starter) goes through in one step. A nil
+// updatedBy is a trusted caller.
+func (a *App) BatchUpdateLineup(ctx context.Context, fantasyTeamID uuid.UUID, assignments []LineupAssignment, updatedBy *uuid.UUID) ([]models.Roster, error) {
+	if err := a.validateBatchUpdateLineupRequest(fantasyTeamID, assignments); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := a.checkLineupManager(ctx, fantasyTeamID, updatedBy); err != nil {
+		return nil, err
+	}
+
+	rosters, err := a.repo.BatchUpdateLineup(ctx, fantasyTeamID, assignments, updatedBy, func(current, proposed []models.Roster) error {
+		return a.validateLineup(ctx, fantasyTeamID, current, proposed)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update lineup: %w", err)
+	}
+
+	log.Printf("Updated lineup for team %s: %d assignments", fantasyTeamID, len(assignments))
+	return rosters, nil
+}
+
+// AssignLineupSlot starts a roster entry in one of its league's lineup slots, e.g. FLEX. The
+// player's position must be eligible for the slot and the slot must have room. A nil assignedBy
+// is a trusted caller.
+func (a *App) AssignLineupSlot(ctx context.Context, id uuid.UUID, slot string, assignedBy *uuid.UUID) (*models.Roster, error) {
+	if slot == "" {
+		return nil, fmt.Errorf("validation failed: lineup_slot is required")
+	}
+
+	existing, err := a.repo.GetRoster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("roster entry not found: %w", err)
+	}
+
+	rosters, err := a.BatchUpdateLineup(ctx, existing.FantasyTeamID, []LineupAssignment{{
+		RosterID:   id,
+		Position:   models.RosterPositionStarter,
+		LineupSlot: slot,
+	}}, assignedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rosters {
+		if rosters[i].ID == id {
+			return &rosters[i], nil
+		}
+	}
+	return nil, fmt.Errorf("roster entry %s missing after lineup change", id)
+}
+
+// GetLineupSlots returns the starting lineup slots of the league a fantasy team plays in
+func (a *App) GetLineupSlots(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.LineupSlot, error) {
+	slots, err := a.repo.GetLineupSlots(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lineup slots: %w", err)
+	}
+	return slots, nil
+}
+
+// CheckLineup looks for problems with a team's lineup ahead of the next lineup lock and
+// suggests a fix for each. Best-ball teams have their lineups set for them and aren't checked.
+func (a *App) CheckLineup(ctx context.Context, fantasyTeamID uuid.UUID) (*LineupCheck, error) {
+	if err := a.ensureLineupManagedManually(ctx, fantasyTeamID); err != nil {
+		return nil, err
+	}
+
+	slots, err := a.repo.GetLineupSlots(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lineup slots: %w", err)
+	}
+	lock, err := a.repo.GetLineupLock(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lineup lock: %w", err)
+	}
+	players, err := a.repo.ListLineupPlayers(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	check := checkLineup(fantasyTeamID, players, slots)
+	if check.LocksAt, err = lock.Next(a.clock.Now()); err != nil {
+		return nil, fmt.Errorf("failed to find next lineup lock: %w", err)
+	}
+	return &check, nil
+}
+
+// AlertLineupIssues checks the lineup of every team in a league whose lineups lock within lead
+// of now, and queues a notification to the owner of each team with problems. Owners are
+// alerted once per lock however often it runs. A team that can't be checked is logged and
+// skipped so it doesn't hold up the rest. It returns the number of teams checked and alerted.
+func (a *App) AlertLineupIssues(ctx context.Context, now time.Time, lead time.Duration) (int, int, error) {
+	teams, err := a.repo.ListLineupCheckTeams(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	checked, alerted := 0, 0
+	for _, team := range teams {
+		if team.BestBall {
+			continue
+		}
+		locksAt, err := team.Lock.Next(now)
+		if err != nil {
+			return checked, alerted, fmt.Errorf("failed to find next lineup lock: %w", err)
+		}
+		if locksAt.Sub(now) > lead {
+			continue
+		}
+
+		players, err := a.repo.ListLineupPlayers(ctx, team.FantasyTeamID)
+		if err != nil {
+			log.Printf("Failed to check lineup of fantasy team %s: %v", team.FantasyTeamID, err)
+			continue
+		}
+		checked++
+
+		check := checkLineup(team.FantasyTeamID, players, team.Slots)
+		if len(check.Issues) == 0 {
+			continue
+		}
+		check.LocksAt = locksAt
+		queued, err := a.repo.QueueLineupAlert(ctx, team, &check)
+		if err != nil {
+			log.Printf("Failed to alert owner of fantasy team %s to lineup issues: %v", team.FantasyTeamID, err)
+			continue
+		}
+		if queued {
+			alerted++
+		}
+	}
+	return checked, alerted, nil
+}
+
 // UpdateRosterPlayerKeeperData updates a player's keeper data
 func (a *App) UpdateRosterPlayerKeeperData(ctx context.Context, id uuid.UUID, req UpdateRosterKeeperDataRequest) (*models.Roster, error) {
 	// Verify roster entry exists
@@ -176,11 +456,20 @@ func (a *App) UpdateRosterPositionAndKeeperData(ctx context.Context, id uuid.UUI
 	}
 
 	// Verify roster entry exists
-	_, err := a.repo.GetRoster(ctx, id)
+	existing, err := a.repo.GetRoster(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("roster entry not found: %w", err)
 	}
 
+	if err := a.validateLineupChange(ctx, existing, req.Position); err != nil {
+		return nil, err
+	}
+	if req.Position == models.RosterPositionTaxi && existing.Position != models.RosterPositionTaxi {
+		if err := a.validateTaxiMove(ctx, *existing); err != nil {
+			return nil, err
+		}
+	}
+
 	roster, err := a.repo.UpdateRosterPositionAndKeeperData(ctx, id, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update roster position and keeper data: %w", err)
@@ -232,6 +521,138 @@ func (a *App) DeleteTeamRoster(ctx context.Context, fantasyTeamID uuid.UUID) err
 	return nil
 }
 
+// CheckTaxiSquads tests every taxi squad against its league's rules as of now and records what
+// it finds as the open taxi squad violations, resolving violations that have been fixed. Squads
+// over their size limit are flagged right away; players with too much experience are flagged
+// once the promotion deadline they should have been promoted by has passed. Exempt roster
+// entries are never flagged and don't count toward a squad's size. It returns the number of
+// open and newly resolved violations.
+func (a *App) CheckTaxiSquads(ctx context.Context, now time.Time) (int, int64, error) {
+	entries, err := a.repo.ListTaxiSquadEntries(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list taxi squads: %w", err)
+	}
+
+	var violations []models.TaxiSquadViolation
+	sizes := make(map[uuid.UUID]int)
+	squads := make(map[uuid.UUID]TaxiSquadEntry)
+	for _, entry := range entries {
+		if entry.Exempt {
+			continue
+		}
+		sizes[entry.FantasyTeamID]++
+		squads[entry.FantasyTeamID] = entry
+
+		if entry.Rules.PastDeadline(now) && !entry.Rules.ExperienceEligible(entry.Experience) {
+			rosterID := entry.RosterID
+			violations = append(violations, models.TaxiSquadViolation{
+				LeagueID:      entry.LeagueID,
+				FantasyTeamID: entry.FantasyTeamID,
+				RosterID:      &rosterID,
+				Reason:        models.TaxiSquadViolationIneligibleExperience,
+				Detail: fmt.Sprintf("player %s has %d years of experience, more than the %d the taxi squad allows, and wasn't promoted by the %s deadline",
+					entry.PlayerID, *entry.Experience, *entry.Rules.MaxExperience, entry.Rules.PromotionDeadline.Format("Jan 2")),
+			})
+		}
+	}
+	for fantasyTeamID, size := range sizes {
+		squad := squads[fantasyTeamID]
+		if squad.Rules.Slots != nil && size > *squad.Rules.Slots {
+			violations = append(violations, models.TaxiSquadViolation{
+				LeagueID:      squad.LeagueID,
+				FantasyTeamID: fantasyTeamID,
+				Reason:        models.TaxiSquadViolationOverLimit,
+				Detail:        fmt.Sprintf("%d players for %d taxi squad spots", size, *squad.Rules.Slots),
+			})
+		}
+	}
+
+	resolved, err := a.repo.RecordTaxiSquadViolations(ctx, violations)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to record taxi squad violations: %w", err)
+	}
+	return len(violations), resolved, nil
+}
+
+// ListTaxiSquadViolations returns a league's open taxi squad violations, and its resolved ones
+// when includeResolved is set
+func (a *App) ListTaxiSquadViolations(ctx context.Context, leagueID uuid.UUID, includeResolved bool) ([]models.TaxiSquadViolation, error) {
+	violations, err := a.repo.ListTaxiSquadViolations(ctx, leagueID, includeResolved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list taxi squad violations: %w", err)
+	}
+	return violations, nil
+}
+
+// GrantTaxiSquadExemption lets the league's commissioner exempt a roster entry from the taxi
+// squad rules, e.g. to keep a rookie who missed the season injured on the squad
+func (a *App) GrantTaxiSquadExemption(ctx context.Context, id, commissionerID uuid.UUID, reason string) (*models.TaxiSquadExemption, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("validation failed: reason is required")
+	}
+	if len(reason) > maxExemptionReasonLength {
+		return nil, fmt.Errorf("validation failed: reason must be at most %d characters", maxExemptionReasonLength)
+	}
+	if err := a.ensureCommissioner(ctx, id, commissionerID); err != nil {
+		return nil, err
+	}
+
+	exemption, err := a.repo.GrantTaxiSquadExemption(ctx, id, commissionerID, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant taxi squad exemption: %w", err)
+	}
+
+	log.Printf("Commissioner %s exempted roster entry %s from taxi squad rules", commissionerID, id)
+	return exemption, nil
+}
+
+// RevokeTaxiSquadExemption lets the league's commissioner put a roster entry back under the taxi squad rules
+func (a *App) RevokeTaxiSquadExemption(ctx context.Context, id, commissionerID uuid.UUID) error {
+	if err := a.ensureCommissioner(ctx, id, commissionerID); err != nil {
+		return err
+	}
+
+	revoked, err := a.repo.RevokeTaxiSquadExemption(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to revoke taxi squad exemption: %w", err)
+	}
+	if !revoked {
+		return ErrTaxiSquadExemptionNotFound
+	}
+
+	log.Printf("Commissioner %s revoked the taxi squad exemption of roster entry %s", commissionerID, id)
+	return nil
+}
+
+// checkLineupManager returns ErrNotLineupManager unless the user owns the team, stands in for its
+// owner as its active delegate or is its league's commissioner. A nil user is a trusted caller.
+func (a *App) checkLineupManager(ctx context.Context, fantasyTeamID uuid.UUID, userID *uuid.UUID) error {
+	if userID == nil {
+		return nil
+	}
+
+	canSet, err := a.repo.CanUserSetLineup(ctx, fantasyTeamID, *userID)
+	if err != nil {
+		return err
+	}
+	if !canSet {
+		return ErrNotLineupManager
+	}
+	return nil
+}
+
+// ensureCommissioner checks that userID commissions the league owning roster entry id
+func (a *App) ensureCommissioner(ctx context.Context, id, userID uuid.UUID) error {
+	commissionerID, err := a.repo.GetRosterPlayerCommissionerID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("roster entry not found: %w", err)
+	}
+	if commissionerID != userID {
+		return ErrNotCommissioner
+	}
+	return nil
+}
+
 // Validation methods
 
 func (a *App) validateCreateRosterPlayerRequest(req CreateRosterPlayerRequest) error {
@@ -258,6 +679,204 @@ func (a *App) validateUpdateRosterPositionAndKeeperDataRequest(req UpdateRosterP
 	return a.validateRosterPosition(req.Position)
 }
 
+func (a *App) validateBatchUpdateLineupRequest(fantasyTeamID uuid.UUID, assignments []LineupAssignment) error {
+	if fantasyTeamID == uuid.Nil {
+		return fmt.Errorf("fantasy_team_id is required")
+	}
+	if len(assignments) == 0 {
+		return fmt.Errorf("at least one assignment is required")
+	}
+	seen := make(map[uuid.UUID]bool, len(assignments))
+	for _, assignment := range assignments {
+		if seen[assignment.RosterID] {
+			return fmt.Errorf("roster entry %s is assigned more than once", assignment.RosterID)
+		}
+		seen[assignment.RosterID] = true
+		if err := a.validateRosterPosition(assignment.Position); err != nil {
+			return err
+		}
+		if assignment.LineupSlot != "" && assignment.Position != models.RosterPositionStarter {
+			return fmt.Errorf("roster entry %s is given a lineup slot but isn't starting", assignment.RosterID)
+		}
+	}
+	return nil
+}
+
+// validateLineup checks a team's lineup after a batch change. Players moving onto the taxi squad
+// must meet the league's taxi squad rules. Best-ball teams can't change their starters. In a league with configured lineup slots, the team can't start more players than it
+// has slots, each slot holds as many starters as the league has slots of that name, and every
+// starter the change moves needs a slot its player's position is eligible for. Starters the
+// change leaves alone aren't re-checked, so a player whose listed position changed stays put.
+func (a *App) validateLineup(ctx context.Context, fantasyTeamID uuid.UUID, current, proposed []models.Roster) error {
+	var toTaxi, taxiSquad []models.Roster
+	for i, roster := range proposed {
+		if roster.Position != models.RosterPositionTaxi {
+			continue
+		}
+		taxiSquad = append(taxiSquad, roster)
+		if current[i].Position != models.RosterPositionTaxi {
+			toTaxi = append(toTaxi, roster)
+		}
+	}
+	if err := a.validateTaxiMoves(ctx, fantasyTeamID, toTaxi, taxiSquad); err != nil {
+		return err
+	}
+
+	starters := 0
+	var moved []models.Roster
+	for i, roster := range proposed {
+		if roster.Position == models.RosterPositionStarter {
+			starters++
+		}
+		if (roster.Position == models.RosterPositionStarter) != (current[i].Position == models.RosterPositionStarter) ||
+			roster.LineupSlot != current[i].LineupSlot {
+			moved = append(moved, roster)
+		}
+	}
+	if len(moved) == 0 {
+		return nil
+	}
+
+	if err := a.ensureLineupManagedManually(ctx, fantasyTeamID); err != nil {
+		return err
+	}
+
+	slots, err := a.repo.GetLineupSlots(ctx, fantasyTeamID)
+	if err != nil {
+		return fmt.Errorf("failed to get lineup slots: %w", err)
+	}
+	if len(slots) == 0 {
+		for _, roster := range moved {
+			if roster.LineupSlot != "" {
+				return fmt.Errorf("%w: the league has no lineup slots to assign", ErrInvalidLineup)
+			}
+		}
+		return nil
+	}
+	if starters > len(slots) {
+		return fmt.Errorf("%w: %d starters for %d lineup slots", ErrInvalidLineup, starters, len(slots))
+	}
+
+	capacity := make(map[string]int, len(slots))
+	for _, slot := range slots {
+		capacity[slot.Name]++
+	}
+	filled := make(map[string]int, len(capacity))
+	for _, roster := range proposed {
+		if roster.Position != models.RosterPositionStarter || roster.LineupSlot == "" {
+			continue
+		}
+		filled[roster.LineupSlot]++
+		if filled[roster.LineupSlot] > capacity[roster.LineupSlot] {
+			return fmt.Errorf("%w: more starters in %s than the league has %s slots", ErrInvalidLineup, roster.LineupSlot, roster.LineupSlot)
+		}
+	}
+
+	positions, err := a.repo.GetRosterPlayerPositions(ctx, fantasyTeamID)
+	if err != nil {
+		return fmt.Errorf("failed to get player positions: %w", err)
+	}
+	for _, roster := range moved {
+		if roster.Position != models.RosterPositionStarter {
+			continue
+		}
+		if roster.LineupSlot == "" {
+			return fmt.Errorf("%w: player %s needs a lineup slot to start", ErrInvalidLineup, roster.PlayerID)
+		}
+		if !slotAccepts(slots, roster.LineupSlot, positions[roster.ID]) {
+			return fmt.Errorf("%w: player %s at %s isn't eligible for a %s slot", ErrInvalidLineup, roster.PlayerID, positions[roster.ID], roster.LineupSlot)
+		}
+	}
+	return nil
+}
+
+// validateTaxiMove checks a single player moving onto its team's taxi squad
+func (a *App) validateTaxiMove(ctx context.Context, roster models.Roster) error {
+	taxiSquad, err := a.repo.GetRosterPlayersByFantasyTeamAndPosition(ctx, roster.FantasyTeamID, models.RosterPositionTaxi)
+	if err != nil {
+		return fmt.Errorf("failed to get taxi squad: %w", err)
+	}
+	return a.validateTaxiMoves(ctx, roster.FantasyTeamID, []models.Roster{roster}, append(taxiSquad, roster))
+}
+
+// validateTaxiMoves checks players moving onto a team's taxi squad against the league's taxi
+// squad rules: no moves after the promotion deadline, no players with more experience than the
+// league allows, and no more players than the squad holds once taxiSquad is in place.
+// Commissioner-exempt roster entries skip the checks and don't count toward the squad's size.
+func (a *App) validateTaxiMoves(ctx context.Context, fantasyTeamID uuid.UUID, moving, taxiSquad []models.Roster) error {
+	if len(moving) == 0 {
+		return nil
+	}
+
+	rules, err := a.repo.GetTaxiSquadRules(ctx, fantasyTeamID)
+	if err != nil {
+		return fmt.Errorf("failed to get taxi squad rules: %w", err)
+	}
+	if !rules.Enforced() {
+		return nil
+	}
+
+	exemptions, err := a.repo.GetTaxiSquadExemptions(ctx, fantasyTeamID)
+	if err != nil {
+		return fmt.Errorf("failed to get taxi squad exemptions: %w", err)
+	}
+	var checked []models.Roster
+	for _, roster := range moving {
+		if _, exempt := exemptions[roster.ID]; !exempt {
+			checked = append(checked, roster)
+		}
+	}
+	if len(checked) == 0 {
+		return nil
+	}
+
+	if rules.PastDeadline(a.clock.Now()) {
+		return fmt.Errorf("%w: players can't move onto the taxi squad after the %s promotion deadline",
+			ErrTaxiSquadRule, rules.PromotionDeadline.Format("Jan 2"))
+	}
+
+	if rules.MaxExperience != nil {
+		playerIDs := make([]uuid.UUID, len(checked))
+		for i, roster := range checked {
+			playerIDs[i] = roster.PlayerID
+		}
+		experience, err := a.repo.GetPlayersExperience(ctx, playerIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get player experience: %w", err)
+		}
+		for _, roster := range checked {
+			years, known := experience[roster.PlayerID]
+			if known && !rules.ExperienceEligible(&years) {
+				return fmt.Errorf("%w: player %s has %d years of experience, more than the %d the taxi squad allows",
+					ErrTaxiSquadRule, roster.PlayerID, years, *rules.MaxExperience)
+			}
+		}
+	}
+
+	if rules.Slots != nil {
+		size := 0
+		for _, roster := range taxiSquad {
+			if _, exempt := exemptions[roster.ID]; !exempt {
+				size++
+			}
+		}
+		if size > *rules.Slots {
+			return fmt.Errorf("%w: %d players for %d taxi squad spots", ErrTaxiSquadRule, size, *rules.Slots)
+		}
+	}
+	return nil
+}
+
+// slotAccepts reports whether a league slot named name accepts a player listed at position
+func slotAccepts(slots []models.LineupSlot, name, position string) bool {
+	for _, slot := range slots {
+		if slot.Name == name && slot.Accepts(position) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *App) validateTransferPlayerRequest(req TransferPlayerRequest) error {
 	if req.FantasyTeamID == uuid.Nil {
 		return fmt.Errorf("fantasy_team_id is required")
@@ -268,6 +887,43 @@ func (a *App) validateTransferPlayerRequest(req TransferPlayerRequest) error {
 	return nil
 }
 
+// validateLineupChange rejects moves into or out of the starting lineup for best-ball teams, and
+// moves into it in leagues with lineup slots, where starting a player takes AssignLineupSlot
+func (a *App) validateLineupChange(ctx context.Context, existing *models.Roster, position models.RosterPosition) error {
+	if existing.Position == position {
+		return nil
+	}
+	if existing.Position != models.RosterPositionStarter && position != models.RosterPositionStarter {
+		return nil
+	}
+	if err := a.ensureLineupManagedManually(ctx, existing.FantasyTeamID); err != nil {
+		return err
+	}
+	if position != models.RosterPositionStarter {
+		return nil
+	}
+
+	slots, err := a.repo.GetLineupSlots(ctx, existing.FantasyTeamID)
+	if err != nil {
+		return fmt.Errorf("failed to get lineup slots: %w", err)
+	}
+	if len(slots) > 0 {
+		return fmt.Errorf("%w: player %s needs a lineup slot to start", ErrInvalidLineup, existing.PlayerID)
+	}
+	return nil
+}
+
+func (a *App) ensureLineupManagedManually(ctx context.Context, fantasyTeamID uuid.UUID) error {
+	bestBall, err := a.repo.IsBestBallTeam(ctx, fantasyTeamID)
+	if err != nil {
+		return fmt.Errorf("failed to check league scoring mode: %w", err)
+	}
+	if bestBall {
+		return ErrLineupManagedAutomatically
+	}
+	return nil
+}
+
 func (a *App) validateRosterPosition(position models.RosterPosition) error {
 	switch position {
 	case models.RosterPositionStarter, models.RosterPositionBench, models.RosterPositionIR, models.RosterPositionTaxi:
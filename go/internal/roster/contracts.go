@@ -0,0 +1,193 @@
+package roster
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// SetRosterContract lets the league's commissioner set the salary and length of a roster entry's
+// contract. Signing a franchise tagged player to a contract lifts the tag.
+func (a *App) SetRosterContract(ctx context.Context, id, commissionerID uuid.UUID, salary, years int) (*models.Roster, error) {
+	if err := a.ensureCommissioner(ctx, id, commissionerID); err != nil {
+		return nil, err
+	}
+
+	existing, err := a.repo.GetRoster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("roster entry not found: %w", err)
+	}
+	rules, err := a.salaryCapRules(ctx, existing.FantasyTeamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := rules.ValidateContract(&salary, &years); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidContract, err)
+	}
+
+	rosters, err := a.repo.GetRosterPlayersByFantasyTeam(ctx, existing.FantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster: %w", err)
+	}
+	if err := ensureCapRoom(rules, rosters, id, salary); err != nil {
+		return nil, err
+	}
+
+	roster, err := a.repo.UpdateRosterPlayerContract(ctx, id, RosterContract{Salary: &salary, ContractYears: &years})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set roster contract: %w", err)
+	}
+
+	log.Printf("Commissioner %s signed player %s on team %s to %d years at %d", commissionerID, roster.PlayerID, roster.FantasyTeamID, years, salary)
+	return roster, nil
+}
+
+// FranchiseTagPlayer keeps a roster entry for one more season at the league's franchise tag
+// salary, or its current salary if that's more. The team's owner or the league's commissioner
+// may tag players, up to the league's number of franchise tags per team. Tagging a player who is
+// already tagged changes nothing.
+func (a *App) FranchiseTagPlayer(ctx context.Context, id, userID uuid.UUID) (*models.Roster, error) {
+	ownerID, commissionerID, err := a.repo.GetRosterPlayerManagers(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("roster entry not found: %w", err)
+	}
+	if userID != ownerID && userID != commissionerID {
+		return nil, ErrNotTeamManager
+	}
+
+	existing, err := a.repo.GetRoster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("roster entry not found: %w", err)
+	}
+	if existing.FranchiseTagged {
+		return existing, nil
+	}
+	rules, err := a.salaryCapRules(ctx, existing.FantasyTeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	rosters, err := a.repo.GetRosterPlayersByFantasyTeam(ctx, existing.FantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster: %w", err)
+	}
+	if tagged := countFranchiseTagged(rosters); tagged >= rules.FranchiseTags {
+		return nil, fmt.Errorf("%w: %d of %d used", ErrNoFranchiseTags, tagged, rules.FranchiseTags)
+	}
+	salary, years := rules.TagSalary(existing.Salary), 1
+	if err := ensureCapRoom(rules, rosters, id, salary); err != nil {
+		return nil, err
+	}
+
+	roster, err := a.repo.UpdateRosterPlayerContract(ctx, id, RosterContract{Salary: &salary, ContractYears: &years, FranchiseTagged: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to franchise tag player: %w", err)
+	}
+
+	log.Printf("User %s franchise tagged player %s on team %s at %d", userID, roster.PlayerID, roster.FantasyTeamID, salary)
+	return roster, nil
+}
+
+// GetCapSheet returns a team's contracts, highest cap hit first, against its league's salary cap
+func (a *App) GetCapSheet(ctx context.Context, fantasyTeamID uuid.UUID) (*models.CapSheet, error) {
+	rules, err := a.salaryCapRules(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, err
+	}
+	rosters, err := a.repo.GetRosterPlayersByFantasyTeam(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster: %w", err)
+	}
+
+	sheet := &models.CapSheet{
+		FantasyTeamID:        fantasyTeamID,
+		SalaryCap:            *rules.Cap,
+		Payroll:              payroll(rules, rosters, uuid.Nil),
+		FranchiseTagsUsed:    countFranchiseTagged(rosters),
+		FranchiseTagsAllowed: rules.FranchiseTags,
+		Entries:              make([]models.CapSheetEntry, len(rosters)),
+	}
+	sheet.CapSpace = sheet.SalaryCap - sheet.Payroll
+	for i, roster := range rosters {
+		sheet.Entries[i] = models.CapSheetEntry{Roster: roster, CapHit: rules.CapHit(roster.Salary)}
+	}
+	sort.SliceStable(sheet.Entries, func(i, j int) bool {
+		return sheet.Entries[i].CapHit > sheet.Entries[j].CapHit
+	})
+	return sheet, nil
+}
+
+// signAcquisition checks the contract of a player being added to a roster against the league's
+// salary cap, paying players acquired without a salary the league's minimum. Contract terms are
+// only accepted in leagues with a salary cap.
+func (a *App) signAcquisition(ctx context.Context, req *CreateRosterPlayerRequest) error {
+	rules, err := a.repo.GetSalaryCapRules(ctx, req.FantasyTeamID)
+	if err != nil {
+		return fmt.Errorf("failed to get salary cap rules: %w", err)
+	}
+	if !rules.Enforced() {
+		if req.Salary != nil || req.ContractYears != nil {
+			return ErrNoSalaryCap
+		}
+		return nil
+	}
+	if err := rules.ValidateContract(req.Salary, req.ContractYears); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidContract, err)
+	}
+
+	salary := rules.CapHit(req.Salary)
+	req.Salary = &salary
+	rosters, err := a.repo.GetRosterPlayersByFantasyTeam(ctx, req.FantasyTeamID)
+	if err != nil {
+		return fmt.Errorf("failed to get roster: %w", err)
+	}
+	return ensureCapRoom(rules, rosters, uuid.Nil, salary)
+}
+
+// salaryCapRules returns the salary cap rules of a team's league, or ErrNoSalaryCap when it has none
+func (a *App) salaryCapRules(ctx context.Context, fantasyTeamID uuid.UUID) (models.SalaryCapRules, error) {
+	rules, err := a.repo.GetSalaryCapRules(ctx, fantasyTeamID)
+	if err != nil {
+		return models.SalaryCapRules{}, fmt.Errorf("failed to get salary cap rules: %w", err)
+	}
+	if !rules.Enforced() {
+		return models.SalaryCapRules{}, ErrNoSalaryCap
+	}
+	return rules, nil
+}
+
+// ensureCapRoom checks that a team rostering rosters can pay salary to the roster entry id, or to
+// a new player when id is uuid.Nil. A team already over the cap may still cut its payroll.
+func ensureCapRoom(rules models.SalaryCapRules, rosters []models.Roster, id uuid.UUID, salary int) error {
+	current := payroll(rules, rosters, uuid.Nil)
+	proposed := payroll(rules, rosters, id) + salary
+	if proposed > *rules.Cap && proposed > current {
+		return fmt.Errorf("%w: a payroll of %d against a cap of %d", ErrOverSalaryCap, proposed, *rules.Cap)
+	}
+	return nil
+}
+
+// payroll totals the cap hits of rosters, leaving out the roster entry except
+func payroll(rules models.SalaryCapRules, rosters []models.Roster, except uuid.UUID) int {
+	total := 0
+	for _, roster := range rosters {
+		if roster.ID != except {
+			total += rules.CapHit(roster.Salary)
+		}
+	}
+	return total
+}
+
+func countFranchiseTagged(rosters []models.Roster) int {
+	tagged := 0
+	for _, roster := range rosters {
+		if roster.FranchiseTagged {
+			tagged++
+		}
+	}
+	return tagged
+}
@@ -244,6 +244,10 @@ type RosterPlayer struct {
 	AcquiredAt      time.Time             `json:"acquired_at"`
 	AcquisitionType AcquisitionTypeEnum   `json:"acquisition_type"`
 	KeeperData      pqtype.NullRawMessage `json:"keeper_data"`
+	LineupSlot      sql.NullString        `json:"lineup_slot"`
+	Salary          sql.NullInt32         `json:"salary"`
+	ContractYears   sql.NullInt32         `json:"contract_years"`
+	FranchiseTagged bool                  `json:"franchise_tagged"`
 }
 
 type Sport struct {
@@ -253,6 +257,24 @@ type Sport struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type TaxiSquadExemption struct {
+	RosterPlayerID uuid.UUID     `json:"roster_player_id"`
+	GrantedBy      uuid.NullUUID `json:"granted_by"`
+	Reason         string        `json:"reason"`
+	CreatedAt      time.Time     `json:"created_at"`
+}
+
+type TaxiSquadViolation struct {
+	ID             uuid.UUID     `json:"id"`
+	LeagueID       uuid.UUID     `json:"league_id"`
+	FantasyTeamID  uuid.UUID     `json:"fantasy_team_id"`
+	RosterPlayerID uuid.NullUUID `json:"roster_player_id"`
+	Reason         string        `json:"reason"`
+	Detail         string        `json:"detail"`
+	DetectedAt     time.Time     `json:"detected_at"`
+	ResolvedAt     sql.NullTime  `json:"resolved_at"`
+}
+
 type Team struct {
 	ID              uuid.UUID      `json:"id"`
 	SportID         string         `json:"sport_id"`
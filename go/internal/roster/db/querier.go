@@ -6,25 +6,83 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	// Add a drafted player to the bench; a no-op if the player is already on the roster.
+	AddDraftedPlayerToRoster(ctx context.Context, arg AddDraftedPlayerToRosterParams) (int64, error)
+	// Whether a user may set a team's lineup: its owner, its delegate while the owner is away, or the
+	// commissioner of its league.
+	CanUserSetLineup(ctx context.Context, arg CanUserSetLineupParams) (bool, error)
 	CreateRosterPlayer(ctx context.Context, arg CreateRosterPlayerParams) (RosterPlayer, error)
 	DeletePlayerFromRoster(ctx context.Context, arg DeletePlayerFromRosterParams) error
 	DeleteRosterEntry(ctx context.Context, id uuid.UUID) error
+	DeleteTaxiSquadExemption(ctx context.Context, rosterPlayerID uuid.UUID) (int64, error)
 	DeleteTeamRoster(ctx context.Context, fantasyTeamID uuid.UUID) error
 	GetBenchRosterPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]RosterPlayer, error)
+	// Fetch the league settings a draft runs under: those it snapshotted when it started, or its
+	// league's before then.
+	GetDraftLeagueSettings(ctx context.Context, id uuid.UUID) (json.RawMessage, error)
+	// Fetch the settings and season of the league a fantasy team belongs to.
+	GetFantasyTeamLeagueSeason(ctx context.Context, id uuid.UUID) (GetFantasyTeamLeagueSeasonRow, error)
+	// Fetch the sport and settings of the league a fantasy team belongs to.
+	GetFantasyTeamLeagueSettings(ctx context.Context, id uuid.UUID) (GetFantasyTeamLeagueSettingsRow, error)
 	GetPlayerOnRoster(ctx context.Context, arg GetPlayerOnRosterParams) (RosterPlayer, error)
+	// Years of pro experience of the given players, for those with a known value.
+	GetPlayersExperience(ctx context.Context, playerIds []uuid.UUID) ([]GetPlayersExperienceRow, error)
 	GetRoster(ctx context.Context, id uuid.UUID) (RosterPlayer, error)
+	// Resolve the commissioner of the league that owns a roster entry.
+	GetRosterPlayerCommissionerID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	// Resolve the league that owns a roster entry via its fantasy team (used for tenancy checks).
+	GetRosterPlayerLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	// Resolve the owner of the team holding a roster entry and the commissioner of its league.
+	GetRosterPlayerManagers(ctx context.Context, id uuid.UUID) (GetRosterPlayerManagersRow, error)
+	// The position each player on a team's roster is listed at, by roster entry.
+	GetRosterPlayerPositions(ctx context.Context, fantasyTeamID uuid.UUID) ([]GetRosterPlayerPositionsRow, error)
 	GetRosterPlayersByAcquisitionType(ctx context.Context, arg GetRosterPlayersByAcquisitionTypeParams) ([]RosterPlayer, error)
 	GetRosterPlayersByFantasyTeam(ctx context.Context, fantasyTeamID uuid.UUID) ([]RosterPlayer, error)
 	GetRosterPlayersByFantasyTeamAndPosition(ctx context.Context, arg GetRosterPlayersByFantasyTeamAndPositionParams) ([]RosterPlayer, error)
 	GetStartingRosterPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]RosterPlayer, error)
+	// Attributes an action on a team to the user who took it when they took it as the team's active
+	// delegate. Inserts nothing for anyone else.
+	InsertDelegateAction(ctx context.Context, arg InsertDelegateActionParams) error
+	// Record that a team's owner is being alerted ahead of a lineup lock; a no-op if they already were.
+	InsertLineupAlert(ctx context.Context, arg InsertLineupAlertParams) (int64, error)
+	// The league is resolved from the fantasy team so the transaction log can scope events by league.
+	InsertRosterOutbox(ctx context.Context, arg InsertRosterOutboxParams) error
+	// Queue a notification for the notification worker to deliver.
+	InsertUserOutbox(ctx context.Context, arg InsertUserOutboxParams) error
+	// Whether a draft is a sandbox rehearsing another draft; its picks never reach rosters.
+	IsSandboxDraft(ctx context.Context, id uuid.UUID) (bool, error)
+	// Every team in a league that is in season, with its league's settings and its owner's
+	// contact details, for the lineup check run ahead of each lineup lock.
+	ListLineupCheckTeams(ctx context.Context) ([]ListLineupCheckTeamsRow, error)
+	// Every player on a team's roster with what a lineup check looks at: where they sit in the
+	// lineup, the position and NFL status they're listed with, and how often they're started
+	// across the platform.
+	ListLineupPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]ListLineupPlayersRow, error)
+	// The player's entries on the rosters of the other teams in a fantasy team's league, which a
+	// draft takes them from when the team drafts them.
+	ListOtherLeagueRosterEntries(ctx context.Context, arg ListOtherLeagueRosterEntriesParams) ([]RosterPlayer, error)
+	// Every player on a taxi squad in a league that is running and sets taxi squad limits, with what
+	// the nightly check needs to test it against its league's rules.
+	ListTaxiSquadEntries(ctx context.Context) ([]ListTaxiSquadEntriesRow, error)
+	ListTaxiSquadExemptionsByFantasyTeam(ctx context.Context, fantasyTeamID uuid.UUID) ([]TaxiSquadExemption, error)
+	ListTaxiSquadViolationsByLeague(ctx context.Context, arg ListTaxiSquadViolationsByLeagueParams) ([]TaxiSquadViolation, error)
+	// Resolve open violations other than the ones the latest check found.
+	ResolveTaxiSquadViolations(ctx context.Context, keepIds []uuid.UUID) (int64, error)
+	UpdateRosterPlayerContract(ctx context.Context, arg UpdateRosterPlayerContractParams) (RosterPlayer, error)
 	UpdateRosterPlayerKeeperData(ctx context.Context, arg UpdateRosterPlayerKeeperDataParams) (RosterPlayer, error)
+	// Set a roster entry's position and, for starters, the lineup slot it fills.
+	UpdateRosterPlayerLineup(ctx context.Context, arg UpdateRosterPlayerLineupParams) (RosterPlayer, error)
 	UpdateRosterPlayerPosition(ctx context.Context, arg UpdateRosterPlayerPositionParams) (RosterPlayer, error)
 	UpdateRosterPositionAndKeeperData(ctx context.Context, arg UpdateRosterPositionAndKeeperDataParams) (RosterPlayer, error)
+	UpsertTaxiSquadExemption(ctx context.Context, arg UpsertTaxiSquadExemptionParams) (TaxiSquadExemption, error)
+	// Record a violation, or refresh the detail of the matching open one.
+	UpsertTaxiSquadViolation(ctx context.Context, arg UpsertTaxiSquadViolationParams) (uuid.UUID, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,15 @@
+-- name: GetRosterPlayerManagers :one
+-- Resolve the owner of the team holding a roster entry and the commissioner of its league.
+SELECT ft.owner_id, l.commissioner_id
+FROM roster_players rp
+JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+JOIN leagues l ON l.id = ft.league_id
+WHERE rp.id = $1;
+
+-- name: UpdateRosterPlayerContract :one
+UPDATE roster_players SET
+    salary = $2,
+    contract_years = $3,
+    franchise_tagged = $4
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,31 @@
+-- name: CanUserSetLineup :one
+-- Whether a user may set a team's lineup: its owner, its delegate while the owner is away, or the
+-- commissioner of its league.
+SELECT EXISTS (
+    SELECT 1
+    FROM fantasy_teams ft
+    WHERE ft.id = sqlc.arg('fantasy_team_id') AND ft.owner_id = sqlc.arg('user_id')
+    UNION ALL
+    SELECT 1
+    FROM team_delegations td
+    WHERE td.fantasy_team_id = sqlc.arg('fantasy_team_id') AND td.delegate_id = sqlc.arg('user_id')
+      AND td.revoked_at IS NULL AND td.starts_at <= NOW() AND td.ends_at > NOW()
+    UNION ALL
+    SELECT 1
+    FROM fantasy_teams ft
+             JOIN leagues l ON l.id = ft.league_id
+    WHERE ft.id = sqlc.arg('fantasy_team_id') AND l.commissioner_id = sqlc.arg('user_id')
+) AS can_set_lineup;
+
+-- name: InsertDelegateAction :exec
+-- Attributes an action on a team to the user who took it when they took it as the team's active
+-- delegate. Inserts nothing for anyone else.
+INSERT INTO team_delegate_actions (id, delegation_id, fantasy_team_id, delegate_id, action, details)
+SELECT sqlc.arg('id')::uuid, td.id, td.fantasy_team_id, td.delegate_id, sqlc.arg('action')::text, sqlc.arg('details')::jsonb
+FROM team_delegations td
+WHERE td.fantasy_team_id = sqlc.arg('fantasy_team_id')
+  AND td.delegate_id = sqlc.arg('user_id')
+  AND td.revoked_at IS NULL
+  AND td.starts_at <= NOW()
+  AND td.ends_at > NOW()
+LIMIT 1;
@@ -0,0 +1,47 @@
+-- name: ListLineupPlayers :many
+-- Every player on a team's roster with what a lineup check looks at: where they sit in the
+-- lineup, the position and NFL status they're listed with, and how often they're started
+-- across the platform.
+SELECT rp.id,
+       rp.player_id,
+       p.full_name,
+       rp.position::TEXT AS roster_position,
+       rp.lineup_slot,
+       COALESCE(npp.position, '')::TEXT AS player_position,
+       COALESCE(npp.status, '')::TEXT AS status,
+       COALESCE(po.started_pct, 0)::DOUBLE PRECISION AS started_pct
+FROM roster_players rp
+JOIN players p ON p.id = rp.player_id
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = rp.player_id
+LEFT JOIN player_ownership po ON po.player_id = rp.player_id
+WHERE rp.fantasy_team_id = $1
+ORDER BY rp.acquired_at, rp.id;
+
+-- name: ListLineupCheckTeams :many
+-- Every team in a league that is in season, with its league's settings and its owner's
+-- contact details, for the lineup check run ahead of each lineup lock.
+SELECT ft.id,
+       ft.name AS team_name,
+       ft.league_id,
+       l.name AS league_name,
+       l.sport_id,
+       l.league_settings,
+       u.id AS owner_id,
+       u.username,
+       u.email
+FROM fantasy_teams ft
+JOIN leagues l ON l.id = ft.league_id
+JOIN users u ON u.id = ft.owner_id
+WHERE l.status = 'ACTIVE'
+ORDER BY ft.league_id, ft.id;
+
+-- name: InsertLineupAlert :execrows
+-- Record that a team's owner is being alerted ahead of a lineup lock; a no-op if they already were.
+INSERT INTO lineup_alerts (fantasy_team_id, locks_at, issues)
+VALUES ($1, $2, $3)
+ON CONFLICT (fantasy_team_id, locks_at) DO NOTHING;
+
+-- name: InsertUserOutbox :exec
+-- Queue a notification for the notification worker to deliver.
+INSERT INTO user_outbox (id, user_id, event_type, payload)
+VALUES ($1, $2, $3, $4);
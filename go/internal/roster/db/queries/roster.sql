@@ -6,7 +6,9 @@ INSERT INTO roster_players (
     position,
     acquired_at,
     acquisition_type,
-    keeper_data
+    keeper_data,
+    salary,
+    contract_years
 ) VALUES (
     gen_random_uuid(),
     $1,
@@ -14,7 +16,9 @@ INSERT INTO roster_players (
     $3,
     NOW(),
     $4,
-    $5
+    $5,
+    $6,
+    $7
 ) RETURNING *;
 
 -- name: GetRoster :one
@@ -50,7 +54,16 @@ ORDER BY acquired_at;
 
 -- name: UpdateRosterPlayerPosition :one
 UPDATE roster_players SET
-    position = $2
+    position = $2,
+    lineup_slot = CASE WHEN $2 = 'STARTING' THEN lineup_slot END
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateRosterPlayerLineup :one
+-- Set a roster entry's position and, for starters, the lineup slot it fills.
+UPDATE roster_players SET
+    position = $2,
+    lineup_slot = $3
 WHERE id = $1
 RETURNING *;
 
@@ -63,6 +76,7 @@ RETURNING *;
 -- name: UpdateRosterPositionAndKeeperData :one
 UPDATE roster_players SET
     position = $2,
+    lineup_slot = CASE WHEN $2 = 'STARTING' THEN lineup_slot END,
     keeper_data = $3
 WHERE id = $1
 RETURNING *;
@@ -75,4 +89,70 @@ DELETE FROM roster_players
 WHERE fantasy_team_id = $1 AND player_id = $2;
 
 -- name: DeleteTeamRoster :exec
-DELETE FROM roster_players WHERE fantasy_team_id = $1;
\ No newline at end of file
+DELETE FROM roster_players WHERE fantasy_team_id = $1;
+
+-- name: GetRosterPlayerLeagueID :one
+-- Resolve the league that owns a roster entry via its fantasy team (used for tenancy checks).
+SELECT ft.league_id
+FROM roster_players rp
+JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+WHERE rp.id = $1;
+
+-- name: AddDraftedPlayerToRoster :execrows
+-- Add a drafted player to the bench; a no-op if the player is already on the roster.
+INSERT INTO roster_players (
+    id,
+    fantasy_team_id,
+    player_id,
+    position,
+    acquired_at,
+    acquisition_type,
+    salary
+) VALUES (
+    gen_random_uuid(),
+    $1,
+    $2,
+    'BENCH',
+    $3,
+    'DRAFT',
+    $4
+)
+ON CONFLICT (fantasy_team_id, player_id) DO NOTHING;
+
+-- name: ListOtherLeagueRosterEntries :many
+-- The player's entries on the rosters of the other teams in a fantasy team's league, which a
+-- draft takes them from when the team drafts them.
+SELECT rp.* FROM roster_players rp
+JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+JOIN fantasy_teams picker ON picker.league_id = ft.league_id
+WHERE picker.id = sqlc.arg('fantasy_team_id')
+  AND rp.fantasy_team_id <> picker.id
+  AND rp.player_id = sqlc.arg('player_id');
+
+-- name: GetFantasyTeamLeagueSettings :one
+-- Fetch the sport and settings of the league a fantasy team belongs to.
+SELECT l.sport_id, l.league_settings
+FROM fantasy_teams ft
+JOIN leagues l ON l.id = ft.league_id
+WHERE ft.id = $1;
+
+-- name: GetDraftLeagueSettings :one
+-- Fetch the league settings a draft runs under: those it snapshotted when it started, or its
+-- league's before then.
+SELECT COALESCE(d.league_settings_snapshot, l.league_settings) AS league_settings
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE d.id = $1;
+
+-- name: IsSandboxDraft :one
+-- Whether a draft is a sandbox rehearsing another draft; its picks never reach rosters.
+SELECT sandbox_of_draft_id IS NOT NULL AS sandbox
+FROM draft
+WHERE id = $1;
+
+-- name: GetRosterPlayerPositions :many
+-- The position each player on a team's roster is listed at, by roster entry.
+SELECT rp.id, p.position
+FROM roster_players rp
+JOIN players p ON p.id = rp.player_id
+WHERE rp.fantasy_team_id = $1;
@@ -0,0 +1,4 @@
+-- name: InsertRosterOutbox :exec
+-- The league is resolved from the fantasy team so the transaction log can scope events by league.
+INSERT INTO roster_outbox (id, league_id, fantasy_team_id, event_type, payload)
+VALUES ($1, (SELECT league_id FROM fantasy_teams WHERE id = $2), $2, $3, $4);
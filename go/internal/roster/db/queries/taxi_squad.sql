@@ -0,0 +1,82 @@
+-- name: GetFantasyTeamLeagueSeason :one
+-- Fetch the settings and season of the league a fantasy team belongs to.
+SELECT l.league_settings, l.season
+FROM fantasy_teams ft
+JOIN leagues l ON l.id = ft.league_id
+WHERE ft.id = $1;
+
+-- name: GetPlayersExperience :many
+-- Years of pro experience of the given players, for those with a known value.
+SELECT player_id, experience
+FROM nfl_player_profiles
+WHERE player_id = ANY(@player_ids::uuid[])
+  AND experience IS NOT NULL;
+
+-- name: GetRosterPlayerCommissionerID :one
+-- Resolve the commissioner of the league that owns a roster entry.
+SELECT l.commissioner_id
+FROM roster_players rp
+JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+JOIN leagues l ON l.id = ft.league_id
+WHERE rp.id = $1;
+
+-- name: ListTaxiSquadExemptionsByFantasyTeam :many
+SELECT e.roster_player_id, e.granted_by, e.reason, e.created_at
+FROM taxi_squad_exemptions e
+JOIN roster_players rp ON rp.id = e.roster_player_id
+WHERE rp.fantasy_team_id = $1;
+
+-- name: UpsertTaxiSquadExemption :one
+INSERT INTO taxi_squad_exemptions (roster_player_id, granted_by, reason)
+VALUES ($1, $2, $3)
+ON CONFLICT (roster_player_id) DO UPDATE SET
+    granted_by = EXCLUDED.granted_by,
+    reason = EXCLUDED.reason,
+    created_at = NOW()
+RETURNING *;
+
+-- name: DeleteTaxiSquadExemption :execrows
+DELETE FROM taxi_squad_exemptions WHERE roster_player_id = $1;
+
+-- name: ListTaxiSquadEntries :many
+-- Every player on a taxi squad in a league that is running and sets taxi squad limits, with what
+-- the nightly check needs to test it against its league's rules.
+SELECT rp.id,
+       rp.fantasy_team_id,
+       rp.player_id,
+       ft.league_id,
+       l.league_settings,
+       l.season,
+       npp.experience,
+       (e.roster_player_id IS NOT NULL)::boolean AS exempt
+FROM roster_players rp
+JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+JOIN leagues l ON l.id = ft.league_id
+LEFT JOIN nfl_player_profiles npp ON npp.player_id = rp.player_id
+LEFT JOIN taxi_squad_exemptions e ON e.roster_player_id = rp.id
+WHERE rp.position = 'TAXI'
+  AND l.status IN ('PENDING', 'ACTIVE')
+  AND l.league_settings ?| ARRAY ['taxi_slots', 'taxi_max_experience']
+ORDER BY ft.league_id, rp.fantasy_team_id, rp.acquired_at;
+
+-- name: UpsertTaxiSquadViolation :one
+-- Record a violation, or refresh the detail of the matching open one.
+INSERT INTO taxi_squad_violations (league_id, fantasy_team_id, roster_player_id, reason, detail)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (fantasy_team_id, COALESCE(roster_player_id, '00000000-0000-0000-0000-000000000000'), reason)
+    WHERE resolved_at IS NULL
+    DO UPDATE SET detail = EXCLUDED.detail
+RETURNING id;
+
+-- name: ResolveTaxiSquadViolations :execrows
+-- Resolve open violations other than the ones the latest check found.
+UPDATE taxi_squad_violations SET
+    resolved_at = NOW()
+WHERE resolved_at IS NULL
+  AND NOT (id = ANY(@keep_ids::uuid[]));
+
+-- name: ListTaxiSquadViolationsByLeague :many
+SELECT * FROM taxi_squad_violations
+WHERE league_id = @league_id
+  AND (@include_resolved::boolean OR resolved_at IS NULL)
+ORDER BY detected_at DESC;
@@ -7,11 +7,56 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
 )
 
+const addDraftedPlayerToRoster = `-- name: AddDraftedPlayerToRoster :execrows
+INSERT INTO roster_players (
+    id,
+    fantasy_team_id,
+    player_id,
+    position,
+    acquired_at,
+    acquisition_type,
+    salary
+) VALUES (
+    gen_random_uuid(),
+    $1,
+    $2,
+    'BENCH',
+    $3,
+    'DRAFT',
+    $4
+)
+ON CONFLICT (fantasy_team_id, player_id) DO NOTHING
+`
+
+type AddDraftedPlayerToRosterParams struct {
+	FantasyTeamID uuid.UUID     `json:"fantasy_team_id"`
+	PlayerID      uuid.UUID     `json:"player_id"`
+	AcquiredAt    time.Time     `json:"acquired_at"`
+	Salary        sql.NullInt32 `json:"salary"`
+}
+
+// Add a drafted player to the bench; a no-op if the player is already on the roster.
+func (q *Queries) AddDraftedPlayerToRoster(ctx context.Context, arg AddDraftedPlayerToRosterParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, addDraftedPlayerToRoster,
+		arg.FantasyTeamID,
+		arg.PlayerID,
+		arg.AcquiredAt,
+		arg.Salary,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const createRosterPlayer = `-- name: CreateRosterPlayer :one
 INSERT INTO roster_players (
     id,
@@ -20,7 +65,9 @@ INSERT INTO roster_players (
     position,
     acquired_at,
     acquisition_type,
-    keeper_data
+    keeper_data,
+    salary,
+    contract_years
 ) VALUES (
     gen_random_uuid(),
     $1,
@@ -28,8 +75,10 @@ INSERT INTO roster_players (
     $3,
     NOW(),
     $4,
-    $5
-) RETURNING id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data
+    $5,
+    $6,
+    $7
+) RETURNING id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged
 `
 
 type CreateRosterPlayerParams struct {
@@ -38,6 +87,8 @@ type CreateRosterPlayerParams struct {
 	Position        RosterPositionEnum    `json:"position"`
 	AcquisitionType AcquisitionTypeEnum   `json:"acquisition_type"`
 	KeeperData      pqtype.NullRawMessage `json:"keeper_data"`
+	Salary          sql.NullInt32         `json:"salary"`
+	ContractYears   sql.NullInt32         `json:"contract_years"`
 }
 
 func (q *Queries) CreateRosterPlayer(ctx context.Context, arg CreateRosterPlayerParams) (RosterPlayer, error) {
@@ -47,6 +98,8 @@ func (q *Queries) CreateRosterPlayer(ctx context.Context, arg CreateRosterPlayer
 		arg.Position,
 		arg.AcquisitionType,
 		arg.KeeperData,
+		arg.Salary,
+		arg.ContractYears,
 	)
 	var i RosterPlayer
 	err := row.Scan(
@@ -57,6 +110,10 @@ func (q *Queries) CreateRosterPlayer(ctx context.Context, arg CreateRosterPlayer
 		&i.AcquiredAt,
 		&i.AcquisitionType,
 		&i.KeeperData,
+		&i.LineupSlot,
+		&i.Salary,
+		&i.ContractYears,
+		&i.FranchiseTagged,
 	)
 	return i, err
 }
@@ -95,7 +152,7 @@ func (q *Queries) DeleteTeamRoster(ctx context.Context, fantasyTeamID uuid.UUID)
 }
 
 const getBenchRosterPlayers = `-- name: GetBenchRosterPlayers :many
-SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data FROM roster_players
+SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged FROM roster_players
 WHERE fantasy_team_id = $1 AND position = 'BENCH'
 ORDER BY acquired_at
 `
@@ -117,6 +174,10 @@ func (q *Queries) GetBenchRosterPlayers(ctx context.Context, fantasyTeamID uuid.
 			&i.AcquiredAt,
 			&i.AcquisitionType,
 			&i.KeeperData,
+			&i.LineupSlot,
+			&i.Salary,
+			&i.ContractYears,
+			&i.FranchiseTagged,
 		); err != nil {
 			return nil, err
 		}
@@ -131,8 +192,44 @@ func (q *Queries) GetBenchRosterPlayers(ctx context.Context, fantasyTeamID uuid.
 	return items, nil
 }
 
+const getDraftLeagueSettings = `-- name: GetDraftLeagueSettings :one
+SELECT COALESCE(d.league_settings_snapshot, l.league_settings) AS league_settings
+FROM draft d
+JOIN leagues l ON l.id = d.league_id
+WHERE d.id = $1
+`
+
+// Fetch the league settings a draft runs under: those it snapshotted when it started, or its
+// league's before then.
+func (q *Queries) GetDraftLeagueSettings(ctx context.Context, id uuid.UUID) (json.RawMessage, error) {
+	row := q.db.QueryRowContext(ctx, getDraftLeagueSettings, id)
+	var league_settings json.RawMessage
+	err := row.Scan(&league_settings)
+	return league_settings, err
+}
+
+const getFantasyTeamLeagueSettings = `-- name: GetFantasyTeamLeagueSettings :one
+SELECT l.sport_id, l.league_settings
+FROM fantasy_teams ft
+JOIN leagues l ON l.id = ft.league_id
+WHERE ft.id = $1
+`
+
+type GetFantasyTeamLeagueSettingsRow struct {
+	SportID        string          `json:"sport_id"`
+	LeagueSettings json.RawMessage `json:"league_settings"`
+}
+
+// Fetch the sport and settings of the league a fantasy team belongs to.
+func (q *Queries) GetFantasyTeamLeagueSettings(ctx context.Context, id uuid.UUID) (GetFantasyTeamLeagueSettingsRow, error) {
+	row := q.db.QueryRowContext(ctx, getFantasyTeamLeagueSettings, id)
+	var i GetFantasyTeamLeagueSettingsRow
+	err := row.Scan(&i.SportID, &i.LeagueSettings)
+	return i, err
+}
+
 const getPlayerOnRoster = `-- name: GetPlayerOnRoster :one
-SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data FROM roster_players
+SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged FROM roster_players
 WHERE fantasy_team_id = $1 AND player_id = $2
 `
 
@@ -152,12 +249,16 @@ func (q *Queries) GetPlayerOnRoster(ctx context.Context, arg GetPlayerOnRosterPa
 		&i.AcquiredAt,
 		&i.AcquisitionType,
 		&i.KeeperData,
+		&i.LineupSlot,
+		&i.Salary,
+		&i.ContractYears,
+		&i.FranchiseTagged,
 	)
 	return i, err
 }
 
 const getRoster = `-- name: GetRoster :one
-SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data FROM roster_players WHERE id = $1
+SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged FROM roster_players WHERE id = $1
 `
 
 func (q *Queries) GetRoster(ctx context.Context, id uuid.UUID) (RosterPlayer, error) {
@@ -171,12 +272,67 @@ func (q *Queries) GetRoster(ctx context.Context, id uuid.UUID) (RosterPlayer, er
 		&i.AcquiredAt,
 		&i.AcquisitionType,
 		&i.KeeperData,
+		&i.LineupSlot,
+		&i.Salary,
+		&i.ContractYears,
+		&i.FranchiseTagged,
 	)
 	return i, err
 }
 
+const getRosterPlayerLeagueID = `-- name: GetRosterPlayerLeagueID :one
+SELECT ft.league_id
+FROM roster_players rp
+JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+WHERE rp.id = $1
+`
+
+// Resolve the league that owns a roster entry via its fantasy team (used for tenancy checks).
+func (q *Queries) GetRosterPlayerLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, getRosterPlayerLeagueID, id)
+	var league_id uuid.UUID
+	err := row.Scan(&league_id)
+	return league_id, err
+}
+
+const getRosterPlayerPositions = `-- name: GetRosterPlayerPositions :many
+SELECT rp.id, p.position
+FROM roster_players rp
+JOIN players p ON p.id = rp.player_id
+WHERE rp.fantasy_team_id = $1
+`
+
+type GetRosterPlayerPositionsRow struct {
+	ID       uuid.UUID `json:"id"`
+	Position string    `json:"position"`
+}
+
+// The position each player on a team's roster is listed at, by roster entry.
+func (q *Queries) GetRosterPlayerPositions(ctx context.Context, fantasyTeamID uuid.UUID) ([]GetRosterPlayerPositionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRosterPlayerPositions, fantasyTeamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRosterPlayerPositionsRow
+	for rows.Next() {
+		var i GetRosterPlayerPositionsRow
+		if err := rows.Scan(&i.ID, &i.Position); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRosterPlayersByAcquisitionType = `-- name: GetRosterPlayersByAcquisitionType :many
-SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data FROM roster_players
+SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged FROM roster_players
 WHERE fantasy_team_id = $1 AND acquisition_type = $2
 ORDER BY acquired_at
 `
@@ -203,6 +359,10 @@ func (q *Queries) GetRosterPlayersByAcquisitionType(ctx context.Context, arg Get
 			&i.AcquiredAt,
 			&i.AcquisitionType,
 			&i.KeeperData,
+			&i.LineupSlot,
+			&i.Salary,
+			&i.ContractYears,
+			&i.FranchiseTagged,
 		); err != nil {
 			return nil, err
 		}
@@ -218,7 +378,7 @@ func (q *Queries) GetRosterPlayersByAcquisitionType(ctx context.Context, arg Get
 }
 
 const getRosterPlayersByFantasyTeam = `-- name: GetRosterPlayersByFantasyTeam :many
-SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data FROM roster_players WHERE fantasy_team_id = $1
+SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged FROM roster_players WHERE fantasy_team_id = $1
 ORDER BY position, acquired_at
 `
 
@@ -239,6 +399,10 @@ func (q *Queries) GetRosterPlayersByFantasyTeam(ctx context.Context, fantasyTeam
 			&i.AcquiredAt,
 			&i.AcquisitionType,
 			&i.KeeperData,
+			&i.LineupSlot,
+			&i.Salary,
+			&i.ContractYears,
+			&i.FranchiseTagged,
 		); err != nil {
 			return nil, err
 		}
@@ -254,7 +418,7 @@ func (q *Queries) GetRosterPlayersByFantasyTeam(ctx context.Context, fantasyTeam
 }
 
 const getRosterPlayersByFantasyTeamAndPosition = `-- name: GetRosterPlayersByFantasyTeamAndPosition :many
-SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data FROM roster_players
+SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged FROM roster_players
 WHERE fantasy_team_id = $1 AND position = $2
 ORDER BY acquired_at
 `
@@ -281,6 +445,10 @@ func (q *Queries) GetRosterPlayersByFantasyTeamAndPosition(ctx context.Context,
 			&i.AcquiredAt,
 			&i.AcquisitionType,
 			&i.KeeperData,
+			&i.LineupSlot,
+			&i.Salary,
+			&i.ContractYears,
+			&i.FranchiseTagged,
 		); err != nil {
 			return nil, err
 		}
@@ -296,7 +464,7 @@ func (q *Queries) GetRosterPlayersByFantasyTeamAndPosition(ctx context.Context,
 }
 
 const getStartingRosterPlayers = `-- name: GetStartingRosterPlayers :many
-SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data FROM roster_players
+SELECT id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged FROM roster_players
 WHERE fantasy_team_id = $1 AND position = 'STARTER'
 ORDER BY acquired_at
 `
@@ -318,6 +486,75 @@ func (q *Queries) GetStartingRosterPlayers(ctx context.Context, fantasyTeamID uu
 			&i.AcquiredAt,
 			&i.AcquisitionType,
 			&i.KeeperData,
+			&i.LineupSlot,
+			&i.Salary,
+			&i.ContractYears,
+			&i.FranchiseTagged,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isSandboxDraft = `-- name: IsSandboxDraft :one
+SELECT sandbox_of_draft_id IS NOT NULL AS sandbox
+FROM draft
+WHERE id = $1
+`
+
+// Whether a draft is a sandbox rehearsing another draft; its picks never reach rosters.
+func (q *Queries) IsSandboxDraft(ctx context.Context, id uuid.UUID) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isSandboxDraft, id)
+	var sandbox bool
+	err := row.Scan(&sandbox)
+	return sandbox, err
+}
+
+const listOtherLeagueRosterEntries = `-- name: ListOtherLeagueRosterEntries :many
+SELECT rp.id, rp.fantasy_team_id, rp.player_id, rp.position, rp.acquired_at, rp.acquisition_type, rp.keeper_data, rp.lineup_slot, rp.salary, rp.contract_years, rp.franchise_tagged FROM roster_players rp
+JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+JOIN fantasy_teams picker ON picker.league_id = ft.league_id
+WHERE picker.id = $1
+  AND rp.fantasy_team_id <> picker.id
+  AND rp.player_id = $2
+`
+
+type ListOtherLeagueRosterEntriesParams struct {
+	FantasyTeamID uuid.UUID `json:"fantasy_team_id"`
+	PlayerID      uuid.UUID `json:"player_id"`
+}
+
+// The player's entries on the rosters of the other teams in a fantasy team's league, which a
+// draft takes them from when the team drafts them.
+func (q *Queries) ListOtherLeagueRosterEntries(ctx context.Context, arg ListOtherLeagueRosterEntriesParams) ([]RosterPlayer, error) {
+	rows, err := q.db.QueryContext(ctx, listOtherLeagueRosterEntries, arg.FantasyTeamID, arg.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RosterPlayer
+	for rows.Next() {
+		var i RosterPlayer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FantasyTeamID,
+			&i.PlayerID,
+			&i.Position,
+			&i.AcquiredAt,
+			&i.AcquisitionType,
+			&i.KeeperData,
+			&i.LineupSlot,
+			&i.Salary,
+			&i.ContractYears,
+			&i.FranchiseTagged,
 		); err != nil {
 			return nil, err
 		}
@@ -336,7 +573,7 @@ const updateRosterPlayerKeeperData = `-- name: UpdateRosterPlayerKeeperData :one
 UPDATE roster_players SET
     keeper_data = $2
 WHERE id = $1
-RETURNING id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data
+RETURNING id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged
 `
 
 type UpdateRosterPlayerKeeperDataParams struct {
@@ -355,15 +592,54 @@ func (q *Queries) UpdateRosterPlayerKeeperData(ctx context.Context, arg UpdateRo
 		&i.AcquiredAt,
 		&i.AcquisitionType,
 		&i.KeeperData,
+		&i.LineupSlot,
+		&i.Salary,
+		&i.ContractYears,
+		&i.FranchiseTagged,
+	)
+	return i, err
+}
+
+const updateRosterPlayerLineup = `-- name: UpdateRosterPlayerLineup :one
+UPDATE roster_players SET
+    position = $2,
+    lineup_slot = $3
+WHERE id = $1
+RETURNING id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged
+`
+
+type UpdateRosterPlayerLineupParams struct {
+	ID         uuid.UUID          `json:"id"`
+	Position   RosterPositionEnum `json:"position"`
+	LineupSlot sql.NullString     `json:"lineup_slot"`
+}
+
+// Set a roster entry's position and, for starters, the lineup slot it fills.
+func (q *Queries) UpdateRosterPlayerLineup(ctx context.Context, arg UpdateRosterPlayerLineupParams) (RosterPlayer, error) {
+	row := q.db.QueryRowContext(ctx, updateRosterPlayerLineup, arg.ID, arg.Position, arg.LineupSlot)
+	var i RosterPlayer
+	err := row.Scan(
+		&i.ID,
+		&i.FantasyTeamID,
+		&i.PlayerID,
+		&i.Position,
+		&i.AcquiredAt,
+		&i.AcquisitionType,
+		&i.KeeperData,
+		&i.LineupSlot,
+		&i.Salary,
+		&i.ContractYears,
+		&i.FranchiseTagged,
 	)
 	return i, err
 }
 
 const updateRosterPlayerPosition = `-- name: UpdateRosterPlayerPosition :one
 UPDATE roster_players SET
-    position = $2
+    position = $2,
+    lineup_slot = CASE WHEN $2 = 'STARTING' THEN lineup_slot END
 WHERE id = $1
-RETURNING id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data
+RETURNING id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged
 `
 
 type UpdateRosterPlayerPositionParams struct {
@@ -382,6 +658,10 @@ func (q *Queries) UpdateRosterPlayerPosition(ctx context.Context, arg UpdateRost
 		&i.AcquiredAt,
 		&i.AcquisitionType,
 		&i.KeeperData,
+		&i.LineupSlot,
+		&i.Salary,
+		&i.ContractYears,
+		&i.FranchiseTagged,
 	)
 	return i, err
 }
@@ -389,9 +669,10 @@ func (q *Queries) UpdateRosterPlayerPosition(ctx context.Context, arg UpdateRost
 const updateRosterPositionAndKeeperData = `-- name: UpdateRosterPositionAndKeeperData :one
 UPDATE roster_players SET
     position = $2,
+    lineup_slot = CASE WHEN $2 = 'STARTING' THEN lineup_slot END,
     keeper_data = $3
 WHERE id = $1
-RETURNING id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data
+RETURNING id, fantasy_team_id, player_id, position, acquired_at, acquisition_type, keeper_data, lineup_slot, salary, contract_years, franchise_tagged
 `
 
 type UpdateRosterPositionAndKeeperDataParams struct {
@@ -411,6 +692,10 @@ func (q *Queries) UpdateRosterPositionAndKeeperData(ctx context.Context, arg Upd
 		&i.AcquiredAt,
 		&i.AcquisitionType,
 		&i.KeeperData,
+		&i.LineupSlot,
+		&i.Salary,
+		&i.ContractYears,
+		&i.FranchiseTagged,
 	)
 	return i, err
 }
@@ -0,0 +1,242 @@
+package draftsync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+)
+
+// PickApplier applies a single draft pick to the owning fantasy team's roster, under the
+// settings of the draft it was made in. auctionAmount is the winning bid of auction draft picks
+// and nil otherwise.
+// Implementations must be idempotent since JetStream may redeliver a message.
+type PickApplier interface {
+	ApplyDraftPick(ctx context.Context, draftID, fantasyTeamID, playerID uuid.UUID, pickedAt time.Time, auctionAmount *float64) (bool, error)
+}
+
+// Config holds configuration for the roster sync consumer
+type Config struct {
+	NATS          natsconfig.Config
+	StreamName    string
+	ConsumerName  string
+	SubjectFilter string        // Only PickMade events are needed
+	MaxDeliver    int           // Max delivery attempts
+	AckWait       time.Duration // How long to wait for ack
+	MaxAckPending int           // Max messages pending ack
+	MaxReconnects int
+	ReconnectWait time.Duration
+}
+
+// DefaultConfig returns default roster sync consumer configuration
+func DefaultConfig() Config {
+	return Config{
+		NATS:          natsconfig.Default(),
+		StreamName:    events.StateStream,
+		ConsumerName:  "roster-sync",
+		SubjectFilter: events.Subject(events.PickMade),
+		MaxDeliver:    10,
+		AckWait:       30 * time.Second,
+		MaxAckPending: 100,
+		MaxReconnects: -1, // Infinite
+		ReconnectWait: 2 * time.Second,
+	}
+}
+
+// Consumer applies PickMade events to fantasy team rosters as they happen,
+// so roster views and positional needs stay current during a draft.
+type Consumer struct {
+	applier  PickApplier
+	nc       *nats.Conn
+	js       jetstream.JetStream
+	consumer jetstream.Consumer
+	config   Config
+}
+
+// NewConsumer connects to NATS and creates or binds the durable roster sync consumer
+func NewConsumer(applier PickApplier, config Config) (*Consumer, error) {
+	opts := []nats.Option{
+		nats.MaxReconnects(config.MaxReconnects),
+		nats.ReconnectWait(config.ReconnectWait),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			log.Error().Err(err).Msg("NATS disconnected")
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Info().Str("url", nc.ConnectedUrl()).Msg("NATS reconnected")
+		}),
+	}
+
+	nc, err := config.NATS.Connect(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("create JetStream context: %w", err)
+	}
+
+	c := &Consumer{
+		applier: applier,
+		nc:      nc,
+		js:      js,
+		config:  config,
+	}
+
+	if err := c.ensureConsumer(context.Background()); err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("ensure consumer: %w", err)
+	}
+
+	return c, nil
+}
+
+// ensureConsumer creates or gets the JetStream consumer
+func (c *Consumer) ensureConsumer(ctx context.Context) error {
+	stream, err := c.js.Stream(ctx, c.config.StreamName)
+	if err != nil {
+		return fmt.Errorf("get stream: %w", err)
+	}
+
+	consumerConfig := jetstream.ConsumerConfig{
+		Name:          c.config.ConsumerName,
+		Durable:       c.config.ConsumerName,
+		Description:   "Roster sync consumer applying draft picks to rosters",
+		FilterSubject: c.config.SubjectFilter,
+		DeliverPolicy: jetstream.DeliverAllPolicy, // Catch up on any picks missed while offline
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		MaxDeliver:    c.config.MaxDeliver,
+		AckWait:       c.config.AckWait,
+		MaxAckPending: c.config.MaxAckPending,
+		ReplayPolicy:  jetstream.ReplayInstantPolicy,
+	}
+
+	consumer, err := stream.Consumer(ctx, c.config.ConsumerName)
+	if err != nil {
+		consumer, err = stream.CreateConsumer(ctx, consumerConfig)
+		if err != nil {
+			return fmt.Errorf("create consumer: %w", err)
+		}
+		log.Info().
+			Str("consumer", c.config.ConsumerName).
+			Str("stream", c.config.StreamName).
+			Msg("created JetStream consumer")
+	} else {
+		log.Info().
+			Str("consumer", c.config.ConsumerName).
+			Str("stream", c.config.StreamName).
+			Msg("using existing JetStream consumer")
+	}
+
+	c.consumer = consumer
+	return nil
+}
+
+// Start consumes PickMade events until ctx is cancelled
+func (c *Consumer) Start(ctx context.Context) error {
+	log.Info().
+		Str("consumer", c.config.ConsumerName).
+		Str("stream", c.config.StreamName).
+		Msg("starting roster sync consumer")
+
+	messageCh := make(chan jetstream.Msg, 100)
+
+	consumeCtx, err := c.consumer.Consume(func(msg jetstream.Msg) {
+		select {
+		case messageCh <- msg:
+		case <-ctx.Done():
+			msg.Nak()
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("start consumer: %w", err)
+	}
+	defer consumeCtx.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("roster sync consumer shutting down")
+			return nil
+		case msg := <-messageCh:
+			if err := c.processMessage(ctx, msg); err != nil {
+				log.Error().
+					Err(err).
+					Str("subject", msg.Subject()).
+					Msg("failed to apply pick to roster")
+				if nakErr := msg.Nak(); nakErr != nil {
+					log.Error().Err(nakErr).Msg("failed to NAK message")
+				}
+				continue
+			}
+			if ackErr := msg.Ack(); ackErr != nil {
+				log.Error().Err(ackErr).Msg("failed to ACK message")
+			}
+		}
+	}
+}
+
+// processMessage applies a single PickMade event to the roster
+func (c *Consumer) processMessage(ctx context.Context, msg jetstream.Msg) error {
+	var envelope struct {
+		EventID   string          `json:"eventId"`
+		EventType string          `json:"eventType"`
+		DraftID   string          `json:"draftId"`
+		Timestamp time.Time       `json:"timestamp"`
+		Payload   json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(msg.Data(), &envelope); err != nil {
+		return fmt.Errorf("unmarshal event envelope: %w", err)
+	}
+
+	if envelope.EventType != "PickMade" {
+		return nil
+	}
+
+	var payload events.PickMadePayload
+	if err := json.Unmarshal(envelope.Payload, &payload); err != nil {
+		return fmt.Errorf("unmarshal PickMade payload: %w", err)
+	}
+
+	draftID, err := uuid.Parse(envelope.DraftID)
+	if err != nil {
+		return fmt.Errorf("parse draft ID: %w", err)
+	}
+	teamID, err := uuid.Parse(payload.TeamID)
+	if err != nil {
+		return fmt.Errorf("parse team ID: %w", err)
+	}
+	playerID, err := uuid.Parse(payload.PlayerID)
+	if err != nil {
+		return fmt.Errorf("parse player ID: %w", err)
+	}
+
+	added, err := c.applier.ApplyDraftPick(ctx, draftID, teamID, playerID, payload.MadeAt, payload.AuctionAmount)
+	if err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("draft_id", envelope.DraftID).
+		Str("pick_id", payload.PickID).
+		Bool("added", added).
+		Msg("applied pick to roster")
+
+	return nil
+}
+
+// Close closes the NATS connection
+func (c *Consumer) Close() error {
+	if c.nc != nil {
+		c.nc.Close()
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event types written to the roster outbox alongside the roster change they describe
+const (
+	EventTypeRosterPlayerAdded   = "RosterPlayerAdded"
+	EventTypeRosterPlayerDropped = "RosterPlayerDropped"
+	EventTypeKeeperDesignated    = "KeeperDesignated"
+)
+
+// RosterPlayerAddedPayload is the payload for a RosterPlayerAdded event. Drafted players
+// are not reported here; PickMade draft events cover them.
+type RosterPlayerAddedPayload struct {
+	RosterID        string    `json:"roster_id"`
+	FantasyTeamID   string    `json:"fantasy_team_id"`
+	PlayerID        string    `json:"player_id"`
+	AcquisitionType string    `json:"acquisition_type"`
+	AcquiredAt      time.Time `json:"acquired_at"`
+}
+
+// RosterPlayerDroppedPayload is the payload for a RosterPlayerDropped event
+type RosterPlayerDroppedPayload struct {
+	RosterID      string    `json:"roster_id"`
+	FantasyTeamID string    `json:"fantasy_team_id"`
+	PlayerID      string    `json:"player_id"`
+	DroppedAt     time.Time `json:"dropped_at"`
+}
+
+// KeeperDesignatedPayload is the payload for a KeeperDesignated event, emitted when a
+// rostered player is first given keeper data
+type KeeperDesignatedPayload struct {
+	RosterID      string          `json:"roster_id"`
+	FantasyTeamID string          `json:"fantasy_team_id"`
+	PlayerID      string          `json:"player_id"`
+	KeeperData    json.RawMessage `json:"keeper_data,omitempty"`
+	DesignatedAt  time.Time       `json:"designated_at"`
+}
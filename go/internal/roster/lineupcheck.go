@@ -0,0 +1,284 @@
+package roster
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// LineupIssueKind is a kind of problem a lineup check finds
+type LineupIssueKind string
+
+const (
+	// LineupIssueEmptySlot is a league lineup slot no starter fills
+	LineupIssueEmptySlot LineupIssueKind = "EMPTY_SLOT"
+	// LineupIssueInactiveStarter is a starter whose NFL status keeps them out of games
+	LineupIssueInactiveStarter LineupIssueKind = "INACTIVE_STARTER"
+	// LineupIssueTooManyStarters is a starter in a slot that already holds as many starters as
+	// the league has slots of its name
+	LineupIssueTooManyStarters LineupIssueKind = "TOO_MANY_STARTERS"
+	// LineupIssueIneligibleStarter is a starter without a slot, in a slot the league doesn't
+	// have, or in a slot their position isn't eligible for
+	LineupIssueIneligibleStarter LineupIssueKind = "INELIGIBLE_STARTER"
+)
+
+// LineupPlayer is a player on a team's roster with what a lineup check looks at
+type LineupPlayer struct {
+	RosterID       uuid.UUID
+	PlayerID       uuid.UUID
+	FullName       string
+	Position       models.RosterPosition
+	LineupSlot     string
+	PlayerPosition string  // the position the player is listed at
+	Status         string  // NFL roster status; empty when unknown
+	StartedPct     float64 // how often the player is started across the platform
+}
+
+// Active reports whether the player can play. Players without a known status are given the
+// benefit of the doubt.
+func (p LineupPlayer) Active() bool {
+	return p.Status == "" || p.Status == models.NFLPlayerStatusActive
+}
+
+// LineupIssue is a problem with a team's lineup. Fix is nil when the team has no bench player
+// who could solve it.
+type LineupIssue struct {
+	Kind     LineupIssueKind
+	Slot     string
+	RosterID *uuid.UUID // the starter at fault; unset for empty slots
+	Detail   string
+	Fix      *LineupFix
+}
+
+// LineupFix is a batch lineup change that solves a lineup issue
+type LineupFix struct {
+	Description string
+	Assignments []LineupAssignment
+}
+
+// LineupCheck is what a lineup check found on a team ahead of the next lineup lock.
+// Assignments combines every issue's fix into one batch lineup change; the fixes use distinct
+// players, so applying it solves every fixable issue at once. A single fix can be applied on
+// its own once no slot holds too many starters.
+type LineupCheck struct {
+	FantasyTeamID uuid.UUID
+	LocksAt       time.Time
+	Issues        []LineupIssue
+	Assignments   []LineupAssignment
+}
+
+// checkLineup finds the problems with a team's lineup and suggests a fix for each. Starters
+// are kept in the slot they hold while it has room and accepts them; the rest are moved to an
+// open slot they're eligible for, or to the bench. Inactive starters are swapped for the most
+// started active bench player eligible for their slot, and slots left empty are filled the
+// same way. In leagues without lineup slots only inactive starters are checked, and they're
+// swapped for a bench player listed at the same position.
+func checkLineup(fantasyTeamID uuid.UUID, players []LineupPlayer, slots []models.LineupSlot) LineupCheck {
+	check := LineupCheck{FantasyTeamID: fantasyTeamID}
+	bench := newBenchPool(players)
+
+	var starters []LineupPlayer
+	for _, player := range players {
+		if player.Position == models.RosterPositionStarter {
+			starters = append(starters, player)
+		}
+	}
+
+	if len(slots) == 0 {
+		for _, starter := range starters {
+			if starter.Active() {
+				continue
+			}
+			issue := inactiveStarterIssue(starter, "")
+			if sub, ok := bench.take(func(p LineupPlayer) bool { return p.PlayerPosition == starter.PlayerPosition }); ok {
+				issue.Fix = &LineupFix{
+					Description: fmt.Sprintf("Start %s in place of %s", sub.FullName, starter.FullName),
+					Assignments: []LineupAssignment{
+						{RosterID: starter.RosterID, Position: models.RosterPositionBench},
+						{RosterID: sub.RosterID, Position: models.RosterPositionStarter},
+					},
+				}
+			}
+			check.add(issue)
+		}
+		return check
+	}
+
+	var slotNames []string
+	capacity := make(map[string]int, len(slots))
+	for _, slot := range slots {
+		if capacity[slot.Name] == 0 {
+			slotNames = append(slotNames, slot.Name)
+		}
+		capacity[slot.Name]++
+	}
+
+	// Keep starters in their slots while there's room, then find the rest somewhere to go
+	filled := make(map[string]int, len(capacity))
+	var placed, misplaced []LineupPlayer
+	for _, starter := range starters {
+		if filled[starter.LineupSlot] < capacity[starter.LineupSlot] && slotAccepts(slots, starter.LineupSlot, starter.PlayerPosition) {
+			filled[starter.LineupSlot]++
+			placed = append(placed, starter)
+		} else {
+			misplaced = append(misplaced, starter)
+		}
+	}
+
+	for _, starter := range misplaced {
+		rosterID := starter.RosterID
+		issue := LineupIssue{
+			Kind:     LineupIssueIneligibleStarter,
+			Slot:     starter.LineupSlot,
+			RosterID: &rosterID,
+		}
+		switch {
+		case starter.LineupSlot == "":
+			issue.Detail = fmt.Sprintf("%s starts without a lineup slot", starter.FullName)
+		case capacity[starter.LineupSlot] == 0:
+			issue.Detail = fmt.Sprintf("%s starts in %s, which isn't one of the league's lineup slots", starter.FullName, starter.LineupSlot)
+		case !slotAccepts(slots, starter.LineupSlot, starter.PlayerPosition):
+			issue.Detail = fmt.Sprintf("%s at %s isn't eligible for the %s slot", starter.FullName, starter.PlayerPosition, starter.LineupSlot)
+		default:
+			issue.Kind = LineupIssueTooManyStarters
+			issue.Detail = fmt.Sprintf("%s starts in %s, but the league only has %d %s slots and they're full",
+				starter.FullName, starter.LineupSlot, capacity[starter.LineupSlot], starter.LineupSlot)
+		}
+
+		if slot, ok := openSlotFor(starter, slotNames, slots, capacity, filled); ok && starter.Active() {
+			filled[slot]++
+			issue.Fix = &LineupFix{
+				Description: fmt.Sprintf("Move %s to %s", starter.FullName, slot),
+				Assignments: []LineupAssignment{{RosterID: starter.RosterID, Position: models.RosterPositionStarter, LineupSlot: slot}},
+			}
+		} else {
+			issue.Fix = &LineupFix{
+				Description: fmt.Sprintf("Move %s to the bench", starter.FullName),
+				Assignments: []LineupAssignment{{RosterID: starter.RosterID, Position: models.RosterPositionBench}},
+			}
+		}
+		check.add(issue)
+	}
+
+	for _, starter := range placed {
+		if starter.Active() {
+			continue
+		}
+		issue := inactiveStarterIssue(starter, starter.LineupSlot)
+		if sub, ok := bench.take(func(p LineupPlayer) bool { return slotAccepts(slots, starter.LineupSlot, p.PlayerPosition) }); ok {
+			issue.Fix = &LineupFix{
+				Description: fmt.Sprintf("Start %s at %s in place of %s", sub.FullName, starter.LineupSlot, starter.FullName),
+				Assignments: []LineupAssignment{
+					{RosterID: starter.RosterID, Position: models.RosterPositionBench},
+					{RosterID: sub.RosterID, Position: models.RosterPositionStarter, LineupSlot: starter.LineupSlot},
+				},
+			}
+		}
+		check.add(issue)
+	}
+
+	for _, name := range slotNames {
+		for open := capacity[name] - filled[name]; open > 0; open-- {
+			issue := LineupIssue{
+				Kind:   LineupIssueEmptySlot,
+				Slot:   name,
+				Detail: fmt.Sprintf("a %s slot is empty", name),
+			}
+			if sub, ok := bench.take(func(p LineupPlayer) bool { return slotAccepts(slots, name, p.PlayerPosition) }); ok {
+				issue.Fix = &LineupFix{
+					Description: fmt.Sprintf("Start %s at %s", sub.FullName, name),
+					Assignments: []LineupAssignment{{RosterID: sub.RosterID, Position: models.RosterPositionStarter, LineupSlot: name}},
+				}
+			}
+			check.add(issue)
+		}
+	}
+	return check
+}
+
+// add records an issue and folds its fix into the combined change
+func (c *LineupCheck) add(issue LineupIssue) {
+	c.Issues = append(c.Issues, issue)
+	if issue.Fix != nil {
+		c.Assignments = append(c.Assignments, issue.Fix.Assignments...)
+	}
+}
+
+// Summaries describes each issue in a sentence, with its suggested fix when there is one
+func (c *LineupCheck) Summaries() []string {
+	summaries := make([]string, len(c.Issues))
+	for i, issue := range c.Issues {
+		if issue.Fix != nil {
+			summaries[i] = fmt.Sprintf("%s. Suggested fix: %s.", capitalize(issue.Detail), issue.Fix.Description)
+		} else {
+			summaries[i] = fmt.Sprintf("%s, and no bench player can fill in.", capitalize(issue.Detail))
+		}
+	}
+	return summaries
+}
+
+// inactiveStarterIssue describes a starter who can't play
+func inactiveStarterIssue(starter LineupPlayer, slot string) LineupIssue {
+	rosterID := starter.RosterID
+	detail := fmt.Sprintf("%s starts but is listed as %s", starter.FullName, starter.Status)
+	if slot != "" {
+		detail = fmt.Sprintf("%s starts at %s but is listed as %s", starter.FullName, slot, starter.Status)
+	}
+	return LineupIssue{
+		Kind:     LineupIssueInactiveStarter,
+		Slot:     slot,
+		RosterID: &rosterID,
+		Detail:   detail,
+	}
+}
+
+// openSlotFor returns the first slot, in league order, with room left that accepts the starter
+func openSlotFor(starter LineupPlayer, slotNames []string, slots []models.LineupSlot, capacity, filled map[string]int) (string, bool) {
+	for _, name := range slotNames {
+		if filled[name] < capacity[name] && slotAccepts(slots, name, starter.PlayerPosition) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// benchPool hands out active bench players as substitutes, most started first, each at most once
+type benchPool struct {
+	players []LineupPlayer
+	taken   map[uuid.UUID]bool
+}
+
+func newBenchPool(players []LineupPlayer) *benchPool {
+	var bench []LineupPlayer
+	for _, player := range players {
+		if player.Position == models.RosterPositionBench && player.Active() {
+			bench = append(bench, player)
+		}
+	}
+	sort.SliceStable(bench, func(i, j int) bool {
+		return bench[i].StartedPct > bench[j].StartedPct
+	})
+	return &benchPool{players: bench, taken: make(map[uuid.UUID]bool)}
+}
+
+// take hands out the most started bench player not yet taken who matches eligible
+func (b *benchPool) take(eligible func(LineupPlayer) bool) (LineupPlayer, bool) {
+	for _, player := range b.players {
+		if !b.taken[player.RosterID] && eligible(player) {
+			b.taken[player.RosterID] = true
+			return player, true
+		}
+	}
+	return LineupPlayer{}, false
+}
+
+// capitalize upper-cases the first letter of a sentence
+func capitalize(s string) string {
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		return s
+	}
+	return string(s[0]-'a'+'A') + s[1:]
+}
@@ -1,53 +1,112 @@
 package roster
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/changes"
+	"github.com/mcdev12/dynasty/go/internal/clock"
+	"github.com/mcdev12/dynasty/go/internal/ids"
 	"github.com/mcdev12/dynasty/go/internal/models"
 	"github.com/mcdev12/dynasty/go/internal/roster/db"
+	"github.com/mcdev12/dynasty/go/internal/roster/events"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	userevents "github.com/mcdev12/dynasty/go/internal/users/events"
 	"github.com/sqlc-dev/pqtype"
 )
 
 type Querier interface {
+	AddDraftedPlayerToRoster(ctx context.Context, arg db.AddDraftedPlayerToRosterParams) (int64, error)
+	CanUserSetLineup(ctx context.Context, arg db.CanUserSetLineupParams) (bool, error)
 	CreateRosterPlayer(ctx context.Context, arg db.CreateRosterPlayerParams) (db.RosterPlayer, error)
 	DeletePlayerFromRoster(ctx context.Context, arg db.DeletePlayerFromRosterParams) error
 	DeleteRosterEntry(ctx context.Context, id uuid.UUID) error
+	DeleteTaxiSquadExemption(ctx context.Context, rosterPlayerID uuid.UUID) (int64, error)
 	DeleteTeamRoster(ctx context.Context, fantasyTeamID uuid.UUID) error
 	GetBenchRosterPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]db.RosterPlayer, error)
+	GetDraftLeagueSettings(ctx context.Context, id uuid.UUID) (json.RawMessage, error)
+	GetFantasyTeamLeagueSeason(ctx context.Context, id uuid.UUID) (db.GetFantasyTeamLeagueSeasonRow, error)
+	GetFantasyTeamLeagueSettings(ctx context.Context, id uuid.UUID) (db.GetFantasyTeamLeagueSettingsRow, error)
 	GetPlayerOnRoster(ctx context.Context, arg db.GetPlayerOnRosterParams) (db.RosterPlayer, error)
+	GetPlayersExperience(ctx context.Context, playerIds []uuid.UUID) ([]db.GetPlayersExperienceRow, error)
 	GetRoster(ctx context.Context, id uuid.UUID) (db.RosterPlayer, error)
+	GetRosterPlayerCommissionerID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	GetRosterPlayerLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	GetRosterPlayerManagers(ctx context.Context, id uuid.UUID) (db.GetRosterPlayerManagersRow, error)
+	GetRosterPlayerPositions(ctx context.Context, fantasyTeamID uuid.UUID) ([]db.GetRosterPlayerPositionsRow, error)
 	GetRosterPlayersByAcquisitionType(ctx context.Context, arg db.GetRosterPlayersByAcquisitionTypeParams) ([]db.RosterPlayer, error)
 	GetRosterPlayersByFantasyTeam(ctx context.Context, fantasyTeamID uuid.UUID) ([]db.RosterPlayer, error)
 	GetRosterPlayersByFantasyTeamAndPosition(ctx context.Context, arg db.GetRosterPlayersByFantasyTeamAndPositionParams) ([]db.RosterPlayer, error)
 	GetStartingRosterPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]db.RosterPlayer, error)
+	IsSandboxDraft(ctx context.Context, id uuid.UUID) (bool, error)
+	ListLineupCheckTeams(ctx context.Context) ([]db.ListLineupCheckTeamsRow, error)
+	ListLineupPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]db.ListLineupPlayersRow, error)
+	ListTaxiSquadEntries(ctx context.Context) ([]db.ListTaxiSquadEntriesRow, error)
+	ListTaxiSquadExemptionsByFantasyTeam(ctx context.Context, fantasyTeamID uuid.UUID) ([]db.TaxiSquadExemption, error)
+	ListTaxiSquadViolationsByLeague(ctx context.Context, arg db.ListTaxiSquadViolationsByLeagueParams) ([]db.TaxiSquadViolation, error)
+	UpdateRosterPlayerContract(ctx context.Context, arg db.UpdateRosterPlayerContractParams) (db.RosterPlayer, error)
 	UpdateRosterPlayerKeeperData(ctx context.Context, arg db.UpdateRosterPlayerKeeperDataParams) (db.RosterPlayer, error)
+	UpdateRosterPlayerLineup(ctx context.Context, arg db.UpdateRosterPlayerLineupParams) (db.RosterPlayer, error)
 	UpdateRosterPlayerPosition(ctx context.Context, arg db.UpdateRosterPlayerPositionParams) (db.RosterPlayer, error)
 	UpdateRosterPositionAndKeeperData(ctx context.Context, arg db.UpdateRosterPositionAndKeeperDataParams) (db.RosterPlayer, error)
+	UpsertTaxiSquadExemption(ctx context.Context, arg db.UpsertTaxiSquadExemptionParams) (db.TaxiSquadExemption, error)
 }
 
 type Repository struct {
 	queries Querier
+	sqlDB   *sql.DB
+	changes *changes.TxInserter
+	clock   clock.Clock
 }
 
-func NewRepository(querier Querier) *Repository {
+// NewRepository creates a new roster repository. sqlDB runs batch lineup changes, and roster
+// changes together with the outbox events that describe them, in a transaction. Changes to
+// rosters are recorded with changes, in the transaction that made them, and the events are
+// timestamped with clock.
+func NewRepository(querier Querier, sqlDB *sql.DB, changes *changes.TxInserter, clock clock.Clock) *Repository {
 	return &Repository{
 		queries: querier,
+		sqlDB:   sqlDB,
+		changes: changes,
+		clock:   clock,
 	}
 }
 
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
+// q returns the repository's queries, bound to the transaction in ctx if a service started one
+func (r *Repository) q(ctx context.Context) Querier {
+	return sqlutil.Bind(ctx, r.queries, func(tx *sql.Tx) Querier { return txQueries(tx) })
+}
+
 type CreateRosterPlayerRequest struct {
 	FantasyTeamID   uuid.UUID              `json:"fantasy_team_id"`
 	PlayerID        uuid.UUID              `json:"player_id"`
 	Position        models.RosterPosition  `json:"position"`
 	AcquisitionType models.AcquisitionType `json:"acquisition_type"`
 	KeeperData      json.RawMessage        `json:"keeper_data"`
+	Salary          *int                   `json:"salary,omitempty"`
+	ContractYears   *int                   `json:"contract_years,omitempty"`
+}
+
+// RosterContract is the contract terms of a roster entry
+type RosterContract struct {
+	Salary          *int `json:"salary,omitempty"`
+	ContractYears   *int `json:"contract_years,omitempty"`
+	FranchiseTagged bool `json:"franchise_tagged"`
 }
 
 type UpdateRosterPositionRequest struct {
-	Position models.RosterPosition `json:"position"`
+	Position  models.RosterPosition `json:"position"`
+	UpdatedBy *uuid.UUID            `json:"updated_by,omitempty"` // nil for trusted callers, who may move any player
 }
 
 type UpdateRosterKeeperDataRequest struct {
@@ -59,29 +118,144 @@ type UpdateRosterPositionAndKeeperDataRequest struct {
 	KeeperData json.RawMessage       `json:"keeper_data"`
 }
 
+// LineupAssignment moves one roster entry to a position as part of a batch lineup change.
+// Starters name the league lineup slot they fill; LineupSlot is ignored for other positions.
+type LineupAssignment struct {
+	RosterID   uuid.UUID             `json:"roster_id"`
+	Position   models.RosterPosition `json:"position"`
+	LineupSlot string                `json:"lineup_slot"`
+}
+
+// TaxiSquadEntry is a player on a taxi squad, with the rules of its league and what they're checked against
+type TaxiSquadEntry struct {
+	RosterID      uuid.UUID
+	FantasyTeamID uuid.UUID
+	PlayerID      uuid.UUID
+	LeagueID      uuid.UUID
+	Rules         models.TaxiSquadRules
+	Experience    *int
+	Exempt        bool
+}
+
+// LineupCheckTeam is a team checked ahead of its league's lineup lock, with its league's lineup
+// rules and its owner's contact details
+type LineupCheckTeam struct {
+	FantasyTeamID uuid.UUID
+	TeamName      string
+	LeagueID      uuid.UUID
+	LeagueName    string
+	BestBall      bool
+	Slots         []models.LineupSlot
+	Lock          models.LineupLock
+	OwnerID       uuid.UUID
+	Username      string
+	Email         string
+}
+
 type TransferPlayerRequest struct {
 	FantasyTeamID   uuid.UUID              `json:"fantasy_team_id"`
 	AcquisitionType models.AcquisitionType `json:"acquisition_type"`
 	KeeperData      json.RawMessage        `json:"keeper_data"`
 }
 
+// CreateRosterPlayer adds a player to a roster and records a RosterPlayerAdded event. Drafted
+// players are left to the draft's own PickMade events.
 func (r *Repository) CreateRosterPlayer(ctx context.Context, req CreateRosterPlayerRequest) (*models.Roster, error) {
-	roster, err := r.queries.CreateRosterPlayer(ctx, db.CreateRosterPlayerParams{
-		FantasyTeamID:   req.FantasyTeamID,
-		PlayerID:        req.PlayerID,
-		Position:        db.RosterPositionEnum(req.Position),
-		AcquisitionType: db.AcquisitionTypeEnum(req.AcquisitionType),
-		KeeperData:      pqtype.NullRawMessage{RawMessage: req.KeeperData, Valid: len(req.KeeperData) > 0},
+	var created *models.Roster
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			roster, err := q.CreateRosterPlayer(ctx, db.CreateRosterPlayerParams{
+				FantasyTeamID:   req.FantasyTeamID,
+				PlayerID:        req.PlayerID,
+				Position:        db.RosterPositionEnum(req.Position),
+				AcquisitionType: db.AcquisitionTypeEnum(req.AcquisitionType),
+				KeeperData:      pqtype.NullRawMessage{RawMessage: req.KeeperData, Valid: len(req.KeeperData) > 0},
+				Salary:          sqlutil.ToSqlInt32(req.Salary),
+				ContractYears:   sqlutil.ToSqlInt32(req.ContractYears),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create roster entry: %w", err)
+			}
+			created = r.dbRosterToModel(roster)
+
+			if created.AcquisitionType == models.AcquisitionTypeDraft {
+				return nil
+			}
+			return insertRosterEvent(ctx, q, created.FantasyTeamID, events.EventTypeRosterPlayerAdded, events.RosterPlayerAddedPayload{
+				RosterID:        created.ID.String(),
+				FantasyTeamID:   created.FantasyTeamID.String(),
+				PlayerID:        created.PlayerID.String(),
+				AcquisitionType: string(created.AcquisitionType),
+				AcquiredAt:      created.AcquiredAt,
+			})
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.RosterChanged(created.FantasyTeamID, changes.ActionPlayerAdded))
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create roster entry: %w", err)
+		return nil, err
 	}
 
-	return r.dbRosterToModel(roster), nil
+	return created, nil
+}
+
+// AddDraftedPlayerToRoster adds a drafted player to a team's bench and drops them from any other
+// team in the league, as an expansion draft takes players from existing rosters. Each drop
+// records a RosterPlayerDropped event. salary is nil outside salary cap leagues.
+func (r *Repository) AddDraftedPlayerToRoster(ctx context.Context, fantasyTeamID, playerID uuid.UUID, acquiredAt time.Time, salary *int) (bool, error) {
+	var added bool
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		var rosterChanges []changes.Change
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			rows, err := q.AddDraftedPlayerToRoster(ctx, db.AddDraftedPlayerToRosterParams{
+				FantasyTeamID: fantasyTeamID,
+				PlayerID:      playerID,
+				AcquiredAt:    acquiredAt,
+				Salary:        sqlutil.ToSqlInt32(salary),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add drafted player to roster: %w", err)
+			}
+			added = rows > 0
+			if !added {
+				return nil
+			}
+			rosterChanges = append(rosterChanges, changes.RosterChanged(fantasyTeamID, changes.ActionPlayerAdded))
+
+			previous, err := q.ListOtherLeagueRosterEntries(ctx, db.ListOtherLeagueRosterEntriesParams{
+				FantasyTeamID: fantasyTeamID,
+				PlayerID:      playerID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list player's other roster entries: %w", err)
+			}
+			for _, entry := range previous {
+				if err := q.DeleteRosterEntry(ctx, entry.ID); err != nil {
+					return fmt.Errorf("failed to delete roster entry: %w", err)
+				}
+				if err := r.insertDroppedEvent(ctx, q, r.dbRosterToModel(entry)); err != nil {
+					return err
+				}
+				rosterChanges = append(rosterChanges, changes.RosterChanged(entry.FantasyTeamID, changes.ActionPlayerDropped))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, rosterChanges...)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return added, nil
 }
 
 func (r *Repository) GetRoster(ctx context.Context, id uuid.UUID) (*models.Roster, error) {
-	roster, err := r.queries.GetRoster(ctx, id)
+	roster, err := r.q(ctx).GetRoster(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get roster entry: %w", err)
 	}
@@ -89,8 +263,440 @@ func (r *Repository) GetRoster(ctx context.Context, id uuid.UUID) (*models.Roste
 	return r.dbRosterToModel(roster), nil
 }
 
+func (r *Repository) GetRosterPlayerLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	leagueID, err := r.q(ctx).GetRosterPlayerLeagueID(ctx, id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get roster entry league: %w", err)
+	}
+
+	return leagueID, nil
+}
+
+func (r *Repository) IsBestBallTeam(ctx context.Context, fantasyTeamID uuid.UUID) (bool, error) {
+	row, err := r.q(ctx).GetFantasyTeamLeagueSettings(ctx, fantasyTeamID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get league settings for fantasy team: %w", err)
+	}
+
+	var settings map[string]interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return false, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	return models.SettingsBestBall(settings), nil
+}
+
+// GetLineupSlots returns the starting lineup slots of the league a fantasy team plays in, or
+// none when the league doesn't configure its lineup
+func (r *Repository) GetLineupSlots(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.LineupSlot, error) {
+	row, err := r.q(ctx).GetFantasyTeamLeagueSettings(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league settings for fantasy team: %w", err)
+	}
+
+	var settings map[string]interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	return models.SettingsLineupSlots(row.SportID, settings), nil
+}
+
+// GetRosterPlayerPositions returns the position each player on a team's roster is listed at,
+// keyed by roster entry ID
+func (r *Repository) GetRosterPlayerPositions(ctx context.Context, fantasyTeamID uuid.UUID) (map[uuid.UUID]string, error) {
+	rows, err := r.q(ctx).GetRosterPlayerPositions(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster player positions: %w", err)
+	}
+
+	positions := make(map[uuid.UUID]string, len(rows))
+	for _, row := range rows {
+		positions[row.ID] = row.Position
+	}
+	return positions, nil
+}
+
+// GetLineupLock returns when lineups lock in the league a fantasy team plays in
+func (r *Repository) GetLineupLock(ctx context.Context, fantasyTeamID uuid.UUID) (models.LineupLock, error) {
+	row, err := r.q(ctx).GetFantasyTeamLeagueSettings(ctx, fantasyTeamID)
+	if err != nil {
+		return models.LineupLock{}, fmt.Errorf("failed to get league settings for fantasy team: %w", err)
+	}
+
+	var settings map[string]interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return models.LineupLock{}, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	return models.SettingsLineupLock(settings), nil
+}
+
+// ListLineupPlayers returns every player on a team's roster with their lineup position, NFL
+// status and how often they're started across the platform, in the order they joined the team
+func (r *Repository) ListLineupPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]LineupPlayer, error) {
+	rows, err := r.q(ctx).ListLineupPlayers(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lineup players: %w", err)
+	}
+
+	players := make([]LineupPlayer, len(rows))
+	for i, row := range rows {
+		players[i] = LineupPlayer{
+			RosterID:       row.ID,
+			PlayerID:       row.PlayerID,
+			FullName:       row.FullName,
+			Position:       models.RosterPosition(row.RosterPosition),
+			LineupSlot:     row.LineupSlot.String,
+			PlayerPosition: row.PlayerPosition,
+			Status:         row.Status,
+			StartedPct:     row.StartedPct,
+		}
+	}
+	return players, nil
+}
+
+// ListLineupCheckTeams returns every team in a league that is in season, ordered by league
+func (r *Repository) ListLineupCheckTeams(ctx context.Context) ([]LineupCheckTeam, error) {
+	rows, err := r.q(ctx).ListLineupCheckTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams to check lineups of: %w", err)
+	}
+
+	teams := make([]LineupCheckTeam, len(rows))
+	for i, row := range rows {
+		var settings map[string]interface{}
+		if len(row.LeagueSettings) > 0 {
+			if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal league settings: %w", err)
+			}
+		}
+
+		teams[i] = LineupCheckTeam{
+			FantasyTeamID: row.ID,
+			TeamName:      row.TeamName,
+			LeagueID:      row.LeagueID,
+			LeagueName:    row.LeagueName,
+			BestBall:      models.SettingsBestBall(settings),
+			Slots:         models.SettingsLineupSlots(row.SportID, settings),
+			Lock:          models.SettingsLineupLock(settings),
+			OwnerID:       row.OwnerID,
+			Username:      row.Username,
+			Email:         row.Email,
+		}
+	}
+	return teams, nil
+}
+
+// QueueLineupAlert queues a LineupIssues notification to a team's owner about the problems a
+// lineup check found, unless they were already alerted ahead of the same lock. It reports
+// whether a notification was queued.
+func (r *Repository) QueueLineupAlert(ctx context.Context, team LineupCheckTeam, check *LineupCheck) (bool, error) {
+	queued := false
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		inserted, err := q.InsertLineupAlert(ctx, db.InsertLineupAlertParams{
+			FantasyTeamID: team.FantasyTeamID,
+			LocksAt:       check.LocksAt,
+			Issues:        int32(len(check.Issues)),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record lineup alert: %w", err)
+		}
+		if inserted == 0 {
+			return nil
+		}
+
+		payload, err := json.Marshal(userevents.LineupIssuesPayload{
+			UserID:        team.OwnerID.String(),
+			Username:      team.Username,
+			Email:         team.Email,
+			LeagueID:      team.LeagueID.String(),
+			LeagueName:    team.LeagueName,
+			FantasyTeamID: team.FantasyTeamID.String(),
+			TeamName:      team.TeamName,
+			Issues:        check.Summaries(),
+			LocksAt:       check.LocksAt,
+			Timezone:      team.Lock.Clock.Location.String(),
+			Locale:        team.Lock.Clock.Locale.String(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal LineupIssues notification: %w", err)
+		}
+
+		if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+			ID:        ids.New(),
+			UserID:    team.OwnerID,
+			EventType: userevents.LineupIssues,
+			Payload:   payload,
+		}); err != nil {
+			return fmt.Errorf("failed to queue LineupIssues notification: %w", err)
+		}
+		queued = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return queued, nil
+}
+
+// GetTaxiSquadRules returns the taxi squad rules of the league a fantasy team plays in
+func (r *Repository) GetTaxiSquadRules(ctx context.Context, fantasyTeamID uuid.UUID) (models.TaxiSquadRules, error) {
+	row, err := r.q(ctx).GetFantasyTeamLeagueSeason(ctx, fantasyTeamID)
+	if err != nil {
+		return models.TaxiSquadRules{}, fmt.Errorf("failed to get league settings for fantasy team: %w", err)
+	}
+
+	return taxiSquadRules(row.LeagueSettings, row.Season)
+}
+
+// GetPlayersExperience returns the years of pro experience of the given players, leaving out
+// players whose experience isn't known
+func (r *Repository) GetPlayersExperience(ctx context.Context, playerIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	rows, err := r.q(ctx).GetPlayersExperience(ctx, playerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player experience: %w", err)
+	}
+
+	experience := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		experience[row.PlayerID] = int(row.Experience.Int16)
+	}
+	return experience, nil
+}
+
+// GetTaxiSquadExemptions returns the taxi squad exemptions of a team's roster entries, keyed by roster entry ID
+func (r *Repository) GetTaxiSquadExemptions(ctx context.Context, fantasyTeamID uuid.UUID) (map[uuid.UUID]models.TaxiSquadExemption, error) {
+	rows, err := r.q(ctx).ListTaxiSquadExemptionsByFantasyTeam(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list taxi squad exemptions: %w", err)
+	}
+
+	exemptions := make(map[uuid.UUID]models.TaxiSquadExemption, len(rows))
+	for _, row := range rows {
+		exemptions[row.RosterPlayerID] = *dbTaxiSquadExemptionToModel(row)
+	}
+	return exemptions, nil
+}
+
+// GetSalaryCapRules returns the salary cap rules of the league a fantasy team plays in
+func (r *Repository) GetSalaryCapRules(ctx context.Context, fantasyTeamID uuid.UUID) (models.SalaryCapRules, error) {
+	row, err := r.q(ctx).GetFantasyTeamLeagueSettings(ctx, fantasyTeamID)
+	if err != nil {
+		return models.SalaryCapRules{}, fmt.Errorf("failed to get league settings for fantasy team: %w", err)
+	}
+
+	var settings map[string]interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return models.SalaryCapRules{}, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	return models.SettingsSalaryCapRules(settings), nil
+}
+
+// IsSandboxDraft reports whether a draft is a sandbox rehearsing another draft
+func (r *Repository) IsSandboxDraft(ctx context.Context, draftID uuid.UUID) (bool, error) {
+	sandbox, err := r.q(ctx).IsSandboxDraft(ctx, draftID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for sandbox draft: %w", err)
+	}
+	return sandbox, nil
+}
+
+// GetDraftSalaryCapRules returns the salary cap rules a draft runs under, which stay as they
+// were when it started
+func (r *Repository) GetDraftSalaryCapRules(ctx context.Context, draftID uuid.UUID) (models.SalaryCapRules, error) {
+	raw, err := r.q(ctx).GetDraftLeagueSettings(ctx, draftID)
+	if err != nil {
+		return models.SalaryCapRules{}, fmt.Errorf("failed to get league settings for draft: %w", err)
+	}
+
+	var settings map[string]interface{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &settings); err != nil {
+			return models.SalaryCapRules{}, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	return models.SettingsSalaryCapRules(settings), nil
+}
+
+// GetRosterPlayerManagers returns the owner of the team holding a roster entry and the
+// commissioner of its league
+func (r *Repository) GetRosterPlayerManagers(ctx context.Context, id uuid.UUID) (uuid.UUID, uuid.UUID, error) {
+	row, err := r.q(ctx).GetRosterPlayerManagers(ctx, id)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to get roster entry managers: %w", err)
+	}
+
+	return row.OwnerID, row.CommissionerID, nil
+}
+
+// UpdateRosterPlayerContract replaces the contract terms of a roster entry
+func (r *Repository) UpdateRosterPlayerContract(ctx context.Context, id uuid.UUID, contract RosterContract) (*models.Roster, error) {
+	var row db.RosterPlayer
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		row, err = r.q(ctx).UpdateRosterPlayerContract(ctx, db.UpdateRosterPlayerContractParams{
+			ID:              id,
+			Salary:          sqlutil.ToSqlInt32(contract.Salary),
+			ContractYears:   sqlutil.ToSqlInt32(contract.ContractYears),
+			FranchiseTagged: contract.FranchiseTagged,
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.RosterChanged(row.FantasyTeamID, changes.ActionContractChanged))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update roster player contract: %w", err)
+	}
+
+	return r.dbRosterToModel(row), nil
+}
+
+func (r *Repository) GetRosterPlayerCommissionerID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	commissionerID, err := r.q(ctx).GetRosterPlayerCommissionerID(ctx, id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get roster entry commissioner: %w", err)
+	}
+
+	return commissionerID, nil
+}
+
+// GrantTaxiSquadExemption exempts a roster entry from the taxi squad rules, replacing any
+// earlier exemption of it
+func (r *Repository) GrantTaxiSquadExemption(ctx context.Context, id, grantedBy uuid.UUID, reason string) (*models.TaxiSquadExemption, error) {
+	row, err := r.q(ctx).UpsertTaxiSquadExemption(ctx, db.UpsertTaxiSquadExemptionParams{
+		RosterPlayerID: id,
+		GrantedBy:      uuid.NullUUID{UUID: grantedBy, Valid: true},
+		Reason:         reason,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant taxi squad exemption: %w", err)
+	}
+
+	return dbTaxiSquadExemptionToModel(row), nil
+}
+
+// RevokeTaxiSquadExemption removes a roster entry's taxi squad exemption, reporting whether it had one
+func (r *Repository) RevokeTaxiSquadExemption(ctx context.Context, id uuid.UUID) (bool, error) {
+	deleted, err := r.q(ctx).DeleteTaxiSquadExemption(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke taxi squad exemption: %w", err)
+	}
+
+	return deleted > 0, nil
+}
+
+// ListTaxiSquadEntries returns every player on a taxi squad in a running league that sets taxi
+// squad limits, ordered by league and team
+func (r *Repository) ListTaxiSquadEntries(ctx context.Context) ([]TaxiSquadEntry, error) {
+	rows, err := r.q(ctx).ListTaxiSquadEntries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list taxi squad entries: %w", err)
+	}
+
+	rulesByLeague := make(map[uuid.UUID]models.TaxiSquadRules)
+	entries := make([]TaxiSquadEntry, len(rows))
+	for i, row := range rows {
+		rules, ok := rulesByLeague[row.LeagueID]
+		if !ok {
+			rules, err = taxiSquadRules(row.LeagueSettings, row.Season)
+			if err != nil {
+				return nil, err
+			}
+			rulesByLeague[row.LeagueID] = rules
+		}
+
+		entries[i] = TaxiSquadEntry{
+			RosterID:      row.ID,
+			FantasyTeamID: row.FantasyTeamID,
+			PlayerID:      row.PlayerID,
+			LeagueID:      row.LeagueID,
+			Rules:         rules,
+			Exempt:        row.Exempt,
+		}
+		if row.Experience.Valid {
+			experience := int(row.Experience.Int16)
+			entries[i].Experience = &experience
+		}
+	}
+	return entries, nil
+}
+
+// RecordTaxiSquadViolations makes violations the open taxi squad violations: each is recorded,
+// or kept open if it already was, and every other open violation is resolved. It returns how
+// many violations were resolved.
+func (r *Repository) RecordTaxiSquadViolations(ctx context.Context, violations []models.TaxiSquadViolation) (int64, error) {
+	var resolved int64
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		open := make([]uuid.UUID, 0, len(violations))
+		for _, violation := range violations {
+			id, err := q.UpsertTaxiSquadViolation(ctx, db.UpsertTaxiSquadViolationParams{
+				LeagueID:       violation.LeagueID,
+				FantasyTeamID:  violation.FantasyTeamID,
+				RosterPlayerID: sqlutil.ToNullUUID(violation.RosterID),
+				Reason:         string(violation.Reason),
+				Detail:         violation.Detail,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record taxi squad violation: %w", err)
+			}
+			open = append(open, id)
+		}
+
+		var err error
+		resolved, err = q.ResolveTaxiSquadViolations(ctx, open)
+		if err != nil {
+			return fmt.Errorf("failed to resolve taxi squad violations: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resolved, nil
+}
+
+// ListTaxiSquadViolations returns a league's open taxi squad violations, newest first, along
+// with resolved ones when includeResolved is set
+func (r *Repository) ListTaxiSquadViolations(ctx context.Context, leagueID uuid.UUID, includeResolved bool) ([]models.TaxiSquadViolation, error) {
+	rows, err := r.q(ctx).ListTaxiSquadViolationsByLeague(ctx, db.ListTaxiSquadViolationsByLeagueParams{
+		LeagueID:        leagueID,
+		IncludeResolved: includeResolved,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list taxi squad violations: %w", err)
+	}
+
+	violations := make([]models.TaxiSquadViolation, len(rows))
+	for i, row := range rows {
+		violations[i] = models.TaxiSquadViolation{
+			ID:            row.ID,
+			LeagueID:      row.LeagueID,
+			FantasyTeamID: row.FantasyTeamID,
+			RosterID:      sqlutil.FromNullUUID(row.RosterPlayerID),
+			Reason:        models.TaxiSquadViolationReason(row.Reason),
+			Detail:        row.Detail,
+			DetectedAt:    row.DetectedAt,
+			ResolvedAt:    sqlutil.FromSqlTime(row.ResolvedAt),
+		}
+	}
+	return violations, nil
+}
+
 func (r *Repository) GetRosterPlayersByFantasyTeam(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.Roster, error) {
-	rosters, err := r.queries.GetRosterPlayersByFantasyTeam(ctx, fantasyTeamID)
+	rosters, err := r.q(ctx).GetRosterPlayersByFantasyTeam(ctx, fantasyTeamID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get roster players by fantasy team: %w", err)
 	}
@@ -99,7 +705,7 @@ func (r *Repository) GetRosterPlayersByFantasyTeam(ctx context.Context, fantasyT
 }
 
 func (r *Repository) GetRosterPlayersByFantasyTeamAndPosition(ctx context.Context, fantasyTeamID uuid.UUID, position models.RosterPosition) ([]models.Roster, error) {
-	rosters, err := r.queries.GetRosterPlayersByFantasyTeamAndPosition(ctx, db.GetRosterPlayersByFantasyTeamAndPositionParams{
+	rosters, err := r.q(ctx).GetRosterPlayersByFantasyTeamAndPosition(ctx, db.GetRosterPlayersByFantasyTeamAndPositionParams{
 		FantasyTeamID: fantasyTeamID,
 		Position:      db.RosterPositionEnum(position),
 	})
@@ -111,7 +717,7 @@ func (r *Repository) GetRosterPlayersByFantasyTeamAndPosition(ctx context.Contex
 }
 
 func (r *Repository) GetPlayerOnRoster(ctx context.Context, fantasyTeamID, playerID uuid.UUID) (*models.Roster, error) {
-	roster, err := r.queries.GetPlayerOnRoster(ctx, db.GetPlayerOnRosterParams{
+	roster, err := r.q(ctx).GetPlayerOnRoster(ctx, db.GetPlayerOnRosterParams{
 		FantasyTeamID: fantasyTeamID,
 		PlayerID:      playerID,
 	})
@@ -123,7 +729,7 @@ func (r *Repository) GetPlayerOnRoster(ctx context.Context, fantasyTeamID, playe
 }
 
 func (r *Repository) GetStartingRosterPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.Roster, error) {
-	rosters, err := r.queries.GetStartingRosterPlayers(ctx, fantasyTeamID)
+	rosters, err := r.q(ctx).GetStartingRosterPlayers(ctx, fantasyTeamID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get starting roster players: %w", err)
 	}
@@ -132,7 +738,7 @@ func (r *Repository) GetStartingRosterPlayers(ctx context.Context, fantasyTeamID
 }
 
 func (r *Repository) GetBenchRosterPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.Roster, error) {
-	rosters, err := r.queries.GetBenchRosterPlayers(ctx, fantasyTeamID)
+	rosters, err := r.q(ctx).GetBenchRosterPlayers(ctx, fantasyTeamID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bench roster players: %w", err)
 	}
@@ -141,7 +747,7 @@ func (r *Repository) GetBenchRosterPlayers(ctx context.Context, fantasyTeamID uu
 }
 
 func (r *Repository) GetRosterPlayersByAcquisitionType(ctx context.Context, fantasyTeamID uuid.UUID, acquisitionType models.AcquisitionType) ([]models.Roster, error) {
-	rosters, err := r.queries.GetRosterPlayersByAcquisitionType(ctx, db.GetRosterPlayersByAcquisitionTypeParams{
+	rosters, err := r.q(ctx).GetRosterPlayersByAcquisitionType(ctx, db.GetRosterPlayersByAcquisitionTypeParams{
 		FantasyTeamID:   fantasyTeamID,
 		AcquisitionType: db.AcquisitionTypeEnum(acquisitionType),
 	})
@@ -152,65 +758,351 @@ func (r *Repository) GetRosterPlayersByAcquisitionType(ctx context.Context, fant
 	return r.dbRostersToModels(rosters), nil
 }
 
+// UpdateRosterPlayerPosition moves a roster entry, logging the move in its team's delegate action
+// log when req.UpdatedBy made it as the team's delegate
 func (r *Repository) UpdateRosterPlayerPosition(ctx context.Context, id uuid.UUID, req UpdateRosterPositionRequest) (*models.Roster, error) {
-	roster, err := r.queries.UpdateRosterPlayerPosition(ctx, db.UpdateRosterPlayerPositionParams{
-		ID:       id,
-		Position: db.RosterPositionEnum(req.Position),
+	var updated *models.Roster
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			roster, err := q.UpdateRosterPlayerPosition(ctx, db.UpdateRosterPlayerPositionParams{
+				ID:       id,
+				Position: db.RosterPositionEnum(req.Position),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update roster player position: %w", err)
+			}
+			updated = r.dbRosterToModel(roster)
+
+			return recordDelegateAction(ctx, q, roster.FantasyTeamID, req.UpdatedBy, models.DelegateActionLineupUpdated, map[string]any{
+				"assignments": []LineupAssignment{{RosterID: id, Position: req.Position}},
+			})
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.RosterChanged(updated.FantasyTeamID, changes.ActionLineupChanged))
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update roster player position: %w", err)
+		return nil, err
 	}
 
-	return r.dbRosterToModel(roster), nil
+	return updated, nil
+}
+
+// BatchUpdateLineup applies assignments to a team's roster in one transaction. check sees the
+// team's roster before and after the change, under a lock on the team's lineup, and vetoes it by
+// returning an error. The change is logged in the team's delegate action log when updatedBy made
+// it as the team's delegate. The team's full roster after the change is returned.
+func (r *Repository) BatchUpdateLineup(ctx context.Context, fantasyTeamID uuid.UUID, assignments []LineupAssignment, updatedBy *uuid.UUID, check func(current, proposed []models.Roster) error) ([]models.Roster, error) {
+	var updated []models.Roster
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassFantasyTeamRoster, fantasyTeamID, txQueries, func(q *db.Queries) error {
+			rows, err := q.GetRosterPlayersByFantasyTeam(ctx, fantasyTeamID)
+			if err != nil {
+				return fmt.Errorf("failed to get roster players by fantasy team: %w", err)
+			}
+			current := r.dbRostersToModels(rows)
+
+			byID := make(map[uuid.UUID]int, len(current))
+			for i, roster := range current {
+				byID[roster.ID] = i
+			}
+			proposed := make([]models.Roster, len(current))
+			copy(proposed, current)
+			for _, assignment := range assignments {
+				i, ok := byID[assignment.RosterID]
+				if !ok {
+					return fmt.Errorf("%w: %s", ErrRosterEntryNotOnTeam, assignment.RosterID)
+				}
+				proposed[i].Position = assignment.Position
+				proposed[i].LineupSlot = ""
+				if assignment.Position == models.RosterPositionStarter {
+					proposed[i].LineupSlot = assignment.LineupSlot
+				}
+			}
+
+			if err := check(current, proposed); err != nil {
+				return err
+			}
+
+			for i, roster := range proposed {
+				if roster.Position == current[i].Position && roster.LineupSlot == current[i].LineupSlot {
+					continue
+				}
+				row, err := q.UpdateRosterPlayerLineup(ctx, db.UpdateRosterPlayerLineupParams{
+					ID:         roster.ID,
+					Position:   db.RosterPositionEnum(roster.Position),
+					LineupSlot: sql.NullString{String: roster.LineupSlot, Valid: roster.LineupSlot != ""},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to update roster player lineup: %w", err)
+				}
+				proposed[i] = *r.dbRosterToModel(row)
+			}
+			updated = proposed
+
+			return recordDelegateAction(ctx, q, fantasyTeamID, updatedBy, models.DelegateActionLineupUpdated, map[string]any{
+				"assignments": assignments,
+			})
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.RosterChanged(fantasyTeamID, changes.ActionLineupChanged))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// CanUserSetLineup reports whether a user may set a team's lineup: its owner, its active delegate
+// or its league's commissioner
+func (r *Repository) CanUserSetLineup(ctx context.Context, fantasyTeamID, userID uuid.UUID) (bool, error) {
+	canSet, err := r.q(ctx).CanUserSetLineup(ctx, db.CanUserSetLineupParams{
+		FantasyTeamID: fantasyTeamID,
+		UserID:        userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check lineup permission: %w", err)
+	}
+	return canSet, nil
+}
+
+// recordDelegateAction logs a change to a team in its delegate action log when the user who made
+// it made it as the team's delegate. Changes by anyone else, or by trusted callers, aren't logged.
+func recordDelegateAction(ctx context.Context, q *db.Queries, fantasyTeamID uuid.UUID, userID *uuid.UUID, action string, details any) error {
+	if userID == nil {
+		return nil
+	}
+	payload, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s delegate action: %w", action, err)
+	}
+	if err := q.InsertDelegateAction(ctx, db.InsertDelegateActionParams{
+		ID:            ids.New(),
+		Action:        action,
+		Details:       payload,
+		FantasyTeamID: fantasyTeamID,
+		UserID:        *userID,
+	}); err != nil {
+		return fmt.Errorf("failed to record %s delegate action: %w", action, err)
+	}
+	return nil
 }
 
+// UpdateRosterPlayerKeeperData replaces a roster entry's keeper data, recording a
+// KeeperDesignated event when the player had none before
 func (r *Repository) UpdateRosterPlayerKeeperData(ctx context.Context, id uuid.UUID, req UpdateRosterKeeperDataRequest) (*models.Roster, error) {
-	roster, err := r.queries.UpdateRosterPlayerKeeperData(ctx, db.UpdateRosterPlayerKeeperDataParams{
-		ID:         id,
-		KeeperData: pqtype.NullRawMessage{RawMessage: req.KeeperData, Valid: len(req.KeeperData) > 0},
+	var updated *models.Roster
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			previous, err := q.GetRoster(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to get roster entry: %w", err)
+			}
+
+			roster, err := q.UpdateRosterPlayerKeeperData(ctx, db.UpdateRosterPlayerKeeperDataParams{
+				ID:         id,
+				KeeperData: pqtype.NullRawMessage{RawMessage: req.KeeperData, Valid: len(req.KeeperData) > 0},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update roster player keeper data: %w", err)
+			}
+			updated = r.dbRosterToModel(roster)
+
+			return r.insertKeeperDesignatedEvent(ctx, q, r.dbRosterToModel(previous), updated)
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.RosterChanged(updated.FantasyTeamID, changes.ActionKeeperChanged))
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update roster player keeper data: %w", err)
+		return nil, err
 	}
 
-	return r.dbRosterToModel(roster), nil
+	return updated, nil
 }
 
+// UpdateRosterPositionAndKeeperData moves a roster entry and replaces its keeper data,
+// recording a KeeperDesignated event when the player had none before
 func (r *Repository) UpdateRosterPositionAndKeeperData(ctx context.Context, id uuid.UUID, req UpdateRosterPositionAndKeeperDataRequest) (*models.Roster, error) {
-	roster, err := r.queries.UpdateRosterPositionAndKeeperData(ctx, db.UpdateRosterPositionAndKeeperDataParams{
-		ID:         id,
-		Position:   db.RosterPositionEnum(req.Position),
-		KeeperData: pqtype.NullRawMessage{RawMessage: req.KeeperData, Valid: len(req.KeeperData) > 0},
+	var updated *models.Roster
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			previous, err := q.GetRoster(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to get roster entry: %w", err)
+			}
+
+			roster, err := q.UpdateRosterPositionAndKeeperData(ctx, db.UpdateRosterPositionAndKeeperDataParams{
+				ID:         id,
+				Position:   db.RosterPositionEnum(req.Position),
+				KeeperData: pqtype.NullRawMessage{RawMessage: req.KeeperData, Valid: len(req.KeeperData) > 0},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update roster position and keeper data: %w", err)
+			}
+			updated = r.dbRosterToModel(roster)
+
+			return r.insertKeeperDesignatedEvent(ctx, q, r.dbRosterToModel(previous), updated)
+		})
+		if err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.RosterChanged(updated.FantasyTeamID, changes.ActionKeeperChanged))
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update roster position and keeper data: %w", err)
+		return nil, err
 	}
 
-	return r.dbRosterToModel(roster), nil
+	return updated, nil
 }
 
+// DeleteRosterEntry removes a roster entry and records a RosterPlayerDropped event.
+// Deleting an entry that no longer exists is a no-op.
 func (r *Repository) DeleteRosterEntry(ctx context.Context, id uuid.UUID) error {
-	if err := r.queries.DeleteRosterEntry(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete roster entry: %w", err)
+	return r.changes.WithTx(ctx, func(ctx context.Context) error {
+		var dropped *models.Roster
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			roster, err := q.GetRoster(ctx, id)
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get roster entry: %w", err)
+			}
+
+			if err := q.DeleteRosterEntry(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete roster entry: %w", err)
+			}
+			dropped = r.dbRosterToModel(roster)
+			return r.insertDroppedEvent(ctx, q, dropped)
+		})
+		if err != nil || dropped == nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.RosterChanged(dropped.FantasyTeamID, changes.ActionPlayerDropped))
+	})
+}
+
+// DeletePlayerFromRoster drops a player from a team's roster and records a RosterPlayerDropped
+// event. Dropping a player who is not on the roster is a no-op.
+func (r *Repository) DeletePlayerFromRoster(ctx context.Context, fantasyTeamID, playerID uuid.UUID) error {
+	return r.changes.WithTx(ctx, func(ctx context.Context) error {
+		var dropped bool
+		err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+			roster, err := q.GetPlayerOnRoster(ctx, db.GetPlayerOnRosterParams{
+				FantasyTeamID: fantasyTeamID,
+				PlayerID:      playerID,
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get player on roster: %w", err)
+			}
+
+			if err := q.DeletePlayerFromRoster(ctx, db.DeletePlayerFromRosterParams{
+				FantasyTeamID: fantasyTeamID,
+				PlayerID:      playerID,
+			}); err != nil {
+				return fmt.Errorf("failed to delete player from roster: %w", err)
+			}
+			dropped = true
+			return r.insertDroppedEvent(ctx, q, r.dbRosterToModel(roster))
+		})
+		if err != nil || !dropped {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.RosterChanged(fantasyTeamID, changes.ActionPlayerDropped))
+	})
+}
+
+// DeleteTeamRoster clears a team's roster. Clearing a roster is an administrative reset, so
+// no RosterPlayerDropped events are recorded.
+func (r *Repository) DeleteTeamRoster(ctx context.Context, fantasyTeamID uuid.UUID) error {
+	err := r.changes.WithTx(ctx, func(ctx context.Context) error {
+		if err := r.q(ctx).DeleteTeamRoster(ctx, fantasyTeamID); err != nil {
+			return err
+		}
+		return r.changes.Insert(ctx, changes.RosterChanged(fantasyTeamID, changes.ActionRosterCleared))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete team roster: %w", err)
 	}
 	return nil
 }
 
-func (r *Repository) DeletePlayerFromRoster(ctx context.Context, fantasyTeamID, playerID uuid.UUID) error {
-	if err := r.queries.DeletePlayerFromRoster(ctx, db.DeletePlayerFromRosterParams{
+// insertKeeperDesignatedEvent records a KeeperDesignated event when updated gave a player
+// keeper data it did not have before
+func (r *Repository) insertKeeperDesignatedEvent(ctx context.Context, q *db.Queries, previous, updated *models.Roster) error {
+	if hasKeeperData(previous.KeeperData) || !hasKeeperData(updated.KeeperData) {
+		return nil
+	}
+	return insertRosterEvent(ctx, q, updated.FantasyTeamID, events.EventTypeKeeperDesignated, events.KeeperDesignatedPayload{
+		RosterID:      updated.ID.String(),
+		FantasyTeamID: updated.FantasyTeamID.String(),
+		PlayerID:      updated.PlayerID.String(),
+		KeeperData:    updated.KeeperData,
+		DesignatedAt:  r.clock.Now(),
+	})
+}
+
+// insertDroppedEvent records a RosterPlayerDropped event for a deleted roster entry
+func (r *Repository) insertDroppedEvent(ctx context.Context, q *db.Queries, dropped *models.Roster) error {
+	return insertRosterEvent(ctx, q, dropped.FantasyTeamID, events.EventTypeRosterPlayerDropped, events.RosterPlayerDroppedPayload{
+		RosterID:      dropped.ID.String(),
+		FantasyTeamID: dropped.FantasyTeamID.String(),
+		PlayerID:      dropped.PlayerID.String(),
+		DroppedAt:     r.clock.Now(),
+	})
+}
+
+// insertRosterEvent writes an event to the roster outbox in the caller's transaction
+func insertRosterEvent(ctx context.Context, q *db.Queries, fantasyTeamID uuid.UUID, eventType string, payload any) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s payload: %w", eventType, err)
+	}
+
+	if err := q.InsertRosterOutbox(ctx, db.InsertRosterOutboxParams{
+		ID:            ids.New(),
 		FantasyTeamID: fantasyTeamID,
-		PlayerID:      playerID,
+		EventType:     eventType,
+		Payload:       payloadBytes,
 	}); err != nil {
-		return fmt.Errorf("failed to delete player from roster: %w", err)
+		return fmt.Errorf("failed to insert %s event: %w", eventType, err)
 	}
 	return nil
 }
 
-func (r *Repository) DeleteTeamRoster(ctx context.Context, fantasyTeamID uuid.UUID) error {
-	if err := r.queries.DeleteTeamRoster(ctx, fantasyTeamID); err != nil {
-		return fmt.Errorf("failed to delete team roster: %w", err)
+// hasKeeperData reports whether keeper data is set to something other than JSON null
+func hasKeeperData(keeperData json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(keeperData)
+	return len(trimmed) > 0 && !bytes.Equal(trimmed, []byte("null"))
+}
+
+func taxiSquadRules(raw json.RawMessage, season string) (models.TaxiSquadRules, error) {
+	var settings map[string]interface{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &settings); err != nil {
+			return models.TaxiSquadRules{}, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	return models.SettingsTaxiSquadRules(settings, season), nil
+}
+
+func dbTaxiSquadExemptionToModel(row db.TaxiSquadExemption) *models.TaxiSquadExemption {
+	return &models.TaxiSquadExemption{
+		RosterID:  row.RosterPlayerID,
+		GrantedBy: sqlutil.FromNullUUID(row.GrantedBy),
+		Reason:    row.Reason,
+		CreatedAt: row.CreatedAt,
 	}
-	return nil
 }
 
 func (r *Repository) dbRosterToModel(dbRoster db.RosterPlayer) *models.Roster {
@@ -224,9 +1116,13 @@ func (r *Repository) dbRosterToModel(dbRoster db.RosterPlayer) *models.Roster {
 		FantasyTeamID:   dbRoster.FantasyTeamID,
 		PlayerID:        dbRoster.PlayerID,
 		Position:        models.RosterPosition(dbRoster.Position),
+		LineupSlot:      dbRoster.LineupSlot.String,
 		AcquiredAt:      dbRoster.AcquiredAt,
 		AcquisitionType: models.AcquisitionType(dbRoster.AcquisitionType),
 		KeeperData:      keeperData,
+		Salary:          sqlutil.FromSqlInt32(dbRoster.Salary),
+		ContractYears:   sqlutil.FromSqlInt32(dbRoster.ContractYears),
+		FranchiseTagged: dbRoster.FranchiseTagged,
 	}
 }
 
@@ -0,0 +1,101 @@
+package roster
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/clock"
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+)
+
+// TaxiSquadCheckJob is the job kind of the nightly taxi squad check
+const TaxiSquadCheckJob = "roster.check_taxi_squads"
+
+// TaxiSquadChecker tests taxi squads against their leagues' rules
+type TaxiSquadChecker interface {
+	CheckTaxiSquads(ctx context.Context, now time.Time) (int, int64, error)
+}
+
+// TaxiSquadRunnerConfig holds configuration for the nightly taxi squad check
+type TaxiSquadRunnerConfig struct {
+	HourUTC int // Hour of the day, in UTC, the check runs at
+}
+
+// DefaultTaxiSquadRunnerConfig returns default taxi squad runner configuration
+func DefaultTaxiSquadRunnerConfig() TaxiSquadRunnerConfig {
+	return TaxiSquadRunnerConfig{
+		HourUTC: 8, // early morning in US time zones
+	}
+}
+
+// ScheduleTaxiSquadCheck schedules a check of every taxi squad once a night on the job worker,
+// flagging the ones breaking their league's rules. The job queue runs each night's check once
+// however many servers schedule it, and recording violations is idempotent, so a retried
+// check is harmless. Squads are checked as of the time on clock.
+func ScheduleTaxiSquadCheck(worker *jobs.Worker, checker TaxiSquadChecker, config TaxiSquadRunnerConfig, clock clock.Clock) {
+	log.Info().
+		Int("hour_utc", config.HourUTC).
+		Msg("scheduling taxi squad check")
+
+	worker.Schedule(TaxiSquadCheckJob, jobs.DailyAt(config.HourUTC, 0, time.UTC), func(ctx context.Context, _ jobs.Job) error {
+		open, resolved, err := checker.CheckTaxiSquads(ctx, clock.Now())
+		if err != nil {
+			return err
+		}
+		log.Info().
+			Int("open", open).
+			Int64("resolved", resolved).
+			Msg("checked taxi squads")
+		return nil
+	})
+}
+
+// LineupCheckJob is the job kind of the check that alerts owners to lineup problems before lock
+const LineupCheckJob = "roster.check_lineups"
+
+// LineupIssueAlerter checks lineups ahead of lineup lock and alerts owners to problems
+type LineupIssueAlerter interface {
+	AlertLineupIssues(ctx context.Context, now time.Time, lead time.Duration) (int, int, error)
+}
+
+// LineupCheckRunnerConfig holds configuration for the lineup check
+type LineupCheckRunnerConfig struct {
+	Interval time.Duration // How often the check runs
+	Lead     time.Duration // How long before lineup lock teams are checked
+}
+
+// DefaultLineupCheckRunnerConfig returns default lineup check runner configuration
+func DefaultLineupCheckRunnerConfig() LineupCheckRunnerConfig {
+	return LineupCheckRunnerConfig{
+		Interval: 15 * time.Minute,
+		Lead:     time.Hour,
+	}
+}
+
+// ScheduleLineupCheck schedules a check of the lineups of teams whose leagues lock lineups
+// within the lead time, alerting owners to problems. Lineup locks fall at different times in
+// different leagues, so the check runs every interval; owners are alerted once per lock, between
+// the lead time and the lead time less one interval before it. Locks are measured from the time
+// on clock.
+func ScheduleLineupCheck(worker *jobs.Worker, alerter LineupIssueAlerter, config LineupCheckRunnerConfig, clock clock.Clock) {
+	log.Info().
+		Dur("interval", config.Interval).
+		Dur("lead", config.Lead).
+		Msg("scheduling lineup check")
+
+	worker.Schedule(LineupCheckJob, jobs.Every(config.Interval), func(ctx context.Context, _ jobs.Job) error {
+		checked, alerted, err := alerter.AlertLineupIssues(ctx, clock.Now(), config.Lead)
+		if err != nil {
+			return err
+		}
+		if checked > 0 {
+			log.Info().
+				Int("checked", checked).
+				Int("alerted", alerted).
+				Msg("checked lineups before lock")
+		}
+		return nil
+	})
+}
@@ -2,7 +2,10 @@ package roster
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -12,7 +15,9 @@ import (
 	"github.com/mcdev12/dynasty/go/internal/genproto/player/v1/playerv1connect"
 	rosterv1 "github.com/mcdev12/dynasty/go/internal/genproto/roster/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/roster/v1/rosterv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -28,6 +33,16 @@ type RosterApp interface {
 	GetBenchRosterPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.Roster, error)
 	GetRosterPlayersByAcquisitionType(ctx context.Context, fantasyTeamID uuid.UUID, acquisitionType models.AcquisitionType) ([]models.Roster, error)
 	UpdateRosterPlayerPosition(ctx context.Context, id uuid.UUID, req UpdateRosterPositionRequest) (*models.Roster, error)
+	BatchUpdateLineup(ctx context.Context, fantasyTeamID uuid.UUID, assignments []LineupAssignment, updatedBy *uuid.UUID) ([]models.Roster, error)
+	AssignLineupSlot(ctx context.Context, id uuid.UUID, slot string, assignedBy *uuid.UUID) (*models.Roster, error)
+	GetLineupSlots(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.LineupSlot, error)
+	CheckLineup(ctx context.Context, fantasyTeamID uuid.UUID) (*LineupCheck, error)
+	GrantTaxiSquadExemption(ctx context.Context, id, commissionerID uuid.UUID, reason string) (*models.TaxiSquadExemption, error)
+	RevokeTaxiSquadExemption(ctx context.Context, id, commissionerID uuid.UUID) error
+	ListTaxiSquadViolations(ctx context.Context, leagueID uuid.UUID, includeResolved bool) ([]models.TaxiSquadViolation, error)
+	SetRosterContract(ctx context.Context, id, commissionerID uuid.UUID, salary, years int) (*models.Roster, error)
+	FranchiseTagPlayer(ctx context.Context, id, userID uuid.UUID) (*models.Roster, error)
+	GetCapSheet(ctx context.Context, fantasyTeamID uuid.UUID) (*models.CapSheet, error)
 	UpdateRosterPlayerKeeperData(ctx context.Context, id uuid.UUID, req UpdateRosterKeeperDataRequest) (*models.Roster, error)
 	UpdateRosterPositionAndKeeperData(ctx context.Context, id uuid.UUID, req UpdateRosterPositionAndKeeperDataRequest) (*models.Roster, error)
 	DeleteRosterEntry(ctx context.Context, id uuid.UUID) error
@@ -79,6 +94,13 @@ func (s *Service) CreateRosterPlayer(ctx context.Context, req *connect.Request[r
 
 	roster, err := s.app.CreateRosterPlayer(ctx, appReq)
 	if err != nil {
+		if errors.Is(err, ErrLineupManagedAutomatically) || errors.Is(err, ErrInvalidLineup) || errors.Is(err, ErrTaxiSquadRule) ||
+			errors.Is(err, ErrNoSalaryCap) || errors.Is(err, ErrOverSalaryCap) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if errors.Is(err, ErrInvalidContract) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -94,9 +116,9 @@ func (s *Service) CreateRosterPlayer(ctx context.Context, req *connect.Request[r
 
 // GetRoster retrieves a roster entry by ID
 func (s *Service) GetRoster(ctx context.Context, req *connect.Request[rosterv1.GetRosterRequest]) (*connect.Response[rosterv1.GetRosterResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	roster, err := s.app.GetRoster(ctx, id)
@@ -116,9 +138,9 @@ func (s *Service) GetRoster(ctx context.Context, req *connect.Request[rosterv1.G
 
 // GetRosterPlayersByFantasyTeam retrieves all players on a team's roster
 func (s *Service) GetRosterPlayersByFantasyTeam(ctx context.Context, req *connect.Request[rosterv1.GetRosterPlayersByFantasyTeamRequest]) (*connect.Response[rosterv1.GetRosterPlayersByFantasyTeamResponse], error) {
-	fantasyTeamID, err := uuid.Parse(req.Msg.FantasyTeamId)
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Cross-domain orchestration: validate fantasy team exists first
@@ -146,9 +168,9 @@ func (s *Service) GetRosterPlayersByFantasyTeam(ctx context.Context, req *connec
 
 // GetRosterPlayersByFantasyTeamAndPosition retrieves players by team and position
 func (s *Service) GetRosterPlayersByFantasyTeamAndPosition(ctx context.Context, req *connect.Request[rosterv1.GetRosterPlayersByFantasyTeamAndPositionRequest]) (*connect.Response[rosterv1.GetRosterPlayersByFantasyTeamAndPositionResponse], error) {
-	fantasyTeamID, err := uuid.Parse(req.Msg.FantasyTeamId)
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	position := s.protoToRosterPosition(req.Msg.Position)
@@ -170,14 +192,13 @@ func (s *Service) GetRosterPlayersByFantasyTeamAndPosition(ctx context.Context,
 
 // GetPlayerOnRoster checks if a specific player is on a team's roster
 func (s *Service) GetPlayerOnRoster(ctx context.Context, req *connect.Request[rosterv1.GetPlayerOnRosterRequest]) (*connect.Response[rosterv1.GetPlayerOnRosterResponse], error) {
-	fantasyTeamID, err := uuid.Parse(req.Msg.FantasyTeamId)
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
-
-	playerID, err := uuid.Parse(req.Msg.PlayerId)
+	playerID, err := uuidutil.MustParseOrInvalidArg("player_id", req.Msg.PlayerId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	// Cross-domain orchestration: validate fantasy team exists first
@@ -213,9 +234,9 @@ func (s *Service) GetPlayerOnRoster(ctx context.Context, req *connect.Request[ro
 
 // GetStartingRosterPlayers retrieves all starting players for a team
 func (s *Service) GetStartingRosterPlayers(ctx context.Context, req *connect.Request[rosterv1.GetStartingRosterPlayersRequest]) (*connect.Response[rosterv1.GetStartingRosterPlayersResponse], error) {
-	fantasyTeamID, err := uuid.Parse(req.Msg.FantasyTeamId)
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	rosters, err := s.app.GetStartingRosterPlayers(ctx, fantasyTeamID)
@@ -235,9 +256,9 @@ func (s *Service) GetStartingRosterPlayers(ctx context.Context, req *connect.Req
 
 // GetBenchRosterPlayers retrieves all bench players for a team
 func (s *Service) GetBenchRosterPlayers(ctx context.Context, req *connect.Request[rosterv1.GetBenchRosterPlayersRequest]) (*connect.Response[rosterv1.GetBenchRosterPlayersResponse], error) {
-	fantasyTeamID, err := uuid.Parse(req.Msg.FantasyTeamId)
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	rosters, err := s.app.GetBenchRosterPlayers(ctx, fantasyTeamID)
@@ -257,9 +278,9 @@ func (s *Service) GetBenchRosterPlayers(ctx context.Context, req *connect.Reques
 
 // GetRosterPlayersByAcquisitionType retrieves players by how they were acquired
 func (s *Service) GetRosterPlayersByAcquisitionType(ctx context.Context, req *connect.Request[rosterv1.GetRosterPlayersByAcquisitionTypeRequest]) (*connect.Response[rosterv1.GetRosterPlayersByAcquisitionTypeResponse], error) {
-	fantasyTeamID, err := uuid.Parse(req.Msg.FantasyTeamId)
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	acquisitionType := s.protoToAcquisitionType(req.Msg.AcquisitionType)
@@ -281,17 +302,24 @@ func (s *Service) GetRosterPlayersByAcquisitionType(ctx context.Context, req *co
 
 // UpdateRosterPlayerPosition updates a player's position on the roster
 func (s *Service) UpdateRosterPlayerPosition(ctx context.Context, req *connect.Request[rosterv1.UpdateRosterPlayerPositionRequest]) (*connect.Response[rosterv1.UpdateRosterPlayerPositionResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	appReq := UpdateRosterPositionRequest{
-		Position: s.protoToRosterPosition(req.Msg.Position),
+		Position:  s.protoToRosterPosition(req.Msg.Position),
+		UpdatedBy: interceptors.ActingUserPtr(ctx),
 	}
 
 	roster, err := s.app.UpdateRosterPlayerPosition(ctx, id, appReq)
 	if err != nil {
+		if errors.Is(err, ErrLineupManagedAutomatically) || errors.Is(err, ErrInvalidLineup) || errors.Is(err, ErrTaxiSquadRule) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if errors.Is(err, ErrNotLineupManager) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -305,11 +333,326 @@ func (s *Service) UpdateRosterPlayerPosition(ctx context.Context, req *connect.R
 	}), nil
 }
 
+// BatchUpdateLineup moves several of a team's roster entries at once, validating and applying the resulting lineup atomically
+func (s *Service) BatchUpdateLineup(ctx context.Context, req *connect.Request[rosterv1.BatchUpdateLineupRequest]) (*connect.Response[rosterv1.BatchUpdateLineupResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]LineupAssignment, len(req.Msg.Assignments))
+	for i, assignment := range req.Msg.Assignments {
+		rosterID, err := uuidutil.MustParseOrInvalidArg("assignments.roster_id", assignment.RosterId)
+		if err != nil {
+			return nil, err
+		}
+		assignments[i] = LineupAssignment{
+			RosterID:   rosterID,
+			Position:   s.protoToRosterPosition(assignment.Position),
+			LineupSlot: assignment.LineupSlot,
+		}
+	}
+
+	rosters, err := s.app.BatchUpdateLineup(ctx, fantasyTeamID, assignments, interceptors.ActingUserPtr(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrLineupManagedAutomatically), errors.Is(err, ErrInvalidLineup), errors.Is(err, ErrTaxiSquadRule):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, ErrRosterEntryNotOnTeam):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, ErrNotLineupManager):
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoRosters, err := s.rostersToProto(rosters)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&rosterv1.BatchUpdateLineupResponse{
+		Rosters: protoRosters,
+	}), nil
+}
+
+// AssignLineupSlot starts a roster entry in one of its league's lineup slots
+func (s *Service) AssignLineupSlot(ctx context.Context, req *connect.Request[rosterv1.AssignLineupSlotRequest]) (*connect.Response[rosterv1.AssignLineupSlotResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	roster, err := s.app.AssignLineupSlot(ctx, id, req.Msg.LineupSlot, interceptors.ActingUserPtr(ctx))
+	if err != nil {
+		if errors.Is(err, ErrLineupManagedAutomatically) || errors.Is(err, ErrInvalidLineup) || errors.Is(err, ErrTaxiSquadRule) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if errors.Is(err, ErrNotLineupManager) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoRoster, err := s.rosterToProto(roster)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&rosterv1.AssignLineupSlotResponse{
+		Roster: protoRoster,
+	}), nil
+}
+
+// GetLineupSlots lists the starting lineup slots of a fantasy team's league
+func (s *Service) GetLineupSlots(ctx context.Context, req *connect.Request[rosterv1.GetLineupSlotsRequest]) (*connect.Response[rosterv1.GetLineupSlotsResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := s.app.GetLineupSlots(ctx, fantasyTeamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoSlots := make([]*rosterv1.LineupSlot, len(slots))
+	for i, slot := range slots {
+		protoSlots[i] = &rosterv1.LineupSlot{
+			Name:     slot.Name,
+			Eligible: slot.Eligible,
+		}
+	}
+
+	return connect.NewResponse(&rosterv1.GetLineupSlotsResponse{
+		Slots: protoSlots,
+	}), nil
+}
+
+// CheckLineup looks for problems with a team's lineup ahead of the next lineup lock and suggests fixes
+func (s *Service) CheckLineup(ctx context.Context, req *connect.Request[rosterv1.CheckLineupRequest]) (*connect.Response[rosterv1.CheckLineupResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := s.app.CheckLineup(ctx, fantasyTeamID)
+	if err != nil {
+		if errors.Is(err, ErrLineupManagedAutomatically) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoIssues := make([]*rosterv1.LineupIssue, len(check.Issues))
+	for i, issue := range check.Issues {
+		protoIssue := &rosterv1.LineupIssue{
+			Kind:       s.lineupIssueKindToProto(issue.Kind),
+			LineupSlot: issue.Slot,
+			Detail:     issue.Detail,
+		}
+		if issue.RosterID != nil {
+			protoIssue.RosterId = issue.RosterID.String()
+		}
+		if issue.Fix != nil {
+			protoIssue.Fix = &rosterv1.LineupFix{
+				Description: issue.Fix.Description,
+				Assignments: s.lineupAssignmentsToProto(issue.Fix.Assignments),
+			}
+		}
+		protoIssues[i] = protoIssue
+	}
+
+	return connect.NewResponse(&rosterv1.CheckLineupResponse{
+		LocksAt:     timestamppb.New(check.LocksAt),
+		Issues:      protoIssues,
+		Assignments: s.lineupAssignmentsToProto(check.Assignments),
+	}), nil
+}
+
+// GrantTaxiSquadExemption lets the league's commissioner exempt a roster entry from the taxi squad rules
+func (s *Service) GrantTaxiSquadExemption(ctx context.Context, req *connect.Request[rosterv1.GrantTaxiSquadExemptionRequest]) (*connect.Response[rosterv1.GrantTaxiSquadExemptionResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("missing %s header", interceptors.UserIDHeader))
+	}
+
+	exemption, err := s.app.GrantTaxiSquadExemption(ctx, id, actingUser, req.Msg.Reason)
+	if err != nil {
+		return nil, connect.NewError(taxiSquadExemptionErrorCode(err), err)
+	}
+
+	return connect.NewResponse(&rosterv1.GrantTaxiSquadExemptionResponse{
+		Exemption: s.taxiSquadExemptionToProto(exemption),
+	}), nil
+}
+
+// RevokeTaxiSquadExemption lets the league's commissioner put a roster entry back under the taxi squad rules
+func (s *Service) RevokeTaxiSquadExemption(ctx context.Context, req *connect.Request[rosterv1.RevokeTaxiSquadExemptionRequest]) (*connect.Response[rosterv1.RevokeTaxiSquadExemptionResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("missing %s header", interceptors.UserIDHeader))
+	}
+
+	if err := s.app.RevokeTaxiSquadExemption(ctx, id, actingUser); err != nil {
+		return nil, connect.NewError(taxiSquadExemptionErrorCode(err), err)
+	}
+
+	return connect.NewResponse(&rosterv1.RevokeTaxiSquadExemptionResponse{
+		Success: true,
+	}), nil
+}
+
+// ListTaxiSquadViolations lists the taxi squad rule violations found in a league
+func (s *Service) ListTaxiSquadViolations(ctx context.Context, req *connect.Request[rosterv1.ListTaxiSquadViolationsRequest]) (*connect.Response[rosterv1.ListTaxiSquadViolationsResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	violations, err := s.app.ListTaxiSquadViolations(ctx, leagueID, req.Msg.IncludeResolved)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoViolations := make([]*rosterv1.TaxiSquadViolation, len(violations))
+	for i := range violations {
+		protoViolations[i] = s.taxiSquadViolationToProto(&violations[i])
+	}
+
+	return connect.NewResponse(&rosterv1.ListTaxiSquadViolationsResponse{
+		Violations: protoViolations,
+	}), nil
+}
+
+// taxiSquadExemptionErrorCode maps taxi squad exemption failures to Connect codes
+func taxiSquadExemptionErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, ErrNotCommissioner):
+		return connect.CodePermissionDenied
+	case errors.Is(err, ErrTaxiSquadExemptionNotFound), errors.Is(err, sql.ErrNoRows):
+		return connect.CodeNotFound
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// SetRosterContract lets the league's commissioner set the terms of a roster entry's contract
+func (s *Service) SetRosterContract(ctx context.Context, req *connect.Request[rosterv1.SetRosterContractRequest]) (*connect.Response[rosterv1.SetRosterContractResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("missing %s header", interceptors.UserIDHeader))
+	}
+
+	roster, err := s.app.SetRosterContract(ctx, id, actingUser, int(req.Msg.Salary), int(req.Msg.ContractYears))
+	if err != nil {
+		return nil, connect.NewError(contractErrorCode(err), err)
+	}
+
+	protoRoster, err := s.rosterToProto(roster)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&rosterv1.SetRosterContractResponse{
+		Roster: protoRoster,
+	}), nil
+}
+
+// FranchiseTagPlayer keeps a roster entry for one more season at the franchise tag salary
+func (s *Service) FranchiseTagPlayer(ctx context.Context, req *connect.Request[rosterv1.FranchiseTagPlayerRequest]) (*connect.Response[rosterv1.FranchiseTagPlayerResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("missing %s header", interceptors.UserIDHeader))
+	}
+
+	roster, err := s.app.FranchiseTagPlayer(ctx, id, actingUser)
+	if err != nil {
+		return nil, connect.NewError(contractErrorCode(err), err)
+	}
+
+	protoRoster, err := s.rosterToProto(roster)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&rosterv1.FranchiseTagPlayerResponse{
+		Roster: protoRoster,
+	}), nil
+}
+
+// GetCapSheet lists a team's contracts against its league's salary cap
+func (s *Service) GetCapSheet(ctx context.Context, req *connect.Request[rosterv1.GetCapSheetRequest]) (*connect.Response[rosterv1.GetCapSheetResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet, err := s.app.GetCapSheet(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, connect.NewError(contractErrorCode(err), err)
+	}
+
+	protoSheet, err := s.capSheetToProto(sheet)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&rosterv1.GetCapSheetResponse{
+		CapSheet: protoSheet,
+	}), nil
+}
+
+// contractErrorCode maps contract and salary cap failures to Connect codes
+func contractErrorCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, ErrNotCommissioner), errors.Is(err, ErrNotTeamManager):
+		return connect.CodePermissionDenied
+	case errors.Is(err, ErrNoSalaryCap), errors.Is(err, ErrOverSalaryCap), errors.Is(err, ErrNoFranchiseTags):
+		return connect.CodeFailedPrecondition
+	case errors.Is(err, sql.ErrNoRows):
+		return connect.CodeNotFound
+	case errors.Is(err, ErrInvalidContract):
+		return connect.CodeInvalidArgument
+	default:
+		return connect.CodeInternal
+	}
+}
+
 // UpdateRosterPlayerKeeperData updates a player's keeper data
 func (s *Service) UpdateRosterPlayerKeeperData(ctx context.Context, req *connect.Request[rosterv1.UpdateRosterPlayerKeeperDataRequest]) (*connect.Response[rosterv1.UpdateRosterPlayerKeeperDataResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	var keeperData json.RawMessage
@@ -342,9 +685,9 @@ func (s *Service) UpdateRosterPlayerKeeperData(ctx context.Context, req *connect
 
 // UpdateRosterPositionAndKeeperData updates both position and keeper data
 func (s *Service) UpdateRosterPositionAndKeeperData(ctx context.Context, req *connect.Request[rosterv1.UpdateRosterPositionAndKeeperDataRequest]) (*connect.Response[rosterv1.UpdateRosterPositionAndKeeperDataResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	var keeperData json.RawMessage
@@ -363,6 +706,9 @@ func (s *Service) UpdateRosterPositionAndKeeperData(ctx context.Context, req *co
 
 	roster, err := s.app.UpdateRosterPositionAndKeeperData(ctx, id, appReq)
 	if err != nil {
+		if errors.Is(err, ErrLineupManagedAutomatically) || errors.Is(err, ErrInvalidLineup) || errors.Is(err, ErrTaxiSquadRule) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -378,9 +724,9 @@ func (s *Service) UpdateRosterPositionAndKeeperData(ctx context.Context, req *co
 
 // DeleteRosterEntry removes a specific roster entry
 func (s *Service) DeleteRosterEntry(ctx context.Context, req *connect.Request[rosterv1.DeleteRosterEntryRequest]) (*connect.Response[rosterv1.DeleteRosterEntryResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	err = s.app.DeleteRosterEntry(ctx, id)
@@ -395,14 +741,13 @@ func (s *Service) DeleteRosterEntry(ctx context.Context, req *connect.Request[ro
 
 // DeletePlayerFromRoster removes a player from a team's roster
 func (s *Service) DeletePlayerFromRoster(ctx context.Context, req *connect.Request[rosterv1.DeletePlayerFromRosterRequest]) (*connect.Response[rosterv1.DeletePlayerFromRosterResponse], error) {
-	fantasyTeamID, err := uuid.Parse(req.Msg.FantasyTeamId)
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
-
-	playerID, err := uuid.Parse(req.Msg.PlayerId)
+	playerID, err := uuidutil.MustParseOrInvalidArg("player_id", req.Msg.PlayerId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	err = s.app.DeletePlayerFromRoster(ctx, fantasyTeamID, playerID)
@@ -417,9 +762,9 @@ func (s *Service) DeletePlayerFromRoster(ctx context.Context, req *connect.Reque
 
 // DeleteTeamRoster clears an entire team's roster
 func (s *Service) DeleteTeamRoster(ctx context.Context, req *connect.Request[rosterv1.DeleteTeamRosterRequest]) (*connect.Response[rosterv1.DeleteTeamRosterResponse], error) {
-	fantasyTeamID, err := uuid.Parse(req.Msg.FantasyTeamId)
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	err = s.app.DeleteTeamRoster(ctx, fantasyTeamID)
@@ -456,9 +801,94 @@ func (s *Service) rosterToProto(roster *models.Roster) (*rosterv1.Roster, error)
 		AcquisitionType: s.acquisitionTypeToProto(roster.AcquisitionType),
 		CreatedAt:       timestamppb.New(roster.AcquiredAt),
 		KeeperData:      keeperDataStruct,
+		LineupSlot:      roster.LineupSlot,
+		Salary:          toProtoInt32(roster.Salary),
+		ContractYears:   toProtoInt32(roster.ContractYears),
+		FranchiseTagged: roster.FranchiseTagged,
+	}, nil
+}
+
+func (s *Service) capSheetToProto(sheet *models.CapSheet) (*rosterv1.CapSheet, error) {
+	entries := make([]*rosterv1.CapSheetEntry, len(sheet.Entries))
+	for i := range sheet.Entries {
+		protoRoster, err := s.rosterToProto(&sheet.Entries[i].Roster)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = &rosterv1.CapSheetEntry{
+			Roster: protoRoster,
+			CapHit: int32(sheet.Entries[i].CapHit),
+		}
+	}
+
+	return &rosterv1.CapSheet{
+		FantasyTeamId:        sheet.FantasyTeamID.String(),
+		SalaryCap:            int32(sheet.SalaryCap),
+		Payroll:              int32(sheet.Payroll),
+		CapSpace:             int32(sheet.CapSpace),
+		FranchiseTagsUsed:    int32(sheet.FranchiseTagsUsed),
+		FranchiseTagsAllowed: int32(sheet.FranchiseTagsAllowed),
+		Entries:              entries,
 	}, nil
 }
 
+func toProtoInt32(val *int) *int32 {
+	if val == nil {
+		return nil
+	}
+	n := int32(*val)
+	return &n
+}
+
+func fromProtoInt32(val *int32) *int {
+	if val == nil {
+		return nil
+	}
+	n := int(*val)
+	return &n
+}
+
+func (s *Service) taxiSquadExemptionToProto(exemption *models.TaxiSquadExemption) *rosterv1.TaxiSquadExemption {
+	protoExemption := &rosterv1.TaxiSquadExemption{
+		RosterId:  exemption.RosterID.String(),
+		Reason:    exemption.Reason,
+		CreatedAt: timestamppb.New(exemption.CreatedAt),
+	}
+	if exemption.GrantedBy != nil {
+		protoExemption.GrantedBy = exemption.GrantedBy.String()
+	}
+	return protoExemption
+}
+
+func (s *Service) taxiSquadViolationToProto(violation *models.TaxiSquadViolation) *rosterv1.TaxiSquadViolation {
+	protoViolation := &rosterv1.TaxiSquadViolation{
+		Id:            violation.ID.String(),
+		LeagueId:      violation.LeagueID.String(),
+		FantasyTeamId: violation.FantasyTeamID.String(),
+		Reason:        s.taxiSquadViolationReasonToProto(violation.Reason),
+		Detail:        violation.Detail,
+		DetectedAt:    timestamppb.New(violation.DetectedAt),
+	}
+	if violation.RosterID != nil {
+		protoViolation.RosterId = violation.RosterID.String()
+	}
+	if violation.ResolvedAt != nil {
+		protoViolation.ResolvedAt = timestamppb.New(*violation.ResolvedAt)
+	}
+	return protoViolation
+}
+
+func (s *Service) taxiSquadViolationReasonToProto(reason models.TaxiSquadViolationReason) rosterv1.TaxiSquadViolationReason {
+	switch reason {
+	case models.TaxiSquadViolationOverLimit:
+		return rosterv1.TaxiSquadViolationReason_TAXI_SQUAD_VIOLATION_REASON_OVER_LIMIT
+	case models.TaxiSquadViolationIneligibleExperience:
+		return rosterv1.TaxiSquadViolationReason_TAXI_SQUAD_VIOLATION_REASON_INELIGIBLE_EXPERIENCE
+	default:
+		return rosterv1.TaxiSquadViolationReason_TAXI_SQUAD_VIOLATION_REASON_UNSPECIFIED
+	}
+}
+
 func (s *Service) rostersToProto(rosters []models.Roster) ([]*rosterv1.Roster, error) {
 	protoRosters := make([]*rosterv1.Roster, len(rosters))
 	for i, roster := range rosters {
@@ -472,12 +902,11 @@ func (s *Service) rostersToProto(rosters []models.Roster) ([]*rosterv1.Roster, e
 }
 
 func (s *Service) protoToCreateRosterRequest(proto *rosterv1.CreateRosterPlayerRequest) (CreateRosterPlayerRequest, error) {
-	fantasyTeamID, err := uuid.Parse(proto.FantasyTeamId)
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", proto.FantasyTeamId)
 	if err != nil {
 		return CreateRosterPlayerRequest{}, err
 	}
-
-	playerID, err := uuid.Parse(proto.PlayerId)
+	playerID, err := uuidutil.MustParseOrInvalidArg("player_id", proto.PlayerId)
 	if err != nil {
 		return CreateRosterPlayerRequest{}, err
 	}
@@ -497,11 +926,41 @@ func (s *Service) protoToCreateRosterRequest(proto *rosterv1.CreateRosterPlayerR
 		Position:        s.protoToRosterPosition(proto.Position),
 		AcquisitionType: s.protoToAcquisitionType(proto.AcquisitionType),
 		KeeperData:      keeperData,
+		Salary:          fromProtoInt32(proto.Salary),
+		ContractYears:   fromProtoInt32(proto.ContractYears),
 	}, nil
 }
 
+// lineupAssignmentsToProto converts a batch lineup change to proto
+func (s *Service) lineupAssignmentsToProto(assignments []LineupAssignment) []*rosterv1.LineupAssignment {
+	protoAssignments := make([]*rosterv1.LineupAssignment, len(assignments))
+	for i, assignment := range assignments {
+		protoAssignments[i] = &rosterv1.LineupAssignment{
+			RosterId:   assignment.RosterID.String(),
+			Position:   s.rosterPositionToProto(assignment.Position),
+			LineupSlot: assignment.LineupSlot,
+		}
+	}
+	return protoAssignments
+}
+
 // Enum conversion methods
 
+func (s *Service) lineupIssueKindToProto(kind LineupIssueKind) rosterv1.LineupIssueKind {
+	switch kind {
+	case LineupIssueEmptySlot:
+		return rosterv1.LineupIssueKind_LINEUP_ISSUE_KIND_EMPTY_SLOT
+	case LineupIssueInactiveStarter:
+		return rosterv1.LineupIssueKind_LINEUP_ISSUE_KIND_INACTIVE_STARTER
+	case LineupIssueTooManyStarters:
+		return rosterv1.LineupIssueKind_LINEUP_ISSUE_KIND_TOO_MANY_STARTERS
+	case LineupIssueIneligibleStarter:
+		return rosterv1.LineupIssueKind_LINEUP_ISSUE_KIND_INELIGIBLE_STARTER
+	default:
+		return rosterv1.LineupIssueKind_LINEUP_ISSUE_KIND_UNSPECIFIED
+	}
+}
+
 func (s *Service) rosterPositionToProto(position models.RosterPosition) rosterv1.RosterPosition {
 	switch position {
 	case models.RosterPositionStarter:
@@ -0,0 +1,313 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/scoring"
+)
+
+// ScheduleRepository defines what the schedule app layer needs from the repository
+type ScheduleRepository interface {
+	GetLeague(ctx context.Context, leagueID uuid.UUID) (*League, error)
+	ListTeams(ctx context.Context, leagueID uuid.UUID) ([]Team, error)
+	ReplaceMatchups(ctx context.Context, leagueID uuid.UUID, season string, weeks []Week) ([]models.Matchup, error)
+	ListMatchups(ctx context.Context, leagueID uuid.UUID, season string) ([]models.Matchup, error)
+	ListUserMatchups(ctx context.Context, userID uuid.UUID, week *int) ([]UserMatchup, error)
+	GetMatchup(ctx context.Context, id uuid.UUID) (*models.Matchup, error)
+	RecordMatchupResult(ctx context.Context, result MatchupResult) (bool, error)
+	ListMatchupResults(ctx context.Context, leagueID uuid.UUID, season string) ([]PlayedMatchup, error)
+	ReplaceStandings(ctx context.Context, standings Standings) error
+	GetStandings(ctx context.Context, leagueID uuid.UUID, season string) (*Standings, error)
+	ListStandingsLeagues(ctx context.Context) ([]uuid.UUID, error)
+}
+
+// App handles schedule business logic
+type App struct {
+	repo ScheduleRepository
+}
+
+// NewApp creates a new schedule App
+func NewApp(repo ScheduleRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// PreviewSchedule generates a season schedule from the league's settings without saving it.
+// Committing the returned seed saves the same schedule as long as the league's teams and
+// schedule settings are unchanged. Commissioner only.
+func (a *App) PreviewSchedule(ctx context.Context, req PreviewRequest) (*Schedule, error) {
+	league, err := a.getCommissionedLeague(ctx, req.LeagueID, req.RequestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := rand.Int63()
+	if req.Seed != nil {
+		seed = *req.Seed
+	}
+
+	teams, weeks, err := a.generate(ctx, league, seed)
+	if err != nil {
+		return nil, err
+	}
+	return &Schedule{
+		LeagueID: league.ID,
+		Season:   league.Season,
+		Seed:     &seed,
+		Weeks:    weeks,
+		Teams:    summarize(teams, league.Settings, weeks),
+	}, nil
+}
+
+// CommitSchedule saves the schedule a seed generates as the league's season schedule,
+// replacing any schedule committed before. Commissioner only.
+func (a *App) CommitSchedule(ctx context.Context, req CommitRequest) (*Schedule, error) {
+	league, err := a.getCommissionedLeague(ctx, req.LeagueID, req.CommittedBy)
+	if err != nil {
+		return nil, err
+	}
+	if league.Status == models.LeagueStatusCompleted || league.Status == models.LeagueStatusCancelled {
+		return nil, ErrSeasonOver
+	}
+
+	teams, weeks, err := a.generate(ctx, league, req.Seed)
+	if err != nil {
+		return nil, err
+	}
+
+	matchups, err := a.repo.ReplaceMatchups(ctx, league.ID, league.Season, weeks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit schedule: %w", err)
+	}
+
+	weeks = groupWeeks(teams, matchups)
+	return &Schedule{
+		LeagueID: league.ID,
+		Season:   league.Season,
+		Seed:     &req.Seed,
+		Weeks:    weeks,
+		Teams:    summarize(teams, league.Settings, weeks),
+	}, nil
+}
+
+// GetSchedule retrieves the league's committed schedule for its current season. A league
+// without one gets an empty schedule.
+func (a *App) GetSchedule(ctx context.Context, leagueID uuid.UUID) (*Schedule, error) {
+	league, err := a.repo.GetLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := a.repo.ListTeams(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	matchups, err := a.repo.ListMatchups(ctx, leagueID, league.Season)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	weeks := groupWeeks(teams, matchups)
+	return &Schedule{
+		LeagueID: league.ID,
+		Season:   league.Season,
+		Weeks:    weeks,
+		Teams:    summarize(teams, league.Settings, weeks),
+	}, nil
+}
+
+// ListUserMatchups lists the matchups a user's teams play in the current season of their
+// active leagues, optionally only those of one week
+func (a *App) ListUserMatchups(ctx context.Context, userID uuid.UUID, week *int) ([]UserMatchup, error) {
+	return a.repo.ListUserMatchups(ctx, userID, week)
+}
+
+// RecordMatchupResult records a matchup's final score from a live scoring update. It returns
+// false without error for updates that aren't final, for matchups a newly committed schedule
+// replaced and for updates older than the score already recorded.
+func (a *App) RecordMatchupResult(ctx context.Context, update scoring.MatchupScoreUpdate) (bool, error) {
+	if !update.Final {
+		return false, nil
+	}
+
+	matchup, err := a.repo.GetMatchup(ctx, update.MatchupID)
+	if errors.Is(err, ErrMatchupNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	result := MatchupResult{MatchupID: matchup.ID, FinalizedAt: update.UpdatedAt}
+	switch {
+	case update.Home.FantasyTeamID == matchup.HomeTeamID && update.Away.FantasyTeamID == matchup.AwayTeamID:
+		result.HomePoints, result.AwayPoints = update.Home.Points, update.Away.Points
+	case update.Home.FantasyTeamID == matchup.AwayTeamID && update.Away.FantasyTeamID == matchup.HomeTeamID:
+		result.HomePoints, result.AwayPoints = update.Away.Points, update.Home.Points
+	default:
+		return false, fmt.Errorf("%w: matchup %s", ErrResultTeamsMismatch, matchup.ID)
+	}
+
+	recorded, err := a.repo.RecordMatchupResult(ctx, result)
+	if err != nil {
+		return false, err
+	}
+	if recorded {
+		log.Printf("Recorded final score of matchup %s in week %d: %.2f-%.2f", matchup.ID, matchup.Week, result.HomePoints, result.AwayPoints)
+	}
+	return recorded, nil
+}
+
+// GetStandings retrieves the league's standings for its current season as of their last weekly
+// recompute. A league whose standings haven't been computed yet gets them computed from the
+// results recorded so far.
+func (a *App) GetStandings(ctx context.Context, leagueID uuid.UUID) (*Standings, error) {
+	league, err := a.repo.GetLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := a.repo.GetStandings(ctx, league.ID, league.Season)
+	if err != nil {
+		return nil, err
+	}
+	if standings == nil {
+		return a.computeStandings(ctx, league)
+	}
+	standings.Tiebreakers = league.Settings.Tiebreakers
+	for i := range standings.Teams {
+		standings.Teams[i].Division = league.Settings.DivisionOf(standings.Teams[i].FantasyTeamID)
+	}
+	return standings, nil
+}
+
+// RecomputeStandings computes the league's current season standings from its matchup results
+// and saves them in place of the last ones
+func (a *App) RecomputeStandings(ctx context.Context, leagueID uuid.UUID) (*Standings, error) {
+	league, err := a.repo.GetLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := a.computeStandings(ctx, league)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	standings.ComputedAt = &now
+	if err := a.repo.ReplaceStandings(ctx, *standings); err != nil {
+		return nil, err
+	}
+	return standings, nil
+}
+
+// RecomputeAllStandings recomputes the standings of every active league with a committed
+// schedule, returning how many leagues were recomputed
+func (a *App) RecomputeAllStandings(ctx context.Context) (int, error) {
+	leagueIDs, err := a.repo.ListStandingsLeagues(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, leagueID := range leagueIDs {
+		if _, err := a.RecomputeStandings(ctx, leagueID); err != nil {
+			return i, fmt.Errorf("failed to recompute standings of league %s: %w", leagueID, err)
+		}
+	}
+	return len(leagueIDs), nil
+}
+
+// computeStandings ranks the league's teams by the results recorded so far this season
+func (a *App) computeStandings(ctx context.Context, league *League) (*Standings, error) {
+	teams, err := a.repo.ListTeams(ctx, league.ID)
+	if err != nil {
+		return nil, err
+	}
+	played, err := a.repo.ListMatchupResults(ctx, league.ID, league.Season)
+	if err != nil {
+		return nil, err
+	}
+
+	throughWeek := 0
+	for _, game := range played {
+		throughWeek = max(throughWeek, game.Matchup.Week)
+	}
+	return &Standings{
+		LeagueID:    league.ID,
+		Season:      league.Season,
+		ThroughWeek: throughWeek,
+		Tiebreakers: league.Settings.Tiebreakers,
+		Teams:       ComputeStandings(teams, league.Settings, played, league.ID.String()+":"+league.Season),
+	}, nil
+}
+
+// generate builds the schedule a seed gives for the league's current teams and settings
+func (a *App) generate(ctx context.Context, league *League, seed int64) ([]Team, []Week, error) {
+	teams, err := a.repo.ListTeams(ctx, league.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teamIDs := make([]uuid.UUID, len(teams))
+	for i, team := range teams {
+		teamIDs[i] = team.ID
+	}
+	weeks, err := Generate(teamIDs, league.Settings, seed)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range weeks {
+		for j := range weeks[i].Matchups {
+			weeks[i].Matchups[j].LeagueID = league.ID
+			weeks[i].Matchups[j].Season = league.Season
+		}
+	}
+	return teams, weeks, nil
+}
+
+// getCommissionedLeague retrieves a league, returning ErrNotCommissioner unless the user
+// commissions it. A nil user is a trusted caller.
+func (a *App) getCommissionedLeague(ctx context.Context, leagueID uuid.UUID, userID *uuid.UUID) (*League, error) {
+	league, err := a.repo.GetLeague(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	if userID != nil && *userID != league.CommissionerID {
+		return nil, ErrNotCommissioner
+	}
+	return league, nil
+}
+
+// groupWeeks splits a season's matchups, in week order, into weeks, listing the teams
+// without a game each week as byes
+func groupWeeks(teams []Team, matchups []models.Matchup) []Week {
+	var weeks []Week
+	for _, matchup := range matchups {
+		for len(weeks) < matchup.Week {
+			weeks = append(weeks, Week{Week: len(weeks) + 1})
+		}
+		weeks[matchup.Week-1].Matchups = append(weeks[matchup.Week-1].Matchups, matchup)
+	}
+
+	for i := range weeks {
+		playing := make(map[uuid.UUID]bool, len(teams))
+		for _, matchup := range weeks[i].Matchups {
+			playing[matchup.HomeTeamID] = true
+			playing[matchup.AwayTeamID] = true
+		}
+		for _, team := range teams {
+			if !playing[team.ID] {
+				weeks[i].Byes = append(weeks[i].Byes, team.ID)
+			}
+		}
+	}
+	return weeks
+}
@@ -0,0 +1,48 @@
+-- name: GetScheduleLeague :one
+SELECT id, commissioner_id, season, status::TEXT AS status, league_settings FROM leagues WHERE id = $1;
+
+-- name: ListScheduleTeams :many
+-- Ordered by ID so a seed generates the same schedule however the teams were created.
+SELECT id, name FROM fantasy_teams WHERE league_id = $1 ORDER BY id;
+
+-- name: DeleteLeagueMatchups :exec
+DELETE FROM league_matchups WHERE league_id = $1 AND season = $2;
+
+-- name: CreateLeagueMatchup :one
+INSERT INTO league_matchups (league_id, season, week, home_team_id, away_team_id, division, rivalry, season_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, (SELECT s.id FROM league_seasons s WHERE s.league_id = $1 AND s.year = $2))
+RETURNING *;
+
+-- name: ListLeagueMatchups :many
+SELECT * FROM league_matchups
+WHERE league_id = $1 AND season = $2
+ORDER BY week, rivalry DESC, created_at, id;
+
+-- name: ListUserMatchups :many
+-- Matchups the user's teams play in the current season of their active leagues, optionally
+-- limited to one week.
+SELECT
+    sqlc.embed(m),
+    l.name AS league_name,
+    ft.id  AS team_id
+FROM league_matchups m
+         JOIN leagues l ON l.id = m.league_id AND l.season = m.season
+         JOIN fantasy_teams ft ON ft.league_id = m.league_id AND ft.owner_id = sqlc.arg('user_id')::uuid
+WHERE (m.home_team_id = ft.id OR m.away_team_id = ft.id)
+  AND l.status IN ('PENDING', 'ACTIVE')
+  AND (sqlc.narg('week')::INTEGER IS NULL OR m.week = sqlc.narg('week')::INTEGER)
+ORDER BY m.week, l.name, m.id;
+
+-- name: GetLeagueMatchup :one
+SELECT * FROM league_matchups WHERE id = $1;
+
+-- name: UpsertMatchupResult :execrows
+-- Record a matchup's final score, replacing one recorded from an older scoring update.
+INSERT INTO matchup_results (matchup_id, home_points, away_points, finalized_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (matchup_id) DO UPDATE
+    SET home_points  = EXCLUDED.home_points,
+        away_points  = EXCLUDED.away_points,
+        finalized_at = EXCLUDED.finalized_at,
+        recorded_at  = NOW()
+WHERE matchup_results.finalized_at < EXCLUDED.finalized_at;
@@ -0,0 +1,34 @@
+-- name: ListMatchupResults :many
+-- A season's matchups that have a final score, in week order.
+SELECT
+    sqlc.embed(m),
+    r.home_points,
+    r.away_points
+FROM league_matchups m
+         JOIN matchup_results r ON r.matchup_id = m.id
+WHERE m.league_id = $1 AND m.season = $2
+ORDER BY m.week, m.id;
+
+-- name: DeleteLeagueStandings :exec
+DELETE FROM league_standings WHERE league_id = $1 AND season = $2;
+
+-- name: CreateLeagueStanding :exec
+INSERT INTO league_standings (league_id, season, fantasy_team_id, rank, wins, losses, ties, points_for, points_against,
+                              division_wins, division_losses, division_ties, tiebreaker, tiebreaker_team_id,
+                              tiebreaker_detail, through_week, computed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17);
+
+-- name: ListLeagueStandings :many
+SELECT s.*, ft.name AS team_name
+FROM league_standings s
+         JOIN fantasy_teams ft ON ft.id = s.fantasy_team_id
+WHERE s.league_id = $1 AND s.season = $2
+ORDER BY s.rank;
+
+-- name: ListStandingsLeagues :many
+-- Active leagues with a schedule committed for their current season.
+SELECT l.id
+FROM leagues l
+WHERE l.status IN ('PENDING', 'ACTIVE')
+  AND EXISTS (SELECT 1 FROM league_matchups m WHERE m.league_id = l.id AND m.season = l.season)
+ORDER BY l.id;
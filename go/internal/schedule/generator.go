@@ -0,0 +1,367 @@
+package schedule
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// generateAttempts is how many candidate schedules a seed draws before keeping the most balanced
+const generateAttempts = 25
+
+// Generate builds a regular season schedule for teams from a league's schedule settings.
+// The same teams, settings and seed always give the same schedule, so a previewed schedule
+// can be committed unchanged.
+//
+// Each week rivalries pinned to it are placed first, then the other teams are paired off by
+// how far behind they are on the games they owe each other, where division rivals are owed
+// DivisionGameWeight times as many games as other teams. Home and away alternate to keep
+// every team within a game of even. Several candidates are drawn and the one closest to the
+// owed games, with the fewest back-to-back rematches and the most even home/away split, wins.
+func Generate(teams []uuid.UUID, settings models.ScheduleSettings, seed int64) ([]Week, error) {
+	if len(teams) < 2 {
+		return nil, ErrTooFewTeams
+	}
+
+	g := &generator{
+		teams:    teams,
+		settings: settings,
+		index:    make(map[uuid.UUID]int, len(teams)),
+	}
+	for i, teamID := range teams {
+		g.index[teamID] = i
+	}
+	if err := g.checkTeams(); err != nil {
+		return nil, err
+	}
+	g.owed = g.owedGames()
+
+	rng := rand.New(rand.NewSource(seed))
+	var best *attempt
+	for range generateAttempts {
+		candidate := g.attempt(rng)
+		if best == nil || candidate.cost < best.cost {
+			best = candidate
+		}
+	}
+	return best.weeks, nil
+}
+
+type generator struct {
+	teams    []uuid.UUID
+	settings models.ScheduleSettings
+	index    map[uuid.UUID]int
+	owed     [][]float64 // games each pair of teams is owed over the season
+}
+
+// attempt is one candidate schedule and how far it strays from a balanced one
+type attempt struct {
+	weeks []Week
+	cost  float64
+}
+
+// checkTeams makes sure the divisions and rivalries only name the league's teams
+func (g *generator) checkTeams() error {
+	for _, division := range g.settings.Divisions {
+		for _, teamID := range division.TeamIDs {
+			if _, ok := g.index[teamID]; !ok {
+				return fmt.Errorf("%w: division %q lists %s", ErrUnknownTeam, division.Name, teamID)
+			}
+		}
+	}
+	for _, rivalry := range g.settings.Rivalries {
+		for _, teamID := range rivalry.TeamIDs {
+			if _, ok := g.index[teamID]; !ok {
+				return fmt.Errorf("%w: the week %d rivalry lists %s", ErrUnknownTeam, rivalry.Week, teamID)
+			}
+		}
+	}
+	return nil
+}
+
+// sameDivision reports whether two teams play in the same division
+func (g *generator) sameDivision(a, b int) bool {
+	division := g.settings.DivisionOf(g.teams[a])
+	return division != "" && division == g.settings.DivisionOf(g.teams[b])
+}
+
+// owedGames splits each team's games between its opponents by division weight. A pair is
+// owed the average of what each side's split gives it, since divisions may differ in size.
+func (g *generator) owedGames() [][]float64 {
+	n := len(g.teams)
+	games := float64(g.settings.Weeks)
+	if n%2 == 1 {
+		games = games * float64(n-1) / float64(n) // one team sits out every week
+	}
+
+	weights := make([][]float64, n)
+	totals := make([]float64, n)
+	for a := range n {
+		weights[a] = make([]float64, n)
+		for b := range n {
+			if a == b {
+				continue
+			}
+			weights[a][b] = 1
+			if g.sameDivision(a, b) {
+				weights[a][b] = float64(g.settings.DivisionGameWeight)
+			}
+			totals[a] += weights[a][b]
+		}
+	}
+
+	owed := make([][]float64, n)
+	for a := range n {
+		owed[a] = make([]float64, n)
+		for b := range n {
+			if a != b {
+				owed[a][b] = (games*weights[a][b]/totals[a] + games*weights[b][a]/totals[b]) / 2
+			}
+		}
+	}
+	return owed
+}
+
+func (g *generator) attempt(rng *rand.Rand) *attempt {
+	n := len(g.teams)
+	played := make([][]int, n)
+	lastMet := make([][]int, n)
+	lastHome := make([][]int, n) // who hosted the pair's last meeting, -1 before they meet
+	for a := range n {
+		played[a] = make([]int, n)
+		lastMet[a] = make([]int, n)
+		lastHome[a] = make([]int, n)
+		for b := range n {
+			lastHome[a][b] = -1
+		}
+	}
+	home := make([]int, n)
+	away := make([]int, n)
+	byes := make([]int, n)
+	rematches := 0
+
+	result := &attempt{weeks: make([]Week, g.settings.Weeks)}
+	for w := 1; w <= g.settings.Weeks; w++ {
+		week := Week{Week: w}
+		free := make(map[int]bool, n)
+		for a := range n {
+			free[a] = true
+		}
+
+		type pairing struct {
+			a, b    int
+			rivalry bool
+		}
+		var pairings []pairing
+		for _, rivalry := range g.settings.Rivalries {
+			a, b := g.index[rivalry.TeamIDs[0]], g.index[rivalry.TeamIDs[1]]
+			if rivalry.Week == w && free[a] && free[b] {
+				pairings = append(pairings, pairing{a: a, b: b, rivalry: true})
+				delete(free, a)
+				delete(free, b)
+			}
+		}
+
+		order := rng.Perm(n)
+		if len(free)%2 == 1 {
+			// The bye goes to the free team that has sat out least
+			bye := -1
+			for _, a := range order {
+				if free[a] && (bye == -1 || byes[a] < byes[bye]) {
+					bye = a
+				}
+			}
+			byes[bye]++
+			delete(free, bye)
+			week.Byes = append(week.Byes, g.teams[bye])
+		}
+
+		rest := make([]int, 0, len(free))
+		for _, a := range order {
+			if free[a] {
+				rest = append(rest, a)
+			}
+		}
+		for _, pair := range g.pairUp(rest, w, played, lastMet) {
+			pairings = append(pairings, pairing{a: pair[0], b: pair[1]})
+		}
+
+		for _, p := range pairings {
+			a, b := p.a, p.b
+			if played[a][b] > 0 && lastMet[a][b] == w-1 {
+				rematches++
+			}
+
+			// The team further behind on home games hosts; a level pair swaps from its last
+			// meeting, or tosses a coin before meeting at all
+			h, v := a, b
+			switch balanceA, balanceB := home[a]-away[a], home[b]-away[b]; {
+			case balanceA > balanceB:
+				h, v = b, a
+			case balanceA == balanceB && lastHome[a][b] == a:
+				h, v = b, a
+			case balanceA == balanceB && lastHome[a][b] == -1 && rng.Intn(2) == 0:
+				h, v = b, a
+			}
+
+			played[a][b]++
+			played[b][a]++
+			lastMet[a][b], lastMet[b][a] = w, w
+			lastHome[a][b], lastHome[b][a] = h, h
+			home[h]++
+			away[v]++
+
+			week.Matchups = append(week.Matchups, models.Matchup{
+				Week:       w,
+				HomeTeamID: g.teams[h],
+				AwayTeamID: g.teams[v],
+				Division:   g.sameDivision(h, v),
+				Rivalry:    p.rivalry,
+			})
+		}
+		result.weeks[w-1] = week
+	}
+
+	for a := range n {
+		for b := a + 1; b < n; b++ {
+			diff := float64(played[a][b]) - g.owed[a][b]
+			result.cost += diff * diff
+		}
+		balance := float64(home[a] - away[a])
+		result.cost += balance * balance / 2
+	}
+	result.cost += float64(rematches)
+	return result
+}
+
+// searchBudget bounds the matchings pairUp tries in a week before settling for greedy pairing
+const searchBudget = 5000
+
+// pairUp pairs off the free teams of week w. It searches for a pairing in which every pair
+// is still owed a game and didn't meet the week before, filling the team with the fewest
+// such opponents first; if none turns up within searchBudget it pairs each team with the
+// free opponent it is furthest behind on meeting.
+func (g *generator) pairUp(free []int, w int, played, lastMet [][]int) [][2]int {
+	score := func(a, b int) float64 {
+		s := float64(played[a][b]) - g.owed[a][b]
+		if played[a][b] > 0 && lastMet[a][b] == w-1 {
+			s += 0.5 // avoid back-to-back rematches
+		}
+		return s
+	}
+	owedGame := func(a, b int) bool {
+		return float64(played[a][b])+1 <= math.Ceil(g.owed[a][b]-1e-9) && (played[a][b] == 0 || lastMet[a][b] != w-1)
+	}
+
+	open := make(map[int]bool, len(free))
+	for _, a := range free {
+		open[a] = true
+	}
+	pairs := make([][2]int, 0, len(free)/2)
+	budget := searchBudget
+
+	var search func() bool
+	search = func() bool {
+		if len(open) == 0 {
+			return true
+		}
+		if budget--; budget < 0 {
+			return false
+		}
+		// Fill the team with the fewest owed opponents left
+		team, options := -1, []int(nil)
+		for _, a := range free {
+			if !open[a] {
+				continue
+			}
+			var candidates []int
+			for _, b := range free {
+				if b != a && open[b] && owedGame(a, b) {
+					candidates = append(candidates, b)
+				}
+			}
+			if team == -1 || len(candidates) < len(options) {
+				team, options = a, candidates
+			}
+		}
+		sort.SliceStable(options, func(i, j int) bool { return score(team, options[i]) < score(team, options[j]) })
+
+		delete(open, team)
+		for _, b := range options {
+			delete(open, b)
+			pairs = append(pairs, [2]int{team, b})
+			if search() {
+				return true
+			}
+			pairs = pairs[:len(pairs)-1]
+			open[b] = true
+		}
+		open[team] = true
+		return false
+	}
+	if search() {
+		return pairs
+	}
+
+	// A failed search leaves every team open
+	pairs = pairs[:0]
+	for _, a := range free {
+		if !open[a] {
+			continue
+		}
+		delete(open, a)
+		opponent := -1
+		for _, b := range free {
+			if open[b] && (opponent == -1 || score(a, b) < score(a, opponent)) {
+				opponent = b
+			}
+		}
+		delete(open, opponent)
+		pairs = append(pairs, [2]int{a, opponent})
+	}
+	return pairs
+}
+
+// summarize tallies each team's home, away, division games and byes in a schedule
+func summarize(teams []Team, settings models.ScheduleSettings, weeks []Week) []TeamSummary {
+	summaries := make([]TeamSummary, len(teams))
+	index := make(map[uuid.UUID]int, len(teams))
+	for i, team := range teams {
+		summaries[i] = TeamSummary{
+			FantasyTeamID: team.ID,
+			Name:          team.Name,
+			Division:      settings.DivisionOf(team.ID),
+		}
+		index[team.ID] = i
+	}
+
+	for _, week := range weeks {
+		playing := make(map[uuid.UUID]bool, len(teams))
+		for _, matchup := range week.Matchups {
+			playing[matchup.HomeTeamID] = true
+			playing[matchup.AwayTeamID] = true
+			if i, ok := index[matchup.HomeTeamID]; ok {
+				summaries[i].HomeGames++
+				if matchup.Division {
+					summaries[i].DivisionGames++
+				}
+			}
+			if i, ok := index[matchup.AwayTeamID]; ok {
+				summaries[i].AwayGames++
+				if matchup.Division {
+					summaries[i].DivisionGames++
+				}
+			}
+		}
+		for i, team := range teams {
+			if !playing[team.ID] {
+				summaries[i].Byes++
+			}
+		}
+	}
+	return summaries
+}
@@ -0,0 +1,303 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/schedule/db"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	GetLeagueMatchup(ctx context.Context, id uuid.UUID) (db.LeagueMatchup, error)
+	GetScheduleLeague(ctx context.Context, id uuid.UUID) (db.GetScheduleLeagueRow, error)
+	ListLeagueMatchups(ctx context.Context, arg db.ListLeagueMatchupsParams) ([]db.LeagueMatchup, error)
+	ListLeagueStandings(ctx context.Context, arg db.ListLeagueStandingsParams) ([]db.ListLeagueStandingsRow, error)
+	ListMatchupResults(ctx context.Context, arg db.ListMatchupResultsParams) ([]db.ListMatchupResultsRow, error)
+	ListScheduleTeams(ctx context.Context, leagueID uuid.UUID) ([]db.ListScheduleTeamsRow, error)
+	ListStandingsLeagues(ctx context.Context) ([]uuid.UUID, error)
+	ListUserMatchups(ctx context.Context, arg db.ListUserMatchupsParams) ([]db.ListUserMatchupsRow, error)
+	UpsertMatchupResult(ctx context.Context, arg db.UpsertMatchupResultParams) (int64, error)
+}
+
+// Repository implements schedule data access operations
+type Repository struct {
+	queries Querier
+	sqlDB   *sql.DB
+}
+
+// NewRepository creates a new schedule repository. sqlDB replaces a season's matchups in a
+// single transaction.
+func NewRepository(querier Querier, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: querier,
+		sqlDB:   sqlDB,
+	}
+}
+
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
+// GetLeague retrieves a league with its schedule settings
+func (r *Repository) GetLeague(ctx context.Context, leagueID uuid.UUID) (*League, error) {
+	row, err := r.queries.GetScheduleLeague(ctx, leagueID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrLeagueNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league: %w", err)
+	}
+
+	var settings interface{}
+	if len(row.LeagueSettings) > 0 {
+		if err := json.Unmarshal(row.LeagueSettings, &settings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+
+	return &League{
+		ID:             row.ID,
+		CommissionerID: row.CommissionerID,
+		Season:         row.Season,
+		Status:         models.LeagueStatus(row.Status),
+		Settings:       models.SettingsSchedule(settings),
+	}, nil
+}
+
+// ListTeams retrieves a league's teams in ID order
+func (r *Repository) ListTeams(ctx context.Context, leagueID uuid.UUID) ([]Team, error) {
+	rows, err := r.queries.ListScheduleTeams(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fantasy teams: %w", err)
+	}
+
+	teams := make([]Team, len(rows))
+	for i, row := range rows {
+		teams[i] = Team{ID: row.ID, Name: row.Name}
+	}
+	return teams, nil
+}
+
+// ReplaceMatchups saves weeks as a league's season schedule in place of any it had
+func (r *Repository) ReplaceMatchups(ctx context.Context, leagueID uuid.UUID, season string, weeks []Week) ([]models.Matchup, error) {
+	var matchups []models.Matchup
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassLeagueSchedule, leagueID, txQueries, func(q *db.Queries) error {
+		matchups = nil
+		if err := q.DeleteLeagueMatchups(ctx, db.DeleteLeagueMatchupsParams{LeagueID: leagueID, Season: season}); err != nil {
+			return fmt.Errorf("failed to clear schedule: %w", err)
+		}
+		for _, week := range weeks {
+			for _, matchup := range week.Matchups {
+				row, err := q.CreateLeagueMatchup(ctx, db.CreateLeagueMatchupParams{
+					LeagueID:   leagueID,
+					Season:     season,
+					Week:       int32(matchup.Week),
+					HomeTeamID: matchup.HomeTeamID,
+					AwayTeamID: matchup.AwayTeamID,
+					Division:   matchup.Division,
+					Rivalry:    matchup.Rivalry,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create matchup: %w", err)
+				}
+				matchups = append(matchups, dbMatchupToModel(row))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matchups, nil
+}
+
+// ListMatchups retrieves a league's season schedule in week order
+func (r *Repository) ListMatchups(ctx context.Context, leagueID uuid.UUID, season string) ([]models.Matchup, error) {
+	rows, err := r.queries.ListLeagueMatchups(ctx, db.ListLeagueMatchupsParams{LeagueID: leagueID, Season: season})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list matchups: %w", err)
+	}
+
+	matchups := make([]models.Matchup, len(rows))
+	for i, row := range rows {
+		matchups[i] = dbMatchupToModel(row)
+	}
+	return matchups, nil
+}
+
+// ListUserMatchups retrieves the matchups a user's teams play in the current season of their
+// active leagues, in week order. A nil week lists the whole season.
+func (r *Repository) ListUserMatchups(ctx context.Context, userID uuid.UUID, week *int) ([]UserMatchup, error) {
+	params := db.ListUserMatchupsParams{UserID: userID}
+	if week != nil {
+		params.Week = sql.NullInt32{Int32: int32(*week), Valid: true}
+	}
+	rows, err := r.queries.ListUserMatchups(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user matchups: %w", err)
+	}
+
+	matchups := make([]UserMatchup, len(rows))
+	for i, row := range rows {
+		matchups[i] = UserMatchup{
+			Matchup:    dbMatchupToModel(row.LeagueMatchup),
+			LeagueName: row.LeagueName,
+			TeamID:     row.TeamID,
+		}
+	}
+	return matchups, nil
+}
+
+// GetMatchup retrieves a matchup of a committed schedule
+func (r *Repository) GetMatchup(ctx context.Context, id uuid.UUID) (*models.Matchup, error) {
+	row, err := r.queries.GetLeagueMatchup(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrMatchupNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matchup: %w", err)
+	}
+	matchup := dbMatchupToModel(row)
+	return &matchup, nil
+}
+
+// RecordMatchupResult records a matchup's final score. It returns false without error when
+// the matchup already has a score recorded from the same or a later scoring update.
+func (r *Repository) RecordMatchupResult(ctx context.Context, result MatchupResult) (bool, error) {
+	rows, err := r.queries.UpsertMatchupResult(ctx, db.UpsertMatchupResultParams{
+		MatchupID:   result.MatchupID,
+		HomePoints:  result.HomePoints,
+		AwayPoints:  result.AwayPoints,
+		FinalizedAt: result.FinalizedAt,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to record matchup result: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// ListMatchupResults retrieves the matchups of a league's season that have a final score, in
+// week order
+func (r *Repository) ListMatchupResults(ctx context.Context, leagueID uuid.UUID, season string) ([]PlayedMatchup, error) {
+	rows, err := r.queries.ListMatchupResults(ctx, db.ListMatchupResultsParams{LeagueID: leagueID, Season: season})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list matchup results: %w", err)
+	}
+
+	played := make([]PlayedMatchup, len(rows))
+	for i, row := range rows {
+		played[i] = PlayedMatchup{
+			Matchup:    dbMatchupToModel(row.LeagueMatchup),
+			HomePoints: row.HomePoints,
+			AwayPoints: row.AwayPoints,
+		}
+	}
+	return played, nil
+}
+
+// ReplaceStandings saves a league's season standings in place of the ones computed before
+func (r *Repository) ReplaceStandings(ctx context.Context, standings Standings) error {
+	return sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassLeagueStandings, standings.LeagueID, txQueries, func(q *db.Queries) error {
+		if err := q.DeleteLeagueStandings(ctx, db.DeleteLeagueStandingsParams{LeagueID: standings.LeagueID, Season: standings.Season}); err != nil {
+			return fmt.Errorf("failed to clear standings: %w", err)
+		}
+		for _, standing := range standings.Teams {
+			params := db.CreateLeagueStandingParams{
+				LeagueID:       standings.LeagueID,
+				Season:         standings.Season,
+				FantasyTeamID:  standing.FantasyTeamID,
+				Rank:           int32(standing.Rank),
+				Wins:           int32(standing.Record.Wins),
+				Losses:         int32(standing.Record.Losses),
+				Ties:           int32(standing.Record.Ties),
+				PointsFor:      standing.PointsFor,
+				PointsAgainst:  standing.PointsAgainst,
+				DivisionWins:   int32(standing.DivisionRecord.Wins),
+				DivisionLosses: int32(standing.DivisionRecord.Losses),
+				DivisionTies:   int32(standing.DivisionRecord.Ties),
+				ThroughWeek:    int32(standings.ThroughWeek),
+				ComputedAt:     *standings.ComputedAt,
+			}
+			if tiebreaker := standing.Tiebreaker; tiebreaker != nil {
+				params.Tiebreaker = sql.NullString{String: string(tiebreaker.Tiebreaker), Valid: true}
+				params.TiebreakerTeamID = uuid.NullUUID{UUID: tiebreaker.OverTeamID, Valid: true}
+				params.TiebreakerDetail = sql.NullString{String: tiebreaker.Explanation, Valid: true}
+			}
+			if err := q.CreateLeagueStanding(ctx, params); err != nil {
+				return fmt.Errorf("failed to save standing: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetStandings retrieves a league's saved season standings, teams in rank order. It returns
+// nil without error when the season's standings haven't been computed yet.
+func (r *Repository) GetStandings(ctx context.Context, leagueID uuid.UUID, season string) (*Standings, error) {
+	rows, err := r.queries.ListLeagueStandings(ctx, db.ListLeagueStandingsParams{LeagueID: leagueID, Season: season})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list standings: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	computedAt := rows[0].ComputedAt
+	standings := &Standings{
+		LeagueID:    leagueID,
+		Season:      season,
+		ThroughWeek: int(rows[0].ThroughWeek),
+		Teams:       make([]Standing, len(rows)),
+		ComputedAt:  &computedAt,
+	}
+	for i, row := range rows {
+		standings.Teams[i] = Standing{
+			Rank:           int(row.Rank),
+			FantasyTeamID:  row.FantasyTeamID,
+			Name:           row.TeamName,
+			Record:         Record{Wins: int(row.Wins), Losses: int(row.Losses), Ties: int(row.Ties)},
+			PointsFor:      row.PointsFor,
+			PointsAgainst:  row.PointsAgainst,
+			DivisionRecord: Record{Wins: int(row.DivisionWins), Losses: int(row.DivisionLosses), Ties: int(row.DivisionTies)},
+		}
+		if row.Tiebreaker.Valid {
+			standings.Teams[i].Tiebreaker = &AppliedTiebreaker{
+				Tiebreaker:  models.Tiebreaker(row.Tiebreaker.String),
+				OverTeamID:  row.TiebreakerTeamID.UUID,
+				Explanation: row.TiebreakerDetail.String,
+			}
+		}
+	}
+	return standings, nil
+}
+
+// ListStandingsLeagues retrieves the active leagues with a schedule committed for their
+// current season
+func (r *Repository) ListStandingsLeagues(ctx context.Context) ([]uuid.UUID, error) {
+	leagueIDs, err := r.queries.ListStandingsLeagues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list standings leagues: %w", err)
+	}
+	return leagueIDs, nil
+}
+
+func dbMatchupToModel(row db.LeagueMatchup) models.Matchup {
+	return models.Matchup{
+		ID:         row.ID,
+		LeagueID:   row.LeagueID,
+		Season:     row.Season,
+		Week:       int(row.Week),
+		HomeTeamID: row.HomeTeamID,
+		AwayTeamID: row.AwayTeamID,
+		Division:   row.Division,
+		Rivalry:    row.Rivalry,
+		CreatedAt:  row.CreatedAt,
+	}
+}
@@ -0,0 +1,50 @@
+package schedule
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+)
+
+// StandingsJob is the job kind of the weekly standings recompute
+const StandingsJob = "schedule.recompute_standings"
+
+// StandingsRecomputer recomputes league standings from matchup results
+type StandingsRecomputer interface {
+	RecomputeAllStandings(ctx context.Context) (int, error)
+}
+
+// StandingsRunnerConfig holds configuration for the weekly standings recompute
+type StandingsRunnerConfig struct {
+	HourUTC  int          // Hour of the day, in UTC, the recompute runs at
+	WeeklyOn time.Weekday // Day of the week, in UTC, the recompute runs on
+}
+
+// DefaultStandingsRunnerConfig returns default standings runner configuration
+func DefaultStandingsRunnerConfig() StandingsRunnerConfig {
+	return StandingsRunnerConfig{
+		HourUTC:  11,           // before the weekly digests go out
+		WeeklyOn: time.Tuesday, // once Monday night's scores are final
+	}
+}
+
+// ScheduleStandingsRecompute schedules the recompute of every active league's standings once a
+// week on the job worker. Each league's standings are replaced whole, so a retried run
+// recomputes the leagues done before the failure without harm.
+func ScheduleStandingsRecompute(worker *jobs.Worker, recomputer StandingsRecomputer, config StandingsRunnerConfig) {
+	log.Printf("Scheduling standings recompute on %ss at %02d:00 UTC", config.WeeklyOn, config.HourUTC)
+
+	worker.Schedule(StandingsJob, jobs.DailyAt(config.HourUTC, 0, time.UTC), func(ctx context.Context, job jobs.Job) error {
+		if job.RunAt.UTC().Weekday() != config.WeeklyOn {
+			return nil
+		}
+		recomputed, err := recomputer.RecomputeAllStandings(ctx)
+		if err != nil {
+			return err
+		}
+		log.Printf("Recomputed the standings of %d leagues", recomputed)
+		return nil
+	})
+}
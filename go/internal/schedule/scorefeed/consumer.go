@@ -0,0 +1,219 @@
+package scorefeed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/natsconfig"
+	"github.com/mcdev12/dynasty/go/internal/scoring"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog/log"
+)
+
+// MatchupResultRecorder records the final score carried by a live scoring update.
+// Implementations must be idempotent since JetStream may redeliver a message.
+type MatchupResultRecorder interface {
+	RecordMatchupResult(ctx context.Context, update scoring.MatchupScoreUpdate) (bool, error)
+}
+
+// Config holds configuration for the matchup result consumer
+type Config struct {
+	NATS           natsconfig.Config
+	StreamName     string
+	ConsumerName   string
+	SubjectFilters []string
+	MaxDeliver     int           // Max delivery attempts
+	AckWait        time.Duration // How long to wait for ack
+	MaxAckPending  int           // Max messages pending ack
+	MaxReconnects  int
+	ReconnectWait  time.Duration
+}
+
+// DefaultConfig returns default matchup result consumer configuration
+func DefaultConfig() Config {
+	return Config{
+		NATS:           natsconfig.Default(),
+		StreamName:     scoring.LiveScoringStream,
+		ConsumerName:   "matchup-results",
+		SubjectFilters: []string{scoring.LiveScoringSubjectPrefix + ".>"},
+		MaxDeliver:     10,
+		AckWait:        30 * time.Second,
+		MaxAckPending:  100,
+		MaxReconnects:  -1, // Infinite
+		ReconnectWait:  2 * time.Second,
+	}
+}
+
+// Consumer records the final scores of matchups from the live scoring stream
+type Consumer struct {
+	recorder MatchupResultRecorder
+	nc       *nats.Conn
+	js       jetstream.JetStream
+	consumer jetstream.Consumer
+	config   Config
+}
+
+// NewConsumer connects to NATS and creates or binds the durable matchup result consumer
+func NewConsumer(recorder MatchupResultRecorder, config Config) (*Consumer, error) {
+	opts := []nats.Option{
+		nats.MaxReconnects(config.MaxReconnects),
+		nats.ReconnectWait(config.ReconnectWait),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			log.Error().Err(err).Msg("NATS disconnected")
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Info().Str("url", nc.ConnectedUrl()).Msg("NATS reconnected")
+		}),
+	}
+
+	nc, err := config.NATS.Connect(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("create JetStream context: %w", err)
+	}
+
+	c := &Consumer{
+		recorder: recorder,
+		nc:       nc,
+		js:       js,
+		config:   config,
+	}
+
+	if err := c.ensureConsumer(context.Background()); err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("ensure consumer: %w", err)
+	}
+
+	return c, nil
+}
+
+// ensureConsumer creates or gets the JetStream consumer
+func (c *Consumer) ensureConsumer(ctx context.Context) error {
+	stream, err := c.js.Stream(ctx, c.config.StreamName)
+	if err != nil {
+		return fmt.Errorf("get stream: %w", err)
+	}
+
+	consumerConfig := jetstream.ConsumerConfig{
+		Name:           c.config.ConsumerName,
+		Durable:        c.config.ConsumerName,
+		Description:    "Matchup result consumer recording final scores",
+		FilterSubjects: c.config.SubjectFilters,
+		DeliverPolicy:  jetstream.DeliverAllPolicy, // Catch up on any scores finalized while offline
+		AckPolicy:      jetstream.AckExplicitPolicy,
+		MaxDeliver:     c.config.MaxDeliver,
+		AckWait:        c.config.AckWait,
+		MaxAckPending:  c.config.MaxAckPending,
+		ReplayPolicy:   jetstream.ReplayInstantPolicy,
+	}
+
+	consumer, err := stream.Consumer(ctx, c.config.ConsumerName)
+	if err != nil {
+		consumer, err = stream.CreateConsumer(ctx, consumerConfig)
+		if err != nil {
+			return fmt.Errorf("create consumer: %w", err)
+		}
+		log.Info().
+			Str("consumer", c.config.ConsumerName).
+			Str("stream", c.config.StreamName).
+			Msg("created JetStream consumer")
+	} else {
+		log.Info().
+			Str("consumer", c.config.ConsumerName).
+			Str("stream", c.config.StreamName).
+			Msg("using existing JetStream consumer")
+	}
+
+	c.consumer = consumer
+	return nil
+}
+
+// Start consumes scoring updates until ctx is cancelled
+func (c *Consumer) Start(ctx context.Context) error {
+	log.Info().
+		Str("consumer", c.config.ConsumerName).
+		Str("stream", c.config.StreamName).
+		Msg("starting matchup result consumer")
+
+	messageCh := make(chan jetstream.Msg, 100)
+
+	consumeCtx, err := c.consumer.Consume(func(msg jetstream.Msg) {
+		select {
+		case messageCh <- msg:
+		case <-ctx.Done():
+			msg.Nak()
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("start consumer: %w", err)
+	}
+	defer consumeCtx.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("matchup result consumer shutting down")
+			return nil
+		case msg := <-messageCh:
+			if err := c.processMessage(ctx, msg); err != nil {
+				log.Error().
+					Err(err).
+					Str("subject", msg.Subject()).
+					Msg("failed to record matchup result")
+				if nakErr := msg.Nak(); nakErr != nil {
+					log.Error().Err(nakErr).Msg("failed to NAK message")
+				}
+				continue
+			}
+			if ackErr := msg.Ack(); ackErr != nil {
+				log.Error().Err(ackErr).Msg("failed to ACK message")
+			}
+		}
+	}
+}
+
+// processMessage records the final score carried by a single scoring update
+func (c *Consumer) processMessage(ctx context.Context, msg jetstream.Msg) error {
+	var update scoring.MatchupScoreUpdate
+	if err := json.Unmarshal(msg.Data(), &update); err != nil {
+		return fmt.Errorf("unmarshal scoring update: %w", err)
+	}
+	if update.MatchupID == uuid.Nil {
+		return errors.New("scoring update has no matchup ID")
+	}
+	if update.UpdatedAt.IsZero() {
+		// Without a publish time a correction can't be ordered against the recorded score
+		update.UpdatedAt = time.Now()
+	}
+
+	recorded, err := c.recorder.RecordMatchupResult(ctx, update)
+	if err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("matchup_id", update.MatchupID.String()).
+		Bool("final", update.Final).
+		Bool("recorded", recorded).
+		Msg("processed scoring update")
+
+	return nil
+}
+
+// Close closes the NATS connection
+func (c *Consumer) Close() error {
+	if c.nc != nil {
+		c.nc.Close()
+	}
+	return nil
+}
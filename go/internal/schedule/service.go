@@ -0,0 +1,303 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	schedulev1 "github.com/mcdev12/dynasty/go/internal/genproto/schedule/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/schedule/v1/schedulev1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScheduleApp defines what the service layer needs from the schedule application
+type ScheduleApp interface {
+	PreviewSchedule(ctx context.Context, req PreviewRequest) (*Schedule, error)
+	CommitSchedule(ctx context.Context, req CommitRequest) (*Schedule, error)
+	GetSchedule(ctx context.Context, leagueID uuid.UUID) (*Schedule, error)
+	ListUserMatchups(ctx context.Context, userID uuid.UUID, week *int) ([]UserMatchup, error)
+	GetStandings(ctx context.Context, leagueID uuid.UUID) (*Standings, error)
+}
+
+// Service implements the ScheduleService gRPC interface. Requests without a signed in user
+// are trusted callers.
+type Service struct {
+	app ScheduleApp
+}
+
+// NewService creates a new schedule gRPC service
+func NewService(app ScheduleApp) *Service {
+	return &Service{
+		app: app,
+	}
+}
+
+// Verify that Service implements the ScheduleServiceHandler interface
+var _ schedulev1connect.ScheduleServiceHandler = (*Service)(nil)
+
+// PreviewSchedule generates a schedule without saving it. Commissioner only.
+func (s *Service) PreviewSchedule(ctx context.Context, req *connect.Request[schedulev1.PreviewScheduleRequest]) (*connect.Response[schedulev1.PreviewScheduleResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.app.PreviewSchedule(ctx, PreviewRequest{
+		LeagueID:    leagueID,
+		Seed:        req.Msg.Seed,
+		RequestedBy: interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&schedulev1.PreviewScheduleResponse{
+		Schedule: s.scheduleToProto(schedule),
+	}), nil
+}
+
+// CommitSchedule saves the schedule a previewed seed generates. Commissioner only.
+func (s *Service) CommitSchedule(ctx context.Context, req *connect.Request[schedulev1.CommitScheduleRequest]) (*connect.Response[schedulev1.CommitScheduleResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.app.CommitSchedule(ctx, CommitRequest{
+		LeagueID:    leagueID,
+		Seed:        req.Msg.Seed,
+		CommittedBy: interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&schedulev1.CommitScheduleResponse{
+		Schedule: s.scheduleToProto(schedule),
+	}), nil
+}
+
+// GetSchedule retrieves a league's committed schedule for its current season
+func (s *Service) GetSchedule(ctx context.Context, req *connect.Request[schedulev1.GetScheduleRequest]) (*connect.Response[schedulev1.GetScheduleResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.app.GetSchedule(ctx, leagueID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&schedulev1.GetScheduleResponse{
+		Schedule: s.scheduleToProto(schedule),
+	}), nil
+}
+
+// ListUserMatchups lists the matchups a user's teams play this season. Signed in users can
+// only list their own.
+func (s *Service) ListUserMatchups(ctx context.Context, req *connect.Request[schedulev1.ListUserMatchupsRequest]) (*connect.Response[schedulev1.ListUserMatchupsResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if actingUser, ok := interceptors.ActingUserFromContext(ctx); ok && actingUser != userID {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("cannot list another user's matchups"))
+	}
+
+	var week *int
+	if req.Msg.Week != nil {
+		w := int(*req.Msg.Week)
+		week = &w
+	}
+	matchups, err := s.app.ListUserMatchups(ctx, userID, week)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	resp := &schedulev1.ListUserMatchupsResponse{
+		Matchups: make([]*schedulev1.UserMatchup, len(matchups)),
+	}
+	for i, matchup := range matchups {
+		resp.Matchups[i] = &schedulev1.UserMatchup{
+			Matchup:    s.matchupToProto(matchup.Matchup),
+			LeagueId:   matchup.Matchup.LeagueID.String(),
+			LeagueName: matchup.LeagueName,
+			Season:     matchup.Matchup.Season,
+			TeamId:     matchup.TeamID.String(),
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetStandings retrieves a league's standings for its current season
+func (s *Service) GetStandings(ctx context.Context, req *connect.Request[schedulev1.GetStandingsRequest]) (*connect.Response[schedulev1.GetStandingsResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := s.app.GetStandings(ctx, leagueID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&schedulev1.GetStandingsResponse{
+		Standings: s.standingsToProto(standings),
+	}), nil
+}
+
+// toConnectError maps app errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrLeagueNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrTooFewTeams), errors.Is(err, ErrUnknownTeam), errors.Is(err, ErrSeasonOver):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, ErrNotCommissioner):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// Conversion methods
+
+// scheduleToProto converts a schedule to proto
+func (s *Service) scheduleToProto(schedule *Schedule) *schedulev1.Schedule {
+	protoSchedule := &schedulev1.Schedule{
+		LeagueId: schedule.LeagueID.String(),
+		Season:   schedule.Season,
+		Seed:     schedule.Seed,
+		Weeks:    make([]*schedulev1.ScheduleWeek, len(schedule.Weeks)),
+		Teams:    make([]*schedulev1.TeamScheduleSummary, len(schedule.Teams)),
+	}
+
+	for i, week := range schedule.Weeks {
+		protoWeek := &schedulev1.ScheduleWeek{
+			Week:       int32(week.Week),
+			Matchups:   make([]*schedulev1.Matchup, len(week.Matchups)),
+			ByeTeamIds: make([]string, len(week.Byes)),
+		}
+		for j, matchup := range week.Matchups {
+			protoMatchup := &schedulev1.Matchup{
+				Week:       int32(matchup.Week),
+				HomeTeamId: matchup.HomeTeamID.String(),
+				AwayTeamId: matchup.AwayTeamID.String(),
+				Division:   matchup.Division,
+				Rivalry:    matchup.Rivalry,
+			}
+			if matchup.ID != uuid.Nil {
+				protoMatchup.Id = matchup.ID.String()
+				protoMatchup.CreatedAt = timestamppb.New(matchup.CreatedAt)
+			}
+			protoWeek.Matchups[j] = protoMatchup
+		}
+		for j, teamID := range week.Byes {
+			protoWeek.ByeTeamIds[j] = teamID.String()
+		}
+		protoSchedule.Weeks[i] = protoWeek
+	}
+
+	for i, team := range schedule.Teams {
+		protoTeam := &schedulev1.TeamScheduleSummary{
+			FantasyTeamId: team.FantasyTeamID.String(),
+			Name:          team.Name,
+			HomeGames:     int32(team.HomeGames),
+			AwayGames:     int32(team.AwayGames),
+			DivisionGames: int32(team.DivisionGames),
+			Byes:          int32(team.Byes),
+		}
+		if team.Division != "" {
+			division := team.Division
+			protoTeam.Division = &division
+		}
+		protoSchedule.Teams[i] = protoTeam
+	}
+	return protoSchedule
+}
+
+// matchupToProto converts a matchup to proto. Previewed matchups have no ID yet.
+func (s *Service) matchupToProto(matchup models.Matchup) *schedulev1.Matchup {
+	protoMatchup := &schedulev1.Matchup{
+		Week:       int32(matchup.Week),
+		HomeTeamId: matchup.HomeTeamID.String(),
+		AwayTeamId: matchup.AwayTeamID.String(),
+		Division:   matchup.Division,
+		Rivalry:    matchup.Rivalry,
+	}
+	if matchup.ID != uuid.Nil {
+		protoMatchup.Id = matchup.ID.String()
+		protoMatchup.CreatedAt = timestamppb.New(matchup.CreatedAt)
+	}
+	return protoMatchup
+}
+
+// standingsToProto converts standings to proto
+func (s *Service) standingsToProto(standings *Standings) *schedulev1.Standings {
+	protoStandings := &schedulev1.Standings{
+		LeagueId:    standings.LeagueID.String(),
+		Season:      standings.Season,
+		ThroughWeek: int32(standings.ThroughWeek),
+		Tiebreakers: make([]schedulev1.Tiebreaker, len(standings.Tiebreakers)),
+		Teams:       make([]*schedulev1.Standing, len(standings.Teams)),
+	}
+	if standings.ComputedAt != nil {
+		protoStandings.ComputedAt = timestamppb.New(*standings.ComputedAt)
+	}
+	for i, tiebreaker := range standings.Tiebreakers {
+		protoStandings.Tiebreakers[i] = tiebreakerToProto(tiebreaker)
+	}
+
+	for i, standing := range standings.Teams {
+		protoStanding := &schedulev1.Standing{
+			Rank:           int32(standing.Rank),
+			FantasyTeamId:  standing.FantasyTeamID.String(),
+			Name:           standing.Name,
+			Record:         recordToProto(standing.Record),
+			PointsFor:      standing.PointsFor,
+			PointsAgainst:  standing.PointsAgainst,
+			DivisionRecord: recordToProto(standing.DivisionRecord),
+		}
+		if standing.Division != "" {
+			division := standing.Division
+			protoStanding.Division = &division
+		}
+		if standing.Tiebreaker != nil {
+			protoStanding.Tiebreaker = &schedulev1.AppliedTiebreaker{
+				Tiebreaker:  tiebreakerToProto(standing.Tiebreaker.Tiebreaker),
+				OverTeamId:  standing.Tiebreaker.OverTeamID.String(),
+				Explanation: standing.Tiebreaker.Explanation,
+			}
+		}
+		protoStandings.Teams[i] = protoStanding
+	}
+	return protoStandings
+}
+
+func recordToProto(record Record) *schedulev1.Record {
+	return &schedulev1.Record{
+		Wins:   int32(record.Wins),
+		Losses: int32(record.Losses),
+		Ties:   int32(record.Ties),
+	}
+}
+
+func tiebreakerToProto(tiebreaker models.Tiebreaker) schedulev1.Tiebreaker {
+	switch tiebreaker {
+	case models.TiebreakerHeadToHead:
+		return schedulev1.Tiebreaker_TIEBREAKER_HEAD_TO_HEAD
+	case models.TiebreakerPointsFor:
+		return schedulev1.Tiebreaker_TIEBREAKER_POINTS_FOR
+	case models.TiebreakerDivisionRecord:
+		return schedulev1.Tiebreaker_TIEBREAKER_DIVISION_RECORD
+	case models.TiebreakerCoinFlip:
+		return schedulev1.Tiebreaker_TIEBREAKER_COIN_FLIP
+	default:
+		return schedulev1.Tiebreaker_TIEBREAKER_UNSPECIFIED
+	}
+}
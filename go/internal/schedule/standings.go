@@ -0,0 +1,213 @@
+package schedule
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// ComputeStandings ranks a league's teams by win percentage over the played matchups, ties
+// counting as half a win. Teams level on record are ranked by the league's tiebreakers in
+// order: each splits the tied group into smaller groups, which the remaining tiebreakers
+// settle in turn. A tiebreaker that can't tell the group apart, such as head-to-head between
+// teams that haven't all met, is skipped. seed makes the coin flip land the same way every
+// time the standings are computed; pass the league ID and season.
+func ComputeStandings(teams []Team, settings models.ScheduleSettings, played []PlayedMatchup, seed string) []Standing {
+	standings := make([]*Standing, len(teams))
+	byTeam := make(map[uuid.UUID]*Standing, len(teams))
+	for i, team := range teams {
+		standings[i] = &Standing{
+			FantasyTeamID: team.ID,
+			Name:          team.Name,
+			Division:      settings.DivisionOf(team.ID),
+		}
+		byTeam[team.ID] = standings[i]
+	}
+
+	for _, game := range played {
+		home, away := byTeam[game.Matchup.HomeTeamID], byTeam[game.Matchup.AwayTeamID]
+		if home == nil || away == nil {
+			continue
+		}
+		home.PointsFor += game.HomePoints
+		home.PointsAgainst += game.AwayPoints
+		away.PointsFor += game.AwayPoints
+		away.PointsAgainst += game.HomePoints
+		addResult(&home.Record, &away.Record, game.HomePoints, game.AwayPoints)
+		if game.Matchup.Division {
+			addResult(&home.DivisionRecord, &away.DivisionRecord, game.HomePoints, game.AwayPoints)
+		}
+	}
+
+	ranker := &standingsRanker{played: played, seed: seed}
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Record.WinPct() > standings[j].Record.WinPct()
+	})
+	var ranked []*Standing
+	for _, group := range splitBy(standings, func(s *Standing) float64 { return s.Record.WinPct() }) {
+		ranked = append(ranked, ranker.rank(group, settings.Tiebreakers)...)
+	}
+
+	result := make([]Standing, len(ranked))
+	for i, standing := range ranked {
+		standing.Rank = i + 1
+		result[i] = *standing
+	}
+	return result
+}
+
+// addResult adds a game's outcome to both teams' records
+func addResult(home, away *Record, homePoints, awayPoints float64) {
+	switch {
+	case homePoints > awayPoints:
+		home.Wins++
+		away.Losses++
+	case awayPoints > homePoints:
+		away.Wins++
+		home.Losses++
+	default:
+		home.Ties++
+		away.Ties++
+	}
+}
+
+// standingsRanker applies tiebreakers to groups of teams level on record
+type standingsRanker struct {
+	played []PlayedMatchup
+	seed   string
+}
+
+// tiebreakValue is where a tiebreaker puts a team, higher ranking first, and how to show it
+type tiebreakValue struct {
+	value   float64
+	display string
+}
+
+// rank orders a group of teams level on record, noting on each team a tiebreaker ranks ahead
+// of the next team which tiebreaker did it
+func (r *standingsRanker) rank(group []*Standing, tiebreakers []models.Tiebreaker) []*Standing {
+	if len(group) < 2 || len(tiebreakers) == 0 {
+		return group
+	}
+
+	tiebreaker := tiebreakers[0]
+	values, ok := r.evaluate(tiebreaker, group)
+	if !ok {
+		return r.rank(group, tiebreakers[1:])
+	}
+	sort.SliceStable(group, func(i, j int) bool {
+		return values[group[i].FantasyTeamID].value > values[group[j].FantasyTeamID].value
+	})
+	subgroups := splitBy(group, func(s *Standing) float64 { return values[s.FantasyTeamID].value })
+	if len(subgroups) == 1 {
+		return r.rank(group, tiebreakers[1:])
+	}
+
+	var ranked []*Standing
+	for _, subgroup := range subgroups {
+		subgroup = r.rank(subgroup, tiebreakers[1:])
+		if len(ranked) > 0 {
+			ahead, behind := ranked[len(ranked)-1], subgroup[0]
+			ahead.Tiebreaker = &AppliedTiebreaker{
+				Tiebreaker:  tiebreaker,
+				OverTeamID:  behind.FantasyTeamID,
+				Explanation: explainTiebreaker(tiebreaker, behind.Name, values[ahead.FantasyTeamID], values[behind.FantasyTeamID]),
+			}
+		}
+		ranked = append(ranked, subgroup...)
+	}
+	return ranked
+}
+
+// evaluate places each team of a group by a tiebreaker. It returns false when the tiebreaker
+// doesn't apply to the group.
+func (r *standingsRanker) evaluate(tiebreaker models.Tiebreaker, group []*Standing) (map[uuid.UUID]tiebreakValue, bool) {
+	values := make(map[uuid.UUID]tiebreakValue, len(group))
+	switch tiebreaker {
+	case models.TiebreakerHeadToHead:
+		inGroup := make(map[uuid.UUID]bool, len(group))
+		for _, standing := range group {
+			inGroup[standing.FantasyTeamID] = true
+		}
+		records := make(map[uuid.UUID]*Record, len(group))
+		met := make(map[[2]uuid.UUID]bool)
+		for _, standing := range group {
+			records[standing.FantasyTeamID] = &Record{}
+		}
+		for _, game := range r.played {
+			home, away := game.Matchup.HomeTeamID, game.Matchup.AwayTeamID
+			if !inGroup[home] || !inGroup[away] {
+				continue
+			}
+			addResult(records[home], records[away], game.HomePoints, game.AwayPoints)
+			met[[2]uuid.UUID{home, away}] = true
+			met[[2]uuid.UUID{away, home}] = true
+		}
+		for i, a := range group {
+			for _, b := range group[i+1:] {
+				if !met[[2]uuid.UUID{a.FantasyTeamID, b.FantasyTeamID}] {
+					return nil, false
+				}
+			}
+		}
+		for id, record := range records {
+			values[id] = tiebreakValue{value: record.WinPct(), display: record.String()}
+		}
+	case models.TiebreakerPointsFor:
+		for _, standing := range group {
+			values[standing.FantasyTeamID] = tiebreakValue{value: standing.PointsFor, display: fmt.Sprintf("%.2f", standing.PointsFor)}
+		}
+	case models.TiebreakerDivisionRecord:
+		division := group[0].Division
+		for _, standing := range group {
+			if division == "" || standing.Division != division {
+				return nil, false
+			}
+			values[standing.FantasyTeamID] = tiebreakValue{value: standing.DivisionRecord.WinPct(), display: standing.DivisionRecord.String()}
+		}
+	case models.TiebreakerCoinFlip:
+		for _, standing := range group {
+			values[standing.FantasyTeamID] = tiebreakValue{value: r.coinFlip(standing.FantasyTeamID)}
+		}
+	default:
+		return nil, false
+	}
+	return values, true
+}
+
+// coinFlip draws a team's side of the coin flip from the seed, so it never changes over a season
+func (r *standingsRanker) coinFlip(teamID uuid.UUID) float64 {
+	h := fnv.New64a()
+	h.Write([]byte(r.seed))
+	h.Write(teamID[:])
+	return float64(h.Sum64() >> 11)
+}
+
+// explainTiebreaker describes how a tiebreaker ranked a team ahead of the named team below it
+func explainTiebreaker(tiebreaker models.Tiebreaker, behindName string, ahead, behind tiebreakValue) string {
+	switch tiebreaker {
+	case models.TiebreakerHeadToHead:
+		return fmt.Sprintf("Ahead of %s on head-to-head record between the tied teams, %s to %s", behindName, ahead.display, behind.display)
+	case models.TiebreakerPointsFor:
+		return fmt.Sprintf("Ahead of %s on points scored, %s to %s", behindName, ahead.display, behind.display)
+	case models.TiebreakerDivisionRecord:
+		return fmt.Sprintf("Ahead of %s on division record, %s to %s", behindName, ahead.display, behind.display)
+	default:
+		return fmt.Sprintf("Ahead of %s on a coin flip", behindName)
+	}
+}
+
+// splitBy splits standings sorted by value into runs of teams with the same value
+func splitBy(standings []*Standing, value func(*Standing) float64) [][]*Standing {
+	var groups [][]*Standing
+	for i, standing := range standings {
+		if i == 0 || value(standing) != value(standings[i-1]) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], standing)
+	}
+	return groups
+}
@@ -0,0 +1,164 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrLeagueNotFound is returned when a league does not exist
+	ErrLeagueNotFound = errors.New("league not found")
+	// ErrNotCommissioner is returned when someone other than the league's commissioner schedules its season
+	ErrNotCommissioner = errors.New("only the league commissioner can do this")
+	// ErrTooFewTeams is returned when a league has fewer than two teams to schedule
+	ErrTooFewTeams = errors.New("a schedule needs at least two teams")
+	// ErrUnknownTeam is returned when a division or rivalry names a team that isn't in the league
+	ErrUnknownTeam = errors.New("team is not in the league")
+	// ErrSeasonOver is returned when scheduling a league that has completed or been cancelled
+	ErrSeasonOver = errors.New("league season is over")
+	// ErrMatchupNotFound is returned for a matchup that isn't on a committed schedule
+	ErrMatchupNotFound = errors.New("matchup not found")
+	// ErrResultTeamsMismatch is returned when a scoring update's teams aren't the two teams of its matchup
+	ErrResultTeamsMismatch = errors.New("scoring update teams don't match the matchup")
+)
+
+// PreviewRequest asks for a generated schedule without saving it
+type PreviewRequest struct {
+	LeagueID    uuid.UUID  `json:"league_id"`
+	Seed        *int64     `json:"seed,omitempty"`         // nil picks a new schedule at random
+	RequestedBy *uuid.UUID `json:"requested_by,omitempty"` // nil for trusted callers
+}
+
+// CommitRequest saves the schedule a seed generates as the league's season schedule
+type CommitRequest struct {
+	LeagueID    uuid.UUID  `json:"league_id"`
+	Seed        int64      `json:"seed"`                   // from the preview being committed
+	CommittedBy *uuid.UUID `json:"committed_by,omitempty"` // nil for trusted callers
+}
+
+// Schedule is a league's regular season, week by week, with each team's share of it
+type Schedule struct {
+	LeagueID uuid.UUID     `json:"league_id"`
+	Season   string        `json:"season"`
+	Seed     *int64        `json:"seed,omitempty"` // set on generated schedules
+	Weeks    []Week        `json:"weeks"`
+	Teams    []TeamSummary `json:"teams"`
+}
+
+// Week is one week of a schedule
+type Week struct {
+	Week     int              `json:"week"`
+	Matchups []models.Matchup `json:"matchups"`
+	Byes     []uuid.UUID      `json:"byes,omitempty"` // teams without a game, in leagues with an odd number of teams
+}
+
+// TeamSummary is how a schedule treats one team
+type TeamSummary struct {
+	FantasyTeamID uuid.UUID `json:"fantasy_team_id"`
+	Name          string    `json:"name"`
+	Division      string    `json:"division,omitempty"`
+	HomeGames     int       `json:"home_games"`
+	AwayGames     int       `json:"away_games"`
+	DivisionGames int       `json:"division_games"`
+	Byes          int       `json:"byes"`
+}
+
+// UserMatchup is a matchup one of a user's teams plays in
+type UserMatchup struct {
+	Matchup    models.Matchup `json:"matchup"`
+	LeagueName string         `json:"league_name"`
+	TeamID     uuid.UUID      `json:"team_id"` // the user's team
+}
+
+// MatchupResult is the final score of a matchup
+type MatchupResult struct {
+	MatchupID   uuid.UUID `json:"matchup_id"`
+	HomePoints  float64   `json:"home_points"`
+	AwayPoints  float64   `json:"away_points"`
+	FinalizedAt time.Time `json:"finalized_at"` // when the scoring update was published
+}
+
+// League is what scheduling needs to know about a league
+type League struct {
+	ID             uuid.UUID
+	CommissionerID uuid.UUID
+	Season         string
+	Status         models.LeagueStatus
+	Settings       models.ScheduleSettings
+}
+
+// Team is a fantasy team to schedule
+type Team struct {
+	ID   uuid.UUID
+	Name string
+}
+
+// PlayedMatchup is a matchup with its final score
+type PlayedMatchup struct {
+	Matchup    models.Matchup
+	HomePoints float64
+	AwayPoints float64
+}
+
+// Record is a team's wins, losses and ties
+type Record struct {
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+	Ties   int `json:"ties"`
+}
+
+// Games returns how many games the record covers
+func (r Record) Games() int {
+	return r.Wins + r.Losses + r.Ties
+}
+
+// WinPct returns the share of games won, counting ties as half a win. A record without games
+// has a win percentage of 0.
+func (r Record) WinPct() float64 {
+	if r.Games() == 0 {
+		return 0
+	}
+	return (float64(r.Wins) + float64(r.Ties)/2) / float64(r.Games())
+}
+
+// String formats the record as W-L, or W-L-T once there is a tie
+func (r Record) String() string {
+	if r.Ties > 0 {
+		return fmt.Sprintf("%d-%d-%d", r.Wins, r.Losses, r.Ties)
+	}
+	return fmt.Sprintf("%d-%d", r.Wins, r.Losses)
+}
+
+// AppliedTiebreaker is the tiebreaker that ranked a team ahead of the next team in the standings
+type AppliedTiebreaker struct {
+	Tiebreaker  models.Tiebreaker `json:"tiebreaker"`
+	OverTeamID  uuid.UUID         `json:"over_team_id"` // the team ranked right below
+	Explanation string            `json:"explanation"`
+}
+
+// Standing is one team's place in the standings
+type Standing struct {
+	Rank           int                `json:"rank"`
+	FantasyTeamID  uuid.UUID          `json:"fantasy_team_id"`
+	Name           string             `json:"name"`
+	Division       string             `json:"division,omitempty"`
+	Record         Record             `json:"record"`
+	PointsFor      float64            `json:"points_for"`
+	PointsAgainst  float64            `json:"points_against"`
+	DivisionRecord Record             `json:"division_record"`
+	Tiebreaker     *AppliedTiebreaker `json:"tiebreaker,omitempty"` // set when the team is level on record with the next team
+}
+
+// Standings rank a league's teams by record, then by the league's tiebreakers
+type Standings struct {
+	LeagueID    uuid.UUID           `json:"league_id"`
+	Season      string              `json:"season"`
+	ThroughWeek int                 `json:"through_week"` // the last week with a result counted
+	Tiebreakers []models.Tiebreaker `json:"tiebreakers"`  // in the order they apply
+	Teams       []Standing          `json:"teams"`
+	ComputedAt  *time.Time          `json:"computed_at,omitempty"` // unset on standings computed on request
+}
@@ -0,0 +1,151 @@
+package scoring
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// LineupSlot is a starting lineup slot and the player positions eligible to fill it
+type LineupSlot = models.LineupSlot
+
+// PlayerScore is a rostered player's fantasy points for a scoring period
+type PlayerScore struct {
+	PlayerID uuid.UUID `json:"player_id"`
+	Position string    `json:"position"`
+	Points   float64   `json:"points"`
+}
+
+// LineupEntry is a slot filled by a player
+type LineupEntry struct {
+	Slot   LineupSlot  `json:"slot"`
+	Player PlayerScore `json:"player"`
+}
+
+// Lineup is the set of starters for a scoring period
+type Lineup struct {
+	Starters []LineupEntry `json:"starters"`
+}
+
+// Points returns the total points scored by the lineup's starters
+func (l Lineup) Points() float64 {
+	var total float64
+	for _, entry := range l.Starters {
+		total += entry.Player.Points
+	}
+	return total
+}
+
+// OptimalLineup selects the highest scoring lineup for a best-ball team from its full roster.
+//
+// Flex slots can overlap in any way (e.g. RB/WR and WR/TE), so filling slots one at a time
+// can leave points on the bench; the lineup is instead the assignment of players to slots
+// that fills the most slots and, among those, scores the most points. Slots that no player is
+// left eligible for are left empty. Starters are listed in slot order.
+func OptimalLineup(slots []LineupSlot, roster []PlayerScore) Lineup {
+	// Every filled slot is worth more than any difference in points, so no slot is left empty
+	// to bench a player with negative points
+	fill := 1.0
+	for _, player := range roster {
+		fill += math.Abs(player.Points)
+	}
+
+	// Each slot may also take one of len(slots) placeholders, which leave it empty
+	assignment := maxWeightAssignment(len(slots), len(roster)+len(slots), func(slot, player int) float64 {
+		if player >= len(roster) || !slots[slot].Accepts(roster[player].Position) {
+			return 0
+		}
+		return roster[player].Points + fill
+	})
+
+	lineup := Lineup{Starters: make([]LineupEntry, 0, len(slots))}
+	for i, slot := range slots {
+		player := assignment[i]
+		if player < len(roster) && slot.Accepts(roster[player].Position) {
+			lineup.Starters = append(lineup.Starters, LineupEntry{Slot: slot, Player: roster[player]})
+		}
+	}
+
+	return lineup
+}
+
+// maxWeightAssignment assigns each of rows to a distinct one of cols (rows <= cols) so the
+// total weight is highest, with the Hungarian algorithm. It returns the column of each row.
+func maxWeightAssignment(rows, cols int, weight func(row, col int) float64) []int {
+	// Potentials and matching are 1-indexed; column 0 is the row being added
+	u := make([]float64, rows+1)
+	v := make([]float64, cols+1)
+	match := make([]int, cols+1) // row matched to each column, 0 for none
+	way := make([]int, cols+1)
+
+	for row := 1; row <= rows; row++ {
+		match[0] = row
+		col := 0
+		minSlack := make([]float64, cols+1)
+		for j := range minSlack {
+			minSlack[j] = math.Inf(1)
+		}
+		used := make([]bool, cols+1)
+		for {
+			used[col] = true
+			matched, delta, next := match[col], math.Inf(1), 0
+			for j := 1; j <= cols; j++ {
+				if used[j] {
+					continue
+				}
+				slack := -weight(matched-1, j-1) - u[matched] - v[j]
+				if slack < minSlack[j] {
+					minSlack[j] = slack
+					way[j] = col
+				}
+				if minSlack[j] < delta {
+					delta = minSlack[j]
+					next = j
+				}
+			}
+			for j := 0; j <= cols; j++ {
+				if used[j] {
+					u[match[j]] += delta
+					v[j] -= delta
+				} else {
+					minSlack[j] -= delta
+				}
+			}
+			col = next
+			if match[col] == 0 {
+				break
+			}
+		}
+		// Flip the augmenting path
+		for col != 0 {
+			prev := way[col]
+			match[col] = match[prev]
+			col = prev
+		}
+	}
+
+	assignment := make([]int, rows)
+	for j := 1; j <= cols; j++ {
+		if match[j] != 0 {
+			assignment[match[j]-1] = j - 1
+		}
+	}
+	return assignment
+}
+
+// TeamScore returns a team's points for a scoring period. Best-ball teams score their
+// optimal lineup; other teams score the starters they set.
+func TeamScore(bestBall bool, slots []LineupSlot, roster []PlayerScore, starters map[uuid.UUID]bool) float64 {
+	if bestBall {
+		return OptimalLineup(slots, roster).Points()
+	}
+
+	var total float64
+	for _, player := range roster {
+		if starters[player.PlayerID] {
+			total += player.Points
+		}
+	}
+	return total
+}
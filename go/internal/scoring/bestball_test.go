@@ -0,0 +1,93 @@
+package scoring
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func player(position string, points float64) PlayerScore {
+	return PlayerScore{PlayerID: uuid.New(), Position: position, Points: points}
+}
+
+func TestOptimalLineupOverlappingFlex(t *testing.T) {
+	// Filling RB/WR first with the WR leaves WR/TE to the TE, 5 points short
+	slots := []LineupSlot{
+		{Name: "RB/WR", Eligible: []string{"RB", "WR"}},
+		{Name: "WR/TE", Eligible: []string{"WR", "TE"}},
+	}
+	roster := []PlayerScore{player("WR", 20), player("RB", 10), player("TE", 5)}
+
+	lineup := OptimalLineup(slots, roster)
+	if got := lineup.Points(); got != 30 {
+		t.Fatalf("lineup scores %v, want 30: %+v", got, lineup.Starters)
+	}
+	if len(lineup.Starters) != 2 || lineup.Starters[0].Slot.Name != "RB/WR" || lineup.Starters[0].Player.Position != "RB" {
+		t.Fatalf("starters %+v, want the RB at RB/WR and the WR at WR/TE", lineup.Starters)
+	}
+}
+
+func TestOptimalLineupFillsSlotsFirst(t *testing.T) {
+	slots := []LineupSlot{
+		{Name: "QB", Eligible: []string{"QB"}},
+		{Name: "DST", Eligible: []string{"DST"}},
+		{Name: "K", Eligible: []string{"K"}},
+	}
+	// No kicker; the defense scored negative points but still starts
+	roster := []PlayerScore{player("QB", 18), player("DST", -3)}
+
+	lineup := OptimalLineup(slots, roster)
+	if len(lineup.Starters) != 2 || lineup.Points() != 15 {
+		t.Fatalf("starters %+v, want the QB and the defense for 15 points", lineup.Starters)
+	}
+}
+
+func TestOptimalLineupMatchesBruteForce(t *testing.T) {
+	positions := []string{"QB", "RB", "WR", "TE"}
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		slots := make([]LineupSlot, 1+rng.Intn(4))
+		for i := range slots {
+			for _, position := range positions {
+				if rng.Intn(2) == 0 {
+					slots[i].Eligible = append(slots[i].Eligible, position)
+				}
+			}
+		}
+		roster := make([]PlayerScore, rng.Intn(6))
+		for i := range roster {
+			roster[i] = player(positions[rng.Intn(len(positions))], float64(rng.Intn(30)-5))
+		}
+
+		lineup := OptimalLineup(slots, roster)
+		filled, points := bruteForceLineup(slots, roster, 0, make([]bool, len(roster)))
+		if len(lineup.Starters) != filled || math.Abs(lineup.Points()-points) > 1e-9 {
+			t.Fatalf("trial %d: lineup fills %d slots for %v points, best is %d for %v\nslots %+v\nroster %+v",
+				trial, len(lineup.Starters), lineup.Points(), filled, points, slots, roster)
+		}
+	}
+}
+
+// bruteForceLineup returns the most slots from slot on that can be filled, and the most points
+// scored filling that many, trying every assignment
+func bruteForceLineup(slots []LineupSlot, roster []PlayerScore, slot int, used []bool) (int, float64) {
+	if slot == len(slots) {
+		return 0, 0
+	}
+	bestFilled, bestPoints := bruteForceLineup(slots, roster, slot+1, used)
+	for i, p := range roster {
+		if used[i] || !slots[slot].Accepts(p.Position) {
+			continue
+		}
+		used[i] = true
+		filled, points := bruteForceLineup(slots, roster, slot+1, used)
+		used[i] = false
+		filled, points = filled+1, points+p.Points
+		if filled > bestFilled || (filled == bestFilled && points > bestPoints) {
+			bestFilled, bestPoints = filled, points
+		}
+	}
+	return bestFilled, bestPoints
+}
@@ -0,0 +1,50 @@
+package scoring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// SettingsProvider returns a league, for its sport, and its settings as they were in force at a
+// point in time
+type SettingsProvider interface {
+	GetLeague(ctx context.Context, id uuid.UUID) (*models.League, error)
+	GetSettingsEffectiveAt(ctx context.Context, leagueID uuid.UUID, at time.Time) (interface{}, error)
+}
+
+// Engine scores fantasy teams with the league settings effective for the week being scored,
+// so a settings change scheduled for a later week or season doesn't affect earlier weeks
+type Engine struct {
+	settings SettingsProvider
+}
+
+// NewEngine creates a new scoring engine
+func NewEngine(settings SettingsProvider) *Engine {
+	return &Engine{
+		settings: settings,
+	}
+}
+
+// ScoreTeam returns a team's points for the week starting at weekStart
+func (e *Engine) ScoreTeam(ctx context.Context, leagueID uuid.UUID, weekStart time.Time, roster []PlayerScore, starters map[uuid.UUID]bool) (float64, error) {
+	league, err := e.settings.GetLeague(ctx, leagueID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get league: %w", err)
+	}
+	settings, err := e.settings.GetSettingsEffectiveAt(ctx, leagueID, weekStart)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get league settings for week: %w", err)
+	}
+
+	return TeamScore(models.SettingsBestBall(settings), SettingsLineupSlots(league.SportID, settings), roster, starters), nil
+}
+
+// SettingsLineupSlots reads the starting lineup slots from a raw league_settings value of a
+// league in sportID
+func SettingsLineupSlots(sportID string, settings interface{}) []LineupSlot {
+	return models.SettingsLineupSlots(sportID, settings)
+}
@@ -0,0 +1,43 @@
+package scoring
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Live scoring updates are published to JetStream as plays and stat corrections change a
+// matchup's score. The draft gateway relays them to the users watching each matchup.
+const (
+	// LiveScoringStream is the JetStream stream carrying live scoring updates
+	LiveScoringStream = "MATCHUP_EVENTS"
+	// LiveScoringSubjectPrefix prefixes the subject each matchup's updates are published on
+	LiveScoringSubjectPrefix = "matchup.scoring"
+)
+
+// LiveScoringSubject returns the subject a matchup's scoring updates are published on
+func LiveScoringSubject(matchupID uuid.UUID) string {
+	return LiveScoringSubjectPrefix + "." + matchupID.String()
+}
+
+// MatchupScoreUpdate is the current score of a matchup, published whenever it changes. Each
+// update carries the full score rather than a delta, so only the latest one matters.
+type MatchupScoreUpdate struct {
+	EventID   string        `json:"event_id"`
+	MatchupID uuid.UUID     `json:"matchup_id"`
+	LeagueID  uuid.UUID     `json:"league_id"`
+	Week      int           `json:"week"`
+	Home      LiveTeamScore `json:"home"`
+	Away      LiveTeamScore `json:"away"`
+	Final     bool          `json:"final"` // every player in the matchup has finished the week
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// LiveTeamScore is one side of a matchup's live score
+type LiveTeamScore struct {
+	FantasyTeamID     uuid.UUID `json:"fantasy_team_id"`
+	Points            float64   `json:"points"`
+	ProjectedPoints   *float64  `json:"projected_points,omitempty"`
+	PlayersInProgress int       `json:"players_in_progress"`
+	PlayersYetToPlay  int       `json:"players_yet_to_play"`
+}
@@ -0,0 +1,184 @@
+// Command seed creates a demo dataset in one go, for new contributors and demo environments:
+// 12 users, NFL teams and players, a league with a fantasy team per user and its first draft
+// scheduled for tomorrow evening. NFL data comes from fixtures with fictional players and
+// rankings unless -sync is given, which needs the provider API keys. -fast-forward starts the
+// draft and makes the first half of its picks instead; run the orchestrator to carry it on.
+//
+//	seed [-users 12] [-prefix demo] [-league name] [-season year] [-sync] [-fast-forward]
+//
+// Every demo user's password is "demo-password".
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+	_ "github.com/lib/pq"
+	"github.com/mcdev12/dynasty/go/internal/changes"
+	changesdb "github.com/mcdev12/dynasty/go/internal/changes/db"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/clock"
+	"github.com/mcdev12/dynasty/go/internal/dbconfig"
+	draftdraft "github.com/mcdev12/dynasty/go/internal/draft/draft"
+	draftdb "github.com/mcdev12/dynasty/go/internal/draft/draft/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/orchestrator"
+	"github.com/mcdev12/dynasty/go/internal/draft/outbox"
+	outboxdb "github.com/mcdev12/dynasty/go/internal/draft/outbox/db"
+	"github.com/mcdev12/dynasty/go/internal/draft/pick"
+	pickdb "github.com/mcdev12/dynasty/go/internal/draft/pick/db"
+	"github.com/mcdev12/dynasty/go/internal/fantasyteam"
+	fantasyteamdb "github.com/mcdev12/dynasty/go/internal/fantasyteam/db"
+	"github.com/mcdev12/dynasty/go/internal/leagueinit"
+	leagueinitdb "github.com/mcdev12/dynasty/go/internal/leagueinit/db"
+	"github.com/mcdev12/dynasty/go/internal/leagues"
+	leaguedb "github.com/mcdev12/dynasty/go/internal/leagues/db"
+	"github.com/mcdev12/dynasty/go/internal/player"
+	playerdb "github.com/mcdev12/dynasty/go/internal/player/db"
+	"github.com/mcdev12/dynasty/go/internal/roster"
+	rosterdb "github.com/mcdev12/dynasty/go/internal/roster/db"
+	"github.com/mcdev12/dynasty/go/internal/seed"
+	"github.com/mcdev12/dynasty/go/internal/sports/base"
+	_ "github.com/mcdev12/dynasty/go/internal/sports/nfl"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/mcdev12/dynasty/go/internal/teams"
+	teamsdb "github.com/mcdev12/dynasty/go/internal/teams/db"
+	"github.com/mcdev12/dynasty/go/internal/templates"
+	templatesdb "github.com/mcdev12/dynasty/go/internal/templates/db"
+	"github.com/mcdev12/dynasty/go/internal/users"
+	usersdb "github.com/mcdev12/dynasty/go/internal/users/db"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run seeds a demo dataset and returns the process exit code
+func run(args []string) int {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: seed [flags]\n\n")
+		fmt.Fprintf(fs.Output(), "Creates demo users, NFL teams and players, and a league with its first draft.\n\n")
+		fs.PrintDefaults()
+	}
+	userCount := fs.Int("users", 12, "league members to create, the first of them commissioner")
+	prefix := fs.String("prefix", "demo", "username prefix; users from an earlier seed with the same prefix are reused")
+	leagueName := fs.String("league", "Demo Dynasty League", "name of the league")
+	season := fs.String("season", strconv.Itoa(time.Now().Year()), "season of the league and its rankings")
+	sync := fs.Bool("sync", false, "sync NFL teams and players from the provider APIs instead of the fixtures")
+	fastForward := fs.Bool("fast-forward", false, "start the draft and make the first half of its picks")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if *userCount < 2 || *userCount > 32 {
+		fmt.Fprintln(os.Stderr, "seed: -users must be between 2 and 32")
+		return 2
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Debug().Err(err).Msg("could not load .env file")
+	}
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Fixtures are mapped by the NFL plugin without calling its providers, so it's only
+	// initialized, which needs the API keys, for a sync
+	if *sync {
+		if err := base.InitializePlugin(seed.SportID); err != nil {
+			fmt.Fprintf(os.Stderr, "seed: %v\n", err)
+			return 1
+		}
+	}
+	plugin, err := base.GetPlugin(seed.SportID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "seed: %v\n", err)
+		return 1
+	}
+	plugins := map[string]base.SportPlugin{seed.SportID: plugin}
+
+	db, err := sql.Open("postgres", dbconfig.NewConfigFromEnv().DSN())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "seed: open database: %v\n", err)
+		return 1
+	}
+	defer db.Close()
+	if err := db.PingContext(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "seed: ping database: %v\n", err)
+		return 1
+	}
+
+	// Tomorrow at 8pm local time
+	now := time.Now()
+	draftAt := time.Date(now.Year(), now.Month(), now.Day()+1, 20, 0, 0, 0, now.Location())
+
+	result, err := newSeeder(db, plugins).Seed(ctx, seed.Config{
+		UsernamePrefix:    *prefix,
+		Users:             *userCount,
+		LeagueName:        *leagueName,
+		Season:            *season,
+		DraftAt:           draftAt,
+		SyncFromProviders: *sync,
+		FastForward:       *fastForward,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "seed: %v\n", err)
+		return 1
+	}
+
+	log.Info().
+		Str("league_id", result.LeagueID.String()).
+		Str("draft_id", result.DraftID.String()).
+		Str("commissioner", result.Users[0].Username).
+		Int("users", len(result.Users)).
+		Int("nfl_teams", result.Teams).
+		Int("nfl_players", result.Players).
+		Int("picks_made", result.PicksMade).
+		Msgf("seeded demo league, sign in as %s%02d to %s%02d with password %q", *prefix, 1, *prefix, len(result.Users), seed.DemoPassword)
+	return 0
+}
+
+// newSeeder wires the seeder to in-process apps and services, as the API server does
+func newSeeder(db *sql.DB, plugins map[string]base.SportPlugin) *seed.Seeder {
+	entityChanges := changes.NewTxInserter(changesdb.New(db), sqlutil.NewTxManager(db))
+
+	userApp := users.NewApp(users.NewRepository(usersdb.New(db), db), nil)
+	userService := users.NewService(userApp)
+	templateService := templates.NewService(templates.NewApp(templates.NewRepository(templatesdb.New(db))), userService)
+	leagueService := leagues.NewService(leagues.NewApp(leagues.NewRepository(leaguedb.New(db), db, entityChanges), nil), userService, templateService)
+
+	appClock := clock.Real()
+	rosterApp := roster.NewApp(roster.NewRepository(rosterdb.New(db), db, entityChanges, appClock), appClock)
+	fantasyTeamApp := fantasyteam.NewApp(fantasyteam.NewRepository(fantasyteamdb.New(db), entityChanges), rosterApp)
+	fantasyTeamService := fantasyteam.NewService(fantasyTeamApp, userService, leagueService)
+
+	outboxApp := outbox.NewApp(outbox.NewRepository(outboxdb.New(db)))
+	draftService := draftdraft.NewService(draftdraft.NewApp(draftdraft.NewRepository(draftdb.New(db), db, appClock), appClock), outboxApp, leagueService, templateService)
+	pickService := pick.NewService(pick.NewApp(pick.NewRepository(pickdb.New(db), db, appClock), appClock), draftService, outboxApp, sqlutil.NewTxManager(db), nil)
+
+	saga := leagueinit.NewSaga(leagueinit.NewRepository(leagueinitdb.New(db), db), leagueService, fantasyTeamService, draftService, pickService)
+
+	return seed.NewSeeder(
+		userApp,
+		teams.NewApp(teams.NewRepository(teamsdb.New(db), db), plugins),
+		player.NewApp(player.NewRepository(playerdb.New(db), db), plugins),
+		saga,
+		draftService,
+		pickService,
+		orchestrator.NewBestAvailableStrategy(pickService),
+	)
+}
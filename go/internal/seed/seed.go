@@ -0,0 +1,395 @@
+// Package seed fills a database with a realistic demo dataset: league members, NFL teams and
+// players, a league with a fantasy team per member and its scheduled first draft, optionally
+// fast-forwarded to its halfway point. It goes through the same apps and services as the API, so
+// the dataset holds up to everything the API does with it.
+package seed
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sportradarclient "github.com/mcdev12/dynasty/go/clients/sport_radar_client"
+	sportsapi "github.com/mcdev12/dynasty/go/clients/sports_api_client"
+	"github.com/mcdev12/dynasty/go/internal/draft/pick"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
+	"github.com/mcdev12/dynasty/go/internal/leagueinit"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/player"
+	"github.com/mcdev12/dynasty/go/internal/teams"
+	"github.com/mcdev12/dynasty/go/internal/users"
+)
+
+// SportID is the sport the demo league plays
+const SportID = "nfl"
+
+// DemoPassword is the password of every demo user
+const DemoPassword = "demo-password"
+
+// nflFixture holds the 32 NFL teams with fictional rosters and rankings, for seeding without
+// provider API keys
+//
+//go:embed fixtures/nfl.json
+var nflFixture []byte
+
+// fixture is the shape of nflFixture: teams as the sports API returns them, and each team's
+// players as SportRadar does with their board rank and projection added
+type fixture struct {
+	Teams   []sportsapi.Team `json:"teams"`
+	Players []struct {
+		TeamCode string          `json:"team_code"`
+		Players  []fixturePlayer `json:"players"`
+	} `json:"players"`
+}
+
+type fixturePlayer struct {
+	sportradarclient.SRPlayer
+	Rank            int     `json:"rank"`
+	ProjectedPoints float64 `json:"projected_points"`
+}
+
+// demoTeamNames name the members' fantasy teams; members past the end get numbered teams
+var demoTeamNames = []string{
+	"Gridiron Gurus", "Blitz Brigade", "Hail Mary Heroes", "End Zone Elite", "Fourth and Long",
+	"Red Zone Raiders", "Pick Six Posse", "Two Minute Drill", "Audible Alerts", "Sack Masters",
+	"Fumble Rumblers", "Onside Kickers",
+}
+
+// demoLeagueSettings is a PPR league starting QB, 2 RB, 2 WR, TE, FLEX and K, with bench room
+// for the rest of a 15 round draft
+func demoLeagueSettings() map[string]interface{} {
+	slots := []interface{}{}
+	for _, name := range []string{"QB", "RB", "RB", "WR", "WR", "TE", "FLEX", "K"} {
+		slots = append(slots, map[string]interface{}{"name": name})
+	}
+	return map[string]interface{}{
+		models.LeagueSettingScoring:     map[string]interface{}{"reception": 1},
+		models.LeagueSettingLineupSlots: slots,
+		models.LeagueSettingBenchSlots:  7,
+	}
+}
+
+// Config configures a seed
+type Config struct {
+	// UsernamePrefix names the users <prefix>01, <prefix>02 and so on. Users left by an earlier
+	// seed with the same prefix are reused, so seeding again adds another league for them.
+	UsernamePrefix string
+	Users          int // league members, the first of them commissioner
+	LeagueName     string
+	Season         string
+	DraftAt        time.Time // when the draft is scheduled; ignored when fast-forwarding
+	// SyncFromProviders syncs NFL teams and players from the provider APIs instead of loading
+	// the fixtures. The providers have no rankings, so the draft board is left unranked.
+	SyncFromProviders bool
+	// FastForward starts the draft and makes the first half of its picks, best available first
+	FastForward bool
+}
+
+// Result is what a seed created
+type Result struct {
+	Users     []models.User // in draft order
+	LeagueID  uuid.UUID
+	DraftID   uuid.UUID
+	Teams     int // NFL teams created or updated
+	Players   int // NFL players created or updated
+	PicksMade int
+}
+
+// UserApp defines what the seeder needs from the users app
+type UserApp interface {
+	CreateUser(ctx context.Context, req users.CreateUserRequest) (*models.User, error)
+	GetUserByUsername(ctx context.Context, username string) (*models.User, error)
+}
+
+// TeamApp defines what the seeder needs from the teams app
+type TeamApp interface {
+	SyncTeamsFromAPI(ctx context.Context, sportID string) (*teams.SyncResult, error)
+	IngestTeams(ctx context.Context, sportID string, apiTeams []sportsapi.Team) (*teams.SyncResult, error)
+	GetTeamBySportIdAndCode(ctx context.Context, sportID, code string) (*models.Team, error)
+	ListTeamsBySport(ctx context.Context, sportID string) ([]models.Team, error)
+}
+
+// PlayerApp defines what the seeder needs from the player app
+type PlayerApp interface {
+	SyncPlayersFromAPI(ctx context.Context, teamID uuid.UUID, teamCode string, sportID string) (*player.SyncResult, error)
+	IngestPlayers(ctx context.Context, teamID uuid.UUID, sportID string, players []sportradarclient.SRPlayer) (*player.SyncResult, error)
+	GetPlayerByExternalID(ctx context.Context, sportID, externalID string) (*models.Player, error)
+	UpsertPlayerRankings(ctx context.Context, rankings []models.PlayerRanking) error
+}
+
+// LeagueInitializer creates the league, its teams and its draft
+type LeagueInitializer interface {
+	Initialize(ctx context.Context, id, commissionerID uuid.UUID, req *leaguev1.InitializeLeagueRequest) (*leagueinit.Result, error)
+}
+
+// PickChooser claims the pick on the clock and chooses a player for it, as auto-pick does
+type PickChooser interface {
+	SelectClaim(ctx context.Context, draftID uuid.UUID) (pick.MakePickRequest, error)
+}
+
+// Seeder seeds demo datasets
+type Seeder struct {
+	users        UserApp
+	teams        TeamApp
+	players      PlayerApp
+	leagues      LeagueInitializer
+	draftService draftv1connect.DraftServiceClient
+	pickService  draftv1connect.DraftPickServiceClient
+	chooser      PickChooser
+}
+
+// NewSeeder creates a seeder
+func NewSeeder(userApp UserApp, teamApp TeamApp, playerApp PlayerApp, leagues LeagueInitializer, draftService draftv1connect.DraftServiceClient, pickService draftv1connect.DraftPickServiceClient, chooser PickChooser) *Seeder {
+	return &Seeder{
+		users:        userApp,
+		teams:        teamApp,
+		players:      playerApp,
+		leagues:      leagues,
+		draftService: draftService,
+		pickService:  pickService,
+		chooser:      chooser,
+	}
+}
+
+// Seed creates a demo dataset
+func (s *Seeder) Seed(ctx context.Context, cfg Config) (*Result, error) {
+	if cfg.Users < 2 {
+		return nil, fmt.Errorf("a league needs at least 2 users, got %d", cfg.Users)
+	}
+	if cfg.UsernamePrefix == "" || cfg.Season == "" || cfg.LeagueName == "" {
+		return nil, fmt.Errorf("username prefix, season and league name are required")
+	}
+
+	result := &Result{}
+	var err error
+	result.Users, err = s.seedUsers(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.SyncFromProviders {
+		err = s.syncNFL(ctx, result)
+	} else {
+		err = s.loadNFLFixture(ctx, cfg.Season, result)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	initialized, err := s.initializeLeague(ctx, cfg, result.Users)
+	if err != nil {
+		return nil, err
+	}
+	result.LeagueID = uuid.MustParse(initialized.League.Id)
+	result.DraftID = initialized.DraftID
+
+	if cfg.FastForward {
+		result.PicksMade, err = s.fastForward(ctx, result.DraftID, initialized.PicksCreated/2)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// seedUsers creates the league members, or finds them when an earlier seed created them
+func (s *Seeder) seedUsers(ctx context.Context, cfg Config) ([]models.User, error) {
+	members := make([]models.User, 0, cfg.Users)
+	for i := 1; i <= cfg.Users; i++ {
+		username := fmt.Sprintf("%s%02d", cfg.UsernamePrefix, i)
+		if user, err := s.users.GetUserByUsername(ctx, username); err == nil {
+			members = append(members, *user)
+			continue
+		}
+
+		user, err := s.users.CreateUser(ctx, users.CreateUserRequest{
+			Username: username,
+			Email:    username + "@example.com",
+			Password: DemoPassword,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create user %s: %w", username, err)
+		}
+		members = append(members, *user)
+	}
+	return members, nil
+}
+
+// loadNFLFixture upserts the fixture's teams and players and puts the players on the season's
+// boards for every scoring format
+func (s *Seeder) loadNFLFixture(ctx context.Context, season string, result *Result) error {
+	var data fixture
+	if err := json.Unmarshal(nflFixture, &data); err != nil {
+		return fmt.Errorf("failed to read NFL fixture: %w", err)
+	}
+
+	teamResult, err := s.teams.IngestTeams(ctx, SportID, data.Teams)
+	if err != nil {
+		return fmt.Errorf("failed to load NFL teams: %w", err)
+	}
+	if len(teamResult.Errors) > 0 {
+		return fmt.Errorf("failed to load NFL teams: %w", teamResult.Errors[0])
+	}
+	result.Teams = teamResult.Created + teamResult.Updated
+
+	var rankings []models.PlayerRanking
+	for _, roster := range data.Players {
+		team, err := s.teams.GetTeamBySportIdAndCode(ctx, SportID, roster.TeamCode)
+		if err != nil {
+			return fmt.Errorf("failed to get NFL team %s: %w", roster.TeamCode, err)
+		}
+
+		srPlayers := make([]sportradarclient.SRPlayer, len(roster.Players))
+		for i, p := range roster.Players {
+			srPlayers[i] = p.SRPlayer
+		}
+		playerResult, err := s.players.IngestPlayers(ctx, team.ID, SportID, srPlayers)
+		if err != nil {
+			return fmt.Errorf("failed to load %s players: %w", roster.TeamCode, err)
+		}
+		if playerResult.HasErrors() {
+			return fmt.Errorf("failed to load %s players: %w", roster.TeamCode, playerResult.Errors[0])
+		}
+		result.Players += playerResult.Created + playerResult.Updated
+
+		for _, p := range roster.Players {
+			// Ingested players are keyed by their SportRadar ID, as the NFL plugin maps them
+			seeded, err := s.players.GetPlayerByExternalID(ctx, SportID, "sr_"+p.SrID)
+			if err != nil {
+				return fmt.Errorf("failed to get player %s: %w", p.Name, err)
+			}
+			for _, format := range []models.ScoringFormat{models.ScoringFormatStandard, models.ScoringFormatHalfPPR, models.ScoringFormatPPR} {
+				projected := p.ProjectedPoints
+				rankings = append(rankings, models.PlayerRanking{
+					Season:          season,
+					ScoringFormat:   format,
+					PlayerID:        seeded.ID,
+					OverallRank:     p.Rank,
+					ProjectedPoints: &projected,
+				})
+			}
+		}
+	}
+
+	if err := s.players.UpsertPlayerRankings(ctx, rankings); err != nil {
+		return err
+	}
+
+	log.Printf("Loaded NFL fixture: %d teams, %d players", result.Teams, result.Players)
+	return nil
+}
+
+// syncNFL syncs the NFL teams and every team's players from the provider APIs
+func (s *Seeder) syncNFL(ctx context.Context, result *Result) error {
+	teamResult, err := s.teams.SyncTeamsFromAPI(ctx, SportID)
+	if err != nil {
+		return fmt.Errorf("failed to sync NFL teams: %w", err)
+	}
+	result.Teams = teamResult.Created + teamResult.Updated
+
+	nflTeams, err := s.teams.ListTeamsBySport(ctx, SportID)
+	if err != nil {
+		return fmt.Errorf("failed to list NFL teams: %w", err)
+	}
+	for _, team := range nflTeams {
+		playerResult, err := s.players.SyncPlayersFromAPI(ctx, team.ID, team.Code, SportID)
+		if err != nil {
+			return fmt.Errorf("failed to sync %s players: %w", team.Code, err)
+		}
+		result.Players += playerResult.Created + playerResult.Updated
+	}
+
+	log.Printf("Synced NFL from providers: %d teams, %d players; the draft board is unranked", result.Teams, result.Players)
+	return nil
+}
+
+// initializeLeague creates the league with a team per member and its scheduled draft, the
+// first member commissioner
+func (s *Seeder) initializeLeague(ctx context.Context, cfg Config, members []models.User) (*leagueinit.Result, error) {
+	settings, err := structpb.NewStruct(demoLeagueSettings())
+	if err != nil {
+		return nil, fmt.Errorf("failed to build league settings: %w", err)
+	}
+
+	draftAt := cfg.DraftAt
+	if cfg.FastForward {
+		draftAt = time.Now()
+	}
+
+	leagueMembers := make([]*leaguev1.LeagueMember, len(members))
+	for i, member := range members {
+		teamName := fmt.Sprintf("Team %d", i+1)
+		if i < len(demoTeamNames) {
+			teamName = demoTeamNames[i]
+		}
+		leagueMembers[i] = &leaguev1.LeagueMember{
+			OwnerId:  member.ID.String(),
+			TeamName: teamName,
+		}
+	}
+
+	id := uuid.New()
+	commissionerID := members[0].ID
+	initialized, err := s.leagues.Initialize(ctx, id, commissionerID, &leaguev1.InitializeLeagueRequest{
+		InitializationId: id.String(),
+		Name:             cfg.LeagueName,
+		SportId:          SportID,
+		LeagueType:       leaguev1.LeagueType_LEAGUE_TYPE_DYNASTY,
+		CommissionerId:   commissionerID.String(),
+		LeagueSettings:   settings,
+		Season:           cfg.Season,
+		Members:          leagueMembers,
+		Draft: &leaguev1.InitialDraftSettings{
+			ScheduledAt: timestamppb.New(draftAt),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize league: %w", err)
+	}
+	return initialized, nil
+}
+
+// fastForward starts the draft and makes its first picks as auto-pick would, returning how many
+// were made
+func (s *Seeder) fastForward(ctx context.Context, draftID uuid.UUID, picks int) (int, error) {
+	// The services run in process, past the service auth interceptor, so the seeder acts as
+	// the internal service a signed token would name
+	ctx = interceptors.WithServicePrincipal(ctx, "seed")
+	if _, err := s.draftService.StartDraft(ctx, connect.NewRequest(&draftv1.StartDraftRequest{
+		DraftId:           draftID.String(),
+		OverrideReadiness: true,
+	})); err != nil {
+		return 0, fmt.Errorf("failed to start draft: %w", err)
+	}
+
+	for made := 0; made < picks; made++ {
+		req, err := s.chooser.SelectClaim(ctx, draftID)
+		if err != nil {
+			return made, fmt.Errorf("failed to choose pick %d: %w", made+1, err)
+		}
+		if _, err := s.pickService.MakePick(ctx, connect.NewRequest(&draftv1.MakePickRequest{
+			PickId:      req.PickID.String(),
+			DraftId:     req.DraftID.String(),
+			TeamId:      req.TeamID.String(),
+			PlayerId:    req.PlayerID.String(),
+			OverallPick: int32(req.OverallPick),
+		})); err != nil {
+			return made, fmt.Errorf("failed to make pick %d: %w", req.OverallPick, err)
+		}
+	}
+
+	log.Printf("Fast-forwarded draft %s through %d picks", draftID, picks)
+	return picks, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	sportradarclient "github.com/mcdev12/dynasty/go/clients/sport_radar_client"
 	sportsapi "github.com/mcdev12/dynasty/go/clients/sports_api_client"
@@ -14,6 +15,10 @@ import (
 type SportPlugin interface {
 	Init() error
 
+	// Positions returns the sport's positions and standard lineup slots. It's registered with
+	// the plugin, so it must not depend on Init.
+	Positions() models.PositionTaxonomy
+
 	// Team operations
 	FetchTeams(ctx context.Context) ([]sportsapi.Team, error)
 	MapExternalTeam(apiTeam sportsapi.Team, sportID string) (*models.Team, error)
@@ -22,6 +27,14 @@ type SportPlugin interface {
 	FetchPlayers(ctx context.Context, teamAlias string) ([]sportradarclient.SRPlayer, error)
 	MapExternalPlayer(srPlayer sportradarclient.SRPlayer) (*models.Player, error)
 
+	// Season data operations
+	FetchDepthCharts(ctx context.Context, season, week int) ([]sportradarclient.SRTeamDepthChart, error)
+	MapExternalDepthChart(srDepthChart sportradarclient.SRTeamDepthChart) ([]models.DepthChartSlot, error)
+	FetchByeWeeks(ctx context.Context, season int) (map[string]int, error)
+
+	// News operations
+	FetchPlayerNews(ctx context.Context, since time.Time) ([]models.ExternalPlayerNews, error)
+
 	//DefaultScoringTemplates() map[string][]ScoringRule
 	//ValidateRoster(r *Roster) error
 	//
@@ -43,7 +56,8 @@ var (
 	registryMu sync.RWMutex
 )
 
-// RegisterPlugin adds a plugin implementation under a key.
+// RegisterPlugin adds a plugin implementation under a key, and registers its position taxonomy
+// for the sport of the same key.
 // It should be called in each sport plugin's init() function.
 // The plugin will be initialized later when retrieved.
 func RegisterPlugin(key string, plugin SportPlugin) error {
@@ -56,6 +70,7 @@ func RegisterPlugin(key string, plugin SportPlugin) error {
 		return fmt.Errorf("plugin already registered for key %q", key)
 	}
 	registry[key] = plugin
+	models.RegisterPositionTaxonomy(key, plugin.Positions())
 	return nil
 }
 
@@ -0,0 +1,74 @@
+package base
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+
+	sportradarclient "github.com/mcdev12/dynasty/go/clients/sport_radar_client"
+	sportsapi "github.com/mcdev12/dynasty/go/clients/sports_api_client"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// WebhookPlugin is implemented by plugins whose data providers push updates instead of, or as
+// well as, being polled. The API server mounts each of the plugin's webhooks and feeds what they
+// deliver through the same upserts as the syncs.
+type WebhookPlugin interface {
+	SportPlugin
+
+	// Webhooks returns the plugin's webhooks. It's called after Init, so a plugin can leave out
+	// webhooks it has no secret for.
+	Webhooks() []Webhook
+}
+
+// Webhook is a provider push endpoint
+type Webhook struct {
+	// Path is served under /webhooks/sports/<plugin key>/
+	Path string
+
+	// Verify checks a delivery came from the provider, typically by a signature over its body
+	Verify func(header http.Header, body []byte) error
+
+	// Map decodes a delivery's body into the updates it carries
+	Map func(body []byte) (*WebhookUpdate, error)
+}
+
+// WebhookUpdate holds the data a webhook delivery carries, in the forms the plugin's fetch
+// operations return. Teams are upserted before players, so a delivery can carry a new team
+// along with its roster.
+type WebhookUpdate struct {
+	Teams   []sportsapi.Team
+	Players []TeamPlayers
+	News    []models.ExternalPlayerNews
+}
+
+// TeamPlayers holds the players pushed for one team
+type TeamPlayers struct {
+	TeamCode string                      `json:"team_code"`
+	Players  []sportradarclient.SRPlayer `json:"players"`
+}
+
+// VerifyHMACSHA256 returns a Verify that checks the named header holds the hex HMAC-SHA256 of
+// the body, keyed with secret
+func VerifyHMACSHA256(headerName, secret string) func(http.Header, []byte) error {
+	return func(header http.Header, body []byte) error {
+		value := header.Get(headerName)
+		if value == "" {
+			return fmt.Errorf("missing %s header", headerName)
+		}
+		sig, err := hex.DecodeString(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s header", headerName)
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		if !hmac.Equal(sig, mac.Sum(nil)) {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	}
+}
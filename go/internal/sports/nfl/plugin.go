@@ -5,18 +5,23 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
+	rssclient "github.com/mcdev12/dynasty/go/clients/rss_client"
+
 	sportradarclient "github.com/mcdev12/dynasty/go/clients/sport_radar_client"
 	sportsapiclient "github.com/mcdev12/dynasty/go/clients/sports_api_client"
 	"github.com/mcdev12/dynasty/go/internal/models"
 	"github.com/mcdev12/dynasty/go/internal/sports/base"
+	"golang.org/x/text/language"
 )
 
 // NFLPlugin implements the SportPlugin interface for the NFL.
 type NFLPlugin struct {
 	sportsApi  *sportsapiclient.SportsApiClient
 	sportRadar *sportradarclient.SportRadarClient
+	newsFeed   *rssclient.RSSClient // optional, nil when no news feed is configured
 	config     Config
 }
 
@@ -30,7 +35,9 @@ type Config struct {
 		APIKey  string `yaml:"api_key"`
 		BaseURL string `yaml:"base_url"`
 	} `yaml:"sport_radar"`
-	PageSize int `yaml:"page_size"` // number of players per fetch
+	PageSize      int    `yaml:"page_size"`      // number of players per fetch
+	NewsFeedURL   string `yaml:"news_feed_url"`  // RSS feed of player news
+	WebhookSecret string `yaml:"webhook_secret"` // signs pushed updates; the webhook is off when empty
 }
 
 // init registers the NFL plugin with the base registry (without initialization).
@@ -70,12 +77,17 @@ func (p *NFLPlugin) Init() error {
 			APIKey:  sportRadarApiKey,
 			BaseURL: "https://api.sportradar.us", // default base URL
 		},
-		PageSize: 100, // default page size
+		PageSize:      100, // default page size
+		NewsFeedURL:   os.Getenv("NFL_NEWS_RSS_URL"),
+		WebhookSecret: os.Getenv("NFL_WEBHOOK_SECRET"),
 	}
 
 	// Initialize API clients with their respective keys
 	p.sportsApi = sportsapiclient.NewSportsApiClient(p.config.SportsAPI.APIKey)
 	p.sportRadar = sportradarclient.NewSportRadarClient(p.config.SportRadar.APIKey)
+	if p.config.NewsFeedURL != "" {
+		p.newsFeed = rssclient.NewRSSClient(p.config.NewsFeedURL)
+	}
 
 	return nil
 }
@@ -115,6 +127,23 @@ func (p *NFLPlugin) MapExternalTeam(apiTeam sportsapiclient.Team, sportID string
 		team.EstablishedYear = &apiTeam.Established
 	}
 
+	// Keep translations under their canonical tags, skipping ones we can't tell the locale of
+	for _, translation := range apiTeam.Translations {
+		tag, err := language.Parse(translation.Locale)
+		if err != nil || translation.Name == "" {
+			continue
+		}
+		city := translation.City
+		if city == "" {
+			city = apiTeam.City
+		}
+		team.LocalizedNames = append(team.LocalizedNames, models.TeamLocalizedName{
+			Locale: tag.String(),
+			Name:   translation.Name,
+			City:   city,
+		})
+	}
+
 	return team, nil
 }
 
@@ -170,6 +199,7 @@ func (p *NFLPlugin) MapExternalPlayer(srPlayer sportradarclient.SRPlayer) (*mode
 		SportID:          "nfl",
 		ExternalID:       fmt.Sprintf("sr_%s", srPlayer.SrID),
 		FullName:         srPlayer.Name,
+		ExternalIDs:      mapExternalPlayerIDs(srPlayer),
 		NFLPlayerProfile: profile,
 		// TODO Team id is mapped in App. Think about how to handle free agents. Should team be optional?
 	}
@@ -177,6 +207,126 @@ func (p *NFLPlugin) MapExternalPlayer(srPlayer sportradarclient.SRPlayer) (*mode
 	return player, nil
 }
 
+// referenceProviders maps SportRadar reference origins to crosswalk providers
+var referenceProviders = map[string]string{
+	"gsis":    models.PlayerIDProviderGSIS,
+	"sleeper": models.PlayerIDProviderSleeper,
+	"espn":    models.PlayerIDProviderESPN,
+	"yahoo":   models.PlayerIDProviderYahoo,
+}
+
+// mapExternalPlayerIDs collects the player's SportRadar ID and any references to
+// other providers it carries. Unknown origins are ignored.
+func mapExternalPlayerIDs(srPlayer sportradarclient.SRPlayer) map[string]string {
+	ids := map[string]string{}
+	if srPlayer.ID != "" {
+		ids[models.PlayerIDProviderSportradar] = srPlayer.ID
+	}
+	for _, ref := range srPlayer.References {
+		if provider, ok := referenceProviders[strings.ToLower(ref.Origin)]; ok && ref.ID != "" {
+			ids[provider] = ref.ID
+		}
+	}
+	return ids
+}
+
+// FetchDepthCharts retrieves the regular season depth charts for every NFL team for the given week.
+func (p *NFLPlugin) FetchDepthCharts(ctx context.Context, season, week int) ([]sportradarclient.SRTeamDepthChart, error) {
+	depthCharts, err := p.sportRadar.GetSeasonDepthCharts(season, sportradarclient.SeasonTypeRegular, week)
+	if err != nil {
+		return nil, fmt.Errorf("nfl: failed to fetch depth charts from SportRadar: %w", err)
+	}
+
+	return depthCharts, nil
+}
+
+// MapExternalDepthChart flattens a SportRadar team depth chart into depth chart slots.
+// Player external IDs use the same format as MapExternalPlayer so they can be resolved on ingest.
+func (p *NFLPlugin) MapExternalDepthChart(srDepthChart sportradarclient.SRTeamDepthChart) ([]models.DepthChartSlot, error) {
+	if srDepthChart.Alias == "" {
+		return nil, fmt.Errorf("nfl: depth chart for team %q has no alias", srDepthChart.Name)
+	}
+
+	var slots []models.DepthChartSlot
+	groups := [][]sportradarclient.SRDepthChartPosition{
+		srDepthChart.Offense,
+		srDepthChart.Defense,
+		srDepthChart.SpecialTeams,
+	}
+	for _, group := range groups {
+		for _, position := range group {
+			for _, player := range position.Position.Players {
+				if player.SrID == "" || player.Depth <= 0 {
+					continue
+				}
+				slots = append(slots, models.DepthChartSlot{
+					TeamCode:         srDepthChart.Alias,
+					PlayerExternalID: fmt.Sprintf("sr_%s", player.SrID),
+					Position:         position.Position.Name,
+					Depth:            player.Depth,
+				})
+			}
+		}
+	}
+
+	return slots, nil
+}
+
+// FetchByeWeeks retrieves the regular season bye week for every NFL team, keyed by team alias.
+func (p *NFLPlugin) FetchByeWeeks(ctx context.Context, season int) (map[string]int, error) {
+	schedule, err := p.sportRadar.GetSeasonSchedule(season, sportradarclient.SeasonTypeRegular)
+	if err != nil {
+		return nil, fmt.Errorf("nfl: failed to fetch schedule from SportRadar: %w", err)
+	}
+
+	byeWeeks := make(map[string]int)
+	for _, week := range schedule.Weeks {
+		for _, bye := range week.ByeWeek {
+			byeWeeks[bye.Team.Alias] = week.Sequence
+		}
+	}
+
+	return byeWeeks, nil
+}
+
+// FetchPlayerNews retrieves player news published after since from the configured RSS feed.
+// Feed headlines follow the "Player Name: update" convention, which is how items are tied to players.
+func (p *NFLPlugin) FetchPlayerNews(ctx context.Context, since time.Time) ([]models.ExternalPlayerNews, error) {
+	if p.newsFeed == nil {
+		return nil, nil
+	}
+
+	feed, err := p.newsFeed.GetFeed(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("nfl: failed to fetch news feed: %w", err)
+	}
+
+	news := make([]models.ExternalPlayerNews, 0, len(feed.Channel.Items))
+	for _, item := range feed.Channel.Items {
+		publishedAt, err := item.PublishedAt()
+		if err != nil || !publishedAt.After(since) {
+			continue
+		}
+
+		playerName, _, found := strings.Cut(item.Title, ":")
+		if !found || item.ID() == "" {
+			continue
+		}
+
+		news = append(news, models.ExternalPlayerNews{
+			Source:      "nfl_rss",
+			ExternalID:  item.ID(),
+			PlayerName:  strings.TrimSpace(playerName),
+			Headline:    strings.TrimSpace(item.Title),
+			Body:        strings.TrimSpace(item.Description),
+			URL:         item.Link,
+			PublishedAt: publishedAt,
+		})
+	}
+
+	return news, nil
+}
+
 // Helper functions
 func stringPtr(s string) *string {
 	if s == "" {
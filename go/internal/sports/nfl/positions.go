@@ -0,0 +1,43 @@
+package nfl
+
+import "github.com/mcdev12/dynasty/go/internal/models"
+
+// Standard NFL lineup slot names
+const (
+	LineupSlotQB        = "QB"
+	LineupSlotRB        = "RB"
+	LineupSlotWR        = "WR"
+	LineupSlotTE        = "TE"
+	LineupSlotFlex      = "FLEX"
+	LineupSlotSuperflex = "SUPERFLEX"
+	LineupSlotDST       = "DST"
+	LineupSlotK         = "K"
+)
+
+// positions are the positions NFL players are listed at across the data feeds, including
+// individual defensive players for leagues with IDP slots. Team defenses are listed as DEF or
+// DST depending on the feed.
+var positions = []string{
+	"QB", "RB", "FB", "WR", "TE",
+	"OL", "C", "G", "OG", "T", "OT",
+	"DL", "DE", "DT", "NT", "LB", "OLB", "ILB", "MLB", "DB", "CB", "S", "SAF", "FS", "SS",
+	"K", "P", "LS",
+	"DEF", "DST",
+}
+
+// Positions returns the NFL's positions and the positions eligible for each standard lineup slot
+func (p *NFLPlugin) Positions() models.PositionTaxonomy {
+	return models.PositionTaxonomy{
+		Positions: positions,
+		Slots: map[string][]string{
+			LineupSlotQB:        {"QB"},
+			LineupSlotRB:        {"RB"},
+			LineupSlotWR:        {"WR"},
+			LineupSlotTE:        {"TE"},
+			LineupSlotFlex:      {"RB", "WR", "TE"},
+			LineupSlotSuperflex: {"QB", "RB", "WR", "TE"},
+			LineupSlotDST:       {"DEF", "DST"},
+			LineupSlotK:         {"K"},
+		},
+	}
+}
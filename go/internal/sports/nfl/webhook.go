@@ -0,0 +1,54 @@
+package nfl
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sportsapiclient "github.com/mcdev12/dynasty/go/clients/sports_api_client"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sports/base"
+)
+
+// Verify that NFLPlugin takes pushed updates
+var _ base.WebhookPlugin = (*NFLPlugin)(nil)
+
+// webhookSignatureHeader carries the hex HMAC-SHA256 of a pushed update's body
+const webhookSignatureHeader = "X-Dynasty-Signature"
+
+// webhookUpdate is the body of a pushed update: teams in the sports API's form, rosters in
+// Sportradar's, and news items
+type webhookUpdate struct {
+	Teams   []sportsapiclient.Team      `json:"teams"`
+	Players []base.TeamPlayers          `json:"players"`
+	News    []models.ExternalPlayerNews `json:"news"`
+}
+
+// Webhooks registers the "updates" webhook when NFL_WEBHOOK_SECRET is set, so data providers
+// can push teams, rosters and news signed with it instead of waiting for the next sync
+func (p *NFLPlugin) Webhooks() []base.Webhook {
+	if p.config.WebhookSecret == "" {
+		return nil
+	}
+	return []base.Webhook{{
+		Path:   "updates",
+		Verify: base.VerifyHMACSHA256(webhookSignatureHeader, p.config.WebhookSecret),
+		Map:    mapWebhookUpdate,
+	}}
+}
+
+func mapWebhookUpdate(body []byte) (*base.WebhookUpdate, error) {
+	var update webhookUpdate
+	if err := json.Unmarshal(body, &update); err != nil {
+		return nil, fmt.Errorf("nfl: invalid webhook update: %w", err)
+	}
+	for _, team := range update.Players {
+		if team.TeamCode == "" {
+			return nil, fmt.Errorf("nfl: webhook update has players without a team_code")
+		}
+	}
+	return &base.WebhookUpdate{
+		Teams:   update.Teams,
+		Players: update.Players,
+		News:    update.News,
+	}, nil
+}
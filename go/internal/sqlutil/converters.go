@@ -95,6 +95,14 @@ func FromSqlTime(val sql.NullTime) *time.Time {
 	return &val.Time
 }
 
+// FromSqlFloat64 converts sql.NullFloat64 to Go float64 pointer
+func FromSqlFloat64(val sql.NullFloat64) *float64 {
+	if !val.Valid {
+		return nil
+	}
+	return &val.Float64
+}
+
 // ToSqlInt32Direct converts a Go int to sql.NullInt32
 func ToSqlInt32Direct(val int) sql.NullInt32 {
 	return sql.NullInt32{Int32: int32(val), Valid: true}
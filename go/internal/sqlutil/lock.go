@@ -0,0 +1,115 @@
+package sqlutil
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+
+	"github.com/google/uuid"
+)
+
+// LockClass namespaces advisory locks so different kinds of work on the same
+// entity don't contend with each other.
+type LockClass int32
+
+const (
+	// LockClassDraft serializes state changes (status, picks, deadlines) for a single draft
+	LockClassDraft LockClass = iota + 1
+	// LockClassDraftTimeout makes sure a single orchestrator instance handles a draft's pick timeout
+	LockClassDraftTimeout
+	// LockClassFantasyTeamRoster serializes lineup changes for a single fantasy team
+	LockClassFantasyTeamRoster
+	// LockClassLeagueSettings serializes settings changes for a single league so its change log stays in order
+	LockClassLeagueSettings
+	// LockClassLeagueInitialization makes sure a single request runs a league initialization saga at a time
+	LockClassLeagueInitialization
+	// LockClassTradeWishlist serializes additions to a single fantasy team's trade wishlist so it stays under its cap
+	LockClassTradeWishlist
+	// LockClassLeagueSchedule serializes commits of a single league's season schedule
+	LockClassLeagueSchedule
+	// LockClassPlayerWatchlist serializes additions to a single user's player watchlist so it stays under its cap
+	LockClassPlayerWatchlist
+	// LockClassLeagueSeason serializes starting and completing a single league's seasons so it has one active at a time
+	LockClassLeagueSeason
+	// LockClassLeagueStandings serializes recomputes of a single league's standings
+	LockClassLeagueStandings
+)
+
+// lockKey folds a UUID into the 32-bit object key of a two-key advisory lock.
+// Collisions only cause unrelated entities to wait on each other.
+func lockKey(id uuid.UUID) int32 {
+	h := fnv.New32a()
+	h.Write(id[:])
+	return int32(h.Sum32())
+}
+
+// LockXact takes a transaction-scoped advisory lock on id, waiting until it is free.
+// The lock is released when tx commits or rolls back.
+func LockXact(ctx context.Context, tx *sql.Tx, class LockClass, id uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, $2)", int32(class), lockKey(id))
+	return err
+}
+
+// RunLocked is Run with a transaction-scoped advisory lock on id taken before fn,
+// so concurrent callers for the same id execute one at a time. Like Run, it retries
+// transactions that fail with a transient error, and joins a TxManager transaction in ctx,
+// which then holds the lock until it ends.
+func RunLocked[T any](
+	ctx context.Context,
+	db *sql.DB,
+	class LockClass,
+	id uuid.UUID,
+	newQueries func(*sql.Tx) *T,
+	fn func(q *T) error,
+) error {
+	if tx, ok := TxFromContext(ctx); ok {
+		if err := LockXact(ctx, tx, class, id); err != nil {
+			return err
+		}
+		return fn(newQueries(tx))
+	}
+	return Retry(ctx, DefaultRetryPolicy, "locked_tx", func() error {
+		tx, err := db.BeginTx(ctx, nil) // BEGIN
+		if err != nil {
+			return err
+		}
+		if err := LockXact(ctx, tx, class, id); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		q := newQueries(tx)
+		if err := fn(q); err != nil {
+			_ = tx.Rollback() // ROLLBACK, releasing the lock
+			return err
+		}
+		return commit(tx) // COMMIT, releasing the lock
+	})
+}
+
+// TryLockSession takes a session-level advisory lock on id without waiting.
+// The lock is pinned to a dedicated connection, so it can be held across calls
+// that don't run in a transaction. ok is false when another session holds it;
+// otherwise release must be called to unlock and return the connection to the pool.
+func TryLockSession(ctx context.Context, db *sql.DB, class LockClass, id uuid.UUID) (release func(), ok bool, err error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	key := lockKey(id)
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1, $2)", int32(class), key).Scan(&ok); err != nil {
+		_ = conn.Close()
+		return nil, false, err
+	}
+	if !ok {
+		_ = conn.Close()
+		return nil, false, nil
+	}
+
+	release = func() {
+		// Unlock even if the caller's context was cancelled
+		_, _ = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1, $2)", int32(class), key)
+		_ = conn.Close()
+	}
+	return release, true, nil
+}
@@ -0,0 +1,253 @@
+package sqlutil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"expvar"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+)
+
+// QueryObserverConfig configures the query instrumentation added by OpenObserved
+type QueryObserverConfig struct {
+	// SlowQueryThreshold logs every query taking at least this long; 0 disables the slow query log
+	SlowQueryThreshold time.Duration
+}
+
+// QueryFields identifies what a query is working on in the slow query log
+type QueryFields struct {
+	DraftID  uuid.UUID
+	LeagueID uuid.UUID
+}
+
+type queryFieldsKey struct{}
+
+// WithQueryFields returns a copy of ctx whose queries are logged with fields. Fields left
+// unset keep the value from any fields already in ctx.
+func WithQueryFields(ctx context.Context, fields QueryFields) context.Context {
+	existing := queryFieldsFromContext(ctx)
+	if fields.DraftID == uuid.Nil {
+		fields.DraftID = existing.DraftID
+	}
+	if fields.LeagueID == uuid.Nil {
+		fields.LeagueID = existing.LeagueID
+	}
+	return context.WithValue(ctx, queryFieldsKey{}, fields)
+}
+
+func queryFieldsFromContext(ctx context.Context) QueryFields {
+	fields, _ := ctx.Value(queryFieldsKey{}).(QueryFields)
+	return fields
+}
+
+// OpenObserved opens a Postgres database whose queries are timed per sqlc query name.
+// Durations are published as histograms under "db_query_duration" at /debug/vars, and
+// slow queries are logged with the draft and league from WithQueryFields. Queries run in
+// transactions are observed too, since the instrumentation sits in the driver.
+func OpenObserved(dsn string, cfg QueryObserverConfig) (*sql.DB, error) {
+	connector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(&observedConnector{Connector: connector, cfg: cfg}), nil
+}
+
+type observedConnector struct {
+	driver.Connector
+	cfg QueryObserverConfig
+}
+
+func (c *observedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &observedConn{Conn: conn, cfg: c.cfg}, nil
+}
+
+// observedConn times queries on a driver connection and passes everything else through.
+// Optional interfaces the wrapped connection lacks report driver.ErrSkip, so database/sql
+// falls back the same way it would without the wrapper.
+type observedConn struct {
+	driver.Conn
+	cfg QueryObserverConfig
+}
+
+func (c *observedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	start := time.Now()
+	rows, err := queryer.QueryContext(ctx, query, args)
+	c.observe(ctx, query, start, err)
+	return rows, err
+}
+
+func (c *observedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	start := time.Now()
+	result, err := execer.ExecContext(ctx, query, args)
+	c.observe(ctx, query, start, err)
+	return result, err
+}
+
+func (c *observedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *observedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin()
+}
+
+func (c *observedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *observedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *observedConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+// observe records how long a query took. For queries this is the time until the first
+// rows arrive, not until they are all read.
+func (c *observedConn) observe(ctx context.Context, query string, start time.Time, err error) {
+	elapsed := time.Since(start)
+	name := queryName(query)
+	queryDurations.histogram(name).observe(elapsed)
+
+	if c.cfg.SlowQueryThreshold <= 0 || elapsed < c.cfg.SlowQueryThreshold {
+		return
+	}
+	event := log.Warn().
+		Str("query", name).
+		Dur("duration", elapsed)
+	fields := queryFieldsFromContext(ctx)
+	if fields.DraftID != uuid.Nil {
+		event = event.Str("draft_id", fields.DraftID.String())
+	}
+	if fields.LeagueID != uuid.Nil {
+		event = event.Str("league_id", fields.LeagueID.String())
+	}
+	if err != nil {
+		event = event.Err(err)
+	}
+	event.Msg("slow query")
+}
+
+// queryName returns the name sqlc puts at the top of every query ("-- name: GetDraft :one").
+// Queries written by hand are grouped together.
+func queryName(query string) string {
+	rest, ok := strings.CutPrefix(query, "-- name: ")
+	if !ok {
+		return "unnamed"
+	}
+	if i := strings.IndexAny(rest, " \n"); i >= 0 {
+		return rest[:i]
+	}
+	return rest
+}
+
+// queryDurationBucketsMs are the upper bounds of the query duration histogram buckets
+var queryDurationBucketsMs = []float64{1, 2.5, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000}
+
+var queryDurations = &durationHistograms{vars: expvar.NewMap("db_query_duration")}
+
+// durationHistograms holds one histogram per query name
+type durationHistograms struct {
+	mu   sync.Mutex
+	vars *expvar.Map
+}
+
+func (h *durationHistograms) histogram(name string) *durationHistogram {
+	if v, ok := h.vars.Get(name).(*durationHistogram); ok {
+		return v
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if v, ok := h.vars.Get(name).(*durationHistogram); ok {
+		return v
+	}
+	v := &durationHistogram{counts: make([]int64, len(queryDurationBucketsMs)+1)}
+	h.vars.Set(name, v)
+	return v
+}
+
+// durationHistogram is a cumulative histogram of durations that renders as JSON for expvar
+type durationHistogram struct {
+	mu     sync.Mutex
+	counts []int64 // per bucket, the last one past the largest bound
+	count  int64
+	sumMs  float64
+}
+
+func (h *durationHistogram) observe(d time.Duration) {
+	ms := float64(d) / float64(time.Millisecond)
+	bucket := len(queryDurationBucketsMs)
+	for i, bound := range queryDurationBucketsMs {
+		if ms <= bound {
+			bucket = i
+			break
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.counts[bucket]++
+	h.count++
+	h.sumMs += ms
+}
+
+// String renders the histogram as {"count":n,"sum_ms":n,"buckets":{"le_1":n,...,"le_inf":n}}
+// with cumulative bucket counts
+func (h *durationHistogram) String() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	buckets := make(map[string]int64, len(h.counts))
+	var cumulative int64
+	for i, n := range h.counts {
+		cumulative += n
+		key := "le_inf"
+		if i < len(queryDurationBucketsMs) {
+			key = "le_" + strconv.FormatFloat(queryDurationBucketsMs[i], 'f', -1, 64)
+		}
+		buckets[key] = cumulative
+	}
+
+	out, _ := json.Marshal(struct {
+		Count   int64            `json:"count"`
+		SumMs   float64          `json:"sum_ms"`
+		Buckets map[string]int64 `json:"buckets"`
+	}{h.count, h.sumMs, buckets})
+	return string(out)
+}
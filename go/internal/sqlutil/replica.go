@@ -0,0 +1,131 @@
+package sqlutil
+
+import (
+	"context"
+	"database/sql"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// replicaLagQuery measures how far the replica's replay is behind the primary. A replica that
+// has replayed everything it received isn't behind, however long ago the last write was.
+const replicaLagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// replicaStats publishes at /debug/vars the replica's last measured lag, "lag_ms", and how
+// many reads allowed to be stale went to the replica, "reads.replica", or stayed on the
+// primary because the replica was too far behind or unreachable, "reads.primary".
+var replicaStats = expvar.NewMap("db_replica")
+
+type readStalenessKey struct{}
+
+// WithReadStaleness returns a copy of ctx whose reads may run on the read replica while it is
+// no more than maxStaleness behind the primary. Reads without it run on the primary.
+func WithReadStaleness(ctx context.Context, maxStaleness time.Duration) context.Context {
+	return context.WithValue(ctx, readStalenessKey{}, maxStaleness)
+}
+
+func readStalenessFromContext(ctx context.Context) (time.Duration, bool) {
+	maxStaleness, ok := ctx.Value(readStalenessKey{}).(time.Duration)
+	return maxStaleness, ok
+}
+
+// ReadReplica is a streaming replica of the primary that takes the reads allowed to be stale.
+// Its lag is measured every interval; until the first measurement, or once measurements
+// stop succeeding, it takes no reads. A nil ReadReplica takes none either.
+type ReadReplica struct {
+	db       *sql.DB
+	interval time.Duration
+
+	mu        sync.RWMutex
+	lag       time.Duration
+	checkedAt time.Time // when lag was last measured successfully
+}
+
+// NewReadReplica creates a read replica over db, measuring its lag every interval once Run
+// starts
+func NewReadReplica(db *sql.DB, interval time.Duration) *ReadReplica {
+	return &ReadReplica{db: db, interval: interval}
+}
+
+// DB returns the replica's database
+func (r *ReadReplica) DB() *sql.DB {
+	return r.db
+}
+
+// Run measures the replica's lag until ctx is done
+func (r *ReadReplica) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		r.measure(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *ReadReplica) measure(ctx context.Context) {
+	measureCtx, cancel := context.WithTimeout(ctx, r.interval)
+	defer cancel()
+
+	var seconds float64
+	if err := r.db.QueryRowContext(measureCtx, replicaLagQuery).Scan(&seconds); err != nil {
+		if ctx.Err() == nil {
+			log.Warn().Err(err).Msg("failed to measure read replica lag")
+		}
+		return
+	}
+	lag := time.Duration(seconds * float64(time.Second))
+
+	r.mu.Lock()
+	r.lag = lag
+	r.checkedAt = time.Now()
+	r.mu.Unlock()
+
+	lagMs := new(expvar.Int)
+	lagMs.Set(lag.Milliseconds())
+	replicaStats.Set("lag_ms", lagMs)
+}
+
+// Serves reports whether the reads made with ctx should run on the replica: they were allowed
+// to be stale, and the replica's lag was measured recently and is within what they allow
+func (r *ReadReplica) Serves(ctx context.Context) bool {
+	maxStaleness, ok := readStalenessFromContext(ctx)
+	if r == nil || !ok {
+		return false
+	}
+
+	r.mu.RLock()
+	lag, checkedAt := r.lag, r.checkedAt
+	r.mu.RUnlock()
+
+	// A lag measured more than a few intervals ago says nothing about the replica now
+	fresh := !checkedAt.IsZero() && time.Since(checkedAt) <= 3*r.interval
+	if !fresh || lag > maxStaleness {
+		replicaStats.Add("reads.primary", 1)
+		return false
+	}
+	replicaStats.Add("reads.replica", 1)
+	return true
+}
+
+// BindRead returns the queries a read runs on: the transaction in ctx if there is one, so
+// reads see the transaction's own writes, replicaQ if the replica Serves ctx, and q, the
+// primary's, otherwise
+func BindRead[T any](ctx context.Context, q, replicaQ T, replica *ReadReplica, newQueries func(*sql.Tx) T) T {
+	if tx, ok := TxFromContext(ctx); ok {
+		return newQueries(tx)
+	}
+	if replica.Serves(ctx) {
+		return replicaQ
+	}
+	return q
+}
@@ -0,0 +1,138 @@
+package sqlutil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"expvar"
+	"io"
+	"math/rand/v2"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// RetryPolicy controls how operations that fail with a transient database error are retried
+type RetryPolicy struct {
+	MaxAttempts    int           // Attempts including the first; 1 disables retrying
+	InitialBackoff time.Duration // Wait before the first retry, doubled for each one after
+	MaxBackoff     time.Duration // Upper bound on the wait between attempts
+}
+
+// DefaultRetryPolicy rides out a primary failover, which usually takes a few seconds
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 50 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+// retryStats publishes per-operation counters at /debug/vars: "<op>.retried" counts retries,
+// "<op>.recovered" operations that succeeded after a retry and "<op>.exhausted" operations
+// that gave up while the error was still transient.
+var retryStats = expvar.NewMap("db_retries")
+
+// Retry calls fn until it succeeds, fails with an error IsTransient doesn't accept, runs out
+// of attempts or would have to wait past ctx's deadline. op labels the retry metrics.
+func Retry(ctx context.Context, policy RetryPolicy, op string, fn func() error) error {
+	backoff := policy.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			if attempt > 1 {
+				retryStats.Add(op+".recovered", 1)
+			}
+			return nil
+		}
+		if !IsTransient(err) {
+			return err
+		}
+		if attempt >= policy.MaxAttempts {
+			retryStats.Add(op+".exhausted", 1)
+			return err
+		}
+
+		// Full jitter keeps every caller from reconnecting at the same moment
+		wait := time.Duration(rand.Int64N(int64(backoff) + 1))
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			retryStats.Add(op+".exhausted", 1)
+			return err
+		}
+
+		retryStats.Add(op+".retried", 1)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		backoff = min(backoff*2, policy.MaxBackoff)
+	}
+}
+
+// commitError marks a failed COMMIT. When the connection drops during COMMIT the
+// transaction may or may not have been applied, so it is never retried.
+type commitError struct {
+	err error
+}
+
+func (e *commitError) Error() string { return e.err.Error() }
+func (e *commitError) Unwrap() error { return e.err }
+
+// commit commits tx, marking failures that leave the outcome unknown
+func commit(tx *sql.Tx) error {
+	err := tx.Commit()
+	if err == nil {
+		return nil
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "40001" {
+		// A serialization failure at commit means the transaction was rolled back
+		return err
+	}
+	return &commitError{err: err}
+}
+
+// IsTransient reports whether err is a database error that is expected to go away on its
+// own, such as a serialization failure or a connection lost while the primary fails over.
+// Retrying the operation that failed with it is safe.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	var commitErr *commitError
+	if errors.As(err, &commitErr) {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "40001", // serialization_failure
+			"40P01", // deadlock_detected
+			"25006", // read_only_sql_transaction, a write reached a demoted primary
+			"53300", // too_many_connections
+			"57P01", // admin_shutdown
+			"57P02", // crash_shutdown
+			"57P03": // cannot_connect_now
+			return true
+		}
+		// Class 08 is connection exceptions
+		return pqErr.Code.Class() == "08"
+	}
+
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr *net.OpError
+	return errors.As(err, &netErr)
+}
@@ -5,22 +5,88 @@ import (
 	"database/sql"
 )
 
+type txKey struct{}
+
+// TxFromContext returns the transaction a TxManager is running the caller in, if any
+func TxFromContext(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
+	return tx, ok
+}
+
+// Bind returns q bound to the transaction in ctx, or q itself outside of one. Repositories
+// run every query on Bind's result so their writes join a transaction a service started.
+func Bind[T any](ctx context.Context, q T, newQueries func(*sql.Tx) T) T {
+	if tx, ok := TxFromContext(ctx); ok {
+		return newQueries(tx)
+	}
+	return q
+}
+
+// Transactor runs fn in a transaction that the repository calls fn makes with the context it
+// is given join, so writes across repositories, and across modules, commit or roll back
+// together
+type Transactor interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// TxManager is the Transactor over a database
+type TxManager struct {
+	db *sql.DB
+}
+
+// NewTxManager creates a transaction manager for db
+func NewTxManager(db *sql.DB) *TxManager {
+	return &TxManager{db: db}
+}
+
+// Verify that TxManager implements the Transactor interface
+var _ Transactor = (*TxManager)(nil)
+
+// WithTx implements Transactor. If fn returns an error the tx rolls back, else it commits.
+// Like Run, it retries transactions that fail with a transient error, so fn may run more
+// than once and must only have effects through the repositories it calls. Called inside
+// another transaction, fn joins it instead.
+func (m *TxManager) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+	return Retry(ctx, DefaultRetryPolicy, "managed_tx", func() error {
+		tx, err := m.db.BeginTx(ctx, nil) // BEGIN
+		if err != nil {
+			return err
+		}
+		if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+			_ = tx.Rollback() // ROLLBACK
+			return err
+		}
+		return commit(tx) // COMMIT
+	})
+}
+
 // Run executes fn inside a *sql.Tx.
-// If fn returns an error the tx rolls back, else it commits.
+// If fn returns an error the tx rolls back, else it commits. A transaction that fails with a
+// transient error is retried from BEGIN under DefaultRetryPolicy, so fn may run more than
+// once and must only have effects through q. When ctx carries a TxManager transaction, fn
+// runs in it, leaving the commit and any retry to the manager.
 func Run[T any](
 	ctx context.Context,
 	db *sql.DB,
 	newQueries func(*sql.Tx) *T,
 	fn func(q *T) error,
 ) error {
-	tx, err := db.BeginTx(ctx, nil) // BEGIN
-	if err != nil {
-		return err
-	}
-	q := newQueries(tx) // bind sqlc Queries to this tx
-	if err := fn(q); err != nil {
-		_ = tx.Rollback() // ROLLBACK
-		return err
+	if tx, ok := TxFromContext(ctx); ok {
+		return fn(newQueries(tx))
 	}
-	return tx.Commit() // COMMIT
+	return Retry(ctx, DefaultRetryPolicy, "tx", func() error {
+		tx, err := db.BeginTx(ctx, nil) // BEGIN
+		if err != nil {
+			return err
+		}
+		q := newQueries(tx) // bind sqlc Queries to this tx
+		if err := fn(q); err != nil {
+			_ = tx.Rollback() // ROLLBACK
+			return err
+		}
+		return commit(tx) // COMMIT
+	})
 }
@@ -2,12 +2,14 @@ package teams
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
 	"github.com/google/uuid"
 	"github.com/mcdev12/dynasty/go/clients/sports_api_client"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
 	"github.com/mcdev12/dynasty/go/internal/sports/base"
 )
 
@@ -15,12 +17,18 @@ import (
 type TeamsRepository interface {
 	CreateTeam(ctx context.Context, req CreateTeamRequest) (*models.Team, error)
 	GetTeam(ctx context.Context, id uuid.UUID) (*models.Team, error)
+	GetTeamsByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Team, error)
 	GetTeamByExternalID(ctx context.Context, sportID, externalID string) (*models.Team, error)
 	GetTeamBySportIdAndCode(ctx context.Context, sportID, code string) (*models.Team, error)
 	ListTeamsBySport(ctx context.Context, sportID string) ([]models.Team, error)
 	ListAllTeams(ctx context.Context) ([]models.Team, error)
 	UpdateTeam(ctx context.Context, id uuid.UUID, req UpdateTeamRequest) (*models.Team, error)
 	DeleteTeam(ctx context.Context, id uuid.UUID) error
+	UpsertTeamByeWeek(ctx context.Context, teamID uuid.UUID, season, byeWeek int) error
+	GetTeamByeWeek(ctx context.Context, teamID uuid.UUID, season *int) (*TeamByeWeek, error)
+	ReplaceTeamDepthChart(ctx context.Context, teamID uuid.UUID, sportID string, slots []models.DepthChartSlot) (int, error)
+	GetTeamDepthChart(ctx context.Context, teamID uuid.UUID) ([]models.TeamDepthChartEntry, error)
+	ReplaceTeamLocalizedNames(ctx context.Context, teamID uuid.UUID, names []models.TeamLocalizedName) error
 }
 
 // SyncResult represents the result of syncing teams from external API
@@ -31,6 +39,16 @@ type SyncResult struct {
 	Errors         []error `json:"errors,omitempty"`
 }
 
+// SeasonDataSyncResult represents the result of syncing bye weeks and depth charts from external API
+type SeasonDataSyncResult struct {
+	Season          int     `json:"season"`
+	ByeWeeksUpdated int     `json:"bye_weeks_updated"`
+	DepthChartTeams int     `json:"depth_chart_teams"`
+	DepthChartSlots int     `json:"depth_chart_slots"`
+	SkippedSlots    int     `json:"skipped_slots"` // slots whose player has not been synced yet
+	Errors          []error `json:"errors,omitempty"`
+}
+
 // App handles teams business logic
 type App struct {
 	repo    TeamsRepository
@@ -75,6 +93,28 @@ func (a *App) GetTeam(ctx context.Context, id uuid.UUID) (*models.Team, error) {
 	return team, nil
 }
 
+// BatchGetTeams retrieves teams by ID, by sport then name, along with the requested IDs without
+// a team in request order
+func (a *App) BatchGetTeams(ctx context.Context, ids []uuid.UUID) ([]models.Team, []uuid.UUID, error) {
+	teams, err := a.repo.GetTeamsByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get teams: %w", err)
+	}
+
+	found := make(map[uuid.UUID]bool, len(teams))
+	for _, team := range teams {
+		found[team.ID] = true
+	}
+	var missing []uuid.UUID
+	for _, id := range ids {
+		if !found[id] {
+			found[id] = true
+			missing = append(missing, id)
+		}
+	}
+	return teams, missing, nil
+}
+
 // GetTeamByExternalID retrieves a team by sport ID and external ID
 func (a *App) GetTeamByExternalID(ctx context.Context, sportID, externalID string) (*models.Team, error) {
 	team, err := a.repo.GetTeamByExternalID(ctx, sportID, externalID)
@@ -110,6 +150,39 @@ func (a *App) ListAllTeams(ctx context.Context) ([]models.Team, error) {
 	return teams, nil
 }
 
+// ListTeamsPage retrieves a page of every sport's teams, ordered by sport and name. Teams are
+// few enough to page through in memory, so pages are by offset.
+func (a *App) ListTeamsPage(ctx context.Context, req pagination.Request) (*TeamPage, error) {
+	pageSize, err := teamPageSizes.Resolve(req.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	offset, err := pagination.DecodeOffset(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := a.repo.ListAllTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list all teams: %w", err)
+	}
+
+	page := &TeamPage{}
+	var more bool
+	page.Teams, more = pagination.Window(teams, offset, pageSize)
+	if more {
+		page.NextPageToken, err = pagination.EncodeOffset(offset + pageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.IncludeTotal {
+		total := len(teams)
+		page.Total = &total
+	}
+	return page, nil
+}
+
 // UpdateTeam updates an existing team with validation
 func (a *App) UpdateTeam(ctx context.Context, id uuid.UUID, req UpdateTeamRequest) (*models.Team, error) {
 	if err := a.validateUpdateTeamRequest(req); err != nil {
@@ -149,8 +222,6 @@ func (a *App) DeleteTeam(ctx context.Context, id uuid.UUID) error {
 
 // SyncTeamsFromAPI synchronizes teams from external sports API
 func (a *App) SyncTeamsFromAPI(ctx context.Context, sportID string) (*SyncResult, error) {
-	result := &SyncResult{}
-
 	plugin, ok := a.plugins[sportID]
 	if !ok {
 		return nil, fmt.Errorf("no plugin registered for sport %q", sportID)
@@ -162,7 +233,17 @@ func (a *App) SyncTeamsFromAPI(ctx context.Context, sportID string) (*SyncResult
 		return nil, fmt.Errorf("failed to fetch teams from plugin: %w", err)
 	}
 
-	result.TotalProcessed = len(apiTeams)
+	return a.IngestTeams(ctx, sportID, apiTeams)
+}
+
+// IngestTeams upserts teams in the sport API's form, whether fetched by a sync or pushed to a
+// plugin webhook
+func (a *App) IngestTeams(ctx context.Context, sportID string, apiTeams []sports_api_client.Team) (*SyncResult, error) {
+	if _, ok := a.plugins[sportID]; !ok {
+		return nil, fmt.Errorf("no plugin registered for sport %q", sportID)
+	}
+
+	result := &SyncResult{TotalProcessed: len(apiTeams)}
 
 	for _, apiTeam := range apiTeams {
 		isNew, err := a.upsertTeam(ctx, sportID, apiTeam)
@@ -184,6 +265,108 @@ func (a *App) SyncTeamsFromAPI(ctx context.Context, sportID string) (*SyncResult
 	return result, nil
 }
 
+// SyncSeasonDataFromAPI ingests bye weeks and depth charts for a season from the sport plugin.
+// Players must already be synced; depth chart slots for unknown players are skipped.
+func (a *App) SyncSeasonDataFromAPI(ctx context.Context, req SyncSeasonDataRequest) (*SeasonDataSyncResult, error) {
+	if err := a.validateSyncSeasonDataRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	plugin, ok := a.plugins[req.SportID]
+	if !ok {
+		return nil, fmt.Errorf("no plugin registered for sport %q", req.SportID)
+	}
+
+	result := &SeasonDataSyncResult{Season: req.Season}
+
+	byeWeeks, err := plugin.FetchByeWeeks(ctx, req.Season)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch bye weeks from plugin: %w", err)
+	}
+
+	for teamCode, byeWeek := range byeWeeks {
+		team, err := a.repo.GetTeamBySportIdAndCode(ctx, req.SportID, teamCode)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("failed to find team %s for bye week: %w", teamCode, err))
+			continue
+		}
+
+		if err := a.repo.UpsertTeamByeWeek(ctx, team.ID, req.Season, byeWeek); err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("failed to store bye week for team %s: %w", teamCode, err))
+			continue
+		}
+		result.ByeWeeksUpdated++
+	}
+
+	depthCharts, err := plugin.FetchDepthCharts(ctx, req.Season, req.Week)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch depth charts from plugin: %w", err)
+	}
+
+	for _, depthChart := range depthCharts {
+		slots, err := plugin.MapExternalDepthChart(depthChart)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("failed to map depth chart: %w", err))
+			continue
+		}
+		if len(slots) == 0 {
+			continue
+		}
+
+		teamCode := slots[0].TeamCode
+		team, err := a.repo.GetTeamBySportIdAndCode(ctx, req.SportID, teamCode)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("failed to find team %s for depth chart: %w", teamCode, err))
+			continue
+		}
+
+		stored, err := a.repo.ReplaceTeamDepthChart(ctx, team.ID, req.SportID, slots)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("failed to store depth chart for team %s: %w", teamCode, err))
+			continue
+		}
+
+		result.DepthChartTeams++
+		result.DepthChartSlots += stored
+		result.SkippedSlots += len(slots) - stored
+	}
+
+	log.Printf("Season data sync completed for %s %d: %d bye weeks, %d depth charts (%d slots, %d skipped), %d errors",
+		req.SportID, req.Season, result.ByeWeeksUpdated, result.DepthChartTeams, result.DepthChartSlots, result.SkippedSlots, len(result.Errors))
+
+	return result, nil
+}
+
+// GetTeamDepthChart retrieves a team's depth chart along with its bye week.
+// When season is nil the bye week for the latest synced season is returned.
+func (a *App) GetTeamDepthChart(ctx context.Context, teamID uuid.UUID, season *int) (*models.TeamDepthChart, error) {
+	// Verify team exists
+	if _, err := a.repo.GetTeam(ctx, teamID); err != nil {
+		return nil, fmt.Errorf("team not found: %w", err)
+	}
+
+	entries, err := a.repo.GetTeamDepthChart(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team depth chart: %w", err)
+	}
+
+	depthChart := &models.TeamDepthChart{
+		TeamID:  teamID,
+		Entries: entries,
+	}
+
+	byeWeek, err := a.repo.GetTeamByeWeek(ctx, teamID, season)
+	if err != nil && !errors.Is(err, ErrNoByeWeek) {
+		return nil, fmt.Errorf("failed to get team bye week: %w", err)
+	}
+	if byeWeek != nil {
+		depthChart.Season = &byeWeek.Season
+		depthChart.ByeWeek = &byeWeek.ByeWeek
+	}
+
+	return depthChart, nil
+}
+
 // GetTeamsWithFilter retrieves teams with filtering and pagination
 func (a *App) GetTeamsWithFilter(ctx context.Context, filter TeamFilter, pagination PaginationParams) (*TeamListResponse, error) {
 	// For now, implement basic filtering - extend with more sophisticated filtering later
@@ -233,10 +416,13 @@ func (a *App) upsertTeam(ctx context.Context, sportID string, apiTeam sports_api
 	if err != nil {
 		// Team doesn't exist, create it
 		createReq := a.teamToCreateRequest(mappedTeam)
-		_, err := a.repo.CreateTeam(ctx, createReq)
+		team, err := a.repo.CreateTeam(ctx, createReq)
 		if err != nil {
 			return false, fmt.Errorf("failed to create team: %w", err)
 		}
+		if err := a.repo.ReplaceTeamLocalizedNames(ctx, team.ID, mappedTeam.LocalizedNames); err != nil {
+			return false, fmt.Errorf("failed to store localized names: %w", err)
+		}
 		return true, nil // Created new team
 	}
 
@@ -246,6 +432,10 @@ func (a *App) upsertTeam(ctx context.Context, sportID string, apiTeam sports_api
 	if err != nil {
 		return false, fmt.Errorf("failed to update team: %w", err)
 	}
+	// The provider's translations replace the ones it sent before
+	if err := a.repo.ReplaceTeamLocalizedNames(ctx, existingTeam.ID, mappedTeam.LocalizedNames); err != nil {
+		return false, fmt.Errorf("failed to store localized names: %w", err)
+	}
 	return false, nil // Updated existing team
 }
 
@@ -331,6 +521,20 @@ func (a *App) validateCreateTeamRequest(req CreateTeamRequest) error {
 	return nil
 }
 
+// validateSyncSeasonDataRequest validates sync season data request
+func (a *App) validateSyncSeasonDataRequest(req SyncSeasonDataRequest) error {
+	if req.SportID == "" {
+		return fmt.Errorf("sport_id is required")
+	}
+	if req.Season <= 0 {
+		return fmt.Errorf("season is required")
+	}
+	if req.Week < 1 {
+		return fmt.Errorf("week must be at least 1")
+	}
+	return nil
+}
+
 // validateUpdateTeamRequest validates update team request
 func (a *App) validateUpdateTeamRequest(req UpdateTeamRequest) error {
 	if req.Name != nil && *req.Name == "" {
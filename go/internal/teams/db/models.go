@@ -55,3 +55,18 @@ type Team struct {
 	EstablishedYear sql.NullInt32  `json:"established_year"`
 	CreatedAt       time.Time      `json:"created_at"`
 }
+
+type TeamByeWeek struct {
+	TeamID    uuid.UUID `json:"team_id"`
+	Season    int32     `json:"season"`
+	ByeWeek   int32     `json:"bye_week"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TeamDepthChart struct {
+	TeamID    uuid.UUID `json:"team_id"`
+	PlayerID  uuid.UUID `json:"player_id"`
+	Position  string    `json:"position"`
+	Depth     int32     `json:"depth"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -13,12 +13,24 @@ import (
 type Querier interface {
 	CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, error)
 	DeleteTeam(ctx context.Context, id uuid.UUID) error
+	DeleteTeamDepthChart(ctx context.Context, teamID uuid.UUID) error
+	DeleteTeamLocalizedNames(ctx context.Context, teamID uuid.UUID) error
 	GetTeam(ctx context.Context, id uuid.UUID) (Team, error)
 	GetTeamByExternalID(ctx context.Context, arg GetTeamByExternalIDParams) (Team, error)
 	GetTeamBySportIdAndAlias(ctx context.Context, arg GetTeamBySportIdAndAliasParams) (Team, error)
+	// Returns the bye week for the given season, or the latest known season when season is NULL.
+	GetTeamByeWeek(ctx context.Context, arg GetTeamByeWeekParams) (TeamByeWeek, error)
+	GetTeamDepthChart(ctx context.Context, teamID uuid.UUID) ([]GetTeamDepthChartRow, error)
+	GetTeamsByIDs(ctx context.Context, ids []uuid.UUID) ([]Team, error)
+	// Resolves the player by external ID; affects no rows when the player has not been synced yet.
+	InsertTeamDepthChartEntry(ctx context.Context, arg InsertTeamDepthChartEntryParams) (int64, error)
+	InsertTeamLocalizedName(ctx context.Context, arg InsertTeamLocalizedNameParams) error
 	ListAllTeams(ctx context.Context) ([]Team, error)
+	// The localized names of the given teams, by team then locale.
+	ListTeamLocalizedNames(ctx context.Context, teamIds []uuid.UUID) ([]ListTeamLocalizedNamesRow, error)
 	ListTeamsBySport(ctx context.Context, sportID string) ([]Team, error)
 	UpdateTeam(ctx context.Context, arg UpdateTeamParams) (Team, error)
+	UpsertTeamByeWeek(ctx context.Context, arg UpsertTeamByeWeekParams) error
 }
 
 var _ Querier = (*Queries)(nil)
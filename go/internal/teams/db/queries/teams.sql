@@ -26,6 +26,11 @@ INSERT INTO teams (
 -- name: GetTeam :one
 SELECT * FROM teams WHERE id = $1;
 
+-- name: GetTeamsByIDs :many
+SELECT * FROM teams
+WHERE id = ANY(@ids::uuid[])
+ORDER BY sport_id, name;
+
 -- name: GetTeamByExternalID :one
 SELECT * FROM teams WHERE sport_id = $1 AND external_id = $2;
 
@@ -51,4 +56,63 @@ WHERE id = $1
 RETURNING *;
 
 -- name: DeleteTeam :exec
-DELETE FROM teams WHERE id = $1;
\ No newline at end of file
+DELETE FROM teams WHERE id = $1;
+
+-- name: UpsertTeamByeWeek :exec
+INSERT INTO team_bye_weeks (team_id, season, bye_week)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id, season) DO UPDATE
+    SET bye_week   = EXCLUDED.bye_week,
+        updated_at = NOW();
+
+-- name: GetTeamByeWeek :one
+-- Returns the bye week for the given season, or the latest known season when season is NULL.
+SELECT * FROM team_bye_weeks
+WHERE team_id = @team_id
+  AND (sqlc.narg('season')::integer IS NULL OR season = sqlc.narg('season')::integer)
+ORDER BY season DESC
+LIMIT 1;
+
+-- name: DeleteTeamDepthChart :exec
+DELETE FROM team_depth_charts WHERE team_id = $1;
+
+-- name: InsertTeamDepthChartEntry :execrows
+-- Resolves the player by external ID; affects no rows when the player has not been synced yet.
+INSERT INTO team_depth_charts (team_id, player_id, position, depth)
+SELECT @team_id::uuid, p.id, @position::text, @depth::integer
+FROM players p
+WHERE p.sport_id = @sport_id
+  AND p.external_id = @player_external_id
+ON CONFLICT (team_id, position, depth) DO UPDATE
+    SET player_id  = EXCLUDED.player_id,
+        updated_at = NOW();
+
+-- name: GetTeamDepthChart :many
+SELECT
+    dc.team_id,
+    dc.player_id,
+    p.full_name,
+    dc.position,
+    dc.depth,
+    dc.updated_at
+FROM team_depth_charts dc
+JOIN players p ON p.id = dc.player_id
+WHERE dc.team_id = $1
+ORDER BY dc.position, dc.depth;
+
+-- name: DeleteTeamLocalizedNames :exec
+DELETE FROM team_localized_names WHERE team_id = $1;
+
+-- name: InsertTeamLocalizedName :exec
+INSERT INTO team_localized_names (team_id, locale, name, city)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (team_id, locale) DO UPDATE
+    SET name       = EXCLUDED.name,
+        city       = EXCLUDED.city,
+        updated_at = NOW();
+
+-- name: ListTeamLocalizedNames :many
+-- The localized names of the given teams, by team then locale.
+SELECT team_id, locale, name, city FROM team_localized_names
+WHERE team_id = ANY(@team_ids::uuid[])
+ORDER BY team_id, locale;
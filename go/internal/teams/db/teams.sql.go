@@ -8,8 +8,10 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const createTeam = `-- name: CreateTeam :one
@@ -88,6 +90,24 @@ func (q *Queries) DeleteTeam(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteTeamDepthChart = `-- name: DeleteTeamDepthChart :exec
+DELETE FROM team_depth_charts WHERE team_id = $1
+`
+
+func (q *Queries) DeleteTeamDepthChart(ctx context.Context, teamID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteTeamDepthChart, teamID)
+	return err
+}
+
+const deleteTeamLocalizedNames = `-- name: DeleteTeamLocalizedNames :exec
+DELETE FROM team_localized_names WHERE team_id = $1
+`
+
+func (q *Queries) DeleteTeamLocalizedNames(ctx context.Context, teamID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteTeamLocalizedNames, teamID)
+	return err
+}
+
 const getTeam = `-- name: GetTeam :one
 SELECT id, sport_id, external_id, name, code, city, coach, owner, stadium, established_year, created_at FROM teams WHERE id = $1
 `
@@ -167,6 +187,186 @@ func (q *Queries) GetTeamBySportIdAndAlias(ctx context.Context, arg GetTeamBySpo
 	return i, err
 }
 
+const getTeamByeWeek = `-- name: GetTeamByeWeek :one
+SELECT team_id, season, bye_week, updated_at FROM team_bye_weeks
+WHERE team_id = $1
+  AND ($2::integer IS NULL OR season = $2::integer)
+ORDER BY season DESC
+LIMIT 1
+`
+
+type GetTeamByeWeekParams struct {
+	TeamID uuid.UUID     `json:"team_id"`
+	Season sql.NullInt32 `json:"season"`
+}
+
+// Returns the bye week for the given season, or the latest known season when season is NULL.
+func (q *Queries) GetTeamByeWeek(ctx context.Context, arg GetTeamByeWeekParams) (TeamByeWeek, error) {
+	row := q.db.QueryRowContext(ctx, getTeamByeWeek, arg.TeamID, arg.Season)
+	var i TeamByeWeek
+	err := row.Scan(
+		&i.TeamID,
+		&i.Season,
+		&i.ByeWeek,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTeamDepthChart = `-- name: GetTeamDepthChart :many
+SELECT
+    dc.team_id,
+    dc.player_id,
+    p.full_name,
+    dc.position,
+    dc.depth,
+    dc.updated_at
+FROM team_depth_charts dc
+JOIN players p ON p.id = dc.player_id
+WHERE dc.team_id = $1
+ORDER BY dc.position, dc.depth
+`
+
+type GetTeamDepthChartRow struct {
+	TeamID    uuid.UUID `json:"team_id"`
+	PlayerID  uuid.UUID `json:"player_id"`
+	FullName  string    `json:"full_name"`
+	Position  string    `json:"position"`
+	Depth     int32     `json:"depth"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (q *Queries) GetTeamDepthChart(ctx context.Context, teamID uuid.UUID) ([]GetTeamDepthChartRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamDepthChart, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTeamDepthChartRow
+	for rows.Next() {
+		var i GetTeamDepthChartRow
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.PlayerID,
+			&i.FullName,
+			&i.Position,
+			&i.Depth,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamsByIDs = `-- name: GetTeamsByIDs :many
+SELECT id, sport_id, external_id, name, code, city, coach, owner, stadium, established_year, created_at FROM teams
+WHERE id = ANY($1::uuid[])
+ORDER BY sport_id, name
+`
+
+func (q *Queries) GetTeamsByIDs(ctx context.Context, ids []uuid.UUID) ([]Team, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamsByIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Team
+	for rows.Next() {
+		var i Team
+		if err := rows.Scan(
+			&i.ID,
+			&i.SportID,
+			&i.ExternalID,
+			&i.Name,
+			&i.Code,
+			&i.City,
+			&i.Coach,
+			&i.Owner,
+			&i.Stadium,
+			&i.EstablishedYear,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertTeamDepthChartEntry = `-- name: InsertTeamDepthChartEntry :execrows
+INSERT INTO team_depth_charts (team_id, player_id, position, depth)
+SELECT $1::uuid, p.id, $2::text, $3::integer
+FROM players p
+WHERE p.sport_id = $4
+  AND p.external_id = $5
+ON CONFLICT (team_id, position, depth) DO UPDATE
+    SET player_id  = EXCLUDED.player_id,
+        updated_at = NOW()
+`
+
+type InsertTeamDepthChartEntryParams struct {
+	TeamID           uuid.UUID `json:"team_id"`
+	Position         string    `json:"position"`
+	Depth            int32     `json:"depth"`
+	SportID          string    `json:"sport_id"`
+	PlayerExternalID string    `json:"player_external_id"`
+}
+
+// Resolves the player by external ID; affects no rows when the player has not been synced yet.
+func (q *Queries) InsertTeamDepthChartEntry(ctx context.Context, arg InsertTeamDepthChartEntryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, insertTeamDepthChartEntry,
+		arg.TeamID,
+		arg.Position,
+		arg.Depth,
+		arg.SportID,
+		arg.PlayerExternalID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const insertTeamLocalizedName = `-- name: InsertTeamLocalizedName :exec
+INSERT INTO team_localized_names (team_id, locale, name, city)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (team_id, locale) DO UPDATE
+    SET name       = EXCLUDED.name,
+        city       = EXCLUDED.city,
+        updated_at = NOW()
+`
+
+type InsertTeamLocalizedNameParams struct {
+	TeamID uuid.UUID `json:"team_id"`
+	Locale string    `json:"locale"`
+	Name   string    `json:"name"`
+	City   string    `json:"city"`
+}
+
+func (q *Queries) InsertTeamLocalizedName(ctx context.Context, arg InsertTeamLocalizedNameParams) error {
+	_, err := q.db.ExecContext(ctx, insertTeamLocalizedName,
+		arg.TeamID,
+		arg.Locale,
+		arg.Name,
+		arg.City,
+	)
+	return err
+}
+
 const listAllTeams = `-- name: ListAllTeams :many
 SELECT id, sport_id, external_id, name, code, city, coach, owner, stadium, established_year, created_at FROM teams ORDER BY sport_id, name
 `
@@ -206,6 +406,48 @@ func (q *Queries) ListAllTeams(ctx context.Context) ([]Team, error) {
 	return items, nil
 }
 
+const listTeamLocalizedNames = `-- name: ListTeamLocalizedNames :many
+SELECT team_id, locale, name, city FROM team_localized_names
+WHERE team_id = ANY($1::uuid[])
+ORDER BY team_id, locale
+`
+
+type ListTeamLocalizedNamesRow struct {
+	TeamID uuid.UUID `json:"team_id"`
+	Locale string    `json:"locale"`
+	Name   string    `json:"name"`
+	City   string    `json:"city"`
+}
+
+// The localized names of the given teams, by team then locale.
+func (q *Queries) ListTeamLocalizedNames(ctx context.Context, teamIds []uuid.UUID) ([]ListTeamLocalizedNamesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamLocalizedNames, pq.Array(teamIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTeamLocalizedNamesRow
+	for rows.Next() {
+		var i ListTeamLocalizedNamesRow
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.Locale,
+			&i.Name,
+			&i.City,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTeamsBySport = `-- name: ListTeamsBySport :many
 SELECT id, sport_id, external_id, name, code, city, coach, owner, stadium, established_year, created_at FROM teams WHERE sport_id = $1 ORDER BY name
 `
@@ -296,3 +538,22 @@ func (q *Queries) UpdateTeam(ctx context.Context, arg UpdateTeamParams) (Team, e
 	)
 	return i, err
 }
+
+const upsertTeamByeWeek = `-- name: UpsertTeamByeWeek :exec
+INSERT INTO team_bye_weeks (team_id, season, bye_week)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_id, season) DO UPDATE
+    SET bye_week   = EXCLUDED.bye_week,
+        updated_at = NOW()
+`
+
+type UpsertTeamByeWeekParams struct {
+	TeamID  uuid.UUID `json:"team_id"`
+	Season  int32     `json:"season"`
+	ByeWeek int32     `json:"bye_week"`
+}
+
+func (q *Queries) UpsertTeamByeWeek(ctx context.Context, arg UpsertTeamByeWeekParams) error {
+	_, err := q.db.ExecContext(ctx, upsertTeamByeWeek, arg.TeamID, arg.Season, arg.ByeWeek)
+	return err
+}
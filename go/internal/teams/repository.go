@@ -2,6 +2,8 @@ package teams
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -14,26 +16,42 @@ import (
 type Querier interface {
 	CreateTeam(ctx context.Context, arg db.CreateTeamParams) (db.Team, error)
 	GetTeam(ctx context.Context, id uuid.UUID) (db.Team, error)
+	GetTeamsByIDs(ctx context.Context, ids []uuid.UUID) ([]db.Team, error)
 	GetTeamByExternalID(ctx context.Context, arg db.GetTeamByExternalIDParams) (db.Team, error)
 	GetTeamBySportIdAndAlias(ctx context.Context, arg db.GetTeamBySportIdAndAliasParams) (db.Team, error)
 	ListTeamsBySport(ctx context.Context, sportID string) ([]db.Team, error)
 	ListAllTeams(ctx context.Context) ([]db.Team, error)
 	UpdateTeam(ctx context.Context, arg db.UpdateTeamParams) (db.Team, error)
 	DeleteTeam(ctx context.Context, id uuid.UUID) error
+	UpsertTeamByeWeek(ctx context.Context, arg db.UpsertTeamByeWeekParams) error
+	GetTeamByeWeek(ctx context.Context, arg db.GetTeamByeWeekParams) (db.TeamByeWeek, error)
+	DeleteTeamDepthChart(ctx context.Context, teamID uuid.UUID) error
+	InsertTeamDepthChartEntry(ctx context.Context, arg db.InsertTeamDepthChartEntryParams) (int64, error)
+	GetTeamDepthChart(ctx context.Context, teamID uuid.UUID) ([]db.GetTeamDepthChartRow, error)
+	DeleteTeamLocalizedNames(ctx context.Context, teamID uuid.UUID) error
+	InsertTeamLocalizedName(ctx context.Context, arg db.InsertTeamLocalizedNameParams) error
+	ListTeamLocalizedNames(ctx context.Context, teamIds []uuid.UUID) ([]db.ListTeamLocalizedNamesRow, error)
 }
 
 // Repository implements team data access operations
 type Repository struct {
 	queries Querier
+	sqlDB   *sql.DB
 }
 
-// NewRepository creates a new teams repository
-func NewRepository(querier Querier) *Repository {
+// NewRepository creates a new teams repository. sqlDB runs depth chart replacements in a
+// transaction.
+func NewRepository(querier Querier, sqlDB *sql.DB) *Repository {
 	return &Repository{
 		queries: querier,
+		sqlDB:   sqlDB,
 	}
 }
 
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
 // CreateTeam creates a new team
 func (r *Repository) CreateTeam(ctx context.Context, req CreateTeamRequest) (*models.Team, error) {
 	params := r.createTeamRequestToParams(req)
@@ -53,7 +71,25 @@ func (r *Repository) GetTeam(ctx context.Context, id uuid.UUID) (*models.Team, e
 		return nil, fmt.Errorf("failed to get team: %w", err)
 	}
 
-	return r.dbTeamToModel(dbTeam), nil
+	return r.withLocalizedNames(ctx, r.dbTeamToModel(dbTeam))
+}
+
+// GetTeamsByIDs retrieves the teams with the given IDs in one query, by sport then name. IDs
+// without a team are skipped.
+func (r *Repository) GetTeamsByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Team, error) {
+	dbTeams, err := r.queries.GetTeamsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams: %w", err)
+	}
+
+	teams := make([]models.Team, len(dbTeams))
+	for i, dbTeam := range dbTeams {
+		teams[i] = *r.dbTeamToModel(dbTeam)
+	}
+	if err := r.loadLocalizedNames(ctx, teams); err != nil {
+		return nil, err
+	}
+	return teams, nil
 }
 
 // GetTeamByExternalID retrieves a team by sport ID and external ID
@@ -68,7 +104,7 @@ func (r *Repository) GetTeamByExternalID(ctx context.Context, sportID, externalI
 		return nil, fmt.Errorf("failed to get team by external ID: %w", err)
 	}
 
-	return r.dbTeamToModel(dbTeam), nil
+	return r.withLocalizedNames(ctx, r.dbTeamToModel(dbTeam))
 }
 
 func (r *Repository) GetTeamBySportIdAndCode(ctx context.Context, sportID, code string) (*models.Team, error) {
@@ -82,7 +118,7 @@ func (r *Repository) GetTeamBySportIdAndCode(ctx context.Context, sportID, code
 		return nil, fmt.Errorf("failed to get team by sport ID and code: %w", err)
 	}
 
-	return r.dbTeamToModel(dbTeam), nil
+	return r.withLocalizedNames(ctx, r.dbTeamToModel(dbTeam))
 }
 
 // ListTeamsBySport retrieves all teams for a specific sport
@@ -96,6 +132,9 @@ func (r *Repository) ListTeamsBySport(ctx context.Context, sportID string) ([]mo
 	for i, dbTeam := range dbTeams {
 		teams[i] = *r.dbTeamToModel(dbTeam)
 	}
+	if err := r.loadLocalizedNames(ctx, teams); err != nil {
+		return nil, err
+	}
 
 	return teams, nil
 }
@@ -111,6 +150,9 @@ func (r *Repository) ListAllTeams(ctx context.Context) ([]models.Team, error) {
 	for i, dbTeam := range dbTeams {
 		teams[i] = *r.dbTeamToModel(dbTeam)
 	}
+	if err := r.loadLocalizedNames(ctx, teams); err != nil {
+		return nil, err
+	}
 
 	return teams, nil
 }
@@ -136,6 +178,158 @@ func (r *Repository) DeleteTeam(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// UpsertTeamByeWeek records the bye week for a team in a season
+func (r *Repository) UpsertTeamByeWeek(ctx context.Context, teamID uuid.UUID, season, byeWeek int) error {
+	params := db.UpsertTeamByeWeekParams{
+		TeamID:  teamID,
+		Season:  int32(season),
+		ByeWeek: int32(byeWeek),
+	}
+
+	if err := r.queries.UpsertTeamByeWeek(ctx, params); err != nil {
+		return fmt.Errorf("failed to upsert team bye week: %w", err)
+	}
+
+	return nil
+}
+
+// GetTeamByeWeek retrieves a team's bye week for a season, or for the latest known season when season is nil.
+// Returns ErrNoByeWeek when no bye week has been recorded.
+func (r *Repository) GetTeamByeWeek(ctx context.Context, teamID uuid.UUID, season *int) (*TeamByeWeek, error) {
+	params := db.GetTeamByeWeekParams{
+		TeamID: teamID,
+		Season: sqlutil.ToSqlInt32(season),
+	}
+
+	dbByeWeek, err := r.queries.GetTeamByeWeek(ctx, params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoByeWeek
+		}
+		return nil, fmt.Errorf("failed to get team bye week: %w", err)
+	}
+
+	return &TeamByeWeek{
+		TeamID:  dbByeWeek.TeamID,
+		Season:  int(dbByeWeek.Season),
+		ByeWeek: int(dbByeWeek.ByeWeek),
+	}, nil
+}
+
+// ReplaceTeamDepthChart replaces a team's depth chart with the given slots.
+// Slots whose player has not been synced yet are skipped; the number of stored slots is returned.
+func (r *Repository) ReplaceTeamDepthChart(ctx context.Context, teamID uuid.UUID, sportID string, slots []models.DepthChartSlot) (int, error) {
+	stored := 0
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		// Count from scratch if the transaction is retried
+		stored = 0
+		if err := q.DeleteTeamDepthChart(ctx, teamID); err != nil {
+			return fmt.Errorf("failed to delete team depth chart: %w", err)
+		}
+
+		for _, slot := range slots {
+			rowsAffected, err := q.InsertTeamDepthChartEntry(ctx, db.InsertTeamDepthChartEntryParams{
+				TeamID:           teamID,
+				Position:         slot.Position,
+				Depth:            int32(slot.Depth),
+				SportID:          sportID,
+				PlayerExternalID: slot.PlayerExternalID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to insert depth chart entry for %s: %w", slot.PlayerExternalID, err)
+			}
+			stored += int(rowsAffected)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return stored, nil
+}
+
+// GetTeamDepthChart retrieves a team's depth chart ordered by position and depth
+func (r *Repository) GetTeamDepthChart(ctx context.Context, teamID uuid.UUID) ([]models.TeamDepthChartEntry, error) {
+	rows, err := r.queries.GetTeamDepthChart(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team depth chart: %w", err)
+	}
+
+	entries := make([]models.TeamDepthChartEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = models.TeamDepthChartEntry{
+			TeamID:     row.TeamID,
+			PlayerID:   row.PlayerID,
+			PlayerName: row.FullName,
+			Position:   row.Position,
+			Depth:      int(row.Depth),
+			UpdatedAt:  row.UpdatedAt,
+		}
+	}
+
+	return entries, nil
+}
+
+// ReplaceTeamLocalizedNames replaces a team's localized names with the given ones
+func (r *Repository) ReplaceTeamLocalizedNames(ctx context.Context, teamID uuid.UUID, names []models.TeamLocalizedName) error {
+	if err := r.queries.DeleteTeamLocalizedNames(ctx, teamID); err != nil {
+		return fmt.Errorf("failed to delete team localized names: %w", err)
+	}
+
+	for _, name := range names {
+		err := r.queries.InsertTeamLocalizedName(ctx, db.InsertTeamLocalizedNameParams{
+			TeamID: teamID,
+			Locale: name.Locale,
+			Name:   name.Name,
+			City:   name.City,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert team localized name for %s: %w", name.Locale, err)
+		}
+	}
+
+	return nil
+}
+
+// withLocalizedNames loads a team's localized names into it
+func (r *Repository) withLocalizedNames(ctx context.Context, team *models.Team) (*models.Team, error) {
+	teams := []models.Team{*team}
+	if err := r.loadLocalizedNames(ctx, teams); err != nil {
+		return nil, err
+	}
+	return &teams[0], nil
+}
+
+// loadLocalizedNames loads the localized names of teams into them in one query
+func (r *Repository) loadLocalizedNames(ctx context.Context, teams []models.Team) error {
+	if len(teams) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(teams))
+	byID := make(map[uuid.UUID]*models.Team, len(teams))
+	for i := range teams {
+		ids[i] = teams[i].ID
+		byID[teams[i].ID] = &teams[i]
+	}
+
+	rows, err := r.queries.ListTeamLocalizedNames(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to list team localized names: %w", err)
+	}
+	for _, row := range rows {
+		if team, ok := byID[row.TeamID]; ok {
+			team.LocalizedNames = append(team.LocalizedNames, models.TeamLocalizedName{
+				Locale: row.Locale,
+				Name:   row.Name,
+				City:   row.City,
+			})
+		}
+	}
+	return nil
+}
+
 // createTeamRequestToParams converts CreateTeamRequest to sqlc params
 func (r *Repository) createTeamRequestToParams(req CreateTeamRequest) db.CreateTeamParams {
 	return db.CreateTeamParams{
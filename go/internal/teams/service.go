@@ -2,12 +2,17 @@ package teams
 
 import (
 	"context"
+	"errors"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/clients/sports_api_client"
 	teamv1 "github.com/mcdev12/dynasty/go/internal/genproto/team/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/team/v1/teamv1connect"
+	"github.com/mcdev12/dynasty/go/internal/i18n"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -15,14 +20,19 @@ import (
 type TeamsApp interface {
 	CreateTeam(ctx context.Context, req CreateTeamRequest) (*models.Team, error)
 	GetTeam(ctx context.Context, id uuid.UUID) (*models.Team, error)
+	BatchGetTeams(ctx context.Context, ids []uuid.UUID) ([]models.Team, []uuid.UUID, error)
 	GetTeamByExternalID(ctx context.Context, sportID, externalID string) (*models.Team, error)
 	GetTeamBySportIdAndCode(ctx context.Context, sportID, code string) (*models.Team, error)
 	ListTeamsBySport(ctx context.Context, sportID string) ([]models.Team, error)
 	ListAllTeams(ctx context.Context) ([]models.Team, error)
+	ListTeamsPage(ctx context.Context, req pagination.Request) (*TeamPage, error)
 	UpdateTeam(ctx context.Context, id uuid.UUID, req UpdateTeamRequest) (*models.Team, error)
 	DeleteTeam(ctx context.Context, id uuid.UUID) error
 	SyncTeamsFromAPI(ctx context.Context, sportID string) (*SyncResult, error)
+	IngestTeams(ctx context.Context, sportID string, apiTeams []sports_api_client.Team) (*SyncResult, error)
 	GetTeamsWithFilter(ctx context.Context, filter TeamFilter, pagination PaginationParams) (*TeamListResponse, error)
+	GetTeamDepthChart(ctx context.Context, teamID uuid.UUID, season *int) (*models.TeamDepthChart, error)
+	SyncSeasonDataFromAPI(ctx context.Context, req SyncSeasonDataRequest) (*SeasonDataSyncResult, error)
 }
 
 // Service implements the TeamService gRPC interface
@@ -49,7 +59,7 @@ func (s *Service) CreateTeam(ctx context.Context, req *connect.Request[teamv1.Cr
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	protoTeam := s.teamToProto(team)
+	protoTeam := s.teamToProto(ctx, team)
 
 	return connect.NewResponse(&teamv1.CreateTeamResponse{
 		Team: protoTeam,
@@ -58,9 +68,9 @@ func (s *Service) CreateTeam(ctx context.Context, req *connect.Request[teamv1.Cr
 
 // GetTeam retrieves a team by ID
 func (s *Service) GetTeam(ctx context.Context, req *connect.Request[teamv1.GetTeamRequest]) (*connect.Response[teamv1.GetTeamResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	team, err := s.app.GetTeam(ctx, id)
@@ -68,13 +78,38 @@ func (s *Service) GetTeam(ctx context.Context, req *connect.Request[teamv1.GetTe
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	protoTeam := s.teamToProto(team)
+	protoTeam := s.teamToProto(ctx, team)
 
 	return connect.NewResponse(&teamv1.GetTeamResponse{
 		Team: protoTeam,
 	}), nil
 }
 
+// BatchGetTeams retrieves teams by ID in one round trip, reporting the IDs without a team
+func (s *Service) BatchGetTeams(ctx context.Context, req *connect.Request[teamv1.BatchGetTeamsRequest]) (*connect.Response[teamv1.BatchGetTeamsResponse], error) {
+	ids, err := uuidutil.ParseAll("ids", req.Msg.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, missing, err := s.app.BatchGetTeams(ctx, ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &teamv1.BatchGetTeamsResponse{
+		Teams:      make([]*teamv1.Team, len(teams)),
+		MissingIds: make([]string, len(missing)),
+	}
+	for i := range teams {
+		resp.Teams[i] = s.teamToProto(ctx, &teams[i])
+	}
+	for i, id := range missing {
+		resp.MissingIds[i] = id.String()
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // GetTeamByExternalID retrieves a team by sport ID and external ID
 func (s *Service) GetTeamByExternalID(ctx context.Context, req *connect.Request[teamv1.GetTeamByExternalIDRequest]) (*connect.Response[teamv1.GetTeamByExternalIDResponse], error) {
 	team, err := s.app.GetTeamByExternalID(ctx, req.Msg.SportId, req.Msg.ExternalId)
@@ -82,7 +117,7 @@ func (s *Service) GetTeamByExternalID(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	protoTeam := s.teamToProto(team)
+	protoTeam := s.teamToProto(ctx, team)
 
 	return connect.NewResponse(&teamv1.GetTeamByExternalIDResponse{
 		Team: protoTeam,
@@ -95,7 +130,7 @@ func (s *Service) GetTeamBySportIDAndCode(ctx context.Context, req *connect.Requ
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	protoTeam := s.teamToProto(team)
+	protoTeam := s.teamToProto(ctx, team)
 
 	return connect.NewResponse(&teamv1.GetTeamBySportIDAndCodeResponse{
 		Team: protoTeam,
@@ -111,7 +146,7 @@ func (s *Service) ListTeamsBySport(ctx context.Context, req *connect.Request[tea
 
 	protoTeams := make([]*teamv1.Team, len(teams))
 	for i, team := range teams {
-		protoTeams[i] = s.teamToProto(&team)
+		protoTeams[i] = s.teamToProto(ctx, &team)
 	}
 
 	return connect.NewResponse(&teamv1.ListTeamsBySportResponse{
@@ -121,6 +156,15 @@ func (s *Service) ListTeamsBySport(ctx context.Context, req *connect.Request[tea
 
 // ListAllTeams retrieves all teams with optional filtering and pagination
 func (s *Service) ListAllTeams(ctx context.Context, req *connect.Request[teamv1.ListAllTeamsRequest]) (*connect.Response[teamv1.ListAllTeamsResponse], error) {
+	paging := pagination.Request{
+		PageSize:     int(req.Msg.PageSize),
+		PageToken:    req.Msg.PageToken,
+		IncludeTotal: req.Msg.IncludeTotal,
+	}
+	if paging.Paged() {
+		return s.listTeamsPage(ctx, paging)
+	}
+
 	teams, err := s.app.ListAllTeams(ctx)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
@@ -145,7 +189,7 @@ func (s *Service) ListAllTeams(ctx context.Context, req *connect.Request[teamv1.
 
 	protoTeams := make([]*teamv1.Team, len(teams))
 	for i, team := range teams {
-		protoTeams[i] = s.teamToProto(&team)
+		protoTeams[i] = s.teamToProto(ctx, &team)
 	}
 
 	hasMore := false
@@ -160,11 +204,37 @@ func (s *Service) ListAllTeams(ctx context.Context, req *connect.Request[teamv1.
 	}), nil
 }
 
+// listTeamsPage serves a ListAllTeams request paged by token
+func (s *Service) listTeamsPage(ctx context.Context, paging pagination.Request) (*connect.Response[teamv1.ListAllTeamsResponse], error) {
+	page, err := s.app.ListTeamsPage(ctx, paging)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidPageToken) || errors.Is(err, pagination.ErrInvalidPageSize) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoTeams := make([]*teamv1.Team, len(page.Teams))
+	for i := range page.Teams {
+		protoTeams[i] = s.teamToProto(ctx, &page.Teams[i])
+	}
+
+	resp := &teamv1.ListAllTeamsResponse{
+		Teams:         protoTeams,
+		HasMore:       page.NextPageToken != "",
+		NextPageToken: page.NextPageToken,
+	}
+	if page.Total != nil {
+		resp.Total = int32(*page.Total)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // UpdateTeam updates an existing team
 func (s *Service) UpdateTeam(ctx context.Context, req *connect.Request[teamv1.UpdateTeamRequest]) (*connect.Response[teamv1.UpdateTeamResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	appReq := s.protoToUpdateTeamRequest(req.Msg)
@@ -174,7 +244,7 @@ func (s *Service) UpdateTeam(ctx context.Context, req *connect.Request[teamv1.Up
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	protoTeam := s.teamToProto(team)
+	protoTeam := s.teamToProto(ctx, team)
 
 	return connect.NewResponse(&teamv1.UpdateTeamResponse{
 		Team: protoTeam,
@@ -183,9 +253,9 @@ func (s *Service) UpdateTeam(ctx context.Context, req *connect.Request[teamv1.Up
 
 // DeleteTeam deletes a team by ID
 func (s *Service) DeleteTeam(ctx context.Context, req *connect.Request[teamv1.DeleteTeamRequest]) (*connect.Response[teamv1.DeleteTeamResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	err = s.app.DeleteTeam(ctx, id)
@@ -212,6 +282,11 @@ func (s *Service) SyncTeamsFromAPI(ctx context.Context, req *connect.Request[tea
 	}), nil
 }
 
+// IngestTeams upserts teams pushed to a sport plugin's webhook
+func (s *Service) IngestTeams(ctx context.Context, sportID string, apiTeams []sports_api_client.Team) (*SyncResult, error) {
+	return s.app.IngestTeams(ctx, sportID, apiTeams)
+}
+
 // GetTeamsWithFilter retrieves teams with filtering and pagination
 func (s *Service) GetTeamsWithFilter(ctx context.Context, req *connect.Request[teamv1.GetTeamsWithFilterRequest]) (*connect.Response[teamv1.GetTeamsWithFilterResponse], error) {
 	filter := TeamFilter{}
@@ -230,16 +305,61 @@ func (s *Service) GetTeamsWithFilter(ctx context.Context, req *connect.Request[t
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	protoResponse := s.teamListResponseToProto(response)
+	protoResponse := s.teamListResponseToProto(ctx, response)
 
 	return connect.NewResponse(&teamv1.GetTeamsWithFilterResponse{
 		Response: protoResponse,
 	}), nil
 }
 
+// GetTeamDepthChart retrieves a team's depth chart and bye week
+func (s *Service) GetTeamDepthChart(ctx context.Context, req *connect.Request[teamv1.GetTeamDepthChartRequest]) (*connect.Response[teamv1.GetTeamDepthChartResponse], error) {
+	teamID, err := uuidutil.MustParseOrInvalidArg("team_id", req.Msg.TeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	var season *int
+	if req.Msg.Season != nil {
+		value := int(*req.Msg.Season)
+		season = &value
+	}
+
+	depthChart, err := s.app.GetTeamDepthChart(ctx, teamID, season)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	return connect.NewResponse(&teamv1.GetTeamDepthChartResponse{
+		DepthChart: s.teamDepthChartToProto(depthChart),
+	}), nil
+}
+
+// SyncSeasonDataFromAPI ingests bye weeks and depth charts from external sports API
+func (s *Service) SyncSeasonDataFromAPI(ctx context.Context, req *connect.Request[teamv1.SyncSeasonDataFromAPIRequest]) (*connect.Response[teamv1.SyncSeasonDataFromAPIResponse], error) {
+	appReq := SyncSeasonDataRequest{
+		SportID: req.Msg.SportId,
+		Season:  int(req.Msg.Season),
+		Week:    int(req.Msg.Week),
+	}
+	if appReq.Week == 0 {
+		appReq.Week = 1
+	}
+
+	result, err := s.app.SyncSeasonDataFromAPI(ctx, appReq)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&teamv1.SyncSeasonDataFromAPIResponse{
+		Result: s.seasonDataSyncResultToProto(result),
+	}), nil
+}
+
 // Conversion methods between proto and app layer models
 
-func (s *Service) teamToProto(team *models.Team) *teamv1.Team {
+// teamToProto converts a team, naming it for display in the request's locale
+func (s *Service) teamToProto(ctx context.Context, team *models.Team) *teamv1.Team {
 	proto := &teamv1.Team{
 		Id:         team.ID.String(),
 		SportId:    team.SportID,
@@ -250,6 +370,21 @@ func (s *Service) teamToProto(team *models.Team) *teamv1.Team {
 		CreatedAt:  timestamppb.New(team.CreatedAt),
 	}
 
+	proto.DisplayName, proto.DisplayCity = team.Name, team.City
+	locales := make([]string, len(team.LocalizedNames))
+	proto.LocalizedNames = make([]*teamv1.LocalizedTeamName, len(team.LocalizedNames))
+	for i, name := range team.LocalizedNames {
+		locales[i] = name.Locale
+		proto.LocalizedNames[i] = &teamv1.LocalizedTeamName{
+			Locale: name.Locale,
+			Name:   name.Name,
+			City:   name.City,
+		}
+	}
+	if best := i18n.Best(i18n.FromContext(ctx), locales); best >= 0 {
+		proto.DisplayName, proto.DisplayCity = team.LocalizedNames[best].Name, team.LocalizedNames[best].City
+	}
+
 	if team.Coach != nil {
 		proto.Coach = team.Coach
 	}
@@ -359,10 +494,10 @@ func (s *Service) syncResultToProto(result *SyncResult) *teamv1.SyncResult {
 	}
 }
 
-func (s *Service) teamListResponseToProto(response *TeamListResponse) *teamv1.TeamListResponse {
+func (s *Service) teamListResponseToProto(ctx context.Context, response *TeamListResponse) *teamv1.TeamListResponse {
 	protoTeams := make([]*teamv1.Team, len(response.Teams))
 	for i, team := range response.Teams {
-		protoTeams[i] = s.teamToProto(&team)
+		protoTeams[i] = s.teamToProto(ctx, &team)
 	}
 
 	return &teamv1.TeamListResponse{
@@ -373,3 +508,48 @@ func (s *Service) teamListResponseToProto(response *TeamListResponse) *teamv1.Te
 		HasMore: response.HasMore,
 	}
 }
+
+func (s *Service) teamDepthChartToProto(depthChart *models.TeamDepthChart) *teamv1.TeamDepthChart {
+	entries := make([]*teamv1.DepthChartEntry, len(depthChart.Entries))
+	for i, entry := range depthChart.Entries {
+		entries[i] = &teamv1.DepthChartEntry{
+			PlayerId:   entry.PlayerID.String(),
+			PlayerName: entry.PlayerName,
+			Position:   entry.Position,
+			Depth:      int32(entry.Depth),
+			UpdatedAt:  timestamppb.New(entry.UpdatedAt),
+		}
+	}
+
+	proto := &teamv1.TeamDepthChart{
+		TeamId:  depthChart.TeamID.String(),
+		Entries: entries,
+	}
+
+	if depthChart.Season != nil {
+		season := int32(*depthChart.Season)
+		proto.Season = &season
+	}
+	if depthChart.ByeWeek != nil {
+		byeWeek := int32(*depthChart.ByeWeek)
+		proto.ByeWeek = &byeWeek
+	}
+
+	return proto
+}
+
+func (s *Service) seasonDataSyncResultToProto(result *SeasonDataSyncResult) *teamv1.SeasonDataSyncResult {
+	errors := make([]string, len(result.Errors))
+	for i, err := range result.Errors {
+		errors[i] = err.Error()
+	}
+
+	return &teamv1.SeasonDataSyncResult{
+		Season:          int32(result.Season),
+		ByeWeeksUpdated: int32(result.ByeWeeksUpdated),
+		DepthChartTeams: int32(result.DepthChartTeams),
+		DepthChartSlots: int32(result.DepthChartSlots),
+		SkippedSlots:    int32(result.SkippedSlots),
+		Errors:          errors,
+	}
+}
@@ -1,9 +1,16 @@
 package teams
 
 import (
+	"errors"
+
+	"github.com/google/uuid"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
 )
 
+// ErrNoByeWeek is returned when no bye week has been recorded for a team
+var ErrNoByeWeek = errors.New("no bye week recorded for team")
+
 // CreateTeamRequest represents the data needed to create a new team
 type CreateTeamRequest struct {
 	SportID         string  `json:"sport_id" validate:"required"`
@@ -51,6 +58,16 @@ type PaginationParams struct {
 	Offset int `json:"offset" validate:"min=0"`
 }
 
+// teamPageSizes are the page sizes of a ListTeamsPage request
+var teamPageSizes = pagination.Sizes{Default: 50, Max: 200}
+
+// TeamPage is one page of every sport's teams
+type TeamPage struct {
+	Teams         []models.Team `json:"teams"`
+	NextPageToken string        `json:"next_page_token,omitempty"` // empty on the last page
+	Total         *int          `json:"total,omitempty"`           // set when the request included the total
+}
+
 // TeamListResponse represents a paginated list of teams
 type TeamListResponse struct {
 	Teams   []models.Team `json:"teams"`
@@ -59,3 +76,17 @@ type TeamListResponse struct {
 	Offset  int           `json:"offset"`
 	HasMore bool          `json:"has_more"`
 }
+
+// TeamByeWeek represents a team's bye week in a season
+type TeamByeWeek struct {
+	TeamID  uuid.UUID `json:"team_id"`
+	Season  int       `json:"season"`
+	ByeWeek int       `json:"bye_week"`
+}
+
+// SyncSeasonDataRequest represents the season data to ingest from the sport plugin
+type SyncSeasonDataRequest struct {
+	SportID string `json:"sport_id" validate:"required"`
+	Season  int    `json:"season" validate:"required"`
+	Week    int    `json:"week" validate:"min=1"` // depth chart week
+}
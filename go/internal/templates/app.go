@@ -0,0 +1,123 @@
+package templates
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// TemplatesRepository defines what the app layer needs from the repository
+type TemplatesRepository interface {
+	CreateSettingsTemplate(ctx context.Context, req CreateSettingsTemplateRequest) (*models.SettingsTemplate, error)
+	GetSettingsTemplate(ctx context.Context, id uuid.UUID) (*models.SettingsTemplate, error)
+	ListSettingsTemplates(ctx context.Context, filter ListSettingsTemplatesFilter) ([]models.SettingsTemplate, error)
+	DeleteSettingsTemplate(ctx context.Context, id, ownerID uuid.UUID) (bool, error)
+}
+
+// App handles settings template business logic
+type App struct {
+	repo TemplatesRepository
+}
+
+// NewApp creates a new templates App
+func NewApp(repo TemplatesRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// CreateSettingsTemplate saves a settings bundle as a named template
+func (a *App) CreateSettingsTemplate(ctx context.Context, req CreateSettingsTemplateRequest) (*models.SettingsTemplate, error) {
+	if err := a.validateCreateSettingsTemplateRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Draft order is per-league, so it never belongs in a reusable template
+	if req.DraftSettings != nil {
+		settings := *req.DraftSettings
+		settings.DraftOrder = nil
+		req.DraftSettings = &settings
+	}
+
+	template, err := a.repo.CreateSettingsTemplate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create settings template: %w", err)
+	}
+
+	log.Printf("Created settings template: %s (%s) for sport %s", template.Name, template.ID, template.SportID)
+	return template, nil
+}
+
+// GetSettingsTemplate retrieves a template by ID
+func (a *App) GetSettingsTemplate(ctx context.Context, id uuid.UUID) (*models.SettingsTemplate, error) {
+	template, err := a.repo.GetSettingsTemplate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings template: %w", err)
+	}
+	return template, nil
+}
+
+// ListSettingsTemplates lists templates matching the filter
+func (a *App) ListSettingsTemplates(ctx context.Context, filter ListSettingsTemplatesFilter) ([]models.SettingsTemplate, error) {
+	templates, err := a.repo.ListSettingsTemplates(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list settings templates: %w", err)
+	}
+	return templates, nil
+}
+
+// DeleteSettingsTemplate deletes a template owned by ownerID. Built-in presets can't be deleted.
+func (a *App) DeleteSettingsTemplate(ctx context.Context, id, ownerID uuid.UUID) error {
+	template, err := a.repo.GetSettingsTemplate(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get settings template: %w", err)
+	}
+	if template.IsPreset() || *template.OwnerID != ownerID {
+		return ErrNotTemplateOwner
+	}
+
+	deleted, err := a.repo.DeleteSettingsTemplate(ctx, id, ownerID)
+	if err != nil {
+		return fmt.Errorf("failed to delete settings template: %w", err)
+	}
+	if !deleted {
+		return ErrTemplateNotFound
+	}
+
+	log.Printf("Deleted settings template: %s (%s)", template.Name, template.ID)
+	return nil
+}
+
+// validateCreateSettingsTemplateRequest validates create settings template request
+func (a *App) validateCreateSettingsTemplateRequest(req CreateSettingsTemplateRequest) error {
+	if req.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if req.SportID == "" {
+		return fmt.Errorf("sport_id is required")
+	}
+	if req.OwnerID == uuid.Nil {
+		return fmt.Errorf("owner_id is required")
+	}
+	if req.LeagueSettings == nil && req.DraftSettings == nil {
+		return fmt.Errorf("league_settings or draft_settings is required")
+	}
+	if req.DraftSettings != nil {
+		if req.DraftType == nil {
+			return fmt.Errorf("draft_type is required with draft_settings")
+		}
+		if req.DraftSettings.Rounds <= 0 {
+			return fmt.Errorf("rounds must be greater than 0")
+		}
+		if req.DraftSettings.TimePerPickSec < 0 {
+			return fmt.Errorf("time_per_pick_sec cannot be negative")
+		}
+		if err := req.DraftSettings.ValidateTypeSettings(*req.DraftType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
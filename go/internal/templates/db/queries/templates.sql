@@ -0,0 +1,20 @@
+-- name: CreateSettingsTemplate :one
+INSERT INTO settings_templates (name, description, sport_id, owner_id, is_public, league_type, league_settings, draft_type, draft_settings)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetSettingsTemplate :one
+SELECT * FROM settings_templates
+WHERE id = $1;
+
+-- name: ListSettingsTemplates :many
+-- Public templates plus the given owner's private ones, built-in presets first.
+SELECT * FROM settings_templates
+WHERE (sqlc.narg('sport_id')::text IS NULL OR sport_id = sqlc.narg('sport_id')::text)
+  AND (is_public OR owner_id = sqlc.narg('owner_id')::uuid)
+ORDER BY owner_id IS NOT NULL, name;
+
+-- name: DeleteSettingsTemplate :execrows
+-- Only the owner can delete a template; built-in presets have no owner.
+DELETE FROM settings_templates
+WHERE id = $1 AND owner_id = $2;
\ No newline at end of file
@@ -0,0 +1,170 @@
+package templates
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/templates/db"
+	"github.com/sqlc-dev/pqtype"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	CreateSettingsTemplate(ctx context.Context, arg db.CreateSettingsTemplateParams) (db.SettingsTemplate, error)
+	DeleteSettingsTemplate(ctx context.Context, arg db.DeleteSettingsTemplateParams) (int64, error)
+	GetSettingsTemplate(ctx context.Context, id uuid.UUID) (db.SettingsTemplate, error)
+	ListSettingsTemplates(ctx context.Context, arg db.ListSettingsTemplatesParams) ([]db.SettingsTemplate, error)
+}
+
+// Repository implements settings template data access operations
+type Repository struct {
+	queries Querier
+}
+
+// NewRepository creates a new settings template repository
+func NewRepository(querier Querier) *Repository {
+	return &Repository{
+		queries: querier,
+	}
+}
+
+// CreateSettingsTemplate stores a new template owned by req.OwnerID
+func (r *Repository) CreateSettingsTemplate(ctx context.Context, req CreateSettingsTemplateRequest) (*models.SettingsTemplate, error) {
+	leagueSettings := req.LeagueSettings
+	if leagueSettings == nil {
+		leagueSettings = map[string]interface{}{}
+	}
+	leagueSettingsJSON, err := json.Marshal(leagueSettings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal league settings: %w", err)
+	}
+
+	params := db.CreateSettingsTemplateParams{
+		Name:           req.Name,
+		Description:    req.Description,
+		SportID:        req.SportID,
+		OwnerID:        uuid.NullUUID{UUID: req.OwnerID, Valid: true},
+		IsPublic:       req.IsPublic,
+		LeagueSettings: leagueSettingsJSON,
+	}
+	if req.LeagueType != nil {
+		params.LeagueType = db.NullLeagueType{LeagueType: db.LeagueType(*req.LeagueType), Valid: true}
+	}
+	if req.DraftType != nil {
+		params.DraftType = db.NullDraftType{DraftType: db.DraftType(*req.DraftType), Valid: true}
+	}
+	if req.DraftSettings != nil {
+		draftSettingsJSON, err := json.Marshal(req.DraftSettings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal draft settings: %w", err)
+		}
+		params.DraftSettings = pqtype.NullRawMessage{RawMessage: draftSettingsJSON, Valid: true}
+	}
+
+	template, err := r.queries.CreateSettingsTemplate(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create settings template: %w", err)
+	}
+
+	return r.dbSettingsTemplateToModel(template)
+}
+
+// GetSettingsTemplate retrieves a template by ID
+func (r *Repository) GetSettingsTemplate(ctx context.Context, id uuid.UUID) (*models.SettingsTemplate, error) {
+	template, err := r.queries.GetSettingsTemplate(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get settings template: %w", err)
+	}
+
+	return r.dbSettingsTemplateToModel(template)
+}
+
+// ListSettingsTemplates lists public templates, plus the owner's private ones when filter.OwnerID is set
+func (r *Repository) ListSettingsTemplates(ctx context.Context, filter ListSettingsTemplatesFilter) ([]models.SettingsTemplate, error) {
+	params := db.ListSettingsTemplatesParams{}
+	if filter.SportID != nil {
+		params.SportID = sql.NullString{String: *filter.SportID, Valid: true}
+	}
+	if filter.OwnerID != nil {
+		params.OwnerID = uuid.NullUUID{UUID: *filter.OwnerID, Valid: true}
+	}
+
+	rows, err := r.queries.ListSettingsTemplates(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list settings templates: %w", err)
+	}
+
+	result := make([]models.SettingsTemplate, len(rows))
+	for i, row := range rows {
+		template, err := r.dbSettingsTemplateToModel(row)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *template
+	}
+	return result, nil
+}
+
+// DeleteSettingsTemplate deletes a template owned by ownerID, reporting whether one was deleted
+func (r *Repository) DeleteSettingsTemplate(ctx context.Context, id, ownerID uuid.UUID) (bool, error) {
+	rowsAffected, err := r.queries.DeleteSettingsTemplate(ctx, db.DeleteSettingsTemplateParams{
+		ID:      id,
+		OwnerID: uuid.NullUUID{UUID: ownerID, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete settings template: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// dbSettingsTemplateToModel converts a database template to the domain model
+func (r *Repository) dbSettingsTemplateToModel(dbTemplate db.SettingsTemplate) (*models.SettingsTemplate, error) {
+	template := &models.SettingsTemplate{
+		ID:          dbTemplate.ID,
+		Name:        dbTemplate.Name,
+		Description: dbTemplate.Description,
+		SportID:     dbTemplate.SportID,
+		IsPublic:    dbTemplate.IsPublic,
+		CreatedAt:   dbTemplate.CreatedAt,
+		UpdatedAt:   dbTemplate.UpdatedAt,
+	}
+
+	if dbTemplate.OwnerID.Valid {
+		ownerID := dbTemplate.OwnerID.UUID
+		template.OwnerID = &ownerID
+	}
+	if dbTemplate.LeagueType.Valid {
+		leagueType := models.LeagueType(dbTemplate.LeagueType.LeagueType)
+		template.LeagueType = &leagueType
+	}
+	if dbTemplate.DraftType.Valid {
+		draftType := models.DraftType(dbTemplate.DraftType.DraftType)
+		template.DraftType = &draftType
+	}
+
+	var leagueSettings interface{}
+	if len(dbTemplate.LeagueSettings) > 0 {
+		if err := json.Unmarshal(dbTemplate.LeagueSettings, &leagueSettings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal league settings: %w", err)
+		}
+	}
+	template.LeagueSettings = leagueSettings
+
+	if dbTemplate.DraftSettings.Valid {
+		var draftSettings models.DraftSettings
+		if err := json.Unmarshal(dbTemplate.DraftSettings.RawMessage, &draftSettings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal draft settings: %w", err)
+		}
+		template.DraftSettings = &draftSettings
+	}
+
+	return template, nil
+}
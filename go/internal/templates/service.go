@@ -0,0 +1,477 @@
+package templates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
+	templatev1 "github.com/mcdev12/dynasty/go/internal/genproto/template/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/template/v1/templatev1connect"
+	userv1 "github.com/mcdev12/dynasty/go/internal/genproto/user/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/user/v1/userv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TemplatesApp defines what the service layer needs from the templates application
+type TemplatesApp interface {
+	CreateSettingsTemplate(ctx context.Context, req CreateSettingsTemplateRequest) (*models.SettingsTemplate, error)
+	GetSettingsTemplate(ctx context.Context, id uuid.UUID) (*models.SettingsTemplate, error)
+	ListSettingsTemplates(ctx context.Context, filter ListSettingsTemplatesFilter) ([]models.SettingsTemplate, error)
+	DeleteSettingsTemplate(ctx context.Context, id, ownerID uuid.UUID) error
+}
+
+// Service implements the SettingsTemplateService gRPC interface
+type Service struct {
+	app         TemplatesApp
+	userService userv1connect.UserServiceClient
+}
+
+// NewService creates a new settings template gRPC service
+func NewService(app TemplatesApp, userService userv1connect.UserServiceClient) *Service {
+	return &Service{
+		app:         app,
+		userService: userService,
+	}
+}
+
+// Verify that Service implements the SettingsTemplateServiceHandler interface
+var _ templatev1connect.SettingsTemplateServiceHandler = (*Service)(nil)
+
+// CreateSettingsTemplate saves a settings bundle as a named template
+func (s *Service) CreateSettingsTemplate(ctx context.Context, req *connect.Request[templatev1.CreateSettingsTemplateRequest]) (*connect.Response[templatev1.CreateSettingsTemplateResponse], error) {
+	appReq, err := s.protoToCreateSettingsTemplateRequest(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cross-domain orchestration: validate owner exists first
+	_, err = s.userService.GetUser(ctx, connect.NewRequest(&userv1.GetUserRequest{
+		Id: appReq.OwnerID.String(),
+	}))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	template, err := s.app.CreateSettingsTemplate(ctx, appReq)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoTemplate, err := s.settingsTemplateToProto(template)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&templatev1.CreateSettingsTemplateResponse{
+		Template: protoTemplate,
+	}), nil
+}
+
+// GetSettingsTemplate retrieves a template by ID. Private templates are only
+// returned to their owner when the request carries an acting user.
+func (s *Service) GetSettingsTemplate(ctx context.Context, req *connect.Request[templatev1.GetSettingsTemplateRequest]) (*connect.Response[templatev1.GetSettingsTemplateResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.app.GetSettingsTemplate(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if userID, ok := interceptors.ActingUserFromContext(ctx); ok && !template.VisibleTo(userID) {
+		return nil, connect.NewError(connect.CodeNotFound, ErrTemplateNotFound)
+	}
+
+	protoTemplate, err := s.settingsTemplateToProto(template)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&templatev1.GetSettingsTemplateResponse{
+		Template: protoTemplate,
+	}), nil
+}
+
+// ListSettingsTemplates lists public presets, plus the owner's own templates when owner_id is set
+func (s *Service) ListSettingsTemplates(ctx context.Context, req *connect.Request[templatev1.ListSettingsTemplatesRequest]) (*connect.Response[templatev1.ListSettingsTemplatesResponse], error) {
+	filter := ListSettingsTemplatesFilter{
+		SportID: req.Msg.SportId,
+	}
+	if req.Msg.OwnerId != nil {
+		ownerID, err := uuidutil.MustParseOrInvalidArg("owner_id", *req.Msg.OwnerId)
+		if err != nil {
+			return nil, err
+		}
+		// Only the owner may list their private templates
+		if userID, ok := interceptors.ActingUserFromContext(ctx); ok && userID != ownerID {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot list another user's templates"))
+		}
+		filter.OwnerID = &ownerID
+	}
+
+	templates, err := s.app.ListSettingsTemplates(ctx, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoTemplates := make([]*templatev1.SettingsTemplate, len(templates))
+	for i := range templates {
+		protoTemplate, err := s.settingsTemplateToProto(&templates[i])
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		protoTemplates[i] = protoTemplate
+	}
+
+	return connect.NewResponse(&templatev1.ListSettingsTemplatesResponse{
+		Templates: protoTemplates,
+	}), nil
+}
+
+// DeleteSettingsTemplate deletes a template owned by a user
+func (s *Service) DeleteSettingsTemplate(ctx context.Context, req *connect.Request[templatev1.DeleteSettingsTemplateRequest]) (*connect.Response[templatev1.DeleteSettingsTemplateResponse], error) {
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := uuidutil.MustParseOrInvalidArg("owner_id", req.Msg.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID, ok := interceptors.ActingUserFromContext(ctx); ok && userID != ownerID {
+		return nil, connect.NewError(connect.CodePermissionDenied, ErrNotTemplateOwner)
+	}
+
+	err = s.app.DeleteSettingsTemplate(ctx, id, ownerID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrNotTemplateOwner):
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		case errors.Is(err, ErrTemplateNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	return connect.NewResponse(&templatev1.DeleteSettingsTemplateResponse{
+		Success: true,
+	}), nil
+}
+
+// Conversion methods between proto and app layer models
+
+func (s *Service) settingsTemplateToProto(template *models.SettingsTemplate) (*templatev1.SettingsTemplate, error) {
+	leagueSettings, ok := template.LeagueSettings.(map[string]interface{})
+	if !ok {
+		leagueSettings = map[string]interface{}{}
+	}
+	settingsStruct, err := structpb.NewStruct(leagueSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	protoTemplate := &templatev1.SettingsTemplate{
+		Id:             template.ID.String(),
+		Name:           template.Name,
+		Description:    template.Description,
+		SportId:        template.SportID,
+		IsPublic:       template.IsPublic,
+		LeagueSettings: settingsStruct,
+		CreatedAt:      timestamppb.New(template.CreatedAt),
+		UpdatedAt:      timestamppb.New(template.UpdatedAt),
+	}
+	if template.OwnerID != nil {
+		ownerID := template.OwnerID.String()
+		protoTemplate.OwnerId = &ownerID
+	}
+	if template.LeagueType != nil {
+		leagueType := s.leagueTypeToProto(*template.LeagueType)
+		protoTemplate.LeagueType = &leagueType
+	}
+	if template.DraftType != nil {
+		draftType := s.draftTypeToProto(*template.DraftType)
+		protoTemplate.DraftType = &draftType
+	}
+	if template.DraftSettings != nil {
+		protoTemplate.DraftSettings = s.draftSettingsToProto(*template.DraftSettings)
+	}
+
+	return protoTemplate, nil
+}
+
+func (s *Service) protoToCreateSettingsTemplateRequest(proto *templatev1.CreateSettingsTemplateRequest) (CreateSettingsTemplateRequest, error) {
+	ownerID, err := uuidutil.MustParseOrInvalidArg("owner_id", proto.OwnerId)
+	if err != nil {
+		return CreateSettingsTemplateRequest{}, err
+	}
+
+	req := CreateSettingsTemplateRequest{
+		Name:        proto.Name,
+		Description: proto.Description,
+		SportID:     proto.SportId,
+		OwnerID:     ownerID,
+		IsPublic:    proto.IsPublic,
+	}
+	if proto.LeagueType != nil {
+		leagueType := s.protoToLeagueType(*proto.LeagueType)
+		req.LeagueType = &leagueType
+	}
+	if proto.LeagueSettings != nil {
+		req.LeagueSettings = proto.LeagueSettings.AsMap()
+	}
+	if proto.DraftType != nil {
+		draftType := s.protoToDraftType(*proto.DraftType)
+		req.DraftType = &draftType
+	}
+	if proto.DraftSettings != nil {
+		settings := s.protoToDraftSettings(proto.DraftSettings)
+		req.DraftSettings = &settings
+	}
+	return req, nil
+}
+
+// draftSettingsToProto converts template draft settings; templates never carry a draft order
+func (s *Service) draftSettingsToProto(settings models.DraftSettings) *draftv1.DraftSettings {
+	protoSettings := &draftv1.DraftSettings{
+		Rounds:                      int32(settings.Rounds),
+		TimePerPickSec:              int32(settings.TimePerPickSec),
+		DeferPicksOnTimeout:         settings.DeferPicksOnTimeout,
+		SkipPicksWithoutRosterSpace: settings.SkipPicksWithoutRosterSpace,
+		RequireAllTeamsReady:        settings.RequireAllTeamsReady,
+		ProtectedPlayersPerTeam:     int32(settings.ProtectedPlayersPerTeam),
+		MaxPlayersLostPerTeam:       int32(settings.MaxPlayersLostPerTeam),
+	}
+	s.typeSettingsToProto(settings, protoSettings)
+	if len(settings.RoundTimers) > 0 {
+		protoSettings.RoundTimers = make([]*draftv1.RoundTimer, len(settings.RoundTimers))
+		for i, t := range settings.RoundTimers {
+			protoSettings.RoundTimers[i] = &draftv1.RoundTimer{
+				FromRound:      int32(t.FromRound),
+				ToRound:        int32(t.ToRound),
+				TimePerPickSec: int32(t.TimePerPickSec),
+			}
+		}
+	}
+	if len(settings.ClockWarningPercents) > 0 {
+		protoSettings.ClockWarningPercents = make([]int32, len(settings.ClockWarningPercents))
+		for i, percent := range settings.ClockWarningPercents {
+			protoSettings.ClockWarningPercents[i] = int32(percent)
+		}
+	}
+	if settings.PauseWindow != nil {
+		protoSettings.PauseWindow = &draftv1.PauseWindow{
+			Start:    settings.PauseWindow.Start,
+			End:      settings.PauseWindow.End,
+			Timezone: settings.PauseWindow.Timezone,
+		}
+	}
+	if settings.PauseVote != nil {
+		protoSettings.PauseVote = &draftv1.PauseVoteSettings{
+			WindowSec:        int32(settings.PauseVote.WindowSec),
+			ThresholdPercent: int32(settings.PauseVote.ThresholdPercent),
+		}
+	}
+	if settings.CommissionerDisconnectPause != nil {
+		protoSettings.CommissionerDisconnectPause = &draftv1.CommissionerDisconnectPauseSettings{
+			AfterMinutes: int32(settings.CommissionerDisconnectPause.AfterMinutes),
+		}
+	}
+	if settings.LivePickTrades != nil {
+		protoSettings.LivePickTrades = &draftv1.LivePickTradeSettings{
+			NegotiationWindowSec: int32(settings.LivePickTrades.NegotiationWindowSec),
+		}
+	}
+	return protoSettings
+}
+
+func (s *Service) protoToDraftSettings(proto *draftv1.DraftSettings) models.DraftSettings {
+	settings := models.DraftSettings{
+		Rounds:                      int(proto.Rounds),
+		TimePerPickSec:              int(proto.TimePerPickSec),
+		DeferPicksOnTimeout:         proto.DeferPicksOnTimeout,
+		SkipPicksWithoutRosterSpace: proto.SkipPicksWithoutRosterSpace,
+		RequireAllTeamsReady:        proto.RequireAllTeamsReady,
+		ProtectedPlayersPerTeam:     int(proto.ProtectedPlayersPerTeam),
+		MaxPlayersLostPerTeam:       int(proto.MaxPlayersLostPerTeam),
+	}
+	s.protoToTypeSettings(proto, &settings)
+	if len(proto.RoundTimers) > 0 {
+		settings.RoundTimers = make([]models.RoundTimer, len(proto.RoundTimers))
+		for i, t := range proto.RoundTimers {
+			settings.RoundTimers[i] = models.RoundTimer{
+				FromRound:      int(t.FromRound),
+				ToRound:        int(t.ToRound),
+				TimePerPickSec: int(t.TimePerPickSec),
+			}
+		}
+	}
+	if len(proto.ClockWarningPercents) > 0 {
+		settings.ClockWarningPercents = make([]int, len(proto.ClockWarningPercents))
+		for i, percent := range proto.ClockWarningPercents {
+			settings.ClockWarningPercents[i] = int(percent)
+		}
+	}
+	if proto.PauseWindow != nil {
+		settings.PauseWindow = &models.PauseWindow{
+			Start:    proto.PauseWindow.Start,
+			End:      proto.PauseWindow.End,
+			Timezone: proto.PauseWindow.Timezone,
+		}
+	}
+	if proto.PauseVote != nil {
+		settings.PauseVote = &models.PauseVoteSettings{
+			WindowSec:        int(proto.PauseVote.WindowSec),
+			ThresholdPercent: int(proto.PauseVote.ThresholdPercent),
+		}
+	}
+	if proto.CommissionerDisconnectPause != nil {
+		settings.CommissionerDisconnectPause = &models.CommissionerDisconnectPauseSettings{
+			AfterMinutes: int(proto.CommissionerDisconnectPause.AfterMinutes),
+		}
+	}
+	if proto.LivePickTrades != nil {
+		settings.LivePickTrades = &models.LivePickTradeSettings{
+			NegotiationWindowSec: int(proto.LivePickTrades.NegotiationWindowSec),
+		}
+	}
+	return settings
+}
+
+// Enum conversion methods
+
+func (s *Service) leagueTypeToProto(leagueType models.LeagueType) leaguev1.LeagueType {
+	switch leagueType {
+	case models.LeagueTypeRedraft:
+		return leaguev1.LeagueType_LEAGUE_TYPE_REDRAFT
+	case models.LeagueTypeKeeper:
+		return leaguev1.LeagueType_LEAGUE_TYPE_KEEPER
+	case models.LeagueTypeDynasty:
+		return leaguev1.LeagueType_LEAGUE_TYPE_DYNASTY
+	default:
+		return leaguev1.LeagueType_LEAGUE_TYPE_UNSPECIFIED
+	}
+}
+
+func (s *Service) protoToLeagueType(protoType leaguev1.LeagueType) models.LeagueType {
+	switch protoType {
+	case leaguev1.LeagueType_LEAGUE_TYPE_REDRAFT:
+		return models.LeagueTypeRedraft
+	case leaguev1.LeagueType_LEAGUE_TYPE_KEEPER:
+		return models.LeagueTypeKeeper
+	case leaguev1.LeagueType_LEAGUE_TYPE_DYNASTY:
+		return models.LeagueTypeDynasty
+	default:
+		return models.LeagueTypeRedraft // default fallback
+	}
+}
+
+func (s *Service) draftTypeToProto(draftType models.DraftType) draftv1.DraftType {
+	switch draftType {
+	case models.DraftTypeSnake:
+		return draftv1.DraftType_DRAFT_TYPE_SNAKE
+	case models.DraftTypeAuction:
+		return draftv1.DraftType_DRAFT_TYPE_AUCTION
+	case models.DraftTypeRookie:
+		return draftv1.DraftType_DRAFT_TYPE_ROOKIE
+	case models.DraftTypeExpansion:
+		return draftv1.DraftType_DRAFT_TYPE_EXPANSION
+	case models.DraftTypeDispersal:
+		return draftv1.DraftType_DRAFT_TYPE_DISPERSAL
+	default:
+		return draftv1.DraftType_DRAFT_TYPE_UNSPECIFIED
+	}
+}
+
+func (s *Service) protoToDraftType(protoType draftv1.DraftType) models.DraftType {
+	switch protoType {
+	case draftv1.DraftType_DRAFT_TYPE_SNAKE:
+		return models.DraftTypeSnake
+	case draftv1.DraftType_DRAFT_TYPE_AUCTION:
+		return models.DraftTypeAuction
+	case draftv1.DraftType_DRAFT_TYPE_ROOKIE:
+		return models.DraftTypeRookie
+	case draftv1.DraftType_DRAFT_TYPE_EXPANSION:
+		return models.DraftTypeExpansion
+	case draftv1.DraftType_DRAFT_TYPE_DISPERSAL:
+		return models.DraftTypeDispersal
+	default:
+		return models.DraftTypeSnake // default fallback
+	}
+}
+
+// typeSettingsToProto sets the oneof of protoSettings to the settings of the template's draft type
+func (s *Service) typeSettingsToProto(settings models.DraftSettings, protoSettings *draftv1.DraftSettings) {
+	switch {
+	case settings.Snake != nil:
+		protoSettings.TypeSettings = &draftv1.DraftSettings_Snake{Snake: &draftv1.SnakeSettings{
+			OrderMode: s.draftOrderModeToProto(settings.Snake.OrderMode),
+		}}
+	case settings.Auction != nil:
+		protoSettings.TypeSettings = &draftv1.DraftSettings_Auction{Auction: &draftv1.AuctionSettings{
+			BudgetPerTeam:        settings.Auction.BudgetPerTeam,
+			MinBidIncrement:      settings.Auction.MinBidIncrement,
+			TimePerNominationSec: int32(settings.Auction.TimePerNominationSec),
+		}}
+	case settings.Rookie != nil:
+		protoSettings.TypeSettings = &draftv1.DraftSettings_Rookie{Rookie: &draftv1.RookieSettings{
+			OrderMode: s.draftOrderModeToProto(settings.Rookie.OrderMode),
+		}}
+	}
+}
+
+// protoToTypeSettings sets the settings of the template's draft type from the oneof in proto
+func (s *Service) protoToTypeSettings(proto *draftv1.DraftSettings, settings *models.DraftSettings) {
+	switch typeSettings := proto.TypeSettings.(type) {
+	case *draftv1.DraftSettings_Snake:
+		settings.Snake = &models.SnakeSettings{
+			OrderMode: s.protoToDraftOrderMode(typeSettings.Snake.GetOrderMode()),
+		}
+	case *draftv1.DraftSettings_Auction:
+		settings.Auction = &models.AuctionSettings{
+			BudgetPerTeam:        typeSettings.Auction.GetBudgetPerTeam(),
+			MinBidIncrement:      typeSettings.Auction.GetMinBidIncrement(),
+			TimePerNominationSec: int(typeSettings.Auction.GetTimePerNominationSec()),
+		}
+	case *draftv1.DraftSettings_Rookie:
+		settings.Rookie = &models.RookieSettings{
+			OrderMode: s.protoToDraftOrderMode(typeSettings.Rookie.GetOrderMode()),
+		}
+	}
+}
+
+func (s *Service) draftOrderModeToProto(mode models.DraftOrderMode) draftv1.DraftOrderMode {
+	switch mode {
+	case models.DraftOrderModeSnake:
+		return draftv1.DraftOrderMode_DRAFT_ORDER_MODE_SNAKE
+	case models.DraftOrderModeThirdRoundReversal:
+		return draftv1.DraftOrderMode_DRAFT_ORDER_MODE_THIRD_ROUND_REVERSAL
+	case models.DraftOrderModeLinear:
+		return draftv1.DraftOrderMode_DRAFT_ORDER_MODE_LINEAR
+	default:
+		return draftv1.DraftOrderMode_DRAFT_ORDER_MODE_UNSPECIFIED
+	}
+}
+
+func (s *Service) protoToDraftOrderMode(protoMode draftv1.DraftOrderMode) models.DraftOrderMode {
+	switch protoMode {
+	case draftv1.DraftOrderMode_DRAFT_ORDER_MODE_SNAKE:
+		return models.DraftOrderModeSnake
+	case draftv1.DraftOrderMode_DRAFT_ORDER_MODE_THIRD_ROUND_REVERSAL:
+		return models.DraftOrderModeThirdRoundReversal
+	case draftv1.DraftOrderMode_DRAFT_ORDER_MODE_LINEAR:
+		return models.DraftOrderModeLinear
+	default:
+		return "" // unset; snakes
+	}
+}
@@ -0,0 +1,33 @@
+package templates
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// ErrTemplateNotFound is returned when a template does not exist or is not visible to the caller
+var ErrTemplateNotFound = errors.New("settings template not found")
+
+// ErrNotTemplateOwner is returned when a user deletes a template they don't own, including built-in presets
+var ErrNotTemplateOwner = errors.New("only the template owner can delete it")
+
+// CreateSettingsTemplateRequest represents a request to save a settings bundle as a template
+type CreateSettingsTemplateRequest struct {
+	Name           string                `json:"name"`
+	Description    string                `json:"description"`
+	SportID        string                `json:"sport_id"`
+	OwnerID        uuid.UUID             `json:"owner_id"`
+	IsPublic       bool                  `json:"is_public"`
+	LeagueType     *models.LeagueType    `json:"league_type,omitempty"`
+	LeagueSettings interface{}           `json:"league_settings,omitempty"`
+	DraftType      *models.DraftType     `json:"draft_type,omitempty"`
+	DraftSettings  *models.DraftSettings `json:"draft_settings,omitempty"`
+}
+
+// ListSettingsTemplatesFilter narrows the templates returned by ListSettingsTemplates
+type ListSettingsTemplatesFilter struct {
+	SportID *string    `json:"sport_id,omitempty"`
+	OwnerID *uuid.UUID `json:"owner_id,omitempty"` // include this user's private templates
+}
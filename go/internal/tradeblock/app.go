@@ -0,0 +1,160 @@
+package tradeblock
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// TradeBlockRepository defines what the trade block app layer needs from the repository
+type TradeBlockRepository interface {
+	GetRosterEntryOwnerID(ctx context.Context, rosterPlayerID uuid.UUID) (uuid.UUID, error)
+	GetTeamOwnerID(ctx context.Context, fantasyTeamID uuid.UUID) (uuid.UUID, error)
+	AddListing(ctx context.Context, req AddListingRequest) (*models.TradeBlockListing, error)
+	RemoveListing(ctx context.Context, rosterPlayerID uuid.UUID) error
+	ListListings(ctx context.Context, req ListListingsRequest) ([]models.TradeBlockListing, error)
+	AddWishlistPlayer(ctx context.Context, req WishlistPlayerRequest) (*models.WishlistPlayer, error)
+	RemoveWishlistPlayer(ctx context.Context, fantasyTeamID, playerID uuid.UUID) error
+	ListWishlist(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.WishlistPlayer, error)
+}
+
+// App handles trade block business logic
+type App struct {
+	repo TradeBlockRepository
+}
+
+// NewApp creates a new trade block App
+func NewApp(repo TradeBlockRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// AddListing puts a roster player on the block, or updates the note of their listing. The
+// team's owner only.
+func (a *App) AddListing(ctx context.Context, req AddListingRequest) (*models.TradeBlockListing, error) {
+	req.Note = strings.TrimSpace(req.Note)
+	if err := validateNote(req.Note); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := a.checkRosterEntryOwner(ctx, req.RosterPlayerID, req.ListedBy); err != nil {
+		return nil, err
+	}
+
+	listing, err := a.repo.AddListing(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add listing: %w", err)
+	}
+	return listing, nil
+}
+
+// RemoveListing takes a roster player off the block. The team's owner only.
+func (a *App) RemoveListing(ctx context.Context, req RemoveListingRequest) error {
+	if err := a.checkRosterEntryOwner(ctx, req.RosterPlayerID, req.RemovedBy); err != nil {
+		return err
+	}
+
+	if err := a.repo.RemoveListing(ctx, req.RosterPlayerID); err != nil {
+		return fmt.Errorf("failed to remove listing: %w", err)
+	}
+	return nil
+}
+
+// ListListings retrieves the players on a league's trade block, newest listings first
+func (a *App) ListListings(ctx context.Context, req ListListingsRequest) ([]models.TradeBlockListing, error) {
+	listings, err := a.repo.ListListings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list listings: %w", err)
+	}
+	return listings, nil
+}
+
+// AddWishlistPlayer adds a player to a team's wishlist, or updates their note. The team's
+// owner only.
+func (a *App) AddWishlistPlayer(ctx context.Context, req WishlistPlayerRequest) (*models.WishlistPlayer, error) {
+	req.Note = strings.TrimSpace(req.Note)
+	if err := validateNote(req.Note); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := a.checkTeamOwner(ctx, req.FantasyTeamID, req.RequestedBy); err != nil {
+		return nil, err
+	}
+
+	player, err := a.repo.AddWishlistPlayer(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add wishlist player: %w", err)
+	}
+	return player, nil
+}
+
+// RemoveWishlistPlayer takes a player off a team's wishlist. The team's owner only.
+func (a *App) RemoveWishlistPlayer(ctx context.Context, req WishlistPlayerRequest) error {
+	if err := a.checkTeamOwner(ctx, req.FantasyTeamID, req.RequestedBy); err != nil {
+		return err
+	}
+
+	if err := a.repo.RemoveWishlistPlayer(ctx, req.FantasyTeamID, req.PlayerID); err != nil {
+		return fmt.Errorf("failed to remove wishlist player: %w", err)
+	}
+	return nil
+}
+
+// ListWishlist retrieves a team's wishlist. Wishlists are private to the team's owner.
+func (a *App) ListWishlist(ctx context.Context, fantasyTeamID uuid.UUID, requestedBy *uuid.UUID) ([]models.WishlistPlayer, error) {
+	if err := a.checkTeamOwner(ctx, fantasyTeamID, requestedBy); err != nil {
+		return nil, err
+	}
+
+	players, err := a.repo.ListWishlist(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wishlist: %w", err)
+	}
+	return players, nil
+}
+
+// checkRosterEntryOwner returns ErrNotTeamOwner unless the user owns the team a roster entry
+// belongs to. A nil user is a trusted caller.
+func (a *App) checkRosterEntryOwner(ctx context.Context, rosterPlayerID uuid.UUID, userID *uuid.UUID) error {
+	if userID == nil {
+		return nil
+	}
+
+	ownerID, err := a.repo.GetRosterEntryOwnerID(ctx, rosterPlayerID)
+	if err != nil {
+		return err
+	}
+	if *userID != ownerID {
+		return ErrNotTeamOwner
+	}
+	return nil
+}
+
+// checkTeamOwner returns ErrNotTeamOwner unless the user owns the team. A nil user is a
+// trusted caller.
+func (a *App) checkTeamOwner(ctx context.Context, fantasyTeamID uuid.UUID, userID *uuid.UUID) error {
+	if userID == nil {
+		return nil
+	}
+
+	ownerID, err := a.repo.GetTeamOwnerID(ctx, fantasyTeamID)
+	if err != nil {
+		return err
+	}
+	if *userID != ownerID {
+		return ErrNotTeamOwner
+	}
+	return nil
+}
+
+// Validation methods
+
+func validateNote(note string) error {
+	if utf8.RuneCountInString(note) > MaxNoteLen {
+		return fmt.Errorf("%w: must be at most %d characters", ErrInvalidNote, MaxNoteLen)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+-- name: GetRosterEntryForListing :one
+-- A roster entry with the team, league and player names a listing and its notifications show.
+SELECT rp.id, rp.fantasy_team_id, rp.player_id, ft.league_id, ft.owner_id, ft.name AS team_name, p.full_name AS player_name, l.name AS league_name
+FROM roster_players rp
+         JOIN fantasy_teams ft ON ft.id = rp.fantasy_team_id
+         JOIN players p ON p.id = rp.player_id
+         JOIN leagues l ON l.id = ft.league_id
+WHERE rp.id = $1;
+
+-- name: UpsertTradeBlockListing :one
+-- Lists a roster player, or updates the note of their listing. inserted is false for updates.
+INSERT INTO trade_block_listings (roster_player_id, league_id, fantasy_team_id, player_id, note, listed_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (roster_player_id) DO UPDATE SET note = EXCLUDED.note
+RETURNING roster_player_id, league_id, fantasy_team_id, player_id, note, listed_by, created_at, (xmax = 0)::boolean AS inserted;
+
+-- name: DeleteTradeBlockListing :execrows
+DELETE FROM trade_block_listings WHERE roster_player_id = $1;
+
+-- name: ListTradeBlockListings :many
+-- Newest listings first, optionally only one team's.
+SELECT b.roster_player_id, b.league_id, b.fantasy_team_id, ft.name AS team_name, b.player_id, p.full_name AS player_name, b.note, b.listed_by, b.created_at
+FROM trade_block_listings b
+         JOIN fantasy_teams ft ON ft.id = b.fantasy_team_id
+         JOIN players p ON p.id = b.player_id
+WHERE b.league_id = @league_id
+  AND (sqlc.narg('fantasy_team_id')::uuid IS NULL OR b.fantasy_team_id = sqlc.narg('fantasy_team_id')::uuid)
+ORDER BY b.created_at DESC, b.roster_player_id;
+
+-- name: ListWishlistOwnersForPlayer :many
+-- The owners of the league's other teams with the player on their wishlist.
+SELECT w.fantasy_team_id, ft.name AS team_name, u.id AS user_id, u.username, u.email
+FROM trade_wishlist_players w
+         JOIN fantasy_teams ft ON ft.id = w.fantasy_team_id
+         JOIN users u ON u.id = ft.owner_id
+WHERE w.player_id = @player_id
+  AND ft.league_id = @league_id
+  AND ft.id <> @listing_team_id
+  AND u.deleted_at IS NULL
+ORDER BY ft.name;
+
+-- name: InsertUserOutbox :exec
+-- Queue a notification for the notification worker to deliver.
+INSERT INTO user_outbox (id, user_id, event_type, payload)
+VALUES ($1, $2, $3, $4);
@@ -0,0 +1,31 @@
+-- name: GetFantasyTeamOwnerID :one
+SELECT owner_id FROM fantasy_teams WHERE id = $1;
+
+-- name: GetPlayerName :one
+SELECT full_name FROM players WHERE id = $1;
+
+-- name: CountOtherWishlistPlayers :one
+-- How many players besides this one are on the team's wishlist.
+SELECT COUNT(*) FROM trade_wishlist_players WHERE fantasy_team_id = $1 AND player_id <> $2;
+
+-- name: UpsertWishlistPlayer :one
+INSERT INTO trade_wishlist_players (fantasy_team_id, player_id, note)
+VALUES ($1, $2, $3)
+ON CONFLICT (fantasy_team_id, player_id) DO UPDATE SET note = EXCLUDED.note
+RETURNING fantasy_team_id, player_id, note, created_at;
+
+-- name: DeleteWishlistPlayer :execrows
+DELETE FROM trade_wishlist_players WHERE fantasy_team_id = $1 AND player_id = $2;
+
+-- name: ListWishlistPlayers :many
+-- The team's wishlist in the order players were added, with the listing of any player another
+-- team in the league has on the block.
+SELECT w.fantasy_team_id, w.player_id, p.full_name AS player_name, w.note, w.created_at,
+       b.roster_player_id AS on_block_roster_player_id, b.fantasy_team_id AS on_block_fantasy_team_id
+FROM trade_wishlist_players w
+         JOIN fantasy_teams ft ON ft.id = w.fantasy_team_id
+         JOIN players p ON p.id = w.player_id
+         LEFT JOIN trade_block_listings b
+                   ON b.player_id = w.player_id AND b.league_id = ft.league_id AND b.fantasy_team_id <> w.fantasy_team_id
+WHERE w.fantasy_team_id = $1
+ORDER BY w.created_at, w.player_id;
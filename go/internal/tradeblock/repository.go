@@ -0,0 +1,284 @@
+package tradeblock
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/ids"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/mcdev12/dynasty/go/internal/tradeblock/db"
+	userevents "github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	DeleteTradeBlockListing(ctx context.Context, rosterPlayerID uuid.UUID) (int64, error)
+	DeleteWishlistPlayer(ctx context.Context, arg db.DeleteWishlistPlayerParams) (int64, error)
+	GetFantasyTeamOwnerID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	GetRosterEntryForListing(ctx context.Context, id uuid.UUID) (db.GetRosterEntryForListingRow, error)
+	ListTradeBlockListings(ctx context.Context, arg db.ListTradeBlockListingsParams) ([]db.ListTradeBlockListingsRow, error)
+	ListWishlistPlayers(ctx context.Context, fantasyTeamID uuid.UUID) ([]db.ListWishlistPlayersRow, error)
+}
+
+// Repository implements trade block data access operations
+type Repository struct {
+	queries Querier
+	sqlDB   *sql.DB
+}
+
+// NewRepository creates a new trade block repository. sqlDB lists players in a transaction
+// with the notifications for the wishlists they are on.
+func NewRepository(querier Querier, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: querier,
+		sqlDB:   sqlDB,
+	}
+}
+
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
+// GetRosterEntryOwnerID retrieves the owner of the team a roster entry belongs to
+func (r *Repository) GetRosterEntryOwnerID(ctx context.Context, rosterPlayerID uuid.UUID) (uuid.UUID, error) {
+	entry, err := r.queries.GetRosterEntryForListing(ctx, rosterPlayerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, ErrRosterEntryNotFound
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get roster entry: %w", err)
+	}
+	return entry.OwnerID, nil
+}
+
+// GetTeamOwnerID retrieves the owner of a fantasy team
+func (r *Repository) GetTeamOwnerID(ctx context.Context, fantasyTeamID uuid.UUID) (uuid.UUID, error) {
+	ownerID, err := r.queries.GetFantasyTeamOwnerID(ctx, fantasyTeamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, ErrTeamNotFound
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get fantasy team: %w", err)
+	}
+	return ownerID, nil
+}
+
+// AddListing puts a roster player on the block, or updates the note of their listing. A new
+// listing queues notifications for the owners of the league's other teams with the player on
+// their wishlist, in the same transaction.
+func (r *Repository) AddListing(ctx context.Context, req AddListingRequest) (*models.TradeBlockListing, error) {
+	var listing *models.TradeBlockListing
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		entry, err := q.GetRosterEntryForListing(ctx, req.RosterPlayerID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRosterEntryNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get roster entry: %w", err)
+		}
+
+		row, err := q.UpsertTradeBlockListing(ctx, db.UpsertTradeBlockListingParams{
+			RosterPlayerID: entry.ID,
+			LeagueID:       entry.LeagueID,
+			FantasyTeamID:  entry.FantasyTeamID,
+			PlayerID:       entry.PlayerID,
+			Note:           req.Note,
+			ListedBy:       sqlutil.ToNullUUID(req.ListedBy),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upsert trade block listing: %w", err)
+		}
+		listing = &models.TradeBlockListing{
+			RosterPlayerID:  row.RosterPlayerID,
+			LeagueID:        row.LeagueID,
+			FantasyTeamID:   row.FantasyTeamID,
+			FantasyTeamName: entry.TeamName,
+			PlayerID:        row.PlayerID,
+			PlayerName:      entry.PlayerName,
+			Note:            row.Note,
+			ListedBy:        sqlutil.FromNullUUID(row.ListedBy),
+			CreatedAt:       row.CreatedAt,
+		}
+
+		// Editing a listing's note doesn't notify anyone again
+		if !row.Inserted {
+			return nil
+		}
+		return r.notifyWishlistOwners(ctx, q, entry, row.Note)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return listing, nil
+}
+
+// notifyWishlistOwners queues a WishlistPlayerOnBlock notification for the owner of every other
+// team in the league with the listed player on its wishlist
+func (r *Repository) notifyWishlistOwners(ctx context.Context, q *db.Queries, entry db.GetRosterEntryForListingRow, note string) error {
+	owners, err := q.ListWishlistOwnersForPlayer(ctx, db.ListWishlistOwnersForPlayerParams{
+		PlayerID:      entry.PlayerID,
+		LeagueID:      entry.LeagueID,
+		ListingTeamID: entry.FantasyTeamID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list wishlist owners: %w", err)
+	}
+
+	for _, owner := range owners {
+		payload, err := json.Marshal(userevents.WishlistPlayerOnBlockPayload{
+			UserID:          owner.UserID.String(),
+			Username:        owner.Username,
+			Email:           owner.Email,
+			LeagueID:        entry.LeagueID.String(),
+			LeagueName:      entry.LeagueName,
+			TeamName:        owner.TeamName,
+			PlayerID:        entry.PlayerID.String(),
+			PlayerName:      entry.PlayerName,
+			ListingTeamID:   entry.FantasyTeamID.String(),
+			ListingTeamName: entry.TeamName,
+			Note:            note,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal WishlistPlayerOnBlock notification: %w", err)
+		}
+
+		if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+			ID:        ids.New(),
+			UserID:    owner.UserID,
+			EventType: userevents.WishlistPlayerOnBlock,
+			Payload:   payload,
+		}); err != nil {
+			return fmt.Errorf("failed to queue WishlistPlayerOnBlock notification: %w", err)
+		}
+	}
+	return nil
+}
+
+// RemoveListing takes a roster player off the block
+func (r *Repository) RemoveListing(ctx context.Context, rosterPlayerID uuid.UUID) error {
+	n, err := r.queries.DeleteTradeBlockListing(ctx, rosterPlayerID)
+	if err != nil {
+		return fmt.Errorf("failed to delete trade block listing: %w", err)
+	}
+	if n == 0 {
+		return ErrListingNotFound
+	}
+	return nil
+}
+
+// ListListings retrieves a league's listings, newest first
+func (r *Repository) ListListings(ctx context.Context, req ListListingsRequest) ([]models.TradeBlockListing, error) {
+	rows, err := r.queries.ListTradeBlockListings(ctx, db.ListTradeBlockListingsParams{
+		LeagueID:      req.LeagueID,
+		FantasyTeamID: sqlutil.ToNullUUID(req.FantasyTeamID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trade block listings: %w", err)
+	}
+
+	result := make([]models.TradeBlockListing, len(rows))
+	for i, row := range rows {
+		result[i] = models.TradeBlockListing{
+			RosterPlayerID:  row.RosterPlayerID,
+			LeagueID:        row.LeagueID,
+			FantasyTeamID:   row.FantasyTeamID,
+			FantasyTeamName: row.TeamName,
+			PlayerID:        row.PlayerID,
+			PlayerName:      row.PlayerName,
+			Note:            row.Note,
+			ListedBy:        sqlutil.FromNullUUID(row.ListedBy),
+			CreatedAt:       row.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// AddWishlistPlayer adds a player to a team's wishlist, or updates their note. The team's
+// wishlist is locked while it is checked against MaxWishlistPlayers.
+func (r *Repository) AddWishlistPlayer(ctx context.Context, req WishlistPlayerRequest) (*models.WishlistPlayer, error) {
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassTradeWishlist, req.FantasyTeamID, txQueries, func(q *db.Queries) error {
+		if _, err := q.GetPlayerName(ctx, req.PlayerID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrPlayerNotFound
+			}
+			return fmt.Errorf("failed to get player: %w", err)
+		}
+
+		others, err := q.CountOtherWishlistPlayers(ctx, db.CountOtherWishlistPlayersParams{
+			FantasyTeamID: req.FantasyTeamID,
+			PlayerID:      req.PlayerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to count wishlist players: %w", err)
+		}
+		if others >= MaxWishlistPlayers {
+			return fmt.Errorf("%w: at most %d players", ErrWishlistFull, MaxWishlistPlayers)
+		}
+
+		if _, err := q.UpsertWishlistPlayer(ctx, db.UpsertWishlistPlayerParams{
+			FantasyTeamID: req.FantasyTeamID,
+			PlayerID:      req.PlayerID,
+			Note:          req.Note,
+		}); err != nil {
+			return fmt.Errorf("failed to upsert wishlist player: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the player back through the wishlist for their name and any listing of them
+	players, err := r.ListWishlist(ctx, req.FantasyTeamID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range players {
+		if players[i].PlayerID == req.PlayerID {
+			return &players[i], nil
+		}
+	}
+	return nil, ErrWishlistPlayerNotFound
+}
+
+// RemoveWishlistPlayer takes a player off a team's wishlist
+func (r *Repository) RemoveWishlistPlayer(ctx context.Context, fantasyTeamID, playerID uuid.UUID) error {
+	n, err := r.queries.DeleteWishlistPlayer(ctx, db.DeleteWishlistPlayerParams{
+		FantasyTeamID: fantasyTeamID,
+		PlayerID:      playerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete wishlist player: %w", err)
+	}
+	if n == 0 {
+		return ErrWishlistPlayerNotFound
+	}
+	return nil
+}
+
+// ListWishlist retrieves a team's wishlist in the order players were added
+func (r *Repository) ListWishlist(ctx context.Context, fantasyTeamID uuid.UUID) ([]models.WishlistPlayer, error) {
+	rows, err := r.queries.ListWishlistPlayers(ctx, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wishlist players: %w", err)
+	}
+
+	result := make([]models.WishlistPlayer, len(rows))
+	for i, row := range rows {
+		result[i] = models.WishlistPlayer{
+			FantasyTeamID:         row.FantasyTeamID,
+			PlayerID:              row.PlayerID,
+			PlayerName:            row.PlayerName,
+			Note:                  row.Note,
+			CreatedAt:             row.CreatedAt,
+			OnBlockRosterPlayerID: sqlutil.FromNullUUID(row.OnBlockRosterPlayerID),
+			OnBlockFantasyTeamID:  sqlutil.FromNullUUID(row.OnBlockFantasyTeamID),
+		}
+	}
+	return result, nil
+}
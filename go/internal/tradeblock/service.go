@@ -0,0 +1,239 @@
+package tradeblock
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	tradeblockv1 "github.com/mcdev12/dynasty/go/internal/genproto/tradeblock/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/tradeblock/v1/tradeblockv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TradeBlockApp defines what the service layer needs from the trade block application
+type TradeBlockApp interface {
+	AddListing(ctx context.Context, req AddListingRequest) (*models.TradeBlockListing, error)
+	RemoveListing(ctx context.Context, req RemoveListingRequest) error
+	ListListings(ctx context.Context, req ListListingsRequest) ([]models.TradeBlockListing, error)
+	AddWishlistPlayer(ctx context.Context, req WishlistPlayerRequest) (*models.WishlistPlayer, error)
+	RemoveWishlistPlayer(ctx context.Context, req WishlistPlayerRequest) error
+	ListWishlist(ctx context.Context, fantasyTeamID uuid.UUID, requestedBy *uuid.UUID) ([]models.WishlistPlayer, error)
+}
+
+// Service implements the TradeBlockService gRPC interface. Requests without a signed in user
+// are trusted callers.
+type Service struct {
+	app TradeBlockApp
+}
+
+// NewService creates a new trade block gRPC service
+func NewService(app TradeBlockApp) *Service {
+	return &Service{
+		app: app,
+	}
+}
+
+// Verify that Service implements the TradeBlockServiceHandler interface
+var _ tradeblockv1connect.TradeBlockServiceHandler = (*Service)(nil)
+
+// AddToTradeBlock puts a roster player on the block, or updates the listing's note
+func (s *Service) AddToTradeBlock(ctx context.Context, req *connect.Request[tradeblockv1.AddToTradeBlockRequest]) (*connect.Response[tradeblockv1.AddToTradeBlockResponse], error) {
+	rosterPlayerID, err := uuidutil.MustParseOrInvalidArg("roster_player_id", req.Msg.RosterPlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	listing, err := s.app.AddListing(ctx, AddListingRequest{
+		RosterPlayerID: rosterPlayerID,
+		Note:           req.Msg.Note,
+		ListedBy:       interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&tradeblockv1.AddToTradeBlockResponse{
+		Listing: s.listingToProto(listing),
+	}), nil
+}
+
+// RemoveFromTradeBlock takes a roster player off the block
+func (s *Service) RemoveFromTradeBlock(ctx context.Context, req *connect.Request[tradeblockv1.RemoveFromTradeBlockRequest]) (*connect.Response[tradeblockv1.RemoveFromTradeBlockResponse], error) {
+	rosterPlayerID, err := uuidutil.MustParseOrInvalidArg("roster_player_id", req.Msg.RosterPlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.app.RemoveListing(ctx, RemoveListingRequest{
+		RosterPlayerID: rosterPlayerID,
+		RemovedBy:      interceptors.ActingUserPtr(ctx),
+	}); err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&tradeblockv1.RemoveFromTradeBlockResponse{
+		Success: true,
+	}), nil
+}
+
+// ListTradeBlock lists the players on a league's trade block
+func (s *Service) ListTradeBlock(ctx context.Context, req *connect.Request[tradeblockv1.ListTradeBlockRequest]) (*connect.Response[tradeblockv1.ListTradeBlockResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	listReq := ListListingsRequest{LeagueID: leagueID}
+	listReq.FantasyTeamID, err = uuidutil.ParseOptional("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	listings, err := s.app.ListListings(ctx, listReq)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	protoListings := make([]*tradeblockv1.TradeBlockListing, len(listings))
+	for i := range listings {
+		protoListings[i] = s.listingToProto(&listings[i])
+	}
+
+	return connect.NewResponse(&tradeblockv1.ListTradeBlockResponse{
+		Listings: protoListings,
+	}), nil
+}
+
+// AddWishlistPlayer adds a player to a team's wishlist, or updates its note
+func (s *Service) AddWishlistPlayer(ctx context.Context, req *connect.Request[tradeblockv1.AddWishlistPlayerRequest]) (*connect.Response[tradeblockv1.AddWishlistPlayerResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	playerID, err := uuidutil.MustParseOrInvalidArg("player_id", req.Msg.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := s.app.AddWishlistPlayer(ctx, WishlistPlayerRequest{
+		FantasyTeamID: fantasyTeamID,
+		PlayerID:      playerID,
+		Note:          req.Msg.Note,
+		RequestedBy:   interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&tradeblockv1.AddWishlistPlayerResponse{
+		Player: s.wishlistPlayerToProto(player),
+	}), nil
+}
+
+// RemoveWishlistPlayer takes a player off a team's wishlist
+func (s *Service) RemoveWishlistPlayer(ctx context.Context, req *connect.Request[tradeblockv1.RemoveWishlistPlayerRequest]) (*connect.Response[tradeblockv1.RemoveWishlistPlayerResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	playerID, err := uuidutil.MustParseOrInvalidArg("player_id", req.Msg.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.app.RemoveWishlistPlayer(ctx, WishlistPlayerRequest{
+		FantasyTeamID: fantasyTeamID,
+		PlayerID:      playerID,
+		RequestedBy:   interceptors.ActingUserPtr(ctx),
+	}); err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&tradeblockv1.RemoveWishlistPlayerResponse{
+		Success: true,
+	}), nil
+}
+
+// ListWishlist lists a team's wishlist
+func (s *Service) ListWishlist(ctx context.Context, req *connect.Request[tradeblockv1.ListWishlistRequest]) (*connect.Response[tradeblockv1.ListWishlistResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	players, err := s.app.ListWishlist(ctx, fantasyTeamID, interceptors.ActingUserPtr(ctx))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	protoPlayers := make([]*tradeblockv1.WishlistPlayer, len(players))
+	for i := range players {
+		protoPlayers[i] = s.wishlistPlayerToProto(&players[i])
+	}
+
+	return connect.NewResponse(&tradeblockv1.ListWishlistResponse{
+		Players: protoPlayers,
+	}), nil
+}
+
+// toConnectError maps app errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidNote):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrRosterEntryNotFound), errors.Is(err, ErrListingNotFound), errors.Is(err, ErrTeamNotFound),
+		errors.Is(err, ErrPlayerNotFound), errors.Is(err, ErrWishlistPlayerNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrWishlistFull):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case errors.Is(err, ErrNotTeamOwner):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// Conversion methods
+
+// listingToProto converts a domain listing to proto
+func (s *Service) listingToProto(listing *models.TradeBlockListing) *tradeblockv1.TradeBlockListing {
+	protoListing := &tradeblockv1.TradeBlockListing{
+		RosterPlayerId:  listing.RosterPlayerID.String(),
+		LeagueId:        listing.LeagueID.String(),
+		FantasyTeamId:   listing.FantasyTeamID.String(),
+		FantasyTeamName: listing.FantasyTeamName,
+		PlayerId:        listing.PlayerID.String(),
+		PlayerName:      listing.PlayerName,
+		Note:            listing.Note,
+		CreatedAt:       timestamppb.New(listing.CreatedAt),
+	}
+	if listing.ListedBy != nil {
+		listedBy := listing.ListedBy.String()
+		protoListing.ListedBy = &listedBy
+	}
+	return protoListing
+}
+
+// wishlistPlayerToProto converts a domain wishlist player to proto
+func (s *Service) wishlistPlayerToProto(player *models.WishlistPlayer) *tradeblockv1.WishlistPlayer {
+	protoPlayer := &tradeblockv1.WishlistPlayer{
+		FantasyTeamId: player.FantasyTeamID.String(),
+		PlayerId:      player.PlayerID.String(),
+		PlayerName:    player.PlayerName,
+		Note:          player.Note,
+		CreatedAt:     timestamppb.New(player.CreatedAt),
+	}
+	if player.OnBlockRosterPlayerID != nil {
+		rosterPlayerID := player.OnBlockRosterPlayerID.String()
+		protoPlayer.OnBlockRosterPlayerId = &rosterPlayerID
+	}
+	if player.OnBlockFantasyTeamID != nil {
+		fantasyTeamID := player.OnBlockFantasyTeamID.String()
+		protoPlayer.OnBlockFantasyTeamId = &fantasyTeamID
+	}
+	return protoPlayer
+}
@@ -0,0 +1,60 @@
+package tradeblock
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrRosterEntryNotFound is returned when a roster entry does not exist
+	ErrRosterEntryNotFound = errors.New("roster entry not found")
+	// ErrListingNotFound is returned when a roster player is not on the block
+	ErrListingNotFound = errors.New("player is not on the trade block")
+	// ErrTeamNotFound is returned when a fantasy team does not exist
+	ErrTeamNotFound = errors.New("fantasy team not found")
+	// ErrPlayerNotFound is returned when a player does not exist
+	ErrPlayerNotFound = errors.New("player not found")
+	// ErrWishlistPlayerNotFound is returned when a player is not on a team's wishlist
+	ErrWishlistPlayerNotFound = errors.New("player is not on the wishlist")
+	// ErrWishlistFull is returned when a team's wishlist already has MaxWishlistPlayers players
+	ErrWishlistFull = errors.New("wishlist is full")
+	// ErrInvalidNote is returned for a note that is too long
+	ErrInvalidNote = errors.New("invalid note")
+	// ErrNotTeamOwner is returned when someone other than a team's owner manages its block or wishlist
+	ErrNotTeamOwner = errors.New("only the team's owner can do this")
+)
+
+const (
+	// MaxNoteLen is the longest listing or wishlist note, in characters
+	MaxNoteLen = 280
+	// MaxWishlistPlayers caps how many players a team's wishlist holds
+	MaxWishlistPlayers = 50
+)
+
+// AddListingRequest puts a roster player on the block
+type AddListingRequest struct {
+	RosterPlayerID uuid.UUID  `json:"roster_player_id"`
+	Note           string     `json:"note,omitempty"`
+	ListedBy       *uuid.UUID `json:"listed_by,omitempty"` // nil for trusted callers, who may list any player
+}
+
+// RemoveListingRequest takes a roster player off the block
+type RemoveListingRequest struct {
+	RosterPlayerID uuid.UUID  `json:"roster_player_id"`
+	RemovedBy      *uuid.UUID `json:"removed_by,omitempty"` // nil for trusted callers
+}
+
+// ListListingsRequest selects a league's listings
+type ListListingsRequest struct {
+	LeagueID      uuid.UUID  `json:"league_id"`
+	FantasyTeamID *uuid.UUID `json:"fantasy_team_id,omitempty"` // nil returns every team's
+}
+
+// WishlistPlayerRequest adds a player to a team's wishlist, or takes them off it
+type WishlistPlayerRequest struct {
+	FantasyTeamID uuid.UUID  `json:"fantasy_team_id"`
+	PlayerID      uuid.UUID  `json:"player_id"`
+	Note          string     `json:"note,omitempty"`         // ignored on removal
+	RequestedBy   *uuid.UUID `json:"requested_by,omitempty"` // nil for trusted callers
+}
@@ -0,0 +1,158 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
+	userevents "github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+// TransactionRepository defines what the transaction log app layer needs from the repository
+type TransactionRepository interface {
+	ListLeagueTransactions(ctx context.Context, query ListTransactionsQuery) ([]models.LeagueTransaction, error)
+	GetDraftLeagueID(ctx context.Context, draftID uuid.UUID) (uuid.UUID, error)
+	IsSandboxDraft(ctx context.Context, draftID uuid.UUID) (bool, error)
+	RecordTransaction(ctx context.Context, sourceEventID uuid.UUID, txn models.LeagueTransaction) (bool, error)
+	ProjectRosterOutbox(ctx context.Context, limit int32, project func(RosterEvent) (*models.LeagueTransaction, error)) (int, error)
+	ListDigestRecipients(ctx context.Context, frequency models.DigestFrequency) ([]DigestRecipient, error)
+	ListDigestTransactions(ctx context.Context, leagueID uuid.UUID, periodStart, periodEnd time.Time) ([]userevents.LeagueDigestTransaction, error)
+	QueueDigest(ctx context.Context, userID uuid.UUID, digest userevents.LeagueDigestPayload) (bool, error)
+}
+
+// App handles league transaction log business logic
+type App struct {
+	repo TransactionRepository
+}
+
+// NewApp creates a new transaction log App
+func NewApp(repo TransactionRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// ListLeagueTransactions retrieves a page of a league's transactions, newest first
+func (a *App) ListLeagueTransactions(ctx context.Context, req ListTransactionsRequest) (*TransactionPage, error) {
+	if err := a.validateListTransactionsRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	after, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize, err := pageSizes.Resolve(req.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Fetch one extra transaction to tell whether another page follows
+	txns, err := a.repo.ListLeagueTransactions(ctx, ListTransactionsQuery{
+		LeagueID:      req.LeagueID,
+		Types:         req.Types,
+		FantasyTeamID: req.FantasyTeamID,
+		SeasonID:      req.SeasonID,
+		After:         after,
+		Limit:         int32(pageSize + 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list league transactions: %w", err)
+	}
+
+	page := &TransactionPage{}
+	var more bool
+	page.Transactions, more = pagination.Trim(txns, pageSize)
+	if more {
+		last := page.Transactions[pageSize-1]
+		page.NextPageToken, err = pagination.EncodeToken(PageCursor{OccurredAt: last.OccurredAt, ID: last.ID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// ProjectRosterOutbox records the transactions described by a batch of roster outbox events
+// and returns how many were added to the log
+func (a *App) ProjectRosterOutbox(ctx context.Context, limit int32) (int, error) {
+	if limit <= 0 {
+		return 0, fmt.Errorf("limit must be greater than 0")
+	}
+
+	recorded, err := a.repo.ProjectRosterOutbox(ctx, limit, func(event RosterEvent) (*models.LeagueTransaction, error) {
+		txn, err := transactionFromRosterEvent(event)
+		if err != nil {
+			log.Printf("Failed to project roster event %s (%s): %v", event.ID, event.EventType, err)
+		}
+		return txn, err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to project roster outbox: %w", err)
+	}
+	return recorded, nil
+}
+
+// RecordDraftEvent records the transaction described by a draft event. It returns false
+// without error for events that aren't logged, come from sandbox drafts or have already been
+// recorded.
+func (a *App) RecordDraftEvent(ctx context.Context, event DraftEvent) (bool, error) {
+	if !isLoggedDraftEvent(event.EventType) {
+		return false, nil
+	}
+
+	// A sandbox draft's picks are a rehearsal and never happened in its league
+	sandbox, err := a.repo.IsSandboxDraft(ctx, event.DraftID)
+	if err != nil || sandbox {
+		return false, err
+	}
+
+	leagueID, err := a.repo.GetDraftLeagueID(ctx, event.DraftID)
+	if err != nil {
+		return false, err
+	}
+
+	txn, err := transactionFromDraftEvent(event, leagueID)
+	if err != nil || txn == nil {
+		return false, err
+	}
+
+	recorded, err := a.repo.RecordTransaction(ctx, event.EventID, *txn)
+	if err != nil {
+		return false, fmt.Errorf("failed to record %s transaction: %w", event.EventType, err)
+	}
+
+	if recorded {
+		log.Printf("Recorded %s transaction for team %s in league %s", txn.Type, txn.FantasyTeamID, leagueID)
+	}
+	return recorded, nil
+}
+
+// Validation methods
+
+// validateListTransactionsRequest validates list transactions request
+func (a *App) validateListTransactionsRequest(req ListTransactionsRequest) error {
+	if req.LeagueID == uuid.Nil {
+		return fmt.Errorf("league_id is required")
+	}
+	if req.FantasyTeamID != nil && *req.FantasyTeamID == uuid.Nil {
+		return fmt.Errorf("fantasy_team_id cannot be empty")
+	}
+	if req.SeasonID != nil && *req.SeasonID == uuid.Nil {
+		return fmt.Errorf("season_id cannot be empty")
+	}
+	for _, txnType := range req.Types {
+		switch txnType {
+		case models.TransactionTypeDrafted, models.TransactionTypeAdded, models.TransactionTypeDropped,
+			models.TransactionTypeTraded, models.TransactionTypeWaiverClaim, models.TransactionTypeKeeperDesignated:
+		default:
+			return fmt.Errorf("%w: %s", ErrInvalidTransactionType, txnType)
+		}
+	}
+	return nil
+}
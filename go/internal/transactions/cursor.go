@@ -0,0 +1,20 @@
+package transactions
+
+import (
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
+)
+
+// decodePageToken returns the cursor of a page token. An empty token starts from the newest
+// transaction.
+func decodePageToken(token string) (*PageCursor, error) {
+	var cursor PageCursor
+	ok, err := pagination.DecodeToken(token, &cursor)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if cursor.ID == uuid.Nil || cursor.OccurredAt.IsZero() {
+		return nil, ErrInvalidPageToken
+	}
+	return &cursor, nil
+}
@@ -0,0 +1,45 @@
+-- name: ListDigestRecipients :many
+-- Every owner of a team in a league that hasn't finished whose digest frequency is the one
+-- given, with each such league they have a team in. Users who haven't chosen a frequency get
+-- the weekly digest.
+SELECT DISTINCT u.id AS user_id,
+                u.username,
+                u.email,
+                l.id AS league_id,
+                l.name AS league_name
+FROM users u
+JOIN fantasy_teams ft ON ft.owner_id = u.id
+JOIN leagues l ON l.id = ft.league_id
+LEFT JOIN user_digest_preferences udp ON udp.user_id = u.id
+WHERE COALESCE(udp.frequency, 'WEEKLY') = @frequency::text
+  AND l.status IN ('PENDING', 'ACTIVE')
+  AND l.deleted_at IS NULL
+  AND u.deleted_at IS NULL
+ORDER BY u.id, l.name, l.id;
+
+-- name: ListDigestTransactions :many
+-- A league's transactions in a digest period, oldest first, with the names a digest shows
+SELECT lt.transaction_type,
+       ft.name AS team_name,
+       ct.name AS counterparty_team_name,
+       p.full_name AS player_name,
+       lt.occurred_at
+FROM league_transactions lt
+JOIN fantasy_teams ft ON ft.id = lt.fantasy_team_id
+LEFT JOIN fantasy_teams ct ON ct.id = lt.counterparty_team_id
+LEFT JOIN players p ON p.id = lt.player_id
+WHERE lt.league_id = @league_id
+  AND lt.occurred_at >= @period_start
+  AND lt.occurred_at < @period_end
+ORDER BY lt.occurred_at, lt.id;
+
+-- name: InsertUserDigest :execrows
+-- Record that a user is being sent the digest of a period; a no-op if they already were.
+INSERT INTO user_digests (user_id, period_end, frequency, transactions)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, period_end) DO NOTHING;
+
+-- name: InsertUserOutbox :exec
+-- Queue a notification for the notification worker to deliver.
+INSERT INTO user_outbox (id, user_id, event_type, payload)
+VALUES ($1, $2, $3, $4);
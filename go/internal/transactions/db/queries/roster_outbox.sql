@@ -0,0 +1,14 @@
+-- name: FetchUnsentRosterOutbox :many
+SELECT id, league_id, fantasy_team_id, event_type, payload, created_at
+FROM roster_outbox
+WHERE sent_at IS NULL
+ORDER BY created_at, id
+LIMIT $1
+    FOR UPDATE SKIP LOCKED;
+
+-- name: MarkRosterOutboxSent :exec
+-- created_at narrows the update to the event's partition
+UPDATE roster_outbox
+SET sent_at = NOW()
+WHERE id = $1
+  AND created_at = $2;
@@ -0,0 +1,44 @@
+-- name: InsertLeagueTransaction :execrows
+-- A no-op when the source event has already been projected. A transaction belongs to the season
+-- of its draft, or else its league's active season.
+INSERT INTO league_transactions (
+    league_id,
+    transaction_type,
+    fantasy_team_id,
+    counterparty_team_id,
+    player_id,
+    draft_id,
+    pick_id,
+    details,
+    source_event_id,
+    occurred_at,
+    season_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
+    COALESCE((SELECT d.season_id FROM draft d WHERE d.id = $6),
+             (SELECT s.id FROM league_seasons s WHERE s.league_id = $1 AND s.status = 'ACTIVE'))
+)
+ON CONFLICT (source_event_id) DO NOTHING;
+
+-- name: ListLeagueTransactions :many
+-- Newest first, continuing after the (occurred_at, id) cursor when one is given. A team filter
+-- matches either side of a trade.
+SELECT id, league_id, transaction_type, fantasy_team_id, counterparty_team_id, player_id, draft_id, pick_id, details, source_event_id, occurred_at, created_at, season_id
+FROM league_transactions
+WHERE league_id = @league_id
+  AND (cardinality(@transaction_types::text[]) = 0 OR transaction_type = ANY(@transaction_types::text[]))
+  AND (sqlc.narg('fantasy_team_id')::uuid IS NULL
+       OR fantasy_team_id = sqlc.narg('fantasy_team_id')::uuid
+       OR counterparty_team_id = sqlc.narg('fantasy_team_id')::uuid)
+  AND (sqlc.narg('season_id')::uuid IS NULL OR season_id = sqlc.narg('season_id')::uuid)
+  AND (sqlc.narg('cursor_occurred_at')::timestamptz IS NULL
+       OR (occurred_at, id) < (sqlc.narg('cursor_occurred_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY occurred_at DESC, id DESC
+LIMIT @page_size;
+
+-- name: GetDraftLeagueID :one
+SELECT league_id FROM draft WHERE id = $1;
+
+-- name: IsSandboxDraft :one
+-- Whether a draft is a sandbox rehearsing another draft; its picks and trades aren't logged.
+SELECT sandbox_of_draft_id IS NOT NULL AS sandbox FROM draft WHERE id = $1;
@@ -0,0 +1,85 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	userevents "github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+// maxDigestTransactions caps how many of a league's transactions one digest lists; the
+// earlier ones are only counted
+const maxDigestTransactions = 25
+
+// SendDigests queues a digest of the activity in their leagues over the period ending at
+// periodEnd to every user sent digests at frequency. Users whose leagues were quiet aren't sent
+// one. Each user is sent a period's digest once however often it runs, and a user whose digest
+// can't be queued is logged and skipped so they don't hold up the rest. It returns the number
+// of digests queued.
+func (a *App) SendDigests(ctx context.Context, frequency models.DigestFrequency, periodEnd time.Time) (int, error) {
+	period := frequency.Period()
+	if period == 0 {
+		return 0, fmt.Errorf("no digests are sent at frequency %q", frequency)
+	}
+	periodStart := periodEnd.Add(-period)
+
+	recipients, err := a.repo.ListDigestRecipients(ctx, frequency)
+	if err != nil {
+		return 0, err
+	}
+
+	// Most leagues are in several users' digests, so each league's activity is read once
+	activity := make(map[uuid.UUID][]userevents.LeagueDigestTransaction)
+	queued := 0
+	for _, recipient := range recipients {
+		digest := userevents.LeagueDigestPayload{
+			UserID:      recipient.UserID.String(),
+			Username:    recipient.Username,
+			Email:       recipient.Email,
+			Frequency:   string(frequency),
+			PeriodStart: periodStart,
+			PeriodEnd:   periodEnd,
+		}
+		for _, league := range recipient.Leagues {
+			txns, ok := activity[league.ID]
+			if !ok {
+				txns, err = a.repo.ListDigestTransactions(ctx, league.ID, periodStart, periodEnd)
+				if err != nil {
+					return queued, err
+				}
+				activity[league.ID] = txns
+			}
+			if len(txns) == 0 {
+				continue
+			}
+
+			entry := userevents.LeagueDigestLeague{
+				LeagueID:     league.ID.String(),
+				LeagueName:   league.Name,
+				Transactions: txns,
+			}
+			if len(txns) > maxDigestTransactions {
+				entry.Transactions = txns[len(txns)-maxDigestTransactions:]
+				entry.MoreTransactions = len(txns) - maxDigestTransactions
+			}
+			digest.Leagues = append(digest.Leagues, entry)
+		}
+		if len(digest.Leagues) == 0 {
+			continue
+		}
+
+		sent, err := a.repo.QueueDigest(ctx, recipient.UserID, digest)
+		if err != nil {
+			log.Printf("Failed to queue %s digest for user %s: %v", frequency, recipient.UserID, err)
+			continue
+		}
+		if sent {
+			queued++
+		}
+	}
+	return queued, nil
+}
@@ -0,0 +1,196 @@
+package transactions
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	draftevents "github.com/mcdev12/dynasty/go/internal/draft/events"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	rosterevents "github.com/mcdev12/dynasty/go/internal/roster/events"
+)
+
+// Draft event types that appear in the transaction log
+const (
+	draftEventPickMade           = "PickMade"
+	draftEventPickSlotReassigned = "PickSlotReassigned"
+)
+
+// rosterAcquisitionTypes maps how a player joined a roster outside the draft to the
+// transaction it records. Drafted players are logged from PickMade events instead.
+var rosterAcquisitionTypes = map[models.AcquisitionType]models.TransactionType{
+	models.AcquisitionTypeFreeAgent: models.TransactionTypeAdded,
+	models.AcquisitionTypeWaiver:    models.TransactionTypeWaiverClaim,
+	models.AcquisitionTypeTrade:     models.TransactionTypeTraded,
+	models.AcquisitionTypeKeeper:    models.TransactionTypeKeeperDesignated,
+}
+
+// draftPickDetails are the details of a DRAFTED transaction or a draft pick trade
+type draftPickDetails struct {
+	Round         int      `json:"round"`
+	Pick          int      `json:"pick"`
+	OverallPick   int      `json:"overall_pick"`
+	AuctionAmount *float64 `json:"auction_amount,omitempty"`
+	Reason        string   `json:"reason,omitempty"`
+}
+
+// isLoggedDraftEvent reports whether a draft event type appears in the transaction log
+func isLoggedDraftEvent(eventType string) bool {
+	return eventType == draftEventPickMade || eventType == draftEventPickSlotReassigned
+}
+
+// transactionFromRosterEvent maps a roster outbox event to the transaction it records.
+// It returns nil for events that don't appear in the log.
+func transactionFromRosterEvent(event RosterEvent) (*models.LeagueTransaction, error) {
+	txn := &models.LeagueTransaction{
+		LeagueID:      event.LeagueID,
+		FantasyTeamID: event.FantasyTeamID,
+		Details:       json.RawMessage("{}"),
+	}
+
+	switch event.EventType {
+	case rosterevents.EventTypeRosterPlayerAdded:
+		var payload rosterevents.RosterPlayerAddedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("unmarshal %s payload: %w", event.EventType, err)
+		}
+		txnType, ok := rosterAcquisitionTypes[models.AcquisitionType(payload.AcquisitionType)]
+		if !ok {
+			return nil, nil
+		}
+		txn.Type = txnType
+		txn.OccurredAt = occurredAt(payload.AcquiredAt, event.CreatedAt)
+		return txn, setPlayerID(txn, payload.PlayerID)
+
+	case rosterevents.EventTypeRosterPlayerDropped:
+		var payload rosterevents.RosterPlayerDroppedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("unmarshal %s payload: %w", event.EventType, err)
+		}
+		txn.Type = models.TransactionTypeDropped
+		txn.OccurredAt = occurredAt(payload.DroppedAt, event.CreatedAt)
+		return txn, setPlayerID(txn, payload.PlayerID)
+
+	case rosterevents.EventTypeKeeperDesignated:
+		var payload rosterevents.KeeperDesignatedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("unmarshal %s payload: %w", event.EventType, err)
+		}
+		txn.Type = models.TransactionTypeKeeperDesignated
+		txn.OccurredAt = occurredAt(payload.DesignatedAt, event.CreatedAt)
+		if len(payload.KeeperData) > 0 {
+			txn.Details = payload.KeeperData
+		}
+		return txn, setPlayerID(txn, payload.PlayerID)
+	}
+
+	return nil, nil
+}
+
+// transactionFromDraftEvent maps a draft event in a league to the transaction it records.
+// It returns nil for events that don't appear in the log.
+func transactionFromDraftEvent(event DraftEvent, leagueID uuid.UUID) (*models.LeagueTransaction, error) {
+	draftID := event.DraftID
+	txn := &models.LeagueTransaction{
+		LeagueID: leagueID,
+		DraftID:  &draftID,
+	}
+
+	var details draftPickDetails
+	switch event.EventType {
+	case draftEventPickMade:
+		var payload draftevents.PickMadePayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("unmarshal %s payload: %w", event.EventType, err)
+		}
+		txn.Type = models.TransactionTypeDrafted
+		txn.OccurredAt = occurredAt(payload.MadeAt, event.Timestamp)
+		details = draftPickDetails{
+			Round:         payload.Round,
+			Pick:          payload.Pick,
+			OverallPick:   payload.OverallPick,
+			AuctionAmount: payload.AuctionAmount,
+		}
+		if err := setTeamID(&txn.FantasyTeamID, payload.TeamID); err != nil {
+			return nil, err
+		}
+		if err := setPlayerID(txn, payload.PlayerID); err != nil {
+			return nil, err
+		}
+		if err := setPickID(txn, payload.PickID); err != nil {
+			return nil, err
+		}
+
+	case draftEventPickSlotReassigned:
+		// A traded pick is logged against the team that received it
+		var payload draftevents.PickSlotReassignedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("unmarshal %s payload: %w", event.EventType, err)
+		}
+		txn.Type = models.TransactionTypeTraded
+		txn.OccurredAt = occurredAt(payload.ReassignedAt, event.Timestamp)
+		details = draftPickDetails{
+			Round:       payload.Round,
+			Pick:        payload.Pick,
+			OverallPick: payload.OverallPick,
+			Reason:      payload.Reason,
+		}
+		if err := setTeamID(&txn.FantasyTeamID, payload.ToTeamID); err != nil {
+			return nil, err
+		}
+		var fromTeamID uuid.UUID
+		if err := setTeamID(&fromTeamID, payload.FromTeamID); err != nil {
+			return nil, err
+		}
+		txn.CounterpartyTeamID = &fromTeamID
+		if err := setPickID(txn, payload.PickID); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return nil, fmt.Errorf("marshal transaction details: %w", err)
+	}
+	txn.Details = raw
+	return txn, nil
+}
+
+// occurredAt prefers the time recorded in an event's payload over the time the event was written
+func occurredAt(payloadTime, writtenAt time.Time) time.Time {
+	if payloadTime.IsZero() {
+		return writtenAt
+	}
+	return payloadTime
+}
+
+func setTeamID(dst *uuid.UUID, teamID string) error {
+	id, err := uuid.Parse(teamID)
+	if err != nil {
+		return fmt.Errorf("parse team ID: %w", err)
+	}
+	*dst = id
+	return nil
+}
+
+func setPlayerID(txn *models.LeagueTransaction, playerID string) error {
+	id, err := uuid.Parse(playerID)
+	if err != nil {
+		return fmt.Errorf("parse player ID: %w", err)
+	}
+	txn.PlayerID = &id
+	return nil
+}
+
+func setPickID(txn *models.LeagueTransaction, pickID string) error {
+	id, err := uuid.Parse(pickID)
+	if err != nil {
+		return fmt.Errorf("parse pick ID: %w", err)
+	}
+	txn.PickID = &id
+	return nil
+}
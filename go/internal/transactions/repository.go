@@ -0,0 +1,279 @@
+package transactions
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/ids"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/mcdev12/dynasty/go/internal/transactions/db"
+	userevents "github.com/mcdev12/dynasty/go/internal/users/events"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	GetDraftLeagueID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	InsertLeagueTransaction(ctx context.Context, arg db.InsertLeagueTransactionParams) (int64, error)
+	IsSandboxDraft(ctx context.Context, id uuid.UUID) (bool, error)
+	ListLeagueTransactions(ctx context.Context, arg db.ListLeagueTransactionsParams) ([]db.LeagueTransaction, error)
+	ListDigestRecipients(ctx context.Context, frequency string) ([]db.ListDigestRecipientsRow, error)
+	ListDigestTransactions(ctx context.Context, arg db.ListDigestTransactionsParams) ([]db.ListDigestTransactionsRow, error)
+}
+
+// Repository implements league transaction log data access operations
+type Repository struct {
+	queries Querier
+	sqlDB   *sql.DB
+}
+
+// NewRepository creates a new transaction log repository. sqlDB drains the roster outbox
+// in a transaction.
+func NewRepository(querier Querier, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: querier,
+		sqlDB:   sqlDB,
+	}
+}
+
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
+// ListLeagueTransactions retrieves a league's transactions, newest first
+func (r *Repository) ListLeagueTransactions(ctx context.Context, query ListTransactionsQuery) ([]models.LeagueTransaction, error) {
+	params := db.ListLeagueTransactionsParams{
+		LeagueID:         query.LeagueID,
+		TransactionTypes: make([]string, len(query.Types)),
+		FantasyTeamID:    sqlutil.ToNullUUID(query.FantasyTeamID),
+		SeasonID:         sqlutil.ToNullUUID(query.SeasonID),
+		PageSize:         query.Limit,
+	}
+	for i, txnType := range query.Types {
+		params.TransactionTypes[i] = string(txnType)
+	}
+	if query.After != nil {
+		params.CursorOccurredAt = sql.NullTime{Time: query.After.OccurredAt, Valid: true}
+		params.CursorID = uuid.NullUUID{UUID: query.After.ID, Valid: true}
+	}
+
+	rows, err := r.queries.ListLeagueTransactions(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list league transactions: %w", err)
+	}
+
+	result := make([]models.LeagueTransaction, len(rows))
+	for i, row := range rows {
+		result[i] = *r.dbTransactionToModel(row)
+	}
+	return result, nil
+}
+
+// GetDraftLeagueID resolves the league that owns a draft
+func (r *Repository) GetDraftLeagueID(ctx context.Context, draftID uuid.UUID) (uuid.UUID, error) {
+	leagueID, err := r.queries.GetDraftLeagueID(ctx, draftID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get draft league: %w", err)
+	}
+	return leagueID, nil
+}
+
+// IsSandboxDraft reports whether a draft is a sandbox rehearsing another draft
+func (r *Repository) IsSandboxDraft(ctx context.Context, draftID uuid.UUID) (bool, error) {
+	sandbox, err := r.queries.IsSandboxDraft(ctx, draftID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for sandbox draft: %w", err)
+	}
+	return sandbox, nil
+}
+
+// RecordTransaction adds a transaction to the log. It returns false without error when the
+// event it was projected from has already been recorded.
+func (r *Repository) RecordTransaction(ctx context.Context, sourceEventID uuid.UUID, txn models.LeagueTransaction) (bool, error) {
+	return r.recordTransaction(ctx, r.queries, sourceEventID, txn)
+}
+
+// ProjectRosterOutbox claims a batch of unprojected roster outbox events and records the
+// transaction project maps each to, in one transaction. Events project returns nil for are
+// marked projected and skipped; events it fails on stay unprojected and are retried on the
+// next batch. Rows are claimed with FOR UPDATE SKIP LOCKED, so several projectors can run at once.
+func (r *Repository) ProjectRosterOutbox(ctx context.Context, limit int32, project func(RosterEvent) (*models.LeagueTransaction, error)) (int, error) {
+	recorded := 0
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		rows, err := q.FetchUnsentRosterOutbox(ctx, limit)
+		if err != nil {
+			return fmt.Errorf("failed to fetch roster outbox: %w", err)
+		}
+
+		for _, row := range rows {
+			txn, err := project(RosterEvent{
+				ID:            row.ID,
+				LeagueID:      row.LeagueID,
+				FantasyTeamID: row.FantasyTeamID,
+				EventType:     row.EventType,
+				Payload:       row.Payload,
+				CreatedAt:     row.CreatedAt,
+			})
+			if err != nil {
+				continue
+			}
+
+			if txn != nil {
+				added, err := r.recordTransaction(ctx, q, row.ID, *txn)
+				if err != nil {
+					return err
+				}
+				if added {
+					recorded++
+				}
+			}
+
+			if err := q.MarkRosterOutboxSent(ctx, db.MarkRosterOutboxSentParams{
+				ID:        row.ID,
+				CreatedAt: row.CreatedAt,
+			}); err != nil {
+				return fmt.Errorf("failed to mark roster outbox event projected: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return recorded, nil
+}
+
+// ListDigestRecipients returns every user sent digests at frequency, with the leagues their
+// digests cover
+func (r *Repository) ListDigestRecipients(ctx context.Context, frequency models.DigestFrequency) ([]DigestRecipient, error) {
+	rows, err := r.queries.ListDigestRecipients(ctx, string(frequency))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list digest recipients: %w", err)
+	}
+
+	// Rows come ordered by user, one per league
+	var recipients []DigestRecipient
+	for _, row := range rows {
+		if n := len(recipients); n == 0 || recipients[n-1].UserID != row.UserID {
+			recipients = append(recipients, DigestRecipient{
+				UserID:   row.UserID,
+				Username: row.Username,
+				Email:    row.Email,
+			})
+		}
+		recipient := &recipients[len(recipients)-1]
+		recipient.Leagues = append(recipient.Leagues, DigestLeague{ID: row.LeagueID, Name: row.LeagueName})
+	}
+	return recipients, nil
+}
+
+// ListDigestTransactions returns a league's transactions that occurred from periodStart up to
+// periodEnd, oldest first, as a digest shows them
+func (r *Repository) ListDigestTransactions(ctx context.Context, leagueID uuid.UUID, periodStart, periodEnd time.Time) ([]userevents.LeagueDigestTransaction, error) {
+	rows, err := r.queries.ListDigestTransactions(ctx, db.ListDigestTransactionsParams{
+		LeagueID:    leagueID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list digest transactions: %w", err)
+	}
+
+	txns := make([]userevents.LeagueDigestTransaction, len(rows))
+	for i, row := range rows {
+		txns[i] = userevents.LeagueDigestTransaction{
+			Type:                 row.TransactionType,
+			TeamName:             row.TeamName,
+			CounterpartyTeamName: row.CounterpartyTeamName.String,
+			PlayerName:           row.PlayerName.String,
+			OccurredAt:           row.OccurredAt,
+		}
+	}
+	return txns, nil
+}
+
+// QueueDigest queues a LeagueDigest notification to a user, unless they were already sent the
+// digest of the same period. It reports whether a notification was queued.
+func (r *Repository) QueueDigest(ctx context.Context, userID uuid.UUID, digest userevents.LeagueDigestPayload) (bool, error) {
+	transactions := 0
+	for _, league := range digest.Leagues {
+		transactions += len(league.Transactions) + league.MoreTransactions
+	}
+
+	queued := false
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		inserted, err := q.InsertUserDigest(ctx, db.InsertUserDigestParams{
+			UserID:       userID,
+			PeriodEnd:    digest.PeriodEnd,
+			Frequency:    digest.Frequency,
+			Transactions: int32(transactions),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record digest: %w", err)
+		}
+		if inserted == 0 {
+			return nil
+		}
+
+		payload, err := json.Marshal(digest)
+		if err != nil {
+			return fmt.Errorf("failed to marshal LeagueDigest notification: %w", err)
+		}
+
+		if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+			ID:        ids.New(),
+			UserID:    userID,
+			EventType: userevents.LeagueDigest,
+			Payload:   payload,
+		}); err != nil {
+			return fmt.Errorf("failed to queue LeagueDigest notification: %w", err)
+		}
+		queued = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return queued, nil
+}
+
+func (r *Repository) recordTransaction(ctx context.Context, q Querier, sourceEventID uuid.UUID, txn models.LeagueTransaction) (bool, error) {
+	rows, err := q.InsertLeagueTransaction(ctx, db.InsertLeagueTransactionParams{
+		LeagueID:           txn.LeagueID,
+		TransactionType:    string(txn.Type),
+		FantasyTeamID:      txn.FantasyTeamID,
+		CounterpartyTeamID: sqlutil.ToNullUUID(txn.CounterpartyTeamID),
+		PlayerID:           sqlutil.ToNullUUID(txn.PlayerID),
+		DraftID:            sqlutil.ToNullUUID(txn.DraftID),
+		PickID:             sqlutil.ToNullUUID(txn.PickID),
+		Details:            txn.Details,
+		SourceEventID:      sourceEventID,
+		OccurredAt:         txn.OccurredAt,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to insert league transaction: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// dbTransactionToModel converts a database transaction to domain model
+func (r *Repository) dbTransactionToModel(txn db.LeagueTransaction) *models.LeagueTransaction {
+	return &models.LeagueTransaction{
+		ID:                 txn.ID,
+		LeagueID:           txn.LeagueID,
+		Type:               models.TransactionType(txn.TransactionType),
+		FantasyTeamID:      txn.FantasyTeamID,
+		CounterpartyTeamID: sqlutil.FromNullUUID(txn.CounterpartyTeamID),
+		PlayerID:           sqlutil.FromNullUUID(txn.PlayerID),
+		DraftID:            sqlutil.FromNullUUID(txn.DraftID),
+		PickID:             sqlutil.FromNullUUID(txn.PickID),
+		Details:            txn.Details,
+		OccurredAt:         txn.OccurredAt,
+		SeasonID:           sqlutil.FromNullUUID(txn.SeasonID),
+	}
+}
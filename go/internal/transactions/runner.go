@@ -0,0 +1,125 @@
+package transactions
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/mcdev12/dynasty/go/internal/jobs"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// RosterOutboxProjector records the transactions described by roster outbox events
+type RosterOutboxProjector interface {
+	ProjectRosterOutbox(ctx context.Context, limit int32) (int, error)
+}
+
+// RunnerConfig holds configuration for the roster outbox runner
+type RunnerConfig struct {
+	PollInterval time.Duration // How often to drain the roster outbox
+	BatchSize    int32         // Max events projected per poll
+}
+
+// DefaultRunnerConfig returns default roster outbox runner configuration
+func DefaultRunnerConfig() RunnerConfig {
+	return RunnerConfig{
+		PollInterval: 2 * time.Second,
+		BatchSize:    100,
+	}
+}
+
+// Runner drains the roster outbox into the league transaction log. Events are claimed with
+// FOR UPDATE SKIP LOCKED, so several runners can safely poll the same database.
+type Runner struct {
+	projector RosterOutboxProjector
+	config    RunnerConfig
+}
+
+// NewRunner creates a new roster outbox runner
+func NewRunner(projector RosterOutboxProjector, config RunnerConfig) *Runner {
+	return &Runner{
+		projector: projector,
+		config:    config,
+	}
+}
+
+// Start polls the roster outbox until ctx is cancelled
+func (r *Runner) Start(ctx context.Context) error {
+	log.Info().
+		Dur("poll_interval", r.config.PollInterval).
+		Msg("starting transaction log runner")
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("transaction log runner shutting down")
+			return nil
+		case <-ticker.C:
+			recorded, err := r.projector.ProjectRosterOutbox(ctx, r.config.BatchSize)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to project roster outbox")
+				continue
+			}
+			if recorded > 0 {
+				log.Debug().Int("recorded", recorded).Msg("recorded roster transactions")
+			}
+		}
+	}
+}
+
+// DigestJob is the job kind of the daily run that sends league activity digests
+const DigestJob = "transactions.send_digests"
+
+// DigestSender sends users digests of the activity in their leagues
+type DigestSender interface {
+	SendDigests(ctx context.Context, frequency models.DigestFrequency, periodEnd time.Time) (int, error)
+}
+
+// DigestRunnerConfig holds configuration for the digest run
+type DigestRunnerConfig struct {
+	HourUTC  int          // Hour of the day, in UTC, digests are sent at
+	WeeklyOn time.Weekday // Day of the week, in UTC, weekly digests are sent on
+}
+
+// DefaultDigestRunnerConfig returns default digest runner configuration
+func DefaultDigestRunnerConfig() DigestRunnerConfig {
+	return DigestRunnerConfig{
+		HourUTC:  13,           // morning in US time zones
+		WeeklyOn: time.Tuesday, // once the weekend's games are done
+	}
+}
+
+// ScheduleDigests schedules the daily digests, and the weekly ones on their day, on the job
+// worker. A digest covers the period up to when its run was due rather than when it ran, so a
+// run that is late or retried covers the same period and, as each user is sent a period's
+// digest once, nobody is sent the same digest twice.
+func ScheduleDigests(worker *jobs.Worker, sender DigestSender, config DigestRunnerConfig) {
+	log.Info().
+		Int("hour_utc", config.HourUTC).
+		Str("weekly_on", config.WeeklyOn.String()).
+		Msg("scheduling digests")
+
+	worker.Schedule(DigestJob, jobs.DailyAt(config.HourUTC, 0, time.UTC), func(ctx context.Context, job jobs.Job) error {
+		periodEnd := job.RunAt.UTC()
+		frequencies := []models.DigestFrequency{models.DigestFrequencyDaily}
+		if periodEnd.Weekday() == config.WeeklyOn {
+			frequencies = append(frequencies, models.DigestFrequencyWeekly)
+		}
+
+		for _, frequency := range frequencies {
+			queued, err := sender.SendDigests(ctx, frequency, periodEnd)
+			if err != nil {
+				return err
+			}
+			log.Info().
+				Str("frequency", string(frequency)).
+				Int("queued", queued).
+				Msg("sent digests")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,153 @@
+package transactions
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	transactionv1 "github.com/mcdev12/dynasty/go/internal/genproto/transaction/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/transaction/v1/transactionv1connect"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TransactionApp defines what the service layer needs from the transaction log application
+type TransactionApp interface {
+	ListLeagueTransactions(ctx context.Context, req ListTransactionsRequest) (*TransactionPage, error)
+}
+
+// Service implements the TransactionService gRPC interface
+type Service struct {
+	app TransactionApp
+}
+
+// NewService creates a new transaction log gRPC service
+func NewService(app TransactionApp) *Service {
+	return &Service{
+		app: app,
+	}
+}
+
+// Verify that Service implements the TransactionServiceHandler interface
+var _ transactionv1connect.TransactionServiceHandler = (*Service)(nil)
+
+// ListLeagueTransactions pages through a league's transactions, newest first
+func (s *Service) ListLeagueTransactions(ctx context.Context, req *connect.Request[transactionv1.ListLeagueTransactionsRequest]) (*connect.Response[transactionv1.ListLeagueTransactionsResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := ListTransactionsRequest{
+		LeagueID:  leagueID,
+		Types:     make([]models.TransactionType, len(req.Msg.Types)),
+		PageSize:  int(req.Msg.PageSize),
+		PageToken: req.Msg.PageToken,
+	}
+	for i, protoType := range req.Msg.Types {
+		appReq.Types[i] = s.protoToTransactionType(protoType)
+	}
+	appReq.FantasyTeamID, err = uuidutil.ParseOptional("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+	appReq.SeasonID, err = uuidutil.ParseOptional("season_id", req.Msg.SeasonId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.app.ListLeagueTransactions(ctx, appReq)
+	if err != nil {
+		if errors.Is(err, ErrInvalidPageToken) || errors.Is(err, pagination.ErrInvalidPageSize) || errors.Is(err, ErrInvalidTransactionType) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoTxns := make([]*transactionv1.LeagueTransaction, len(page.Transactions))
+	for i := range page.Transactions {
+		protoTxns[i] = s.transactionToProto(&page.Transactions[i])
+	}
+
+	return connect.NewResponse(&transactionv1.ListLeagueTransactionsResponse{
+		Transactions:  protoTxns,
+		NextPageToken: page.NextPageToken,
+	}), nil
+}
+
+// Conversion methods
+
+// transactionToProto converts a domain transaction to proto
+func (s *Service) transactionToProto(txn *models.LeagueTransaction) *transactionv1.LeagueTransaction {
+	protoTxn := &transactionv1.LeagueTransaction{
+		Id:            txn.ID.String(),
+		LeagueId:      txn.LeagueID.String(),
+		Type:          s.transactionTypeToProto(txn.Type),
+		FantasyTeamId: txn.FantasyTeamID.String(),
+		Details:       string(txn.Details),
+		OccurredAt:    timestamppb.New(txn.OccurredAt),
+	}
+	if txn.CounterpartyTeamID != nil {
+		counterpartyTeamID := txn.CounterpartyTeamID.String()
+		protoTxn.CounterpartyTeamId = &counterpartyTeamID
+	}
+	if txn.PlayerID != nil {
+		playerID := txn.PlayerID.String()
+		protoTxn.PlayerId = &playerID
+	}
+	if txn.DraftID != nil {
+		draftID := txn.DraftID.String()
+		protoTxn.DraftId = &draftID
+	}
+	if txn.PickID != nil {
+		pickID := txn.PickID.String()
+		protoTxn.PickId = &pickID
+	}
+	if txn.SeasonID != nil {
+		seasonID := txn.SeasonID.String()
+		protoTxn.SeasonId = &seasonID
+	}
+	return protoTxn
+}
+
+// transactionTypeToProto converts a domain transaction type to proto
+func (s *Service) transactionTypeToProto(txnType models.TransactionType) transactionv1.TransactionType {
+	switch txnType {
+	case models.TransactionTypeDrafted:
+		return transactionv1.TransactionType_TRANSACTION_TYPE_DRAFTED
+	case models.TransactionTypeAdded:
+		return transactionv1.TransactionType_TRANSACTION_TYPE_ADDED
+	case models.TransactionTypeDropped:
+		return transactionv1.TransactionType_TRANSACTION_TYPE_DROPPED
+	case models.TransactionTypeTraded:
+		return transactionv1.TransactionType_TRANSACTION_TYPE_TRADED
+	case models.TransactionTypeWaiverClaim:
+		return transactionv1.TransactionType_TRANSACTION_TYPE_WAIVER_CLAIM
+	case models.TransactionTypeKeeperDesignated:
+		return transactionv1.TransactionType_TRANSACTION_TYPE_KEEPER_DESIGNATED
+	default:
+		return transactionv1.TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+	}
+}
+
+// protoToTransactionType converts a proto transaction type to domain
+func (s *Service) protoToTransactionType(protoType transactionv1.TransactionType) models.TransactionType {
+	switch protoType {
+	case transactionv1.TransactionType_TRANSACTION_TYPE_DRAFTED:
+		return models.TransactionTypeDrafted
+	case transactionv1.TransactionType_TRANSACTION_TYPE_ADDED:
+		return models.TransactionTypeAdded
+	case transactionv1.TransactionType_TRANSACTION_TYPE_DROPPED:
+		return models.TransactionTypeDropped
+	case transactionv1.TransactionType_TRANSACTION_TYPE_TRADED:
+		return models.TransactionTypeTraded
+	case transactionv1.TransactionType_TRANSACTION_TYPE_WAIVER_CLAIM:
+		return models.TransactionTypeWaiverClaim
+	case transactionv1.TransactionType_TRANSACTION_TYPE_KEEPER_DESIGNATED:
+		return models.TransactionTypeKeeperDesignated
+	default:
+		return ""
+	}
+}
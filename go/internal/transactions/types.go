@@ -0,0 +1,86 @@
+package transactions
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/pagination"
+)
+
+var (
+	// ErrInvalidPageToken is returned when a page token wasn't issued by ListLeagueTransactions
+	ErrInvalidPageToken = pagination.ErrInvalidPageToken
+	// ErrInvalidTransactionType is returned when filtering on an unknown transaction type
+	ErrInvalidTransactionType = errors.New("invalid transaction type")
+)
+
+// pageSizes are the page sizes of a list request
+var pageSizes = pagination.Sizes{Default: 50, Max: 200}
+
+// ListTransactionsRequest pages through a league's transactions, newest first
+type ListTransactionsRequest struct {
+	LeagueID      uuid.UUID                `json:"league_id"`
+	Types         []models.TransactionType `json:"types,omitempty"`           // empty returns every type
+	FantasyTeamID *uuid.UUID               `json:"fantasy_team_id,omitempty"` // matches either side of a trade
+	SeasonID      *uuid.UUID               `json:"season_id,omitempty"`
+	PageSize      int                      `json:"page_size"`
+	PageToken     string                   `json:"page_token,omitempty"`
+}
+
+// TransactionPage is one page of a league's transactions
+type TransactionPage struct {
+	Transactions  []models.LeagueTransaction `json:"transactions"`
+	NextPageToken string                     `json:"next_page_token,omitempty"` // empty on the last page
+}
+
+// ListTransactionsQuery selects the transactions the repository returns
+type ListTransactionsQuery struct {
+	LeagueID      uuid.UUID
+	Types         []models.TransactionType
+	FantasyTeamID *uuid.UUID
+	SeasonID      *uuid.UUID
+	After         *PageCursor // continue after this transaction
+	Limit         int32
+}
+
+// PageCursor is the position of the last transaction on a page in feed order
+type PageCursor struct {
+	OccurredAt time.Time `json:"occurred_at"`
+	ID         uuid.UUID `json:"id"`
+}
+
+// RosterEvent is an event from the roster outbox
+type RosterEvent struct {
+	ID            uuid.UUID       `json:"id"`
+	LeagueID      uuid.UUID       `json:"league_id"`
+	FantasyTeamID uuid.UUID       `json:"fantasy_team_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// DraftEvent is an event relayed from the draft outbox
+type DraftEvent struct {
+	EventID   uuid.UUID       `json:"event_id"`
+	EventType string          `json:"event_type"`
+	DraftID   uuid.UUID       `json:"draft_id"`
+	Timestamp time.Time       `json:"timestamp"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// DigestRecipient is a user sent a league activity digest, with the leagues it covers
+type DigestRecipient struct {
+	UserID   uuid.UUID
+	Username string
+	Email    string
+	Leagues  []DigestLeague
+}
+
+// DigestLeague is a league whose activity goes in a digest
+type DigestLeague struct {
+	ID   uuid.UUID
+	Name string
+}
@@ -0,0 +1,257 @@
+package treasury
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// TreasuryRepository defines what the treasury app layer needs from the repository
+type TreasuryRepository interface {
+	GetLeagueCommissionerID(ctx context.Context, leagueID uuid.UUID) (uuid.UUID, error)
+	GetTeamLeagueID(ctx context.Context, fantasyTeamID uuid.UUID) (uuid.UUID, error)
+	GetSummary(ctx context.Context, leagueID uuid.UUID) (*Summary, error)
+	UpdateSettings(ctx context.Context, req UpdateSettingsRequest) error
+	RecordEntry(ctx context.Context, entry NewEntry) (*models.TreasuryEntry, error)
+	ListEntries(ctx context.Context, leagueID uuid.UUID, fantasyTeamID *uuid.UUID) ([]models.TreasuryEntry, error)
+	ListAudit(ctx context.Context, leagueID uuid.UUID, limit int32) ([]models.TreasuryAuditEntry, error)
+}
+
+// App handles treasury business logic
+type App struct {
+	repo TreasuryRepository
+}
+
+// NewApp creates a new treasury App
+func NewApp(repo TreasuryRepository) *App {
+	return &App{
+		repo: repo,
+	}
+}
+
+// GetSummary retrieves a league's dues configuration with every team's balance
+func (a *App) GetSummary(ctx context.Context, leagueID uuid.UUID) (*Summary, error) {
+	summary, err := a.repo.GetSummary(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get treasury: %w", err)
+	}
+	return summary, nil
+}
+
+// UpdateSettings sets a league's entry fee and payout structure. Commissioner only.
+func (a *App) UpdateSettings(ctx context.Context, req UpdateSettingsRequest) (*Summary, error) {
+	req.Currency = strings.ToUpper(strings.TrimSpace(req.Currency))
+	if req.Currency == "" {
+		req.Currency = DefaultCurrency
+	}
+	for i := range req.Payouts {
+		req.Payouts[i].Label = strings.TrimSpace(req.Payouts[i].Label)
+	}
+	if err := a.validateUpdateSettingsRequest(req); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	sort.Slice(req.Payouts, func(i, j int) bool { return req.Payouts[i].Place < req.Payouts[j].Place })
+
+	if err := a.checkCommissioner(ctx, req.LeagueID, req.UpdatedBy); err != nil {
+		return nil, err
+	}
+
+	if err := a.repo.UpdateSettings(ctx, req); err != nil {
+		return nil, fmt.Errorf("failed to update treasury settings: %w", err)
+	}
+	return a.GetSummary(ctx, req.LeagueID)
+}
+
+// RecordDuesPayment marks dues a team paid, or refunds them with a negative amount. Without
+// an amount the team's outstanding balance is marked paid. Commissioner only.
+func (a *App) RecordDuesPayment(ctx context.Context, req RecordPaymentRequest) (*models.TreasuryEntry, error) {
+	req.Note = strings.TrimSpace(req.Note)
+	if req.AmountCents != nil && *req.AmountCents == 0 {
+		return nil, fmt.Errorf("validation failed: %w: must not be zero", ErrInvalidAmount)
+	}
+	if err := validateNote(req.Note); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := a.checkTeamCommissioner(ctx, req.FantasyTeamID, req.RecordedBy); err != nil {
+		return nil, err
+	}
+
+	entry, err := a.repo.RecordEntry(ctx, NewEntry{
+		FantasyTeamID: req.FantasyTeamID,
+		Kind:          models.TreasuryEntryKindDues,
+		AmountCents:   req.AmountCents,
+		Method:        models.TreasuryPaymentMethodManual,
+		Note:          req.Note,
+		RecordedBy:    req.RecordedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record dues payment: %w", err)
+	}
+	return entry, nil
+}
+
+// RecordPayout records money paid out of the pot to a team. Commissioner only.
+func (a *App) RecordPayout(ctx context.Context, req RecordPaymentRequest) (*models.TreasuryEntry, error) {
+	req.Note = strings.TrimSpace(req.Note)
+	if req.AmountCents == nil || *req.AmountCents <= 0 {
+		return nil, fmt.Errorf("validation failed: %w: must be positive", ErrInvalidAmount)
+	}
+	if err := validateNote(req.Note); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := a.checkTeamCommissioner(ctx, req.FantasyTeamID, req.RecordedBy); err != nil {
+		return nil, err
+	}
+
+	entry, err := a.repo.RecordEntry(ctx, NewEntry{
+		FantasyTeamID: req.FantasyTeamID,
+		Kind:          models.TreasuryEntryKindPayout,
+		AmountCents:   req.AmountCents,
+		Method:        models.TreasuryPaymentMethodManual,
+		Note:          req.Note,
+		RecordedBy:    req.RecordedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record payout: %w", err)
+	}
+	return entry, nil
+}
+
+// RecordStripePayment records dues paid through a completed Stripe checkout. Redelivered
+// events return ErrAlreadyRecorded.
+func (a *App) RecordStripePayment(ctx context.Context, payment StripePayment) (*models.TreasuryEntry, error) {
+	if payment.AmountCents <= 0 {
+		return nil, fmt.Errorf("validation failed: %w: must be positive", ErrInvalidAmount)
+	}
+
+	entry, err := a.repo.RecordEntry(ctx, NewEntry{
+		FantasyTeamID: payment.FantasyTeamID,
+		Kind:          models.TreasuryEntryKindDues,
+		AmountCents:   &payment.AmountCents,
+		Method:        models.TreasuryPaymentMethodStripe,
+		StripeEventID: payment.EventID,
+		Currency:      payment.Currency,
+		Note:          "Paid with Stripe",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record Stripe payment: %w", err)
+	}
+	return entry, nil
+}
+
+// ListEntries retrieves the money paid into and out of a league's pot, newest first
+func (a *App) ListEntries(ctx context.Context, leagueID uuid.UUID, fantasyTeamID *uuid.UUID) ([]models.TreasuryEntry, error) {
+	entries, err := a.repo.ListEntries(ctx, leagueID, fantasyTeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list treasury entries: %w", err)
+	}
+	return entries, nil
+}
+
+// ListAudit retrieves a league's latest treasury changes, newest first
+func (a *App) ListAudit(ctx context.Context, leagueID uuid.UUID, limit int) ([]models.TreasuryAuditEntry, error) {
+	if limit < 0 || limit > maxAuditLimit {
+		return nil, fmt.Errorf("validation failed: %w: must be between 0 and %d", ErrInvalidLimit, maxAuditLimit)
+	}
+	if limit == 0 {
+		limit = defaultAuditLimit
+	}
+
+	entries, err := a.repo.ListAudit(ctx, leagueID, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list treasury audit: %w", err)
+	}
+	return entries, nil
+}
+
+// checkTeamCommissioner returns ErrNotCommissioner unless the user commissions the team's
+// league. A nil user is a trusted caller.
+func (a *App) checkTeamCommissioner(ctx context.Context, fantasyTeamID uuid.UUID, userID *uuid.UUID) error {
+	if userID == nil {
+		return nil
+	}
+
+	leagueID, err := a.repo.GetTeamLeagueID(ctx, fantasyTeamID)
+	if err != nil {
+		return err
+	}
+	return a.checkCommissioner(ctx, leagueID, userID)
+}
+
+// checkCommissioner returns ErrNotCommissioner unless the user commissions the league. A nil
+// user is a trusted caller.
+func (a *App) checkCommissioner(ctx context.Context, leagueID uuid.UUID, userID *uuid.UUID) error {
+	if userID == nil {
+		return nil
+	}
+
+	commissionerID, err := a.repo.GetLeagueCommissionerID(ctx, leagueID)
+	if err != nil {
+		return err
+	}
+	if *userID != commissionerID {
+		return ErrNotCommissioner
+	}
+	return nil
+}
+
+// Validation methods
+
+func (a *App) validateUpdateSettingsRequest(req UpdateSettingsRequest) error {
+	if req.EntryFeeCents < 0 {
+		return fmt.Errorf("%w: must not be negative", ErrInvalidEntryFee)
+	}
+	if !validCurrency(req.Currency) {
+		return fmt.Errorf("%w: %q is not a three letter currency code", ErrInvalidCurrency, req.Currency)
+	}
+	if len(req.Payouts) > MaxPayoutPlaces {
+		return fmt.Errorf("%w: at most %d places", ErrInvalidPayouts, MaxPayoutPlaces)
+	}
+
+	places := make(map[int]bool, len(req.Payouts))
+	totalBps := 0
+	for _, payout := range req.Payouts {
+		if payout.Place < 1 {
+			return fmt.Errorf("%w: places start at 1", ErrInvalidPayouts)
+		}
+		if places[payout.Place] {
+			return fmt.Errorf("%w: place %d is listed twice", ErrInvalidPayouts, payout.Place)
+		}
+		places[payout.Place] = true
+		if payout.ShareBps <= 0 {
+			return fmt.Errorf("%w: place %d needs a positive share", ErrInvalidPayouts, payout.Place)
+		}
+		if utf8.RuneCountInString(payout.Label) > MaxPayoutLabelLen {
+			return fmt.Errorf("%w: labels must be at most %d characters", ErrInvalidPayouts, MaxPayoutLabelLen)
+		}
+		totalBps += payout.ShareBps
+	}
+	if totalBps > 10000 {
+		return fmt.Errorf("%w: shares add up to more than 100%%", ErrInvalidPayouts)
+	}
+	return nil
+}
+
+func validateNote(note string) error {
+	if utf8.RuneCountInString(note) > MaxNoteLen {
+		return fmt.Errorf("%w: must be at most %d characters", ErrInvalidNote, MaxNoteLen)
+	}
+	return nil
+}
+
+func validCurrency(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+	for _, c := range currency {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
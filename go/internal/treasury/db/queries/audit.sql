@@ -0,0 +1,11 @@
+-- name: InsertTreasuryAudit :exec
+INSERT INTO league_treasury_audit (league_id, actor_user_id, action, details)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListTreasuryAudit :many
+-- Newest changes first.
+SELECT *
+FROM league_treasury_audit
+WHERE league_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
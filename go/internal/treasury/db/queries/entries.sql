@@ -0,0 +1,20 @@
+-- name: InsertTreasuryEntry :one
+-- Returns no rows when the Stripe event was already recorded.
+INSERT INTO league_treasury_entries (league_id, fantasy_team_id, kind, amount_cents, method, stripe_event_id, note, recorded_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (stripe_event_id) DO NOTHING
+RETURNING *;
+
+-- name: GetTeamDuesPaid :one
+SELECT COALESCE(SUM(amount_cents), 0)::bigint AS dues_paid_cents
+FROM league_treasury_entries
+WHERE fantasy_team_id = $1
+  AND kind = 'DUES';
+
+-- name: ListTreasuryEntries :many
+-- Newest entries first, optionally only one team's.
+SELECT *
+FROM league_treasury_entries
+WHERE league_id = @league_id
+  AND (sqlc.narg('fantasy_team_id')::uuid IS NULL OR fantasy_team_id = sqlc.narg('fantasy_team_id')::uuid)
+ORDER BY created_at DESC, id DESC;
@@ -0,0 +1,29 @@
+-- name: GetTreasuryLeague :one
+SELECT id, name, commissioner_id FROM leagues WHERE id = $1;
+
+-- name: GetTreasuryTeam :one
+SELECT id, league_id, name FROM fantasy_teams WHERE id = $1;
+
+-- name: GetLeagueTreasury :one
+SELECT * FROM league_treasuries WHERE league_id = $1;
+
+-- name: UpsertLeagueTreasury :one
+INSERT INTO league_treasuries (league_id, entry_fee_cents, currency, payouts)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (league_id) DO UPDATE SET entry_fee_cents = EXCLUDED.entry_fee_cents,
+                                      currency        = EXCLUDED.currency,
+                                      payouts         = EXCLUDED.payouts,
+                                      updated_at      = NOW()
+RETURNING *;
+
+-- name: ListTeamDuesBalances :many
+-- What every team in the league has paid in and received, by team name.
+SELECT ft.id AS fantasy_team_id,
+       ft.name AS team_name,
+       COALESCE(SUM(e.amount_cents) FILTER (WHERE e.kind = 'DUES'), 0)::bigint AS dues_paid_cents,
+       COALESCE(SUM(e.amount_cents) FILTER (WHERE e.kind = 'PAYOUT'), 0)::bigint AS payouts_received_cents
+FROM fantasy_teams ft
+         LEFT JOIN league_treasury_entries e ON e.fantasy_team_id = ft.id
+WHERE ft.league_id = $1
+GROUP BY ft.id, ft.name
+ORDER BY ft.name, ft.id;
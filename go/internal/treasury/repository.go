@@ -0,0 +1,312 @@
+package treasury
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
+	"github.com/mcdev12/dynasty/go/internal/treasury/db"
+)
+
+// Querier defines what the repository needs from the database layer
+type Querier interface {
+	GetLeagueTreasury(ctx context.Context, leagueID uuid.UUID) (db.LeagueTreasury, error)
+	GetTreasuryLeague(ctx context.Context, id uuid.UUID) (db.GetTreasuryLeagueRow, error)
+	GetTreasuryTeam(ctx context.Context, id uuid.UUID) (db.GetTreasuryTeamRow, error)
+	ListTeamDuesBalances(ctx context.Context, leagueID uuid.UUID) ([]db.ListTeamDuesBalancesRow, error)
+	ListTreasuryAudit(ctx context.Context, arg db.ListTreasuryAuditParams) ([]db.LeagueTreasuryAudit, error)
+	ListTreasuryEntries(ctx context.Context, arg db.ListTreasuryEntriesParams) ([]db.LeagueTreasuryEntry, error)
+}
+
+// Repository implements treasury data access operations
+type Repository struct {
+	queries Querier
+	sqlDB   *sql.DB
+}
+
+// NewRepository creates a new treasury repository. sqlDB records changes in a transaction
+// with their audit trail entries.
+func NewRepository(querier Querier, sqlDB *sql.DB) *Repository {
+	return &Repository{
+		queries: querier,
+		sqlDB:   sqlDB,
+	}
+}
+
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
+// GetLeagueCommissionerID retrieves the commissioner of a league
+func (r *Repository) GetLeagueCommissionerID(ctx context.Context, leagueID uuid.UUID) (uuid.UUID, error) {
+	league, err := r.queries.GetTreasuryLeague(ctx, leagueID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, ErrLeagueNotFound
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get league: %w", err)
+	}
+	return league.CommissionerID, nil
+}
+
+// GetTeamLeagueID resolves the league a fantasy team plays in
+func (r *Repository) GetTeamLeagueID(ctx context.Context, fantasyTeamID uuid.UUID) (uuid.UUID, error) {
+	team, err := r.queries.GetTreasuryTeam(ctx, fantasyTeamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, ErrTeamNotFound
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get fantasy team: %w", err)
+	}
+	return team.LeagueID, nil
+}
+
+// GetSummary retrieves a league's dues configuration with every team's balance. Leagues
+// without dues get a free treasury in DefaultCurrency.
+func (r *Repository) GetSummary(ctx context.Context, leagueID uuid.UUID) (*Summary, error) {
+	if _, err := r.GetLeagueCommissionerID(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{
+		Treasury: models.LeagueTreasury{LeagueID: leagueID, Currency: DefaultCurrency, Payouts: []models.PayoutPlace{}},
+	}
+	row, err := r.queries.GetLeagueTreasury(ctx, leagueID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get league treasury: %w", err)
+	default:
+		treasury, err := r.dbTreasuryToModel(row)
+		if err != nil {
+			return nil, err
+		}
+		summary.Treasury = *treasury
+		summary.Configured = true
+	}
+
+	balances, err := r.queries.ListTeamDuesBalances(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team dues balances: %w", err)
+	}
+
+	summary.Teams = make([]models.TeamDuesBalance, len(balances))
+	for i, balance := range balances {
+		summary.Teams[i] = models.TeamDuesBalance{
+			FantasyTeamID:        balance.FantasyTeamID,
+			FantasyTeamName:      balance.TeamName,
+			DuesPaidCents:        balance.DuesPaidCents,
+			BalanceCents:         summary.Treasury.EntryFeeCents - balance.DuesPaidCents,
+			PayoutsReceivedCents: balance.PayoutsReceivedCents,
+		}
+		summary.CollectedCents += balance.DuesPaidCents
+		summary.PaidOutCents += balance.PayoutsReceivedCents
+	}
+	summary.PotCents = summary.Treasury.EntryFeeCents * int64(len(balances))
+	return summary, nil
+}
+
+// UpdateSettings sets a league's entry fee and payout structure, recording the old and new
+// settings in the audit trail in the same transaction
+func (r *Repository) UpdateSettings(ctx context.Context, req UpdateSettingsRequest) error {
+	payouts, err := json.Marshal(req.Payouts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payouts: %w", err)
+	}
+
+	return sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		var old *models.LeagueTreasury
+		row, err := q.GetLeagueTreasury(ctx, req.LeagueID)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return fmt.Errorf("failed to get league treasury: %w", err)
+		default:
+			if old, err = r.dbTreasuryToModel(row); err != nil {
+				return err
+			}
+		}
+
+		row, err = q.UpsertLeagueTreasury(ctx, db.UpsertLeagueTreasuryParams{
+			LeagueID:      req.LeagueID,
+			EntryFeeCents: req.EntryFeeCents,
+			Currency:      req.Currency,
+			Payouts:       payouts,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upsert league treasury: %w", err)
+		}
+		updated, err := r.dbTreasuryToModel(row)
+		if err != nil {
+			return err
+		}
+
+		return r.audit(ctx, q, req.LeagueID, req.UpdatedBy, AuditActionSettingsUpdated, map[string]any{
+			"old": old,
+			"new": updated,
+		})
+	})
+}
+
+// RecordEntry records money paid into or out of the pot of a team's league, with its audit
+// trail entry, in one transaction
+func (r *Repository) RecordEntry(ctx context.Context, entry NewEntry) (*models.TreasuryEntry, error) {
+	var recorded *models.TreasuryEntry
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		team, err := q.GetTreasuryTeam(ctx, entry.FantasyTeamID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTeamNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get fantasy team: %w", err)
+		}
+
+		if entry.AmountCents == nil || entry.Currency != "" {
+			treasury, err := q.GetLeagueTreasury(ctx, team.LeagueID)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("failed to get league treasury: %w", err)
+			}
+			currency := DefaultCurrency
+			if err == nil {
+				currency = treasury.Currency
+			}
+			if entry.Currency != "" && !strings.EqualFold(entry.Currency, currency) {
+				return fmt.Errorf("%w: paid in %s, dues are in %s", ErrCurrencyMismatch, strings.ToUpper(entry.Currency), currency)
+			}
+
+			if entry.AmountCents == nil {
+				paid, err := q.GetTeamDuesPaid(ctx, team.ID)
+				if err != nil {
+					return fmt.Errorf("failed to get team dues paid: %w", err)
+				}
+				owed := treasury.EntryFeeCents - paid
+				if owed <= 0 {
+					return ErrNothingOwed
+				}
+				entry.AmountCents = &owed
+			}
+		}
+
+		row, err := q.InsertTreasuryEntry(ctx, db.InsertTreasuryEntryParams{
+			LeagueID:      team.LeagueID,
+			FantasyTeamID: team.ID,
+			Kind:          string(entry.Kind),
+			AmountCents:   *entry.AmountCents,
+			Method:        string(entry.Method),
+			StripeEventID: sql.NullString{String: entry.StripeEventID, Valid: entry.StripeEventID != ""},
+			Note:          entry.Note,
+			RecordedBy:    sqlutil.ToNullUUID(entry.RecordedBy),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrAlreadyRecorded
+		}
+		if err != nil {
+			return fmt.Errorf("failed to insert treasury entry: %w", err)
+		}
+		recorded = r.dbEntryToModel(row)
+
+		action := AuditActionDuesRecorded
+		if entry.Kind == models.TreasuryEntryKindPayout {
+			action = AuditActionPayoutRecorded
+		}
+		return r.audit(ctx, q, team.LeagueID, entry.RecordedBy, action, recorded)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recorded, nil
+}
+
+// audit adds an entry to a league's treasury audit trail
+func (r *Repository) audit(ctx context.Context, q *db.Queries, leagueID uuid.UUID, actor *uuid.UUID, action string, details any) error {
+	payload, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s audit details: %w", action, err)
+	}
+
+	if err := q.InsertTreasuryAudit(ctx, db.InsertTreasuryAuditParams{
+		LeagueID:    leagueID,
+		ActorUserID: sqlutil.ToNullUUID(actor),
+		Action:      action,
+		Details:     payload,
+	}); err != nil {
+		return fmt.Errorf("failed to insert %s audit entry: %w", action, err)
+	}
+	return nil
+}
+
+// ListEntries retrieves the money paid into and out of a league's pot, newest first
+func (r *Repository) ListEntries(ctx context.Context, leagueID uuid.UUID, fantasyTeamID *uuid.UUID) ([]models.TreasuryEntry, error) {
+	rows, err := r.queries.ListTreasuryEntries(ctx, db.ListTreasuryEntriesParams{
+		LeagueID:      leagueID,
+		FantasyTeamID: sqlutil.ToNullUUID(fantasyTeamID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list treasury entries: %w", err)
+	}
+
+	result := make([]models.TreasuryEntry, len(rows))
+	for i, row := range rows {
+		result[i] = *r.dbEntryToModel(row)
+	}
+	return result, nil
+}
+
+// ListAudit retrieves a league's latest treasury changes, newest first
+func (r *Repository) ListAudit(ctx context.Context, leagueID uuid.UUID, limit int32) ([]models.TreasuryAuditEntry, error) {
+	rows, err := r.queries.ListTreasuryAudit(ctx, db.ListTreasuryAuditParams{
+		LeagueID: leagueID,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list treasury audit: %w", err)
+	}
+
+	result := make([]models.TreasuryAuditEntry, len(rows))
+	for i, row := range rows {
+		result[i] = models.TreasuryAuditEntry{
+			ID:          row.ID,
+			LeagueID:    row.LeagueID,
+			ActorUserID: sqlutil.FromNullUUID(row.ActorUserID),
+			Action:      row.Action,
+			Details:     row.Details,
+			CreatedAt:   row.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// Conversion methods
+
+func (r *Repository) dbTreasuryToModel(row db.LeagueTreasury) (*models.LeagueTreasury, error) {
+	treasury := &models.LeagueTreasury{
+		LeagueID:      row.LeagueID,
+		EntryFeeCents: row.EntryFeeCents,
+		Currency:      row.Currency,
+		UpdatedAt:     row.UpdatedAt,
+	}
+	if err := json.Unmarshal(row.Payouts, &treasury.Payouts); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal payouts: %w", err)
+	}
+	return treasury, nil
+}
+
+func (r *Repository) dbEntryToModel(row db.LeagueTreasuryEntry) *models.TreasuryEntry {
+	return &models.TreasuryEntry{
+		ID:            row.ID,
+		LeagueID:      row.LeagueID,
+		FantasyTeamID: row.FantasyTeamID,
+		Kind:          models.TreasuryEntryKind(row.Kind),
+		AmountCents:   row.AmountCents,
+		Method:        models.TreasuryPaymentMethod(row.Method),
+		Note:          row.Note,
+		RecordedBy:    sqlutil.FromNullUUID(row.RecordedBy),
+		CreatedAt:     row.CreatedAt,
+	}
+}
@@ -0,0 +1,291 @@
+package treasury
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	treasuryv1 "github.com/mcdev12/dynasty/go/internal/genproto/treasury/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/treasury/v1/treasuryv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TreasuryApp defines what the service layer needs from the treasury application
+type TreasuryApp interface {
+	GetSummary(ctx context.Context, leagueID uuid.UUID) (*Summary, error)
+	UpdateSettings(ctx context.Context, req UpdateSettingsRequest) (*Summary, error)
+	RecordDuesPayment(ctx context.Context, req RecordPaymentRequest) (*models.TreasuryEntry, error)
+	RecordPayout(ctx context.Context, req RecordPaymentRequest) (*models.TreasuryEntry, error)
+	ListEntries(ctx context.Context, leagueID uuid.UUID, fantasyTeamID *uuid.UUID) ([]models.TreasuryEntry, error)
+	ListAudit(ctx context.Context, leagueID uuid.UUID, limit int) ([]models.TreasuryAuditEntry, error)
+}
+
+// Service implements the TreasuryService gRPC interface. Requests without a signed in user
+// are trusted callers.
+type Service struct {
+	app TreasuryApp
+}
+
+// NewService creates a new treasury gRPC service
+func NewService(app TreasuryApp) *Service {
+	return &Service{
+		app: app,
+	}
+}
+
+// Verify that Service implements the TreasuryServiceHandler interface
+var _ treasuryv1connect.TreasuryServiceHandler = (*Service)(nil)
+
+// GetTreasury retrieves a league's dues configuration with every team's balance
+func (s *Service) GetTreasury(ctx context.Context, req *connect.Request[treasuryv1.GetTreasuryRequest]) (*connect.Response[treasuryv1.GetTreasuryResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.app.GetSummary(ctx, leagueID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&treasuryv1.GetTreasuryResponse{
+		Treasury: s.summaryToProto(summary),
+	}), nil
+}
+
+// UpdateTreasurySettings sets the entry fee and payout structure. Commissioner only.
+func (s *Service) UpdateTreasurySettings(ctx context.Context, req *connect.Request[treasuryv1.UpdateTreasurySettingsRequest]) (*connect.Response[treasuryv1.UpdateTreasurySettingsResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	payouts := make([]models.PayoutPlace, len(req.Msg.Payouts))
+	for i, payout := range req.Msg.Payouts {
+		payouts[i] = models.PayoutPlace{
+			Place:    int(payout.Place),
+			Label:    payout.Label,
+			ShareBps: int(payout.ShareBps),
+		}
+	}
+
+	summary, err := s.app.UpdateSettings(ctx, UpdateSettingsRequest{
+		LeagueID:      leagueID,
+		EntryFeeCents: req.Msg.EntryFeeCents,
+		Currency:      req.Msg.Currency,
+		Payouts:       payouts,
+		UpdatedBy:     interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&treasuryv1.UpdateTreasurySettingsResponse{
+		Treasury: s.summaryToProto(summary),
+	}), nil
+}
+
+// RecordDuesPayment marks dues a team paid, or refunds them. Commissioner only.
+func (s *Service) RecordDuesPayment(ctx context.Context, req *connect.Request[treasuryv1.RecordDuesPaymentRequest]) (*connect.Response[treasuryv1.RecordDuesPaymentResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.app.RecordDuesPayment(ctx, RecordPaymentRequest{
+		FantasyTeamID: fantasyTeamID,
+		AmountCents:   req.Msg.AmountCents,
+		Note:          req.Msg.Note,
+		RecordedBy:    interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&treasuryv1.RecordDuesPaymentResponse{
+		Entry: s.entryToProto(entry),
+	}), nil
+}
+
+// RecordPayout records money paid out of the pot to a team. Commissioner only.
+func (s *Service) RecordPayout(ctx context.Context, req *connect.Request[treasuryv1.RecordPayoutRequest]) (*connect.Response[treasuryv1.RecordPayoutResponse], error) {
+	fantasyTeamID, err := uuidutil.MustParseOrInvalidArg("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.app.RecordPayout(ctx, RecordPaymentRequest{
+		FantasyTeamID: fantasyTeamID,
+		AmountCents:   &req.Msg.AmountCents,
+		Note:          req.Msg.Note,
+		RecordedBy:    interceptors.ActingUserPtr(ctx),
+	})
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	return connect.NewResponse(&treasuryv1.RecordPayoutResponse{
+		Entry: s.entryToProto(entry),
+	}), nil
+}
+
+// ListTreasuryEntries lists the money paid into and out of a league's pot
+func (s *Service) ListTreasuryEntries(ctx context.Context, req *connect.Request[treasuryv1.ListTreasuryEntriesRequest]) (*connect.Response[treasuryv1.ListTreasuryEntriesResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	fantasyTeamID, err := uuidutil.ParseOptional("fantasy_team_id", req.Msg.FantasyTeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.app.ListEntries(ctx, leagueID, fantasyTeamID)
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	protoEntries := make([]*treasuryv1.TreasuryEntry, len(entries))
+	for i := range entries {
+		protoEntries[i] = s.entryToProto(&entries[i])
+	}
+
+	return connect.NewResponse(&treasuryv1.ListTreasuryEntriesResponse{
+		Entries: protoEntries,
+	}), nil
+}
+
+// ListTreasuryAudit lists changes to a league's treasury
+func (s *Service) ListTreasuryAudit(ctx context.Context, req *connect.Request[treasuryv1.ListTreasuryAuditRequest]) (*connect.Response[treasuryv1.ListTreasuryAuditResponse], error) {
+	leagueID, err := uuidutil.MustParseOrInvalidArg("league_id", req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.app.ListAudit(ctx, leagueID, int(req.Msg.Limit))
+	if err != nil {
+		return nil, s.toConnectError(err)
+	}
+
+	protoEntries := make([]*treasuryv1.AuditEntry, len(entries))
+	for i, entry := range entries {
+		protoEntries[i] = &treasuryv1.AuditEntry{
+			Id:          entry.ID.String(),
+			LeagueId:    entry.LeagueID.String(),
+			Action:      entry.Action,
+			DetailsJson: string(entry.Details),
+			CreatedAt:   timestamppb.New(entry.CreatedAt),
+		}
+		if entry.ActorUserID != nil {
+			actor := entry.ActorUserID.String()
+			protoEntries[i].ActorUserId = &actor
+		}
+	}
+
+	return connect.NewResponse(&treasuryv1.ListTreasuryAuditResponse{
+		Entries: protoEntries,
+	}), nil
+}
+
+// toConnectError maps app errors to Connect codes
+func (s *Service) toConnectError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidEntryFee), errors.Is(err, ErrInvalidCurrency), errors.Is(err, ErrInvalidPayouts),
+		errors.Is(err, ErrInvalidAmount), errors.Is(err, ErrInvalidNote), errors.Is(err, ErrInvalidLimit):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrLeagueNotFound), errors.Is(err, ErrTeamNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrNothingOwed):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, ErrNotCommissioner):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// Conversion methods
+
+// summaryToProto converts a treasury summary to proto
+func (s *Service) summaryToProto(summary *Summary) *treasuryv1.Treasury {
+	protoTreasury := &treasuryv1.Treasury{
+		LeagueId:       summary.Treasury.LeagueID.String(),
+		EntryFeeCents:  summary.Treasury.EntryFeeCents,
+		Currency:       summary.Treasury.Currency,
+		Payouts:        make([]*treasuryv1.PayoutPlace, len(summary.Treasury.Payouts)),
+		Teams:          make([]*treasuryv1.TeamBalance, len(summary.Teams)),
+		PotCents:       summary.PotCents,
+		CollectedCents: summary.CollectedCents,
+		PaidOutCents:   summary.PaidOutCents,
+		OnHandCents:    summary.OnHandCents(),
+	}
+	if summary.Configured {
+		protoTreasury.UpdatedAt = timestamppb.New(summary.Treasury.UpdatedAt)
+	}
+
+	for i, payout := range summary.Treasury.Payouts {
+		protoTreasury.Payouts[i] = &treasuryv1.PayoutPlace{
+			Place:       int32(payout.Place),
+			Label:       payout.Label,
+			ShareBps:    int32(payout.ShareBps),
+			AmountCents: summary.PayoutCents(payout),
+		}
+	}
+	for i, team := range summary.Teams {
+		protoTreasury.Teams[i] = &treasuryv1.TeamBalance{
+			FantasyTeamId:        team.FantasyTeamID.String(),
+			FantasyTeamName:      team.FantasyTeamName,
+			DuesPaidCents:        team.DuesPaidCents,
+			BalanceCents:         team.BalanceCents,
+			PaidInFull:           team.PaidInFull(),
+			PayoutsReceivedCents: team.PayoutsReceivedCents,
+		}
+	}
+	return protoTreasury
+}
+
+// entryToProto converts a domain treasury entry to proto
+func (s *Service) entryToProto(entry *models.TreasuryEntry) *treasuryv1.TreasuryEntry {
+	protoEntry := &treasuryv1.TreasuryEntry{
+		Id:            entry.ID.String(),
+		LeagueId:      entry.LeagueID.String(),
+		FantasyTeamId: entry.FantasyTeamID.String(),
+		Kind:          s.entryKindToProto(entry.Kind),
+		AmountCents:   entry.AmountCents,
+		Method:        s.paymentMethodToProto(entry.Method),
+		Note:          entry.Note,
+		CreatedAt:     timestamppb.New(entry.CreatedAt),
+	}
+	if entry.RecordedBy != nil {
+		recordedBy := entry.RecordedBy.String()
+		protoEntry.RecordedBy = &recordedBy
+	}
+	return protoEntry
+}
+
+func (s *Service) entryKindToProto(kind models.TreasuryEntryKind) treasuryv1.EntryKind {
+	switch kind {
+	case models.TreasuryEntryKindDues:
+		return treasuryv1.EntryKind_ENTRY_KIND_DUES
+	case models.TreasuryEntryKindPayout:
+		return treasuryv1.EntryKind_ENTRY_KIND_PAYOUT
+	default:
+		return treasuryv1.EntryKind_ENTRY_KIND_UNSPECIFIED
+	}
+}
+
+func (s *Service) paymentMethodToProto(method models.TreasuryPaymentMethod) treasuryv1.PaymentMethod {
+	switch method {
+	case models.TreasuryPaymentMethodManual:
+		return treasuryv1.PaymentMethod_PAYMENT_METHOD_MANUAL
+	case models.TreasuryPaymentMethodStripe:
+		return treasuryv1.PaymentMethod_PAYMENT_METHOD_STRIPE
+	default:
+		return treasuryv1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
@@ -0,0 +1,174 @@
+package treasury
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+const (
+	// stripeSignatureHeader carries an event's signature, in the form "t=<unix seconds>,v1=<hex HMAC>"
+	stripeSignatureHeader = "Stripe-Signature"
+	// stripeSignatureTolerance is how old a signed event may be before it is rejected as a replay
+	stripeSignatureTolerance = 5 * time.Minute
+	// maxStripeEventBytes caps the size of an event body
+	maxStripeEventBytes = 1 << 20
+)
+
+// StripePaymentRecorder records dues paid through Stripe
+type StripePaymentRecorder interface {
+	RecordStripePayment(ctx context.Context, payment StripePayment) (*models.TreasuryEntry, error)
+}
+
+// StripeWebhookHandler records dues paid through Stripe Checkout. Checkout sessions for dues
+// are created with the paying team in their metadata, as fantasy_team_id. Events are verified
+// with the endpoint's signing secret; anything other than a paid checkout is acknowledged and
+// ignored.
+type StripeWebhookHandler struct {
+	recorder StripePaymentRecorder
+	secret   string
+	now      func() time.Time
+}
+
+// NewStripeWebhookHandler creates a handler for the Stripe webhook endpoint signed with secret
+func NewStripeWebhookHandler(recorder StripePaymentRecorder, secret string) *StripeWebhookHandler {
+	return &StripeWebhookHandler{
+		recorder: recorder,
+		secret:   secret,
+		now:      time.Now,
+	}
+}
+
+// stripeEvent is the part of a Stripe event the handler reads
+type stripeEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object struct {
+			PaymentStatus string            `json:"payment_status"`
+			AmountTotal   int64             `json:"amount_total"`
+			Currency      string            `json:"currency"`
+			Metadata      map[string]string `json:"metadata"`
+		} `json:"object"`
+	} `json:"data"`
+}
+
+// ServeHTTP verifies and records a Stripe event. Stripe retries deliveries that don't succeed,
+// so only failures a retry could fix are answered with an error.
+func (h *StripeWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxStripeEventBytes))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if err := h.verify(r.Header.Get(stripeSignatureHeader), body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var event stripeEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "invalid event", http.StatusBadRequest)
+		return
+	}
+
+	switch event.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded":
+	default:
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	session := event.Data.Object
+	if session.PaymentStatus != "paid" {
+		// Delayed payment methods complete the session first and pay later
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	fantasyTeamID, err := uuid.Parse(session.Metadata["fantasy_team_id"])
+	if err != nil {
+		log.Printf("Ignoring Stripe event %s: checkout has no fantasy_team_id metadata", event.ID)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	_, err = h.recorder.RecordStripePayment(r.Context(), StripePayment{
+		EventID:       event.ID,
+		FantasyTeamID: fantasyTeamID,
+		AmountCents:   session.AmountTotal,
+		Currency:      session.Currency,
+	})
+	switch {
+	case err == nil, errors.Is(err, ErrAlreadyRecorded):
+	case errors.Is(err, ErrTeamNotFound), errors.Is(err, ErrCurrencyMismatch), errors.Is(err, ErrInvalidAmount):
+		log.Printf("Ignoring Stripe event %s: %v", event.ID, err)
+	default:
+		log.Printf("Failed to record Stripe event %s: %v", event.ID, err)
+		http.Error(w, "failed to record payment", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// verify checks a Stripe-Signature header against the body. Any of the header's v1
+// signatures may match, so the signing secret can be rolled.
+func (h *StripeWebhookHandler) verify(header string, body []byte) error {
+	var (
+		timestamp  int64
+		signatures [][]byte
+	)
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			t, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return errors.New("invalid signature timestamp")
+			}
+			timestamp = t
+		case "v1":
+			if sig, err := hex.DecodeString(value); err == nil {
+				signatures = append(signatures, sig)
+			}
+		}
+	}
+	if timestamp == 0 || len(signatures) == 0 {
+		return fmt.Errorf("missing %s header", stripeSignatureHeader)
+	}
+	if age := h.now().Sub(time.Unix(timestamp, 0)); age > stripeSignatureTolerance || age < -stripeSignatureTolerance {
+		return errors.New("signature timestamp outside tolerance")
+	}
+
+	mac := hmac.New(sha256.New, []byte(h.secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+	for _, sig := range signatures {
+		if hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return errors.New("signature mismatch")
+}
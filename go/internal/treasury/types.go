@@ -0,0 +1,115 @@
+package treasury
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+var (
+	// ErrLeagueNotFound is returned when a league does not exist
+	ErrLeagueNotFound = errors.New("league not found")
+	// ErrTeamNotFound is returned when a fantasy team does not exist
+	ErrTeamNotFound = errors.New("fantasy team not found")
+	// ErrNotCommissioner is returned when someone other than the league's commissioner changes its treasury
+	ErrNotCommissioner = errors.New("only the league commissioner can do this")
+	// ErrInvalidEntryFee is returned for a negative entry fee
+	ErrInvalidEntryFee = errors.New("invalid entry fee")
+	// ErrInvalidCurrency is returned for a currency that isn't a three letter ISO 4217 code
+	ErrInvalidCurrency = errors.New("invalid currency")
+	// ErrInvalidPayouts is returned for a payout structure with repeated places or shares over 100%
+	ErrInvalidPayouts = errors.New("invalid payouts")
+	// ErrInvalidAmount is returned for a zero amount, or a payout that isn't positive
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrInvalidNote is returned for a note that is too long
+	ErrInvalidNote = errors.New("invalid note")
+	// ErrInvalidLimit is returned for an audit list limit out of range
+	ErrInvalidLimit = errors.New("invalid limit")
+	// ErrNothingOwed is returned when dues are marked without an amount for a team that owes nothing
+	ErrNothingOwed = errors.New("team owes no dues")
+	// ErrCurrencyMismatch is returned for a Stripe payment in a different currency than the league's dues
+	ErrCurrencyMismatch = errors.New("payment currency doesn't match the league's dues")
+	// ErrAlreadyRecorded is returned for a Stripe event that was already recorded
+	ErrAlreadyRecorded = errors.New("payment already recorded")
+)
+
+const (
+	// DefaultCurrency is used when a league's dues don't set one
+	DefaultCurrency = "USD"
+	// MaxNoteLen is the longest entry note, in characters
+	MaxNoteLen = 280
+	// MaxPayoutPlaces caps how many places a payout structure pays
+	MaxPayoutPlaces = 20
+	// MaxPayoutLabelLen is the longest payout place label, in characters
+	MaxPayoutLabelLen = 40
+
+	// defaultAuditLimit is used when an audit list request doesn't set a limit
+	defaultAuditLimit = 50
+	// maxAuditLimit caps the limit of an audit list request
+	maxAuditLimit = 200
+)
+
+// Audit trail actions
+const (
+	AuditActionSettingsUpdated = "SETTINGS_UPDATED"
+	AuditActionDuesRecorded    = "DUES_RECORDED"
+	AuditActionPayoutRecorded  = "PAYOUT_RECORDED"
+)
+
+// UpdateSettingsRequest sets a league's entry fee and payout structure
+type UpdateSettingsRequest struct {
+	LeagueID      uuid.UUID            `json:"league_id"`
+	EntryFeeCents int64                `json:"entry_fee_cents"`
+	Currency      string               `json:"currency"`
+	Payouts       []models.PayoutPlace `json:"payouts"`
+	UpdatedBy     *uuid.UUID           `json:"updated_by,omitempty"` // nil for trusted callers
+}
+
+// RecordPaymentRequest records dues a team paid, or a payout it received
+type RecordPaymentRequest struct {
+	FantasyTeamID uuid.UUID  `json:"fantasy_team_id"`
+	AmountCents   *int64     `json:"amount_cents,omitempty"` // dues default to the team's outstanding balance
+	Note          string     `json:"note,omitempty"`
+	RecordedBy    *uuid.UUID `json:"recorded_by,omitempty"` // nil for trusted callers
+}
+
+// StripePayment is dues paid through a completed Stripe checkout
+type StripePayment struct {
+	EventID       string    `json:"event_id"`
+	FantasyTeamID uuid.UUID `json:"fantasy_team_id"`
+	AmountCents   int64     `json:"amount_cents"`
+	Currency      string    `json:"currency"`
+}
+
+// NewEntry is a treasury entry the repository records, with its audit trail entry
+type NewEntry struct {
+	FantasyTeamID uuid.UUID
+	Kind          models.TreasuryEntryKind
+	AmountCents   *int64 // nil records the team's outstanding dues
+	Method        models.TreasuryPaymentMethod
+	StripeEventID string
+	Currency      string // checked against the league's dues when set
+	Note          string
+	RecordedBy    *uuid.UUID
+}
+
+// Summary is a league's dues configuration with every team's balance
+type Summary struct {
+	Treasury       models.LeagueTreasury    `json:"treasury"`
+	Configured     bool                     `json:"configured"` // false until the commissioner sets dues
+	Teams          []models.TeamDuesBalance `json:"teams"`
+	PotCents       int64                    `json:"pot_cents"` // the entry fee times the number of teams
+	CollectedCents int64                    `json:"collected_cents"`
+	PaidOutCents   int64                    `json:"paid_out_cents"`
+}
+
+// OnHandCents is the money collected and not yet paid out
+func (s *Summary) OnHandCents() int64 {
+	return s.CollectedCents - s.PaidOutCents
+}
+
+// PayoutCents is a place's share of the pot, rounded down to the cent
+func (s *Summary) PayoutCents(place models.PayoutPlace) int64 {
+	return s.PotCents * int64(place.ShareBps) / 10000
+}
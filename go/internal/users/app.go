@@ -2,32 +2,88 @@ package users
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/users/events"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// emailVerificationTTL is how long an email verification link stays valid
+	emailVerificationTTL = 24 * time.Hour
+	// passwordResetTTL is how long a password reset link stays valid
+	passwordResetTTL = time.Hour
+	// tokenResendCooldown is the minimum gap between two emails of the same kind to one user
+	tokenResendCooldown = time.Minute
+
+	// accessTokenTTL is how long an access token works before it has to be refreshed
+	accessTokenTTL = 15 * time.Minute
+	// sessionTTL is how long a session lasts without being refreshed
+	sessionTTL = 30 * 24 * time.Hour
+	// impersonationTTL is how long an operator's session as a user lasts; it can't be refreshed
+	impersonationTTL = 30 * time.Minute
+	// maxUserAgentLength bounds the user agent kept with a session
+	maxUserAgentLength = 512
+
+	minPasswordLength = 8
+	// maxPasswordBytes is bcrypt's input limit; longer passwords would be silently truncated
+	maxPasswordBytes = 72
 )
 
 // UsersRepository defines what the app layer needs from the repository
 type UsersRepository interface {
-	CreateUser(ctx context.Context, req CreateUserRequest) (*models.User, error)
+	CreateUser(ctx context.Context, req CreateUserRequest, passwordHash *string) (*models.User, error)
 	GetUser(ctx context.Context, id uuid.UUID) (*models.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*models.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 	UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUserRequest) (*models.User, error)
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	RestoreUser(ctx context.Context, id uuid.UUID) (*models.User, error)
+	GetLatestTokenTime(ctx context.Context, userID uuid.UUID, purpose models.UserTokenPurpose) (*time.Time, error)
+	IssueToken(ctx context.Context, req IssueTokenRequest) error
+	VerifyEmail(ctx context.Context, tokenHash string) (*models.User, error)
+	ResetPassword(ctx context.Context, tokenHash, passwordHash string, notice func(*models.User) ([]byte, error)) (*models.User, error)
+	GetUserCredentials(ctx context.Context, login string) (*models.User, string, error)
+	CreateSession(ctx context.Context, req CreateSessionRequest) (*models.UserSession, error)
+	GetActiveSessionByAccessToken(ctx context.Context, accessTokenHash string) (*models.UserSession, error)
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]models.UserSession, error)
+	TouchSession(ctx context.Context, id uuid.UUID, ipAddress string) error
+	RotateSession(ctx context.Context, req RotateSessionRequest) (*models.UserSession, error)
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	SetNotificationOptOut(ctx context.Context, userID uuid.UUID, notificationType string, channel models.NotificationChannel, optedOut bool) error
+	ListNotificationOptOuts(ctx context.Context, userID uuid.UUID) ([]models.NotificationOptOut, error)
+	GetDigestFrequency(ctx context.Context, userID uuid.UUID) (models.DigestFrequency, error)
+	SetDigestFrequency(ctx context.Context, userID uuid.UUID, frequency models.DigestFrequency) error
+	AddWatchlistPlayer(ctx context.Context, req WatchlistPlayerRequest) (*models.WatchlistPlayer, error)
+	RemoveWatchlistPlayer(ctx context.Context, userID, playerID uuid.UUID) error
+	ListWatchlist(ctx context.Context, userID uuid.UUID) ([]models.WatchlistPlayer, error)
 }
 
 // App handles users business logic
 type App struct {
-	repo UsersRepository
+	repo        UsersRepository
+	tokenSecret []byte
 }
 
-// NewApp creates a new users App
-func NewApp(repo UsersRepository) *App {
+// NewApp creates a new users App. Emailed tokens are sealed with tokenSecret, shared with the
+// notification worker; without one no verification or password reset email can be sent.
+func NewApp(repo UsersRepository, tokenSecret []byte) *App {
 	return &App{
-		repo: repo,
+		repo:        repo,
+		tokenSecret: tokenSecret,
 	}
 }
 
@@ -49,11 +105,25 @@ func (a *App) CreateUser(ctx context.Context, req CreateUserRequest) (*models.Us
 		return nil, fmt.Errorf("user with email %s already exists", req.Email)
 	}
 
-	user, err := a.repo.CreateUser(ctx, req)
+	var passwordHash *string
+	if req.Password != "" {
+		hash, err := hashPassword(req.Password)
+		if err != nil {
+			return nil, err
+		}
+		passwordHash = &hash
+	}
+
+	user, err := a.repo.CreateUser(ctx, req, passwordHash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
+	// The user exists either way; a failed email can be retried with SendVerificationEmail
+	if err := a.issueToken(ctx, user, models.UserTokenPurposeEmailVerification); err != nil {
+		log.Printf("Failed to queue verification email for user %s: %v", user.ID, err)
+	}
+
 	log.Printf("Created user: %s (%s)", user.Username, user.Email)
 	return user, nil
 }
@@ -85,7 +155,8 @@ func (a *App) GetUserByEmail(ctx context.Context, email string) (*models.User, e
 	return user, nil
 }
 
-// UpdateUser updates an existing user with validation
+// UpdateUser updates an existing user with validation. Changing their email signs them out
+// of every session.
 func (a *App) UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUserRequest) (*models.User, error) {
 	if err := a.validateUpdateUserRequest(req); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
@@ -122,7 +193,8 @@ func (a *App) UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUserReques
 	return user, nil
 }
 
-// DeleteUser deletes a user by ID
+// DeleteUser soft deletes a user. They can no longer sign in and drop out of lookups, but
+// the drafts, teams and transactions they took part in keep pointing at them.
 func (a *App) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	// Verify user exists
 	user, err := a.repo.GetUser(ctx, id)
@@ -138,6 +210,460 @@ func (a *App) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// RestoreUser undoes a user's deletion, for platform operators. Restoring a user who isn't
+// deleted changes nothing.
+func (a *App) RestoreUser(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	user, err := a.repo.RestoreUser(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	log.Printf("Restored user: %s (%s)", user.Username, user.Email)
+	return user, nil
+}
+
+// SendVerificationEmail queues a new email verification link, superseding earlier ones
+func (a *App) SendVerificationEmail(ctx context.Context, userID uuid.UUID) error {
+	user, err := a.repo.GetUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+	if user.IsEmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+	if err := a.checkResendCooldown(ctx, user.ID, models.UserTokenPurposeEmailVerification); err != nil {
+		return err
+	}
+
+	if err := a.issueToken(ctx, user, models.UserTokenPurposeEmailVerification); err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	log.Printf("Queued verification email for user %s", user.ID)
+	return nil
+}
+
+// VerifyEmail redeems an emailed verification token
+func (a *App) VerifyEmail(ctx context.Context, token string) (*models.User, error) {
+	user, err := a.repo.VerifyEmail(ctx, hashToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify email: %w", err)
+	}
+
+	log.Printf("Verified email for user: %s (%s)", user.Username, user.Email)
+	return user, nil
+}
+
+// RequestPasswordReset queues a password reset link for the account with the given email.
+// Unknown and unverified emails and requests within the resend cooldown succeed without
+// sending anything, so the response doesn't reveal which addresses have accounts. An address
+// that was never verified may not belong to the user, so it can't be used to take the
+// account over.
+func (a *App) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := a.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Password reset requested for unknown email")
+			return nil
+		}
+		return fmt.Errorf("failed to get user by email: %w", err)
+	}
+	if !user.IsEmailVerified() {
+		log.Printf("Password reset requested for user %s with an unverified email, skipping", user.ID)
+		return nil
+	}
+
+	if err := a.checkResendCooldown(ctx, user.ID, models.UserTokenPurposePasswordReset); err != nil {
+		if errors.Is(err, ErrTokenResendTooSoon) {
+			log.Printf("Password reset for user %s requested within cooldown, skipping", user.ID)
+			return nil
+		}
+		return err
+	}
+
+	if err := a.issueToken(ctx, user, models.UserTokenPurposePasswordReset); err != nil {
+		return fmt.Errorf("failed to send password reset email: %w", err)
+	}
+
+	log.Printf("Queued password reset email for user %s", user.ID)
+	return nil
+}
+
+// ResetPassword redeems an emailed password reset token and sets a new password. The user
+// is signed out of every session.
+func (a *App) ResetPassword(ctx context.Context, token, newPassword string) error {
+	if err := validatePassword(newPassword); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	passwordHash, err := hashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
+	changedAt := time.Now()
+	user, err := a.repo.ResetPassword(ctx, hashToken(token), passwordHash, func(user *models.User) ([]byte, error) {
+		return json.Marshal(events.PasswordChangedPayload{
+			UserID:    user.ID.String(),
+			Username:  user.Username,
+			Email:     user.Email,
+			ChangedAt: changedAt,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+
+	log.Printf("Reset password for user: %s (%s), all sessions revoked", user.Username, user.Email)
+	return nil
+}
+
+// Login checks a user's password and starts a session on their device
+func (a *App) Login(ctx context.Context, req LoginRequest) (*IssuedSession, error) {
+	user, passwordHash, err := a.repo.GetUserCredentials(ctx, req.Login)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get user credentials: %w", err)
+		}
+		// Take as long as a real check, so response times don't reveal which accounts exist
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(req.Password))
+		return nil, ErrInvalidCredentials
+	}
+	if passwordHash == "" || bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(req.Password)) != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	accessToken, accessTokenHash, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, refreshTokenHash, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session, err := a.repo.CreateSession(ctx, CreateSessionRequest{
+		UserID:           user.ID,
+		DeviceName:       req.DeviceName,
+		UserAgent:        truncate(req.UserAgent, maxUserAgentLength),
+		IPAddress:        req.IPAddress,
+		AccessTokenHash:  accessTokenHash,
+		AccessExpiresAt:  now.Add(accessTokenTTL),
+		RefreshTokenHash: refreshTokenHash,
+		ExpiresAt:        now.Add(sessionTTL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	log.Printf("User %s signed in, session %s", user.ID, session.ID)
+	return &IssuedSession{
+		User:            user,
+		Session:         session,
+		AccessToken:     accessToken,
+		AccessExpiresAt: now.Add(accessTokenTTL),
+		RefreshToken:    refreshToken,
+	}, nil
+}
+
+// Impersonate opens a session as a user for operator, who is supporting them. The session
+// can't be refreshed and ends after impersonationTTL, so only an access token is issued, and
+// the user sees it among their sessions. Deleted users can't be impersonated.
+func (a *App) Impersonate(ctx context.Context, userID uuid.UUID, operator string) (*IssuedSession, error) {
+	user, err := a.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	accessToken, accessTokenHash, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	// Sessions need a refresh token, but this one is never handed out
+	_, refreshTokenHash, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session, err := a.repo.CreateSession(ctx, CreateSessionRequest{
+		UserID:           user.ID,
+		DeviceName:       "Support",
+		AccessTokenHash:  accessTokenHash,
+		AccessExpiresAt:  now.Add(impersonationTTL),
+		RefreshTokenHash: refreshTokenHash,
+		ExpiresAt:        now.Add(impersonationTTL),
+		ImpersonatedBy:   &operator,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	log.Printf("Operator %s is impersonating user %s, session %s", operator, user.ID, session.ID)
+	return &IssuedSession{
+		User:            user,
+		Session:         session,
+		AccessToken:     accessToken,
+		AccessExpiresAt: now.Add(impersonationTTL),
+	}, nil
+}
+
+// RefreshSession trades a refresh token for a new access token and refresh token, extending
+// the session. Each refresh token works once; presenting one again revokes the session,
+// since only a leaked copy would still be in use.
+func (a *App) RefreshSession(ctx context.Context, req RefreshSessionRequest) (*IssuedSession, error) {
+	accessToken, accessTokenHash, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, refreshTokenHash, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session, err := a.repo.RotateSession(ctx, RotateSessionRequest{
+		RefreshTokenHash:    hashToken(req.RefreshToken),
+		NewRefreshTokenHash: refreshTokenHash,
+		AccessTokenHash:     accessTokenHash,
+		AccessExpiresAt:     now.Add(accessTokenTTL),
+		ExpiresAt:           now.Add(sessionTTL),
+		UserAgent:           truncate(req.UserAgent, maxUserAgentLength),
+		IPAddress:           req.IPAddress,
+	})
+	if err != nil {
+		if errors.Is(err, ErrRefreshTokenReused) {
+			log.Printf("Refresh token reused for session %s of user %s from %s, session revoked", session.ID, session.UserID, req.IPAddress)
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("failed to refresh session: %w", err)
+	}
+
+	return &IssuedSession{
+		Session:         session,
+		AccessToken:     accessToken,
+		AccessExpiresAt: now.Add(accessTokenTTL),
+		RefreshToken:    refreshToken,
+	}, nil
+}
+
+// AuthenticateSession returns the session an access token belongs to and records its use
+func (a *App) AuthenticateSession(ctx context.Context, accessToken, ipAddress string) (*models.UserSession, error) {
+	session, err := a.repo.GetActiveSessionByAccessToken(ctx, hashToken(accessToken))
+	if err != nil {
+		return nil, err
+	}
+
+	// Last seen is informational; don't fail the request over it
+	if err := a.repo.TouchSession(ctx, session.ID, ipAddress); err != nil {
+		log.Printf("Failed to record use of session %s: %v", session.ID, err)
+	}
+	return session, nil
+}
+
+// ListSessions returns the devices the user is signed in on, most recently used first
+func (a *App) ListSessions(ctx context.Context, userID uuid.UUID) ([]models.UserSession, error) {
+	sessions, err := a.repo.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// RevokeSession signs the user out of one of their sessions
+func (a *App) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	if err := a.repo.RevokeSession(ctx, userID, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	log.Printf("Revoked session %s of user %s", sessionID, userID)
+	return nil
+}
+
+// SetNotificationOptOut turns a notification off, or back on, for the user on one channel and
+// returns the user's opt-outs after the change
+func (a *App) SetNotificationOptOut(ctx context.Context, userID uuid.UUID, notificationType string, channel models.NotificationChannel, optedOut bool) ([]models.NotificationOptOut, error) {
+	if !events.CanOptOut(notificationType) {
+		return nil, fmt.Errorf("%w: %s", ErrNotificationRequired, notificationType)
+	}
+
+	if err := a.repo.SetNotificationOptOut(ctx, userID, notificationType, channel, optedOut); err != nil {
+		return nil, fmt.Errorf("failed to set notification opt-out: %w", err)
+	}
+
+	log.Printf("User %s set %s %s notifications opted out: %t", userID, notificationType, channel, optedOut)
+	return a.ListNotificationOptOuts(ctx, userID)
+}
+
+// ListNotificationOptOuts returns the notifications the user has turned off
+func (a *App) ListNotificationOptOuts(ctx context.Context, userID uuid.UUID) ([]models.NotificationOptOut, error) {
+	optOuts, err := a.repo.ListNotificationOptOuts(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification opt-outs: %w", err)
+	}
+	return optOuts, nil
+}
+
+// GetDigestFrequency returns how often the user is sent a digest of the activity in their leagues
+func (a *App) GetDigestFrequency(ctx context.Context, userID uuid.UUID) (models.DigestFrequency, error) {
+	return a.repo.GetDigestFrequency(ctx, userID)
+}
+
+// SetDigestFrequency sets how often the user is sent a digest of the activity in their leagues.
+// Turning digests off stops them on every channel.
+func (a *App) SetDigestFrequency(ctx context.Context, userID uuid.UUID, frequency models.DigestFrequency) (models.DigestFrequency, error) {
+	switch frequency {
+	case models.DigestFrequencyOff, models.DigestFrequencyDaily, models.DigestFrequencyWeekly:
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidDigestFrequency, frequency)
+	}
+
+	if err := a.repo.SetDigestFrequency(ctx, userID, frequency); err != nil {
+		return "", err
+	}
+
+	log.Printf("User %s set digest frequency to %s", userID, frequency)
+	return frequency, nil
+}
+
+// AddWatchlistPlayer adds a player to the user's watchlist, or changes how many picks before
+// the user's turn they raise an alert in the user's drafts
+func (a *App) AddWatchlistPlayer(ctx context.Context, req WatchlistPlayerRequest) (*models.WatchlistPlayer, error) {
+	if req.AlertWithinPicks == 0 {
+		req.AlertWithinPicks = DefaultWatchlistAlertWithinPicks
+	}
+	if req.AlertWithinPicks < 1 || req.AlertWithinPicks > MaxWatchlistAlertWithinPicks {
+		return nil, fmt.Errorf("%w: must be 1 to %d picks", ErrInvalidAlertWindow, MaxWatchlistAlertWithinPicks)
+	}
+
+	player, err := a.repo.AddWatchlistPlayer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("User %s watchlisted player %s, alerting %d picks out", req.UserID, req.PlayerID, req.AlertWithinPicks)
+	return player, nil
+}
+
+// RemoveWatchlistPlayer takes a player off the user's watchlist
+func (a *App) RemoveWatchlistPlayer(ctx context.Context, userID, playerID uuid.UUID) error {
+	if err := a.repo.RemoveWatchlistPlayer(ctx, userID, playerID); err != nil {
+		return err
+	}
+
+	log.Printf("User %s took player %s off their watchlist", userID, playerID)
+	return nil
+}
+
+// ListWatchlist returns the user's watchlist
+func (a *App) ListWatchlist(ctx context.Context, userID uuid.UUID) ([]models.WatchlistPlayer, error) {
+	return a.repo.ListWatchlist(ctx, userID)
+}
+
+// issueToken creates a single-use token for the user and queues the email carrying it
+func (a *App) issueToken(ctx context.Context, user *models.User, purpose models.UserTokenPurpose) error {
+	token, tokenHash, err := newToken()
+	if err != nil {
+		return err
+	}
+
+	eventType, ttl := events.EmailVerificationRequested, emailVerificationTTL
+	if purpose == models.UserTokenPurposePasswordReset {
+		eventType, ttl = events.PasswordResetRequested, passwordResetTTL
+	}
+	expiresAt := time.Now().Add(ttl)
+
+	// Only the hash is stored; the token reaches the mailer sealed
+	sealed, err := events.SealToken(a.tokenSecret, token)
+	if err != nil {
+		return fmt.Errorf("failed to seal token: %w", err)
+	}
+	payload, err := json.Marshal(events.EmailTokenPayload{
+		UserID:      user.ID.String(),
+		Username:    user.Username,
+		Email:       user.Email,
+		SealedToken: sealed,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s payload: %w", eventType, err)
+	}
+
+	return a.repo.IssueToken(ctx, IssueTokenRequest{
+		UserID:    user.ID,
+		Purpose:   purpose,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		EventType: eventType,
+		Payload:   payload,
+	})
+}
+
+// checkResendCooldown returns ErrTokenResendTooSoon if a token of the same purpose was issued recently
+func (a *App) checkResendCooldown(ctx context.Context, userID uuid.UUID, purpose models.UserTokenPurpose) error {
+	issuedAt, err := a.repo.GetLatestTokenTime(ctx, userID, purpose)
+	if err != nil {
+		return err
+	}
+	if issuedAt != nil && time.Since(*issuedAt) < tokenResendCooldown {
+		return ErrTokenResendTooSoon
+	}
+	return nil
+}
+
+// newToken generates a random URL-safe token and the hash stored in its place
+func newToken() (token, tokenHash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	token = base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashToken(token), nil
+}
+
+// hashToken returns the hex SHA-256 of a token. Tokens are random, so a fast hash is enough.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// dummyPasswordHash is compared against when logging in to an account that doesn't exist
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("not a real password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// truncate cuts s to at most max bytes, keeping whole UTF-8 characters
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	for max > 0 && !utf8.RuneStart(s[max]) {
+		max--
+	}
+	return s[:max]
+}
+
+// hashPassword bcrypt-hashes a password
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// validatePassword validates a new password
+func validatePassword(password string) error {
+	if len([]rune(password)) < minPasswordLength {
+		return fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+	if len(password) > maxPasswordBytes {
+		return fmt.Errorf("password must be at most %d bytes", maxPasswordBytes)
+	}
+	return nil
+}
+
 // validateCreateUserRequest validates create user request
 func (a *App) validateCreateUserRequest(req CreateUserRequest) error {
 	if req.Username == "" {
@@ -150,6 +676,11 @@ func (a *App) validateCreateUserRequest(req CreateUserRequest) error {
 	if !contains(req.Email, "@") || !contains(req.Email, ".") {
 		return fmt.Errorf("email format is invalid")
 	}
+	if req.Password != "" {
+		if err := validatePassword(req.Password); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
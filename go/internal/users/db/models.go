@@ -57,6 +57,48 @@ func (ns NullLeagueType) Value() (driver.Value, error) {
 	return string(ns.LeagueType), nil
 }
 
+type UserTokenPurpose string
+
+const (
+	UserTokenPurposeEMAILVERIFICATION UserTokenPurpose = "EMAIL_VERIFICATION"
+	UserTokenPurposePASSWORDRESET     UserTokenPurpose = "PASSWORD_RESET"
+)
+
+func (e *UserTokenPurpose) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserTokenPurpose(s)
+	case string:
+		*e = UserTokenPurpose(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserTokenPurpose: %T", src)
+	}
+	return nil
+}
+
+type NullUserTokenPurpose struct {
+	UserTokenPurpose UserTokenPurpose `json:"user_token_purpose"`
+	Valid            bool             `json:"valid"` // Valid is true if UserTokenPurpose is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserTokenPurpose) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserTokenPurpose, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserTokenPurpose.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserTokenPurpose) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserTokenPurpose), nil
+}
+
 type League struct {
 	ID             uuid.UUID       `json:"id"`
 	Name           string          `json:"name"`
@@ -115,8 +157,55 @@ type Team struct {
 }
 
 type User struct {
-	ID        uuid.UUID `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
+	ID              uuid.UUID      `json:"id"`
+	Username        string         `json:"username"`
+	Email           string         `json:"email"`
+	CreatedAt       time.Time      `json:"created_at"`
+	PasswordHash    sql.NullString `json:"password_hash"`
+	EmailVerifiedAt sql.NullTime   `json:"email_verified_at"`
+	AvatarUrl       sql.NullString `json:"avatar_url"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+}
+
+type UserNotificationOptOut struct {
+	UserID           uuid.UUID `json:"user_id"`
+	NotificationType string    `json:"notification_type"`
+	Channel          string    `json:"channel"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type UserOutbox struct {
+	ID        uuid.UUID       `json:"id"`
+	UserID    uuid.UUID       `json:"user_id"`
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+	SentAt    sql.NullTime    `json:"sent_at"`
+}
+
+type UserSession struct {
+	ID                       uuid.UUID      `json:"id"`
+	UserID                   uuid.UUID      `json:"user_id"`
+	DeviceName               string         `json:"device_name"`
+	UserAgent                string         `json:"user_agent"`
+	IpAddress                string         `json:"ip_address"`
+	AccessTokenHash          string         `json:"access_token_hash"`
+	AccessExpiresAt          time.Time      `json:"access_expires_at"`
+	RefreshTokenHash         string         `json:"refresh_token_hash"`
+	PreviousRefreshTokenHash sql.NullString `json:"previous_refresh_token_hash"`
+	ExpiresAt                time.Time      `json:"expires_at"`
+	CreatedAt                time.Time      `json:"created_at"`
+	LastSeenAt               time.Time      `json:"last_seen_at"`
+	RevokedAt                sql.NullTime   `json:"revoked_at"`
+	ImpersonatedBy           sql.NullString `json:"impersonated_by"`
+}
+
+type UserToken struct {
+	ID        uuid.UUID        `json:"id"`
+	UserID    uuid.UUID        `json:"user_id"`
+	Purpose   UserTokenPurpose `json:"purpose"`
+	TokenHash string           `json:"token_hash"`
+	ExpiresAt time.Time        `json:"expires_at"`
+	UsedAt    sql.NullTime     `json:"used_at"`
+	CreatedAt time.Time        `json:"created_at"`
 }
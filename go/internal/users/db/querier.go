@@ -6,17 +6,68 @@ package db
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	// Redeems a token exactly once; no row comes back if it is unknown, used or expired
+	ConsumeUserToken(ctx context.Context, arg ConsumeUserTokenParams) (uuid.UUID, error)
+	// Leagues the user commissions that haven't been deleted.
+	CountCommissionedLeagues(ctx context.Context, commissionerID uuid.UUID) (int64, error)
+	// How many players besides this one are on the user's watchlist.
+	CountOtherWatchlistPlayers(ctx context.Context, arg CountOtherWatchlistPlayersParams) (int64, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	DeleteUser(ctx context.Context, id uuid.UUID) error
+	CreateUserSession(ctx context.Context, arg CreateUserSessionParams) (UserSession, error)
+	CreateUserToken(ctx context.Context, arg CreateUserTokenParams) (UserToken, error)
+	DeleteNotificationOptOut(ctx context.Context, arg DeleteNotificationOptOutParams) error
+	// Marks the user deleted; their teams, picks and the rest of their history stay.
+	DeleteUser(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteWatchlistPlayer(ctx context.Context, arg DeleteWatchlistPlayerParams) (int64, error)
+	// Events for users deleted since they were queued are flagged so they can be dropped unsent
+	FetchUnsentUserOutbox(ctx context.Context, limit int32) ([]FetchUnsentUserOutboxRow, error)
+	GetActiveUserSessionByAccessToken(ctx context.Context, accessTokenHash string) (UserSession, error)
+	GetDigestFrequency(ctx context.Context, userID uuid.UUID) (string, error)
+	GetLatestUserToken(ctx context.Context, arg GetLatestUserTokenParams) (UserToken, error)
+	GetPlayerExists(ctx context.Context, id uuid.UUID) (bool, error)
 	GetUser(ctx context.Context, id uuid.UUID) (User, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByUsername(ctx context.Context, username string) (User, error)
+	InsertNotificationOptOut(ctx context.Context, arg InsertNotificationOptOutParams) error
+	InsertUserOutbox(ctx context.Context, arg InsertUserOutboxParams) error
+	// Supersedes every unused token of a purpose, so only the newest one can be redeemed
+	InvalidateUserTokens(ctx context.Context, arg InvalidateUserTokensParams) error
+	// Whether one of a user's clients acknowledged a draft room frame, even after it was recorded
+	// unacknowledged
+	IsFrameAcked(ctx context.Context, arg IsFrameAckedParams) (bool, error)
+	ListActiveUserSessions(ctx context.Context, userID uuid.UUID) ([]UserSession, error)
+	ListNotificationOptOuts(ctx context.Context, userID uuid.UUID) ([]UserNotificationOptOut, error)
+	// The channels a user has turned a notification off on.
+	ListOptedOutChannels(ctx context.Context, arg ListOptedOutChannelsParams) ([]string, error)
+	// The user's watchlist in the order players were added.
+	ListWatchlistPlayers(ctx context.Context, userID uuid.UUID) ([]ListWatchlistPlayersRow, error)
+	MarkUserEmailVerified(ctx context.Context, id uuid.UUID) (User, error)
+	// The token is dropped from the payload once the email is out. created_at narrows the update
+	// to the event's partition.
+	MarkUserOutboxSent(ctx context.Context, arg MarkUserOutboxSentParams) error
+	// Clears a user's deletion; restoring a user who isn't deleted changes nothing.
+	RestoreUser(ctx context.Context, id uuid.UUID) (User, error)
+	RevokeAllUserSessions(ctx context.Context, userID uuid.UUID) error
+	RevokeUserSession(ctx context.Context, arg RevokeUserSessionParams) (int64, error)
+	// A refresh token that was already rotated out is being replayed, so whoever holds the
+	// session's tokens can't be trusted; the session is ended for everyone
+	RevokeUserSessionByPreviousRefreshToken(ctx context.Context, previousRefreshTokenHash sql.NullString) (UserSession, error)
+	// Swaps a session's tokens for new ones; no row comes back if the refresh token is unknown,
+	// already rotated, expired or revoked, or the session is an impersonation
+	RotateUserSessionTokens(ctx context.Context, arg RotateUserSessionTokensParams) (UserSession, error)
+	SetUserPassword(ctx context.Context, arg SetUserPasswordParams) error
+	// Records activity at most once a minute, so authenticated requests don't all write
+	TouchUserSession(ctx context.Context, arg TouchUserSessionParams) error
+	// Changing the email address clears its verification
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpsertDigestFrequency(ctx context.Context, arg UpsertDigestFrequencyParams) error
+	UpsertWatchlistPlayer(ctx context.Context, arg UpsertWatchlistPlayerParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,11 @@
+-- name: GetDigestFrequency :one
+SELECT frequency
+FROM user_digest_preferences
+WHERE user_id = $1;
+
+-- name: UpsertDigestFrequency :exec
+INSERT INTO user_digest_preferences (user_id, frequency)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+    SET frequency  = EXCLUDED.frequency,
+        updated_at = NOW();
@@ -0,0 +1,22 @@
+-- name: InsertNotificationOptOut :exec
+INSERT INTO user_notification_opt_outs (user_id, notification_type, channel)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteNotificationOptOut :exec
+DELETE FROM user_notification_opt_outs
+WHERE user_id = $1
+  AND notification_type = $2
+  AND channel = $3;
+
+-- name: ListNotificationOptOuts :many
+SELECT * FROM user_notification_opt_outs
+WHERE user_id = $1
+ORDER BY notification_type, channel;
+
+-- name: ListOptedOutChannels :many
+-- The channels a user has turned a notification off on.
+SELECT channel
+FROM user_notification_opt_outs
+WHERE user_id = $1
+  AND notification_type = $2;
@@ -0,0 +1,32 @@
+-- name: InsertUserOutbox :exec
+INSERT INTO user_outbox (id, user_id, event_type, payload)
+VALUES ($1, $2, $3, $4);
+
+-- name: FetchUnsentUserOutbox :many
+-- Events for users deleted since they were queued are flagged so they can be dropped unsent
+SELECT o.id, o.user_id, o.event_type, o.payload, o.created_at,
+       (u.deleted_at IS NOT NULL)::boolean AS recipient_deleted
+FROM user_outbox o
+JOIN users u ON u.id = o.user_id
+WHERE o.sent_at IS NULL
+ORDER BY o.created_at, o.id
+LIMIT $1
+    FOR UPDATE OF o SKIP LOCKED;
+
+-- name: IsFrameAcked :one
+-- Whether one of a user's clients acknowledged a draft room frame, even after it was recorded
+-- unacknowledged
+SELECT EXISTS (SELECT 1
+               FROM frame_deliveries
+               WHERE event_id = $1
+                 AND user_id = $2
+                 AND status = 'ACKED') AS acked;
+
+-- name: MarkUserOutboxSent :exec
+-- The token is dropped from the payload once the email is out. created_at narrows the update
+-- to the event's partition.
+UPDATE user_outbox
+SET sent_at = NOW(),
+    payload = payload - 'sealed_token'
+WHERE id = $1
+  AND created_at = $2;
\ No newline at end of file
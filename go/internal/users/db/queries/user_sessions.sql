@@ -0,0 +1,83 @@
+-- name: CreateUserSession :one
+INSERT INTO user_sessions (
+    user_id,
+    device_name,
+    user_agent,
+    ip_address,
+    access_token_hash,
+    access_expires_at,
+    refresh_token_hash,
+    expires_at,
+    impersonated_by
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9
+) RETURNING *;
+
+-- name: GetActiveUserSessionByAccessToken :one
+SELECT * FROM user_sessions
+WHERE access_token_hash = $1
+  AND access_expires_at > NOW()
+  AND revoked_at IS NULL;
+
+-- name: ListActiveUserSessions :many
+SELECT * FROM user_sessions
+WHERE user_id = $1
+  AND expires_at > NOW()
+  AND revoked_at IS NULL
+ORDER BY last_seen_at DESC;
+
+-- name: TouchUserSession :exec
+-- Records activity at most once a minute, so authenticated requests don't all write
+UPDATE user_sessions SET
+    last_seen_at = NOW(),
+    ip_address = $2
+WHERE id = $1
+  AND last_seen_at < NOW() - INTERVAL '1 minute';
+
+-- name: RotateUserSessionTokens :one
+-- Swaps a session's tokens for new ones; no row comes back if the refresh token is unknown,
+-- already rotated, expired or revoked, or the session is an impersonation
+UPDATE user_sessions SET
+    previous_refresh_token_hash = refresh_token_hash,
+    refresh_token_hash = sqlc.arg('new_refresh_token_hash'),
+    access_token_hash = sqlc.arg('access_token_hash'),
+    access_expires_at = sqlc.arg('access_expires_at'),
+    expires_at = sqlc.arg('expires_at'),
+    user_agent = sqlc.arg('user_agent'),
+    ip_address = sqlc.arg('ip_address'),
+    last_seen_at = NOW()
+WHERE refresh_token_hash = sqlc.arg('refresh_token_hash')
+  AND expires_at > NOW()
+  AND revoked_at IS NULL
+  AND impersonated_by IS NULL
+RETURNING *;
+
+-- name: RevokeUserSessionByPreviousRefreshToken :one
+-- A refresh token that was already rotated out is being replayed, so whoever holds the
+-- session's tokens can't be trusted; the session is ended for everyone
+UPDATE user_sessions SET
+    revoked_at = NOW()
+WHERE previous_refresh_token_hash = $1
+  AND revoked_at IS NULL
+RETURNING *;
+
+-- name: RevokeUserSession :execrows
+UPDATE user_sessions SET
+    revoked_at = NOW()
+WHERE id = $1
+  AND user_id = $2
+  AND revoked_at IS NULL;
+
+-- name: RevokeAllUserSessions :exec
+UPDATE user_sessions SET
+    revoked_at = NOW()
+WHERE user_id = $1
+  AND revoked_at IS NULL;
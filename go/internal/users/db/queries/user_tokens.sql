@@ -0,0 +1,37 @@
+-- name: CreateUserToken :one
+INSERT INTO user_tokens (
+    user_id,
+    purpose,
+    token_hash,
+    expires_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING *;
+
+-- name: GetLatestUserToken :one
+SELECT * FROM user_tokens
+WHERE user_id = $1
+  AND purpose = $2
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: InvalidateUserTokens :exec
+-- Supersedes every unused token of a purpose, so only the newest one can be redeemed
+UPDATE user_tokens SET
+    used_at = NOW()
+WHERE user_id = $1
+  AND purpose = $2
+  AND used_at IS NULL;
+
+-- name: ConsumeUserToken :one
+-- Redeems a token exactly once; no row comes back if it is unknown, used or expired
+UPDATE user_tokens SET
+    used_at = NOW()
+WHERE token_hash = $1
+  AND purpose = $2
+  AND used_at IS NULL
+  AND expires_at > NOW()
+RETURNING user_id;
\ No newline at end of file
@@ -2,28 +2,57 @@
 INSERT INTO users (
     id,
     username,
-    email
+    email,
+    password_hash
 ) VALUES (
     gen_random_uuid(),
     $1,
-    $2
+    $2,
+    $3
 ) RETURNING *;
 
 -- name: GetUser :one
-SELECT * FROM users WHERE id = $1;
+SELECT * FROM users WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: GetUserByUsername :one
-SELECT * FROM users WHERE username = $1;
+SELECT * FROM users WHERE username = $1 AND deleted_at IS NULL;
 
 -- name: GetUserByEmail :one
-SELECT * FROM users WHERE email = $1;
+SELECT * FROM users WHERE email = $1 AND deleted_at IS NULL;
 
 -- name: UpdateUser :one
+-- Changing the email address clears its verification
 UPDATE users SET
     username = $2,
-    email = $3
+    email = $3,
+    email_verified_at = CASE WHEN email = $3 THEN email_verified_at END
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: MarkUserEmailVerified :one
+UPDATE users SET
+    email_verified_at = COALESCE(email_verified_at, NOW())
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SetUserPassword :exec
+UPDATE users SET
+    password_hash = $2
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: DeleteUser :execrows
+-- Marks the user deleted; their teams, picks and the rest of their history stay.
+UPDATE users SET
+    deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: RestoreUser :one
+-- Clears a user's deletion; restoring a user who isn't deleted changes nothing.
+UPDATE users SET
+    deleted_at = NULL
 WHERE id = $1
 RETURNING *;
 
--- name: DeleteUser :exec
-DELETE FROM users WHERE id = $1;
\ No newline at end of file
+-- name: CountCommissionedLeagues :one
+-- Leagues the user commissions that haven't been deleted.
+SELECT COUNT(*) FROM leagues WHERE commissioner_id = $1 AND deleted_at IS NULL;
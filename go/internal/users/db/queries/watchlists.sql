@@ -0,0 +1,22 @@
+-- name: GetPlayerExists :one
+SELECT EXISTS (SELECT 1 FROM players WHERE id = $1) AS exists;
+
+-- name: CountOtherWatchlistPlayers :one
+-- How many players besides this one are on the user's watchlist.
+SELECT COUNT(*) FROM player_watchlists WHERE user_id = $1 AND player_id <> $2;
+
+-- name: UpsertWatchlistPlayer :exec
+INSERT INTO player_watchlists (user_id, player_id, alert_within_picks)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, player_id) DO UPDATE SET alert_within_picks = EXCLUDED.alert_within_picks;
+
+-- name: DeleteWatchlistPlayer :execrows
+DELETE FROM player_watchlists WHERE user_id = $1 AND player_id = $2;
+
+-- name: ListWatchlistPlayers :many
+-- The user's watchlist in the order players were added.
+SELECT w.user_id, w.player_id, p.full_name AS player_name, w.alert_within_picks, w.created_at
+FROM player_watchlists w
+         JOIN players p ON p.id = w.player_id
+WHERE w.user_id = $1
+ORDER BY w.created_at, w.player_id;
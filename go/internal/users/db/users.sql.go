@@ -7,50 +7,76 @@ package db
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
 )
 
+const countCommissionedLeagues = `-- name: CountCommissionedLeagues :one
+SELECT COUNT(*) FROM leagues WHERE commissioner_id = $1 AND deleted_at IS NULL
+`
+
+// Leagues the user commissions that haven't been deleted.
+func (q *Queries) CountCommissionedLeagues(ctx context.Context, commissionerID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCommissionedLeagues, commissionerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
     id,
     username,
-    email
+    email,
+    password_hash
 ) VALUES (
     gen_random_uuid(),
     $1,
-    $2
-) RETURNING id, username, email, created_at
+    $2,
+    $3
+) RETURNING id, username, email, created_at, password_hash, email_verified_at, avatar_url, deleted_at
 `
 
 type CreateUserParams struct {
-	Username string `json:"username"`
-	Email    string `json:"email"`
+	Username     string         `json:"username"`
+	Email        string         `json:"email"`
+	PasswordHash sql.NullString `json:"password_hash"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	row := q.db.QueryRowContext(ctx, createUser, arg.Username, arg.Email)
+	row := q.db.QueryRowContext(ctx, createUser, arg.Username, arg.Email, arg.PasswordHash)
 	var i User
 	err := row.Scan(
 		&i.ID,
 		&i.Username,
 		&i.Email,
 		&i.CreatedAt,
+		&i.PasswordHash,
+		&i.EmailVerifiedAt,
+		&i.AvatarUrl,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteUser = `-- name: DeleteUser :exec
-DELETE FROM users WHERE id = $1
+const deleteUser = `-- name: DeleteUser :execrows
+UPDATE users SET
+    deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
 `
 
-func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteUser, id)
-	return err
+// Marks the user deleted; their teams, picks and the rest of their history stay.
+func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, username, email, created_at FROM users WHERE id = $1
+SELECT id, username, email, created_at, password_hash, email_verified_at, avatar_url, deleted_at FROM users WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (User, error) {
@@ -61,12 +87,16 @@ func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.Username,
 		&i.Email,
 		&i.CreatedAt,
+		&i.PasswordHash,
+		&i.EmailVerifiedAt,
+		&i.AvatarUrl,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, username, email, created_at FROM users WHERE email = $1
+SELECT id, username, email, created_at, password_hash, email_verified_at, avatar_url, deleted_at FROM users WHERE email = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
@@ -77,12 +107,16 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.Username,
 		&i.Email,
 		&i.CreatedAt,
+		&i.PasswordHash,
+		&i.EmailVerifiedAt,
+		&i.AvatarUrl,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, email, created_at FROM users WHERE username = $1
+SELECT id, username, email, created_at, password_hash, email_verified_at, avatar_url, deleted_at FROM users WHERE username = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
@@ -93,16 +127,84 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 		&i.Username,
 		&i.Email,
 		&i.CreatedAt,
+		&i.PasswordHash,
+		&i.EmailVerifiedAt,
+		&i.AvatarUrl,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const markUserEmailVerified = `-- name: MarkUserEmailVerified :one
+UPDATE users SET
+    email_verified_at = COALESCE(email_verified_at, NOW())
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, username, email, created_at, password_hash, email_verified_at, avatar_url, deleted_at
+`
+
+func (q *Queries) MarkUserEmailVerified(ctx context.Context, id uuid.UUID) (User, error) {
+	row := q.db.QueryRowContext(ctx, markUserEmailVerified, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordHash,
+		&i.EmailVerifiedAt,
+		&i.AvatarUrl,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const restoreUser = `-- name: RestoreUser :one
+UPDATE users SET
+    deleted_at = NULL
+WHERE id = $1
+RETURNING id, username, email, created_at, password_hash, email_verified_at, avatar_url, deleted_at
+`
+
+// Clears a user's deletion; restoring a user who isn't deleted changes nothing.
+func (q *Queries) RestoreUser(ctx context.Context, id uuid.UUID) (User, error) {
+	row := q.db.QueryRowContext(ctx, restoreUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.CreatedAt,
+		&i.PasswordHash,
+		&i.EmailVerifiedAt,
+		&i.AvatarUrl,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const setUserPassword = `-- name: SetUserPassword :exec
+UPDATE users SET
+    password_hash = $2
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type SetUserPasswordParams struct {
+	ID           uuid.UUID      `json:"id"`
+	PasswordHash sql.NullString `json:"password_hash"`
+}
+
+func (q *Queries) SetUserPassword(ctx context.Context, arg SetUserPasswordParams) error {
+	_, err := q.db.ExecContext(ctx, setUserPassword, arg.ID, arg.PasswordHash)
+	return err
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE users SET
     username = $2,
-    email = $3
-WHERE id = $1
-RETURNING id, username, email, created_at
+    email = $3,
+    email_verified_at = CASE WHEN email = $3 THEN email_verified_at END
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, username, email, created_at, password_hash, email_verified_at, avatar_url, deleted_at
 `
 
 type UpdateUserParams struct {
@@ -111,6 +213,7 @@ type UpdateUserParams struct {
 	Email    string    `json:"email"`
 }
 
+// Changing the email address clears its verification
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
 	row := q.db.QueryRowContext(ctx, updateUser, arg.ID, arg.Username, arg.Email)
 	var i User
@@ -119,6 +222,10 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.Username,
 		&i.Email,
 		&i.CreatedAt,
+		&i.PasswordHash,
+		&i.EmailVerifiedAt,
+		&i.AvatarUrl,
+		&i.DeletedAt,
 	)
 	return i, err
 }
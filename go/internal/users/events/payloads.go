@@ -0,0 +1,267 @@
+package events
+
+import (
+	"time"
+)
+
+// Event payload types that are shared between the users service and the notification worker
+
+// User outbox event types
+const (
+	EmailVerificationRequested = "EmailVerificationRequested"
+	PasswordResetRequested     = "PasswordResetRequested"
+	PasswordChanged            = "PasswordChanged"
+	PickClockWarning           = "PickClockWarning"
+	DraftStartingSoon          = "DraftStartingSoon"
+	DraftScheduled             = "DraftScheduled"
+	LeagueChatMention          = "LeagueChatMention"
+	WishlistPlayerOnBlock      = "WishlistPlayerOnBlock"
+	LineupIssues               = "LineupIssues"
+	LeagueDigest               = "LeagueDigest"
+	OnTheClock                 = "OnTheClock"
+	LeagueArchiveWarning       = "LeagueArchiveWarning"
+	WatchlistAlert             = "WatchlistAlert"
+	DraftRecap                 = "DraftRecap"
+)
+
+// CanOptOut reports whether users may turn off the notifications for an event type.
+// Account and security emails are always sent.
+func CanOptOut(eventType string) bool {
+	switch eventType {
+	case PickClockWarning, DraftStartingSoon, DraftScheduled, LeagueChatMention, WishlistPlayerOnBlock, LineupIssues, LeagueDigest, OnTheClock, WatchlistAlert, DraftRecap:
+		return true
+	default:
+		return false
+	}
+}
+
+// PushOnly reports whether an event type is delivered by push notification alone, with no email
+func PushOnly(eventType string) bool {
+	return eventType == OnTheClock || eventType == WatchlistAlert
+}
+
+// EmailTokenPayload is the payload for EmailVerificationRequested and PasswordResetRequested
+// events. SealedToken is the single-use token sealed with SealToken, since the outbox may be
+// read by more than the mailer; it is removed from the outbox once the email is sent.
+type EmailTokenPayload struct {
+	UserID      string    `json:"user_id"`
+	Username    string    `json:"username"`
+	Email       string    `json:"email"`
+	SealedToken string    `json:"sealed_token,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// PasswordChangedPayload is the payload for a PasswordChanged event, a security notice
+// sent after a password reset
+type PasswordChangedPayload struct {
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// PickClockWarningPayload is the payload for a PickClockWarning event, queued by the draft
+// service when the pick clock of the user's team is about to run out
+type PickClockWarningPayload struct {
+	UserID           string    `json:"user_id"`
+	Username         string    `json:"username"`
+	Email            string    `json:"email"`
+	DraftID          string    `json:"draft_id"`
+	PickID           string    `json:"pick_id"`
+	TeamName         string    `json:"team_name"`
+	Round            int       `json:"round"`
+	Pick             int       `json:"pick"`
+	OverallPick      int       `json:"overall_pick"`
+	PercentRemaining int       `json:"percent_remaining"`
+	Deadline         time.Time `json:"deadline"`
+	Timezone         string    `json:"timezone,omitempty"` // IANA zone of the league to show the deadline in
+	Locale           string    `json:"locale,omitempty"`   // locale of the league to show the deadline in
+}
+
+// OnTheClockPayload is the payload for an OnTheClock event, queued by the draft service when
+// a user's team came on the clock and none of the user's draft room connections acknowledged
+// the frame telling them. EventID is that frame's event, so the push can be skipped if a late
+// acknowledgement arrives before it's sent.
+type OnTheClockPayload struct {
+	UserID      string     `json:"user_id"`
+	Username    string     `json:"username"`
+	Email       string     `json:"email"`
+	DraftID     string     `json:"draft_id"`
+	EventID     string     `json:"event_id"`
+	PickID      string     `json:"pick_id"`
+	TeamName    string     `json:"team_name"`
+	Round       int        `json:"round"`
+	Pick        int        `json:"pick"`
+	OverallPick int        `json:"overall_pick"`
+	Deadline    *time.Time `json:"deadline,omitempty"` // none for drafts without a pick clock
+	Timezone    string     `json:"timezone,omitempty"` // IANA zone of the league to show the deadline in
+	Locale      string     `json:"locale,omitempty"`   // locale of the league to show the deadline in
+}
+
+// WatchlistAlertPayload is the payload for a WatchlistAlert event, queued by the draft service
+// when a watchlisted player approached the user's pick or was drafted by another team and none
+// of the user's draft room connections acknowledged the frame telling them. EventID is that
+// frame's event, as for OnTheClock.
+type WatchlistAlertPayload struct {
+	UserID       string `json:"user_id"`
+	Username     string `json:"username"`
+	Email        string `json:"email"`
+	DraftID      string `json:"draft_id"`
+	EventID      string `json:"event_id"`
+	LeagueName   string `json:"league_name"`
+	PlayerID     string `json:"player_id"`
+	PlayerName   string `json:"player_name"`
+	Kind         string `json:"kind"` // APPROACHING or DRAFTED
+	OverallPick  int    `json:"overall_pick"`
+	PickTeamName string `json:"pick_team_name"`       // the user's team when approaching, the drafting team when drafted
+	PicksAway    *int   `json:"picks_away,omitempty"` // approaching only
+}
+
+// DraftStartingSoonPayload is the payload for a DraftStartingSoon event, queued by the draft
+// service for the owner of every team in a league once its draft's countdown begins
+type DraftStartingSoonPayload struct {
+	UserID      string    `json:"user_id"`
+	Username    string    `json:"username"`
+	Email       string    `json:"email"`
+	DraftID     string    `json:"draft_id"`
+	LeagueName  string    `json:"league_name"`
+	TeamName    string    `json:"team_name"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	Timezone    string    `json:"timezone,omitempty"` // IANA zone of the league to show the start in
+	Locale      string    `json:"locale,omitempty"`   // locale of the league to show the start in
+}
+
+// DraftScheduledPayload is the payload for a DraftScheduled event, queued by the draft service
+// for the owner of every team in a league when its draft is scheduled or moved to a new time.
+// The email carries a calendar invite; Sequence numbers the draft's invites, so calendars
+// update the event an earlier invite added.
+type DraftScheduledPayload struct {
+	UserID              string     `json:"user_id"`
+	Username            string     `json:"username"`
+	Email               string     `json:"email"`
+	DraftID             string     `json:"draft_id"`
+	LeagueName          string     `json:"league_name"`
+	TeamName            string     `json:"team_name"`
+	ScheduledAt         time.Time  `json:"scheduled_at"`
+	PreviousScheduledAt *time.Time `json:"previous_scheduled_at,omitempty"` // none for a draft scheduled for the first time
+	Sequence            int        `json:"sequence"`
+	Timezone            string     `json:"timezone,omitempty"` // IANA zone of the league to show the start in
+	Locale              string     `json:"locale,omitempty"`   // locale of the league to show the start in
+}
+
+// LeagueChatMentionPayload is the payload for a LeagueChatMention event, queued by the league
+// chat service for each member mentioned in a message posted to one of the league's threads
+type LeagueChatMentionPayload struct {
+	UserID         string    `json:"user_id"`
+	Username       string    `json:"username"`
+	Email          string    `json:"email"`
+	LeagueID       string    `json:"league_id"`
+	LeagueName     string    `json:"league_name"`
+	ThreadID       string    `json:"thread_id"`
+	ThreadTitle    string    `json:"thread_title"`
+	MessageID      string    `json:"message_id"`
+	AuthorUsername string    `json:"author_username"`
+	Excerpt        string    `json:"excerpt"` // the start of the message
+	PostedAt       time.Time `json:"posted_at"`
+}
+
+// WishlistPlayerOnBlockPayload is the payload for a WishlistPlayerOnBlock event, queued by the
+// trade block service for the owner of every team with a player on its wishlist when another
+// team in the league puts the player on the block
+type WishlistPlayerOnBlockPayload struct {
+	UserID          string `json:"user_id"`
+	Username        string `json:"username"`
+	Email           string `json:"email"`
+	LeagueID        string `json:"league_id"`
+	LeagueName      string `json:"league_name"`
+	TeamName        string `json:"team_name"` // the team whose wishlist has the player
+	PlayerID        string `json:"player_id"`
+	PlayerName      string `json:"player_name"`
+	ListingTeamID   string `json:"listing_team_id"`
+	ListingTeamName string `json:"listing_team_name"`
+	Note            string `json:"note,omitempty"`
+}
+
+// LineupIssuesPayload is the payload for a LineupIssues event, queued by the roster service for
+// the owner of a team whose lineup has problems an hour before lineups lock
+type LineupIssuesPayload struct {
+	UserID        string    `json:"user_id"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email"`
+	LeagueID      string    `json:"league_id"`
+	LeagueName    string    `json:"league_name"`
+	FantasyTeamID string    `json:"fantasy_team_id"`
+	TeamName      string    `json:"team_name"`
+	Issues        []string  `json:"issues"` // each problem, with the suggested fix when there is one
+	LocksAt       time.Time `json:"locks_at"`
+	Timezone      string    `json:"timezone,omitempty"` // IANA zone of the league to show the lock in
+	Locale        string    `json:"locale,omitempty"`   // locale of the league to show the lock in
+}
+
+// LeagueArchiveWarningPayload is the payload for a LeagueArchiveWarning event, queued by the
+// league archival job for the commissioner of a league that has been inactive for a season
+type LeagueArchiveWarningPayload struct {
+	UserID         string    `json:"user_id"`
+	Username       string    `json:"username"`
+	Email          string    `json:"email"`
+	LeagueID       string    `json:"league_id"`
+	LeagueName     string    `json:"league_name"`
+	LastActivityAt time.Time `json:"last_activity_at"`
+	ArchiveAfter   time.Time `json:"archive_after"` // archived from then on unless the league is used again
+}
+
+// LeagueDigestPayload is the payload for a LeagueDigest event, queued by the transaction log
+// for each user at their digest frequency with what happened in the leagues they have a team in
+type LeagueDigestPayload struct {
+	UserID      string               `json:"user_id"`
+	Username    string               `json:"username"`
+	Email       string               `json:"email"`
+	Frequency   string               `json:"frequency"` // DAILY or WEEKLY
+	PeriodStart time.Time            `json:"period_start"`
+	PeriodEnd   time.Time            `json:"period_end"`
+	Leagues     []LeagueDigestLeague `json:"leagues"` // only leagues with activity
+}
+
+// LeagueDigestLeague is one league's activity in a digest
+type LeagueDigestLeague struct {
+	LeagueID         string                    `json:"league_id"`
+	LeagueName       string                    `json:"league_name"`
+	Transactions     []LeagueDigestTransaction `json:"transactions"`      // oldest first
+	MoreTransactions int                       `json:"more_transactions"` // left out to keep the digest short
+}
+
+// LeagueDigestTransaction is an entry from a league's transaction log in a digest
+type LeagueDigestTransaction struct {
+	Type                 string    `json:"type"` // a league transaction type, e.g. "ADDED"
+	TeamName             string    `json:"team_name"`
+	CounterpartyTeamName string    `json:"counterparty_team_name,omitempty"` // the other side of a trade
+	PlayerName           string    `json:"player_name,omitempty"`            // unset for draft pick trades
+	OccurredAt           time.Time `json:"occurred_at"`
+}
+
+// DraftRecapPayload is the payload for a DraftRecap event, queued by the draft recap consumer
+// for the owner of every team in a league once its draft completes, with the team's grade and
+// the top of the recap
+type DraftRecapPayload struct {
+	UserID          string           `json:"user_id"`
+	Username        string           `json:"username"`
+	Email           string           `json:"email"`
+	DraftID         string           `json:"draft_id"`
+	LeagueName      string           `json:"league_name"`
+	TeamName        string           `json:"team_name"`
+	Grade           string           `json:"grade,omitempty"` // unset when the team made no picks
+	Rank            int              `json:"rank,omitempty"`
+	Teams           int              `json:"teams"` // how many teams were graded
+	ProjectedPoints float64          `json:"projected_points"`
+	BestValues      []DraftRecapPick `json:"best_values"`     // biggest value first
+	BiggestReaches  []DraftRecapPick `json:"biggest_reaches"` // biggest reach first
+}
+
+// DraftRecapPick is a pick a draft recap singles out
+type DraftRecapPick struct {
+	OverallPick  int    `json:"overall_pick"`
+	TeamName     string `json:"team_name"`
+	PlayerName   string `json:"player_name"`
+	ExpectedPick int    `json:"expected_pick"`
+	PicksVsRank  int    `json:"picks_vs_rank"` // positive for a value, negative for a reach
+}
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// EmailTokenSecretEnv names the environment variable holding the secret the users service
+// seals emailed tokens with and the notification worker opens them with
+const EmailTokenSecretEnv = "EMAIL_TOKEN_SECRET"
+
+// ErrNoEmailTokenSecret is returned when sealing or opening a token without a secret
+var ErrNoEmailTokenSecret = errors.New("no email token secret configured")
+
+// SealToken encrypts a single-use token for an outbox payload, so a token read from the
+// outbox can't be redeemed without the secret
+func SealToken(secret []byte, token string) (string, error) {
+	aead, err := tokenCipher(secret)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(token), nil)
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// OpenToken decrypts a token sealed with SealToken
+func OpenToken(secret []byte, sealed string) (string, error) {
+	aead, err := tokenCipher(secret)
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.RawURLEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode sealed token: %w", err)
+	}
+	if len(data) < aead.NonceSize() {
+		return "", errors.New("sealed token is too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	token, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to open sealed token: %w", err)
+	}
+	return string(token), nil
+}
+
+// tokenCipher returns AES-256-GCM keyed by the SHA-256 of secret
+func tokenCipher(secret []byte) (cipher.AEAD, error) {
+	if len(secret) == 0 {
+		return nil, ErrNoEmailTokenSecret
+	}
+	key := sha256.Sum256(secret)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
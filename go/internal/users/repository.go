@@ -2,11 +2,18 @@ package users
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/ids"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/sqlutil"
 	"github.com/mcdev12/dynasty/go/internal/users/db"
+	"github.com/mcdev12/dynasty/go/internal/users/events"
 )
 
 // Querier defines what the repository needs from the database layer
@@ -16,26 +23,50 @@ type Querier interface {
 	GetUserByUsername(ctx context.Context, username string) (db.User, error)
 	GetUserByEmail(ctx context.Context, email string) (db.User, error)
 	UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error)
-	DeleteUser(ctx context.Context, id uuid.UUID) error
+	RestoreUser(ctx context.Context, id uuid.UUID) (db.User, error)
+	GetLatestUserToken(ctx context.Context, arg db.GetLatestUserTokenParams) (db.UserToken, error)
+	CreateUserSession(ctx context.Context, arg db.CreateUserSessionParams) (db.UserSession, error)
+	GetActiveUserSessionByAccessToken(ctx context.Context, accessTokenHash string) (db.UserSession, error)
+	ListActiveUserSessions(ctx context.Context, userID uuid.UUID) ([]db.UserSession, error)
+	TouchUserSession(ctx context.Context, arg db.TouchUserSessionParams) error
+	RotateUserSessionTokens(ctx context.Context, arg db.RotateUserSessionTokensParams) (db.UserSession, error)
+	RevokeUserSessionByPreviousRefreshToken(ctx context.Context, previousRefreshTokenHash sql.NullString) (db.UserSession, error)
+	RevokeUserSession(ctx context.Context, arg db.RevokeUserSessionParams) (int64, error)
+	InsertNotificationOptOut(ctx context.Context, arg db.InsertNotificationOptOutParams) error
+	DeleteNotificationOptOut(ctx context.Context, arg db.DeleteNotificationOptOutParams) error
+	ListNotificationOptOuts(ctx context.Context, userID uuid.UUID) ([]db.UserNotificationOptOut, error)
+	GetDigestFrequency(ctx context.Context, userID uuid.UUID) (string, error)
+	UpsertDigestFrequency(ctx context.Context, arg db.UpsertDigestFrequencyParams) error
+	DeleteWatchlistPlayer(ctx context.Context, arg db.DeleteWatchlistPlayerParams) (int64, error)
+	ListWatchlistPlayers(ctx context.Context, userID uuid.UUID) ([]db.ListWatchlistPlayersRow, error)
 }
 
 // Repository implements user data access operations
 type Repository struct {
 	queries Querier
+	sqlDB   *sql.DB
 }
 
-// NewRepository creates a new users repository
-func NewRepository(querier Querier) *Repository {
+// NewRepository creates a new users repository. sqlDB runs the token flows,
+// which touch several tables, in a transaction.
+func NewRepository(querier Querier, sqlDB *sql.DB) *Repository {
 	return &Repository{
 		queries: querier,
+		sqlDB:   sqlDB,
 	}
 }
 
-// CreateUser creates a new user
-func (r *Repository) CreateUser(ctx context.Context, req CreateUserRequest) (*models.User, error) {
+// txQueries binds the sqlc queries to a transaction
+func txQueries(tx *sql.Tx) *db.Queries {
+	return db.New(tx)
+}
+
+// CreateUser creates a new user. passwordHash is nil for users without a password.
+func (r *Repository) CreateUser(ctx context.Context, req CreateUserRequest, passwordHash *string) (*models.User, error) {
 	user, err := r.queries.CreateUser(ctx, db.CreateUserParams{
-		Username: req.Username,
-		Email:    req.Email,
+		Username:     req.Username,
+		Email:        req.Email,
+		PasswordHash: sqlutil.ToSqlString(passwordHash),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
@@ -74,34 +105,524 @@ func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*models.
 	return r.dbUserToModel(user), nil
 }
 
-// UpdateUser updates an existing user
+// UpdateUser updates an existing user. Changing their email signs them out everywhere and
+// voids the email links sent to the old address.
 func (r *Repository) UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUserRequest) (*models.User, error) {
-	user, err := r.queries.UpdateUser(ctx, db.UpdateUserParams{
-		ID:       id,
-		Username: req.Username,
-		Email:    req.Email,
+	var user db.User
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		existing, err := q.GetUser(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		user, err = q.UpdateUser(ctx, db.UpdateUserParams{
+			ID:       id,
+			Username: req.Username,
+			Email:    req.Email,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+		if user.Email == existing.Email {
+			return nil
+		}
+
+		// Whoever holds a session or an emailed link may not own the new address
+		if err := q.RevokeAllUserSessions(ctx, id); err != nil {
+			return fmt.Errorf("failed to revoke user sessions: %w", err)
+		}
+		for _, purpose := range []db.UserTokenPurpose{db.UserTokenPurposeEMAILVERIFICATION, db.UserTokenPurposePASSWORDRESET} {
+			if err := q.InvalidateUserTokens(ctx, db.InvalidateUserTokensParams{
+				UserID:  id,
+				Purpose: purpose,
+			}); err != nil {
+				return fmt.Errorf("failed to invalidate user tokens: %w", err)
+			}
+		}
+		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update user: %w", err)
+		return nil, err
 	}
 
 	return r.dbUserToModel(user), nil
 }
 
-// DeleteUser deletes a user by ID
+// DeleteUser soft deletes a user, signing them out everywhere and voiding their outstanding
+// email links. Their teams, picks and transactions are kept. Commissioners must hand over or
+// delete their leagues first.
 func (r *Repository) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	if err := r.queries.DeleteUser(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete user: %w", err)
+	return sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		leagues, err := q.CountCommissionedLeagues(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to count commissioned leagues: %w", err)
+		}
+		if leagues > 0 {
+			return ErrUserIsCommissioner
+		}
+
+		deleted, err := q.DeleteUser(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		if deleted == 0 {
+			return fmt.Errorf("failed to delete user: %w", sql.ErrNoRows)
+		}
+
+		if err := q.RevokeAllUserSessions(ctx, id); err != nil {
+			return fmt.Errorf("failed to revoke user sessions: %w", err)
+		}
+		for _, purpose := range []db.UserTokenPurpose{db.UserTokenPurposeEMAILVERIFICATION, db.UserTokenPurposePASSWORDRESET} {
+			if err := q.InvalidateUserTokens(ctx, db.InvalidateUserTokensParams{
+				UserID:  id,
+				Purpose: purpose,
+			}); err != nil {
+				return fmt.Errorf("failed to invalidate user tokens: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// RestoreUser undoes a user's deletion. Sessions revoked when they were deleted stay revoked.
+func (r *Repository) RestoreUser(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	user, err := r.queries.RestoreUser(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore user: %w", err)
+	}
+	return r.dbUserToModel(user), nil
+}
+
+// GetUserCredentials returns the user with a username or email, and their password hash,
+// which is empty for users without a password
+func (r *Repository) GetUserCredentials(ctx context.Context, login string) (*models.User, string, error) {
+	var user db.User
+	var err error
+	if strings.Contains(login, "@") {
+		user, err = r.queries.GetUserByEmail(ctx, login)
+	} else {
+		user, err = r.queries.GetUserByUsername(ctx, login)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get user credentials: %w", err)
+	}
+
+	return r.dbUserToModel(user), user.PasswordHash.String, nil
+}
+
+// GetLatestTokenTime returns when the user's newest token of a purpose was issued, or nil if none was
+func (r *Repository) GetLatestTokenTime(ctx context.Context, userID uuid.UUID, purpose models.UserTokenPurpose) (*time.Time, error) {
+	token, err := r.queries.GetLatestUserToken(ctx, db.GetLatestUserTokenParams{
+		UserID:  userID,
+		Purpose: db.UserTokenPurpose(purpose),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest user token: %w", err)
+	}
+	return &token.CreatedAt, nil
+}
+
+// IssueToken stores a new token, superseding unused ones with the same purpose,
+// and queues the email that delivers it in the same transaction
+func (r *Repository) IssueToken(ctx context.Context, req IssueTokenRequest) error {
+	return sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		if err := q.InvalidateUserTokens(ctx, db.InvalidateUserTokensParams{
+			UserID:  req.UserID,
+			Purpose: db.UserTokenPurpose(req.Purpose),
+		}); err != nil {
+			return fmt.Errorf("failed to invalidate user tokens: %w", err)
+		}
+
+		if _, err := q.CreateUserToken(ctx, db.CreateUserTokenParams{
+			UserID:    req.UserID,
+			Purpose:   db.UserTokenPurpose(req.Purpose),
+			TokenHash: req.TokenHash,
+			ExpiresAt: req.ExpiresAt,
+		}); err != nil {
+			return fmt.Errorf("failed to create user token: %w", err)
+		}
+
+		if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+			ID:        ids.New(),
+			UserID:    req.UserID,
+			EventType: req.EventType,
+			Payload:   req.Payload,
+		}); err != nil {
+			return fmt.Errorf("failed to insert user outbox event: %w", err)
+		}
+		return nil
+	})
+}
+
+// VerifyEmail redeems an email verification token and marks the user's email verified
+func (r *Repository) VerifyEmail(ctx context.Context, tokenHash string) (*models.User, error) {
+	var user *models.User
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		userID, err := q.ConsumeUserToken(ctx, db.ConsumeUserTokenParams{
+			TokenHash: tokenHash,
+			Purpose:   db.UserTokenPurposeEMAILVERIFICATION,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrInvalidToken
+			}
+			return fmt.Errorf("failed to consume user token: %w", err)
+		}
+
+		dbUser, err := q.MarkUserEmailVerified(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to mark email verified: %w", err)
+		}
+		user = r.dbUserToModel(dbUser)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ResetPassword redeems a password reset token and sets the new password hash.
+// Other outstanding reset tokens and every session are revoked, signing the user out
+// everywhere, the email is marked verified since
+// the user proved they receive mail there, and a PasswordChanged notice is queued
+// with the payload built by notice.
+func (r *Repository) ResetPassword(ctx context.Context, tokenHash, passwordHash string, notice func(*models.User) ([]byte, error)) (*models.User, error) {
+	var user *models.User
+	err := sqlutil.Run(ctx, r.sqlDB, txQueries, func(q *db.Queries) error {
+		userID, err := q.ConsumeUserToken(ctx, db.ConsumeUserTokenParams{
+			TokenHash: tokenHash,
+			Purpose:   db.UserTokenPurposePASSWORDRESET,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrInvalidToken
+			}
+			return fmt.Errorf("failed to consume user token: %w", err)
+		}
+
+		if err := q.SetUserPassword(ctx, db.SetUserPasswordParams{
+			ID:           userID,
+			PasswordHash: sql.NullString{String: passwordHash, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to set user password: %w", err)
+		}
+
+		if err := q.InvalidateUserTokens(ctx, db.InvalidateUserTokensParams{
+			UserID:  userID,
+			Purpose: db.UserTokenPurposePASSWORDRESET,
+		}); err != nil {
+			return fmt.Errorf("failed to invalidate user tokens: %w", err)
+		}
+
+		if err := q.RevokeAllUserSessions(ctx, userID); err != nil {
+			return fmt.Errorf("failed to revoke user sessions: %w", err)
+		}
+
+		dbUser, err := q.MarkUserEmailVerified(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to mark email verified: %w", err)
+		}
+		user = r.dbUserToModel(dbUser)
+
+		payload, err := notice(user)
+		if err != nil {
+			return fmt.Errorf("failed to build password changed payload: %w", err)
+		}
+		if err := q.InsertUserOutbox(ctx, db.InsertUserOutboxParams{
+			ID:        ids.New(),
+			UserID:    userID,
+			EventType: events.PasswordChanged,
+			Payload:   payload,
+		}); err != nil {
+			return fmt.Errorf("failed to insert user outbox event: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// CreateSession records a new session
+func (r *Repository) CreateSession(ctx context.Context, req CreateSessionRequest) (*models.UserSession, error) {
+	session, err := r.queries.CreateUserSession(ctx, db.CreateUserSessionParams{
+		UserID:           req.UserID,
+		DeviceName:       req.DeviceName,
+		UserAgent:        req.UserAgent,
+		IpAddress:        req.IPAddress,
+		AccessTokenHash:  req.AccessTokenHash,
+		AccessExpiresAt:  req.AccessExpiresAt,
+		RefreshTokenHash: req.RefreshTokenHash,
+		ExpiresAt:        req.ExpiresAt,
+		ImpersonatedBy:   sqlutil.ToSqlString(req.ImpersonatedBy),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user session: %w", err)
+	}
+
+	return r.dbSessionToModel(session), nil
+}
+
+// GetActiveSessionByAccessToken returns the unrevoked session whose unexpired access token has this hash
+func (r *Repository) GetActiveSessionByAccessToken(ctx context.Context, accessTokenHash string) (*models.UserSession, error) {
+	session, err := r.queries.GetActiveUserSessionByAccessToken(ctx, accessTokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidSession
+		}
+		return nil, fmt.Errorf("failed to get user session: %w", err)
+	}
+
+	return r.dbSessionToModel(session), nil
+}
+
+// ListSessions returns the user's active sessions, most recently used first
+func (r *Repository) ListSessions(ctx context.Context, userID uuid.UUID) ([]models.UserSession, error) {
+	rows, err := r.queries.ListActiveUserSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user sessions: %w", err)
+	}
+
+	sessions := make([]models.UserSession, len(rows))
+	for i, row := range rows {
+		sessions[i] = *r.dbSessionToModel(row)
+	}
+	return sessions, nil
+}
+
+// TouchSession records that a session was just used from ipAddress
+func (r *Repository) TouchSession(ctx context.Context, id uuid.UUID, ipAddress string) error {
+	if err := r.queries.TouchUserSession(ctx, db.TouchUserSessionParams{
+		ID:        id,
+		IpAddress: ipAddress,
+	}); err != nil {
+		return fmt.Errorf("failed to touch user session: %w", err)
 	}
 	return nil
 }
 
+// RotateSession replaces a session's tokens. A refresh token that was already rotated out
+// revokes its session, which is returned along with ErrRefreshTokenReused.
+func (r *Repository) RotateSession(ctx context.Context, req RotateSessionRequest) (*models.UserSession, error) {
+	session, err := r.queries.RotateUserSessionTokens(ctx, db.RotateUserSessionTokensParams{
+		NewRefreshTokenHash: req.NewRefreshTokenHash,
+		AccessTokenHash:     req.AccessTokenHash,
+		AccessExpiresAt:     req.AccessExpiresAt,
+		ExpiresAt:           req.ExpiresAt,
+		UserAgent:           req.UserAgent,
+		IpAddress:           req.IPAddress,
+		RefreshTokenHash:    req.RefreshTokenHash,
+	})
+	if err == nil {
+		return r.dbSessionToModel(session), nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to rotate user session tokens: %w", err)
+	}
+
+	revoked, err := r.queries.RevokeUserSessionByPreviousRefreshToken(ctx, sql.NullString{String: req.RefreshTokenHash, Valid: true})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("failed to revoke user session: %w", err)
+	}
+	return r.dbSessionToModel(revoked), ErrRefreshTokenReused
+}
+
+// RevokeSession ends one of the user's sessions
+func (r *Repository) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	rows, err := r.queries.RevokeUserSession(ctx, db.RevokeUserSessionParams{
+		ID:     sessionID,
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke user session: %w", err)
+	}
+	if rows == 0 {
+		return ErrSessionNotFound
+	}
+	return nil
+}
+
+// SetNotificationOptOut records or removes a user's opt-out of a notification on one channel
+func (r *Repository) SetNotificationOptOut(ctx context.Context, userID uuid.UUID, notificationType string, channel models.NotificationChannel, optedOut bool) error {
+	if optedOut {
+		if err := r.queries.InsertNotificationOptOut(ctx, db.InsertNotificationOptOutParams{
+			UserID:           userID,
+			NotificationType: notificationType,
+			Channel:          string(channel),
+		}); err != nil {
+			return fmt.Errorf("failed to insert notification opt-out: %w", err)
+		}
+		return nil
+	}
+
+	if err := r.queries.DeleteNotificationOptOut(ctx, db.DeleteNotificationOptOutParams{
+		UserID:           userID,
+		NotificationType: notificationType,
+		Channel:          string(channel),
+	}); err != nil {
+		return fmt.Errorf("failed to delete notification opt-out: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) ListNotificationOptOuts(ctx context.Context, userID uuid.UUID) ([]models.NotificationOptOut, error) {
+	rows, err := r.queries.ListNotificationOptOuts(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification opt-outs: %w", err)
+	}
+
+	optOuts := make([]models.NotificationOptOut, len(rows))
+	for i, row := range rows {
+		optOuts[i] = models.NotificationOptOut{
+			UserID:           row.UserID,
+			NotificationType: row.NotificationType,
+			Channel:          models.NotificationChannel(row.Channel),
+			CreatedAt:        row.CreatedAt,
+		}
+	}
+	return optOuts, nil
+}
+
+// GetDigestFrequency returns how often the user is sent a digest, the default when they
+// haven't chosen
+func (r *Repository) GetDigestFrequency(ctx context.Context, userID uuid.UUID) (models.DigestFrequency, error) {
+	frequency, err := r.queries.GetDigestFrequency(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.DefaultDigestFrequency, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get digest frequency: %w", err)
+	}
+	return models.DigestFrequency(frequency), nil
+}
+
+// SetDigestFrequency records how often the user is sent a digest
+func (r *Repository) SetDigestFrequency(ctx context.Context, userID uuid.UUID, frequency models.DigestFrequency) error {
+	if err := r.queries.UpsertDigestFrequency(ctx, db.UpsertDigestFrequencyParams{
+		UserID:    userID,
+		Frequency: string(frequency),
+	}); err != nil {
+		return fmt.Errorf("failed to set digest frequency: %w", err)
+	}
+	return nil
+}
+
+// AddWatchlistPlayer adds a player to a user's watchlist, or changes their alert window. The
+// user's watchlist is locked while it is checked against MaxWatchlistPlayers.
+func (r *Repository) AddWatchlistPlayer(ctx context.Context, req WatchlistPlayerRequest) (*models.WatchlistPlayer, error) {
+	err := sqlutil.RunLocked(ctx, r.sqlDB, sqlutil.LockClassPlayerWatchlist, req.UserID, txQueries, func(q *db.Queries) error {
+		exists, err := q.GetPlayerExists(ctx, req.PlayerID)
+		if err != nil {
+			return fmt.Errorf("failed to get player: %w", err)
+		}
+		if !exists {
+			return ErrPlayerNotFound
+		}
+
+		others, err := q.CountOtherWatchlistPlayers(ctx, db.CountOtherWatchlistPlayersParams{
+			UserID:   req.UserID,
+			PlayerID: req.PlayerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to count watchlist players: %w", err)
+		}
+		if others >= MaxWatchlistPlayers {
+			return fmt.Errorf("%w: at most %d players", ErrWatchlistFull, MaxWatchlistPlayers)
+		}
+
+		if err := q.UpsertWatchlistPlayer(ctx, db.UpsertWatchlistPlayerParams{
+			UserID:           req.UserID,
+			PlayerID:         req.PlayerID,
+			AlertWithinPicks: int32(req.AlertWithinPicks),
+		}); err != nil {
+			return fmt.Errorf("failed to upsert watchlist player: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the player back through the watchlist for their name
+	players, err := r.ListWatchlist(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range players {
+		if players[i].PlayerID == req.PlayerID {
+			return &players[i], nil
+		}
+	}
+	return nil, ErrWatchlistPlayerNotFound
+}
+
+// RemoveWatchlistPlayer takes a player off a user's watchlist
+func (r *Repository) RemoveWatchlistPlayer(ctx context.Context, userID, playerID uuid.UUID) error {
+	n, err := r.queries.DeleteWatchlistPlayer(ctx, db.DeleteWatchlistPlayerParams{
+		UserID:   userID,
+		PlayerID: playerID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete watchlist player: %w", err)
+	}
+	if n == 0 {
+		return ErrWatchlistPlayerNotFound
+	}
+	return nil
+}
+
+// ListWatchlist retrieves a user's watchlist in the order players were added
+func (r *Repository) ListWatchlist(ctx context.Context, userID uuid.UUID) ([]models.WatchlistPlayer, error) {
+	rows, err := r.queries.ListWatchlistPlayers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list watchlist players: %w", err)
+	}
+
+	result := make([]models.WatchlistPlayer, len(rows))
+	for i, row := range rows {
+		result[i] = models.WatchlistPlayer{
+			UserID:           row.UserID,
+			PlayerID:         row.PlayerID,
+			PlayerName:       row.PlayerName,
+			AlertWithinPicks: int(row.AlertWithinPicks),
+			CreatedAt:        row.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// dbSessionToModel converts a database session to domain model
+func (r *Repository) dbSessionToModel(dbSession db.UserSession) *models.UserSession {
+	return &models.UserSession{
+		ID:             dbSession.ID,
+		UserID:         dbSession.UserID,
+		DeviceName:     dbSession.DeviceName,
+		UserAgent:      dbSession.UserAgent,
+		IPAddress:      dbSession.IpAddress,
+		CreatedAt:      dbSession.CreatedAt,
+		LastSeenAt:     dbSession.LastSeenAt,
+		ExpiresAt:      dbSession.ExpiresAt,
+		RevokedAt:      sqlutil.FromSqlTime(dbSession.RevokedAt),
+		ImpersonatedBy: sqlutil.FromSqlStringPtr(dbSession.ImpersonatedBy),
+	}
+}
+
 // dbUserToModel converts a database user to domain model
 func (r *Repository) dbUserToModel(dbUser db.User) *models.User {
 	return &models.User{
-		ID:        dbUser.ID,
-		Username:  dbUser.Username,
-		Email:     dbUser.Email,
-		CreatedAt: dbUser.CreatedAt,
+		ID:              dbUser.ID,
+		Username:        dbUser.Username,
+		Email:           dbUser.Email,
+		EmailVerifiedAt: sqlutil.FromSqlTime(dbUser.EmailVerifiedAt),
+		AvatarURL:       sqlutil.FromSqlStringPtr(dbUser.AvatarUrl),
+		CreatedAt:       dbUser.CreatedAt,
 	}
 }
@@ -2,12 +2,16 @@ package users
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	userv1 "github.com/mcdev12/dynasty/go/internal/genproto/user/v1"
 	"github.com/mcdev12/dynasty/go/internal/genproto/user/v1/userv1connect"
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
 	"github.com/mcdev12/dynasty/go/internal/models"
+	"github.com/mcdev12/dynasty/go/internal/uuidutil"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -19,6 +23,21 @@ type UsersApp interface {
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 	UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUserRequest) (*models.User, error)
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	SendVerificationEmail(ctx context.Context, userID uuid.UUID) error
+	VerifyEmail(ctx context.Context, token string) (*models.User, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	Login(ctx context.Context, req LoginRequest) (*IssuedSession, error)
+	RefreshSession(ctx context.Context, req RefreshSessionRequest) (*IssuedSession, error)
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]models.UserSession, error)
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	SetNotificationOptOut(ctx context.Context, userID uuid.UUID, notificationType string, channel models.NotificationChannel, optedOut bool) ([]models.NotificationOptOut, error)
+	ListNotificationOptOuts(ctx context.Context, userID uuid.UUID) ([]models.NotificationOptOut, error)
+	GetDigestFrequency(ctx context.Context, userID uuid.UUID) (models.DigestFrequency, error)
+	SetDigestFrequency(ctx context.Context, userID uuid.UUID, frequency models.DigestFrequency) (models.DigestFrequency, error)
+	AddWatchlistPlayer(ctx context.Context, req WatchlistPlayerRequest) (*models.WatchlistPlayer, error)
+	RemoveWatchlistPlayer(ctx context.Context, userID, playerID uuid.UUID) error
+	ListWatchlist(ctx context.Context, userID uuid.UUID) ([]models.WatchlistPlayer, error)
 }
 
 // Service implements the UserService gRPC interface
@@ -54,9 +73,9 @@ func (s *Service) CreateUser(ctx context.Context, req *connect.Request[userv1.Cr
 
 // GetUser retrieves a user by ID
 func (s *Service) GetUser(ctx context.Context, req *connect.Request[userv1.GetUserRequest]) (*connect.Response[userv1.GetUserResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	user, err := s.app.GetUser(ctx, id)
@@ -99,11 +118,14 @@ func (s *Service) GetUserByEmail(ctx context.Context, req *connect.Request[userv
 	}), nil
 }
 
-// UpdateUser updates an existing user
+// UpdateUser updates the signed-in user
 func (s *Service) UpdateUser(ctx context.Context, req *connect.Request[userv1.UpdateUserRequest]) (*connect.Response[userv1.UpdateUserResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
+	}
+	if err := ensureSelf(ctx, id); err != nil {
+		return nil, err
 	}
 
 	appReq := s.protoToUpdateUserRequest(req.Msg)
@@ -120,16 +142,26 @@ func (s *Service) UpdateUser(ctx context.Context, req *connect.Request[userv1.Up
 	}), nil
 }
 
-// DeleteUser deletes a user by ID
+// DeleteUser soft deletes the signed-in user
 func (s *Service) DeleteUser(ctx context.Context, req *connect.Request[userv1.DeleteUserRequest]) (*connect.Response[userv1.DeleteUserResponse], error) {
-	id, err := uuid.Parse(req.Msg.Id)
+	id, err := uuidutil.MustParseOrInvalidArg("id", req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
+	}
+	if err := ensureSelf(ctx, id); err != nil {
+		return nil, err
 	}
 
 	err = s.app.DeleteUser(ctx, id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, ErrUserIsCommissioner):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
 	}
 
 	return connect.NewResponse(&userv1.DeleteUserResponse{
@@ -137,21 +169,470 @@ func (s *Service) DeleteUser(ctx context.Context, req *connect.Request[userv1.De
 	}), nil
 }
 
+// SendVerificationEmail emails a new verification link, superseding earlier ones
+func (s *Service) SendVerificationEmail(ctx context.Context, req *connect.Request[userv1.SendVerificationEmailRequest]) (*connect.Response[userv1.SendVerificationEmailResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.app.SendVerificationEmail(ctx, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrEmailAlreadyVerified):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, ErrTokenResendTooSoon):
+			return nil, connect.NewError(connect.CodeResourceExhausted, err)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	return connect.NewResponse(&userv1.SendVerificationEmailResponse{
+		Success: true,
+	}), nil
+}
+
+// VerifyEmail redeems an emailed verification token
+func (s *Service) VerifyEmail(ctx context.Context, req *connect.Request[userv1.VerifyEmailRequest]) (*connect.Response[userv1.VerifyEmailResponse], error) {
+	user, err := s.app.VerifyEmail(ctx, req.Msg.Token)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.VerifyEmailResponse{
+		User: s.userToProto(user),
+	}), nil
+}
+
+// RequestPasswordReset emails a password reset link. It succeeds for unknown emails too.
+func (s *Service) RequestPasswordReset(ctx context.Context, req *connect.Request[userv1.RequestPasswordResetRequest]) (*connect.Response[userv1.RequestPasswordResetResponse], error) {
+	err := s.app.RequestPasswordReset(ctx, req.Msg.Email)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.RequestPasswordResetResponse{
+		Success: true,
+	}), nil
+}
+
+// ResetPassword redeems an emailed password reset token and sets a new password.
+// The user is signed out of every session.
+func (s *Service) ResetPassword(ctx context.Context, req *connect.Request[userv1.ResetPasswordRequest]) (*connect.Response[userv1.ResetPasswordResponse], error) {
+	err := s.app.ResetPassword(ctx, req.Msg.Token, req.Msg.NewPassword)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.ResetPasswordResponse{
+		Success: true,
+	}), nil
+}
+
+// Login checks a user's password and starts a session on their device
+func (s *Service) Login(ctx context.Context, req *connect.Request[userv1.LoginRequest]) (*connect.Response[userv1.LoginResponse], error) {
+	issued, err := s.app.Login(ctx, LoginRequest{
+		Login:      req.Msg.Login,
+		Password:   req.Msg.Password,
+		DeviceName: req.Msg.DeviceName,
+		UserAgent:  req.Header().Get("User-Agent"),
+		IPAddress:  interceptors.ClientIP(req),
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidCredentials) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.LoginResponse{
+		User:   s.userToProto(issued.User),
+		Tokens: s.sessionTokensToProto(issued),
+	}), nil
+}
+
+// RefreshSession trades a refresh token for new session tokens
+func (s *Service) RefreshSession(ctx context.Context, req *connect.Request[userv1.RefreshSessionRequest]) (*connect.Response[userv1.RefreshSessionResponse], error) {
+	issued, err := s.app.RefreshSession(ctx, RefreshSessionRequest{
+		RefreshToken: req.Msg.RefreshToken,
+		UserAgent:    req.Header().Get("User-Agent"),
+		IPAddress:    interceptors.ClientIP(req),
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidRefreshToken) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.RefreshSessionResponse{
+		Tokens: s.sessionTokensToProto(issued),
+	}), nil
+}
+
+// ListSessions lists the devices a user is signed in on. Users can only list their own sessions.
+func (s *Service) ListSessions(ctx context.Context, req *connect.Request[userv1.ListSessionsRequest]) (*connect.Response[userv1.ListSessionsResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.app.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var currentID uuid.UUID
+	if principal, ok := interceptors.SessionPrincipalFromContext(ctx); ok {
+		currentID = principal.SessionID
+	}
+
+	resp := &userv1.ListSessionsResponse{
+		Sessions: make([]*userv1.UserSession, len(sessions)),
+	}
+	for i, session := range sessions {
+		resp.Sessions[i] = &userv1.UserSession{
+			Id:             session.ID.String(),
+			DeviceName:     session.DeviceName,
+			UserAgent:      session.UserAgent,
+			IpAddress:      session.IPAddress,
+			CreatedAt:      timestamppb.New(session.CreatedAt),
+			LastSeenAt:     timestamppb.New(session.LastSeenAt),
+			ExpiresAt:      timestamppb.New(session.ExpiresAt),
+			Current:        session.ID == currentID,
+			ImpersonatedBy: session.ImpersonatedBy,
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// RevokeSession signs a user out of one of their sessions. Users can only revoke their own sessions.
+func (s *Service) RevokeSession(ctx context.Context, req *connect.Request[userv1.RevokeSessionRequest]) (*connect.Response[userv1.RevokeSessionResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	sessionID, err := uuidutil.MustParseOrInvalidArg("session_id", req.Msg.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	err = s.app.RevokeSession(ctx, userID, sessionID)
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.RevokeSessionResponse{
+		Success: true,
+	}), nil
+}
+
+// SetNotificationOptOut turns a notification off or back on. Users can only change their own notifications.
+func (s *Service) SetNotificationOptOut(ctx context.Context, req *connect.Request[userv1.SetNotificationOptOutRequest]) (*connect.Response[userv1.SetNotificationOptOutResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	optOuts, err := s.app.SetNotificationOptOut(ctx, userID, req.Msg.NotificationType, s.protoToNotificationChannel(req.Msg.Channel), req.Msg.OptedOut)
+	if err != nil {
+		if errors.Is(err, ErrNotificationRequired) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.SetNotificationOptOutResponse{
+		OptOuts: s.notificationOptOutsToProto(optOuts),
+	}), nil
+}
+
+// ListNotificationOptOuts lists the notifications a user has turned off. Users can only list their own.
+func (s *Service) ListNotificationOptOuts(ctx context.Context, req *connect.Request[userv1.ListNotificationOptOutsRequest]) (*connect.Response[userv1.ListNotificationOptOutsResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	optOuts, err := s.app.ListNotificationOptOuts(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.ListNotificationOptOutsResponse{
+		OptOuts: s.notificationOptOutsToProto(optOuts),
+	}), nil
+}
+
+// GetDigestFrequency gets how often a user is sent a league activity digest. Users can only get their own.
+func (s *Service) GetDigestFrequency(ctx context.Context, req *connect.Request[userv1.GetDigestFrequencyRequest]) (*connect.Response[userv1.GetDigestFrequencyResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	frequency, err := s.app.GetDigestFrequency(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.GetDigestFrequencyResponse{
+		Frequency: s.digestFrequencyToProto(frequency),
+	}), nil
+}
+
+// SetDigestFrequency sets how often a user is sent a league activity digest. Users can only change their own.
+func (s *Service) SetDigestFrequency(ctx context.Context, req *connect.Request[userv1.SetDigestFrequencyRequest]) (*connect.Response[userv1.SetDigestFrequencyResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	frequency, err := s.app.SetDigestFrequency(ctx, userID, s.protoToDigestFrequency(req.Msg.Frequency))
+	if err != nil {
+		if errors.Is(err, ErrInvalidDigestFrequency) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&userv1.SetDigestFrequencyResponse{
+		Frequency: s.digestFrequencyToProto(frequency),
+	}), nil
+}
+
+// AddWatchlistPlayer adds a player to a user's watchlist. Users can only change their own watchlist.
+func (s *Service) AddWatchlistPlayer(ctx context.Context, req *connect.Request[userv1.AddWatchlistPlayerRequest]) (*connect.Response[userv1.AddWatchlistPlayerResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	playerID, err := uuidutil.MustParseOrInvalidArg("player_id", req.Msg.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+	player, err := s.app.AddWatchlistPlayer(ctx, WatchlistPlayerRequest{
+		UserID:           userID,
+		PlayerID:         playerID,
+		AlertWithinPicks: int(req.Msg.AlertWithinPicks),
+	})
+	if err != nil {
+		return nil, watchlistErrorCode(err)
+	}
+
+	return connect.NewResponse(&userv1.AddWatchlistPlayerResponse{
+		Player: s.watchlistPlayerToProto(player),
+	}), nil
+}
+
+// RemoveWatchlistPlayer takes a player off a user's watchlist. Users can only change their own watchlist.
+func (s *Service) RemoveWatchlistPlayer(ctx context.Context, req *connect.Request[userv1.RemoveWatchlistPlayerRequest]) (*connect.Response[userv1.RemoveWatchlistPlayerResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	playerID, err := uuidutil.MustParseOrInvalidArg("player_id", req.Msg.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.app.RemoveWatchlistPlayer(ctx, userID, playerID); err != nil {
+		return nil, watchlistErrorCode(err)
+	}
+
+	return connect.NewResponse(&userv1.RemoveWatchlistPlayerResponse{
+		Success: true,
+	}), nil
+}
+
+// ListWatchlist lists a user's watchlist. Users can only list their own.
+func (s *Service) ListWatchlist(ctx context.Context, req *connect.Request[userv1.ListWatchlistRequest]) (*connect.Response[userv1.ListWatchlistResponse], error) {
+	userID, err := uuidutil.MustParseOrInvalidArg("user_id", req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	players, err := s.app.ListWatchlist(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoPlayers := make([]*userv1.WatchlistPlayer, len(players))
+	for i := range players {
+		protoPlayers[i] = s.watchlistPlayerToProto(&players[i])
+	}
+
+	return connect.NewResponse(&userv1.ListWatchlistResponse{
+		Players: protoPlayers,
+	}), nil
+}
+
+// watchlistErrorCode maps watchlist errors to connect codes
+func watchlistErrorCode(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidAlertWindow):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ErrPlayerNotFound), errors.Is(err, ErrWatchlistPlayerNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrWatchlistFull):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// ensureSelf requires the request to be made by userID, signed in with a session or through an
+// internal service acting for them
+func ensureSelf(ctx context.Context, userID uuid.UUID) error {
+	actingUser, ok := interceptors.ActingUserFromContext(ctx)
+	_, hasSession := interceptors.SessionPrincipalFromContext(ctx)
+	_, isService := interceptors.ServicePrincipalFromContext(ctx)
+	if !ok || (!hasSession && !isService) {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("sign in to manage your account"))
+	}
+	if actingUser != userID {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("cannot manage another user's account"))
+	}
+	return nil
+}
+
 // Conversion methods between proto and app layer models
 
+func (s *Service) notificationOptOutsToProto(optOuts []models.NotificationOptOut) []*userv1.NotificationOptOut {
+	protoOptOuts := make([]*userv1.NotificationOptOut, len(optOuts))
+	for i, optOut := range optOuts {
+		protoOptOuts[i] = &userv1.NotificationOptOut{
+			NotificationType: optOut.NotificationType,
+			Channel:          s.notificationChannelToProto(optOut.Channel),
+			CreatedAt:        timestamppb.New(optOut.CreatedAt),
+		}
+	}
+	return protoOptOuts
+}
+
+func (s *Service) watchlistPlayerToProto(player *models.WatchlistPlayer) *userv1.WatchlistPlayer {
+	return &userv1.WatchlistPlayer{
+		PlayerId:         player.PlayerID.String(),
+		PlayerName:       player.PlayerName,
+		AlertWithinPicks: int32(player.AlertWithinPicks),
+		CreatedAt:        timestamppb.New(player.CreatedAt),
+	}
+}
+
+func (s *Service) notificationChannelToProto(channel models.NotificationChannel) userv1.NotificationChannel {
+	switch channel {
+	case models.NotificationChannelEmail:
+		return userv1.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	case models.NotificationChannelPush:
+		return userv1.NotificationChannel_NOTIFICATION_CHANNEL_PUSH
+	default:
+		return userv1.NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+	}
+}
+
+func (s *Service) protoToNotificationChannel(channel userv1.NotificationChannel) models.NotificationChannel {
+	switch channel {
+	case userv1.NotificationChannel_NOTIFICATION_CHANNEL_PUSH:
+		return models.NotificationChannelPush
+	default:
+		return models.NotificationChannelEmail
+	}
+}
+
+func (s *Service) digestFrequencyToProto(frequency models.DigestFrequency) userv1.DigestFrequency {
+	switch frequency {
+	case models.DigestFrequencyOff:
+		return userv1.DigestFrequency_DIGEST_FREQUENCY_OFF
+	case models.DigestFrequencyDaily:
+		return userv1.DigestFrequency_DIGEST_FREQUENCY_DAILY
+	case models.DigestFrequencyWeekly:
+		return userv1.DigestFrequency_DIGEST_FREQUENCY_WEEKLY
+	default:
+		return userv1.DigestFrequency_DIGEST_FREQUENCY_UNSPECIFIED
+	}
+}
+
+func (s *Service) protoToDigestFrequency(frequency userv1.DigestFrequency) models.DigestFrequency {
+	switch frequency {
+	case userv1.DigestFrequency_DIGEST_FREQUENCY_OFF:
+		return models.DigestFrequencyOff
+	case userv1.DigestFrequency_DIGEST_FREQUENCY_DAILY:
+		return models.DigestFrequencyDaily
+	case userv1.DigestFrequency_DIGEST_FREQUENCY_WEEKLY:
+		return models.DigestFrequencyWeekly
+	default:
+		return ""
+	}
+}
+
+func (s *Service) sessionTokensToProto(issued *IssuedSession) *userv1.SessionTokens {
+	return &userv1.SessionTokens{
+		SessionId:        issued.Session.ID.String(),
+		AccessToken:      issued.AccessToken,
+		AccessExpiresAt:  timestamppb.New(issued.AccessExpiresAt),
+		RefreshToken:     issued.RefreshToken,
+		RefreshExpiresAt: timestamppb.New(issued.Session.ExpiresAt),
+	}
+}
+
 func (s *Service) userToProto(user *models.User) *userv1.User {
-	return &userv1.User{
+	protoUser := &userv1.User{
 		Id:        user.ID.String(),
 		Username:  user.Username,
 		Email:     user.Email,
 		CreatedAt: timestamppb.New(user.CreatedAt),
+		AvatarUrl: user.AvatarURL,
+	}
+	if user.EmailVerifiedAt != nil {
+		protoUser.EmailVerifiedAt = timestamppb.New(*user.EmailVerifiedAt)
 	}
+	return protoUser
 }
 
 func (s *Service) protoToCreateUserRequest(proto *userv1.CreateUserRequest) CreateUserRequest {
 	return CreateUserRequest{
 		Username: proto.Username,
 		Email:    proto.Email,
+		Password: proto.GetPassword(),
 	}
 }
 
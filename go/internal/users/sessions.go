@@ -0,0 +1,38 @@
+package users
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mcdev12/dynasty/go/internal/interceptors"
+)
+
+// SessionAuthenticator adapts the App to interceptors.SessionAuthenticator, for the API
+// server's session interceptor and the gateway's WebSocket and REST handlers
+type SessionAuthenticator struct {
+	app *App
+}
+
+// NewSessionAuthenticator creates a SessionAuthenticator looking sessions up with app
+func NewSessionAuthenticator(app *App) SessionAuthenticator {
+	return SessionAuthenticator{app: app}
+}
+
+func (a SessionAuthenticator) AuthenticateSession(ctx context.Context, accessToken, ipAddress string) (*interceptors.SessionPrincipal, error) {
+	session, err := a.app.AuthenticateSession(ctx, accessToken, ipAddress)
+	if err != nil {
+		if errors.Is(err, ErrInvalidSession) {
+			return nil, interceptors.ErrInvalidSession
+		}
+		return nil, err
+	}
+
+	principal := &interceptors.SessionPrincipal{
+		SessionID: session.ID,
+		UserID:    session.UserID,
+	}
+	if session.ImpersonatedBy != nil {
+		principal.ImpersonatedBy = *session.ImpersonatedBy
+	}
+	return principal, nil
+}
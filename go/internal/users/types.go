@@ -1,9 +1,74 @@
 package users
 
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcdev12/dynasty/go/internal/models"
+)
+
+// ErrInvalidToken is returned when an emailed token is unknown, expired or already used
+var ErrInvalidToken = errors.New("token is invalid, expired or already used")
+
+// ErrEmailAlreadyVerified is returned when a verification email is requested for a verified address
+var ErrEmailAlreadyVerified = errors.New("email is already verified")
+
+// ErrTokenResendTooSoon is returned when a token email is requested again within the resend cooldown
+var ErrTokenResendTooSoon = errors.New("an email was sent recently, try again shortly")
+
+// ErrInvalidCredentials is returned when a login doesn't match an account with that password
+var ErrInvalidCredentials = errors.New("invalid login or password")
+
+// ErrInvalidRefreshToken is returned for a refresh token that is unknown, expired, revoked or already used
+var ErrInvalidRefreshToken = errors.New("refresh token is invalid, expired or already used")
+
+// ErrRefreshTokenReused is returned when a refresh token that was already rotated out is
+// presented again. The session it belonged to has been revoked.
+var ErrRefreshTokenReused = errors.New("refresh token was already used, session revoked")
+
+// ErrInvalidSession is returned for an access token that is unknown, expired or revoked
+var ErrInvalidSession = errors.New("session is invalid, expired or revoked")
+
+// ErrSessionNotFound is returned when revoking a session the user doesn't have
+var ErrSessionNotFound = errors.New("session not found")
+
+// ErrNotificationRequired is returned when opting out of a notification users can't turn off
+var ErrNotificationRequired = errors.New("notification can't be turned off")
+
+// ErrInvalidDigestFrequency is returned when setting a digest frequency that doesn't exist
+var ErrInvalidDigestFrequency = errors.New("invalid digest frequency")
+
+// ErrUserIsCommissioner is returned when deleting a user who still commissions a league
+var ErrUserIsCommissioner = errors.New("user commissions a league, hand it over or delete it first")
+
+// ErrPlayerNotFound is returned when watchlisting a player that does not exist
+var ErrPlayerNotFound = errors.New("player not found")
+
+// ErrWatchlistPlayerNotFound is returned when a player is not on the user's watchlist
+var ErrWatchlistPlayerNotFound = errors.New("player is not on the watchlist")
+
+// ErrWatchlistFull is returned when a user's watchlist already has MaxWatchlistPlayers players
+var ErrWatchlistFull = errors.New("watchlist is full")
+
+// ErrInvalidAlertWindow is returned for an alert window outside 1 to MaxWatchlistAlertWithinPicks picks
+var ErrInvalidAlertWindow = errors.New("invalid watchlist alert window")
+
+const (
+	// MaxWatchlistPlayers caps how many players a user's watchlist holds
+	MaxWatchlistPlayers = 100
+	// DefaultWatchlistAlertWithinPicks is how many picks before the user's turn a watchlisted
+	// player raises an alert when the user doesn't choose
+	DefaultWatchlistAlertWithinPicks = 3
+	// MaxWatchlistAlertWithinPicks is the widest alert window, in picks
+	MaxWatchlistAlertWithinPicks = 30
+)
+
 // CreateUserRequest represents the data needed to create a new user
 type CreateUserRequest struct {
 	Username string `json:"username" validate:"required"`
 	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"-"` // optional; only its bcrypt hash is stored
 }
 
 // UpdateUserRequest represents the data that can be updated for a user
@@ -11,3 +76,69 @@ type UpdateUserRequest struct {
 	Username string `json:"username" validate:"required"`
 	Email    string `json:"email" validate:"required,email"`
 }
+
+// IssueTokenRequest records a single-use token and the outbox event that emails it
+type IssueTokenRequest struct {
+	UserID    uuid.UUID
+	Purpose   models.UserTokenPurpose
+	TokenHash string
+	ExpiresAt time.Time
+	EventType string
+	Payload   []byte
+}
+
+// LoginRequest signs a user in on a device
+type LoginRequest struct {
+	Login      string `json:"login"` // username or email
+	Password   string `json:"-"`
+	DeviceName string `json:"device_name"`
+	UserAgent  string `json:"user_agent"`
+	IPAddress  string `json:"ip_address"`
+}
+
+// RefreshSessionRequest trades a session's refresh token for new tokens
+type RefreshSessionRequest struct {
+	RefreshToken string `json:"-"`
+	UserAgent    string `json:"user_agent"`
+	IPAddress    string `json:"ip_address"`
+}
+
+// CreateSessionRequest records a new session with the hashes of its tokens
+type CreateSessionRequest struct {
+	UserID           uuid.UUID
+	DeviceName       string
+	UserAgent        string
+	IPAddress        string
+	AccessTokenHash  string
+	AccessExpiresAt  time.Time
+	RefreshTokenHash string
+	ExpiresAt        time.Time
+	ImpersonatedBy   *string // the operator opening the session as the user, for support
+}
+
+// RotateSessionRequest replaces the tokens of the session holding RefreshTokenHash
+type RotateSessionRequest struct {
+	RefreshTokenHash    string
+	NewRefreshTokenHash string
+	AccessTokenHash     string
+	AccessExpiresAt     time.Time
+	ExpiresAt           time.Time
+	UserAgent           string
+	IPAddress           string
+}
+
+// IssuedSession is a session along with its tokens, which are only known at this point
+type IssuedSession struct {
+	User            *models.User // set on login
+	Session         *models.UserSession
+	AccessToken     string
+	AccessExpiresAt time.Time
+	RefreshToken    string
+}
+
+// WatchlistPlayerRequest adds a player to a user's watchlist, or changes their alert window
+type WatchlistPlayerRequest struct {
+	UserID           uuid.UUID `json:"user_id"`
+	PlayerID         uuid.UUID `json:"player_id"`
+	AlertWithinPicks int       `json:"alert_within_picks"` // 0 for DefaultWatchlistAlertWithinPicks
+}
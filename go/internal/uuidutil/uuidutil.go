@@ -0,0 +1,60 @@
+// Package uuidutil parses the UUIDs services receive in requests. Request validation already
+// rejects most malformed ids, but not every field is annotated and not every caller goes through
+// the validation interceptor, so handlers parse with these instead of uuid.MustParse or a
+// discarded error: a bad id comes back as InvalidArgument naming the field rather than a panic
+// or a zero UUID the request carries on with.
+package uuidutil
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+)
+
+// MustParseOrInvalidArg parses the UUID in the request field named field, returning an
+// InvalidArgument error naming the field when it isn't one
+func MustParseOrInvalidArg(field, s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s %q: %w", field, s, err))
+	}
+	return id, nil
+}
+
+// ParseAll parses every UUID in a repeated request field, failing on the first that isn't one
+// with an InvalidArgument error naming its index
+func ParseAll(field string, ss []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(ss))
+	for i, s := range ss {
+		id, err := MustParseOrInvalidArg(fmt.Sprintf("%s[%d]", field, i), s)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// ParseOptional parses an optional request field, returning nil when it isn't set
+func ParseOptional(field string, s *string) (*uuid.UUID, error) {
+	if s == nil {
+		return nil, nil
+	}
+	id, err := MustParseOrInvalidArg(field, *s)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// ParseID parses the UUID in the request field named field as one of the typed ids in models,
+// like MustParseOrInvalidArg
+func ParseID[T ~[16]byte](field, s string) (T, error) {
+	id, err := MustParseOrInvalidArg(field, s)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return T(id), nil
+}
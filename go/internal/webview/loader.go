@@ -0,0 +1,80 @@
+package webview
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// loader fetches values by key for a single view, dataloader style: the keys asked for are
+// deduplicated and fetched in batches of at most batchSize, the batches concurrently, and what
+// was fetched is remembered so a key asked for again isn't fetched twice.
+type loader[K comparable, V any] struct {
+	fetch     func(ctx context.Context, keys []K) (map[K]V, error)
+	batchSize int
+
+	mu     sync.Mutex
+	loaded map[K]V
+	missed map[K]bool // keys fetch found nothing for
+}
+
+func newLoader[K comparable, V any](batchSize int, fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:     fetch,
+		batchSize: batchSize,
+		loaded:    make(map[K]V),
+		missed:    make(map[K]bool),
+	}
+}
+
+// Load returns the values of keys, leaving out the keys there is nothing for
+func (l *loader[K, V]) Load(ctx context.Context, keys []K) (map[K]V, error) {
+	l.mu.Lock()
+	var pending []K
+	seen := make(map[K]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if _, ok := l.loaded[key]; !ok && !l.missed[key] {
+			pending = append(pending, key)
+		}
+	}
+	l.mu.Unlock()
+
+	g, gctx := errgroup.WithContext(ctx)
+	for start := 0; start < len(pending); start += l.batchSize {
+		batch := pending[start:min(start+l.batchSize, len(pending))]
+		g.Go(func() error {
+			values, err := l.fetch(gctx, batch)
+			if err != nil {
+				return err
+			}
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			for _, key := range batch {
+				if value, ok := values[key]; ok {
+					l.loaded[key] = value
+				} else {
+					l.missed[key] = true
+				}
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	values := make(map[K]V, len(seen))
+	for key := range seen {
+		if value, ok := l.loaded[key]; ok {
+			values[key] = value
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,197 @@
+// Package webview serves the web client's screens in one round trip each, composing the
+// league, draft, fantasy team and player services in process instead of leaving the client
+// to call them one after another.
+package webview
+
+import (
+	"context"
+	"sort"
+
+	"connectrpc.com/connect"
+	draftv1 "github.com/mcdev12/dynasty/go/internal/genproto/draft/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/draft/v1/draftv1connect"
+	fantasyteamv1 "github.com/mcdev12/dynasty/go/internal/genproto/fantasyteam/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/fantasyteam/v1/fantasyteamv1connect"
+	leaguev1 "github.com/mcdev12/dynasty/go/internal/genproto/league/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/league/v1/leaguev1connect"
+	playerv1 "github.com/mcdev12/dynasty/go/internal/genproto/player/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/player/v1/playerv1connect"
+	webviewv1 "github.com/mcdev12/dynasty/go/internal/genproto/webview/v1"
+	"github.com/mcdev12/dynasty/go/internal/genproto/webview/v1/webviewv1connect"
+	"golang.org/x/sync/errgroup"
+)
+
+// playerBatchSize is the most players fetched in one BatchGetPlayers call
+const playerBatchSize = 500
+
+// Service implements the WebViewService gRPC interface. The services it composes are called
+// directly, so the access checks on its own RPCs must cover everything a view reads: each
+// view is scoped to the league of its draft_id or league_id.
+type Service struct {
+	leagueService leaguev1connect.LeagueServiceClient
+	teamService   fantasyteamv1connect.FantasyTeamServiceClient
+	draftService  draftv1connect.DraftServiceClient
+	pickService   draftv1connect.DraftPickServiceClient
+	playerService playerv1connect.PlayerServiceClient
+}
+
+// NewService creates a new web view gRPC service
+func NewService(leagueService leaguev1connect.LeagueServiceClient, teamService fantasyteamv1connect.FantasyTeamServiceClient, draftService draftv1connect.DraftServiceClient, pickService draftv1connect.DraftPickServiceClient, playerService playerv1connect.PlayerServiceClient) *Service {
+	return &Service{
+		leagueService: leagueService,
+		teamService:   teamService,
+		draftService:  draftService,
+		pickService:   pickService,
+		playerService: playerService,
+	}
+}
+
+// Verify that Service implements the WebViewServiceHandler interface
+var _ webviewv1connect.WebViewServiceHandler = (*Service)(nil)
+
+// GetDraftRoom retrieves a draft, its pick board, its league's teams and the players picked
+func (s *Service) GetDraftRoom(ctx context.Context, req *connect.Request[webviewv1.GetDraftRoomRequest]) (*connect.Response[webviewv1.GetDraftRoomResponse], error) {
+	draftID := req.Msg.DraftId
+
+	// The draft and its picks are independent reads, so fetch them together
+	var (
+		draftResp *connect.Response[draftv1.GetDraftResponse]
+		picksResp *connect.Response[draftv1.GetDraftPicksByDraftResponse]
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		draftResp, err = s.draftService.GetDraft(gctx, connect.NewRequest(&draftv1.GetDraftRequest{
+			DraftId: draftID,
+		}))
+		return err
+	})
+	g.Go(func() error {
+		// No page size returns every pick on the board
+		var err error
+		picksResp, err = s.pickService.GetDraftPicksByDraft(gctx, connect.NewRequest(&draftv1.GetDraftPicksByDraftRequest{
+			DraftId: draftID,
+		}))
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	draft := draftResp.Msg.Draft
+	picks := picksResp.Msg.Picks
+
+	// Then the teams and the picked players, which depend on them
+	var (
+		teamsResp *connect.Response[fantasyteamv1.GetFantasyTeamsByLeagueResponse]
+		players   []*playerv1.Player
+	)
+	g, gctx = errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		teamsResp, err = s.teamService.GetFantasyTeamsByLeague(gctx, connect.NewRequest(&fantasyteamv1.GetFantasyTeamsByLeagueRequest{
+			LeagueId: draft.LeagueId,
+		}))
+		return err
+	})
+	g.Go(func() error {
+		playerIDs := make([]string, 0, len(picks))
+		for _, pick := range picks {
+			if pick.PlayerId != "" {
+				playerIDs = append(playerIDs, pick.PlayerId)
+			}
+		}
+		loaded, err := s.playerLoader().Load(gctx, playerIDs)
+		if err != nil {
+			return err
+		}
+		players = sortedPlayers(loaded)
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&webviewv1.GetDraftRoomResponse{
+		Draft:   draft,
+		Picks:   picks,
+		Teams:   teamsResp.Msg.FantasyTeams,
+		Players: players,
+		// Each read carries the sequence it was taken at; every event up to the older of the
+		// two is reflected in both the draft and its board
+		EventSequence: min(draftResp.Msg.EventSequence, picksResp.Msg.EventSequence),
+	}), nil
+}
+
+// GetLeagueHome retrieves a league, its teams and its drafts
+func (s *Service) GetLeagueHome(ctx context.Context, req *connect.Request[webviewv1.GetLeagueHomeRequest]) (*connect.Response[webviewv1.GetLeagueHomeResponse], error) {
+	leagueID := req.Msg.LeagueId
+
+	var (
+		leagueResp *connect.Response[leaguev1.GetLeagueResponse]
+		teamsResp  *connect.Response[fantasyteamv1.GetFantasyTeamsByLeagueResponse]
+		draftsResp *connect.Response[draftv1.ListDraftsForLeagueResponse]
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		leagueResp, err = s.leagueService.GetLeague(gctx, connect.NewRequest(&leaguev1.GetLeagueRequest{
+			Id: leagueID,
+		}))
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		teamsResp, err = s.teamService.GetFantasyTeamsByLeague(gctx, connect.NewRequest(&fantasyteamv1.GetFantasyTeamsByLeagueRequest{
+			LeagueId: leagueID,
+		}))
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		draftsResp, err = s.draftService.ListDraftsForLeague(gctx, connect.NewRequest(&draftv1.ListDraftsForLeagueRequest{
+			LeagueId: leagueID,
+		}))
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&webviewv1.GetLeagueHomeResponse{
+		League: leagueResp.Msg.League,
+		Teams:  teamsResp.Msg.FantasyTeams,
+		Drafts: draftsResp.Msg.Drafts,
+	}), nil
+}
+
+// playerLoader returns a loader of players by ID for one view
+func (s *Service) playerLoader() *loader[string, *playerv1.Player] {
+	return newLoader(playerBatchSize, func(ctx context.Context, ids []string) (map[string]*playerv1.Player, error) {
+		resp, err := s.playerService.BatchGetPlayers(ctx, connect.NewRequest(&playerv1.BatchGetPlayersRequest{
+			Ids: ids,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		players := make(map[string]*playerv1.Player, len(resp.Msg.Players))
+		for _, player := range resp.Msg.Players {
+			players[player.Id] = player
+		}
+		return players, nil
+	})
+}
+
+// sortedPlayers returns loaded players by name, then ID
+func sortedPlayers(loaded map[string]*playerv1.Player) []*playerv1.Player {
+	players := make([]*playerv1.Player, 0, len(loaded))
+	for _, player := range loaded {
+		players = append(players, player)
+	}
+	sort.Slice(players, func(i, j int) bool {
+		if players[i].FullName != players[j].FullName {
+			return players[i].FullName < players[j].FullName
+		}
+		return players[i].Id < players[j].Id
+	})
+	return players
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_team_depth_charts_player;
+
+DROP TABLE IF EXISTS team_depth_charts;
+DROP TABLE IF EXISTS team_bye_weeks;
@@ -0,0 +1,22 @@
+-- Bye week per team and season, ingested from the sport plugin schedule
+CREATE TABLE team_bye_weeks
+(
+    team_id    UUID        NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    season     INTEGER     NOT NULL, -- e.g. 2025
+    bye_week   INTEGER     NOT NULL, -- e.g. 7
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team_id, season)
+);
+
+-- Current depth chart slots per team, replaced wholesale on every sync
+CREATE TABLE team_depth_charts
+(
+    team_id    UUID        NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    player_id  UUID        NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    position   TEXT        NOT NULL, -- depth chart position, e.g. 'QB', 'LWR'
+    depth      INTEGER     NOT NULL, -- 1 = starter
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team_id, position, depth)
+);
+
+CREATE INDEX idx_team_depth_charts_player ON team_depth_charts (player_id);
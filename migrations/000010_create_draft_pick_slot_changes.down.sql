@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_draft_pick_slot_changes_draft;
+
+DROP TABLE IF EXISTS draft_pick_slot_changes;
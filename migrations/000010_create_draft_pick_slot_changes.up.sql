@@ -0,0 +1,14 @@
+-- Audit log of pick slot ownership changes (e.g. picks traded before the draft starts)
+CREATE TABLE draft_pick_slot_changes
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    pick_id      UUID        NOT NULL REFERENCES draft_picks (id) ON DELETE CASCADE,
+    draft_id     UUID        NOT NULL REFERENCES draft (id),
+    from_team_id UUID        NOT NULL REFERENCES fantasy_teams (id),
+    to_team_id   UUID        NOT NULL REFERENCES fantasy_teams (id),
+    reason       TEXT,                 -- e.g. 'Traded for 2026 1st'
+    changed_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_draft_pick_slot_changes_draft
+    ON draft_pick_slot_changes (draft_id, changed_at);
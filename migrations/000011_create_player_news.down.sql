@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_player_news_player_published;
+
+DROP TABLE IF EXISTS player_news;
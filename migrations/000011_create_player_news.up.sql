@@ -0,0 +1,16 @@
+-- Player news items ingested from sport plugins (API or RSS), deduplicated per source
+CREATE TABLE player_news
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    player_id    UUID        NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    source       TEXT        NOT NULL, -- e.g. 'nfl_rss'
+    external_id  TEXT        NOT NULL, -- item ID from the source (GUID or link)
+    headline     TEXT        NOT NULL,
+    body         TEXT,
+    url          TEXT,
+    published_at TIMESTAMPTZ NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (source, external_id)
+);
+
+CREATE INDEX idx_player_news_player_published ON player_news (player_id, published_at DESC);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_draft_slot_selections_deadline;
+DROP TABLE IF EXISTS draft_slot_claims;
+DROP TABLE IF EXISTS draft_slot_selections;
@@ -0,0 +1,28 @@
+-- Pre-draft slot selection: teams take turns, in lottery order, choosing their draft slot
+CREATE TABLE draft_slot_selections
+(
+    draft_id               UUID PRIMARY KEY REFERENCES draft (id) ON DELETE CASCADE,
+    selection_order        JSONB       NOT NULL, -- fantasy team IDs in the order they choose
+    time_per_selection_sec INTEGER     NOT NULL,
+    current_turn           INTEGER     NOT NULL DEFAULT 0, -- index into selection_order
+    turn_deadline          TIMESTAMPTZ,                    -- NULL once selection is complete
+    status                 TEXT        NOT NULL DEFAULT 'IN_PROGRESS' CHECK (status IN ('IN_PROGRESS', 'COMPLETED')),
+    started_at             TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at           TIMESTAMPTZ
+);
+
+CREATE TABLE draft_slot_claims
+(
+    draft_id        UUID        NOT NULL REFERENCES draft_slot_selections (draft_id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id),
+    slot            INTEGER     NOT NULL, -- 1-based position in the draft order
+    auto_assigned   BOOLEAN     NOT NULL DEFAULT FALSE,
+    claimed_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (draft_id, slot),
+    UNIQUE (draft_id, fantasy_team_id)
+);
+
+-- Fast scan for turns whose deadline has passed
+CREATE INDEX idx_draft_slot_selections_deadline
+    ON draft_slot_selections (turn_deadline)
+    WHERE status = 'IN_PROGRESS';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_settings_templates_sport_public;
+DROP INDEX IF EXISTS idx_settings_templates_preset_name;
+DROP INDEX IF EXISTS idx_settings_templates_owner_name;
+
+DROP TABLE IF EXISTS settings_templates;
@@ -0,0 +1,43 @@
+-- Named bundles of league and draft settings that can be applied when creating a league or draft.
+-- Templates without an owner are built-in presets.
+CREATE TABLE settings_templates
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    name            TEXT        NOT NULL,
+    description     TEXT        NOT NULL DEFAULT '',
+    sport_id        TEXT        NOT NULL REFERENCES sports (id) ON DELETE RESTRICT ON UPDATE CASCADE,
+    owner_id        UUID REFERENCES users (id) ON DELETE CASCADE, -- NULL for built-in presets
+    is_public       BOOLEAN     NOT NULL DEFAULT FALSE,
+    league_type     league_type,
+    league_settings JSONB       NOT NULL DEFAULT '{}', -- scoring, lineup and roster slots
+    draft_type      draft_type,
+    draft_settings  JSONB,                             -- rounds and timers, never a draft order
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_settings_templates_owner_name ON settings_templates (owner_id, name) WHERE owner_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_settings_templates_preset_name ON settings_templates (name) WHERE owner_id IS NULL;
+CREATE INDEX idx_settings_templates_sport_public ON settings_templates (sport_id) WHERE is_public;
+
+INSERT INTO settings_templates (name, description, sport_id, is_public, league_type, league_settings, draft_type, draft_settings)
+VALUES ('Superflex Dynasty Startup',
+        'Full PPR superflex dynasty startup with a deep bench and a slow draft.',
+        'nfl', TRUE, 'DYNASTY',
+        '{"scoring": {"reception": 1, "pass_td": 4}, "lineup_slots": [{"name": "QB", "eligible": ["QB"]}, {"name": "RB", "eligible": ["RB"]}, {"name": "RB", "eligible": ["RB"]}, {"name": "WR", "eligible": ["WR"]}, {"name": "WR", "eligible": ["WR"]}, {"name": "TE", "eligible": ["TE"]}, {"name": "FLEX", "eligible": ["RB", "WR", "TE"]}, {"name": "FLEX", "eligible": ["RB", "WR", "TE"]}, {"name": "SUPERFLEX", "eligible": ["QB", "RB", "WR", "TE"]}], "bench_slots": 20, "taxi_slots": 3}',
+        'SNAKE', '{"rounds": 29, "time_per_pick_sec": 28800}'),
+       ('Standard Redraft Snake',
+        'Half PPR one-quarterback redraft league with a live snake draft.',
+        'nfl', TRUE, 'REDRAFT',
+        '{"scoring": {"reception": 0.5, "pass_td": 4}, "lineup_slots": [{"name": "QB", "eligible": ["QB"]}, {"name": "RB", "eligible": ["RB"]}, {"name": "RB", "eligible": ["RB"]}, {"name": "WR", "eligible": ["WR"]}, {"name": "WR", "eligible": ["WR"]}, {"name": "TE", "eligible": ["TE"]}, {"name": "FLEX", "eligible": ["RB", "WR", "TE"]}, {"name": "K", "eligible": ["K"]}, {"name": "DEF", "eligible": ["DEF"]}], "bench_slots": 6}',
+        'SNAKE', '{"rounds": 15, "time_per_pick_sec": 90}'),
+       ('Best Ball Redraft',
+        'Full PPR best-ball league, lineups are set automatically each week.',
+        'nfl', TRUE, 'REDRAFT',
+        '{"best_ball": true, "scoring": {"reception": 1, "pass_td": 4}, "lineup_slots": [{"name": "QB", "eligible": ["QB"]}, {"name": "RB", "eligible": ["RB"]}, {"name": "RB", "eligible": ["RB"]}, {"name": "WR", "eligible": ["WR"]}, {"name": "WR", "eligible": ["WR"]}, {"name": "WR", "eligible": ["WR"]}, {"name": "TE", "eligible": ["TE"]}, {"name": "FLEX", "eligible": ["RB", "WR", "TE"]}], "bench_slots": 10}',
+        'SNAKE', '{"rounds": 18, "time_per_pick_sec": 30}'),
+       ('Auction Redraft',
+        'Full PPR redraft league with a $200 auction.',
+        'nfl', TRUE, 'REDRAFT',
+        '{"scoring": {"reception": 1, "pass_td": 4}, "lineup_slots": [{"name": "QB", "eligible": ["QB"]}, {"name": "RB", "eligible": ["RB"]}, {"name": "RB", "eligible": ["RB"]}, {"name": "WR", "eligible": ["WR"]}, {"name": "WR", "eligible": ["WR"]}, {"name": "TE", "eligible": ["TE"]}, {"name": "FLEX", "eligible": ["RB", "WR", "TE"]}, {"name": "K", "eligible": ["K"]}, {"name": "DEF", "eligible": ["DEF"]}], "bench_slots": 6}',
+        'AUCTION', '{"rounds": 15, "time_per_pick_sec": 30, "budget_per_team": 200, "min_bid_increment": 1, "time_per_nomination_sec": 30}');
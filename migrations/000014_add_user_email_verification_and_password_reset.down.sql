@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_user_outbox_unsent;
+
+DROP TABLE IF EXISTS user_outbox;
+
+DROP INDEX IF EXISTS idx_user_tokens_user_purpose;
+
+DROP TABLE IF EXISTS user_tokens;
+
+DROP TYPE IF EXISTS user_token_purpose;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS email_verified_at,
+    DROP COLUMN IF EXISTS password_hash;
@@ -0,0 +1,34 @@
+-- Passwords and email verification for users created before this migration stay unset
+ALTER TABLE users
+    ADD COLUMN password_hash     TEXT,        -- bcrypt hash, NULL until the user sets a password
+    ADD COLUMN email_verified_at TIMESTAMPTZ; -- NULL until the email address is verified
+
+-- enum for what a single-use token authorizes
+CREATE TYPE user_token_purpose AS ENUM ('EMAIL_VERIFICATION', 'PASSWORD_RESET');
+
+-- Single-use tokens emailed to users. Only a SHA-256 hash of the token is stored.
+CREATE TABLE user_tokens
+(
+    id         UUID PRIMARY KEY            DEFAULT gen_random_uuid(),
+    user_id    UUID               NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    purpose    user_token_purpose NOT NULL,
+    token_hash TEXT               NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ        NOT NULL,
+    used_at    TIMESTAMPTZ,                -- set when redeemed or superseded by a newer token
+    created_at TIMESTAMPTZ        NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_tokens_user_purpose ON user_tokens (user_id, purpose, created_at DESC);
+
+-- Outbox for user emails, drained by the notification worker
+CREATE TABLE user_outbox
+(
+    id         UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    event_type TEXT        NOT NULL, -- e.g. 'EmailVerificationRequested', 'PasswordResetRequested'
+    payload    JSONB       NOT NULL, -- complete event body, token included until sent
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at    TIMESTAMPTZ           -- NULL = not delivered yet
+);
+
+CREATE INDEX idx_user_outbox_unsent ON user_outbox (created_at) WHERE sent_at IS NULL;
@@ -0,0 +1,11 @@
+-- Crosswalk between internal player IDs and the IDs other providers (Sportradar, GSIS,
+-- Sleeper, ESPN, Yahoo) use for the same player. Maintained during player sync.
+CREATE TABLE external_player_ids
+(
+    provider    TEXT        NOT NULL, -- 'sportradar', 'gsis', 'sleeper', 'espn', 'yahoo'
+    external_id TEXT        NOT NULL, -- the provider's ID for the player
+    player_id   UUID        NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, external_id),
+    UNIQUE (player_id, provider)
+);
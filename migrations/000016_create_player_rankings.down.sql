@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_player_rankings_board;
+
+DROP TABLE IF EXISTS player_rankings;
@@ -0,0 +1,16 @@
+-- Preseason rankings and projections per scoring format, used to sort the draft room.
+-- Superflex rankings are kept separately since quarterbacks move up the board.
+CREATE TABLE player_rankings
+(
+    season           VARCHAR(10)      NOT NULL, -- e.g. '2025', matches leagues.season
+    scoring_format   TEXT             NOT NULL, -- 'STANDARD', 'HALF_PPR' or 'PPR'
+    superflex        BOOLEAN          NOT NULL DEFAULT FALSE,
+    player_id        UUID             NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    overall_rank     INTEGER          NOT NULL,
+    projected_points DOUBLE PRECISION,          -- season-long fantasy points in this format
+    source           TEXT             NOT NULL DEFAULT 'consensus',
+    updated_at       TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (season, scoring_format, superflex, player_id)
+);
+
+CREATE INDEX idx_player_rankings_board ON player_rankings (season, scoring_format, superflex, overall_rank);
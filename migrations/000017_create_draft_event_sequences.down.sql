@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS draft_outbox_draft_seq_idx;
+
+ALTER TABLE draft_outbox
+    DROP COLUMN IF EXISTS seq;
+
+DROP TABLE IF EXISTS draft_event_sequences;
@@ -0,0 +1,14 @@
+-- Per-draft event sequence. Every outbox insert takes the next number so readers can tell
+-- whether an event is already reflected in a state snapshot that reports the same counter.
+CREATE TABLE draft_event_sequences
+(
+    draft_id UUID PRIMARY KEY REFERENCES draft (id) ON DELETE CASCADE,
+    last_seq BIGINT NOT NULL DEFAULT 0
+);
+
+-- NULL for events written before sequencing existed
+ALTER TABLE draft_outbox
+    ADD COLUMN seq BIGINT;
+
+CREATE UNIQUE INDEX draft_outbox_draft_seq_idx
+    ON draft_outbox (draft_id, seq);
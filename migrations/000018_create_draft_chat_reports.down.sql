@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_draft_chat_reports_draft;
+
+DROP TABLE IF EXISTS draft_chat_reports;
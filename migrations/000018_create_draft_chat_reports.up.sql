@@ -0,0 +1,17 @@
+-- Draft room chat messages flagged by other managers for the commissioner to review.
+-- Chat itself isn't stored, so the report keeps a copy of the message.
+CREATE TABLE draft_chat_reports
+(
+    id               UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    draft_id         UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    message_id       UUID        NOT NULL,
+    reporter_user_id UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    sender_user_id   UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    message_text     TEXT        NOT NULL,
+    reason           TEXT        NOT NULL DEFAULT '',
+    sent_at          TIMESTAMPTZ NOT NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (message_id, reporter_user_id)
+);
+
+CREATE INDEX idx_draft_chat_reports_draft ON draft_chat_reports (draft_id, created_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_league_settings_changes_effective;
+
+DROP TABLE IF EXISTS league_settings_changes;
@@ -0,0 +1,20 @@
+-- Every version of a league's settings, with who changed it and when it takes effect.
+-- Scoring reads the version effective for the week being scored, so a change can be
+-- scheduled (e.g. for next season) without rescoring past weeks.
+CREATE TABLE league_settings_changes
+(
+    id            UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id     UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    actor_user_id UUID REFERENCES users (id) ON DELETE SET NULL, -- NULL for system changes
+    settings      JSONB       NOT NULL,                          -- the full settings after the change
+    diff          JSONB       NOT NULL DEFAULT '{}',             -- changed keys, {"key": {"old": ..., "new": ...}}
+    effective_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_league_settings_changes_effective ON league_settings_changes (league_id, effective_at DESC, created_at DESC);
+
+-- Existing leagues start their history with the settings they have now
+INSERT INTO league_settings_changes (league_id, actor_user_id, settings, effective_at, created_at)
+SELECT id, commissioner_id, league_settings, created_at, created_at
+FROM leagues;
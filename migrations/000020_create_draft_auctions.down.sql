@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_draft_auctions_nomination_deadline;
+DROP INDEX IF EXISTS idx_auction_lots_ends_at;
+DROP TABLE IF EXISTS auction_proxy_bids;
+DROP INDEX IF EXISTS idx_auction_lots_open;
+DROP TABLE IF EXISTS auction_lots;
+DROP TABLE IF EXISTS auction_nomination_queue;
+DROP TABLE IF EXISTS draft_auctions;
@@ -0,0 +1,64 @@
+-- Auction draft progress: the team whose turn it is to nominate a player and by when
+CREATE TABLE draft_auctions
+(
+    draft_id            UUID PRIMARY KEY REFERENCES draft (id) ON DELETE CASCADE,
+    nomination_turn     INTEGER     NOT NULL DEFAULT 0, -- index into the draft order
+    nomination_deadline TIMESTAMPTZ,                    -- NULL while a player is up for auction
+    started_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Players each team wants to nominate, in order. A team that lets its nomination turn run
+-- out nominates the first of them still available.
+CREATE TABLE auction_nomination_queue
+(
+    draft_id        UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    player_id       UUID        NOT NULL REFERENCES players (id),
+    position        INTEGER     NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (draft_id, fantasy_team_id, player_id),
+    UNIQUE (draft_id, fantasy_team_id, position)
+);
+
+-- A nominated player up for auction, and who is winning at what price
+CREATE TABLE auction_lots
+(
+    id              UUID PRIMARY KEY        DEFAULT gen_random_uuid(),
+    draft_id        UUID           NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    player_id       UUID           NOT NULL REFERENCES players (id),
+    nominated_by    UUID           NOT NULL REFERENCES fantasy_teams (id),
+    status          TEXT           NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'SOLD')),
+    current_price   NUMERIC(10, 2) NOT NULL,
+    leading_team_id UUID           NOT NULL REFERENCES fantasy_teams (id),
+    ends_at         TIMESTAMPTZ    NOT NULL,
+    opened_at       TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    sold_at         TIMESTAMPTZ,
+    pick_id         UUID REFERENCES draft_picks (id), -- the winner's pick the player was drafted with
+    UNIQUE (draft_id, player_id)
+);
+
+-- Only one player is up for auction in a draft at a time
+CREATE UNIQUE INDEX idx_auction_lots_open
+    ON auction_lots (draft_id)
+    WHERE status = 'OPEN';
+
+-- Proxy bids: the most each team will pay for a lot. The system bids on a team's behalf up
+-- to this amount; only the resulting price is ever shown to other teams.
+CREATE TABLE auction_proxy_bids
+(
+    lot_id          UUID           NOT NULL REFERENCES auction_lots (id) ON DELETE CASCADE,
+    fantasy_team_id UUID           NOT NULL REFERENCES fantasy_teams (id),
+    max_amount      NUMERIC(10, 2) NOT NULL,
+    placed_at       TIMESTAMPTZ    NOT NULL DEFAULT NOW(), -- first bid on the lot; the earlier of equal maximums wins
+    updated_at      TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (lot_id, fantasy_team_id)
+);
+
+-- Fast scans for lots and nomination turns whose clock has run out
+CREATE INDEX idx_auction_lots_ends_at
+    ON auction_lots (ends_at)
+    WHERE status = 'OPEN';
+
+CREATE INDEX idx_draft_auctions_nomination_deadline
+    ON draft_auctions (nomination_deadline)
+    WHERE nomination_deadline IS NOT NULL;
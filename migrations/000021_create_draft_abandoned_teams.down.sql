@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS draft_abandoned_teams;
+ALTER TABLE draft_picks DROP COLUMN IF EXISTS forfeited;
@@ -0,0 +1,15 @@
+-- A pick forfeited by a team abandoned mid-draft; it is never made and the draft moves past it
+ALTER TABLE draft_picks ADD COLUMN forfeited BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Teams the commissioner marked as abandoned mid-draft. Their remaining picks are either
+-- auto-picked as soon as they come up or forfeited, and their owners can no longer pick.
+CREATE TABLE draft_abandoned_teams
+(
+    draft_id        UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    pick_handling   TEXT        NOT NULL CHECK (pick_handling IN ('AUTO_PICK', 'SKIP')),
+    reason          TEXT,
+    abandoned_by    UUID REFERENCES users (id),
+    abandoned_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (draft_id, fantasy_team_id)
+);
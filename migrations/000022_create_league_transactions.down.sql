@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_league_transactions_team;
+DROP INDEX IF EXISTS idx_league_transactions_type;
+DROP INDEX IF EXISTS idx_league_transactions_feed;
+
+DROP TABLE IF EXISTS league_transactions;
+
+DROP INDEX IF EXISTS idx_roster_outbox_unsent;
+
+DROP TABLE IF EXISTS roster_outbox;
@@ -0,0 +1,36 @@
+-- Outbox for roster changes made outside the draft, drained into the league transaction log
+CREATE TABLE roster_outbox
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id       UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    event_type      TEXT        NOT NULL, -- e.g. 'RosterPlayerAdded', 'RosterPlayerDropped', 'KeeperDesignated'
+    payload         JSONB       NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at         TIMESTAMPTZ           -- NULL = not projected yet
+);
+
+CREATE INDEX idx_roster_outbox_unsent ON roster_outbox (created_at) WHERE sent_at IS NULL;
+
+-- League activity feed, projected from roster and draft events. Rows are never updated.
+CREATE TABLE league_transactions
+(
+    id                   UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id            UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    transaction_type     TEXT        NOT NULL CHECK (transaction_type IN
+                                                     ('DRAFTED', 'ADDED', 'DROPPED', 'TRADED', 'WAIVER_CLAIM',
+                                                      'KEEPER_DESIGNATED')),
+    fantasy_team_id      UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    counterparty_team_id UUID REFERENCES fantasy_teams (id) ON DELETE SET NULL, -- the other side of a trade
+    player_id            UUID REFERENCES players (id) ON DELETE SET NULL,       -- NULL for draft pick trades
+    draft_id             UUID REFERENCES draft (id) ON DELETE SET NULL,
+    pick_id              UUID REFERENCES draft_picks (id) ON DELETE SET NULL,
+    details              JSONB       NOT NULL DEFAULT '{}',                      -- type-specific extras, e.g. round and pick
+    source_event_id      UUID        NOT NULL UNIQUE,                            -- outbox event it was projected from
+    occurred_at          TIMESTAMPTZ NOT NULL,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_league_transactions_feed ON league_transactions (league_id, occurred_at DESC, id DESC);
+CREATE INDEX idx_league_transactions_type ON league_transactions (league_id, transaction_type, occurred_at DESC, id DESC);
+CREATE INDEX idx_league_transactions_team ON league_transactions (fantasy_team_id, occurred_at DESC, id DESC);
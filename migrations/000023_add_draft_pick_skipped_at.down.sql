@@ -0,0 +1 @@
+ALTER TABLE draft_picks DROP COLUMN IF EXISTS skipped_at;
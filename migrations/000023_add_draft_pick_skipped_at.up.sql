@@ -0,0 +1,4 @@
+-- Set when a pick's clock ran out in a draft that defers picks on timeout. The draft moves
+-- past the pick and the team can still make it late; skipped picks left when every other
+-- pick is made come back on the clock in board order.
+ALTER TABLE draft_picks ADD COLUMN skipped_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+ALTER TABLE draft DROP COLUMN IF EXISTS claimed_until;
+ALTER TABLE draft DROP COLUMN IF EXISTS claimed_by;
@@ -0,0 +1,5 @@
+-- Lease on a draft whose pick deadline has passed, taken by FetchDraftsDueForPick so only
+-- one caller handles it. Moving the deadline releases the lease; otherwise it runs out at
+-- claimed_until and the draft can be claimed again.
+ALTER TABLE draft ADD COLUMN claimed_by TEXT;
+ALTER TABLE draft ADD COLUMN claimed_until TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_league_api_keys_league;
+
+DROP TABLE IF EXISTS league_api_keys;
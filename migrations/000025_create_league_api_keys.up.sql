@@ -0,0 +1,18 @@
+-- API keys a commissioner issues so external tools can work with one league without user
+-- credentials. Only a hash of the key is stored; the key itself is shown once, when issued.
+CREATE TABLE league_api_keys
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id    UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    name         TEXT        NOT NULL,                              -- what the key is for, e.g. 'Draft board export'
+    key_prefix   TEXT        NOT NULL,                              -- start of the key, to tell keys apart
+    key_hash     BYTEA       NOT NULL UNIQUE,                       -- SHA-256 of the key
+    scopes       TEXT[]      NOT NULL CHECK (cardinality(scopes) > 0 AND
+                                             scopes <@ ARRAY ['results:read', 'stats:write', 'webhooks:manage']),
+    created_by   UUID REFERENCES users (id) ON DELETE SET NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ                                        -- NULL = active
+);
+
+CREATE INDEX idx_league_api_keys_league ON league_api_keys (league_id, created_at DESC);
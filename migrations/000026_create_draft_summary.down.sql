@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS draft_summary_create_trigger ON draft;
+DROP FUNCTION IF EXISTS draft_create_summary();
+
+DROP TRIGGER IF EXISTS draft_picks_summary_trigger ON draft_picks;
+DROP FUNCTION IF EXISTS draft_picks_refresh_summary();
+DROP FUNCTION IF EXISTS refresh_draft_summary(UUID);
+
+DROP TABLE IF EXISTS draft_summary;
@@ -0,0 +1,90 @@
+-- Running summary of each draft's board, kept in step with draft_picks by trigger in the same
+-- transaction as every pick change, so reading a draft's progress doesn't scan its picks.
+-- The current pick is the first open slot on the board, whatever the draft's status; readers
+-- only treat it as on the clock while the draft is in progress.
+CREATE TABLE draft_summary
+(
+    draft_id             UUID PRIMARY KEY REFERENCES draft (id) ON DELETE CASCADE,
+    total_picks          INTEGER     NOT NULL DEFAULT 0,
+    picks_made           INTEGER     NOT NULL DEFAULT 0,
+    remaining_picks      INTEGER     NOT NULL DEFAULT 0, -- open and not forfeited
+    current_pick_id      UUID,                           -- NULL once every pick is made or forfeited
+    current_round        INTEGER,
+    current_pick         INTEGER,
+    current_overall_pick INTEGER,
+    on_the_clock_team_id UUID,
+    last_pick_at         TIMESTAMPTZ,
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Recompute one draft's summary from its picks. The summary row is locked first so concurrent
+-- writers to the same draft recompute one after the other, each seeing the other's picks.
+CREATE OR REPLACE FUNCTION refresh_draft_summary(p_draft_id UUID) RETURNS VOID AS $$
+BEGIN
+    -- The draft is gone when its picks are deleted along with it
+    IF NOT EXISTS (SELECT 1 FROM draft WHERE id = p_draft_id) THEN
+        RETURN;
+    END IF;
+
+    INSERT INTO draft_summary (draft_id) VALUES (p_draft_id) ON CONFLICT (draft_id) DO NOTHING;
+    PERFORM 1 FROM draft_summary WHERE draft_id = p_draft_id FOR UPDATE;
+
+    UPDATE draft_summary s
+    SET total_picks          = counts.total_picks,
+        picks_made           = counts.picks_made,
+        remaining_picks      = counts.remaining_picks,
+        current_pick_id      = cp.id,
+        current_round        = cp.round,
+        current_pick         = cp.pick,
+        current_overall_pick = cp.overall_pick,
+        on_the_clock_team_id = cp.team_id,
+        last_pick_at         = counts.last_pick_at,
+        updated_at           = NOW()
+    FROM (SELECT COUNT(*)                                                    AS total_picks,
+                 COUNT(*) FILTER (WHERE player_id IS NOT NULL)               AS picks_made,
+                 COUNT(*) FILTER (WHERE player_id IS NULL AND NOT forfeited) AS remaining_picks,
+                 MAX(picked_at)                                              AS last_pick_at
+          FROM draft_picks
+          WHERE draft_id = p_draft_id) counts
+             LEFT JOIN LATERAL (SELECT id, round, pick, overall_pick, team_id
+                                FROM draft_picks
+                                WHERE draft_id = p_draft_id
+                                  AND player_id IS NULL
+                                  AND NOT forfeited
+                                ORDER BY skipped_at IS NOT NULL, overall_pick
+                                LIMIT 1) cp ON TRUE
+    WHERE s.draft_id = p_draft_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION draft_picks_refresh_summary() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM refresh_draft_summary(OLD.draft_id);
+    ELSE
+        PERFORM refresh_draft_summary(NEW.draft_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER draft_picks_summary_trigger
+AFTER INSERT OR UPDATE OR DELETE ON draft_picks
+FOR EACH ROW
+EXECUTE FUNCTION draft_picks_refresh_summary();
+
+-- Every draft has a summary from the start, even before its picks are generated
+CREATE OR REPLACE FUNCTION draft_create_summary() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO draft_summary (draft_id) VALUES (NEW.id) ON CONFLICT (draft_id) DO NOTHING;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER draft_summary_create_trigger
+AFTER INSERT ON draft
+FOR EACH ROW
+EXECUTE FUNCTION draft_create_summary();
+
+-- Existing drafts
+SELECT refresh_draft_summary(id) FROM draft;
@@ -0,0 +1,21 @@
+-- Signed-in devices. Each session holds a short-lived access token sent with every request
+-- and a refresh token that is rotated every time it is used. Only SHA-256 hashes of the
+-- tokens are stored.
+CREATE TABLE user_sessions
+(
+    id                          UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    user_id                     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    device_name                 TEXT        NOT NULL DEFAULT '', -- chosen by the client, e.g. 'Pixel 8'
+    user_agent                  TEXT        NOT NULL DEFAULT '',
+    ip_address                  TEXT        NOT NULL DEFAULT '', -- where the session was last seen from
+    access_token_hash           TEXT        NOT NULL UNIQUE,
+    access_expires_at           TIMESTAMPTZ NOT NULL,
+    refresh_token_hash          TEXT        NOT NULL UNIQUE,
+    previous_refresh_token_hash TEXT UNIQUE,                     -- presented again only if the token leaked
+    expires_at                  TIMESTAMPTZ NOT NULL,            -- when the refresh token stops working
+    created_at                  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at                TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at                  TIMESTAMPTZ                      -- signed out, revoked or password changed
+);
+
+CREATE INDEX idx_user_sessions_user_active ON user_sessions (user_id, last_seen_at DESC) WHERE revoked_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE roster_players DROP CONSTRAINT IF EXISTS roster_players_lineup_slot_starting;
+
+ALTER TABLE roster_players DROP COLUMN IF EXISTS lineup_slot;
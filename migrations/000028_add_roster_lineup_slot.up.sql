@@ -0,0 +1,7 @@
+-- The league lineup slot a starter fills, by name (e.g. 'FLEX'). Slots are matched by name
+-- rather than position in league_settings, so reordering the slots keeps lineups intact.
+ALTER TABLE roster_players ADD COLUMN lineup_slot TEXT;
+
+ALTER TABLE roster_players
+    ADD CONSTRAINT roster_players_lineup_slot_starting
+        CHECK (lineup_slot IS NULL OR position = 'STARTING');
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_taxi_squad_violations_league;
+DROP INDEX IF EXISTS idx_taxi_squad_violations_open;
+
+DROP TABLE IF EXISTS taxi_squad_violations;
+DROP TABLE IF EXISTS taxi_squad_exemptions;
@@ -0,0 +1,31 @@
+-- Commissioner overrides of the league's taxi squad rules. An exempt roster entry may be moved
+-- onto the taxi squad whatever its player's experience or the promotion deadline, doesn't count
+-- toward the squad's size limit, and is never flagged by the nightly taxi squad check.
+CREATE TABLE taxi_squad_exemptions
+(
+    roster_player_id UUID PRIMARY KEY REFERENCES roster_players (id) ON DELETE CASCADE,
+    granted_by       UUID        REFERENCES users (id) ON DELETE SET NULL,
+    reason           TEXT        NOT NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Taxi squad rule violations found by the nightly check. A violation stays open while the check
+-- keeps finding it and is resolved the first night it doesn't.
+CREATE TABLE taxi_squad_violations
+(
+    id               UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id        UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    fantasy_team_id  UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    roster_player_id UUID REFERENCES roster_players (id) ON DELETE CASCADE, -- NULL for squad-wide violations
+    reason           TEXT        NOT NULL CHECK (reason IN ('OVER_LIMIT', 'INELIGIBLE_EXPERIENCE')),
+    detail           TEXT        NOT NULL,
+    detected_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at      TIMESTAMPTZ                                            -- NULL = open
+);
+
+-- One open violation per team, roster entry and reason, so nightly checks update rather than duplicate
+CREATE UNIQUE INDEX idx_taxi_squad_violations_open
+    ON taxi_squad_violations (fantasy_team_id, COALESCE(roster_player_id, '00000000-0000-0000-0000-000000000000'), reason)
+    WHERE resolved_at IS NULL;
+
+CREATE INDEX idx_taxi_squad_violations_league ON taxi_squad_violations (league_id, detected_at DESC);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_notification_opt_outs;
+DROP TABLE IF EXISTS draft_pick_clock_warnings;
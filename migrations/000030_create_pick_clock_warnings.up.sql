@@ -0,0 +1,22 @@
+-- Pick clock warnings already given. A warning is given once per pick, clock and threshold, so
+-- a timer firing twice doesn't repeat it; a skipped pick that comes back on the clock gets a new
+-- deadline and is warned again.
+CREATE TABLE draft_pick_clock_warnings
+(
+    pick_id           UUID        NOT NULL REFERENCES draft_picks (id) ON DELETE CASCADE,
+    deadline          TIMESTAMPTZ NOT NULL,
+    percent_remaining INTEGER     NOT NULL CHECK (percent_remaining BETWEEN 1 AND 99),
+    warned_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pick_id, deadline, percent_remaining)
+);
+
+-- Notifications a user has turned off, per delivery channel. Account and security emails
+-- can't be turned off.
+CREATE TABLE user_notification_opt_outs
+(
+    user_id           UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    notification_type TEXT        NOT NULL, -- a user outbox event type, e.g. 'PickClockWarning'
+    channel           TEXT        NOT NULL CHECK (channel IN ('EMAIL', 'PUSH')),
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, notification_type, channel)
+);
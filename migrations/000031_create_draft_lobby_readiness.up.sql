@@ -0,0 +1,11 @@
+-- Teams marking themselves ready in the lobby before a draft starts. Teams without a row
+-- haven't marked themselves ready yet.
+CREATE TABLE draft_lobby_readiness
+(
+    draft_id        UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    ready           BOOLEAN     NOT NULL,
+    updated_by      UUID REFERENCES users (id),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (draft_id, fantasy_team_id)
+);
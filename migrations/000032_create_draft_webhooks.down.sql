@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_draft_webhook_deliveries_due;
+
+DROP TABLE IF EXISTS draft_webhook_deliveries;
+
+DROP INDEX IF EXISTS idx_draft_webhooks_draft;
+
+DROP TABLE IF EXISTS draft_webhooks;
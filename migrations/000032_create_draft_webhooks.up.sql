@@ -0,0 +1,37 @@
+-- Endpoints a commissioner registers so third-party draft trackers receive a draft's pick
+-- events. Every delivery is signed with the webhook's secret, which is only shown once.
+CREATE TABLE draft_webhooks
+(
+    id                UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    draft_id          UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    url               TEXT        NOT NULL,
+    secret            TEXT        NOT NULL,                         -- HMAC-SHA256 key deliveries are signed with
+    description       TEXT,                                         -- what the webhook feeds, e.g. 'Stream overlay'
+    created_by        UUID REFERENCES users (id) ON DELETE SET NULL,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_delivered_at TIMESTAMPTZ,
+    last_failed_at    TIMESTAMPTZ,
+    last_error        TEXT                                          -- why the last failed attempt failed
+);
+
+CREATE INDEX idx_draft_webhooks_draft ON draft_webhooks (draft_id);
+
+-- Events queued for a webhook, retried with backoff until delivered or given up on
+CREATE TABLE draft_webhook_deliveries
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    webhook_id      UUID        NOT NULL REFERENCES draft_webhooks (id) ON DELETE CASCADE,
+    event_id        UUID        NOT NULL,                           -- the draft outbox event delivered
+    event_type      TEXT        NOT NULL,
+    body            JSONB       NOT NULL,
+    attempts        INT         NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error      TEXT,
+    delivered_at    TIMESTAMPTZ,
+    failed_at       TIMESTAMPTZ,                                    -- set when retries ran out
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX idx_draft_webhook_deliveries_due ON draft_webhook_deliveries (next_attempt_at)
+    WHERE delivered_at IS NULL AND failed_at IS NULL;
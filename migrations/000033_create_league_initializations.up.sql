@@ -0,0 +1,15 @@
+-- League initialization sagas: creating a league with its teams, draft and draft picks in one
+-- request. The caller picks the id, so retrying a request resumes its saga instead of starting
+-- another. progress records what each completed step created, for resuming and compensating.
+CREATE TABLE league_initializations
+(
+    id              UUID PRIMARY KEY,
+    commissioner_id UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    request         JSONB       NOT NULL,
+    status          TEXT        NOT NULL DEFAULT 'RUNNING' CHECK (status IN
+                                                              ('RUNNING', 'COMPLETED', 'COMPENSATING', 'FAILED')),
+    progress        JSONB       NOT NULL DEFAULT '{}',
+    error           TEXT,                                           -- why the saga failed
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,25 @@
+-- Background jobs shared by every worker. Workers lease due jobs with FOR UPDATE SKIP LOCKED,
+-- run them outside the claiming transaction and retry failures with backoff. A job whose
+-- lease runs out, because its worker died, is claimed again.
+CREATE TABLE jobs
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    kind         TEXT        NOT NULL,                              -- picks the handler, e.g. 'roster.check_taxi_squads'
+    payload      JSONB       NOT NULL DEFAULT '{}',
+    status       TEXT        NOT NULL DEFAULT 'PENDING' CHECK (status IN
+                                                               ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED')),
+    run_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),                -- not claimed before this
+    attempts     INT         NOT NULL DEFAULT 0,
+    max_attempts INT         NOT NULL DEFAULT 10,
+    unique_key   TEXT,                                              -- at most one unfinished job per key
+    locked_until TIMESTAMPTZ,                                       -- lease of the worker running the job
+    last_error   TEXT,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_jobs_unique_key ON jobs (unique_key) WHERE status IN ('PENDING', 'RUNNING');
+
+CREATE INDEX idx_jobs_due ON jobs (run_at) WHERE status IN ('PENDING', 'RUNNING');
+
+CREATE INDEX idx_jobs_finished ON jobs (finished_at) WHERE finished_at IS NOT NULL;
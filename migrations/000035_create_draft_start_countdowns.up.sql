@@ -0,0 +1,10 @@
+-- Countdown checkpoints already announced for drafts about to start. A checkpoint is announced
+-- once per draft and scheduled start, so a draft moved to a new time counts down again.
+CREATE TABLE draft_start_countdowns
+(
+    draft_id       UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    scheduled_at   TIMESTAMPTZ NOT NULL,
+    minutes_before INTEGER     NOT NULL CHECK (minutes_before > 0),
+    announced_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (draft_id, scheduled_at, minutes_before)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS draft_deadline_changes;
+ALTER TABLE draft DROP COLUMN IF EXISTS paused_at;
+ALTER TABLE draft DROP COLUMN IF EXISTS pick_clock_started_at;
@@ -0,0 +1,23 @@
+-- The pick clock stands still while a draft is paused. pick_clock_started_at is when the
+-- clock of the pick on it started, moved on by the length of each pause when the draft
+-- resumes, and paused_at is when the draft was paused. On resume the deadline moves on by the
+-- pause; when the clock settings change while paused it is derived again from the clock's
+-- start and the new settings.
+ALTER TABLE draft ADD COLUMN pick_clock_started_at TIMESTAMPTZ;
+ALTER TABLE draft ADD COLUMN paused_at TIMESTAMPTZ;
+
+-- Audit log of recomputed pick deadlines
+CREATE TABLE draft_deadline_changes
+(
+    id            UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    draft_id      UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    reason        TEXT        NOT NULL CHECK (reason IN ('RESUME', 'SETTINGS_CHANGE')),
+    old_deadline  TIMESTAMPTZ,
+    new_deadline  TIMESTAMPTZ,
+    pick_time_sec INTEGER,                                       -- the clock the new settings give the pick on it
+    actor_user_id UUID REFERENCES users (id) ON DELETE SET NULL, -- NULL for system changes
+    changed_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_draft_deadline_changes_draft
+    ON draft_deadline_changes (draft_id, changed_at);
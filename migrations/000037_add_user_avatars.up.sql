@@ -0,0 +1,3 @@
+-- Avatars are uploaded through the media service, which stores the image and records its URL
+ALTER TABLE users
+    ADD COLUMN avatar_url TEXT; -- NULL until the user uploads an avatar
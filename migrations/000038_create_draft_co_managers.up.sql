@@ -0,0 +1,14 @@
+-- Users besides a team's owner who may make the team's picks in a draft. The league's
+-- commissioner may always pick for any team, to proxy draft for an absent owner.
+CREATE TABLE draft_co_managers
+(
+    draft_id        UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    user_id         UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    added_by        UUID REFERENCES users (id),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (draft_id, fantasy_team_id, user_id)
+);
+
+-- Co-managers belong to the league for tenancy checks
+CREATE INDEX idx_draft_co_managers_user ON draft_co_managers (user_id);
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS league_chat_mutes;
+
+DROP INDEX IF EXISTS idx_league_chat_messages_thread;
+
+DROP TABLE IF EXISTS league_chat_messages;
+
+DROP INDEX IF EXISTS idx_league_chat_threads_board;
+
+DROP TABLE IF EXISTS league_chat_threads;
@@ -0,0 +1,43 @@
+-- League discussion threads, such as the trash talk board and trade block postings. Unlike
+-- draft room chat, threads and their messages are kept.
+CREATE TABLE league_chat_threads
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id       UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    kind            TEXT        NOT NULL CHECK (kind IN ('TRASH_TALK', 'TRADE_BLOCK')),
+    title           TEXT        NOT NULL,
+    created_by      UUID        REFERENCES users (id) ON DELETE SET NULL,
+    pinned          BOOLEAN     NOT NULL DEFAULT FALSE, -- listed ahead of other threads
+    locked          BOOLEAN     NOT NULL DEFAULT FALSE, -- no new messages
+    message_count   INTEGER     NOT NULL DEFAULT 0,
+    last_message_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_league_chat_threads_board ON league_chat_threads (league_id, pinned DESC, last_message_at DESC, id DESC);
+
+-- Messages posted to a league thread. Deleted messages keep their row, without the text,
+-- so replies around them still read in order.
+CREATE TABLE league_chat_messages
+(
+    id         UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    thread_id  UUID        NOT NULL REFERENCES league_chat_threads (id) ON DELETE CASCADE,
+    author_id  UUID        REFERENCES users (id) ON DELETE SET NULL,
+    body       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    deleted_by UUID REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_league_chat_messages_thread ON league_chat_messages (thread_id, created_at DESC, id DESC);
+
+-- Members the commissioner stopped from posting to the league's threads
+CREATE TABLE league_chat_mutes
+(
+    league_id  UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    muted_by   UUID REFERENCES users (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (league_id, user_id)
+);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_trade_wishlist_players_player;
+
+DROP TABLE IF EXISTS trade_wishlist_players;
+
+DROP INDEX IF EXISTS idx_trade_block_listings_player;
+DROP INDEX IF EXISTS idx_trade_block_listings_league;
+
+DROP TABLE IF EXISTS trade_block_listings;
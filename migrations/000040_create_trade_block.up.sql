@@ -0,0 +1,28 @@
+-- Roster players a team is shopping. A listing goes away with the roster entry, so players
+-- who are dropped or traded leave the block.
+CREATE TABLE trade_block_listings
+(
+    roster_player_id UUID PRIMARY KEY REFERENCES roster_players (id) ON DELETE CASCADE,
+    league_id        UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    fantasy_team_id  UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    player_id        UUID        NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    note             TEXT        NOT NULL DEFAULT '', -- e.g. what the team wants back
+    listed_by        UUID REFERENCES users (id) ON DELETE SET NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_trade_block_listings_league ON trade_block_listings (league_id, created_at DESC);
+CREATE INDEX idx_trade_block_listings_player ON trade_block_listings (league_id, player_id);
+
+-- Players a team would like to trade for. Only the team's owner sees its wishlist.
+CREATE TABLE trade_wishlist_players
+(
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    player_id       UUID        NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    note            TEXT        NOT NULL DEFAULT '',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (fantasy_team_id, player_id)
+);
+
+-- Wishlists are looked up by player when the player is put on the block
+CREATE INDEX idx_trade_wishlist_players_player ON trade_wishlist_players (player_id);
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_league_treasury_audit_league;
+
+DROP TABLE IF EXISTS league_treasury_audit;
+
+DROP INDEX IF EXISTS idx_league_treasury_entries_team;
+
+DROP INDEX IF EXISTS idx_league_treasury_entries_league;
+
+DROP TABLE IF EXISTS league_treasury_entries;
+
+DROP TABLE IF EXISTS league_treasuries;
@@ -0,0 +1,43 @@
+-- A league's dues: the entry fee every team owes and how the pot is split between the
+-- finishing places. payouts is [{"place": 1, "label": "Champion", "share_bps": 6000}, ...],
+-- shares in hundredths of a percent of the pot.
+CREATE TABLE league_treasuries
+(
+    league_id       UUID PRIMARY KEY REFERENCES leagues (id) ON DELETE CASCADE,
+    entry_fee_cents BIGINT      NOT NULL DEFAULT 0 CHECK (entry_fee_cents >= 0),
+    currency        TEXT        NOT NULL DEFAULT 'USD',
+    payouts         JSONB       NOT NULL DEFAULT '[]',
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Money moving in and out of a league's pot: dues a team paid in, negative for refunds, and
+-- payouts sent to a team. A team's balance is the entry fee less the dues it paid.
+CREATE TABLE league_treasury_entries
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id       UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    kind            TEXT        NOT NULL CHECK (kind IN ('DUES', 'PAYOUT')),
+    amount_cents    BIGINT      NOT NULL CHECK (amount_cents <> 0),
+    method          TEXT        NOT NULL CHECK (method IN ('MANUAL', 'STRIPE')),
+    stripe_event_id TEXT UNIQUE,                                      -- dedupes redelivered webhooks
+    note            TEXT        NOT NULL DEFAULT '',
+    recorded_by     UUID        REFERENCES users (id) ON DELETE SET NULL, -- NULL for Stripe and trusted callers
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_league_treasury_entries_league ON league_treasury_entries (league_id, created_at DESC);
+CREATE INDEX idx_league_treasury_entries_team ON league_treasury_entries (fantasy_team_id);
+
+-- Every change to a league's treasury, with who made it
+CREATE TABLE league_treasury_audit
+(
+    id            UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id     UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    actor_user_id UUID REFERENCES users (id) ON DELETE SET NULL, -- NULL for Stripe and trusted callers
+    action        TEXT        NOT NULL,                          -- e.g. SETTINGS_UPDATED, DUES_RECORDED
+    details       JSONB       NOT NULL DEFAULT '{}',
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_league_treasury_audit_league ON league_treasury_audit (league_id, created_at DESC);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_player_ownership_owned;
+
+DROP TABLE IF EXISTS player_ownership;
@@ -0,0 +1,15 @@
+-- How widely each player is rostered and started across the platform, recomputed nightly.
+-- Percentages are of the leagues of the player's sport with rosters that haven't finished
+-- or been cancelled. Players on no roster have no row.
+CREATE TABLE player_ownership
+(
+    player_id        UUID PRIMARY KEY REFERENCES players (id) ON DELETE CASCADE,
+    rostered_leagues INTEGER          NOT NULL,
+    started_leagues  INTEGER          NOT NULL,
+    total_leagues    INTEGER          NOT NULL,
+    owned_pct        DOUBLE PRECISION NOT NULL, -- 0 to 100, to one decimal
+    started_pct      DOUBLE PRECISION NOT NULL,
+    computed_at      TIMESTAMPTZ      NOT NULL
+);
+
+CREATE INDEX idx_player_ownership_owned ON player_ownership (owned_pct DESC);
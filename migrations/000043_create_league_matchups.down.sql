@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_league_matchups_away;
+
+DROP INDEX IF EXISTS idx_league_matchups_home;
+
+DROP INDEX IF EXISTS idx_league_matchups_league_week;
+
+DROP TABLE IF EXISTS league_matchups;
@@ -0,0 +1,19 @@
+-- A league's regular season schedule: one row per head-to-head game. A committed schedule
+-- replaces the season's previous one. Teams on a bye week have no row that week.
+CREATE TABLE league_matchups
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id    UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    season       VARCHAR(10) NOT NULL,
+    week         INTEGER     NOT NULL CHECK (week >= 1),
+    home_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    away_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    division     BOOLEAN     NOT NULL DEFAULT FALSE, -- both teams play in the same division
+    rivalry      BOOLEAN     NOT NULL DEFAULT FALSE, -- pinned by a rivalry setting
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (home_team_id <> away_team_id)
+);
+
+CREATE INDEX idx_league_matchups_league_week ON league_matchups (league_id, season, week);
+CREATE UNIQUE INDEX idx_league_matchups_home ON league_matchups (league_id, season, week, home_team_id);
+CREATE UNIQUE INDEX idx_league_matchups_away ON league_matchups (league_id, season, week, away_team_id);
@@ -0,0 +1,10 @@
+-- Owners alerted to problems with their team's lineup ahead of a lineup lock. The check runs
+-- several times before each lock; a team is alerted at most once per lock.
+CREATE TABLE lineup_alerts
+(
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    locks_at        TIMESTAMPTZ NOT NULL,
+    issues          INTEGER     NOT NULL, -- how many problems the check found
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (fantasy_team_id, locks_at)
+);
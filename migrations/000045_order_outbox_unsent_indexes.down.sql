@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_roster_outbox_unsent;
+CREATE INDEX idx_roster_outbox_unsent ON roster_outbox (created_at) WHERE sent_at IS NULL;
+
+DROP INDEX IF EXISTS idx_user_outbox_unsent;
+CREATE INDEX idx_user_outbox_unsent ON user_outbox (created_at) WHERE sent_at IS NULL;
+
+DROP INDEX IF EXISTS draft_outbox_unsent_idx;
+CREATE INDEX draft_outbox_unsent_idx
+    ON draft_outbox (sent_at)
+    WHERE sent_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_draft_outbox_sent_at
+    ON draft_outbox (sent_at)
+    WHERE sent_at IS NULL;
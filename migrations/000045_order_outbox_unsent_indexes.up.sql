@@ -0,0 +1,13 @@
+-- Outbox ids are now UUIDv7s and break ties in the relay's created_at order, so the unsent
+-- queue indexes cover the whole ordering
+DROP INDEX IF EXISTS draft_outbox_unsent_idx;
+DROP INDEX IF EXISTS idx_draft_outbox_sent_at;
+CREATE INDEX draft_outbox_unsent_idx
+    ON draft_outbox (created_at, seq, id)
+    WHERE sent_at IS NULL;
+
+DROP INDEX IF EXISTS idx_user_outbox_unsent;
+CREATE INDEX idx_user_outbox_unsent ON user_outbox (created_at, id) WHERE sent_at IS NULL;
+
+DROP INDEX IF EXISTS idx_roster_outbox_unsent;
+CREATE INDEX idx_roster_outbox_unsent ON roster_outbox (created_at, id) WHERE sent_at IS NULL;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS expansion_selections;
+DROP TABLE IF EXISTS expansion_protected_players;
+DROP TABLE IF EXISTS expansion_protection_lists;
+
+-- Postgres can't drop an enum value, so EXPANSION stays on draft_type; nothing uses it once
+-- the tables above are gone
@@ -0,0 +1,37 @@
+-- Expansion drafts stock teams added to a running league with players from the existing teams
+ALTER TYPE draft_type ADD VALUE IF NOT EXISTS 'EXPANSION';
+
+-- An existing team's protection list for an expansion draft. A team that submits an empty list
+-- still has a row here, so submitted lists can be told apart from missing ones.
+CREATE TABLE expansion_protection_lists
+(
+    draft_id        UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    submitted_by    UUID REFERENCES users (id) ON DELETE SET NULL, -- NULL when submitted by another service
+    submitted_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (draft_id, fantasy_team_id)
+);
+
+-- The players on a protection list, who the expansion teams can't select
+CREATE TABLE expansion_protected_players
+(
+    draft_id        UUID NOT NULL,
+    fantasy_team_id UUID NOT NULL,
+    player_id       UUID NOT NULL REFERENCES players (id),
+    PRIMARY KEY (draft_id, fantasy_team_id, player_id),
+    FOREIGN KEY (draft_id, fantasy_team_id)
+        REFERENCES expansion_protection_lists (draft_id, fantasy_team_id) ON DELETE CASCADE
+);
+
+-- The team each expansion pick took its player from, counted against the most players a team
+-- may lose. Kept apart from the roster, which no longer shows it once the player has moved.
+CREATE TABLE expansion_selections
+(
+    pick_id      UUID PRIMARY KEY REFERENCES draft_picks (id) ON DELETE CASCADE,
+    draft_id     UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    from_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    player_id    UUID        NOT NULL REFERENCES players (id),
+    selected_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_expansion_selections_draft_team ON expansion_selections (draft_id, from_team_id);
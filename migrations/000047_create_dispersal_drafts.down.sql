@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS dispersal_drafts;
+
+-- Postgres can't drop an enum value, so DISPERSAL stays on draft_type; nothing uses it once
+-- the table above is gone
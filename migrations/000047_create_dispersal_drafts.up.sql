@@ -0,0 +1,17 @@
+-- Dispersal drafts share out the players and picks of a team that folds among the league's
+-- remaining teams
+ALTER TYPE draft_type ADD VALUE IF NOT EXISTS 'DISPERSAL';
+
+-- The folded team a dispersal draft pools the players of. completed_at is set once the draft
+-- has finished and the folded team's undrafted players and future picks have been dealt with.
+CREATE TABLE dispersal_drafts
+(
+    draft_id       UUID PRIMARY KEY REFERENCES draft (id) ON DELETE CASCADE,
+    folded_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    created_by     UUID REFERENCES users (id) ON DELETE SET NULL, -- NULL when created by another service
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at   TIMESTAMPTZ
+);
+
+-- A team folds once: it can't be pooled into a second dispersal draft while one is outstanding
+CREATE UNIQUE INDEX idx_dispersal_drafts_open_folded_team ON dispersal_drafts (folded_team_id) WHERE completed_at IS NULL;
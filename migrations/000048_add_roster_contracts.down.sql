@@ -0,0 +1,5 @@
+ALTER TABLE roster_players DROP CONSTRAINT IF EXISTS roster_players_contract_terms;
+
+ALTER TABLE roster_players DROP COLUMN IF EXISTS franchise_tagged;
+ALTER TABLE roster_players DROP COLUMN IF EXISTS contract_years;
+ALTER TABLE roster_players DROP COLUMN IF EXISTS salary;
@@ -0,0 +1,10 @@
+-- Contract terms of a roster entry in leagues with a salary cap. An entry without a salary counts
+-- against the cap at the league's minimum salary; contract_years is how many seasons, counting
+-- the current one, the contract has left.
+ALTER TABLE roster_players ADD COLUMN salary INTEGER;
+ALTER TABLE roster_players ADD COLUMN contract_years INTEGER;
+ALTER TABLE roster_players ADD COLUMN franchise_tagged BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE roster_players
+    ADD CONSTRAINT roster_players_contract_terms
+        CHECK ((salary IS NULL OR salary >= 0) AND (contract_years IS NULL OR contract_years > 0));
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS team_delegate_actions;
+DROP TABLE IF EXISTS team_delegations;
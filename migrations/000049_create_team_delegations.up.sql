@@ -0,0 +1,32 @@
+-- A team's owner handing control of the team to another league member for a while, e.g. while
+-- on vacation. From starts_at until ends_at the delegate may set the team's lineups and make its
+-- draft picks, unless the owner revokes the delegation sooner.
+CREATE TABLE team_delegations
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    delegate_id     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    starts_at       TIMESTAMPTZ NOT NULL,
+    ends_at         TIMESTAMPTZ NOT NULL,
+    created_by      UUID REFERENCES users (id) ON DELETE SET NULL, -- NULL when created by another service
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at      TIMESTAMPTZ,
+    CONSTRAINT team_delegations_period CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_team_delegations_team ON team_delegations (fantasy_team_id, starts_at DESC);
+CREATE INDEX idx_team_delegations_delegate ON team_delegations (delegate_id);
+
+-- What delegates did while standing in for a team's owner, so the owner can see who changed what
+CREATE TABLE team_delegate_actions
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    delegation_id   UUID        NOT NULL REFERENCES team_delegations (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    delegate_id     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    action          TEXT        NOT NULL, -- e.g. LINEUP_UPDATED, PICK_MADE
+    details         JSONB       NOT NULL DEFAULT '{}',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_team_delegate_actions_team ON team_delegate_actions (fantasy_team_id, created_at DESC);
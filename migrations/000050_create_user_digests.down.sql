@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_digests;
+DROP TABLE IF EXISTS user_digest_preferences;
@@ -0,0 +1,20 @@
+-- How often a user is sent a digest of what happened in their leagues. Users without a row get
+-- the weekly digest.
+CREATE TABLE user_digest_preferences
+(
+    user_id    UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    frequency  TEXT        NOT NULL CHECK (frequency IN ('OFF', 'DAILY', 'WEEKLY')),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Digests already sent, one per user and period, so a digest run that is retried doesn't send
+-- anyone the same digest twice
+CREATE TABLE user_digests
+(
+    user_id      UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    period_end   TIMESTAMPTZ NOT NULL,
+    frequency    TEXT        NOT NULL CHECK (frequency IN ('DAILY', 'WEEKLY')),
+    transactions INTEGER     NOT NULL, -- how many transactions the digest covered
+    sent_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, period_end)
+);
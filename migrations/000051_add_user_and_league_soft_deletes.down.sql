@@ -0,0 +1,33 @@
+ALTER TABLE team_delegate_actions
+    DROP CONSTRAINT team_delegate_actions_delegate_id_fkey,
+    ADD CONSTRAINT team_delegate_actions_delegate_id_fkey
+        FOREIGN KEY (delegate_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE team_delegations
+    DROP CONSTRAINT team_delegations_delegate_id_fkey,
+    ADD CONSTRAINT team_delegations_delegate_id_fkey
+        FOREIGN KEY (delegate_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE league_matchups
+    DROP CONSTRAINT league_matchups_league_id_fkey,
+    ADD CONSTRAINT league_matchups_league_id_fkey
+        FOREIGN KEY (league_id) REFERENCES leagues (id) ON DELETE CASCADE;
+ALTER TABLE league_treasury_audit
+    DROP CONSTRAINT league_treasury_audit_league_id_fkey,
+    ADD CONSTRAINT league_treasury_audit_league_id_fkey
+        FOREIGN KEY (league_id) REFERENCES leagues (id) ON DELETE CASCADE;
+ALTER TABLE league_treasury_entries
+    DROP CONSTRAINT league_treasury_entries_league_id_fkey,
+    ADD CONSTRAINT league_treasury_entries_league_id_fkey
+        FOREIGN KEY (league_id) REFERENCES leagues (id) ON DELETE CASCADE;
+ALTER TABLE league_settings_changes
+    DROP CONSTRAINT league_settings_changes_league_id_fkey,
+    ADD CONSTRAINT league_settings_changes_league_id_fkey
+        FOREIGN KEY (league_id) REFERENCES leagues (id) ON DELETE CASCADE;
+ALTER TABLE league_transactions
+    DROP CONSTRAINT league_transactions_league_id_fkey,
+    ADD CONSTRAINT league_transactions_league_id_fkey
+        FOREIGN KEY (league_id) REFERENCES leagues (id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_leagues_commissioner_active;
+
+ALTER TABLE leagues DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
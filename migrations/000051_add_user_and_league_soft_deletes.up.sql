@@ -0,0 +1,38 @@
+-- Deleting a user or league only marks it deleted, so the drafts, transactions and other
+-- history that refer to it stay intact and it can be restored. Deleted rows are left out of
+-- the default queries.
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE leagues ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_leagues_commissioner_active ON leagues (commissioner_id) WHERE deleted_at IS NULL;
+
+-- History is never removed along with the user or league it refers to; a row can only be
+-- removed for good once its history has been dealt with
+ALTER TABLE league_transactions
+    DROP CONSTRAINT league_transactions_league_id_fkey,
+    ADD CONSTRAINT league_transactions_league_id_fkey
+        FOREIGN KEY (league_id) REFERENCES leagues (id) ON DELETE RESTRICT;
+ALTER TABLE league_settings_changes
+    DROP CONSTRAINT league_settings_changes_league_id_fkey,
+    ADD CONSTRAINT league_settings_changes_league_id_fkey
+        FOREIGN KEY (league_id) REFERENCES leagues (id) ON DELETE RESTRICT;
+ALTER TABLE league_treasury_entries
+    DROP CONSTRAINT league_treasury_entries_league_id_fkey,
+    ADD CONSTRAINT league_treasury_entries_league_id_fkey
+        FOREIGN KEY (league_id) REFERENCES leagues (id) ON DELETE RESTRICT;
+ALTER TABLE league_treasury_audit
+    DROP CONSTRAINT league_treasury_audit_league_id_fkey,
+    ADD CONSTRAINT league_treasury_audit_league_id_fkey
+        FOREIGN KEY (league_id) REFERENCES leagues (id) ON DELETE RESTRICT;
+ALTER TABLE league_matchups
+    DROP CONSTRAINT league_matchups_league_id_fkey,
+    ADD CONSTRAINT league_matchups_league_id_fkey
+        FOREIGN KEY (league_id) REFERENCES leagues (id) ON DELETE RESTRICT;
+ALTER TABLE team_delegations
+    DROP CONSTRAINT team_delegations_delegate_id_fkey,
+    ADD CONSTRAINT team_delegations_delegate_id_fkey
+        FOREIGN KEY (delegate_id) REFERENCES users (id) ON DELETE RESTRICT;
+ALTER TABLE team_delegate_actions
+    DROP CONSTRAINT team_delegate_actions_delegate_id_fkey,
+    ADD CONSTRAINT team_delegate_actions_delegate_id_fkey
+        FOREIGN KEY (delegate_id) REFERENCES users (id) ON DELETE RESTRICT;
@@ -0,0 +1,17 @@
+-- Drafts a league held before it moved to the platform, imported so its history and analytics
+-- start from its first season rather than the season it moved. The draft and its picks are
+-- stored like any other completed draft; this records where they came from.
+CREATE TABLE historical_drafts
+(
+    draft_id    UUID PRIMARY KEY REFERENCES draft (id) ON DELETE CASCADE,
+    league_id   UUID        NOT NULL REFERENCES leagues (id),
+    season      VARCHAR(10) NOT NULL,                          -- the season the draft was held for, e.g. '2021'
+    draft_type  draft_type  NOT NULL,
+    source      TEXT,                                          -- where the results came from, e.g. 'sleeper'
+    imported_by UUID REFERENCES users (id) ON DELETE SET NULL, -- NULL when imported by another service
+    imported_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A league held one draft of each type a season, so importing it again is refused rather than
+-- doubling its history
+CREATE UNIQUE INDEX idx_historical_drafts_league_season_type ON historical_drafts (league_id, season, draft_type);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS draft_pause_vote_ballots;
+DROP TABLE IF EXISTS draft_pause_votes;
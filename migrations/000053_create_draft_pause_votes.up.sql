@@ -0,0 +1,31 @@
+-- Votes by a draft's managers to pause it, for leagues whose drafts allow them. A vote stays
+-- open for the draft's vote window and passes once more than its threshold of the teams still
+-- drafting vote for it, at which point the orchestrator pauses the draft.
+CREATE TABLE draft_pause_votes
+(
+    id                 UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    draft_id           UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    initiating_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    initiated_by       UUID REFERENCES users (id) ON DELETE SET NULL,
+    reason             TEXT,
+    eligible_teams     INT         NOT NULL, -- teams still drafting when the vote opened
+    votes_needed       INT         NOT NULL,
+    status             TEXT        NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'PASSED', 'FAILED')),
+    opened_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    closes_at          TIMESTAMPTZ NOT NULL,
+    closed_at          TIMESTAMPTZ
+);
+
+-- A draft has at most one vote open at a time
+CREATE UNIQUE INDEX idx_draft_pause_votes_open ON draft_pause_votes (draft_id) WHERE status = 'OPEN';
+
+-- One ballot per team in each vote; a team changing its mind replaces its ballot
+CREATE TABLE draft_pause_vote_ballots
+(
+    vote_id         UUID        NOT NULL REFERENCES draft_pause_votes (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    in_favor        BOOLEAN     NOT NULL,
+    cast_by         UUID REFERENCES users (id) ON DELETE SET NULL,
+    cast_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (vote_id, fantasy_team_id)
+);
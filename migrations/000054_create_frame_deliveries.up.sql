@@ -0,0 +1,19 @@
+-- Delivery of the draft room frames the gateway requires clients to acknowledge, per user.
+-- A frame no client acknowledged within the gateway's retries is recorded UNACKED and may fall
+-- back to a push notification, which the notification worker skips if a late ack marked the
+-- frame ACKED in the meantime.
+CREATE TABLE frame_deliveries
+(
+    event_id    UUID        NOT NULL, -- the draft event the frame carried
+    user_id     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    draft_id    UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    event_type  TEXT        NOT NULL,
+    status      TEXT        NOT NULL CHECK (status IN ('ACKED', 'UNACKED')),
+    attempts    INT         NOT NULL,
+    sent_at     TIMESTAMPTZ NOT NULL,
+    acked_at    TIMESTAMPTZ,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX idx_frame_deliveries_draft ON frame_deliveries (draft_id);
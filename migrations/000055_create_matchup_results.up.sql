@@ -0,0 +1,11 @@
+-- Final scores of regular season matchups, recorded from the live scoring stream once every
+-- player in a matchup has finished the week. Stat corrections published after that replace the
+-- score; redelivered or out of order updates older than the recorded one are ignored.
+CREATE TABLE matchup_results
+(
+    matchup_id   UUID PRIMARY KEY REFERENCES league_matchups (id) ON DELETE CASCADE,
+    home_points  DOUBLE PRECISION NOT NULL,
+    away_points  DOUBLE PRECISION NOT NULL,
+    finalized_at TIMESTAMPTZ      NOT NULL, -- when the scoring update was published
+    recorded_at  TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
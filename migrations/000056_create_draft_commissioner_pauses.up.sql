@@ -0,0 +1,8 @@
+-- Drafts paused because their commissioner had no connection to the draft room for the
+-- draft's commissioner_disconnect_pause wait. A draft is only resumed automatically when its
+-- commissioner reconnects while it has a row here; any resume clears it.
+CREATE TABLE draft_commissioner_pauses
+(
+    draft_id  UUID PRIMARY KEY REFERENCES draft (id) ON DELETE CASCADE,
+    paused_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1 @@
+ALTER TABLE draft DROP COLUMN IF EXISTS league_settings_snapshot;
@@ -0,0 +1,5 @@
+-- The league settings a draft runs under, snapshotted when it first starts. Roster limits,
+-- lineup slots, scoring format and the salary cap are read from the snapshot until the draft
+-- completes, so edits to the league mid-draft can't break the picks already made. Drafts that
+-- haven't started, and those started before the snapshot was taken, read the league's settings.
+ALTER TABLE draft ADD COLUMN league_settings_snapshot JSONB;
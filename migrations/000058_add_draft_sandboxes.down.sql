@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_draft_sandbox_of;
+ALTER TABLE draft DROP COLUMN IF EXISTS sandbox_of_draft_id;
@@ -0,0 +1,6 @@
+-- A sandbox draft rehearses another draft of the same league: a copy of its settings, order and
+-- keeper slots the commissioner runs end to end to check them, then discards. Its picks never
+-- reach the league's rosters or transaction log and its teams' owners are never notified.
+ALTER TABLE draft ADD COLUMN sandbox_of_draft_id UUID;
+
+CREATE INDEX idx_draft_sandbox_of ON draft (sandbox_of_draft_id) WHERE sandbox_of_draft_id IS NOT NULL;
@@ -0,0 +1,10 @@
+-- The calendar invite last sent to league members for each scheduled draft. Every invite sent
+-- for the draft bumps its sequence, so calendars replace the event they already have with the
+-- new time rather than adding another.
+CREATE TABLE draft_calendar_invites
+(
+    draft_id     UUID        NOT NULL PRIMARY KEY REFERENCES draft (id) ON DELETE CASCADE,
+    sequence     INTEGER     NOT NULL DEFAULT 0,
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    sent_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
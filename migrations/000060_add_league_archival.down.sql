@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS league_archive_notices;
+DROP VIEW IF EXISTS league_activity;
+ALTER TABLE leagues DROP COLUMN IF EXISTS archived_at;
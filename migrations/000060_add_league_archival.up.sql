@@ -0,0 +1,25 @@
+-- Leagues with no activity for a season are archived: kept whole, but read-only and left out of
+-- the default lists until their commissioner restores them.
+ALTER TABLE leagues ADD COLUMN archived_at TIMESTAMPTZ;
+
+-- When each league was last active: changed itself, or had a transaction, a draft change or a
+-- chat message
+CREATE VIEW league_activity AS
+SELECT l.id AS league_id,
+       GREATEST(l.updated_at, t.last_at, d.last_at, c.last_at) AS last_activity_at
+FROM leagues l
+         LEFT JOIN LATERAL (SELECT MAX(occurred_at) AS last_at FROM league_transactions WHERE league_id = l.id) t ON TRUE
+         LEFT JOIN LATERAL (SELECT MAX(updated_at) AS last_at FROM draft WHERE league_id = l.id) d ON TRUE
+         LEFT JOIN LATERAL (SELECT MAX(last_message_at) AS last_at FROM league_chat_threads WHERE league_id = l.id) c ON TRUE;
+
+-- Commissioners warned that their league has gone quiet and is archived after archive_after.
+-- A notice is dropped when the league shows activity again, so the next quiet season starts over.
+CREATE TABLE league_archive_notices
+(
+    league_id        UUID        NOT NULL PRIMARY KEY REFERENCES leagues (id) ON DELETE CASCADE,
+    last_activity_at TIMESTAMPTZ NOT NULL,
+    notified_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    archive_after    TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_league_archive_notices_due ON league_archive_notices (archive_after);
@@ -0,0 +1,8 @@
+UPDATE draft
+SET settings = settings - 'snake' - 'auction' - 'rookie' ||
+               COALESCE(settings -> 'snake', settings -> 'auction', settings -> 'rookie', '{}'::JSONB);
+
+UPDATE settings_templates
+SET draft_settings = draft_settings - 'snake' - 'auction' - 'rookie' ||
+                     COALESCE(draft_settings -> 'snake', draft_settings -> 'auction', draft_settings -> 'rookie', '{}'::JSONB)
+WHERE draft_settings IS NOT NULL;
@@ -0,0 +1,34 @@
+-- Settings of a single draft type move under a key of their own: "snake", "auction" or
+-- "rookie". The legacy third_round_reversal flag becomes the THIRD_ROUND_REVERSAL order mode.
+UPDATE draft
+SET settings = settings - 'order_mode' - 'third_round_reversal' - 'budget_per_team' - 'min_bid_increment' - 'time_per_nomination_sec' ||
+               CASE draft_type
+                   WHEN 'AUCTION' THEN jsonb_build_object('auction', jsonb_strip_nulls(jsonb_build_object(
+                           'budget_per_team', settings -> 'budget_per_team',
+                           'min_bid_increment', settings -> 'min_bid_increment',
+                           'time_per_nomination_sec', settings -> 'time_per_nomination_sec')))
+                   WHEN 'SNAKE' THEN jsonb_build_object('snake', jsonb_strip_nulls(jsonb_build_object(
+                           'order_mode', COALESCE(settings -> 'order_mode',
+                                                  CASE WHEN (settings ->> 'third_round_reversal')::BOOLEAN THEN '"THIRD_ROUND_REVERSAL"'::JSONB END))))
+                   WHEN 'ROOKIE' THEN jsonb_build_object('rookie', jsonb_strip_nulls(jsonb_build_object(
+                           'order_mode', COALESCE(settings -> 'order_mode',
+                                                  CASE WHEN (settings ->> 'third_round_reversal')::BOOLEAN THEN '"THIRD_ROUND_REVERSAL"'::JSONB END))))
+                   ELSE '{}'::JSONB
+                   END;
+
+UPDATE settings_templates
+SET draft_settings = draft_settings - 'order_mode' - 'third_round_reversal' - 'budget_per_team' - 'min_bid_increment' - 'time_per_nomination_sec' ||
+                     CASE draft_type
+                         WHEN 'AUCTION' THEN jsonb_build_object('auction', jsonb_strip_nulls(jsonb_build_object(
+                                 'budget_per_team', draft_settings -> 'budget_per_team',
+                                 'min_bid_increment', draft_settings -> 'min_bid_increment',
+                                 'time_per_nomination_sec', draft_settings -> 'time_per_nomination_sec')))
+                         WHEN 'SNAKE' THEN jsonb_build_object('snake', jsonb_strip_nulls(jsonb_build_object(
+                                 'order_mode', COALESCE(draft_settings -> 'order_mode',
+                                                        CASE WHEN (draft_settings ->> 'third_round_reversal')::BOOLEAN THEN '"THIRD_ROUND_REVERSAL"'::JSONB END))))
+                         WHEN 'ROOKIE' THEN jsonb_build_object('rookie', jsonb_strip_nulls(jsonb_build_object(
+                                 'order_mode', COALESCE(draft_settings -> 'order_mode',
+                                                        CASE WHEN (draft_settings ->> 'third_round_reversal')::BOOLEAN THEN '"THIRD_ROUND_REVERSAL"'::JSONB END))))
+                         ELSE '{}'::JSONB
+                         END
+WHERE draft_settings IS NOT NULL;
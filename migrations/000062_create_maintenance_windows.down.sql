@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS draft_maintenance_pauses;
+DROP TABLE IF EXISTS draft_maintenance_notices;
+DROP TABLE IF EXISTS maintenance_windows;
@@ -0,0 +1,33 @@
+-- Deploy windows scheduled through the admin API. No draft starts or resumes from shortly
+-- before a window opens until it closes; drafts in progress are told it's coming, then paused
+-- while it's open. A cancelled window no longer blocks anything.
+CREATE TABLE maintenance_windows
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    starts_at    TIMESTAMPTZ NOT NULL,
+    ends_at      TIMESTAMPTZ NOT NULL,
+    message      TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    cancelled_at TIMESTAMPTZ,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_maintenance_windows_ends_at ON maintenance_windows (ends_at) WHERE cancelled_at IS NULL;
+
+-- The maintenance notices sent to each draft's room, so a window is announced to a draft once
+CREATE TABLE draft_maintenance_notices
+(
+    window_id    UUID        NOT NULL REFERENCES maintenance_windows (id) ON DELETE CASCADE,
+    draft_id     UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    announced_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (window_id, draft_id)
+);
+
+-- Drafts paused for a maintenance window. They're resumed once the window closes or is
+-- cancelled while they still have a row here; any resume clears it.
+CREATE TABLE draft_maintenance_pauses
+(
+    draft_id  UUID PRIMARY KEY REFERENCES draft (id) ON DELETE CASCADE,
+    window_id UUID        NOT NULL REFERENCES maintenance_windows (id) ON DELETE CASCADE,
+    paused_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
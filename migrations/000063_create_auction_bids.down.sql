@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS player_auction_values;
+DROP INDEX IF EXISTS idx_auction_bids_draft_player;
+DROP TABLE IF EXISTS auction_bids;
@@ -0,0 +1,32 @@
+-- Every bid placed in an auction draft, in the order it was placed: the nominating team's
+-- opening bid and each proxy bid after it, with the price and leader the lot was left at.
+-- Kept after the draft for post-draft analysis and settling disputes. Drafts auctioned before
+-- this table existed have no bid history.
+CREATE TABLE auction_bids
+(
+    id              BIGSERIAL PRIMARY KEY,
+    lot_id          UUID           NOT NULL REFERENCES auction_lots (id) ON DELETE CASCADE,
+    draft_id        UUID           NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    player_id       UUID           NOT NULL REFERENCES players (id),
+    fantasy_team_id UUID           NOT NULL REFERENCES fantasy_teams (id),
+    kind            TEXT           NOT NULL CHECK (kind IN ('OPENING', 'PROXY')),
+    max_amount      NUMERIC(10, 2) NOT NULL, -- the team's maximum as of this bid
+    price           NUMERIC(10, 2) NOT NULL, -- the lot's price once the bid was applied
+    leading_team_id UUID           NOT NULL REFERENCES fantasy_teams (id),
+    placed_at       TIMESTAMPTZ    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_auction_bids_draft_player ON auction_bids (draft_id, player_id, id);
+
+-- Average winning bid per player and season across auction drafts, scaled to a $200 budget so
+-- leagues with different budgets compare. Refreshed as lots are sold and shown alongside the
+-- rankings in the draft room. Sandbox drafts don't count.
+CREATE TABLE player_auction_values
+(
+    season        VARCHAR(10)    NOT NULL, -- matches leagues.season
+    player_id     UUID           NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    average_price NUMERIC(10, 2) NOT NULL,
+    sales         INTEGER        NOT NULL,
+    updated_at    TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (season, player_id)
+);
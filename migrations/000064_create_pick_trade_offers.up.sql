@@ -0,0 +1,24 @@
+-- Offers of the pick on the clock made while a draft runs, for drafts that allow live pick
+-- trades. The pick clock stops while an offer is open: the draft's deadline is pushed back by
+-- the negotiation window, and set to what was left on the clock once the offer closes.
+-- Accepted offers move the picks through draft_pick_slot_changes like any other reassignment.
+CREATE TABLE pick_trade_offers
+(
+    id                 UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    draft_id           UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    pick_id            UUID        NOT NULL REFERENCES draft_picks (id) ON DELETE CASCADE,
+    from_team_id       UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    to_team_id         UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    requested_pick_id  UUID REFERENCES draft_picks (id) ON DELETE CASCADE, -- NULL when the pick is given away
+    message            TEXT,
+    proposed_by        UUID REFERENCES users (id) ON DELETE SET NULL,
+    status             TEXT        NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'ACCEPTED', 'DECLINED', 'WITHDRAWN', 'EXPIRED')),
+    clock_remaining_ms BIGINT, -- left on the pick clock when the offer was made; NULL for untimed picks
+    opened_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at         TIMESTAMPTZ NOT NULL,
+    closed_at          TIMESTAMPTZ,
+    responded_by       UUID REFERENCES users (id) ON DELETE SET NULL
+);
+
+-- A draft has at most one offer open at a time, since only the pick on the clock is offered
+CREATE UNIQUE INDEX idx_pick_trade_offers_open ON pick_trade_offers (draft_id) WHERE status = 'OPEN';
@@ -0,0 +1,29 @@
+-- Players a user follows across every draft they take part in. While one of their drafts runs,
+-- a watchlisted player still on the board raises an alert once the pick on the clock is within
+-- alert_within_picks picks of the user's team, and another if a different team drafts them.
+CREATE TABLE player_watchlists
+(
+    user_id            UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    player_id          UUID        NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    alert_within_picks INTEGER     NOT NULL DEFAULT 3 CHECK (alert_within_picks BETWEEN 1 AND 30),
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, player_id)
+);
+
+-- Alerts raised from watchlists. A player approaches each of the user's picks once, and is
+-- drafted once, so an alert is never raised twice.
+CREATE TABLE watchlist_alerts
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    draft_id        UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    user_id         UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE, -- the user's team in the draft
+    player_id       UUID        NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    kind            TEXT        NOT NULL CHECK (kind IN ('APPROACHING', 'DRAFTED')),
+    pick_id         UUID        NOT NULL REFERENCES draft_picks (id) ON DELETE CASCADE, -- the user's next pick when APPROACHING, the pick that took the player when DRAFTED
+    picks_away      INTEGER, -- picks left before the user's, APPROACHING only
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, player_id, pick_id, kind)
+);
+
+CREATE INDEX idx_watchlist_alerts_draft ON watchlist_alerts (draft_id);
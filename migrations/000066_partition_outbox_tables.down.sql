@@ -0,0 +1,94 @@
+-- draft_outbox
+ALTER TABLE draft_outbox RENAME TO draft_outbox_partitioned;
+ALTER INDEX draft_outbox_pkey RENAME TO draft_outbox_partitioned_pkey;
+DROP INDEX draft_outbox_unsent_idx;
+DROP INDEX draft_outbox_draft_idx;
+DROP INDEX draft_outbox_draft_seq_idx;
+DROP TRIGGER draft_outbox_notify_trigger ON draft_outbox_partitioned;
+
+CREATE TABLE draft_outbox
+(
+    id         UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    draft_id   UUID        NOT NULL REFERENCES draft (id),
+    event_type TEXT        NOT NULL,
+    payload    JSONB       NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at    TIMESTAMPTZ,
+    seq        BIGINT
+);
+
+INSERT INTO draft_outbox (id, draft_id, event_type, payload, created_at, sent_at, seq)
+SELECT id, draft_id, event_type, payload, created_at, sent_at, seq
+FROM draft_outbox_partitioned;
+
+DROP TABLE draft_outbox_partitioned;
+
+CREATE INDEX draft_outbox_unsent_idx
+    ON draft_outbox (created_at, seq, id)
+    WHERE sent_at IS NULL;
+CREATE INDEX draft_outbox_draft_idx
+    ON draft_outbox (draft_id, created_at);
+CREATE UNIQUE INDEX draft_outbox_draft_seq_idx
+    ON draft_outbox (draft_id, seq);
+
+CREATE OR REPLACE FUNCTION notify_outbox_event() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('draft_outbox_events', NEW.id::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER draft_outbox_notify_trigger
+AFTER INSERT ON draft_outbox
+FOR EACH ROW
+EXECUTE FUNCTION notify_outbox_event();
+
+-- user_outbox
+ALTER TABLE user_outbox RENAME TO user_outbox_partitioned;
+ALTER INDEX user_outbox_pkey RENAME TO user_outbox_partitioned_pkey;
+DROP INDEX idx_user_outbox_unsent;
+
+CREATE TABLE user_outbox
+(
+    id         UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    event_type TEXT        NOT NULL,
+    payload    JSONB       NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at    TIMESTAMPTZ
+);
+
+INSERT INTO user_outbox (id, user_id, event_type, payload, created_at, sent_at)
+SELECT id, user_id, event_type, payload, created_at, sent_at
+FROM user_outbox_partitioned;
+
+DROP TABLE user_outbox_partitioned;
+
+CREATE INDEX idx_user_outbox_unsent ON user_outbox (created_at, id) WHERE sent_at IS NULL;
+
+-- roster_outbox
+ALTER TABLE roster_outbox RENAME TO roster_outbox_partitioned;
+ALTER INDEX roster_outbox_pkey RENAME TO roster_outbox_partitioned_pkey;
+DROP INDEX idx_roster_outbox_unsent;
+
+CREATE TABLE roster_outbox
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id       UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    event_type      TEXT        NOT NULL,
+    payload         JSONB       NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at         TIMESTAMPTZ
+);
+
+INSERT INTO roster_outbox (id, league_id, fantasy_team_id, event_type, payload, created_at, sent_at)
+SELECT id, league_id, fantasy_team_id, event_type, payload, created_at, sent_at
+FROM roster_outbox_partitioned;
+
+DROP TABLE roster_outbox_partitioned;
+
+CREATE INDEX idx_roster_outbox_unsent ON roster_outbox (created_at, id) WHERE sent_at IS NULL;
+
+DROP FUNCTION IF EXISTS retire_monthly_partition(TEXT, DATE, BOOLEAN);
+DROP FUNCTION IF EXISTS create_monthly_partition(TEXT, DATE);
@@ -0,0 +1,173 @@
+-- The outbox tables keep every event ever written and grow with each season, so they're
+-- partitioned by month of created_at. The partition maintenance job creates the months ahead
+-- and retires the months past retention; a default partition takes rows for a month that
+-- wasn't created in time. Primary keys include created_at, as partitioned tables require.
+
+-- Create the partition of parent holding the UTC month of month, named <parent>_pYYYYMM.
+-- Creating a month that exists is a no-op.
+CREATE OR REPLACE FUNCTION create_monthly_partition(parent TEXT, month DATE) RETURNS TEXT AS $$
+DECLARE
+    month_start DATE := date_trunc('month', month)::date;
+    partition   TEXT := format('%s_p%s', parent, to_char(month_start, 'YYYYMM'));
+BEGIN
+    EXECUTE format('CREATE TABLE IF NOT EXISTS %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)',
+                   partition, parent,
+                   month_start::timestamp AT TIME ZONE 'UTC',
+                   (month_start + INTERVAL '1 month')::timestamp AT TIME ZONE 'UTC');
+    RETURN partition;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Detach the partition of parent holding the UTC month of month, dropping it when drop_table
+-- is set and otherwise leaving it as a standalone table to archive. A month with rows not yet
+-- sent is left attached. Returns whether the partition was retired.
+CREATE OR REPLACE FUNCTION retire_monthly_partition(parent TEXT, month DATE, drop_table BOOLEAN) RETURNS BOOLEAN AS $$
+DECLARE
+    partition TEXT := format('%s_p%s', parent, to_char(date_trunc('month', month), 'YYYYMM'));
+    unsent    BOOLEAN;
+BEGIN
+    IF NOT EXISTS (SELECT 1
+                   FROM pg_inherits
+                   WHERE inhrelid = to_regclass(partition)
+                     AND inhparent = to_regclass(parent)) THEN
+        RETURN FALSE;
+    END IF;
+
+    EXECUTE format('SELECT EXISTS (SELECT 1 FROM %I WHERE sent_at IS NULL)', partition) INTO unsent;
+    IF unsent THEN
+        RETURN FALSE;
+    END IF;
+
+    EXECUTE format('ALTER TABLE %I DETACH PARTITION %I', parent, partition);
+    IF drop_table THEN
+        EXECUTE format('DROP TABLE %I', partition);
+    END IF;
+    RETURN TRUE;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create the partitions of parent from the month of its oldest row in source through
+-- months_ahead months from now
+CREATE OR REPLACE FUNCTION create_outbox_partitions(parent TEXT, source TEXT, months_ahead INTEGER) RETURNS VOID AS $$
+DECLARE
+    oldest TIMESTAMPTZ;
+    month  TIMESTAMP;
+BEGIN
+    EXECUTE format('SELECT MIN(created_at) FROM %I', source) INTO oldest;
+    FOR month IN SELECT generate_series(date_trunc('month', COALESCE(oldest, NOW()) AT TIME ZONE 'UTC'),
+                                        date_trunc('month', NOW() AT TIME ZONE 'UTC') + make_interval(months => months_ahead),
+                                        INTERVAL '1 month')
+        LOOP
+            PERFORM create_monthly_partition(parent, month::date);
+        END LOOP;
+END;
+$$ LANGUAGE plpgsql;
+
+-- draft_outbox
+ALTER TABLE draft_outbox RENAME TO draft_outbox_unpartitioned;
+ALTER INDEX draft_outbox_pkey RENAME TO draft_outbox_unpartitioned_pkey;
+DROP INDEX draft_outbox_unsent_idx;
+DROP INDEX draft_outbox_draft_idx;
+DROP INDEX draft_outbox_draft_seq_idx;
+DROP TRIGGER draft_outbox_notify_trigger ON draft_outbox_unpartitioned;
+
+CREATE TABLE draft_outbox
+(
+    id         UUID        NOT NULL DEFAULT gen_random_uuid(),
+    draft_id   UUID        NOT NULL REFERENCES draft (id),
+    event_type TEXT        NOT NULL, -- e.g. 'PickMade', 'DraftStarted'
+    payload    JSONB       NOT NULL, -- complete event body
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at    TIMESTAMPTZ,          -- NULL = not forwarded yet
+    seq        BIGINT,               -- NULL for events written before sequencing existed
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE draft_outbox_default PARTITION OF draft_outbox DEFAULT;
+SELECT create_outbox_partitions('draft_outbox', 'draft_outbox_unpartitioned', 3);
+
+INSERT INTO draft_outbox (id, draft_id, event_type, payload, created_at, sent_at, seq)
+SELECT id, draft_id, event_type, payload, created_at, sent_at, seq
+FROM draft_outbox_unpartitioned;
+
+DROP TABLE draft_outbox_unpartitioned;
+
+CREATE INDEX draft_outbox_unsent_idx
+    ON draft_outbox (created_at, seq, id)
+    WHERE sent_at IS NULL;
+CREATE INDEX draft_outbox_draft_idx
+    ON draft_outbox (draft_id, created_at);
+-- No longer unique, since unique indexes must include created_at; draft_event_sequences
+-- still hands out each draft's numbers once
+CREATE INDEX draft_outbox_draft_seq_idx
+    ON draft_outbox (draft_id, seq);
+
+-- The relay looks events up by id and created_at, so the notification carries both
+CREATE OR REPLACE FUNCTION notify_outbox_event() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('draft_outbox_events', json_build_object('id', NEW.id, 'created_at', NEW.created_at)::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER draft_outbox_notify_trigger
+AFTER INSERT ON draft_outbox
+FOR EACH ROW
+EXECUTE FUNCTION notify_outbox_event();
+
+-- user_outbox
+ALTER TABLE user_outbox RENAME TO user_outbox_unpartitioned;
+ALTER INDEX user_outbox_pkey RENAME TO user_outbox_unpartitioned_pkey;
+DROP INDEX idx_user_outbox_unsent;
+
+CREATE TABLE user_outbox
+(
+    id         UUID        NOT NULL DEFAULT gen_random_uuid(),
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    event_type TEXT        NOT NULL, -- e.g. 'EmailVerificationRequested', 'PasswordResetRequested'
+    payload    JSONB       NOT NULL, -- complete event body, token included until sent
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at    TIMESTAMPTZ,          -- NULL = not delivered yet
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE user_outbox_default PARTITION OF user_outbox DEFAULT;
+SELECT create_outbox_partitions('user_outbox', 'user_outbox_unpartitioned', 3);
+
+INSERT INTO user_outbox (id, user_id, event_type, payload, created_at, sent_at)
+SELECT id, user_id, event_type, payload, created_at, sent_at
+FROM user_outbox_unpartitioned;
+
+DROP TABLE user_outbox_unpartitioned;
+
+CREATE INDEX idx_user_outbox_unsent ON user_outbox (created_at, id) WHERE sent_at IS NULL;
+
+-- roster_outbox
+ALTER TABLE roster_outbox RENAME TO roster_outbox_unpartitioned;
+ALTER INDEX roster_outbox_pkey RENAME TO roster_outbox_unpartitioned_pkey;
+DROP INDEX idx_roster_outbox_unsent;
+
+CREATE TABLE roster_outbox
+(
+    id              UUID        NOT NULL DEFAULT gen_random_uuid(),
+    league_id       UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    fantasy_team_id UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    event_type      TEXT        NOT NULL, -- e.g. 'RosterPlayerAdded', 'RosterPlayerDropped', 'KeeperDesignated'
+    payload         JSONB       NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at         TIMESTAMPTZ,          -- NULL = not projected yet
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE roster_outbox_default PARTITION OF roster_outbox DEFAULT;
+SELECT create_outbox_partitions('roster_outbox', 'roster_outbox_unpartitioned', 3);
+
+INSERT INTO roster_outbox (id, league_id, fantasy_team_id, event_type, payload, created_at, sent_at)
+SELECT id, league_id, fantasy_team_id, event_type, payload, created_at, sent_at
+FROM roster_outbox_unpartitioned;
+
+DROP TABLE roster_outbox_unpartitioned;
+
+CREATE INDEX idx_roster_outbox_unsent ON roster_outbox (created_at, id) WHERE sent_at IS NULL;
+
+DROP FUNCTION create_outbox_partitions(TEXT, TEXT, INTEGER);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS draft_recaps;
@@ -0,0 +1,16 @@
+-- The recap of a completed draft, generated once when it completes: the picks made furthest
+-- after and before where the players' ranks put them, and every team's grade from its players'
+-- projections. It's stored as worked out, so the recap doesn't shift as rankings are updated
+-- during the season.
+CREATE TABLE draft_recaps
+(
+    draft_id        UUID PRIMARY KEY REFERENCES draft (id) ON DELETE CASCADE,
+    league_id       UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    season          VARCHAR(10) NOT NULL, -- the rankings the picks were measured against
+    scoring_format  TEXT        NOT NULL,
+    superflex       BOOLEAN     NOT NULL,
+    best_values     JSONB       NOT NULL, -- biggest value first
+    biggest_reaches JSONB       NOT NULL, -- biggest reach first
+    team_grades     JSONB       NOT NULL, -- best graded first
+    generated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
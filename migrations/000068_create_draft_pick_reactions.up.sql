@@ -0,0 +1,10 @@
+-- Emoji reactions left on made picks from the draft room, one of each kind per user and pick.
+-- The room sees the counts per pick as they change, and the recap page shows them afterwards.
+CREATE TABLE draft_pick_reactions
+(
+    pick_id    UUID        NOT NULL REFERENCES draft_picks (id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    reaction   TEXT        NOT NULL CHECK (reaction IN ('🔥', '🤔')),
+    reacted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pick_id, user_id, reaction)
+);
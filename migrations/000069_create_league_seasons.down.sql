@@ -0,0 +1,4 @@
+ALTER TABLE league_transactions DROP COLUMN IF EXISTS season_id;
+ALTER TABLE league_matchups DROP COLUMN IF EXISTS season_id;
+ALTER TABLE draft DROP COLUMN IF EXISTS season_id;
+DROP TABLE IF EXISTS league_seasons;
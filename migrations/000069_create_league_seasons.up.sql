@@ -0,0 +1,62 @@
+-- A league's seasons, one a year. leagues.season is the year of the latest one. A league has at
+-- most one active season at a time: the previous one has to be completed before the next starts.
+CREATE TABLE league_seasons
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    league_id    UUID        NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    year         VARCHAR(10) NOT NULL, -- e.g. '2025', as in leagues.season
+    status       TEXT        NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'COMPLETED')),
+    started_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,          -- NULL while active, and for seasons completed before they were recorded
+    UNIQUE (league_id, year)
+);
+
+CREATE UNIQUE INDEX idx_league_seasons_active ON league_seasons (league_id) WHERE status = 'ACTIVE';
+
+-- Every league's current season, active unless the league is over
+INSERT INTO league_seasons (league_id, year, status, started_at, completed_at)
+SELECT id,
+       season,
+       CASE WHEN status IN ('COMPLETED', 'CANCELLED') THEN 'COMPLETED' ELSE 'ACTIVE' END,
+       created_at,
+       CASE WHEN status IN ('COMPLETED', 'CANCELLED') THEN updated_at END
+FROM leagues;
+
+-- Earlier seasons the league has a schedule or an imported draft for
+INSERT INTO league_seasons (league_id, year, status, started_at)
+SELECT league_id, season, 'COMPLETED', MIN(created_at)
+FROM (SELECT league_id, season, created_at FROM league_matchups
+      UNION ALL
+      SELECT league_id, season, imported_at FROM historical_drafts) earlier
+GROUP BY league_id, season
+ON CONFLICT (league_id, year) DO NOTHING;
+
+-- The season a draft was held, a matchup played or a transaction made in. Rows from before
+-- seasons were recorded belong to their league's current season, unless they say otherwise.
+ALTER TABLE draft ADD COLUMN season_id UUID REFERENCES league_seasons (id) ON DELETE SET NULL;
+ALTER TABLE league_matchups ADD COLUMN season_id UUID REFERENCES league_seasons (id) ON DELETE SET NULL;
+ALTER TABLE league_transactions ADD COLUMN season_id UUID REFERENCES league_seasons (id) ON DELETE SET NULL;
+
+UPDATE draft d
+SET season_id = s.id
+FROM league_seasons s
+         JOIN leagues l ON l.id = s.league_id
+WHERE s.league_id = d.league_id
+  AND s.year = COALESCE((SELECT hd.season FROM historical_drafts hd WHERE hd.draft_id = d.id), l.season);
+
+UPDATE league_matchups m
+SET season_id = s.id
+FROM league_seasons s
+WHERE s.league_id = m.league_id
+  AND s.year = m.season;
+
+UPDATE league_transactions t
+SET season_id = COALESCE((SELECT d.season_id FROM draft d WHERE d.id = t.draft_id), s.id)
+FROM league_seasons s
+         JOIN leagues l ON l.id = s.league_id
+WHERE s.league_id = t.league_id
+  AND s.year = l.season;
+
+CREATE INDEX idx_draft_season ON draft (season_id);
+CREATE INDEX idx_league_matchups_season ON league_matchups (season_id);
+CREATE INDEX idx_league_transactions_season ON league_transactions (season_id, occurred_at DESC, id DESC);
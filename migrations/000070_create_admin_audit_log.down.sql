@@ -0,0 +1,2 @@
+ALTER TABLE user_sessions DROP COLUMN IF EXISTS impersonated_by;
+DROP TABLE IF EXISTS admin_audit_log;
@@ -0,0 +1,22 @@
+-- What platform operators did through the admin service: impersonations, forced draft
+-- completions and re-driven jobs and deliveries. Operators sign in with their own tokens,
+-- not as users, so they are named by the operator their token belongs to.
+CREATE TABLE admin_audit_log
+(
+    id          UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    operator    TEXT        NOT NULL,
+    action      TEXT        NOT NULL,                  -- e.g. 'user.impersonate', 'draft.force_complete'
+    target_type TEXT        NOT NULL,                  -- 'user', 'draft', 'job' or 'webhook_delivery'
+    target_id   UUID        NOT NULL,
+    reason      TEXT        NOT NULL,                  -- why, e.g. the support ticket
+    details     JSONB       NOT NULL DEFAULT '{}',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_admin_audit_log_created ON admin_audit_log (created_at DESC);
+
+CREATE INDEX idx_admin_audit_log_target ON admin_audit_log (target_id, created_at DESC);
+
+-- Sessions an operator opened as the user to support them. They can't be refreshed, and the
+-- user sees them among their sessions.
+ALTER TABLE user_sessions ADD COLUMN impersonated_by TEXT;
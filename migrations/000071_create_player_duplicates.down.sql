@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS player_merges;
+DROP TABLE IF EXISTS player_duplicates;
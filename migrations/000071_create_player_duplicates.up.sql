@@ -0,0 +1,36 @@
+-- Likely duplicate player records found by the nightly dedupe job: players of a sport with the
+-- same name and either the same birth date or the same team, usually created by syncs from two
+-- providers. Pairs that match on all three are merged right away; the rest wait here for review.
+-- player_id is the older record, the one a merge keeps.
+CREATE TABLE player_duplicates
+(
+    id              UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    sport_id        TEXT        NOT NULL REFERENCES sports (id) ON DELETE CASCADE,
+    player_id       UUID        NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    duplicate_id    UUID        NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    same_birth_date BOOLEAN     NOT NULL,
+    same_team       BOOLEAN     NOT NULL,
+    status          TEXT        NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'DISMISSED')),
+    detected_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    dismissed_at    TIMESTAMPTZ,
+    UNIQUE (player_id, duplicate_id),
+    CHECK (player_id <> duplicate_id)
+);
+
+CREATE INDEX idx_player_duplicates_pending ON player_duplicates (detected_at) WHERE status = 'PENDING';
+CREATE INDEX idx_player_duplicates_duplicate ON player_duplicates (duplicate_id);
+
+-- Players merged into another, kept so syncs that still send the merged player's external ID
+-- update the player it was merged into instead of creating it again
+CREATE TABLE player_merges
+(
+    duplicate_id UUID PRIMARY KEY,
+    canonical_id UUID        NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    sport_id     TEXT        NOT NULL,
+    external_id  TEXT        NOT NULL, -- the merged player's players.external_id
+    full_name    TEXT        NOT NULL,
+    merged_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (sport_id, external_id)
+);
+
+CREATE INDEX idx_player_merges_canonical ON player_merges (canonical_id);
@@ -0,0 +1,26 @@
+-- A league's regular season standings, recomputed every week from the recorded matchup results.
+-- Teams are ranked by win percentage, then by the league's tiebreakers; a team placed ahead of
+-- the next team by a tiebreaker records which one and why.
+CREATE TABLE league_standings
+(
+    league_id          UUID             NOT NULL REFERENCES leagues (id) ON DELETE CASCADE,
+    season             VARCHAR(10)      NOT NULL,
+    fantasy_team_id    UUID             NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    rank               INTEGER          NOT NULL CHECK (rank >= 1),
+    wins               INTEGER          NOT NULL DEFAULT 0,
+    losses             INTEGER          NOT NULL DEFAULT 0,
+    ties               INTEGER          NOT NULL DEFAULT 0,
+    points_for         DOUBLE PRECISION NOT NULL DEFAULT 0,
+    points_against     DOUBLE PRECISION NOT NULL DEFAULT 0,
+    division_wins      INTEGER          NOT NULL DEFAULT 0,
+    division_losses    INTEGER          NOT NULL DEFAULT 0,
+    division_ties      INTEGER          NOT NULL DEFAULT 0,
+    tiebreaker         VARCHAR(20),         -- set when a tiebreaker ranked the team over the next one
+    tiebreaker_team_id UUID REFERENCES fantasy_teams (id) ON DELETE SET NULL,
+    tiebreaker_detail  TEXT,
+    through_week       INTEGER          NOT NULL DEFAULT 0, -- the last week with a result counted
+    computed_at        TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (league_id, season, fantasy_team_id)
+);
+
+CREATE INDEX idx_league_standings_rank ON league_standings (league_id, season, rank);
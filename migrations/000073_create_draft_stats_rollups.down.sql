@@ -0,0 +1,4 @@
+DROP FUNCTION IF EXISTS refresh_draft_stats(UUID);
+DROP TABLE IF EXISTS draft_position_stats;
+DROP TABLE IF EXISTS draft_team_position_stats;
+DROP TABLE IF EXISTS draft_team_stats;
@@ -0,0 +1,100 @@
+-- Statistics of a completed draft for the league home page, rolled up from its picks when it
+-- completes so clients don't derive them from the whole board. refresh_draft_stats rebuilds a
+-- draft's rows, so a redelivered completion or a refresh after a correction leaves them right.
+
+-- Each team's picks: how many it made, how many were made for it by the auto-picker and how
+-- many were keepers
+CREATE TABLE draft_team_stats
+(
+    draft_id         UUID        NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    team_id          UUID        NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    picks_made       INTEGER     NOT NULL,
+    autopicks        INTEGER     NOT NULL,
+    keeper_picks     INTEGER     NOT NULL,
+    avg_overall_pick NUMERIC(8, 2),        -- NULL when the team made no picks
+    refreshed_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (draft_id, team_id)
+);
+
+-- How many players of each position each team drafted
+CREATE TABLE draft_team_position_stats
+(
+    draft_id         UUID          NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    team_id          UUID          NOT NULL REFERENCES fantasy_teams (id) ON DELETE CASCADE,
+    position         TEXT          NOT NULL, -- empty for players without a position
+    picks            INTEGER       NOT NULL,
+    avg_overall_pick NUMERIC(8, 2) NOT NULL,
+    PRIMARY KEY (draft_id, team_id, position)
+);
+
+-- Where each position actually went in the draft: its realized average draft position, and
+-- its first and last picks
+CREATE TABLE draft_position_stats
+(
+    draft_id           UUID          NOT NULL REFERENCES draft (id) ON DELETE CASCADE,
+    position           TEXT          NOT NULL,
+    picks              INTEGER       NOT NULL,
+    avg_overall_pick   NUMERIC(8, 2) NOT NULL,
+    first_overall_pick INTEGER       NOT NULL,
+    last_overall_pick  INTEGER       NOT NULL,
+    PRIMARY KEY (draft_id, position)
+);
+
+-- Rebuild one draft's statistics from its made picks. Auto-picks are the PickMade events the
+-- draft outbox recorded without a picking user, leaving out auction sales, and counted only
+-- while the pick still holds the player they announced.
+CREATE OR REPLACE FUNCTION refresh_draft_stats(p_draft_id UUID) RETURNS VOID AS $$
+BEGIN
+    DELETE FROM draft_team_stats WHERE draft_id = p_draft_id;
+    DELETE FROM draft_team_position_stats WHERE draft_id = p_draft_id;
+    DELETE FROM draft_position_stats WHERE draft_id = p_draft_id;
+
+    INSERT INTO draft_team_stats (draft_id, team_id, picks_made, autopicks, keeper_picks, avg_overall_pick)
+    SELECT p_draft_id,
+           dp.team_id,
+           COUNT(dp.player_id),
+           COUNT(auto.pick_id),
+           COUNT(*) FILTER (WHERE dp.player_id IS NOT NULL AND COALESCE(dp.keeper_pick, FALSE)),
+           ROUND(AVG(dp.overall_pick) FILTER (WHERE dp.player_id IS NOT NULL), 2)
+    FROM draft_picks dp
+             LEFT JOIN (SELECT DISTINCT (o.payload ->> 'pick_id')::uuid AS pick_id,
+                                        (o.payload ->> 'player_id')::uuid AS player_id
+                        FROM draft_outbox o
+                        WHERE o.draft_id = p_draft_id
+                          AND o.event_type = 'PickMade'
+                          AND o.payload ->> 'picked_by' IS NULL
+                          AND o.payload -> 'auction_amount' IS NULL) auto
+                       ON auto.pick_id = dp.id AND auto.player_id = dp.player_id
+                           AND NOT COALESCE(dp.keeper_pick, FALSE)
+    WHERE dp.draft_id = p_draft_id
+    GROUP BY dp.team_id;
+
+    INSERT INTO draft_team_position_stats (draft_id, team_id, position, picks, avg_overall_pick)
+    SELECT p_draft_id,
+           dp.team_id,
+           COALESCE(npp.position, ''),
+           COUNT(*),
+           ROUND(AVG(dp.overall_pick), 2)
+    FROM draft_picks dp
+             LEFT JOIN nfl_player_profiles npp ON npp.player_id = dp.player_id
+    WHERE dp.draft_id = p_draft_id
+      AND dp.player_id IS NOT NULL
+    GROUP BY dp.team_id, COALESCE(npp.position, '');
+
+    INSERT INTO draft_position_stats (draft_id, position, picks, avg_overall_pick, first_overall_pick, last_overall_pick)
+    SELECT p_draft_id,
+           COALESCE(npp.position, ''),
+           COUNT(*),
+           ROUND(AVG(dp.overall_pick), 2),
+           MIN(dp.overall_pick),
+           MAX(dp.overall_pick)
+    FROM draft_picks dp
+             LEFT JOIN nfl_player_profiles npp ON npp.player_id = dp.player_id
+    WHERE dp.draft_id = p_draft_id
+      AND dp.player_id IS NOT NULL
+    GROUP BY COALESCE(npp.position, '');
+END;
+$$ LANGUAGE plpgsql;
+
+-- Drafts completed before the rollups
+SELECT refresh_draft_stats(id) FROM draft WHERE status = 'COMPLETED' AND sandbox_of_draft_id IS NULL;
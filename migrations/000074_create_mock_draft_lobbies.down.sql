@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS mock_draft_queue;
+DROP TABLE IF EXISTS mock_draft_lobbies;
+DELETE FROM users WHERE id BETWEEN '00000000-0000-4000-8000-00000000b000' AND '00000000-0000-4000-8000-00000000b015';
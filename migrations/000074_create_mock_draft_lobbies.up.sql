@@ -0,0 +1,46 @@
+-- Public mock draft lobbies. Users queue for a mock draft format; the matchmaker seats them in a
+-- lobby, filling the seats nobody queued for with bots once the longest waiting user has waited
+-- long enough, and creates a throwaway league and draft for it. The league, its teams and its
+-- draft are deleted a while after the draft completes; the lobby row stays as a record.
+CREATE TABLE mock_draft_lobbies
+(
+    id           UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    format       TEXT        NOT NULL,
+    status       TEXT        NOT NULL DEFAULT 'FORMING'
+        CHECK (status IN ('FORMING', 'DRAFTING', 'TORN_DOWN', 'FAILED')),
+    league_id    UUID,                -- no foreign keys: the league and draft are torn down
+    draft_id     UUID,
+    human_seats  INTEGER     NOT NULL,
+    bot_seats    INTEGER     NOT NULL,
+    error        TEXT,                -- why the lobby failed to form
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    torn_down_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_mock_draft_lobbies_open ON mock_draft_lobbies (created_at) WHERE status IN ('FORMING', 'DRAFTING');
+
+-- A user waiting for, or seated in, a mock draft. A user is in one mock draft at a time; the
+-- row is deleted when the user leaves the queue or the lobby is torn down.
+CREATE TABLE mock_draft_queue
+(
+    user_id         UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    format          TEXT        NOT NULL,
+    team_name       TEXT        NOT NULL,
+    lobby_id        UUID REFERENCES mock_draft_lobbies (id) ON DELETE CASCADE, -- NULL while waiting
+    fantasy_team_id UUID,                                                      -- the user's team once the lobby's league exists
+    queued_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_mock_draft_queue_waiting ON mock_draft_queue (format, queued_at) WHERE lobby_id IS NULL;
+CREATE INDEX idx_mock_draft_queue_lobby ON mock_draft_queue (lobby_id) WHERE lobby_id IS NOT NULL;
+
+-- The host that commissions mock leagues, and the bots that own the seats nobody queued for
+INSERT INTO users (id, username, email)
+VALUES ('00000000-0000-4000-8000-00000000b000', 'mock-draft-host', 'mock-draft-host@bots.invalid');
+
+INSERT INTO users (id, username, email)
+SELECT ('00000000-0000-4000-8000-00000000b' || lpad(n::text, 3, '0'))::uuid,
+       'mock-draft-bot-' || lpad(n::text, 2, '0'),
+       'mock-draft-bot-' || lpad(n::text, 2, '0') || '@bots.invalid'
+FROM generate_series(1, 15) AS n;
@@ -0,0 +1,11 @@
+-- Team names and cities in other locales, as supplied by the data providers, replaced wholesale
+-- on every sync of the team
+CREATE TABLE team_localized_names
+(
+    team_id    UUID        NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    locale     TEXT        NOT NULL, -- BCP 47 tag, e.g. 'es-MX'
+    name       TEXT        NOT NULL,
+    city       TEXT        NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team_id, locale)
+);
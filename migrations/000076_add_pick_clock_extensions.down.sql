@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_draft_deadline_changes_extension;
+DELETE FROM draft_deadline_changes WHERE reason = 'EXTENSION';
+ALTER TABLE draft_deadline_changes DROP CONSTRAINT draft_deadline_changes_reason_check;
+ALTER TABLE draft_deadline_changes
+    ADD CONSTRAINT draft_deadline_changes_reason_check CHECK (reason IN ('RESUME', 'SETTINGS_CHANGE'));
+ALTER TABLE draft_deadline_changes DROP COLUMN IF EXISTS pick_id;
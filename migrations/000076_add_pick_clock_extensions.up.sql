@@ -0,0 +1,12 @@
+-- Commissioners can give the team on the clock a one-time extension of its pick clock. Each
+-- grant is recorded in the deadline log against the pick it extended, and a pick can be
+-- extended only once.
+ALTER TABLE draft_deadline_changes ADD COLUMN pick_id UUID REFERENCES draft_picks (id) ON DELETE CASCADE;
+
+ALTER TABLE draft_deadline_changes DROP CONSTRAINT draft_deadline_changes_reason_check;
+ALTER TABLE draft_deadline_changes
+    ADD CONSTRAINT draft_deadline_changes_reason_check CHECK (reason IN ('RESUME', 'SETTINGS_CHANGE', 'EXTENSION'));
+
+CREATE UNIQUE INDEX idx_draft_deadline_changes_extension
+    ON draft_deadline_changes (pick_id)
+    WHERE reason = 'EXTENSION';
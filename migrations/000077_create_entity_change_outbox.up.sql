@@ -0,0 +1,21 @@
+-- Changes to leagues, fantasy teams and rosters, written in the transaction of the change and
+-- published to the ENTITY_CHANGES stream so caches, search indexes and read models know what
+-- to invalidate. Partitioned by month of created_at like the other outbox tables. There are no
+-- foreign keys, so a change outlives the team it was about.
+CREATE TABLE entity_change_outbox
+(
+    id         UUID        NOT NULL DEFAULT gen_random_uuid(),
+    event_type TEXT        NOT NULL CHECK (event_type IN ('LeagueUpdated', 'TeamUpdated', 'RosterChanged')),
+    entity_id  UUID        NOT NULL, -- the league, or the fantasy team whose team or roster changed
+    league_id  UUID        NOT NULL,
+    action     TEXT        NOT NULL, -- what happened, e.g. 'settings_changed', 'player_dropped'
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at    TIMESTAMPTZ,          -- NULL = not published yet
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE entity_change_outbox_default PARTITION OF entity_change_outbox DEFAULT;
+SELECT create_monthly_partition('entity_change_outbox', (date_trunc('month', NOW() AT TIME ZONE 'UTC') + make_interval(months => m))::date)
+FROM generate_series(0, 3) AS m;
+
+CREATE INDEX idx_entity_change_outbox_unsent ON entity_change_outbox (created_at, id) WHERE sent_at IS NULL;
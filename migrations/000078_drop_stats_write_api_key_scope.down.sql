@@ -0,0 +1,4 @@
+ALTER TABLE league_api_keys
+    DROP CONSTRAINT league_api_keys_scopes_check,
+    ADD CONSTRAINT league_api_keys_scopes_check
+        CHECK (cardinality(scopes) > 0 AND scopes <@ ARRAY ['results:read', 'stats:write', 'webhooks:manage']);
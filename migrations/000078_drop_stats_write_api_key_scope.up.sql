@@ -0,0 +1,12 @@
+-- stats:write was issued but no RPC accepted it. Keys that held only stats:write could do
+-- nothing and are deleted; the scope is dropped from the rest.
+DELETE FROM league_api_keys WHERE scopes = ARRAY ['stats:write'];
+
+UPDATE league_api_keys
+SET scopes = array_remove(scopes, 'stats:write')
+WHERE 'stats:write' = ANY (scopes);
+
+ALTER TABLE league_api_keys
+    DROP CONSTRAINT league_api_keys_scopes_check,
+    ADD CONSTRAINT league_api_keys_scopes_check
+        CHECK (cardinality(scopes) > 0 AND scopes <@ ARRAY ['results:read', 'webhooks:manage']);
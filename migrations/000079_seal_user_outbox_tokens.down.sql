@@ -0,0 +1,2 @@
+-- The plain text tokens dropped by the up migration can't be brought back.
+SELECT 1;
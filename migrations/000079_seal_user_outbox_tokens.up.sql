@@ -0,0 +1,5 @@
+-- Emailed tokens used to be kept in the user outbox in plain text until their email was sent.
+-- They are sealed now; unsent ones are dropped, and their users can ask for a new email.
+UPDATE user_outbox
+SET payload = payload - 'token'
+WHERE payload ? 'token';
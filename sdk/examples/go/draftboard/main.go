@@ -0,0 +1,75 @@
+// Command draftboard signs in and prints a draft's board, round by round.
+//
+//	go run ./draftboard -api http://localhost:8080 -login demo01 -password demo-password -draft <draft id>
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	dynasty "github.com/mcdev12/dynasty/sdk/go"
+	draftv1 "github.com/mcdev12/dynasty/sdk/go/draft/v1"
+	"github.com/mcdev12/dynasty/sdk/go/draft/v1/draftv1connect"
+	userv1 "github.com/mcdev12/dynasty/sdk/go/user/v1"
+	"github.com/mcdev12/dynasty/sdk/go/user/v1/userv1connect"
+)
+
+func main() {
+	api := flag.String("api", "http://localhost:8080", "API server address")
+	login := flag.String("login", "", "username or email")
+	password := flag.String("password", "", "password")
+	draftID := flag.String("draft", "", "id of the draft to print")
+	flag.Parse()
+	if *login == "" || *password == "" || *draftID == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+
+	users := userv1connect.NewUserServiceClient(http.DefaultClient, *api)
+	session, err := users.Login(ctx, connect.NewRequest(&userv1.LoginRequest{
+		Login:      *login,
+		Password:   *password,
+		DeviceName: "draftboard example",
+	}))
+	if err != nil {
+		log.Fatalf("Failed to sign in: %v", err)
+	}
+
+	picks := draftv1connect.NewDraftPickServiceClient(http.DefaultClient, *api,
+		connect.WithInterceptors(dynasty.WithAccessToken(session.Msg.Tokens.AccessToken)))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PICK\tROUND\tTEAM\tPLAYER")
+	req := &draftv1.GetDraftPicksByDraftRequest{DraftId: *draftID, PageSize: 100}
+	for {
+		resp, err := picks.GetDraftPicksByDraft(ctx, connect.NewRequest(req))
+		if err != nil {
+			log.Fatalf("Failed to get picks: %v", err)
+		}
+		for _, pick := range resp.Msg.Picks {
+			player := pick.PlayerId
+			switch {
+			case pick.Forfeited:
+				player = "(forfeited)"
+			case player == "":
+				player = "-"
+			}
+			fmt.Fprintf(w, "%d\t%d.%02d\t%s\t%s\n", pick.OverallPick, pick.Round, pick.Pick, pick.TeamId, player)
+		}
+		if resp.Msg.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.Msg.NextPageToken
+	}
+	if err := w.Flush(); err != nil {
+		log.Fatalf("Failed to print the board: %v", err)
+	}
+}
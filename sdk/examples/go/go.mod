@@ -0,0 +1,11 @@
+module github.com/mcdev12/dynasty/sdk/examples/go
+
+go 1.24.4
+
+require (
+	connectrpc.com/connect v1.18.1
+	github.com/mcdev12/dynasty/sdk/go v0.0.0
+)
+
+// The examples build against the SDK in this checkout; run `make sdk` first
+replace github.com/mcdev12/dynasty/sdk/go => ../../go
@@ -0,0 +1,41 @@
+// Signs in and lists the leagues the user is a member of, with their drafts.
+//
+//   DYNASTY_LOGIN=demo01 DYNASTY_PASSWORD=demo-password npm run my-leagues
+import { createClient } from "@connectrpc/connect";
+import { createConnectTransport } from "@connectrpc/connect-node";
+import { withAccessToken } from "@mcdev12/dynasty-sdk";
+import { DraftStatus } from "@mcdev12/dynasty-sdk/draft/v1/draft_pb";
+import { DraftService } from "@mcdev12/dynasty-sdk/draft/v1/draft_service_pb";
+import { LeagueService } from "@mcdev12/dynasty-sdk/league/v1/service_pb";
+import { UserService } from "@mcdev12/dynasty-sdk/user/v1/service_pb";
+
+const baseUrl = process.env.DYNASTY_API ?? "http://localhost:8080";
+const login = process.env.DYNASTY_LOGIN;
+const password = process.env.DYNASTY_PASSWORD;
+if (!login || !password) {
+  console.error("Set DYNASTY_LOGIN and DYNASTY_PASSWORD");
+  process.exit(2);
+}
+
+const users = createClient(UserService, createConnectTransport({ baseUrl, httpVersion: "1.1" }));
+const { user, tokens } = await users.login({ login, password, deviceName: "my-leagues example" });
+if (!user || !tokens) {
+  throw new Error("login returned no session");
+}
+
+const transport = createConnectTransport({
+  baseUrl,
+  httpVersion: "1.1",
+  interceptors: [withAccessToken(tokens.accessToken)],
+});
+const leagues = createClient(LeagueService, transport);
+const drafts = createClient(DraftService, transport);
+
+const { leagues: mine } = await leagues.listLeagues({ memberUserId: user.id });
+for (const league of mine) {
+  console.log(`${league.name} (${league.season})`);
+  const { drafts: leagueDrafts } = await drafts.listDraftsForLeague({ leagueId: league.id });
+  for (const draft of leagueDrafts) {
+    console.log(`  draft ${draft.id}: ${DraftStatus[draft.status]}`);
+  }
+}
@@ -0,0 +1,41 @@
+// Package dynasty holds what the generated Dynasty API clients share. The clients themselves
+// are generated per service, in packages named after their protos, e.g.
+//
+//	client := draftv1connect.NewDraftPickServiceClient(http.DefaultClient, "https://api.example.com",
+//		connect.WithInterceptors(dynasty.WithAccessToken(tokens.AccessToken)))
+//
+// Calls are signed in with a user's session access token from UserService.Login, or made by an
+// external tool with a league API key from LeagueService.CreateLeagueAPIKey.
+package dynasty
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+// APIKeyHeader carries a league API key
+const APIKeyHeader = "X-API-Key"
+
+// WithAccessToken signs calls in as the user whose session access token it is. Access tokens
+// expire; refresh them with UserService.RefreshSession and make new clients.
+func WithAccessToken(accessToken string) connect.Interceptor {
+	return withHeader("Authorization", "Bearer "+accessToken)
+}
+
+// WithAPIKey makes calls with a league API key. A key may only call what its scopes cover, on
+// its own league.
+func WithAPIKey(key string) connect.Interceptor {
+	return withHeader(APIKeyHeader, key)
+}
+
+func withHeader(name, value string) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				req.Header().Set(name, value)
+			}
+			return next(ctx, req)
+		}
+	})
+}
@@ -0,0 +1,9 @@
+module github.com/mcdev12/dynasty/sdk/go
+
+go 1.24.4
+
+require (
+	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.36.6-20250425153114-8976f5be98c1.1
+	connectrpc.com/connect v1.18.1
+	google.golang.org/protobuf v1.36.6
+)
@@ -0,0 +1,51 @@
+#!/bin/sh
+# Releases the client SDKs at a version:
+#
+#   make sdk-release version=1.4.0
+#
+# The SDK's protos must stay backwards compatible with the last release, so this fails on a
+# breaking change; ship a new package version (e.g. draft/v2) instead. The generated code isn't
+# kept on the branch, so it goes into a release commit off it: the Go module is fetched from
+# that commit's sdk/go/vX.Y.Z tag, and the TypeScript package is published to npm from it.
+set -eu
+
+version=${1:-}
+version=${version#v}
+case "$version" in
+[0-9]*.[0-9]*.[0-9]*) ;;
+*)
+	echo "usage: sdk/release.sh <major.minor.patch>" >&2
+	exit 1
+	;;
+esac
+
+cd "$(git rev-parse --show-toplevel)"
+if [ -n "$(git status --porcelain)" ]; then
+	echo "commit or stash your changes before releasing" >&2
+	exit 1
+fi
+tag="sdk/go/v$version"
+if git rev-parse -q --verify "refs/tags/$tag" >/dev/null; then
+	echo "$tag is already released" >&2
+	exit 1
+fi
+
+# The first release has nothing to be compatible with
+if [ -n "$(git tag --list 'sdk/go/v*')" ]; then
+	make sdk-breaking
+fi
+
+make sdk
+(cd sdk/go && go mod tidy && go vet ./...)
+(cd sdk/ts && npm version "$version" --no-git-tag-version --allow-same-version && npm install && npm run build)
+
+branch=$(git rev-parse --abbrev-ref HEAD)
+git switch -q --detach
+git add -f sdk/go sdk/ts/package.json sdk/ts/package-lock.json sdk/ts/src/gen
+git commit -q -m "Release client SDKs v$version"
+git tag -a "$tag" -m "Client SDKs v$version"
+git push -q origin "$tag"
+(cd sdk/ts && npm publish --access public)
+git switch -q "$branch"
+
+echo "Released $tag and @mcdev12/dynasty-sdk@$version"
@@ -0,0 +1,38 @@
+// Typed clients for the Dynasty API. Services, messages and enums are generated from the API's
+// protos and imported from the paths of their files, e.g.
+//
+//   import { createClient } from "@connectrpc/connect";
+//   import { createConnectTransport } from "@connectrpc/connect-web";
+//   import { DraftPickService } from "@mcdev12/dynasty-sdk/draft/v1/draft_pick_service_pb";
+//   import { withAccessToken } from "@mcdev12/dynasty-sdk";
+//
+//   const transport = createConnectTransport({
+//     baseUrl: "https://api.example.com",
+//     interceptors: [withAccessToken(tokens.accessToken)],
+//   });
+//   const picks = createClient(DraftPickService, transport);
+//
+// Calls are signed in with a user's session access token from UserService.login, or made by an
+// external tool with a league API key from LeagueService.createLeagueAPIKey.
+import type { Interceptor } from "@connectrpc/connect";
+
+// The header a league API key is sent in
+export const API_KEY_HEADER = "X-API-Key";
+
+// Signs calls in as the user whose session access token it is. Access tokens expire; pass a
+// function to read the current one from wherever refreshSession stores it.
+export function withAccessToken(accessToken: string | (() => string)): Interceptor {
+  return (next) => async (req) => {
+    const token = typeof accessToken === "function" ? accessToken() : accessToken;
+    req.header.set("Authorization", `Bearer ${token}`);
+    return await next(req);
+  };
+}
+
+// Makes calls with a league API key. A key may only call what its scopes cover, on its own league.
+export function withAPIKey(key: string): Interceptor {
+  return (next) => async (req) => {
+    req.header.set(API_KEY_HEADER, key);
+    return await next(req);
+  };
+}
@@ -325,7 +325,6 @@ export default function DraftScreen() {
               rounds: draftState.metadata.total_rounds,
               timePerPickSec: 120,
               draftOrder: [],
-              thirdRoundReversal: false,
             })}
             draftType={draftState.metadata.draft_type}
             playersById={draftState.playersById || new Map()}